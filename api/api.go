@@ -0,0 +1,14 @@
+// Package api embeds the API specifications so the running servers can publish them.
+package api
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI specification of the REST API, in YAML.
+//
+//go:embed openapi/openapi.yml
+var OpenAPISpec []byte
+
+// GraphQLSchema is the schema of the GraphQL API, in SDL.
+//
+//go:embed graphql/schema.graphql
+var GraphQLSchema string
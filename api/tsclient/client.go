@@ -0,0 +1,429 @@
+package tsclient
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// schemaNamespace is the namespace the client imports the generated types into.
+const schemaNamespace = "schema."
+
+// methodOrder sorts the operations of a path the way the specification usually lists them.
+var methodOrder = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "TRACE"}
+
+// clientRuntime is the hand-written part of the client shared by all operations.
+const clientRuntime = `
+/** Options of createApiClient. */
+export interface ApiClientOptions {
+  /** Base URL of the API, e.g. "https://todo.example.com". Defaults to the same origin. */
+  baseUrl?: string;
+  /** Returns headers sent with every request, e.g. an Authorization bearer token. */
+  headers?: () => Record<string, string>;
+  /** Fetch implementation, defaults to the global fetch. */
+  fetch?: typeof fetch;
+}
+
+/** Error thrown when the API answers with an error status. */
+export class ApiError extends Error {
+  readonly status: number;
+  /** Machine-readable error code, e.g. BAD_REQUEST, when the body is an API error. */
+  readonly code: string | undefined;
+
+  constructor(status: number, message: string, code?: string) {
+    super(` + "`[${status}] ${message}`" + `);
+    this.name = 'ApiError';
+    this.status = status;
+    this.code = code;
+  }
+}
+
+interface Operation {
+  method: string;
+  path: string;
+  query?: Record<string, unknown>;
+  /** Query parameters serialized as deepObject, e.g. dateRange[dueAfter]=2026-01-01. */
+  deepObject?: string[];
+  headers?: Record<string, string | undefined>;
+  body?: unknown;
+}
+
+const encodeQuery = (query: Record<string, unknown> = {}, deepObject: string[] = []): string => {
+  const search = new URLSearchParams();
+  for (const [name, value] of Object.entries(query)) {
+    if (value === undefined || value === null) {
+      continue;
+    }
+    if (Array.isArray(value)) {
+      value.forEach((item) => search.append(name, String(item)));
+    } else if (deepObject.includes(name) && typeof value === 'object') {
+      for (const [key, item] of Object.entries(value as Record<string, unknown>)) {
+        if (item !== undefined && item !== null) {
+          search.append(` + "`${name}[${key}]`" + `, String(item));
+        }
+      }
+    } else {
+      search.append(name, String(value));
+    }
+  }
+  const encoded = search.toString();
+  return encoded ? ` + "`?${encoded}`" + ` : '';
+};
+
+const toApiError = async (response: Response): Promise<ApiError> => {
+  const text = await response.text().catch(() => '');
+  try {
+    const body = JSON.parse(text) as { error?: { code?: string; message?: string } };
+    if (body.error?.message) {
+      return new ApiError(response.status, body.error.message, body.error.code);
+    }
+  } catch {
+    // Not an API error body.
+  }
+  return new ApiError(response.status, text.trim() || response.statusText || 'Request failed');
+};
+
+/** Creates a client of the REST API. Every method rejects with an ApiError when the API answers with an error status. */
+export const createApiClient = (options: ApiClientOptions = {}) => {
+  const baseUrl = (options.baseUrl ?? '').replace(/\/+$/, '');
+  const fetchImpl = options.fetch ?? ((input: RequestInfo | URL, init?: RequestInit) => fetch(input, init));
+
+  const send = async (operation: Operation, init?: RequestInit): Promise<Response> => {
+    const headers = new Headers(init?.headers);
+    for (const [name, value] of Object.entries({ ...options.headers?.(), ...operation.headers })) {
+      if (value !== undefined) {
+        headers.set(name, value);
+      }
+    }
+    if (operation.body !== undefined) {
+      headers.set('Content-Type', 'application/json');
+    }
+
+    const response = await fetchImpl(` + "`${baseUrl}${operation.path}${encodeQuery(operation.query, operation.deepObject)}`" + `, {
+      ...init,
+      method: operation.method,
+      headers,
+      body: operation.body === undefined ? undefined : JSON.stringify(operation.body),
+    });
+    if (!response.ok) {
+      throw await toApiError(response);
+    }
+    return response;
+  };
+
+  const json = async <T>(operation: Operation, init?: RequestInit): Promise<T> =>
+    (await (await send(operation, init)).json()) as T;
+
+  const text = async (operation: Operation, init?: RequestInit): Promise<string> =>
+    (await send(operation, init)).text();
+
+  const none = async (operation: Operation, init?: RequestInit): Promise<void> => {
+    await send(operation, init);
+  };
+
+  return {
+`
+
+// responseKind is how the client reads the success response of an operation.
+type responseKind int
+
+const (
+	responseNone responseKind = iota
+	responseJSON
+	responseText
+	// responseStream returns the open Response, e.g. of a Server-Sent Events stream.
+	responseStream
+)
+
+// operation is one API operation as rendered in the client.
+type operation struct {
+	id         string
+	method     string
+	path       string
+	op         *openapi3.Operation
+	parameters []*openapi3.Parameter
+}
+
+func renderClient(doc *openapi3.T, cfg Config) ([]byte, error) {
+	operations, err := collectOperations(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	writeHeader(&b, cfg.Source)
+	fmt.Fprintf(&b, "import type * as schema from '%s';\n", cfg.TypesImport)
+	for _, o := range operations {
+		writeParamsType(&b, o)
+	}
+	b.WriteString(clientRuntime)
+	for _, o := range operations {
+		if err := writeMethod(&b, o); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteString("  };\n};\n\n/** Client of the REST API returned by createApiClient. */\nexport type ApiClient = ReturnType<typeof createApiClient>;\n")
+	return b.Bytes(), nil
+}
+
+// collectOperations lists the operations of the specification sorted by path and method.
+func collectOperations(doc *openapi3.T) ([]operation, error) {
+	if doc.Paths == nil {
+		return nil, nil
+	}
+	paths := doc.Paths.Map()
+	names := make([]string, 0, len(paths))
+	for name := range paths {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var operations []operation
+	seen := map[string]string{}
+	for _, path := range names {
+		item := paths[path]
+		for _, method := range methodOrder {
+			op := item.GetOperation(method)
+			if op == nil {
+				continue
+			}
+			if op.OperationID == "" {
+				return nil, fmt.Errorf("%s %s: missing operationId", method, path)
+			}
+			if previous, ok := seen[op.OperationID]; ok {
+				return nil, fmt.Errorf("%s %s: operationId %s already used by %s", method, path, op.OperationID, previous)
+			}
+			seen[op.OperationID] = method + " " + path
+			operations = append(operations, operation{
+				id:         op.OperationID,
+				method:     method,
+				path:       path,
+				op:         op,
+				parameters: mergeParameters(item.Parameters, op.Parameters),
+			})
+		}
+	}
+	return operations, nil
+}
+
+// mergeParameters returns the path item parameters overridden by the operation parameters.
+func mergeParameters(pathParams, opParams openapi3.Parameters) []*openapi3.Parameter {
+	var parameters []*openapi3.Parameter
+	for _, ref := range append(slices.Clone(pathParams), opParams...) {
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		param := ref.Value
+		parameters = slices.DeleteFunc(parameters, func(p *openapi3.Parameter) bool {
+			return p.Name == param.Name && p.In == param.In
+		})
+		parameters = append(parameters, param)
+	}
+	return parameters
+}
+
+// hasParams reports whether the method of an operation takes a params argument.
+func (o operation) hasParams() bool {
+	return len(o.parameters) > 0 || o.requestBody() != nil
+}
+
+func (o operation) paramsType() string {
+	return pascalCase(o.id) + "Params"
+}
+
+func (o operation) requestBody() *openapi3.RequestBody {
+	if o.op.RequestBody == nil || o.op.RequestBody.Value == nil {
+		return nil
+	}
+	return o.op.RequestBody.Value
+}
+
+// writeParamsType declares the params argument of an operation method.
+func writeParamsType(b *bytes.Buffer, o operation) {
+	if !o.hasParams() {
+		return
+	}
+	fmt.Fprintf(b, "\n/** Parameters of %s. */\nexport interface %s {\n", o.id, o.paramsType())
+	for _, param := range o.parameters {
+		writeDoc(b, "  ", param.Description)
+		optional := "?"
+		if param.Required {
+			optional = ""
+		}
+		fmt.Fprintf(b, "  %s%s: %s;\n", propertyName(param.Name), optional, tsType(param.Schema, schemaNamespace, "  ", false))
+	}
+	if body := o.requestBody(); body != nil {
+		optional := "?"
+		if body.Required {
+			optional = ""
+		}
+		fmt.Fprintf(b, "  body%s: %s;\n", optional, tsType(jsonSchema(body.Content), schemaNamespace, "  ", false))
+	}
+	b.WriteString("}\n")
+}
+
+// writeMethod renders the client method of an operation.
+func writeMethod(b *bytes.Buffer, o operation) error {
+	kind, resultType := successResponse(o.op)
+
+	var doc []string
+	for _, text := range []string{o.op.Summary, o.op.Description} {
+		if text = strings.TrimSpace(text); text != "" {
+			doc = append(doc, sentence(text))
+		}
+	}
+	if kind == responseStream {
+		doc = append(doc, "Resolves with the open streaming response.")
+	}
+	writeDoc(b, "    ", strings.Join(doc, " "))
+
+	args := "init?: RequestInit"
+	if o.hasParams() {
+		args = "params: " + o.paramsType() + ", " + args
+	}
+	fmt.Fprintf(b, "    %s: (%s) =>\n", o.id, args)
+
+	path, err := pathTemplate(o)
+	if err != nil {
+		return err
+	}
+	fields := []string{fmt.Sprintf("method: '%s'", o.method), "path: " + path}
+
+	var query, headers, deepObject []string
+	for _, param := range o.parameters {
+		switch param.In {
+		case openapi3.ParameterInQuery:
+			query = append(query, fmt.Sprintf("%s: %s", propertyName(param.Name), paramAccess(param.Name)))
+			if param.Style == openapi3.SerializationDeepObject {
+				deepObject = append(deepObject, quote(param.Name))
+			}
+		case openapi3.ParameterInHeader:
+			headers = append(headers, fmt.Sprintf("%s: %s", propertyName(param.Name), paramAccess(param.Name)))
+		}
+	}
+	if len(query) > 0 {
+		fields = append(fields, "query: { "+strings.Join(query, ", ")+" }")
+	}
+	if len(deepObject) > 0 {
+		fields = append(fields, "deepObject: ["+strings.Join(deepObject, ", ")+"]")
+	}
+	if len(headers) > 0 {
+		fields = append(fields, "headers: { "+strings.Join(headers, ", ")+" }")
+	}
+	if o.requestBody() != nil {
+		fields = append(fields, "body: params.body")
+	}
+
+	call := "send"
+	switch kind {
+	case responseJSON:
+		call = "json<" + resultType + ">"
+	case responseText:
+		call = "text"
+	case responseNone:
+		call = "none"
+	}
+	fmt.Fprintf(b, "      %s({\n", call)
+	for _, field := range fields {
+		fmt.Fprintf(b, "        %s,\n", field)
+	}
+	b.WriteString("      }, init),\n")
+	return nil
+}
+
+// successResponse returns how to read the first 2xx response of an operation and its TypeScript type.
+func successResponse(op *openapi3.Operation) (responseKind, string) {
+	if op.Responses == nil {
+		return responseNone, ""
+	}
+	responses := op.Responses.Map()
+	codes := make([]string, 0, len(responses))
+	for code := range responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+	if len(codes) == 0 || responses[codes[0]].Value == nil {
+		return responseNone, ""
+	}
+
+	content := responses[codes[0]].Value.Content
+	switch {
+	case len(content) == 0:
+		return responseNone, ""
+	case content.Get("text/event-stream") != nil:
+		return responseStream, ""
+	case jsonSchema(content) != nil:
+		return responseJSON, tsType(jsonSchema(content), schemaNamespace, "    ", false)
+	default:
+		return responseText, ""
+	}
+}
+
+// jsonSchema returns the application/json schema of a content map, if any.
+func jsonSchema(content openapi3.Content) *openapi3.SchemaRef {
+	if media := content.Get("application/json"); media != nil {
+		return media.Schema
+	}
+	return nil
+}
+
+// pathTemplate renders the path of an operation as a template literal that encodes its path parameters.
+func pathTemplate(o operation) (string, error) {
+	var b strings.Builder
+	b.WriteString("`")
+	rest := o.path
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			b.WriteString(rest)
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("%s: unterminated path parameter in %s", o.id, o.path)
+		}
+		name := rest[start+1 : start+end]
+		if !slices.ContainsFunc(o.parameters, func(p *openapi3.Parameter) bool {
+			return p.In == openapi3.ParameterInPath && p.Name == name
+		}) {
+			return "", fmt.Errorf("%s: path parameter %s is not declared", o.id, name)
+		}
+		b.WriteString(rest[:start])
+		fmt.Fprintf(&b, "${encodeURIComponent(String(%s))}", paramAccess(name))
+		rest = rest[start+end+1:]
+	}
+	b.WriteString("`")
+	return b.String(), nil
+}
+
+// paramAccess renders the expression reading a parameter from the params argument.
+func paramAccess(name string) string {
+	if identifier.MatchString(name) {
+		return "params." + name
+	}
+	return "params[" + quote(name) + "]"
+}
+
+// sentence ends text with a period unless it already ends with punctuation.
+func sentence(text string) string {
+	if strings.HasSuffix(text, ".") || strings.HasSuffix(text, "!") || strings.HasSuffix(text, "?") {
+		return text
+	}
+	return text + "."
+}
+
+// pascalCase turns an operationId such as listTodos into ListTodos.
+func pascalCase(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,216 @@
+// Package tsclient generates the TypeScript client of the REST API from its OpenAPI specification.
+//
+// Generate renders two files: the schema types, and a fetch based client with one method per
+// operation that imports them. Both are committed in the web UI so it and external frontends
+// build against the same API surface; streaming operations return the raw Response, which the
+// hand-written streaming helpers of the web UI decode.
+package tsclient
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Output holds the generated TypeScript sources.
+type Output struct {
+	// Types declares one type per component schema.
+	Types []byte
+	// Client declares createApiClient, which imports the types from TypesImport.
+	Client []byte
+}
+
+// Config configures Generate.
+type Config struct {
+	// Source is the path of the specification named in the generated headers.
+	Source string
+	// TypesImport is the module specifier the client uses to import the types, e.g. "../types/openapi".
+	TypesImport string
+}
+
+// Generate renders the TypeScript types and client of an OpenAPI 3.0 specification.
+func Generate(spec []byte, cfg Config) (Output, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		return Output{}, fmt.Errorf("load OpenAPI spec: %w", err)
+	}
+
+	types := renderTypes(doc, cfg)
+	client, err := renderClient(doc, cfg)
+	if err != nil {
+		return Output{}, err
+	}
+	return Output{Types: types, Client: client}, nil
+}
+
+func renderTypes(doc *openapi3.T, cfg Config) []byte {
+	var b bytes.Buffer
+	writeHeader(&b, cfg.Source)
+
+	var names []string
+	if doc.Components != nil {
+		for name := range doc.Components.Schemas {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		schema := doc.Components.Schemas[name].Value
+		b.WriteString("\n")
+		writeDoc(&b, "", schema.Description)
+		if isInterface(schema) {
+			fmt.Fprintf(&b, "export interface %s ", name)
+			b.WriteString(objectType(schema, "", ""))
+			b.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(&b, "export type %s = %s;\n", name, tsType(doc.Components.Schemas[name], "", "", true))
+	}
+	return b.Bytes()
+}
+
+// isInterface reports whether a component schema renders as an interface rather than a type alias.
+func isInterface(schema *openapi3.Schema) bool {
+	return schema.Type.Is("object") && len(schema.Properties) > 0 && !schema.Nullable &&
+		len(schema.AllOf) == 0 && len(schema.OneOf) == 0 && len(schema.AnyOf) == 0
+}
+
+// tsType renders the TypeScript type of a schema. Component references render as the name of
+// their type, prefixed with namespace when it is set. top is true for the schema of a component
+// itself, which must be expanded instead of referencing its own name.
+func tsType(ref *openapi3.SchemaRef, namespace, indent string, top bool) string {
+	if ref == nil || ref.Value == nil {
+		return "unknown"
+	}
+	if ref.Ref != "" && !top {
+		return namespace + strings.TrimPrefix(ref.Ref, "#/components/schemas/")
+	}
+
+	schema := ref.Value
+	t := baseType(schema, namespace, indent)
+	if schema.Nullable {
+		t += " | null"
+	}
+	return t
+}
+
+func baseType(schema *openapi3.Schema, namespace, indent string) string {
+	switch {
+	case len(schema.AllOf) > 0:
+		return joinTypes(schema.AllOf, " & ", namespace, indent)
+	case len(schema.OneOf) > 0:
+		return joinTypes(schema.OneOf, " | ", namespace, indent)
+	case len(schema.AnyOf) > 0:
+		return joinTypes(schema.AnyOf, " | ", namespace, indent)
+	case len(schema.Enum) > 0:
+		literals := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			literals = append(literals, literal(value))
+		}
+		return strings.Join(literals, " | ")
+	case schema.Type.Is("string"):
+		return "string"
+	case schema.Type.Is("integer"), schema.Type.Is("number"):
+		return "number"
+	case schema.Type.Is("boolean"):
+		return "boolean"
+	case schema.Type.Is("array"):
+		item := tsType(schema.Items, namespace, indent, false)
+		if strings.ContainsAny(item, "|&") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case schema.Type.Is("object"):
+		if len(schema.Properties) == 0 {
+			if additional := schema.AdditionalProperties.Schema; additional != nil {
+				return "Record<string, " + tsType(additional, namespace, indent, false) + ">"
+			}
+			return "Record<string, unknown>"
+		}
+		return objectType(schema, namespace, indent)
+	default:
+		return "unknown"
+	}
+}
+
+func joinTypes(refs openapi3.SchemaRefs, separator, namespace, indent string) string {
+	types := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		types = append(types, tsType(ref, namespace, indent, false))
+	}
+	return strings.Join(types, separator)
+}
+
+// objectType renders the properties of an object schema as an object type literal.
+func objectType(schema *openapi3.Schema, namespace, indent string) string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	inner := indent + "  "
+	for _, name := range names {
+		property := schema.Properties[name]
+		if property.Value != nil {
+			writeDoc(&b, inner, property.Value.Description)
+		}
+		optional := "?"
+		if slices.Contains(schema.Required, name) {
+			optional = ""
+		}
+		fmt.Fprintf(&b, "%s%s%s: %s;\n", inner, propertyName(name), optional, tsType(property, namespace, inner, false))
+	}
+	if schema.AdditionalProperties.Has != nil && *schema.AdditionalProperties.Has {
+		fmt.Fprintf(&b, "%s[key: string]: unknown;\n", inner)
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// propertyName quotes a property name that is not a valid identifier, e.g. a header name.
+func propertyName(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return quote(name)
+}
+
+func literal(value any) string {
+	switch v := value.(type) {
+	case string:
+		return quote(v)
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// writeDoc writes a description as a one-paragraph JSDoc comment.
+func writeDoc(w io.StringWriter, indent, description string) {
+	description = strings.Join(strings.Fields(description), " ")
+	if description == "" {
+		return
+	}
+	description = strings.ReplaceAll(description, "*/", "*\\/")
+	_, _ = w.WriteString(indent + "/** " + description + " */\n")
+}
+
+func writeHeader(b *bytes.Buffer, source string) {
+	fmt.Fprintf(b, "// Code generated by tsclient-gen from %s. DO NOT EDIT.\n", source)
+}
@@ -0,0 +1,225 @@
+package tsclient
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info:
+  title: Test API
+  version: 1.0.0
+paths:
+  /items/{item_id}:
+    parameters:
+      - in: path
+        name: item_id
+        required: true
+        schema:
+          type: string
+          format: uuid
+    get:
+      operationId: getItem
+      summary: Get an item
+      parameters:
+        - in: query
+          name: range
+          style: deepObject
+          explode: true
+          schema:
+            $ref: '#/components/schemas/Range'
+        - in: header
+          name: X-Trace-Id
+          schema:
+            type: string
+      responses:
+        "200":
+          description: The item.
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Item'
+    delete:
+      operationId: deleteItem
+      responses:
+        "204":
+          description: Deleted.
+  /items/{item_id}/events:
+    parameters:
+      - in: path
+        name: item_id
+        required: true
+        schema:
+          type: string
+    post:
+      operationId: streamItemEvents
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                since:
+                  type: integer
+      responses:
+        "200":
+          description: SSE stream
+          content:
+            text/event-stream:
+              schema:
+                type: string
+components:
+  schemas:
+    Item:
+      type: object
+      description: An item.
+      required: [id, status]
+      properties:
+        id:
+          type: string
+          format: uuid
+        status:
+          $ref: '#/components/schemas/ItemStatus'
+        tags:
+          type: array
+          items:
+            type: string
+        note:
+          type: string
+          nullable: true
+          description: >
+            Free text
+            note.
+        attributes:
+          type: object
+          additionalProperties: true
+    ItemStatus:
+      type: string
+      enum: [OPEN, DONE]
+    Range:
+      type: object
+      properties:
+        from:
+          type: string
+          format: date
+`
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	out, err := Generate([]byte(testSpec), Config{Source: "test.yml", TypesImport: "./types"})
+	require.NoError(t, err)
+
+	assert.Equal(t, `// Code generated by tsclient-gen from test.yml. DO NOT EDIT.
+
+/** An item. */
+export interface Item {
+  attributes?: Record<string, unknown>;
+  id: string;
+  /** Free text note. */
+  note?: string | null;
+  status: ItemStatus;
+  tags?: string[];
+}
+
+export type ItemStatus = 'OPEN' | 'DONE';
+
+export interface Range {
+  from?: string;
+}
+`, string(out.Types))
+
+	client := string(out.Client)
+	assert.Contains(t, client, "import type * as schema from './types';\n")
+	assert.Contains(t, client, `export interface GetItemParams {
+  item_id: string;
+  range?: schema.Range;
+  'X-Trace-Id'?: string;
+}`)
+	assert.Contains(t, client, `export interface StreamItemEventsParams {
+  item_id: string;
+  body?: {
+    since?: number;
+  };
+}`)
+	assert.Contains(t, client, "    /** Get an item. */\n    getItem: (params: GetItemParams, init?: RequestInit) =>\n      json<schema.Item>({\n"+
+		"        method: 'GET',\n"+
+		"        path: `/items/${encodeURIComponent(String(params.item_id))}`,\n"+
+		"        query: { range: params.range },\n"+
+		"        deepObject: ['range'],\n"+
+		"        headers: { 'X-Trace-Id': params['X-Trace-Id'] },\n"+
+		"      }, init),\n")
+	assert.Contains(t, client, "    deleteItem: (params: DeleteItemParams, init?: RequestInit) =>\n      none({\n")
+	assert.Contains(t, client, "    /** Resolves with the open streaming response. */\n    streamItemEvents: (params: StreamItemEventsParams, init?: RequestInit) =>\n      send({\n")
+	assert.Contains(t, client, "        body: params.body,\n")
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		spec        string
+		expectedErr string
+	}{
+		"invalid-spec": {
+			spec:        "openapi: [",
+			expectedErr: "load OpenAPI spec",
+		},
+		"missing-operation-id": {
+			spec: `
+openapi: 3.0.3
+info: {title: Test, version: 1.0.0}
+paths:
+  /items:
+    get:
+      responses:
+        "204": {description: Empty.}
+`,
+			expectedErr: "GET /items: missing operationId",
+		},
+		"undeclared-path-parameter": {
+			spec: `
+openapi: 3.0.3
+info: {title: Test, version: 1.0.0}
+paths:
+  /items/{id}:
+    get:
+      operationId: getItem
+      responses:
+        "204": {description: Empty.}
+`,
+			expectedErr: "getItem: path parameter id is not declared",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Generate([]byte(tt.spec), Config{})
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+// TestGenerate_WebappUpToDate fails when the committed web UI client was not regenerated after
+// the OpenAPI spec changed. Run go generate ./... to fix it.
+func TestGenerate_WebappUpToDate(t *testing.T) {
+	t.Parallel()
+
+	out, err := Generate(api.OpenAPISpec, Config{Source: "api/openapi/openapi.yml", TypesImport: "../types/openapi"})
+	require.NoError(t, err)
+
+	types, err := os.ReadFile("../../webapp/src/types/openapi.ts")
+	require.NoError(t, err)
+	client, err := os.ReadFile("../../webapp/src/services/openapiClient.ts")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(out.Types), string(types), "webapp/src/types/openapi.ts is stale, run go generate ./...")
+	assert.Equal(t, string(out.Client), string(client), "webapp/src/services/openapiClient.ts is stale, run go generate ./...")
+}
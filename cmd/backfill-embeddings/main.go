@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/workers"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/app"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+)
+
+func main() {
+	tenantID := flag.String("tenant", string(tenant.Default), "tenant whose todos are embedded")
+	batchSize := flag.Int("batch-size", 50, "todos embedded per batch")
+	interval := flag.Duration("interval", time.Second, "pause between batches, to stay under the embedding API rate limit")
+	secondary := flag.Bool("secondary", false, "fill the secondary embedding with LLM_EMBEDDING_SECONDARY_MODEL instead of the primary one")
+	flag.Parse()
+
+	if *batchSize < 1 {
+		log.Fatalf("Invalid -batch-size: must be at least 1, got %d", *batchSize)
+	}
+
+	slot := todo.EmbeddingSlot_Primary
+	if *secondary {
+		slot = todo.EmbeddingSlot_Secondary
+	}
+
+	err := app.NewEmbeddingBackfiller(&workers.EmbeddingBackfiller{
+		TenantID:  tenant.ID(*tenantID),
+		Slot:      slot,
+		BatchSize: *batchSize,
+		Interval:  *interval,
+	}).Run()
+	if err != nil {
+		log.Fatalf("Failed to backfill embeddings: %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/app"
+)
+
+func main() {
+	err := app.NewGRPCAPI().Run()
+	if err != nil {
+		log.Fatalf("Failed to run the gRPC API: %v", err)
+	}
+}
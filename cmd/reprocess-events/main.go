@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/workers"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/app"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/google/uuid"
+)
+
+func main() {
+	target := flag.String("target", "", "work to regenerate: board-summaries or conversation-titles")
+	tenantID := flag.String("tenant", string(tenant.Default), "tenant whose records are reprocessed")
+	conversation := flag.String("conversation", "", "restrict the run to one conversation ID")
+	from := flag.String("from", "", "start of the activity range, as 2006-01-02 or RFC 3339")
+	to := flag.String("to", "", "end of the activity range (excluded), as 2006-01-02 or RFC 3339")
+	dryRun := flag.Bool("dry-run", false, "count the events without recording them")
+	flag.Parse()
+
+	req := outbox.ReprocessRequest{
+		Target: outbox.ReprocessTarget(*target),
+		From:   parseTime("from", *from),
+		To:     parseTime("to", *to),
+		DryRun: *dryRun,
+	}
+	if *conversation != "" {
+		conversationID, err := uuid.Parse(*conversation)
+		if err != nil {
+			log.Fatalf("Invalid -conversation: %v", err)
+		}
+		req.ConversationID = &conversationID
+	}
+
+	err := app.NewEventReprocessor(&workers.EventReprocessor{
+		TenantID: tenant.ID(*tenantID),
+		Request:  req,
+	}).Run()
+	if err != nil {
+		log.Fatalf("Failed to reprocess events: %v", err)
+	}
+}
+
+// parseTime parses a date or RFC 3339 timestamp flag. Dates are midnight UTC.
+func parseTime(name, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.Fatalf("Invalid -%s: expected 2006-01-02 or RFC 3339, got %q", name, value)
+	}
+	return t
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/redaction"
+)
+
+func main() {
+	generateKey := flag.Bool("generate-key", false, "print a new REDACTION_PUBLIC_KEY and its private key, then exit")
+	flag.Parse()
+
+	if *generateKey {
+		publicKey, privateKey, err := redaction.GenerateKeyPair()
+		if err != nil {
+			log.Fatalf("Failed to generate key pair: %v", err)
+		}
+		fmt.Printf("REDACTION_PUBLIC_KEY=%s\nREDACTION_PRIVATE_KEY=%s\n", publicKey, privateKey)
+		return
+	}
+
+	privateKey := os.Getenv("REDACTION_PRIVATE_KEY")
+	if privateKey == "" {
+		log.Fatal("REDACTION_PRIVATE_KEY is required")
+	}
+	opener, err := redaction.NewOpener(privateKey)
+	if err != nil {
+		log.Fatalf("Invalid REDACTION_PRIVATE_KEY: %v", err)
+	}
+
+	content, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		log.Fatalf("Failed to read stdin: %v", err)
+	}
+	revealed, err := redaction.Reveal(string(content), opener)
+	if err != nil {
+		log.Fatalf("Failed to reveal redacted values: %v", err)
+	}
+	fmt.Print(revealed)
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/app"
+)
+
+func main() {
+	err := app.NewTelegramBot().Run()
+	if err != nil {
+		log.Fatalf("Failed to run the Telegram bot: %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/api/tsclient"
+)
+
+func main() {
+	spec := flag.String("spec", "api/openapi/openapi.yml", "OpenAPI specification to generate the client from")
+	typesOut := flag.String("types", "webapp/src/types/openapi.ts", "output file of the schema types")
+	clientOut := flag.String("client", "webapp/src/services/openapiClient.ts", "output file of the client")
+	typesImport := flag.String("types-import", "../types/openapi", "module specifier the client imports the types from")
+	flag.Parse()
+
+	content, err := os.ReadFile(*spec)
+	if err != nil {
+		log.Fatalf("Failed to read OpenAPI spec: %v", err)
+	}
+	out, err := tsclient.Generate(content, tsclient.Config{Source: *spec, TypesImport: *typesImport})
+	if err != nil {
+		log.Fatalf("Failed to generate TypeScript client: %v", err)
+	}
+	if err := os.WriteFile(*typesOut, out.Types, 0o644); err != nil {
+		log.Fatalf("Failed to write types: %v", err)
+	}
+	if err := os.WriteFile(*clientOut, out.Client, 0o644); err != nil {
+		log.Fatalf("Failed to write client: %v", err)
+	}
+}
@@ -1,3 +1,4 @@
 package todoapp
 
 //go:generate go tool github.com/vektra/mockery/v3
+//go:generate go run ./cmd/tsclient-gen
@@ -0,0 +1,563 @@
+package caldav
+
+import (
+	"crypto/subtle"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+)
+
+const (
+	// BasePath is the path the handler is mounted on. It is both the principal and the calendar home.
+	BasePath = "/caldav/"
+	// CalendarPath is the calendar collection holding one VTODO object per todo.
+	CalendarPath = BasePath + "todos/"
+	// FocusFeedPath is the read-only iCalendar feed of the suggested and accepted focus blocks.
+	FocusFeedPath = BasePath + "focus-blocks.ics"
+
+	// listPageSize is the page size used to read every todo of the calendar.
+	listPageSize = 100
+	// maxBodySize bounds request bodies.
+	maxBodySize = 1 << 20
+
+	calendarContentType = "text/calendar; charset=utf-8"
+	xmlContentType      = "application/xml; charset=utf-8"
+)
+
+// Handler serves the todos as a CalDAV calendar of VTODO components, so clients such as Apple Reminders and
+// Thunderbird can subscribe to them and edit them. Every request requires HTTP Basic authentication.
+//
+// Edits go through the todo use cases. The ETag of a todo is its updated_at, so a PUT or DELETE whose If-Match
+// no longer matches the stored todo is rejected with 412 Precondition Failed and the client refetches it.
+//
+// When FocusBlocks is set, FocusFeedPath also serves the focus blocks as a subscribable feed of VEVENT holds.
+type Handler struct {
+	Logger       *log.Logger
+	TodoRepo     todo.Repository
+	ListTodos    todouc.List
+	CreateTodo   todouc.Create
+	UpdateTodo   todouc.Update
+	DeleteTodo   todouc.Delete
+	FocusBlocks  todouc.FocusBlocks
+	TimeProvider core.CurrentTimeProvider
+	Username     string
+	Password     string
+}
+
+// ServeHTTP routes a CalDAV request to the principal, the calendar collection or a todo object.
+func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !h.authenticated(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="TodoApp CalDAV", charset="UTF-8"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	path := r.URL.Path
+	switch {
+	case path == BasePath || path+"/" == BasePath:
+		h.serveHome(w, r)
+	case path == CalendarPath || path+"/" == CalendarPath:
+		h.serveCalendar(w, r)
+	case path == FocusFeedPath && h.FocusBlocks != nil:
+		h.serveFocusFeed(w, r)
+	case strings.HasPrefix(path, CalendarPath) && strings.HasSuffix(path, ".ics"):
+		h.serveObject(w, r, strings.TrimSuffix(strings.TrimPrefix(path, CalendarPath), ".ics"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// authenticated checks the Basic credentials of the request.
+func (h Handler) authenticated(r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	if !ok || h.Password == "" {
+		return false
+	}
+	userOK := subtle.ConstantTimeCompare([]byte(username), []byte(h.Username)) == 1
+	passwordOK := subtle.ConstantTimeCompare([]byte(password), []byte(h.Password)) == 1
+	return userOK && passwordOK
+}
+
+// serveHome answers PROPFIND on the principal, which is also the calendar home holding the todo calendar.
+func (h Handler) serveHome(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PROPFIND" {
+		methodNotAllowed(w, "OPTIONS, PROPFIND")
+		return
+	}
+	names, ok := readPropfind(w, r)
+	if !ok {
+		return
+	}
+
+	resources := []resource{h.homeResource()}
+	if depth(r) > 0 {
+		todos, err := h.listAll(r)
+		if err != nil {
+			h.fail(w, "list todos", err)
+			return
+		}
+		resources = append(resources, calendarResource(todos))
+	}
+	writeMultistatus(w, resources, names)
+}
+
+// serveCalendar answers PROPFIND and REPORT on the todo calendar collection.
+func (h Handler) serveCalendar(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PROPFIND":
+		names, ok := readPropfind(w, r)
+		if !ok {
+			return
+		}
+		todos, err := h.listAll(r)
+		if err != nil {
+			h.fail(w, "list todos", err)
+			return
+		}
+		resources := []resource{calendarResource(todos)}
+		if depth(r) > 0 {
+			for _, t := range todos {
+				resources = append(resources, todoResource(t))
+			}
+		}
+		writeMultistatus(w, resources, names)
+	case "REPORT":
+		h.report(w, r)
+	default:
+		methodNotAllowed(w, "OPTIONS, PROPFIND, REPORT")
+	}
+}
+
+// report answers the calendar-query and calendar-multiget reports.
+func (h Handler) report(w http.ResponseWriter, r *http.Request) {
+	var req reportRequest
+	if err := xml.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&req); err != nil {
+		http.Error(w, "invalid REPORT body", http.StatusBadRequest)
+		return
+	}
+	names := requested(req.AllProp, req.Prop)
+
+	switch req.XMLName {
+	case reportCalendarQuery:
+		var resources []resource
+		if req.matchesTodos() {
+			todos, err := h.listAll(r)
+			if err != nil {
+				h.fail(w, "list todos", err)
+				return
+			}
+			for _, t := range todos {
+				resources = append(resources, todoResource(t))
+			}
+		}
+		writeMultistatus(w, resources, names)
+	case reportCalendarMultiget:
+		resp := multistatus{Responses: []response{}}
+		for _, ref := range req.Hrefs {
+			t, found, err := h.getTodo(r, objectName(ref))
+			if err != nil {
+				h.fail(w, "get todo", err)
+				return
+			}
+			if !found {
+				resp.Responses = append(resp.Responses, response{Href: ref, Status: statusLine(http.StatusNotFound)})
+				continue
+			}
+			resp.Responses = append(resp.Responses, todoResource(t).response(names))
+		}
+		writeXML(w, resp)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported report %q", req.XMLName.Local), http.StatusForbidden)
+	}
+}
+
+// serveFocusFeed answers GET on the focus block feed, which calendar apps subscribe to as a read-only calendar.
+func (h Handler) serveFocusFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		methodNotAllowed(w, "OPTIONS, GET, HEAD")
+		return
+	}
+	blocks, err := h.FocusBlocks.Calendar(r.Context())
+	if err != nil {
+		h.fail(w, "list focus blocks", err)
+		return
+	}
+	w.Header().Set("Content-Type", calendarContentType)
+	_, _ = io.WriteString(w, encodeFocusBlocks(blocks, h.TimeProvider.Now()))
+}
+
+// serveObject answers the requests on the VTODO object of one todo.
+func (h Handler) serveObject(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		t, found, err := h.getTodo(r, name)
+		if err != nil {
+			h.fail(w, "get todo", err)
+			return
+		}
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", calendarContentType)
+		w.Header().Set("ETag", etag(t))
+		w.Header().Set("Last-Modified", t.UpdatedAt.UTC().Format(http.TimeFormat))
+		_, _ = io.WriteString(w, encodeTodo(t))
+	case "PROPFIND":
+		names, ok := readPropfind(w, r)
+		if !ok {
+			return
+		}
+		t, found, err := h.getTodo(r, name)
+		if err != nil {
+			h.fail(w, "get todo", err)
+			return
+		}
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		writeMultistatus(w, []resource{todoResource(t)}, names)
+	case http.MethodPut:
+		h.put(w, r, name)
+	case http.MethodDelete:
+		h.delete(w, r, name)
+	default:
+		methodNotAllowed(w, "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND")
+	}
+}
+
+// put updates the todo of an existing object or creates a todo for a new one.
+// Only the fields that differ from the stored todo are updated, so a status change does not re-embed the title.
+func (h Handler) put(w http.ResponseWriter, r *http.Request, name string) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	item, err := decodeTodo(string(body))
+	if err != nil {
+		h.fail(w, "decode VTODO", err)
+		return
+	}
+
+	current, found, err := h.getTodo(r, name)
+	if err != nil {
+		h.fail(w, "get todo", err)
+		return
+	}
+
+	ctx := r.Context()
+	ifMatch := r.Header.Get("If-Match")
+	if !found {
+		if ifMatch != "" {
+			http.Error(w, "todo no longer exists", http.StatusPreconditionFailed)
+			return
+		}
+		created, err := h.create(r, item)
+		if err != nil {
+			h.fail(w, "create todo", err)
+			return
+		}
+		w.Header().Set("ETag", etag(created))
+		w.Header().Set("Location", objectPath(created.ID))
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if r.Header.Get("If-None-Match") == "*" {
+		http.Error(w, "todo already exists", http.StatusPreconditionFailed)
+		return
+	}
+
+	if !ifMatchMatches(ifMatch, current) {
+		http.Error(w, "todo was modified by another client", http.StatusPreconditionFailed)
+		return
+	}
+	var opts []todouc.UpdateOption
+	if ifMatch != "" && ifMatch != "*" {
+		// Repeat the check in the update transaction, in case the todo changes in the meantime.
+		opts = append(opts, todouc.WithExpectedUpdatedAt(current.UpdatedAt))
+	}
+
+	var (
+		title   *string
+		status  *todo.Status
+		dueDate *time.Time
+	)
+	if item.Summary != nil && *item.Summary != current.Title {
+		title = item.Summary
+	}
+	if item.Status != nil && *item.Status != current.Status {
+		status = item.Status
+	}
+	if item.DueDate != nil && !item.DueDate.Equal(current.DueDate) {
+		dueDate = item.DueDate
+	}
+	if title == nil && status == nil && dueDate == nil {
+		w.Header().Set("ETag", etag(current))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	updated, err := h.UpdateTodo.Execute(ctx, current.ID, title, status, dueDate, opts...)
+	if err != nil {
+		h.fail(w, "update todo", err)
+		return
+	}
+	w.Header().Set("ETag", etag(updated))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// create creates the todo of a new object. Todos need a due date, so an object without DUE is due today.
+func (h Handler) create(r *http.Request, item vtodo) (todo.Todo, error) {
+	title := ""
+	if item.Summary != nil {
+		title = *item.Summary
+	}
+	dueDate := h.TimeProvider.Now().UTC().Truncate(24 * time.Hour)
+	if item.DueDate != nil {
+		dueDate = *item.DueDate
+	}
+
+	created, err := h.CreateTodo.Execute(r.Context(), title, dueDate, 0, nil)
+	if err != nil || item.Status == nil || *item.Status == created.Status {
+		return created, err
+	}
+	return h.UpdateTodo.Execute(r.Context(), created.ID, nil, item.Status, nil)
+}
+
+// delete deletes the todo of an object, unless its If-Match no longer matches.
+func (h Handler) delete(w http.ResponseWriter, r *http.Request, name string) {
+	current, found, err := h.getTodo(r, name)
+	if err != nil {
+		h.fail(w, "get todo", err)
+		return
+	}
+	if !found {
+		http.NotFound(w, r)
+		return
+	}
+	if !ifMatchMatches(r.Header.Get("If-Match"), current) {
+		http.Error(w, "todo was modified by another client", http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.DeleteTodo.Execute(r.Context(), current.ID); err != nil {
+		h.fail(w, "delete todo", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// homeResource returns the principal and calendar home resource.
+func (h Handler) homeResource() resource {
+	return resource{
+		href: BasePath,
+		props: map[xml.Name]string{
+			propResourceType:         `<collection xmlns="DAV:"/><principal xmlns="DAV:"/>`,
+			propDisplayName:          escapeXML(h.Username),
+			propCurrentUserPrincipal: href(BasePath),
+			propPrincipalURL:         href(BasePath),
+			propCalendarHomeSet:      href(BasePath),
+		},
+	}
+}
+
+// calendarResource returns the calendar collection resource holding the todos.
+// Its ctag changes whenever a todo is created, updated or deleted, which tells clients to resynchronize.
+func calendarResource(todos []todo.Todo) resource {
+	tags := make([]string, 0, len(todos))
+	for _, t := range todos {
+		tags = append(tags, t.ID.String()+etag(t))
+	}
+	slices.Sort(tags)
+	hash := fnv.New64a()
+	for _, tag := range tags {
+		_, _ = io.WriteString(hash, tag)
+	}
+	ctag := strconv.FormatUint(hash.Sum64(), 16)
+
+	return resource{
+		href: CalendarPath,
+		props: map[xml.Name]string{
+			propResourceType:          `<collection xmlns="DAV:"/><calendar xmlns="urn:ietf:params:xml:ns:caldav"/>`,
+			propDisplayName:           "Todos",
+			propCurrentUserPrincipal:  href(BasePath),
+			propCurrentUserPrivileges: privilegesReadWrite,
+			propSupportedComponentSet: `<comp xmlns="urn:ietf:params:xml:ns:caldav" name="VTODO"/>`,
+			propSupportedReportSet:    supportedReports,
+			propGetCTag:               ctag,
+			propGetETag:               escapeXML(`"` + ctag + `"`),
+		},
+	}
+}
+
+// todoResource returns the VTODO object resource of a todo.
+func todoResource(t todo.Todo) resource {
+	return resource{
+		href: objectPath(t.ID),
+		props: map[xml.Name]string{
+			propResourceType:          "",
+			propGetETag:               escapeXML(etag(t)),
+			propGetContentType:        calendarContentType,
+			propGetLastModified:       t.UpdatedAt.UTC().Format(http.TimeFormat),
+			propCurrentUserPrivileges: privilegesReadWrite,
+			propCalendarData:          escapeXML(encodeTodo(t)),
+		},
+		hidden: map[xml.Name]bool{propCalendarData: true},
+	}
+}
+
+// listAll reads every todo.
+func (h Handler) listAll(r *http.Request) ([]todo.Todo, error) {
+	var all []todo.Todo
+	for page := 1; ; page++ {
+		todos, hasMore, err := h.ListTodos.Query(r.Context(), page, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, todos...)
+		if !hasMore {
+			return all, nil
+		}
+	}
+}
+
+// getTodo returns the todo of an object name. Names that are not todo IDs are reported as not found.
+func (h Handler) getTodo(r *http.Request, name string) (todo.Todo, bool, error) {
+	id, err := uuid.Parse(name)
+	if err != nil {
+		return todo.Todo{}, false, nil
+	}
+	return h.TodoRepo.GetTodo(r.Context(), id)
+}
+
+// fail writes the response of a failed request, logging unexpected errors.
+func (h Handler) fail(w http.ResponseWriter, action string, err error) {
+	var (
+		validationErr *core.ValidationErr
+		notFoundErr   *core.NotFoundErr
+		conflictErr   *core.ConflictErr
+	)
+	switch {
+	case errors.As(err, &validationErr):
+		http.Error(w, validationErr.Error(), http.StatusBadRequest)
+	case errors.As(err, &notFoundErr):
+		http.Error(w, notFoundErr.Error(), http.StatusNotFound)
+	case errors.As(err, &conflictErr):
+		http.Error(w, conflictErr.Error(), http.StatusPreconditionFailed)
+	default:
+		h.Logger.Printf("CalDAV: failed to %s: %v", action, err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}
+}
+
+// readPropfind decodes the requested property names of a PROPFIND. An empty body requests all properties.
+func readPropfind(w http.ResponseWriter, r *http.Request) ([]xml.Name, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return nil, false
+	}
+	if strings.TrimSpace(string(body)) == "" {
+		return nil, true
+	}
+
+	var req propfindRequest
+	if err := xml.Unmarshal(body, &req); err != nil {
+		http.Error(w, "invalid PROPFIND body", http.StatusBadRequest)
+		return nil, false
+	}
+	return requested(req.AllProp, req.Prop), true
+}
+
+// depth returns the Depth header, where infinity is served as 1.
+func depth(r *http.Request) int {
+	if r.Header.Get("Depth") == "0" {
+		return 0
+	}
+	return 1
+}
+
+// writeMultistatus writes the requested properties of the resources.
+func writeMultistatus(w http.ResponseWriter, resources []resource, names []xml.Name) {
+	resp := multistatus{Responses: make([]response, 0, len(resources))}
+	for _, res := range resources {
+		resp.Responses = append(resp.Responses, res.response(names))
+	}
+	writeXML(w, resp)
+}
+
+// writeXML writes a 207 Multi-Status response.
+func writeXML(w http.ResponseWriter, resp multistatus) {
+	body, err := xml.Marshal(resp)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", xmlContentType)
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = io.WriteString(w, xml.Header)
+	_, _ = w.Write(body)
+}
+
+// methodNotAllowed rejects a method not supported by the resource.
+func methodNotAllowed(w http.ResponseWriter, allow string) {
+	w.Header().Set("Allow", allow)
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+}
+
+// objectPath returns the path of the VTODO object of a todo.
+func objectPath(id uuid.UUID) string {
+	return CalendarPath + id.String() + ".ics"
+}
+
+// objectName returns the object name of an href, which clients may send as a path or as an absolute URL.
+func objectName(ref string) string {
+	path := ref
+	if u, err := url.Parse(ref); err == nil {
+		path = u.Path
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(path, CalendarPath), ".ics")
+}
+
+// etag returns the entity tag of a todo, derived from its last update.
+func etag(t todo.Todo) string {
+	return `"` + strconv.FormatInt(t.UpdatedAt.UnixNano(), 10) + `"`
+}
+
+// ifMatchMatches reports whether an If-Match header accepts the current version of a todo.
+// An absent header or "*" accepts any version.
+func ifMatchMatches(ifMatch string, t todo.Todo) bool {
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	for tag := range strings.SplitSeq(ifMatch, ",") {
+		tag = strings.Trim(strings.TrimPrefix(strings.TrimSpace(tag), "W/"), `"`)
+		nanos, err := strconv.ParseInt(tag, 10, 64)
+		if err == nil && t.UpdatedAt.Equal(time.Unix(0, nanos)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,520 @@
+package caldav
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type handlerMocks struct {
+	repo   *todo.MockRepository
+	list   *todouc.MockList
+	create *todouc.MockCreate
+	update *todouc.MockUpdate
+	delete *todouc.MockDelete
+	focus  *todouc.MockFocusBlocks
+	clock  *core.MockCurrentTimeProvider
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	updatedAt := time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	storedTodo := todo.Todo{
+		ID:        todoID,
+		Title:     "Buy milk",
+		Status:    todo.Status_OPEN,
+		DueDate:   dueDate,
+		CreatedAt: updatedAt,
+		UpdatedAt: updatedAt,
+	}
+	storedETag := `"1772447400000000000"`
+	staleETag := `"1772440000000000000"`
+	objectURL := "/caldav/todos/123e4567-e89b-12d3-a456-426614174000.ics"
+
+	vtodoBody := func(lines ...string) string {
+		return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VTODO\r\nUID:123e4567-e89b-12d3-a456-426614174000\r\n" +
+			strings.Join(lines, "\r\n") + "\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
+	}
+	expectedUpdatedAt := func(expected time.Time) any {
+		return mock.MatchedBy(func(opts []todouc.UpdateOption) bool {
+			params := todouc.UpdateParams{}
+			for _, opt := range opts {
+				opt(&params)
+			}
+			return params.ExpectedUpdatedAt != nil && params.ExpectedUpdatedAt.Equal(expected)
+		})
+	}
+
+	tests := map[string]struct {
+		method          string
+		path            string
+		headers         map[string]string
+		body            string
+		noAuth          bool
+		setExpectations func(m handlerMocks)
+		expectedStatus  int
+		expectedHeaders map[string]string
+		expectedBody    []string
+		unexpectedBody  []string
+	}{
+		"options-without-auth": {
+			method:          http.MethodOptions,
+			path:            "/caldav/",
+			noAuth:          true,
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{"DAV": "1, 3, calendar-access"},
+		},
+		"unauthorized": {
+			method:          "PROPFIND",
+			path:            "/caldav/",
+			noAuth:          true,
+			expectedStatus:  http.StatusUnauthorized,
+			expectedHeaders: map[string]string{"WWW-Authenticate": `Basic realm="TodoApp CalDAV", charset="UTF-8"`},
+		},
+		"propfind-principal": {
+			method:  "PROPFIND",
+			path:    "/caldav/",
+			headers: map[string]string{"Depth": "0"},
+			body: `<?xml version="1.0"?><propfind xmlns="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">` +
+				`<prop><current-user-principal/><C:calendar-home-set/><C:calendar-user-address-set/></prop></propfind>`,
+			expectedStatus: http.StatusMultiStatus,
+			expectedBody: []string{
+				`<current-user-principal xmlns="DAV:"><href xmlns="DAV:">/caldav/</href></current-user-principal>`,
+				`<calendar-home-set xmlns="urn:ietf:params:xml:ns:caldav"><href xmlns="DAV:">/caldav/</href></calendar-home-set>`,
+				`<calendar-user-address-set xmlns="urn:ietf:params:xml:ns:caldav"></calendar-user-address-set></prop><status xmlns="DAV:">HTTP/1.1 404 Not Found</status>`,
+			},
+		},
+		"propfind-home-lists-calendar": {
+			method:  "PROPFIND",
+			path:    "/caldav",
+			headers: map[string]string{"Depth": "1"},
+			body:    `<propfind xmlns="DAV:"><prop><resourcetype/></prop></propfind>`,
+			setExpectations: func(m handlerMocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, listPageSize).Return([]todo.Todo{storedTodo}, false, nil)
+			},
+			expectedStatus: http.StatusMultiStatus,
+			expectedBody: []string{
+				`<href xmlns="DAV:">/caldav/todos/</href>`,
+				`<calendar xmlns="urn:ietf:params:xml:ns:caldav"/>`,
+			},
+		},
+		"propfind-calendar-lists-todos": {
+			method:  "PROPFIND",
+			path:    "/caldav/todos/",
+			headers: map[string]string{"Depth": "1"},
+			setExpectations: func(m handlerMocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, listPageSize).Return([]todo.Todo{storedTodo}, true, nil)
+				m.list.EXPECT().Query(mock.Anything, 2, listPageSize).Return([]todo.Todo{}, false, nil)
+			},
+			expectedStatus: http.StatusMultiStatus,
+			expectedBody: []string{
+				`<getctag xmlns="http://calendarserver.org/ns/">`,
+				`<comp xmlns="urn:ietf:params:xml:ns:caldav" name="VTODO"/>`,
+				`<href xmlns="DAV:">` + objectURL + `</href>`,
+				`<getetag xmlns="DAV:">&#34;1772447400000000000&#34;</getetag>`,
+			},
+			unexpectedBody: []string{"BEGIN:VCALENDAR"},
+		},
+		"propfind-list-error": {
+			method:  "PROPFIND",
+			path:    "/caldav/todos/",
+			headers: map[string]string{"Depth": "1"},
+			setExpectations: func(m handlerMocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, listPageSize).Return(nil, false, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"propfind-invalid-body": {
+			method:         "PROPFIND",
+			path:           "/caldav/todos/",
+			body:           `<propfind`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		"report-calendar-query": {
+			method: "REPORT",
+			path:   "/caldav/todos/",
+			body: `<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">` +
+				`<D:prop><D:getetag/><C:calendar-data/></D:prop>` +
+				`<C:filter><C:comp-filter name="VCALENDAR"><C:comp-filter name="VTODO"/></C:comp-filter></C:filter>` +
+				`</C:calendar-query>`,
+			setExpectations: func(m handlerMocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, listPageSize).Return([]todo.Todo{storedTodo}, false, nil)
+			},
+			expectedStatus: http.StatusMultiStatus,
+			expectedBody: []string{
+				`<href xmlns="DAV:">` + objectURL + `</href>`,
+				`<calendar-data xmlns="urn:ietf:params:xml:ns:caldav">BEGIN:VCALENDAR&#xD;&#xA;`,
+				`SUMMARY:Buy milk&#xD;&#xA;`,
+			},
+		},
+		"report-calendar-query-events": {
+			method: "REPORT",
+			path:   "/caldav/todos/",
+			body: `<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">` +
+				`<D:prop><D:getetag/></D:prop>` +
+				`<C:filter><C:comp-filter name="VCALENDAR"><C:comp-filter name="VEVENT"/></C:comp-filter></C:filter>` +
+				`</C:calendar-query>`,
+			expectedStatus: http.StatusMultiStatus,
+			unexpectedBody: []string{"<response"},
+		},
+		"report-calendar-multiget": {
+			method: "REPORT",
+			path:   "/caldav/todos/",
+			body: `<C:calendar-multiget xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">` +
+				`<D:prop><D:getetag/><C:calendar-data/></D:prop>` +
+				`<D:href>https://todo.example.com` + objectURL + `</D:href>` +
+				`<D:href>/caldav/todos/223e4567-e89b-12d3-a456-426614174000.ics</D:href>` +
+				`</C:calendar-multiget>`,
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.repo.EXPECT().GetTodo(mock.Anything, createdID).Return(todo.Todo{}, false, nil)
+			},
+			expectedStatus: http.StatusMultiStatus,
+			expectedBody: []string{
+				`<href xmlns="DAV:">` + objectURL + `</href>`,
+				`SUMMARY:Buy milk`,
+				`<href xmlns="DAV:">/caldav/todos/223e4567-e89b-12d3-a456-426614174000.ics</href><status xmlns="DAV:">HTTP/1.1 404 Not Found</status>`,
+			},
+		},
+		"report-unsupported": {
+			method:         "REPORT",
+			path:           "/caldav/todos/",
+			body:           `<sync-collection xmlns="DAV:"><sync-token/></sync-collection>`,
+			expectedStatus: http.StatusForbidden,
+		},
+		"get-object": {
+			method: http.MethodGet,
+			path:   objectURL,
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"ETag":         storedETag,
+				"Content-Type": "text/calendar; charset=utf-8",
+			},
+			expectedBody: []string{"BEGIN:VTODO\r\nUID:123e4567-e89b-12d3-a456-426614174000\r\n"},
+		},
+		"get-unknown-object": {
+			method:         http.MethodGet,
+			path:           "/caldav/todos/not-a-todo.ics",
+			expectedStatus: http.StatusNotFound,
+		},
+		"get-calendar-not-allowed": {
+			method:          http.MethodGet,
+			path:            "/caldav/todos/",
+			expectedStatus:  http.StatusMethodNotAllowed,
+			expectedHeaders: map[string]string{"Allow": "OPTIONS, PROPFIND, REPORT"},
+		},
+		"unknown-path": {
+			method:         "PROPFIND",
+			path:           "/caldav/events/",
+			expectedStatus: http.StatusNotFound,
+		},
+		"put-completes-todo": {
+			method:  http.MethodPut,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": storedETag},
+			body:    vtodoBody("SUMMARY:Buy milk", "DUE;VALUE=DATE:20260305", "STATUS:COMPLETED"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.update.EXPECT().
+					Execute(mock.Anything, todoID, (*string)(nil), common.Ptr(todo.Status_DONE), (*time.Time)(nil), expectedUpdatedAt(updatedAt)).
+					Return(todo.Todo{ID: todoID, UpdatedAt: updatedAt.Add(time.Second)}, nil)
+			},
+			expectedStatus:  http.StatusNoContent,
+			expectedHeaders: map[string]string{"ETag": `"1772447401000000000"`},
+		},
+		"put-changes-title-and-due-date": {
+			method: http.MethodPut,
+			path:   objectURL,
+			body:   vtodoBody("SUMMARY:Buy oat milk", "DUE:20260306T120000Z", "STATUS:NEEDS-ACTION"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.update.EXPECT().
+					Execute(mock.Anything, todoID, common.Ptr("Buy oat milk"), (*todo.Status)(nil), common.Ptr(time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC))).
+					Return(storedTodo, nil)
+			},
+			expectedStatus:  http.StatusNoContent,
+			expectedHeaders: map[string]string{"ETag": storedETag},
+		},
+		"put-unchanged": {
+			method:  http.MethodPut,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": storedETag},
+			body:    vtodoBody("SUMMARY:Buy milk", "DUE;VALUE=DATE:20260305", "STATUS:NEEDS-ACTION"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+			},
+			expectedStatus:  http.StatusNoContent,
+			expectedHeaders: map[string]string{"ETag": storedETag},
+		},
+		"put-stale-etag": {
+			method:  http.MethodPut,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": staleETag},
+			body:    vtodoBody("STATUS:COMPLETED"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"put-concurrent-update": {
+			method:  http.MethodPut,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": storedETag},
+			body:    vtodoBody("STATUS:COMPLETED"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.update.EXPECT().
+					Execute(mock.Anything, todoID, (*string)(nil), common.Ptr(todo.Status_DONE), (*time.Time)(nil), expectedUpdatedAt(updatedAt)).
+					Return(todo.Todo{}, core.NewConflictErr("todo was modified by another client"))
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"put-deleted-todo": {
+			method:  http.MethodPut,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": storedETag},
+			body:    vtodoBody("STATUS:COMPLETED"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(todo.Todo{}, false, nil)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"put-invalid-title": {
+			method: http.MethodPut,
+			path:   objectURL,
+			body:   vtodoBody("SUMMARY:ab"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.update.EXPECT().
+					Execute(mock.Anything, todoID, common.Ptr("ab"), (*todo.Status)(nil), (*time.Time)(nil)).
+					Return(todo.Todo{}, core.NewValidationErr("title must be between 3 and 200 characters"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   []string{"title must be between 3 and 200 characters"},
+		},
+		"put-creates-todo": {
+			method:  http.MethodPut,
+			path:    "/caldav/todos/7F1C2B.ics",
+			headers: map[string]string{"If-None-Match": "*"},
+			body:    vtodoBody("SUMMARY:Water plants"),
+			setExpectations: func(m handlerMocks) {
+				m.clock.EXPECT().Now().Return(time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC))
+				m.create.EXPECT().
+					Execute(mock.Anything, "Water plants", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), 0, todo.CustomFieldValues(nil)).
+					Return(todo.Todo{ID: createdID, Status: todo.Status_OPEN, UpdatedAt: updatedAt}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedHeaders: map[string]string{
+				"ETag":     storedETag,
+				"Location": "/caldav/todos/223e4567-e89b-12d3-a456-426614174000.ics",
+			},
+		},
+		"put-creates-completed-todo": {
+			method: http.MethodPut,
+			path:   "/caldav/todos/7F1C2B.ics",
+			body:   vtodoBody("SUMMARY:Water plants", "DUE;VALUE=DATE:20260305", "STATUS:COMPLETED"),
+			setExpectations: func(m handlerMocks) {
+				m.clock.EXPECT().Now().Return(updatedAt)
+				m.create.EXPECT().
+					Execute(mock.Anything, "Water plants", dueDate, 0, todo.CustomFieldValues(nil)).
+					Return(todo.Todo{ID: createdID, Status: todo.Status_OPEN, UpdatedAt: updatedAt}, nil)
+				m.update.EXPECT().
+					Execute(mock.Anything, createdID, (*string)(nil), common.Ptr(todo.Status_DONE), (*time.Time)(nil)).
+					Return(todo.Todo{ID: createdID, Status: todo.Status_DONE, UpdatedAt: updatedAt}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		"put-existing-with-if-none-match": {
+			method:  http.MethodPut,
+			path:    objectURL,
+			headers: map[string]string{"If-None-Match": "*"},
+			body:    vtodoBody("SUMMARY:Buy milk"),
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"put-event": {
+			method:         http.MethodPut,
+			path:           objectURL,
+			body:           "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:Meeting\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"delete-todo": {
+			method:  http.MethodDelete,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": storedETag},
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.delete.EXPECT().Execute(mock.Anything, todoID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"delete-stale-etag": {
+			method:  http.MethodDelete,
+			path:    objectURL,
+			headers: map[string]string{"If-Match": staleETag},
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+		},
+		"delete-error": {
+			method: http.MethodDelete,
+			path:   objectURL,
+			setExpectations: func(m handlerMocks) {
+				m.repo.EXPECT().GetTodo(mock.Anything, todoID).Return(storedTodo, true, nil)
+				m.delete.EXPECT().Execute(mock.Anything, todoID).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   []string{"internal server error"},
+		},
+		"get-focus-feed": {
+			method: http.MethodGet,
+			path:   "/caldav/focus-blocks.ics",
+			setExpectations: func(m handlerMocks) {
+				m.focus.EXPECT().Calendar(mock.Anything).Return([]todo.FocusBlock{{
+					ID:     uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"),
+					TodoID: todoID,
+					Title:  "Buy milk",
+					Start:  updatedAt,
+					End:    updatedAt.Add(time.Hour),
+					Status: todo.FocusBlockStatus_SUGGESTED,
+				}}, nil)
+				m.clock.EXPECT().Now().Return(updatedAt)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{"Content-Type": calendarContentType},
+			expectedBody: []string{
+				"BEGIN:VEVENT\r\nUID:323e4567-e89b-12d3-a456-426614174000\r\n",
+				"SUMMARY:Focus: Buy milk\r\n",
+				"STATUS:TENTATIVE\r\n",
+			},
+		},
+		"put-focus-feed-not-allowed": {
+			method:          http.MethodPut,
+			path:            "/caldav/focus-blocks.ics",
+			expectedStatus:  http.StatusMethodNotAllowed,
+			expectedHeaders: map[string]string{"Allow": "OPTIONS, GET, HEAD"},
+		},
+		"get-focus-feed-error": {
+			method: http.MethodGet,
+			path:   "/caldav/focus-blocks.ics",
+			setExpectations: func(m handlerMocks) {
+				m.focus.EXPECT().Calendar(mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := handlerMocks{
+				repo:   todo.NewMockRepository(t),
+				list:   todouc.NewMockList(t),
+				create: todouc.NewMockCreate(t),
+				update: todouc.NewMockUpdate(t),
+				delete: todouc.NewMockDelete(t),
+				focus:  todouc.NewMockFocusBlocks(t),
+				clock:  core.NewMockCurrentTimeProvider(t),
+			}
+			if tt.setExpectations != nil {
+				tt.setExpectations(m)
+			}
+
+			h := Handler{
+				Logger:       log.New(io.Discard, "", 0),
+				TodoRepo:     m.repo,
+				ListTodos:    m.list,
+				CreateTodo:   m.create,
+				UpdateTodo:   m.update,
+				DeleteTodo:   m.delete,
+				FocusBlocks:  m.focus,
+				TimeProvider: m.clock,
+				Username:     "todoapp",
+				Password:     "secret",
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if !tt.noAuth {
+				req.SetBasicAuth("todoapp", "secret")
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for k, v := range tt.expectedHeaders {
+				assert.Equal(t, v, w.Header().Get(k), k)
+			}
+			for _, s := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), s)
+			}
+			for _, s := range tt.unexpectedBody {
+				assert.NotContains(t, w.Body.String(), s)
+			}
+		})
+	}
+}
+
+func TestHandler_authenticated(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		password string
+		setAuth  func(r *http.Request)
+		expected bool
+	}{
+		"valid": {
+			password: "secret",
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("todoapp", "secret") },
+			expected: true,
+		},
+		"wrong-password": {
+			password: "secret",
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("todoapp", "nope") },
+		},
+		"wrong-username": {
+			password: "secret",
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("admin", "secret") },
+		},
+		"missing-credentials": {
+			password: "secret",
+			setAuth:  func(r *http.Request) {},
+		},
+		"no-password-configured": {
+			setAuth: func(r *http.Request) { r.SetBasicAuth("todoapp", "") },
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := Handler{Username: "todoapp", Password: tt.password}
+			req := httptest.NewRequest("PROPFIND", "/caldav/", nil)
+			tt.setAuth(req)
+			assert.Equal(t, tt.expected, h.authenticated(req))
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package caldav
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+)
+
+const (
+	// maxLineLength is the longest iCalendar content line, in octets, before it is folded.
+	maxLineLength = 75
+	// utcDateTimeLayout is the iCalendar UTC DATE-TIME format.
+	utcDateTimeLayout = "20060102T150405Z"
+	// localDateTimeLayout is the iCalendar floating and TZID DATE-TIME format.
+	localDateTimeLayout = "20060102T150405"
+	// dateLayout is the iCalendar DATE format.
+	dateLayout = "20060102"
+)
+
+// vtodo holds the VTODO properties mapped to a todo. Nil fields were not present in the component.
+type vtodo struct {
+	Summary *string
+	Status  *todo.Status
+	DueDate *time.Time
+}
+
+// encodeTodo renders a todo as an iCalendar object holding one VTODO component.
+func encodeTodo(t todo.Todo) string {
+	var b strings.Builder
+	writeLine := func(line string) {
+		b.WriteString(foldLine(line))
+		b.WriteString("\r\n")
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Symbiont//TodoApp//EN")
+	writeLine("BEGIN:VTODO")
+	writeLine("UID:" + t.ID.String())
+	writeLine("DTSTAMP:" + t.UpdatedAt.UTC().Format(utcDateTimeLayout))
+	writeLine("CREATED:" + t.CreatedAt.UTC().Format(utcDateTimeLayout))
+	writeLine("LAST-MODIFIED:" + t.UpdatedAt.UTC().Format(utcDateTimeLayout))
+	writeLine("SUMMARY:" + escapeText(t.Title))
+	writeLine("DUE;VALUE=DATE:" + t.DueDate.UTC().Format(dateLayout))
+	if t.Location != nil {
+		if name := strings.TrimSpace(t.Location.Name); name != "" {
+			writeLine("LOCATION:" + escapeText(name))
+		}
+		if point := t.Location.Point; point != nil {
+			writeLine("GEO:" + strconv.FormatFloat(point.Latitude, 'f', -1, 64) + ";" +
+				strconv.FormatFloat(point.Longitude, 'f', -1, 64))
+		}
+	}
+	if t.Status == todo.Status_DONE {
+		writeLine("STATUS:COMPLETED")
+		writeLine("COMPLETED:" + t.UpdatedAt.UTC().Format(utcDateTimeLayout))
+	} else {
+		writeLine("STATUS:NEEDS-ACTION")
+	}
+	writeLine("END:VTODO")
+	writeLine("END:VCALENDAR")
+	return b.String()
+}
+
+// encodeFocusBlocks renders focus blocks as an iCalendar feed of VEVENT components. Suggested blocks are
+// TENTATIVE holds and accepted blocks are CONFIRMED; both keep the UID of the suggestion, so accepting a block
+// updates the event a subscribed calendar already shows.
+func encodeFocusBlocks(blocks []todo.FocusBlock, now time.Time) string {
+	var b strings.Builder
+	writeLine := func(line string) {
+		b.WriteString(foldLine(line))
+		b.WriteString("\r\n")
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Symbiont//TodoApp//EN")
+	writeLine("METHOD:PUBLISH")
+	writeLine("X-WR-CALNAME:Focus blocks")
+	for _, block := range blocks {
+		stamp := now
+		if !block.CreatedAt.IsZero() {
+			stamp = block.CreatedAt
+		}
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:" + block.ID.String())
+		writeLine("DTSTAMP:" + stamp.UTC().Format(utcDateTimeLayout))
+		writeLine("DTSTART:" + block.Start.UTC().Format(utcDateTimeLayout))
+		writeLine("DTEND:" + block.End.UTC().Format(utcDateTimeLayout))
+		writeLine("SUMMARY:" + escapeText("Focus: "+block.Title))
+		writeLine("RELATED-TO:" + block.TodoID.String())
+		if block.Status == todo.FocusBlockStatus_ACCEPTED {
+			writeLine("STATUS:CONFIRMED")
+		} else {
+			writeLine("STATUS:TENTATIVE")
+		}
+		writeLine("TRANSP:OPAQUE")
+		writeLine("END:VEVENT")
+	}
+	writeLine("END:VCALENDAR")
+	return b.String()
+}
+
+// decodeTodo reads the first VTODO component of an iCalendar object.
+// Nested components such as VALARM and properties without a todo counterpart are ignored.
+func decodeTodo(data string) (vtodo, error) {
+	var (
+		result  vtodo
+		inTodo  bool
+		found   bool
+		nesting int
+	)
+
+	for _, line := range unfoldLines(data) {
+		name, params, value, ok := parseContentLine(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case !inTodo:
+			if name == "BEGIN" && strings.EqualFold(value, "VTODO") && !found {
+				inTodo, found = true, true
+			}
+			continue
+		case name == "BEGIN":
+			nesting++
+			continue
+		case name == "END" && nesting > 0:
+			nesting--
+			continue
+		case name == "END":
+			inTodo = false
+			continue
+		case nesting > 0:
+			continue
+		}
+
+		switch name {
+		case "SUMMARY":
+			summary := strings.TrimSpace(unescapeText(value))
+			result.Summary = &summary
+		case "STATUS":
+			status := todo.Status_OPEN
+			if strings.EqualFold(value, "COMPLETED") {
+				status = todo.Status_DONE
+			}
+			result.Status = &status
+		case "COMPLETED":
+			if result.Status == nil {
+				status := todo.Status_DONE
+				result.Status = &status
+			}
+		case "DUE":
+			due, err := parseDate(value, params)
+			if err != nil {
+				return vtodo{}, err
+			}
+			result.DueDate = &due
+		}
+	}
+
+	if !found {
+		return vtodo{}, core.NewValidationErr("calendar object must contain a VTODO component")
+	}
+	return result, nil
+}
+
+// parseDate returns the calendar day of a DATE or DATE-TIME value as a UTC midnight, the way todo due dates are stored.
+// DATE-TIME values keep the day of their TZID, or of UTC when they end in Z.
+func parseDate(value string, params map[string]string) (time.Time, error) {
+	var (
+		t   time.Time
+		err error
+	)
+	switch {
+	case len(value) == len(dateLayout):
+		t, err = time.Parse(dateLayout, value)
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(utcDateTimeLayout, value)
+	default:
+		loc := time.UTC
+		if tzid := params["TZID"]; tzid != "" {
+			if l, lerr := time.LoadLocation(tzid); lerr == nil {
+				loc = l
+			}
+		}
+		t, err = time.ParseInLocation(localDateTimeLayout, value, loc)
+	}
+	if err != nil {
+		return time.Time{}, core.NewValidationErr(fmt.Sprintf("invalid DUE value %q", value))
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// unfoldLines splits an iCalendar object into content lines, joining folded continuation lines.
+func unfoldLines(data string) []string {
+	var lines []string
+	for raw := range strings.SplitSeq(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(raw, " ") || strings.HasPrefix(raw, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += raw[1:]
+			continue
+		}
+		if strings.TrimSpace(raw) != "" {
+			lines = append(lines, raw)
+		}
+	}
+	return lines
+}
+
+// parseContentLine splits a "NAME;PARAM=value:VALUE" content line. Names and parameter names are upper-cased.
+func parseContentLine(line string) (string, map[string]string, string, bool) {
+	quoted := false
+	sep := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:sep], ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return strings.ToUpper(parts[0]), params, line[sep+1:], true
+}
+
+// foldLine breaks a content line into chunks of at most maxLineLength octets without splitting a UTF-8 sequence.
+func foldLine(line string) string {
+	if len(line) <= maxLineLength {
+		return line
+	}
+	var b strings.Builder
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
+
+// escapeText escapes an iCalendar TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// unescapeText reverses escapeText.
+func unescapeText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
@@ -0,0 +1,260 @@
+package caldav
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeTodo(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		todo     todo.Todo
+		expected string
+	}{
+		"open": {
+			todo: todo.Todo{
+				ID:        todoID,
+				Title:     "Buy milk, eggs; bread",
+				Status:    todo.Status_OPEN,
+				DueDate:   dueDate,
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			},
+			expected: "BEGIN:VCALENDAR\r\n" +
+				"VERSION:2.0\r\n" +
+				"PRODID:-//Symbiont//TodoApp//EN\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:123e4567-e89b-12d3-a456-426614174000\r\n" +
+				"DTSTAMP:20260302T103000Z\r\n" +
+				"CREATED:20260301T090000Z\r\n" +
+				"LAST-MODIFIED:20260302T103000Z\r\n" +
+				"SUMMARY:Buy milk\\, eggs\\; bread\r\n" +
+				"DUE;VALUE=DATE:20260305\r\n" +
+				"STATUS:NEEDS-ACTION\r\n" +
+				"END:VTODO\r\n" +
+				"END:VCALENDAR\r\n",
+		},
+		"with-location": {
+			todo: todo.Todo{
+				ID:        todoID,
+				Title:     "Pick up package",
+				Status:    todo.Status_OPEN,
+				DueDate:   dueDate,
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+				Location: &todo.Location{
+					Name:  "Post office, Main St",
+					Point: &todo.GeoPoint{Latitude: 38.7139, Longitude: -9.1394},
+				},
+			},
+			expected: "BEGIN:VCALENDAR\r\n" +
+				"VERSION:2.0\r\n" +
+				"PRODID:-//Symbiont//TodoApp//EN\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:123e4567-e89b-12d3-a456-426614174000\r\n" +
+				"DTSTAMP:20260302T103000Z\r\n" +
+				"CREATED:20260301T090000Z\r\n" +
+				"LAST-MODIFIED:20260302T103000Z\r\n" +
+				"SUMMARY:Pick up package\r\n" +
+				"DUE;VALUE=DATE:20260305\r\n" +
+				"LOCATION:Post office\\, Main St\r\n" +
+				"GEO:38.7139;-9.1394\r\n" +
+				"STATUS:NEEDS-ACTION\r\n" +
+				"END:VTODO\r\n" +
+				"END:VCALENDAR\r\n",
+		},
+		"done-with-long-title": {
+			todo: todo.Todo{
+				ID:        todoID,
+				Title:     strings.Repeat("ab", 40),
+				Status:    todo.Status_DONE,
+				DueDate:   dueDate,
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			},
+			expected: "BEGIN:VCALENDAR\r\n" +
+				"VERSION:2.0\r\n" +
+				"PRODID:-//Symbiont//TodoApp//EN\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:123e4567-e89b-12d3-a456-426614174000\r\n" +
+				"DTSTAMP:20260302T103000Z\r\n" +
+				"CREATED:20260301T090000Z\r\n" +
+				"LAST-MODIFIED:20260302T103000Z\r\n" +
+				"SUMMARY:" + strings.Repeat("ab", 33) + "a\r\n" +
+				" b" + strings.Repeat("ab", 6) + "\r\n" +
+				"DUE;VALUE=DATE:20260305\r\n" +
+				"STATUS:COMPLETED\r\n" +
+				"COMPLETED:20260302T103000Z\r\n" +
+				"END:VTODO\r\n" +
+				"END:VCALENDAR\r\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := encodeTodo(tt.todo)
+			assert.Equal(t, tt.expected, got)
+
+			decoded, err := decodeTodo(got)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.todo.Title, *decoded.Summary)
+			assert.Equal(t, tt.todo.Status, *decoded.Status)
+			assert.Equal(t, tt.todo.DueDate, *decoded.DueDate)
+		})
+	}
+}
+
+func TestEncodeFocusBlocks(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	blocks := []todo.FocusBlock{
+		{
+			ID:        uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+			TodoID:    todoID,
+			Title:     "Write report",
+			Start:     start,
+			End:       start.Add(time.Hour),
+			Status:    todo.FocusBlockStatus_ACCEPTED,
+			CreatedAt: time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:     uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"),
+			TodoID: todoID,
+			Title:  "Write report",
+			Start:  start.Add(time.Hour),
+			End:    start.Add(2 * time.Hour),
+			Status: todo.FocusBlockStatus_SUGGESTED,
+		},
+	}
+
+	expected := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Symbiont//TodoApp//EN\r\n" +
+		"METHOD:PUBLISH\r\n" +
+		"X-WR-CALNAME:Focus blocks\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:223e4567-e89b-12d3-a456-426614174000\r\n" +
+		"DTSTAMP:20260301T180000Z\r\n" +
+		"DTSTART:20260302T090000Z\r\n" +
+		"DTEND:20260302T100000Z\r\n" +
+		"SUMMARY:Focus: Write report\r\n" +
+		"RELATED-TO:123e4567-e89b-12d3-a456-426614174000\r\n" +
+		"STATUS:CONFIRMED\r\n" +
+		"TRANSP:OPAQUE\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:323e4567-e89b-12d3-a456-426614174000\r\n" +
+		"DTSTAMP:20260302T080000Z\r\n" +
+		"DTSTART:20260302T100000Z\r\n" +
+		"DTEND:20260302T110000Z\r\n" +
+		"SUMMARY:Focus: Write report\r\n" +
+		"RELATED-TO:123e4567-e89b-12d3-a456-426614174000\r\n" +
+		"STATUS:TENTATIVE\r\n" +
+		"TRANSP:OPAQUE\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	assert.Equal(t, expected, encodeFocusBlocks(blocks, now))
+}
+
+func TestDecodeTodo(t *testing.T) {
+	t.Parallel()
+
+	open := todo.Status_OPEN
+	done := todo.Status_DONE
+
+	tests := map[string]struct {
+		data        string
+		expected    vtodo
+		expectedErr error
+	}{
+		"apple-reminders": {
+			data: "BEGIN:VCALENDAR\r\n" +
+				"VERSION:2.0\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:9C1A\r\n" +
+				"SUMMARY:Call the\r\n" +
+				"  plumber\r\n" +
+				"DUE;TZID=America/Sao_Paulo:20260305T230000\r\n" +
+				"STATUS:NEEDS-ACTION\r\n" +
+				"BEGIN:VALARM\r\n" +
+				"ACTION:DISPLAY\r\n" +
+				"DESCRIPTION:Reminder\r\n" +
+				"STATUS:COMPLETED\r\n" +
+				"END:VALARM\r\n" +
+				"END:VTODO\r\n" +
+				"END:VCALENDAR\r\n",
+			expected: vtodo{
+				Summary: common.Ptr("Call the plumber"),
+				Status:  &open,
+				DueDate: common.Ptr(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		"completed-utc-due": {
+			data: "BEGIN:VCALENDAR\n" +
+				"BEGIN:VTODO\n" +
+				"SUMMARY:Line one\\nLine two\n" +
+				"DUE:20260305T230000Z\n" +
+				"COMPLETED:20260306T080000Z\n" +
+				"END:VTODO\n" +
+				"END:VCALENDAR\n",
+			expected: vtodo{
+				Summary: common.Ptr("Line one\nLine two"),
+				Status:  &done,
+				DueDate: common.Ptr(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		"only-first-vtodo": {
+			data: "BEGIN:VCALENDAR\n" +
+				"BEGIN:VTODO\n" +
+				"SUMMARY:First\n" +
+				"END:VTODO\n" +
+				"BEGIN:VTODO\n" +
+				"SUMMARY:Second\n" +
+				"STATUS:COMPLETED\n" +
+				"END:VTODO\n" +
+				"END:VCALENDAR\n",
+			expected: vtodo{Summary: common.Ptr("First")},
+		},
+		"no-vtodo": {
+			data: "BEGIN:VCALENDAR\n" +
+				"BEGIN:VEVENT\n" +
+				"SUMMARY:Meeting\n" +
+				"END:VEVENT\n" +
+				"END:VCALENDAR\n",
+			expectedErr: core.NewValidationErr("calendar object must contain a VTODO component"),
+		},
+		"invalid-due": {
+			data: "BEGIN:VCALENDAR\n" +
+				"BEGIN:VTODO\n" +
+				"DUE;VALUE=DATE:tomorrow\n" +
+				"END:VTODO\n" +
+				"END:VCALENDAR\n",
+			expectedErr: core.NewValidationErr(`invalid DUE value "tomorrow"`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := decodeTodo(tt.data)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
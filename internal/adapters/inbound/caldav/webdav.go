@@ -0,0 +1,197 @@
+package caldav
+
+import (
+	"cmp"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	nsDAV            = "DAV:"
+	nsCalDAV         = "urn:ietf:params:xml:ns:caldav"
+	nsCalendarServer = "http://calendarserver.org/ns/"
+
+	// privilegesReadWrite is the current-user-privilege-set of the calendar and its objects.
+	privilegesReadWrite = `<privilege xmlns="DAV:"><read/></privilege><privilege xmlns="DAV:"><write/></privilege>` +
+		`<privilege xmlns="DAV:"><write-content/></privilege><privilege xmlns="DAV:"><bind/></privilege>` +
+		`<privilege xmlns="DAV:"><unbind/></privilege>`
+	// supportedReports is the supported-report-set of the calendar.
+	supportedReports = `<supported-report xmlns="DAV:"><report><calendar-query xmlns="urn:ietf:params:xml:ns:caldav"/></report></supported-report>` +
+		`<supported-report xmlns="DAV:"><report><calendar-multiget xmlns="urn:ietf:params:xml:ns:caldav"/></report></supported-report>`
+)
+
+var (
+	propResourceType          = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName           = xml.Name{Space: nsDAV, Local: "displayname"}
+	propCurrentUserPrincipal  = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL          = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propCurrentUserPrivileges = xml.Name{Space: nsDAV, Local: "current-user-privilege-set"}
+	propSupportedReportSet    = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propGetETag               = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType        = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propGetLastModified       = xml.Name{Space: nsDAV, Local: "getlastmodified"}
+	propCalendarHomeSet       = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propSupportedComponentSet = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propCalendarData          = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propGetCTag               = xml.Name{Space: nsCalendarServer, Local: "getctag"}
+	reportCalendarQuery       = xml.Name{Space: nsCalDAV, Local: "calendar-query"}
+	reportCalendarMultiget    = xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}
+)
+
+// propNames is the list of properties named in a DAV:prop request element.
+type propNames struct {
+	Names []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+// propfindRequest is the body of a PROPFIND request.
+type propfindRequest struct {
+	XMLName xml.Name   `xml:"DAV: propfind"`
+	AllProp *struct{}  `xml:"DAV: allprop"`
+	Prop    *propNames `xml:"DAV: prop"`
+}
+
+// compFilter is a CalDAV comp-filter, only evaluated on component names.
+type compFilter struct {
+	Name    string       `xml:"name,attr"`
+	Filters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+// reportRequest is the body of a calendar-query or calendar-multiget REPORT request.
+type reportRequest struct {
+	XMLName xml.Name
+	AllProp *struct{}  `xml:"DAV: allprop"`
+	Prop    *propNames `xml:"DAV: prop"`
+	Hrefs   []string   `xml:"DAV: href"`
+	Filter  *struct {
+		Filters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+// matchesTodos reports whether the calendar-query filter selects VTODO components.
+// Property and time-range filters are not evaluated, so clients receive every todo and filter locally.
+func (r reportRequest) matchesTodos() bool {
+	if r.Filter == nil {
+		return true
+	}
+	for _, calendar := range r.Filter.Filters {
+		if !strings.EqualFold(calendar.Name, "VCALENDAR") {
+			continue
+		}
+		if len(calendar.Filters) == 0 {
+			return true
+		}
+		for _, component := range calendar.Filters {
+			if strings.EqualFold(component.Name, "VTODO") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requested returns the property names a PROPFIND or REPORT asked for, or nil for all properties.
+func requested(allProp *struct{}, prop *propNames) []xml.Name {
+	if allProp != nil || prop == nil {
+		return nil
+	}
+	names := make([]xml.Name, 0, len(prop.Names))
+	for _, n := range prop.Names {
+		names = append(names, n.XMLName)
+	}
+	return names
+}
+
+// property is a property element with its already encoded content.
+type property struct {
+	XMLName  xml.Name
+	InnerXML string `xml:",innerxml"`
+}
+
+// propList is the DAV:prop element of a propstat.
+type propList struct {
+	Props []property `xml:",any"`
+}
+
+// propstat groups the properties of a resource sharing one status.
+type propstat struct {
+	Prop   propList `xml:"DAV: prop"`
+	Status string   `xml:"DAV: status"`
+}
+
+// response is the result of one resource in a multistatus response.
+type response struct {
+	Href      string     `xml:"DAV: href"`
+	Propstats []propstat `xml:"DAV: propstat,omitempty"`
+	Status    string     `xml:"DAV: status,omitempty"`
+}
+
+// multistatus is a 207 Multi-Status response body.
+type multistatus struct {
+	XMLName   xml.Name   `xml:"DAV: multistatus"`
+	Responses []response `xml:"DAV: response"`
+}
+
+// resource is a WebDAV resource with the values of its properties.
+type resource struct {
+	href  string
+	props map[xml.Name]string
+	// hidden holds properties returned only when requested by name, like calendar-data.
+	hidden map[xml.Name]bool
+}
+
+// response returns the multistatus entry of the resource for the requested properties, or for all of them when names is nil.
+// Properties the resource does not have are reported with a 404 status.
+func (res resource) response(names []xml.Name) response {
+	found := propstat{Status: statusLine(http.StatusOK)}
+	missing := propstat{Status: statusLine(http.StatusNotFound)}
+
+	if names == nil {
+		for name := range res.props {
+			if !res.hidden[name] {
+				names = append(names, name)
+			}
+		}
+		slices.SortFunc(names, func(a, b xml.Name) int {
+			return cmp.Or(cmp.Compare(a.Space, b.Space), cmp.Compare(a.Local, b.Local))
+		})
+	}
+	for _, name := range names {
+		value, ok := res.props[name]
+		if !ok {
+			missing.Prop.Props = append(missing.Prop.Props, property{XMLName: name})
+			continue
+		}
+		found.Prop.Props = append(found.Prop.Props, property{XMLName: name, InnerXML: value})
+	}
+
+	resp := response{Href: res.href}
+	if len(found.Prop.Props) > 0 {
+		resp.Propstats = append(resp.Propstats, found)
+	}
+	if len(missing.Prop.Props) > 0 {
+		resp.Propstats = append(resp.Propstats, missing)
+	}
+	return resp
+}
+
+// statusLine formats a status for a multistatus response.
+func statusLine(code int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", code, http.StatusText(code))
+}
+
+// escapeXML escapes a text value for use as property content.
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// href encodes a DAV:href element.
+func href(path string) string {
+	return `<href xmlns="DAV:">` + escapeXML(path) + `</href>`
+}
@@ -157,6 +157,22 @@ func (c *Client) ListTodos(ctx context.Context, status *gen.TodoStatus, page int
 	return resp.Data["listTodos"], nil
 }
 
+// ApplyTodoChanges applies a batch of create, update and delete operations atomically.
+func (c *Client) ApplyTodoChanges(ctx context.Context, operations []gen.TodoOperationInput) (*gen.ApplyTodoChangesResult, error) {
+	req := request{
+		Query:     applyTodoChangesMutation,
+		Variables: map[string]any{"operations": operations},
+		url:       c.url,
+	}
+
+	resp, err := makeRequest[*gen.ApplyTodoChangesResult](ctx, c.httpClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data["applyTodoChanges"], nil
+}
+
 // makeRequest sends a GraphQL request and decodes the response.
 func makeRequest[T any](ctx context.Context, httpClient *http.Client, req request) (response[T], error) {
 	if httpClient == nil {
@@ -198,3 +214,14 @@ query ListTodos($status: TodoStatus, $page: Int, $pageSize: Int) {
     page nextPage previousPage
   }
 }`
+
+var applyTodoChangesMutation = `
+mutation ApplyTodoChanges($operations: [TodoOperationInput!]!) {
+  applyTodoChanges(operations: $operations) {
+    applied
+    results {
+      index operation status id error
+      todo { id title status due_date created_at updated_at }
+    }
+  }
+}`
@@ -209,6 +209,66 @@ func TestClient_ListTodos(t *testing.T) {
 	}
 }
 
+func TestClient_ApplyTodoChanges(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	operations := []gen.TodoOperationInput{{Operation: gen.TodoOperationKindDelete, ID: &id}}
+	result := &gen.ApplyTodoChangesResult{
+		Applied: true,
+		Results: []*gen.TodoOperationResult{
+			{Index: 0, Operation: gen.TodoOperationKindDelete, Status: gen.TodoOperationStatusApplied, ID: &id},
+		},
+	}
+	tests := map[string]struct {
+		mockHandler func(*http.Request) *http.Response
+		expectErr   bool
+	}{
+		"success": {
+			mockHandler: func(req *http.Request) *http.Response {
+				resp := response[*gen.ApplyTodoChangesResult]{
+					Data: map[string]*gen.ApplyTodoChangesResult{"applyTodoChanges": result},
+				}
+				b, _ := json.Marshal(resp)
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioNopCloser(b),
+					Header:     make(http.Header),
+				}
+			},
+			expectErr: false,
+		},
+		"error-response": {
+			mockHandler: func(req *http.Request) *http.Response {
+				resp := response[*gen.ApplyTodoChangesResult]{
+					Errors: []gqlError{{Message: "fail"}},
+				}
+				b, _ := json.Marshal(resp)
+				return &http.Response{
+					StatusCode: 400,
+					Body:       ioNopCloser(b),
+					Header:     make(http.Header),
+				}
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := NewClientWithHTTPClient("http://fake", testHTTPClient(tt.mockHandler))
+			out, err := client.ApplyTodoChanges(t.Context(), operations)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Nil(t, out)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, result, out)
+			}
+		})
+	}
+}
+
 // ioNopCloser returns a ReadCloser from bytes.
 func ioNopCloser(b []byte) *nopCloser {
 	return &nopCloser{data: b}
@@ -13,6 +13,59 @@ import (
 	"github.com/google/uuid"
 )
 
+type ApplyTodoChangesResult struct {
+	Applied bool                   `json:"applied"`
+	Results []*TodoOperationResult `json:"results"`
+}
+
+type Comment struct {
+	ID        uuid.UUID     `json:"id"`
+	TodoID    uuid.UUID     `json:"todo_id"`
+	Author    CommentAuthor `json:"author"`
+	Body      string        `json:"body"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+type CommentPage struct {
+	Items        []*Comment `json:"items"`
+	Page         int        `json:"page"`
+	NextPage     *int       `json:"nextPage,omitempty"`
+	PreviousPage *int       `json:"previousPage,omitempty"`
+}
+
+type Conversation struct {
+	ID        uuid.UUID             `json:"id"`
+	Title     string                `json:"title"`
+	Settings  *ConversationSettings `json:"settings"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+type ConversationSettings struct {
+	Temperature *float64 `json:"temperature,omitempty"`
+	TopP        *float64 `json:"top_p,omitempty"`
+	Model       *string  `json:"model,omitempty"`
+}
+
+type ConversationSettingsInput struct {
+	Temperature *float64 `json:"temperature,omitempty"`
+	TopP        *float64 `json:"top_p,omitempty"`
+	Model       *string  `json:"model,omitempty"`
+}
+
+type CustomField struct {
+	Name      string          `json:"name"`
+	Type      CustomFieldType `json:"type"`
+	Options   []string        `json:"options"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type CustomFieldFilter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 type DateRange struct {
 	DueAfter  types.Date `json:"DueAfter"`
 	DueBefore types.Date `json:"DueBefore"`
@@ -25,12 +78,30 @@ type Query struct {
 }
 
 type Todo struct {
-	ID        uuid.UUID  `json:"id"`
-	Title     string     `json:"title"`
-	Status    TodoStatus `json:"status"`
-	DueDate   types.Date `json:"due_date"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	ID           uuid.UUID      `json:"id"`
+	Title        string         `json:"title"`
+	Status       TodoStatus     `json:"status"`
+	DueDate      types.Date     `json:"due_date"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+}
+
+type TodoOperationInput struct {
+	Operation TodoOperationKind `json:"operation"`
+	ID        *uuid.UUID        `json:"id,omitempty"`
+	Title     *string           `json:"title,omitempty"`
+	Status    *TodoStatus       `json:"status,omitempty"`
+	DueDate   *types.Date       `json:"due_date,omitempty"`
+}
+
+type TodoOperationResult struct {
+	Index     int                 `json:"index"`
+	Operation TodoOperationKind   `json:"operation"`
+	Status    TodoOperationStatus `json:"status"`
+	ID        *uuid.UUID          `json:"id,omitempty"`
+	Todo      *Todo               `json:"todo,omitempty"`
+	Error     *string             `json:"error,omitempty"`
 }
 
 type TodoPage struct {
@@ -40,11 +111,161 @@ type TodoPage struct {
 	PreviousPage *int    `json:"previousPage,omitempty"`
 }
 
+type View struct {
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	BuiltIn   bool        `json:"built_in"`
+	Filter    *ViewFilter `json:"filter"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+type ViewFilter struct {
+	Status             *TodoStatus `json:"status,omitempty"`
+	SearchBySimilarity *string     `json:"search_by_similarity,omitempty"`
+	SearchByTitle      *string     `json:"search_by_title,omitempty"`
+	SortBy             *TodoSortBy `json:"sort_by,omitempty"`
+	DueAfter           *types.Date `json:"due_after,omitempty"`
+	DueBefore          *types.Date `json:"due_before,omitempty"`
+	DueFromDays        *int        `json:"due_from_days,omitempty"`
+	DueToDays          *int        `json:"due_to_days,omitempty"`
+	Query              *string     `json:"query,omitempty"`
+}
+
+type ViewFilterInput struct {
+	Status             *TodoStatus `json:"status,omitempty"`
+	SearchBySimilarity *string     `json:"search_by_similarity,omitempty"`
+	SearchByTitle      *string     `json:"search_by_title,omitempty"`
+	SortBy             *TodoSortBy `json:"sort_by,omitempty"`
+	DueAfter           *types.Date `json:"due_after,omitempty"`
+	DueBefore          *types.Date `json:"due_before,omitempty"`
+	DueFromDays        *int        `json:"due_from_days,omitempty"`
+	DueToDays          *int        `json:"due_to_days,omitempty"`
+	Query              *string     `json:"query,omitempty"`
+}
+
 type UpdateTodoParams struct {
-	ID      uuid.UUID   `json:"id"`
-	Title   *string     `json:"title,omitempty"`
-	Status  *TodoStatus `json:"status,omitempty"`
-	DueDate *types.Date `json:"due_date,omitempty"`
+	ID           uuid.UUID      `json:"id"`
+	Title        *string        `json:"title,omitempty"`
+	Status       *TodoStatus    `json:"status,omitempty"`
+	DueDate      *types.Date    `json:"due_date,omitempty"`
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+}
+
+type CommentAuthor string
+
+const (
+	CommentAuthorUser      CommentAuthor = "USER"
+	CommentAuthorAssistant CommentAuthor = "ASSISTANT"
+)
+
+var AllCommentAuthor = []CommentAuthor{
+	CommentAuthorUser,
+	CommentAuthorAssistant,
+}
+
+func (e CommentAuthor) IsValid() bool {
+	switch e {
+	case CommentAuthorUser, CommentAuthorAssistant:
+		return true
+	}
+	return false
+}
+
+func (e CommentAuthor) String() string {
+	return string(e)
+}
+
+func (e *CommentAuthor) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CommentAuthor(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CommentAuthor", str)
+	}
+	return nil
+}
+
+func (e CommentAuthor) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CommentAuthor) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CommentAuthor) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText    CustomFieldType = "TEXT"
+	CustomFieldTypeNumber  CustomFieldType = "NUMBER"
+	CustomFieldTypeBoolean CustomFieldType = "BOOLEAN"
+	CustomFieldTypeDate    CustomFieldType = "DATE"
+	CustomFieldTypeEnum    CustomFieldType = "ENUM"
+)
+
+var AllCustomFieldType = []CustomFieldType{
+	CustomFieldTypeText,
+	CustomFieldTypeNumber,
+	CustomFieldTypeBoolean,
+	CustomFieldTypeDate,
+	CustomFieldTypeEnum,
+}
+
+func (e CustomFieldType) IsValid() bool {
+	switch e {
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeBoolean, CustomFieldTypeDate, CustomFieldTypeEnum:
+		return true
+	}
+	return false
+}
+
+func (e CustomFieldType) String() string {
+	return string(e)
+}
+
+func (e *CustomFieldType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CustomFieldType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CustomFieldType", str)
+	}
+	return nil
+}
+
+func (e CustomFieldType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CustomFieldType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CustomFieldType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
 
 type SearchType string
@@ -102,6 +323,120 @@ func (e SearchType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type TodoOperationKind string
+
+const (
+	TodoOperationKindCreate TodoOperationKind = "CREATE"
+	TodoOperationKindUpdate TodoOperationKind = "UPDATE"
+	TodoOperationKindDelete TodoOperationKind = "DELETE"
+)
+
+var AllTodoOperationKind = []TodoOperationKind{
+	TodoOperationKindCreate,
+	TodoOperationKindUpdate,
+	TodoOperationKindDelete,
+}
+
+func (e TodoOperationKind) IsValid() bool {
+	switch e {
+	case TodoOperationKindCreate, TodoOperationKindUpdate, TodoOperationKindDelete:
+		return true
+	}
+	return false
+}
+
+func (e TodoOperationKind) String() string {
+	return string(e)
+}
+
+func (e *TodoOperationKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoOperationKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoOperationKind", str)
+	}
+	return nil
+}
+
+func (e TodoOperationKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TodoOperationKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TodoOperationKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TodoOperationStatus string
+
+const (
+	TodoOperationStatusApplied    TodoOperationStatus = "APPLIED"
+	TodoOperationStatusFailed     TodoOperationStatus = "FAILED"
+	TodoOperationStatusRolledBack TodoOperationStatus = "ROLLED_BACK"
+)
+
+var AllTodoOperationStatus = []TodoOperationStatus{
+	TodoOperationStatusApplied,
+	TodoOperationStatusFailed,
+	TodoOperationStatusRolledBack,
+}
+
+func (e TodoOperationStatus) IsValid() bool {
+	switch e {
+	case TodoOperationStatusApplied, TodoOperationStatusFailed, TodoOperationStatusRolledBack:
+		return true
+	}
+	return false
+}
+
+func (e TodoOperationStatus) String() string {
+	return string(e)
+}
+
+func (e *TodoOperationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoOperationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoOperationStatus", str)
+	}
+	return nil
+}
+
+func (e TodoOperationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TodoOperationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TodoOperationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type TodoSortBy string
 
 const (
@@ -111,6 +446,7 @@ const (
 	TodoSortByDueDateDesc    TodoSortBy = "dueDateDesc"
 	TodoSortBySimilarityAsc  TodoSortBy = "similarityAsc"
 	TodoSortBySimilarityDesc TodoSortBy = "similarityDesc"
+	TodoSortBySmartOrder     TodoSortBy = "smartOrder"
 )
 
 var AllTodoSortBy = []TodoSortBy{
@@ -120,11 +456,12 @@ var AllTodoSortBy = []TodoSortBy{
 	TodoSortByDueDateDesc,
 	TodoSortBySimilarityAsc,
 	TodoSortBySimilarityDesc,
+	TodoSortBySmartOrder,
 }
 
 func (e TodoSortBy) IsValid() bool {
 	switch e {
-	case TodoSortByCreatedAtAsc, TodoSortByCreatedAtDesc, TodoSortByDueDateAsc, TodoSortByDueDateDesc, TodoSortBySimilarityAsc, TodoSortBySimilarityDesc:
+	case TodoSortByCreatedAtAsc, TodoSortByCreatedAtDesc, TodoSortByDueDateAsc, TodoSortByDueDateDesc, TodoSortBySimilarityAsc, TodoSortBySimilarityDesc, TodoSortBySmartOrder:
 		return true
 	}
 	return false
@@ -2,12 +2,17 @@ package graphql
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/gen"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/types"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -17,26 +22,24 @@ func (s *TodoGraphQLServer) Mutation() gen.MutationResolver { return s }
 
 // UpdateTodo is the resolver for the updateTodo field.
 func (s *TodoGraphQLServer) UpdateTodo(ctx context.Context, params gen.UpdateTodoParams) (*gen.Todo, error) {
+	var opts []todouc.UpdateOption
+	if params.CustomFields != nil {
+		opts = append(opts, todouc.WithCustomFields(todo.CustomFieldValues(params.CustomFields)))
+	}
 	td, err := s.UpdateTodoUsecase.Execute(
 		ctx,
 		params.ID,
 		params.Title,
 		(*todo.Status)(params.Status),
 		(*time.Time)(params.DueDate),
+		opts...,
 	)
 	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
 		s.Logger.Printf("Error updating todo: %v", err)
 		return nil, err
 	}
 
-	return &gen.Todo{
-		ID:        td.ID,
-		Title:     td.Title,
-		Status:    gen.TodoStatus(td.Status),
-		DueDate:   (types.Date)(td.DueDate),
-		CreatedAt: td.CreatedAt,
-		UpdatedAt: td.UpdatedAt,
-	}, nil
+	return toTodo(td), nil
 }
 
 // DeleteTodo is the resolver for the deleteTodo field.
@@ -49,3 +52,269 @@ func (s *TodoGraphQLServer) DeleteTodo(ctx context.Context, id uuid.UUID) (bool,
 
 	return true, nil
 }
+
+// ApplyTodoChanges is the resolver for the applyTodoChanges field.
+func (s *TodoGraphQLServer) ApplyTodoChanges(ctx context.Context, operations []*gen.TodoOperationInput) (*gen.ApplyTodoChangesResult, error) {
+	ops := make([]todouc.TodoOperation, len(operations))
+	for i, op := range operations {
+		ops[i] = todouc.TodoOperation{
+			Kind:    todouc.TodoOperationKind(op.Operation),
+			ID:      op.ID,
+			Title:   op.Title,
+			Status:  (*todo.Status)(op.Status),
+			DueDate: (*time.Time)(op.DueDate),
+		}
+	}
+
+	results, err := s.ApplyChangesUsecase.Execute(ctx, ops)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error applying todo changes: %v", err)
+		return nil, err
+	}
+
+	resp := &gen.ApplyTodoChangesResult{
+		Applied: true,
+		Results: make([]*gen.TodoOperationResult, len(results)),
+	}
+	for i, r := range results {
+		result := &gen.TodoOperationResult{
+			Index:     i,
+			Operation: gen.TodoOperationKind(r.Kind),
+			Status:    gen.TodoOperationStatus(r.Status),
+		}
+		if r.ID != uuid.Nil {
+			result.ID = &r.ID
+		}
+		if r.Todo != nil {
+			result.Todo = toTodo(*r.Todo)
+		}
+		if r.Err != nil {
+			result.Error = common.Ptr(r.Err.Error())
+		}
+		if r.Status != todouc.TodoOperationStatus_APPLIED {
+			resp.Applied = false
+		}
+		resp.Results[i] = result
+	}
+
+	return resp, nil
+}
+
+// AddComment is the resolver for the addComment field.
+func (s *TodoGraphQLServer) AddComment(ctx context.Context, todoID uuid.UUID, body string) (*gen.Comment, error) {
+	comment, err := s.CommentsUsecase.Add(ctx, todoID, todo.CommentAuthor_User, body)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error adding comment: %v", err)
+		return nil, err
+	}
+
+	return toComment(comment), nil
+}
+
+// UpdateComment is the resolver for the updateComment field.
+func (s *TodoGraphQLServer) UpdateComment(ctx context.Context, todoID uuid.UUID, id uuid.UUID, body string) (*gen.Comment, error) {
+	comment, err := s.CommentsUsecase.Update(ctx, todoID, id, body)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error updating comment: %v", err)
+		return nil, err
+	}
+
+	return toComment(comment), nil
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (s *TodoGraphQLServer) DeleteComment(ctx context.Context, todoID uuid.UUID, id uuid.UUID) (bool, error) {
+	err := s.CommentsUsecase.Delete(ctx, todoID, id)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error deleting comment: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SaveView is the resolver for the saveView field.
+func (s *TodoGraphQLServer) SaveView(ctx context.Context, name string, filter gen.ViewFilterInput) (*gen.View, error) {
+	view, err := s.ViewsUsecase.Save(ctx, name, toViewFilter(filter))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error saving view: %v", err)
+		return nil, err
+	}
+
+	return toView(view), nil
+}
+
+// UpdateView is the resolver for the updateView field.
+func (s *TodoGraphQLServer) UpdateView(ctx context.Context, id uuid.UUID, name string, filter gen.ViewFilterInput) (*gen.View, error) {
+	view, err := s.ViewsUsecase.Update(ctx, id, name, toViewFilter(filter))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error updating view: %v", err)
+		return nil, err
+	}
+
+	return toView(view), nil
+}
+
+// DeleteView is the resolver for the deleteView field.
+func (s *TodoGraphQLServer) DeleteView(ctx context.Context, id uuid.UUID) (bool, error) {
+	err := s.ViewsUsecase.Delete(ctx, id)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error deleting view: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UpdateConversationSettings is the resolver for the updateConversationSettings field.
+func (s *TodoGraphQLServer) UpdateConversationSettings(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	settings gen.ConversationSettingsInput,
+) (*gen.Conversation, error) {
+	conversationSettings := toConversationSettings(settings)
+	conversation, err := s.UpdateConversationUsecase.Execute(ctx, conversationID, chat.ConversationUpdate{
+		Settings: &conversationSettings,
+	})
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error updating conversation settings: %v", err)
+		return nil, err
+	}
+
+	return toConversation(conversation), nil
+}
+
+// DefineCustomField is the resolver for the defineCustomField field.
+func (s *TodoGraphQLServer) DefineCustomField(ctx context.Context, name string, typeArg gen.CustomFieldType, options []string) (*gen.CustomField, error) {
+	field, err := s.CustomFieldsUsecase.Define(ctx, todo.CustomField{
+		Name:    name,
+		Type:    todo.CustomFieldType(typeArg),
+		Options: options,
+	})
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error defining custom field: %v", err)
+		return nil, err
+	}
+
+	return toCustomField(field), nil
+}
+
+// DeleteCustomField is the resolver for the deleteCustomField field.
+func (s *TodoGraphQLServer) DeleteCustomField(ctx context.Context, name string) (bool, error) {
+	err := s.CustomFieldsUsecase.Delete(ctx, name)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error deleting custom field: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// toView converts a domain view into its GraphQL representation.
+func toView(v todo.View) *gen.View {
+	view := &gen.View{
+		ID:      v.ID,
+		Name:    v.Name,
+		BuiltIn: v.BuiltIn,
+		Filter: &gen.ViewFilter{
+			Status:             (*gen.TodoStatus)(v.Filter.Status),
+			SearchBySimilarity: v.Filter.SearchBySimilarity,
+			SearchByTitle:      v.Filter.SearchByTitle,
+			SortBy:             (*gen.TodoSortBy)(v.Filter.SortBy),
+			DueAfter:           (*types.Date)(v.Filter.DueAfter),
+			DueBefore:          (*types.Date)(v.Filter.DueBefore),
+			DueFromDays:        v.Filter.DueFromDays,
+			DueToDays:          v.Filter.DueToDays,
+			Query:              v.Filter.Query,
+		},
+	}
+	if !v.BuiltIn {
+		view.CreatedAt = &v.CreatedAt
+		view.UpdatedAt = &v.UpdatedAt
+	}
+	return view
+}
+
+// toViewFilter converts a GraphQL view filter input into the domain filter.
+func toViewFilter(f gen.ViewFilterInput) todo.ViewFilter {
+	return todo.ViewFilter{
+		Status:             (*todo.Status)(f.Status),
+		SearchBySimilarity: f.SearchBySimilarity,
+		SearchByTitle:      f.SearchByTitle,
+		SortBy:             (*string)(f.SortBy),
+		DueAfter:           (*time.Time)(f.DueAfter),
+		DueBefore:          (*time.Time)(f.DueBefore),
+		DueFromDays:        f.DueFromDays,
+		DueToDays:          f.DueToDays,
+		Query:              f.Query,
+	}
+}
+
+// toConversationSettings converts GraphQL conversation settings input into the domain settings.
+func toConversationSettings(s gen.ConversationSettingsInput) assistant.ConversationSettings {
+	settings := assistant.ConversationSettings{
+		Temperature: s.Temperature,
+		TopP:        s.TopP,
+	}
+	if s.Model != nil {
+		settings.Model = *s.Model
+	}
+	return settings
+}
+
+// toConversation converts a domain conversation into its GraphQL representation.
+func toConversation(c assistant.Conversation) *gen.Conversation {
+	conversation := &gen.Conversation{
+		ID:    c.ID,
+		Title: c.Title,
+		Settings: &gen.ConversationSettings{
+			Temperature: c.Settings.Temperature,
+			TopP:        c.Settings.TopP,
+		},
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+	if c.Settings.Model != "" {
+		conversation.Settings.Model = &c.Settings.Model
+	}
+	return conversation
+}
+
+// toTodo converts a domain todo into its GraphQL representation.
+func toTodo(td todo.Todo) *gen.Todo {
+	return &gen.Todo{
+		ID:           td.ID,
+		Title:        td.Title,
+		Status:       gen.TodoStatus(td.Status),
+		DueDate:      (types.Date)(td.DueDate),
+		CreatedAt:    td.CreatedAt,
+		UpdatedAt:    td.UpdatedAt,
+		CustomFields: td.CustomFields,
+	}
+}
+
+// toCustomField converts a domain custom field into its GraphQL representation.
+func toCustomField(f todo.CustomField) *gen.CustomField {
+	options := f.Options
+	if options == nil {
+		options = []string{}
+	}
+	return &gen.CustomField{
+		Name:      f.Name,
+		Type:      gen.CustomFieldType(f.Type),
+		Options:   options,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+// toComment converts a domain comment into its GraphQL representation.
+func toComment(c todo.Comment) *gen.Comment {
+	return &gen.Comment{
+		ID:        c.ID,
+		TodoID:    c.TodoID,
+		Author:    gen.CommentAuthor(strings.ToUpper(string(c.Author))),
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
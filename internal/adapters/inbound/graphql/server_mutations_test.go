@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"context"
 	"errors"
 	"io"
 	"log"
@@ -9,7 +10,11 @@ import (
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/gen"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/types"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +68,26 @@ func TestTodoGraphQLServer_UpdateTodo(t *testing.T) {
 			expected:    &testGenTodo,
 			expectError: false,
 		},
+		"success-with-custom-fields": {
+			params: gen.UpdateTodoParams{
+				ID:           testID,
+				CustomFields: map[string]any{"area": "work"},
+			},
+			setupUsecases: func(m *todouc.MockUpdate) {
+				m.EXPECT().
+					Execute(mock.Anything, testID, (*string)(nil), (*todo.Status)(nil), (*time.Time)(nil), mock.Anything).
+					Run(func(_ context.Context, _ uuid.UUID, _ *string, _ *todo.Status, _ *time.Time, opts ...todouc.UpdateOption) {
+						var params todouc.UpdateParams
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, todo.CustomFieldValues{"area": "work"}, params.CustomFields)
+					}).
+					Return(testTodo, nil)
+			},
+			expected:    &testGenTodo,
+			expectError: false,
+		},
 		"error": {
 			params: gen.UpdateTodoParams{
 				ID: testID,
@@ -142,3 +167,554 @@ func TestTodoGraphQLServer_DeleteTodo(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoGraphQLServer_ApplyTodoChanges(t *testing.T) {
+	t.Parallel()
+
+	deletedID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	operations := []*gen.TodoOperationInput{
+		{Operation: gen.TodoOperationKindCreate, Title: &testTitle, DueDate: (*types.Date)(&testNow)},
+		{Operation: gen.TodoOperationKindDelete, ID: &deletedID},
+	}
+	expectedOps := []todouc.TodoOperation{
+		{Kind: todouc.TodoOperationKind_CREATE, Title: &testTitle, DueDate: &testNow},
+		{Kind: todouc.TodoOperationKind_DELETE, ID: &deletedID},
+	}
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockApplyChanges)
+		expected      *gen.ApplyTodoChangesResult
+		expectError   bool
+	}{
+		"applied": {
+			setupUsecases: func(m *todouc.MockApplyChanges) {
+				m.EXPECT().
+					Execute(mock.Anything, expectedOps).
+					Return([]todouc.TodoOperationResult{
+						{Kind: todouc.TodoOperationKind_CREATE, Status: todouc.TodoOperationStatus_APPLIED, ID: testID, Todo: &testTodo},
+						{Kind: todouc.TodoOperationKind_DELETE, Status: todouc.TodoOperationStatus_APPLIED, ID: deletedID},
+					}, nil)
+			},
+			expected: &gen.ApplyTodoChangesResult{
+				Applied: true,
+				Results: []*gen.TodoOperationResult{
+					{Index: 0, Operation: gen.TodoOperationKindCreate, Status: gen.TodoOperationStatusApplied, ID: &testID, Todo: &testGenTodo},
+					{Index: 1, Operation: gen.TodoOperationKindDelete, Status: gen.TodoOperationStatusApplied, ID: &deletedID},
+				},
+			},
+		},
+		"rolled-back": {
+			setupUsecases: func(m *todouc.MockApplyChanges) {
+				m.EXPECT().
+					Execute(mock.Anything, expectedOps).
+					Return([]todouc.TodoOperationResult{
+						{Kind: todouc.TodoOperationKind_CREATE, Status: todouc.TodoOperationStatus_ROLLED_BACK},
+						{Kind: todouc.TodoOperationKind_DELETE, Status: todouc.TodoOperationStatus_FAILED, ID: deletedID, Err: core.NewNotFoundErr("todo not found")},
+					}, nil)
+			},
+			expected: &gen.ApplyTodoChangesResult{
+				Applied: false,
+				Results: []*gen.TodoOperationResult{
+					{Index: 0, Operation: gen.TodoOperationKindCreate, Status: gen.TodoOperationStatusRolledBack},
+					{Index: 1, Operation: gen.TodoOperationKindDelete, Status: gen.TodoOperationStatusFailed, ID: &deletedID, Error: common.Ptr("todo not found")},
+				},
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockApplyChanges) {
+				m.EXPECT().Execute(mock.Anything, expectedOps).Return(nil, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockApplyChanges(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				ApplyChangesUsecase: mockUC,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.ApplyTodoChanges(t.Context(), operations)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_AddComment(t *testing.T) {
+	t.Parallel()
+
+	commentID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockComments)
+		expected      *gen.Comment
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Add(mock.Anything, testID, todo.CommentAuthor_User, "Waiting on the vendor").
+					Return(todo.Comment{
+						ID:        commentID,
+						TodoID:    testID,
+						Author:    todo.CommentAuthor_User,
+						Body:      "Waiting on the vendor",
+						CreatedAt: testNow,
+						UpdatedAt: testNow,
+					}, nil)
+			},
+			expected: &gen.Comment{
+				ID:        commentID,
+				TodoID:    testID,
+				Author:    gen.CommentAuthorUser,
+				Body:      "Waiting on the vendor",
+				CreatedAt: testNow,
+				UpdatedAt: testNow,
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Add(mock.Anything, testID, todo.CommentAuthor_User, "Waiting on the vendor").
+					Return(todo.Comment{}, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockComments(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CommentsUsecase: mockUC,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.AddComment(t.Context(), testID, "Waiting on the vendor")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_UpdateComment(t *testing.T) {
+	t.Parallel()
+
+	commentID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockComments)
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Update(mock.Anything, testID, commentID, "Vendor replied").
+					Return(todo.Comment{ID: commentID, TodoID: testID, Author: todo.CommentAuthor_User, Body: "Vendor replied"}, nil)
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Update(mock.Anything, testID, commentID, "Vendor replied").
+					Return(todo.Comment{}, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockComments(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CommentsUsecase: mockUC,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.UpdateComment(t.Context(), testID, commentID, "Vendor replied")
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Vendor replied", got.Body)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	commentID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockComments)
+		expect        bool
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().Delete(mock.Anything, testID, commentID).Return(nil)
+			},
+			expect: true,
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().Delete(mock.Anything, testID, commentID).Return(errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockComments(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CommentsUsecase: mockUC,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.DeleteComment(t.Context(), testID, commentID)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expect, got)
+		})
+	}
+}
+
+func TestTodoGraphQLServer_SaveView(t *testing.T) {
+	t.Parallel()
+
+	viewID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	dueAfter := types.Date(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	dueBefore := types.Date(time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC))
+	status := gen.TodoStatusOpen
+	input := gen.ViewFilterInput{Status: &status, DueAfter: &dueAfter, DueBefore: &dueBefore}
+	filter := todo.ViewFilter{
+		Status:    (*todo.Status)(&status),
+		DueAfter:  (*time.Time)(&dueAfter),
+		DueBefore: (*time.Time)(&dueBefore),
+	}
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockViews)
+		expected      *gen.View
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Save(mock.Anything, "Work focus", filter).
+					Return(todo.View{ID: viewID, Name: "Work focus", Filter: filter, CreatedAt: testNow, UpdatedAt: testNow}, nil)
+			},
+			expected: &gen.View{
+				ID:        viewID,
+				Name:      "Work focus",
+				Filter:    &gen.ViewFilter{Status: &status, DueAfter: &dueAfter, DueBefore: &dueBefore},
+				CreatedAt: &testNow,
+				UpdatedAt: &testNow,
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Save(mock.Anything, "Work focus", filter).
+					Return(todo.View{}, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockViews(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				ViewsUsecase: mockUC,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.SaveView(t.Context(), "Work focus", input)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_UpdateView(t *testing.T) {
+	t.Parallel()
+
+	viewID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	input := gen.ViewFilterInput{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)}
+	filter := todo.ViewFilter{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)}
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockViews)
+		expected      *gen.View
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Update(mock.Anything, viewID, "Next week", filter).
+					Return(todo.View{ID: viewID, Name: "Next week", Filter: filter, CreatedAt: testNow, UpdatedAt: testNow}, nil)
+			},
+			expected: &gen.View{
+				ID:        viewID,
+				Name:      "Next week",
+				Filter:    &gen.ViewFilter{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)},
+				CreatedAt: &testNow,
+				UpdatedAt: &testNow,
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Update(mock.Anything, viewID, "Next week", filter).
+					Return(todo.View{}, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockViews(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				ViewsUsecase: mockUC,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.UpdateView(t.Context(), viewID, "Next week", input)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_DeleteView(t *testing.T) {
+	t.Parallel()
+
+	viewID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockViews)
+		expected      bool
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().Delete(mock.Anything, viewID).Return(nil)
+			},
+			expected: true,
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().Delete(mock.Anything, viewID).Return(errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockViews(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				ViewsUsecase: mockUC,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.DeleteView(t.Context(), viewID)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGraphQLServer_DefineCustomField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockCustomFields)
+		expected      *gen.CustomField
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().
+					Define(mock.Anything, todo.CustomField{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}}).
+					Return(todo.CustomField{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}, CreatedAt: testNow}, nil)
+			},
+			expected: &gen.CustomField{
+				Name:      "area",
+				Type:      gen.CustomFieldTypeEnum,
+				Options:   []string{"home", "work"},
+				CreatedAt: testNow,
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().
+					Define(mock.Anything, mock.Anything).
+					Return(todo.CustomField{}, core.NewValidationErr("invalid"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockCustomFields(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CustomFieldsUsecase: mockUC,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.DefineCustomField(t.Context(), "area", gen.CustomFieldTypeEnum, []string{"home", "work"})
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGraphQLServer_DeleteCustomField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockCustomFields)
+		expected      bool
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().Delete(mock.Anything, "area").Return(nil)
+			},
+			expected: true,
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().Delete(mock.Anything, "area").Return(errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockCustomFields(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CustomFieldsUsecase: mockUC,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.DeleteCustomField(t.Context(), "area")
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGraphQLServer_UpdateConversationSettings(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	input := gen.ConversationSettingsInput{Temperature: common.Ptr(0.9), Model: common.Ptr("ai/qwen3")}
+	settings := assistant.ConversationSettings{Temperature: common.Ptr(0.9), Model: "ai/qwen3"}
+
+	tests := map[string]struct {
+		setupUsecases func(*chat.MockUpdateConversation)
+		expected      *gen.Conversation
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *chat.MockUpdateConversation) {
+				m.EXPECT().
+					Execute(mock.Anything, conversationID, chat.ConversationUpdate{Settings: &settings}).
+					Return(assistant.Conversation{
+						ID:        conversationID,
+						Title:     "Trip planning",
+						Settings:  settings,
+						CreatedAt: testNow,
+						UpdatedAt: testNow,
+					}, nil)
+			},
+			expected: &gen.Conversation{
+				ID:        conversationID,
+				Title:     "Trip planning",
+				Settings:  &gen.ConversationSettings{Temperature: common.Ptr(0.9), Model: common.Ptr("ai/qwen3")},
+				CreatedAt: testNow,
+				UpdatedAt: testNow,
+			},
+		},
+		"validation-error": {
+			setupUsecases: func(m *chat.MockUpdateConversation) {
+				m.EXPECT().
+					Execute(mock.Anything, conversationID, chat.ConversationUpdate{Settings: &settings}).
+					Return(assistant.Conversation{}, core.NewValidationErr("model ai/qwen3 is not available"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := chat.NewMockUpdateConversation(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				UpdateConversationUsecase: mockUC,
+				Logger:                    log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.UpdateConversationSettings(t.Context(), conversationID, input)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -5,16 +5,16 @@ import (
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/gen"
-	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/types"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 )
 
 // ListTodos is the resolver for the listTodos field.
-func (s *TodoGraphQLServer) ListTodos(ctx context.Context, page int, pageSize int, status *gen.TodoStatus, search *string, searchType *gen.SearchType, dateRange *gen.DateRange, sortBy *gen.TodoSortBy) (*gen.TodoPage, error) {
+func (s *TodoGraphQLServer) ListTodos(ctx context.Context, page int, pageSize int, status *gen.TodoStatus, search *string, searchType *gen.SearchType, dateRange *gen.DateRange, sortBy *gen.TodoSortBy, customFields []*gen.CustomFieldFilter) (*gen.TodoPage, error) {
 	var options []todouc.ListOptions
 	if status != nil {
 		options = append(options, todouc.WithStatus(todo.Status(*status)))
@@ -31,6 +31,9 @@ func (s *TodoGraphQLServer) ListTodos(ctx context.Context, page int, pageSize in
 	if sortBy != nil {
 		options = append(options, todouc.WithSortBy(string(*sortBy)))
 	}
+	for _, f := range customFields {
+		options = append(options, todouc.WithCustomFieldFilter(f.Name, f.Value))
+	}
 
 	todos, hasMore, err := s.ListTodosUsecase.Query(ctx, page, pageSize, options...)
 	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
@@ -44,14 +47,7 @@ func (s *TodoGraphQLServer) ListTodos(ctx context.Context, page int, pageSize in
 	}
 
 	for i, t := range todos {
-		todoPage.Items[i] = &gen.Todo{
-			ID:        t.ID,
-			Title:     t.Title,
-			Status:    gen.TodoStatus(t.Status),
-			DueDate:   (types.Date)(t.DueDate),
-			CreatedAt: t.CreatedAt,
-			UpdatedAt: t.UpdatedAt,
-		}
+		todoPage.Items[i] = toTodo(t)
 	}
 
 	if hasMore {
@@ -64,5 +60,63 @@ func (s *TodoGraphQLServer) ListTodos(ctx context.Context, page int, pageSize in
 	return &todoPage, nil
 }
 
+// ListComments is the resolver for the listComments field.
+func (s *TodoGraphQLServer) ListComments(ctx context.Context, todoID uuid.UUID, page int, pageSize int) (*gen.CommentPage, error) {
+	comments, hasMore, err := s.CommentsUsecase.List(ctx, todoID, page, pageSize)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error listing comments: %v", err)
+		return nil, err
+	}
+
+	commentPage := gen.CommentPage{
+		Items: make([]*gen.Comment, len(comments)),
+		Page:  page,
+	}
+	for i, c := range comments {
+		commentPage.Items[i] = toComment(c)
+	}
+
+	if hasMore {
+		commentPage.NextPage = common.Ptr(page + 1)
+	}
+	if page > 1 {
+		commentPage.PreviousPage = common.Ptr(page - 1)
+	}
+
+	return &commentPage, nil
+}
+
+// ListViews is the resolver for the listViews field.
+func (s *TodoGraphQLServer) ListViews(ctx context.Context) ([]*gen.View, error) {
+	views, err := s.ViewsUsecase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error listing views: %v", err)
+		return nil, err
+	}
+
+	items := make([]*gen.View, len(views))
+	for i, v := range views {
+		items[i] = toView(v)
+	}
+
+	return items, nil
+}
+
+// ListCustomFields is the resolver for the listCustomFields field.
+func (s *TodoGraphQLServer) ListCustomFields(ctx context.Context) ([]*gen.CustomField, error) {
+	fields, err := s.CustomFieldsUsecase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("Error listing custom fields: %v", err)
+		return nil, err
+	}
+
+	items := make([]*gen.CustomField, len(fields))
+	for i, f := range fields {
+		items[i] = toCustomField(f)
+	}
+
+	return items, nil
+}
+
 // Query returns QueryResolver implementation.
 func (s *TodoGraphQLServer) Query() gen.QueryResolver { return s }
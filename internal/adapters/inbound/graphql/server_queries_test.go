@@ -13,6 +13,7 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -188,6 +189,7 @@ func TestTodoGraphQLServer_ListTodos(t *testing.T) {
 				tt.searchType,
 				tt.dateRange,
 				tt.sortBy,
+				nil,
 			)
 			if tt.expectError {
 				assert.Error(t, err)
@@ -199,3 +201,194 @@ func TestTodoGraphQLServer_ListTodos(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoGraphQLServer_ListComments(t *testing.T) {
+	t.Parallel()
+
+	commentID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		page          int
+		setupUsecases func(*todouc.MockComments)
+		expected      *gen.CommentPage
+		expectError   bool
+	}{
+		"success": {
+			page: 2,
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					List(mock.Anything, testID, 2, 20).
+					Return([]todo.Comment{{
+						ID:        commentID,
+						TodoID:    testID,
+						Author:    todo.CommentAuthor_Assistant,
+						Body:      "Updated from chat: marked DONE.",
+						CreatedAt: testNow,
+						UpdatedAt: testNow,
+					}}, true, nil)
+			},
+			expected: &gen.CommentPage{
+				Items: []*gen.Comment{{
+					ID:        commentID,
+					TodoID:    testID,
+					Author:    gen.CommentAuthorAssistant,
+					Body:      "Updated from chat: marked DONE.",
+					CreatedAt: testNow,
+					UpdatedAt: testNow,
+				}},
+				Page:         2,
+				NextPage:     common.Ptr(3),
+				PreviousPage: common.Ptr(1),
+			},
+		},
+		"error": {
+			page: 1,
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					List(mock.Anything, testID, 1, 20).
+					Return(nil, false, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockComments(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CommentsUsecase: mockUC,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.ListComments(t.Context(), testID, tt.page, 20)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_ListViews(t *testing.T) {
+	t.Parallel()
+
+	today := todo.BuiltinViews()[0]
+	viewID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockViews)
+		expected      []*gen.View
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					List(mock.Anything).
+					Return([]todo.View{today, {
+						ID:        viewID,
+						Name:      "Work focus",
+						Filter:    todo.ViewFilter{SearchByTitle: common.Ptr("work")},
+						CreatedAt: testNow,
+						UpdatedAt: testNow,
+					}}, nil)
+			},
+			expected: []*gen.View{
+				{
+					ID:      today.ID,
+					Name:    "Today",
+					BuiltIn: true,
+					Filter: &gen.ViewFilter{
+						Status:    common.Ptr(gen.TodoStatusOpen),
+						SortBy:    common.Ptr(gen.TodoSortByDueDateAsc),
+						DueToDays: common.Ptr(0),
+					},
+				},
+				{
+					ID:        viewID,
+					Name:      "Work focus",
+					Filter:    &gen.ViewFilter{SearchByTitle: common.Ptr("work")},
+					CreatedAt: &testNow,
+					UpdatedAt: &testNow,
+				},
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					List(mock.Anything).
+					Return(nil, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockViews(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				ViewsUsecase: mockUC,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.ListViews(t.Context())
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTodoGraphQLServer_ListCustomFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases func(*todouc.MockCustomFields)
+		expected      []*gen.CustomField
+		expectError   bool
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().
+					List(mock.Anything).
+					Return([]todo.CustomField{{Name: "points", Type: todo.CustomFieldType_NUMBER, CreatedAt: testNow}}, nil)
+			},
+			expected: []*gen.CustomField{
+				{Name: "points", Type: gen.CustomFieldTypeNumber, Options: []string{}, CreatedAt: testNow},
+			},
+		},
+		"error": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().
+					List(mock.Anything).
+					Return(nil, errors.New("fail"))
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := todouc.NewMockCustomFields(t)
+			tt.setupUsecases(mockUC)
+			server := &TodoGraphQLServer{
+				CustomFieldsUsecase: mockUC,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			got, err := server.ListCustomFields(t.Context())
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
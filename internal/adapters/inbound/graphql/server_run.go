@@ -12,7 +12,9 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 	"github.com/rs/cors"
 )
@@ -25,11 +27,17 @@ const (
 
 // TodoGraphQLServer is the GraphQL Server for the TodoApp application.
 type TodoGraphQLServer struct {
-	Logger            *log.Logger `resolve:""`
-	ListTodosUsecase  todo.List   `resolve:""`
-	DeleteTodoUsecase todo.Delete `resolve:""`
-	UpdateTodoUsecase todo.Update `resolve:""`
-	Port              int         `config:"GRAPHQL_SERVER_PORT" default:"8085"`
+	Logger                    *log.Logger             `resolve:""`
+	ListTodosUsecase          todo.List               `resolve:""`
+	DeleteTodoUsecase         todo.Delete             `resolve:""`
+	UpdateTodoUsecase         todo.Update             `resolve:""`
+	ApplyChangesUsecase       todo.ApplyChanges       `resolve:""`
+	CommentsUsecase           todo.Comments           `resolve:""`
+	ViewsUsecase              todo.Views              `resolve:""`
+	CustomFieldsUsecase       todo.CustomFields       `resolve:""`
+	UpdateConversationUsecase chat.UpdateConversation `resolve:""`
+	TenantDirectory           tenant.Directory        `resolve:""`
+	Port                      int                     `config:"GRAPHQL_SERVER_PORT" default:"8085"`
 }
 
 // Run starts the GraphQL server for the TodoApp application.
@@ -46,7 +54,7 @@ func (s *TodoGraphQLServer) Run(ctx context.Context) error {
 	corsHandler := cors.AllowAll()
 
 	mux.Handle("/v1/query", corsHandler.Handler(
-		telemetry.HttpHandler(h, "todoapp-graphql"),
+		telemetry.HttpHandler(tenantMiddleware(s.TenantDirectory, h), "todoapp-graphql"),
 	))
 
 	mux.Handle("/", playground.Handler("TodoApp GraphQL", "/v1/query"))
@@ -0,0 +1,48 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+)
+
+// tenantHeader picks the tenant on hosts no tenant claims. On a tenant's own host it may only name that tenant.
+const tenantHeader = "X-Tenant-ID"
+
+// tenantMiddleware scopes each query to the tenant resolved from the X-Tenant-ID header or the
+// request host. Queries that match no tenant are rejected with a GraphQL error response.
+func tenantMiddleware(directory tenant.Directory, next http.Handler) http.Handler {
+	if directory == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := tenant.ID(strings.TrimSpace(r.Header.Get(tenantHeader)))
+		t, err := directory.Resolve(r.Context(), r.Host, requested)
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			message := "internal server error"
+			var notFoundErr *core.NotFoundErr
+			var validationErr *core.ValidationErr
+			var forbiddenErr *core.ForbiddenErr
+			switch {
+			case errors.As(err, &notFoundErr):
+				statusCode, message = http.StatusNotFound, err.Error()
+			case errors.As(err, &forbiddenErr):
+				statusCode, message = http.StatusForbidden, err.Error()
+			case errors.As(err, &validationErr):
+				statusCode, message = http.StatusBadRequest, err.Error()
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"errors": []map[string]string{{"message": message}},
+			})
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(tenant.NewContext(r.Context(), t)))
+	})
+}
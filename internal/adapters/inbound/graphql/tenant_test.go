@@ -0,0 +1,73 @@
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setExpectation func(*tenant.MockDirectory)
+		expectedStatus int
+		expectedBody   string
+		expectedTenant tenant.ID
+	}{
+		"resolves-tenant": {
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "acme.example.com", tenant.ID("acme")).
+					Return(tenant.Tenant{ID: "acme"}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedTenant: "acme",
+		},
+		"unknown-tenant": {
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "acme.example.com", tenant.ID("acme")).
+					Return(tenant.Tenant{}, core.NewNotFoundErr("tenant not found")).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"errors":[{"message":"tenant not found"}]}`,
+		},
+		"tenant-of-another-host": {
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "acme.example.com", tenant.ID("acme")).
+					Return(tenant.Tenant{}, core.NewForbiddenErr("tenant \"acme\" is not served by this host")).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"errors":[{"message":"tenant \"acme\" is not served by this host"}]}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			directory := tenant.NewMockDirectory(t)
+			tt.setExpectation(directory)
+
+			var gotTenant tenant.ID
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotTenant = tenant.IDFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "http://acme.example.com/v1/query", nil)
+			req.Header.Set(tenantHeader, "acme")
+			rec := httptest.NewRecorder()
+			tenantMiddleware(directory, next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedTenant, gotTenant)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
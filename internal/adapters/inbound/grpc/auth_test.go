@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokenAuth(t *testing.T) {
+	t.Parallel()
+
+	unauthenticated := status.Error(codes.Unauthenticated, "missing or invalid service token")
+
+	tests := map[string]struct {
+		serverToken   string
+		authorization []string
+		expectedErr   error
+	}{
+		"valid-token": {
+			serverToken:   "secret",
+			authorization: []string{"Bearer secret"},
+		},
+		"missing-token": {
+			serverToken: "secret",
+			expectedErr: unauthenticated,
+		},
+		"wrong-token": {
+			serverToken:   "secret",
+			authorization: []string{"Bearer other"},
+			expectedErr:   unauthenticated,
+		},
+		"not-a-bearer-token": {
+			serverToken:   "secret",
+			authorization: []string{"secret"},
+			expectedErr:   unauthenticated,
+		},
+		"auth-disabled": {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			listTodos := todouc.NewMockList(t)
+			if tt.expectedErr == nil {
+				listTodos.EXPECT().Query(mock.Anything, 1, 100).Return([]todo.Todo{}, false, nil)
+			}
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:           log.New(io.Discard, "", 0),
+				AuthToken:        tt.serverToken,
+				ListTodosUseCase: listTodos,
+			})
+
+			ctx := t.Context()
+			for _, value := range tt.authorization {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", value)
+			}
+
+			// Unary and streaming calls share the same check.
+			_, err := client.ListTodos(ctx, &gen.ListTodosRequest{})
+			assertStatusErr(t, tt.expectedErr, err)
+
+			if tt.expectedErr != nil {
+				stream, err := client.Chat(ctx, &gen.ChatRequest{})
+				assert.NoError(t, err)
+				_, err = stream.Recv()
+				assertStatusErr(t, tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,1153 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: todoapp.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TodoStatus is the lifecycle status of a todo.
+type TodoStatus int32
+
+const (
+	TodoStatus_TODO_STATUS_UNSPECIFIED TodoStatus = 0
+	TodoStatus_TODO_STATUS_OPEN        TodoStatus = 1
+	TodoStatus_TODO_STATUS_DONE        TodoStatus = 2
+)
+
+// Enum value maps for TodoStatus.
+var (
+	TodoStatus_name = map[int32]string{
+		0: "TODO_STATUS_UNSPECIFIED",
+		1: "TODO_STATUS_OPEN",
+		2: "TODO_STATUS_DONE",
+	}
+	TodoStatus_value = map[string]int32{
+		"TODO_STATUS_UNSPECIFIED": 0,
+		"TODO_STATUS_OPEN":        1,
+		"TODO_STATUS_DONE":        2,
+	}
+)
+
+func (x TodoStatus) Enum() *TodoStatus {
+	p := new(TodoStatus)
+	*p = x
+	return p
+}
+
+func (x TodoStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TodoStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_todoapp_proto_enumTypes[0].Descriptor()
+}
+
+func (TodoStatus) Type() protoreflect.EnumType {
+	return &file_todoapp_proto_enumTypes[0]
+}
+
+func (x TodoStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TodoStatus.Descriptor instead.
+func (TodoStatus) EnumDescriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{0}
+}
+
+// ChatEventType mirrors the assistant stream event types.
+type ChatEventType int32
+
+const (
+	ChatEventType_CHAT_EVENT_TYPE_UNSPECIFIED                  ChatEventType = 0
+	ChatEventType_CHAT_EVENT_TYPE_TURN_STARTED                 ChatEventType = 1
+	ChatEventType_CHAT_EVENT_TYPE_MESSAGE_DELTA                ChatEventType = 2
+	ChatEventType_CHAT_EVENT_TYPE_REASONING                    ChatEventType = 3
+	ChatEventType_CHAT_EVENT_TYPE_ACTION_REQUESTED             ChatEventType = 4
+	ChatEventType_CHAT_EVENT_TYPE_ACTION_APPROVAL_REQUIRED     ChatEventType = 5
+	ChatEventType_CHAT_EVENT_TYPE_ACTION_APPROVAL_RESOLVED     ChatEventType = 6
+	ChatEventType_CHAT_EVENT_TYPE_ACTION_STARTED               ChatEventType = 7
+	ChatEventType_CHAT_EVENT_TYPE_ACTION_COMPLETED             ChatEventType = 8
+	ChatEventType_CHAT_EVENT_TYPE_TURN_COMPLETED               ChatEventType = 9
+	ChatEventType_CHAT_EVENT_TYPE_TURN_FAILED                  ChatEventType = 10
+	ChatEventType_CHAT_EVENT_TYPE_CONTEXT_COMPACTION_STARTED   ChatEventType = 11
+	ChatEventType_CHAT_EVENT_TYPE_CONTEXT_COMPACTION_COMPLETED ChatEventType = 12
+	ChatEventType_CHAT_EVENT_TYPE_CONTEXT_COMPACTION_FAILED    ChatEventType = 13
+	ChatEventType_CHAT_EVENT_TYPE_CONTEXT_TRUNCATED            ChatEventType = 14
+	ChatEventType_CHAT_EVENT_TYPE_FOCUS_SESSION_COMPLETED      ChatEventType = 15
+	ChatEventType_CHAT_EVENT_TYPE_TOPIC_SHIFT_SUGGESTED        ChatEventType = 16
+	ChatEventType_CHAT_EVENT_TYPE_CONVERSATION_SPLIT           ChatEventType = 17
+	ChatEventType_CHAT_EVENT_TYPE_MESSAGE_MODERATED            ChatEventType = 18
+	ChatEventType_CHAT_EVENT_TYPE_GROUNDING_WARNING            ChatEventType = 19
+)
+
+// Enum value maps for ChatEventType.
+var (
+	ChatEventType_name = map[int32]string{
+		0:  "CHAT_EVENT_TYPE_UNSPECIFIED",
+		1:  "CHAT_EVENT_TYPE_TURN_STARTED",
+		2:  "CHAT_EVENT_TYPE_MESSAGE_DELTA",
+		3:  "CHAT_EVENT_TYPE_REASONING",
+		4:  "CHAT_EVENT_TYPE_ACTION_REQUESTED",
+		5:  "CHAT_EVENT_TYPE_ACTION_APPROVAL_REQUIRED",
+		6:  "CHAT_EVENT_TYPE_ACTION_APPROVAL_RESOLVED",
+		7:  "CHAT_EVENT_TYPE_ACTION_STARTED",
+		8:  "CHAT_EVENT_TYPE_ACTION_COMPLETED",
+		9:  "CHAT_EVENT_TYPE_TURN_COMPLETED",
+		10: "CHAT_EVENT_TYPE_TURN_FAILED",
+		11: "CHAT_EVENT_TYPE_CONTEXT_COMPACTION_STARTED",
+		12: "CHAT_EVENT_TYPE_CONTEXT_COMPACTION_COMPLETED",
+		13: "CHAT_EVENT_TYPE_CONTEXT_COMPACTION_FAILED",
+		14: "CHAT_EVENT_TYPE_CONTEXT_TRUNCATED",
+		15: "CHAT_EVENT_TYPE_FOCUS_SESSION_COMPLETED",
+		16: "CHAT_EVENT_TYPE_TOPIC_SHIFT_SUGGESTED",
+		17: "CHAT_EVENT_TYPE_CONVERSATION_SPLIT",
+		18: "CHAT_EVENT_TYPE_MESSAGE_MODERATED",
+		19: "CHAT_EVENT_TYPE_GROUNDING_WARNING",
+	}
+	ChatEventType_value = map[string]int32{
+		"CHAT_EVENT_TYPE_UNSPECIFIED":                  0,
+		"CHAT_EVENT_TYPE_TURN_STARTED":                 1,
+		"CHAT_EVENT_TYPE_MESSAGE_DELTA":                2,
+		"CHAT_EVENT_TYPE_REASONING":                    3,
+		"CHAT_EVENT_TYPE_ACTION_REQUESTED":             4,
+		"CHAT_EVENT_TYPE_ACTION_APPROVAL_REQUIRED":     5,
+		"CHAT_EVENT_TYPE_ACTION_APPROVAL_RESOLVED":     6,
+		"CHAT_EVENT_TYPE_ACTION_STARTED":               7,
+		"CHAT_EVENT_TYPE_ACTION_COMPLETED":             8,
+		"CHAT_EVENT_TYPE_TURN_COMPLETED":               9,
+		"CHAT_EVENT_TYPE_TURN_FAILED":                  10,
+		"CHAT_EVENT_TYPE_CONTEXT_COMPACTION_STARTED":   11,
+		"CHAT_EVENT_TYPE_CONTEXT_COMPACTION_COMPLETED": 12,
+		"CHAT_EVENT_TYPE_CONTEXT_COMPACTION_FAILED":    13,
+		"CHAT_EVENT_TYPE_CONTEXT_TRUNCATED":            14,
+		"CHAT_EVENT_TYPE_FOCUS_SESSION_COMPLETED":      15,
+		"CHAT_EVENT_TYPE_TOPIC_SHIFT_SUGGESTED":        16,
+		"CHAT_EVENT_TYPE_CONVERSATION_SPLIT":           17,
+		"CHAT_EVENT_TYPE_MESSAGE_MODERATED":            18,
+		"CHAT_EVENT_TYPE_GROUNDING_WARNING":            19,
+	}
+)
+
+func (x ChatEventType) Enum() *ChatEventType {
+	p := new(ChatEventType)
+	*p = x
+	return p
+}
+
+func (x ChatEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_todoapp_proto_enumTypes[1].Descriptor()
+}
+
+func (ChatEventType) Type() protoreflect.EnumType {
+	return &file_todoapp_proto_enumTypes[1]
+}
+
+func (x ChatEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatEventType.Descriptor instead.
+func (ChatEventType) EnumDescriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{1}
+}
+
+// Todo is a todo item.
+type Todo struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status TodoStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=todoapp.v1.TodoStatus" json:"status,omitempty"`
+	// Calendar due date, formatted as YYYY-MM-DD.
+	DueDate       string                 `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Todo) Reset() {
+	*x = Todo{}
+	mi := &file_todoapp_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Todo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Todo) ProtoMessage() {}
+
+func (x *Todo) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Todo.ProtoReflect.Descriptor instead.
+func (*Todo) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Todo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Todo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Todo) GetStatus() TodoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TodoStatus_TODO_STATUS_UNSPECIFIED
+}
+
+func (x *Todo) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *Todo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Todo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListTodosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number, starting at 1.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Number of todos per page.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Only return todos with this status when set.
+	Status TodoStatus `protobuf:"varint,3,opt,name=status,proto3,enum=todoapp.v1.TodoStatus" json:"status,omitempty"`
+	// Search query, matched according to search_type.
+	Search *string `protobuf:"bytes,4,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	// Search type: "title" or "similarity".
+	SearchType *string `protobuf:"bytes,5,opt,name=search_type,json=searchType,proto3,oneof" json:"search_type,omitempty"`
+	// Only return todos due on or after this date (YYYY-MM-DD). Requires due_before.
+	DueAfter *string `protobuf:"bytes,6,opt,name=due_after,json=dueAfter,proto3,oneof" json:"due_after,omitempty"`
+	// Only return todos due on or before this date (YYYY-MM-DD). Requires due_after.
+	DueBefore *string `protobuf:"bytes,7,opt,name=due_before,json=dueBefore,proto3,oneof" json:"due_before,omitempty"`
+	// Sorting criteria, e.g. "dueDateAsc" or "createdAtDesc".
+	Sort          *string `protobuf:"bytes,8,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTodosRequest) Reset() {
+	*x = ListTodosRequest{}
+	mi := &file_todoapp_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTodosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodosRequest) ProtoMessage() {}
+
+func (x *ListTodosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodosRequest.ProtoReflect.Descriptor instead.
+func (*ListTodosRequest) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTodosRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTodosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTodosRequest) GetStatus() TodoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TodoStatus_TODO_STATUS_UNSPECIFIED
+}
+
+func (x *ListTodosRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ListTodosRequest) GetSearchType() string {
+	if x != nil && x.SearchType != nil {
+		return *x.SearchType
+	}
+	return ""
+}
+
+func (x *ListTodosRequest) GetDueAfter() string {
+	if x != nil && x.DueAfter != nil {
+		return *x.DueAfter
+	}
+	return ""
+}
+
+func (x *ListTodosRequest) GetDueBefore() string {
+	if x != nil && x.DueBefore != nil {
+		return *x.DueBefore
+	}
+	return ""
+}
+
+func (x *ListTodosRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+type ListTodosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*Todo                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Next page number, unset on the last page.
+	NextPage      *int32 `protobuf:"varint,3,opt,name=next_page,json=nextPage,proto3,oneof" json:"next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTodosResponse) Reset() {
+	*x = ListTodosResponse{}
+	mi := &file_todoapp_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTodosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTodosResponse) ProtoMessage() {}
+
+func (x *ListTodosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTodosResponse.ProtoReflect.Descriptor instead.
+func (*ListTodosResponse) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTodosResponse) GetItems() []*Todo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTodosResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTodosResponse) GetNextPage() int32 {
+	if x != nil && x.NextPage != nil {
+		return *x.NextPage
+	}
+	return 0
+}
+
+type CreateTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Calendar due date, formatted as YYYY-MM-DD.
+	DueDate       string `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTodoRequest) Reset() {
+	*x = CreateTodoRequest{}
+	mi := &file_todoapp_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTodoRequest) ProtoMessage() {}
+
+func (x *CreateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTodoRequest.ProtoReflect.Descriptor instead.
+func (*CreateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTodoRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTodoRequest) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+type UpdateTodoRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Status TodoStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=todoapp.v1.TodoStatus" json:"status,omitempty"`
+	// Calendar due date, formatted as YYYY-MM-DD.
+	DueDate       *string `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTodoRequest) Reset() {
+	*x = UpdateTodoRequest{}
+	mi := &file_todoapp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTodoRequest) ProtoMessage() {}
+
+func (x *UpdateTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTodoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTodoRequest) GetStatus() TodoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TodoStatus_TODO_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateTodoRequest) GetDueDate() string {
+	if x != nil && x.DueDate != nil {
+		return *x.DueDate
+	}
+	return ""
+}
+
+type DeleteTodoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTodoRequest) Reset() {
+	*x = DeleteTodoRequest{}
+	mi := &file_todoapp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTodoRequest) ProtoMessage() {}
+
+func (x *DeleteTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTodoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTodoRequest) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTodoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTodoResponse) Reset() {
+	*x = DeleteTodoResponse{}
+	mi := &file_todoapp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTodoResponse) ProtoMessage() {}
+
+func (x *DeleteTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTodoResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTodoResponse) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{6}
+}
+
+// Conversation is an assistant conversation.
+type Conversation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Title source: "user" or "llm".
+	TitleSource     string                 `protobuf:"bytes,3,opt,name=title_source,json=titleSource,proto3" json:"title_source,omitempty"`
+	TotalTokensUsed int64                  `protobuf:"varint,4,opt,name=total_tokens_used,json=totalTokensUsed,proto3" json:"total_tokens_used,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Conversation) Reset() {
+	*x = Conversation{}
+	mi := &file_todoapp_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Conversation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conversation) ProtoMessage() {}
+
+func (x *Conversation) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conversation.ProtoReflect.Descriptor instead.
+func (*Conversation) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Conversation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Conversation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Conversation) GetTitleSource() string {
+	if x != nil {
+		return x.TitleSource
+	}
+	return ""
+}
+
+func (x *Conversation) GetTotalTokensUsed() int64 {
+	if x != nil {
+		return x.TotalTokensUsed
+	}
+	return 0
+}
+
+func (x *Conversation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Conversation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListConversationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number, starting at 1.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Number of conversations per page.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_todoapp_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListConversationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Next page number, unset on the last page.
+	NextPage      *int32 `protobuf:"varint,3,opt,name=next_page,json=nextPage,proto3,oneof" json:"next_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsResponse) Reset() {
+	*x = ListConversationsResponse{}
+	mi := &file_todoapp_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsResponse) ProtoMessage() {}
+
+func (x *ListConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *ListConversationsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListConversationsResponse) GetNextPage() int32 {
+	if x != nil && x.NextPage != nil {
+		return *x.NextPage
+	}
+	return 0
+}
+
+type ChatRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Model   string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	// Conversation to continue. A new conversation is started when unset.
+	ConversationId *string `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
+	mi := &file_todoapp_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRequest) ProtoMessage() {}
+
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChatRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ChatRequest) GetConversationId() string {
+	if x != nil && x.ConversationId != nil {
+		return *x.ConversationId
+	}
+	return ""
+}
+
+// ChatEvent is one event of an assistant turn.
+type ChatEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  ChatEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=todoapp.v1.ChatEventType" json:"type,omitempty"`
+	// Event payload as JSON, identical to the data of the REST chat stream event.
+	Data          string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_todoapp_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_todoapp_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_todoapp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChatEvent) GetType() ChatEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ChatEventType_CHAT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ChatEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_todoapp_proto protoreflect.FileDescriptor
+
+const file_todoapp_proto_rawDesc = "" +
+	"\n" +
+	"\rtodoapp.proto\x12\n" +
+	"todoapp.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x01\n" +
+	"\x04Todo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.todoapp.v1.TodoStatusR\x06status\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd6\x02\n" +
+	"\x10ListTodosRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.todoapp.v1.TodoStatusR\x06status\x12\x1b\n" +
+	"\x06search\x18\x04 \x01(\tH\x00R\x06search\x88\x01\x01\x12$\n" +
+	"\vsearch_type\x18\x05 \x01(\tH\x01R\n" +
+	"searchType\x88\x01\x01\x12 \n" +
+	"\tdue_after\x18\x06 \x01(\tH\x02R\bdueAfter\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"due_before\x18\a \x01(\tH\x03R\tdueBefore\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\b \x01(\tH\x04R\x04sort\x88\x01\x01B\t\n" +
+	"\a_searchB\x0e\n" +
+	"\f_search_typeB\f\n" +
+	"\n" +
+	"_due_afterB\r\n" +
+	"\v_due_beforeB\a\n" +
+	"\x05_sort\"\x7f\n" +
+	"\x11ListTodosResponse\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.todoapp.v1.TodoR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12 \n" +
+	"\tnext_page\x18\x03 \x01(\x05H\x00R\bnextPage\x88\x01\x01B\f\n" +
+	"\n" +
+	"_next_page\"D\n" +
+	"\x11CreateTodoRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x19\n" +
+	"\bdue_date\x18\x02 \x01(\tR\adueDate\"\xa5\x01\n" +
+	"\x11UpdateTodoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.todoapp.v1.TodoStatusR\x06status\x12\x1e\n" +
+	"\bdue_date\x18\x04 \x01(\tH\x01R\adueDate\x88\x01\x01B\b\n" +
+	"\x06_titleB\v\n" +
+	"\t_due_date\"#\n" +
+	"\x11DeleteTodoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteTodoResponse\"\xf9\x01\n" +
+	"\fConversation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\ftitle_source\x18\x03 \x01(\tR\vtitleSource\x12*\n" +
+	"\x11total_tokens_used\x18\x04 \x01(\x03R\x0ftotalTokensUsed\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"K\n" +
+	"\x18ListConversationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x9f\x01\n" +
+	"\x19ListConversationsResponse\x12>\n" +
+	"\rconversations\x18\x01 \x03(\v2\x18.todoapp.v1.ConversationR\rconversations\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12 \n" +
+	"\tnext_page\x18\x03 \x01(\x05H\x00R\bnextPage\x88\x01\x01B\f\n" +
+	"\n" +
+	"_next_page\"\x7f\n" +
+	"\vChatRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12,\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tH\x00R\x0econversationId\x88\x01\x01B\x12\n" +
+	"\x10_conversation_id\"N\n" +
+	"\tChatEvent\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.todoapp.v1.ChatEventTypeR\x04type\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data*U\n" +
+	"\n" +
+	"TodoStatus\x12\x1b\n" +
+	"\x17TODO_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TODO_STATUS_OPEN\x10\x01\x12\x14\n" +
+	"\x10TODO_STATUS_DONE\x10\x02*\xab\x06\n" +
+	"\rChatEventType\x12\x1f\n" +
+	"\x1bCHAT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCHAT_EVENT_TYPE_TURN_STARTED\x10\x01\x12!\n" +
+	"\x1dCHAT_EVENT_TYPE_MESSAGE_DELTA\x10\x02\x12\x1d\n" +
+	"\x19CHAT_EVENT_TYPE_REASONING\x10\x03\x12$\n" +
+	" CHAT_EVENT_TYPE_ACTION_REQUESTED\x10\x04\x12,\n" +
+	"(CHAT_EVENT_TYPE_ACTION_APPROVAL_REQUIRED\x10\x05\x12,\n" +
+	"(CHAT_EVENT_TYPE_ACTION_APPROVAL_RESOLVED\x10\x06\x12\"\n" +
+	"\x1eCHAT_EVENT_TYPE_ACTION_STARTED\x10\a\x12$\n" +
+	" CHAT_EVENT_TYPE_ACTION_COMPLETED\x10\b\x12\"\n" +
+	"\x1eCHAT_EVENT_TYPE_TURN_COMPLETED\x10\t\x12\x1f\n" +
+	"\x1bCHAT_EVENT_TYPE_TURN_FAILED\x10\n" +
+	"\x12.\n" +
+	"*CHAT_EVENT_TYPE_CONTEXT_COMPACTION_STARTED\x10\v\x120\n" +
+	",CHAT_EVENT_TYPE_CONTEXT_COMPACTION_COMPLETED\x10\f\x12-\n" +
+	")CHAT_EVENT_TYPE_CONTEXT_COMPACTION_FAILED\x10\r\x12%\n" +
+	"!CHAT_EVENT_TYPE_CONTEXT_TRUNCATED\x10\x0e\x12+\n" +
+	"'CHAT_EVENT_TYPE_FOCUS_SESSION_COMPLETED\x10\x0f\x12)\n" +
+	"%CHAT_EVENT_TYPE_TOPIC_SHIFT_SUGGESTED\x10\x10\x12&\n" +
+	"\"CHAT_EVENT_TYPE_CONVERSATION_SPLIT\x10\x11\x12%\n" +
+	"!CHAT_EVENT_TYPE_MESSAGE_MODERATED\x10\x12\x12%\n" +
+	"!CHAT_EVENT_TYPE_GROUNDING_WARNING\x10\x132\xc1\x03\n" +
+	"\x0eTodoAppService\x12H\n" +
+	"\tListTodos\x12\x1c.todoapp.v1.ListTodosRequest\x1a\x1d.todoapp.v1.ListTodosResponse\x12=\n" +
+	"\n" +
+	"CreateTodo\x12\x1d.todoapp.v1.CreateTodoRequest\x1a\x10.todoapp.v1.Todo\x12=\n" +
+	"\n" +
+	"UpdateTodo\x12\x1d.todoapp.v1.UpdateTodoRequest\x1a\x10.todoapp.v1.Todo\x12K\n" +
+	"\n" +
+	"DeleteTodo\x12\x1d.todoapp.v1.DeleteTodoRequest\x1a\x1e.todoapp.v1.DeleteTodoResponse\x12`\n" +
+	"\x11ListConversations\x12$.todoapp.v1.ListConversationsRequest\x1a%.todoapp.v1.ListConversationsResponse\x128\n" +
+	"\x04Chat\x12\x17.todoapp.v1.ChatRequest\x1a\x15.todoapp.v1.ChatEvent0\x01BSZQgithub.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen;genb\x06proto3"
+
+var (
+	file_todoapp_proto_rawDescOnce sync.Once
+	file_todoapp_proto_rawDescData []byte
+)
+
+func file_todoapp_proto_rawDescGZIP() []byte {
+	file_todoapp_proto_rawDescOnce.Do(func() {
+		file_todoapp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_todoapp_proto_rawDesc), len(file_todoapp_proto_rawDesc)))
+	})
+	return file_todoapp_proto_rawDescData
+}
+
+var file_todoapp_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_todoapp_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_todoapp_proto_goTypes = []any{
+	(TodoStatus)(0),                   // 0: todoapp.v1.TodoStatus
+	(ChatEventType)(0),                // 1: todoapp.v1.ChatEventType
+	(*Todo)(nil),                      // 2: todoapp.v1.Todo
+	(*ListTodosRequest)(nil),          // 3: todoapp.v1.ListTodosRequest
+	(*ListTodosResponse)(nil),         // 4: todoapp.v1.ListTodosResponse
+	(*CreateTodoRequest)(nil),         // 5: todoapp.v1.CreateTodoRequest
+	(*UpdateTodoRequest)(nil),         // 6: todoapp.v1.UpdateTodoRequest
+	(*DeleteTodoRequest)(nil),         // 7: todoapp.v1.DeleteTodoRequest
+	(*DeleteTodoResponse)(nil),        // 8: todoapp.v1.DeleteTodoResponse
+	(*Conversation)(nil),              // 9: todoapp.v1.Conversation
+	(*ListConversationsRequest)(nil),  // 10: todoapp.v1.ListConversationsRequest
+	(*ListConversationsResponse)(nil), // 11: todoapp.v1.ListConversationsResponse
+	(*ChatRequest)(nil),               // 12: todoapp.v1.ChatRequest
+	(*ChatEvent)(nil),                 // 13: todoapp.v1.ChatEvent
+	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+}
+var file_todoapp_proto_depIdxs = []int32{
+	0,  // 0: todoapp.v1.Todo.status:type_name -> todoapp.v1.TodoStatus
+	14, // 1: todoapp.v1.Todo.created_at:type_name -> google.protobuf.Timestamp
+	14, // 2: todoapp.v1.Todo.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: todoapp.v1.ListTodosRequest.status:type_name -> todoapp.v1.TodoStatus
+	2,  // 4: todoapp.v1.ListTodosResponse.items:type_name -> todoapp.v1.Todo
+	0,  // 5: todoapp.v1.UpdateTodoRequest.status:type_name -> todoapp.v1.TodoStatus
+	14, // 6: todoapp.v1.Conversation.created_at:type_name -> google.protobuf.Timestamp
+	14, // 7: todoapp.v1.Conversation.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 8: todoapp.v1.ListConversationsResponse.conversations:type_name -> todoapp.v1.Conversation
+	1,  // 9: todoapp.v1.ChatEvent.type:type_name -> todoapp.v1.ChatEventType
+	3,  // 10: todoapp.v1.TodoAppService.ListTodos:input_type -> todoapp.v1.ListTodosRequest
+	5,  // 11: todoapp.v1.TodoAppService.CreateTodo:input_type -> todoapp.v1.CreateTodoRequest
+	6,  // 12: todoapp.v1.TodoAppService.UpdateTodo:input_type -> todoapp.v1.UpdateTodoRequest
+	7,  // 13: todoapp.v1.TodoAppService.DeleteTodo:input_type -> todoapp.v1.DeleteTodoRequest
+	10, // 14: todoapp.v1.TodoAppService.ListConversations:input_type -> todoapp.v1.ListConversationsRequest
+	12, // 15: todoapp.v1.TodoAppService.Chat:input_type -> todoapp.v1.ChatRequest
+	4,  // 16: todoapp.v1.TodoAppService.ListTodos:output_type -> todoapp.v1.ListTodosResponse
+	2,  // 17: todoapp.v1.TodoAppService.CreateTodo:output_type -> todoapp.v1.Todo
+	2,  // 18: todoapp.v1.TodoAppService.UpdateTodo:output_type -> todoapp.v1.Todo
+	8,  // 19: todoapp.v1.TodoAppService.DeleteTodo:output_type -> todoapp.v1.DeleteTodoResponse
+	11, // 20: todoapp.v1.TodoAppService.ListConversations:output_type -> todoapp.v1.ListConversationsResponse
+	13, // 21: todoapp.v1.TodoAppService.Chat:output_type -> todoapp.v1.ChatEvent
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_todoapp_proto_init() }
+func file_todoapp_proto_init() {
+	if File_todoapp_proto != nil {
+		return
+	}
+	file_todoapp_proto_msgTypes[1].OneofWrappers = []any{}
+	file_todoapp_proto_msgTypes[2].OneofWrappers = []any{}
+	file_todoapp_proto_msgTypes[4].OneofWrappers = []any{}
+	file_todoapp_proto_msgTypes[9].OneofWrappers = []any{}
+	file_todoapp_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todoapp_proto_rawDesc), len(file_todoapp_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_todoapp_proto_goTypes,
+		DependencyIndexes: file_todoapp_proto_depIdxs,
+		EnumInfos:         file_todoapp_proto_enumTypes,
+		MessageInfos:      file_todoapp_proto_msgTypes,
+	}.Build()
+	File_todoapp_proto = out.File
+	file_todoapp_proto_goTypes = nil
+	file_todoapp_proto_depIdxs = nil
+}
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient serves the server over an in-memory listener and returns a client connected to it.
+func newTestClient(t *testing.T, s *TodoGRPCServer) gen.TodoAppServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	svr := s.newServer()
+	go func() {
+		_ = svr.Serve(lis)
+	}()
+	t.Cleanup(svr.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return gen.NewTodoAppServiceClient(conn)
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dateLayout is the format of calendar dates in requests and responses.
+const dateLayout = time.DateOnly
+
+// chatEventTypes maps the assistant stream event types to their gRPC counterparts.
+var chatEventTypes = map[assistant.EventType]gen.ChatEventType{
+	assistant.EventType_TurnStarted:                gen.ChatEventType_CHAT_EVENT_TYPE_TURN_STARTED,
+	assistant.EventType_MessageDelta:               gen.ChatEventType_CHAT_EVENT_TYPE_MESSAGE_DELTA,
+	assistant.EventType_Reasoning:                  gen.ChatEventType_CHAT_EVENT_TYPE_REASONING,
+	assistant.EventType_ActionRequested:            gen.ChatEventType_CHAT_EVENT_TYPE_ACTION_REQUESTED,
+	assistant.EventType_ActionApprovalRequired:     gen.ChatEventType_CHAT_EVENT_TYPE_ACTION_APPROVAL_REQUIRED,
+	assistant.EventType_ActionApprovalResolved:     gen.ChatEventType_CHAT_EVENT_TYPE_ACTION_APPROVAL_RESOLVED,
+	assistant.EventType_ActionStarted:              gen.ChatEventType_CHAT_EVENT_TYPE_ACTION_STARTED,
+	assistant.EventType_ActionCompleted:            gen.ChatEventType_CHAT_EVENT_TYPE_ACTION_COMPLETED,
+	assistant.EventType_TurnCompleted:              gen.ChatEventType_CHAT_EVENT_TYPE_TURN_COMPLETED,
+	assistant.EventType_TurnFailed:                 gen.ChatEventType_CHAT_EVENT_TYPE_TURN_FAILED,
+	assistant.EventType_ContextCompactionStarted:   gen.ChatEventType_CHAT_EVENT_TYPE_CONTEXT_COMPACTION_STARTED,
+	assistant.EventType_ContextCompactionCompleted: gen.ChatEventType_CHAT_EVENT_TYPE_CONTEXT_COMPACTION_COMPLETED,
+	assistant.EventType_ContextCompactionFailed:    gen.ChatEventType_CHAT_EVENT_TYPE_CONTEXT_COMPACTION_FAILED,
+	assistant.EventType_ContextTruncated:           gen.ChatEventType_CHAT_EVENT_TYPE_CONTEXT_TRUNCATED,
+	assistant.EventType_FocusSessionCompleted:      gen.ChatEventType_CHAT_EVENT_TYPE_FOCUS_SESSION_COMPLETED,
+	assistant.EventType_TopicShiftSuggested:        gen.ChatEventType_CHAT_EVENT_TYPE_TOPIC_SHIFT_SUGGESTED,
+	assistant.EventType_ConversationSplit:          gen.ChatEventType_CHAT_EVENT_TYPE_CONVERSATION_SPLIT,
+	assistant.EventType_MessageModerated:           gen.ChatEventType_CHAT_EVENT_TYPE_MESSAGE_MODERATED,
+	assistant.EventType_GroundingWarning:           gen.ChatEventType_CHAT_EVENT_TYPE_GROUNDING_WARNING,
+}
+
+// toStatusErr converts a domain error into a gRPC status error.
+func toStatusErr(err error) error {
+	switch e := err.(type) {
+	case *core.ValidationErr:
+		return status.Error(codes.InvalidArgument, e.Error())
+	case *core.NotFoundErr:
+		return status.Error(codes.NotFound, e.Error())
+	case *core.UnauthorizedErr:
+		return status.Error(codes.Unauthenticated, e.Error())
+	case *core.ForbiddenErr:
+		return status.Error(codes.PermissionDenied, e.Error())
+	case *core.ConflictErr:
+		return status.Error(codes.Aborted, e.Error())
+	case *core.UnavailableErr:
+		return status.Error(codes.Unavailable, e.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func toTodo(t todo.Todo) *gen.Todo {
+	return &gen.Todo{
+		Id:        t.ID.String(),
+		Title:     t.Title,
+		Status:    toTodoStatus(t.Status),
+		DueDate:   t.DueDate.Format(dateLayout),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func toTodoStatus(s todo.Status) gen.TodoStatus {
+	switch s {
+	case todo.Status_OPEN:
+		return gen.TodoStatus_TODO_STATUS_OPEN
+	case todo.Status_DONE:
+		return gen.TodoStatus_TODO_STATUS_DONE
+	default:
+		return gen.TodoStatus_TODO_STATUS_UNSPECIFIED
+	}
+}
+
+// toDomainStatus returns the todo status of a request, or nil when it is unspecified.
+func toDomainStatus(s gen.TodoStatus) *todo.Status {
+	var status todo.Status
+	switch s {
+	case gen.TodoStatus_TODO_STATUS_OPEN:
+		status = todo.Status_OPEN
+	case gen.TodoStatus_TODO_STATUS_DONE:
+		status = todo.Status_DONE
+	default:
+		return nil
+	}
+	return &status
+}
+
+func toConversation(c assistant.Conversation, totalTokensUsed int64) *gen.Conversation {
+	return &gen.Conversation{
+		Id:              c.ID.String(),
+		Title:           c.Title,
+		TitleSource:     string(c.TitleSource),
+		TotalTokensUsed: totalTokensUsed,
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// toChatEvent converts an assistant stream event, encoding its data as the REST chat stream does.
+func toChatEvent(eventType assistant.EventType, data any) (*gen.ChatEvent, error) {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &gen.ChatEvent{
+		Type: chatEventTypes[eventType],
+		Data: string(dataBytes),
+	}, nil
+}
+
+// parseID parses a UUID field of a request.
+func parseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s %q", field, value)
+	}
+	return id, nil
+}
+
+// parseDate parses a calendar date field of a request.
+func parseDate(field, value string) (time.Time, error) {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s %q, expected YYYY-MM-DD", field, value)
+	}
+	return t, nil
+}
+
+// pagination applies the default page and page size of a request and checks the page size limit.
+func pagination(page, pageSize int32, defaultPageSize, maxPageSize int) (int, int, error) {
+	p, size := int(page), int(pageSize)
+	if p == 0 {
+		p = 1
+	}
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if p < 1 {
+		return 0, 0, status.Error(codes.InvalidArgument, "page must be greater than 0")
+	}
+	if size < 1 || size > maxPageSize {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "page_size must be between 1 and %d", maxPageSize)
+	}
+	return p, size, nil
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusErr(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err             error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		"validation": {
+			err:             core.NewValidationErr("title cannot be empty"),
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "title cannot be empty",
+		},
+		"not-found": {
+			err:             core.NewNotFoundErr("todo not found"),
+			expectedCode:    codes.NotFound,
+			expectedMessage: "todo not found",
+		},
+		"unauthorized": {
+			err:             core.NewUnauthorizedErr("invalid signature"),
+			expectedCode:    codes.Unauthenticated,
+			expectedMessage: "invalid signature",
+		},
+		"forbidden": {
+			err:             core.NewForbiddenErr("readonly role may not change data"),
+			expectedCode:    codes.PermissionDenied,
+			expectedMessage: "readonly role may not change data",
+		},
+		"conflict": {
+			err:             core.NewConflictErr("todo was modified by another client"),
+			expectedCode:    codes.Aborted,
+			expectedMessage: "todo was modified by another client",
+		},
+		"unavailable": {
+			err:             core.NewUnavailableErr("the server is shutting down, please retry the request"),
+			expectedCode:    codes.Unavailable,
+			expectedMessage: "the server is shutting down, please retry the request",
+		},
+		"internal": {
+			err:             errors.New("database error"),
+			expectedCode:    codes.Internal,
+			expectedMessage: "internal server error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			st := status.Convert(toStatusErr(tt.err))
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMessage, st.Message())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultConversationsPageSize = 200
+	maxConversationsPageSize     = 500
+)
+
+// ListConversations returns a page of conversations ordered by last message time descending.
+func (s *TodoGRPCServer) ListConversations(ctx context.Context, req *gen.ListConversationsRequest) (*gen.ListConversationsResponse, error) {
+	page, pageSize, err := pagination(req.GetPage(), req.GetPageSize(), defaultConversationsPageSize, maxConversationsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	conversations, tokensByConversationID, hasMore, err := s.ListConversationsUseCase.Query(ctx, page, pageSize)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("TodoGRPCServer: error listing conversations: %v", err)
+		return nil, toStatusErr(err)
+	}
+
+	resp := &gen.ListConversationsResponse{
+		Conversations: make([]*gen.Conversation, 0, len(conversations)),
+		Page:          int32(page),
+	}
+	for _, c := range conversations {
+		resp.Conversations = append(resp.Conversations, toConversation(c, tokensByConversationID[c.ID]))
+	}
+	if hasMore {
+		resp.NextPage = common.Ptr(int32(page + 1))
+	}
+	return resp, nil
+}
+
+// Chat runs one assistant turn and streams its events.
+func (s *TodoGRPCServer) Chat(req *gen.ChatRequest, stream grpc.ServerStreamingServer[gen.ChatEvent]) error {
+	var options []chat.StreamChatOption
+	if req.ConversationId != nil {
+		conversationID, err := parseID("conversation_id", req.GetConversationId())
+		if err != nil {
+			return err
+		}
+		options = append(options, chat.WithConversationID(conversationID))
+	}
+
+	ctx := stream.Context()
+	err := s.StreamChatUseCase.Execute(ctx, req.GetMessage(), req.GetModel(), func(_ context.Context, eventType assistant.EventType, data any) error {
+		event, err := toChatEvent(eventType, data)
+		if err != nil {
+			return err
+		}
+		return stream.Send(event)
+	}, options...)
+	if !telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	s.Logger.Printf("TodoGRPCServer: error during chat streaming: %v", err)
+	// Turn failures are already reported to the client as a turn_failed event.
+	var turnErr *assistant.TurnError
+	if errors.As(err, &turnErr) {
+		return nil
+	}
+	return toStatusErr(err)
+}
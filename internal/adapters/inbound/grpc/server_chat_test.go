@@ -0,0 +1,204 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTodoGRPCServer_ListConversations(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	conversation := assistant.Conversation{
+		ID:          conversationID,
+		Title:       "Weekly planning",
+		TitleSource: assistant.ConversationTitleSource_LLM,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	tests := map[string]struct {
+		req             *gen.ListConversationsRequest
+		setExpectations func(*chat.MockListConversations)
+		expected        *gen.ListConversationsResponse
+		expectedErr     error
+	}{
+		"success": {
+			req: &gen.ListConversationsRequest{Page: 1, PageSize: 1},
+			setExpectations: func(m *chat.MockListConversations) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 1).
+					Return([]assistant.Conversation{conversation}, map[uuid.UUID]int64{conversationID: 1200}, true, nil)
+			},
+			expected: &gen.ListConversationsResponse{
+				Conversations: []*gen.Conversation{{
+					Id:              conversationID.String(),
+					Title:           "Weekly planning",
+					TitleSource:     "llm",
+					TotalTokensUsed: 1200,
+					CreatedAt:       timestamppb.New(fixedTime),
+					UpdatedAt:       timestamppb.New(fixedTime),
+				}},
+				Page:     1,
+				NextPage: common.Ptr(int32(2)),
+			},
+		},
+		"invalid-page": {
+			req:             &gen.ListConversationsRequest{Page: -1},
+			setExpectations: func(m *chat.MockListConversations) {},
+			expectedErr:     status.Error(codes.InvalidArgument, "page must be greater than 0"),
+		},
+		"use-case-error": {
+			req: &gen.ListConversationsRequest{},
+			setExpectations: func(m *chat.MockListConversations) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 200).
+					Return(nil, nil, false, errors.New("database error"))
+			},
+			expectedErr: status.Error(codes.Internal, "internal server error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			listConversations := chat.NewMockListConversations(t)
+			tt.setExpectations(listConversations)
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:                   log.New(io.Discard, "", 0),
+				ListConversationsUseCase: listConversations,
+			})
+
+			got, err := client.ListConversations(t.Context(), tt.req)
+			assertStatusErr(t, tt.expectedErr, err)
+			assertProtoEqual(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGRPCServer_Chat(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	turnID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		req             *gen.ChatRequest
+		setExpectations func(*chat.MockStreamChat)
+		expectedEvents  []*gen.ChatEvent
+		expectedErr     error
+	}{
+		"streams-events": {
+			req: &gen.ChatRequest{Message: "hi", Model: "qwen3", ConversationId: common.Ptr(conversationID.String())},
+			setExpectations: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "hi", "qwen3", mock.Anything, mock.MatchedBy(func(opts []chat.StreamChatOption) bool {
+						p := chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(&p)
+						}
+						return p.ConversationID != nil && *p.ConversationID == conversationID
+					})).
+					RunAndReturn(func(ctx context.Context, _ string, _ string, onEvent assistant.EventCallback, _ ...chat.StreamChatOption) error {
+						if err := onEvent(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{ConversationID: conversationID, TurnID: turnID}); err != nil {
+							return err
+						}
+						return onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hello"})
+					})
+			},
+			expectedEvents: []*gen.ChatEvent{
+				{
+					Type: gen.ChatEventType_CHAT_EVENT_TYPE_TURN_STARTED,
+					Data: `{"conversation_id":"223e4567-e89b-12d3-a456-426614174000","conversation_created":false,"turn_id":"323e4567-e89b-12d3-a456-426614174000"}`,
+				},
+				{
+					Type: gen.ChatEventType_CHAT_EVENT_TYPE_MESSAGE_DELTA,
+					Data: `{"text":"Hello"}`,
+				},
+			},
+		},
+		"turn-failure-ends-stream-after-event": {
+			req: &gen.ChatRequest{Message: "hi", Model: "qwen3"},
+			setExpectations: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "hi", "qwen3", mock.Anything).
+					RunAndReturn(func(ctx context.Context, _ string, _ string, onEvent assistant.EventCallback, _ ...chat.StreamChatOption) error {
+						turnErr := assistant.NewTurnError(assistant.TurnErrorCode_RateLimited, time.Second, errors.New("rate limited"))
+						if err := onEvent(ctx, assistant.EventType_TurnFailed, assistant.TurnFailed{Code: turnErr.Code, Error: "rate limited", Retriable: true}); err != nil {
+							return err
+						}
+						return turnErr
+					})
+			},
+			expectedEvents: []*gen.ChatEvent{
+				{
+					Type: gen.ChatEventType_CHAT_EVENT_TYPE_TURN_FAILED,
+					Data: `{"conversation_id":"00000000-0000-0000-0000-000000000000","turn_id":"00000000-0000-0000-0000-000000000000","code":"rate_limited","error":"rate limited","retriable":true}`,
+				},
+			},
+		},
+		"invalid-conversation-id": {
+			req:             &gen.ChatRequest{Message: "hi", ConversationId: common.Ptr("abc")},
+			setExpectations: func(m *chat.MockStreamChat) {},
+			expectedErr:     status.Error(codes.InvalidArgument, `invalid conversation_id "abc"`),
+		},
+		"validation-error": {
+			req: &gen.ChatRequest{Model: "qwen3"},
+			setExpectations: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "", "qwen3", mock.Anything).
+					Return(core.NewValidationErr("message cannot be empty"))
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "message cannot be empty"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			streamChat := chat.NewMockStreamChat(t)
+			tt.setExpectations(streamChat)
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:            log.New(io.Discard, "", 0),
+				StreamChatUseCase: streamChat,
+			})
+
+			stream, err := client.Chat(t.Context(), tt.req)
+			assert.NoError(t, err)
+
+			var events []*gen.ChatEvent
+			for {
+				event, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					assertStatusErr(t, tt.expectedErr, err)
+					break
+				}
+				events = append(events, event)
+			}
+
+			assert.Len(t, events, len(tt.expectedEvents))
+			for i := range min(len(events), len(tt.expectedEvents)) {
+				assertProtoEqual(t, tt.expectedEvents[i], events[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const defaultShutdownTimeout = 5 * time.Second
+
+var _ gen.TodoAppServiceServer = (*TodoGRPCServer)(nil)
+
+// TodoGRPCServer is the gRPC API server for service-to-service consumers of the TodoApp application.
+type TodoGRPCServer struct {
+	gen.UnimplementedTodoAppServiceServer
+	Port                     int                    `config:"GRPC_SERVER_PORT" default:"50051"`
+	AuthToken                string                 `config:"GRPC_AUTH_TOKEN" default:""`
+	Logger                   *log.Logger            `resolve:""`
+	ListTodosUseCase         todo.List              `resolve:""`
+	CreateTodoUseCase        todo.Create            `resolve:""`
+	UpdateTodoUseCase        todo.Update            `resolve:""`
+	DeleteTodoUseCase        todo.Delete            `resolve:""`
+	ListConversationsUseCase chat.ListConversations `resolve:""`
+	StreamChatUseCase        chat.StreamChat        `resolve:""`
+	TenantDirectory          tenant.Directory       `resolve:""`
+}
+
+// newServer builds the gRPC server with the telemetry, authentication and tenant interceptors shared by all services.
+func (s *TodoGRPCServer) newServer() *grpc.Server {
+	auth := tokenAuth{token: s.AuthToken}
+	tenants := tenantResolver{directory: s.TenantDirectory}
+	svr := grpc.NewServer(
+		grpc.StatsHandler(telemetry.GRPCServerHandler()),
+		grpc.ChainUnaryInterceptor(auth.UnaryInterceptor, tenants.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(auth.StreamInterceptor, tenants.StreamInterceptor),
+	)
+	gen.RegisterTodoAppServiceServer(svr, s)
+	grpc_health_v1.RegisterHealthServer(svr, health.NewServer())
+	return svr
+}
+
+// Run starts the gRPC server for the TodoApp application.
+func (s *TodoGRPCServer) Run(ctx context.Context) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", s.Port, err)
+	}
+
+	svr := s.newServer()
+	if s.AuthToken == "" {
+		s.Logger.Println("TodoGRPCServer: GRPC_AUTH_TOKEN is not set, calls are not authenticated")
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.Logger.Printf("TodoGRPCServer: Listening on port %d", s.Port)
+		errCh <- svr.Serve(lis)
+	}()
+
+	select {
+	case <-ctx.Done():
+		stopped := make(chan struct{})
+		go func() {
+			svr.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+			s.Logger.Println("TodoGRPCServer: stopped")
+		case <-time.After(defaultShutdownTimeout):
+			// Open chat streams would otherwise hold the shutdown until their turn completes.
+			svr.Stop()
+			s.Logger.Println("TodoGRPCServer: stopped after closing open streams")
+		}
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// IsReady verifies the gRPC server is reachable and its health service reports SERVING.
+func (s *TodoGRPCServer) IsReady(ctx context.Context) error {
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", s.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close() //nolint:errcheck
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("unexpected health status: %s", resp.GetStatus())
+	}
+	return nil
+}
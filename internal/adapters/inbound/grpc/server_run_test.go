@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoGRPCServer_Run(t *testing.T) {
+	t.Parallel()
+
+	cancelCtx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	server := &TodoGRPCServer{
+		Port:      19090,
+		AuthToken: "secret",
+		Logger:    log.New(io.Discard, "", 0),
+	}
+
+	shutdownCh := make(chan error, 1)
+	go func() {
+		shutdownCh <- server.Run(cancelCtx)
+	}()
+
+	// Health checks are served without the service token.
+	var err error
+	for range 20 {
+		if err = server.IsReady(cancelCtx); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NoError(t, err)
+
+	cancel()
+
+	select {
+	case err := <-shutdownCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "server did not shut down in time")
+	}
+}
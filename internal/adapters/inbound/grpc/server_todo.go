@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultTodosPageSize = 100
+	maxTodosPageSize     = 500
+)
+
+// ListTodos returns a page of todos with optional filtering and sorting.
+func (s *TodoGRPCServer) ListTodos(ctx context.Context, req *gen.ListTodosRequest) (*gen.ListTodosResponse, error) {
+	page, pageSize, err := pagination(req.GetPage(), req.GetPageSize(), defaultTodosPageSize, maxTodosPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryParams []todouc.ListOptions
+	if status := toDomainStatus(req.GetStatus()); status != nil {
+		queryParams = append(queryParams, todouc.WithStatus(*status))
+	}
+	if req.Search != nil {
+		queryParams = append(queryParams, todouc.WithSearchQuery(req.GetSearch()))
+	}
+	if req.SearchType != nil {
+		queryParams = append(queryParams, todouc.WithSearchType(todouc.SearchType(req.GetSearchType())))
+	}
+	if req.DueAfter != nil || req.DueBefore != nil {
+		dueAfter, err := parseDate("due_after", req.GetDueAfter())
+		if err != nil {
+			return nil, err
+		}
+		dueBefore, err := parseDate("due_before", req.GetDueBefore())
+		if err != nil {
+			return nil, err
+		}
+		queryParams = append(queryParams, todouc.WithDueDateRange(dueAfter, dueBefore))
+	}
+	if req.Sort != nil {
+		queryParams = append(queryParams, todouc.WithSortBy(req.GetSort()))
+	}
+
+	todos, hasMore, err := s.ListTodosUseCase.Query(ctx, page, pageSize, queryParams...)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("TodoGRPCServer: error listing todos: %v", err)
+		return nil, toStatusErr(err)
+	}
+
+	resp := &gen.ListTodosResponse{
+		Items: make([]*gen.Todo, 0, len(todos)),
+		Page:  int32(page),
+	}
+	for _, t := range todos {
+		resp.Items = append(resp.Items, toTodo(t))
+	}
+	if hasMore {
+		resp.NextPage = common.Ptr(int32(page + 1))
+	}
+	return resp, nil
+}
+
+// CreateTodo creates a new todo.
+func (s *TodoGRPCServer) CreateTodo(ctx context.Context, req *gen.CreateTodoRequest) (*gen.Todo, error) {
+	dueDate, err := parseDate("due_date", req.GetDueDate())
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.CreateTodoUseCase.Execute(ctx, req.GetTitle(), dueDate, 0, nil)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("TodoGRPCServer: error creating todo: %v", err)
+		return nil, toStatusErr(err)
+	}
+	return toTodo(created), nil
+}
+
+// UpdateTodo updates the fields set on the request.
+func (s *TodoGRPCServer) UpdateTodo(ctx context.Context, req *gen.UpdateTodoRequest) (*gen.Todo, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	var dueDate *time.Time
+	if req.DueDate != nil {
+		d, err := parseDate("due_date", req.GetDueDate())
+		if err != nil {
+			return nil, err
+		}
+		dueDate = &d
+	}
+
+	updated, err := s.UpdateTodoUseCase.Execute(ctx, id, req.Title, toDomainStatus(req.GetStatus()), dueDate)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("TodoGRPCServer: error updating todo: %v", err)
+		return nil, toStatusErr(err)
+	}
+	return toTodo(updated), nil
+}
+
+// DeleteTodo deletes a todo.
+func (s *TodoGRPCServer) DeleteTodo(ctx context.Context, req *gen.DeleteTodoRequest) (*gen.DeleteTodoResponse, error) {
+	id, err := parseID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DeleteTodoUseCase.Execute(ctx, id); telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		s.Logger.Printf("TodoGRPCServer: error deleting todo: %v", err)
+		return nil, toStatusErr(err)
+	}
+	return &gen.DeleteTodoResponse{}, nil
+}
@@ -0,0 +1,300 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	fixedTime  = time.Date(2026, 1, 22, 10, 30, 0, 0, time.UTC)
+	domainTodo = todo.Todo{
+		ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Title:     "Buy groceries",
+		Status:    todo.Status_DONE,
+		DueDate:   time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC),
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+	grpcTodo = &gen.Todo{
+		Id:        "123e4567-e89b-12d3-a456-426614174000",
+		Title:     "Buy groceries",
+		Status:    gen.TodoStatus_TODO_STATUS_DONE,
+		DueDate:   "2026-01-25",
+		CreatedAt: timestamppb.New(fixedTime),
+		UpdatedAt: timestamppb.New(fixedTime),
+	}
+)
+
+// applyListOptions returns the list parameters set by the options.
+func applyListOptions(opts []todouc.ListOptions) todouc.ListParams {
+	p := todouc.ListParams{}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return p
+}
+
+func TestTodoGRPCServer_ListTodos(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		req             *gen.ListTodosRequest
+		setExpectations func(*todouc.MockList)
+		expected        *gen.ListTodosResponse
+		expectedErr     error
+	}{
+		"defaults": {
+			req: &gen.ListTodosRequest{},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 100).
+					Return([]todo.Todo{domainTodo}, false, nil)
+			},
+			expected: &gen.ListTodosResponse{Items: []*gen.Todo{grpcTodo}, Page: 1},
+		},
+		"filters-and-next-page": {
+			req: &gen.ListTodosRequest{
+				Page:      2,
+				PageSize:  1,
+				Status:    gen.TodoStatus_TODO_STATUS_DONE,
+				Search:    common.Ptr("groceries"),
+				DueAfter:  common.Ptr("2026-01-01"),
+				DueBefore: common.Ptr("2026-01-31"),
+				Sort:      common.Ptr("dueDateAsc"),
+			},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 2, 1, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...todouc.ListOptions) {
+						p := applyListOptions(opts)
+						assert.Equal(t, todo.Status_DONE, *p.Status)
+						assert.Equal(t, "groceries", *p.Search)
+						assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *p.DueAfter)
+						assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), *p.DueBefore)
+						assert.Equal(t, "dueDateAsc", *p.SortBy)
+					}).
+					Return([]todo.Todo{domainTodo}, true, nil)
+			},
+			expected: &gen.ListTodosResponse{Items: []*gen.Todo{grpcTodo}, Page: 2, NextPage: common.Ptr(int32(3))},
+		},
+		"page-size-too-large": {
+			req:             &gen.ListTodosRequest{PageSize: 501},
+			setExpectations: func(m *todouc.MockList) {},
+			expectedErr:     status.Error(codes.InvalidArgument, "page_size must be between 1 and 500"),
+		},
+		"invalid-due-date-range": {
+			req:             &gen.ListTodosRequest{DueAfter: common.Ptr("2026-01-01")},
+			setExpectations: func(m *todouc.MockList) {},
+			expectedErr:     status.Error(codes.InvalidArgument, `invalid due_before "", expected YYYY-MM-DD`),
+		},
+		"use-case-error": {
+			req: &gen.ListTodosRequest{},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 100).
+					Return(nil, false, errors.New("database error"))
+			},
+			expectedErr: status.Error(codes.Internal, "internal server error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			listTodos := todouc.NewMockList(t)
+			tt.setExpectations(listTodos)
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:           log.New(io.Discard, "", 0),
+				ListTodosUseCase: listTodos,
+			})
+
+			got, err := client.ListTodos(t.Context(), tt.req)
+			assertStatusErr(t, tt.expectedErr, err)
+			assertProtoEqual(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGRPCServer_CreateTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		req             *gen.CreateTodoRequest
+		setExpectations func(*todouc.MockCreate)
+		expected        *gen.Todo
+		expectedErr     error
+	}{
+		"success": {
+			req: &gen.CreateTodoRequest{Title: "Buy groceries", DueDate: "2026-01-25"},
+			setExpectations: func(m *todouc.MockCreate) {
+				m.EXPECT().
+					Execute(mock.Anything, "Buy groceries", domainTodo.DueDate, 0, todo.CustomFieldValues(nil)).
+					Return(domainTodo, nil)
+			},
+			expected: grpcTodo,
+		},
+		"invalid-due-date": {
+			req:             &gen.CreateTodoRequest{Title: "Buy groceries", DueDate: "25/01/2026"},
+			setExpectations: func(m *todouc.MockCreate) {},
+			expectedErr:     status.Error(codes.InvalidArgument, `invalid due_date "25/01/2026", expected YYYY-MM-DD`),
+		},
+		"validation-error": {
+			req: &gen.CreateTodoRequest{DueDate: "2026-01-25"},
+			setExpectations: func(m *todouc.MockCreate) {
+				m.EXPECT().
+					Execute(mock.Anything, "", domainTodo.DueDate, 0, todo.CustomFieldValues(nil)).
+					Return(todo.Todo{}, core.NewValidationErr("title cannot be empty"))
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "title cannot be empty"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			createTodo := todouc.NewMockCreate(t)
+			tt.setExpectations(createTodo)
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:            log.New(io.Discard, "", 0),
+				CreateTodoUseCase: createTodo,
+			})
+
+			got, err := client.CreateTodo(t.Context(), tt.req)
+			assertStatusErr(t, tt.expectedErr, err)
+			assertProtoEqual(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGRPCServer_UpdateTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		req             *gen.UpdateTodoRequest
+		setExpectations func(*todouc.MockUpdate)
+		expected        *gen.Todo
+		expectedErr     error
+	}{
+		"update-status": {
+			req: &gen.UpdateTodoRequest{Id: grpcTodo.Id, Status: gen.TodoStatus_TODO_STATUS_DONE},
+			setExpectations: func(m *todouc.MockUpdate) {
+				m.EXPECT().
+					Execute(mock.Anything, domainTodo.ID, (*string)(nil), common.Ptr(todo.Status_DONE), (*time.Time)(nil)).
+					Return(domainTodo, nil)
+			},
+			expected: grpcTodo,
+		},
+		"update-title-and-due-date": {
+			req: &gen.UpdateTodoRequest{Id: grpcTodo.Id, Title: common.Ptr("Buy groceries"), DueDate: common.Ptr("2026-01-25")},
+			setExpectations: func(m *todouc.MockUpdate) {
+				m.EXPECT().
+					Execute(mock.Anything, domainTodo.ID, common.Ptr("Buy groceries"), (*todo.Status)(nil), common.Ptr(domainTodo.DueDate)).
+					Return(domainTodo, nil)
+			},
+			expected: grpcTodo,
+		},
+		"invalid-id": {
+			req:             &gen.UpdateTodoRequest{Id: "not-a-uuid"},
+			setExpectations: func(m *todouc.MockUpdate) {},
+			expectedErr:     status.Error(codes.InvalidArgument, `invalid id "not-a-uuid"`),
+		},
+		"not-found": {
+			req: &gen.UpdateTodoRequest{Id: grpcTodo.Id},
+			setExpectations: func(m *todouc.MockUpdate) {
+				m.EXPECT().
+					Execute(mock.Anything, domainTodo.ID, (*string)(nil), (*todo.Status)(nil), (*time.Time)(nil)).
+					Return(todo.Todo{}, core.NewNotFoundErr("todo not found"))
+			},
+			expectedErr: status.Error(codes.NotFound, "todo not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			updateTodo := todouc.NewMockUpdate(t)
+			tt.setExpectations(updateTodo)
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:            log.New(io.Discard, "", 0),
+				UpdateTodoUseCase: updateTodo,
+			})
+
+			got, err := client.UpdateTodo(t.Context(), tt.req)
+			assertStatusErr(t, tt.expectedErr, err)
+			assertProtoEqual(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTodoGRPCServer_DeleteTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		req             *gen.DeleteTodoRequest
+		setExpectations func(*todouc.MockDelete)
+		expectedErr     error
+	}{
+		"success": {
+			req: &gen.DeleteTodoRequest{Id: grpcTodo.Id},
+			setExpectations: func(m *todouc.MockDelete) {
+				m.EXPECT().Execute(mock.Anything, domainTodo.ID).Return(nil)
+			},
+		},
+		"use-case-error": {
+			req: &gen.DeleteTodoRequest{Id: grpcTodo.Id},
+			setExpectations: func(m *todouc.MockDelete) {
+				m.EXPECT().Execute(mock.Anything, domainTodo.ID).Return(errors.New("database error"))
+			},
+			expectedErr: status.Error(codes.Internal, "internal server error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			deleteTodo := todouc.NewMockDelete(t)
+			tt.setExpectations(deleteTodo)
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:            log.New(io.Discard, "", 0),
+				DeleteTodoUseCase: deleteTodo,
+			})
+
+			_, err := client.DeleteTodo(t.Context(), tt.req)
+			assertStatusErr(t, tt.expectedErr, err)
+		})
+	}
+}
+
+// assertStatusErr compares gRPC status errors by code and message.
+func assertStatusErr(t *testing.T, expected, actual error) {
+	t.Helper()
+	if expected == nil {
+		assert.NoError(t, actual)
+		return
+	}
+	assert.Equal(t, status.Code(expected), status.Code(actual))
+	assert.Equal(t, status.Convert(expected).Message(), status.Convert(actual).Message())
+}
+
+// assertProtoEqual compares protobuf messages, which cannot be compared with assert.Equal.
+func assertProtoEqual(t *testing.T, expected, actual proto.Message) {
+	t.Helper()
+	assert.True(t, proto.Equal(expected, actual), "expected %v, got %v", expected, actual)
+}
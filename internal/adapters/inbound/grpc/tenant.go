@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// tenantMetadataKey picks the tenant on hosts no tenant claims. On a tenant's own :authority host it may only
+// name that tenant.
+const tenantMetadataKey = "x-tenant-id"
+
+// tenantResolver scopes calls to the tenant resolved from the "x-tenant-id" metadata or the
+// :authority host. Health checks are left unscoped so probes can reach them.
+type tenantResolver struct {
+	directory tenant.Directory
+}
+
+// UnaryInterceptor scopes unary calls to their tenant.
+func (tr tenantResolver) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := tr.resolve(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor scopes streaming calls to their tenant.
+func (tr tenantResolver) StreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := tr.resolve(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, tenantServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (tr tenantResolver) resolve(ctx context.Context, fullMethod string) (context.Context, error) {
+	if tr.directory == nil || strings.HasPrefix(fullMethod, "/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/") {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var host string
+	var requested tenant.ID
+	if values := md.Get(":authority"); len(values) > 0 {
+		host = values[0]
+	}
+	if values := md.Get(tenantMetadataKey); len(values) > 0 {
+		requested = tenant.ID(strings.TrimSpace(values[0]))
+	}
+
+	t, err := tr.directory.Resolve(ctx, host, requested)
+	if err != nil {
+		return ctx, toStatusErr(err)
+	}
+	return tenant.NewContext(ctx, t), nil
+}
+
+// tenantServerStream overrides the context of a server stream with the tenant-scoped one.
+type tenantServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the tenant-scoped context of the stream.
+func (s tenantServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tenantID       string
+		setExpectation func(*tenant.MockDirectory)
+		expectedErr    error
+	}{
+		"resolves-by-metadata": {
+			tenantID: "acme",
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "bufnet", tenant.ID("acme")).Return(tenant.Tenant{ID: "acme"}, nil)
+			},
+		},
+		"resolves-by-authority": {
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "bufnet", tenant.ID("")).Return(tenant.Tenant{ID: "acme"}, nil)
+			},
+		},
+		"unknown-tenant": {
+			tenantID: "initech",
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "bufnet", tenant.ID("initech")).
+					Return(tenant.Tenant{}, core.NewNotFoundErr("tenant not found"))
+			},
+			expectedErr: status.Error(codes.NotFound, "tenant not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			directory := tenant.NewMockDirectory(t)
+			tt.setExpectation(directory)
+
+			isAcme := mock.MatchedBy(func(ctx context.Context) bool {
+				return tenant.IDFromContext(ctx) == "acme"
+			})
+			listTodos := todouc.NewMockList(t)
+			streamChat := chat.NewMockStreamChat(t)
+			if tt.expectedErr == nil {
+				listTodos.EXPECT().Query(isAcme, 1, 100).Return([]todo.Todo{}, false, nil)
+				streamChat.EXPECT().Execute(isAcme, "hi", "", mock.Anything).Return(nil)
+			}
+
+			client := newTestClient(t, &TodoGRPCServer{
+				Logger:            log.New(io.Discard, "", 0),
+				ListTodosUseCase:  listTodos,
+				StreamChatUseCase: streamChat,
+				TenantDirectory:   directory,
+			})
+
+			ctx := t.Context()
+			if tt.tenantID != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, tenantMetadataKey, tt.tenantID)
+			}
+
+			// Unary and streaming calls are scoped the same way.
+			_, err := client.ListTodos(ctx, &gen.ListTodosRequest{})
+			assertStatusErr(t, tt.expectedErr, err)
+
+			stream, err := client.Chat(ctx, &gen.ChatRequest{Message: "hi"})
+			assert.NoError(t, err)
+			_, err = stream.Recv()
+			if tt.expectedErr != nil {
+				assertStatusErr(t, tt.expectedErr, err)
+			} else {
+				assert.ErrorIs(t, err, io.EOF)
+			}
+		})
+	}
+}
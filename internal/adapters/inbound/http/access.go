@@ -0,0 +1,172 @@
+package http
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
+	"github.com/google/uuid"
+)
+
+// publicRoutes are authenticated by other means than API tokens, such as webhook signatures, refresh tokens
+// or identity provider logins.
+var publicRoutes = map[string]bool{
+	"POST /api/v1/inbound/webhooks/{source}": true,
+	"POST /api/v1/sessions/refresh":          true,
+	"GET /api/v1/auth/oidc/login":            true,
+	"GET /api/v1/auth/oidc/callback":         true,
+}
+
+// readonlyRoutes are the routes, besides reads, that readonly principals may call. Chatting does not
+// change todos by itself: the assistant only gets read actions for readonly principals, and previews discard
+// every change. Every principal may manage its own sessions, tokens and read cursors.
+var readonlyRoutes = map[string]bool{
+	"POST /api/v1/chat":                                 true,
+	"POST /api/v1/chat/preview":                         true,
+	"POST /api/v1/chat/approvals":                       true,
+	"PUT /api/v1/chat/messages/{message_id}/feedback":   true,
+	"POST /api/v1/conversations/{conversation_id}/read": true,
+	"POST /api/v1/sessions":                             true,
+	"DELETE /api/v1/sessions/{session_id}":              true,
+	"POST /api/v1/tokens":                               true,
+	"DELETE /api/v1/tokens/{token_id}":                  true,
+}
+
+// credentialRoutes manage sessions and personal access tokens, which personal access tokens may not call
+// so a leaked token cannot be turned into other credentials.
+var credentialRoutes = map[string]bool{
+	"GET /api/v1/sessions":                 true,
+	"POST /api/v1/sessions":                true,
+	"DELETE /api/v1/sessions/{session_id}": true,
+	"GET /api/v1/tokens":                   true,
+	"POST /api/v1/tokens":                  true,
+	"DELETE /api/v1/tokens/{token_id}":     true,
+}
+
+// adminRoutes are the routes, besides the admin endpoints, that only admin principals may call.
+// Background jobs post messages the assistant takes as its own.
+var adminRoutes = map[string]bool{
+	"POST /api/v1/conversations/{conversation_id}/system-messages": true,
+}
+
+// routeRole returns the role required to call the route of the request, or "" when the route is public.
+// Reads need the readonly role and every other operation needs the member role, unless it is an admin route.
+func routeRole(r *http.Request) access.Role {
+	switch {
+	case publicRoutes[r.Pattern]:
+		return ""
+	case adminRoutes[r.Pattern]:
+		return access.RoleAdmin
+	case r.Method == http.MethodGet || r.Method == http.MethodHead || readonlyRoutes[r.Pattern]:
+		return access.RoleReadonly
+	default:
+		return access.RoleMember
+	}
+}
+
+// routeScope returns the scope a personal access token needs to call the route of the request, or "" when
+// tokens may not call it. Chat and conversation routes need the chat scope, reads need todos:read and every
+// other operation needs todos:write.
+func routeScope(r *http.Request) access.Scope {
+	_, path, _ := strings.Cut(r.Pattern, " ")
+	switch {
+	case credentialRoutes[r.Pattern]:
+		return ""
+	case strings.HasPrefix(path, "/api/v1/chat") || strings.HasPrefix(path, "/api/v1/conversations"):
+		return access.ScopeChat
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return access.ScopeTodosRead
+	default:
+		return access.ScopeTodosWrite
+	}
+}
+
+// checkScope rejects principals authenticated with a personal access token that lacks the scope of the route.
+// A todos:write token may also read.
+func checkScope(r *http.Request, principal access.Principal) error {
+	if principal.TokenID == uuid.Nil {
+		return nil
+	}
+	scope := routeScope(r)
+	if scope == "" {
+		return core.NewForbiddenErr("personal access tokens may not manage sessions or tokens")
+	}
+	if principal.HasScope(scope) || (scope == access.ScopeTodosRead && principal.HasScope(access.ScopeTodosWrite)) {
+		return nil
+	}
+	return core.NewForbiddenErr(fmt.Sprintf("token lacks the %s scope", scope))
+}
+
+// adminRole requires the admin role on every route.
+func adminRole(*http.Request) access.Role {
+	return access.RoleAdmin
+}
+
+// accessMiddleware runs each request on behalf of the principal presenting the bearer token and rejects
+// requests whose principal is bound to another tenant, lacks the role returned by required, or whose personal
+// access token lacks the scope of the route. Public routes run on behalf of a personal access token when one
+// is presented, so integrations may use tokens instead of webhook secrets. It is a no-op when principals are
+// not enabled.
+func accessMiddleware(authenticator access.Authenticator, required func(*http.Request) access.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if authenticator == nil || !authenticator.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role := required(r)
+			token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if role == "" && !strings.HasPrefix(token, session.PersonalAccessTokenPrefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := authenticator.Authenticate(r.Context(), token)
+			if err != nil {
+				respondError(w, toError(err))
+				return
+			}
+			if tenantID := tenant.IDFromContext(r.Context()); !principal.AllowsTenant(tenantID) {
+				respondError(w, toError(core.NewForbiddenErr(fmt.Sprintf("principal %q may not act in tenant %q", principal.Name, tenantID))))
+				return
+			}
+			if role != "" && !principal.Role.Allows(role) {
+				respondError(w, toError(core.NewForbiddenErr(fmt.Sprintf("%s role may not perform this operation", principal.Role))))
+				return
+			}
+			if err := checkScope(r, principal); err != nil {
+				respondError(w, toError(err))
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(access.NewContext(r.Context(), principal)))
+		})
+	}
+}
+
+// adminTokenMiddleware rejects requests that do not send token as a bearer token. It guards the admin
+// endpoints when API principals are not enabled, and is a no-op when token is empty.
+func adminTokenMiddleware(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if token == "" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				respondError(w, gen.ErrorResp{
+					Error: gen.Error{
+						Code:    gen.UNAUTHORIZED,
+						Message: "missing or invalid admin token",
+					},
+				})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
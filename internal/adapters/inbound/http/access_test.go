@@ -0,0 +1,373 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRouteRole(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method  string
+		pattern string
+		want    access.Role
+	}{
+		"read":               {method: http.MethodGet, pattern: "GET /api/v1/todos", want: access.RoleReadonly},
+		"chat":               {method: http.MethodPost, pattern: "POST /api/v1/chat", want: access.RoleReadonly},
+		"chat-preview":       {method: http.MethodPost, pattern: "POST /api/v1/chat/preview", want: access.RoleReadonly},
+		"feedback":           {method: http.MethodPut, pattern: "PUT /api/v1/chat/messages/{message_id}/feedback", want: access.RoleReadonly},
+		"create-todo":        {method: http.MethodPost, pattern: "POST /api/v1/todos", want: access.RoleMember},
+		"delete-todo":        {method: http.MethodDelete, pattern: "DELETE /api/v1/todos/{todo_id}", want: access.RoleMember},
+		"update-convo":       {method: http.MethodPatch, pattern: "PATCH /api/v1/conversations/{conversation_id}", want: access.RoleMember},
+		"replay-turn":        {method: http.MethodPost, pattern: "POST /api/v1/conversations/{conversation_id}/turns/{turn_id}/replay", want: access.RoleMember},
+		"regenerate-message": {method: http.MethodPost, pattern: "POST /api/v1/conversations/{conversation_id}/messages/{message_id}/regenerate", want: access.RoleMember},
+		"edit-message":       {method: http.MethodPost, pattern: "POST /api/v1/conversations/{conversation_id}/messages/{message_id}/edit", want: access.RoleMember},
+		"system-message":     {method: http.MethodPost, pattern: "POST /api/v1/conversations/{conversation_id}/system-messages", want: access.RoleAdmin},
+		"inbound-webhook":    {method: http.MethodPost, pattern: "POST /api/v1/inbound/webhooks/{source}", want: ""},
+		"start-session":      {method: http.MethodPost, pattern: "POST /api/v1/sessions", want: access.RoleReadonly},
+		"revoke-session":     {method: http.MethodDelete, pattern: "DELETE /api/v1/sessions/{session_id}", want: access.RoleReadonly},
+		"refresh-session":    {method: http.MethodPost, pattern: "POST /api/v1/sessions/refresh", want: ""},
+		"create-token":       {method: http.MethodPost, pattern: "POST /api/v1/tokens", want: access.RoleReadonly},
+		"revoke-token":       {method: http.MethodDelete, pattern: "DELETE /api/v1/tokens/{token_id}", want: access.RoleReadonly},
+		"oidc-login":         {method: http.MethodGet, pattern: "GET /api/v1/auth/oidc/login", want: ""},
+		"oidc-callback":      {method: http.MethodGet, pattern: "GET /api/v1/auth/oidc/callback", want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Pattern = tt.pattern
+			assert.Equal(t, tt.want, routeRole(req))
+		})
+	}
+}
+
+func TestRouteScope(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method  string
+		pattern string
+		want    access.Scope
+	}{
+		"read":            {method: http.MethodGet, pattern: "GET /api/v1/todos", want: access.ScopeTodosRead},
+		"create-todo":     {method: http.MethodPost, pattern: "POST /api/v1/todos", want: access.ScopeTodosWrite},
+		"inbound-webhook": {method: http.MethodPost, pattern: "POST /api/v1/inbound/webhooks/{source}", want: access.ScopeTodosWrite},
+		"chat":            {method: http.MethodPost, pattern: "POST /api/v1/chat", want: access.ScopeChat},
+		"list-messages":   {method: http.MethodGet, pattern: "GET /api/v1/chat/messages", want: access.ScopeChat},
+		"update-convo":    {method: http.MethodPatch, pattern: "PATCH /api/v1/conversations/{conversation_id}", want: access.ScopeChat},
+		"list-sessions":   {method: http.MethodGet, pattern: "GET /api/v1/sessions", want: ""},
+		"create-token":    {method: http.MethodPost, pattern: "POST /api/v1/tokens", want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Pattern = tt.pattern
+			assert.Equal(t, tt.want, routeScope(req))
+		})
+	}
+}
+
+func TestAccessMiddleware(t *testing.T) {
+	t.Parallel()
+
+	viewer := access.Principal{Name: "viewer", Role: access.RoleReadonly}
+	ops := access.Principal{Name: "ops", Role: access.RoleAdmin}
+	tokenID := uuid.MustParse("623e4567-e89b-12d3-a456-426614174000")
+	reader := access.Principal{Name: "ci", Role: access.RoleMember, TokenID: tokenID, Scopes: []access.Scope{access.ScopeTodosRead}}
+	writer := access.Principal{Name: "ci", Role: access.RoleMember, TokenID: tokenID, Scopes: []access.Scope{access.ScopeTodosWrite}}
+
+	tests := map[string]struct {
+		method            string
+		pattern           string
+		authHeader        string
+		required          func(*http.Request) access.Role
+		setExpectation    func(*access.MockAuthenticator)
+		nilAuthenticator  bool
+		tenant            tenant.ID
+		expectedStatus    int
+		expectedPrincipal access.Principal
+	}{
+		"readonly-reads": {
+			method:     http.MethodGet,
+			pattern:    "GET /api/v1/todos",
+			authHeader: "Bearer viewer-token",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "viewer-token").Return(viewer, nil).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: viewer,
+		},
+		"principal-of-another-tenant": {
+			method:     http.MethodGet,
+			pattern:    "GET /api/v1/todos",
+			authHeader: "Bearer acme-token",
+			required:   routeRole,
+			tenant:     "globex",
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "acme-token").
+					Return(access.Principal{Name: "acme-ci", Role: access.RoleAdmin, Tenant: "acme"}, nil).
+					Once()
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		"principal-of-its-tenant": {
+			method:     http.MethodGet,
+			pattern:    "GET /api/v1/todos",
+			authHeader: "Bearer acme-token",
+			required:   routeRole,
+			tenant:     "acme",
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "acme-token").
+					Return(access.Principal{Name: "acme-ci", Role: access.RoleMember, Tenant: "acme"}, nil).
+					Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: access.Principal{Name: "acme-ci", Role: access.RoleMember, Tenant: "acme"},
+		},
+		"readonly-deletes": {
+			method:     http.MethodDelete,
+			pattern:    "DELETE /api/v1/todos/{todo_id}",
+			authHeader: "Bearer viewer-token",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "viewer-token").Return(viewer, nil).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		"admin-endpoint": {
+			method:     http.MethodGet,
+			pattern:    "GET /admin/experiments",
+			authHeader: "Bearer ops-token",
+			required:   adminRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "ops-token").Return(ops, nil).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: ops,
+		},
+		"token-with-scope": {
+			method:     http.MethodGet,
+			pattern:    "GET /api/v1/todos",
+			authHeader: "Bearer pat_reader",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "pat_reader").Return(reader, nil).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: reader,
+		},
+		"write-token-reads": {
+			method:     http.MethodGet,
+			pattern:    "GET /api/v1/todos",
+			authHeader: "Bearer pat_writer",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "pat_writer").Return(writer, nil).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: writer,
+		},
+		"token-without-scope": {
+			method:     http.MethodPost,
+			pattern:    "POST /api/v1/todos",
+			authHeader: "Bearer pat_reader",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "pat_reader").Return(reader, nil).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		"token-manages-tokens": {
+			method:     http.MethodPost,
+			pattern:    "POST /api/v1/tokens",
+			authHeader: "Bearer pat_writer",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "pat_writer").Return(writer, nil).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		"public-route-with-token": {
+			method:     http.MethodPost,
+			pattern:    "POST /api/v1/inbound/webhooks/{source}",
+			authHeader: "Bearer pat_writer",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "pat_writer").Return(writer, nil).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: writer,
+		},
+		"public-route-with-revoked-token": {
+			method:     http.MethodPost,
+			pattern:    "POST /api/v1/inbound/webhooks/{source}",
+			authHeader: "Bearer pat_revoked",
+			required:   routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "pat_revoked").
+					Return(access.Principal{}, core.NewUnauthorizedErr("invalid or revoked personal access token")).Once()
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"invalid-token": {
+			method:   http.MethodGet,
+			pattern:  "GET /api/v1/todos",
+			required: routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+				a.EXPECT().Authenticate(mock.Anything, "").
+					Return(access.Principal{}, core.NewUnauthorizedErr("missing or invalid API token")).Once()
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"public-route": {
+			method:   http.MethodPost,
+			pattern:  "POST /api/v1/inbound/webhooks/{source}",
+			required: routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(true).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: access.System,
+		},
+		"principals-disabled": {
+			method:   http.MethodDelete,
+			pattern:  "DELETE /api/v1/todos/{todo_id}",
+			required: routeRole,
+			setExpectation: func(a *access.MockAuthenticator) {
+				a.EXPECT().Enabled().Return(false).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: access.System,
+		},
+		"no-authenticator": {
+			method:            http.MethodDelete,
+			pattern:           "DELETE /api/v1/todos/{todo_id}",
+			required:          routeRole,
+			nilAuthenticator:  true,
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: access.System,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var authenticator access.Authenticator
+			if !tt.nilAuthenticator {
+				a := access.NewMockAuthenticator(t)
+				tt.setExpectation(a)
+				authenticator = a
+			}
+
+			var gotPrincipal access.Principal
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPrincipal = access.FromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Pattern = tt.pattern
+			if tt.tenant != "" {
+				req = req.WithContext(tenant.WithID(req.Context(), tt.tenant))
+			}
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			rec := httptest.NewRecorder()
+			accessMiddleware(authenticator, tt.required)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedPrincipal, gotPrincipal)
+			}
+		})
+	}
+}
+
+func TestAdminTokenMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		token          string
+		authHeader     string
+		expectedStatus int
+		expectedBody   string
+	}{
+		"valid-token": {
+			token:          "secret",
+			authHeader:     "Bearer secret",
+			expectedStatus: http.StatusOK,
+		},
+		"missing-token": {
+			token:          "secret",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"wrong-token": {
+			token:          "secret",
+			authHeader:     "Bearer nope",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"not-a-bearer-token": {
+			token:          "secret",
+			authHeader:     "secret",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"no-token-configured": {
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/experiments", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			rec := httptest.NewRecorder()
+			adminTokenMiddleware(tt.token)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
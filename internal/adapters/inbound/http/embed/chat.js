@@ -0,0 +1,104 @@
+// Todo app chat widget loader. Embed it with:
+//
+//   <script src="https://todo.example.com/v1/embed/chat.js"
+//           data-api-key="..." data-theme="dark" data-conversation-id="..." async></script>
+//
+// It adds a floating button that opens the chat host page in an iframe. Supported attributes:
+// data-api-key, data-theme (light or dark), data-accent (hex color), data-title, data-greeting,
+// data-model, data-conversation-id, data-position (right or left) and data-open ("true" opens the
+// chat on load). window.TodoAppChat exposes open(), close() and toggle(), and the page receives a
+// "todoapp-chat:conversation" message with the conversation id once a conversation started, so it
+// can pass it back as data-conversation-id later.
+(function () {
+  'use strict';
+
+  if (window.TodoAppChat) {
+    return;
+  }
+
+  var script = document.currentScript;
+  if (!script || !script.src) {
+    return;
+  }
+  var origin = new URL(script.src).origin;
+  var data = script.dataset;
+
+  var params = new URLSearchParams();
+  ['theme', 'accent', 'title', 'greeting', 'model'].forEach(function (name) {
+    if (data[name]) {
+      params.set(name, data[name]);
+    }
+  });
+  if (data.conversationId) {
+    params.set('conversation_id', data.conversationId);
+  }
+  // The host page only accepts messages posted to its own origin.
+  params.set('parent_origin', window.location.origin);
+  // The API key travels in the fragment so it never reaches server logs.
+  var fragment = data.apiKey ? '#api_key=' + encodeURIComponent(data.apiKey) : '';
+
+  var side = data.position === 'left' ? 'left' : 'right';
+  var accent = /^#[0-9a-fA-F]{3,8}$/.test(data.accent || '') ? data.accent : '#2563eb';
+
+  var frame = document.createElement('iframe');
+  frame.title = data.title || 'Assistant';
+  frame.src = origin + '/v1/embed/chat?' + params.toString() + fragment;
+  frame.setAttribute('allow', 'clipboard-write');
+  frame.style.cssText =
+    'position:fixed;bottom:88px;' + side + ':20px;width:380px;max-width:calc(100vw - 40px);' +
+    'height:560px;max-height:calc(100vh - 108px);border:0;border-radius:12px;' +
+    'box-shadow:0 8px 32px rgba(0,0,0,.25);z-index:2147483000;display:none;background:transparent;';
+
+  var button = document.createElement('button');
+  button.type = 'button';
+  button.setAttribute('aria-label', 'Open assistant chat');
+  button.textContent = '\u{1F4AC}';
+  button.style.cssText =
+    'position:fixed;bottom:20px;' + side + ':20px;width:56px;height:56px;border:0;border-radius:50%;' +
+    'background:' + accent + ';color:#fff;font-size:24px;cursor:pointer;z-index:2147483000;' +
+    'box-shadow:0 4px 16px rgba(0,0,0,.25);';
+
+  var opened = false;
+  function setOpen(open) {
+    opened = open;
+    frame.style.display = open ? 'block' : 'none';
+    button.setAttribute('aria-label', open ? 'Close assistant chat' : 'Open assistant chat');
+  }
+  button.addEventListener('click', function () {
+    setOpen(!opened);
+  });
+
+  window.addEventListener('message', function (event) {
+    if (event.origin !== origin || event.source !== frame.contentWindow || !event.data) {
+      return;
+    }
+    if (event.data.type === 'todoapp-chat:close') {
+      setOpen(false);
+    }
+  });
+
+  window.TodoAppChat = {
+    open: function () {
+      setOpen(true);
+    },
+    close: function () {
+      setOpen(false);
+    },
+    toggle: function () {
+      setOpen(!opened);
+    },
+  };
+
+  function mount() {
+    document.body.appendChild(frame);
+    document.body.appendChild(button);
+    if (data.open === 'true') {
+      setOpen(true);
+    }
+  }
+  if (document.body) {
+    mount();
+  } else {
+    document.addEventListener('DOMContentLoaded', mount);
+  }
+})();
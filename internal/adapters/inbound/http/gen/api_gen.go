@@ -44,8 +44,14 @@ const (
 
 // Defines values for ChatMessageActionDetailMessageState.
 const (
-	COMPLETED ChatMessageActionDetailMessageState = "COMPLETED"
-	FAILED    ChatMessageActionDetailMessageState = "FAILED"
+	ChatMessageActionDetailMessageStateCOMPLETED ChatMessageActionDetailMessageState = "COMPLETED"
+	ChatMessageActionDetailMessageStateFAILED    ChatMessageActionDetailMessageState = "FAILED"
+)
+
+// Defines values for CommentAuthor.
+const (
+	ASSISTANT CommentAuthor = "ASSISTANT"
+	USER      CommentAuthor = "USER"
 )
 
 // Defines values for ConversationTitleSource.
@@ -55,11 +61,68 @@ const (
 	ConversationTitleSourceUser ConversationTitleSource = "user"
 )
 
+// Defines values for CustomFieldType.
+const (
+	BOOLEAN CustomFieldType = "BOOLEAN"
+	DATE    CustomFieldType = "DATE"
+	ENUM    CustomFieldType = "ENUM"
+	NUMBER  CustomFieldType = "NUMBER"
+	TEXT    CustomFieldType = "TEXT"
+)
+
 // Defines values for ErrorCode.
 const (
-	BADREQUEST    ErrorCode = "BAD_REQUEST"
-	INTERNALERROR ErrorCode = "INTERNAL_ERROR"
-	NOTFOUND      ErrorCode = "NOT_FOUND"
+	BADREQUEST         ErrorCode = "BAD_REQUEST"
+	FORBIDDEN          ErrorCode = "FORBIDDEN"
+	INTERNALERROR      ErrorCode = "INTERNAL_ERROR"
+	NOTFOUND           ErrorCode = "NOT_FOUND"
+	SERVICEUNAVAILABLE ErrorCode = "SERVICE_UNAVAILABLE"
+	UNAUTHORIZED       ErrorCode = "UNAUTHORIZED"
+)
+
+// Defines values for MemoryKind.
+const (
+	Fact       MemoryKind = "fact"
+	Preference MemoryKind = "preference"
+)
+
+// Defines values for QuickAddRespSource.
+const (
+	LLM    QuickAddRespSource = "LLM"
+	PARSER QuickAddRespSource = "PARSER"
+)
+
+// Defines values for SubmitMessageFeedbackRequestScore.
+const (
+	Minus1 SubmitMessageFeedbackRequestScore = -1
+	N1     SubmitMessageFeedbackRequestScore = 1
+)
+
+// Defines values for SyncEntity.
+const (
+	CONVERSATION SyncEntity = "CONVERSATION"
+	TODO         SyncEntity = "TODO"
+)
+
+// Defines values for SyncMutationStatus.
+const (
+	SyncMutationStatusAPPLIED  SyncMutationStatus = "APPLIED"
+	SyncMutationStatusCONFLICT SyncMutationStatus = "CONFLICT"
+	SyncMutationStatusFAILED   SyncMutationStatus = "FAILED"
+)
+
+// Defines values for SyncOperation.
+const (
+	CREATE SyncOperation = "CREATE"
+	DELETE SyncOperation = "DELETE"
+	UPDATE SyncOperation = "UPDATE"
+)
+
+// Defines values for TodoChangeEventType.
+const (
+	TODOCREATED TodoChangeEventType = "TODO_CREATED"
+	TODODELETED TodoChangeEventType = "TODO_DELETED"
+	TODOUPDATED TodoChangeEventType = "TODO_UPDATED"
 )
 
 // Defines values for TodoStatus.
@@ -68,6 +131,56 @@ const (
 	OPEN TodoStatus = "OPEN"
 )
 
+// Defines values for TokenScope.
+const (
+	Chat       TokenScope = "chat"
+	TodosRead  TokenScope = "todos:read"
+	TodosWrite TokenScope = "todos:write"
+)
+
+// Defines values for TurnTranscriptStepApprovalStatus.
+const (
+	APPROVED     TurnTranscriptStepApprovalStatus = "APPROVED"
+	AUTOREJECTED TurnTranscriptStepApprovalStatus = "AUTO_REJECTED"
+	EXPIRED      TurnTranscriptStepApprovalStatus = "EXPIRED"
+	PENDING      TurnTranscriptStepApprovalStatus = "PENDING"
+	REJECTED     TurnTranscriptStepApprovalStatus = "REJECTED"
+)
+
+// Defines values for TurnTranscriptStepMessageState.
+const (
+	COMPLETED TurnTranscriptStepMessageState = "COMPLETED"
+	FAILED    TurnTranscriptStepMessageState = "FAILED"
+	MODERATED TurnTranscriptStepMessageState = "MODERATED"
+	STREAMING TurnTranscriptStepMessageState = "STREAMING"
+)
+
+// Defines values for TurnTranscriptStepRole.
+const (
+	Assistant TurnTranscriptStepRole = "assistant"
+	Developer TurnTranscriptStepRole = "developer"
+	System    TurnTranscriptStepRole = "system"
+	Tool      TurnTranscriptStepRole = "tool"
+	User      TurnTranscriptStepRole = "user"
+)
+
+// Defines values for ViewFilterSortBy.
+const (
+	ViewFilterSortByCreatedAtAsc   ViewFilterSortBy = "createdAtAsc"
+	ViewFilterSortByCreatedAtDesc  ViewFilterSortBy = "createdAtDesc"
+	ViewFilterSortByDueDateAsc     ViewFilterSortBy = "dueDateAsc"
+	ViewFilterSortByDueDateDesc    ViewFilterSortBy = "dueDateDesc"
+	ViewFilterSortBySimilarityAsc  ViewFilterSortBy = "similarityAsc"
+	ViewFilterSortBySimilarityDesc ViewFilterSortBy = "similarityDesc"
+	ViewFilterSortBySmartOrder     ViewFilterSortBy = "smartOrder"
+)
+
+// Defines values for GetBoardSnapshotParamsFormat.
+const (
+	Json     GetBoardSnapshotParamsFormat = "json"
+	Markdown GetBoardSnapshotParamsFormat = "markdown"
+)
+
 // Defines values for ListTodosParamsSearchType.
 const (
 	SIMILARITY ListTodosParamsSearchType = "SIMILARITY"
@@ -76,17 +189,36 @@ const (
 
 // Defines values for ListTodosParamsSort.
 const (
-	CreatedAtAsc   ListTodosParamsSort = "createdAtAsc"
-	CreatedAtDesc  ListTodosParamsSort = "createdAtDesc"
-	DueDateAsc     ListTodosParamsSort = "dueDateAsc"
-	DueDateDesc    ListTodosParamsSort = "dueDateDesc"
-	SimilarityAsc  ListTodosParamsSort = "similarityAsc"
-	SimilarityDesc ListTodosParamsSort = "similarityDesc"
+	ListTodosParamsSortCreatedAtAsc   ListTodosParamsSort = "createdAtAsc"
+	ListTodosParamsSortCreatedAtDesc  ListTodosParamsSort = "createdAtDesc"
+	ListTodosParamsSortDueDateAsc     ListTodosParamsSort = "dueDateAsc"
+	ListTodosParamsSortDueDateDesc    ListTodosParamsSort = "dueDateDesc"
+	ListTodosParamsSortSimilarityAsc  ListTodosParamsSort = "similarityAsc"
+	ListTodosParamsSortSimilarityDesc ListTodosParamsSort = "similarityDesc"
+	ListTodosParamsSortSmartOrder     ListTodosParamsSort = "smartOrder"
 )
 
+// AcceptProjectSuggestionRequest Request payload for accepting a project suggestion.
+type AcceptProjectSuggestionRequest struct {
+	// Name Project name replacing the suggested one.
+	Name *string `json:"name,omitempty"`
+}
+
 // ActionApprovalStatus Human approval decision status for a requested action execution.
 type ActionApprovalStatus string
 
+// ApplyTemplateRequest Request payload for applying a template.
+type ApplyTemplateRequest struct {
+	// AnchorDate Date the item offsets are counted from. Defaults to today.
+	AnchorDate *openapi_types.Date `json:"anchor_date,omitempty"`
+}
+
+// ApplyTemplateResp The todos created from a template.
+type ApplyTemplateResp struct {
+	// Items Created todos, in template order.
+	Items []Todo `json:"items"`
+}
+
 // AvailableSkill Skill metadata displayed for slash-command selection.
 type AvailableSkill struct {
 	// Aliases Hidden slash aliases that map to this canonical skill.
@@ -105,6 +237,28 @@ type AvailableSkill struct {
 	Tools []string `json:"tools"`
 }
 
+// BoardSnapshot Immutable copy of a filtered todo list and the board summary current when it was taken.
+type BoardSnapshot struct {
+	// CreatedAt Timestamp when the snapshot was taken.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filter Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today.
+	Filter ViewFilter `json:"filter"`
+
+	// Markdown The snapshot rendered as a Markdown document.
+	Markdown string        `json:"markdown"`
+	Summary  *BoardSummary `json:"summary,omitempty"`
+
+	// Title Snapshot title.
+	Title string `json:"title"`
+
+	// Todos Todos matching the filter when the snapshot was taken.
+	Todos []Todo `json:"todos"`
+
+	// Token Share token of the snapshot.
+	Token string `json:"token"`
+}
+
 // BoardSummary defines model for BoardSummary.
 type BoardSummary struct {
 	// Counts Count of todos per status.
@@ -147,10 +301,22 @@ type ChatMessage struct {
 	ActionExecuted *bool                      `json:"action_executed"`
 	Content        string                     `json:"content"`
 	CreatedAt      time.Time                  `json:"created_at"`
-	Id             openapi_types.UUID         `json:"id"`
-	Role           ChatMessageRole            `json:"role"`
-	SelectedSkills *[]SelectedSkill           `json:"selected_skills,omitempty"`
-	TurnId         *openapi_types.UUID        `json:"turn_id,omitempty"`
+
+	// FeedbackScore User rating of an assistant response, -1 or 1.
+	FeedbackScore *int               `json:"feedback_score"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// Moderated True when content moderation blocked the user message; it never reached the assistant.
+	Moderated *bool           `json:"moderated,omitempty"`
+	Role      ChatMessageRole `json:"role"`
+
+	// Seed Sampling seed the assistant message was generated with.
+	Seed           *int64           `json:"seed"`
+	SelectedSkills *[]SelectedSkill `json:"selected_skills,omitempty"`
+
+	// SupersededAt Set when a regenerated response of the turn, or an edit of an earlier user message, replaced this message. Superseded messages are kept so every version of a turn stays retrievable.
+	SupersededAt *time.Time          `json:"superseded_at"`
+	TurnId       *openapi_types.UUID `json:"turn_id,omitempty"`
 }
 
 // ChatMessageRole defines model for ChatMessage.Role.
@@ -168,7 +334,10 @@ type ChatMessageActionDetail struct {
 	MessageState           ChatMessageActionDetailMessageState    `json:"message_state"`
 	Name                   string                                 `json:"name"`
 	Output                 string                                 `json:"output"`
-	Text                   string                                 `json:"text"`
+
+	// OutputRef Hash of the stored whole output when output only holds a preview of it. Fetch it with GET /api/v1/chat/blobs/{hash}.
+	OutputRef *string `json:"output_ref"`
+	Text      string  `json:"text"`
 }
 
 // ChatMessageActionDetailApprovalStatus defines model for ChatMessageActionDetail.ApprovalStatus.
@@ -182,11 +351,72 @@ type ChatStreamRequest struct {
 	// ConversationId Identifier for the conversation. For this API, it should always be "global".
 	ConversationId *openapi_types.UUID `json:"conversation_id"`
 
+	// FrequencyPenalty Penalizes tokens proportionally to how often they already appeared.
+	FrequencyPenalty *float64 `json:"frequency_penalty,omitempty"`
+
+	// MaxTokens Upper bound on the tokens generated for the assistant response. Must not exceed the model's max_output_tokens.
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
 	// Message User message to send to the AI assistant.
 	Message string `json:"message"`
 
 	// Model AI model to use for generating the assistant response.
 	Model string `json:"model"`
+
+	// PresencePenalty Penalizes tokens that already appeared, encouraging new topics.
+	PresencePenalty *float64 `json:"presence_penalty,omitempty"`
+
+	// Seed Seed for best-effort deterministic sampling on providers that support it.
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Stop Sequences where the model stops generating further tokens.
+	Stop *[]string `json:"stop,omitempty"`
+
+	// Temperature Sampling temperature for this turn only. Overrides the conversation settings and chat default.
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// TopP Nucleus sampling probability mass for this turn only. Overrides the conversation settings and chat default.
+	TopP *float64 `json:"top_p,omitempty"`
+}
+
+// Comment A note attached to a todo.
+type Comment struct {
+	// Author Who wrote the comment. USER comments are written by the user. ASSISTANT comments are recorded when the assistant changes a todo from chat.
+	Author CommentAuthor `json:"author"`
+
+	// Body Comment text.
+	Body string `json:"body"`
+
+	// CreatedAt Timestamp when the comment was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Unique identifier for the comment.
+	Id openapi_types.UUID `json:"id"`
+
+	// TodoId Identifier of the todo the comment belongs to.
+	TodoId openapi_types.UUID `json:"todo_id"`
+
+	// UpdatedAt Timestamp when the comment was last edited.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CommentAuthor Who wrote the comment. USER comments are written by the user. ASSISTANT comments are recorded when the assistant changes a todo from chat.
+type CommentAuthor string
+
+// CommentRequest Request payload for adding or editing a comment.
+type CommentRequest struct {
+	// Body Comment text.
+	Body string `json:"body"`
+}
+
+// ContentBlob defines model for ContentBlob.
+type ContentBlob struct {
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Hash Lowercase hex-encoded SHA-256 hash of the content.
+	Hash      string `json:"hash"`
+	SizeBytes int    `json:"size_bytes"`
 }
 
 // Conversation A conversation between the user and the AI assistant.
@@ -200,6 +430,9 @@ type Conversation struct {
 	// Id Unique identifier for the conversation.
 	Id openapi_types.UUID `json:"id"`
 
+	// Settings Generation settings applied to every turn of the conversation. Unset settings fall back to the chat defaults.
+	Settings *ConversationSettings `json:"settings,omitempty"`
+
 	// Title User-defined title for the conversation.
 	Title string `json:"title"`
 
@@ -209,6 +442,9 @@ type Conversation struct {
 	// TotalTokensUsed Estimated current context tokens since the last summarized message checkpoint.
 	TotalTokensUsed int64 `json:"total_tokens_used"`
 
+	// UnreadCount Messages added by background work, such as the weekly review, that the caller has not read. Only set in the conversation list.
+	UnreadCount *int64 `json:"unread_count,omitempty"`
+
 	// UpdatedAt Timestamp when the conversation was last updated.
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -228,18 +464,102 @@ type ConversationListResp struct {
 	PreviousPage *int `json:"previous_page"`
 }
 
+// ConversationSettings Generation settings applied to every turn of the conversation. Unset settings fall back to the chat defaults.
+type ConversationSettings struct {
+	// Model Model that answers the conversation turns instead of the requested one. It must be available and enabled for the tenant.
+	Model *string `json:"model,omitempty"`
+
+	// Temperature Sampling temperature for the conversation turns.
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// TopP Nucleus sampling probability mass for the conversation turns.
+	TopP *float64 `json:"top_p,omitempty"`
+}
+
 // ConversationTitleSource Source of the conversation title.
 type ConversationTitleSource string
 
+// ConversationUnreadEvent Unread count of a conversation after background work added a message to it.
+type ConversationUnreadEvent struct {
+	// ConversationId Conversation the message was added to.
+	ConversationId openapi_types.UUID `json:"conversation_id"`
+
+	// CreatedAt Timestamp when the message was added.
+	CreatedAt time.Time `json:"created_at"`
+
+	// MessageId Message added to the conversation.
+	MessageId openapi_types.UUID `json:"message_id"`
+
+	// UnreadCount Messages of the conversation the caller has not read.
+	UnreadCount int64 `json:"unread_count"`
+}
+
+// CreateBoardSnapshotRequest Request payload for creating a board snapshot.
+type CreateBoardSnapshotRequest struct {
+	// Filter Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today.
+	Filter *ViewFilter `json:"filter,omitempty"`
+
+	// Title Snapshot title. Defaults to "Board snapshot".
+	Title *string `json:"title,omitempty"`
+}
+
 // CreateTodoRequest Request payload for creating a todo.
 type CreateTodoRequest struct {
+	// CustomFields Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+
 	// DueDate Calendar due date (date only, no time component).
 	DueDate openapi_types.Date `json:"due_date"`
 
+	// EstimatedMinutes Estimated effort in minutes. Omit or set to 0 when unknown.
+	EstimatedMinutes *int `json:"estimated_minutes,omitempty"`
+
+	// Location Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location.
+	Location *TodoLocation `json:"location,omitempty"`
+
 	// Title Human-readable todo title. Must be non-empty.
 	Title string `json:"title"`
 }
 
+// CreateTokenRequest Request payload for creating a personal access token.
+type CreateTokenRequest struct {
+	// Name Name that tells the token apart, such as the script or integration using it.
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to the token.
+	Scopes []TokenScope `json:"scopes"`
+}
+
+// CreatedToken A newly created personal access token together with the bearer token, which is only returned once.
+type CreatedToken struct {
+	// Secret Bearer token for API requests, starting with pat_.
+	Secret string `json:"secret"`
+
+	// Token A personal access token of a principal. The token itself is never returned after creation.
+	Token PersonalAccessToken `json:"token"`
+}
+
+// CustomField A tenant-defined field of todos.
+type CustomField struct {
+	// CreatedAt Timestamp when the field was first defined.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Name Field name, used as the key of the todo custom_fields object.
+	Name string `json:"name"`
+
+	// Options Accepted values of an ENUM field. Empty for the other types.
+	Options []string `json:"options"`
+
+	// Type Type of the values stored in a custom field.
+	Type CustomFieldType `json:"type"`
+}
+
+// CustomFieldType Type of the values stored in a custom field.
+type CustomFieldType string
+
+// CustomFieldValues Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged.
+type CustomFieldValues map[string]interface{}
+
 // DateRange defines model for DateRange.
 type DateRange struct {
 	// DueAfter Filter todos with due_date on or after this date (YYYY-MM-DD).
@@ -256,6 +576,42 @@ type DateRange0 = interface{}
 // DateRange1 defines model for .
 type DateRange1 = interface{}
 
+// DefineCustomFieldRequest Request payload for defining a custom field.
+type DefineCustomFieldRequest struct {
+	// Options Accepted values. Required for ENUM fields and not allowed for the other types.
+	Options *[]string `json:"options,omitempty"`
+
+	// Type Type of the values stored in a custom field.
+	Type CustomFieldType `json:"type"`
+}
+
+// EditMessageRequest defines model for EditMessageRequest.
+type EditMessageRequest struct {
+	FrequencyPenalty *float64 `json:"frequency_penalty,omitempty"`
+
+	// MaxTokens Upper bound on the tokens generated for the response. Must not exceed the model's max_output_tokens.
+	MaxTokens *int `json:"max_tokens,omitempty"`
+
+	// Message New content of the edited user message.
+	Message string `json:"message"`
+
+	// Model AI model for the new turn. Defaults to the model of the edited message.
+	Model           *string  `json:"model,omitempty"`
+	PresencePenalty *float64 `json:"presence_penalty,omitempty"`
+
+	// Seed Sampling seed for best-effort reproducible responses on providers that support it.
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Stop Sequences where the model stops generating further tokens.
+	Stop *[]string `json:"stop,omitempty"`
+
+	// Temperature Sampling temperature for the new turn. Overrides the conversation settings and chat default.
+	Temperature *float64 `json:"temperature,omitempty"`
+
+	// TopP Nucleus sampling probability mass for the new turn. Overrides the conversation settings and chat default.
+	TopP *float64 `json:"top_p,omitempty"`
+}
+
 // Error Error details.
 type Error struct {
 	// Code Machine-readable error code.
@@ -274,6 +630,96 @@ type ErrorResp struct {
 	Error Error `json:"error"`
 }
 
+// GraphQLSchemaResp GraphQL schema of the running build.
+type GraphQLSchemaResp struct {
+	// Schema GraphQL schema in SDL.
+	Schema string `json:"schema"`
+
+	// Version Version of the running build.
+	Version string `json:"version"`
+}
+
+// InboundWebhookResp defines model for InboundWebhookResp.
+type InboundWebhookResp struct {
+	// Created False when the delivery matched no mapping template and was ignored.
+	Created bool `json:"created"`
+
+	// Todo A todo item.
+	Todo *Todo `json:"todo,omitempty"`
+}
+
+// Instruction An instruction the assistant follows in every answer.
+type Instruction struct {
+	// ConversationId Conversation the instruction is pinned to. Absent for global instructions.
+	ConversationId *openapi_types.UUID `json:"conversation_id,omitempty"`
+
+	// CreatedAt Timestamp when the instruction was pinned.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Unique identifier for the instruction.
+	Id openapi_types.UUID `json:"id"`
+
+	// Text Instruction text.
+	Text string `json:"text"`
+}
+
+// ListCommentsResp A paginated list of comments.
+type ListCommentsResp struct {
+	// Items List of comments, newest first.
+	Items []Comment `json:"items"`
+
+	// NextPage Next page number. Null if there are no more pages.
+	NextPage *int `json:"next_page"`
+
+	// Page Current page number.
+	Page int `json:"page"`
+
+	// PreviousPage Previous page number. Null if there is no previous page.
+	PreviousPage *int `json:"previous_page"`
+}
+
+// ListCustomFieldsResp The custom fields of the tenant.
+type ListCustomFieldsResp struct {
+	// Items Custom fields ordered by name.
+	Items []CustomField `json:"items"`
+}
+
+// ListInstructionsResp Pinned instructions.
+type ListInstructionsResp struct {
+	// Items Global instructions followed by the ones pinned to the conversation.
+	Items []Instruction `json:"items"`
+}
+
+// ListMemoriesResp Long-term memories.
+type ListMemoriesResp struct {
+	// Items Memories, newest first.
+	Items []Memory `json:"items"`
+}
+
+// ListProjectSuggestionsResp The pending project suggestions.
+type ListProjectSuggestionsResp struct {
+	// Items Suggestions, largest group first.
+	Items []ProjectSuggestion `json:"items"`
+}
+
+// ListProjectsResp The accepted projects.
+type ListProjectsResp struct {
+	// Items Projects, newest first.
+	Items []Project `json:"items"`
+}
+
+// ListSessionsResp The active sessions of the calling principal.
+type ListSessionsResp struct {
+	// Items Sessions ordered by last use, most recent first.
+	Items []Session `json:"items"`
+}
+
+// ListTemplatesResp The saved templates.
+type ListTemplatesResp struct {
+	// Items Templates ordered by name.
+	Items []Template `json:"items"`
+}
+
 // ListTodosResp A paginated list of todos.
 type ListTodosResp struct {
 	// Items List of todos.
@@ -289,11 +735,53 @@ type ListTodosResp struct {
 	PreviousPage *int `json:"previous_page"`
 }
 
+// ListTokensResp The active personal access tokens of the calling principal.
+type ListTokensResp struct {
+	// Items Tokens ordered by creation, newest first.
+	Items []PersonalAccessToken `json:"items"`
+}
+
+// ListViewsResp The built-in and saved views.
+type ListViewsResp struct {
+	// Items Built-in views followed by saved views ordered by name.
+	Items []View `json:"items"`
+}
+
+// MarkConversationReadRequest Message to mark the conversation read up to.
+type MarkConversationReadRequest struct {
+	// MessageId Last message read. Omit to mark every message of the conversation read.
+	MessageId *openapi_types.UUID `json:"message_id,omitempty"`
+}
+
+// Memory A fact or preference about the user extracted from an earlier conversation.
+type Memory struct {
+	// CreatedAt Timestamp when the memory was stored.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Unique identifier for the memory.
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind Whether the memory is a durable fact or a preference.
+	Kind MemoryKind `json:"kind"`
+
+	// SourceConversationId Conversation the memory was extracted from. Absent when that conversation was deleted.
+	SourceConversationId *openapi_types.UUID `json:"source_conversation_id,omitempty"`
+
+	// Text Memory text.
+	Text string `json:"text"`
+}
+
+// MemoryKind Whether the memory is a durable fact or a preference.
+type MemoryKind string
+
 // ModelInfo Information about an AI model.
 type ModelInfo struct {
 	// Id Unique identifier for the model.
 	Id string `json:"id"`
 
+	// MaxOutputTokens Maximum number of tokens one response of this model may generate. Omitted when unknown.
+	MaxOutputTokens *int `json:"max_output_tokens,omitempty"`
+
 	// Name Human-readable name for the model.
 	Name string `json:"name"`
 }
@@ -310,2411 +798,13306 @@ type NextUpTodoItem struct {
 	Title  string `json:"title"`
 }
 
-// SelectedSkill defines model for SelectedSkill.
-type SelectedSkill struct {
-	Name   string   `json:"name"`
-	Source string   `json:"source"`
-	Tools  []string `json:"tools"`
+// PersonalAccessToken A personal access token of a principal. The token itself is never returned after creation.
+type PersonalAccessToken struct {
+	// CreatedAt Timestamp when the token was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Unique identifier for the token.
+	Id openapi_types.UUID `json:"id"`
+
+	// LastUsedAt Timestamp when the token was last used, recorded at most once a minute. Absent when never used.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+
+	// Name Name of the token.
+	Name string `json:"name"`
+
+	// Scopes Scopes granted to the token.
+	Scopes []TokenScope `json:"scopes"`
 }
 
-// SkillListResp List of available skills.
-type SkillListResp struct {
-	// Skills Available skill definitions.
-	Skills []AvailableSkill `json:"skills"`
+// PostSystemMessageRequest Message of a background job to post into a conversation.
+type PostSystemMessageRequest struct {
+	// Content Message content, in Markdown.
+	Content string `json:"content"`
+
+	// Source Name of the background job posting the message, recorded in traces.
+	Source *string `json:"source,omitempty"`
 }
 
-// SubmitActionApprovalRequest defines model for SubmitActionApprovalRequest.
-type SubmitActionApprovalRequest struct {
-	// ActionCallId Assistant action call identifier.
-	ActionCallId string `json:"action_call_id"`
+// Project A named group of related todos.
+type Project struct {
+	// CreatedAt Timestamp when the project was created.
+	CreatedAt time.Time `json:"created_at"`
 
-	// ActionName Optional action name for observability.
-	ActionName     *string            `json:"action_name,omitempty"`
-	ConversationId openapi_types.UUID `json:"conversation_id"`
+	// Id Unique identifier for the project.
+	Id openapi_types.UUID `json:"id"`
 
-	// Reason Optional human-readable reason for the decision.
-	Reason *string `json:"reason"`
+	// Name Project name.
+	Name string `json:"name"`
 
-	// Status Human approval decision status for a requested action execution.
-	Status ActionApprovalStatus `json:"status"`
-	TurnId openapi_types.UUID   `json:"turn_id"`
+	// Todos Todos of the project ordered by title.
+	Todos []ProjectTodo `json:"todos"`
 }
 
-// Todo A todo item.
-type Todo struct {
-	// CreatedAt Timestamp when the todo was created.
+// ProjectSuggestion A group of similar open todos proposed as a project.
+type ProjectSuggestion struct {
+	// CreatedAt Timestamp when the group was found.
 	CreatedAt time.Time `json:"created_at"`
 
-	// DueDate Calendar due date (date only, no time component).
-	DueDate openapi_types.Date `json:"due_date"`
-
-	// Id Unique identifier for the todo.
+	// Id Unique identifier for the suggestion.
 	Id openapi_types.UUID `json:"id"`
 
-	// Status Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
-	Status TodoStatus `json:"status"`
+	// Name Project name suggested by the assistant.
+	Name string `json:"name"`
 
-	// Title Human-readable todo title.
+	// Todos Todos of the group.
+	Todos []ProjectTodo `json:"todos"`
+}
+
+// ProjectTodo A todo grouped into a project or a project suggestion.
+type ProjectTodo struct {
+	// Id Todo identifier.
+	Id openapi_types.UUID `json:"id"`
+
+	// Title Todo title.
 	Title string `json:"title"`
+}
 
-	// UpdatedAt Timestamp when the todo was last updated.
-	UpdatedAt time.Time `json:"updated_at"`
+// QuickAddRequest Request payload for parsing a quick add line.
+type QuickAddRequest struct {
+	// Commit Create the parsed todo instead of only previewing it.
+	Commit *bool `json:"commit,omitempty"`
+
+	// Text One line describing the todo. Words starting with # are tags and a word starting with ! is the priority; today, tomorrow, weekdays, next week, next month, "in N days|weeks" and YYYY-MM-DD set the due date.
+	Text string `json:"text"`
 }
 
-// TodoStatus Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
-type TodoStatus string
+// QuickAddResp Todo parsed from a quick add line.
+type QuickAddResp struct {
+	// CustomFields Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged.
+	CustomFields CustomFieldValues `json:"custom_fields"`
 
-// TodoStatusCounts Count of todos per status.
-type TodoStatusCounts struct {
-	// DONE Number of completed todos.
-	DONE int `json:"DONE"`
+	// DueDate Due date named by the line, or today when it names none.
+	DueDate openapi_types.Date `json:"due_date"`
 
-	// OPEN Number of open todos.
-	OPEN int `json:"OPEN"`
-}
+	// Priority Lowercased priority of the line, without the ! sign.
+	Priority *string `json:"priority,omitempty"`
 
-// UpdateConversationRequest Payload to update conversation.
-type UpdateConversationRequest struct {
-	// Title New title for the conversation. Must be non-empty.
+	// Source Whether the deterministic parser or the model resolved the title and due date.
+	Source QuickAddRespSource `json:"source"`
+
+	// Tags Lowercased tags of the line, without the # sign.
+	Tags []string `json:"tags"`
+
+	// Title Title left once the date, tags and priority are removed.
 	Title string `json:"title"`
+
+	// Todo A todo item.
+	Todo *Todo `json:"todo,omitempty"`
+
+	// Unresolved Tags and priority matching no custom field; they are not applied. A tag sets the BOOLEAN field of the same name or an ENUM field with a matching option, and the priority sets the priority field.
+	Unresolved []string `json:"unresolved"`
 }
 
-// UpdateTodoRequest Partial update payload. Provide at least one of: title, status, due_date.
-type UpdateTodoRequest struct {
-	// DueDate Updated calendar due date (date only).
-	DueDate *openapi_types.Date `json:"due_date,omitempty"`
-
-	// Status Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
-	Status *TodoStatus `json:"status,omitempty"`
+// QuickAddRespSource Whether the deterministic parser or the model resolved the title and due date.
+type QuickAddRespSource string
 
-	// Title New title for the todo. Must be non-empty if provided.
-	Title *string `json:"title,omitempty"`
-	union json.RawMessage
+// RefreshSessionRequest Request payload for refreshing a session.
+type RefreshSessionRequest struct {
+	// RefreshToken Refresh token issued when the session was started or last refreshed.
+	RefreshToken string `json:"refresh_token"`
 }
 
-// UpdateTodoRequest0 defines model for .
-type UpdateTodoRequest0 = interface{}
-
-// UpdateTodoRequest1 defines model for .
-type UpdateTodoRequest1 = interface{}
+// RegenerateMessageRequest defines model for RegenerateMessageRequest.
+type RegenerateMessageRequest struct {
+	FrequencyPenalty *float64 `json:"frequency_penalty,omitempty"`
 
-// UpdateTodoRequest2 defines model for .
-type UpdateTodoRequest2 = interface{}
+	// MaxTokens Upper bound on the tokens generated for the response. Must not exceed the model's max_output_tokens.
+	MaxTokens *int `json:"max_tokens,omitempty"`
 
-// BadRequest Standard error envelope.
-type BadRequest = ErrorResp
+	// Model AI model for the regenerated response. Defaults to the model of the regenerated message.
+	Model           *string  `json:"model,omitempty"`
+	PresencePenalty *float64 `json:"presence_penalty,omitempty"`
 
-// NotFound Standard error envelope.
-type NotFound = ErrorResp
+	// Seed Sampling seed for best-effort reproducible responses on providers that support it.
+	Seed *int64 `json:"seed,omitempty"`
 
-// ListChatMessagesParams defines parameters for ListChatMessages.
-type ListChatMessagesParams struct {
-	// ConversationId Identifier for the conversation.
-	ConversationId openapi_types.UUID `form:"conversation_id" json:"conversation_id"`
+	// Stop Sequences where the model stops generating further tokens.
+	Stop *[]string `json:"stop,omitempty"`
 
-	// PageSize Maximum number of messages to return (server may cap).
-	PageSize int `form:"pageSize" json:"pageSize"`
+	// Temperature Sampling temperature for the regenerated response. Overrides the conversation settings and chat default.
+	Temperature *float64 `json:"temperature,omitempty"`
 
-	// Page Opaque cursor from a prior ListChatMessagesResp to fetch the next page. Omit or set to null to fetch the first page.
-	Page int `form:"page" json:"page"`
+	// TopP Nucleus sampling probability mass for the regenerated response. Overrides the conversation settings and chat default.
+	TopP *float64 `json:"top_p,omitempty"`
 }
 
-// ListConversationsParams defines parameters for ListConversations.
-type ListConversationsParams struct {
-	// PageSize Maximum number of messages to return (server may cap).
-	PageSize int `form:"pageSize" json:"pageSize"`
+// RelatedConversation A conversation about the same topic as another conversation.
+type RelatedConversation struct {
+	// ConversationId Identifier of the related conversation.
+	ConversationId openapi_types.UUID `json:"conversation_id"`
 
-	// Page Opaque cursor from a prior ListChatMessagesResp to fetch the next page. Omit or set to null to fetch the first page.
-	Page int `form:"page" json:"page"`
-}
+	// LastActiveAt Timestamp of the last message of the related conversation.
+	LastActiveAt time.Time `json:"last_active_at"`
 
-// ListTodosParams defines parameters for ListTodos.
-type ListTodosParams struct {
-	// PageSize Maximum number of todos to return (server may cap).
-	PageSize int `form:"pageSize" json:"pageSize"`
+	// Similarity Cosine similarity between the conversation topics.
+	Similarity float64 `json:"similarity"`
 
-	// Page Opaque cursor from a prior ListTodosResp to fetch the next page. Omit or set to null to fetch the first page.
-	Page int `form:"page" json:"page"`
+	// Title Title of the related conversation.
+	Title string `json:"title"`
+}
 
-	// Status Filter todos by status.
-	Status *TodoStatus `form:"status,omitempty" json:"status,omitempty"`
+// RelatedConversationListResp Related conversations.
+type RelatedConversationListResp struct {
+	// Items Related conversations, closest first.
+	Items []RelatedConversation `json:"items"`
+}
 
-	// Search Full-text or semantic search query to retrieve todos most relevant to the provided keywords or context using vector similarity.
-	Search *string `form:"search,omitempty" json:"search,omitempty"`
+// RememberInstructionRequest Request payload for pinning an instruction.
+type RememberInstructionRequest struct {
+	// ConversationId Conversation to pin the instruction to. Omit to store it globally.
+	ConversationId *openapi_types.UUID `json:"conversation_id,omitempty"`
 
-	// SearchType The type of search to perform when the 'search' parameter is provided. 'title' performs a case-insensitive substring match on todo titles. 'similarity' uses vector similarity search based on the todo embeddings.
-	SearchType *ListTodosParamsSearchType `form:"searchType,omitempty" json:"searchType,omitempty"`
-	DateRange  *DateRange                 `json:"dateRange,omitempty"`
+	// Text Instruction text.
+	Text string `json:"text"`
+}
 
-	// Sort Sorting criteria.
-	Sort *ListTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+// SelectedSkill defines model for SelectedSkill.
+type SelectedSkill struct {
+	Name   string   `json:"name"`
+	Source string   `json:"source"`
+	Tools  []string `json:"tools"`
 }
 
-// ListTodosParamsSearchType defines parameters for ListTodos.
-type ListTodosParamsSearchType string
+// Session A device login of a principal.
+type Session struct {
+	// CreatedAt Timestamp when the session was started.
+	CreatedAt time.Time `json:"created_at"`
 
-// ListTodosParamsSort defines parameters for ListTodos.
-type ListTodosParamsSort string
+	// Current True for the session the request was authenticated with.
+	Current bool `json:"current"`
 
-// StreamChatJSONRequestBody defines body for StreamChat for application/json ContentType.
-type StreamChatJSONRequestBody = ChatStreamRequest
+	// Device Description of the device that started the session.
+	Device string `json:"device"`
 
-// SubmitActionApprovalJSONRequestBody defines body for SubmitActionApproval for application/json ContentType.
-type SubmitActionApprovalJSONRequestBody = SubmitActionApprovalRequest
+	// ExpiresAt Timestamp when the refresh token expires unless the session is refreshed.
+	ExpiresAt time.Time `json:"expires_at"`
 
-// UpdateConversationJSONRequestBody defines body for UpdateConversation for application/json ContentType.
-type UpdateConversationJSONRequestBody = UpdateConversationRequest
+	// Id Unique identifier for the session.
+	Id openapi_types.UUID `json:"id"`
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = CreateTodoRequest
+	// LastUsedAt Timestamp when the session was last used, recorded at most once a minute.
+	LastUsedAt time.Time `json:"last_used_at"`
+}
 
-// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
-type UpdateTodoJSONRequestBody = UpdateTodoRequest
+// SessionTokens A session together with its newly issued tokens.
+type SessionTokens struct {
+	// AccessExpiresAt Timestamp when the access token expires.
+	AccessExpiresAt time.Time `json:"access_expires_at"`
 
-// AsDateRange0 returns the union data inside the DateRange as a DateRange0
-func (t DateRange) AsDateRange0() (DateRange0, error) {
-	var body DateRange0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+	// AccessToken Bearer token for API requests.
+	AccessToken string `json:"access_token"`
 
-// FromDateRange0 overwrites any union data inside the DateRange as the provided DateRange0
-func (t *DateRange) FromDateRange0(v DateRange0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+	// RefreshToken Token to refresh the session. It changes on every refresh.
+	RefreshToken string `json:"refresh_token"`
+
+	// Session A device login of a principal.
+	Session Session `json:"session"`
 }
 
-// MergeDateRange0 performs a merge with any union data inside the DateRange, using the provided DateRange0
-func (t *DateRange) MergeDateRange0(v DateRange0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SkillListResp List of available skills.
+type SkillListResp struct {
+	// Skills Available skill definitions.
+	Skills []AvailableSkill `json:"skills"`
+}
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+// StartSessionRequest Request payload for starting a session.
+type StartSessionRequest struct {
+	// Device Description of the device starting the session. Defaults to the User-Agent header.
+	Device *string `json:"device,omitempty"`
 }
 
-// AsDateRange1 returns the union data inside the DateRange as a DateRange1
-func (t DateRange) AsDateRange1() (DateRange1, error) {
-	var body DateRange1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// SubmitActionApprovalRequest defines model for SubmitActionApprovalRequest.
+type SubmitActionApprovalRequest struct {
+	// ActionCallId Assistant action call identifier.
+	ActionCallId string `json:"action_call_id"`
+
+	// ActionName Optional action name for observability.
+	ActionName     *string            `json:"action_name,omitempty"`
+	ConversationId openapi_types.UUID `json:"conversation_id"`
+
+	// Reason Optional human-readable reason for the decision.
+	Reason *string `json:"reason"`
+
+	// Status Human approval decision status for a requested action execution.
+	Status ActionApprovalStatus `json:"status"`
+	TurnId openapi_types.UUID   `json:"turn_id"`
 }
 
-// FromDateRange1 overwrites any union data inside the DateRange as the provided DateRange1
-func (t *DateRange) FromDateRange1(v DateRange1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// SubmitMessageFeedbackRequest defines model for SubmitMessageFeedbackRequest.
+type SubmitMessageFeedbackRequest struct {
+	// Score -1 for an unhelpful response, 1 for a helpful one.
+	Score SubmitMessageFeedbackRequestScore `json:"score"`
 }
 
-// MergeDateRange1 performs a merge with any union data inside the DateRange, using the provided DateRange1
-func (t *DateRange) MergeDateRange1(v DateRange1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SubmitMessageFeedbackRequestScore -1 for an unhelpful response, 1 for a helpful one.
+type SubmitMessageFeedbackRequestScore int
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+// SyncConversation defines model for SyncConversation.
+type SyncConversation struct {
+	CreatedAt     time.Time          `json:"created_at"`
+	Id            openapi_types.UUID `json:"id"`
+	LastMessageAt *time.Time         `json:"last_message_at,omitempty"`
+	Title         string             `json:"title"`
+
+	// TitleSource Source of the conversation title.
+	TitleSource ConversationTitleSource `json:"title_source"`
+	UpdatedAt   time.Time               `json:"updated_at"`
 }
 
-func (t DateRange) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	object := make(map[string]json.RawMessage)
-	if t.union != nil {
-		err = json.Unmarshal(b, &object)
-		if err != nil {
-			return nil, err
-		}
-	}
+// SyncEntity defines model for SyncEntity.
+type SyncEntity string
 
-	if t.DueAfter != nil {
-		object["dueAfter"], err = json.Marshal(t.DueAfter)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'dueAfter': %w", err)
-		}
-	}
+// SyncMutation A todo mutation recorded offline. CREATE requires title and due_date; UPDATE and DELETE require todo_id.
+type SyncMutation struct {
+	// BaseUpdatedAt updated_at of the todo version the client edited. When set, the mutation is rejected as a conflict if the server version differs.
+	BaseUpdatedAt *time.Time          `json:"base_updated_at,omitempty"`
+	DueDate       *openapi_types.Date `json:"due_date,omitempty"`
+	Operation     SyncOperation       `json:"operation"`
 
-	if t.DueBefore != nil {
-		object["dueBefore"], err = json.Marshal(t.DueBefore)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'dueBefore': %w", err)
-		}
-	}
-	b, err = json.Marshal(object)
-	return b, err
+	// Status Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
+	Status *TodoStatus         `json:"status,omitempty"`
+	Title  *string             `json:"title,omitempty"`
+	TodoId *openapi_types.UUID `json:"todo_id,omitempty"`
 }
 
-func (t *DateRange) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	if err != nil {
-		return err
-	}
-	object := make(map[string]json.RawMessage)
-	err = json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
+// SyncMutationResult defines model for SyncMutationResult.
+type SyncMutationResult struct {
+	// Error Error details.
+	Error *Error `json:"error,omitempty"`
 
-	if raw, found := object["dueAfter"]; found {
-		err = json.Unmarshal(raw, &t.DueAfter)
-		if err != nil {
-			return fmt.Errorf("error reading 'dueAfter': %w", err)
-		}
-	}
+	// Index Position of the mutation in the request.
+	Index     int                `json:"index"`
+	Operation SyncOperation      `json:"operation"`
+	Status    SyncMutationStatus `json:"status"`
 
-	if raw, found := object["dueBefore"]; found {
-		err = json.Unmarshal(raw, &t.DueBefore)
-		if err != nil {
-			return fmt.Errorf("error reading 'dueBefore': %w", err)
-		}
-	}
+	// Todo A todo item.
+	Todo *Todo `json:"todo,omitempty"`
 
-	return err
+	// TodoId Affected todo. Omitted for creates that were not applied.
+	TodoId *openapi_types.UUID `json:"todo_id,omitempty"`
 }
 
-// AsUpdateTodoRequest0 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest0
-func (t UpdateTodoRequest) AsUpdateTodoRequest0() (UpdateTodoRequest0, error) {
-	var body UpdateTodoRequest0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// SyncMutationStatus defines model for SyncMutationStatus.
+type SyncMutationStatus string
+
+// SyncOperation defines model for SyncOperation.
+type SyncOperation string
+
+// SyncPushRequest defines model for SyncPushRequest.
+type SyncPushRequest struct {
+	Mutations []SyncMutation `json:"mutations"`
 }
 
-// FromUpdateTodoRequest0 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest0
-func (t *UpdateTodoRequest) FromUpdateTodoRequest0(v UpdateTodoRequest0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// SyncPushResp defines model for SyncPushResp.
+type SyncPushResp struct {
+	Results []SyncMutationResult `json:"results"`
 }
 
-// MergeUpdateTodoRequest0 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest0
-func (t *UpdateTodoRequest) MergeUpdateTodoRequest0(v UpdateTodoRequest0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SyncResp defines model for SyncResp.
+type SyncResp struct {
+	// Conversations Current state of the conversations changed since the cursor.
+	Conversations []SyncConversation `json:"conversations"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
-}
+	// Cursor Cursor to pass as `since` on the next sync.
+	Cursor string `json:"cursor"`
 
-// AsUpdateTodoRequest1 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest1
-func (t UpdateTodoRequest) AsUpdateTodoRequest1() (UpdateTodoRequest1, error) {
-	var body UpdateTodoRequest1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+	// HasMore True when more changes are available after the returned cursor.
+	HasMore bool `json:"has_more"`
+
+	// Todos Current state of the todos changed since the cursor.
+	Todos []Todo `json:"todos"`
+
+	// Tombstones Todos and conversations deleted since the cursor.
+	Tombstones []SyncTombstone `json:"tombstones"`
 }
 
-// FromUpdateTodoRequest1 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest1
-func (t *UpdateTodoRequest) FromUpdateTodoRequest1(v UpdateTodoRequest1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// SyncTombstone defines model for SyncTombstone.
+type SyncTombstone struct {
+	DeletedAt time.Time          `json:"deleted_at"`
+	Entity    SyncEntity         `json:"entity"`
+	Id        openapi_types.UUID `json:"id"`
 }
 
-// MergeUpdateTodoRequest1 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest1
-func (t *UpdateTodoRequest) MergeUpdateTodoRequest1(v UpdateTodoRequest1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// Template A named, reusable list of todos.
+type Template struct {
+	// CreatedAt Timestamp when the template was saved.
+	CreatedAt time.Time `json:"created_at"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+	// Id Unique identifier for the template.
+	Id openapi_types.UUID `json:"id"`
+
+	// Items Todos the template creates, in order.
+	Items []TemplateItem `json:"items"`
+
+	// Name Template name.
+	Name string `json:"name"`
+
+	// UpdatedAt Timestamp when the template was last changed.
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// AsUpdateTodoRequest2 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest2
-func (t UpdateTodoRequest) AsUpdateTodoRequest2() (UpdateTodoRequest2, error) {
-	var body UpdateTodoRequest2
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// TemplateItem A todo created when the template is applied.
+type TemplateItem struct {
+	// DueOffsetDays Days between the anchor date and the due date; negative values fall before the anchor.
+	DueOffsetDays int `json:"due_offset_days"`
+
+	// EstimatedMinutes Estimated effort of the todo in minutes.
+	EstimatedMinutes *int `json:"estimated_minutes,omitempty"`
+
+	// Title Todo title.
+	Title string `json:"title"`
 }
 
-// FromUpdateTodoRequest2 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest2
-func (t *UpdateTodoRequest) FromUpdateTodoRequest2(v UpdateTodoRequest2) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// TemplateRequest Request payload for saving or updating a template.
+type TemplateRequest struct {
+	// Items Todos the template creates, in order.
+	Items []TemplateItem `json:"items"`
+
+	// Name Template name, unique regardless of case.
+	Name string `json:"name"`
 }
 
-// MergeUpdateTodoRequest2 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest2
-func (t *UpdateTodoRequest) MergeUpdateTodoRequest2(v UpdateTodoRequest2) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// TimeEntry A work session logged against a todo.
+type TimeEntry struct {
+	// DurationSeconds Session duration in seconds, measured up to now while running.
+	DurationSeconds int `json:"duration_seconds"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+	// EndedAt Timestamp when the session ended. Absent while the timer is running.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+
+	// Id Unique identifier for the time entry.
+	Id openapi_types.UUID `json:"id"`
+
+	// StartedAt Timestamp when the session started.
+	StartedAt time.Time `json:"started_at"`
+
+	// TodoId Identifier of the todo the session belongs to.
+	TodoId openapi_types.UUID `json:"todo_id"`
 }
 
-func (t UpdateTodoRequest) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	object := make(map[string]json.RawMessage)
-	if t.union != nil {
-		err = json.Unmarshal(b, &object)
-		if err != nil {
-			return nil, err
-		}
-	}
+// TimeReport Logged time aggregated per todo.
+type TimeReport struct {
+	// From Inclusive start date of the report.
+	From  openapi_types.Date `json:"from"`
+	Items []TimeReportItem   `json:"items"`
 
-	if t.DueDate != nil {
-		object["due_date"], err = json.Marshal(t.DueDate)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'due_date': %w", err)
-		}
-	}
+	// To Exclusive end date of the report.
+	To openapi_types.Date `json:"to"`
 
-	if t.Status != nil {
-		object["status"], err = json.Marshal(t.Status)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'status': %w", err)
-		}
-	}
+	// TotalSeconds Total logged time across all todos in seconds.
+	TotalSeconds int `json:"total_seconds"`
+}
 
-	if t.Title != nil {
-		object["title"], err = json.Marshal(t.Title)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'title': %w", err)
-		}
-	}
-	b, err = json.Marshal(object)
-	return b, err
+// TimeReportItem defines model for TimeReportItem.
+type TimeReportItem struct {
+	// Entries Number of work sessions logged.
+	Entries int                `json:"entries"`
+	Title   string             `json:"title"`
+	TodoId  openapi_types.UUID `json:"todo_id"`
+
+	// TotalSeconds Total logged time for the todo in seconds.
+	TotalSeconds int `json:"total_seconds"`
 }
 
-func (t *UpdateTodoRequest) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	if err != nil {
-		return err
-	}
-	object := make(map[string]json.RawMessage)
-	err = json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
+// Todo A todo item.
+type Todo struct {
+	// ArchivedAt Timestamp when the completed todo was archived. Null for active todos.
+	ArchivedAt *time.Time `json:"archived_at"`
 
-	if raw, found := object["due_date"]; found {
-		err = json.Unmarshal(raw, &t.DueDate)
-		if err != nil {
-			return fmt.Errorf("error reading 'due_date': %w", err)
-		}
-	}
+	// CreatedAt Timestamp when the todo was created.
+	CreatedAt time.Time `json:"created_at"`
 
-	if raw, found := object["status"]; found {
-		err = json.Unmarshal(raw, &t.Status)
-		if err != nil {
-			return fmt.Errorf("error reading 'status': %w", err)
-		}
-	}
+	// CustomFields Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
 
-	if raw, found := object["title"]; found {
-		err = json.Unmarshal(raw, &t.Title)
-		if err != nil {
-			return fmt.Errorf("error reading 'title': %w", err)
-		}
-	}
+	// DueDate Calendar due date (date only, no time component).
+	DueDate openapi_types.Date `json:"due_date"`
 
-	return err
+	// EstimatedMinutes Estimated effort in minutes. 0 means the todo has no estimate.
+	EstimatedMinutes int `json:"estimated_minutes"`
+
+	// Id Unique identifier for the todo.
+	Id openapi_types.UUID `json:"id"`
+
+	// Location Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location.
+	Location *TodoLocation `json:"location,omitempty"`
+
+	// Status Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
+	Status TodoStatus `json:"status"`
+
+	// Title Human-readable todo title.
+	Title string `json:"title"`
+
+	// UpdatedAt Timestamp when the todo was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// TodoChangeEvent defines model for TodoChangeEvent.
+type TodoChangeEvent struct {
+	// ConversationId Conversation whose assistant action produced the change, if any.
+	ConversationId *openapi_types.UUID `json:"conversation_id,omitempty"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// ConversationSequence Monotonically increasing sequence of the changes produced by the conversation.
+	ConversationSequence *int64    `json:"conversation_sequence,omitempty"`
+	CreatedAt            time.Time `json:"created_at"`
+
+	// Sequence Monotonically increasing sequence across all todo changes.
+	Sequence int64               `json:"sequence"`
+	TodoId   openapi_types.UUID  `json:"todo_id"`
+	Type     TodoChangeEventType `json:"type"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// TodoChangeEventType defines model for TodoChangeEvent.Type.
+type TodoChangeEventType string
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// TodoChangesResp defines model for TodoChangesResp.
+type TodoChangesResp struct {
+	Changes []TodoChangeEvent `json:"changes"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// HasMore True when more changes are available after the returned ones.
+	HasMore bool `json:"has_more"`
+
+	// LatestSequence Sequence to pass as `since` on the next request. It is the last returned sequence (the conversation sequence when filtering by conversation), or the requested `since` when no changes were returned.
+	LatestSequence int64 `json:"latest_sequence"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// TodoLocation Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location.
+type TodoLocation struct {
+	// Latitude Latitude in decimal degrees.
+	Latitude *float64 `json:"latitude,omitempty"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// Longitude Longitude in decimal degrees.
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Name Free text place name or address.
+	Name *string `json:"name,omitempty"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// TodoStatus Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
+type TodoStatus string
+
+// TodoStatusCounts Count of todos per status.
+type TodoStatusCounts struct {
+	// ARCHIVED Number of completed todos archived by the retention policy.
+	ARCHIVED int `json:"ARCHIVED"`
+
+	// DONE Number of completed todos that are not archived.
+	DONE int `json:"DONE"`
+
+	// OPEN Number of open todos.
+	OPEN int `json:"OPEN"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// TokenScope Scope of a personal access token. todos:read allows reading todos and the rest of the API, todos:write also allows changing them, and chat allows chatting with the assistant, which only gets write actions with todos:write.
+type TokenScope string
+
+// TurnReplay defines model for TurnReplay.
+type TurnReplay struct {
+	// Matches True when the replayed content and action calls are identical to the original ones.
+	Matches             bool                   `json:"matches"`
+	Model               string                 `json:"model"`
+	OriginalActionCalls []TurnReplayActionCall `json:"original_action_calls"`
+	OriginalContent     string                 `json:"original_content"`
+	ReplayedActionCalls []TurnReplayActionCall `json:"replayed_action_calls"`
+	ReplayedContent     string                 `json:"replayed_content"`
+
+	// Seed Recorded sampling seed the turn was replayed with; null when the original turn had none.
+	Seed        *int64             `json:"seed"`
+	TotalTokens int                `json:"total_tokens"`
+	TurnId      openapi_types.UUID `json:"turn_id"`
+}
+
+// TurnReplayActionCall defines model for TurnReplayActionCall.
+type TurnReplayActionCall struct {
+	Input string `json:"input"`
+	Name  string `json:"name"`
+}
+
+// TurnTranscript defines model for TurnTranscript.
+type TurnTranscript struct {
+	CompletedAt      time.Time            `json:"completed_at"`
+	CompletionTokens int                  `json:"completion_tokens"`
+	ConversationId   openapi_types.UUID   `json:"conversation_id"`
+	DurationMs       int64                `json:"duration_ms"`
+	PromptTokens     int                  `json:"prompt_tokens"`
+	StartedAt        time.Time            `json:"started_at"`
+	Steps            []TurnTranscriptStep `json:"steps"`
+	TotalTokens      int                  `json:"total_tokens"`
+	TurnId           openapi_types.UUID   `json:"turn_id"`
+}
+
+// TurnTranscriptActionCall defines model for TurnTranscriptActionCall.
+type TurnTranscriptActionCall struct {
+	Id    string `json:"id"`
+	Input string `json:"input"`
+	Name  string `json:"name"`
+}
+
+// TurnTranscriptStep defines model for TurnTranscriptStep.
+type TurnTranscriptStep struct {
+	// ActionCallId Action call answered by a tool step.
+	ActionCallId *string `json:"action_call_id,omitempty"`
+
+	// ActionCalls Actions requested by an assistant step.
+	ActionCalls      *[]TurnTranscriptActionCall       `json:"action_calls,omitempty"`
+	ActionExecuted   *bool                             `json:"action_executed,omitempty"`
+	ApprovalStatus   *TurnTranscriptStepApprovalStatus `json:"approval_status,omitempty"`
+	CompletionTokens int                               `json:"completion_tokens"`
+	Content          string                            `json:"content"`
+
+	// ContentRef Hash of the content blob holding the whole action result when content is only a preview.
+	ContentRef *string   `json:"content_ref,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// DurationMs Milliseconds between the creation of the step and its last update, e.g. while streaming.
+	DurationMs   int64                          `json:"duration_ms"`
+	ErrorMessage *string                        `json:"error_message,omitempty"`
+	Id           openapi_types.UUID             `json:"id"`
+	MessageState TurnTranscriptStepMessageState `json:"message_state"`
+	Model        *string                        `json:"model,omitempty"`
+
+	// OffsetMs Milliseconds between the start of the turn and the creation of the step.
+	OffsetMs     int64                  `json:"offset_ms"`
+	PromptTokens int                    `json:"prompt_tokens"`
+	Role         TurnTranscriptStepRole `json:"role"`
+	Seed         *int64                 `json:"seed,omitempty"`
+
+	// SupersededAt Set when the step was replaced by a regenerated response and no longer reaches the model.
+	SupersededAt *time.Time `json:"superseded_at,omitempty"`
+	TotalTokens  int        `json:"total_tokens"`
+	TurnSequence int64      `json:"turn_sequence"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TurnTranscriptStepApprovalStatus defines model for TurnTranscriptStep.ApprovalStatus.
+type TurnTranscriptStepApprovalStatus string
+
+// TurnTranscriptStepMessageState defines model for TurnTranscriptStep.MessageState.
+type TurnTranscriptStepMessageState string
+
+// TurnTranscriptStepRole defines model for TurnTranscriptStep.Role.
+type TurnTranscriptStepRole string
+
+// UpdateConversationRequest Payload to update conversation. At least one of title or settings must be provided.
+type UpdateConversationRequest struct {
+	// Settings Generation settings applied to every turn of the conversation. Unset settings fall back to the chat defaults.
+	Settings *ConversationSettings `json:"settings,omitempty"`
+
+	// Title New title for the conversation. Must be non-empty.
+	Title *string `json:"title,omitempty"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GetBoardSummary request
-	GetBoardSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest Partial update payload. Provide at least one of: title, status, due_date, estimated_minutes, custom_fields, location.
+type UpdateTodoRequest struct {
+	// CustomFields Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
 
-	// StreamChatWithBody request with any body
-	StreamChatWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DueDate Updated calendar due date (date only).
+	DueDate *openapi_types.Date `json:"due_date,omitempty"`
 
-	StreamChat(ctx context.Context, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// EstimatedMinutes Updated estimated effort in minutes. Set to 0 to clear the estimate.
+	EstimatedMinutes *int `json:"estimated_minutes,omitempty"`
 
-	// SubmitActionApprovalWithBody request with any body
-	SubmitActionApprovalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Location Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location.
+	Location *TodoLocation `json:"location,omitempty"`
 
-	SubmitActionApproval(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Status Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
+	Status *TodoStatus `json:"status,omitempty"`
 
-	// ListChatMessages request
-	ListChatMessages(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Title New title for the todo. Must be non-empty if provided.
+	Title *string `json:"title,omitempty"`
+	union json.RawMessage
+}
 
-	// ListAvailableSkills request
-	ListAvailableSkills(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest0 defines model for .
+type UpdateTodoRequest0 = interface{}
 
-	// ListConversations request
-	ListConversations(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest1 defines model for .
+type UpdateTodoRequest1 = interface{}
 
-	// DeleteConversation request
-	DeleteConversation(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest2 defines model for .
+type UpdateTodoRequest2 = interface{}
 
-	// UpdateConversationWithBody request with any body
-	UpdateConversationWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest3 defines model for .
+type UpdateTodoRequest3 = interface{}
 
-	UpdateConversation(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest4 defines model for .
+type UpdateTodoRequest4 = interface{}
 
-	// ListAvailableModels request
-	ListAvailableModels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// UpdateTodoRequest5 defines model for .
+type UpdateTodoRequest5 = interface{}
 
-	// ListTodos request
-	ListTodos(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// View A named todo list filter.
+type View struct {
+	// BuiltIn True for the Today, Upcoming and Someday views, which cannot be changed.
+	BuiltIn bool `json:"built_in"`
 
-	// CreateTodoWithBody request with any body
-	CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CreatedAt Timestamp when the view was saved. Absent for built-in views.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
 
-	CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Filter Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today.
+	Filter ViewFilter `json:"filter"`
 
-	// DeleteTodo request
-	DeleteTodo(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Id Unique identifier for the view. Built-in views have stable identifiers.
+	Id openapi_types.UUID `json:"id"`
 
-	// UpdateTodoWithBody request with any body
-	UpdateTodoWithBody(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Name View name.
+	Name string `json:"name"`
 
-	UpdateTodo(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// UpdatedAt Timestamp when the view was last changed. Absent for built-in views.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
-func (c *Client) GetBoardSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBoardSummaryRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+// ViewFilter Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today.
+type ViewFilter struct {
+	// DueAfter Lower due date bound (YYYY-MM-DD). Must be provided with due_before.
+	DueAfter *openapi_types.Date `json:"due_after,omitempty"`
 
-func (c *Client) StreamChatWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStreamChatRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// DueBefore Upper due date bound (YYYY-MM-DD). Must be provided with due_after.
+	DueBefore *openapi_types.Date `json:"due_before,omitempty"`
+
+	// DueFromDays Lower due date bound in days relative to today, e.g. 1 for tomorrow.
+	DueFromDays *int `json:"due_from_days,omitempty"`
+
+	// DueToDays Upper due date bound in days relative to today, e.g. 7 for a week ahead.
+	DueToDays *int `json:"due_to_days,omitempty"`
+
+	// Query Query expression applied on top of the other fields, using the syntax of the q parameter of the todo list, e.g. status:open area:work -area:errands.
+	Query *string `json:"query,omitempty"`
+
+	// SearchBySimilarity Semantic search query.
+	SearchBySimilarity *string `json:"search_by_similarity,omitempty"`
+
+	// SearchByTitle Title contains query.
+	SearchByTitle *string `json:"search_by_title,omitempty"`
+
+	// SortBy Sorting criteria. Similarity sorting requires search_by_similarity.
+	SortBy *ViewFilterSortBy `json:"sort_by,omitempty"`
+
+	// Status Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed.
+	Status *TodoStatus `json:"status,omitempty"`
 }
 
-func (c *Client) StreamChat(ctx context.Context, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStreamChatRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// ViewFilterSortBy Sorting criteria. Similarity sorting requires search_by_similarity.
+type ViewFilterSortBy string
+
+// ViewRequest Request payload for saving or updating a view.
+type ViewRequest struct {
+	// Filter Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today.
+	Filter ViewFilter `json:"filter"`
+
+	// Name View name, unique regardless of case.
+	Name string `json:"name"`
 }
 
-func (c *Client) SubmitActionApprovalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// WeeklyReview Assistant-written review of the todos that were due in one week.
+type WeeklyReview struct {
+	// Completed Titles of the planned todos that are done.
+	Completed []string `json:"completed"`
+
+	// ConversationId Conversation holding the review report.
+	ConversationId openapi_types.UUID `json:"conversation_id"`
+
+	// GeneratedAt Timestamp when the review was generated.
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// Id Review identifier.
+	Id openapi_types.UUID `json:"id"`
+
+	// Model Model that wrote the report.
+	Model string `json:"model"`
+
+	// PeriodEnd Last day of the reviewed week.
+	PeriodEnd openapi_types.Date `json:"period_end"`
+
+	// PeriodStart First day of the reviewed week.
+	PeriodStart openapi_types.Date `json:"period_start"`
+
+	// Planned Number of todos due in the reviewed week.
+	Planned int `json:"planned"`
+
+	// Report The review report with suggestions for the next week.
+	Report string `json:"report"`
+
+	// Slipped Titles of the planned todos that are still open.
+	Slipped []string `json:"slipped"`
+}
+
+// WeeklyReviewListResp List of weekly reviews.
+type WeeklyReviewListResp struct {
+	// NextPage Next page number. Null if there are no more pages.
+	NextPage *int `json:"next_page"`
+
+	// Page Current page number.
+	Page int `json:"page"`
+
+	// PreviousPage Previous page number. Null if there is no previous page.
+	PreviousPage *int `json:"previous_page"`
+
+	// Reviews List of weekly reviews.
+	Reviews []WeeklyReview `json:"reviews"`
+}
+
+// IncludeActionResults defines model for IncludeActionResults.
+type IncludeActionResults = bool
+
+// BadRequest Standard error envelope.
+type BadRequest = ErrorResp
+
+// Forbidden Standard error envelope.
+type Forbidden = ErrorResp
+
+// NotFound Standard error envelope.
+type NotFound = ErrorResp
+
+// ServiceUnavailable Standard error envelope.
+type ServiceUnavailable = ErrorResp
+
+// Unauthorized Standard error envelope.
+type Unauthorized = ErrorResp
+
+// CompleteOIDCLoginParams defines parameters for CompleteOIDCLogin.
+type CompleteOIDCLoginParams struct {
+	// Code Authorization code issued by the provider.
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// State State of the login, which must match the login cookie.
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error Error code returned by the provider when the user did not sign in.
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
+// ListWeeklyReviewsParams defines parameters for ListWeeklyReviews.
+type ListWeeklyReviewsParams struct {
+	// PageSize Maximum number of reviews to return.
+	PageSize int `form:"pageSize" json:"pageSize"`
+
+	// Page Page number, starting at 1.
+	Page int `form:"page" json:"page"`
+}
+
+// GetBoardSnapshotParams defines parameters for GetBoardSnapshot.
+type GetBoardSnapshotParams struct {
+	// Format Response format. markdown returns the Markdown document as text/markdown.
+	Format *GetBoardSnapshotParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBoardSnapshotParamsFormat defines parameters for GetBoardSnapshot.
+type GetBoardSnapshotParamsFormat string
+
+// StreamChatParams defines parameters for StreamChat.
+type StreamChatParams struct {
+	// IncludeActionResults When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit.
+	IncludeActionResults *IncludeActionResults `form:"include_action_results,omitempty" json:"include_action_results,omitempty"`
+}
+
+// ListInstructionsParams defines parameters for ListInstructions.
+type ListInstructionsParams struct {
+	// ConversationId Conversation identifier (UUID).
+	ConversationId *openapi_types.UUID `form:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+}
+
+// ListChatMessagesParams defines parameters for ListChatMessages.
+type ListChatMessagesParams struct {
+	// ConversationId Identifier for the conversation.
+	ConversationId openapi_types.UUID `form:"conversation_id" json:"conversation_id"`
+
+	// PageSize Maximum number of messages to return (server may cap).
+	PageSize int `form:"pageSize" json:"pageSize"`
+
+	// Page Opaque cursor from a prior ListChatMessagesResp to fetch the next page. Omit or set to null to fetch the first page.
+	Page int `form:"page" json:"page"`
+}
+
+// PreviewChatParams defines parameters for PreviewChat.
+type PreviewChatParams struct {
+	// IncludeActionResults When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit.
+	IncludeActionResults *IncludeActionResults `form:"include_action_results,omitempty" json:"include_action_results,omitempty"`
+}
+
+// ListConversationsParams defines parameters for ListConversations.
+type ListConversationsParams struct {
+	// PageSize Maximum number of messages to return (server may cap).
+	PageSize int `form:"pageSize" json:"pageSize"`
+
+	// Page Opaque cursor from a prior ListChatMessagesResp to fetch the next page. Omit or set to null to fetch the first page.
+	Page int `form:"page" json:"page"`
+}
+
+// EditMessageParams defines parameters for EditMessage.
+type EditMessageParams struct {
+	// IncludeActionResults When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit.
+	IncludeActionResults *IncludeActionResults `form:"include_action_results,omitempty" json:"include_action_results,omitempty"`
+}
+
+// RegenerateMessageParams defines parameters for RegenerateMessage.
+type RegenerateMessageParams struct {
+	// IncludeActionResults When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit.
+	IncludeActionResults *IncludeActionResults `form:"include_action_results,omitempty" json:"include_action_results,omitempty"`
+}
+
+// ReceiveInboundWebhookJSONBody defines parameters for ReceiveInboundWebhook.
+type ReceiveInboundWebhookJSONBody map[string]interface{}
+
+// ReceiveInboundWebhookParams defines parameters for ReceiveInboundWebhook.
+type ReceiveInboundWebhookParams struct {
+	// XGitHubEvent GitHub event name.
+	XGitHubEvent *string `json:"X-GitHub-Event,omitempty"`
+
+	// XWebhookEvent Event name for sources other than GitHub.
+	XWebhookEvent *string `json:"X-Webhook-Event,omitempty"`
+
+	// XHubSignature256 GitHub HMAC-SHA256 signature of the body, `sha256=<hex>`.
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
+
+	// XWebhookSignature HMAC-SHA256 signature of the body, `sha256=<hex>`.
+	XWebhookSignature *string `json:"X-Webhook-Signature,omitempty"`
+
+	// XWebhookSecret Shared secret, for sources that cannot sign payloads.
+	XWebhookSecret *string `json:"X-Webhook-Secret,omitempty"`
+}
+
+// GetTimeReportParams defines parameters for GetTimeReport.
+type GetTimeReportParams struct {
+	// From Inclusive start date (YYYY-MM-DD).
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Exclusive end date (YYYY-MM-DD).
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PullSyncParams defines parameters for PullSync.
+type PullSyncParams struct {
+	// Since Opaque cursor returned by a previous sync.
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit Maximum number of todo changes and of conversation changes to read.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListTodosParams defines parameters for ListTodos.
+type ListTodosParams struct {
+	// PageSize Maximum number of todos to return (server may cap).
+	PageSize int `form:"pageSize" json:"pageSize"`
+
+	// Page Opaque cursor from a prior ListTodosResp to fetch the next page. Omit or set to null to fetch the first page.
+	Page int `form:"page" json:"page"`
+
+	// Status Filter todos by status.
+	Status *TodoStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Search Full-text or semantic search query to retrieve todos most relevant to the provided keywords or context using vector similarity.
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// SearchType The type of search to perform when the 'search' parameter is provided. 'title' performs a case-insensitive substring match on todo titles. 'similarity' uses vector similarity search based on the todo embeddings.
+	SearchType *ListTodosParamsSearchType `form:"searchType,omitempty" json:"searchType,omitempty"`
+	DateRange  *DateRange                 `json:"dateRange,omitempty"`
+
+	// Sort Sorting criteria. smartOrder lists the todos to do first: overdue open todos, then by the rank of the priority custom field, when the tenant defines an ENUM field named priority, and by due date proximity of open todos.
+	Sort *ListTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeArchived Include the completed todos archived by the retention policy. They are hidden by default.
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+
+	// CustomField Filter todos by custom field value, as name:value (e.g. area:work). The value is parsed according to the field type. Repeat the parameter to require several values.
+	CustomField *[]string `form:"customField,omitempty" json:"customField,omitempty"`
+
+	// NearLat Latitude of the point to keep the todos located around, in decimal degrees. Requires nearLon.
+	NearLat *float64 `form:"nearLat,omitempty" json:"nearLat,omitempty"`
+
+	// NearLon Longitude of the point to keep the todos located around, in decimal degrees. Requires nearLat.
+	NearLon *float64 `form:"nearLon,omitempty" json:"nearLon,omitempty"`
+
+	// NearRadiusKm Radius, in kilometers, around nearLat and nearLon within which todos are kept. Todos without coordinates are left out.
+	NearRadiusKm *float64 `form:"nearRadiusKm,omitempty" json:"nearRadiusKm,omitempty"`
+
+	// Q Query expression of space separated terms that must all match, e.g. `status:open due<2026-05-01 area:work -area:errands`. Supported terms are status:open|done; due:DATE, due<DATE, due<=DATE, due>DATE and due>=DATE with DATE as YYYY-MM-DD, today, tomorrow or today+N; title:TEXT; similar:TEXT; sort:SORT; archived:true; and NAME:VALUE or -NAME:VALUE to keep or drop todos by custom field. Other words search the title. Its terms take precedence over the other filter parameters.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+}
+
+// ListTodosParamsSearchType defines parameters for ListTodos.
+type ListTodosParamsSearchType string
+
+// ListTodosParamsSort defines parameters for ListTodos.
+type ListTodosParamsSort string
+
+// ListTodoChangesParams defines parameters for ListTodoChanges.
+type ListTodoChangesParams struct {
+	// Since Return changes with a sequence greater than this value.
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
+
+	// ConversationId Only list changes produced by this conversation.
+	ConversationId *openapi_types.UUID `form:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+
+	// Limit Maximum number of changes to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListTodoCommentsParams defines parameters for ListTodoComments.
+type ListTodoCommentsParams struct {
+	// PageSize Maximum number of comments to return (server may cap).
+	PageSize int `form:"pageSize" json:"pageSize"`
+
+	// Page Page number to fetch, starting at 1.
+	Page int `form:"page" json:"page"`
+}
+
+// CreateBoardSnapshotJSONRequestBody defines body for CreateBoardSnapshot for application/json ContentType.
+type CreateBoardSnapshotJSONRequestBody = CreateBoardSnapshotRequest
+
+// StreamChatJSONRequestBody defines body for StreamChat for application/json ContentType.
+type StreamChatJSONRequestBody = ChatStreamRequest
+
+// SubmitActionApprovalJSONRequestBody defines body for SubmitActionApproval for application/json ContentType.
+type SubmitActionApprovalJSONRequestBody = SubmitActionApprovalRequest
+
+// RememberInstructionJSONRequestBody defines body for RememberInstruction for application/json ContentType.
+type RememberInstructionJSONRequestBody = RememberInstructionRequest
+
+// SubmitMessageFeedbackJSONRequestBody defines body for SubmitMessageFeedback for application/json ContentType.
+type SubmitMessageFeedbackJSONRequestBody = SubmitMessageFeedbackRequest
+
+// PreviewChatJSONRequestBody defines body for PreviewChat for application/json ContentType.
+type PreviewChatJSONRequestBody = ChatStreamRequest
+
+// UpdateConversationJSONRequestBody defines body for UpdateConversation for application/json ContentType.
+type UpdateConversationJSONRequestBody = UpdateConversationRequest
+
+// EditMessageJSONRequestBody defines body for EditMessage for application/json ContentType.
+type EditMessageJSONRequestBody = EditMessageRequest
+
+// RegenerateMessageJSONRequestBody defines body for RegenerateMessage for application/json ContentType.
+type RegenerateMessageJSONRequestBody = RegenerateMessageRequest
+
+// MarkConversationReadJSONRequestBody defines body for MarkConversationRead for application/json ContentType.
+type MarkConversationReadJSONRequestBody = MarkConversationReadRequest
+
+// PostSystemMessageJSONRequestBody defines body for PostSystemMessage for application/json ContentType.
+type PostSystemMessageJSONRequestBody = PostSystemMessageRequest
+
+// DefineCustomFieldJSONRequestBody defines body for DefineCustomField for application/json ContentType.
+type DefineCustomFieldJSONRequestBody = DefineCustomFieldRequest
+
+// ReceiveInboundWebhookJSONRequestBody defines body for ReceiveInboundWebhook for application/json ContentType.
+type ReceiveInboundWebhookJSONRequestBody ReceiveInboundWebhookJSONBody
+
+// AcceptProjectSuggestionJSONRequestBody defines body for AcceptProjectSuggestion for application/json ContentType.
+type AcceptProjectSuggestionJSONRequestBody = AcceptProjectSuggestionRequest
+
+// StartSessionJSONRequestBody defines body for StartSession for application/json ContentType.
+type StartSessionJSONRequestBody = StartSessionRequest
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = RefreshSessionRequest
+
+// PushSyncJSONRequestBody defines body for PushSync for application/json ContentType.
+type PushSyncJSONRequestBody = SyncPushRequest
+
+// SaveTemplateJSONRequestBody defines body for SaveTemplate for application/json ContentType.
+type SaveTemplateJSONRequestBody = TemplateRequest
+
+// UpdateTemplateJSONRequestBody defines body for UpdateTemplate for application/json ContentType.
+type UpdateTemplateJSONRequestBody = TemplateRequest
+
+// ApplyTemplateJSONRequestBody defines body for ApplyTemplate for application/json ContentType.
+type ApplyTemplateJSONRequestBody = ApplyTemplateRequest
+
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = CreateTodoRequest
+
+// QuickAddTodoJSONRequestBody defines body for QuickAddTodo for application/json ContentType.
+type QuickAddTodoJSONRequestBody = QuickAddRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodoRequest
+
+// CreateTodoCommentJSONRequestBody defines body for CreateTodoComment for application/json ContentType.
+type CreateTodoCommentJSONRequestBody = CommentRequest
+
+// UpdateTodoCommentJSONRequestBody defines body for UpdateTodoComment for application/json ContentType.
+type UpdateTodoCommentJSONRequestBody = CommentRequest
+
+// CreateTokenJSONRequestBody defines body for CreateToken for application/json ContentType.
+type CreateTokenJSONRequestBody = CreateTokenRequest
+
+// SaveViewJSONRequestBody defines body for SaveView for application/json ContentType.
+type SaveViewJSONRequestBody = ViewRequest
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = ViewRequest
+
+// AsDateRange0 returns the union data inside the DateRange as a DateRange0
+func (t DateRange) AsDateRange0() (DateRange0, error) {
+	var body DateRange0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDateRange0 overwrites any union data inside the DateRange as the provided DateRange0
+func (t *DateRange) FromDateRange0(v DateRange0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDateRange0 performs a merge with any union data inside the DateRange, using the provided DateRange0
+func (t *DateRange) MergeDateRange0(v DateRange0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDateRange1 returns the union data inside the DateRange as a DateRange1
+func (t DateRange) AsDateRange1() (DateRange1, error) {
+	var body DateRange1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDateRange1 overwrites any union data inside the DateRange as the provided DateRange1
+func (t *DateRange) FromDateRange1(v DateRange1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDateRange1 performs a merge with any union data inside the DateRange, using the provided DateRange1
+func (t *DateRange) MergeDateRange1(v DateRange1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DateRange) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.DueAfter != nil {
+		object["dueAfter"], err = json.Marshal(t.DueAfter)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dueAfter': %w", err)
+		}
+	}
+
+	if t.DueBefore != nil {
+		object["dueBefore"], err = json.Marshal(t.DueBefore)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dueBefore': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *DateRange) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["dueAfter"]; found {
+		err = json.Unmarshal(raw, &t.DueAfter)
+		if err != nil {
+			return fmt.Errorf("error reading 'dueAfter': %w", err)
+		}
+	}
+
+	if raw, found := object["dueBefore"]; found {
+		err = json.Unmarshal(raw, &t.DueBefore)
+		if err != nil {
+			return fmt.Errorf("error reading 'dueBefore': %w", err)
+		}
+	}
+
+	return err
+}
+
+// AsUpdateTodoRequest0 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest0
+func (t UpdateTodoRequest) AsUpdateTodoRequest0() (UpdateTodoRequest0, error) {
+	var body UpdateTodoRequest0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUpdateTodoRequest0 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest0
+func (t *UpdateTodoRequest) FromUpdateTodoRequest0(v UpdateTodoRequest0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUpdateTodoRequest0 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest0
+func (t *UpdateTodoRequest) MergeUpdateTodoRequest0(v UpdateTodoRequest0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUpdateTodoRequest1 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest1
+func (t UpdateTodoRequest) AsUpdateTodoRequest1() (UpdateTodoRequest1, error) {
+	var body UpdateTodoRequest1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUpdateTodoRequest1 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest1
+func (t *UpdateTodoRequest) FromUpdateTodoRequest1(v UpdateTodoRequest1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUpdateTodoRequest1 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest1
+func (t *UpdateTodoRequest) MergeUpdateTodoRequest1(v UpdateTodoRequest1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUpdateTodoRequest2 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest2
+func (t UpdateTodoRequest) AsUpdateTodoRequest2() (UpdateTodoRequest2, error) {
+	var body UpdateTodoRequest2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUpdateTodoRequest2 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest2
+func (t *UpdateTodoRequest) FromUpdateTodoRequest2(v UpdateTodoRequest2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUpdateTodoRequest2 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest2
+func (t *UpdateTodoRequest) MergeUpdateTodoRequest2(v UpdateTodoRequest2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUpdateTodoRequest3 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest3
+func (t UpdateTodoRequest) AsUpdateTodoRequest3() (UpdateTodoRequest3, error) {
+	var body UpdateTodoRequest3
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUpdateTodoRequest3 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest3
+func (t *UpdateTodoRequest) FromUpdateTodoRequest3(v UpdateTodoRequest3) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUpdateTodoRequest3 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest3
+func (t *UpdateTodoRequest) MergeUpdateTodoRequest3(v UpdateTodoRequest3) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUpdateTodoRequest4 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest4
+func (t UpdateTodoRequest) AsUpdateTodoRequest4() (UpdateTodoRequest4, error) {
+	var body UpdateTodoRequest4
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUpdateTodoRequest4 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest4
+func (t *UpdateTodoRequest) FromUpdateTodoRequest4(v UpdateTodoRequest4) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUpdateTodoRequest4 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest4
+func (t *UpdateTodoRequest) MergeUpdateTodoRequest4(v UpdateTodoRequest4) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsUpdateTodoRequest5 returns the union data inside the UpdateTodoRequest as a UpdateTodoRequest5
+func (t UpdateTodoRequest) AsUpdateTodoRequest5() (UpdateTodoRequest5, error) {
+	var body UpdateTodoRequest5
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUpdateTodoRequest5 overwrites any union data inside the UpdateTodoRequest as the provided UpdateTodoRequest5
+func (t *UpdateTodoRequest) FromUpdateTodoRequest5(v UpdateTodoRequest5) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUpdateTodoRequest5 performs a merge with any union data inside the UpdateTodoRequest, using the provided UpdateTodoRequest5
+func (t *UpdateTodoRequest) MergeUpdateTodoRequest5(v UpdateTodoRequest5) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t UpdateTodoRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.CustomFields != nil {
+		object["custom_fields"], err = json.Marshal(t.CustomFields)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'custom_fields': %w", err)
+		}
+	}
+
+	if t.DueDate != nil {
+		object["due_date"], err = json.Marshal(t.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'due_date': %w", err)
+		}
+	}
+
+	if t.EstimatedMinutes != nil {
+		object["estimated_minutes"], err = json.Marshal(t.EstimatedMinutes)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'estimated_minutes': %w", err)
+		}
+	}
+
+	if t.Location != nil {
+		object["location"], err = json.Marshal(t.Location)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'location': %w", err)
+		}
+	}
+
+	if t.Status != nil {
+		object["status"], err = json.Marshal(t.Status)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'status': %w", err)
+		}
+	}
+
+	if t.Title != nil {
+		object["title"], err = json.Marshal(t.Title)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'title': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *UpdateTodoRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["custom_fields"]; found {
+		err = json.Unmarshal(raw, &t.CustomFields)
+		if err != nil {
+			return fmt.Errorf("error reading 'custom_fields': %w", err)
+		}
+	}
+
+	if raw, found := object["due_date"]; found {
+		err = json.Unmarshal(raw, &t.DueDate)
+		if err != nil {
+			return fmt.Errorf("error reading 'due_date': %w", err)
+		}
+	}
+
+	if raw, found := object["estimated_minutes"]; found {
+		err = json.Unmarshal(raw, &t.EstimatedMinutes)
+		if err != nil {
+			return fmt.Errorf("error reading 'estimated_minutes': %w", err)
+		}
+	}
+
+	if raw, found := object["location"]; found {
+		err = json.Unmarshal(raw, &t.Location)
+		if err != nil {
+			return fmt.Errorf("error reading 'location': %w", err)
+		}
+	}
+
+	if raw, found := object["status"]; found {
+		err = json.Unmarshal(raw, &t.Status)
+		if err != nil {
+			return fmt.Errorf("error reading 'status': %w", err)
+		}
+	}
+
+	if raw, found := object["title"]; found {
+		err = json.Unmarshal(raw, &t.Title)
+		if err != nil {
+			return fmt.Errorf("error reading 'title': %w", err)
+		}
+	}
+
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// CompleteOIDCLogin request
+	CompleteOIDCLogin(ctx context.Context, params *CompleteOIDCLoginParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BeginOIDCLogin request
+	BeginOIDCLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWeeklyReviews request
+	ListWeeklyReviews(ctx context.Context, params *ListWeeklyReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBoardSnapshotWithBody request with any body
+	CreateBoardSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBoardSnapshot(ctx context.Context, body CreateBoardSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoardSnapshot request
+	GetBoardSnapshot(ctx context.Context, token string, params *GetBoardSnapshotParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBoardSummary request
+	GetBoardSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamChatWithBody request with any body
+	StreamChatWithBody(ctx context.Context, params *StreamChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StreamChat(ctx context.Context, params *StreamChatParams, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitActionApprovalWithBody request with any body
+	SubmitActionApprovalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitActionApproval(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetContentBlob request
+	GetContentBlob(ctx context.Context, hash string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInstructions request
+	ListInstructions(ctx context.Context, params *ListInstructionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RememberInstructionWithBody request with any body
+	RememberInstructionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RememberInstruction(ctx context.Context, body RememberInstructionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInstruction request
+	DeleteInstruction(ctx context.Context, instructionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMemories request
+	ListMemories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMemory request
+	DeleteMemory(ctx context.Context, memoryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListChatMessages request
+	ListChatMessages(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitMessageFeedbackWithBody request with any body
+	SubmitMessageFeedbackWithBody(ctx context.Context, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitMessageFeedback(ctx context.Context, messageId openapi_types.UUID, body SubmitMessageFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewChatWithBody request with any body
+	PreviewChatWithBody(ctx context.Context, params *PreviewChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewChat(ctx context.Context, params *PreviewChatParams, body PreviewChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAvailableSkills request
+	ListAvailableSkills(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListConversations request
+	ListConversations(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamConversationEvents request
+	StreamConversationEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRelatedConversations request
+	ListRelatedConversations(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteConversation request
+	DeleteConversation(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateConversationWithBody request with any body
+	UpdateConversationWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateConversation(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditMessageWithBody request with any body
+	EditMessageWithBody(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditMessage(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, body EditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegenerateMessageWithBody request with any body
+	RegenerateMessageWithBody(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegenerateMessage(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, body RegenerateMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MarkConversationReadWithBody request with any body
+	MarkConversationReadWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MarkConversationRead(ctx context.Context, conversationId openapi_types.UUID, body MarkConversationReadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSystemMessageWithBody request with any body
+	PostSystemMessageWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSystemMessage(ctx context.Context, conversationId openapi_types.UUID, body PostSystemMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConversationTurn request
+	GetConversationTurn(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplayConversationTurn request
+	ReplayConversationTurn(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCustomFields request
+	ListCustomFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCustomField request
+	DeleteCustomField(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DefineCustomFieldWithBody request with any body
+	DefineCustomFieldWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DefineCustomField(ctx context.Context, name string, body DefineCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGraphQLSchema request
+	GetGraphQLSchema(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReceiveInboundWebhookWithBody request with any body
+	ReceiveInboundWebhookWithBody(ctx context.Context, source string, params *ReceiveInboundWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReceiveInboundWebhook(ctx context.Context, source string, params *ReceiveInboundWebhookParams, body ReceiveInboundWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAvailableModels request
+	ListAvailableModels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOpenAPISpec request
+	GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjects request
+	ListProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjectSuggestions request
+	ListProjectSuggestions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcceptProjectSuggestionWithBody request with any body
+	AcceptProjectSuggestionWithBody(ctx context.Context, suggestionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AcceptProjectSuggestion(ctx context.Context, suggestionId openapi_types.UUID, body AcceptProjectSuggestionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSessions request
+	ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartSessionWithBody request with any body
+	StartSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartSession(ctx context.Context, body StartSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshSessionWithBody request with any body
+	RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeSession request
+	RevokeSession(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeReport request
+	GetTimeReport(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PullSync request
+	PullSync(ctx context.Context, params *PullSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PushSyncWithBody request with any body
+	PushSyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PushSync(ctx context.Context, body PushSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTemplates request
+	ListTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveTemplateWithBody request with any body
+	SaveTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SaveTemplate(ctx context.Context, body SaveTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTemplate request
+	DeleteTemplate(ctx context.Context, templateId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTemplateWithBody request with any body
+	UpdateTemplateWithBody(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTemplate(ctx context.Context, templateId openapi_types.UUID, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyTemplateWithBody request with any body
+	ApplyTemplateWithBody(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApplyTemplate(ctx context.Context, templateId openapi_types.UUID, body ApplyTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTodos request
+	ListTodos(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoWithBody request with any body
+	CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTodoChanges request
+	ListTodoChanges(ctx context.Context, params *ListTodoChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamTodoEvents request
+	StreamTodoEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QuickAddTodoWithBody request with any body
+	QuickAddTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QuickAddTodo(ctx context.Context, body QuickAddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodo request
+	DeleteTodo(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTodoWithBody request with any body
+	UpdateTodoWithBody(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodo(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTodoComments request
+	ListTodoComments(ctx context.Context, todoId openapi_types.UUID, params *ListTodoCommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoCommentWithBody request with any body
+	CreateTodoCommentWithBody(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodoComment(ctx context.Context, todoId openapi_types.UUID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodoComment request
+	DeleteTodoComment(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTodoCommentWithBody request with any body
+	UpdateTodoCommentWithBody(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodoComment(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTodo request
+	RestoreTodo(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartTodoTimer request
+	StartTodoTimer(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StopTodoTimer request
+	StopTodoTimer(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTokens request
+	ListTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTokenWithBody request with any body
+	CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateToken(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeToken request
+	RevokeToken(ctx context.Context, tokenId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListViews request
+	ListViews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveViewWithBody request with any body
+	SaveViewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SaveView(ctx context.Context, body SaveViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteView request
+	DeleteView(ctx context.Context, viewId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateViewWithBody request with any body
+	UpdateViewWithBody(ctx context.Context, viewId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateView(ctx context.Context, viewId openapi_types.UUID, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) CompleteOIDCLogin(ctx context.Context, params *CompleteOIDCLoginParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteOIDCLoginRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BeginOIDCLogin(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBeginOIDCLoginRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListWeeklyReviews(ctx context.Context, params *ListWeeklyReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWeeklyReviewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBoardSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBoardSnapshotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBoardSnapshot(ctx context.Context, body CreateBoardSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBoardSnapshotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBoardSnapshot(ctx context.Context, token string, params *GetBoardSnapshotParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardSnapshotRequest(c.Server, token, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBoardSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBoardSummaryRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamChatWithBody(ctx context.Context, params *StreamChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamChatRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamChat(ctx context.Context, params *StreamChatParams, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamChatRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitActionApprovalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSubmitActionApprovalRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitActionApproval(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitActionApprovalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetContentBlob(ctx context.Context, hash string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContentBlobRequest(c.Server, hash)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInstructions(ctx context.Context, params *ListInstructionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInstructionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RememberInstructionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRememberInstructionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RememberInstruction(ctx context.Context, body RememberInstructionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRememberInstructionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInstruction(ctx context.Context, instructionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInstructionRequest(c.Server, instructionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListMemories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMemoriesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteMemory(ctx context.Context, memoryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMemoryRequest(c.Server, memoryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListChatMessages(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListChatMessagesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitMessageFeedbackWithBody(ctx context.Context, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitMessageFeedbackRequestWithBody(c.Server, messageId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitMessageFeedback(ctx context.Context, messageId openapi_types.UUID, body SubmitMessageFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitMessageFeedbackRequest(c.Server, messageId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewChatWithBody(ctx context.Context, params *PreviewChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewChatRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewChat(ctx context.Context, params *PreviewChatParams, body PreviewChatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewChatRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAvailableSkills(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAvailableSkillsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListConversations(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListConversationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamConversationEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamConversationEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRelatedConversations(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRelatedConversationsRequest(c.Server, conversationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteConversation(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteConversationRequest(c.Server, conversationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateConversationWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateConversationRequestWithBody(c.Server, conversationId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateConversation(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateConversationRequest(c.Server, conversationId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditMessageWithBody(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditMessageRequestWithBody(c.Server, conversationId, messageId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditMessage(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, body EditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditMessageRequest(c.Server, conversationId, messageId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateMessageWithBody(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateMessageRequestWithBody(c.Server, conversationId, messageId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegenerateMessage(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, body RegenerateMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegenerateMessageRequest(c.Server, conversationId, messageId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarkConversationReadWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkConversationReadRequestWithBody(c.Server, conversationId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarkConversationRead(ctx context.Context, conversationId openapi_types.UUID, body MarkConversationReadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkConversationReadRequest(c.Server, conversationId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSystemMessageWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSystemMessageRequestWithBody(c.Server, conversationId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSystemMessage(ctx context.Context, conversationId openapi_types.UUID, body PostSystemMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSystemMessageRequest(c.Server, conversationId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConversationTurn(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConversationTurnRequest(c.Server, conversationId, turnId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplayConversationTurn(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplayConversationTurnRequest(c.Server, conversationId, turnId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCustomFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCustomFieldsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCustomField(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCustomFieldRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DefineCustomFieldWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDefineCustomFieldRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DefineCustomField(ctx context.Context, name string, body DefineCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDefineCustomFieldRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGraphQLSchema(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGraphQLSchemaRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReceiveInboundWebhookWithBody(ctx context.Context, source string, params *ReceiveInboundWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReceiveInboundWebhookRequestWithBody(c.Server, source, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReceiveInboundWebhook(ctx context.Context, source string, params *ReceiveInboundWebhookParams, body ReceiveInboundWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReceiveInboundWebhookRequest(c.Server, source, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAvailableModels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAvailableModelsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenAPISpecRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProjectSuggestions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectSuggestionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptProjectSuggestionWithBody(ctx context.Context, suggestionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptProjectSuggestionRequestWithBody(c.Server, suggestionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptProjectSuggestion(ctx context.Context, suggestionId openapi_types.UUID, body AcceptProjectSuggestionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptProjectSuggestionRequest(c.Server, suggestionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartSession(ctx context.Context, body StartSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeSession(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeSessionRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTimeReport(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimeReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PullSync(ctx context.Context, params *PullSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPullSyncRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PushSyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPushSyncRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PushSync(ctx context.Context, body PushSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPushSyncRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveTemplateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveTemplate(ctx context.Context, body SaveTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveTemplateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTemplate(ctx context.Context, templateId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTemplateRequest(c.Server, templateId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTemplateWithBody(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTemplateRequestWithBody(c.Server, templateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTemplate(ctx context.Context, templateId openapi_types.UUID, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTemplateRequest(c.Server, templateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyTemplateWithBody(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyTemplateRequestWithBody(c.Server, templateId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyTemplate(ctx context.Context, templateId openapi_types.UUID, body ApplyTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyTemplateRequest(c.Server, templateId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTodos(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTodoChanges(ctx context.Context, params *ListTodoChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodoChangesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamTodoEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamTodoEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QuickAddTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuickAddTodoRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QuickAddTodo(ctx context.Context, body QuickAddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQuickAddTodoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodo(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodo(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTodoComments(ctx context.Context, todoId openapi_types.UUID, params *ListTodoCommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodoCommentsRequest(c.Server, todoId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoCommentWithBody(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoCommentRequestWithBody(c.Server, todoId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoComment(ctx context.Context, todoId openapi_types.UUID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoCommentRequest(c.Server, todoId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodoComment(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoCommentRequest(c.Server, todoId, commentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoCommentWithBody(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoCommentRequestWithBody(c.Server, todoId, commentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoComment(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoCommentRequest(c.Server, todoId, commentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTodo(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartTodoTimer(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartTodoTimerRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StopTodoTimer(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopTodoTimerRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTokensRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateToken(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeToken(ctx context.Context, tokenId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeTokenRequest(c.Server, tokenId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListViews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListViewsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveViewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveViewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SaveView(ctx context.Context, body SaveViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveViewRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteView(ctx context.Context, viewId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteViewRequest(c.Server, viewId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateViewWithBody(ctx context.Context, viewId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateViewRequestWithBody(c.Server, viewId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateView(ctx context.Context, viewId openapi_types.UUID, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateViewRequest(c.Server, viewId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCompleteOIDCLoginRequest generates requests for CompleteOIDCLogin
+func NewCompleteOIDCLoginRequest(server string, params *CompleteOIDCLoginParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/oidc/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBeginOIDCLoginRequest generates requests for BeginOIDCLogin
+func NewBeginOIDCLoginRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/auth/oidc/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWeeklyReviewsRequest generates requests for ListWeeklyReviews
+func NewListWeeklyReviewsRequest(server string, params *ListWeeklyReviewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/board/reviews")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBoardSnapshotRequest calls the generic CreateBoardSnapshot builder with application/json body
+func NewCreateBoardSnapshotRequest(server string, body CreateBoardSnapshotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBoardSnapshotRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBoardSnapshotRequestWithBody generates requests for CreateBoardSnapshot with any type of body
+func NewCreateBoardSnapshotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/board/snapshots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBoardSnapshotRequest generates requests for GetBoardSnapshot
+func NewGetBoardSnapshotRequest(server string, token string, params *GetBoardSnapshotParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/board/snapshots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBoardSummaryRequest generates requests for GetBoardSummary
+func NewGetBoardSummaryRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/board/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamChatRequest calls the generic StreamChat builder with application/json body
+func NewStreamChatRequest(server string, params *StreamChatParams, body StreamChatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStreamChatRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewStreamChatRequestWithBody generates requests for StreamChat with any type of body
+func NewStreamChatRequestWithBody(server string, params *StreamChatParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeActionResults != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_action_results", runtime.ParamLocationQuery, *params.IncludeActionResults); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSubmitActionApprovalRequest calls the generic SubmitActionApproval builder with application/json body
+func NewSubmitActionApprovalRequest(server string, body SubmitActionApprovalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitActionApprovalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitActionApprovalRequestWithBody generates requests for SubmitActionApproval with any type of body
+func NewSubmitActionApprovalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/approvals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetContentBlobRequest generates requests for GetContentBlob
+func NewGetContentBlobRequest(server string, hash string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "hash", runtime.ParamLocationPath, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/blobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInstructionsRequest generates requests for ListInstructions
+func NewListInstructionsRequest(server string, params *ListInstructionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/instructions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ConversationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conversation_id", runtime.ParamLocationQuery, *params.ConversationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRememberInstructionRequest calls the generic RememberInstruction builder with application/json body
+func NewRememberInstructionRequest(server string, body RememberInstructionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRememberInstructionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRememberInstructionRequestWithBody generates requests for RememberInstruction with any type of body
+func NewRememberInstructionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/instructions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInstructionRequest generates requests for DeleteInstruction
+func NewDeleteInstructionRequest(server string, instructionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "instruction_id", runtime.ParamLocationPath, instructionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/instructions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMemoriesRequest generates requests for ListMemories
+func NewListMemoriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/memories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteMemoryRequest generates requests for DeleteMemory
+func NewDeleteMemoryRequest(server string, memoryId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "memory_id", runtime.ParamLocationPath, memoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/memories/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListChatMessagesRequest generates requests for ListChatMessages
+func NewListChatMessagesRequest(server string, params *ListChatMessagesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/messages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conversation_id", runtime.ParamLocationQuery, params.ConversationId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitMessageFeedbackRequest calls the generic SubmitMessageFeedback builder with application/json body
+func NewSubmitMessageFeedbackRequest(server string, messageId openapi_types.UUID, body SubmitMessageFeedbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitMessageFeedbackRequestWithBody(server, messageId, "application/json", bodyReader)
+}
+
+// NewSubmitMessageFeedbackRequestWithBody generates requests for SubmitMessageFeedback with any type of body
+func NewSubmitMessageFeedbackRequestWithBody(server string, messageId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "message_id", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/messages/%s/feedback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewChatRequest calls the generic PreviewChat builder with application/json body
+func NewPreviewChatRequest(server string, params *PreviewChatParams, body PreviewChatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewChatRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPreviewChatRequestWithBody generates requests for PreviewChat with any type of body
+func NewPreviewChatRequestWithBody(server string, params *PreviewChatParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeActionResults != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_action_results", runtime.ParamLocationQuery, *params.IncludeActionResults); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAvailableSkillsRequest generates requests for ListAvailableSkills
+func NewListAvailableSkillsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/chat/skills")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListConversationsRequest generates requests for ListConversations
+func NewListConversationsRequest(server string, params *ListConversationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamConversationEventsRequest generates requests for StreamConversationEvents
+func NewStreamConversationEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRelatedConversationsRequest generates requests for ListRelatedConversations
+func NewListRelatedConversationsRequest(server string, conversationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/related/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteConversationRequest generates requests for DeleteConversation
+func NewDeleteConversationRequest(server string, conversationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateConversationRequest calls the generic UpdateConversation builder with application/json body
+func NewUpdateConversationRequest(server string, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateConversationRequestWithBody(server, conversationId, "application/json", bodyReader)
+}
+
+// NewUpdateConversationRequestWithBody generates requests for UpdateConversation with any type of body
+func NewUpdateConversationRequestWithBody(server string, conversationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEditMessageRequest calls the generic EditMessage builder with application/json body
+func NewEditMessageRequest(server string, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, body EditMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditMessageRequestWithBody(server, conversationId, messageId, params, "application/json", bodyReader)
+}
+
+// NewEditMessageRequestWithBody generates requests for EditMessage with any type of body
+func NewEditMessageRequestWithBody(server string, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "message_id", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s/messages/%s/edit", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeActionResults != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_action_results", runtime.ParamLocationQuery, *params.IncludeActionResults); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegenerateMessageRequest calls the generic RegenerateMessage builder with application/json body
+func NewRegenerateMessageRequest(server string, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, body RegenerateMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegenerateMessageRequestWithBody(server, conversationId, messageId, params, "application/json", bodyReader)
+}
+
+// NewRegenerateMessageRequestWithBody generates requests for RegenerateMessage with any type of body
+func NewRegenerateMessageRequestWithBody(server string, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "message_id", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s/messages/%s/regenerate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeActionResults != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_action_results", runtime.ParamLocationQuery, *params.IncludeActionResults); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMarkConversationReadRequest calls the generic MarkConversationRead builder with application/json body
+func NewMarkConversationReadRequest(server string, conversationId openapi_types.UUID, body MarkConversationReadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMarkConversationReadRequestWithBody(server, conversationId, "application/json", bodyReader)
+}
+
+// NewMarkConversationReadRequestWithBody generates requests for MarkConversationRead with any type of body
+func NewMarkConversationReadRequestWithBody(server string, conversationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSystemMessageRequest calls the generic PostSystemMessage builder with application/json body
+func NewPostSystemMessageRequest(server string, conversationId openapi_types.UUID, body PostSystemMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSystemMessageRequestWithBody(server, conversationId, "application/json", bodyReader)
+}
+
+// NewPostSystemMessageRequestWithBody generates requests for PostSystemMessage with any type of body
+func NewPostSystemMessageRequestWithBody(server string, conversationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s/system-messages", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetConversationTurnRequest generates requests for GetConversationTurn
+func NewGetConversationTurnRequest(server string, conversationId openapi_types.UUID, turnId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "turn_id", runtime.ParamLocationPath, turnId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s/turns/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplayConversationTurnRequest generates requests for ReplayConversationTurn
+func NewReplayConversationTurnRequest(server string, conversationId openapi_types.UUID, turnId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "turn_id", runtime.ParamLocationPath, turnId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/conversations/%s/turns/%s/replay", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCustomFieldsRequest generates requests for ListCustomFields
+func NewListCustomFieldsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/custom-fields")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCustomFieldRequest generates requests for DeleteCustomField
+func NewDeleteCustomFieldRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/custom-fields/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDefineCustomFieldRequest calls the generic DefineCustomField builder with application/json body
+func NewDefineCustomFieldRequest(server string, name string, body DefineCustomFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDefineCustomFieldRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewDefineCustomFieldRequestWithBody generates requests for DefineCustomField with any type of body
+func NewDefineCustomFieldRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/custom-fields/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetGraphQLSchemaRequest generates requests for GetGraphQLSchema
+func NewGetGraphQLSchemaRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/graphql/schema")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReceiveInboundWebhookRequest calls the generic ReceiveInboundWebhook builder with application/json body
+func NewReceiveInboundWebhookRequest(server string, source string, params *ReceiveInboundWebhookParams, body ReceiveInboundWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReceiveInboundWebhookRequestWithBody(server, source, params, "application/json", bodyReader)
+}
+
+// NewReceiveInboundWebhookRequestWithBody generates requests for ReceiveInboundWebhook with any type of body
+func NewReceiveInboundWebhookRequestWithBody(server string, source string, params *ReceiveInboundWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "source", runtime.ParamLocationPath, source)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/inbound/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XGitHubEvent != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-GitHub-Event", runtime.ParamLocationHeader, *params.XGitHubEvent)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-GitHub-Event", headerParam0)
+		}
+
+		if params.XWebhookEvent != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Webhook-Event", runtime.ParamLocationHeader, *params.XWebhookEvent)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Webhook-Event", headerParam1)
+		}
+
+		if params.XHubSignature256 != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-Hub-Signature-256", runtime.ParamLocationHeader, *params.XHubSignature256)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Hub-Signature-256", headerParam2)
+		}
+
+		if params.XWebhookSignature != nil {
+			var headerParam3 string
+
+			headerParam3, err = runtime.StyleParamWithLocation("simple", false, "X-Webhook-Signature", runtime.ParamLocationHeader, *params.XWebhookSignature)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Webhook-Signature", headerParam3)
+		}
+
+		if params.XWebhookSecret != nil {
+			var headerParam4 string
+
+			headerParam4, err = runtime.StyleParamWithLocation("simple", false, "X-Webhook-Secret", runtime.ParamLocationHeader, *params.XWebhookSecret)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Webhook-Secret", headerParam4)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListAvailableModelsRequest generates requests for ListAvailableModels
+func NewListAvailableModelsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/models")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOpenAPISpecRequest generates requests for GetOpenAPISpec
+func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/openapi.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectsRequest generates requests for ListProjects
+func NewListProjectsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectSuggestionsRequest generates requests for ListProjectSuggestions
+func NewListProjectSuggestionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/suggestions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAcceptProjectSuggestionRequest calls the generic AcceptProjectSuggestion builder with application/json body
+func NewAcceptProjectSuggestionRequest(server string, suggestionId openapi_types.UUID, body AcceptProjectSuggestionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAcceptProjectSuggestionRequestWithBody(server, suggestionId, "application/json", bodyReader)
+}
+
+// NewAcceptProjectSuggestionRequestWithBody generates requests for AcceptProjectSuggestion with any type of body
+func NewAcceptProjectSuggestionRequestWithBody(server string, suggestionId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "suggestion_id", runtime.ParamLocationPath, suggestionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/projects/suggestions/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSessionsRequest generates requests for ListSessions
+func NewListSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartSessionRequest calls the generic StartSession builder with application/json body
+func NewStartSessionRequest(server string, body StartSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewStartSessionRequestWithBody generates requests for StartSession with any type of body
+func NewStartSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshSessionRequest calls the generic RefreshSession builder with application/json body
+func NewRefreshSessionRequest(server string, body RefreshSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshSessionRequestWithBody generates requests for RefreshSession with any type of body
+func NewRefreshSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sessions/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeSessionRequest generates requests for RevokeSession
+func NewRevokeSessionRequest(server string, sessionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTimeReportRequest generates requests for GetTimeReport
+func NewGetTimeReportRequest(server string, params *GetTimeReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stats/time")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPullSyncRequest generates requests for PullSync
+func NewPullSyncRequest(server string, params *PullSyncParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPushSyncRequest calls the generic PushSync builder with application/json body
+func NewPushSyncRequest(server string, body PushSyncJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPushSyncRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPushSyncRequestWithBody generates requests for PushSync with any type of body
+func NewPushSyncRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/sync")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTemplatesRequest generates requests for ListTemplates
+func NewListTemplatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSaveTemplateRequest calls the generic SaveTemplate builder with application/json body
+func NewSaveTemplateRequest(server string, body SaveTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSaveTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSaveTemplateRequestWithBody generates requests for SaveTemplate with any type of body
+func NewSaveTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTemplateRequest generates requests for DeleteTemplate
+func NewDeleteTemplateRequest(server string, templateId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "template_id", runtime.ParamLocationPath, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTemplateRequest calls the generic UpdateTemplate builder with application/json body
+func NewUpdateTemplateRequest(server string, templateId openapi_types.UUID, body UpdateTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTemplateRequestWithBody(server, templateId, "application/json", bodyReader)
+}
+
+// NewUpdateTemplateRequestWithBody generates requests for UpdateTemplate with any type of body
+func NewUpdateTemplateRequestWithBody(server string, templateId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "template_id", runtime.ParamLocationPath, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApplyTemplateRequest calls the generic ApplyTemplate builder with application/json body
+func NewApplyTemplateRequest(server string, templateId openapi_types.UUID, body ApplyTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApplyTemplateRequestWithBody(server, templateId, "application/json", bodyReader)
+}
+
+// NewApplyTemplateRequestWithBody generates requests for ApplyTemplate with any type of body
+func NewApplyTemplateRequestWithBody(server string, templateId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "template_id", runtime.ParamLocationPath, templateId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/templates/%s/apply", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTodosRequest generates requests for ListTodos
+func NewListTodosRequest(server string, params *ListTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SearchType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "searchType", runtime.ParamLocationQuery, *params.SearchType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateRange != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "dateRange", runtime.ParamLocationQuery, *params.DateRange); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeArchived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CustomField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "customField", runtime.ParamLocationQuery, *params.CustomField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NearLat != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nearLat", runtime.ParamLocationQuery, *params.NearLat); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NearLon != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nearLon", runtime.ParamLocationQuery, *params.NearLon); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NearRadiusKm != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nearRadiusKm", runtime.ParamLocationQuery, *params.NearRadiusKm); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
+func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
+func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTodoChangesRequest generates requests for ListTodoChanges
+func NewListTodoChangesRequest(server string, params *ListTodoChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConversationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conversation_id", runtime.ParamLocationQuery, *params.ConversationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamTodoEventsRequest generates requests for StreamTodoEvents
+func NewStreamTodoEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQuickAddTodoRequest calls the generic QuickAddTodo builder with application/json body
+func NewQuickAddTodoRequest(server string, body QuickAddTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQuickAddTodoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQuickAddTodoRequestWithBody generates requests for QuickAddTodo with any type of body
+func NewQuickAddTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/quick-add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoRequest generates requests for DeleteTodo
+func NewDeleteTodoRequest(server string, todoId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
+func NewUpdateTodoRequest(server string, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
+func NewUpdateTodoRequestWithBody(server string, todoId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTodoCommentsRequest generates requests for ListTodoComments
+func NewListTodoCommentsRequest(server string, todoId openapi_types.UUID, params *ListTodoCommentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoCommentRequest calls the generic CreateTodoComment builder with application/json body
+func NewCreateTodoCommentRequest(server string, todoId openapi_types.UUID, body CreateTodoCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoCommentRequestWithBody(server, todoId, "application/json", bodyReader)
+}
+
+// NewCreateTodoCommentRequestWithBody generates requests for CreateTodoComment with any type of body
+func NewCreateTodoCommentRequestWithBody(server string, todoId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoCommentRequest generates requests for DeleteTodoComment
+func NewDeleteTodoCommentRequest(server string, todoId openapi_types.UUID, commentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "comment_id", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoCommentRequest calls the generic UpdateTodoComment builder with application/json body
+func NewUpdateTodoCommentRequest(server string, todoId openapi_types.UUID, commentId openapi_types.UUID, body UpdateTodoCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoCommentRequestWithBody(server, todoId, commentId, "application/json", bodyReader)
+}
+
+// NewUpdateTodoCommentRequestWithBody generates requests for UpdateTodoComment with any type of body
+func NewUpdateTodoCommentRequestWithBody(server string, todoId openapi_types.UUID, commentId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "comment_id", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRestoreTodoRequest generates requests for RestoreTodo
+func NewRestoreTodoRequest(server string, todoId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartTodoTimerRequest generates requests for StartTodoTimer
+func NewStartTodoTimerRequest(server string, todoId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/timer/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStopTodoTimerRequest generates requests for StopTodoTimer
+func NewStopTodoTimerRequest(server string, todoId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/todos/%s/timer/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTokensRequest generates requests for ListTokens
+func NewListTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTokenRequest calls the generic CreateToken builder with application/json body
+func NewCreateTokenRequest(server string, body CreateTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTokenRequestWithBody generates requests for CreateToken with any type of body
+func NewCreateTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeTokenRequest generates requests for RevokeToken
+func NewRevokeTokenRequest(server string, tokenId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token_id", runtime.ParamLocationPath, tokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListViewsRequest generates requests for ListViews
+func NewListViewsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSaveViewRequest calls the generic SaveView builder with application/json body
+func NewSaveViewRequest(server string, body SaveViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSaveViewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSaveViewRequestWithBody generates requests for SaveView with any type of body
+func NewSaveViewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteViewRequest generates requests for DeleteView
+func NewDeleteViewRequest(server string, viewId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "view_id", runtime.ParamLocationPath, viewId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateViewRequest calls the generic UpdateView builder with application/json body
+func NewUpdateViewRequest(server string, viewId openapi_types.UUID, body UpdateViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateViewRequestWithBody(server, viewId, "application/json", bodyReader)
+}
+
+// NewUpdateViewRequestWithBody generates requests for UpdateView with any type of body
+func NewUpdateViewRequestWithBody(server string, viewId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "view_id", runtime.ParamLocationPath, viewId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CompleteOIDCLoginWithResponse request
+	CompleteOIDCLoginWithResponse(ctx context.Context, params *CompleteOIDCLoginParams, reqEditors ...RequestEditorFn) (*CompleteOIDCLoginResponse, error)
+
+	// BeginOIDCLoginWithResponse request
+	BeginOIDCLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BeginOIDCLoginResponse, error)
+
+	// ListWeeklyReviewsWithResponse request
+	ListWeeklyReviewsWithResponse(ctx context.Context, params *ListWeeklyReviewsParams, reqEditors ...RequestEditorFn) (*ListWeeklyReviewsResponse, error)
+
+	// CreateBoardSnapshotWithBodyWithResponse request with any body
+	CreateBoardSnapshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBoardSnapshotResponse, error)
+
+	CreateBoardSnapshotWithResponse(ctx context.Context, body CreateBoardSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBoardSnapshotResponse, error)
+
+	// GetBoardSnapshotWithResponse request
+	GetBoardSnapshotWithResponse(ctx context.Context, token string, params *GetBoardSnapshotParams, reqEditors ...RequestEditorFn) (*GetBoardSnapshotResponse, error)
+
+	// GetBoardSummaryWithResponse request
+	GetBoardSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBoardSummaryResponse, error)
+
+	// StreamChatWithBodyWithResponse request with any body
+	StreamChatWithBodyWithResponse(ctx context.Context, params *StreamChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamChatResponse, error)
+
+	StreamChatWithResponse(ctx context.Context, params *StreamChatParams, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamChatResponse, error)
+
+	// SubmitActionApprovalWithBodyWithResponse request with any body
+	SubmitActionApprovalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error)
+
+	SubmitActionApprovalWithResponse(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error)
+
+	// GetContentBlobWithResponse request
+	GetContentBlobWithResponse(ctx context.Context, hash string, reqEditors ...RequestEditorFn) (*GetContentBlobResponse, error)
+
+	// ListInstructionsWithResponse request
+	ListInstructionsWithResponse(ctx context.Context, params *ListInstructionsParams, reqEditors ...RequestEditorFn) (*ListInstructionsResponse, error)
+
+	// RememberInstructionWithBodyWithResponse request with any body
+	RememberInstructionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RememberInstructionResponse, error)
+
+	RememberInstructionWithResponse(ctx context.Context, body RememberInstructionJSONRequestBody, reqEditors ...RequestEditorFn) (*RememberInstructionResponse, error)
+
+	// DeleteInstructionWithResponse request
+	DeleteInstructionWithResponse(ctx context.Context, instructionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInstructionResponse, error)
+
+	// ListMemoriesWithResponse request
+	ListMemoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMemoriesResponse, error)
+
+	// DeleteMemoryWithResponse request
+	DeleteMemoryWithResponse(ctx context.Context, memoryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMemoryResponse, error)
+
+	// ListChatMessagesWithResponse request
+	ListChatMessagesWithResponse(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*ListChatMessagesResponse, error)
+
+	// SubmitMessageFeedbackWithBodyWithResponse request with any body
+	SubmitMessageFeedbackWithBodyWithResponse(ctx context.Context, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitMessageFeedbackResponse, error)
+
+	SubmitMessageFeedbackWithResponse(ctx context.Context, messageId openapi_types.UUID, body SubmitMessageFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitMessageFeedbackResponse, error)
+
+	// PreviewChatWithBodyWithResponse request with any body
+	PreviewChatWithBodyWithResponse(ctx context.Context, params *PreviewChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewChatResponse, error)
+
+	PreviewChatWithResponse(ctx context.Context, params *PreviewChatParams, body PreviewChatJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewChatResponse, error)
+
+	// ListAvailableSkillsWithResponse request
+	ListAvailableSkillsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableSkillsResponse, error)
+
+	// ListConversationsWithResponse request
+	ListConversationsWithResponse(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*ListConversationsResponse, error)
+
+	// StreamConversationEventsWithResponse request
+	StreamConversationEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamConversationEventsResponse, error)
+
+	// ListRelatedConversationsWithResponse request
+	ListRelatedConversationsWithResponse(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListRelatedConversationsResponse, error)
+
+	// DeleteConversationWithResponse request
+	DeleteConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteConversationResponse, error)
+
+	// UpdateConversationWithBodyWithResponse request with any body
+	UpdateConversationWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error)
+
+	UpdateConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error)
+
+	// EditMessageWithBodyWithResponse request with any body
+	EditMessageWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditMessageResponse, error)
+
+	EditMessageWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, body EditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*EditMessageResponse, error)
+
+	// RegenerateMessageWithBodyWithResponse request with any body
+	RegenerateMessageWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateMessageResponse, error)
+
+	RegenerateMessageWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, body RegenerateMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateMessageResponse, error)
+
+	// MarkConversationReadWithBodyWithResponse request with any body
+	MarkConversationReadWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MarkConversationReadResponse, error)
+
+	MarkConversationReadWithResponse(ctx context.Context, conversationId openapi_types.UUID, body MarkConversationReadJSONRequestBody, reqEditors ...RequestEditorFn) (*MarkConversationReadResponse, error)
+
+	// PostSystemMessageWithBodyWithResponse request with any body
+	PostSystemMessageWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSystemMessageResponse, error)
+
+	PostSystemMessageWithResponse(ctx context.Context, conversationId openapi_types.UUID, body PostSystemMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSystemMessageResponse, error)
+
+	// GetConversationTurnWithResponse request
+	GetConversationTurnWithResponse(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetConversationTurnResponse, error)
+
+	// ReplayConversationTurnWithResponse request
+	ReplayConversationTurnWithResponse(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReplayConversationTurnResponse, error)
+
+	// ListCustomFieldsWithResponse request
+	ListCustomFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCustomFieldsResponse, error)
+
+	// DeleteCustomFieldWithResponse request
+	DeleteCustomFieldWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteCustomFieldResponse, error)
+
+	// DefineCustomFieldWithBodyWithResponse request with any body
+	DefineCustomFieldWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DefineCustomFieldResponse, error)
+
+	DefineCustomFieldWithResponse(ctx context.Context, name string, body DefineCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*DefineCustomFieldResponse, error)
+
+	// GetGraphQLSchemaWithResponse request
+	GetGraphQLSchemaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGraphQLSchemaResponse, error)
+
+	// ReceiveInboundWebhookWithBodyWithResponse request with any body
+	ReceiveInboundWebhookWithBodyWithResponse(ctx context.Context, source string, params *ReceiveInboundWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiveInboundWebhookResponse, error)
+
+	ReceiveInboundWebhookWithResponse(ctx context.Context, source string, params *ReceiveInboundWebhookParams, body ReceiveInboundWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*ReceiveInboundWebhookResponse, error)
+
+	// ListAvailableModelsWithResponse request
+	ListAvailableModelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableModelsResponse, error)
+
+	// GetOpenAPISpecWithResponse request
+	GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error)
+
+	// ListProjectsWithResponse request
+	ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error)
+
+	// ListProjectSuggestionsWithResponse request
+	ListProjectSuggestionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectSuggestionsResponse, error)
+
+	// AcceptProjectSuggestionWithBodyWithResponse request with any body
+	AcceptProjectSuggestionWithBodyWithResponse(ctx context.Context, suggestionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptProjectSuggestionResponse, error)
+
+	AcceptProjectSuggestionWithResponse(ctx context.Context, suggestionId openapi_types.UUID, body AcceptProjectSuggestionJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptProjectSuggestionResponse, error)
+
+	// ListSessionsWithResponse request
+	ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResponse, error)
+
+	// StartSessionWithBodyWithResponse request with any body
+	StartSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartSessionResponse, error)
+
+	StartSessionWithResponse(ctx context.Context, body StartSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*StartSessionResponse, error)
+
+	// RefreshSessionWithBodyWithResponse request with any body
+	RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	// RevokeSessionWithResponse request
+	RevokeSessionWithResponse(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeSessionResponse, error)
+
+	// GetTimeReportWithResponse request
+	GetTimeReportWithResponse(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*GetTimeReportResponse, error)
+
+	// PullSyncWithResponse request
+	PullSyncWithResponse(ctx context.Context, params *PullSyncParams, reqEditors ...RequestEditorFn) (*PullSyncResponse, error)
+
+	// PushSyncWithBodyWithResponse request with any body
+	PushSyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PushSyncResponse, error)
+
+	PushSyncWithResponse(ctx context.Context, body PushSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PushSyncResponse, error)
+
+	// ListTemplatesWithResponse request
+	ListTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error)
+
+	// SaveTemplateWithBodyWithResponse request with any body
+	SaveTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveTemplateResponse, error)
+
+	SaveTemplateWithResponse(ctx context.Context, body SaveTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveTemplateResponse, error)
+
+	// DeleteTemplateWithResponse request
+	DeleteTemplateWithResponse(ctx context.Context, templateId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTemplateResponse, error)
+
+	// UpdateTemplateWithBodyWithResponse request with any body
+	UpdateTemplateWithBodyWithResponse(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error)
+
+	UpdateTemplateWithResponse(ctx context.Context, templateId openapi_types.UUID, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error)
+
+	// ApplyTemplateWithBodyWithResponse request with any body
+	ApplyTemplateWithBodyWithResponse(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyTemplateResponse, error)
+
+	ApplyTemplateWithResponse(ctx context.Context, templateId openapi_types.UUID, body ApplyTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyTemplateResponse, error)
+
+	// ListTodosWithResponse request
+	ListTodosWithResponse(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+
+	// CreateTodoWithBodyWithResponse request with any body
+	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	// ListTodoChangesWithResponse request
+	ListTodoChangesWithResponse(ctx context.Context, params *ListTodoChangesParams, reqEditors ...RequestEditorFn) (*ListTodoChangesResponse, error)
+
+	// StreamTodoEventsWithResponse request
+	StreamTodoEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamTodoEventsResponse, error)
+
+	// QuickAddTodoWithBodyWithResponse request with any body
+	QuickAddTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuickAddTodoResponse, error)
+
+	QuickAddTodoWithResponse(ctx context.Context, body QuickAddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*QuickAddTodoResponse, error)
+
+	// DeleteTodoWithResponse request
+	DeleteTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+
+	// UpdateTodoWithBodyWithResponse request with any body
+	UpdateTodoWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	UpdateTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	// ListTodoCommentsWithResponse request
+	ListTodoCommentsWithResponse(ctx context.Context, todoId openapi_types.UUID, params *ListTodoCommentsParams, reqEditors ...RequestEditorFn) (*ListTodoCommentsResponse, error)
+
+	// CreateTodoCommentWithBodyWithResponse request with any body
+	CreateTodoCommentWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error)
+
+	CreateTodoCommentWithResponse(ctx context.Context, todoId openapi_types.UUID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error)
+
+	// DeleteTodoCommentWithResponse request
+	DeleteTodoCommentWithResponse(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTodoCommentResponse, error)
+
+	// UpdateTodoCommentWithBodyWithResponse request with any body
+	UpdateTodoCommentWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error)
+
+	UpdateTodoCommentWithResponse(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error)
+
+	// RestoreTodoWithResponse request
+	RestoreTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreTodoResponse, error)
+
+	// StartTodoTimerWithResponse request
+	StartTodoTimerWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*StartTodoTimerResponse, error)
+
+	// StopTodoTimerWithResponse request
+	StopTodoTimerWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*StopTodoTimerResponse, error)
+
+	// ListTokensWithResponse request
+	ListTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTokensResponse, error)
+
+	// CreateTokenWithBodyWithResponse request with any body
+	CreateTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+
+	CreateTokenWithResponse(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+
+	// RevokeTokenWithResponse request
+	RevokeTokenWithResponse(ctx context.Context, tokenId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeTokenResponse, error)
+
+	// ListViewsWithResponse request
+	ListViewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListViewsResponse, error)
+
+	// SaveViewWithBodyWithResponse request with any body
+	SaveViewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveViewResponse, error)
+
+	SaveViewWithResponse(ctx context.Context, body SaveViewJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveViewResponse, error)
+
+	// DeleteViewWithResponse request
+	DeleteViewWithResponse(ctx context.Context, viewId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteViewResponse, error)
+
+	// UpdateViewWithBodyWithResponse request with any body
+	UpdateViewWithBodyWithResponse(ctx context.Context, viewId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateViewResponse, error)
+
+	UpdateViewWithResponse(ctx context.Context, viewId openapi_types.UUID, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateViewResponse, error)
+}
+
+type CompleteOIDCLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionTokens
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteOIDCLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteOIDCLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BeginOIDCLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r BeginOIDCLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BeginOIDCLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWeeklyReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WeeklyReviewListResp
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWeeklyReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWeeklyReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBoardSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BoardSnapshot
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBoardSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBoardSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BoardSnapshot
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBoardSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BoardSummary
+	JSON404      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBoardSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBoardSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamChatResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResp
+	JSON500      *ErrorResp
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamChatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamChatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitActionApprovalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON500      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitActionApprovalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitActionApprovalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetContentBlobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ContentBlob
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContentBlobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContentBlobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInstructionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListInstructionsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInstructionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInstructionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RememberInstructionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Instruction
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RememberInstructionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RememberInstructionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInstructionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInstructionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInstructionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMemoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListMemoriesResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMemoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMemoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMemoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMemoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMemoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListChatMessagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChatHistoryResp
+	JSON500      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListChatMessagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListChatMessagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitMessageFeedbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitMessageFeedbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitMessageFeedbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewChatResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResp
+	JSON500      *ErrorResp
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewChatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewChatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAvailableSkillsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SkillListResp
+	JSON500      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAvailableSkillsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAvailableSkillsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListConversationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConversationListResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListConversationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListConversationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamConversationEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamConversationEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamConversationEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRelatedConversationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RelatedConversationListResp
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRelatedConversationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRelatedConversationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteConversationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteConversationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteConversationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateConversationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Conversation
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateConversationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateConversationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r EditMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegenerateMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON503      *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r RegenerateMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegenerateMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MarkConversationReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r MarkConversationReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MarkConversationReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSystemMessageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ChatMessage
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSystemMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSystemMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConversationTurnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TurnTranscript
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConversationTurnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConversationTurnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplayConversationTurnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TurnReplay
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplayConversationTurnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplayConversationTurnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCustomFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListCustomFieldsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCustomFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCustomFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DefineCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomField
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r DefineCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DefineCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGraphQLSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GraphQLSchemaResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGraphQLSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGraphQLSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReceiveInboundWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboundWebhookResp
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ReceiveInboundWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReceiveInboundWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAvailableModelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ModelListResp
+	JSON500      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAvailableModelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAvailableModelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPISpecResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON500      *ErrorResp
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPISpecResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPISpecResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListProjectsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectSuggestionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListProjectSuggestionsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectSuggestionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectSuggestionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcceptProjectSuggestionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r AcceptProjectSuggestionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptProjectSuggestionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSessionsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SessionTokens
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r StartSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionTokens
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimeReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeReport
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimeReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimeReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PullSyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncResp
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PullSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PullSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PushSyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncPushResp
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r PushSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PushSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListTemplatesResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Template
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Template
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ApplyTemplateResp
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListTodosResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Todo
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTodoChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoChangesResp
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTodoChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTodoChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamTodoEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamTodoEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamTodoEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QuickAddTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuickAddResp
+	JSON201      *QuickAddResp
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r QuickAddTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QuickAddTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Todo
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTodoCommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListCommentsResp
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTodoCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTodoCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Comment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Comment
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Todo
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartTodoTimerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntry
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r StartTodoTimerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartTodoTimerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopTodoTimerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r StopTodoTimerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopTodoTimerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListTokensResp
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreatedToken
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListViewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListViewsResp
+}
+
+// Status returns HTTPResponse.Status
+func (r ListViewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListViewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *View
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateViewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *View
+	JSON400      *BadRequest
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateViewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateViewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CompleteOIDCLoginWithResponse request returning *CompleteOIDCLoginResponse
+func (c *ClientWithResponses) CompleteOIDCLoginWithResponse(ctx context.Context, params *CompleteOIDCLoginParams, reqEditors ...RequestEditorFn) (*CompleteOIDCLoginResponse, error) {
+	rsp, err := c.CompleteOIDCLogin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteOIDCLoginResponse(rsp)
+}
+
+// BeginOIDCLoginWithResponse request returning *BeginOIDCLoginResponse
+func (c *ClientWithResponses) BeginOIDCLoginWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BeginOIDCLoginResponse, error) {
+	rsp, err := c.BeginOIDCLogin(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBeginOIDCLoginResponse(rsp)
+}
+
+// ListWeeklyReviewsWithResponse request returning *ListWeeklyReviewsResponse
+func (c *ClientWithResponses) ListWeeklyReviewsWithResponse(ctx context.Context, params *ListWeeklyReviewsParams, reqEditors ...RequestEditorFn) (*ListWeeklyReviewsResponse, error) {
+	rsp, err := c.ListWeeklyReviews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWeeklyReviewsResponse(rsp)
+}
+
+// CreateBoardSnapshotWithBodyWithResponse request with arbitrary body returning *CreateBoardSnapshotResponse
+func (c *ClientWithResponses) CreateBoardSnapshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBoardSnapshotResponse, error) {
+	rsp, err := c.CreateBoardSnapshotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBoardSnapshotResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBoardSnapshotWithResponse(ctx context.Context, body CreateBoardSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBoardSnapshotResponse, error) {
+	rsp, err := c.CreateBoardSnapshot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBoardSnapshotResponse(rsp)
+}
+
+// GetBoardSnapshotWithResponse request returning *GetBoardSnapshotResponse
+func (c *ClientWithResponses) GetBoardSnapshotWithResponse(ctx context.Context, token string, params *GetBoardSnapshotParams, reqEditors ...RequestEditorFn) (*GetBoardSnapshotResponse, error) {
+	rsp, err := c.GetBoardSnapshot(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardSnapshotResponse(rsp)
+}
+
+// GetBoardSummaryWithResponse request returning *GetBoardSummaryResponse
+func (c *ClientWithResponses) GetBoardSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBoardSummaryResponse, error) {
+	rsp, err := c.GetBoardSummary(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBoardSummaryResponse(rsp)
+}
+
+// StreamChatWithBodyWithResponse request with arbitrary body returning *StreamChatResponse
+func (c *ClientWithResponses) StreamChatWithBodyWithResponse(ctx context.Context, params *StreamChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamChatResponse, error) {
+	rsp, err := c.StreamChatWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamChatResponse(rsp)
+}
+
+func (c *ClientWithResponses) StreamChatWithResponse(ctx context.Context, params *StreamChatParams, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamChatResponse, error) {
+	rsp, err := c.StreamChat(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamChatResponse(rsp)
+}
+
+// SubmitActionApprovalWithBodyWithResponse request with arbitrary body returning *SubmitActionApprovalResponse
+func (c *ClientWithResponses) SubmitActionApprovalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error) {
+	rsp, err := c.SubmitActionApprovalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitActionApprovalResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitActionApprovalWithResponse(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error) {
+	rsp, err := c.SubmitActionApproval(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitActionApprovalResponse(rsp)
+}
+
+// GetContentBlobWithResponse request returning *GetContentBlobResponse
+func (c *ClientWithResponses) GetContentBlobWithResponse(ctx context.Context, hash string, reqEditors ...RequestEditorFn) (*GetContentBlobResponse, error) {
+	rsp, err := c.GetContentBlob(ctx, hash, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContentBlobResponse(rsp)
+}
+
+// ListInstructionsWithResponse request returning *ListInstructionsResponse
+func (c *ClientWithResponses) ListInstructionsWithResponse(ctx context.Context, params *ListInstructionsParams, reqEditors ...RequestEditorFn) (*ListInstructionsResponse, error) {
+	rsp, err := c.ListInstructions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInstructionsResponse(rsp)
+}
+
+// RememberInstructionWithBodyWithResponse request with arbitrary body returning *RememberInstructionResponse
+func (c *ClientWithResponses) RememberInstructionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RememberInstructionResponse, error) {
+	rsp, err := c.RememberInstructionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRememberInstructionResponse(rsp)
+}
+
+func (c *ClientWithResponses) RememberInstructionWithResponse(ctx context.Context, body RememberInstructionJSONRequestBody, reqEditors ...RequestEditorFn) (*RememberInstructionResponse, error) {
+	rsp, err := c.RememberInstruction(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRememberInstructionResponse(rsp)
+}
+
+// DeleteInstructionWithResponse request returning *DeleteInstructionResponse
+func (c *ClientWithResponses) DeleteInstructionWithResponse(ctx context.Context, instructionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInstructionResponse, error) {
+	rsp, err := c.DeleteInstruction(ctx, instructionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInstructionResponse(rsp)
+}
+
+// ListMemoriesWithResponse request returning *ListMemoriesResponse
+func (c *ClientWithResponses) ListMemoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMemoriesResponse, error) {
+	rsp, err := c.ListMemories(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMemoriesResponse(rsp)
+}
+
+// DeleteMemoryWithResponse request returning *DeleteMemoryResponse
+func (c *ClientWithResponses) DeleteMemoryWithResponse(ctx context.Context, memoryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMemoryResponse, error) {
+	rsp, err := c.DeleteMemory(ctx, memoryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMemoryResponse(rsp)
+}
+
+// ListChatMessagesWithResponse request returning *ListChatMessagesResponse
+func (c *ClientWithResponses) ListChatMessagesWithResponse(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*ListChatMessagesResponse, error) {
+	rsp, err := c.ListChatMessages(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListChatMessagesResponse(rsp)
+}
+
+// SubmitMessageFeedbackWithBodyWithResponse request with arbitrary body returning *SubmitMessageFeedbackResponse
+func (c *ClientWithResponses) SubmitMessageFeedbackWithBodyWithResponse(ctx context.Context, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitMessageFeedbackResponse, error) {
+	rsp, err := c.SubmitMessageFeedbackWithBody(ctx, messageId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitMessageFeedbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitMessageFeedbackWithResponse(ctx context.Context, messageId openapi_types.UUID, body SubmitMessageFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitMessageFeedbackResponse, error) {
+	rsp, err := c.SubmitMessageFeedback(ctx, messageId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitMessageFeedbackResponse(rsp)
+}
+
+// PreviewChatWithBodyWithResponse request with arbitrary body returning *PreviewChatResponse
+func (c *ClientWithResponses) PreviewChatWithBodyWithResponse(ctx context.Context, params *PreviewChatParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewChatResponse, error) {
+	rsp, err := c.PreviewChatWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewChatResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreviewChatWithResponse(ctx context.Context, params *PreviewChatParams, body PreviewChatJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewChatResponse, error) {
+	rsp, err := c.PreviewChat(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewChatResponse(rsp)
+}
+
+// ListAvailableSkillsWithResponse request returning *ListAvailableSkillsResponse
+func (c *ClientWithResponses) ListAvailableSkillsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableSkillsResponse, error) {
+	rsp, err := c.ListAvailableSkills(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAvailableSkillsResponse(rsp)
+}
+
+// ListConversationsWithResponse request returning *ListConversationsResponse
+func (c *ClientWithResponses) ListConversationsWithResponse(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*ListConversationsResponse, error) {
+	rsp, err := c.ListConversations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListConversationsResponse(rsp)
+}
+
+// StreamConversationEventsWithResponse request returning *StreamConversationEventsResponse
+func (c *ClientWithResponses) StreamConversationEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamConversationEventsResponse, error) {
+	rsp, err := c.StreamConversationEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamConversationEventsResponse(rsp)
+}
+
+// ListRelatedConversationsWithResponse request returning *ListRelatedConversationsResponse
+func (c *ClientWithResponses) ListRelatedConversationsWithResponse(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListRelatedConversationsResponse, error) {
+	rsp, err := c.ListRelatedConversations(ctx, conversationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRelatedConversationsResponse(rsp)
+}
+
+// DeleteConversationWithResponse request returning *DeleteConversationResponse
+func (c *ClientWithResponses) DeleteConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteConversationResponse, error) {
+	rsp, err := c.DeleteConversation(ctx, conversationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteConversationResponse(rsp)
+}
+
+// UpdateConversationWithBodyWithResponse request with arbitrary body returning *UpdateConversationResponse
+func (c *ClientWithResponses) UpdateConversationWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error) {
+	rsp, err := c.UpdateConversationWithBody(ctx, conversationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateConversationResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error) {
+	rsp, err := c.UpdateConversation(ctx, conversationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateConversationResponse(rsp)
+}
+
+// EditMessageWithBodyWithResponse request with arbitrary body returning *EditMessageResponse
+func (c *ClientWithResponses) EditMessageWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditMessageResponse, error) {
+	rsp, err := c.EditMessageWithBody(ctx, conversationId, messageId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditMessageWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *EditMessageParams, body EditMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*EditMessageResponse, error) {
+	rsp, err := c.EditMessage(ctx, conversationId, messageId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditMessageResponse(rsp)
+}
+
+// RegenerateMessageWithBodyWithResponse request with arbitrary body returning *RegenerateMessageResponse
+func (c *ClientWithResponses) RegenerateMessageWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegenerateMessageResponse, error) {
+	rsp, err := c.RegenerateMessageWithBody(ctx, conversationId, messageId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegenerateMessageWithResponse(ctx context.Context, conversationId openapi_types.UUID, messageId openapi_types.UUID, params *RegenerateMessageParams, body RegenerateMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*RegenerateMessageResponse, error) {
+	rsp, err := c.RegenerateMessage(ctx, conversationId, messageId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegenerateMessageResponse(rsp)
+}
+
+// MarkConversationReadWithBodyWithResponse request with arbitrary body returning *MarkConversationReadResponse
+func (c *ClientWithResponses) MarkConversationReadWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MarkConversationReadResponse, error) {
+	rsp, err := c.MarkConversationReadWithBody(ctx, conversationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkConversationReadResponse(rsp)
+}
+
+func (c *ClientWithResponses) MarkConversationReadWithResponse(ctx context.Context, conversationId openapi_types.UUID, body MarkConversationReadJSONRequestBody, reqEditors ...RequestEditorFn) (*MarkConversationReadResponse, error) {
+	rsp, err := c.MarkConversationRead(ctx, conversationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkConversationReadResponse(rsp)
+}
+
+// PostSystemMessageWithBodyWithResponse request with arbitrary body returning *PostSystemMessageResponse
+func (c *ClientWithResponses) PostSystemMessageWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSystemMessageResponse, error) {
+	rsp, err := c.PostSystemMessageWithBody(ctx, conversationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSystemMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSystemMessageWithResponse(ctx context.Context, conversationId openapi_types.UUID, body PostSystemMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSystemMessageResponse, error) {
+	rsp, err := c.PostSystemMessage(ctx, conversationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSystemMessageResponse(rsp)
+}
+
+// GetConversationTurnWithResponse request returning *GetConversationTurnResponse
+func (c *ClientWithResponses) GetConversationTurnWithResponse(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetConversationTurnResponse, error) {
+	rsp, err := c.GetConversationTurn(ctx, conversationId, turnId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConversationTurnResponse(rsp)
+}
+
+// ReplayConversationTurnWithResponse request returning *ReplayConversationTurnResponse
+func (c *ClientWithResponses) ReplayConversationTurnWithResponse(ctx context.Context, conversationId openapi_types.UUID, turnId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReplayConversationTurnResponse, error) {
+	rsp, err := c.ReplayConversationTurn(ctx, conversationId, turnId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplayConversationTurnResponse(rsp)
+}
+
+// ListCustomFieldsWithResponse request returning *ListCustomFieldsResponse
+func (c *ClientWithResponses) ListCustomFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCustomFieldsResponse, error) {
+	rsp, err := c.ListCustomFields(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCustomFieldsResponse(rsp)
+}
+
+// DeleteCustomFieldWithResponse request returning *DeleteCustomFieldResponse
+func (c *ClientWithResponses) DeleteCustomFieldWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteCustomFieldResponse, error) {
+	rsp, err := c.DeleteCustomField(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCustomFieldResponse(rsp)
+}
+
+// DefineCustomFieldWithBodyWithResponse request with arbitrary body returning *DefineCustomFieldResponse
+func (c *ClientWithResponses) DefineCustomFieldWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DefineCustomFieldResponse, error) {
+	rsp, err := c.DefineCustomFieldWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDefineCustomFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) DefineCustomFieldWithResponse(ctx context.Context, name string, body DefineCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*DefineCustomFieldResponse, error) {
+	rsp, err := c.DefineCustomField(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDefineCustomFieldResponse(rsp)
+}
+
+// GetGraphQLSchemaWithResponse request returning *GetGraphQLSchemaResponse
+func (c *ClientWithResponses) GetGraphQLSchemaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGraphQLSchemaResponse, error) {
+	rsp, err := c.GetGraphQLSchema(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGraphQLSchemaResponse(rsp)
+}
+
+// ReceiveInboundWebhookWithBodyWithResponse request with arbitrary body returning *ReceiveInboundWebhookResponse
+func (c *ClientWithResponses) ReceiveInboundWebhookWithBodyWithResponse(ctx context.Context, source string, params *ReceiveInboundWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiveInboundWebhookResponse, error) {
+	rsp, err := c.ReceiveInboundWebhookWithBody(ctx, source, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReceiveInboundWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReceiveInboundWebhookWithResponse(ctx context.Context, source string, params *ReceiveInboundWebhookParams, body ReceiveInboundWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*ReceiveInboundWebhookResponse, error) {
+	rsp, err := c.ReceiveInboundWebhook(ctx, source, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReceiveInboundWebhookResponse(rsp)
+}
+
+// ListAvailableModelsWithResponse request returning *ListAvailableModelsResponse
+func (c *ClientWithResponses) ListAvailableModelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableModelsResponse, error) {
+	rsp, err := c.ListAvailableModels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAvailableModelsResponse(rsp)
+}
+
+// GetOpenAPISpecWithResponse request returning *GetOpenAPISpecResponse
+func (c *ClientWithResponses) GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error) {
+	rsp, err := c.GetOpenAPISpec(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPISpecResponse(rsp)
+}
+
+// ListProjectsWithResponse request returning *ListProjectsResponse
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	rsp, err := c.ListProjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResponse(rsp)
+}
+
+// ListProjectSuggestionsWithResponse request returning *ListProjectSuggestionsResponse
+func (c *ClientWithResponses) ListProjectSuggestionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProjectSuggestionsResponse, error) {
+	rsp, err := c.ListProjectSuggestions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectSuggestionsResponse(rsp)
+}
+
+// AcceptProjectSuggestionWithBodyWithResponse request with arbitrary body returning *AcceptProjectSuggestionResponse
+func (c *ClientWithResponses) AcceptProjectSuggestionWithBodyWithResponse(ctx context.Context, suggestionId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcceptProjectSuggestionResponse, error) {
+	rsp, err := c.AcceptProjectSuggestionWithBody(ctx, suggestionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptProjectSuggestionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AcceptProjectSuggestionWithResponse(ctx context.Context, suggestionId openapi_types.UUID, body AcceptProjectSuggestionJSONRequestBody, reqEditors ...RequestEditorFn) (*AcceptProjectSuggestionResponse, error) {
+	rsp, err := c.AcceptProjectSuggestion(ctx, suggestionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptProjectSuggestionResponse(rsp)
+}
+
+// ListSessionsWithResponse request returning *ListSessionsResponse
+func (c *ClientWithResponses) ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResponse, error) {
+	rsp, err := c.ListSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSessionsResponse(rsp)
+}
+
+// StartSessionWithBodyWithResponse request with arbitrary body returning *StartSessionResponse
+func (c *ClientWithResponses) StartSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartSessionResponse, error) {
+	rsp, err := c.StartSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartSessionWithResponse(ctx context.Context, body StartSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*StartSessionResponse, error) {
+	rsp, err := c.StartSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartSessionResponse(rsp)
+}
+
+// RefreshSessionWithBodyWithResponse request with arbitrary body returning *RefreshSessionResponse
+func (c *ClientWithResponses) RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+// RevokeSessionWithResponse request returning *RevokeSessionResponse
+func (c *ClientWithResponses) RevokeSessionWithResponse(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeSessionResponse, error) {
+	rsp, err := c.RevokeSession(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeSessionResponse(rsp)
+}
+
+// GetTimeReportWithResponse request returning *GetTimeReportResponse
+func (c *ClientWithResponses) GetTimeReportWithResponse(ctx context.Context, params *GetTimeReportParams, reqEditors ...RequestEditorFn) (*GetTimeReportResponse, error) {
+	rsp, err := c.GetTimeReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimeReportResponse(rsp)
+}
+
+// PullSyncWithResponse request returning *PullSyncResponse
+func (c *ClientWithResponses) PullSyncWithResponse(ctx context.Context, params *PullSyncParams, reqEditors ...RequestEditorFn) (*PullSyncResponse, error) {
+	rsp, err := c.PullSync(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePullSyncResponse(rsp)
+}
+
+// PushSyncWithBodyWithResponse request with arbitrary body returning *PushSyncResponse
+func (c *ClientWithResponses) PushSyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PushSyncResponse, error) {
+	rsp, err := c.PushSyncWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePushSyncResponse(rsp)
+}
+
+func (c *ClientWithResponses) PushSyncWithResponse(ctx context.Context, body PushSyncJSONRequestBody, reqEditors ...RequestEditorFn) (*PushSyncResponse, error) {
+	rsp, err := c.PushSync(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePushSyncResponse(rsp)
+}
+
+// ListTemplatesWithResponse request returning *ListTemplatesResponse
+func (c *ClientWithResponses) ListTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTemplatesResponse, error) {
+	rsp, err := c.ListTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTemplatesResponse(rsp)
+}
+
+// SaveTemplateWithBodyWithResponse request with arbitrary body returning *SaveTemplateResponse
+func (c *ClientWithResponses) SaveTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveTemplateResponse, error) {
+	rsp, err := c.SaveTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveTemplateWithResponse(ctx context.Context, body SaveTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveTemplateResponse, error) {
+	rsp, err := c.SaveTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveTemplateResponse(rsp)
+}
+
+// DeleteTemplateWithResponse request returning *DeleteTemplateResponse
+func (c *ClientWithResponses) DeleteTemplateWithResponse(ctx context.Context, templateId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTemplateResponse, error) {
+	rsp, err := c.DeleteTemplate(ctx, templateId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTemplateResponse(rsp)
+}
+
+// UpdateTemplateWithBodyWithResponse request with arbitrary body returning *UpdateTemplateResponse
+func (c *ClientWithResponses) UpdateTemplateWithBodyWithResponse(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error) {
+	rsp, err := c.UpdateTemplateWithBody(ctx, templateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTemplateWithResponse(ctx context.Context, templateId openapi_types.UUID, body UpdateTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTemplateResponse, error) {
+	rsp, err := c.UpdateTemplate(ctx, templateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTemplateResponse(rsp)
+}
+
+// ApplyTemplateWithBodyWithResponse request with arbitrary body returning *ApplyTemplateResponse
+func (c *ClientWithResponses) ApplyTemplateWithBodyWithResponse(ctx context.Context, templateId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyTemplateResponse, error) {
+	rsp, err := c.ApplyTemplateWithBody(ctx, templateId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApplyTemplateWithResponse(ctx context.Context, templateId openapi_types.UUID, body ApplyTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyTemplateResponse, error) {
+	rsp, err := c.ApplyTemplate(ctx, templateId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyTemplateResponse(rsp)
+}
+
+// ListTodosWithResponse request returning *ListTodosResponse
+func (c *ClientWithResponses) ListTodosWithResponse(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
+	rsp, err := c.ListTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodosResponse(rsp)
+}
+
+// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
+func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+// ListTodoChangesWithResponse request returning *ListTodoChangesResponse
+func (c *ClientWithResponses) ListTodoChangesWithResponse(ctx context.Context, params *ListTodoChangesParams, reqEditors ...RequestEditorFn) (*ListTodoChangesResponse, error) {
+	rsp, err := c.ListTodoChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodoChangesResponse(rsp)
+}
+
+// StreamTodoEventsWithResponse request returning *StreamTodoEventsResponse
+func (c *ClientWithResponses) StreamTodoEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamTodoEventsResponse, error) {
+	rsp, err := c.StreamTodoEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamTodoEventsResponse(rsp)
+}
+
+// QuickAddTodoWithBodyWithResponse request with arbitrary body returning *QuickAddTodoResponse
+func (c *ClientWithResponses) QuickAddTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QuickAddTodoResponse, error) {
+	rsp, err := c.QuickAddTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuickAddTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) QuickAddTodoWithResponse(ctx context.Context, body QuickAddTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*QuickAddTodoResponse, error) {
+	rsp, err := c.QuickAddTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuickAddTodoResponse(rsp)
+}
+
+// DeleteTodoWithResponse request returning *DeleteTodoResponse
+func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
+	rsp, err := c.DeleteTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoResponse(rsp)
+}
+
+// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
+func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodo(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+// ListTodoCommentsWithResponse request returning *ListTodoCommentsResponse
+func (c *ClientWithResponses) ListTodoCommentsWithResponse(ctx context.Context, todoId openapi_types.UUID, params *ListTodoCommentsParams, reqEditors ...RequestEditorFn) (*ListTodoCommentsResponse, error) {
+	rsp, err := c.ListTodoComments(ctx, todoId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodoCommentsResponse(rsp)
+}
+
+// CreateTodoCommentWithBodyWithResponse request with arbitrary body returning *CreateTodoCommentResponse
+func (c *ClientWithResponses) CreateTodoCommentWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error) {
+	rsp, err := c.CreateTodoCommentWithBody(ctx, todoId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoCommentWithResponse(ctx context.Context, todoId openapi_types.UUID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error) {
+	rsp, err := c.CreateTodoComment(ctx, todoId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoCommentResponse(rsp)
+}
+
+// DeleteTodoCommentWithResponse request returning *DeleteTodoCommentResponse
+func (c *ClientWithResponses) DeleteTodoCommentWithResponse(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTodoCommentResponse, error) {
+	rsp, err := c.DeleteTodoComment(ctx, todoId, commentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoCommentResponse(rsp)
+}
+
+// UpdateTodoCommentWithBodyWithResponse request with arbitrary body returning *UpdateTodoCommentResponse
+func (c *ClientWithResponses) UpdateTodoCommentWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error) {
+	rsp, err := c.UpdateTodoCommentWithBody(ctx, todoId, commentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoCommentWithResponse(ctx context.Context, todoId openapi_types.UUID, commentId openapi_types.UUID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error) {
+	rsp, err := c.UpdateTodoComment(ctx, todoId, commentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoCommentResponse(rsp)
+}
+
+// RestoreTodoWithResponse request returning *RestoreTodoResponse
+func (c *ClientWithResponses) RestoreTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreTodoResponse, error) {
+	rsp, err := c.RestoreTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTodoResponse(rsp)
+}
+
+// StartTodoTimerWithResponse request returning *StartTodoTimerResponse
+func (c *ClientWithResponses) StartTodoTimerWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*StartTodoTimerResponse, error) {
+	rsp, err := c.StartTodoTimer(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartTodoTimerResponse(rsp)
+}
+
+// StopTodoTimerWithResponse request returning *StopTodoTimerResponse
+func (c *ClientWithResponses) StopTodoTimerWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*StopTodoTimerResponse, error) {
+	rsp, err := c.StopTodoTimer(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopTodoTimerResponse(rsp)
+}
+
+// ListTokensWithResponse request returning *ListTokensResponse
+func (c *ClientWithResponses) ListTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTokensResponse, error) {
+	rsp, err := c.ListTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTokensResponse(rsp)
+}
+
+// CreateTokenWithBodyWithResponse request with arbitrary body returning *CreateTokenResponse
+func (c *ClientWithResponses) CreateTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	rsp, err := c.CreateTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTokenWithResponse(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	rsp, err := c.CreateToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTokenResponse(rsp)
+}
+
+// RevokeTokenWithResponse request returning *RevokeTokenResponse
+func (c *ClientWithResponses) RevokeTokenWithResponse(ctx context.Context, tokenId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeTokenResponse, error) {
+	rsp, err := c.RevokeToken(ctx, tokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeTokenResponse(rsp)
+}
+
+// ListViewsWithResponse request returning *ListViewsResponse
+func (c *ClientWithResponses) ListViewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListViewsResponse, error) {
+	rsp, err := c.ListViews(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListViewsResponse(rsp)
+}
+
+// SaveViewWithBodyWithResponse request with arbitrary body returning *SaveViewResponse
+func (c *ClientWithResponses) SaveViewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveViewResponse, error) {
+	rsp, err := c.SaveViewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveViewWithResponse(ctx context.Context, body SaveViewJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveViewResponse, error) {
+	rsp, err := c.SaveView(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveViewResponse(rsp)
+}
+
+// DeleteViewWithResponse request returning *DeleteViewResponse
+func (c *ClientWithResponses) DeleteViewWithResponse(ctx context.Context, viewId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteViewResponse, error) {
+	rsp, err := c.DeleteView(ctx, viewId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteViewResponse(rsp)
+}
+
+// UpdateViewWithBodyWithResponse request with arbitrary body returning *UpdateViewResponse
+func (c *ClientWithResponses) UpdateViewWithBodyWithResponse(ctx context.Context, viewId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateViewResponse, error) {
+	rsp, err := c.UpdateViewWithBody(ctx, viewId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateViewResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateViewWithResponse(ctx context.Context, viewId openapi_types.UUID, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateViewResponse, error) {
+	rsp, err := c.UpdateView(ctx, viewId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateViewResponse(rsp)
+}
+
+// ParseCompleteOIDCLoginResponse parses an HTTP response from a CompleteOIDCLoginWithResponse call
+func ParseCompleteOIDCLoginResponse(rsp *http.Response) (*CompleteOIDCLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteOIDCLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionTokens
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBeginOIDCLoginResponse parses an HTTP response from a BeginOIDCLoginWithResponse call
+func ParseBeginOIDCLoginResponse(rsp *http.Response) (*BeginOIDCLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BeginOIDCLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWeeklyReviewsResponse parses an HTTP response from a ListWeeklyReviewsWithResponse call
+func ParseListWeeklyReviewsResponse(rsp *http.Response) (*ListWeeklyReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWeeklyReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WeeklyReviewListResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBoardSnapshotResponse parses an HTTP response from a CreateBoardSnapshotWithResponse call
+func ParseCreateBoardSnapshotResponse(rsp *http.Response) (*CreateBoardSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBoardSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BoardSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardSnapshotResponse parses an HTTP response from a GetBoardSnapshotWithResponse call
+func ParseGetBoardSnapshotResponse(rsp *http.Response) (*GetBoardSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BoardSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/markdown) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBoardSummaryResponse parses an HTTP response from a GetBoardSummaryWithResponse call
+func ParseGetBoardSummaryResponse(rsp *http.Response) (*GetBoardSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBoardSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BoardSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamChatResponse parses an HTTP response from a StreamChatWithResponse call
+func ParseStreamChatResponse(rsp *http.Response) (*StreamChatResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamChatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitActionApprovalResponse parses an HTTP response from a SubmitActionApprovalWithResponse call
+func ParseSubmitActionApprovalResponse(rsp *http.Response) (*SubmitActionApprovalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitActionApprovalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContentBlobResponse parses an HTTP response from a GetContentBlobWithResponse call
+func ParseGetContentBlobResponse(rsp *http.Response) (*GetContentBlobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContentBlobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContentBlob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInstructionsResponse parses an HTTP response from a ListInstructionsWithResponse call
+func ParseListInstructionsResponse(rsp *http.Response) (*ListInstructionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInstructionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListInstructionsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRememberInstructionResponse parses an HTTP response from a RememberInstructionWithResponse call
+func ParseRememberInstructionResponse(rsp *http.Response) (*RememberInstructionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RememberInstructionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Instruction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInstructionResponse parses an HTTP response from a DeleteInstructionWithResponse call
+func ParseDeleteInstructionResponse(rsp *http.Response) (*DeleteInstructionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInstructionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMemoriesResponse parses an HTTP response from a ListMemoriesWithResponse call
+func ParseListMemoriesResponse(rsp *http.Response) (*ListMemoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMemoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListMemoriesResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMemoryResponse parses an HTTP response from a DeleteMemoryWithResponse call
+func ParseDeleteMemoryResponse(rsp *http.Response) (*DeleteMemoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMemoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListChatMessagesResponse parses an HTTP response from a ListChatMessagesWithResponse call
+func ParseListChatMessagesResponse(rsp *http.Response) (*ListChatMessagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListChatMessagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChatHistoryResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitMessageFeedbackResponse parses an HTTP response from a SubmitMessageFeedbackWithResponse call
+func ParseSubmitMessageFeedbackResponse(rsp *http.Response) (*SubmitMessageFeedbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitMessageFeedbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewChatResponse parses an HTTP response from a PreviewChatWithResponse call
+func ParsePreviewChatResponse(rsp *http.Response) (*PreviewChatResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewChatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAvailableSkillsResponse parses an HTTP response from a ListAvailableSkillsWithResponse call
+func ParseListAvailableSkillsResponse(rsp *http.Response) (*ListAvailableSkillsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAvailableSkillsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SkillListResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListConversationsResponse parses an HTTP response from a ListConversationsWithResponse call
+func ParseListConversationsResponse(rsp *http.Response) (*ListConversationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListConversationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConversationListResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamConversationEventsResponse parses an HTTP response from a StreamConversationEventsWithResponse call
+func ParseStreamConversationEventsResponse(rsp *http.Response) (*StreamConversationEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamConversationEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListRelatedConversationsResponse parses an HTTP response from a ListRelatedConversationsWithResponse call
+func ParseListRelatedConversationsResponse(rsp *http.Response) (*ListRelatedConversationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRelatedConversationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RelatedConversationListResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteConversationResponse parses an HTTP response from a DeleteConversationWithResponse call
+func ParseDeleteConversationResponse(rsp *http.Response) (*DeleteConversationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteConversationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateConversationResponse parses an HTTP response from a UpdateConversationWithResponse call
+func ParseUpdateConversationResponse(rsp *http.Response) (*UpdateConversationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateConversationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Conversation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditMessageResponse parses an HTTP response from a EditMessageWithResponse call
+func ParseEditMessageResponse(rsp *http.Response) (*EditMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegenerateMessageResponse parses an HTTP response from a RegenerateMessageWithResponse call
+func ParseRegenerateMessageResponse(rsp *http.Response) (*RegenerateMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegenerateMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMarkConversationReadResponse parses an HTTP response from a MarkConversationReadWithResponse call
+func ParseMarkConversationReadResponse(rsp *http.Response) (*MarkConversationReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MarkConversationReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSystemMessageResponse parses an HTTP response from a PostSystemMessageWithResponse call
+func ParsePostSystemMessageResponse(rsp *http.Response) (*PostSystemMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSystemMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ChatMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConversationTurnResponse parses an HTTP response from a GetConversationTurnWithResponse call
+func ParseGetConversationTurnResponse(rsp *http.Response) (*GetConversationTurnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConversationTurnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TurnTranscript
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReplayConversationTurnResponse parses an HTTP response from a ReplayConversationTurnWithResponse call
+func ParseReplayConversationTurnResponse(rsp *http.Response) (*ReplayConversationTurnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReplayConversationTurnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TurnReplay
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCustomFieldsResponse parses an HTTP response from a ListCustomFieldsWithResponse call
+func ParseListCustomFieldsResponse(rsp *http.Response) (*ListCustomFieldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCustomFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCustomFieldsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCustomFieldResponse parses an HTTP response from a DeleteCustomFieldWithResponse call
+func ParseDeleteCustomFieldResponse(rsp *http.Response) (*DeleteCustomFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDefineCustomFieldResponse parses an HTTP response from a DefineCustomFieldWithResponse call
+func ParseDefineCustomFieldResponse(rsp *http.Response) (*DefineCustomFieldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DefineCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomField
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGraphQLSchemaResponse parses an HTTP response from a GetGraphQLSchemaWithResponse call
+func ParseGetGraphQLSchemaResponse(rsp *http.Response) (*GetGraphQLSchemaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGraphQLSchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GraphQLSchemaResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReceiveInboundWebhookResponse parses an HTTP response from a ReceiveInboundWebhookWithResponse call
+func ParseReceiveInboundWebhookResponse(rsp *http.Response) (*ReceiveInboundWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReceiveInboundWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InboundWebhookResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAvailableModelsResponse parses an HTTP response from a ListAvailableModelsWithResponse call
+func ParseListAvailableModelsResponse(rsp *http.Response) (*ListAvailableModelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAvailableModelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ModelListResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenAPISpecResponse parses an HTTP response from a GetOpenAPISpecWithResponse call
+func ParseGetOpenAPISpecResponse(rsp *http.Response) (*GetOpenAPISpecResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenAPISpecResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListProjectsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectSuggestionsResponse parses an HTTP response from a ListProjectSuggestionsWithResponse call
+func ParseListProjectSuggestionsResponse(rsp *http.Response) (*ListProjectSuggestionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectSuggestionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListProjectSuggestionsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAcceptProjectSuggestionResponse parses an HTTP response from a AcceptProjectSuggestionWithResponse call
+func ParseAcceptProjectSuggestionResponse(rsp *http.Response) (*AcceptProjectSuggestionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AcceptProjectSuggestionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSessionsResponse parses an HTTP response from a ListSessionsWithResponse call
+func ParseListSessionsResponse(rsp *http.Response) (*ListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListSessionsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartSessionResponse parses an HTTP response from a StartSessionWithResponse call
+func ParseStartSessionResponse(rsp *http.Response) (*StartSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SessionTokens
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshSessionResponse parses an HTTP response from a RefreshSessionWithResponse call
+func ParseRefreshSessionResponse(rsp *http.Response) (*RefreshSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionTokens
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeSessionResponse parses an HTTP response from a RevokeSessionWithResponse call
+func ParseRevokeSessionResponse(rsp *http.Response) (*RevokeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTimeReportResponse parses an HTTP response from a GetTimeReportWithResponse call
+func ParseGetTimeReportResponse(rsp *http.Response) (*GetTimeReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTimeReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePullSyncResponse parses an HTTP response from a PullSyncWithResponse call
+func ParsePullSyncResponse(rsp *http.Response) (*PullSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PullSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePushSyncResponse parses an HTTP response from a PushSyncWithResponse call
+func ParsePushSyncResponse(rsp *http.Response) (*PushSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PushSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncPushResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTemplatesResponse parses an HTTP response from a ListTemplatesWithResponse call
+func ParseListTemplatesResponse(rsp *http.Response) (*ListTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTemplatesResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSaveTemplateResponse parses an HTTP response from a SaveTemplateWithResponse call
+func ParseSaveTemplateResponse(rsp *http.Response) (*SaveTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SaveTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Template
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTemplateResponse parses an HTTP response from a DeleteTemplateWithResponse call
+func ParseDeleteTemplateResponse(rsp *http.Response) (*DeleteTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTemplateResponse parses an HTTP response from a UpdateTemplateWithResponse call
+func ParseUpdateTemplateResponse(rsp *http.Response) (*UpdateTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Template
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplyTemplateResponse parses an HTTP response from a ApplyTemplateWithResponse call
+func ParseApplyTemplateResponse(rsp *http.Response) (*ApplyTemplateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	response := &ApplyTemplateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApplyTemplateResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) SubmitActionApproval(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitActionApprovalRequest(c.Server, body)
+// ParseListTodosResponse parses an HTTP response from a ListTodosWithResponse call
+func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) ListChatMessages(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListChatMessagesRequest(c.Server, params)
-	if err != nil {
-		return nil, err
+	response := &ListTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTodosResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) ListAvailableSkills(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListAvailableSkillsRequest(c.Server)
+// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
+func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &CreateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Todo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ListConversations(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListConversationsRequest(c.Server, params)
+// ParseListTodoChangesResponse parses an HTTP response from a ListTodoChangesWithResponse call
+func ParseListTodoChangesResponse(rsp *http.Response) (*ListTodoChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &ListTodoChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoChangesResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) DeleteConversation(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteConversationRequest(c.Server, conversationId)
+// ParseStreamTodoEventsResponse parses an HTTP response from a StreamTodoEventsWithResponse call
+func ParseStreamTodoEventsResponse(rsp *http.Response) (*StreamTodoEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &StreamTodoEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) UpdateConversationWithBody(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateConversationRequestWithBody(c.Server, conversationId, contentType, body)
+// ParseQuickAddTodoResponse parses an HTTP response from a QuickAddTodoWithResponse call
+func ParseQuickAddTodoResponse(rsp *http.Response) (*QuickAddTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &QuickAddTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QuickAddResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest QuickAddResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) UpdateConversation(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateConversationRequest(c.Server, conversationId, body)
+// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
+func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &DeleteTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ListAvailableModels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListAvailableModelsRequest(c.Server)
+// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
+func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UpdateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Todo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) ListTodos(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTodosRequest(c.Server, params)
+// ParseListTodoCommentsResponse parses an HTTP response from a ListTodoCommentsWithResponse call
+func ParseListTodoCommentsResponse(rsp *http.Response) (*ListTodoCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &ListTodoCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListCommentsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
+// ParseCreateTodoCommentResponse parses an HTTP response from a CreateTodoCommentWithResponse call
+func ParseCreateTodoCommentResponse(rsp *http.Response) (*CreateTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequest(c.Server, body)
-	if err != nil {
-		return nil, err
+	response := &CreateTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) DeleteTodo(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTodoRequest(c.Server, todoId)
+// ParseDeleteTodoCommentResponse parses an HTTP response from a DeleteTodoCommentWithResponse call
+func ParseDeleteTodoCommentResponse(rsp *http.Response) (*DeleteTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequestWithBody(c.Server, todoId, contentType, body)
-	if err != nil {
-		return nil, err
+	response := &DeleteTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) UpdateTodo(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequest(c.Server, todoId, body)
+// ParseUpdateTodoCommentResponse parses an HTTP response from a UpdateTodoCommentWithResponse call
+func ParseUpdateTodoCommentResponse(rsp *http.Response) (*UpdateTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UpdateTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
-}
 
-// NewGetBoardSummaryRequest generates requests for GetBoardSummary
-func NewGetBoardSummaryRequest(server string) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	operationPath := fmt.Sprintf("/api/v1/board/summary")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseRestoreTodoResponse parses an HTTP response from a RestoreTodoWithResponse call
+func ParseRestoreTodoResponse(rsp *http.Response) (*RestoreTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &RestoreTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Todo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// NewStreamChatRequest calls the generic StreamChat builder with application/json body
-func NewStreamChatRequest(server string, body StreamChatJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewStreamChatRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewStreamChatRequestWithBody generates requests for StreamChat with any type of body
-func NewStreamChatRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	return response, nil
+}
 
-	serverURL, err := url.Parse(server)
+// ParseStartTodoTimerResponse parses an HTTP response from a StartTodoTimerWithResponse call
+func ParseStartTodoTimerResponse(rsp *http.Response) (*StartTodoTimerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/chat")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &StartTodoTimerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseStopTodoTimerResponse parses an HTTP response from a StopTodoTimerWithResponse call
+func ParseStopTodoTimerResponse(rsp *http.Response) (*StopTodoTimerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &StopTodoTimerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// NewSubmitActionApprovalRequest calls the generic SubmitActionApproval builder with application/json body
-func NewSubmitActionApprovalRequest(server string, body SubmitActionApprovalJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseListTokensResponse parses an HTTP response from a ListTokensWithResponse call
+func ParseListTokensResponse(rsp *http.Response) (*ListTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSubmitActionApprovalRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewSubmitActionApprovalRequestWithBody generates requests for SubmitActionApproval with any type of body
-func NewSubmitActionApprovalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &ListTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListTokensResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	operationPath := fmt.Sprintf("/api/v1/chat/approvals")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseCreateTokenResponse parses an HTTP response from a CreateTokenWithResponse call
+func ParseCreateTokenResponse(rsp *http.Response) (*CreateTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	response := &CreateTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-	return req, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// NewListChatMessagesRequest generates requests for ListChatMessages
-func NewListChatMessagesRequest(server string, params *ListChatMessagesParams) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/chat/messages")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseRevokeTokenResponse parses an HTTP response from a RevokeTokenWithResponse call
+func ParseRevokeTokenResponse(rsp *http.Response) (*RevokeTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	response := &RevokeTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "conversation_id", runtime.ParamLocationQuery, params.ConversationId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON400 = &dest
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON403 = &dest
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON404 = &dest
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseListViewsResponse parses an HTTP response from a ListViewsWithResponse call
+func ParseListViewsResponse(rsp *http.Response) (*ListViewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &ListViewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// NewListAvailableSkillsRequest generates requests for ListAvailableSkills
-func NewListAvailableSkillsRequest(server string) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListViewsResp
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/chat/skills")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseSaveViewResponse parses an HTTP response from a SaveViewWithResponse call
+func ParseSaveViewResponse(rsp *http.Response) (*SaveViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &SaveViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest View
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewListConversationsRequest generates requests for ListConversations
-func NewListConversationsRequest(server string, params *ListConversationsParams) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	serverURL, err := url.Parse(server)
+	}
+
+	return response, nil
+}
+
+// ParseDeleteViewResponse parses an HTTP response from a DeleteViewWithResponse call
+func ParseDeleteViewResponse(rsp *http.Response) (*DeleteViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/conversations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &DeleteViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateViewResponse parses an HTTP response from a UpdateViewWithResponse call
+func ParseUpdateViewResponse(rsp *http.Response) (*UpdateViewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	response := &UpdateViewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest View
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Complete an OpenID Connect login
+	// (GET /api/v1/auth/oidc/callback)
+	CompleteOIDCLogin(w http.ResponseWriter, r *http.Request, params CompleteOIDCLoginParams)
+	// Begin an OpenID Connect login
+	// (GET /api/v1/auth/oidc/login)
+	BeginOIDCLogin(w http.ResponseWriter, r *http.Request)
+	// List weekly reviews
+	// (GET /api/v1/board/reviews)
+	ListWeeklyReviews(w http.ResponseWriter, r *http.Request, params ListWeeklyReviewsParams)
+	// Create a board snapshot
+	// (POST /api/v1/board/snapshots)
+	CreateBoardSnapshot(w http.ResponseWriter, r *http.Request)
+	// Get a board snapshot
+	// (GET /api/v1/board/snapshots/{token})
+	GetBoardSnapshot(w http.ResponseWriter, r *http.Request, token string, params GetBoardSnapshotParams)
+	// Get AI-generated board summary
+	// (GET /api/v1/board/summary)
+	GetBoardSummary(w http.ResponseWriter, r *http.Request)
+	// Stream assistant response for a user message (single global chat)
+	// (POST /api/v1/chat)
+	StreamChat(w http.ResponseWriter, r *http.Request, params StreamChatParams)
+	// Submit action approval decision
+	// (POST /api/v1/chat/approvals)
+	SubmitActionApproval(w http.ResponseWriter, r *http.Request)
+	// Get a stored action result
+	// (GET /api/v1/chat/blobs/{hash})
+	GetContentBlob(w http.ResponseWriter, r *http.Request, hash string)
+	// List pinned instructions
+	// (GET /api/v1/chat/instructions)
+	ListInstructions(w http.ResponseWriter, r *http.Request, params ListInstructionsParams)
+	// Pin an instruction
+	// (POST /api/v1/chat/instructions)
+	RememberInstruction(w http.ResponseWriter, r *http.Request)
+	// Delete a pinned instruction
+	// (DELETE /api/v1/chat/instructions/{instruction_id})
+	DeleteInstruction(w http.ResponseWriter, r *http.Request, instructionId openapi_types.UUID)
+	// List long-term memories
+	// (GET /api/v1/chat/memories)
+	ListMemories(w http.ResponseWriter, r *http.Request)
+	// Delete a long-term memory
+	// (DELETE /api/v1/chat/memories/{memory_id})
+	DeleteMemory(w http.ResponseWriter, r *http.Request, memoryId openapi_types.UUID)
+	// Fetch chat history (single global chat)
+	// (GET /api/v1/chat/messages)
+	ListChatMessages(w http.ResponseWriter, r *http.Request, params ListChatMessagesParams)
+	// Rate an assistant response
+	// (PUT /api/v1/chat/messages/{message_id}/feedback)
+	SubmitMessageFeedback(w http.ResponseWriter, r *http.Request, messageId openapi_types.UUID)
+	// Preview the assistant turn for a user message without side effects
+	// (POST /api/v1/chat/preview)
+	PreviewChat(w http.ResponseWriter, r *http.Request, params PreviewChatParams)
+	// List available skills
+	// (GET /api/v1/chat/skills)
+	ListAvailableSkills(w http.ResponseWriter, r *http.Request)
+	// List conversations
+	// (GET /api/v1/conversations)
+	ListConversations(w http.ResponseWriter, r *http.Request, params ListConversationsParams)
+	// Stream conversation unread events
+	// (GET /api/v1/conversations/events)
+	StreamConversationEvents(w http.ResponseWriter, r *http.Request)
+	// List related conversations
+	// (GET /api/v1/conversations/related/{conversation_id})
+	ListRelatedConversations(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
+	// Delete a conversation
+	// (DELETE /api/v1/conversations/{conversation_id})
+	DeleteConversation(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
+	// Update conversation
+	// (PATCH /api/v1/conversations/{conversation_id})
+	UpdateConversation(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
+	// Edit and resend a user message
+	// (POST /api/v1/conversations/{conversation_id}/messages/{message_id}/edit)
+	EditMessage(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, messageId openapi_types.UUID, params EditMessageParams)
+	// Regenerate an assistant message
+	// (POST /api/v1/conversations/{conversation_id}/messages/{message_id}/regenerate)
+	RegenerateMessage(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, messageId openapi_types.UUID, params RegenerateMessageParams)
+	// Mark a conversation read
+	// (POST /api/v1/conversations/{conversation_id}/read)
+	MarkConversationRead(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
+	// Post a system message
+	// (POST /api/v1/conversations/{conversation_id}/system-messages)
+	PostSystemMessage(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
+	// Get a conversation turn transcript
+	// (GET /api/v1/conversations/{conversation_id}/turns/{turn_id})
+	GetConversationTurn(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, turnId openapi_types.UUID)
+	// Replay a conversation turn
+	// (POST /api/v1/conversations/{conversation_id}/turns/{turn_id}/replay)
+	ReplayConversationTurn(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, turnId openapi_types.UUID)
+	// List custom fields
+	// (GET /api/v1/custom-fields)
+	ListCustomFields(w http.ResponseWriter, r *http.Request)
+	// Delete a custom field
+	// (DELETE /api/v1/custom-fields/{name})
+	DeleteCustomField(w http.ResponseWriter, r *http.Request, name string)
+	// Define a custom field
+	// (PUT /api/v1/custom-fields/{name})
+	DefineCustomField(w http.ResponseWriter, r *http.Request, name string)
+	// Get the GraphQL schema
+	// (GET /api/v1/graphql/schema)
+	GetGraphQLSchema(w http.ResponseWriter, r *http.Request)
+	// Create a todo from an inbound webhook
+	// (POST /api/v1/inbound/webhooks/{source})
+	ReceiveInboundWebhook(w http.ResponseWriter, r *http.Request, source string, params ReceiveInboundWebhookParams)
+	// List available AI models
+	// (GET /api/v1/models)
+	ListAvailableModels(w http.ResponseWriter, r *http.Request)
+	// Get the OpenAPI specification
+	// (GET /api/v1/openapi.json)
+	GetOpenAPISpec(w http.ResponseWriter, r *http.Request)
+	// List projects
+	// (GET /api/v1/projects)
+	ListProjects(w http.ResponseWriter, r *http.Request)
+	// List project suggestions
+	// (GET /api/v1/projects/suggestions)
+	ListProjectSuggestions(w http.ResponseWriter, r *http.Request)
+	// Accept a project suggestion
+	// (POST /api/v1/projects/suggestions/{suggestion_id}/accept)
+	AcceptProjectSuggestion(w http.ResponseWriter, r *http.Request, suggestionId openapi_types.UUID)
+	// List sessions
+	// (GET /api/v1/sessions)
+	ListSessions(w http.ResponseWriter, r *http.Request)
+	// Start a session
+	// (POST /api/v1/sessions)
+	StartSession(w http.ResponseWriter, r *http.Request)
+	// Refresh a session
+	// (POST /api/v1/sessions/refresh)
+	RefreshSession(w http.ResponseWriter, r *http.Request)
+	// Revoke a session
+	// (DELETE /api/v1/sessions/{session_id})
+	RevokeSession(w http.ResponseWriter, r *http.Request, sessionId openapi_types.UUID)
+	// Get logged time report
+	// (GET /api/v1/stats/time)
+	GetTimeReport(w http.ResponseWriter, r *http.Request, params GetTimeReportParams)
+	// Pull changes since a sync cursor
+	// (GET /api/v1/sync)
+	PullSync(w http.ResponseWriter, r *http.Request, params PullSyncParams)
+	// Push client mutations
+	// (POST /api/v1/sync)
+	PushSync(w http.ResponseWriter, r *http.Request)
+	// List templates
+	// (GET /api/v1/templates)
+	ListTemplates(w http.ResponseWriter, r *http.Request)
+	// Save a template
+	// (POST /api/v1/templates)
+	SaveTemplate(w http.ResponseWriter, r *http.Request)
+	// Delete a template
+	// (DELETE /api/v1/templates/{template_id})
+	DeleteTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// Update a template
+	// (PATCH /api/v1/templates/{template_id})
+	UpdateTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// Apply a template
+	// (POST /api/v1/templates/{template_id}/apply)
+	ApplyTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID)
+	// List todos
+	// (GET /api/v1/todos)
+	ListTodos(w http.ResponseWriter, r *http.Request, params ListTodosParams)
+	// Create a todo
+	// (POST /api/v1/todos)
+	CreateTodo(w http.ResponseWriter, r *http.Request)
+	// List todo changes since a sequence number
+	// (GET /api/v1/todos/changes)
+	ListTodoChanges(w http.ResponseWriter, r *http.Request, params ListTodoChangesParams)
+	// Stream todo change events
+	// (GET /api/v1/todos/events)
+	StreamTodoEvents(w http.ResponseWriter, r *http.Request)
+	// Parse a quick add line into a todo
+	// (POST /api/v1/todos/quick-add)
+	QuickAddTodo(w http.ResponseWriter, r *http.Request)
+	// Delete a todo
+	// (DELETE /api/v1/todos/{todo_id})
+	DeleteTodo(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
+	// Update a todo
+	// (PATCH /api/v1/todos/{todo_id})
+	UpdateTodo(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
+	// List todo comments
+	// (GET /api/v1/todos/{todo_id}/comments)
+	ListTodoComments(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID, params ListTodoCommentsParams)
+	// Add a comment to a todo
+	// (POST /api/v1/todos/{todo_id}/comments)
+	CreateTodoComment(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
+	// Delete a comment
+	// (DELETE /api/v1/todos/{todo_id}/comments/{comment_id})
+	DeleteTodoComment(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID, commentId openapi_types.UUID)
+	// Edit a comment
+	// (PATCH /api/v1/todos/{todo_id}/comments/{comment_id})
+	UpdateTodoComment(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID, commentId openapi_types.UUID)
+	// Restore an archived todo
+	// (POST /api/v1/todos/{todo_id}/restore)
+	RestoreTodo(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
+	// Start a todo timer
+	// (POST /api/v1/todos/{todo_id}/timer/start)
+	StartTodoTimer(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
+	// Stop a todo timer
+	// (POST /api/v1/todos/{todo_id}/timer/stop)
+	StopTodoTimer(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
+	// List personal access tokens
+	// (GET /api/v1/tokens)
+	ListTokens(w http.ResponseWriter, r *http.Request)
+	// Create a personal access token
+	// (POST /api/v1/tokens)
+	CreateToken(w http.ResponseWriter, r *http.Request)
+	// Revoke a personal access token
+	// (DELETE /api/v1/tokens/{token_id})
+	RevokeToken(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID)
+	// List views
+	// (GET /api/v1/views)
+	ListViews(w http.ResponseWriter, r *http.Request)
+	// Save a view
+	// (POST /api/v1/views)
+	SaveView(w http.ResponseWriter, r *http.Request)
+	// Delete a view
+	// (DELETE /api/v1/views/{view_id})
+	DeleteView(w http.ResponseWriter, r *http.Request, viewId openapi_types.UUID)
+	// Update a view
+	// (PATCH /api/v1/views/{view_id})
+	UpdateView(w http.ResponseWriter, r *http.Request, viewId openapi_types.UUID)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+type MiddlewareFunc func(http.Handler) http.Handler
 
-	return req, nil
-}
+// CompleteOIDCLogin operation middleware
+func (siw *ServerInterfaceWrapper) CompleteOIDCLogin(w http.ResponseWriter, r *http.Request) {
 
-// NewDeleteConversationRequest generates requests for DeleteConversation
-func NewDeleteConversationRequest(server string, conversationId openapi_types.UUID) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompleteOIDCLoginParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
 	}
 
-	serverURL, err := url.Parse(server)
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/conversations/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ------------- Optional query parameter "error" -------------
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteOIDCLogin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return req, nil
+	handler.ServeHTTP(w, r)
 }
 
-// NewUpdateConversationRequest calls the generic UpdateConversation builder with application/json body
-func NewUpdateConversationRequest(server string, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// BeginOIDCLogin operation middleware
+func (siw *ServerInterfaceWrapper) BeginOIDCLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BeginOIDCLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateConversationRequestWithBody(server, conversationId, "application/json", bodyReader)
+
+	handler.ServeHTTP(w, r)
 }
 
-// NewUpdateConversationRequestWithBody generates requests for UpdateConversation with any type of body
-func NewUpdateConversationRequestWithBody(server string, conversationId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// ListWeeklyReviews operation middleware
+func (siw *ServerInterfaceWrapper) ListWeeklyReviews(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWeeklyReviewsParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, conversationId)
-	if err != nil {
-		return nil, err
+	// ------------- Required query parameter "pageSize" -------------
+
+	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		return
 	}
 
-	serverURL, err := url.Parse(server)
+	err = runtime.BindQueryParameter("form", true, true, "pageSize", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/conversations/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	// ------------- Required query parameter "page" -------------
+
+	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		return
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWeeklyReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	handler.ServeHTTP(w, r)
+}
 
-	return req, nil
+// CreateBoardSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateBoardSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBoardSnapshot(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// NewListAvailableModelsRequest generates requests for ListAvailableModels
-func NewListAvailableModelsRequest(server string) (*http.Request, error) {
+// GetBoardSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardSnapshot(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
-	serverURL, err := url.Parse(server)
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/models")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBoardSnapshotParams
 
-	queryURL, err := serverURL.Parse(operationPath)
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardSnapshot(w, r, token, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return req, nil
+	handler.ServeHTTP(w, r)
 }
 
-// NewListTodosRequest generates requests for ListTodos
-func NewListTodosRequest(server string, params *ListTodosParams) (*http.Request, error) {
-	var err error
+// GetBoardSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardSummary(w http.ResponseWriter, r *http.Request) {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardSummary(w, r)
+	}))
 
-	operationPath := fmt.Sprintf("/api/v1/todos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	handler.ServeHTTP(w, r)
+}
+
+// StreamChat operation middleware
+func (siw *ServerInterfaceWrapper) StreamChat(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamChatParams
+
+	// ------------- Optional query parameter "include_action_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_action_results", r.URL.Query(), &params.IncludeActionResults)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_action_results", Err: err})
+		return
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamChat(w, r, params)
+	}))
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, params.PageSize); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, params.Page); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	handler.ServeHTTP(w, r)
+}
 
-		if params.Status != nil {
+// SubmitActionApproval operation middleware
+func (siw *ServerInterfaceWrapper) SubmitActionApproval(w http.ResponseWriter, r *http.Request) {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitActionApproval(w, r)
+	}))
 
-		}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-		if params.Search != nil {
+	handler.ServeHTTP(w, r)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// GetContentBlob operation middleware
+func (siw *ServerInterfaceWrapper) GetContentBlob(w http.ResponseWriter, r *http.Request) {
 
-		}
+	var err error
 
-		if params.SearchType != nil {
+	// ------------- Path parameter "hash" -------------
+	var hash string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "searchType", runtime.ParamLocationQuery, *params.SearchType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	err = runtime.BindStyledParameterWithOptions("simple", "hash", r.PathValue("hash"), &hash, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hash", Err: err})
+		return
+	}
 
-		}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContentBlob(w, r, hash)
+	}))
 
-		if params.DateRange != nil {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "dateRange", runtime.ParamLocationQuery, *params.DateRange); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	handler.ServeHTTP(w, r)
+}
 
-		}
+// ListInstructions operation middleware
+func (siw *ServerInterfaceWrapper) ListInstructions(w http.ResponseWriter, r *http.Request) {
 
-		if params.Sort != nil {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInstructionsParams
 
-		}
+	// ------------- Optional query parameter "conversation_id" -------------
 
-		queryURL.RawQuery = queryValues.Encode()
+	err = runtime.BindQueryParameter("form", true, false, "conversation_id", r.URL.Query(), &params.ConversationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInstructions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return req, nil
+	handler.ServeHTTP(w, r)
 }
 
-// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
-func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+// RememberInstruction operation middleware
+func (siw *ServerInterfaceWrapper) RememberInstruction(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RememberInstruction(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
+
+	handler.ServeHTTP(w, r)
 }
 
-// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
-func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// DeleteInstruction operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInstruction(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
-	serverURL, err := url.Parse(server)
+	// ------------- Path parameter "instruction_id" -------------
+	var instructionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "instruction_id", r.PathValue("instruction_id"), &instructionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "instruction_id", Err: err})
+		return
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/todos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteInstruction(w, r, instructionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	handler.ServeHTTP(w, r)
+}
+
+// ListMemories operation middleware
+func (siw *ServerInterfaceWrapper) ListMemories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMemories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMemory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMemory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "memory_id" -------------
+	var memoryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memory_id", r.PathValue("memory_id"), &memoryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memory_id", Err: err})
+		return
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMemory(w, r, memoryId)
+	}))
 
-	return req, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// NewDeleteTodoRequest generates requests for DeleteTodo
-func NewDeleteTodoRequest(server string, todoId openapi_types.UUID) (*http.Request, error) {
+// ListChatMessages operation middleware
+func (siw *ServerInterfaceWrapper) ListChatMessages(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListChatMessagesParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
-	if err != nil {
-		return nil, err
+	// ------------- Required query parameter "conversation_id" -------------
+
+	if paramValue := r.URL.Query().Get("conversation_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "conversation_id"})
+		return
 	}
 
-	serverURL, err := url.Parse(server)
+	err = runtime.BindQueryParameter("form", true, true, "conversation_id", r.URL.Query(), &params.ConversationId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	// ------------- Required query parameter "pageSize" -------------
+
+	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		return
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	err = runtime.BindQueryParameter("form", true, true, "pageSize", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	// ------------- Required query parameter "page" -------------
+
+	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
 
-	return req, nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListChatMessages(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
-func NewUpdateTodoRequest(server string, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// SubmitMessageFeedback operation middleware
+func (siw *ServerInterfaceWrapper) SubmitMessageFeedback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "message_id" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "message_id", r.PathValue("message_id"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "message_id", Err: err})
+		return
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitMessageFeedback(w, r, messageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
-func NewUpdateTodoRequestWithBody(server string, todoId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// PreviewChat operation middleware
+func (siw *ServerInterfaceWrapper) PreviewChat(w http.ResponseWriter, r *http.Request) {
+
 	var err error
 
-	var pathParam0 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PreviewChatParams
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todo_id", runtime.ParamLocationPath, todoId)
+	// ------------- Optional query parameter "include_action_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_action_results", r.URL.Query(), &params.IncludeActionResults)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_action_results", Err: err})
+		return
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewChat(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	handler.ServeHTTP(w, r)
+}
+
+// ListAvailableSkills operation middleware
+func (siw *ServerInterfaceWrapper) ListAvailableSkills(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAvailableSkills(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	handler.ServeHTTP(w, r)
+}
+
+// ListConversations operation middleware
+func (siw *ServerInterfaceWrapper) ListConversations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListConversationsParams
+
+	// ------------- Required query parameter "pageSize" -------------
+
+	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		return
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	err = runtime.BindQueryParameter("form", true, true, "pageSize", r.URL.Query(), &params.PageSize)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	// ------------- Required query parameter "page" -------------
 
-	return req, nil
-}
+	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		return
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
-
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListConversations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetBoardSummaryWithResponse request
-	GetBoardSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBoardSummaryResponse, error)
+// StreamConversationEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamConversationEvents(w http.ResponseWriter, r *http.Request) {
 
-	// StreamChatWithBodyWithResponse request with any body
-	StreamChatWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamChatResponse, error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamConversationEvents(w, r)
+	}))
 
-	StreamChatWithResponse(ctx context.Context, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamChatResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// SubmitActionApprovalWithBodyWithResponse request with any body
-	SubmitActionApprovalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error)
+	handler.ServeHTTP(w, r)
+}
 
-	SubmitActionApprovalWithResponse(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error)
+// ListRelatedConversations operation middleware
+func (siw *ServerInterfaceWrapper) ListRelatedConversations(w http.ResponseWriter, r *http.Request) {
 
-	// ListChatMessagesWithResponse request
-	ListChatMessagesWithResponse(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*ListChatMessagesResponse, error)
+	var err error
 
-	// ListAvailableSkillsWithResponse request
-	ListAvailableSkillsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableSkillsResponse, error)
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
 
-	// ListConversationsWithResponse request
-	ListConversationsWithResponse(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*ListConversationsResponse, error)
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
+	}
 
-	// DeleteConversationWithResponse request
-	DeleteConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteConversationResponse, error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRelatedConversations(w, r, conversationId)
+	}))
 
-	// UpdateConversationWithBodyWithResponse request with any body
-	UpdateConversationWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	UpdateConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error)
+	handler.ServeHTTP(w, r)
+}
 
-	// ListAvailableModelsWithResponse request
-	ListAvailableModelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableModelsResponse, error)
+// DeleteConversation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *http.Request) {
 
-	// ListTodosWithResponse request
-	ListTodosWithResponse(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+	var err error
 
-	// CreateTodoWithBodyWithResponse request with any body
-	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
 
-	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
+	}
 
-	// DeleteTodoWithResponse request
-	DeleteTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteConversation(w, r, conversationId)
+	}))
 
-	// UpdateTodoWithBodyWithResponse request with any body
-	UpdateTodoWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	UpdateTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	handler.ServeHTTP(w, r)
 }
 
-type GetBoardSummaryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BoardSummary
-	JSON404      *ErrorResp
-}
+// UpdateConversation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *http.Request) {
 
-// Status returns HTTPResponse.Status
-func (r GetBoardSummaryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBoardSummaryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type StreamChatResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResp
-	JSON500      *ErrorResp
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateConversation(w, r, conversationId)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r StreamChatResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StreamChatResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	handler.ServeHTTP(w, r)
 }
 
-type SubmitActionApprovalResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON500      *ErrorResp
-}
+// EditMessage operation middleware
+func (siw *ServerInterfaceWrapper) EditMessage(w http.ResponseWriter, r *http.Request) {
 
-// Status returns HTTPResponse.Status
-func (r SubmitActionApprovalResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var err error
+
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubmitActionApprovalResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "message_id" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "message_id", r.PathValue("message_id"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "message_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type ListChatMessagesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ChatHistoryResp
-	JSON500      *ErrorResp
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditMessageParams
 
-// Status returns HTTPResponse.Status
-func (r ListChatMessagesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Optional query parameter "include_action_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_action_results", r.URL.Query(), &params.IncludeActionResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_action_results", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListChatMessagesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditMessage(w, r, conversationId, messageId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListAvailableSkillsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SkillListResp
-	JSON500      *ErrorResp
-}
+// RegenerateMessage operation middleware
+func (siw *ServerInterfaceWrapper) RegenerateMessage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "message_id" -------------
+	var messageId openapi_types.UUID
 
-// Status returns HTTPResponse.Status
-func (r ListAvailableSkillsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "message_id", r.PathValue("message_id"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "message_id", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListAvailableSkillsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegenerateMessageParams
+
+	// ------------- Optional query parameter "include_action_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_action_results", r.URL.Query(), &params.IncludeActionResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_action_results", Err: err})
+		return
 	}
-	return 0
-}
 
-type ListConversationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ConversationListResp
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegenerateMessage(w, r, conversationId, messageId, params)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r ListConversationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListConversationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MarkConversationRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkConversationRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type DeleteConversationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *NotFound
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkConversationRead(w, r, conversationId)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r DeleteConversationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteConversationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostSystemMessage operation middleware
+func (siw *ServerInterfaceWrapper) PostSystemMessage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type UpdateConversationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Conversation
-	JSON400      *BadRequest
-	JSON404      *NotFound
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSystemMessage(w, r, conversationId)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r UpdateConversationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateConversationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetConversationTurn operation middleware
+func (siw *ServerInterfaceWrapper) GetConversationTurn(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type ListAvailableModelsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ModelListResp
-	JSON500      *ErrorResp
-}
+	// ------------- Path parameter "turn_id" -------------
+	var turnId openapi_types.UUID
 
-// Status returns HTTPResponse.Status
-func (r ListAvailableModelsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithOptions("simple", "turn_id", r.PathValue("turn_id"), &turnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "turn_id", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListAvailableModelsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConversationTurn(w, r, conversationId, turnId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type ListTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListTodosResp
+	handler.ServeHTTP(w, r)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ReplayConversationTurn operation middleware
+func (siw *ServerInterfaceWrapper) ReplayConversationTurn(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "turn_id" -------------
+	var turnId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "turn_id", r.PathValue("turn_id"), &turnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "turn_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type CreateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Todo
-	JSON400      *BadRequest
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayConversationTurn(w, r, conversationId, turnId)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r CreateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListCustomFields operation middleware
+func (siw *ServerInterfaceWrapper) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCustomFields(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type DeleteTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *NotFound
+	handler.ServeHTTP(w, r)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteCustomField operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomField(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomField(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type UpdateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Todo
-	JSON400      *BadRequest
-	JSON404      *NotFound
+	handler.ServeHTTP(w, r)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DefineCustomField operation middleware
+func (siw *ServerInterfaceWrapper) DefineCustomField(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DefineCustomField(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetGraphQLSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetGraphQLSchema(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGraphQLSchema(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
+
+	handler.ServeHTTP(w, r)
 }
 
-// GetBoardSummaryWithResponse request returning *GetBoardSummaryResponse
-func (c *ClientWithResponses) GetBoardSummaryWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBoardSummaryResponse, error) {
-	rsp, err := c.GetBoardSummary(ctx, reqEditors...)
+// ReceiveInboundWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ReceiveInboundWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "source" -------------
+	var source string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "source", r.PathValue("source"), &source, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
 	}
-	return ParseGetBoardSummaryResponse(rsp)
-}
 
-// StreamChatWithBodyWithResponse request with arbitrary body returning *StreamChatResponse
-func (c *ClientWithResponses) StreamChatWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamChatResponse, error) {
-	rsp, err := c.StreamChatWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReceiveInboundWebhookParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-GitHub-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Event", Err: err})
+			return
+		}
+
+		params.XGitHubEvent = &XGitHubEvent
+
 	}
-	return ParseStreamChatResponse(rsp)
-}
 
-func (c *ClientWithResponses) StreamChatWithResponse(ctx context.Context, body StreamChatJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamChatResponse, error) {
-	rsp, err := c.StreamChat(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Optional header parameter "X-Webhook-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Webhook-Event")]; found {
+		var XWebhookEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Webhook-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Webhook-Event", valueList[0], &XWebhookEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Webhook-Event", Err: err})
+			return
+		}
+
+		params.XWebhookEvent = &XWebhookEvent
+
 	}
-	return ParseStreamChatResponse(rsp)
-}
 
-// SubmitActionApprovalWithBodyWithResponse request with arbitrary body returning *SubmitActionApprovalResponse
-func (c *ClientWithResponses) SubmitActionApprovalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error) {
-	rsp, err := c.SubmitActionApprovalWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Optional header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Hub-Signature-256", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Hub-Signature-256", Err: err})
+			return
+		}
+
+		params.XHubSignature256 = &XHubSignature256
+
 	}
-	return ParseSubmitActionApprovalResponse(rsp)
-}
 
-func (c *ClientWithResponses) SubmitActionApprovalWithResponse(ctx context.Context, body SubmitActionApprovalJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitActionApprovalResponse, error) {
-	rsp, err := c.SubmitActionApproval(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Optional header parameter "X-Webhook-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Webhook-Signature")]; found {
+		var XWebhookSignature string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Webhook-Signature", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Webhook-Signature", valueList[0], &XWebhookSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Webhook-Signature", Err: err})
+			return
+		}
+
+		params.XWebhookSignature = &XWebhookSignature
+
 	}
-	return ParseSubmitActionApprovalResponse(rsp)
-}
 
-// ListChatMessagesWithResponse request returning *ListChatMessagesResponse
-func (c *ClientWithResponses) ListChatMessagesWithResponse(ctx context.Context, params *ListChatMessagesParams, reqEditors ...RequestEditorFn) (*ListChatMessagesResponse, error) {
-	rsp, err := c.ListChatMessages(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Optional header parameter "X-Webhook-Secret" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Webhook-Secret")]; found {
+		var XWebhookSecret string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Webhook-Secret", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Webhook-Secret", valueList[0], &XWebhookSecret, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Webhook-Secret", Err: err})
+			return
+		}
+
+		params.XWebhookSecret = &XWebhookSecret
+
 	}
-	return ParseListChatMessagesResponse(rsp)
-}
 
-// ListAvailableSkillsWithResponse request returning *ListAvailableSkillsResponse
-func (c *ClientWithResponses) ListAvailableSkillsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableSkillsResponse, error) {
-	rsp, err := c.ListAvailableSkills(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReceiveInboundWebhook(w, r, source, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseListAvailableSkillsResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// ListConversationsWithResponse request returning *ListConversationsResponse
-func (c *ClientWithResponses) ListConversationsWithResponse(ctx context.Context, params *ListConversationsParams, reqEditors ...RequestEditorFn) (*ListConversationsResponse, error) {
-	rsp, err := c.ListConversations(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ListAvailableModels operation middleware
+func (siw *ServerInterfaceWrapper) ListAvailableModels(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAvailableModels(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseListConversationsResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteConversationWithResponse request returning *DeleteConversationResponse
-func (c *ClientWithResponses) DeleteConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteConversationResponse, error) {
-	rsp, err := c.DeleteConversation(ctx, conversationId, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetOpenAPISpec operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOpenAPISpec(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseDeleteConversationResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateConversationWithBodyWithResponse request with arbitrary body returning *UpdateConversationResponse
-func (c *ClientWithResponses) UpdateConversationWithBodyWithResponse(ctx context.Context, conversationId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error) {
-	rsp, err := c.UpdateConversationWithBody(ctx, conversationId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjects(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseUpdateConversationResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-func (c *ClientWithResponses) UpdateConversationWithResponse(ctx context.Context, conversationId openapi_types.UUID, body UpdateConversationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateConversationResponse, error) {
-	rsp, err := c.UpdateConversation(ctx, conversationId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ListProjectSuggestions operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectSuggestions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectSuggestions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseUpdateConversationResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// ListAvailableModelsWithResponse request returning *ListAvailableModelsResponse
-func (c *ClientWithResponses) ListAvailableModelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAvailableModelsResponse, error) {
-	rsp, err := c.ListAvailableModels(ctx, reqEditors...)
+// AcceptProjectSuggestion operation middleware
+func (siw *ServerInterfaceWrapper) AcceptProjectSuggestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "suggestion_id" -------------
+	var suggestionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "suggestion_id", r.PathValue("suggestion_id"), &suggestionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suggestion_id", Err: err})
+		return
 	}
-	return ParseListAvailableModelsResponse(rsp)
-}
 
-// ListTodosWithResponse request returning *ListTodosResponse
-func (c *ClientWithResponses) ListTodosWithResponse(ctx context.Context, params *ListTodosParams, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
-	rsp, err := c.ListTodos(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptProjectSuggestion(w, r, suggestionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseListTodosResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
-func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseCreateTodoResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StartSession operation middleware
+func (siw *ServerInterfaceWrapper) StartSession(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartSession(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseCreateTodoResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteTodoWithResponse request returning *DeleteTodoResponse
-func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
-	rsp, err := c.DeleteTodo(ctx, todoId, reqEditors...)
-	if err != nil {
-		return nil, err
+// RefreshSession operation middleware
+func (siw *ServerInterfaceWrapper) RefreshSession(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshSession(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseDeleteTodoResponse(rsp)
+
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
-func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, todoId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+// RevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", r.PathValue("session_id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session_id", Err: err})
+		return
 	}
-	return ParseUpdateTodoResponse(rsp)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeSession(w, r, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, todoId openapi_types.UUID, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodo(ctx, todoId, body, reqEditors...)
+// GetTimeReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimeReportParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
 	}
-	return ParseUpdateTodoResponse(rsp)
-}
 
-// ParseGetBoardSummaryResponse parses an HTTP response from a GetBoardSummaryWithResponse call
-func ParseGetBoardSummaryResponse(rsp *http.Response) (*GetBoardSummaryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
 	}
 
-	response := &GetBoardSummaryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimeReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BoardSummary
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// PullSync operation middleware
+func (siw *ServerInterfaceWrapper) PullSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PullSyncParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// ParseStreamChatResponse parses an HTTP response from a StreamChatWithResponse call
-func ParseStreamChatResponse(rsp *http.Response) (*StreamChatResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
 
-	response := &StreamChatResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PullSync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PushSync operation middleware
+func (siw *ServerInterfaceWrapper) PushSync(w http.ResponseWriter, r *http.Request) {
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PushSync(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseSubmitActionApprovalResponse parses an HTTP response from a SubmitActionApprovalWithResponse call
-func ParseSubmitActionApprovalResponse(rsp *http.Response) (*SubmitActionApprovalResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ListTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListTemplates(w http.ResponseWriter, r *http.Request) {
 
-	response := &SubmitActionApprovalResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// SaveTemplate operation middleware
+func (siw *ServerInterfaceWrapper) SaveTemplate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SaveTemplate(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseListChatMessagesResponse parses an HTTP response from a ListChatMessagesWithResponse call
-func ParseListChatMessagesResponse(rsp *http.Response) (*ListChatMessagesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", r.PathValue("template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
 	}
 
-	response := &ListChatMessagesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTemplate(w, r, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ChatHistoryResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// UpdateTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
 
-// ParseListAvailableSkillsResponse parses an HTTP response from a ListAvailableSkillsWithResponse call
-func ParseListAvailableSkillsResponse(rsp *http.Response) (*ListAvailableSkillsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", r.PathValue("template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
 	}
 
-	response := &ListAvailableSkillsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTemplate(w, r, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SkillListResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// ApplyTemplate operation middleware
+func (siw *ServerInterfaceWrapper) ApplyTemplate(w http.ResponseWriter, r *http.Request) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "template_id" -------------
+	var templateId openapi_types.UUID
 
-// ParseListConversationsResponse parses an HTTP response from a ListConversationsWithResponse call
-func ParseListConversationsResponse(rsp *http.Response) (*ListConversationsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "template_id", r.PathValue("template_id"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
-	}
-
-	response := &ListConversationsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ConversationListResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyTemplate(w, r, templateId)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseDeleteConversationResponse parses an HTTP response from a DeleteConversationWithResponse call
-func ParseDeleteConversationResponse(rsp *http.Response) (*DeleteConversationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// ListTodos operation middleware
+func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodosParams
+
+	// ------------- Required query parameter "pageSize" -------------
+
+	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+		return
 	}
 
-	response := &DeleteConversationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, true, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Required query parameter "page" -------------
+
+	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "status" -------------
 
-// ParseUpdateConversationResponse parses an HTTP response from a UpdateConversationWithResponse call
-func ParseUpdateConversationResponse(rsp *http.Response) (*UpdateConversationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
 	}
 
-	response := &UpdateConversationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Conversation
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "searchType" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "searchType", r.URL.Query(), &params.SearchType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchType", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Optional query parameter "dateRange" -------------
 
+	err = runtime.BindQueryParameter("deepObject", true, false, "dateRange", r.URL.Query(), &params.DateRange)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dateRange", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "sort" -------------
 
-// ParseListAvailableModelsResponse parses an HTTP response from a ListAvailableModelsWithResponse call
-func ParseListAvailableModelsResponse(rsp *http.Response) (*ListAvailableModelsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
 	}
 
-	response := &ListAvailableModelsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "includeArchived" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ModelListResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeArchived", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Optional query parameter "customField" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "customField", r.URL.Query(), &params.CustomField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "customField", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "nearLat" -------------
 
-// ParseListTodosResponse parses an HTTP response from a ListTodosWithResponse call
-func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "nearLat", r.URL.Query(), &params.NearLat)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nearLat", Err: err})
+		return
 	}
 
-	response := &ListTodosResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "nearLon" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nearLon", r.URL.Query(), &params.NearLon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nearLon", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListTodosResp
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "nearRadiusKm" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "nearRadiusKm", r.URL.Query(), &params.NearRadiusKm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nearRadiusKm", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "q" -------------
 
-// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
-func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
 	}
 
-	response := &CreateTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTodos(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Todo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	handler.ServeHTTP(w, r)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// CreateTodo operation middleware
+func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTodo(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
-func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// ListTodoChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListTodoChanges(w http.ResponseWriter, r *http.Request) {
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var err error
 
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodoChangesParams
 
-	return response, nil
-}
+	// ------------- Optional query parameter "since" -------------
 
-// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
-func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
 	if err != nil {
-		return nil, err
-	}
-
-	response := &UpdateTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Todo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "conversation_id" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "conversation_id", r.URL.Query(), &params.ConversationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTodoChanges(w, r, params)
+	}))
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get AI-generated board summary
-	// (GET /api/v1/board/summary)
-	GetBoardSummary(w http.ResponseWriter, r *http.Request)
-	// Stream assistant response for a user message (single global chat)
-	// (POST /api/v1/chat)
-	StreamChat(w http.ResponseWriter, r *http.Request)
-	// Submit action approval decision
-	// (POST /api/v1/chat/approvals)
-	SubmitActionApproval(w http.ResponseWriter, r *http.Request)
-	// Fetch chat history (single global chat)
-	// (GET /api/v1/chat/messages)
-	ListChatMessages(w http.ResponseWriter, r *http.Request, params ListChatMessagesParams)
-	// List available skills
-	// (GET /api/v1/chat/skills)
-	ListAvailableSkills(w http.ResponseWriter, r *http.Request)
-	// List conversations
-	// (GET /api/v1/conversations)
-	ListConversations(w http.ResponseWriter, r *http.Request, params ListConversationsParams)
-	// Delete a conversation
-	// (DELETE /api/v1/conversations/{conversation_id})
-	DeleteConversation(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
-	// Update conversation
-	// (PATCH /api/v1/conversations/{conversation_id})
-	UpdateConversation(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID)
-	// List available AI models
-	// (GET /api/v1/models)
-	ListAvailableModels(w http.ResponseWriter, r *http.Request)
-	// List todos
-	// (GET /api/v1/todos)
-	ListTodos(w http.ResponseWriter, r *http.Request, params ListTodosParams)
-	// Create a todo
-	// (POST /api/v1/todos)
-	CreateTodo(w http.ResponseWriter, r *http.Request)
-	// Delete a todo
-	// (DELETE /api/v1/todos/{todo_id})
-	DeleteTodo(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
-	// Update a todo
-	// (PATCH /api/v1/todos/{todo_id})
-	UpdateTodo(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// GetBoardSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetBoardSummary(w http.ResponseWriter, r *http.Request) {
+// StreamTodoEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamTodoEvents(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBoardSummary(w, r)
+		siw.Handler.StreamTodoEvents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2724,11 +14107,11 @@ func (siw *ServerInterfaceWrapper) GetBoardSummary(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// StreamChat operation middleware
-func (siw *ServerInterfaceWrapper) StreamChat(w http.ResponseWriter, r *http.Request) {
+// QuickAddTodo operation middleware
+func (siw *ServerInterfaceWrapper) QuickAddTodo(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StreamChat(w, r)
+		siw.Handler.QuickAddTodo(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2738,11 +14121,22 @@ func (siw *ServerInterfaceWrapper) StreamChat(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// SubmitActionApproval operation middleware
-func (siw *ServerInterfaceWrapper) SubmitActionApproval(w http.ResponseWriter, r *http.Request) {
+// DeleteTodo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SubmitActionApproval(w, r)
+		siw.Handler.DeleteTodo(w, r, todoId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2752,29 +14146,48 @@ func (siw *ServerInterfaceWrapper) SubmitActionApproval(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListChatMessages operation middleware
-func (siw *ServerInterfaceWrapper) ListChatMessages(w http.ResponseWriter, r *http.Request) {
+// UpdateTodo operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListChatMessagesParams
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
 
-	// ------------- Required query parameter "conversation_id" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		return
+	}
 
-	if paramValue := r.URL.Query().Get("conversation_id"); paramValue != "" {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTodo(w, r, todoId)
+	}))
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "conversation_id"})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "conversation_id", r.URL.Query(), &params.ConversationId)
+	handler.ServeHTTP(w, r)
+}
+
+// ListTodoComments operation middleware
+func (siw *ServerInterfaceWrapper) ListTodoComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodoCommentsParams
+
 	// ------------- Required query parameter "pageSize" -------------
 
 	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
@@ -2806,7 +14219,7 @@ func (siw *ServerInterfaceWrapper) ListChatMessages(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListChatMessages(w, r, params)
+		siw.Handler.ListTodoComments(w, r, todoId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2816,11 +14229,22 @@ func (siw *ServerInterfaceWrapper) ListChatMessages(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListAvailableSkills operation middleware
-func (siw *ServerInterfaceWrapper) ListAvailableSkills(w http.ResponseWriter, r *http.Request) {
+// CreateTodoComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateTodoComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAvailableSkills(w, r)
+		siw.Handler.CreateTodoComment(w, r, todoId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2830,46 +14254,31 @@ func (siw *ServerInterfaceWrapper) ListAvailableSkills(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListConversations operation middleware
-func (siw *ServerInterfaceWrapper) ListConversations(w http.ResponseWriter, r *http.Request) {
+// DeleteTodoComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTodoComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListConversationsParams
-
-	// ------------- Required query parameter "pageSize" -------------
-
-	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
-		return
-	}
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, true, "pageSize", r.URL.Query(), &params.PageSize)
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "page" -------------
-
-	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
-		return
-	}
+	// ------------- Path parameter "comment_id" -------------
+	var commentId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", r.PathValue("comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "comment_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListConversations(w, r, params)
+		siw.Handler.DeleteTodoComment(w, r, todoId, commentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2879,22 +14288,31 @@ func (siw *ServerInterfaceWrapper) ListConversations(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteConversation operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *http.Request) {
+// UpdateTodoComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTodoComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "conversation_id" -------------
-	var conversationId openapi_types.UUID
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", r.PathValue("comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "comment_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteConversation(w, r, conversationId)
+		siw.Handler.UpdateTodoComment(w, r, todoId, commentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2904,22 +14322,22 @@ func (siw *ServerInterfaceWrapper) DeleteConversation(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateConversation operation middleware
-func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *http.Request) {
+// RestoreTodo operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTodo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "conversation_id" -------------
-	var conversationId openapi_types.UUID
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "conversation_id", r.PathValue("conversation_id"), &conversationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "conversation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateConversation(w, r, conversationId)
+		siw.Handler.RestoreTodo(w, r, todoId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2929,11 +14347,22 @@ func (siw *ServerInterfaceWrapper) UpdateConversation(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListAvailableModels operation middleware
-func (siw *ServerInterfaceWrapper) ListAvailableModels(w http.ResponseWriter, r *http.Request) {
+// StartTodoTimer operation middleware
+func (siw *ServerInterfaceWrapper) StartTodoTimer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAvailableModels(w, r)
+		siw.Handler.StartTodoTimer(w, r, todoId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2943,86 +14372,89 @@ func (siw *ServerInterfaceWrapper) ListAvailableModels(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListTodos operation middleware
-func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Request) {
+// StopTodoTimer operation middleware
+func (siw *ServerInterfaceWrapper) StopTodoTimer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTodosParams
-
-	// ------------- Required query parameter "pageSize" -------------
-
-	if paramValue := r.URL.Query().Get("pageSize"); paramValue != "" {
+	// ------------- Path parameter "todo_id" -------------
+	var todoId openapi_types.UUID
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pageSize"})
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "pageSize", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopTodoTimer(w, r, todoId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Required query parameter "page" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	if paramValue := r.URL.Query().Get("page"); paramValue != "" {
+// ListTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListTokens(w http.ResponseWriter, r *http.Request) {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
-		return
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTokens(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
+// CreateToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateToken(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "search" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateToken(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "searchType" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "searchType", r.URL.Query(), &params.SearchType)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "searchType", Err: err})
-		return
-	}
+// RevokeToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeToken(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "dateRange" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "dateRange", r.URL.Query(), &params.DateRange)
+	// ------------- Path parameter "token_id" -------------
+	var tokenId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token_id", r.PathValue("token_id"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dateRange", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeToken(w, r, tokenId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// ListViews operation middleware
+func (siw *ServerInterfaceWrapper) ListViews(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTodos(w, r, params)
+		siw.Handler.ListViews(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3032,11 +14464,11 @@ func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTodo operation middleware
-func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Request) {
+// SaveView operation middleware
+func (siw *ServerInterfaceWrapper) SaveView(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTodo(w, r)
+		siw.Handler.SaveView(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3046,22 +14478,22 @@ func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTodo operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+// DeleteView operation middleware
+func (siw *ServerInterfaceWrapper) DeleteView(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "todo_id" -------------
-	var todoId openapi_types.UUID
+	// ------------- Path parameter "view_id" -------------
+	var viewId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", r.PathValue("view_id"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTodo(w, r, todoId)
+		siw.Handler.DeleteView(w, r, viewId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3071,22 +14503,22 @@ func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTodo operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTodo(w http.ResponseWriter, r *http.Request) {
+// UpdateView operation middleware
+func (siw *ServerInterfaceWrapper) UpdateView(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "todo_id" -------------
-	var todoId openapi_types.UUID
+	// ------------- Path parameter "view_id" -------------
+	var viewId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", r.PathValue("todo_id"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", r.PathValue("view_id"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "todo_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTodo(w, r, todoId)
+		siw.Handler.UpdateView(w, r, viewId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3216,19 +14648,78 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/auth/oidc/callback", wrapper.CompleteOIDCLogin)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/auth/oidc/login", wrapper.BeginOIDCLogin)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/board/reviews", wrapper.ListWeeklyReviews)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/board/snapshots", wrapper.CreateBoardSnapshot)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/board/snapshots/{token}", wrapper.GetBoardSnapshot)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/board/summary", wrapper.GetBoardSummary)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/chat", wrapper.StreamChat)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/chat/approvals", wrapper.SubmitActionApproval)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/chat/blobs/{hash}", wrapper.GetContentBlob)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/chat/instructions", wrapper.ListInstructions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/chat/instructions", wrapper.RememberInstruction)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/chat/instructions/{instruction_id}", wrapper.DeleteInstruction)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/chat/memories", wrapper.ListMemories)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/chat/memories/{memory_id}", wrapper.DeleteMemory)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/chat/messages", wrapper.ListChatMessages)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/chat/messages/{message_id}/feedback", wrapper.SubmitMessageFeedback)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/chat/preview", wrapper.PreviewChat)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/chat/skills", wrapper.ListAvailableSkills)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/conversations", wrapper.ListConversations)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/conversations/events", wrapper.StreamConversationEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/conversations/related/{conversation_id}", wrapper.ListRelatedConversations)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/conversations/{conversation_id}", wrapper.DeleteConversation)
 	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/conversations/{conversation_id}", wrapper.UpdateConversation)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/conversations/{conversation_id}/messages/{message_id}/edit", wrapper.EditMessage)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/conversations/{conversation_id}/messages/{message_id}/regenerate", wrapper.RegenerateMessage)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/conversations/{conversation_id}/read", wrapper.MarkConversationRead)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/conversations/{conversation_id}/system-messages", wrapper.PostSystemMessage)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/conversations/{conversation_id}/turns/{turn_id}", wrapper.GetConversationTurn)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/conversations/{conversation_id}/turns/{turn_id}/replay", wrapper.ReplayConversationTurn)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/custom-fields", wrapper.ListCustomFields)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/custom-fields/{name}", wrapper.DeleteCustomField)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/custom-fields/{name}", wrapper.DefineCustomField)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/graphql/schema", wrapper.GetGraphQLSchema)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/inbound/webhooks/{source}", wrapper.ReceiveInboundWebhook)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/models", wrapper.ListAvailableModels)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/openapi.json", wrapper.GetOpenAPISpec)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/projects", wrapper.ListProjects)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/projects/suggestions", wrapper.ListProjectSuggestions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/projects/suggestions/{suggestion_id}/accept", wrapper.AcceptProjectSuggestion)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/sessions", wrapper.ListSessions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/sessions", wrapper.StartSession)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/sessions/refresh", wrapper.RefreshSession)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/sessions/{session_id}", wrapper.RevokeSession)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/stats/time", wrapper.GetTimeReport)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/sync", wrapper.PullSync)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/sync", wrapper.PushSync)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/templates", wrapper.ListTemplates)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/templates", wrapper.SaveTemplate)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/templates/{template_id}", wrapper.DeleteTemplate)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/templates/{template_id}", wrapper.UpdateTemplate)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/templates/{template_id}/apply", wrapper.ApplyTemplate)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/todos", wrapper.ListTodos)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/todos", wrapper.CreateTodo)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/todos/changes", wrapper.ListTodoChanges)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/todos/events", wrapper.StreamTodoEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/todos/quick-add", wrapper.QuickAddTodo)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/todos/{todo_id}", wrapper.DeleteTodo)
 	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/todos/{todo_id}", wrapper.UpdateTodo)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/todos/{todo_id}/comments", wrapper.ListTodoComments)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/todos/{todo_id}/comments", wrapper.CreateTodoComment)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/todos/{todo_id}/comments/{comment_id}", wrapper.DeleteTodoComment)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/todos/{todo_id}/comments/{comment_id}", wrapper.UpdateTodoComment)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/todos/{todo_id}/restore", wrapper.RestoreTodo)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/todos/{todo_id}/timer/start", wrapper.StartTodoTimer)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/todos/{todo_id}/timer/stop", wrapper.StopTodoTimer)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/tokens", wrapper.ListTokens)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/tokens", wrapper.CreateToken)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/tokens/{token_id}", wrapper.RevokeToken)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/views", wrapper.ListViews)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/views", wrapper.SaveView)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/views/{view_id}", wrapper.DeleteView)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/v1/views/{view_id}", wrapper.UpdateView)
 
 	return m
 }
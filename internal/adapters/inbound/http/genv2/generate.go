@@ -0,0 +1,3 @@
+package genv2
+
+//go:generate go tool oapi-codegen -config=gencfg.yml ./../../../../../api/openapi/openapi.v2.yml
@@ -1,10 +1,19 @@
 package http
 
 import (
+	"strings"
+	"time"
+
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -18,6 +27,15 @@ func toError(err error) gen.ErrorResp {
 	case *core.NotFoundErr:
 		errResp.Error.Code = gen.NOTFOUND
 		errResp.Error.Message = e.Error()
+	case *core.UnauthorizedErr:
+		errResp.Error.Code = gen.UNAUTHORIZED
+		errResp.Error.Message = e.Error()
+	case *core.ForbiddenErr:
+		errResp.Error.Code = gen.FORBIDDEN
+		errResp.Error.Message = e.Error()
+	case *core.UnavailableErr:
+		errResp.Error.Code = gen.SERVICEUNAVAILABLE
+		errResp.Error.Message = e.Error()
 	default:
 		errResp.Error.Code = gen.INTERNALERROR
 		errResp.Error.Message = "internal server error"
@@ -25,19 +43,79 @@ func toError(err error) gen.ErrorResp {
 	return errResp
 }
 
+// toQuickAddResp maps a quick add preview, and the todo created from it when committed.
+func toQuickAddResp(p todouc.QuickAddPreview, created *todo.Todo) gen.QuickAddResp {
+	resp := gen.QuickAddResp{
+		Title:        p.Title,
+		DueDate:      openapi_types.Date{Time: p.DueDate},
+		Tags:         p.Tags,
+		CustomFields: gen.CustomFieldValues(p.CustomFields),
+		Unresolved:   p.Unresolved,
+		Source:       gen.QuickAddRespSource(p.Source),
+	}
+	if resp.Tags == nil {
+		resp.Tags = []string{}
+	}
+	if p.Priority != "" {
+		resp.Priority = common.Ptr(p.Priority)
+	}
+	if created != nil {
+		resp.Todo = common.Ptr(toTodo(*created))
+	}
+	return resp
+}
+
 func toTodo(t todo.Todo) gen.Todo {
-	return gen.Todo{
-		Id:        openapi_types.UUID(t.ID),
-		Title:     t.Title,
-		CreatedAt: t.CreatedAt,
-		Status:    gen.TodoStatus(t.Status),
-		DueDate:   openapi_types.Date{Time: t.DueDate},
-		UpdatedAt: t.UpdatedAt,
+	resp := gen.Todo{
+		Id:               openapi_types.UUID(t.ID),
+		Title:            t.Title,
+		CreatedAt:        t.CreatedAt,
+		Status:           gen.TodoStatus(t.Status),
+		DueDate:          openapi_types.Date{Time: t.DueDate},
+		EstimatedMinutes: t.EstimatedMinutes,
+		UpdatedAt:        t.UpdatedAt,
+		ArchivedAt:       t.ArchivedAt,
+	}
+	if len(t.CustomFields) > 0 {
+		resp.CustomFields = (*gen.CustomFieldValues)(&t.CustomFields)
+	}
+	if t.Location != nil {
+		location := toTodoLocation(*t.Location)
+		resp.Location = &location
+	}
+	return resp
+}
+
+func toTodoLocation(l todo.Location) gen.TodoLocation {
+	location := gen.TodoLocation{}
+	if l.Name != "" {
+		location.Name = common.Ptr(l.Name)
+	}
+	if l.Point != nil {
+		location.Latitude = common.Ptr(l.Point.Latitude)
+		location.Longitude = common.Ptr(l.Point.Longitude)
 	}
+	return location
+}
+
+// fromTodoLocation converts a request location, rejecting a latitude without longitude and vice versa.
+// An empty location converts to the zero location.
+func fromTodoLocation(l gen.TodoLocation) (todo.Location, error) {
+	if (l.Latitude == nil) != (l.Longitude == nil) {
+		return todo.Location{}, core.NewValidationErr("location latitude and longitude must be provided together")
+	}
+	location := todo.Location{}
+	if l.Name != nil {
+		location.Name = strings.TrimSpace(*l.Name)
+	}
+	if l.Latitude != nil {
+		location.Point = &todo.GeoPoint{Latitude: *l.Latitude, Longitude: *l.Longitude}
+	}
+	return location, nil
 }
 
 func toConversationProjection(c assistant.Conversation, totalTokensUsed int64, contextCompactionTriggerTokens int) gen.Conversation {
-	return gen.Conversation{
+	resp := gen.Conversation{
 		Id:                             c.ID,
 		Title:                          c.Title,
 		TitleSource:                    gen.ConversationTitleSource(c.TitleSource),
@@ -46,6 +124,112 @@ func toConversationProjection(c assistant.Conversation, totalTokensUsed int64, c
 		UpdatedAt:                      c.UpdatedAt,
 		CreatedAt:                      c.CreatedAt,
 	}
+	if !c.Settings.IsZero() {
+		settings := toConversationSettings(c.Settings)
+		resp.Settings = &settings
+	}
+	return resp
+}
+
+func toConversationSettings(s assistant.ConversationSettings) gen.ConversationSettings {
+	resp := gen.ConversationSettings{
+		Temperature: s.Temperature,
+		TopP:        s.TopP,
+	}
+	if s.Model != "" {
+		resp.Model = common.Ptr(s.Model)
+	}
+	return resp
+}
+
+func fromConversationSettings(s gen.ConversationSettings) assistant.ConversationSettings {
+	settings := assistant.ConversationSettings{
+		Temperature: s.Temperature,
+		TopP:        s.TopP,
+	}
+	if s.Model != nil {
+		settings.Model = *s.Model
+	}
+	return settings
+}
+
+func toTurnReplay(replay chat.TurnReplay) gen.TurnReplay {
+	return gen.TurnReplay{
+		TurnId:              replay.TurnID,
+		Model:               replay.Model,
+		Seed:                replay.Seed,
+		OriginalContent:     replay.OriginalContent,
+		OriginalActionCalls: toTurnReplayActionCalls(replay.OriginalActionCalls),
+		ReplayedContent:     replay.ReplayedContent,
+		ReplayedActionCalls: toTurnReplayActionCalls(replay.ReplayedActionCalls),
+		TotalTokens:         replay.Usage.TotalTokens,
+		Matches:             replay.Matches,
+	}
+}
+
+func toTurnReplayActionCalls(calls []assistant.ActionCall) []gen.TurnReplayActionCall {
+	resp := make([]gen.TurnReplayActionCall, 0, len(calls))
+	for _, call := range calls {
+		resp = append(resp, gen.TurnReplayActionCall{Name: call.Name, Input: call.Input})
+	}
+	return resp
+}
+
+func toTurnTranscript(transcript chat.TurnTranscript) gen.TurnTranscript {
+	steps := make([]gen.TurnTranscriptStep, 0, len(transcript.Steps))
+	for _, step := range transcript.Steps {
+		steps = append(steps, toTurnTranscriptStep(step))
+	}
+	return gen.TurnTranscript{
+		ConversationId:   transcript.ConversationID,
+		TurnId:           transcript.TurnID,
+		Steps:            steps,
+		PromptTokens:     transcript.Usage.PromptTokens,
+		CompletionTokens: transcript.Usage.CompletionTokens,
+		TotalTokens:      transcript.Usage.TotalTokens,
+		StartedAt:        transcript.StartedAt,
+		CompletedAt:      transcript.CompletedAt,
+		DurationMs:       transcript.Duration().Milliseconds(),
+	}
+}
+
+func toTurnTranscriptStep(step chat.TurnTranscriptStep) gen.TurnTranscriptStep {
+	msg := step.Message
+	resp := gen.TurnTranscriptStep{
+		Id:               msg.ID,
+		TurnSequence:     msg.TurnSequence,
+		Role:             gen.TurnTranscriptStepRole(msg.ChatRole),
+		Content:          msg.Content,
+		ContentRef:       msg.ContentRef,
+		MessageState:     gen.TurnTranscriptStepMessageState(msg.MessageState),
+		ErrorMessage:     msg.ErrorMessage,
+		ActionCallId:     msg.ActionCallID,
+		ActionExecuted:   msg.ActionExecuted,
+		Seed:             msg.Seed,
+		SupersededAt:     msg.SupersededAt,
+		PromptTokens:     msg.PromptTokens,
+		CompletionTokens: msg.CompletionTokens,
+		TotalTokens:      msg.TotalTokens,
+		OffsetMs:         step.Offset.Milliseconds(),
+		DurationMs:       step.Duration.Milliseconds(),
+		CreatedAt:        msg.CreatedAt,
+		UpdatedAt:        msg.UpdatedAt,
+	}
+	if msg.Model != "" {
+		resp.Model = &msg.Model
+	}
+	if msg.ApprovalStatus != nil {
+		status := gen.TurnTranscriptStepApprovalStatus(*msg.ApprovalStatus)
+		resp.ApprovalStatus = &status
+	}
+	if len(msg.ActionCalls) > 0 {
+		calls := make([]gen.TurnTranscriptActionCall, 0, len(msg.ActionCalls))
+		for _, call := range msg.ActionCalls {
+			calls = append(calls, gen.TurnTranscriptActionCall{Id: call.ID, Name: call.Name, Input: call.Input})
+		}
+		resp.ActionCalls = &calls
+	}
+	return resp
 }
 
 func toChatMessage(msg assistant.ChatMessage) gen.ChatMessage {
@@ -62,6 +246,18 @@ func toChatMessage(msg assistant.ChatMessage) gen.ChatMessage {
 	if msg.ActionExecuted != nil {
 		resp.ActionExecuted = msg.ActionExecuted
 	}
+	if msg.FeedbackScore != nil {
+		resp.FeedbackScore = msg.FeedbackScore
+	}
+	if msg.Seed != nil {
+		resp.Seed = msg.Seed
+	}
+	if msg.SupersededAt != nil {
+		resp.SupersededAt = msg.SupersededAt
+	}
+	if msg.IsModerated() {
+		resp.Moderated = common.Ptr(true)
+	}
 	if len(msg.SelectedSkills) > 0 {
 		selectedSkills := make([]gen.SelectedSkill, 0, len(msg.SelectedSkills))
 		for _, skill := range msg.SelectedSkills {
@@ -84,6 +280,7 @@ func toChatMessage(msg assistant.ChatMessage) gen.ChatMessage {
 				MessageState: gen.ChatMessageActionDetailMessageState(detail.MessageState),
 				Name:         detail.Name,
 				Output:       detail.Output,
+				OutputRef:    detail.OutputRef,
 				Text:         detail.Text,
 			}
 			if detail.ErrorMessage != nil {
@@ -109,11 +306,57 @@ func toChatMessage(msg assistant.ChatMessage) gen.ChatMessage {
 	return resp
 }
 
+func toGenerationOptions(req gen.ChatStreamRequest) assistant.GenerationOptions {
+	generation := assistant.GenerationOptions{
+		MaxTokens:        req.MaxTokens,
+		PresencePenalty:  req.PresencePenalty,
+		FrequencyPenalty: req.FrequencyPenalty,
+		Temperature:      req.Temperature,
+		TopP:             req.TopP,
+		Seed:             req.Seed,
+	}
+	if req.Stop != nil {
+		generation.Stop = *req.Stop
+	}
+	return generation
+}
+
+func toRegenerateGenerationOptions(req gen.RegenerateMessageRequest) assistant.GenerationOptions {
+	generation := assistant.GenerationOptions{
+		MaxTokens:        req.MaxTokens,
+		PresencePenalty:  req.PresencePenalty,
+		FrequencyPenalty: req.FrequencyPenalty,
+		Temperature:      req.Temperature,
+		TopP:             req.TopP,
+		Seed:             req.Seed,
+	}
+	if req.Stop != nil {
+		generation.Stop = *req.Stop
+	}
+	return generation
+}
+
+func toEditGenerationOptions(req gen.EditMessageRequest) assistant.GenerationOptions {
+	generation := assistant.GenerationOptions{
+		MaxTokens:        req.MaxTokens,
+		PresencePenalty:  req.PresencePenalty,
+		FrequencyPenalty: req.FrequencyPenalty,
+		Temperature:      req.Temperature,
+		TopP:             req.TopP,
+		Seed:             req.Seed,
+	}
+	if req.Stop != nil {
+		generation.Stop = *req.Stop
+	}
+	return generation
+}
+
 func toBoardSummary(summary todo.BoardSummary) gen.BoardSummary {
 	resp := gen.BoardSummary{
 		Counts: gen.TodoStatusCounts{
-			DONE: summary.Content.Counts.Done,
-			OPEN: summary.Content.Counts.Open,
+			ARCHIVED: summary.Content.Counts.Archived,
+			DONE:     summary.Content.Counts.Done,
+			OPEN:     summary.Content.Counts.Open,
 		},
 		GeneratedAt:  summary.GeneratedAt,
 		NearDeadline: summary.Content.NearDeadline,
@@ -129,3 +372,387 @@ func toBoardSummary(summary todo.BoardSummary) gen.BoardSummary {
 	}
 	return resp
 }
+
+func toBoardSnapshot(s todo.BoardSnapshot) gen.BoardSnapshot {
+	resp := gen.BoardSnapshot{
+		Token:     s.Token,
+		Title:     s.Title,
+		Filter:    toViewFilterResp(s.Filter),
+		Todos:     make([]gen.Todo, len(s.Todos)),
+		Markdown:  s.Markdown,
+		CreatedAt: s.CreatedAt,
+	}
+	for i, t := range s.Todos {
+		resp.Todos[i] = toTodo(t)
+	}
+	if s.Summary != nil {
+		summary := toBoardSummary(*s.Summary)
+		resp.Summary = &summary
+	}
+	return resp
+}
+
+func toWeeklyReview(r todo.WeeklyReview) gen.WeeklyReview {
+	return gen.WeeklyReview{
+		Id:             r.ID,
+		ConversationId: r.ConversationID,
+		PeriodStart:    openapi_types.Date{Time: r.PeriodStart},
+		PeriodEnd:      openapi_types.Date{Time: r.PeriodEnd},
+		Planned:        r.Planned,
+		Completed:      r.Completed,
+		Slipped:        r.Slipped,
+		Report:         r.Report,
+		Model:          r.Model,
+		GeneratedAt:    r.GeneratedAt,
+	}
+}
+
+func toTimeEntry(e todo.TimeEntry, now time.Time) gen.TimeEntry {
+	return gen.TimeEntry{
+		Id:              e.ID,
+		TodoId:          e.TodoID,
+		StartedAt:       e.StartedAt,
+		EndedAt:         e.EndedAt,
+		DurationSeconds: int(e.Duration(now).Seconds()),
+	}
+}
+
+func toTimeReport(from, to time.Time, items []todo.TimeReportItem) gen.TimeReport {
+	resp := gen.TimeReport{
+		From:  openapi_types.Date{Time: from},
+		To:    openapi_types.Date{Time: to},
+		Items: make([]gen.TimeReportItem, 0, len(items)),
+	}
+	for _, item := range items {
+		seconds := int(item.Total.Seconds())
+		resp.TotalSeconds += seconds
+		resp.Items = append(resp.Items, gen.TimeReportItem{
+			TodoId:       item.TodoID,
+			Title:        item.Title,
+			TotalSeconds: seconds,
+			Entries:      item.Entries,
+		})
+	}
+	return resp
+}
+
+func toComment(c todo.Comment) gen.Comment {
+	return gen.Comment{
+		Id:        c.ID,
+		TodoId:    c.TodoID,
+		Author:    gen.CommentAuthor(strings.ToUpper(string(c.Author))),
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toInstruction(i assistant.Instruction) gen.Instruction {
+	return gen.Instruction{
+		Id:             i.ID,
+		ConversationId: i.ConversationID,
+		Text:           i.Text,
+		CreatedAt:      i.CreatedAt,
+	}
+}
+
+func toRelatedConversation(c assistant.RelatedConversation) gen.RelatedConversation {
+	return gen.RelatedConversation{
+		ConversationId: c.ConversationID,
+		Title:          c.Title,
+		Similarity:     c.Similarity,
+		LastActiveAt:   c.LastActiveAt,
+	}
+}
+
+func toMemory(m assistant.Memory) gen.Memory {
+	return gen.Memory{
+		Id:                   m.ID,
+		Kind:                 gen.MemoryKind(m.Kind),
+		Text:                 m.Text,
+		SourceConversationId: m.SourceConversationID,
+		CreatedAt:            m.CreatedAt,
+	}
+}
+
+func toProjectTodos(todos []todo.ProjectTodo) []gen.ProjectTodo {
+	resp := make([]gen.ProjectTodo, len(todos))
+	for i, t := range todos {
+		resp[i] = gen.ProjectTodo{Id: t.ID, Title: t.Title}
+	}
+	return resp
+}
+
+func toProject(p todo.Project) gen.Project {
+	return gen.Project{
+		Id:        p.ID,
+		Name:      p.Name,
+		Todos:     toProjectTodos(p.Todos),
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+func toCustomField(f todo.CustomField) gen.CustomField {
+	options := f.Options
+	if options == nil {
+		options = []string{}
+	}
+	return gen.CustomField{
+		Name:      f.Name,
+		Type:      gen.CustomFieldType(f.Type),
+		Options:   options,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+func toProjectSuggestion(s todo.ProjectSuggestion) gen.ProjectSuggestion {
+	return gen.ProjectSuggestion{
+		Id:        s.ID,
+		Name:      s.Name,
+		Todos:     toProjectTodos(s.Todos),
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+func toView(v todo.View) gen.View {
+	view := gen.View{
+		Id:      v.ID,
+		Name:    v.Name,
+		BuiltIn: v.BuiltIn,
+		Filter:  toViewFilterResp(v.Filter),
+	}
+	if !v.BuiltIn {
+		view.CreatedAt = &v.CreatedAt
+		view.UpdatedAt = &v.UpdatedAt
+	}
+	return view
+}
+
+func toViewFilterResp(f todo.ViewFilter) gen.ViewFilter {
+	filter := gen.ViewFilter{
+		Status:             (*gen.TodoStatus)(f.Status),
+		SearchBySimilarity: f.SearchBySimilarity,
+		SearchByTitle:      f.SearchByTitle,
+		SortBy:             (*gen.ViewFilterSortBy)(f.SortBy),
+		DueFromDays:        f.DueFromDays,
+		DueToDays:          f.DueToDays,
+		Query:              f.Query,
+	}
+	if f.DueAfter != nil && f.DueBefore != nil {
+		filter.DueAfter = &openapi_types.Date{Time: *f.DueAfter}
+		filter.DueBefore = &openapi_types.Date{Time: *f.DueBefore}
+	}
+	return filter
+}
+
+func toTemplate(t todo.Template) gen.Template {
+	template := gen.Template{
+		Id:        t.ID,
+		Name:      t.Name,
+		Items:     make([]gen.TemplateItem, len(t.Items)),
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+	for i, item := range t.Items {
+		template.Items[i] = gen.TemplateItem{
+			Title:         item.Title,
+			DueOffsetDays: item.DueOffsetDays,
+		}
+		if item.EstimatedMinutes > 0 {
+			template.Items[i].EstimatedMinutes = common.Ptr(item.EstimatedMinutes)
+		}
+	}
+	return template
+}
+
+func toTemplateItems(items []gen.TemplateItem) []todo.TemplateItem {
+	templateItems := make([]todo.TemplateItem, len(items))
+	for i, item := range items {
+		templateItems[i] = todo.TemplateItem{
+			Title:         item.Title,
+			DueOffsetDays: item.DueOffsetDays,
+		}
+		if item.EstimatedMinutes != nil {
+			templateItems[i].EstimatedMinutes = *item.EstimatedMinutes
+		}
+	}
+	return templateItems
+}
+
+func toSession(s access.Session, current uuid.UUID) gen.Session {
+	return gen.Session{
+		Id:         s.ID,
+		Device:     s.Device,
+		Current:    s.ID == current,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+		ExpiresAt:  s.ExpiresAt,
+	}
+}
+
+func toSessionTokens(issued session.Issued) gen.SessionTokens {
+	return gen.SessionTokens{
+		Session:         toSession(issued.Session, issued.Session.ID),
+		AccessToken:     issued.AccessToken,
+		AccessExpiresAt: issued.Session.AccessExpiresAt,
+		RefreshToken:    issued.RefreshToken,
+	}
+}
+
+func toPersonalAccessToken(t access.PersonalAccessToken) gen.PersonalAccessToken {
+	scopes := make([]gen.TokenScope, len(t.Scopes))
+	for i, scope := range t.Scopes {
+		scopes[i] = gen.TokenScope(scope)
+	}
+	return gen.PersonalAccessToken{
+		Id:         t.ID,
+		Name:       t.Name,
+		Scopes:     scopes,
+		CreatedAt:  t.CreatedAt,
+		LastUsedAt: t.LastUsedAt,
+	}
+}
+
+func fromTokenScopes(scopes []gen.TokenScope) []access.Scope {
+	result := make([]access.Scope, len(scopes))
+	for i, scope := range scopes {
+		result[i] = access.Scope(scope)
+	}
+	return result
+}
+
+func toViewFilter(f gen.ViewFilter) todo.ViewFilter {
+	filter := todo.ViewFilter{
+		Status:             (*todo.Status)(f.Status),
+		SearchBySimilarity: f.SearchBySimilarity,
+		SearchByTitle:      f.SearchByTitle,
+		SortBy:             (*string)(f.SortBy),
+		DueFromDays:        f.DueFromDays,
+		DueToDays:          f.DueToDays,
+		Query:              f.Query,
+	}
+	if f.DueAfter != nil {
+		filter.DueAfter = &f.DueAfter.Time
+	}
+	if f.DueBefore != nil {
+		filter.DueBefore = &f.DueBefore.Time
+	}
+	return filter
+}
+
+func toTodoChangeEvent(event outbox.TodoEvent) (gen.TodoChangeEvent, bool) {
+	var eventType gen.TodoChangeEventType
+	switch event.Type {
+	case outbox.EventType_TODO_CREATED:
+		eventType = gen.TODOCREATED
+	case outbox.EventType_TODO_UPDATED:
+		eventType = gen.TODOUPDATED
+	case outbox.EventType_TODO_DELETED:
+		eventType = gen.TODODELETED
+	default:
+		return gen.TodoChangeEvent{}, false
+	}
+
+	return gen.TodoChangeEvent{
+		Type:                 eventType,
+		TodoId:               event.TodoID,
+		CreatedAt:            event.CreatedAt,
+		Sequence:             event.Sequence,
+		ConversationId:       event.ConversationID,
+		ConversationSequence: event.ConversationSequence,
+	}, true
+}
+
+func toTodoChange(change todo.Change) gen.TodoChangeEvent {
+	var eventType gen.TodoChangeEventType
+	switch change.Type {
+	case todo.ChangeType_Created:
+		eventType = gen.TODOCREATED
+	case todo.ChangeType_Deleted:
+		eventType = gen.TODODELETED
+	default:
+		eventType = gen.TODOUPDATED
+	}
+
+	return gen.TodoChangeEvent{
+		Type:                 eventType,
+		TodoId:               change.TodoID,
+		CreatedAt:            change.CreatedAt,
+		Sequence:             change.Sequence,
+		ConversationId:       change.ConversationID,
+		ConversationSequence: change.ConversationSequence,
+	}
+}
+
+func toSyncResp(page todouc.SyncPage) gen.SyncResp {
+	resp := gen.SyncResp{
+		Todos:         make([]gen.Todo, len(page.Todos)),
+		Conversations: make([]gen.SyncConversation, len(page.Conversations)),
+		Tombstones:    make([]gen.SyncTombstone, len(page.Tombstones)),
+		Cursor:        page.Cursor.String(),
+		HasMore:       page.HasMore,
+	}
+	for i, t := range page.Todos {
+		resp.Todos[i] = toTodo(t)
+	}
+	for i, c := range page.Conversations {
+		resp.Conversations[i] = gen.SyncConversation{
+			Id:            c.ID,
+			Title:         c.Title,
+			TitleSource:   gen.ConversationTitleSource(c.TitleSource),
+			LastMessageAt: c.LastMessageAt,
+			CreatedAt:     c.CreatedAt,
+			UpdatedAt:     c.UpdatedAt,
+		}
+	}
+	for i, t := range page.Tombstones {
+		resp.Tombstones[i] = gen.SyncTombstone{
+			Entity:    gen.SyncEntity(t.Entity),
+			Id:        t.ID,
+			DeletedAt: t.DeletedAt,
+		}
+	}
+	return resp
+}
+
+func toSyncMutations(mutations []gen.SyncMutation) []todouc.SyncMutation {
+	result := make([]todouc.SyncMutation, len(mutations))
+	for i, m := range mutations {
+		result[i] = todouc.SyncMutation{
+			TodoOperation: todouc.TodoOperation{
+				Kind:  todouc.TodoOperationKind(m.Operation),
+				ID:    m.TodoId,
+				Title: m.Title,
+			},
+			BaseUpdatedAt: m.BaseUpdatedAt,
+		}
+		if m.Status != nil {
+			status := todo.Status(*m.Status)
+			result[i].Status = &status
+		}
+		if m.DueDate != nil {
+			result[i].DueDate = &m.DueDate.Time
+		}
+	}
+	return result
+}
+
+func toSyncMutationResult(index int, result todouc.SyncMutationResult) gen.SyncMutationResult {
+	resp := gen.SyncMutationResult{
+		Index:     index,
+		Operation: gen.SyncOperation(result.Kind),
+		Status:    gen.SyncMutationStatus(result.Status),
+	}
+	if result.ID != uuid.Nil {
+		resp.TodoId = &result.ID
+	}
+	if result.Todo != nil {
+		td := toTodo(*result.Todo)
+		resp.Todo = &td
+	}
+	if result.Err != nil {
+		errResp := toError(result.Err)
+		resp.Error = &errResp.Error
+	}
+	return resp
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/genv2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func toErrorV2(err error) genv2.ErrorResp {
+	v1 := toError(err)
+	errResp := genv2.ErrorResp{}
+	errResp.Error.Code = genv2.ErrorCode(v1.Error.Code)
+	errResp.Error.Message = v1.Error.Message
+	return errResp
+}
+
+func toTodoV2(t todo.Todo) genv2.Todo {
+	return genv2.Todo{
+		Id:        openapi_types.UUID(t.ID),
+		Title:     t.Title,
+		CreatedAt: t.CreatedAt,
+		Status:    genv2.TodoStatus(t.Status),
+		DueDate:   openapi_types.Date{Time: t.DueDate},
+		UpdatedAt: t.UpdatedAt,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/genv2"
 )
 
 func respondJSON(w http.ResponseWriter, statusCode int, payload any) {
@@ -14,12 +15,27 @@ func respondJSON(w http.ResponseWriter, statusCode int, payload any) {
 }
 
 func respondError(w http.ResponseWriter, err gen.ErrorResp) {
-	statusCode := http.StatusInternalServerError
-	switch err.Error.Code {
+	respondJSON(w, errorStatusCode(string(err.Error.Code)), err)
+}
+
+func respondErrorV2(w http.ResponseWriter, err genv2.ErrorResp) {
+	respondJSON(w, errorStatusCode(string(err.Error.Code)), err)
+}
+
+// errorStatusCode returns the HTTP status of an error code, which is shared by all API versions.
+func errorStatusCode(code string) int {
+	switch gen.ErrorCode(code) {
 	case gen.BADREQUEST:
-		statusCode = http.StatusBadRequest
+		return http.StatusBadRequest
 	case gen.NOTFOUND:
-		statusCode = http.StatusNotFound
+		return http.StatusNotFound
+	case gen.UNAUTHORIZED:
+		return http.StatusUnauthorized
+	case gen.FORBIDDEN:
+		return http.StatusForbidden
+	case gen.SERVICEUNAVAILABLE:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
 	}
-	respondJSON(w, statusCode, err)
 }
@@ -0,0 +1,219 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	domainaudit "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/audit"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// auditAdminPath is the path the audit log export endpoint is mounted on.
+const auditAdminPath = "/admin/audit"
+
+// auditMiddleware records every mutating call in the audit log once it is answered, with a hash of its
+// body and its status code. Reads are not recorded. Calls to public routes are recorded as anonymous.
+func auditMiddleware(auditLog audit.Log) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if auditLog == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				respondError(w, toError(core.NewValidationErr("failed to read request body")))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			recorder := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r)
+
+			status := recorder.Status()
+			entry := domainaudit.Entry{
+				Source:      domainaudit.SourceAPI,
+				Operation:   r.Pattern,
+				Target:      r.URL.Path,
+				PayloadHash: domainaudit.HashPayload(body),
+				Result:      strconv.Itoa(status),
+				Succeeded:   status < http.StatusBadRequest,
+			}
+			if publicRoutes[r.Pattern] {
+				entry.Principal = domainaudit.Anonymous
+			}
+			auditLog.Record(r.Context(), entry)
+		})
+	}
+}
+
+// statusRecorder captures the status code written by a handler. It keeps streaming responses working by
+// forwarding flushes.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Write implements http.ResponseWriter.
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher.
+func (s *statusRecorder) Flush() {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the wrapped writer for http.ResponseController.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// Status returns the written status code, which is 200 when the handler wrote nothing.
+func (s *statusRecorder) Status() int {
+	if s.status == 0 {
+		return http.StatusOK
+	}
+	return s.status
+}
+
+// auditExportJSON is the admin API representation of a page of exported audit entries.
+type auditExportJSON struct {
+	Entries []auditEntryJSON `json:"entries"`
+	// NextAfter is the after parameter of the next page, set when the page is full.
+	NextAfter *int64 `json:"next_after,omitempty"`
+}
+
+// auditEntryJSON is the admin API representation of an audit.Entry.
+type auditEntryJSON struct {
+	Sequence    int64     `json:"sequence"`
+	ID          string    `json:"id"`
+	Principal   string    `json:"principal"`
+	Role        string    `json:"role,omitempty"`
+	Source      string    `json:"source"`
+	Operation   string    `json:"operation"`
+	Target      string    `json:"target,omitempty"`
+	PayloadHash string    `json:"payload_hash"`
+	Result      string    `json:"result"`
+	Succeeded   bool      `json:"succeeded"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// auditHandler serves the audit log export endpoint:
+//
+//	GET /admin/audit?from={RFC3339}&to={RFC3339}&after={sequence}&limit={n}  exports the audit entries of
+//	                                                                          the tenant in sequence order
+type auditHandler struct {
+	Logger *log.Logger
+	Log    audit.Log
+}
+
+// ServeHTTP implements http.Handler.
+func (h auditHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	query, err := parseAuditQuery(r)
+	if err != nil {
+		respondError(w, toError(err))
+		return
+	}
+
+	ctx := r.Context()
+	entries, err := h.Log.Export(ctx, query)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		h.Logger.Printf("Error exporting audit log: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := auditExportJSON{Entries: make([]auditEntryJSON, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, auditEntryJSON{
+			Sequence:    entry.Sequence,
+			ID:          entry.ID.String(),
+			Principal:   entry.Principal,
+			Role:        string(entry.Role),
+			Source:      string(entry.Source),
+			Operation:   entry.Operation,
+			Target:      entry.Target,
+			PayloadHash: entry.PayloadHash,
+			Result:      entry.Result,
+			Succeeded:   entry.Succeeded,
+			OccurredAt:  entry.OccurredAt,
+		})
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = audit.DefaultExportLimit
+	}
+	if len(entries) > 0 && len(entries) == limit {
+		resp.NextAfter = &entries[len(entries)-1].Sequence
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// parseAuditQuery reads the export query from the request parameters.
+func parseAuditQuery(r *http.Request) (domainaudit.Query, error) {
+	var query domainaudit.Query
+	params := r.URL.Query()
+
+	bounds := []struct {
+		name  string
+		value *time.Time
+	}{{"from", &query.From}, {"to", &query.To}}
+	for _, bound := range bounds {
+		if value := params.Get(bound.name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return domainaudit.Query{}, core.NewValidationErr(bound.name + " must be an RFC 3339 timestamp")
+			}
+			*bound.value = parsed
+		}
+	}
+	if value := params.Get("after"); value != "" {
+		after, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return domainaudit.Query{}, core.NewValidationErr("after must be a sequence number")
+		}
+		query.AfterSequence = after
+	}
+	if value := params.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			return domainaudit.Query{}, core.NewValidationErr("limit must be a number")
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
@@ -0,0 +1,300 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	domainaudit "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/audit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	t.Parallel()
+
+	body := `{"title":"Buy milk"}`
+	principal := access.Principal{Name: "ops", Role: access.RoleMember}
+
+	tests := map[string]struct {
+		method          string
+		pattern         string
+		target          string
+		status          int
+		setExpectations func(*audit.MockLog)
+	}{
+		"records-mutation": {
+			method:  http.MethodPost,
+			pattern: "POST /api/v1/todos",
+			target:  "/api/v1/todos",
+			status:  http.StatusCreated,
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Record(mock.Anything, domainaudit.Entry{
+					Source:      domainaudit.SourceAPI,
+					Operation:   "POST /api/v1/todos",
+					Target:      "/api/v1/todos",
+					PayloadHash: domainaudit.HashPayload([]byte(body)),
+					Result:      "201",
+					Succeeded:   true,
+				}).Return()
+			},
+		},
+		"records-failed-mutation": {
+			method:  http.MethodPatch,
+			pattern: "PATCH /api/v1/todos/{id}",
+			target:  "/api/v1/todos/123",
+			status:  http.StatusNotFound,
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Record(mock.Anything, domainaudit.Entry{
+					Source:      domainaudit.SourceAPI,
+					Operation:   "PATCH /api/v1/todos/{id}",
+					Target:      "/api/v1/todos/123",
+					PayloadHash: domainaudit.HashPayload([]byte(body)),
+					Result:      "404",
+				}).Return()
+			},
+		},
+		"records-public-route-as-anonymous": {
+			method:  http.MethodPost,
+			pattern: "POST /api/v1/sessions/refresh",
+			target:  "/api/v1/sessions/refresh",
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Record(mock.Anything, domainaudit.Entry{
+					Principal:   domainaudit.Anonymous,
+					Source:      domainaudit.SourceAPI,
+					Operation:   "POST /api/v1/sessions/refresh",
+					Target:      "/api/v1/sessions/refresh",
+					PayloadHash: domainaudit.HashPayload([]byte(body)),
+					Result:      "200",
+					Succeeded:   true,
+				}).Return()
+			},
+		},
+		"skips-read": {
+			method:          http.MethodGet,
+			pattern:         "GET /api/v1/todos",
+			target:          "/api/v1/todos",
+			status:          http.StatusOK,
+			setExpectations: func(*audit.MockLog) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			auditLog := audit.NewMockLog(t)
+			tt.setExpectations(auditLog)
+
+			var received string
+			mux := http.NewServeMux()
+			mux.Handle(tt.pattern, auditMiddleware(auditLog)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				received = string(data)
+				assert.Equal(t, principal, access.FromContext(r.Context()))
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				_, _ = w.Write([]byte("{}"))
+			})))
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(body))
+			req = req.WithContext(access.NewContext(req.Context(), principal))
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			assert.Equal(t, body, received)
+		})
+	}
+}
+
+func TestStatusRecorder(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		write    func(*statusRecorder)
+		expected int
+		flushed  bool
+	}{
+		"nothing-written": {
+			write:    func(*statusRecorder) {},
+			expected: http.StatusOK,
+		},
+		"explicit-status": {
+			write: func(s *statusRecorder) {
+				s.WriteHeader(http.StatusNoContent)
+				s.WriteHeader(http.StatusInternalServerError)
+			},
+			expected: http.StatusNoContent,
+		},
+		"streamed-response": {
+			write:    func(s *statusRecorder) { s.Flush() },
+			expected: http.StatusOK,
+			flushed:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			recorder := &statusRecorder{ResponseWriter: w}
+			tt.write(recorder)
+
+			assert.Equal(t, tt.expected, recorder.Status())
+			assert.Equal(t, tt.flushed, w.Flushed)
+			assert.Same(t, w, recorder.Unwrap())
+		})
+	}
+}
+
+func TestAuditHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	entry := domainaudit.Entry{
+		ID:          uuid.MustParse("523e4567-e89b-12d3-a456-426614174000"),
+		Sequence:    42,
+		Principal:   "ops",
+		Role:        access.RoleMember,
+		Source:      domainaudit.SourceChat,
+		Operation:   "create_todos",
+		PayloadHash: "hash",
+		Result:      "ok",
+		Succeeded:   true,
+		OccurredAt:  time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC),
+	}
+	entryJSON := `{"sequence":42,"id":"523e4567-e89b-12d3-a456-426614174000","principal":"ops","role":"member","source":"chat",` +
+		`"operation":"create_todos","payload_hash":"hash","result":"ok","succeeded":true,"occurred_at":"2026-10-15T12:00:00Z"}`
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		method          string
+		target          string
+		authHeader      string
+		setExpectations func(*audit.MockLog)
+		expectedStatus  int
+		expectedBody    string
+	}{
+		"exports-page": {
+			method:     http.MethodGet,
+			target:     auditAdminPath + "?from=2026-10-01T00:00:00Z&after=41&limit=5",
+			authHeader: "Bearer secret",
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Export(mock.Anything, domainaudit.Query{From: from, AfterSequence: 41, Limit: 5}).
+					Return([]domainaudit.Entry{entry}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"entries":[` + entryJSON + `]}`,
+		},
+		"full-page-has-next": {
+			method:     http.MethodGet,
+			target:     auditAdminPath + "?limit=1",
+			authHeader: "Bearer secret",
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Export(mock.Anything, domainaudit.Query{Limit: 1}).Return([]domainaudit.Entry{entry}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"entries":[` + entryJSON + `],"next_after":42}`,
+		},
+		"empty-export": {
+			method:     http.MethodGet,
+			target:     auditAdminPath,
+			authHeader: "Bearer secret",
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Export(mock.Anything, domainaudit.Query{}).Return([]domainaudit.Entry{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"entries":[]}`,
+		},
+		"invalid-from": {
+			method:          http.MethodGet,
+			target:          auditAdminPath + "?from=yesterday",
+			authHeader:      "Bearer secret",
+			setExpectations: func(*audit.MockLog) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody:    `{"error":{"code":"BAD_REQUEST","message":"from must be an RFC 3339 timestamp"}}`,
+		},
+		"invalid-after": {
+			method:          http.MethodGet,
+			target:          auditAdminPath + "?after=last",
+			authHeader:      "Bearer secret",
+			setExpectations: func(*audit.MockLog) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedBody:    `{"error":{"code":"BAD_REQUEST","message":"after must be a sequence number"}}`,
+		},
+		"invalid-limit": {
+			method:     http.MethodGet,
+			target:     auditAdminPath + "?limit=99999",
+			authHeader: "Bearer secret",
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Export(mock.Anything, domainaudit.Query{Limit: 99999}).
+					Return(nil, core.NewValidationErr("limit must be between 1 and 10000")).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"BAD_REQUEST","message":"limit must be between 1 and 10000"}}`,
+		},
+		"missing-token": {
+			method:          http.MethodGet,
+			target:          auditAdminPath,
+			setExpectations: func(*audit.MockLog) {},
+			expectedStatus:  http.StatusUnauthorized,
+			expectedBody:    `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"method-not-allowed": {
+			method:          http.MethodDelete,
+			target:          auditAdminPath,
+			authHeader:      "Bearer secret",
+			setExpectations: func(*audit.MockLog) {},
+			expectedStatus:  http.StatusMethodNotAllowed,
+		},
+		"export-error": {
+			method:     http.MethodGet,
+			target:     auditAdminPath,
+			authHeader: "Bearer secret",
+			setExpectations: func(m *audit.MockLog) {
+				m.EXPECT().Export(mock.Anything, domainaudit.Query{}).Return(nil, errors.New("db error")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"INTERNAL_ERROR","message":"internal server error"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			auditLog := audit.NewMockLog(t)
+			tt.setExpectations(auditLog)
+
+			handler := auditHandler{
+				Logger: log.New(io.Discard, "", 0),
+				Log:    auditLog,
+			}
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			adminTokenMiddleware("secret")(handler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package http
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -21,3 +25,88 @@ func (api TodoAppServer) GetBoardSummary(w http.ResponseWriter, r *http.Request)
 
 	respondJSON(w, http.StatusOK, toBoardSummary(summary))
 }
+
+// CreateBoardSnapshot freezes the filtered todo list and the latest board summary into a shareable snapshot
+// (POST /api/v1/board/snapshots)
+func (api TodoAppServer) CreateBoardSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req gen.CreateBoardSnapshotJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	var (
+		title  string
+		filter todo.ViewFilter
+	)
+	if req.Title != nil {
+		title = *req.Title
+	}
+	if req.Filter != nil {
+		filter = toViewFilter(*req.Filter)
+	}
+
+	ctx := r.Context()
+	snapshot, err := api.SnapshotsUseCase.Create(ctx, title, filter)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error creating board snapshot: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toBoardSnapshot(snapshot))
+}
+
+// GetBoardSnapshot returns a shared board snapshot as JSON or as its Markdown document
+// (GET /api/v1/board/snapshots/{token})
+func (api TodoAppServer) GetBoardSnapshot(w http.ResponseWriter, r *http.Request, token string, params gen.GetBoardSnapshotParams) {
+	ctx := r.Context()
+	snapshot, err := api.SnapshotsUseCase.Get(ctx, token)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error getting board snapshot: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	if params.Format != nil && *params.Format == gen.Markdown {
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(snapshot.Markdown))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toBoardSnapshot(snapshot))
+}
+
+// ListWeeklyReviews lists the assistant-written weekly reviews, most recent week first
+// (GET /api/v1/board/reviews)
+func (api TodoAppServer) ListWeeklyReviews(w http.ResponseWriter, r *http.Request, params gen.ListWeeklyReviewsParams) {
+	ctx := r.Context()
+	reviews, hasMore, err := api.ListWeeklyReviewsUseCase.Query(ctx, params.Page, params.PageSize)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing weekly reviews: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.WeeklyReviewListResp{
+		Reviews: make([]gen.WeeklyReview, len(reviews)),
+		Page:    params.Page,
+	}
+	for i, review := range reviews {
+		resp.Reviews[i] = toWeeklyReview(review)
+	}
+	if hasMore {
+		nextPage := params.Page + 1
+		resp.NextPage = &nextPage
+	}
+	if params.Page > 1 {
+		prevPage := params.Page - 1
+		resp.PreviousPage = &prevPage
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
@@ -7,14 +7,18 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -138,3 +142,263 @@ func TestTodoAppServer_GetBoardSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoAppServer_CreateBoardSnapshot(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	open := todo.Status_OPEN
+	snapshot := todo.BoardSnapshot{
+		Token:  "tok_abc",
+		Title:  "This week",
+		Filter: todo.ViewFilter{Status: &open},
+		Todos: []todo.Todo{{
+			ID: todoID, Title: "Write draft", Status: todo.Status_OPEN, DueDate: dueDate, CreatedAt: createdAt, UpdatedAt: createdAt,
+		}},
+		Markdown:  "# This week\n",
+		CreatedAt: createdAt,
+	}
+
+	tests := map[string]struct {
+		requestBody    string
+		setupUsecases  func(*todouc.MockSnapshots)
+		expectedStatus int
+		expectedBody   *gen.BoardSnapshot
+		expectedError  *gen.Error
+	}{
+		"success": {
+			requestBody: `{"title":"This week","filter":{"status":"OPEN"}}`,
+			setupUsecases: func(m *todouc.MockSnapshots) {
+				m.EXPECT().Create(mock.Anything, "This week", todo.ViewFilter{Status: &open}).Return(snapshot, nil).Once()
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: &gen.BoardSnapshot{
+				Token:  "tok_abc",
+				Title:  "This week",
+				Filter: gen.ViewFilter{Status: common.Ptr(gen.OPEN)},
+				Todos: []gen.Todo{{
+					Id: todoID, Title: "Write draft", Status: gen.OPEN, DueDate: openapi_types.Date{Time: dueDate}, CreatedAt: createdAt, UpdatedAt: createdAt,
+				}},
+				Markdown:  "# This week\n",
+				CreatedAt: createdAt,
+			},
+		},
+		"empty-body-uses-defaults": {
+			requestBody: `{}`,
+			setupUsecases: func(m *todouc.MockSnapshots) {
+				m.EXPECT().Create(mock.Anything, "", todo.ViewFilter{}).Return(snapshot, nil).Once()
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		"invalid-body": {
+			requestBody:    `{`,
+			setupUsecases:  func(m *todouc.MockSnapshots) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &gen.Error{Code: gen.BADREQUEST, Message: "invalid request body: unexpected EOF"},
+		},
+		"validation-error": {
+			requestBody: `{"title":"This week"}`,
+			setupUsecases: func(m *todouc.MockSnapshots) {
+				m.EXPECT().Create(mock.Anything, "This week", todo.ViewFilter{}).
+					Return(todo.BoardSnapshot{}, core.NewValidationErr("title cannot exceed 120 characters")).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &gen.Error{Code: gen.BADREQUEST, Message: "title cannot exceed 120 characters"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			snapshots := todouc.NewMockSnapshots(t)
+			tt.setupUsecases(snapshots)
+
+			server := &TodoAppServer{
+				SnapshotsUseCase: snapshots,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/board/snapshots", strings.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.CreateBoardSnapshot(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.BoardSnapshot
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedError, response.Error)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_GetBoardSnapshot(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	snapshot := todo.BoardSnapshot{
+		Token:     "tok_abc",
+		Title:     "This week",
+		Summary:   &todo.BoardSummary{Content: todo.BoardSummaryContent{Summary: "One task left."}, GeneratedAt: createdAt},
+		Markdown:  "# This week\n",
+		CreatedAt: createdAt,
+	}
+
+	tests := map[string]struct {
+		format          *gen.GetBoardSnapshotParamsFormat
+		setupUsecases   func(*todouc.MockSnapshots)
+		expectedStatus  int
+		expectedType    string
+		expectedContent string
+	}{
+		"json": {
+			setupUsecases: func(m *todouc.MockSnapshots) {
+				m.EXPECT().Get(mock.Anything, "tok_abc").Return(snapshot, nil).Once()
+			},
+			expectedStatus:  http.StatusOK,
+			expectedType:    "application/json",
+			expectedContent: `"summary":{"counts":{"ARCHIVED":0,"DONE":0,"OPEN":0},"generated_at":"2026-03-02T09:00:00Z","near_deadline":null,"next_up":[],"overdue":null,"summary":"One task left."}`,
+		},
+		"markdown": {
+			format: common.Ptr(gen.Markdown),
+			setupUsecases: func(m *todouc.MockSnapshots) {
+				m.EXPECT().Get(mock.Anything, "tok_abc").Return(snapshot, nil).Once()
+			},
+			expectedStatus:  http.StatusOK,
+			expectedType:    "text/markdown; charset=utf-8",
+			expectedContent: "# This week\n",
+		},
+		"not-found": {
+			setupUsecases: func(m *todouc.MockSnapshots) {
+				m.EXPECT().Get(mock.Anything, "tok_abc").Return(todo.BoardSnapshot{}, core.NewNotFoundErr("board snapshot not found")).Once()
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedType:    "application/json",
+			expectedContent: `"message":"board snapshot not found"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			snapshots := todouc.NewMockSnapshots(t)
+			tt.setupUsecases(snapshots)
+
+			server := &TodoAppServer{
+				SnapshotsUseCase: snapshots,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/board/snapshots/tok_abc", nil)
+			w := httptest.NewRecorder()
+
+			server.GetBoardSnapshot(w, req, "tok_abc", gen.GetBoardSnapshotParams{Format: tt.format})
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Body.String(), tt.expectedContent)
+		})
+	}
+}
+
+func TestTodoAppServer_ListWeeklyReviews(t *testing.T) {
+	t.Parallel()
+
+	generatedAt := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+	reviewID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	review := todo.WeeklyReview{
+		ID:             reviewID,
+		ConversationID: conversationID,
+		PeriodStart:    time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:      time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		Planned:        2,
+		Completed:      []string{"Write draft"},
+		Slipped:        []string{"Book flights"},
+		Report:         "You finished 1 of 2 todos.",
+		Model:          "review-model",
+		GeneratedAt:    generatedAt,
+	}
+
+	tests := map[string]struct {
+		params         gen.ListWeeklyReviewsParams
+		setupUsecases  func(*board.MockListWeeklyReviews)
+		expectedStatus int
+		expectedBody   *gen.WeeklyReviewListResp
+		expectedError  *gen.Error
+	}{
+		"success": {
+			params: gen.ListWeeklyReviewsParams{Page: 2, PageSize: 1},
+			setupUsecases: func(m *board.MockListWeeklyReviews) {
+				m.EXPECT().Query(mock.Anything, 2, 1).Return([]todo.WeeklyReview{review}, true, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.WeeklyReviewListResp{
+				Reviews: []gen.WeeklyReview{{
+					Id:             reviewID,
+					ConversationId: conversationID,
+					PeriodStart:    openapi_types.Date{Time: review.PeriodStart},
+					PeriodEnd:      openapi_types.Date{Time: review.PeriodEnd},
+					Planned:        2,
+					Completed:      []string{"Write draft"},
+					Slipped:        []string{"Book flights"},
+					Report:         "You finished 1 of 2 todos.",
+					Model:          "review-model",
+					GeneratedAt:    generatedAt,
+				}},
+				Page:         2,
+				PreviousPage: common.Ptr(1),
+				NextPage:     common.Ptr(3),
+			},
+		},
+		"invalid-page": {
+			params: gen.ListWeeklyReviewsParams{Page: 0, PageSize: 10},
+			setupUsecases: func(m *board.MockListWeeklyReviews) {
+				m.EXPECT().Query(mock.Anything, 0, 10).Return(nil, false, core.NewValidationErr("page must be greater than 0")).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  &gen.Error{Code: gen.BADREQUEST, Message: "page must be greater than 0"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reviews := board.NewMockListWeeklyReviews(t)
+			tt.setupUsecases(reviews)
+
+			server := &TodoAppServer{
+				ListWeeklyReviewsUseCase: reviews,
+				Logger:                   log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/board/reviews", nil)
+			w := httptest.NewRecorder()
+
+			server.ListWeeklyReviews(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.WeeklyReviewListResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedError, response.Error)
+			}
+		})
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -47,9 +50,53 @@ func (api TodoAppServer) ListChatMessages(w http.ResponseWriter, r *http.Request
 
 }
 
+// GetContentBlob returns the full content of a content blob referenced by a chat message.
+// (GET /api/v1/chat/blobs/{hash})
+func (api TodoAppServer) GetContentBlob(w http.ResponseWriter, r *http.Request, hash string) {
+	ctx := r.Context()
+	blob, err := api.GetContentBlobUseCase.Query(ctx, hash)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error getting content blob: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, gen.ContentBlob{
+		Hash:      blob.Hash,
+		Content:   blob.Content,
+		SizeBytes: blob.SizeBytes,
+		CreatedAt: blob.CreatedAt,
+	})
+}
+
+// SubmitMessageFeedback records the user rating of an assistant message.
+// (PUT /api/v1/chat/messages/{message_id}/feedback)
+func (api TodoAppServer) SubmitMessageFeedback(w http.ResponseWriter, r *http.Request, messageId openapi_types.UUID) {
+	var req gen.SubmitMessageFeedbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.BADREQUEST,
+				Message: "invalid request body",
+			},
+		})
+		return
+	}
+
+	ctx := r.Context()
+	err := api.SubmitMessageFeedbackUseCase.Execute(ctx, messageId, int(req.Score))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error submitting message feedback: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // StreamChat handles streaming assistant chat responses.
 // (POST /api/v1/chat/stream)
-func (api TodoAppServer) StreamChat(w http.ResponseWriter, r *http.Request) {
+func (api TodoAppServer) StreamChat(w http.ResponseWriter, r *http.Request, params gen.StreamChatParams) {
 	req := gen.StreamChatJSONRequestBody{}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondError(w, gen.ErrorResp{
@@ -61,6 +108,129 @@ func (api TodoAppServer) StreamChat(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var options []chat.StreamChatOption
+	if req.ConversationId != nil {
+		options = append(options, chat.WithConversationID(*req.ConversationId))
+	}
+	if generation := toGenerationOptions(req); !generation.IsZero() {
+		options = append(options, chat.WithGenerationOptions(generation))
+	}
+	options = appendActionResultsOption(options, params.IncludeActionResults)
+
+	api.streamTurn(w, r, "StreamChat", func(ctx context.Context, onEvent assistant.EventCallback) error {
+		return api.StreamChatUseCase.Execute(ctx, req.Message, req.Model, onEvent, options...)
+	})
+}
+
+// PreviewChat streams the turn StreamChat would run for a user message, discarding all of its changes.
+// (POST /api/v1/chat/preview)
+func (api TodoAppServer) PreviewChat(w http.ResponseWriter, r *http.Request, params gen.PreviewChatParams) {
+	req := gen.PreviewChatJSONRequestBody{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.BADREQUEST,
+				Message: "invalid request body",
+			},
+		})
+		return
+	}
+
+	var options []chat.StreamChatOption
+	if req.ConversationId != nil {
+		options = append(options, chat.WithConversationID(*req.ConversationId))
+	}
+	if generation := toGenerationOptions(req); !generation.IsZero() {
+		options = append(options, chat.WithGenerationOptions(generation))
+	}
+	options = appendActionResultsOption(options, params.IncludeActionResults)
+
+	api.streamTurn(w, r, "PreviewChat", func(ctx context.Context, onEvent assistant.EventCallback) error {
+		return api.PreviewChatUseCase.Execute(ctx, req.Message, req.Model, onEvent, options...)
+	})
+}
+
+// RegenerateMessage streams a regenerated response for the latest turn of a conversation.
+// (POST /api/v1/conversations/{conversation_id}/messages/{message_id}/regenerate)
+func (api TodoAppServer) RegenerateMessage(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, messageId openapi_types.UUID, params gen.RegenerateMessageParams) {
+	req := gen.RegenerateMessageJSONRequestBody{}
+	// The body is optional: an empty one regenerates with the model of the message and the default options.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.BADREQUEST,
+				Message: "invalid request body",
+			},
+		})
+		return
+	}
+
+	options := []chat.StreamChatOption{
+		chat.WithConversationID(conversationId),
+		chat.WithRegeneratedMessage(messageId),
+	}
+	if generation := toRegenerateGenerationOptions(req); !generation.IsZero() {
+		options = append(options, chat.WithGenerationOptions(generation))
+	}
+	options = appendActionResultsOption(options, params.IncludeActionResults)
+	model := ""
+	if req.Model != nil {
+		model = *req.Model
+	}
+
+	api.streamTurn(w, r, "RegenerateMessage", func(ctx context.Context, onEvent assistant.EventCallback) error {
+		return api.StreamChatUseCase.Execute(ctx, "", model, onEvent, options...)
+	})
+}
+
+// EditMessage replaces a previous user message and streams the turn resent from it.
+// (POST /api/v1/conversations/{conversation_id}/messages/{message_id}/edit)
+func (api TodoAppServer) EditMessage(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, messageId openapi_types.UUID, params gen.EditMessageParams) {
+	req := gen.EditMessageJSONRequestBody{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.BADREQUEST,
+				Message: "invalid request body",
+			},
+		})
+		return
+	}
+
+	options := []chat.StreamChatOption{
+		chat.WithConversationID(conversationId),
+		chat.WithEditedMessage(messageId),
+	}
+	if generation := toEditGenerationOptions(req); !generation.IsZero() {
+		options = append(options, chat.WithGenerationOptions(generation))
+	}
+	options = appendActionResultsOption(options, params.IncludeActionResults)
+	model := ""
+	if req.Model != nil {
+		model = *req.Model
+	}
+
+	api.streamTurn(w, r, "EditMessage", func(ctx context.Context, onEvent assistant.EventCallback) error {
+		return api.StreamChatUseCase.Execute(ctx, req.Message, model, onEvent, options...)
+	})
+}
+
+// appendActionResultsOption adds chat.WithActionResults when the client asked for action results.
+func appendActionResultsOption(options []chat.StreamChatOption, includeActionResults *bool) []chat.StreamChatOption {
+	if includeActionResults != nil && *includeActionResults {
+		return append(options, chat.WithActionResults())
+	}
+	return options
+}
+
+// streamTurn runs one chat turn and writes its events as Server-Sent Events. Errors raised before
+// streaming started are returned as a JSON error response.
+func (api TodoAppServer) streamTurn(
+	w http.ResponseWriter,
+	r *http.Request,
+	operation string,
+	run func(ctx context.Context, onEvent assistant.EventCallback) error,
+) {
 	flusher, ok := w.(http.Flusher)
 	if !ok {
 		errResp := gen.ErrorResp{
@@ -78,13 +248,9 @@ func (api TodoAppServer) StreamChat(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Connection", "keep-alive")
 	w.Header().Set("X-Content-Type-Options", "nosniff")
 
-	var options []chat.StreamChatOption
-	if req.ConversationId != nil {
-		options = append(options, chat.WithConversationID(*req.ConversationId))
-	}
-
-	ctx := r.Context()
-	err := api.StreamChatUseCase.Execute(ctx, req.Message, req.Model, func(ctx context.Context, eventType assistant.EventType, data any) error {
+	ctx, untrack := api.trackSessionStream(r.Context())
+	defer untrack()
+	err := run(ctx, func(ctx context.Context, eventType assistant.EventType, data any) error {
 		dataBytes, err := json.Marshal(data)
 		if err != nil {
 			return err
@@ -96,10 +262,15 @@ func (api TodoAppServer) StreamChat(w http.ResponseWriter, r *http.Request) {
 
 		flusher.Flush()
 		return nil
-	}, options...)
+	})
 	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) &&
 		!errors.Is(err, context.Canceled) {
-		api.Logger.Printf("StreamChat: error during streaming: %v", err)
+		api.Logger.Printf("%s: error during streaming: %v", operation, err)
+		// Turn failures are already reported to the client as a turn_failed event.
+		var turnErr *assistant.TurnError
+		if errors.As(err, &turnErr) {
+			return
+		}
 		respondError(w, toError(err))
 	}
 }
@@ -120,10 +291,14 @@ func (api TodoAppServer) ListAvailableModels(w http.ResponseWriter, r *http.Requ
 		if m.Kind != assistant.ModelKindAssistant {
 			continue
 		}
-		rp.Models = append(rp.Models, gen.ModelInfo{
+		info := gen.ModelInfo{
 			Id:   m.ID,
 			Name: m.Name,
-		})
+		}
+		if m.MaxOutputTokens > 0 {
+			info.MaxOutputTokens = common.Ptr(m.MaxOutputTokens)
+		}
+		rp.Models = append(rp.Models, info)
 	}
 
 	respondJSON(w, http.StatusOK, rp)
@@ -17,6 +17,7 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -40,6 +41,10 @@ func TestTodoAppServer_ListChatMessages(t *testing.T) {
 		Content:        "Hello, how are you?",
 		CreatedAt:      fixedTime,
 		ActionExecuted: &actionExecuted,
+		FeedbackScore:  common.Ptr(1),
+		Seed:           common.Ptr[int64](42),
+		SupersededAt:   &fixedTime,
+		MessageState:   assistant.ChatMessageState_Moderated,
 		SelectedSkills: []assistant.SelectedSkill{
 			{
 				Name:   "update_todos",
@@ -67,6 +72,10 @@ func TestTodoAppServer_ListChatMessages(t *testing.T) {
 		Content:        "Hello, how are you?",
 		CreatedAt:      fixedTime,
 		ActionExecuted: &actionExecuted,
+		FeedbackScore:  common.Ptr(1),
+		Seed:           common.Ptr[int64](42),
+		SupersededAt:   &fixedTime,
+		Moderated:      common.Ptr(true),
 		SelectedSkills: &[]gen.SelectedSkill{
 			{
 				Name:   "update_todos",
@@ -204,15 +213,167 @@ func TestTodoAppServer_ListChatMessages(t *testing.T) {
 	}
 }
 
+func TestTodoAppServer_SubmitMessageFeedback(t *testing.T) {
+	t.Parallel()
+
+	messageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		requestBody     []byte
+		setExpectations func(*chat.MockSubmitMessageFeedback)
+		expectedStatus  int
+		expectedError   *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: serializeJSON(t, gen.SubmitMessageFeedbackRequest{Score: gen.N1}),
+			setExpectations: func(uc *chat.MockSubmitMessageFeedback) {
+				uc.EXPECT().Execute(mock.Anything, messageID, 1).Return(nil).Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"invalid-body": {
+			requestBody:     []byte("{"),
+			setExpectations: func(*chat.MockSubmitMessageFeedback) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "invalid request body"},
+			},
+		},
+		"message-not-found": {
+			requestBody: serializeJSON(t, gen.SubmitMessageFeedbackRequest{Score: gen.Minus1}),
+			setExpectations: func(uc *chat.MockSubmitMessageFeedback) {
+				uc.EXPECT().Execute(mock.Anything, messageID, -1).
+					Return(core.NewNotFoundErr("assistant message not found")).
+					Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.NOTFOUND, Message: "assistant message not found"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase := chat.NewMockSubmitMessageFeedback(t)
+			tt.setExpectations(useCase)
+
+			server := TodoAppServer{
+				SubmitMessageFeedbackUseCase: useCase,
+				Logger:                       log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/chat/messages/"+messageID.String()+"/feedback", bytes.NewBuffer(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.SubmitMessageFeedback(w, req, messageID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_GetContentBlob(t *testing.T) {
+	t.Parallel()
+
+	blob := assistant.NewContentBlob(`{"todos":[]}`, time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC))
+
+	tests := map[string]struct {
+		hash            string
+		setExpectations func(*chat.MockGetContentBlob)
+		expectedStatus  int
+		expectedBody    *gen.ContentBlob
+		expectedError   *gen.ErrorResp
+	}{
+		"success": {
+			hash: blob.Hash,
+			setExpectations: func(uc *chat.MockGetContentBlob) {
+				uc.EXPECT().Query(mock.Anything, blob.Hash).Return(blob, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ContentBlob{
+				Hash:      blob.Hash,
+				Content:   blob.Content,
+				SizeBytes: blob.SizeBytes,
+				CreatedAt: blob.CreatedAt,
+			},
+		},
+		"invalid-hash": {
+			hash: "not-a-hash",
+			setExpectations: func(uc *chat.MockGetContentBlob) {
+				uc.EXPECT().Query(mock.Anything, "not-a-hash").
+					Return(assistant.ContentBlob{}, core.NewValidationErr("hash must be a lowercase hex-encoded SHA-256 hash")).
+					Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "hash must be a lowercase hex-encoded SHA-256 hash"},
+			},
+		},
+		"not-found": {
+			hash: blob.Hash,
+			setExpectations: func(uc *chat.MockGetContentBlob) {
+				uc.EXPECT().Query(mock.Anything, blob.Hash).
+					Return(assistant.ContentBlob{}, core.NewNotFoundErr("content blob "+blob.Hash+" not found")).
+					Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.NOTFOUND, Message: "content blob " + blob.Hash + " not found"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase := chat.NewMockGetContentBlob(t)
+			tt.setExpectations(useCase)
+
+			server := TodoAppServer{
+				GetContentBlobUseCase: useCase,
+				Logger:                log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/chat/blobs/"+tt.hash, nil)
+			w := httptest.NewRecorder()
+
+			server.GetContentBlob(w, req, tt.hash)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.ContentBlob
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
+
 func TestTodoAppServer_StreamChat(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
 		requestBody    any
+		params         gen.StreamChatParams
 		setupUsecases  func(*chat.MockStreamChat)
 		options        []chat.StreamChatOption
 		expectedStatus int
 		expectedEvents []string
+		unexpectedBody []string
 		expectedError  *gen.ErrorResp
 	}{
 		"success": {
@@ -255,6 +416,87 @@ func TestTodoAppServer_StreamChat(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedEvents: []string{"event: turn_started", "event: message_delta"},
 		},
+		"success-with-generation-options": {
+			requestBody: gen.StreamChatJSONRequestBody{
+				Message:         "Hello",
+				Model:           "qwen2.5:7B-Q4_0",
+				MaxTokens:       common.Ptr(128),
+				Stop:            &[]string{"\n\n"},
+				PresencePenalty: common.Ptr(0.5),
+				Temperature:     common.Ptr(1.1),
+				TopP:            common.Ptr(0.95),
+				Seed:            common.Ptr(int64(42)),
+			},
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Hello", "qwen2.5:7B-Q4_0", mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.Equal(t, assistant.GenerationOptions{
+							MaxTokens:       common.Ptr(128),
+							Stop:            []string{"\n\n"},
+							PresencePenalty: common.Ptr(0.5),
+							Temperature:     common.Ptr(1.1),
+							TopP:            common.Ptr(0.95),
+							Seed:            common.Ptr(int64(42)),
+						}, params.Generation)
+
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: turn_started"},
+		},
+		"success-with-action-results": {
+			requestBody: gen.StreamChatJSONRequestBody{Message: "Hello", Model: "qwen2.5:7B-Q4_0"},
+			params:      gen.StreamChatParams{IncludeActionResults: common.Ptr(true)},
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Hello", "qwen2.5:7B-Q4_0", mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.True(t, params.IncludeActionResults)
+
+						_ = cb(ctx, assistant.EventType_ActionCompleted, assistant.ActionCompleted{
+							ID:      "call-1",
+							Name:    "fetch_todos",
+							Success: true,
+							Result:  json.RawMessage(`{"status":"success","data":{"todos":[]}}`),
+						})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: action_completed", `"result":{"status":"success","data":{"todos":[]}}`},
+		},
+		"turn-failed-reported-in-stream": {
+			requestBody: gen.StreamChatJSONRequestBody{Message: "Hello", Model: "qwen2.5:7B-Q4_0"},
+			setupUsecases: func(m *chat.MockStreamChat) {
+				turnErr := assistant.NewTurnError(assistant.TurnErrorCode_RateLimited, 0, errors.New("too many requests"))
+				m.EXPECT().
+					Execute(mock.Anything, "Hello", "qwen2.5:7B-Q4_0", mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hi"})
+						_ = cb(ctx, assistant.EventType_TurnFailed, assistant.TurnFailed{
+							Code:      turnErr.Code,
+							Error:     turnErr.Error(),
+							Retriable: turnErr.Retriable(),
+						})
+					}).
+					Return(turnErr)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: turn_started", "event: message_delta", "event: turn_failed", `"code":"rate_limited"`, `"retriable":true`},
+			unexpectedBody: []string{"internal server error"},
+		},
 		"invalid-json": {
 			requestBody:    []byte(`{invalid json}`),
 			setupUsecases:  func(m *chat.MockStreamChat) {},
@@ -266,6 +508,21 @@ func TestTodoAppServer_StreamChat(t *testing.T) {
 				},
 			},
 		},
+		"shutting-down": {
+			requestBody: gen.StreamChatJSONRequestBody{Message: "Hello", Model: "qwen2.5:7B-Q4_0"},
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Hello", "qwen2.5:7B-Q4_0", mock.Anything).
+					Return(core.NewUnavailableErr("the server is shutting down, please retry the request"))
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.SERVICEUNAVAILABLE,
+					Message: "the server is shutting down, please retry the request",
+				},
+			},
+		},
 		"use-case-error": {
 			requestBody: gen.StreamChatJSONRequestBody{Message: "fail", Model: "qwen2.5:7B-Q4_0"},
 			setupUsecases: func(m *chat.MockStreamChat) {
@@ -308,7 +565,7 @@ func TestTodoAppServer_StreamChat(t *testing.T) {
 			// For streaming, ResponseRecorder does not implement http.Flusher, so we use a custom ResponseWriter
 			w := newMockFlusherRecorder()
 
-			server.StreamChat(w, req)
+			server.StreamChat(w, req, tt.params)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
 
@@ -318,6 +575,9 @@ func TestTodoAppServer_StreamChat(t *testing.T) {
 					assert.Contains(t, body, event)
 				}
 			}
+			for _, unexpected := range tt.unexpectedBody {
+				assert.NotContains(t, w.Body.String(), unexpected)
+			}
 
 			if tt.expectedError != nil {
 				var response gen.ErrorResp
@@ -331,6 +591,338 @@ func TestTodoAppServer_StreamChat(t *testing.T) {
 	}
 }
 
+func TestTodoAppServer_PreviewChat(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		requestBody    []byte
+		params         gen.PreviewChatParams
+		setupUsecases  func(*chat.MockPreviewChat)
+		expectedStatus int
+		expectedEvents []string
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"message":"Add buy milk","model":"qwen2.5:7B-Q4_0","conversation_id":"00000000-0000-0000-0000-000000000001"}`),
+			params:      gen.PreviewChatParams{IncludeActionResults: common.Ptr(true)},
+			setupUsecases: func(m *chat.MockPreviewChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Add buy milk", "qwen2.5:7B-Q4_0", mock.Anything, mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.Equal(t, &conversationID, params.ConversationID)
+						assert.True(t, params.IncludeActionResults)
+
+						_ = cb(ctx, assistant.EventType_ActionCompleted, assistant.ActionCompleted{
+							ID:      "call-1",
+							Name:    "create_todos",
+							Success: true,
+							Result:  json.RawMessage(`{"status":"success","data":{"todos":[{"title":"Buy milk"}]}}`),
+						})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Added."})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: action_completed", `"title":"Buy milk"`, "event: message_delta"},
+		},
+		"invalid-json": {
+			requestBody:    []byte(`{invalid json}`),
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "invalid request body",
+				},
+			},
+		},
+		"use-case-error": {
+			requestBody: []byte(`{"message":"fail","model":"qwen2.5:7B-Q4_0"}`),
+			setupUsecases: func(m *chat.MockPreviewChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "fail", "qwen2.5:7B-Q4_0", mock.Anything).
+					Return(errors.New("sandbox error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.INTERNALERROR,
+					Message: "internal server error",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockPreviewChat := chat.NewMockPreviewChat(t)
+			if tt.setupUsecases != nil {
+				tt.setupUsecases(mockPreviewChat)
+			}
+
+			server := &TodoAppServer{
+				PreviewChatUseCase: mockPreviewChat,
+				Logger:             log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/chat/preview", bytes.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := newMockFlusherRecorder()
+
+			server.PreviewChat(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for _, event := range tt.expectedEvents {
+				assert.Contains(t, w.Body.String(), event)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_RegenerateMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	messageID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*chat.MockStreamChat)
+		expectedStatus int
+		expectedEvents []string
+		expectedError  *gen.ErrorResp
+	}{
+		"success-without-body": {
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "", "", mock.Anything, mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.Equal(t, &conversationID, params.ConversationID)
+						assert.Equal(t, &messageID, params.RegeneratedMessageID)
+						assert.True(t, params.Generation.IsZero())
+
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: turn_started"},
+		},
+		"success-with-model-and-generation-options": {
+			requestBody: []byte(`{"model":"qwen2.5:7B-Q4_0","temperature":1.2,"seed":7}`),
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "", "qwen2.5:7B-Q4_0", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.Equal(t, assistant.GenerationOptions{
+							Temperature: common.Ptr(1.2),
+							Seed:        common.Ptr(int64(7)),
+						}, params.Generation)
+
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: turn_started"},
+		},
+		"invalid-json": {
+			requestBody:    []byte(`{invalid json}`),
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "invalid request body",
+				},
+			},
+		},
+		"message-not-regenerable": {
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "", "", mock.Anything, mock.Anything, mock.Anything).
+					Return(core.NewValidationErr("only assistant messages of the latest turn can be regenerated"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "only assistant messages of the latest turn can be regenerated",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockStreamChat := chat.NewMockStreamChat(t)
+			if tt.setupUsecases != nil {
+				tt.setupUsecases(mockStreamChat)
+			}
+
+			server := &TodoAppServer{
+				StreamChatUseCase: mockStreamChat,
+				Logger:            log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/conversations/"+conversationID.String()+"/messages/"+messageID.String()+"/regenerate",
+				bytes.NewReader(tt.requestBody),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := newMockFlusherRecorder()
+
+			server.RegenerateMessage(w, req, conversationID, messageID, gen.RegenerateMessageParams{})
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for _, event := range tt.expectedEvents {
+				assert.Contains(t, w.Body.String(), event)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_EditMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	messageID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*chat.MockStreamChat)
+		expectedStatus int
+		expectedEvents []string
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"message":"Add eggs"}`),
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Add eggs", "", mock.Anything, mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.Equal(t, &conversationID, params.ConversationID)
+						assert.Equal(t, &messageID, params.EditedMessageID)
+						assert.True(t, params.Generation.IsZero())
+
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: turn_started"},
+		},
+		"success-with-model-and-generation-options": {
+			requestBody: []byte(`{"message":"Add eggs","model":"qwen2.5:7B-Q4_0","max_tokens":64}`),
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Add eggs", "qwen2.5:7B-Q4_0", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, userMessage string, model string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						params := &chat.StreamChatParams{}
+						for _, opt := range opts {
+							opt(params)
+						}
+						assert.Equal(t, assistant.GenerationOptions{MaxTokens: common.Ptr(64)}, params.Generation)
+
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{})
+					}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []string{"event: turn_started"},
+		},
+		"invalid-json": {
+			requestBody:    []byte(`{invalid json}`),
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "invalid request body",
+				},
+			},
+		},
+		"message-not-found": {
+			requestBody: []byte(`{"message":"Add eggs"}`),
+			setupUsecases: func(m *chat.MockStreamChat) {
+				m.EXPECT().
+					Execute(mock.Anything, "Add eggs", "", mock.Anything, mock.Anything, mock.Anything).
+					Return(core.NewNotFoundErr("message with ID 00000000-0000-0000-0000-000000000002 not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.NOTFOUND,
+					Message: "message with ID 00000000-0000-0000-0000-000000000002 not found",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockStreamChat := chat.NewMockStreamChat(t)
+			if tt.setupUsecases != nil {
+				tt.setupUsecases(mockStreamChat)
+			}
+
+			server := &TodoAppServer{
+				StreamChatUseCase: mockStreamChat,
+				Logger:            log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/v1/conversations/"+conversationID.String()+"/messages/"+messageID.String()+"/edit",
+				bytes.NewReader(tt.requestBody),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := newMockFlusherRecorder()
+
+			server.EditMessage(w, req, conversationID, messageID, gen.EditMessageParams{})
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for _, event := range tt.expectedEvents {
+				assert.Contains(t, w.Body.String(), event)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
+
 // mockFlusherRecorder is a ResponseRecorder that implements http.Flusher
 type mockFlusherRecorder struct {
 	*httptest.ResponseRecorder
@@ -356,7 +948,7 @@ func TestTodoAppServer_ListAvailableModels(t *testing.T) {
 				m.EXPECT().
 					Query(mock.Anything).
 					Return([]assistant.ModelInfo{
-						{ID: "gpt-4", Name: "gpt-4", Kind: assistant.ModelKindAssistant},
+						{ID: "gpt-4", Name: "gpt-4", Kind: assistant.ModelKindAssistant, MaxOutputTokens: 4096},
 						{ID: "text-embed", Name: "text-embed", Kind: assistant.ModelKindEmbedding},
 						{ID: "gpt-3.5", Name: "gpt-3.5", Kind: assistant.ModelKindAssistant},
 					}, nil)
@@ -364,7 +956,7 @@ func TestTodoAppServer_ListAvailableModels(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody: &gen.ModelListResp{
 				Models: []gen.ModelInfo{
-					{Id: "gpt-4", Name: "gpt-4"},
+					{Id: "gpt-4", Name: "gpt-4", MaxOutputTokens: common.Ptr(4096)},
 					{Id: "gpt-3.5", Name: "gpt-3.5"},
 				},
 			},
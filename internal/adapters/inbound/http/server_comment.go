@@ -0,0 +1,103 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTodoComments lists the comments of a todo
+// (GET /api/v1/todos/{todo_id}/comments)
+func (api TodoAppServer) ListTodoComments(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID, params gen.ListTodoCommentsParams) {
+	ctx := r.Context()
+	comments, hasMore, err := api.CommentsUseCase.List(ctx, todoId, params.Page, params.PageSize)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing todo comments: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListCommentsResp{
+		Items: make([]gen.Comment, len(comments)),
+		Page:  params.Page,
+	}
+	for i, c := range comments {
+		resp.Items[i] = toComment(c)
+	}
+	if hasMore {
+		nextPage := params.Page + 1
+		resp.NextPage = &nextPage
+	}
+	if params.Page > 1 {
+		prevPage := params.Page - 1
+		resp.PreviousPage = &prevPage
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// CreateTodoComment adds a user comment to a todo
+// (POST /api/v1/todos/{todo_id}/comments)
+func (api TodoAppServer) CreateTodoComment(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID) {
+	var req gen.CreateTodoCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	comment, err := api.CommentsUseCase.Add(ctx, todoId, todo.CommentAuthor_User, req.Body)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error creating todo comment: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toComment(comment))
+}
+
+// UpdateTodoComment edits the body of a user comment
+// (PATCH /api/v1/todos/{todo_id}/comments/{comment_id})
+func (api TodoAppServer) UpdateTodoComment(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID, commentId openapi_types.UUID) {
+	var req gen.UpdateTodoCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	comment, err := api.CommentsUseCase.Update(ctx, todoId, commentId, req.Body)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error updating todo comment: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toComment(comment))
+}
+
+// DeleteTodoComment deletes a comment from a todo
+// (DELETE /api/v1/todos/{todo_id}/comments/{comment_id})
+func (api TodoAppServer) DeleteTodoComment(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID, commentId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.CommentsUseCase.Delete(ctx, todoId, commentId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error deleting todo comment: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,297 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	commentTodoID = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	commentID     = uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	commentTime   = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	domainComment = todo.Comment{
+		ID:        commentID,
+		TodoID:    commentTodoID,
+		Author:    todo.CommentAuthor_User,
+		Body:      "Waiting on the vendor quote",
+		CreatedAt: commentTime,
+		UpdatedAt: commentTime,
+	}
+	restComment = gen.Comment{
+		Id:        commentID,
+		TodoId:    commentTodoID,
+		Author:    gen.USER,
+		Body:      "Waiting on the vendor quote",
+		CreatedAt: commentTime,
+		UpdatedAt: commentTime,
+	}
+)
+
+func TestTodoAppServer_ListTodoComments(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params         gen.ListTodoCommentsParams
+		setupUsecases  func(*todouc.MockComments)
+		expectedStatus int
+		expectedBody   *gen.ListCommentsResp
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			params: gen.ListTodoCommentsParams{Page: 2, PageSize: 1},
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					List(mock.Anything, commentTodoID, 2, 1).
+					Return([]todo.Comment{domainComment}, true, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListCommentsResp{
+				Items:        []gen.Comment{restComment},
+				Page:         2,
+				PreviousPage: common.Ptr(1),
+				NextPage:     common.Ptr(3),
+			},
+		},
+		"todo-not-found": {
+			params: gen.ListTodoCommentsParams{Page: 1, PageSize: 10},
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					List(mock.Anything, commentTodoID, 1, 10).
+					Return(nil, false, core.NewNotFoundErr("todo not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.NOTFOUND, Message: "todo not found"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockComments := todouc.NewMockComments(t)
+			tt.setupUsecases(mockComments)
+
+			server := &TodoAppServer{
+				CommentsUseCase: mockComments,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos/"+commentTodoID.String()+"/comments", nil)
+			w := httptest.NewRecorder()
+
+			server.ListTodoComments(w, req, commentTodoID, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.ListCommentsResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_CreateTodoComment(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockComments)
+		expectedStatus int
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: serializeJSON(t, gen.CreateTodoCommentJSONRequestBody{Body: "Waiting on the vendor quote"}),
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Add(mock.Anything, commentTodoID, todo.CommentAuthor_User, "Waiting on the vendor quote").
+					Return(domainComment, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		"invalid-json": {
+			requestBody:    []byte("{"),
+			setupUsecases:  func(m *todouc.MockComments) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "invalid request body: unexpected EOF"},
+			},
+		},
+		"validation-error": {
+			requestBody: serializeJSON(t, gen.CreateTodoCommentJSONRequestBody{Body: " "}),
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Add(mock.Anything, commentTodoID, todo.CommentAuthor_User, " ").
+					Return(todo.Comment{}, core.NewValidationErr("body cannot be empty"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "body cannot be empty"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockComments := todouc.NewMockComments(t)
+			tt.setupUsecases(mockComments)
+
+			server := &TodoAppServer{
+				CommentsUseCase: mockComments,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/todos/"+commentTodoID.String()+"/comments", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.CreateTodoComment(w, req, commentTodoID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.Comment
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, restComment, response)
+		})
+	}
+}
+
+func TestTodoAppServer_UpdateTodoComment(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockComments)
+		expectedStatus int
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Update(mock.Anything, commentTodoID, commentID, "Waiting on the vendor quote").
+					Return(domainComment, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		"assistant-comment": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().
+					Update(mock.Anything, commentTodoID, commentID, "Waiting on the vendor quote").
+					Return(todo.Comment{}, core.NewValidationErr("only user comments can be edited"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "only user comments can be edited"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockComments := todouc.NewMockComments(t)
+			tt.setupUsecases(mockComments)
+
+			server := &TodoAppServer{
+				CommentsUseCase: mockComments,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			body := serializeJSON(t, gen.UpdateTodoCommentJSONRequestBody{Body: "Waiting on the vendor quote"})
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/todos/"+commentTodoID.String()+"/comments/"+commentID.String(), bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			server.UpdateTodoComment(w, req, commentTodoID, commentID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.Comment
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, restComment, response)
+		})
+	}
+}
+
+func TestTodoAppServer_DeleteTodoComment(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockComments)
+		expectedStatus int
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().Delete(mock.Anything, commentTodoID, commentID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"use-case-error": {
+			setupUsecases: func(m *todouc.MockComments) {
+				m.EXPECT().Delete(mock.Anything, commentTodoID, commentID).Return(errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.INTERNALERROR, Message: "internal server error"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockComments := todouc.NewMockComments(t)
+			tt.setupUsecases(mockComments)
+
+			server := &TodoAppServer{
+				CommentsUseCase: mockComments,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/todos/"+commentTodoID.String()+"/comments/"+commentID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.DeleteTodoComment(w, req, commentTodoID, commentID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// configReloadAdminPath is the path the configuration reload admin endpoint is mounted on.
+const configReloadAdminPath = "/admin/config/reload"
+
+// configReloadJSON is the admin API representation of a configuration reload.
+type configReloadJSON struct {
+	Applied  []string `json:"applied"`
+	Rejected []string `json:"rejected"`
+}
+
+// configReloadHandler serves the configuration reload admin endpoint:
+//
+//	POST /admin/config/reload  re-reads the configuration and reports the subsystems that applied it
+//	                           and the reasons the others rejected it
+type configReloadHandler struct {
+	Logger   *log.Logger
+	Reloader core.ConfigReloader
+}
+
+// ServeHTTP implements http.Handler.
+func (h configReloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	applied, err := h.Reloader.Reload(r.Context())
+	resp := configReloadJSON{
+		Applied:  append([]string{}, applied...),
+		Rejected: []string{},
+	}
+	if err != nil {
+		h.Logger.Printf("Configuration reload rejected: %v", err)
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, rejected := range joined.Unwrap() {
+				resp.Rejected = append(resp.Rejected, rejected.Error())
+			}
+		} else {
+			resp.Rejected = append(resp.Rejected, err.Error())
+		}
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
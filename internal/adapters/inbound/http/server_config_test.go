@@ -0,0 +1,111 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConfigReloadHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method          string
+		authHeader      string
+		noToken         bool
+		setExpectations func(*core.MockConfigReloader)
+		expectedStatus  int
+		expectedBody    string
+	}{
+		"applied": {
+			method:     http.MethodPost,
+			authHeader: "Bearer secret",
+			setExpectations: func(r *core.MockConfigReloader) {
+				r.EXPECT().Reload(mock.Anything).Return([]string{"llm_limiter", "shadow_evaluation"}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":["llm_limiter","shadow_evaluation"],"rejected":[]}`,
+		},
+		"partially-rejected": {
+			method:     http.MethodPost,
+			authHeader: "Bearer secret",
+			setExpectations: func(r *core.MockConfigReloader) {
+				r.EXPECT().Reload(mock.Anything).Return([]string{"shadow_evaluation"}, errors.Join(
+					fmt.Errorf("llm_limiter: %w", errors.New("invalid llm concurrency limits")),
+				)).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":["shadow_evaluation"],"rejected":["llm_limiter: invalid llm concurrency limits"]}`,
+		},
+		"nothing-registered": {
+			method:     http.MethodPost,
+			authHeader: "Bearer secret",
+			setExpectations: func(r *core.MockConfigReloader) {
+				r.EXPECT().Reload(mock.Anything).Return(nil, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":[],"rejected":[]}`,
+		},
+		"no-token-configured": {
+			method: http.MethodPost,
+			// API principals guard the endpoint instead of the admin token.
+			noToken: true,
+			setExpectations: func(r *core.MockConfigReloader) {
+				r.EXPECT().Reload(mock.Anything).Return([]string{"llm_limiter"}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"applied":["llm_limiter"],"rejected":[]}`,
+		},
+		"missing-token": {
+			method:          http.MethodPost,
+			setExpectations: func(*core.MockConfigReloader) {},
+			expectedStatus:  http.StatusUnauthorized,
+			expectedBody:    `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"method-not-allowed": {
+			method:          http.MethodGet,
+			authHeader:      "Bearer secret",
+			setExpectations: func(*core.MockConfigReloader) {},
+			expectedStatus:  http.StatusMethodNotAllowed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reloader := core.NewMockConfigReloader(t)
+			tt.setExpectations(reloader)
+
+			handler := configReloadHandler{
+				Logger:   log.New(io.Discard, "", 0),
+				Reloader: reloader,
+			}
+			token := "secret"
+			if tt.noToken {
+				token = ""
+			}
+
+			req := httptest.NewRequest(tt.method, configReloadAdminPath, nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			adminTokenMiddleware(token)(handler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"go.opentelemetry.io/otel/trace"
@@ -27,12 +30,25 @@ func (api TodoAppServer) ListConversations(w http.ResponseWriter, r *http.Reques
 		Page:          params.Page,
 	}
 
+	conversationIDs := make([]uuid.UUID, len(conversations))
+	for i, c := range conversations {
+		conversationIDs[i] = c.ID
+	}
+	unreadByConversationID, err := api.CountUnreadMessagesUseCase.Query(ctx, conversationIDs)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error counting unread messages: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
 	for i, c := range conversations {
 		resp.Conversations[i] = toConversationProjection(
 			c,
 			usageByConversationID[c.ID],
 			api.ContextCompactionTriggerTokens,
 		)
+		unread := unreadByConversationID[c.ID]
+		resp.Conversations[i].UnreadCount = &unread
 	}
 	if hasMore {
 		nextPage := params.Page + 1
@@ -60,6 +76,86 @@ func (api TodoAppServer) DeleteConversation(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// MarkConversationRead marks a conversation read for the caller.
+// (POST /api/v1/conversations/{conversation_id}/read)
+func (api TodoAppServer) MarkConversationRead(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID) {
+	var req gen.MarkConversationReadJSONRequestBody
+	// The body is optional: an empty one marks every message of the conversation read.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.BADREQUEST,
+				Message: "invalid request body",
+			},
+		})
+		return
+	}
+
+	ctx := r.Context()
+	err := api.MarkConversationReadUseCase.Execute(ctx, conversationId, req.MessageId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error marking conversation read: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PostSystemMessage posts the message of a background job into a conversation.
+// (POST /api/v1/conversations/{conversation_id}/system-messages)
+func (api TodoAppServer) PostSystemMessage(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID) {
+	var req gen.PostSystemMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.BADREQUEST,
+				Message: "invalid request body",
+			},
+		})
+		return
+	}
+
+	systemMessage := chat.SystemMessage{
+		ConversationID: conversationId,
+		Content:        req.Content,
+	}
+	if req.Source != nil {
+		systemMessage.Source = *req.Source
+	}
+
+	ctx := r.Context()
+	message, err := api.PostSystemMessageUseCase.Execute(ctx, systemMessage)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error posting system message: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toChatMessage(message))
+}
+
+// ListRelatedConversations lists the conversations about the same topic as a conversation.
+// (GET /api/v1/conversations/related/{conversation_id})
+func (api TodoAppServer) ListRelatedConversations(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID) {
+	ctx := r.Context()
+	related, err := api.RelatedConversationsUseCase.List(ctx, conversationId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing related conversations: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.RelatedConversationListResp{
+		Items: make([]gen.RelatedConversation, len(related)),
+	}
+	for i, conversation := range related {
+		resp.Items[i] = toRelatedConversation(conversation)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
 // UpdateConversation updates a conversation.
 // (PATCH /api/v1/conversations/{conversation_id})
 func (api TodoAppServer) UpdateConversation(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID) {
@@ -74,8 +170,14 @@ func (api TodoAppServer) UpdateConversation(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	update := chat.ConversationUpdate{Title: req.Title}
+	if req.Settings != nil {
+		settings := fromConversationSettings(*req.Settings)
+		update.Settings = &settings
+	}
+
 	ctx := r.Context()
-	updatedConversation, err := api.UpdateConversationUseCase.Execute(ctx, conversationId, req.Title)
+	updatedConversation, err := api.UpdateConversationUseCase.Execute(ctx, conversationId, update)
 	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
 		api.Logger.Printf("Error updating conversation: %v", err)
 		respondError(w, toError(err))
@@ -100,3 +202,31 @@ func (api TodoAppServer) UpdateConversation(w http.ResponseWriter, r *http.Reque
 		),
 	)
 }
+
+// GetConversationTurn returns the full transcript of a conversation turn.
+// (GET /api/v1/conversations/{conversation_id}/turns/{turn_id})
+func (api TodoAppServer) GetConversationTurn(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, turnId openapi_types.UUID) {
+	ctx := r.Context()
+	transcript, err := api.GetTurnTranscriptUseCase.Query(ctx, conversationId, turnId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error getting conversation turn: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTurnTranscript(transcript))
+}
+
+// ReplayConversationTurn re-runs a past conversation turn with its recorded model and seed.
+// (POST /api/v1/conversations/{conversation_id}/turns/{turn_id}/replay)
+func (api TodoAppServer) ReplayConversationTurn(w http.ResponseWriter, r *http.Request, conversationId openapi_types.UUID, turnId openapi_types.UUID) {
+	ctx := r.Context()
+	replay, err := api.ReplayTurnUseCase.Execute(ctx, conversationId, turnId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error replaying conversation turn: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTurnReplay(replay))
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// conversationUnreadEventName is the SSE event name of unread count updates.
+const conversationUnreadEventName = "conversation.unread"
+
+// StreamConversationEvents streams the unread counts of conversations that background work adds messages to.
+// (GET /api/v1/conversations/events)
+func (api TodoAppServer) StreamConversationEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.INTERNALERROR,
+				Message: "streaming not supported",
+			},
+		})
+		return
+	}
+
+	events, unsubscribe := api.ChatMessageEventStream.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(todoEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx, untrack := api.trackSessionStream(r.Context())
+	defer untrack()
+	tenantID := tenant.IDFromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			// The stream carries the events of every tenant; only forward the caller's.
+			if event.TenantID != tenantID {
+				continue
+			}
+			// The count is the caller's: each reader keeps its own cursor on the conversation.
+			unread, err := api.CountUnreadMessagesUseCase.Query(ctx, []uuid.UUID{event.ConversationID})
+			if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+				api.Logger.Printf("StreamConversationEvents: failed to count unread messages: %v", err)
+				continue
+			}
+			data, err := json.Marshal(gen.ConversationUnreadEvent{
+				ConversationId: event.ConversationID,
+				MessageId:      event.ChatMessageID,
+				UnreadCount:    unread[event.ConversationID],
+				CreatedAt:      event.CreatedAt,
+			})
+			if err != nil {
+				api.Logger.Printf("StreamConversationEvents: failed to encode event: %v", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", conversationUnreadEventName, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTodoAppServer_StreamConversationEvents(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f")
+	messageID := uuid.MustParse("0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1")
+	createdAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	event := outbox.ChatMessageEvent{
+		Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+		ChatMessageID:  messageID,
+		ConversationID: conversationID,
+		CreatedAt:      createdAt,
+		Background:     true,
+		TenantID:       tenant.Default,
+	}
+	otherTenantEvent := event
+	otherTenantEvent.TenantID = "acme"
+
+	tests := map[string]struct {
+		events          []outbox.ChatMessageEvent
+		setExpectations func(uc *chat.MockCountUnreadMessages)
+		expectedEvents  []string
+		unexpected      []string
+	}{
+		"streams-unread-counts": {
+			events: []outbox.ChatMessageEvent{event},
+			setExpectations: func(uc *chat.MockCountUnreadMessages) {
+				uc.EXPECT().Query(mock.Anything, []uuid.UUID{conversationID}).
+					Return(map[uuid.UUID]int64{conversationID: 2}, nil).Once()
+			},
+			expectedEvents: []string{
+				"event: conversation.unread\ndata: {\"conversation_id\":\"4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f\",\"created_at\":\"2026-10-16T09:00:00Z\",\"message_id\":\"0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1\",\"unread_count\":2}\n\n",
+			},
+		},
+		"skips-other-tenant-events": {
+			events:     []outbox.ChatMessageEvent{otherTenantEvent},
+			unexpected: []string{"event:"},
+		},
+		"skips-events-failing-to-count": {
+			events: []outbox.ChatMessageEvent{event},
+			setExpectations: func(uc *chat.MockCountUnreadMessages) {
+				uc.EXPECT().Query(mock.Anything, []uuid.UUID{conversationID}).Return(nil, errors.New("database error")).Once()
+			},
+			unexpected: []string{"event:"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stream := outbox.NewMockChatMessageEventStream(t)
+			events := make(chan outbox.ChatMessageEvent, len(tt.events))
+			for _, e := range tt.events {
+				events <- e
+			}
+			close(events)
+
+			unsubscribed := false
+			stream.EXPECT().Subscribe().Return(events, func() { unsubscribed = true }).Once()
+
+			unreadUC := chat.NewMockCountUnreadMessages(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(unreadUC)
+			}
+
+			server := &TodoAppServer{
+				ChatMessageEventStream:     stream,
+				CountUnreadMessagesUseCase: unreadUC,
+				Logger:                     log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/conversations/events", nil)
+			w := newMockFlusherRecorder()
+
+			server.StreamConversationEvents(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			body := w.Body.String()
+			for _, e := range tt.expectedEvents {
+				assert.Contains(t, body, e)
+			}
+			for _, e := range tt.unexpected {
+				assert.NotContains(t, body, e)
+			}
+			assert.True(t, unsubscribed)
+		})
+	}
+}
+
+func TestTodoAppServer_StreamConversationEvents_ClientDisconnect(t *testing.T) {
+	t.Parallel()
+
+	stream := outbox.NewMockChatMessageEventStream(t)
+	events := make(chan outbox.ChatMessageEvent)
+	stream.EXPECT().Subscribe().Return(events, func() {}).Once()
+
+	server := &TodoAppServer{
+		ChatMessageEventStream: stream,
+		Logger:                 log.New(io.Discard, "", 0),
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/conversations/events", nil).WithContext(ctx)
+	w := newMockFlusherRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		server.StreamConversationEvents(w, req)
+		close(done)
+	}()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("stream did not stop after client disconnect")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
@@ -83,6 +84,78 @@ func TestTodoAppServer_DeleteConversation(t *testing.T) {
 	}
 }
 
+func TestTodoAppServer_ListRelatedConversations(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	relatedID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	lastActiveAt := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupUsecases  func(*chat.MockRelatedConversations)
+		expectedStatus int
+		expectedBody   *gen.RelatedConversationListResp
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *chat.MockRelatedConversations) {
+				m.EXPECT().List(mock.Anything, conversationID).Return([]assistant.RelatedConversation{{
+					ConversationID: relatedID,
+					Title:          "Spring cleaning",
+					Similarity:     0.82,
+					LastActiveAt:   lastActiveAt,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.RelatedConversationListResp{Items: []gen.RelatedConversation{{
+				ConversationId: relatedID,
+				Title:          "Spring cleaning",
+				Similarity:     0.82,
+				LastActiveAt:   lastActiveAt,
+			}}},
+		},
+		"not-found": {
+			setupUsecases: func(m *chat.MockRelatedConversations) {
+				m.EXPECT().List(mock.Anything, conversationID).Return(nil, core.NewNotFoundErr("conversation not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.NOTFOUND, Message: "conversation not found"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockRelatedConversations := chat.NewMockRelatedConversations(t)
+			tt.setupUsecases(mockRelatedConversations)
+
+			server := &TodoAppServer{
+				RelatedConversationsUseCase: mockRelatedConversations,
+				Logger:                      log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/conversations/related/"+conversationID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.ListRelatedConversations(w, req, conversationID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.RelatedConversationListResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
 func TestTodoAppServer_UpdateConversation(t *testing.T) {
 	t.Parallel()
 
@@ -97,13 +170,37 @@ func TestTodoAppServer_UpdateConversation(t *testing.T) {
 		setExpectations      func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository)
 		expectedStatusCode   int
 		expectedResponseBody any
+		expectedSettings     *gen.ConversationSettings
 		expectedErr          bool
 	}{
+		"success-update-settings": {
+			conversationID: openapi_types.UUID(fixedUUID),
+			requestBody: serializeJSON(t, gen.UpdateConversationRequest{
+				Settings: &gen.ConversationSettings{Temperature: common.Ptr(0.9), Model: common.Ptr("ai/qwen3")},
+			}),
+			setExpectations: func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository) {
+				settings := assistant.ConversationSettings{Temperature: common.Ptr(0.9), Model: "ai/qwen3"}
+				uc.EXPECT().Execute(mock.Anything, fixedUUID, chat.ConversationUpdate{Settings: &settings}).Return(
+					assistant.Conversation{
+						ID:          fixedUUID,
+						Title:       newTitle,
+						TitleSource: assistant.ConversationTitleSource_User,
+						Settings:    settings,
+						CreatedAt:   fixedTime,
+						UpdatedAt:   fixedTime,
+					}, nil)
+				repo.EXPECT().
+					GetConversationContextTokenUsage(mock.Anything, []uuid.UUID{fixedUUID}).
+					Return(map[uuid.UUID]int64{fixedUUID: 84}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedSettings:   &gen.ConversationSettings{Temperature: common.Ptr(0.9), Model: common.Ptr("ai/qwen3")},
+		},
 		"success-update-title": {
 			conversationID: openapi_types.UUID(fixedUUID),
-			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: newTitle}),
+			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: &newTitle}),
 			setExpectations: func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository) {
-				uc.EXPECT().Execute(mock.Anything, fixedUUID, newTitle).Return(
+				uc.EXPECT().Execute(mock.Anything, fixedUUID, chat.ConversationUpdate{Title: &newTitle}).Return(
 					assistant.Conversation{
 						ID:          fixedUUID,
 						Title:       newTitle,
@@ -134,9 +231,9 @@ func TestTodoAppServer_UpdateConversation(t *testing.T) {
 		},
 		"conversation-not-found": {
 			conversationID: openapi_types.UUID(fixedUUID),
-			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: newTitle}),
+			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: &newTitle}),
 			setExpectations: func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository) {
-				uc.EXPECT().Execute(mock.Anything, fixedUUID, newTitle).Return(
+				uc.EXPECT().Execute(mock.Anything, fixedUUID, chat.ConversationUpdate{Title: &newTitle}).Return(
 					assistant.Conversation{},
 					core.NewNotFoundErr("conversation not found"))
 			},
@@ -145,9 +242,9 @@ func TestTodoAppServer_UpdateConversation(t *testing.T) {
 		},
 		"validation-error": {
 			conversationID: openapi_types.UUID(fixedUUID),
-			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: ""}),
+			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: common.Ptr("")}),
 			setExpectations: func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository) {
-				uc.EXPECT().Execute(mock.Anything, fixedUUID, "").Return(
+				uc.EXPECT().Execute(mock.Anything, fixedUUID, chat.ConversationUpdate{Title: common.Ptr("")}).Return(
 					assistant.Conversation{},
 					core.NewValidationErr("conversation title cannot be empty"))
 			},
@@ -156,9 +253,9 @@ func TestTodoAppServer_UpdateConversation(t *testing.T) {
 		},
 		"use-case-error": {
 			conversationID: openapi_types.UUID(fixedUUID),
-			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: newTitle}),
+			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: &newTitle}),
 			setExpectations: func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository) {
-				uc.EXPECT().Execute(mock.Anything, fixedUUID, newTitle).Return(
+				uc.EXPECT().Execute(mock.Anything, fixedUUID, chat.ConversationUpdate{Title: &newTitle}).Return(
 					assistant.Conversation{},
 					errors.New("internal server error"))
 			},
@@ -167,9 +264,9 @@ func TestTodoAppServer_UpdateConversation(t *testing.T) {
 		},
 		"context-token-usage-error": {
 			conversationID: openapi_types.UUID(fixedUUID),
-			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: newTitle}),
+			requestBody:    serializeJSON(t, gen.UpdateConversationRequest{Title: &newTitle}),
 			setExpectations: func(uc *chat.MockUpdateConversation, repo *assistant.MockConversationRepository) {
-				uc.EXPECT().Execute(mock.Anything, fixedUUID, newTitle).Return(
+				uc.EXPECT().Execute(mock.Anything, fixedUUID, chat.ConversationUpdate{Title: &newTitle}).Return(
 					assistant.Conversation{
 						ID:          fixedUUID,
 						Title:       newTitle,
@@ -213,6 +310,7 @@ func TestTodoAppServer_UpdateConversation(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, int64(contextCompactionTriggerTokens), resp.ContextCompactionTriggerTokens)
 				assert.Equal(t, int64(84), resp.TotalTokensUsed)
+				assert.Equal(t, tt.expectedSettings, resp.Settings)
 			}
 			mockUC.AssertExpectations(t)
 		})
@@ -224,11 +322,13 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	contextCompactionTriggerTokens := 8000
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
 
 	tests := map[string]struct {
 		page                int
 		pageSize            int
-		setExpectations     func(uc *chat.MockListConversations)
+		setExpectations     func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages)
+		expectedUnread      map[uuid.UUID]int64
 		expectedStatusCode  int
 		expectedHasNextPage bool
 		expectedHasPrevPage bool
@@ -237,18 +337,19 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 		"success-first-page": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(uc *chat.MockListConversations) {
+			setExpectations: func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages) {
 				uc.EXPECT().Query(mock.Anything, 1, 10).Return([]assistant.Conversation{
 					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+						ID:          conversationID,
 						Title:       "Conversation 1",
 						TitleSource: assistant.ConversationTitleSource_User,
 						CreatedAt:   fixedTime,
 						UpdatedAt:   fixedTime,
 					},
 				}, map[uuid.UUID]int64{
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 55,
+					conversationID: 55,
 				}, true, nil)
+				unreadUC.EXPECT().Query(mock.Anything, []uuid.UUID{conversationID}).Return(map[uuid.UUID]int64{}, nil)
 			},
 			expectedStatusCode:  http.StatusOK,
 			expectedHasNextPage: true,
@@ -258,18 +359,19 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 		"success-middle-page": {
 			page:     2,
 			pageSize: 10,
-			setExpectations: func(uc *chat.MockListConversations) {
+			setExpectations: func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages) {
 				uc.EXPECT().Query(mock.Anything, 2, 10).Return([]assistant.Conversation{
 					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+						ID:          conversationID,
 						Title:       "Conversation 2",
 						TitleSource: assistant.ConversationTitleSource_LLM,
 						CreatedAt:   fixedTime,
 						UpdatedAt:   fixedTime,
 					},
 				}, map[uuid.UUID]int64{
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 77,
+					conversationID: 77,
 				}, true, nil)
+				unreadUC.EXPECT().Query(mock.Anything, []uuid.UUID{conversationID}).Return(map[uuid.UUID]int64{}, nil)
 			},
 			expectedStatusCode:  http.StatusOK,
 			expectedHasNextPage: true,
@@ -279,19 +381,21 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 		"success-last-page": {
 			page:     3,
 			pageSize: 10,
-			setExpectations: func(uc *chat.MockListConversations) {
+			setExpectations: func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages) {
 				uc.EXPECT().Query(mock.Anything, 3, 10).Return([]assistant.Conversation{
 					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+						ID:          conversationID,
 						Title:       "Conversation 3",
 						TitleSource: assistant.ConversationTitleSource_Auto,
 						CreatedAt:   fixedTime,
 						UpdatedAt:   fixedTime,
 					},
 				}, map[uuid.UUID]int64{
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 12,
+					conversationID: 12,
 				}, false, nil)
+				unreadUC.EXPECT().Query(mock.Anything, []uuid.UUID{conversationID}).Return(map[uuid.UUID]int64{conversationID: 2}, nil)
 			},
+			expectedUnread:      map[uuid.UUID]int64{conversationID: 2},
 			expectedStatusCode:  http.StatusOK,
 			expectedHasNextPage: false,
 			expectedHasPrevPage: true,
@@ -300,18 +404,30 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 		"success-empty-list": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(uc *chat.MockListConversations) {
+			setExpectations: func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages) {
 				uc.EXPECT().Query(mock.Anything, 1, 10).Return([]assistant.Conversation{}, map[uuid.UUID]int64{}, false, nil)
+				unreadUC.EXPECT().Query(mock.Anything, []uuid.UUID{}).Return(map[uuid.UUID]int64{}, nil)
 			},
 			expectedStatusCode:  http.StatusOK,
 			expectedHasNextPage: false,
 			expectedHasPrevPage: false,
 			expectedErr:         false,
 		},
+		"unread-count-error": {
+			page:     1,
+			pageSize: 10,
+			setExpectations: func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages) {
+				uc.EXPECT().Query(mock.Anything, 1, 10).Return([]assistant.Conversation{
+					{ID: conversationID, Title: "Conversation 1", CreatedAt: fixedTime, UpdatedAt: fixedTime},
+				}, map[uuid.UUID]int64{}, false, nil)
+				unreadUC.EXPECT().Query(mock.Anything, []uuid.UUID{conversationID}).Return(nil, errors.New("database error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
 		"use-case-error": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(uc *chat.MockListConversations) {
+			setExpectations: func(uc *chat.MockListConversations, unreadUC *chat.MockCountUnreadMessages) {
 				uc.EXPECT().Query(mock.Anything, 1, 10).Return(nil, nil, false, errors.New("database error"))
 			},
 			expectedStatusCode:  http.StatusInternalServerError,
@@ -324,12 +440,14 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			mockUC := chat.NewMockListConversations(t)
+			mockUnreadUC := chat.NewMockCountUnreadMessages(t)
 			if tt.setExpectations != nil {
-				tt.setExpectations(mockUC)
+				tt.setExpectations(mockUC, mockUnreadUC)
 			}
 
 			server := TodoAppServer{
 				ListConversationsUseCase:       mockUC,
+				CountUnreadMessagesUseCase:     mockUnreadUC,
 				Logger:                         log.New(io.Discard, "", 0),
 				ContextCompactionTriggerTokens: contextCompactionTriggerTokens,
 			}
@@ -367,8 +485,355 @@ func TestTodoAppServer_ListConversations(t *testing.T) {
 
 				for _, conversation := range resp.Conversations {
 					assert.Equal(t, int64(contextCompactionTriggerTokens), conversation.ContextCompactionTriggerTokens)
+					assert.Equal(t, common.Ptr(tt.expectedUnread[conversation.Id]), conversation.UnreadCount)
 				}
 			}
 		})
 	}
 }
+
+func TestTodoAppServer_GetConversationTurn(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	messageID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		setupUsecases  func(*chat.MockGetTurnTranscript)
+		expectedStatus int
+		expectedBody   *gen.TurnTranscript
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *chat.MockGetTurnTranscript) {
+				m.EXPECT().
+					Query(mock.Anything, conversationID, turnID).
+					Return(chat.TurnTranscript{
+						ConversationID: conversationID,
+						TurnID:         turnID,
+						Steps: []chat.TurnTranscriptStep{
+							{
+								Message: assistant.ChatMessage{
+									ID:           messageID,
+									TurnID:       turnID,
+									TurnSequence: 1,
+									ChatRole:     assistant.ChatRole_Assistant,
+									ActionCalls:  []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos", Input: "{}"}},
+									Model:        "test-model",
+									MessageState: assistant.ChatMessageState_Completed,
+									PromptTokens: 100,
+									TotalTokens:  110,
+									CreatedAt:    start.Add(200 * time.Millisecond),
+									UpdatedAt:    start.Add(900 * time.Millisecond),
+								},
+								Offset:   200 * time.Millisecond,
+								Duration: 700 * time.Millisecond,
+							},
+						},
+						Usage:       assistant.Usage{PromptTokens: 100, TotalTokens: 110},
+						StartedAt:   start,
+						CompletedAt: start.Add(2 * time.Second),
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.TurnTranscript{
+				ConversationId: conversationID,
+				TurnId:         turnID,
+				Steps: []gen.TurnTranscriptStep{
+					{
+						Id:           messageID,
+						TurnSequence: 1,
+						Role:         gen.Assistant,
+						ActionCalls:  &[]gen.TurnTranscriptActionCall{{Id: "call-1", Name: "fetch_todos", Input: "{}"}},
+						Model:        common.Ptr("test-model"),
+						MessageState: gen.COMPLETED,
+						PromptTokens: 100,
+						TotalTokens:  110,
+						OffsetMs:     200,
+						DurationMs:   700,
+						CreatedAt:    start.Add(200 * time.Millisecond),
+						UpdatedAt:    start.Add(900 * time.Millisecond),
+					},
+				},
+				PromptTokens: 100,
+				TotalTokens:  110,
+				StartedAt:    start,
+				CompletedAt:  start.Add(2 * time.Second),
+				DurationMs:   2000,
+			},
+		},
+		"turn-not-found": {
+			setupUsecases: func(m *chat.MockGetTurnTranscript) {
+				m.EXPECT().
+					Query(mock.Anything, conversationID, turnID).
+					Return(chat.TurnTranscript{}, core.NewNotFoundErr("turn not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.NOTFOUND,
+					Message: "turn not found",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockGetTurnTranscript := chat.NewMockGetTurnTranscript(t)
+			tt.setupUsecases(mockGetTurnTranscript)
+
+			server := &TodoAppServer{
+				GetTurnTranscriptUseCase: mockGetTurnTranscript,
+				Logger:                   log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/conversations/"+conversationID.String()+"/turns/"+turnID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.GetConversationTurn(w, req, conversationID, turnID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response gen.TurnTranscript
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_ReplayConversationTurn(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	tests := map[string]struct {
+		setupUsecases  func(*chat.MockReplayTurn)
+		expectedStatus int
+		expectedBody   *gen.TurnReplay
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *chat.MockReplayTurn) {
+				m.EXPECT().
+					Execute(mock.Anything, conversationID, turnID).
+					Return(chat.TurnReplay{
+						TurnID:              turnID,
+						Model:               "test-model",
+						Seed:                common.Ptr[int64](7),
+						OriginalActionCalls: []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos", Input: "{}"}},
+						ReplayedContent:     "Which todos?",
+						Usage:               assistant.Usage{TotalTokens: 12},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.TurnReplay{
+				TurnId:              turnID,
+				Model:               "test-model",
+				Seed:                common.Ptr[int64](7),
+				OriginalActionCalls: []gen.TurnReplayActionCall{{Name: "fetch_todos", Input: "{}"}},
+				ReplayedContent:     "Which todos?",
+				ReplayedActionCalls: []gen.TurnReplayActionCall{},
+				TotalTokens:         12,
+			},
+		},
+		"turn-not-found": {
+			setupUsecases: func(m *chat.MockReplayTurn) {
+				m.EXPECT().
+					Execute(mock.Anything, conversationID, turnID).
+					Return(chat.TurnReplay{}, core.NewNotFoundErr("turn not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.NOTFOUND,
+					Message: "turn not found",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReplayTurn := chat.NewMockReplayTurn(t)
+			tt.setupUsecases(mockReplayTurn)
+
+			server := &TodoAppServer{
+				ReplayTurnUseCase: mockReplayTurn,
+				Logger:            log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/conversations/"+conversationID.String()+"/turns/"+turnID.String()+"/replay", nil)
+			w := httptest.NewRecorder()
+
+			server.ReplayConversationTurn(w, req, conversationID, turnID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response gen.TurnReplay
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_MarkConversationRead(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	messageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+
+	tests := map[string]struct {
+		body               string
+		setExpectations    func(uc *chat.MockMarkConversationRead)
+		expectedStatusCode int
+	}{
+		"marks-latest-message": {
+			setExpectations: func(uc *chat.MockMarkConversationRead) {
+				uc.EXPECT().Execute(mock.Anything, conversationID, (*uuid.UUID)(nil)).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		"marks-given-message": {
+			body: `{"message_id":"` + messageID.String() + `"}`,
+			setExpectations: func(uc *chat.MockMarkConversationRead) {
+				uc.EXPECT().Execute(mock.Anything, conversationID, &messageID).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		"invalid-body": {
+			body:               `{"message_id":`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"not-found": {
+			body: `{"message_id":"` + messageID.String() + `"}`,
+			setExpectations: func(uc *chat.MockMarkConversationRead) {
+				uc.EXPECT().Execute(mock.Anything, conversationID, &messageID).Return(core.NewNotFoundErr("message not found")).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"use-case-error": {
+			setExpectations: func(uc *chat.MockMarkConversationRead) {
+				uc.EXPECT().Execute(mock.Anything, conversationID, (*uuid.UUID)(nil)).Return(errors.New("database error")).Once()
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := chat.NewMockMarkConversationRead(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(mockUC)
+			}
+
+			server := TodoAppServer{
+				MarkConversationReadUseCase: mockUC,
+				Logger:                      log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/conversations/"+conversationID.String()+"/read", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			server.MarkConversationRead(w, req, conversationID)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestTodoAppServer_PostSystemMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	messageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		body               string
+		setExpectations    func(uc *chat.MockPostSystemMessage)
+		expectedStatusCode int
+		expectedContent    string
+	}{
+		"posts-message": {
+			body: `{"content":"Pay rent is due tomorrow.","source":"reminders"}`,
+			setExpectations: func(uc *chat.MockPostSystemMessage) {
+				uc.EXPECT().Execute(mock.Anything, chat.SystemMessage{
+					ConversationID: conversationID,
+					Content:        "Pay rent is due tomorrow.",
+					Source:         "reminders",
+				}).Return(assistant.ChatMessage{
+					ID:             messageID,
+					ConversationID: conversationID,
+					ChatRole:       assistant.ChatRole_Assistant,
+					Content:        "Pay rent is due tomorrow.",
+					CreatedAt:      createdAt,
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedContent:    "Pay rent is due tomorrow.",
+		},
+		"invalid-body": {
+			body:               `{"content":`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"conversation-not-found": {
+			body: `{"content":"Hello"}`,
+			setExpectations: func(uc *chat.MockPostSystemMessage) {
+				uc.EXPECT().Execute(mock.Anything, chat.SystemMessage{ConversationID: conversationID, Content: "Hello"}).
+					Return(assistant.ChatMessage{}, core.NewNotFoundErr("conversation not found")).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockUC := chat.NewMockPostSystemMessage(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(mockUC)
+			}
+
+			server := TodoAppServer{
+				PostSystemMessageUseCase: mockUC,
+				Logger:                   log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/conversations/"+conversationID.String()+"/system-messages", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			server.PostSystemMessage(w, req, conversationID)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedContent != "" {
+				var resp gen.ChatMessage
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, messageID, resp.Id)
+				assert.Equal(t, tt.expectedContent, resp.Content)
+			}
+		})
+	}
+}
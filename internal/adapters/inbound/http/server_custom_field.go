@@ -0,0 +1,78 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListCustomFields lists the custom fields defined by the tenant
+// (GET /api/v1/custom-fields)
+func (api TodoAppServer) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	fields, err := api.CustomFieldsUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing custom fields: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListCustomFieldsResp{
+		Items: make([]gen.CustomField, len(fields)),
+	}
+	for i, f := range fields {
+		resp.Items[i] = toCustomField(f)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// DefineCustomField creates a custom field or updates the options of an existing one
+// (PUT /api/v1/custom-fields/{name})
+func (api TodoAppServer) DefineCustomField(w http.ResponseWriter, r *http.Request, name string) {
+	var req gen.DefineCustomFieldJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	field := todo.CustomField{
+		Name: name,
+		Type: todo.CustomFieldType(req.Type),
+	}
+	if req.Options != nil {
+		field.Options = *req.Options
+	}
+
+	ctx := r.Context()
+	defined, err := api.CustomFieldsUseCase.Define(ctx, field)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error defining custom field: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toCustomField(defined))
+}
+
+// DeleteCustomField deletes a custom field and its values from every todo
+// (DELETE /api/v1/custom-fields/{name})
+func (api TodoAppServer) DeleteCustomField(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	err := api.CustomFieldsUseCase.Delete(ctx, name)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error deleting custom field: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
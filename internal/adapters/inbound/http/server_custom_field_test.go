@@ -0,0 +1,177 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var customFieldTime = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func TestTodoAppServer_ListCustomFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockCustomFields)
+		expectedStatus int
+		expectedBody   *gen.ListCustomFieldsResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().List(mock.Anything).Return([]todo.CustomField{
+					{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}, CreatedAt: customFieldTime},
+					{Name: "points", Type: todo.CustomFieldType_NUMBER, CreatedAt: customFieldTime},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListCustomFieldsResp{Items: []gen.CustomField{
+				{Name: "area", Type: gen.ENUM, Options: []string{"home", "work"}, CreatedAt: customFieldTime},
+				{Name: "points", Type: gen.NUMBER, Options: []string{}, CreatedAt: customFieldTime},
+			}},
+		},
+		"internal-error": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCustomFields := todouc.NewMockCustomFields(t)
+			tt.setupUsecases(mockCustomFields)
+
+			server := &TodoAppServer{
+				CustomFieldsUseCase: mockCustomFields,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/custom-fields", nil)
+			w := httptest.NewRecorder()
+
+			server.ListCustomFields(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.ListCustomFieldsResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_DefineCustomField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body           string
+		setupUsecases  func(*todouc.MockCustomFields)
+		expectedStatus int
+		expectedBody   *gen.CustomField
+	}{
+		"success": {
+			body: `{"type":"ENUM","options":["home","work"]}`,
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().
+					Define(mock.Anything, todo.CustomField{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}}).
+					Return(todo.CustomField{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}, CreatedAt: customFieldTime}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.CustomField{Name: "area", Type: gen.ENUM, Options: []string{"home", "work"}, CreatedAt: customFieldTime},
+		},
+		"validation-error": {
+			body: `{"type":"TEXT","options":["home"]}`,
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().
+					Define(mock.Anything, todo.CustomField{Name: "area", Type: todo.CustomFieldType_TEXT, Options: []string{"home"}}).
+					Return(todo.CustomField{}, core.NewValidationErr("only ENUM custom fields can have options"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"invalid-body": {
+			body:           `{`,
+			setupUsecases:  func(*todouc.MockCustomFields) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCustomFields := todouc.NewMockCustomFields(t)
+			tt.setupUsecases(mockCustomFields)
+
+			server := &TodoAppServer{
+				CustomFieldsUseCase: mockCustomFields,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/custom-fields/area", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			server.DefineCustomField(w, req, "area")
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.CustomField
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_DeleteCustomField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockCustomFields)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().Delete(mock.Anything, "area").Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"not-found": {
+			setupUsecases: func(m *todouc.MockCustomFields) {
+				m.EXPECT().Delete(mock.Anything, "area").Return(core.NewNotFoundErr("custom field area not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCustomFields := todouc.NewMockCustomFields(t)
+			tt.setupUsecases(mockCustomFields)
+
+			server := &TodoAppServer{
+				CustomFieldsUseCase: mockCustomFields,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/custom-fields/area", nil)
+			w := httptest.NewRecorder()
+
+			server.DeleteCustomField(w, req, "area")
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
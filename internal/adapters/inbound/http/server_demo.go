@@ -0,0 +1,51 @@
+package http
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// demoBasePath is the path the demo UI is mounted on.
+const demoBasePath = "/demo/"
+
+//go:embed demo/index.html
+var demoAssets embed.FS
+
+// demoHandler serves the demo UI, a single page with the todo board and an assistant chat panel
+// built on the REST API. Unlike the web app, it needs no frontend build, so a locally built binary
+// gives a complete demo.
+type demoHandler struct{}
+
+// ServeHTTP implements http.Handler.
+func (demoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != demoBasePath {
+		http.NotFound(w, r)
+		return
+	}
+
+	content, err := demoAssets.ReadFile("demo/index.html")
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(content)
+	}
+}
+
+// webappBuilt reports whether the web app was built into the binary. Local builds embed an
+// empty webappdist directory.
+func webappBuilt(webapp fs.FS) bool {
+	_, err := fs.Stat(webapp, "index.html")
+	return err == nil
+}
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDemoHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method          string
+		path            string
+		expectedStatus  int
+		expectedContent string
+	}{
+		"page": {
+			method:          http.MethodGet,
+			path:            "/demo/",
+			expectedStatus:  http.StatusOK,
+			expectedContent: "/api/v1/chat",
+		},
+		"head": {
+			method:         http.MethodHead,
+			path:           "/demo/",
+			expectedStatus: http.StatusOK,
+		},
+		"unknown-path": {
+			method:         http.MethodGet,
+			path:           "/demo/app.js",
+			expectedStatus: http.StatusNotFound,
+		},
+		"method-not-allowed": {
+			method:         http.MethodPost,
+			path:           "/demo/",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			demoHandler{}.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+			}
+			assert.Contains(t, rec.Body.String(), tt.expectedContent)
+			if tt.method == http.MethodHead {
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebappBuilt(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, webappBuilt(fstest.MapFS{"empty.txt": {}}))
+	assert.True(t, webappBuilt(fstest.MapFS{"index.html": {Data: []byte("<html></html>")}}))
+}
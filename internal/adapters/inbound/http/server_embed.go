@@ -0,0 +1,73 @@
+package http
+
+import (
+	"embed"
+	"net/http"
+	"strings"
+)
+
+// embedBasePath is the path the embeddable chat widget is mounted on.
+const embedBasePath = "/v1/embed/"
+
+//go:embed embed/chat.js embed/chat.html
+var embedAssets embed.FS
+
+// embedHandler serves the embeddable chat widget:
+//
+//	GET /v1/embed/chat.js  loader script that adds a chat button and opens the host page in an iframe
+//	GET /v1/embed/chat     host page of the iframe, talking to POST /api/v1/chat with the API key of the embedding page
+//
+// The widget is configured with data attributes of the loader script tag; see embed/chat.js.
+// FrameAncestors is the frame-ancestors source list of the host page, e.g. "https://tools.example.com".
+type embedHandler struct {
+	FrameAncestors string
+}
+
+// ServeHTTP implements http.Handler.
+func (h embedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var asset, contentType string
+	switch strings.TrimPrefix(r.URL.Path, embedBasePath) {
+	case "chat.js":
+		asset, contentType = "embed/chat.js", "text/javascript; charset=utf-8"
+		// The loader runs on other origins and is refetched at most every five minutes.
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Header().Set("Cross-Origin-Resource-Policy", "cross-origin")
+	case "chat":
+		asset, contentType = "embed/chat.html", "text/html; charset=utf-8"
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Content-Security-Policy", "frame-ancestors "+h.frameAncestors())
+		w.Header().Set("Referrer-Policy", "no-referrer")
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	content, err := embedAssets.ReadFile(asset)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(content)
+	}
+}
+
+// frameAncestors returns the configured frame-ancestors sources, allowing any page when none are set.
+func (h embedHandler) frameAncestors() string {
+	sources := strings.Join(strings.FieldsFunc(h.FrameAncestors, func(r rune) bool {
+		return r == ',' || r == ' '
+	}), " ")
+	if sources == "" {
+		return "*"
+	}
+	return sources
+}
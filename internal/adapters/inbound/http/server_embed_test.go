@@ -0,0 +1,76 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbedHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method          string
+		path            string
+		frameAncestors  string
+		expectedStatus  int
+		expectedType    string
+		expectedCSP     string
+		expectedContent string
+	}{
+		"loader-script": {
+			method:          http.MethodGet,
+			path:            "/v1/embed/chat.js",
+			expectedStatus:  http.StatusOK,
+			expectedType:    "text/javascript; charset=utf-8",
+			expectedContent: "window.TodoAppChat",
+		},
+		"host-page-any-ancestor": {
+			method:          http.MethodGet,
+			path:            "/v1/embed/chat",
+			expectedStatus:  http.StatusOK,
+			expectedType:    "text/html; charset=utf-8",
+			expectedCSP:     "frame-ancestors *",
+			expectedContent: "/api/v1/chat",
+		},
+		"host-page-configured-ancestors": {
+			method:         http.MethodHead,
+			path:           "/v1/embed/chat",
+			frameAncestors: "https://tools.example.com, https://wiki.example.com",
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/html; charset=utf-8",
+			expectedCSP:    "frame-ancestors https://tools.example.com https://wiki.example.com",
+		},
+		"unknown-asset": {
+			method:         http.MethodGet,
+			path:           "/v1/embed/other.js",
+			expectedStatus: http.StatusNotFound,
+		},
+		"method-not-allowed": {
+			method:         http.MethodPost,
+			path:           "/v1/embed/chat",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			embedHandler{FrameAncestors: tt.frameAncestors}.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedType != "" {
+				assert.Equal(t, tt.expectedType, rec.Header().Get("Content-Type"))
+			}
+			assert.Equal(t, tt.expectedCSP, rec.Header().Get("Content-Security-Policy"))
+			assert.Contains(t, rec.Body.String(), tt.expectedContent)
+			if tt.method == http.MethodHead {
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+}
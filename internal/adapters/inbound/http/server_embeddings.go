@@ -0,0 +1,79 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	domaintodo "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// embeddingBackfillsAdminPath is the path the embedding backfills admin endpoint is mounted on.
+const embeddingBackfillsAdminPath = "/admin/embeddings/backfills"
+
+// embeddingBackfillsJSON is the admin API response listing the embedding backfill runs.
+type embeddingBackfillsJSON struct {
+	Backfills []embeddingBackfillJSON `json:"backfills"`
+}
+
+// embeddingBackfillJSON is the admin API representation of a domaintodo.EmbeddingBackfill.
+type embeddingBackfillJSON struct {
+	Model       string     `json:"model"`
+	Slot        string     `json:"slot"`
+	Total       int        `json:"total"`
+	Processed   int        `json:"processed"`
+	Remaining   int        `json:"remaining"`
+	Completed   bool       `json:"completed"`
+	StartedAt   time.Time  `json:"started_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// embeddingBackfillsHandler serves the embedding backfills admin endpoint:
+//
+//	GET /admin/embeddings/backfills  reports the progress of the latest backfill run of each embedding model
+type embeddingBackfillsHandler struct {
+	Logger   *log.Logger
+	Backfill todo.BackfillEmbeddings
+}
+
+// ServeHTTP implements http.Handler.
+func (h embeddingBackfillsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	backfills, err := h.Backfill.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		h.Logger.Printf("Error listing embedding backfills: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := embeddingBackfillsJSON{Backfills: make([]embeddingBackfillJSON, 0, len(backfills))}
+	for _, b := range backfills {
+		resp.Backfills = append(resp.Backfills, toEmbeddingBackfillJSON(b))
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// toEmbeddingBackfillJSON maps an embedding backfill checkpoint to its admin API representation.
+func toEmbeddingBackfillJSON(b domaintodo.EmbeddingBackfill) embeddingBackfillJSON {
+	return embeddingBackfillJSON{
+		Model:       b.Model,
+		Slot:        string(b.Slot),
+		Total:       b.Total,
+		Processed:   b.Processed,
+		Remaining:   b.Remaining(),
+		Completed:   b.Completed(),
+		StartedAt:   b.StartedAt,
+		UpdatedAt:   b.UpdatedAt,
+		CompletedAt: b.CompletedAt,
+	}
+}
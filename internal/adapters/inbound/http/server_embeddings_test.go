@@ -0,0 +1,109 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domaintodo "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEmbeddingBackfillsHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(time.Hour)
+
+	tests := map[string]struct {
+		method          string
+		authHeader      string
+		noToken         bool
+		setExpectations func(*todo.MockBackfillEmbeddings)
+		expectedStatus  int
+		expectedBody    string
+	}{
+		"in-progress-and-completed-runs": {
+			method:     http.MethodGet,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *todo.MockBackfillEmbeddings) {
+				uc.EXPECT().List(mock.Anything).Return([]domaintodo.EmbeddingBackfill{
+					{Model: "embedding-v2", Slot: domaintodo.EmbeddingSlot_Secondary, Total: 120, Processed: 50, StartedAt: startedAt, UpdatedAt: startedAt},
+					{Model: "embedding-v1", Slot: domaintodo.EmbeddingSlot_Primary, Total: 10, Processed: 10, StartedAt: startedAt, UpdatedAt: completedAt, CompletedAt: &completedAt},
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"backfills":[` +
+				`{"model":"embedding-v2","slot":"secondary","total":120,"processed":50,"remaining":70,"completed":false,"started_at":"2026-03-04T10:00:00Z","updated_at":"2026-03-04T10:00:00Z"},` +
+				`{"model":"embedding-v1","slot":"primary","total":10,"processed":10,"remaining":0,"completed":true,"started_at":"2026-03-04T10:00:00Z","updated_at":"2026-03-04T11:00:00Z","completed_at":"2026-03-04T11:00:00Z"}]}`,
+		},
+		"no-runs": {
+			method: http.MethodGet,
+			// API principals guard the endpoint instead of the admin token.
+			noToken: true,
+			setExpectations: func(uc *todo.MockBackfillEmbeddings) {
+				uc.EXPECT().List(mock.Anything).Return(nil, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"backfills":[]}`,
+		},
+		"missing-token": {
+			method:          http.MethodGet,
+			setExpectations: func(*todo.MockBackfillEmbeddings) {},
+			expectedStatus:  http.StatusUnauthorized,
+			expectedBody:    `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"method-not-allowed": {
+			method:          http.MethodPost,
+			authHeader:      "Bearer secret",
+			setExpectations: func(*todo.MockBackfillEmbeddings) {},
+			expectedStatus:  http.StatusMethodNotAllowed,
+		},
+		"list-error": {
+			method:     http.MethodGet,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *todo.MockBackfillEmbeddings) {
+				uc.EXPECT().List(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"INTERNAL_ERROR","message":"internal server error"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase := todo.NewMockBackfillEmbeddings(t)
+			tt.setExpectations(useCase)
+
+			handler := embeddingBackfillsHandler{
+				Logger:   log.New(io.Discard, "", 0),
+				Backfill: useCase,
+			}
+			token := "secret"
+			if tt.noToken {
+				token = ""
+			}
+
+			req := httptest.NewRequest(tt.method, embeddingBackfillsAdminPath, nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			adminTokenMiddleware(token)(handler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
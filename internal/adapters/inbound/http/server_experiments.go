@@ -0,0 +1,94 @@
+package http
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// experimentsAdminPath is the path the experiments admin endpoint is mounted on.
+const experimentsAdminPath = "/admin/experiments"
+
+// experimentReportJSON is the admin API representation of a chat.ExperimentReport.
+type experimentReportJSON struct {
+	Experiment string               `json:"experiment"`
+	Active     bool                 `json:"active"`
+	Variants   []variantOutcomeJSON `json:"variants"`
+}
+
+// variantOutcomeJSON is the admin API representation of the configuration and outcomes of a variant.
+type variantOutcomeJSON struct {
+	Variant         string   `json:"variant"`
+	Weight          *int     `json:"weight,omitempty"`
+	Model           string   `json:"model,omitempty"`
+	PromptVersion   string   `json:"prompt_version,omitempty"`
+	Temperature     *float64 `json:"temperature,omitempty"`
+	Turns           int      `json:"turns"`
+	FailedTurns     int      `json:"failed_turns"`
+	FailureRate     float64  `json:"failure_rate"`
+	ActionLoopTurns int      `json:"action_loop_turns"`
+	ActionLoopRate  float64  `json:"action_loop_rate"`
+	FeedbackCount   int      `json:"feedback_count"`
+	FeedbackScore   float64  `json:"feedback_score"`
+}
+
+// experimentsHandler serves the experiments admin endpoint:
+//
+//	GET /admin/experiments?name={experiment}  reports the outcomes of each variant of the experiment,
+//	                                          defaulting to the active experiment
+type experimentsHandler struct {
+	Logger *log.Logger
+	Report chat.GetExperimentReport
+}
+
+// ServeHTTP implements http.Handler.
+func (h experimentsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	report, err := h.Report.Query(ctx, r.URL.Query().Get("name"))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		h.Logger.Printf("Error reporting experiment: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toExperimentReportJSON(report))
+}
+
+// toExperimentReportJSON maps an experiment report to its admin API representation.
+func toExperimentReportJSON(report chat.ExperimentReport) experimentReportJSON {
+	resp := experimentReportJSON{
+		Experiment: report.Experiment,
+		Active:     report.Active,
+		Variants:   make([]variantOutcomeJSON, 0, len(report.Variants)),
+	}
+	for _, variant := range report.Variants {
+		outcome := variant.Outcome
+		variantJSON := variantOutcomeJSON{
+			Variant:         outcome.Variant,
+			Turns:           outcome.Turns,
+			FailedTurns:     outcome.FailedTurns,
+			FailureRate:     outcome.FailureRate(),
+			ActionLoopTurns: outcome.ActionLoopTurns,
+			ActionLoopRate:  outcome.ActionLoopRate(),
+			FeedbackCount:   outcome.FeedbackCount,
+			FeedbackScore:   outcome.FeedbackScore,
+		}
+		if config := variant.Config; config != nil {
+			variantJSON.Weight = &config.Weight
+			variantJSON.Model = config.Model
+			variantJSON.PromptVersion = config.PromptVersion
+			variantJSON.Temperature = config.Temperature
+		}
+		resp.Variants = append(resp.Variants, variantJSON)
+	}
+	return resp
+}
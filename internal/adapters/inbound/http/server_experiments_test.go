@@ -0,0 +1,160 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExperimentsHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	concise := assistant.ExperimentVariant{Name: "concise", Weight: 50, PromptVersion: "concise", Temperature: common.Ptr(0.4)}
+
+	tests := map[string]struct {
+		method          string
+		target          string
+		authHeader      string
+		noToken         bool
+		setExpectations func(*chat.MockGetExperimentReport)
+		expectedStatus  int
+		expectedBody    string
+	}{
+		"active-experiment": {
+			method:     http.MethodGet,
+			target:     experimentsAdminPath,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *chat.MockGetExperimentReport) {
+				uc.EXPECT().Query(mock.Anything, "").Return(chat.ExperimentReport{
+					Experiment: "prompt-v2",
+					Active:     true,
+					Variants: []chat.ExperimentVariantReport{
+						{
+							Config: &concise,
+							Outcome: assistant.VariantOutcome{
+								Variant:         "concise",
+								Turns:           8,
+								FailedTurns:     2,
+								ActionLoopTurns: 1,
+								FeedbackCount:   4,
+								FeedbackScore:   0.5,
+							},
+						},
+						{Outcome: assistant.VariantOutcome{Variant: "verbose"}},
+					},
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"experiment":"prompt-v2","active":true,"variants":[` +
+				`{"variant":"concise","weight":50,"prompt_version":"concise","temperature":0.4,"turns":8,"failed_turns":2,"failure_rate":0.25,` +
+				`"action_loop_turns":1,"action_loop_rate":0.125,"feedback_count":4,"feedback_score":0.5},` +
+				`{"variant":"verbose","turns":0,"failed_turns":0,"failure_rate":0,"action_loop_turns":0,"action_loop_rate":0,"feedback_count":0,"feedback_score":0}]}`,
+		},
+		"named-experiment": {
+			method:     http.MethodGet,
+			target:     experimentsAdminPath + "?name=prompt-v1",
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *chat.MockGetExperimentReport) {
+				uc.EXPECT().Query(mock.Anything, "prompt-v1").Return(chat.ExperimentReport{Experiment: "prompt-v1", Variants: []chat.ExperimentVariantReport{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"experiment":"prompt-v1","active":false,"variants":[]}`,
+		},
+		"missing-token": {
+			method:          http.MethodGet,
+			target:          experimentsAdminPath,
+			setExpectations: func(*chat.MockGetExperimentReport) {},
+			expectedStatus:  http.StatusUnauthorized,
+			expectedBody:    `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"wrong-token": {
+			method:          http.MethodGet,
+			target:          experimentsAdminPath,
+			authHeader:      "Bearer nope",
+			setExpectations: func(*chat.MockGetExperimentReport) {},
+			expectedStatus:  http.StatusUnauthorized,
+			expectedBody:    `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"no-token-configured": {
+			method: http.MethodGet,
+			target: experimentsAdminPath + "?name=prompt-v1",
+			// API principals guard the endpoint instead of the admin token.
+			noToken: true,
+			setExpectations: func(uc *chat.MockGetExperimentReport) {
+				uc.EXPECT().Query(mock.Anything, "prompt-v1").Return(chat.ExperimentReport{Experiment: "prompt-v1", Variants: []chat.ExperimentVariantReport{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"experiment":"prompt-v1","active":false,"variants":[]}`,
+		},
+		"method-not-allowed": {
+			method:          http.MethodPost,
+			target:          experimentsAdminPath,
+			authHeader:      "Bearer secret",
+			setExpectations: func(*chat.MockGetExperimentReport) {},
+			expectedStatus:  http.StatusMethodNotAllowed,
+		},
+		"no-active-experiment": {
+			method:     http.MethodGet,
+			target:     experimentsAdminPath,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *chat.MockGetExperimentReport) {
+				uc.EXPECT().Query(mock.Anything, "").
+					Return(chat.ExperimentReport{}, core.NewValidationErr("experiment name is required when no experiment is active")).
+					Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"BAD_REQUEST","message":"experiment name is required when no experiment is active"}}`,
+		},
+		"report-error": {
+			method:     http.MethodGet,
+			target:     experimentsAdminPath,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *chat.MockGetExperimentReport) {
+				uc.EXPECT().Query(mock.Anything, "").Return(chat.ExperimentReport{}, errors.New("db error")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"INTERNAL_ERROR","message":"internal server error"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase := chat.NewMockGetExperimentReport(t)
+			tt.setExpectations(useCase)
+
+			handler := experimentsHandler{
+				Logger: log.New(io.Discard, "", 0),
+				Report: useCase,
+			}
+			token := "secret"
+			if tt.noToken {
+				token = ""
+			}
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			adminTokenMiddleware(token)(handler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
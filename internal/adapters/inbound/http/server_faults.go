@@ -0,0 +1,97 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/faultinject"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// faultsAdminPath is the path the fault injection admin API is mounted on.
+const faultsAdminPath = "/admin/faults"
+
+// faultJSON is the admin API representation of a faultinject.Fault.
+type faultJSON struct {
+	Latency   string  `json:"latency,omitempty"`
+	ErrorRate float64 `json:"error_rate,omitempty"`
+	Message   string  `json:"message,omitempty"`
+	Remaining int     `json:"remaining,omitempty"`
+}
+
+// faultsHandler serves the fault injection admin API used by resilience tests:
+//
+//	GET    /admin/faults           lists the active faults by target
+//	PUT    /admin/faults/{target}  sets the fault of a target, such as {"latency":"2s","error_rate":1,"remaining":1}
+//	DELETE /admin/faults/{target}  clears the fault of a target
+//	DELETE /admin/faults           clears every fault
+type faultsHandler struct {
+	Injector *faultinject.Injector
+}
+
+// ServeHTTP implements http.Handler.
+func (h faultsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+faultsAdminPath, h.list)
+	mux.HandleFunc("DELETE "+faultsAdminPath, h.clearAll)
+	mux.HandleFunc("PUT "+faultsAdminPath+"/{target}", h.set)
+	mux.HandleFunc("DELETE "+faultsAdminPath+"/{target}", h.clear)
+	mux.ServeHTTP(w, r)
+}
+
+func (h faultsHandler) list(w http.ResponseWriter, _ *http.Request) {
+	faults := make(map[faultinject.Target]faultJSON)
+	for target, fault := range h.Injector.Faults() {
+		faults[target] = toFaultJSON(fault)
+	}
+	respondJSON(w, http.StatusOK, faults)
+}
+
+func (h faultsHandler) set(w http.ResponseWriter, r *http.Request) {
+	var body faultJSON
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		respondError(w, toError(core.NewValidationErr("invalid fault: "+err.Error())))
+		return
+	}
+	fault := faultinject.Fault{ErrorRate: body.ErrorRate, Message: body.Message, Remaining: body.Remaining}
+	if body.Latency != "" {
+		latency, err := time.ParseDuration(body.Latency)
+		if err != nil {
+			respondError(w, toError(core.NewValidationErr("invalid fault latency: "+err.Error())))
+			return
+		}
+		fault.Latency = latency
+	}
+
+	target := faultinject.Target(r.PathValue("target"))
+	if err := h.Injector.Set(target, fault); err != nil {
+		respondError(w, toError(core.NewValidationErr(err.Error())))
+		return
+	}
+	respondJSON(w, http.StatusOK, toFaultJSON(fault))
+}
+
+func (h faultsHandler) clear(w http.ResponseWriter, r *http.Request) {
+	target := faultinject.Target(r.PathValue("target"))
+	if err := target.Validate(); err != nil {
+		respondError(w, toError(core.NewValidationErr(err.Error())))
+		return
+	}
+	h.Injector.Clear(target)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h faultsHandler) clearAll(w http.ResponseWriter, _ *http.Request) {
+	h.Injector.ClearAll()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toFaultJSON maps a fault to its admin API representation.
+func toFaultJSON(fault faultinject.Fault) faultJSON {
+	body := faultJSON{ErrorRate: fault.ErrorRate, Message: fault.Message, Remaining: fault.Remaining}
+	if fault.Latency > 0 {
+		body.Latency = fault.Latency.String()
+	}
+	return body
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/faultinject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFaultsHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		authHeader     string
+		noToken        bool
+		method         string
+		path           string
+		body           string
+		setup          func(*faultinject.Injector)
+		expectedStatus int
+		expectedBody   string
+		expectedFaults map[faultinject.Target]faultinject.Fault
+	}{
+		"set-fault": {
+			authHeader:     "Bearer secret",
+			method:         http.MethodPut,
+			path:           "/admin/faults/assistant",
+			body:           `{"latency":"1.5s","error_rate":1,"message":"model host down","remaining":1}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"latency":"1.5s","error_rate":1,"message":"model host down","remaining":1}`,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{
+				faultinject.Target_Assistant: {Latency: 1500 * time.Millisecond, ErrorRate: 1, Message: "model host down", Remaining: 1},
+			},
+		},
+		"set-unknown-target": {
+			authHeader:     "Bearer secret",
+			method:         http.MethodPut,
+			path:           "/admin/faults/cache",
+			body:           `{"error_rate":1}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"BAD_REQUEST","message":"unknown fault target \"cache\", expected \"assistant\", \"repository\" or \"bus\""}}`,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{},
+		},
+		"set-invalid-latency": {
+			authHeader:     "Bearer secret",
+			method:         http.MethodPut,
+			path:           "/admin/faults/bus",
+			body:           `{"latency":"soon"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{},
+		},
+		"list-faults": {
+			authHeader:     "Bearer secret",
+			method:         http.MethodGet,
+			path:           "/admin/faults",
+			setup:          func(i *faultinject.Injector) { _ = i.Set(faultinject.Target_Bus, faultinject.Fault{ErrorRate: 0.5}) },
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"bus":{"error_rate":0.5}}`,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{faultinject.Target_Bus: {ErrorRate: 0.5}},
+		},
+		"clear-fault": {
+			authHeader: "Bearer secret",
+			method:     http.MethodDelete,
+			path:       "/admin/faults/bus",
+			setup: func(i *faultinject.Injector) {
+				_ = i.Set(faultinject.Target_Bus, faultinject.Fault{ErrorRate: 1})
+				_ = i.Set(faultinject.Target_Repository, faultinject.Fault{ErrorRate: 1})
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{faultinject.Target_Repository: {ErrorRate: 1}},
+		},
+		"clear-all": {
+			// API principals guard the endpoint instead of the admin token.
+			noToken: true,
+			method:  http.MethodDelete,
+			path:    "/admin/faults",
+			setup: func(i *faultinject.Injector) {
+				_ = i.Set(faultinject.Target_Bus, faultinject.Fault{ErrorRate: 1})
+				_ = i.Set(faultinject.Target_Repository, faultinject.Fault{ErrorRate: 1})
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{},
+		},
+		"missing-token": {
+			method:         http.MethodPut,
+			path:           "/admin/faults/assistant",
+			body:           `{"error_rate":1}`,
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+			expectedFaults: map[faultinject.Target]faultinject.Fault{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			injector := faultinject.NewInjector(true)
+			if tt.setup != nil {
+				tt.setup(injector)
+			}
+			token := "secret"
+			if tt.noToken {
+				token = ""
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			adminTokenMiddleware(token)(faultsHandler{Injector: injector}).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+			assert.Equal(t, tt.expectedFaults, injector.Faults())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxInboundWebhookBytes caps the size of inbound webhook bodies.
+const maxInboundWebhookBytes = 1 << 20
+
+// ReceiveInboundWebhook creates a todo from an inbound integration webhook.
+// (POST /api/v1/inbound/webhooks/{source})
+func (api TodoAppServer) ReceiveInboundWebhook(w http.ResponseWriter, r *http.Request, source string, params gen.ReceiveInboundWebhookParams) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxInboundWebhookBytes))
+	if err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	delivery := todo.WebhookDelivery{
+		Event:     firstHeader(params.XGitHubEvent, params.XWebhookEvent),
+		Signature: firstHeader(params.XHubSignature256, params.XWebhookSignature),
+		Secret:    firstHeader(params.XWebhookSecret),
+		Payload:   payload,
+	}
+
+	ctx := r.Context()
+	td, created, err := api.InboundWebhooksUseCase.Receive(ctx, source, delivery)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error receiving %s webhook: %v", source, err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.InboundWebhookResp{Created: created}
+	if created {
+		restTodo := toTodo(td)
+		resp.Todo = &restTodo
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// firstHeader returns the first non-empty optional header value.
+func firstHeader(values ...*string) string {
+	for _, v := range values {
+		if v != nil && *v != "" {
+			return *v
+		}
+	}
+	return ""
+}
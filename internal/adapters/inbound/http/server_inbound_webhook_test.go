@@ -0,0 +1,141 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTodoAppServer_ReceiveInboundWebhook(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	dueDate := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	payload := []byte(`{"action":"opened","issue":{"number":42,"title":"Crash on save"}}`)
+
+	tests := map[string]struct {
+		source         string
+		params         gen.ReceiveInboundWebhookParams
+		setupUsecases  func(*todouc.MockInboundWebhooks)
+		expectedStatus int
+		expectedBody   *gen.InboundWebhookResp
+		expectedError  *gen.ErrorResp
+	}{
+		"created": {
+			source: "github",
+			params: gen.ReceiveInboundWebhookParams{
+				XGitHubEvent:     common.Ptr("issues"),
+				XHubSignature256: common.Ptr("sha256=abc"),
+			},
+			setupUsecases: func(m *todouc.MockInboundWebhooks) {
+				m.EXPECT().
+					Receive(mock.Anything, "github", todouc.WebhookDelivery{Event: "issues", Signature: "sha256=abc", Payload: payload}).
+					Return(todo.Todo{
+						ID:        todoID,
+						Title:     "GitHub #42: Crash on save",
+						Status:    todo.Status_OPEN,
+						DueDate:   dueDate,
+						CreatedAt: createdAt,
+						UpdatedAt: createdAt,
+					}, true, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.InboundWebhookResp{
+				Created: true,
+				Todo: &gen.Todo{
+					Id:        todoID,
+					Title:     "GitHub #42: Crash on save",
+					Status:    gen.OPEN,
+					DueDate:   openapi_types.Date{Time: dueDate},
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				},
+			},
+		},
+		"ignored": {
+			source: "zapier",
+			params: gen.ReceiveInboundWebhookParams{
+				XWebhookEvent:  common.Ptr("ping"),
+				XWebhookSecret: common.Ptr("zap-secret"),
+			},
+			setupUsecases: func(m *todouc.MockInboundWebhooks) {
+				m.EXPECT().
+					Receive(mock.Anything, "zapier", todouc.WebhookDelivery{Event: "ping", Secret: "zap-secret", Payload: payload}).
+					Return(todo.Todo{}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.InboundWebhookResp{Created: false},
+		},
+		"unauthorized": {
+			source: "github",
+			params: gen.ReceiveInboundWebhookParams{XWebhookSignature: common.Ptr("sha256=bad")},
+			setupUsecases: func(m *todouc.MockInboundWebhooks) {
+				m.EXPECT().
+					Receive(mock.Anything, "github", todouc.WebhookDelivery{Signature: "sha256=bad", Payload: payload}).
+					Return(todo.Todo{}, false, core.NewUnauthorizedErr("invalid webhook signature"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.UNAUTHORIZED, Message: "invalid webhook signature"},
+			},
+		},
+		"unknown-source": {
+			source: "gitlab",
+			setupUsecases: func(m *todouc.MockInboundWebhooks) {
+				m.EXPECT().
+					Receive(mock.Anything, "gitlab", todouc.WebhookDelivery{Payload: payload}).
+					Return(todo.Todo{}, false, core.NewNotFoundErr(`webhook source "gitlab" not found`))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.NOTFOUND, Message: `webhook source "gitlab" not found`},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockWebhooks := todouc.NewMockInboundWebhooks(t)
+			tt.setupUsecases(mockWebhooks)
+
+			server := &TodoAppServer{
+				InboundWebhooksUseCase: mockWebhooks,
+				Logger:                 log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/inbound/webhooks/"+tt.source, bytes.NewReader(payload))
+			w := httptest.NewRecorder()
+
+			server.ReceiveInboundWebhook(w, req, tt.source, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.InboundWebhookResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
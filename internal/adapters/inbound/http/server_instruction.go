@@ -0,0 +1,76 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListInstructions lists the global pinned instructions followed by the ones pinned to the conversation
+// (GET /api/v1/chat/instructions)
+func (api TodoAppServer) ListInstructions(w http.ResponseWriter, r *http.Request, params gen.ListInstructionsParams) {
+	conversationID := uuid.Nil
+	if params.ConversationId != nil {
+		conversationID = *params.ConversationId
+	}
+
+	ctx := r.Context()
+	instructions, err := api.InstructionsUseCase.List(ctx, conversationID)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing instructions: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListInstructionsResp{
+		Items: make([]gen.Instruction, len(instructions)),
+	}
+	for i, instruction := range instructions {
+		resp.Items[i] = toInstruction(instruction)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// RememberInstruction pins an instruction to a conversation or globally
+// (POST /api/v1/chat/instructions)
+func (api TodoAppServer) RememberInstruction(w http.ResponseWriter, r *http.Request) {
+	var req gen.RememberInstructionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	instruction, err := api.InstructionsUseCase.Remember(ctx, req.ConversationId, req.Text)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error remembering instruction: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toInstruction(instruction))
+}
+
+// DeleteInstruction deletes a pinned instruction
+// (DELETE /api/v1/chat/instructions/{instruction_id})
+func (api TodoAppServer) DeleteInstruction(w http.ResponseWriter, r *http.Request, instructionId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.InstructionsUseCase.Delete(ctx, instructionId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error deleting instruction: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,216 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	instructionID             = uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	instructionConversationID = uuid.MustParse("523e4567-e89b-12d3-a456-426614174000")
+	instructionTime           = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	domainInstruction         = assistant.Instruction{
+		ID:             instructionID,
+		ConversationID: &instructionConversationID,
+		Text:           "Always use DD/MM dates",
+		CreatedAt:      instructionTime,
+	}
+	restInstruction = gen.Instruction{
+		Id:             instructionID,
+		ConversationId: &instructionConversationID,
+		Text:           "Always use DD/MM dates",
+		CreatedAt:      instructionTime,
+	}
+)
+
+func TestTodoAppServer_ListInstructions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params         gen.ListInstructionsParams
+		setupUsecases  func(*chatuc.MockInstructions)
+		expectedStatus int
+		expectedBody   *gen.ListInstructionsResp
+		expectedError  *gen.ErrorResp
+	}{
+		"conversation": {
+			params: gen.ListInstructionsParams{ConversationId: &instructionConversationID},
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().List(mock.Anything, instructionConversationID).Return([]assistant.Instruction{domainInstruction}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.ListInstructionsResp{Items: []gen.Instruction{restInstruction}},
+		},
+		"global-only": {
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().List(mock.Anything, uuid.Nil).Return([]assistant.Instruction{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.ListInstructionsResp{Items: []gen.Instruction{}},
+		},
+		"internal-error": {
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().List(mock.Anything, uuid.Nil).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.INTERNALERROR, Message: "internal server error"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockInstructions := chatuc.NewMockInstructions(t)
+			tt.setupUsecases(mockInstructions)
+
+			server := &TodoAppServer{
+				InstructionsUseCase: mockInstructions,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/chat/instructions", nil)
+			w := httptest.NewRecorder()
+
+			server.ListInstructions(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.ListInstructionsResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_RememberInstruction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*chatuc.MockInstructions)
+		expectedStatus int
+		expectedBody   *gen.Instruction
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"text":"Always use DD/MM dates","conversation_id":"523e4567-e89b-12d3-a456-426614174000"}`),
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Remember(mock.Anything, &instructionConversationID, "Always use DD/MM dates").Return(domainInstruction, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restInstruction,
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*chatuc.MockInstructions) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "invalid request body: unexpected EOF"},
+			},
+		},
+		"validation-error": {
+			requestBody: []byte(`{"text":""}`),
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Remember(mock.Anything, (*uuid.UUID)(nil), "").Return(assistant.Instruction{}, core.NewValidationErr("instruction cannot be empty"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "instruction cannot be empty"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockInstructions := chatuc.NewMockInstructions(t)
+			tt.setupUsecases(mockInstructions)
+
+			server := &TodoAppServer{
+				InstructionsUseCase: mockInstructions,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/chat/instructions", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.RememberInstruction(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.Instruction
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_DeleteInstruction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*chatuc.MockInstructions)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Delete(mock.Anything, instructionID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"not-found": {
+			setupUsecases: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Delete(mock.Anything, instructionID).Return(core.NewNotFoundErr("instruction not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockInstructions := chatuc.NewMockInstructions(t)
+			tt.setupUsecases(mockInstructions)
+
+			server := &TodoAppServer{
+				InstructionsUseCase: mockInstructions,
+				Logger:              log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/chat/instructions/"+instructionID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.DeleteInstruction(w, req, instructionID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
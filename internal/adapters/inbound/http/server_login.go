@@ -0,0 +1,94 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// loginCookieName is the cookie keeping an OIDC login attempt until the provider redirects back.
+	loginCookieName = "todoapp_oidc_login"
+	// loginCookiePath limits the login cookie to the OIDC login routes.
+	loginCookiePath = "/api/v1/auth/oidc"
+	// loginCookieMaxAge bounds how long the user has to sign in at the provider.
+	loginCookieMaxAge = 600
+)
+
+// BeginOIDCLogin redirects the browser to the OpenID Connect provider
+// (GET /api/v1/auth/oidc/login)
+func (api TodoAppServer) BeginOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	authURL, attempt, err := api.LoginsUseCase.Begin(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error beginning OIDC login: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	value, err := json.Marshal(attempt)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		respondError(w, toError(err))
+		return
+	}
+	http.SetCookie(w, loginCookie(r, base64.RawURLEncoding.EncodeToString(value), loginCookieMaxAge))
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// CompleteOIDCLogin redeems the provider callback and starts a session for the user
+// (GET /api/v1/auth/oidc/callback)
+func (api TodoAppServer) CompleteOIDCLogin(w http.ResponseWriter, r *http.Request, params gen.CompleteOIDCLoginParams) {
+	// The login attempt is single use, whatever its outcome.
+	http.SetCookie(w, loginCookie(r, "", -1))
+
+	if params.Error != nil {
+		respondError(w, toError(core.NewUnauthorizedErr(fmt.Sprintf("identity provider returned %s", *params.Error))))
+		return
+	}
+
+	var attempt session.LoginAttempt
+	if cookie, err := r.Cookie(loginCookieName); err == nil {
+		if value, err := base64.RawURLEncoding.DecodeString(cookie.Value); err == nil {
+			_ = json.Unmarshal(value, &attempt)
+		}
+	}
+
+	var code, state string
+	if params.Code != nil {
+		code = *params.Code
+	}
+	if params.State != nil {
+		state = *params.State
+	}
+
+	ctx := r.Context()
+	issued, err := api.LoginsUseCase.Complete(ctx, attempt, code, state, r.UserAgent())
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error completing OIDC login: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toSessionTokens(issued))
+}
+
+// loginCookie returns the login attempt cookie. It is sent back on the top-level redirect from the
+// provider, which SameSite=Lax allows, and is secure when the request arrived over HTTPS.
+func loginCookie(r *http.Request, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     loginCookieName,
+		Value:    value,
+		Path:     loginCookiePath,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	}
+}
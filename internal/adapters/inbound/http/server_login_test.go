@@ -0,0 +1,156 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var loginAttempt = session.LoginAttempt{State: "state", Nonce: "nonce", Verifier: "verifier"}
+
+func encodedLoginAttempt(t *testing.T) string {
+	t.Helper()
+
+	value, err := json.Marshal(loginAttempt)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+func TestTodoAppServer_BeginOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases    func(*session.MockLogins)
+		expectedStatus   int
+		expectedLocation string
+		expectCookie     bool
+	}{
+		"redirects-to-provider": {
+			setupUsecases: func(m *session.MockLogins) {
+				m.EXPECT().Begin(mock.Anything).Return("https://idp.example.com/authorize?state=state", loginAttempt, nil)
+			},
+			expectedStatus:   http.StatusFound,
+			expectedLocation: "https://idp.example.com/authorize?state=state",
+			expectCookie:     true,
+		},
+		"not-configured": {
+			setupUsecases: func(m *session.MockLogins) {
+				m.EXPECT().Begin(mock.Anything).Return("", session.LoginAttempt{}, core.NewNotFoundErr("OIDC login is not configured"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			logins := session.NewMockLogins(t)
+			tt.setupUsecases(logins)
+
+			server := &TodoAppServer{
+				LoginsUseCase: logins,
+				Logger:        log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/login", nil)
+			req.Header.Set("X-Forwarded-Proto", "https")
+			w := httptest.NewRecorder()
+
+			server.BeginOIDCLogin(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedLocation, w.Header().Get("Location"))
+			cookies := w.Result().Cookies()
+			if !tt.expectCookie {
+				assert.Empty(t, cookies)
+				return
+			}
+			require.Len(t, cookies, 1)
+			assert.Equal(t, loginCookieName, cookies[0].Name)
+			assert.Equal(t, encodedLoginAttempt(t), cookies[0].Value)
+			assert.Equal(t, loginCookiePath, cookies[0].Path)
+			assert.True(t, cookies[0].HttpOnly)
+			assert.True(t, cookies[0].Secure)
+			assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+		})
+	}
+}
+
+func TestTodoAppServer_CompleteOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params         gen.CompleteOIDCLoginParams
+		withCookie     bool
+		setupUsecases  func(*session.MockLogins)
+		expectedStatus int
+		expectedBody   *gen.SessionTokens
+	}{
+		"starts-session": {
+			params:     gen.CompleteOIDCLoginParams{Code: common.Ptr("code"), State: common.Ptr("state")},
+			withCookie: true,
+			setupUsecases: func(m *session.MockLogins) {
+				m.EXPECT().Complete(mock.Anything, loginAttempt, "code", "state", "Mozilla/5.0").Return(issuedSession, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restSessionTokens,
+		},
+		"missing-cookie": {
+			params: gen.CompleteOIDCLoginParams{Code: common.Ptr("code"), State: common.Ptr("state")},
+			setupUsecases: func(m *session.MockLogins) {
+				m.EXPECT().Complete(mock.Anything, session.LoginAttempt{}, "code", "state", "Mozilla/5.0").
+					Return(session.Issued{}, core.NewUnauthorizedErr("login state does not match, start the login again"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"provider-error": {
+			params:         gen.CompleteOIDCLoginParams{Error: common.Ptr("access_denied")},
+			withCookie:     true,
+			setupUsecases:  func(*session.MockLogins) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			logins := session.NewMockLogins(t)
+			tt.setupUsecases(logins)
+
+			server := &TodoAppServer{
+				LoginsUseCase: logins,
+				Logger:        log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/callback", nil)
+			req.Header.Set("User-Agent", "Mozilla/5.0")
+			if tt.withCookie {
+				req.AddCookie(&http.Cookie{Name: loginCookieName, Value: encodedLoginAttempt(t)})
+			}
+			w := httptest.NewRecorder()
+
+			server.CompleteOIDCLogin(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			cookies := w.Result().Cookies()
+			require.Len(t, cookies, 1)
+			assert.Equal(t, loginCookieName, cookies[0].Name)
+			assert.Negative(t, cookies[0].MaxAge)
+			if tt.expectedBody != nil {
+				var resp gen.SessionTokens
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, *tt.expectedBody, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListMemories lists the long-term memories extracted from earlier conversations
+// (GET /api/v1/chat/memories)
+func (api TodoAppServer) ListMemories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	memories, err := api.MemoriesUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing memories: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListMemoriesResp{
+		Items: make([]gen.Memory, len(memories)),
+	}
+	for i, memory := range memories {
+		resp.Items[i] = toMemory(memory)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// DeleteMemory deletes a long-term memory
+// (DELETE /api/v1/chat/memories/{memory_id})
+func (api TodoAppServer) DeleteMemory(w http.ResponseWriter, r *http.Request, memoryId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.MemoriesUseCase.Delete(ctx, memoryId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error deleting memory: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
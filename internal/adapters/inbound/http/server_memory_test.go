@@ -0,0 +1,144 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	memoryID                   = uuid.MustParse("623e4567-e89b-12d3-a456-426614174000")
+	memorySourceConversationID = uuid.MustParse("723e4567-e89b-12d3-a456-426614174000")
+	memoryTime                 = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+)
+
+func TestTodoAppServer_ListMemories(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*chatuc.MockMemories)
+		expectedStatus int
+		expectedBody   *gen.ListMemoriesResp
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *chatuc.MockMemories) {
+				m.EXPECT().List(mock.Anything).Return([]assistant.Memory{{
+					ID:                   memoryID,
+					Kind:                 assistant.MemoryKind_Preference,
+					Text:                 "Prefers to plan errands on Saturday mornings",
+					SourceConversationID: &memorySourceConversationID,
+					CreatedAt:            memoryTime,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListMemoriesResp{Items: []gen.Memory{{
+				Id:                   memoryID,
+				Kind:                 gen.Preference,
+				Text:                 "Prefers to plan errands on Saturday mornings",
+				SourceConversationId: &memorySourceConversationID,
+				CreatedAt:            memoryTime,
+			}}},
+		},
+		"empty": {
+			setupUsecases: func(m *chatuc.MockMemories) {
+				m.EXPECT().List(mock.Anything).Return([]assistant.Memory{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.ListMemoriesResp{Items: []gen.Memory{}},
+		},
+		"internal-error": {
+			setupUsecases: func(m *chatuc.MockMemories) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.INTERNALERROR, Message: "internal server error"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockMemories := chatuc.NewMockMemories(t)
+			tt.setupUsecases(mockMemories)
+
+			server := &TodoAppServer{
+				MemoriesUseCase: mockMemories,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/chat/memories", nil)
+			w := httptest.NewRecorder()
+
+			server.ListMemories(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.ListMemoriesResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_DeleteMemory(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*chatuc.MockMemories)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *chatuc.MockMemories) {
+				m.EXPECT().Delete(mock.Anything, memoryID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"not-found": {
+			setupUsecases: func(m *chatuc.MockMemories) {
+				m.EXPECT().Delete(mock.Anything, memoryID).Return(core.NewNotFoundErr("memory not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockMemories := chatuc.NewMockMemories(t)
+			tt.setupUsecases(mockMemories)
+
+			server := &TodoAppServer{
+				MemoriesUseCase: mockMemories,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/chat/memories/"+memoryID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.DeleteMemory(w, req, memoryID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	domainoutbox "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// outboxStatusAdminPath is the path the outbox status admin endpoint is mounted on.
+const outboxStatusAdminPath = "/admin/outbox/status"
+
+// outboxStatusJSON is the admin API representation of a domainoutbox.Health.
+type outboxStatusJSON struct {
+	CheckedAt               time.Time               `json:"checked_at"`
+	Pending                 int                     `json:"pending"`
+	Failed                  int                     `json:"failed"`
+	OldestPendingAgeSeconds float64                 `json:"oldest_pending_age_seconds"`
+	Topics                  []outboxTopicJSON       `json:"topics"`
+	LastError               *outboxEventErrorJSON   `json:"last_error,omitempty"`
+	Consumers               []outboxConsumerLagJSON `json:"consumers"`
+}
+
+// outboxTopicJSON is the admin API representation of a domainoutbox.Backlog.
+type outboxTopicJSON struct {
+	Topic           string     `json:"topic"`
+	Pending         int        `json:"pending"`
+	Failed          int        `json:"failed"`
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+	LastPublishedAt *time.Time `json:"last_published_at,omitempty"`
+}
+
+// outboxEventErrorJSON is the admin API representation of a domainoutbox.EventError.
+type outboxEventErrorJSON struct {
+	EventID     uuid.UUID `json:"event_id"`
+	Topic       string    `json:"topic"`
+	Status      string    `json:"status"`
+	RetryCount  int       `json:"retry_count"`
+	Message     string    `json:"message"`
+	AvailableAt time.Time `json:"available_at"`
+}
+
+// outboxConsumerLagJSON is the admin API representation of a domainoutbox.ConsumerLag.
+type outboxConsumerLagJSON struct {
+	Subscription string     `json:"subscription"`
+	Topic        string     `json:"topic"`
+	LagSeconds   float64    `json:"lag_seconds"`
+	LastEventAt  *time.Time `json:"last_event_at,omitempty"`
+	LastError    *string    `json:"last_error,omitempty"`
+	LastErrorAt  *time.Time `json:"last_error_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// outboxStatusHandler serves the outbox status admin endpoint:
+//
+//	GET /admin/outbox/status  reports the unpublished events, the consumer lag and the last publishing error
+type outboxStatusHandler struct {
+	Logger  *log.Logger
+	Monitor outbox.Monitor
+}
+
+// ServeHTTP implements http.Handler.
+func (h outboxStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+	health, err := h.Monitor.Health(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		h.Logger.Printf("Error checking outbox health: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toOutboxStatusJSON(health))
+}
+
+// toOutboxStatusJSON maps the outbox health report to its admin API representation.
+func toOutboxStatusJSON(health domainoutbox.Health) outboxStatusJSON {
+	resp := outboxStatusJSON{
+		CheckedAt:               health.CheckedAt,
+		Pending:                 health.Pending,
+		Failed:                  health.Failed,
+		OldestPendingAgeSeconds: health.OldestPendingAge.Seconds(),
+		Topics:                  make([]outboxTopicJSON, 0, len(health.Topics)),
+		Consumers:               make([]outboxConsumerLagJSON, 0, len(health.Consumers)),
+	}
+	for _, b := range health.Topics {
+		resp.Topics = append(resp.Topics, outboxTopicJSON{
+			Topic:           string(b.Topic),
+			Pending:         b.Pending,
+			Failed:          b.Failed,
+			OldestPendingAt: b.OldestPendingAt,
+			LastPublishedAt: b.LastPublishedAt,
+		})
+	}
+	if e := health.LastError; e != nil {
+		resp.LastError = &outboxEventErrorJSON{
+			EventID:     e.EventID,
+			Topic:       string(e.Topic),
+			Status:      string(e.Status),
+			RetryCount:  e.RetryCount,
+			Message:     e.Message,
+			AvailableAt: e.AvailableAt,
+		}
+	}
+	for _, c := range health.Consumers {
+		resp.Consumers = append(resp.Consumers, outboxConsumerLagJSON{
+			Subscription: c.Subscription,
+			Topic:        string(c.Topic),
+			LagSeconds:   c.Lag.Seconds(),
+			LastEventAt:  c.LastEventAt,
+			LastError:    c.LastError,
+			LastErrorAt:  c.LastErrorAt,
+			UpdatedAt:    c.UpdatedAt,
+		})
+	}
+	return resp
+}
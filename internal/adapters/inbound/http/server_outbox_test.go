@@ -0,0 +1,142 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainoutbox "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutboxStatusHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	checkedAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	oldest := checkedAt.Add(-90 * time.Second)
+	published := checkedAt.Add(-time.Minute)
+	consumed := checkedAt.Add(-31 * time.Minute)
+	eventID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	lastError := "generation failed"
+
+	tests := map[string]struct {
+		method          string
+		authHeader      string
+		noToken         bool
+		setExpectations func(*outbox.MockMonitor)
+		expectedStatus  int
+		expectedBody    string
+	}{
+		"backlog-and-lagging-consumer": {
+			method:     http.MethodGet,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *outbox.MockMonitor) {
+				uc.EXPECT().Health(mock.Anything).Return(domainoutbox.Health{
+					Pending:          2,
+					Failed:           1,
+					OldestPendingAge: 90 * time.Second,
+					Topics: []domainoutbox.Backlog{
+						{Topic: domainoutbox.Topic_Todo, Pending: 2, Failed: 1, OldestPendingAt: &oldest, LastPublishedAt: &published},
+					},
+					LastError: &domainoutbox.EventError{
+						EventID:     eventID,
+						Topic:       domainoutbox.Topic_Todo,
+						Status:      domainoutbox.Status_Failed,
+						RetryCount:  5,
+						Message:     "broker unavailable",
+						AvailableAt: oldest,
+					},
+					Consumers: []domainoutbox.ConsumerLag{
+						{
+							ConsumerCheckpoint: domainoutbox.ConsumerCheckpoint{
+								Subscription: "todo-summary",
+								Topic:        domainoutbox.Topic_Todo,
+								LastEventAt:  &consumed,
+								LastError:    &lastError,
+								LastErrorAt:  &published,
+								UpdatedAt:    published,
+							},
+							Lag: 30 * time.Minute,
+						},
+					},
+					CheckedAt: checkedAt,
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"checked_at":"2026-10-16T09:00:00Z","pending":2,"failed":1,"oldest_pending_age_seconds":90,` +
+				`"topics":[{"topic":"Todo","pending":2,"failed":1,"oldest_pending_at":"2026-10-16T08:58:30Z","last_published_at":"2026-10-16T08:59:00Z"}],` +
+				`"last_error":{"event_id":"123e4567-e89b-12d3-a456-426614174000","topic":"Todo","status":"FAILED","retry_count":5,"message":"broker unavailable","available_at":"2026-10-16T08:58:30Z"},` +
+				`"consumers":[{"subscription":"todo-summary","topic":"Todo","lag_seconds":1800,"last_event_at":"2026-10-16T08:29:00Z",` +
+				`"last_error":"generation failed","last_error_at":"2026-10-16T08:59:00Z","updated_at":"2026-10-16T08:59:00Z"}]}`,
+		},
+		"empty-outbox": {
+			method: http.MethodGet,
+			// API principals guard the endpoint instead of the admin token.
+			noToken: true,
+			setExpectations: func(uc *outbox.MockMonitor) {
+				uc.EXPECT().Health(mock.Anything).Return(domainoutbox.Health{CheckedAt: checkedAt}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"checked_at":"2026-10-16T09:00:00Z","pending":0,"failed":0,"oldest_pending_age_seconds":0,"topics":[],"consumers":[]}`,
+		},
+		"missing-token": {
+			method:          http.MethodGet,
+			setExpectations: func(*outbox.MockMonitor) {},
+			expectedStatus:  http.StatusUnauthorized,
+			expectedBody:    `{"error":{"code":"UNAUTHORIZED","message":"missing or invalid admin token"}}`,
+		},
+		"method-not-allowed": {
+			method:          http.MethodPost,
+			authHeader:      "Bearer secret",
+			setExpectations: func(*outbox.MockMonitor) {},
+			expectedStatus:  http.StatusMethodNotAllowed,
+		},
+		"health-error": {
+			method:     http.MethodGet,
+			authHeader: "Bearer secret",
+			setExpectations: func(uc *outbox.MockMonitor) {
+				uc.EXPECT().Health(mock.Anything).Return(domainoutbox.Health{}, errors.New("db error")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"INTERNAL_ERROR","message":"internal server error"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase := outbox.NewMockMonitor(t)
+			tt.setExpectations(useCase)
+
+			handler := outboxStatusHandler{
+				Logger:  log.New(io.Discard, "", 0),
+				Monitor: useCase,
+			}
+			token := "secret"
+			if tt.noToken {
+				token = ""
+			}
+
+			req := httptest.NewRequest(tt.method, outboxStatusAdminPath, nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			adminTokenMiddleware(token)(handler).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListProjects lists the accepted projects with their todos
+// (GET /api/v1/projects)
+func (api TodoAppServer) ListProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projects, err := api.ProjectsUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing projects: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListProjectsResp{
+		Items: make([]gen.Project, len(projects)),
+	}
+	for i, p := range projects {
+		resp.Items[i] = toProject(p)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// ListProjectSuggestions lists the groups of similar todos proposed as projects
+// (GET /api/v1/projects/suggestions)
+func (api TodoAppServer) ListProjectSuggestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	suggestions, err := api.ProjectsUseCase.Suggestions(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing project suggestions: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListProjectSuggestionsResp{
+		Items: make([]gen.ProjectSuggestion, len(suggestions)),
+	}
+	for i, s := range suggestions {
+		resp.Items[i] = toProjectSuggestion(s)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// AcceptProjectSuggestion creates a project from a suggestion
+// (POST /api/v1/projects/suggestions/{suggestion_id}/accept)
+func (api TodoAppServer) AcceptProjectSuggestion(w http.ResponseWriter, r *http.Request, suggestionId openapi_types.UUID) {
+	var req gen.AcceptProjectSuggestionJSONRequestBody
+	// The body is optional.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+	name := ""
+	if req.Name != nil {
+		name = *req.Name
+	}
+
+	ctx := r.Context()
+	project, err := api.ProjectsUseCase.Accept(ctx, suggestionId, name)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error accepting project suggestion: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toProject(project))
+}
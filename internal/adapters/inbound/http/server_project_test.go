@@ -0,0 +1,217 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	projectID           = uuid.MustParse("823e4567-e89b-12d3-a456-426614174000")
+	projectSuggestionID = uuid.MustParse("923e4567-e89b-12d3-a456-426614174000")
+	projectTodoID       = uuid.MustParse("a23e4567-e89b-12d3-a456-426614174000")
+	projectTime         = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+)
+
+func TestTodoAppServer_ListProjects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockProjects)
+		expectedStatus int
+		expectedBody   *gen.ListProjectsResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().List(mock.Anything).Return([]todo.Project{{
+					ID:        projectID,
+					Name:      "House move",
+					Todos:     []todo.ProjectTodo{{ID: projectTodoID, Title: "Book movers"}},
+					CreatedAt: projectTime,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListProjectsResp{Items: []gen.Project{{
+				Id:        projectID,
+				Name:      "House move",
+				Todos:     []gen.ProjectTodo{{Id: projectTodoID, Title: "Book movers"}},
+				CreatedAt: projectTime,
+			}}},
+		},
+		"internal-error": {
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockProjects := todouc.NewMockProjects(t)
+			tt.setupUsecases(mockProjects)
+
+			server := &TodoAppServer{
+				ProjectsUseCase: mockProjects,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/projects", nil)
+			w := httptest.NewRecorder()
+
+			server.ListProjects(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.ListProjectsResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_ListProjectSuggestions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockProjects)
+		expectedStatus int
+		expectedBody   *gen.ListProjectSuggestionsResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().Suggestions(mock.Anything).Return([]todo.ProjectSuggestion{{
+					ID:        projectSuggestionID,
+					Name:      "House move",
+					Todos:     []todo.ProjectTodo{{ID: projectTodoID, Title: "Book movers"}},
+					CreatedAt: projectTime,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListProjectSuggestionsResp{Items: []gen.ProjectSuggestion{{
+				Id:        projectSuggestionID,
+				Name:      "House move",
+				Todos:     []gen.ProjectTodo{{Id: projectTodoID, Title: "Book movers"}},
+				CreatedAt: projectTime,
+			}}},
+		},
+		"internal-error": {
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().Suggestions(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockProjects := todouc.NewMockProjects(t)
+			tt.setupUsecases(mockProjects)
+
+			server := &TodoAppServer{
+				ProjectsUseCase: mockProjects,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/projects/suggestions", nil)
+			w := httptest.NewRecorder()
+
+			server.ListProjectSuggestions(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.ListProjectSuggestionsResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_AcceptProjectSuggestion(t *testing.T) {
+	t.Parallel()
+
+	project := todo.Project{
+		ID:        projectID,
+		Name:      "Moving to Lisbon",
+		Todos:     []todo.ProjectTodo{{ID: projectTodoID, Title: "Book movers"}},
+		CreatedAt: projectTime,
+	}
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockProjects)
+		expectedStatus int
+		expectedBody   *gen.Project
+	}{
+		"renamed": {
+			requestBody: []byte(`{"name":"Moving to Lisbon"}`),
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().Accept(mock.Anything, projectSuggestionID, "Moving to Lisbon").Return(project, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: &gen.Project{
+				Id:        projectID,
+				Name:      "Moving to Lisbon",
+				Todos:     []gen.ProjectTodo{{Id: projectTodoID, Title: "Book movers"}},
+				CreatedAt: projectTime,
+			},
+		},
+		"no-body-keeps-suggested-name": {
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().Accept(mock.Anything, projectSuggestionID, "").Return(project, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*todouc.MockProjects) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"not-found": {
+			setupUsecases: func(m *todouc.MockProjects) {
+				m.EXPECT().Accept(mock.Anything, projectSuggestionID, "").Return(todo.Project{}, core.NewNotFoundErr("project suggestion not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockProjects := todouc.NewMockProjects(t)
+			tt.setupUsecases(mockProjects)
+
+			server := &TodoAppServer{
+				ProjectsUseCase: mockProjects,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/suggestions/"+projectSuggestionID.String()+"/accept", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.AcceptProjectSuggestion(w, req, projectSuggestionID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.Project
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
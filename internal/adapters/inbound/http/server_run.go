@@ -9,11 +9,22 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/caldav"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/genv2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/faultinject"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domainoutbox "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	domaintodo "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/audit"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 	"github.com/cleitonmarx/symbiont/introspection"
 	"github.com/cleitonmarx/symbiont/introspection/mermaid"
@@ -30,23 +41,77 @@ const (
 
 // TodoAppServer is the REST API and UI HTTP server for the TodoApp application.
 type TodoAppServer struct {
-	Port                           int                              `config:"API_SERVER_PORT" default:"8080"`
-	Logger                         *log.Logger                      `resolve:""`
-	ListTodosUseCase               todo.List                        `resolve:""`
-	CreateTodoUseCase              todo.Create                      `resolve:""`
-	UpdateTodoUseCase              todo.Update                      `resolve:""`
-	DeleteTodoUseCase              todo.Delete                      `resolve:""`
-	GetBoardSummaryUseCase         board.GetBoardSummary            `resolve:""`
-	ListConversationsUseCase       chat.ListConversations           `resolve:""`
-	UpdateConversationUseCase      chat.UpdateConversation          `resolve:""`
-	ConversationRepo               assistant.ConversationRepository `resolve:""`
-	ListChatMessagesUseCase        chat.ListChatMessages            `resolve:""`
-	SubmitActionApprovalUseCase    chat.SubmitActionApproval        `resolve:""`
-	DeleteConversationUseCase      chat.DeleteConversation          `resolve:""`
-	ListAvailableModelsUseCase     chat.ListAvailableModels         `resolve:""`
-	ListAvailableSkillsUseCase     chat.ListAvailableSkills         `resolve:""`
-	StreamChatUseCase              chat.StreamChat                  `resolve:""`
-	ContextCompactionTriggerTokens int                              `config:"CHAT_COMPACTION_TRIGGER_TOKENS"`
+	Port                           int                                 `config:"API_SERVER_PORT" default:"8080"`
+	Logger                         *log.Logger                         `resolve:""`
+	ListTodosUseCase               todo.List                           `resolve:""`
+	CreateTodoUseCase              todo.Create                         `resolve:""`
+	QuickAddUseCase                todo.QuickAdd                       `resolve:""`
+	UpdateTodoUseCase              todo.Update                         `resolve:""`
+	DeleteTodoUseCase              todo.Delete                         `resolve:""`
+	ArchiveUseCase                 todo.Archive                        `resolve:""`
+	TimeTrackerUseCase             todo.TimeTracker                    `resolve:""`
+	GetTimeReportUseCase           todo.GetTimeReport                  `resolve:""`
+	CommentsUseCase                todo.Comments                       `resolve:""`
+	ListChangesUseCase             todo.ListChanges                    `resolve:""`
+	ViewsUseCase                   todo.Views                          `resolve:""`
+	TemplatesUseCase               todo.Templates                      `resolve:""`
+	SnapshotsUseCase               todo.Snapshots                      `resolve:""`
+	FocusBlocksUseCase             todo.FocusBlocks                    `resolve:""`
+	ProjectsUseCase                todo.Projects                       `resolve:""`
+	CustomFieldsUseCase            todo.CustomFields                   `resolve:""`
+	BackfillEmbeddingsUseCase      todo.BackfillEmbeddings             `resolve:""`
+	SessionsUseCase                session.Sessions                    `resolve:""`
+	LoginsUseCase                  session.Logins                      `resolve:""`
+	TokensUseCase                  session.Tokens                      `resolve:""`
+	SessionStreams                 access.SessionStreams               `resolve:""`
+	AuditLog                       audit.Log                           `resolve:""`
+	SyncUseCase                    todo.Sync                           `resolve:""`
+	InboundWebhooksUseCase         todo.InboundWebhooks                `resolve:""`
+	GetBoardSummaryUseCase         board.GetBoardSummary               `resolve:""`
+	ListWeeklyReviewsUseCase       board.ListWeeklyReviews             `resolve:""`
+	ListConversationsUseCase       chat.ListConversations              `resolve:""`
+	CountUnreadMessagesUseCase     chat.CountUnreadMessages            `resolve:""`
+	MarkConversationReadUseCase    chat.MarkConversationRead           `resolve:""`
+	PostSystemMessageUseCase       chat.PostSystemMessage              `resolve:""`
+	UpdateConversationUseCase      chat.UpdateConversation             `resolve:""`
+	ReplayTurnUseCase              chat.ReplayTurn                     `resolve:""`
+	GetTurnTranscriptUseCase       chat.GetTurnTranscript              `resolve:""`
+	ConversationRepo               assistant.ConversationRepository    `resolve:""`
+	ListChatMessagesUseCase        chat.ListChatMessages               `resolve:""`
+	GetContentBlobUseCase          chat.GetContentBlob                 `resolve:""`
+	SubmitMessageFeedbackUseCase   chat.SubmitMessageFeedback          `resolve:""`
+	GetExperimentReportUseCase     chat.GetExperimentReport            `resolve:""`
+	SubmitActionApprovalUseCase    chat.SubmitActionApproval           `resolve:""`
+	DeleteConversationUseCase      chat.DeleteConversation             `resolve:""`
+	RelatedConversationsUseCase    chat.RelatedConversations           `resolve:""`
+	ListAvailableModelsUseCase     chat.ListAvailableModels            `resolve:""`
+	ListAvailableSkillsUseCase     chat.ListAvailableSkills            `resolve:""`
+	InstructionsUseCase            chat.Instructions                   `resolve:""`
+	MemoriesUseCase                chat.Memories                       `resolve:""`
+	StreamChatUseCase              chat.StreamChat                     `resolve:""`
+	PreviewChatUseCase             chat.PreviewChat                    `resolve:""`
+	TodoEventStream                domainoutbox.TodoEventStream        `resolve:""`
+	ChatMessageEventStream         domainoutbox.ChatMessageEventStream `resolve:""`
+	TodoRepo                       domaintodo.Repository               `resolve:""`
+	TimeProvider                   core.CurrentTimeProvider            `resolve:""`
+	TenantDirectory                tenant.Directory                    `resolve:""`
+	Authenticator                  access.Authenticator                `resolve:""`
+	ContextCompactionTriggerTokens int                                 `config:"CHAT_COMPACTION_TRIGGER_TOKENS"`
+	CalDAVUsername                 string                              `config:"CALDAV_USERNAME" default:"todoapp"`
+	CalDAVPassword                 string                              `config:"CALDAV_PASSWORD" default:""`
+	FaultInjector                  *faultinject.Injector               `resolve:""`
+	FaultInjectionToken            string                              `config:"FAULT_INJECTION_ADMIN_TOKEN" default:""`
+	ExperimentsAdminToken          string                              `config:"EXPERIMENTS_ADMIN_TOKEN" default:""`
+	AuditAdminToken                string                              `config:"AUDIT_ADMIN_TOKEN" default:""`
+	ConfigReloader                 core.ConfigReloader                 `resolve:""`
+	ConfigAdminToken               string                              `config:"CONFIG_ADMIN_TOKEN" default:""`
+	EmbeddingsAdminToken           string                              `config:"EMBEDDINGS_ADMIN_TOKEN" default:""`
+	OutboxMonitor                  outbox.Monitor                      `resolve:""`
+	OutboxAdminToken               string                              `config:"OUTBOX_ADMIN_TOKEN" default:""`
+	DisabledAPIVersions            string                              `config:"API_DISABLED_VERSIONS" default:""`
+	ChatEmbedEnabled               bool                                `config:"CHAT_EMBED_ENABLED" default:"false"`
+	ChatEmbedFrameAncestors        string                              `config:"CHAT_EMBED_FRAME_ANCESTORS" default:""`
+	DemoUIEnabled                  bool                                `config:"DEMO_UI_ENABLED" default:"false"`
 	introspectionReport            introspection.Report
 }
 
@@ -65,19 +130,154 @@ func (api TodoAppServer) Run(ctx context.Context) error {
 	}
 	mux.Handle("/", http.FileServerFS(sub))
 
+	// Register the demo UI. It is disabled unless DEMO_UI_ENABLED is set, and takes over the root
+	// page when the web app was not built into the binary.
+	if api.DemoUIEnabled {
+		mux.Handle(demoBasePath, demoHandler{})
+		if !webappBuilt(sub) {
+			mux.Handle("GET /{$}", http.RedirectHandler(demoBasePath, http.StatusFound))
+		}
+	}
+
 	// Register introspection endpoint for debugging and testing purposes
 	mux.Handle("/introspect/", mermaid.NewGraphHandler("TodoApp", api.introspectionReport))
 
-	// Create the OpenAPI handler with telemetry middleware
-	h := gen.HandlerWithOptions(api, gen.StdHTTPServerOptions{
-		BaseRouter: mux,
-		Middlewares: []gen.MiddlewareFunc{
-			telemetry.Middleware("todoapp-api"),
-		},
-	})
+	// Register the CalDAV endpoint for Apple Reminders, Thunderbird and other VTODO clients.
+	// It is disabled unless a password is configured.
+	if api.CalDAVPassword != "" {
+		mux.Handle(caldav.BasePath, telemetry.Middleware("todoapp-caldav")(tenantMiddleware(api.TenantDirectory)(caldav.Handler{
+			Logger:       api.Logger,
+			TodoRepo:     api.TodoRepo,
+			ListTodos:    api.ListTodosUseCase,
+			CreateTodo:   api.CreateTodoUseCase,
+			UpdateTodo:   api.UpdateTodoUseCase,
+			DeleteTodo:   api.DeleteTodoUseCase,
+			FocusBlocks:  api.FocusBlocksUseCase,
+			TimeProvider: api.TimeProvider,
+			Username:     api.CalDAVUsername,
+			Password:     api.CalDAVPassword,
+		})))
+		mux.Handle("/.well-known/caldav", http.RedirectHandler(caldav.BasePath, http.StatusMovedPermanently))
+	}
+
+	// Register the embeddable chat widget for other internal tools. It is disabled unless CHAT_EMBED_ENABLED is set.
+	if api.ChatEmbedEnabled {
+		mux.Handle(embedBasePath, embedHandler{FrameAncestors: api.ChatEmbedFrameAncestors})
+	}
+
+	// When API principals are configured, the admin endpoints require an admin principal instead of
+	// their own admin tokens.
+	principalsEnabled := api.Authenticator != nil && api.Authenticator.Enabled()
+	adminAccess := accessMiddleware(api.Authenticator, adminRole)
+	// adminGuard guards an admin endpoint with its admin token, or with the admin role when API principals are enabled.
+	adminGuard := func(token string) func(http.Handler) http.Handler {
+		if principalsEnabled {
+			return adminAccess
+		}
+		return adminTokenMiddleware(token)
+	}
+
+	// Register the fault injection admin API used by resilience tests. It is disabled unless
+	// FAULT_INJECTION_ENABLED is set, and is never mounted without an admin token or API principals.
+	if api.FaultInjector.Enabled() {
+		if api.FaultInjectionToken != "" || principalsEnabled {
+			faults := telemetry.Middleware("todoapp-admin")(tenantMiddleware(api.TenantDirectory)(adminGuard(api.FaultInjectionToken)(faultsHandler{
+				Injector: api.FaultInjector,
+			})))
+			mux.Handle(faultsAdminPath, faults)
+			mux.Handle(faultsAdminPath+"/", faults)
+		} else {
+			api.Logger.Println("TodoAppServer: fault injection admin API not mounted, set FAULT_INJECTION_ADMIN_TOKEN or API_PRINCIPALS")
+		}
+	}
+
+	// Register the experiments admin endpoint reporting the outcomes of the chat experiment variants.
+	// It is disabled unless an admin token or API principals are configured.
+	if api.ExperimentsAdminToken != "" || principalsEnabled {
+		experiments := experimentsHandler{
+			Logger: api.Logger,
+			Report: api.GetExperimentReportUseCase,
+		}
+		mux.Handle(experimentsAdminPath, telemetry.Middleware("todoapp-admin")(tenantMiddleware(api.TenantDirectory)(adminGuard(api.ExperimentsAdminToken)(experiments))))
+	}
+
+	// Register the audit log export endpoint. It is disabled unless an admin token or API principals are configured.
+	if api.AuditAdminToken != "" || principalsEnabled {
+		auditExport := auditHandler{
+			Logger: api.Logger,
+			Log:    api.AuditLog,
+		}
+		mux.Handle(auditAdminPath, telemetry.Middleware("todoapp-admin")(tenantMiddleware(api.TenantDirectory)(adminGuard(api.AuditAdminToken)(auditExport))))
+	}
+
+	// Register the configuration reload endpoint. It is disabled unless an admin token or API principals are configured.
+	if api.ConfigAdminToken != "" || principalsEnabled {
+		reload := configReloadHandler{
+			Logger:   api.Logger,
+			Reloader: api.ConfigReloader,
+		}
+		mux.Handle(configReloadAdminPath, telemetry.Middleware("todoapp-admin")(tenantMiddleware(api.TenantDirectory)(adminGuard(api.ConfigAdminToken)(reload))))
+	}
+
+	// Register the embedding backfills endpoint reporting the progress of cmd/backfill-embeddings. It is disabled
+	// unless an admin token or API principals are configured.
+	if api.EmbeddingsAdminToken != "" || principalsEnabled {
+		backfills := embeddingBackfillsHandler{
+			Logger:   api.Logger,
+			Backfill: api.BackfillEmbeddingsUseCase,
+		}
+		mux.Handle(embeddingBackfillsAdminPath, telemetry.Middleware("todoapp-admin")(tenantMiddleware(api.TenantDirectory)(adminGuard(api.EmbeddingsAdminToken)(backfills))))
+	}
+
+	// Register the outbox status endpoint reporting unpublished events and consumer lag. It is disabled unless an
+	// admin token or API principals are configured.
+	if api.OutboxAdminToken != "" || principalsEnabled {
+		status := outboxStatusHandler{
+			Logger:  api.Logger,
+			Monitor: api.OutboxMonitor,
+		}
+		mux.Handle(outboxStatusAdminPath, telemetry.Middleware("todoapp-admin")(tenantMiddleware(api.TenantDirectory)(adminGuard(api.OutboxAdminToken)(status))))
+	}
+
+	disabledVersions, err := parseAPIVersions(api.DisabledAPIVersions)
+	if err != nil {
+		return fmt.Errorf("invalid API_DISABLED_VERSIONS: %w", err)
+	}
+
+	// Register the OpenAPI handlers of each enabled version with telemetry middleware.
+	// Disabled versions answer 410 Gone so clients can tell them apart from unknown routes.
+	// Later middlewares wrap earlier ones, so the tenant is resolved before the access check looks up sessions,
+	// and mutations are audited on behalf of the authenticated principal.
+	if disabledVersions[apiV1] {
+		mux.Handle("/api/v1/", disabledVersionHandler(apiV1))
+	} else {
+		gen.HandlerWithOptions(api, gen.StdHTTPServerOptions{
+			BaseRouter: mux,
+			Middlewares: []gen.MiddlewareFunc{
+				auditMiddleware(api.AuditLog),
+				accessMiddleware(api.Authenticator, routeRole),
+				tenantMiddleware(api.TenantDirectory),
+				deprecationMiddleware(deprecatedRoutes),
+				telemetry.Middleware("todoapp-api"),
+			},
+		})
+	}
+	if disabledVersions[apiV2] {
+		mux.Handle("/api/v2/", disabledVersionHandler(apiV2))
+	} else {
+		genv2.HandlerWithOptions(todoAppServerV2{api}, genv2.StdHTTPServerOptions{
+			BaseRouter: mux,
+			Middlewares: []genv2.MiddlewareFunc{
+				auditMiddleware(api.AuditLog),
+				accessMiddleware(api.Authenticator, routeRole),
+				tenantMiddleware(api.TenantDirectory),
+				telemetry.Middleware("todoapp-api"),
+			},
+		})
+	}
 
 	// Apply CORS at the top-level so preflight requests hit it, too.
-	h = cors.AllowAll().Handler(h)
+	h := cors.AllowAll().Handler(mux)
 
 	s := &http.Server{
 		Handler:           h,
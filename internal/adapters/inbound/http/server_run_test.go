@@ -2,11 +2,18 @@ package http
 
 import (
 	"context"
+	"io"
 	"log"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/faultinject"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTodoAppServer_Run(t *testing.T) {
@@ -47,3 +54,184 @@ func TestTodoAppServer_Run(t *testing.T) {
 
 	}
 }
+
+func TestTodoAppServer_Run_CalDAV(t *testing.T) {
+	t.Parallel()
+
+	cancelCtx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	server := &TodoAppServer{
+		Port:           12346,
+		Logger:         log.New(io.Discard, "", 0),
+		CalDAVUsername: "todoapp",
+		CalDAVPassword: "secret",
+	}
+
+	shutdownCh := make(chan error, 1)
+	go func() {
+		shutdownCh <- server.Run(cancelCtx)
+	}()
+
+	waitUntilReady(t, cancelCtx, server)
+
+	req, err := http.NewRequestWithContext(cancelCtx, "PROPFIND", "http://localhost:12346/caldav/", nil)
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close() //nolint:errcheck
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err = noRedirect.Get("http://localhost:12346/.well-known/caldav")
+	if assert.NoError(t, err) {
+		resp.Body.Close() //nolint:errcheck
+		assert.Equal(t, http.StatusMovedPermanently, resp.StatusCode)
+		assert.Equal(t, "/caldav/", resp.Header.Get("Location"))
+	}
+
+	cancel()
+
+	select {
+	case err := <-shutdownCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "server did not shut down in time")
+	}
+}
+
+func TestTodoAppServer_Run_FaultInjection(t *testing.T) {
+	cancelCtx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	injector := faultinject.NewInjector(true)
+	server := &TodoAppServer{
+		Port:                12347,
+		Logger:              log.New(io.Discard, "", 0),
+		FaultInjector:       injector,
+		FaultInjectionToken: "secret",
+	}
+
+	shutdownCh := make(chan error, 1)
+	go func() {
+		shutdownCh <- server.Run(cancelCtx)
+	}()
+
+	waitUntilReady(t, cancelCtx, server)
+
+	req, err := http.NewRequestWithContext(cancelCtx, http.MethodPut, "http://localhost:12347/admin/faults/assistant", strings.NewReader(`{"error_rate":1}`))
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close() //nolint:errcheck
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, map[faultinject.Target]faultinject.Fault{
+		faultinject.Target_Assistant: {ErrorRate: 1},
+	}, injector.Faults())
+
+	cancel()
+
+	select {
+	case err := <-shutdownCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "server did not shut down in time")
+	}
+}
+
+func TestTodoAppServer_Run_FaultInjectionWithoutCredential(t *testing.T) {
+	cancelCtx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	injector := faultinject.NewInjector(true)
+	server := &TodoAppServer{
+		Port:          12349,
+		Logger:        log.New(io.Discard, "", 0),
+		FaultInjector: injector,
+	}
+
+	shutdownCh := make(chan error, 1)
+	go func() {
+		shutdownCh <- server.Run(cancelCtx)
+	}()
+
+	waitUntilReady(t, cancelCtx, server)
+
+	// Without an admin token or API principals the admin API is not mounted.
+	req, err := http.NewRequestWithContext(cancelCtx, http.MethodPut, "http://localhost:12349/admin/faults/assistant", strings.NewReader(`{"error_rate":1}`))
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close() //nolint:errcheck
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+	assert.Empty(t, injector.Faults())
+
+	cancel()
+
+	select {
+	case err := <-shutdownCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "server did not shut down in time")
+	}
+}
+
+func TestTodoAppServer_Run_ExperimentsAdmin(t *testing.T) {
+	t.Parallel()
+
+	cancelCtx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	report := chat.NewMockGetExperimentReport(t)
+	report.EXPECT().Query(mock.Anything, "prompt-v2").Return(chat.ExperimentReport{Experiment: "prompt-v2"}, nil).Once()
+
+	server := &TodoAppServer{
+		Port:                       12348,
+		Logger:                     log.New(io.Discard, "", 0),
+		GetExperimentReportUseCase: report,
+		ExperimentsAdminToken:      "secret",
+	}
+
+	shutdownCh := make(chan error, 1)
+	go func() {
+		shutdownCh <- server.Run(cancelCtx)
+	}()
+
+	waitUntilReady(t, cancelCtx, server)
+
+	resp, err := http.Get("http://localhost:12348/admin/experiments?name=prompt-v2")
+	if assert.NoError(t, err) {
+		resp.Body.Close() //nolint:errcheck
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+
+	req, err := http.NewRequestWithContext(cancelCtx, http.MethodGet, "http://localhost:12348/admin/experiments?name=prompt-v2", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err = http.DefaultClient.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close() //nolint:errcheck
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	cancel()
+
+	select {
+	case err := <-shutdownCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "server did not shut down in time")
+	}
+}
+
+// waitUntilReady fails the test when the server does not pass its health check in time.
+func waitUntilReady(t *testing.T, ctx context.Context, server *TodoAppServer) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return server.IsReady(ctx) == nil
+	}, 5*time.Second, 10*time.Millisecond, "server did not become ready")
+}
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+
+	apispec "github.com/cleitonmarx/symbiont-ai-todoapp/api"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/trace"
+	"go.yaml.in/yaml/v3"
+)
+
+// loadOpenAPISpec parses the embedded OpenAPI specification once.
+var loadOpenAPISpec = sync.OnceValues(func() (map[string]any, error) {
+	var doc any
+	if err := yaml.Unmarshal(apispec.OpenAPISpec, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
+	}
+	spec, ok := jsonCompatible(doc).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("OpenAPI spec is not an object")
+	}
+	return spec, nil
+})
+
+// GetOpenAPISpec returns the OpenAPI specification as JSON
+// (GET /api/v1/openapi.json)
+func (api TodoAppServer) GetOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	spec, err := loadOpenAPISpec()
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(r.Context()), err) {
+		api.Logger.Printf("Error loading OpenAPI spec: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	// Copy the top-level and info objects, the cached spec is shared by all requests.
+	spec = maps.Clone(spec)
+	info, _ := spec["info"].(map[string]any)
+	info = maps.Clone(info)
+	if info == nil {
+		info = map[string]any{}
+	}
+	info["x-build-version"] = common.BuildVersion()
+	spec["info"] = info
+	spec["servers"] = []map[string]any{{"url": serverURL(r)}}
+
+	respondJSON(w, http.StatusOK, spec)
+}
+
+// GetGraphQLSchema returns the GraphQL schema in SDL
+// (GET /api/v1/graphql/schema)
+func (api TodoAppServer) GetGraphQLSchema(w http.ResponseWriter, _ *http.Request) {
+	respondJSON(w, http.StatusOK, gen.GraphQLSchemaResp{
+		Version: common.BuildVersion(),
+		Schema:  apispec.GraphQLSchema,
+	})
+}
+
+// serverURL returns the origin the client used to reach the server, honoring reverse proxy headers.
+func serverURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := firstHeaderValue(r, "X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	host := r.Host
+	if forwardedHost := firstHeaderValue(r, "X-Forwarded-Host"); forwardedHost != "" {
+		host = forwardedHost
+	}
+	return scheme + "://" + host
+}
+
+// firstHeaderValue returns the first entry of a comma-separated header, as set by a chain of proxies.
+func firstHeaderValue(r *http.Request, name string) string {
+	value, _, _ := strings.Cut(r.Header.Get(name), ",")
+	return strings.TrimSpace(value)
+}
+
+// jsonCompatible converts YAML decoded maps with non-string keys, such as response codes, to JSON objects.
+func jsonCompatible(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			v[k] = jsonCompatible(item)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = jsonCompatible(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = jsonCompatible(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,100 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apispec "github.com/cleitonmarx/symbiont-ai-todoapp/api"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoAppServer_GetOpenAPISpec(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		host              string
+		headers           map[string]string
+		expectedServerURL string
+	}{
+		"direct": {
+			host:              "localhost:8080",
+			expectedServerURL: "http://localhost:8080",
+		},
+		"behind-proxy": {
+			host: "todoapp-http-api:8080",
+			headers: map[string]string{
+				"X-Forwarded-Proto": "https",
+				"X-Forwarded-Host":  "todo.example.com, todoapp-ingress",
+			},
+			expectedServerURL: "https://todo.example.com",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := &TodoAppServer{Logger: log.New(io.Discard, "", 0)}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil)
+			req.Host = tt.host
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			server.GetOpenAPISpec(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+			var spec struct {
+				OpenAPI string `json:"openapi"`
+				Info    struct {
+					Title        string `json:"title"`
+					Version      string `json:"version"`
+					BuildVersion string `json:"x-build-version"`
+				} `json:"info"`
+				Servers []struct {
+					URL string `json:"url"`
+				} `json:"servers"`
+				Paths map[string]map[string]struct {
+					OperationID string                    `json:"operationId"`
+					Responses   map[string]map[string]any `json:"responses"`
+				} `json:"paths"`
+			}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+			assert.Equal(t, "3.0.3", spec.OpenAPI)
+			assert.Equal(t, "Todo API", spec.Info.Title)
+			assert.Equal(t, "1.0.0", spec.Info.Version)
+			assert.Equal(t, "dev", spec.Info.BuildVersion)
+			if assert.Len(t, spec.Servers, 1) {
+				assert.Equal(t, tt.expectedServerURL, spec.Servers[0].URL)
+			}
+			operation := spec.Paths["/api/v1/openapi.json"]["get"]
+			assert.Equal(t, "getOpenAPISpec", operation.OperationID)
+			assert.Contains(t, operation.Responses, "200")
+		})
+	}
+}
+
+func TestTodoAppServer_GetGraphQLSchema(t *testing.T) {
+	t.Parallel()
+
+	server := &TodoAppServer{Logger: log.New(io.Discard, "", 0)}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/graphql/schema", nil)
+	w := httptest.NewRecorder()
+
+	server.GetGraphQLSchema(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response gen.GraphQLSchemaResp
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, gen.GraphQLSchemaResp{Version: "dev", Schema: apispec.GraphQLSchema}, response)
+	assert.Contains(t, response.Schema, "type Query {")
+}
@@ -0,0 +1,121 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListSessions lists the active sessions of the calling principal
+// (GET /api/v1/sessions)
+func (api TodoAppServer) ListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sessions, err := api.SessionsUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing sessions: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	current := access.FromContext(ctx).SessionID
+	resp := gen.ListSessionsResp{
+		Items: make([]gen.Session, len(sessions)),
+	}
+	for i, s := range sessions {
+		resp.Items[i] = toSession(s, current)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// StartSession starts a session for the principal of the API token
+// (POST /api/v1/sessions)
+func (api TodoAppServer) StartSession(w http.ResponseWriter, r *http.Request) {
+	var req gen.StartSessionRequest
+	// The body is optional.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+	device := r.UserAgent()
+	if req.Device != nil {
+		device = *req.Device
+	}
+
+	ctx := r.Context()
+	issued, err := api.SessionsUseCase.Start(ctx, device)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error starting session: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toSessionTokens(issued))
+}
+
+// RefreshSession exchanges a refresh token for new session tokens
+// (POST /api/v1/sessions/refresh)
+func (api TodoAppServer) RefreshSession(w http.ResponseWriter, r *http.Request) {
+	var req gen.RefreshSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	issued, err := api.SessionsUseCase.Refresh(ctx, req.RefreshToken)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error refreshing session: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toSessionTokens(issued))
+}
+
+// RevokeSession revokes a session and terminates its streams
+// (DELETE /api/v1/sessions/{session_id})
+func (api TodoAppServer) RevokeSession(w http.ResponseWriter, r *http.Request, sessionId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.SessionsUseCase.Revoke(ctx, sessionId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error revoking session: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// trackSessionStream returns a copy of ctx that is canceled when the session the request was
+// authenticated with is revoked, and a function to call when the stream ends. Requests without
+// a session are not tracked.
+func (api TodoAppServer) trackSessionStream(ctx context.Context) (context.Context, func()) {
+	sessionID := access.FromContext(ctx).SessionID
+	if sessionID == uuid.Nil || api.SessionStreams == nil {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	untrack := api.SessionStreams.Track(sessionID, cancel)
+	return ctx, func() {
+		untrack()
+		cancel()
+	}
+}
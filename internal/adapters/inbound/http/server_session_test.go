@@ -0,0 +1,333 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	sessionID     = uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	sessionTime   = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	domainSession = access.Session{
+		ID:              sessionID,
+		Principal:       "viewer",
+		Role:            access.RoleReadonly,
+		Device:          "laptop",
+		AccessExpiresAt: sessionTime.Add(15 * time.Minute),
+		ExpiresAt:       sessionTime.Add(720 * time.Hour),
+		CreatedAt:       sessionTime,
+		LastUsedAt:      sessionTime,
+	}
+	restSession = gen.Session{
+		Id:         sessionID,
+		Device:     "laptop",
+		Current:    true,
+		CreatedAt:  sessionTime,
+		LastUsedAt: sessionTime,
+		ExpiresAt:  sessionTime.Add(720 * time.Hour),
+	}
+	issuedSession = session.Issued{
+		Session:      domainSession,
+		AccessToken:  "sess_access",
+		RefreshToken: "refr_refresh",
+	}
+	restSessionTokens = gen.SessionTokens{
+		Session:         restSession,
+		AccessToken:     "sess_access",
+		AccessExpiresAt: sessionTime.Add(15 * time.Minute),
+		RefreshToken:    "refr_refresh",
+	}
+)
+
+func TestTodoAppServer_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	other := domainSession
+	other.ID = uuid.MustParse("523e4567-e89b-12d3-a456-426614174000")
+	otherRest := restSession
+	otherRest.Id = other.ID
+	otherRest.Current = false
+
+	tests := map[string]struct {
+		setupUsecases  func(*session.MockSessions)
+		expectedStatus int
+		expectedBody   *gen.ListSessionsResp
+	}{
+		"marks-current-session": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().List(mock.Anything).Return([]access.Session{domainSession, other}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.ListSessionsResp{Items: []gen.Session{restSession, otherRest}},
+		},
+		"usecase-error": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().List(mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sessions := session.NewMockSessions(t)
+			tt.setupUsecases(sessions)
+
+			server := &TodoAppServer{
+				SessionsUseCase: sessions,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions", nil)
+			req = req.WithContext(access.NewContext(req.Context(), domainSession.AsPrincipal()))
+			w := httptest.NewRecorder()
+
+			server.ListSessions(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var resp gen.ListSessionsResp
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, *tt.expectedBody, resp)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_StartSession(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body           string
+		setupUsecases  func(*session.MockSessions)
+		expectedStatus int
+		expectedBody   *gen.SessionTokens
+	}{
+		"device-from-body": {
+			body: `{"device":"laptop"}`,
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Start(mock.Anything, "laptop").Return(issuedSession, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   &restSessionTokens,
+		},
+		"device-from-user-agent": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Start(mock.Anything, "todo-cli/1.0").Return(issuedSession, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   &restSessionTokens,
+		},
+		"invalid-body": {
+			body:           `{`,
+			setupUsecases:  func(*session.MockSessions) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"principals-disabled": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Start(mock.Anything, "todo-cli/1.0").
+					Return(session.Issued{}, core.NewValidationErr("sessions require API_PRINCIPALS to be configured"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"started-from-session": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Start(mock.Anything, "todo-cli/1.0").
+					Return(session.Issued{}, core.NewForbiddenErr("sessions can only be started with an API token"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sessions := session.NewMockSessions(t)
+			tt.setupUsecases(sessions)
+
+			server := &TodoAppServer{
+				SessionsUseCase: sessions,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions", bytes.NewBufferString(tt.body))
+			req.Header.Set("User-Agent", "todo-cli/1.0")
+			w := httptest.NewRecorder()
+
+			server.StartSession(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var resp gen.SessionTokens
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, *tt.expectedBody, resp)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_RefreshSession(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body           string
+		setupUsecases  func(*session.MockSessions)
+		expectedStatus int
+		expectedBody   *gen.SessionTokens
+	}{
+		"success": {
+			body: `{"refresh_token":"refr_old"}`,
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Refresh(mock.Anything, "refr_old").Return(issuedSession, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restSessionTokens,
+		},
+		"invalid-body": {
+			body:           `{`,
+			setupUsecases:  func(*session.MockSessions) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"expired-token": {
+			body: `{"refresh_token":"refr_old"}`,
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Refresh(mock.Anything, "refr_old").
+					Return(session.Issued{}, core.NewUnauthorizedErr("invalid or expired refresh token"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sessions := session.NewMockSessions(t)
+			tt.setupUsecases(sessions)
+
+			server := &TodoAppServer{
+				SessionsUseCase: sessions,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/refresh", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			server.RefreshSession(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var resp gen.SessionTokens
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, *tt.expectedBody, resp)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*session.MockSessions)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Revoke(mock.Anything, sessionID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"not-found": {
+			setupUsecases: func(m *session.MockSessions) {
+				m.EXPECT().Revoke(mock.Anything, sessionID).Return(core.NewNotFoundErr("session not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sessions := session.NewMockSessions(t)
+			tt.setupUsecases(sessions)
+
+			server := &TodoAppServer{
+				SessionsUseCase: sessions,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/sessions/"+sessionID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.RevokeSession(w, req, sessionID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestTodoAppServer_TrackSessionStream(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		principal      access.Principal
+		setupStreams   func(*access.MockSessionStreams, *bool)
+		revoke         bool
+		expectCanceled bool
+	}{
+		"api-token-not-tracked": {
+			principal:    access.Principal{Name: "viewer", Role: access.RoleReadonly},
+			setupStreams: func(*access.MockSessionStreams, *bool) {},
+		},
+		"session-tracked": {
+			principal: domainSession.AsPrincipal(),
+			setupStreams: func(m *access.MockSessionStreams, untracked *bool) {
+				m.EXPECT().Track(sessionID, mock.Anything).Return(func() { *untracked = true }).Once()
+			},
+		},
+		"revoked-session-cancels-stream": {
+			principal: domainSession.AsPrincipal(),
+			setupStreams: func(m *access.MockSessionStreams, untracked *bool) {
+				m.EXPECT().Track(sessionID, mock.Anything).
+					RunAndReturn(func(_ uuid.UUID, cancel context.CancelFunc) func() {
+						cancel()
+						return func() { *untracked = true }
+					}).Once()
+			},
+			expectCanceled: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			streams := access.NewMockSessionStreams(t)
+			var untracked bool
+			tt.setupStreams(streams, &untracked)
+
+			server := TodoAppServer{SessionStreams: streams}
+			ctx, untrack := server.trackSessionStream(access.NewContext(t.Context(), tt.principal))
+
+			if tt.expectCanceled {
+				assert.Error(t, ctx.Err())
+			} else {
+				assert.NoError(t, ctx.Err())
+			}
+			untrack()
+			assert.Equal(t, tt.principal.SessionID != uuid.Nil, untracked)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultSyncLimit is the number of changes read from each change log when the limit parameter is omitted.
+const defaultSyncLimit = 100
+
+// PullSync returns the todos and conversations changed since a sync cursor, plus tombstones.
+// (GET /api/v1/sync)
+func (api TodoAppServer) PullSync(w http.ResponseWriter, r *http.Request, params gen.PullSyncParams) {
+	ctx := r.Context()
+
+	var since string
+	if params.Since != nil {
+		since = *params.Since
+	}
+	limit := defaultSyncLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	page, err := api.SyncUseCase.Pull(ctx, since, limit)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error pulling sync changes: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toSyncResp(page))
+}
+
+// PushSync applies todo mutations recorded by an offline client.
+// (POST /api/v1/sync)
+func (api TodoAppServer) PushSync(w http.ResponseWriter, r *http.Request) {
+	var req gen.PushSyncJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	results, err := api.SyncUseCase.Push(ctx, toSyncMutations(req.Mutations))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error pushing sync mutations: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.SyncPushResp{
+		Results: make([]gen.SyncMutationResult, len(results)),
+	}
+	for i, result := range results {
+		if result.Err != nil {
+			api.Logger.Printf("Sync mutation %d failed: %v", i, result.Err)
+		}
+		resp.Results[i] = toSyncMutationResult(i, result)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
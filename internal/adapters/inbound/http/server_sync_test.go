@@ -0,0 +1,261 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	syncTodoID         = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	syncConversationID = uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	syncTime           = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	syncDueDate        = time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	syncDomainTodo     = todo.Todo{
+		ID:        syncTodoID,
+		Title:     "Buy milk",
+		Status:    todo.Status_DONE,
+		DueDate:   syncDueDate,
+		CreatedAt: syncTime,
+		UpdatedAt: syncTime,
+	}
+	syncRestTodo = gen.Todo{
+		Id:        syncTodoID,
+		Title:     "Buy milk",
+		Status:    gen.DONE,
+		DueDate:   openapi_types.Date{Time: syncDueDate},
+		CreatedAt: syncTime,
+		UpdatedAt: syncTime,
+	}
+)
+
+func TestTodoAppServer_PullSync(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params         gen.PullSyncParams
+		setupUsecases  func(*todouc.MockSync)
+		expectedStatus int
+		expectedBody   *gen.SyncResp
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			params: gen.PullSyncParams{Since: common.Ptr("10.3"), Limit: common.Ptr(20)},
+			setupUsecases: func(m *todouc.MockSync) {
+				m.EXPECT().
+					Pull(mock.Anything, "10.3", 20).
+					Return(todouc.SyncPage{
+						Todos: []todo.Todo{syncDomainTodo},
+						Conversations: []assistant.Conversation{{
+							ID:          syncConversationID,
+							Title:       "Weekly plan",
+							TitleSource: assistant.ConversationTitleSource_User,
+							CreatedAt:   syncTime,
+							UpdatedAt:   syncTime,
+						}},
+						Tombstones: []todouc.SyncTombstone{
+							{Entity: todouc.SyncEntity_TODO, ID: syncConversationID, DeletedAt: syncTime},
+						},
+						Cursor:  todouc.SyncCursor{Todo: 12, Conversation: 4},
+						HasMore: true,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.SyncResp{
+				Todos: []gen.Todo{syncRestTodo},
+				Conversations: []gen.SyncConversation{{
+					Id:          syncConversationID,
+					Title:       "Weekly plan",
+					TitleSource: gen.ConversationTitleSourceUser,
+					CreatedAt:   syncTime,
+					UpdatedAt:   syncTime,
+				}},
+				Tombstones: []gen.SyncTombstone{
+					{Entity: gen.TODO, Id: syncConversationID, DeletedAt: syncTime},
+				},
+				Cursor:  "12.4",
+				HasMore: true,
+			},
+		},
+		"default-limit": {
+			setupUsecases: func(m *todouc.MockSync) {
+				m.EXPECT().
+					Pull(mock.Anything, "", defaultSyncLimit).
+					Return(todouc.SyncPage{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.SyncResp{
+				Todos:         []gen.Todo{},
+				Conversations: []gen.SyncConversation{},
+				Tombstones:    []gen.SyncTombstone{},
+				Cursor:        "0.0",
+			},
+		},
+		"invalid-cursor": {
+			params: gen.PullSyncParams{Since: common.Ptr("abc")},
+			setupUsecases: func(m *todouc.MockSync) {
+				m.EXPECT().
+					Pull(mock.Anything, "abc", defaultSyncLimit).
+					Return(todouc.SyncPage{}, core.NewValidationErr(`invalid sync cursor "abc"`))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: `invalid sync cursor "abc"`},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockSync := todouc.NewMockSync(t)
+			tt.setupUsecases(mockSync)
+
+			server := &TodoAppServer{
+				SyncUseCase: mockSync,
+				Logger:      log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/sync", nil)
+			w := httptest.NewRecorder()
+
+			server.PullSync(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.SyncResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_PushSync(t *testing.T) {
+	t.Parallel()
+
+	createdID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockSync)
+		expectedStatus int
+		expectedBody   *gen.SyncPushResp
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"mutations":[` +
+				`{"operation":"UPDATE","todo_id":"123e4567-e89b-12d3-a456-426614174000","status":"DONE","base_updated_at":"2026-03-02T09:00:00Z"},` +
+				`{"operation":"CREATE","title":"Call mom","due_date":"2026-03-05"},` +
+				`{"operation":"DELETE","todo_id":"123e4567-e89b-12d3-a456-426614174000"}]}`),
+			setupUsecases: func(m *todouc.MockSync) {
+				m.EXPECT().
+					Push(mock.Anything, []todouc.SyncMutation{
+						{
+							TodoOperation: todouc.TodoOperation{Kind: todouc.TodoOperationKind_UPDATE, ID: &syncTodoID, Status: common.Ptr(todo.Status_DONE)},
+							BaseUpdatedAt: &syncTime,
+						},
+						{TodoOperation: todouc.TodoOperation{Kind: todouc.TodoOperationKind_CREATE, Title: common.Ptr("Call mom"), DueDate: &syncDueDate}},
+						{TodoOperation: todouc.TodoOperation{Kind: todouc.TodoOperationKind_DELETE, ID: &syncTodoID}},
+					}).
+					Return([]todouc.SyncMutationResult{
+						{Kind: todouc.TodoOperationKind_UPDATE, Status: todouc.SyncMutationStatus_CONFLICT, ID: syncTodoID, Todo: &syncDomainTodo},
+						{Kind: todouc.TodoOperationKind_CREATE, Status: todouc.SyncMutationStatus_APPLIED, ID: createdID, Todo: &todo.Todo{ID: createdID, Title: "Call mom", Status: todo.Status_OPEN, DueDate: syncDueDate}},
+						{Kind: todouc.TodoOperationKind_DELETE, Status: todouc.SyncMutationStatus_FAILED, ID: syncTodoID, Err: errors.New("db error")},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.SyncPushResp{
+				Results: []gen.SyncMutationResult{
+					{Index: 0, Operation: gen.UPDATE, Status: gen.SyncMutationStatusCONFLICT, TodoId: &syncTodoID, Todo: &syncRestTodo},
+					{
+						Index:     1,
+						Operation: gen.CREATE,
+						Status:    gen.SyncMutationStatusAPPLIED,
+						TodoId:    &createdID,
+						Todo:      &gen.Todo{Id: createdID, Title: "Call mom", Status: gen.OPEN, DueDate: openapi_types.Date{Time: syncDueDate}},
+					},
+					{
+						Index:     2,
+						Operation: gen.DELETE,
+						Status:    gen.SyncMutationStatusFAILED,
+						TodoId:    &syncTodoID,
+						Error:     &gen.Error{Code: gen.INTERNALERROR, Message: "internal server error"},
+					},
+				},
+			},
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*todouc.MockSync) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "invalid request body: unexpected EOF"},
+			},
+		},
+		"empty-push": {
+			requestBody: []byte(`{"mutations":[]}`),
+			setupUsecases: func(m *todouc.MockSync) {
+				m.EXPECT().
+					Push(mock.Anything, []todouc.SyncMutation{}).
+					Return(nil, core.NewValidationErr("at least one mutation is required"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "at least one mutation is required"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockSync := todouc.NewMockSync(t)
+			tt.setupUsecases(mockSync)
+
+			server := &TodoAppServer{
+				SyncUseCase: mockSync,
+				Logger:      log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sync", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.PushSync(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.SyncPushResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
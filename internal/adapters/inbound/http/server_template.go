@@ -0,0 +1,130 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTemplates lists the saved templates
+// (GET /api/v1/templates)
+func (api TodoAppServer) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	templates, err := api.TemplatesUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing templates: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListTemplatesResp{
+		Items: make([]gen.Template, len(templates)),
+	}
+	for i, t := range templates {
+		resp.Items[i] = toTemplate(t)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// SaveTemplate saves a named list of todos, replacing the template with the same name
+// (POST /api/v1/templates)
+func (api TodoAppServer) SaveTemplate(w http.ResponseWriter, r *http.Request) {
+	var req gen.SaveTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	template, err := api.TemplatesUseCase.Save(ctx, req.Name, toTemplateItems(req.Items))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error saving template: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTemplate(template))
+}
+
+// UpdateTemplate renames a template and replaces its items
+// (PATCH /api/v1/templates/{template_id})
+func (api TodoAppServer) UpdateTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	var req gen.UpdateTemplateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	template, err := api.TemplatesUseCase.Update(ctx, templateId, req.Name, toTemplateItems(req.Items))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error updating template: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTemplate(template))
+}
+
+// DeleteTemplate deletes a template
+// (DELETE /api/v1/templates/{template_id})
+func (api TodoAppServer) DeleteTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.TemplatesUseCase.Delete(ctx, templateId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error deleting template: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ApplyTemplate creates the todos of a template, due relative to the anchor date
+// (POST /api/v1/templates/{template_id}/apply)
+func (api TodoAppServer) ApplyTemplate(w http.ResponseWriter, r *http.Request, templateId openapi_types.UUID) {
+	var req gen.ApplyTemplateJSONRequestBody
+	// The body is optional.
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+	anchor := api.TimeProvider.Now()
+	if req.AnchorDate != nil {
+		anchor = req.AnchorDate.Time
+	}
+
+	ctx := r.Context()
+	todos, err := api.TemplatesUseCase.Apply(ctx, templateId, anchor)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error applying template: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ApplyTemplateResp{
+		Items: make([]gen.Todo, len(todos)),
+	}
+	for i, t := range todos {
+		resp.Items[i] = toTodo(t)
+	}
+
+	respondJSON(w, http.StatusCreated, resp)
+}
@@ -0,0 +1,341 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	templateID     = uuid.MustParse("963e4567-e89b-12d3-a456-426614174000")
+	templateTime   = time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	domainTemplate = todo.Template{
+		ID:   templateID,
+		Name: "Trip packing",
+		Items: []todo.TemplateItem{
+			{Title: "Book airport transfer", DueOffsetDays: -3},
+			{Title: "Pack passport", DueOffsetDays: -1, EstimatedMinutes: 5},
+		},
+		CreatedAt: templateTime,
+		UpdatedAt: templateTime,
+	}
+	restTemplate = gen.Template{
+		Id:   templateID,
+		Name: "Trip packing",
+		Items: []gen.TemplateItem{
+			{Title: "Book airport transfer", DueOffsetDays: -3},
+			{Title: "Pack passport", DueOffsetDays: -1, EstimatedMinutes: common.Ptr(5)},
+		},
+		CreatedAt: templateTime,
+		UpdatedAt: templateTime,
+	}
+)
+
+func TestTodoAppServer_ListTemplates(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockTemplates)
+		expectedStatus int
+		expectedBody   *gen.ListTemplatesResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return([]todo.Template{domainTemplate}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.ListTemplatesResp{Items: []gen.Template{restTemplate}},
+		},
+		"internal-error": {
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTemplates := todouc.NewMockTemplates(t)
+			tt.setupUsecases(mockTemplates)
+
+			server := &TodoAppServer{
+				TemplatesUseCase: mockTemplates,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/templates", nil)
+			w := httptest.NewRecorder()
+
+			server.ListTemplates(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.ListTemplatesResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_SaveTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockTemplates)
+		expectedStatus int
+		expectedBody   *gen.Template
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"name":"Trip packing","items":[{"title":"Book airport transfer","due_offset_days":-3},{"title":"Pack passport","due_offset_days":-1,"estimated_minutes":5}]}`),
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Save(mock.Anything, "Trip packing", domainTemplate.Items).Return(domainTemplate, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restTemplate,
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*todouc.MockTemplates) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "invalid request body: unexpected EOF"},
+			},
+		},
+		"validation-error": {
+			requestBody: []byte(`{"name":"Trip packing","items":[]}`),
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Save(mock.Anything, "Trip packing", []todo.TemplateItem{}).
+					Return(todo.Template{}, core.NewValidationErr("templates must have between 1 and 50 items"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "templates must have between 1 and 50 items"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTemplates := todouc.NewMockTemplates(t)
+			tt.setupUsecases(mockTemplates)
+
+			server := &TodoAppServer{
+				TemplatesUseCase: mockTemplates,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/templates", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.SaveTemplate(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.Template
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_UpdateTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockTemplates)
+		expectedStatus int
+	}{
+		"success": {
+			requestBody: []byte(`{"name":"Trip packing","items":[{"title":"Book airport transfer","due_offset_days":-3},{"title":"Pack passport","due_offset_days":-1,"estimated_minutes":5}]}`),
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Update(mock.Anything, templateID, "Trip packing", domainTemplate.Items).Return(domainTemplate, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*todouc.MockTemplates) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"not-found": {
+			requestBody: []byte(`{"name":"Trip packing","items":[]}`),
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Update(mock.Anything, templateID, "Trip packing", []todo.TemplateItem{}).
+					Return(todo.Template{}, core.NewNotFoundErr("template not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTemplates := todouc.NewMockTemplates(t)
+			tt.setupUsecases(mockTemplates)
+
+			server := &TodoAppServer{
+				TemplatesUseCase: mockTemplates,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/templates/"+templateID.String(), bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.UpdateTemplate(w, req, templateID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestTodoAppServer_DeleteTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockTemplates)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Delete(mock.Anything, templateID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"not-found": {
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Delete(mock.Anything, templateID).Return(core.NewNotFoundErr("template not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTemplates := todouc.NewMockTemplates(t)
+			tt.setupUsecases(mockTemplates)
+
+			server := &TodoAppServer{
+				TemplatesUseCase: mockTemplates,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/templates/"+templateID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.DeleteTemplate(w, req, templateID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestTodoAppServer_ApplyTemplate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	anchor := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	created := todo.Todo{
+		ID:        uuid.MustParse("963e4567-e89b-12d3-a456-426614174001"),
+		Title:     "Pack passport",
+		Status:    todo.Status_OPEN,
+		DueDate:   anchor.AddDate(0, 0, -1),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockTemplates)
+		expectedStatus int
+		expectedBody   *gen.ApplyTemplateResp
+	}{
+		"anchored": {
+			requestBody: []byte(`{"anchor_date":"2026-11-02"}`),
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Apply(mock.Anything, templateID, anchor).Return([]todo.Todo{created}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: &gen.ApplyTemplateResp{
+				Items: []gen.Todo{{
+					Id:        created.ID,
+					Title:     "Pack passport",
+					Status:    gen.OPEN,
+					DueDate:   openapi_types.Date{Time: created.DueDate},
+					CreatedAt: now,
+					UpdatedAt: now,
+				}},
+			},
+		},
+		"no-body-anchors-today": {
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Apply(mock.Anything, templateID, now).Return([]todo.Todo{created}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*todouc.MockTemplates) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"not-found": {
+			setupUsecases: func(m *todouc.MockTemplates) {
+				m.EXPECT().Apply(mock.Anything, templateID, now).Return(nil, core.NewNotFoundErr("template not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTemplates := todouc.NewMockTemplates(t)
+			tt.setupUsecases(mockTemplates)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			server := &TodoAppServer{
+				TemplatesUseCase: mockTemplates,
+				TimeProvider:     timeProvider,
+				Logger:           log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/templates/"+templateID.String()+"/apply", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.ApplyTemplate(w, req, templateID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.ApplyTemplateResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+		})
+	}
+}
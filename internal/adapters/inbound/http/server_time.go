@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultTimeReportDays is the report window used when no range is provided.
+const defaultTimeReportDays = 7
+
+// StartTodoTimer starts a work session timer for a todo
+// (POST /api/v1/todos/{todo_id}/timer/start)
+func (api TodoAppServer) StartTodoTimer(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID) {
+	ctx := r.Context()
+	entry, err := api.TimeTrackerUseCase.StartTimer(ctx, todoId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error starting todo timer: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toTimeEntry(entry, time.Now()))
+}
+
+// StopTodoTimer stops the running work session timer of a todo
+// (POST /api/v1/todos/{todo_id}/timer/stop)
+func (api TodoAppServer) StopTodoTimer(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID) {
+	ctx := r.Context()
+	entry, err := api.TimeTrackerUseCase.StopTimer(ctx, todoId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error stopping todo timer: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTimeEntry(entry, time.Now()))
+}
+
+// GetTimeReport returns the time logged per todo
+// (GET /api/v1/stats/time)
+func (api TodoAppServer) GetTimeReport(w http.ResponseWriter, r *http.Request, params gen.GetTimeReportParams) {
+	to := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	if params.To != nil {
+		to = params.To.Time
+	}
+	from := to.AddDate(0, 0, -defaultTimeReportDays)
+	if params.From != nil {
+		from = params.From.Time
+	}
+
+	ctx := r.Context()
+	items, err := api.GetTimeReportUseCase.Query(ctx, from, to)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error getting time report: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTimeReport(from, to, items))
+}
@@ -0,0 +1,287 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTodoAppServer_StartTodoTimer(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	entryID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockTimeTracker)
+		expectedStatus int
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockTimeTracker) {
+				m.EXPECT().
+					StartTimer(mock.Anything, todoID).
+					Return(todo.TimeEntry{ID: entryID, TodoID: todoID, StartedAt: startedAt}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		"already-running": {
+			setupUsecases: func(m *todouc.MockTimeTracker) {
+				m.EXPECT().
+					StartTimer(mock.Anything, todoID).
+					Return(todo.TimeEntry{}, core.NewValidationErr("a timer is already running for this todo"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "a timer is already running for this todo",
+				},
+			},
+		},
+		"use-case-error": {
+			setupUsecases: func(m *todouc.MockTimeTracker) {
+				m.EXPECT().
+					StartTimer(mock.Anything, todoID).
+					Return(todo.TimeEntry{}, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.INTERNALERROR,
+					Message: "internal server error",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTracker := todouc.NewMockTimeTracker(t)
+			tt.setupUsecases(mockTracker)
+
+			server := &TodoAppServer{
+				TimeTrackerUseCase: mockTracker,
+				Logger:             log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/todos/"+todoID.String()+"/timer/start", nil)
+			w := httptest.NewRecorder()
+
+			server.StartTodoTimer(w, req, todoID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.TimeEntry
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, entryID, response.Id)
+			assert.Equal(t, todoID, response.TodoId)
+			assert.Nil(t, response.EndedAt)
+		})
+	}
+}
+
+func TestTodoAppServer_StopTodoTimer(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	entryID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(45 * time.Minute)
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockTimeTracker)
+		expectedStatus int
+		expectedBody   *gen.TimeEntry
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockTimeTracker) {
+				m.EXPECT().
+					StopTimer(mock.Anything, todoID).
+					Return(todo.TimeEntry{ID: entryID, TodoID: todoID, StartedAt: startedAt, EndedAt: common.Ptr(endedAt)}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.TimeEntry{
+				Id:              entryID,
+				TodoId:          todoID,
+				StartedAt:       startedAt,
+				EndedAt:         common.Ptr(endedAt),
+				DurationSeconds: 2700,
+			},
+		},
+		"no-running-timer": {
+			setupUsecases: func(m *todouc.MockTimeTracker) {
+				m.EXPECT().
+					StopTimer(mock.Anything, todoID).
+					Return(todo.TimeEntry{}, core.NewNotFoundErr("no running timer for todo"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.NOTFOUND,
+					Message: "no running timer for todo",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockTracker := todouc.NewMockTimeTracker(t)
+			tt.setupUsecases(mockTracker)
+
+			server := &TodoAppServer{
+				TimeTrackerUseCase: mockTracker,
+				Logger:             log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/todos/"+todoID.String()+"/timer/stop", nil)
+			w := httptest.NewRecorder()
+
+			server.StopTodoTimer(w, req, todoID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response gen.TimeEntry
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_GetTimeReport(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		params         gen.GetTimeReportParams
+		setupUsecases  func(*todouc.MockGetTimeReport)
+		expectedStatus int
+		expectedBody   *gen.TimeReport
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			params: gen.GetTimeReportParams{
+				From: &openapi_types.Date{Time: from},
+				To:   &openapi_types.Date{Time: to},
+			},
+			setupUsecases: func(m *todouc.MockGetTimeReport) {
+				m.EXPECT().
+					Query(mock.Anything, from, to).
+					Return([]todo.TimeReportItem{
+						{TodoID: todoID, Title: "Write report", Total: 90 * time.Minute, Entries: 2},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.TimeReport{
+				From:         openapi_types.Date{Time: from},
+				To:           openapi_types.Date{Time: to},
+				TotalSeconds: 5400,
+				Items: []gen.TimeReportItem{
+					{TodoId: todoID, Title: "Write report", TotalSeconds: 5400, Entries: 2},
+				},
+			},
+		},
+		"default-range": {
+			params: gen.GetTimeReportParams{},
+			setupUsecases: func(m *todouc.MockGetTimeReport) {
+				m.EXPECT().
+					Query(mock.Anything, mock.MatchedBy(func(from time.Time) bool {
+						return from.Hour() == 0
+					}), mock.Anything).
+					Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		"invalid-range": {
+			params: gen.GetTimeReportParams{
+				From: &openapi_types.Date{Time: to},
+				To:   &openapi_types.Date{Time: from},
+			},
+			setupUsecases: func(m *todouc.MockGetTimeReport) {
+				m.EXPECT().
+					Query(mock.Anything, to, from).
+					Return(nil, core.NewValidationErr("to must be after from"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "to must be after from",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockReport := todouc.NewMockGetTimeReport(t)
+			tt.setupUsecases(mockReport)
+
+			server := &TodoAppServer{
+				GetTimeReportUseCase: mockReport,
+				Logger:               log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/stats/time", nil)
+			w := httptest.NewRecorder()
+
+			server.GetTimeReport(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response gen.TimeReport
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
@@ -38,6 +39,28 @@ func (api TodoAppServer) ListTodos(w http.ResponseWriter, r *http.Request, param
 	if params.Sort != nil {
 		queryParams = append(queryParams, todouc.WithSortBy(string(*params.Sort)))
 	}
+	if params.IncludeArchived != nil && *params.IncludeArchived {
+		queryParams = append(queryParams, todouc.WithIncludeArchived())
+	}
+	if params.CustomField != nil {
+		for _, filter := range *params.CustomField {
+			name, value, ok := strings.Cut(filter, ":")
+			if !ok || name == "" {
+				errResp := gen.ErrorResp{}
+				errResp.Error.Code = gen.BADREQUEST
+				errResp.Error.Message = fmt.Sprintf("invalid customField filter %q: expected name:value", filter)
+				respondError(w, errResp)
+				return
+			}
+			queryParams = append(queryParams, todouc.WithCustomFieldFilter(name, value))
+		}
+	}
+	if params.NearLat != nil || params.NearLon != nil || params.NearRadiusKm != nil {
+		queryParams = append(queryParams, todouc.WithNear(params.NearLat, params.NearLon, params.NearRadiusKm))
+	}
+	if params.Q != nil && strings.TrimSpace(*params.Q) != "" {
+		queryParams = append(queryParams, todouc.WithQuery(*params.Q))
+	}
 
 	ctx := r.Context()
 	todos, hasMore, err := api.ListTodosUseCase.Query(ctx, params.Page, params.PageSize, queryParams...)
@@ -76,14 +99,68 @@ func (api TodoAppServer) CreateTodo(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
-	todo, err := api.CreateTodoUseCase.Execute(ctx, req.Title, req.DueDate.Time)
+	estimatedMinutes := 0
+	if req.EstimatedMinutes != nil {
+		estimatedMinutes = *req.EstimatedMinutes
+	}
+	var customFields todo.CustomFieldValues
+	if req.CustomFields != nil {
+		customFields = todo.CustomFieldValues(*req.CustomFields)
+	}
+	var opts []todouc.CreateOption
+	if req.Location != nil {
+		location, err := fromTodoLocation(*req.Location)
+		if err != nil {
+			respondError(w, toError(err))
+			return
+		}
+		if !location.IsZero() {
+			opts = append(opts, todouc.WithCreateLocation(location))
+		}
+	}
+	created, err := api.CreateTodoUseCase.Execute(ctx, req.Title, req.DueDate.Time, estimatedMinutes, customFields, opts...)
 	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
 		api.Logger.Printf("Error creating todo: %v", err)
 		respondError(w, toError(err))
 		return
 	}
 
-	respondJSON(w, http.StatusCreated, toTodo(todo))
+	respondJSON(w, http.StatusCreated, toTodo(created))
+}
+
+// QuickAddTodo parses a quick add line and creates the todo when committed
+// (POST /api/v1/todos/quick-add)
+func (api TodoAppServer) QuickAddTodo(w http.ResponseWriter, r *http.Request) {
+	var req gen.QuickAddTodoJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	if req.Commit == nil || !*req.Commit {
+		preview, err := api.QuickAddUseCase.Preview(ctx, req.Text)
+		if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+			api.Logger.Printf("Error previewing quick add: %v", err)
+			respondError(w, toError(err))
+			return
+		}
+		respondJSON(w, http.StatusOK, toQuickAddResp(preview, nil))
+		return
+	}
+
+	preview, created, err := api.QuickAddUseCase.Execute(ctx, req.Text)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error creating quick add todo: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toQuickAddResp(preview, &created))
 }
 
 // UpdateTodo updates an existing todo item
@@ -110,6 +187,21 @@ func (api TodoAppServer) UpdateTodo(w http.ResponseWriter, r *http.Request, todo
 		respondError(w, errResp)
 		return
 	}
+	var opts []todouc.UpdateOption
+	if req.EstimatedMinutes != nil {
+		opts = append(opts, todouc.WithEstimatedMinutes(*req.EstimatedMinutes))
+	}
+	if req.CustomFields != nil {
+		opts = append(opts, todouc.WithCustomFields(todo.CustomFieldValues(*req.CustomFields)))
+	}
+	if req.Location != nil {
+		location, err := fromTodoLocation(*req.Location)
+		if err != nil {
+			respondError(w, toError(err))
+			return
+		}
+		opts = append(opts, todouc.WithLocation(location))
+	}
 
 	ctx := r.Context()
 	todo, err := api.UpdateTodoUseCase.Execute(
@@ -118,6 +210,7 @@ func (api TodoAppServer) UpdateTodo(w http.ResponseWriter, r *http.Request, todo
 		req.Title,
 		(*todo.Status)(req.Status),
 		dueDate,
+		opts...,
 	)
 	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
 		api.Logger.Printf("Error updating todo: %v", err)
@@ -141,3 +234,17 @@ func (api TodoAppServer) DeleteTodo(w http.ResponseWriter, r *http.Request, todo
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// RestoreTodo brings an archived todo back to the default listings
+// (POST /api/v1/todos/{todo_id}/restore)
+func (api TodoAppServer) RestoreTodo(w http.ResponseWriter, r *http.Request, todoId openapi_types.UUID) {
+	ctx := r.Context()
+	restored, err := api.ArchiveUseCase.Restore(ctx, todoId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error restoring todo: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toTodo(restored))
+}
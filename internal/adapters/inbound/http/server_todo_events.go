@@ -0,0 +1,131 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultTodoChangesLimit is the number of changes returned when the limit parameter is omitted.
+const defaultTodoChangesLimit = 100
+
+// todoEventsKeepAliveInterval is how often a comment line is written to idle todo event streams
+// so proxies do not close the connection.
+const todoEventsKeepAliveInterval = 15 * time.Second
+
+// ListTodoChanges lists todo changes recorded after a sequence number.
+// (GET /api/v1/todos/changes)
+func (api TodoAppServer) ListTodoChanges(w http.ResponseWriter, r *http.Request, params gen.ListTodoChangesParams) {
+	ctx := r.Context()
+
+	var since int64
+	if params.Since != nil {
+		since = *params.Since
+	}
+	limit := defaultTodoChangesLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	changes, hasMore, err := api.ListChangesUseCase.Query(ctx, since, params.ConversationId, limit)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing todo changes: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.TodoChangesResp{
+		Changes:        make([]gen.TodoChangeEvent, 0, len(changes)),
+		HasMore:        hasMore,
+		LatestSequence: since,
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, toTodoChange(change))
+		resp.LatestSequence = change.Sequence
+		if params.ConversationId != nil && change.ConversationSequence != nil {
+			resp.LatestSequence = *change.ConversationSequence
+		}
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// StreamTodoEvents streams todo change events to board clients.
+// (GET /api/v1/todos/events)
+func (api TodoAppServer) StreamTodoEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondError(w, gen.ErrorResp{
+			Error: gen.Error{
+				Code:    gen.INTERNALERROR,
+				Message: "streaming not supported",
+			},
+		})
+		return
+	}
+
+	events, unsubscribe := api.TodoEventStream.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(todoEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx, untrack := api.trackSessionStream(r.Context())
+	defer untrack()
+	tenantID := tenant.IDFromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			// The stream carries the events of every tenant; only forward the caller's.
+			if !belongsToTenant(event, tenantID) {
+				continue
+			}
+			changeEvent, ok := toTodoChangeEvent(event)
+			if !ok {
+				continue
+			}
+			data, err := json.Marshal(changeEvent)
+			if err != nil {
+				api.Logger.Printf("StreamTodoEvents: failed to encode event: %v", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", changeEvent.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// belongsToTenant reports whether event was recorded for tenantID. Events recorded before
+// multi-tenant mode carry no tenant and belong to the default tenant.
+func belongsToTenant(event outbox.TodoEvent, tenantID tenant.ID) bool {
+	if event.TenantID == "" {
+		return tenantID == tenant.Default
+	}
+	return event.TenantID == tenantID
+}
@@ -0,0 +1,281 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTodoAppServer_StreamTodoEvents(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1")
+	conversationID := uuid.MustParse("4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f")
+	createdAt := time.Date(2026, 1, 19, 10, 15, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		events         []outbox.TodoEvent
+		expectedEvents []string
+		unexpected     []string
+	}{
+		"streams-todo-events": {
+			events: []outbox.TodoEvent{
+				{Type: outbox.EventType_TODO_CREATED, TodoID: todoID, CreatedAt: createdAt, Sequence: 41},
+				{
+					Type:                 outbox.EventType_TODO_UPDATED,
+					TodoID:               todoID,
+					CreatedAt:            createdAt,
+					Sequence:             42,
+					ConversationID:       &conversationID,
+					ConversationSequence: common.Ptr(int64(3)),
+				},
+				{Type: outbox.EventType_TODO_DELETED, TodoID: todoID, CreatedAt: createdAt},
+			},
+			expectedEvents: []string{
+				"event: TODO_CREATED\ndata: {\"created_at\":\"2026-01-19T10:15:00Z\",\"sequence\":41,\"todo_id\":\"0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1\",\"type\":\"TODO_CREATED\"}\n\n",
+				"event: TODO_UPDATED\ndata: {\"conversation_id\":\"4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f\",\"conversation_sequence\":3,\"created_at\":\"2026-01-19T10:15:00Z\",\"sequence\":42,",
+				"event: TODO_DELETED\n",
+			},
+		},
+		"skips-other-tenant-events": {
+			events: []outbox.TodoEvent{
+				{Type: outbox.EventType_TODO_CREATED, TodoID: todoID, CreatedAt: createdAt, TenantID: "acme"},
+			},
+			unexpected: []string{"event:"},
+		},
+		"skips-non-todo-events": {
+			events: []outbox.TodoEvent{
+				{Type: outbox.EventType_CHAT_MESSAGE_SENT, TodoID: todoID, CreatedAt: createdAt},
+			},
+			unexpected: []string{"event:"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stream := outbox.NewMockTodoEventStream(t)
+			events := make(chan outbox.TodoEvent, len(tt.events))
+			for _, e := range tt.events {
+				events <- e
+			}
+			close(events)
+
+			unsubscribed := false
+			stream.EXPECT().Subscribe().Return(events, func() { unsubscribed = true }).Once()
+
+			server := &TodoAppServer{
+				TodoEventStream: stream,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos/events", nil)
+			w := newMockFlusherRecorder()
+
+			server.StreamTodoEvents(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			body := w.Body.String()
+			for _, e := range tt.expectedEvents {
+				assert.Contains(t, body, e)
+			}
+			for _, e := range tt.unexpected {
+				assert.NotContains(t, body, e)
+			}
+			assert.True(t, unsubscribed)
+		})
+	}
+}
+
+func TestTodoAppServer_StreamTodoEvents_ClientDisconnect(t *testing.T) {
+	t.Parallel()
+
+	stream := outbox.NewMockTodoEventStream(t)
+	events := make(chan outbox.TodoEvent)
+	stream.EXPECT().Subscribe().Return(events, func() {}).Once()
+
+	server := &TodoAppServer{
+		TodoEventStream: stream,
+		Logger:          log.New(io.Discard, "", 0),
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/todos/events", nil).WithContext(ctx)
+	w := newMockFlusherRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		server.StreamTodoEvents(w, req)
+		close(done)
+	}()
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("stream did not stop after client disconnect")
+	}
+}
+
+func TestTodoAppServer_ListTodoChanges(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1")
+	conversationID := uuid.MustParse("4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f")
+	createdAt := time.Date(2026, 1, 19, 10, 15, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		params         gen.ListTodoChangesParams
+		setupUsecases  func(*todouc.MockListChanges)
+		expectedStatus int
+		expectedResp   *gen.TodoChangesResp
+		expectedError  *gen.ErrorResp
+	}{
+		"global-changes-with-defaults": {
+			setupUsecases: func(m *todouc.MockListChanges) {
+				m.EXPECT().
+					Query(mock.Anything, int64(0), (*uuid.UUID)(nil), 100).
+					Return([]todo.Change{
+						{Sequence: 41, TodoID: todoID, Type: todo.ChangeType_Created, CreatedAt: createdAt},
+						{Sequence: 42, TodoID: todoID, Type: todo.ChangeType_Deleted, CreatedAt: createdAt},
+					}, true, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: &gen.TodoChangesResp{
+				Changes: []gen.TodoChangeEvent{
+					{Type: gen.TODOCREATED, TodoId: todoID, CreatedAt: createdAt, Sequence: 41},
+					{Type: gen.TODODELETED, TodoId: todoID, CreatedAt: createdAt, Sequence: 42},
+				},
+				HasMore:        true,
+				LatestSequence: 42,
+			},
+		},
+		"conversation-changes": {
+			params: gen.ListTodoChangesParams{
+				Since:          common.Ptr(int64(2)),
+				ConversationId: &conversationID,
+				Limit:          common.Ptr(10),
+			},
+			setupUsecases: func(m *todouc.MockListChanges) {
+				m.EXPECT().
+					Query(mock.Anything, int64(2), &conversationID, 10).
+					Return([]todo.Change{
+						{
+							Sequence:             57,
+							TodoID:               todoID,
+							Type:                 todo.ChangeType_Updated,
+							ConversationID:       &conversationID,
+							ConversationSequence: common.Ptr(int64(3)),
+							CreatedAt:            createdAt,
+						},
+					}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: &gen.TodoChangesResp{
+				Changes: []gen.TodoChangeEvent{
+					{
+						Type:                 gen.TODOUPDATED,
+						TodoId:               todoID,
+						CreatedAt:            createdAt,
+						Sequence:             57,
+						ConversationId:       &conversationID,
+						ConversationSequence: common.Ptr(int64(3)),
+					},
+				},
+				LatestSequence: 3,
+			},
+		},
+		"no-changes-keeps-since": {
+			params: gen.ListTodoChangesParams{Since: common.Ptr(int64(99))},
+			setupUsecases: func(m *todouc.MockListChanges) {
+				m.EXPECT().
+					Query(mock.Anything, int64(99), (*uuid.UUID)(nil), 100).
+					Return([]todo.Change{}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResp: &gen.TodoChangesResp{
+				Changes:        []gen.TodoChangeEvent{},
+				LatestSequence: 99,
+			},
+		},
+		"validation-error": {
+			params: gen.ListTodoChangesParams{Since: common.Ptr(int64(-1))},
+			setupUsecases: func(m *todouc.MockListChanges) {
+				m.EXPECT().
+					Query(mock.Anything, int64(-1), (*uuid.UUID)(nil), 100).
+					Return(nil, false, core.NewValidationErr("since must be greater than or equal to 0"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "since must be greater than or equal to 0",
+				},
+			},
+		},
+		"use-case-error": {
+			setupUsecases: func(m *todouc.MockListChanges) {
+				m.EXPECT().
+					Query(mock.Anything, int64(0), (*uuid.UUID)(nil), 100).
+					Return(nil, false, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.INTERNALERROR,
+					Message: "internal server error",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockListChanges := todouc.NewMockListChanges(t)
+			tt.setupUsecases(mockListChanges)
+
+			server := &TodoAppServer{
+				ListChangesUseCase: mockListChanges,
+				Logger:             log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos/changes", nil)
+			w := httptest.NewRecorder()
+
+			server.ListTodoChanges(w, req, tt.params)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedResp != nil {
+				var resp gen.TodoChangesResp
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedResp, resp)
+			}
+
+			if tt.expectedError != nil {
+				var resp gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedError, resp)
+			}
+		})
+	}
+}
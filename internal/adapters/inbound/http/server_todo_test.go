@@ -35,6 +35,15 @@ var (
 		CreatedAt: time.Date(2026, 1, 22, 10, 30, 0, 0, time.UTC),
 		UpdatedAt: time.Date(2026, 1, 22, 10, 30, 0, 0, time.UTC),
 	}
+	archivedTodo = todo.Todo{
+		ID:         domainTodo.ID,
+		Title:      domainTodo.Title,
+		Status:     todo.Status_DONE,
+		DueDate:    dueDate,
+		CreatedAt:  domainTodo.CreatedAt,
+		UpdatedAt:  domainTodo.UpdatedAt,
+		ArchivedAt: common.Ptr(time.Date(2026, 2, 21, 10, 30, 0, 0, time.UTC)),
+	}
 	restTodo = gen.Todo{
 		Id:        openapi_types.UUID(domainTodo.ID),
 		Title:     domainTodo.Title,
@@ -62,18 +71,73 @@ func TestTodoAppServer_CreateTodo(t *testing.T) {
 			}),
 			setupUsecases: func(m *todouc.MockCreate) {
 				m.EXPECT().
-					Execute(mock.Anything, "Buy groceries", dueDate).Return(domainTodo, nil)
+					Execute(mock.Anything, "Buy groceries", dueDate, 0, todo.CustomFieldValues(nil)).Return(domainTodo, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   &restTodo,
+		},
+		"success-with-estimate": {
+			requestBody: serializeJSON(t, gen.CreateTodoJSONRequestBody{
+				Title:            "Buy groceries",
+				DueDate:          openapi_types.Date{Time: dueDate},
+				EstimatedMinutes: common.Ptr(30),
+			}),
+			setupUsecases: func(m *todouc.MockCreate) {
+				m.EXPECT().
+					Execute(mock.Anything, "Buy groceries", dueDate, 30, todo.CustomFieldValues(nil)).Return(domainTodo, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   &restTodo,
+		},
+		"success-with-location": {
+			requestBody: serializeJSON(t, gen.CreateTodoJSONRequestBody{
+				Title:   "Buy groceries",
+				DueDate: openapi_types.Date{Time: dueDate},
+				Location: &gen.TodoLocation{
+					Name:      common.Ptr("Downtown market"),
+					Latitude:  common.Ptr(38.7223),
+					Longitude: common.Ptr(-9.1393),
+				},
+			}),
+			setupUsecases: func(m *todouc.MockCreate) {
+				m.EXPECT().
+					Execute(mock.Anything, "Buy groceries", dueDate, 0, todo.CustomFieldValues(nil),
+						mock.MatchedBy(func(opts []todouc.CreateOption) bool {
+							params := todouc.CreateParams{}
+							for _, opt := range opts {
+								opt(&params)
+							}
+							return assert.ObjectsAreEqual(&todo.Location{
+								Name:  "Downtown market",
+								Point: &todo.GeoPoint{Latitude: 38.7223, Longitude: -9.1393},
+							}, params.Location)
+						})).
+					Return(domainTodo, nil)
 			},
 			expectedStatus: http.StatusCreated,
 			expectedBody:   &restTodo,
 		},
+		"location-without-longitude": {
+			requestBody: serializeJSON(t, gen.CreateTodoJSONRequestBody{
+				Title:    "Buy groceries",
+				DueDate:  openapi_types.Date{Time: dueDate},
+				Location: &gen.TodoLocation{Latitude: common.Ptr(38.7223)},
+			}),
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "location latitude and longitude must be provided together",
+				},
+			},
+		},
 		"bad-request": {
 			requestBody: serializeJSON(t, gen.CreateTodoJSONRequestBody{
 				DueDate: openapi_types.Date{Time: dueDate},
 			}),
 			setupUsecases: func(m *todouc.MockCreate) {
 				m.EXPECT().
-					Execute(mock.Anything, "", dueDate).
+					Execute(mock.Anything, "", dueDate, 0, todo.CustomFieldValues(nil)).
 					Return(todo.Todo{}, core.NewValidationErr("title is required"))
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -102,7 +166,7 @@ func TestTodoAppServer_CreateTodo(t *testing.T) {
 			}),
 			setupUsecases: func(m *todouc.MockCreate) {
 				m.EXPECT().
-					Execute(mock.Anything, "Test todo", time.Time{}).
+					Execute(mock.Anything, "Test todo", time.Time{}, 0, todo.CustomFieldValues(nil)).
 					Return(todo.Todo{}, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -156,6 +220,122 @@ func TestTodoAppServer_CreateTodo(t *testing.T) {
 	}
 }
 
+func TestTodoAppServer_QuickAddTodo(t *testing.T) {
+	t.Parallel()
+
+	preview := todouc.QuickAddPreview{
+		Title:        "Buy groceries",
+		DueDate:      dueDate,
+		Tags:         []string{"home", "later"},
+		Priority:     "high",
+		CustomFields: todo.CustomFieldValues{"area": "home", "priority": "high"},
+		Unresolved:   []string{"#later"},
+		Source:       todouc.QuickAddSource_PARSER,
+	}
+	restPreview := gen.QuickAddResp{
+		Title:        "Buy groceries",
+		DueDate:      openapi_types.Date{Time: dueDate},
+		Tags:         []string{"home", "later"},
+		Priority:     common.Ptr("high"),
+		CustomFields: gen.CustomFieldValues{"area": "home", "priority": "high"},
+		Unresolved:   []string{"#later"},
+		Source:       gen.PARSER,
+	}
+	restCommitted := restPreview
+	restCommitted.Todo = &restTodo
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockQuickAdd)
+		expectedStatus int
+		expectedBody   *gen.QuickAddResp
+		expectedError  *gen.ErrorResp
+	}{
+		"preview": {
+			requestBody: serializeJSON(t, gen.QuickAddTodoJSONRequestBody{Text: "Buy groceries 2026-01-25 #home #later !high"}),
+			setupUsecases: func(m *todouc.MockQuickAdd) {
+				m.EXPECT().Preview(mock.Anything, "Buy groceries 2026-01-25 #home #later !high").Return(preview, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restPreview,
+		},
+		"commit": {
+			requestBody: serializeJSON(t, gen.QuickAddTodoJSONRequestBody{
+				Text:   "Buy groceries 2026-01-25 #home #later !high",
+				Commit: common.Ptr(true),
+			}),
+			setupUsecases: func(m *todouc.MockQuickAdd) {
+				m.EXPECT().Execute(mock.Anything, "Buy groceries 2026-01-25 #home #later !high").Return(preview, domainTodo, nil).Once()
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   &restCommitted,
+		},
+		"validation-error": {
+			requestBody: serializeJSON(t, gen.QuickAddTodoJSONRequestBody{Text: " "}),
+			setupUsecases: func(m *todouc.MockQuickAdd) {
+				m.EXPECT().Preview(mock.Anything, " ").Return(todouc.QuickAddPreview{}, core.NewValidationErr("text cannot be empty")).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "text cannot be empty"},
+			},
+		},
+		"invalid-json-body": {
+			requestBody:    []byte(`{"text": 1}`),
+			setupUsecases:  func(*todouc.MockQuickAdd) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "invalid request body: json: cannot unmarshal number into Go struct field QuickAddRequest.text of type string",
+				},
+			},
+		},
+		"commit-error": {
+			requestBody: serializeJSON(t, gen.QuickAddTodoJSONRequestBody{Text: "Buy groceries", Commit: common.Ptr(true)}),
+			setupUsecases: func(m *todouc.MockQuickAdd) {
+				m.EXPECT().Execute(mock.Anything, "Buy groceries").Return(todouc.QuickAddPreview{}, todo.Todo{}, errors.New("database error")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.INTERNALERROR, Message: "internal server error"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			quickAdd := todouc.NewMockQuickAdd(t)
+			tt.setupUsecases(quickAdd)
+
+			server := &TodoAppServer{
+				QuickAddUseCase: quickAdd,
+				Logger:          log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/todos/quick-add", bytes.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			gen.Handler(server).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.QuickAddResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+			}
+		})
+	}
+}
+
 func TestTodoAppServer_ListTodos(t *testing.T) {
 	t.Parallel()
 
@@ -167,6 +347,10 @@ func TestTodoAppServer_ListTodos(t *testing.T) {
 		searchType      *gen.ListTodosParamsSearchType
 		dateRange       *gen.DateRange
 		sortBy          *string
+		includeArchived bool
+		customFields    []string
+		near            []string
+		query           *string
 		setExpectations func(*todouc.MockList)
 		expectedStatus  int
 		expectedBody    *gen.ListTodosResp
@@ -241,6 +425,28 @@ func TestTodoAppServer_ListTodos(t *testing.T) {
 				Page:  1,
 			},
 		},
+		"success-including-archived": {
+			page:            1,
+			pageSize:        10,
+			includeArchived: true,
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...todouc.ListOptions) {
+						p := todouc.ListParams{}
+						for _, opt := range opts {
+							opt(&p)
+						}
+						assert.True(t, p.IncludeArchived)
+					}).
+					Return([]todo.Todo{archivedTodo}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListTodosResp{
+				Items: []gen.Todo{toTodo(archivedTodo)},
+				Page:  1,
+			},
+		},
 		"success-with-search-similarity": {
 			page:       1,
 			pageSize:   10,
@@ -329,6 +535,92 @@ func TestTodoAppServer_ListTodos(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		"success-with-custom-field-filters": {
+			page:         1,
+			pageSize:     10,
+			customFields: []string{"area:work", "note:a:b"},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...todouc.ListOptions) {
+						p := todouc.ListParams{}
+						for _, opt := range opts {
+							opt(&p)
+						}
+						assert.Equal(t, map[string]string{"area": "work", "note": "a:b"}, p.CustomFields)
+					}).
+					Return([]todo.Todo{domainTodo}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		"success-with-near": {
+			page:     1,
+			pageSize: 10,
+			near:     []string{"38.7223", "-9.1393", "5"},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...todouc.ListOptions) {
+						p := todouc.ListParams{}
+						for _, opt := range opts {
+							opt(&p)
+						}
+						assert.Equal(t, common.Ptr(38.7223), p.NearLat)
+						assert.Equal(t, common.Ptr(-9.1393), p.NearLon)
+						assert.Equal(t, common.Ptr(5.0), p.NearRadiusKm)
+					}).
+					Return([]todo.Todo{domainTodo}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		"success-with-query": {
+			page:     1,
+			pageSize: 10,
+			query:    common.Ptr("status:open due<today+7 -area:errands"),
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...todouc.ListOptions) {
+						p := todouc.ListParams{}
+						for _, opt := range opts {
+							opt(&p)
+						}
+						assert.Equal(t, "status:open due<today+7 -area:errands", *p.Query)
+					}).
+					Return([]todo.Todo{domainTodo}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		"invalid-query": {
+			page:     1,
+			pageSize: 10,
+			query:    common.Ptr("status:later"),
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Return(nil, false, core.NewValidationErr("status must be either OPEN or DONE"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "status must be either OPEN or DONE",
+				},
+			},
+		},
+		"invalid-custom-field-filter": {
+			page:            1,
+			pageSize:        10,
+			customFields:    []string{"area"},
+			setExpectations: func(*todouc.MockList) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: `invalid customField filter "area": expected name:value`,
+				},
+			},
+		},
 		"use-case-error": {
 			page:     1,
 			pageSize: 10,
@@ -378,6 +670,20 @@ func TestTodoAppServer_ListTodos(t *testing.T) {
 			if tt.sortBy != nil {
 				q.Set("sort", *tt.sortBy)
 			}
+			if tt.includeArchived {
+				q.Set("includeArchived", "true")
+			}
+			for _, f := range tt.customFields {
+				q.Add("customField", f)
+			}
+			if len(tt.near) == 3 {
+				q.Set("nearLat", tt.near[0])
+				q.Set("nearLon", tt.near[1])
+				q.Set("nearRadiusKm", tt.near[2])
+			}
+			if tt.query != nil {
+				q.Set("q", *tt.query)
+			}
 			u.RawQuery = q.Encode()
 			req := httptest.NewRequest(http.MethodGet, u.String(), nil)
 
@@ -432,6 +738,44 @@ func TestTodoAppServer_UpdateTodo(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedBody:   &restTodo,
 		},
+		"success-estimated-minutes": {
+			todoID: domainTodo.ID.String(),
+			requestBody: serializeJSON(t, gen.UpdateTodoJSONRequestBody{
+				EstimatedMinutes: common.Ptr(90),
+			}),
+			setupUsecases: func(m *todouc.MockUpdate) {
+				m.EXPECT().
+					Execute(mock.Anything, domainTodo.ID, (*string)(nil), (*todo.Status)(nil), (*time.Time)(nil),
+						mock.MatchedBy(func(opts []todouc.UpdateOption) bool {
+							params := todouc.UpdateParams{}
+							for _, opt := range opts {
+								opt(&params)
+							}
+							return params.EstimatedMinutes != nil && *params.EstimatedMinutes == 90
+						})).
+					Return(domainTodo, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restTodo,
+		},
+		"success-clear-location": {
+			todoID:      domainTodo.ID.String(),
+			requestBody: []byte(`{"location": {}}`),
+			setupUsecases: func(m *todouc.MockUpdate) {
+				m.EXPECT().
+					Execute(mock.Anything, domainTodo.ID, (*string)(nil), (*todo.Status)(nil), (*time.Time)(nil),
+						mock.MatchedBy(func(opts []todouc.UpdateOption) bool {
+							params := todouc.UpdateParams{}
+							for _, opt := range opts {
+								opt(&params)
+							}
+							return params.Location != nil && params.Location.IsZero()
+						})).
+					Return(domainTodo, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restTodo,
+		},
 		"todo-not-found": {
 			todoID: domainTodo.ID.String(),
 			requestBody: serializeJSON(t, gen.UpdateTodoJSONRequestBody{
@@ -602,6 +946,85 @@ func TestTodoAppServer_DeleteTodo(t *testing.T) {
 	}
 }
 
+func TestTodoAppServer_RestoreTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupMocks     func(*todouc.MockArchive)
+		expectedStatus int
+		expectedBody   *gen.Todo
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupMocks: func(m *todouc.MockArchive) {
+				m.EXPECT().
+					Restore(mock.Anything, domainTodo.ID).
+					Return(domainTodo, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restTodo,
+		},
+		"not-archived": {
+			setupMocks: func(m *todouc.MockArchive) {
+				m.EXPECT().
+					Restore(mock.Anything, domainTodo.ID).
+					Return(todo.Todo{}, core.NewValidationErr("todo is not archived"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.BADREQUEST,
+					Message: "todo is not archived",
+				},
+			},
+		},
+		"todo-not-found": {
+			setupMocks: func(m *todouc.MockArchive) {
+				m.EXPECT().
+					Restore(mock.Anything, domainTodo.ID).
+					Return(todo.Todo{}, core.NewNotFoundErr("todo not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{
+					Code:    gen.NOTFOUND,
+					Message: "todo not found",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockArchive := todouc.NewMockArchive(t)
+			tt.setupMocks(mockArchive)
+			server := &TodoAppServer{
+				ArchiveUseCase: mockArchive,
+				Logger:         log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/todos/"+domainTodo.ID.String()+"/restore", nil)
+			w := httptest.NewRecorder()
+
+			gen.Handler(server).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var response gen.Todo
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
+
 // serializeJSON is a helper function to marshal a value to JSON for test requests.
 func serializeJSON(t *testing.T, v any) []byte {
 	t.Helper()
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListTokens lists the active personal access tokens of the calling principal
+// (GET /api/v1/tokens)
+func (api TodoAppServer) ListTokens(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	tokens, err := api.TokensUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing tokens: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListTokensResp{
+		Items: make([]gen.PersonalAccessToken, len(tokens)),
+	}
+	for i, t := range tokens {
+		resp.Items[i] = toPersonalAccessToken(t)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// CreateToken creates a personal access token for the calling principal
+// (POST /api/v1/tokens)
+func (api TodoAppServer) CreateToken(w http.ResponseWriter, r *http.Request) {
+	var req gen.CreateTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	issued, err := api.TokensUseCase.Create(ctx, req.Name, fromTokenScopes(req.Scopes))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error creating token: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, gen.CreatedToken{
+		Token:  toPersonalAccessToken(issued.Token),
+		Secret: issued.PlainToken,
+	})
+}
+
+// RevokeToken revokes a personal access token
+// (DELETE /api/v1/tokens/{token_id})
+func (api TodoAppServer) RevokeToken(w http.ResponseWriter, r *http.Request, tokenId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.TokensUseCase.Revoke(ctx, tokenId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error revoking token: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,196 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	patID       = uuid.MustParse("723e4567-e89b-12d3-a456-426614174000")
+	patTime     = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	domainToken = access.PersonalAccessToken{
+		ID:        patID,
+		Principal: "viewer",
+		Role:      access.RoleReadonly,
+		Name:      "backup script",
+		Scopes:    []access.Scope{access.ScopeTodosRead, access.ScopeChat},
+		CreatedAt: patTime,
+	}
+	restToken = gen.PersonalAccessToken{
+		Id:        patID,
+		Name:      "backup script",
+		Scopes:    []gen.TokenScope{gen.TodosRead, gen.Chat},
+		CreatedAt: patTime,
+	}
+)
+
+func TestTodoAppServer_ListTokens(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*session.MockTokens)
+		expectedStatus int
+		expectedBody   *gen.ListTokensResp
+	}{
+		"success": {
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().List(mock.Anything).Return([]access.PersonalAccessToken{domainToken}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &gen.ListTokensResp{Items: []gen.PersonalAccessToken{restToken}},
+		},
+		"usecase-error": {
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().List(mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokens := session.NewMockTokens(t)
+			tt.setupUsecases(tokens)
+
+			server := &TodoAppServer{
+				TokensUseCase: tokens,
+				Logger:        log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tokens", nil)
+			w := httptest.NewRecorder()
+
+			server.ListTokens(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var resp gen.ListTokensResp
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, *tt.expectedBody, resp)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_CreateToken(t *testing.T) {
+	t.Parallel()
+
+	scopes := []access.Scope{access.ScopeTodosRead, access.ScopeChat}
+
+	tests := map[string]struct {
+		body           string
+		setupUsecases  func(*session.MockTokens)
+		expectedStatus int
+		expectedBody   *gen.CreatedToken
+	}{
+		"success": {
+			body: `{"name":"backup script","scopes":["todos:read","chat"]}`,
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().Create(mock.Anything, "backup script", scopes).
+					Return(session.IssuedToken{Token: domainToken, PlainToken: "pat_secret"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   &gen.CreatedToken{Token: restToken, Secret: "pat_secret"},
+		},
+		"invalid-body": {
+			body:           `{`,
+			setupUsecases:  func(*session.MockTokens) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"invalid-scope": {
+			body: `{"name":"backup script","scopes":["todos:admin"]}`,
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().Create(mock.Anything, "backup script", []access.Scope{"todos:admin"}).
+					Return(session.IssuedToken{}, core.NewValidationErr(`scope must be one of todos:read, todos:write or chat, got "todos:admin"`))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		"created-from-token": {
+			body: `{"name":"backup script","scopes":["todos:read","chat"]}`,
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().Create(mock.Anything, "backup script", scopes).
+					Return(session.IssuedToken{}, core.NewForbiddenErr("personal access tokens cannot be created with a personal access token"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokens := session.NewMockTokens(t)
+			tt.setupUsecases(tokens)
+
+			server := &TodoAppServer{
+				TokensUseCase: tokens,
+				Logger:        log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/tokens", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			server.CreateToken(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != nil {
+				var resp gen.CreatedToken
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, *tt.expectedBody, resp)
+			}
+		})
+	}
+}
+
+func TestTodoAppServer_RevokeToken(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*session.MockTokens)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().Revoke(mock.Anything, patID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"not-found": {
+			setupUsecases: func(m *session.MockTokens) {
+				m.EXPECT().Revoke(mock.Anything, patID).Return(core.NewNotFoundErr("token not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokens := session.NewMockTokens(t)
+			tt.setupUsecases(tokens)
+
+			server := &TodoAppServer{
+				TokensUseCase: tokens,
+				Logger:        log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/tokens/"+patID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.RevokeToken(w, req, patID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/genv2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// defaultCursorLimit is the page size of a v2 listing without limit.
+	defaultCursorLimit = 100
+	// maxCursorLimit is the largest page size of a v2 listing.
+	maxCursorLimit = 500
+)
+
+// todoAppServerV2 serves the v2 REST API over the usecases of TodoAppServer.
+type todoAppServerV2 struct {
+	TodoAppServer
+}
+
+// listCursor is the position of a v2 listing, encoded as an opaque token.
+type listCursor struct {
+	Page     int `json:"p"`
+	PageSize int `json:"s"`
+}
+
+func (c listCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeListCursor(token string) (listCursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return listCursor{}, false
+	}
+	var c listCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Page < 1 || c.PageSize < 1 || c.PageSize > maxCursorLimit {
+		return listCursor{}, false
+	}
+	return c, true
+}
+
+// ListTodos returns a cursor-paginated list of todos with optional filtering and sorting
+// (GET /api/v2/todos)
+func (api todoAppServerV2) ListTodos(w http.ResponseWriter, r *http.Request, params genv2.ListTodosParams) {
+	cursor := listCursor{Page: 1, PageSize: defaultCursorLimit}
+	switch {
+	case params.Cursor != nil:
+		c, ok := decodeListCursor(*params.Cursor)
+		if !ok {
+			respondErrorV2(w, badRequestV2("invalid cursor"))
+			return
+		}
+		cursor = c
+	case params.Limit != nil:
+		if *params.Limit < 1 || *params.Limit > maxCursorLimit {
+			respondErrorV2(w, badRequestV2("limit must be between 1 and 500"))
+			return
+		}
+		cursor.PageSize = *params.Limit
+	}
+
+	var queryParams []todouc.ListOptions
+	if params.Status != nil {
+		queryParams = append(queryParams, todouc.WithStatus(todo.Status(*params.Status)))
+	}
+	if params.Search != nil {
+		queryParams = append(queryParams, todouc.WithSearchQuery(*params.Search))
+	}
+	if params.SearchType != nil {
+		queryParams = append(queryParams, todouc.WithSearchType(todouc.SearchType(*params.SearchType)))
+	}
+	if params.DateRange != nil && params.DateRange.DueAfter != nil && params.DateRange.DueBefore != nil {
+		queryParams = append(queryParams, todouc.WithDueDateRange(params.DateRange.DueAfter.Time, params.DateRange.DueBefore.Time))
+	}
+	if params.Sort != nil {
+		queryParams = append(queryParams, todouc.WithSortBy(string(*params.Sort)))
+	}
+
+	ctx := r.Context()
+	todos, hasMore, err := api.ListTodosUseCase.Query(ctx, cursor.Page, cursor.PageSize, queryParams...)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing todos: %v", err)
+		respondErrorV2(w, toErrorV2(err))
+		return
+	}
+
+	resp := genv2.ListTodosResp{Items: []genv2.Todo{}}
+	for _, t := range todos {
+		resp.Items = append(resp.Items, toTodoV2(t))
+	}
+	if hasMore {
+		next := listCursor{Page: cursor.Page + 1, PageSize: cursor.PageSize}.encode()
+		resp.NextCursor = &next
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+func badRequestV2(message string) genv2.ErrorResp {
+	errResp := genv2.ErrorResp{}
+	errResp.Error.Code = genv2.BADREQUEST
+	errResp.Error.Message = message
+	return errResp
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/genv2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTodoAppServerV2_ListTodos(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query           url.Values
+		setExpectations func(*todouc.MockList)
+		expectedStatus  int
+		expectedBody    *genv2.ListTodosResp
+		expectedError   *genv2.ErrorResp
+	}{
+		"first-page-with-default-limit": {
+			query: url.Values{},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 100, mock.Anything).
+					Return([]todo.Todo{domainTodo}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &genv2.ListTodosResp{
+				Items: []genv2.Todo{toTodoV2(domainTodo)},
+			},
+		},
+		"first-page-with-next-cursor": {
+			query: url.Values{"limit": {"1"}, "status": {"DONE"}},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 1, mock.MatchedBy(func(opts []todouc.ListOptions) bool {
+						p := todouc.ListParams{}
+						for _, opt := range opts {
+							opt(&p)
+						}
+						return p.Status != nil && *p.Status == todo.Status_DONE
+					})).
+					Return([]todo.Todo{domainTodo}, true, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &genv2.ListTodosResp{
+				Items:      []genv2.Todo{toTodoV2(domainTodo)},
+				NextCursor: common.Ptr(listCursor{Page: 2, PageSize: 1}.encode()),
+			},
+		},
+		"next-page-from-cursor-ignores-limit": {
+			query: url.Values{"cursor": {listCursor{Page: 3, PageSize: 20}.encode()}, "limit": {"5"}},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 3, 20, mock.Anything).
+					Return([]todo.Todo{}, false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &genv2.ListTodosResp{
+				Items: []genv2.Todo{},
+			},
+		},
+		"invalid-cursor": {
+			query:           url.Values{"cursor": {"not-a-cursor"}},
+			setExpectations: func(m *todouc.MockList) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedError: &genv2.ErrorResp{
+				Error: genv2.Error{Code: genv2.BADREQUEST, Message: "invalid cursor"},
+			},
+		},
+		"limit-out-of-range": {
+			query:           url.Values{"limit": {"501"}},
+			setExpectations: func(m *todouc.MockList) {},
+			expectedStatus:  http.StatusBadRequest,
+			expectedError: &genv2.ErrorResp{
+				Error: genv2.Error{Code: genv2.BADREQUEST, Message: "limit must be between 1 and 500"},
+			},
+		},
+		"use-case-error": {
+			query: url.Values{},
+			setExpectations: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 100, mock.Anything).
+					Return(nil, false, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &genv2.ErrorResp{
+				Error: genv2.Error{Code: genv2.INTERNALERROR, Message: "internal server error"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockListTodos := todouc.NewMockList(t)
+			tt.setExpectations(mockListTodos)
+
+			server := todoAppServerV2{TodoAppServer{
+				ListTodosUseCase: mockListTodos,
+				Logger:           log.New(io.Discard, "", 0),
+			}}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/todos?"+tt.query.Encode(), nil)
+			w := httptest.NewRecorder()
+
+			genv2.Handler(server).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response genv2.ListTodosResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedBody, response)
+			}
+
+			if tt.expectedError != nil {
+				var response genv2.ErrorResp
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, *tt.expectedError, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListViews lists the built-in and saved views
+// (GET /api/v1/views)
+func (api TodoAppServer) ListViews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	views, err := api.ViewsUseCase.List(ctx)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error listing views: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	resp := gen.ListViewsResp{
+		Items: make([]gen.View, len(views)),
+	}
+	for i, v := range views {
+		resp.Items[i] = toView(v)
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// SaveView saves a named filter, replacing the view with the same name
+// (POST /api/v1/views)
+func (api TodoAppServer) SaveView(w http.ResponseWriter, r *http.Request) {
+	var req gen.SaveViewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	view, err := api.ViewsUseCase.Save(ctx, req.Name, toViewFilter(req.Filter))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error saving view: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toView(view))
+}
+
+// UpdateView renames a saved view and replaces its filter
+// (PATCH /api/v1/views/{view_id})
+func (api TodoAppServer) UpdateView(w http.ResponseWriter, r *http.Request, viewId openapi_types.UUID) {
+	var req gen.UpdateViewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.BADREQUEST
+		errResp.Error.Message = fmt.Sprintf("invalid request body: %v", err)
+		respondError(w, errResp)
+		return
+	}
+
+	ctx := r.Context()
+	view, err := api.ViewsUseCase.Update(ctx, viewId, req.Name, toViewFilter(req.Filter))
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error updating view: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toView(view))
+}
+
+// DeleteView deletes a saved view
+// (DELETE /api/v1/views/{view_id})
+func (api TodoAppServer) DeleteView(w http.ResponseWriter, r *http.Request, viewId openapi_types.UUID) {
+	ctx := r.Context()
+	err := api.ViewsUseCase.Delete(ctx, viewId)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		api.Logger.Printf("Error deleting view: %v", err)
+		respondError(w, toError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
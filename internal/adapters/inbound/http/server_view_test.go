@@ -0,0 +1,305 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	viewID     = uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	viewTime   = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	domainView = todo.View{
+		ID:   viewID,
+		Name: "Work focus",
+		Filter: todo.ViewFilter{
+			Status:             common.Ptr(todo.Status_OPEN),
+			SearchBySimilarity: common.Ptr("work"),
+			DueAfter:           common.Ptr(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)),
+			DueBefore:          common.Ptr(time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)),
+		},
+		CreatedAt: viewTime,
+		UpdatedAt: viewTime,
+	}
+	restView = gen.View{
+		Id:   viewID,
+		Name: "Work focus",
+		Filter: gen.ViewFilter{
+			Status:             common.Ptr(gen.OPEN),
+			SearchBySimilarity: common.Ptr("work"),
+			DueAfter:           &openapi_types.Date{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+			DueBefore:          &openapi_types.Date{Time: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)},
+		},
+		CreatedAt: &viewTime,
+		UpdatedAt: &viewTime,
+	}
+)
+
+func TestTodoAppServer_ListViews(t *testing.T) {
+	t.Parallel()
+
+	today := todo.BuiltinViews()[0]
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockViews)
+		expectedStatus int
+		expectedBody   *gen.ListViewsResp
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().List(mock.Anything).Return([]todo.View{today, domainView}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &gen.ListViewsResp{
+				Items: []gen.View{
+					{
+						Id:      today.ID,
+						Name:    "Today",
+						BuiltIn: true,
+						Filter: gen.ViewFilter{
+							Status:    common.Ptr(gen.OPEN),
+							SortBy:    common.Ptr(gen.ViewFilterSortByDueDateAsc),
+							DueToDays: common.Ptr(0),
+						},
+					},
+					restView,
+				},
+			},
+		},
+		"internal-error": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.INTERNALERROR, Message: "internal server error"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockViews := todouc.NewMockViews(t)
+			tt.setupUsecases(mockViews)
+
+			server := &TodoAppServer{
+				ViewsUseCase: mockViews,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/views", nil)
+			w := httptest.NewRecorder()
+
+			server.ListViews(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.ListViewsResp
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_SaveView(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockViews)
+		expectedStatus int
+		expectedBody   *gen.View
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"name":"Work focus","filter":{"status":"OPEN","search_by_similarity":"work","due_after":"2026-03-01","due_before":"2026-03-07"}}`),
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Save(mock.Anything, "Work focus", domainView.Filter).
+					Return(domainView, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restView,
+		},
+		"invalid-body": {
+			requestBody:    []byte(`{`),
+			setupUsecases:  func(*todouc.MockViews) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: "invalid request body: unexpected EOF"},
+			},
+		},
+		"reserved-name": {
+			requestBody: []byte(`{"name":"Today","filter":{}}`),
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Save(mock.Anything, "Today", todo.ViewFilter{}).
+					Return(todo.View{}, core.NewValidationErr(`view name "Today" is reserved`))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.BADREQUEST, Message: `view name "Today" is reserved`},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockViews := todouc.NewMockViews(t)
+			tt.setupUsecases(mockViews)
+
+			server := &TodoAppServer{
+				ViewsUseCase: mockViews,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/views", bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.SaveView(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.View
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_UpdateView(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requestBody    []byte
+		setupUsecases  func(*todouc.MockViews)
+		expectedStatus int
+		expectedBody   *gen.View
+		expectedError  *gen.ErrorResp
+	}{
+		"success": {
+			requestBody: []byte(`{"name":"Work focus","filter":{"status":"OPEN","search_by_similarity":"work","due_after":"2026-03-01","due_before":"2026-03-07"}}`),
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Update(mock.Anything, viewID, "Work focus", domainView.Filter).
+					Return(domainView, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &restView,
+		},
+		"not-found": {
+			requestBody: []byte(`{"name":"Work focus","filter":{}}`),
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Update(mock.Anything, viewID, "Work focus", todo.ViewFilter{}).
+					Return(todo.View{}, core.NewNotFoundErr("view not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError: &gen.ErrorResp{
+				Error: gen.Error{Code: gen.NOTFOUND, Message: "view not found"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockViews := todouc.NewMockViews(t)
+			tt.setupUsecases(mockViews)
+
+			server := &TodoAppServer{
+				ViewsUseCase: mockViews,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/v1/views/"+viewID.String(), bytes.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			server.UpdateView(w, req, viewID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != nil {
+				var response gen.ErrorResp
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedError.Error, response.Error)
+				return
+			}
+
+			var response gen.View
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *tt.expectedBody, response)
+		})
+	}
+}
+
+func TestTodoAppServer_DeleteView(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setupUsecases  func(*todouc.MockViews)
+		expectedStatus int
+	}{
+		"success": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().Delete(mock.Anything, viewID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		"builtin-view": {
+			setupUsecases: func(m *todouc.MockViews) {
+				m.EXPECT().Delete(mock.Anything, viewID).Return(core.NewValidationErr("built-in views cannot be changed"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockViews := todouc.NewMockViews(t)
+			tt.setupUsecases(mockViews)
+
+			server := &TodoAppServer{
+				ViewsUseCase: mockViews,
+				Logger:       log.New(io.Discard, "", 0),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/v1/views/"+viewID.String(), nil)
+			w := httptest.NewRecorder()
+
+			server.DeleteView(w, req, viewID)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+)
+
+// tenantHeader picks the tenant on hosts no tenant claims. On a tenant's own host it may only name that tenant.
+const tenantHeader = "X-Tenant-ID"
+
+// tenantMiddleware scopes each request to the tenant resolved from the X-Tenant-ID header or the
+// request host. Requests that match no tenant are rejected before reaching the handlers.
+func tenantMiddleware(directory tenant.Directory) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if directory == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested := tenant.ID(strings.TrimSpace(r.Header.Get(tenantHeader)))
+			t, err := directory.Resolve(r.Context(), r.Host, requested)
+			if err != nil {
+				respondError(w, toError(err))
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(tenant.NewContext(r.Context(), t)))
+		})
+	}
+}
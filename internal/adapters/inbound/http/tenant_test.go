@@ -0,0 +1,105 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/tenantdir"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	t.Parallel()
+
+	acme := tenant.Tenant{ID: "acme", Hosts: []string{"acme.example.com"}}
+
+	tests := map[string]struct {
+		header         string
+		setExpectation func(*tenant.MockDirectory)
+		nilDirectory   bool
+		expectedStatus int
+		expectedTenant tenant.ID
+	}{
+		"resolves-by-host": {
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "acme.example.com", tenant.ID("")).Return(acme, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedTenant: "acme",
+		},
+		"resolves-by-header": {
+			header: " acme ",
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "acme.example.com", tenant.ID("acme")).Return(acme, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedTenant: "acme",
+		},
+		"unknown-tenant": {
+			header: "initech",
+			setExpectation: func(d *tenant.MockDirectory) {
+				d.EXPECT().Resolve(mock.Anything, "acme.example.com", tenant.ID("initech")).
+					Return(tenant.Tenant{}, core.NewNotFoundErr("tenant \"initech\" not found")).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		"no-directory": {
+			nilDirectory:   true,
+			expectedStatus: http.StatusOK,
+			expectedTenant: tenant.Default,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var directory tenant.Directory
+			if !tt.nilDirectory {
+				d := tenant.NewMockDirectory(t)
+				tt.setExpectation(d)
+				directory = d
+			}
+
+			var gotTenant tenant.ID
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotTenant = tenant.IDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "http://acme.example.com/api/v1/todos", nil)
+			if tt.header != "" {
+				req.Header.Set(tenantHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			tenantMiddleware(directory)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedTenant, gotTenant)
+		})
+	}
+}
+
+func TestTenantMiddleware_CrossTenantHeader(t *testing.T) {
+	t.Parallel()
+
+	directory := tenantdir.NewDirectory(true, []tenant.Tenant{
+		{ID: "acme", Hosts: []string{"acme.example.com"}},
+		{ID: "globex", Hosts: []string{"globex.example.com"}},
+	})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("handler reached for tenant %q", tenant.IDFromContext(r.Context()))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "http://acme.example.com/api/v1/todos", nil)
+	req.Header.Set(tenantHeader, "globex")
+	rec := httptest.NewRecorder()
+	tenantMiddleware(directory)(next).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.JSONEq(t, `{"error":{"code":"FORBIDDEN","message":"tenant \"globex\" is not served by this host"}}`, rec.Body.String())
+}
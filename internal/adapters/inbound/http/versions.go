@@ -0,0 +1,80 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+)
+
+const (
+	// apiV1 is the first version of the REST API, served under /api/v1.
+	apiV1 = "v1"
+	// apiV2 is the version carrying the breaking changes of the REST API, served under /api/v2.
+	apiV2 = "v2"
+)
+
+// deprecation describes an operation slated for removal.
+type deprecation struct {
+	// since is when the operation was deprecated, sent in the Deprecation header.
+	since time.Time
+	// sunset is when the operation stops being served, sent in the Sunset header.
+	sunset time.Time
+	// successor is the path of the operation replacing it, sent as a successor-version link.
+	successor string
+}
+
+// deprecatedRoutes lists the operations slated for removal, by route pattern.
+var deprecatedRoutes = map[string]deprecation{
+	"GET /api/v1/todos": {
+		since:     time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		sunset:    time.Date(2027, 4, 15, 0, 0, 0, 0, time.UTC),
+		successor: "/api/v2/todos",
+	},
+}
+
+// parseAPIVersions parses a comma-separated list of API versions, e.g. "v1,v2".
+func parseAPIVersions(list string) (map[string]bool, error) {
+	versions := map[string]bool{}
+	for entry := range strings.SplitSeq(list, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry != apiV1 && entry != apiV2 {
+			return nil, fmt.Errorf("unknown API version %q", entry)
+		}
+		versions[entry] = true
+	}
+	return versions, nil
+}
+
+// deprecationMiddleware announces the removal of the deprecated routes with the Deprecation (RFC 9745),
+// Sunset (RFC 8594) and successor-version Link headers. The route is matched on the request pattern.
+func deprecationMiddleware(routes map[string]deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if d, ok := routes[r.Pattern]; ok {
+				w.Header().Set("Deprecation", "@"+strconv.FormatInt(d.since.Unix(), 10))
+				w.Header().Set("Sunset", d.sunset.UTC().Format(http.TimeFormat))
+				if d.successor != "" {
+					w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", d.successor))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// disabledVersionHandler answers every request to a disabled API version with 410 Gone.
+func disabledVersionHandler(version string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		errResp := gen.ErrorResp{}
+		errResp.Error.Code = gen.NOTFOUND
+		errResp.Error.Message = fmt.Sprintf("API version %s is disabled", version)
+		respondJSON(w, http.StatusGone, errResp)
+	})
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAPIVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		list        string
+		expected    map[string]bool
+		expectedErr error
+	}{
+		"empty": {
+			list:     "",
+			expected: map[string]bool{},
+		},
+		"both-versions": {
+			list:     " V1, v2 ,",
+			expected: map[string]bool{apiV1: true, apiV2: true},
+		},
+		"unknown-version": {
+			list:        "v1,v3",
+			expectedErr: errors.New(`unknown API version "v3"`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseAPIVersions(tt.list)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestDeprecationMiddleware(t *testing.T) {
+	t.Parallel()
+
+	routes := map[string]deprecation{
+		"GET /api/v1/todos": {
+			since:     time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+			sunset:    time.Date(2027, 4, 15, 0, 0, 0, 0, time.UTC),
+			successor: "/api/v2/todos",
+		},
+	}
+
+	tests := map[string]struct {
+		method          string
+		path            string
+		expectedHeaders http.Header
+	}{
+		"deprecated-route": {
+			method: http.MethodGet,
+			path:   "/api/v1/todos",
+			expectedHeaders: http.Header{
+				"Deprecation": {"@1792022400"},
+				"Sunset":      {"Thu, 15 Apr 2027 00:00:00 GMT"},
+				"Link":        {`</api/v2/todos>; rel="successor-version"`},
+			},
+		},
+		"supported-route": {
+			method:          http.MethodPost,
+			path:            "/api/v1/todos",
+			expectedHeaders: http.Header{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			noop := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v1/todos", deprecationMiddleware(routes)(noop))
+			mux.Handle("POST /api/v1/todos", deprecationMiddleware(routes)(noop))
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.expectedHeaders, w.Header())
+		})
+	}
+}
+
+func TestDisabledVersionHandler(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	disabledVersionHandler(apiV1).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/todos", nil))
+
+	assert.Equal(t, http.StatusGone, w.Code)
+	var response gen.ErrorResp
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, gen.ErrorResp{Error: gen.Error{Code: gen.NOTFOUND, Message: "API version v1 is disabled"}}, response)
+}
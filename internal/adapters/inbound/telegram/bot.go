@@ -0,0 +1,312 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+)
+
+const (
+	// pollTimeout is how long one getUpdates call waits for new updates.
+	pollTimeout = 30 * time.Second
+	// pollRetryDelay is the pause before polling again after a failed getUpdates call.
+	pollRetryDelay = 5 * time.Second
+)
+
+const (
+	startText      = "Hi! Send me a message to chat with your todo assistant. Use /new to start a new conversation."
+	newChatText    = "Started a new conversation."
+	notAllowedText = "This chat is not allowed to use the assistant."
+	turnFailedText = "Sorry, something went wrong. Please try again."
+	splitText      = "Continuing in a new conversation about this topic."
+)
+
+// botAPI is the subset of the Bot API used by the bot.
+type botAPI interface {
+	GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error)
+	SendMessage(ctx context.Context, chatID int64, text string) (int64, error)
+	EditMessageText(ctx context.Context, chatID, messageID int64, text string) error
+}
+
+// ParseAllowedChatIDs parses a comma-separated list of Telegram chat IDs, e.g. "12345,-100987".
+func ParseAllowedChatIDs(list string) (map[int64]struct{}, error) {
+	ids := map[int64]struct{}{}
+	for entry := range strings.SplitSeq(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(entry, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid telegram chat id %q", entry)
+		}
+		ids[id] = struct{}{}
+	}
+	return ids, nil
+}
+
+// Bot is a Telegram frontend for the assistant chat.
+// It long-polls the Bot API for messages, runs each one as a StreamChat turn and streams the answer back
+// by progressively editing the bot messages. Each Telegram chat continues its linked conversation.
+type Bot struct {
+	Logger           *log.Logger                      `resolve:""`
+	HttpClient       *http.Client                     `resolve:"streaming"`
+	StreamChat       chat.StreamChat                  `resolve:""`
+	ConversationRepo assistant.ConversationRepository `resolve:""`
+	ChannelLinkRepo  assistant.ChannelLinkRepository  `resolve:""`
+	TimeProvider     core.CurrentTimeProvider         `resolve:""`
+	TenantDirectory  tenant.Directory                 `resolve:""`
+	Token            string                           `config:"TELEGRAM_BOT_TOKEN" default:""`
+	APIBaseURL       string                           `config:"TELEGRAM_API_BASE_URL" default:"https://api.telegram.org"`
+	Model            string                           `config:"TELEGRAM_CHAT_MODEL" default:""`
+	AllowedChatIDs   string                           `config:"TELEGRAM_ALLOWED_CHAT_IDS" default:""`
+	EditInterval     time.Duration                    `config:"TELEGRAM_EDIT_INTERVAL" default:"1s"`
+	TenantID         string                           `config:"TELEGRAM_TENANT_ID" default:""`
+	api              botAPI
+	allowed          map[int64]struct{}
+	chatLocks        *sync.Map
+}
+
+// Run polls Telegram updates until the context is canceled. The bot is disabled when no token is configured.
+func (b Bot) Run(ctx context.Context) error {
+	if strings.TrimSpace(b.Token) == "" {
+		b.Logger.Print("TelegramBot: disabled (TELEGRAM_BOT_TOKEN is empty)")
+		return nil
+	}
+	if strings.TrimSpace(b.Model) == "" {
+		return errors.New("TELEGRAM_CHAT_MODEL is required")
+	}
+	allowed, err := ParseAllowedChatIDs(b.AllowedChatIDs)
+	if err != nil {
+		return err
+	}
+	b.allowed = allowed
+	b.chatLocks = &sync.Map{}
+	// A bot token belongs to a single tenant, so every chat runs in that tenant.
+	ctx, err = b.tenantContext(ctx)
+	if err != nil {
+		return err
+	}
+	if b.api == nil {
+		b.api = NewClient(b.APIBaseURL, b.Token, b.HttpClient)
+	}
+
+	b.Logger.Printf("TelegramBot: running (allowed_chats=%d)...", len(b.allowed))
+
+	var (
+		wg     sync.WaitGroup
+		offset int64
+	)
+	defer wg.Wait()
+
+	for {
+		updates, err := b.api.GetUpdates(ctx, offset, pollTimeout)
+		if ctx.Err() != nil {
+			b.Logger.Print("TelegramBot: stopped")
+			return nil
+		}
+		if err != nil {
+			b.Logger.Printf("TelegramBot: failed to get updates: %v", err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(pollRetryDelay):
+			}
+			continue
+		}
+
+		for _, update := range updates {
+			offset = max(offset, update.UpdateID+1)
+			if update.Message == nil || strings.TrimSpace(update.Message.Text) == "" {
+				continue
+			}
+			msg := *update.Message
+			wg.Go(func() {
+				b.handleMessage(ctx, msg)
+			})
+		}
+	}
+}
+
+// handleMessage answers one message. Messages of the same chat are answered one at a time.
+// tenantContext scopes ctx to the tenant served by the bot, falling back to the default tenant.
+func (b Bot) tenantContext(ctx context.Context) (context.Context, error) {
+	tenantID := tenant.ID(strings.TrimSpace(b.TenantID))
+	if b.TenantDirectory == nil {
+		if tenantID == "" {
+			tenantID = tenant.Default
+		}
+		return tenant.WithID(ctx, tenantID), nil
+	}
+
+	t, err := b.TenantDirectory.Resolve(ctx, "", tenantID)
+	if err != nil {
+		return ctx, fmt.Errorf("TELEGRAM_TENANT_ID: %w", err)
+	}
+	return tenant.NewContext(ctx, t), nil
+}
+
+func (b Bot) handleMessage(ctx context.Context, msg Message) {
+	chatID := msg.Chat.ID
+	if _, ok := b.allowed[chatID]; !ok {
+		b.Logger.Printf("TelegramBot: rejected message from chat %d", chatID)
+		b.send(ctx, chatID, notAllowedText)
+		return
+	}
+
+	lock, _ := b.chatLocks.LoadOrStore(chatID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	externalChatID := strconv.FormatInt(chatID, 10)
+	text := strings.TrimSpace(msg.Text)
+	switch command, _, _ := strings.Cut(text, " "); command {
+	case "/start", "/help":
+		b.send(ctx, chatID, startText)
+		return
+	case "/new":
+		if err := b.ChannelLinkRepo.DeleteChannelLink(ctx, assistant.Channel_Telegram, externalChatID); err != nil {
+			b.Logger.Printf("TelegramBot: failed to unlink chat %d: %v", chatID, err)
+			b.send(ctx, chatID, turnFailedText)
+			return
+		}
+		b.send(ctx, chatID, newChatText)
+		return
+	}
+
+	conversationID, err := b.linkedConversation(ctx, externalChatID)
+	if err != nil {
+		b.Logger.Printf("TelegramBot: failed to resolve conversation of chat %d: %v", chatID, err)
+		b.send(ctx, chatID, turnFailedText)
+		return
+	}
+
+	var opts []chat.StreamChatOption
+	if conversationID != uuid.Nil {
+		opts = append(opts, chat.WithConversationID(conversationID))
+	}
+
+	r := newReply(b.api, chatID, b.EditInterval, b.TimeProvider.Now)
+	err = b.StreamChat.Execute(ctx, text, b.Model, func(ctx context.Context, eventType assistant.EventType, data any) error {
+		switch eventType {
+		case assistant.EventType_TurnStarted:
+			if started, ok := data.(assistant.TurnStarted); ok && started.ConversationID != conversationID {
+				conversationID = started.ConversationID
+				b.link(ctx, externalChatID, conversationID)
+			}
+		case assistant.EventType_ConversationSplit:
+			if split, ok := data.(assistant.ConversationSplit); ok {
+				conversationID = split.ConversationID
+				b.link(ctx, externalChatID, conversationID)
+				r.appendStatus(splitText)
+			}
+		case assistant.EventType_MessageModerated:
+			if moderated, ok := data.(assistant.MessageModerated); ok {
+				if moderated.ConversationID != conversationID {
+					conversationID = moderated.ConversationID
+					b.link(ctx, externalChatID, conversationID)
+				}
+				r.appendStatus(moderated.Message)
+			}
+		case assistant.EventType_MessageDelta:
+			if delta, ok := data.(assistant.MessageDelta); ok {
+				r.appendText(delta.Text)
+			}
+		case assistant.EventType_ActionStarted:
+			if call, ok := data.(assistant.ActionCall); ok {
+				r.appendStatus(actionStatus(call))
+			}
+		case assistant.EventType_ActionApprovalRequired:
+			if approval, ok := data.(assistant.ActionApprovalRequired); ok {
+				r.appendStatus(fmt.Sprintf("Waiting for approval in the web app: %s", approval.Title))
+				b.flush(ctx, r, true)
+			}
+			return nil
+		case assistant.EventType_TurnFailed:
+			if failed, ok := data.(assistant.TurnFailed); ok {
+				r.appendStatus(failed.Error)
+			}
+		default:
+			return nil
+		}
+		b.flush(ctx, r, false)
+		return nil
+	}, opts...)
+
+	var turnErr *assistant.TurnError
+	if err != nil && !errors.As(err, &turnErr) && ctx.Err() == nil {
+		b.Logger.Printf("TelegramBot: chat turn failed for chat %d: %v", chatID, err)
+		r.appendStatus(turnFailedText)
+	}
+	b.flush(ctx, r, true)
+}
+
+// linkedConversation returns the conversation linked to the chat, or uuid.Nil when the chat has none.
+// Links to conversations deleted from the web app are dropped.
+func (b Bot) linkedConversation(ctx context.Context, externalChatID string) (uuid.UUID, error) {
+	link, found, err := b.ChannelLinkRepo.GetChannelLink(ctx, assistant.Channel_Telegram, externalChatID)
+	if err != nil || !found {
+		return uuid.Nil, err
+	}
+
+	_, found, err = b.ConversationRepo.GetConversation(ctx, link.ConversationID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !found {
+		return uuid.Nil, b.ChannelLinkRepo.DeleteChannelLink(ctx, assistant.Channel_Telegram, externalChatID)
+	}
+	return link.ConversationID, nil
+}
+
+// link points the chat to a conversation. A failure is only logged so the turn keeps streaming.
+func (b Bot) link(ctx context.Context, externalChatID string, conversationID uuid.UUID) {
+	now := b.TimeProvider.Now()
+	err := b.ChannelLinkRepo.SaveChannelLink(ctx, assistant.ChannelLink{
+		Channel:        assistant.Channel_Telegram,
+		ExternalChatID: externalChatID,
+		ConversationID: conversationID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if err != nil {
+		b.Logger.Printf("TelegramBot: failed to link chat %s to conversation %s: %v", externalChatID, conversationID, err)
+	}
+}
+
+// flush pushes the reply to Telegram. A failure is only logged so the turn keeps running.
+func (b Bot) flush(ctx context.Context, r *reply, force bool) {
+	if r.empty() {
+		return
+	}
+	if err := r.flush(ctx, force); err != nil {
+		b.Logger.Printf("TelegramBot: failed to update reply in chat %d: %v", r.chatID, err)
+	}
+}
+
+// send sends a plain text message.
+func (b Bot) send(ctx context.Context, chatID int64, text string) {
+	r := newReply(b.api, chatID, 0, b.TimeProvider.Now)
+	r.appendText(text)
+	b.flush(ctx, r, true)
+}
+
+// actionStatus returns the status line shown while an action runs.
+func actionStatus(call assistant.ActionCall) string {
+	if status := strings.TrimSpace(call.Text); status != "" {
+		return status
+	}
+	return fmt.Sprintf("Running %s...", call.Name)
+}
@@ -0,0 +1,453 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParseAllowedChatIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		list        string
+		expected    map[int64]struct{}
+		expectedErr bool
+	}{
+		"empty": {
+			list:     "",
+			expected: map[int64]struct{}{},
+		},
+		"entries": {
+			list:     "42, -100987 ,",
+			expected: map[int64]struct{}{42: {}, -100987: {}},
+		},
+		"invalid-entry": {
+			list:        "42,abc",
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseAllowedChatIDs(tt.list)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBot_handleMessage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	linkedID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	link := assistant.ChannelLink{Channel: assistant.Channel_Telegram, ExternalChatID: "42", ConversationID: linkedID}
+	newLink := assistant.ChannelLink{
+		Channel:        assistant.Channel_Telegram,
+		ExternalChatID: "42",
+		ConversationID: createdID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	type mocks struct {
+		streamChat       *chat.MockStreamChat
+		conversationRepo *assistant.MockConversationRepository
+		channelLinkRepo  *assistant.MockChannelLinkRepository
+	}
+	expectConversationID := func(t *testing.T, expected *uuid.UUID, opts []chat.StreamChatOption) {
+		params := &chat.StreamChatParams{}
+		for _, opt := range opts {
+			opt(params)
+		}
+		assert.Equal(t, expected, params.ConversationID)
+	}
+
+	tests := map[string]struct {
+		chatID          int64
+		text            string
+		setExpectations func(t *testing.T, m mocks)
+		expectedSends   []string
+		expectedEdits   []string
+	}{
+		"new-conversation-is-linked": {
+			chatID: 42,
+			text:   "What is due?",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(assistant.ChannelLink{}, false, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "What is due?", "test-model", mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						expectConversationID(t, nil, opts)
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{ConversationID: createdID, ConversationCreated: true})
+						_ = cb(ctx, assistant.EventType_ActionStarted, assistant.ActionCall{Name: "fetch_todos", Text: "Fetching todos..."})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "You have "})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "2 todos."})
+						_ = cb(ctx, assistant.EventType_TurnCompleted, assistant.TurnCompleted{})
+					}).
+					Return(nil).
+					Once()
+				m.channelLinkRepo.EXPECT().SaveChannelLink(mock.Anything, newLink).Return(nil).Once()
+			},
+			expectedSends: []string{"<i>Fetching todos...</i>"},
+			expectedEdits: []string{
+				"<i>Fetching todos...</i>\nYou have",
+				"<i>Fetching todos...</i>\nYou have 2 todos.",
+			},
+		},
+		"linked-conversation-continues": {
+			chatID: 42,
+			text:   "Thanks",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(link, true, nil).
+					Once()
+				m.conversationRepo.EXPECT().
+					GetConversation(mock.Anything, linkedID).
+					Return(assistant.Conversation{ID: linkedID}, true, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Thanks", "test-model", mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						expectConversationID(t, &linkedID, opts)
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{ConversationID: linkedID})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "You're welcome!"})
+					}).
+					Return(nil).
+					Once()
+			},
+			expectedSends: []string{"You&#39;re welcome!"},
+		},
+		"deleted-conversation-is-unlinked": {
+			chatID: 42,
+			text:   "Hello",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(link, true, nil).
+					Once()
+				m.conversationRepo.EXPECT().
+					GetConversation(mock.Anything, linkedID).
+					Return(assistant.Conversation{}, false, nil).
+					Once()
+				m.channelLinkRepo.EXPECT().
+					DeleteChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Hello", "test-model", mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, opts ...chat.StreamChatOption) {
+						expectConversationID(t, nil, opts)
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{ConversationID: createdID, ConversationCreated: true})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hi"})
+					}).
+					Return(nil).
+					Once()
+				m.channelLinkRepo.EXPECT().SaveChannelLink(mock.Anything, newLink).Return(nil).Once()
+			},
+			expectedSends: []string{"Hi"},
+		},
+		"conversation-split-moves-link": {
+			chatID: 42,
+			text:   "Plan my trip",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(link, true, nil).
+					Once()
+				m.conversationRepo.EXPECT().
+					GetConversation(mock.Anything, linkedID).
+					Return(assistant.Conversation{ID: linkedID}, true, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Plan my trip", "test-model", mock.Anything, mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, _ ...chat.StreamChatOption) {
+						_ = cb(ctx, assistant.EventType_ConversationSplit, assistant.ConversationSplit{PreviousConversationID: linkedID, ConversationID: createdID})
+						_ = cb(ctx, assistant.EventType_TurnStarted, assistant.TurnStarted{ConversationID: createdID, ConversationCreated: true})
+						_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Sure."})
+					}).
+					Return(nil).
+					Once()
+				m.channelLinkRepo.EXPECT().SaveChannelLink(mock.Anything, newLink).Return(nil).Once()
+			},
+			expectedSends: []string{"<i>" + splitText + "</i>"},
+			expectedEdits: []string{"<i>" + splitText + "</i>\nSure."},
+		},
+		"moderated-message-is-refused": {
+			chatID: 42,
+			text:   "Blocked text",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(assistant.ChannelLink{}, false, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Blocked text", "test-model", mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, _ ...chat.StreamChatOption) {
+						_ = cb(ctx, assistant.EventType_MessageModerated, assistant.MessageModerated{
+							ConversationID:      createdID,
+							ConversationCreated: true,
+							Message:             "Sorry, I cannot help with that.",
+						})
+					}).
+					Return(nil).
+					Once()
+				m.channelLinkRepo.EXPECT().SaveChannelLink(mock.Anything, newLink).Return(nil).Once()
+			},
+			expectedSends: []string{"<i>Sorry, I cannot help with that.</i>"},
+		},
+		"approval-is-announced": {
+			chatID: 42,
+			text:   "Delete it",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(assistant.ChannelLink{}, false, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Delete it", "test-model", mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, _ ...chat.StreamChatOption) {
+						_ = cb(ctx, assistant.EventType_ActionApprovalRequired, assistant.ActionApprovalRequired{Title: "Delete todo"})
+					}).
+					Return(nil).
+					Once()
+			},
+			expectedSends: []string{"<i>Waiting for approval in the web app: Delete todo</i>"},
+		},
+		"turn-failure-is-rendered-once": {
+			chatID: 42,
+			text:   "Hello",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(assistant.ChannelLink{}, false, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Hello", "test-model", mock.Anything).
+					Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, _ ...chat.StreamChatOption) {
+						_ = cb(ctx, assistant.EventType_TurnFailed, assistant.TurnFailed{Error: "rate limited"})
+					}).
+					Return(assistant.NewTurnError(assistant.TurnErrorCode_RateLimited, 0, errors.New("rate limited"))).
+					Once()
+			},
+			expectedSends: []string{"<i>rate limited</i>"},
+		},
+		"execute-error": {
+			chatID: 42,
+			text:   "Hello",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(assistant.ChannelLink{}, false, nil).
+					Once()
+				m.streamChat.EXPECT().
+					Execute(mock.Anything, "Hello", "test-model", mock.Anything).
+					Return(errors.New("db error")).
+					Once()
+			},
+			expectedSends: []string{"<i>" + turnFailedText + "</i>"},
+		},
+		"link-lookup-error": {
+			chatID: 42,
+			text:   "Hello",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					GetChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(assistant.ChannelLink{}, false, errors.New("db error")).
+					Once()
+			},
+			expectedSends: []string{turnFailedText},
+		},
+		"new-command-unlinks-chat": {
+			chatID: 42,
+			text:   "/new",
+			setExpectations: func(t *testing.T, m mocks) {
+				m.channelLinkRepo.EXPECT().
+					DeleteChannelLink(mock.Anything, assistant.Channel_Telegram, "42").
+					Return(nil).
+					Once()
+			},
+			expectedSends: []string{newChatText},
+		},
+		"start-command": {
+			chatID:          42,
+			text:            "/start",
+			setExpectations: func(t *testing.T, m mocks) {},
+			expectedSends:   []string{startText},
+		},
+		"chat-not-allowed": {
+			chatID:          7,
+			text:            "Hello",
+			setExpectations: func(t *testing.T, m mocks) {},
+			expectedSends:   []string{notAllowedText},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				streamChat:       chat.NewMockStreamChat(t),
+				conversationRepo: assistant.NewMockConversationRepository(t),
+				channelLinkRepo:  assistant.NewMockChannelLinkRepository(t),
+			}
+			tt.setExpectations(t, m)
+
+			api := &fakeBotAPI{}
+			bot := Bot{
+				Logger:           log.New(io.Discard, "", 0),
+				StreamChat:       m.streamChat,
+				ConversationRepo: m.conversationRepo,
+				ChannelLinkRepo:  m.channelLinkRepo,
+				TimeProvider:     &fakeClock{now: now},
+				Model:            "test-model",
+				api:              api,
+				allowed:          map[int64]struct{}{42: {}},
+				chatLocks:        &sync.Map{},
+			}
+
+			bot.handleMessage(t.Context(), Message{MessageID: 1, Chat: Chat{ID: tt.chatID}, Text: tt.text})
+
+			sends, edits := api.calls()
+			var gotSends, gotEdits []string
+			for _, s := range sends {
+				assert.Equal(t, tt.chatID, s.ChatID)
+				gotSends = append(gotSends, s.Text)
+			}
+			for _, e := range edits {
+				gotEdits = append(gotEdits, e.Text)
+			}
+			assert.Equal(t, tt.expectedSends, gotSends)
+			assert.Equal(t, tt.expectedEdits, gotEdits)
+		})
+	}
+}
+
+func TestBot_Run(t *testing.T) {
+	t.Parallel()
+
+	unknownTenant := tenant.NewMockDirectory(t)
+	unknownTenant.EXPECT().Resolve(mock.Anything, "", tenant.ID("initech")).
+		Return(tenant.Tenant{}, core.NewNotFoundErr(`tenant "initech" not found`)).
+		Once()
+
+	tests := map[string]struct {
+		bot         Bot
+		expectedErr error
+	}{
+		"disabled-without-token": {
+			bot: Bot{},
+		},
+		"model-is-required": {
+			bot:         Bot{Token: "token"},
+			expectedErr: errors.New("TELEGRAM_CHAT_MODEL is required"),
+		},
+		"invalid-allowed-chat-ids": {
+			bot:         Bot{Token: "token", Model: "test-model", AllowedChatIDs: "abc"},
+			expectedErr: errors.New(`invalid telegram chat id "abc"`),
+		},
+		"unknown-tenant": {
+			bot:         Bot{Token: "token", Model: "test-model", TenantID: "initech", TenantDirectory: unknownTenant},
+			expectedErr: fmt.Errorf("TELEGRAM_TENANT_ID: %w", core.NewNotFoundErr(`tenant "initech" not found`)),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.bot.Logger = log.New(io.Discard, "", 0)
+			err := tt.bot.Run(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestBot_Run_AnswersUpdates(t *testing.T) {
+	t.Parallel()
+
+	streamChat := chat.NewMockStreamChat(t)
+	channelLinkRepo := assistant.NewMockChannelLinkRepository(t)
+	tenantDirectory := tenant.NewMockDirectory(t)
+	api := &fakeBotAPI{
+		updates: [][]Update{{
+			{UpdateID: 10, Message: &Message{MessageID: 1, Chat: Chat{ID: 42}, Text: "Hello"}},
+			{UpdateID: 11},
+		}},
+	}
+
+	isAcme := mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "acme"
+	})
+	tenantDirectory.EXPECT().
+		Resolve(mock.Anything, "", tenant.ID("acme")).
+		Return(tenant.Tenant{ID: "acme"}, nil).
+		Once()
+	channelLinkRepo.EXPECT().
+		GetChannelLink(isAcme, assistant.Channel_Telegram, "42").
+		Return(assistant.ChannelLink{}, false, nil).
+		Once()
+	streamChat.EXPECT().
+		Execute(isAcme, "Hello", "test-model", mock.Anything).
+		Run(func(ctx context.Context, _ string, _ string, cb assistant.EventCallback, _ ...chat.StreamChatOption) {
+			_ = cb(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hi"})
+		}).
+		Return(nil).
+		Once()
+
+	bot := Bot{
+		Logger:          log.New(io.Discard, "", 0),
+		StreamChat:      streamChat,
+		ChannelLinkRepo: channelLinkRepo,
+		TimeProvider:    &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)},
+		TenantDirectory: tenantDirectory,
+		Token:           "token",
+		Model:           "test-model",
+		AllowedChatIDs:  "42",
+		TenantID:        "acme",
+		api:             api,
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() {
+		done <- bot.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool {
+		sends, _ := api.calls()
+		return len(sends) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+
+	sends, _ := api.calls()
+	assert.Equal(t, []sentMessage{{ChatID: 42, Text: "Hi"}}, sends)
+}
@@ -0,0 +1,167 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds the Bot API calls other than long polling.
+const requestTimeout = 15 * time.Second
+
+// Update is an incoming update of the Bot API getUpdates method.
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message,omitempty"`
+}
+
+// Message is a Telegram message.
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+// Chat is the Telegram chat a message belongs to.
+type Chat struct {
+	ID int64 `json:"id"`
+}
+
+// APIError is an error returned by the Bot API.
+type APIError struct {
+	Method      string
+	Code        int
+	Description string
+}
+
+// Error returns the Bot API error message.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram %s failed (%d): %s", e.Method, e.Code, e.Description)
+}
+
+// Client is a thin client for the Telegram Bot API.
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewClient creates a new client.
+func NewClient(baseURL string, token string, httpClient *http.Client) Client {
+	return Client{
+		baseURL: baseURL,
+		token:   token,
+		http:    httpClient,
+	}
+}
+
+// GetUpdates long-polls the updates after offset, waiting up to timeout for new ones.
+func (c Client) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	var updates []Update
+	err := c.call(ctx, "getUpdates", map[string]any{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message"},
+	}, &updates)
+	return updates, err
+}
+
+// SendMessage sends an HTML formatted message and returns its ID.
+func (c Client) SendMessage(ctx context.Context, chatID int64, text string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	var msg Message
+	err := c.call(ctx, "sendMessage", map[string]any{
+		"chat_id":    chatID,
+		"text":       text,
+		"parse_mode": "HTML",
+	}, &msg)
+	return msg.MessageID, err
+}
+
+// EditMessageText replaces the text of a message with HTML formatted text.
+// Edits that do not change the text are not reported as errors.
+func (c Client) EditMessageText(ctx context.Context, chatID, messageID int64, text string) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	err := c.call(ctx, "editMessageText", map[string]any{
+		"chat_id":    chatID,
+		"message_id": messageID,
+		"text":       text,
+		"parse_mode": "HTML",
+	}, nil)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && strings.Contains(apiErr.Description, "message is not modified") {
+		return nil
+	}
+	return err
+}
+
+// call invokes a Bot API method and decodes its result into out when out is not nil.
+func (c Client) call(ctx context.Context, method string, params any, out any) error {
+	endpoint, err := url.JoinPath(c.baseURL, "bot"+c.token, method)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// The request URL embeds the bot token, keep it out of the error.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("telegram %s: %w", method, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	var envelope struct {
+		OK          bool            `json:"ok"`
+		Result      json.RawMessage `json:"result"`
+		ErrorCode   int             `json:"error_code"`
+		Description string          `json:"description"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return fmt.Errorf("unmarshal response (status %d): %w", resp.StatusCode, err)
+	}
+	if !envelope.OK {
+		code := envelope.ErrorCode
+		if code == 0 {
+			code = resp.StatusCode
+		}
+		return &APIError{Method: method, Code: code, Description: envelope.Description}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Result, out); err != nil {
+		return fmt.Errorf("unmarshal result: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package telegram
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient starts a Bot API stub that checks the called method and request body,
+// then replies with the given status and body.
+func newTestClient(t *testing.T, method string, expectedBody string, status int, body string) Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bottoken/"+method, r.URL.Path)
+		got, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, expectedBody, string(got))
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(server.URL, "token", server.Client())
+}
+
+func TestClient_GetUpdates(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status      int
+		body        string
+		expected    []Update
+		expectedErr error
+	}{
+		"success": {
+			status: http.StatusOK,
+			body:   `{"ok":true,"result":[{"update_id":7,"message":{"message_id":3,"chat":{"id":42},"text":"hi"}},{"update_id":8}]}`,
+			expected: []Update{
+				{UpdateID: 7, Message: &Message{MessageID: 3, Chat: Chat{ID: 42}, Text: "hi"}},
+				{UpdateID: 8},
+			},
+		},
+		"api-error": {
+			status:      http.StatusUnauthorized,
+			body:        `{"ok":false,"error_code":401,"description":"Unauthorized"}`,
+			expectedErr: &APIError{Method: "getUpdates", Code: 401, Description: "Unauthorized"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, "getUpdates", `{"offset":5,"timeout":30,"allowed_updates":["message"]}`, tt.status, tt.body)
+			got, err := client.GetUpdates(t.Context(), 5, 30*time.Second)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestClient_SendMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status      int
+		body        string
+		expected    int64
+		expectedErr error
+	}{
+		"success": {
+			status:   http.StatusOK,
+			body:     `{"ok":true,"result":{"message_id":11,"chat":{"id":42},"text":"hello"}}`,
+			expected: 11,
+		},
+		"api-error": {
+			status:      http.StatusBadRequest,
+			body:        `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+			expectedErr: &APIError{Method: "sendMessage", Code: 400, Description: "Bad Request: chat not found"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, "sendMessage", `{"chat_id":42,"text":"<i>hello</i>","parse_mode":"HTML"}`, tt.status, tt.body)
+			got, err := client.SendMessage(t.Context(), 42, "<i>hello</i>")
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestClient_EditMessageText(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status      int
+		body        string
+		expectedErr error
+	}{
+		"success": {
+			status: http.StatusOK,
+			body:   `{"ok":true,"result":{"message_id":11,"chat":{"id":42},"text":"hello"}}`,
+		},
+		"not-modified-is-ignored": {
+			status: http.StatusBadRequest,
+			body:   `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`,
+		},
+		"api-error": {
+			status:      http.StatusTooManyRequests,
+			body:        `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 3"}`,
+			expectedErr: &APIError{Method: "editMessageText", Code: 429, Description: "Too Many Requests: retry after 3"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, "editMessageText", `{"chat_id":42,"message_id":11,"text":"hello","parse_mode":"HTML"}`, tt.status, tt.body)
+			err := client.EditMessageText(t.Context(), 42, 11, "hello")
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestClient_TransportErrorHidesToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := NewClient(server.URL, "s3cr3t-token", server.Client())
+	_, err := client.SendMessage(t.Context(), 42, "hello")
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "s3cr3t-token")
+}
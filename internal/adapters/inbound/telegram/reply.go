@@ -0,0 +1,155 @@
+package telegram
+
+import (
+	"context"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// maxMessageLength is the longest message text accepted by Telegram, in UTF-16 code units after entity parsing.
+const maxMessageLength = 4096
+
+// segment is a run of reply text rendered either as plain text or as an italic status line.
+type segment struct {
+	text   string
+	italic bool
+}
+
+// reply renders one assistant turn into Telegram messages.
+// The text is streamed by editing the sent messages at most once per interval, and spills into
+// additional messages when it outgrows the Telegram message length limit.
+type reply struct {
+	api      botAPI
+	chatID   int64
+	interval time.Duration
+	now      func() time.Time
+
+	segments   []segment
+	messageIDs []int64
+	sent       []string
+	lastFlush  time.Time
+}
+
+// newReply creates a reply to a chat.
+func newReply(api botAPI, chatID int64, interval time.Duration, now func() time.Time) *reply {
+	return &reply{
+		api:      api,
+		chatID:   chatID,
+		interval: interval,
+		now:      now,
+	}
+}
+
+// appendText appends assistant answer text.
+func (r *reply) appendText(text string) {
+	if text == "" {
+		return
+	}
+	if n := len(r.segments); n > 0 && !r.segments[n-1].italic {
+		r.segments[n-1].text += text
+		return
+	}
+	r.segments = append(r.segments, segment{text: text})
+}
+
+// appendStatus appends an italic status line, starting it on a new line when needed.
+func (r *reply) appendStatus(status string) {
+	status = strings.TrimSpace(status)
+	if status == "" {
+		return
+	}
+	if n := len(r.segments); n > 0 && !strings.HasSuffix(r.segments[n-1].text, "\n") {
+		r.appendText("\n")
+	}
+	r.segments = append(r.segments, segment{text: status, italic: true}, segment{text: "\n"})
+}
+
+// empty reports whether nothing was rendered yet.
+func (r *reply) empty() bool {
+	return len(r.segments) == 0
+}
+
+// flush sends or edits the reply messages whose text changed.
+// Unless force is set, it does nothing when the previous flush happened less than interval ago.
+func (r *reply) flush(ctx context.Context, force bool) error {
+	now := r.now()
+	if !force && !r.lastFlush.IsZero() && now.Sub(r.lastFlush) < r.interval {
+		return nil
+	}
+	r.lastFlush = now
+
+	for i, chunk := range r.render() {
+		if i < len(r.messageIDs) {
+			if r.sent[i] == chunk {
+				continue
+			}
+			if err := r.api.EditMessageText(ctx, r.chatID, r.messageIDs[i], chunk); err != nil {
+				return err
+			}
+			r.sent[i] = chunk
+			continue
+		}
+		messageID, err := r.api.SendMessage(ctx, r.chatID, chunk)
+		if err != nil {
+			return err
+		}
+		r.messageIDs = append(r.messageIDs, messageID)
+		r.sent = append(r.sent, chunk)
+	}
+	return nil
+}
+
+// render splits the reply into HTML message texts of at most maxMessageLength visible characters.
+// Splitting happens on the plain text so no chunk breaks an HTML tag or entity.
+func (r *reply) render() []string {
+	var (
+		chunks []string
+		chunk  strings.Builder
+		length int
+	)
+	closeChunk := func() {
+		if text := strings.TrimSpace(chunk.String()); text != "" {
+			chunks = append(chunks, text)
+		}
+		chunk.Reset()
+		length = 0
+	}
+
+	for _, seg := range r.segments {
+		rest := seg.text
+		for rest != "" {
+			if length == maxMessageLength {
+				closeChunk()
+			}
+			part, size := cutUTF16(rest, maxMessageLength-length)
+			rest = rest[len(part):]
+			length += size
+			if seg.italic {
+				chunk.WriteString("<i>" + html.EscapeString(part) + "</i>")
+			} else {
+				chunk.WriteString(html.EscapeString(part))
+			}
+			if part == "" {
+				// The next rune does not fit the current chunk.
+				closeChunk()
+			}
+		}
+	}
+	closeChunk()
+	return chunks
+}
+
+// cutUTF16 returns the longest prefix of s that fits in limit UTF-16 code units, and its length in code units.
+func cutUTF16(s string, limit int) (string, int) {
+	size := 0
+	for i, r := range s {
+		n := max(utf16.RuneLen(r), 1)
+		if size+n > limit {
+			return s[:i], size
+		}
+		size += n
+	}
+	return s, size
+}
@@ -0,0 +1,113 @@
+package telegram
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReply_Render(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		build    func(r *reply)
+		expected []string
+	}{
+		"empty": {
+			build:    func(r *reply) {},
+			expected: nil,
+		},
+		"text-is-escaped": {
+			build: func(r *reply) {
+				r.appendText("a < b & ")
+				r.appendText("c > d")
+			},
+			expected: []string{"a &lt; b &amp; c &gt; d"},
+		},
+		"status-lines-are-italic": {
+			build: func(r *reply) {
+				r.appendStatus("Looking up your todos...")
+				r.appendText("You have ")
+				r.appendText("2 todos.")
+				r.appendStatus("Creating <todo>")
+				r.appendText("Done.")
+			},
+			expected: []string{
+				"<i>Looking up your todos...</i>\nYou have 2 todos.\n<i>Creating &lt;todo&gt;</i>\nDone.",
+			},
+		},
+		"blank-status-is-ignored": {
+			build: func(r *reply) {
+				r.appendText("Hi")
+				r.appendStatus("  ")
+			},
+			expected: []string{"Hi"},
+		},
+		"long-text-spills-into-messages": {
+			build: func(r *reply) {
+				r.appendText(strings.Repeat("a", maxMessageLength-1))
+				r.appendStatus("bc")
+			},
+			expected: []string{
+				strings.Repeat("a", maxMessageLength-1),
+				"<i>bc</i>",
+			},
+		},
+		"surrogate-pairs-are-not-split": {
+			build: func(r *reply) {
+				r.appendText(strings.Repeat("a", maxMessageLength-1) + "😀b")
+			},
+			expected: []string{
+				strings.Repeat("a", maxMessageLength-1),
+				"😀b",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newReply(&fakeBotAPI{}, 42, time.Second, time.Now)
+			tt.build(r)
+			assert.Equal(t, tt.expected, r.render())
+		})
+	}
+}
+
+func TestReply_Flush(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}
+	api := &fakeBotAPI{}
+	r := newReply(api, 42, time.Second, clock.Now)
+
+	r.appendText("Hel")
+	assert.NoError(t, r.flush(t.Context(), false))
+
+	// Updates within the edit interval are held back.
+	r.appendText("lo")
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	assert.NoError(t, r.flush(t.Context(), false))
+
+	clock.now = clock.now.Add(time.Second)
+	assert.NoError(t, r.flush(t.Context(), false))
+
+	// Forced flushes skip unchanged messages.
+	assert.NoError(t, r.flush(t.Context(), true))
+
+	r.appendText(strings.Repeat("!", maxMessageLength))
+	assert.NoError(t, r.flush(t.Context(), true))
+
+	sends, edits := api.calls()
+	assert.Equal(t, []sentMessage{
+		{ChatID: 42, Text: "Hel"},
+		{ChatID: 42, Text: "!!!!!"},
+	}, sends)
+	assert.Equal(t, []sentMessage{
+		{ChatID: 42, MessageID: 101, Text: "Hello"},
+		{ChatID: 42, MessageID: 101, Text: "Hello" + strings.Repeat("!", maxMessageLength-5)},
+	}, edits)
+}
@@ -0,0 +1,71 @@
+package telegram
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sentMessage is a message call recorded by fakeBotAPI. MessageID is zero for sendMessage calls.
+type sentMessage struct {
+	ChatID    int64
+	MessageID int64
+	Text      string
+}
+
+// fakeBotAPI records sendMessage and editMessageText calls and serves getUpdates from a queue.
+type fakeBotAPI struct {
+	mu      sync.Mutex
+	updates [][]Update
+	sends   []sentMessage
+	edits   []sentMessage
+	sendErr error
+}
+
+// GetUpdates returns the next queued batch, then blocks until the context is canceled.
+func (f *fakeBotAPI) GetUpdates(ctx context.Context, _ int64, _ time.Duration) ([]Update, error) {
+	f.mu.Lock()
+	if len(f.updates) > 0 {
+		batch := f.updates[0]
+		f.updates = f.updates[1:]
+		f.mu.Unlock()
+		return batch, nil
+	}
+	f.mu.Unlock()
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (f *fakeBotAPI) SendMessage(_ context.Context, chatID int64, text string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sendErr != nil {
+		return 0, f.sendErr
+	}
+	f.sends = append(f.sends, sentMessage{ChatID: chatID, Text: text})
+	return int64(100 + len(f.sends)), nil
+}
+
+func (f *fakeBotAPI) EditMessageText(_ context.Context, chatID, messageID int64, text string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.edits = append(f.edits, sentMessage{ChatID: chatID, MessageID: messageID, Text: text})
+	return nil
+}
+
+// calls returns the recorded sends and edits.
+func (f *fakeBotAPI) calls() ([]sentMessage, []sentMessage) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]sentMessage(nil), f.sends...), append([]sentMessage(nil), f.edits...)
+}
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
@@ -4,18 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"log"
 	"strings"
 	"time"
-	"unicode"
 
 	"cloud.google.com/go/pubsub/v2"
-	"cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
-	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 const actionApprovalEventsTopicID = "ActionApprovals"
@@ -28,6 +22,7 @@ type ActionApprovalDispatcher struct {
 	Dispatcher          assistant.ActionApprovalDispatcher `resolve:""`
 	SubscriptionPrefix  string                             `config:"ACTION_APPROVAL_EVENTS_SUBSCRIPTION_PREFIX"`
 	ProjectID           string                             `config:"PUBSUB_PROJECT_ID"`
+	TenantFilter        string                             `config:"PUBSUB_TENANT_FILTER" default:""`
 	ServerID            string
 	workerExecutionChan chan struct{}
 }
@@ -63,7 +58,11 @@ func (w ActionApprovalDispatcher) Run(ctx context.Context) error {
 				}
 			}
 
-			decision, err := decodeApprovalDecision(msg.Data)
+			payload, err := messagePayload(msg)
+			var decision assistant.ActionApprovalDecision
+			if err == nil {
+				decision, err = decodeApprovalDecision(payload)
+			}
 			if err != nil {
 				w.Logger.Printf("ActionApprovalDispatcher: invalid payload: %v", err)
 				msg.Ack()
@@ -71,7 +70,7 @@ func (w ActionApprovalDispatcher) Run(ctx context.Context) error {
 				return
 			}
 
-			dispatched := w.Dispatcher.Dispatch(msgCtx, decision)
+			dispatched := w.Dispatcher.Dispatch(messageTenantContext(msgCtx, msg), decision)
 			if !dispatched {
 				w.Logger.Printf(
 					"ActionApprovalDispatcher: no active waiter for conversation_id=%s turn_id=%s action_call_id=%s",
@@ -99,109 +98,25 @@ func (w ActionApprovalDispatcher) Run(ctx context.Context) error {
 
 // resolveSubscriptionID determines the effective subscription ID to use, applying server ID suffix if configured.
 func (w ActionApprovalDispatcher) resolveSubscriptionID() string {
-	base := strings.TrimSpace(w.SubscriptionPrefix)
-	if base == "" {
-		return ""
-	}
-
-	serverID := strings.TrimSpace(w.ServerID)
-	if serverID == "" {
-		serverID = uuid.NewString()
-	}
-	serverID = sanitizeSubscriptionPart(serverID)
-	if serverID == "" {
-		return base
-	}
-	return base + "-" + serverID
-}
-
-// sanitizeSubscriptionPart cleans a string to be safely used as part of a Pub/Sub subscription ID,
-// ensuring it meets character and length requirements.
-func sanitizeSubscriptionPart(part string) string {
-	trimmed := strings.TrimSpace(strings.ToLower(part))
-	if trimmed == "" {
-		return ""
-	}
-
-	var b strings.Builder
-	prevDash := false
-	for _, r := range trimmed {
-		valid := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
-		if valid {
-			b.WriteRune(r)
-			prevDash = false
-			continue
-		}
-		if !prevDash {
-			b.WriteByte('-')
-			prevDash = true
-		}
-	}
-
-	result := strings.Trim(b.String(), "-")
-	const maxLen = 40
-	if len(result) > maxLen {
-		result = strings.Trim(result[:maxLen], "-")
-	}
-	return result
+	return resolveReplicaSubscriptionID(w.SubscriptionPrefix, w.ServerID)
 }
 
 func (w ActionApprovalDispatcher) ensureSubscription(ctx context.Context, subscriptionID string) error {
-	projectID := strings.TrimSpace(w.ProjectID)
-	if projectID == "" {
-		return errors.New("PUBSUB_PROJECT_ID is required")
-	}
 	if strings.TrimSpace(subscriptionID) == "" {
 		return errors.New("ACTION_APPROVAL_EVENTS_SUBSCRIPTION_PREFIX is required")
 	}
-
-	subscriptionPath := fmt.Sprintf("projects/%s/subscriptions/%s", projectID, subscriptionID)
-	_, err := w.Client.SubscriptionAdminClient.GetSubscription(
-		ctx,
-		&pubsubpb.GetSubscriptionRequest{Subscription: subscriptionPath},
-	)
-	if err == nil {
-		return nil
-	}
-
-	if status.Code(err) != codes.NotFound {
-		return err
-	}
-
-	topicPath := fmt.Sprintf("projects/%s/topics/%s", projectID, actionApprovalEventsTopicID)
-	_, err = w.Client.SubscriptionAdminClient.CreateSubscription(
-		ctx,
-		&pubsubpb.Subscription{
-			Name:  subscriptionPath,
-			Topic: topicPath,
-		},
-	)
-	if err != nil && status.Code(err) != codes.AlreadyExists {
+	filter, err := tenantSubscriptionFilter(w.TenantFilter)
+	if err != nil {
 		return err
 	}
-
-	return nil
+	return ensureSubscription(ctx, w.Client, w.ProjectID, actionApprovalEventsTopicID, subscriptionID, filter)
 }
 
 func (w ActionApprovalDispatcher) deleteSubscription(ctx context.Context, subscriptionID string) error {
-	projectID := strings.TrimSpace(w.ProjectID)
-	if projectID == "" {
-		return errors.New("PUBSUB_PROJECT_ID is required")
-	}
 	if strings.TrimSpace(subscriptionID) == "" {
 		return errors.New("ACTION_APPROVAL_EVENTS_SUBSCRIPTION_PREFIX is required")
 	}
-
-	subscriptionPath := fmt.Sprintf("projects/%s/subscriptions/%s", projectID, subscriptionID)
-	err := w.Client.SubscriptionAdminClient.DeleteSubscription(
-		ctx,
-		&pubsubpb.DeleteSubscriptionRequest{Subscription: subscriptionPath},
-	)
-	if err != nil && status.Code(err) != codes.NotFound {
-		return err
-	}
-
-	return nil
+	return deleteSubscription(ctx, w.Client, w.ProjectID, subscriptionID)
 }
 
 // decodeApprovalDecision attempts to parse the incoming Pub/Sub message payload into an ActionApprovalDecision struct,
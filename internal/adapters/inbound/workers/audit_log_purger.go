@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/audit"
+)
+
+// AuditLogPurger is a runnable that periodically removes the audit entries older than the retention period.
+type AuditLogPurger struct {
+	AuditLog            audit.Log     `resolve:""`
+	Logger              *log.Logger   `resolve:""`
+	Interval            time.Duration `config:"AUDIT_PURGE_INTERVAL" default:"1h"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the audit log purger worker.
+func (p AuditLogPurger) Run(ctx context.Context) error {
+	p.Logger.Println("AuditLogPurger: running...")
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			removed, err := p.AuditLog.Purge(ctx)
+			if err != nil {
+				p.Logger.Printf("AuditLogPurger: error purging audit log: %v", err)
+			} else if removed > 0 {
+				p.Logger.Printf("AuditLogPurger: removed %d expired audit entries", removed)
+			}
+			if p.workerExecutionChan != nil {
+				p.workerExecutionChan <- struct{}{}
+			}
+		case <-ctx.Done():
+			p.Logger.Println("AuditLogPurger: stopped")
+			return nil
+		}
+	}
+}
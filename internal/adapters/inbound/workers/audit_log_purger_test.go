@@ -0,0 +1,35 @@
+package workers
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditLogPurger_Run(t *testing.T) {
+	t.Parallel()
+
+	auditLog := audit.NewMockLog(t)
+
+	auditLog.EXPECT().Purge(mock.Anything).Return(0, assert.AnError).Once()
+	auditLog.EXPECT().Purge(mock.Anything).Return(3, nil).Once()
+
+	signalChan := make(chan struct{})
+
+	cancel, doneChan := run(t, t.Context(), AuditLogPurger{
+		AuditLog:            auditLog,
+		Logger:              log.Default(),
+		Interval:            2 * time.Millisecond,
+		workerExecutionChan: signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
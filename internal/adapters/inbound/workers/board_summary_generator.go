@@ -7,7 +7,11 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/workerpool"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
 )
 
 // BoardSummaryGenerator is a runnable that consumes Todo domain events from Pub/Sub
@@ -19,6 +23,8 @@ type BoardSummaryGenerator struct {
 	BatchSize            int                        `config:"SUMMARY_BATCH_SIZE" default:"100"`
 	SubscriptionID       string                     `config:"TODO_EVENTS_SUBSCRIPTION_ID"`
 	GenerateBoardSummary board.GenerateBoardSummary `resolve:""`
+	Monitor              outboxuc.Monitor           `resolve:""`
+	Pool                 *workerpool.Pool           `resolve:""`
 	workerExecutionChan  chan struct{}
 }
 
@@ -85,16 +91,32 @@ func (s BoardSummaryGenerator) flush(ctx context.Context, batch []*pubsub.Messag
 		s.workerExecutionChan <- struct{}{}
 	}
 
-	// Generate board-level summary once per batch
-	if err := s.GenerateBoardSummary.Execute(ctx); err != nil {
-		if !errors.Is(err, context.Canceled) {
-			s.Logger.Printf("BoardSummaryGenerator: %v", err)
-		}
-		return
+	// Generate the board-level summary once per tenant in the batch
+	tenants := make(map[tenant.ID][]*pubsub.Message)
+	for _, msg := range batch {
+		tenantID := messageTenantID(msg)
+		tenants[tenantID] = append(tenants[tenantID], msg)
 	}
 
-	// Ack messages only after successful enqueue/processing
-	for _, msg := range batch {
-		msg.Ack()
+	for tenantID, messages := range tenants {
+		err := runJob(tenant.WithID(ctx, tenantID), s.Pool, boardSummaryJobType, func(jobCtx context.Context) {
+			err := s.GenerateBoardSummary.Execute(jobCtx)
+			recordConsumption(jobCtx, s.Logger, s.Monitor, s.SubscriptionID, outbox.Topic_Todo, messages, err)
+			if err != nil {
+				if !errors.Is(err, context.Canceled) {
+					s.Logger.Printf("BoardSummaryGenerator: tenant_id=%s: %v", tenantID, err)
+				}
+				return
+			}
+
+			// Ack messages only after successful enqueue/processing
+			for _, msg := range messages {
+				msg.Ack()
+			}
+		})
+		if err != nil {
+			s.Logger.Printf("BoardSummaryGenerator: tenant_id=%s: %v", tenantID, err)
+			nackAll(messages)
+		}
 	}
 }
@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -52,6 +54,11 @@ func TestBoardSummaryGenerator_Run(t *testing.T) {
 				tt.setExpectations(gbs)
 			}
 
+			monitor := outboxuc.NewMockMonitor(t)
+			monitor.EXPECT().RecordConsumption(mock.Anything, "test-subscription-"+name, outbox.Topic_Todo, mock.Anything, nil).
+				Return(nil).
+				Times(tt.expectedBatches)
+
 			signalChan := make(chan struct{})
 			cancel, doneChan := run(t, ctx, BoardSummaryGenerator{
 				Logger:               log.Default(),
@@ -60,6 +67,7 @@ func TestBoardSummaryGenerator_Run(t *testing.T) {
 				BatchSize:            tt.batchSize,
 				SubscriptionID:       "test-subscription-" + name,
 				GenerateBoardSummary: gbs,
+				Monitor:              monitor,
 				workerExecutionChan:  signalChan,
 			})
 
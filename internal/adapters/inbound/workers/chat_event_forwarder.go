@@ -0,0 +1,94 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+)
+
+// ChatEventForwarder consumes the chat message events of background work from a per-replica Pub/Sub subscription
+// and forwards them into the in-memory chat message event stream used by the unread badges endpoint.
+type ChatEventForwarder struct {
+	Logger              *log.Logger                   `resolve:""`
+	Client              *pubsub.Client                `resolve:""`
+	Stream              outbox.ChatMessageEventStream `resolve:""`
+	SubscriptionPrefix  string                        `config:"CHAT_STREAM_EVENTS_SUBSCRIPTION_PREFIX" default:"chat_event_forwarder"`
+	ProjectID           string                        `config:"PUBSUB_PROJECT_ID"`
+	TenantFilter        string                        `config:"PUBSUB_TENANT_FILTER" default:""`
+	ServerID            string
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the chat event forwarder worker.
+func (w ChatEventForwarder) Run(ctx context.Context) error {
+	effectiveSubscriptionID := resolveReplicaSubscriptionID(w.SubscriptionPrefix, w.ServerID)
+	if strings.TrimSpace(effectiveSubscriptionID) == "" {
+		return errors.New("CHAT_STREAM_EVENTS_SUBSCRIPTION_PREFIX is required")
+	}
+	filter, err := tenantSubscriptionFilter(w.TenantFilter)
+	if err != nil {
+		return err
+	}
+	if err := ensureSubscription(ctx, w.Client, w.ProjectID, string(outbox.Topic_ChatMessages), effectiveSubscriptionID, filter); err != nil {
+		return err
+	}
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := deleteSubscription(cleanupCtx, w.Client, w.ProjectID, effectiveSubscriptionID); err != nil {
+			w.Logger.Printf(
+				"ChatEventForwarder: failed to delete subscription_id=%s: %v",
+				effectiveSubscriptionID,
+				err,
+			)
+		}
+	}()
+
+	w.Logger.Printf("ChatEventForwarder: running (subscription_id=%s)...", effectiveSubscriptionID)
+
+	subscriberErrCh := make(chan error, 1)
+
+	go func() {
+		err := w.Client.Subscriber(effectiveSubscriptionID).Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+			payload, err := messagePayload(msg)
+			var event outbox.ChatMessageEvent
+			if err == nil {
+				err = json.Unmarshal(payload, &event)
+			}
+			switch {
+			case err != nil:
+				w.Logger.Printf("ChatEventForwarder: invalid payload: %v", err)
+			case !event.Background || event.Reprocessed:
+				// Only new messages of background work raise badges; turn replies reach the user through the chat stream.
+			default:
+				if event.TenantID == "" {
+					event.TenantID = messageTenantID(msg)
+				}
+				w.Stream.Broadcast(event)
+			}
+			msg.Ack()
+
+			if w.workerExecutionChan != nil {
+				w.workerExecutionChan <- struct{}{}
+			}
+		})
+		if err != nil {
+			subscriberErrCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		w.Logger.Println("ChatEventForwarder: stopped")
+		return nil
+	case err := <-subscriberErrCh:
+		return err
+	}
+}
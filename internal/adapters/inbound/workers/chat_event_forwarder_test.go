@@ -0,0 +1,107 @@
+package workers
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChatEventForwarder_Run(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.ChatMessageEvent{
+		Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+		ChatRole:       assistant.ChatRole_Assistant,
+		ChatMessageID:  uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		ConversationID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+		CreatedAt:      time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+		Background:     true,
+	}
+	// Messages without a tenant attribute belong to the default tenant.
+	broadcastEvent := event
+	broadcastEvent.TenantID = tenant.Default
+
+	turnEvent := event
+	turnEvent.Background = false
+	reprocessedEvent := event
+	reprocessedEvent.Reprocessed = true
+
+	tests := map[string]struct {
+		payload         []byte
+		expectBroadcast bool
+	}{
+		"forwards-background-event": {
+			payload:         chatEventPayload(t, event),
+			expectBroadcast: true,
+		},
+		"skips-turn-event": {
+			payload: chatEventPayload(t, turnEvent),
+		},
+		"skips-reprocessed-event": {
+			payload: chatEventPayload(t, reprocessedEvent),
+		},
+		"invalid-payload": {
+			payload: []byte(`{"invalid"`),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			subscriptionID := "chat-stream-sub-" + name
+			client, topicName := setupPubSubServer(t, ctx, string(outbox.Topic_ChatMessages), subscriptionID)
+			stream := outbox.NewMockChatMessageEventStream(t)
+			if tc.expectBroadcast {
+				stream.EXPECT().Broadcast(broadcastEvent).Once()
+			}
+
+			signalChan := make(chan struct{}, 10)
+			worker := ChatEventForwarder{
+				Logger:              log.Default(),
+				Client:              client,
+				Stream:              stream,
+				SubscriptionPrefix:  subscriptionID,
+				ProjectID:           testPubSubProjectID,
+				ServerID:            "server_" + name,
+				workerExecutionChan: signalChan,
+			}
+			effectiveSubscriptionID := resolveReplicaSubscriptionID(worker.SubscriptionPrefix, worker.ServerID)
+
+			cancel, doneChan := run(t, ctx, worker)
+
+			// Wait for the replica subscription before publishing so the message is delivered to it.
+			assert.Eventually(t, func() bool {
+				_, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{
+					Subscription: "projects/" + testPubSubProjectID + "/subscriptions/" + effectiveSubscriptionID,
+				})
+				return err == nil
+			}, time.Second, 10*time.Millisecond)
+
+			err := publishMessages(ctx, client, topicName, [][]byte{tc.payload})
+			assert.NoError(t, err)
+
+			waitForBatchSignals(t, signalChan, 1, 500*time.Millisecond)
+
+			cancel()
+			waitRunnableStop(t, doneChan)
+
+			_, err = client.SubscriptionAdminClient.GetSubscription(
+				ctx,
+				&pubsubpb.GetSubscriptionRequest{
+					Subscription: "projects/" + testPubSubProjectID + "/subscriptions/" + effectiveSubscriptionID,
+				},
+			)
+			assert.Error(t, err)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+)
+
+// ChatMessagePartitionMaintainer is a runnable that creates the upcoming monthly chat message partitions
+// and drops the expired ones, once at startup and then periodically.
+type ChatMessagePartitionMaintainer struct {
+	MaintainPartitions  chat.MaintainChatMessagePartitions `resolve:""`
+	Logger              *log.Logger                        `resolve:""`
+	Interval            time.Duration                      `config:"CHAT_MESSAGES_PARTITION_INTERVAL" default:"1h"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the chat message partition maintainer worker.
+func (m ChatMessagePartitionMaintainer) Run(ctx context.Context) error {
+	m.Logger.Println("ChatMessagePartitionMaintainer: running...")
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+
+	m.maintain(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			m.maintain(ctx)
+		case <-ctx.Done():
+			m.Logger.Println("ChatMessagePartitionMaintainer: stopped")
+			return nil
+		}
+	}
+}
+
+// maintain runs one maintenance pass and logs its outcome.
+func (m ChatMessagePartitionMaintainer) maintain(ctx context.Context) {
+	dropped, err := m.MaintainPartitions.Execute(ctx)
+	if err != nil {
+		m.Logger.Printf("ChatMessagePartitionMaintainer: error maintaining partitions: %v", err)
+	} else if dropped > 0 {
+		m.Logger.Printf("ChatMessagePartitionMaintainer: dropped %d expired partitions", dropped)
+	}
+	if m.workerExecutionChan != nil {
+		m.workerExecutionChan <- struct{}{}
+	}
+}
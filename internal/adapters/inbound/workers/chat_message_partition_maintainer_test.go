@@ -0,0 +1,37 @@
+package workers
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChatMessagePartitionMaintainer_Run(t *testing.T) {
+	t.Parallel()
+
+	maintainPartitions := chat.NewMockMaintainChatMessagePartitions(t)
+
+	maintainPartitions.EXPECT().Execute(mock.Anything).Return(0, assert.AnError).Once()
+	maintainPartitions.EXPECT().Execute(mock.Anything).Return(2, nil).Once()
+	maintainPartitions.EXPECT().Execute(mock.Anything).Return(0, nil).Maybe()
+
+	// Buffered, so passes after the awaited ones never block the shutdown.
+	signalChan := make(chan struct{}, 10)
+
+	cancel, doneChan := run(t, t.Context(), ChatMessagePartitionMaintainer{
+		MaintainPartitions:  maintainPartitions,
+		Logger:              log.Default(),
+		Interval:            2 * time.Millisecond,
+		workerExecutionChan: signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
@@ -0,0 +1,74 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+)
+
+// ConversationReadModelProjector is a runnable that consumes chat-message events and refreshes the
+// conversation read model served by the conversation list.
+type ConversationReadModelProjector struct {
+	Logger              *log.Logger                       `resolve:""`
+	Client              *pubsub.Client                    `resolve:""`
+	Project             chat.ProjectConversationReadModel `resolve:""`
+	Monitor             outboxuc.Monitor                  `resolve:""`
+	ProjectID           string                            `config:"PUBSUB_PROJECT_ID"`
+	SubscriptionID      string                            `config:"CONVERSATION_READ_MODEL_SUBSCRIPTION_ID" default:"conversation_read_model_projector"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the conversation read model projector worker.
+func (p ConversationReadModelProjector) Run(ctx context.Context) error {
+	if err := ensureSubscription(ctx, p.Client, p.ProjectID, string(outbox.Topic_ChatMessages), p.SubscriptionID, ""); err != nil {
+		return err
+	}
+
+	p.Logger.Printf("ConversationReadModelProjector: running (subscription_id=%s)...", p.SubscriptionID)
+
+	err := p.Client.Subscriber(p.SubscriptionID).Receive(ctx, func(msgCtx context.Context, msg *pubsub.Message) {
+		p.project(msgCtx, msg)
+		if p.workerExecutionChan != nil {
+			p.workerExecutionChan <- struct{}{}
+		}
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	p.Logger.Println("ConversationReadModelProjector: stopped")
+	return nil
+}
+
+// project refreshes the projection of the conversation the message belongs to.
+// A failed refresh is redelivered, while undecodable payloads are dropped since no redelivery can fix them.
+func (p ConversationReadModelProjector) project(ctx context.Context, msg *pubsub.Message) {
+	payload, err := messagePayload(msg)
+	var event outbox.ChatMessageEvent
+	if err == nil {
+		err = json.Unmarshal(payload, &event)
+	}
+	if err != nil {
+		p.Logger.Printf("ConversationReadModelProjector: failed to decode event payload: %v", err)
+		msg.Ack()
+		return
+	}
+
+	err = p.Project.Execute(tenant.WithID(ctx, messageTenantID(msg)), event)
+	recordConsumption(ctx, p.Logger, p.Monitor, p.SubscriptionID, outbox.Topic_ChatMessages, []*pubsub.Message{msg}, err)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			p.Logger.Printf("ConversationReadModelProjector: %v", err)
+		}
+		msg.Nack()
+		return
+	}
+	msg.Ack()
+}
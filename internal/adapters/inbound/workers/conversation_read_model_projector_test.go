@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConversationReadModelProjector_Run(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.ChatMessageEvent{
+		Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+		ChatRole:       assistant.ChatRole_User,
+		ChatMessageID:  uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		ConversationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+	}
+
+	tests := map[string]struct {
+		payload    []byte
+		setupMocks func(*chat.MockProjectConversationReadModel, *outboxuc.MockMonitor, string)
+	}{
+		"projects-event": {
+			payload: chatEventPayload(t, event),
+			setupMocks: func(project *chat.MockProjectConversationReadModel, monitor *outboxuc.MockMonitor, subscriptionID string) {
+				project.EXPECT().Execute(mock.Anything, event).Return(nil).Once()
+				monitor.EXPECT().RecordConsumption(mock.Anything, subscriptionID, outbox.Topic_ChatMessages, mock.Anything, nil).
+					Return(nil).Once()
+			},
+		},
+		"redelivers-failed-projection": {
+			payload: chatEventPayload(t, event),
+			setupMocks: func(project *chat.MockProjectConversationReadModel, monitor *outboxuc.MockMonitor, subscriptionID string) {
+				project.EXPECT().Execute(mock.Anything, event).Return(errors.New("db error"))
+				monitor.EXPECT().RecordConsumption(mock.Anything, subscriptionID, outbox.Topic_ChatMessages, mock.Anything, errors.New("db error")).
+					Return(nil)
+			},
+		},
+		"drops-invalid-payload": {
+			payload:    []byte(`{"type"`),
+			setupMocks: func(*chat.MockProjectConversationReadModel, *outboxuc.MockMonitor, string) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			subscriptionID := "conversation-read-model-sub-" + name
+			client, topicName := setupPubSubServer(t, ctx, "conversation-read-model-topic-"+name, subscriptionID)
+
+			project := chat.NewMockProjectConversationReadModel(t)
+			monitor := outboxuc.NewMockMonitor(t)
+			tt.setupMocks(project, monitor, subscriptionID)
+
+			signalChan := make(chan struct{}, 10)
+			cancel, doneChan := run(t, ctx, ConversationReadModelProjector{
+				Logger:              log.Default(),
+				Client:              client,
+				Project:             project,
+				Monitor:             monitor,
+				ProjectID:           testPubSubProjectID,
+				SubscriptionID:      subscriptionID,
+				workerExecutionChan: signalChan,
+			})
+
+			err := publishMessages(ctx, client, topicName, [][]byte{tt.payload})
+			assert.NoError(t, err)
+
+			waitForBatchSignals(t, signalChan, 1, 1*time.Second)
+			cancel()
+			waitRunnableStop(t, doneChan)
+		})
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/workerpool"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
 	"github.com/google/uuid"
 )
 
@@ -19,9 +22,11 @@ type ConversationTitleGenerator struct {
 	Logger                    *log.Logger                    `resolve:""`
 	Client                    *pubsub.Client                 `resolve:""`
 	GenerateConversationTitle chat.GenerateConversationTitle `resolve:""`
+	Monitor                   outboxuc.Monitor               `resolve:""`
 	Interval                  time.Duration                  `config:"CHAT_TITLE_BATCH_INTERVAL" default:"5s"`
 	BatchSize                 int                            `config:"CHAT_TITLE_BATCH_SIZE" default:"50"`
 	SubscriptionID            string                         `config:"CHAT_TITLE_EVENTS_SUBSCRIPTION_ID"`
+	Pool                      *workerpool.Pool               `resolve:""`
 	workerExecutionChan       chan struct{}
 }
 
@@ -94,10 +99,15 @@ func (s ConversationTitleGenerator) flush(ctx context.Context, batch []*pubsub.M
 		s.workerExecutionChan <- struct{}{}
 	}
 
-	conversations := make(map[uuid.UUID]conversationTitleGeneratorBatch)
+	conversations := make(map[conversationTitleGeneratorKey]conversationTitleGeneratorBatch)
+	var ignored []*pubsub.Message
 	for _, msg := range batch {
+		payload, err := messagePayload(msg)
 		var event outbox.ChatMessageEvent
-		if err := json.Unmarshal(msg.Data, &event); err != nil {
+		if err == nil {
+			err = json.Unmarshal(payload, &event)
+		}
+		if err != nil {
 			s.Logger.Printf("ConversationTitleGenerator: failed to decode event payload: %v", err)
 			msg.Nack()
 			continue
@@ -105,42 +115,61 @@ func (s ConversationTitleGenerator) flush(ctx context.Context, batch []*pubsub.M
 
 		if event.Type != outbox.EventType_CHAT_MESSAGE_SENT {
 			msg.Ack()
+			ignored = append(ignored, msg)
 			continue
 		}
 		// Title generation should only be triggered by assistant messages.
 		// User messages are acked and ignored by this worker.
 		if event.ChatRole != assistant.ChatRole_Assistant {
 			msg.Ack()
+			ignored = append(ignored, msg)
 			continue
 		}
 
-		conversationBatch, found := conversations[event.ConversationID]
+		key := conversationTitleGeneratorKey{TenantID: messageTenantID(msg), ConversationID: event.ConversationID}
+		conversationBatch, found := conversations[key]
 		if !found {
 			conversationBatch = conversationTitleGeneratorBatch{}
 		}
 		conversationBatch.LatestEvent = event
 		conversationBatch.Messages = append(conversationBatch.Messages, msg)
-		conversations[event.ConversationID] = conversationBatch
+		conversations[key] = conversationBatch
 	}
 
-	for _, conversationBatch := range conversations {
-		err := s.GenerateConversationTitle.Execute(ctx, conversationBatch.LatestEvent)
-		if err != nil {
-			for _, message := range conversationBatch.Messages {
-				message.Nack()
-			}
-			if !errors.Is(err, context.Canceled) {
-				s.Logger.Printf("ConversationTitleGenerator: %v", err)
+	// Ignored messages were handled too, so they count towards the progress of the subscription.
+	if len(ignored) > 0 {
+		recordConsumption(ctx, s.Logger, s.Monitor, s.SubscriptionID, outbox.Topic_ChatMessages, ignored, nil)
+	}
+
+	for key, conversationBatch := range conversations {
+		err := runJob(tenant.WithID(ctx, key.TenantID), s.Pool, conversationTitleJobType, func(jobCtx context.Context) {
+			err := s.GenerateConversationTitle.Execute(jobCtx, conversationBatch.LatestEvent)
+			recordConsumption(jobCtx, s.Logger, s.Monitor, s.SubscriptionID, outbox.Topic_ChatMessages, conversationBatch.Messages, err)
+			if err != nil {
+				nackAll(conversationBatch.Messages)
+				if !errors.Is(err, context.Canceled) {
+					s.Logger.Printf("ConversationTitleGenerator: %v", err)
+				}
+				return
 			}
-			continue
-		}
 
-		for _, message := range conversationBatch.Messages {
-			message.Ack()
+			for _, message := range conversationBatch.Messages {
+				message.Ack()
+			}
+		})
+		if err != nil {
+			s.Logger.Printf("ConversationTitleGenerator: %v", err)
+			nackAll(conversationBatch.Messages)
 		}
 	}
 }
 
+// conversationTitleGeneratorKey identifies a conversation within its tenant.
+type conversationTitleGeneratorKey struct {
+	TenantID       tenant.ID
+	ConversationID uuid.UUID
+}
+
 // conversationTitleGeneratorBatch represents a batch of chat message events for a single conversation,
 // along with the latest event for that conversation.
 type conversationTitleGeneratorBatch struct {
@@ -9,6 +9,7 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -173,6 +174,11 @@ func TestConversationTitleGenerator_Run(t *testing.T) {
 					Once()
 			}
 
+			monitor := outboxuc.NewMockMonitor(t)
+			monitor.EXPECT().RecordConsumption(mock.Anything, subscriptionID, outbox.Topic_ChatMessages, mock.Anything, nil).
+				Return(nil).
+				Maybe()
+
 			signalChan := make(chan struct{}, 10)
 			cancel, doneChan := run(t, ctx, ConversationTitleGenerator{
 				Logger:                    log.Default(),
@@ -181,6 +187,7 @@ func TestConversationTitleGenerator_Run(t *testing.T) {
 				BatchSize:                 len(tt.payloads),
 				SubscriptionID:            subscriptionID,
 				GenerateConversationTitle: gct,
+				Monitor:                   monitor,
 				workerExecutionChan:       signalChan,
 			})
 
@@ -0,0 +1,98 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expr   string
+		errMsg string
+	}{
+		"weekly":            {expr: "0 9 * * 1"},
+		"lists-and-steps":   {expr: "*/15 8-18/2 1,15 1-6 1-5"},
+		"sunday-as-seven":   {expr: "30 18 * * 7"},
+		"too-few-fields":    {expr: "0 9 * *", errMsg: `cron expression "0 9 * *" must have 5 fields`},
+		"minute-too-large":  {expr: "60 9 * * 1", errMsg: `invalid minute field: "60" is out of range 0-59`},
+		"invalid-value":     {expr: "0 nine * * 1", errMsg: `invalid hour field: invalid value "nine"`},
+		"invalid-step":      {expr: "*/0 9 * * 1", errMsg: `invalid minute field: invalid step "0"`},
+		"reversed-range":    {expr: "0 9 * * 5-1", errMsg: `invalid day of week field: "5-1" is out of range 0-7`},
+		"day-of-month-zero": {expr: "0 9 0 * *", errMsg: `invalid day of month field: "0" is out of range 1-31`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseCronSchedule(tt.expr)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCronSchedule_Next(t *testing.T) {
+	t.Parallel()
+
+	// 2026-03-04 is a Wednesday.
+	from := time.Date(2026, 3, 4, 10, 17, 30, 0, time.UTC)
+
+	tests := map[string]struct {
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		"next-monday": {
+			expr:     "0 9 * * 1",
+			from:     from,
+			expected: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+		},
+		"same-minute-is-skipped": {
+			expr:     "0 9 * * 1",
+			from:     time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC),
+		},
+		"every-quarter-hour": {
+			expr:     "*/15 * * * *",
+			from:     from,
+			expected: time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC),
+		},
+		"sunday-as-seven": {
+			expr:     "30 18 * * 7",
+			from:     from,
+			expected: time.Date(2026, 3, 8, 18, 30, 0, 0, time.UTC),
+		},
+		"next-month": {
+			expr:     "0 0 1 * *",
+			from:     from,
+			expected: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"day-of-month-or-day-of-week": {
+			expr:     "0 12 20 * 5",
+			from:     from,
+			expected: time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			expr: "0 0 30 2 *",
+			from: from,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := parseCronSchedule(tt.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, s.next(tt.from))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// EmbeddingBackfiller is a one-shot runnable that re-embeds the todos of one tenant whose embedding is
+// missing or stale, one batch every Interval, and returns once the backfill completes. An interrupted
+// backfill resumes from its last checkpoint on the next run. Slot defaults to the primary embedding.
+type EmbeddingBackfiller struct {
+	Backfill  todo.BackfillEmbeddings `resolve:""`
+	Logger    *log.Logger             `resolve:""`
+	TenantID  tenant.ID
+	Slot      domain.EmbeddingSlot
+	BatchSize int
+	Interval  time.Duration
+}
+
+// Run embeds batches of stale todos until none is left, logging the progress after each batch.
+func (b EmbeddingBackfiller) Run(ctx context.Context) error {
+	tenantID := b.TenantID
+	if tenantID == "" {
+		tenantID = tenant.Default
+	}
+	if err := tenantID.Validate(); err != nil {
+		return err
+	}
+	tenantCtx := tenant.WithID(ctx, tenantID)
+	slot := b.Slot
+	if slot == "" {
+		slot = domain.EmbeddingSlot_Primary
+	}
+
+	for {
+		backfill, err := b.Backfill.Step(tenantCtx, slot, b.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to backfill embeddings after %d todos: %w", backfill.Processed, err)
+		}
+		b.Logger.Printf("EmbeddingBackfiller: tenant_id=%s slot=%s model=%s: %d/%d todos embedded", tenantID, slot, backfill.Model, backfill.Processed, backfill.Total)
+		if backfill.Completed() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(b.Interval):
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEmbeddingBackfiller_Run(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	inProgress := domain.EmbeddingBackfill{Model: "model-name", Total: 3, Processed: 2}
+	completed := domain.EmbeddingBackfill{Model: "model-name", Total: 3, Processed: 3, CompletedAt: &completedAt}
+
+	tests := map[string]struct {
+		tenantID        tenant.ID
+		slot            domain.EmbeddingSlot
+		setExpectations func(backfill *todo.MockBackfillEmbeddings)
+		expectErr       bool
+	}{
+		"runs-until-completed": {
+			setExpectations: func(backfill *todo.MockBackfillEmbeddings) {
+				backfill.EXPECT().Step(
+					mock.MatchedBy(func(ctx context.Context) bool { return tenant.IDFromContext(ctx) == tenant.Default }),
+					domain.EmbeddingSlot_Primary,
+					2,
+				).Return(inProgress, nil).Once()
+				backfill.EXPECT().Step(mock.Anything, domain.EmbeddingSlot_Primary, 2).Return(completed, nil).Once()
+			},
+		},
+		"requested-tenant": {
+			tenantID: "acme",
+			setExpectations: func(backfill *todo.MockBackfillEmbeddings) {
+				backfill.EXPECT().Step(
+					mock.MatchedBy(func(ctx context.Context) bool { return tenant.IDFromContext(ctx) == "acme" }),
+					domain.EmbeddingSlot_Primary,
+					2,
+				).Return(completed, nil).Once()
+			},
+		},
+		"secondary-slot": {
+			slot: domain.EmbeddingSlot_Secondary,
+			setExpectations: func(backfill *todo.MockBackfillEmbeddings) {
+				backfill.EXPECT().Step(mock.Anything, domain.EmbeddingSlot_Secondary, 2).Return(completed, nil).Once()
+			},
+		},
+		"invalid-tenant": {
+			tenantID:  "Acme Corp",
+			expectErr: true,
+		},
+		"step-error": {
+			setExpectations: func(backfill *todo.MockBackfillEmbeddings) {
+				backfill.EXPECT().Step(mock.Anything, domain.EmbeddingSlot_Primary, 2).Return(inProgress, assert.AnError).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			backfill := todo.NewMockBackfillEmbeddings(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(backfill)
+			}
+
+			err := EmbeddingBackfiller{
+				Backfill:  backfill,
+				Logger:    log.New(io.Discard, "", 0),
+				TenantID:  tt.tenantID,
+				Slot:      tt.slot,
+				BatchSize: 2,
+				Interval:  time.Millisecond,
+			}.Run(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
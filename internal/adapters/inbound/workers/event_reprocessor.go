@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+)
+
+// EventReprocessor is a one-shot runnable that re-emits synthetic events for historical records of
+// one tenant and returns, stopping the app once the events are recorded in the outbox.
+type EventReprocessor struct {
+	Reprocess outbox.ReprocessEvents `resolve:""`
+	Logger    *log.Logger            `resolve:""`
+	TenantID  tenant.ID
+	Request   outbox.ReprocessRequest
+}
+
+// Run executes the reprocess request.
+func (r EventReprocessor) Run(ctx context.Context) error {
+	tenantID := r.TenantID
+	if tenantID == "" {
+		tenantID = tenant.Default
+	}
+	if err := tenantID.Validate(); err != nil {
+		return err
+	}
+
+	emitted, err := r.Reprocess.Execute(tenant.WithID(ctx, tenantID), r.Request)
+	if err != nil {
+		return fmt.Errorf("failed to reprocess %s: %w", r.Request.Target, err)
+	}
+
+	if r.Request.DryRun {
+		r.Logger.Printf("EventReprocessor: tenant_id=%s target=%s: %d events would be emitted (dry run)", tenantID, r.Request.Target, emitted)
+		return nil
+	}
+	r.Logger.Printf("EventReprocessor: tenant_id=%s target=%s: %d events emitted", tenantID, r.Request.Target, emitted)
+	return nil
+}
@@ -0,0 +1,76 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEventReprocessor_Run(t *testing.T) {
+	t.Parallel()
+
+	req := outbox.ReprocessRequest{Target: outbox.ReprocessTarget_BoardSummaries}
+
+	tests := map[string]struct {
+		tenantID        tenant.ID
+		setExpectations func(reprocess *outbox.MockReprocessEvents)
+		expectErr       bool
+	}{
+		"default-tenant": {
+			setExpectations: func(reprocess *outbox.MockReprocessEvents) {
+				reprocess.EXPECT().Execute(
+					mock.MatchedBy(func(ctx context.Context) bool { return tenant.IDFromContext(ctx) == tenant.Default }),
+					req,
+				).Return(1, nil).Once()
+			},
+		},
+		"requested-tenant": {
+			tenantID: "acme",
+			setExpectations: func(reprocess *outbox.MockReprocessEvents) {
+				reprocess.EXPECT().Execute(
+					mock.MatchedBy(func(ctx context.Context) bool { return tenant.IDFromContext(ctx) == "acme" }),
+					req,
+				).Return(3, nil).Once()
+			},
+		},
+		"invalid-tenant": {
+			tenantID:  "Acme Corp",
+			expectErr: true,
+		},
+		"reprocess-error": {
+			setExpectations: func(reprocess *outbox.MockReprocessEvents) {
+				reprocess.EXPECT().Execute(mock.Anything, req).Return(0, assert.AnError).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reprocess := outbox.NewMockReprocessEvents(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(reprocess)
+			}
+
+			err := EventReprocessor{
+				Reprocess: reprocess,
+				Logger:    log.New(io.Discard, "", 0),
+				TenantID:  tt.tenantID,
+				Request:   req,
+			}.Run(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package workers
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/workerpool"
+)
+
+// Job types of the worker pool, capped through WORKER_POOL_TYPE_LIMITS.
+const (
+	boardSummaryJobType      = "board_summary"
+	conversationTitleJobType = "conversation_title"
+)
+
+// runJob runs job on the shared worker pool, or inline when the worker has no pool.
+// The error reports a job the pool refused because it is draining.
+func runJob(ctx context.Context, pool *workerpool.Pool, jobType string, job workerpool.Job) error {
+	if pool == nil {
+		job(ctx)
+		return nil
+	}
+	return pool.Submit(ctx, jobType, job)
+}
+
+// nackAll asks the bus to redeliver every message.
+func nackAll(messages []*pubsub.Message) {
+	for _, msg := range messages {
+		msg.Nack()
+	}
+}
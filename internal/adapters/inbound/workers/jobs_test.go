@@ -0,0 +1,61 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/workerpool"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunJob(t *testing.T) {
+	tests := map[string]struct {
+		pool    func() *workerpool.Pool
+		wantRun bool
+		wantErr error
+	}{
+		"inline-without-pool": {
+			pool:    func() *workerpool.Pool { return nil },
+			wantRun: true,
+		},
+		"on-pool": {
+			pool: func() *workerpool.Pool {
+				return workerpool.NewPool(workerpool.Config{MinWorkers: 1, MaxWorkers: 1})
+			},
+			wantRun: true,
+		},
+		"closed-pool": {
+			pool: func() *workerpool.Pool {
+				pool := workerpool.NewPool(workerpool.Config{MinWorkers: 1, MaxWorkers: 1})
+				pool.Close()
+				return pool
+			},
+			wantErr: workerpool.ErrClosed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := tt.pool()
+			if pool != nil {
+				defer pool.Close()
+			}
+
+			ran := make(chan tenant.ID, 1)
+			err := runJob(tenant.WithID(t.Context(), "acme"), pool, boardSummaryJobType, func(ctx context.Context) {
+				ran <- tenant.IDFromContext(ctx)
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			select {
+			case tenantID := <-ran:
+				assert.True(t, tt.wantRun)
+				assert.Equal(t, tenant.ID("acme"), tenantID)
+			case <-time.After(100 * time.Millisecond):
+				assert.False(t, tt.wantRun)
+			}
+		})
+	}
+}
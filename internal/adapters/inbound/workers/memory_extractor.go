@@ -0,0 +1,58 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+)
+
+// MemoryExtractor is a runnable that periodically extracts long-term memories from the idle conversations
+// of each configured tenant.
+type MemoryExtractor struct {
+	ExtractMemories     chat.ExtractMemories `resolve:""`
+	Logger              *log.Logger          `resolve:""`
+	Interval            time.Duration        `config:"MEMORY_EXTRACTION_INTERVAL" default:"15m"`
+	Tenants             string               `config:"MEMORY_EXTRACTION_TENANTS" default:"default"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the memory extractor worker.
+func (e MemoryExtractor) Run(ctx context.Context) error {
+	if e.Interval <= 0 {
+		e.Logger.Print("MemoryExtractor: disabled (MEMORY_EXTRACTION_INTERVAL <= 0)")
+		return nil
+	}
+	tenants, err := parseTenantIDs(e.Tenants)
+	if err != nil {
+		return fmt.Errorf("MemoryExtractor: invalid MEMORY_EXTRACTION_TENANTS: %w", err)
+	}
+
+	e.Logger.Println("MemoryExtractor: running...")
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, tenantID := range tenants {
+				stored, err := e.ExtractMemories.Execute(tenant.WithID(ctx, tenantID))
+				if err != nil {
+					e.Logger.Printf("MemoryExtractor: tenant_id=%s: %v", tenantID, err)
+				}
+				if stored > 0 {
+					e.Logger.Printf("MemoryExtractor: tenant_id=%s: stored %d memories", tenantID, stored)
+				}
+			}
+			if e.workerExecutionChan != nil {
+				e.workerExecutionChan <- struct{}{}
+			}
+		case <-ctx.Done():
+			e.Logger.Println("MemoryExtractor: stopped")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMemoryExtractor_Run(t *testing.T) {
+	t.Parallel()
+
+	extract := chat.NewMockExtractMemories(t)
+	extract.EXPECT().Execute(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "acme"
+	})).Return(1, assert.AnError).Once()
+	extract.EXPECT().Execute(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "globex"
+	})).Return(2, nil).Once()
+	extract.EXPECT().Execute(mock.Anything).Return(0, nil).Twice()
+
+	signalChan := make(chan struct{})
+
+	cancel, doneChan := run(t, t.Context(), MemoryExtractor{
+		ExtractMemories:     extract,
+		Logger:              log.New(io.Discard, "", 0),
+		Interval:            2 * time.Millisecond,
+		Tenants:             "acme, globex",
+		workerExecutionChan: signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
+
+func TestMemoryExtractor_Run_Config(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		interval time.Duration
+		tenants  string
+		errMsg   string
+	}{
+		"disabled": {},
+		"invalid-tenants": {
+			interval: time.Minute,
+			tenants:  "default,Not Valid",
+			errMsg:   "MemoryExtractor: invalid MEMORY_EXTRACTION_TENANTS: tenant id must be 1-40 lowercase letters, digits, '-' or '_'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			e := MemoryExtractor{
+				Logger:   log.New(io.Discard, "", 0),
+				Interval: tt.interval,
+				Tenants:  tt.tenants,
+			}
+			err := e.Run(t.Context())
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
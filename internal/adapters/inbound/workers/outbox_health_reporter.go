@@ -0,0 +1,39 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+)
+
+// OutboxHealthReporter is a runnable that periodically records the outbox backlog and consumer lag as metrics.
+type OutboxHealthReporter struct {
+	Monitor             outbox.Monitor `resolve:""`
+	Logger              *log.Logger    `resolve:""`
+	Interval            time.Duration  `config:"OUTBOX_HEALTH_INTERVAL" default:"30s"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the outbox health reporter worker.
+func (r OutboxHealthReporter) Run(ctx context.Context) error {
+	r.Logger.Println("OutboxHealthReporter: running...")
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := r.Monitor.Health(ctx); err != nil {
+				r.Logger.Printf("OutboxHealthReporter: error checking outbox health: %v", err)
+			}
+			if r.workerExecutionChan != nil {
+				r.workerExecutionChan <- struct{}{}
+			}
+		case <-ctx.Done():
+			r.Logger.Println("OutboxHealthReporter: stopped")
+			return nil
+		}
+	}
+}
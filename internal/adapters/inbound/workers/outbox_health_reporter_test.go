@@ -0,0 +1,36 @@
+package workers
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	domainoutbox "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutboxHealthReporter_Run(t *testing.T) {
+	t.Parallel()
+
+	monitor := outbox.NewMockMonitor(t)
+
+	monitor.EXPECT().Health(mock.Anything).Return(domainoutbox.Health{}, assert.AnError).Once()
+	monitor.EXPECT().Health(mock.Anything).Return(domainoutbox.Health{Pending: 2}, nil).Once()
+
+	signalChan := make(chan struct{})
+
+	cancel, doneChan := run(t, t.Context(), OutboxHealthReporter{
+		Monitor:             monitor,
+		Logger:              log.Default(),
+		Interval:            2 * time.Millisecond,
+		workerExecutionChan: signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
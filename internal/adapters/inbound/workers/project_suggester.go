@@ -0,0 +1,58 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// ProjectSuggester is a runnable that periodically clusters the ungrouped todos of each configured tenant
+// into project suggestions.
+type ProjectSuggester struct {
+	Projects            todo.Projects `resolve:""`
+	Logger              *log.Logger   `resolve:""`
+	Interval            time.Duration `config:"PROJECT_SUGGESTION_INTERVAL" default:"6h"`
+	Tenants             string        `config:"PROJECT_SUGGESTION_TENANTS" default:"default"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the project suggester worker.
+func (s ProjectSuggester) Run(ctx context.Context) error {
+	if s.Interval <= 0 {
+		s.Logger.Print("ProjectSuggester: disabled (PROJECT_SUGGESTION_INTERVAL <= 0)")
+		return nil
+	}
+	tenants, err := parseTenantIDs(s.Tenants)
+	if err != nil {
+		return fmt.Errorf("ProjectSuggester: invalid PROJECT_SUGGESTION_TENANTS: %w", err)
+	}
+
+	s.Logger.Println("ProjectSuggester: running...")
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, tenantID := range tenants {
+				suggested, err := s.Projects.Refresh(tenant.WithID(ctx, tenantID))
+				if err != nil {
+					s.Logger.Printf("ProjectSuggester: tenant_id=%s: %v", tenantID, err)
+				}
+				if suggested > 0 {
+					s.Logger.Printf("ProjectSuggester: tenant_id=%s: suggested %d projects", tenantID, suggested)
+				}
+			}
+			if s.workerExecutionChan != nil {
+				s.workerExecutionChan <- struct{}{}
+			}
+		case <-ctx.Done():
+			s.Logger.Println("ProjectSuggester: stopped")
+			return nil
+		}
+	}
+}
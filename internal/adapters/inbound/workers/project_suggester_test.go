@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProjectSuggester_Run(t *testing.T) {
+	t.Parallel()
+
+	projects := todo.NewMockProjects(t)
+	projects.EXPECT().Refresh(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "acme"
+	})).Return(0, assert.AnError).Once()
+	projects.EXPECT().Refresh(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "globex"
+	})).Return(2, nil).Once()
+	projects.EXPECT().Refresh(mock.Anything).Return(0, nil).Twice()
+
+	signalChan := make(chan struct{})
+
+	cancel, doneChan := run(t, t.Context(), ProjectSuggester{
+		Projects:            projects,
+		Logger:              log.New(io.Discard, "", 0),
+		Interval:            2 * time.Millisecond,
+		Tenants:             "acme, globex",
+		workerExecutionChan: signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
+
+func TestProjectSuggester_Run_Config(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		interval time.Duration
+		tenants  string
+		errMsg   string
+	}{
+		"disabled": {},
+		"invalid-tenants": {
+			interval: time.Minute,
+			tenants:  "default,Not Valid",
+			errMsg:   "ProjectSuggester: invalid PROJECT_SUGGESTION_TENANTS: tenant id must be 1-40 lowercase letters, digits, '-' or '_'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := ProjectSuggester{
+				Logger:   log.New(io.Discard, "", 0),
+				Interval: tt.interval,
+				Tenants:  tt.tenants,
+			}
+			err := s.Run(t.Context())
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
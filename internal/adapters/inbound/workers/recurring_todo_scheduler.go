@@ -0,0 +1,73 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// RecurringTodoScheduler is a runnable that consumes todo events and creates the next occurrence of the
+// recurring todos marked as done.
+type RecurringTodoScheduler struct {
+	Logger              *log.Logger                 `resolve:""`
+	Client              *pubsub.Client              `resolve:""`
+	Schedule            todo.ScheduleNextOccurrence `resolve:""`
+	Monitor             outboxuc.Monitor            `resolve:""`
+	ProjectID           string                      `config:"PUBSUB_PROJECT_ID"`
+	SubscriptionID      string                      `config:"RECURRING_TODO_SUBSCRIPTION_ID" default:"recurring_todo_scheduler"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the recurring todo scheduler worker.
+func (s RecurringTodoScheduler) Run(ctx context.Context) error {
+	if err := ensureSubscription(ctx, s.Client, s.ProjectID, string(outbox.Topic_Todo), s.SubscriptionID, ""); err != nil {
+		return err
+	}
+
+	s.Logger.Printf("RecurringTodoScheduler: running (subscription_id=%s)...", s.SubscriptionID)
+
+	err := s.Client.Subscriber(s.SubscriptionID).Receive(ctx, func(msgCtx context.Context, msg *pubsub.Message) {
+		s.schedule(msgCtx, msg)
+		if s.workerExecutionChan != nil {
+			s.workerExecutionChan <- struct{}{}
+		}
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	s.Logger.Println("RecurringTodoScheduler: stopped")
+	return nil
+}
+
+// schedule creates the next occurrence of the todo the event is about, when it is a completed recurring todo.
+// A failed attempt is redelivered, while undecodable payloads are dropped since no redelivery can fix them.
+func (s RecurringTodoScheduler) schedule(ctx context.Context, msg *pubsub.Message) {
+	payload, err := messagePayload(msg)
+	var event outbox.TodoEvent
+	if err == nil {
+		err = json.Unmarshal(payload, &event)
+	}
+	if err != nil {
+		s.Logger.Printf("RecurringTodoScheduler: failed to decode event payload: %v", err)
+		msg.Ack()
+		return
+	}
+
+	err = s.Schedule.Execute(messageTenantContext(ctx, msg), event)
+	recordConsumption(ctx, s.Logger, s.Monitor, s.SubscriptionID, outbox.Topic_Todo, []*pubsub.Message{msg}, err)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			s.Logger.Printf("RecurringTodoScheduler: %v", err)
+		}
+		msg.Nack()
+		return
+	}
+	msg.Ack()
+}
@@ -0,0 +1,81 @@
+package workers
+
+import (
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecurringTodoScheduler_Run(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.TodoEvent{
+		Type:     outbox.EventType_TODO_UPDATED,
+		TodoID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Sequence: 4,
+	}
+
+	tests := map[string]struct {
+		payload    []byte
+		setupMocks func(*todo.MockScheduleNextOccurrence, *outboxuc.MockMonitor, string)
+	}{
+		"schedules-event": {
+			payload: todoEventPayload(t, event),
+			setupMocks: func(schedule *todo.MockScheduleNextOccurrence, monitor *outboxuc.MockMonitor, subscriptionID string) {
+				schedule.EXPECT().Execute(mock.Anything, event).Return(nil).Once()
+				monitor.EXPECT().RecordConsumption(mock.Anything, subscriptionID, outbox.Topic_Todo, mock.Anything, nil).
+					Return(nil).Once()
+			},
+		},
+		"redelivers-failed-schedule": {
+			payload: todoEventPayload(t, event),
+			setupMocks: func(schedule *todo.MockScheduleNextOccurrence, monitor *outboxuc.MockMonitor, subscriptionID string) {
+				schedule.EXPECT().Execute(mock.Anything, event).Return(errors.New("db error"))
+				monitor.EXPECT().RecordConsumption(mock.Anything, subscriptionID, outbox.Topic_Todo, mock.Anything, errors.New("db error")).
+					Return(nil)
+			},
+		},
+		"drops-invalid-payload": {
+			payload:    []byte(`{"type"`),
+			setupMocks: func(*todo.MockScheduleNextOccurrence, *outboxuc.MockMonitor, string) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			subscriptionID := "recurring-todo-sub-" + name
+			client, topicName := setupPubSubServer(t, ctx, "recurring-todo-topic-"+name, subscriptionID)
+
+			schedule := todo.NewMockScheduleNextOccurrence(t)
+			monitor := outboxuc.NewMockMonitor(t)
+			tt.setupMocks(schedule, monitor, subscriptionID)
+
+			signalChan := make(chan struct{}, 10)
+			cancel, doneChan := run(t, ctx, RecurringTodoScheduler{
+				Logger:              log.Default(),
+				Client:              client,
+				Schedule:            schedule,
+				Monitor:             monitor,
+				ProjectID:           testPubSubProjectID,
+				SubscriptionID:      subscriptionID,
+				workerExecutionChan: signalChan,
+			})
+
+			err := publishMessages(ctx, client, topicName, [][]byte{tt.payload})
+			assert.NoError(t, err)
+
+			waitForBatchSignals(t, signalChan, 1, 1*time.Second)
+			cancel()
+			waitRunnableStop(t, doneChan)
+		})
+	}
+}
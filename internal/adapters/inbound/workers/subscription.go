@@ -0,0 +1,188 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode"
+
+	"cloud.google.com/go/pubsub/v2"
+	"cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	outboxuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveReplicaSubscriptionID builds a per-replica subscription ID from the prefix and server ID,
+// generating a random server ID when none is configured.
+func resolveReplicaSubscriptionID(prefix, serverID string) string {
+	base := strings.TrimSpace(prefix)
+	if base == "" {
+		return ""
+	}
+
+	serverID = strings.TrimSpace(serverID)
+	if serverID == "" {
+		serverID = uuid.NewString()
+	}
+	serverID = sanitizeSubscriptionPart(serverID)
+	if serverID == "" {
+		return base
+	}
+	return base + "-" + serverID
+}
+
+// sanitizeSubscriptionPart cleans a string to be safely used as part of a Pub/Sub subscription ID,
+// ensuring it meets character and length requirements.
+func sanitizeSubscriptionPart(part string) string {
+	trimmed := strings.TrimSpace(strings.ToLower(part))
+	if trimmed == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	prevDash := false
+	for _, r := range trimmed {
+		valid := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+		if valid {
+			b.WriteRune(r)
+			prevDash = false
+			continue
+		}
+		if !prevDash {
+			b.WriteByte('-')
+			prevDash = true
+		}
+	}
+
+	result := strings.Trim(b.String(), "-")
+	const maxLen = 40
+	if len(result) > maxLen {
+		result = strings.Trim(result[:maxLen], "-")
+	}
+	return result
+}
+
+// tenantAttribute is the message attribute the publisher stamps with the tenant owning the event.
+const tenantAttribute = "tenant_id"
+
+// contentTypeAttribute is the message attribute describing the encoding of the message data.
+const contentTypeAttribute = "content-type"
+
+// messageTenantContext scopes ctx to the tenant that published msg. Messages published before
+// multi-tenant mode carry no tenant attribute and belong to the default tenant.
+func messageTenantContext(ctx context.Context, msg *pubsub.Message) context.Context {
+	return tenant.WithID(ctx, messageTenantID(msg))
+}
+
+// messageTenantID returns the tenant that published msg.
+func messageTenantID(msg *pubsub.Message) tenant.ID {
+	if id := tenant.ID(msg.Attributes[tenantAttribute]); id != "" {
+		return id
+	}
+	return tenant.Default
+}
+
+// messagePayload returns the event payload of msg, unwrapping it from its CloudEvents envelope
+// when the publisher used one. Messages in the legacy format carry the bare payload.
+func messagePayload(msg *pubsub.Message) ([]byte, error) {
+	if msg.Attributes[contentTypeAttribute] != outbox.CloudEventsContentType {
+		return msg.Data, nil
+	}
+	event, err := outbox.DecodeCloudEvent(msg.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cloudevent: %w", err)
+	}
+	return event.Data, nil
+}
+
+// tenantSubscriptionFilter builds the Pub/Sub filter that restricts a subscription to one tenant.
+// An empty tenant ID means the subscription receives the events of every tenant.
+func tenantSubscriptionFilter(tenantID string) (string, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" {
+		return "", nil
+	}
+	if err := tenant.ID(tenantID).Validate(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("attributes.%s = \"%s\"", tenantAttribute, tenantID), nil
+}
+
+// ensureSubscription creates the subscription on the topic when it does not exist yet.
+// A non-empty filter only applies to newly created subscriptions, since Pub/Sub filters are immutable.
+// Created subscriptions have message ordering enabled, so events sharing an ordering key are delivered in order.
+func ensureSubscription(ctx context.Context, client *pubsub.Client, projectID, topicID, subscriptionID, filter string) error {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return errors.New("PUBSUB_PROJECT_ID is required")
+	}
+
+	subscriptionPath := fmt.Sprintf("projects/%s/subscriptions/%s", projectID, subscriptionID)
+	_, err := client.SubscriptionAdminClient.GetSubscription(
+		ctx,
+		&pubsubpb.GetSubscriptionRequest{Subscription: subscriptionPath},
+	)
+	if err == nil {
+		return nil
+	}
+
+	if status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	topicPath := fmt.Sprintf("projects/%s/topics/%s", projectID, topicID)
+	_, err = client.SubscriptionAdminClient.CreateSubscription(
+		ctx,
+		&pubsubpb.Subscription{
+			Name:                  subscriptionPath,
+			Topic:                 topicPath,
+			Filter:                filter,
+			EnableMessageOrdering: true,
+		},
+	)
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return err
+	}
+
+	return nil
+}
+
+// deleteSubscription removes the subscription, ignoring subscriptions that are already gone.
+func deleteSubscription(ctx context.Context, client *pubsub.Client, projectID, subscriptionID string) error {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return errors.New("PUBSUB_PROJECT_ID is required")
+	}
+
+	subscriptionPath := fmt.Sprintf("projects/%s/subscriptions/%s", projectID, subscriptionID)
+	err := client.SubscriptionAdminClient.DeleteSubscription(
+		ctx,
+		&pubsubpb.DeleteSubscriptionRequest{Subscription: subscriptionPath},
+	)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	return nil
+}
+
+// recordConsumption records in the checkpoint of the subscription that the messages were handled, or the
+// error that failed them, so the outbox health report can tell how far the subscription trails its topic.
+func recordConsumption(ctx context.Context, logger *log.Logger, monitor outboxuc.Monitor, subscriptionID string, topic outbox.Topic, messages []*pubsub.Message, consumeErr error) {
+	var lastEventAt time.Time
+	for _, msg := range messages {
+		if msg.PublishTime.After(lastEventAt) {
+			lastEventAt = msg.PublishTime
+		}
+	}
+	if err := monitor.RecordConsumption(ctx, subscriptionID, topic, lastEventAt, consumeErr); err != nil {
+		logger.Printf("subscription_id=%s: failed to record consumption: %v", subscriptionID, err)
+	}
+}
@@ -0,0 +1,121 @@
+package workers
+
+import (
+	"testing"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageTenantContext(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		attributes map[string]string
+		want       tenant.ID
+	}{
+		"tenant-attribute": {
+			attributes: map[string]string{"tenant_id": "acme"},
+			want:       "acme",
+		},
+		"missing-attribute": {
+			attributes: map[string]string{"event_type": "TODO_CREATED"},
+			want:       tenant.Default,
+		},
+		"no-attributes": {
+			want: tenant.Default,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := messageTenantContext(t.Context(), &pubsub.Message{Attributes: tt.attributes})
+			assert.Equal(t, tt.want, tenant.IDFromContext(ctx))
+		})
+	}
+}
+
+func TestTenantSubscriptionFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tenantID  string
+		want      string
+		expectErr bool
+	}{
+		"no-filter": {
+			tenantID: "  ",
+		},
+		"tenant-filter": {
+			tenantID: "acme",
+			want:     `attributes.tenant_id = "acme"`,
+		},
+		"invalid-tenant": {
+			tenantID:  `acme" OR attributes:tenant_id`,
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tenantSubscriptionFilter(tt.tenantID)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMessagePayload(t *testing.T) {
+	t.Parallel()
+
+	cloudEvents := map[string]string{"content-type": outbox.CloudEventsContentType}
+
+	tests := map[string]struct {
+		msg     *pubsub.Message
+		want    string
+		wantErr bool
+	}{
+		"legacy": {
+			msg:  &pubsub.Message{Data: []byte(`{"Type":"TODO.CREATED"}`)},
+			want: `{"Type":"TODO.CREATED"}`,
+		},
+		"cloudevent": {
+			msg: &pubsub.Message{
+				Attributes: cloudEvents,
+				Data:       []byte(`{"specversion":"1.0","id":"1","source":"/todoapp","type":"TODO.CREATED","data":{"Type":"TODO.CREATED"}}`),
+			},
+			want: `{"Type":"TODO.CREATED"}`,
+		},
+		"invalid-cloudevent": {
+			msg: &pubsub.Message{
+				Attributes: cloudEvents,
+				Data:       []byte(`{"Type":"TODO.CREATED"}`),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := messagePayload(tt.msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// TodoArchiver is a runnable that periodically archives the completed todos of each configured tenant
+// once they are older than the retention window.
+type TodoArchiver struct {
+	Archive             todo.Archive  `resolve:""`
+	Logger              *log.Logger   `resolve:""`
+	Interval            time.Duration `config:"TODO_ARCHIVE_INTERVAL" default:"1h"`
+	Tenants             string        `config:"TODO_ARCHIVE_TENANTS" default:"default"`
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the todo archiver worker.
+func (a TodoArchiver) Run(ctx context.Context) error {
+	if a.Interval <= 0 {
+		a.Logger.Print("TodoArchiver: disabled (TODO_ARCHIVE_INTERVAL <= 0)")
+		return nil
+	}
+	tenants, err := parseTenantIDs(a.Tenants)
+	if err != nil {
+		return fmt.Errorf("TodoArchiver: invalid TODO_ARCHIVE_TENANTS: %w", err)
+	}
+
+	a.Logger.Println("TodoArchiver: running...")
+	ticker := time.NewTicker(a.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, tenantID := range tenants {
+				archived, err := a.Archive.ArchiveCompleted(tenant.WithID(ctx, tenantID))
+				if err != nil {
+					a.Logger.Printf("TodoArchiver: tenant_id=%s: %v", tenantID, err)
+				}
+				if archived > 0 {
+					a.Logger.Printf("TodoArchiver: tenant_id=%s: archived %d todos", tenantID, archived)
+				}
+			}
+			if a.workerExecutionChan != nil {
+				a.workerExecutionChan <- struct{}{}
+			}
+		case <-ctx.Done():
+			a.Logger.Println("TodoArchiver: stopped")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTodoArchiver_Run(t *testing.T) {
+	t.Parallel()
+
+	archive := todo.NewMockArchive(t)
+	archive.EXPECT().ArchiveCompleted(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "acme"
+	})).Return(0, assert.AnError).Once()
+	archive.EXPECT().ArchiveCompleted(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "globex"
+	})).Return(2, nil).Once()
+	archive.EXPECT().ArchiveCompleted(mock.Anything).Return(0, nil).Twice()
+
+	signalChan := make(chan struct{})
+
+	cancel, doneChan := run(t, t.Context(), TodoArchiver{
+		Archive:             archive,
+		Logger:              log.New(io.Discard, "", 0),
+		Interval:            2 * time.Millisecond,
+		Tenants:             "acme, globex",
+		workerExecutionChan: signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
+
+func TestTodoArchiver_Run_Config(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		interval time.Duration
+		tenants  string
+		errMsg   string
+	}{
+		"disabled": {},
+		"invalid-tenants": {
+			interval: time.Minute,
+			tenants:  "default,Not Valid",
+			errMsg:   "TodoArchiver: invalid TODO_ARCHIVE_TENANTS: tenant id must be 1-40 lowercase letters, digits, '-' or '_'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := TodoArchiver{
+				Logger:   log.New(io.Discard, "", 0),
+				Interval: tt.interval,
+				Tenants:  tt.tenants,
+			}
+			err := a.Run(t.Context())
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
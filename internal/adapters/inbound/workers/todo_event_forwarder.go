@@ -0,0 +1,137 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+)
+
+// TodoEventForwarder consumes todo domain events from a per-replica Pub/Sub subscription
+// and forwards them into the in-memory todo event stream used by the realtime board endpoint.
+// When the stream already fans broadcasts out to every replica, the replicas share one subscription instead.
+type TodoEventForwarder struct {
+	Logger              *log.Logger            `resolve:""`
+	Client              *pubsub.Client         `resolve:""`
+	Stream              outbox.TodoEventStream `resolve:""`
+	SubscriptionPrefix  string                 `config:"TODO_STREAM_EVENTS_SUBSCRIPTION_PREFIX"`
+	ProjectID           string                 `config:"PUBSUB_PROJECT_ID"`
+	TenantFilter        string                 `config:"PUBSUB_TENANT_FILTER" default:""`
+	ServerID            string
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the todo event forwarder worker.
+func (w TodoEventForwarder) Run(ctx context.Context) error {
+	effectiveSubscriptionID := w.resolveSubscriptionID()
+	if strings.TrimSpace(effectiveSubscriptionID) == "" {
+		return errors.New("TODO_STREAM_EVENTS_SUBSCRIPTION_PREFIX is required")
+	}
+	filter, err := tenantSubscriptionFilter(w.TenantFilter)
+	if err != nil {
+		return err
+	}
+	if err := ensureSubscription(ctx, w.Client, w.ProjectID, string(outbox.Topic_Todo), effectiveSubscriptionID, filter); err != nil {
+		return err
+	}
+	defer func() {
+		// The shared subscription outlives the replicas consuming it.
+		if w.sharesSubscription() {
+			return
+		}
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := deleteSubscription(cleanupCtx, w.Client, w.ProjectID, effectiveSubscriptionID); err != nil {
+			w.Logger.Printf(
+				"TodoEventForwarder: failed to delete subscription_id=%s: %v",
+				effectiveSubscriptionID,
+				err,
+			)
+		}
+	}()
+
+	w.Logger.Printf("TodoEventForwarder: running (subscription_id=%s)...", effectiveSubscriptionID)
+
+	subscriberErrCh := make(chan error, 1)
+
+	go func() {
+		err := w.Client.Subscriber(effectiveSubscriptionID).Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+			payload, err := messagePayload(msg)
+			var event outbox.TodoEvent
+			if err == nil {
+				event, err = decodeTodoEvent(payload, messageTenantID(msg))
+			}
+			switch {
+			case err != nil:
+				w.Logger.Printf("TodoEventForwarder: invalid payload: %v", err)
+			case event.Reprocessed:
+				// Reprocessed events replay past changes that boards already show.
+			default:
+				w.Stream.Broadcast(event)
+			}
+			msg.Ack()
+
+			if w.workerExecutionChan != nil {
+				w.workerExecutionChan <- struct{}{}
+			}
+		})
+		if err != nil {
+			subscriberErrCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		w.Logger.Println("TodoEventForwarder: stopped")
+		return nil
+	case err := <-subscriberErrCh:
+		return err
+	}
+}
+
+// resolveSubscriptionID determines the effective subscription ID to use, applying server ID suffix if configured.
+// Replicas sharing the subscription use the prefix as is.
+func (w TodoEventForwarder) resolveSubscriptionID() string {
+	if w.sharesSubscription() {
+		return strings.TrimSpace(w.SubscriptionPrefix)
+	}
+	return resolveReplicaSubscriptionID(w.SubscriptionPrefix, w.ServerID)
+}
+
+// sharesSubscription reports whether the stream broadcasts to every replica, in which case each event
+// must be consumed by a single replica.
+func (w TodoEventForwarder) sharesSubscription() bool {
+	replicated, ok := w.Stream.(outbox.ReplicatedTodoEventStream)
+	return ok && replicated.Replicated()
+}
+
+// decodeTodoEvent parses the Pub/Sub message payload into a todo event, attributing events
+// recorded without a tenant to the tenant the message was published for.
+func decodeTodoEvent(payload []byte, tenantID tenant.ID) (outbox.TodoEvent, error) {
+	var event outbox.TodoEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return outbox.TodoEvent{}, err
+	}
+
+	switch event.Type {
+	case outbox.EventType_TODO_CREATED, outbox.EventType_TODO_UPDATED, outbox.EventType_TODO_DELETED:
+	default:
+		return outbox.TodoEvent{}, errors.New("unsupported todo event type: " + string(event.Type))
+	}
+	if event.TodoID == uuid.Nil {
+		return outbox.TodoEvent{}, errors.New("todo event is missing the todo id")
+	}
+	if event.TenantID == "" {
+		event.TenantID = tenantID
+	}
+
+	return event, nil
+}
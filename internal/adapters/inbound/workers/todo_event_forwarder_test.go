@@ -0,0 +1,137 @@
+package workers
+
+import (
+	"encoding/json"
+	"log"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTodoEventForwarder_Run(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.TodoEvent{
+		Type:      outbox.EventType_TODO_UPDATED,
+		TodoID:    uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		CreatedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	// Messages without a tenant attribute belong to the default tenant.
+	broadcastEvent := event
+	broadcastEvent.TenantID = tenant.Default
+
+	tests := map[string]struct {
+		payload         []byte
+		replicated      bool
+		expectBroadcast bool
+	}{
+		"forwards-todo-event": {
+			payload:         todoEventPayload(t, event),
+			expectBroadcast: true,
+		},
+		"replicated-stream-shares-subscription": {
+			payload:         todoEventPayload(t, event),
+			replicated:      true,
+			expectBroadcast: true,
+		},
+		"skips-reprocessed-event": {
+			payload: todoEventPayload(t, outbox.TodoEvent{Type: outbox.EventType_TODO_UPDATED, TodoID: event.TodoID, Reprocessed: true}),
+		},
+		"invalid-payload": {
+			payload: []byte(`{"invalid"`),
+		},
+		"unsupported-event-type": {
+			payload: todoEventPayload(t, outbox.TodoEvent{Type: outbox.EventType_CHAT_MESSAGE_SENT, TodoID: event.TodoID}),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			subscriptionID := "todo-stream-sub-" + name
+			client, topicName := setupPubSubServer(t, ctx, string(outbox.Topic_Todo), subscriptionID)
+			var stream outbox.TodoEventStream
+			if tc.replicated {
+				replicatedStream := outbox.NewMockReplicatedTodoEventStream(t)
+				replicatedStream.EXPECT().Replicated().Return(true)
+				if tc.expectBroadcast {
+					replicatedStream.EXPECT().Broadcast(broadcastEvent).Once()
+				}
+				stream = replicatedStream
+			} else {
+				todoStream := outbox.NewMockTodoEventStream(t)
+				if tc.expectBroadcast {
+					todoStream.EXPECT().Broadcast(broadcastEvent).Once()
+				}
+				stream = todoStream
+			}
+
+			prefix := subscriptionID
+			if tc.replicated {
+				prefix += "-shared"
+			}
+
+			signalChan := make(chan struct{}, 10)
+			worker := TodoEventForwarder{
+				Logger:              log.Default(),
+				Client:              client,
+				Stream:              stream,
+				SubscriptionPrefix:  prefix,
+				ProjectID:           testPubSubProjectID,
+				ServerID:            "server_" + name,
+				workerExecutionChan: signalChan,
+			}
+			effectiveSubscriptionID := worker.resolveSubscriptionID()
+			if tc.replicated {
+				assert.Equal(t, prefix, effectiveSubscriptionID)
+			}
+
+			cancel, doneChan := run(t, ctx, worker)
+
+			// Wait for the replica subscription before publishing so the message is delivered to it.
+			assert.Eventually(t, func() bool {
+				sub, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{
+					Subscription: "projects/" + testPubSubProjectID + "/subscriptions/" + effectiveSubscriptionID,
+				})
+				return err == nil && sub.GetEnableMessageOrdering()
+			}, time.Second, 10*time.Millisecond)
+
+			err := publishMessages(ctx, client, topicName, [][]byte{tc.payload})
+			assert.NoError(t, err)
+
+			waitForBatchSignals(t, signalChan, 1, 500*time.Millisecond)
+
+			cancel()
+			waitRunnableStop(t, doneChan)
+
+			_, err = client.SubscriptionAdminClient.GetSubscription(
+				ctx,
+				&pubsubpb.GetSubscriptionRequest{
+					Subscription: "projects/" + testPubSubProjectID + "/subscriptions/" + effectiveSubscriptionID,
+				},
+			)
+			if tc.replicated {
+				// The shared subscription is kept for the other replicas.
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	}
+}
+
+func todoEventPayload(t *testing.T, event outbox.TodoEvent) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(event)
+	assert.NoError(t, err)
+	return data
+}
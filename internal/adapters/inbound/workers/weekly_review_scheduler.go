@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
+)
+
+// WeeklyReviewScheduler is a runnable that generates the weekly review of each configured tenant on a cron schedule.
+type WeeklyReviewScheduler struct {
+	GenerateWeeklyReview board.GenerateWeeklyReview `resolve:""`
+	Logger               *log.Logger                `resolve:""`
+	Schedule             string                     `config:"WEEKLY_REVIEW_SCHEDULE" default:"0 9 * * 1"`
+	Tenants              string                     `config:"WEEKLY_REVIEW_TENANTS" default:"default"`
+	// after waits for the next scheduled run; tests replace it to fire immediately.
+	after               func(time.Duration) <-chan time.Time
+	workerExecutionChan chan struct{}
+}
+
+// Run starts the weekly review scheduler worker.
+func (s WeeklyReviewScheduler) Run(ctx context.Context) error {
+	if strings.TrimSpace(s.Schedule) == "" {
+		s.Logger.Print("WeeklyReviewScheduler: disabled (WEEKLY_REVIEW_SCHEDULE is empty)")
+		return nil
+	}
+	schedule, err := parseCronSchedule(s.Schedule)
+	if err != nil {
+		return fmt.Errorf("WeeklyReviewScheduler: invalid WEEKLY_REVIEW_SCHEDULE: %w", err)
+	}
+	tenants, err := parseTenantIDs(s.Tenants)
+	if err != nil {
+		return fmt.Errorf("WeeklyReviewScheduler: invalid WEEKLY_REVIEW_TENANTS: %w", err)
+	}
+	after := s.after
+	if after == nil {
+		after = time.After
+	}
+
+	s.Logger.Println("WeeklyReviewScheduler: running...")
+	for {
+		next := schedule.next(time.Now())
+		if next.IsZero() {
+			s.Logger.Printf("WeeklyReviewScheduler: schedule %q never runs", s.Schedule)
+			return nil
+		}
+
+		select {
+		case <-after(time.Until(next)):
+			for _, tenantID := range tenants {
+				if err := s.GenerateWeeklyReview.Execute(tenant.WithID(ctx, tenantID)); err != nil {
+					s.Logger.Printf("WeeklyReviewScheduler: tenant_id=%s: %v", tenantID, err)
+				}
+			}
+			if s.workerExecutionChan != nil {
+				s.workerExecutionChan <- struct{}{}
+			}
+		case <-ctx.Done():
+			s.Logger.Println("WeeklyReviewScheduler: stopped")
+			return nil
+		}
+	}
+}
+
+// parseTenantIDs parses a comma-separated list of tenant IDs.
+func parseTenantIDs(value string) ([]tenant.ID, error) {
+	ids := []tenant.ID{}
+	for part := range strings.SplitSeq(value, ",") {
+		id := tenant.ID(strings.TrimSpace(part))
+		if id == "" {
+			continue
+		}
+		if err := id.Validate(); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,99 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// firesTimes returns an after func that fires immediately for the first n waits and never afterwards.
+func firesTimes(n int) func(time.Duration) <-chan time.Time {
+	return func(time.Duration) <-chan time.Time {
+		if n == 0 {
+			return nil
+		}
+		n--
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+}
+
+func TestWeeklyReviewScheduler_Run(t *testing.T) {
+	t.Parallel()
+
+	generate := board.NewMockGenerateWeeklyReview(t)
+	generate.EXPECT().Execute(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "acme"
+	})).Return(assert.AnError).Once()
+	generate.EXPECT().Execute(mock.MatchedBy(func(ctx context.Context) bool {
+		return tenant.IDFromContext(ctx) == "globex"
+	})).Return(nil).Once()
+	generate.EXPECT().Execute(mock.Anything).Return(nil).Twice()
+
+	signalChan := make(chan struct{})
+
+	cancel, doneChan := run(t, t.Context(), WeeklyReviewScheduler{
+		GenerateWeeklyReview: generate,
+		Logger:               log.New(io.Discard, "", 0),
+		Schedule:             "0 9 * * 1",
+		Tenants:              "acme, globex",
+		after:                firesTimes(2),
+		workerExecutionChan:  signalChan,
+	})
+
+	waitForBatchSignals(t, signalChan, 2, 1*time.Second)
+
+	cancel()
+
+	waitRunnableStop(t, doneChan)
+}
+
+func TestWeeklyReviewScheduler_Run_Config(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		schedule string
+		tenants  string
+		errMsg   string
+	}{
+		"disabled": {
+			schedule: " ",
+		},
+		"invalid-schedule": {
+			schedule: "0 9 * *",
+			tenants:  "default",
+			errMsg:   `WeeklyReviewScheduler: invalid WEEKLY_REVIEW_SCHEDULE: cron expression "0 9 * *" must have 5 fields`,
+		},
+		"invalid-tenants": {
+			schedule: "0 9 * * 1",
+			tenants:  "default,Not Valid",
+			errMsg:   "WeeklyReviewScheduler: invalid WEEKLY_REVIEW_TENANTS: tenant id must be 1-40 lowercase letters, digits, '-' or '_'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := WeeklyReviewScheduler{
+				Logger:   log.New(io.Discard, "", 0),
+				Schedule: tt.schedule,
+				Tenants:  tt.tenants,
+			}
+			err := s.Run(t.Context())
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -9,13 +9,20 @@ import (
 
 // InitActionRegistry is the initializer for ActionRegistry, composing local and MCP gateway registries.
 type InitActionRegistry struct {
-	Local assistant.ActionRegistry `resolve:"local"`
-	MCP   assistant.ActionRegistry `resolve:"mcp"`
+	Local                assistant.ActionRegistry `resolve:"local"`
+	MCP                  assistant.ActionRegistry `resolve:"mcp"`
+	ResultMaxBytes       int                      `config:"ACTION_RESULT_MAX_BYTES" default:"16384"`
+	ActionResultMaxBytes string                   `config:"ACTION_RESULT_MAX_BYTES_OVERRIDES" default:""`
 }
 
-// Initialize creates an ActionRegistry from the local and MCP gateway registries and registers it in the dependency container.
+// Initialize creates an ActionRegistry from the local and MCP gateway registries, bounding the size of their
+// results, and registers it in the dependency container.
 func (i InitActionRegistry) Initialize(ctx context.Context) (context.Context, error) {
-	composite := NewActionRegistry(ctx, i.Local, i.MCP)
+	resultLimits, err := ParseResultLimits(i.ResultMaxBytes, i.ActionResultMaxBytes)
+	if err != nil {
+		return ctx, err
+	}
+	composite := NewActionRegistry(ctx, resultLimits, i.Local, i.MCP)
 	depend.Register[assistant.ActionRegistry](composite)
 	return ctx, nil
 }
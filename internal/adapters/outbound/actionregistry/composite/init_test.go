@@ -20,3 +20,11 @@ func TestInitCompositeActionRegistry_Initialize(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, ActionRegistry{}, dep)
 }
+
+func TestInitCompositeActionRegistry_Initialize_InvalidResultLimits(t *testing.T) {
+	t.Parallel()
+
+	r := &InitActionRegistry{ActionResultMaxBytes: "fetch_todos"}
+	_, err := r.Initialize(t.Context())
+	assert.EqualError(t, err, `invalid action result limit "fetch_todos": expected action=bytes`)
+}
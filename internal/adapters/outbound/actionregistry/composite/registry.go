@@ -6,18 +6,25 @@ import (
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 )
 
+// defaultStatusMessage is the status message shown for actions without their own.
+const defaultStatusMessage = "⏳ Processing request..."
+
 // ActionRegistry implements assistant.ActionRegistry interface.
 // It aggregates actions from multiple EmbeddingActionRegistry instances.
 type ActionRegistry struct {
 	registriesActions []assistant.ActionRegistry
+	resultLimits      ResultLimits
 }
 
 // NewActionRegistry creates a new composite ActionRegistry from the given embedding registries.
-func NewActionRegistry(ctx context.Context, registries ...assistant.ActionRegistry) ActionRegistry {
+// Action results larger than their limit are truncated before being returned.
+func NewActionRegistry(ctx context.Context, resultLimits ResultLimits, registries ...assistant.ActionRegistry) ActionRegistry {
 	return ActionRegistry{
 		registriesActions: registries,
+		resultLimits:      resultLimits,
 	}
 }
 
@@ -26,24 +33,24 @@ func (r ActionRegistry) Execute(ctx context.Context, call assistant.ActionCall,
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 	for _, actionRegistry := range r.registriesActions {
-		_, found := actionRegistry.GetDefinition(call.Name)
+		_, found := actionRegistry.GetDefinition(spanCtx, call.Name)
 		if !found {
 			continue
 		}
-		return actionRegistry.Execute(spanCtx, call, conversationHistory)
-	}
-	errMsg := fmt.Sprintf("no registry found for action '%s'", call.Name)
-	return assistant.Message{
-		Role:        assistant.ChatRole_Tool,
-		Content:     "error: " + errMsg,
-		ActionError: &errMsg,
+		result := actionRegistry.Execute(spanCtx, call, conversationHistory)
+		if content, truncated := TruncateResult(result.Content, r.resultLimits.For(call.Name)); truncated {
+			result.Content = content
+			metrics.RecordActionResultTruncated(spanCtx, call.Name)
+		}
+		return result
 	}
+	return assistant.NewActionErrorMessage(call, "unknown_action", fmt.Sprintf("no registry found for action '%s'", call.Name), "")
 }
 
 // GetDefinition returns one action definition by name.
-func (r ActionRegistry) GetDefinition(actionName string) (assistant.ActionDefinition, bool) {
+func (r ActionRegistry) GetDefinition(ctx context.Context, actionName string) (assistant.ActionDefinition, bool) {
 	for _, actionRegistry := range r.registriesActions {
-		definition, found := actionRegistry.GetDefinition(actionName)
+		definition, found := actionRegistry.GetDefinition(ctx, actionName)
 		if found {
 			return definition, true
 		}
@@ -63,12 +70,19 @@ func (r ActionRegistry) GetRenderer(actionName string) (assistant.ActionResultRe
 }
 
 // StatusMessage iterates through the composed registries to get the status message for the given action, returning a default message if none found.
+// Registries answer unknown actions with the default message, so the first other message belongs to the owning registry.
 func (r ActionRegistry) StatusMessage(actionName string) string {
 	for _, actionRegistry := range r.registriesActions {
-		_, found := actionRegistry.GetDefinition(actionName)
-		if found {
-			return actionRegistry.StatusMessage(actionName)
+		if msg := actionRegistry.StatusMessage(actionName); msg != defaultStatusMessage {
+			return msg
 		}
 	}
-	return "⏳ Processing request..."
+	return defaultStatusMessage
+}
+
+// Prefetch forwards the speculative calls to every composed registry, which ignore actions they do not own.
+func (r ActionRegistry) Prefetch(ctx context.Context, actionNames []string, messages []assistant.Message) {
+	for _, actionRegistry := range r.registriesActions {
+		actionRegistry.Prefetch(ctx, actionNames, messages)
+	}
 }
@@ -17,6 +17,7 @@ func TestCompositeActionRegistry_Execute(t *testing.T) {
 		call          assistant.ActionCall
 		history       []assistant.Message
 		registriesLen int
+		resultLimits  ResultLimits
 		setMocks      func(t *testing.T, registries []*assistant.MockActionRegistry)
 		assertMessage func(t *testing.T, message assistant.Message)
 	}{
@@ -26,7 +27,7 @@ func TestCompositeActionRegistry_Execute(t *testing.T) {
 			registriesLen: 2,
 			setMocks: func(t *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("fetch_todos").
+					GetDefinition(mock.Anything, "fetch_todos").
 					Return(assistant.ActionDefinition{Name: "fetch_todos"}, true).
 					Once()
 				registries[0].EXPECT().
@@ -49,24 +50,49 @@ func TestCompositeActionRegistry_Execute(t *testing.T) {
 				assert.Equal(t, "call-1", *message.ActionCallID)
 			},
 		},
+		"truncates-result-over-action-limit": {
+			call:          assistant.ActionCall{ID: "call-3", Name: "fetch_todos", Input: "{}"},
+			registriesLen: 1,
+			resultLimits:  ResultLimits{Default: 1024, PerAction: map[string]int{"fetch_todos": 120}},
+			setMocks: func(t *testing.T, registries []*assistant.MockActionRegistry) {
+				registries[0].EXPECT().
+					GetDefinition(mock.Anything, "fetch_todos").
+					Return(assistant.ActionDefinition{Name: "fetch_todos"}, true).
+					Once()
+				registries[0].EXPECT().
+					Execute(mock.Anything, mock.Anything, mock.Anything).
+					Return(assistant.Message{
+						Role:         assistant.ChatRole_Tool,
+						Content:      `{"status":"success","data":{"todos":[{"id":1,"title":"Buy milk"},{"id":2,"title":"Pay rent"},{"id":3,"title":"Call mom"}]}}`,
+						ActionCallID: common.Ptr("call-3"),
+					}).
+					Once()
+			},
+			assertMessage: func(t *testing.T, message assistant.Message) {
+				assert.Equal(t, `{"status":"success","data":{"todos":[{"id":1,"title":"Buy milk"}]},"truncated":{"todos":{"shown":1,"total":3}}}`, message.Content)
+				require.NotNil(t, message.ActionCallID)
+				assert.Equal(t, "call-3", *message.ActionCallID)
+			},
+		},
 		"returns-error-when-action-is-not-registered": {
 			call:          assistant.ActionCall{ID: "call-2", Name: "unknown_action", Input: "{}"},
 			registriesLen: 2,
 			setMocks: func(_ *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("unknown_action").
+					GetDefinition(mock.Anything, "unknown_action").
 					Return(assistant.ActionDefinition{}, false).
 					Once()
 				registries[1].EXPECT().
-					GetDefinition("unknown_action").
+					GetDefinition(mock.Anything, "unknown_action").
 					Return(assistant.ActionDefinition{}, false).
 					Once()
 			},
 			assertMessage: func(t *testing.T, message assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, message.Role)
-				assert.Equal(t, "error: no registry found for action 'unknown_action'", message.Content)
+				assert.JSONEq(t, `{"status":"error","error":{"code":"unknown_action","details":"no registry found for action 'unknown_action'"}}`, message.Content)
 				require.NotNil(t, message.ActionError)
-				assert.Equal(t, "no registry found for action 'unknown_action'", *message.ActionError)
+				assert.Equal(t, message.Content, *message.ActionError)
+				assert.False(t, message.IsActionCallSuccess())
 			},
 		},
 	}
@@ -82,7 +108,7 @@ func TestCompositeActionRegistry_Execute(t *testing.T) {
 				registries = append(registries, mock)
 			}
 
-			registry := NewActionRegistry(t.Context(), registries...)
+			registry := NewActionRegistry(t.Context(), tt.resultLimits, registries...)
 			message := registry.Execute(t.Context(), tt.call, tt.history)
 			if tt.assertMessage != nil {
 				tt.assertMessage(t, message)
@@ -105,7 +131,7 @@ func TestCompositeActionRegistry_GetDefinition(t *testing.T) {
 			registriesLen: 2,
 			setMocks: func(_ *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("fetch_todos").
+					GetDefinition(mock.Anything, "fetch_todos").
 					Return(assistant.ActionDefinition{Name: "fetch_todos", Description: "from first"}, true).
 					Once()
 			},
@@ -120,11 +146,11 @@ func TestCompositeActionRegistry_GetDefinition(t *testing.T) {
 			registriesLen: 2,
 			setMocks: func(_ *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("update_todos").
+					GetDefinition(mock.Anything, "update_todos").
 					Return(assistant.ActionDefinition{}, false).
 					Once()
 				registries[1].EXPECT().
-					GetDefinition("update_todos").
+					GetDefinition(mock.Anything, "update_todos").
 					Return(assistant.ActionDefinition{Name: "update_todos", Description: "from second"}, true).
 					Once()
 			},
@@ -139,11 +165,11 @@ func TestCompositeActionRegistry_GetDefinition(t *testing.T) {
 			registriesLen: 2,
 			setMocks: func(_ *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("missing_action").
+					GetDefinition(mock.Anything, "missing_action").
 					Return(assistant.ActionDefinition{}, false).
 					Once()
 				registries[1].EXPECT().
-					GetDefinition("missing_action").
+					GetDefinition(mock.Anything, "missing_action").
 					Return(assistant.ActionDefinition{}, false).
 					Once()
 			},
@@ -166,8 +192,8 @@ func TestCompositeActionRegistry_GetDefinition(t *testing.T) {
 				registries = append(registries, mockRegistry)
 			}
 
-			registry := NewActionRegistry(t.Context(), registries...)
-			definition, found := registry.GetDefinition(tt.actionName)
+			registry := NewActionRegistry(t.Context(), ResultLimits{}, registries...)
+			definition, found := registry.GetDefinition(t.Context(), tt.actionName)
 			if tt.assertResult != nil {
 				tt.assertResult(t, definition, found)
 			}
@@ -189,12 +215,8 @@ func TestCompositeActionRegistry_StatusMessage(t *testing.T) {
 			registriesLen: 2,
 			setMocks: func(t *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("search_web").
-					Return(assistant.ActionDefinition{}, false).
-					Once()
-				registries[1].EXPECT().
-					GetDefinition("search_web").
-					Return(assistant.ActionDefinition{Name: "search_web"}, true).
+					StatusMessage("search_web").
+					Return("⏳ Processing request...").
 					Once()
 				registries[1].EXPECT().
 					StatusMessage("search_web").
@@ -208,12 +230,12 @@ func TestCompositeActionRegistry_StatusMessage(t *testing.T) {
 			registriesLen: 2,
 			setMocks: func(_ *testing.T, registries []*assistant.MockActionRegistry) {
 				registries[0].EXPECT().
-					GetDefinition("missing_action").
-					Return(assistant.ActionDefinition{}, false).
+					StatusMessage("missing_action").
+					Return("⏳ Processing request...").
 					Once()
 				registries[1].EXPECT().
-					GetDefinition("missing_action").
-					Return(assistant.ActionDefinition{}, false).
+					StatusMessage("missing_action").
+					Return("⏳ Processing request...").
 					Once()
 			},
 			expected: "⏳ Processing request...",
@@ -232,7 +254,7 @@ func TestCompositeActionRegistry_StatusMessage(t *testing.T) {
 				registries = append(registries, mock)
 			}
 
-			registry := NewActionRegistry(t.Context(), registries...)
+			registry := NewActionRegistry(t.Context(), ResultLimits{}, registries...)
 			assert.Equal(t, tt.expected, registry.StatusMessage(tt.actionName))
 		})
 	}
@@ -293,7 +315,7 @@ func TestCompositeActionRegistry_GetRenderer(t *testing.T) {
 				registries = append(registries, mock)
 			}
 
-			registry := NewActionRegistry(t.Context(), registries...)
+			registry := NewActionRegistry(t.Context(), ResultLimits{}, registries...)
 			got, found := registry.GetRenderer(tt.actionName)
 			tt.assertResult(t, got, found)
 		})
@@ -317,3 +339,18 @@ type compositeMockRenderer struct{}
 func (compositeMockRenderer) Render(_ assistant.ActionCall, _ assistant.Message) (assistant.Message, bool) {
 	return assistant.Message{}, false
 }
+
+func TestCompositeActionRegistry_Prefetch(t *testing.T) {
+	t.Parallel()
+
+	actionNames := []string{"fetch_todos"}
+	messages := []assistant.Message{{Role: assistant.ChatRole_User, Content: "show my todos"}}
+
+	local := assistant.NewMockActionRegistry(t)
+	local.EXPECT().Prefetch(mock.Anything, actionNames, messages).Once()
+	mcp := assistant.NewMockActionRegistry(t)
+	mcp.EXPECT().Prefetch(mock.Anything, actionNames, messages).Once()
+
+	registry := NewActionRegistry(t.Context(), ResultLimits{}, local, mcp)
+	registry.Prefetch(t.Context(), actionNames, messages)
+}
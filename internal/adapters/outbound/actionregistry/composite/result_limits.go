@@ -0,0 +1,179 @@
+package composite
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// ResultLimits bounds the size in bytes of the content each action may return to the model.
+type ResultLimits struct {
+	// Default applies to actions without an explicit limit. Zero disables truncation.
+	Default int
+	// PerAction maps an action name to its limit.
+	PerAction map[string]int
+}
+
+// For returns the result size limit of an action.
+func (l ResultLimits) For(actionName string) int {
+	if limit, ok := l.PerAction[actionName]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+// ParseResultLimits builds ResultLimits from a default limit and a comma-separated
+// list of action=bytes overrides, e.g. "fetch_todos=32768,search_web=8192".
+func ParseResultLimits(defaultLimit int, overrides string) (ResultLimits, error) {
+	if defaultLimit < 0 {
+		return ResultLimits{}, fmt.Errorf("invalid action result limit %d: bytes must not be negative", defaultLimit)
+	}
+	limits := ResultLimits{Default: defaultLimit, PerAction: map[string]int{}}
+	for entry := range strings.SplitSeq(overrides, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		action, rawLimit, ok := strings.Cut(entry, "=")
+		action = strings.TrimSpace(action)
+		if !ok || action == "" {
+			return ResultLimits{}, fmt.Errorf("invalid action result limit %q: expected action=bytes", entry)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(rawLimit))
+		if err != nil || limit < 0 {
+			return ResultLimits{}, fmt.Errorf("invalid action result limit %q: bytes must be a non-negative integer", entry)
+		}
+		limits.PerAction[action] = limit
+	}
+	return limits, nil
+}
+
+// TruncateResult shrinks content to at most maxBytes. For an action result envelope, the arrays
+// in its data, in key order, keep their first items that fit, or text data keeps its beginning,
+// and the envelope records the shown and total counts under truncated. Any other content, or an
+// envelope that cannot fit, is cut at a line boundary with a trailing marker.
+// It returns false when content already fits or maxBytes is not positive.
+func TruncateResult(content string, maxBytes int) (string, bool) {
+	if maxBytes <= 0 || len(content) <= maxBytes {
+		return content, false
+	}
+	if truncated, ok := truncateEnvelope(content, maxBytes); ok {
+		return truncated, true
+	}
+	return truncateText(content, maxBytes), true
+}
+
+// truncateEnvelope trims the data of an action result envelope to fit maxBytes.
+func truncateEnvelope(content string, maxBytes int) (string, bool) {
+	if _, ok := assistant.ParseActionResult(content); !ok {
+		return "", false
+	}
+	// Decode again keeping numbers as written, since ParseActionResult turns them into float64.
+	var result assistant.ActionResult
+	decoder := json.NewDecoder(strings.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return "", false
+	}
+
+	switch data := result.Data.(type) {
+	case []any:
+		result.Truncated = map[string]assistant.ActionResultTruncation{}
+		kept := largestFitting(len(data), func(n int) bool {
+			result.Data = data[:n]
+			result.Truncated["data"] = assistant.ActionResultTruncation{Shown: n, Total: len(data)}
+			return len(result.String()) <= maxBytes
+		})
+		result.Data = data[:kept]
+		result.Truncated["data"] = assistant.ActionResultTruncation{Shown: kept, Total: len(data)}
+	case map[string]any:
+		keys := make([]string, 0, len(data))
+		for key, value := range data {
+			if _, ok := value.([]any); ok {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			return "", false
+		}
+		sort.Strings(keys)
+
+		// Arrays start empty and are then filled in key order with as many items as fit. The first
+		// pass reserves the truncation entries of the arrays not filled yet; the second pass hands
+		// the space they did not need back to the arrays in key order.
+		items := make(map[string][]any, len(keys))
+		result.Truncated = map[string]assistant.ActionResultTruncation{}
+		for _, key := range keys {
+			items[key] = data[key].([]any)
+			data[key] = []any{}
+			result.Truncated[key] = assistant.ActionResultTruncation{Total: len(items[key])}
+		}
+		for _, key := range append(keys, keys...) {
+			all := items[key]
+			fill := func(n int) {
+				data[key] = all[:n]
+				if n < len(all) {
+					result.Truncated[key] = assistant.ActionResultTruncation{Shown: n, Total: len(all)}
+				} else {
+					delete(result.Truncated, key)
+				}
+			}
+			fill(largestFitting(len(all), func(n int) bool {
+				fill(n)
+				return len(result.String()) <= maxBytes
+			}))
+		}
+	case string:
+		result.Truncated = map[string]assistant.ActionResultTruncation{}
+		kept := runeStart(data, largestFitting(len(data), func(n int) bool {
+			n = runeStart(data, n)
+			result.Data = data[:n]
+			result.Truncated["data"] = assistant.ActionResultTruncation{Shown: n, Total: len(data)}
+			return len(result.String()) <= maxBytes
+		}))
+		result.Data = data[:kept]
+		result.Truncated["data"] = assistant.ActionResultTruncation{Shown: kept, Total: len(data)}
+	default:
+		return "", false
+	}
+
+	truncated := result.String()
+	if len(truncated) > maxBytes {
+		return "", false
+	}
+	return truncated, true
+}
+
+// largestFitting returns the largest n in [0, total] for which fits holds, assuming fits
+// holds for every n below a threshold and for none above it. It returns 0 when nothing fits.
+func largestFitting(total int, fits func(n int) bool) int {
+	return max(sort.Search(total+1, func(n int) bool { return !fits(n) })-1, 0)
+}
+
+// runeStart moves the byte offset n of text back to the start of the rune it falls in.
+func runeStart(text string, n int) int {
+	for n > 0 && n < len(text) && !utf8.RuneStart(text[n]) {
+		n--
+	}
+	return n
+}
+
+// truncateText cuts content to fit maxBytes together with a trailing marker,
+// preferring the last complete line.
+func truncateText(content string, maxBytes int) string {
+	cut := runeStart(content, max(maxBytes-len(truncatedBytesMarker(maxBytes, len(content)))-1, 0))
+	kept := content[:cut]
+	if newline := strings.LastIndexByte(kept, '\n'); newline > 0 {
+		kept = kept[:newline]
+	}
+	return kept + "\n" + truncatedBytesMarker(len(kept), len(content))
+}
+
+func truncatedBytesMarker(kept, total int) string {
+	return fmt.Sprintf("... truncated: showing %d of %d bytes", kept, total)
+}
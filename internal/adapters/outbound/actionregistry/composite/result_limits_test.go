@@ -0,0 +1,150 @@
+package composite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResultLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		defaultLimit int
+		overrides    string
+		expected     ResultLimits
+		expectErr    bool
+	}{
+		"default-only": {
+			defaultLimit: 16384,
+			expected:     ResultLimits{Default: 16384, PerAction: map[string]int{}},
+		},
+		"with-overrides": {
+			defaultLimit: 16384,
+			overrides:    " fetch_todos=32768 , search_web=0,",
+			expected: ResultLimits{
+				Default:   16384,
+				PerAction: map[string]int{"fetch_todos": 32768, "search_web": 0},
+			},
+		},
+		"negative-default": {
+			defaultLimit: -1,
+			expectErr:    true,
+		},
+		"missing-separator": {
+			overrides: "fetch_todos",
+			expectErr: true,
+		},
+		"invalid-bytes": {
+			overrides: "fetch_todos=many",
+			expectErr: true,
+		},
+		"negative-bytes": {
+			overrides: "fetch_todos=-1",
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseResultLimits(tt.defaultLimit, tt.overrides)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestResultLimits_For(t *testing.T) {
+	t.Parallel()
+
+	limits := ResultLimits{Default: 16384, PerAction: map[string]int{"fetch_todos": 32768, "search_web": 0}}
+
+	assert.Equal(t, 32768, limits.For("fetch_todos"))
+	assert.Equal(t, 0, limits.For("search_web"))
+	assert.Equal(t, 16384, limits.For("create_todos"))
+	assert.Equal(t, 0, ResultLimits{}.For("fetch_todos"))
+}
+
+func TestTruncateResult(t *testing.T) {
+	t.Parallel()
+
+	todos := `{"status":"success","data":{"todos":[{"id":1,"title":"Buy milk"},{"id":2,"title":"Pay rent"},{"id":3,"title":"Call mom"}],"views":["all"]},"pagination":{"page":1,"page_size":3}}`
+
+	tests := map[string]struct {
+		content           string
+		maxBytes          int
+		expectedContent   string
+		expectedTruncated bool
+	}{
+		"fits-within-limit": {
+			content:         todos,
+			maxBytes:        len(todos),
+			expectedContent: todos,
+		},
+		"zero-limit-disables-truncation": {
+			content:         todos,
+			expectedContent: todos,
+		},
+		"keeps-first-data-array-items-and-counts": {
+			content:           todos,
+			maxBytes:          170,
+			expectedContent:   `{"status":"success","data":{"todos":[{"id":1,"title":"Buy milk"}],"views":["all"]},"pagination":{"page":1,"page_size":3},"truncated":{"todos":{"shown":1,"total":3}}}`,
+			expectedTruncated: true,
+		},
+		"keeps-no-items-when-none-fit": {
+			content:           todos,
+			maxBytes:          150,
+			expectedContent:   `{"status":"success","data":{"todos":[],"views":["all"]},"pagination":{"page":1,"page_size":3},"truncated":{"todos":{"shown":0,"total":3}}}`,
+			expectedTruncated: true,
+		},
+		"keeps-first-items-of-array-data": {
+			content:           `{"status":"success","data":["alpha","beta","gamma","delta","epsilon","zeta","eta","theta"]}`,
+			maxBytes:          90,
+			expectedContent:   `{"status":"success","data":["alpha","beta"],"truncated":{"data":{"shown":2,"total":8}}}`,
+			expectedTruncated: true,
+		},
+		"keeps-beginning-of-text-data-at-rune-boundary": {
+			content:           `{"status":"success","data":"` + strings.Repeat("é", 40) + `"}`,
+			maxBytes:          80,
+			expectedContent:   `{"status":"success","data":"` + strings.Repeat("é", 3) + `","truncated":{"data":{"shown":6,"total":80}}}`,
+			expectedTruncated: true,
+		},
+		"cuts-plain-text-at-line-boundary": {
+			content:  strings.Repeat("line of text\n", 10),
+			maxBytes: 80,
+			expectedContent: strings.Join([]string{
+				"line of text",
+				"line of text",
+				"line of text",
+				"... truncated: showing 38 of 130 bytes",
+			}, "\n"),
+			expectedTruncated: true,
+		},
+		"cuts-envelope-without-arrays-as-text": {
+			content:           `{"status":"error","error":{"code":"failed","details":"` + strings.Repeat("x", 60) + `"}}`,
+			maxBytes:          70,
+			expectedContent:   `{"status":"error","error":{"cod` + "\n... truncated: showing 31 of 117 bytes",
+			expectedTruncated: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, truncated := TruncateResult(tt.content, tt.maxBytes)
+			assert.Equal(t, tt.expectedTruncated, truncated)
+			assert.Equal(t, tt.expectedContent, got)
+			if tt.maxBytes > 0 {
+				assert.LessOrEqual(t, len(got), tt.maxBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// maxResponseBytes caps the backend response passed back to the model.
+const maxResponseBytes = 16 << 10
+
+// HTTPAction is a generic assistant action declared in YAML that maps its validated arguments into an HTTP
+// request and the response back into the tool message.
+//
+// The method, URL and body are text/template sources rendered with the arguments; URL values are path
+// escaped and body values must be printed with the json function. Arguments the URL does not reference are sent as query parameters for GET and DELETE and as a
+// JSON body otherwise, unless a body template is set. Configured response fields pick values out of a JSON
+// response; without them the whole response is returned.
+type HTTPAction struct {
+	definition    assistant.ActionDefinition
+	statusMessage string
+	backend       backend
+	response      []responseField
+	client        *http.Client
+	lookup        Lookup
+}
+
+// Definition returns the action definition declared in YAML.
+func (a HTTPAction) Definition() assistant.ActionDefinition {
+	return a.definition
+}
+
+// StatusMessage returns the declared status message, or one derived from the action name.
+func (a HTTPAction) StatusMessage() string {
+	if a.statusMessage != "" {
+		return a.statusMessage
+	}
+	return "⏳ Running " + a.definition.Name + "..."
+}
+
+// Renderer reports that declarative actions do not expose a deterministic renderer.
+func (a HTTPAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Execute validates the call arguments, calls the backend and returns its response as a tool message.
+func (a HTTPAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	args, err := parseArguments(call.Input)
+	if err != nil {
+		return actionErrorMessage(call, "invalid_arguments", err.Error())
+	}
+	if err := validateArguments(a.definition.Input, args); err != nil {
+		return actionErrorMessage(call, "invalid_arguments", err.Error())
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, a.backend.timeout)
+	defer cancel()
+
+	req, err := a.newRequest(callCtx, args)
+	if err != nil {
+		return actionErrorMessage(call, "invalid_request", err.Error())
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return actionErrorMessage(call, "http_call_error", err.Error())
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
+	if err != nil {
+		return actionErrorMessage(call, "http_call_error", err.Error())
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body = body[:min(len(body), maxResponseBytes)]
+		return actionErrorMessage(call, "http_error", fmt.Sprintf("backend responded %d: %s", resp.StatusCode, strings.TrimSpace(string(body))))
+	}
+	return assistant.NewActionResultMessage(call, responseData(resp.Header.Get("Content-Type"), body, a.response))
+}
+
+// newRequest maps args into the backend request.
+func (a HTTPAction) newRequest(ctx context.Context, args map[string]any) (*http.Request, error) {
+	method := a.backend.method
+	if a.backend.methodTmpl != nil {
+		rendered, err := renderTemplate(a.backend.methodTmpl, args)
+		if err != nil {
+			return nil, err
+		}
+		method = strings.ToUpper(rendered)
+		if err := validateMethod(method); err != nil {
+			return nil, err
+		}
+	}
+
+	target := *a.backend.url
+	if a.backend.urlTmpl != nil {
+		rendered, err := renderTemplate(a.backend.urlTmpl, pathEscaped(args))
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := url.Parse(rendered)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rendered url: %w", err)
+		}
+		if parsed.Scheme != a.backend.url.Scheme || parsed.Host != a.backend.url.Host {
+			return nil, errors.New("rendered url must keep the configured scheme and host")
+		}
+		target = *parsed
+	}
+
+	remaining := make(map[string]any, len(args))
+	for name, value := range args {
+		if !a.backend.urlFields[name] {
+			remaining[name] = value
+		}
+	}
+
+	var body io.Reader
+	switch {
+	case !sendsBody(method):
+		query := target.Query()
+		for name, value := range remaining {
+			query.Set(name, stringify(value))
+		}
+		target.RawQuery = query.Encode()
+	case a.backend.bodyTmpl != nil:
+		rendered, err := renderTemplate(a.backend.bodyTmpl, args)
+		if err != nil {
+			return nil, err
+		}
+		if !json.Valid([]byte(rendered)) {
+			return nil, errors.New("body template did not render valid JSON")
+		}
+		body = strings.NewReader(rendered)
+	default:
+		encoded, err := json.Marshal(remaining)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = a.backend.headers.Clone()
+	if a.backend.auth.Secret != "" {
+		// The secret is read on every call, so a rotated credential applies after a configuration reload.
+		value, err := a.backend.auth.headerValue(ctx, a.lookup)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(a.backend.auth.Header, value)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json, text/plain")
+	return req, nil
+}
+
+// parseArguments decodes the call input, which must be one JSON object.
+func parseArguments(input string) (map[string]any, error) {
+	if strings.TrimSpace(input) == "" {
+		return map[string]any{}, nil
+	}
+
+	var args map[string]any
+	if err := json.Unmarshal([]byte(input), &args); err != nil {
+		return nil, errors.New("action arguments must be a JSON object")
+	}
+	if args == nil {
+		args = map[string]any{}
+	}
+	return args, nil
+}
+
+// responseData converts the backend response into the data of the action result: JSON is decoded,
+// keeping only fields when set, and everything else is passed through as text. Empty responses have
+// no data and responses over maxResponseBytes are truncated text.
+func responseData(contentType string, body []byte, fields []responseField) any {
+	if len(body) > maxResponseBytes {
+		return strings.TrimSpace(string(body[:maxResponseBytes])) + " …(truncated)"
+	}
+	if strings.Contains(contentType, "json") {
+		var payload any
+		if err := json.Unmarshal(body, &payload); err == nil {
+			if len(fields) > 0 {
+				payload = mapResponse(fields, payload)
+			}
+			return payload
+		}
+	}
+	if text := strings.TrimSpace(string(body)); text != "" {
+		return text
+	}
+	return nil
+}
+
+// actionErrorMessage builds a tool message carrying a standardized action error.
+func actionErrorMessage(call assistant.ActionCall, code, details string) assistant.Message {
+	return assistant.NewActionErrorMessage(call, code, details, "")
+}
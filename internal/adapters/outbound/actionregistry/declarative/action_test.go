@@ -0,0 +1,235 @@
+package declarative
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPAction_Execute(t *testing.T) {
+	t.Parallel()
+
+	input := assistant.ActionInput{
+		Type: "object",
+		Fields: map[string]assistant.ActionField{
+			"number": {Type: "string", Required: true},
+			"limit":  {Type: "integer"},
+		},
+	}
+	lookup := func(_ context.Context, name string) (string, error) {
+		if name == "BILLING_TOKEN" {
+			return "rotated", nil
+		}
+		return "", errors.New("not found")
+	}
+	// SERVER in the configured URLs is replaced with the test server URL.
+	invoicesURL := "SERVER/invoices?source=assistant"
+
+	tests := map[string]struct {
+		cfg             httpConfig
+		response        []responseField
+		callInput       string
+		handler         http.HandlerFunc
+		expectedMessage assistant.Message
+	}{
+		"post-sends-json-body": {
+			cfg:       httpConfig{URL: invoicesURL, Headers: map[string]string{"Authorization": "Bearer secret"}},
+			callInput: `{"number":"INV-1","limit":2}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"number":"INV-1","limit":2}`, string(body))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"number":"INV-1","status":"paid"}`))
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, map[string]any{"number": "INV-1", "status": "paid"}),
+		},
+		"get-sends-query-parameters": {
+			cfg:       httpConfig{Method: http.MethodGet, URL: invoicesURL},
+			callInput: `{"number":"INV-1","limit":2}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, url.Values{"number": {"INV-1"}, "limit": {"2"}, "source": {"assistant"}}, r.URL.Query())
+				_, _ = w.Write([]byte("invoice INV-1 is paid\n"))
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, "invoice INV-1 is paid"),
+		},
+		"url-template-consumes-arguments": {
+			cfg:       httpConfig{Method: http.MethodGet, URL: "SERVER/invoices/{{ .number }}"},
+			callInput: `{"number":"INV 1/../2","limit":2}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/invoices/INV%201%2F..%2F2", r.URL.EscapedPath())
+				assert.Equal(t, url.Values{"limit": {"2"}}, r.URL.Query())
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, nil),
+		},
+		"body-template": {
+			cfg: httpConfig{
+				Method: http.MethodPut,
+				URL:    invoicesURL,
+				Body:   `{"invoice": {"id": {{ json .number }}}, "max": {{ default 10 .limit | json }}}`,
+			},
+			callInput: `{"number":"INV-1"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"invoice":{"id":"INV-1"},"max":10}`, string(body))
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, nil),
+		},
+		"body-template-escapes-injected-fields": {
+			cfg:       httpConfig{URL: invoicesURL, Body: `{"id": {{ json .number }}, "admin": false}`},
+			callInput: `{"number":"INV-1\", \"admin\": true, \"note\": \""}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"id":"INV-1\", \"admin\": true, \"note\": \"","admin":false}`, string(body))
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, nil),
+		},
+		"body-template-invalid-json": {
+			cfg:       httpConfig{URL: invoicesURL, Body: `{"id": {{ json .number }},}`},
+			callInput: `{"number":"INV-1"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("backend must not be called")
+			},
+			expectedMessage: assistant.NewActionErrorMessage(assistant.ActionCall{ID: "call-1"}, "invalid_request", "body template did not render valid JSON", ""),
+		},
+		"templated-method": {
+			cfg:       httpConfig{Method: "{{ if .limit }}patch{{ else }}delete{{ end }}", URL: invoicesURL},
+			callInput: `{"number":"INV-1"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "INV-1", r.URL.Query().Get("number"))
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, nil),
+		},
+		"auth-header-from-secret": {
+			cfg:       httpConfig{URL: invoicesURL, Auth: authConfig{Secret: "BILLING_TOKEN"}},
+			callInput: `{"number":"INV-1"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer rotated", r.Header.Get("Authorization"))
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, nil),
+		},
+		"response-fields": {
+			cfg:       httpConfig{URL: invoicesURL},
+			response:  []responseField{{name: "status", path: []string{"invoice", "status"}}},
+			callInput: `{"number":"INV-1"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"invoice":{"number":"INV-1","status":"paid","customer":"acme"}}`))
+			},
+			expectedMessage: assistant.NewActionResultMessage(assistant.ActionCall{ID: "call-1"}, map[string]any{"status": "paid"}),
+		},
+		"backend-error": {
+			cfg:       httpConfig{URL: invoicesURL},
+			callInput: `{"number":"INV-1"}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "invoice not found", http.StatusNotFound)
+			},
+			expectedMessage: assistant.NewActionErrorMessage(assistant.ActionCall{ID: "call-1"}, "http_error", "backend responded 404: invoice not found", ""),
+		},
+		"invalid-arguments": {
+			cfg:       httpConfig{URL: invoicesURL},
+			callInput: `{"limit":2}`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("backend must not be called")
+			},
+			expectedMessage: assistant.NewActionErrorMessage(assistant.ActionCall{ID: "call-1"}, "invalid_arguments", "number is required", ""),
+		},
+		"non-object-arguments": {
+			cfg:       httpConfig{URL: invoicesURL},
+			callInput: `["INV-1"]`,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("backend must not be called")
+			},
+			expectedMessage: assistant.NewActionErrorMessage(assistant.ActionCall{ID: "call-1"}, "invalid_arguments", "action arguments must be a JSON object", ""),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			t.Cleanup(server.Close)
+
+			cfg := tt.cfg
+			cfg.URL = strings.Replace(cfg.URL, "SERVER", server.URL, 1)
+			backend, err := cfg.toBackend(t.Context(), lookup)
+			require.NoError(t, err)
+			action := HTTPAction{
+				definition: assistant.ActionDefinition{Name: "lookup_invoice", Input: input},
+				backend:    backend,
+				response:   tt.response,
+				client:     server.Client(),
+				lookup:     lookup,
+			}
+
+			got := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "lookup_invoice", Input: tt.callInput}, nil)
+			assert.Equal(t, tt.expectedMessage, got)
+		})
+	}
+}
+
+func TestResponseData(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		contentType string
+		body        []byte
+		fields      []responseField
+		expected    any
+	}{
+		"json-decoded": {
+			contentType: "application/json; charset=utf-8",
+			body:        []byte(`{"status":"paid"}`),
+			expected:    map[string]any{"status": "paid"},
+		},
+		"json-fields": {
+			contentType: "application/json",
+			body:        []byte(`{"invoice":{"number":"INV-1","total":12.5},"lines":[{"sku":"A"},{"sku":"B"}]}`),
+			fields: []responseField{
+				{name: "number", path: []string{"invoice", "number"}},
+				{name: "skus", path: []string{"lines[]", "sku"}},
+				{name: "status", path: []string{"status"}},
+			},
+			expected: map[string]any{"number": "INV-1", "skus": []any{"A", "B"}},
+		},
+		"invalid-json-as-text": {
+			contentType: "application/json",
+			body:        []byte(`{"status":`),
+			expected:    `{"status":`,
+		},
+		"truncated": {
+			contentType: "text/plain",
+			body:        []byte(strings.Repeat("a", maxResponseBytes+1)),
+			expected:    strings.Repeat("a", maxResponseBytes) + " …(truncated)",
+		},
+		"empty-body": {
+			contentType: "text/plain",
+			body:        []byte("  \n"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, responseData(tt.contentType, tt.body, tt.fields))
+		})
+	}
+}
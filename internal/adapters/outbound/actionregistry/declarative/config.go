@@ -0,0 +1,286 @@
+package declarative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+const (
+	defaultRequestTimeout = 10 * time.Second
+	maxRequestTimeout     = 2 * time.Minute
+)
+
+// actionNamePattern matches the action names accepted by the model providers.
+var actionNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// actionFile mirrors the structure of one YAML action definition file.
+type actionFile struct {
+	Actions []actionConfig `yaml:"actions"`
+}
+
+// Lookup reads one configuration value by name, such as a secret kept in Vault.
+type Lookup func(ctx context.Context, name string) (string, error)
+
+// actionConfig describes one declarative action backed by an HTTP endpoint.
+type actionConfig struct {
+	Name          string         `yaml:"name"`
+	Description   string         `yaml:"description"`
+	StatusMessage string         `yaml:"status_message"`
+	ReadOnly      bool           `yaml:"read_only"`
+	InputSchema   map[string]any `yaml:"input_schema"`
+	Approval      approvalConfig `yaml:"approval"`
+	HTTP          httpConfig     `yaml:"http"`
+	Response      responseConfig `yaml:"response"`
+}
+
+// approvalConfig configures the human-in-the-loop approval policy of one action.
+type approvalConfig struct {
+	Required      bool     `yaml:"required"`
+	Title         string   `yaml:"title"`
+	Description   string   `yaml:"description"`
+	PreviewFields []string `yaml:"preview_fields"`
+	Timeout       string   `yaml:"timeout"`
+}
+
+// httpConfig describes the HTTP backend an action calls. Method, URL and Body are text/template sources
+// rendered with the call arguments.
+type httpConfig struct {
+	Method  string            `yaml:"method"`
+	URL     string            `yaml:"url"`
+	Body    string            `yaml:"body"`
+	Headers map[string]string `yaml:"headers"`
+	Auth    authConfig        `yaml:"auth"`
+	Timeout string            `yaml:"timeout"`
+}
+
+// authConfig sends a secret read from the configuration as a request header.
+type authConfig struct {
+	// Secret is the configuration key of the credential, e.g. BILLING_API_TOKEN.
+	Secret string `yaml:"secret"`
+	// Header defaults to Authorization.
+	Header string `yaml:"header"`
+	// Scheme prefixes the credential. It defaults to Bearer for the Authorization header.
+	Scheme string `yaml:"scheme"`
+}
+
+// toDefinition validates the action configuration and converts it into a domain action definition.
+func (c actionConfig) toDefinition() (assistant.ActionDefinition, error) {
+	name := strings.TrimSpace(c.Name)
+	if !actionNamePattern.MatchString(name) {
+		return assistant.ActionDefinition{}, fmt.Errorf("invalid action name %q", c.Name)
+	}
+	description := strings.TrimSpace(c.Description)
+	if description == "" {
+		return assistant.ActionDefinition{}, errors.New("description is required")
+	}
+	input, err := schemaToInput(c.InputSchema)
+	if err != nil {
+		return assistant.ActionDefinition{}, fmt.Errorf("invalid input schema: %w", err)
+	}
+	approval, err := c.Approval.toDomain()
+	if err != nil {
+		return assistant.ActionDefinition{}, err
+	}
+
+	return assistant.ActionDefinition{
+		Name:        name,
+		Description: description,
+		Input:       input,
+		Approval:    approval,
+		ReadOnly:    c.ReadOnly,
+	}, nil
+}
+
+// toDomain converts the approval block into a domain approval policy.
+func (c approvalConfig) toDomain() (assistant.ActionApproval, error) {
+	var timeout time.Duration
+	if trimmed := strings.TrimSpace(c.Timeout); trimmed != "" {
+		parsed, err := time.ParseDuration(trimmed)
+		if err != nil || parsed <= 0 {
+			return assistant.ActionApproval{}, fmt.Errorf("invalid approval timeout %q", trimmed)
+		}
+		timeout = parsed
+	}
+
+	var previewFields []string
+	for _, field := range c.PreviewFields {
+		if trimmed := strings.TrimSpace(field); trimmed != "" && !slices.Contains(previewFields, trimmed) {
+			previewFields = append(previewFields, trimmed)
+		}
+	}
+
+	return assistant.ActionApproval{
+		Required:      c.Required,
+		Title:         strings.TrimSpace(c.Title),
+		Description:   strings.TrimSpace(c.Description),
+		PreviewFields: previewFields,
+		Timeout:       timeout,
+	}, nil
+}
+
+// backend is a validated HTTP backend configuration.
+type backend struct {
+	method     string
+	methodTmpl *template.Template
+	url        *url.URL
+	urlTmpl    *template.Template
+	urlFields  map[string]bool
+	bodyTmpl   *template.Template
+	headers    http.Header
+	auth       authConfig
+	timeout    time.Duration
+}
+
+// toBackend validates the HTTP block, expanding ${NAME} references in the URL and header values with lookup.
+func (c httpConfig) toBackend(ctx context.Context, lookup Lookup) (backend, error) {
+	var (
+		b   backend
+		err error
+	)
+
+	b.method = strings.TrimSpace(c.Method)
+	if b.method == "" {
+		b.method = http.MethodPost
+	}
+	if b.methodTmpl, err = parseTemplate("method", b.method); err != nil {
+		return backend{}, err
+	}
+	if b.methodTmpl == nil {
+		b.method = strings.ToUpper(b.method)
+		if err := validateMethod(b.method); err != nil {
+			return backend{}, err
+		}
+	}
+
+	rawURL, err := expand(ctx, strings.TrimSpace(c.URL), lookup)
+	if err != nil {
+		return backend{}, fmt.Errorf("http url: %w", err)
+	}
+	if b.urlTmpl, err = parseTemplate("url", rawURL); err != nil {
+		return backend{}, err
+	}
+	baseURL := rawURL
+	if b.urlTmpl != nil {
+		// The scheme and host must not depend on the arguments, so they are checked without any.
+		if baseURL, err = renderTemplate(b.urlTmpl, map[string]any{}); err != nil {
+			return backend{}, err
+		}
+	}
+	b.url, err = url.Parse(baseURL)
+	if err != nil || (b.url.Scheme != "http" && b.url.Scheme != "https") || b.url.Host == "" {
+		return backend{}, fmt.Errorf("http url must be an absolute http or https URL, got %q", c.URL)
+	}
+	b.urlFields = templateFields(b.urlTmpl)
+
+	if body := strings.TrimSpace(c.Body); body != "" {
+		if b.bodyTmpl, err = template.New("body").Funcs(templateFuncs).Parse(body); err != nil {
+			return backend{}, fmt.Errorf("invalid body template: %w", err)
+		}
+		if err := checkJSONOutputs(b.bodyTmpl); err != nil {
+			return backend{}, err
+		}
+		if !sendsBody(b.method) {
+			return backend{}, fmt.Errorf("http body is not sent with %s requests", b.method)
+		}
+	}
+
+	b.headers = http.Header{}
+	for name, value := range c.Headers {
+		expanded, err := expand(ctx, value, lookup)
+		if err != nil {
+			return backend{}, fmt.Errorf("http header %q: %w", name, err)
+		}
+		b.headers.Set(strings.TrimSpace(name), expanded)
+	}
+
+	if b.auth, err = c.Auth.normalize(ctx, lookup); err != nil {
+		return backend{}, err
+	}
+
+	b.timeout = defaultRequestTimeout
+	if trimmed := strings.TrimSpace(c.Timeout); trimmed != "" {
+		parsed, err := time.ParseDuration(trimmed)
+		if err != nil || parsed <= 0 || parsed > maxRequestTimeout {
+			return backend{}, fmt.Errorf("http timeout must be a duration up to %s, got %q", maxRequestTimeout, trimmed)
+		}
+		b.timeout = parsed
+	}
+	return b, nil
+}
+
+// validateMethod rejects the HTTP methods an action cannot use.
+func validateMethod(method string) error {
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return nil
+	default:
+		return fmt.Errorf("unsupported http method %q", method)
+	}
+}
+
+// sendsBody reports whether requests with method carry the arguments as a JSON body rather than as
+// query parameters.
+func sendsBody(method string) bool {
+	return method != http.MethodGet && method != http.MethodDelete
+}
+
+// normalize applies the auth header defaults and checks the secret can be read, so a missing credential
+// fails at startup rather than on the first call.
+func (c authConfig) normalize(ctx context.Context, lookup Lookup) (authConfig, error) {
+	c.Secret = strings.TrimSpace(c.Secret)
+	if c.Secret == "" {
+		return authConfig{}, nil
+	}
+	c.Header = strings.TrimSpace(c.Header)
+	if c.Header == "" {
+		c.Header = "Authorization"
+	}
+	c.Scheme = strings.TrimSpace(c.Scheme)
+	if c.Scheme == "" && strings.EqualFold(c.Header, "Authorization") {
+		c.Scheme = "Bearer"
+	}
+	if _, err := lookup(ctx, c.Secret); err != nil {
+		return authConfig{}, fmt.Errorf("http auth: failed to resolve %s: %w", c.Secret, err)
+	}
+	return c, nil
+}
+
+// headerValue reads the secret and formats the auth header value.
+func (c authConfig) headerValue(ctx context.Context, lookup Lookup) (string, error) {
+	secret, err := lookup(ctx, c.Secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", c.Secret, err)
+	}
+	if c.Scheme == "" {
+		return secret, nil
+	}
+	return c.Scheme + " " + secret, nil
+}
+
+// referencePattern matches ${NAME} references in URLs and header values.
+var referencePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expand replaces every ${NAME} reference in value with its configuration value, so secrets stay in Vault
+// instead of the YAML files.
+func expand(ctx context.Context, value string, lookup Lookup) (string, error) {
+	var err error
+	expanded := referencePattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := referencePattern.FindStringSubmatch(ref)[1]
+		resolved, lookupErr := lookup(ctx, name)
+		if lookupErr != nil && err == nil {
+			err = fmt.Errorf("failed to resolve %s: %w", name, lookupErr)
+		}
+		return resolved
+	})
+	return expanded, err
+}
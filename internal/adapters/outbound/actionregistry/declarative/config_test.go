@@ -0,0 +1,146 @@
+package declarative
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPConfig_ToBackend(t *testing.T) {
+	t.Parallel()
+
+	lookup := func(_ context.Context, name string) (string, error) {
+		if name == "MISSING" {
+			return "", errors.New("not found")
+		}
+		return "value-of-" + name, nil
+	}
+
+	tests := map[string]struct {
+		cfg            httpConfig
+		expectedMethod string
+		expectedURL    string
+		expectedErr    string
+	}{
+		"defaults-to-post": {
+			cfg:            httpConfig{URL: "https://example.com/hooks"},
+			expectedMethod: "POST",
+			expectedURL:    "https://example.com/hooks",
+		},
+		"expands-references": {
+			cfg:            httpConfig{Method: "patch", URL: "https://${HOST}/items"},
+			expectedMethod: "PATCH",
+			expectedURL:    "https://value-of-HOST/items",
+		},
+		"unsupported-method": {
+			cfg:         httpConfig{Method: "TRACE", URL: "https://example.com"},
+			expectedErr: `unsupported http method "TRACE"`,
+		},
+		"relative-url": {
+			cfg:         httpConfig{URL: "/items"},
+			expectedErr: `http url must be an absolute http or https URL, got "/items"`,
+		},
+		"non-http-url": {
+			cfg:         httpConfig{URL: "file:///etc/passwd"},
+			expectedErr: `http url must be an absolute http or https URL, got "file:///etc/passwd"`,
+		},
+		"templated-path": {
+			cfg:            httpConfig{Method: "get", URL: "https://${HOST}/items/{{ .id }}"},
+			expectedMethod: "GET",
+			expectedURL:    "https://value-of-HOST/items/",
+		},
+		"templated-method": {
+			cfg:            httpConfig{Method: "{{ if .id }}put{{ else }}post{{ end }}", URL: "https://example.com"},
+			expectedMethod: "{{ if .id }}put{{ else }}post{{ end }}",
+			expectedURL:    "https://example.com",
+		},
+		"templated-host": {
+			cfg:         httpConfig{URL: "https://{{ .host }}/items"},
+			expectedErr: `http url must be an absolute http or https URL, got "https://{{ .host }}/items"`,
+		},
+		"invalid-template": {
+			cfg:         httpConfig{URL: "https://example.com/items/{{ .id"},
+			expectedErr: "invalid url template: template: url:1: unclosed action",
+		},
+		"body-with-get": {
+			cfg:         httpConfig{Method: "GET", URL: "https://example.com", Body: `{"id": {{ json .id }}}`},
+			expectedErr: "http body is not sent with GET requests",
+		},
+		"body-prints-raw-value": {
+			cfg:         httpConfig{URL: "https://example.com", Body: `{"id": "{{ .id }}"}`},
+			expectedErr: "body template must print values with the json function, got {{.id}}",
+		},
+		"unresolved-auth-secret": {
+			cfg:         httpConfig{URL: "https://example.com", Auth: authConfig{Secret: "MISSING"}},
+			expectedErr: "http auth: failed to resolve MISSING: not found",
+		},
+		"timeout-over-limit": {
+			cfg:         httpConfig{URL: "https://example.com", Timeout: "5m"},
+			expectedErr: `http timeout must be a duration up to 2m0s, got "5m"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.cfg.toBackend(t.Context(), lookup)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMethod, got.method)
+			assert.Equal(t, tt.expectedURL, got.url.String())
+		})
+	}
+}
+
+func TestActionConfig_ToDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cfg         actionConfig
+		expectedErr string
+	}{
+		"valid": {
+			cfg: actionConfig{Name: "lookup_invoice", Description: "Look up one invoice."},
+		},
+		"invalid-name": {
+			cfg:         actionConfig{Name: "lookup invoice", Description: "Look up one invoice."},
+			expectedErr: `invalid action name "lookup invoice"`,
+		},
+		"invalid-approval-timeout": {
+			cfg: actionConfig{
+				Name:        "lookup_invoice",
+				Description: "Look up one invoice.",
+				Approval:    approvalConfig{Required: true, Timeout: "soon"},
+			},
+			expectedErr: `invalid approval timeout "soon"`,
+		},
+		"invalid-input-schema": {
+			cfg: actionConfig{
+				Name:        "lookup_invoice",
+				Description: "Look up one invoice.",
+				InputSchema: map[string]any{"type": "array"},
+			},
+			expectedErr: "invalid input schema: input type must be object, got array",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.cfg.toDefinition()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
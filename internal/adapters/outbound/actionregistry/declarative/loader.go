@@ -0,0 +1,94 @@
+package declarative
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"go.yaml.in/yaml/v3"
+)
+
+// LoadActionsFromFS reads every .yaml and .yml file of actionsFS and returns the HTTP actions they declare,
+// sorted by name. lookup resolves the ${NAME} references of URLs and headers and the auth secrets. Any
+// invalid definition or duplicated name fails the whole load, so a broken file is noticed at startup.
+func LoadActionsFromFS(ctx context.Context, actionsFS fs.FS, client *http.Client, lookup Lookup) ([]assistant.Action, error) {
+	if actionsFS == nil {
+		return nil, errors.New("actions fs is nil")
+	}
+	if client == nil {
+		return nil, errors.New("http client is nil")
+	}
+
+	sources := map[string]string{}
+	actions := make([]assistant.Action, 0)
+	err := fs.WalkDir(actionsFS, ".", func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		lower := strings.ToLower(path)
+		if d.IsDir() || !(strings.HasSuffix(lower, ".yaml") || strings.HasSuffix(lower, ".yml")) {
+			return nil
+		}
+
+		content, err := fs.ReadFile(actionsFS, path)
+		if err != nil {
+			return fmt.Errorf("failed to read action file %q: %w", path, err)
+		}
+		var file actionFile
+		if err := yaml.Unmarshal(content, &file); err != nil {
+			return fmt.Errorf("failed to parse action file %q: %w", path, err)
+		}
+
+		for idx, cfg := range file.Actions {
+			action, err := newHTTPAction(ctx, cfg, client, lookup)
+			if err != nil {
+				return fmt.Errorf("action file %q, action %d: %w", path, idx, err)
+			}
+			name := action.definition.Name
+			if source, exists := sources[name]; exists {
+				return fmt.Errorf("action file %q: action %q is already declared in %q", path, name, source)
+			}
+			sources[name] = path
+			actions = append(actions, action)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Definition().Name < actions[j].Definition().Name
+	})
+	return actions, nil
+}
+
+// newHTTPAction validates one action configuration and builds its HTTPAction.
+func newHTTPAction(ctx context.Context, cfg actionConfig, client *http.Client, lookup Lookup) (HTTPAction, error) {
+	definition, err := cfg.toDefinition()
+	if err != nil {
+		return HTTPAction{}, err
+	}
+	backend, err := cfg.HTTP.toBackend(ctx, lookup)
+	if err != nil {
+		return HTTPAction{}, fmt.Errorf("%s: %w", definition.Name, err)
+	}
+	response, err := cfg.Response.toFields()
+	if err != nil {
+		return HTTPAction{}, fmt.Errorf("%s: %w", definition.Name, err)
+	}
+
+	return HTTPAction{
+		definition:    definition,
+		statusMessage: strings.TrimSpace(cfg.StatusMessage),
+		backend:       backend,
+		response:      response,
+		client:        client,
+		lookup:        lookup,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package declarative
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const invoiceActions = `
+actions:
+  - name: lookup_invoice
+    description: Look up one invoice by number.
+    status_message: 🧾 Looking up the invoice...
+    read_only: true
+    input_schema:
+      type: object
+      properties:
+        number:
+          type: string
+          description: Invoice number.
+      required: [number]
+    http:
+      method: get
+      url: https://billing.example.com/invoices?source=${BILLING_SOURCE}
+      headers:
+        Authorization: Bearer ${BILLING_TOKEN}
+      timeout: 5s
+  - name: refund_invoice
+    description: Refund one invoice.
+    approval:
+      required: true
+      title: Confirm refund
+      preview_fields: [number, number]
+      timeout: 60s
+    input_schema:
+      type: object
+      properties:
+        number:
+          type: string
+    http:
+      url: https://billing.example.com/refunds
+`
+
+func TestLoadActionsFromFS(t *testing.T) {
+	t.Parallel()
+
+	lookup := func(_ context.Context, name string) (string, error) {
+		values := map[string]string{"BILLING_TOKEN": "secret", "BILLING_SOURCE": "assistant"}
+		if value, ok := values[name]; ok {
+			return value, nil
+		}
+		return "", errors.New("not found")
+	}
+
+	tests := map[string]struct {
+		files       fstest.MapFS
+		assertLoad  func(t *testing.T, actions []assistant.Action)
+		expectedErr string
+	}{
+		"loads-yaml-files": {
+			files: fstest.MapFS{
+				"billing/invoices.yaml": {Data: []byte(invoiceActions)},
+				"billing/skill.md":      {Data: []byte("not an action file")},
+				"empty.yml":             {Data: []byte("actions: []")},
+			},
+			assertLoad: func(t *testing.T, actions []assistant.Action) {
+				require.Len(t, actions, 2)
+
+				lookupInvoice := actions[0].(HTTPAction)
+				assert.Equal(t, assistant.ActionDefinition{
+					Name:        "lookup_invoice",
+					Description: "Look up one invoice by number.",
+					Input: assistant.ActionInput{
+						Type: "object",
+						Fields: map[string]assistant.ActionField{
+							"number": {Type: "string", Description: "Invoice number.", Required: true},
+						},
+					},
+					ReadOnly: true,
+				}, lookupInvoice.Definition())
+				assert.Equal(t, "🧾 Looking up the invoice...", lookupInvoice.StatusMessage())
+				assert.Equal(t, http.MethodGet, lookupInvoice.backend.method)
+				assert.Equal(t, "https://billing.example.com/invoices?source=assistant", lookupInvoice.backend.url.String())
+				assert.Equal(t, "Bearer secret", lookupInvoice.backend.headers.Get("Authorization"))
+				assert.Equal(t, 5*time.Second, lookupInvoice.backend.timeout)
+
+				refund := actions[1].(HTTPAction)
+				assert.Equal(t, assistant.ActionApproval{
+					Required:      true,
+					Title:         "Confirm refund",
+					PreviewFields: []string{"number"},
+					Timeout:       time.Minute,
+				}, refund.Definition().Approval)
+				assert.Equal(t, "⏳ Running refund_invoice...", refund.StatusMessage())
+				assert.Equal(t, http.MethodPost, refund.backend.method)
+				assert.Equal(t, defaultRequestTimeout, refund.backend.timeout)
+			},
+		},
+		"duplicated-name": {
+			files: fstest.MapFS{
+				"a.yaml": {Data: []byte(invoiceActions)},
+				"b.yaml": {Data: []byte(invoiceActions)},
+			},
+			expectedErr: `action file "b.yaml": action "lookup_invoice" is already declared in "a.yaml"`,
+		},
+		"invalid-yaml": {
+			files: fstest.MapFS{
+				"a.yaml": {Data: []byte("actions: [")},
+			},
+			expectedErr: `failed to parse action file "a.yaml"`,
+		},
+		"missing-description": {
+			files: fstest.MapFS{
+				"a.yaml": {Data: []byte("actions:\n  - name: ping\n    http:\n      url: https://example.com\n")},
+			},
+			expectedErr: `action file "a.yaml", action 0: description is required`,
+		},
+		"unresolved-reference": {
+			files: fstest.MapFS{
+				"a.yaml": {Data: []byte("actions:\n  - name: ping\n    description: Ping.\n    http:\n      url: https://example.com\n      headers:\n        X-Key: ${UNKNOWN}\n")},
+			},
+			expectedErr: `action file "a.yaml", action 0: ping: http header "X-Key": failed to resolve UNKNOWN: not found`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actions, err := LoadActionsFromFS(t.Context(), tt.files, http.DefaultClient, lookup)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.assertLoad(t, actions)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// responseFieldPattern matches response field paths such as status, invoice.total or items[].title.
+var responseFieldPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(\[\])?(\.[A-Za-z0-9_-]+(\[\])?)*$`)
+
+// responseConfig configures how the JSON response of the backend is mapped into the tool message.
+type responseConfig struct {
+	// Fields maps each tool result field to a path in the JSON response. Empty passes the whole response.
+	Fields map[string]string `yaml:"fields"`
+}
+
+// responseField is one validated response field mapping.
+type responseField struct {
+	name string
+	path []string
+}
+
+// toFields validates the response field paths and returns them sorted by result field name.
+func (c responseConfig) toFields() ([]responseField, error) {
+	fields := make([]responseField, 0, len(c.Fields))
+	for name, path := range c.Fields {
+		name = strings.TrimSpace(name)
+		path = strings.TrimSpace(path)
+		if name == "" {
+			return nil, errors.New("response field names must not be empty")
+		}
+		if !responseFieldPattern.MatchString(path) {
+			return nil, fmt.Errorf("invalid response field path %q", path)
+		}
+		fields = append(fields, responseField{name: name, path: strings.Split(path, ".")})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].name < fields[j].name
+	})
+	return fields, nil
+}
+
+// mapResponse picks the configured fields out of the decoded JSON response. Paths missing from the
+// response are left out of the result.
+func mapResponse(fields []responseField, payload any) map[string]any {
+	mapped := make(map[string]any, len(fields))
+	for _, field := range fields {
+		if value, ok := selectPath(payload, field.path); ok {
+			mapped[field.name] = value
+		}
+	}
+	return mapped
+}
+
+// selectPath walks path through value. A segment ending in [] maps the rest of the path over an array.
+func selectPath(value any, path []string) (any, bool) {
+	if len(path) == 0 {
+		return value, true
+	}
+
+	key, isArray := strings.CutSuffix(path[0], "[]")
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	next, ok := object[key]
+	if !ok {
+		return nil, false
+	}
+	if !isArray {
+		return selectPath(next, path[1:])
+	}
+
+	items, ok := next.([]any)
+	if !ok {
+		return nil, false
+	}
+	selected := make([]any, 0, len(items))
+	for _, item := range items {
+		if picked, ok := selectPath(item, path[1:]); ok {
+			selected = append(selected, picked)
+		}
+	}
+	return selected, true
+}
@@ -0,0 +1,63 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseConfig_ToFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cfg         responseConfig
+		expected    []responseField
+		expectedErr string
+	}{
+		"sorted-fields": {
+			cfg: responseConfig{Fields: map[string]string{"status": "invoice.status", "skus": "lines[].sku"}},
+			expected: []responseField{
+				{name: "skus", path: []string{"lines[]", "sku"}},
+				{name: "status", path: []string{"invoice", "status"}},
+			},
+		},
+		"invalid-path": {
+			cfg:         responseConfig{Fields: map[string]string{"status": "invoice..status"}},
+			expectedErr: `invalid response field path "invoice..status"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.cfg.toFields()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMapResponse(t *testing.T) {
+	t.Parallel()
+
+	payload := map[string]any{
+		"invoice": map[string]any{"status": "paid"},
+		"lines":   []any{map[string]any{"sku": "A"}, map[string]any{"qty": float64(1)}, "bad"},
+	}
+	fields := []responseField{
+		{name: "status", path: []string{"invoice", "status"}},
+		{name: "skus", path: []string{"lines[]", "sku"}},
+		{name: "missing", path: []string{"invoice", "total"}},
+		{name: "not_array", path: []string{"invoice[]", "status"}},
+	}
+
+	assert.Equal(t, map[string]any{
+		"status": "paid",
+		"skus":   []any{"A"},
+	}, mapResponse(fields, payload))
+}
@@ -0,0 +1,202 @@
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// schemaTypes lists the JSON Schema types a declarative action field may use.
+var schemaTypes = []string{"string", "integer", "number", "boolean", "array", "object"}
+
+// schemaToInput converts the JSON Schema of an action input into the domain action input format.
+// An empty schema declares an action without arguments.
+func schemaToInput(schema map[string]any) (assistant.ActionInput, error) {
+	input := assistant.ActionInput{
+		Type:   "object",
+		Fields: map[string]assistant.ActionField{},
+	}
+	if len(schema) == 0 {
+		return input, nil
+	}
+	if schemaType, ok := schema["type"]; ok && schemaType != "object" {
+		return assistant.ActionInput{}, fmt.Errorf("input type must be object, got %v", schemaType)
+	}
+
+	fields, err := schemaProperties(schema)
+	if err != nil {
+		return assistant.ActionInput{}, err
+	}
+	input.Fields = fields
+	return input, nil
+}
+
+// schemaProperties converts the properties of one object schema, marking the fields listed in required.
+func schemaProperties(schema map[string]any) (map[string]assistant.ActionField, error) {
+	props, ok := schema["properties"].(map[string]any)
+	if !ok {
+		if _, present := schema["properties"]; present {
+			return nil, errors.New("properties must be a map")
+		}
+		return map[string]assistant.ActionField{}, nil
+	}
+
+	required := map[string]bool{}
+	if list, ok := schema["required"].([]any); ok {
+		for _, name := range list {
+			nameStr, _ := name.(string)
+			if _, declared := props[nameStr]; !declared {
+				return nil, fmt.Errorf("required field %v is not declared in properties", name)
+			}
+			required[nameStr] = true
+		}
+	}
+
+	fields := make(map[string]assistant.ActionField, len(props))
+	for name, raw := range props {
+		fieldSchema, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("field %q must be a schema", name)
+		}
+		field, err := schemaField(fieldSchema, required[name])
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+		fields[name] = field
+	}
+	return fields, nil
+}
+
+// schemaField converts one field schema into a domain ActionField recursively.
+func schemaField(schema map[string]any, required bool) (assistant.ActionField, error) {
+	fieldType, _ := schema["type"].(string)
+	if !slices.Contains(schemaTypes, fieldType) {
+		return assistant.ActionField{}, fmt.Errorf("unsupported type %v", schema["type"])
+	}
+	description, _ := schema["description"].(string)
+	format, _ := schema["format"].(string)
+
+	field := assistant.ActionField{
+		Type:        fieldType,
+		Description: strings.TrimSpace(description),
+		Required:    required,
+		Format:      strings.TrimSpace(format),
+	}
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		field.Enum = enum
+	}
+
+	switch fieldType {
+	case "object":
+		fields, err := schemaProperties(schema)
+		if err != nil {
+			return assistant.ActionField{}, err
+		}
+		field.Fields = fields
+	case "array":
+		itemsSchema, ok := schema["items"].(map[string]any)
+		if !ok {
+			return assistant.ActionField{}, errors.New("array fields must declare items")
+		}
+		items, err := schemaField(itemsSchema, false)
+		if err != nil {
+			return assistant.ActionField{}, fmt.Errorf("items: %w", err)
+		}
+		field.Items = &items
+	}
+	return field, nil
+}
+
+// validateArguments checks the decoded call arguments against the action input, so the backend only
+// receives the fields it declared with the types it expects.
+func validateArguments(input assistant.ActionInput, args map[string]any) error {
+	return validateFields(input.Fields, args, "")
+}
+
+// validateFields validates one object value against its declared fields.
+func validateFields(fields map[string]assistant.ActionField, values map[string]any, path string) error {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := fields[name]
+		value, present := values[name]
+		if !present || value == nil {
+			if field.Required {
+				return fmt.Errorf("%s is required", path+name)
+			}
+			continue
+		}
+		if err := validateValue(field, value, path+name); err != nil {
+			return err
+		}
+	}
+	for name := range values {
+		if _, declared := fields[name]; !declared {
+			return fmt.Errorf("%s is not a known field", path+name)
+		}
+	}
+	return nil
+}
+
+// validateValue validates one JSON-decoded value against its field declaration.
+func validateValue(field assistant.ActionField, value any, path string) error {
+	switch field.Type {
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s must be a string", path)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return fmt.Errorf("%s must be an integer", path)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s must be a number", path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be an array", path)
+		}
+		if field.Items != nil {
+			for idx, item := range items {
+				if err := validateValue(*field.Items, item, fmt.Sprintf("%s[%d]", path, idx)); err != nil {
+					return err
+				}
+			}
+		}
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s must be an object", path)
+		}
+		// Objects without declared properties accept any fields.
+		if len(field.Fields) == 0 {
+			break
+		}
+		if err := validateFields(field.Fields, object, path+"."); err != nil {
+			return err
+		}
+	}
+
+	if len(field.Enum) > 0 && !slices.ContainsFunc(field.Enum, func(allowed any) bool {
+		return fmt.Sprint(allowed) == fmt.Sprint(value)
+	}) {
+		return fmt.Errorf("%s must be one of %v", path, field.Enum)
+	}
+	return nil
+}
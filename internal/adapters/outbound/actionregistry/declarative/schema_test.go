@@ -0,0 +1,146 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaToInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		schema      map[string]any
+		expected    assistant.ActionInput
+		expectedErr string
+	}{
+		"empty-schema": {
+			expected: assistant.ActionInput{Type: "object", Fields: map[string]assistant.ActionField{}},
+		},
+		"nested-fields": {
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"priority": map[string]any{"type": "string", "enum": []any{"low", "high"}},
+					"tags":     map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+					"customer": map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"id": map[string]any{"type": "integer"}},
+						"required":   []any{"id"},
+					},
+				},
+				"required": []any{"customer"},
+			},
+			expected: assistant.ActionInput{
+				Type: "object",
+				Fields: map[string]assistant.ActionField{
+					"priority": {Type: "string", Enum: []any{"low", "high"}},
+					"tags":     {Type: "array", Items: &assistant.ActionField{Type: "string"}},
+					"customer": {
+						Type:     "object",
+						Required: true,
+						Fields:   map[string]assistant.ActionField{"id": {Type: "integer", Required: true}},
+					},
+				},
+			},
+		},
+		"undeclared-required-field": {
+			schema: map[string]any{
+				"properties": map[string]any{"id": map[string]any{"type": "string"}},
+				"required":   []any{"name"},
+			},
+			expectedErr: "required field name is not declared in properties",
+		},
+		"unsupported-type": {
+			schema: map[string]any{
+				"properties": map[string]any{"id": map[string]any{"type": "uuid"}},
+			},
+			expectedErr: `field "id": unsupported type uuid`,
+		},
+		"array-without-items": {
+			schema: map[string]any{
+				"properties": map[string]any{"ids": map[string]any{"type": "array"}},
+			},
+			expectedErr: `field "ids": array fields must declare items`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := schemaToInput(tt.schema)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestValidateArguments(t *testing.T) {
+	t.Parallel()
+
+	input := assistant.ActionInput{
+		Type: "object",
+		Fields: map[string]assistant.ActionField{
+			"number":   {Type: "string", Required: true},
+			"limit":    {Type: "integer"},
+			"priority": {Type: "string", Enum: []any{"low", "high"}},
+			"ids":      {Type: "array", Items: &assistant.ActionField{Type: "integer"}},
+			"metadata": {Type: "object"},
+		},
+	}
+
+	tests := map[string]struct {
+		args        map[string]any
+		expectedErr string
+	}{
+		"valid": {
+			args: map[string]any{
+				"number":   "INV-1",
+				"limit":    float64(3),
+				"priority": "high",
+				"ids":      []any{float64(1), float64(2)},
+				"metadata": map[string]any{"free": "form"},
+			},
+		},
+		"missing-required": {
+			args:        map[string]any{"limit": float64(3)},
+			expectedErr: "number is required",
+		},
+		"unknown-field": {
+			args:        map[string]any{"number": "INV-1", "customer": "acme"},
+			expectedErr: "customer is not a known field",
+		},
+		"fractional-integer": {
+			args:        map[string]any{"number": "INV-1", "limit": 1.5},
+			expectedErr: "limit must be an integer",
+		},
+		"value-outside-enum": {
+			args:        map[string]any{"number": "INV-1", "priority": "urgent"},
+			expectedErr: "priority must be one of [low high]",
+		},
+		"invalid-array-item": {
+			args:        map[string]any{"number": "INV-1", "ids": []any{float64(1), "two"}},
+			expectedErr: "ids[1] must be an integer",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateArguments(input, tt.args)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
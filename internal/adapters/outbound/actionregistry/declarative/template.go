@@ -0,0 +1,179 @@
+package declarative
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// templateFuncs are the functions available to method, URL and body templates.
+var templateFuncs = template.FuncMap{
+	// json encodes a value as JSON, e.g. {"ids": {{ json .ids }}}.
+	"json": func(v any) (string, error) {
+		encoded, err := json.Marshal(v)
+		return string(encoded), err
+	},
+	// default returns fallback when v is missing or empty, e.g. {{ default 10 .limit }}.
+	"default": func(fallback, v any) any {
+		if v == nil || v == "" {
+			return fallback
+		}
+		return v
+	},
+}
+
+// parseTemplate parses one request template. It returns nil for sources without template actions, which
+// are used verbatim.
+func parseTemplate(name, source string) (*template.Template, error) {
+	if !strings.Contains(source, "{{") {
+		return nil, nil
+	}
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// renderTemplate renders tmpl with data. Missing arguments render empty.
+func renderTemplate(tmpl *template.Template, data any) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", tmpl.Name(), err)
+	}
+	return strings.TrimSpace(strings.ReplaceAll(sb.String(), "<no value>", "")), nil
+}
+
+// checkJSONOutputs rejects body templates that print a value without the json function, so an argument such as
+// `x", "admin": true` cannot add fields to the request body. Every printed pipeline must end with json, e.g.
+// {{ json .id }} or {{ default 10 .limit | json }}; conditions and declarations print nothing and are not checked.
+func checkJSONOutputs(tmpl *template.Template) error {
+	var walk func(node parse.Node) error
+	walk = func(node parse.Node) error {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return nil
+			}
+			for _, child := range n.Nodes {
+				if err := walk(child); err != nil {
+					return err
+				}
+			}
+		case *parse.ActionNode:
+			if len(n.Pipe.Decl) > 0 {
+				return nil
+			}
+			last := n.Pipe.Cmds[len(n.Pipe.Cmds)-1]
+			if ident, ok := last.Args[0].(*parse.IdentifierNode); !ok || ident.Ident != "json" {
+				return fmt.Errorf("body template must print values with the json function, got %s", n)
+			}
+		case *parse.IfNode:
+			return walkBranches(walk, n.List, n.ElseList)
+		case *parse.RangeNode:
+			return walkBranches(walk, n.List, n.ElseList)
+		case *parse.WithNode:
+			return walkBranches(walk, n.List, n.ElseList)
+		}
+		return nil
+	}
+
+	for _, t := range tmpl.Templates() {
+		if t.Tree == nil {
+			continue
+		}
+		if err := walk(t.Root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkBranches walks the branches of a conditional or loop node.
+func walkBranches(walk func(parse.Node) error, list, elseList *parse.ListNode) error {
+	if err := walk(list); err != nil {
+		return err
+	}
+	return walk(elseList)
+}
+
+// pathEscaped returns a copy of args whose values are escaped for use as URL path segments, so an argument
+// cannot change the host or add path segments to the backend URL.
+func pathEscaped(args map[string]any) map[string]any {
+	escaped := make(map[string]any, len(args))
+	for name, value := range args {
+		escaped[name] = url.PathEscape(stringify(value))
+	}
+	return escaped
+}
+
+// templateFields returns the top-level argument names a template references, e.g. "id" for {{ .id }}.
+func templateFields(tmpl *template.Template) map[string]bool {
+	fields := map[string]bool{}
+	if tmpl == nil || tmpl.Tree == nil {
+		return fields
+	}
+
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.FieldNode:
+			fields[n.Ident[0]] = true
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		}
+	}
+	walk(tmpl.Root)
+	return fields
+}
+
+// stringify renders an argument value as text: strings as is, objects and arrays as JSON.
+func stringify(v any) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case map[string]any, []any:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprint(value)
+	}
+}
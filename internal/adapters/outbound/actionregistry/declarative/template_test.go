@@ -0,0 +1,133 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		source   string
+		expected map[string]bool
+	}{
+		"plain-source": {
+			source:   "https://example.com/items",
+			expected: map[string]bool{},
+		},
+		"fields-and-functions": {
+			source:   "https://example.com/{{ .tenant }}/items/{{ default 1 .page }}",
+			expected: map[string]bool{"tenant": true, "page": true},
+		},
+		"conditionals": {
+			source:   "{{ if .id }}items/{{ .id }}{{ else }}{{ with .parent }}{{ .name }}{{ end }}{{ end }}",
+			expected: map[string]bool{"id": true, "parent": true, "name": true},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := parseTemplate("url", tt.source)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, templateFields(tmpl))
+		})
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		source   string
+		data     map[string]any
+		expected string
+	}{
+		"missing-arguments-render-empty": {
+			source:   "items/{{ .id }}",
+			data:     map[string]any{},
+			expected: "items/",
+		},
+		"json": {
+			source:   `{"tags": {{ json .tags }}}`,
+			data:     map[string]any{"tags": []any{"a", "b"}},
+			expected: `{"tags": ["a","b"]}`,
+		},
+		"default": {
+			source:   "{{ default 10 .limit }}/{{ default 10 .page }}",
+			data:     map[string]any{"page": float64(3)},
+			expected: "10/3",
+		},
+		"path-escaped": {
+			source:   "items/{{ .id }}",
+			data:     pathEscaped(map[string]any{"id": "a/b?c"}),
+			expected: "items/a%2Fb%3Fc",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := parseTemplate("body", tt.source)
+			require.NoError(t, err)
+			got, err := renderTemplate(tmpl, tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCheckJSONOutputs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		source      string
+		expectedErr string
+	}{
+		"json-function": {
+			source: `{"id": {{ json .id }}, "tags": {{ .tags | json }}}`,
+		},
+		"default-piped-to-json": {
+			source: `{"max": {{ json (default 10 .limit) }}, "page": {{ default 1 .page | json }}}`,
+		},
+		"conditions-and-declarations": {
+			source: `{ {{- $id := .id -}} {{ if .id }}"id": {{ json $id }}{{ else }}"new": true{{ end }} }`,
+		},
+		"raw-field": {
+			source:      `{"id": "{{ .id }}"}`,
+			expectedErr: "body template must print values with the json function, got {{.id}}",
+		},
+		"raw-default": {
+			source:      `{"max": {{ default 10 .limit }}}`,
+			expectedErr: "body template must print values with the json function, got {{default 10 .limit}}",
+		},
+		"raw-field-in-branch": {
+			source:      `{ {{ with .parent }}"parent": "{{ .name }}"{{ end }} }`,
+			expectedErr: "body template must print values with the json function, got {{.name}}",
+		},
+		"raw-field-in-defined-template": {
+			source:      `{{ define "id" }}"{{ .id }}"{{ end }}{"id": {{ json .id }}}`,
+			expectedErr: "body template must print values with the json function, got {{.id}}",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := parseTemplate("body", tt.source)
+			require.NoError(t, err)
+			err = checkJSONOutputs(tmpl)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+)
+
+// AcceptFocusBlocksAction is an assistant action that turns suggested focus blocks into firm calendar entries.
+type AcceptFocusBlocksAction struct {
+	focusBlocks todouc.FocusBlocks
+}
+
+// NewAcceptFocusBlocksAction creates a new instance of AcceptFocusBlocksAction.
+func NewAcceptFocusBlocksAction(focusBlocks todouc.FocusBlocks) AcceptFocusBlocksAction {
+	return AcceptFocusBlocksAction{
+		focusBlocks: focusBlocks,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a AcceptFocusBlocksAction) StatusMessage() string {
+	return "📅 Booking focus time..."
+}
+
+// Renderer reports that accept_focus_blocks does not expose a deterministic renderer.
+func (a AcceptFocusBlocksAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for AcceptFocusBlocksAction.
+func (a AcceptFocusBlocksAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "accept_focus_blocks",
+		Description: "Book focus blocks returned by suggest_focus_blocks as firm calendar entries. Pass todo_id, start and minutes exactly as suggested.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"blocks": {
+					Type:        "array",
+					Description: "Focus blocks to book. Each item: {todo_id,start,minutes}. REQUIRED.",
+					Required:    true,
+					Items: &assistant.ActionField{
+						Type:        "object",
+						Description: "Focus block to book.",
+						Required:    true,
+						Fields: map[string]assistant.ActionField{
+							"todo_id": {
+								Type:        "string",
+								Description: "ID of the todo the block is for. REQUIRED.",
+								Required:    true,
+							},
+							"start": {
+								Type:        "string",
+								Description: "Start of the block in RFC3339, e.g. 2026-03-02T09:00:00Z. REQUIRED.",
+								Required:    true,
+								Format:      "date-time",
+							},
+							"minutes": {
+								Type:        "integer",
+								Description: fmt.Sprintf("Length of the block in minutes, from 15 to %d. REQUIRED.", int(todo.MaxFocusBlockDuration.Minutes())),
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		Approval: assistant.ActionApproval{
+			Required:    true,
+			Title:       "Confirm focus blocks",
+			Description: "Booking focus blocks will add them to your calendar. Please confirm.",
+			PreviewFields: []string{
+				"blocks[].todo_id",
+				"blocks[].start",
+				"blocks[].minutes",
+			},
+			Timeout: 2 * time.Minute,
+		},
+	}
+}
+
+// Execute executes AcceptFocusBlocksAction.
+func (a AcceptFocusBlocksAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Blocks []struct {
+			TodoID  string `json:"todo_id"`
+			Start   string `json:"start"`
+			Minutes int    `json:"minutes"`
+		} `json:"blocks"`
+	}{}
+	exampleArgs := `{"blocks":[{"todo_id":"<uuid>","start":"2026-03-02T09:00:00Z","minutes":60}]}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	if len(params.Blocks) == 0 {
+		return newActionErrorMessage(call, "invalid_blocks", "blocks must contain at least one item.", exampleArgs)
+	}
+
+	blocks := make([]todo.FocusBlock, 0, len(params.Blocks))
+	for _, item := range params.Blocks {
+		todoID, err := uuid.Parse(item.TodoID)
+		if err != nil {
+			return newActionErrorMessage(call, "invalid_todo_id", err.Error(), exampleArgs)
+		}
+		start, err := time.Parse(time.RFC3339, item.Start)
+		if err != nil {
+			return newActionErrorMessage(call, "invalid_start", "start must be an RFC3339 date-time.", exampleArgs)
+		}
+		blocks = append(blocks, todo.FocusBlock{
+			TodoID: todoID,
+			Start:  start,
+			End:    start.Add(time.Duration(item.Minutes) * time.Minute),
+		})
+	}
+
+	accepted, err := a.focusBlocks.Accept(ctx, blocks)
+	if err != nil {
+		return newActionErrorMessage(call, "accept_focus_blocks_error", err.Error(), exampleArgs)
+	}
+	return newFocusBlocksResultMessage(call, "accepted", accepted)
+}
@@ -0,0 +1,104 @@
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAcceptFocusBlocksAction(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	input := `{"blocks":[{"todo_id":"` + todoID.String() + `","start":"2026-03-02T09:00:00Z","minutes":60}]}`
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockFocusBlocks)
+		input        string
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"accepted": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {
+				m.EXPECT().Accept(mock.Anything, []todo.FocusBlock{{TodoID: todoID, Start: start, End: start.Add(time.Hour)}}).
+					Return([]todo.FocusBlock{{
+						ID:     todo.FocusBlockID(todoID, start),
+						TodoID: todoID,
+						Title:  "Write report",
+						Start:  start,
+						End:    start.Add(time.Hour),
+						Status: todo.FocusBlockStatus_ACCEPTED,
+					}}, nil).Once()
+			},
+			input: input,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"accepted":[{`)
+				assert.Contains(t, resp.Content, `"title":"Write report"`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {},
+			input:      `invalid json`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"no-blocks": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {},
+			input:      `{"blocks":[]}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_blocks")
+			},
+		},
+		"invalid-todo-id": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {},
+			input:      `{"blocks":[{"todo_id":"abc","start":"2026-03-02T09:00:00Z","minutes":60}]}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_todo_id")
+			},
+		},
+		"invalid-start": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {},
+			input:      `{"blocks":[{"todo_id":"` + todoID.String() + `","start":"tomorrow","minutes":60}]}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_start")
+			},
+		},
+		"accept-error": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {
+				m.EXPECT().Accept(mock.Anything, mock.Anything).
+					Return(nil, core.NewValidationErr("focus block overlaps another focus block")).Once()
+			},
+			input: input,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "accept_focus_blocks_error")
+				assert.Contains(t, resp.Content, "overlaps")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			focusBlocks := todouc.NewMockFocusBlocks(t)
+			tt.setupMocks(focusBlocks)
+
+			action := NewAcceptFocusBlocksAction(focusBlocks)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "accept_focus_blocks", definition.Name)
+			assert.True(t, definition.RequiresApproval())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{Name: "accept_focus_blocks", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
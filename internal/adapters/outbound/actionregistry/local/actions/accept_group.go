@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+)
+
+// AcceptGroupAction is an assistant action that turns a suggested group of todos into a project.
+type AcceptGroupAction struct {
+	projects todouc.Projects
+}
+
+// NewAcceptGroupAction creates a new instance of AcceptGroupAction.
+func NewAcceptGroupAction(projects todouc.Projects) AcceptGroupAction {
+	return AcceptGroupAction{
+		projects: projects,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a AcceptGroupAction) StatusMessage() string {
+	return "🗂️ Creating the project..."
+}
+
+// Renderer reports that accept_group does not expose a deterministic renderer.
+func (a AcceptGroupAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for AcceptGroupAction.
+func (a AcceptGroupAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "accept_group",
+		Description: "Create a project from a group returned by suggest_groups. Pass its suggestion_id exactly as returned; name is optional and replaces the suggested name.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"suggestion_id": {
+					Type:        "string",
+					Description: "ID of the suggested group. REQUIRED.",
+					Required:    true,
+				},
+				"name": {
+					Type:        "string",
+					Description: fmt.Sprintf("Project name chosen by the user, up to %d characters. Omit to keep the suggested name.", todo.MaxProjectNameChars),
+				},
+			},
+		},
+		Approval: assistant.ActionApproval{
+			Required:    true,
+			Title:       "Confirm project",
+			Description: "Accepting the group will create a project with its todos. Please confirm.",
+			PreviewFields: []string{
+				"suggestion_id",
+				"name",
+			},
+			Timeout: 2 * time.Minute,
+		},
+	}
+}
+
+// Execute executes AcceptGroupAction.
+func (a AcceptGroupAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		SuggestionID string `json:"suggestion_id"`
+		Name         string `json:"name"`
+	}{}
+	exampleArgs := `{"suggestion_id":"<uuid>","name":"House move"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	suggestionID, err := uuid.Parse(params.SuggestionID)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_suggestion_id", err.Error(), exampleArgs)
+	}
+
+	project, err := a.projects.Accept(ctx, suggestionID, params.Name)
+	if err != nil {
+		return newActionErrorMessage(call, "accept_group_error", err.Error(), exampleArgs)
+	}
+
+	titles := make([]string, len(project.Todos))
+	for i, t := range project.Todos {
+		titles[i] = t.Title
+	}
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"project": map[string]any{
+			"id":    project.ID.String(),
+			"name":  project.Name,
+			"todos": titles,
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAcceptGroupAction(t *testing.T) {
+	t.Parallel()
+
+	suggestionID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockProjects)
+		input        string
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"accepted": {
+			setupMocks: func(m *todouc.MockProjects) {
+				m.EXPECT().Accept(mock.Anything, suggestionID, "Moving").Return(todo.Project{
+					ID:    projectID,
+					Name:  "Moving",
+					Todos: []todo.ProjectTodo{{ID: uuid.New(), Title: "Book movers"}},
+				}, nil).Once()
+			},
+			input: `{"suggestion_id":"` + suggestionID.String() + `","name":"Moving"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"id":"00000000-0000-0000-0000-000000000002"`)
+				assert.Contains(t, resp.Content, `"name":"Moving"`)
+				assert.Contains(t, resp.Content, `"todos":["Book movers"]`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(m *todouc.MockProjects) {},
+			input:      `invalid json`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"invalid-suggestion-id": {
+			setupMocks: func(m *todouc.MockProjects) {},
+			input:      `{"suggestion_id":"abc"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_suggestion_id")
+			},
+		},
+		"accept-error": {
+			setupMocks: func(m *todouc.MockProjects) {
+				m.EXPECT().Accept(mock.Anything, suggestionID, "").
+					Return(todo.Project{}, core.NewNotFoundErr("project suggestion not found")).Once()
+			},
+			input: `{"suggestion_id":"` + suggestionID.String() + `"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "accept_group_error")
+				assert.Contains(t, resp.Content, "not found")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			projects := todouc.NewMockProjects(t)
+			tt.setupMocks(projects)
+
+			action := NewAcceptGroupAction(projects)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "accept_group", definition.Name)
+			assert.True(t, definition.RequiresApproval())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{Name: "accept_group", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// ApplyTemplateAction is an assistant action that creates the todos of a saved template.
+type ApplyTemplateAction struct {
+	templates    todouc.Templates
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewApplyTemplateAction creates a new instance of ApplyTemplateAction.
+func NewApplyTemplateAction(templates todouc.Templates, timeProvider core.CurrentTimeProvider) ApplyTemplateAction {
+	return ApplyTemplateAction{
+		templates:    templates,
+		timeProvider: timeProvider,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a ApplyTemplateAction) StatusMessage() string {
+	return "📋 Applying the template..."
+}
+
+// Renderer reports that apply_template does not expose a deterministic renderer.
+func (a ApplyTemplateAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for ApplyTemplateAction.
+func (a ApplyTemplateAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "apply_template",
+		Description: "Create every todo of a saved template, such as \"Trip packing\", with due dates offset from an anchor date. All todos are created together or none is.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"template": {
+					Type:        "string",
+					Description: "Name of the saved template, case-insensitive. REQUIRED.",
+					Required:    true,
+				},
+				"anchor_date": {
+					Type:        "string",
+					Description: "Date the item offsets are counted from, e.g. the trip departure, as YYYY-MM-DD. Omit for today.",
+				},
+			},
+		},
+		Approval: assistant.ActionApproval{
+			Required:    true,
+			Title:       "Confirm template",
+			Description: "Applying the template will create all of its todos. Please confirm.",
+			PreviewFields: []string{
+				"template",
+				"anchor_date",
+			},
+			Timeout: 2 * time.Minute,
+		},
+	}
+}
+
+// Execute executes ApplyTemplateAction.
+func (a ApplyTemplateAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Template   string `json:"template"`
+		AnchorDate string `json:"anchor_date"`
+	}{}
+	exampleArgs := `{"template":"Trip packing","anchor_date":"2026-11-02"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	now := a.timeProvider.Now()
+	anchor := now
+	if params.AnchorDate != "" {
+		parsed, err := time.Parse(time.DateOnly, params.AnchorDate)
+		if err != nil {
+			return newActionErrorMessage(call, "invalid_anchor_date", "anchor_date must be a date in YYYY-MM-DD format.", exampleArgs)
+		}
+		anchor = parsed
+	}
+
+	templates, err := a.templates.List(ctx)
+	if err != nil {
+		return newActionErrorMessage(call, "apply_template_error", err.Error(), exampleArgs)
+	}
+	names := make([]string, len(templates))
+	for i, t := range templates {
+		names[i] = t.Name
+		if !strings.EqualFold(t.Name, strings.TrimSpace(params.Template)) {
+			continue
+		}
+
+		todos, err := a.templates.Apply(ctx, t.ID, anchor)
+		if err != nil {
+			return newActionErrorMessage(call, "apply_template_error", err.Error(), exampleArgs)
+		}
+
+		created := make([]map[string]any, len(todos))
+		for j, td := range todos {
+			created[j] = map[string]any{
+				"id":       td.ID.String(),
+				"title":    td.Title,
+				"due_date": td.DueDate.Format(time.DateOnly),
+			}
+		}
+		return assistant.NewActionResultMessage(call, map[string]any{
+			"template":    t.Name,
+			"anchor_date": anchor.Format(time.DateOnly),
+			"todos":       created,
+		})
+	}
+
+	msg := fmt.Sprintf("no template named %q; no templates are saved yet.", params.Template)
+	if len(names) > 0 {
+		msg = fmt.Sprintf("no template named %q. Available templates: %s.", params.Template, strings.Join(names, ", "))
+	}
+	return newActionErrorMessage(call, "template_not_found", msg, exampleArgs)
+}
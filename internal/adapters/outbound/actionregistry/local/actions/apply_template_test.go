@@ -0,0 +1,125 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyTemplateAction(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	anchor := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	templateID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	templates := []todo.Template{{ID: templateID, Name: "Trip packing"}}
+	created := []todo.Todo{
+		{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Title: "Book hotel", DueDate: anchor.AddDate(0, 0, -7)},
+		{ID: uuid.MustParse("00000000-0000-0000-0000-000000000003"), Title: "Pack passport", DueDate: anchor},
+	}
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockTemplates)
+		input        string
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"applied": {
+			setupMocks: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return(templates, nil).Once()
+				m.EXPECT().Apply(mock.Anything, templateID, anchor).Return(created, nil).Once()
+			},
+			input: `{"template":"trip packing","anchor_date":"2026-11-02"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"template":"Trip packing"`)
+				assert.Contains(t, resp.Content, `{"due_date":"2026-10-26","id":"00000000-0000-0000-0000-000000000002","title":"Book hotel"}`)
+				assert.Contains(t, resp.Content, `{"due_date":"2026-11-02","id":"00000000-0000-0000-0000-000000000003","title":"Pack passport"}`)
+			},
+		},
+		"anchor-defaults-to-today": {
+			setupMocks: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return(templates, nil).Once()
+				m.EXPECT().Apply(mock.Anything, templateID, now).Return(created[1:], nil).Once()
+			},
+			input: `{"template":"Trip packing"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"anchor_date":"2026-10-16"`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(m *todouc.MockTemplates) {},
+			input:      `invalid json`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"invalid-anchor-date": {
+			setupMocks: func(m *todouc.MockTemplates) {},
+			input:      `{"template":"Trip packing","anchor_date":"next friday"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_anchor_date")
+			},
+		},
+		"template-not-found": {
+			setupMocks: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return(templates, nil).Once()
+			},
+			input: `{"template":"Move house"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "template_not_found")
+				assert.Contains(t, resp.Content, "Available templates: Trip packing.")
+			},
+		},
+		"apply-error": {
+			setupMocks: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return(templates, nil).Once()
+				m.EXPECT().Apply(mock.Anything, templateID, anchor).
+					Return(nil, core.NewValidationErr("title must be between 3 and 200 characters")).Once()
+			},
+			input: `{"template":"Trip packing","anchor_date":"2026-11-02"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "apply_template_error")
+			},
+		},
+		"list-error": {
+			setupMocks: func(m *todouc.MockTemplates) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			input: `{"template":"Trip packing"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "apply_template_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			templates := todouc.NewMockTemplates(t)
+			tt.setupMocks(templates)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			action := NewApplyTemplateAction(templates, timeProvider)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "apply_template", definition.Name)
+			assert.True(t, definition.RequiresApproval())
+			_, ok := action.Renderer()
+			assert.False(t, ok)
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{Name: "apply_template", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// CalculateAction is an assistant action that evaluates arithmetic expressions, so the model does not
+// compute totals, averages or estimates on its own.
+type CalculateAction struct{}
+
+// NewCalculateAction creates a new instance of CalculateAction.
+func NewCalculateAction() CalculateAction {
+	return CalculateAction{}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a CalculateAction) StatusMessage() string {
+	return "🧮 Calculating..."
+}
+
+// Renderer reports that calculate does not expose a deterministic renderer.
+func (a CalculateAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for CalculateAction.
+func (a CalculateAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "calculate",
+		Description: "Evaluate an arithmetic expression exactly. Use it for any sum, product, average, percentage or estimate instead of computing it yourself.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"expression": {
+					Type:        "string",
+					Description: "Arithmetic expression with numbers, + - * / % (remainder), ^ (power), parentheses and abs, ceil, floor, round(x, digits), sqrt, min, max. For example (3*25 + 40) / 60 or round(1234 * 0.15, 2). REQUIRED.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes CalculateAction.
+func (a CalculateAction) Execute(_ context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Expression string `json:"expression"`
+	}{}
+	exampleArgs := `{"expression":"(3*25 + 40) / 60"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	result, err := evaluateExpression(params.Expression)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_expression", err.Error(), exampleArgs)
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"expression": params.Expression,
+		"result":     formatNumber(result),
+	})
+}
@@ -0,0 +1,53 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateAction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input           string
+		expectedMessage assistant.Message
+	}{
+		"evaluates-expression": {
+			input: `{"expression":"(3*25 + 40) / 60"}`,
+			expectedMessage: assistant.Message{
+				Role:         assistant.ChatRole_Tool,
+				ActionCallID: common.Ptr("call-1"),
+				Content:      `{"status":"success","data":{"expression":"(3*25 + 40) / 60","result":"1.91666666667"}}`,
+			},
+		},
+		"invalid-expression": {
+			input: `{"expression":"1 / 0"}`,
+			expectedMessage: assistant.NewActionErrorMessage(
+				assistant.ActionCall{ID: "call-1"}, "invalid_expression", "division by zero", `{"expression":"(3*25 + 40) / 60"}`,
+			),
+		},
+		"missing-expression": {
+			input: `{}`,
+			expectedMessage: assistant.NewActionErrorMessage(
+				assistant.ActionCall{ID: "call-1"}, "invalid_expression", "expression is empty", `{"expression":"(3*25 + 40) / 60"}`,
+			),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			action := NewCalculateAction()
+			assert.Equal(t, "calculate", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			got := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "calculate", Input: tt.input}, nil)
+			assert.Equal(t, tt.expectedMessage, got)
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxExpressionLength bounds the expressions calculate accepts.
+	maxExpressionLength = 500
+	// maxExpressionDepth bounds nested parentheses and function calls.
+	maxExpressionDepth = 32
+)
+
+// calculatorFuncs are the functions calculate expressions may call, keyed by name.
+var calculatorFuncs = map[string]struct {
+	minArgs, maxArgs int
+	apply            func(args []float64) float64
+}{
+	"abs":   {1, 1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"ceil":  {1, 1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"floor": {1, 1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"sqrt":  {1, 1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"round": {1, 2, func(a []float64) float64 {
+		if len(a) == 1 {
+			return math.Round(a[0])
+		}
+		scale := math.Pow(10, math.Trunc(a[1]))
+		return math.Round(a[0]*scale) / scale
+	}},
+	"min": {1, 64, func(a []float64) float64 {
+		result := a[0]
+		for _, v := range a[1:] {
+			result = math.Min(result, v)
+		}
+		return result
+	}},
+	"max": {1, 64, func(a []float64) float64 {
+		result := a[0]
+		for _, v := range a[1:] {
+			result = math.Max(result, v)
+		}
+		return result
+	}},
+}
+
+// evaluateExpression evaluates an arithmetic expression with +, -, *, /, % (remainder), ^ (power),
+// parentheses, decimal numbers and the calculatorFuncs. It never runs code, only computes numbers.
+func evaluateExpression(expression string) (float64, error) {
+	if strings.TrimSpace(expression) == "" {
+		return 0, errors.New("expression is empty")
+	}
+	if len(expression) > maxExpressionLength {
+		return 0, fmt.Errorf("expression is longer than %d characters", maxExpressionLength)
+	}
+
+	p := &expressionParser{input: expression}
+	result, err := p.parseSum(0)
+	if err != nil {
+		return 0, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return 0, fmt.Errorf("unexpected %q at position %d", p.input[p.pos], p.pos+1)
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, errors.New("result is not a finite number")
+	}
+	return result, nil
+}
+
+// expressionParser is a recursive descent parser evaluating while it parses.
+type expressionParser struct {
+	input string
+	pos   int
+}
+
+// parseSum parses additions and subtractions.
+func (p *expressionParser) parseSum(depth int) (float64, error) {
+	left, err := p.parseProduct(depth)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '+':
+			p.pos++
+			right, err := p.parseProduct(depth)
+			if err != nil {
+				return 0, err
+			}
+			left += right
+		case '-':
+			p.pos++
+			right, err := p.parseProduct(depth)
+			if err != nil {
+				return 0, err
+			}
+			left -= right
+		default:
+			return left, nil
+		}
+	}
+}
+
+// parseProduct parses multiplications, divisions and remainders.
+func (p *expressionParser) parseProduct(depth int) (float64, error) {
+	left, err := p.parseUnary(depth)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' && op != '%' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseUnary(depth)
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case '*':
+			left *= right
+		case '/':
+			if right == 0 {
+				return 0, errors.New("division by zero")
+			}
+			left /= right
+		case '%':
+			if right == 0 {
+				return 0, errors.New("division by zero")
+			}
+			left = math.Mod(left, right)
+		}
+	}
+}
+
+// parseUnary parses a leading sign.
+func (p *expressionParser) parseUnary(depth int) (float64, error) {
+	switch p.peek() {
+	case '-':
+		p.pos++
+		value, err := p.parseUnary(depth)
+		return -value, err
+	case '+':
+		p.pos++
+		return p.parseUnary(depth)
+	default:
+		return p.parsePower(depth)
+	}
+}
+
+// parsePower parses exponentiation, which is right associative: 2^3^2 is 2^9.
+func (p *expressionParser) parsePower(depth int) (float64, error) {
+	base, err := p.parsePrimary(depth)
+	if err != nil {
+		return 0, err
+	}
+	if p.peek() != '^' {
+		return base, nil
+	}
+	p.pos++
+	exponent, err := p.parseUnary(depth)
+	if err != nil {
+		return 0, err
+	}
+	return math.Pow(base, exponent), nil
+}
+
+// parsePrimary parses a number, a parenthesized expression or a function call.
+func (p *expressionParser) parsePrimary(depth int) (float64, error) {
+	if depth > maxExpressionDepth {
+		return 0, errors.New("expression is nested too deeply")
+	}
+
+	c := p.peek()
+	switch {
+	case c == '(':
+		p.pos++
+		value, err := p.parseSum(depth + 1)
+		if err != nil {
+			return 0, err
+		}
+		if p.peek() != ')' {
+			return 0, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return value, nil
+	case c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case unicode.IsLetter(rune(c)):
+		return p.parseCall(depth)
+	case c == 0:
+		return 0, errors.New("unexpected end of expression")
+	default:
+		return 0, fmt.Errorf("unexpected %q at position %d", c, p.pos+1)
+	}
+}
+
+// parseNumber parses a decimal number, allowing _ as a thousands separator. Commas separate function
+// arguments, so they cannot be used as separators.
+func (p *expressionParser) parseNumber() (float64, error) {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if (c < '0' || c > '9') && c != '.' && c != '_' {
+			break
+		}
+		p.pos++
+	}
+	literal := strings.ReplaceAll(p.input[start:p.pos], "_", "")
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", p.input[start:p.pos])
+	}
+	return value, nil
+}
+
+// parseCall parses a function call such as round(2.345, 2).
+func (p *expressionParser) parseCall(depth int) (float64, error) {
+	start := p.pos
+	for p.pos < len(p.input) && unicode.IsLetter(rune(p.input[p.pos])) {
+		p.pos++
+	}
+	name := strings.ToLower(p.input[start:p.pos])
+	fn, ok := calculatorFuncs[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown function %q", name)
+	}
+	if p.peek() != '(' {
+		return 0, fmt.Errorf("%s must be called with parentheses", name)
+	}
+	p.pos++
+
+	var args []float64
+	for {
+		arg, err := p.parseSum(depth + 1)
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, arg)
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+	}
+	if p.peek() != ')' {
+		return 0, errors.New("missing closing parenthesis")
+	}
+	p.pos++
+
+	if len(args) < fn.minArgs || len(args) > fn.maxArgs {
+		return 0, fmt.Errorf("%s takes %d to %d arguments, got %d", name, fn.minArgs, fn.maxArgs, len(args))
+	}
+	return fn.apply(args), nil
+}
+
+// peek skips spaces and returns the next character, or 0 at the end of the input.
+func (p *expressionParser) peek() byte {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// skipSpaces advances past whitespace.
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// formatNumber renders a calculation result without binary floating point noise, e.g. 0.1+0.2 as 0.3.
+func formatNumber(value float64) string {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(value, 'g', 12, 64), 64)
+	if err != nil {
+		rounded = value
+	}
+	return strconv.FormatFloat(rounded, 'f', -1, 64)
+}
@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expression  string
+		expected    float64
+		expectedErr string
+	}{
+		"precedence":          {expression: "2 + 3 * 4", expected: 14},
+		"parentheses":         {expression: "(3*25 + 40) / 60", expected: 115.0 / 60},
+		"unary-minus":         {expression: "-2 * -(3 + 1)", expected: 8},
+		"power-right-assoc":   {expression: "2^3^2", expected: 512},
+		"power-before-unary":  {expression: "-2^2", expected: -4},
+		"remainder":           {expression: "17 % 5", expected: 2},
+		"decimals":            {expression: ".5 + 1.25", expected: 1.75},
+		"underscore-grouping": {expression: "1_000 * 3", expected: 3000},
+		"functions":           {expression: "round(1234 * 0.15, 2) + abs(-1) + sqrt(16)", expected: 190.1},
+		"variadic-functions":  {expression: "max(1, 7, 3) - min(4, 2)", expected: 5},
+		"case-insensitive":    {expression: "FLOOR(2.7) + Ceil(2.1)", expected: 5},
+		"empty":               {expression: "  ", expectedErr: "expression is empty"},
+		"division-by-zero":    {expression: "1 / (2 - 2)", expectedErr: "division by zero"},
+		"remainder-by-zero":   {expression: "1 % 0", expectedErr: "division by zero"},
+		"not-finite":          {expression: "sqrt(-1)", expectedErr: "result is not a finite number"},
+		"unknown-function":    {expression: "exec(1)", expectedErr: `unknown function "exec"`},
+		"function-arity":      {expression: "abs(1, 2)", expectedErr: "abs takes 1 to 1 arguments, got 2"},
+		"function-no-parens":  {expression: "sqrt 4", expectedErr: "sqrt must be called with parentheses"},
+		"unclosed":            {expression: "(1 + 2", expectedErr: "missing closing parenthesis"},
+		"trailing-input":      {expression: "1 + 2)", expectedErr: `unexpected ')' at position 6`},
+		"dangling-operator":   {expression: "1 +", expectedErr: "unexpected end of expression"},
+		"invalid-number":      {expression: "1.2.3", expectedErr: `invalid number "1.2.3"`},
+		"too-long":            {expression: strings.Repeat("1+", 300) + "1", expectedErr: "expression is longer than 500 characters"},
+		"too-deep":            {expression: strings.Repeat("(", 40) + "1" + strings.Repeat(")", 40), expectedErr: "expression is nested too deeply"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := evaluateExpression(tt.expression)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, got, 1e-9)
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    float64
+		expected string
+	}{
+		"integer":        {value: 3000, expected: "3000"},
+		"float-noise":    {value: 0.1 + 0.2, expected: "0.3"},
+		"fraction":       {value: 115.0 / 60, expected: "1.91666666667"},
+		"negative":       {value: -2.5, expected: "-2.5"},
+		"large-integer":  {value: 123456789012, expected: "123456789012"},
+		"small-fraction": {value: 0.000125, expected: "0.000125"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, formatNumber(tt.value))
+		})
+	}
+}
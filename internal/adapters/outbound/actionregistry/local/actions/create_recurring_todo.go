@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// CreateRecurringTodoAction is an assistant action for creating a todo that repeats on a schedule.
+type CreateRecurringTodoAction struct {
+	uow          transaction.UnitOfWork
+	creator      todouc.Creator
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewCreateRecurringTodoAction creates a new instance of CreateRecurringTodoAction.
+func NewCreateRecurringTodoAction(
+	uow transaction.UnitOfWork,
+	creator todouc.Creator,
+	timeProvider core.CurrentTimeProvider,
+) CreateRecurringTodoAction {
+	return CreateRecurringTodoAction{
+		uow:          uow,
+		creator:      creator,
+		timeProvider: timeProvider,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a CreateRecurringTodoAction) StatusMessage() string {
+	return "🔁 Creating your recurring todo..."
+}
+
+// Renderer reports that create_recurring_todo does not expose a deterministic renderer.
+func (a CreateRecurringTodoAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for CreateRecurringTodoAction.
+func (a CreateRecurringTodoAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "create_recurring_todo",
+		Description: "Create a todo that repeats, e.g. \"remind me every Monday to send the report\". Only the first occurrence is created; marking it DONE creates the next one.",
+		Sandboxed:   true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"title": {
+					Type:        "string",
+					Description: "Title of the todo. REQUIRED.",
+					Required:    true,
+				},
+				"recurrence": {
+					Type:        "string",
+					Description: "How the todo repeats: daily, weekly, monthly or an RRULE with FREQ (DAILY, WEEKLY or MONTHLY), optional INTERVAL, BYDAY for WEEKLY and BYMONTHDAY for MONTHLY, e.g. FREQ=WEEKLY;BYDAY=MO for every Monday or FREQ=MONTHLY;INTERVAL=3 for every quarter. REQUIRED.",
+					Required:    true,
+				},
+				"due_date": {
+					Type:        "string",
+					Description: "Due date of the first occurrence in YYYY-MM-DD format. Omit to start today, or on the next listed weekday for weekly rules with BYDAY.",
+					Format:      "date",
+				},
+				"estimated_minutes": {
+					Type:        "integer",
+					Description: fmt.Sprintf("Estimated effort of each occurrence in minutes, from 1 to %d. Optional.", todo.MaxEstimatedMinutes),
+				},
+			},
+		},
+	}
+}
+
+// Execute executes CreateRecurringTodoAction.
+func (a CreateRecurringTodoAction) Execute(ctx context.Context, call assistant.ActionCall, conversationHistory []assistant.Message) assistant.Message {
+	params := struct {
+		Title            string `json:"title"`
+		Recurrence       string `json:"recurrence"`
+		DueDate          string `json:"due_date"`
+		EstimatedMinutes int    `json:"estimated_minutes"`
+	}{}
+	exampleArgs := `{"title":"Send weekly report","recurrence":"FREQ=WEEKLY;BYDAY=MO"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	title := strings.TrimSpace(params.Title)
+	if title == "" {
+		return newActionErrorMessage(call, "invalid_title", "title must not be empty.", exampleArgs)
+	}
+
+	recurrence, err := todo.ParseRecurrence(params.Recurrence)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_recurrence", err.Error(), exampleArgs)
+	}
+
+	now := a.timeProvider.Now()
+	dueDate := recurrence.FirstOnOrAfter(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()))
+	if strings.TrimSpace(params.DueDate) != "" {
+		parsed, found := extractDateParam(params.DueDate, conversationHistory, now)
+		if !found {
+			return newActionErrorMessage(call, "invalid_due_date", "due_date must be a date in YYYY-MM-DD format.", exampleArgs)
+		}
+		dueDate = parsed
+	}
+
+	var created todo.Todo
+	err = a.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
+		var createErr error
+		created, createErr = a.creator.Create(uowCtx, scope, title, dueDate, params.EstimatedMinutes, nil, todouc.WithCreateRecurrence(recurrence))
+		return createErr
+	})
+	if err != nil {
+		return newActionErrorMessage(call, "create_recurring_todo_error", err.Error(), exampleArgs)
+	}
+
+	return newTodosResultMessage(call, []todo.Todo{created})
+}
@@ -0,0 +1,212 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateRecurringTodoAction(t *testing.T) {
+	t.Parallel()
+
+	// 2026-01-24 is a Saturday.
+	fixedTime := time.Date(2026, 1, 24, 15, 0, 0, 0, time.UTC)
+	mondays := todo.Recurrence{Frequency: todo.RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Monday}}
+
+	tests := map[string]struct {
+		setupMocks func(
+			*transaction.MockUnitOfWork,
+			*core.MockCurrentTimeProvider,
+			*todouc.MockCreator,
+		)
+		functionCall assistant.ActionCall
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"create-recurring-todo-success": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+				timeProvider.EXPECT().
+					Now().
+					Return(fixedTime).
+					Once()
+				scope := transaction.NewMockScope(t)
+				nextMonday := time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC)
+
+				creator.EXPECT().
+					Create(mock.Anything, scope, "Send weekly report", nextMonday, 30, todo.CustomFieldValues(nil), mock.Anything).
+					RunAndReturn(func(_ context.Context, _ transaction.Scope, title string, dueDate time.Time, estimate int, _ todo.CustomFieldValues, opts ...todouc.CreateOption) (todo.Todo, error) {
+						params := todouc.CreateParams{}
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, &mondays, params.Recurrence)
+						return todo.Todo{
+							ID:               uuid.New(),
+							Title:            title,
+							DueDate:          dueDate,
+							Status:           todo.Status_OPEN,
+							EstimatedMinutes: estimate,
+							Recurrence:       params.Recurrence,
+						}, nil
+					}).
+					Once()
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `{"title":" Send weekly report ","recurrence":"RRULE:FREQ=WEEKLY;BYDAY=MO","estimated_minutes":30}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				payload := struct {
+					Todos []struct {
+						DueDate    string `json:"due_date"`
+						Recurrence string `json:"recurrence"`
+					} `json:"todos"`
+				}{}
+				assert.True(t, unmarshalResultData(resp, &payload))
+				assert.Len(t, payload.Todos, 1)
+				assert.Equal(t, "2026-01-26", payload.Todos[0].DueDate)
+				assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", payload.Todos[0].Recurrence)
+			},
+		},
+		"create-recurring-todo-with-due-date": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+				timeProvider.EXPECT().
+					Now().
+					Return(fixedTime).
+					Once()
+				scope := transaction.NewMockScope(t)
+
+				creator.EXPECT().
+					Create(mock.Anything, scope, "Pay rent", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), 0, todo.CustomFieldValues(nil), mock.Anything).
+					Return(todo.Todo{ID: uuid.New(), Title: "Pay rent"}, nil).
+					Once()
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `{"title":"Pay rent","recurrence":"monthly","due_date":"2026-02-01"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "Pay rent")
+			},
+		},
+		"create-recurring-todo-invalid-arguments": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"create-recurring-todo-empty-title": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `{"title":"  ","recurrence":"daily"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_title")
+			},
+		},
+		"create-recurring-todo-invalid-recurrence": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `{"title":"Water plants","recurrence":"FREQ=YEARLY"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_recurrence")
+			},
+		},
+		"create-recurring-todo-invalid-due-date": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+				timeProvider.EXPECT().
+					Now().
+					Return(fixedTime).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `{"title":"Water plants","recurrence":"daily","due_date":"invalid"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_due_date")
+			},
+		},
+		"create-recurring-todo-create-error": {
+			setupMocks: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, creator *todouc.MockCreator) {
+				timeProvider.EXPECT().
+					Now().
+					Return(fixedTime).
+					Once()
+				scope := transaction.NewMockScope(t)
+
+				creator.EXPECT().
+					Create(mock.Anything, scope, "Water plants", mock.Anything, 0, todo.CustomFieldValues(nil), mock.Anything).
+					Return(todo.Todo{}, errors.New("create error")).
+					Once()
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "create_recurring_todo",
+				Input: `{"title":"Water plants","recurrence":"daily"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "create_recurring_todo_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			uow := transaction.NewMockUnitOfWork(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			todoCreator := todouc.NewMockCreator(t)
+			tt.setupMocks(uow, timeProvider, todoCreator)
+
+			action := NewCreateRecurringTodoAction(uow, todoCreator, timeProvider)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			definition := action.Definition()
+			assert.Equal(t, "create_recurring_todo", definition.Name)
+			assert.NotEmpty(t, definition.Description)
+			assert.NotEmpty(t, definition.Input)
+
+			resp := action.Execute(t.Context(), tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -15,17 +15,24 @@ import (
 
 // CreateTodosAction is an assistant action for creating multiple todos.
 type CreateTodosAction struct {
-	uow          transaction.UnitOfWork
-	creator      todouc.Creator
-	timeProvider core.CurrentTimeProvider
+	uow             transaction.UnitOfWork
+	creator         todouc.Creator
+	customFieldRepo todo.CustomFieldRepository
+	timeProvider    core.CurrentTimeProvider
 }
 
 // NewCreateTodosAction creates a new instance of CreateTodosAction.
-func NewCreateTodosAction(uow transaction.UnitOfWork, creator todouc.Creator, timeProvider core.CurrentTimeProvider) CreateTodosAction {
+func NewCreateTodosAction(
+	uow transaction.UnitOfWork,
+	creator todouc.Creator,
+	customFieldRepo todo.CustomFieldRepository,
+	timeProvider core.CurrentTimeProvider,
+) CreateTodosAction {
 	return CreateTodosAction{
-		uow:          uow,
-		creator:      creator,
-		timeProvider: timeProvider,
+		uow:             uow,
+		creator:         creator,
+		customFieldRepo: customFieldRepo,
+		timeProvider:    timeProvider,
 	}
 }
 
@@ -44,12 +51,13 @@ func (a CreateTodosAction) Definition() assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name:        "create_todos",
 		Description: "Create multiple todo items in one call (batch).",
+		Sandboxed:   true,
 		Input: assistant.ActionInput{
 			Type: "object",
 			Fields: map[string]assistant.ActionField{
 				"todos": {
 					Type:        "array",
-					Description: "List of todos to create. Each item: {title, due_date}. REQUIRED.",
+					Description: "List of todos to create. Each item: {title, due_date, estimated_minutes?}. REQUIRED.",
 					Required:    true,
 					Items: &assistant.ActionField{
 						Type:        "object",
@@ -66,6 +74,11 @@ func (a CreateTodosAction) Definition() assistant.ActionDefinition {
 								Required:    true,
 								Format:      "date",
 							},
+							"estimated_minutes": {
+								Type:        "integer",
+								Description: fmt.Sprintf("Estimated effort in minutes, from 1 to %d. Optional.", todo.MaxEstimatedMinutes),
+								Required:    false,
+							},
 						},
 					},
 				},
@@ -74,72 +87,72 @@ func (a CreateTodosAction) Definition() assistant.ActionDefinition {
 	}
 }
 
+// DefinitionFor returns the create_todos definition with a custom_fields input on each todo describing the
+// custom fields of the tenant in ctx. Tenants without custom fields get the static definition.
+func (a CreateTodosAction) DefinitionFor(ctx context.Context) assistant.ActionDefinition {
+	definition := a.Definition()
+	fields := listCustomFields(ctx, a.customFieldRepo)
+	if len(fields) == 0 {
+		return definition
+	}
+	definition.Input.Fields["todos"].Items.Fields["custom_fields"] = customFieldsInput(
+		"Optional values for the custom fields the user defined on todos.",
+		fields,
+	)
+	return definition
+}
+
 // Execute executes CreateTodosAction.
 func (a CreateTodosAction) Execute(ctx context.Context, call assistant.ActionCall, conversationHistory []assistant.Message) assistant.Message {
 	params := struct {
 		Todos []struct {
-			Title   string `json:"title"`
-			DueDate string `json:"due_date"`
+			Title            string                 `json:"title"`
+			DueDate          string                 `json:"due_date"`
+			EstimatedMinutes int                    `json:"estimated_minutes"`
+			CustomFields     todo.CustomFieldValues `json:"custom_fields"`
 		} `json:"todos"`
 	}{}
-	exampleArgs := `{"todos":[{"title":"Pay rent","due_date":"2026-04-30"},{"title":"Buy groceries","due_date":"2026-05-01"}]}`
+	exampleArgs := `{"todos":[{"title":"Pay rent","due_date":"2026-04-30"},{"title":"Write report","due_date":"2026-05-01","estimated_minutes":120}]}`
 
 	err := unmarshalActionInput(call.Input, &params)
 	if err != nil {
-		content := newActionError("invalid_arguments", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
 	}
 	if len(params.Todos) == 0 {
-		content := newActionError("invalid_arguments", "todos must not be empty.", exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", "todos must not be empty.", exampleArgs)
 	}
 
 	now := a.timeProvider.Now()
 	type createItem struct {
-		Title   string
-		DueDate time.Time
+		Title            string
+		DueDate          time.Time
+		EstimatedMinutes int
+		CustomFields     todo.CustomFieldValues
 	}
 	items := make([]createItem, 0, len(params.Todos))
 	for i, td := range params.Todos {
 		title := strings.TrimSpace(td.Title)
 		if title == "" {
-			content := newActionError("invalid_title", fmt.Sprintf("todo at index %d has an empty title.", i), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_title", fmt.Sprintf("todo at index %d has an empty title.", i), exampleArgs)
 		}
 
 		dueDate, found := extractDateParam(td.DueDate, conversationHistory, now)
 		if !found {
-			content := newActionError("invalid_due_date", fmt.Sprintf("todo at index %d has invalid due_date.", i), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_due_date", fmt.Sprintf("todo at index %d has invalid due_date.", i), exampleArgs)
 		}
 
-		items = append(items, createItem{Title: title, DueDate: dueDate})
+		items = append(items, createItem{
+			Title:            title,
+			DueDate:          dueDate,
+			EstimatedMinutes: td.EstimatedMinutes,
+			CustomFields:     td.CustomFields,
+		})
 	}
 
 	todos := make([]todo.Todo, 0, len(items))
 	err = a.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
 		for i, item := range items {
-			todo, createErr := a.creator.Create(uowCtx, scope, item.Title, item.DueDate)
+			todo, createErr := a.creator.Create(uowCtx, scope, item.Title, item.DueDate, item.EstimatedMinutes, item.CustomFields)
 			if createErr != nil {
 				return fmt.Errorf("todo at index %d: %w", i, createErr)
 			}
@@ -148,18 +161,8 @@ func (a CreateTodosAction) Execute(ctx context.Context, call assistant.ActionCal
 		return nil
 	})
 	if err != nil {
-		content := newActionError("create_todos_error", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "create_todos_error", err.Error(), exampleArgs)
 	}
 
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      formatTodosRows(todos),
-	}
+	return newTodosResultMessage(call, todos)
 }
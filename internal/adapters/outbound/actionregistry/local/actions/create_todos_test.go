@@ -14,7 +14,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/toon-format/toon-go"
 )
 
 func TestCreateTodosAction(t *testing.T) {
@@ -42,7 +41,7 @@ func TestCreateTodosAction(t *testing.T) {
 				scope := transaction.NewMockScope(t)
 
 				creator.EXPECT().
-					Create(mock.Anything, scope, "Todo 1", mock.Anything).
+					Create(mock.Anything, scope, "Todo 1", mock.Anything, 0, todo.CustomFieldValues(nil)).
 					Return(todo.Todo{
 						ID:      uuid.New(),
 						Title:   "Todo 1",
@@ -51,12 +50,13 @@ func TestCreateTodosAction(t *testing.T) {
 					}, nil).
 					Once()
 				creator.EXPECT().
-					Create(mock.Anything, scope, "Todo 2", mock.Anything).
+					Create(mock.Anything, scope, "Todo 2", mock.Anything, 60, todo.CustomFieldValues(nil)).
 					Return(todo.Todo{
-						ID:      uuid.New(),
-						Title:   "Todo 2",
-						DueDate: time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC),
-						Status:  todo.Status_OPEN,
+						ID:               uuid.New(),
+						Title:            "Todo 2",
+						DueDate:          time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC),
+						Status:           todo.Status_OPEN,
+						EstimatedMinutes: 60,
 					}, nil).
 					Once()
 
@@ -69,18 +69,20 @@ func TestCreateTodosAction(t *testing.T) {
 			},
 			functionCall: assistant.ActionCall{
 				Name:  "create_todos",
-				Input: `{"todos":[{"title":"Todo 1","due_date":"2026-01-25"},{"title":"Todo 2","due_date":"2026-01-26"}]}`,
+				Input: `{"todos":[{"title":"Todo 1","due_date":"2026-01-25"},{"title":"Todo 2","due_date":"2026-01-26","estimated_minutes":60}]}`,
 			},
 			history: []assistant.Message{},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
 				payload := struct {
 					Todos []struct {
-						Title string `toon:"title"`
-					} `toon:"todos"`
+						Title            string `json:"title"`
+						EstimatedMinutes int    `json:"estimated_minutes"`
+					} `json:"todos"`
 				}{}
-				assert.NoError(t, toon.UnmarshalString(resp.Content, &payload))
+				assert.True(t, unmarshalResultData(resp, &payload))
 				assert.Len(t, payload.Todos, 2)
+				assert.Equal(t, 60, payload.Todos[1].EstimatedMinutes)
 			},
 		},
 		"create-todos-invalid-arguments": {
@@ -120,7 +122,7 @@ func TestCreateTodosAction(t *testing.T) {
 				scope := transaction.NewMockScope(t)
 
 				creator.EXPECT().
-					Create(mock.Anything, scope, "Todo 1", mock.Anything).
+					Create(mock.Anything, scope, "Todo 1", mock.Anything, 0, todo.CustomFieldValues(nil)).
 					Return(todo.Todo{}, errors.New("create error")).
 					Once()
 
@@ -149,7 +151,7 @@ func TestCreateTodosAction(t *testing.T) {
 			todoCreator := todouc.NewMockCreator(t)
 			tt.setupMocks(uow, timeProvider, todoCreator)
 
-			action := NewCreateTodosAction(uow, todoCreator, timeProvider)
+			action := NewCreateTodosAction(uow, todoCreator, todo.NewMockCustomFieldRepository(t), timeProvider)
 			assert.NotEmpty(t, action.StatusMessage())
 
 			definition := action.Definition()
@@ -0,0 +1,94 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	// The scratch runtime image has no zoneinfo, so the IANA database is embedded for time.LoadLocation.
+	_ "time/tzdata"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// CurrentDatetimeAction is an assistant action returning the current date and time in a time zone.
+type CurrentDatetimeAction struct {
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewCurrentDatetimeAction creates a new instance of CurrentDatetimeAction.
+func NewCurrentDatetimeAction(timeProvider core.CurrentTimeProvider) CurrentDatetimeAction {
+	return CurrentDatetimeAction{
+		timeProvider: timeProvider,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a CurrentDatetimeAction) StatusMessage() string {
+	return "🕒 Checking the time..."
+}
+
+// Renderer reports that current_datetime does not expose a deterministic renderer.
+func (a CurrentDatetimeAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for CurrentDatetimeAction.
+func (a CurrentDatetimeAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "current_datetime",
+		Description: "Get the current date, time, weekday and ISO week in the user's time zone.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"timezone": {
+					Type:        "string",
+					Description: "Optional IANA time zone of the user, for example Europe/Lisbon or America/Sao_Paulo. Pass it whenever the user mentioned their time zone or city. Defaults to UTC.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes CurrentDatetimeAction.
+func (a CurrentDatetimeAction) Execute(_ context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Timezone *string `json:"timezone"`
+	}{}
+	exampleArgs := `{"timezone":"Europe/Lisbon"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	loc, err := loadTimezone(params.Timezone)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_timezone", err.Error(), exampleArgs)
+	}
+
+	now := a.timeProvider.Now().In(loc)
+	year, week := now.ISOWeek()
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"datetime":   now.Format(time.RFC3339),
+		"date":       now.Format(time.DateOnly),
+		"time":       now.Format("15:04"),
+		"weekday":    now.Weekday().String(),
+		"iso_week":   fmt.Sprintf("%d-W%02d", year, week),
+		"timezone":   loc.String(),
+		"utc_offset": now.Format("-07:00"),
+	})
+}
+
+// loadTimezone loads an optional IANA time zone, defaulting to UTC.
+func loadTimezone(name *string) (*time.Location, error) {
+	if name == nil || strings.TrimSpace(*name) == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(strings.TrimSpace(*name))
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q; use an IANA name such as Europe/Lisbon", *name)
+	}
+	return loc, nil
+}
@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrentDatetimeAction(t *testing.T) {
+	t.Parallel()
+
+	// Sunday 2026-03-01 23:30 UTC is already Monday in Tokyo and still Sunday in São Paulo.
+	now := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		input        string
+		setupMocks   func(*core.MockCurrentTimeProvider)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"defaults-to-utc": {
+			input: `{}`,
+			setupMocks: func(tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"datetime":"2026-03-01T23:30:00Z"`)
+				assert.Contains(t, resp.Content, `"weekday":"Sunday"`)
+				assert.Contains(t, resp.Content, `"iso_week":"2026-W09"`)
+				assert.Contains(t, resp.Content, `"timezone":"UTC"`)
+				assert.Contains(t, resp.Content, `"utc_offset":"+00:00"`)
+			},
+		},
+		"user-timezone-ahead": {
+			input: `{"timezone":"Asia/Tokyo"}`,
+			setupMocks: func(tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"date":"2026-03-02"`)
+				assert.Contains(t, resp.Content, `"time":"08:30"`)
+				assert.Contains(t, resp.Content, `"weekday":"Monday"`)
+				assert.Contains(t, resp.Content, `"iso_week":"2026-W10"`)
+				assert.Contains(t, resp.Content, `"timezone":"Asia/Tokyo"`)
+				assert.Contains(t, resp.Content, `"utc_offset":"+09:00"`)
+			},
+		},
+		"user-timezone-behind": {
+			input: `{"timezone":"America/Sao_Paulo"}`,
+			setupMocks: func(tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"date":"2026-03-01"`)
+				assert.Contains(t, resp.Content, `"time":"20:30"`)
+				assert.Contains(t, resp.Content, `"utc_offset":"-03:00"`)
+			},
+		},
+		"unknown-timezone": {
+			input:      `{"timezone":"Mars/Olympus"}`,
+			setupMocks: func(tp *core.MockCurrentTimeProvider) {},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_timezone")
+				assert.Contains(t, resp.Content, "Mars/Olympus")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setupMocks(timeProvider)
+
+			action := NewCurrentDatetimeAction(timeProvider)
+			assert.Equal(t, "current_datetime", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "current_datetime", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// listCustomFields returns the custom fields of the tenant in ctx. Definitions fall back to their static form
+// when the fields cannot be loaded, so errors only end up on the span.
+func listCustomFields(ctx context.Context, repo todo.CustomFieldRepository) []todo.CustomField {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	fields, err := repo.ListCustomFields(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil
+	}
+	return fields
+}
+
+// customFieldsInput describes the custom fields of the tenant as an object input, one property per field.
+func customFieldsInput(description string, fields []todo.CustomField) assistant.ActionField {
+	properties := make(map[string]assistant.ActionField, len(fields))
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		properties[f.Name] = customFieldInput(f)
+		names = append(names, f.Name)
+	}
+	slices.Sort(names)
+
+	return assistant.ActionField{
+		Type:        "object",
+		Description: fmt.Sprintf("%s Available fields: %s.", description, strings.Join(names, ", ")),
+		Required:    false,
+		Fields:      properties,
+	}
+}
+
+// customFieldInput maps one custom field to the input property that accepts its values.
+func customFieldInput(f todo.CustomField) assistant.ActionField {
+	switch f.Type {
+	case todo.CustomFieldType_NUMBER:
+		return assistant.ActionField{Type: "number", Description: "Custom field (number)."}
+	case todo.CustomFieldType_BOOLEAN:
+		return assistant.ActionField{Type: "boolean", Description: "Custom field (true or false)."}
+	case todo.CustomFieldType_DATE:
+		return assistant.ActionField{Type: "string", Description: "Custom field (date in YYYY-MM-DD format).", Format: "date"}
+	case todo.CustomFieldType_ENUM:
+		options := make([]any, len(f.Options))
+		for i, o := range f.Options {
+			options[i] = o
+		}
+		return assistant.ActionField{Type: "string", Description: "Custom field (one of the allowed values).", Enum: options}
+	default:
+		return assistant.ActionField{Type: "string", Description: "Custom field (text)."}
+	}
+}
@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFetchTodosAction_DefinitionFor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fields      []todo.CustomField
+		listErr     error
+		assertInput func(t *testing.T, input assistant.ActionInput)
+	}{
+		"describes-custom-fields": {
+			fields: []todo.CustomField{
+				{Name: "points", Type: todo.CustomFieldType_NUMBER},
+				{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}},
+			},
+			assertInput: func(t *testing.T, input assistant.ActionInput) {
+				field, ok := input.Fields["custom_fields"]
+				assert.True(t, ok)
+				assert.Equal(t, "object", field.Type)
+				assert.Contains(t, field.Description, "Available fields: area, points.")
+				assert.Equal(t, "number", field.Fields["points"].Type)
+				assert.Equal(t, []any{"home", "work"}, field.Fields["area"].Enum)
+			},
+		},
+		"no-custom-fields": {
+			assertInput: func(t *testing.T, input assistant.ActionInput) {
+				assert.NotContains(t, input.Fields, "custom_fields")
+			},
+		},
+		"list-error-falls-back-to-static-definition": {
+			listErr: errors.New("db error"),
+			assertInput: func(t *testing.T, input assistant.ActionInput) {
+				assert.NotContains(t, input.Fields, "custom_fields")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFieldRepo := todo.NewMockCustomFieldRepository(t)
+			customFieldRepo.EXPECT().ListCustomFields(mock.Anything).Return(tt.fields, tt.listErr).Once()
+
+			action := NewFetchTodosAction(
+				todo.NewMockRepository(t),
+				todo.NewMockTimeEntryRepository(t),
+				todo.NewMockCommentRepository(t),
+				customFieldRepo,
+				semantic.NewMockEncoder(t),
+				core.NewMockCurrentTimeProvider(t),
+				"embedding-model",
+			)
+
+			definition := action.DefinitionFor(t.Context())
+			assert.Equal(t, "fetch_todos", definition.Name)
+			tt.assertInput(t, definition.Input)
+		})
+	}
+}
+
+func TestCreateTodosAction_DefinitionFor(t *testing.T) {
+	t.Parallel()
+
+	customFieldRepo := todo.NewMockCustomFieldRepository(t)
+	customFieldRepo.EXPECT().ListCustomFields(mock.Anything).
+		Return([]todo.CustomField{{Name: "due_review", Type: todo.CustomFieldType_DATE}}, nil).
+		Once()
+
+	action := NewCreateTodosAction(
+		transaction.NewMockUnitOfWork(t),
+		todouc.NewMockCreator(t),
+		customFieldRepo,
+		core.NewMockCurrentTimeProvider(t),
+	)
+
+	definition := action.DefinitionFor(t.Context())
+	field := definition.Input.Fields["todos"].Items.Fields["custom_fields"]
+	assert.Equal(t, "object", field.Type)
+	assert.Equal(t, "date", field.Fields["due_review"].Format)
+
+	// The static definition is left untouched.
+	assert.NotContains(t, action.Definition().Input.Fields["todos"].Items.Fields, "custom_fields")
+}
@@ -0,0 +1,164 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// DateDiffAction is an assistant action computing the distance between two dates in calendar days,
+// weeks, months and business days.
+type DateDiffAction struct {
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewDateDiffAction creates a new instance of DateDiffAction.
+func NewDateDiffAction(timeProvider core.CurrentTimeProvider) DateDiffAction {
+	return DateDiffAction{
+		timeProvider: timeProvider,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a DateDiffAction) StatusMessage() string {
+	return "📆 Counting days..."
+}
+
+// Renderer reports that date_diff does not expose a deterministic renderer.
+func (a DateDiffAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for DateDiffAction.
+func (a DateDiffAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "date_diff",
+		Description: "Count the days, weeks, months and business days (Monday to Friday) between two dates. Use it instead of counting days yourself.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"from": {
+					Type:        "string",
+					Description: "Start date in YYYY-MM-DD, or today, tomorrow, yesterday or next <weekday>. REQUIRED.",
+					Required:    true,
+				},
+				"to": {
+					Type:        "string",
+					Description: "End date in YYYY-MM-DD, or today, tomorrow, yesterday or next <weekday>. REQUIRED.",
+					Required:    true,
+				},
+				"timezone": {
+					Type:        "string",
+					Description: "Optional IANA time zone used to resolve relative dates such as today. Defaults to UTC.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes DateDiffAction.
+func (a DateDiffAction) Execute(_ context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		From     string  `json:"from"`
+		To       string  `json:"to"`
+		Timezone *string `json:"timezone"`
+	}{}
+	exampleArgs := `{"from":"today","to":"2026-12-24"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	loc, err := loadTimezone(params.Timezone)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_timezone", err.Error(), exampleArgs)
+	}
+
+	now := a.timeProvider.Now().In(loc)
+	from, ok := parseDiffDate(params.From, now, loc)
+	if !ok {
+		return newActionErrorMessage(call, "invalid_from", fmt.Sprintf("could not parse date %q.", params.From), exampleArgs)
+	}
+	to, ok := parseDiffDate(params.To, now, loc)
+	if !ok {
+		return newActionErrorMessage(call, "invalid_to", fmt.Sprintf("could not parse date %q.", params.To), exampleArgs)
+	}
+
+	days := daysBetween(from, to)
+	months, monthDays := monthsBetween(from, to)
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"from":          from.Format(time.DateOnly),
+		"to":            to.Format(time.DateOnly),
+		"days":          days,
+		"weeks":         days / 7,
+		"extra_days":    days % 7,
+		"months":        months,
+		"month_days":    monthDays,
+		"business_days": businessDaysBetween(from, to),
+	})
+}
+
+// parseDiffDate parses a YYYY-MM-DD date or a relative date phrase into a civil date at midnight UTC,
+// so day arithmetic is not affected by daylight saving changes.
+func parseDiffDate(value string, now time.Time, loc *time.Location) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	parsed, err := time.ParseInLocation(time.DateOnly, value, loc)
+	if err != nil {
+		var ok bool
+		if parsed, ok = core.ExtractTimeFromText(value, now, loc); !ok {
+			return time.Time{}, false
+		}
+		parsed = parsed.In(loc)
+	}
+	return time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC), true
+}
+
+// daysBetween returns the signed number of calendar days from from to to.
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// monthsBetween splits the distance from from to to into whole calendar months and remaining days,
+// both negative when to is before from.
+func monthsBetween(from, to time.Time) (int, int) {
+	if to.Before(from) {
+		months, days := monthsBetween(to, from)
+		return -months, -days
+	}
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	anchor := addMonthsClamped(from, months)
+	if anchor.After(to) {
+		months--
+		anchor = addMonthsClamped(from, months)
+	}
+	return months, daysBetween(anchor, to)
+}
+
+// addMonthsClamped adds months to t, clamping the day to the end of shorter months (Jan 31 + 1 month is
+// Feb 28 or 29).
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), min(t.Day(), lastDay), 0, 0, 0, 0, time.UTC)
+}
+
+// businessDaysBetween counts the weekdays from from up to, but not including, to. It is negative when to
+// is before from.
+func businessDaysBetween(from, to time.Time) int {
+	if to.Before(from) {
+		return -businessDaysBetween(to, from)
+	}
+	days := daysBetween(from, to)
+	count := (days / 7) * 5
+	for d := from.AddDate(0, 0, (days/7)*7); d.Before(to); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateDiffAction(t *testing.T) {
+	t.Parallel()
+
+	// Monday 2026-03-02 01:00 UTC is still Sunday 2026-03-01 in New York.
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		input        string
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"absolute-dates": {
+			input: `{"from":"2026-01-31","to":"2026-03-15"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"days":43`)
+				assert.Contains(t, resp.Content, `"weeks":6`)
+				assert.Contains(t, resp.Content, `"extra_days":1`)
+				assert.Contains(t, resp.Content, `"months":1`)
+				assert.Contains(t, resp.Content, `"month_days":15`)
+				assert.Contains(t, resp.Content, `"business_days":30`)
+			},
+		},
+		"relative-dates": {
+			input: `{"from":"today","to":"next friday"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"from":"2026-03-02"`)
+				assert.Contains(t, resp.Content, `"to":"2026-03-06"`)
+				assert.Contains(t, resp.Content, `"days":4`)
+				assert.Contains(t, resp.Content, `"business_days":4`)
+			},
+		},
+		"relative-dates-in-user-timezone": {
+			input: `{"from":"today","to":"2026-03-09","timezone":"America/New_York"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"from":"2026-03-01"`)
+				assert.Contains(t, resp.Content, `"days":8`)
+				assert.Contains(t, resp.Content, `"business_days":5`)
+			},
+		},
+		"backwards": {
+			input: `{"from":"2026-03-15","to":"2026-01-31"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"days":-43`)
+				assert.Contains(t, resp.Content, `"months":-1`)
+				assert.Contains(t, resp.Content, `"month_days":-15`)
+				assert.Contains(t, resp.Content, `"business_days":-30`)
+			},
+		},
+		"invalid-from": {
+			input: `{"from":"someday","to":"2026-03-15"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_from")
+			},
+		},
+		"invalid-timezone": {
+			input: `{"from":"today","to":"tomorrow","timezone":"Nowhere"}`,
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_timezone")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			action := NewDateDiffAction(timeProvider)
+			assert.Equal(t, "date_diff", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "date_diff", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		from, to       string
+		expectedMonths int
+		expectedDays   int
+	}{
+		"same-day":         {from: "2026-03-02", to: "2026-03-02", expectedMonths: 0, expectedDays: 0},
+		"whole-months":     {from: "2026-01-15", to: "2026-04-15", expectedMonths: 3, expectedDays: 0},
+		"month-end-clamp":  {from: "2026-01-31", to: "2026-02-28", expectedMonths: 1, expectedDays: 0},
+		"partial-month":    {from: "2026-01-20", to: "2026-03-05", expectedMonths: 1, expectedDays: 13},
+		"across-year":      {from: "2025-11-30", to: "2026-01-01", expectedMonths: 1, expectedDays: 2},
+		"negative-partial": {from: "2026-03-05", to: "2026-01-20", expectedMonths: -1, expectedDays: -13},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			from, _ := time.Parse(time.DateOnly, tt.from)
+			to, _ := time.Parse(time.DateOnly, tt.to)
+			months, days := monthsBetween(from, to)
+			assert.Equal(t, tt.expectedMonths, months)
+			assert.Equal(t, tt.expectedDays, days)
+		})
+	}
+}
@@ -41,6 +41,7 @@ func (a DeleteTodosAction) Definition() assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name:        "delete_todos",
 		Description: "Delete multiple todos.",
+		Sandboxed:   true,
 		Input: assistant.ActionInput{
 			Type: "object",
 			Fields: map[string]assistant.ActionField{
@@ -95,45 +96,21 @@ func (a DeleteTodosAction) Execute(ctx context.Context, call assistant.ActionCal
 
 	err := unmarshalActionInput(call.Input, &params)
 	if err != nil {
-		content := newActionError("invalid_arguments", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
 	}
 	if len(params.Todos) == 0 {
-		content := newActionError("invalid_arguments", "todos must not be empty.", exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", "todos must not be empty.", exampleArgs)
 	}
 
 	ids := make([]uuid.UUID, 0, len(params.Todos))
 	for i, todo := range params.Todos {
 		todoID, parseErr := uuid.Parse(todo.ID)
 		if parseErr != nil {
-			content := newActionError("invalid_todo_id", fmt.Sprintf("id at index %d is invalid: %s", i, parseErr.Error()), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_todo_id", fmt.Sprintf("id at index %d is invalid: %s", i, parseErr.Error()), exampleArgs)
 		}
 
 		if strings.TrimSpace(todo.Title) == "" {
-			content := newActionError("invalid_title", fmt.Sprintf("title at index %d must not be empty.", i), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_title", fmt.Sprintf("title at index %d must not be empty.", i), exampleArgs)
 		}
 
 		ids = append(ids, todoID)
@@ -149,18 +126,8 @@ func (a DeleteTodosAction) Execute(ctx context.Context, call assistant.ActionCal
 		return nil
 	})
 	if err != nil {
-		content := newActionError("delete_todos_error", fmt.Sprintf("Failed to delete todos: %s", err.Error()), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "delete_todos_error", fmt.Sprintf("Failed to delete todos: %s", err.Error()), exampleArgs)
 	}
 
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      formatDeletedRows(ids),
-	}
+	return newDeletedTodosResultMessage(call, ids)
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/toon-format/toon-go"
 )
 
 func TestDeleteTodosAction(t *testing.T) {
@@ -52,10 +51,10 @@ func TestDeleteTodosAction(t *testing.T) {
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				payload := struct {
 					Todos []struct {
-						Deleted bool `toon:"deleted"`
-					} `toon:"todos"`
+						Deleted bool `json:"deleted"`
+					} `json:"todos"`
 				}{}
-				assert.NoError(t, toon.UnmarshalString(resp.Content, &payload))
+				assert.True(t, unmarshalResultData(resp, &payload))
 				assert.Len(t, payload.Todos, 2)
 			},
 		},
@@ -130,6 +129,7 @@ func TestDeleteTodosAction(t *testing.T) {
 			assert.Equal(t, "delete_todos", definition.Name)
 			assert.NotEmpty(t, definition.Description)
 			assert.NotEmpty(t, definition.Input)
+			assert.False(t, definition.ReadOnly)
 
 			resp := action.Execute(t.Context(), tt.functionCall, []assistant.Message{})
 			tt.validateResp(t, resp)
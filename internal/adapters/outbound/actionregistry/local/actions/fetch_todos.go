@@ -3,30 +3,60 @@ package actions
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
-	"github.com/toon-format/toon-go"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// recentCommentsPerTodo is the number of newest comments included per todo when include_comments is set.
+const recentCommentsPerTodo = 3
+
+// approximateTotalLimit caps the count of matching todos reported when there are more pages, so that broad
+// queries stay cheap to count.
+const approximateTotalLimit = 1000
+
 // NewFetchTodosAction creates a new instance of FetchTodosAction.
-func NewFetchTodosAction(repo todo.Repository, semanticEncoder semantic.Encoder, embeddingModel string) FetchTodosAction {
+func NewFetchTodosAction(
+	repo todo.Repository,
+	timeEntryRepo todo.TimeEntryRepository,
+	commentRepo todo.CommentRepository,
+	customFieldRepo todo.CustomFieldRepository,
+	semanticEncoder semantic.Encoder,
+	timeProvider core.CurrentTimeProvider,
+	embeddingModel string,
+) FetchTodosAction {
 	return FetchTodosAction{
 		repo:            repo,
+		timeEntryRepo:   timeEntryRepo,
+		commentRepo:     commentRepo,
+		customFieldRepo: customFieldRepo,
 		semanticEncoder: semanticEncoder,
+		timeProvider:    timeProvider,
 		embeddingModel:  embeddingModel,
+		prefetcher:      newTodoListPrefetcher(repo),
 	}
 }
 
 // FetchTodosAction is an assistant action for fetching todos.
 type FetchTodosAction struct {
 	repo            todo.Repository
+	timeEntryRepo   todo.TimeEntryRepository
+	commentRepo     todo.CommentRepository
+	customFieldRepo todo.CustomFieldRepository
 	semanticEncoder semantic.Encoder
+	timeProvider    core.CurrentTimeProvider
 	embeddingModel  string
+	prefetcher      *todoListPrefetcher
 }
 
 // StatusMessage returns a status message about the action execution.
@@ -39,11 +69,22 @@ func (t FetchTodosAction) Renderer() (assistant.ActionResultRenderer, bool) {
 	return nil, false
 }
 
+// Prefetch starts the todo query in the background when the latest user message plainly asks to list todos.
+func (lft FetchTodosAction) Prefetch(ctx context.Context, messages []assistant.Message) {
+	lft.prefetcher.Start(ctx, messages)
+}
+
+// DiscardPrefetched drops prefetched todo lists, e.g. after another action may have changed todos.
+func (lft FetchTodosAction) DiscardPrefetched() {
+	lft.prefetcher.Discard()
+}
+
 // Definition returns the assistant action definition for FetchTodosAction.
 func (lft FetchTodosAction) Definition() assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name:        "fetch_todos",
 		Description: "Fetch todos with pagination and optional filters.",
+		ReadOnly:    true,
 		Input: assistant.ActionInput{
 			Type: "object",
 			Fields: map[string]assistant.ActionField{
@@ -75,9 +116,9 @@ func (lft FetchTodosAction) Definition() assistant.ActionDefinition {
 				},
 				"sort_by": {
 					Type:        "string",
-					Description: "Optional sort. Allowed: dueDateAsc, dueDateDesc, createdAtAsc, createdAtDesc, similarityAsc, similarityDesc. Use similarity sort only with search_by_similarity. similarityAsc returns most similar first.",
+					Description: "Optional sort. Allowed: dueDateAsc, dueDateDesc, createdAtAsc, createdAtDesc, similarityAsc, similarityDesc, smartOrder. Use similarity sort only with search_by_similarity. similarityAsc returns most similar first. smartOrder lists what to do first: overdue open todos, then by the ENUM custom field named priority (first option highest; ignored when the tenant has no such field) and by due date proximity of open todos.",
 					Required:    false,
-					Enum:        []any{"dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc"},
+					Enum:        []any{"dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc", "smartOrder"},
 				},
 				"due_after": {
 					Type:        "string",
@@ -89,22 +130,80 @@ func (lft FetchTodosAction) Definition() assistant.ActionDefinition {
 					Description: "Optional upper due-date bound in YYYY-MM-DD. Must be provided together with due_after and should not be earlier than due_after.",
 					Required:    false,
 				},
+				"include_logged_time": {
+					Type:        "boolean",
+					Description: "Optional. When true, each todo includes logged_minutes with the total time logged against it. Use it to answer how long was spent on todos.",
+					Required:    false,
+				},
+				"include_archived": {
+					Type:        "boolean",
+					Description: "Optional. Completed todos are archived automatically after a while and hidden by default. When true, archived todos are included and marked with archived=true. Use it when the user asks about old or archived completed todos.",
+					Required:    false,
+				},
+				"include_comments": {
+					Type:        "boolean",
+					Description: "Optional. When true, the output includes a comments table with the most recent notes on each returned todo, written by the user or recorded by the assistant when it changed the todo.",
+					Required:    false,
+				},
+				"near_lat": {
+					Type:        "number",
+					Description: "Optional latitude, in decimal degrees, of a place to find todos located near it (e.g., what can I do while I'm downtown?). Must be provided together with near_lon. Todos without coordinates are left out and each returned todo includes distance_km.",
+					Required:    false,
+				},
+				"near_lon": {
+					Type:        "number",
+					Description: "Optional longitude, in decimal degrees, of the place. Must be provided together with near_lat.",
+					Required:    false,
+				},
+				"near_radius_km": {
+					Type:        "number",
+					Description: "Optional radius in kilometers around near_lat and near_lon, up to 100. Defaults to 2.",
+					Required:    false,
+				},
+				"explain": {
+					Type:        "boolean",
+					Description: "Optional. When true, the output includes explain, a SQL-like description of the effective query. Use it to tell the user why todos did or did not match.",
+					Required:    false,
+				},
 			},
 		},
 	}
 }
 
+// DefinitionFor returns the fetch_todos definition with a custom_fields filter describing the custom fields
+// of the tenant in ctx. Tenants without custom fields get the static definition.
+func (lft FetchTodosAction) DefinitionFor(ctx context.Context) assistant.ActionDefinition {
+	definition := lft.Definition()
+	fields := listCustomFields(ctx, lft.customFieldRepo)
+	if len(fields) == 0 {
+		return definition
+	}
+	definition.Input.Fields["custom_fields"] = customFieldsInput(
+		"Optional filter on the custom fields the user defined on todos. Only todos holding all the given values are returned. Each todo in the result includes its custom_fields.",
+		fields,
+	)
+	return definition
+}
+
 // Execute executes FetchTodosAction.
 func (lft FetchTodosAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
 	params := struct {
-		Page               int     `json:"page"`
-		PageSize           int     `json:"page_size"`
-		Status             *string `json:"status"`
-		SearchBySimilarity *string `json:"search_by_similarity"`
-		SearchByTitle      *string `json:"search_by_title"`
-		SortBy             *string `json:"sort_by"`
-		DueAfter           *string `json:"due_after"`
-		DueBefore          *string `json:"due_before"`
+		Page               int                    `json:"page"`
+		PageSize           int                    `json:"page_size"`
+		Status             *string                `json:"status"`
+		SearchBySimilarity *string                `json:"search_by_similarity"`
+		SearchByTitle      *string                `json:"search_by_title"`
+		SortBy             *string                `json:"sort_by"`
+		DueAfter           *string                `json:"due_after"`
+		DueBefore          *string                `json:"due_before"`
+		IncludeLoggedTime  bool                   `json:"include_logged_time"`
+		IncludeComments    bool                   `json:"include_comments"`
+		IncludeArchived    bool                   `json:"include_archived"`
+		CustomFields       todo.CustomFieldValues `json:"custom_fields"`
+		NearLat            *float64               `json:"near_lat"`
+		NearLon            *float64               `json:"near_lon"`
+		NearRadiusKm       *float64               `json:"near_radius_km"`
+		Explain            bool                   `json:"explain"`
 	}{
 		Page:     1,  // default page
 		PageSize: 10, // default page size
@@ -114,103 +213,322 @@ func (lft FetchTodosAction) Execute(ctx context.Context, call assistant.ActionCa
 
 	err := unmarshalActionInput(call.Input, &params)
 	if err != nil {
-		content := newActionError("invalid_arguments", fmt.Sprintf("failed to parse action input: %s", err.Error()), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", fmt.Sprintf("failed to parse action input: %s", err.Error()), exampleArgs)
 	}
 
 	var dueAfterTime *time.Time
 	var dueBeforeTime *time.Time
 	if params.DueAfter != nil || params.DueBefore != nil {
 		var errMsg *assistant.Message
-		dueAfterTime, dueBeforeTime, errMsg = parseDueDateParams(params.DueAfter, params.DueBefore, exampleArgs)
+		dueAfterTime, dueBeforeTime, errMsg = parseDueDateParams(call, params.DueAfter, params.DueBefore, exampleArgs)
 		if errMsg != nil {
-			errMsg.ActionCallID = &call.ID
 			return *errMsg
 		}
 	}
 
+	var customFields todo.CustomFieldValues
+	if len(params.CustomFields) > 0 {
+		fields, err := lft.customFieldRepo.ListCustomFields(ctx)
+		if err != nil {
+			return newActionErrorMessage(call, "list_custom_fields_error", fmt.Sprintf("failed to list custom fields:%s", err.Error()), exampleArgs)
+		}
+		customFields, err = todo.CustomFieldValues(nil).Apply(params.CustomFields, fields)
+		if err != nil {
+			return newActionErrorMessage(call, "invalid_custom_fields", err.Error(), exampleArgs)
+		}
+	}
+
 	buildResult, err := todouc.NewSearchBuilder().
 		WithStatus((*todo.Status)(params.Status)).
 		WithDueDateRange(dueAfterTime, dueBeforeTime).
 		WithSortBy(params.SortBy).
 		WithTitleContains(params.SearchByTitle).
 		WithSimilaritySearch(params.SearchBySimilarity).
+		WithIncludeArchived(params.IncludeArchived).
+		WithCustomFields(customFields).
+		WithNear(params.NearLat, params.NearLon, params.NearRadiusKm).
+		WithToday(lft.timeProvider.Now()).
 		Build(ctx, lft.semanticEncoder, lft.embeddingModel)
 	if err != nil {
 		code := mapTodoFilterBuildErrCode(err)
-		content := newActionError(code, err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, code, err.Error(), exampleArgs)
 	}
 	if buildResult.EmbeddingTotalTokens > 0 {
 		metrics.RecordLLMTokensEmbedding(ctx, buildResult.EmbeddingTotalTokens)
 	}
 
-	todos, hasMore, err := lft.repo.ListTodos(ctx, params.Page, params.PageSize, buildResult.Options...)
+	var (
+		todos      []todo.Todo
+		hasMore    bool
+		prefetched bool
+	)
+	// Only status filters are prefetched; searches, sorting, due ranges, custom fields, proximity and archived
+	// todos always query the repository.
+	if params.SearchBySimilarity == nil && params.SearchByTitle == nil && params.SortBy == nil && dueAfterTime == nil &&
+		!params.IncludeArchived && len(customFields) == 0 && params.NearLat == nil {
+		todos, hasMore, prefetched = lft.prefetchedPage(ctx, params.Status, params.Page, params.PageSize)
+	}
+	if !prefetched {
+		todos, hasMore, err = lft.repo.ListTodos(ctx, params.Page, params.PageSize, buildResult.Options...)
+	}
 	if err != nil {
-		content := newActionError("list_todos_error", fmt.Sprintf("failed to list todos:%s", err.Error()), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "list_todos_error", fmt.Sprintf("failed to list todos:%s", err.Error()), exampleArgs)
 	}
 
 	if len(todos) == 0 {
 		todos = []todo.Todo{}
 	}
 
-	type result struct {
-		ID      string `toon:"id"`
-		Title   string `toon:"title"`
-		DueDate string `toon:"due_date"`
-		Status  string `toon:"status"`
+	listParams := todo.NewListParams(buildResult.Options...)
+	var scores map[uuid.UUID]float64
+	if len(listParams.Embedding) > 0 && len(todos) > 0 {
+		scores, err = lft.repo.ScoreTodos(ctx, todoIDs(todos), buildResult.Options...)
+		if err != nil {
+			return newActionErrorMessage(call, "score_todos_error", fmt.Sprintf("failed to score todos:%s", err.Error()), exampleArgs)
+		}
 	}
 
-	todosResult := make([]result, len(todos))
-	for i, t := range todos {
-		todosResult[i] = result{
-			ID:      t.ID.String(),
-			Title:   t.Title,
-			DueDate: t.DueDate.Format(time.DateOnly),
-			Status:  string(t.Status),
+	var approximateTotal any = (params.Page-1)*params.PageSize + len(todos)
+	if hasMore {
+		count, err := lft.repo.CountTodos(ctx, approximateTotalLimit, buildResult.Options...)
+		if err != nil {
+			return newActionErrorMessage(call, "count_todos_error", fmt.Sprintf("failed to count todos:%s", err.Error()), exampleArgs)
+		}
+		approximateTotal = count
+		if count >= approximateTotalLimit {
+			approximateTotal = fmt.Sprintf("%d+", approximateTotalLimit)
 		}
 	}
 
-	var nextPage *int
+	var todosResult any
+	if params.IncludeLoggedTime {
+		todosResult, err = lft.todosWithLoggedTime(ctx, todos, scores, listParams.Near)
+		if err != nil {
+			return newActionErrorMessage(call, "logged_time_error", fmt.Sprintf("failed to sum logged time:%s", err.Error()), exampleArgs)
+		}
+	} else {
+		type result struct {
+			ID               string                 `json:"id"`
+			Title            string                 `json:"title"`
+			DueDate          string                 `json:"due_date"`
+			Status           string                 `json:"status"`
+			EstimatedMinutes int                    `json:"estimated_minutes,omitempty"`
+			Archived         bool                   `json:"archived,omitempty"`
+			CustomFields     todo.CustomFieldValues `json:"custom_fields,omitempty"`
+			Location         string                 `json:"location,omitempty"`
+			Recurrence       string                 `json:"recurrence,omitempty"`
+			DistanceKm       *float64               `json:"distance_km,omitempty"`
+			Similarity       *float64               `json:"similarity,omitempty"`
+		}
+
+		rows := make([]result, len(todos))
+		for i, t := range todos {
+			rows[i] = result{
+				ID:               t.ID.String(),
+				Title:            t.Title,
+				DueDate:          t.DueDate.Format(time.DateOnly),
+				Status:           string(t.Status),
+				EstimatedMinutes: t.EstimatedMinutes,
+				Archived:         t.IsArchived(),
+				CustomFields:     t.CustomFields,
+				Location:         locationOf(t),
+				Recurrence:       recurrenceOf(t),
+				DistanceKm:       distanceOf(listParams.Near, t),
+				Similarity:       similarityOf(scores, t.ID),
+			}
+		}
+		todosResult = rows
+	}
+
+	pagination := assistant.ActionResultPagination{Page: params.Page, PageSize: params.PageSize}
 	if hasMore {
 		nxt := params.Page + 1
-		nextPage = &nxt
+		pagination.NextPage = &nxt
 	}
 
 	output := map[string]any{
-		"todos":     todosResult,
-		"next_page": nextPage,
+		"todos":             todosResult,
+		"approximate_total": approximateTotal,
+	}
+	if filters := appliedTodoFilters(listParams, params.SearchBySimilarity); len(filters) > 0 {
+		output["applied_filters"] = filters
+	}
+	if params.Explain {
+		output["explain"] = listParams.Explain()
+	}
+	if params.IncludeComments {
+		comments, err := lft.recentComments(ctx, todos)
+		if err != nil {
+			return newActionErrorMessage(call, "comments_error", fmt.Sprintf("failed to list comments:%s", err.Error()), exampleArgs)
+		}
+		output["comments"] = comments
 	}
-	content, err := toon.Marshal(output)
+	return assistant.NewPaginatedActionResultMessage(call, output, pagination)
+}
+
+// prefetchedPage serves the requested page from a speculative todo query with the same status filter.
+func (lft FetchTodosAction) prefetchedPage(ctx context.Context, status *string, page, pageSize int) ([]todo.Todo, bool, bool) {
+	todos, hasMore, ok := lft.prefetcher.Page(ctx, (*todo.Status)(status), page, pageSize)
+	if ok {
+		trace.SpanFromContext(ctx).AddEvent("Served prefetched todos", trace.WithAttributes(
+			attribute.Int("page", page),
+			attribute.Int("page_size", pageSize),
+		))
+	}
+	return todos, hasMore, ok
+}
+
+// todosWithLoggedTime builds result rows that include the total logged time of each todo.
+func (lft FetchTodosAction) todosWithLoggedTime(ctx context.Context, todos []todo.Todo, scores map[uuid.UUID]float64, near *todo.NearFilter) (any, error) {
+	type result struct {
+		ID               string                 `json:"id"`
+		Title            string                 `json:"title"`
+		DueDate          string                 `json:"due_date"`
+		Status           string                 `json:"status"`
+		EstimatedMinutes int                    `json:"estimated_minutes,omitempty"`
+		LoggedMinutes    int                    `json:"logged_minutes"`
+		Archived         bool                   `json:"archived,omitempty"`
+		CustomFields     todo.CustomFieldValues `json:"custom_fields,omitempty"`
+		Location         string                 `json:"location,omitempty"`
+		Recurrence       string                 `json:"recurrence,omitempty"`
+		DistanceKm       *float64               `json:"distance_km,omitempty"`
+		Similarity       *float64               `json:"similarity,omitempty"`
+	}
+
+	totals, err := lft.timeEntryRepo.SumLoggedTime(ctx, todoIDs(todos))
 	if err != nil {
-		errorContent := newActionError("marshal_error", err.Error(), "")
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      errorContent,
-			ActionError:  &errorContent,
+		return nil, err
+	}
+
+	rows := make([]result, len(todos))
+	for i, t := range todos {
+		rows[i] = result{
+			ID:               t.ID.String(),
+			Title:            t.Title,
+			DueDate:          t.DueDate.Format(time.DateOnly),
+			Status:           string(t.Status),
+			EstimatedMinutes: t.EstimatedMinutes,
+			LoggedMinutes:    int(totals[t.ID].Minutes()),
+			Archived:         t.IsArchived(),
+			CustomFields:     t.CustomFields,
+			Location:         locationOf(t),
+			Recurrence:       recurrenceOf(t),
+			DistanceKm:       distanceOf(near, t),
+			Similarity:       similarityOf(scores, t.ID),
 		}
 	}
+	return rows, nil
+}
+
+// recentComments builds result rows with the newest comments of each todo, in todo order.
+func (lft FetchTodosAction) recentComments(ctx context.Context, todos []todo.Todo) (any, error) {
+	type result struct {
+		TodoID    string `json:"todo_id"`
+		Author    string `json:"author"`
+		CreatedAt string `json:"created_at"`
+		Body      string `json:"body"`
+	}
+
+	recent, err := lft.commentRepo.ListRecentComments(ctx, todoIDs(todos), recentCommentsPerTodo)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []result{}
+	for _, t := range todos {
+		for _, c := range recent[t.ID] {
+			rows = append(rows, result{
+				TodoID:    t.ID.String(),
+				Author:    string(c.Author),
+				CreatedAt: c.CreatedAt.Format(time.RFC3339),
+				Body:      c.Body,
+			})
+		}
+	}
+	return rows, nil
+}
+
+// todoIDs returns the IDs of the todos, in order.
+func todoIDs(todos []todo.Todo) []uuid.UUID {
+	ids := make([]uuid.UUID, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+// similarityOf returns the similarity score of a todo rounded to three decimals, or nil without one.
+func similarityOf(scores map[uuid.UUID]float64, id uuid.UUID) *float64 {
+	score, ok := scores[id]
+	if !ok {
+		return nil
+	}
+	rounded := math.Round(score*1000) / 1000
+	return &rounded
+}
+
+// locationOf returns the location of a todo as shown to the model, or an empty string without one.
+func locationOf(t todo.Todo) string {
+	if t.Location == nil {
+		return ""
+	}
+	return t.Location.String()
+}
+
+// recurrenceOf returns the RRULE a todo repeats by, or an empty string when it does not recur.
+func recurrenceOf(t todo.Todo) string {
+	if t.Recurrence == nil {
+		return ""
+	}
+	return t.Recurrence.String()
+}
 
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      string(content),
+// distanceOf returns the distance in kilometers from the proximity filter point to the todo, rounded to one
+// decimal, or nil without a proximity filter or todo coordinates.
+func distanceOf(near *todo.NearFilter, t todo.Todo) *float64 {
+	if near == nil || t.Location == nil || t.Location.Point == nil {
+		return nil
+	}
+	distance := math.Round(near.Point.DistanceKm(*t.Location.Point)*10) / 10
+	return &distance
+}
+
+// appliedTodoFilters describes the filters of a fetch_todos query with the names of its input fields, so the
+// model can tell which ones narrowed the result.
+func appliedTodoFilters(params todo.ListParams, similarityQuery *string) map[string]any {
+	filters := map[string]any{}
+	if params.Status != nil {
+		filters["status"] = *params.Status
+	}
+	if len(params.Embedding) > 0 && similarityQuery != nil {
+		filters["search_by_similarity"] = strings.TrimSpace(*similarityQuery)
+	}
+	if params.TitleContains != nil {
+		filters["search_by_title"] = *params.TitleContains
+	}
+	if params.DueAfter != nil && params.DueBefore != nil {
+		filters["due_after"] = params.DueAfter.Format(time.DateOnly)
+		filters["due_before"] = params.DueBefore.Format(time.DateOnly)
+	}
+	if params.IncludeArchived {
+		filters["include_archived"] = true
+	}
+	if len(params.CustomFields) > 0 {
+		filters["custom_fields"] = params.CustomFields
+	}
+	if params.Near != nil {
+		filters["near_lat"] = params.Near.Point.Latitude
+		filters["near_lon"] = params.Near.Point.Longitude
+		filters["near_radius_km"] = params.Near.RadiusKm
+	}
+	if params.SortBy != nil && params.SortBy.Field == todo.SortSmartOrder {
+		filters["sort_by"] = todo.SortSmartOrder
+	} else if params.SortBy != nil {
+		direction := "Asc"
+		if params.SortBy.Direction == "DESC" {
+			direction = "Desc"
+		}
+		filters["sort_by"] = params.SortBy.Field + direction
 	}
+	return filters
 }
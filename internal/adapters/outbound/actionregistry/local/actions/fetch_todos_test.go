@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/google/uuid"
@@ -51,7 +52,7 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "todos[1]{id,title,due_date,status}:")
+				assert.Contains(t, resp.Content, `"todos":[{"id":"`+testTodo.ID.String()+`","title":"Test Todo","due_date":"2026-01-24","status":"OPEN"}]`)
 			},
 		},
 		"fetch-todos-with-status-and-similarity": {
@@ -75,9 +76,14 @@ func TestFetchTodosAction(t *testing.T) {
 						}
 						assert.Equal(t, todo.Status_OPEN, *param.Status)
 						assert.Equal(t, []float64{0.3, 0.4}, param.Embedding)
+						assert.Equal(t, "embedding-model", param.EmbeddingModel)
 					}).
 					Return([]todo.Todo{testTodo}, false, nil).
 					Once()
+				todoRepo.EXPECT().
+					ScoreTodos(mock.Anything, []uuid.UUID{testTodo.ID}, mock.Anything).
+					Return(map[uuid.UUID]float64{testTodo.ID: 0.81234}, nil).
+					Once()
 
 			},
 			functionCall: assistant.ActionCall{
@@ -86,7 +92,8 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "todos[1]{id,title,due_date,status}:")
+				assert.Contains(t, resp.Content, `"todos":[{"id":"`+testTodo.ID.String()+`","title":"Test Todo","due_date":"2026-01-24","status":"OPEN","similarity":0.812}]`)
+				assert.Contains(t, resp.Content, `"applied_filters":{"search_by_similarity":"urgent","status":"OPEN"}`)
 			},
 		},
 		"fetch-todos-by-title": {
@@ -115,7 +122,7 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "todos[1]{id,title,due_date,status}:")
+				assert.Contains(t, resp.Content, `"todos":[{"id":"`+testTodo.ID.String()+`","title":"Test Todo","due_date":"2026-01-24","status":"OPEN"}]`)
 			},
 		},
 		"fetch-todos-with-sortby": {
@@ -142,7 +149,80 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "todos[0]:")
+				assert.Contains(t, resp.Content, `"todos":[]`)
+			},
+		},
+		"fetch-todos-including-archived": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				archived := testTodo
+				archived.Status = todo.Status_DONE
+				archived.ArchivedAt = &fixedTime
+				todoRepo.EXPECT().
+					ListTodos(
+						mock.Anything,
+						1,
+						10,
+						mock.Anything,
+					).
+					Run(func(ctx context.Context, page, pageSize int, opts ...todo.ListOption) {
+						param := todo.ListParams{}
+						for _, opt := range opts {
+							opt(&param)
+						}
+						assert.True(t, param.IncludeArchived)
+					}).
+					Return([]todo.Todo{archived}, false, nil)
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 1, "page_size": 10, "status": "DONE", "include_archived": true}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
+				assert.Contains(t, resp.Content, `"todos":[{"id":"`+testTodo.ID.String()+`","title":"Test Todo","due_date":"2026-01-24","status":"DONE","archived":true}]`)
+			},
+		},
+		"fetch-todos-near-location": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				located := testTodo
+				located.Location = &todo.Location{
+					Name:  "Post office",
+					Point: &todo.GeoPoint{Latitude: 38.7139, Longitude: -9.1394},
+				}
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Run(func(ctx context.Context, page, pageSize int, opts ...todo.ListOption) {
+						param := todo.ListParams{}
+						for _, opt := range opts {
+							opt(&param)
+						}
+						assert.Equal(t, &todo.NearFilter{
+							Point:    todo.GeoPoint{Latitude: 38.7223, Longitude: -9.1393},
+							RadiusKm: todo.DefaultNearRadiusKm,
+						}, param.Near)
+					}).
+					Return([]todo.Todo{located}, false, nil)
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 1, "page_size": 10, "near_lat": 38.7223, "near_lon": -9.1393}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
+				assert.Contains(t, resp.Content, `"status":"OPEN","location":"Post office (38.7139, -9.1394)","distance_km":0.9}]`)
+				assert.Contains(t, resp.Content, `"near_lat":38.7223,"near_lon":-9.1393,"near_radius_km":2`)
+			},
+		},
+		"fetch-todos-invalid-near": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 1, "page_size": 10, "near_lat": 38.7223}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
+				assert.Contains(t, resp.Content, "invalid_near")
 			},
 		},
 		"fetch-todos-with-due-date-filters": {
@@ -173,7 +253,7 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "todos[1]{id,title,due_date,status}:")
+				assert.Contains(t, resp.Content, `"todos":[{"id":"`+testTodo.ID.String()+`","title":"Test Todo","due_date":"2026-01-24","status":"OPEN"}]`)
 			},
 		},
 		"fetch-todos-invalid-due-after": {
@@ -301,6 +381,10 @@ func TestFetchTodosAction(t *testing.T) {
 					ListTodos(mock.Anything, 1, 10, mock.Anything).
 					Return([]todo.Todo{testTodo}, true, nil).
 					Once()
+				todoRepo.EXPECT().
+					CountTodos(mock.Anything, approximateTotalLimit, mock.Anything).
+					Return(25, nil).
+					Once()
 			},
 			functionCall: assistant.ActionCall{
 				Name:  "fetch_todos",
@@ -308,7 +392,82 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "next_page: 2\ntodos[1]")
+				assert.Contains(t, resp.Content, `"pagination":{"page":1,"page_size":10,"next_page":2}`)
+				assert.Contains(t, resp.Content, `"approximate_total":25`)
+			},
+		},
+		"fetch-todos-has-more-than-the-count-limit": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 2, 10, mock.Anything).
+					Return([]todo.Todo{testTodo}, true, nil).
+					Once()
+				todoRepo.EXPECT().
+					CountTodos(mock.Anything, approximateTotalLimit, mock.Anything).
+					Return(approximateTotalLimit, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 2, "page_size": 10, "search_by_title": "report"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, `"approximate_total":"1000+"`)
+			},
+		},
+		"fetch-todos-count-error": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{testTodo}, true, nil).
+					Once()
+				todoRepo.EXPECT().
+					CountTodos(mock.Anything, approximateTotalLimit, mock.Anything).
+					Return(0, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 1, "page_size": 10}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "count_todos_error")
+			},
+		},
+		"fetch-todos-explain": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{}, false, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 1, "page_size": 10, "status": "DONE", "search_by_title": "report", "sort_by": "createdAtDesc", "explain": true}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"applied_filters":{"search_by_title":"report","sort_by":"createdAtDesc","status":"DONE"}`)
+				assert.Contains(t, resp.Content, `"approximate_total":0`)
+				assert.Contains(t, resp.Content, `"explain":"SELECT todos WHERE status = 'DONE' AND title ILIKE '%report%' AND archived_at IS NULL ORDER BY created_at DESC"`)
+			},
+		},
+		"fetch-todos-smart-order": {
+			setupMocks: func(todoRepo *todo.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{}, false, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page": 1, "page_size": 10, "status": "OPEN", "sort_by": "smartOrder", "explain": true}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"applied_filters":{"sort_by":"smartOrder","status":"OPEN"}`)
+				assert.Contains(t, resp.Content, `"explain":"SELECT todos WHERE status = 'OPEN' AND archived_at IS NULL ORDER BY smart_order_score DESC, due_date ASC"`)
 			},
 		},
 		"fetch-todos-no-results": {
@@ -324,7 +483,7 @@ func TestFetchTodosAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Contains(t, resp.Content, "todos[0]")
+				assert.Contains(t, resp.Content, `"todos":[]`)
 			},
 		},
 	}
@@ -333,18 +492,212 @@ func TestFetchTodosAction(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			todoRepo := todo.NewMockRepository(t)
 			semanticEncoder := semantic.NewMockEncoder(t)
+			timeEntryRepo := todo.NewMockTimeEntryRepository(t)
 			tt.setupMocks(todoRepo, semanticEncoder)
 
-			action := NewFetchTodosAction(todoRepo, semanticEncoder, "embedding-model")
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)).Maybe()
+
+			action := NewFetchTodosAction(todoRepo, timeEntryRepo, todo.NewMockCommentRepository(t), todo.NewMockCustomFieldRepository(t), semanticEncoder, timeProvider, "embedding-model")
 			assert.NotEmpty(t, action.StatusMessage())
 
 			definition := action.Definition()
 			assert.Equal(t, "fetch_todos", definition.Name)
 			assert.NotEmpty(t, definition.Description)
 			assert.NotEmpty(t, definition.Input)
+			assert.True(t, definition.ReadOnly)
 
 			resp := action.Execute(t.Context(), tt.functionCall, []assistant.Message{})
 			tt.validateResp(t, resp)
 		})
 	}
 }
+
+func TestFetchTodosAction_IncludeLoggedTime(t *testing.T) {
+	t.Parallel()
+
+	testTodo := todo.Todo{
+		ID:      uuid.New(),
+		Title:   "Write report",
+		DueDate: time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC),
+		Status:  todo.Status_OPEN,
+	}
+
+	tests := map[string]struct {
+		setupMocks   func(*todo.MockRepository, *todo.MockTimeEntryRepository)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"includes-logged-minutes": {
+			setupMocks: func(todoRepo *todo.MockRepository, timeEntryRepo *todo.MockTimeEntryRepository) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{testTodo}, false, nil).
+					Once()
+				timeEntryRepo.EXPECT().
+					SumLoggedTime(mock.Anything, []uuid.UUID{testTodo.ID}).
+					Return(map[uuid.UUID]time.Duration{testTodo.ID: 90 * time.Minute}, nil).
+					Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"status":"OPEN","logged_minutes":90}`)
+			},
+		},
+		"sum-logged-time-error": {
+			setupMocks: func(todoRepo *todo.MockRepository, timeEntryRepo *todo.MockTimeEntryRepository) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{testTodo}, false, nil).
+					Once()
+				timeEntryRepo.EXPECT().
+					SumLoggedTime(mock.Anything, []uuid.UUID{testTodo.ID}).
+					Return(nil, errors.New("db error")).
+					Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "logged_time_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			todoRepo := todo.NewMockRepository(t)
+			timeEntryRepo := todo.NewMockTimeEntryRepository(t)
+			tt.setupMocks(todoRepo, timeEntryRepo)
+
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)).Maybe()
+
+			action := NewFetchTodosAction(todoRepo, timeEntryRepo, todo.NewMockCommentRepository(t), todo.NewMockCustomFieldRepository(t), semantic.NewMockEncoder(t), timeProvider, "embedding-model")
+			resp := action.Execute(t.Context(), assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page":1,"page_size":10,"include_logged_time":true}`,
+			}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
+
+func TestFetchTodosAction_IncludeComments(t *testing.T) {
+	t.Parallel()
+
+	testTodo := todo.Todo{
+		ID:      uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Title:   "Renew passport",
+		DueDate: time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC),
+		Status:  todo.Status_OPEN,
+	}
+	createdAt := time.Date(2026, 1, 20, 9, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupMocks   func(*todo.MockRepository, *todo.MockCommentRepository)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"includes-recent-comments": {
+			setupMocks: func(todoRepo *todo.MockRepository, commentRepo *todo.MockCommentRepository) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{testTodo}, false, nil).
+					Once()
+				commentRepo.EXPECT().
+					ListRecentComments(mock.Anything, []uuid.UUID{testTodo.ID}, 3).
+					Return(map[uuid.UUID][]todo.Comment{
+						testTodo.ID: {{
+							TodoID:    testTodo.ID,
+							Author:    todo.CommentAuthor_Assistant,
+							Body:      "Updated from chat: rescheduled from 2026-01-22 to 2026-01-24.",
+							CreatedAt: createdAt,
+						}},
+					}, nil).
+					Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"comments":[{"todo_id":"123e4567-e89b-12d3-a456-426614174000","author":"assistant","created_at":"2026-01-20T09:30:00Z"`)
+			},
+		},
+		"list-comments-error": {
+			setupMocks: func(todoRepo *todo.MockRepository, commentRepo *todo.MockCommentRepository) {
+				todoRepo.EXPECT().
+					ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return([]todo.Todo{testTodo}, false, nil).
+					Once()
+				commentRepo.EXPECT().
+					ListRecentComments(mock.Anything, []uuid.UUID{testTodo.ID}, 3).
+					Return(nil, errors.New("db error")).
+					Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "comments_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			todoRepo := todo.NewMockRepository(t)
+			commentRepo := todo.NewMockCommentRepository(t)
+			tt.setupMocks(todoRepo, commentRepo)
+
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)).Maybe()
+
+			action := NewFetchTodosAction(todoRepo, todo.NewMockTimeEntryRepository(t), commentRepo, todo.NewMockCustomFieldRepository(t), semantic.NewMockEncoder(t), timeProvider, "embedding-model")
+			resp := action.Execute(t.Context(), assistant.ActionCall{
+				Name:  "fetch_todos",
+				Input: `{"page":1,"page_size":10,"include_comments":true}`,
+			}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
+
+func TestFetchTodosAction_ServesPrefetchedTodos(t *testing.T) {
+	t.Parallel()
+
+	testTodo := todo.Todo{
+		ID:      uuid.New(),
+		Title:   "Buy groceries",
+		DueDate: time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC),
+		Status:  todo.Status_OPEN,
+	}
+
+	todoRepo := todo.NewMockRepository(t)
+	todoRepo.EXPECT().
+		ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+		Return([]todo.Todo{testTodo}, false, nil).
+		Once()
+
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)).Maybe()
+
+	action := NewFetchTodosAction(todoRepo, todo.NewMockTimeEntryRepository(t), todo.NewMockCommentRepository(t), todo.NewMockCustomFieldRepository(t), semantic.NewMockEncoder(t), timeProvider, "embedding-model")
+	history := []assistant.Message{{Role: assistant.ChatRole_User, Content: "show my open todos"}}
+	action.Prefetch(t.Context(), history)
+
+	resp := action.Execute(t.Context(), assistant.ActionCall{
+		ID:    "call-1",
+		Name:  "fetch_todos",
+		Input: `{"page":1,"page_size":10,"status":"OPEN"}`,
+	}, history)
+	assert.Nil(t, resp.ActionError)
+	assert.Contains(t, resp.Content, "Buy groceries")
+	assert.Contains(t, resp.Content, `"pagination":{"page":1,"page_size":10}}`)
+
+	// Another action may have changed todos, so the next call queries the repository again.
+	action.DiscardPrefetched()
+	todoRepo.EXPECT().
+		ListTodos(mock.Anything, 1, 10, mock.Anything).
+		Return([]todo.Todo{}, false, nil).
+		Once()
+	resp = action.Execute(t.Context(), assistant.ActionCall{
+		ID:    "call-2",
+		Name:  "fetch_todos",
+		Input: `{"page":1,"page_size":10,"status":"OPEN"}`,
+	}, history)
+	assert.Nil(t, resp.ActionError)
+	assert.Contains(t, resp.Content, `"todos":[]`)
+}
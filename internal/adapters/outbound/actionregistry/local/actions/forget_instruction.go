@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+)
+
+// ForgetInstructionAction is an assistant action for deleting a pinned instruction.
+type ForgetInstructionAction struct {
+	instructions chatuc.Instructions
+}
+
+// NewForgetInstructionAction creates a new instance of ForgetInstructionAction.
+func NewForgetInstructionAction(instructions chatuc.Instructions) ForgetInstructionAction {
+	return ForgetInstructionAction{
+		instructions: instructions,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a ForgetInstructionAction) StatusMessage() string {
+	return "📌 Removing pinned instruction..."
+}
+
+// Renderer reports that forget_instruction does not expose a deterministic renderer.
+func (a ForgetInstructionAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for ForgetInstructionAction.
+func (a ForgetInstructionAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "forget_instruction",
+		Description: "Delete a pinned instruction so it is no longer followed. Get the instruction ID from list_instructions first.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"instruction_id": {
+					Type:        "string",
+					Description: "ID of the pinned instruction to delete. REQUIRED.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes ForgetInstructionAction.
+func (a ForgetInstructionAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		InstructionID string `json:"instruction_id"`
+	}{}
+	exampleArgs := `{"instruction_id":"<uuid>"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	instructionID, err := uuid.Parse(params.InstructionID)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_instruction_id", err.Error(), exampleArgs)
+	}
+
+	if err := a.instructions.Delete(ctx, instructionID); err != nil {
+		var notFoundErr *core.NotFoundErr
+		if errors.As(err, &notFoundErr) {
+			return newActionErrorMessage(call, "instruction_not_found", err.Error(), exampleArgs)
+		}
+		return newActionErrorMessage(call, "forget_instruction_error", err.Error(), exampleArgs)
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"deleted_instruction_ids": []string{instructionID.String()},
+	})
+}
@@ -0,0 +1,99 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestForgetInstructionAction(t *testing.T) {
+	t.Parallel()
+
+	instructionID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setupMocks   func(*chatuc.MockInstructions)
+		functionCall assistant.ActionCall
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"deletes-instruction": {
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Delete(mock.Anything, instructionID).Return(nil).Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "forget_instruction",
+				Input: `{"instruction_id":"123e4567-e89b-12d3-a456-426614174000"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"deleted_instruction_ids":["123e4567-e89b-12d3-a456-426614174000"]`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(*chatuc.MockInstructions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "forget_instruction",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"invalid-id": {
+			setupMocks: func(*chatuc.MockInstructions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "forget_instruction",
+				Input: `{"instruction_id":"dates"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_instruction_id")
+			},
+		},
+		"not-found": {
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Delete(mock.Anything, instructionID).Return(core.NewNotFoundErr("instruction not found")).Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "forget_instruction",
+				Input: `{"instruction_id":"123e4567-e89b-12d3-a456-426614174000"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "instruction_not_found")
+			},
+		},
+		"delete-error": {
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().Delete(mock.Anything, instructionID).Return(errors.New("db error")).Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "forget_instruction",
+				Input: `{"instruction_id":"123e4567-e89b-12d3-a456-426614174000"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "forget_instruction_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			instructions := chatuc.NewMockInstructions(t)
+			tt.setupMocks(instructions)
+
+			action := NewForgetInstructionAction(instructions)
+			assert.Equal(t, "forget_instruction", action.Definition().Name)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
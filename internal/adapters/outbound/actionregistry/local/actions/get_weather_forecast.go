@@ -0,0 +1,125 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+const (
+	// defaultForecastDays is the forecast length when the model does not pass days.
+	defaultForecastDays = 7
+	// maxForecastDays bounds the forecast length the model may ask for.
+	maxForecastDays = 16
+)
+
+// GetWeatherForecastAction is an assistant action returning the daily weather forecast of a place, so
+// outdoor todos can be scheduled on suitable days.
+type GetWeatherForecastAction struct {
+	forecaster assistant.WeatherForecaster
+}
+
+// NewGetWeatherForecastAction creates a new instance of GetWeatherForecastAction.
+func NewGetWeatherForecastAction(forecaster assistant.WeatherForecaster) GetWeatherForecastAction {
+	return GetWeatherForecastAction{
+		forecaster: forecaster,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a GetWeatherForecastAction) StatusMessage() string {
+	return "🌦️ Checking the weather forecast..."
+}
+
+// Renderer reports that get_weather_forecast does not expose a deterministic renderer.
+func (a GetWeatherForecastAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for GetWeatherForecastAction.
+func (a GetWeatherForecastAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "get_weather_forecast",
+		Description: "Get the daily weather forecast of a place, starting today. Each day reports whether it is dry, to pick days for outdoor todos.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"location": {
+					Type:        "string",
+					Description: "City or place name, for example Lisbon or Porto, Portugal. REQUIRED.",
+					Required:    true,
+				},
+				"days": {
+					Type:        "integer",
+					Description: "Optional number of days to forecast, from 1 to 16. Defaults to 7.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes GetWeatherForecastAction.
+func (a GetWeatherForecastAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Location string `json:"location"`
+		Days     *int   `json:"days"`
+	}{}
+	exampleArgs := `{"location":"Lisbon","days":7}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	location := strings.TrimSpace(params.Location)
+	if location == "" {
+		return newActionErrorMessage(call, "invalid_location", "location is required.", exampleArgs)
+	}
+	days := defaultForecastDays
+	if params.Days != nil {
+		days = min(max(*params.Days, 1), maxForecastDays)
+	}
+
+	forecast, err := a.forecaster.Forecast(ctx, location, days)
+	if err != nil {
+		var notFound *core.NotFoundErr
+		if errors.As(err, &notFound) {
+			return newActionErrorMessage(call, "location_not_found", err.Error(), exampleArgs)
+		}
+		return newActionErrorMessage(call, "weather_error", err.Error(), "")
+	}
+
+	type dayOutput struct {
+		Date                     string  `json:"date"`
+		Weekday                  string  `json:"weekday"`
+		Summary                  string  `json:"summary"`
+		MinC                     float64 `json:"min_c"`
+		MaxC                     float64 `json:"max_c"`
+		PrecipitationMM          float64 `json:"precipitation_mm"`
+		PrecipitationProbability int     `json:"precipitation_probability"`
+		Dry                      bool    `json:"dry"`
+	}
+	output := make([]dayOutput, 0, len(forecast.Days))
+	for _, day := range forecast.Days {
+		output = append(output, dayOutput{
+			Date:                     day.Date.Format(time.DateOnly),
+			Weekday:                  day.Date.Weekday().String(),
+			Summary:                  day.Summary,
+			MinC:                     day.MinTemperatureC,
+			MaxC:                     day.MaxTemperatureC,
+			PrecipitationMM:          day.PrecipitationMM,
+			PrecipitationProbability: day.PrecipitationProbability,
+			Dry:                      day.Dry(),
+		})
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"location": forecast.Location,
+		"timezone": forecast.Timezone,
+		"days":     output,
+	})
+}
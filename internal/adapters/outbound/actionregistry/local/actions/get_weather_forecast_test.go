@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetWeatherForecastAction(t *testing.T) {
+	t.Parallel()
+
+	forecast := assistant.WeatherForecast{
+		Location: "Lisbon, Portugal",
+		Timezone: "Europe/Lisbon",
+		Days: []assistant.WeatherDay{
+			{
+				Date:                     time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+				Summary:                  "clear sky",
+				MinTemperatureC:          9.5,
+				MaxTemperatureC:          18,
+				PrecipitationProbability: 5,
+			},
+			{
+				Date:                     time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+				Summary:                  "rain",
+				MinTemperatureC:          11,
+				MaxTemperatureC:          15,
+				PrecipitationMM:          12.5,
+				PrecipitationProbability: 90,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		input        string
+		setupMocks   func(*assistant.MockWeatherForecaster)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"default-days": {
+			input: `{"location":" Lisbon "}`,
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(forecast, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"location":"Lisbon, Portugal"`)
+				assert.Contains(t, resp.Content, `"timezone":"Europe/Lisbon"`)
+				assert.Contains(t, resp.Content, `{"date":"2026-03-02","weekday":"Monday","summary":"clear sky","min_c":9.5,"max_c":18,"precipitation_mm":0,"precipitation_probability":5,"dry":true}`)
+				assert.Contains(t, resp.Content, `{"date":"2026-03-03","weekday":"Tuesday","summary":"rain","min_c":11,"max_c":15,"precipitation_mm":12.5,"precipitation_probability":90,"dry":false}`)
+			},
+		},
+		"days-are-clamped": {
+			input: `{"location":"Lisbon","days":30}`,
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 16).Return(forecast, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+			},
+		},
+		"missing-location": {
+			input:      `{"location":"  "}`,
+			setupMocks: func(m *assistant.MockWeatherForecaster) {},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_location")
+			},
+		},
+		"location-not-found": {
+			input: `{"location":"Atlantis"}`,
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Atlantis", 7).
+					Return(assistant.WeatherForecast{}, core.NewNotFoundErr(`location "Atlantis" not found`)).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "location_not_found")
+			},
+		},
+		"forecaster-error": {
+			input: `{"location":"Lisbon"}`,
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(assistant.WeatherForecast{}, errors.New("timeout")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "weather_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			forecaster := assistant.NewMockWeatherForecaster(t)
+			tt.setupMocks(forecaster)
+
+			action := NewGetWeatherForecastAction(forecaster)
+			assert.Equal(t, "get_weather_forecast", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "get_weather_forecast", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/google/uuid"
-	"github.com/toon-format/toon-go"
 )
 
 // extractDateParam tries to extract a date from the provided parameter
@@ -56,13 +55,13 @@ func unmarshalActionInput(arguments string, target any) error {
 	return fmt.Errorf("action arguments must contain a single JSON object")
 }
 
-// newActionError constructs a standardized error message for assistant actions.
-func newActionError(errorType, details, example string) string {
-	return fmt.Sprintf("errors[1]{error,details,example}%s,%s,%s", errorType, details, example)
+// newActionErrorMessage builds a tool message carrying a standardized action error for the given call.
+func newActionErrorMessage(call assistant.ActionCall, errorType, details, example string) assistant.Message {
+	return assistant.NewActionErrorMessage(call, errorType, details, example)
 }
 
 // parseDueDateParams parses and validates due date parameters, returning pointers to parsed times.
-func parseDueDateParams(dueAfter, dueBefore *string, exampleArgs string) (*time.Time, *time.Time, *assistant.Message) {
+func parseDueDateParams(call assistant.ActionCall, dueAfter, dueBefore *string, exampleArgs string) (*time.Time, *time.Time, *assistant.Message) {
 	var (
 		dueAfterTime  *time.Time
 		dueBeforeTime *time.Time
@@ -72,13 +71,7 @@ func parseDueDateParams(dueAfter, dueBefore *string, exampleArgs string) (*time.
 	if dueAfter != nil {
 		parsedTime, ok := core.ExtractTimeFromText(*dueAfter, now, now.Location())
 		if !ok {
-			content := newActionError("invalid_due_after", "could not parse due_after date", exampleArgs)
-			errMsg := assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: nil,
-				Content:      content,
-				ActionError:  &content,
-			}
+			errMsg := newActionErrorMessage(call, "invalid_due_after", "could not parse due_after date", exampleArgs)
 			return nil, nil, &errMsg
 		}
 		dueAfterTime = &parsedTime
@@ -87,13 +80,7 @@ func parseDueDateParams(dueAfter, dueBefore *string, exampleArgs string) (*time.
 	if dueBefore != nil {
 		parsedTime, ok := core.ExtractTimeFromText(*dueBefore, now, now.Location())
 		if !ok {
-			content := newActionError("invalid_due_before", "could not parse due_before date", exampleArgs)
-			errMsg := assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: nil,
-				Content:      content,
-				ActionError:  &content,
-			}
+			errMsg := newActionErrorMessage(call, "invalid_due_before", "could not parse due_before date", exampleArgs)
 			return nil, nil, &errMsg
 		}
 		dueBeforeTime = &parsedTime
@@ -119,50 +106,48 @@ func mapTodoFilterBuildErrCode(err error) string {
 			return "multiple_search_queries"
 		case "status must be either OPEN or DONE":
 			return "invalid_status"
+		case "latitude must be between -90 and 90", "longitude must be between -180 and 180":
+			return "invalid_near"
 		default:
+			if strings.HasPrefix(err.Error(), "near_") {
+				return "invalid_near"
+			}
 			return "invalid_filters"
 		}
 	}
 	return "embedding_error"
 }
 
-// formatTodosRows formats todos as a compact table-like payload consumed by the assistant.
-func formatTodosRows(todos []todo.Todo) string {
+// newTodosResultMessage builds the result of a todo mutation listing the affected todos.
+func newTodosResultMessage(call assistant.ActionCall, todos []todo.Todo) assistant.Message {
 	type todoRow struct {
-		ID      string `toon:"id"`
-		Title   string `toon:"title"`
-		DueDate string `toon:"due_date"`
-		Status  string `toon:"status"`
-	}
-	type payload struct {
-		Todos []todoRow `toon:"todos"`
+		ID               string `json:"id"`
+		Title            string `json:"title"`
+		DueDate          string `json:"due_date"`
+		Status           string `json:"status"`
+		EstimatedMinutes int    `json:"estimated_minutes,omitempty"`
+		Recurrence       string `json:"recurrence,omitempty"`
 	}
 
 	rows := make([]todoRow, 0, len(todos))
 	for _, todo := range todos {
 		rows = append(rows, todoRow{
-			ID:      todo.ID.String(),
-			Title:   todo.Title,
-			DueDate: todo.DueDate.Format(time.DateOnly),
-			Status:  string(todo.Status),
+			ID:               todo.ID.String(),
+			Title:            todo.Title,
+			DueDate:          todo.DueDate.Format(time.DateOnly),
+			Status:           string(todo.Status),
+			EstimatedMinutes: todo.EstimatedMinutes,
+			Recurrence:       recurrenceOf(todo),
 		})
 	}
-
-	content, err := toon.MarshalString(payload{Todos: rows})
-	if err != nil {
-		return newActionError("marshal_error", err.Error(), "")
-	}
-	return content
+	return assistant.NewActionResultMessage(call, map[string]any{"todos": rows})
 }
 
-// formatDeletedRows formats deleted todo ids as a compact table-like payload.
-func formatDeletedRows(ids []uuid.UUID) string {
+// newDeletedTodosResultMessage builds the result of a todo deletion listing the deleted ids.
+func newDeletedTodosResultMessage(call assistant.ActionCall, ids []uuid.UUID) assistant.Message {
 	type deletedRow struct {
-		ID      string `toon:"id"`
-		Deleted bool   `toon:"deleted"`
-	}
-	type payload struct {
-		Todos []deletedRow `toon:"todos"`
+		ID      string `json:"id"`
+		Deleted bool   `json:"deleted"`
 	}
 
 	rows := make([]deletedRow, 0, len(ids))
@@ -172,10 +157,5 @@ func formatDeletedRows(ids []uuid.UUID) string {
 			Deleted: true,
 		})
 	}
-
-	content, err := toon.MarshalString(payload{Todos: rows})
-	if err != nil {
-		return newActionError("marshal_error", err.Error(), "")
-	}
-	return content
+	return assistant.NewActionResultMessage(call, map[string]any{"todos": rows})
 }
@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+)
+
+// instructionRow is a pinned instruction as returned to the model.
+type instructionRow struct {
+	ID        string `json:"id"`
+	Scope     string `json:"scope"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"created_at"`
+}
+
+// toInstructionRow converts a pinned instruction into a row.
+func toInstructionRow(instruction assistant.Instruction) instructionRow {
+	scope := "conversation"
+	if instruction.IsGlobal() {
+		scope = "global"
+	}
+	return instructionRow{
+		ID:        instruction.ID.String(),
+		Scope:     scope,
+		Text:      instruction.Text,
+		CreatedAt: instruction.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// ListInstructionsAction is an assistant action for listing the pinned instructions of the conversation.
+type ListInstructionsAction struct {
+	instructions chatuc.Instructions
+}
+
+// NewListInstructionsAction creates a new instance of ListInstructionsAction.
+func NewListInstructionsAction(instructions chatuc.Instructions) ListInstructionsAction {
+	return ListInstructionsAction{
+		instructions: instructions,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a ListInstructionsAction) StatusMessage() string {
+	return "📌 Loading pinned instructions..."
+}
+
+// Renderer reports that list_instructions does not expose a deterministic renderer.
+func (a ListInstructionsAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for ListInstructionsAction.
+func (a ListInstructionsAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "list_instructions",
+		Description: "List the pinned instructions that apply to this conversation: the global ones followed by the ones pinned to this conversation, with their IDs and scope.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type:   "object",
+			Fields: map[string]assistant.ActionField{},
+		},
+	}
+}
+
+// Execute executes ListInstructionsAction.
+func (a ListInstructionsAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	// Outside a conversation only the global instructions apply.
+	conversationID, _ := assistant.ConversationIDFromContext(ctx)
+	instructions, err := a.instructions.List(ctx, conversationID)
+	if err != nil {
+		return newActionErrorMessage(call, "list_instructions_error", err.Error(), "{}")
+	}
+
+	rows := make([]instructionRow, len(instructions))
+	for i, instruction := range instructions {
+		rows[i] = toInstructionRow(instruction)
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"instructions": rows,
+	})
+}
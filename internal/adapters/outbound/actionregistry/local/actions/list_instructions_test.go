@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListInstructionsAction(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	globalID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	pinnedID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		withConversation bool
+		setupMocks       func(*chatuc.MockInstructions)
+		validateResp     func(t *testing.T, resp assistant.Message)
+	}{
+		"conversation": {
+			withConversation: true,
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().
+					List(mock.Anything, conversationID).
+					Return([]assistant.Instruction{
+						{ID: globalID, Text: "Always use DD/MM dates", CreatedAt: createdAt},
+						{ID: pinnedID, ConversationID: &conversationID, Text: "Answer in Portuguese", CreatedAt: createdAt},
+					}, nil).
+					Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `{"id":"123e4567-e89b-12d3-a456-426614174000","scope":"global","text":"Always use DD/MM dates"`)
+				assert.Contains(t, resp.Content, `{"id":"323e4567-e89b-12d3-a456-426614174002","scope":"conversation","text":"Answer in Portuguese"`)
+			},
+		},
+		"no-active-conversation": {
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().List(mock.Anything, uuid.Nil).Return([]assistant.Instruction{}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"instructions":[]`)
+			},
+		},
+		"list-error": {
+			withConversation: true,
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().List(mock.Anything, conversationID).Return(nil, errors.New("db error")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "list_instructions_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			instructions := chatuc.NewMockInstructions(t)
+			tt.setupMocks(instructions)
+
+			ctx := t.Context()
+			if tt.withConversation {
+				ctx = assistant.WithConversationID(ctx, conversationID)
+			}
+
+			action := NewListInstructionsAction(instructions)
+			assert.Equal(t, "list_instructions", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+
+			resp := action.Execute(ctx, assistant.ActionCall{Name: "list_instructions", Input: `{}`}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// viewRow is a view with its filter expressed as fetch_todos and set_ui_filters arguments, plus the
+// query_todos expression of views saved with one.
+type viewRow struct {
+	Name               string `json:"name"`
+	BuiltIn            bool   `json:"built_in"`
+	Status             string `json:"status"`
+	SearchBySimilarity string `json:"search_by_similarity"`
+	SearchByTitle      string `json:"search_by_title"`
+	SortBy             string `json:"sort_by"`
+	DueAfter           string `json:"due_after"`
+	DueBefore          string `json:"due_before"`
+	Query              string `json:"query,omitempty"`
+}
+
+// toViewRow converts a view into a row, resolving relative due ranges to concrete dates for the day of now.
+func toViewRow(view todo.View, now time.Time) viewRow {
+	filter := view.Filter.Resolve(now)
+	row := viewRow{
+		Name:    view.Name,
+		BuiltIn: view.BuiltIn,
+	}
+	if filter.Status != nil {
+		row.Status = string(*filter.Status)
+	}
+	if filter.SearchBySimilarity != nil {
+		row.SearchBySimilarity = *filter.SearchBySimilarity
+	}
+	if filter.SearchByTitle != nil {
+		row.SearchByTitle = *filter.SearchByTitle
+	}
+	if filter.SortBy != nil {
+		row.SortBy = *filter.SortBy
+	}
+	if filter.DueAfter != nil && filter.DueBefore != nil {
+		row.DueAfter = filter.DueAfter.Format(time.DateOnly)
+		row.DueBefore = filter.DueBefore.Format(time.DateOnly)
+	}
+	if filter.Query != nil {
+		row.Query = *filter.Query
+	}
+	return row
+}
+
+// ListViewsAction is an assistant action for listing the built-in and saved todo views.
+type ListViewsAction struct {
+	views        todouc.Views
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewListViewsAction creates a new instance of ListViewsAction.
+func NewListViewsAction(views todouc.Views, timeProvider core.CurrentTimeProvider) ListViewsAction {
+	return ListViewsAction{
+		views:        views,
+		timeProvider: timeProvider,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a ListViewsAction) StatusMessage() string {
+	return "🔖 Loading views..."
+}
+
+// Renderer reports that list_views does not expose a deterministic renderer.
+func (a ListViewsAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for ListViewsAction.
+func (a ListViewsAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "list_views",
+		Description: "List the built-in (Today, Upcoming, Someday) and saved todo views. Each view returns its filter as fetch_todos and set_ui_filters arguments, with relative due ranges resolved to dates.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type:   "object",
+			Fields: map[string]assistant.ActionField{},
+		},
+	}
+}
+
+// Execute executes ListViewsAction.
+func (a ListViewsAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	views, err := a.views.List(ctx)
+	if err != nil {
+		return newActionErrorMessage(call, "list_views_error", err.Error(), "{}")
+	}
+
+	now := a.timeProvider.Now()
+	rows := make([]viewRow, len(views))
+	for i, view := range views {
+		rows[i] = toViewRow(view, now)
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"views": rows,
+	})
+}
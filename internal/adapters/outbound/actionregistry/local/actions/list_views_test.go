@@ -0,0 +1,75 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListViewsAction(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockViews, *core.MockCurrentTimeProvider)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"builtin-and-saved-views": {
+			setupMocks: func(m *todouc.MockViews, tp *core.MockCurrentTimeProvider) {
+				saved := todo.View{
+					ID:   uuid.New(),
+					Name: "Work focus",
+					Filter: todo.ViewFilter{
+						Status:             common.Ptr(todo.Status_OPEN),
+						SearchBySimilarity: common.Ptr("work"),
+					},
+				}
+				m.EXPECT().List(mock.Anything).Return(append(todo.BuiltinViews(), saved), nil).Once()
+				tp.EXPECT().Now().Return(now).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `{"name":"Today","built_in":true,"status":"OPEN","search_by_similarity":"","search_by_title":"","sort_by":"dueDateAsc","due_after":"1970-01-01","due_before":"2026-03-02"}`)
+				assert.Contains(t, resp.Content, `{"name":"Upcoming","built_in":true,"status":"OPEN","search_by_similarity":"","search_by_title":"","sort_by":"dueDateAsc","due_after":"2026-03-03","due_before":"2026-03-09"}`)
+				assert.Contains(t, resp.Content, `{"name":"Someday","built_in":true,"status":"OPEN","search_by_similarity":"","search_by_title":"","sort_by":"dueDateAsc","due_after":"2026-03-10","due_before":"9999-12-31"}`)
+				assert.Contains(t, resp.Content, `{"name":"Work focus","built_in":false,"status":"OPEN","search_by_similarity":"work","search_by_title":"","sort_by":"","due_after":"","due_before":""}`)
+			},
+		},
+		"list-error": {
+			setupMocks: func(m *todouc.MockViews, tp *core.MockCurrentTimeProvider) {
+				m.EXPECT().List(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "list_views_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			views := todouc.NewMockViews(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setupMocks(views, timeProvider)
+
+			action := NewListViewsAction(views, timeProvider)
+			assert.Equal(t, "list_views", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{Name: "list_views", Input: `{}`}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+)
+
+// LogTimeAction is an assistant action for recording work sessions against a todo.
+type LogTimeAction struct {
+	tracker      todouc.TimeTracker
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewLogTimeAction creates a new instance of LogTimeAction.
+func NewLogTimeAction(tracker todouc.TimeTracker, timeProvider core.CurrentTimeProvider) LogTimeAction {
+	return LogTimeAction{
+		tracker:      tracker,
+		timeProvider: timeProvider,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a LogTimeAction) StatusMessage() string {
+	return "⏱️ Logging time..."
+}
+
+// Renderer reports that log_time does not expose a deterministic renderer.
+func (a LogTimeAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for LogTimeAction.
+func (a LogTimeAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "log_time",
+		Description: "Record a finished work session against one todo.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"todo_id": {
+					Type:        "string",
+					Description: "ID of the todo the work was done on. REQUIRED.",
+					Required:    true,
+				},
+				"minutes": {
+					Type:        "integer",
+					Description: "Duration of the work session in minutes. Positive integer. REQUIRED.",
+					Required:    true,
+				},
+				"date": {
+					Type:        "string",
+					Description: "Optional day the work was done in YYYY-MM-DD. Defaults to a session ending now.",
+					Required:    false,
+					Format:      "date",
+				},
+			},
+		},
+	}
+}
+
+// Execute executes LogTimeAction.
+func (a LogTimeAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		TodoID  string  `json:"todo_id"`
+		Minutes int     `json:"minutes"`
+		Date    *string `json:"date"`
+	}{}
+	exampleArgs := `{"todo_id":"<uuid>","minutes":25}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	todoID, err := uuid.Parse(params.TodoID)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_todo_id", err.Error(), exampleArgs)
+	}
+	if params.Minutes <= 0 {
+		return newActionErrorMessage(call, "invalid_minutes", "minutes must be greater than zero.", exampleArgs)
+	}
+
+	duration := time.Duration(params.Minutes) * time.Minute
+	now := a.timeProvider.Now()
+	startedAt := now.Add(-duration)
+	if params.Date != nil {
+		day, found := core.ExtractTimeFromText(*params.Date, now, now.Location())
+		if !found {
+			return newActionErrorMessage(call, "invalid_date", "could not parse date.", exampleArgs)
+		}
+		startedAt = day
+	}
+
+	entry, err := a.tracker.LogTime(ctx, todoID, startedAt, duration)
+	if err != nil {
+		return newActionErrorMessage(call, "log_time_error", err.Error(), exampleArgs)
+	}
+
+	type loggedRow struct {
+		ID      string `json:"id"`
+		TodoID  string `json:"todo_id"`
+		Date    string `json:"date"`
+		Minutes int    `json:"minutes"`
+	}
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"logged": []loggedRow{{
+			ID:      entry.ID.String(),
+			TodoID:  entry.TodoID.String(),
+			Date:    entry.StartedAt.Format(time.DateOnly),
+			Minutes: params.Minutes,
+		}},
+	})
+}
@@ -0,0 +1,135 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLogTimeAction(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)
+	todoID := uuid.New()
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockTimeTracker, *core.MockCurrentTimeProvider)
+		functionCall assistant.ActionCall
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"log-time-success": {
+			setupMocks: func(tracker *todouc.MockTimeTracker, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedTime).Once()
+				startedAt := fixedTime.Add(-25 * time.Minute)
+				tracker.EXPECT().
+					LogTime(mock.Anything, todoID, startedAt, 25*time.Minute).
+					Return(todo.TimeEntry{
+						ID:        uuid.New(),
+						TodoID:    todoID,
+						StartedAt: startedAt,
+						EndedAt:   common.Ptr(fixedTime),
+					}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "log_time",
+				Input: `{"todo_id":"` + todoID.String() + `","minutes":25}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"logged":[{`)
+				assert.Contains(t, resp.Content, todoID.String())
+			},
+		},
+		"log-time-with-date": {
+			setupMocks: func(tracker *todouc.MockTimeTracker, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedTime).Once()
+				tracker.EXPECT().
+					LogTime(mock.Anything, todoID, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Hour).
+					Return(todo.TimeEntry{ID: uuid.New(), TodoID: todoID, StartedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "log_time",
+				Input: `{"todo_id":"` + todoID.String() + `","minutes":60,"date":"2026-03-01"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "2026-03-01")
+			},
+		},
+		"log-time-invalid-todo-id": {
+			setupMocks: func(tracker *todouc.MockTimeTracker, tp *core.MockCurrentTimeProvider) {},
+			functionCall: assistant.ActionCall{
+				Name:  "log_time",
+				Input: `{"todo_id":"abc","minutes":25}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_todo_id")
+			},
+		},
+		"log-time-invalid-minutes": {
+			setupMocks: func(tracker *todouc.MockTimeTracker, tp *core.MockCurrentTimeProvider) {},
+			functionCall: assistant.ActionCall{
+				Name:  "log_time",
+				Input: `{"todo_id":"` + todoID.String() + `","minutes":0}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_minutes")
+			},
+		},
+		"log-time-invalid-arguments": {
+			setupMocks: func(tracker *todouc.MockTimeTracker, tp *core.MockCurrentTimeProvider) {},
+			functionCall: assistant.ActionCall{
+				Name:  "log_time",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"log-time-tracker-error": {
+			setupMocks: func(tracker *todouc.MockTimeTracker, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedTime).Once()
+				tracker.EXPECT().
+					LogTime(mock.Anything, todoID, mock.Anything, 25*time.Minute).
+					Return(todo.TimeEntry{}, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "log_time",
+				Input: `{"todo_id":"` + todoID.String() + `","minutes":25}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "log_time_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tracker := todouc.NewMockTimeTracker(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setupMocks(tracker, tp)
+
+			action := NewLogTimeAction(tracker, tp)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "log_time", definition.Name)
+			assert.False(t, definition.RequiresApproval())
+
+			resp := action.Execute(t.Context(), tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
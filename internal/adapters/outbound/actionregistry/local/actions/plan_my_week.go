@@ -0,0 +1,320 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/toon-format/toon-go"
+)
+
+const (
+	// defaultPlanMaxPerDay is the default number of todos allowed per day in a week plan.
+	defaultPlanMaxPerDay = 3
+	// maxPlanTodos caps how many open todos are considered when planning the week.
+	maxPlanTodos = 100
+)
+
+// PlanMyWeekAction is an assistant action that balances open todos across the next 7 days.
+type PlanMyWeekAction struct {
+	repo            todo.Repository
+	assistant       assistant.Assistant
+	uow             transaction.UnitOfWork
+	updater         todouc.Updater
+	timeProvider    core.CurrentTimeProvider
+	model           string
+	capacityMinutes int
+}
+
+// NewPlanMyWeekAction creates a new instance of PlanMyWeekAction.
+func NewPlanMyWeekAction(
+	repo todo.Repository,
+	assistant assistant.Assistant,
+	uow transaction.UnitOfWork,
+	updater todouc.Updater,
+	timeProvider core.CurrentTimeProvider,
+	model string,
+	capacityMinutes int,
+) PlanMyWeekAction {
+	return PlanMyWeekAction{
+		repo:            repo,
+		assistant:       assistant,
+		uow:             uow,
+		updater:         updater,
+		timeProvider:    timeProvider,
+		model:           model,
+		capacityMinutes: capacityMinutes,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a PlanMyWeekAction) StatusMessage() string {
+	return "🗓️ Planning your week..."
+}
+
+// Renderer returns the deterministic result renderer for week plans.
+func (a PlanMyWeekAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for PlanMyWeekAction.
+func (a PlanMyWeekAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "plan_my_week",
+		Description: "Balance open todos across the next 7 days without moving any todo past its current due date or over the daily capacity, then apply the new due dates. Overdue todos are planned for today. Warn the user about days reported with overbooked_minutes, e.g. 'Tuesday is overbooked by 2 hours'.",
+		Sandboxed:   true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"max_per_day": {
+					Type:        "integer",
+					Description: fmt.Sprintf("Maximum number of todos per day. Optional. Default: %d.", defaultPlanMaxPerDay),
+					Required:    false,
+				},
+				"capacity_minutes": {
+					Type:        "integer",
+					Description: "Minutes of estimated work that fit in one day. Optional. Default: the configured daily capacity. Use 0 to ignore estimates.",
+					Required:    false,
+				},
+			},
+		},
+		Approval: assistant.ActionApproval{
+			Required:    true,
+			Title:       "Confirm weekly plan",
+			Description: "Planning your week will change the due dates of open todos. Please confirm.",
+			PreviewFields: []string{
+				"max_per_day",
+				"capacity_minutes",
+			},
+			Timeout: 2 * time.Minute,
+		},
+	}
+}
+
+// Execute executes PlanMyWeekAction.
+func (a PlanMyWeekAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		MaxPerDay       *int `json:"max_per_day"`
+		CapacityMinutes *int `json:"capacity_minutes"`
+	}{}
+	exampleArgs := `{"max_per_day":3,"capacity_minutes":480}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	maxPerDay := defaultPlanMaxPerDay
+	if params.MaxPerDay != nil {
+		maxPerDay = *params.MaxPerDay
+	}
+	if maxPerDay < 1 {
+		return newActionErrorMessage(call, "invalid_max_per_day", "max_per_day must be greater than zero.", exampleArgs)
+	}
+	capacityMinutes := a.capacityMinutes
+	if params.CapacityMinutes != nil {
+		capacityMinutes = *params.CapacityMinutes
+	}
+	if capacityMinutes < 0 {
+		return newActionErrorMessage(call, "invalid_capacity_minutes", "capacity_minutes must not be negative.", exampleArgs)
+	}
+
+	todos, _, err := a.repo.ListTodos(
+		ctx,
+		1,
+		maxPlanTodos,
+		todo.WithStatus(todo.Status_OPEN),
+		todo.WithSortBy("dueDateAsc"),
+	)
+	if err != nil {
+		return newActionErrorMessage(call, "fetch_todos_error", err.Error(), exampleArgs)
+	}
+
+	now := a.timeProvider.Now()
+	candidates := todo.NewWeekPlan(todos, now, maxPerDay, capacityMinutes, nil)
+	if len(candidates.Items) == 0 {
+		return newWeekPlanResultMessage(call, candidates)
+	}
+
+	proposed, err := a.proposePlan(ctx, candidates)
+	if err != nil {
+		return newActionErrorMessage(call, "planner_error", err.Error(), exampleArgs)
+	}
+
+	plan := todo.NewWeekPlan(todos, now, maxPerDay, capacityMinutes, proposed)
+	err = a.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
+		for _, item := range plan.Changes() {
+			if _, updateErr := a.updater.Update(uowCtx, scope, item.Todo.ID, nil, nil, common.Ptr(item.DueDate), nil, nil); updateErr != nil {
+				return fmt.Errorf("todo %s: %w", item.Todo.ID, updateErr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return newActionErrorMessage(call, "plan_my_week_error", err.Error(), exampleArgs)
+	}
+
+	return newWeekPlanResultMessage(call, plan)
+}
+
+// proposePlan asks the model to distribute the candidate todos across the week.
+// Proposals are advisory: todo.NewWeekPlan enforces due dates, the per-day limit and the daily capacity.
+func (a PlanMyWeekAction) proposePlan(ctx context.Context, candidates todo.WeekPlan) (map[uuid.UUID]time.Time, error) {
+	type todoRow struct {
+		ID               string `toon:"id"`
+		Title            string `toon:"title"`
+		DueDate          string `toon:"due_date"`
+		EstimatedMinutes int    `toon:"estimated_minutes"`
+	}
+	type payload struct {
+		Todos []todoRow `toon:"todos"`
+	}
+
+	rows := make([]todoRow, 0, len(candidates.Items))
+	for _, item := range candidates.Items {
+		rows = append(rows, todoRow{
+			ID:               item.Todo.ID.String(),
+			Title:            item.Todo.Title,
+			DueDate:          item.Todo.DueDate.Format(time.DateOnly),
+			EstimatedMinutes: item.Todo.EstimatedMinutes,
+		})
+	}
+	input, err := toon.MarshalString(payload{Todos: rows})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal todos: %w", err)
+	}
+
+	start := candidates.Start
+	end := start.AddDate(0, 0, todo.WeekPlanDays-1)
+	resp, err := a.assistant.RunTurnSync(ctx, assistant.TurnRequest{
+		Model:       a.model,
+		Stream:      false,
+		Temperature: common.Ptr(0.2),
+		Messages: []assistant.Message{
+			{
+				Role: assistant.ChatRole_System,
+				Content: fmt.Sprintf(planMyWeekPrompt,
+					start.Format(time.DateOnly),
+					end.Format(time.DateOnly),
+					candidates.MaxPerDay,
+					capacityRule(candidates.CapacityMinutes),
+				),
+			},
+			{
+				Role:    assistant.ChatRole_User,
+				Content: input,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseProposedPlan(resp.Content), nil
+}
+
+// planMyWeekPrompt instructs the model to spread todos across the plan window.
+const planMyWeekPrompt = `You are a planning assistant that balances a todo workload.
+Distribute the todos across the days from %[1]s to %[2]s.
+Rules:
+1. Never schedule a todo after its due_date.
+2. Schedule at most %[3]d todos per day.
+3. Schedule todos whose due_date is before %[1]s on %[1]s.
+4. Prefer spreading work evenly and keep todos on their due_date when there is room.
+5. %[4]s
+Return strict JSON only, with no markdown: {"plan":[{"id":"<todo id>","due_date":"YYYY-MM-DD"}]}`
+
+// capacityRule describes the daily capacity to the planner; estimated_minutes of 0 means unknown effort.
+func capacityRule(capacityMinutes int) string {
+	if capacityMinutes <= 0 {
+		return "Ignore estimated_minutes."
+	}
+	return fmt.Sprintf("Keep the sum of estimated_minutes of each day at or below %d; 0 means unknown effort.", capacityMinutes)
+}
+
+// parseProposedPlan extracts todo assignments from the model response, ignoring invalid entries.
+func parseProposedPlan(content string) map[uuid.UUID]time.Time {
+	proposed := map[uuid.UUID]time.Time{}
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end <= start {
+		return proposed
+	}
+
+	var resp struct {
+		Plan []struct {
+			ID      string `json:"id"`
+			DueDate string `json:"due_date"`
+		} `json:"plan"`
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), &resp); err != nil {
+		return proposed
+	}
+
+	for _, item := range resp.Plan {
+		id, err := uuid.Parse(item.ID)
+		if err != nil {
+			continue
+		}
+		dueDate, err := time.Parse(time.DateOnly, item.DueDate)
+		if err != nil {
+			continue
+		}
+		proposed[id] = dueDate
+	}
+	return proposed
+}
+
+// newWeekPlanResultMessage builds the result listing a week plan and its daily workload.
+func newWeekPlanResultMessage(call assistant.ActionCall, plan todo.WeekPlan) assistant.Message {
+	type planRow struct {
+		ID      string `json:"id"`
+		Title   string `json:"title"`
+		DueDate string `json:"due_date"`
+		Moved   bool   `json:"moved"`
+	}
+	type workloadRow struct {
+		Date              string `json:"date"`
+		Weekday           string `json:"weekday"`
+		Count             int    `json:"count"`
+		Minutes           int    `json:"minutes"`
+		OverbookedMinutes int    `json:"overbooked_minutes,omitempty"`
+	}
+	type payload struct {
+		Plan            []planRow     `json:"plan"`
+		Workload        []workloadRow `json:"workload"`
+		CapacityMinutes int           `json:"capacity_minutes,omitempty"`
+	}
+
+	out := payload{
+		Plan:            make([]planRow, 0, len(plan.Items)),
+		Workload:        []workloadRow{},
+		CapacityMinutes: plan.CapacityMinutes,
+	}
+	for _, item := range plan.Items {
+		out.Plan = append(out.Plan, planRow{
+			ID:      item.Todo.ID.String(),
+			Title:   item.Todo.Title,
+			DueDate: item.DueDate.Format(time.DateOnly),
+			Moved:   item.Changed(),
+		})
+	}
+	for _, w := range plan.Workload() {
+		out.Workload = append(out.Workload, workloadRow{
+			Date:              w.Date.Format(time.DateOnly),
+			Weekday:           w.Date.Weekday().String(),
+			Count:             w.Count,
+			Minutes:           w.Minutes,
+			OverbookedMinutes: w.OverbookedMinutes,
+		})
+	}
+
+	return assistant.NewActionResultMessage(call, out)
+}
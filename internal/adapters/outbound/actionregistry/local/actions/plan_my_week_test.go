@@ -0,0 +1,310 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPlanMyWeekAction(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	todoID1 := uuid.New()
+	todoID2 := uuid.New()
+	todoID3 := uuid.New()
+	openTodos := []todo.Todo{
+		{ID: todoID1, Title: "Pay rent", Status: todo.Status_OPEN, DueDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: todoID2, Title: "Write report", Status: todo.Status_OPEN, DueDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: todoID3, Title: "Plan trip", Status: todo.Status_OPEN, DueDate: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	type mocks struct {
+		repo         *todo.MockRepository
+		assistant    *assistant.MockAssistant
+		uow          *transaction.MockUnitOfWork
+		updater      *todouc.MockUpdater
+		timeProvider *core.MockCurrentTimeProvider
+	}
+
+	tests := map[string]struct {
+		setupMocks   func(m mocks)
+		functionCall assistant.ActionCall
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"plan-my-week-success": {
+			setupMocks: func(m mocks) {
+				m.repo.EXPECT().
+					ListTodos(mock.Anything, 1, maxPlanTodos, mock.Anything, mock.Anything).
+					Return(openTodos, false, nil).
+					Once()
+				m.timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				m.assistant.EXPECT().
+					RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						return req.Model == "planner-model" && len(req.Messages) == 2
+					})).
+					Return(assistant.TurnResponse{
+						Content: "```json\n" + `{"plan":[{"id":"` + todoID1.String() + `","due_date":"2026-03-02"},{"id":"` + todoID2.String() + `","due_date":"2026-03-03"}]}` + "\n```",
+					}, nil).
+					Once()
+
+				scope := transaction.NewMockScope(t)
+				m.updater.EXPECT().
+					Update(
+						mock.Anything,
+						scope,
+						todoID1,
+						(*string)(nil),
+						(*todo.Status)(nil),
+						common.Ptr(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
+					).
+					Return(todo.Todo{ID: todoID1}, nil).
+					Once()
+				m.updater.EXPECT().
+					Update(
+						mock.Anything,
+						scope,
+						todoID2,
+						(*string)(nil),
+						(*todo.Status)(nil),
+						common.Ptr(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
+					).
+					Return(todo.Todo{ID: todoID2}, nil).
+					Once()
+				m.uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{"max_per_day":2}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				payload := struct {
+					Plan []struct {
+						ID      string `json:"id"`
+						DueDate string `json:"due_date"`
+						Moved   bool   `json:"moved"`
+					} `json:"plan"`
+					Workload []struct {
+						Date  string `json:"date"`
+						Count int    `json:"count"`
+					} `json:"workload"`
+				}{}
+				assert.True(t, unmarshalResultData(resp, &payload))
+				assert.Len(t, payload.Plan, 2)
+				assert.Len(t, payload.Workload, todo.WeekPlanDays)
+				assert.Equal(t, "2026-03-03", payload.Plan[1].DueDate)
+				assert.True(t, payload.Plan[1].Moved)
+			},
+		},
+		"plan-my-week-reports-overbooked-days": {
+			setupMocks: func(m mocks) {
+				m.repo.EXPECT().
+					ListTodos(mock.Anything, 1, maxPlanTodos, mock.Anything, mock.Anything).
+					Return([]todo.Todo{
+						{ID: todoID1, Title: "Write report", Status: todo.Status_OPEN, DueDate: fixedTime, EstimatedMinutes: 300},
+						{ID: todoID2, Title: "Prepare slides", Status: todo.Status_OPEN, DueDate: fixedTime, EstimatedMinutes: 400},
+					}, false, nil).
+					Once()
+				m.timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				m.assistant.EXPECT().
+					RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						return strings.Contains(req.Messages[0].Content, "at or below 360") &&
+							strings.Contains(req.Messages[1].Content, "estimated_minutes")
+					})).
+					Return(assistant.TurnResponse{Content: `{"plan":[]}`}, nil).
+					Once()
+				m.uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, transaction.NewMockScope(t))
+					}).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{"capacity_minutes":360}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				payload := struct {
+					CapacityMinutes int `json:"capacity_minutes"`
+					Workload        []struct {
+						Date              string `json:"date"`
+						Weekday           string `json:"weekday"`
+						Minutes           int    `json:"minutes"`
+						OverbookedMinutes int    `json:"overbooked_minutes"`
+					} `json:"workload"`
+				}{}
+				assert.True(t, unmarshalResultData(resp, &payload))
+				assert.Equal(t, 360, payload.CapacityMinutes)
+				assert.Equal(t, "Monday", payload.Workload[0].Weekday)
+				assert.Equal(t, 700, payload.Workload[0].Minutes)
+				assert.Equal(t, 340, payload.Workload[0].OverbookedMinutes)
+				assert.Zero(t, payload.Workload[1].OverbookedMinutes)
+			},
+		},
+		"plan-my-week-nothing-to-plan": {
+			setupMocks: func(m mocks) {
+				m.repo.EXPECT().
+					ListTodos(mock.Anything, 1, maxPlanTodos, mock.Anything, mock.Anything).
+					Return(openTodos[2:], false, nil).
+					Once()
+				m.timeProvider.EXPECT().Now().Return(fixedTime).Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "workload")
+			},
+		},
+		"plan-my-week-invalid-max-per-day": {
+			setupMocks: func(m mocks) {},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{"max_per_day":0}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_max_per_day")
+			},
+		},
+		"plan-my-week-invalid-capacity": {
+			setupMocks: func(m mocks) {},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{"capacity_minutes":-60}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_capacity_minutes")
+			},
+		},
+		"plan-my-week-invalid-arguments": {
+			setupMocks: func(m mocks) {},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{"days":3}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"plan-my-week-fetch-error": {
+			setupMocks: func(m mocks) {
+				m.repo.EXPECT().
+					ListTodos(mock.Anything, 1, maxPlanTodos, mock.Anything, mock.Anything).
+					Return(nil, false, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "fetch_todos_error")
+			},
+		},
+		"plan-my-week-planner-error": {
+			setupMocks: func(m mocks) {
+				m.repo.EXPECT().
+					ListTodos(mock.Anything, 1, maxPlanTodos, mock.Anything, mock.Anything).
+					Return(openTodos, false, nil).
+					Once()
+				m.timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				m.assistant.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{}, errors.New("model error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "planner_error")
+			},
+		},
+		"plan-my-week-update-error": {
+			setupMocks: func(m mocks) {
+				m.repo.EXPECT().
+					ListTodos(mock.Anything, 1, maxPlanTodos, mock.Anything, mock.Anything).
+					Return(openTodos, false, nil).
+					Once()
+				m.timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				m.assistant.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: "not json"}, nil).
+					Once()
+
+				scope := transaction.NewMockScope(t)
+				m.updater.EXPECT().
+					Update(mock.Anything, scope, todoID1, (*string)(nil), (*todo.Status)(nil), mock.Anything, (*int)(nil), todo.CustomFieldValues(nil)).
+					Return(todo.Todo{}, errors.New("update error")).
+					Once()
+				m.uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "plan_my_week",
+				Input: `{}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "plan_my_week_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := mocks{
+				repo:         todo.NewMockRepository(t),
+				assistant:    assistant.NewMockAssistant(t),
+				uow:          transaction.NewMockUnitOfWork(t),
+				updater:      todouc.NewMockUpdater(t),
+				timeProvider: core.NewMockCurrentTimeProvider(t),
+			}
+			tt.setupMocks(m)
+
+			action := NewPlanMyWeekAction(m.repo, m.assistant, m.uow, m.updater, m.timeProvider, "planner-model", 480)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			definition := action.Definition()
+			assert.Equal(t, "plan_my_week", definition.Name)
+			assert.NotEmpty(t, definition.Description)
+			assert.True(t, definition.Approval.Required)
+			assert.Equal(t, []string{"max_per_day", "capacity_minutes"}, definition.Approval.PreviewFields)
+
+			resp := action.Execute(t.Context(), tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// QueryTodosAction is an assistant action for fetching todos with a query expression, for power users
+// who type filters such as `status:open due<today+7 area:work -area:errands`.
+type QueryTodosAction struct {
+	list todouc.List
+}
+
+// NewQueryTodosAction creates a new instance of QueryTodosAction.
+func NewQueryTodosAction(list todouc.List) QueryTodosAction {
+	return QueryTodosAction{
+		list: list,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a QueryTodosAction) StatusMessage() string {
+	return "🔎 Querying todos..."
+}
+
+// Renderer reports that query_todos does not expose a deterministic renderer.
+func (a QueryTodosAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for QueryTodosAction.
+func (a QueryTodosAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name: "query_todos",
+		Description: "Fetch todos with a query expression. Use it when the user writes a query such as " +
+			"`status:open due<2026-05-01 area:work -area:errands`; prefer fetch_todos otherwise.",
+		ReadOnly: true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"query": {
+					Type: "string",
+					Description: "Query expression of space separated terms that must all match: status:open|done; " +
+						"due:DATE, due<DATE, due<=DATE, due>DATE, due>=DATE with DATE as YYYY-MM-DD, today, tomorrow or today+N; " +
+						"title:TEXT; similar:TEXT; sort:dueDateAsc|dueDateDesc|createdAtAsc|createdAtDesc|similarityAsc|similarityDesc|smartOrder; " +
+						"archived:true; NAME:VALUE and -NAME:VALUE to keep or drop todos by custom field. " +
+						`Other words search the title. Quote values with spaces, e.g. title:"buy milk". REQUIRED.`,
+					Required: true,
+				},
+				"page": {
+					Type:        "integer",
+					Description: "Page number starting from 1. Optional, defaults to 1.",
+					Required:    false,
+				},
+				"page_size": {
+					Type:        "integer",
+					Description: "Items per page. Optional, defaults to 10.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes QueryTodosAction.
+func (a QueryTodosAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Query    string `json:"query"`
+		Page     int    `json:"page"`
+		PageSize int    `json:"page_size"`
+	}{
+		Page:     1,
+		PageSize: 10,
+	}
+	exampleArgs := `{"query":"status:open due<=today+7","page":1,"page_size":10}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	todos, hasMore, err := a.list.Query(ctx, params.Page, params.PageSize, todouc.WithQuery(params.Query))
+	if err != nil {
+		var validationErr *core.ValidationErr
+		if errors.As(err, &validationErr) {
+			return newActionErrorMessage(call, "invalid_query", err.Error(), exampleArgs)
+		}
+		return newActionErrorMessage(call, "list_todos_error", fmt.Sprintf("failed to list todos:%s", err.Error()), exampleArgs)
+	}
+
+	type result struct {
+		ID               string                 `json:"id"`
+		Title            string                 `json:"title"`
+		DueDate          string                 `json:"due_date"`
+		Status           string                 `json:"status"`
+		EstimatedMinutes int                    `json:"estimated_minutes,omitempty"`
+		Archived         bool                   `json:"archived,omitempty"`
+		CustomFields     todo.CustomFieldValues `json:"custom_fields,omitempty"`
+	}
+
+	rows := make([]result, len(todos))
+	for i, t := range todos {
+		rows[i] = result{
+			ID:               t.ID.String(),
+			Title:            t.Title,
+			DueDate:          t.DueDate.Format(time.DateOnly),
+			Status:           string(t.Status),
+			EstimatedMinutes: t.EstimatedMinutes,
+			Archived:         t.IsArchived(),
+			CustomFields:     t.CustomFields,
+		}
+	}
+
+	pagination := assistant.ActionResultPagination{Page: params.Page, PageSize: params.PageSize}
+	if hasMore {
+		nxt := params.Page + 1
+		pagination.NextPage = &nxt
+	}
+	return assistant.NewPaginatedActionResultMessage(call, map[string]any{"todos": rows}, pagination)
+}
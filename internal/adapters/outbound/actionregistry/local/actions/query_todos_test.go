@@ -0,0 +1,116 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQueryTodosAction(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	dueDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockList)
+		functionCall assistant.ActionCall
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"success": {
+			setupMocks: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 2, 5, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...todouc.ListOptions) {
+						var params todouc.ListParams
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, "status:open area:work", *params.Query)
+					}).
+					Return([]todo.Todo{{
+						ID:           todoID,
+						Title:        "Ship release",
+						Status:       todo.Status_OPEN,
+						DueDate:      dueDate,
+						CustomFields: todo.CustomFieldValues{"area": "work"},
+					}}, true, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "query_todos",
+				Input: `{"query":"status:open area:work","page":2,"page_size":5}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"title":"Ship release","due_date":"2026-03-05","status":"OPEN","custom_fields":{"area":"work"}`)
+				assert.Contains(t, resp.Content, `"pagination":{"page":2,"page_size":5,"next_page":3}`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(*todouc.MockList) {},
+			functionCall: assistant.ActionCall{
+				Name:  "query_todos",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"invalid-query": {
+			setupMocks: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Return(nil, false, core.NewValidationErr("status must be either OPEN or DONE")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "query_todos",
+				Input: `{"query":"status:later"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_query")
+			},
+		},
+		"list-error": {
+			setupMocks: func(m *todouc.MockList) {
+				m.EXPECT().
+					Query(mock.Anything, 1, 10, mock.Anything).
+					Return(nil, false, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "query_todos",
+				Input: `{"query":"status:open"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "list_todos_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			list := todouc.NewMockList(t)
+			tt.setupMocks(list)
+
+			action := NewQueryTodosAction(list)
+			assert.Equal(t, "query_todos", action.Definition().Name)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+)
+
+// RememberInstructionAction is an assistant action for pinning a standing user instruction to the conversation
+// or storing it globally.
+type RememberInstructionAction struct {
+	instructions chatuc.Instructions
+}
+
+// NewRememberInstructionAction creates a new instance of RememberInstructionAction.
+func NewRememberInstructionAction(instructions chatuc.Instructions) RememberInstructionAction {
+	return RememberInstructionAction{
+		instructions: instructions,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a RememberInstructionAction) StatusMessage() string {
+	return "📌 Pinning instruction..."
+}
+
+// Renderer reports that remember_instruction does not expose a deterministic renderer.
+func (a RememberInstructionAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for RememberInstructionAction.
+func (a RememberInstructionAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "remember_instruction",
+		Description: "Pin a standing instruction the user wants followed in every answer, e.g. always use DD/MM dates. Pinned instructions are added to the system prompt of later turns.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"instruction": {
+					Type:        "string",
+					Description: "The instruction as one short imperative sentence, e.g. Always use DD/MM dates. REQUIRED.",
+					Required:    true,
+				},
+				"scope": {
+					Type:        "string",
+					Description: "conversation pins it to this conversation only; global applies it to every conversation. Use global only when the user asks for it everywhere or always. Defaults to conversation.",
+					Required:    false,
+					Enum:        []any{"conversation", "global"},
+				},
+			},
+		},
+	}
+}
+
+// Execute executes RememberInstructionAction.
+func (a RememberInstructionAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Instruction string `json:"instruction"`
+		Scope       string `json:"scope"`
+	}{}
+	exampleArgs := `{"instruction":"Always use DD/MM dates","scope":"conversation"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	var conversationID *uuid.UUID
+	switch params.Scope {
+	case "", "conversation":
+		id, ok := assistant.ConversationIDFromContext(ctx)
+		if !ok {
+			return newActionErrorMessage(call, "invalid_scope", "no conversation is active; use the global scope", exampleArgs)
+		}
+		conversationID = &id
+	case "global":
+	default:
+		return newActionErrorMessage(call, "invalid_scope", "scope must be conversation or global", exampleArgs)
+	}
+
+	instruction, err := a.instructions.Remember(ctx, conversationID, params.Instruction)
+	if err != nil {
+		var validationErr *core.ValidationErr
+		if errors.As(err, &validationErr) {
+			return newActionErrorMessage(call, "invalid_instruction", err.Error(), exampleArgs)
+		}
+		return newActionErrorMessage(call, "remember_instruction_error", err.Error(), exampleArgs)
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"pinned_instruction": []instructionRow{toInstructionRow(instruction)},
+	})
+}
@@ -0,0 +1,148 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRememberInstructionAction(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	instructionID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		withConversation bool
+		setupMocks       func(*chatuc.MockInstructions)
+		functionCall     assistant.ActionCall
+		validateResp     func(t *testing.T, resp assistant.Message)
+	}{
+		"pins-to-conversation-by-default": {
+			withConversation: true,
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().
+					Remember(mock.Anything, &conversationID, "Always use DD/MM dates").
+					Return(assistant.Instruction{ID: instructionID, ConversationID: &conversationID, Text: "Always use DD/MM dates", CreatedAt: createdAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `{"instruction":"Always use DD/MM dates"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"pinned_instruction":[{"id":"123e4567-e89b-12d3-a456-426614174000","scope":"conversation","text":"Always use DD/MM dates","created_at":"2026-03-02T09:00:00Z"}]`)
+			},
+		},
+		"stores-global": {
+			withConversation: true,
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().
+					Remember(mock.Anything, (*uuid.UUID)(nil), "Answer in Portuguese").
+					Return(assistant.Instruction{ID: instructionID, Text: "Answer in Portuguese", CreatedAt: createdAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `{"instruction":"Answer in Portuguese","scope":"global"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"scope":"global"`)
+			},
+		},
+		"no-active-conversation": {
+			setupMocks: func(*chatuc.MockInstructions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `{"instruction":"Answer in Portuguese","scope":"conversation"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_scope")
+			},
+		},
+		"invalid-scope": {
+			withConversation: true,
+			setupMocks:       func(*chatuc.MockInstructions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `{"instruction":"Answer in Portuguese","scope":"forever"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_scope")
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(*chatuc.MockInstructions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"invalid-instruction": {
+			withConversation: true,
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().
+					Remember(mock.Anything, &conversationID, "").
+					Return(assistant.Instruction{}, core.NewValidationErr("instruction cannot be empty")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `{"instruction":""}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_instruction")
+			},
+		},
+		"remember-error": {
+			withConversation: true,
+			setupMocks: func(m *chatuc.MockInstructions) {
+				m.EXPECT().
+					Remember(mock.Anything, &conversationID, "Answer in Portuguese").
+					Return(assistant.Instruction{}, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "remember_instruction",
+				Input: `{"instruction":"Answer in Portuguese"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "remember_instruction_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			instructions := chatuc.NewMockInstructions(t)
+			tt.setupMocks(instructions)
+
+			ctx := t.Context()
+			if tt.withConversation {
+				ctx = assistant.WithConversationID(ctx, conversationID)
+			}
+
+			action := NewRememberInstructionAction(instructions)
+			assert.Equal(t, "remember_instruction", action.Definition().Name)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(ctx, tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
-	"github.com/toon-format/toon-go"
 )
 
 // createTodosRenderer renders successful create_todos tool results.
@@ -74,12 +73,12 @@ func parseRenderedTodos(result assistant.Message) ([]renderedTodo, bool) {
 
 	payload := struct {
 		Todos []struct {
-			Title   string `toon:"title"`
-			DueDate string `toon:"due_date"`
-			Status  string `toon:"status"`
-		} `toon:"todos"`
+			Title   string `json:"title"`
+			DueDate string `json:"due_date"`
+			Status  string `json:"status"`
+		} `json:"todos"`
 	}{}
-	if err := toon.UnmarshalString(strings.TrimSpace(result.Content), &payload); err != nil {
+	if !unmarshalResultData(result, &payload) {
 		return nil, false
 	}
 	todos := make([]renderedTodo, 0, len(payload.Todos))
@@ -101,15 +100,27 @@ func parseDeletedRowsCount(result assistant.Message) (int, bool) {
 
 	payload := struct {
 		Todos []struct {
-			Deleted bool `toon:"deleted"`
-		} `toon:"todos"`
+			Deleted bool `json:"deleted"`
+		} `json:"todos"`
 	}{}
-	if err := toon.UnmarshalString(strings.TrimSpace(result.Content), &payload); err != nil {
+	if !unmarshalResultData(result, &payload) {
 		return 0, false
 	}
 	return len(payload.Todos), true
 }
 
+// unmarshalResultData decodes the data of the action result envelope carried by result into target.
+func unmarshalResultData(result assistant.Message, target any) bool {
+	envelope := struct {
+		Status assistant.ActionResultStatus `json:"status"`
+		Data   json.RawMessage              `json:"data"`
+	}{}
+	if err := json.Unmarshal([]byte(result.Content), &envelope); err != nil || envelope.Status != assistant.ActionResultStatus_Success {
+		return false
+	}
+	return json.Unmarshal(envelope.Data, target) == nil
+}
+
 // parseDeleteTitles extracts todo titles from the original delete action input when present.
 func parseDeleteTitles(input string) []string {
 	params := struct {
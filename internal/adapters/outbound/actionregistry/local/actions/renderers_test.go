@@ -6,19 +6,13 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/stretchr/testify/assert"
-	"github.com/toon-format/toon-go"
 )
 
 func TestActionResultRenderers(t *testing.T) {
 	t.Parallel()
 
-	mustMarshal := func(t *testing.T, value any) string {
-		t.Helper()
-		content, err := toon.MarshalString(value)
-		if err != nil {
-			t.Fatalf("toon.MarshalString: %v", err)
-		}
-		return content
+	resultContent := func(value any) string {
+		return assistant.ActionResult{Status: assistant.ActionResultStatus_Success, Data: value}.String()
 	}
 
 	tests := map[string]struct {
@@ -34,19 +28,19 @@ func TestActionResultRenderers(t *testing.T) {
 			result: assistant.Message{
 				Role:         assistant.ChatRole_Tool,
 				ActionCallID: common.Ptr("call-1"),
-				Content: mustMarshal(t, struct {
+				Content: resultContent(struct {
 					Todos []struct {
-						ID      string `toon:"id"`
-						Title   string `toon:"title"`
-						DueDate string `toon:"due_date"`
-						Status  string `toon:"status"`
-					} `toon:"todos"`
+						ID      string `json:"id"`
+						Title   string `json:"title"`
+						DueDate string `json:"due_date"`
+						Status  string `json:"status"`
+					} `json:"todos"`
 				}{
 					Todos: []struct {
-						ID      string `toon:"id"`
-						Title   string `toon:"title"`
-						DueDate string `toon:"due_date"`
-						Status  string `toon:"status"`
+						ID      string `json:"id"`
+						Title   string `json:"title"`
+						DueDate string `json:"due_date"`
+						Status  string `json:"status"`
 					}{
 						{ID: "1", Title: "Call Alice", DueDate: "2026-03-02", Status: "OPEN"},
 					},
@@ -61,19 +55,19 @@ func TestActionResultRenderers(t *testing.T) {
 			result: assistant.Message{
 				Role:         assistant.ChatRole_Tool,
 				ActionCallID: common.Ptr("call-2"),
-				Content: mustMarshal(t, struct {
+				Content: resultContent(struct {
 					Todos []struct {
-						ID      string `toon:"id"`
-						Title   string `toon:"title"`
-						DueDate string `toon:"due_date"`
-						Status  string `toon:"status"`
-					} `toon:"todos"`
+						ID      string `json:"id"`
+						Title   string `json:"title"`
+						DueDate string `json:"due_date"`
+						Status  string `json:"status"`
+					} `json:"todos"`
 				}{
 					Todos: []struct {
-						ID      string `toon:"id"`
-						Title   string `toon:"title"`
-						DueDate string `toon:"due_date"`
-						Status  string `toon:"status"`
+						ID      string `json:"id"`
+						Title   string `json:"title"`
+						DueDate string `json:"due_date"`
+						Status  string `json:"status"`
 					}{
 						{ID: "1", Title: "Call Alice", DueDate: "2026-03-02", Status: "OPEN"},
 						{ID: "2", Title: "Buy milk", DueDate: "2026-03-03", Status: "DONE"},
@@ -89,19 +83,19 @@ func TestActionResultRenderers(t *testing.T) {
 			result: assistant.Message{
 				Role:         assistant.ChatRole_Tool,
 				ActionCallID: common.Ptr("call-3"),
-				Content: mustMarshal(t, struct {
+				Content: resultContent(struct {
 					Todos []struct {
-						ID      string `toon:"id"`
-						Title   string `toon:"title"`
-						DueDate string `toon:"due_date"`
-						Status  string `toon:"status"`
-					} `toon:"todos"`
+						ID      string `json:"id"`
+						Title   string `json:"title"`
+						DueDate string `json:"due_date"`
+						Status  string `json:"status"`
+					} `json:"todos"`
 				}{
 					Todos: []struct {
-						ID      string `toon:"id"`
-						Title   string `toon:"title"`
-						DueDate string `toon:"due_date"`
-						Status  string `toon:"status"`
+						ID      string `json:"id"`
+						Title   string `json:"title"`
+						DueDate string `json:"due_date"`
+						Status  string `json:"status"`
 					}{
 						{ID: "1", Title: "Call Alice", DueDate: "2026-03-04", Status: "OPEN"},
 					},
@@ -119,15 +113,15 @@ func TestActionResultRenderers(t *testing.T) {
 			result: assistant.Message{
 				Role:         assistant.ChatRole_Tool,
 				ActionCallID: common.Ptr("call-4"),
-				Content: mustMarshal(t, struct {
+				Content: resultContent(struct {
 					Todos []struct {
-						ID      string `toon:"id"`
-						Deleted bool   `toon:"deleted"`
-					} `toon:"todos"`
+						ID      string `json:"id"`
+						Deleted bool   `json:"deleted"`
+					} `json:"todos"`
 				}{
 					Todos: []struct {
-						ID      string `toon:"id"`
-						Deleted bool   `toon:"deleted"`
+						ID      string `json:"id"`
+						Deleted bool   `json:"deleted"`
 					}{
 						{ID: "1", Deleted: true},
 					},
@@ -0,0 +1,154 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// SaveViewAction is an assistant action for saving a todo list filter as a named view.
+type SaveViewAction struct {
+	views todouc.Views
+}
+
+// NewSaveViewAction creates a new instance of SaveViewAction.
+func NewSaveViewAction(views todouc.Views) SaveViewAction {
+	return SaveViewAction{
+		views: views,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a SaveViewAction) StatusMessage() string {
+	return "🔖 Saving view..."
+}
+
+// Renderer reports that save_view does not expose a deterministic renderer.
+func (a SaveViewAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for SaveViewAction.
+func (a SaveViewAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "save_view",
+		Description: "Save a todo list filter as a named view. Saving an existing view name replaces its filter. Today, Upcoming and Someday are reserved built-in views.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"name": {
+					Type:        "string",
+					Description: "View name, e.g. Work focus. REQUIRED.",
+					Required:    true,
+				},
+				"status": {
+					Type:        "string",
+					Description: "status filter. Optional.",
+					Required:    false,
+					Enum:        []any{todo.Status_OPEN, todo.Status_DONE},
+				},
+				"search_by_similarity": {
+					Type:        "string",
+					Description: "semantic search query. Optional.",
+					Required:    false,
+				},
+				"search_by_title": {
+					Type:        "string",
+					Description: "title contains query. Optional.",
+					Required:    false,
+				},
+				"sort_by": {
+					Type:        "string",
+					Description: "Optional sort. Use similarity sort only with search_by_similarity.",
+					Required:    false,
+					Enum:        []any{"dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc", "smartOrder"},
+				},
+				"due_after": {
+					Type:        "string",
+					Description: "fixed lower due-date bound in YYYY-MM-DD. Must be provided with due_before. Optional.",
+					Required:    false,
+					Format:      "date",
+				},
+				"due_before": {
+					Type:        "string",
+					Description: "fixed upper due-date bound in YYYY-MM-DD. Must be provided with due_after. Optional.",
+					Required:    false,
+					Format:      "date",
+				},
+				"due_from_days": {
+					Type:        "integer",
+					Description: "lower due-date bound in days relative to today, e.g. 1 for tomorrow. Use instead of due_after for rolling ranges. Optional.",
+					Required:    false,
+				},
+				"due_to_days": {
+					Type:        "integer",
+					Description: "upper due-date bound in days relative to today, e.g. 7 for a week ahead. Use instead of due_before for rolling ranges. Optional.",
+					Required:    false,
+				},
+				"query": {
+					Type:        "string",
+					Description: "query_todos expression applied on top of the other filters, e.g. status:open due<=today+7 area:work. Use it when the user gives a query. Optional.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes SaveViewAction.
+func (a SaveViewAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Name               string  `json:"name"`
+		Status             *string `json:"status"`
+		SearchBySimilarity *string `json:"search_by_similarity"`
+		SearchByTitle      *string `json:"search_by_title"`
+		SortBy             *string `json:"sort_by"`
+		DueAfter           *string `json:"due_after"`
+		DueBefore          *string `json:"due_before"`
+		DueFromDays        *int    `json:"due_from_days"`
+		DueToDays          *int    `json:"due_to_days"`
+		Query              *string `json:"query"`
+	}{}
+	exampleArgs := `{"name":"Work focus","status":"OPEN","search_by_similarity":"work","sort_by":"dueDateAsc"}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	var dueAfter, dueBefore *time.Time
+	if params.DueAfter != nil || params.DueBefore != nil {
+		var errMsg *assistant.Message
+		dueAfter, dueBefore, errMsg = parseDueDateParams(call, params.DueAfter, params.DueBefore, exampleArgs)
+		if errMsg != nil {
+			return *errMsg
+		}
+	}
+
+	view, err := a.views.Save(ctx, params.Name, todo.ViewFilter{
+		Status:             (*todo.Status)(params.Status),
+		SearchBySimilarity: params.SearchBySimilarity,
+		SearchByTitle:      params.SearchByTitle,
+		SortBy:             params.SortBy,
+		DueAfter:           dueAfter,
+		DueBefore:          dueBefore,
+		DueFromDays:        params.DueFromDays,
+		DueToDays:          params.DueToDays,
+		Query:              params.Query,
+	})
+	if err != nil {
+		var validationErr *core.ValidationErr
+		if errors.As(err, &validationErr) {
+			return newActionErrorMessage(call, "invalid_view", err.Error(), exampleArgs)
+		}
+		return newActionErrorMessage(call, "save_view_error", err.Error(), exampleArgs)
+	}
+
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"saved_view": []viewRow{toViewRow(view, view.UpdatedAt)},
+	})
+}
@@ -0,0 +1,161 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSaveViewAction(t *testing.T) {
+	t.Parallel()
+
+	savedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockViews)
+		functionCall assistant.ActionCall
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"save-search-view": {
+			setupMocks: func(m *todouc.MockViews) {
+				filter := todo.ViewFilter{
+					Status:             common.Ptr(todo.Status_OPEN),
+					SearchBySimilarity: common.Ptr("work"),
+					SortBy:             common.Ptr("dueDateAsc"),
+				}
+				m.EXPECT().
+					Save(mock.Anything, "Work focus", filter).
+					Return(todo.View{ID: uuid.New(), Name: "Work focus", Filter: filter, UpdatedAt: savedAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `{"name":"Work focus","status":"OPEN","search_by_similarity":"work","sort_by":"dueDateAsc"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"saved_view":[{"name":"Work focus","built_in":false,"status":"OPEN","search_by_similarity":"work","search_by_title":"","sort_by":"dueDateAsc"`)
+			},
+		},
+		"save-rolling-due-range": {
+			setupMocks: func(m *todouc.MockViews) {
+				filter := todo.ViewFilter{DueFromDays: common.Ptr(0), DueToDays: common.Ptr(3)}
+				m.EXPECT().
+					Save(mock.Anything, "Next days", filter).
+					Return(todo.View{ID: uuid.New(), Name: "Next days", Filter: filter, UpdatedAt: savedAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `{"name":"Next days","due_from_days":0,"due_to_days":3}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"due_after":"2026-03-02","due_before":"2026-03-05"`)
+			},
+		},
+		"save-fixed-due-range": {
+			setupMocks: func(m *todouc.MockViews) {
+				filter := todo.ViewFilter{
+					DueAfter:  common.Ptr(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)),
+					DueBefore: common.Ptr(time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)),
+				}
+				m.EXPECT().
+					Save(mock.Anything, "Sprint", filter).
+					Return(todo.View{ID: uuid.New(), Name: "Sprint", Filter: filter, UpdatedAt: savedAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `{"name":"Sprint","due_after":"2026-03-01","due_before":"2026-03-07"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"due_after":"2026-03-01","due_before":"2026-03-07"`)
+			},
+		},
+		"save-query-view": {
+			setupMocks: func(m *todouc.MockViews) {
+				filter := todo.ViewFilter{Query: common.Ptr("status:open area:work -area:errands")}
+				m.EXPECT().
+					Save(mock.Anything, "Work", filter).
+					Return(todo.View{ID: uuid.New(), Name: "Work", Filter: filter, UpdatedAt: savedAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `{"name":"Work","query":"status:open area:work -area:errands"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"query":"status:open area:work -area:errands"`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(m *todouc.MockViews) {},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"reserved-name": {
+			setupMocks: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Save(mock.Anything, "Today", todo.ViewFilter{}).
+					Return(todo.View{}, core.NewValidationErr(`view name "Today" is reserved`)).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `{"name":"Today"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_view")
+				assert.Contains(t, resp.Content, "reserved")
+			},
+		},
+		"save-error": {
+			setupMocks: func(m *todouc.MockViews) {
+				m.EXPECT().
+					Save(mock.Anything, "Work", todo.ViewFilter{}).
+					Return(todo.View{}, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "save_view",
+				Input: `{"name":"Work"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "save_view_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			views := todouc.NewMockViews(t)
+			tt.setupMocks(views)
+
+			action := NewSaveViewAction(views)
+			assert.Equal(t, "save_view", action.Definition().Name)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package actions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+const (
+	// defaultSearchResults is the number of results when the model does not pass max_results.
+	defaultSearchResults = 5
+	// maxSearchResults bounds the results the model may ask for.
+	maxSearchResults = 10
+	// maxSnippetRunes bounds each result snippet passed to the model.
+	maxSnippetRunes = 300
+)
+
+// SearchWebAction is an assistant action searching the web on the allow-listed domains of the
+// configured assistant.WebSearcher.
+type SearchWebAction struct {
+	searcher assistant.WebSearcher
+}
+
+// NewSearchWebAction creates a new instance of SearchWebAction.
+func NewSearchWebAction(searcher assistant.WebSearcher) SearchWebAction {
+	return SearchWebAction{
+		searcher: searcher,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a SearchWebAction) StatusMessage() string {
+	return "🔎 Searching the web..."
+}
+
+// Renderer reports that search_web does not expose a deterministic renderer.
+func (a SearchWebAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for SearchWebAction.
+func (a SearchWebAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "search_web",
+		Description: "Search the web for pages on the trusted domains configured by the operator. Returns the title, URL and snippet of each page.",
+		ReadOnly:    true,
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"query": {
+					Type:        "string",
+					Description: "Focused search query, for example go generics tutorial. REQUIRED.",
+					Required:    true,
+				},
+				"max_results": {
+					Type:        "integer",
+					Description: "Optional number of results, from 1 to 10. Defaults to 5.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes SearchWebAction.
+func (a SearchWebAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		Query      string `json:"query"`
+		MaxResults *int   `json:"max_results"`
+	}{}
+	exampleArgs := `{"query":"go generics tutorial","max_results":3}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+	query := strings.TrimSpace(params.Query)
+	if query == "" {
+		return newActionErrorMessage(call, "invalid_query", "query is required.", exampleArgs)
+	}
+	limit := defaultSearchResults
+	if params.MaxResults != nil {
+		limit = min(max(*params.MaxResults, 1), maxSearchResults)
+	}
+
+	found, err := a.searcher.Search(ctx, query, limit)
+	if err != nil {
+		return newActionErrorMessage(call, "search_error", err.Error(), "")
+	}
+
+	type resultOutput struct {
+		Title   string `json:"title"`
+		URL     string `json:"url"`
+		Snippet string `json:"snippet"`
+	}
+	results := make([]resultOutput, 0, len(found))
+	for _, r := range found {
+		results = append(results, resultOutput{
+			Title:   strings.TrimSpace(r.Title),
+			URL:     r.URL,
+			Snippet: truncateRunes(strings.Join(strings.Fields(r.Snippet), " "), maxSnippetRunes),
+		})
+	}
+
+	output := map[string]any{
+		"query":   query,
+		"results": results,
+	}
+	if len(results) == 0 {
+		output["note"] = "No results on the allowed domains. Try a broader query."
+	}
+	return assistant.NewActionResultMessage(call, output)
+}
+
+// truncateRunes cuts s to at most n runes, marking the cut with an ellipsis.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
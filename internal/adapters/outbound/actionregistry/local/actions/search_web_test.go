@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchWebAction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input        string
+		setupMocks   func(*assistant.MockWebSearcher)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"returns-results": {
+			input: `{"query":" go generics ","max_results":2}`,
+			setupMocks: func(m *assistant.MockWebSearcher) {
+				m.EXPECT().Search(mock.Anything, "go generics", 2).Return([]assistant.WebSearchResult{
+					{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics", Snippet: "Get started\nwith generics."},
+					{Title: "Long", URL: "https://go.dev/blog", Snippet: strings.Repeat("a", 400)},
+				}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"query":"go generics"`)
+				assert.Contains(t, resp.Content, `{"title":"Tutorial","url":"https://go.dev/doc/tutorial/generics","snippet":"Get started with generics."}`)
+				assert.Contains(t, resp.Content, strings.Repeat("a", 300)+"…")
+				assert.NotContains(t, resp.Content, strings.Repeat("a", 301))
+			},
+		},
+		"default-and-clamped-limit": {
+			input: `{"query":"go","max_results":50}`,
+			setupMocks: func(m *assistant.MockWebSearcher) {
+				m.EXPECT().Search(mock.Anything, "go", 10).Return(nil, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "No results on the allowed domains")
+			},
+		},
+		"missing-query": {
+			input:      `{"query":""}`,
+			setupMocks: func(m *assistant.MockWebSearcher) {},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_query")
+			},
+		},
+		"searcher-error": {
+			input: `{"query":"go"}`,
+			setupMocks: func(m *assistant.MockWebSearcher) {
+				m.EXPECT().Search(mock.Anything, "go", 5).Return(nil, errors.New("timeout")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "search_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			searcher := assistant.NewMockWebSearcher(t)
+			tt.setupMocks(searcher)
+
+			action := NewSearchWebAction(searcher)
+			assert.Equal(t, "search_web", action.Definition().Name)
+			assert.True(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "search_web", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
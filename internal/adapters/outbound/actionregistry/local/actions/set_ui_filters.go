@@ -32,6 +32,7 @@ func (t SetUIFiltersAction) Definition() assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name:        "set_ui_filters",
 		Description: "Set UI filter state for read/query views.",
+		ReadOnly:    true,
 		Input: assistant.ActionInput{
 			Type: "object",
 			Fields: map[string]assistant.ActionField{
@@ -64,9 +65,9 @@ func (t SetUIFiltersAction) Definition() assistant.ActionDefinition {
 				},
 				"sort_by": {
 					Type:        "string",
-					Description: "Optional sort. Allowed: dueDateAsc, dueDateDesc, createdAtAsc, createdAtDesc, similarityAsc, similarityDesc. Use similarity sort only with search_by_similarity. similarityAsc returns most similar first.",
+					Description: "Optional sort. Allowed: dueDateAsc, dueDateDesc, createdAtAsc, createdAtDesc, similarityAsc, similarityDesc, smartOrder. Use similarity sort only with search_by_similarity. similarityAsc returns most similar first. smartOrder lists what to do first: overdue todos, then by priority and due date proximity.",
 					Required:    false,
-					Enum:        []any{"dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc"},
+					Enum:        []any{"dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc", "smartOrder"},
 				},
 				"due_after": {
 					Type:        "string",
@@ -101,22 +102,15 @@ func (t SetUIFiltersAction) Execute(_ context.Context, call assistant.ActionCall
 	exampleArgs := `{"search_by_similarity":"buy milk","sort_by":"similarityAsc","page":1,"page_size":25}`
 
 	if err := unmarshalActionInput(call.Input, &params); err != nil {
-		content := newActionError("invalid_arguments", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
 	}
 
 	var dueAfterTime *time.Time
 	var dueBeforeTime *time.Time
 	if params.DueAfter != nil || params.DueBefore != nil {
 		var errMsg *assistant.Message
-		dueAfterTime, dueBeforeTime, errMsg = parseDueDateParams(params.DueAfter, params.DueBefore, exampleArgs)
+		dueAfterTime, dueBeforeTime, errMsg = parseDueDateParams(call, params.DueAfter, params.DueBefore, exampleArgs)
 		if errMsg != nil {
-			errMsg.ActionCallID = &call.ID
 			return *errMsg
 		}
 	}
@@ -130,18 +124,8 @@ func (t SetUIFiltersAction) Execute(_ context.Context, call assistant.ActionCall
 		Validate()
 	if err != nil {
 		code := mapTodoFilterBuildErrCode(err)
-		content := newActionError(code, err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, code, err.Error(), exampleArgs)
 	}
 
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      "ok",
-	}
+	return assistant.NewActionResultMessage(call, nil)
 }
@@ -21,7 +21,7 @@ func TestSetUIFiltersAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Equal(t, "ok", resp.Content)
+				assert.Equal(t, `{"status":"success"}`, resp.Content)
 			},
 		},
 		"set-ui-filters-success-one-search-empty-other-search-used": {
@@ -31,7 +31,7 @@ func TestSetUIFiltersAction(t *testing.T) {
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, resp.Role)
-				assert.Equal(t, "ok", resp.Content)
+				assert.Equal(t, `{"status":"success"}`, resp.Content)
 			},
 		},
 		"set-ui-filters-invalid-status": {
@@ -143,6 +143,7 @@ func TestSetUIFiltersAction(t *testing.T) {
 			assert.Equal(t, "set_ui_filters", definition.Name)
 			assert.NotEmpty(t, definition.Description)
 			assert.NotEmpty(t, definition.Input)
+			assert.True(t, definition.ReadOnly)
 
 			resp := action.Execute(t.Context(), tt.functionCall, []assistant.Message{})
 			tt.validateResp(t, resp)
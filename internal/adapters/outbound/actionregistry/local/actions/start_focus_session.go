@@ -0,0 +1,109 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+)
+
+// defaultFocusSessionMinutes is the classic pomodoro length used when no duration is given.
+const defaultFocusSessionMinutes = 25
+
+// StartFocusSessionAction is an assistant action for starting a pomodoro-style focus session on a todo.
+type StartFocusSessionAction struct {
+	focusSessions todouc.FocusSessions
+}
+
+// NewStartFocusSessionAction creates a new instance of StartFocusSessionAction.
+func NewStartFocusSessionAction(focusSessions todouc.FocusSessions) StartFocusSessionAction {
+	return StartFocusSessionAction{
+		focusSessions: focusSessions,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a StartFocusSessionAction) StatusMessage() string {
+	return "🍅 Starting focus session..."
+}
+
+// Renderer reports that start_focus_session does not expose a deterministic renderer.
+func (a StartFocusSessionAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for StartFocusSessionAction.
+func (a StartFocusSessionAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "start_focus_session",
+		Description: "Start a timed focus (pomodoro) session on one todo. The session time is logged against the todo when it ends.",
+		Input: assistant.ActionInput{
+			Type: "object",
+			Fields: map[string]assistant.ActionField{
+				"todo_id": {
+					Type:        "string",
+					Description: "ID of the todo to focus on. REQUIRED.",
+					Required:    true,
+				},
+				"minutes": {
+					Type:        "integer",
+					Description: "Optional session length in minutes, between 1 and 240. Defaults to 25.",
+					Required:    false,
+				},
+			},
+		},
+	}
+}
+
+// Execute executes StartFocusSessionAction.
+func (a StartFocusSessionAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	params := struct {
+		TodoID  string `json:"todo_id"`
+		Minutes *int   `json:"minutes"`
+	}{}
+	exampleArgs := `{"todo_id":"<uuid>","minutes":25}`
+
+	if err := unmarshalActionInput(call.Input, &params); err != nil {
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
+	}
+
+	todoID, err := uuid.Parse(params.TodoID)
+	if err != nil {
+		return newActionErrorMessage(call, "invalid_todo_id", err.Error(), exampleArgs)
+	}
+
+	minutes := defaultFocusSessionMinutes
+	if params.Minutes != nil {
+		minutes = *params.Minutes
+	}
+	if minutes <= 0 {
+		return newActionErrorMessage(call, "invalid_minutes", "minutes must be greater than zero.", exampleArgs)
+	}
+
+	var conversationID *uuid.UUID
+	if id, ok := assistant.ConversationIDFromContext(ctx); ok {
+		conversationID = &id
+	}
+
+	session, err := a.focusSessions.Start(ctx, todoID, time.Duration(minutes)*time.Minute, conversationID)
+	if err != nil {
+		return newActionErrorMessage(call, "focus_session_error", err.Error(), exampleArgs)
+	}
+
+	type sessionRow struct {
+		ID      string `json:"id"`
+		TodoID  string `json:"todo_id"`
+		Minutes int    `json:"minutes"`
+		EndsAt  string `json:"ends_at"`
+	}
+	return assistant.NewActionResultMessage(call, map[string]any{
+		"focus_session": []sessionRow{{
+			ID:      session.ID.String(),
+			TodoID:  session.TodoID.String(),
+			Minutes: minutes,
+			EndsAt:  session.EndsAt().Format(time.RFC3339),
+		}},
+	})
+}
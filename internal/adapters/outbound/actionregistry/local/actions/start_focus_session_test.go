@@ -0,0 +1,147 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStartFocusSessionAction(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.New()
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		withConversation bool
+		setupMocks       func(*todouc.MockFocusSessions)
+		functionCall     assistant.ActionCall
+		validateResp     func(t *testing.T, resp assistant.Message)
+	}{
+		"start-default-duration": {
+			withConversation: true,
+			setupMocks: func(m *todouc.MockFocusSessions) {
+				m.EXPECT().
+					Start(mock.Anything, todoID, 25*time.Minute, &conversationID).
+					Return(todo.FocusSession{ID: uuid.New(), TodoID: todoID, Duration: 25 * time.Minute, StartedAt: startedAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `{"todo_id":"` + todoID.String() + `"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"focus_session":[{`)
+				assert.Contains(t, resp.Content, "2026-03-02T09:25:00Z")
+			},
+		},
+		"start-without-conversation": {
+			setupMocks: func(m *todouc.MockFocusSessions) {
+				m.EXPECT().
+					Start(mock.Anything, todoID, 50*time.Minute, (*uuid.UUID)(nil)).
+					Return(todo.FocusSession{ID: uuid.New(), TodoID: todoID, Duration: 50 * time.Minute, StartedAt: startedAt}, nil).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `{"todo_id":"` + todoID.String() + `","minutes":50}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"minutes":50,`)
+			},
+		},
+		"invalid-arguments": {
+			setupMocks: func(m *todouc.MockFocusSessions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `invalid json`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_arguments")
+			},
+		},
+		"invalid-todo-id": {
+			setupMocks: func(m *todouc.MockFocusSessions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `{"todo_id":"abc"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_todo_id")
+			},
+		},
+		"invalid-minutes": {
+			setupMocks: func(m *todouc.MockFocusSessions) {},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `{"todo_id":"` + todoID.String() + `","minutes":-5}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Contains(t, resp.Content, "invalid_minutes")
+			},
+		},
+		"start-error": {
+			setupMocks: func(m *todouc.MockFocusSessions) {
+				m.EXPECT().
+					Start(mock.Anything, todoID, 25*time.Minute, mock.Anything).
+					Return(todo.FocusSession{}, core.NewValidationErr("a focus session is already running for this todo")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `{"todo_id":"` + todoID.String() + `"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "focus_session_error")
+				assert.Contains(t, resp.Content, "already running")
+			},
+		},
+		"start-unexpected-error": {
+			setupMocks: func(m *todouc.MockFocusSessions) {
+				m.EXPECT().
+					Start(mock.Anything, todoID, 25*time.Minute, mock.Anything).
+					Return(todo.FocusSession{}, errors.New("db error")).
+					Once()
+			},
+			functionCall: assistant.ActionCall{
+				Name:  "start_focus_session",
+				Input: `{"todo_id":"` + todoID.String() + `"}`,
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			focusSessions := todouc.NewMockFocusSessions(t)
+			tt.setupMocks(focusSessions)
+
+			action := NewStartFocusSessionAction(focusSessions)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "start_focus_session", definition.Name)
+			assert.False(t, definition.RequiresApproval())
+
+			ctx := t.Context()
+			if tt.withConversation {
+				ctx = assistant.WithConversationID(ctx, conversationID)
+			}
+			resp := action.Execute(ctx, tt.functionCall, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
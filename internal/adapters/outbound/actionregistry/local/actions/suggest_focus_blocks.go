@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// SuggestFocusBlocksAction is an assistant action that suggests calendar holds for working on estimated todos.
+type SuggestFocusBlocksAction struct {
+	focusBlocks todouc.FocusBlocks
+}
+
+// NewSuggestFocusBlocksAction creates a new instance of SuggestFocusBlocksAction.
+func NewSuggestFocusBlocksAction(focusBlocks todouc.FocusBlocks) SuggestFocusBlocksAction {
+	return SuggestFocusBlocksAction{
+		focusBlocks: focusBlocks,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a SuggestFocusBlocksAction) StatusMessage() string {
+	return "📅 Finding focus time..."
+}
+
+// Renderer reports that suggest_focus_blocks does not expose a deterministic renderer.
+func (a SuggestFocusBlocksAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for SuggestFocusBlocksAction.
+func (a SuggestFocusBlocksAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "suggest_focus_blocks",
+		Description: "Suggest focus blocks (calendar holds) for the open todos with an effort estimate over the next 7 days, before each todo's due date and within working hours. Suggestions are not saved; pass the ones the user wants to accept_focus_blocks.",
+		Input: assistant.ActionInput{
+			Type:   "object",
+			Fields: map[string]assistant.ActionField{},
+		},
+	}
+}
+
+// Execute executes SuggestFocusBlocksAction.
+func (a SuggestFocusBlocksAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	blocks, err := a.focusBlocks.Suggest(ctx)
+	if err != nil {
+		return newActionErrorMessage(call, "suggest_focus_blocks_error", err.Error(), `{}`)
+	}
+	return newFocusBlocksResultMessage(call, "suggested", blocks)
+}
+
+// newFocusBlocksResultMessage builds the result listing focus blocks under the given key.
+func newFocusBlocksResultMessage(call assistant.ActionCall, key string, blocks []todo.FocusBlock) assistant.Message {
+	type blockRow struct {
+		TodoID  string `json:"todo_id"`
+		Title   string `json:"title"`
+		Start   string `json:"start"`
+		End     string `json:"end"`
+		Minutes int    `json:"minutes"`
+	}
+
+	rows := make([]blockRow, 0, len(blocks))
+	for _, b := range blocks {
+		rows = append(rows, blockRow{
+			TodoID:  b.TodoID.String(),
+			Title:   b.Title,
+			Start:   b.Start.Format(time.RFC3339),
+			End:     b.End.Format(time.RFC3339),
+			Minutes: int(b.Duration().Minutes()),
+		})
+	}
+	return assistant.NewActionResultMessage(call, map[string]any{key: rows})
+}
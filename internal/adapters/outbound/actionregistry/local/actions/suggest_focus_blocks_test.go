@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSuggestFocusBlocksAction(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockFocusBlocks)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"suggested": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {
+				m.EXPECT().Suggest(mock.Anything).Return([]todo.FocusBlock{{
+					TodoID: todoID,
+					Title:  "Write report",
+					Start:  start,
+					End:    start.Add(90 * time.Minute),
+					Status: todo.FocusBlockStatus_SUGGESTED,
+				}}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"suggested":[{`)
+				assert.Contains(t, resp.Content, `"todo_id":"00000000-0000-0000-0000-000000000001"`)
+				assert.Contains(t, resp.Content, `"start":"2026-03-02T09:00:00Z"`)
+				assert.Contains(t, resp.Content, `"minutes":90`)
+			},
+		},
+		"suggest-error": {
+			setupMocks: func(m *todouc.MockFocusBlocks) {
+				m.EXPECT().Suggest(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "suggest_focus_blocks_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			focusBlocks := todouc.NewMockFocusBlocks(t)
+			tt.setupMocks(focusBlocks)
+
+			action := NewSuggestFocusBlocksAction(focusBlocks)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "suggest_focus_blocks", definition.Name)
+			assert.False(t, definition.RequiresApproval())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{Name: "suggest_focus_blocks", Input: `{}`}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
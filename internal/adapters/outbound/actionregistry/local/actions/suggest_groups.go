@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+)
+
+// SuggestGroupsAction is an assistant action that lists the groups of similar todos proposed as projects.
+type SuggestGroupsAction struct {
+	projects todouc.Projects
+}
+
+// NewSuggestGroupsAction creates a new instance of SuggestGroupsAction.
+func NewSuggestGroupsAction(projects todouc.Projects) SuggestGroupsAction {
+	return SuggestGroupsAction{
+		projects: projects,
+	}
+}
+
+// StatusMessage returns a status message about the action execution.
+func (a SuggestGroupsAction) StatusMessage() string {
+	return "🗂️ Looking for related todos..."
+}
+
+// Renderer reports that suggest_groups does not expose a deterministic renderer.
+func (a SuggestGroupsAction) Renderer() (assistant.ActionResultRenderer, bool) {
+	return nil, false
+}
+
+// Definition returns the assistant action definition for SuggestGroupsAction.
+func (a SuggestGroupsAction) Definition() assistant.ActionDefinition {
+	return assistant.ActionDefinition{
+		Name:        "suggest_groups",
+		Description: "List the groups of similar open todos proposed as projects, each with a suggested name and its todos. Groups are found periodically from todos that belong to no project; pass the suggestion_id of a group the user wants to keep to accept_group.",
+		Input: assistant.ActionInput{
+			Type:   "object",
+			Fields: map[string]assistant.ActionField{},
+		},
+	}
+}
+
+// Execute executes SuggestGroupsAction.
+func (a SuggestGroupsAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
+	suggestions, err := a.projects.Suggestions(ctx)
+	if err != nil {
+		return newActionErrorMessage(call, "suggest_groups_error", err.Error(), `{}`)
+	}
+
+	type groupRow struct {
+		SuggestionID string   `json:"suggestion_id"`
+		Name         string   `json:"name"`
+		Todos        []string `json:"todos"`
+	}
+
+	rows := make([]groupRow, 0, len(suggestions))
+	for _, s := range suggestions {
+		titles := make([]string, len(s.Todos))
+		for i, t := range s.Todos {
+			titles[i] = t.Title
+		}
+		rows = append(rows, groupRow{
+			SuggestionID: s.ID.String(),
+			Name:         s.Name,
+			Todos:        titles,
+		})
+	}
+	return assistant.NewActionResultMessage(call, map[string]any{"groups": rows})
+}
@@ -0,0 +1,69 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSuggestGroupsAction(t *testing.T) {
+	t.Parallel()
+
+	suggestionID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		setupMocks   func(*todouc.MockProjects)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"suggested": {
+			setupMocks: func(m *todouc.MockProjects) {
+				m.EXPECT().Suggestions(mock.Anything).Return([]todo.ProjectSuggestion{{
+					ID:   suggestionID,
+					Name: "House move",
+					Todos: []todo.ProjectTodo{
+						{ID: uuid.New(), Title: "Pack kitchen boxes"},
+						{ID: uuid.New(), Title: "Book movers"},
+					},
+				}}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"groups":[{`)
+				assert.Contains(t, resp.Content, `"suggestion_id":"00000000-0000-0000-0000-000000000001"`)
+				assert.Contains(t, resp.Content, `"name":"House move"`)
+				assert.Contains(t, resp.Content, `"todos":["Pack kitchen boxes","Book movers"]`)
+			},
+		},
+		"suggestions-error": {
+			setupMocks: func(m *todouc.MockProjects) {
+				m.EXPECT().Suggestions(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "suggest_groups_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			projects := todouc.NewMockProjects(t)
+			tt.setupMocks(projects)
+
+			action := NewSuggestGroupsAction(projects)
+			assert.NotEmpty(t, action.StatusMessage())
+			definition := action.Definition()
+			assert.Equal(t, "suggest_groups", definition.Name)
+			assert.False(t, definition.RequiresApproval())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{Name: "suggest_groups", Input: `{}`}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package actions
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSummarizeURLAction(t *testing.T) {
+	t.Parallel()
+
+	pageURL := "https://go.dev/blog/intro-generics"
+	page := assistant.WebPage{URL: pageURL, Title: "An Introduction To Generics", Text: "Go 1.18 adds generics."}
+	todoID := uuid.MustParse("0195a6a1-3b5c-7c3e-9d2f-5e8f1a2b3c4d")
+	noteID := uuid.MustParse("0195a6a1-3b5c-7c3e-9d2f-000000000001")
+
+	tests := map[string]struct {
+		input        string
+		setupMocks   func(*assistant.MockWebPageReader, *assistant.MockAssistant, *todouc.MockComments)
+		validateResp func(t *testing.T, resp assistant.Message)
+	}{
+		"summarizes-page": {
+			input: `{"url":"https://go.dev/blog/intro-generics","focus":"syntax"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, pageURL).Return(page, nil).Once()
+				a.EXPECT().RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+					return req.Model == "cheap-model" &&
+						strings.HasSuffix(req.Messages[0].Content, "Focus on: syntax") &&
+						strings.Contains(req.Messages[1].Content, "Go 1.18 adds generics.")
+				})).Return(assistant.TurnResponse{Content: " - Generics arrive in Go 1.18.\nTakeaway: try them. "}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"title":"An Introduction To Generics"`)
+				assert.Contains(t, resp.Content, "Generics arrive in Go 1.18.")
+				assert.NotContains(t, resp.Content, "note_id")
+			},
+		},
+		"attaches-note": {
+			input: `{"url":"https://go.dev/blog/intro-generics","todo_id":"0195a6a1-3b5c-7c3e-9d2f-5e8f1a2b3c4d"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, pageURL).Return(assistant.WebPage{
+					URL: pageURL, Title: page.Title, Text: page.Text, Truncated: true,
+				}, nil).Once()
+				a.EXPECT().RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: "Takeaway: generics are here."}, nil).Once()
+				c.EXPECT().Add(mock.Anything, todoID, todo.CommentAuthor_Assistant,
+					"Summary of An Introduction To Generics (https://go.dev/blog/intro-generics):\nTakeaway: generics are here.").
+					Return(todo.Comment{ID: noteID}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.Nil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, `"note_id":"`+noteID.String()+`"`)
+				assert.Contains(t, resp.Content, `"truncated":"The page was longer than the size limit`)
+			},
+		},
+		"invalid-todo-id": {
+			input:      `{"url":"https://go.dev/","todo_id":"abc"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "invalid_todo_id")
+			},
+		},
+		"url-not-allowed": {
+			input: `{"url":"https://example.com/"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, "https://example.com/").
+					Return(assistant.WebPage{}, core.NewForbiddenErr("url is outside the allowed domains")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "url_not_allowed")
+			},
+		},
+		"fetch-error": {
+			input: `{"url":"https://go.dev/"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, "https://go.dev/").Return(assistant.WebPage{}, errors.New("timeout")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "fetch_error")
+			},
+		},
+		"empty-page": {
+			input: `{"url":"https://go.dev/"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, "https://go.dev/").Return(assistant.WebPage{URL: "https://go.dev/"}, nil).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "empty_page")
+			},
+		},
+		"summarize-error": {
+			input: `{"url":"https://go.dev/blog/intro-generics"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, pageURL).Return(page, nil).Once()
+				a.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{}, errors.New("model down")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "summarize_error")
+			},
+		},
+		"attach-note-error": {
+			input: `{"url":"https://go.dev/blog/intro-generics","todo_id":"0195a6a1-3b5c-7c3e-9d2f-5e8f1a2b3c4d"}`,
+			setupMocks: func(r *assistant.MockWebPageReader, a *assistant.MockAssistant, c *todouc.MockComments) {
+				r.EXPECT().Read(mock.Anything, pageURL).Return(page, nil).Once()
+				a.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{Content: "ok"}, nil).Once()
+				c.EXPECT().Add(mock.Anything, todoID, todo.CommentAuthor_Assistant, mock.Anything).
+					Return(todo.Comment{}, core.NewNotFoundErr("todo not found")).Once()
+			},
+			validateResp: func(t *testing.T, resp assistant.Message) {
+				assert.NotNil(t, resp.ActionError)
+				assert.Contains(t, resp.Content, "attach_note_error")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reader := assistant.NewMockWebPageReader(t)
+			assistantMock := assistant.NewMockAssistant(t)
+			comments := todouc.NewMockComments(t)
+			tt.setupMocks(reader, assistantMock, comments)
+
+			action := NewSummarizeURLAction(reader, assistantMock, comments, "cheap-model")
+			assert.Equal(t, "summarize_url", action.Definition().Name)
+			assert.False(t, action.Definition().ReadOnly)
+			assert.NotEmpty(t, action.StatusMessage())
+
+			resp := action.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "summarize_url", Input: tt.input}, nil)
+			tt.validateResp(t, resp)
+		})
+	}
+}
+
+func TestSummaryNote(t *testing.T) {
+	t.Parallel()
+
+	note := summaryNote(assistant.WebPage{URL: "https://go.dev/"}, strings.Repeat("a", 3000))
+	assert.True(t, strings.HasPrefix(note, "Summary of https://go.dev/:\n"))
+	assert.Equal(t, todo.MaxCommentBodyChars, utf8.RuneCountInString(note))
+}
@@ -0,0 +1,232 @@
+package actions
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// todoPrefetchTTL bounds how long a speculatively fetched todo list can be served.
+	todoPrefetchTTL = 10 * time.Second
+	// todoPrefetchLimit is the number of todos fetched speculatively, enough to serve the first pages of common page sizes.
+	todoPrefetchLimit = 50
+)
+
+var (
+	// listIntentVerbs are words asking to see existing todos.
+	listIntentVerbs = wordSet("show", "list", "display", "view", "see")
+	// listIntentNouns are words naming todos.
+	listIntentNouns = wordSet("todo", "todos", "task", "tasks")
+	// listIntentBlockers are words implying a mutation or filters the prefetch query would not match.
+	listIntentBlockers = wordSet(
+		"add", "create", "new", "delete", "remove", "update", "rename", "change", "edit", "mark", "complete",
+		"reschedule", "move", "postpone", "log", "start", "plan", "schedule", "summarize", "summary", "research",
+		"due", "overdue", "late", "today", "tomorrow", "yesterday", "week", "month", "about", "related", "similar",
+		"regarding", "named", "called", "titled", "containing", "sort", "sorted", "order", "first", "oldest",
+		"newest", "latest", "page", "next", "more", "comments", "notes", "time", "logged", "spent", "screen",
+	)
+	// openStatusWords and doneStatusWords map status language to the fetch_todos status filter.
+	openStatusWords = wordSet("open", "pending", "remaining", "unfinished")
+	doneStatusWords = wordSet("done", "completed", "finished")
+)
+
+// todoPrefetch is one speculative todo list query, shared by every reader until it expires.
+type todoPrefetch struct {
+	done      chan struct{}
+	todos     []todo.Todo
+	hasMore   bool
+	err       error
+	expiresAt time.Time
+}
+
+// todoListPrefetcher runs the likely fetch_todos repository query while the model is still deciding,
+// and serves its pages from a short-lived cache when the action call arrives.
+type todoListPrefetcher struct {
+	repo todo.Repository
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*todoPrefetch
+}
+
+// newTodoListPrefetcher creates a todoListPrefetcher.
+func newTodoListPrefetcher(repo todo.Repository) *todoListPrefetcher {
+	return &todoListPrefetcher{
+		repo:    repo,
+		now:     time.Now,
+		entries: make(map[string]*todoPrefetch),
+	}
+}
+
+// Start queries the todos in the background when the latest user message plainly asks to list them.
+// A fresh prefetch for the same status filter is reused. Turns run inside a transaction.Sandbox are not
+// prefetched, since the query would share their transaction and cache changes that are discarded.
+func (p *todoListPrefetcher) Start(ctx context.Context, messages []assistant.Message) {
+	if transaction.InSandbox(ctx) {
+		return
+	}
+	status, ok := detectTodoListIntent(latestUserContent(messages))
+	if !ok {
+		return
+	}
+	key := prefetchKey(ctx, status)
+
+	p.mu.Lock()
+	if entry, exists := p.entries[key]; exists && p.now().Before(entry.expiresAt) {
+		p.mu.Unlock()
+		return
+	}
+	entry := &todoPrefetch{
+		done:      make(chan struct{}),
+		expiresAt: p.now().Add(todoPrefetchTTL),
+	}
+	p.entries[key] = entry
+	p.mu.Unlock()
+
+	// The query outlives the caller, which returns before the model requests the action.
+	prefetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), todoPrefetchTTL)
+	metrics.RecordActionPrefetch(prefetchCtx, "fetch_todos", "started")
+	go func() {
+		defer cancel()
+		defer close(entry.done)
+
+		spanCtx, span := telemetry.StartSpan(prefetchCtx, trace.WithAttributes(
+			attribute.String("status", statusFilter(status)),
+		))
+		defer span.End()
+
+		opts := []todo.ListOption{}
+		if status != nil {
+			opts = append(opts, todo.WithStatus(*status))
+		}
+		entry.todos, entry.hasMore, entry.err = p.repo.ListTodos(spanCtx, 1, todoPrefetchLimit, opts...)
+		telemetry.IsErrorRecorded(span, entry.err)
+	}()
+}
+
+// Page returns one page of the prefetched todos with the given status filter, waiting for a running query.
+// It reports false when no fresh prefetch covers the page, so the caller queries the repository itself.
+// Prefetched lists miss the changes of a transaction.Sandbox, so they are never served inside one.
+func (p *todoListPrefetcher) Page(ctx context.Context, status *todo.Status, page, pageSize int) ([]todo.Todo, bool, bool) {
+	if page < 1 || pageSize < 1 || transaction.InSandbox(ctx) {
+		return nil, false, false
+	}
+
+	p.mu.Lock()
+	entry, exists := p.entries[prefetchKey(ctx, status)]
+	p.mu.Unlock()
+	if !exists || !p.now().Before(entry.expiresAt) {
+		return nil, false, false
+	}
+
+	select {
+	case <-entry.done:
+	case <-ctx.Done():
+		return nil, false, false
+	}
+	if entry.err != nil {
+		return nil, false, false
+	}
+
+	start := (page - 1) * pageSize
+	end := start + pageSize
+	if end > len(entry.todos) && entry.hasMore {
+		return nil, false, false
+	}
+
+	start = min(start, len(entry.todos))
+	end = min(end, len(entry.todos))
+	todos := make([]todo.Todo, end-start)
+	copy(todos, entry.todos[start:end])
+	metrics.RecordActionPrefetch(ctx, "fetch_todos", "hit")
+	return todos, end < len(entry.todos) || entry.hasMore, true
+}
+
+// Discard drops every prefetched list, so later reads go to the repository.
+func (p *todoListPrefetcher) Discard() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clear(p.entries)
+}
+
+// prefetchKey identifies a prefetched list by its tenant and status filter.
+func prefetchKey(ctx context.Context, status *todo.Status) string {
+	return string(tenant.IDFromContext(ctx)) + ":" + statusFilter(status)
+}
+
+// statusFilter names the status filter of a prefetched list, empty when it lists every status.
+func statusFilter(status *todo.Status) string {
+	if status == nil {
+		return ""
+	}
+	return string(*status)
+}
+
+// detectTodoListIntent reports whether the message plainly asks to list todos, returning the status it names.
+// Messages that mention mutations, dates, searches or sorting are rejected, since the model would call
+// fetch_todos with arguments the speculative query does not match.
+func detectTodoListIntent(message string) (*todo.Status, bool) {
+	words := strings.FieldsFunc(strings.ToLower(message), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	var hasVerb, hasNoun, wantsOpen, wantsDone bool
+	for _, word := range words {
+		if _, ok := listIntentBlockers[word]; ok {
+			return nil, false
+		}
+		_, isVerb := listIntentVerbs[word]
+		_, isNoun := listIntentNouns[word]
+		_, isOpen := openStatusWords[word]
+		_, isDone := doneStatusWords[word]
+		hasVerb = hasVerb || isVerb
+		hasNoun = hasNoun || isNoun
+		wantsOpen = wantsOpen || isOpen
+		wantsDone = wantsDone || isDone
+	}
+	if !hasVerb || !hasNoun || (wantsOpen && wantsDone) {
+		return nil, false
+	}
+
+	switch {
+	case wantsOpen:
+		status := todo.Status_OPEN
+		return &status, true
+	case wantsDone:
+		status := todo.Status_DONE
+		return &status, true
+	default:
+		return nil, true
+	}
+}
+
+// latestUserContent returns the content of the most recent user message.
+func latestUserContent(messages []assistant.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == assistant.ChatRole_User {
+			return messages[i].Content
+		}
+	}
+	return ""
+}
+
+// wordSet builds a lookup set from the given words.
+func wordSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,255 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDetectTodoListIntent(t *testing.T) {
+	t.Parallel()
+
+	open := todo.Status_OPEN
+	done := todo.Status_DONE
+
+	tests := map[string]struct {
+		message    string
+		wantStatus *todo.Status
+		wantOK     bool
+	}{
+		"list-my-todos":        {message: "List my todos", wantOK: true},
+		"show-open-tasks":      {message: "Show my open tasks, please!", wantStatus: &open, wantOK: true},
+		"display-completed":    {message: "display completed todos", wantStatus: &done, wantOK: true},
+		"missing-verb":         {message: "my todos", wantOK: false},
+		"missing-noun":         {message: "show me the weather", wantOK: false},
+		"mutation-blocks":      {message: "add milk and show my todos", wantOK: false},
+		"due-range-blocks":     {message: "show my todos due this week", wantOK: false},
+		"search-blocks":        {message: "list todos about taxes", wantOK: false},
+		"conflicting-statuses": {message: "show open and done todos", wantOK: false},
+		"empty":                {message: "", wantOK: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotStatus, gotOK := detectTodoListIntent(tt.message)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.wantStatus, gotStatus)
+		})
+	}
+}
+
+func TestTodoListPrefetcher_Page(t *testing.T) {
+	t.Parallel()
+
+	prefetched := make([]todo.Todo, 25)
+	for i := range prefetched {
+		prefetched[i] = todo.Todo{Title: fmt.Sprintf("Todo %d", i+1), Status: todo.Status_OPEN}
+	}
+	open := todo.Status_OPEN
+	listMessages := []assistant.Message{{Role: assistant.ChatRole_User, Content: "show my open todos"}}
+
+	tests := map[string]struct {
+		messages    []assistant.Message
+		setupMocks  func(*todo.MockRepository)
+		prepare     func(*todoListPrefetcher)
+		pageTenant  tenant.ID
+		status      *todo.Status
+		page        int
+		pageSize    int
+		wantTitles  []string
+		wantHasMore bool
+		wantServed  bool
+	}{
+		"serves-first-page": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, false, nil).Once()
+			},
+			status:      &open,
+			page:        1,
+			pageSize:    2,
+			wantTitles:  []string{"Todo 1", "Todo 2"},
+			wantHasMore: true,
+			wantServed:  true,
+		},
+		"serves-last-page-of-complete-list": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, false, nil).Once()
+			},
+			status:      &open,
+			page:        3,
+			pageSize:    10,
+			wantTitles:  []string{"Todo 21", "Todo 22", "Todo 23", "Todo 24", "Todo 25"},
+			wantHasMore: false,
+			wantServed:  true,
+		},
+		"misses-page-beyond-truncated-list": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, true, nil).Once()
+			},
+			status:     &open,
+			page:       3,
+			pageSize:   10,
+			wantServed: false,
+		},
+		"misses-other-status": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, false, nil).Once()
+			},
+			status:     nil,
+			page:       1,
+			pageSize:   10,
+			wantServed: false,
+		},
+		"misses-other-tenant": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, false, nil).Once()
+			},
+			pageTenant: "acme",
+			status:     &open,
+			page:       1,
+			pageSize:   10,
+			wantServed: false,
+		},
+		"misses-after-query-error": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(nil, false, errors.New("db down")).Once()
+			},
+			status:     &open,
+			page:       1,
+			pageSize:   10,
+			wantServed: false,
+		},
+		"misses-after-expiry": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, false, nil).Once()
+			},
+			prepare: func(p *todoListPrefetcher) {
+				p.now = func() time.Time { return time.Now().Add(todoPrefetchTTL) }
+			},
+			status:     &open,
+			page:       1,
+			pageSize:   10,
+			wantServed: false,
+		},
+		"misses-after-discard": {
+			messages: listMessages,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit, mock.Anything).
+					Return(prefetched, false, nil).Once()
+			},
+			prepare: func(p *todoListPrefetcher) {
+				p.Discard()
+			},
+			status:     &open,
+			page:       1,
+			pageSize:   10,
+			wantServed: false,
+		},
+		"no-prefetch-without-list-intent": {
+			messages:   []assistant.Message{{Role: assistant.ChatRole_User, Content: "delete my open todos"}},
+			setupMocks: func(*todo.MockRepository) {},
+			status:     &open,
+			page:       1,
+			pageSize:   10,
+			wantServed: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := todo.NewMockRepository(t)
+			tt.setupMocks(repo)
+
+			prefetcher := newTodoListPrefetcher(repo)
+			prefetcher.Start(t.Context(), tt.messages)
+			if entry, ok := prefetcher.entries[prefetchKey(t.Context(), &open)]; ok {
+				<-entry.done
+			}
+			if tt.prepare != nil {
+				tt.prepare(prefetcher)
+			}
+
+			pageCtx := t.Context()
+			if tt.pageTenant != "" {
+				pageCtx = tenant.WithID(pageCtx, tt.pageTenant)
+			}
+			todos, hasMore, served := prefetcher.Page(pageCtx, tt.status, tt.page, tt.pageSize)
+			assert.Equal(t, tt.wantServed, served)
+			if !tt.wantServed {
+				return
+			}
+			titles := make([]string, len(todos))
+			for i, td := range todos {
+				titles[i] = td.Title
+			}
+			assert.Equal(t, tt.wantTitles, titles)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+		})
+	}
+}
+
+func TestTodoListPrefetcher_Start_ReusesFreshPrefetch(t *testing.T) {
+	t.Parallel()
+
+	repo := todo.NewMockRepository(t)
+	repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit).
+		Return([]todo.Todo{}, false, nil).Once()
+
+	prefetcher := newTodoListPrefetcher(repo)
+	messages := []assistant.Message{{Role: assistant.ChatRole_User, Content: "list my todos"}}
+	prefetcher.Start(t.Context(), messages)
+	prefetcher.Start(t.Context(), messages)
+
+	todos, hasMore, served := prefetcher.Page(t.Context(), nil, 1, 10)
+	assert.True(t, served)
+	assert.Empty(t, todos)
+	assert.False(t, hasMore)
+}
+
+func TestTodoListPrefetcher_SkipsSandbox(t *testing.T) {
+	t.Parallel()
+
+	repo := todo.NewMockRepository(t)
+	repo.EXPECT().ListTodos(mock.Anything, 1, todoPrefetchLimit).
+		Return([]todo.Todo{}, false, nil).Once()
+
+	prefetcher := newTodoListPrefetcher(repo)
+	messages := []assistant.Message{{Role: assistant.ChatRole_User, Content: "list my todos"}}
+	sandboxCtx := transaction.WithSandbox(t.Context())
+
+	prefetcher.Start(sandboxCtx, messages)
+	_, _, served := prefetcher.Page(t.Context(), nil, 1, 10)
+	assert.False(t, served)
+
+	prefetcher.Start(t.Context(), messages)
+	_, _, served = prefetcher.Page(sandboxCtx, nil, 1, 10)
+	assert.False(t, served)
+	_, _, served = prefetcher.Page(t.Context(), nil, 1, 10)
+	assert.True(t, served)
+}
@@ -40,13 +40,14 @@ func (a UpdateTodosAction) Renderer() (assistant.ActionResultRenderer, bool) {
 func (a UpdateTodosAction) Definition() assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name:        "update_todos",
-		Description: "Update title and/or status for multiple todos.",
+		Description: "Update title, status and/or estimated effort for multiple todos.",
+		Sandboxed:   true,
 		Input: assistant.ActionInput{
 			Type: "object",
 			Fields: map[string]assistant.ActionField{
 				"todos": {
 					Type:        "array",
-					Description: "List of todo updates. Each item: {id,title?,status?}. REQUIRED.",
+					Description: "List of todo updates. Each item: {id,title?,status?,estimated_minutes?}. REQUIRED.",
 					Required:    true,
 					Items: &assistant.ActionField{
 						Type:        "object",
@@ -60,15 +61,20 @@ func (a UpdateTodosAction) Definition() assistant.ActionDefinition {
 							},
 							"title": {
 								Type:        "string",
-								Description: "New title for the todo. Optional but at least one of title or status must be present.",
+								Description: "New title for the todo. Optional but at least one of title, status or estimated_minutes must be present.",
 								Required:    false,
 							},
 							"status": {
 								Type:        "string",
-								Description: "New status for the todo. Allowed values: OPEN or DONE. Optional but at least one of title or status must be present.",
+								Description: "New status for the todo. Allowed values: OPEN or DONE. Optional but at least one of title, status or estimated_minutes must be present.",
 								Required:    false,
 								Enum:        []any{todo.Status_OPEN, todo.Status_DONE},
 							},
+							"estimated_minutes": {
+								Type:        "integer",
+								Description: fmt.Sprintf("New estimated effort in minutes, from 0 to %d. Use 0 to clear the estimate. Optional.", todo.MaxEstimatedMinutes),
+								Required:    false,
+							},
 						},
 					},
 				},
@@ -82,6 +88,7 @@ func (a UpdateTodosAction) Definition() assistant.ActionDefinition {
 				"todos[].id",
 				"todos[].title",
 				"todos[].status",
+				"todos[].estimated_minutes",
 			},
 			Timeout: 2 * time.Minute,
 		},
@@ -92,77 +99,56 @@ func (a UpdateTodosAction) Definition() assistant.ActionDefinition {
 func (a UpdateTodosAction) Execute(ctx context.Context, call assistant.ActionCall, _ []assistant.Message) assistant.Message {
 	params := struct {
 		Todos []struct {
-			ID     string  `json:"id"`
-			Title  *string `json:"title"`
-			Status *string `json:"status"`
+			ID               string  `json:"id"`
+			Title            *string `json:"title"`
+			Status           *string `json:"status"`
+			EstimatedMinutes *int    `json:"estimated_minutes"`
 		} `json:"todos"`
 	}{}
 	exampleArgs := `{"todos":[{"id":"<uuid>","title":"Pay rent (done)","status":"DONE"},{"id":"<uuid>","title":"Buy groceries"}]}`
 
 	err := unmarshalActionInput(call.Input, &params)
 	if err != nil {
-		content := newActionError("invalid_arguments", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
 	}
 	if len(params.Todos) == 0 {
-		content := newActionError("invalid_arguments", "todos must not be empty.", exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", "todos must not be empty.", exampleArgs)
 	}
 
 	type updateItem struct {
-		ID     uuid.UUID
-		Title  *string
-		Status *todo.Status
+		ID               uuid.UUID
+		Title            *string
+		Status           *todo.Status
+		EstimatedMinutes *int
 	}
 	items := make([]updateItem, 0, len(params.Todos))
 	for i, td := range params.Todos {
 		todoID, parseErr := uuid.Parse(td.ID)
 		if parseErr != nil {
-			content := newActionError("invalid_todo_id", fmt.Sprintf("todo at index %d has invalid id: %s", i, parseErr.Error()), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_todo_id", fmt.Sprintf("todo at index %d has invalid id: %s", i, parseErr.Error()), exampleArgs)
 		}
 
 		var statusPtr *todo.Status
 		if td.Status != nil {
 			status := todo.Status(*td.Status)
 			if status != todo.Status_OPEN && status != todo.Status_DONE {
-				content := newActionError("invalid_status", fmt.Sprintf("todo at index %d has invalid status: %s", i, *td.Status), exampleArgs)
-				return assistant.Message{
-					Role:         assistant.ChatRole_Tool,
-					ActionCallID: &call.ID,
-					Content:      content,
-					ActionError:  &content,
-				}
+				return newActionErrorMessage(call, "invalid_status", fmt.Sprintf("todo at index %d has invalid status: %s", i, *td.Status), exampleArgs)
 			}
 			statusPtr = &status
 		}
 
 		items = append(items, updateItem{
-			ID:     todoID,
-			Title:  td.Title,
-			Status: statusPtr,
+			ID:               todoID,
+			Title:            td.Title,
+			Status:           statusPtr,
+			EstimatedMinutes: td.EstimatedMinutes,
 		})
 	}
 
 	todos := make([]todo.Todo, 0, len(items))
 	err = a.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
 		for i, item := range items {
-			todo, updateErr := a.updater.Update(uowCtx, scope, item.ID, item.Title, item.Status, nil)
+			todo, updateErr := a.updater.Update(uowCtx, scope, item.ID, item.Title, item.Status, nil, item.EstimatedMinutes, nil)
 			if updateErr != nil {
 				return fmt.Errorf("todo at index %d: %w", i, updateErr)
 			}
@@ -171,18 +157,8 @@ func (a UpdateTodosAction) Execute(ctx context.Context, call assistant.ActionCal
 		return nil
 	})
 	if err != nil {
-		content := newActionError("update_todos_error", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "update_todos_error", err.Error(), exampleArgs)
 	}
 
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      formatTodosRows(todos),
-	}
+	return newTodosResultMessage(call, todos)
 }
@@ -44,6 +44,7 @@ func (a UpdateTodosDueDateAction) Definition() assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name:        "update_todos_due_date",
 		Description: "Update due dates for multiple todos.",
+		Sandboxed:   true,
 		Input: assistant.ActionInput{
 			Type: "object",
 			Fields: map[string]assistant.ActionField{
@@ -96,22 +97,10 @@ func (a UpdateTodosDueDateAction) Execute(ctx context.Context, call assistant.Ac
 
 	err := unmarshalActionInput(call.Input, &params)
 	if err != nil {
-		content := newActionError("invalid_arguments", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", err.Error(), exampleArgs)
 	}
 	if len(params.Todos) == 0 {
-		content := newActionError("invalid_arguments", "todos must not be empty.", exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "invalid_arguments", "todos must not be empty.", exampleArgs)
 	}
 
 	now := a.timeProvider.Now()
@@ -123,24 +112,12 @@ func (a UpdateTodosDueDateAction) Execute(ctx context.Context, call assistant.Ac
 	for i, todo := range params.Todos {
 		todoID, parseErr := uuid.Parse(todo.ID)
 		if parseErr != nil {
-			content := newActionError("invalid_todo_id", fmt.Sprintf("todo at index %d has invalid id: %s", i, parseErr.Error()), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_todo_id", fmt.Sprintf("todo at index %d has invalid id: %s", i, parseErr.Error()), exampleArgs)
 		}
 
 		dueDate, found := extractDateParam(todo.DueDate, conversationHistory, now)
 		if !found {
-			content := newActionError("invalid_due_date", fmt.Sprintf("todo at index %d has invalid due_date.", i), exampleArgs)
-			return assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				ActionCallID: &call.ID,
-				Content:      content,
-				ActionError:  &content,
-			}
+			return newActionErrorMessage(call, "invalid_due_date", fmt.Sprintf("todo at index %d has invalid due_date.", i), exampleArgs)
 		}
 
 		items = append(items, updateItem{
@@ -152,7 +129,7 @@ func (a UpdateTodosDueDateAction) Execute(ctx context.Context, call assistant.Ac
 	todos := make([]todo.Todo, 0, len(items))
 	err = a.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
 		for i, item := range items {
-			todo, updateErr := a.updater.Update(uowCtx, scope, item.ID, nil, nil, &item.DueDate)
+			todo, updateErr := a.updater.Update(uowCtx, scope, item.ID, nil, nil, &item.DueDate, nil, nil)
 			if updateErr != nil {
 				return fmt.Errorf("todo at index %d: %w", i, updateErr)
 			}
@@ -161,18 +138,8 @@ func (a UpdateTodosDueDateAction) Execute(ctx context.Context, call assistant.Ac
 		return nil
 	})
 	if err != nil {
-		content := newActionError("update_todos_due_date_error", err.Error(), exampleArgs)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &content,
-		}
+		return newActionErrorMessage(call, "update_todos_due_date_error", err.Error(), exampleArgs)
 	}
 
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      formatTodosRows(todos),
-	}
+	return newTodosResultMessage(call, todos)
 }
@@ -15,7 +15,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/toon-format/toon-go"
 )
 
 func TestUpdateTodosDueDateAction(t *testing.T) {
@@ -47,6 +46,8 @@ func TestUpdateTodosDueDateAction(t *testing.T) {
 						(*string)(nil),
 						(*todo.Status)(nil),
 						common.Ptr(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
 					).
 					Return(
 						todo.Todo{
@@ -66,6 +67,8 @@ func TestUpdateTodosDueDateAction(t *testing.T) {
 						(*string)(nil),
 						(*todo.Status)(nil),
 						common.Ptr(time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
 					).
 					Return(
 						todo.Todo{
@@ -93,10 +96,10 @@ func TestUpdateTodosDueDateAction(t *testing.T) {
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				payload := struct {
 					Todos []struct {
-						Title string `toon:"title"`
-					} `toon:"todos"`
+						Title string `json:"title"`
+					} `json:"todos"`
 				}{}
-				assert.NoError(t, toon.UnmarshalString(resp.Content, &payload))
+				assert.True(t, unmarshalResultData(resp, &payload))
 				assert.Len(t, payload.Todos, 2)
 			},
 		},
@@ -144,6 +147,8 @@ func TestUpdateTodosDueDateAction(t *testing.T) {
 						(*string)(nil),
 						(*todo.Status)(nil),
 						common.Ptr(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
 					).
 					Return(todo.Todo{}, errors.New("update error")).
 					Once()
@@ -14,7 +14,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"github.com/toon-format/toon-go"
 )
 
 func TestUpdateTodosAction(t *testing.T) {
@@ -40,6 +39,8 @@ func TestUpdateTodosAction(t *testing.T) {
 						common.Ptr("Updated 1"),
 						common.Ptr(todo.Status_DONE),
 						(*time.Time)(nil),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
 					).
 					Return(
 						todo.Todo{
@@ -58,6 +59,8 @@ func TestUpdateTodosAction(t *testing.T) {
 						common.Ptr("Updated 2"),
 						(*todo.Status)(nil),
 						(*time.Time)(nil),
+						common.Ptr(45),
+						todo.CustomFieldValues(nil),
 					).
 					Return(
 						todo.Todo{
@@ -78,15 +81,15 @@ func TestUpdateTodosAction(t *testing.T) {
 			},
 			functionCall: assistant.ActionCall{
 				Name:  "update_todos",
-				Input: `{"todos":[{"id":"` + todoID1.String() + `","title":"Updated 1","status":"DONE"},{"id":"` + todoID2.String() + `","title":"Updated 2"}]}`,
+				Input: `{"todos":[{"id":"` + todoID1.String() + `","title":"Updated 1","status":"DONE"},{"id":"` + todoID2.String() + `","title":"Updated 2","estimated_minutes":45}]}`,
 			},
 			validateResp: func(t *testing.T, resp assistant.Message) {
 				payload := struct {
 					Todos []struct {
-						Title string `toon:"title"`
-					} `toon:"todos"`
+						Title string `json:"title"`
+					} `json:"todos"`
 				}{}
-				assert.NoError(t, toon.UnmarshalString(resp.Content, &payload))
+				assert.True(t, unmarshalResultData(resp, &payload))
 				assert.Len(t, payload.Todos, 2)
 			},
 		},
@@ -124,6 +127,8 @@ func TestUpdateTodosAction(t *testing.T) {
 						common.Ptr("Updated 1"),
 						(*todo.Status)(nil),
 						(*time.Time)(nil),
+						(*int)(nil),
+						todo.CustomFieldValues(nil),
 					).
 					Return(todo.Todo{}, errors.New("update error")).
 					Once()
@@ -161,7 +166,7 @@ func TestUpdateTodosAction(t *testing.T) {
 			assert.True(t, definition.Approval.Required)
 			assert.Equal(t, "Confirm update of todos", definition.Approval.Title)
 			assert.Equal(t, "Updating todos will modify existing items. Please confirm.", definition.Approval.Description)
-			assert.Equal(t, []string{"todos[].id", "todos[].title", "todos[].status"}, definition.Approval.PreviewFields)
+			assert.Equal(t, []string{"todos[].id", "todos[].title", "todos[].status", "todos[].estimated_minutes"}, definition.Approval.PreviewFields)
 			assert.Equal(t, 2*time.Minute, definition.Approval.Timeout)
 
 			resp := action.Execute(t.Context(), tt.functionCall, []assistant.Message{})
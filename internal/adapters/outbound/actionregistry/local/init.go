@@ -2,27 +2,50 @@ package local
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"os"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/actionregistry/declarative"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/actionregistry/local/actions"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	chatuc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	todouc "github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
+	"github.com/cleitonmarx/symbiont/config"
 	"github.com/cleitonmarx/symbiont/depend"
 )
 
 // InitActionRegistry initializes the local ActionRegistry with core and domain dependencies and registers it in the dependency container.
 type InitActionRegistry struct {
-	Uow            transaction.UnitOfWork   `resolve:""`
-	Creator        todouc.Creator           `resolve:""`
-	Updater        todouc.Updater           `resolve:""`
-	Deleter        todouc.Deleter           `resolve:""`
-	TodoRepo       todo.Repository          `resolve:""`
-	Encoder        semantic.Encoder         `resolve:""`
-	TimeProvider   core.CurrentTimeProvider `resolve:""`
-	EmbeddingModel string                   `config:"LLM_EMBEDDING_MODEL"`
+	Uow             transaction.UnitOfWork     `resolve:""`
+	Creator         todouc.Creator             `resolve:""`
+	Updater         todouc.Updater             `resolve:""`
+	TimeTracker     todouc.TimeTracker         `resolve:""`
+	FocusSessions   todouc.FocusSessions       `resolve:""`
+	FocusBlocks     todouc.FocusBlocks         `resolve:""`
+	Projects        todouc.Projects            `resolve:""`
+	Views           todouc.Views               `resolve:""`
+	Templates       todouc.Templates           `resolve:""`
+	List            todouc.List                `resolve:""`
+	Comments        todouc.Comments            `resolve:""`
+	Instructions    chatuc.Instructions        `resolve:""`
+	Deleter         todouc.Deleter             `resolve:""`
+	TodoRepo        todo.Repository            `resolve:""`
+	TimeEntryRepo   todo.TimeEntryRepository   `resolve:""`
+	CommentRepo     todo.CommentRepository     `resolve:""`
+	CustomFieldRepo todo.CustomFieldRepository `resolve:""`
+	Encoder         semantic.Encoder           `resolve:""`
+	Assistant       assistant.Assistant        `resolve:""`
+	TimeProvider    core.CurrentTimeProvider   `resolve:""`
+	HttpClient      *http.Client               `resolve:"standard"`
+	EmbeddingModel  string                     `config:"LLM_EMBEDDING_MODEL"`
+	PlannerModel    string                     `config:"LLM_SUMMARY_MODEL"`
+	ActionsDir      string                     `config:"ASSISTANT_ACTIONS_DIR" default:""`
+	DailyCapacity   int                        `config:"DAILY_CAPACITY_MINUTES" default:"480"`
 }
 
 // Initialize creates an ActionRegistry with the provided dependencies and registers it in the dependency container.
@@ -31,10 +54,23 @@ func (i InitActionRegistry) Initialize(ctx context.Context) (context.Context, er
 		actions.NewSetUIFiltersAction(),
 		actions.NewFetchTodosAction(
 			i.TodoRepo,
+			i.TimeEntryRepo,
+			i.CommentRepo,
+			i.CustomFieldRepo,
 			i.Encoder,
+			i.TimeProvider,
 			i.EmbeddingModel,
 		),
+		actions.NewQueryTodosAction(
+			i.List,
+		),
 		actions.NewCreateTodosAction(
+			i.Uow,
+			i.Creator,
+			i.CustomFieldRepo,
+			i.TimeProvider,
+		),
+		actions.NewCreateRecurringTodoAction(
 			i.Uow,
 			i.Creator,
 			i.TimeProvider,
@@ -52,9 +88,110 @@ func (i InitActionRegistry) Initialize(ctx context.Context) (context.Context, er
 			i.Uow,
 			i.Deleter,
 		),
+		actions.NewLogTimeAction(
+			i.TimeTracker,
+			i.TimeProvider,
+		),
+		actions.NewStartFocusSessionAction(
+			i.FocusSessions,
+		),
+		actions.NewSuggestFocusBlocksAction(
+			i.FocusBlocks,
+		),
+		actions.NewAcceptFocusBlocksAction(
+			i.FocusBlocks,
+		),
+		actions.NewSuggestGroupsAction(
+			i.Projects,
+		),
+		actions.NewAcceptGroupAction(
+			i.Projects,
+		),
+		actions.NewListViewsAction(
+			i.Views,
+			i.TimeProvider,
+		),
+		actions.NewSaveViewAction(
+			i.Views,
+		),
+		actions.NewApplyTemplateAction(
+			i.Templates,
+			i.TimeProvider,
+		),
+		actions.NewRememberInstructionAction(
+			i.Instructions,
+		),
+		actions.NewListInstructionsAction(
+			i.Instructions,
+		),
+		actions.NewForgetInstructionAction(
+			i.Instructions,
+		),
+		actions.NewPlanMyWeekAction(
+			i.TodoRepo,
+			i.Assistant,
+			i.Uow,
+			i.Updater,
+			i.TimeProvider,
+			i.PlannerModel,
+			i.DailyCapacity,
+		),
+		actions.NewCalculateAction(),
+		actions.NewCurrentDatetimeAction(
+			i.TimeProvider,
+		),
+		actions.NewDateDiffAction(
+			i.TimeProvider,
+		),
 	}
+	actions = append(actions, i.optionalActions()...)
+
+	declared, err := i.declarativeActions(ctx, actions)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to load declarative actions: %w", err)
+	}
+	actions = append(actions, declared...)
 
 	actionRegistry := NewActionRegistry(i.Encoder, i.EmbeddingModel, actions...)
 	depend.RegisterNamed[assistant.ActionRegistry](actionRegistry, "local")
 	return ctx, nil
 }
+
+// optionalActions returns the built-in actions whose backing service is only registered when configured.
+func (i InitActionRegistry) optionalActions() []assistant.Action {
+	var optional []assistant.Action
+	if forecaster, err := depend.Resolve[assistant.WeatherForecaster](); err == nil {
+		optional = append(optional, actions.NewGetWeatherForecastAction(forecaster))
+	}
+	if searcher, err := depend.Resolve[assistant.WebSearcher](); err == nil {
+		optional = append(optional, actions.NewSearchWebAction(searcher))
+	}
+	if reader, err := depend.Resolve[assistant.WebPageReader](); err == nil {
+		optional = append(optional, actions.NewSummarizeURLAction(reader, i.Assistant, i.Comments, i.PlannerModel))
+	}
+	return optional
+}
+
+// declarativeActions loads the HTTP actions declared in the YAML files of ActionsDir, rejecting the ones that
+// reuse the name of a built-in action.
+func (i InitActionRegistry) declarativeActions(ctx context.Context, builtIn []assistant.Action) ([]assistant.Action, error) {
+	if i.ActionsDir == "" {
+		return nil, nil
+	}
+
+	declared, err := declarative.LoadActionsFromFS(ctx, os.DirFS(i.ActionsDir), i.HttpClient, func(ctx context.Context, name string) (string, error) {
+		return config.Get[string](ctx, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range declared {
+		name := action.Definition().Name
+		for _, existing := range builtIn {
+			if existing.Definition().Name == name {
+				return nil, fmt.Errorf("action %q conflicts with a built-in action", name)
+			}
+		}
+	}
+	return declared, nil
+}
@@ -1,11 +1,15 @@
 package local
 
 import (
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont/depend"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitActionRegistry_Initialize(t *testing.T) {
@@ -20,3 +24,40 @@ func TestInitActionRegistry_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.IsType(t, ActionRegistry{}, dependency)
 }
+
+func TestInitActionRegistry_Initialize_DeclarativeActions(t *testing.T) {
+	tests := map[string]struct {
+		actionName  string
+		expectedErr string
+	}{
+		"registers-declared-actions": {
+			actionName: "lookup_invoice",
+		},
+		"rejects-built-in-names": {
+			actionName:  "fetch_todos",
+			expectedErr: `failed to load declarative actions: action "fetch_todos" conflicts with a built-in action`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			content := "actions:\n  - name: " + tt.actionName + "\n    description: Look up one invoice.\n    http:\n      url: https://billing.example.com/invoices\n"
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "billing.yaml"), []byte(content), 0o600))
+
+			i := InitActionRegistry{HttpClient: http.DefaultClient, ActionsDir: dir}
+			_, err := i.Initialize(t.Context())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			dependency, err := depend.ResolveNamed[assistant.ActionRegistry]("local")
+			require.NoError(t, err)
+			definition, found := dependency.GetDefinition(t.Context(), tt.actionName)
+			assert.True(t, found)
+			assert.Equal(t, "Look up one invoice.", definition.Description)
+		})
+	}
+}
@@ -11,6 +11,20 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// prefetchingAction is implemented by actions that can speculatively run their likely call.
+type prefetchingAction interface {
+	// Prefetch starts the likely call for the turn messages in the background.
+	Prefetch(ctx context.Context, messages []assistant.Message)
+	// DiscardPrefetched drops prefetched results that another action may have made stale.
+	DiscardPrefetched()
+}
+
+// contextualAction is implemented by actions whose definition depends on the tenant data in ctx.
+type contextualAction interface {
+	// DefinitionFor returns the action definition for the tenant in ctx.
+	DefinitionFor(ctx context.Context) assistant.ActionDefinition
+}
+
 // ActionRegistry manages a set of assistant actions defined within the todo application.
 type ActionRegistry struct {
 	actionsByName map[string]assistant.Action
@@ -36,23 +50,40 @@ func (r ActionRegistry) Execute(ctx context.Context, call assistant.ActionCall,
 	defer span.End()
 	details, exists := r.actionsByName[call.Name]
 	if !exists {
-		errMsg := fmt.Sprintf("Action '%s' is not registered.", call.Name)
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      fmt.Sprintf(`{"error":"unknown_action","details":"%s"}`, errMsg),
-			ActionError:  &errMsg,
-		}
+		return assistant.NewActionErrorMessage(call, "unknown_action", fmt.Sprintf("Action '%s' is not registered.", call.Name), "")
 	}
+	r.discardPrefetched(call.Name)
 	return details.Execute(spanCtx, call, conversationHistory)
 }
 
-// GetDefinition returns one action definition by name.
-func (r ActionRegistry) GetDefinition(actionName string) (assistant.ActionDefinition, bool) {
+// Prefetch starts the speculative calls of the named actions that support prefetching.
+func (r ActionRegistry) Prefetch(ctx context.Context, actionNames []string, messages []assistant.Message) {
+	for _, name := range actionNames {
+		if action, ok := r.actionsByName[name].(prefetchingAction); ok {
+			action.Prefetch(ctx, messages)
+		}
+	}
+}
+
+// discardPrefetched drops the prefetched results of every other action before one runs,
+// since the running action may change the data they were fetched from.
+func (r ActionRegistry) discardPrefetched(actionName string) {
+	for name, action := range r.actionsByName {
+		if prefetcher, ok := action.(prefetchingAction); ok && name != actionName {
+			prefetcher.DiscardPrefetched()
+		}
+	}
+}
+
+// GetDefinition returns one action definition by name, tailored to the tenant in ctx when the action supports it.
+func (r ActionRegistry) GetDefinition(ctx context.Context, actionName string) (assistant.ActionDefinition, bool) {
 	details, exists := r.actionsByName[actionName]
 	if !exists {
 		return assistant.ActionDefinition{}, false
 	}
+	if action, ok := details.(contextualAction); ok {
+		return action.DefinitionFor(ctx), true
+	}
 	return details.Definition(), true
 }
 
@@ -1,6 +1,7 @@
 package local
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
@@ -148,7 +149,7 @@ func TestActionRegistry_GetDefinition(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			registry := NewActionRegistry(nil, "", tt.setupActions(t)...)
-			definition, found := registry.GetDefinition(tt.actionName)
+			definition, found := registry.GetDefinition(t.Context(), tt.actionName)
 			tt.assertResult(t, definition, found)
 		})
 	}
@@ -196,6 +197,28 @@ func TestActionRegistry_GetRenderer(t *testing.T) {
 	}
 }
 
+func TestActionRegistry_Prefetch(t *testing.T) {
+	t.Parallel()
+
+	fetch := &prefetchingMockAction{MockAction: assistant.NewMockAction(t)}
+	fetch.EXPECT().Definition().Return(mockActionDefinition("fetch_todos"))
+	update := assistant.NewMockAction(t)
+	update.EXPECT().Definition().Return(mockActionDefinition("update_todos"))
+	update.EXPECT().
+		Execute(mock.Anything, mock.Anything, mock.Anything).
+		Return(assistant.Message{Role: assistant.ChatRole_Tool}).
+		Once()
+
+	registry := NewActionRegistry(nil, "", fetch, update)
+	messages := []assistant.Message{{Role: assistant.ChatRole_User, Content: "show my todos"}}
+
+	registry.Prefetch(t.Context(), []string{"fetch_todos", "update_todos", "unknown_action"}, messages)
+	assert.Equal(t, [][]assistant.Message{messages}, fetch.prefetched)
+
+	registry.Execute(t.Context(), assistant.ActionCall{ID: "call-1", Name: "update_todos", Input: "{}"}, messages)
+	assert.Equal(t, 1, fetch.discarded)
+}
+
 func mockActionDefinition(name string) assistant.ActionDefinition {
 	return assistant.ActionDefinition{
 		Name: name,
@@ -211,3 +234,18 @@ type actionsMockRenderer struct{}
 func (actionsMockRenderer) Render(_ assistant.ActionCall, _ assistant.Message) (assistant.Message, bool) {
 	return assistant.Message{}, false
 }
+
+// prefetchingMockAction is a mock action that records prefetch and discard calls.
+type prefetchingMockAction struct {
+	*assistant.MockAction
+	prefetched [][]assistant.Message
+	discarded  int
+}
+
+func (a *prefetchingMockAction) Prefetch(_ context.Context, messages []assistant.Message) {
+	a.prefetched = append(a.prefetched, messages)
+}
+
+func (a *prefetchingMockAction) DiscardPrefetched() {
+	a.discarded++
+}
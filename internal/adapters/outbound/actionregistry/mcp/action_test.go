@@ -73,9 +73,9 @@ func TestMCPToolAction_Methods(t *testing.T) {
 				assert.Equal(t, assistant.ChatRole_Tool, msg.Role)
 				require.NotNil(t, msg.ActionCallID)
 				assert.Equal(t, "call-1", *msg.ActionCallID)
-				assert.Contains(t, msg.Content, "mcp_call_error")
+				assert.Equal(t, `{"status":"error","error":{"code":"mcp_call_error","details":"action is not executable"}}`, msg.Content)
 				require.NotNil(t, msg.ActionError)
-				assert.Equal(t, "action is not executable", *msg.ActionError)
+				assert.Equal(t, msg.Content, *msg.ActionError)
 			},
 		},
 	}
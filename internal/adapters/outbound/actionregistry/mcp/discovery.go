@@ -7,10 +7,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
-	"github.com/toon-format/toon-go"
 )
 
 // listAllTools paginates through MCP ListTools until no cursor is returned.
@@ -62,7 +60,7 @@ func renderCallToolResult(result *mcp.CallToolResult) string {
 	}
 
 	if result.StructuredContent != nil {
-		if bytes, err := toon.Marshal(result.StructuredContent); err == nil {
+		if bytes, err := json.Marshal(result.StructuredContent); err == nil {
 			return string(bytes)
 		}
 	}
@@ -113,13 +111,21 @@ func renderContent(content mcp.Content) string {
 	}
 }
 
-// actionErrorMessage formats a structured tool error payload consumed by the assistant loop.
-func actionErrorMessage(callID, code, details string) assistant.Message {
-	content := fmt.Sprintf("errors[1]{error,details}%s,%s", code, details)
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: common.Ptr(callID),
-		Content:      content,
-		ActionError:  common.Ptr(details),
+// resultData converts rendered tool content into the data of the action result. JSON content,
+// such as structured content, is kept as JSON and anything else is passed through as text.
+func resultData(content string) any {
+	content = strings.TrimSpace(content)
+	switch {
+	case content == "":
+		return nil
+	case json.Valid([]byte(content)):
+		return json.RawMessage(content)
+	default:
+		return content
 	}
 }
+
+// actionErrorMessage formats a structured tool error payload consumed by the assistant loop.
+func actionErrorMessage(call assistant.ActionCall, code, details string) assistant.Message {
+	return assistant.NewActionErrorMessage(call, code, details, "")
+}
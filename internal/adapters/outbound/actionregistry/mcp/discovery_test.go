@@ -1,6 +1,7 @@
 package mcp
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
@@ -198,14 +199,37 @@ func TestActionErrorMessage(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			msg := actionErrorMessage(tt.callID, tt.code, tt.details)
+			msg := actionErrorMessage(assistant.ActionCall{ID: tt.callID}, tt.code, tt.details)
 			assert.Equal(t, assistant.ChatRole_Tool, msg.Role)
 			require.NotNil(t, msg.ActionCallID)
 			assert.Equal(t, tt.callID, *msg.ActionCallID)
-			assert.Contains(t, msg.Content, tt.code)
-			assert.Contains(t, msg.Content, tt.details)
+			result, ok := assistant.ParseActionResult(msg.Content)
+			require.True(t, ok)
+			assert.Equal(t, assistant.ActionResultStatus_Error, result.Status)
+			assert.Equal(t, &assistant.ActionResultError{Code: tt.code, Details: tt.details}, result.Error)
 			require.NotNil(t, msg.ActionError)
-			assert.Equal(t, tt.details, *msg.ActionError)
+			assert.Equal(t, msg.Content, *msg.ActionError)
+		})
+	}
+}
+
+func TestResultData(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content  string
+		expected any
+	}{
+		"empty":        {content: "  \n", expected: nil},
+		"json":         {content: ` {"items":[1,2]} `, expected: json.RawMessage(`{"items":[1,2]}`)},
+		"text":         {content: "plain result\n", expected: "plain result"},
+		"invalid-json": {content: `{"items":`, expected: `{"items":`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, resultData(tt.content))
 		})
 	}
 }
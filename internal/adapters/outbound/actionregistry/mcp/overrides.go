@@ -27,6 +27,7 @@ type assistantActionDefinitionOverride struct {
 	Input         assistantActionInputConfig    `yaml:"input"`
 	Approval      assistantActionApprovalConfig `yaml:"approval"`
 	Approvals     assistantActionApprovalConfig `yaml:"approvals"`
+	ReadOnly      bool                          `yaml:"read_only"`
 }
 
 // assistantActionInputConfig allows overriding MCP tool input schema with a simplified format.
@@ -175,6 +176,7 @@ func parseToolOverrides(content []byte) (toolOverrides, error) {
 				Type:   strings.TrimSpace(override.Input.Type),
 				Fields: fields,
 			},
+			ReadOnly: override.ReadOnly,
 		}
 
 		approvalCfg := override.Approval
@@ -20,6 +20,7 @@ func TestParseToolOverrideDefinitions(t *testing.T) {
 			content: `
 tools:
   - name: search
+    read_only: true
     description: Search docs
     status_message: Searching docs...
     input:
@@ -38,6 +39,7 @@ tools:
 				require.Contains(t, got, "search")
 				assert.Equal(t, "Search docs", got["search"].Description)
 				assert.Equal(t, "string", got["search"].Input.Fields["query"].Type)
+				assert.True(t, got["search"].ReadOnly)
 			},
 		},
 		"valid-yaml-with-approvals-override": {
@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -60,19 +59,19 @@ func (r *ActionRegistry) Execute(ctx context.Context, call assistant.ActionCall,
 
 	_, knownAction := r.actionsByName[call.Name]
 	if !knownAction {
-		return actionErrorMessage(call.ID, "unknown_action", fmt.Sprintf("action '%s' is not registered", call.Name))
+		return actionErrorMessage(call, "unknown_action", fmt.Sprintf("action '%s' is not registered", call.Name))
 	}
 
 	arguments, err := parseActionCallArguments(call.Input)
 	if err != nil {
-		return actionErrorMessage(call.ID, "invalid_arguments", err.Error())
+		return actionErrorMessage(call, "invalid_arguments", err.Error())
 	}
 	if formattedArguments, found := toolFormatters.FormatArguments(call.Name, arguments); found {
 		arguments = formattedArguments
 	}
 
 	if r.session == nil {
-		return actionErrorMessage(call.ID, "mcp_not_initialized", "mcp registry was not initialized with a live session")
+		return actionErrorMessage(call, "mcp_not_initialized", "mcp registry was not initialized with a live session")
 	}
 
 	callCtx, cancel := r.withTimeout(spanCtx)
@@ -83,7 +82,7 @@ func (r *ActionRegistry) Execute(ctx context.Context, call assistant.ActionCall,
 		Arguments: arguments,
 	})
 	if err != nil {
-		return actionErrorMessage(call.ID, "mcp_call_error", err.Error())
+		return actionErrorMessage(call, "mcp_call_error", err.Error())
 	}
 
 	content := renderCallToolResult(result)
@@ -91,29 +90,14 @@ func (r *ActionRegistry) Execute(ctx context.Context, call assistant.ActionCall,
 		return formatted
 	}
 
-	if result != nil && result.IsError && !strings.Contains(strings.ToLower(content), "error") {
-		content = "error: " + strings.TrimSpace(content)
-	}
-	if strings.TrimSpace(content) == "" {
-		content = "ok"
-	}
-
-	var actionError *string
 	if result != nil && result.IsError {
-		errText := strings.TrimSpace(content)
-		actionError = &errText
-	}
-
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: common.Ptr(call.ID),
-		Content:      content,
-		ActionError:  actionError,
+		return actionErrorMessage(call, "mcp_tool_error", strings.TrimSpace(content))
 	}
+	return assistant.NewActionResultMessage(call, resultData(content))
 }
 
 // GetDefinition returns one action definition by name.
-func (r *ActionRegistry) GetDefinition(actionName string) (assistant.ActionDefinition, bool) {
+func (r *ActionRegistry) GetDefinition(_ context.Context, actionName string) (assistant.ActionDefinition, bool) {
 	action, found := r.actionsByName[actionName]
 	if !found {
 		return assistant.ActionDefinition{}, false
@@ -144,6 +128,9 @@ func (r *ActionRegistry) StatusMessage(actionName string) string {
 	return action.StatusMessage()
 }
 
+// Prefetch is a no-op: MCP gateway tools are remote and may have side effects, so they are never run speculatively.
+func (r *ActionRegistry) Prefetch(context.Context, []string, []assistant.Message) {}
+
 // withTimeout applies request timeout defaults to MCP network calls.
 func (r *ActionRegistry) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
 	if r.cfg.RequestTimeout <= 0 {
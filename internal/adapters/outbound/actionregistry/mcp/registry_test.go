@@ -27,7 +27,7 @@ func TestActionRegistry_Execute(t *testing.T) {
 				require.NotNil(t, msg.ActionCallID)
 				assert.Equal(t, "call-1", *msg.ActionCallID)
 				assert.Equal(t, assistant.ChatRole_Tool, msg.Role)
-				assert.Equal(t, "done", msg.Content)
+				assert.Equal(t, `{"status":"success","data":"done"}`, msg.Content)
 			},
 			assertSession: func(t *testing.T, session *fakeSession) {
 				require.NotNil(t, session.lastCallParams)
@@ -44,14 +44,14 @@ func TestActionRegistry_Execute(t *testing.T) {
 				assert.NotNil(t, msg.ActionError)
 			},
 		},
-		"error-prefixes-content": {
+		"tool-error": {
 			session:    &fakeSession{listResults: []*mcp.ListToolsResult{{Tools: []*mcp.Tool{{Name: "fetch", Description: "Fetches content", InputSchema: map[string]any{"type": "object"}}}}}, callResult: &mcp.CallToolResult{IsError: true, Content: []mcp.Content{&mcp.TextContent{Text: "failed"}}}},
 			call:       assistant.ActionCall{ID: "call-3", Name: "fetch", Input: `{}`},
 			initialize: true,
 			assertMessage: func(t *testing.T, msg assistant.Message) {
-				assert.Equal(t, "error: failed", msg.Content)
+				assert.Equal(t, `{"status":"error","error":{"code":"mcp_tool_error","details":"failed"}}`, msg.Content)
 				assert.NotNil(t, msg.ActionError)
-				assert.Equal(t, "error: failed", *msg.ActionError)
+				assert.False(t, msg.IsActionCallSuccess())
 			},
 		},
 		"execute-code-normalizes-escaped-newlines": {
@@ -62,7 +62,7 @@ func TestActionRegistry_Execute(t *testing.T) {
 			call:       assistant.ActionCall{ID: "call-exec", Name: "execute_code", Input: `{"code":"result = 1\\nresult"}`},
 			initialize: true,
 			assertMessage: func(t *testing.T, msg assistant.Message) {
-				assert.Equal(t, "ok", msg.Content)
+				assert.Equal(t, `{"status":"success","data":"ok"}`, msg.Content)
 			},
 			assertSession: func(t *testing.T, session *fakeSession) {
 				require.NotNil(t, session.lastCallParams)
@@ -106,7 +106,7 @@ func TestActionRegistry_Methods(t *testing.T) {
 		"get-definition-found": {
 			registry: &ActionRegistry{actionsByName: map[string]assistant.Action{"search": mcpToolAction{definition: assistant.ActionDefinition{Name: "search"}}}},
 			assert: func(t *testing.T, registry *ActionRegistry) {
-				def, found := registry.GetDefinition("search")
+				def, found := registry.GetDefinition(t.Context(), "search")
 				require.True(t, found)
 				assert.Equal(t, "search", def.Name)
 			},
@@ -161,7 +161,7 @@ func TestActionRegistry_InitializeActions_AppliesToolOverrides(t *testing.T) {
 		"search": {
 			tool: &mcp.Tool{Name: "search", Description: "Original description", InputSchema: map[string]any{"type": "object", "properties": map[string]any{"query": map[string]any{"type": "string", "description": "Query"}}, "required": []any{"query"}}},
 			assert: func(t *testing.T, registry *ActionRegistry) {
-				def, found := registry.GetDefinition("search")
+				def, found := registry.GetDefinition(t.Context(), "search")
 				require.True(t, found)
 				assert.Equal(t, "Search the web with DuckDuckGo and return concise result snippets with source links.", def.Description)
 				assert.Equal(t, "Search query in natural language.", def.Input.Fields["query"].Description)
@@ -171,7 +171,7 @@ func TestActionRegistry_InitializeActions_AppliesToolOverrides(t *testing.T) {
 		"execute-code": {
 			tool: &mcp.Tool{Name: "execute_code", Description: "Original execute code description", InputSchema: map[string]any{"type": "object", "properties": map[string]any{"code": map[string]any{"type": "string", "description": "Original code description"}, "session_id": map[string]any{"type": "integer", "description": "Original session_id description"}}, "required": []any{"code"}}},
 			assert: func(t *testing.T, registry *ActionRegistry) {
-				def, found := registry.GetDefinition("execute_code")
+				def, found := registry.GetDefinition(t.Context(), "execute_code")
 				require.True(t, found)
 				assert.Equal(t, "Execute short self-contained Python code for deterministic calculations, grouping, validation, and data shaping. The tool input must be valid JSON, but the `code` field must contain raw Python source with real newlines, not escaped source like `\\\\n` or `\\\\t`. Prefer compact self-contained scripts and use single quotes inside Python when possible to reduce escaping.", def.Description)
 				assert.Equal(t, "🧮 Running code...", registry.StatusMessage("execute_code"))
@@ -27,6 +27,7 @@ func toolToActionDefinition(tool *mcp.Tool) assistant.ActionDefinition {
 		Name:        strings.TrimSpace(tool.Name),
 		Description: description,
 		Input:       schemaToInput(tool.InputSchema),
+		ReadOnly:    tool.Annotations != nil && tool.Annotations.ReadOnlyHint,
 	}
 }
 
@@ -218,6 +219,8 @@ func mergeAssistantActionDefinition(base, override assistant.ActionDefinition) a
 	if hasApprovalOverride(override.Approval) {
 		merged.Approval = override.Approval
 	}
+	// Overrides can only mark a tool read-only; a tool annotated as read-only by its server stays so.
+	merged.ReadOnly = base.ReadOnly || override.ReadOnly
 	return merged
 }
 
@@ -37,6 +37,12 @@ func TestToolToActionDefinition(t *testing.T) {
 				assert.Equal(t, "Search docs", got.Description)
 			},
 		},
+		"read-only-annotation": {
+			tool: &mcp.Tool{Name: "search", Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true}},
+			assert: func(t *testing.T, got assistant.ActionDefinition) {
+				assert.True(t, got.ReadOnly)
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -194,6 +200,18 @@ func TestMergeActionDefinition(t *testing.T) {
 				assert.Equal(t, []string{"todos[].title"}, got.Approval.PreviewFields)
 			},
 		},
+		"mark-read-only": {
+			override: assistant.ActionDefinition{ReadOnly: true},
+			assert: func(t *testing.T, got assistant.ActionDefinition) {
+				assert.True(t, got.ReadOnly)
+			},
+		},
+		"keep-write-by-default": {
+			override: assistant.ActionDefinition{Description: "override description"},
+			assert: func(t *testing.T, got assistant.ActionDefinition) {
+				assert.False(t, got.ReadOnly)
+			},
+		},
 	}
 
 	for name, tt := range tests {
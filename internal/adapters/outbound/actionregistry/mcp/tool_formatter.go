@@ -52,33 +52,16 @@ func (f executeCodeToolFormatter) FormatArguments(arguments map[string]any) map[
 
 // FormatResult converts the execute_code response payload into a tool message.
 func (f executeCodeToolFormatter) FormatResult(actionResult string, call assistant.ActionCall) assistant.Message {
-	var (
-		result struct {
-			Errors []string `json:"error"`
-			Result []string `json:"result"`
-		}
-		content string
-	)
+	var result struct {
+		Errors []string `json:"error"`
+		Result []string `json:"result"`
+	}
 
 	_ = json.Unmarshal([]byte(actionResult), &result) //nolint:errcheck
 	if len(result.Errors) > 0 {
-		content = `{"error":"code_error","details":"` + strings.Join(result.Errors, ", ") + `"}`
-		errMsg := strings.Join(result.Errors, ", ")
-		return assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			ActionCallID: &call.ID,
-			Content:      content,
-			ActionError:  &errMsg,
-		}
-	} else {
-		content = strings.Join(result.Result, "\n")
-	}
-
-	return assistant.Message{
-		Role:         assistant.ChatRole_Tool,
-		ActionCallID: &call.ID,
-		Content:      content,
+		return actionErrorMessage(call, "code_error", strings.Join(result.Errors, ", "))
 	}
+	return assistant.NewActionResultMessage(call, resultData(strings.Join(result.Result, "\n")))
 }
 
 // toolFormatterRegistry maps tool names to their corresponding formatters and
@@ -20,22 +20,21 @@ func TestExecuteCodeToolFormatter_FormatResult(t *testing.T) {
 			assert: func(t *testing.T, msg assistant.Message) {
 				assert.Equal(t, assistant.ChatRole_Tool, msg.Role)
 				assert.NotNil(t, msg.ActionCallID)
-				assert.Equal(t, "line1\nline2", msg.Content)
+				assert.Equal(t, `{"status":"success","data":"line1\nline2"}`, msg.Content)
 			},
 		},
 		"formats-errors": {
 			actionResult: `{"error":["boom"]}`,
 			assert: func(t *testing.T, msg assistant.Message) {
-				assert.Contains(t, msg.Content, "code_error")
-				assert.Contains(t, msg.Content, "boom")
+				assert.Equal(t, `{"status":"error","error":{"code":"code_error","details":"boom"}}`, msg.Content)
 				assert.NotNil(t, msg.ActionError)
-				assert.Equal(t, "boom", *msg.ActionError)
+				assert.Equal(t, msg.Content, *msg.ActionError)
 			},
 		},
-		"invalid-json-produces-empty-content": {
+		"invalid-json-produces-empty-result": {
 			actionResult: `not-json`,
 			assert: func(t *testing.T, msg assistant.Message) {
-				assert.Equal(t, "", msg.Content)
+				assert.Equal(t, `{"status":"success"}`, msg.Content)
 			},
 		},
 	}
@@ -107,7 +106,7 @@ func TestToolFormatterRegistry_FormatResult(t *testing.T) {
 			wantFound:    true,
 			assert: func(t *testing.T, msg assistant.Message) {
 				assert.NotNil(t, msg.ActionCallID)
-				assert.Equal(t, "ok", msg.Content)
+				assert.Equal(t, `{"status":"success","data":"ok"}`, msg.Content)
 			},
 		},
 		"returns-not-found-for-unknown-tool": {
@@ -0,0 +1,56 @@
+package chateventhub
+
+import (
+	"sync"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+)
+
+// listenerBufferSize is the number of events buffered per listener before events are dropped.
+const listenerBufferSize = 32
+
+// Hub fans chat message events out to in-process listeners.
+type Hub struct {
+	mu        sync.Mutex
+	listeners map[chan outbox.ChatMessageEvent]struct{}
+}
+
+// NewHub creates a new in-memory chat message event hub.
+func NewHub() *Hub {
+	return &Hub{
+		listeners: make(map[chan outbox.ChatMessageEvent]struct{}),
+	}
+}
+
+// Subscribe registers a listener and returns its event channel and a function that removes it.
+func (h *Hub) Subscribe() (<-chan outbox.ChatMessageEvent, func()) {
+	ch := make(chan outbox.ChatMessageEvent, listenerBufferSize)
+
+	h.mu.Lock()
+	h.listeners[ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			delete(h.listeners, ch)
+			close(ch)
+		})
+	}
+}
+
+// Broadcast delivers the event to every listener. Listeners with a full buffer miss the event.
+func (h *Hub) Broadcast(event outbox.ChatMessageEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.listeners {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
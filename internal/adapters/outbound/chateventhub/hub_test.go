@@ -0,0 +1,93 @@
+package chateventhub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.ChatMessageEvent{
+		Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+		ChatRole:       assistant.ChatRole_Assistant,
+		ChatMessageID:  uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		ConversationID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+		CreatedAt:      time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+		Background:     true,
+	}
+
+	tests := map[string]struct {
+		listeners   int
+		unsubscribe int
+	}{
+		"single-listener": {
+			listeners: 1,
+		},
+		"fans-out-to-every-listener": {
+			listeners: 3,
+		},
+		"skips-removed-listeners": {
+			listeners:   3,
+			unsubscribe: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hub := NewHub()
+			channels := make([]<-chan outbox.ChatMessageEvent, tt.listeners)
+			removers := make([]func(), tt.listeners)
+			for i := range tt.listeners {
+				channels[i], removers[i] = hub.Subscribe()
+			}
+			for i := range tt.unsubscribe {
+				removers[i]()
+			}
+
+			hub.Broadcast(event)
+
+			for i, ch := range channels {
+				got, open := <-ch
+				if i < tt.unsubscribe {
+					assert.False(t, open)
+					continue
+				}
+				assert.True(t, open)
+				assert.Equal(t, event, got)
+			}
+		})
+	}
+}
+
+func TestHub_Broadcast_DropsWhenListenerIsFull(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+	ch, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+
+	for range listenerBufferSize + 5 {
+		hub.Broadcast(outbox.ChatMessageEvent{Type: outbox.EventType_CHAT_MESSAGE_SENT, ChatMessageID: uuid.New()})
+	}
+
+	assert.Len(t, ch, listenerBufferSize)
+}
+
+func TestHub_Unsubscribe_IsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+	_, unsubscribe := hub.Subscribe()
+
+	unsubscribe()
+	assert.NotPanics(t, unsubscribe)
+	assert.Empty(t, hub.listeners)
+}
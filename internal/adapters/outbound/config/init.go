@@ -3,8 +3,15 @@ package config
 import (
 	"context"
 	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
 )
 
 // InitVaultProvider is used to initialize and register the VaultProvider
@@ -22,12 +29,57 @@ func (ivp InitVaultProvider) Initialize(ctx context.Context) (context.Context, e
 		return ctx, fmt.Errorf("failed to initialize Vault provider: %w", err)
 	}
 
-	config.SetGlobalProvider(
-		config.NewCompositeProvider(
-			config.EnvVarProvider{},
-			vaultProvider,
-		),
+	provider := config.NewCompositeProvider(
+		config.EnvVarProvider{},
+		vaultProvider,
 	)
+	config.SetGlobalProvider(provider)
+	depend.Register[config.Provider](provider)
 
 	return ctx, nil
 }
+
+// InitReloader creates and registers the configuration reloader. A SIGHUP reloads the configuration.
+// It must run after InitVaultProvider and before the subsystems registering for reloads.
+type InitReloader struct {
+	Logger   *log.Logger     `resolve:""`
+	Provider config.Provider `resolve:""`
+	stop     func()
+	wg       sync.WaitGroup
+}
+
+// Initialize registers the reloader in the dependency container and starts listening for SIGHUP.
+func (i *InitReloader) Initialize(ctx context.Context) (context.Context, error) {
+	reloader := NewReloader(i.Logger, i.Provider)
+
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	listenCtx, cancel := context.WithCancel(ctx)
+	i.stop = func() {
+		signal.Stop(hangups)
+		cancel()
+	}
+	i.wg.Go(func() {
+		for {
+			select {
+			case <-listenCtx.Done():
+				return
+			case <-hangups:
+				i.Logger.Println("InitReloader: SIGHUP received, reloading the configuration")
+				reloader.Reload(listenCtx) //nolint:errcheck
+			}
+		}
+	})
+
+	depend.Register[core.ConfigReloader](reloader)
+	return ctx, nil
+}
+
+// Close stops listening for SIGHUP.
+func (i *InitReloader) Close() {
+	if i == nil || i.stop == nil {
+		return
+	}
+	i.stop()
+	i.wg.Wait()
+}
@@ -1,8 +1,17 @@
 package config
 
 import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,3 +24,28 @@ func TestInitVaultProvider_Initialize(t *testing.T) {
 	assert.NotNil(t, ctx)
 	assert.Contains(t, err.Error(), "failed to initialize Vault provider")
 }
+
+func TestInitReloader_Initialize(t *testing.T) {
+	t.Cleanup(config.ResetGlobalProvider)
+
+	i := &InitReloader{Logger: log.New(io.Discard, "", 0), Provider: config.NewEnvVarProvider()}
+	_, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	defer i.Close()
+
+	reloader, err := depend.Resolve[core.ConfigReloader]()
+	require.NoError(t, err)
+	reloaded := make(chan struct{}, 1)
+	reloader.OnReload("test", func(context.Context) error {
+		reloaded <- struct{}{}
+		return nil
+	})
+
+	// A SIGHUP reloads the configuration.
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+	select {
+	case <-reloaded:
+	case <-time.After(time.Second):
+		t.Fatal("SIGHUP did not reload the configuration")
+	}
+}
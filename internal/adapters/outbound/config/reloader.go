@@ -0,0 +1,72 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/cleitonmarx/symbiont/config"
+)
+
+// subscriber is a subsystem notified of configuration reloads.
+type subscriber struct {
+	name   string
+	reload func(ctx context.Context) error
+}
+
+// Reloader implements core.ConfigReloader on top of the global configuration provider. A reload sets the
+// provider again, which drops the values cached by earlier lookups, so subsystems reading their config
+// tags afterwards see the current values of Vault.
+type Reloader struct {
+	logger   *log.Logger
+	provider config.Provider
+
+	mu          sync.Mutex
+	subscribers []subscriber
+}
+
+// NewReloader creates a Reloader re-reading the configuration from provider.
+func NewReloader(logger *log.Logger, provider config.Provider) *Reloader {
+	return &Reloader{
+		logger:   logger,
+		provider: provider,
+	}
+}
+
+// OnReload implements core.ConfigReloader.
+func (r *Reloader) OnReload(name string, reload func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, subscriber{name: name, reload: reload})
+}
+
+// Reload implements core.ConfigReloader. Concurrent reloads run one after the other.
+func (r *Reloader) Reload(ctx context.Context) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	config.SetGlobalProvider(r.provider)
+
+	var (
+		reloaded []string
+		errs     []error
+	)
+	for _, sub := range r.subscribers {
+		if err := sub.reload(ctx); err != nil {
+			r.logger.Printf("Reloader: %s rejected the new configuration: %v", sub.name, err)
+			metrics.RecordConfigReload(ctx, sub.name, false)
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+			continue
+		}
+		metrics.RecordConfigReload(ctx, sub.name, true)
+		reloaded = append(reloaded, sub.name)
+	}
+	r.logger.Printf("Reloader: configuration reloaded (%d applied, %d rejected)", len(reloaded), len(errs))
+	return reloaded, errors.Join(errs...)
+}
+
+var _ core.ConfigReloader = (*Reloader)(nil)
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader_Reload(t *testing.T) {
+	tests := map[string]struct {
+		rejections      map[string]error
+		expectedApplied []string
+		expectedErr     string
+	}{
+		"every-subsystem-applies": {
+			expectedApplied: []string{"llm_limiter", "shadow_evaluation"},
+		},
+		"rejection-keeps-the-others": {
+			rejections:      map[string]error{"llm_limiter": errors.New("invalid limits")},
+			expectedApplied: []string{"shadow_evaluation"},
+			expectedErr:     "llm_limiter: invalid limits",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("RELOADER_TEST_VALUE", "before")
+			provider := config.NewEnvVarProvider()
+			config.SetGlobalProvider(provider)
+			t.Cleanup(config.ResetGlobalProvider)
+
+			// The first lookup caches the value in the global provider.
+			before, err := config.Get[string](t.Context(), "RELOADER_TEST_VALUE")
+			require.NoError(t, err)
+			require.Equal(t, "before", before)
+
+			reloader := NewReloader(log.New(io.Discard, "", 0), provider)
+			seen := map[string]string{}
+			for _, subsystem := range []string{"llm_limiter", "shadow_evaluation"} {
+				reloader.OnReload(subsystem, func(ctx context.Context) error {
+					value, err := config.Get[string](ctx, "RELOADER_TEST_VALUE")
+					if err != nil {
+						return err
+					}
+					seen[subsystem] = value
+					return tt.rejections[subsystem]
+				})
+			}
+
+			t.Setenv("RELOADER_TEST_VALUE", "after")
+			applied, err := reloader.Reload(t.Context())
+
+			assert.Equal(t, tt.expectedApplied, applied)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			// Every subsystem reads the current value, not the cached one.
+			assert.Equal(t, map[string]string{"llm_limiter": "after", "shadow_evaluation": "after"}, seen)
+		})
+	}
+}
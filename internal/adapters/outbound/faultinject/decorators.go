@@ -0,0 +1,86 @@
+package faultinject
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+)
+
+// Assistant decorates an assistant.Assistant with the faults of the assistant target.
+type Assistant struct {
+	next     assistant.Assistant
+	injector *Injector
+}
+
+// NewAssistant creates an Assistant injecting faults before the turns run by next.
+func NewAssistant(next assistant.Assistant, injector *Injector) Assistant {
+	return Assistant{next: next, injector: injector}
+}
+
+// RunTurn streams one assistant turn unless a fault is injected.
+func (a Assistant) RunTurn(ctx context.Context, req assistant.TurnRequest, onEvent assistant.EventCallback) error {
+	if err := a.injector.Inject(ctx, Target_Assistant); err != nil {
+		return err
+	}
+	return a.next.RunTurn(ctx, req, onEvent)
+}
+
+// RunTurnSync executes one assistant turn unless a fault is injected.
+func (a Assistant) RunTurnSync(ctx context.Context, req assistant.TurnRequest) (assistant.TurnResponse, error) {
+	if err := a.injector.Inject(ctx, Target_Assistant); err != nil {
+		return assistant.TurnResponse{}, err
+	}
+	return a.next.RunTurnSync(ctx, req)
+}
+
+// UnitOfWork decorates a transaction.UnitOfWork with the faults of the repository target.
+type UnitOfWork struct {
+	next     transaction.UnitOfWork
+	injector *Injector
+}
+
+// NewUnitOfWork creates a UnitOfWork injecting faults before the transactions run by next.
+func NewUnitOfWork(next transaction.UnitOfWork, injector *Injector) UnitOfWork {
+	return UnitOfWork{next: next, injector: injector}
+}
+
+// Execute runs fn in a transaction unless a fault is injected.
+func (u UnitOfWork) Execute(ctx context.Context, fn func(ctx context.Context, scope transaction.Scope) error) error {
+	if err := u.injector.Inject(ctx, Target_Repository); err != nil {
+		return err
+	}
+	return u.next.Execute(ctx, fn)
+}
+
+// Publisher decorates an outbox.EventPublisher with the faults of the bus target.
+type Publisher struct {
+	next     outbox.EventPublisher
+	injector *Injector
+}
+
+// NewPublisher creates a Publisher injecting faults before the events published by next.
+func NewPublisher(next outbox.EventPublisher, injector *Injector) Publisher {
+	return Publisher{next: next, injector: injector}
+}
+
+// PublishEvent publishes the event unless a fault is injected.
+func (p Publisher) PublishEvent(ctx context.Context, event outbox.Event) error {
+	if err := p.injector.Inject(ctx, Target_Bus); err != nil {
+		return err
+	}
+	return p.next.PublishEvent(ctx, event)
+}
+
+// PublishEvents publishes the batch unless a fault is injected, in which case every event fails.
+func (p Publisher) PublishEvents(ctx context.Context, events []outbox.Event) []error {
+	if err := p.injector.Inject(ctx, Target_Bus); err != nil {
+		errs := make([]error, len(events))
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	return p.next.PublishEvents(ctx, events)
+}
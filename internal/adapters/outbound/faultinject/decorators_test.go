@@ -0,0 +1,69 @@
+package faultinject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssistant(t *testing.T) {
+	t.Parallel()
+
+	next := assistant.NewMockAssistant(t)
+	injector := NewInjector(true)
+	decorated := NewAssistant(next, injector)
+	req := assistant.TurnRequest{Model: "qwen3"}
+
+	next.EXPECT().RunTurn(mock.Anything, req, mock.Anything).Return(nil).Once()
+	next.EXPECT().RunTurnSync(mock.Anything, req).Return(assistant.TurnResponse{}, nil).Once()
+	require.NoError(t, decorated.RunTurn(t.Context(), req, nil))
+	_, err := decorated.RunTurnSync(t.Context(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, injector.Set(Target_Assistant, Fault{ErrorRate: 1}))
+	assert.ErrorIs(t, decorated.RunTurn(t.Context(), req, nil), ErrInjected)
+	_, err = decorated.RunTurnSync(t.Context(), req)
+	assert.ErrorIs(t, err, ErrInjected)
+}
+
+func TestUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	next := transaction.NewMockUnitOfWork(t)
+	injector := NewInjector(true)
+	decorated := NewUnitOfWork(next, injector)
+	fn := func(context.Context, transaction.Scope) error { return nil }
+
+	next.EXPECT().Execute(mock.Anything, mock.Anything).Return(nil).Once()
+	require.NoError(t, decorated.Execute(t.Context(), fn))
+
+	require.NoError(t, injector.Set(Target_Repository, Fault{ErrorRate: 1}))
+	assert.ErrorIs(t, decorated.Execute(t.Context(), fn), ErrInjected)
+}
+
+func TestPublisher(t *testing.T) {
+	t.Parallel()
+
+	next := outbox.NewMockEventPublisher(t)
+	injector := NewInjector(true)
+	decorated := NewPublisher(next, injector)
+	events := []outbox.Event{{EventType: outbox.EventType_TODO_CREATED}, {EventType: outbox.EventType_TODO_UPDATED}}
+
+	next.EXPECT().PublishEvent(mock.Anything, events[0]).Return(nil).Once()
+	next.EXPECT().PublishEvents(mock.Anything, events).Return([]error{nil, nil}).Once()
+	require.NoError(t, decorated.PublishEvent(t.Context(), events[0]))
+	assert.Equal(t, []error{nil, nil}, decorated.PublishEvents(t.Context(), events))
+
+	require.NoError(t, injector.Set(Target_Bus, Fault{ErrorRate: 1}))
+	assert.ErrorIs(t, decorated.PublishEvent(t.Context(), events[0]), ErrInjected)
+	errs := decorated.PublishEvents(t.Context(), events)
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[0], ErrInjected)
+	assert.ErrorIs(t, errs[1], ErrInjected)
+}
@@ -0,0 +1,72 @@
+package faultinject
+
+import (
+	"context"
+	"log"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitInjector registers the fault Injector. Fault injection is meant for test environments and stays
+// disabled unless FAULT_INJECTION_ENABLED is set.
+type InitInjector struct {
+	Logger  *log.Logger `resolve:""`
+	Enabled bool        `config:"FAULT_INJECTION_ENABLED" default:"false"`
+}
+
+// Initialize registers the injector in the dependency container.
+func (i InitInjector) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Enabled {
+		i.Logger.Println("InitInjector: fault injection is enabled, do not use this deployment in production")
+	}
+	depend.Register(NewInjector(i.Enabled))
+	return ctx, nil
+}
+
+// InitAssistant wraps the registered assistant.Assistant with fault injection when it is enabled.
+// It must run after the assistant client and its limiter are registered.
+type InitAssistant struct {
+	Assistant assistant.Assistant `resolve:""`
+	Injector  *Injector           `resolve:""`
+}
+
+// Initialize registers the fault injecting assistant in place of the assistant client.
+func (i InitAssistant) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Injector.Enabled() {
+		depend.Register[assistant.Assistant](NewAssistant(i.Assistant, i.Injector))
+	}
+	return ctx, nil
+}
+
+// InitUnitOfWork wraps the registered transaction.UnitOfWork with fault injection when it is enabled.
+// It must run right after the unit of work is registered, before the use cases resolve it.
+type InitUnitOfWork struct {
+	Uow      transaction.UnitOfWork `resolve:""`
+	Injector *Injector              `resolve:""`
+}
+
+// Initialize registers the fault injecting unit of work in place of the Postgres one.
+func (i InitUnitOfWork) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Injector.Enabled() {
+		depend.Register[transaction.UnitOfWork](NewUnitOfWork(i.Uow, i.Injector))
+	}
+	return ctx, nil
+}
+
+// InitPublisher wraps the registered outbox.EventPublisher with fault injection when it is enabled.
+// It must run right after the publisher is registered.
+type InitPublisher struct {
+	Publisher outbox.EventPublisher `resolve:""`
+	Injector  *Injector             `resolve:""`
+}
+
+// Initialize registers the fault injecting publisher in place of the Pub/Sub one.
+func (i InitPublisher) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Injector.Enabled() {
+		depend.Register[outbox.EventPublisher](NewPublisher(i.Publisher, i.Injector))
+	}
+	return ctx, nil
+}
@@ -0,0 +1,69 @@
+package faultinject
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitInjector_Initialize(t *testing.T) {
+	_, err := InitInjector{Logger: log.New(io.Discard, "", 0), Enabled: true}.Initialize(t.Context())
+	require.NoError(t, err)
+
+	injector, err := depend.Resolve[*Injector]()
+	require.NoError(t, err)
+	assert.True(t, injector.Enabled())
+}
+
+func TestInitDecorators_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		enabled  bool
+		decorate bool
+	}{
+		"enabled":  {enabled: true, decorate: true},
+		"disabled": {enabled: false, decorate: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			injector := NewInjector(tt.enabled)
+			nextAssistant := assistant.NewMockAssistant(t)
+			nextUow := transaction.NewMockUnitOfWork(t)
+			nextPublisher := outbox.NewMockEventPublisher(t)
+			depend.Register[assistant.Assistant](nextAssistant)
+			depend.Register[transaction.UnitOfWork](nextUow)
+			depend.Register[outbox.EventPublisher](nextPublisher)
+
+			_, err := InitAssistant{Assistant: nextAssistant, Injector: injector}.Initialize(t.Context())
+			require.NoError(t, err)
+			_, err = InitUnitOfWork{Uow: nextUow, Injector: injector}.Initialize(t.Context())
+			require.NoError(t, err)
+			_, err = InitPublisher{Publisher: nextPublisher, Injector: injector}.Initialize(t.Context())
+			require.NoError(t, err)
+
+			registeredAssistant, err := depend.Resolve[assistant.Assistant]()
+			require.NoError(t, err)
+			registeredUow, err := depend.Resolve[transaction.UnitOfWork]()
+			require.NoError(t, err)
+			registeredPublisher, err := depend.Resolve[outbox.EventPublisher]()
+			require.NoError(t, err)
+
+			if tt.decorate {
+				assert.IsType(t, Assistant{}, registeredAssistant)
+				assert.IsType(t, UnitOfWork{}, registeredUow)
+				assert.IsType(t, Publisher{}, registeredPublisher)
+				return
+			}
+			assert.Same(t, nextAssistant, registeredAssistant)
+			assert.Same(t, nextUow, registeredUow)
+			assert.Same(t, nextPublisher, registeredPublisher)
+		})
+	}
+}
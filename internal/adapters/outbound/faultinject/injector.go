@@ -0,0 +1,179 @@
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// ErrInjected is wrapped by every error produced by the injector.
+var ErrInjected = errors.New("injected fault")
+
+// Target identifies the dependency a fault is injected into.
+type Target string
+
+const (
+	// Target_Assistant injects faults into assistant turns.
+	Target_Assistant Target = "assistant"
+	// Target_Repository injects faults into unit of work transactions, before any repository call.
+	Target_Repository Target = "repository"
+	// Target_Bus injects faults into outbox event publishing.
+	Target_Bus Target = "bus"
+)
+
+// Validate checks the target is known.
+func (t Target) Validate() error {
+	switch t {
+	case Target_Assistant, Target_Repository, Target_Bus:
+		return nil
+	default:
+		return fmt.Errorf("unknown fault target %q, expected %q, %q or %q", t, Target_Assistant, Target_Repository, Target_Bus)
+	}
+}
+
+// Fault describes the failure injected into the calls of a target.
+type Fault struct {
+	// Latency delays every affected call.
+	Latency time.Duration
+	// ErrorRate is the probability, from 0 to 1, that an affected call fails.
+	ErrorRate float64
+	// Message is the text of the injected error.
+	Message string
+	// Remaining is the number of calls still affected. Zero affects every call until the fault is cleared.
+	Remaining int
+}
+
+// Validate checks the fault injects something and its values are in range.
+func (f Fault) Validate() error {
+	if f.Latency < 0 || f.Remaining < 0 {
+		return errors.New("fault latency and remaining calls must not be negative")
+	}
+	if f.ErrorRate < 0 || f.ErrorRate > 1 {
+		return errors.New("fault error rate must be between 0 and 1")
+	}
+	if f.Latency == 0 && f.ErrorRate == 0 {
+		return errors.New("fault must set a latency or an error rate")
+	}
+	return nil
+}
+
+// Injector holds the faults toggled through the admin API and applies them to the decorated dependencies.
+// A disabled injector never injects anything and rejects new faults.
+type Injector struct {
+	enabled bool
+	rand    func() float64
+
+	mu     sync.Mutex
+	faults map[Target]Fault
+}
+
+// NewInjector creates an Injector.
+func NewInjector(enabled bool) *Injector {
+	return &Injector{
+		enabled: enabled,
+		rand:    rand.Float64,
+		faults:  make(map[Target]Fault),
+	}
+}
+
+// Enabled reports whether faults can be injected.
+func (i *Injector) Enabled() bool {
+	return i != nil && i.enabled
+}
+
+// Set replaces the fault of target.
+func (i *Injector) Set(target Target, fault Fault) error {
+	if !i.Enabled() {
+		return errors.New("fault injection is disabled")
+	}
+	if err := target.Validate(); err != nil {
+		return err
+	}
+	if err := fault.Validate(); err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults[target] = fault
+	return nil
+}
+
+// Clear removes the fault of target.
+func (i *Injector) Clear(target Target) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.faults, target)
+}
+
+// ClearAll removes every fault.
+func (i *Injector) ClearAll() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults = make(map[Target]Fault)
+}
+
+// Faults returns the active faults by target.
+func (i *Injector) Faults() map[Target]Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	faults := make(map[Target]Fault, len(i.faults))
+	for target, fault := range i.faults {
+		faults[target] = fault
+	}
+	return faults
+}
+
+// Inject applies the fault of target to one call: it waits for the fault latency and then returns an
+// error wrapping ErrInjected with the fault error rate. It returns nil when target has no fault.
+func (i *Injector) Inject(ctx context.Context, target Target) error {
+	if !i.Enabled() {
+		return nil
+	}
+	fault, ok := i.take(target)
+	if !ok {
+		return nil
+	}
+
+	if fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if fault.ErrorRate > 0 && i.rand() < fault.ErrorRate {
+		if fault.Message != "" {
+			return fmt.Errorf("%w: %s: %s", ErrInjected, target, fault.Message)
+		}
+		return fmt.Errorf("%w: %s", ErrInjected, target)
+	}
+	return nil
+}
+
+// take returns the fault of target, counting the call against its remaining calls.
+func (i *Injector) take(target Target) (Fault, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	fault, ok := i.faults[target]
+	if !ok {
+		return Fault{}, false
+	}
+	if fault.Remaining > 0 {
+		if fault.Remaining == 1 {
+			delete(i.faults, target)
+		} else {
+			left := fault
+			left.Remaining--
+			i.faults[target] = left
+		}
+	}
+	return fault, true
+}
@@ -0,0 +1,127 @@
+package faultinject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjector_Inject(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		enabled bool
+		target  Target
+		fault   *Fault
+		roll    float64
+		wantErr bool
+	}{
+		"error": {
+			enabled: true,
+			target:  Target_Assistant,
+			fault:   &Fault{ErrorRate: 1, Message: "model host down"},
+			wantErr: true,
+		},
+		"error-rate-not-hit": {
+			enabled: true,
+			target:  Target_Assistant,
+			fault:   &Fault{ErrorRate: 0.3},
+			roll:    0.5,
+		},
+		"other-target": {
+			enabled: true,
+			target:  Target_Bus,
+			fault:   &Fault{ErrorRate: 1},
+		},
+		"no-fault": {
+			enabled: true,
+			target:  Target_Assistant,
+		},
+		"disabled": {
+			target: Target_Assistant,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			injector := NewInjector(tt.enabled)
+			injector.rand = func() float64 { return tt.roll }
+			if tt.fault != nil {
+				require.NoError(t, injector.Set(Target_Assistant, *tt.fault))
+			}
+
+			err := injector.Inject(t.Context(), tt.target)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInjected)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInjector_InjectLatency(t *testing.T) {
+	t.Parallel()
+
+	injector := NewInjector(true)
+	require.NoError(t, injector.Set(Target_Repository, Fault{Latency: 20 * time.Millisecond}))
+
+	start := time.Now()
+	require.NoError(t, injector.Inject(t.Context(), Target_Repository))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	require.NoError(t, injector.Set(Target_Repository, Fault{Latency: time.Minute}))
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	assert.ErrorIs(t, injector.Inject(ctx, Target_Repository), context.Canceled)
+}
+
+func TestInjector_RemainingCalls(t *testing.T) {
+	t.Parallel()
+
+	injector := NewInjector(true)
+	require.NoError(t, injector.Set(Target_Bus, Fault{ErrorRate: 1, Remaining: 2}))
+
+	assert.ErrorIs(t, injector.Inject(t.Context(), Target_Bus), ErrInjected)
+	assert.Equal(t, 1, injector.Faults()[Target_Bus].Remaining)
+	assert.ErrorIs(t, injector.Inject(t.Context(), Target_Bus), ErrInjected)
+	assert.NoError(t, injector.Inject(t.Context(), Target_Bus))
+	assert.Empty(t, injector.Faults())
+}
+
+func TestInjector_Set(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		enabled bool
+		target  Target
+		fault   Fault
+		wantErr bool
+	}{
+		"valid":            {enabled: true, target: Target_Assistant, fault: Fault{ErrorRate: 0.5}},
+		"latency-only":     {enabled: true, target: Target_Repository, fault: Fault{Latency: time.Second}},
+		"disabled":         {target: Target_Assistant, fault: Fault{ErrorRate: 1}, wantErr: true},
+		"unknown-target":   {enabled: true, target: "cache", fault: Fault{ErrorRate: 1}, wantErr: true},
+		"empty-fault":      {enabled: true, target: Target_Bus, wantErr: true},
+		"rate-over-one":    {enabled: true, target: Target_Bus, fault: Fault{ErrorRate: 1.5}, wantErr: true},
+		"negative-latency": {enabled: true, target: Target_Bus, fault: Fault{Latency: -time.Second}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewInjector(tt.enabled).Set(tt.target, tt.fault)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
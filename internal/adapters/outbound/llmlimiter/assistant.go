@@ -0,0 +1,209 @@
+package llmlimiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+)
+
+// ErrQueueTimeout is returned when a turn waited longer than the queue timeout for a free slot.
+var ErrQueueTimeout = errors.New("llm call queue timeout")
+
+// Limits configures the concurrency allowed in front of the model host. A zero limit means unlimited.
+type Limits struct {
+	// PerModel caps concurrent turns on one model, counting streamed chat turns and background turns.
+	PerModel int
+	// PerTenant caps concurrent turns of one tenant across all models.
+	PerTenant int
+	// ReservedBackground keeps slots of every model for background turns (summaries, titles, compaction),
+	// so streamed chat turns can use at most PerModel minus ReservedBackground slots.
+	ReservedBackground int
+	// QueueTimeout bounds how long a turn waits for its slots. Zero waits until the context is done.
+	QueueTimeout time.Duration
+	// BackgroundPauseThreshold holds background turns of a model back while at least this many
+	// streamed chat turns run on it.
+	BackgroundPauseThreshold int
+	// BackgroundMaxWait promotes a background turn to the chat priority once it waited this long,
+	// so summaries and titles are never starved. Zero never promotes.
+	BackgroundMaxWait time.Duration
+}
+
+// Enabled reports whether any limit is configured.
+func (l Limits) Enabled() bool {
+	return l.PerModel > 0 || l.PerTenant > 0 || l.BackgroundPauseThreshold > 0
+}
+
+// Validate checks the limits are consistent with each other.
+func (l Limits) Validate() error {
+	if l.PerModel < 0 || l.PerTenant < 0 || l.ReservedBackground < 0 || l.QueueTimeout < 0 ||
+		l.BackgroundPauseThreshold < 0 || l.BackgroundMaxWait < 0 {
+		return errors.New("llm concurrency limits must not be negative")
+	}
+	if l.ReservedBackground > 0 && l.ReservedBackground >= l.PerModel {
+		return fmt.Errorf("reserved background slots (%d) must be lower than the per-model limit (%d)", l.ReservedBackground, l.PerModel)
+	}
+	return nil
+}
+
+// semaphore is a counting semaphore backed by a buffered channel.
+type semaphore chan struct{}
+
+// slotsKey marks a context whose turn already holds its slots.
+type slotsKey struct{}
+
+// Assistant decorates an assistant.Assistant with per-model and per-tenant concurrency limits.
+// Turns over a limit queue until a slot frees up, the queue timeout elapses or the context is done.
+// Streamed chat turns run in the interactive lane and hold their slots until the stream ends; turns
+// started by their actions reuse those slots. Other turns run in the background lane, served after
+// queued chat turns.
+type Assistant struct {
+	next   assistant.Assistant
+	limits Limits
+
+	mu      sync.Mutex
+	models  map[string]*modelScheduler
+	tenants map[tenant.ID]semaphore
+}
+
+// NewAssistant creates an Assistant limiting the turns run by next.
+func NewAssistant(next assistant.Assistant, limits Limits) *Assistant {
+	return &Assistant{
+		next:    next,
+		limits:  limits,
+		models:  make(map[string]*modelScheduler),
+		tenants: make(map[tenant.ID]semaphore),
+	}
+}
+
+// RunTurn streams one assistant turn in the interactive lane once its slots are acquired.
+func (a *Assistant) RunTurn(ctx context.Context, req assistant.TurnRequest, onEvent assistant.EventCallback) error {
+	ctx, release, err := a.acquire(ctx, req.Model, laneInteractive)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return a.next.RunTurn(ctx, req, onEvent)
+}
+
+// RunTurnSync executes one assistant turn in the background lane once its slots are acquired.
+func (a *Assistant) RunTurnSync(ctx context.Context, req assistant.TurnRequest) (assistant.TurnResponse, error) {
+	ctx, release, err := a.acquire(ctx, req.Model, laneBackground)
+	if err != nil {
+		return assistant.TurnResponse{}, err
+	}
+	defer release()
+	return a.next.RunTurnSync(ctx, req)
+}
+
+// acquire takes the tenant slot and then the model slot of the turn, and returns the context to run it
+// with and a function releasing the slots. Turns whose context already holds slots take none.
+func (a *Assistant) acquire(ctx context.Context, model string, l lane) (context.Context, func(), error) {
+	if ctx.Value(slotsKey{}) != nil {
+		return ctx, func() {}, nil
+	}
+	tenantSem, scheduler, limits := a.limitsFor(tenant.IDFromContext(ctx), model)
+
+	waitCtx, cancel := ctx, context.CancelFunc(func() {})
+	if limits.QueueTimeout > 0 {
+		waitCtx, cancel = context.WithTimeout(ctx, limits.QueueTimeout)
+	}
+	defer cancel()
+
+	start := time.Now()
+	fail := func() (context.Context, func(), error) {
+		if ctx.Err() != nil {
+			metrics.RecordLLMQueueWait(ctx, model, string(l), "canceled", time.Since(start))
+			return ctx, nil, ctx.Err()
+		}
+		metrics.RecordLLMQueueWait(ctx, model, string(l), "timeout", time.Since(start))
+		return ctx, nil, fmt.Errorf("%w: model %q is busy after waiting %s", ErrQueueTimeout, model, limits.QueueTimeout)
+	}
+
+	if tenantSem != nil {
+		select {
+		case tenantSem <- struct{}{}:
+		case <-waitCtx.Done():
+			return fail()
+		}
+	}
+	releaseTenant := func() {
+		if tenantSem != nil {
+			<-tenantSem
+		}
+	}
+
+	outcome := "acquired"
+	promoted, err := scheduler.acquire(waitCtx, l)
+	if err != nil {
+		releaseTenant()
+		return fail()
+	}
+	if promoted {
+		outcome = "promoted"
+	}
+	metrics.RecordLLMQueueWait(ctx, model, string(l), outcome, time.Since(start))
+
+	metrics.RecordLLMInFlight(ctx, model, string(l), 1)
+	return context.WithValue(ctx, slotsKey{}, struct{}{}), func() {
+		scheduler.release(l)
+		releaseTenant()
+		metrics.RecordLLMInFlight(context.WithoutCancel(ctx), model, string(l), -1)
+	}, nil
+}
+
+// SetLimits applies new limits at runtime. Model schedulers adopt the new capacities at once, granting queued
+// turns the slots they gained. Tenant limits start afresh: running turns keep their slots but are no longer
+// counted, so a tenant may briefly exceed a lowered limit.
+func (a *Assistant) SetLimits(limits Limits) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.limits = limits
+	a.tenants = make(map[tenant.ID]semaphore)
+	for _, scheduler := range a.models {
+		scheduler.setLimits(a.laneLimits())
+	}
+}
+
+// laneLimits returns the scheduler limits of every model. The caller must hold mu.
+func (a *Assistant) laneLimits() laneLimits {
+	interactiveCapacity := 0
+	if a.limits.ReservedBackground > 0 {
+		interactiveCapacity = a.limits.PerModel - a.limits.ReservedBackground
+	}
+	return laneLimits{
+		capacity:            a.limits.PerModel,
+		interactiveCapacity: interactiveCapacity,
+		pauseThreshold:      a.limits.BackgroundPauseThreshold,
+		maxBackgroundWait:   a.limits.BackgroundMaxWait,
+	}
+}
+
+// limitsFor returns the tenant semaphore, nil when tenants are unlimited, and the model scheduler
+// of a turn, creating them on first use, along with the limits in effect.
+func (a *Assistant) limitsFor(tenantID tenant.ID, model string) (semaphore, *modelScheduler, Limits) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var tenantSem semaphore
+	if a.limits.PerTenant > 0 {
+		tenantSem = a.tenants[tenantID]
+		if tenantSem == nil {
+			tenantSem = make(semaphore, a.limits.PerTenant)
+			a.tenants[tenantID] = tenantSem
+		}
+	}
+
+	scheduler := a.models[model]
+	if scheduler == nil {
+		scheduler = newModelScheduler(a.laneLimits())
+		a.models[model] = scheduler
+	}
+	return tenantSem, scheduler, a.limits
+}
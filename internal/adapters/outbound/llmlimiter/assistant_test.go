@@ -0,0 +1,274 @@
+package llmlimiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingAssistant expects turns to block until release is closed, signalling each start on started.
+func blockingAssistant(t *testing.T) (*assistant.MockAssistant, chan struct{}, chan struct{}) {
+	t.Helper()
+	next := assistant.NewMockAssistant(t)
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	next.EXPECT().RunTurnSync(mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, assistant.TurnRequest) (assistant.TurnResponse, error) {
+			started <- struct{}{}
+			<-release
+			return assistant.TurnResponse{}, nil
+		},
+	).Maybe()
+	next.EXPECT().RunTurn(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, assistant.TurnRequest, assistant.EventCallback) error {
+			started <- struct{}{}
+			<-release
+			return nil
+		},
+	).Maybe()
+	return next, started, release
+}
+
+func waitStarted(t *testing.T, started chan struct{}) {
+	t.Helper()
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("turn did not start")
+	}
+}
+
+func TestAssistant_Limits(t *testing.T) {
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+
+	tests := map[string]struct {
+		limits          Limits
+		holdCtx         context.Context
+		holdModel       string
+		holdInteractive bool
+		ctx             context.Context
+		model           string
+		interactive     bool
+		wantErr         bool
+	}{
+		"model-full": {
+			limits:    Limits{PerModel: 1, QueueTimeout: 20 * time.Millisecond},
+			holdCtx:   acme,
+			holdModel: "qwen3",
+			ctx:       globex,
+			model:     "qwen3",
+			wantErr:   true,
+		},
+		"other-model-free": {
+			limits:    Limits{PerModel: 1, QueueTimeout: 20 * time.Millisecond},
+			holdCtx:   acme,
+			holdModel: "qwen3",
+			ctx:       acme,
+			model:     "llama3",
+		},
+		"tenant-full": {
+			limits:    Limits{PerTenant: 1, QueueTimeout: 20 * time.Millisecond},
+			holdCtx:   acme,
+			holdModel: "qwen3",
+			ctx:       acme,
+			model:     "llama3",
+			wantErr:   true,
+		},
+		"other-tenant-free": {
+			limits:    Limits{PerTenant: 1, QueueTimeout: 20 * time.Millisecond},
+			holdCtx:   acme,
+			holdModel: "qwen3",
+			ctx:       globex,
+			model:     "qwen3",
+		},
+		"interactive-slots-full": {
+			limits:          Limits{PerModel: 2, ReservedBackground: 1, QueueTimeout: 20 * time.Millisecond},
+			holdCtx:         acme,
+			holdModel:       "qwen3",
+			holdInteractive: true,
+			ctx:             globex,
+			model:           "qwen3",
+			interactive:     true,
+			wantErr:         true,
+		},
+		"background-uses-reserved-slot": {
+			limits:          Limits{PerModel: 2, ReservedBackground: 1, QueueTimeout: 20 * time.Millisecond},
+			holdCtx:         acme,
+			holdModel:       "qwen3",
+			holdInteractive: true,
+			ctx:             globex,
+			model:           "qwen3",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next, started, release := blockingAssistant(t)
+			limiter := NewAssistant(next, tt.limits)
+
+			run := func(ctx context.Context, model string, interactive bool) error {
+				req := assistant.TurnRequest{Model: model}
+				if interactive {
+					return limiter.RunTurn(ctx, req, nil)
+				}
+				_, err := limiter.RunTurnSync(ctx, req)
+				return err
+			}
+
+			held := make(chan error, 1)
+			go func() { held <- run(tt.holdCtx, tt.holdModel, tt.holdInteractive) }()
+			waitStarted(t, started)
+
+			result := make(chan error, 1)
+			go func() { result <- run(tt.ctx, tt.model, tt.interactive) }()
+
+			if tt.wantErr {
+				err := <-result
+				assert.ErrorIs(t, err, ErrQueueTimeout)
+			} else {
+				waitStarted(t, started)
+			}
+			close(release)
+			require.NoError(t, <-held)
+			if !tt.wantErr {
+				require.NoError(t, <-result)
+			}
+		})
+	}
+}
+
+func TestAssistant_QueuesUntilSlotFrees(t *testing.T) {
+	next, started, release := blockingAssistant(t)
+	limiter := NewAssistant(next, Limits{PerModel: 1, QueueTimeout: time.Second})
+	req := assistant.TurnRequest{Model: "qwen3"}
+
+	first := make(chan error, 1)
+	go func() {
+		_, err := limiter.RunTurnSync(t.Context(), req)
+		first <- err
+	}()
+	waitStarted(t, started)
+
+	second := make(chan error, 1)
+	go func() {
+		_, err := limiter.RunTurnSync(t.Context(), req)
+		second <- err
+	}()
+
+	select {
+	case <-started:
+		t.Fatal("second turn started before a slot was free")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-first)
+	waitStarted(t, started)
+	require.NoError(t, <-second)
+}
+
+func TestAssistant_SetLimits(t *testing.T) {
+	next, started, release := blockingAssistant(t)
+	limiter := NewAssistant(next, Limits{PerModel: 1, QueueTimeout: time.Second})
+	req := assistant.TurnRequest{Model: "qwen3"}
+
+	results := make(chan error, 3)
+	go func() {
+		_, err := limiter.RunTurnSync(t.Context(), req)
+		results <- err
+	}()
+	waitStarted(t, started)
+
+	go func() {
+		_, err := limiter.RunTurnSync(t.Context(), req)
+		results <- err
+	}()
+	select {
+	case <-started:
+		t.Fatal("second turn started before the limit was raised")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// Raising the limit grants the queued turn at once.
+	limiter.SetLimits(Limits{PerModel: 2, QueueTimeout: 10 * time.Millisecond})
+	waitStarted(t, started)
+
+	// Turns over the new limit time out with the new queue timeout.
+	_, err := limiter.RunTurnSync(t.Context(), req)
+	assert.ErrorIs(t, err, ErrQueueTimeout)
+
+	close(release)
+	require.NoError(t, <-results)
+	require.NoError(t, <-results)
+}
+
+func TestAssistant_ContextCanceledWhileQueued(t *testing.T) {
+	next, started, release := blockingAssistant(t)
+	limiter := NewAssistant(next, Limits{PerModel: 1})
+	req := assistant.TurnRequest{Model: "qwen3"}
+
+	held := make(chan error, 1)
+	go func() {
+		_, err := limiter.RunTurnSync(t.Context(), req)
+		held <- err
+	}()
+	waitStarted(t, started)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	result := make(chan error, 1)
+	go func() { result <- limiter.RunTurn(ctx, req, nil) }()
+	cancel()
+
+	assert.ErrorIs(t, <-result, context.Canceled)
+	close(release)
+	require.NoError(t, <-held)
+}
+
+func TestLimits_Validate(t *testing.T) {
+	tests := map[string]struct {
+		limits  Limits
+		wantErr bool
+	}{
+		"valid":              {limits: Limits{PerModel: 4, PerTenant: 2, ReservedBackground: 1, QueueTimeout: time.Second}},
+		"no-reserved":        {limits: Limits{PerModel: 1}},
+		"negative":           {limits: Limits{PerModel: -1}, wantErr: true},
+		"negative-max-wait":  {limits: Limits{BackgroundPauseThreshold: 2, BackgroundMaxWait: -time.Second}, wantErr: true},
+		"reserved-too-large": {limits: Limits{PerModel: 2, ReservedBackground: 2}, wantErr: true},
+		"reserved-no-model":  {limits: Limits{PerTenant: 2, ReservedBackground: 1}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.limits.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAssistant_NestedTurnReusesSlots(t *testing.T) {
+	next := assistant.NewMockAssistant(t)
+	limiter := NewAssistant(next, Limits{PerModel: 1, PerTenant: 1, QueueTimeout: 50 * time.Millisecond})
+	req := assistant.TurnRequest{Model: "qwen3"}
+
+	next.EXPECT().RunTurn(mock.Anything, req, mock.Anything).RunAndReturn(
+		func(ctx context.Context, _ assistant.TurnRequest, _ assistant.EventCallback) error {
+			// An action started by the streamed turn runs its own turn on the same slots.
+			_, err := limiter.RunTurnSync(ctx, req)
+			return err
+		},
+	).Once()
+	next.EXPECT().RunTurnSync(mock.Anything, req).Return(assistant.TurnResponse{}, nil).Once()
+
+	require.NoError(t, limiter.RunTurn(t.Context(), req, nil))
+}
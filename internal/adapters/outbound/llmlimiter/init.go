@@ -0,0 +1,69 @@
+package llmlimiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitAssistant wraps the registered assistant.Assistant with the concurrency limiter.
+// It must run after the assistant client and the configuration reloader are registered.
+type InitAssistant struct {
+	Assistant          assistant.Assistant `resolve:""`
+	Reloader           core.ConfigReloader `resolve:""`
+	PerModel           int                 `config:"LLM_MAX_CONCURRENCY_PER_MODEL" default:"4"`
+	PerTenant          int                 `config:"LLM_MAX_CONCURRENCY_PER_TENANT" default:"0"`
+	ReservedBackground int                 `config:"LLM_RESERVED_BACKGROUND_SLOTS" default:"1"`
+	QueueTimeout       time.Duration       `config:"LLM_QUEUE_TIMEOUT" default:"30s"`
+	PauseThreshold     int                 `config:"LLM_BACKGROUND_PAUSE_THRESHOLD" default:"2"`
+	BackgroundMaxWait  time.Duration       `config:"LLM_BACKGROUND_MAX_WAIT" default:"10s"`
+}
+
+// Initialize registers the limiting assistant in place of the assistant client. Zero limits and a zero
+// pause threshold disable the limiter. The limits of an enabled limiter follow configuration reloads;
+// enabling a disabled one takes a restart.
+func (i *InitAssistant) Initialize(ctx context.Context) (context.Context, error) {
+	limits := i.limits()
+	if !limits.Enabled() {
+		return ctx, nil
+	}
+	if err := limits.Validate(); err != nil {
+		return ctx, fmt.Errorf("invalid llm concurrency limits: %w", err)
+	}
+
+	limiter := NewAssistant(i.Assistant, limits)
+	if i.Reloader != nil {
+		i.Reloader.OnReload("llm_limiter", func(ctx context.Context) error {
+			reloaded := *i
+			if err := config.LoadStruct(ctx, &reloaded); err != nil {
+				return err
+			}
+			limits := reloaded.limits()
+			if err := limits.Validate(); err != nil {
+				return fmt.Errorf("invalid llm concurrency limits: %w", err)
+			}
+			limiter.SetLimits(limits)
+			return nil
+		})
+	}
+
+	depend.Register[assistant.Assistant](limiter)
+	return ctx, nil
+}
+
+// limits returns the configured limits.
+func (i *InitAssistant) limits() Limits {
+	return Limits{
+		PerModel:                 i.PerModel,
+		PerTenant:                i.PerTenant,
+		ReservedBackground:       i.ReservedBackground,
+		QueueTimeout:             i.QueueTimeout,
+		BackgroundPauseThreshold: i.PauseThreshold,
+		BackgroundMaxWait:        i.BackgroundMaxWait,
+	}
+}
@@ -0,0 +1,117 @@
+package llmlimiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitAssistant_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		init        *InitAssistant
+		wantLimiter bool
+		wantErr     bool
+	}{
+		"enabled": {
+			init:        &InitAssistant{PerModel: 4, ReservedBackground: 1, QueueTimeout: 30 * time.Second},
+			wantLimiter: true,
+		},
+		"disabled": {
+			init: &InitAssistant{},
+		},
+		"invalid": {
+			init:    &InitAssistant{PerModel: 1, ReservedBackground: 1},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := assistant.NewMockAssistant(t)
+			depend.Register[assistant.Assistant](next)
+			tt.init.Assistant = next
+
+			_, err := tt.init.Initialize(t.Context())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			registered, err := depend.Resolve[assistant.Assistant]()
+			require.NoError(t, err)
+			if tt.wantLimiter {
+				assert.IsType(t, &Assistant{}, registered)
+				return
+			}
+			assert.Same(t, next, registered)
+		})
+	}
+}
+
+func TestInitAssistant_Initialize_Reload(t *testing.T) {
+	tests := map[string]struct {
+		env            map[string]string
+		expectedLimits Limits
+		wantErr        bool
+	}{
+		"applies-new-limits": {
+			env: map[string]string{"LLM_MAX_CONCURRENCY_PER_MODEL": "8", "LLM_QUEUE_TIMEOUT": "5s"},
+			expectedLimits: Limits{
+				PerModel:                 8,
+				ReservedBackground:       1,
+				QueueTimeout:             5 * time.Second,
+				BackgroundPauseThreshold: 2,
+				BackgroundMaxWait:        10 * time.Second,
+			},
+		},
+		"rejects-invalid-limits": {
+			env:     map[string]string{"LLM_MAX_CONCURRENCY_PER_MODEL": "1"},
+			wantErr: true,
+			expectedLimits: Limits{
+				PerModel:           4,
+				ReservedBackground: 1,
+				QueueTimeout:       30 * time.Second,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var reload func(ctx context.Context) error
+			reloader := core.NewMockConfigReloader(t)
+			reloader.EXPECT().OnReload("llm_limiter", mock.Anything).Run(func(_ string, fn func(ctx context.Context) error) {
+				reload = fn
+			}).Once()
+
+			next := assistant.NewMockAssistant(t)
+			i := &InitAssistant{Assistant: next, Reloader: reloader, PerModel: 4, ReservedBackground: 1, QueueTimeout: 30 * time.Second}
+			_, err := i.Initialize(t.Context())
+			require.NoError(t, err)
+			limiter, err := depend.Resolve[assistant.Assistant]()
+			require.NoError(t, err)
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			config.ResetGlobalProvider()
+			t.Cleanup(config.ResetGlobalProvider)
+
+			err = reload(t.Context())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLimits, limiter.(*Assistant).limits)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package llmlimiter
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// lane is the priority class of a turn.
+type lane string
+
+const (
+	// laneInteractive serves streamed chat turns and the turns they start.
+	laneInteractive lane = "interactive"
+	// laneBackground serves standalone turns such as summaries, titles and compaction.
+	laneBackground lane = "background"
+)
+
+// laneLimits configures how a model scheduler shares its slots between lanes. A zero value means unlimited.
+type laneLimits struct {
+	capacity            int
+	interactiveCapacity int
+	pauseThreshold      int
+	maxBackgroundWait   time.Duration
+}
+
+// waiter is a turn queued for a model slot.
+type waiter struct {
+	lane     lane
+	since    time.Time
+	ready    chan struct{}
+	promoted bool
+	timer    *time.Timer
+}
+
+// modelScheduler hands out the slots of one model. Interactive turns are always served first, background
+// turns wait while the interactive lane is busy, and a background turn waiting longer than maxBackgroundWait
+// is promoted to the interactive lane so it cannot starve.
+type modelScheduler struct {
+	limits laneLimits
+	now    func() time.Time
+
+	mu      sync.Mutex
+	running map[lane]int
+	waiting []*waiter
+}
+
+// newModelScheduler creates a modelScheduler with the given limits.
+func newModelScheduler(limits laneLimits) *modelScheduler {
+	return &modelScheduler{
+		limits:  limits,
+		now:     time.Now,
+		running: make(map[lane]int),
+	}
+}
+
+// acquire queues a turn on the lane until a slot is granted or ctx is done, and returns whether the turn
+// was promoted out of the background lane.
+func (s *modelScheduler) acquire(ctx context.Context, l lane) (bool, error) {
+	s.mu.Lock()
+	w := &waiter{lane: l, since: s.now(), ready: make(chan struct{})}
+	s.waiting = append(s.waiting, w)
+	if l == laneBackground && s.limits.maxBackgroundWait > 0 {
+		w.timer = time.AfterFunc(s.limits.maxBackgroundWait, s.promote)
+	}
+	s.dispatch()
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return w.promoted, nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		select {
+		case <-w.ready:
+			// Granted while giving up: hand the slot to the next turn.
+			s.releaseLocked(l)
+		default:
+			s.remove(w)
+		}
+		return false, ctx.Err()
+	}
+}
+
+// setLimits replaces the limits and grants queued turns the slots they allow. Turns already queued keep
+// the promotion delay they were queued with.
+func (s *modelScheduler) setLimits(limits laneLimits) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limits = limits
+	s.dispatch()
+}
+
+// release frees a slot taken on the lane.
+func (s *modelScheduler) release(l lane) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.releaseLocked(l)
+}
+
+// releaseLocked frees a slot taken on the lane. The caller must hold mu.
+func (s *modelScheduler) releaseLocked(l lane) {
+	s.running[l]--
+	s.dispatch()
+}
+
+// promote moves background turns that waited too long to the interactive priority.
+func (s *modelScheduler) promote() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatch()
+}
+
+// dispatch grants slots to queued turns in priority order: interactive and promoted turns first,
+// then background turns while the interactive lane is below the pause threshold. Background turns may
+// still pass interactive turns that only wait for the interactive share of the slots. The caller must hold mu.
+func (s *modelScheduler) dispatch() {
+	now := s.now()
+	for _, w := range s.waiting {
+		if w.lane == laneBackground && s.limits.maxBackgroundWait > 0 && now.Sub(w.since) >= s.limits.maxBackgroundWait {
+			w.promoted = true
+		}
+	}
+
+	for i := 0; i < len(s.waiting); {
+		w := s.waiting[i]
+		if w.lane == laneInteractive || w.promoted {
+			if s.grant(w) {
+				continue
+			}
+		}
+		i++
+	}
+
+	if s.backgroundPaused() {
+		return
+	}
+	for i := 0; i < len(s.waiting); {
+		w := s.waiting[i]
+		if w.lane == laneInteractive {
+			i++
+			continue
+		}
+		if !s.grant(w) {
+			return
+		}
+	}
+}
+
+// grant gives w a slot if its lane has room, removing it from the queue. The caller must hold mu.
+func (s *modelScheduler) grant(w *waiter) bool {
+	total := s.running[laneInteractive] + s.running[laneBackground]
+	if s.limits.capacity > 0 && total >= s.limits.capacity {
+		return false
+	}
+	if w.lane == laneInteractive && s.limits.interactiveCapacity > 0 && s.running[laneInteractive] >= s.limits.interactiveCapacity {
+		return false
+	}
+	s.remove(w)
+	s.running[w.lane]++
+	close(w.ready)
+	return true
+}
+
+// remove drops w from the queue and stops its promotion timer. The caller must hold mu.
+func (s *modelScheduler) remove(w *waiter) {
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	s.waiting = slices.DeleteFunc(s.waiting, func(queued *waiter) bool { return queued == w })
+}
+
+// backgroundPaused reports whether the interactive lane is busy enough to hold back background turns.
+// The caller must hold mu.
+func (s *modelScheduler) backgroundPaused() bool {
+	return s.limits.pauseThreshold > 0 && s.running[laneInteractive] >= s.limits.pauseThreshold
+}
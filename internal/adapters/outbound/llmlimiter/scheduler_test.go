@@ -0,0 +1,133 @@
+package llmlimiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// queue starts acquiring a slot on the lane and returns a channel reporting the lane once granted.
+func queue(t *testing.T, s *modelScheduler, l lane) chan lane {
+	t.Helper()
+	granted := make(chan lane, 1)
+	go func() {
+		_, err := s.acquire(t.Context(), l)
+		if err == nil {
+			granted <- l
+		}
+	}()
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, w := range s.waiting {
+			if w.lane == l {
+				return true
+			}
+		}
+		return len(granted) > 0
+	}, time.Second, time.Millisecond)
+	return granted
+}
+
+func assertGranted(t *testing.T, granted chan lane) {
+	t.Helper()
+	select {
+	case <-granted:
+	case <-time.After(time.Second):
+		t.Fatal("slot was not granted")
+	}
+}
+
+func assertWaiting(t *testing.T, granted chan lane) {
+	t.Helper()
+	select {
+	case l := <-granted:
+		t.Fatalf("%s slot granted too early", l)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestModelScheduler_InteractiveServedFirst(t *testing.T) {
+	s := newModelScheduler(laneLimits{capacity: 1})
+	_, err := s.acquire(t.Context(), laneBackground)
+	require.NoError(t, err)
+
+	background := queue(t, s, laneBackground)
+	interactive := queue(t, s, laneInteractive)
+
+	s.release(laneBackground)
+	assertGranted(t, interactive)
+	assertWaiting(t, background)
+
+	s.release(laneInteractive)
+	assertGranted(t, background)
+}
+
+func TestModelScheduler_BackgroundPausedWhileInteractiveBusy(t *testing.T) {
+	s := newModelScheduler(laneLimits{capacity: 4, pauseThreshold: 2})
+	for range 2 {
+		_, err := s.acquire(t.Context(), laneInteractive)
+		require.NoError(t, err)
+	}
+
+	background := queue(t, s, laneBackground)
+	assertWaiting(t, background)
+
+	s.release(laneInteractive)
+	assertGranted(t, background)
+}
+
+func TestModelScheduler_PromotesStarvedBackground(t *testing.T) {
+	s := newModelScheduler(laneLimits{capacity: 4, pauseThreshold: 1, maxBackgroundWait: 30 * time.Millisecond})
+	_, err := s.acquire(t.Context(), laneInteractive)
+	require.NoError(t, err)
+
+	granted := make(chan bool, 1)
+	go func() {
+		promoted, err := s.acquire(t.Context(), laneBackground)
+		assert.NoError(t, err)
+		granted <- promoted
+	}()
+
+	select {
+	case promoted := <-granted:
+		assert.True(t, promoted)
+	case <-time.After(time.Second):
+		t.Fatal("starved background turn was not promoted")
+	}
+}
+
+func TestModelScheduler_BackgroundUsesReservedSlots(t *testing.T) {
+	s := newModelScheduler(laneLimits{capacity: 2, interactiveCapacity: 1})
+	_, err := s.acquire(t.Context(), laneInteractive)
+	require.NoError(t, err)
+
+	interactive := queue(t, s, laneInteractive)
+	background := queue(t, s, laneBackground)
+
+	assertGranted(t, background)
+	assertWaiting(t, interactive)
+
+	s.release(laneInteractive)
+	assertGranted(t, interactive)
+}
+
+func TestModelScheduler_CanceledWaiterLeavesQueue(t *testing.T) {
+	s := newModelScheduler(laneLimits{capacity: 1, maxBackgroundWait: time.Minute})
+	_, err := s.acquire(t.Context(), laneInteractive)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = s.acquire(ctx, laneBackground)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Empty(t, s.waiting)
+	assert.Equal(t, 1, s.running[laneInteractive])
+	assert.Zero(t, s.running[laneBackground])
+}
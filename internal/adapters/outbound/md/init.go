@@ -3,6 +3,7 @@ package md
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
@@ -13,11 +14,17 @@ import (
 type InitSkillRegistry struct {
 	Encoder        semantic.Encoder `resolve:""`
 	EmbeddingModel string           `config:"LLM_EMBEDDING_MODEL"`
+	// ActionsDir holds the declarative actions; its markdown skills expose them to the model.
+	ActionsDir string `config:"ASSISTANT_ACTIONS_DIR" default:""`
 }
 
 // Initialize builds the skill registry from embedded markdown files and registers it in the dependency container.
 func (i InitSkillRegistry) Initialize(ctx context.Context) (context.Context, error) {
-	registry, err := NewRegistryFromFS(ctx, i.Encoder, i.EmbeddingModel, Config{})
+	skills, err := i.loadSkills()
+	if err != nil {
+		return ctx, fmt.Errorf("failed to initialize skill registry: %w", err)
+	}
+	registry, err := NewRegistry(ctx, skills, i.Encoder, i.EmbeddingModel, Config{})
 	if err != nil {
 		return ctx, fmt.Errorf("failed to initialize skill registry: %w", err)
 	}
@@ -25,3 +32,30 @@ func (i InitSkillRegistry) Initialize(ctx context.Context) (context.Context, err
 	depend.Register[assistant.SkillRegistry](registry)
 	return ctx, nil
 }
+
+// loadSkills loads the embedded skills and the ones of ActionsDir, rejecting names used by both.
+func (i InitSkillRegistry) loadSkills() ([]assistant.SkillDefinition, error) {
+	skills, err := LoadSkillsFromFS(skillDirectory)
+	if err != nil {
+		return nil, err
+	}
+	if i.ActionsDir == "" {
+		return skills, nil
+	}
+
+	extra, err := LoadSkillsFromFS(os.DirFS(i.ActionsDir))
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{}, len(skills))
+	for _, skill := range skills {
+		names[skill.Name] = struct{}{}
+	}
+	for _, skill := range extra {
+		if _, exists := names[skill.Name]; exists {
+			return nil, fmt.Errorf("skill %q in %s conflicts with a built-in skill", skill.Name, skill.Source)
+		}
+		names[skill.Name] = struct{}{}
+	}
+	return append(skills, extra...), nil
+}
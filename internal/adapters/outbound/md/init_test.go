@@ -1,6 +1,9 @@
 package md
 
 import (
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
@@ -8,6 +11,7 @@ import (
 	"github.com/cleitonmarx/symbiont/depend"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitLSkillRegistry_Initialize(t *testing.T) {
@@ -32,3 +36,56 @@ func TestInitLSkillRegistry_Initialize(t *testing.T) {
 	assert.NotNil(t, dep)
 
 }
+
+func TestInitSkillRegistry_Initialize_ActionsDir(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		skillName   string
+		expectedErr string
+	}{
+		"adds-skills-of-actions-dir": {
+			skillName: "billing",
+		},
+		"rejects-built-in-names": {
+			skillName:   "todo-create",
+			expectedErr: `failed to initialize skill registry: skill "todo-create" in billing.md conflicts with a built-in skill`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			content := "---\nname: " + tt.skillName + "\ndescription: Answer billing questions.\ntools: [lookup_invoice]\n---\n\nLook up invoices before answering.\n"
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "billing.md"), []byte(content), 0o600))
+
+			enc := semantic.NewMockEncoder(t)
+			if tt.expectedErr == "" {
+				enc.EXPECT().
+					VectorizeSkillDefinition(mock.Anything, "test-embedding-model", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: []float64{1, 0}}, semantic.EmbeddingVector{Vector: []float64{0, 1}}, nil)
+			}
+
+			i := InitSkillRegistry{
+				Encoder:        enc,
+				EmbeddingModel: "test-embedding-model",
+				ActionsDir:     dir,
+			}
+			skills, err := i.loadSkills()
+			if tt.expectedErr != "" {
+				_, err = i.Initialize(t.Context())
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, slices.ContainsFunc(skills, func(s assistant.SkillDefinition) bool {
+				return s.Name == "billing" && slices.Equal(s.Tools, []string{"lookup_invoice"})
+			}))
+
+			_, err = i.Initialize(t.Context())
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -10,14 +10,41 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 )
 
+// finishReasonContentFilter is the finish reason reported when the provider filtered the response.
+const finishReasonContentFilter = "content_filter"
+
+var errContentFiltered = assistant.NewTurnError(
+	assistant.TurnErrorCode_ContentFiltered, 0,
+	errors.New("response was filtered by the model provider"),
+)
+
 // AssistantClient adapts OpenAICompatClient to domain assistant/model interfaces.
 type AssistantClient struct {
-	client OpenAICompatClient
+	client       OpenAICompatClient
+	outputLimits ModelOutputLimits
+	// toolEmulationModels lists models without native function calling, whose tool calls are emulated in text.
+	toolEmulationModels ModelSet
+	// constrainedDecoding lists models whose backend can constrain output to a JSON schema.
+	constrainedDecoding ConstrainedDecoding
+	// limits caps the size of requests and responses.
+	limits RequestLimits
 }
 
 // NewAssistantClient creates a new AssistantClient.
-func NewAssistantClient(client OpenAICompatClient) AssistantClient {
-	return AssistantClient{client: client}
+func NewAssistantClient(
+	client OpenAICompatClient,
+	outputLimits ModelOutputLimits,
+	toolEmulationModels ModelSet,
+	constrainedDecoding ConstrainedDecoding,
+	limits RequestLimits,
+) AssistantClient {
+	return AssistantClient{
+		client:              client,
+		outputLimits:        outputLimits,
+		toolEmulationModels: toolEmulationModels,
+		constrainedDecoding: constrainedDecoding,
+		limits:              limits,
+	}
 }
 
 // RunTurn implements assistant.Assistant.RunTurn.
@@ -26,18 +53,55 @@ func (a AssistantClient) RunTurn(ctx context.Context, req assistant.TurnRequest,
 	defer span.End()
 
 	adapterReq := toChatRequest(req)
+	if err := a.limits.Check(adapterReq); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	a.constrainedDecoding.Apply(&adapterReq, req.ResponseSchema)
+	emulateTools := len(adapterReq.Tools) > 0 && a.toolEmulationModels.Contains(req.Model)
+	if emulateTools {
+		var err error
+		if adapterReq, err = emulateToolCalling(adapterReq); telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+	}
 
 	var (
-		actionCalls []*assistant.ActionCall
-		usage       assistant.Usage
+		actionCalls     []*assistant.ActionCall
+		usage           assistant.Usage
+		splitter        reasoningSplitter
+		toolParser      toolCallParser
+		contentFiltered bool
+		responseBytes   int
 	)
+	// splitToolCalls collects fenced tool calls out of the visible content when tool calling is emulated.
+	splitToolCalls := func(content string) string {
+		if !emulateTools {
+			return content
+		}
+		content, calls := toolParser.Split(content)
+		for _, call := range calls {
+			actionCalls = append(actionCalls, &call)
+		}
+		return content
+	}
 
 	err := a.client.ChatStream(spanCtx, adapterReq, func(chunk StreamChunk) error {
 		for _, choice := range chunk.Choices {
-			if choice.Delta.Content != "" {
-				if err := onEvent(spanCtx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: choice.Delta.Content}); err != nil {
-					return err
-				}
+			if choice.FinishReason != nil && *choice.FinishReason == finishReasonContentFilter {
+				contentFiltered = true
+			}
+			responseBytes += len(choice.Delta.Content) + len(choice.Delta.ReasoningContent)
+			for _, tc := range choice.Delta.ToolCalls {
+				responseBytes += len(tc.Function.Arguments)
+			}
+			// The stream is abandoned as soon as the response goes over the limit.
+			if err := a.limits.CheckResponse(responseBytes); err != nil {
+				return err
+			}
+			content, reasoning := splitter.Split(choice.Delta.Content)
+			content = splitToolCalls(content)
+			if err := emitTextDeltas(spanCtx, onEvent, content, choice.Delta.ReasoningContent+reasoning); err != nil {
+				return err
 			}
 			if len(choice.Delta.ToolCalls) > 0 {
 				for _, tc := range choice.Delta.ToolCalls {
@@ -65,9 +129,26 @@ func (a AssistantClient) RunTurn(ctx context.Context, req assistant.TurnRequest,
 		return nil
 	})
 	if err != nil {
+		return toTurnError(spanCtx, err)
+	}
+
+	content, reasoning := splitter.Flush()
+	content = splitToolCalls(content)
+	if emulateTools {
+		rest, calls := toolParser.Flush()
+		content += rest
+		for _, call := range calls {
+			actionCalls = append(actionCalls, &call)
+		}
+	}
+	if err := emitTextDeltas(spanCtx, onEvent, content, reasoning); err != nil {
 		return err
 	}
 
+	if contentFiltered {
+		return errContentFiltered
+	}
+
 	for _, call := range actionCalls {
 		if err := onEvent(spanCtx, assistant.EventType_ActionRequested, *call); err != nil {
 			return err
@@ -79,15 +160,39 @@ func (a AssistantClient) RunTurn(ctx context.Context, req assistant.TurnRequest,
 	})
 }
 
+// emitTextDeltas emits the reasoning delta ahead of the visible content delta, skipping empty ones.
+func emitTextDeltas(ctx context.Context, onEvent assistant.EventCallback, content, reasoning string) error {
+	if reasoning != "" {
+		if err := onEvent(ctx, assistant.EventType_Reasoning, assistant.Reasoning{Text: reasoning}); err != nil {
+			return err
+		}
+	}
+	if content != "" {
+		return onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: content})
+	}
+	return nil
+}
+
 // RunTurnSync implements assistant.Assistant.RunTurnSync.
 func (a AssistantClient) RunTurnSync(ctx context.Context, req assistant.TurnRequest) (assistant.TurnResponse, error) {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
 	adapterReq := toChatRequest(req)
+	if err := a.limits.Check(adapterReq); telemetry.IsErrorRecorded(span, err) {
+		return assistant.TurnResponse{}, err
+	}
+	a.constrainedDecoding.Apply(&adapterReq, req.ResponseSchema)
+	emulateTools := len(adapterReq.Tools) > 0 && a.toolEmulationModels.Contains(req.Model)
+	if emulateTools {
+		var err error
+		if adapterReq, err = emulateToolCalling(adapterReq); telemetry.IsErrorRecorded(span, err) {
+			return assistant.TurnResponse{}, err
+		}
+	}
 	resp, err := a.client.Chat(spanCtx, adapterReq)
 	if telemetry.IsErrorRecorded(span, err) {
-		return assistant.TurnResponse{}, err
+		return assistant.TurnResponse{}, toTurnError(spanCtx, err)
 	}
 	if len(resp.Choices) == 0 {
 		err := errors.New("no choices in response")
@@ -95,7 +200,33 @@ func (a AssistantClient) RunTurnSync(ctx context.Context, req assistant.TurnRequ
 		return assistant.TurnResponse{}, err
 	}
 
-	res := assistant.TurnResponse{Content: resp.Choices[0].Message.Content}
+	if resp.Choices[0].FinishReason == finishReasonContentFilter {
+		telemetry.IsErrorRecorded(span, errContentFiltered)
+		return assistant.TurnResponse{}, errContentFiltered
+	}
+	if err := a.limits.CheckResponse(responseBytes(resp.Choices[0].Message)); telemetry.IsErrorRecorded(span, err) {
+		return assistant.TurnResponse{}, err
+	}
+
+	content, _ := splitReasoning(resp.Choices[0].Message.Content)
+	res := assistant.TurnResponse{Content: content}
+	for _, tc := range resp.Choices[0].Message.ToolCalls {
+		res.ActionCalls = append(res.ActionCalls, assistant.ActionCall{
+			ID:    tc.ID,
+			Name:  tc.Function.Name,
+			Input: tc.Function.Arguments,
+		})
+	}
+	if emulateTools {
+		var (
+			parser toolCallParser
+			calls  []assistant.ActionCall
+		)
+		res.Content, calls = parser.Split(content)
+		rest, flushed := parser.Flush()
+		res.Content += rest
+		res.ActionCalls = append(res.ActionCalls, append(calls, flushed...)...)
+	}
 	if resp.Usage != nil {
 		res.Usage = assistant.Usage{
 			PromptTokens:     resp.Usage.PromptTokens,
@@ -129,6 +260,9 @@ func (a AssistantClient) ListAvailableModels(ctx context.Context) ([]assistant.M
 			Name: name,
 			Kind: kind,
 		}
+		if kind == assistant.ModelKindAssistant {
+			models[i].MaxOutputTokens = a.outputLimits.For(m.ID, name)
+		}
 	}
 	return models, nil
 }
@@ -155,6 +289,7 @@ func (a AssistantClient) ListModels(ctx context.Context) ([]assistant.ModelCapab
 			Name:              name,
 			SupportsStreaming: true,
 			SupportsActions:   true,
+			MaxOutputTokens:   a.outputLimits.For(m.ID, name),
 		})
 	}
 	return res, nil
@@ -168,7 +303,10 @@ func toChatRequest(req assistant.TurnRequest) ChatRequest {
 		Stream:           req.Stream,
 		MaxTokens:        req.MaxTokens,
 		TopP:             req.TopP,
+		Stop:             req.Stop,
+		PresencePenalty:  req.PresencePenalty,
 		FrequencyPenalty: req.FrequencyPenalty,
+		Seed:             req.Seed,
 		Messages:         make([]ChatMessage, len(req.Messages)),
 		Tools:            make([]Tool, len(req.AvailableActions)),
 	}
@@ -197,31 +335,35 @@ func toChatRequest(req assistant.TurnRequest) ChatRequest {
 	}
 
 	for i, action := range req.AvailableActions {
-		tool := Tool{
+		adapterReq.Tools[i] = Tool{
 			Type: "function",
 			Function: ToolFunc{
 				Description: action.Description,
 				Name:        action.Name,
-				Parameters: ToolFuncParameters{
-					Type:       action.Input.Type,
-					Properties: make(map[string]ToolFuncParameterDetail),
-					Required:   []string{},
-				},
+				Parameters:  mapActionInputToSchema(action.Input),
 			},
 		}
-
-		for paramName, field := range action.Input.Fields {
-			tool.Function.Parameters.Properties[paramName] = mapActionFieldToSchema(field)
-			if field.Required {
-				tool.Function.Parameters.Required = append(tool.Function.Parameters.Required, paramName)
-			}
-		}
-		adapterReq.Tools[i] = tool
 	}
 
 	return adapterReq
 }
 
+// mapActionInputToSchema maps assistant.ActionInput to the top-level ToolFuncParameters schema.
+func mapActionInputToSchema(input assistant.ActionInput) ToolFuncParameters {
+	params := ToolFuncParameters{
+		Type:       input.Type,
+		Properties: make(map[string]ToolFuncParameterDetail, len(input.Fields)),
+		Required:   []string{},
+	}
+	for paramName, field := range input.Fields {
+		params.Properties[paramName] = mapActionFieldToSchema(field)
+		if field.Required {
+			params.Required = append(params.Required, paramName)
+		}
+	}
+	return params
+}
+
 // mapActionFieldToSchema recursively maps assistant.ActionField to ToolFuncParameterDetail,
 // handling nested fields for object types.
 func mapActionFieldToSchema(field assistant.ActionField) ToolFuncParameterDetail {
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
@@ -88,6 +89,22 @@ func TestAssistantClientAdapter_RunTurn(t *testing.T) {
 			},
 			expectedContent: "Hello world",
 		},
+		"reasoning-kept-out-of-content": {
+			req: req,
+			chunks: []StreamChunk{
+				{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "<think>Check the"}}}},
+				{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: " list</think>\n\nHello"}}}},
+				{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{ReasoningContent: "More thoughts"}}}},
+			},
+			expectedEvents: []assistant.EventType{
+				assistant.EventType_Reasoning,
+				assistant.EventType_Reasoning,
+				assistant.EventType_MessageDelta,
+				assistant.EventType_Reasoning,
+				assistant.EventType_TurnCompleted,
+			},
+			expectedContent: "Hello",
+		},
 		"empty-delta": {
 			req: req,
 			chunks: []StreamChunk{
@@ -163,7 +180,7 @@ func TestAssistantClientAdapter_RunTurn(t *testing.T) {
 			defer server.Close()
 
 			client := NewOpenAICompatClient(server.URL, "", server.Client())
-			adapter := NewAssistantClient(client)
+			adapter := NewAssistantClient(client, ModelOutputLimits{}, nil, nil, RequestLimits{})
 
 			eventTypes, deltaTexts, _, err := collectStreamEvents(t.Context(), adapter, tt.req)
 
@@ -182,6 +199,67 @@ func TestAssistantClientAdapter_RunTurn(t *testing.T) {
 	}
 }
 
+func TestAssistantClientAdapter_RunTurn_EmulatedToolCalls(t *testing.T) {
+	t.Parallel()
+
+	chunks := []StreamChunk{
+		{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "Checking.\n```tool"}}}},
+		{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "_call\n{\"name\": \"fetch_todos\", \"arguments\": {\"page\": 1}}\n```"}}}},
+	}
+	var received ChatRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, chunk := range chunks {
+			data, _ := json.Marshal(chunk)
+			fmt.Fprintf(w, "data: %s\n\n", data) //nolint:errcheck
+		}
+		fmt.Fprintf(w, "data: [DONE]\n\n") //nolint:errcheck
+	}))
+	defer server.Close()
+
+	adapter := NewAssistantClient(
+		NewOpenAICompatClient(server.URL, "", server.Client()),
+		ModelOutputLimits{},
+		ParseModelSet("gemma3"),
+		nil,
+		RequestLimits{},
+	)
+
+	var (
+		content     strings.Builder
+		actionCalls []assistant.ActionCall
+	)
+	err := adapter.RunTurn(t.Context(), assistant.TurnRequest{
+		Model:    "ai/gemma3",
+		Messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "List my todos"}},
+		AvailableActions: []assistant.ActionDefinition{{
+			Name:  "fetch_todos",
+			Input: assistant.ActionInput{Type: "object"},
+		}},
+	}, func(_ context.Context, eventType assistant.EventType, data any) error {
+		switch eventType {
+		case assistant.EventType_MessageDelta:
+			content.WriteString(data.(assistant.MessageDelta).Text)
+		case assistant.EventType_ActionRequested:
+			actionCalls = append(actionCalls, data.(assistant.ActionCall))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, received.Tools)
+	require.Len(t, received.Messages, 2)
+	assert.Equal(t, "system", received.Messages[0].Role)
+	assert.Contains(t, received.Messages[0].Content, `"name":"fetch_todos"`)
+
+	assert.Equal(t, "Checking.\n", content.String())
+	require.Len(t, actionCalls, 1)
+	assert.NotEmpty(t, actionCalls[0].ID)
+	assert.Equal(t, "fetch_todos", actionCalls[0].Name)
+	assert.Equal(t, `{"page": 1}`, actionCalls[0].Input)
+}
+
 func TestAssistantClientAdapter_RunTurn_ServerError(t *testing.T) {
 	t.Parallel()
 
@@ -191,7 +269,7 @@ func TestAssistantClientAdapter_RunTurn_ServerError(t *testing.T) {
 	defer server.Close()
 
 	client := NewOpenAICompatClient(server.URL, "", server.Client())
-	adapter := NewAssistantClient(client)
+	adapter := NewAssistantClient(client, ModelOutputLimits{}, nil, nil, RequestLimits{})
 
 	req := assistant.TurnRequest{
 		Model: "test-model",
@@ -208,6 +286,170 @@ func TestAssistantClientAdapter_RunTurn_ServerError(t *testing.T) {
 	assert.Contains(t, err.Error(), "500")
 }
 
+func TestAssistantClientAdapter_RunTurn_ClassifiesFailures(t *testing.T) {
+	t.Parallel()
+
+	contentFilter := "content_filter"
+
+	tests := map[string]struct {
+		newServer func() *httptest.Server
+		wantCode  assistant.TurnErrorCode
+		wantRetry time.Duration
+	}{
+		"rate-limited": {
+			newServer: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Retry-After", "4")
+					http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+				}))
+			},
+			wantCode:  assistant.TurnErrorCode_RateLimited,
+			wantRetry: 4 * time.Second,
+		},
+		"context-too-long": {
+			newServer: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					http.Error(w, `{"error":{"code":"context_length_exceeded"}}`, http.StatusBadRequest)
+				}))
+			},
+			wantCode: assistant.TurnErrorCode_ContextTooLong,
+		},
+		"content-filtered": {
+			newServer: func() *httptest.Server {
+				return createStreamingServer([]StreamChunk{
+					{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "Partial"}}}},
+					{Choices: []StreamChunkChoice{{FinishReason: &contentFilter}}},
+				})
+			},
+			wantCode: assistant.TurnErrorCode_ContentFiltered,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := tt.newServer()
+			defer server.Close()
+
+			adapter := NewAssistantClient(NewOpenAICompatClient(server.URL, "", server.Client()), ModelOutputLimits{}, nil, nil, RequestLimits{})
+
+			var completed bool
+			err := adapter.RunTurn(t.Context(), assistant.TurnRequest{
+				Model:    "test-model",
+				Messages: []assistant.Message{{Role: "user", Content: "test"}},
+			}, func(_ context.Context, eventType assistant.EventType, _ any) error {
+				completed = completed || eventType == assistant.EventType_TurnCompleted
+				return nil
+			})
+
+			var turnErr *assistant.TurnError
+			require.ErrorAs(t, err, &turnErr)
+			assert.Equal(t, tt.wantCode, turnErr.Code)
+			assert.Equal(t, tt.wantRetry, turnErr.RetryAfter)
+			assert.False(t, completed)
+		})
+	}
+
+	t.Run("network", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		adapter := NewAssistantClient(NewOpenAICompatClient(server.URL, "", server.Client()), ModelOutputLimits{}, nil, nil, RequestLimits{})
+		err := adapter.RunTurn(t.Context(), assistant.TurnRequest{
+			Model:    "test-model",
+			Messages: []assistant.Message{{Role: "user", Content: "test"}},
+		}, func(context.Context, assistant.EventType, any) error { return nil })
+
+		var turnErr *assistant.TurnError
+		require.ErrorAs(t, err, &turnErr)
+		assert.Equal(t, assistant.TurnErrorCode_Network, turnErr.Code)
+		assert.True(t, turnErr.Retriable())
+	})
+}
+
+func TestAssistantClientAdapter_RequestLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request-rejected-before-reaching-the-model-host", func(t *testing.T) {
+		var called bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		adapter := NewAssistantClient(NewOpenAICompatClient(server.URL, "", server.Client()), ModelOutputLimits{}, nil, nil, RequestLimits{MaxTools: 1})
+		req := assistant.TurnRequest{
+			Model:    "test-model",
+			Messages: []assistant.Message{{Role: "user", Content: "test"}},
+			AvailableActions: []assistant.ActionDefinition{
+				{Name: "fetch_todos", Description: "Fetch todos"},
+				{Name: "create_todo", Description: "Create a todo"},
+			},
+		}
+
+		err := adapter.RunTurn(t.Context(), req, func(context.Context, assistant.EventType, any) error { return nil })
+		var turnErr *assistant.TurnError
+		require.ErrorAs(t, err, &turnErr)
+		assert.Equal(t, assistant.TurnErrorCode_RequestTooLarge, turnErr.Code)
+
+		_, err = adapter.RunTurnSync(t.Context(), req)
+		require.ErrorAs(t, err, &turnErr)
+		assert.Equal(t, assistant.TurnErrorCode_RequestTooLarge, turnErr.Code)
+		assert.False(t, called)
+	})
+
+	t.Run("stream-abandoned-over-the-response-limit", func(t *testing.T) {
+		server := createStreamingServer([]StreamChunk{
+			{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "Hello "}}}},
+			{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "world, and more"}}}},
+			{Choices: []StreamChunkChoice{{Delta: StreamChunkDelta{Content: "!"}}}},
+		})
+		defer server.Close()
+
+		adapter := NewAssistantClient(NewOpenAICompatClient(server.URL, "", server.Client()), ModelOutputLimits{}, nil, nil, RequestLimits{MaxResponseBytes: 10})
+
+		var (
+			deltas    []string
+			completed bool
+		)
+		err := adapter.RunTurn(t.Context(), assistant.TurnRequest{
+			Model:    "test-model",
+			Messages: []assistant.Message{{Role: "user", Content: "test"}},
+		}, func(_ context.Context, eventType assistant.EventType, data any) error {
+			if delta, ok := data.(assistant.MessageDelta); ok {
+				deltas = append(deltas, delta.Text)
+			}
+			completed = completed || eventType == assistant.EventType_TurnCompleted
+			return nil
+		})
+
+		var turnErr *assistant.TurnError
+		require.ErrorAs(t, err, &turnErr)
+		assert.Equal(t, assistant.TurnErrorCode_ResponseTooLong, turnErr.Code)
+		assert.Equal(t, []string{"Hello "}, deltas)
+		assert.False(t, completed)
+	})
+
+	t.Run("sync-response-over-the-limit", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ChatResponse{
+				Choices: []Choice{{Message: Message{Role: "assistant", Content: "a response longer than ten bytes"}}},
+			})
+		}))
+		defer server.Close()
+
+		adapter := NewAssistantClient(NewOpenAICompatClient(server.URL, "", server.Client()), ModelOutputLimits{}, nil, nil, RequestLimits{MaxResponseBytes: 10})
+		_, err := adapter.RunTurnSync(t.Context(), assistant.TurnRequest{
+			Model:    "test-model",
+			Messages: []assistant.Message{{Role: "user", Content: "test"}},
+		})
+
+		var turnErr *assistant.TurnError
+		require.ErrorAs(t, err, &turnErr)
+		assert.Equal(t, assistant.TurnErrorCode_ResponseTooLong, turnErr.Code)
+	})
+}
+
 func TestAssistantClientAdapter_RunTurnSync(t *testing.T) {
 	t.Parallel()
 
@@ -220,8 +462,68 @@ func TestAssistantClientAdapter_RunTurnSync(t *testing.T) {
 		req          assistant.TurnRequest
 		expectErr    bool
 		expectedResp string
+		expectedActs []string
 		validateReq  func(*testing.T, *ChatRequest)
+		constrained  ConstrainedDecoding
+		emulation    ModelSet
 	}{
+		"with-tool-calls": {
+			response:   `{"choices":[{"message":{"role":"assistant","tool_calls":[{"type":"function","id":"call-1","function":{"name":"fetch_todos","arguments":"{\"status\":\"OPEN\"}"}}]}}]}`,
+			statusCode: http.StatusOK,
+			req: assistant.TurnRequest{
+				Model:            "test-model",
+				Messages:         []assistant.Message{{Role: assistant.ChatRole_User, Content: "What is open?"}},
+				AvailableActions: []assistant.ActionDefinition{{Name: "fetch_todos", Description: "Fetch todos"}},
+			},
+			expectedActs: []string{"fetch_todos"},
+		},
+		"with-emulated-tool-calls": {
+			response:   `{"choices":[{"message":{"role":"assistant","content":"Let me check.\n` + "```tool_call" + `\n{\"name\": \"fetch_todos\", \"arguments\": {}}\n` + "```" + `"}}]}`,
+			statusCode: http.StatusOK,
+			req: assistant.TurnRequest{
+				Model:            "ai/gemma3",
+				Messages:         []assistant.Message{{Role: assistant.ChatRole_User, Content: "What is open?"}},
+				AvailableActions: []assistant.ActionDefinition{{Name: "fetch_todos", Description: "Fetch todos"}},
+			},
+			emulation:    ParseModelSet("gemma3"),
+			expectedResp: "Let me check.\n",
+			expectedActs: []string{"fetch_todos"},
+			validateReq: func(t *testing.T, req *ChatRequest) {
+				assert.Empty(t, req.Tools)
+			},
+		},
+		"with-response-schema-on-grammar-model": {
+			response:   `{"choices":[{"message":{"role":"assistant","content":"{\"title\":\"Groceries\"}"}}]}`,
+			statusCode: http.StatusOK,
+			req: assistant.TurnRequest{
+				Model:          "ai/qwen3",
+				Messages:       []assistant.Message{{Role: assistant.ChatRole_User, Content: "Title?"}},
+				ResponseSchema: assistant.NewTextResponseSchema("conversation_title", "title", "The title"),
+			},
+			constrained:  ConstrainedDecoding{"qwen3": ConstrainedDecodingMode_Grammar},
+			expectedResp: `{"title":"Groceries"}`,
+			validateReq: func(t *testing.T, req *ChatRequest) {
+				assert.Nil(t, req.ResponseFormat)
+				require.NotNil(t, req.JSONSchema)
+				assert.Equal(t, []string{"title"}, req.JSONSchema.Required)
+				assert.Equal(t, "string", req.JSONSchema.Properties["title"].Type)
+			},
+		},
+		"response-schema-ignored-for-unconfigured-model": {
+			response:   `{"choices":[{"message":{"role":"assistant","content":"Groceries"}}]}`,
+			statusCode: http.StatusOK,
+			req: assistant.TurnRequest{
+				Model:          "ai/llama3",
+				Messages:       []assistant.Message{{Role: assistant.ChatRole_User, Content: "Title?"}},
+				ResponseSchema: assistant.NewTextResponseSchema("conversation_title", "title", "The title"),
+			},
+			constrained:  ConstrainedDecoding{"qwen3": ConstrainedDecodingMode_Grammar},
+			expectedResp: "Groceries",
+			validateReq: func(t *testing.T, req *ChatRequest) {
+				assert.Nil(t, req.ResponseFormat)
+				assert.Nil(t, req.JSONSchema)
+			},
+		},
 		"success": {
 			response:   `{"choices":[{"message":{"role":"assistant","content":"Hello!"}}],"usage": {"completion_tokens": 10,"prompt_tokens": 10,"total_tokens": 20}}`,
 			statusCode: http.StatusOK,
@@ -233,13 +535,28 @@ func TestAssistantClientAdapter_RunTurnSync(t *testing.T) {
 			},
 			expectedResp: "Hello!",
 		},
+		"strips-reasoning": {
+			response:   `{"choices":[{"message":{"role":"assistant","content":"<think>short title</think>\n\nGrocery run"}}]}`,
+			statusCode: http.StatusOK,
+			req: assistant.TurnRequest{
+				Model: "test-model",
+				Messages: []assistant.Message{
+					{Role: "user", Content: "hi"},
+				},
+			},
+			expectedResp: "Grocery run",
+		},
 		"with-params": {
 			response:   `{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`,
 			statusCode: http.StatusOK,
 			req: assistant.TurnRequest{
-				Model:       "test-model",
-				Temperature: &temp,
-				TopP:        &topP,
+				Model:           "test-model",
+				Temperature:     &temp,
+				TopP:            &topP,
+				MaxTokens:       common.Ptr(64),
+				Stop:            []string{"END"},
+				PresencePenalty: common.Ptr(0.5),
+				Seed:            common.Ptr(int64(42)),
 				Messages: []assistant.Message{
 					{Role: "system", Content: "sys"},
 					{Role: "user", Content: "hi"},
@@ -248,10 +565,16 @@ func TestAssistantClientAdapter_RunTurnSync(t *testing.T) {
 			expectedResp: "ok",
 			validateReq: func(t *testing.T, req *ChatRequest) {
 				assert.Equal(t, "test-model", req.Model)
+				assert.Equal(t, []string{"END"}, req.Stop)
+				assert.NotNil(t, req.MaxTokens)
+				assert.Equal(t, 64, *req.MaxTokens)
+				assert.NotNil(t, req.PresencePenalty)
+				assert.InDelta(t, 0.5, *req.PresencePenalty, 1e-6)
 				assert.NotNil(t, req.Temperature)
 				assert.InDelta(t, 0.5, *req.Temperature, 1e-6)
 				assert.NotNil(t, req.TopP)
 				assert.InDelta(t, 0.9, *req.TopP, 1e-6)
+				assert.Equal(t, common.Ptr(int64(42)), req.Seed)
 				assert.Len(t, req.Messages, 2)
 			},
 		},
@@ -307,7 +630,7 @@ func TestAssistantClientAdapter_RunTurnSync(t *testing.T) {
 			defer server.Close()
 
 			client := NewOpenAICompatClient(server.URL, "", server.Client())
-			adapter := NewAssistantClient(client)
+			adapter := NewAssistantClient(client, ModelOutputLimits{}, tt.emulation, tt.constrained, RequestLimits{})
 
 			resp, err := adapter.RunTurnSync(t.Context(), tt.req)
 
@@ -318,6 +641,11 @@ func TestAssistantClientAdapter_RunTurnSync(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedResp, resp.Content)
+			var actions []string
+			for _, call := range resp.ActionCalls {
+				actions = append(actions, call.Name)
+			}
+			assert.Equal(t, tt.expectedActs, actions)
 
 			if tt.validateReq != nil && capturedReq != nil {
 				tt.validateReq(t, capturedReq)
@@ -335,7 +663,7 @@ func TestAssistantClientAdapter_RunTurnSync_ValidationErrors(t *testing.T) {
 	defer server.Close()
 
 	client := NewOpenAICompatClient(server.URL, "", server.Client())
-	adapter := NewAssistantClient(client)
+	adapter := NewAssistantClient(client, ModelOutputLimits{}, nil, nil, RequestLimits{})
 
 	tests := map[string]struct {
 		req assistant.TurnRequest
@@ -436,7 +764,7 @@ func TestAssistantClientAdapter_ListAvailableModels(t *testing.T) {
 			defer server.Close()
 
 			client := NewOpenAICompatClient(server.URL, "", server.Client())
-			adapter := NewAssistantClient(client)
+			adapter := NewAssistantClient(client, ModelOutputLimits{}, nil, nil, RequestLimits{})
 
 			models, err := adapter.ListAvailableModels(t.Context())
 
@@ -525,7 +853,7 @@ func TestAssistantClientAdapter_ListModels(t *testing.T) {
 			defer server.Close()
 
 			client := NewOpenAICompatClient(server.URL, "", server.Client())
-			adapter := NewAssistantClient(client)
+			adapter := NewAssistantClient(client, ModelOutputLimits{}, nil, nil, RequestLimits{})
 
 			models, err := adapter.ListModels(t.Context())
 
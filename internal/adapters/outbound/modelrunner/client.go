@@ -48,7 +48,7 @@ func (c OpenAICompatClient) Chat(ctx context.Context, req ChatRequest) (*ChatRes
 
 	resp, err := c.http.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("http do: %w", err)
+		return nil, &TransportError{Op: "http do", Err: err}
 	}
 	defer resp.Body.Close() //nolint:errcheck
 
@@ -58,7 +58,7 @@ func (c OpenAICompatClient) Chat(ctx context.Context, req ChatRequest) (*ChatRes
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("non-2xx response: %s: %s", resp.Status, string(respBody))
+		return nil, newStatusError(resp, respBody)
 	}
 
 	var out ChatResponse
@@ -91,13 +91,13 @@ func (c OpenAICompatClient) ChatStream(ctx context.Context, req ChatRequest, onC
 
 	resp, err := c.http.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("http do: %w", err)
+		return &TransportError{Op: "http do", Err: err}
 	}
 	defer resp.Body.Close() //nolint:errcheck
 
 	if resp.StatusCode != http.StatusOK {
 		b, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("non-2xx response: %s: %s", resp.Status, string(b))
+		return newStatusError(resp, b)
 	}
 
 	scanner := bufio.NewScanner(resp.Body)
@@ -123,7 +123,10 @@ func (c OpenAICompatClient) ChatStream(ctx context.Context, req ChatRequest, onC
 		}
 	}
 
-	return scanner.Err()
+	if err := scanner.Err(); err != nil {
+		return &TransportError{Op: "read stream", Err: err}
+	}
+	return nil
 }
 
 // Embeddings requests embeddings for the given input
@@ -135,7 +138,7 @@ func (c OpenAICompatClient) Embeddings(ctx context.Context, req EmbeddingsReques
 
 	resp, err := c.http.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("http do: %w", err)
+		return nil, &TransportError{Op: "http do", Err: err}
 	}
 	defer resp.Body.Close() //nolint:errcheck
 
@@ -145,7 +148,7 @@ func (c OpenAICompatClient) Embeddings(ctx context.Context, req EmbeddingsReques
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("non-2xx response: %s: %s", resp.Status, string(respBody))
+		return nil, newStatusError(resp, respBody)
 	}
 
 	var out EmbeddingsResponse
@@ -156,6 +159,36 @@ func (c OpenAICompatClient) Embeddings(ctx context.Context, req EmbeddingsReques
 	return &out, nil
 }
 
+// Moderations classifies the given input against the provider content policy
+func (c OpenAICompatClient) Moderations(ctx context.Context, req ModerationsRequest) (*ModerationsResponse, error) {
+	httpReq, err := c.newRequest(ctx, http.MethodPost, "/v1/moderations", req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return nil, &TransportError{Op: "http do", Err: err}
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, newStatusError(resp, respBody)
+	}
+
+	var out ModerationsResponse
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &out, nil
+}
+
 // AvailableModels retrieves the list of available models
 func (c OpenAICompatClient) AvailableModels(ctx context.Context) (*ModelsResponse, error) {
 	httpReq, err := c.newRequest(ctx, http.MethodGet, "/v1/models", nil)
@@ -165,7 +198,7 @@ func (c OpenAICompatClient) AvailableModels(ctx context.Context) (*ModelsRespons
 
 	resp, err := c.http.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("http do: %w", err)
+		return nil, &TransportError{Op: "http do", Err: err}
 	}
 	defer resp.Body.Close() //nolint:errcheck
 
@@ -175,7 +208,7 @@ func (c OpenAICompatClient) AvailableModels(ctx context.Context) (*ModelsRespons
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("non-2xx response: %s: %s", resp.Status, string(respBody))
+		return nil, newStatusError(resp, respBody)
 	}
 
 	var out ModelsResponse
@@ -0,0 +1,162 @@
+package modelrunner
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// ConstrainedDecodingMode selects how a model backend enforces JSON output.
+type ConstrainedDecodingMode string
+
+const (
+	// ConstrainedDecodingMode_ResponseFormat uses OpenAI structured outputs: strict tool schemas
+	// and a json_schema response_format.
+	ConstrainedDecodingMode_ResponseFormat ConstrainedDecodingMode = "response_format"
+	// ConstrainedDecodingMode_Grammar uses the llama.cpp json_schema field, which is compiled into a grammar.
+	// llama.cpp already constrains native tool call arguments with a grammar, so tools are sent unchanged.
+	ConstrainedDecodingMode_Grammar ConstrainedDecodingMode = "grammar"
+)
+
+// ConstrainedDecoding maps model IDs or short names to their constrained decoding mode.
+type ConstrainedDecoding map[string]ConstrainedDecodingMode
+
+// ParseConstrainedDecoding builds ConstrainedDecoding from a comma-separated list of model=mode entries,
+// e.g. "qwen3=grammar,gpt-4o-mini=response_format".
+func ParseConstrainedDecoding(list string) (ConstrainedDecoding, error) {
+	modes := ConstrainedDecoding{}
+	for entry := range strings.SplitSeq(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		model, rawMode, ok := strings.Cut(entry, "=")
+		model = strings.TrimSpace(model)
+		if !ok || model == "" {
+			return ConstrainedDecoding{}, fmt.Errorf("invalid constrained decoding entry %q: expected model=mode", entry)
+		}
+		mode := ConstrainedDecodingMode(strings.TrimSpace(rawMode))
+		if mode != ConstrainedDecodingMode_ResponseFormat && mode != ConstrainedDecodingMode_Grammar {
+			return ConstrainedDecoding{}, fmt.Errorf(
+				"invalid constrained decoding entry %q: mode must be %s or %s",
+				entry, ConstrainedDecodingMode_ResponseFormat, ConstrainedDecodingMode_Grammar,
+			)
+		}
+		modes[model] = mode
+	}
+	return modes, nil
+}
+
+// For returns the constrained decoding mode of a model, looked up by ID first and then by short name.
+func (c ConstrainedDecoding) For(modelID string) (ConstrainedDecodingMode, bool) {
+	if mode, ok := c[modelID]; ok {
+		return mode, true
+	}
+	mode, ok := c[modelShortName(modelID)]
+	return mode, ok
+}
+
+// Apply constrains tool arguments and the optional response schema of the request
+// according to the mode configured for its model. Unconfigured models are left unchanged.
+func (c ConstrainedDecoding) Apply(req *ChatRequest, schema *assistant.ResponseSchema) {
+	mode, ok := c.For(req.Model)
+	if !ok {
+		return
+	}
+
+	switch mode {
+	case ConstrainedDecodingMode_ResponseFormat:
+		for i := range req.Tools {
+			req.Tools[i].Function.Strict = true
+			req.Tools[i].Function.Parameters = strictParameters(req.Tools[i].Function.Parameters)
+		}
+		if schema != nil {
+			req.ResponseFormat = &ResponseFormat{
+				Type: "json_schema",
+				JSONSchema: &ResponseJSONSchema{
+					Name:   schema.Name,
+					Strict: true,
+					Schema: strictParameters(mapActionInputToSchema(schema.Input)),
+				},
+			}
+		}
+	case ConstrainedDecodingMode_Grammar:
+		if schema != nil {
+			params := mapActionInputToSchema(schema.Input)
+			params.AdditionalProperties = false
+			req.JSONSchema = &params
+		}
+	}
+}
+
+// strictParameters adapts a schema to strict structured outputs: every property becomes required,
+// optional ones turn nullable instead, and objects reject additional properties.
+func strictParameters(params ToolFuncParameters) ToolFuncParameters {
+	params.Properties, params.Required = strictProperties(params.Properties, params.Required)
+	if params.Items != nil {
+		items := strictDetail(*params.Items)
+		params.Items = &items
+	}
+	if params.Type == "object" {
+		params.AdditionalProperties = false
+	}
+	return params
+}
+
+// strictDetail applies strictParameters rules to a nested schema node.
+func strictDetail(detail ToolFuncParameterDetail) ToolFuncParameterDetail {
+	detail.Properties, detail.Required = strictProperties(detail.Properties, detail.Required)
+	if detail.Items != nil {
+		items := strictDetail(*detail.Items)
+		detail.Items = &items
+	}
+	if detail.Type == "object" {
+		detail.AdditionalProperties = false
+	}
+	return detail
+}
+
+// strictProperties marks every property as required and makes the originally optional ones nullable.
+func strictProperties(
+	properties map[string]ToolFuncParameterDetail,
+	required []string,
+) (map[string]ToolFuncParameterDetail, []string) {
+	if len(properties) == 0 {
+		return properties, required
+	}
+
+	strict := make(map[string]ToolFuncParameterDetail, len(properties))
+	names := make([]string, 0, len(properties))
+	for name, property := range properties {
+		property = strictDetail(property)
+		if !slices.Contains(required, name) {
+			property.Type = nullableType(property.Type)
+			if len(property.Enum) > 0 && !slices.Contains(property.Enum, nil) {
+				property.Enum = append(slices.Clone(property.Enum), nil)
+			}
+		}
+		strict[name] = property
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strict, names
+}
+
+// nullableType adds "null" to a schema type, keeping the single-or-union shape used by normalizeSchemaType.
+func nullableType(typ any) any {
+	switch t := typ.(type) {
+	case string:
+		if t == "" || t == "null" {
+			return t
+		}
+		return []string{t, "null"}
+	case []string:
+		if slices.Contains(t, "null") {
+			return t
+		}
+		return append(slices.Clone(t), "null")
+	}
+	return typ
+}
@@ -0,0 +1,153 @@
+package modelrunner
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConstrainedDecoding(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		list      string
+		want      ConstrainedDecoding
+		expectErr bool
+	}{
+		"empty": {
+			list: "",
+			want: ConstrainedDecoding{},
+		},
+		"modes": {
+			list: " qwen3=grammar , docker.io/ai/gpt-oss=response_format,",
+			want: ConstrainedDecoding{
+				"qwen3":                ConstrainedDecodingMode_Grammar,
+				"docker.io/ai/gpt-oss": ConstrainedDecodingMode_ResponseFormat,
+			},
+		},
+		"missing-mode": {
+			list:      "qwen3",
+			expectErr: true,
+		},
+		"unknown-mode": {
+			list:      "qwen3=regex",
+			expectErr: true,
+		},
+		"missing-model": {
+			list:      "=grammar",
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseConstrainedDecoding(tt.list)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConstrainedDecoding_Apply(t *testing.T) {
+	t.Parallel()
+
+	modes := ConstrainedDecoding{
+		"qwen3":   ConstrainedDecodingMode_Grammar,
+		"gpt-oss": ConstrainedDecodingMode_ResponseFormat,
+	}
+	schema := assistant.NewTextResponseSchema("conversation_title", "title", "The title")
+	tool := Tool{
+		Type: "function",
+		Function: ToolFunc{
+			Name: "fetch_todos",
+			Parameters: mapActionInputToSchema(assistant.ActionInput{
+				Type: "object",
+				Fields: map[string]assistant.ActionField{
+					"page":   {Type: "integer", Required: true},
+					"status": {Type: "string", Enum: []any{"OPEN", "DONE"}},
+					"filter": {
+						Type: "object",
+						Fields: map[string]assistant.ActionField{
+							"search": {Type: "string|null"},
+						},
+					},
+				},
+			}),
+		},
+	}
+
+	tests := map[string]struct {
+		model    string
+		schema   *assistant.ResponseSchema
+		validate func(*testing.T, ChatRequest)
+	}{
+		"unconfigured-model-unchanged": {
+			model:  "llama3",
+			schema: schema,
+			validate: func(t *testing.T, req ChatRequest) {
+				assert.Nil(t, req.ResponseFormat)
+				assert.Nil(t, req.JSONSchema)
+				assert.False(t, req.Tools[0].Function.Strict)
+				assert.Equal(t, tool, req.Tools[0])
+			},
+		},
+		"grammar-sets-json-schema": {
+			model:  "ai/qwen3",
+			schema: schema,
+			validate: func(t *testing.T, req ChatRequest) {
+				assert.Nil(t, req.ResponseFormat)
+				require.NotNil(t, req.JSONSchema)
+				assert.Equal(t, "object", req.JSONSchema.Type)
+				assert.Equal(t, []string{"title"}, req.JSONSchema.Required)
+				assert.Equal(t, false, req.JSONSchema.AdditionalProperties)
+				assert.Equal(t, tool, req.Tools[0])
+			},
+		},
+		"grammar-without-schema": {
+			model: "qwen3",
+			validate: func(t *testing.T, req ChatRequest) {
+				assert.Nil(t, req.JSONSchema)
+			},
+		},
+		"response-format-strict-tools-and-schema": {
+			model:  "gpt-oss",
+			schema: schema,
+			validate: func(t *testing.T, req ChatRequest) {
+				assert.Nil(t, req.JSONSchema)
+				require.NotNil(t, req.ResponseFormat)
+				assert.Equal(t, "json_schema", req.ResponseFormat.Type)
+				assert.Equal(t, "conversation_title", req.ResponseFormat.JSONSchema.Name)
+				assert.True(t, req.ResponseFormat.JSONSchema.Strict)
+				assert.Equal(t, []string{"title"}, req.ResponseFormat.JSONSchema.Schema.Required)
+
+				params := req.Tools[0].Function.Parameters
+				assert.True(t, req.Tools[0].Function.Strict)
+				assert.Equal(t, []string{"filter", "page", "status"}, params.Required)
+				assert.Equal(t, false, params.AdditionalProperties)
+				assert.Equal(t, "integer", params.Properties["page"].Type)
+				assert.Equal(t, []string{"string", "null"}, params.Properties["status"].Type)
+				assert.Equal(t, []any{"OPEN", "DONE", nil}, params.Properties["status"].Enum)
+				assert.Equal(t, []string{"object", "null"}, params.Properties["filter"].Type)
+				assert.Equal(t, []string{"search"}, params.Properties["filter"].Required)
+				assert.Equal(t, []string{"string", "null"}, params.Properties["filter"].Properties["search"].Type)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := ChatRequest{Model: tt.model, Tools: []Tool{tool}}
+			modes.Apply(&req, tt.schema)
+			tt.validate(t, req)
+		})
+	}
+
+	// The original tool schema must not be mutated by strict mode.
+	assert.Equal(t, []string{"page"}, tool.Function.Parameters.Required)
+}
@@ -0,0 +1,57 @@
+package modelrunner
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// ContentModerator implements the assistant.Moderator interface using the moderations endpoint
+// of an OpenAICompatClient.
+type ContentModerator struct {
+	client OpenAICompatClient
+	model  string
+}
+
+// NewContentModerator creates a new ContentModerator. An empty model lets the provider pick its default.
+func NewContentModerator(client OpenAICompatClient, model string) ContentModerator {
+	return ContentModerator{
+		client: client,
+		model:  model,
+	}
+}
+
+// Moderate implements assistant.Moderator.Moderate.
+func (m ContentModerator) Moderate(ctx context.Context, content string) (assistant.ModerationResult, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	resp, err := m.client.Moderations(spanCtx, ModerationsRequest{
+		Model: m.model,
+		Input: content,
+	})
+	if err == nil && len(resp.Results) == 0 {
+		err = errors.New("moderation response has no results")
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.ModerationResult{}, err
+	}
+
+	result := assistant.ModerationResult{}
+	for _, r := range resp.Results {
+		if !r.Flagged {
+			continue
+		}
+		result.Flagged = true
+		for category, flagged := range r.Categories {
+			if flagged && !slices.Contains(result.Categories, category) {
+				result.Categories = append(result.Categories, category)
+			}
+		}
+	}
+	slices.Sort(result.Categories)
+	return result, nil
+}
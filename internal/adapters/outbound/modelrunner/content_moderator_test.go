@@ -0,0 +1,92 @@
+package modelrunner
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentModerator_Moderate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		response   string
+		statusCode int
+		model      string
+		expected   assistant.ModerationResult
+		expectErr  bool
+	}{
+		"flagged": {
+			statusCode: http.StatusOK,
+			model:      "omni-moderation-latest",
+			response: `{
+                "id": "modr-1",
+                "model": "omni-moderation-latest",
+                "results": [{
+                    "flagged": true,
+                    "categories": {"violence": true, "harassment": true, "sexual": false}
+                }]
+            }`,
+			expected: assistant.ModerationResult{
+				Flagged:    true,
+				Categories: []string{"harassment", "violence"},
+			},
+		},
+		"not-flagged": {
+			statusCode: http.StatusOK,
+			response: `{
+                "id": "modr-2",
+                "results": [{"flagged": false, "categories": {"violence": false}}]
+            }`,
+			expected: assistant.ModerationResult{},
+		},
+		"no-results": {
+			statusCode: http.StatusOK,
+			response:   `{"id": "modr-3", "results": []}`,
+			expectErr:  true,
+		},
+		"server-error": {
+			statusCode: http.StatusInternalServerError,
+			response:   "Internal Server Error",
+			expectErr:  true,
+		},
+		"invalid-json": {
+			statusCode: http.StatusOK,
+			response:   `{invalid json}`,
+			expectErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/moderations", r.URL.Path)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+				var req ModerationsRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "some user input", req.Input)
+				assert.Equal(t, tt.model, req.Model)
+
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.response)) //nolint:errcheck
+			}))
+			defer server.Close()
+
+			moderator := NewContentModerator(NewOpenAICompatClient(server.URL, "secret", server.Client()), tt.model)
+
+			got, err := moderator.Moderate(t.Context(), "some user input")
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package modelrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// contextTooLongMarkers are lowercase fragments OpenAI-compatible servers use to report an oversized prompt.
+var contextTooLongMarkers = []string{
+	"context_length_exceeded",
+	"exceed_context_size",
+	"context length",
+	"maximum context",
+	"context window",
+}
+
+// StatusError is returned when the API responds with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       string
+	// RetryAfter is parsed from the Retry-After header, zero when absent.
+	RetryAfter time.Duration
+}
+
+// Error returns the error message.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("non-2xx response: %s: %s", e.Status, e.Body)
+}
+
+// TransportError is returned when the API could not be reached or the response stream was interrupted.
+type TransportError struct {
+	Op  string
+	Err error
+}
+
+// Error returns the error message.
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Op, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// newStatusError builds a StatusError from a non-2xx response and its already read body.
+func newStatusError(resp *http.Response, body []byte) *StatusError {
+	return &StatusError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now).Round(time.Second), 0)
+	}
+	return 0
+}
+
+// toTurnError classifies chat completion failures into assistant.TurnError.
+// Errors caused by the caller canceling the request and unrecognized errors are returned unchanged.
+func toTurnError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch {
+		case statusErr.StatusCode == http.StatusTooManyRequests:
+			return assistant.NewTurnError(assistant.TurnErrorCode_RateLimited, statusErr.RetryAfter, err)
+		case statusErr.StatusCode == http.StatusRequestEntityTooLarge,
+			statusErr.StatusCode == http.StatusBadRequest && isContextTooLong(statusErr.Body):
+			return assistant.NewTurnError(assistant.TurnErrorCode_ContextTooLong, 0, err)
+		case statusErr.StatusCode == http.StatusBadGateway,
+			statusErr.StatusCode == http.StatusServiceUnavailable,
+			statusErr.StatusCode == http.StatusGatewayTimeout:
+			return assistant.NewTurnError(assistant.TurnErrorCode_Network, statusErr.RetryAfter, err)
+		}
+		return err
+	}
+
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) {
+		return assistant.NewTurnError(assistant.TurnErrorCode_Network, 0, err)
+	}
+
+	return err
+}
+
+// isContextTooLong reports whether an error response body describes an oversized prompt.
+func isContextTooLong(body string) bool {
+	body = strings.ToLower(body)
+	for _, marker := range contextTooLongMarkers {
+		if strings.Contains(body, marker) {
+			return true
+		}
+	}
+	return false
+}
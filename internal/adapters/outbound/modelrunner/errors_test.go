@@ -0,0 +1,110 @@
+package modelrunner
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTurnError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err           error
+		canceled      bool
+		wantCode      assistant.TurnErrorCode
+		wantRetry     time.Duration
+		wantUnchanged bool
+	}{
+		"rate-limited": {
+			err:       &StatusError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests", RetryAfter: 3 * time.Second},
+			wantCode:  assistant.TurnErrorCode_RateLimited,
+			wantRetry: 3 * time.Second,
+		},
+		"context-too-long-bad-request": {
+			err:      &StatusError{StatusCode: http.StatusBadRequest, Body: `{"error":{"type":"exceed_context_size_error"}}`},
+			wantCode: assistant.TurnErrorCode_ContextTooLong,
+		},
+		"context-too-long-entity-too-large": {
+			err:      &StatusError{StatusCode: http.StatusRequestEntityTooLarge},
+			wantCode: assistant.TurnErrorCode_ContextTooLong,
+		},
+		"service-unavailable": {
+			err:      &StatusError{StatusCode: http.StatusServiceUnavailable},
+			wantCode: assistant.TurnErrorCode_Network,
+		},
+		"transport": {
+			err:      &TransportError{Op: "http do", Err: errors.New("connection refused")},
+			wantCode: assistant.TurnErrorCode_Network,
+		},
+		"other-bad-request-unchanged": {
+			err:           &StatusError{StatusCode: http.StatusBadRequest, Body: "invalid model"},
+			wantUnchanged: true,
+		},
+		"internal-server-error-unchanged": {
+			err:           &StatusError{StatusCode: http.StatusInternalServerError},
+			wantUnchanged: true,
+		},
+		"callback-error-unchanged": {
+			err:           errors.New("onEvent error"),
+			wantUnchanged: true,
+		},
+		"canceled-unchanged": {
+			err:           &TransportError{Op: "http do", Err: context.Canceled},
+			canceled:      true,
+			wantUnchanged: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			if tt.canceled {
+				cancel()
+			}
+
+			got := toTurnError(ctx, tt.err)
+			if tt.wantUnchanged {
+				assert.Equal(t, tt.err, got)
+				return
+			}
+
+			var turnErr *assistant.TurnError
+			if assert.ErrorAs(t, got, &turnErr) {
+				assert.Equal(t, tt.wantCode, turnErr.Code)
+				assert.Equal(t, tt.wantRetry, turnErr.RetryAfter)
+				assert.Equal(t, tt.err.Error(), turnErr.Error())
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 2, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		value string
+		want  time.Duration
+	}{
+		"empty":       {value: "", want: 0},
+		"seconds":     {value: "7", want: 7 * time.Second},
+		"negative":    {value: "-3", want: 0},
+		"http-date":   {value: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second},
+		"past-date":   {value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		"unparseable": {value: "soon", want: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.value, now))
+		})
+	}
+}
@@ -2,6 +2,8 @@ package modelrunner
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
@@ -11,15 +13,41 @@ import (
 
 // InitAssistantClient initializes assistant/chat-model dependencies.
 type InitAssistantClient struct {
-	HttpClient *http.Client `resolve:"streaming"`
-	ModelHost  string       `config:"LLM_MODEL_HOST"`
-	APIKey     string       `config:"LLM_API_KEY" default:""`
+	HttpClient           *http.Client `resolve:"streaming"`
+	ModelHost            string       `config:"LLM_MODEL_HOST"`
+	APIKey               string       `config:"LLM_API_KEY" default:""`
+	MaxOutputTokens      int          `config:"LLM_MAX_OUTPUT_TOKENS" default:"4096"`
+	ModelMaxOutputTokens string       `config:"LLM_MODEL_MAX_OUTPUT_TOKENS" default:""`
+	ToolEmulationModels  string       `config:"LLM_TOOL_EMULATION_MODELS" default:""`
+	ConstrainedDecoding  string       `config:"LLM_CONSTRAINED_DECODING_MODELS" default:""`
+	MaxHistoryBytes      int          `config:"LLM_MAX_REQUEST_HISTORY_BYTES" default:"1048576"`
+	MaxTools             int          `config:"LLM_MAX_REQUEST_TOOLS" default:"128"`
+	MaxResponseBytes     int          `config:"LLM_MAX_RESPONSE_BYTES" default:"262144"`
 }
 
 // Initialize creates and registers assistant/model-catalog interfaces in the dependency container.
 func (i InitAssistantClient) Initialize(ctx context.Context) (context.Context, error) {
+	outputLimits, err := ParseModelOutputLimits(i.MaxOutputTokens, i.ModelMaxOutputTokens)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse model output limits: %w", err)
+	}
+	constrainedDecoding, err := ParseConstrainedDecoding(i.ConstrainedDecoding)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse constrained decoding models: %w", err)
+	}
+	if i.MaxHistoryBytes < 0 || i.MaxTools < 0 || i.MaxResponseBytes < 0 {
+		return ctx, errors.New("invalid LLM request limits: limits must not be negative")
+	}
 	adapter := NewAssistantClient(
 		NewOpenAICompatClient(i.ModelHost, i.APIKey, i.HttpClient),
+		outputLimits,
+		ParseModelSet(i.ToolEmulationModels),
+		constrainedDecoding,
+		RequestLimits{
+			MaxHistoryBytes:  i.MaxHistoryBytes,
+			MaxTools:         i.MaxTools,
+			MaxResponseBytes: i.MaxResponseBytes,
+		},
 	)
 	depend.Register[assistant.Assistant](adapter)
 	depend.Register[assistant.ModelCatalog](adapter)
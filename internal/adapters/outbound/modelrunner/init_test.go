@@ -26,6 +26,33 @@ func TestInitAssistantClient_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestInitAssistantClient_Initialize_InvalidOutputLimits(t *testing.T) {
+	t.Parallel()
+
+	i := InitAssistantClient{ModelMaxOutputTokens: "qwen3=lots"}
+
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+}
+
+func TestInitAssistantClient_Initialize_InvalidConstrainedDecoding(t *testing.T) {
+	t.Parallel()
+
+	i := InitAssistantClient{ConstrainedDecoding: "qwen3=regex"}
+
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+}
+
+func TestInitAssistantClient_Initialize_NegativeRequestLimits(t *testing.T) {
+	t.Parallel()
+
+	i := InitAssistantClient{MaxResponseBytes: -1}
+
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+}
+
 func TestInitEncoderClient_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,49 @@
+package modelrunner
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ModelOutputLimits bounds the tokens each model may be asked to generate in one response.
+type ModelOutputLimits struct {
+	// Default applies to models without an explicit limit. Zero means unknown.
+	Default int
+	// PerModel maps a model ID or short name to its limit.
+	PerModel map[string]int
+}
+
+// For returns the output limit of a model, looked up by ID first and then by short name.
+func (l ModelOutputLimits) For(modelID, name string) int {
+	if limit, ok := l.PerModel[modelID]; ok {
+		return limit
+	}
+	if limit, ok := l.PerModel[name]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+// ParseModelOutputLimits builds ModelOutputLimits from a default limit and a comma-separated
+// list of model=tokens overrides, e.g. "qwen3=8192,docker.io/ai/llama3=2048".
+func ParseModelOutputLimits(defaultLimit int, overrides string) (ModelOutputLimits, error) {
+	limits := ModelOutputLimits{Default: defaultLimit, PerModel: map[string]int{}}
+	for entry := range strings.SplitSeq(overrides, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		model, rawLimit, ok := strings.Cut(entry, "=")
+		model = strings.TrimSpace(model)
+		if !ok || model == "" {
+			return ModelOutputLimits{}, fmt.Errorf("invalid model output limit %q: expected model=tokens", entry)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(rawLimit))
+		if err != nil || limit <= 0 {
+			return ModelOutputLimits{}, fmt.Errorf("invalid model output limit %q: tokens must be a positive integer", entry)
+		}
+		limits.PerModel[model] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,71 @@
+package modelrunner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseModelOutputLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		defaultLimit int
+		overrides    string
+		expected     ModelOutputLimits
+		expectErr    bool
+	}{
+		"default-only": {
+			defaultLimit: 4096,
+			expected:     ModelOutputLimits{Default: 4096, PerModel: map[string]int{}},
+		},
+		"with-overrides": {
+			defaultLimit: 4096,
+			overrides:    " qwen3=8192 , docker.io/ai/llama3=2048,",
+			expected: ModelOutputLimits{
+				Default:  4096,
+				PerModel: map[string]int{"qwen3": 8192, "docker.io/ai/llama3": 2048},
+			},
+		},
+		"missing-separator": {
+			overrides: "qwen3",
+			expectErr: true,
+		},
+		"invalid-tokens": {
+			overrides: "qwen3=many",
+			expectErr: true,
+		},
+		"non-positive-tokens": {
+			overrides: "qwen3=0",
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseModelOutputLimits(tt.defaultLimit, tt.overrides)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestModelOutputLimits_For(t *testing.T) {
+	t.Parallel()
+
+	limits := ModelOutputLimits{
+		Default:  4096,
+		PerModel: map[string]int{"docker.io/ai/llama3": 2048, "qwen3": 8192},
+	}
+
+	assert.Equal(t, 2048, limits.For("docker.io/ai/llama3", "llama3"))
+	assert.Equal(t, 8192, limits.For("docker.io/ai/qwen3", "qwen3"))
+	assert.Equal(t, 4096, limits.For("gpt-4o-mini", "gpt-4o-mini"))
+	assert.Equal(t, 0, ModelOutputLimits{}.For("gpt-4o-mini", "gpt-4o-mini"))
+}
@@ -0,0 +1,95 @@
+package modelrunner
+
+import "strings"
+
+const (
+	reasoningOpenTag  = "<think>"
+	reasoningCloseTag = "</think>"
+)
+
+// reasoningSplitter separates inline <think> reasoning blocks (e.g. qwen3) from streamed content.
+// Text that may be the beginning of a tag split across chunks is held back until the next chunk.
+type reasoningSplitter struct {
+	inReasoning    bool
+	afterReasoning bool
+	pending        string
+}
+
+// Split consumes one streamed content chunk and returns its visible content and reasoning parts.
+func (s *reasoningSplitter) Split(text string) (string, string) {
+	var content, reasoning strings.Builder
+	buf := s.pending + text
+	s.pending = ""
+
+	for buf != "" {
+		tag := reasoningOpenTag
+		if s.inReasoning {
+			tag = reasoningCloseTag
+		}
+
+		segment := buf
+		idx := strings.Index(buf, tag)
+		if idx >= 0 {
+			segment = buf[:idx]
+			buf = buf[idx+len(tag):]
+		} else {
+			keep := partialTagSuffixLen(buf, tag)
+			segment = buf[:len(buf)-keep]
+			s.pending = buf[len(buf)-keep:]
+			buf = ""
+		}
+
+		if s.inReasoning {
+			reasoning.WriteString(segment)
+		} else {
+			content.WriteString(s.trimAfterReasoning(segment))
+		}
+
+		if idx >= 0 {
+			s.inReasoning = !s.inReasoning
+			s.afterReasoning = !s.inReasoning
+		}
+	}
+
+	return content.String(), reasoning.String()
+}
+
+// Flush releases any held back text once the stream has ended.
+func (s *reasoningSplitter) Flush() (string, string) {
+	pending := s.pending
+	s.pending = ""
+	if s.inReasoning {
+		return "", pending
+	}
+	return s.trimAfterReasoning(pending), ""
+}
+
+// trimAfterReasoning drops the whitespace models emit between a reasoning block and the answer.
+func (s *reasoningSplitter) trimAfterReasoning(segment string) string {
+	if !s.afterReasoning {
+		return segment
+	}
+	segment = strings.TrimLeft(segment, " \t\r\n")
+	if segment != "" {
+		s.afterReasoning = false
+	}
+	return segment
+}
+
+// partialTagSuffixLen returns the length of the longest suffix of text that is a proper prefix of tag.
+func partialTagSuffixLen(text, tag string) int {
+	for n := min(len(text), len(tag)-1); n > 0; n-- {
+		if strings.HasSuffix(text, tag[:n]) {
+			return n
+		}
+	}
+	return 0
+}
+
+// splitReasoning separates reasoning blocks from a complete (non-streamed) model response.
+func splitReasoning(text string) (string, string) {
+	var s reasoningSplitter
+	content, reasoning := s.Split(text)
+	restContent, restReasoning := s.Flush()
+	return content + restContent, reasoning + restReasoning
+}
@@ -0,0 +1,76 @@
+package modelrunner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReasoningSplitter_Split(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		chunks            []string
+		expectedContent   string
+		expectedReasoning string
+	}{
+		"plain-content": {
+			chunks:          []string{"Hello", " world"},
+			expectedContent: "Hello world",
+		},
+		"reasoning-block-in-one-chunk": {
+			chunks:            []string{"<think>Check overdue todos.</think>\n\nYou have 2 overdue todos."},
+			expectedContent:   "You have 2 overdue todos.",
+			expectedReasoning: "Check overdue todos.",
+		},
+		"tags-split-across-chunks": {
+			chunks:            []string{"<th", "ink>Check ", "overdue</th", "ink>", "\n\n", "Done."},
+			expectedContent:   "Done.",
+			expectedReasoning: "Check overdue",
+		},
+		"partial-tag-that-is-content": {
+			chunks:          []string{"a <", "b"},
+			expectedContent: "a <b",
+		},
+		"unterminated-reasoning": {
+			chunks:            []string{"<think>Still thinking", " <"},
+			expectedReasoning: "Still thinking <",
+		},
+		"content-before-reasoning": {
+			chunks:            []string{"Sure. <think>plan</think> Here you go."},
+			expectedContent:   "Sure. Here you go.",
+			expectedReasoning: "plan",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				splitter           reasoningSplitter
+				content, reasoning strings.Builder
+			)
+			for _, chunk := range tt.chunks {
+				c, r := splitter.Split(chunk)
+				content.WriteString(c)
+				reasoning.WriteString(r)
+			}
+			c, r := splitter.Flush()
+			content.WriteString(c)
+			reasoning.WriteString(r)
+
+			assert.Equal(t, tt.expectedContent, content.String())
+			assert.Equal(t, tt.expectedReasoning, reasoning.String())
+		})
+	}
+}
+
+func TestSplitReasoning(t *testing.T) {
+	t.Parallel()
+
+	content, reasoning := splitReasoning("<think>\nPick a short title.\n</think>\n\nGrocery run")
+	assert.Equal(t, "Grocery run", content)
+	assert.Equal(t, "\nPick a short title.\n", reasoning)
+}
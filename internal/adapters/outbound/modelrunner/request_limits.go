@@ -0,0 +1,68 @@
+package modelrunner
+
+import (
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// RequestLimits caps the size of the requests sent to the model host and of the responses read from it,
+// so pathological prompts, such as one built from huge imported todos or notes, never reach the model host.
+// A zero limit disables that cap.
+type RequestLimits struct {
+	// MaxHistoryBytes caps the message contents and action call inputs of one request.
+	MaxHistoryBytes int
+	// MaxTools caps the action definitions of one request.
+	MaxTools int
+	// MaxResponseBytes caps the content, reasoning and action call inputs of one response.
+	MaxResponseBytes int
+}
+
+// Check rejects a request over the history or action definition limits. An oversized history fails with
+// the context too long code, so the turn is retried once with a trimmed history.
+func (l RequestLimits) Check(req ChatRequest) error {
+	if l.MaxTools > 0 && len(req.Tools) > l.MaxTools {
+		return assistant.NewTurnError(assistant.TurnErrorCode_RequestTooLarge, 0, fmt.Errorf(
+			"request has %d action definitions, above the limit of %d", len(req.Tools), l.MaxTools,
+		))
+	}
+	if l.MaxHistoryBytes > 0 {
+		if size := historyBytes(req.Messages); size > l.MaxHistoryBytes {
+			return assistant.NewTurnError(assistant.TurnErrorCode_ContextTooLong, 0, fmt.Errorf(
+				"request history has %d bytes, above the limit of %d", size, l.MaxHistoryBytes,
+			))
+		}
+	}
+	return nil
+}
+
+// CheckResponse rejects a response of size bytes over the response limit.
+func (l RequestLimits) CheckResponse(size int) error {
+	if l.MaxResponseBytes > 0 && size > l.MaxResponseBytes {
+		return assistant.NewTurnError(assistant.TurnErrorCode_ResponseTooLong, 0, fmt.Errorf(
+			"response exceeded the limit of %d bytes", l.MaxResponseBytes,
+		))
+	}
+	return nil
+}
+
+// historyBytes returns the bytes of the message contents and action call inputs of messages.
+func historyBytes(messages []ChatMessage) int {
+	size := 0
+	for _, msg := range messages {
+		size += len(msg.Content)
+		for _, call := range msg.ToolCalls {
+			size += len(call.Function.Arguments)
+		}
+	}
+	return size
+}
+
+// responseBytes returns the bytes of the content and action call inputs of a response message.
+func responseBytes(msg Message) int {
+	size := len(msg.Content)
+	for _, call := range msg.ToolCalls {
+		size += len(call.Function.Arguments)
+	}
+	return size
+}
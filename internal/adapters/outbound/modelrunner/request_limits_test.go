@@ -0,0 +1,74 @@
+package modelrunner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimits_Check(t *testing.T) {
+	t.Parallel()
+
+	req := ChatRequest{
+		Model: "test-model",
+		Messages: []ChatMessage{
+			{Role: "user", Content: strings.Repeat("a", 10)},
+			{Role: "assistant", ToolCalls: []ToolCall{{Function: ToolCallFunction{Name: "fetch_todos", Arguments: `{"page":1}`}}}},
+		},
+		Tools: []Tool{{Type: "function"}, {Type: "function"}},
+	}
+
+	tests := map[string]struct {
+		limits   RequestLimits
+		wantCode assistant.TurnErrorCode
+		wantErr  string
+	}{
+		"no-limits": {},
+		"within-limits": {
+			limits: RequestLimits{MaxHistoryBytes: 20, MaxTools: 2},
+		},
+		"history-too-large": {
+			limits:   RequestLimits{MaxHistoryBytes: 19},
+			wantCode: assistant.TurnErrorCode_ContextTooLong,
+			wantErr:  "request history has 20 bytes, above the limit of 19",
+		},
+		"too-many-tools": {
+			limits:   RequestLimits{MaxTools: 1},
+			wantCode: assistant.TurnErrorCode_RequestTooLarge,
+			wantErr:  "request has 2 action definitions, above the limit of 1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.limits.Check(req)
+			if tt.wantCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var turnErr *assistant.TurnError
+			require.ErrorAs(t, err, &turnErr)
+			assert.Equal(t, tt.wantCode, turnErr.Code)
+			assert.False(t, turnErr.Retriable())
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRequestLimits_CheckResponse(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, RequestLimits{}.CheckResponse(1<<20))
+	assert.NoError(t, RequestLimits{MaxResponseBytes: 10}.CheckResponse(10))
+
+	err := RequestLimits{MaxResponseBytes: 10}.CheckResponse(11)
+	var turnErr *assistant.TurnError
+	require.ErrorAs(t, err, &turnErr)
+	assert.Equal(t, assistant.TurnErrorCode_ResponseTooLong, turnErr.Code)
+	assert.EqualError(t, err, "response exceeded the limit of 10 bytes")
+}
@@ -0,0 +1,234 @@
+package modelrunner
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+)
+
+const (
+	toolCallFenceOpen  = "```tool_call"
+	toolCallFenceClose = "```"
+)
+
+// toolEmulationPrompt instructs models without native function calling to request tools through fenced JSON blocks.
+const toolEmulationPrompt = `You can call tools. Each available tool is described by one JSON object:
+%s
+
+To call a tool, reply with a fenced block in exactly this format and stop writing after it:
+` + toolCallFenceOpen + `
+{"name": "<tool name>", "arguments": {<tool arguments>}}
+` + toolCallFenceClose + `
+Use one block per tool call. Tool results are sent back to you in a user message starting with "Tool result".
+When no tool is needed, answer normally without any tool_call block.`
+
+// ModelSet is a set of model IDs or short names.
+type ModelSet map[string]struct{}
+
+// ParseModelSet builds a ModelSet from a comma-separated list of model IDs or short names.
+func ParseModelSet(list string) ModelSet {
+	models := ModelSet{}
+	for model := range strings.SplitSeq(list, ",") {
+		if model = strings.TrimSpace(model); model != "" {
+			models[model] = struct{}{}
+		}
+	}
+	return models
+}
+
+// Contains reports whether the model is in the set, looked up by ID first and then by short name.
+func (s ModelSet) Contains(modelID string) bool {
+	if _, ok := s[modelID]; ok {
+		return true
+	}
+	_, ok := s[modelShortName(modelID)]
+	return ok
+}
+
+// modelShortName returns the last path segment of a model ID, e.g. "qwen3" for "ai/qwen3".
+func modelShortName(modelID string) string {
+	nameParts := strings.Split(modelID, "/")
+	return nameParts[len(nameParts)-1]
+}
+
+// emulateToolCalling rewrites a request for models without native function calling. Tool schemas move
+// into a system prompt, and earlier tool calls and results are replayed as plain text messages.
+func emulateToolCalling(req ChatRequest) (ChatRequest, error) {
+	if len(req.Tools) == 0 {
+		return req, nil
+	}
+
+	toolSchemas := make([]string, 0, len(req.Tools))
+	for _, tool := range req.Tools {
+		schema, err := json.Marshal(tool.Function)
+		if err != nil {
+			return ChatRequest{}, fmt.Errorf("marshal tool schema %s: %w", tool.Function.Name, err)
+		}
+		toolSchemas = append(toolSchemas, string(schema))
+	}
+	promptMsg := ChatMessage{
+		Role:    string(assistant.ChatRole_System),
+		Content: fmt.Sprintf(toolEmulationPrompt, strings.Join(toolSchemas, "\n")),
+	}
+
+	messages := make([]ChatMessage, 0, len(req.Messages)+1)
+	promptInserted := false
+	for _, msg := range req.Messages {
+		if !promptInserted && msg.Role != string(assistant.ChatRole_System) && msg.Role != string(assistant.ChatRole_Developer) {
+			messages = append(messages, promptMsg)
+			promptInserted = true
+		}
+		messages = append(messages, toEmulatedMessage(msg))
+	}
+	if !promptInserted {
+		messages = append(messages, promptMsg)
+	}
+
+	req.Messages = messages
+	req.Tools = nil
+	return req, nil
+}
+
+// toEmulatedMessage renders native tool calls and tool results as plain text.
+func toEmulatedMessage(msg ChatMessage) ChatMessage {
+	if msg.Role == string(assistant.ChatRole_Tool) {
+		callID := ""
+		if msg.ToolCallID != nil {
+			callID = " " + *msg.ToolCallID
+		}
+		return ChatMessage{
+			Role:    string(assistant.ChatRole_User),
+			Content: fmt.Sprintf("Tool result%s:\n%s", callID, msg.Content),
+		}
+	}
+	if len(msg.ToolCalls) == 0 {
+		return msg
+	}
+
+	var content strings.Builder
+	content.WriteString(msg.Content)
+	for _, call := range msg.ToolCalls {
+		if content.Len() > 0 {
+			content.WriteString("\n")
+		}
+		arguments := strings.TrimSpace(call.Function.Arguments)
+		if arguments == "" {
+			arguments = "{}"
+		}
+		fmt.Fprintf(&content, "%s\n{\"name\": %q, \"arguments\": %s}\n%s", toolCallFenceOpen, call.Function.Name, arguments, toolCallFenceClose)
+	}
+	return ChatMessage{
+		Role:    msg.Role,
+		Content: content.String(),
+	}
+}
+
+// emulatedToolCall is the JSON body of a fenced tool_call block.
+type emulatedToolCall struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+// toolCallParser extracts fenced tool_call blocks from streamed content.
+// Text that may be the beginning of a fence split across chunks is held back until the next chunk.
+type toolCallParser struct {
+	inCall  bool
+	pending string
+	body    strings.Builder
+}
+
+// Split consumes one streamed content chunk and returns its visible content and the completed tool calls.
+func (p *toolCallParser) Split(text string) (string, []assistant.ActionCall) {
+	var (
+		content strings.Builder
+		calls   []assistant.ActionCall
+	)
+	buf := p.pending + text
+	p.pending = ""
+
+	for buf != "" {
+		fence := toolCallFenceOpen
+		if p.inCall {
+			fence = toolCallFenceClose
+		}
+
+		segment := buf
+		idx := strings.Index(buf, fence)
+		if idx >= 0 {
+			segment = buf[:idx]
+			buf = buf[idx+len(fence):]
+		} else {
+			keep := partialTagSuffixLen(buf, fence)
+			segment = buf[:len(buf)-keep]
+			p.pending = buf[len(buf)-keep:]
+			buf = ""
+		}
+
+		if !p.inCall {
+			content.WriteString(segment)
+			if idx >= 0 {
+				p.inCall = true
+			}
+			continue
+		}
+
+		p.body.WriteString(segment)
+		if idx >= 0 {
+			call, ok := p.closeCall()
+			if ok {
+				calls = append(calls, call)
+			} else {
+				content.WriteString(toolCallFenceOpen + p.body.String() + toolCallFenceClose)
+			}
+			p.body.Reset()
+		}
+	}
+
+	return content.String(), calls
+}
+
+// Flush releases held back text once the stream has ended. An unterminated tool_call block
+// is still accepted when its body is a valid call, since models often stop right before the closing fence.
+func (p *toolCallParser) Flush() (string, []assistant.ActionCall) {
+	pending := p.pending
+	p.pending = ""
+	if !p.inCall {
+		return pending, nil
+	}
+
+	p.body.WriteString(pending)
+	defer p.body.Reset()
+	if call, ok := p.closeCall(); ok {
+		return "", []assistant.ActionCall{call}
+	}
+	return toolCallFenceOpen + p.body.String(), nil
+}
+
+// closeCall parses the buffered block body into an action call and leaves the block.
+func (p *toolCallParser) closeCall() (assistant.ActionCall, bool) {
+	p.inCall = false
+
+	var parsed emulatedToolCall
+	if err := json.Unmarshal([]byte(strings.TrimSpace(p.body.String())), &parsed); err != nil || parsed.Name == "" {
+		return assistant.ActionCall{}, false
+	}
+
+	input := strings.TrimSpace(string(parsed.Arguments))
+	var encoded string
+	if err := json.Unmarshal(parsed.Arguments, &encoded); err == nil {
+		// Some models encode the arguments object as a JSON string.
+		input = encoded
+	}
+	if input == "" || input == "null" {
+		input = "{}"
+	}
+
+	return assistant.ActionCall{
+		ID:    "call_" + uuid.NewString(),
+		Name:  parsed.Name,
+		Input: input,
+	}, true
+}
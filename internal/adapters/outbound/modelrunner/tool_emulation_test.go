@@ -0,0 +1,171 @@
+package modelrunner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelSet_Contains(t *testing.T) {
+	t.Parallel()
+
+	models := ParseModelSet(" gemma3 , docker.io/ai/smollm2,,")
+
+	tests := map[string]struct {
+		model string
+		want  bool
+	}{
+		"short-name":         {model: "gemma3", want: true},
+		"id-by-short-name":   {model: "ai/gemma3", want: true},
+		"full-id":            {model: "docker.io/ai/smollm2", want: true},
+		"other-registry-id":  {model: "ai/smollm2", want: false},
+		"unknown-model":      {model: "qwen3", want: false},
+		"empty-entries-skip": {model: "", want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, models.Contains(tt.model))
+		})
+	}
+}
+
+func TestEmulateToolCalling(t *testing.T) {
+	t.Parallel()
+
+	req := ChatRequest{
+		Model: "gemma3",
+		Messages: []ChatMessage{
+			{Role: "system", Content: "You are a todo assistant."},
+			{Role: "user", Content: "List my todos"},
+			{Role: "assistant", ToolCalls: []ToolCall{{
+				ID:       "call_1",
+				Type:     "function",
+				Function: ToolCallFunction{Name: "fetch_todos", Arguments: `{"page":1}`},
+			}}},
+			{Role: "tool", ToolCallID: common.Ptr("call_1"), Content: "[]"},
+		},
+		Tools: []Tool{{
+			Type: "function",
+			Function: ToolFunc{
+				Name:        "fetch_todos",
+				Description: "Fetch todos",
+				Parameters:  ToolFuncParameters{Type: "object"},
+			},
+		}},
+	}
+
+	got, err := emulateToolCalling(req)
+	require.NoError(t, err)
+
+	assert.Nil(t, got.Tools)
+	require.Len(t, got.Messages, 5)
+	assert.Equal(t, req.Messages[0], got.Messages[0])
+
+	assert.Equal(t, "system", got.Messages[1].Role)
+	assert.Contains(t, got.Messages[1].Content, `{"description":"Fetch todos","name":"fetch_todos","parameters":{"type":"object"}}`)
+	assert.Contains(t, got.Messages[1].Content, toolCallFenceOpen)
+
+	assert.Equal(t, req.Messages[1], got.Messages[2])
+	assert.Equal(t, ChatMessage{
+		Role:    "assistant",
+		Content: "```tool_call\n{\"name\": \"fetch_todos\", \"arguments\": {\"page\":1}}\n```",
+	}, got.Messages[3])
+	assert.Equal(t, ChatMessage{
+		Role:    "user",
+		Content: "Tool result call_1:\n[]",
+	}, got.Messages[4])
+}
+
+func TestEmulateToolCalling_WithoutTools(t *testing.T) {
+	t.Parallel()
+
+	req := ChatRequest{
+		Model:    "gemma3",
+		Messages: []ChatMessage{{Role: "user", Content: "Hello"}},
+	}
+
+	got, err := emulateToolCalling(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, got)
+}
+
+func TestToolCallParser_Split(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		chunks          []string
+		expectedContent string
+		expectedCalls   []assistant.ActionCall
+	}{
+		"plain-content": {
+			chunks:          []string{"Hello", " world"},
+			expectedContent: "Hello world",
+		},
+		"tool-call-in-one-chunk": {
+			chunks: []string{"Let me check.\n```tool_call\n{\"name\": \"fetch_todos\", \"arguments\": {\"page\": 1}}\n```"},
+			expectedCalls: []assistant.ActionCall{
+				{Name: "fetch_todos", Input: `{"page": 1}`},
+			},
+			expectedContent: "Let me check.\n",
+		},
+		"fences-split-across-chunks": {
+			chunks: []string{"``", "`tool", "_call\n{\"name\": \"fetch_todos\",", " \"arguments\": {}}\n`", "``", " done"},
+			expectedCalls: []assistant.ActionCall{
+				{Name: "fetch_todos", Input: "{}"},
+			},
+			expectedContent: " done",
+		},
+		"string-encoded-arguments": {
+			chunks: []string{"```tool_call\n{\"name\": \"fetch_todos\", \"arguments\": \"{\\\"page\\\":2}\"}\n```"},
+			expectedCalls: []assistant.ActionCall{
+				{Name: "fetch_todos", Input: `{"page":2}`},
+			},
+		},
+		"unterminated-call-at-end-of-stream": {
+			chunks: []string{"```tool_call\n{\"name\": \"fetch_todos\"}\n"},
+			expectedCalls: []assistant.ActionCall{
+				{Name: "fetch_todos", Input: "{}"},
+			},
+		},
+		"invalid-block-kept-as-content": {
+			chunks:          []string{"```tool_call\nnot json\n``` after"},
+			expectedContent: "```tool_call\nnot json\n``` after",
+		},
+		"other-code-fence-is-content": {
+			chunks:          []string{"```go\nfmt.Println()\n", "```"},
+			expectedContent: "```go\nfmt.Println()\n```",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				parser  toolCallParser
+				content strings.Builder
+				calls   []assistant.ActionCall
+			)
+			for _, chunk := range tt.chunks {
+				c, cs := parser.Split(chunk)
+				content.WriteString(c)
+				calls = append(calls, cs...)
+			}
+			c, cs := parser.Flush()
+			content.WriteString(c)
+			calls = append(calls, cs...)
+
+			assert.Equal(t, tt.expectedContent, content.String())
+			for i := range calls {
+				assert.True(t, strings.HasPrefix(calls[i].ID, "call_"))
+				calls[i].ID = ""
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
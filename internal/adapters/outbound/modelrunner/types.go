@@ -9,8 +9,28 @@ type ChatRequest struct {
 	Temperature      *float64       `json:"temperature,omitempty"`
 	MaxTokens        *int           `json:"max_tokens,omitempty"`
 	TopP             *float64       `json:"top_p,omitempty"`
+	Stop             []string       `json:"stop,omitempty"`
+	PresencePenalty  *float64       `json:"presence_penalty,omitempty"`
 	FrequencyPenalty *float64       `json:"frequency_penalty,omitempty"`
+	Seed             *int64         `json:"seed,omitempty"`
 	Tools            []Tool         `json:"tools,omitempty"`
+	// ResponseFormat constrains the response to a JSON schema on OpenAI-compatible servers.
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
+	// JSONSchema constrains the response to a JSON schema through a llama.cpp grammar.
+	JSONSchema *ToolFuncParameters `json:"json_schema,omitempty"`
+}
+
+// ResponseFormat represents the OpenAI response_format request field
+type ResponseFormat struct {
+	Type       string              `json:"type"`
+	JSONSchema *ResponseJSONSchema `json:"json_schema,omitempty"`
+}
+
+// ResponseJSONSchema represents a named JSON schema for structured outputs
+type ResponseJSONSchema struct {
+	Name   string             `json:"name"`
+	Strict bool               `json:"strict,omitempty"`
+	Schema ToolFuncParameters `json:"schema"`
 }
 
 // StreamOptions represents options for streaming responses
@@ -147,9 +167,11 @@ type StreamChunkChoice struct {
 
 // StreamChunkDelta represents the delta content
 type StreamChunkDelta struct {
-	Role      *string         `json:"role,omitempty"`
-	Content   string          `json:"content,omitempty"`
-	ToolCalls []ToolCallChunk `json:"tool_calls,omitempty"`
+	Role    *string `json:"role,omitempty"`
+	Content string  `json:"content,omitempty"`
+	// ReasoningContent carries reasoning tokens when the server extracts them from the content itself.
+	ReasoningContent string          `json:"reasoning_content,omitempty"`
+	ToolCalls        []ToolCallChunk `json:"tool_calls,omitempty"`
 }
 
 // ToolCallChunk represents a tool call in a streaming chunk
@@ -219,3 +241,22 @@ type ModelInfo struct {
 	Object  string `json:"object"`
 	Created int64  `json:"created"`
 }
+
+// ModerationsRequest represents the request payload for the moderations endpoint.
+type ModerationsRequest struct {
+	Model string `json:"model,omitempty"`
+	Input string `json:"input"`
+}
+
+// ModerationsResult represents the classification of a single input.
+type ModerationsResult struct {
+	Flagged    bool            `json:"flagged"`
+	Categories map[string]bool `json:"categories"`
+}
+
+// ModerationsResponse represents the response from the moderations endpoint.
+type ModerationsResponse struct {
+	ID      string              `json:"id"`
+	Model   string              `json:"model"`
+	Results []ModerationsResult `json:"results"`
+}
@@ -0,0 +1,57 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/modelrunner"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+const (
+	// Provider_Keywords moderates user input with the local MODERATION_KEYWORDS rules.
+	Provider_Keywords = "keywords"
+	// Provider_API moderates user input with an OpenAI-compatible moderations endpoint.
+	Provider_API = "api"
+)
+
+// InitModerator registers the assistant.Moderator selected by MODERATION_PROVIDER. Moderation stays
+// disabled, and nothing is registered, when the provider is empty.
+type InitModerator struct {
+	HttpClient *http.Client `resolve:"standard"`
+	Provider   string       `config:"MODERATION_PROVIDER" default:""`
+	Keywords   string       `config:"MODERATION_KEYWORDS" default:""`
+	APIHost    string       `config:"MODERATION_API_HOST" default:""`
+	APIKey     string       `config:"MODERATION_API_KEY" default:""`
+	Model      string       `config:"MODERATION_MODEL" default:""`
+}
+
+// Initialize creates and registers the moderator in the dependency container.
+func (i InitModerator) Initialize(ctx context.Context) (context.Context, error) {
+	switch i.Provider {
+	case "":
+		return ctx, nil
+	case Provider_Keywords:
+		rules, err := ParseKeywordRules(i.Keywords)
+		if err != nil {
+			return ctx, fmt.Errorf("failed to parse moderation keywords: %w", err)
+		}
+		if len(rules) == 0 {
+			return ctx, fmt.Errorf("MODERATION_KEYWORDS is required for the %q moderation provider", Provider_Keywords)
+		}
+		depend.Register[assistant.Moderator](NewKeywordModerator(rules))
+	case Provider_API:
+		if i.APIHost == "" {
+			return ctx, fmt.Errorf("MODERATION_API_HOST is required for the %q moderation provider", Provider_API)
+		}
+		depend.Register[assistant.Moderator](modelrunner.NewContentModerator(
+			modelrunner.NewOpenAICompatClient(i.APIHost, i.APIKey, i.HttpClient),
+			i.Model,
+		))
+	default:
+		return ctx, fmt.Errorf("unknown moderation provider %q", i.Provider)
+	}
+	return ctx, nil
+}
@@ -0,0 +1,60 @@
+package moderation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/modelrunner"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitModerator_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		init      InitModerator
+		expected  assistant.Moderator
+		expectErr bool
+	}{
+		"keywords": {
+			init:     InitModerator{Provider: Provider_Keywords, Keywords: "violence=kill"},
+			expected: KeywordModerator{},
+		},
+		"api": {
+			init:     InitModerator{Provider: Provider_API, APIHost: "http://localhost:8080", HttpClient: http.DefaultClient},
+			expected: modelrunner.ContentModerator{},
+		},
+		"keywords-without-rules": {
+			init:      InitModerator{Provider: Provider_Keywords},
+			expectErr: true,
+		},
+		"keywords-invalid-rules": {
+			init:      InitModerator{Provider: Provider_Keywords, Keywords: "kill"},
+			expectErr: true,
+		},
+		"api-without-host": {
+			init:      InitModerator{Provider: Provider_API},
+			expectErr: true,
+		},
+		"unknown-provider": {
+			init:      InitModerator{Provider: "other"},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			moderator, err := depend.Resolve[assistant.Moderator]()
+			require.NoError(t, err)
+			assert.IsType(t, tt.expected, moderator)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// KeywordRule flags content containing any of its terms under a category.
+type KeywordRule struct {
+	Category string
+	Terms    []string
+}
+
+// KeywordModerator implements the assistant.Moderator interface with local keyword rules. Terms match
+// case-insensitively on whole words, so a rule for "kill" does not flag "skill".
+type KeywordModerator struct {
+	categories []string
+	patterns   []*regexp.Regexp
+}
+
+// NewKeywordModerator creates a KeywordModerator from the given rules.
+func NewKeywordModerator(rules []KeywordRule) KeywordModerator {
+	m := KeywordModerator{}
+	for _, rule := range rules {
+		terms := make([]string, 0, len(rule.Terms))
+		for _, term := range rule.Terms {
+			terms = append(terms, strings.Join(strings.Fields(regexp.QuoteMeta(term)), `\s+`))
+		}
+		m.categories = append(m.categories, rule.Category)
+		m.patterns = append(m.patterns, regexp.MustCompile(`(?i)\b(?:`+strings.Join(terms, "|")+`)\b`))
+	}
+	return m
+}
+
+// Moderate implements assistant.Moderator.Moderate.
+func (m KeywordModerator) Moderate(_ context.Context, content string) (assistant.ModerationResult, error) {
+	result := assistant.ModerationResult{}
+	for i, pattern := range m.patterns {
+		if pattern.MatchString(content) {
+			result.Flagged = true
+			result.Categories = append(result.Categories, m.categories[i])
+		}
+	}
+	return result, nil
+}
+
+// ParseKeywordRules parses keyword rules in the format "category=term,term;category=term".
+// Terms may be phrases; surrounding whitespace is ignored.
+func ParseKeywordRules(raw string) ([]KeywordRule, error) {
+	var rules []KeywordRule
+	for entry := range strings.SplitSeq(raw, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		category, rawTerms, ok := strings.Cut(entry, "=")
+		category = strings.TrimSpace(category)
+		if !ok || category == "" {
+			return nil, fmt.Errorf("invalid keyword rule %q: expected category=term,term", entry)
+		}
+		rule := KeywordRule{Category: category}
+		for term := range strings.SplitSeq(rawTerms, ",") {
+			if term = strings.TrimSpace(term); term != "" {
+				rule.Terms = append(rule.Terms, term)
+			}
+		}
+		if len(rule.Terms) == 0 {
+			return nil, fmt.Errorf("invalid keyword rule %q: no terms", entry)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,97 @@
+package moderation
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeywordRules(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		raw       string
+		expected  []KeywordRule
+		expectErr bool
+	}{
+		"empty": {
+			raw: "",
+		},
+		"multiple-categories": {
+			raw: " violence = kill, hurt someone ;spam=buy now;",
+			expected: []KeywordRule{
+				{Category: "violence", Terms: []string{"kill", "hurt someone"}},
+				{Category: "spam", Terms: []string{"buy now"}},
+			},
+		},
+		"missing-category": {
+			raw:       "=kill",
+			expectErr: true,
+		},
+		"missing-separator": {
+			raw:       "kill",
+			expectErr: true,
+		},
+		"missing-terms": {
+			raw:       "violence= , ",
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseKeywordRules(tt.raw)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestKeywordModerator_Moderate(t *testing.T) {
+	t.Parallel()
+
+	moderator := NewKeywordModerator([]KeywordRule{
+		{Category: "violence", Terms: []string{"kill", "hurt someone"}},
+		{Category: "spam", Terms: []string{"buy now", "c++ deals"}},
+	})
+
+	tests := map[string]struct {
+		content  string
+		expected assistant.ModerationResult
+	}{
+		"clean": {
+			content:  "Add a todo to practice my guitar skills",
+			expected: assistant.ModerationResult{},
+		},
+		"case-insensitive-word": {
+			content:  "I want to KILL the noise",
+			expected: assistant.ModerationResult{Flagged: true, Categories: []string{"violence"}},
+		},
+		"phrase-with-extra-whitespace": {
+			content:  "Buy   now and save",
+			expected: assistant.ModerationResult{Flagged: true, Categories: []string{"spam"}},
+		},
+		"term-with-special-characters": {
+			content:  "Great c++ deals today",
+			expected: assistant.ModerationResult{Flagged: true, Categories: []string{"spam"}},
+		},
+		"multiple-categories": {
+			content:  "buy now or I will hurt someone",
+			expected: assistant.ModerationResult{Flagged: true, Categories: []string{"violence", "spam"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := moderator.Moderate(t.Context(), tt.content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
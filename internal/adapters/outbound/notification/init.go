@@ -0,0 +1,22 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitNotifier is used to initialize and register the notification adapter.
+type InitNotifier struct {
+	Logger *log.Logger `resolve:""`
+}
+
+// Initialize creates and registers the notifier in the dependency container.
+func (i InitNotifier) Initialize(ctx context.Context) (context.Context, error) {
+	notifier := NewLogNotifier(i.Logger)
+	depend.Register[todo.FocusSessionNotifier](notifier)
+	depend.Register[todo.WeeklyReviewNotifier](notifier)
+	return ctx, nil
+}
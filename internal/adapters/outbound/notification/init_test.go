@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitNotifier_Initialize(t *testing.T) {
+	i := InitNotifier{Logger: log.New(io.Discard, "", 0)}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[todo.FocusSessionNotifier]()
+	require.NoError(t, err)
+	assert.NotNil(t, registered)
+
+	reviewNotifier, err := depend.Resolve[todo.WeeklyReviewNotifier]()
+	require.NoError(t, err)
+	assert.NotNil(t, reviewNotifier)
+}
@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// LogNotifier delivers notifications by writing them to the application log.
+// It is the fallback channel used when no richer notification transport is configured.
+type LogNotifier struct {
+	logger *log.Logger
+}
+
+// NewLogNotifier creates a new LogNotifier.
+func NewLogNotifier(logger *log.Logger) LogNotifier {
+	return LogNotifier{logger: logger}
+}
+
+// NotifyFocusSessionCompleted logs that a focus session has finished.
+func (n LogNotifier) NotifyFocusSessionCompleted(ctx context.Context, session todo.FocusSession) error {
+	_, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	n.logger.Printf(
+		"Notification: focus session completed (session_id=%s todo_id=%s duration=%s ended_at=%s)",
+		session.ID,
+		session.TodoID,
+		session.Duration,
+		session.EndsAt().Format(time.RFC3339),
+	)
+	return nil
+}
+
+// NotifyWeeklyReviewReady logs that a new weekly review is available.
+func (n LogNotifier) NotifyWeeklyReviewReady(ctx context.Context, review todo.WeeklyReview) error {
+	_, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	n.logger.Printf(
+		"Notification: weekly review ready (review_id=%s conversation_id=%s period=%s..%s planned=%d completed=%d slipped=%d)",
+		review.ID,
+		review.ConversationID,
+		review.PeriodStart.Format(time.DateOnly),
+		review.PeriodEnd.Format(time.DateOnly),
+		review.Planned,
+		len(review.Completed),
+		len(review.Slipped),
+	)
+	return nil
+}
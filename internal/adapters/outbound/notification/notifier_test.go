@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"bytes"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogNotifier_NotifyFocusSessionCompleted(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	notifier := NewLogNotifier(log.New(&buf, "", 0))
+	session := todo.FocusSession{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		TodoID:    uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Duration:  25 * time.Minute,
+		StartedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	err := notifier.NotifyFocusSessionCompleted(t.Context(), session)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "focus session completed")
+	assert.Contains(t, buf.String(), "todo_id=123e4567-e89b-12d3-a456-426614174000")
+	assert.Contains(t, buf.String(), "ended_at=2026-03-02T09:25:00Z")
+}
+
+func TestLogNotifier_NotifyWeeklyReviewReady(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	notifier := NewLogNotifier(log.New(&buf, "", 0))
+	review := todo.WeeklyReview{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ConversationID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		PeriodStart:    time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:      time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		Planned:        3,
+		Completed:      []string{"Write draft", "Pay rent"},
+		Slipped:        []string{"Book flights"},
+	}
+
+	err := notifier.NotifyWeeklyReviewReady(t.Context(), review)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "weekly review ready")
+	assert.Contains(t, buf.String(), "conversation_id=123e4567-e89b-12d3-a456-426614174000")
+	assert.Contains(t, buf.String(), "period=2026-03-02..2026-03-08 planned=3 completed=2 slipped=1")
+}
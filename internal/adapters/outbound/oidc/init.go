@@ -0,0 +1,48 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitIdentityProvider registers the OpenID Connect access.IdentityProvider. Logins stay disabled,
+// and nothing is registered, when OIDC_ISSUER_URL is empty.
+type InitIdentityProvider struct {
+	HttpClient   *http.Client             `resolve:"standard"`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	IssuerURL    string                   `config:"OIDC_ISSUER_URL" default:""`
+	ClientID     string                   `config:"OIDC_CLIENT_ID" default:""`
+	ClientSecret string                   `config:"OIDC_CLIENT_SECRET" default:""`
+	RedirectURL  string                   `config:"OIDC_REDIRECT_URL" default:""`
+	Scopes       string                   `config:"OIDC_SCOPES" default:"openid email profile"`
+}
+
+// Initialize creates and registers the identity provider in the dependency container.
+func (i InitIdentityProvider) Initialize(ctx context.Context) (context.Context, error) {
+	if i.IssuerURL == "" {
+		return ctx, nil
+	}
+	if i.ClientID == "" || i.RedirectURL == "" {
+		return ctx, fmt.Errorf("OIDC_CLIENT_ID and OIDC_REDIRECT_URL are required when OIDC_ISSUER_URL is set")
+	}
+
+	scopes := strings.Fields(i.Scopes)
+	if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+	depend.Register[access.IdentityProvider](NewProvider(Config{
+		IssuerURL:    i.IssuerURL,
+		ClientID:     i.ClientID,
+		ClientSecret: i.ClientSecret,
+		RedirectURL:  i.RedirectURL,
+		Scopes:       scopes,
+	}, i.HttpClient, i.TimeProvider))
+	return ctx, nil
+}
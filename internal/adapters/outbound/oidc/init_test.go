@@ -0,0 +1,54 @@
+package oidc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitIdentityProvider_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		init           InitIdentityProvider
+		expectErr      bool
+		expectedScopes []string
+	}{
+		"configured": {
+			init: InitIdentityProvider{
+				IssuerURL:   "https://idp.example.com",
+				ClientID:    "todoapp",
+				RedirectURL: "https://todo.example.com/api/v1/auth/oidc/callback",
+				Scopes:      "email profile",
+			},
+			expectedScopes: []string{"openid", "email", "profile"},
+		},
+		"missing-client": {
+			init:      InitIdentityProvider{IssuerURL: "https://idp.example.com"},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.init.HttpClient = http.DefaultClient
+
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			registered, err := depend.Resolve[access.IdentityProvider]()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedScopes, registered.(*Provider).config.Scopes)
+		})
+	}
+}
+
+func TestInitIdentityProvider_Disabled(t *testing.T) {
+	_, err := InitIdentityProvider{}.Initialize(t.Context())
+	assert.NoError(t, err)
+}
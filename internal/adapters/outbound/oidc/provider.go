@@ -0,0 +1,256 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"golang.org/x/oauth2"
+)
+
+// clockSkew is the leeway allowed between the clocks of the provider and this service.
+const clockSkew = time.Minute
+
+// signatureAlgorithms are the ID token signature algorithms accepted from the provider.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+}
+
+// Config describes the OpenID Connect client registered at the provider.
+type Config struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// metadata is the part of the provider discovery document the client uses.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims are the ID token claims besides the registered JWT claims.
+type idTokenClaims struct {
+	Nonce string `json:"nonce"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// Provider implements the access.IdentityProvider interface for an OpenID Connect provider. The
+// provider metadata is discovered from the issuer on first use and the signing keys are fetched
+// again when an ID token is signed with an unknown key.
+type Provider struct {
+	config       Config
+	client       *http.Client
+	timeProvider core.CurrentTimeProvider
+
+	mu   sync.Mutex
+	meta *metadata
+	keys *jose.JSONWebKeySet
+}
+
+// NewProvider creates a new Provider.
+func NewProvider(config Config, client *http.Client, timeProvider core.CurrentTimeProvider) *Provider {
+	config.IssuerURL = strings.TrimSuffix(config.IssuerURL, "/")
+	return &Provider{
+		config:       config,
+		client:       client,
+		timeProvider: timeProvider,
+	}
+}
+
+// AuthCodeURL implements access.IdentityProvider.AuthCodeURL.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	meta, err := p.discover(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", err
+	}
+
+	return p.oauthConfig(meta).AuthCodeURL(
+		state,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+// Exchange implements access.IdentityProvider.Exchange.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (access.Identity, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	meta, err := p.discover(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Identity{}, err
+	}
+
+	token, err := p.oauthConfig(meta).Exchange(
+		context.WithValue(spanCtx, oauth2.HTTPClient, p.client),
+		code,
+		oauth2.VerifierOption(verifier),
+	)
+	if retrieveErr := (*oauth2.RetrieveError)(nil); errors.As(err, &retrieveErr) {
+		err = core.NewUnauthorizedErr(fmt.Sprintf("identity provider rejected the login: %s", retrieveErr.ErrorCode))
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Identity{}, err
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		err := core.NewUnauthorizedErr("identity provider returned no ID token")
+		telemetry.IsErrorRecorded(span, err)
+		return access.Identity{}, err
+	}
+
+	identity, err := p.verify(spanCtx, meta, rawIDToken, nonce)
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Identity{}, err
+	}
+
+	return identity, nil
+}
+
+// verify checks the signature and claims of the ID token and returns the identity it asserts.
+func (p *Provider) verify(ctx context.Context, meta metadata, rawIDToken, nonce string) (access.Identity, error) {
+	idToken, err := jwt.ParseSigned(rawIDToken, signatureAlgorithms)
+	if err != nil {
+		return access.Identity{}, core.NewUnauthorizedErr(fmt.Sprintf("invalid ID token: %v", err))
+	}
+
+	var (
+		claims jwt.Claims
+		extra  idTokenClaims
+	)
+	keys, err := p.signingKeys(ctx, meta, false)
+	if err != nil {
+		return access.Identity{}, err
+	}
+	if err := idToken.Claims(keys, &claims, &extra); err != nil {
+		// The provider may have rotated its keys since they were fetched.
+		if keys, err = p.signingKeys(ctx, meta, true); err != nil {
+			return access.Identity{}, err
+		}
+		if err := idToken.Claims(keys, &claims, &extra); err != nil {
+			return access.Identity{}, core.NewUnauthorizedErr(fmt.Sprintf("invalid ID token signature: %v", err))
+		}
+	}
+
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      meta.Issuer,
+		AnyAudience: jwt.Audience{p.config.ClientID},
+		Time:        p.timeProvider.Now(),
+	}, clockSkew)
+	if err != nil {
+		return access.Identity{}, core.NewUnauthorizedErr(fmt.Sprintf("invalid ID token: %v", err))
+	}
+	if claims.Subject == "" {
+		return access.Identity{}, core.NewUnauthorizedErr("invalid ID token: missing subject")
+	}
+	if subtle.ConstantTimeCompare([]byte(extra.Nonce), []byte(nonce)) != 1 {
+		return access.Identity{}, core.NewUnauthorizedErr("invalid ID token: nonce does not match")
+	}
+
+	return access.Identity{
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+		Email:   extra.Email,
+		Name:    extra.Name,
+	}, nil
+}
+
+// oauthConfig returns the OAuth2 client configuration for the provider endpoints.
+func (p *Provider) oauthConfig(meta metadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Scopes:       p.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}
+}
+
+// discover returns the provider metadata, fetching the discovery document on first use.
+// Failed discoveries are retried on the next call.
+func (p *Provider) discover(ctx context.Context) (metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return *p.meta, nil
+	}
+
+	var meta metadata
+	if err := p.getJSON(ctx, p.config.IssuerURL+"/.well-known/openid-configuration", &meta); err != nil {
+		return metadata{}, fmt.Errorf("failed to discover OIDC provider: %w", err)
+	}
+	if meta.Issuer != p.config.IssuerURL {
+		return metadata{}, fmt.Errorf("OIDC provider issuer %q does not match %q", meta.Issuer, p.config.IssuerURL)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return metadata{}, fmt.Errorf("OIDC provider discovery document lacks required endpoints")
+	}
+
+	p.meta = &meta
+	return meta, nil
+}
+
+// signingKeys returns the provider signing keys, fetching them on first use or when refresh is set.
+func (p *Provider) signingKeys(ctx context.Context, meta metadata, refresh bool) (*jose.JSONWebKeySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys != nil && !refresh {
+		return p.keys, nil
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := p.getJSON(ctx, meta.JWKSURI, &keys); err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC provider keys: %w", err)
+	}
+
+	p.keys = &keys
+	return p.keys, nil
+}
+
+// getJSON fetches a JSON document from the provider.
+func (p *Provider) getJSON(ctx context.Context, url string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(dest)
+}
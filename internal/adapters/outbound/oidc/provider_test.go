@@ -0,0 +1,262 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+var fixedNow = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+// fakeIdP is an OpenID Connect provider serving discovery, keys and a token endpoint.
+type fakeIdP struct {
+	server      *httptest.Server
+	key         *rsa.PrivateKey
+	keyID       string
+	issuer      string
+	idToken     func(issuer string) string
+	tokenStatus int
+	verifier    string
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := &fakeIdP{key: key, keyID: "key-1", tokenStatus: http.StatusOK}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.issuer,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key: &idp.key.PublicKey, KeyID: idp.keyID, Algorithm: string(jose.RS256), Use: "sig",
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		idp.verifier = r.PostForm.Get("code_verifier")
+		w.Header().Set("Content-Type", "application/json")
+		if idp.tokenStatus != http.StatusOK {
+			w.WriteHeader(idp.tokenStatus)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "provider-access-token",
+			"token_type":   "Bearer",
+			"id_token":     idp.idToken(idp.issuer),
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	idp.issuer = idp.server.URL
+	return idp
+}
+
+// sign signs ID token claims with the key of the provider.
+func (idp *fakeIdP) sign(t *testing.T, claims jwt.Claims, extra idTokenClaims) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: idp.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", idp.keyID),
+	)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Claims(extra).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func newTestProvider(t *testing.T, idp *fakeIdP) *Provider {
+	t.Helper()
+
+	tp := core.NewMockCurrentTimeProvider(t)
+	tp.EXPECT().Now().Return(fixedNow).Maybe()
+	return NewProvider(Config{
+		IssuerURL:   idp.server.URL + "/",
+		ClientID:    "todoapp",
+		RedirectURL: "https://todo.example.com/api/v1/auth/oidc/callback",
+		Scopes:      []string{"openid", "email"},
+	}, idp.server.Client(), tp)
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	idp := newFakeIdP(t)
+	provider := newTestProvider(t, idp)
+
+	raw, err := provider.AuthCodeURL(t.Context(), "state", "nonce", "verifier-verifier-verifier-verifier-verifier")
+	require.NoError(t, err)
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, idp.server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	query := u.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "todoapp", query.Get("client_id"))
+	assert.Equal(t, "state", query.Get("state"))
+	assert.Equal(t, "nonce", query.Get("nonce"))
+	assert.Equal(t, "openid email", query.Get("scope"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, oauth2.S256ChallengeFromVerifier("verifier-verifier-verifier-verifier-verifier"), query.Get("code_challenge"))
+}
+
+func TestProvider_AuthCodeURL_IssuerMismatch(t *testing.T) {
+	t.Parallel()
+
+	idp := newFakeIdP(t)
+	idp.issuer = "https://other.example.com"
+	provider := newTestProvider(t, idp)
+
+	_, err := provider.AuthCodeURL(t.Context(), "state", "nonce", "verifier")
+	assert.ErrorContains(t, err, "does not match")
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	t.Parallel()
+
+	validClaims := func(issuer string) jwt.Claims {
+		return jwt.Claims{
+			Issuer:   issuer,
+			Subject:  "248289761001",
+			Audience: jwt.Audience{"todoapp"},
+			IssuedAt: jwt.NewNumericDate(fixedNow),
+			Expiry:   jwt.NewNumericDate(fixedNow.Add(5 * time.Minute)),
+		}
+	}
+	profile := idTokenClaims{Nonce: "nonce", Email: "jane@example.com", Name: "Jane"}
+
+	tests := map[string]struct {
+		setup       func(t *testing.T, idp *fakeIdP)
+		rotateKey   bool
+		expected    access.Identity
+		expectedErr string
+	}{
+		"verified-identity": {
+			setup: func(t *testing.T, idp *fakeIdP) {
+				idp.idToken = func(issuer string) string { return idp.sign(t, validClaims(issuer), profile) }
+			},
+			expected: access.Identity{Subject: "248289761001", Email: "jane@example.com", Name: "Jane"},
+		},
+		"rotated-signing-key": {
+			setup: func(t *testing.T, idp *fakeIdP) {
+				idp.idToken = func(issuer string) string { return idp.sign(t, validClaims(issuer), profile) }
+			},
+			rotateKey: true,
+			expected:  access.Identity{Subject: "248289761001", Email: "jane@example.com", Name: "Jane"},
+		},
+		"nonce-mismatch": {
+			setup: func(t *testing.T, idp *fakeIdP) {
+				idp.idToken = func(issuer string) string {
+					return idp.sign(t, validClaims(issuer), idTokenClaims{Nonce: "replayed"})
+				}
+			},
+			expectedErr: "nonce does not match",
+		},
+		"wrong-audience": {
+			setup: func(t *testing.T, idp *fakeIdP) {
+				idp.idToken = func(issuer string) string {
+					claims := validClaims(issuer)
+					claims.Audience = jwt.Audience{"another-client"}
+					return idp.sign(t, claims, profile)
+				}
+			},
+			expectedErr: "invalid ID token",
+		},
+		"expired-token": {
+			setup: func(t *testing.T, idp *fakeIdP) {
+				idp.idToken = func(issuer string) string {
+					claims := validClaims(issuer)
+					claims.Expiry = jwt.NewNumericDate(fixedNow.Add(-time.Hour))
+					return idp.sign(t, claims, profile)
+				}
+			},
+			expectedErr: "invalid ID token",
+		},
+		"forged-signature": {
+			setup: func(t *testing.T, idp *fakeIdP) {
+				forger, err := rsa.GenerateKey(rand.Reader, 2048)
+				require.NoError(t, err)
+				idp.idToken = func(issuer string) string {
+					signer, err := jose.NewSigner(
+						jose.SigningKey{Algorithm: jose.RS256, Key: forger},
+						(&jose.SignerOptions{}).WithHeader("kid", idp.keyID),
+					)
+					require.NoError(t, err)
+					token, err := jwt.Signed(signer).Claims(validClaims(issuer)).Claims(profile).Serialize()
+					require.NoError(t, err)
+					return token
+				}
+			},
+			expectedErr: "invalid ID token signature",
+		},
+		"code-rejected": {
+			setup: func(_ *testing.T, idp *fakeIdP) {
+				idp.tokenStatus = http.StatusBadRequest
+			},
+			expectedErr: "identity provider rejected the login: invalid_grant",
+		},
+		"no-id-token": {
+			setup: func(_ *testing.T, idp *fakeIdP) {
+				idp.idToken = func(string) string { return "" }
+			},
+			expectedErr: "identity provider returned no ID token",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			idp := newFakeIdP(t)
+			tt.setup(t, idp)
+			provider := newTestProvider(t, idp)
+
+			if tt.rotateKey {
+				// Cache the current keys, then rotate them at the provider.
+				_, err := provider.AuthCodeURL(t.Context(), "state", "nonce", "verifier")
+				require.NoError(t, err)
+				meta, err := provider.discover(t.Context())
+				require.NoError(t, err)
+				_, err = provider.signingKeys(t.Context(), meta, false)
+				require.NoError(t, err)
+				idp.key, err = rsa.GenerateKey(rand.Reader, 2048)
+				require.NoError(t, err)
+				idp.keyID = "key-2"
+			}
+
+			got, err := provider.Exchange(t.Context(), "code", "verifier-verifier-verifier-verifier-verifier", "nonce")
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				assert.IsType(t, &core.UnauthorizedErr{}, err)
+				return
+			}
+			require.NoError(t, err)
+			tt.expected.Issuer = idp.issuer
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, "verifier-verifier-verifier-verifier-verifier", idp.verifier)
+		})
+	}
+}
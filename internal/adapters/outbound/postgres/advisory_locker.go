@@ -24,6 +24,7 @@ func NewAdvisoryLocker(db *sql.DB) AdvisoryLocker {
 }
 
 // TryLock attempts to acquire a non-blocking advisory lock for one key.
+// Keys are scoped to the tenant of ctx, so tenants never contend for the same lock.
 func (l AdvisoryLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
 	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
 		attribute.String("lock.key", key),
@@ -35,7 +36,7 @@ func (l AdvisoryLocker) TryLock(ctx context.Context, key string) (func(), bool,
 		return nil, false, err
 	}
 
-	lockKey := advisoryLockKey(key)
+	lockKey := advisoryLockKey(tenantOf(ctx) + ":" + key)
 
 	var locked bool
 	err = conn.QueryRowContext(spanCtx, "SELECT pg_try_advisory_lock($1)", lockKey).Scan(&locked)
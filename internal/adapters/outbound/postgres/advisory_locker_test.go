@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,7 +13,7 @@ func TestAdvisoryLocker_TryLock(t *testing.T) {
 	t.Parallel()
 
 	lockName := "conversation-title:00000000-0000-0000-0000-000000000001"
-	lockKey := advisoryLockKey(lockName)
+	lockKey := advisoryLockKey(string(tenant.Default) + ":" + lockName)
 
 	tests := map[string]struct {
 		expect    func(sqlmock.Sqlmock)
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+var auditEntryFields = []string{
+	"id",
+	"principal",
+	"role",
+	"source",
+	"operation",
+	"target",
+	"payload_hash",
+	"result",
+	"succeeded",
+	"occurred_at",
+}
+
+// AuditRepository implements the audit.Repository interface using PostgreSQL as the storage backend.
+type AuditRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewAuditRepository creates a new instance of AuditRepository.
+func NewAuditRepository(br sq.BaseRunner) AuditRepository {
+	return AuditRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// AppendEntry stores an audit entry. The sequence is assigned by the database.
+func (r AuditRepository) AppendEntry(ctx context.Context, entry audit.Entry) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("audit_log").
+		Columns(auditEntryFields...).
+		Columns(tenantColumn).
+		Values(
+			entry.ID,
+			entry.Principal,
+			entry.Role,
+			entry.Source,
+			entry.Operation,
+			entry.Target,
+			entry.PayloadHash,
+			entry.Result,
+			entry.Succeeded,
+			entry.OccurredAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListEntries returns the audit entries of the tenant matching the query in sequence order.
+func (r AuditRepository) ListEntries(ctx context.Context, query audit.Query) ([]audit.Entry, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	qry := r.sb.
+		Select(append([]string{"seq"}, auditEntryFields...)...).
+		From("audit_log").
+		Where(tenantEq(ctx)).
+		Where(sq.Gt{"seq": query.AfterSequence})
+	if !query.From.IsZero() {
+		qry = qry.Where(sq.GtOrEq{"occurred_at": query.From})
+	}
+	if !query.To.IsZero() {
+		qry = qry.Where(sq.Lt{"occurred_at": query.To})
+	}
+
+	rows, err := qry.
+		OrderBy("seq ASC").
+		Limit(uint64(query.Limit)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	entries := []audit.Entry{}
+	for rows.Next() {
+		var entry audit.Entry
+		err := rows.Scan(
+			&entry.Sequence,
+			&entry.ID,
+			&entry.Principal,
+			&entry.Role,
+			&entry.Source,
+			&entry.Operation,
+			&entry.Target,
+			&entry.PayloadHash,
+			&entry.Result,
+			&entry.Succeeded,
+			&entry.OccurredAt,
+		)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// DeleteEntriesBefore removes the audit entries of every tenant that occurred before the given time.
+func (r AuditRepository) DeleteEntriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	result, err := r.sb.
+		Delete("audit_log").
+		Where(sq.Lt{"occurred_at": before}).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+
+	removed, err := result.RowsAffected()
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+	return removed, nil
+}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var fixedAuditEntry = audit.Entry{
+	ID:          uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"),
+	Sequence:    7,
+	Principal:   "ops",
+	Role:        access.RoleMember,
+	Source:      audit.SourceAPI,
+	Operation:   "POST /api/v1/todos",
+	Target:      "/api/v1/todos",
+	PayloadHash: "hash",
+	Result:      "201",
+	Succeeded:   true,
+	OccurredAt:  time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC),
+}
+
+func TestAuditRepository_AppendEntry(t *testing.T) {
+	t.Parallel()
+
+	query := "INSERT INTO audit_log (id,principal,role,source,operation,target,payload_hash,result,succeeded,occurred_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)"
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			e := fixedAuditEntry
+			exp := mock.ExpectExec(query).WithArgs(
+				e.ID, e.Principal, e.Role, e.Source, e.Operation, e.Target,
+				e.PayloadHash, e.Result, e.Succeeded, e.OccurredAt, tenant.Default,
+			)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			err = NewAuditRepository(db).AppendEntry(t.Context(), e)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuditRepository_ListEntries(t *testing.T) {
+	t.Parallel()
+
+	columns := append([]string{"seq"}, auditEntryFields...)
+	entryRows := func() *sqlmock.Rows {
+		e := fixedAuditEntry
+		return sqlmock.NewRows(columns).AddRow(
+			e.Sequence, e.ID, e.Principal, e.Role, e.Source, e.Operation, e.Target,
+			e.PayloadHash, e.Result, e.Succeeded, e.OccurredAt,
+		)
+	}
+	from := fixedAuditEntry.OccurredAt.Add(-time.Hour)
+	to := fixedAuditEntry.OccurredAt.Add(time.Hour)
+
+	tests := map[string]struct {
+		query     audit.Query
+		sql       string
+		args      []driver.Value
+		rows      *sqlmock.Rows
+		queryErr  error
+		expected  []audit.Entry
+		expectErr bool
+	}{
+		"open-bounds": {
+			query:    audit.Query{Limit: 10},
+			sql:      "SELECT seq, id, principal, role, source, operation, target, payload_hash, result, succeeded, occurred_at FROM audit_log WHERE tenant_id = $1 AND seq > $2 ORDER BY seq ASC LIMIT 10",
+			args:     []driver.Value{tenant.Default, int64(0)},
+			rows:     entryRows(),
+			expected: []audit.Entry{fixedAuditEntry},
+		},
+		"time-range-after-sequence": {
+			query:    audit.Query{From: from, To: to, AfterSequence: 3, Limit: 5},
+			sql:      "SELECT seq, id, principal, role, source, operation, target, payload_hash, result, succeeded, occurred_at FROM audit_log WHERE tenant_id = $1 AND seq > $2 AND occurred_at >= $3 AND occurred_at < $4 ORDER BY seq ASC LIMIT 5",
+			args:     []driver.Value{tenant.Default, int64(3), from, to},
+			rows:     entryRows(),
+			expected: []audit.Entry{fixedAuditEntry},
+		},
+		"no-entries": {
+			query:    audit.Query{Limit: 10},
+			sql:      "SELECT seq, id, principal, role, source, operation, target, payload_hash, result, succeeded, occurred_at FROM audit_log WHERE tenant_id = $1 AND seq > $2 ORDER BY seq ASC LIMIT 10",
+			args:     []driver.Value{tenant.Default, int64(0)},
+			rows:     sqlmock.NewRows(columns),
+			expected: []audit.Entry{},
+		},
+		"database-error": {
+			query:     audit.Query{Limit: 10},
+			sql:       "SELECT seq, id, principal, role, source, operation, target, payload_hash, result, succeeded, occurred_at FROM audit_log WHERE tenant_id = $1 AND seq > $2 ORDER BY seq ASC LIMIT 10",
+			args:      []driver.Value{tenant.Default, int64(0)},
+			queryErr:  errors.New("db error"),
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectQuery(tt.sql).WithArgs(tt.args...)
+			if tt.queryErr != nil {
+				exp.WillReturnError(tt.queryErr)
+			} else {
+				exp.WillReturnRows(tt.rows)
+			}
+
+			got, err := NewAuditRepository(db).ListEntries(t.Context(), tt.query)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAuditRepository_DeleteEntriesBefore(t *testing.T) {
+	t.Parallel()
+
+	query := "DELETE FROM audit_log WHERE occurred_at < $1"
+	before := fixedAuditEntry.OccurredAt
+
+	tests := map[string]struct {
+		execErr   error
+		expected  int64
+		expectErr bool
+	}{
+		"success":        {expected: 3},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(before)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, tt.expected))
+			}
+
+			got, err := NewAuditRepository(db).DeleteEntriesBefore(t.Context(), before)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
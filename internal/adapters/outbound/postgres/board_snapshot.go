@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var boardSnapshotFields = []string{
+	"id",
+	"token",
+	"title",
+	"document",
+	"markdown",
+	"created_at",
+}
+
+// boardSnapshotDocument is the JSON representation of the copied board in the document column.
+type boardSnapshotDocument struct {
+	Filter  viewFilterRecord            `json:"filter"`
+	Todos   []boardSnapshotTodoRecord   `json:"todos"`
+	Summary *boardSnapshotSummaryRecord `json:"summary,omitempty"`
+}
+
+// boardSnapshotTodoRecord is the JSON representation of a todo copied into a snapshot.
+type boardSnapshotTodoRecord struct {
+	ID        uuid.UUID   `json:"id"`
+	Title     string      `json:"title"`
+	Status    todo.Status `json:"status"`
+	DueDate   time.Time   `json:"due_date"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// boardSnapshotSummaryRecord is the JSON representation of the board summary copied into a snapshot.
+type boardSnapshotSummaryRecord struct {
+	ID          uuid.UUID                `json:"id"`
+	Content     todo.BoardSummaryContent `json:"content"`
+	Model       string                   `json:"model"`
+	GeneratedAt time.Time                `json:"generated_at"`
+}
+
+// BoardSnapshotRepository implements the todo.BoardSnapshotRepository interface using PostgreSQL as the storage backend.
+type BoardSnapshotRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewBoardSnapshotRepository creates a new instance of BoardSnapshotRepository.
+func NewBoardSnapshotRepository(br sq.BaseRunner) BoardSnapshotRepository {
+	return BoardSnapshotRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateSnapshot stores a new snapshot.
+func (r BoardSnapshotRepository) CreateSnapshot(ctx context.Context, snapshot todo.BoardSnapshot) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	document := boardSnapshotDocument{
+		Filter: viewFilterRecord(snapshot.Filter),
+		Todos:  make([]boardSnapshotTodoRecord, len(snapshot.Todos)),
+	}
+	for i, t := range snapshot.Todos {
+		document.Todos[i] = boardSnapshotTodoRecord{
+			ID:        t.ID,
+			Title:     t.Title,
+			Status:    t.Status,
+			DueDate:   t.DueDate,
+			CreatedAt: t.CreatedAt,
+			UpdatedAt: t.UpdatedAt,
+		}
+	}
+	if s := snapshot.Summary; s != nil {
+		document.Summary = &boardSnapshotSummaryRecord{
+			ID:          s.ID,
+			Content:     s.Content,
+			Model:       s.Model,
+			GeneratedAt: s.GeneratedAt,
+		}
+	}
+	documentJSON, err := json.Marshal(document)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("board_snapshots").
+		Columns(boardSnapshotFields...).
+		Columns(tenantColumn).
+		Values(
+			snapshot.ID,
+			snapshot.Token,
+			snapshot.Title,
+			documentJSON,
+			snapshot.Markdown,
+			snapshot.CreatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetSnapshotByToken retrieves a snapshot by its token.
+func (r BoardSnapshotRepository) GetSnapshotByToken(ctx context.Context, token string) (todo.BoardSnapshot, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	snapshot, err := scanBoardSnapshot(r.sb.
+		Select(boardSnapshotFields...).
+		From("board_snapshots").
+		Where(sq.Eq{"token": token}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.BoardSnapshot{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.BoardSnapshot{}, false, err
+	}
+
+	return snapshot, true, nil
+}
+
+// scanBoardSnapshot reads a snapshot row, decoding its JSON document.
+func scanBoardSnapshot(row sq.RowScanner) (todo.BoardSnapshot, error) {
+	var (
+		snapshot     todo.BoardSnapshot
+		documentJSON []byte
+	)
+	if err := row.Scan(
+		&snapshot.ID,
+		&snapshot.Token,
+		&snapshot.Title,
+		&documentJSON,
+		&snapshot.Markdown,
+		&snapshot.CreatedAt,
+	); err != nil {
+		return todo.BoardSnapshot{}, err
+	}
+
+	var document boardSnapshotDocument
+	if err := json.Unmarshal(documentJSON, &document); err != nil {
+		return todo.BoardSnapshot{}, err
+	}
+	snapshot.Filter = todo.ViewFilter(document.Filter)
+	snapshot.Todos = make([]todo.Todo, len(document.Todos))
+	for i, t := range document.Todos {
+		snapshot.Todos[i] = todo.Todo{
+			ID:        t.ID,
+			Title:     t.Title,
+			Status:    t.Status,
+			DueDate:   t.DueDate,
+			CreatedAt: t.CreatedAt,
+			UpdatedAt: t.UpdatedAt,
+		}
+	}
+	if s := document.Summary; s != nil {
+		snapshot.Summary = &todo.BoardSummary{
+			ID:          s.ID,
+			Content:     s.Content,
+			Model:       s.Model,
+			GeneratedAt: s.GeneratedAt,
+		}
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixtureBoardSnapshot() (todo.BoardSnapshot, []byte) {
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	snapshot := todo.BoardSnapshot{
+		ID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Token:  "tok_abc",
+		Title:  "Sprint board",
+		Filter: todo.ViewFilter{Status: common.Ptr(todo.Status_OPEN)},
+		Todos: []todo.Todo{{
+			ID:        uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+			Title:     "Write draft",
+			Status:    todo.Status_OPEN,
+			DueDate:   dueDate,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}},
+		Summary: &todo.BoardSummary{
+			ID:          uuid.MustParse("323e4567-e89b-12d3-a456-426614174002"),
+			Content:     todo.BoardSummaryContent{Counts: todo.StatusCounts{Open: 1}, Summary: "One task left."},
+			Model:       "summary-model",
+			GeneratedAt: createdAt,
+		},
+		Markdown:  "# Sprint board\n",
+		CreatedAt: createdAt,
+	}
+	document := []byte(`{"filter":{"status":"OPEN"},` +
+		`"todos":[{"id":"223e4567-e89b-12d3-a456-426614174001","title":"Write draft","status":"OPEN",` +
+		`"due_date":"2026-03-05T00:00:00Z","created_at":"2026-03-02T09:00:00Z","updated_at":"2026-03-02T09:00:00Z"}],` +
+		`"summary":{"id":"323e4567-e89b-12d3-a456-426614174002","content":{"counts":{"OPEN":1,"DONE":0,"ARCHIVED":0},` +
+		`"next_up":null,"overdue":null,"near_deadline":null,"summary":"One task left."},` +
+		`"model":"summary-model","generated_at":"2026-03-02T09:00:00Z"}}`)
+	return snapshot, document
+}
+
+func TestBoardSnapshotRepository_CreateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	snapshot, document := fixtureBoardSnapshot()
+	query := "INSERT INTO board_snapshots (id,token,title,document,markdown,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(snapshot.ID, snapshot.Token, snapshot.Title, document, snapshot.Markdown, snapshot.CreatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(snapshot.ID, snapshot.Token, snapshot.Title, document, snapshot.Markdown, snapshot.CreatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewBoardSnapshotRepository(db)
+			gotErr := repo.CreateSnapshot(t.Context(), snapshot)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBoardSnapshotRepository_GetSnapshotByToken(t *testing.T) {
+	t.Parallel()
+
+	snapshot, document := fixtureBoardSnapshot()
+	query := "SELECT id, token, title, document, markdown, created_at FROM board_snapshots WHERE token = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     todo.BoardSnapshot
+		expectedFind bool
+		expectErr    bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(boardSnapshotFields).
+					AddRow(snapshot.ID, snapshot.Token, snapshot.Title, document, snapshot.Markdown, snapshot.CreatedAt)
+				m.ExpectQuery(query).WithArgs(snapshot.Token, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     snapshot,
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(snapshot.Token, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"invalid-document-json": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(boardSnapshotFields).
+					AddRow(snapshot.ID, snapshot.Token, snapshot.Title, []byte(`{`), snapshot.Markdown, snapshot.CreatedAt)
+				m.ExpectQuery(query).WithArgs(snapshot.Token, tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(snapshot.Token, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewBoardSnapshotRepository(db)
+			got, found, err := repo.GetSnapshotByToken(t.Context(), snapshot.Token)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -57,14 +57,16 @@ func (bsr BoardSummaryRepository) StoreSummary(ctx context.Context, summary todo
 		Columns(
 			boardSummaryFields...,
 		).
+		Columns(tenantColumn).
 		Values(
 			summary.ID,
 			contentJSON,
 			summary.Model,
 			summary.GeneratedAt,
 			summary.SourceVersion,
+			tenantOf(ctx),
 		).
-		Suffix(`ON CONFLICT (id) DO UPDATE SET
+		Suffix(`ON CONFLICT (tenant_id, id) DO UPDATE SET
             summary = EXCLUDED.summary,
             model = EXCLUDED.model,
             generated_at = EXCLUDED.generated_at,
@@ -92,6 +94,7 @@ func (bsr BoardSummaryRepository) GetLatestSummary(ctx context.Context) (todo.Bo
 			boardSummaryFields...,
 		).
 		From("board_summary").
+		Where(tenantEq(ctx)).
 		OrderBy("generated_at DESC").
 		Limit(1).
 		QueryRowContext(spanCtx).
@@ -136,7 +139,7 @@ func (bsr BoardSummaryRepository) CalculateSummaryContent(ctx context.Context) (
 			"next_tasks.next_up",
 		).
 		From("stats, near_deadline, next_tasks").
-		Prefix(boardSummaryCTEQry).
+		Prefix(boardSummaryCTEQry, tenantOf(ctx)).
 		QueryRowContext(spanCtx).
 		Scan(&countsJSON, &overdueJSON, &nearDeadlineJSON, &nextUpJSON)
 
@@ -167,20 +170,24 @@ WITH task_data AS (
         status,
         title,
         due_date,
+        archived_at IS NOT NULL as archived,
         CASE 
+            WHEN archived_at IS NOT NULL THEN 'archived'
             WHEN due_date < CURRENT_DATE AND status != 'DONE' THEN 'overdue'
             WHEN due_date >= CURRENT_DATE AND due_date <= CURRENT_DATE + 7 AND status != 'DONE' THEN 'near_deadline'
             WHEN status = 'OPEN' THEN 'next_up'
             ELSE 'other'
         END as category
     FROM todos
+    WHERE tenant_id = ?
     ORDER BY due_date ASC
 ),
 stats AS (
     SELECT 
         jsonb_build_object(
-            'DONE', COUNT(*) FILTER (WHERE status = 'DONE'),
-            'OPEN', COUNT(*) FILTER (WHERE status = 'OPEN')
+            'DONE', COUNT(*) FILTER (WHERE status = 'DONE' AND NOT archived),
+            'OPEN', COUNT(*) FILTER (WHERE status = 'OPEN' AND NOT archived),
+            'ARCHIVED', COUNT(*) FILTER (WHERE archived)
         ) as counts
     FROM task_data
 ),
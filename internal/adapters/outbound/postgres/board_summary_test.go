@@ -3,12 +3,14 @@ package postgres
 import (
 	"database/sql"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -57,13 +59,14 @@ func TestBoardSummaryRepository_StoreSummary(t *testing.T) {
 		"success-insert": {
 			summary: summary,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO board_summary (id,summary,model,generated_at,source_version) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (id) DO UPDATE SET summary = EXCLUDED.summary, model = EXCLUDED.model, generated_at = EXCLUDED.generated_at, source_version = EXCLUDED.source_version`).
+				mock.ExpectExec(`INSERT INTO board_summary (id,summary,model,generated_at,source_version,tenant_id) VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (tenant_id, id) DO UPDATE SET summary = EXCLUDED.summary, model = EXCLUDED.model, generated_at = EXCLUDED.generated_at, source_version = EXCLUDED.source_version`).
 					WithArgs(
 						summary.ID,
 						contentJSON,
 						summary.Model,
 						summary.GeneratedAt,
 						summary.SourceVersion,
+						tenant.Default,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
@@ -72,13 +75,14 @@ func TestBoardSummaryRepository_StoreSummary(t *testing.T) {
 		"success-store": {
 			summary: summary,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO board_summary (id,summary,model,generated_at,source_version) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (id) DO UPDATE SET summary = EXCLUDED.summary, model = EXCLUDED.model, generated_at = EXCLUDED.generated_at, source_version = EXCLUDED.source_version`).
+				mock.ExpectExec(`INSERT INTO board_summary (id,summary,model,generated_at,source_version,tenant_id) VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (tenant_id, id) DO UPDATE SET summary = EXCLUDED.summary, model = EXCLUDED.model, generated_at = EXCLUDED.generated_at, source_version = EXCLUDED.source_version`).
 					WithArgs(
 						summary.ID,
 						contentJSON,
 						summary.Model,
 						summary.GeneratedAt,
 						summary.SourceVersion,
+						tenant.Default,
 					).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
@@ -87,13 +91,14 @@ func TestBoardSummaryRepository_StoreSummary(t *testing.T) {
 		"database-error": {
 			summary: summary,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO board_summary (id,summary,model,generated_at,source_version) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (id) DO UPDATE SET summary = EXCLUDED.summary, model = EXCLUDED.model, generated_at = EXCLUDED.generated_at, source_version = EXCLUDED.source_version`).
+				mock.ExpectExec(`INSERT INTO board_summary (id,summary,model,generated_at,source_version,tenant_id) VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (tenant_id, id) DO UPDATE SET summary = EXCLUDED.summary, model = EXCLUDED.model, generated_at = EXCLUDED.generated_at, source_version = EXCLUDED.source_version`).
 					WithArgs(
 						summary.ID,
 						contentJSON,
 						summary.Model,
 						summary.GeneratedAt,
 						summary.SourceVersion,
+						tenant.Default,
 					).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -173,7 +178,7 @@ func TestBoardSummaryRepository_GetLatestSummary(t *testing.T) {
 						summary.GeneratedAt,
 						summary.SourceVersion,
 					)
-				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary ORDER BY generated_at DESC LIMIT 1`).
+				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary WHERE tenant_id = $1 ORDER BY generated_at DESC LIMIT 1`).
 					WillReturnRows(rows)
 			},
 			expectedSummary: summary,
@@ -182,7 +187,7 @@ func TestBoardSummaryRepository_GetLatestSummary(t *testing.T) {
 		},
 		"not-found": {
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary ORDER BY generated_at DESC LIMIT 1`).
+				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary WHERE tenant_id = $1 ORDER BY generated_at DESC LIMIT 1`).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectedSummary: todo.BoardSummary{},
@@ -191,7 +196,7 @@ func TestBoardSummaryRepository_GetLatestSummary(t *testing.T) {
 		},
 		"database-error": {
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary ORDER BY generated_at DESC LIMIT 1`).
+				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary WHERE tenant_id = $1 ORDER BY generated_at DESC LIMIT 1`).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedSummary: todo.BoardSummary{},
@@ -208,7 +213,7 @@ func TestBoardSummaryRepository_GetLatestSummary(t *testing.T) {
 						summary.GeneratedAt,
 						summary.SourceVersion,
 					)
-				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary ORDER BY generated_at DESC LIMIT 1`).
+				mock.ExpectQuery(`SELECT id, summary, model, generated_at, source_version FROM board_summary WHERE tenant_id = $1 ORDER BY generated_at DESC LIMIT 1`).
 					WillReturnRows(rows)
 			},
 			expectedSummary: todo.BoardSummary{},
@@ -246,6 +251,9 @@ func TestBoardSummaryRepository_GetLatestSummary(t *testing.T) {
 func TestBoardSummaryRepository_CalculateSummaryContent(t *testing.T) {
 	t.Parallel()
 
+	calculateSummaryQuery := strings.Replace(boardSummaryCTEQry, "tenant_id = ?", "tenant_id = $1", 1) +
+		" SELECT stats.counts, near_deadline.overdue, near_deadline.near_deadline, next_tasks.next_up FROM stats, near_deadline, next_tasks"
+
 	tests := map[string]struct {
 		setExpectations func(mock sqlmock.Sqlmock)
 		expectedSummary todo.BoardSummaryContent
@@ -256,19 +264,21 @@ func TestBoardSummaryRepository_CalculateSummaryContent(t *testing.T) {
 			setExpectations: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"counts", "overdue", "near_deadline", "next_up"}).
 					AddRow(
-						[]byte(`{"OPEN":4,"DONE":6}`),
+						[]byte(`{"OPEN":4,"DONE":6,"ARCHIVED":3}`),
 						[]byte(`["File annual report","Pay credit card bill"]`),
 						[]byte(`["Book flight tickets"]`),
 						[]byte(`[{"title":"Submit tax documents","reason":"Due in 2 days"}]`),
 					)
 
-				mock.ExpectQuery(boardSummaryCTEQry + ` SELECT stats.counts, near_deadline.overdue, near_deadline.near_deadline, next_tasks.next_up FROM stats, near_deadline, next_tasks`).
+				mock.ExpectQuery(calculateSummaryQuery).
+					WithArgs(tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedSummary: todo.BoardSummaryContent{
 				Counts: todo.StatusCounts{
-					Open: 4,
-					Done: 6,
+					Open:     4,
+					Done:     6,
+					Archived: 3,
 				},
 				NextUp: []todo.NextUpItem{
 					{
@@ -288,7 +298,8 @@ func TestBoardSummaryRepository_CalculateSummaryContent(t *testing.T) {
 		},
 		"database-error": {
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(boardSummaryCTEQry + ` SELECT stats.counts, near_deadline.overdue, near_deadline.near_deadline, next_tasks.next_up FROM stats, near_deadline, next_tasks`).
+				mock.ExpectQuery(calculateSummaryQuery).
+					WithArgs(tenant.Default).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedSummary: todo.BoardSummaryContent{},
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// changeLogLockKey is the advisory lock key serializing the change log writers of a tenant.
+const changeLogLockKey = "todo_changes"
+
+var changeFields = []string{
+	"sequence",
+	"todo_id",
+	"change_type",
+	"conversation_id",
+	"conversation_sequence",
+	"created_at",
+}
+
+// ChangeRepository implements the todo.ChangeRepository interface using PostgreSQL as the storage backend.
+type ChangeRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewChangeRepository creates a new instance of ChangeRepository.
+func NewChangeRepository(br sq.BaseRunner) ChangeRepository {
+	return ChangeRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// RecordChange appends a change to the log and returns it with its assigned sequence numbers.
+//
+// It must run inside a unit of work: the writers of a tenant are serialized by a transaction-level
+// advisory lock held until commit, so sequences become visible in the order they are assigned and
+// readers following the log never skip a change that commits late. The same lock keeps concurrent
+// writers from computing the same conversation sequence.
+func (r ChangeRepository) RecordChange(ctx context.Context, change todo.Change) (todo.Change, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Select().
+		Column(sq.Expr("pg_advisory_xact_lock(?)", advisoryLockKey(tenantOf(ctx)+":"+changeLogLockKey))).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Change{}, err
+	}
+
+	var conversationSequence any
+	if change.ConversationID != nil {
+		conversationSequence = sq.Expr(
+			"(SELECT COALESCE(MAX(conversation_sequence), 0) + 1 FROM todo_changes WHERE conversation_id = ? AND tenant_id = ?)",
+			*change.ConversationID,
+			tenantOf(ctx),
+		)
+	}
+
+	err = r.sb.
+		Insert("todo_changes").
+		Columns(changeFields[1:]...).
+		Columns(tenantColumn).
+		Values(
+			change.TodoID,
+			change.Type,
+			change.ConversationID,
+			conversationSequence,
+			change.CreatedAt,
+			tenantOf(ctx),
+		).
+		Suffix("RETURNING sequence, conversation_sequence").
+		QueryRowContext(spanCtx).
+		Scan(&change.Sequence, &change.ConversationSequence)
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Change{}, err
+	}
+
+	return change, nil
+}
+
+// ListChangesSince lists up to limit changes recorded after the since sequence, oldest first.
+func (r ChangeRepository) ListChangesSince(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]todo.Change, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, false, core.NewValidationErr("limit must be greater than 0")
+	}
+
+	qry := r.sb.
+		Select(changeFields...).
+		From("todo_changes").
+		Limit(uint64(limit + 1)) // fetch one extra to determine if there's more
+
+	if conversationID != nil {
+		qry = qry.
+			Where(sq.Eq{"conversation_id": *conversationID}).
+			Where(sq.Gt{"conversation_sequence": since}).
+			OrderBy("conversation_sequence ASC")
+	} else {
+		qry = qry.
+			Where(sq.Gt{"sequence": since}).
+			OrderBy("sequence ASC")
+	}
+	qry = qry.Where(tenantEq(ctx))
+
+	rows, err := qry.QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	changes := []todo.Change{}
+	for rows.Next() {
+		var c todo.Change
+		if err := rows.Scan(
+			&c.Sequence,
+			&c.TodoID,
+			&c.Type,
+			&c.ConversationID,
+			&c.ConversationSequence,
+			&c.CreatedAt,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, false, err
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	hasMore := false
+	if len(changes) > limit {
+		hasMore = true
+		changes = changes[:limit]
+	}
+
+	return changes, hasMore, nil
+}
+
+// LatestSequences returns the latest global sequence and the latest sequence of the given conversation.
+func (r ChangeRepository) LatestSequences(ctx context.Context, conversationID uuid.UUID) (todo.ChangeSequences, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var sequences todo.ChangeSequences
+	err := r.sb.
+		Select().
+		Column(sq.Expr(
+			"(SELECT COALESCE(MAX(sequence), 0) FROM todo_changes WHERE tenant_id = ?)",
+			tenantOf(ctx),
+		)).
+		Column(sq.Expr(
+			"(SELECT COALESCE(MAX(conversation_sequence), 0) FROM todo_changes WHERE conversation_id = ? AND tenant_id = ?)",
+			conversationID,
+			tenantOf(ctx),
+		)).
+		QueryRowContext(spanCtx).
+		Scan(&sequences.Global, &sequences.Conversation)
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.ChangeSequences{}, err
+	}
+
+	return sequences, nil
+}
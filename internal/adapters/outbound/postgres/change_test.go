@@ -0,0 +1,261 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeRepository_RecordChange(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	conversationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+
+	tests := map[string]struct {
+		change    todo.Change
+		expect    func(sqlmock.Sqlmock)
+		want      todo.Change
+		expectErr bool
+	}{
+		"global-change": {
+			change: todo.Change{TodoID: todoID, Type: todo.ChangeType_Created, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+					WithArgs(advisoryLockKey(string(tenant.Default) + ":todo_changes")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectQuery(
+					"INSERT INTO todo_changes (todo_id,change_type,conversation_id,conversation_sequence,created_at,tenant_id) "+
+						"VALUES ($1,$2,$3,$4,$5,$6) RETURNING sequence, conversation_sequence",
+				).
+					WithArgs(todoID, todo.ChangeType_Created, nil, nil, createdAt, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"sequence", "conversation_sequence"}).AddRow(int64(42), nil))
+			},
+			want: todo.Change{Sequence: 42, TodoID: todoID, Type: todo.ChangeType_Created, CreatedAt: createdAt},
+		},
+		"conversation-change": {
+			change: todo.Change{TodoID: todoID, Type: todo.ChangeType_Updated, ConversationID: &conversationID, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+					WithArgs(advisoryLockKey(string(tenant.Default) + ":todo_changes")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectQuery(
+					"INSERT INTO todo_changes (todo_id,change_type,conversation_id,conversation_sequence,created_at,tenant_id) "+
+						"VALUES ($1,$2,$3,(SELECT COALESCE(MAX(conversation_sequence), 0) + 1 FROM todo_changes WHERE conversation_id = $4 AND tenant_id = $5),$6,$7) "+
+						"RETURNING sequence, conversation_sequence",
+				).
+					WithArgs(todoID, todo.ChangeType_Updated, &conversationID, conversationID, tenant.Default, createdAt, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"sequence", "conversation_sequence"}).AddRow(int64(43), int64(3)))
+			},
+			want: todo.Change{
+				Sequence:             43,
+				TodoID:               todoID,
+				Type:                 todo.ChangeType_Updated,
+				ConversationID:       &conversationID,
+				ConversationSequence: common.Ptr(int64(3)),
+				CreatedAt:            createdAt,
+			},
+		},
+		"database-error": {
+			change: todo.Change{TodoID: todoID, Type: todo.ChangeType_Deleted, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+					WithArgs(advisoryLockKey(string(tenant.Default) + ":todo_changes")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectQuery(
+					"INSERT INTO todo_changes (todo_id,change_type,conversation_id,conversation_sequence,created_at,tenant_id) " +
+						"VALUES ($1,$2,$3,$4,$5,$6) RETURNING sequence, conversation_sequence",
+				).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		"lock-error": {
+			change: todo.Change{TodoID: todoID, Type: todo.ChangeType_Created, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("SELECT pg_advisory_xact_lock($1)").
+					WillReturnError(errors.New("lock error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChangeRepository(db)
+			got, gotErr := repo.RecordChange(t.Context(), tt.change)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.want, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChangeRepository_ListChangesSince(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	conversationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	columns := []string{"sequence", "todo_id", "change_type", "conversation_id", "conversation_sequence", "created_at"}
+
+	tests := map[string]struct {
+		since          int64
+		conversationID *uuid.UUID
+		limit          int
+		expect         func(sqlmock.Sqlmock)
+		want           []todo.Change
+		wantHasMore    bool
+		expectErr      error
+	}{
+		"global-changes-with-more": {
+			since: 10,
+			limit: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(
+					"SELECT sequence, todo_id, change_type, conversation_id, conversation_sequence, created_at "+
+						"FROM todo_changes WHERE sequence > $1 AND tenant_id = $2 ORDER BY sequence ASC LIMIT 2",
+				).
+					WithArgs(int64(10), tenant.Default).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(int64(11), todoID, "CREATED", nil, nil, createdAt).
+						AddRow(int64(12), todoID, "UPDATED", nil, nil, createdAt))
+			},
+			want: []todo.Change{
+				{Sequence: 11, TodoID: todoID, Type: todo.ChangeType_Created, CreatedAt: createdAt},
+			},
+			wantHasMore: true,
+		},
+		"conversation-changes": {
+			since:          2,
+			conversationID: &conversationID,
+			limit:          10,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(
+					"SELECT sequence, todo_id, change_type, conversation_id, conversation_sequence, created_at "+
+						"FROM todo_changes WHERE conversation_id = $1 AND conversation_sequence > $2 AND tenant_id = $3 ORDER BY conversation_sequence ASC LIMIT 11",
+				).
+					WithArgs(conversationID, int64(2), tenant.Default).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(int64(40), todoID, "DELETED", conversationID, int64(3), createdAt))
+			},
+			want: []todo.Change{
+				{
+					Sequence:             40,
+					TodoID:               todoID,
+					Type:                 todo.ChangeType_Deleted,
+					ConversationID:       &conversationID,
+					ConversationSequence: common.Ptr(int64(3)),
+					CreatedAt:            createdAt,
+				},
+			},
+		},
+		"invalid-limit": {
+			limit:     0,
+			expect:    func(m sqlmock.Sqlmock) {},
+			expectErr: core.NewValidationErr("limit must be greater than 0"),
+		},
+		"database-error": {
+			limit: 5,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(
+					"SELECT sequence, todo_id, change_type, conversation_id, conversation_sequence, created_at " +
+						"FROM todo_changes WHERE sequence > $1 AND tenant_id = $2 ORDER BY sequence ASC LIMIT 6",
+				).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectErr: sql.ErrConnDone,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChangeRepository(db)
+			got, hasMore, gotErr := repo.ListChangesSince(t.Context(), tt.since, tt.conversationID, tt.limit)
+			assert.Equal(t, tt.expectErr, gotErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChangeRepository_LatestSequences(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	query := "SELECT (SELECT COALESCE(MAX(sequence), 0) FROM todo_changes WHERE tenant_id = $1), " +
+		"(SELECT COALESCE(MAX(conversation_sequence), 0) FROM todo_changes WHERE conversation_id = $2 AND tenant_id = $3)"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		want      todo.ChangeSequences
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(tenant.Default, conversationID, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"global", "conversation"}).AddRow(int64(42), int64(3)))
+			},
+			want: todo.ChangeSequences{Global: 42, Conversation: 3},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(tenant.Default, conversationID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChangeRepository(db)
+			got, gotErr := repo.LatestSequences(t.Context(), conversationID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.want, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
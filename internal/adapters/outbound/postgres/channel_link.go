@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+var channelLinkFields = []string{
+	"channel",
+	"external_chat_id",
+	"conversation_id",
+	"created_at",
+	"updated_at",
+}
+
+// ChannelLinkRepository is a PostgreSQL implementation of assistant.ChannelLinkRepository.
+type ChannelLinkRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewChannelLinkRepository creates a new instance of ChannelLinkRepository.
+func NewChannelLinkRepository(br squirrel.BaseRunner) ChannelLinkRepository {
+	return ChannelLinkRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// GetChannelLink retrieves the link of an external chat.
+func (r ChannelLinkRepository) GetChannelLink(
+	ctx context.Context,
+	channel assistant.Channel,
+	externalChatID string,
+) (assistant.ChannelLink, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var link assistant.ChannelLink
+	err := r.sb.
+		Select(channelLinkFields...).
+		From("channel_links").
+		Where(squirrel.Eq{"channel": channel, "external_chat_id": externalChatID}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx).
+		Scan(
+			&link.Channel,
+			&link.ExternalChatID,
+			&link.ConversationID,
+			&link.CreatedAt,
+			&link.UpdatedAt,
+		)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return assistant.ChannelLink{}, false, nil
+	}
+
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.ChannelLink{}, false, err
+	}
+
+	return link, true, nil
+}
+
+// SaveChannelLink upserts the link of an external chat. The creation time of an existing link is kept.
+func (r ChannelLinkRepository) SaveChannelLink(ctx context.Context, link assistant.ChannelLink) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("channel_links").
+		Columns(channelLinkFields...).
+		Columns(tenantColumn).
+		Values(
+			link.Channel,
+			link.ExternalChatID,
+			link.ConversationID,
+			link.CreatedAt,
+			link.UpdatedAt,
+			tenantOf(ctx),
+		).
+		Suffix(`ON CONFLICT (tenant_id, channel, external_chat_id) DO UPDATE SET
+			conversation_id = EXCLUDED.conversation_id,
+			updated_at = EXCLUDED.updated_at`).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteChannelLink deletes the link of an external chat.
+func (r ChannelLinkRepository) DeleteChannelLink(ctx context.Context, channel assistant.Channel, externalChatID string) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("channel_links").
+		Where(squirrel.Eq{"channel": channel, "external_chat_id": externalChatID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
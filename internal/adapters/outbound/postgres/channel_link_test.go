@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelLinkRepository_GetChannelLink(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2026, 2, 15, 11, 0, 0, 0, time.UTC)
+	query := "SELECT channel, external_chat_id, conversation_id, created_at, updated_at FROM channel_links " +
+		"WHERE channel = $1 AND external_chat_id = $2 AND tenant_id = $3"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     assistant.ChannelLink
+		expectedFind bool
+		expectErr    bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(channelLinkFields).
+					AddRow("telegram", "42", conversationID, createdAt, updatedAt)
+				m.ExpectQuery(query).
+					WithArgs(assistant.Channel_Telegram, "42", tenant.Default).
+					WillReturnRows(rows)
+			},
+			expected: assistant.ChannelLink{
+				Channel:        assistant.Channel_Telegram,
+				ExternalChatID: "42",
+				ConversationID: conversationID,
+				CreatedAt:      createdAt,
+				UpdatedAt:      updatedAt,
+			},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(assistant.Channel_Telegram, "42", tenant.Default).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(assistant.Channel_Telegram, "42", tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChannelLinkRepository(db)
+			got, found, gotErr := repo.GetChannelLink(t.Context(), assistant.Channel_Telegram, "42")
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChannelLinkRepository_SaveChannelLink(t *testing.T) {
+	t.Parallel()
+
+	link := assistant.ChannelLink{
+		Channel:        assistant.Channel_Telegram,
+		ExternalChatID: "42",
+		ConversationID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		CreatedAt:      time.Date(2026, 2, 15, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2026, 2, 15, 11, 0, 0, 0, time.UTC),
+	}
+	query := "INSERT INTO channel_links (channel,external_chat_id,conversation_id,created_at,updated_at,tenant_id) " +
+		"VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (tenant_id, channel, external_chat_id) DO UPDATE SET " +
+		"conversation_id = EXCLUDED.conversation_id, updated_at = EXCLUDED.updated_at"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(link.Channel, link.ExternalChatID, link.ConversationID, link.CreatedAt, link.UpdatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(link.Channel, link.ExternalChatID, link.ConversationID, link.CreatedAt, link.UpdatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChannelLinkRepository(db)
+			gotErr := repo.SaveChannelLink(t.Context(), link)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChannelLinkRepository_DeleteChannelLink(t *testing.T) {
+	t.Parallel()
+
+	query := "DELETE FROM channel_links WHERE channel = $1 AND external_chat_id = $2 AND tenant_id = $3"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(assistant.Channel_Telegram, "42", tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(assistant.Channel_Telegram, "42", tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChannelLinkRepository(db)
+			gotErr := repo.DeleteChannelLink(t.Context(), assistant.Channel_Telegram, "42")
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"encoding/json"
 	"sort"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
@@ -35,6 +36,12 @@ var chatFields = []string{
 	"approval_decided_at",
 	"selected_skills",
 	"action_executed",
+	"experiment_variant",
+	"action_loop_detected",
+	"feedback_score",
+	"seed",
+	"superseded_at",
+	"content_ref",
 	"created_at",
 	"updated_at",
 }
@@ -51,16 +58,19 @@ func NewChatMessageRepository(br sq.BaseRunner) ChatMessageRepository {
 	}
 }
 
-// CreateChatMessages persists chat messages for the global conversation.
+// CreateChatMessages persists chat messages for the global conversation, replacing the ones with the same ID.
 func (r ChatMessageRepository) CreateChatMessages(ctx context.Context, messages []assistant.ChatMessage) error {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
 	insertQry := r.sb.
 		Insert("chat_messages").
-		Columns(chatFields...)
+		Columns(chatFields...).
+		Columns(tenantColumn)
 
+	messageIDs := make([]uuid.UUID, 0, len(messages))
 	for _, message := range messages {
+		messageIDs = append(messageIDs, message.ID)
 		actionCallsJSON, err := json.Marshal(message.ActionCalls)
 		if telemetry.IsErrorRecorded(span, err) {
 			return err
@@ -69,6 +79,10 @@ func (r ChatMessageRepository) CreateChatMessages(ctx context.Context, messages
 		if telemetry.IsErrorRecorded(span, err) {
 			return err
 		}
+		experimentJSON, err := marshalExperiment(message.Experiment)
+		if telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
 
 		insertQry = insertQry.Values(
 			message.ID,
@@ -91,12 +105,50 @@ func (r ChatMessageRepository) CreateChatMessages(ctx context.Context, messages
 			message.ApprovalDecidedAt,
 			selectedSkillsJSON,
 			message.ActionExecuted,
+			experimentJSON,
+			message.ActionLoopDetected,
+			message.FeedbackScore,
+			message.Seed,
+			message.SupersededAt,
+			message.ContentRef,
 			message.CreatedAt,
 			message.UpdatedAt,
+			tenantOf(ctx),
 		)
 	}
 
-	_, err := insertQry.ExecContext(spanCtx)
+	// Streaming checkpoints are written under the ID of the final message, which replaces them. The final
+	// message is created after its checkpoints and may fall in another monthly partition, so the checkpoints
+	// are deleted instead of being updated in place.
+	_, err := r.sb.
+		Delete("chat_messages").
+		Where(sq.Eq{"id": messageIDs, "message_state": assistant.ChatMessageState_Streaming}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	insertQry = insertQry.Suffix(`ON CONFLICT (id, created_at) DO UPDATE SET
+			turn_sequence = EXCLUDED.turn_sequence,
+			content = EXCLUDED.content,
+			content_ref = EXCLUDED.content_ref,
+			action_calls = EXCLUDED.action_calls,
+			model = EXCLUDED.model,
+			message_state = EXCLUDED.message_state,
+			error_message = EXCLUDED.error_message,
+			prompt_tokens = EXCLUDED.prompt_tokens,
+			completion_tokens = EXCLUDED.completion_tokens,
+			total_tokens = EXCLUDED.total_tokens,
+			context_tokens_estimate = EXCLUDED.context_tokens_estimate,
+			selected_skills = EXCLUDED.selected_skills,
+			experiment_variant = EXCLUDED.experiment_variant,
+			action_loop_detected = EXCLUDED.action_loop_detected,
+			seed = EXCLUDED.seed,
+			updated_at = EXCLUDED.updated_at
+		WHERE chat_messages.tenant_id = EXCLUDED.tenant_id`)
+
+	_, err = insertQry.ExecContext(spanCtx)
 	if telemetry.IsErrorRecorded(span, err) {
 		return err
 	}
@@ -105,6 +157,8 @@ func (r ChatMessageRepository) CreateChatMessages(ctx context.Context, messages
 
 // ListChatMessages retrieves messages ordered by creation time using optional filters.
 // If limit > 0, returns up to N messages and indicates whether more messages exist.
+// The messages are bounded by the creation time of the conversation, so only the monthly partitions
+// written since then are scanned.
 func (r ChatMessageRepository) ListChatMessages(
 	ctx context.Context,
 	conversationID uuid.UUID,
@@ -129,7 +183,9 @@ func (r ChatMessageRepository) ListChatMessages(
 	qry := r.sb.
 		Select(chatFields...).
 		From("chat_messages").
-		Where(sq.Eq{"conversation_id": conversationID})
+		Where(sq.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
+		Where(conversationPartitionBound(ctx, "chat_messages.created_at", conversationID))
 
 	if queryOptions.AfterMessageID != nil {
 		span.SetAttributes(
@@ -147,6 +203,7 @@ func (r ChatMessageRepository) ListChatMessages(
 					"conversation_id": conversationID,
 					"id":              *queryOptions.AfterMessageID,
 				}).
+				Where(conversationPartitionBound(ctx, "created_at", conversationID)).
 				Limit(1).
 				Prefix("LEFT JOIN (").
 				Suffix(") checkpoint ON TRUE"),
@@ -166,6 +223,41 @@ func (r ChatMessageRepository) ListChatMessages(
 		qry = qry.OrderBy("created_at DESC", "id DESC")
 	}
 
+	if queryOptions.BeforeMessageID != nil {
+		span.SetAttributes(
+			attribute.String("before_message_id", queryOptions.BeforeMessageID.String()),
+		)
+
+		qry = qry.JoinClause(
+			r.sb.
+				Select(
+					"created_at AS before_created_at",
+					"id AS before_id",
+				).
+				From("chat_messages").
+				Where(sq.Eq{
+					"conversation_id": conversationID,
+					"id":              *queryOptions.BeforeMessageID,
+				}).
+				Where(conversationPartitionBound(ctx, "created_at", conversationID)).
+				Limit(1).
+				Prefix("JOIN (").
+				Suffix(") before_checkpoint ON TRUE"),
+		).Where(
+			sq.Or{
+				sq.Expr("chat_messages.created_at < before_checkpoint.before_created_at"),
+				sq.And{
+					sq.Expr("chat_messages.created_at = before_checkpoint.before_created_at"),
+					sq.Expr("chat_messages.id < before_checkpoint.before_id"),
+				},
+			},
+		)
+	}
+
+	if queryOptions.TurnID != nil {
+		qry = qry.Where(sq.Eq{"turn_id": *queryOptions.TurnID})
+	}
+
 	if pageSize > 0 {
 		qry = qry.Limit(uint64(pageSize + 1)) // fetch one extra to detect more
 	}
@@ -186,6 +278,7 @@ func (r ChatMessageRepository) ListChatMessages(
 			m                  assistant.ChatMessage
 			tcJSON             []byte
 			selectedSkillsJSON []byte
+			experimentJSON     []byte
 		)
 
 		if err := rows.Scan(
@@ -209,6 +302,12 @@ func (r ChatMessageRepository) ListChatMessages(
 			&m.ApprovalDecidedAt,
 			&selectedSkillsJSON,
 			&m.ActionExecuted,
+			&experimentJSON,
+			&m.ActionLoopDetected,
+			&m.FeedbackScore,
+			&m.Seed,
+			&m.SupersededAt,
+			&m.ContentRef,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 		); telemetry.IsErrorRecorded(span, err) {
@@ -225,6 +324,11 @@ func (r ChatMessageRepository) ListChatMessages(
 				return nil, false, err
 			}
 		}
+		if len(experimentJSON) > 0 {
+			if err := json.Unmarshal(experimentJSON, &m.Experiment); telemetry.IsErrorRecorded(span, err) {
+				return nil, false, err
+			}
+		}
 		msgs = append(msgs, m)
 	}
 	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
@@ -248,6 +352,18 @@ func (r ChatMessageRepository) ListChatMessages(
 	return msgs, hasMore, nil
 }
 
+// conversationPartitionBound bounds the creation time in column to the messages of a conversation, which
+// lets the planner skip the partitions of earlier months. A message can be stamped slightly before its
+// conversation, such as a weekly review stamped before the conversation holding it is created, so the bound
+// starts a day before the conversation. Messages of unknown conversations are not bounded.
+func conversationPartitionBound(ctx context.Context, column string, conversationID uuid.UUID) sq.Sqlizer {
+	return sq.Expr(
+		column+" >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations "+
+			"WHERE conversations.id = ? AND conversations.tenant_id = ?), '-infinity')",
+		conversationID, tenantOf(ctx),
+	)
+}
+
 // DeleteChatMessages removes specific chat messages by ID.
 func (r ChatMessageRepository) DeleteChatMessages(ctx context.Context, messageIDs []uuid.UUID) error {
 	spanCtx, span := telemetry.StartSpan(ctx)
@@ -260,6 +376,7 @@ func (r ChatMessageRepository) DeleteChatMessages(ctx context.Context, messageID
 	_, err := r.sb.
 		Delete("chat_messages").
 		Where(sq.Eq{"id": messageIDs}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 
 	if telemetry.IsErrorRecorded(span, err) {
@@ -276,6 +393,7 @@ func (r ChatMessageRepository) DeleteConversationMessages(ctx context.Context, c
 	_, err := r.sb.
 		Delete("chat_messages").
 		Where(sq.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 
 	if telemetry.IsErrorRecorded(span, err) {
@@ -283,3 +401,59 @@ func (r ChatMessageRepository) DeleteConversationMessages(ctx context.Context, c
 	}
 	return nil
 }
+
+// UpdateChatMessageFeedback sets the feedback score of an assistant message.
+func (r ChatMessageRepository) UpdateChatMessageFeedback(ctx context.Context, messageID uuid.UUID, score int) (bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("message_id", messageID.String()),
+		attribute.Int("score", score),
+	))
+	defer span.End()
+
+	result, err := r.sb.
+		Update("chat_messages").
+		Set("feedback_score", score).
+		Where(sq.Eq{"id": messageID, "chat_role": assistant.ChatRole_Assistant}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if telemetry.IsErrorRecorded(span, err) {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// SupersedeChatMessages marks specific chat messages by ID as superseded at the given time.
+func (r ChatMessageRepository) SupersedeChatMessages(ctx context.Context, messageIDs []uuid.UUID, supersededAt time.Time) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.sb.
+		Update("chat_messages").
+		Set("superseded_at", supersededAt).
+		Set("updated_at", supersededAt).
+		Where(sq.Eq{"id": messageIDs}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	return nil
+}
+
+// marshalExperiment encodes the experiment assignment of a message, leaving it NULL when unset.
+func marshalExperiment(experiment *assistant.ExperimentAssignment) ([]byte, error) {
+	if experiment == nil {
+		return nil, nil
+	}
+	return json.Marshal(experiment)
+}
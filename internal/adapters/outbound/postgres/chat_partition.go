@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	chatMessagePartitionPrefix = "chat_messages_p"
+	chatMessagePartitionLayout = "200601"
+)
+
+// ChatMessagePartitionRepository is a PostgreSQL implementation of assistant.ChatMessagePartitionRepository.
+// The partitions are named chat_messages_pYYYYMM after the month they hold.
+type ChatMessagePartitionRepository struct {
+	db *sql.DB
+	sb sq.StatementBuilderType
+}
+
+// NewChatMessagePartitionRepository creates a new instance of ChatMessagePartitionRepository.
+func NewChatMessagePartitionRepository(db *sql.DB) ChatMessagePartitionRepository {
+	return ChatMessagePartitionRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(db),
+	}
+}
+
+// EnsureChatMessagePartitions creates the missing partitions of the months from the month of from through
+// the month of to.
+func (r ChatMessagePartitionRepository) EnsureChatMessagePartitions(ctx context.Context, from time.Time, to time.Time) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("from", from.String()),
+		attribute.String("to", to.String()),
+	))
+	defer span.End()
+
+	for month := assistant.ChatMessagePartitionMonth(from); !month.After(to); month = month.AddDate(0, 1, 0) {
+		// DDL takes no placeholders; the name and bounds are rendered from the month only.
+		_, err := r.db.ExecContext(spanCtx, fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF chat_messages FOR VALUES FROM ('%s') TO ('%s')",
+			chatMessagePartitionName(month),
+			month.Format(time.RFC3339),
+			month.AddDate(0, 1, 0).Format(time.RFC3339),
+		))
+		if telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DropChatMessagePartitionsBefore drops the partitions of the months ending at or before before and deletes
+// the content blobs no remaining message references.
+func (r ChatMessagePartitionRepository) DropChatMessagePartitionsBefore(ctx context.Context, before time.Time) ([]time.Time, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("before", before.String()),
+	))
+	defer span.End()
+
+	months, err := r.listPartitionMonths(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	var dropped []time.Time
+	for _, month := range months {
+		if month.AddDate(0, 1, 0).After(before) {
+			continue
+		}
+		_, err := r.db.ExecContext(spanCtx, "DROP TABLE IF EXISTS "+chatMessagePartitionName(month))
+		if telemetry.IsErrorRecorded(span, err) {
+			return dropped, err
+		}
+		dropped = append(dropped, month)
+	}
+	span.SetAttributes(attribute.Int("dropped", len(dropped)))
+
+	if len(dropped) == 0 {
+		return nil, nil
+	}
+
+	// Blobs of every tenant may have lost their last message with the dropped months.
+	_, err = r.sb.
+		Delete("chat_content_blobs").
+		Where("NOT EXISTS (SELECT 1 FROM chat_messages WHERE chat_messages.tenant_id = chat_content_blobs.tenant_id " +
+			"AND chat_messages.content_ref = chat_content_blobs.hash)").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return dropped, err
+	}
+
+	return dropped, nil
+}
+
+// listPartitionMonths returns the months of the chat message partitions, ignoring partitions named otherwise.
+func (r ChatMessagePartitionRepository) listPartitionMonths(ctx context.Context) ([]time.Time, error) {
+	rows, err := r.sb.
+		Select("child.relname").
+		From("pg_inherits").
+		Join("pg_class parent ON parent.oid = pg_inherits.inhparent").
+		Join("pg_class child ON child.oid = pg_inherits.inhrelid").
+		Where(sq.Eq{"parent.relname": "chat_messages"}).
+		OrderBy("child.relname").
+		QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var months []time.Time
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		suffix, ok := strings.CutPrefix(name, chatMessagePartitionPrefix)
+		if !ok {
+			continue
+		}
+		month, err := time.Parse(chatMessagePartitionLayout, suffix)
+		if err != nil {
+			continue
+		}
+		months = append(months, month)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return months, nil
+}
+
+// chatMessagePartitionName returns the name of the partition holding the messages of month.
+func chatMessagePartitionName(month time.Time) string {
+	return chatMessagePartitionPrefix + month.UTC().Format(chatMessagePartitionLayout)
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatMessagePartitionRepository_EnsureChatMessagePartitions(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 11, 16, 8, 0, 0, 0, time.UTC)
+	to := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("CREATE TABLE IF NOT EXISTS chat_messages_p202611 PARTITION OF chat_messages " +
+					"FOR VALUES FROM ('2026-11-01T00:00:00Z') TO ('2026-12-01T00:00:00Z')").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec("CREATE TABLE IF NOT EXISTS chat_messages_p202612 PARTITION OF chat_messages " +
+					"FOR VALUES FROM ('2026-12-01T00:00:00Z') TO ('2027-01-01T00:00:00Z')").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec("CREATE TABLE IF NOT EXISTS chat_messages_p202701 PARTITION OF chat_messages " +
+					"FOR VALUES FROM ('2027-01-01T00:00:00Z') TO ('2027-02-01T00:00:00Z')").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("CREATE TABLE IF NOT EXISTS chat_messages_p202611 PARTITION OF chat_messages " +
+					"FOR VALUES FROM ('2026-11-01T00:00:00Z') TO ('2026-12-01T00:00:00Z')").
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChatMessagePartitionRepository(db)
+			gotErr := repo.EnsureChatMessagePartitions(t.Context(), from, to)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChatMessagePartitionRepository_DropChatMessagePartitionsBefore(t *testing.T) {
+	t.Parallel()
+
+	before := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	listQuery := "SELECT child.relname FROM pg_inherits " +
+		"JOIN pg_class parent ON parent.oid = pg_inherits.inhparent " +
+		"JOIN pg_class child ON child.oid = pg_inherits.inhrelid " +
+		"WHERE parent.relname = $1 ORDER BY child.relname"
+	blobQuery := "DELETE FROM chat_content_blobs WHERE NOT EXISTS (SELECT 1 FROM chat_messages " +
+		"WHERE chat_messages.tenant_id = chat_content_blobs.tenant_id AND chat_messages.content_ref = chat_content_blobs.hash)"
+	partitions := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"relname"}).
+			AddRow("chat_messages_legacy").
+			AddRow("chat_messages_p202607").
+			AddRow("chat_messages_p202608").
+			AddRow("chat_messages_p202609")
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []time.Time
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(listQuery).WithArgs("chat_messages").WillReturnRows(partitions())
+				m.ExpectExec("DROP TABLE IF EXISTS chat_messages_p202607").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec("DROP TABLE IF EXISTS chat_messages_p202608").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(blobQuery).WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			expected: []time.Time{
+				time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"nothing-to-drop": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(listQuery).WithArgs("chat_messages").WillReturnRows(
+					sqlmock.NewRows([]string{"relname"}).AddRow("chat_messages_p202609"),
+				)
+			},
+		},
+		"list-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(listQuery).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		"drop-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(listQuery).WithArgs("chat_messages").WillReturnRows(partitions())
+				m.ExpectExec("DROP TABLE IF EXISTS chat_messages_p202607").WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		"blob-cleanup-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(listQuery).WithArgs("chat_messages").WillReturnRows(partitions())
+				m.ExpectExec("DROP TABLE IF EXISTS chat_messages_p202607").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec("DROP TABLE IF EXISTS chat_messages_p202608").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(blobQuery).WillReturnError(errors.New("db error"))
+			},
+			expected: []time.Time{
+				time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChatMessagePartitionRepository(db)
+			got, gotErr := repo.DropChatMessagePartitionsBefore(t.Context(), before)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.Equal(t, tt.expected, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -46,8 +47,15 @@ func TestChatMessageRepository_CreateChatMessages(t *testing.T) {
 			},
 		},
 		ActionExecuted: common.Ptr(true),
-		CreatedAt:      fixedTime,
-		UpdatedAt:      updatedAt,
+		Experiment: &assistant.ExperimentAssignment{
+			Experiment:    "prompt-v2",
+			Variant:       "concise",
+			PromptVersion: "concise",
+		},
+		ActionLoopDetected: true,
+		Seed:               common.Ptr(int64(42)),
+		CreatedAt:          fixedTime,
+		UpdatedAt:          updatedAt,
 	}
 
 	tests := map[string]struct {
@@ -56,7 +64,28 @@ func TestChatMessageRepository_CreateChatMessages(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("INSERT INTO chat_messages (id,conversation_id,turn_id,turn_sequence,chat_role,content,action_call_id,action_calls,model,message_state,error_message,prompt_tokens,completion_tokens,total_tokens,context_tokens_estimate,approval_status,approval_decision_reason,approval_decided_at,selected_skills,action_executed,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22)").
+				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1) AND message_state = $2 AND tenant_id = $3").
+					WithArgs(msg.ID, assistant.ChatMessageState_Streaming, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec("INSERT INTO chat_messages (id,conversation_id,turn_id,turn_sequence,chat_role,content,action_call_id,action_calls,model,message_state,error_message,prompt_tokens,completion_tokens,total_tokens,context_tokens_estimate,approval_status,approval_decision_reason,approval_decided_at,selected_skills,action_executed,experiment_variant,action_loop_detected,feedback_score,seed,superseded_at,content_ref,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29) "+
+					"ON CONFLICT (id, created_at) DO UPDATE SET\n"+
+					"\t\t\tturn_sequence = EXCLUDED.turn_sequence,\n"+
+					"\t\t\tcontent = EXCLUDED.content,\n"+
+					"\t\t\tcontent_ref = EXCLUDED.content_ref,\n"+
+					"\t\t\taction_calls = EXCLUDED.action_calls,\n"+
+					"\t\t\tmodel = EXCLUDED.model,\n"+
+					"\t\t\tmessage_state = EXCLUDED.message_state,\n"+
+					"\t\t\terror_message = EXCLUDED.error_message,\n"+
+					"\t\t\tprompt_tokens = EXCLUDED.prompt_tokens,\n"+
+					"\t\t\tcompletion_tokens = EXCLUDED.completion_tokens,\n"+
+					"\t\t\ttotal_tokens = EXCLUDED.total_tokens,\n"+
+					"\t\t\tcontext_tokens_estimate = EXCLUDED.context_tokens_estimate,\n"+
+					"\t\t\tselected_skills = EXCLUDED.selected_skills,\n"+
+					"\t\t\texperiment_variant = EXCLUDED.experiment_variant,\n"+
+					"\t\t\taction_loop_detected = EXCLUDED.action_loop_detected,\n"+
+					"\t\t\tseed = EXCLUDED.seed,\n"+
+					"\t\t\tupdated_at = EXCLUDED.updated_at\n"+
+					"\t\tWHERE chat_messages.tenant_id = EXCLUDED.tenant_id").
 					WithArgs(
 						msg.ID,
 						msg.ConversationID,
@@ -78,16 +107,52 @@ func TestChatMessageRepository_CreateChatMessages(t *testing.T) {
 						msg.ApprovalDecidedAt,
 						[]byte(`[{"Name":"update_todos","Source":"skills/update_todos.md","Tools":["fetch_todos","update_todos"]}]`),
 						msg.ActionExecuted,
+						[]byte(`{"experiment":"prompt-v2","variant":"concise","prompt_version":"concise"}`),
+						true,
+						nil,
+						msg.Seed,
+						msg.SupersededAt,
+						msg.ContentRef,
 						msg.CreatedAt,
 						msg.UpdatedAt,
+						tenant.Default,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			err: nil,
 		},
+		"checkpoint-delete-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1) AND message_state = $2 AND tenant_id = $3").
+					WithArgs(msg.ID, assistant.ChatMessageState_Streaming, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("INSERT INTO chat_messages (id,conversation_id,turn_id,turn_sequence,chat_role,content,action_call_id,action_calls,model,message_state,error_message,prompt_tokens,completion_tokens,total_tokens,context_tokens_estimate,approval_status,approval_decision_reason,approval_decided_at,selected_skills,action_executed,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22)").
+				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1) AND message_state = $2 AND tenant_id = $3").
+					WithArgs(msg.ID, assistant.ChatMessageState_Streaming, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec("INSERT INTO chat_messages (id,conversation_id,turn_id,turn_sequence,chat_role,content,action_call_id,action_calls,model,message_state,error_message,prompt_tokens,completion_tokens,total_tokens,context_tokens_estimate,approval_status,approval_decision_reason,approval_decided_at,selected_skills,action_executed,experiment_variant,action_loop_detected,feedback_score,seed,superseded_at,content_ref,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29) "+
+					"ON CONFLICT (id, created_at) DO UPDATE SET\n"+
+					"\t\t\tturn_sequence = EXCLUDED.turn_sequence,\n"+
+					"\t\t\tcontent = EXCLUDED.content,\n"+
+					"\t\t\tcontent_ref = EXCLUDED.content_ref,\n"+
+					"\t\t\taction_calls = EXCLUDED.action_calls,\n"+
+					"\t\t\tmodel = EXCLUDED.model,\n"+
+					"\t\t\tmessage_state = EXCLUDED.message_state,\n"+
+					"\t\t\terror_message = EXCLUDED.error_message,\n"+
+					"\t\t\tprompt_tokens = EXCLUDED.prompt_tokens,\n"+
+					"\t\t\tcompletion_tokens = EXCLUDED.completion_tokens,\n"+
+					"\t\t\ttotal_tokens = EXCLUDED.total_tokens,\n"+
+					"\t\t\tcontext_tokens_estimate = EXCLUDED.context_tokens_estimate,\n"+
+					"\t\t\tselected_skills = EXCLUDED.selected_skills,\n"+
+					"\t\t\texperiment_variant = EXCLUDED.experiment_variant,\n"+
+					"\t\t\taction_loop_detected = EXCLUDED.action_loop_detected,\n"+
+					"\t\t\tseed = EXCLUDED.seed,\n"+
+					"\t\t\tupdated_at = EXCLUDED.updated_at\n"+
+					"\t\tWHERE chat_messages.tenant_id = EXCLUDED.tenant_id").
 					WithArgs(
 						msg.ID,
 						msg.ConversationID,
@@ -109,8 +174,15 @@ func TestChatMessageRepository_CreateChatMessages(t *testing.T) {
 						msg.ApprovalDecidedAt,
 						[]byte(`[{"Name":"update_todos","Source":"skills/update_todos.md","Tools":["fetch_todos","update_todos"]}]`),
 						msg.ActionExecuted,
+						[]byte(`{"experiment":"prompt-v2","variant":"concise","prompt_version":"concise"}`),
+						true,
+						nil,
+						msg.Seed,
+						msg.SupersededAt,
+						msg.ContentRef,
 						msg.CreatedAt,
 						msg.UpdatedAt,
+						tenant.Default,
 					).
 					WillReturnError(errors.New("db error"))
 			},
@@ -149,6 +221,7 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 	turnID3 := uuid.MustParse("623e4567-e89b-12d3-a456-426614174005")
 	approvalReason := "approved by user"
 	approvalDecidedAt := time.Date(2026, 1, 24, 12, 5, 0, 0, time.UTC)
+	contentRef := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
 
 	row := func(id uuid.UUID, conversationID uuid.UUID, turnID uuid.UUID, turnSequence int64, ts time.Time) []driver.Value {
 		return []driver.Value{
@@ -172,6 +245,12 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
 			ts,
 			ts,
 		}
@@ -193,8 +272,8 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 					AddRow(row(fixedID3, conversationID, turnID3, 2, t3)...).
 					AddRow(row(fixedID2, conversationID, turnID2, 1, t2)...).
 					AddRow(row(fixedID1, conversationID, turnID1, 0, t1)...)
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 11").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') ORDER BY created_at DESC, id DESC LIMIT 11").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedMsgs: []assistant.ChatMessage{
@@ -231,11 +310,17 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 						approvalDecidedAt,
 						[]byte(`[{"Name":"delete_todos","Source":"skills/delete_todos.md","Tools":["fetch_todos","delete_todos"]}]`),
 						true,
+						[]byte(`{"experiment":"prompt-v2","variant":"control"}`),
+						false,
+						int64(1),
+						int64(42),
+						t2,
+						contentRef,
 						t1,
 						t1,
 					)
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 11").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') ORDER BY created_at DESC, id DESC LIMIT 11").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedMsgs: []assistant.ChatMessage{
@@ -260,6 +345,11 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 						},
 					},
 					ActionExecuted: common.Ptr(true),
+					Experiment:     &assistant.ExperimentAssignment{Experiment: "prompt-v2", Variant: "control"},
+					FeedbackScore:  common.Ptr(1),
+					Seed:           common.Ptr(int64(42)),
+					SupersededAt:   &t2,
+					ContentRef:     common.Ptr(contentRef),
 					CreatedAt:      t1,
 					UpdatedAt:      t1,
 				},
@@ -276,8 +366,8 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 					AddRow(row(fixedID2, conversationID, turnID2, 1, t2)...).
 					AddRow(row(fixedID1, conversationID, turnID1, 0, t1)...)
 
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 3").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') ORDER BY created_at DESC, id DESC LIMIT 3").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedMsgs: []assistant.ChatMessage{
@@ -294,8 +384,8 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 				rows := sqlmock.NewRows(chatFields).
 					AddRow(row(fixedID1, conversationID, turnID1, 0, t1)...)
 
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 3 OFFSET 2").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') ORDER BY created_at DESC, id DESC LIMIT 3 OFFSET 2").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedMsgs: []assistant.ChatMessage{
@@ -309,8 +399,8 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 			pageSize: 10,
 			expect: func(m sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows(chatFields)
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 11").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') ORDER BY created_at DESC, id DESC LIMIT 11").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedMsgs:    nil,
@@ -321,8 +411,8 @@ func TestChatMessageRepository_ListChatMessages(t *testing.T) {
 			page:     1,
 			pageSize: 10,
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 11").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') ORDER BY created_at DESC, id DESC LIMIT 11").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectedMsgs:    nil,
@@ -386,6 +476,12 @@ func TestChatMessageRepository_ListChatMessages_WithOptionalParameters(t *testin
 			nil,
 			nil,
 			nil,
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
 			ts,
 			ts,
 		}
@@ -411,8 +507,8 @@ func TestChatMessageRepository_ListChatMessages_WithOptionalParameters(t *testin
 					AddRow(row(fixedID2, turnID, 1, fixedTime)...).
 					AddRow(row(fixedID3, turnID, 2, fixedTime)...).
 					AddRow(row(fixedID4, turnID, 3, fixedTime)...)
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages LEFT JOIN ( SELECT created_at AS checkpoint_created_at, id AS checkpoint_id FROM chat_messages WHERE conversation_id = $1 AND id = $2 LIMIT 1 ) checkpoint ON TRUE WHERE conversation_id = $3 AND (checkpoint.checkpoint_id IS NULL OR chat_messages.created_at > checkpoint.checkpoint_created_at OR (chat_messages.created_at = checkpoint.checkpoint_created_at AND chat_messages.id > checkpoint.checkpoint_id)) ORDER BY created_at ASC, id ASC LIMIT 3").
-					WithArgs(conversationID, fixedID1, conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages LEFT JOIN ( SELECT created_at AS checkpoint_created_at, id AS checkpoint_id FROM chat_messages WHERE conversation_id = $1 AND id = $2 AND created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') LIMIT 1 ) checkpoint ON TRUE WHERE conversation_id = $5 AND tenant_id = $6 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $7 AND conversations.tenant_id = $8), '-infinity') AND (checkpoint.checkpoint_id IS NULL OR chat_messages.created_at > checkpoint.checkpoint_created_at OR (chat_messages.created_at = checkpoint.checkpoint_created_at AND chat_messages.id > checkpoint.checkpoint_id)) ORDER BY created_at ASC, id ASC LIMIT 3").
+					WithArgs(conversationID, fixedID1, conversationID, tenant.Default, conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedMsgs: []assistant.ChatMessage{
@@ -422,6 +518,26 @@ func TestChatMessageRepository_ListChatMessages_WithOptionalParameters(t *testin
 			expectedHasMore: true,
 			expectErr:       false,
 		},
+		"success-with-before-message-and-turn-options": {
+			page:     1,
+			pageSize: 10,
+			options: []assistant.ListChatMessagesOption{
+				assistant.WithChatMessagesBeforeMessageID(fixedID4),
+				assistant.WithChatMessagesTurnID(turnID),
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(chatFields).
+					AddRow(row(fixedID3, turnID, 2, fixedTime.Add(time.Second))...).
+					AddRow(row(fixedID2, turnID, 1, fixedTime)...)
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages JOIN ( SELECT created_at AS before_created_at, id AS before_id FROM chat_messages WHERE conversation_id = $1 AND id = $2 AND created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') LIMIT 1 ) before_checkpoint ON TRUE WHERE conversation_id = $5 AND tenant_id = $6 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $7 AND conversations.tenant_id = $8), '-infinity') AND (chat_messages.created_at < before_checkpoint.before_created_at OR (chat_messages.created_at = before_checkpoint.before_created_at AND chat_messages.id < before_checkpoint.before_id)) AND turn_id = $9 ORDER BY created_at DESC, id DESC LIMIT 11").
+					WithArgs(conversationID, fixedID4, conversationID, tenant.Default, conversationID, tenant.Default, conversationID, tenant.Default, turnID).
+					WillReturnRows(rows)
+			},
+			expectedMsgs: []assistant.ChatMessage{
+				{ID: fixedID2, ConversationID: conversationID, TurnID: turnID, TurnSequence: 1, ChatRole: assistant.ChatRole("user"), Content: "content", Model: "ai/gpt-oss", MessageState: assistant.ChatMessageState_Completed, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+				{ID: fixedID3, ConversationID: conversationID, TurnID: turnID, TurnSequence: 2, ChatRole: assistant.ChatRole("user"), Content: "content", Model: "ai/gpt-oss", MessageState: assistant.ChatMessageState_Completed, CreatedAt: fixedTime.Add(time.Second), UpdatedAt: fixedTime.Add(time.Second)},
+			},
+		},
 		"after-message-query-error": {
 			page:     1,
 			pageSize: 10,
@@ -429,8 +545,8 @@ func TestChatMessageRepository_ListChatMessages_WithOptionalParameters(t *testin
 				assistant.WithChatMessagesAfterMessageID(fixedID1),
 			},
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, created_at, updated_at FROM chat_messages LEFT JOIN ( SELECT created_at AS checkpoint_created_at, id AS checkpoint_id FROM chat_messages WHERE conversation_id = $1 AND id = $2 LIMIT 1 ) checkpoint ON TRUE WHERE conversation_id = $3 AND (checkpoint.checkpoint_id IS NULL OR chat_messages.created_at > checkpoint.checkpoint_created_at OR (chat_messages.created_at = checkpoint.checkpoint_created_at AND chat_messages.id > checkpoint.checkpoint_id)) ORDER BY created_at ASC, id ASC LIMIT 11").
-					WithArgs(conversationID, fixedID1, conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, turn_id, turn_sequence, chat_role, content, action_call_id, action_calls, model, message_state, error_message, prompt_tokens, completion_tokens, total_tokens, context_tokens_estimate, approval_status, approval_decision_reason, approval_decided_at, selected_skills, action_executed, experiment_variant, action_loop_detected, feedback_score, seed, superseded_at, content_ref, created_at, updated_at FROM chat_messages LEFT JOIN ( SELECT created_at AS checkpoint_created_at, id AS checkpoint_id FROM chat_messages WHERE conversation_id = $1 AND id = $2 AND created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity') LIMIT 1 ) checkpoint ON TRUE WHERE conversation_id = $5 AND tenant_id = $6 AND chat_messages.created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations WHERE conversations.id = $7 AND conversations.tenant_id = $8), '-infinity') AND (checkpoint.checkpoint_id IS NULL OR chat_messages.created_at > checkpoint.checkpoint_created_at OR (chat_messages.created_at = checkpoint.checkpoint_created_at AND chat_messages.id > checkpoint.checkpoint_id)) ORDER BY created_at ASC, id ASC LIMIT 11").
+					WithArgs(conversationID, fixedID1, conversationID, tenant.Default, conversationID, tenant.Default, conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectedMsgs:    nil,
@@ -471,16 +587,16 @@ func TestChatMessageRepository_DeleteConversationMessages(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM chat_messages WHERE conversation_id = $1").
-					WithArgs(conversationID).
+				m.ExpectExec("DELETE FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			err: nil,
 		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM chat_messages WHERE conversation_id = $1").
-					WithArgs(conversationID).
+				m.ExpectExec("DELETE FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			err: errors.New("db error"),
@@ -517,16 +633,16 @@ func TestChatMessageRepository_DeleteChatMessages(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1,$2)").
-					WithArgs(messageIDs[0], messageIDs[1]).
+				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1,$2) AND tenant_id = $3").
+					WithArgs(messageIDs[0], messageIDs[1], tenant.Default).
 					WillReturnResult(sqlmock.NewResult(1, 2))
 			},
 			err: nil,
 		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1,$2)").
-					WithArgs(messageIDs[0], messageIDs[1]).
+				m.ExpectExec("DELETE FROM chat_messages WHERE id IN ($1,$2) AND tenant_id = $3").
+					WithArgs(messageIDs[0], messageIDs[1], tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			err: errors.New("db error"),
@@ -548,3 +664,108 @@ func TestChatMessageRepository_DeleteChatMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestChatMessageRepository_UpdateChatMessageFeedback(t *testing.T) {
+	t.Parallel()
+
+	messageID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		expect        func(sqlmock.Sqlmock)
+		expectedFound bool
+		err           error
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE chat_messages SET feedback_score = $1 WHERE chat_role = $2 AND id = $3 AND tenant_id = $4").
+					WithArgs(-1, assistant.ChatRole_Assistant, messageID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectedFound: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE chat_messages SET feedback_score = $1 WHERE chat_role = $2 AND id = $3 AND tenant_id = $4").
+					WithArgs(-1, assistant.ChatRole_Assistant, messageID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedFound: false,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE chat_messages SET feedback_score = $1 WHERE chat_role = $2 AND id = $3 AND tenant_id = $4").
+					WithArgs(-1, assistant.ChatRole_Assistant, messageID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChatMessageRepository(db)
+			found, gotErr := repo.UpdateChatMessageFeedback(t.Context(), messageID, -1)
+			assert.Equal(t, tt.err, gotErr)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChatMessageRepository_SupersedeChatMessages(t *testing.T) {
+	t.Parallel()
+
+	messageIDs := []uuid.UUID{
+		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+	}
+	supersededAt := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		messageIDs []uuid.UUID
+		expect     func(sqlmock.Sqlmock)
+		err        error
+	}{
+		"success": {
+			messageIDs: messageIDs,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE chat_messages SET superseded_at = $1, updated_at = $2 WHERE id IN ($3,$4) AND tenant_id = $5").
+					WithArgs(supersededAt, supersededAt, messageIDs[0], messageIDs[1], tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		"no-messages": {
+			expect: func(sqlmock.Sqlmock) {},
+		},
+		"database-error": {
+			messageIDs: messageIDs,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE chat_messages SET superseded_at = $1, updated_at = $2 WHERE id IN ($3,$4) AND tenant_id = $5").
+					WithArgs(supersededAt, supersededAt, messageIDs[0], messageIDs[1], tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewChatMessageRepository(db)
+			gotErr := repo.SupersedeChatMessages(t.Context(), tt.messageIDs, supersededAt)
+			assert.Equal(t, tt.err, gotErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var commentFields = []string{
+	"id",
+	"todo_id",
+	"author",
+	"body",
+	"created_at",
+	"updated_at",
+}
+
+// CommentRepository implements the todo.CommentRepository interface using PostgreSQL as the storage backend.
+type CommentRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewCommentRepository creates a new instance of CommentRepository.
+func NewCommentRepository(br sq.BaseRunner) CommentRepository {
+	return CommentRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateComment stores a new comment.
+func (r CommentRepository) CreateComment(ctx context.Context, comment todo.Comment) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("todo_comments").
+		Columns(commentFields...).
+		Columns(tenantColumn).
+		Values(
+			comment.ID,
+			comment.TodoID,
+			comment.Author,
+			comment.Body,
+			comment.CreatedAt,
+			comment.UpdatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateComment updates the body of an existing comment.
+func (r CommentRepository) UpdateComment(ctx context.Context, comment todo.Comment) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("todo_comments").
+		Set("body", comment.Body).
+		Set("updated_at", comment.UpdatedAt).
+		Where(sq.Eq{"id": comment.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteComment deletes a comment by its ID.
+func (r CommentRepository) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("todo_comments").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetComment retrieves a comment by its ID.
+func (r CommentRepository) GetComment(ctx context.Context, id uuid.UUID) (todo.Comment, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var comment todo.Comment
+	err := r.sb.
+		Select(commentFields...).
+		From("todo_comments").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx).
+		Scan(
+			&comment.ID,
+			&comment.TodoID,
+			&comment.Author,
+			&comment.Body,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.Comment{}, false, nil
+	}
+
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Comment{}, false, err
+	}
+
+	return comment, true, nil
+}
+
+// ListComments lists the comments of a todo, newest first.
+func (r CommentRepository) ListComments(ctx context.Context, todoID uuid.UUID, page, pageSize int) ([]todo.Comment, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if pageSize <= 0 {
+		return nil, false, core.NewValidationErr("page_size must be greater than 0")
+	}
+	if page <= 0 {
+		return nil, false, core.NewValidationErr("page must be greater than 0")
+	}
+
+	rows, err := r.sb.
+		Select(commentFields...).
+		From("todo_comments").
+		Where(sq.Eq{"todo_id": todoID}).
+		Where(tenantEq(ctx)).
+		OrderBy("created_at DESC", "id").
+		Limit(uint64(pageSize + 1)). // fetch one extra to determine if there's more
+		Offset(uint64((page - 1) * pageSize)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	comments, err := scanComments(rows)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	hasMore := false
+	if len(comments) > pageSize {
+		hasMore = true
+		comments = comments[:pageSize]
+	}
+
+	return comments, hasMore, nil
+}
+
+// ListRecentComments returns up to perTodo newest comments for each of the provided todos.
+func (r CommentRepository) ListRecentComments(ctx context.Context, todoIDs []uuid.UUID, perTodo int) (map[uuid.UUID][]todo.Comment, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	recent := make(map[uuid.UUID][]todo.Comment, len(todoIDs))
+	if len(todoIDs) == 0 || perTodo <= 0 {
+		return recent, nil
+	}
+
+	ranked := r.sb.
+		Select(append(commentFields, "ROW_NUMBER() OVER (PARTITION BY todo_id ORDER BY created_at DESC, id) AS rn")...).
+		From("todo_comments").
+		Where(sq.Eq{"todo_id": todoIDs}).
+		Where(tenantEq(ctx))
+
+	rows, err := r.sb.
+		Select(commentFields...).
+		FromSelect(ranked, "c").
+		Where(sq.LtOrEq{"rn": perTodo}).
+		OrderBy("todo_id", "created_at DESC").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	comments, err := scanComments(rows)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	for _, c := range comments {
+		recent[c.TodoID] = append(recent[c.TodoID], c)
+	}
+
+	return recent, nil
+}
+
+// scanComments reads all comment rows from the result set.
+func scanComments(rows *sql.Rows) ([]todo.Comment, error) {
+	comments := []todo.Comment{}
+	for rows.Next() {
+		var c todo.Comment
+		if err := rows.Scan(
+			&c.ID,
+			&c.TodoID,
+			&c.Author,
+			&c.Body,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+	return comments, rows.Err()
+}
@@ -0,0 +1,394 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentRepository_CreateComment(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	comment := todo.Comment{
+		ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		TodoID:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Author:    todo.CommentAuthor_User,
+		Body:      "Waiting on the vendor",
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	query := "INSERT INTO todo_comments (id,todo_id,author,body,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(comment.ID, comment.TodoID, comment.Author, comment.Body, comment.CreatedAt, comment.UpdatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(comment.ID, comment.TodoID, comment.Author, comment.Body, comment.CreatedAt, comment.UpdatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCommentRepository(db)
+			gotErr := repo.CreateComment(t.Context(), comment)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_UpdateComment(t *testing.T) {
+	t.Parallel()
+
+	comment := todo.Comment{
+		ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Body:      "Vendor replied",
+		UpdatedAt: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+	}
+	query := "UPDATE todo_comments SET body = $1, updated_at = $2 WHERE id = $3 AND tenant_id = $4"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(comment.Body, comment.UpdatedAt, comment.ID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(comment.Body, comment.UpdatedAt, comment.ID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCommentRepository(db)
+			gotErr := repo.UpdateComment(t.Context(), comment)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	commentID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	query := "DELETE FROM todo_comments WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(commentID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(commentID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCommentRepository(db)
+			gotErr := repo.DeleteComment(t.Context(), commentID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_GetComment(t *testing.T) {
+	t.Parallel()
+
+	commentID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, todo_id, author, body, created_at, updated_at FROM todo_comments WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     todo.Comment
+		expectedFind bool
+		expectErr    bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentFields).
+					AddRow(commentID, todoID, "user", "Waiting on the vendor", createdAt, createdAt)
+				m.ExpectQuery(query).WithArgs(commentID, tenant.Default).WillReturnRows(rows)
+			},
+			expected: todo.Comment{
+				ID:        commentID,
+				TodoID:    todoID,
+				Author:    todo.CommentAuthor_User,
+				Body:      "Waiting on the vendor",
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(commentID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(commentID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCommentRepository(db)
+			got, found, gotErr := repo.GetComment(t.Context(), commentID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.Equal(t, tt.expectedFind, found)
+			assert.Equal(t, tt.expected, got)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_ListComments(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	firstID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	secondID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, todo_id, author, body, created_at, updated_at FROM todo_comments WHERE todo_id = $1 AND tenant_id = $2 ORDER BY created_at DESC, id LIMIT 2 OFFSET 0"
+
+	tests := map[string]struct {
+		page            int
+		pageSize        int
+		expect          func(sqlmock.Sqlmock)
+		expected        []todo.Comment
+		expectedHasMore bool
+		expectedErr     error
+		expectErr       bool
+	}{
+		"success-with-more": {
+			page:     1,
+			pageSize: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentFields).
+					AddRow(secondID, todoID, "assistant", "Marked DONE from chat.", createdAt.Add(time.Hour), createdAt.Add(time.Hour)).
+					AddRow(firstID, todoID, "user", "Waiting on the vendor", createdAt, createdAt)
+				m.ExpectQuery(query).WithArgs(todoID, tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.Comment{
+				{
+					ID:        secondID,
+					TodoID:    todoID,
+					Author:    todo.CommentAuthor_Assistant,
+					Body:      "Marked DONE from chat.",
+					CreatedAt: createdAt.Add(time.Hour),
+					UpdatedAt: createdAt.Add(time.Hour),
+				},
+			},
+			expectedHasMore: true,
+		},
+		"empty": {
+			page:     1,
+			pageSize: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(todoID, tenant.Default).WillReturnRows(sqlmock.NewRows(commentFields))
+			},
+			expected: []todo.Comment{},
+		},
+		"invalid-page-size": {
+			page:        1,
+			pageSize:    0,
+			expect:      func(m sqlmock.Sqlmock) {},
+			expectedErr: core.NewValidationErr("page_size must be greater than 0"),
+		},
+		"invalid-page": {
+			page:        0,
+			pageSize:    1,
+			expect:      func(m sqlmock.Sqlmock) {},
+			expectedErr: core.NewValidationErr("page must be greater than 0"),
+		},
+		"database-error": {
+			page:     1,
+			pageSize: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(todoID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCommentRepository(db)
+			got, hasMore, gotErr := repo.ListComments(t.Context(), todoID, tt.page, tt.pageSize)
+			switch {
+			case tt.expectedErr != nil:
+				assert.Equal(t, tt.expectedErr, gotErr)
+			case tt.expectErr:
+				assert.Error(t, gotErr)
+			default:
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+				assert.Equal(t, tt.expectedHasMore, hasMore)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_ListRecentComments(t *testing.T) {
+	t.Parallel()
+
+	todoA := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	todoB := uuid.MustParse("223e4567-e89b-12d3-a456-426614174002")
+	commentA := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	commentB := uuid.MustParse("123e4567-e89b-12d3-a456-426614174003")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, todo_id, author, body, created_at, updated_at FROM " +
+		"(SELECT id, todo_id, author, body, created_at, updated_at, ROW_NUMBER() OVER (PARTITION BY todo_id ORDER BY created_at DESC, id) AS rn " +
+		"FROM todo_comments WHERE todo_id IN ($1,$2) AND tenant_id = $3) AS c WHERE rn <= $4 ORDER BY todo_id, created_at DESC"
+
+	tests := map[string]struct {
+		todoIDs   []uuid.UUID
+		expect    func(sqlmock.Sqlmock)
+		expected  map[uuid.UUID][]todo.Comment
+		expectErr bool
+	}{
+		"success": {
+			todoIDs: []uuid.UUID{todoA, todoB},
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentFields).
+					AddRow(commentA, todoA, "user", "Waiting on the vendor", createdAt, createdAt).
+					AddRow(commentB, todoB, "assistant", "Marked DONE from chat.", createdAt, createdAt)
+				m.ExpectQuery(query).WithArgs(todoA, todoB, tenant.Default, 3).WillReturnRows(rows)
+			},
+			expected: map[uuid.UUID][]todo.Comment{
+				todoA: {{ID: commentA, TodoID: todoA, Author: todo.CommentAuthor_User, Body: "Waiting on the vendor", CreatedAt: createdAt, UpdatedAt: createdAt}},
+				todoB: {{ID: commentB, TodoID: todoB, Author: todo.CommentAuthor_Assistant, Body: "Marked DONE from chat.", CreatedAt: createdAt, UpdatedAt: createdAt}},
+			},
+		},
+		"no-todos": {
+			expect:   func(m sqlmock.Sqlmock) {},
+			expected: map[uuid.UUID][]todo.Comment{},
+		},
+		"database-error": {
+			todoIDs: []uuid.UUID{todoA, todoB},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(todoA, todoB, tenant.Default, 3).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCommentRepository(db)
+			got, gotErr := repo.ListRecentComments(t.Context(), tt.todoIDs, 3)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
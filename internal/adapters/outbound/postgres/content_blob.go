@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var contentBlobFields = []string{
+	"hash",
+	"content",
+	"size_bytes",
+	"created_at",
+}
+
+// ContentBlobRepository is a PostgreSQL implementation of assistant.ContentBlobRepository.
+type ContentBlobRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewContentBlobRepository creates a new instance of ContentBlobRepository.
+func NewContentBlobRepository(br squirrel.BaseRunner) ContentBlobRepository {
+	return ContentBlobRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// SaveContentBlob stores a blob, keeping the stored one when the tenant already has a blob with the same hash.
+func (r ContentBlobRepository) SaveContentBlob(ctx context.Context, blob assistant.ContentBlob) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("hash", blob.Hash),
+		attribute.Int("size_bytes", blob.SizeBytes),
+	))
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("chat_content_blobs").
+		Columns(contentBlobFields...).
+		Columns(tenantColumn).
+		Values(
+			blob.Hash,
+			blob.Content,
+			blob.SizeBytes,
+			blob.CreatedAt,
+			tenantOf(ctx),
+		).
+		Suffix("ON CONFLICT (tenant_id, hash) DO NOTHING").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListContentBlobs returns the blobs of the tenant with the given hashes.
+func (r ContentBlobRepository) ListContentBlobs(ctx context.Context, hashes []string) ([]assistant.ContentBlob, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("hashes", len(hashes)),
+	))
+	defer span.End()
+
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.sb.
+		Select(contentBlobFields...).
+		From("chat_content_blobs").
+		Where(squirrel.Eq{"hash": hashes}).
+		Where(tenantEq(ctx)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var blobs []assistant.ContentBlob
+	for rows.Next() {
+		var blob assistant.ContentBlob
+		if err := rows.Scan(
+			&blob.Hash,
+			&blob.Content,
+			&blob.SizeBytes,
+			&blob.CreatedAt,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return blobs, nil
+}
+
+// DeleteUnreferencedContentBlobs deletes the blobs of the tenant no chat message references anymore.
+func (r ContentBlobRepository) DeleteUnreferencedContentBlobs(ctx context.Context) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("chat_content_blobs").
+		Where(tenantEq(ctx)).
+		Where("NOT EXISTS (SELECT 1 FROM chat_messages WHERE chat_messages.tenant_id = chat_content_blobs.tenant_id " +
+			"AND chat_messages.content_ref = chat_content_blobs.hash)").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentBlobRepository_SaveContentBlob(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+	blob := assistant.NewContentBlob(`{"ok":true}`, createdAt)
+	query := "INSERT INTO chat_content_blobs (hash,content,size_bytes,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5) " +
+		"ON CONFLICT (tenant_id, hash) DO NOTHING"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(blob.Hash, blob.Content, blob.SizeBytes, createdAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewContentBlobRepository(db)
+			gotErr := repo.SaveContentBlob(t.Context(), blob)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestContentBlobRepository_ListContentBlobs(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+	first := assistant.NewContentBlob("first", createdAt)
+	second := assistant.NewContentBlob("second", createdAt)
+	query := "SELECT hash, content, size_bytes, created_at FROM chat_content_blobs WHERE hash IN ($1,$2) AND tenant_id = $3"
+
+	tests := map[string]struct {
+		hashes    []string
+		expect    func(sqlmock.Sqlmock)
+		expected  []assistant.ContentBlob
+		expectErr bool
+	}{
+		"success": {
+			hashes: []string{first.Hash, second.Hash},
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(contentBlobFields).
+					AddRow(first.Hash, first.Content, first.SizeBytes, createdAt).
+					AddRow(second.Hash, second.Content, second.SizeBytes, createdAt)
+				m.ExpectQuery(query).WithArgs(first.Hash, second.Hash, tenant.Default).WillReturnRows(rows)
+			},
+			expected: []assistant.ContentBlob{first, second},
+		},
+		"no-hashes": {
+			expect: func(m sqlmock.Sqlmock) {},
+		},
+		"database-error": {
+			hashes: []string{first.Hash, second.Hash},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewContentBlobRepository(db)
+			got, gotErr := repo.ListContentBlobs(t.Context(), tt.hashes)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestContentBlobRepository_DeleteUnreferencedContentBlobs(t *testing.T) {
+	t.Parallel()
+
+	query := "DELETE FROM chat_content_blobs WHERE tenant_id = $1 AND NOT EXISTS (SELECT 1 FROM chat_messages " +
+		"WHERE chat_messages.tenant_id = chat_content_blobs.tenant_id AND chat_messages.content_ref = chat_content_blobs.hash)"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(tenant.Default).WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewContentBlobRepository(db)
+			gotErr := repo.DeleteUnreferencedContentBlobs(t.Context())
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
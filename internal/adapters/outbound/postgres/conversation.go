@@ -20,6 +20,9 @@ var conversationFields = []string{
 	"id",
 	"title",
 	"title_source",
+	"temperature",
+	"top_p",
+	"model",
 	"last_message_at",
 	"created_at",
 	"updated_at",
@@ -63,20 +66,28 @@ func (r ConversationRepository) CreateConversation(
 	err := r.sb.
 		Insert("conversations").
 		Columns(conversationFields...).
+		Columns(tenantColumn).
 		Values(
 			input.ID,
 			input.Title,
 			input.TitleSource,
+			input.Settings.Temperature,
+			input.Settings.TopP,
+			input.Settings.Model,
 			input.LastMessageAt,
 			input.CreatedAt,
 			input.UpdatedAt,
+			tenantOf(ctx),
 		).
-		Suffix("RETURNING id, title, title_source, last_message_at, created_at, updated_at").
+		Suffix("RETURNING id, title, title_source, temperature, top_p, model, last_message_at, created_at, updated_at").
 		QueryRowContext(spanCtx).
 		Scan(
 			&created.ID,
 			&created.Title,
 			&created.TitleSource,
+			&created.Settings.Temperature,
+			&created.Settings.TopP,
+			&created.Settings.Model,
 			&created.LastMessageAt,
 			&created.CreatedAt,
 			&created.UpdatedAt,
@@ -85,6 +96,11 @@ func (r ConversationRepository) CreateConversation(
 		return assistant.Conversation{}, err
 	}
 
+	err = recordConversationChange(spanCtx, r.sb, created.ID, assistant.ConversationChangeType_Created)
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.Conversation{}, err
+	}
+
 	return created, nil
 }
 
@@ -101,12 +117,16 @@ func (r ConversationRepository) GetConversation(
 		Select(conversationFields...).
 		From("conversations").
 		Where(squirrel.Eq{"id": conversationID}).
+		Where(tenantEq(ctx)).
 		Limit(1).
 		QueryRowContext(spanCtx).
 		Scan(
 			&conversation.ID,
 			&conversation.Title,
 			&conversation.TitleSource,
+			&conversation.Settings.Temperature,
+			&conversation.Settings.TopP,
+			&conversation.Settings.Model,
 			&conversation.LastMessageAt,
 			&conversation.CreatedAt,
 			&conversation.UpdatedAt,
@@ -136,34 +156,16 @@ func (r ConversationRepository) GetConversationContextTokenUsage(
 		return usageByConversationID, nil
 	}
 
-	conversationTokenUsageSubquery := r.sb.
-		Select(
-			"COALESCE(SUM(chat_messages.context_tokens_estimate), 0)::BIGINT AS total_tokens_used",
-		).
-		From("chat_messages").
-		LeftJoin("conversations_summary conversation_summary ON conversation_summary.conversation_id = conversations.id").
-		LeftJoin("chat_messages checkpoint ON checkpoint.conversation_id = conversations.id AND checkpoint.id = conversation_summary.last_summarized_message_id").
-		Where("chat_messages.conversation_id = conversations.id").
-		Where(`(
-			checkpoint.id IS NULL
-			OR chat_messages.created_at > checkpoint.created_at
-			OR (
-				chat_messages.created_at = checkpoint.created_at
-				AND chat_messages.id > checkpoint.id
-			)
-		)`).
-		Prefix("LEFT JOIN LATERAL (").
-		Suffix(") conversation_token_usage ON TRUE")
-
 	query := r.sb.
 		Select(
 			"conversations.id AS conversation_id",
 			"COALESCE(conversation_token_usage.total_tokens_used, 0) AS total_tokens_used",
 		).
 		From("conversations").
-		JoinClause(conversationTokenUsageSubquery).
+		JoinClause(conversationTokenUsageJoin(r.sb)).
 		//Where(squirrel.Eq{"conversations.id": conversationIDs})
-		Where(squirrel.Expr("conversations.id = ANY(?)", pq.Array(conversationIDs)))
+		Where(squirrel.Expr("conversations.id = ANY(?)", pq.Array(conversationIDs))).
+		Where(squirrel.Eq{"conversations.tenant_id": tenantOf(ctx)})
 
 	rows, err := query.QueryContext(spanCtx)
 	if telemetry.IsErrorRecorded(span, err) {
@@ -186,6 +188,29 @@ func (r ConversationRepository) GetConversationContextTokenUsage(
 	return usageByConversationID, nil
 }
 
+// conversationTokenUsageJoin joins to each conversation, as conversation_token_usage.total_tokens_used, the
+// context token estimate of its messages after the last summarized one.
+func conversationTokenUsageJoin(sb squirrel.StatementBuilderType) squirrel.SelectBuilder {
+	return sb.
+		Select(
+			"COALESCE(SUM(chat_messages.context_tokens_estimate), 0)::BIGINT AS total_tokens_used",
+		).
+		From("chat_messages").
+		LeftJoin("conversations_summary conversation_summary ON conversation_summary.conversation_id = conversations.id").
+		LeftJoin("chat_messages checkpoint ON checkpoint.conversation_id = conversations.id AND checkpoint.id = conversation_summary.last_summarized_message_id").
+		Where("chat_messages.conversation_id = conversations.id").
+		Where(`(
+			checkpoint.id IS NULL
+			OR chat_messages.created_at > checkpoint.created_at
+			OR (
+				chat_messages.created_at = checkpoint.created_at
+				AND chat_messages.id > checkpoint.id
+			)
+		)`).
+		Prefix("LEFT JOIN LATERAL (").
+		Suffix(") conversation_token_usage ON TRUE")
+}
+
 // UpdateConversation updates mutable fields for one conversation.
 func (r ConversationRepository) UpdateConversation(
 	ctx context.Context,
@@ -198,14 +223,23 @@ func (r ConversationRepository) UpdateConversation(
 		Update("conversations").
 		Set("title", conversation.Title).
 		Set("title_source", conversation.TitleSource).
+		Set("temperature", conversation.Settings.Temperature).
+		Set("top_p", conversation.Settings.TopP).
+		Set("model", conversation.Settings.Model).
 		Set("last_message_at", conversation.LastMessageAt).
 		Set("updated_at", conversation.UpdatedAt).
 		Where(squirrel.Eq{"id": conversation.ID}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 	if telemetry.IsErrorRecorded(span, err) {
 		return err
 	}
 
+	err = recordConversationChange(spanCtx, r.sb, conversation.ID, assistant.ConversationChangeType_Updated)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
 	return nil
 }
 
@@ -235,7 +269,9 @@ func (r ConversationRepository) ListConversations(
 	rows, err := r.sb.
 		Select(conversationFields...).
 		From("conversations").
-		OrderBy("last_message_at DESC NULLS LAST", "updated_at DESC", "created_at DESC").
+		Where(tenantEq(ctx)).
+		// The ID breaks ties, so a conversation never shows up on two pages.
+		OrderBy("last_message_at DESC NULLS LAST", "updated_at DESC", "created_at DESC", "id DESC").
 		Limit(uint64(pageSize + 1)).
 		Offset(uint64((page - 1) * pageSize)).
 		QueryContext(spanCtx)
@@ -251,6 +287,9 @@ func (r ConversationRepository) ListConversations(
 			&conversation.ID,
 			&conversation.Title,
 			&conversation.TitleSource,
+			&conversation.Settings.Temperature,
+			&conversation.Settings.TopP,
+			&conversation.Settings.Model,
 			&conversation.LastMessageAt,
 			&conversation.CreatedAt,
 			&conversation.UpdatedAt,
@@ -282,10 +321,16 @@ func (r ConversationRepository) DeleteConversation(ctx context.Context, conversa
 	_, err := r.sb.
 		Delete("conversations").
 		Where(squirrel.Eq{"id": conversationID}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 	if telemetry.IsErrorRecorded(span, err) {
 		return err
 	}
 
+	err = recordConversationChange(spanCtx, r.sb, conversationID, assistant.ConversationChangeType_Deleted)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var conversationChangeFields = []string{
+	"sequence",
+	"conversation_id",
+	"change_type",
+	"created_at",
+}
+
+// ConversationChangeRepository implements the assistant.ConversationChangeRepository interface using PostgreSQL as the storage backend.
+type ConversationChangeRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewConversationChangeRepository creates a new instance of ConversationChangeRepository.
+func NewConversationChangeRepository(br sq.BaseRunner) ConversationChangeRepository {
+	return ConversationChangeRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// ListConversationChangesSince lists up to limit changes recorded after the since sequence, oldest first.
+func (r ConversationChangeRepository) ListConversationChangesSince(ctx context.Context, since int64, limit int) ([]assistant.ConversationChange, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, false, core.NewValidationErr("limit must be greater than 0")
+	}
+
+	rows, err := r.sb.
+		Select(conversationChangeFields...).
+		From("conversation_changes").
+		Where(sq.Gt{"sequence": since}).
+		Where(tenantEq(ctx)).
+		OrderBy("sequence ASC").
+		Limit(uint64(limit + 1)). // fetch one extra to determine if there's more
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	changes := []assistant.ConversationChange{}
+	for rows.Next() {
+		var c assistant.ConversationChange
+		if err := rows.Scan(
+			&c.Sequence,
+			&c.ConversationID,
+			&c.Type,
+			&c.CreatedAt,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, false, err
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	hasMore := false
+	if len(changes) > limit {
+		hasMore = true
+		changes = changes[:limit]
+	}
+
+	return changes, hasMore, nil
+}
+
+// recordConversationChange appends a change to the conversation change log.
+func recordConversationChange(ctx context.Context, sb sq.StatementBuilderType, conversationID uuid.UUID, changeType assistant.ConversationChangeType) error {
+	_, err := sb.
+		Insert("conversation_changes").
+		Columns("conversation_id", "change_type", tenantColumn).
+		Values(conversationID, changeType, tenantOf(ctx)).
+		ExecContext(ctx)
+	return err
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationChangeRepository_ListConversationChangesSince(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	conversationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	columns := []string{"sequence", "conversation_id", "change_type", "created_at"}
+
+	tests := map[string]struct {
+		since       int64
+		limit       int
+		expect      func(sqlmock.Sqlmock)
+		want        []assistant.ConversationChange
+		wantHasMore bool
+		expectErr   error
+	}{
+		"changes-with-more": {
+			since: 10,
+			limit: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(
+					"SELECT sequence, conversation_id, change_type, created_at "+
+						"FROM conversation_changes WHERE sequence > $1 AND tenant_id = $2 ORDER BY sequence ASC LIMIT 2",
+				).
+					WithArgs(int64(10), tenant.Default).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(int64(11), conversationID, "CREATED", createdAt).
+						AddRow(int64(12), conversationID, "UPDATED", createdAt))
+			},
+			want: []assistant.ConversationChange{
+				{Sequence: 11, ConversationID: conversationID, Type: assistant.ConversationChangeType_Created, CreatedAt: createdAt},
+			},
+			wantHasMore: true,
+		},
+		"no-changes": {
+			since: 12,
+			limit: 10,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(
+					"SELECT sequence, conversation_id, change_type, created_at "+
+						"FROM conversation_changes WHERE sequence > $1 AND tenant_id = $2 ORDER BY sequence ASC LIMIT 11",
+				).
+					WithArgs(int64(12), tenant.Default).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want: []assistant.ConversationChange{},
+		},
+		"invalid-limit": {
+			limit:     0,
+			expect:    func(m sqlmock.Sqlmock) {},
+			expectErr: core.NewValidationErr("limit must be greater than 0"),
+		},
+		"database-error": {
+			limit: 5,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(
+					"SELECT sequence, conversation_id, change_type, created_at " +
+						"FROM conversation_changes WHERE sequence > $1 AND tenant_id = $2 ORDER BY sequence ASC LIMIT 6",
+				).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectErr: sql.ErrConnDone,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationChangeRepository(db)
+			got, hasMore, gotErr := repo.ListConversationChangesSince(t.Context(), tt.since, tt.limit)
+			assert.Equal(t, tt.expectErr, gotErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
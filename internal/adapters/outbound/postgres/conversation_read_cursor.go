@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConversationReadCursorRepository is a PostgreSQL implementation of assistant.ConversationReadCursorRepository.
+type ConversationReadCursorRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewConversationReadCursorRepository creates a new instance of ConversationReadCursorRepository.
+func NewConversationReadCursorRepository(br squirrel.BaseRunner) ConversationReadCursorRepository {
+	return ConversationReadCursorRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// MarkConversationRead moves the read cursor of the reader on a conversation to the given message, or to the
+// latest message of the conversation when messageID is nil. The cursor never moves back to an older message.
+func (r ConversationReadCursorRepository) MarkConversationRead(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	reader string,
+	messageID *uuid.UUID,
+	readAt time.Time,
+) (bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("conversation_id", conversationID.String()),
+	))
+	defer span.End()
+
+	query := r.sb.
+		Select("id", "created_at").
+		From("chat_messages").
+		Where(squirrel.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
+		Where(conversationPartitionBound(ctx, "created_at", conversationID))
+	if messageID != nil {
+		query = query.Where(squirrel.Eq{"id": *messageID})
+	}
+
+	var (
+		lastReadMessageID uuid.UUID
+		lastReadMessageAt time.Time
+	)
+	err := query.
+		OrderBy("created_at DESC", "id DESC").
+		Limit(1).
+		QueryRowContext(spanCtx).
+		Scan(&lastReadMessageID, &lastReadMessageAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return false, err
+	}
+
+	_, err = r.sb.
+		Insert("conversation_read_cursors").
+		Columns("conversation_id", "reader", "last_read_message_id", "last_read_message_at", "updated_at", tenantColumn).
+		Values(conversationID, reader, lastReadMessageID, lastReadMessageAt, readAt, tenantOf(ctx)).
+		Suffix("ON CONFLICT (conversation_id, reader) DO UPDATE SET last_read_message_id = EXCLUDED.last_read_message_id, " +
+			"last_read_message_at = EXCLUDED.last_read_message_at, updated_at = EXCLUDED.updated_at " +
+			"WHERE conversation_read_cursors.last_read_message_at <= EXCLUDED.last_read_message_at").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CountUnreadMessages returns how many messages of each given conversation the reader has not read,
+// keyed by conversation ID. Conversations without unread messages are missing from the map.
+func (r ConversationReadCursorRepository) CountUnreadMessages(
+	ctx context.Context,
+	reader string,
+	conversationIDs []uuid.UUID,
+) (map[uuid.UUID]int64, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	unreadByConversationID := make(map[uuid.UUID]int64, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return unreadByConversationID, nil
+	}
+
+	rows, err := r.sb.
+		Select("chat_messages.conversation_id", "COUNT(*)").
+		From("chat_messages").
+		LeftJoin("conversation_read_cursors read_cursor ON read_cursor.conversation_id = chat_messages.conversation_id "+
+			"AND read_cursor.tenant_id = chat_messages.tenant_id AND read_cursor.reader = ?", reader).
+		Where(squirrel.Expr("chat_messages.conversation_id = ANY(?)", pq.Array(conversationIDs))).
+		Where(squirrel.Eq{"chat_messages.tenant_id": tenantOf(ctx)}).
+		Where(squirrel.Eq{"chat_messages.chat_role": assistant.ChatRole_Assistant}).
+		Where(squirrel.Eq{"chat_messages.message_state": assistant.ChatMessageState_Completed}).
+		Where("chat_messages.superseded_at IS NULL").
+		Where("(read_cursor.last_read_message_at IS NULL OR chat_messages.created_at > read_cursor.last_read_message_at)").
+		// Replies to a user message are followed by whoever asked, so only unprompted messages count.
+		Where("NOT EXISTS (SELECT 1 FROM chat_messages prompt WHERE prompt.conversation_id = chat_messages.conversation_id " +
+			"AND prompt.turn_id = chat_messages.turn_id AND prompt.chat_role = 'user')").
+		GroupBy("chat_messages.conversation_id").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		var (
+			conversationID uuid.UUID
+			unread         int64
+		)
+		if err := rows.Scan(&conversationID, &unread); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		unreadByConversationID[conversationID] = unread
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return unreadByConversationID, nil
+}
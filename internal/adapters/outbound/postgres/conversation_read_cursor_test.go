@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	selectReadMessageQuery = "SELECT id, created_at FROM chat_messages WHERE conversation_id = $1 AND tenant_id = $2 " +
+		"AND created_at >= COALESCE((SELECT conversations.created_at - INTERVAL '1 day' FROM conversations " +
+		"WHERE conversations.id = $3 AND conversations.tenant_id = $4), '-infinity')"
+	upsertReadCursorQuery = "INSERT INTO conversation_read_cursors (conversation_id,reader,last_read_message_id,last_read_message_at,updated_at,tenant_id) " +
+		"VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (conversation_id, reader) DO UPDATE SET last_read_message_id = EXCLUDED.last_read_message_id, " +
+		"last_read_message_at = EXCLUDED.last_read_message_at, updated_at = EXCLUDED.updated_at " +
+		"WHERE conversation_read_cursors.last_read_message_at <= EXCLUDED.last_read_message_at"
+	countUnreadMessagesQuery = "SELECT chat_messages.conversation_id, COUNT(*) FROM chat_messages " +
+		"LEFT JOIN conversation_read_cursors read_cursor ON read_cursor.conversation_id = chat_messages.conversation_id " +
+		"AND read_cursor.tenant_id = chat_messages.tenant_id AND read_cursor.reader = $1 " +
+		"WHERE chat_messages.conversation_id = ANY($2) AND chat_messages.tenant_id = $3 AND chat_messages.chat_role = $4 " +
+		"AND chat_messages.message_state = $5 AND chat_messages.superseded_at IS NULL " +
+		"AND (read_cursor.last_read_message_at IS NULL OR chat_messages.created_at > read_cursor.last_read_message_at) " +
+		"AND NOT EXISTS (SELECT 1 FROM chat_messages prompt WHERE prompt.conversation_id = chat_messages.conversation_id " +
+		"AND prompt.turn_id = chat_messages.turn_id AND prompt.chat_role = 'user') GROUP BY chat_messages.conversation_id"
+)
+
+func TestConversationReadCursorRepository_MarkConversationRead(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	messageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	messageAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	readAt := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	reader := "principal:system"
+
+	messageRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "created_at"}).AddRow(messageID, messageAt)
+	}
+
+	tests := map[string]struct {
+		messageID *uuid.UUID
+		expect    func(sqlmock.Sqlmock)
+		expected  bool
+		expectErr bool
+	}{
+		"latest-message": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectReadMessageQuery+" ORDER BY created_at DESC, id DESC LIMIT 1").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default).
+					WillReturnRows(messageRow())
+				m.ExpectExec(upsertReadCursorQuery).
+					WithArgs(conversationID, reader, messageID, messageAt, readAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expected: true,
+		},
+		"given-message": {
+			messageID: &messageID,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectReadMessageQuery+" AND id = $5 ORDER BY created_at DESC, id DESC LIMIT 1").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default, messageID).
+					WillReturnRows(messageRow())
+				m.ExpectExec(upsertReadCursorQuery).
+					WithArgs(conversationID, reader, messageID, messageAt, readAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expected: true,
+		},
+		"message-not-found": {
+			messageID: &messageID,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectReadMessageQuery+" AND id = $5 ORDER BY created_at DESC, id DESC LIMIT 1").
+					WithArgs(conversationID, tenant.Default, conversationID, tenant.Default, messageID).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+			},
+		},
+		"select-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectReadMessageQuery + " ORDER BY created_at DESC, id DESC LIMIT 1").
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		"upsert-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectReadMessageQuery + " ORDER BY created_at DESC, id DESC LIMIT 1").
+					WillReturnRows(messageRow())
+				m.ExpectExec(upsertReadCursorQuery).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationReadCursorRepository(db)
+			got, gotErr := repo.MarkConversationRead(t.Context(), conversationID, reader, tt.messageID, readAt)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.Equal(t, tt.expected, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationReadCursorRepository_CountUnreadMessages(t *testing.T) {
+	t.Parallel()
+
+	c1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	c2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	reader := "principal:system"
+
+	tests := map[string]struct {
+		conversationIDs []uuid.UUID
+		expect          func(sqlmock.Sqlmock)
+		expected        map[uuid.UUID]int64
+		expectErr       bool
+	}{
+		"success": {
+			conversationIDs: []uuid.UUID{c1, c2},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(countUnreadMessagesQuery).
+					WithArgs(reader, pq.Array([]uuid.UUID{c1, c2}), tenant.Default, assistant.ChatRole_Assistant, assistant.ChatMessageState_Completed).
+					WillReturnRows(sqlmock.NewRows([]string{"conversation_id", "count"}).AddRow(c2, int64(2)))
+			},
+			expected: map[uuid.UUID]int64{c2: 2},
+		},
+		"empty-input": {
+			conversationIDs: []uuid.UUID{},
+			expect:          func(sqlmock.Sqlmock) {},
+			expected:        map[uuid.UUID]int64{},
+		},
+		"database-error": {
+			conversationIDs: []uuid.UUID{c1},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(countUnreadMessagesQuery).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationReadCursorRepository(db)
+			got, gotErr := repo.CountUnreadMessages(t.Context(), reader, tt.conversationIDs)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
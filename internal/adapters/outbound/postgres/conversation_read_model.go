@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ConversationReadModelRepository is a PostgreSQL implementation of assistant.ConversationReadModelRepository.
+type ConversationReadModelRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewConversationReadModelRepository creates a new instance of ConversationReadModelRepository.
+func NewConversationReadModelRepository(br squirrel.BaseRunner) ConversationReadModelRepository {
+	return ConversationReadModelRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// RefreshConversationReadModel recomputes the projection of a conversation from its current state as of
+// projectedAt. Conversations that no longer exist are skipped.
+func (r ConversationReadModelRepository) RefreshConversationReadModel(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	projectedAt time.Time,
+) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("conversation_id", conversationID.String()),
+	))
+	defer span.End()
+
+	// The projection is computed from the conversation itself rather than from the event, so replayed and
+	// out-of-order events converge to the same row.
+	projection := r.sb.
+		Select(
+			"conversations.id",
+			"conversations.last_message_at",
+			"COALESCE(conversation_token_usage.total_tokens_used, 0)",
+		).
+		Column("?", projectedAt).
+		Column("conversations.tenant_id").
+		From("conversations").
+		JoinClause(conversationTokenUsageJoin(r.sb)).
+		Where(squirrel.Eq{"conversations.id": conversationID}).
+		Where(squirrel.Eq{"conversations.tenant_id": tenantOf(ctx)})
+
+	_, err := r.sb.
+		Insert("conversation_read_model").
+		Columns("conversation_id", "last_message_at", "context_tokens_used", "projected_at", tenantColumn).
+		Select(projection).
+		Suffix("ON CONFLICT (conversation_id) DO UPDATE SET last_message_at = EXCLUDED.last_message_at, " +
+			"context_tokens_used = EXCLUDED.context_tokens_used, projected_at = EXCLUDED.projected_at").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListConversationReadModels returns the projections of the given conversations keyed by conversation ID.
+// Conversations not projected yet are missing from the map.
+func (r ConversationReadModelRepository) ListConversationReadModels(
+	ctx context.Context,
+	conversationIDs []uuid.UUID,
+) (map[uuid.UUID]assistant.ConversationReadModel, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	readModels := make(map[uuid.UUID]assistant.ConversationReadModel, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return readModels, nil
+	}
+
+	rows, err := r.sb.
+		Select("conversation_id", "last_message_at", "context_tokens_used", "projected_at").
+		From("conversation_read_model").
+		Where(squirrel.Expr("conversation_id = ANY(?)", pq.Array(conversationIDs))).
+		Where(tenantEq(ctx)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		var readModel assistant.ConversationReadModel
+		err := rows.Scan(
+			&readModel.ConversationID,
+			&readModel.LastMessageAt,
+			&readModel.ContextTokensUsed,
+			&readModel.ProjectedAt,
+		)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		readModels[readModel.ConversationID] = readModel
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return readModels, nil
+}
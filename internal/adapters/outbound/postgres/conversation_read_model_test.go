@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	refreshConversationReadModelQuery = "INSERT INTO conversation_read_model (conversation_id,last_message_at,context_tokens_used,projected_at,tenant_id) " +
+		"SELECT conversations.id, conversations.last_message_at, COALESCE(conversation_token_usage.total_tokens_used, 0), $1, conversations.tenant_id " +
+		"FROM conversations LEFT JOIN LATERAL ( SELECT COALESCE(SUM(chat_messages.context_tokens_estimate), 0)::BIGINT AS total_tokens_used FROM chat_messages " +
+		"LEFT JOIN conversations_summary conversation_summary ON conversation_summary.conversation_id = conversations.id " +
+		"LEFT JOIN chat_messages checkpoint ON checkpoint.conversation_id = conversations.id AND checkpoint.id = conversation_summary.last_summarized_message_id " +
+		"WHERE chat_messages.conversation_id = conversations.id AND (\n\t\t\tcheckpoint.id IS NULL\n\t\t\tOR chat_messages.created_at > checkpoint.created_at\n\t\t\tOR (\n\t\t\t\tchat_messages.created_at = checkpoint.created_at\n\t\t\t\tAND chat_messages.id > checkpoint.id\n\t\t\t)\n\t\t) ) conversation_token_usage ON TRUE " +
+		"WHERE conversations.id = $2 AND conversations.tenant_id = $3 " +
+		"ON CONFLICT (conversation_id) DO UPDATE SET last_message_at = EXCLUDED.last_message_at, " +
+		"context_tokens_used = EXCLUDED.context_tokens_used, projected_at = EXCLUDED.projected_at"
+	listConversationReadModelsQuery = "SELECT conversation_id, last_message_at, context_tokens_used, projected_at " +
+		"FROM conversation_read_model WHERE conversation_id = ANY($1) AND tenant_id = $2"
+)
+
+func TestConversationReadModelRepository_RefreshConversationReadModel(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectedAt := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(refreshConversationReadModelQuery).
+					WithArgs(projectedAt, conversationID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"deleted-conversation": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(refreshConversationReadModelQuery).
+					WithArgs(projectedAt, conversationID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(refreshConversationReadModelQuery).
+					WithArgs(projectedAt, conversationID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationReadModelRepository(db)
+			gotErr := repo.RefreshConversationReadModel(t.Context(), conversationID, projectedAt)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationReadModelRepository_ListConversationReadModels(t *testing.T) {
+	t.Parallel()
+
+	c1 := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	c2 := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	lastMessageAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	projectedAt := time.Date(2026, 10, 16, 9, 0, 1, 0, time.UTC)
+
+	tests := map[string]struct {
+		conversationIDs []uuid.UUID
+		expect          func(sqlmock.Sqlmock)
+		expected        map[uuid.UUID]assistant.ConversationReadModel
+		expectErr       bool
+	}{
+		"success": {
+			conversationIDs: []uuid.UUID{c1, c2},
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"conversation_id", "last_message_at", "context_tokens_used", "projected_at"}).
+					AddRow(c1, lastMessageAt, int64(120), projectedAt)
+				m.ExpectQuery(listConversationReadModelsQuery).
+					WithArgs(pq.Array([]uuid.UUID{c1, c2}), tenant.Default).
+					WillReturnRows(rows)
+			},
+			expected: map[uuid.UUID]assistant.ConversationReadModel{
+				c1: {ConversationID: c1, LastMessageAt: &lastMessageAt, ContextTokensUsed: 120, ProjectedAt: projectedAt},
+			},
+		},
+		"empty-input": {
+			conversationIDs: []uuid.UUID{},
+			expect:          func(sqlmock.Sqlmock) {},
+			expected:        map[uuid.UUID]assistant.ConversationReadModel{},
+		},
+		"database-error": {
+			conversationIDs: []uuid.UUID{c1},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(listConversationReadModelsQuery).
+					WithArgs(pq.Array([]uuid.UUID{c1}), tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationReadModelRepository(db)
+			got, gotErr := repo.ListConversationReadModels(t.Context(), tt.conversationIDs)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var conversationSnapshotFields = []string{
+	"id",
+	"conversation_id",
+	"summary",
+	"pinned_todos",
+	"open_loops",
+	"last_message_id",
+	"change_sequence",
+	"created_at",
+}
+
+// ConversationSnapshotRepository is a PostgreSQL implementation of assistant.ConversationSnapshotRepository.
+type ConversationSnapshotRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewConversationSnapshotRepository creates a new instance of ConversationSnapshotRepository.
+func NewConversationSnapshotRepository(br squirrel.BaseRunner) ConversationSnapshotRepository {
+	return ConversationSnapshotRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// StoreConversationSnapshot inserts a new conversation snapshot.
+func (r ConversationSnapshotRepository) StoreConversationSnapshot(ctx context.Context, snapshot assistant.ConversationSnapshot) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	pinnedTodos := snapshot.PinnedTodos
+	if pinnedTodos == nil {
+		pinnedTodos = []assistant.PinnedTodo{}
+	}
+	pinnedTodosJSON, err := json.Marshal(pinnedTodos)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	openLoops := snapshot.OpenLoops
+	if openLoops == nil {
+		openLoops = []string{}
+	}
+	openLoopsJSON, err := json.Marshal(openLoops)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("conversation_snapshots").
+		Columns(conversationSnapshotFields...).
+		Columns(tenantColumn).
+		Values(
+			snapshot.ID,
+			snapshot.ConversationID,
+			snapshot.Summary,
+			pinnedTodosJSON,
+			openLoopsJSON,
+			snapshot.LastMessageID,
+			snapshot.ChangeSequence,
+			snapshot.CreatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetLatestConversationSnapshot retrieves the most recent snapshot of a conversation.
+func (r ConversationSnapshotRepository) GetLatestConversationSnapshot(
+	ctx context.Context,
+	conversationID uuid.UUID,
+) (assistant.ConversationSnapshot, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var (
+		snapshot        assistant.ConversationSnapshot
+		pinnedTodosJSON []byte
+		openLoopsJSON   []byte
+	)
+	err := r.sb.
+		Select(conversationSnapshotFields...).
+		From("conversation_snapshots").
+		Where(squirrel.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
+		OrderBy("created_at DESC").
+		Limit(1).
+		QueryRowContext(spanCtx).
+		Scan(
+			&snapshot.ID,
+			&snapshot.ConversationID,
+			&snapshot.Summary,
+			&pinnedTodosJSON,
+			&openLoopsJSON,
+			&snapshot.LastMessageID,
+			&snapshot.ChangeSequence,
+			&snapshot.CreatedAt,
+		)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return assistant.ConversationSnapshot{}, false, nil
+	}
+
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.ConversationSnapshot{}, false, err
+	}
+
+	if err := json.Unmarshal(pinnedTodosJSON, &snapshot.PinnedTodos); telemetry.IsErrorRecorded(span, err) {
+		return assistant.ConversationSnapshot{}, false, err
+	}
+	if err := json.Unmarshal(openLoopsJSON, &snapshot.OpenLoops); telemetry.IsErrorRecorded(span, err) {
+		return assistant.ConversationSnapshot{}, false, err
+	}
+
+	return snapshot, true, nil
+}
+
+// DeleteConversationSnapshots deletes all snapshots of a conversation.
+func (r ConversationSnapshotRepository) DeleteConversationSnapshots(ctx context.Context, conversationID uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("conversation_snapshots").
+		Where(squirrel.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
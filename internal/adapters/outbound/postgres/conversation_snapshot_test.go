@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationSnapshotRepository_StoreConversationSnapshot(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	snapshotID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	messageID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	todoID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	createdAt := time.Date(2026, 2, 12, 12, 0, 0, 0, time.UTC)
+	query := "INSERT INTO conversation_snapshots " +
+		"(id,conversation_id,summary,pinned_todos,open_loops,last_message_id,change_sequence,created_at,tenant_id) " +
+		"VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
+
+	tests := map[string]struct {
+		snapshot  assistant.ConversationSnapshot
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			snapshot: assistant.ConversationSnapshot{
+				ID:             snapshotID,
+				ConversationID: conversationID,
+				Summary:        "memory: dinner planning",
+				PinnedTodos: []assistant.PinnedTodo{
+					{ID: todoID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+				},
+				OpenLoops:      []string{"confirm guest list"},
+				LastMessageID:  messageID,
+				ChangeSequence: 4,
+				CreatedAt:      createdAt,
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(
+						snapshotID,
+						conversationID,
+						"memory: dinner planning",
+						[]byte(`[{"id":"323e4567-e89b-12d3-a456-426614174002","title":"Buy wine","status":"OPEN","due_date":"2026-02-20"}]`),
+						[]byte(`["confirm guest list"]`),
+						messageID,
+						int64(4),
+						createdAt,
+						tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"stores-empty-lists": {
+			snapshot: assistant.ConversationSnapshot{
+				ID:             snapshotID,
+				ConversationID: conversationID,
+				Summary:        "memory: none",
+				LastMessageID:  messageID,
+				CreatedAt:      createdAt,
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(snapshotID, conversationID, "memory: none", []byte(`[]`), []byte(`[]`), messageID, int64(0), createdAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			snapshot: assistant.ConversationSnapshot{
+				ID:             snapshotID,
+				ConversationID: conversationID,
+				LastMessageID:  messageID,
+				CreatedAt:      createdAt,
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationSnapshotRepository(db)
+			gotErr := repo.StoreConversationSnapshot(t.Context(), tt.snapshot)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationSnapshotRepository_GetLatestConversationSnapshot(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	snapshotID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	messageID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	todoID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	createdAt := time.Date(2026, 2, 12, 12, 0, 0, 0, time.UTC)
+	query := "SELECT id, conversation_id, summary, pinned_todos, open_loops, last_message_id, change_sequence, created_at " +
+		"FROM conversation_snapshots WHERE conversation_id = $1 AND tenant_id = $2 ORDER BY created_at DESC LIMIT 1"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     assistant.ConversationSnapshot
+		expectedFind bool
+		expectErr    bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(conversationSnapshotFields).
+					AddRow(
+						snapshotID,
+						conversationID,
+						"memory: dinner planning",
+						[]byte(`[{"id":"323e4567-e89b-12d3-a456-426614174002","title":"Buy wine","status":"OPEN","due_date":"2026-02-20"}]`),
+						[]byte(`["confirm guest list"]`),
+						messageID,
+						int64(4),
+						createdAt,
+					)
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnRows(rows)
+			},
+			expected: assistant.ConversationSnapshot{
+				ID:             snapshotID,
+				ConversationID: conversationID,
+				Summary:        "memory: dinner planning",
+				PinnedTodos: []assistant.PinnedTodo{
+					{ID: todoID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+				},
+				OpenLoops:      []string{"confirm guest list"},
+				LastMessageID:  messageID,
+				ChangeSequence: 4,
+				CreatedAt:      createdAt,
+			},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"invalid-pinned-todos": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(conversationSnapshotFields).
+					AddRow(snapshotID, conversationID, "summary", []byte(`{`), []byte(`[]`), messageID, int64(0), createdAt)
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationSnapshotRepository(db)
+			got, found, gotErr := repo.GetLatestConversationSnapshot(t.Context(), conversationID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationSnapshotRepository_DeleteConversationSnapshots(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("DELETE FROM conversation_snapshots WHERE conversation_id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("DELETE FROM conversation_snapshots WHERE conversation_id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationSnapshotRepository(db)
+			gotErr := repo.DeleteConversationSnapshots(t.Context(), conversationID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
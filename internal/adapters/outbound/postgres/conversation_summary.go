@@ -44,6 +44,7 @@ func (r ConversationSummaryRepository) GetConversationSummary(
 		Select(conversationSummaryFields...).
 		From("conversations_summary").
 		Where(squirrel.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
 		Limit(1).
 		QueryRowContext(spanCtx).
 		Scan(
@@ -73,12 +74,14 @@ func (r ConversationSummaryRepository) StoreConversationSummary(ctx context.Cont
 	_, err := r.sb.
 		Insert("conversations_summary").
 		Columns(conversationSummaryFields...).
+		Columns(tenantColumn).
 		Values(
 			summary.ID,
 			summary.ConversationID,
 			summary.CurrentStateSummary,
 			summary.LastSummarizedMessageID,
 			summary.UpdatedAt,
+			tenantOf(ctx),
 		).
 		Suffix(`ON CONFLICT (conversation_id) DO UPDATE SET
 			current_state_summary = EXCLUDED.current_state_summary,
@@ -100,6 +103,7 @@ func (r ConversationSummaryRepository) DeleteConversationSummary(ctx context.Con
 	_, err := r.sb.
 		Delete("conversations_summary").
 		Where(squirrel.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 	if telemetry.IsErrorRecorded(span, err) {
 		return err
@@ -9,6 +9,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -31,8 +32,8 @@ func TestConversationSummaryRepository_GetConversationSummary(t *testing.T) {
 			expect: func(m sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows(conversationSummaryFields).
 					AddRow(summaryID, conversationID, "current state", messageID, updatedAt)
-				m.ExpectQuery("SELECT id, conversation_id, current_state_summary, last_summarized_message_id, updated_at FROM conversations_summary WHERE conversation_id = $1 LIMIT 1").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, current_state_summary, last_summarized_message_id, updated_at FROM conversations_summary WHERE conversation_id = $1 AND tenant_id = $2 LIMIT 1").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expected: assistant.ConversationSummary{
@@ -47,8 +48,8 @@ func TestConversationSummaryRepository_GetConversationSummary(t *testing.T) {
 		},
 		"not-found": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectQuery("SELECT id, conversation_id, current_state_summary, last_summarized_message_id, updated_at FROM conversations_summary WHERE conversation_id = $1 LIMIT 1").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, current_state_summary, last_summarized_message_id, updated_at FROM conversations_summary WHERE conversation_id = $1 AND tenant_id = $2 LIMIT 1").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expected:     assistant.ConversationSummary{},
@@ -57,8 +58,8 @@ func TestConversationSummaryRepository_GetConversationSummary(t *testing.T) {
 		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectQuery("SELECT id, conversation_id, current_state_summary, last_summarized_message_id, updated_at FROM conversations_summary WHERE conversation_id = $1 LIMIT 1").
-					WithArgs(conversationID).
+				m.ExpectQuery("SELECT id, conversation_id, current_state_summary, last_summarized_message_id, updated_at FROM conversations_summary WHERE conversation_id = $1 AND tenant_id = $2 LIMIT 1").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expected:     assistant.ConversationSummary{},
@@ -112,16 +113,16 @@ func TestConversationSummaryRepository_StoreConversationSummary(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec(`INSERT INTO conversations_summary (id,conversation_id,current_state_summary,last_summarized_message_id,updated_at) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (conversation_id) DO UPDATE SET current_state_summary = EXCLUDED.current_state_summary, last_summarized_message_id = EXCLUDED.last_summarized_message_id, updated_at = EXCLUDED.updated_at`).
-					WithArgs(summary.ID, summary.ConversationID, summary.CurrentStateSummary, summary.LastSummarizedMessageID, summary.UpdatedAt).
+				m.ExpectExec(`INSERT INTO conversations_summary (id,conversation_id,current_state_summary,last_summarized_message_id,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (conversation_id) DO UPDATE SET current_state_summary = EXCLUDED.current_state_summary, last_summarized_message_id = EXCLUDED.last_summarized_message_id, updated_at = EXCLUDED.updated_at`).
+					WithArgs(summary.ID, summary.ConversationID, summary.CurrentStateSummary, summary.LastSummarizedMessageID, summary.UpdatedAt, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectErr: false,
 		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec(`INSERT INTO conversations_summary (id,conversation_id,current_state_summary,last_summarized_message_id,updated_at) VALUES ($1,$2,$3,$4,$5) ON CONFLICT (conversation_id) DO UPDATE SET current_state_summary = EXCLUDED.current_state_summary, last_summarized_message_id = EXCLUDED.last_summarized_message_id, updated_at = EXCLUDED.updated_at`).
-					WithArgs(summary.ID, summary.ConversationID, summary.CurrentStateSummary, summary.LastSummarizedMessageID, summary.UpdatedAt).
+				m.ExpectExec(`INSERT INTO conversations_summary (id,conversation_id,current_state_summary,last_summarized_message_id,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (conversation_id) DO UPDATE SET current_state_summary = EXCLUDED.current_state_summary, last_summarized_message_id = EXCLUDED.last_summarized_message_id, updated_at = EXCLUDED.updated_at`).
+					WithArgs(summary.ID, summary.ConversationID, summary.CurrentStateSummary, summary.LastSummarizedMessageID, summary.UpdatedAt, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectErr: true,
@@ -158,16 +159,16 @@ func TestConversationSummaryRepository_DeleteConversationSummary(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM conversations_summary WHERE conversation_id = $1").
-					WithArgs(conversationID).
+				m.ExpectExec("DELETE FROM conversations_summary WHERE conversation_id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectErr: false,
 		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM conversations_summary WHERE conversation_id = $1").
-					WithArgs(conversationID).
+				m.ExpectExec("DELETE FROM conversations_summary WHERE conversation_id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectErr: true,
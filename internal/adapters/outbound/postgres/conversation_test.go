@@ -7,16 +7,19 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
 var (
-	selectConversationQuery                  = "SELECT id, title, title_source, last_message_at, created_at, updated_at FROM conversations WHERE id = $1 LIMIT 1"
-	listConversationQuery                    = "SELECT id, title, title_source, last_message_at, created_at, updated_at FROM conversations ORDER BY last_message_at DESC NULLS LAST, updated_at DESC, created_at DESC LIMIT 3 OFFSET 0"
-	selectConversationContextTokenUsageQuery = "SELECT conversations.id AS conversation_id, COALESCE(conversation_token_usage.total_tokens_used, 0) AS total_tokens_used FROM conversations LEFT JOIN LATERAL ( SELECT COALESCE(SUM(chat_messages.context_tokens_estimate), 0)::BIGINT AS total_tokens_used FROM chat_messages LEFT JOIN conversations_summary conversation_summary ON conversation_summary.conversation_id = conversations.id LEFT JOIN chat_messages checkpoint ON checkpoint.conversation_id = conversations.id AND checkpoint.id = conversation_summary.last_summarized_message_id WHERE chat_messages.conversation_id = conversations.id AND (\n\t\t\tcheckpoint.id IS NULL\n\t\t\tOR chat_messages.created_at > checkpoint.created_at\n\t\t\tOR (\n\t\t\t\tchat_messages.created_at = checkpoint.created_at\n\t\t\t\tAND chat_messages.id > checkpoint.id\n\t\t\t)\n\t\t) ) conversation_token_usage ON TRUE WHERE conversations.id = ANY($1)"
+	insertConversationChangeQuery            = "INSERT INTO conversation_changes (conversation_id,change_type,tenant_id) VALUES ($1,$2,$3)"
+	selectConversationQuery                  = "SELECT id, title, title_source, temperature, top_p, model, last_message_at, created_at, updated_at FROM conversations WHERE id = $1 AND tenant_id = $2 LIMIT 1"
+	listConversationQuery                    = "SELECT id, title, title_source, temperature, top_p, model, last_message_at, created_at, updated_at FROM conversations WHERE tenant_id = $1 ORDER BY last_message_at DESC NULLS LAST, updated_at DESC, created_at DESC, id DESC LIMIT 3 OFFSET 0"
+	selectConversationContextTokenUsageQuery = "SELECT conversations.id AS conversation_id, COALESCE(conversation_token_usage.total_tokens_used, 0) AS total_tokens_used FROM conversations LEFT JOIN LATERAL ( SELECT COALESCE(SUM(chat_messages.context_tokens_estimate), 0)::BIGINT AS total_tokens_used FROM chat_messages LEFT JOIN conversations_summary conversation_summary ON conversation_summary.conversation_id = conversations.id LEFT JOIN chat_messages checkpoint ON checkpoint.conversation_id = conversations.id AND checkpoint.id = conversation_summary.last_summarized_message_id WHERE chat_messages.conversation_id = conversations.id AND (\n\t\t\tcheckpoint.id IS NULL\n\t\t\tOR chat_messages.created_at > checkpoint.created_at\n\t\t\tOR (\n\t\t\t\tchat_messages.created_at = checkpoint.created_at\n\t\t\t\tAND chat_messages.id > checkpoint.id\n\t\t\t)\n\t\t) ) conversation_token_usage ON TRUE WHERE conversations.id = ANY($1) AND conversations.tenant_id = $2"
 )
 
 func TestConversationRepository_CreateConversation(t *testing.T) {
@@ -37,10 +40,13 @@ func TestConversationRepository_CreateConversation(t *testing.T) {
 			titleSource: assistant.ConversationTitleSource_Auto,
 			expect: func(m sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows(conversationFields).
-					AddRow(fixedID, "Plan Japan trip", assistant.ConversationTitleSource_Auto, nil, fixedTime, fixedTime)
-				m.ExpectQuery("INSERT INTO conversations (id,title,title_source,last_message_at,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id, title, title_source, last_message_at, created_at, updated_at").
-					WithArgs(sqlmock.AnyArg(), "Plan Japan trip", assistant.ConversationTitleSource_Auto, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					AddRow(fixedID, "Plan Japan trip", assistant.ConversationTitleSource_Auto, nil, nil, "", nil, fixedTime, fixedTime)
+				m.ExpectQuery("INSERT INTO conversations (id,title,title_source,temperature,top_p,model,last_message_at,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING id, title, title_source, temperature, top_p, model, last_message_at, created_at, updated_at").
+					WithArgs(sqlmock.AnyArg(), "Plan Japan trip", assistant.ConversationTitleSource_Auto, nil, nil, "", nil, sqlmock.AnyArg(), sqlmock.AnyArg(), tenant.Default).
 					WillReturnRows(rows)
+				m.ExpectExec(insertConversationChangeQuery).
+					WithArgs(fixedID, assistant.ConversationChangeType_Created, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expected: assistant.Conversation{
 				ID:          fixedID,
@@ -61,8 +67,8 @@ func TestConversationRepository_CreateConversation(t *testing.T) {
 			title:       "Plan Japan trip",
 			titleSource: assistant.ConversationTitleSource_Auto,
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectQuery("INSERT INTO conversations (id,title,title_source,last_message_at,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6) RETURNING id, title, title_source, last_message_at, created_at, updated_at").
-					WithArgs(sqlmock.AnyArg(), "Plan Japan trip", assistant.ConversationTitleSource_Auto, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				m.ExpectQuery("INSERT INTO conversations (id,title,title_source,temperature,top_p,model,last_message_at,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING id, title, title_source, temperature, top_p, model, last_message_at, created_at, updated_at").
+					WithArgs(sqlmock.AnyArg(), "Plan Japan trip", assistant.ConversationTitleSource_Auto, nil, nil, "", nil, sqlmock.AnyArg(), sqlmock.AnyArg(), tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectErr: true,
@@ -107,15 +113,20 @@ func TestConversationRepository_GetConversation(t *testing.T) {
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows(conversationFields).
-					AddRow(conversationID, "Trip", assistant.ConversationTitleSource_User, lastMessageAt, fixedTime, fixedTime)
+					AddRow(conversationID, "Trip", assistant.ConversationTitleSource_User, 0.4, 0.9, "qwen3", lastMessageAt, fixedTime, fixedTime)
 				m.ExpectQuery(selectConversationQuery).
-					WithArgs(conversationID).
+					WithArgs(conversationID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expected: assistant.Conversation{
-				ID:            conversationID,
-				Title:         "Trip",
-				TitleSource:   assistant.ConversationTitleSource_User,
+				ID:          conversationID,
+				Title:       "Trip",
+				TitleSource: assistant.ConversationTitleSource_User,
+				Settings: assistant.ConversationSettings{
+					Temperature: common.Ptr(0.4),
+					TopP:        common.Ptr(0.9),
+					Model:       "qwen3",
+				},
 				LastMessageAt: &lastMessageAt,
 				CreatedAt:     fixedTime,
 				UpdatedAt:     fixedTime,
@@ -126,7 +137,7 @@ func TestConversationRepository_GetConversation(t *testing.T) {
 		"not-found": {
 			expect: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(selectConversationQuery).
-					WithArgs(conversationID).
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectedFind: false,
@@ -135,7 +146,7 @@ func TestConversationRepository_GetConversation(t *testing.T) {
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(selectConversationQuery).
-					WithArgs(conversationID).
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectedFind: false,
@@ -185,7 +196,7 @@ func TestConversationRepository_GetConversationContextTokenUsage(t *testing.T) {
 					AddRow(c1, int64(120)).
 					AddRow(c2, int64(55))
 				m.ExpectQuery(selectConversationContextTokenUsageQuery).
-					WithArgs(pq.Array([]uuid.UUID{c1, c2})).
+					WithArgs(pq.Array([]uuid.UUID{c1, c2}), tenant.Default).
 					WillReturnRows(rows)
 			},
 			expected: map[uuid.UUID]int64{
@@ -202,7 +213,7 @@ func TestConversationRepository_GetConversationContextTokenUsage(t *testing.T) {
 			conversationIDs: []uuid.UUID{c1, c2},
 			expect: func(m sqlmock.Sqlmock) {
 				m.ExpectQuery(selectConversationContextTokenUsageQuery).
-					WithArgs(pq.Array([]uuid.UUID{c1, c2})).
+					WithArgs(pq.Array([]uuid.UUID{c1, c2}), tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectErr: true,
@@ -237,9 +248,13 @@ func TestConversationRepository_UpdateConversation(t *testing.T) {
 	lastMessageAt := time.Date(2026, 2, 16, 13, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2026, 2, 16, 14, 0, 0, 0, time.UTC)
 	conversation := assistant.Conversation{
-		ID:            uuid.MustParse("00000000-0000-0000-0000-000000000001"),
-		Title:         "Renamed",
-		TitleSource:   assistant.ConversationTitleSource_User,
+		ID:          uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		Title:       "Renamed",
+		TitleSource: assistant.ConversationTitleSource_User,
+		Settings: assistant.ConversationSettings{
+			Temperature: common.Ptr(0.4),
+			Model:       "qwen3",
+		},
 		LastMessageAt: &lastMessageAt,
 		UpdatedAt:     updatedAt,
 	}
@@ -252,8 +267,11 @@ func TestConversationRepository_UpdateConversation(t *testing.T) {
 		"success": {
 			conversation: conversation,
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("UPDATE conversations SET title = $1, title_source = $2, last_message_at = $3, updated_at = $4 WHERE id = $5").
-					WithArgs(conversation.Title, conversation.TitleSource, conversation.LastMessageAt, conversation.UpdatedAt, conversation.ID).
+				m.ExpectExec("UPDATE conversations SET title = $1, title_source = $2, temperature = $3, top_p = $4, model = $5, last_message_at = $6, updated_at = $7 WHERE id = $8 AND tenant_id = $9").
+					WithArgs(conversation.Title, conversation.TitleSource, conversation.Settings.Temperature, conversation.Settings.TopP, conversation.Settings.Model, conversation.LastMessageAt, conversation.UpdatedAt, conversation.ID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectExec(insertConversationChangeQuery).
+					WithArgs(conversation.ID, assistant.ConversationChangeType_Updated, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectErr: false,
@@ -270,8 +288,8 @@ func TestConversationRepository_UpdateConversation(t *testing.T) {
 		"database-error": {
 			conversation: conversation,
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("UPDATE conversations SET title = $1, title_source = $2, last_message_at = $3, updated_at = $4 WHERE id = $5").
-					WithArgs(conversation.Title, conversation.TitleSource, conversation.LastMessageAt, conversation.UpdatedAt, conversation.ID).
+				m.ExpectExec("UPDATE conversations SET title = $1, title_source = $2, temperature = $3, top_p = $4, model = $5, last_message_at = $6, updated_at = $7 WHERE id = $8 AND tenant_id = $9").
+					WithArgs(conversation.Title, conversation.TitleSource, conversation.Settings.Temperature, conversation.Settings.TopP, conversation.Settings.Model, conversation.LastMessageAt, conversation.UpdatedAt, conversation.ID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectErr: true,
@@ -322,9 +340,9 @@ func TestConversationRepository_ListConversations(t *testing.T) {
 			pageSize: 2,
 			expect: func(m sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows(conversationFields).
-					AddRow(c1, "C1", assistant.ConversationTitleSource_Auto, lastMessageAt, createdAt, updatedAt).
-					AddRow(c2, "C2", assistant.ConversationTitleSource_User, nil, createdAt, updatedAt).
-					AddRow(c3, "C3", assistant.ConversationTitleSource_LLM, nil, createdAt, updatedAt)
+					AddRow(c1, "C1", assistant.ConversationTitleSource_Auto, nil, nil, "", lastMessageAt, createdAt, updatedAt).
+					AddRow(c2, "C2", assistant.ConversationTitleSource_User, nil, nil, "", nil, createdAt, updatedAt).
+					AddRow(c3, "C3", assistant.ConversationTitleSource_LLM, nil, nil, "", nil, createdAt, updatedAt)
 				m.ExpectQuery(listConversationQuery).
 					WillReturnRows(rows)
 			},
@@ -398,16 +416,19 @@ func TestConversationRepository_DeleteConversation(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM conversations WHERE id = $1").
-					WithArgs(conversationID).
+				m.ExpectExec("DELETE FROM conversations WHERE id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectExec(insertConversationChangeQuery).
+					WithArgs(conversationID, assistant.ConversationChangeType_Deleted, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectErr: false,
 		},
 		"database-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM conversations WHERE id = $1").
-					WithArgs(conversationID).
+				m.ExpectExec("DELETE FROM conversations WHERE id = $1 AND tenant_id = $2").
+					WithArgs(conversationID, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			expectErr: true,
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+// ConversationTopicRepository implements the assistant.ConversationTopicRepository interface using PostgreSQL
+// as the storage backend.
+type ConversationTopicRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewConversationTopicRepository creates a new instance of ConversationTopicRepository.
+func NewConversationTopicRepository(br sq.BaseRunner) ConversationTopicRepository {
+	return ConversationTopicRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// StoreConversationTopic creates or replaces the topic of a conversation.
+func (r ConversationTopicRepository) StoreConversationTopic(ctx context.Context, topic assistant.ConversationTopic) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("conversation_topics").
+		Columns("conversation_id", "embedding", "updated_at", tenantColumn).
+		Values(
+			topic.ConversationID,
+			pgvector.NewVector(toFloat32Truncated(topic.Embedding)),
+			topic.UpdatedAt,
+			tenantOf(ctx),
+		).
+		Suffix("ON CONFLICT (conversation_id) DO UPDATE SET embedding = EXCLUDED.embedding, updated_at = EXCLUDED.updated_at").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetConversationTopic retrieves the topic of a conversation.
+func (r ConversationTopicRepository) GetConversationTopic(ctx context.Context, conversationID uuid.UUID) (assistant.ConversationTopic, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var (
+		topic     assistant.ConversationTopic
+		embedding pgvector.Vector
+	)
+	err := r.sb.
+		Select("conversation_id", "embedding", "updated_at").
+		From("conversation_topics").
+		Where(sq.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx).
+		Scan(&topic.ConversationID, &embedding, &topic.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return assistant.ConversationTopic{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.ConversationTopic{}, false, err
+	}
+
+	topic.Embedding = toFloat64(embedding.Slice())
+	return topic, true, nil
+}
+
+// SearchRelatedConversations lists up to limit conversations, other than excludeConversationID, whose topic is
+// within maxDistance cosine distance of the embedding, closest first.
+func (r ConversationTopicRepository) SearchRelatedConversations(
+	ctx context.Context,
+	embedding []float64,
+	excludeConversationID uuid.UUID,
+	limit int,
+	maxDistance float64,
+) ([]assistant.RelatedConversation, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	vector := pgvector.NewVector(toFloat32Truncated(embedding))
+	rows, err := r.sb.
+		Select(
+			"c.id",
+			"c.title",
+			"COALESCE(c.last_message_at, t.updated_at)",
+		).
+		Column(sq.Expr("1 - (t.embedding <=> ?)", vector)).
+		From("conversation_topics t").
+		Join("conversations c ON c.id = t.conversation_id").
+		Where(sq.Eq{"t.tenant_id": tenantOf(ctx)}).
+		Where(sq.NotEq{"t.conversation_id": excludeConversationID}).
+		Where(sq.Expr("(t.embedding <=> ?) < ?", vector, maxDistance)).
+		OrderByClause("t.embedding <=> ?", vector).
+		Limit(uint64(limit)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	related := []assistant.RelatedConversation{}
+	for rows.Next() {
+		var conversation assistant.RelatedConversation
+		if err := rows.Scan(
+			&conversation.ConversationID,
+			&conversation.Title,
+			&conversation.LastActiveAt,
+			&conversation.Similarity,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		related = append(related, conversation)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return related, nil
+}
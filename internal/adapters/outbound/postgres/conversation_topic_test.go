@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationTopicRepository_StoreConversationTopic(t *testing.T) {
+	t.Parallel()
+
+	topic := assistant.ConversationTopic{
+		ConversationID: uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Embedding:      []float64{0.1, 0.2},
+		UpdatedAt:      time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	query := "INSERT INTO conversation_topics (conversation_id,embedding,updated_at,tenant_id) VALUES ($1,$2,$3,$4) " +
+		"ON CONFLICT (conversation_id) DO UPDATE SET embedding = EXCLUDED.embedding, updated_at = EXCLUDED.updated_at"
+	args := []driver.Value{
+		topic.ConversationID,
+		pgvector.NewVector(toFloat32Truncated(topic.Embedding)),
+		topic.UpdatedAt,
+		tenant.Default,
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationTopicRepository(db)
+			gotErr := repo.StoreConversationTopic(t.Context(), topic)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationTopicRepository_GetConversationTopic(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	updatedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT conversation_id, embedding, updated_at FROM conversation_topics WHERE conversation_id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     assistant.ConversationTopic
+		expectedFind bool
+		expectErr    bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"conversation_id", "embedding", "updated_at"}).
+					AddRow(conversationID, "[0.5,0.25]", updatedAt)
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     assistant.ConversationTopic{ConversationID: conversationID, Embedding: []float64{0.5, 0.25}, UpdatedAt: updatedAt},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(conversationID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationTopicRepository(db)
+			got, found, err := repo.GetConversationTopic(t.Context(), conversationID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestConversationTopicRepository_SearchRelatedConversations(t *testing.T) {
+	t.Parallel()
+
+	excludeID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	relatedID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	lastActiveAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	embedding := []float64{0.1, 0.2}
+	vector := pgvector.NewVector(toFloat32Truncated(embedding))
+	query := "SELECT c.id, c.title, COALESCE(c.last_message_at, t.updated_at), 1 - (t.embedding <=> $1) " +
+		"FROM conversation_topics t JOIN conversations c ON c.id = t.conversation_id " +
+		"WHERE t.tenant_id = $2 AND t.conversation_id <> $3 AND (t.embedding <=> $4) < $5 " +
+		"ORDER BY t.embedding <=> $6 LIMIT 5"
+	args := []driver.Value{vector, tenant.Default, excludeID, vector, 0.35, vector}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []assistant.RelatedConversation
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "last_active_at", "similarity"}).
+					AddRow(relatedID, "Spring cleaning", lastActiveAt, 0.87)
+				m.ExpectQuery(query).WithArgs(args...).WillReturnRows(rows)
+			},
+			expected: []assistant.RelatedConversation{
+				{ConversationID: relatedID, Title: "Spring cleaning", Similarity: 0.87, LastActiveAt: lastActiveAt},
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewConversationTopicRepository(db)
+			got, err := repo.SearchRelatedConversations(t.Context(), embedding, excludeID, 5, 0.35)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
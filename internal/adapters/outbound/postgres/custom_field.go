@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var customFieldFields = []string{
+	"name",
+	"type",
+	"options",
+	"created_at",
+}
+
+// CustomFieldRepository implements the todo.CustomFieldRepository interface using PostgreSQL as the storage backend.
+type CustomFieldRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewCustomFieldRepository creates a new instance of CustomFieldRepository.
+func NewCustomFieldRepository(br sq.BaseRunner) CustomFieldRepository {
+	return CustomFieldRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// ListCustomFields returns the custom fields of the tenant ordered by name.
+func (r CustomFieldRepository) ListCustomFields(ctx context.Context) ([]todo.CustomField, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(customFieldFields...).
+		From("custom_fields").
+		Where(tenantEq(ctx)).
+		OrderBy("name").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	fields := []todo.CustomField{}
+	for rows.Next() {
+		var (
+			field       todo.CustomField
+			optionsJSON []byte
+		)
+		if err := rows.Scan(
+			&field.Name,
+			&field.Type,
+			&optionsJSON,
+			&field.CreatedAt,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		if err := json.Unmarshal(optionsJSON, &field.Options); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// UpsertCustomField creates the custom field or replaces the type and options of the one with the same name.
+func (r CustomFieldRepository) UpsertCustomField(ctx context.Context, field todo.CustomField) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("name", field.Name),
+	))
+	defer span.End()
+
+	options := field.Options
+	if options == nil {
+		options = []string{}
+	}
+	optionsJSON, err := json.Marshal(options)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("custom_fields").
+		Columns(customFieldFields...).
+		Columns(tenantColumn).
+		Values(field.Name, field.Type, optionsJSON, field.CreatedAt, tenantOf(ctx)).
+		Suffix("ON CONFLICT (tenant_id, name) DO UPDATE SET type = EXCLUDED.type, options = EXCLUDED.options").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCustomField removes the custom field and strips its values from the todos of the tenant.
+func (r CustomFieldRepository) DeleteCustomField(ctx context.Context, name string) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	_, err := r.sb.
+		Update("todos").
+		Set("custom_fields", sq.Expr("custom_fields - ?", name)).
+		Where(sq.Expr("custom_fields ?? ?", name)).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Delete("custom_fields").
+		Where(sq.Eq{"name": name}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
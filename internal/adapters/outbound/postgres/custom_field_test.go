@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldRepository_ListCustomFields(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT name, type, options, created_at FROM custom_fields WHERE tenant_id = $1 ORDER BY name"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.CustomField
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(customFieldFields).
+					AddRow("area", todo.CustomFieldType_ENUM, []byte(`["home","work"]`), createdAt).
+					AddRow("points", todo.CustomFieldType_NUMBER, []byte(`[]`), createdAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.CustomField{
+				{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}, CreatedAt: createdAt},
+				{Name: "points", Type: todo.CustomFieldType_NUMBER, Options: []string{}, CreatedAt: createdAt},
+			},
+		},
+		"invalid-json": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(customFieldFields).AddRow("area", todo.CustomFieldType_ENUM, []byte(`{`), createdAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCustomFieldRepository(db)
+			got, err := repo.ListCustomFields(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCustomFieldRepository_UpsertCustomField(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "INSERT INTO custom_fields (name,type,options,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5) " +
+		"ON CONFLICT (tenant_id, name) DO UPDATE SET type = EXCLUDED.type, options = EXCLUDED.options"
+
+	tests := map[string]struct {
+		field     todo.CustomField
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"enum": {
+			field: todo.CustomField{Name: "area", Type: todo.CustomFieldType_ENUM, Options: []string{"home", "work"}, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs("area", todo.CustomFieldType_ENUM, []byte(`["home","work"]`), createdAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"without-options": {
+			field: todo.CustomField{Name: "points", Type: todo.CustomFieldType_NUMBER, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs("points", todo.CustomFieldType_NUMBER, []byte(`[]`), createdAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			field: todo.CustomField{Name: "points", Type: todo.CustomFieldType_NUMBER, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs("points", todo.CustomFieldType_NUMBER, []byte(`[]`), createdAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCustomFieldRepository(db)
+			gotErr := repo.UpsertCustomField(t.Context(), tt.field)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCustomFieldRepository_DeleteCustomField(t *testing.T) {
+	t.Parallel()
+
+	stripQuery := "UPDATE todos SET custom_fields = custom_fields - $1 WHERE custom_fields ? $2 AND tenant_id = $3"
+	deleteQuery := "DELETE FROM custom_fields WHERE name = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(stripQuery).WithArgs("area", "area", tenant.Default).WillReturnResult(sqlmock.NewResult(0, 2))
+				m.ExpectExec(deleteQuery).WithArgs("area", tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"strip-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(stripQuery).WithArgs("area", "area", tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		"delete-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(stripQuery).WithArgs("area", "area", tenant.Default).WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(deleteQuery).WithArgs("area", tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewCustomFieldRepository(db)
+			gotErr := repo.DeleteCustomField(t.Context(), "area")
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var embeddingBackfillFields = []string{
+	"model",
+	"slot",
+	"last_todo_id",
+	"total",
+	"processed",
+	"started_at",
+	"updated_at",
+	"completed_at",
+}
+
+// EmbeddingBackfillRepository implements the todo.EmbeddingBackfillRepository interface using PostgreSQL as the
+// storage backend.
+type EmbeddingBackfillRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewEmbeddingBackfillRepository creates a new instance of EmbeddingBackfillRepository.
+func NewEmbeddingBackfillRepository(br sq.BaseRunner) EmbeddingBackfillRepository {
+	return EmbeddingBackfillRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// GetEmbeddingBackfill retrieves the latest run for the model.
+func (r EmbeddingBackfillRepository) GetEmbeddingBackfill(ctx context.Context, model string) (todo.EmbeddingBackfill, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("model", model),
+	))
+	defer span.End()
+
+	backfill, err := scanEmbeddingBackfill(r.sb.
+		Select(embeddingBackfillFields...).
+		From("embedding_backfills").
+		Where(sq.Eq{"model": model}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.EmbeddingBackfill{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.EmbeddingBackfill{}, false, err
+	}
+
+	return backfill, true, nil
+}
+
+// SaveEmbeddingBackfill stores the checkpoint of a run, replacing the previous run for the same model.
+func (r EmbeddingBackfillRepository) SaveEmbeddingBackfill(ctx context.Context, backfill todo.EmbeddingBackfill) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("model", backfill.Model),
+		attribute.Int("processed", backfill.Processed),
+	))
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("embedding_backfills").
+		Columns(embeddingBackfillFields...).
+		Columns(tenantColumn).
+		Values(
+			backfill.Model,
+			backfill.Slot,
+			backfill.Cursor,
+			backfill.Total,
+			backfill.Processed,
+			backfill.StartedAt,
+			backfill.UpdatedAt,
+			backfill.CompletedAt,
+			tenantOf(ctx),
+		).
+		Suffix(`ON CONFLICT (tenant_id, model) DO UPDATE SET
+            slot = EXCLUDED.slot,
+            last_todo_id = EXCLUDED.last_todo_id,
+            total = EXCLUDED.total,
+            processed = EXCLUDED.processed,
+            started_at = EXCLUDED.started_at,
+            updated_at = EXCLUDED.updated_at,
+            completed_at = EXCLUDED.completed_at`).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListEmbeddingBackfills returns the latest run of every model, most recently updated first.
+func (r EmbeddingBackfillRepository) ListEmbeddingBackfills(ctx context.Context) ([]todo.EmbeddingBackfill, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(embeddingBackfillFields...).
+		From("embedding_backfills").
+		Where(tenantEq(ctx)).
+		OrderBy("updated_at DESC").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	backfills := []todo.EmbeddingBackfill{}
+	for rows.Next() {
+		backfill, err := scanEmbeddingBackfill(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		backfills = append(backfills, backfill)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return backfills, nil
+}
+
+// scanEmbeddingBackfill reads an embedding backfill row.
+func scanEmbeddingBackfill(row sq.RowScanner) (todo.EmbeddingBackfill, error) {
+	var backfill todo.EmbeddingBackfill
+	if err := row.Scan(
+		&backfill.Model,
+		&backfill.Slot,
+		&backfill.Cursor,
+		&backfill.Total,
+		&backfill.Processed,
+		&backfill.StartedAt,
+		&backfill.UpdatedAt,
+		&backfill.CompletedAt,
+	); err != nil {
+		return todo.EmbeddingBackfill{}, err
+	}
+	return backfill, nil
+}
@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixtureEmbeddingBackfill(model string, completedAt *time.Time) todo.EmbeddingBackfill {
+	startedAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	return todo.EmbeddingBackfill{
+		Model:       model,
+		Slot:        todo.EmbeddingSlot_Primary,
+		Cursor:      uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Total:       120,
+		Processed:   50,
+		StartedAt:   startedAt,
+		UpdatedAt:   startedAt.Add(time.Minute),
+		CompletedAt: completedAt,
+	}
+}
+
+func embeddingBackfillRow(rows *sqlmock.Rows, b todo.EmbeddingBackfill) *sqlmock.Rows {
+	return rows.AddRow(b.Model, b.Slot, b.Cursor, b.Total, b.Processed, b.StartedAt, b.UpdatedAt, b.CompletedAt)
+}
+
+func TestEmbeddingBackfillRepository_GetEmbeddingBackfill(t *testing.T) {
+	t.Parallel()
+
+	backfill := fixtureEmbeddingBackfill("embedding-v2", nil)
+	query := "SELECT model, slot, last_todo_id, total, processed, started_at, updated_at, completed_at " +
+		"FROM embedding_backfills WHERE model = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect        func(sqlmock.Sqlmock)
+		expected      todo.EmbeddingBackfill
+		expectedFound bool
+		expectErr     bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := embeddingBackfillRow(sqlmock.NewRows(embeddingBackfillFields), backfill)
+				m.ExpectQuery(query).WithArgs("embedding-v2", tenant.Default).WillReturnRows(rows)
+			},
+			expected:      backfill,
+			expectedFound: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("embedding-v2", tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("embedding-v2", tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewEmbeddingBackfillRepository(db)
+			got, found, err := repo.GetEmbeddingBackfill(t.Context(), "embedding-v2")
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFound, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEmbeddingBackfillRepository_SaveEmbeddingBackfill(t *testing.T) {
+	t.Parallel()
+
+	backfill := fixtureEmbeddingBackfill("embedding-v2", nil)
+	query := "INSERT INTO embedding_backfills (model,slot,last_todo_id,total,processed,started_at,updated_at,completed_at,tenant_id) " +
+		"VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) ON CONFLICT (tenant_id, model) DO UPDATE SET slot = EXCLUDED.slot, last_todo_id = EXCLUDED.last_todo_id, " +
+		"total = EXCLUDED.total, processed = EXCLUDED.processed, started_at = EXCLUDED.started_at, " +
+		"updated_at = EXCLUDED.updated_at, completed_at = EXCLUDED.completed_at"
+	args := []driver.Value{
+		backfill.Model, backfill.Slot, backfill.Cursor, backfill.Total, backfill.Processed,
+		backfill.StartedAt, backfill.UpdatedAt, backfill.CompletedAt, tenant.Default,
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewEmbeddingBackfillRepository(db)
+			gotErr := repo.SaveEmbeddingBackfill(t.Context(), backfill)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEmbeddingBackfillRepository_ListEmbeddingBackfills(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC)
+	b1 := fixtureEmbeddingBackfill("embedding-v2", nil)
+	b2 := fixtureEmbeddingBackfill("embedding-v1", &completedAt)
+	query := "SELECT model, slot, last_todo_id, total, processed, started_at, updated_at, completed_at " +
+		"FROM embedding_backfills WHERE tenant_id = $1 ORDER BY updated_at DESC"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.EmbeddingBackfill
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := embeddingBackfillRow(embeddingBackfillRow(sqlmock.NewRows(embeddingBackfillFields), b1), b2)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.EmbeddingBackfill{b1, b2},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(sqlmock.NewRows(embeddingBackfillFields))
+			},
+			expected: []todo.EmbeddingBackfill{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewEmbeddingBackfillRepository(db)
+			got, err := repo.ListEmbeddingBackfills(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
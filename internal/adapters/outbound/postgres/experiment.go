@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ExperimentRepository is a PostgreSQL implementation of assistant.ExperimentRepository.
+// It aggregates the experiment metadata recorded on chat messages.
+type ExperimentRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewExperimentRepository creates a new instance of ExperimentRepository.
+func NewExperimentRepository(br squirrel.BaseRunner) ExperimentRepository {
+	return ExperimentRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// ListVariantOutcomes aggregates the final assistant message of every turn answered under the experiment.
+// Final messages always carry content, unlike the assistant messages requesting actions.
+func (r ExperimentRepository) ListVariantOutcomes(ctx context.Context, experiment string) ([]assistant.VariantOutcome, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("experiment", experiment),
+	))
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(
+			"experiment_variant->>'variant' AS variant",
+			"COUNT(*) AS turns",
+			"COUNT(*) FILTER (WHERE message_state = 'FAILED') AS failed_turns",
+			"COUNT(*) FILTER (WHERE action_loop_detected) AS action_loop_turns",
+			"COUNT(feedback_score) AS feedback_count",
+			"COALESCE(AVG(feedback_score), 0)::FLOAT8 AS feedback_score",
+		).
+		From("chat_messages").
+		Where(squirrel.Eq{
+			"chat_role":                         assistant.ChatRole_Assistant,
+			"experiment_variant->>'experiment'": experiment,
+		}).
+		Where(squirrel.NotEq{"content": ""}).
+		Where(tenantEq(ctx)).
+		GroupBy("variant").
+		OrderBy("variant").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var outcomes []assistant.VariantOutcome
+	for rows.Next() {
+		var outcome assistant.VariantOutcome
+		if err := rows.Scan(
+			&outcome.Variant,
+			&outcome.Turns,
+			&outcome.FailedTurns,
+			&outcome.ActionLoopTurns,
+			&outcome.FeedbackCount,
+			&outcome.FeedbackScore,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return outcomes, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExperimentRepository_ListVariantOutcomes(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT experiment_variant->>'variant' AS variant, COUNT(*) AS turns, " +
+		"COUNT(*) FILTER (WHERE message_state = 'FAILED') AS failed_turns, " +
+		"COUNT(*) FILTER (WHERE action_loop_detected) AS action_loop_turns, " +
+		"COUNT(feedback_score) AS feedback_count, " +
+		"COALESCE(AVG(feedback_score), 0)::FLOAT8 AS feedback_score " +
+		"FROM chat_messages " +
+		"WHERE chat_role = $1 AND experiment_variant->>'experiment' = $2 AND content <> $3 AND tenant_id = $4 " +
+		"GROUP BY variant ORDER BY variant"
+	columns := []string{"variant", "turns", "failed_turns", "action_loop_turns", "feedback_count", "feedback_score"}
+
+	tests := map[string]struct {
+		expect           func(sqlmock.Sqlmock)
+		expectedOutcomes []assistant.VariantOutcome
+		expectErr        bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(assistant.ChatRole_Assistant, "prompt-v2", "", tenant.Default).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow("concise", 40, 2, 1, 10, 0.6).
+						AddRow("control", 50, 5, 4, 8, -0.25))
+			},
+			expectedOutcomes: []assistant.VariantOutcome{
+				{Variant: "concise", Turns: 40, FailedTurns: 2, ActionLoopTurns: 1, FeedbackCount: 10, FeedbackScore: 0.6},
+				{Variant: "control", Turns: 50, FailedTurns: 5, ActionLoopTurns: 4, FeedbackCount: 8, FeedbackScore: -0.25},
+			},
+		},
+		"no-turns": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(assistant.ChatRole_Assistant, "prompt-v2", "", tenant.Default).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(assistant.ChatRole_Assistant, "prompt-v2", "", tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewExperimentRepository(db)
+			outcomes, err := repo.ListVariantOutcomes(t.Context(), "prompt-v2")
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutcomes, outcomes)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var focusBlockFields = []string{
+	"id",
+	"todo_id",
+	"title",
+	"starts_at",
+	"ends_at",
+	"created_at",
+}
+
+// FocusBlockRepository implements the todo.FocusBlockRepository interface using PostgreSQL as the storage backend.
+type FocusBlockRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewFocusBlockRepository creates a new instance of FocusBlockRepository.
+func NewFocusBlockRepository(br sq.BaseRunner) FocusBlockRepository {
+	return FocusBlockRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateFocusBlocks stores new accepted focus blocks in one statement.
+func (r FocusBlockRepository) CreateFocusBlocks(ctx context.Context, blocks []todo.FocusBlock) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("blocks", len(blocks)),
+	))
+	defer span.End()
+
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	insert := r.sb.
+		Insert("focus_blocks").
+		Columns(focusBlockFields...).
+		Columns(tenantColumn)
+	for _, b := range blocks {
+		insert = insert.Values(
+			b.ID,
+			b.TodoID,
+			b.Title,
+			b.Start,
+			b.End,
+			b.CreatedAt,
+			tenantOf(ctx),
+		)
+	}
+
+	_, err := insert.ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListFocusBlocks returns the accepted focus blocks starting in the [from, to) range, earliest first.
+func (r FocusBlockRepository) ListFocusBlocks(ctx context.Context, from, to time.Time) ([]todo.FocusBlock, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(focusBlockFields...).
+		From("focus_blocks").
+		Where(sq.GtOrEq{"starts_at": from}).
+		Where(sq.Lt{"starts_at": to}).
+		Where(tenantEq(ctx)).
+		OrderBy("starts_at ASC").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	blocks := []todo.FocusBlock{}
+	for rows.Next() {
+		b := todo.FocusBlock{Status: todo.FocusBlockStatus_ACCEPTED}
+		if err := rows.Scan(
+			&b.ID,
+			&b.TodoID,
+			&b.Title,
+			&b.Start,
+			&b.End,
+			&b.CreatedAt,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		blocks = append(blocks, b)
+	}
+
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFocusBlockRepository_CreateFocusBlocks(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	blocks := []todo.FocusBlock{
+		{
+			ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			TodoID:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+			Title:     "Write report",
+			Start:     start,
+			End:       start.Add(time.Hour),
+			CreatedAt: start,
+		},
+		{
+			ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+			TodoID:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174003"),
+			Title:     "Review budget",
+			Start:     start.Add(time.Hour),
+			End:       start.Add(2 * time.Hour),
+			CreatedAt: start,
+		},
+	}
+	query := "INSERT INTO focus_blocks (id,todo_id,title,starts_at,ends_at,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7),($8,$9,$10,$11,$12,$13,$14)"
+
+	tests := map[string]struct {
+		blocks    []todo.FocusBlock
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			blocks: blocks,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(
+						blocks[0].ID, blocks[0].TodoID, blocks[0].Title, blocks[0].Start, blocks[0].End, blocks[0].CreatedAt, tenant.Default,
+						blocks[1].ID, blocks[1].TodoID, blocks[1].Title, blocks[1].Start, blocks[1].End, blocks[1].CreatedAt, tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+			},
+		},
+		"no-blocks": {
+			blocks: nil,
+			expect: func(m sqlmock.Sqlmock) {},
+		},
+		"database-error": {
+			blocks: blocks,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewFocusBlockRepository(db)
+			gotErr := repo.CreateFocusBlocks(t.Context(), tt.blocks)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFocusBlockRepository_ListFocusBlocks(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	start := from.Add(9 * time.Hour)
+	blockID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	query := "SELECT id, todo_id, title, starts_at, ends_at, created_at FROM focus_blocks WHERE starts_at >= $1 AND starts_at < $2 AND tenant_id = $3 ORDER BY starts_at ASC"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.FocusBlock
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(focusBlockFields).
+					AddRow(blockID, todoID, "Write report", start, start.Add(time.Hour), from)
+				m.ExpectQuery(query).
+					WithArgs(from, to, tenant.Default).
+					WillReturnRows(rows)
+			},
+			expected: []todo.FocusBlock{{
+				ID:        blockID,
+				TodoID:    todoID,
+				Title:     "Write report",
+				Start:     start,
+				End:       start.Add(time.Hour),
+				Status:    todo.FocusBlockStatus_ACCEPTED,
+				CreatedAt: from,
+			}},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(from, to, tenant.Default).
+					WillReturnRows(sqlmock.NewRows(focusBlockFields))
+			},
+			expected: []todo.FocusBlock{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(from, to, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewFocusBlockRepository(db)
+			got, gotErr := repo.ListFocusBlocks(t.Context(), from, to)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
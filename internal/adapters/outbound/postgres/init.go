@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
@@ -29,7 +31,18 @@ type InitChatMessageRepository struct {
 
 // Initialize registers the ChatMessageRepository in the dependency container.
 func (r InitChatMessageRepository) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[assistant.ChatMessageRepository](NewChatMessageRepository(r.DB))
+	depend.Register[assistant.ChatMessageRepository](NewChatMessageRepository(NewSandboxRunner(r.DB)))
+	return ctx, nil
+}
+
+// InitChatMessagePartitionRepository is a Symbiont initializer for ChatMessagePartitionRepository.
+type InitChatMessagePartitionRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ChatMessagePartitionRepository in the dependency container.
+func (i InitChatMessagePartitionRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ChatMessagePartitionRepository](NewChatMessagePartitionRepository(i.DB))
 	return ctx, nil
 }
 
@@ -40,7 +53,29 @@ type InitConversationRepository struct {
 
 // Initialize registers the ConversationRepository in the dependency container.
 func (i InitConversationRepository) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[assistant.ConversationRepository](NewConversationRepository(i.DB))
+	depend.Register[assistant.ConversationRepository](NewConversationRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitConversationReadModelRepository is a Symbiont initializer for ConversationReadModelRepository.
+type InitConversationReadModelRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ConversationReadModelRepository in the dependency container.
+func (i InitConversationReadModelRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ConversationReadModelRepository](NewConversationReadModelRepository(i.DB))
+	return ctx, nil
+}
+
+// InitConversationReadCursorRepository is a Symbiont initializer for ConversationReadCursorRepository.
+type InitConversationReadCursorRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ConversationReadCursorRepository in the dependency container.
+func (i InitConversationReadCursorRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ConversationReadCursorRepository](NewConversationReadCursorRepository(i.DB))
 	return ctx, nil
 }
 
@@ -62,7 +97,139 @@ type InitConversationSummaryRepository struct {
 
 // Initialize registers the ConversationSummaryRepository in the dependency container.
 func (i InitConversationSummaryRepository) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[assistant.ConversationSummaryRepository](NewConversationSummaryRepository(i.DB))
+	depend.Register[assistant.ConversationSummaryRepository](NewConversationSummaryRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitContentBlobRepository is a Symbiont initializer for ContentBlobRepository.
+type InitContentBlobRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ContentBlobRepository in the dependency container.
+func (i InitContentBlobRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ContentBlobRepository](NewContentBlobRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitConversationSnapshotRepository is a Symbiont initializer for ConversationSnapshotRepository.
+type InitConversationSnapshotRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ConversationSnapshotRepository in the dependency container.
+func (i InitConversationSnapshotRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ConversationSnapshotRepository](NewConversationSnapshotRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitInstructionRepository is a Symbiont initializer for InstructionRepository.
+type InitInstructionRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the InstructionRepository in the dependency container.
+func (i InitInstructionRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.InstructionRepository](NewInstructionRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitMemoryRepository is a Symbiont initializer for MemoryRepository.
+type InitMemoryRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the MemoryRepository in the dependency container.
+func (i InitMemoryRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.MemoryRepository](NewMemoryRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitConversationTopicRepository is a Symbiont initializer for ConversationTopicRepository.
+type InitConversationTopicRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ConversationTopicRepository in the dependency container.
+func (i InitConversationTopicRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ConversationTopicRepository](NewConversationTopicRepository(i.DB))
+	return ctx, nil
+}
+
+// InitShadowEvaluationRepository is a Symbiont initializer for ShadowEvaluationRepository.
+type InitShadowEvaluationRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ShadowEvaluationRepository in the dependency container.
+func (i InitShadowEvaluationRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ShadowEvaluationRepository](NewShadowEvaluationRepository(i.DB))
+	return ctx, nil
+}
+
+// InitExperimentRepository is a Symbiont initializer for ExperimentRepository.
+type InitExperimentRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ExperimentRepository in the dependency container.
+func (i InitExperimentRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ExperimentRepository](NewExperimentRepository(i.DB))
+	return ctx, nil
+}
+
+// InitChannelLinkRepository is a Symbiont initializer for ChannelLinkRepository.
+type InitChannelLinkRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ChannelLinkRepository in the dependency container.
+func (i InitChannelLinkRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ChannelLinkRepository](NewChannelLinkRepository(i.DB))
+	return ctx, nil
+}
+
+// InitSessionRepository is a Symbiont initializer for SessionRepository.
+type InitSessionRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the SessionRepository in the dependency container.
+func (i InitSessionRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[access.SessionRepository](NewSessionRepository(i.DB))
+	return ctx, nil
+}
+
+// InitTokenRepository is a Symbiont initializer for TokenRepository.
+type InitTokenRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the TokenRepository in the dependency container.
+func (i InitTokenRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[access.TokenRepository](NewTokenRepository(i.DB))
+	return ctx, nil
+}
+
+// InitUserRepository is a Symbiont initializer for UserRepository.
+type InitUserRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the UserRepository in the dependency container.
+func (i InitUserRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[access.UserRepository](NewUserRepository(i.DB))
+	return ctx, nil
+}
+
+// InitAuditRepository is a Symbiont initializer for AuditRepository.
+type InitAuditRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the AuditRepository in the dependency container.
+func (i InitAuditRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[audit.Repository](NewAuditRepository(i.DB))
 	return ctx, nil
 }
 
@@ -73,17 +240,151 @@ type InitTodoRepository struct {
 
 // Initialize registers the TodoRepository in the dependency container.
 func (tr InitTodoRepository) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[todo.Repository](NewTodoRepository(tr.DB))
+	depend.Register[todo.Repository](NewTodoRepository(NewSandboxRunner(tr.DB)))
+	return ctx, nil
+}
+
+// InitTimeEntryRepository is a Symbiont initializer for TimeEntryRepository.
+type InitTimeEntryRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the TimeEntryRepository in the dependency container.
+func (i InitTimeEntryRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.TimeEntryRepository](NewTimeEntryRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitFocusBlockRepository is a Symbiont initializer for FocusBlockRepository.
+type InitFocusBlockRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the FocusBlockRepository in the dependency container.
+func (i InitFocusBlockRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.FocusBlockRepository](NewFocusBlockRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitCommentRepository is a Symbiont initializer for CommentRepository.
+type InitCommentRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the CommentRepository in the dependency container.
+func (i InitCommentRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.CommentRepository](NewCommentRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitViewRepository is a Symbiont initializer for ViewRepository.
+type InitViewRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ViewRepository in the dependency container.
+func (i InitViewRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.ViewRepository](NewViewRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitTemplateRepository is a Symbiont initializer for TemplateRepository.
+type InitTemplateRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the TemplateRepository in the dependency container.
+func (i InitTemplateRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.TemplateRepository](NewTemplateRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitBoardSnapshotRepository is a Symbiont initializer for BoardSnapshotRepository.
+type InitBoardSnapshotRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the BoardSnapshotRepository in the dependency container.
+func (i InitBoardSnapshotRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.BoardSnapshotRepository](NewBoardSnapshotRepository(i.DB))
+	return ctx, nil
+}
+
+// InitWeeklyReviewRepository is a Symbiont initializer for WeeklyReviewRepository.
+type InitWeeklyReviewRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the WeeklyReviewRepository in the dependency container.
+func (i InitWeeklyReviewRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.WeeklyReviewRepository](NewWeeklyReviewRepository(i.DB))
+	return ctx, nil
+}
+
+// InitProjectRepository is a Symbiont initializer for ProjectRepository.
+type InitProjectRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ProjectRepository in the dependency container.
+func (i InitProjectRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.ProjectRepository](NewProjectRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitCustomFieldRepository is a Symbiont initializer for CustomFieldRepository.
+type InitCustomFieldRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the CustomFieldRepository in the dependency container.
+func (i InitCustomFieldRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.CustomFieldRepository](NewCustomFieldRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitEmbeddingBackfillRepository is a Symbiont initializer for EmbeddingBackfillRepository.
+type InitEmbeddingBackfillRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the EmbeddingBackfillRepository in the dependency container.
+func (i InitEmbeddingBackfillRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.EmbeddingBackfillRepository](NewEmbeddingBackfillRepository(i.DB))
+	return ctx, nil
+}
+
+// InitChangeRepository is a Symbiont initializer for ChangeRepository.
+type InitChangeRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ChangeRepository in the dependency container.
+func (i InitChangeRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[todo.ChangeRepository](NewChangeRepository(NewSandboxRunner(i.DB)))
+	return ctx, nil
+}
+
+// InitConversationChangeRepository is a Symbiont initializer for ConversationChangeRepository.
+type InitConversationChangeRepository struct {
+	DB *sql.DB `resolve:""`
+}
+
+// Initialize registers the ConversationChangeRepository in the dependency container.
+func (i InitConversationChangeRepository) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ConversationChangeRepository](NewConversationChangeRepository(NewSandboxRunner(i.DB)))
 	return ctx, nil
 }
 
-// InitUnitOfWork is responsible for initializing the UnitOfWork dependency.
+// InitUnitOfWork is responsible for initializing the UnitOfWork and Sandbox dependencies.
 type InitUnitOfWork struct {
 	DB *sql.DB `resolve:""`
 }
 
-// Initialize registers the UnitOfWork implementation in the dependency container.
+// Initialize registers the UnitOfWork implementation in the dependency container, also as the Sandbox.
 func (iuw InitUnitOfWork) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[transaction.UnitOfWork](NewUnitOfWork(iuw.DB))
+	uow := NewUnitOfWork(iuw.DB)
+	depend.Register[transaction.UnitOfWork](uow)
+	depend.Register[transaction.Sandbox](uow)
 	return ctx, nil
 }
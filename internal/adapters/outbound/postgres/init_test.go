@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
@@ -29,6 +31,174 @@ func TestInitBoardSummaryRepository_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestInitTimeEntryRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitTimeEntryRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.TimeEntryRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitFocusBlockRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitFocusBlockRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.FocusBlockRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitProjectRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitProjectRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.ProjectRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitCustomFieldRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitCustomFieldRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.CustomFieldRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitCommentRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitCommentRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.CommentRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitViewRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitViewRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.ViewRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitTemplateRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitTemplateRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.TemplateRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitBoardSnapshotRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitBoardSnapshotRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.BoardSnapshotRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitWeeklyReviewRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitWeeklyReviewRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.WeeklyReviewRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitEmbeddingBackfillRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitEmbeddingBackfillRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.EmbeddingBackfillRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitChangeRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitChangeRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[todo.ChangeRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitConversationChangeRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitConversationChangeRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ConversationChangeRepository]()
+	assert.NoError(t, err)
+}
+
 func TestInitChatMessageRepository_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -57,6 +227,188 @@ func TestInitConversationSummaryRepository_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestInitChatMessagePartitionRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitChatMessagePartitionRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ChatMessagePartitionRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitContentBlobRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitContentBlobRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ContentBlobRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitConversationSnapshotRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitConversationSnapshotRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ConversationSnapshotRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitInstructionRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitInstructionRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.InstructionRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitMemoryRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitMemoryRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.MemoryRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitConversationTopicRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitConversationTopicRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ConversationTopicRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitShadowEvaluationRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitShadowEvaluationRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ShadowEvaluationRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitExperimentRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitExperimentRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ExperimentRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitChannelLinkRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitChannelLinkRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ChannelLinkRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitSessionRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitSessionRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[access.SessionRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitTokenRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitTokenRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[access.TokenRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitUserRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitUserRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[access.UserRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitAuditRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitAuditRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[audit.Repository]()
+	assert.NoError(t, err)
+}
+
 func TestInitConversationRepository_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -71,6 +423,34 @@ func TestInitConversationRepository_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestInitConversationReadModelRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitConversationReadModelRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ConversationReadModelRepository]()
+	assert.NoError(t, err)
+}
+
+func TestInitConversationReadCursorRepository_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := &InitConversationReadCursorRepository{
+		DB: &sql.DB{},
+	}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	_, err = depend.Resolve[assistant.ConversationReadCursorRepository]()
+	assert.NoError(t, err)
+}
+
 func TestInitLocker_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -194,4 +574,6 @@ func TestInitUnitOfWork_Initialize(t *testing.T) {
 	_, err = depend.Resolve[transaction.UnitOfWork]()
 	assert.NoError(t, err)
 
+	_, err = depend.Resolve[transaction.Sandbox]()
+	assert.NoError(t, err)
 }
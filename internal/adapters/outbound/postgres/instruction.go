@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var instructionFields = []string{
+	"id",
+	"conversation_id",
+	"text",
+	"created_at",
+}
+
+// InstructionRepository implements the assistant.InstructionRepository interface using PostgreSQL as the storage backend.
+type InstructionRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewInstructionRepository creates a new instance of InstructionRepository.
+func NewInstructionRepository(br sq.BaseRunner) InstructionRepository {
+	return InstructionRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateInstruction stores a new instruction.
+func (r InstructionRepository) CreateInstruction(ctx context.Context, instruction assistant.Instruction) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("pinned_instructions").
+		Columns(instructionFields...).
+		Columns(tenantColumn).
+		Values(
+			instruction.ID,
+			instruction.ConversationID,
+			instruction.Text,
+			instruction.CreatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteInstruction deletes an instruction by its ID.
+func (r InstructionRepository) DeleteInstruction(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("pinned_instructions").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetInstruction retrieves an instruction by its ID.
+func (r InstructionRepository) GetInstruction(ctx context.Context, id uuid.UUID) (assistant.Instruction, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	instruction, err := scanInstruction(r.sb.
+		Select(instructionFields...).
+		From("pinned_instructions").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return assistant.Instruction{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.Instruction{}, false, err
+	}
+
+	return instruction, true, nil
+}
+
+// ListInstructions lists the global instructions followed by the ones pinned to the conversation, oldest
+// first. A nil conversation ID lists only the global instructions.
+func (r InstructionRepository) ListInstructions(ctx context.Context, conversationID uuid.UUID) ([]assistant.Instruction, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var scope sq.Sqlizer = sq.Eq{"conversation_id": nil}
+	if conversationID != uuid.Nil {
+		scope = sq.Or{sq.Eq{"conversation_id": nil}, sq.Eq{"conversation_id": conversationID}}
+	}
+
+	rows, err := r.sb.
+		Select(instructionFields...).
+		From("pinned_instructions").
+		Where(scope).
+		Where(tenantEq(ctx)).
+		OrderBy("conversation_id IS NOT NULL", "created_at", "id").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	instructions := []assistant.Instruction{}
+	for rows.Next() {
+		instruction, err := scanInstruction(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		instructions = append(instructions, instruction)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return instructions, nil
+}
+
+// scanInstruction reads an instruction row.
+func scanInstruction(row sq.RowScanner) (assistant.Instruction, error) {
+	var (
+		instruction    assistant.Instruction
+		conversationID uuid.NullUUID
+	)
+	if err := row.Scan(
+		&instruction.ID,
+		&conversationID,
+		&instruction.Text,
+		&instruction.CreatedAt,
+	); err != nil {
+		return assistant.Instruction{}, err
+	}
+	if conversationID.Valid {
+		instruction.ConversationID = &conversationID.UUID
+	}
+	return instruction, nil
+}
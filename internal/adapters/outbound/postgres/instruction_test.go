@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstructionRepository_CreateInstruction(t *testing.T) {
+	t.Parallel()
+
+	instruction := assistant.Instruction{
+		ID:             uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		ConversationID: common.Ptr(uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")),
+		Text:           "Always use DD/MM dates",
+		CreatedAt:      time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	query := "INSERT INTO pinned_instructions (id,conversation_id,text,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5)"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(instruction.ID, instruction.ConversationID, instruction.Text, instruction.CreatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(instruction.ID, instruction.ConversationID, instruction.Text, instruction.CreatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewInstructionRepository(db)
+			gotErr := repo.CreateInstruction(t.Context(), instruction)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInstructionRepository_DeleteInstruction(t *testing.T) {
+	t.Parallel()
+
+	instructionID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	query := "DELETE FROM pinned_instructions WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(instructionID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(instructionID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewInstructionRepository(db)
+			gotErr := repo.DeleteInstruction(t.Context(), instructionID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInstructionRepository_GetInstruction(t *testing.T) {
+	t.Parallel()
+
+	instructionID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, conversation_id, text, created_at FROM pinned_instructions WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     assistant.Instruction
+		expectedFind bool
+		expectErr    bool
+	}{
+		"global": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(instructionFields).AddRow(instructionID, nil, "Always use DD/MM dates", createdAt)
+				m.ExpectQuery(query).WithArgs(instructionID, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     assistant.Instruction{ID: instructionID, Text: "Always use DD/MM dates", CreatedAt: createdAt},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(instructionID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(instructionID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewInstructionRepository(db)
+			got, found, err := repo.GetInstruction(t.Context(), instructionID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInstructionRepository_ListInstructions(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	globalID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	pinnedID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	conversationQuery := "SELECT id, conversation_id, text, created_at FROM pinned_instructions " +
+		"WHERE (conversation_id IS NULL OR conversation_id = $1) AND tenant_id = $2 " +
+		"ORDER BY conversation_id IS NOT NULL, created_at, id"
+	globalQuery := "SELECT id, conversation_id, text, created_at FROM pinned_instructions " +
+		"WHERE conversation_id IS NULL AND tenant_id = $1 " +
+		"ORDER BY conversation_id IS NOT NULL, created_at, id"
+
+	tests := map[string]struct {
+		conversationID uuid.UUID
+		expect         func(sqlmock.Sqlmock)
+		expected       []assistant.Instruction
+		expectErr      bool
+	}{
+		"conversation": {
+			conversationID: conversationID,
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(instructionFields).
+					AddRow(globalID, nil, "Always use DD/MM dates", createdAt).
+					AddRow(pinnedID, conversationID, "Answer in Portuguese", createdAt)
+				m.ExpectQuery(conversationQuery).WithArgs(conversationID, tenant.Default).WillReturnRows(rows)
+			},
+			expected: []assistant.Instruction{
+				{ID: globalID, Text: "Always use DD/MM dates", CreatedAt: createdAt},
+				{ID: pinnedID, ConversationID: &conversationID, Text: "Answer in Portuguese", CreatedAt: createdAt},
+			},
+		},
+		"global-only": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(globalQuery).WithArgs(tenant.Default).WillReturnRows(sqlmock.NewRows(instructionFields))
+			},
+			expected: []assistant.Instruction{},
+		},
+		"database-error": {
+			conversationID: conversationID,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(conversationQuery).WithArgs(conversationID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewInstructionRepository(db)
+			got, err := repo.ListInstructions(t.Context(), tt.conversationID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+var memoryFields = []string{
+	"id",
+	"kind",
+	"text",
+	"source_conversation_id",
+	"created_at",
+}
+
+// MemoryRepository implements the assistant.MemoryRepository interface using PostgreSQL as the storage backend.
+type MemoryRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewMemoryRepository creates a new instance of MemoryRepository.
+func NewMemoryRepository(br sq.BaseRunner) MemoryRepository {
+	return MemoryRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateMemory stores a new memory.
+func (r MemoryRepository) CreateMemory(ctx context.Context, memory assistant.Memory) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("user_memories").
+		Columns(memoryFields...).
+		Columns("embedding", tenantColumn).
+		Values(
+			memory.ID,
+			memory.Kind,
+			memory.Text,
+			memory.SourceConversationID,
+			memory.CreatedAt,
+			pgvector.NewVector(toFloat32Truncated(memory.Embedding)),
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMemory deletes a memory by its ID.
+func (r MemoryRepository) DeleteMemory(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("user_memories").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetMemory retrieves a memory by its ID, without its embedding.
+func (r MemoryRepository) GetMemory(ctx context.Context, id uuid.UUID) (assistant.Memory, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	memory, err := scanMemory(r.sb.
+		Select(memoryFields...).
+		From("user_memories").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return assistant.Memory{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.Memory{}, false, err
+	}
+
+	return memory, true, nil
+}
+
+// ListMemories lists the memories, newest first, without their embeddings.
+func (r MemoryRepository) ListMemories(ctx context.Context) ([]assistant.Memory, error) {
+	return r.queryMemories(ctx, r.sb.
+		Select(memoryFields...).
+		From("user_memories").
+		Where(tenantEq(ctx)).
+		OrderBy("created_at DESC", "id"))
+}
+
+// SearchMemories lists up to limit memories whose cosine distance to the embedding is below maxDistance,
+// closest first, without their embeddings.
+func (r MemoryRepository) SearchMemories(ctx context.Context, embedding []float64, limit int, maxDistance float64) ([]assistant.Memory, error) {
+	vector := pgvector.NewVector(toFloat32Truncated(embedding))
+	return r.queryMemories(ctx, r.sb.
+		Select(memoryFields...).
+		From("user_memories").
+		Where(tenantEq(ctx)).
+		Where(sq.Expr("(embedding <=> ?) < ?", vector, maxDistance)).
+		OrderByClause("embedding <=> ?", vector).
+		Limit(uint64(limit)))
+}
+
+// queryMemories runs a memory query and scans its rows.
+func (r MemoryRepository) queryMemories(ctx context.Context, qry sq.SelectBuilder) ([]assistant.Memory, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := qry.QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	memories := []assistant.Memory{}
+	for rows.Next() {
+		memory, err := scanMemory(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		memories = append(memories, memory)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return memories, nil
+}
+
+// GetMemoryExtractionCursor returns the creation time of the last message mined from the conversation.
+func (r MemoryRepository) GetMemoryExtractionCursor(ctx context.Context, conversationID uuid.UUID) (time.Time, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var extractedThrough time.Time
+	err := r.sb.
+		Select("extracted_through").
+		From("memory_extraction_cursors").
+		Where(sq.Eq{"conversation_id": conversationID}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx).
+		Scan(&extractedThrough)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return time.Time{}, false, err
+	}
+
+	return extractedThrough, true, nil
+}
+
+// SaveMemoryExtractionCursor records the creation time of the last message mined from the conversation.
+func (r MemoryRepository) SaveMemoryExtractionCursor(ctx context.Context, conversationID uuid.UUID, extractedThrough time.Time) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("memory_extraction_cursors").
+		Columns("conversation_id", "extracted_through", tenantColumn).
+		Values(conversationID, extractedThrough, tenantOf(ctx)).
+		Suffix("ON CONFLICT (conversation_id) DO UPDATE SET extracted_through = EXCLUDED.extracted_through").
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// scanMemory reads a memory row.
+func scanMemory(row sq.RowScanner) (assistant.Memory, error) {
+	var (
+		memory               assistant.Memory
+		sourceConversationID uuid.NullUUID
+	)
+	if err := row.Scan(
+		&memory.ID,
+		&memory.Kind,
+		&memory.Text,
+		&sourceConversationID,
+		&memory.CreatedAt,
+	); err != nil {
+		return assistant.Memory{}, err
+	}
+	if sourceConversationID.Valid {
+		memory.SourceConversationID = &sourceConversationID.UUID
+	}
+	return memory, nil
+}
@@ -0,0 +1,335 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryRepository_CreateMemory(t *testing.T) {
+	t.Parallel()
+
+	memory := assistant.Memory{
+		ID:                   uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Kind:                 assistant.MemoryKind_Preference,
+		Text:                 "Prefers morning workouts",
+		SourceConversationID: common.Ptr(uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")),
+		Embedding:            []float64{0.1, 0.2},
+		CreatedAt:            time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	query := "INSERT INTO user_memories (id,kind,text,source_conversation_id,created_at,embedding,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+	args := []driver.Value{
+		memory.ID,
+		memory.Kind,
+		memory.Text,
+		memory.SourceConversationID,
+		memory.CreatedAt,
+		pgvector.NewVector(toFloat32Truncated(memory.Embedding)),
+		tenant.Default,
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewMemoryRepository(db)
+			gotErr := repo.CreateMemory(t.Context(), memory)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMemoryRepository_DeleteMemory(t *testing.T) {
+	t.Parallel()
+
+	memoryID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	query := "DELETE FROM user_memories WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(memoryID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(memoryID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewMemoryRepository(db)
+			gotErr := repo.DeleteMemory(t.Context(), memoryID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMemoryRepository_GetMemory(t *testing.T) {
+	t.Parallel()
+
+	memoryID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, kind, text, source_conversation_id, created_at FROM user_memories WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     assistant.Memory
+		expectedFind bool
+		expectErr    bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(memoryFields).AddRow(memoryID, "fact", "Has a dog named Rex", nil, createdAt)
+				m.ExpectQuery(query).WithArgs(memoryID, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     assistant.Memory{ID: memoryID, Kind: assistant.MemoryKind_Fact, Text: "Has a dog named Rex", CreatedAt: createdAt},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(memoryID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(memoryID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewMemoryRepository(db)
+			got, found, err := repo.GetMemory(t.Context(), memoryID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMemoryRepository_ListMemories(t *testing.T) {
+	t.Parallel()
+
+	memoryID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, kind, text, source_conversation_id, created_at FROM user_memories WHERE tenant_id = $1 ORDER BY created_at DESC, id"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []assistant.Memory
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(memoryFields).AddRow(memoryID, "preference", "Prefers morning workouts", conversationID, createdAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expected: []assistant.Memory{
+				{ID: memoryID, Kind: assistant.MemoryKind_Preference, Text: "Prefers morning workouts", SourceConversationID: &conversationID, CreatedAt: createdAt},
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewMemoryRepository(db)
+			got, err := repo.ListMemories(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMemoryRepository_SearchMemories(t *testing.T) {
+	t.Parallel()
+
+	memoryID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	embedding := []float64{0.1, 0.2}
+	vector := pgvector.NewVector(toFloat32Truncated(embedding))
+	query := "SELECT id, kind, text, source_conversation_id, created_at FROM user_memories " +
+		"WHERE tenant_id = $1 AND (embedding <=> $2) < $3 ORDER BY embedding <=> $4 LIMIT 5"
+	args := []driver.Value{tenant.Default, vector, 0.5, vector}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []assistant.Memory
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(memoryFields).AddRow(memoryID, "fact", "Has a dog named Rex", nil, createdAt)
+				m.ExpectQuery(query).WithArgs(args...).WillReturnRows(rows)
+			},
+			expected: []assistant.Memory{
+				{ID: memoryID, Kind: assistant.MemoryKind_Fact, Text: "Has a dog named Rex", CreatedAt: createdAt},
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewMemoryRepository(db)
+			got, err := repo.SearchMemories(t.Context(), embedding, 5, 0.5)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMemoryRepository_MemoryExtractionCursor(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	extractedThrough := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	getQuery := "SELECT extracted_through FROM memory_extraction_cursors WHERE conversation_id = $1 AND tenant_id = $2"
+	saveQuery := "INSERT INTO memory_extraction_cursors (conversation_id,extracted_through,tenant_id) VALUES ($1,$2,$3) " +
+		"ON CONFLICT (conversation_id) DO UPDATE SET extracted_through = EXCLUDED.extracted_through"
+
+	t.Run("get-found", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		assert.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(getQuery).WithArgs(conversationID, tenant.Default).
+			WillReturnRows(sqlmock.NewRows([]string{"extracted_through"}).AddRow(extractedThrough))
+
+		got, found, err := NewMemoryRepository(db).GetMemoryExtractionCursor(t.Context(), conversationID)
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, extractedThrough, got)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("get-not-found", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		assert.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectQuery(getQuery).WithArgs(conversationID, tenant.Default).WillReturnError(sql.ErrNoRows)
+
+		_, found, err := NewMemoryRepository(db).GetMemoryExtractionCursor(t.Context(), conversationID)
+		assert.NoError(t, err)
+		assert.False(t, found)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("save", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		assert.NoError(t, err)
+		defer db.Close() //nolint:errcheck
+
+		mock.ExpectExec(saveQuery).WithArgs(conversationID, extractedThrough, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = NewMemoryRepository(db).SaveMemoryExtractionCursor(t.Context(), conversationID, extractedThrough)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,11 @@
+CREATE TABLE todo_time_entries (
+    id UUID PRIMARY KEY,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_todo_time_entries_todo_id ON todo_time_entries(todo_id);
+CREATE INDEX IF NOT EXISTS idx_todo_time_entries_started_at ON todo_time_entries(started_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todo_time_entries_running_unique ON todo_time_entries(todo_id) WHERE ended_at IS NULL;
@@ -0,0 +1,10 @@
+CREATE TABLE todo_comments (
+    id UUID PRIMARY KEY,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    author TEXT NOT NULL CHECK (author IN ('user', 'assistant')),
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_todo_comments_todo_id_created_at ON todo_comments(todo_id, created_at DESC);
@@ -0,0 +1,12 @@
+CREATE TABLE todo_changes (
+    sequence BIGSERIAL PRIMARY KEY,
+    todo_id UUID NOT NULL,
+    change_type TEXT NOT NULL CHECK (change_type IN ('CREATED', 'UPDATED', 'DELETED')),
+    conversation_id UUID,
+    conversation_sequence BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todo_changes_conversation_sequence
+    ON todo_changes(conversation_id, conversation_sequence)
+    WHERE conversation_id IS NOT NULL;
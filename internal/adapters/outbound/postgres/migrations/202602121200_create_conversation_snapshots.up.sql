@@ -0,0 +1,12 @@
+CREATE TABLE conversation_snapshots (
+    id UUID PRIMARY KEY,
+    conversation_id UUID NOT NULL,
+    summary TEXT NOT NULL,
+    pinned_todos JSONB NOT NULL DEFAULT '[]',
+    open_loops JSONB NOT NULL DEFAULT '[]',
+    last_message_id UUID NOT NULL,
+    change_sequence BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_conversation_snapshots_convo_created_at ON conversation_snapshots(conversation_id, created_at DESC);
@@ -0,0 +1,9 @@
+CREATE TABLE todo_views (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    filter JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todo_views_lower_name ON todo_views(lower(name));
@@ -0,0 +1,6 @@
+CREATE TABLE conversation_changes (
+    sequence BIGSERIAL PRIMARY KEY,
+    conversation_id UUID NOT NULL,
+    change_type TEXT NOT NULL CHECK (change_type IN ('CREATED', 'UPDATED', 'DELETED')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
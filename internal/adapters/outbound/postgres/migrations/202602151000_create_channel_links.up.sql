@@ -0,0 +1,8 @@
+CREATE TABLE channel_links (
+    channel TEXT NOT NULL,
+    external_chat_id TEXT NOT NULL,
+    conversation_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (channel, external_chat_id)
+);
@@ -0,0 +1,30 @@
+ALTER TABLE todos ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE board_summary ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE outbox_events ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE conversations ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE chat_messages ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE conversations_summary ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE todo_time_entries ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE todo_comments ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE todo_changes ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE conversation_snapshots ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE todo_views ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE conversation_changes ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE channel_links ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+-- Keys that were unique per deployment become unique per tenant.
+ALTER TABLE board_summary DROP CONSTRAINT board_summary_pkey;
+ALTER TABLE board_summary ADD PRIMARY KEY (tenant_id, id);
+
+DROP INDEX IF EXISTS idx_todo_views_lower_name;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todo_views_tenant_lower_name ON todo_views(tenant_id, lower(name));
+
+ALTER TABLE channel_links DROP CONSTRAINT channel_links_pkey;
+ALTER TABLE channel_links ADD PRIMARY KEY (tenant_id, channel, external_chat_id);
+
+CREATE INDEX IF NOT EXISTS idx_todos_tenant_status_due_date ON todos(tenant_id, status, due_date);
+CREATE INDEX IF NOT EXISTS idx_todos_tenant_due_date ON todos(tenant_id, due_date);
+CREATE INDEX IF NOT EXISTS idx_conversations_tenant_last_message_at ON conversations(tenant_id, last_message_at);
+CREATE INDEX IF NOT EXISTS idx_todo_changes_tenant_sequence ON todo_changes(tenant_id, sequence);
+CREATE INDEX IF NOT EXISTS idx_conversation_changes_tenant_sequence ON conversation_changes(tenant_id, sequence);
+CREATE INDEX IF NOT EXISTS idx_todo_time_entries_tenant_started_at ON todo_time_entries(tenant_id, started_at);
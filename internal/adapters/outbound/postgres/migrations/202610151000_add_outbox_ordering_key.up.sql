@@ -0,0 +1,3 @@
+-- Events sharing an ordering key are published in order, e.g. the events of one conversation.
+ALTER TABLE outbox_events ADD COLUMN ordering_key TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_ordering_key ON outbox_events(ordering_key, created_at) WHERE status = 'PENDING' AND ordering_key <> '';
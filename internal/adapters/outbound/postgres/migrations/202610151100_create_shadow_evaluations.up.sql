@@ -0,0 +1,21 @@
+-- Interactive turns replayed against a candidate model, stored side by side for offline comparison.
+CREATE TABLE shadow_evaluations (
+    id UUID PRIMARY KEY,
+    conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    turn_id UUID NOT NULL,
+    primary_model TEXT NOT NULL,
+    primary_content TEXT NOT NULL,
+    primary_actions JSONB NOT NULL DEFAULT '[]',
+    candidate_model TEXT NOT NULL,
+    candidate_content TEXT NOT NULL,
+    candidate_actions JSONB NOT NULL DEFAULT '[]',
+    candidate_error TEXT,
+    candidate_prompt_tokens INTEGER NOT NULL DEFAULT 0,
+    candidate_completion_tokens INTEGER NOT NULL DEFAULT 0,
+    candidate_total_tokens INTEGER NOT NULL DEFAULT 0,
+    candidate_latency_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_shadow_evaluations_tenant_candidate_created_at ON shadow_evaluations(tenant_id, candidate_model, created_at);
@@ -0,0 +1,5 @@
+-- Experiment variant, action loop flag and user feedback of chat messages, aggregated per variant.
+ALTER TABLE chat_messages ADD COLUMN experiment_variant JSONB;
+ALTER TABLE chat_messages ADD COLUMN action_loop_detected BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE chat_messages ADD COLUMN feedback_score SMALLINT;
+CREATE INDEX IF NOT EXISTS idx_chat_messages_tenant_experiment ON chat_messages(tenant_id, (experiment_variant->>'experiment')) WHERE experiment_variant IS NOT NULL;
@@ -0,0 +1,17 @@
+-- Device logins of API principals. Tokens are stored as SHA-256 hashes.
+CREATE TABLE sessions (
+    id UUID PRIMARY KEY,
+    principal TEXT NOT NULL,
+    role TEXT NOT NULL,
+    device TEXT NOT NULL DEFAULT '',
+    access_token_hash TEXT NOT NULL UNIQUE,
+    access_expires_at TIMESTAMPTZ NOT NULL,
+    refresh_token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMPTZ,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_tenant_principal ON sessions(tenant_id, principal, last_used_at DESC);
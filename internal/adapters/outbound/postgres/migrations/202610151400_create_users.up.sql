@@ -0,0 +1,15 @@
+-- Users signed in through an OpenID Connect provider, identified by the issuer and subject of the provider.
+CREATE TABLE users (
+    id UUID PRIMARY KEY,
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT NOT NULL DEFAULT '',
+    name TEXT NOT NULL DEFAULT '',
+    role TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_login_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default',
+    UNIQUE (tenant_id, issuer, subject)
+);
+
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS user_id UUID REFERENCES users(id) ON DELETE CASCADE;
@@ -0,0 +1,29 @@
+-- Append-only record of the mutating API calls and assistant actions. Rows are only removed by the
+-- retention policy, so updates are rejected.
+CREATE TABLE audit_log (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    principal TEXT NOT NULL,
+    role TEXT NOT NULL DEFAULT '',
+    source TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    payload_hash TEXT NOT NULL,
+    result TEXT NOT NULL,
+    succeeded BOOLEAN NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_tenant_seq ON audit_log(tenant_id, seq);
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log(occurred_at);
+
+CREATE OR REPLACE FUNCTION reject_audit_log_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_log_update();
@@ -0,0 +1,4 @@
+-- Optional per-conversation generation settings; NULL and empty values fall back to the chat defaults.
+ALTER TABLE conversations ADD COLUMN temperature DOUBLE PRECISION;
+ALTER TABLE conversations ADD COLUMN top_p DOUBLE PRECISION;
+ALTER TABLE conversations ADD COLUMN model TEXT NOT NULL DEFAULT '';
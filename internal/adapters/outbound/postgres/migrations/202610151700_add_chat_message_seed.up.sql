@@ -0,0 +1,2 @@
+-- Sampling seed of assistant messages generated with one, so their turns can be replayed.
+ALTER TABLE chat_messages ADD COLUMN seed BIGINT;
@@ -0,0 +1,2 @@
+-- Assistant and tool messages replaced by a regenerated response of their turn.
+ALTER TABLE chat_messages ADD COLUMN superseded_at TIMESTAMPTZ;
@@ -0,0 +1,11 @@
+-- Shared board snapshots are immutable: the todos and board summary are copied into the document
+-- and the Markdown rendering is stored as it was when the snapshot was taken.
+CREATE TABLE board_snapshots (
+    id UUID PRIMARY KEY,
+    token TEXT NOT NULL UNIQUE,
+    title TEXT NOT NULL,
+    document JSONB NOT NULL,
+    markdown TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
@@ -0,0 +1,16 @@
+-- Weekly reviews point at the conversation holding the assistant report; deleting the conversation
+-- removes the review. One review is stored per tenant and week.
+CREATE TABLE weekly_reviews (
+    id UUID PRIMARY KEY,
+    conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    planned INTEGER NOT NULL,
+    completed JSONB NOT NULL,
+    slipped JSONB NOT NULL,
+    report TEXT NOT NULL,
+    model TEXT NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default',
+    UNIQUE (tenant_id, period_start)
+);
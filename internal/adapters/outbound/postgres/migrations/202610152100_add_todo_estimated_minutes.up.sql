@@ -0,0 +1,2 @@
+-- Estimated effort of each todo in minutes. Zero means the todo has no estimate.
+ALTER TABLE todos ADD COLUMN estimated_minutes INTEGER NOT NULL DEFAULT 0 CHECK (estimated_minutes >= 0);
@@ -0,0 +1,13 @@
+-- Focus blocks are the calendar holds the user accepted from the focus block suggestions.
+-- Suggestions are computed on demand and never stored.
+CREATE TABLE focus_blocks (
+    id UUID PRIMARY KEY,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL CHECK (ends_at > starts_at),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_focus_blocks_tenant_starts_at ON focus_blocks(tenant_id, starts_at);
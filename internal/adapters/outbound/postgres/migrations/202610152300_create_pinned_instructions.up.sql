@@ -0,0 +1,11 @@
+-- Pinned instructions are standing user instructions added to the chat system prompt.
+-- Instructions without a conversation are global and apply to every conversation.
+CREATE TABLE pinned_instructions (
+    id UUID PRIMARY KEY,
+    conversation_id UUID REFERENCES conversations(id) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_pinned_instructions_tenant_conversation ON pinned_instructions(tenant_id, conversation_id, created_at);
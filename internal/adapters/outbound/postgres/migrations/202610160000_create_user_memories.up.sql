@@ -0,0 +1,21 @@
+-- User memories are durable facts and preferences extracted from completed conversations.
+-- They outlive the conversation they were extracted from and are recalled by embedding similarity.
+CREATE TABLE user_memories (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    text TEXT NOT NULL,
+    source_conversation_id UUID REFERENCES conversations(id) ON DELETE SET NULL,
+    embedding VECTOR(768) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_memories_tenant_created ON user_memories(tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_user_memories_embedding ON user_memories USING hnsw (embedding vector_cosine_ops) WITH (m = 24, ef_construction = 128);
+
+-- Memory extraction cursors record the last message mined from each conversation.
+CREATE TABLE memory_extraction_cursors (
+    conversation_id UUID PRIMARY KEY REFERENCES conversations(id) ON DELETE CASCADE,
+    extracted_through TIMESTAMPTZ NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
@@ -0,0 +1,10 @@
+-- Conversation topics index the compacted summary of each conversation by its embedding,
+-- so conversations about the same topic can be found across the conversation list.
+CREATE TABLE conversation_topics (
+    conversation_id UUID PRIMARY KEY REFERENCES conversations(id) ON DELETE CASCADE,
+    embedding VECTOR(768) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_conversation_topics_embedding ON conversation_topics USING hnsw (embedding vector_cosine_ops) WITH (m = 24, ef_construction = 128);
@@ -0,0 +1,30 @@
+-- Projects group related todos. A todo belongs to at most one project; deleting the todo or the project
+-- removes the membership.
+CREATE TABLE projects (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_projects_tenant_created_at ON projects(tenant_id, created_at);
+
+CREATE TABLE project_todos (
+    todo_id UUID PRIMARY KEY REFERENCES todos(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_todos_project_id ON project_todos(project_id);
+
+-- Project suggestions are the clusters of similar ungrouped todos found by the periodic clustering job.
+-- Each run replaces the pending suggestions of the tenant; todos holds the [{id,title}] members.
+CREATE TABLE project_suggestions (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    todos JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_suggestions_tenant ON project_suggestions(tenant_id);
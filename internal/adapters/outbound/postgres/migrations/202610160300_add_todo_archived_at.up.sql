@@ -0,0 +1,5 @@
+-- Time a completed todo was archived by the retention policy. Archived todos are hidden from the default
+-- listings until they are restored, which clears the column.
+ALTER TABLE todos ADD COLUMN archived_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_todos_tenant_status_updated_at ON todos(tenant_id, status, updated_at) WHERE archived_at IS NULL;
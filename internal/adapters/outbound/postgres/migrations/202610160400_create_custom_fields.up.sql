@@ -0,0 +1,15 @@
+-- Custom fields are tenant-defined attributes of todos. options holds the accepted values of ENUM fields
+-- as a JSON array of strings.
+CREATE TABLE custom_fields (
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    options JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default',
+    PRIMARY KEY (tenant_id, name)
+);
+
+-- Values of the custom fields set on each todo, keyed by field name. The GIN index serves the @> filters.
+ALTER TABLE todos ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_todos_custom_fields ON todos USING GIN (custom_fields jsonb_path_ops);
@@ -0,0 +1,17 @@
+-- Model that produced the embedding of each todo. Todos embedded before the column existed have no model
+-- and are picked up once by the embedding backfill.
+ALTER TABLE todos ADD COLUMN embedding_model TEXT;
+
+-- Checkpoints of the embedding backfill runs, one per tenant and embedding model. last_todo_id is the ID of the
+-- last todo processed, so an interrupted run resumes after it.
+CREATE TABLE embedding_backfills (
+    model TEXT NOT NULL,
+    last_todo_id UUID NOT NULL,
+    total INTEGER NOT NULL DEFAULT 0,
+    processed INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    tenant_id TEXT NOT NULL DEFAULT 'default',
+    PRIMARY KEY (tenant_id, model)
+);
@@ -0,0 +1,16 @@
+-- Dimension of each embedding as returned by its model, before it is fitted to the vector column. Searches
+-- check the query embedding against it. Embeddings stored before the column existed have no dimension.
+ALTER TABLE todos ADD COLUMN embedding_dimensions INTEGER;
+
+-- Secondary embedding written with LLM_EMBEDDING_SECONDARY_MODEL while migrating to another embedding model.
+-- Searches compare each todo through the embedding produced by the query model, in either column.
+ALTER TABLE todos ADD COLUMN secondary_embedding VECTOR(768);
+ALTER TABLE todos ADD COLUMN secondary_embedding_model TEXT;
+ALTER TABLE todos ADD COLUMN secondary_embedding_dimensions INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_todos_secondary_embedding ON todos USING hnsw (secondary_embedding vector_cosine_ops) WITH (m = 24, ef_construction = 128);
+CREATE INDEX IF NOT EXISTS idx_todos_tenant_embedding_model ON todos(tenant_id, embedding_model);
+CREATE INDEX IF NOT EXISTS idx_todos_tenant_secondary_embedding_model ON todos(tenant_id, secondary_embedding_model);
+
+-- Embedding backfill runs fill either the primary or the secondary embedding of the todos.
+ALTER TABLE embedding_backfills ADD COLUMN slot TEXT NOT NULL DEFAULT 'primary';
@@ -0,0 +1,12 @@
+-- The progress of every durable subscription, used to report consumer lag.
+CREATE TABLE IF NOT EXISTS outbox_consumer_checkpoints (
+    subscription TEXT PRIMARY KEY,
+    topic TEXT NOT NULL,
+    last_event_at TIMESTAMPTZ,
+    last_error TEXT,
+    last_error_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Supports finding the last event published on each topic.
+CREATE INDEX IF NOT EXISTS idx_outbox_topic_processed ON outbox_events(topic, processed_at DESC) WHERE status = 'PROCESSED';
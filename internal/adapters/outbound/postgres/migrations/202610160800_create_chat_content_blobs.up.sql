@@ -0,0 +1,15 @@
+-- Large action results, stored once per tenant under the SHA-256 hash of their content. A chat message that
+-- carries one keeps a preview in content and the hash in content_ref.
+CREATE TABLE IF NOT EXISTS chat_content_blobs (
+    hash TEXT NOT NULL,
+    content TEXT NOT NULL,
+    size_bytes INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tenant_id TEXT NOT NULL DEFAULT 'default',
+    PRIMARY KEY (tenant_id, hash)
+);
+
+ALTER TABLE chat_messages ADD COLUMN content_ref TEXT;
+
+-- Supports finding the blobs no message references anymore.
+CREATE INDEX IF NOT EXISTS idx_chat_messages_content_ref ON chat_messages(tenant_id, content_ref) WHERE content_ref IS NOT NULL;
@@ -0,0 +1,46 @@
+-- Range partitions chat_messages by the UTC month of created_at, so queries bounded by creation time only
+-- scan the months they need and expired months are dropped whole. A partitioned table only enforces
+-- uniqueness on keys including created_at, so the primary key becomes (id, created_at) and the turn
+-- sequence index is no longer unique.
+ALTER TABLE chat_messages RENAME TO chat_messages_unpartitioned;
+
+CREATE TABLE chat_messages (LIKE chat_messages_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY RANGE (created_at);
+
+-- Partitions named chat_messages_pYYYYMM cover the stored messages through the next month. Later months
+-- are created ahead of time by the chat message partition maintainer.
+DO $$
+DECLARE
+    month_start TIMESTAMP;
+    last_month TIMESTAMP;
+BEGIN
+    SELECT
+        date_trunc('month', COALESCE(min(created_at), now()) AT TIME ZONE 'UTC'),
+        date_trunc('month', GREATEST(max(created_at), now()) AT TIME ZONE 'UTC') + INTERVAL '1 month'
+    INTO month_start, last_month
+    FROM chat_messages_unpartitioned;
+
+    WHILE month_start <= last_month LOOP
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF chat_messages FOR VALUES FROM (%L) TO (%L)',
+            'chat_messages_p' || to_char(month_start, 'YYYYMM'),
+            month_start AT TIME ZONE 'UTC',
+            (month_start + INTERVAL '1 month') AT TIME ZONE 'UTC'
+        );
+        month_start := month_start + INTERVAL '1 month';
+    END LOOP;
+END $$;
+
+INSERT INTO chat_messages SELECT * FROM chat_messages_unpartitioned;
+DROP TABLE chat_messages_unpartitioned;
+
+ALTER TABLE chat_messages ADD PRIMARY KEY (id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_chat_messages_convo_created_at_id ON chat_messages(conversation_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_chat_messages_convo_turn_sequence ON chat_messages(conversation_id, turn_id, turn_sequence);
+CREATE INDEX IF NOT EXISTS idx_chat_messages_convo_id ON chat_messages(conversation_id, id);
+CREATE INDEX IF NOT EXISTS idx_chat_messages_convo_incomplete ON chat_messages(conversation_id, created_at) WHERE message_state <> 'COMPLETED';
+CREATE INDEX IF NOT EXISTS idx_chat_messages_approval_lookup ON chat_messages(conversation_id, turn_id, action_call_id) WHERE action_call_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_chat_messages_approval_status ON chat_messages(conversation_id, approval_status, created_at) WHERE approval_status IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_chat_messages_tenant_experiment ON chat_messages(tenant_id, (experiment_variant->>'experiment')) WHERE experiment_variant IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_chat_messages_content_ref ON chat_messages(tenant_id, content_ref) WHERE content_ref IS NOT NULL;
@@ -0,0 +1,13 @@
+-- Conversation read model: the per-conversation aggregates of the conversation list, projected from the
+-- chat message events so the list no longer joins chat_messages and conversations_summary on every page.
+CREATE TABLE conversation_read_model (
+    conversation_id UUID PRIMARY KEY REFERENCES conversations(id) ON DELETE CASCADE,
+    last_message_at TIMESTAMPTZ,
+    context_tokens_used BIGINT NOT NULL DEFAULT 0,
+    projected_at TIMESTAMPTZ NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+-- Serves the conversation list page in its exact order, with the ID breaking ties so pages never overlap.
+CREATE INDEX IF NOT EXISTS idx_conversations_tenant_list_order
+    ON conversations(tenant_id, last_message_at DESC NULLS LAST, updated_at DESC, created_at DESC, id DESC);
@@ -0,0 +1,11 @@
+-- Read cursors: the last message each reader marked read on a conversation, so the conversation list can
+-- report the messages added since, such as the ones background work posts while the reader is elsewhere.
+CREATE TABLE conversation_read_cursors (
+    conversation_id UUID NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    reader TEXT NOT NULL,
+    last_read_message_id UUID NOT NULL,
+    last_read_message_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT 'default',
+    PRIMARY KEY (conversation_id, reader)
+);
@@ -0,0 +1,12 @@
+-- Templates are named lists of todos created together, e.g. "Trip packing". items holds the
+-- [{title,due_offset_days,estimated_minutes}] todos, with due dates as day offsets from the anchor date.
+CREATE TABLE todo_templates (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    items JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_todo_templates_tenant_lower_name ON todo_templates(tenant_id, lower(name));
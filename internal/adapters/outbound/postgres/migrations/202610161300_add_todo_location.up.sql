@@ -0,0 +1,7 @@
+-- Optional place where a todo is done. location_name is free text, e.g. "Downtown pharmacy"; the coordinates
+-- are set together and let searches keep the todos within a radius of a point.
+ALTER TABLE todos ADD COLUMN location_name TEXT;
+ALTER TABLE todos ADD COLUMN location_lat DOUBLE PRECISION;
+ALTER TABLE todos ADD COLUMN location_lon DOUBLE PRECISION;
+
+CREATE INDEX IF NOT EXISTS idx_todos_tenant_location ON todos(tenant_id, location_lat, location_lon) WHERE location_lat IS NOT NULL;
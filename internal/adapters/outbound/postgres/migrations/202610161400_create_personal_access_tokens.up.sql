@@ -0,0 +1,16 @@
+-- Personal access tokens for automation, limited to their scopes. Tokens are stored as SHA-256 hashes.
+CREATE TABLE personal_access_tokens (
+    id UUID PRIMARY KEY,
+    principal TEXT NOT NULL,
+    role TEXT NOT NULL,
+    user_id UUID,
+    name TEXT NOT NULL,
+    scopes TEXT[] NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    tenant_id TEXT NOT NULL DEFAULT 'default'
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_tenant_principal ON personal_access_tokens(tenant_id, principal, created_at DESC);
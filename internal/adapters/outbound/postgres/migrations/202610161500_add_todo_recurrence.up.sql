@@ -0,0 +1,3 @@
+-- Optional rule a todo repeats by, stored as an RRULE such as "FREQ=WEEKLY;BYDAY=MO". Completing a recurring
+-- todo creates its next occurrence, which takes the rule over.
+ALTER TABLE todos ADD COLUMN recurrence_rule TEXT;
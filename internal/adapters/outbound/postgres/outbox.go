@@ -2,12 +2,15 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/google/uuid"
 )
@@ -28,9 +31,17 @@ var (
 		"available_at",
 		"processed_at",
 		"created_at",
+		"tenant_id",
+		"ordering_key",
 	}
 )
 
+// orderingKeyBackoffClause holds back events queued behind an older event with the same ordering key
+// that is waiting for a retry.
+const orderingKeyBackoffClause = "NOT EXISTS (SELECT 1 FROM outbox_events earlier " +
+	"WHERE earlier.ordering_key = outbox_events.ordering_key AND earlier.ordering_key <> '' " +
+	"AND earlier.status = ? AND earlier.created_at < outbox_events.created_at AND earlier.available_at > ?)"
+
 // Repository implements the event.Repository interface for Postgres.
 type Repository struct {
 	sb squirrel.StatementBuilderType
@@ -52,6 +63,7 @@ func (op Repository) CreateTodoEvent(ctx context.Context, event outbox.TodoEvent
 	if createdAt.IsZero() {
 		createdAt = time.Now().UTC()
 	}
+	event.TenantID = tenant.IDFromContext(ctx)
 
 	// Marshal the content to JSON
 	contentJSON, err := json.Marshal(event)
@@ -85,6 +97,8 @@ func (op Repository) CreateTodoEvent(ctx context.Context, event outbox.TodoEvent
 			createdAt,
 			nil,
 			createdAt,
+			tenantOf(ctx),
+			event.OrderingKey(),
 		).
 		Suffix("ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 		ExecContext(spanCtx)
@@ -108,11 +122,16 @@ func (op Repository) CreateChatEvent(ctx context.Context, event outbox.ChatMessa
 		return fmt.Errorf("failed to marshal chat event content: %w", err)
 	}
 
-	dedupeKey := fmt.Sprintf(
-		"chat:%s:%s",
-		event.Type,
-		event.ChatMessageID.String(),
-	)
+	// Reprocessed events re-emit an already published message, so they are not deduplicated.
+	var dedupeKey *string
+	if !event.Reprocessed {
+		key := fmt.Sprintf(
+			"chat:%s:%s",
+			event.Type,
+			event.ChatMessageID.String(),
+		)
+		dedupeKey = &key
+	}
 
 	_, err = op.sb.Insert("outbox_events").
 		Columns(
@@ -133,6 +152,8 @@ func (op Repository) CreateChatEvent(ctx context.Context, event outbox.ChatMessa
 			createdAt,
 			nil,
 			createdAt,
+			tenantOf(ctx),
+			event.OrderingKey(),
 		).
 		Suffix("ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 		ExecContext(spanCtx)
@@ -144,14 +165,17 @@ func (op Repository) CreateChatEvent(ctx context.Context, event outbox.ChatMessa
 }
 
 // FetchPendingEvents retrieves a batch of pending outbox events from the database.
+// An event waits while an older event with the same ordering key is backing off after a failed publish.
 func (op Repository) FetchPendingEvents(ctx context.Context, limit int) ([]outbox.Event, error) {
+	now := time.Now().UTC()
 	rows, err := op.sb.
 		Select(
 			outboxEventFields...,
 		).
 		From("outbox_events").
 		Where(squirrel.Eq{"status": string(outbox.Status_Pending)}).
-		Where(squirrel.LtOrEq{"available_at": time.Now().UTC()}).
+		Where(squirrel.LtOrEq{"available_at": now}).
+		Where(squirrel.Expr(orderingKeyBackoffClause, string(outbox.Status_Pending), now)).
 		OrderBy("available_at ASC", "created_at ASC").
 		Limit(uint64(limit)).
 		Suffix("FOR UPDATE SKIP LOCKED").
@@ -182,6 +206,8 @@ func (op Repository) FetchPendingEvents(ctx context.Context, limit int) ([]outbo
 			&oe.AvailableAt,
 			&oe.ProcessedAt,
 			&oe.CreatedAt,
+			&oe.TenantID,
+			&oe.OrderingKey,
 		)
 		if err != nil {
 			return nil, err
@@ -247,3 +273,124 @@ func backoffDelay(retryCount int) time.Duration {
 		return 2 * time.Minute
 	}
 }
+
+// ListBacklogs summarizes the events of every topic across tenants.
+func (op Repository) ListBacklogs(ctx context.Context) ([]outbox.Backlog, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := op.sb.
+		Select("topic").
+		Column(squirrel.Expr("COUNT(*) FILTER (WHERE status = ?)", string(outbox.Status_Pending))).
+		Column(squirrel.Expr("COUNT(*) FILTER (WHERE status = ?)", string(outbox.Status_Failed))).
+		Column(squirrel.Expr("MIN(created_at) FILTER (WHERE status = ?)", string(outbox.Status_Pending))).
+		Column(squirrel.Expr("MAX(processed_at) FILTER (WHERE status = ?)", string(outbox.Status_Processed))).
+		From("outbox_events").
+		GroupBy("topic").
+		OrderBy("topic").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var backlogs []outbox.Backlog
+	for rows.Next() {
+		var b outbox.Backlog
+		if err := rows.Scan(&b.Topic, &b.Pending, &b.Failed, &b.OldestPendingAt, &b.LastPublishedAt); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		backlogs = append(backlogs, b)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return backlogs, nil
+}
+
+// GetLastEventError returns the most recent error of an event still pending or failed. Published events
+// clear their error, so only the errors the relay has not recovered from are reported.
+func (op Repository) GetLastEventError(ctx context.Context) (outbox.EventError, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var e outbox.EventError
+	err := op.sb.
+		Select("id", "topic", "status", "retry_count", "last_error", "available_at").
+		From("outbox_events").
+		Where(squirrel.Eq{"status": []string{string(outbox.Status_Pending), string(outbox.Status_Failed)}}).
+		Where(squirrel.NotEq{"last_error": ""}).
+		OrderBy("available_at DESC").
+		Limit(1).
+		QueryRowContext(spanCtx).
+		Scan(&e.EventID, &e.Topic, &e.Status, &e.RetryCount, &e.Message, &e.AvailableAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return outbox.EventError{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return outbox.EventError{}, false, err
+	}
+
+	return e, true, nil
+}
+
+// SaveConsumerCheckpoint records the progress of a subscription. The recorded progress never moves back,
+// since messages may be handled out of publish order.
+func (op Repository) SaveConsumerCheckpoint(ctx context.Context, checkpoint outbox.ConsumerCheckpoint) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := op.sb.Insert("outbox_consumer_checkpoints").
+		Columns("subscription", "topic", "last_event_at", "last_error", "last_error_at", "updated_at").
+		Values(
+			checkpoint.Subscription,
+			string(checkpoint.Topic),
+			checkpoint.LastEventAt,
+			checkpoint.LastError,
+			checkpoint.LastErrorAt,
+			checkpoint.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (subscription) DO UPDATE SET
+			topic = EXCLUDED.topic,
+			last_event_at = GREATEST(outbox_consumer_checkpoints.last_event_at, EXCLUDED.last_event_at),
+			last_error = COALESCE(EXCLUDED.last_error, outbox_consumer_checkpoints.last_error),
+			last_error_at = COALESCE(EXCLUDED.last_error_at, outbox_consumer_checkpoints.last_error_at),
+			updated_at = EXCLUDED.updated_at`).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to save consumer checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// ListConsumerCheckpoints returns the progress of every subscription.
+func (op Repository) ListConsumerCheckpoints(ctx context.Context) ([]outbox.ConsumerCheckpoint, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := op.sb.
+		Select("subscription", "topic", "last_event_at", "last_error", "last_error_at", "updated_at").
+		From("outbox_consumer_checkpoints").
+		OrderBy("subscription").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var checkpoints []outbox.ConsumerCheckpoint
+	for rows.Next() {
+		var c outbox.ConsumerCheckpoint
+		if err := rows.Scan(&c.Subscription, &c.Topic, &c.LastEventAt, &c.LastError, &c.LastErrorAt, &c.UpdatedAt); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		checkpoints = append(checkpoints, c)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return checkpoints, nil
+}
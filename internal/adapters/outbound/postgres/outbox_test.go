@@ -10,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -30,14 +31,16 @@ func TestOutboxRepository_CreateEvent(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
+				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at,tenant_id,ordering_key) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 					WithArgs(
-						sqlmock.AnyArg(), // id
+						sqlmock.AnyArg(),
+						// id
 						string(outbox.EntityType_Todo),
 						event.TodoID,
 						string(outbox.Topic_Todo),
 						string(event.Type),
-						sqlmock.AnyArg(), // payload json
+						sqlmock.AnyArg(),
+						// payload json
 						string(outbox.Status_Pending),
 						0,
 						5,
@@ -46,6 +49,8 @@ func TestOutboxRepository_CreateEvent(t *testing.T) {
 						event.CreatedAt,
 						nil,
 						event.CreatedAt,
+						tenant.Default,
+						"todo:"+event.TodoID.String(),
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
@@ -53,7 +58,7 @@ func TestOutboxRepository_CreateEvent(t *testing.T) {
 		},
 		"db-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
+				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at,tenant_id,ordering_key) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 					WithArgs(
 						sqlmock.AnyArg(),
 						string(outbox.EntityType_Todo),
@@ -69,6 +74,8 @@ func TestOutboxRepository_CreateEvent(t *testing.T) {
 						event.CreatedAt,
 						nil,
 						event.CreatedAt,
+						tenant.Default,
+						"todo:"+event.TodoID.String(),
 					).
 					WillReturnError(errors.New("db error"))
 			},
@@ -107,19 +114,48 @@ func TestOutboxRepository_CreateChatEvent(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		expect func(sqlmock.Sqlmock)
-		err    bool
+		reprocessed bool
+		expect      func(sqlmock.Sqlmock)
+		err         bool
 	}{
+		"reprocessed-without-dedupe-key": {
+			reprocessed: true,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at,tenant_id,ordering_key) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
+					WithArgs(
+						sqlmock.AnyArg(),
+						string(outbox.EntityType_ChatMessage),
+						event.ChatMessageID,
+						string(outbox.Topic_ChatMessages),
+						string(event.Type),
+						sqlmock.AnyArg(),
+						string(outbox.Status_Pending),
+						0,
+						5,
+						nil,
+						nil,
+						sqlmock.AnyArg(),
+						nil,
+						sqlmock.AnyArg(),
+						tenant.Default,
+						"conversation:"+event.ConversationID.String(),
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			err: false,
+		},
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
+				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at,tenant_id,ordering_key) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 					WithArgs(
-						sqlmock.AnyArg(), // id
+						sqlmock.AnyArg(),
+						// id
 						string(outbox.EntityType_ChatMessage),
 						event.ChatMessageID,
 						string(outbox.Topic_ChatMessages),
 						string(event.Type),
-						sqlmock.AnyArg(), // payload
+						sqlmock.AnyArg(),
+						// payload
 						string(outbox.Status_Pending),
 						0,
 						5,
@@ -128,6 +164,8 @@ func TestOutboxRepository_CreateChatEvent(t *testing.T) {
 						sqlmock.AnyArg(),
 						nil,
 						sqlmock.AnyArg(),
+						tenant.Default,
+						"conversation:"+event.ConversationID.String(),
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
@@ -135,7 +173,7 @@ func TestOutboxRepository_CreateChatEvent(t *testing.T) {
 		},
 		"db-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
+				m.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at,tenant_id,ordering_key) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 					WithArgs(
 						sqlmock.AnyArg(),
 						string(outbox.EntityType_ChatMessage),
@@ -151,6 +189,8 @@ func TestOutboxRepository_CreateChatEvent(t *testing.T) {
 						sqlmock.AnyArg(),
 						nil,
 						sqlmock.AnyArg(),
+						tenant.Default,
+						"conversation:"+event.ConversationID.String(),
 					).
 					WillReturnError(errors.New("db error"))
 			},
@@ -167,7 +207,9 @@ func TestOutboxRepository_CreateChatEvent(t *testing.T) {
 			tt.expect(mock)
 
 			repo := NewOutboxRepository(db)
-			gotErr := repo.CreateChatEvent(t.Context(), event)
+			chatEvent := event
+			chatEvent.Reprocessed = tt.reprocessed
+			gotErr := repo.CreateChatEvent(t.Context(), chatEvent)
 			if tt.err {
 				assert.Error(t, gotErr)
 			} else {
@@ -209,9 +251,11 @@ func TestOutboxRepository_FetchPendingEvents(t *testing.T) {
 						t1,
 						nil,
 						t1,
+						"acme",
+						"todo:"+id1.String(),
 					)
-				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at FROM outbox_events WHERE status = $1 AND available_at <= $2 ORDER BY available_at ASC, created_at ASC LIMIT 2 FOR UPDATE SKIP LOCKED").
-					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg()).
+				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at, tenant_id, ordering_key FROM outbox_events WHERE status = $1 AND available_at <= $2 AND NOT EXISTS (SELECT 1 FROM outbox_events earlier WHERE earlier.ordering_key = outbox_events.ordering_key AND earlier.ordering_key <> '' AND earlier.status = $3 AND earlier.created_at < outbox_events.created_at AND earlier.available_at > $4) ORDER BY available_at ASC, created_at ASC LIMIT 2 FOR UPDATE SKIP LOCKED").
+					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg(), string(outbox.Status_Pending), sqlmock.AnyArg()).
 					WillReturnRows(rows)
 			},
 			wantLen: 1,
@@ -220,8 +264,8 @@ func TestOutboxRepository_FetchPendingEvents(t *testing.T) {
 		"db-error": {
 			limit: 1,
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at FROM outbox_events WHERE status = $1 AND available_at <= $2 ORDER BY available_at ASC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED").
-					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg()).
+				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at, tenant_id, ordering_key FROM outbox_events WHERE status = $1 AND available_at <= $2 AND NOT EXISTS (SELECT 1 FROM outbox_events earlier WHERE earlier.ordering_key = outbox_events.ordering_key AND earlier.ordering_key <> '' AND earlier.status = $3 AND earlier.created_at < outbox_events.created_at AND earlier.available_at > $4) ORDER BY available_at ASC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED").
+					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg(), string(outbox.Status_Pending), sqlmock.AnyArg()).
 					WillReturnError(errors.New("db error"))
 			},
 			wantLen: 0,
@@ -247,9 +291,11 @@ func TestOutboxRepository_FetchPendingEvents(t *testing.T) {
 						t1,
 						nil,
 						t1,
+						"default",
+						"",
 					)
-				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at FROM outbox_events WHERE status = $1 AND available_at <= $2 ORDER BY available_at ASC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED").
-					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg()).
+				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at, tenant_id, ordering_key FROM outbox_events WHERE status = $1 AND available_at <= $2 AND NOT EXISTS (SELECT 1 FROM outbox_events earlier WHERE earlier.ordering_key = outbox_events.ordering_key AND earlier.ordering_key <> '' AND earlier.status = $3 AND earlier.created_at < outbox_events.created_at AND earlier.available_at > $4) ORDER BY available_at ASC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED").
+					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg(), string(outbox.Status_Pending), sqlmock.AnyArg()).
 					WillReturnRows(rows)
 			},
 			wantLen: 0,
@@ -259,8 +305,8 @@ func TestOutboxRepository_FetchPendingEvents(t *testing.T) {
 			limit: 1,
 			expect: func(m sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows(outboxEventFields)
-				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at FROM outbox_events WHERE status = $1 AND available_at <= $2 ORDER BY available_at ASC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED").
-					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg()).
+				m.ExpectQuery("SELECT id, entity_type, entity_id, topic, event_type, payload, status, retry_count, max_retries, last_error, dedupe_key, available_at, processed_at, created_at, tenant_id, ordering_key FROM outbox_events WHERE status = $1 AND available_at <= $2 AND NOT EXISTS (SELECT 1 FROM outbox_events earlier WHERE earlier.ordering_key = outbox_events.ordering_key AND earlier.ordering_key <> '' AND earlier.status = $3 AND earlier.created_at < outbox_events.created_at AND earlier.available_at > $4) ORDER BY available_at ASC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED").
+					WithArgs(string(outbox.Status_Pending), sqlmock.AnyArg(), string(outbox.Status_Pending), sqlmock.AnyArg()).
 					WillReturnRows(rows)
 			},
 			wantLen: 0,
@@ -285,6 +331,7 @@ func TestOutboxRepository_FetchPendingEvents(t *testing.T) {
 				assert.Len(t, got, tt.wantLen)
 				if tt.wantLen > 0 {
 					assert.NotEmpty(t, got[0].Payload)
+					assert.Equal(t, tenant.ID("acme"), got[0].TenantID)
 				}
 			}
 			assert.NoError(t, mock.ExpectationsWereMet())
@@ -401,3 +448,265 @@ func TestOutboxRepository_DeleteEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestOutboxRepository_ListBacklogs(t *testing.T) {
+	t.Parallel()
+
+	oldest := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	published := time.Date(2026, 10, 16, 9, 5, 0, 0, time.UTC)
+	query := "SELECT topic, COUNT(*) FILTER (WHERE status = $1), COUNT(*) FILTER (WHERE status = $2), " +
+		"MIN(created_at) FILTER (WHERE status = $3), MAX(processed_at) FILTER (WHERE status = $4) " +
+		"FROM outbox_events GROUP BY topic ORDER BY topic"
+	args := []driver.Value{
+		string(outbox.Status_Pending),
+		string(outbox.Status_Failed),
+		string(outbox.Status_Pending),
+		string(outbox.Status_Processed),
+	}
+
+	tests := map[string]struct {
+		expect   func(sqlmock.Sqlmock)
+		expected []outbox.Backlog
+		err      bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnRows(sqlmock.NewRows([]string{"topic", "pending", "failed", "oldest", "published"}).
+						AddRow(string(outbox.Topic_ChatMessages), 0, 0, nil, published).
+						AddRow(string(outbox.Topic_Todo), 3, 1, oldest, nil))
+			},
+			expected: []outbox.Backlog{
+				{Topic: outbox.Topic_ChatMessages, LastPublishedAt: &published},
+				{Topic: outbox.Topic_Todo, Pending: 3, Failed: 1, OldestPendingAt: &oldest},
+			},
+		},
+		"db-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() // nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewOutboxRepository(db)
+			got, gotErr := repo.ListBacklogs(t.Context())
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOutboxRepository_GetLastEventError(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	availableAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, topic, status, retry_count, last_error, available_at FROM outbox_events " +
+		"WHERE status IN ($1,$2) AND last_error <> $3 ORDER BY available_at DESC LIMIT 1"
+	args := []driver.Value{string(outbox.Status_Pending), string(outbox.Status_Failed), ""}
+
+	tests := map[string]struct {
+		expect        func(sqlmock.Sqlmock)
+		expected      outbox.EventError
+		expectedFound bool
+		err           bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "topic", "status", "retry_count", "last_error", "available_at"}).
+						AddRow(id, string(outbox.Topic_Todo), string(outbox.Status_Failed), 5, "broker unavailable", availableAt))
+			},
+			expected: outbox.EventError{
+				EventID:     id,
+				Topic:       outbox.Topic_Todo,
+				Status:      outbox.Status_Failed,
+				RetryCount:  5,
+				Message:     "broker unavailable",
+				AvailableAt: availableAt,
+			},
+			expectedFound: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "topic", "status", "retry_count", "last_error", "available_at"}))
+			},
+		},
+		"db-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WithArgs(args...).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() // nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewOutboxRepository(db)
+			got, found, gotErr := repo.GetLastEventError(t.Context())
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expectedFound, found)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOutboxRepository_SaveConsumerCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	eventAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	updatedAt := eventAt.Add(time.Second)
+	query := `INSERT INTO outbox_consumer_checkpoints (subscription,topic,last_event_at,last_error,last_error_at,updated_at) ` +
+		`VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT (subscription) DO UPDATE SET
+			topic = EXCLUDED.topic,
+			last_event_at = GREATEST(outbox_consumer_checkpoints.last_event_at, EXCLUDED.last_event_at),
+			last_error = COALESCE(EXCLUDED.last_error, outbox_consumer_checkpoints.last_error),
+			last_error_at = COALESCE(EXCLUDED.last_error_at, outbox_consumer_checkpoints.last_error_at),
+			updated_at = EXCLUDED.updated_at`
+	checkpoint := outbox.ConsumerCheckpoint{
+		Subscription: "todo-summary",
+		Topic:        outbox.Topic_Todo,
+		LastEventAt:  &eventAt,
+		UpdatedAt:    updatedAt,
+	}
+
+	tests := map[string]struct {
+		expect func(sqlmock.Sqlmock)
+		err    bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs("todo-summary", string(outbox.Topic_Todo), &eventAt, nil, nil, updatedAt).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"db-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs("todo-summary", string(outbox.Topic_Todo), &eventAt, nil, nil, updatedAt).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() // nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewOutboxRepository(db)
+			gotErr := repo.SaveConsumerCheckpoint(t.Context(), checkpoint)
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOutboxRepository_ListConsumerCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	eventAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	errorAt := eventAt.Add(time.Minute)
+	lastError := "generation failed"
+	query := "SELECT subscription, topic, last_event_at, last_error, last_error_at, updated_at " +
+		"FROM outbox_consumer_checkpoints ORDER BY subscription"
+
+	tests := map[string]struct {
+		expect   func(sqlmock.Sqlmock)
+		expected []outbox.ConsumerCheckpoint
+		err      bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"subscription", "topic", "last_event_at", "last_error", "last_error_at", "updated_at"}).
+						AddRow("chat-title", string(outbox.Topic_ChatMessages), nil, lastError, errorAt, errorAt).
+						AddRow("todo-summary", string(outbox.Topic_Todo), eventAt, nil, nil, eventAt))
+			},
+			expected: []outbox.ConsumerCheckpoint{
+				{
+					Subscription: "chat-title",
+					Topic:        outbox.Topic_ChatMessages,
+					LastError:    &lastError,
+					LastErrorAt:  &errorAt,
+					UpdatedAt:    errorAt,
+				},
+				{
+					Subscription: "todo-summary",
+					Topic:        outbox.Topic_Todo,
+					LastEventAt:  &eventAt,
+					UpdatedAt:    eventAt,
+				},
+			},
+		},
+		"db-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() // nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewOutboxRepository(db)
+			got, gotErr := repo.ListConsumerCheckpoints(t.Context())
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var projectSuggestionFields = []string{
+	"id",
+	"name",
+	"todos",
+	"created_at",
+}
+
+// projectTodoJSON is the stored form of a project suggestion member.
+type projectTodoJSON struct {
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+}
+
+// ProjectRepository implements the todo.ProjectRepository interface using PostgreSQL as the storage backend.
+type ProjectRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewProjectRepository creates a new instance of ProjectRepository.
+func NewProjectRepository(br sq.BaseRunner) ProjectRepository {
+	return ProjectRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// ListUngroupedTodos returns up to limit open todos with an embedding that belong to no project, oldest first.
+func (r ProjectRepository) ListUngroupedTodos(ctx context.Context, limit int) ([]todo.Todo, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+
+	rows, err := r.sb.
+		Select("t.id", "t.title", "t.status", "t.due_date", "t.embedding", "t.created_at", "t.updated_at").
+		From("todos t").
+		Where(sq.Eq{"t.tenant_id": tenantOf(ctx)}).
+		Where(sq.Eq{"t.status": todo.Status_OPEN}).
+		Where(sq.NotEq{"t.embedding": nil}).
+		Where("NOT EXISTS (SELECT 1 FROM project_todos pt WHERE pt.todo_id = t.id)").
+		OrderBy("t.created_at ASC", "t.id").
+		Limit(uint64(limit)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	todos := []todo.Todo{}
+	for rows.Next() {
+		var (
+			td        todo.Todo
+			embedding pgvector.Vector
+		)
+		if err := rows.Scan(
+			&td.ID,
+			&td.Title,
+			&td.Status,
+			&td.DueDate,
+			&embedding,
+			&td.CreatedAt,
+			&td.UpdatedAt,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		td.Embedding = toFloat64(embedding.Slice())
+		todos = append(todos, td)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// CreateProject stores a new project and the membership of its todos.
+func (r ProjectRepository) CreateProject(ctx context.Context, project todo.Project) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("project_id", project.ID.String()),
+		attribute.Int("todos", len(project.Todos)),
+	))
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("projects").
+		Columns("id", "name", "created_at", tenantColumn).
+		Values(project.ID, project.Name, project.CreatedAt, tenantOf(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	if len(project.Todos) == 0 {
+		return nil
+	}
+
+	insert := r.sb.
+		Insert("project_todos").
+		Columns("todo_id", "project_id", tenantColumn)
+	for _, t := range project.Todos {
+		insert = insert.Values(t.ID, project.ID, tenantOf(ctx))
+	}
+	_, err = insert.ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListProjects returns the projects with their todos, newest first. Todos are ordered by title.
+func (r ProjectRepository) ListProjects(ctx context.Context) ([]todo.Project, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select("p.id", "p.name", "p.created_at", "t.id", "t.title").
+		From("projects p").
+		LeftJoin("project_todos pt ON pt.project_id = p.id").
+		LeftJoin("todos t ON t.id = pt.todo_id").
+		Where(sq.Eq{"p.tenant_id": tenantOf(ctx)}).
+		OrderBy("p.created_at DESC", "p.id", "t.title").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	projects := []todo.Project{}
+	for rows.Next() {
+		var (
+			project   todo.Project
+			todoID    uuid.NullUUID
+			todoTitle sql.NullString
+		)
+		if err := rows.Scan(
+			&project.ID,
+			&project.Name,
+			&project.CreatedAt,
+			&todoID,
+			&todoTitle,
+		); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+
+		if len(projects) == 0 || projects[len(projects)-1].ID != project.ID {
+			project.Todos = []todo.ProjectTodo{}
+			projects = append(projects, project)
+		}
+		if todoID.Valid {
+			last := &projects[len(projects)-1]
+			last.Todos = append(last.Todos, todo.ProjectTodo{ID: todoID.UUID, Title: todoTitle.String})
+		}
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// CreateProjectSuggestions stores new project suggestions in one statement.
+func (r ProjectRepository) CreateProjectSuggestions(ctx context.Context, suggestions []todo.ProjectSuggestion) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("suggestions", len(suggestions)),
+	))
+	defer span.End()
+
+	if len(suggestions) == 0 {
+		return nil
+	}
+
+	insert := r.sb.
+		Insert("project_suggestions").
+		Columns(projectSuggestionFields...).
+		Columns(tenantColumn)
+	for _, s := range suggestions {
+		members := make([]projectTodoJSON, len(s.Todos))
+		for i, t := range s.Todos {
+			members[i] = projectTodoJSON(t)
+		}
+		todosJSON, err := json.Marshal(members)
+		if telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+		insert = insert.Values(
+			s.ID,
+			s.Name,
+			todosJSON,
+			s.CreatedAt,
+			tenantOf(ctx),
+		)
+	}
+
+	_, err := insert.ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// ListProjectSuggestions returns the pending project suggestions, largest group first.
+func (r ProjectRepository) ListProjectSuggestions(ctx context.Context) ([]todo.ProjectSuggestion, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(projectSuggestionFields...).
+		From("project_suggestions").
+		Where(tenantEq(ctx)).
+		OrderBy("jsonb_array_length(todos) DESC", "name").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	suggestions := []todo.ProjectSuggestion{}
+	for rows.Next() {
+		suggestion, err := scanProjectSuggestion(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+// GetProjectSuggestion retrieves a project suggestion by its ID.
+func (r ProjectRepository) GetProjectSuggestion(ctx context.Context, id uuid.UUID) (todo.ProjectSuggestion, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	suggestion, err := scanProjectSuggestion(r.sb.
+		Select(projectSuggestionFields...).
+		From("project_suggestions").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.ProjectSuggestion{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.ProjectSuggestion{}, false, err
+	}
+
+	return suggestion, true, nil
+}
+
+// DeleteProjectSuggestion removes a project suggestion.
+func (r ProjectRepository) DeleteProjectSuggestion(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("project_suggestions").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteProjectSuggestions removes all pending project suggestions.
+func (r ProjectRepository) DeleteProjectSuggestions(ctx context.Context) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("project_suggestions").
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// scanProjectSuggestion reads a project suggestion row, decoding its JSON members.
+func scanProjectSuggestion(row sq.RowScanner) (todo.ProjectSuggestion, error) {
+	var (
+		suggestion todo.ProjectSuggestion
+		todosJSON  []byte
+	)
+	if err := row.Scan(
+		&suggestion.ID,
+		&suggestion.Name,
+		&todosJSON,
+		&suggestion.CreatedAt,
+	); err != nil {
+		return todo.ProjectSuggestion{}, err
+	}
+
+	var members []projectTodoJSON
+	if err := json.Unmarshal(todosJSON, &members); err != nil {
+		return todo.ProjectSuggestion{}, err
+	}
+	suggestion.Todos = make([]todo.ProjectTodo, len(members))
+	for i, m := range members {
+		suggestion.Todos[i] = todo.ProjectTodo(m)
+	}
+
+	return suggestion, nil
+}
@@ -0,0 +1,492 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectRepository_ListUngroupedTodos(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	dueDate := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT t.id, t.title, t.status, t.due_date, t.embedding, t.created_at, t.updated_at FROM todos t " +
+		"WHERE t.tenant_id = $1 AND t.status = $2 AND t.embedding IS NOT NULL " +
+		"AND NOT EXISTS (SELECT 1 FROM project_todos pt WHERE pt.todo_id = t.id) " +
+		"ORDER BY t.created_at ASC, t.id LIMIT 200"
+	columns := []string{"id", "title", "status", "due_date", "embedding", "created_at", "updated_at"}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.Todo
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(todoID, "Pack kitchen boxes", todo.Status_OPEN, dueDate, "[0.5,0.25]", createdAt, createdAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default, todo.Status_OPEN).WillReturnRows(rows)
+			},
+			expected: []todo.Todo{{
+				ID:        todoID,
+				Title:     "Pack kitchen boxes",
+				Status:    todo.Status_OPEN,
+				DueDate:   dueDate,
+				Embedding: []float64{0.5, 0.25},
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default, todo.Status_OPEN).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			got, err := repo.ListUngroupedTodos(t.Context(), 200)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_CreateProject(t *testing.T) {
+	t.Parallel()
+
+	project := todo.Project{
+		ID:   uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Name: "House move",
+		Todos: []todo.ProjectTodo{
+			{ID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), Title: "Pack kitchen boxes"},
+			{ID: uuid.MustParse("323e4567-e89b-12d3-a456-426614174002"), Title: "Book movers"},
+		},
+		CreatedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	projectQuery := "INSERT INTO projects (id,name,created_at,tenant_id) VALUES ($1,$2,$3,$4)"
+	todosQuery := "INSERT INTO project_todos (todo_id,project_id,tenant_id) VALUES ($1,$2,$3),($4,$5,$6)"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(projectQuery).
+					WithArgs(project.ID, project.Name, project.CreatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectExec(todosQuery).
+					WithArgs(
+						project.Todos[0].ID, project.ID, tenant.Default,
+						project.Todos[1].ID, project.ID, tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+			},
+		},
+		"project-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(projectQuery).
+					WithArgs(project.ID, project.Name, project.CreatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+		"todos-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(projectQuery).
+					WithArgs(project.ID, project.Name, project.CreatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectExec(todosQuery).
+					WithArgs(
+						project.Todos[0].ID, project.ID, tenant.Default,
+						project.Todos[1].ID, project.ID, tenant.Default,
+					).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			gotErr := repo.CreateProject(t.Context(), project)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_ListProjects(t *testing.T) {
+	t.Parallel()
+
+	moveID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	taxesID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174003")
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	otherTodoID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT p.id, p.name, p.created_at, t.id, t.title FROM projects p " +
+		"LEFT JOIN project_todos pt ON pt.project_id = p.id " +
+		"LEFT JOIN todos t ON t.id = pt.todo_id " +
+		"WHERE p.tenant_id = $1 ORDER BY p.created_at DESC, p.id, t.title"
+	columns := []string{"id", "name", "created_at", "todo_id", "todo_title"}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.Project
+		expectErr bool
+	}{
+		"groups-todos-by-project": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(moveID, "House move", createdAt, otherTodoID, "Book movers").
+					AddRow(moveID, "House move", createdAt, todoID, "Pack kitchen boxes").
+					AddRow(taxesID, "Taxes", createdAt.Add(-time.Hour), nil, nil)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.Project{
+				{
+					ID:   moveID,
+					Name: "House move",
+					Todos: []todo.ProjectTodo{
+						{ID: otherTodoID, Title: "Book movers"},
+						{ID: todoID, Title: "Pack kitchen boxes"},
+					},
+					CreatedAt: createdAt,
+				},
+				{ID: taxesID, Name: "Taxes", Todos: []todo.ProjectTodo{}, CreatedAt: createdAt.Add(-time.Hour)},
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			got, err := repo.ListProjects(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_CreateProjectSuggestions(t *testing.T) {
+	t.Parallel()
+
+	suggestion := todo.ProjectSuggestion{
+		ID:        uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		Name:      "House move",
+		Todos:     []todo.ProjectTodo{{ID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"), Title: "Pack kitchen boxes"}},
+		CreatedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	query := "INSERT INTO project_suggestions (id,name,todos,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5)"
+	todosJSON := []byte(`[{"id":"123e4567-e89b-12d3-a456-426614174000","title":"Pack kitchen boxes"}]`)
+
+	tests := map[string]struct {
+		suggestions []todo.ProjectSuggestion
+		expect      func(sqlmock.Sqlmock)
+		expectErr   bool
+	}{
+		"success": {
+			suggestions: []todo.ProjectSuggestion{suggestion},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(suggestion.ID, suggestion.Name, todosJSON, suggestion.CreatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"no-suggestions": {
+			expect: func(sqlmock.Sqlmock) {},
+		},
+		"database-error": {
+			suggestions: []todo.ProjectSuggestion{suggestion},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(suggestion.ID, suggestion.Name, todosJSON, suggestion.CreatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			gotErr := repo.CreateProjectSuggestions(t.Context(), tt.suggestions)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_ListProjectSuggestions(t *testing.T) {
+	t.Parallel()
+
+	suggestionID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, name, todos, created_at FROM project_suggestions WHERE tenant_id = $1 " +
+		"ORDER BY jsonb_array_length(todos) DESC, name"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.ProjectSuggestion
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(projectSuggestionFields).
+					AddRow(suggestionID, "House move", []byte(`[{"id":"123e4567-e89b-12d3-a456-426614174000","title":"Pack kitchen boxes"}]`), createdAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.ProjectSuggestion{{
+				ID:        suggestionID,
+				Name:      "House move",
+				Todos:     []todo.ProjectTodo{{ID: todoID, Title: "Pack kitchen boxes"}},
+				CreatedAt: createdAt,
+			}},
+		},
+		"invalid-json": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(projectSuggestionFields).AddRow(suggestionID, "House move", []byte(`{`), createdAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			got, err := repo.ListProjectSuggestions(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_GetProjectSuggestion(t *testing.T) {
+	t.Parallel()
+
+	suggestionID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, name, todos, created_at FROM project_suggestions WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     todo.ProjectSuggestion
+		expectedFind bool
+		expectErr    bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(projectSuggestionFields).AddRow(suggestionID, "House move", []byte(`[]`), createdAt)
+				m.ExpectQuery(query).WithArgs(suggestionID, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     todo.ProjectSuggestion{ID: suggestionID, Name: "House move", Todos: []todo.ProjectTodo{}, CreatedAt: createdAt},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(suggestionID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(suggestionID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			got, found, err := repo.GetProjectSuggestion(t.Context(), suggestionID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_DeleteProjectSuggestion(t *testing.T) {
+	t.Parallel()
+
+	suggestionID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	query := "DELETE FROM project_suggestions WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(suggestionID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(suggestionID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			gotErr := repo.DeleteProjectSuggestion(t.Context(), suggestionID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProjectRepository_DeleteProjectSuggestions(t *testing.T) {
+	t.Parallel()
+
+	query := "DELETE FROM project_suggestions WHERE tenant_id = $1"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(tenant.Default).WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewProjectRepository(db)
+			gotErr := repo.DeleteProjectSuggestions(t.Context())
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
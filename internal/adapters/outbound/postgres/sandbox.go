@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// sandboxTxKey is the context key of the transaction of a sandbox.
+type sandboxTxKey struct{}
+
+// sandboxTx returns the sandbox transaction of ctx, if any.
+func sandboxTx(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(sandboxTxKey{}).(*sql.Tx)
+	return tx, ok
+}
+
+// Run implements transaction.Sandbox. It opens a transaction that every unit of work of fn joins,
+// and the repositories built with NewSandboxRunner use, and rolls it back when fn returns. The transaction
+// is also rolled back, releasing its locks, as soon as ctx is done.
+func (u *UnitOfWork) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	tx, err := u.db.BeginTx(spanCtx, nil)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	err = fn(transaction.WithSandbox(context.WithValue(spanCtx, sandboxTxKey{}, tx)))
+	if rbErr := tx.Rollback(); rbErr != nil {
+		if err != nil {
+			return fmt.Errorf("sandbox rollback error: %v, original error: %w", rbErr, err)
+		}
+		return rbErr
+	}
+	return err
+}
+
+// executeInSavepoint runs fn in a savepoint of tx, so an error rolls back the changes of fn only
+// and leaves the transaction usable.
+func executeInSavepoint(ctx context.Context, tx *sql.Tx, scope transaction.Scope, fn func(context.Context, transaction.Scope) error) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT unit_of_work"); err != nil {
+		return err
+	}
+
+	if err := fn(ctx, scope); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT unit_of_work"); rbErr != nil {
+			return fmt.Errorf("savepoint rollback error: %v, original error: %w", rbErr, err)
+		}
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT unit_of_work")
+	return err
+}
+
+// SandboxRunner runs the statements of a repository on the sandbox transaction of their context, and on
+// the database handle outside a sandbox. The repositories a chat turn uses outside units of work are built
+// with it, so work in a sandbox reads its own changes and writes nothing outside the sandbox.
+type SandboxRunner struct {
+	db *sql.DB
+}
+
+// NewSandboxRunner creates a SandboxRunner bound to a database handle.
+func NewSandboxRunner(db *sql.DB) SandboxRunner {
+	return SandboxRunner{db: db}
+}
+
+// Exec runs a statement on the database handle.
+func (r SandboxRunner) Exec(query string, args ...any) (sql.Result, error) {
+	return r.db.Exec(query, args...)
+}
+
+// Query runs a query on the database handle.
+func (r SandboxRunner) Query(query string, args ...any) (*sql.Rows, error) {
+	return r.db.Query(query, args...)
+}
+
+// QueryRow runs a single-row query on the database handle.
+func (r SandboxRunner) QueryRow(query string, args ...any) *sql.Row {
+	return r.db.QueryRow(query, args...)
+}
+
+// ExecContext runs a statement on the sandbox transaction of ctx, or on the database handle.
+func (r SandboxRunner) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if tx, ok := sandboxTx(ctx); ok {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return r.db.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs a query on the sandbox transaction of ctx, or on the database handle.
+func (r SandboxRunner) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if tx, ok := sandboxTx(ctx); ok {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return r.db.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs a single-row query on the sandbox transaction of ctx, or on the database handle.
+func (r SandboxRunner) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if tx, ok := sandboxTx(ctx); ok {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return r.db.QueryRowContext(ctx, query, args...)
+}
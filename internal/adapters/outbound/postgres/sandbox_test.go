@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitOfWork_Run(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	deleteQuery := "DELETE FROM todos WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		setupMock func(sqlmock.Sqlmock)
+		fn        func(ctx context.Context, uow *UnitOfWork) error
+		expectErr bool
+	}{
+		"rolls-back-units-of-work": {
+			setupMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec("SAVEPOINT unit_of_work").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(deleteQuery).WithArgs(todoID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectExec("RELEASE SAVEPOINT unit_of_work").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectRollback()
+			},
+			fn: func(ctx context.Context, uow *UnitOfWork) error {
+				if !transaction.InSandbox(ctx) {
+					return errors.New("not in sandbox")
+				}
+				return uow.Execute(ctx, func(ctx context.Context, scope transaction.Scope) error {
+					return scope.Todo().DeleteTodo(ctx, todoID)
+				})
+			},
+		},
+		"failed-unit-of-work-rolls-back-savepoint": {
+			setupMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec("SAVEPOINT unit_of_work").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(deleteQuery).WithArgs(todoID, tenant.Default).WillReturnError(errors.New("delete error"))
+				m.ExpectExec("ROLLBACK TO SAVEPOINT unit_of_work").WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectRollback()
+			},
+			fn: func(ctx context.Context, uow *UnitOfWork) error {
+				return uow.Execute(ctx, func(ctx context.Context, scope transaction.Scope) error {
+					return scope.Todo().DeleteTodo(ctx, todoID)
+				})
+			},
+			expectErr: true,
+		},
+		"repository-with-sandbox-runner": {
+			setupMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(deleteQuery).WithArgs(todoID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectRollback()
+			},
+			fn: func(ctx context.Context, uow *UnitOfWork) error {
+				return NewTodoRepository(NewSandboxRunner(uow.db)).DeleteTodo(ctx, todoID)
+			},
+		},
+		"begin-error": {
+			setupMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin().WillReturnError(errors.New("begin error"))
+			},
+			fn: func(context.Context, *UnitOfWork) error {
+				return nil
+			},
+			expectErr: true,
+		},
+		"rollback-error": {
+			setupMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectRollback().WillReturnError(errors.New("rollback error"))
+			},
+			fn: func(context.Context, *UnitOfWork) error {
+				return nil
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.setupMock(mock)
+
+			uow := NewUnitOfWork(db)
+			err = uow.Run(t.Context(), func(ctx context.Context) error {
+				return tt.fn(ctx, uow)
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSandboxRunner_OutsideSandbox(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	mock.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+		WithArgs(todoID, tenant.Default).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewTodoRepository(NewSandboxRunner(db)).DeleteTodo(t.Context(), todoID)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
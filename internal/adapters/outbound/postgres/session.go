@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var sessionFields = []string{
+	"id",
+	"principal",
+	"role",
+	"user_id",
+	"device",
+	"access_token_hash",
+	"access_expires_at",
+	"refresh_token_hash",
+	"expires_at",
+	"created_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+// SessionRepository implements the access.SessionRepository interface using PostgreSQL as the storage backend.
+type SessionRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewSessionRepository creates a new instance of SessionRepository.
+func NewSessionRepository(br sq.BaseRunner) SessionRepository {
+	return SessionRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateSession stores a new session.
+func (r SessionRepository) CreateSession(ctx context.Context, session access.Session) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("sessions").
+		Columns(sessionFields...).
+		Columns(tenantColumn).
+		Values(
+			session.ID,
+			session.Principal,
+			session.Role,
+			uuid.NullUUID{UUID: session.UserID, Valid: session.UserID != uuid.Nil},
+			session.Device,
+			session.AccessTokenHash,
+			session.AccessExpiresAt,
+			session.RefreshTokenHash,
+			session.ExpiresAt,
+			session.CreatedAt,
+			session.LastUsedAt,
+			session.RevokedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSession stores the tokens, expirations, last use and revocation of an existing session.
+func (r SessionRepository) UpdateSession(ctx context.Context, session access.Session) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("sessions").
+		Set("access_token_hash", session.AccessTokenHash).
+		Set("access_expires_at", session.AccessExpiresAt).
+		Set("refresh_token_hash", session.RefreshTokenHash).
+		Set("expires_at", session.ExpiresAt).
+		Set("last_used_at", session.LastUsedAt).
+		Set("revoked_at", session.RevokedAt).
+		Where(sq.Eq{"id": session.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// TouchSession records the last use of a session that has not been revoked. Only last_used_at is
+// written so a concurrent revocation or refresh rotation is never undone.
+func (r SessionRepository) TouchSession(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("sessions").
+		Set("last_used_at", lastUsedAt).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetSession retrieves a session by its ID.
+func (r SessionRepository) GetSession(ctx context.Context, id uuid.UUID) (access.Session, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	session, found, err := r.getSession(spanCtx, sq.Eq{"id": id})
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Session{}, false, err
+	}
+
+	return session, found, nil
+}
+
+// GetSessionByAccessToken retrieves the session holding the access token hash.
+func (r SessionRepository) GetSessionByAccessToken(ctx context.Context, tokenHash string) (access.Session, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	session, found, err := r.getSession(spanCtx, sq.Eq{"access_token_hash": tokenHash})
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Session{}, false, err
+	}
+
+	return session, found, nil
+}
+
+// GetSessionByRefreshToken retrieves the session holding the refresh token hash.
+func (r SessionRepository) GetSessionByRefreshToken(ctx context.Context, tokenHash string) (access.Session, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	session, found, err := r.getSession(spanCtx, sq.Eq{"refresh_token_hash": tokenHash})
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Session{}, false, err
+	}
+
+	return session, found, nil
+}
+
+// ListActiveSessions lists the sessions of the principal that are active at the given time, most recently used first.
+func (r SessionRepository) ListActiveSessions(ctx context.Context, principal string, now time.Time) ([]access.Session, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(sessionFields...).
+		From("sessions").
+		Where(sq.Eq{"principal": principal, "revoked_at": nil}).
+		Where(sq.Gt{"expires_at": now}).
+		Where(tenantEq(ctx)).
+		OrderBy("last_used_at DESC").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	sessions := []access.Session{}
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// getSession retrieves the first session matching the predicate.
+func (r SessionRepository) getSession(ctx context.Context, pred sq.Sqlizer) (access.Session, bool, error) {
+	session, err := scanSession(r.sb.
+		Select(sessionFields...).
+		From("sessions").
+		Where(pred).
+		Where(tenantEq(ctx)).
+		QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return access.Session{}, false, nil
+	}
+	if err != nil {
+		return access.Session{}, false, err
+	}
+	return session, true, nil
+}
+
+// scanSession reads a session row.
+func scanSession(row sq.RowScanner) (access.Session, error) {
+	var (
+		session access.Session
+		userID  uuid.NullUUID
+	)
+	if err := row.Scan(
+		&session.ID,
+		&session.Principal,
+		&session.Role,
+		&userID,
+		&session.Device,
+		&session.AccessTokenHash,
+		&session.AccessExpiresAt,
+		&session.RefreshTokenHash,
+		&session.ExpiresAt,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.RevokedAt,
+	); err != nil {
+		return access.Session{}, err
+	}
+	session.UserID = userID.UUID
+	return session, nil
+}
@@ -0,0 +1,330 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixedSessionID = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	fixedUserID    = uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	fixedSessionAt = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	fixedSession   = access.Session{
+		ID:               fixedSessionID,
+		Principal:        "viewer",
+		Role:             access.RoleReadonly,
+		Device:           "laptop",
+		AccessTokenHash:  "access-hash",
+		AccessExpiresAt:  fixedSessionAt.Add(15 * time.Minute),
+		RefreshTokenHash: "refresh-hash",
+		ExpiresAt:        fixedSessionAt.Add(720 * time.Hour),
+		CreatedAt:        fixedSessionAt,
+		LastUsedAt:       fixedSessionAt,
+	}
+)
+
+func sessionRows() *sqlmock.Rows {
+	return sqlmock.NewRows(sessionFields).AddRow(
+		fixedSession.ID,
+		fixedSession.Principal,
+		fixedSession.Role,
+		nil,
+		fixedSession.Device,
+		fixedSession.AccessTokenHash,
+		fixedSession.AccessExpiresAt,
+		fixedSession.RefreshTokenHash,
+		fixedSession.ExpiresAt,
+		fixedSession.CreatedAt,
+		fixedSession.LastUsedAt,
+		nil,
+	)
+}
+
+func TestSessionRepository_CreateSession(t *testing.T) {
+	t.Parallel()
+
+	query := "INSERT INTO sessions (id,principal,role,user_id,device,access_token_hash,access_expires_at,refresh_token_hash,expires_at,created_at,last_used_at,revoked_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)"
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(
+				fixedSession.ID, fixedSession.Principal, fixedSession.Role, uuid.NullUUID{}, fixedSession.Device,
+				fixedSession.AccessTokenHash, fixedSession.AccessExpiresAt, fixedSession.RefreshTokenHash,
+				fixedSession.ExpiresAt, fixedSession.CreatedAt, fixedSession.LastUsedAt, nil, tenant.Default,
+			)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			err = NewSessionRepository(db).CreateSession(t.Context(), fixedSession)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_UpdateSession(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE sessions SET access_token_hash = $1, access_expires_at = $2, refresh_token_hash = $3, expires_at = $4, last_used_at = $5, revoked_at = $6 WHERE id = $7 AND tenant_id = $8"
+	revokedAt := fixedSessionAt.Add(time.Hour)
+	revoked := fixedSession
+	revoked.RevokedAt = &revokedAt
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(
+				revoked.AccessTokenHash, revoked.AccessExpiresAt, revoked.RefreshTokenHash,
+				revoked.ExpiresAt, revoked.LastUsedAt, revoked.RevokedAt, revoked.ID, tenant.Default,
+			)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err = NewSessionRepository(db).UpdateSession(t.Context(), revoked)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_TouchSession(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE sessions SET last_used_at = $1 WHERE id = $2 AND revoked_at IS NULL AND tenant_id = $3"
+	lastUsedAt := fixedSessionAt.Add(time.Hour)
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(lastUsedAt, fixedSessionID, tenant.Default)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err = NewSessionRepository(db).TouchSession(t.Context(), fixedSessionID, lastUsedAt)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_GetSession(t *testing.T) {
+	t.Parallel()
+
+	selectQuery := "SELECT id, principal, role, user_id, device, access_token_hash, access_expires_at, refresh_token_hash, expires_at, created_at, last_used_at, revoked_at FROM sessions WHERE "
+
+	tests := map[string]struct {
+		get          func(SessionRepository) (access.Session, bool, error)
+		expect       func(sqlmock.Sqlmock)
+		expected     access.Session
+		expectedFind bool
+		expectErr    bool
+	}{
+		"by-id": {
+			get: func(r SessionRepository) (access.Session, bool, error) {
+				return r.GetSession(t.Context(), fixedSessionID)
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"id = $1 AND tenant_id = $2").
+					WithArgs(fixedSessionID, tenant.Default).WillReturnRows(sessionRows())
+			},
+			expected:     fixedSession,
+			expectedFind: true,
+		},
+		"user-session": {
+			get: func(r SessionRepository) (access.Session, bool, error) {
+				return r.GetSession(t.Context(), fixedSessionID)
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(sessionFields).AddRow(
+					fixedSession.ID, "user:"+fixedUserID.String(), fixedSession.Role, fixedUserID, fixedSession.Device,
+					fixedSession.AccessTokenHash, fixedSession.AccessExpiresAt, fixedSession.RefreshTokenHash,
+					fixedSession.ExpiresAt, fixedSession.CreatedAt, fixedSession.LastUsedAt, nil,
+				)
+				m.ExpectQuery(selectQuery+"id = $1 AND tenant_id = $2").
+					WithArgs(fixedSessionID, tenant.Default).WillReturnRows(rows)
+			},
+			expected: func() access.Session {
+				s := fixedSession
+				s.Principal = "user:" + fixedUserID.String()
+				s.UserID = fixedUserID
+				return s
+			}(),
+			expectedFind: true,
+		},
+		"by-access-token": {
+			get: func(r SessionRepository) (access.Session, bool, error) {
+				return r.GetSessionByAccessToken(t.Context(), "access-hash")
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"access_token_hash = $1 AND tenant_id = $2").
+					WithArgs("access-hash", tenant.Default).WillReturnRows(sessionRows())
+			},
+			expected:     fixedSession,
+			expectedFind: true,
+		},
+		"by-refresh-token": {
+			get: func(r SessionRepository) (access.Session, bool, error) {
+				return r.GetSessionByRefreshToken(t.Context(), "refresh-hash")
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"refresh_token_hash = $1 AND tenant_id = $2").
+					WithArgs("refresh-hash", tenant.Default).WillReturnRows(sessionRows())
+			},
+			expected:     fixedSession,
+			expectedFind: true,
+		},
+		"not-found": {
+			get: func(r SessionRepository) (access.Session, bool, error) {
+				return r.GetSession(t.Context(), fixedSessionID)
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"id = $1 AND tenant_id = $2").
+					WithArgs(fixedSessionID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			get: func(r SessionRepository) (access.Session, bool, error) {
+				return r.GetSessionByAccessToken(t.Context(), "access-hash")
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"access_token_hash = $1 AND tenant_id = $2").
+					WithArgs("access-hash", tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			got, found, err := tt.get(NewSessionRepository(db))
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_ListActiveSessions(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT id, principal, role, user_id, device, access_token_hash, access_expires_at, refresh_token_hash, expires_at, created_at, last_used_at, revoked_at FROM sessions WHERE principal = $1 AND revoked_at IS NULL AND expires_at > $2 AND tenant_id = $3 ORDER BY last_used_at DESC"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []access.Session
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("viewer", fixedSessionAt, tenant.Default).WillReturnRows(sessionRows())
+			},
+			expected: []access.Session{fixedSession},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("viewer", fixedSessionAt, tenant.Default).
+					WillReturnRows(sqlmock.NewRows(sessionFields))
+			},
+			expected: []access.Session{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("viewer", fixedSessionAt, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			got, err := NewSessionRepository(db).ListActiveSessions(t.Context(), "viewer", fixedSessionAt)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
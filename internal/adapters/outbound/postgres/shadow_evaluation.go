@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+var shadowEvaluationFields = []string{
+	"id",
+	"conversation_id",
+	"turn_id",
+	"primary_model",
+	"primary_content",
+	"primary_actions",
+	"candidate_model",
+	"candidate_content",
+	"candidate_actions",
+	"candidate_error",
+	"candidate_prompt_tokens",
+	"candidate_completion_tokens",
+	"candidate_total_tokens",
+	"candidate_latency_ms",
+	"created_at",
+}
+
+// ShadowEvaluationRepository is a PostgreSQL implementation of assistant.ShadowEvaluationRepository.
+type ShadowEvaluationRepository struct {
+	sb squirrel.StatementBuilderType
+}
+
+// NewShadowEvaluationRepository creates a new instance of ShadowEvaluationRepository.
+func NewShadowEvaluationRepository(br squirrel.BaseRunner) ShadowEvaluationRepository {
+	return ShadowEvaluationRepository{
+		sb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).RunWith(br),
+	}
+}
+
+// CreateShadowEvaluation inserts a new shadow evaluation.
+func (r ShadowEvaluationRepository) CreateShadowEvaluation(ctx context.Context, evaluation assistant.ShadowEvaluation) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	primaryActionsJSON, err := marshalActionNames(evaluation.PrimaryActions)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	candidateActionsJSON, err := marshalActionNames(evaluation.CandidateActions)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("shadow_evaluations").
+		Columns(shadowEvaluationFields...).
+		Columns(tenantColumn).
+		Values(
+			evaluation.ID,
+			evaluation.ConversationID,
+			evaluation.TurnID,
+			evaluation.PrimaryModel,
+			evaluation.PrimaryContent,
+			primaryActionsJSON,
+			evaluation.CandidateModel,
+			evaluation.CandidateContent,
+			candidateActionsJSON,
+			evaluation.CandidateError,
+			evaluation.CandidateUsage.PromptTokens,
+			evaluation.CandidateUsage.CompletionTokens,
+			evaluation.CandidateUsage.TotalTokens,
+			evaluation.CandidateLatency.Milliseconds(),
+			evaluation.CreatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// marshalActionNames encodes action names as a JSON array, empty when there are none.
+func marshalActionNames(names []string) ([]byte, error) {
+	if names == nil {
+		names = []string{}
+	}
+	return json.Marshal(names)
+}
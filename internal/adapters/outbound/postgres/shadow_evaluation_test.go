@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShadowEvaluationRepository_CreateShadowEvaluation(t *testing.T) {
+	t.Parallel()
+
+	evaluationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	query := "INSERT INTO shadow_evaluations " +
+		"(id,conversation_id,turn_id,primary_model,primary_content,primary_actions,candidate_model,candidate_content," +
+		"candidate_actions,candidate_error,candidate_prompt_tokens,candidate_completion_tokens,candidate_total_tokens," +
+		"candidate_latency_ms,created_at,tenant_id) " +
+		"VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)"
+
+	tests := map[string]struct {
+		evaluation assistant.ShadowEvaluation
+		expect     func(sqlmock.Sqlmock)
+		expectErr  bool
+	}{
+		"success": {
+			evaluation: assistant.ShadowEvaluation{
+				ID:               evaluationID,
+				ConversationID:   conversationID,
+				TurnID:           turnID,
+				PrimaryModel:     "qwen3",
+				PrimaryContent:   "Created your todo.",
+				PrimaryActions:   []string{"create_todos"},
+				CandidateModel:   "llama3",
+				CandidateContent: "",
+				CandidateActions: []string{"create_todos", "fetch_todos"},
+				CandidateUsage:   assistant.Usage{PromptTokens: 120, CompletionTokens: 30, TotalTokens: 150},
+				CandidateLatency: 1500 * time.Millisecond,
+				CreatedAt:        createdAt,
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(
+						evaluationID, conversationID, turnID,
+						"qwen3", "Created your todo.", []byte(`["create_todos"]`),
+						"llama3", "", []byte(`["create_todos","fetch_todos"]`), nil,
+						120, 30, 150, int64(1500), createdAt, tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"candidate-error": {
+			evaluation: assistant.ShadowEvaluation{
+				ID:             evaluationID,
+				ConversationID: conversationID,
+				TurnID:         turnID,
+				PrimaryModel:   "qwen3",
+				PrimaryContent: "Here are your todos.",
+				CandidateModel: "llama3",
+				CandidateError: common.Ptr("model not found"),
+				CreatedAt:      createdAt,
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(
+						evaluationID, conversationID, turnID,
+						"qwen3", "Here are your todos.", []byte(`[]`),
+						"llama3", "", []byte(`[]`), common.Ptr("model not found"),
+						0, 0, 0, int64(0), createdAt, tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			evaluation: assistant.ShadowEvaluation{ID: evaluationID, ConversationID: conversationID, CreatedAt: createdAt},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewShadowEvaluationRepository(db)
+			gotErr := repo.CreateShadowEvaluation(t.Context(), tt.evaluation)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var templateFields = []string{
+	"id",
+	"name",
+	"items",
+	"created_at",
+	"updated_at",
+}
+
+// templateItemRecord is the JSON representation of a template item in the items column.
+type templateItemRecord struct {
+	Title            string `json:"title"`
+	DueOffsetDays    int    `json:"due_offset_days"`
+	EstimatedMinutes int    `json:"estimated_minutes,omitempty"`
+}
+
+// TemplateRepository implements the todo.TemplateRepository interface using PostgreSQL as the storage backend.
+type TemplateRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewTemplateRepository creates a new instance of TemplateRepository.
+func NewTemplateRepository(br sq.BaseRunner) TemplateRepository {
+	return TemplateRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateTemplate stores a new template.
+func (r TemplateRepository) CreateTemplate(ctx context.Context, template todo.Template) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	itemsJSON, err := marshalTemplateItems(template.Items)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("todo_templates").
+		Columns(templateFields...).
+		Columns(tenantColumn).
+		Values(
+			template.ID,
+			template.Name,
+			itemsJSON,
+			template.CreatedAt,
+			template.UpdatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateTemplate replaces the name and items of an existing template.
+func (r TemplateRepository) UpdateTemplate(ctx context.Context, template todo.Template) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	itemsJSON, err := marshalTemplateItems(template.Items)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Update("todo_templates").
+		Set("name", template.Name).
+		Set("items", itemsJSON).
+		Set("updated_at", template.UpdatedAt).
+		Where(sq.Eq{"id": template.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTemplate deletes a template by its ID.
+func (r TemplateRepository) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("todo_templates").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetTemplate retrieves a template by its ID.
+func (r TemplateRepository) GetTemplate(ctx context.Context, id uuid.UUID) (todo.Template, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	template, found, err := r.getTemplate(spanCtx, sq.Eq{"id": id})
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Template{}, false, err
+	}
+
+	return template, found, nil
+}
+
+// GetTemplateByName retrieves a template by its case-insensitive name.
+func (r TemplateRepository) GetTemplateByName(ctx context.Context, name string) (todo.Template, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	template, found, err := r.getTemplate(spanCtx, sq.Expr("lower(name) = ?", strings.ToLower(strings.TrimSpace(name))))
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Template{}, false, err
+	}
+
+	return template, found, nil
+}
+
+// ListTemplates lists the templates ordered by name.
+func (r TemplateRepository) ListTemplates(ctx context.Context) ([]todo.Template, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(templateFields...).
+		From("todo_templates").
+		Where(tenantEq(ctx)).
+		OrderBy("lower(name)").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	templates := []todo.Template{}
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// getTemplate retrieves the first template matching the predicate.
+func (r TemplateRepository) getTemplate(ctx context.Context, pred sq.Sqlizer) (todo.Template, bool, error) {
+	template, err := scanTemplate(r.sb.
+		Select(templateFields...).
+		From("todo_templates").
+		Where(pred).
+		Where(tenantEq(ctx)).
+		QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.Template{}, false, nil
+	}
+	if err != nil {
+		return todo.Template{}, false, err
+	}
+	return template, true, nil
+}
+
+// marshalTemplateItems encodes the template items for the items column.
+func marshalTemplateItems(items []todo.TemplateItem) ([]byte, error) {
+	records := make([]templateItemRecord, len(items))
+	for i, item := range items {
+		records[i] = templateItemRecord(item)
+	}
+	return json.Marshal(records)
+}
+
+// scanTemplate reads a template row, decoding its JSON items.
+func scanTemplate(row sq.RowScanner) (todo.Template, error) {
+	var (
+		template  todo.Template
+		itemsJSON []byte
+	)
+	if err := row.Scan(
+		&template.ID,
+		&template.Name,
+		&itemsJSON,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	); err != nil {
+		return todo.Template{}, err
+	}
+
+	var records []templateItemRecord
+	if err := json.Unmarshal(itemsJSON, &records); err != nil {
+		return todo.Template{}, err
+	}
+	template.Items = make([]todo.TemplateItem, len(records))
+	for i, record := range records {
+		template.Items[i] = todo.TemplateItem(record)
+	}
+	return template, nil
+}
@@ -0,0 +1,335 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateRepository_CreateTemplate(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	template := todo.Template{
+		ID:   uuid.MustParse("96000000-0000-0000-0000-000000000001"),
+		Name: "Trip packing",
+		Items: []todo.TemplateItem{
+			{Title: "Pack passport", DueOffsetDays: -1},
+			{Title: "Charge phone", EstimatedMinutes: 5},
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	query := "INSERT INTO todo_templates (id,name,items,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6)"
+	itemsJSON := []byte(`[{"title":"Pack passport","due_offset_days":-1},{"title":"Charge phone","due_offset_days":0,"estimated_minutes":5}]`)
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(template.ID, template.Name, itemsJSON, template.CreatedAt, template.UpdatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(template.ID, template.Name, itemsJSON, template.CreatedAt, template.UpdatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTemplateRepository(db)
+			gotErr := repo.CreateTemplate(t.Context(), template)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTemplateRepository_UpdateTemplate(t *testing.T) {
+	t.Parallel()
+
+	template := todo.Template{
+		ID:        uuid.MustParse("96000000-0000-0000-0000-000000000001"),
+		Name:      "Weekend trip",
+		Items:     []todo.TemplateItem{{Title: "Book hotel", DueOffsetDays: -7}},
+		UpdatedAt: time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+	}
+	query := "UPDATE todo_templates SET name = $1, items = $2, updated_at = $3 WHERE id = $4 AND tenant_id = $5"
+	itemsJSON := []byte(`[{"title":"Book hotel","due_offset_days":-7}]`)
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(template.Name, itemsJSON, template.UpdatedAt, template.ID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(template.Name, itemsJSON, template.UpdatedAt, template.ID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTemplateRepository(db)
+			gotErr := repo.UpdateTemplate(t.Context(), template)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTemplateRepository_DeleteTemplate(t *testing.T) {
+	t.Parallel()
+
+	templateID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+	query := "DELETE FROM todo_templates WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(templateID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(templateID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTemplateRepository(db)
+			gotErr := repo.DeleteTemplate(t.Context(), templateID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTemplateRepository_GetTemplate(t *testing.T) {
+	t.Parallel()
+
+	templateID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+	createdAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	byIDQuery := "SELECT id, name, items, created_at, updated_at FROM todo_templates WHERE id = $1 AND tenant_id = $2"
+	byNameQuery := "SELECT id, name, items, created_at, updated_at FROM todo_templates WHERE lower(name) = $1 AND tenant_id = $2"
+	itemsJSON := []byte(`[{"title":"Pack passport","due_offset_days":-1}]`)
+	expectedTemplate := todo.Template{
+		ID:        templateID,
+		Name:      "Trip packing",
+		Items:     []todo.TemplateItem{{Title: "Pack passport", DueOffsetDays: -1}},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+
+	tests := map[string]struct {
+		byName       bool
+		expect       func(sqlmock.Sqlmock)
+		expected     todo.Template
+		expectedFind bool
+		expectErr    bool
+	}{
+		"by-id": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(templateFields).AddRow(templateID, "Trip packing", itemsJSON, createdAt, createdAt)
+				m.ExpectQuery(byIDQuery).WithArgs(templateID, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     expectedTemplate,
+			expectedFind: true,
+		},
+		"by-name": {
+			byName: true,
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(templateFields).AddRow(templateID, "Trip packing", itemsJSON, createdAt, createdAt)
+				m.ExpectQuery(byNameQuery).WithArgs("trip packing", tenant.Default).WillReturnRows(rows)
+			},
+			expected:     expectedTemplate,
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(byIDQuery).WithArgs(templateID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"invalid-items-json": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(templateFields).AddRow(templateID, "Trip packing", []byte(`[`), createdAt, createdAt)
+				m.ExpectQuery(byIDQuery).WithArgs(templateID, tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(byIDQuery).WithArgs(templateID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTemplateRepository(db)
+			var (
+				got   todo.Template
+				found bool
+			)
+			if tt.byName {
+				got, found, err = repo.GetTemplateByName(t.Context(), " Trip Packing ")
+			} else {
+				got, found, err = repo.GetTemplate(t.Context(), templateID)
+			}
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTemplateRepository_ListTemplates(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, name, items, created_at, updated_at FROM todo_templates WHERE tenant_id = $1 ORDER BY lower(name)"
+	firstID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+	secondID := uuid.MustParse("96000000-0000-0000-0000-000000000002")
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.Template
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(templateFields).
+					AddRow(firstID, "Move house", []byte(`[{"title":"Book movers","due_offset_days":-14,"estimated_minutes":30}]`), createdAt, createdAt).
+					AddRow(secondID, "Trip packing", []byte(`[{"title":"Pack passport","due_offset_days":-1}]`), createdAt, createdAt)
+				m.ExpectQuery(query).WillReturnRows(rows)
+			},
+			expected: []todo.Template{
+				{
+					ID:        firstID,
+					Name:      "Move house",
+					Items:     []todo.TemplateItem{{Title: "Book movers", DueOffsetDays: -14, EstimatedMinutes: 30}},
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				},
+				{
+					ID:        secondID,
+					Name:      "Trip packing",
+					Items:     []todo.TemplateItem{{Title: "Pack passport", DueOffsetDays: -1}},
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+				},
+			},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(templateFields))
+			},
+			expected: []todo.Template{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTemplateRepository(db)
+			got, err := repo.ListTemplates(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
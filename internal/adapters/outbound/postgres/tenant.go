@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+)
+
+// tenantColumn is the column every table uses to scope its rows to a tenant.
+const tenantColumn = "tenant_id"
+
+// tenantOf returns the ID of the tenant ctx is scoped to, as stored in the tenant column.
+func tenantOf(ctx context.Context) string {
+	return string(tenant.IDFromContext(ctx))
+}
+
+// tenantEq returns the predicate restricting a statement to the rows of the tenant ctx is scoped to.
+func tenantEq(ctx context.Context) sq.Eq {
+	return sq.Eq{tenantColumn: tenantOf(ctx)}
+}
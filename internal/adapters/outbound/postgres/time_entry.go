@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var timeEntryFields = []string{
+	"id",
+	"todo_id",
+	"started_at",
+	"ended_at",
+	"created_at",
+}
+
+// TimeEntryRepository implements the todo.TimeEntryRepository interface using PostgreSQL as the storage backend.
+type TimeEntryRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewTimeEntryRepository creates a new instance of TimeEntryRepository.
+func NewTimeEntryRepository(br sq.BaseRunner) TimeEntryRepository {
+	return TimeEntryRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateTimeEntry stores a new time entry.
+func (r TimeEntryRepository) CreateTimeEntry(ctx context.Context, entry todo.TimeEntry) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("todo_time_entries").
+		Columns(timeEntryFields...).
+		Columns(tenantColumn).
+		Values(
+			entry.ID,
+			entry.TodoID,
+			entry.StartedAt,
+			entry.EndedAt,
+			entry.CreatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateTimeEntry updates the time range of an existing time entry.
+func (r TimeEntryRepository) UpdateTimeEntry(ctx context.Context, entry todo.TimeEntry) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("todo_time_entries").
+		Set("started_at", entry.StartedAt).
+		Set("ended_at", entry.EndedAt).
+		Where(sq.Eq{"id": entry.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetRunningTimeEntry retrieves the running time entry of a todo.
+func (r TimeEntryRepository) GetRunningTimeEntry(ctx context.Context, todoID uuid.UUID) (todo.TimeEntry, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var entry todo.TimeEntry
+	err := r.sb.
+		Select(timeEntryFields...).
+		From("todo_time_entries").
+		Where(sq.Eq{"todo_id": todoID, "ended_at": nil}).
+		Where(tenantEq(ctx)).
+		Limit(1).
+		QueryRowContext(spanCtx).
+		Scan(
+			&entry.ID,
+			&entry.TodoID,
+			&entry.StartedAt,
+			&entry.EndedAt,
+			&entry.CreatedAt,
+		)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.TimeEntry{}, false, nil
+	}
+
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.TimeEntry{}, false, err
+	}
+
+	return entry, true, nil
+}
+
+// SumLoggedTime returns the total logged time of finished entries for the provided todos.
+func (r TimeEntryRepository) SumLoggedTime(ctx context.Context, todoIDs []uuid.UUID) (map[uuid.UUID]time.Duration, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	totals := make(map[uuid.UUID]time.Duration, len(todoIDs))
+	if len(todoIDs) == 0 {
+		return totals, nil
+	}
+
+	rows, err := r.sb.
+		Select(
+			"todo_id",
+			"COALESCE(SUM(EXTRACT(EPOCH FROM (ended_at - started_at))), 0)::BIGINT",
+		).
+		From("todo_time_entries").
+		Where(sq.Eq{"todo_id": todoIDs}).
+		Where(sq.NotEq{"ended_at": nil}).
+		Where(tenantEq(ctx)).
+		GroupBy("todo_id").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		var (
+			todoID  uuid.UUID
+			seconds int64
+		)
+		if err := rows.Scan(&todoID, &seconds); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		totals[todoID] = time.Duration(seconds) * time.Second
+	}
+
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// TimeReport aggregates finished entries started in the [from, to) range per todo.
+func (r TimeEntryRepository) TimeReport(ctx context.Context, from, to time.Time) ([]todo.TimeReportItem, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(
+			"e.todo_id",
+			"t.title",
+			"COALESCE(SUM(EXTRACT(EPOCH FROM (e.ended_at - e.started_at))), 0)::BIGINT AS total_seconds",
+			"COUNT(*)",
+		).
+		From("todo_time_entries e").
+		Join("todos t ON t.id = e.todo_id").
+		Where(sq.NotEq{"e.ended_at": nil}).
+		Where(sq.GtOrEq{"e.started_at": from}).
+		Where(sq.Lt{"e.started_at": to}).
+		Where(sq.Eq{"e.tenant_id": tenantOf(ctx)}).
+		GroupBy("e.todo_id", "t.title").
+		OrderBy("total_seconds DESC").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	items := []todo.TimeReportItem{}
+	for rows.Next() {
+		var (
+			item    todo.TimeReportItem
+			seconds int64
+		)
+		if err := rows.Scan(&item.TodoID, &item.Title, &seconds, &item.Entries); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		item.Total = time.Duration(seconds) * time.Second
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return items, nil
+}
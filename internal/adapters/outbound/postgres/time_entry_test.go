@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntryRepository_CreateTimeEntry(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	entry := todo.TimeEntry{
+		ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		TodoID:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		StartedAt: startedAt,
+		CreatedAt: startedAt,
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("INSERT INTO todo_time_entries (id,todo_id,started_at,ended_at,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6)").
+					WithArgs(entry.ID, entry.TodoID, entry.StartedAt, entry.EndedAt, entry.CreatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("INSERT INTO todo_time_entries (id,todo_id,started_at,ended_at,created_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6)").
+					WithArgs(entry.ID, entry.TodoID, entry.StartedAt, entry.EndedAt, entry.CreatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTimeEntryRepository(db)
+			gotErr := repo.CreateTimeEntry(t.Context(), entry)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTimeEntryRepository_UpdateTimeEntry(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	entry := todo.TimeEntry{
+		ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		TodoID:    uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"),
+		StartedAt: startedAt,
+		EndedAt:   common.Ptr(startedAt.Add(time.Hour)),
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE todo_time_entries SET started_at = $1, ended_at = $2 WHERE id = $3 AND tenant_id = $4").
+					WithArgs(entry.StartedAt, entry.EndedAt, entry.ID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("UPDATE todo_time_entries SET started_at = $1, ended_at = $2 WHERE id = $3 AND tenant_id = $4").
+					WithArgs(entry.StartedAt, entry.EndedAt, entry.ID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTimeEntryRepository(db)
+			gotErr := repo.UpdateTimeEntry(t.Context(), entry)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTimeEntryRepository_GetRunningTimeEntry(t *testing.T) {
+	t.Parallel()
+
+	entryID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, todo_id, started_at, ended_at, created_at FROM todo_time_entries WHERE ended_at IS NULL AND todo_id = $1 AND tenant_id = $2 LIMIT 1"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     todo.TimeEntry
+		expectedFind bool
+		expectErr    bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(timeEntryFields).
+					AddRow(entryID, todoID, startedAt, nil, startedAt)
+				m.ExpectQuery(query).WithArgs(todoID, tenant.Default).WillReturnRows(rows)
+			},
+			expected: todo.TimeEntry{
+				ID:        entryID,
+				TodoID:    todoID,
+				StartedAt: startedAt,
+				CreatedAt: startedAt,
+			},
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(todoID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(todoID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTimeEntryRepository(db)
+			got, found, gotErr := repo.GetRunningTimeEntry(t.Context(), todoID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTimeEntryRepository_SumLoggedTime(t *testing.T) {
+	t.Parallel()
+
+	todoID1 := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	todoID2 := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	query := "SELECT todo_id, COALESCE(SUM(EXTRACT(EPOCH FROM (ended_at - started_at))), 0)::BIGINT FROM todo_time_entries WHERE todo_id IN ($1,$2) AND ended_at IS NOT NULL AND tenant_id = $3 GROUP BY todo_id"
+
+	tests := map[string]struct {
+		ids       []uuid.UUID
+		expect    func(sqlmock.Sqlmock)
+		expected  map[uuid.UUID]time.Duration
+		expectErr bool
+	}{
+		"success": {
+			ids: []uuid.UUID{todoID1, todoID2},
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"todo_id", "total"}).
+					AddRow(todoID1, int64(5400))
+				m.ExpectQuery(query).WithArgs(todoID1, todoID2, tenant.Default).WillReturnRows(rows)
+			},
+			expected: map[uuid.UUID]time.Duration{todoID1: 90 * time.Minute},
+		},
+		"no-ids": {
+			ids:      nil,
+			expect:   func(m sqlmock.Sqlmock) {},
+			expected: map[uuid.UUID]time.Duration{},
+		},
+		"database-error": {
+			ids: []uuid.UUID{todoID1, todoID2},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(todoID1, todoID2, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTimeEntryRepository(db)
+			got, gotErr := repo.SumLoggedTime(t.Context(), tt.ids)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTimeEntryRepository_TimeReport(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	query := "SELECT e.todo_id, t.title, COALESCE(SUM(EXTRACT(EPOCH FROM (e.ended_at - e.started_at))), 0)::BIGINT AS total_seconds, COUNT(*) FROM todo_time_entries e JOIN todos t ON t.id = e.todo_id WHERE e.ended_at IS NOT NULL AND e.started_at >= $1 AND e.started_at < $2 AND e.tenant_id = $3 GROUP BY e.todo_id, t.title ORDER BY total_seconds DESC"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.TimeReportItem
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"todo_id", "title", "total_seconds", "count"}).
+					AddRow(todoID, "Write report", int64(3600), 2)
+				m.ExpectQuery(query).WithArgs(from, to, tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.TimeReportItem{
+				{TodoID: todoID, Title: "Write report", Total: time.Hour, Entries: 2},
+			},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"todo_id", "title", "total_seconds", "count"})
+				m.ExpectQuery(query).WithArgs(from, to, tenant.Default).WillReturnRows(rows)
+			},
+			expected: []todo.TimeReportItem{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(from, to, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTimeEntryRepository(db)
+			got, gotErr := repo.TimeReport(t.Context(), from, to)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -3,7 +3,10 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
@@ -21,8 +24,15 @@ var (
 		"title",
 		"status",
 		"due_date",
+		"estimated_minutes",
 		"created_at",
 		"updated_at",
+		"archived_at",
+		"custom_fields",
+		"location_name",
+		"location_lat",
+		"location_lon",
+		"recurrence_rule",
 	}
 )
 
@@ -60,24 +70,133 @@ func (tr TodoRepository) ListTodos(ctx context.Context, page int, pageSize int,
 		Limit(uint64(pageSize + 1)). // fetch one extra to determine if there's more
 		Offset(uint64((page - 1) * pageSize))
 
-	params := &todo.ListParams{}
-	for _, opt := range opts {
-		opt(params)
+	params := todo.NewListParams(opts...)
+	qry, err := tr.applyFilters(spanCtx, qry, &params)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	qry, err = applySort(qry, &params)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	rows, err := qry.QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	todos, err := scanTodos(rows)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	if len(todos) > pageSize {
+		todos = todos[:pageSize]
+		return todos, true, nil
+	}
+	return todos, false, nil
+}
+
+// CountTodos counts the todos matching the options, up to limit, so that counting a broad search stays cheap.
+func (tr TodoRepository) CountTodos(ctx context.Context, limit int, opts ...todo.ListOption) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+
+	if limit <= 0 {
+		return 0, core.NewValidationErr("limit must be greater than 0")
+	}
+
+	params := todo.NewListParams(opts...)
+	matched, err := tr.applyFilters(spanCtx, tr.sb.Select("1").From("todos"), &params)
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+
+	var count int
+	err = tr.sb.
+		Select("COUNT(*)").
+		FromSelect(matched.Limit(uint64(limit)), "matched").
+		QueryRowContext(spanCtx).
+		Scan(&count)
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ScoreTodos returns the similarity between each of the given todos and the embedding of the options, as one
+// minus their cosine distance.
+func (tr TodoRepository) ScoreTodos(ctx context.Context, ids []uuid.UUID, opts ...todo.ListOption) (map[uuid.UUID]float64, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("todos", len(ids)),
+	))
+	defer span.End()
+
+	params := todo.NewListParams(opts...)
+	if len(params.Embedding) == 0 {
+		return nil, core.NewValidationErr("embedding must be provided to score todos")
+	}
+
+	scores := make(map[uuid.UUID]float64, len(ids))
+	if len(ids) == 0 {
+		return scores, nil
+	}
+
+	distance, args := embeddingDistance(&params)
+	rows, err := tr.sb.
+		Select("id").
+		Column(sq.Expr("1 - ("+distance+")", args...)).
+		From("todos").
+		Where(sq.Eq{"id": ids}).
+		Where(tenantEq(ctx)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	for rows.Next() {
+		var (
+			id    uuid.UUID
+			score sql.NullFloat64
+		)
+		if err := rows.Scan(&id, &score); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		if score.Valid {
+			scores[id] = score.Float64
+		}
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
 	}
 
+	return scores, nil
+}
+
+// applyFilters adds the conditions of the params and the tenant to the query.
+func (tr TodoRepository) applyFilters(ctx context.Context, qry sq.SelectBuilder, params *todo.ListParams) (sq.SelectBuilder, error) {
 	if params.Status != nil {
 		if err := params.Status.Validate(); err != nil {
-			return nil, false, err
+			return qry, err
 		}
 		qry = qry.Where(sq.Eq{"status": *params.Status})
 	}
 
 	if len(params.Embedding) > 0 {
+		if params.EmbeddingModel != "" {
+			if err := tr.validateEmbeddingDimensions(ctx, params.EmbeddingModel, len(params.Embedding)); err != nil {
+				return qry, err
+			}
+		}
+		distance, args := embeddingDistance(params)
 		qry = qry.
-			Where(sq.Expr(
-				"(embedding <=> ?) < 0.5",
-				pgvector.NewVector(toFloat32Truncated(params.Embedding)),
-			)).
+			Where(sq.Expr(fmt.Sprintf("(%s) < %g", distance, todo.MaxSimilarityDistance), args...)).
 			Where(sq.Expr(
 				"set_config('hnsw.ef_search', '400', true) IS NOT NULL",
 			))
@@ -94,43 +213,35 @@ func (tr TodoRepository) ListTodos(ctx context.Context, page int, pageSize int,
 		})
 	}
 
-	qry, err := applySort(qry, params)
-	if telemetry.IsErrorRecorded(span, err) {
-		return nil, false, err
-	}
-
-	rows, err := qry.QueryContext(spanCtx)
-	if telemetry.IsErrorRecorded(span, err) {
-		return nil, false, err
+	if len(params.CustomFields) > 0 {
+		filterJSON, err := json.Marshal(params.CustomFields)
+		if err != nil {
+			return qry, err
+		}
+		qry = qry.Where(sq.Expr("custom_fields @> ?", filterJSON))
 	}
-	defer rows.Close() //nolint:errcheck
 
-	var todos []todo.Todo
-	for rows.Next() {
-		var td todo.Todo
-		err := rows.Scan(
-			&td.ID,
-			&td.Title,
-			&td.Status,
-			&td.DueDate,
-			&td.CreatedAt,
-			&td.UpdatedAt,
-		)
-		if telemetry.IsErrorRecorded(span, err) {
-			return nil, false, err
+	for _, excluded := range params.ExcludedCustomFields {
+		filterJSON, err := json.Marshal(excluded)
+		if err != nil {
+			return qry, err
 		}
-		todos = append(todos, td)
+		qry = qry.Where(sq.Expr("NOT custom_fields @> ?", filterJSON))
 	}
 
-	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
-		return nil, false, err
+	if params.Near != nil {
+		point := params.Near.Point
+		qry = qry.Where(sq.Expr(
+			"location_lat IS NOT NULL AND "+locationDistanceKm+" <= ?",
+			point.Latitude, point.Latitude, point.Longitude, params.Near.RadiusKm,
+		))
 	}
 
-	if len(todos) > pageSize {
-		todos = todos[:pageSize]
-		return todos, true, nil
+	if !params.IncludeArchived {
+		qry = qry.Where(sq.Eq{"archived_at": nil})
 	}
-	return todos, false, nil
+
+	return qry.Where(tenantEq(ctx)), nil
 }
 
 // applySort applies sorting to the given squirrel SelectBuilder based on the provided ListTodosParams.
@@ -144,42 +255,135 @@ func applySort(qry sq.SelectBuilder, params *todo.ListParams) (sq.SelectBuilder,
 	}
 
 	if params.SortBy.Field == "similarity" && len(params.Embedding) > 0 {
-		return qry.OrderByClause(sq.Expr(
-			"embedding <=> ? "+params.SortBy.Direction,
-			pgvector.NewVector(toFloat32Truncated(params.Embedding)),
-		)), nil
+		distance, args := embeddingDistance(params)
+		return qry.OrderByClause(sq.Expr(distance+" "+params.SortBy.Direction, args...)), nil
 	} else if params.SortBy.Field == "similarity" && len(params.Embedding) == 0 {
 		return qry, core.NewValidationErr("embedding must be provided for similarity sorting")
 	}
 
+	if params.SortBy.Field == "smart_order" {
+		if params.Today.IsZero() {
+			return qry, core.NewValidationErr("today must be provided for smart ordering")
+		}
+		today := params.Today.Format(time.DateOnly)
+		return qry.OrderByClause(sq.Expr(smartOrderScore+" DESC, due_date ASC, created_at ASC", today, today)), nil
+	}
+
 	orderClause := params.SortBy.Field + " " + params.SortBy.Direction
 	return qry.OrderBy(orderClause), nil
 }
 
+// smartOrderScore ranks todos by urgency. Open overdue todos score 4, which outweighs the rest. The priority
+// custom field adds up to 2, scaled by the position of the todo's value among the ENUM options, first being
+// highest; it adds nothing unless the tenant defines an ENUM field named priority. Due proximity adds
+// 1 / (1 + days until due) to open todos, so 1 for open todos due today or earlier. Both placeholders take
+// today's date from the app clock rather than the database one.
+const smartOrderScore = "(CASE WHEN status = 'OPEN' AND due_date < CAST(? AS date) THEN 4 ELSE 0 END + " +
+	"2 * COALESCE((SELECT 1 - (o.ord - 1)::float / jsonb_array_length(cf.options) " +
+	"FROM custom_fields cf, jsonb_array_elements_text(cf.options) WITH ORDINALITY AS o(value, ord) " +
+	"WHERE cf.tenant_id = todos.tenant_id AND cf.name = '" + todo.PriorityCustomField + "' AND cf.type = 'ENUM' " +
+	"AND o.value = todos.custom_fields->>'" + todo.PriorityCustomField + "'), 0) + " +
+	"CASE WHEN status = 'OPEN' THEN 1.0 / (1 + GREATEST(due_date - CAST(? AS date), 0)) ELSE 0 END)"
+
+// locationDistanceKm is the haversine distance, in kilometers, between the todo location and the point given
+// by its latitude, latitude again and longitude arguments.
+const locationDistanceKm = "(2 * 6371 * asin(LEAST(1, sqrt(" +
+	"power(sin(radians(location_lat - ?) / 2), 2) + " +
+	"cos(radians(?)) * cos(radians(location_lat)) * power(sin(radians(location_lon - ?) / 2), 2)))))"
+
+// embeddingDistance returns the cosine distance expression between the todos and the query embedding. With a
+// query model, each todo is compared through the embedding that model produced: the primary one, also assumed
+// for embeddings stored before their model was tracked, or else the secondary one. Todos with neither have a
+// NULL distance.
+func embeddingDistance(params *todo.ListParams) (string, []any) {
+	vector := pgvector.NewVector(toFloat32Truncated(params.Embedding))
+	if params.EmbeddingModel == "" {
+		return "embedding <=> ?", []any{vector}
+	}
+	return "(CASE WHEN embedding_model IS NULL OR embedding_model = ? THEN embedding " +
+			"WHEN secondary_embedding_model = ? THEN secondary_embedding END) <=> ?",
+		[]any{params.EmbeddingModel, params.EmbeddingModel, vector}
+}
+
+// validateEmbeddingDimensions checks that a query embedding of the model has the dimension of the embeddings
+// stored for that model, in either slot. Models without stored dimensions are not checked.
+func (tr TodoRepository) validateEmbeddingDimensions(ctx context.Context, model string, dimensions int) error {
+	var stored sql.NullInt64
+	err := tr.sb.
+		Select().
+		Column(sq.Expr(
+			"COALESCE("+
+				"(SELECT embedding_dimensions FROM todos WHERE embedding_model = ? AND embedding_dimensions IS NOT NULL AND tenant_id = ? LIMIT 1), "+
+				"(SELECT secondary_embedding_dimensions FROM todos WHERE secondary_embedding_model = ? AND secondary_embedding_dimensions IS NOT NULL AND tenant_id = ? LIMIT 1))",
+			model, tenantOf(ctx), model, tenantOf(ctx),
+		)).
+		QueryRowContext(ctx).
+		Scan(&stored)
+	if err != nil {
+		return err
+	}
+	if stored.Valid && int(stored.Int64) != dimensions {
+		return core.NewValidationErr(fmt.Sprintf(
+			"query embedding has %d dimensions, but %s embeddings are stored with %d", dimensions, model, stored.Int64,
+		))
+	}
+	return nil
+}
+
 // CreateTodo creates a new todo.
 func (tr TodoRepository) CreateTodo(ctx context.Context, td todo.Todo) error {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
-	_, err := tr.sb.
+	customFieldsJSON, err := encodeCustomFields(td.CustomFields)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	locationName, locationLat, locationLon := encodeLocation(td.Location)
+
+	_, err = tr.sb.
 		Insert("todos").
 		Columns(
 			"id",
 			"title",
 			"status",
 			"due_date",
+			"estimated_minutes",
 			"embedding",
+			"embedding_model",
+			"embedding_dimensions",
+			"secondary_embedding",
+			"secondary_embedding_model",
+			"secondary_embedding_dimensions",
 			"created_at",
 			"updated_at",
+			"custom_fields",
+			"location_name",
+			"location_lat",
+			"location_lon",
+			"recurrence_rule",
+			tenantColumn,
 		).
 		Values(
 			td.ID,
 			td.Title,
 			td.Status,
 			td.DueDate,
+			td.EstimatedMinutes,
 			pgvector.NewVector(toFloat32Truncated(td.Embedding)),
+			td.EmbeddingModel,
+			embeddingDimensions(td.Embedding),
+			nullableVector(td.SecondaryEmbedding),
+			nullableString(td.SecondaryEmbeddingModel),
+			embeddingDimensions(td.SecondaryEmbedding),
 			td.CreatedAt,
 			td.UpdatedAt,
+			customFieldsJSON,
+			locationName,
+			locationLat,
+			locationLon,
+			encodeRecurrence(td.Recurrence),
+			tenantOf(ctx),
 		).
 		ExecContext(spanCtx)
 
@@ -195,14 +399,38 @@ func (tr TodoRepository) UpdateTodo(ctx context.Context, td todo.Todo) error {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
-	_, err := tr.sb.
+	customFieldsJSON, err := encodeCustomFields(td.CustomFields)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	locationName, locationLat, locationLon := encodeLocation(td.Location)
+
+	qry := tr.sb.
 		Update("todos").
 		Set("title", td.Title).
 		Set("status", td.Status).
 		Set("due_date", td.DueDate).
+		Set("estimated_minutes", td.EstimatedMinutes).
 		Set("embedding", pgvector.NewVector(toFloat32Truncated(td.Embedding))).
+		Set("embedding_model", td.EmbeddingModel).
+		Set("embedding_dimensions", embeddingDimensions(td.Embedding))
+	// The secondary embedding is only written during a model migration; otherwise the stored one is kept.
+	if td.SecondaryEmbeddingModel != "" {
+		qry = qry.
+			Set("secondary_embedding", nullableVector(td.SecondaryEmbedding)).
+			Set("secondary_embedding_model", td.SecondaryEmbeddingModel).
+			Set("secondary_embedding_dimensions", embeddingDimensions(td.SecondaryEmbedding))
+	}
+	_, err = qry.
 		Set("updated_at", td.UpdatedAt).
+		Set("archived_at", td.ArchivedAt).
+		Set("custom_fields", customFieldsJSON).
+		Set("location_name", locationName).
+		Set("location_lat", locationLat).
+		Set("location_lon", locationLon).
+		Set("recurrence_rule", encodeRecurrence(td.Recurrence)).
 		Where(sq.Eq{"id": td.ID}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 
 	if telemetry.IsErrorRecorded(span, err) {
@@ -219,6 +447,7 @@ func (tr TodoRepository) DeleteTodo(ctx context.Context, id uuid.UUID) error {
 	_, err := tr.sb.
 		Delete("todos").
 		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
 		ExecContext(spanCtx)
 
 	if telemetry.IsErrorRecorded(span, err) {
@@ -232,34 +461,391 @@ func (tr TodoRepository) GetTodo(ctx context.Context, id uuid.UUID) (todo.Todo,
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
-	var td todo.Todo
-	err := tr.sb.
+	td, found, err := tr.getTodo(spanCtx, id, "")
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Todo{}, false, err
+	}
+	return td, found, nil
+}
+
+// LockTodo retrieves a todo by its ID with SELECT ... FOR UPDATE, so concurrent writers wait until the
+// surrounding transaction ends. It must run inside a unit of work.
+func (tr TodoRepository) LockTodo(ctx context.Context, id uuid.UUID) (todo.Todo, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	td, found, err := tr.getTodo(spanCtx, id, "FOR UPDATE")
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.Todo{}, false, err
+	}
+	return td, found, nil
+}
+
+// getTodo reads one todo, appending suffix to the query.
+func (tr TodoRepository) getTodo(ctx context.Context, id uuid.UUID, suffix string) (todo.Todo, bool, error) {
+	var (
+		td               todo.Todo
+		customFieldsJSON []byte
+		location         locationColumns
+		recurrenceRule   sql.NullString
+	)
+	query := tr.sb.
 		Select(
 			todoFields...,
 		).
 		From("todos").
 		Where(sq.Eq{"id": id}).
-		QueryRowContext(spanCtx).
+		Where(tenantEq(ctx))
+	if suffix != "" {
+		query = query.Suffix(suffix)
+	}
+	err := query.
+		QueryRowContext(ctx).
 		Scan(
 			&td.ID,
 			&td.Title,
 			&td.Status,
 			&td.DueDate,
+			&td.EstimatedMinutes,
 			&td.CreatedAt,
 			&td.UpdatedAt,
+			&td.ArchivedAt,
+			&customFieldsJSON,
+			&location.name,
+			&location.lat,
+			&location.lon,
+			&recurrenceRule,
 		)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return todo.Todo{}, false, nil
 	}
+	if err != nil {
+		return todo.Todo{}, false, err
+	}
 
-	if telemetry.IsErrorRecorded(span, err) {
+	td.CustomFields, err = decodeCustomFields(customFieldsJSON)
+	if err != nil {
+		return todo.Todo{}, false, err
+	}
+	td.Location = location.decode()
+	td.Recurrence, err = decodeRecurrence(recurrenceRule)
+	if err != nil {
 		return todo.Todo{}, false, err
 	}
 
 	return td, true, nil
 }
 
+// ArchiveCompletedTodos archives the active DONE todos last updated before doneBefore and returns their IDs.
+func (tr TodoRepository) ArchiveCompletedTodos(ctx context.Context, doneBefore time.Time, archivedAt time.Time) ([]uuid.UUID, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("done_before", doneBefore.Format(time.RFC3339)),
+	))
+	defer span.End()
+
+	rows, err := tr.sb.
+		Update("todos").
+		Set("archived_at", archivedAt).
+		Where(sq.Eq{"status": todo.Status_DONE}).
+		Where(sq.Eq{"archived_at": nil}).
+		Where(sq.Lt{"updated_at": doneBefore}).
+		Where(tenantEq(ctx)).
+		Suffix("RETURNING id").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ListStaleEmbeddingTodos lists the todos after the given ID whose embedding in the slot is missing or was
+// produced by another model, in ID order. Archived todos are included.
+func (tr TodoRepository) ListStaleEmbeddingTodos(ctx context.Context, slot todo.EmbeddingSlot, model string, after uuid.UUID, limit int) ([]todo.Todo, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("slot", string(slot)),
+		attribute.String("model", model),
+		attribute.Int("limit", limit),
+	))
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, core.NewValidationErr("limit must be greater than 0")
+	}
+	columns, err := embeddingColumnsOf(slot)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	rows, err := tr.sb.
+		Select(
+			todoFields...,
+		).
+		From("todos").
+		Where(staleEmbedding(columns, model)).
+		Where(sq.Gt{"id": after}).
+		Where(tenantEq(ctx)).
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	todos, err := scanTodos(rows)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	return todos, nil
+}
+
+// CountStaleEmbeddingTodos counts the todos whose embedding in the slot is missing or was produced by another model.
+func (tr TodoRepository) CountStaleEmbeddingTodos(ctx context.Context, slot todo.EmbeddingSlot, model string) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("slot", string(slot)),
+		attribute.String("model", model),
+	))
+	defer span.End()
+
+	columns, err := embeddingColumnsOf(slot)
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+
+	var count int
+	err = tr.sb.
+		Select("COUNT(*)").
+		From("todos").
+		Where(staleEmbedding(columns, model)).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx).
+		Scan(&count)
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+	return count, nil
+}
+
+// UpdateTodoEmbedding replaces the embedding of a todo in the slot. The update timestamp is left untouched, since
+// the todo itself did not change.
+func (tr TodoRepository) UpdateTodoEmbedding(ctx context.Context, id uuid.UUID, slot todo.EmbeddingSlot, embedding []float64, model string) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("todo_id", id.String()),
+		attribute.String("slot", string(slot)),
+		attribute.String("model", model),
+	))
+	defer span.End()
+
+	columns, err := embeddingColumnsOf(slot)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = tr.sb.
+		Update("todos").
+		Set(columns.vector, pgvector.NewVector(toFloat32Truncated(embedding))).
+		Set(columns.model, model).
+		Set(columns.dimensions, embeddingDimensions(embedding)).
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	return nil
+}
+
+// embeddingColumns names the columns holding one embedding slot of a todo.
+type embeddingColumns struct {
+	vector     string
+	model      string
+	dimensions string
+}
+
+// embeddingColumnsOf returns the columns of an embedding slot.
+func embeddingColumnsOf(slot todo.EmbeddingSlot) (embeddingColumns, error) {
+	switch slot {
+	case todo.EmbeddingSlot_Primary:
+		return embeddingColumns{vector: "embedding", model: "embedding_model", dimensions: "embedding_dimensions"}, nil
+	case todo.EmbeddingSlot_Secondary:
+		return embeddingColumns{
+			vector:     "secondary_embedding",
+			model:      "secondary_embedding_model",
+			dimensions: "secondary_embedding_dimensions",
+		}, nil
+	}
+	return embeddingColumns{}, slot.Validate()
+}
+
+// staleEmbedding matches the todos whose embedding in the columns is missing or was not produced by model.
+// Todos embedded before the model was tracked have no model and are matched too.
+func staleEmbedding(columns embeddingColumns, model string) sq.Sqlizer {
+	return sq.Or{
+		sq.Eq{columns.vector: nil},
+		sq.Expr(columns.model+" IS DISTINCT FROM ?", model),
+	}
+}
+
+// embeddingDimensions returns the dimension of an embedding as returned by its model, or nil without one.
+func embeddingDimensions(embedding []float64) *int {
+	if len(embedding) == 0 {
+		return nil
+	}
+	dimensions := len(embedding)
+	return &dimensions
+}
+
+// nullableVector converts an optional embedding to a vector, or nil without one.
+func nullableVector(embedding []float64) any {
+	if len(embedding) == 0 {
+		return nil
+	}
+	return pgvector.NewVector(toFloat32Truncated(embedding))
+}
+
+// nullableString returns nil for an empty string.
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// scanTodos scans the rows of a select of todoFields.
+func scanTodos(rows *sql.Rows) ([]todo.Todo, error) {
+	var todos []todo.Todo
+	for rows.Next() {
+		var (
+			td               todo.Todo
+			customFieldsJSON []byte
+			location         locationColumns
+			recurrenceRule   sql.NullString
+		)
+		err := rows.Scan(
+			&td.ID,
+			&td.Title,
+			&td.Status,
+			&td.DueDate,
+			&td.EstimatedMinutes,
+			&td.CreatedAt,
+			&td.UpdatedAt,
+			&td.ArchivedAt,
+			&customFieldsJSON,
+			&location.name,
+			&location.lat,
+			&location.lon,
+			&recurrenceRule,
+		)
+		if err != nil {
+			return nil, err
+		}
+		td.CustomFields, err = decodeCustomFields(customFieldsJSON)
+		if err != nil {
+			return nil, err
+		}
+		td.Location = location.decode()
+		td.Recurrence, err = decodeRecurrence(recurrenceRule)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, td)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return todos, nil
+}
+
+// encodeCustomFields marshals the custom field values of a todo, storing an empty object when none are set.
+func encodeCustomFields(values todo.CustomFieldValues) ([]byte, error) {
+	if values == nil {
+		values = todo.CustomFieldValues{}
+	}
+	return json.Marshal(values)
+}
+
+// decodeCustomFields unmarshals the stored custom field values of a todo. Todos without values get a nil map.
+func decodeCustomFields(data []byte) (todo.CustomFieldValues, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var values todo.CustomFieldValues
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
+// locationColumns holds the nullable location columns of a todo row.
+type locationColumns struct {
+	name sql.NullString
+	lat  sql.NullFloat64
+	lon  sql.NullFloat64
+}
+
+// decode returns the location stored in the columns, or nil when the todo has none.
+func (c locationColumns) decode() *todo.Location {
+	if !c.name.Valid && !c.lat.Valid {
+		return nil
+	}
+	location := &todo.Location{Name: c.name.String}
+	if c.lat.Valid && c.lon.Valid {
+		location.Point = &todo.GeoPoint{Latitude: c.lat.Float64, Longitude: c.lon.Float64}
+	}
+	return location
+}
+
+// encodeLocation returns the values of the location columns, all NULL for a todo without location.
+func encodeLocation(location *todo.Location) (name *string, lat, lon *float64) {
+	if location == nil {
+		return nil, nil, nil
+	}
+	name = nullableString(location.Name)
+	if location.Point != nil {
+		lat, lon = &location.Point.Latitude, &location.Point.Longitude
+	}
+	return name, lat, lon
+}
+
+// decodeRecurrence parses the stored recurrence rule, or returns nil when the todo does not recur.
+func decodeRecurrence(rule sql.NullString) (*todo.Recurrence, error) {
+	if !rule.Valid {
+		return nil, nil
+	}
+	recurrence, err := todo.ParseRecurrence(rule.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode recurrence rule %q: %w", rule.String, err)
+	}
+	return &recurrence, nil
+}
+
+// encodeRecurrence returns the value of the recurrence_rule column, NULL for a todo that does not recur.
+func encodeRecurrence(recurrence *todo.Recurrence) *string {
+	if recurrence == nil {
+		return nil
+	}
+	rule := recurrence.String()
+	return &rule
+}
+
 // toFloat32Truncated converts a slice of float64 to a slice of float32, truncating to 768 dimensions if necessary.
 func toFloat32Truncated(input []float64) []float32 {
 	f32 := make([]float32, len(input))
@@ -271,3 +857,12 @@ func toFloat32Truncated(input []float64) []float32 {
 	}
 	return f32
 }
+
+// toFloat64 converts a slice of float32 to a slice of float64.
+func toFloat64(input []float32) []float64 {
+	f64 := make([]float64, len(input))
+	for i, v := range input {
+		f64[i] = float64(v)
+	}
+	return f64
+}
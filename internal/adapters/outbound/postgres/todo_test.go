@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
@@ -20,12 +22,29 @@ func TestTodoRepository_CreateTodo(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	fixedDueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
 	openTodo := todo.Todo{
-		ID:        fixedUUID,
-		Title:     "My new todo",
-		Status:    todo.Status_OPEN,
-		DueDate:   fixedDueDate,
-		CreatedAt: fixedTime,
-		UpdatedAt: fixedTime,
+		ID:               fixedUUID,
+		Title:            "My new todo",
+		Status:           todo.Status_OPEN,
+		DueDate:          fixedDueDate,
+		EstimatedMinutes: 45,
+		CreatedAt:        fixedTime,
+		UpdatedAt:        fixedTime,
+	}
+	migratingTodo := openTodo
+	migratingTodo.Embedding = []float64{0.1, 0.2}
+	migratingTodo.EmbeddingModel = "embedding-v1"
+	migratingTodo.SecondaryEmbedding = []float64{0.1, 0.2, 0.3}
+	migratingTodo.SecondaryEmbeddingModel = "embedding-v2"
+	locatedTodo := openTodo
+	locatedTodo.Location = &todo.Location{
+		Name:  "Downtown market",
+		Point: &todo.GeoPoint{Latitude: 38.7223, Longitude: -9.1393},
+	}
+	recurringTodo := openTodo
+	recurringTodo.Recurrence = &todo.Recurrence{
+		Frequency: todo.RecurrenceFrequency_WEEKLY,
+		Interval:  1,
+		Weekdays:  []time.Weekday{time.Monday},
 	}
 
 	tests := map[string]struct {
@@ -36,32 +55,141 @@ func TestTodoRepository_CreateTodo(t *testing.T) {
 		"success": {
 			td: openTodo,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,embedding,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)").
+				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,estimated_minutes,embedding,embedding_model,embedding_dimensions,secondary_embedding,secondary_embedding_model,secondary_embedding_dimensions,created_at,updated_at,custom_fields,location_name,location_lat,location_lon,recurrence_rule,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)").
 					WithArgs(
 						openTodo.ID,
 						openTodo.Title,
 						openTodo.Status,
 						openTodo.DueDate,
+						openTodo.EstimatedMinutes,
 						pgvector.NewVector(toFloat32Truncated(openTodo.Embedding)),
+						openTodo.EmbeddingModel,
+						nil,
+						nil,
+						nil,
+						nil,
 						openTodo.CreatedAt,
 						openTodo.UpdatedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+						tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			expectedErr: nil,
+		},
+		"with-location": {
+			td: locatedTodo,
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,estimated_minutes,embedding,embedding_model,embedding_dimensions,secondary_embedding,secondary_embedding_model,secondary_embedding_dimensions,created_at,updated_at,custom_fields,location_name,location_lat,location_lon,recurrence_rule,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)").
+					WithArgs(
+						locatedTodo.ID,
+						locatedTodo.Title,
+						locatedTodo.Status,
+						locatedTodo.DueDate,
+						locatedTodo.EstimatedMinutes,
+						pgvector.NewVector(toFloat32Truncated(locatedTodo.Embedding)),
+						locatedTodo.EmbeddingModel,
+						nil,
+						nil,
+						nil,
+						nil,
+						locatedTodo.CreatedAt,
+						locatedTodo.UpdatedAt,
+						[]byte("{}"),
+						"Downtown market",
+						38.7223,
+						-9.1393,
+						nil,
+						tenant.Default,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedErr: nil,
 		},
+		"with-recurrence": {
+			td: recurringTodo,
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,estimated_minutes,embedding,embedding_model,embedding_dimensions,secondary_embedding,secondary_embedding_model,secondary_embedding_dimensions,created_at,updated_at,custom_fields,location_name,location_lat,location_lon,recurrence_rule,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)").
+					WithArgs(
+						recurringTodo.ID,
+						recurringTodo.Title,
+						recurringTodo.Status,
+						recurringTodo.DueDate,
+						recurringTodo.EstimatedMinutes,
+						pgvector.NewVector(toFloat32Truncated(recurringTodo.Embedding)),
+						recurringTodo.EmbeddingModel,
+						nil,
+						nil,
+						nil,
+						nil,
+						recurringTodo.CreatedAt,
+						recurringTodo.UpdatedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						"FREQ=WEEKLY;BYDAY=MO",
+						tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"secondary-embedding": {
+			td: migratingTodo,
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,estimated_minutes,embedding,embedding_model,embedding_dimensions,secondary_embedding,secondary_embedding_model,secondary_embedding_dimensions,created_at,updated_at,custom_fields,location_name,location_lat,location_lon,recurrence_rule,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)").
+					WithArgs(
+						migratingTodo.ID,
+						migratingTodo.Title,
+						migratingTodo.Status,
+						migratingTodo.DueDate,
+						migratingTodo.EstimatedMinutes,
+						pgvector.NewVector(toFloat32Truncated(migratingTodo.Embedding)),
+						migratingTodo.EmbeddingModel,
+						2,
+						pgvector.NewVector(toFloat32Truncated(migratingTodo.SecondaryEmbedding)),
+						migratingTodo.SecondaryEmbeddingModel,
+						3,
+						migratingTodo.CreatedAt,
+						migratingTodo.UpdatedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+						tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
 		"database-error": {
 			td: openTodo,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,embedding,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)").
+				mock.ExpectExec("INSERT INTO todos (id,title,status,due_date,estimated_minutes,embedding,embedding_model,embedding_dimensions,secondary_embedding,secondary_embedding_model,secondary_embedding_dimensions,created_at,updated_at,custom_fields,location_name,location_lat,location_lon,recurrence_rule,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19)").
 					WithArgs(
 						openTodo.ID,
 						openTodo.Title,
 						openTodo.Status,
 						openTodo.DueDate,
+						openTodo.EstimatedMinutes,
 						pgvector.NewVector(toFloat32Truncated(openTodo.Embedding)),
+						openTodo.EmbeddingModel,
+						nil,
+						nil,
+						nil,
+						nil,
 						openTodo.CreatedAt,
 						openTodo.UpdatedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+						tenant.Default,
 					).
 					WillReturnError(errors.New("database error"))
 			},
@@ -92,12 +220,13 @@ func TestTodoRepository_GetTodo(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	fixedDueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
 	openTodo := todo.Todo{
-		ID:        fixedUUID,
-		Title:     "My todo",
-		Status:    todo.Status_OPEN,
-		DueDate:   fixedDueDate,
-		CreatedAt: fixedTime,
-		UpdatedAt: fixedTime,
+		ID:               fixedUUID,
+		Title:            "My todo",
+		Status:           todo.Status_OPEN,
+		DueDate:          fixedDueDate,
+		EstimatedMinutes: 45,
+		CreatedAt:        fixedTime,
+		UpdatedAt:        fixedTime,
 	}
 
 	tests := map[string]struct {
@@ -116,21 +245,84 @@ func TestTodoRepository_GetTodo(t *testing.T) {
 						openTodo.Title,
 						openTodo.Status,
 						openTodo.DueDate,
+						openTodo.EstimatedMinutes,
 						openTodo.CreatedAt,
 						openTodo.UpdatedAt,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE id = $1").
-					WithArgs(fixedUUID).
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(fixedUUID, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedTodo:  openTodo,
 			expectedFound: true,
 		},
+		"recurring": {
+			id: fixedUUID,
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						openTodo.ID,
+						openTodo.Title,
+						openTodo.Status,
+						openTodo.DueDate,
+						openTodo.EstimatedMinutes,
+						openTodo.CreatedAt,
+						openTodo.UpdatedAt,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						"FREQ=MONTHLY;INTERVAL=2",
+					)
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(fixedUUID, tenant.Default).
+					WillReturnRows(rows)
+			},
+			expectedTodo: func() todo.Todo {
+				td := openTodo
+				td.Recurrence = &todo.Recurrence{Frequency: todo.RecurrenceFrequency_MONTHLY, Interval: 2}
+				return td
+			}(),
+			expectedFound: true,
+		},
+		"invalid-recurrence-rule": {
+			id: fixedUUID,
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						openTodo.ID,
+						openTodo.Title,
+						openTodo.Status,
+						openTodo.DueDate,
+						openTodo.EstimatedMinutes,
+						openTodo.CreatedAt,
+						openTodo.UpdatedAt,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						"FREQ=YEARLY",
+					)
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(fixedUUID, tenant.Default).
+					WillReturnRows(rows)
+			},
+			expectedTodo: todo.Todo{},
+			expectedErr:  true,
+		},
 		"not-found": {
 			id: fixedUUID,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE id = $1").
-					WithArgs(fixedUUID).
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(fixedUUID, tenant.Default).
 					WillReturnError(sql.ErrNoRows)
 			},
 			expectedTodo: todo.Todo{},
@@ -138,8 +330,8 @@ func TestTodoRepository_GetTodo(t *testing.T) {
 		"database-error": {
 			id: fixedUUID,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE id = $1").
-					WithArgs(fixedUUID).
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(fixedUUID, tenant.Default).
 					WillReturnError(errors.New("database error"))
 			},
 			expectedTodo: todo.Todo{},
@@ -169,20 +361,111 @@ func TestTodoRepository_GetTodo(t *testing.T) {
 	}
 }
 
-func TestTodoRepository_UpdateTodo(t *testing.T) {
+func TestTodoRepository_LockTodo(t *testing.T) {
 	t.Parallel()
 
 	fixedUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
-	fixedDueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
-	doneTodo := todo.Todo{
+	openTodo := todo.Todo{
 		ID:        fixedUUID,
-		Title:     "Updated todo",
-		Status:    todo.Status_DONE,
-		DueDate:   fixedDueDate,
+		Title:     "My todo",
+		Status:    todo.Status_OPEN,
+		DueDate:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
 		CreatedAt: fixedTime,
 		UpdatedAt: fixedTime,
 	}
+	lockQuery := "SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE id = $1 AND tenant_id = $2 FOR UPDATE"
+
+	tests := map[string]struct {
+		setExpectations func(mock sqlmock.Sqlmock)
+		expectedTodo    todo.Todo
+		expectedFound   bool
+		expectedErr     bool
+	}{
+		"success": {
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						openTodo.ID,
+						openTodo.Title,
+						openTodo.Status,
+						openTodo.DueDate,
+						openTodo.EstimatedMinutes,
+						openTodo.CreatedAt,
+						openTodo.UpdatedAt,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+					)
+				mock.ExpectQuery(lockQuery).
+					WithArgs(fixedUUID, tenant.Default).
+					WillReturnRows(rows)
+			},
+			expectedTodo:  openTodo,
+			expectedFound: true,
+		},
+		"not-found": {
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(lockQuery).
+					WithArgs(fixedUUID, tenant.Default).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(lockQuery).
+					WithArgs(fixedUUID, tenant.Default).
+					WillReturnError(errors.New("database error"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() // nolint:errcheck
+
+			tt.setExpectations(mock)
+
+			repo := NewTodoRepository(db)
+			got, gotFound, gotErr := repo.LockTodo(t.Context(), fixedUUID)
+			if tt.expectedErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expectedFound, gotFound)
+				assert.Equal(t, tt.expectedTodo, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTodoRepository_UpdateTodo(t *testing.T) {
+	t.Parallel()
+
+	fixedUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fixedDueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	doneTodo := todo.Todo{
+		ID:               fixedUUID,
+		Title:            "Updated todo",
+		Status:           todo.Status_DONE,
+		DueDate:          fixedDueDate,
+		EstimatedMinutes: 45,
+		CreatedAt:        fixedTime,
+		UpdatedAt:        fixedTime,
+	}
+	migratingTodo := doneTodo
+	migratingTodo.Embedding = []float64{0.1, 0.2}
+	migratingTodo.EmbeddingModel = "embedding-v1"
+	migratingTodo.SecondaryEmbedding = []float64{0.1, 0.2, 0.3}
+	migratingTodo.SecondaryEmbeddingModel = "embedding-v2"
 
 	tests := map[string]struct {
 		setExpectations func(mock sqlmock.Sqlmock)
@@ -192,30 +475,78 @@ func TestTodoRepository_UpdateTodo(t *testing.T) {
 		"success": {
 			td: doneTodo,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE todos SET title = $1, status = $2, due_date = $3, embedding = $4, updated_at = $5 WHERE id = $6").
+				mock.ExpectExec("UPDATE todos SET title = $1, status = $2, due_date = $3, estimated_minutes = $4, embedding = $5, embedding_model = $6, embedding_dimensions = $7, updated_at = $8, archived_at = $9, custom_fields = $10, location_name = $11, location_lat = $12, location_lon = $13, recurrence_rule = $14 WHERE id = $15 AND tenant_id = $16").
 					WithArgs(
 						doneTodo.Title,
 						doneTodo.Status,
 						doneTodo.DueDate,
+						doneTodo.EstimatedMinutes,
 						pgvector.NewVector(toFloat32Truncated(doneTodo.Embedding)),
+						doneTodo.EmbeddingModel,
+						nil,
 						doneTodo.UpdatedAt,
+						doneTodo.ArchivedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 						doneTodo.ID,
+						tenant.Default,
 					).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			expectedErr: nil,
 		},
+		"secondary-embedding": {
+			td: migratingTodo,
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE todos SET title = $1, status = $2, due_date = $3, estimated_minutes = $4, embedding = $5, embedding_model = $6, embedding_dimensions = $7, secondary_embedding = $8, secondary_embedding_model = $9, secondary_embedding_dimensions = $10, updated_at = $11, archived_at = $12, custom_fields = $13, location_name = $14, location_lat = $15, location_lon = $16, recurrence_rule = $17 WHERE id = $18 AND tenant_id = $19").
+					WithArgs(
+						migratingTodo.Title,
+						migratingTodo.Status,
+						migratingTodo.DueDate,
+						migratingTodo.EstimatedMinutes,
+						pgvector.NewVector(toFloat32Truncated(migratingTodo.Embedding)),
+						migratingTodo.EmbeddingModel,
+						2,
+						pgvector.NewVector(toFloat32Truncated(migratingTodo.SecondaryEmbedding)),
+						migratingTodo.SecondaryEmbeddingModel,
+						3,
+						migratingTodo.UpdatedAt,
+						migratingTodo.ArchivedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+						migratingTodo.ID,
+						tenant.Default,
+					).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
 		"database-error": {
 			td: doneTodo,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE todos SET title = $1, status = $2, due_date = $3, embedding = $4, updated_at = $5 WHERE id = $6").
+				mock.ExpectExec("UPDATE todos SET title = $1, status = $2, due_date = $3, estimated_minutes = $4, embedding = $5, embedding_model = $6, embedding_dimensions = $7, updated_at = $8, archived_at = $9, custom_fields = $10, location_name = $11, location_lat = $12, location_lon = $13, recurrence_rule = $14 WHERE id = $15 AND tenant_id = $16").
 					WithArgs(
 						doneTodo.Title,
 						doneTodo.Status,
 						doneTodo.DueDate,
+						doneTodo.EstimatedMinutes,
 						pgvector.NewVector(toFloat32Truncated(doneTodo.Embedding)),
+						doneTodo.EmbeddingModel,
+						nil,
 						doneTodo.UpdatedAt,
+						doneTodo.ArchivedAt,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 						doneTodo.ID,
+						tenant.Default,
 					).
 					WillReturnError(errors.New("database error"))
 			},
@@ -267,18 +598,32 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 1",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					).
 					AddRow(
 						fixedUUID2,
 						"Todo 2",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE archived_at IS NULL AND tenant_id = $1 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
 					WillReturnRows(rows)
 			},
 			expectedTodos: []todo.Todo{
@@ -292,7 +637,7 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 			page:     1,
 			pageSize: 10,
 			setExpectations: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE archived_at IS NULL AND tenant_id = $1 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
 					WillReturnError(errors.New("database error"))
 			},
 			expectedTodos:   nil,
@@ -309,10 +654,17 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 3",
 						todo.Status_DONE,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos ORDER BY due_date ASC LIMIT 11 OFFSET 10").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE archived_at IS NULL AND tenant_id = $1 ORDER BY due_date ASC LIMIT 11 OFFSET 10").
 					WillReturnRows(rows)
 			},
 			expectedTodos: []todo.Todo{
@@ -349,26 +701,47 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 1",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					).
 					AddRow(
 						fixedUUID2,
 						"Todo 2",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					).
 					AddRow(
 						fixedUUID3,
 						"Todo 3",
 						todo.Status_DONE,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos ORDER BY due_date ASC LIMIT 3 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE archived_at IS NULL AND tenant_id = $1 ORDER BY due_date ASC LIMIT 3 OFFSET 0").
 					WillReturnRows(rows)
 			},
 			expectedTodos: []todo.Todo{
@@ -391,11 +764,18 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 3",
 						todo.Status_DONE,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE status = $1 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
-					WithArgs(todo.Status_DONE).
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE status = $1 AND archived_at IS NULL AND tenant_id = $2 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+					WithArgs(todo.Status_DONE, tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedTodos: []todo.Todo{
@@ -429,12 +809,20 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 2",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE (embedding <=> $1) < 0.5 AND set_config('hnsw.ef_search', '400', true) IS NOT NULL ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE (embedding <=> $1) < 0.5 AND set_config('hnsw.ef_search', '400', true) IS NOT NULL AND archived_at IS NULL AND tenant_id = $2 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
 					WithArgs(
 						pgvector.NewVector([]float32{0.1, 0.2, 0.3}),
+						tenant.Default,
 					).
 					WillReturnRows(rows)
 			},
@@ -457,17 +845,58 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Finish report",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE title ILIKE $1 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
-					WithArgs("%report%").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE title ILIKE $1 AND archived_at IS NULL AND tenant_id = $2 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+					WithArgs("%report%", tenant.Default).
 					WillReturnRows(rows)
 			},
 			expectedTodos: []todo.Todo{
 				{ID: fixedUUID1, Title: "Finish report", Status: todo.Status_OPEN, DueDate: fixedDueDate, CreatedAt: fixedTime, UpdatedAt: fixedTime},
 			},
 		},
+		"filter-by-near": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithNear(todo.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}, 2),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						fixedUUID1,
+						"Pick up package",
+						todo.Status_OPEN,
+						fixedDueDate,
+						0,
+						fixedTime,
+						fixedTime,
+						nil,
+						[]byte("{}"),
+						"Post office",
+						38.7200,
+						-9.1400,
+						nil,
+					)
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE location_lat IS NOT NULL AND (2 * 6371 * asin(LEAST(1, sqrt(power(sin(radians(location_lat - $1) / 2), 2) + cos(radians($2)) * cos(radians(location_lat)) * power(sin(radians(location_lon - $3) / 2), 2))))) <= $4 AND archived_at IS NULL AND tenant_id = $5 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+					WithArgs(38.7223, 38.7223, -9.1393, 2.0, tenant.Default).
+					WillReturnRows(rows)
+			},
+			expectedTodos: []todo.Todo{
+				{
+					ID: fixedUUID1, Title: "Pick up package", Status: todo.Status_OPEN, DueDate: fixedDueDate, CreatedAt: fixedTime, UpdatedAt: fixedTime,
+					Location: &todo.Location{Name: "Post office", Point: &todo.GeoPoint{Latitude: 38.7200, Longitude: -9.1400}},
+				},
+			},
+		},
 		"filter-by-due-date-range": {
 			page:     1,
 			pageSize: 10,
@@ -484,13 +913,21 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 2",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE (due_date >= $1 AND due_date <= $2) ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE (due_date >= $1 AND due_date <= $2) AND archived_at IS NULL AND tenant_id = $3 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
 					WithArgs(
 						time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
 						time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+						tenant.Default,
 					).
 					WillReturnRows(rows)
 			},
@@ -513,18 +950,32 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 2",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					).
 					AddRow(
 						fixedUUID1,
 						"Todo 1",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos ORDER BY created_at ASC LIMIT 11 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE archived_at IS NULL AND tenant_id = $1 ORDER BY created_at ASC LIMIT 11 OFFSET 0").
 					WillReturnRows(rows)
 			},
 			expectedTodos: []todo.Todo{
@@ -534,6 +985,43 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 			expectedHasMore: false,
 			expectedErr:     false,
 		},
+		"sort-by-smart-order": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithStatus(todo.Status_OPEN),
+				todo.WithSortBy(todo.SortSmartOrder),
+				todo.WithToday(fixedTime),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						fixedUUID1,
+						"Todo 1",
+						todo.Status_OPEN,
+						fixedDueDate,
+						0,
+						fixedTime,
+						fixedTime,
+						nil,
+						[]byte(`{"priority":"high"}`),
+						nil,
+						nil,
+						nil,
+						nil,
+					)
+				query, err := sq.Dollar.ReplacePlaceholders("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE status = ? AND archived_at IS NULL AND tenant_id = ? ORDER BY " + smartOrderScore + " DESC, due_date ASC, created_at ASC LIMIT 11 OFFSET 0")
+				assert.NoError(t, err)
+				mock.ExpectQuery(query).
+					WithArgs(todo.Status_OPEN, tenant.Default, "2024-01-01", "2024-01-01").
+					WillReturnRows(rows)
+			},
+			expectedTodos: []todo.Todo{
+				{ID: fixedUUID1, Title: "Todo 1", Status: todo.Status_OPEN, DueDate: fixedDueDate, CreatedAt: fixedTime, UpdatedAt: fixedTime, CustomFields: todo.CustomFieldValues{"priority": "high"}},
+			},
+			expectedHasMore: false,
+			expectedErr:     false,
+		},
 		"sort-by-similarity": {
 			page:     1,
 			pageSize: 10,
@@ -548,20 +1036,35 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 						"Todo 2",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					).
 					AddRow(
 						fixedUUID1,
 						"Todo 1",
 						todo.Status_OPEN,
 						fixedDueDate,
+						0,
 						fixedTime,
 						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
 					)
-				mock.ExpectQuery("SELECT id, title, status, due_date, created_at, updated_at FROM todos WHERE (embedding <=> $1) < 0.5 AND set_config('hnsw.ef_search', '400', true) IS NOT NULL ORDER BY embedding <=> $2 ASC LIMIT 11 OFFSET 0").
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE (embedding <=> $1) < 0.5 AND set_config('hnsw.ef_search', '400', true) IS NOT NULL AND archived_at IS NULL AND tenant_id = $2 ORDER BY embedding <=> $3 ASC LIMIT 11 OFFSET 0").
 					WithArgs(
 						pgvector.NewVector([]float32{0.1, 0.2, 0.3}),
+						tenant.Default,
 						pgvector.NewVector([]float32{0.1, 0.2, 0.3}),
 					).
 					WillReturnRows(rows)
@@ -573,34 +1076,194 @@ func TestTodoRepository_ListTodos(t *testing.T) {
 			expectedHasMore: false,
 			expectedErr:     false,
 		},
-		"no-embedding-for-similarity-sort": {
+		"sort-by-similarity-with-embedding-model": {
 			page:     1,
 			pageSize: 10,
 			opts: []todo.ListOption{
+				todo.WithEmbedding([]float64{0.1, 0.2, 0.3}),
+				todo.WithEmbeddingModel("embedding-v2"),
 				todo.WithSortBy("similarityAsc"),
 			},
 			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COALESCE((SELECT embedding_dimensions FROM todos WHERE embedding_model = $1 AND embedding_dimensions IS NOT NULL AND tenant_id = $2 LIMIT 1), (SELECT secondary_embedding_dimensions FROM todos WHERE secondary_embedding_model = $3 AND secondary_embedding_dimensions IS NOT NULL AND tenant_id = $4 LIMIT 1))").
+					WithArgs("embedding-v2", tenant.Default, "embedding-v2", tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"dimensions"}).AddRow(3))
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						fixedUUID1,
+						"Todo 1",
+						todo.Status_OPEN,
+						fixedDueDate,
+						0,
+						fixedTime,
+						fixedTime,
+						nil,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+					)
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE ((CASE WHEN embedding_model IS NULL OR embedding_model = $1 THEN embedding WHEN secondary_embedding_model = $2 THEN secondary_embedding END) <=> $3) < 0.5 AND set_config('hnsw.ef_search', '400', true) IS NOT NULL AND archived_at IS NULL AND tenant_id = $4 ORDER BY (CASE WHEN embedding_model IS NULL OR embedding_model = $5 THEN embedding WHEN secondary_embedding_model = $6 THEN secondary_embedding END) <=> $7 ASC LIMIT 11 OFFSET 0").
+					WithArgs(
+						"embedding-v2",
+						"embedding-v2",
+						pgvector.NewVector([]float32{0.1, 0.2, 0.3}),
+						tenant.Default,
+						"embedding-v2",
+						"embedding-v2",
+						pgvector.NewVector([]float32{0.1, 0.2, 0.3}),
+					).
+					WillReturnRows(rows)
+			},
+			expectedTodos: []todo.Todo{
+				{ID: fixedUUID1, Title: "Todo 1", Status: todo.Status_OPEN, DueDate: fixedDueDate, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+			},
+		},
+		"embedding-dimensions-mismatch": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithEmbedding([]float64{0.1, 0.2, 0.3}),
+				todo.WithEmbeddingModel("embedding-v2"),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COALESCE((SELECT embedding_dimensions FROM todos WHERE embedding_model = $1 AND embedding_dimensions IS NOT NULL AND tenant_id = $2 LIMIT 1), (SELECT secondary_embedding_dimensions FROM todos WHERE secondary_embedding_model = $3 AND secondary_embedding_dimensions IS NOT NULL AND tenant_id = $4 LIMIT 1))").
+					WithArgs("embedding-v2", tenant.Default, "embedding-v2", tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"dimensions"}).AddRow(1024))
+			},
+			expectedErr: true,
+		},
+		"include-archived": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithStatus(todo.Status_DONE),
+				todo.WithIncludeArchived(),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						fixedUUID1,
+						"Todo 1",
+						todo.Status_DONE,
+						fixedDueDate,
+						0,
+						fixedTime,
+						fixedTime,
+						fixedTime,
+						[]byte("{}"),
+						nil,
+						nil,
+						nil,
+						nil,
+					)
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE status = $1 AND tenant_id = $2 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+					WithArgs(todo.Status_DONE, tenant.Default).
+					WillReturnRows(rows)
+			},
+			expectedTodos: []todo.Todo{
+				{ID: fixedUUID1, Title: "Todo 1", Status: todo.Status_DONE, DueDate: fixedDueDate, CreatedAt: fixedTime, UpdatedAt: fixedTime, ArchivedAt: &fixedTime},
 			},
-			expectedTodos:   nil,
 			expectedHasMore: false,
-			expectedErr:     true,
+			expectedErr:     false,
 		},
-		"invalid-sort-by": {
+		"custom-field-filter": {
 			page:     1,
 			pageSize: 10,
 			opts: []todo.ListOption{
-				todo.WithSortBy("invalidSort"),
+				todo.WithCustomFields(todo.CustomFieldValues{"area": "work"}),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(todoFields).
+					AddRow(
+						fixedUUID1,
+						"Todo 1",
+						todo.Status_OPEN,
+						fixedDueDate,
+						0,
+						fixedTime,
+						fixedTime,
+						nil,
+						[]byte(`{"area":"work","points":3}`),
+						nil,
+						nil,
+						nil,
+						nil,
+					)
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE custom_fields @> $1 AND archived_at IS NULL AND tenant_id = $2 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+					WithArgs([]byte(`{"area":"work"}`), tenant.Default).
+					WillReturnRows(rows)
+			},
+			expectedTodos: []todo.Todo{
+				{
+					ID:           fixedUUID1,
+					Title:        "Todo 1",
+					Status:       todo.Status_OPEN,
+					DueDate:      fixedDueDate,
+					CreatedAt:    fixedTime,
+					UpdatedAt:    fixedTime,
+					CustomFields: todo.CustomFieldValues{"area": "work", "points": float64(3)},
+				},
+			},
+			expectedHasMore: false,
+			expectedErr:     false,
+		},
+		"excluded-custom-fields": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithoutCustomFields([]todo.CustomFieldValues{{"area": "errands"}, {"area": "home"}}),
 			},
 			setExpectations: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE NOT custom_fields @> $1 AND NOT custom_fields @> $2 AND archived_at IS NULL AND tenant_id = $3 ORDER BY due_date ASC LIMIT 11 OFFSET 0").
+					WithArgs([]byte(`{"area":"errands"}`), []byte(`{"area":"home"}`), tenant.Default).
+					WillReturnRows(sqlmock.NewRows(todoFields))
 			},
 			expectedTodos:   nil,
 			expectedHasMore: false,
-			expectedErr:     true,
+			expectedErr:     false,
 		},
-	}
-
-	for name, tt := range tests {
-		t.Run(name, func(t *testing.T) {
+		"no-embedding-for-similarity-sort": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithSortBy("similarityAsc"),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+			},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     true,
+		},
+		"invalid-sort-by": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithSortBy("invalidSort"),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+			},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     true,
+		},
+		"smart-order-without-today": {
+			page:     1,
+			pageSize: 10,
+			opts: []todo.ListOption{
+				todo.WithSortBy(todo.SortSmartOrder),
+			},
+			setExpectations: func(mock sqlmock.Sqlmock) {
+			},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
 			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 			assert.NoError(t, err)
 			defer db.Close() // nolint:errcheck
@@ -632,16 +1295,16 @@ func TestTodoRepository_DeleteTodo(t *testing.T) {
 	}{
 		"success": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM todos WHERE id = $1").
-					WithArgs(id).
+				m.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(id, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			err: false,
 		},
 		"db-error": {
 			expect: func(m sqlmock.Sqlmock) {
-				m.ExpectExec("DELETE FROM todos WHERE id = $1").
-					WithArgs(id).
+				m.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(id, tenant.Default).
 					WillReturnError(errors.New("db error"))
 			},
 			err: true,
@@ -669,3 +1332,437 @@ func TestTodoRepository_DeleteTodo(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoRepository_ArchiveCompletedTodos(t *testing.T) {
+	t.Parallel()
+
+	doneBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	archivedAt := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	id1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	id2 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	archiveQuery := "UPDATE todos SET archived_at = $1 WHERE status = $2 AND archived_at IS NULL AND updated_at < $3 AND tenant_id = $4 RETURNING id"
+
+	tests := map[string]struct {
+		expect  func(sqlmock.Sqlmock)
+		wantIDs []uuid.UUID
+		err     bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(archiveQuery).
+					WithArgs(archivedAt, todo.Status_DONE, doneBefore, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id1).AddRow(id2))
+			},
+			wantIDs: []uuid.UUID{id1, id2},
+		},
+		"nothing-to-archive": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(archiveQuery).
+					WithArgs(archivedAt, todo.Status_DONE, doneBefore, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			wantIDs: []uuid.UUID{},
+		},
+		"db-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(archiveQuery).
+					WithArgs(archivedAt, todo.Status_DONE, doneBefore, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTodoRepository(db)
+			got, gotErr := repo.ArchiveCompletedTodos(t.Context(), doneBefore, archivedAt)
+
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.wantIDs, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTodoRepository_CountTodos(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		limit    int
+		opts     []todo.ListOption
+		expect   func(sqlmock.Sqlmock)
+		expected int
+		err      bool
+	}{
+		"success": {
+			limit: 1000,
+			opts:  []todo.ListOption{todo.WithStatus(todo.Status_OPEN)},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("SELECT COUNT(*) FROM (SELECT 1 FROM todos WHERE status = $1 AND archived_at IS NULL AND tenant_id = $2 LIMIT 1000) AS matched").
+					WithArgs(todo.Status_OPEN, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+			},
+			expected: 42,
+		},
+		"similarity-search": {
+			limit: 1000,
+			opts:  []todo.ListOption{todo.WithEmbedding([]float64{0.1, 0.2, 0.3})},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("SELECT COUNT(*) FROM (SELECT 1 FROM todos WHERE (embedding <=> $1) < 0.5 AND set_config('hnsw.ef_search', '400', true) IS NOT NULL AND archived_at IS NULL AND tenant_id = $2 LIMIT 1000) AS matched").
+					WithArgs(pgvector.NewVector([]float32{0.1, 0.2, 0.3}), tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+			},
+			expected: 7,
+		},
+		"invalid-limit": {
+			limit:  0,
+			expect: func(sqlmock.Sqlmock) {},
+			err:    true,
+		},
+		"invalid-status": {
+			limit:  1000,
+			opts:   []todo.ListOption{todo.WithStatus("UNKNOWN")},
+			expect: func(sqlmock.Sqlmock) {},
+			err:    true,
+		},
+		"db-error": {
+			limit: 1000,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("SELECT COUNT(*) FROM (SELECT 1 FROM todos WHERE archived_at IS NULL AND tenant_id = $1 LIMIT 1000) AS matched").
+					WithArgs(tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTodoRepository(db)
+			got, gotErr := repo.CountTodos(t.Context(), tt.limit, tt.opts...)
+
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTodoRepository_ScoreTodos(t *testing.T) {
+	t.Parallel()
+
+	id1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	id2 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	embedding := todo.WithEmbedding([]float64{0.1, 0.2, 0.3})
+
+	tests := map[string]struct {
+		ids      []uuid.UUID
+		opts     []todo.ListOption
+		expect   func(sqlmock.Sqlmock)
+		expected map[uuid.UUID]float64
+		err      bool
+	}{
+		"success": {
+			ids:  []uuid.UUID{id1, id2},
+			opts: []todo.ListOption{embedding},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("SELECT id, 1 - (embedding <=> $1) FROM todos WHERE id IN ($2,$3) AND tenant_id = $4").
+					WithArgs(pgvector.NewVector([]float32{0.1, 0.2, 0.3}), id1, id2, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "score"}).AddRow(id1, 0.82).AddRow(id2, nil))
+			},
+			expected: map[uuid.UUID]float64{id1: 0.82},
+		},
+		"with-embedding-model": {
+			ids:  []uuid.UUID{id1},
+			opts: []todo.ListOption{embedding, todo.WithEmbeddingModel("embedding-v2")},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("SELECT id, 1 - ((CASE WHEN embedding_model IS NULL OR embedding_model = $1 THEN embedding WHEN secondary_embedding_model = $2 THEN secondary_embedding END) <=> $3) FROM todos WHERE id IN ($4) AND tenant_id = $5").
+					WithArgs("embedding-v2", "embedding-v2", pgvector.NewVector([]float32{0.1, 0.2, 0.3}), id1, tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "score"}).AddRow(id1, 0.6))
+			},
+			expected: map[uuid.UUID]float64{id1: 0.6},
+		},
+		"no-todos": {
+			opts:     []todo.ListOption{embedding},
+			expect:   func(sqlmock.Sqlmock) {},
+			expected: map[uuid.UUID]float64{},
+		},
+		"no-embedding": {
+			ids:    []uuid.UUID{id1},
+			expect: func(sqlmock.Sqlmock) {},
+			err:    true,
+		},
+		"db-error": {
+			ids:  []uuid.UUID{id1},
+			opts: []todo.ListOption{embedding},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("SELECT id, 1 - (embedding <=> $1) FROM todos WHERE id IN ($2) AND tenant_id = $3").
+					WithArgs(pgvector.NewVector([]float32{0.1, 0.2, 0.3}), id1, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTodoRepository(db)
+			got, gotErr := repo.ScoreTodos(t.Context(), tt.ids, tt.opts...)
+
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTodoRepository_ListStaleEmbeddingTodos(t *testing.T) {
+	t.Parallel()
+
+	after := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	id1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	staleQuery := "SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE (embedding IS NULL OR embedding_model IS DISTINCT FROM $1) AND id > $2 AND tenant_id = $3 ORDER BY id ASC LIMIT 50"
+	secondaryQuery := "SELECT id, title, status, due_date, estimated_minutes, created_at, updated_at, archived_at, custom_fields, location_name, location_lat, location_lon, recurrence_rule FROM todos WHERE (secondary_embedding IS NULL OR secondary_embedding_model IS DISTINCT FROM $1) AND id > $2 AND tenant_id = $3 ORDER BY id ASC LIMIT 50"
+
+	tests := map[string]struct {
+		slot     todo.EmbeddingSlot
+		limit    int
+		expect   func(sqlmock.Sqlmock)
+		expected []todo.Todo
+		err      bool
+	}{
+		"success": {
+			slot:  todo.EmbeddingSlot_Primary,
+			limit: 50,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(staleQuery).
+					WithArgs("embedding-v2", after, tenant.Default).
+					WillReturnRows(sqlmock.NewRows(todoFields).
+						AddRow(id1, "Imported todo", todo.Status_OPEN, dueDate, 0, fixedTime, fixedTime, nil, []byte("{}"), nil, nil, nil, nil))
+			},
+			expected: []todo.Todo{
+				{ID: id1, Title: "Imported todo", Status: todo.Status_OPEN, DueDate: dueDate, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+			},
+		},
+		"secondary-slot": {
+			slot:  todo.EmbeddingSlot_Secondary,
+			limit: 50,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(secondaryQuery).
+					WithArgs("embedding-v2", after, tenant.Default).
+					WillReturnRows(sqlmock.NewRows(todoFields))
+			},
+		},
+		"invalid-limit": {
+			slot:   todo.EmbeddingSlot_Primary,
+			limit:  0,
+			expect: func(sqlmock.Sqlmock) {},
+			err:    true,
+		},
+		"invalid-slot": {
+			slot:   todo.EmbeddingSlot("tertiary"),
+			limit:  50,
+			expect: func(sqlmock.Sqlmock) {},
+			err:    true,
+		},
+		"db-error": {
+			slot:  todo.EmbeddingSlot_Primary,
+			limit: 50,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(staleQuery).
+					WithArgs("embedding-v2", after, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTodoRepository(db)
+			got, gotErr := repo.ListStaleEmbeddingTodos(t.Context(), tt.slot, "embedding-v2", after, tt.limit)
+
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTodoRepository_CountStaleEmbeddingTodos(t *testing.T) {
+	t.Parallel()
+
+	countQuery := "SELECT COUNT(*) FROM todos WHERE (embedding IS NULL OR embedding_model IS DISTINCT FROM $1) AND tenant_id = $2"
+	secondaryQuery := "SELECT COUNT(*) FROM todos WHERE (secondary_embedding IS NULL OR secondary_embedding_model IS DISTINCT FROM $1) AND tenant_id = $2"
+
+	tests := map[string]struct {
+		slot     todo.EmbeddingSlot
+		expect   func(sqlmock.Sqlmock)
+		expected int
+		err      bool
+	}{
+		"success": {
+			slot: todo.EmbeddingSlot_Primary,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(countQuery).
+					WithArgs("embedding-v2", tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1200))
+			},
+			expected: 1200,
+		},
+		"secondary-slot": {
+			slot: todo.EmbeddingSlot_Secondary,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(secondaryQuery).
+					WithArgs("embedding-v2", tenant.Default).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(300))
+			},
+			expected: 300,
+		},
+		"db-error": {
+			slot: todo.EmbeddingSlot_Primary,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(countQuery).
+					WithArgs("embedding-v2", tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTodoRepository(db)
+			got, gotErr := repo.CountStaleEmbeddingTodos(t.Context(), tt.slot, "embedding-v2")
+
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTodoRepository_UpdateTodoEmbedding(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	embedding := []float64{0.1, 0.2, 0.3}
+	updateQuery := "UPDATE todos SET embedding = $1, embedding_model = $2, embedding_dimensions = $3 WHERE id = $4 AND tenant_id = $5"
+	secondaryQuery := "UPDATE todos SET secondary_embedding = $1, secondary_embedding_model = $2, secondary_embedding_dimensions = $3 WHERE id = $4 AND tenant_id = $5"
+
+	tests := map[string]struct {
+		slot   todo.EmbeddingSlot
+		expect func(sqlmock.Sqlmock)
+		err    bool
+	}{
+		"success": {
+			slot: todo.EmbeddingSlot_Primary,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(updateQuery).
+					WithArgs(pgvector.NewVector(toFloat32Truncated(embedding)), "embedding-v2", 3, id, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"secondary-slot": {
+			slot: todo.EmbeddingSlot_Secondary,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(secondaryQuery).
+					WithArgs(pgvector.NewVector(toFloat32Truncated(embedding)), "embedding-v2", 3, id, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"invalid-slot": {
+			slot:   todo.EmbeddingSlot("tertiary"),
+			expect: func(sqlmock.Sqlmock) {},
+			err:    true,
+		},
+		"db-error": {
+			slot: todo.EmbeddingSlot_Primary,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(updateQuery).
+					WithArgs(pgvector.NewVector(toFloat32Truncated(embedding)), "embedding-v2", 3, id, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			err: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewTodoRepository(db)
+			gotErr := repo.UpdateTodoEmbedding(t.Context(), id, tt.slot, embedding, "embedding-v2")
+
+			if tt.err {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var tokenFields = []string{
+	"id",
+	"principal",
+	"role",
+	"user_id",
+	"name",
+	"scopes",
+	"token_hash",
+	"created_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+// TokenRepository implements the access.TokenRepository interface using PostgreSQL as the storage backend.
+type TokenRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewTokenRepository creates a new instance of TokenRepository.
+func NewTokenRepository(br sq.BaseRunner) TokenRepository {
+	return TokenRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateToken stores a new token.
+func (r TokenRepository) CreateToken(ctx context.Context, token access.PersonalAccessToken) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("personal_access_tokens").
+		Columns(tokenFields...).
+		Columns(tenantColumn).
+		Values(
+			token.ID,
+			token.Principal,
+			token.Role,
+			uuid.NullUUID{UUID: token.UserID, Valid: token.UserID != uuid.Nil},
+			token.Name,
+			pq.Array(scopeStrings(token.Scopes)),
+			token.TokenHash,
+			token.CreatedAt,
+			token.LastUsedAt,
+			token.RevokedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateToken stores the last use and revocation of an existing token.
+func (r TokenRepository) UpdateToken(ctx context.Context, token access.PersonalAccessToken) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("personal_access_tokens").
+		Set("last_used_at", token.LastUsedAt).
+		Set("revoked_at", token.RevokedAt).
+		Where(sq.Eq{"id": token.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// TouchToken records the last use of a token that has not been revoked. Only last_used_at is
+// written so a concurrent revocation is never undone.
+func (r TokenRepository) TouchToken(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("personal_access_tokens").
+		Set("last_used_at", lastUsedAt).
+		Where(sq.Eq{"id": id, "revoked_at": nil}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetToken retrieves a token by its ID.
+func (r TokenRepository) GetToken(ctx context.Context, id uuid.UUID) (access.PersonalAccessToken, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	token, found, err := r.getToken(spanCtx, sq.Eq{"id": id})
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.PersonalAccessToken{}, false, err
+	}
+
+	return token, found, nil
+}
+
+// GetTokenByHash retrieves the token with the hash.
+func (r TokenRepository) GetTokenByHash(ctx context.Context, tokenHash string) (access.PersonalAccessToken, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	token, found, err := r.getToken(spanCtx, sq.Eq{"token_hash": tokenHash})
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.PersonalAccessToken{}, false, err
+	}
+
+	return token, found, nil
+}
+
+// ListActiveTokens lists the tokens of the principal that are not revoked, newest first.
+func (r TokenRepository) ListActiveTokens(ctx context.Context, principal string) ([]access.PersonalAccessToken, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(tokenFields...).
+		From("personal_access_tokens").
+		Where(sq.Eq{"principal": principal, "revoked_at": nil}).
+		Where(tenantEq(ctx)).
+		OrderBy("created_at DESC").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	tokens := []access.PersonalAccessToken{}
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// getToken retrieves the first token matching the predicate.
+func (r TokenRepository) getToken(ctx context.Context, pred sq.Sqlizer) (access.PersonalAccessToken, bool, error) {
+	token, err := scanToken(r.sb.
+		Select(tokenFields...).
+		From("personal_access_tokens").
+		Where(pred).
+		Where(tenantEq(ctx)).
+		QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return access.PersonalAccessToken{}, false, nil
+	}
+	if err != nil {
+		return access.PersonalAccessToken{}, false, err
+	}
+	return token, true, nil
+}
+
+// scanToken reads a personal access token row.
+func scanToken(row sq.RowScanner) (access.PersonalAccessToken, error) {
+	var (
+		token  access.PersonalAccessToken
+		userID uuid.NullUUID
+		scopes pq.StringArray
+	)
+	if err := row.Scan(
+		&token.ID,
+		&token.Principal,
+		&token.Role,
+		&userID,
+		&token.Name,
+		&scopes,
+		&token.TokenHash,
+		&token.CreatedAt,
+		&token.LastUsedAt,
+		&token.RevokedAt,
+	); err != nil {
+		return access.PersonalAccessToken{}, err
+	}
+	token.UserID = userID.UUID
+	token.Scopes = make([]access.Scope, len(scopes))
+	for i, scope := range scopes {
+		token.Scopes[i] = access.Scope(scope)
+	}
+	return token, nil
+}
+
+// scopeStrings returns the scopes as strings, to store them in a text array.
+func scopeStrings(scopes []access.Scope) []string {
+	values := make([]string, len(scopes))
+	for i, scope := range scopes {
+		values[i] = string(scope)
+	}
+	return values
+}
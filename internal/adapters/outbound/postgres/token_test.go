@@ -0,0 +1,291 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	fixedTokenID = uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	fixedTokenAt = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	fixedToken   = access.PersonalAccessToken{
+		ID:        fixedTokenID,
+		Principal: "ci",
+		Role:      access.RoleMember,
+		Name:      "backup script",
+		Scopes:    []access.Scope{access.ScopeTodosRead, access.ScopeChat},
+		TokenHash: "token-hash",
+		CreatedAt: fixedTokenAt,
+	}
+)
+
+func tokenRows() *sqlmock.Rows {
+	return sqlmock.NewRows(tokenFields).AddRow(
+		fixedToken.ID,
+		fixedToken.Principal,
+		fixedToken.Role,
+		nil,
+		fixedToken.Name,
+		"{todos:read,chat}",
+		fixedToken.TokenHash,
+		fixedToken.CreatedAt,
+		nil,
+		nil,
+	)
+}
+
+func TestTokenRepository_CreateToken(t *testing.T) {
+	t.Parallel()
+
+	query := "INSERT INTO personal_access_tokens (id,principal,role,user_id,name,scopes,token_hash,created_at,last_used_at,revoked_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)"
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(
+				fixedToken.ID, fixedToken.Principal, fixedToken.Role, uuid.NullUUID{}, fixedToken.Name,
+				pq.Array([]string{"todos:read", "chat"}), fixedToken.TokenHash, fixedToken.CreatedAt, nil, nil,
+				tenant.Default,
+			)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			err = NewTokenRepository(db).CreateToken(t.Context(), fixedToken)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRepository_UpdateToken(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE personal_access_tokens SET last_used_at = $1, revoked_at = $2 WHERE id = $3 AND tenant_id = $4"
+	usedAt := fixedTokenAt.Add(time.Minute)
+	revokedAt := fixedTokenAt.Add(time.Hour)
+	revoked := fixedToken
+	revoked.LastUsedAt = &usedAt
+	revoked.RevokedAt = &revokedAt
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(revoked.LastUsedAt, revoked.RevokedAt, revoked.ID, tenant.Default)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err = NewTokenRepository(db).UpdateToken(t.Context(), revoked)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRepository_TouchToken(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE personal_access_tokens SET last_used_at = $1 WHERE id = $2 AND revoked_at IS NULL AND tenant_id = $3"
+	lastUsedAt := fixedTokenAt.Add(time.Hour)
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(lastUsedAt, fixedTokenID, tenant.Default)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err = NewTokenRepository(db).TouchToken(t.Context(), fixedTokenID, lastUsedAt)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRepository_GetToken(t *testing.T) {
+	t.Parallel()
+
+	selectQuery := "SELECT id, principal, role, user_id, name, scopes, token_hash, created_at, last_used_at, revoked_at FROM personal_access_tokens WHERE "
+
+	tests := map[string]struct {
+		get          func(TokenRepository) (access.PersonalAccessToken, bool, error)
+		expect       func(sqlmock.Sqlmock)
+		expected     access.PersonalAccessToken
+		expectedFind bool
+		expectErr    bool
+	}{
+		"by-id": {
+			get: func(r TokenRepository) (access.PersonalAccessToken, bool, error) {
+				return r.GetToken(t.Context(), fixedTokenID)
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"id = $1 AND tenant_id = $2").
+					WithArgs(fixedTokenID, tenant.Default).WillReturnRows(tokenRows())
+			},
+			expected:     fixedToken,
+			expectedFind: true,
+		},
+		"by-hash": {
+			get: func(r TokenRepository) (access.PersonalAccessToken, bool, error) {
+				return r.GetTokenByHash(t.Context(), "token-hash")
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"token_hash = $1 AND tenant_id = $2").
+					WithArgs("token-hash", tenant.Default).WillReturnRows(tokenRows())
+			},
+			expected:     fixedToken,
+			expectedFind: true,
+		},
+		"not-found": {
+			get: func(r TokenRepository) (access.PersonalAccessToken, bool, error) {
+				return r.GetToken(t.Context(), fixedTokenID)
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"id = $1 AND tenant_id = $2").
+					WithArgs(fixedTokenID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			get: func(r TokenRepository) (access.PersonalAccessToken, bool, error) {
+				return r.GetTokenByHash(t.Context(), "token-hash")
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(selectQuery+"token_hash = $1 AND tenant_id = $2").
+					WithArgs("token-hash", tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			got, found, err := tt.get(NewTokenRepository(db))
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTokenRepository_ListActiveTokens(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT id, principal, role, user_id, name, scopes, token_hash, created_at, last_used_at, revoked_at FROM personal_access_tokens WHERE principal = $1 AND revoked_at IS NULL AND tenant_id = $2 ORDER BY created_at DESC"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []access.PersonalAccessToken
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("ci", tenant.Default).WillReturnRows(tokenRows())
+			},
+			expected: []access.PersonalAccessToken{fixedToken},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("ci", tenant.Default).WillReturnRows(sqlmock.NewRows(tokenFields))
+			},
+			expected: []access.PersonalAccessToken{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs("ci", tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			got, err := NewTokenRepository(db).ListActiveTokens(t.Context(), "ci")
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -27,10 +27,15 @@ func NewUnitOfWork(db *sql.DB) *UnitOfWork {
 }
 
 // Execute opens a transaction, runs fn, and commits or rolls back.
+// Inside a sandbox it runs fn in a savepoint of the sandbox transaction instead.
 func (u *UnitOfWork) Execute(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
+	if tx, ok := sandboxTx(spanCtx); ok {
+		return executeInSavepoint(spanCtx, tx, &UnitOfWork{db: u.db, tx: tx}, fn)
+	}
+
 	tx, err := u.db.BeginTx(spanCtx, nil)
 	if err != nil {
 		return err
@@ -57,6 +62,21 @@ func (u *UnitOfWork) Todo() todo.Repository {
 	return NewTodoRepository(u.getBaseRunner())
 }
 
+// TimeEntry returns a todo time entry repository bound to the current runner.
+func (u *UnitOfWork) TimeEntry() todo.TimeEntryRepository {
+	return NewTimeEntryRepository(u.getBaseRunner())
+}
+
+// Comment returns a todo comment repository bound to the current runner.
+func (u *UnitOfWork) Comment() todo.CommentRepository {
+	return NewCommentRepository(u.getBaseRunner())
+}
+
+// Change returns a todo change log repository bound to the current runner.
+func (u *UnitOfWork) Change() todo.ChangeRepository {
+	return NewChangeRepository(u.getBaseRunner())
+}
+
 // Conversation returns a conversation repository bound to the current runner.
 func (u *UnitOfWork) Conversation() assistant.ConversationRepository {
 	return NewConversationRepository(u.getBaseRunner())
@@ -67,6 +87,11 @@ func (u *UnitOfWork) ChatMessage() assistant.ChatMessageRepository {
 	return NewChatMessageRepository(u.getBaseRunner())
 }
 
+// ContentBlob returns a chat content blob repository bound to the current runner.
+func (u *UnitOfWork) ContentBlob() assistant.ContentBlobRepository {
+	return NewContentBlobRepository(u.getBaseRunner())
+}
+
 // Outbox returns an outbox repository bound to the current runner.
 func (u *UnitOfWork) Outbox() outbox.Repository {
 	return NewOutboxRepository(u.getBaseRunner())
@@ -77,6 +102,26 @@ func (u *UnitOfWork) ConversationSummary() assistant.ConversationSummaryReposito
 	return NewConversationSummaryRepository(u.getBaseRunner())
 }
 
+// ConversationSnapshot returns a conversation snapshot repository bound to the current runner.
+func (u *UnitOfWork) ConversationSnapshot() assistant.ConversationSnapshotRepository {
+	return NewConversationSnapshotRepository(u.getBaseRunner())
+}
+
+// WeeklyReview returns a weekly review repository bound to the current runner.
+func (u *UnitOfWork) WeeklyReview() todo.WeeklyReviewRepository {
+	return NewWeeklyReviewRepository(u.getBaseRunner())
+}
+
+// Project returns a project repository bound to the current runner.
+func (u *UnitOfWork) Project() todo.ProjectRepository {
+	return NewProjectRepository(u.getBaseRunner())
+}
+
+// CustomField returns a custom field definition repository bound to the current runner.
+func (u *UnitOfWork) CustomField() todo.CustomFieldRepository {
+	return NewCustomFieldRepository(u.getBaseRunner())
+}
+
 // getBaseRunner picks the transaction runner when available, otherwise the DB handle.
 func (u *UnitOfWork) getBaseRunner() squirrel.BaseRunner {
 	if u.tx != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -26,8 +27,8 @@ func TestUnitOfWork_Execute(t *testing.T) {
 		"success-commit": {
 			setupMock: func(m sqlmock.Sqlmock) {
 				m.ExpectBegin()
-				m.ExpectExec("DELETE FROM todos WHERE id = $1").
-					WithArgs(todoID).
+				m.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(todoID, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				m.ExpectCommit()
 			},
@@ -39,8 +40,8 @@ func TestUnitOfWork_Execute(t *testing.T) {
 		"success-rollback-on-error": {
 			setupMock: func(m sqlmock.Sqlmock) {
 				m.ExpectBegin()
-				m.ExpectExec("DELETE FROM todos WHERE id = $1").
-					WithArgs(todoID).
+				m.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(todoID, tenant.Default).
 					WillReturnError(errors.New("delete error"))
 				m.ExpectRollback()
 			},
@@ -61,8 +62,8 @@ func TestUnitOfWork_Execute(t *testing.T) {
 		"commit-error": {
 			setupMock: func(m sqlmock.Sqlmock) {
 				m.ExpectBegin()
-				m.ExpectExec("DELETE FROM todos WHERE id = $1").
-					WithArgs(todoID).
+				m.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(todoID, tenant.Default).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				m.ExpectCommit().WillReturnError(errors.New("commit error"))
 			},
@@ -74,8 +75,8 @@ func TestUnitOfWork_Execute(t *testing.T) {
 		"rollback-error-with-original-error": {
 			setupMock: func(m sqlmock.Sqlmock) {
 				m.ExpectBegin()
-				m.ExpectExec("DELETE FROM todos WHERE id = $1").
-					WithArgs(todoID).
+				m.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+					WithArgs(todoID, tenant.Default).
 					WillReturnError(errors.New("delete error"))
 				m.ExpectRollback().WillReturnError(errors.New("rollback error"))
 			},
@@ -122,6 +123,34 @@ func TestUnitOfWork_Todo(t *testing.T) {
 	assert.IsType(t, TodoRepository{}, repo)
 }
 
+func TestUnitOfWork_TimeEntry(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	repo := uow.TimeEntry()
+
+	assert.NotNil(t, repo)
+	assert.IsType(t, TimeEntryRepository{}, repo)
+}
+
+func TestUnitOfWork_Comment(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	repo := uow.Comment()
+
+	assert.NotNil(t, repo)
+	assert.IsType(t, CommentRepository{}, repo)
+}
+
 func TestUnitOfWork_Outbox(t *testing.T) {
 	t.Parallel()
 
@@ -164,6 +193,20 @@ func TestUnitOfWork_ChatMessage(t *testing.T) {
 	assert.IsType(t, ChatMessageRepository{}, chatMessage)
 }
 
+func TestUnitOfWork_ContentBlob(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	contentBlob := uow.ContentBlob()
+
+	assert.NotNil(t, contentBlob)
+	assert.IsType(t, ContentBlobRepository{}, contentBlob)
+}
+
 func TestUnitOfWork_ConversationSummary(t *testing.T) {
 	t.Parallel()
 
@@ -178,6 +221,62 @@ func TestUnitOfWork_ConversationSummary(t *testing.T) {
 	assert.IsType(t, ConversationSummaryRepository{}, summaryRepo)
 }
 
+func TestUnitOfWork_ConversationSnapshot(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	snapshotRepo := uow.ConversationSnapshot()
+
+	assert.NotNil(t, snapshotRepo)
+	assert.IsType(t, ConversationSnapshotRepository{}, snapshotRepo)
+}
+
+func TestUnitOfWork_WeeklyReview(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	reviewRepo := uow.WeeklyReview()
+
+	assert.NotNil(t, reviewRepo)
+	assert.IsType(t, WeeklyReviewRepository{}, reviewRepo)
+}
+
+func TestUnitOfWork_Project(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	projectRepo := uow.Project()
+
+	assert.NotNil(t, projectRepo)
+	assert.IsType(t, ProjectRepository{}, projectRepo)
+}
+
+func TestUnitOfWork_CustomField(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close() //nolint:errcheck
+
+	uow := NewUnitOfWork(db)
+	customFieldRepo := uow.CustomField()
+
+	assert.NotNil(t, customFieldRepo)
+	assert.IsType(t, CustomFieldRepository{}, customFieldRepo)
+}
+
 func TestUnitOfWork_getBaseRunner(t *testing.T) {
 	t.Parallel()
 
@@ -222,10 +321,10 @@ func TestUnitOfWork_TransactionIsolation(t *testing.T) {
 
 	// Simulate nested operations within transaction
 	mock.ExpectBegin()
-	mock.ExpectExec("DELETE FROM todos WHERE id = $1").
-		WithArgs(todoID).
+	mock.ExpectExec("DELETE FROM todos WHERE id = $1 AND tenant_id = $2").
+		WithArgs(todoID, tenant.Default).
 		WillReturnResult(sqlmock.NewResult(0, 1))
-	mock.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
+	mock.ExpectExec("INSERT INTO outbox_events (id,entity_type,entity_id,topic,event_type,payload,status,retry_count,max_retries,last_error,dedupe_key,available_at,processed_at,created_at,tenant_id,ordering_key) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) ON CONFLICT (dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING").
 		WithArgs(
 			sqlmock.AnyArg(),
 			string(outbox.EntityType_Todo),
@@ -241,6 +340,8 @@ func TestUnitOfWork_TransactionIsolation(t *testing.T) {
 			sqlmock.AnyArg(),
 			nil,
 			sqlmock.AnyArg(),
+			tenant.Default,
+			"todo:"+todoID.String(),
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
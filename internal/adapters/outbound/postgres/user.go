@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+var userFields = []string{
+	"id",
+	"issuer",
+	"subject",
+	"email",
+	"name",
+	"role",
+	"created_at",
+	"last_login_at",
+}
+
+// UserRepository implements the access.UserRepository interface using PostgreSQL as the storage backend.
+type UserRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewUserRepository creates a new instance of UserRepository.
+func NewUserRepository(br sq.BaseRunner) UserRepository {
+	return UserRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateUser stores a new user.
+func (r UserRepository) CreateUser(ctx context.Context, user access.User) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Insert("users").
+		Columns(userFields...).
+		Columns(tenantColumn).
+		Values(
+			user.ID,
+			user.Issuer,
+			user.Subject,
+			user.Email,
+			user.Name,
+			user.Role,
+			user.CreatedAt,
+			user.LastLoginAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateUser stores the email, name and last login of an existing user.
+func (r UserRepository) UpdateUser(ctx context.Context, user access.User) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Update("users").
+		Set("email", user.Email).
+		Set("name", user.Name).
+		Set("last_login_at", user.LastLoginAt).
+		Where(sq.Eq{"id": user.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetUserBySubject retrieves the user with the issuer and subject of an identity provider.
+func (r UserRepository) GetUserBySubject(ctx context.Context, issuer, subject string) (access.User, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var user access.User
+	err := r.sb.
+		Select(userFields...).
+		From("users").
+		Where(sq.Eq{"issuer": issuer, "subject": subject}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx).
+		Scan(
+			&user.ID,
+			&user.Issuer,
+			&user.Subject,
+			&user.Email,
+			&user.Name,
+			&user.Role,
+			&user.CreatedAt,
+			&user.LastLoginAt,
+		)
+	if errors.Is(err, sql.ErrNoRows) {
+		return access.User{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.User{}, false, err
+	}
+
+	return user, true, nil
+}
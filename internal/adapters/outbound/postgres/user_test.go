@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+)
+
+var fixedUser = access.User{
+	ID:          fixedUserID,
+	Issuer:      "https://idp.example.com",
+	Subject:     "248289761001",
+	Email:       "jane@example.com",
+	Name:        "Jane",
+	Role:        access.RoleMember,
+	CreatedAt:   time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+	LastLoginAt: time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC),
+}
+
+func TestUserRepository_CreateUser(t *testing.T) {
+	t.Parallel()
+
+	query := "INSERT INTO users (id,issuer,subject,email,name,role,created_at,last_login_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(
+				fixedUser.ID, fixedUser.Issuer, fixedUser.Subject, fixedUser.Email, fixedUser.Name,
+				fixedUser.Role, fixedUser.CreatedAt, fixedUser.LastLoginAt, tenant.Default,
+			)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			err = NewUserRepository(db).CreateUser(t.Context(), fixedUser)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_UpdateUser(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE users SET email = $1, name = $2, last_login_at = $3 WHERE id = $4 AND tenant_id = $5"
+
+	tests := map[string]struct {
+		execErr   error
+		expectErr bool
+	}{
+		"success":        {},
+		"database-error": {execErr: errors.New("db error"), expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			exp := mock.ExpectExec(query).WithArgs(fixedUser.Email, fixedUser.Name, fixedUser.LastLoginAt, fixedUser.ID, tenant.Default)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err = NewUserRepository(db).UpdateUser(t.Context(), fixedUser)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRepository_GetUserBySubject(t *testing.T) {
+	t.Parallel()
+
+	query := "SELECT id, issuer, subject, email, name, role, created_at, last_login_at FROM users WHERE issuer = $1 AND subject = $2 AND tenant_id = $3"
+
+	tests := map[string]struct {
+		expect       func(sqlmock.Sqlmock)
+		expected     access.User
+		expectedFind bool
+		expectErr    bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(userFields).AddRow(
+					fixedUser.ID, fixedUser.Issuer, fixedUser.Subject, fixedUser.Email, fixedUser.Name,
+					fixedUser.Role, fixedUser.CreatedAt, fixedUser.LastLoginAt,
+				)
+				m.ExpectQuery(query).WithArgs(fixedUser.Issuer, fixedUser.Subject, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     fixedUser,
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(fixedUser.Issuer, fixedUser.Subject, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(fixedUser.Issuer, fixedUser.Subject, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			got, found, err := NewUserRepository(db).GetUserBySubject(t.Context(), fixedUser.Issuer, fixedUser.Subject)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
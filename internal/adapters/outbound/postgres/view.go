@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+var viewFields = []string{
+	"id",
+	"name",
+	"filter",
+	"created_at",
+	"updated_at",
+}
+
+// viewFilterRecord is the JSON representation of a view filter in the filter column.
+type viewFilterRecord struct {
+	Status             *todo.Status `json:"status,omitempty"`
+	SearchBySimilarity *string      `json:"search_by_similarity,omitempty"`
+	SearchByTitle      *string      `json:"search_by_title,omitempty"`
+	SortBy             *string      `json:"sort_by,omitempty"`
+	DueAfter           *time.Time   `json:"due_after,omitempty"`
+	DueBefore          *time.Time   `json:"due_before,omitempty"`
+	DueFromDays        *int         `json:"due_from_days,omitempty"`
+	DueToDays          *int         `json:"due_to_days,omitempty"`
+	Query              *string      `json:"query,omitempty"`
+}
+
+// ViewRepository implements the todo.ViewRepository interface using PostgreSQL as the storage backend.
+type ViewRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewViewRepository creates a new instance of ViewRepository.
+func NewViewRepository(br sq.BaseRunner) ViewRepository {
+	return ViewRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateView stores a new view.
+func (r ViewRepository) CreateView(ctx context.Context, view todo.View) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	filterJSON, err := json.Marshal(viewFilterRecord(view.Filter))
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("todo_views").
+		Columns(viewFields...).
+		Columns(tenantColumn).
+		Values(
+			view.ID,
+			view.Name,
+			filterJSON,
+			view.CreatedAt,
+			view.UpdatedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateView updates the name and filter of an existing view.
+func (r ViewRepository) UpdateView(ctx context.Context, view todo.View) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	filterJSON, err := json.Marshal(viewFilterRecord(view.Filter))
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Update("todo_views").
+		Set("name", view.Name).
+		Set("filter", filterJSON).
+		Set("updated_at", view.UpdatedAt).
+		Where(sq.Eq{"id": view.ID}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteView deletes a view by its ID.
+func (r ViewRepository) DeleteView(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, err := r.sb.
+		Delete("todo_views").
+		Where(sq.Eq{"id": id}).
+		Where(tenantEq(ctx)).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetView retrieves a view by its ID.
+func (r ViewRepository) GetView(ctx context.Context, id uuid.UUID) (todo.View, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	view, found, err := r.getView(spanCtx, sq.Eq{"id": id})
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.View{}, false, err
+	}
+
+	return view, found, nil
+}
+
+// GetViewByName retrieves a view by its case-insensitive name.
+func (r ViewRepository) GetViewByName(ctx context.Context, name string) (todo.View, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	view, found, err := r.getView(spanCtx, sq.Expr("lower(name) = ?", strings.ToLower(strings.TrimSpace(name))))
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.View{}, false, err
+	}
+
+	return view, found, nil
+}
+
+// ListViews lists the saved views ordered by name.
+func (r ViewRepository) ListViews(ctx context.Context) ([]todo.View, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	rows, err := r.sb.
+		Select(viewFields...).
+		From("todo_views").
+		Where(tenantEq(ctx)).
+		OrderBy("lower(name)").
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	views := []todo.View{}
+	for rows.Next() {
+		view, err := scanView(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return views, nil
+}
+
+// getView retrieves the first view matching the predicate.
+func (r ViewRepository) getView(ctx context.Context, pred sq.Sqlizer) (todo.View, bool, error) {
+	view, err := scanView(r.sb.
+		Select(viewFields...).
+		From("todo_views").
+		Where(pred).
+		Where(tenantEq(ctx)).
+		QueryRowContext(ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.View{}, false, nil
+	}
+	if err != nil {
+		return todo.View{}, false, err
+	}
+	return view, true, nil
+}
+
+// scanView reads a view row, decoding its JSON filter.
+func scanView(row sq.RowScanner) (todo.View, error) {
+	var (
+		view       todo.View
+		filterJSON []byte
+	)
+	if err := row.Scan(
+		&view.ID,
+		&view.Name,
+		&filterJSON,
+		&view.CreatedAt,
+		&view.UpdatedAt,
+	); err != nil {
+		return todo.View{}, err
+	}
+
+	var filter viewFilterRecord
+	if err := json.Unmarshal(filterJSON, &filter); err != nil {
+		return todo.View{}, err
+	}
+	view.Filter = todo.ViewFilter(filter)
+	return view, nil
+}
@@ -0,0 +1,326 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewRepository_CreateView(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	view := todo.View{
+		ID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Name: "Work focus",
+		Filter: todo.ViewFilter{
+			Status:        common.Ptr(todo.Status_OPEN),
+			SearchByTitle: common.Ptr("work"),
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	query := "INSERT INTO todo_views (id,name,filter,created_at,updated_at,tenant_id) VALUES ($1,$2,$3,$4,$5,$6)"
+	filterJSON := []byte(`{"status":"OPEN","search_by_title":"work"}`)
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(view.ID, view.Name, filterJSON, view.CreatedAt, view.UpdatedAt, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(view.ID, view.Name, filterJSON, view.CreatedAt, view.UpdatedAt, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewViewRepository(db)
+			gotErr := repo.CreateView(t.Context(), view)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestViewRepository_UpdateView(t *testing.T) {
+	t.Parallel()
+
+	view := todo.View{
+		ID:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		Name:      "Next week",
+		Filter:    todo.ViewFilter{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)},
+		UpdatedAt: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+	}
+	query := "UPDATE todo_views SET name = $1, filter = $2, updated_at = $3 WHERE id = $4 AND tenant_id = $5"
+	filterJSON := []byte(`{"due_from_days":1,"due_to_days":7}`)
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(view.Name, filterJSON, view.UpdatedAt, view.ID, tenant.Default).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).
+					WithArgs(view.Name, filterJSON, view.UpdatedAt, view.ID, tenant.Default).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewViewRepository(db)
+			gotErr := repo.UpdateView(t.Context(), view)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestViewRepository_DeleteView(t *testing.T) {
+	t.Parallel()
+
+	viewID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	query := "DELETE FROM todo_views WHERE id = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(viewID, tenant.Default).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(viewID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewViewRepository(db)
+			gotErr := repo.DeleteView(t.Context(), viewID)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestViewRepository_GetView(t *testing.T) {
+	t.Parallel()
+
+	viewID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	byIDQuery := "SELECT id, name, filter, created_at, updated_at FROM todo_views WHERE id = $1 AND tenant_id = $2"
+	byNameQuery := "SELECT id, name, filter, created_at, updated_at FROM todo_views WHERE lower(name) = $1 AND tenant_id = $2"
+	expectedView := todo.View{
+		ID:        viewID,
+		Name:      "Work focus",
+		Filter:    todo.ViewFilter{Status: common.Ptr(todo.Status_OPEN), SortBy: common.Ptr("dueDateAsc")},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+
+	tests := map[string]struct {
+		byName       bool
+		expect       func(sqlmock.Sqlmock)
+		expected     todo.View
+		expectedFind bool
+		expectErr    bool
+	}{
+		"by-id": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(viewFields).
+					AddRow(viewID, "Work focus", []byte(`{"status":"OPEN","sort_by":"dueDateAsc"}`), createdAt, createdAt)
+				m.ExpectQuery(byIDQuery).WithArgs(viewID, tenant.Default).WillReturnRows(rows)
+			},
+			expected:     expectedView,
+			expectedFind: true,
+		},
+		"by-name": {
+			byName: true,
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(viewFields).
+					AddRow(viewID, "Work focus", []byte(`{"status":"OPEN","sort_by":"dueDateAsc"}`), createdAt, createdAt)
+				m.ExpectQuery(byNameQuery).WithArgs("work focus", tenant.Default).WillReturnRows(rows)
+			},
+			expected:     expectedView,
+			expectedFind: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(byIDQuery).WithArgs(viewID, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"invalid-filter-json": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(viewFields).
+					AddRow(viewID, "Work focus", []byte(`{`), createdAt, createdAt)
+				m.ExpectQuery(byIDQuery).WithArgs(viewID, tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(byIDQuery).WithArgs(viewID, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewViewRepository(db)
+			var (
+				got   todo.View
+				found bool
+			)
+			if tt.byName {
+				got, found, err = repo.GetViewByName(t.Context(), " Work Focus ")
+			} else {
+				got, found, err = repo.GetView(t.Context(), viewID)
+			}
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFind, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestViewRepository_ListViews(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	query := "SELECT id, name, filter, created_at, updated_at FROM todo_views WHERE tenant_id = $1 ORDER BY lower(name)"
+	firstID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	secondID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expected  []todo.View
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(viewFields).
+					AddRow(firstID, "Errands", []byte(`{"search_by_similarity":"errands"}`), createdAt, createdAt).
+					AddRow(secondID, "Work focus", []byte(`{}`), createdAt, createdAt)
+				m.ExpectQuery(query).WillReturnRows(rows)
+			},
+			expected: []todo.View{
+				{ID: firstID, Name: "Errands", Filter: todo.ViewFilter{SearchBySimilarity: common.Ptr("errands")}, CreatedAt: createdAt, UpdatedAt: createdAt},
+				{ID: secondID, Name: "Work focus", CreatedAt: createdAt, UpdatedAt: createdAt},
+			},
+		},
+		"empty": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(viewFields))
+			},
+			expected: []todo.View{},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewViewRepository(db)
+			got, err := repo.ListViews(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
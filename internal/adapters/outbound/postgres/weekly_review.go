@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var weeklyReviewFields = []string{
+	"id",
+	"conversation_id",
+	"period_start",
+	"period_end",
+	"planned",
+	"completed",
+	"slipped",
+	"report",
+	"model",
+	"generated_at",
+}
+
+// WeeklyReviewRepository implements the todo.WeeklyReviewRepository interface using PostgreSQL as the storage backend.
+type WeeklyReviewRepository struct {
+	sb sq.StatementBuilderType
+}
+
+// NewWeeklyReviewRepository creates a new instance of WeeklyReviewRepository.
+func NewWeeklyReviewRepository(br sq.BaseRunner) WeeklyReviewRepository {
+	return WeeklyReviewRepository{
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar).RunWith(br),
+	}
+}
+
+// CreateWeeklyReview stores a new weekly review.
+func (r WeeklyReviewRepository) CreateWeeklyReview(ctx context.Context, review todo.WeeklyReview) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("review_id", review.ID.String()),
+	))
+	defer span.End()
+
+	completedJSON, err := json.Marshal(review.Completed)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	slippedJSON, err := json.Marshal(review.Slipped)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	_, err = r.sb.
+		Insert("weekly_reviews").
+		Columns(weeklyReviewFields...).
+		Columns(tenantColumn).
+		Values(
+			review.ID,
+			review.ConversationID,
+			review.PeriodStart,
+			review.PeriodEnd,
+			review.Planned,
+			completedJSON,
+			slippedJSON,
+			review.Report,
+			review.Model,
+			review.GeneratedAt,
+			tenantOf(ctx),
+		).
+		ExecContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// GetWeeklyReviewByPeriod retrieves the review starting on the given day.
+func (r WeeklyReviewRepository) GetWeeklyReviewByPeriod(ctx context.Context, periodStart time.Time) (todo.WeeklyReview, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	review, err := scanWeeklyReview(r.sb.
+		Select(weeklyReviewFields...).
+		From("weekly_reviews").
+		Where(sq.Eq{"period_start": periodStart}).
+		Where(tenantEq(ctx)).
+		QueryRowContext(spanCtx))
+	if errors.Is(err, sql.ErrNoRows) {
+		return todo.WeeklyReview{}, false, nil
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return todo.WeeklyReview{}, false, err
+	}
+
+	return review, true, nil
+}
+
+// ListWeeklyReviews returns the reviews with pagination support, most recent period first.
+func (r WeeklyReviewRepository) ListWeeklyReviews(ctx context.Context, page int, pageSize int) ([]todo.WeeklyReview, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("page", page),
+		attribute.Int("page_size", pageSize),
+	))
+	defer span.End()
+
+	if page <= 0 {
+		err := core.NewValidationErr("page must be greater than 0")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, false, err
+	}
+	if pageSize <= 0 {
+		err := core.NewValidationErr("page_size must be greater than 0")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, false, err
+	}
+
+	rows, err := r.sb.
+		Select(weeklyReviewFields...).
+		From("weekly_reviews").
+		Where(tenantEq(ctx)).
+		OrderBy("period_start DESC").
+		Limit(uint64(pageSize + 1)).
+		Offset(uint64((page - 1) * pageSize)).
+		QueryContext(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	reviews := []todo.WeeklyReview{}
+	for rows.Next() {
+		review, err := scanWeeklyReview(rows)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, false, err
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	hasMore := false
+	if len(reviews) > pageSize {
+		hasMore = true
+		reviews = reviews[:pageSize]
+	}
+
+	return reviews, hasMore, nil
+}
+
+// scanWeeklyReview reads a weekly review row, decoding its JSON title lists.
+func scanWeeklyReview(row sq.RowScanner) (todo.WeeklyReview, error) {
+	var (
+		review        todo.WeeklyReview
+		completedJSON []byte
+		slippedJSON   []byte
+	)
+	if err := row.Scan(
+		&review.ID,
+		&review.ConversationID,
+		&review.PeriodStart,
+		&review.PeriodEnd,
+		&review.Planned,
+		&completedJSON,
+		&slippedJSON,
+		&review.Report,
+		&review.Model,
+		&review.GeneratedAt,
+	); err != nil {
+		return todo.WeeklyReview{}, err
+	}
+
+	if err := json.Unmarshal(completedJSON, &review.Completed); err != nil {
+		return todo.WeeklyReview{}, err
+	}
+	if err := json.Unmarshal(slippedJSON, &review.Slipped); err != nil {
+		return todo.WeeklyReview{}, err
+	}
+
+	return review, nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixtureWeeklyReview(id string, periodStart time.Time) todo.WeeklyReview {
+	return todo.WeeklyReview{
+		ID:             uuid.MustParse(id),
+		ConversationID: uuid.MustParse("923e4567-e89b-12d3-a456-426614174000"),
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodStart.AddDate(0, 0, 6),
+		Planned:        2,
+		Completed:      []string{"Write draft"},
+		Slipped:        []string{"Book flights"},
+		Report:         "Good week.",
+		Model:          "review-model",
+		GeneratedAt:    periodStart.AddDate(0, 0, 7),
+	}
+}
+
+func weeklyReviewRow(rows *sqlmock.Rows, review todo.WeeklyReview) *sqlmock.Rows {
+	return rows.AddRow(
+		review.ID,
+		review.ConversationID,
+		review.PeriodStart,
+		review.PeriodEnd,
+		review.Planned,
+		[]byte(`["Write draft"]`),
+		[]byte(`["Book flights"]`),
+		review.Report,
+		review.Model,
+		review.GeneratedAt,
+	)
+}
+
+func TestWeeklyReviewRepository_CreateWeeklyReview(t *testing.T) {
+	t.Parallel()
+
+	review := fixtureWeeklyReview("123e4567-e89b-12d3-a456-426614174000", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	query := "INSERT INTO weekly_reviews (id,conversation_id,period_start,period_end,planned,completed,slipped,report,model,generated_at,tenant_id) " +
+		"VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)"
+	args := []driver.Value{
+		review.ID, review.ConversationID, review.PeriodStart, review.PeriodEnd, review.Planned,
+		[]byte(`["Write draft"]`), []byte(`["Book flights"]`), review.Report, review.Model, review.GeneratedAt, tenant.Default,
+	}
+
+	tests := map[string]struct {
+		expect    func(sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		"success": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewWeeklyReviewRepository(db)
+			gotErr := repo.CreateWeeklyReview(t.Context(), review)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWeeklyReviewRepository_GetWeeklyReviewByPeriod(t *testing.T) {
+	t.Parallel()
+
+	periodStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	review := fixtureWeeklyReview("123e4567-e89b-12d3-a456-426614174000", periodStart)
+	query := "SELECT id, conversation_id, period_start, period_end, planned, completed, slipped, report, model, generated_at " +
+		"FROM weekly_reviews WHERE period_start = $1 AND tenant_id = $2"
+
+	tests := map[string]struct {
+		expect        func(sqlmock.Sqlmock)
+		expected      todo.WeeklyReview
+		expectedFound bool
+		expectErr     bool
+	}{
+		"found": {
+			expect: func(m sqlmock.Sqlmock) {
+				rows := weeklyReviewRow(sqlmock.NewRows(weeklyReviewFields), review)
+				m.ExpectQuery(query).WithArgs(periodStart, tenant.Default).WillReturnRows(rows)
+			},
+			expected:      review,
+			expectedFound: true,
+		},
+		"not-found": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(periodStart, tenant.Default).WillReturnError(sql.ErrNoRows)
+			},
+		},
+		"database-error": {
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(periodStart, tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewWeeklyReviewRepository(db)
+			got, found, err := repo.GetWeeklyReviewByPeriod(t.Context(), periodStart)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedFound, found)
+				assert.Equal(t, tt.expected, got)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWeeklyReviewRepository_ListWeeklyReviews(t *testing.T) {
+	t.Parallel()
+
+	r1 := fixtureWeeklyReview("123e4567-e89b-12d3-a456-426614174001", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC))
+	r2 := fixtureWeeklyReview("123e4567-e89b-12d3-a456-426614174002", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	query := "SELECT id, conversation_id, period_start, period_end, planned, completed, slipped, report, model, generated_at " +
+		"FROM weekly_reviews WHERE tenant_id = $1 ORDER BY period_start DESC LIMIT 2 OFFSET 0"
+
+	tests := map[string]struct {
+		page            int
+		pageSize        int
+		expect          func(sqlmock.Sqlmock)
+		expected        []todo.WeeklyReview
+		expectedHasMore bool
+		expectErr       bool
+	}{
+		"success-with-has-more": {
+			page:     1,
+			pageSize: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				rows := weeklyReviewRow(weeklyReviewRow(sqlmock.NewRows(weeklyReviewFields), r1), r2)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expected:        []todo.WeeklyReview{r1},
+			expectedHasMore: true,
+		},
+		"invalid-page": {
+			page:      0,
+			pageSize:  1,
+			expect:    func(sqlmock.Sqlmock) {},
+			expectErr: true,
+		},
+		"invalid-document-json": {
+			page:     1,
+			pageSize: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(weeklyReviewFields).
+					AddRow(r1.ID, r1.ConversationID, r1.PeriodStart, r1.PeriodEnd, r1.Planned, []byte(`{`), []byte(`[]`), r1.Report, r1.Model, r1.GeneratedAt)
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnRows(rows)
+			},
+			expectErr: true,
+		},
+		"database-error": {
+			page:     1,
+			pageSize: 1,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery(query).WithArgs(tenant.Default).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			assert.NoError(t, err)
+			defer db.Close() //nolint:errcheck
+
+			tt.expect(mock)
+
+			repo := NewWeeklyReviewRepository(db)
+			got, hasMore, gotErr := repo.ListWeeklyReviews(t.Context(), tt.page, tt.pageSize)
+			if tt.expectErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.expected, got)
+				assert.Equal(t, tt.expectedHasMore, hasMore)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
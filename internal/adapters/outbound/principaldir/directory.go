@@ -0,0 +1,118 @@
+package principaldir
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+)
+
+// minTokenLength keeps configured tokens long enough not to be guessed.
+const minTokenLength = 16
+
+// principalConfig is the JSON representation of one principal in the API_PRINCIPALS configuration.
+type principalConfig struct {
+	Name   string `json:"name"`
+	Token  string `json:"token"`
+	Role   string `json:"role"`
+	Tenant string `json:"tenant"`
+}
+
+// Credential pairs an API token with the principal presenting it.
+type Credential struct {
+	Token     string
+	Principal access.Principal
+}
+
+// ParsePrincipals parses the API_PRINCIPALS configuration, a JSON array such as
+// [{"name":"ops","token":"...","role":"admin"},{"name":"dashboard","token":"...","role":"readonly","tenant":"acme"}].
+// A principal with a tenant may only act in that tenant.
+func ParsePrincipals(raw string) ([]Credential, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var configs []principalConfig
+	if err := json.Unmarshal([]byte(raw), &configs); err != nil {
+		return nil, fmt.Errorf("invalid principals JSON: %w", err)
+	}
+
+	credentials := make([]Credential, 0, len(configs))
+	seenNames := map[string]bool{}
+	seenTokens := map[string]string{}
+	for _, c := range configs {
+		name := strings.TrimSpace(c.Name)
+		if name == "" {
+			return nil, errors.New("principal name must not be empty")
+		}
+		if seenNames[name] {
+			return nil, fmt.Errorf("principal %q is configured more than once", name)
+		}
+		seenNames[name] = true
+
+		role := access.Role(strings.ToLower(strings.TrimSpace(c.Role)))
+		if err := role.Validate(); err != nil {
+			return nil, fmt.Errorf("principal %q: %w", name, err)
+		}
+
+		tenantID := tenant.ID(strings.TrimSpace(c.Tenant))
+		if tenantID != "" {
+			if err := tenantID.Validate(); err != nil {
+				return nil, fmt.Errorf("principal %q: %w", name, err)
+			}
+		}
+
+		token := strings.TrimSpace(c.Token)
+		if len(token) < minTokenLength {
+			return nil, fmt.Errorf("principal %q: token must be at least %d characters", name, minTokenLength)
+		}
+		if owner, ok := seenTokens[token]; ok {
+			return nil, fmt.Errorf("principals %q and %q share a token", owner, name)
+		}
+		seenTokens[token] = name
+
+		credentials = append(credentials, Credential{
+			Token:     token,
+			Principal: access.Principal{Name: name, Role: role, Tenant: tenantID},
+		})
+	}
+
+	return credentials, nil
+}
+
+// Directory implements access.Authenticator over a static list of credentials.
+type Directory struct {
+	credentials []Credential
+}
+
+// NewDirectory creates a Directory. Without credentials, principals are not enabled and every
+// request runs as access.System.
+func NewDirectory(credentials []Credential) Directory {
+	return Directory{credentials: credentials}
+}
+
+// Enabled implements access.Authenticator.
+func (d Directory) Enabled() bool {
+	return len(d.credentials) > 0
+}
+
+// Authenticate implements access.Authenticator. Tokens are compared in constant time.
+func (d Directory) Authenticate(_ context.Context, token string) (access.Principal, error) {
+	if !d.Enabled() {
+		return access.System, nil
+	}
+
+	for _, c := range d.credentials {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) == 1 {
+			return c.Principal, nil
+		}
+	}
+	return access.Principal{}, core.NewUnauthorizedErr("missing or invalid API token")
+}
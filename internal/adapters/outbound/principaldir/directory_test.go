@@ -0,0 +1,136 @@
+package principaldir
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	opsToken    = "ops-0123456789abcdef"
+	viewerToken = "viewer-0123456789abcdef"
+)
+
+func TestParsePrincipals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		raw        string
+		want       []Credential
+		wantErrMsg string
+	}{
+		"empty": {
+			raw: "  ",
+		},
+		"valid": {
+			raw: `[
+				{"name":"ops","token":"` + opsToken + `","role":"admin"},
+				{"name":" viewer ","token":" ` + viewerToken + ` ","role":"ReadOnly","tenant":" acme "}
+			]`,
+			want: []Credential{
+				{Token: opsToken, Principal: access.Principal{Name: "ops", Role: access.RoleAdmin}},
+				{Token: viewerToken, Principal: access.Principal{Name: "viewer", Role: access.RoleReadonly, Tenant: "acme"}},
+			},
+		},
+		"invalid-tenant": {
+			raw:        `[{"name":"ops","token":"` + opsToken + `","role":"admin","tenant":"Acme Corp"}]`,
+			wantErrMsg: `principal "ops": `,
+		},
+		"invalid-json": {
+			raw:        `[{"name":}]`,
+			wantErrMsg: "invalid principals JSON",
+		},
+		"missing-name": {
+			raw:        `[{"token":"` + opsToken + `","role":"admin"}]`,
+			wantErrMsg: "principal name must not be empty",
+		},
+		"duplicate-name": {
+			raw:        `[{"name":"ops","token":"` + opsToken + `","role":"admin"},{"name":"ops","token":"` + viewerToken + `","role":"member"}]`,
+			wantErrMsg: `principal "ops" is configured more than once`,
+		},
+		"unknown-role": {
+			raw:        `[{"name":"ops","token":"` + opsToken + `","role":"owner"}]`,
+			wantErrMsg: `principal "ops": role must be one of admin, member or readonly`,
+		},
+		"short-token": {
+			raw:        `[{"name":"ops","token":"secret","role":"admin"}]`,
+			wantErrMsg: `principal "ops": token must be at least 16 characters`,
+		},
+		"shared-token": {
+			raw:        `[{"name":"ops","token":"` + opsToken + `","role":"admin"},{"name":"ci","token":"` + opsToken + `","role":"member"}]`,
+			wantErrMsg: `principals "ops" and "ci" share a token`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParsePrincipals(tt.raw)
+			if tt.wantErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDirectory_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	ops := access.Principal{Name: "ops", Role: access.RoleAdmin}
+	enabled := NewDirectory([]Credential{{Token: opsToken, Principal: ops}})
+
+	tests := map[string]struct {
+		directory   Directory
+		token       string
+		wantEnabled bool
+		want        access.Principal
+		wantErr     bool
+	}{
+		"disabled-runs-as-system": {
+			directory: NewDirectory(nil),
+			token:     "",
+			want:      access.System,
+		},
+		"known-token": {
+			directory:   enabled,
+			token:       opsToken,
+			wantEnabled: true,
+			want:        ops,
+		},
+		"unknown-token": {
+			directory:   enabled,
+			token:       viewerToken,
+			wantEnabled: true,
+			wantErr:     true,
+		},
+		"missing-token": {
+			directory:   enabled,
+			token:       "",
+			wantEnabled: true,
+			wantErr:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantEnabled, tt.directory.Enabled())
+			got, err := tt.directory.Authenticate(t.Context(), tt.token)
+			if tt.wantErr {
+				assert.IsType(t, &core.UnauthorizedErr{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package principaldir
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitAuthenticator is used to initialize and register the API principal directory.
+type InitAuthenticator struct {
+	Principals string `config:"API_PRINCIPALS" default:""`
+}
+
+// Initialize parses the configured principals and registers the directory in the dependency container.
+func (i InitAuthenticator) Initialize(ctx context.Context) (context.Context, error) {
+	credentials, err := ParsePrincipals(i.Principals)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse API_PRINCIPALS: %w", err)
+	}
+	depend.Register[access.Authenticator](NewDirectory(credentials))
+	return ctx, nil
+}
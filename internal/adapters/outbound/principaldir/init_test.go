@@ -0,0 +1,36 @@
+package principaldir
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitAuthenticator_Initialize(t *testing.T) {
+	i := InitAuthenticator{
+		Principals: `[{"name":"viewer","token":"` + viewerToken + `","role":"readonly"}]`,
+	}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[access.Authenticator]()
+	require.NoError(t, err)
+	assert.True(t, registered.Enabled())
+
+	got, err := registered.Authenticate(t.Context(), viewerToken)
+	require.NoError(t, err)
+	assert.Equal(t, access.RoleReadonly, got.Role)
+}
+
+func TestInitAuthenticator_Initialize_InvalidPrincipals(t *testing.T) {
+	i := InitAuthenticator{Principals: `{`}
+
+	_, err := i.Initialize(t.Context())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse API_PRINCIPALS")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	pubsubV2 "cloud.google.com/go/pubsub/v2"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
@@ -44,11 +45,33 @@ func (i *InitClient) Close() {
 
 // InitPublisher initializes the TodoEventPublisher implementation
 type InitPublisher struct {
-	Client *pubsubV2.Client `resolve:""`
+	Client       *pubsubV2.Client `resolve:""`
+	MaxBatchSize int              `config:"OUTBOX_RELAY_MAX_BATCH_SIZE" default:"100"`
+	BatchWindow  time.Duration    `config:"OUTBOX_RELAY_BATCH_WINDOW" default:"10ms"`
+	EventFormat  string           `config:"EVENT_FORMAT" default:"cloudevents"`
+	EventSource  string           `config:"EVENT_SOURCE" default:"/symbiont-ai-todoapp"`
+	publisher    *PubSubEventPublisher
 }
 
 // Initialize registers the PubSubEventPublisher as the implementation of TodoEventPublisher
 func (i *InitPublisher) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[outbox.EventPublisher](NewPubSubEventPublisher(i.Client))
+	format, err := ParseEventFormat(i.EventFormat)
+	if err != nil {
+		return ctx, err
+	}
+	i.publisher = NewPubSubEventPublisher(
+		i.Client,
+		BatchSettings{MaxBatchSize: i.MaxBatchSize, Window: i.BatchWindow},
+		Envelope{Format: format, Source: i.EventSource},
+	)
+	depend.Register[outbox.EventPublisher](i.publisher)
 	return ctx, nil
 }
+
+// Close flushes the buffered messages and stops the topic publishers.
+func (i *InitPublisher) Close() {
+	if i == nil || i.publisher == nil {
+		return
+	}
+	i.publisher.Stop()
+}
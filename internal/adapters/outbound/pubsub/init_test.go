@@ -48,6 +48,7 @@ func TestInitPublisher_Initialize(t *testing.T) {
 	init := &InitPublisher{
 		Client: &pubsubV2.Client{},
 	}
+	defer init.Close()
 
 	_, err := init.Initialize(t.Context())
 	assert.NoError(t, err)
@@ -56,3 +57,15 @@ func TestInitPublisher_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, res)
 }
+
+func TestInitPublisher_Initialize_InvalidEventFormat(t *testing.T) {
+	t.Parallel()
+
+	init := &InitPublisher{
+		Client:      &pubsubV2.Client{},
+		EventFormat: "avro",
+	}
+
+	_, err := init.Initialize(t.Context())
+	assert.Error(t, err)
+}
@@ -2,43 +2,188 @@ package pubsub
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
 	pubsubV2 "cloud.google.com/go/pubsub/v2"
+	"encoding/json"
+	"fmt"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"strings"
 )
 
-// PubSubEventPublisher implements outbox.EventPublisher using Google Cloud Pub/Sub
+// BatchSettings controls how messages are grouped into Pub/Sub publish requests.
+type BatchSettings struct {
+	// MaxBatchSize is the maximum number of messages sent in one publish request.
+	MaxBatchSize int
+	// Window is how long a publish request waits for more messages before it is sent.
+	Window time.Duration
+}
+
+// EventFormat selects how outbox events are encoded in published messages.
+type EventFormat string
+
+const (
+	// EventFormat_CloudEvents wraps the event payload in a CloudEvents 1.0 structured envelope.
+	EventFormat_CloudEvents EventFormat = "cloudevents"
+	// EventFormat_Legacy publishes the bare event payload.
+	EventFormat_Legacy EventFormat = "legacy"
+)
+
+// ParseEventFormat parses an event format, defaulting to CloudEvents when empty.
+func ParseEventFormat(value string) (EventFormat, error) {
+	switch format := EventFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "":
+		return EventFormat_CloudEvents, nil
+	case EventFormat_CloudEvents, EventFormat_Legacy:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported event format %q, expected %q or %q", value, EventFormat_CloudEvents, EventFormat_Legacy)
+	}
+}
+
+// Envelope controls how events are encoded in published messages.
+type Envelope struct {
+	// Format is the message encoding.
+	Format EventFormat
+	// Source is the CloudEvents source attribute of the published events.
+	Source string
+}
+
+// PubSubEventPublisher implements outbox.EventPublisher using Google Cloud Pub/Sub.
+// Messages are batched per topic and published with their ordering key, so subscriptions with
+// message ordering enabled receive the events of one conversation in order.
 type PubSubEventPublisher struct {
-	Client *pubsubV2.Client
+	Client   *pubsubV2.Client
+	batch    BatchSettings
+	envelope Envelope
+
+	mu         sync.Mutex
+	publishers map[outbox.Topic]*pubsubV2.Publisher
 }
 
 // NewPubSubEventPublisher creates a new instance of PubSubEventPublisher
-func NewPubSubEventPublisher(client *pubsubV2.Client) PubSubEventPublisher {
-	return PubSubEventPublisher{Client: client}
+func NewPubSubEventPublisher(client *pubsubV2.Client, batch BatchSettings, envelope Envelope) *PubSubEventPublisher {
+	return &PubSubEventPublisher{
+		Client:     client,
+		batch:      batch,
+		envelope:   envelope,
+		publishers: make(map[outbox.Topic]*pubsubV2.Publisher),
+	}
 }
 
 // PublishEvent publishes the given event to the appropriate Pub/Sub topic
-func (p PubSubEventPublisher) PublishEvent(ctx context.Context, event outbox.Event) error {
+func (p *PubSubEventPublisher) PublishEvent(ctx context.Context, event outbox.Event) error {
+	return p.PublishEvents(ctx, []outbox.Event{event})[0]
+}
+
+// PublishEvents publishes a batch of events and waits for every result. A failed event pauses its
+// ordering key in the Pub/Sub client, so later events with the same key fail too and are retried in order;
+// the key is resumed once the batch is done.
+func (p *PubSubEventPublisher) PublishEvents(ctx context.Context, events []outbox.Event) []error {
 	spanCtx, span := telemetry.StartSpan(ctx,
-		trace.WithAttributes(
-			attribute.String("event_id", event.ID.String()),
-			attribute.String("event_type", string(event.EventType)),
-			attribute.String("topic", string(event.Topic)),
-		),
+		trace.WithAttributes(attribute.Int("batch_size", len(events))),
 	)
 	defer span.End()
 
-	result := p.Client.Publisher(string(event.Topic)).Publish(spanCtx, &pubsubV2.Message{
-		Data: event.Payload,
+	start := time.Now()
+	errs := make([]error, len(events))
+	results := make([]*pubsubV2.PublishResult, len(events))
+	for i, event := range events {
+		msg, err := p.message(event)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		results[i] = p.publisher(event.Topic).Publish(spanCtx, msg)
+	}
+
+	paused := make(map[outbox.Topic]map[string]struct{})
+	for i, result := range results {
+		event := events[i]
+		if result != nil {
+			_, errs[i] = result.Get(spanCtx)
+		}
+		metrics.RecordOutboxPublish(spanCtx, string(event.Topic), errs[i] == nil, time.Since(start))
+		if errs[i] == nil || event.OrderingKey == "" {
+			continue
+		}
+		if paused[event.Topic] == nil {
+			paused[event.Topic] = make(map[string]struct{})
+		}
+		paused[event.Topic][event.OrderingKey] = struct{}{}
+	}
+
+	for topic, keys := range paused {
+		for key := range keys {
+			p.publisher(topic).ResumePublish(key)
+		}
+	}
+	telemetry.IsErrorRecorded(span, errors.Join(errs...))
+	return errs
+}
+
+// Stop sends the messages still buffered and stops every topic publisher.
+func (p *PubSubEventPublisher) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, publisher := range p.publishers {
+		publisher.Stop()
+	}
+	p.publishers = make(map[outbox.Topic]*pubsubV2.Publisher)
+}
+
+// publisher returns the publisher of topic, configuring batching and ordering on first use.
+func (p *PubSubEventPublisher) publisher(topic outbox.Topic) *pubsubV2.Publisher {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	publisher, ok := p.publishers[topic]
+	if !ok {
+		publisher = p.Client.Publisher(string(topic))
+		publisher.EnableMessageOrdering = true
+		if p.batch.MaxBatchSize > 0 {
+			publisher.PublishSettings.CountThreshold = p.batch.MaxBatchSize
+		}
+		if p.batch.Window > 0 {
+			publisher.PublishSettings.DelayThreshold = p.batch.Window
+		}
+		p.publishers[topic] = publisher
+	}
+	return publisher
+}
+
+// message maps an outbox event to a Pub/Sub message. The routing attributes are kept in both formats,
+// so subscription filters work whatever the payload encoding.
+func (p *PubSubEventPublisher) message(event outbox.Event) (*pubsubV2.Message, error) {
+	if event.TenantID == "" {
+		event.TenantID = tenant.Default
+	}
+
+	msg := &pubsubV2.Message{
+		Data:        event.Payload,
+		OrderingKey: event.OrderingKey,
 		Attributes: map[string]string{
 			"event_type": string(event.EventType),
 			"entity_id":  event.EntityID.String(),
+			"tenant_id":  string(event.TenantID),
 		},
-	})
+	}
+	if p.envelope.Format == EventFormat_Legacy {
+		return msg, nil
+	}
 
-	_, err := result.Get(ctx)
-	return err
+	data, err := json.Marshal(outbox.NewCloudEvent(event, p.envelope.Source))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cloudevent: %w", err)
+	}
+	msg.Data = data
+	msg.Attributes["content-type"] = outbox.CloudEventsContentType
+	return msg, nil
 }
@@ -25,20 +25,24 @@ func TestPubSubEventPublisher_PublishEvent(t *testing.T) {
 
 	tests := map[string]struct {
 		event           outbox.Event
+		envelope        Envelope
 		expectErr       bool
 		validateMessage func(*testing.T, *pubsubV2.Client, string)
 	}{
-		"success-publish-event": {
+		"success-publish-legacy-event": {
 			event: outbox.Event{
-				ID:         eventID,
-				EventType:  "TODO_CREATED",
-				EntityID:   todoID,
-				Topic:      "todo-events",
-				Payload:    []byte(`{"id":"223e4567-e89b-12d3-a456-426614174000","title":"Test Todo"}`),
-				CreatedAt:  fixedTime,
-				RetryCount: 0,
-				MaxRetries: 3,
+				ID:          eventID,
+				EventType:   "TODO_CREATED",
+				EntityID:    todoID,
+				Topic:       "todo-events",
+				TenantID:    "acme",
+				OrderingKey: "todo:223e4567-e89b-12d3-a456-426614174000",
+				Payload:     []byte(`{"id":"223e4567-e89b-12d3-a456-426614174000","title":"Test Todo"}`),
+				CreatedAt:   fixedTime,
+				RetryCount:  0,
+				MaxRetries:  3,
 			},
+			envelope:  Envelope{Format: EventFormat_Legacy},
 			expectErr: false,
 			validateMessage: func(t *testing.T, client *pubsubV2.Client, subName string) {
 				ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
@@ -59,6 +63,58 @@ func TestPubSubEventPublisher_PublishEvent(t *testing.T) {
 				assert.Equal(t, []byte(`{"id":"223e4567-e89b-12d3-a456-426614174000","title":"Test Todo"}`), msg.Data)
 				assert.Equal(t, "TODO_CREATED", msg.Attributes["event_type"])
 				assert.Equal(t, todoID.String(), msg.Attributes["entity_id"])
+				assert.Equal(t, "acme", msg.Attributes["tenant_id"])
+				assert.Empty(t, msg.Attributes["content-type"])
+				assert.Equal(t, "todo:223e4567-e89b-12d3-a456-426614174000", msg.OrderingKey)
+			},
+		},
+		"success-publish-cloudevent": {
+			event: outbox.Event{
+				ID:          eventID,
+				EventType:   "TODO_CREATED",
+				EntityID:    todoID,
+				Topic:       "todo-events",
+				TenantID:    "acme",
+				OrderingKey: "todo:223e4567-e89b-12d3-a456-426614174000",
+				Payload:     []byte(`{"id":"223e4567-e89b-12d3-a456-426614174000","title":"Test Todo"}`),
+				CreatedAt:   fixedTime,
+				RetryCount:  0,
+				MaxRetries:  3,
+			},
+			envelope:  Envelope{Format: EventFormat_CloudEvents, Source: "/todoapp"},
+			expectErr: false,
+			validateMessage: func(t *testing.T, client *pubsubV2.Client, subName string) {
+				ctx, cancel := context.WithTimeout(t.Context(), 2*time.Second)
+				defer cancel()
+
+				messages := make([]*pubsubV2.Message, 0)
+
+				err := client.Subscriber(subName).Receive(ctx, func(ctx context.Context, msg *pubsubV2.Message) {
+					messages = append(messages, msg)
+					msg.Ack() //nolint:errcheck
+				})
+				if err != nil && err != context.DeadlineExceeded {
+					t.Fatalf("failed to receive: %v", err)
+				}
+
+				assert.Len(t, messages, 1)
+				msg := messages[0]
+				assert.JSONEq(t, `{
+					"specversion": "1.0",
+					"id": "123e4567-e89b-12d3-a456-426614174000",
+					"source": "/todoapp",
+					"type": "TODO_CREATED",
+					"subject": "223e4567-e89b-12d3-a456-426614174000",
+					"time": "2024-01-01T12:00:00Z",
+					"datacontenttype": "application/json",
+					"data": {"id":"223e4567-e89b-12d3-a456-426614174000","title":"Test Todo"},
+					"tenantid": "acme"
+				}`, string(msg.Data))
+				assert.Equal(t, outbox.CloudEventsContentType, msg.Attributes["content-type"])
+				assert.Equal(t, "TODO_CREATED", msg.Attributes["event_type"])
+				assert.Equal(t, todoID.String(), msg.Attributes["entity_id"])
+				assert.Equal(t, "acme", msg.Attributes["tenant_id"])
+				assert.Equal(t, "todo:223e4567-e89b-12d3-a456-426614174000", msg.OrderingKey)
 			},
 		},
 		"error-topic-not-found": {
@@ -121,7 +177,8 @@ func TestPubSubEventPublisher_PublishEvent(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			publisher := NewPubSubEventPublisher(client)
+			publisher := NewPubSubEventPublisher(client, BatchSettings{}, tt.envelope)
+			defer publisher.Stop()
 
 			publishCtx, publishCancel := context.WithTimeout(t.Context(), 5*time.Second)
 			defer publishCancel()
@@ -137,3 +194,100 @@ func TestPubSubEventPublisher_PublishEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestPubSubEventPublisher_PublishEvents(t *testing.T) {
+	t.Parallel()
+
+	server := pstest.NewServer()
+	defer server.Close() //nolint:errcheck
+
+	conn, err := grpc.NewClient(server.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer conn.Close() //nolint:errcheck
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	client, err := pubsubV2.NewClient(ctx, "test-project", option.WithGRPCConn(conn))
+	assert.NoError(t, err)
+	defer client.Close() //nolint:errcheck
+
+	topic, err := client.TopicAdminClient.CreateTopic(
+		ctx,
+		&pubsubpb.Topic{Name: "projects/test-project/topics/chat-events"},
+	)
+	assert.NoError(t, err)
+	_, err = client.SubscriptionAdminClient.CreateSubscription(
+		ctx,
+		&pubsubpb.Subscription{
+			Name:                  "projects/test-project/subscriptions/chat-events-sub",
+			Topic:                 topic.GetName(),
+			EnableMessageOrdering: true,
+		},
+	)
+	assert.NoError(t, err)
+
+	events := []outbox.Event{
+		{ID: uuid.New(), EventType: "CHAT_MESSAGE_SENT", Topic: "chat-events", OrderingKey: "conversation:1", Payload: []byte("1")},
+		{ID: uuid.New(), EventType: "CHAT_MESSAGE_SENT", Topic: "missing-events", OrderingKey: "conversation:2", Payload: []byte("x")},
+		{ID: uuid.New(), EventType: "CHAT_MESSAGE_SENT", Topic: "chat-events", OrderingKey: "conversation:1", Payload: []byte("2")},
+	}
+
+	publisher := NewPubSubEventPublisher(
+		client,
+		BatchSettings{MaxBatchSize: 10, Window: time.Millisecond},
+		Envelope{Format: EventFormat_Legacy},
+	)
+	defer publisher.Stop()
+
+	errs := publisher.PublishEvents(ctx, events)
+	assert.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+	assert.NoError(t, errs[2])
+
+	receiveCtx, receiveCancel := context.WithTimeout(ctx, 2*time.Second)
+	defer receiveCancel()
+
+	var payloads []string
+	err = client.Subscriber("chat-events-sub").Receive(receiveCtx, func(ctx context.Context, msg *pubsubV2.Message) {
+		payloads = append(payloads, string(msg.Data))
+		msg.Ack() //nolint:errcheck
+		if len(payloads) == 2 {
+			receiveCancel()
+		}
+	})
+	if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+		t.Fatalf("failed to receive: %v", err)
+	}
+	assert.Equal(t, []string{"1", "2"}, payloads)
+}
+
+func TestParseEventFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   string
+		want    EventFormat
+		wantErr bool
+	}{
+		"empty":       {value: "", want: EventFormat_CloudEvents},
+		"cloudevents": {value: "CloudEvents", want: EventFormat_CloudEvents},
+		"legacy":      {value: " legacy ", want: EventFormat_Legacy},
+		"unsupported": {value: "avro", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseEventFormat(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package redaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitRedactor registers the assistant.Redactor applying the REDACTION_PATTERNS. Redaction stays
+// disabled, and nothing is registered, when no pattern is enabled.
+type InitRedactor struct {
+	Patterns       string `config:"REDACTION_PATTERNS" default:""`
+	ProfanityWords string `config:"REDACTION_PROFANITY_WORDS" default:""`
+	PublicKey      string `config:"REDACTION_PUBLIC_KEY" default:""`
+}
+
+// Initialize creates and registers the redactor in the dependency container.
+func (i InitRedactor) Initialize(ctx context.Context) (context.Context, error) {
+	names, err := ParsePatternNames(i.Patterns)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse redaction patterns: %w", err)
+	}
+	if len(names) == 0 {
+		return ctx, nil
+	}
+
+	var sealer *Sealer
+	if i.PublicKey != "" {
+		if sealer, err = NewSealer(i.PublicKey); err != nil {
+			return ctx, err
+		}
+	}
+	redactor, err := NewRedactor(names, ParseWords(i.ProfanityWords), sealer)
+	if err != nil {
+		return ctx, err
+	}
+	depend.Register[assistant.Redactor](redactor)
+	return ctx, nil
+}
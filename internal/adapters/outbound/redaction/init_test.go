@@ -0,0 +1,54 @@
+package redaction
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitRedactor_Initialize(t *testing.T) {
+	publicKey, _, err := GenerateKeyPair()
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		init      InitRedactor
+		expectErr bool
+	}{
+		"masking": {
+			init: InitRedactor{Patterns: "email,phone"},
+		},
+		"tokenization": {
+			init: InitRedactor{Patterns: "email", PublicKey: publicKey},
+		},
+		"unknown-pattern": {
+			init:      InitRedactor{Patterns: "ssn"},
+			expectErr: true,
+		},
+		"profanity-without-words": {
+			init:      InitRedactor{Patterns: "profanity"},
+			expectErr: true,
+		},
+		"invalid-public-key": {
+			init:      InitRedactor{Patterns: "email", PublicKey: "AAAA"},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			redactor, err := depend.Resolve[assistant.Redactor]()
+			require.NoError(t, err)
+			assert.IsType(t, Redactor{}, redactor)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package redaction
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PatternName identifies a built-in redaction pattern.
+type PatternName string
+
+const (
+	// PatternName_Email masks email addresses.
+	PatternName_Email PatternName = "email"
+	// PatternName_CreditCard masks payment card numbers that pass the Luhn check.
+	PatternName_CreditCard PatternName = "credit_card"
+	// PatternName_Phone masks phone numbers.
+	PatternName_Phone PatternName = "phone"
+	// PatternName_Profanity masks the configured profanity words.
+	PatternName_Profanity PatternName = "profanity"
+)
+
+// patternOrder is the order patterns are applied in: card numbers go before phone numbers so
+// their digit groups are not taken for a phone number.
+var patternOrder = []PatternName{
+	PatternName_Email,
+	PatternName_CreditCard,
+	PatternName_Phone,
+	PatternName_Profanity,
+}
+
+var (
+	emailRegexp      = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	creditCardRegexp = regexp.MustCompile(`\d(?:[ -]?\d){12,18}`)
+	phoneRegexp      = regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{2,4}\)|\d{2,4})[ .-]?\d{3,4}[ .-]?\d{3,4}`)
+)
+
+// pattern finds one kind of sensitive content.
+type pattern struct {
+	name   PatternName
+	regexp *regexp.Regexp
+	// valid filters out regexp matches that are not sensitive content.
+	valid func(match string) bool
+	// standalone rejects matches glued to letters, digits, '-' or '_', such as a UUID segment.
+	standalone bool
+}
+
+// placeholder returns the replacement for a match, e.g. [REDACTED_EMAIL] or [REDACTED_EMAIL:<token>].
+func (p pattern) placeholder(token string) string {
+	label := "REDACTED_" + strings.ToUpper(string(p.name))
+	if token == "" {
+		return "[" + label + "]"
+	}
+	return "[" + label + ":" + token + "]"
+}
+
+// ParsePatternNames parses a comma-separated list of pattern names, e.g. "email,phone".
+func ParsePatternNames(raw string) ([]PatternName, error) {
+	var names []PatternName
+	for name := range strings.SplitSeq(raw, ",") {
+		name = strings.TrimSpace(strings.ToLower(name))
+		if name == "" {
+			continue
+		}
+		switch PatternName(name) {
+		case PatternName_Email, PatternName_CreditCard, PatternName_Phone, PatternName_Profanity:
+			names = append(names, PatternName(name))
+		default:
+			return nil, fmt.Errorf("unknown redaction pattern %q", name)
+		}
+	}
+	return names, nil
+}
+
+// buildPatterns returns the enabled patterns in application order. The profanity pattern requires a word list.
+func buildPatterns(names []PatternName, profanityWords []string) ([]pattern, error) {
+	enabled := make(map[PatternName]bool, len(names))
+	for _, name := range names {
+		enabled[name] = true
+	}
+	if enabled[PatternName_Profanity] && len(profanityWords) == 0 {
+		return nil, fmt.Errorf("the %q redaction pattern requires profanity words", PatternName_Profanity)
+	}
+
+	patterns := make([]pattern, 0, len(enabled))
+	for _, name := range patternOrder {
+		if !enabled[name] {
+			continue
+		}
+		switch name {
+		case PatternName_Email:
+			patterns = append(patterns, pattern{name: name, regexp: emailRegexp})
+		case PatternName_CreditCard:
+			patterns = append(patterns, pattern{name: name, regexp: creditCardRegexp, valid: isCardNumber, standalone: true})
+		case PatternName_Phone:
+			patterns = append(patterns, pattern{name: name, regexp: phoneRegexp, valid: isPhoneNumber, standalone: true})
+		case PatternName_Profanity:
+			words := make([]string, 0, len(profanityWords))
+			for _, word := range profanityWords {
+				words = append(words, regexp.QuoteMeta(word))
+			}
+			patterns = append(patterns, pattern{
+				name:   name,
+				regexp: regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`),
+			})
+		}
+	}
+	return patterns, nil
+}
+
+// ParseWords parses a comma-separated word list, ignoring blanks.
+func ParseWords(raw string) []string {
+	var words []string
+	for word := range strings.SplitSeq(raw, ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// isCardNumber reports whether the match has a card number length and passes the Luhn check.
+func isCardNumber(match string) bool {
+	digits := onlyDigits(match)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// isPhoneNumber reports whether the match has the digit count of a national or international phone number.
+func isPhoneNumber(match string) bool {
+	digits := onlyDigits(match)
+	return len(digits) >= 9 && len(digits) <= 15
+}
+
+func onlyDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// isStandalone reports whether the match at [start, end) is not glued to surrounding word characters.
+func isStandalone(content string, start, end int) bool {
+	glued := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+	}
+	if before, _ := utf8.DecodeLastRuneInString(content[:start]); start > 0 && glued(before) {
+		return false
+	}
+	if after, _ := utf8.DecodeRuneInString(content[end:]); end < len(content) && glued(after) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package redaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePatternNames(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		raw       string
+		expected  []PatternName
+		expectErr bool
+	}{
+		"empty": {
+			raw: "",
+		},
+		"all-patterns": {
+			raw:      " Email, phone ,credit_card,profanity,",
+			expected: []PatternName{PatternName_Email, PatternName_Phone, PatternName_CreditCard, PatternName_Profanity},
+		},
+		"unknown-pattern": {
+			raw:       "email,ssn",
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParsePatternNames(tt.raw)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestIsCardNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		match string
+		want  bool
+	}{
+		"visa":          {match: "4111 1111 1111 1111", want: true},
+		"amex":          {match: "3782-822463-10005", want: true},
+		"fails-luhn":    {match: "4111 1111 1111 1112", want: false},
+		"too-short":     {match: "4111 1111 11", want: false},
+		"digits-only":   {match: "5555555555554444", want: true},
+		"twenty-digits": {match: "41111111111111111111", want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isCardNumber(tt.match))
+		})
+	}
+}
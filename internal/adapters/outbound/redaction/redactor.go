@@ -0,0 +1,91 @@
+package redaction
+
+import (
+	"regexp"
+	"strings"
+)
+
+// tokenRegexp matches the placeholders of reversible redactions.
+var tokenRegexp = regexp.MustCompile(`\[REDACTED_[A-Z_]+:([A-Za-z0-9_-]+)\]`)
+
+// Redactor implements the assistant.Redactor interface with the built-in patterns. Matches are masked,
+// or replaced by a reversible token when a Sealer is configured.
+type Redactor struct {
+	patterns []pattern
+	sealer   *Sealer
+}
+
+// NewRedactor creates a Redactor applying the named patterns. A nil sealer masks matches irreversibly.
+func NewRedactor(names []PatternName, profanityWords []string, sealer *Sealer) (Redactor, error) {
+	patterns, err := buildPatterns(names, profanityWords)
+	if err != nil {
+		return Redactor{}, err
+	}
+	return Redactor{
+		patterns: patterns,
+		sealer:   sealer,
+	}, nil
+}
+
+// Redact implements assistant.Redactor.Redact.
+func (r Redactor) Redact(content string) (string, error) {
+	for _, p := range r.patterns {
+		redacted, err := r.apply(p, content)
+		if err != nil {
+			return "", err
+		}
+		content = redacted
+	}
+	return content, nil
+}
+
+// apply replaces every valid match of the pattern with its placeholder.
+func (r Redactor) apply(p pattern, content string) (string, error) {
+	matches := p.regexp.FindAllStringIndex(content, -1)
+	if len(matches) == 0 {
+		return content, nil
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		match := content[m[0]:m[1]]
+		if (p.standalone && !isStandalone(content, m[0], m[1])) || (p.valid != nil && !p.valid(match)) {
+			continue
+		}
+
+		token := ""
+		if r.sealer != nil {
+			sealed, err := r.sealer.Seal(match)
+			if err != nil {
+				return "", err
+			}
+			token = sealed
+		}
+		b.WriteString(content[last:m[0]])
+		b.WriteString(p.placeholder(token))
+		last = m[1]
+	}
+	b.WriteString(content[last:])
+	return b.String(), nil
+}
+
+// Reveal replaces the reversible redaction tokens in the content with the original values.
+func Reveal(content string, opener *Opener) (string, error) {
+	var openErr error
+	revealed := tokenRegexp.ReplaceAllStringFunc(content, func(placeholder string) string {
+		if openErr != nil {
+			return placeholder
+		}
+		value, err := opener.Open(tokenRegexp.FindStringSubmatch(placeholder)[1])
+		if err != nil {
+			openErr = err
+			return placeholder
+		}
+		return value
+	})
+	if openErr != nil {
+		return "", openErr
+	}
+	return revealed, nil
+}
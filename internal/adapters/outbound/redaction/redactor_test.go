@@ -0,0 +1,103 @@
+package redaction
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactor_Redact(t *testing.T) {
+	t.Parallel()
+
+	all := []PatternName{PatternName_Email, PatternName_Phone, PatternName_CreditCard, PatternName_Profanity}
+
+	tests := map[string]struct {
+		names    []PatternName
+		content  string
+		expected string
+	}{
+		"email": {
+			names:    all,
+			content:  "Send the report to jane.doe+work@example.co.uk today",
+			expected: "Send the report to [REDACTED_EMAIL] today",
+		},
+		"phone-numbers": {
+			names:    all,
+			content:  "Call +1 555 123 4567 or (555) 123-4567 or 555.123.4567",
+			expected: "Call [REDACTED_PHONE] or [REDACTED_PHONE] or [REDACTED_PHONE]",
+		},
+		"credit-card": {
+			names:    all,
+			content:  "My card is 4111-1111-1111-1111, not 4111-1111-1111-1112",
+			expected: "My card is [REDACTED_CREDIT_CARD], not 4111-1111-1111-1112",
+		},
+		"profanity-is-case-insensitive": {
+			names:    all,
+			content:  "This darn task is DARN late",
+			expected: "This [REDACTED_PROFANITY] task is [REDACTED_PROFANITY] late",
+		},
+		"ids-and-dates-are-kept": {
+			names:    all,
+			content:  `{"id":"123e4567-e89b-12d3-a456-426614174000","due_date":"2026-01-24","at":"2026-01-24T10:30:00Z"}`,
+			expected: `{"id":"123e4567-e89b-12d3-a456-426614174000","due_date":"2026-01-24","at":"2026-01-24T10:30:00Z"}`,
+		},
+		"disabled-patterns-are-kept": {
+			names:    []PatternName{PatternName_Email},
+			content:  "Mail jane@example.com or call 555-123-4567",
+			expected: "Mail [REDACTED_EMAIL] or call 555-123-4567",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			redactor, err := NewRedactor(tt.names, []string{"darn"}, nil)
+			require.NoError(t, err)
+
+			got, err := redactor.Redact(tt.content)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNewRedactor_ProfanityRequiresWords(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRedactor([]PatternName{PatternName_Profanity}, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestRedactor_RedactAndReveal(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := GenerateKeyPair()
+	require.NoError(t, err)
+	sealer, err := NewSealer(publicKey)
+	require.NoError(t, err)
+	opener, err := NewOpener(privateKey)
+	require.NoError(t, err)
+
+	redactor, err := NewRedactor([]PatternName{PatternName_Email, PatternName_Phone}, nil, sealer)
+	require.NoError(t, err)
+
+	content := "Mail jane@example.com or call 555-123-4567"
+	redacted, err := redactor.Redact(content)
+	require.NoError(t, err)
+	assert.NotContains(t, redacted, "jane@example.com")
+	assert.NotContains(t, redacted, "555-123-4567")
+	assert.True(t, strings.HasPrefix(redacted, "Mail [REDACTED_EMAIL:"), redacted)
+	assert.Contains(t, redacted, " or call [REDACTED_PHONE:")
+
+	revealed, err := Reveal(redacted, opener)
+	require.NoError(t, err)
+	assert.Equal(t, content, revealed)
+
+	_, otherPrivateKey, err := GenerateKeyPair()
+	require.NoError(t, err)
+	otherOpener, err := NewOpener(otherPrivateKey)
+	require.NoError(t, err)
+	_, err = Reveal(redacted, otherOpener)
+	assert.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package redaction
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// sealInfo binds the derived keys to this use so they are never reused elsewhere.
+const sealInfo = "symbiont-ai-todoapp redaction v1"
+
+// Sealer encrypts redacted values to the admin public key, so the application can write reversible tokens
+// without being able to read them back. Tokens are base64url encoded: ephemeral public key, nonce and
+// AES-256-GCM ciphertext, with the key derived by HKDF-SHA256 from an X25519 key agreement.
+type Sealer struct {
+	publicKey *ecdh.PublicKey
+}
+
+// NewSealer creates a Sealer from a base64 encoded X25519 public key.
+func NewSealer(publicKey string) (*Sealer, error) {
+	raw, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction public key encoding: %w", err)
+	}
+	key, err := ecdh.X25519().NewPublicKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction public key: %w", err)
+	}
+	return &Sealer{publicKey: key}, nil
+}
+
+// Seal encrypts the value and returns its token.
+func (s *Sealer) Seal(value string) (string, error) {
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	shared, err := ephemeral.ECDH(s.publicKey)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := newGCM(shared, ephemeral.PublicKey().Bytes(), s.publicKey.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	token := append(ephemeral.PublicKey().Bytes(), nonce...)
+	token = gcm.Seal(token, nonce, []byte(value), nil)
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Opener decrypts tokens written by a Sealer with the matching admin private key.
+type Opener struct {
+	privateKey *ecdh.PrivateKey
+}
+
+// NewOpener creates an Opener from a base64 encoded X25519 private key.
+func NewOpener(privateKey string) (*Opener, error) {
+	raw, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction private key encoding: %w", err)
+	}
+	key, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction private key: %w", err)
+	}
+	return &Opener{privateKey: key}, nil
+}
+
+// Open decrypts a token and returns the original value.
+func (o *Opener) Open(token string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid redaction token encoding: %w", err)
+	}
+	publicKeySize := len(o.privateKey.PublicKey().Bytes())
+	if len(raw) < publicKeySize {
+		return "", errors.New("redaction token is too short")
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(raw[:publicKeySize])
+	if err != nil {
+		return "", fmt.Errorf("invalid redaction token: %w", err)
+	}
+	shared, err := o.privateKey.ECDH(ephemeral)
+	if err != nil {
+		return "", fmt.Errorf("invalid redaction token: %w", err)
+	}
+	gcm, err := newGCM(shared, ephemeral.Bytes(), o.privateKey.PublicKey().Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	sealed := raw[publicKeySize:]
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("redaction token is too short")
+	}
+	value, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt redaction token: %w", err)
+	}
+	return string(value), nil
+}
+
+// GenerateKeyPair returns a new base64 encoded X25519 key pair for reversible redaction.
+func GenerateKeyPair() (publicKey string, privateKey string, err error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+	return base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()),
+		base64.StdEncoding.EncodeToString(key.Bytes()),
+		nil
+}
+
+// newGCM derives the AES-256-GCM cipher of one token from the shared secret and both public keys.
+func newGCM(shared, ephemeralPublicKey, recipientPublicKey []byte) (cipher.AEAD, error) {
+	salt := append(append([]byte{}, ephemeralPublicKey...), recipientPublicKey...)
+	key, err := hkdf.Key(sha256.New, shared, salt, sealInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,54 @@
+package redaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealer_Seal(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := GenerateKeyPair()
+	require.NoError(t, err)
+	sealer, err := NewSealer(publicKey)
+	require.NoError(t, err)
+	opener, err := NewOpener(privateKey)
+	require.NoError(t, err)
+
+	first, err := sealer.Seal("jane@example.com")
+	require.NoError(t, err)
+	second, err := sealer.Seal("jane@example.com")
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second, "tokens must not reveal equal values")
+
+	value, err := opener.Open(first)
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", value)
+
+	tests := map[string]string{
+		"invalid-encoding": "not base64!",
+		"too-short":        "AAAA",
+		"tampered":         first[:len(first)-2] + "AA",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := opener.Open(token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewSealer_InvalidKeys(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSealer("not base64!")
+	assert.Error(t, err)
+	_, err = NewSealer("AAAA")
+	assert.Error(t, err)
+	_, err = NewOpener("not base64!")
+	assert.Error(t, err)
+	_, err = NewOpener("AAAA")
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Client is the Redis client shared by the caching and fanout adapters.
+type Client struct {
+	rdb *goredis.Client
+}
+
+// NewClient creates a Client from Redis connection options.
+func NewClient(opts *goredis.Options) *Client {
+	return &Client{rdb: goredis.NewClient(opts)}
+}
+
+// Ping checks that the Redis server is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.rdb.Ping(ctx).Err()
+}
+
+// Get returns the value stored under key and whether it was found.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := c.rdb.Get(ctx, key).Bytes()
+	if errors.Is(err, goredis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores value under key for ttl.
+func (c *Client) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.rdb.Set(ctx, key, value, ttl).Err()
+}
+
+// Publish sends payload to the subscribers of channel.
+func (c *Client) Publish(ctx context.Context, channel string, payload []byte) error {
+	return c.rdb.Publish(ctx, channel, payload).Err()
+}
+
+// Receive subscribes to channel and calls handle with every payload until ctx is done.
+// The subscription reconnects on its own when the connection drops.
+func (c *Client) Receive(ctx context.Context, channel string, handle func(payload []byte)) error {
+	sub := c.rdb.Subscribe(ctx, channel)
+	defer sub.Close() //nolint:errcheck
+
+	// Wait for the subscription confirmation so a failing server surfaces as an error.
+	if _, err := sub.Receive(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			handle([]byte(msg.Payload))
+		}
+	}
+}
+
+// Close closes the connections to the Redis server.
+func (c *Client) Close() error {
+	return c.rdb.Close()
+}
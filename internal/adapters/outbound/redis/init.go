@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont/depend"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// resubscribeDelay is how long the todo event fanout waits before subscribing again after a failure.
+const resubscribeDelay = 5 * time.Second
+
+// InitClient creates and registers the Redis client. Redis is optional: without an address nothing is
+// registered and the caches and todo event stream stay in-process.
+type InitClient struct {
+	Logger   *log.Logger `resolve:""`
+	Addr     string      `config:"REDIS_ADDR" default:""`
+	Password string      `config:"REDIS_PASSWORD" default:""`
+	DB       int         `config:"REDIS_DB" default:"0"`
+	client   *Client
+}
+
+// Initialize connects to Redis and registers the client in the dependency container.
+func (i *InitClient) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Addr == "" {
+		return ctx, nil
+	}
+
+	client := NewClient(&goredis.Options{
+		Addr:     i.Addr,
+		Password: i.Password,
+		DB:       i.DB,
+	})
+	if err := client.Ping(ctx); err != nil {
+		client.Close() //nolint:errcheck
+		return ctx, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	i.client = client
+
+	depend.Register(i.client)
+	return ctx, nil
+}
+
+// Close closes the Redis client and logs any errors that occur during closure.
+func (i *InitClient) Close() {
+	if i == nil || i.client == nil {
+		return
+	}
+	if err := i.client.Close(); err != nil {
+		i.Logger.Printf("InitClient: failed to close redis client: %v", err)
+	}
+}
+
+// InitQueryEncoder wraps the registered semantic.Encoder with the Redis query embedding cache.
+// It must run after the encoder client and the Redis client are registered.
+type InitQueryEncoder struct {
+	Logger  *log.Logger      `resolve:""`
+	Encoder semantic.Encoder `resolve:""`
+	TTL     time.Duration    `config:"REDIS_QUERY_EMBEDDING_CACHE_TTL" default:"24h"`
+}
+
+// Initialize registers the caching encoder in place of the encoder client. Without Redis or with a zero TTL
+// the encoder is left as is.
+func (i InitQueryEncoder) Initialize(ctx context.Context) (context.Context, error) {
+	client, err := depend.Resolve[*Client]()
+	if err != nil || client == nil || i.TTL <= 0 {
+		return ctx, nil
+	}
+
+	depend.Register[semantic.Encoder](NewQueryEncoder(i.Encoder, client, i.TTL, i.Logger))
+	return ctx, nil
+}
+
+// InitTodoEventStream wraps the registered todo event stream so broadcasts reach every replica through Redis.
+// It must run after the todo event hub and the Redis client are registered, and before anything subscribes
+// to the stream.
+type InitTodoEventStream struct {
+	Logger *log.Logger            `resolve:""`
+	Stream outbox.TodoEventStream `resolve:""`
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Initialize registers the fanout stream in place of the todo event hub and starts delivering the events
+// of every replica to the local listeners. Without Redis the hub is left as is.
+func (i *InitTodoEventStream) Initialize(ctx context.Context) (context.Context, error) {
+	client, err := depend.Resolve[*Client]()
+	if err != nil || client == nil {
+		return ctx, nil
+	}
+
+	stream := NewTodoEventStream(i.Stream, client, i.Logger)
+	runCtx, cancel := context.WithCancel(ctx)
+	i.cancel = cancel
+	i.wg.Go(func() {
+		for {
+			if err := stream.Run(runCtx); err != nil {
+				i.Logger.Printf("InitTodoEventStream: todo event subscription failed: %v", err)
+			}
+			select {
+			case <-runCtx.Done():
+				return
+			case <-time.After(resubscribeDelay):
+			}
+		}
+	})
+
+	depend.Register[outbox.TodoEventStream](stream)
+	return ctx, nil
+}
+
+// Close stops delivering the events of the other replicas.
+func (i *InitTodoEventStream) Close() {
+	if i == nil || i.cancel == nil {
+		return
+	}
+	i.cancel()
+	i.wg.Wait()
+}
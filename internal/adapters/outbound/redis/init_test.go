@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont/depend"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitClient_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		addr    string
+		wantErr bool
+	}{
+		"disabled-without-address": {},
+		"unreachable-server": {
+			addr:    "127.0.0.1:1",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			i := &InitClient{Logger: log.New(io.Discard, "", 0), Addr: tt.addr}
+
+			_, err := i.Initialize(t.Context())
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Nil(t, i.client)
+			i.Close()
+		})
+	}
+}
+
+func TestInitQueryEncoder_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		client    *Client
+		ttl       time.Duration
+		wantCache bool
+	}{
+		"enabled": {
+			client:    NewClient(&goredis.Options{Addr: "127.0.0.1:1"}),
+			ttl:       time.Hour,
+			wantCache: true,
+		},
+		"without-redis": {
+			ttl: time.Hour,
+		},
+		"zero-ttl": {
+			client: NewClient(&goredis.Options{Addr: "127.0.0.1:1"}),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.Register(tt.client)
+			next := semantic.NewMockEncoder(t)
+			depend.Register[semantic.Encoder](next)
+
+			i := InitQueryEncoder{Logger: log.New(io.Discard, "", 0), Encoder: next, TTL: tt.ttl}
+			_, err := i.Initialize(t.Context())
+			require.NoError(t, err)
+
+			registered, err := depend.Resolve[semantic.Encoder]()
+			require.NoError(t, err)
+			if tt.wantCache {
+				assert.IsType(t, QueryEncoder{}, registered)
+				return
+			}
+			assert.Same(t, next, registered)
+		})
+	}
+}
+
+func TestInitTodoEventStream_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		client     *Client
+		wantFanout bool
+	}{
+		"enabled": {
+			client:     NewClient(&goredis.Options{Addr: "127.0.0.1:1", MaxRetries: -1}),
+			wantFanout: true,
+		},
+		"without-redis": {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.Register(tt.client)
+			local := outbox.NewMockTodoEventStream(t)
+			depend.Register[outbox.TodoEventStream](local)
+
+			i := &InitTodoEventStream{Logger: log.New(io.Discard, "", 0), Stream: local}
+			_, err := i.Initialize(t.Context())
+			require.NoError(t, err)
+
+			registered, err := depend.Resolve[outbox.TodoEventStream]()
+			require.NoError(t, err)
+			if tt.wantFanout {
+				assert.IsType(t, &TodoEventStream{}, registered)
+			} else {
+				assert.Same(t, local, registered)
+			}
+
+			// Close stops the subscription even while Redis is unreachable.
+			i.Close()
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+)
+
+// queryEmbeddingKeyPrefix prefixes the Redis keys of cached query embeddings.
+const queryEmbeddingKeyPrefix = "query_embedding:"
+
+// Cache stores values shared by every replica.
+type Cache interface {
+	// Get returns the value stored under key and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// QueryEncoder decorates a semantic.Encoder with a cache of query embeddings shared by every replica,
+// so repeated searches and skill rankings do not embed the same query again.
+// Cache failures are logged and fall back to the wrapped encoder.
+type QueryEncoder struct {
+	semantic.Encoder
+	cache  Cache
+	ttl    time.Duration
+	logger *log.Logger
+}
+
+// NewQueryEncoder creates a QueryEncoder keeping query embeddings for ttl.
+func NewQueryEncoder(encoder semantic.Encoder, cache Cache, ttl time.Duration, logger *log.Logger) QueryEncoder {
+	return QueryEncoder{
+		Encoder: encoder,
+		cache:   cache,
+		ttl:     ttl,
+		logger:  logger,
+	}
+}
+
+// VectorizeQuery serves the query embedding from the cache, embedding and caching it on a miss.
+// Cached embeddings report no tokens since none were spent.
+func (e QueryEncoder) VectorizeQuery(ctx context.Context, model, query string) (semantic.EmbeddingVector, error) {
+	key := queryEmbeddingKey(model, query)
+
+	payload, found, err := e.cache.Get(ctx, key)
+	if err != nil {
+		e.logger.Printf("QueryEncoder: failed to read cached query embedding: %v", err)
+	}
+	if found {
+		var vector []float64
+		if err := json.Unmarshal(payload, &vector); err == nil && len(vector) > 0 {
+			metrics.RecordQueryEmbeddingCacheRequest(ctx, true)
+			return semantic.EmbeddingVector{Vector: vector}, nil
+		}
+	}
+	metrics.RecordQueryEmbeddingCacheRequest(ctx, false)
+
+	embedded, err := e.Encoder.VectorizeQuery(ctx, model, query)
+	if err != nil {
+		return semantic.EmbeddingVector{}, err
+	}
+
+	payload, err = json.Marshal(embedded.Vector)
+	if err == nil {
+		err = e.cache.Set(ctx, key, payload, e.ttl)
+	}
+	if err != nil {
+		e.logger.Printf("QueryEncoder: failed to cache query embedding: %v", err)
+	}
+	return embedded, nil
+}
+
+// queryEmbeddingKey returns the cache key of the embedding of query by model. The query is hashed
+// to bound the key length and keep user input out of the key space.
+func queryEmbeddingKey(model, query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return queryEmbeddingKeyPrefix + model + ":" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,156 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCache is an in-memory Cache recording the TTL of the stored values.
+type fakeCache struct {
+	values map[string][]byte
+	ttls   map[string]time.Duration
+	getErr error
+	setErr error
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{
+		values: make(map[string][]byte),
+		ttls:   make(map[string]time.Duration),
+	}
+}
+
+func (c *fakeCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	if c.getErr != nil {
+		return nil, false, c.getErr
+	}
+	value, found := c.values[key]
+	return value, found, nil
+}
+
+func (c *fakeCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	if c.setErr != nil {
+		return c.setErr
+	}
+	c.values[key] = value
+	c.ttls[key] = ttl
+	return nil
+}
+
+func TestQueryEncoder_VectorizeQuery(t *testing.T) {
+	t.Parallel()
+
+	const (
+		model = "embedding-model"
+		query = "groceries due this week"
+		ttl   = time.Hour
+	)
+	key := queryEmbeddingKey(model, query)
+	embedded := semantic.EmbeddingVector{Vector: []float64{0.1, 0.2}, TotalTokens: 5}
+
+	tests := map[string]struct {
+		setupCache     func(*fakeCache)
+		setupEncoder   func(*semantic.MockEncoder)
+		expectedVector semantic.EmbeddingVector
+		expectedCached []byte
+		expectedErr    bool
+	}{
+		"miss-embeds-and-caches": {
+			setupEncoder: func(e *semantic.MockEncoder) {
+				e.EXPECT().VectorizeQuery(mock.Anything, model, query).Return(embedded, nil).Once()
+			},
+			expectedVector: embedded,
+			expectedCached: []byte(`[0.1,0.2]`),
+		},
+		"hit-reports-no-tokens": {
+			setupCache: func(c *fakeCache) {
+				c.values[key] = []byte(`[0.3,0.4]`)
+			},
+			expectedVector: semantic.EmbeddingVector{Vector: []float64{0.3, 0.4}},
+			expectedCached: []byte(`[0.3,0.4]`),
+		},
+		"corrupt-entry-is-replaced": {
+			setupCache: func(c *fakeCache) {
+				c.values[key] = []byte(`{`)
+			},
+			setupEncoder: func(e *semantic.MockEncoder) {
+				e.EXPECT().VectorizeQuery(mock.Anything, model, query).Return(embedded, nil).Once()
+			},
+			expectedVector: embedded,
+			expectedCached: []byte(`[0.1,0.2]`),
+		},
+		"read-error-falls-back-to-encoder": {
+			setupCache: func(c *fakeCache) {
+				c.getErr = errors.New("connection refused")
+			},
+			setupEncoder: func(e *semantic.MockEncoder) {
+				e.EXPECT().VectorizeQuery(mock.Anything, model, query).Return(embedded, nil).Once()
+			},
+			expectedVector: embedded,
+			expectedCached: []byte(`[0.1,0.2]`),
+		},
+		"write-error-still-returns-embedding": {
+			setupCache: func(c *fakeCache) {
+				c.setErr = errors.New("connection refused")
+			},
+			setupEncoder: func(e *semantic.MockEncoder) {
+				e.EXPECT().VectorizeQuery(mock.Anything, model, query).Return(embedded, nil).Once()
+			},
+			expectedVector: embedded,
+		},
+		"encoder-error": {
+			setupEncoder: func(e *semantic.MockEncoder) {
+				e.EXPECT().VectorizeQuery(mock.Anything, model, query).Return(semantic.EmbeddingVector{}, errors.New("model unavailable")).Once()
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cache := newFakeCache()
+			if tt.setupCache != nil {
+				tt.setupCache(cache)
+			}
+			next := semantic.NewMockEncoder(t)
+			if tt.setupEncoder != nil {
+				tt.setupEncoder(next)
+			}
+
+			encoder := NewQueryEncoder(next, cache, ttl, log.New(io.Discard, "", 0))
+			got, err := encoder.VectorizeQuery(t.Context(), model, query)
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.Empty(t, cache.values)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVector, got)
+			assert.Equal(t, tt.expectedCached, cache.values[key])
+			if _, stored := cache.ttls[key]; stored {
+				assert.Equal(t, ttl, cache.ttls[key])
+			}
+		})
+	}
+}
+
+func TestQueryEmbeddingKey(t *testing.T) {
+	t.Parallel()
+
+	key := queryEmbeddingKey("embedding-model", "groceries")
+	assert.Equal(t, key, queryEmbeddingKey("embedding-model", "groceries"))
+	assert.NotEqual(t, key, queryEmbeddingKey("other-model", "groceries"))
+	assert.NotEqual(t, key, queryEmbeddingKey("embedding-model", "laundry"))
+	assert.NotContains(t, key, "groceries")
+}
@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+)
+
+// todoEventsChannel is the Redis channel todo events are fanned out on.
+const todoEventsChannel = "todo_events"
+
+// publishTimeout bounds how long a broadcast waits for Redis before delivering the event locally only.
+const publishTimeout = 2 * time.Second
+
+// Channel fans payloads out to every replica.
+type Channel interface {
+	// Publish sends payload to the subscribers of channel.
+	Publish(ctx context.Context, channel string, payload []byte) error
+	// Receive subscribes to channel and calls handle with every payload until ctx is done.
+	Receive(ctx context.Context, channel string, handle func(payload []byte)) error
+}
+
+// TodoEventStream decorates the in-process todo event stream so broadcasts reach the listeners of
+// every replica: events are published on a Redis channel and every replica, this one included,
+// delivers what it receives to its local listeners.
+type TodoEventStream struct {
+	local   outbox.TodoEventStream
+	channel Channel
+	logger  *log.Logger
+}
+
+// NewTodoEventStream creates a TodoEventStream delivering the events received from channel to local.
+func NewTodoEventStream(local outbox.TodoEventStream, channel Channel, logger *log.Logger) *TodoEventStream {
+	return &TodoEventStream{
+		local:   local,
+		channel: channel,
+		logger:  logger,
+	}
+}
+
+// Subscribe registers a listener on the local stream.
+func (s *TodoEventStream) Subscribe() (<-chan outbox.TodoEvent, func()) {
+	return s.local.Subscribe()
+}
+
+// Broadcast publishes the event to every replica. When Redis is unavailable the event only reaches
+// the local listeners.
+func (s *TodoEventStream) Broadcast(event outbox.TodoEvent) {
+	payload, err := json.Marshal(event)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		err = s.channel.Publish(ctx, todoEventsChannel, payload)
+		cancel()
+	}
+	if err != nil {
+		s.logger.Printf("TodoEventStream: failed to publish todo event, delivering it locally: %v", err)
+		s.local.Broadcast(event)
+	}
+}
+
+// Replicated reports that broadcasts reach the listeners of every replica.
+func (s *TodoEventStream) Replicated() bool {
+	return true
+}
+
+// Run delivers the events published by every replica to the local listeners until ctx is done.
+func (s *TodoEventStream) Run(ctx context.Context) error {
+	return s.channel.Receive(ctx, todoEventsChannel, func(payload []byte) {
+		var event outbox.TodoEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			s.logger.Printf("TodoEventStream: invalid payload: %v", err)
+			return
+		}
+		s.local.Broadcast(event)
+	})
+}
@@ -0,0 +1,134 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/todoeventhub"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChannel is an in-memory Channel delivering every published payload to all its receivers.
+type fakeChannel struct {
+	mu         sync.Mutex
+	receivers  map[string][]func([]byte)
+	publishErr error
+	subscribed chan struct{}
+}
+
+func newFakeChannel() *fakeChannel {
+	return &fakeChannel{
+		receivers:  make(map[string][]func([]byte)),
+		subscribed: make(chan struct{}, 10),
+	}
+}
+
+func (c *fakeChannel) Publish(_ context.Context, channel string, payload []byte) error {
+	if c.publishErr != nil {
+		return c.publishErr
+	}
+	c.mu.Lock()
+	receivers := c.receivers[channel]
+	c.mu.Unlock()
+	for _, handle := range receivers {
+		handle(payload)
+	}
+	return nil
+}
+
+func (c *fakeChannel) Receive(ctx context.Context, channel string, handle func([]byte)) error {
+	c.mu.Lock()
+	c.receivers[channel] = append(c.receivers[channel], handle)
+	c.mu.Unlock()
+	c.subscribed <- struct{}{}
+
+	<-ctx.Done()
+	return nil
+}
+
+func TestTodoEventStream_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.TodoEvent{
+		Type:      outbox.EventType_TODO_UPDATED,
+		TodoID:    uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		CreatedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		Sequence:  7,
+		TenantID:  tenant.Default,
+	}
+
+	tests := map[string]struct {
+		publishErr error
+		replicas   int
+	}{
+		"reaches-every-replica": {
+			replicas: 3,
+		},
+		"publish-error-delivers-locally": {
+			publishErr: errors.New("connection refused"),
+			replicas:   2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			channel := newFakeChannel()
+			channel.publishErr = tt.publishErr
+			logger := log.New(io.Discard, "", 0)
+
+			streams := make([]*TodoEventStream, tt.replicas)
+			listeners := make([]<-chan outbox.TodoEvent, tt.replicas)
+			for i := range tt.replicas {
+				streams[i] = NewTodoEventStream(todoeventhub.NewHub(), channel, logger)
+				var unsubscribe func()
+				listeners[i], unsubscribe = streams[i].Subscribe()
+				t.Cleanup(unsubscribe)
+
+				go streams[i].Run(t.Context()) //nolint:errcheck
+				<-channel.subscribed
+			}
+
+			streams[0].Broadcast(event)
+
+			for i, listener := range listeners {
+				if tt.publishErr != nil && i > 0 {
+					assert.Empty(t, listener)
+					continue
+				}
+				select {
+				case got := <-listener:
+					assert.Equal(t, event, got)
+				case <-time.After(time.Second):
+					t.Fatalf("replica %d did not receive the event", i)
+				}
+			}
+		})
+	}
+}
+
+func TestTodoEventStream_Run_SkipsInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	channel := newFakeChannel()
+	stream := NewTodoEventStream(todoeventhub.NewHub(), channel, log.New(io.Discard, "", 0))
+	listener, unsubscribe := stream.Subscribe()
+	defer unsubscribe()
+
+	go stream.Run(t.Context()) //nolint:errcheck
+	<-channel.subscribed
+
+	require.NoError(t, channel.Publish(t.Context(), todoEventsChannel, []byte(`{"invalid"`)))
+	assert.Empty(t, listener)
+	assert.True(t, stream.Replicated())
+}
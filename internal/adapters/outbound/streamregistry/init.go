@@ -0,0 +1,27 @@
+package streamregistry
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitRegistry is used to initialize and register the conversation stream registry.
+type InitRegistry struct{}
+
+// Initialize creates and registers the registry in the dependency container.
+func (i InitRegistry) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ConversationStreams](NewRegistry())
+	return ctx, nil
+}
+
+// InitSessionRegistry is used to initialize and register the session stream registry.
+type InitSessionRegistry struct{}
+
+// Initialize creates and registers the session registry in the dependency container.
+func (i InitSessionRegistry) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[access.SessionStreams](NewSessionRegistry())
+	return ctx, nil
+}
@@ -0,0 +1,35 @@
+package streamregistry
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitRegistry_Initialize(t *testing.T) {
+	i := InitRegistry{}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[assistant.ConversationStreams]()
+	require.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitSessionRegistry_Initialize(t *testing.T) {
+	i := InitSessionRegistry{}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[access.SessionStreams]()
+	require.NoError(t, err)
+	assert.NotNil(t, registered)
+}
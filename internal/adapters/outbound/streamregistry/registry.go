@@ -0,0 +1,65 @@
+package streamregistry
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// stream holds the event callback of one open conversation stream.
+type stream struct {
+	onEvent assistant.EventCallback
+}
+
+// Registry tracks open conversation streams in memory.
+// Only the most recently attached stream of a conversation receives events.
+type Registry struct {
+	mu      sync.Mutex
+	streams map[uuid.UUID]*stream
+}
+
+// NewRegistry creates a new in-memory conversation stream registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		streams: make(map[uuid.UUID]*stream),
+	}
+}
+
+// Attach registers the event callback of an open stream and returns a function that detaches it.
+func (r *Registry) Attach(conversationID uuid.UUID, onEvent assistant.EventCallback) func() {
+	s := &stream{onEvent: onEvent}
+
+	r.mu.Lock()
+	r.streams[conversationID] = s
+	r.mu.Unlock()
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.streams[conversationID] == s {
+			delete(r.streams, conversationID)
+		}
+	}
+}
+
+// Emit sends an event to the open stream of a conversation. Returns false when no stream is open.
+func (r *Registry) Emit(ctx context.Context, conversationID uuid.UUID, eventType assistant.EventType, data any) (bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	r.mu.Lock()
+	s, found := r.streams[conversationID]
+	r.mu.Unlock()
+	if !found {
+		return false, nil
+	}
+
+	if err := s.onEvent(spanCtx, eventType, data); telemetry.IsErrorRecorded(span, err) {
+		return false, err
+	}
+	return true, nil
+}
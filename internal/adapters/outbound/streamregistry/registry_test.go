@@ -0,0 +1,74 @@
+package streamregistry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_AttachAndEmit(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	registry := NewRegistry()
+
+	var received []assistant.EventType
+	detach := registry.Attach(conversationID, func(_ context.Context, eventType assistant.EventType, _ any) error {
+		received = append(received, eventType)
+		return nil
+	})
+
+	delivered, err := registry.Emit(t.Context(), conversationID, assistant.EventType_FocusSessionCompleted, nil)
+	require.NoError(t, err)
+	assert.True(t, delivered)
+	assert.Equal(t, []assistant.EventType{assistant.EventType_FocusSessionCompleted}, received)
+
+	detach()
+
+	delivered, err = registry.Emit(t.Context(), conversationID, assistant.EventType_FocusSessionCompleted, nil)
+	require.NoError(t, err)
+	assert.False(t, delivered)
+	assert.Len(t, received, 1)
+}
+
+func TestRegistry_DetachKeepsNewerStream(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	registry := NewRegistry()
+
+	detachFirst := registry.Attach(conversationID, func(context.Context, assistant.EventType, any) error {
+		return errors.New("first stream should not receive events")
+	})
+	var secondCalls int
+	registry.Attach(conversationID, func(context.Context, assistant.EventType, any) error {
+		secondCalls++
+		return nil
+	})
+
+	detachFirst()
+
+	delivered, err := registry.Emit(t.Context(), conversationID, assistant.EventType_FocusSessionCompleted, nil)
+	require.NoError(t, err)
+	assert.True(t, delivered)
+	assert.Equal(t, 1, secondCalls)
+}
+
+func TestRegistry_EmitCallbackError(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	registry := NewRegistry()
+	registry.Attach(conversationID, func(context.Context, assistant.EventType, any) error {
+		return errors.New("write failed")
+	})
+
+	delivered, err := registry.Emit(t.Context(), conversationID, assistant.EventType_FocusSessionCompleted, nil)
+	assert.EqualError(t, err, "write failed")
+	assert.False(t, delivered)
+}
@@ -0,0 +1,59 @@
+package streamregistry
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// SessionRegistry tracks the streams opened with each session in memory so revoking a session
+// terminates them. Only the streams of this process are tracked.
+type SessionRegistry struct {
+	mu      sync.Mutex
+	nextID  uint64
+	streams map[uuid.UUID]map[uint64]context.CancelFunc
+}
+
+// NewSessionRegistry creates a new in-memory session stream registry.
+func NewSessionRegistry() *SessionRegistry {
+	return &SessionRegistry{
+		streams: make(map[uuid.UUID]map[uint64]context.CancelFunc),
+	}
+}
+
+// Track registers the cancel function of a stream opened with the session and returns a function that untracks it.
+func (r *SessionRegistry) Track(sessionID uuid.UUID, cancel context.CancelFunc) func() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	id := r.nextID
+	if r.streams[sessionID] == nil {
+		r.streams[sessionID] = make(map[uint64]context.CancelFunc)
+	}
+	r.streams[sessionID][id] = cancel
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		delete(r.streams[sessionID], id)
+		if len(r.streams[sessionID]) == 0 {
+			delete(r.streams, sessionID)
+		}
+	}
+}
+
+// Terminate cancels every stream opened with the session and returns how many were open.
+func (r *SessionRegistry) Terminate(sessionID uuid.UUID) int {
+	r.mu.Lock()
+	streams := r.streams[sessionID]
+	delete(r.streams, sessionID)
+	r.mu.Unlock()
+
+	for _, cancel := range streams {
+		cancel()
+	}
+	return len(streams)
+}
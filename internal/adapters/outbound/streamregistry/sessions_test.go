@@ -0,0 +1,47 @@
+package streamregistry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionRegistry_Terminate(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	registry := NewSessionRegistry()
+
+	chatCtx, cancelChat := context.WithCancel(t.Context())
+	eventsCtx, cancelEvents := context.WithCancel(t.Context())
+	otherCtx, cancelOther := context.WithCancel(t.Context())
+	defer cancelOther()
+	registry.Track(sessionID, cancelChat)
+	registry.Track(sessionID, cancelEvents)
+	registry.Track(otherID, cancelOther)
+
+	assert.Equal(t, 2, registry.Terminate(sessionID))
+	assert.Error(t, chatCtx.Err())
+	assert.Error(t, eventsCtx.Err())
+	assert.NoError(t, otherCtx.Err())
+	assert.Equal(t, 0, registry.Terminate(sessionID))
+}
+
+func TestSessionRegistry_Untrack(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	registry := NewSessionRegistry()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	untrack := registry.Track(sessionID, cancel)
+	untrack()
+
+	assert.Equal(t, 0, registry.Terminate(sessionID))
+	assert.NoError(t, ctx.Err())
+	assert.Empty(t, registry.streams)
+}
@@ -0,0 +1,146 @@
+package tenantdir
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+)
+
+// tenantConfig is the JSON representation of one tenant in the TENANTS configuration.
+type tenantConfig struct {
+	ID              string   `json:"id"`
+	Hosts           []string `json:"hosts"`
+	Models          []string `json:"models"`
+	MaxOutputTokens int      `json:"max_output_tokens"`
+	MaxActionCycles int      `json:"max_action_cycles"`
+}
+
+// ParseTenants parses the TENANTS configuration, a JSON array such as
+// [{"id":"acme","hosts":["acme.todo.example.com"],"models":["gpt-oss"],"max_output_tokens":2048,"max_action_cycles":10}].
+func ParseTenants(raw string) ([]tenant.Tenant, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var configs []tenantConfig
+	if err := json.Unmarshal([]byte(raw), &configs); err != nil {
+		return nil, fmt.Errorf("invalid tenants JSON: %w", err)
+	}
+
+	tenants := make([]tenant.Tenant, 0, len(configs))
+	seenIDs := map[tenant.ID]bool{}
+	seenHosts := map[string]tenant.ID{}
+	for _, c := range configs {
+		id := tenant.ID(strings.TrimSpace(c.ID))
+		if err := id.Validate(); err != nil {
+			return nil, fmt.Errorf("tenant %q: %w", c.ID, err)
+		}
+		if seenIDs[id] {
+			return nil, fmt.Errorf("tenant %q is configured more than once", id)
+		}
+		seenIDs[id] = true
+
+		if c.MaxOutputTokens < 0 || c.MaxActionCycles < 0 {
+			return nil, fmt.Errorf("tenant %q: limits must not be negative", id)
+		}
+
+		hosts := make([]string, 0, len(c.Hosts))
+		for _, h := range c.Hosts {
+			host := normalizeHost(h)
+			if host == "" {
+				continue
+			}
+			if owner, ok := seenHosts[host]; ok {
+				return nil, fmt.Errorf("host %q is assigned to tenants %q and %q", host, owner, id)
+			}
+			seenHosts[host] = id
+			hosts = append(hosts, host)
+		}
+
+		tenants = append(tenants, tenant.Tenant{
+			ID:    id,
+			Hosts: hosts,
+			Settings: tenant.Settings{
+				Models:          c.Models,
+				MaxOutputTokens: c.MaxOutputTokens,
+				MaxActionCycles: c.MaxActionCycles,
+			},
+		})
+	}
+
+	return tenants, nil
+}
+
+// Directory implements tenant.Directory over a static list of tenants.
+type Directory struct {
+	enabled bool
+	byID    map[tenant.ID]tenant.Tenant
+	byHost  map[string]tenant.ID
+}
+
+// NewDirectory creates a Directory. When multi-tenant mode is disabled every request resolves
+// to the Default tenant, with the settings configured for it, if any.
+func NewDirectory(enabled bool, tenants []tenant.Tenant) Directory {
+	d := Directory{
+		enabled: enabled,
+		byID:    make(map[tenant.ID]tenant.Tenant, len(tenants)),
+		byHost:  map[string]tenant.ID{},
+	}
+	for _, t := range tenants {
+		d.byID[t.ID] = t
+		for _, h := range t.Hosts {
+			d.byHost[h] = t.ID
+		}
+	}
+	return d
+}
+
+// Resolve implements tenant.Directory. A host claimed by a tenant always resolves to it, and requesting another
+// tenant on it is forbidden, so a client cannot reach another tenant's data by naming it. On hosts no tenant
+// claims the requested ID wins; requests naming no tenant fall back to the Default tenant when it is configured.
+func (d Directory) Resolve(_ context.Context, host string, requested tenant.ID) (tenant.Tenant, error) {
+	if !d.enabled {
+		return d.lookup(tenant.Default), nil
+	}
+
+	if id, ok := d.byHost[normalizeHost(host)]; ok {
+		if requested != "" && requested != id {
+			return tenant.Tenant{}, core.NewForbiddenErr(fmt.Sprintf("tenant %q is not served by this host", requested))
+		}
+		return d.byID[id], nil
+	}
+
+	if requested != "" {
+		if t, ok := d.byID[requested]; ok {
+			return t, nil
+		}
+		return tenant.Tenant{}, core.NewNotFoundErr(fmt.Sprintf("tenant %q not found", requested))
+	}
+	if t, ok := d.byID[tenant.Default]; ok {
+		return t, nil
+	}
+	return tenant.Tenant{}, core.NewNotFoundErr("no tenant serves this host")
+}
+
+// lookup returns the configured tenant or a tenant with default settings.
+func (d Directory) lookup(id tenant.ID) tenant.Tenant {
+	if t, ok := d.byID[id]; ok {
+		return t
+	}
+	return tenant.Tenant{ID: id}
+}
+
+// normalizeHost lowercases the host and strips the port, so "Acme.Example.com:8080" matches "acme.example.com".
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
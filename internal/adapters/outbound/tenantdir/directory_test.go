@@ -0,0 +1,161 @@
+package tenantdir
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTenants(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		raw        string
+		want       []tenant.Tenant
+		wantErrMsg string
+	}{
+		"empty": {
+			raw: "  ",
+		},
+		"valid": {
+			raw: `[
+				{"id":"acme","hosts":["Acme.Example.com:443",""],"models":["m1"],"max_output_tokens":2048,"max_action_cycles":10},
+				{"id":"globex"}
+			]`,
+			want: []tenant.Tenant{
+				{
+					ID:    "acme",
+					Hosts: []string{"acme.example.com"},
+					Settings: tenant.Settings{
+						Models:          []string{"m1"},
+						MaxOutputTokens: 2048,
+						MaxActionCycles: 10,
+					},
+				},
+				{ID: "globex", Hosts: []string{}},
+			},
+		},
+		"invalid-json": {
+			raw:        `[{"id":}]`,
+			wantErrMsg: "invalid tenants JSON",
+		},
+		"invalid-id": {
+			raw:        `[{"id":"Acme Corp"}]`,
+			wantErrMsg: `tenant "Acme Corp"`,
+		},
+		"duplicate-id": {
+			raw:        `[{"id":"acme"},{"id":"acme"}]`,
+			wantErrMsg: `tenant "acme" is configured more than once`,
+		},
+		"duplicate-host": {
+			raw:        `[{"id":"acme","hosts":["todo.example.com"]},{"id":"globex","hosts":["TODO.example.com"]}]`,
+			wantErrMsg: `host "todo.example.com" is assigned to tenants "acme" and "globex"`,
+		},
+		"negative-limit": {
+			raw:        `[{"id":"acme","max_action_cycles":-1}]`,
+			wantErrMsg: `tenant "acme": limits must not be negative`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTenants(tt.raw)
+			if tt.wantErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDirectory_Resolve(t *testing.T) {
+	t.Parallel()
+
+	acme := tenant.Tenant{ID: "acme", Hosts: []string{"acme.example.com"}}
+	globex := tenant.Tenant{ID: "globex", Hosts: []string{"globex.example.com"}}
+	defaultTenant := tenant.Tenant{ID: tenant.Default, Settings: tenant.Settings{MaxActionCycles: 3}}
+
+	tests := map[string]struct {
+		directory     Directory
+		host          string
+		requested     tenant.ID
+		want          tenant.Tenant
+		wantNotFound  bool
+		wantForbidden bool
+	}{
+		"disabled-ignores-request": {
+			directory: NewDirectory(false, []tenant.Tenant{acme}),
+			host:      "acme.example.com",
+			requested: "acme",
+			want:      tenant.Tenant{ID: tenant.Default},
+		},
+		"disabled-uses-default-settings": {
+			directory: NewDirectory(false, []tenant.Tenant{defaultTenant}),
+			want:      defaultTenant,
+		},
+		"requested-id-on-shared-host": {
+			directory: NewDirectory(true, []tenant.Tenant{acme, globex, defaultTenant}),
+			host:      "api.example.com",
+			requested: "globex",
+			want:      globex,
+		},
+		"requested-id-matching-host": {
+			directory: NewDirectory(true, []tenant.Tenant{acme, globex}),
+			host:      "acme.example.com",
+			requested: "acme",
+			want:      acme,
+		},
+		"requested-id-of-another-tenants-host": {
+			directory:     NewDirectory(true, []tenant.Tenant{acme, globex}),
+			host:          "acme.example.com",
+			requested:     "globex",
+			wantForbidden: true,
+		},
+		"unknown-requested-id": {
+			directory:    NewDirectory(true, []tenant.Tenant{acme, defaultTenant}),
+			requested:    "initech",
+			wantNotFound: true,
+		},
+		"host-with-port": {
+			directory: NewDirectory(true, []tenant.Tenant{acme, globex}),
+			host:      "Globex.Example.com:8080",
+			want:      globex,
+		},
+		"unknown-host-falls-back-to-default": {
+			directory: NewDirectory(true, []tenant.Tenant{acme, defaultTenant}),
+			host:      "localhost:8080",
+			want:      defaultTenant,
+		},
+		"unknown-host-without-default": {
+			directory:    NewDirectory(true, []tenant.Tenant{acme}),
+			host:         "localhost:8080",
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.directory.Resolve(t.Context(), tt.host, tt.requested)
+			if tt.wantNotFound {
+				assert.IsType(t, &core.NotFoundErr{}, err)
+				return
+			}
+			if tt.wantForbidden {
+				assert.IsType(t, &core.ForbiddenErr{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package tenantdir
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitDirectory is used to initialize and register the tenant directory.
+type InitDirectory struct {
+	Enabled bool   `config:"MULTI_TENANT_ENABLED" default:"false"`
+	Tenants string `config:"TENANTS" default:""`
+}
+
+// Initialize parses the configured tenants and registers the directory in the dependency container.
+func (i InitDirectory) Initialize(ctx context.Context) (context.Context, error) {
+	tenants, err := ParseTenants(i.Tenants)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse TENANTS: %w", err)
+	}
+	depend.Register[tenant.Directory](NewDirectory(i.Enabled, tenants))
+	return ctx, nil
+}
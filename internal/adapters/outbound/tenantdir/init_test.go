@@ -0,0 +1,36 @@
+package tenantdir
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitDirectory_Initialize(t *testing.T) {
+	i := InitDirectory{
+		Enabled: true,
+		Tenants: `[{"id":"acme","hosts":["acme.example.com"]}]`,
+	}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[tenant.Directory]()
+	require.NoError(t, err)
+
+	got, err := registered.Resolve(t.Context(), "acme.example.com", "")
+	require.NoError(t, err)
+	assert.Equal(t, tenant.ID("acme"), got.ID)
+}
+
+func TestInitDirectory_Initialize_InvalidTenants(t *testing.T) {
+	i := InitDirectory{Tenants: `{`}
+
+	_, err := i.Initialize(t.Context())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse TENANTS")
+}
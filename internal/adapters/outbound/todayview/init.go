@@ -0,0 +1,43 @@
+package todayview
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitRepository wraps the registered todo.Repository with the today view cache.
+// It must run after the todo repository and the todo event stream are registered, and only in
+// deployables that forward todo outbox events into that stream, since events drive invalidation.
+type InitRepository struct {
+	TodoRepo    todo.Repository        `resolve:""`
+	EventStream outbox.TodoEventStream `resolve:""`
+	TTL         time.Duration          `config:"TODO_TODAY_VIEW_CACHE_TTL" default:"30s"`
+	unsubscribe func()
+}
+
+// Initialize registers the caching repository in place of the todo repository. A zero TTL disables the cache.
+func (i *InitRepository) Initialize(ctx context.Context) (context.Context, error) {
+	if i.TTL <= 0 {
+		return ctx, nil
+	}
+
+	repo := NewRepository(i.TodoRepo, i.TTL)
+	events, unsubscribe := i.EventStream.Subscribe()
+	i.unsubscribe = unsubscribe
+	go repo.InvalidateOn(events)
+
+	depend.Register[todo.Repository](repo)
+	return ctx, nil
+}
+
+// Close stops listening to todo events.
+func (i *InitRepository) Close() {
+	if i == nil || i.unsubscribe == nil {
+		return
+	}
+	i.unsubscribe()
+}
@@ -0,0 +1,53 @@
+package todayview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitRepository_Initialize(t *testing.T) {
+	repo := todo.NewMockRepository(t)
+	stream := outbox.NewMockTodoEventStream(t)
+	events := make(chan outbox.TodoEvent)
+	unsubscribed := false
+	stream.EXPECT().Subscribe().Return(events, func() {
+		unsubscribed = true
+		close(events)
+	}).Once()
+
+	i := &InitRepository{TodoRepo: repo, EventStream: stream, TTL: 30 * time.Second}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[todo.Repository]()
+	require.NoError(t, err)
+	assert.IsType(t, &Repository{}, registered)
+
+	i.Close()
+	assert.True(t, unsubscribed)
+}
+
+func TestInitRepository_Initialize_Disabled(t *testing.T) {
+	repo := todo.NewMockRepository(t)
+	stream := outbox.NewMockTodoEventStream(t)
+	depend.Register[todo.Repository](repo)
+
+	i := &InitRepository{TodoRepo: repo, EventStream: stream, TTL: 0}
+
+	_, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+
+	registered, err := depend.Resolve[todo.Repository]()
+	require.NoError(t, err)
+	assert.Same(t, repo, registered)
+
+	i.Close()
+}
@@ -0,0 +1,127 @@
+package todayview
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// pageKey identifies a cached page: tenants never share the cached today view.
+type pageKey struct {
+	tenantID tenant.ID
+	pageSize int
+}
+
+// page is the cached first page of the today view of one tenant for one page size.
+type page struct {
+	todos     []todo.Todo
+	hasMore   bool
+	expiresAt time.Time
+}
+
+// Repository decorates a todo.Repository with an in-memory cache of the today view: the first page of
+// open todos sorted by due date, which is the default query of the board and of fetch_todos.
+// Cached pages are dropped on every todo event and expire after a TTL in case an event is missed.
+type Repository struct {
+	todo.Repository
+	ttl time.Duration
+	now func() time.Time
+
+	mu         sync.Mutex
+	pages      map[pageKey]page
+	generation uint64
+}
+
+// NewRepository creates a Repository caching the today view of repo for ttl.
+func NewRepository(repo todo.Repository, ttl time.Duration) *Repository {
+	return &Repository{
+		Repository: repo,
+		ttl:        ttl,
+		now:        time.Now,
+		pages:      make(map[pageKey]page),
+	}
+}
+
+// ListTodos serves the first page of the today view from the cache and delegates every other query.
+// Queries run inside a transaction.Sandbox always delegate, since they see changes the cache must not keep.
+func (r *Repository) ListTodos(ctx context.Context, pageNumber int, pageSize int, opts ...todo.ListOption) ([]todo.Todo, bool, error) {
+	params := todo.ListParams{}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	if pageNumber != 1 || !params.IsTodayView() || transaction.InSandbox(ctx) {
+		return r.Repository.ListTodos(ctx, pageNumber, pageSize, opts...)
+	}
+
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("page_size", pageSize),
+	))
+	defer span.End()
+
+	key := pageKey{tenantID: tenant.IDFromContext(ctx), pageSize: pageSize}
+
+	r.mu.Lock()
+	cached, found := r.pages[key]
+	generation := r.generation
+	r.mu.Unlock()
+
+	hit := found && r.now().Before(cached.expiresAt)
+	metrics.RecordTodayViewCacheRequest(spanCtx, hit)
+	span.SetAttributes(attribute.Bool("cache_hit", hit))
+	if hit {
+		return cloneTodos(cached.todos), cached.hasMore, nil
+	}
+
+	todos, hasMore, err := r.Repository.ListTodos(spanCtx, pageNumber, pageSize, opts...)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	r.mu.Lock()
+	// A todo event received while loading may have made this result stale already.
+	if generation == r.generation {
+		r.pages[key] = page{
+			todos:     cloneTodos(todos),
+			hasMore:   hasMore,
+			expiresAt: r.now().Add(r.ttl),
+		}
+	}
+	r.mu.Unlock()
+
+	return todos, hasMore, nil
+}
+
+// Invalidate drops every cached page.
+func (r *Repository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	clear(r.pages)
+}
+
+// InvalidateOn drops the cached pages whenever a todo event is received, until the events channel is closed.
+func (r *Repository) InvalidateOn(events <-chan outbox.TodoEvent) {
+	for range events {
+		r.Invalidate()
+	}
+}
+
+// cloneTodos copies the todo slice so callers cannot mutate cached pages.
+func cloneTodos(todos []todo.Todo) []todo.Todo {
+	if todos == nil {
+		return nil
+	}
+	cloned := make([]todo.Todo, len(todos))
+	copy(cloned, todos)
+	return cloned
+}
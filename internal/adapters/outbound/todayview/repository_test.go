@@ -0,0 +1,197 @@
+package todayview
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListTodos(t *testing.T) {
+	t.Parallel()
+
+	todayView := []todo.ListOption{todo.WithStatus(todo.Status_OPEN), todo.WithSortBy("dueDateAsc")}
+	firstLoad := []todo.Todo{{ID: uuid.New(), Title: "Buy milk", Status: todo.Status_OPEN}}
+	secondLoad := []todo.Todo{{ID: uuid.New(), Title: "Pay rent", Status: todo.Status_OPEN}}
+
+	tests := map[string]struct {
+		opts       []todo.ListOption
+		page       int
+		setupMocks func(*todo.MockRepository)
+		between    func(*Repository)
+		// secondTenant scopes the second read to another tenant when set.
+		secondTenant tenant.ID
+		wantFirst    []todo.Todo
+		wantSecond   []todo.Todo
+		wantHasMore  bool
+	}{
+		"serves-second-read-from-cache": {
+			opts: todayView,
+			page: 1,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(firstLoad, true, nil).Once()
+			},
+			wantFirst:   firstLoad,
+			wantSecond:  firstLoad,
+			wantHasMore: true,
+		},
+		"reloads-after-invalidation": {
+			opts: todayView,
+			page: 1,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(firstLoad, false, nil).Once()
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(secondLoad, false, nil).Once()
+			},
+			between: func(r *Repository) {
+				r.Invalidate()
+			},
+			wantFirst:  firstLoad,
+			wantSecond: secondLoad,
+		},
+		"reloads-after-ttl": {
+			opts: todayView,
+			page: 1,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(firstLoad, false, nil).Once()
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(secondLoad, false, nil).Once()
+			},
+			between: func(r *Repository) {
+				r.now = func() time.Time { return time.Now().Add(time.Minute) }
+			},
+			wantFirst:  firstLoad,
+			wantSecond: secondLoad,
+		},
+		"does-not-share-pages-across-tenants": {
+			opts: todayView,
+			page: 1,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(firstLoad, false, nil).Once()
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+					Return(secondLoad, false, nil).Once()
+			},
+			secondTenant: "acme",
+			wantFirst:    firstLoad,
+			wantSecond:   secondLoad,
+		},
+		"bypasses-other-pages": {
+			opts: todayView,
+			page: 2,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 2, 10, mock.Anything, mock.Anything).
+					Return(firstLoad, false, nil).Once()
+				repo.EXPECT().ListTodos(mock.Anything, 2, 10, mock.Anything, mock.Anything).
+					Return(secondLoad, false, nil).Once()
+			},
+			wantFirst:  firstLoad,
+			wantSecond: secondLoad,
+		},
+		"bypasses-other-queries": {
+			opts: []todo.ListOption{todo.WithStatus(todo.Status_DONE)},
+			page: 1,
+			setupMocks: func(repo *todo.MockRepository) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return(firstLoad, false, nil).Once()
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Return(secondLoad, false, nil).Once()
+			},
+			wantFirst:  firstLoad,
+			wantSecond: secondLoad,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := todo.NewMockRepository(t)
+			tt.setupMocks(repo)
+			cached := NewRepository(repo, 30*time.Second)
+
+			got, hasMore, err := cached.ListTodos(t.Context(), tt.page, 10, tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFirst, got)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+
+			if tt.between != nil {
+				tt.between(cached)
+			}
+
+			secondCtx := t.Context()
+			if tt.secondTenant != "" {
+				secondCtx = tenant.WithID(secondCtx, tt.secondTenant)
+			}
+			got, hasMore, err = cached.ListTodos(secondCtx, tt.page, 10, tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSecond, got)
+			assert.Equal(t, tt.wantHasMore, hasMore)
+		})
+	}
+}
+
+func TestRepository_ListTodos_DoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	repo := todo.NewMockRepository(t)
+	repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+		Return(nil, false, errors.New("db down")).Once()
+	repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+		Return([]todo.Todo{}, false, nil).Once()
+	cached := NewRepository(repo, 30*time.Second)
+
+	_, _, err := cached.ListTodos(t.Context(), 1, 10, todo.WithStatus(todo.Status_OPEN), todo.WithSortBy("dueDateAsc"))
+	assert.Error(t, err)
+
+	got, _, err := cached.ListTodos(t.Context(), 1, 10, todo.WithStatus(todo.Status_OPEN), todo.WithSortBy("dueDateAsc"))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestRepository_ListTodos_BypassesCacheInSandbox(t *testing.T) {
+	t.Parallel()
+
+	sandboxed := []todo.Todo{{ID: uuid.New(), Title: "Previewed", Status: todo.Status_OPEN}}
+	repo := todo.NewMockRepository(t)
+	repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+		Return(sandboxed, false, nil).Once()
+	repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything, mock.Anything).
+		Return([]todo.Todo{}, false, nil).Once()
+	cached := NewRepository(repo, 30*time.Second)
+
+	got, _, err := cached.ListTodos(transaction.WithSandbox(t.Context()), 1, 10, todo.WithStatus(todo.Status_OPEN), todo.WithSortBy("dueDateAsc"))
+	require.NoError(t, err)
+	assert.Equal(t, sandboxed, got)
+
+	got, _, err = cached.ListTodos(t.Context(), 1, 10, todo.WithStatus(todo.Status_OPEN), todo.WithSortBy("dueDateAsc"))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestRepository_InvalidateOn(t *testing.T) {
+	t.Parallel()
+
+	repo := todo.NewMockRepository(t)
+	cached := NewRepository(repo, 30*time.Second)
+	cached.pages[pageKey{tenantID: tenant.Default, pageSize: 10}] = page{expiresAt: time.Now().Add(time.Minute)}
+
+	events := make(chan outbox.TodoEvent, 1)
+	events <- outbox.TodoEvent{Type: outbox.EventType_TODO_UPDATED, TodoID: uuid.New()}
+	close(events)
+	cached.InvalidateOn(events)
+
+	assert.Empty(t, cached.pages)
+	assert.Equal(t, uint64(1), cached.generation)
+}
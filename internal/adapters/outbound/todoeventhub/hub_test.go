@@ -0,0 +1,89 @@
+package todoeventhub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub_Broadcast(t *testing.T) {
+	t.Parallel()
+
+	event := outbox.TodoEvent{
+		Type:      outbox.EventType_TODO_UPDATED,
+		TodoID:    uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		CreatedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	tests := map[string]struct {
+		listeners   int
+		unsubscribe int
+	}{
+		"single-listener": {
+			listeners: 1,
+		},
+		"fans-out-to-every-listener": {
+			listeners: 3,
+		},
+		"skips-removed-listeners": {
+			listeners:   3,
+			unsubscribe: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hub := NewHub()
+			channels := make([]<-chan outbox.TodoEvent, tt.listeners)
+			removers := make([]func(), tt.listeners)
+			for i := range tt.listeners {
+				channels[i], removers[i] = hub.Subscribe()
+			}
+			for i := range tt.unsubscribe {
+				removers[i]()
+			}
+
+			hub.Broadcast(event)
+
+			for i, ch := range channels {
+				got, open := <-ch
+				if i < tt.unsubscribe {
+					assert.False(t, open)
+					continue
+				}
+				assert.True(t, open)
+				assert.Equal(t, event, got)
+			}
+		})
+	}
+}
+
+func TestHub_Broadcast_DropsWhenListenerIsFull(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+	ch, unsubscribe := hub.Subscribe()
+	defer unsubscribe()
+
+	for range listenerBufferSize + 5 {
+		hub.Broadcast(outbox.TodoEvent{Type: outbox.EventType_TODO_CREATED, TodoID: uuid.New()})
+	}
+
+	assert.Len(t, ch, listenerBufferSize)
+}
+
+func TestHub_Unsubscribe_IsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	hub := NewHub()
+	_, unsubscribe := hub.Subscribe()
+
+	unsubscribe()
+	assert.NotPanics(t, unsubscribe)
+	assert.Empty(t, hub.listeners)
+}
@@ -0,0 +1,17 @@
+package todoeventhub
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitHub is used to initialize and register the todo event hub.
+type InitHub struct{}
+
+// Initialize creates and registers the hub in the dependency container.
+func (i InitHub) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[outbox.TodoEventStream](NewHub())
+	return ctx, nil
+}
@@ -0,0 +1,22 @@
+package todoeventhub
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitHub_Initialize(t *testing.T) {
+	i := InitHub{}
+
+	ctx, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[outbox.TodoEventStream]()
+	require.NoError(t, err)
+	assert.NotNil(t, registered)
+}
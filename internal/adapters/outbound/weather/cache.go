@@ -0,0 +1,94 @@
+package weather
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+)
+
+// maxCachedForecasts bounds the cache; expired entries are dropped first when it is full.
+const maxCachedForecasts = 1000
+
+// cacheKey identifies a cached forecast. Forecasts are public data, so tenants share them.
+type cacheKey struct {
+	location string
+	days     int
+}
+
+// cachedForecast is a forecast with its expiry time.
+type cachedForecast struct {
+	forecast  assistant.WeatherForecast
+	expiresAt time.Time
+}
+
+// CachingForecaster decorates an assistant.WeatherForecaster with an in-memory cache, so repeated
+// questions about the same place do not call the weather API again until the TTL expires.
+type CachingForecaster struct {
+	next assistant.WeatherForecaster
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]cachedForecast
+}
+
+// NewCachingForecaster creates a CachingForecaster caching the forecasts of next for ttl.
+func NewCachingForecaster(next assistant.WeatherForecaster, ttl time.Duration) *CachingForecaster {
+	return &CachingForecaster{
+		next:    next,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[cacheKey]cachedForecast),
+	}
+}
+
+// Forecast implements assistant.WeatherForecaster.Forecast.
+func (c *CachingForecaster) Forecast(ctx context.Context, location string, days int) (assistant.WeatherForecast, error) {
+	key := cacheKey{location: strings.ToLower(strings.Join(strings.Fields(location), " ")), days: days}
+
+	c.mu.Lock()
+	cached, found := c.entries[key]
+	c.mu.Unlock()
+
+	hit := found && c.now().Before(cached.expiresAt)
+	metrics.RecordWeatherCacheRequest(ctx, hit)
+	if hit {
+		return cloneForecast(cached.forecast), nil
+	}
+
+	forecast, err := c.next.Forecast(ctx, location, days)
+	if err != nil {
+		return assistant.WeatherForecast{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCachedForecasts {
+		c.evict()
+	}
+	c.entries[key] = cachedForecast{forecast: cloneForecast(forecast), expiresAt: c.now().Add(c.ttl)}
+	return forecast, nil
+}
+
+// evict drops the expired entries, or every entry when none has expired. It must be called with mu held.
+func (c *CachingForecaster) evict() {
+	now := c.now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) >= maxCachedForecasts {
+		clear(c.entries)
+	}
+}
+
+// cloneForecast copies a forecast so callers cannot change cached days.
+func cloneForecast(forecast assistant.WeatherForecast) assistant.WeatherForecast {
+	forecast.Days = append([]assistant.WeatherDay(nil), forecast.Days...)
+	return forecast
+}
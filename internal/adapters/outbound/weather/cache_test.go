@@ -0,0 +1,95 @@
+package weather
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingForecaster_Forecast(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	forecast := assistant.WeatherForecast{
+		Location: "Lisbon, Portugal",
+		Days:     []assistant.WeatherDay{{Date: now, Summary: "clear sky"}},
+	}
+
+	tests := map[string]struct {
+		setupMocks  func(*assistant.MockWeatherForecaster)
+		run         func(t *testing.T, c *CachingForecaster, clock *time.Time)
+		expectedErr string
+	}{
+		"serves-cached-forecast": {
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(forecast, nil).Once()
+			},
+			run: func(t *testing.T, c *CachingForecaster, clock *time.Time) {
+				got, err := c.Forecast(t.Context(), "Lisbon", 7)
+				require.NoError(t, err)
+				assert.Equal(t, forecast, got)
+
+				// Case and spacing do not change the key.
+				got, err = c.Forecast(t.Context(), "  lisbon ", 7)
+				require.NoError(t, err)
+				assert.Equal(t, forecast, got)
+			},
+		},
+		"refetches-after-ttl": {
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(forecast, nil).Twice()
+			},
+			run: func(t *testing.T, c *CachingForecaster, clock *time.Time) {
+				_, err := c.Forecast(t.Context(), "Lisbon", 7)
+				require.NoError(t, err)
+				*clock = clock.Add(time.Hour)
+				_, err = c.Forecast(t.Context(), "Lisbon", 7)
+				require.NoError(t, err)
+			},
+		},
+		"days-are-part-of-the-key": {
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(forecast, nil).Once()
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 3).Return(forecast, nil).Once()
+			},
+			run: func(t *testing.T, c *CachingForecaster, clock *time.Time) {
+				_, err := c.Forecast(t.Context(), "Lisbon", 7)
+				require.NoError(t, err)
+				_, err = c.Forecast(t.Context(), "Lisbon", 3)
+				require.NoError(t, err)
+			},
+		},
+		"errors-are-not-cached": {
+			setupMocks: func(m *assistant.MockWeatherForecaster) {
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(assistant.WeatherForecast{}, errors.New("timeout")).Once()
+				m.EXPECT().Forecast(mock.Anything, "Lisbon", 7).Return(forecast, nil).Once()
+			},
+			run: func(t *testing.T, c *CachingForecaster, clock *time.Time) {
+				_, err := c.Forecast(t.Context(), "Lisbon", 7)
+				assert.EqualError(t, err, "timeout")
+				got, err := c.Forecast(t.Context(), "Lisbon", 7)
+				require.NoError(t, err)
+				assert.Equal(t, forecast, got)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := assistant.NewMockWeatherForecaster(t)
+			tt.setupMocks(next)
+
+			clock := now
+			c := NewCachingForecaster(next, 30*time.Minute)
+			c.now = func() time.Time { return clock }
+			tt.run(t, c, &clock)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// Provider_OpenMeteo forecasts with the keyless Open-Meteo APIs.
+const Provider_OpenMeteo = "open-meteo"
+
+// InitForecaster registers the assistant.WeatherForecaster selected by WEATHER_PROVIDER. The weather
+// action stays disabled, and nothing is registered, when the provider is empty.
+type InitForecaster struct {
+	HttpClient    *http.Client  `resolve:"standard"`
+	Provider      string        `config:"WEATHER_PROVIDER" default:""`
+	ForecastHost  string        `config:"WEATHER_API_HOST" default:"https://api.open-meteo.com"`
+	GeocodingHost string        `config:"WEATHER_GEOCODING_HOST" default:"https://geocoding-api.open-meteo.com"`
+	CacheTTL      time.Duration `config:"WEATHER_CACHE_TTL" default:"30m"`
+}
+
+// Initialize creates and registers the forecaster in the dependency container. A zero TTL disables the cache.
+func (i InitForecaster) Initialize(ctx context.Context) (context.Context, error) {
+	var forecaster assistant.WeatherForecaster
+	switch i.Provider {
+	case "":
+		return ctx, nil
+	case Provider_OpenMeteo:
+		forecaster = NewOpenMeteoForecaster(i.HttpClient, i.ForecastHost, i.GeocodingHost)
+	default:
+		return ctx, fmt.Errorf("unknown weather provider %q", i.Provider)
+	}
+
+	if i.CacheTTL > 0 {
+		forecaster = NewCachingForecaster(forecaster, i.CacheTTL)
+	}
+	depend.Register(forecaster)
+	return ctx, nil
+}
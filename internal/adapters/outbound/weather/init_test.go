@@ -0,0 +1,48 @@
+package weather
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitForecaster_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		init      InitForecaster
+		expected  assistant.WeatherForecaster
+		expectErr bool
+	}{
+		"open-meteo-cached": {
+			init:     InitForecaster{Provider: Provider_OpenMeteo, HttpClient: http.DefaultClient, CacheTTL: time.Minute},
+			expected: &CachingForecaster{},
+		},
+		"open-meteo-uncached": {
+			init:     InitForecaster{Provider: Provider_OpenMeteo, HttpClient: http.DefaultClient},
+			expected: OpenMeteoForecaster{},
+		},
+		"unknown-provider": {
+			init:      InitForecaster{Provider: "other"},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			forecaster, err := depend.Resolve[assistant.WeatherForecaster]()
+			require.NoError(t, err)
+			assert.IsType(t, tt.expected, forecaster)
+		})
+	}
+}
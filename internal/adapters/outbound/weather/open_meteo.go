@@ -0,0 +1,218 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MaxForecastDays is the longest forecast the Open-Meteo free API returns.
+const MaxForecastDays = 16
+
+// OpenMeteoForecaster implements the assistant.WeatherForecaster interface with the Open-Meteo geocoding
+// and forecast APIs, which do not need an API key.
+type OpenMeteoForecaster struct {
+	client        *http.Client
+	forecastHost  string
+	geocodingHost string
+}
+
+// NewOpenMeteoForecaster creates a new OpenMeteoForecaster calling the given API hosts.
+func NewOpenMeteoForecaster(client *http.Client, forecastHost, geocodingHost string) OpenMeteoForecaster {
+	return OpenMeteoForecaster{
+		client:        client,
+		forecastHost:  forecastHost,
+		geocodingHost: geocodingHost,
+	}
+}
+
+// geocodingResponse is the subset of the Open-Meteo geocoding response the forecaster reads.
+type geocodingResponse struct {
+	Results []struct {
+		Name      string  `json:"name"`
+		Admin1    string  `json:"admin1"`
+		Country   string  `json:"country"`
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	} `json:"results"`
+}
+
+// forecastResponse is the subset of the Open-Meteo forecast response the forecaster reads.
+type forecastResponse struct {
+	Timezone string `json:"timezone"`
+	Daily    struct {
+		Time                        []string   `json:"time"`
+		WeatherCode                 []int      `json:"weather_code"`
+		TemperatureMin              []float64  `json:"temperature_2m_min"`
+		TemperatureMax              []float64  `json:"temperature_2m_max"`
+		PrecipitationSum            []float64  `json:"precipitation_sum"`
+		PrecipitationProbabilityMax []*float64 `json:"precipitation_probability_max"`
+	} `json:"daily"`
+}
+
+// Forecast implements assistant.WeatherForecaster.Forecast.
+func (f OpenMeteoForecaster) Forecast(ctx context.Context, location string, days int) (assistant.WeatherForecast, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("location", location),
+		attribute.Int("days", days),
+	))
+	defer span.End()
+
+	forecast, err := f.forecast(spanCtx, location, days)
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.WeatherForecast{}, err
+	}
+	return forecast, nil
+}
+
+// forecast geocodes location and fetches its daily forecast.
+func (f OpenMeteoForecaster) forecast(ctx context.Context, location string, days int) (assistant.WeatherForecast, error) {
+	days = min(max(days, 1), MaxForecastDays)
+
+	var places geocodingResponse
+	err := f.get(ctx, f.geocodingHost, "/v1/search", url.Values{
+		"name":     {location},
+		"count":    {"1"},
+		"language": {"en"},
+		"format":   {"json"},
+	}, &places)
+	if err != nil {
+		return assistant.WeatherForecast{}, fmt.Errorf("geocode location: %w", err)
+	}
+	if len(places.Results) == 0 {
+		return assistant.WeatherForecast{}, core.NewNotFoundErr(fmt.Sprintf("location %q not found", location))
+	}
+	place := places.Results[0]
+
+	var resp forecastResponse
+	err = f.get(ctx, f.forecastHost, "/v1/forecast", url.Values{
+		"latitude":      {strconv.FormatFloat(place.Latitude, 'f', 4, 64)},
+		"longitude":     {strconv.FormatFloat(place.Longitude, 'f', 4, 64)},
+		"daily":         {"weather_code,temperature_2m_min,temperature_2m_max,precipitation_sum,precipitation_probability_max"},
+		"timezone":      {"auto"},
+		"forecast_days": {strconv.Itoa(days)},
+	}, &resp)
+	if err != nil {
+		return assistant.WeatherForecast{}, fmt.Errorf("fetch forecast: %w", err)
+	}
+
+	forecast := assistant.WeatherForecast{
+		Location: placeName(place.Name, place.Admin1, place.Country),
+		Timezone: resp.Timezone,
+	}
+	daily := resp.Daily
+	for i, day := range daily.Time {
+		date, err := time.Parse(time.DateOnly, day)
+		if err != nil {
+			return assistant.WeatherForecast{}, fmt.Errorf("invalid forecast date %q: %w", day, err)
+		}
+		forecast.Days = append(forecast.Days, assistant.WeatherDay{
+			Date:                     date,
+			Summary:                  weatherCodeSummary(valueAt(daily.WeatherCode, i)),
+			MinTemperatureC:          valueAt(daily.TemperatureMin, i),
+			MaxTemperatureC:          valueAt(daily.TemperatureMax, i),
+			PrecipitationMM:          valueAt(daily.PrecipitationSum, i),
+			PrecipitationProbability: probabilityAt(daily.PrecipitationProbabilityMax, i),
+		})
+	}
+	return forecast, nil
+}
+
+// get calls an Open-Meteo endpoint and decodes its JSON response into out.
+func (f OpenMeteoForecaster) get(ctx context.Context, host, path string, query url.Values, out any) error {
+	endpoint, err := url.JoinPath(host, path)
+	if err != nil {
+		return fmt.Errorf("invalid host: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("http do: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// placeName joins the non-empty, distinct parts of a place name.
+func placeName(parts ...string) string {
+	var names []string
+	for _, part := range parts {
+		if part != "" && (len(names) == 0 || names[len(names)-1] != part) {
+			names = append(names, part)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// valueAt returns values[i], or the zero value when the API returned a shorter series.
+func valueAt[T any](values []T, i int) T {
+	var zero T
+	if i >= len(values) {
+		return zero
+	}
+	return values[i]
+}
+
+// probabilityAt returns the rounded precipitation probability of day i, zero when it is unknown.
+func probabilityAt(values []*float64, i int) int {
+	if v := valueAt(values, i); v != nil {
+		return int(math.Round(*v))
+	}
+	return 0
+}
+
+// weatherCodeSummary describes a WMO weather interpretation code.
+func weatherCodeSummary(code int) string {
+	switch {
+	case code == 0:
+		return "clear sky"
+	case code <= 2:
+		return "partly cloudy"
+	case code == 3:
+		return "overcast"
+	case code == 45 || code == 48:
+		return "fog"
+	case code >= 51 && code <= 57:
+		return "drizzle"
+	case code >= 61 && code <= 67:
+		return "rain"
+	case code >= 71 && code <= 77:
+		return "snow"
+	case code >= 80 && code <= 82:
+		return "rain showers"
+	case code == 85 || code == 86:
+		return "snow showers"
+	case code >= 95:
+		return "thunderstorm"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,130 @@
+package weather
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenMeteoForecaster_Forecast(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		handler          http.HandlerFunc
+		days             int
+		expectedForecast assistant.WeatherForecast
+		expectedErr      func(t *testing.T, err error)
+	}{
+		"success": {
+			days: 2,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/search":
+					assert.Equal(t, "Lisbon", r.URL.Query().Get("name"))
+					_, _ = w.Write([]byte(`{"results":[{"name":"Lisbon","admin1":"Lisbon","country":"Portugal","latitude":38.71667,"longitude":-9.13333}]}`))
+				case "/v1/forecast":
+					assert.Equal(t, "38.7167", r.URL.Query().Get("latitude"))
+					assert.Equal(t, "-9.1333", r.URL.Query().Get("longitude"))
+					assert.Equal(t, "2", r.URL.Query().Get("forecast_days"))
+					_, _ = w.Write([]byte(`{"timezone":"Europe/Lisbon","daily":{
+						"time":["2026-03-02","2026-03-03"],
+						"weather_code":[1,63],
+						"temperature_2m_min":[9.1,11],
+						"temperature_2m_max":[17.4,15.2],
+						"precipitation_sum":[0,12.3],
+						"precipitation_probability_max":[5.4,null]}}`))
+				default:
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+			},
+			expectedForecast: assistant.WeatherForecast{
+				Location: "Lisbon, Portugal",
+				Timezone: "Europe/Lisbon",
+				Days: []assistant.WeatherDay{
+					{
+						Date:                     time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+						Summary:                  "partly cloudy",
+						MinTemperatureC:          9.1,
+						MaxTemperatureC:          17.4,
+						PrecipitationProbability: 5,
+					},
+					{
+						Date:            time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+						Summary:         "rain",
+						MinTemperatureC: 11,
+						MaxTemperatureC: 15.2,
+						PrecipitationMM: 12.3,
+					},
+				},
+			},
+		},
+		"unknown-location": {
+			days: 7,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"generationtime_ms":0.1}`))
+			},
+			expectedErr: func(t *testing.T, err error) {
+				var notFound *core.NotFoundErr
+				assert.ErrorAs(t, err, &notFound)
+				assert.EqualError(t, err, `location "Lisbon" not found`)
+			},
+		},
+		"api-error": {
+			days: 7,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "rate limited", http.StatusTooManyRequests)
+			},
+			expectedErr: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "geocode location: unexpected status 429: rate limited")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			t.Cleanup(server.Close)
+
+			forecaster := NewOpenMeteoForecaster(server.Client(), server.URL, server.URL)
+			got, err := forecaster.Forecast(t.Context(), "Lisbon", tt.days)
+			if tt.expectedErr != nil {
+				tt.expectedErr(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedForecast, got)
+		})
+	}
+}
+
+func TestWeatherCodeSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		code     int
+		expected string
+	}{
+		"clear":        {code: 0, expected: "clear sky"},
+		"overcast":     {code: 3, expected: "overcast"},
+		"drizzle":      {code: 53, expected: "drizzle"},
+		"rain":         {code: 65, expected: "rain"},
+		"showers":      {code: 81, expected: "rain showers"},
+		"thunderstorm": {code: 95, expected: "thunderstorm"},
+		"unknown":      {code: 42, expected: "unknown"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, weatherCodeSummary(tt.code))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AllowListSearcher decorates an assistant.WebSearcher so it only returns pages of allowed domains. The
+// query is narrowed with site: operators, and results outside the domains are dropped in case the
+// provider ignores them.
+type AllowListSearcher struct {
+	next    assistant.WebSearcher
+	domains []string
+}
+
+// NewAllowListSearcher creates an AllowListSearcher restricting next to domains and their subdomains.
+func NewAllowListSearcher(next assistant.WebSearcher, domains []string) AllowListSearcher {
+	return AllowListSearcher{
+		next:    next,
+		domains: domains,
+	}
+}
+
+// ParseDomains parses a comma separated list of domains such as "go.dev, *.wikipedia.org". A leading
+// "*." or a scheme is ignored, since subdomains are always allowed.
+func ParseDomains(value string) ([]string, error) {
+	var domains []string
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if u, err := url.Parse(entry); err == nil && u.Host != "" {
+			entry = u.Hostname()
+		}
+		entry = strings.TrimPrefix(entry, "*.")
+		entry = strings.TrimSuffix(entry, ".")
+		if !strings.Contains(entry, ".") || strings.ContainsAny(entry, "/*: ") {
+			return nil, fmt.Errorf("invalid domain %q", entry)
+		}
+		domains = append(domains, entry)
+	}
+	return domains, nil
+}
+
+// Search implements assistant.WebSearcher.Search.
+func (s AllowListSearcher) Search(ctx context.Context, query string, limit int) ([]assistant.WebSearchResult, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("limit", limit),
+		attribute.StringSlice("domains", s.domains),
+	))
+	defer span.End()
+
+	// Ask for extra results, since some may be dropped.
+	found, err := s.next.Search(spanCtx, s.restrictQuery(query), limit*2)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	results := make([]assistant.WebSearchResult, 0, limit)
+	for _, r := range found {
+		if len(results) == limit {
+			break
+		}
+		if s.Allowed(r.URL) {
+			results = append(results, r)
+		}
+	}
+	span.SetAttributes(attribute.Int("dropped", len(found)-len(results)))
+	return results, nil
+}
+
+// Allowed reports whether rawURL is an http or https URL on an allowed domain or one of its subdomains.
+func (s AllowListSearcher) Allowed(rawURL string) bool {
+	return allowedURL(s.domains, rawURL)
+}
+
+// allowedURL reports whether rawURL is an http or https URL on one of domains or their subdomains.
+func allowedURL(domains []string, rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// restrictQuery appends site: operators for the allowed domains to query.
+func (s AllowListSearcher) restrictQuery(query string) string {
+	sites := make([]string, 0, len(s.domains))
+	for _, domain := range s.domains {
+		sites = append(sites, "site:"+domain)
+	}
+	if len(sites) == 1 {
+		return query + " " + sites[0]
+	}
+	return query + " (" + strings.Join(sites, " OR ") + ")"
+}
@@ -0,0 +1,104 @@
+package websearch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDomains(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		expected    []string
+		expectedErr string
+	}{
+		"empty":             {value: " , "},
+		"list":              {value: "go.dev, Wikipedia.org.", expected: []string{"go.dev", "wikipedia.org"}},
+		"wildcard-and-urls": {value: "*.example.com,https://docs.python.org/3/", expected: []string{"example.com", "docs.python.org"}},
+		"single-label":      {value: "localhost", expectedErr: `invalid domain "localhost"`},
+		"with-port":         {value: "go.dev:443", expectedErr: `invalid domain "go.dev:443"`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseDomains(tt.value)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAllowListSearcher_Search(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		domains         []string
+		limit           int
+		setupMocks      func(*assistant.MockWebSearcher)
+		expectedResults []assistant.WebSearchResult
+		expectedErr     string
+	}{
+		"filters-results": {
+			domains: []string{"go.dev", "wikipedia.org"},
+			limit:   2,
+			setupMocks: func(m *assistant.MockWebSearcher) {
+				m.EXPECT().Search(mock.Anything, "generics (site:go.dev OR site:wikipedia.org)", 4).Return([]assistant.WebSearchResult{
+					{Title: "Spam", URL: "https://go.dev.evil.com/generics"},
+					{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics"},
+					{Title: "Script", URL: "javascript:alert(1)"},
+					{Title: "Generic programming", URL: "https://en.wikipedia.org/wiki/Generic_programming"},
+					{Title: "Blog", URL: "https://go.dev/blog/intro-generics"},
+				}, nil).Once()
+			},
+			expectedResults: []assistant.WebSearchResult{
+				{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics"},
+				{Title: "Generic programming", URL: "https://en.wikipedia.org/wiki/Generic_programming"},
+			},
+		},
+		"single-domain": {
+			domains: []string{"go.dev"},
+			limit:   5,
+			setupMocks: func(m *assistant.MockWebSearcher) {
+				m.EXPECT().Search(mock.Anything, "generics site:go.dev", 10).Return(nil, nil).Once()
+			},
+			expectedResults: []assistant.WebSearchResult{},
+		},
+		"searcher-error": {
+			domains: []string{"go.dev"},
+			limit:   5,
+			setupMocks: func(m *assistant.MockWebSearcher) {
+				m.EXPECT().Search(mock.Anything, mock.Anything, 10).Return(nil, errors.New("timeout")).Once()
+			},
+			expectedErr: "timeout",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := assistant.NewMockWebSearcher(t)
+			tt.setupMocks(next)
+
+			got, err := NewAllowListSearcher(next, tt.domains).Search(t.Context(), "generics", tt.limit)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResults, got)
+		})
+	}
+}
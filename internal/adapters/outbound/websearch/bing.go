@@ -0,0 +1,74 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// maxBingResults is the largest page the Bing Web Search API returns.
+const maxBingResults = 50
+
+// BingSearcher implements the assistant.WebSearcher interface with the Bing Web Search API.
+type BingSearcher struct {
+	client *http.Client
+	host   string
+	apiKey string
+}
+
+// NewBingSearcher creates a new BingSearcher calling the API at host with apiKey.
+func NewBingSearcher(client *http.Client, host, apiKey string) BingSearcher {
+	return BingSearcher{
+		client: client,
+		host:   host,
+		apiKey: apiKey,
+	}
+}
+
+// bingResponse is the subset of the Bing Web Search response the searcher reads.
+type bingResponse struct {
+	WebPages struct {
+		Value []struct {
+			Name    string `json:"name"`
+			URL     string `json:"url"`
+			Snippet string `json:"snippet"`
+		} `json:"value"`
+	} `json:"webPages"`
+}
+
+// Search implements assistant.WebSearcher.Search.
+func (s BingSearcher) Search(ctx context.Context, query string, limit int) ([]assistant.WebSearchResult, error) {
+	endpoint, err := url.JoinPath(s.host, "/v7.0/search")
+	if err != nil {
+		return nil, fmt.Errorf("invalid host: %w", err)
+	}
+	params := url.Values{
+		"q":               {query},
+		"count":           {strconv.Itoa(min(max(limit, 1), maxBingResults))},
+		"responseFilter":  {"Webpages"},
+		"textDecorations": {"false"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Ocp-Apim-Subscription-Key", s.apiKey)
+
+	var resp bingResponse
+	if err := doJSON(s.client, req, &resp); err != nil {
+		return nil, err
+	}
+
+	results := make([]assistant.WebSearchResult, 0, min(limit, len(resp.WebPages.Value)))
+	for _, r := range resp.WebPages.Value {
+		if len(results) == limit {
+			break
+		}
+		results = append(results, assistant.WebSearchResult{Title: r.Name, URL: r.URL, Snippet: r.Snippet})
+	}
+	return results, nil
+}
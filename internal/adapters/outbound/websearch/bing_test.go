@@ -0,0 +1,64 @@
+package websearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBingSearcher_Search(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		handler         http.HandlerFunc
+		expectedResults []assistant.WebSearchResult
+		expectedErr     string
+	}{
+		"success": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v7.0/search", r.URL.Path)
+				assert.Equal(t, "generics site:go.dev", r.URL.Query().Get("q"))
+				assert.Equal(t, "2", r.URL.Query().Get("count"))
+				assert.Equal(t, "key", r.Header.Get("Ocp-Apim-Subscription-Key"))
+				_, _ = w.Write([]byte(`{"webPages":{"value":[
+					{"name":"Tutorial","url":"https://go.dev/doc/tutorial/generics","snippet":"Get started with generics."}]}}`))
+			},
+			expectedResults: []assistant.WebSearchResult{
+				{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics", Snippet: "Get started with generics."},
+			},
+		},
+		"no-web-pages": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"_type":"SearchResponse"}`))
+			},
+			expectedResults: []assistant.WebSearchResult{},
+		},
+		"invalid-key": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, `{"error":{"code":"401"}}`, http.StatusUnauthorized)
+			},
+			expectedErr: `unexpected status 401: {"error":{"code":"401"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			t.Cleanup(server.Close)
+
+			got, err := NewBingSearcher(server.Client(), server.URL, "key").Search(t.Context(), "generics site:go.dev", 2)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResults, got)
+		})
+	}
+}
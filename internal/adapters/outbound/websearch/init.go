@@ -0,0 +1,99 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+const (
+	// Provider_SearxNG searches with a SearxNG instance.
+	Provider_SearxNG = "searxng"
+	// Provider_Bing searches with the Bing Web Search API.
+	Provider_Bing = "bing"
+)
+
+// defaultBingHost is the Bing Web Search API host used when WEB_SEARCH_API_HOST is empty.
+const defaultBingHost = "https://api.bing.microsoft.com"
+
+// InitSearcher registers the assistant.WebSearcher selected by WEB_SEARCH_PROVIDER, restricted to
+// WEB_SEARCH_ALLOWED_DOMAINS. The search_web action stays disabled, and nothing is registered, when the
+// provider is empty.
+type InitSearcher struct {
+	HttpClient     *http.Client `resolve:"standard"`
+	Provider       string       `config:"WEB_SEARCH_PROVIDER" default:""`
+	APIHost        string       `config:"WEB_SEARCH_API_HOST" default:""`
+	APIKey         string       `config:"WEB_SEARCH_API_KEY" default:""`
+	AllowedDomains string       `config:"WEB_SEARCH_ALLOWED_DOMAINS" default:""`
+}
+
+// Initialize creates and registers the searcher in the dependency container.
+func (i InitSearcher) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Provider == "" {
+		return ctx, nil
+	}
+
+	domains, err := ParseDomains(i.AllowedDomains)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse WEB_SEARCH_ALLOWED_DOMAINS: %w", err)
+	}
+	if len(domains) == 0 {
+		return ctx, fmt.Errorf("WEB_SEARCH_ALLOWED_DOMAINS is required for the %q web search provider", i.Provider)
+	}
+
+	var searcher assistant.WebSearcher
+	switch i.Provider {
+	case Provider_SearxNG:
+		if i.APIHost == "" {
+			return ctx, fmt.Errorf("WEB_SEARCH_API_HOST is required for the %q web search provider", Provider_SearxNG)
+		}
+		searcher = NewSearxNGSearcher(i.HttpClient, i.APIHost)
+	case Provider_Bing:
+		if i.APIKey == "" {
+			return ctx, fmt.Errorf("WEB_SEARCH_API_KEY is required for the %q web search provider", Provider_Bing)
+		}
+		host := i.APIHost
+		if host == "" {
+			host = defaultBingHost
+		}
+		searcher = NewBingSearcher(i.HttpClient, host, i.APIKey)
+	default:
+		return ctx, fmt.Errorf("unknown web search provider %q", i.Provider)
+	}
+
+	depend.Register[assistant.WebSearcher](NewAllowListSearcher(searcher, domains))
+	return ctx, nil
+}
+
+// InitPageReader registers the assistant.WebPageReader restricted to WEB_FETCH_ALLOWED_DOMAINS. The
+// summarize_url action stays disabled, and nothing is registered, when no domain is allowed.
+type InitPageReader struct {
+	HttpClient     *http.Client  `resolve:"standard"`
+	AllowedDomains string        `config:"WEB_FETCH_ALLOWED_DOMAINS" default:""`
+	MaxBytes       int           `config:"WEB_FETCH_MAX_BYTES" default:"1048576"`
+	Timeout        time.Duration `config:"WEB_FETCH_TIMEOUT" default:"10s"`
+}
+
+// Initialize creates and registers the page reader in the dependency container.
+func (i InitPageReader) Initialize(ctx context.Context) (context.Context, error) {
+	domains, err := ParseDomains(i.AllowedDomains)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse WEB_FETCH_ALLOWED_DOMAINS: %w", err)
+	}
+	if len(domains) == 0 {
+		return ctx, nil
+	}
+	if i.MaxBytes <= 0 {
+		return ctx, fmt.Errorf("WEB_FETCH_MAX_BYTES must be positive, got %d", i.MaxBytes)
+	}
+	if i.Timeout <= 0 {
+		return ctx, fmt.Errorf("WEB_FETCH_TIMEOUT must be positive, got %s", i.Timeout)
+	}
+
+	depend.Register[assistant.WebPageReader](NewPageReader(i.HttpClient, domains, int64(i.MaxBytes), i.Timeout))
+	return ctx, nil
+}
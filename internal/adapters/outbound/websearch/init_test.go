@@ -0,0 +1,99 @@
+package websearch
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitSearcher_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		init        InitSearcher
+		expectedErr string
+	}{
+		"searxng": {
+			init: InitSearcher{Provider: Provider_SearxNG, APIHost: "http://searxng:8080", AllowedDomains: "go.dev", HttpClient: http.DefaultClient},
+		},
+		"bing": {
+			init: InitSearcher{Provider: Provider_Bing, APIKey: "key", AllowedDomains: "go.dev,wikipedia.org", HttpClient: http.DefaultClient},
+		},
+		"missing-domains": {
+			init:        InitSearcher{Provider: Provider_SearxNG, APIHost: "http://searxng:8080"},
+			expectedErr: `WEB_SEARCH_ALLOWED_DOMAINS is required for the "searxng" web search provider`,
+		},
+		"invalid-domain": {
+			init:        InitSearcher{Provider: Provider_SearxNG, APIHost: "http://searxng:8080", AllowedDomains: "localhost"},
+			expectedErr: `failed to parse WEB_SEARCH_ALLOWED_DOMAINS: invalid domain "localhost"`,
+		},
+		"searxng-without-host": {
+			init:        InitSearcher{Provider: Provider_SearxNG, AllowedDomains: "go.dev"},
+			expectedErr: `WEB_SEARCH_API_HOST is required for the "searxng" web search provider`,
+		},
+		"bing-without-key": {
+			init:        InitSearcher{Provider: Provider_Bing, AllowedDomains: "go.dev"},
+			expectedErr: `WEB_SEARCH_API_KEY is required for the "bing" web search provider`,
+		},
+		"unknown-provider": {
+			init:        InitSearcher{Provider: "other", AllowedDomains: "go.dev"},
+			expectedErr: `unknown web search provider "other"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			searcher, err := depend.Resolve[assistant.WebSearcher]()
+			require.NoError(t, err)
+			assert.IsType(t, AllowListSearcher{}, searcher)
+		})
+	}
+}
+
+func TestInitPageReader_Initialize(t *testing.T) {
+	tests := map[string]struct {
+		init        InitPageReader
+		expectedErr string
+	}{
+		"registers": {
+			init: InitPageReader{AllowedDomains: "go.dev", MaxBytes: 1024, Timeout: time.Second, HttpClient: http.DefaultClient},
+		},
+		"invalid-domain": {
+			init:        InitPageReader{AllowedDomains: "localhost", MaxBytes: 1024, Timeout: time.Second},
+			expectedErr: `failed to parse WEB_FETCH_ALLOWED_DOMAINS: invalid domain "localhost"`,
+		},
+		"invalid-max-bytes": {
+			init:        InitPageReader{AllowedDomains: "go.dev", Timeout: time.Second},
+			expectedErr: "WEB_FETCH_MAX_BYTES must be positive, got 0",
+		},
+		"invalid-timeout": {
+			init:        InitPageReader{AllowedDomains: "go.dev", MaxBytes: 1024},
+			expectedErr: "WEB_FETCH_TIMEOUT must be positive, got 0s",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			reader, err := depend.Resolve[assistant.WebPageReader]()
+			require.NoError(t, err)
+			assert.IsType(t, PageReader{}, reader)
+		})
+	}
+}
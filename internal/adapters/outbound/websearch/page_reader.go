@@ -0,0 +1,243 @@
+package websearch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	// UserAgent identifies the page reader to web servers and robots.txt groups.
+	UserAgent = "SymbiontTodoBot/1.0 (+https://github.com/cleitonmarx/symbiont-ai-todoapp)"
+	// maxRedirects bounds the redirects followed for one page.
+	maxRedirects = 5
+)
+
+// PageReader implements the assistant.WebPageReader interface. It only fetches pages of allowed domains,
+// following redirects within them, honors robots.txt and reads at most maxBytes of each page.
+type PageReader struct {
+	client   *http.Client
+	domains  []string
+	maxBytes int64
+	timeout  time.Duration
+	robots   *robotsCache
+}
+
+// NewPageReader creates a PageReader fetching pages of domains with client.
+func NewPageReader(client *http.Client, domains []string, maxBytes int64, timeout time.Duration) PageReader {
+	restricted := *client
+	restricted.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		if !allowedURL(domains, req.URL.String()) {
+			return core.NewForbiddenErr(fmt.Sprintf("redirect to %s is outside the allowed domains", req.URL.Host))
+		}
+		return nil
+	}
+	return PageReader{
+		client:   &restricted,
+		domains:  domains,
+		maxBytes: maxBytes,
+		timeout:  timeout,
+		robots:   newRobotsCache(&restricted, UserAgent),
+	}
+}
+
+// Read implements assistant.WebPageReader.Read.
+func (r PageReader) Read(ctx context.Context, rawURL string) (assistant.WebPage, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("url", rawURL),
+	))
+	defer span.End()
+
+	page, err := r.read(spanCtx, rawURL)
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.WebPage{}, err
+	}
+	span.SetAttributes(attribute.Bool("truncated", page.Truncated))
+	return page, nil
+}
+
+// read fetches rawURL and extracts its text.
+func (r PageReader) read(ctx context.Context, rawURL string) (assistant.WebPage, error) {
+	if !allowedURL(r.domains, rawURL) {
+		return assistant.WebPage{}, core.NewForbiddenErr("url is outside the allowed domains")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return assistant.WebPage{}, fmt.Errorf("invalid url: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	allowed, err := r.robots.Allowed(ctx, u)
+	if err != nil {
+		return assistant.WebPage{}, err
+	}
+	if !allowed {
+		return assistant.WebPage{}, core.NewForbiddenErr("robots.txt of the site disallows fetching this url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return assistant.WebPage{}, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,text/plain;q=0.9")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		var forbidden *core.ForbiddenErr
+		if errors.As(err, &forbidden) {
+			return assistant.WebPage{}, forbidden
+		}
+		return assistant.WebPage{}, fmt.Errorf("http do: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return assistant.WebPage{}, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" && mediaType != "text/plain" {
+		return assistant.WebPage{}, fmt.Errorf("unsupported content type %q", mediaType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, r.maxBytes+1))
+	if err != nil {
+		return assistant.WebPage{}, fmt.Errorf("read response: %w", err)
+	}
+	page := assistant.WebPage{URL: resp.Request.URL.String()}
+	if int64(len(body)) > r.maxBytes {
+		// A page cut at the size limit may end mid-rune.
+		body = trimPartialRune(body[:r.maxBytes])
+		page.Truncated = true
+	}
+	body = decodeBody(body, resp.Header.Get("Content-Type"))
+
+	if mediaType == "text/plain" {
+		page.Text = strings.TrimSpace(strings.ToValidUTF8(string(body), ""))
+		return page, nil
+	}
+	page.Title, page.Text = extractText(body)
+	return page, nil
+}
+
+// trimPartialRune drops the incomplete rune b may end with.
+func trimPartialRune(b []byte) []byte {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		if utf8.RuneStart(b[len(b)-i]) {
+			if !utf8.FullRune(b[len(b)-i:]) {
+				return b[:len(b)-i]
+			}
+			return b
+		}
+	}
+	return b
+}
+
+// decodeBody converts a page to UTF-8 from the charset declared by its Content-Type header or its meta tags,
+// so Latin-1 and other legacy pages keep their accented text. Pages without a declaration that are not valid
+// UTF-8 are read as windows-1252.
+func decodeBody(body []byte, contentType string) []byte {
+	encoding, name, _ := charset.DetermineEncoding(body, contentType)
+	if name == "utf-8" {
+		return body
+	}
+	decoded, err := encoding.NewDecoder().Bytes(body)
+	if err != nil {
+		return body
+	}
+	return decoded
+}
+
+// skippedElements hold no readable text.
+var skippedElements = map[string]bool{
+	"script": true, "style": true, "noscript": true, "template": true, "svg": true,
+	"nav": true, "header": true, "footer": true, "aside": true, "form": true, "iframe": true,
+}
+
+// blockElements start a new line in the extracted text.
+var blockElements = map[string]bool{
+	"p": true, "div": true, "br": true, "li": true, "tr": true, "section": true, "article": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "pre": true, "blockquote": true,
+	"table": true, "ul": true, "ol": true, "dl": true, "dt": true, "dd": true, "main": true,
+}
+
+// extractText returns the title and the readable text of an HTML document, one line per block.
+func extractText(document []byte) (string, string) {
+	document = bytes.ToValidUTF8(document, nil)
+
+	var (
+		title string
+		lines []string
+		line  strings.Builder
+		skip  int
+	)
+	flush := func() {
+		if text := strings.Join(strings.Fields(line.String()), " "); text != "" {
+			lines = append(lines, text)
+		}
+		line.Reset()
+	}
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(document))
+	inTitle := false
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			flush()
+			return strings.Join(strings.Fields(title), " "), strings.Join(lines, "\n")
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			switch {
+			case tag == "title":
+				inTitle = true
+			case skippedElements[tag] && tokenType == html.StartTagToken:
+				skip++
+			case blockElements[tag]:
+				flush()
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			switch {
+			case tag == "title":
+				inTitle = false
+			case skippedElements[tag] && skip > 0:
+				skip--
+			case blockElements[tag]:
+				flush()
+			}
+		case html.TextToken:
+			text := string(tokenizer.Text())
+			switch {
+			case inTitle:
+				title += text
+			case skip == 0:
+				line.WriteString(text)
+				line.WriteString(" ")
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package websearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const samplePage = `<!doctype html>
+<html><head><title> Intro to
+ Generics </title><style>body{color:red}</style><script>alert("x")</script></head>
+<body>
+<nav><a href="/">Home</a></nav>
+<main>
+<h1>An Introduction To Generics</h1>
+<p>Generics add <b>type parameters</b> to
+functions.</p>
+<ul><li>One</li><li>Two</li></ul>
+</main>
+<footer>Copyright</footer>
+</body></html>`
+
+func TestExtractText(t *testing.T) {
+	t.Parallel()
+
+	title, text := extractText([]byte(samplePage))
+	assert.Equal(t, "Intro to Generics", title)
+	assert.Equal(t, "An Introduction To Generics\nGenerics add type parameters to functions.\nOne\nTwo", text)
+
+	// Invalid bytes are dropped without losing the text after them.
+	title, text = extractText([]byte("<title>Caf\xe9</title><p>Cr\xe8me br\xfbl\xe9e</p><p>Dessert</p>"))
+	assert.Equal(t, "Caf", title)
+	assert.Equal(t, "Crme brle\nDessert", text)
+}
+
+func TestPageReader_Read(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path         string
+		maxBytes     int64
+		handler      http.HandlerFunc
+		expectedPage func(serverURL string) assistant.WebPage
+		expectedErr  func(t *testing.T, err error)
+	}{
+		"html-page": {
+			path: "/blog/generics",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, UserAgent, r.Header.Get("User-Agent"))
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				_, _ = w.Write([]byte(samplePage))
+			},
+			expectedPage: func(serverURL string) assistant.WebPage {
+				return assistant.WebPage{
+					URL:   serverURL + "/blog/generics",
+					Title: "Intro to Generics",
+					Text:  "An Introduction To Generics\nGenerics add type parameters to functions.\nOne\nTwo",
+				}
+			},
+		},
+		"truncated-text": {
+			path:     "/notes.txt",
+			maxBytes: 10,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte(strings.Repeat("a", 50)))
+			},
+			expectedPage: func(serverURL string) assistant.WebPage {
+				return assistant.WebPage{URL: serverURL + "/notes.txt", Text: strings.Repeat("a", 10), Truncated: true}
+			},
+		},
+		"latin1-page": {
+			path: "/menu",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html; charset=iso-8859-1")
+				_, _ = w.Write([]byte("<title>Caf\xe9</title><p>Cr\xe8me br\xfbl\xe9e</p><p>Dessert</p>"))
+			},
+			expectedPage: func(serverURL string) assistant.WebPage {
+				return assistant.WebPage{URL: serverURL + "/menu", Title: "Café", Text: "Crème brûlée\nDessert"}
+			},
+		},
+		"charset-declared-in-meta": {
+			path: "/legacy",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				_, _ = w.Write([]byte("<meta charset=\"windows-1252\"><p>Na\xefve \x93quotes\x94</p>"))
+			},
+			expectedPage: func(serverURL string) assistant.WebPage {
+				return assistant.WebPage{URL: serverURL + "/legacy", Text: "Naïve “quotes”"}
+			},
+		},
+		"truncated-mid-rune": {
+			path:     "/cafe.txt",
+			maxBytes: 5,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+				_, _ = w.Write([]byte("caféé"))
+			},
+			expectedPage: func(serverURL string) assistant.WebPage {
+				return assistant.WebPage{URL: serverURL + "/cafe.txt", Text: "café", Truncated: true}
+			},
+		},
+		"follows-redirects": {
+			path: "/old",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/old" {
+					http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+					return
+				}
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte("moved"))
+			},
+			expectedPage: func(serverURL string) assistant.WebPage {
+				return assistant.WebPage{URL: serverURL + "/new", Text: "moved"}
+			},
+		},
+		"redirect-outside-allowed-domains": {
+			path: "/leave",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "https://example.com/", http.StatusFound)
+			},
+			expectedErr: func(t *testing.T, err error) {
+				var forbidden *core.ForbiddenErr
+				assert.ErrorAs(t, err, &forbidden)
+				assert.EqualError(t, err, "redirect to example.com is outside the allowed domains")
+			},
+		},
+		"disallowed-by-robots": {
+			path: "/private/page",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("page must not be fetched")
+			},
+			expectedErr: func(t *testing.T, err error) {
+				var forbidden *core.ForbiddenErr
+				assert.ErrorAs(t, err, &forbidden)
+			},
+		},
+		"unsupported-content-type": {
+			path: "/file.pdf",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/pdf")
+				_, _ = w.Write([]byte("%PDF"))
+			},
+			expectedErr: func(t *testing.T, err error) {
+				assert.EqualError(t, err, `unsupported content type "application/pdf"`)
+			},
+		},
+		"not-found": {
+			path: "/missing",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			expectedErr: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "unexpected status 404")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("User-agent: *\nDisallow: /private/\n"))
+			})
+			mux.HandleFunc("/", tt.handler)
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			// httptest servers listen on 127.0.0.1, which is allowed explicitly here.
+			host, _ := url.Parse(server.URL)
+			maxBytes := tt.maxBytes
+			if maxBytes == 0 {
+				maxBytes = 1 << 20
+			}
+			reader := NewPageReader(server.Client(), []string{host.Hostname()}, maxBytes, 5*time.Second)
+
+			got, err := reader.Read(t.Context(), server.URL+tt.path)
+			if tt.expectedErr != nil {
+				tt.expectedErr(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPage(server.URL), got)
+		})
+	}
+}
+
+func TestPageReader_Read_OutsideAllowedDomains(t *testing.T) {
+	t.Parallel()
+
+	reader := NewPageReader(http.DefaultClient, []string{"go.dev"}, 1<<20, time.Second)
+	for _, rawURL := range []string{"https://example.com/", "ftp://go.dev/file", "https://go.dev.evil.com/"} {
+		_, err := reader.Read(t.Context(), rawURL)
+		var forbidden *core.ForbiddenErr
+		assert.ErrorAs(t, err, &forbidden, rawURL)
+	}
+}
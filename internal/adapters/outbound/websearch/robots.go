@@ -0,0 +1,209 @@
+package websearch
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// robotsTTL is how long a fetched robots.txt is trusted.
+	robotsTTL = time.Hour
+	// maxRobotsBytes bounds the robots.txt read, as RFC 9309 allows crawlers to.
+	maxRobotsBytes = 500 << 10
+)
+
+// robotsRule is one Allow or Disallow line of a robots.txt group.
+type robotsRule struct {
+	pattern string
+	allow   bool
+}
+
+// robotsRules are the rules of the robots.txt group that applies to the reader.
+type robotsRules []robotsRule
+
+// parseRobots returns the rules of the group of body matching userAgent, or of the * group when no group
+// names it. Consecutive user-agent lines share one group.
+func parseRobots(body, userAgent string) robotsRules {
+	userAgent = strings.ToLower(userAgent)
+	var (
+		matched, wildcard robotsRules
+		hasMatched        bool
+		inAgents          bool
+		current           []string
+	)
+	groupFor := func() (*robotsRules, bool) {
+		for _, agent := range current {
+			if agent != "*" && strings.Contains(userAgent, agent) {
+				hasMatched = true
+				return &matched, true
+			}
+		}
+		for _, agent := range current {
+			if agent == "*" {
+				return &wildcard, true
+			}
+		}
+		return nil, false
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if !inAgents {
+				current = nil
+			}
+			inAgents = true
+			current = append(current, strings.ToLower(value))
+		case "allow", "disallow":
+			inAgents = false
+			group, ok := groupFor()
+			if !ok || value == "" {
+				continue
+			}
+			*group = append(*group, robotsRule{pattern: value, allow: key == "allow"})
+		}
+	}
+	if hasMatched {
+		return matched
+	}
+	return wildcard
+}
+
+// Allowed reports whether path may be fetched: the longest matching rule wins, and Allow wins ties.
+func (r robotsRules) Allowed(path string) bool {
+	best, allowed := -1, true
+	for _, rule := range r {
+		if !robotsMatch(rule.pattern, path) {
+			continue
+		}
+		if len(rule.pattern) > best || (len(rule.pattern) == best && rule.allow) {
+			best, allowed = len(rule.pattern), rule.allow
+		}
+	}
+	return allowed
+}
+
+// robotsMatch matches a robots.txt path pattern, where * matches any sequence and a trailing $ anchors
+// the end of the path.
+func robotsMatch(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+	parts := strings.Split(pattern, "*")
+
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	rest := path[len(parts[0]):]
+	for i, part := range parts[1:] {
+		last := i == len(parts)-2
+		if last && anchored {
+			return strings.HasSuffix(rest, part)
+		}
+		idx := strings.Index(rest, part)
+		if idx < 0 {
+			return false
+		}
+		rest = rest[idx+len(part):]
+	}
+	return !anchored || rest == ""
+}
+
+// robotsEntry is a cached robots.txt.
+type robotsEntry struct {
+	rules     robotsRules
+	expiresAt time.Time
+}
+
+// robotsCache fetches and caches the robots.txt rules of each origin.
+type robotsCache struct {
+	client    *http.Client
+	userAgent string
+	now       func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]robotsEntry
+}
+
+// newRobotsCache creates a robotsCache fetching with client as userAgent.
+func newRobotsCache(client *http.Client, userAgent string) *robotsCache {
+	return &robotsCache{
+		client:    client,
+		userAgent: userAgent,
+		now:       time.Now,
+		entries:   make(map[string]robotsEntry),
+	}
+}
+
+// Allowed reports whether the robots.txt of the origin of u allows fetching u.
+func (c *robotsCache) Allowed(ctx context.Context, u *url.URL) (bool, error) {
+	origin := u.Scheme + "://" + u.Host
+
+	c.mu.Lock()
+	entry, found := c.entries[origin]
+	c.mu.Unlock()
+
+	if !found || !c.now().Before(entry.expiresAt) {
+		rules, err := c.fetch(ctx, origin)
+		if err != nil {
+			return false, err
+		}
+		entry = robotsEntry{rules: rules, expiresAt: c.now().Add(robotsTTL)}
+		c.mu.Lock()
+		c.entries[origin] = entry
+		c.mu.Unlock()
+	}
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return entry.rules.Allowed(path), nil
+}
+
+// fetch downloads the robots.txt of origin. A missing robots.txt allows everything; an unreachable one
+// is an error, so the page is not fetched (RFC 9309, section 2.3.1).
+func (c *robotsCache) fetch(ctx context.Context, origin string) (robotsRules, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return nil, fmt.Errorf("create robots.txt request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch robots.txt: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxRobotsBytes))
+		if err != nil {
+			return nil, fmt.Errorf("read robots.txt: %w", err)
+		}
+		return parseRobots(string(body), c.userAgent), nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("robots.txt is unavailable: status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,131 @@
+package websearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleRobots = `
+# Comments are ignored.
+User-agent: *
+Disallow: /private/
+Allow: /private/public-page
+Disallow: /*.pdf$
+
+User-agent: GoogleBot
+User-agent: SymbiontTodoBot
+Disallow: /drafts
+
+User-agent: OtherBot
+Disallow: /
+`
+
+func TestParseRobots(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body      string
+		userAgent string
+		path      string
+		expected  bool
+	}{
+		"wildcard-group-disallow":       {body: sampleRobots, userAgent: "AnyBot/1.0", path: "/private/page", expected: false},
+		"wildcard-group-longest-allow":  {body: sampleRobots, userAgent: "AnyBot/1.0", path: "/private/public-page", expected: true},
+		"wildcard-group-anchored":       {body: sampleRobots, userAgent: "AnyBot/1.0", path: "/docs/guide.pdf", expected: false},
+		"wildcard-group-anchor-not-end": {body: sampleRobots, userAgent: "AnyBot/1.0", path: "/docs/guide.pdf?x=1", expected: true},
+		"wildcard-group-allowed":        {body: sampleRobots, userAgent: "AnyBot/1.0", path: "/blog", expected: true},
+		"named-group-wins":              {body: sampleRobots, userAgent: UserAgent, path: "/private/page", expected: true},
+		"named-group-disallow":          {body: sampleRobots, userAgent: UserAgent, path: "/drafts/1", expected: false},
+		"empty-disallow":                {body: "User-agent: *\nDisallow:\n", userAgent: UserAgent, path: "/", expected: true},
+		"no-robots":                     {body: "", userAgent: UserAgent, path: "/", expected: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, parseRobots(tt.body, tt.userAgent).Allowed(tt.path))
+		})
+	}
+}
+
+func TestRobotsCache_Allowed(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status      int
+		body        string
+		path        string
+		expected    bool
+		expectedErr bool
+	}{
+		"disallowed": {status: http.StatusOK, body: "User-agent: *\nDisallow: /private\n", path: "/private/a", expected: false},
+		"allowed":    {status: http.StatusOK, body: "User-agent: *\nDisallow: /private\n", path: "/public", expected: true},
+		"missing":    {status: http.StatusNotFound, path: "/private/a", expected: true},
+		"unavailable": {
+			status:      http.StatusServiceUnavailable,
+			path:        "/public",
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, "/robots.txt", r.URL.Path)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			cache := newRobotsCache(server.Client(), UserAgent)
+			u, err := url.Parse(server.URL + tt.path)
+			require.NoError(t, err)
+
+			for range 2 {
+				got, err := cache.Allowed(t.Context(), u)
+				if tt.expectedErr {
+					assert.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+			if !tt.expectedErr {
+				assert.Equal(t, 1, requests, "robots.txt must be cached")
+			}
+		})
+	}
+}
+
+func TestRobotsCache_Expires(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	clock := time.Now()
+	cache := newRobotsCache(server.Client(), UserAgent)
+	cache.now = func() time.Time { return clock }
+	u, _ := url.Parse(server.URL + "/")
+
+	_, err := cache.Allowed(t.Context(), u)
+	require.NoError(t, err)
+	clock = clock.Add(robotsTTL)
+	_, err = cache.Allowed(t.Context(), u)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
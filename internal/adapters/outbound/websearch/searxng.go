@@ -0,0 +1,61 @@
+package websearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+)
+
+// SearxNGSearcher implements the assistant.WebSearcher interface with the JSON API of a SearxNG
+// instance. The instance must enable the json format in its search settings.
+type SearxNGSearcher struct {
+	client *http.Client
+	host   string
+}
+
+// NewSearxNGSearcher creates a new SearxNGSearcher calling the instance at host.
+func NewSearxNGSearcher(client *http.Client, host string) SearxNGSearcher {
+	return SearxNGSearcher{
+		client: client,
+		host:   host,
+	}
+}
+
+// searxngResponse is the subset of the SearxNG search response the searcher reads.
+type searxngResponse struct {
+	Results []struct {
+		URL     string `json:"url"`
+		Title   string `json:"title"`
+		Content string `json:"content"`
+	} `json:"results"`
+}
+
+// Search implements assistant.WebSearcher.Search.
+func (s SearxNGSearcher) Search(ctx context.Context, query string, limit int) ([]assistant.WebSearchResult, error) {
+	endpoint, err := url.JoinPath(s.host, "/search")
+	if err != nil {
+		return nil, fmt.Errorf("invalid host: %w", err)
+	}
+	params := url.Values{"q": {query}, "format": {"json"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	var resp searxngResponse
+	if err := doJSON(s.client, req, &resp); err != nil {
+		return nil, err
+	}
+
+	results := make([]assistant.WebSearchResult, 0, min(limit, len(resp.Results)))
+	for _, r := range resp.Results {
+		if len(results) == limit {
+			break
+		}
+		results = append(results, assistant.WebSearchResult{Title: r.Title, URL: r.URL, Snippet: r.Content})
+	}
+	return results, nil
+}
@@ -0,0 +1,60 @@
+package websearch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearxNGSearcher_Search(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		handler         http.HandlerFunc
+		expectedResults []assistant.WebSearchResult
+		expectedErr     string
+	}{
+		"success": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/search", r.URL.Path)
+				assert.Equal(t, "generics site:go.dev", r.URL.Query().Get("q"))
+				assert.Equal(t, "json", r.URL.Query().Get("format"))
+				_, _ = w.Write([]byte(`{"results":[
+					{"url":"https://go.dev/doc/tutorial/generics","title":"Tutorial","content":"Get started with generics."},
+					{"url":"https://go.dev/blog/intro-generics","title":"Blog","content":"An introduction."},
+					{"url":"https://go.dev/ref/spec","title":"Spec","content":"The spec."}]}`))
+			},
+			expectedResults: []assistant.WebSearchResult{
+				{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics", Snippet: "Get started with generics."},
+				{Title: "Blog", URL: "https://go.dev/blog/intro-generics", Snippet: "An introduction."},
+			},
+		},
+		"json-format-disabled": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+			},
+			expectedErr: "unexpected status 403: Forbidden",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			t.Cleanup(server.Close)
+
+			got, err := NewSearxNGSearcher(server.Client(), server.URL).Search(t.Context(), "generics site:go.dev", 2)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResults, got)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitPool creates and registers the worker pool shared by the outbox consumers of the process.
+// It should run after the Pub/Sub client so the pool drains, acking its messages, before the client closes.
+type InitPool struct {
+	MinWorkers        int           `config:"WORKER_POOL_MIN_WORKERS" default:"1"`
+	MaxWorkers        int           `config:"WORKER_POOL_MAX_WORKERS" default:"8"`
+	BacklogPerWorker  int           `config:"WORKER_POOL_BACKLOG_PER_WORKER" default:"4"`
+	ScaleDownInterval time.Duration `config:"WORKER_POOL_SCALE_DOWN_INTERVAL" default:"30s"`
+	TypeLimits        string        `config:"WORKER_POOL_TYPE_LIMITS" default:"board_summary=1,conversation_title=4"`
+	DrainTimeout      time.Duration `config:"WORKER_POOL_DRAIN_TIMEOUT" default:"20s"`
+	pool              *Pool
+}
+
+// Initialize creates the pool and registers it in the dependency container.
+func (i *InitPool) Initialize(ctx context.Context) (context.Context, error) {
+	typeLimits, err := ParseTypeLimits(i.TypeLimits)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse worker pool type limits: %w", err)
+	}
+	i.pool = NewPool(Config{
+		MinWorkers:        i.MinWorkers,
+		MaxWorkers:        i.MaxWorkers,
+		BacklogPerWorker:  i.BacklogPerWorker,
+		ScaleDownInterval: i.ScaleDownInterval,
+		TypeLimits:        typeLimits,
+		DrainTimeout:      i.DrainTimeout,
+	})
+	depend.Register(i.pool)
+	return ctx, nil
+}
+
+// Close drains the pool.
+func (i *InitPool) Close() {
+	if i == nil || i.pool == nil {
+		return
+	}
+	i.pool.Close()
+}
+
+// ParseTypeLimits parses a comma-separated list of type=limit entries, e.g. "board_summary=1,conversation_title=4".
+func ParseTypeLimits(raw string) (map[string]int, error) {
+	limits := map[string]int{}
+	for entry := range strings.SplitSeq(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		eventType, rawLimit, ok := strings.Cut(entry, "=")
+		eventType = strings.TrimSpace(eventType)
+		if !ok || eventType == "" {
+			return nil, fmt.Errorf("invalid type limit %q: expected type=limit", entry)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(rawLimit))
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid type limit %q: limit must be a positive integer", entry)
+		}
+		limits[eventType] = limit
+	}
+	return limits, nil
+}
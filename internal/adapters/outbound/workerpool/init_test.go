@@ -0,0 +1,67 @@
+package workerpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitPool_Initialize(t *testing.T) {
+	i := &InitPool{
+		MinWorkers:        1,
+		MaxWorkers:        4,
+		BacklogPerWorker:  2,
+		ScaleDownInterval: time.Second,
+		TypeLimits:        "board_summary=1,conversation_title=4",
+		DrainTimeout:      time.Second,
+	}
+
+	_, err := i.Initialize(t.Context())
+	require.NoError(t, err)
+
+	pool, err := depend.Resolve[*Pool]()
+	require.NoError(t, err)
+	assert.Same(t, i.pool, pool)
+	assert.Equal(t, map[string]int{"board_summary": 1, "conversation_title": 4}, pool.cfg.TypeLimits)
+
+	i.Close()
+	assert.ErrorIs(t, pool.Submit(t.Context(), "board_summary", nil), ErrClosed)
+}
+
+func TestInitPool_Initialize_InvalidTypeLimits(t *testing.T) {
+	i := &InitPool{TypeLimits: "board_summary"}
+
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+	i.Close()
+}
+
+func TestParseTypeLimits(t *testing.T) {
+	tests := map[string]struct {
+		raw     string
+		want    map[string]int
+		wantErr bool
+	}{
+		"empty":        {raw: "", want: map[string]int{}},
+		"entries":      {raw: " board_summary=1 , conversation_title = 4,", want: map[string]int{"board_summary": 1, "conversation_title": 4}},
+		"missing-type": {raw: "=2", wantErr: true},
+		"missing-sign": {raw: "board_summary", wantErr: true},
+		"zero":         {raw: "board_summary=0", wantErr: true},
+		"not-a-number": {raw: "board_summary=one", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseTypeLimits(tt.raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
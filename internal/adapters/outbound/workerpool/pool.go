@@ -0,0 +1,252 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+)
+
+// ErrClosed is returned when a job is submitted to a pool that is draining or stopped.
+var ErrClosed = errors.New("worker pool is closed")
+
+// Job is a unit of work run by the pool.
+type Job func(ctx context.Context)
+
+// Config configures a Pool.
+type Config struct {
+	// MinWorkers is the number of workers kept alive while the pool is idle.
+	MinWorkers int
+	// MaxWorkers caps the number of workers the pool scales up to.
+	MaxWorkers int
+	// BacklogPerWorker is the number of queued jobs each worker is expected to absorb
+	// before the pool adds another one.
+	BacklogPerWorker int
+	// ScaleDownInterval is how often workers above the backlog target are retired once idle.
+	ScaleDownInterval time.Duration
+	// TypeLimits caps the jobs of one event type running at the same time. Types without a limit share
+	// the whole pool.
+	TypeLimits map[string]int
+	// DrainTimeout bounds how long Close waits for queued and running jobs before canceling them.
+	DrainTimeout time.Duration
+}
+
+// queuedJob is a submitted job waiting for a worker.
+type queuedJob struct {
+	eventType string
+	ctx       context.Context
+	run       Job
+}
+
+// Pool runs the jobs of outbox consumers on a shared set of workers. The number of workers follows the
+// backlog of queued and running jobs, which is fed by the messages the bus has delivered and not processed yet,
+// and event types can be capped so one kind of work never takes every worker.
+type Pool struct {
+	cfg    Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queue   []queuedJob
+	running map[string]int
+	active  int
+	workers int
+	target  int
+	closed  bool
+	idle    sync.WaitGroup
+	stop    chan struct{}
+}
+
+// NewPool creates a Pool and starts its minimum workers.
+func NewPool(cfg Config) *Pool {
+	if cfg.MinWorkers < 1 {
+		cfg.MinWorkers = 1
+	}
+	if cfg.MaxWorkers < cfg.MinWorkers {
+		cfg.MaxWorkers = cfg.MinWorkers
+	}
+	if cfg.BacklogPerWorker < 1 {
+		cfg.BacklogPerWorker = 1
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pool{
+		cfg:     cfg,
+		ctx:     ctx,
+		cancel:  cancel,
+		running: make(map[string]int),
+		stop:    make(chan struct{}),
+	}
+	p.cond = sync.NewCond(&p.mu)
+
+	p.mu.Lock()
+	p.scale(true)
+	p.mu.Unlock()
+
+	if cfg.ScaleDownInterval > 0 {
+		go p.scaleDownLoop()
+	}
+	return p
+}
+
+// Submit queues a job of the given event type. The job runs with the values of ctx but outlives its
+// cancellation, so a consumer shutting down leaves its accepted work to the pool drain.
+func (p *Pool) Submit(ctx context.Context, eventType string, job Job) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return ErrClosed
+	}
+
+	p.queue = append(p.queue, queuedJob{eventType: eventType, ctx: context.WithoutCancel(ctx), run: job})
+	p.scale(false)
+	p.cond.Signal()
+	p.record()
+	return nil
+}
+
+// Close stops accepting jobs and waits up to the drain timeout for queued and running jobs to finish.
+// Afterwards queued jobs are dropped and running jobs have their context canceled.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.stop)
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		p.idle.Wait()
+		close(drained)
+	}()
+
+	var timeout <-chan time.Time
+	if p.cfg.DrainTimeout > 0 {
+		timer := time.NewTimer(p.cfg.DrainTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-drained:
+	case <-timeout:
+		// Unprocessed messages are redelivered by the bus once their ack deadline expires.
+		p.mu.Lock()
+		p.queue = nil
+		p.cond.Broadcast()
+		p.mu.Unlock()
+		p.cancel()
+		<-drained
+	}
+	p.cancel()
+}
+
+// Workers returns the number of live workers.
+func (p *Pool) Workers() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.workers
+}
+
+// work runs queued jobs until the worker is retired or the pool is drained.
+func (p *Pool) work() {
+	defer p.idle.Done()
+	for {
+		p.mu.Lock()
+		job, ok := p.next()
+		for !ok {
+			if p.closed && len(p.queue) == 0 || p.workers > p.target {
+				p.workers--
+				p.record()
+				p.mu.Unlock()
+				return
+			}
+			p.cond.Wait()
+			job, ok = p.next()
+		}
+		p.running[job.eventType]++
+		p.active++
+		p.record()
+		p.mu.Unlock()
+
+		p.run(job)
+
+		p.mu.Lock()
+		p.running[job.eventType]--
+		p.active--
+		// A finished job may unblock a job of a capped type.
+		p.cond.Broadcast()
+		p.record()
+		p.mu.Unlock()
+	}
+}
+
+// run executes job, canceling its context when the pool stops.
+func (p *Pool) run(job queuedJob) {
+	ctx, cancel := context.WithCancel(job.ctx)
+	defer cancel()
+	stop := context.AfterFunc(p.ctx, cancel)
+	defer stop()
+	job.run(ctx)
+}
+
+// next removes and returns the oldest queued job whose event type is below its limit.
+// The caller must hold mu.
+func (p *Pool) next() (queuedJob, bool) {
+	for i, job := range p.queue {
+		if limit, ok := p.cfg.TypeLimits[job.eventType]; ok && limit > 0 && p.running[job.eventType] >= limit {
+			continue
+		}
+		p.queue = append(p.queue[:i], p.queue[i+1:]...)
+		return job, true
+	}
+	return queuedJob{}, false
+}
+
+// scale sets the worker target from the backlog, queued and running jobs, and starts missing workers.
+// Unless down is set, the target only grows, leaving retirement to the scale down loop. The caller must hold mu.
+func (p *Pool) scale(down bool) {
+	backlog := len(p.queue) + p.active
+	target := (backlog + p.cfg.BacklogPerWorker - 1) / p.cfg.BacklogPerWorker
+	target = max(p.cfg.MinWorkers, min(p.cfg.MaxWorkers, target))
+	if !down {
+		target = max(target, p.target)
+	}
+	p.target = target
+
+	for p.workers < p.target {
+		p.workers++
+		p.idle.Add(1)
+		go p.work()
+	}
+	if p.workers > p.target {
+		p.cond.Broadcast()
+	}
+}
+
+// scaleDownLoop periodically shrinks the pool to the current backlog.
+func (p *Pool) scaleDownLoop() {
+	ticker := time.NewTicker(p.cfg.ScaleDownInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			p.scale(true)
+			p.mu.Unlock()
+		}
+	}
+}
+
+// record publishes the pool gauges. The caller must hold mu.
+func (p *Pool) record() {
+	metrics.RecordWorkerPoolState(p.ctx, p.workers, len(p.queue))
+}
@@ -0,0 +1,157 @@
+package workerpool
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey struct{}
+
+func TestPool_RunsJobsWithSubmitterValues(t *testing.T) {
+	p := NewPool(Config{MinWorkers: 1, MaxWorkers: 2})
+	defer p.Close()
+
+	ctx, cancel := context.WithCancel(context.WithValue(t.Context(), ctxKey{}, "tenant-a"))
+	done := make(chan any, 1)
+	require.NoError(t, p.Submit(ctx, "title", func(jobCtx context.Context) {
+		done <- jobCtx.Value(ctxKey{})
+		assert.NoError(t, jobCtx.Err())
+	}))
+	cancel()
+
+	select {
+	case value := <-done:
+		assert.Equal(t, "tenant-a", value)
+	case <-time.After(time.Second):
+		t.Fatal("job did not run")
+	}
+}
+
+func TestPool_ScalesWithBacklog(t *testing.T) {
+	tests := map[string]struct {
+		cfg         Config
+		jobs        int
+		wantWorkers int
+	}{
+		"one-worker-per-backlog-share": {
+			cfg:         Config{MinWorkers: 1, MaxWorkers: 8, BacklogPerWorker: 2},
+			jobs:        6,
+			wantWorkers: 3,
+		},
+		"capped-at-max": {
+			cfg:         Config{MinWorkers: 1, MaxWorkers: 2, BacklogPerWorker: 1},
+			jobs:        6,
+			wantWorkers: 2,
+		},
+		"min-when-idle": {
+			cfg:         Config{MinWorkers: 2, MaxWorkers: 4, BacklogPerWorker: 10},
+			jobs:        1,
+			wantWorkers: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPool(tt.cfg)
+			release := make(chan struct{})
+			for range tt.jobs {
+				require.NoError(t, p.Submit(t.Context(), "summary", func(context.Context) { <-release }))
+			}
+			assert.Equal(t, tt.wantWorkers, p.Workers())
+			close(release)
+			p.Close()
+			assert.Zero(t, p.Workers())
+		})
+	}
+}
+
+func TestPool_ScalesDownWhenIdle(t *testing.T) {
+	p := NewPool(Config{MinWorkers: 1, MaxWorkers: 4, BacklogPerWorker: 1, ScaleDownInterval: 10 * time.Millisecond})
+	defer p.Close()
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		require.NoError(t, p.Submit(t.Context(), "summary", func(context.Context) { wg.Done() }))
+	}
+	wg.Wait()
+
+	assert.Eventually(t, func() bool { return p.Workers() == 1 }, time.Second, 5*time.Millisecond)
+}
+
+func TestPool_TypeLimits(t *testing.T) {
+	p := NewPool(Config{MinWorkers: 4, MaxWorkers: 4, TypeLimits: map[string]int{"summary": 1}})
+	defer p.Close()
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		require.NoError(t, p.Submit(t.Context(), "summary", func(context.Context) {
+			defer wg.Done()
+			n := running.Add(1)
+			for {
+				current := peak.Load()
+				if n <= current || peak.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		}))
+	}
+
+	titleDone := make(chan struct{})
+	require.NoError(t, p.Submit(t.Context(), "title", func(context.Context) { close(titleDone) }))
+	select {
+	case <-titleDone:
+	case <-time.After(time.Second):
+		t.Fatal("uncapped job waited for capped jobs")
+	}
+
+	wg.Wait()
+	assert.Equal(t, int32(1), peak.Load())
+}
+
+func TestPool_CloseDrainsQueuedJobs(t *testing.T) {
+	p := NewPool(Config{MinWorkers: 1, MaxWorkers: 1, DrainTimeout: time.Second})
+
+	var ran atomic.Int32
+	for range 3 {
+		require.NoError(t, p.Submit(t.Context(), "title", func(context.Context) {
+			time.Sleep(5 * time.Millisecond)
+			ran.Add(1)
+		}))
+	}
+	p.Close()
+
+	assert.Equal(t, int32(3), ran.Load())
+	assert.ErrorIs(t, p.Submit(t.Context(), "title", func(context.Context) {}), ErrClosed)
+}
+
+func TestPool_CloseCancelsJobsAfterDrainTimeout(t *testing.T) {
+	p := NewPool(Config{MinWorkers: 1, MaxWorkers: 1, DrainTimeout: 20 * time.Millisecond})
+
+	canceled := make(chan struct{})
+	require.NoError(t, p.Submit(t.Context(), "title", func(ctx context.Context) {
+		<-ctx.Done()
+		close(canceled)
+	}))
+	var queuedRan atomic.Bool
+	require.NoError(t, p.Submit(t.Context(), "title", func(context.Context) { queuedRan.Store(true) }))
+
+	p.Close()
+
+	select {
+	case <-canceled:
+	default:
+		t.Fatal("running job was not canceled")
+	}
+	assert.False(t, queuedRan.Load())
+}
@@ -3,147 +3,379 @@ package app
 import (
 	"github.com/cleitonmarx/symbiont"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/grpc"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/telegram"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/workers"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/actionregistry/composite"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/actionregistry/local"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/actionregistry/mcp"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/approvaldispatcher"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/chateventhub"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/config"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/faultinject"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/llmlimiter"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/log"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/md"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/modelrunner"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/moderation"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/notification"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/oidc"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/postgres"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/principaldir"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/pubsub"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/redaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/redis"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/streamregistry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/tenantdir"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/time"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/todayview"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/todoeventhub"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/tokenizer"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/weather"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/websearch"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/outbound/workerpool"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/audit"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/board"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/chat"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/session"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/todo"
 )
 
 // NewMonolithic builds the all-in-one deployable.
 // It hosts the HTTP server (REST API + embedded webapp static files), GraphQL API,
-// action approval dispatcher, message relay, board summary generator,
-// and conversation title generator in a single process.
+// action approval dispatcher, todo event forwarder, message relay, outbox health reporter, audit log purger,
+// board summary generator, conversation title generator, Telegram bot, and gRPC API in a single process.
 // Optional initializers are executed before the default wiring initializers.
 func NewMonolithic(initializers ...symbiont.Initializer) *symbiont.App {
 	return symbiont.NewApp().
 		Initialize(initializers...).
 		Initialize(
 			&log.InitLogger{},
+			&faultinject.InitInjector{},
 			&telemetry.InitOpenTelemetry{},
 			&telemetry.InitHttpClient{},
 			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&tenantdir.InitDirectory{},
+			&principaldir.InitAuthenticator{},
 			&postgres.InitDB{},
 			&modelrunner.InitAssistantClient{},
+			&llmlimiter.InitAssistant{},
+			&faultinject.InitAssistant{},
+			&redis.InitClient{},
 			&modelrunner.InitEncoderClient{},
+			&redis.InitQueryEncoder{},
+			&moderation.InitModerator{},
+			&weather.InitForecaster{},
+			&websearch.InitSearcher{},
+			&websearch.InitPageReader{},
+			&redaction.InitRedactor{},
 			&pubsub.InitClient{},
+			&workerpool.InitPool{},
 			&postgres.InitUnitOfWork{},
+			&faultinject.InitUnitOfWork{},
 			&postgres.InitTodoRepository{},
+			&postgres.InitTimeEntryRepository{},
+			&postgres.InitFocusBlockRepository{},
+			&postgres.InitProjectRepository{},
+			&postgres.InitCustomFieldRepository{},
+			&postgres.InitEmbeddingBackfillRepository{},
+			&postgres.InitCommentRepository{},
+			&postgres.InitViewRepository{},
+			&postgres.InitTemplateRepository{},
+			&postgres.InitChangeRepository{},
 			&postgres.InitBoardSummaryRepository{},
+			&postgres.InitBoardSnapshotRepository{},
+			&postgres.InitWeeklyReviewRepository{},
 			&postgres.InitChatMessageRepository{},
+			&postgres.InitChatMessagePartitionRepository{},
+			&postgres.InitContentBlobRepository{},
 			&postgres.InitConversationRepository{},
+			&postgres.InitConversationReadModelRepository{},
+			&postgres.InitConversationReadCursorRepository{},
+			&postgres.InitConversationChangeRepository{},
 			&postgres.InitLocker{},
 			&postgres.InitConversationSummaryRepository{},
+			&postgres.InitConversationSnapshotRepository{},
+			&postgres.InitInstructionRepository{},
+			&postgres.InitMemoryRepository{},
+			&postgres.InitConversationTopicRepository{},
+			&postgres.InitShadowEvaluationRepository{},
+			&postgres.InitExperimentRepository{},
+			&postgres.InitChannelLinkRepository{},
+			&postgres.InitSessionRepository{},
+			&postgres.InitUserRepository{},
+			&postgres.InitTokenRepository{},
+			&postgres.InitAuditRepository{},
 			&time.InitCurrentTimeProvider{},
+			&oidc.InitIdentityProvider{},
 			&tokenizer.InitTokenizer{},
 			&approvaldispatcher.InitDispatcher{},
+			&streamregistry.InitRegistry{},
+			&streamregistry.InitSessionRegistry{},
+			&session.InitSessions{},
+			&session.InitLogins{},
+			&session.InitAuthenticator{},
+			&session.InitTokens{},
+			&session.InitTokenAuthenticator{},
+			&todoeventhub.InitHub{},
+			&chateventhub.InitHub{},
+			&redis.InitTodoEventStream{},
+			&todayview.InitRepository{},
+			&notification.InitNotifier{},
 			&pubsub.InitPublisher{},
+			&faultinject.InitPublisher{},
 			&md.InitSkillRegistry{},
 			&todo.InitCreator{},
 			&todo.InitDeleter{},
 			&todo.InitUpdater{},
+			&todo.InitTimeTracker{},
+			&todo.InitComments{},
+			&todo.InitViews{},
+			&todo.InitTemplates{},
+			&todo.InitScheduleNextOccurrence{},
+			&chat.InitInstructions{},
+			&chat.InitMemories{},
+			&chat.InitRelatedConversations{},
+			&todo.InitListChanges{},
+			&todo.InitFocusSessions{},
+			&todo.InitFocusBlocks{},
+			&todo.InitProjects{},
+			&todo.InitArchive{},
+			&todo.InitBackfillEmbeddings{},
+			&todo.InitCustomFields{},
+			&todo.InitListTodos{},
 			&local.InitActionRegistry{},
 			&mcp.InitActionRegistry{},
 			&composite.InitActionRegistry{},
-			&todo.InitListTodos{},
+			&audit.InitLog{},
+			&audit.InitActionRegistry{},
+			&todo.InitSnapshots{},
 			&todo.InitCreateTodo{},
+			&todo.InitQuickAdd{},
 			&todo.InitUpdateTodo{},
 			&todo.InitDeleteTodo{},
+			&todo.InitApplyChanges{},
+			&todo.InitSync{},
+			&todo.InitInboundWebhooks{},
+			&todo.InitGetTimeReport{},
 			&board.InitGenerateBoardSummary{},
+			&board.InitGenerateWeeklyReview{},
 			&chat.InitConversationCompactor{},
+			&chat.InitConversationSnapshotter{},
+			&chat.InitTopicShiftDetector{},
 			&chat.InitConversationTranscriptWriter{},
 			&chat.InitActionPipeline{},
 			&chat.InitTurnRunner{},
+			&chat.InitVerifyingTurnRunner{},
+			&chat.InitGroundingTurnRunner{},
+			&chat.InitShadowTurnRunner{},
 			&chat.InitTurnStateBuilder{},
 			&chat.InitGenerateConversationTitle{},
+			&chat.InitExtractMemories{},
 			&board.InitGetBoardSummary{},
+			&board.InitListWeeklyReviews{},
 			&chat.InitListConversations{},
+			&chat.InitCountUnreadMessages{},
+			&chat.InitMarkConversationRead{},
+			&chat.InitPostSystemMessage{},
+			&chat.InitProjectConversationReadModel{},
 			&chat.InitUpdateConversation{},
 			&chat.InitListChatMessages{},
+			&chat.InitGetContentBlob{},
+			&chat.InitMaintainChatMessagePartitions{},
+			&chat.InitSubmitMessageFeedback{},
+			&chat.InitReplayTurn{},
+			&chat.InitGetTurnTranscript{},
+			&chat.InitGetExperimentReport{},
 			&chat.InitSubmitActionApproval{},
 			&chat.InitDeleteConversation{},
 			&chat.InitStreamChat{},
+			&chat.InitPreviewChat{},
 			&chat.InitListAvailableModels{},
 			&chat.InitListAvailableSkills{},
 			&outbox.InitRelay{},
+			&outbox.InitMonitor{},
 		).
 		Host(
 			&http.TodoAppServer{},
 			&graphql.TodoGraphQLServer{},
 			&workers.BoardSummaryGenerator{},
 			&workers.ConversationTitleGenerator{},
+			&workers.ConversationReadModelProjector{},
 			&workers.ActionApprovalDispatcher{},
+			&workers.TodoEventForwarder{},
+			&workers.ChatEventForwarder{},
 			&workers.MessageRelay{},
+			&workers.OutboxHealthReporter{},
+			&workers.AuditLogPurger{},
+			&workers.ChatMessagePartitionMaintainer{},
+			&workers.WeeklyReviewScheduler{},
+			&workers.MemoryExtractor{},
+			&workers.ProjectSuggester{},
+			&workers.TodoArchiver{},
+			&workers.RecurringTodoScheduler{},
+			&telegram.Bot{},
+			&grpc.TodoGRPCServer{},
 		)
 }
 
 // NewHTTPAPI builds the HTTP API deployable.
-// It hosts the HTTP server (REST API + embedded webapp static files)
-// and action approval dispatcher in one process.
+// It hosts the HTTP server (REST API + embedded webapp static files),
+// action approval dispatcher, todo and chat event forwarders, audit log purger and recurring todo scheduler
+// in one process.
 func NewHTTPAPI() *symbiont.App {
 	return symbiont.NewApp().
 		Initialize(
 			&log.InitLogger{},
+			&faultinject.InitInjector{},
 			&telemetry.InitOpenTelemetry{},
 			&telemetry.InitHttpClient{},
 			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&tenantdir.InitDirectory{},
+			&principaldir.InitAuthenticator{},
 			&postgres.InitDB{},
+			&postgres.InitLocker{},
 			&modelrunner.InitAssistantClient{},
+			&llmlimiter.InitAssistant{},
+			&faultinject.InitAssistant{},
+			&redis.InitClient{},
 			&modelrunner.InitEncoderClient{},
+			&redis.InitQueryEncoder{},
+			&moderation.InitModerator{},
+			&weather.InitForecaster{},
+			&websearch.InitSearcher{},
+			&websearch.InitPageReader{},
+			&redaction.InitRedactor{},
 			&pubsub.InitClient{},
 			&postgres.InitUnitOfWork{},
+			&faultinject.InitUnitOfWork{},
 			&postgres.InitTodoRepository{},
+			&postgres.InitTimeEntryRepository{},
+			&postgres.InitFocusBlockRepository{},
+			&postgres.InitProjectRepository{},
+			&postgres.InitCustomFieldRepository{},
+			&postgres.InitEmbeddingBackfillRepository{},
+			&postgres.InitCommentRepository{},
+			&postgres.InitViewRepository{},
+			&postgres.InitTemplateRepository{},
+			&postgres.InitChangeRepository{},
 			&postgres.InitBoardSummaryRepository{},
+			&postgres.InitBoardSnapshotRepository{},
+			&postgres.InitWeeklyReviewRepository{},
 			&postgres.InitChatMessageRepository{},
+			&postgres.InitChatMessagePartitionRepository{},
+			&postgres.InitContentBlobRepository{},
 			&postgres.InitConversationRepository{},
+			&postgres.InitConversationReadModelRepository{},
+			&postgres.InitConversationReadCursorRepository{},
+			&postgres.InitConversationChangeRepository{},
 			&postgres.InitConversationSummaryRepository{},
+			&postgres.InitConversationSnapshotRepository{},
+			&postgres.InitInstructionRepository{},
+			&postgres.InitMemoryRepository{},
+			&postgres.InitConversationTopicRepository{},
+			&postgres.InitShadowEvaluationRepository{},
+			&postgres.InitExperimentRepository{},
+			&postgres.InitSessionRepository{},
+			&postgres.InitUserRepository{},
+			&postgres.InitTokenRepository{},
+			&postgres.InitAuditRepository{},
 			&time.InitCurrentTimeProvider{},
+			&oidc.InitIdentityProvider{},
 			&tokenizer.InitTokenizer{},
 			&approvaldispatcher.InitDispatcher{},
+			&streamregistry.InitRegistry{},
+			&streamregistry.InitSessionRegistry{},
+			&session.InitSessions{},
+			&session.InitLogins{},
+			&session.InitAuthenticator{},
+			&session.InitTokens{},
+			&session.InitTokenAuthenticator{},
+			&todoeventhub.InitHub{},
+			&chateventhub.InitHub{},
+			&redis.InitTodoEventStream{},
+			&todayview.InitRepository{},
+			&notification.InitNotifier{},
 			&pubsub.InitPublisher{},
+			&faultinject.InitPublisher{},
 			&md.InitSkillRegistry{},
 			&todo.InitCreator{},
 			&todo.InitDeleter{},
 			&todo.InitUpdater{},
+			&todo.InitTimeTracker{},
+			&todo.InitComments{},
+			&todo.InitViews{},
+			&todo.InitTemplates{},
+			&todo.InitScheduleNextOccurrence{},
+			&chat.InitInstructions{},
+			&chat.InitMemories{},
+			&chat.InitRelatedConversations{},
+			&todo.InitListChanges{},
+			&todo.InitFocusSessions{},
+			&todo.InitFocusBlocks{},
+			&todo.InitProjects{},
+			&todo.InitArchive{},
+			&todo.InitBackfillEmbeddings{},
+			&todo.InitCustomFields{},
+			&todo.InitListTodos{},
 			&local.InitActionRegistry{},
 			&mcp.InitActionRegistry{},
 			&composite.InitActionRegistry{},
-			&todo.InitListTodos{},
+			&audit.InitLog{},
+			&audit.InitActionRegistry{},
+			&todo.InitSnapshots{},
 			&todo.InitCreateTodo{},
+			&todo.InitQuickAdd{},
 			&todo.InitUpdateTodo{},
 			&todo.InitDeleteTodo{},
+			&todo.InitSync{},
+			&todo.InitInboundWebhooks{},
+			&todo.InitGetTimeReport{},
 			&board.InitGetBoardSummary{},
+			&board.InitListWeeklyReviews{},
 			&chat.InitConversationCompactor{},
+			&chat.InitConversationSnapshotter{},
+			&chat.InitTopicShiftDetector{},
 			&chat.InitConversationTranscriptWriter{},
 			&chat.InitActionPipeline{},
 			&chat.InitTurnRunner{},
+			&chat.InitVerifyingTurnRunner{},
+			&chat.InitGroundingTurnRunner{},
+			&chat.InitShadowTurnRunner{},
 			&chat.InitTurnStateBuilder{},
 			&chat.InitListConversations{},
+			&chat.InitCountUnreadMessages{},
+			&chat.InitMarkConversationRead{},
+			&chat.InitPostSystemMessage{},
 			&chat.InitUpdateConversation{},
 			&chat.InitListChatMessages{},
+			&chat.InitGetContentBlob{},
+			&chat.InitMaintainChatMessagePartitions{},
+			&chat.InitSubmitMessageFeedback{},
+			&chat.InitReplayTurn{},
+			&chat.InitGetTurnTranscript{},
+			&chat.InitGetExperimentReport{},
 			&chat.InitSubmitActionApproval{},
 			&chat.InitDeleteConversation{},
 			&chat.InitStreamChat{},
+			&chat.InitPreviewChat{},
 			&chat.InitListAvailableModels{},
 			&chat.InitListAvailableSkills{},
+			&outbox.InitMonitor{},
 		).
 		Host(
 			&http.TodoAppServer{},
 			&workers.ActionApprovalDispatcher{},
+			&workers.TodoEventForwarder{},
+			&workers.ChatEventForwarder{},
+			&workers.AuditLogPurger{},
+			&workers.ChatMessagePartitionMaintainer{},
+			&workers.RecurringTodoScheduler{},
 		)
 }
 
@@ -156,16 +388,29 @@ func NewGraphQLAPI() *symbiont.App {
 			&telemetry.InitOpenTelemetry{},
 			&telemetry.InitHttpClient{},
 			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&tenantdir.InitDirectory{},
 			&postgres.InitDB{SkipMigration: true},
+			&redis.InitClient{},
 			&modelrunner.InitEncoderClient{},
+			&redis.InitQueryEncoder{},
+			&modelrunner.InitAssistantClient{},
 			&postgres.InitUnitOfWork{},
 			&postgres.InitTodoRepository{},
+			&postgres.InitViewRepository{},
+			&postgres.InitCustomFieldRepository{},
 			&time.InitCurrentTimeProvider{},
+			&todo.InitCreator{},
 			&todo.InitDeleter{},
 			&todo.InitUpdater{},
 			&todo.InitListTodos{},
 			&todo.InitUpdateTodo{},
 			&todo.InitDeleteTodo{},
+			&todo.InitApplyChanges{},
+			&todo.InitComments{},
+			&todo.InitViews{},
+			&todo.InitCustomFields{},
+			&chat.InitUpdateConversation{},
 		).
 		Host(
 			&graphql.TodoGraphQLServer{},
@@ -173,26 +418,73 @@ func NewGraphQLAPI() *symbiont.App {
 }
 
 // NewMessageRelay builds the outbox relay worker deployable.
-// It hosts the message relay worker in a dedicated process.
+// It hosts the message relay worker and the outbox health reporter in a dedicated process.
 func NewMessageRelay() *symbiont.App {
 	return symbiont.NewApp().
 		Initialize(
 			&log.InitLogger{},
 			&telemetry.InitOpenTelemetry{},
 			&config.InitVaultProvider{},
+			&config.InitReloader{},
 			&postgres.InitDB{SkipMigration: true},
+			&postgres.InitLocker{},
 			&pubsub.InitClient{},
 			&postgres.InitUnitOfWork{},
 			&pubsub.InitPublisher{},
+			&time.InitCurrentTimeProvider{},
 			&outbox.InitRelay{},
+			&outbox.InitMonitor{},
 		).
 		Host(
 			&workers.MessageRelay{},
+			&workers.OutboxHealthReporter{},
+		)
+}
+
+// NewEventReprocessor builds the administrative reprocess command.
+// It records the synthetic events selected by the reprocessor in the outbox and exits; the message relay
+// publishes them to the regular consumers.
+func NewEventReprocessor(reprocessor *workers.EventReprocessor) *symbiont.App {
+	return symbiont.NewApp().
+		Initialize(
+			&log.InitLogger{},
+			&telemetry.InitOpenTelemetry{},
+			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&postgres.InitDB{SkipMigration: true},
+			&postgres.InitUnitOfWork{},
+			&time.InitCurrentTimeProvider{},
+			&outbox.InitReprocessEvents{},
+		).
+		Host(
+			reprocessor,
+		)
+}
+
+// NewEmbeddingBackfiller builds the one-shot embedding backfill command. It re-embeds the todos of one tenant
+// whose embedding is missing or stale and stops once none is left.
+func NewEmbeddingBackfiller(backfiller *workers.EmbeddingBackfiller) *symbiont.App {
+	return symbiont.NewApp().
+		Initialize(
+			&log.InitLogger{},
+			&telemetry.InitOpenTelemetry{},
+			&telemetry.InitHttpClient{},
+			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&postgres.InitDB{SkipMigration: true},
+			&modelrunner.InitEncoderClient{},
+			&postgres.InitTodoRepository{},
+			&postgres.InitEmbeddingBackfillRepository{},
+			&time.InitCurrentTimeProvider{},
+			&todo.InitBackfillEmbeddings{},
+		).
+		Host(
+			backfiller,
 		)
 }
 
 // NewBoardSummaryGenerator builds the board summary generator deployable.
-// It hosts the board summary generator in a dedicated process.
+// It hosts the board summary generator and the weekly review scheduler in a dedicated process.
 func NewBoardSummaryGenerator() *symbiont.App {
 	return symbiont.NewApp().
 		Initialize(
@@ -200,21 +492,31 @@ func NewBoardSummaryGenerator() *symbiont.App {
 			&telemetry.InitOpenTelemetry{},
 			&telemetry.InitHttpClient{},
 			&config.InitVaultProvider{},
+			&config.InitReloader{},
 			&postgres.InitDB{SkipMigration: true},
 			&postgres.InitLocker{},
 			&modelrunner.InitAssistantClient{},
+			&llmlimiter.InitAssistant{},
 			&pubsub.InitClient{},
+			&workerpool.InitPool{},
+			&postgres.InitUnitOfWork{},
 			&postgres.InitBoardSummaryRepository{},
+			&postgres.InitTodoRepository{},
+			&postgres.InitWeeklyReviewRepository{},
 			&time.InitCurrentTimeProvider{},
+			&notification.InitNotifier{},
 			&board.InitGenerateBoardSummary{},
+			&board.InitGenerateWeeklyReview{},
+			&outbox.InitMonitor{},
 		).
 		Host(
 			&workers.BoardSummaryGenerator{},
+			&workers.WeeklyReviewScheduler{},
 		)
 }
 
 // NewConversationTitleGenerator builds the conversation title generator deployable.
-// It hosts the conversation title generator in a dedicated process.
+// It hosts the conversation title generator and the conversation read model projector in a dedicated process.
 func NewConversationTitleGenerator() *symbiont.App {
 	return symbiont.NewApp().
 		Initialize(
@@ -222,17 +524,209 @@ func NewConversationTitleGenerator() *symbiont.App {
 			&telemetry.InitOpenTelemetry{},
 			&telemetry.InitHttpClient{},
 			&config.InitVaultProvider{},
+			&config.InitReloader{},
 			&postgres.InitDB{SkipMigration: true},
 			&modelrunner.InitAssistantClient{},
+			&llmlimiter.InitAssistant{},
 			&pubsub.InitClient{},
+			&workerpool.InitPool{},
+			&postgres.InitUnitOfWork{},
 			&postgres.InitChatMessageRepository{},
+			&postgres.InitContentBlobRepository{},
 			&postgres.InitConversationRepository{},
+			&postgres.InitConversationReadModelRepository{},
 			&postgres.InitLocker{},
 			&postgres.InitConversationSummaryRepository{},
 			&time.InitCurrentTimeProvider{},
 			&chat.InitGenerateConversationTitle{},
+			&chat.InitProjectConversationReadModel{},
+			&outbox.InitMonitor{},
 		).
 		Host(
 			&workers.ConversationTitleGenerator{},
+			&workers.ConversationReadModelProjector{},
+		)
+}
+
+// NewTelegramBot builds the Telegram bot deployable.
+// It hosts the Telegram bot and the action approval dispatcher in one process.
+// Run a single replica, the Bot API allows only one poller per bot token.
+func NewTelegramBot() *symbiont.App {
+	return symbiont.NewApp().
+		Initialize(
+			&log.InitLogger{},
+			&telemetry.InitOpenTelemetry{},
+			&telemetry.InitHttpClient{},
+			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&tenantdir.InitDirectory{},
+			&postgres.InitDB{SkipMigration: true},
+			&modelrunner.InitAssistantClient{},
+			&llmlimiter.InitAssistant{},
+			&redis.InitClient{},
+			&modelrunner.InitEncoderClient{},
+			&redis.InitQueryEncoder{},
+			&moderation.InitModerator{},
+			&weather.InitForecaster{},
+			&websearch.InitSearcher{},
+			&websearch.InitPageReader{},
+			&redaction.InitRedactor{},
+			&pubsub.InitClient{},
+			&postgres.InitUnitOfWork{},
+			&postgres.InitTodoRepository{},
+			&postgres.InitTimeEntryRepository{},
+			&postgres.InitFocusBlockRepository{},
+			&postgres.InitProjectRepository{},
+			&postgres.InitCustomFieldRepository{},
+			&postgres.InitCommentRepository{},
+			&postgres.InitViewRepository{},
+			&postgres.InitTemplateRepository{},
+			&postgres.InitChangeRepository{},
+			&postgres.InitChatMessageRepository{},
+			&postgres.InitContentBlobRepository{},
+			&postgres.InitConversationRepository{},
+			&postgres.InitConversationSummaryRepository{},
+			&postgres.InitConversationSnapshotRepository{},
+			&postgres.InitInstructionRepository{},
+			&postgres.InitMemoryRepository{},
+			&postgres.InitConversationTopicRepository{},
+			&postgres.InitShadowEvaluationRepository{},
+			&postgres.InitChannelLinkRepository{},
+			&postgres.InitAuditRepository{},
+			&time.InitCurrentTimeProvider{},
+			&tokenizer.InitTokenizer{},
+			&approvaldispatcher.InitDispatcher{},
+			&streamregistry.InitRegistry{},
+			&notification.InitNotifier{},
+			&md.InitSkillRegistry{},
+			&todo.InitCreator{},
+			&todo.InitDeleter{},
+			&todo.InitUpdater{},
+			&todo.InitTimeTracker{},
+			&todo.InitComments{},
+			&todo.InitViews{},
+			&todo.InitTemplates{},
+			&chat.InitInstructions{},
+			&chat.InitMemories{},
+			&chat.InitRelatedConversations{},
+			&todo.InitFocusSessions{},
+			&todo.InitFocusBlocks{},
+			&todo.InitProjects{},
+			&todo.InitArchive{},
+			&todo.InitCustomFields{},
+			&todo.InitListTodos{},
+			&local.InitActionRegistry{},
+			&mcp.InitActionRegistry{},
+			&composite.InitActionRegistry{},
+			&audit.InitLog{},
+			&audit.InitActionRegistry{},
+			&chat.InitConversationCompactor{},
+			&chat.InitConversationSnapshotter{},
+			&chat.InitTopicShiftDetector{},
+			&chat.InitConversationTranscriptWriter{},
+			&chat.InitActionPipeline{},
+			&chat.InitTurnRunner{},
+			&chat.InitVerifyingTurnRunner{},
+			&chat.InitGroundingTurnRunner{},
+			&chat.InitShadowTurnRunner{},
+			&chat.InitTurnStateBuilder{},
+			&chat.InitStreamChat{},
+		).
+		Host(
+			&telegram.Bot{},
+			&workers.ActionApprovalDispatcher{},
+		)
+}
+
+// NewGRPCAPI builds the gRPC API deployable.
+// It hosts the gRPC server and the action approval dispatcher in one process.
+func NewGRPCAPI() *symbiont.App {
+	return symbiont.NewApp().
+		Initialize(
+			&log.InitLogger{},
+			&telemetry.InitOpenTelemetry{},
+			&telemetry.InitHttpClient{},
+			&config.InitVaultProvider{},
+			&config.InitReloader{},
+			&tenantdir.InitDirectory{},
+			&postgres.InitDB{SkipMigration: true},
+			&modelrunner.InitAssistantClient{},
+			&llmlimiter.InitAssistant{},
+			&redis.InitClient{},
+			&modelrunner.InitEncoderClient{},
+			&redis.InitQueryEncoder{},
+			&moderation.InitModerator{},
+			&weather.InitForecaster{},
+			&websearch.InitSearcher{},
+			&websearch.InitPageReader{},
+			&redaction.InitRedactor{},
+			&pubsub.InitClient{},
+			&postgres.InitUnitOfWork{},
+			&postgres.InitTodoRepository{},
+			&postgres.InitTimeEntryRepository{},
+			&postgres.InitFocusBlockRepository{},
+			&postgres.InitProjectRepository{},
+			&postgres.InitCustomFieldRepository{},
+			&postgres.InitCommentRepository{},
+			&postgres.InitViewRepository{},
+			&postgres.InitTemplateRepository{},
+			&postgres.InitChangeRepository{},
+			&postgres.InitChatMessageRepository{},
+			&postgres.InitContentBlobRepository{},
+			&postgres.InitConversationRepository{},
+			&postgres.InitConversationReadModelRepository{},
+			&postgres.InitConversationSummaryRepository{},
+			&postgres.InitConversationSnapshotRepository{},
+			&postgres.InitInstructionRepository{},
+			&postgres.InitMemoryRepository{},
+			&postgres.InitConversationTopicRepository{},
+			&postgres.InitShadowEvaluationRepository{},
+			&postgres.InitAuditRepository{},
+			&time.InitCurrentTimeProvider{},
+			&tokenizer.InitTokenizer{},
+			&approvaldispatcher.InitDispatcher{},
+			&streamregistry.InitRegistry{},
+			&notification.InitNotifier{},
+			&md.InitSkillRegistry{},
+			&todo.InitCreator{},
+			&todo.InitDeleter{},
+			&todo.InitUpdater{},
+			&todo.InitTimeTracker{},
+			&todo.InitComments{},
+			&todo.InitViews{},
+			&todo.InitTemplates{},
+			&chat.InitInstructions{},
+			&chat.InitMemories{},
+			&chat.InitRelatedConversations{},
+			&todo.InitFocusSessions{},
+			&todo.InitFocusBlocks{},
+			&todo.InitProjects{},
+			&todo.InitArchive{},
+			&todo.InitCustomFields{},
+			&todo.InitListTodos{},
+			&local.InitActionRegistry{},
+			&mcp.InitActionRegistry{},
+			&composite.InitActionRegistry{},
+			&audit.InitLog{},
+			&audit.InitActionRegistry{},
+			&chat.InitConversationCompactor{},
+			&chat.InitConversationSnapshotter{},
+			&chat.InitTopicShiftDetector{},
+			&chat.InitConversationTranscriptWriter{},
+			&chat.InitActionPipeline{},
+			&chat.InitTurnRunner{},
+			&chat.InitVerifyingTurnRunner{},
+			&chat.InitGroundingTurnRunner{},
+			&chat.InitShadowTurnRunner{},
+			&chat.InitTurnStateBuilder{},
+			&todo.InitCreateTodo{},
+			&todo.InitUpdateTodo{},
+			&todo.InitDeleteTodo{},
+			&chat.InitListConversations{},
+			&chat.InitStreamChat{},
+		).
+		Host(
+			&grpc.TodoGRPCServer{},
+			&workers.ActionApprovalDispatcher{},
 		)
 }
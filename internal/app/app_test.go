@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/cleitonmarx/symbiont"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/workers"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,6 +27,10 @@ func TestNewApps_NotNil(t *testing.T) {
 		NewMessageRelay(),
 		NewBoardSummaryGenerator(),
 		NewConversationTitleGenerator(),
+		NewTelegramBot(),
+		NewGRPCAPI(),
+		NewEventReprocessor(&workers.EventReprocessor{}),
+		NewEmbeddingBackfiller(&workers.EmbeddingBackfiller{}),
 	}
 
 	for _, app := range apps {
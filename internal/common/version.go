@@ -0,0 +1,22 @@
+package common
+
+import "runtime/debug"
+
+// Version is the build version, set at build time with
+// -ldflags "-X github.com/cleitonmarx/symbiont-ai-todoapp/internal/common.Version=<version>".
+var Version = ""
+
+// BuildVersion returns Version, falling back to the VCS revision stamped by the Go toolchain, or "dev".
+func BuildVersion() string {
+	if Version != "" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" && setting.Value != "" {
+				return setting.Value
+			}
+		}
+	}
+	return "dev"
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildVersion(t *testing.T) {
+	tests := map[string]struct {
+		version  string
+		expected string
+	}{
+		"set-at-build-time": {
+			version:  "1.4.0",
+			expected: "1.4.0",
+		},
+		"not-set": {
+			version:  "",
+			expected: "dev",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			original := Version
+			t.Cleanup(func() { Version = original })
+			Version = tt.version
+
+			assert.Equal(t, tt.expected, BuildVersion())
+		})
+	}
+}
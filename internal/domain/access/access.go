@@ -0,0 +1,126 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/google/uuid"
+)
+
+// Role grants a principal a level of access to the API and the assistant.
+type Role string
+
+const (
+	// RoleAdmin may use every endpoint, including the admin endpoints.
+	RoleAdmin Role = "admin"
+	// RoleMember may read and change todos and conversations.
+	RoleMember Role = "member"
+	// RoleReadonly may read todos and chat with the assistant, which then only gets read actions.
+	RoleReadonly Role = "readonly"
+)
+
+// roleRanks orders the roles so that each role includes the access of the roles ranked below it.
+var roleRanks = map[Role]int{
+	RoleReadonly: 1,
+	RoleMember:   2,
+	RoleAdmin:    3,
+}
+
+// Validate checks that the role is one of admin, member or readonly.
+func (r Role) Validate() error {
+	if _, ok := roleRanks[r]; !ok {
+		return core.NewValidationErr(fmt.Sprintf("role must be one of admin, member or readonly, got %q", r))
+	}
+	return nil
+}
+
+// Allows reports whether the role grants the access of the required role.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
+
+// CanWrite reports whether the role may change todos and conversations.
+func (r Role) CanWrite() bool {
+	return r.Allows(RoleMember)
+}
+
+// Principal is the caller of the API on whose behalf a request runs.
+type Principal struct {
+	Name string
+	Role Role
+	// SessionID is the session the principal authenticated with, or uuid.Nil for API tokens.
+	SessionID uuid.UUID
+	// UserID is the user signed in through an identity provider, or uuid.Nil for API principals.
+	UserID uuid.UUID
+	// TokenID is the personal access token the principal authenticated with, or uuid.Nil otherwise.
+	TokenID uuid.UUID
+	// Scopes limit a principal authenticated with a personal access token. Other principals have no scopes
+	// and are only limited by their role.
+	Scopes []Scope
+	// Tenant is the only tenant an API principal may act in, or empty for any tenant. Sessions and personal
+	// access tokens are stored per tenant and only authenticate in their own.
+	Tenant tenant.ID
+}
+
+// HasScope reports whether the principal may act within the scope. Principals that did not authenticate
+// with a personal access token have every scope.
+func (p Principal) HasScope(scope Scope) bool {
+	return p.TokenID == uuid.Nil || slices.Contains(p.Scopes, scope)
+}
+
+// CanWrite reports whether the principal may change todos and conversations, by its role and scopes.
+func (p Principal) CanWrite() bool {
+	return p.Role.CanWrite() && p.HasScope(ScopeTodosWrite)
+}
+
+// AllowsTenant reports whether the principal may act in the tenant.
+func (p Principal) AllowsTenant(id tenant.ID) bool {
+	return p.Tenant == "" || p.Tenant == id
+}
+
+// IsSystem reports whether p is the System principal.
+func (p Principal) IsSystem() bool {
+	return p.Name == System.Name && p.Role == System.Role && p.SessionID == uuid.Nil &&
+		p.UserID == uuid.Nil && p.TokenID == uuid.Nil && p.Tenant == ""
+}
+
+// Subject identifies the principal across its sessions and tokens: the signed-in user when there is one,
+// the principal name otherwise.
+func (p Principal) Subject() string {
+	if p.UserID != uuid.Nil {
+		return "user:" + p.UserID.String()
+	}
+	return "principal:" + p.Name
+}
+
+// System is the principal of requests to deployments without configured principals and of background work.
+// It is not restricted.
+var System = Principal{Name: "system", Role: RoleAdmin}
+
+// Authenticator resolves the principal presenting an API token.
+type Authenticator interface {
+	// Enabled reports whether API principals are configured. Without principals every request runs as System.
+	Enabled() bool
+	// Authenticate returns the principal holding the token, or System when principals are not enabled.
+	// It returns an UnauthorizedErr when no principal holds the token.
+	Authenticate(ctx context.Context, token string) (Principal, error)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx running on behalf of the principal.
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the principal ctx runs on behalf of, or System when ctx carries no principal.
+func FromContext(ctx context.Context) Principal {
+	if p, ok := ctx.Value(contextKey{}).(Principal); ok && p.Role != "" {
+		return p
+	}
+	return System
+}
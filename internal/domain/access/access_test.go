@@ -0,0 +1,260 @@
+package access
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		role    Role
+		wantErr bool
+	}{
+		"admin":     {role: RoleAdmin},
+		"member":    {role: RoleMember},
+		"readonly":  {role: RoleReadonly},
+		"empty":     {role: "", wantErr: true},
+		"uppercase": {role: "Admin", wantErr: true},
+		"unknown":   {role: "owner", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.role.Validate()
+			if tt.wantErr {
+				assert.IsType(t, &core.ValidationErr{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRole_Allows(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		role      Role
+		required  Role
+		want      bool
+		wantWrite bool
+	}{
+		"admin-admin":       {role: RoleAdmin, required: RoleAdmin, want: true, wantWrite: true},
+		"admin-readonly":    {role: RoleAdmin, required: RoleReadonly, want: true, wantWrite: true},
+		"member-admin":      {role: RoleMember, required: RoleAdmin, want: false, wantWrite: true},
+		"member-member":     {role: RoleMember, required: RoleMember, want: true, wantWrite: true},
+		"readonly-member":   {role: RoleReadonly, required: RoleMember, want: false},
+		"readonly-readonly": {role: RoleReadonly, required: RoleReadonly, want: true},
+		"unknown-readonly":  {role: "owner", required: RoleReadonly, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.role.Allows(tt.required))
+			assert.Equal(t, tt.wantWrite, tt.role.CanWrite())
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	viewer := Principal{Name: "viewer", Role: RoleReadonly}
+
+	assert.Equal(t, System, FromContext(context.Background()))
+	assert.Equal(t, viewer, FromContext(NewContext(context.Background(), viewer)))
+	assert.Equal(t, System, FromContext(NewContext(context.Background(), Principal{Name: "unnamed"})))
+}
+
+func TestPrincipal_Subject(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	assert.Equal(t, "principal:system", System.Subject())
+	assert.Equal(t, "principal:ci", Principal{Name: "ci", Role: RoleMember}.Subject())
+	assert.Equal(t, "user:"+userID.String(), Principal{Name: "ada@example.com", Role: RoleMember, UserID: userID}.Subject())
+}
+
+func TestSession_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Minute)
+
+	tests := map[string]struct {
+		session Session
+		want    bool
+	}{
+		"active":  {session: Session{ExpiresAt: now.Add(time.Hour)}, want: true},
+		"expired": {session: Session{ExpiresAt: now}, want: false},
+		"revoked": {session: Session{ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt}, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.session.IsActive(now))
+		})
+	}
+}
+
+func TestSession_AsPrincipal(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	session := Session{ID: id, Principal: "viewer", Role: RoleReadonly, UserID: userID}
+
+	assert.Equal(t, Principal{Name: "viewer", Role: RoleReadonly, SessionID: id, UserID: userID}, session.AsPrincipal())
+}
+
+func TestUser_AsPrincipal(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	user := User{ID: id, Subject: "248289761001", Email: "jane@example.com", Role: RoleMember}
+
+	assert.Equal(t, Principal{
+		Name:   "user:00000000-0000-0000-0000-000000000003",
+		Role:   RoleMember,
+		UserID: id,
+	}, user.AsPrincipal())
+}
+
+func TestPrincipal_Scopes(t *testing.T) {
+	t.Parallel()
+
+	tokenID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	tests := map[string]struct {
+		principal Principal
+		wantRead  bool
+		wantChat  bool
+		wantWrite bool
+	}{
+		"api-principal": {
+			principal: Principal{Name: "ci", Role: RoleMember},
+			wantRead:  true,
+			wantChat:  true,
+			wantWrite: true,
+		},
+		"read-token": {
+			principal: Principal{Name: "ci", Role: RoleMember, TokenID: tokenID, Scopes: []Scope{ScopeTodosRead}},
+			wantRead:  true,
+		},
+		"write-and-chat-token": {
+			principal: Principal{Name: "ci", Role: RoleMember, TokenID: tokenID, Scopes: []Scope{ScopeTodosWrite, ScopeChat}},
+			wantChat:  true,
+			wantWrite: true,
+		},
+		"write-token-of-readonly-role": {
+			principal: Principal{Name: "viewer", Role: RoleReadonly, TokenID: tokenID, Scopes: []Scope{ScopeTodosWrite}},
+			wantWrite: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.wantRead, tt.principal.HasScope(ScopeTodosRead))
+			assert.Equal(t, tt.wantChat, tt.principal.HasScope(ScopeChat))
+			assert.Equal(t, tt.wantWrite, tt.principal.CanWrite())
+		})
+	}
+}
+
+func TestPrincipal_IsSystem(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, System.IsSystem())
+	assert.True(t, FromContext(context.Background()).IsSystem())
+	assert.False(t, Principal{Name: "ci", Role: RoleAdmin}.IsSystem())
+	assert.False(t, Principal{Name: "system", Role: RoleAdmin, TokenID: uuid.New()}.IsSystem())
+}
+
+func TestPrincipal_AllowsTenant(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Principal{Name: "ops", Role: RoleAdmin}.AllowsTenant("acme"))
+	assert.True(t, Principal{Name: "ci", Role: RoleMember, Tenant: "acme"}.AllowsTenant("acme"))
+	assert.False(t, Principal{Name: "ci", Role: RoleMember, Tenant: "acme"}.AllowsTenant("globex"))
+}
+
+func TestPersonalAccessToken_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		token   PersonalAccessToken
+		wantErr string
+	}{
+		"valid": {
+			token: PersonalAccessToken{Name: "backup script", Scopes: []Scope{ScopeTodosRead, ScopeChat}},
+		},
+		"missing-name": {
+			token:   PersonalAccessToken{Scopes: []Scope{ScopeTodosRead}},
+			wantErr: "token name is required",
+		},
+		"long-name": {
+			token:   PersonalAccessToken{Name: strings.Repeat("a", MaxTokenNameLength+1), Scopes: []Scope{ScopeTodosRead}},
+			wantErr: "token name cannot exceed 100 characters",
+		},
+		"no-scopes": {
+			token:   PersonalAccessToken{Name: "cli"},
+			wantErr: "token requires at least one scope",
+		},
+		"unknown-scope": {
+			token:   PersonalAccessToken{Name: "cli", Scopes: []Scope{"admin"}},
+			wantErr: `scope must be one of todos:read, todos:write or chat, got "admin"`,
+		},
+		"duplicated-scope": {
+			token:   PersonalAccessToken{Name: "cli", Scopes: []Scope{ScopeChat, ScopeChat}},
+			wantErr: "scope chat is given more than once",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.token.Validate()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPersonalAccessToken_AsPrincipal(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000006")
+	revokedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	token := PersonalAccessToken{ID: id, Principal: "ci", Role: RoleMember, UserID: userID, Scopes: []Scope{ScopeTodosRead}}
+
+	assert.True(t, token.IsActive())
+	assert.Equal(t, Principal{
+		Name:    "ci",
+		Role:    RoleMember,
+		UserID:  userID,
+		TokenID: id,
+		Scopes:  []Scope{ScopeTodosRead},
+	}, token.AsPrincipal())
+
+	token.RevokedAt = &revokedAt
+	assert.False(t, token.IsActive())
+}
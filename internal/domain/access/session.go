@@ -0,0 +1,67 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a login of a principal from one device. The principal exchanges an API token, or signs
+// in through an identity provider, for a short-lived access token and a refresh token that rotates
+// on every refresh.
+// Tokens are only stored as hashes.
+type Session struct {
+	ID        uuid.UUID
+	Principal string
+	Role      Role
+	// UserID is the user the session was started for, or uuid.Nil for API principals.
+	UserID uuid.UUID
+	// Device describes the client that started the session, such as its user agent.
+	Device           string
+	AccessTokenHash  string
+	AccessExpiresAt  time.Time
+	RefreshTokenHash string
+	// ExpiresAt is when the refresh token expires, ending the session.
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  *time.Time
+}
+
+// IsActive reports whether the session is neither revoked nor expired at the given time.
+func (s Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// AsPrincipal returns the principal the session runs requests on behalf of.
+func (s Session) AsPrincipal() Principal {
+	return Principal{Name: s.Principal, Role: s.Role, SessionID: s.ID, UserID: s.UserID}
+}
+
+// SessionRepository stores the sessions of the principals.
+type SessionRepository interface {
+	// CreateSession stores a new session.
+	CreateSession(ctx context.Context, session Session) error
+	// UpdateSession stores the tokens, expirations, last use and revocation of an existing session.
+	UpdateSession(ctx context.Context, session Session) error
+	// TouchSession records the last use of a session that has not been revoked, leaving its tokens untouched.
+	TouchSession(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	// GetSession retrieves a session by its ID.
+	GetSession(ctx context.Context, id uuid.UUID) (Session, bool, error)
+	// GetSessionByAccessToken retrieves the session holding the access token hash.
+	GetSessionByAccessToken(ctx context.Context, tokenHash string) (Session, bool, error)
+	// GetSessionByRefreshToken retrieves the session holding the refresh token hash.
+	GetSessionByRefreshToken(ctx context.Context, tokenHash string) (Session, bool, error)
+	// ListActiveSessions lists the sessions of the principal that are active at the given time, most recently used first.
+	ListActiveSessions(ctx context.Context, principal string, now time.Time) ([]Session, error)
+}
+
+// SessionStreams tracks the long-lived streams, such as SSE chat streams, opened with a session so
+// they can be terminated when the session is revoked.
+type SessionStreams interface {
+	// Track registers the cancel function of a stream opened with the session and returns a function that untracks it.
+	Track(sessionID uuid.UUID, cancel context.CancelFunc) (untrack func())
+	// Terminate cancels every stream opened with the session and returns how many were open.
+	Terminate(sessionID uuid.UUID) int
+}
@@ -0,0 +1,103 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// Scope limits what a personal access token may do on top of the role of its principal.
+type Scope string
+
+const (
+	// ScopeTodosRead allows reading todos and the rest of the API.
+	ScopeTodosRead Scope = "todos:read"
+	// ScopeTodosWrite allows creating, changing and deleting todos and the rest of the API.
+	ScopeTodosWrite Scope = "todos:write"
+	// ScopeChat allows chatting with the assistant, which only gets write actions with ScopeTodosWrite.
+	ScopeChat Scope = "chat"
+)
+
+// Scopes lists every scope, in the order they are documented.
+var Scopes = []Scope{ScopeTodosRead, ScopeTodosWrite, ScopeChat}
+
+// Validate checks that the scope is one of todos:read, todos:write or chat.
+func (s Scope) Validate() error {
+	if !slices.Contains(Scopes, s) {
+		return core.NewValidationErr(fmt.Sprintf("scope must be one of todos:read, todos:write or chat, got %q", s))
+	}
+	return nil
+}
+
+// MaxTokenNameLength caps the name of a personal access token.
+const MaxTokenNameLength = 100
+
+// PersonalAccessToken is a long-lived token a principal creates for automation, such as scripts, the CLI or
+// integrations calling the API. Unlike a session it does not expire or rotate: it works until it is revoked,
+// on behalf of its principal and limited to its scopes. The token is only stored as a hash.
+type PersonalAccessToken struct {
+	ID        uuid.UUID
+	Principal string
+	Role      Role
+	// UserID is the user who created the token, or uuid.Nil for API principals.
+	UserID    uuid.UUID
+	Name      string
+	Scopes    []Scope
+	TokenHash string
+	CreatedAt time.Time
+	// LastUsedAt is when the token last authenticated a request, or nil when it was never used.
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// Validate checks that the token has a name and at least one known scope, each given once.
+func (t PersonalAccessToken) Validate() error {
+	if t.Name == "" {
+		return core.NewValidationErr("token name is required")
+	}
+	if len([]rune(t.Name)) > MaxTokenNameLength {
+		return core.NewValidationErr(fmt.Sprintf("token name cannot exceed %d characters", MaxTokenNameLength))
+	}
+	if len(t.Scopes) == 0 {
+		return core.NewValidationErr("token requires at least one scope")
+	}
+	for i, scope := range t.Scopes {
+		if err := scope.Validate(); err != nil {
+			return err
+		}
+		if slices.Contains(t.Scopes[:i], scope) {
+			return core.NewValidationErr(fmt.Sprintf("scope %s is given more than once", scope))
+		}
+	}
+	return nil
+}
+
+// IsActive reports whether the token has not been revoked.
+func (t PersonalAccessToken) IsActive() bool {
+	return t.RevokedAt == nil
+}
+
+// AsPrincipal returns the principal the token runs requests on behalf of.
+func (t PersonalAccessToken) AsPrincipal() Principal {
+	return Principal{Name: t.Principal, Role: t.Role, UserID: t.UserID, TokenID: t.ID, Scopes: t.Scopes}
+}
+
+// TokenRepository stores the personal access tokens of the principals.
+type TokenRepository interface {
+	// CreateToken stores a new token.
+	CreateToken(ctx context.Context, token PersonalAccessToken) error
+	// UpdateToken stores the last use and revocation of an existing token.
+	UpdateToken(ctx context.Context, token PersonalAccessToken) error
+	// TouchToken records the last use of a token that has not been revoked.
+	TouchToken(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	// GetToken retrieves a token by its ID.
+	GetToken(ctx context.Context, id uuid.UUID) (PersonalAccessToken, bool, error)
+	// GetTokenByHash retrieves the token with the hash.
+	GetTokenByHash(ctx context.Context, tokenHash string) (PersonalAccessToken, bool, error)
+	// ListActiveTokens lists the tokens of the principal that are not revoked, newest first.
+	ListActiveTokens(ctx context.Context, principal string) ([]PersonalAccessToken, error)
+}
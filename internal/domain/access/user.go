@@ -0,0 +1,60 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User is a person who signs in through an OpenID Connect provider. The user is provisioned on
+// the first login and identified by the issuer and subject of the provider.
+type User struct {
+	ID          uuid.UUID
+	Issuer      string
+	Subject     string
+	Email       string
+	Name        string
+	Role        Role
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+// PrincipalName returns the name of the principal of the user. It is based on the user ID, which
+// unlike the email is stable, and is prefixed so it cannot be mistaken for an API principal.
+func (u User) PrincipalName() string {
+	return "user:" + u.ID.String()
+}
+
+// AsPrincipal returns the principal requests of the user run on behalf of.
+func (u User) AsPrincipal() Principal {
+	return Principal{Name: u.PrincipalName(), Role: u.Role, UserID: u.ID}
+}
+
+// UserRepository stores the users provisioned from identity provider logins.
+type UserRepository interface {
+	// CreateUser stores a new user.
+	CreateUser(ctx context.Context, user User) error
+	// UpdateUser stores the email, name and last login of an existing user.
+	UpdateUser(ctx context.Context, user User) error
+	// GetUserBySubject retrieves the user with the issuer and subject of an identity provider.
+	GetUserBySubject(ctx context.Context, issuer, subject string) (User, bool, error)
+}
+
+// Identity is the identity an OpenID Connect provider asserts for a login.
+type Identity struct {
+	Issuer  string
+	Subject string
+	Email   string
+	Name    string
+}
+
+// IdentityProvider signs users in with the OpenID Connect authorization code flow and PKCE.
+type IdentityProvider interface {
+	// AuthCodeURL returns the URL of the provider the user signs in at. The state and nonce bind the
+	// login to the browser that started it and the verifier is the PKCE code verifier.
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	// Exchange redeems the authorization code and returns the identity of the verified ID token,
+	// which must carry the nonce. It returns an UnauthorizedErr when the code or the token is rejected.
+	Exchange(ctx context.Context, code, verifier, nonce string) (Identity, error)
+}
@@ -3,6 +3,8 @@ package assistant
 import (
 	"context"
 	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 )
 
 // ActionCall contains one action invocation requested by the assistant.
@@ -19,6 +21,11 @@ type ActionDefinition struct {
 	Description string
 	Input       ActionInput
 	Approval    ActionApproval
+	// ReadOnly marks actions that do not change todos or other user data. Readonly principals only get read-only actions.
+	ReadOnly bool
+	// Sandboxed marks actions that only change data through the unit of work, so their changes are discarded
+	// when they run inside a transaction.Sandbox. Previews only run read-only and sandboxed actions.
+	Sandboxed bool
 }
 
 // ActionApproval holds human approval policy metadata for one action.
@@ -39,6 +46,16 @@ func (d ActionDefinition) RequiresApproval() bool {
 	return d.Approval.Required
 }
 
+// AllowedFor reports whether the principal may run the action.
+func (d ActionDefinition) AllowedFor(p access.Principal) bool {
+	return d.ReadOnly || p.CanWrite()
+}
+
+// AllowedInSandbox reports whether the action may run inside a transaction.Sandbox.
+func (d ActionDefinition) AllowedInSandbox() bool {
+	return d.ReadOnly || d.Sandboxed
+}
+
 // ActionField represents one action input field.
 type ActionField struct {
 	Type        string
@@ -73,11 +90,15 @@ type ActionRegistry interface {
 	// Execute runs the given action call and returns the resulting assistant message.
 	Execute(context.Context, ActionCall, []Message) Message
 	// GetDefinition returns one action definition by name.
-	GetDefinition(actionName string) (ActionDefinition, bool)
+	// The definition may depend on the tenant in ctx, for instance to describe its custom fields.
+	GetDefinition(ctx context.Context, actionName string) (ActionDefinition, bool)
 	// GetRenderer returns one deterministic action result renderer by action name when available.
 	GetRenderer(actionName string) (ActionResultRenderer, bool)
 	// StatusMessage returns a status message about the action execution, or a default message if not implemented.
 	StatusMessage(actionName string) string
+	// Prefetch speculatively starts the likely calls of the named actions for the turn messages
+	// while the model is still running. It returns immediately; unsupported actions are ignored.
+	Prefetch(ctx context.Context, actionNames []string, messages []Message)
 }
 
 // ActionResultRenderer converts a raw action result into a deterministic
@@ -0,0 +1,124 @@
+package assistant
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ActionResultStatus is the machine-readable outcome of an action call.
+type ActionResultStatus string
+
+const (
+	ActionResultStatus_Success ActionResultStatus = "success"
+	ActionResultStatus_Error   ActionResultStatus = "error"
+)
+
+// ActionResult is the envelope every action returns as the JSON content of its tool message,
+// so the model and clients can tell success from failure without parsing free text.
+type ActionResult struct {
+	Status     ActionResultStatus      `json:"status"`
+	Data       any                     `json:"data,omitempty"`
+	Error      *ActionResultError      `json:"error,omitempty"`
+	Pagination *ActionResultPagination `json:"pagination,omitempty"`
+	// Truncated maps each array of Data, or "data" for text, that was cut to fit the result size limit.
+	Truncated map[string]ActionResultTruncation `json:"truncated,omitempty"`
+}
+
+// ActionResultError describes why an action call failed.
+type ActionResultError struct {
+	Code    string `json:"code"`
+	Details string `json:"details"`
+	// Example holds valid arguments the model can follow when retrying.
+	Example string `json:"example,omitempty"`
+}
+
+// ActionResultPagination describes the page of a paginated action result.
+type ActionResultPagination struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+	// NextPage is nil on the last page.
+	NextPage *int `json:"next_page,omitempty"`
+}
+
+// ActionResultTruncation reports how much of a truncated array or text was kept:
+// items for arrays, bytes for text.
+type ActionResultTruncation struct {
+	Shown int `json:"shown"`
+	Total int `json:"total"`
+}
+
+// String serializes the result to JSON. A Data value that cannot be serialized
+// yields an error result instead.
+func (r ActionResult) String() string {
+	_, content := r.encode()
+	return content
+}
+
+// encode serializes the result, returning the result actually serialized with its JSON.
+func (r ActionResult) encode() (ActionResult, string) {
+	content, err := json.Marshal(r)
+	if err != nil {
+		r = ActionResult{
+			Status: ActionResultStatus_Error,
+			Error:  &ActionResultError{Code: "marshal_error", Details: err.Error()},
+		}
+		content, _ = json.Marshal(r)
+	}
+	return r, string(content)
+}
+
+// ParseActionResult decodes a tool message content holding an ActionResult envelope.
+// It returns false when the content is not an envelope, e.g. for legacy persisted messages.
+func ParseActionResult(content string) (ActionResult, bool) {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, "{") {
+		return ActionResult{}, false
+	}
+	var result ActionResult
+	if err := json.Unmarshal([]byte(content), &result); err != nil {
+		return ActionResult{}, false
+	}
+	switch {
+	case result.Status == ActionResultStatus_Success,
+		result.Status == ActionResultStatus_Error && result.Error != nil:
+		return result, true
+	default:
+		return ActionResult{}, false
+	}
+}
+
+// NewActionResultMessage builds the tool message of a successful action call carrying data.
+func NewActionResultMessage(call ActionCall, data any) Message {
+	return newActionResultMessage(call, ActionResult{Status: ActionResultStatus_Success, Data: data})
+}
+
+// NewPaginatedActionResultMessage builds the tool message of a successful action call carrying one page of data.
+func NewPaginatedActionResultMessage(call ActionCall, data any, pagination ActionResultPagination) Message {
+	return newActionResultMessage(call, ActionResult{
+		Status:     ActionResultStatus_Success,
+		Data:       data,
+		Pagination: &pagination,
+	})
+}
+
+// NewActionErrorMessage builds the tool message of a failed action call. The serialized
+// envelope is also set as the message ActionError.
+func NewActionErrorMessage(call ActionCall, code, details, example string) Message {
+	return newActionResultMessage(call, ActionResult{
+		Status: ActionResultStatus_Error,
+		Error:  &ActionResultError{Code: code, Details: details, Example: example},
+	})
+}
+
+func newActionResultMessage(call ActionCall, result ActionResult) Message {
+	result, content := result.encode()
+	message := Message{
+		Role:         ChatRole_Tool,
+		ActionCallID: &call.ID,
+		Content:      content,
+	}
+	if result.Status != ActionResultStatus_Success {
+		message.ActionError = &content
+	}
+	return message
+}
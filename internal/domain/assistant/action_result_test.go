@@ -0,0 +1,176 @@
+package assistant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionResult_String(t *testing.T) {
+	t.Parallel()
+
+	nextPage := 2
+	tests := map[string]struct {
+		result ActionResult
+		want   string
+	}{
+		"success-without-data": {
+			result: ActionResult{Status: ActionResultStatus_Success},
+			want:   `{"status":"success"}`,
+		},
+		"success-with-pagination": {
+			result: ActionResult{
+				Status:     ActionResultStatus_Success,
+				Data:       map[string]any{"todos": []string{"Buy milk"}},
+				Pagination: &ActionResultPagination{Page: 1, PageSize: 1, NextPage: &nextPage},
+			},
+			want: `{"status":"success","data":{"todos":["Buy milk"]},"pagination":{"page":1,"page_size":1,"next_page":2}}`,
+		},
+		"error-with-example": {
+			result: ActionResult{
+				Status: ActionResultStatus_Error,
+				Error:  &ActionResultError{Code: "invalid_arguments", Details: "page is required", Example: `{"page":1}`},
+			},
+			want: `{"status":"error","error":{"code":"invalid_arguments","details":"page is required","example":"{\"page\":1}"}}`,
+		},
+		"unserializable-data": {
+			result: ActionResult{Status: ActionResultStatus_Success, Data: func() {}},
+			want:   `{"status":"error","error":{"code":"marshal_error","details":"json: unsupported type: func()"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.result.String())
+		})
+	}
+}
+
+func TestParseActionResult(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content string
+		want    ActionResult
+		wantOK  bool
+	}{
+		"success": {
+			content: ` {"status":"success","data":"done"} `,
+			want:    ActionResult{Status: ActionResultStatus_Success, Data: "done"},
+			wantOK:  true,
+		},
+		"error": {
+			content: `{"status":"error","error":{"code":"not_found","details":"todo not found"}}`,
+			want: ActionResult{
+				Status: ActionResultStatus_Error,
+				Error:  &ActionResultError{Code: "not_found", Details: "todo not found"},
+			},
+			wantOK: true,
+		},
+		"error-without-details": {
+			content: `{"status":"error"}`,
+		},
+		"unknown-status": {
+			content: `{"status":"ok"}`,
+		},
+		"plain-text": {
+			content: "error: failed",
+		},
+		"json-array": {
+			content: `["success"]`,
+		},
+		"invalid-json": {
+			content: `{"status":`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := ParseActionResult(tt.content)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewActionResultMessages(t *testing.T) {
+	t.Parallel()
+
+	call := ActionCall{ID: "call-1", Name: "fetch_todos"}
+	errorContent := `{"status":"error","error":{"code":"invalid_arguments","details":"page is required","example":"{\"page\":1}"}}`
+
+	tests := map[string]struct {
+		message Message
+		want    Message
+	}{
+		"result": {
+			message: NewActionResultMessage(call, map[string]int{"count": 2}),
+			want:    Message{Role: ChatRole_Tool, ActionCallID: &call.ID, Content: `{"status":"success","data":{"count":2}}`},
+		},
+		"paginated-result": {
+			message: NewPaginatedActionResultMessage(call, []int{1}, ActionResultPagination{Page: 2, PageSize: 1}),
+			want:    Message{Role: ChatRole_Tool, ActionCallID: &call.ID, Content: `{"status":"success","data":[1],"pagination":{"page":2,"page_size":1}}`},
+		},
+		"error": {
+			message: NewActionErrorMessage(call, "invalid_arguments", "page is required", `{"page":1}`),
+			want:    Message{Role: ChatRole_Tool, ActionCallID: &call.ID, Content: errorContent, ActionError: &errorContent},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.message)
+		})
+	}
+}
+
+func TestMessage_IsActionCallSuccess(t *testing.T) {
+	t.Parallel()
+
+	callID := "call-1"
+	actionError := "failed"
+
+	tests := map[string]struct {
+		message Message
+		want    bool
+	}{
+		"success-envelope": {
+			message: Message{Role: ChatRole_Tool, ActionCallID: &callID, Content: `{"status":"success","data":"error in title"}`},
+			want:    true,
+		},
+		"error-envelope": {
+			message: Message{Role: ChatRole_Tool, ActionCallID: &callID, Content: `{"status":"error","error":{"code":"failed","details":"boom"}}`},
+			want:    false,
+		},
+		"success-envelope-with-action-error": {
+			message: Message{Role: ChatRole_Tool, ActionCallID: &callID, Content: `{"status":"success"}`, ActionError: &actionError},
+			want:    false,
+		},
+		"legacy-content": {
+			message: Message{Role: ChatRole_Tool, ActionCallID: &callID, Content: "error: none"},
+			want:    true,
+		},
+		"legacy-content-with-action-error": {
+			message: Message{Role: ChatRole_Tool, ActionCallID: &callID, Content: "failed", ActionError: &actionError},
+			want:    false,
+		},
+		"missing-action-call-id": {
+			message: Message{Role: ChatRole_Tool, Content: `{"status":"success"}`},
+			want:    false,
+		},
+		"not-a-tool-message": {
+			message: Message{Role: ChatRole_Assistant, ActionCallID: &callID, Content: `{"status":"success"}`},
+			want:    false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.message.IsActionCallSuccess())
+		})
+	}
+}
@@ -2,6 +2,26 @@ package assistant
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+const (
+	// maxStopSequences is the maximum number of stop sequences accepted for one turn.
+	maxStopSequences = 4
+	// maxStopSequenceChars is the maximum length of one stop sequence.
+	maxStopSequenceChars = 32
+	// minPenalty and maxPenalty bound presence and frequency penalties.
+	minPenalty = -2.0
+	maxPenalty = 2.0
+	// minTemperature and maxTemperature bound the sampling temperature.
+	minTemperature = 0.0
+	maxTemperature = 2.0
+	// maxTopP is the upper bound for nucleus sampling; top_p must also be greater than 0.
+	maxTopP = 1.0
 )
 
 // TurnRequest is the domain request for one assistant turn.
@@ -13,14 +33,164 @@ type TurnRequest struct {
 	Temperature      *float64
 	TopP             *float64
 	MaxTokens        *int
+	Stop             []string
+	PresencePenalty  *float64
 	FrequencyPenalty *float64
+	Seed             *int64
 	AvailableActions []ActionDefinition
+	// ResponseSchema optionally asks for a JSON object response. It is enforced through constrained
+	// decoding on models configured for it and ignored otherwise, so prompts must still describe the output.
+	ResponseSchema *ResponseSchema
+}
+
+// ResponseSchema describes the JSON object a constrained response must match.
+type ResponseSchema struct {
+	Name  string
+	Input ActionInput
+}
+
+// NewTextResponseSchema creates a ResponseSchema for an object holding one required string field.
+func NewTextResponseSchema(name, field, description string) *ResponseSchema {
+	return &ResponseSchema{
+		Name: name,
+		Input: ActionInput{
+			Type: "object",
+			Fields: map[string]ActionField{
+				field: {Type: "string", Description: description, Required: true},
+			},
+		},
+	}
+}
+
+// GenerationOptions holds client-supplied bounds and sampling overrides for one assistant response.
+type GenerationOptions struct {
+	MaxTokens        *int
+	Stop             []string
+	PresencePenalty  *float64
+	FrequencyPenalty *float64
+	Temperature      *float64
+	TopP             *float64
+	Seed             *int64
+}
+
+// IsZero reports whether no generation option was supplied.
+func (o GenerationOptions) IsZero() bool {
+	return o.MaxTokens == nil && len(o.Stop) == 0 && o.PresencePenalty == nil && o.FrequencyPenalty == nil &&
+		o.Temperature == nil && o.TopP == nil && o.Seed == nil
+}
+
+// Validate checks the options against the capabilities of the target model.
+// A model without a known output limit only gets the generic bounds checked.
+func (o GenerationOptions) Validate(model ModelCapabilities) error {
+	if o.MaxTokens != nil {
+		if *o.MaxTokens <= 0 {
+			return core.NewValidationErr("max_tokens must be greater than 0")
+		}
+		if model.MaxOutputTokens > 0 && *o.MaxTokens > model.MaxOutputTokens {
+			return core.NewValidationErr(fmt.Sprintf(
+				"max_tokens must be at most %d for model %s", model.MaxOutputTokens, model.ID,
+			))
+		}
+	}
+
+	if len(o.Stop) > maxStopSequences {
+		return core.NewValidationErr(fmt.Sprintf("stop accepts at most %d sequences", maxStopSequences))
+	}
+	for _, stop := range o.Stop {
+		if stop == "" {
+			return core.NewValidationErr("stop sequences cannot be empty")
+		}
+		if len([]rune(stop)) > maxStopSequenceChars {
+			return core.NewValidationErr(fmt.Sprintf("stop sequences must be at most %d characters", maxStopSequenceChars))
+		}
+	}
+
+	if err := validatePenalty("presence_penalty", o.PresencePenalty); err != nil {
+		return err
+	}
+	if err := validatePenalty("frequency_penalty", o.FrequencyPenalty); err != nil {
+		return err
+	}
+	if err := validateTemperature(o.Temperature); err != nil {
+		return err
+	}
+	if err := validateTopP(o.TopP); err != nil {
+		return err
+	}
+	if o.Seed != nil && *o.Seed < 0 {
+		return core.NewValidationErr("seed must not be negative")
+	}
+	return nil
+}
+
+// ApplyTo copies the supplied options onto the turn request, keeping request defaults for unset ones.
+func (o GenerationOptions) ApplyTo(req *TurnRequest) {
+	if o.MaxTokens != nil {
+		req.MaxTokens = o.MaxTokens
+	}
+	if len(o.Stop) > 0 {
+		req.Stop = o.Stop
+	}
+	if o.PresencePenalty != nil {
+		req.PresencePenalty = o.PresencePenalty
+	}
+	if o.FrequencyPenalty != nil {
+		req.FrequencyPenalty = o.FrequencyPenalty
+	}
+	if o.Temperature != nil {
+		req.Temperature = o.Temperature
+	}
+	if o.TopP != nil {
+		req.TopP = o.TopP
+	}
+	if o.Seed != nil {
+		req.Seed = o.Seed
+	}
+}
+
+// validatePenalty checks that an optional penalty lies within the accepted range.
+func validatePenalty(name string, penalty *float64) error {
+	if penalty == nil || (*penalty >= minPenalty && *penalty <= maxPenalty) {
+		return nil
+	}
+	return core.NewValidationErr(fmt.Sprintf("%s must be between %.0f and %.0f", name, minPenalty, maxPenalty))
+}
+
+// validateTemperature checks that an optional sampling temperature lies within the accepted range.
+func validateTemperature(temperature *float64) error {
+	if temperature == nil || (*temperature >= minTemperature && *temperature <= maxTemperature) {
+		return nil
+	}
+	return core.NewValidationErr(fmt.Sprintf("temperature must be between %.0f and %.0f", minTemperature, maxTemperature))
+}
+
+// validateTopP checks that an optional nucleus sampling value lies within the accepted range.
+func validateTopP(topP *float64) error {
+	if topP == nil || (*topP > 0 && *topP <= maxTopP) {
+		return nil
+	}
+	return core.NewValidationErr("top_p must be greater than 0 and at most 1")
 }
 
 // TurnResponse contains the final assistant message and usage for non-stream mode.
 type TurnResponse struct {
 	Content string
-	Usage   Usage
+	// ActionCalls lists the actions the assistant chose. They are returned as is and never executed.
+	ActionCalls []ActionCall
+	Usage       Usage
+}
+
+// TextField returns the named string field when the content is a JSON object, as produced for
+// a ResponseSchema request by a model with constrained decoding, and the raw content otherwise.
+func (r TurnResponse) TextField(field string) string {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(strings.TrimSpace(r.Content)), &fields); err != nil {
+		return r.Content
+	}
+	if value, ok := fields[field].(string); ok {
+		return value
+	}
+	return r.Content
 }
 
 // Assistant defines assistant interaction in domain terms.
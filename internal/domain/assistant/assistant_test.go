@@ -3,6 +3,9 @@ package assistant
 import (
 	"testing"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,3 +66,198 @@ func TestActionDefinition_RequiresApproval(t *testing.T) {
 		})
 	}
 }
+
+func TestActionDefinition_AllowedFor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		definition ActionDefinition
+		principal  access.Principal
+		want       bool
+	}{
+		"read-only-action-readonly-principal": {
+			definition: ActionDefinition{ReadOnly: true},
+			principal:  access.Principal{Name: "viewer", Role: access.RoleReadonly},
+			want:       true,
+		},
+		"write-action-readonly-principal": {
+			definition: ActionDefinition{},
+			principal:  access.Principal{Name: "viewer", Role: access.RoleReadonly},
+			want:       false,
+		},
+		"write-action-member-principal": {
+			definition: ActionDefinition{},
+			principal:  access.Principal{Name: "dev", Role: access.RoleMember},
+			want:       true,
+		},
+		"write-action-system": {
+			definition: ActionDefinition{},
+			principal:  access.System,
+			want:       true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.definition.AllowedFor(tt.principal))
+		})
+	}
+}
+
+func TestGenerationOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	model := ModelCapabilities{ID: "qwen3", MaxOutputTokens: 1024}
+
+	tests := map[string]struct {
+		options GenerationOptions
+		model   ModelCapabilities
+		wantErr error
+	}{
+		"zero-value": {
+			model: model,
+		},
+		"within-limits": {
+			options: GenerationOptions{
+				MaxTokens:        common.Ptr(256),
+				Stop:             []string{"\n\n", "END"},
+				PresencePenalty:  common.Ptr(-2.0),
+				FrequencyPenalty: common.Ptr(2.0),
+				Temperature:      common.Ptr(2.0),
+				TopP:             common.Ptr(1.0),
+				Seed:             common.Ptr(int64(0)),
+			},
+			model: model,
+		},
+		"unknown-model-limit": {
+			options: GenerationOptions{MaxTokens: common.Ptr(100000)},
+			model:   ModelCapabilities{ID: "qwen3"},
+		},
+		"max-tokens-not-positive": {
+			options: GenerationOptions{MaxTokens: common.Ptr(0)},
+			model:   model,
+			wantErr: core.NewValidationErr("max_tokens must be greater than 0"),
+		},
+		"max-tokens-above-model-limit": {
+			options: GenerationOptions{MaxTokens: common.Ptr(2048)},
+			model:   model,
+			wantErr: core.NewValidationErr("max_tokens must be at most 1024 for model qwen3"),
+		},
+		"too-many-stop-sequences": {
+			options: GenerationOptions{Stop: []string{"a", "b", "c", "d", "e"}},
+			model:   model,
+			wantErr: core.NewValidationErr("stop accepts at most 4 sequences"),
+		},
+		"empty-stop-sequence": {
+			options: GenerationOptions{Stop: []string{""}},
+			model:   model,
+			wantErr: core.NewValidationErr("stop sequences cannot be empty"),
+		},
+		"stop-sequence-too-long": {
+			options: GenerationOptions{Stop: []string{"this stop sequence is far too long"}},
+			model:   model,
+			wantErr: core.NewValidationErr("stop sequences must be at most 32 characters"),
+		},
+		"presence-penalty-out-of-range": {
+			options: GenerationOptions{PresencePenalty: common.Ptr(2.5)},
+			model:   model,
+			wantErr: core.NewValidationErr("presence_penalty must be between -2 and 2"),
+		},
+		"frequency-penalty-out-of-range": {
+			options: GenerationOptions{FrequencyPenalty: common.Ptr(-3.0)},
+			model:   model,
+			wantErr: core.NewValidationErr("frequency_penalty must be between -2 and 2"),
+		},
+		"temperature-out-of-range": {
+			options: GenerationOptions{Temperature: common.Ptr(2.5)},
+			model:   model,
+			wantErr: core.NewValidationErr("temperature must be between 0 and 2"),
+		},
+		"top-p-out-of-range": {
+			options: GenerationOptions{TopP: common.Ptr(0.0)},
+			model:   model,
+			wantErr: core.NewValidationErr("top_p must be greater than 0 and at most 1"),
+		},
+		"negative-seed": {
+			options: GenerationOptions{Seed: common.Ptr(int64(-1))},
+			model:   model,
+			wantErr: core.NewValidationErr("seed must not be negative"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.options.Validate(tt.model)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestGenerationOptions_ApplyTo(t *testing.T) {
+	t.Parallel()
+
+	req := TurnRequest{
+		Model:            "qwen3",
+		Temperature:      common.Ptr(0.2),
+		FrequencyPenalty: common.Ptr(0.1),
+	}
+
+	GenerationOptions{
+		MaxTokens:       common.Ptr(128),
+		Stop:            []string{"END"},
+		PresencePenalty: common.Ptr(0.5),
+		TopP:            common.Ptr(0.95),
+		Seed:            common.Ptr(int64(7)),
+	}.ApplyTo(&req)
+
+	assert.Equal(t, TurnRequest{
+		Model:            "qwen3",
+		Temperature:      common.Ptr(0.2),
+		TopP:             common.Ptr(0.95),
+		MaxTokens:        common.Ptr(128),
+		Stop:             []string{"END"},
+		PresencePenalty:  common.Ptr(0.5),
+		FrequencyPenalty: common.Ptr(0.1),
+		Seed:             common.Ptr(int64(7)),
+	}, req)
+	assert.True(t, GenerationOptions{}.IsZero())
+	assert.False(t, GenerationOptions{Seed: common.Ptr(int64(7))}.IsZero())
+}
+
+func TestTurnResponse_TextField(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content string
+		want    string
+	}{
+		"json-object": {
+			content: ` {"title": "Weekly groceries"} `,
+			want:    "Weekly groceries",
+		},
+		"plain-text": {
+			content: "Weekly groceries",
+			want:    "Weekly groceries",
+		},
+		"json-without-field": {
+			content: `{"name": "Weekly groceries"}`,
+			want:    `{"name": "Weekly groceries"}`,
+		},
+		"json-non-string-field": {
+			content: `{"title": 42}`,
+			want:    `{"title": 42}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TurnResponse{Content: tt.content}.TextField("title"))
+		})
+	}
+}
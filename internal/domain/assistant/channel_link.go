@@ -0,0 +1,35 @@
+package assistant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Channel identifies an external messaging channel that fronts the assistant chat.
+type Channel string
+
+const (
+	// Channel_Telegram is the Telegram bot channel.
+	Channel_Telegram Channel = "telegram"
+)
+
+// ChannelLink binds a chat of an external messaging channel to the conversation its messages continue.
+type ChannelLink struct {
+	Channel        Channel
+	ExternalChatID string
+	ConversationID uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ChannelLinkRepository defines the interface for storing and retrieving channel links.
+type ChannelLinkRepository interface {
+	// GetChannelLink retrieves the link of an external chat.
+	GetChannelLink(ctx context.Context, channel Channel, externalChatID string) (ChannelLink, bool, error)
+	// SaveChannelLink creates the link of an external chat or moves it to another conversation.
+	SaveChannelLink(ctx context.Context, link ChannelLink) error
+	// DeleteChannelLink removes the link of an external chat so its next message starts a new conversation.
+	DeleteChannelLink(ctx context.Context, channel Channel, externalChatID string) error
+}
@@ -31,6 +31,11 @@ const (
 	ChatMessageState_Completed ChatMessageState = "COMPLETED"
 	// ChatMessageState_Failed indicates message generation failed.
 	ChatMessageState_Failed ChatMessageState = "FAILED"
+	// ChatMessageState_Moderated indicates a user message was blocked by content moderation and kept for audit only.
+	ChatMessageState_Moderated ChatMessageState = "MODERATED"
+	// ChatMessageState_Streaming indicates an assistant message is still being generated and its content is the
+	// latest partial checkpoint. A message left in this state after its turn ended was interrupted by a crash.
+	ChatMessageState_Streaming ChatMessageState = "STREAMING"
 )
 
 // ChatMessageApprovalStatus represents the approval lifecycle status for a tool call message.
@@ -51,12 +56,15 @@ const (
 
 // ChatMessage represents an AI chat message in a conversation
 type ChatMessage struct {
-	ID                     uuid.UUID
-	ConversationID         uuid.UUID
-	TurnID                 uuid.UUID
-	TurnSequence           int64
-	ChatRole               ChatRole
-	Content                string
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	TurnID         uuid.UUID
+	TurnSequence   int64
+	ChatRole       ChatRole
+	Content        string
+	// ContentRef is the hash of the content blob holding the content of an action result too large to
+	// store inline. Content then keeps only a preview of it.
+	ContentRef             *string
 	ActionCallID           *string
 	ActionCalls            []ActionCall
 	Model                  string
@@ -72,17 +80,30 @@ type ChatMessage struct {
 	CompletionTokens       int
 	TotalTokens            int
 	ContextTokensEstimate  int
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	// Experiment records the experiment variant the message was produced under, if any.
+	Experiment *ExperimentAssignment
+	// ActionLoopDetected marks the final assistant message of a turn stopped by the action loop guards.
+	ActionLoopDetected bool
+	// FeedbackScore is the user rating of an assistant response: 1 for helpful, -1 for unhelpful.
+	FeedbackScore *int
+	// Seed is the sampling seed the assistant message was generated with, if the turn had one.
+	Seed *int64
+	// SupersededAt is set when the message was replaced by a regenerated response of its turn.
+	// Superseded messages stay retrievable but never reach the model again.
+	SupersededAt *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 // ChatMessageActionDetail summarizes one assistant action call for chat-history projections.
 type ChatMessageActionDetail struct {
-	ActionCallID           string
-	Name                   string
-	Input                  string
-	Text                   string
-	Output                 string
+	ActionCallID string
+	Name         string
+	Input        string
+	Text         string
+	Output       string
+	// OutputRef is the hash of the content blob holding the whole output when Output is only a preview.
+	OutputRef              *string
 	MessageState           ChatMessageState
 	ErrorMessage           *string
 	ApprovalStatus         *ChatMessageApprovalStatus
@@ -99,6 +120,39 @@ func (m ChatMessage) IsActionCallSuccess() bool {
 		m.MessageState == ChatMessageState_Completed
 }
 
+// IsModerated returns true when the message was blocked by content moderation and must not reach the model.
+func (m ChatMessage) IsModerated() bool {
+	return m.MessageState == ChatMessageState_Moderated
+}
+
+// IsStreaming returns true when the message holds the partial content of a response still being generated,
+// or of one interrupted by a crash.
+func (m ChatMessage) IsStreaming() bool {
+	return m.MessageState == ChatMessageState_Streaming
+}
+
+// IsSuperseded returns true when a regenerated response of the turn, or an edit of an earlier user message,
+// replaced the message.
+func (m ChatMessage) IsSuperseded() bool {
+	return m.SupersededAt != nil
+}
+
+// NewBackgroundMessage returns a completed assistant message that background work, such as the weekly review,
+// posts into a conversation. It opens a turn of its own, since no user message prompts it.
+func NewBackgroundMessage(conversationID uuid.UUID, content string, model string, now time.Time) ChatMessage {
+	return ChatMessage{
+		ID:             uuid.New(),
+		ConversationID: conversationID,
+		TurnID:         uuid.New(),
+		ChatRole:       ChatRole_Assistant,
+		Content:        content,
+		Model:          model,
+		MessageState:   ChatMessageState_Completed,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
 // IsApprovalPending returns true when the message is waiting for a human approval decision.
 func (m ChatMessage) IsApprovalPending() bool {
 	return m.ApprovalStatus != nil && *m.ApprovalStatus == ChatMessageApprovalStatus_Pending
@@ -106,7 +160,9 @@ func (m ChatMessage) IsApprovalPending() bool {
 
 // ListChatMessagesParams defines optional filters for listing chat messages.
 type ListChatMessagesParams struct {
-	AfterMessageID *uuid.UUID
+	AfterMessageID  *uuid.UUID
+	BeforeMessageID *uuid.UUID
+	TurnID          *uuid.UUID
 }
 
 // ListChatMessagesOption configures optional filters for listing chat messages.
@@ -119,9 +175,24 @@ func WithChatMessagesAfterMessageID(messageID uuid.UUID) ListChatMessagesOption
 	}
 }
 
+// WithChatMessagesBeforeMessageID filters the query to return messages before a checkpoint message ID.
+func WithChatMessagesBeforeMessageID(messageID uuid.UUID) ListChatMessagesOption {
+	return func(options *ListChatMessagesParams) {
+		options.BeforeMessageID = &messageID
+	}
+}
+
+// WithChatMessagesTurnID filters the query to return the messages of one turn.
+func WithChatMessagesTurnID(turnID uuid.UUID) ListChatMessagesOption {
+	return func(options *ListChatMessagesParams) {
+		options.TurnID = &turnID
+	}
+}
+
 // ChatMessageRepository defines the interface for chat message persistence
 type ChatMessageRepository interface {
-	// CreateChatMessages persists chat messages for a conversation
+	// CreateChatMessages persists chat messages for a conversation. A message with the ID of a persisted one
+	// replaces it, so streaming checkpoints are finalized in place.
 	CreateChatMessages(ctx context.Context, messages []ChatMessage) error
 
 	// ListChatMessages retrieves paginated chat messages for a conversation, with optional filters.
@@ -132,4 +203,11 @@ type ChatMessageRepository interface {
 
 	// DeleteConversationMessages removes all messages for a conversation.
 	DeleteConversationMessages(ctx context.Context, conversationID uuid.UUID) error
+
+	// UpdateChatMessageFeedback sets the feedback score of an assistant message.
+	// It reports false when no assistant message has the ID.
+	UpdateChatMessageFeedback(ctx context.Context, messageID uuid.UUID, score int) (bool, error)
+
+	// SupersedeChatMessages marks specific chat messages by ID as superseded at the given time.
+	SupersedeChatMessages(ctx context.Context, messageIDs []uuid.UUID, supersededAt time.Time) error
 }
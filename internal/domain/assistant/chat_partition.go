@@ -0,0 +1,26 @@
+package assistant
+
+import (
+	"context"
+	"time"
+)
+
+// ChatMessagePartitionRepository manages the monthly partitions the chat messages of all tenants are stored
+// in, by the UTC month of their creation time.
+type ChatMessagePartitionRepository interface {
+	// EnsureChatMessagePartitions creates the missing partitions of the months from the month of from
+	// through the month of to.
+	EnsureChatMessagePartitions(ctx context.Context, from time.Time, to time.Time) error
+
+	// DropChatMessagePartitionsBefore drops the partitions of the months ending at or before before, with
+	// their messages, and deletes the content blobs no remaining message references. It returns the first
+	// day of the months dropped.
+	DropChatMessagePartitionsBefore(ctx context.Context, before time.Time) ([]time.Time, error)
+}
+
+// ChatMessagePartitionMonth returns the first instant of the UTC month of t, which starts the partition
+// of the chat messages created at t.
+func ChatMessagePartitionMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
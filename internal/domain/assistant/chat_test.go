@@ -2,7 +2,9 @@ package assistant
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -114,3 +116,82 @@ func TestChatMessage_IsApprovalPending(t *testing.T) {
 		})
 	}
 }
+
+func TestChatMessage_IsModerated(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		message ChatMessage
+		want    bool
+	}{
+		"moderated": {
+			message: ChatMessage{MessageState: ChatMessageState_Moderated},
+			want:    true,
+		},
+		"completed": {
+			message: ChatMessage{MessageState: ChatMessageState_Completed},
+			want:    false,
+		},
+		"empty-state": {
+			message: ChatMessage{},
+			want:    false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.message.IsModerated()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChatMessage_IsSuperseded(t *testing.T) {
+	t.Parallel()
+
+	supersededAt := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		message ChatMessage
+		want    bool
+	}{
+		"superseded": {
+			message: ChatMessage{SupersededAt: &supersededAt},
+			want:    true,
+		},
+		"current": {
+			message: ChatMessage{},
+			want:    false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.message.IsSuperseded()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewBackgroundMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	got := NewBackgroundMessage(conversationID, "Your week in review", "review-model", now)
+
+	assert.NotEqual(t, uuid.Nil, got.ID)
+	assert.NotEqual(t, uuid.Nil, got.TurnID)
+	assert.NotEqual(t, got.ID, got.TurnID)
+	assert.Equal(t, ChatMessage{
+		ID:             got.ID,
+		ConversationID: conversationID,
+		TurnID:         got.TurnID,
+		ChatRole:       ChatRole_Assistant,
+		Content:        "Your week in review",
+		Model:          "review-model",
+		MessageState:   ChatMessageState_Completed,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, got)
+}
@@ -0,0 +1,77 @@
+package assistant
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentBlob is a large message content, such as the result of an action, stored once under the hash
+// of its content instead of inline in the chat messages that carry it.
+type ContentBlob struct {
+	// Hash is the hex-encoded SHA-256 hash of Content.
+	Hash      string
+	Content   string
+	SizeBytes int
+	CreatedAt time.Time
+}
+
+// NewContentBlob creates the blob holding content.
+func NewContentBlob(content string, createdAt time.Time) ContentBlob {
+	sum := sha256.Sum256([]byte(content))
+	return ContentBlob{
+		Hash:      hex.EncodeToString(sum[:]),
+		Content:   content,
+		SizeBytes: len(content),
+		CreatedAt: createdAt,
+	}
+}
+
+// IsContentBlobHash reports whether hash is a hex-encoded SHA-256 hash, as used to reference content blobs.
+func IsContentBlobHash(hash string) bool {
+	if len(hash) != sha256.Size*2 || strings.ToLower(hash) != hash {
+		return false
+	}
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
+
+// Excerpt returns the content cut to at most maxBytes, preferring the last complete line, with a trailing
+// marker telling how much was kept. The whole content is returned when it fits or maxBytes is not positive,
+// and the marker alone when it leaves no room for content.
+func (b ContentBlob) Excerpt(maxBytes int) string {
+	if maxBytes <= 0 || len(b.Content) <= maxBytes {
+		return b.Content
+	}
+
+	cut := max(maxBytes-len(b.excerptMarker(maxBytes))-1, 0)
+	for cut > 0 && !utf8.RuneStart(b.Content[cut]) {
+		cut--
+	}
+	kept := b.Content[:cut]
+	if newline := strings.LastIndexByte(kept, '\n'); newline > 0 {
+		kept = kept[:newline]
+	}
+	if kept == "" {
+		return b.excerptMarker(0)
+	}
+	return kept + "\n" + b.excerptMarker(len(kept))
+}
+
+func (b ContentBlob) excerptMarker(kept int) string {
+	return fmt.Sprintf("... truncated: showing %d of %d bytes of content blob %s", kept, b.SizeBytes, b.Hash)
+}
+
+// ContentBlobRepository defines the interface for storing and retrieving content blobs.
+type ContentBlobRepository interface {
+	// SaveContentBlob stores a blob, keeping the stored one when a blob with the same hash exists.
+	SaveContentBlob(ctx context.Context, blob ContentBlob) error
+	// ListContentBlobs returns the stored blobs with the given hashes. Unknown hashes are skipped.
+	ListContentBlobs(ctx context.Context, hashes []string) ([]ContentBlob, error)
+	// DeleteUnreferencedContentBlobs deletes the blobs no chat message references anymore.
+	DeleteUnreferencedContentBlobs(ctx context.Context) error
+}
@@ -0,0 +1,90 @@
+package assistant
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewContentBlob(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+	blob := NewContentBlob("hello", createdAt)
+
+	assert.Equal(t, ContentBlob{
+		Hash:      "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		Content:   "hello",
+		SizeBytes: 5,
+		CreatedAt: createdAt,
+	}, blob)
+	assert.True(t, IsContentBlobHash(blob.Hash))
+}
+
+func TestIsContentBlobHash(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hash string
+		want bool
+	}{
+		"sha256-hex": {
+			hash: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			want: true,
+		},
+		"uppercase": {
+			hash: "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824",
+		},
+		"too-short": {
+			hash: "2cf24dba",
+		},
+		"not-hex": {
+			hash: strings.Repeat("z", 64),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, IsContentBlobHash(tt.hash))
+		})
+	}
+}
+
+func TestContentBlob_Excerpt(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Repeat("0123456789\n", 10)
+	blob := ContentBlob{Hash: "abc", Content: content, SizeBytes: len(content)}
+
+	tests := map[string]struct {
+		maxBytes int
+		want     string
+	}{
+		"fits": {
+			maxBytes: len(content),
+			want:     content,
+		},
+		"unlimited": {
+			maxBytes: 0,
+			want:     content,
+		},
+		"no-room-for-content": {
+			maxBytes: 20,
+			want:     "... truncated: showing 0 of 110 bytes of content blob abc",
+		},
+		"cut-at-line": {
+			maxBytes: 80,
+			want:     "0123456789\n... truncated: showing 10 of 110 bytes of content blob abc",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, blob.Excerpt(tt.maxBytes))
+		})
+	}
+}
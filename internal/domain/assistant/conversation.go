@@ -51,11 +51,50 @@ type Conversation struct {
 	ID            uuid.UUID
 	Title         string
 	TitleSource   ConversationTitleSource
+	Settings      ConversationSettings
 	LastMessageAt *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// ConversationSettings holds optional generation settings persisted for one conversation.
+// Unset fields fall back to the chat defaults.
+type ConversationSettings struct {
+	Temperature *float64
+	TopP        *float64
+	// Model overrides the model requested by the client when set.
+	Model string
+}
+
+// IsZero reports whether no conversation setting is set.
+func (s ConversationSettings) IsZero() bool {
+	return s.Temperature == nil && s.TopP == nil && s.Model == ""
+}
+
+// Validate checks the settings against the ranges accepted by the model providers.
+func (s ConversationSettings) Validate() error {
+	if err := validateTemperature(s.Temperature); err != nil {
+		return err
+	}
+	if err := validateTopP(s.TopP); err != nil {
+		return err
+	}
+	if strings.TrimSpace(s.Model) != s.Model {
+		return core.NewValidationErr("model must not have leading or trailing spaces")
+	}
+	return nil
+}
+
+// ApplyTo copies the set temperature and top_p onto req.
+func (s ConversationSettings) ApplyTo(req *TurnRequest) {
+	if s.Temperature != nil {
+		req.Temperature = s.Temperature
+	}
+	if s.TopP != nil {
+		req.TopP = s.TopP
+	}
+}
+
 // Validate checks if the conversation has valid data.
 func (c Conversation) Validate() error {
 	if c.Title == "" {
@@ -0,0 +1,36 @@
+package assistant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConversationChangeType identifies the kind of conversation change recorded in the change log.
+type ConversationChangeType string
+
+const (
+	// ConversationChangeType_Created indicates a conversation was created.
+	ConversationChangeType_Created ConversationChangeType = "CREATED"
+	// ConversationChangeType_Updated indicates a conversation was renamed or received new messages.
+	ConversationChangeType_Updated ConversationChangeType = "UPDATED"
+	// ConversationChangeType_Deleted indicates a conversation was deleted.
+	ConversationChangeType_Deleted ConversationChangeType = "DELETED"
+)
+
+// ConversationChange is an entry of the conversation change log.
+// Sequence increases monotonically across all conversation changes.
+type ConversationChange struct {
+	Sequence       int64
+	ConversationID uuid.UUID
+	Type           ConversationChangeType
+	CreatedAt      time.Time
+}
+
+// ConversationChangeRepository defines the interface for reading the conversation change log.
+// Changes are appended by the ConversationRepository whenever a conversation is written.
+type ConversationChangeRepository interface {
+	// ListConversationChangesSince lists up to limit changes recorded after the since sequence, oldest first.
+	ListConversationChangesSince(ctx context.Context, since int64, limit int) ([]ConversationChange, bool, error)
+}
@@ -0,0 +1,23 @@
+package assistant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConversationReadCursorRepository defines the interface for the read cursors readers keep on conversations.
+// A reader is the access subject of the principal reading the conversation. The messages a reader has not read
+// are the completed assistant messages, not superseded, created after the last message the reader marked read
+// and posted without a user message prompting them, such as the ones of background work. Replies to a user message
+// never count, since whoever asked follows the turn.
+type ConversationReadCursorRepository interface {
+	// MarkConversationRead moves the read cursor of the reader on a conversation to the given message, or to the
+	// latest message of the conversation when messageID is nil. The cursor never moves back to an older message.
+	// It reports false when the message is not in the conversation, or the conversation has no messages.
+	MarkConversationRead(ctx context.Context, conversationID uuid.UUID, reader string, messageID *uuid.UUID, readAt time.Time) (bool, error)
+	// CountUnreadMessages returns how many messages of each given conversation the reader has not read,
+	// keyed by conversation ID. Conversations without unread messages are missing from the map.
+	CountUnreadMessages(ctx context.Context, reader string, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+}
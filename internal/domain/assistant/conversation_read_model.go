@@ -0,0 +1,40 @@
+package assistant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConversationReadModel is the denormalized projection of a conversation served by the conversation list.
+// It is refreshed asynchronously from the chat message events, so it may trail the conversation for a while.
+type ConversationReadModel struct {
+	ConversationID uuid.UUID
+	// LastMessageAt is the last message time of the conversation when the projection was refreshed.
+	LastMessageAt     *time.Time
+	ContextTokensUsed int64
+	ProjectedAt       time.Time
+}
+
+// IsCurrent reports whether the projection already reflects the last message of the conversation.
+// A projection that trails the conversation must not be served, since its aggregates miss the newer messages.
+func (m ConversationReadModel) IsCurrent(conversation Conversation) bool {
+	if m.ConversationID != conversation.ID {
+		return false
+	}
+	if m.LastMessageAt == nil || conversation.LastMessageAt == nil {
+		return m.LastMessageAt == nil && conversation.LastMessageAt == nil
+	}
+	return !m.LastMessageAt.Before(*conversation.LastMessageAt)
+}
+
+// ConversationReadModelRepository defines the interface for the conversation read model.
+type ConversationReadModelRepository interface {
+	// RefreshConversationReadModel recomputes the projection of a conversation from its current state as of
+	// projectedAt. Conversations that no longer exist are skipped.
+	RefreshConversationReadModel(ctx context.Context, conversationID uuid.UUID, projectedAt time.Time) error
+	// ListConversationReadModels returns the projections of the given conversations keyed by conversation ID.
+	// Conversations not projected yet are missing from the map.
+	ListConversationReadModels(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]ConversationReadModel, error)
+}
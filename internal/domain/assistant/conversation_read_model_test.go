@@ -0,0 +1,45 @@
+package assistant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationReadModel_IsCurrent(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.New()
+	earlier := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Minute)
+
+	tests := map[string]struct {
+		projected *time.Time
+		actual    *time.Time
+		otherID   bool
+		want      bool
+	}{
+		"same-last-message":         {projected: &later, actual: &later, want: true},
+		"projection-trails":         {projected: &earlier, actual: &later, want: false},
+		"projection-ahead":          {projected: &later, actual: &earlier, want: true},
+		"no-messages-yet":           {want: true},
+		"first-message-not-seen":    {actual: &later, want: false},
+		"other-conversation":        {projected: &later, actual: &later, otherID: true, want: false},
+		"conversation-lost-recency": {projected: &later, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			readModel := ConversationReadModel{ConversationID: conversationID, LastMessageAt: tt.projected}
+			conversation := Conversation{ID: conversationID, LastMessageAt: tt.actual}
+			if tt.otherID {
+				conversation.ID = uuid.New()
+			}
+			assert.Equal(t, tt.want, readModel.IsCurrent(conversation))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// openLoopPrefix marks compacted context lines that carry unresolved work forward.
+const openLoopPrefix = "carry:"
+
+// ConversationSnapshot is a compacted representation of a conversation persisted every few turns.
+// The chat prompt is rebuilt from the latest snapshot plus the messages recorded after LastMessageID.
+type ConversationSnapshot struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	Summary        string
+	PinnedTodos    []PinnedTodo
+	OpenLoops      []string
+	LastMessageID  uuid.UUID
+	// ChangeSequence is the latest conversation todo change sequence folded into the pinned todos.
+	ChangeSequence int64
+	CreatedAt      time.Time
+}
+
+// PinnedTodo is a todo the conversation worked on, kept in snapshots so it survives compaction.
+type PinnedTodo struct {
+	ID      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	Status  string    `json:"status"`
+	DueDate string    `json:"due_date"`
+}
+
+// PromptContext renders the snapshot as compacted context for the chat prompt.
+func (cs ConversationSnapshot) PromptContext() string {
+	summary := strings.TrimSpace(cs.Summary)
+	if summary == "" {
+		summary = DefaultConversationStateSummary
+	}
+
+	var builder strings.Builder
+	builder.WriteString(summary)
+
+	if len(cs.PinnedTodos) > 0 {
+		builder.WriteString("\n\nPinned todos:")
+		for _, pinned := range cs.PinnedTodos {
+			fmt.Fprintf(&builder, "\n- %s | %s | due %s | id %s", pinned.Title, pinned.Status, pinned.DueDate, pinned.ID)
+		}
+	}
+
+	if len(cs.OpenLoops) > 0 {
+		builder.WriteString("\n\nOpen loops:")
+		for _, loop := range cs.OpenLoops {
+			builder.WriteString("\n- ")
+			builder.WriteString(loop)
+		}
+	}
+
+	return builder.String()
+}
+
+// ExtractOpenLoops returns the unresolved work carried by the `carry:` lines of a compacted summary.
+func ExtractOpenLoops(summary string) []string {
+	loops := []string{}
+	for line := range strings.SplitSeq(summary, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(strings.ToLower(line), openLoopPrefix) {
+			continue
+		}
+		if loop := strings.TrimSpace(line[len(openLoopPrefix):]); loop != "" {
+			loops = append(loops, loop)
+		}
+	}
+	return loops
+}
+
+// ConversationSnapshotRepository defines the interface for storing and retrieving conversation snapshots.
+type ConversationSnapshotRepository interface {
+	// StoreConversationSnapshot persists a new snapshot for a conversation.
+	StoreConversationSnapshot(ctx context.Context, snapshot ConversationSnapshot) error
+	// GetLatestConversationSnapshot retrieves the most recent snapshot for the given conversation.
+	GetLatestConversationSnapshot(ctx context.Context, conversationID uuid.UUID) (ConversationSnapshot, bool, error)
+	// DeleteConversationSnapshots deletes all snapshots of a conversation.
+	DeleteConversationSnapshots(ctx context.Context, conversationID uuid.UUID) error
+}
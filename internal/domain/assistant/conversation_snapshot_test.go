@@ -0,0 +1,66 @@
+package assistant
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationSnapshot_PromptContext(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+
+	tests := map[string]struct {
+		snapshot ConversationSnapshot
+		want     string
+	}{
+		"uses-default-summary-when-empty": {
+			snapshot: ConversationSnapshot{Summary: "  "},
+			want:     DefaultConversationStateSummary,
+		},
+		"renders-summary-pinned-todos-and-open-loops": {
+			snapshot: ConversationSnapshot{
+				Summary: "memory: dinner planning",
+				PinnedTodos: []PinnedTodo{
+					{ID: todoID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+				},
+				OpenLoops: []string{"confirm guest list"},
+			},
+			want: "memory: dinner planning\n\n" +
+				"Pinned todos:\n- Buy wine | OPEN | due 2026-02-20 | id 223e4567-e89b-12d3-a456-426614174001\n\n" +
+				"Open loops:\n- confirm guest list",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.snapshot.PromptContext())
+		})
+	}
+}
+
+func TestExtractOpenLoops(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		summary string
+		want    []string
+	}{
+		"no-carry-lines": {
+			summary: "memory: dinner planning\nuser: list todos",
+			want:    []string{},
+		},
+		"extracts-carry-lines": {
+			summary: "memory: dinner planning\ncarry: confirm guest list\n  Carry:  book table  \ncarry:   ",
+			want:    []string{"confirm guest list", "book table"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExtractOpenLoops(tt.summary))
+		})
+	}
+}
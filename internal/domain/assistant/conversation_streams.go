@@ -0,0 +1,29 @@
+package assistant
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ConversationStreams routes out-of-band events into conversation streams that are currently open.
+type ConversationStreams interface {
+	// Attach registers the event callback of an open stream and returns a function that detaches it.
+	Attach(conversationID uuid.UUID, onEvent EventCallback) (detach func())
+	// Emit sends an event to the open stream of a conversation. Returns false when no stream is open.
+	Emit(ctx context.Context, conversationID uuid.UUID, eventType EventType, data any) (bool, error)
+}
+
+// conversationIDKey is the context key used to carry the active conversation ID.
+type conversationIDKey struct{}
+
+// WithConversationID returns a copy of ctx carrying the conversation ID of the running turn.
+func WithConversationID(ctx context.Context, conversationID uuid.UUID) context.Context {
+	return context.WithValue(ctx, conversationIDKey{}, conversationID)
+}
+
+// ConversationIDFromContext returns the conversation ID of the running turn, if any.
+func ConversationIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	conversationID, ok := ctx.Value(conversationIDKey{}).(uuid.UUID)
+	return conversationID, ok && conversationID != uuid.Nil
+}
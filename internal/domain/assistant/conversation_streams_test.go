@@ -0,0 +1,47 @@
+package assistant
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversationIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		setup  func(t *testing.T) (uuid.UUID, bool)
+		wantID uuid.UUID
+		wantOK bool
+	}{
+		"with-conversation-id": {
+			setup: func(t *testing.T) (uuid.UUID, bool) {
+				return ConversationIDFromContext(WithConversationID(t.Context(), conversationID))
+			},
+			wantID: conversationID,
+			wantOK: true,
+		},
+		"without-conversation-id": {
+			setup: func(t *testing.T) (uuid.UUID, bool) {
+				return ConversationIDFromContext(t.Context())
+			},
+		},
+		"nil-conversation-id": {
+			setup: func(t *testing.T) (uuid.UUID, bool) {
+				return ConversationIDFromContext(WithConversationID(t.Context(), uuid.Nil))
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			gotID, gotOK := tt.setup(t)
+			assert.Equal(t, tt.wantID, gotID)
+			assert.Equal(t, tt.wantOK, gotOK)
+		})
+	}
+}
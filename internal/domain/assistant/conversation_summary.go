@@ -23,6 +23,8 @@ const DefaultConversationStateSummary = "No current state."
 // CompactionPolicy controls compaction thresholds.
 type CompactionPolicy struct {
 	TriggerTokenCount int
+	// TriggerTurnCount compacts after this many unsummarized user turns; zero disables the trigger.
+	TriggerTurnCount int
 }
 
 // CompactionDecision is the output of compaction policy evaluation.
@@ -31,6 +33,7 @@ type CompactionDecision struct {
 	Reason        ContextCompactionReason
 	MessageCount  int
 	TotalTokens   int
+	TurnCount     int
 }
 
 // CurrentStateOrDefault returns the persisted current state summary, or a default string when empty.
@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -140,6 +142,80 @@ func TestConversation_ApplyUserTitle(t *testing.T) {
 	}
 }
 
+func TestConversationSettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		settings ConversationSettings
+		wantErr  error
+	}{
+		"zero-value": {},
+		"within-limits": {
+			settings: ConversationSettings{
+				Temperature: common.Ptr(2.0),
+				TopP:        common.Ptr(1.0),
+				Model:       "qwen3",
+			},
+		},
+		"zero-temperature": {
+			settings: ConversationSettings{Temperature: common.Ptr(0.0)},
+		},
+		"temperature-below-range": {
+			settings: ConversationSettings{Temperature: common.Ptr(-0.1)},
+			wantErr:  core.NewValidationErr("temperature must be between 0 and 2"),
+		},
+		"temperature-above-range": {
+			settings: ConversationSettings{Temperature: common.Ptr(2.1)},
+			wantErr:  core.NewValidationErr("temperature must be between 0 and 2"),
+		},
+		"top-p-zero": {
+			settings: ConversationSettings{TopP: common.Ptr(0.0)},
+			wantErr:  core.NewValidationErr("top_p must be greater than 0 and at most 1"),
+		},
+		"top-p-above-range": {
+			settings: ConversationSettings{TopP: common.Ptr(1.5)},
+			wantErr:  core.NewValidationErr("top_p must be greater than 0 and at most 1"),
+		},
+		"model-with-spaces": {
+			settings: ConversationSettings{Model: " qwen3"},
+			wantErr:  core.NewValidationErr("model must not have leading or trailing spaces"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.settings.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestConversationSettings_ApplyTo(t *testing.T) {
+	t.Parallel()
+
+	req := TurnRequest{
+		Model:       "qwen3",
+		Temperature: common.Ptr(0.2),
+		TopP:        common.Ptr(0.7),
+	}
+
+	ConversationSettings{Temperature: common.Ptr(0.9), Model: "ignored"}.ApplyTo(&req)
+
+	assert.Equal(t, TurnRequest{
+		Model:       "qwen3",
+		Temperature: common.Ptr(0.9),
+		TopP:        common.Ptr(0.7),
+	}, req)
+	assert.True(t, ConversationSettings{}.IsZero())
+	assert.False(t, ConversationSettings{Model: "qwen3"}.IsZero())
+}
+
 func TestConversation_ApplyLLMGeneratedTitle(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,81 @@
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConversationTopic indexes the compacted summary of a conversation by its embedding, so conversations
+// about the same topic can be found across the conversation list.
+type ConversationTopic struct {
+	ConversationID uuid.UUID
+	Embedding      []float64
+	// UpdatedAt is the time the indexed summary was updated.
+	UpdatedAt time.Time
+}
+
+// RelatedConversation is a conversation whose topic is close to another conversation or user message.
+type RelatedConversation struct {
+	ConversationID uuid.UUID
+	Title          string
+	// Similarity is the cosine similarity between the topics, from 0 to 1.
+	Similarity float64
+	// LastActiveAt is the time of the last message of the conversation, or of its summary when it has none.
+	LastActiveAt time.Time
+}
+
+// RelatedConversationHint renders a system prompt hint pointing the assistant at an earlier conversation
+// about the same topic.
+func RelatedConversationHint(related RelatedConversation, now time.Time) string {
+	return fmt.Sprintf(
+		"The user discussed a similar topic in the earlier conversation %q %s. If it helps, mention it briefly "+
+			"(e.g. \"you discussed this in '%s' %s\"), but do not assume its details still apply.",
+		strings.TrimSpace(related.Title),
+		describeElapsed(related.LastActiveAt, now),
+		strings.TrimSpace(related.Title),
+		describeElapsed(related.LastActiveAt, now),
+	)
+}
+
+// describeElapsed describes when then happened relative to now in calendar days, e.g. "yesterday" or "last week".
+func describeElapsed(then, now time.Time) string {
+	thenDay := time.Date(then.Year(), then.Month(), then.Day(), 0, 0, 0, 0, time.UTC)
+	nowDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(nowDay.Sub(thenDay).Hours() / 24)
+
+	switch {
+	case days <= 0:
+		return "earlier today"
+	case days == 1:
+		return "yesterday"
+	case days < 7:
+		return fmt.Sprintf("%d days ago", days)
+	case days < 14:
+		return "last week"
+	case days < 31:
+		return fmt.Sprintf("%d weeks ago", days/7)
+	default:
+		return "on " + then.Format("Jan 2, 2006")
+	}
+}
+
+// ConversationTopicRepository defines the interface for the cross-conversation topic index.
+type ConversationTopicRepository interface {
+	// StoreConversationTopic creates or replaces the topic of a conversation.
+	StoreConversationTopic(ctx context.Context, topic ConversationTopic) error
+	// GetConversationTopic retrieves the topic of a conversation.
+	GetConversationTopic(ctx context.Context, conversationID uuid.UUID) (ConversationTopic, bool, error)
+	// SearchRelatedConversations lists up to limit conversations, other than excludeConversationID, whose
+	// topic is within maxDistance cosine distance of the embedding, closest first.
+	SearchRelatedConversations(
+		ctx context.Context,
+		embedding []float64,
+		excludeConversationID uuid.UUID,
+		limit int,
+		maxDistance float64,
+	) ([]RelatedConversation, error)
+}
@@ -0,0 +1,69 @@
+package assistant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelatedConversationHint(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		lastActiveAt time.Time
+		want         string
+	}{
+		"earlier-today": {
+			lastActiveAt: now.Add(-2 * time.Hour),
+			want: `The user discussed a similar topic in the earlier conversation "Spring cleaning" earlier today. ` +
+				`If it helps, mention it briefly (e.g. "you discussed this in 'Spring cleaning' earlier today"), ` +
+				`but do not assume its details still apply.`,
+		},
+		"last-week": {
+			lastActiveAt: now.AddDate(0, 0, -9),
+			want: `The user discussed a similar topic in the earlier conversation "Spring cleaning" last week. ` +
+				`If it helps, mention it briefly (e.g. "you discussed this in 'Spring cleaning' last week"), ` +
+				`but do not assume its details still apply.`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			related := RelatedConversation{ConversationID: uuid.New(), Title: " Spring cleaning ", LastActiveAt: tt.lastActiveAt}
+			assert.Equal(t, tt.want, RelatedConversationHint(related, now))
+		})
+	}
+}
+
+func TestDescribeElapsed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		then time.Time
+		want string
+	}{
+		"same-day":   {then: now.Add(-9 * time.Hour), want: "earlier today"},
+		"yesterday":  {then: now.Add(-11 * time.Hour), want: "yesterday"},
+		"days-ago":   {then: now.AddDate(0, 0, -3), want: "3 days ago"},
+		"last-week":  {then: now.AddDate(0, 0, -7), want: "last week"},
+		"weeks-ago":  {then: now.AddDate(0, 0, -22), want: "3 weeks ago"},
+		"long-ago":   {then: time.Date(2025, 12, 24, 8, 0, 0, 0, time.UTC), want: "on Dec 24, 2025"},
+		"future-day": {then: now.Add(time.Hour), want: "earlier today"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, describeElapsed(tt.then, now))
+		})
+	}
+}
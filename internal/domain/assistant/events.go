@@ -2,6 +2,7 @@ package assistant
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +16,8 @@ const (
 	EventType_TurnStarted EventType = "turn_started"
 	// EventType_MessageDelta indicates a streaming text delta event.
 	EventType_MessageDelta EventType = "message_delta"
+	// EventType_Reasoning indicates a streaming reasoning delta that is kept out of the assistant message.
+	EventType_Reasoning EventType = "reasoning"
 	// EventType_ActionRequested indicates the model requested a tool/action call.
 	EventType_ActionRequested EventType = "action_requested"
 	// EventType_ActionApprovalRequired indicates an action is waiting for human approval.
@@ -27,12 +30,26 @@ const (
 	EventType_ActionCompleted EventType = "action_completed"
 	// EventType_TurnCompleted indicates a chat turn finished.
 	EventType_TurnCompleted EventType = "turn_completed"
+	// EventType_TurnFailed indicates a chat turn failed after it started.
+	EventType_TurnFailed EventType = "turn_failed"
 	// EventType_ContextCompactionStarted indicates context compaction has started.
 	EventType_ContextCompactionStarted EventType = "context_compaction_started"
 	// EventType_ContextCompactionCompleted indicates context compaction has completed.
 	EventType_ContextCompactionCompleted EventType = "context_compaction_completed"
 	// EventType_ContextCompactionFailed indicates context compaction has failed.
 	EventType_ContextCompactionFailed EventType = "context_compaction_failed"
+	// EventType_ContextTruncated warns that the turn was retried without the earlier conversation history.
+	EventType_ContextTruncated EventType = "context_truncated"
+	// EventType_FocusSessionCompleted indicates a focus session started from the conversation has finished.
+	EventType_FocusSessionCompleted EventType = "focus_session_completed"
+	// EventType_TopicShiftSuggested indicates the user message drifted away from the conversation topic.
+	EventType_TopicShiftSuggested EventType = "topic_shift_suggested"
+	// EventType_ConversationSplit indicates the turn moved to a new conversation after a topic shift.
+	EventType_ConversationSplit EventType = "conversation_split"
+	// EventType_MessageModerated indicates the user message was blocked by content moderation.
+	EventType_MessageModerated EventType = "message_moderated"
+	// EventType_GroundingWarning warns that the final answer mentions values the action results do not support.
+	EventType_GroundingWarning EventType = "grounding_warning"
 )
 
 // Usage contains token usage for one assistant turn.
@@ -55,6 +72,11 @@ type MessageDelta struct {
 	Text string `json:"text"`
 }
 
+// Reasoning contains a reasoning delta the model emitted before or between its answer text.
+type Reasoning struct {
+	Text string `json:"text"`
+}
+
 // ActionApprovalRequired indicates an action is blocked waiting for human approval.
 type ActionApprovalRequired struct {
 	ConversationID uuid.UUID     `json:"conversation_id"`
@@ -89,11 +111,54 @@ type ActionCompleted struct {
 	ActionExecuted  *bool                      `json:"action_executed,omitempty"`
 	OutputPreview   *string                    `json:"output_preview,omitempty"`
 	OutputTruncated bool                       `json:"output_truncated,omitempty"`
+	// Result is the ActionResult envelope returned by the action, already bounded by the action result size limit.
+	// It is only streamed to clients that ask for action results.
+	Result json.RawMessage `json:"result,omitempty"`
+	// ChangeSequence is the latest global todo change sequence after the action ran.
+	ChangeSequence *int64 `json:"change_sequence,omitempty"`
+	// ConversationChangeSequence is the latest todo change sequence of the conversation after the action ran.
+	ConversationChangeSequence *int64 `json:"conversation_change_sequence,omitempty"`
 }
 
 // TurnCompleted contains completion metadata and usage.
 type TurnCompleted struct {
 	Usage Usage `json:"usage"`
+	// Latency breaks down where the time of the turn went. It is only set on the turn_completed event sent to clients.
+	Latency *TurnLatency `json:"latency,omitempty"`
+}
+
+// TurnLatency breaks down the wall-clock time of a chat turn by phase, in milliseconds.
+type TurnLatency struct {
+	// HistoryFetchMs is the time spent loading the conversation history and its compacted summary.
+	HistoryFetchMs int64 `json:"history_fetch_ms"`
+	// PromptBuildMs is the rest of the turn preparation: memories, skills, actions and the request itself.
+	PromptBuildMs int64 `json:"prompt_build_ms"`
+	// TimeToFirstTokenMs is the time from sending the first model request, including the wait for an LLM slot,
+	// to the first text, reasoning or action call streamed back.
+	TimeToFirstTokenMs int64 `json:"time_to_first_token_ms"`
+	// Actions lists the execution time of each action run in the turn, in call order.
+	Actions []ActionLatency `json:"actions,omitempty"`
+	// PersistenceMs is the time spent writing the turn messages and streaming checkpoints to the transcript.
+	PersistenceMs int64 `json:"persistence_ms"`
+	// TotalMs is the time from the start of the turn preparation to its completion.
+	TotalMs int64 `json:"total_ms"`
+}
+
+// ActionLatency is the execution time of one action call of a turn.
+type ActionLatency struct {
+	ActionCallID string `json:"action_call_id"`
+	Name         string `json:"name"`
+	DurationMs   int64  `json:"duration_ms"`
+}
+
+// TurnFailed describes a failed chat turn so clients can decide whether to retry.
+type TurnFailed struct {
+	ConversationID    uuid.UUID     `json:"conversation_id"`
+	TurnID            uuid.UUID     `json:"turn_id"`
+	Code              TurnErrorCode `json:"code"`
+	Error             string        `json:"error"`
+	Retriable         bool          `json:"retriable"`
+	RetryAfterSeconds *int          `json:"retry_after_seconds,omitempty"`
 }
 
 // ContextCompactionReason identifies why compaction was triggered.
@@ -104,6 +169,8 @@ const (
 	ContextCompactionReasonNone ContextCompactionReason = "none"
 	// ContextCompactionReasonTokenCountThreshold indicates the unsummarized token threshold matched.
 	ContextCompactionReasonTokenCountThreshold ContextCompactionReason = "token_count_threshold"
+	// ContextCompactionReasonTurnInterval indicates the unsummarized turn interval matched.
+	ContextCompactionReasonTurnInterval ContextCompactionReason = "turn_interval"
 )
 
 // ContextCompactionStarted indicates the compaction process has started.
@@ -132,5 +199,80 @@ type ContextCompactionFailed struct {
 	Error                    string                  `json:"error"`
 }
 
+// ContextTruncated warns that the prompt exceeded the model context window and the turn was retried
+// with only the system prompt, the compacted summary and the current turn.
+type ContextTruncated struct {
+	ConversationID       uuid.UUID `json:"conversation_id"`
+	TurnID               uuid.UUID `json:"turn_id"`
+	DroppedMessageCount  int       `json:"dropped_message_count"`
+	RetainedMessageCount int       `json:"retained_message_count"`
+}
+
+// GroundingIssueKind names the kind of answer value the action results of its turn do not support.
+type GroundingIssueKind string
+
+const (
+	// GroundingIssueKind_UnknownID is a UUID the action results and the turn inputs never mention.
+	GroundingIssueKind_UnknownID GroundingIssueKind = "unknown_id"
+	// GroundingIssueKind_UnknownDate is a date the action results and the turn inputs never mention.
+	GroundingIssueKind_UnknownDate GroundingIssueKind = "unknown_date"
+	// GroundingIssueKind_StatusMismatch is a todo status that differs from the one in the action results.
+	GroundingIssueKind_StatusMismatch GroundingIssueKind = "status_mismatch"
+)
+
+// GroundingIssue is one answer value the action results of its turn do not support.
+type GroundingIssue struct {
+	Kind GroundingIssueKind `json:"kind"`
+	// Value is the ID or date mentioned in the answer, or the todo whose status it misstates.
+	Value string `json:"value"`
+	// Stated and Expected are the status the answer claims and the one in the action results.
+	Stated   string `json:"stated,omitempty"`
+	Expected string `json:"expected,omitempty"`
+}
+
+// GroundingWarning warns that the final answer of a turn mentions values its action results do not support.
+// The answer is not changed.
+type GroundingWarning struct {
+	ConversationID uuid.UUID        `json:"conversation_id"`
+	TurnID         uuid.UUID        `json:"turn_id"`
+	Issues         []GroundingIssue `json:"issues"`
+}
+
+// FocusSessionCompleted indicates a focus session has finished and its time was logged.
+type FocusSessionCompleted struct {
+	SessionID       uuid.UUID `json:"session_id"`
+	TodoID          uuid.UUID `json:"todo_id"`
+	DurationMinutes int       `json:"duration_minutes"`
+	StartedAt       time.Time `json:"started_at"`
+	CompletedAt     time.Time `json:"completed_at"`
+	TimeLogged      bool      `json:"time_logged"`
+}
+
+// TopicShiftSuggested offers to start a new conversation because the user message drifted away from the conversation topic.
+type TopicShiftSuggested struct {
+	ConversationID uuid.UUID `json:"conversation_id"`
+	Distance       float64   `json:"distance"`
+	Threshold      float64   `json:"threshold"`
+}
+
+// ConversationSplit indicates the turn continues in a new conversation that carries over the pinned todos.
+type ConversationSplit struct {
+	PreviousConversationID uuid.UUID    `json:"previous_conversation_id"`
+	ConversationID         uuid.UUID    `json:"conversation_id"`
+	Distance               float64      `json:"distance"`
+	Threshold              float64      `json:"threshold"`
+	PinnedTodos            []PinnedTodo `json:"pinned_todos"`
+}
+
+// MessageModerated is the refusal sent instead of a turn when the user message was blocked by content moderation.
+type MessageModerated struct {
+	ConversationID      uuid.UUID `json:"conversation_id"`
+	ConversationCreated bool      `json:"conversation_created"`
+	TurnID              uuid.UUID `json:"turn_id"`
+	MessageID           uuid.UUID `json:"message_id"`
+	Categories          []string  `json:"categories,omitempty"`
+	Message             string    `json:"message"`
+}
+
 // EventCallback is called for each assistant turn event.
 type EventCallback func(context.Context, EventType, any) error
@@ -0,0 +1,159 @@
+package assistant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+
+	"encoding/json"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// ExperimentVariant is one arm of an experiment. Empty settings keep the value the turn would use
+// outside the experiment.
+type ExperimentVariant struct {
+	Name string `json:"name"`
+	// Weight is the relative share of conversations assigned to the variant.
+	Weight int `json:"weight"`
+	// Model replaces the model requested by the user.
+	Model string `json:"model,omitempty"`
+	// PromptVersion selects the chat system prompt version.
+	PromptVersion string `json:"prompt_version,omitempty"`
+	// Temperature replaces the chat generation temperature.
+	Temperature *float64 `json:"temperature,omitempty"`
+}
+
+// Experiment splits conversations between variants of the chat prompt and generation settings to
+// compare their outcomes.
+type Experiment struct {
+	Name     string              `json:"name"`
+	Variants []ExperimentVariant `json:"variants"`
+}
+
+// ParseExperiment parses an experiment from its JSON configuration, such as
+// {"name":"prompt-v2","variants":[{"name":"control","weight":50},{"name":"concise","weight":50,"prompt_version":"concise"}]}.
+// An empty configuration returns nil, meaning no experiment is running.
+func ParseExperiment(raw string) (*Experiment, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var experiment Experiment
+	if err := json.Unmarshal([]byte(raw), &experiment); err != nil {
+		return nil, fmt.Errorf("invalid experiment JSON: %w", err)
+	}
+	if err := experiment.Validate(); err != nil {
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+// Validate checks the experiment is named and has at least two uniquely named variants with positive weights.
+func (e Experiment) Validate() error {
+	if e.Name == "" {
+		return errors.New("experiment name must be set")
+	}
+	if len(e.Variants) < 2 {
+		return fmt.Errorf("experiment %s must have at least two variants", e.Name)
+	}
+	names := make(map[string]bool, len(e.Variants))
+	for _, variant := range e.Variants {
+		if variant.Name == "" {
+			return fmt.Errorf("experiment %s has a variant without name", e.Name)
+		}
+		if names[variant.Name] {
+			return fmt.Errorf("experiment %s has duplicate variant %s", e.Name, variant.Name)
+		}
+		names[variant.Name] = true
+		if variant.Weight <= 0 {
+			return fmt.Errorf("variant %s of experiment %s must have a positive weight", variant.Name, e.Name)
+		}
+		if variant.Temperature != nil && (*variant.Temperature < 0 || *variant.Temperature > 2) {
+			return fmt.Errorf("variant %s of experiment %s must have a temperature between 0 and 2", variant.Name, e.Name)
+		}
+	}
+	return nil
+}
+
+// Assign returns the variant of the conversation. The assignment is derived from the conversation ID,
+// so a conversation keeps its variant across turns and processes as long as the variants do not change.
+func (e Experiment) Assign(conversationID uuid.UUID) ExperimentVariant {
+	total := 0
+	for _, variant := range e.Variants {
+		total += variant.Weight
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(e.Name))    //nolint:errcheck
+	hash.Write(conversationID[:]) //nolint:errcheck
+	bucket := int(hash.Sum32() % uint32(total))
+	for _, variant := range e.Variants {
+		if bucket < variant.Weight {
+			return variant
+		}
+		bucket -= variant.Weight
+	}
+	return e.Variants[len(e.Variants)-1]
+}
+
+// ExperimentAssignment records the experiment variant a chat message was produced under.
+type ExperimentAssignment struct {
+	Experiment    string   `json:"experiment"`
+	Variant       string   `json:"variant"`
+	Model         string   `json:"model,omitempty"`
+	PromptVersion string   `json:"prompt_version,omitempty"`
+	Temperature   *float64 `json:"temperature,omitempty"`
+}
+
+// NewExperimentAssignment creates the ExperimentAssignment of a variant of the experiment.
+func NewExperimentAssignment(experiment Experiment, variant ExperimentVariant) ExperimentAssignment {
+	return ExperimentAssignment{
+		Experiment:    experiment.Name,
+		Variant:       variant.Name,
+		Model:         variant.Model,
+		PromptVersion: variant.PromptVersion,
+		Temperature:   variant.Temperature,
+	}
+}
+
+// VariantOutcome aggregates the completed turns of one experiment variant.
+type VariantOutcome struct {
+	Variant string
+	// Turns counts the turns answered under the variant.
+	Turns int
+	// FailedTurns counts the turns that ended in a failure message.
+	FailedTurns int
+	// ActionLoopTurns counts the turns stopped by the repeated action or action cycle guards.
+	ActionLoopTurns int
+	// FeedbackCount counts the rated assistant responses.
+	FeedbackCount int
+	// FeedbackScore is the average rating, from -1 to 1, of the rated responses.
+	FeedbackScore float64
+}
+
+// FailureRate returns the share of failed turns.
+func (o VariantOutcome) FailureRate() float64 {
+	return ratio(o.FailedTurns, o.Turns)
+}
+
+// ActionLoopRate returns the share of turns stopped by the action loop guards.
+func (o VariantOutcome) ActionLoopRate() float64 {
+	return ratio(o.ActionLoopTurns, o.Turns)
+}
+
+// ratio returns part over total, or zero when total is zero.
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
+
+// ExperimentRepository reads the outcomes recorded for experiments.
+type ExperimentRepository interface {
+	// ListVariantOutcomes aggregates the outcomes of the variants of the experiment, ordered by variant name.
+	ListVariantOutcomes(ctx context.Context, experiment string) ([]VariantOutcome, error)
+}
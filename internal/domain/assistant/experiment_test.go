@@ -0,0 +1,155 @@
+package assistant
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExperiment(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		raw     string
+		want    *Experiment
+		wantErr string
+	}{
+		"empty": {
+			raw: "  ",
+		},
+		"valid": {
+			raw: `{"name":"prompt-v2","variants":[{"name":"control","weight":50},{"name":"concise","weight":50,"model":"qwen3","prompt_version":"concise","temperature":0.4}]}`,
+			want: &Experiment{
+				Name: "prompt-v2",
+				Variants: []ExperimentVariant{
+					{Name: "control", Weight: 50},
+					{Name: "concise", Weight: 50, Model: "qwen3", PromptVersion: "concise", Temperature: common.Ptr(0.4)},
+				},
+			},
+		},
+		"invalid-json": {
+			raw:     `[]`,
+			wantErr: "invalid experiment JSON: json: cannot unmarshal array into Go value of type assistant.Experiment",
+		},
+		"invalid-experiment": {
+			raw:     `{"name":"prompt-v2","variants":[{"name":"control","weight":50}]}`,
+			wantErr: "experiment prompt-v2 must have at least two variants",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseExperiment(tt.raw)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExperiment_Validate(t *testing.T) {
+	t.Parallel()
+
+	variants := func(variants ...ExperimentVariant) Experiment {
+		return Experiment{Name: "prompt-v2", Variants: variants}
+	}
+	control := ExperimentVariant{Name: "control", Weight: 1}
+
+	tests := map[string]struct {
+		experiment Experiment
+		want       error
+	}{
+		"valid": {
+			experiment: variants(control, ExperimentVariant{Name: "warm", Weight: 1, Temperature: common.Ptr(0.9)}),
+		},
+		"missing-name": {
+			experiment: Experiment{Variants: []ExperimentVariant{control, {Name: "b", Weight: 1}}},
+			want:       errors.New("experiment name must be set"),
+		},
+		"single-variant": {
+			experiment: variants(control),
+			want:       errors.New("experiment prompt-v2 must have at least two variants"),
+		},
+		"unnamed-variant": {
+			experiment: variants(control, ExperimentVariant{Weight: 1}),
+			want:       errors.New("experiment prompt-v2 has a variant without name"),
+		},
+		"duplicate-variant": {
+			experiment: variants(control, control),
+			want:       errors.New("experiment prompt-v2 has duplicate variant control"),
+		},
+		"non-positive-weight": {
+			experiment: variants(control, ExperimentVariant{Name: "b"}),
+			want:       errors.New("variant b of experiment prompt-v2 must have a positive weight"),
+		},
+		"temperature-out-of-range": {
+			experiment: variants(control, ExperimentVariant{Name: "hot", Weight: 1, Temperature: common.Ptr(2.5)}),
+			want:       errors.New("variant hot of experiment prompt-v2 must have a temperature between 0 and 2"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.experiment.Validate())
+		})
+	}
+}
+
+func TestExperiment_Assign(t *testing.T) {
+	t.Parallel()
+
+	experiment := Experiment{
+		Name: "prompt-v2",
+		Variants: []ExperimentVariant{
+			{Name: "control", Weight: 3},
+			{Name: "concise", Weight: 1},
+		},
+	}
+
+	counts := map[string]int{}
+	for i := range 4000 {
+		conversationID := uuid.NewSHA1(uuid.NameSpaceOID, []byte{byte(i), byte(i >> 8)})
+		variant := experiment.Assign(conversationID)
+		assert.Equal(t, variant, experiment.Assign(conversationID), "assignment must be stable")
+		counts[variant.Name]++
+	}
+
+	assert.InDelta(t, 3000, counts["control"], 200)
+	assert.InDelta(t, 1000, counts["concise"], 200)
+}
+
+func TestVariantOutcome_Rates(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		outcome            VariantOutcome
+		wantFailureRate    float64
+		wantActionLoopRate float64
+	}{
+		"no-turns": {
+			outcome: VariantOutcome{Variant: "control"},
+		},
+		"with-turns": {
+			outcome:            VariantOutcome{Variant: "control", Turns: 8, FailedTurns: 2, ActionLoopTurns: 1},
+			wantFailureRate:    0.25,
+			wantActionLoopRate: 0.125,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.wantFailureRate, tt.outcome.FailureRate())
+			assert.Equal(t, tt.wantActionLoopRate, tt.outcome.ActionLoopRate())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxInstructionChars is the maximum number of characters allowed in a pinned instruction.
+	MaxInstructionChars = 500
+	// MaxPinnedInstructions is the maximum number of instructions pinned to one conversation or kept globally.
+	MaxPinnedInstructions = 20
+)
+
+// Instruction is a standing instruction saved by the user, e.g. "always use DD/MM dates", that is added to
+// the system prompt of every turn it applies to.
+type Instruction struct {
+	ID uuid.UUID
+	// ConversationID is the conversation the instruction is pinned to, or nil for a global instruction
+	// that applies to every conversation.
+	ConversationID *uuid.UUID
+	Text           string
+	CreatedAt      time.Time
+}
+
+// IsGlobal reports whether the instruction applies to every conversation.
+func (i Instruction) IsGlobal() bool {
+	return i.ConversationID == nil
+}
+
+// Validate checks if the instruction has valid fields.
+func (i Instruction) Validate() error {
+	if i.ConversationID != nil && *i.ConversationID == uuid.Nil {
+		return core.NewValidationErr("conversation_id cannot be empty")
+	}
+	if strings.TrimSpace(i.Text) == "" {
+		return core.NewValidationErr("instruction cannot be empty")
+	}
+	if utf8.RuneCountInString(i.Text) > MaxInstructionChars {
+		return core.NewValidationErr(fmt.Sprintf("instruction cannot exceed %d characters", MaxInstructionChars))
+	}
+	return nil
+}
+
+// InstructionsPrompt renders the instructions as a system prompt section, or an empty string when there are none.
+func InstructionsPrompt(instructions []Instruction) string {
+	if len(instructions) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	builder.WriteString("Pinned user instructions. Follow them in every answer unless the user overrides them in this turn:")
+	for _, instruction := range instructions {
+		builder.WriteString("\n- ")
+		builder.WriteString(strings.TrimSpace(instruction.Text))
+	}
+	return builder.String()
+}
+
+// InstructionRepository defines the interface for pinned instruction persistence.
+type InstructionRepository interface {
+	// CreateInstruction stores a new instruction.
+	CreateInstruction(ctx context.Context, instruction Instruction) error
+	// DeleteInstruction deletes an instruction by its ID.
+	DeleteInstruction(ctx context.Context, id uuid.UUID) error
+	// GetInstruction retrieves an instruction by its ID.
+	GetInstruction(ctx context.Context, id uuid.UUID) (Instruction, bool, error)
+	// ListInstructions lists the global instructions followed by the ones pinned to the conversation, oldest
+	// first. A nil conversation ID lists only the global instructions.
+	ListInstructions(ctx context.Context, conversationID uuid.UUID) ([]Instruction, error)
+}
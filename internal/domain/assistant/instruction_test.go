@@ -0,0 +1,81 @@
+package assistant
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstruction_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		instruction Instruction
+		expectedErr string
+	}{
+		"global": {
+			instruction: Instruction{Text: "Always use DD/MM dates"},
+		},
+		"conversation": {
+			instruction: Instruction{ConversationID: common.Ptr(uuid.New()), Text: "Answer in Portuguese"},
+		},
+		"empty-text": {
+			instruction: Instruction{Text: "  "},
+			expectedErr: "instruction cannot be empty",
+		},
+		"text-too-long": {
+			instruction: Instruction{Text: strings.Repeat("a", MaxInstructionChars+1)},
+			expectedErr: "instruction cannot exceed 500 characters",
+		},
+		"nil-conversation-id": {
+			instruction: Instruction{ConversationID: common.Ptr(uuid.Nil), Text: "Answer in Portuguese"},
+			expectedErr: "conversation_id cannot be empty",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.instruction.Validate()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInstructionsPrompt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		instructions []Instruction
+		want         string
+	}{
+		"no-instructions": {
+			want: "",
+		},
+		"renders-instructions-in-order": {
+			instructions: []Instruction{
+				{Text: "Always use DD/MM dates"},
+				{ConversationID: common.Ptr(uuid.New()), Text: " Answer in Portuguese "},
+			},
+			want: "Pinned user instructions. Follow them in every answer unless the user overrides them in this turn:\n" +
+				"- Always use DD/MM dates\n" +
+				"- Answer in Portuguese",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, InstructionsPrompt(tt.instructions))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// MemoryKind classifies a long-term memory about the user.
+type MemoryKind string
+
+const (
+	// MemoryKind_Fact is a durable fact about the user, e.g. "works at Acme as a nurse".
+	MemoryKind_Fact MemoryKind = "fact"
+	// MemoryKind_Preference is a standing preference of the user, e.g. "prefers morning workouts".
+	MemoryKind_Preference MemoryKind = "preference"
+)
+
+const (
+	// MaxMemoryChars is the maximum length of a memory text.
+	MaxMemoryChars = 300
+	// MaxMemories is the maximum number of memories kept per tenant. Extraction stops adding memories past it.
+	MaxMemories = 200
+)
+
+// IsValid reports whether the kind is a known memory kind.
+func (k MemoryKind) IsValid() bool {
+	return k == MemoryKind_Fact || k == MemoryKind_Preference
+}
+
+// Memory is a durable fact or preference about the user, extracted from a completed conversation
+// and recalled in later conversations by embedding similarity.
+type Memory struct {
+	ID   uuid.UUID
+	Kind MemoryKind
+	Text string
+	// SourceConversationID is the conversation the memory was extracted from. It is nil once the
+	// conversation is deleted; the memory itself stays until the user deletes it.
+	SourceConversationID *uuid.UUID
+	Embedding            []float64
+	CreatedAt            time.Time
+}
+
+// Validate checks that the memory can be stored.
+func (m Memory) Validate() error {
+	if !m.Kind.IsValid() {
+		return core.NewValidationErr(fmt.Sprintf("invalid memory kind %q", m.Kind))
+	}
+	text := strings.TrimSpace(m.Text)
+	if text == "" {
+		return core.NewValidationErr("memory cannot be empty")
+	}
+	if len([]rune(text)) > MaxMemoryChars {
+		return core.NewValidationErr(fmt.Sprintf("memory cannot exceed %d characters", MaxMemoryChars))
+	}
+	if len(m.Embedding) == 0 {
+		return core.NewValidationErr("memory embedding cannot be empty")
+	}
+	return nil
+}
+
+// MemoriesPrompt renders the recalled memories as a system prompt section, or "" when there are none.
+func MemoriesPrompt(memories []Memory) string {
+	if len(memories) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	builder.WriteString("Long-term memories about the user from earlier conversations. Use them when relevant, " +
+		"but the user's messages in this conversation take precedence:")
+	for _, memory := range memories {
+		builder.WriteString("\n- ")
+		builder.WriteString(strings.TrimSpace(memory.Text))
+	}
+	return builder.String()
+}
+
+// MemoryRepository defines the persistence of long-term memories and of the extraction progress
+// of each conversation.
+type MemoryRepository interface {
+	// CreateMemory stores a new memory.
+	CreateMemory(ctx context.Context, memory Memory) error
+	// DeleteMemory deletes a memory by its ID.
+	DeleteMemory(ctx context.Context, id uuid.UUID) error
+	// GetMemory retrieves a memory by its ID, without its embedding.
+	GetMemory(ctx context.Context, id uuid.UUID) (Memory, bool, error)
+	// ListMemories lists the memories, newest first, without their embeddings.
+	ListMemories(ctx context.Context) ([]Memory, error)
+	// SearchMemories lists up to limit memories whose cosine distance to the embedding is below
+	// maxDistance, closest first, without their embeddings.
+	SearchMemories(ctx context.Context, embedding []float64, limit int, maxDistance float64) ([]Memory, error)
+	// GetMemoryExtractionCursor returns the creation time of the last message mined from the conversation.
+	GetMemoryExtractionCursor(ctx context.Context, conversationID uuid.UUID) (time.Time, bool, error)
+	// SaveMemoryExtractionCursor records the creation time of the last message mined from the conversation.
+	SaveMemoryExtractionCursor(ctx context.Context, conversationID uuid.UUID, extractedThrough time.Time) error
+}
@@ -0,0 +1,84 @@
+package assistant
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		memory      Memory
+		expectedErr string
+	}{
+		"fact": {
+			memory: Memory{Kind: MemoryKind_Fact, Text: "Works night shifts as a nurse", Embedding: []float64{0.1}},
+		},
+		"preference": {
+			memory: Memory{Kind: MemoryKind_Preference, Text: "Prefers morning workouts", Embedding: []float64{0.1}},
+		},
+		"invalid-kind": {
+			memory:      Memory{Kind: "secret", Text: "Password is hunter2", Embedding: []float64{0.1}},
+			expectedErr: `invalid memory kind "secret"`,
+		},
+		"empty-text": {
+			memory:      Memory{Kind: MemoryKind_Fact, Text: " ", Embedding: []float64{0.1}},
+			expectedErr: "memory cannot be empty",
+		},
+		"text-too-long": {
+			memory:      Memory{Kind: MemoryKind_Fact, Text: strings.Repeat("a", MaxMemoryChars+1), Embedding: []float64{0.1}},
+			expectedErr: "memory cannot exceed 300 characters",
+		},
+		"missing-embedding": {
+			memory:      Memory{Kind: MemoryKind_Fact, Text: "Works night shifts as a nurse"},
+			expectedErr: "memory embedding cannot be empty",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.memory.Validate()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMemoriesPrompt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		memories []Memory
+		want     string
+	}{
+		"no-memories": {
+			want: "",
+		},
+		"renders-memories": {
+			memories: []Memory{
+				{Kind: MemoryKind_Preference, Text: "Prefers morning workouts"},
+				{Kind: MemoryKind_Fact, Text: " Has a dog named Rex "},
+			},
+			want: "Long-term memories about the user from earlier conversations. Use them when relevant, " +
+				"but the user's messages in this conversation take precedence:\n" +
+				"- Prefers morning workouts\n" +
+				"- Has a dog named Rex",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, MemoriesPrompt(tt.memories))
+		})
+	}
+}
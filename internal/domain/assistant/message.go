@@ -10,8 +10,14 @@ type Message struct {
 }
 
 // IsActionCallSuccess returns true when this message is a successful action result.
+// The status of an ActionResult envelope in the content decides; other content
+// succeeds unless the message carries an ActionError.
 func (m Message) IsActionCallSuccess() bool {
-	return m.Role == ChatRole_Tool &&
-		m.ActionCallID != nil &&
-		m.ActionError == nil
+	if m.Role != ChatRole_Tool || m.ActionCallID == nil {
+		return false
+	}
+	if result, ok := ParseActionResult(m.Content); ok {
+		return result.Status == ActionResultStatus_Success && m.ActionError == nil
+	}
+	return m.ActionError == nil
 }
@@ -6,6 +6,7 @@ package assistant
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
@@ -335,8 +336,8 @@ func (_c *MockActionRegistry_Execute_Call) RunAndReturn(run func(context1 contex
 }
 
 // GetDefinition provides a mock function for the type MockActionRegistry
-func (_mock *MockActionRegistry) GetDefinition(actionName string) (ActionDefinition, bool) {
-	ret := _mock.Called(actionName)
+func (_mock *MockActionRegistry) GetDefinition(ctx context.Context, actionName string) (ActionDefinition, bool) {
+	ret := _mock.Called(ctx, actionName)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDefinition")
@@ -344,16 +345,16 @@ func (_mock *MockActionRegistry) GetDefinition(actionName string) (ActionDefinit
 
 	var r0 ActionDefinition
 	var r1 bool
-	if returnFunc, ok := ret.Get(0).(func(string) (ActionDefinition, bool)); ok {
-		return returnFunc(actionName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (ActionDefinition, bool)); ok {
+		return returnFunc(ctx, actionName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) ActionDefinition); ok {
-		r0 = returnFunc(actionName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ActionDefinition); ok {
+		r0 = returnFunc(ctx, actionName)
 	} else {
 		r0 = ret.Get(0).(ActionDefinition)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
-		r1 = returnFunc(actionName)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, actionName)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
@@ -366,19 +367,25 @@ type MockActionRegistry_GetDefinition_Call struct {
 }
 
 // GetDefinition is a helper method to define mock.On call
+//   - ctx context.Context
 //   - actionName string
-func (_e *MockActionRegistry_Expecter) GetDefinition(actionName interface{}) *MockActionRegistry_GetDefinition_Call {
-	return &MockActionRegistry_GetDefinition_Call{Call: _e.mock.On("GetDefinition", actionName)}
+func (_e *MockActionRegistry_Expecter) GetDefinition(ctx interface{}, actionName interface{}) *MockActionRegistry_GetDefinition_Call {
+	return &MockActionRegistry_GetDefinition_Call{Call: _e.mock.On("GetDefinition", ctx, actionName)}
 }
 
-func (_c *MockActionRegistry_GetDefinition_Call) Run(run func(actionName string)) *MockActionRegistry_GetDefinition_Call {
+func (_c *MockActionRegistry_GetDefinition_Call) Run(run func(ctx context.Context, actionName string)) *MockActionRegistry_GetDefinition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -389,7 +396,7 @@ func (_c *MockActionRegistry_GetDefinition_Call) Return(actionDefinition ActionD
 	return _c
 }
 
-func (_c *MockActionRegistry_GetDefinition_Call) RunAndReturn(run func(actionName string) (ActionDefinition, bool)) *MockActionRegistry_GetDefinition_Call {
+func (_c *MockActionRegistry_GetDefinition_Call) RunAndReturn(run func(ctx context.Context, actionName string) (ActionDefinition, bool)) *MockActionRegistry_GetDefinition_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -456,6 +463,58 @@ func (_c *MockActionRegistry_GetRenderer_Call) RunAndReturn(run func(actionName
 	return _c
 }
 
+// Prefetch provides a mock function for the type MockActionRegistry
+func (_mock *MockActionRegistry) Prefetch(ctx context.Context, actionNames []string, messages []Message) {
+	_mock.Called(ctx, actionNames, messages)
+	return
+}
+
+// MockActionRegistry_Prefetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prefetch'
+type MockActionRegistry_Prefetch_Call struct {
+	*mock.Call
+}
+
+// Prefetch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionNames []string
+//   - messages []Message
+func (_e *MockActionRegistry_Expecter) Prefetch(ctx interface{}, actionNames interface{}, messages interface{}) *MockActionRegistry_Prefetch_Call {
+	return &MockActionRegistry_Prefetch_Call{Call: _e.mock.On("Prefetch", ctx, actionNames, messages)}
+}
+
+func (_c *MockActionRegistry_Prefetch_Call) Run(run func(ctx context.Context, actionNames []string, messages []Message)) *MockActionRegistry_Prefetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 []Message
+		if args[2] != nil {
+			arg2 = args[2].([]Message)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockActionRegistry_Prefetch_Call) Return() *MockActionRegistry_Prefetch_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockActionRegistry_Prefetch_Call) RunAndReturn(run func(ctx context.Context, actionNames []string, messages []Message)) *MockActionRegistry_Prefetch_Call {
+	_c.Run(run)
+	return _c
+}
+
 // StatusMessage provides a mock function for the type MockActionRegistry
 func (_mock *MockActionRegistry) StatusMessage(actionName string) string {
 	ret := _mock.Called(actionName)
@@ -906,6 +965,231 @@ func (_c *MockAssistant_RunTurnSync_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// NewMockChannelLinkRepository creates a new instance of MockChannelLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChannelLinkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChannelLinkRepository {
+	mock := &MockChannelLinkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChannelLinkRepository is an autogenerated mock type for the ChannelLinkRepository type
+type MockChannelLinkRepository struct {
+	mock.Mock
+}
+
+type MockChannelLinkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChannelLinkRepository) EXPECT() *MockChannelLinkRepository_Expecter {
+	return &MockChannelLinkRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteChannelLink provides a mock function for the type MockChannelLinkRepository
+func (_mock *MockChannelLinkRepository) DeleteChannelLink(ctx context.Context, channel Channel, externalChatID string) error {
+	ret := _mock.Called(ctx, channel, externalChatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChannelLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Channel, string) error); ok {
+		r0 = returnFunc(ctx, channel, externalChatID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelLinkRepository_DeleteChannelLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChannelLink'
+type MockChannelLinkRepository_DeleteChannelLink_Call struct {
+	*mock.Call
+}
+
+// DeleteChannelLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel Channel
+//   - externalChatID string
+func (_e *MockChannelLinkRepository_Expecter) DeleteChannelLink(ctx interface{}, channel interface{}, externalChatID interface{}) *MockChannelLinkRepository_DeleteChannelLink_Call {
+	return &MockChannelLinkRepository_DeleteChannelLink_Call{Call: _e.mock.On("DeleteChannelLink", ctx, channel, externalChatID)}
+}
+
+func (_c *MockChannelLinkRepository_DeleteChannelLink_Call) Run(run func(ctx context.Context, channel Channel, externalChatID string)) *MockChannelLinkRepository_DeleteChannelLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Channel
+		if args[1] != nil {
+			arg1 = args[1].(Channel)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelLinkRepository_DeleteChannelLink_Call) Return(err error) *MockChannelLinkRepository_DeleteChannelLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelLinkRepository_DeleteChannelLink_Call) RunAndReturn(run func(ctx context.Context, channel Channel, externalChatID string) error) *MockChannelLinkRepository_DeleteChannelLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChannelLink provides a mock function for the type MockChannelLinkRepository
+func (_mock *MockChannelLinkRepository) GetChannelLink(ctx context.Context, channel Channel, externalChatID string) (ChannelLink, bool, error) {
+	ret := _mock.Called(ctx, channel, externalChatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChannelLink")
+	}
+
+	var r0 ChannelLink
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Channel, string) (ChannelLink, bool, error)); ok {
+		return returnFunc(ctx, channel, externalChatID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Channel, string) ChannelLink); ok {
+		r0 = returnFunc(ctx, channel, externalChatID)
+	} else {
+		r0 = ret.Get(0).(ChannelLink)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, Channel, string) bool); ok {
+		r1 = returnFunc(ctx, channel, externalChatID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, Channel, string) error); ok {
+		r2 = returnFunc(ctx, channel, externalChatID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockChannelLinkRepository_GetChannelLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChannelLink'
+type MockChannelLinkRepository_GetChannelLink_Call struct {
+	*mock.Call
+}
+
+// GetChannelLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel Channel
+//   - externalChatID string
+func (_e *MockChannelLinkRepository_Expecter) GetChannelLink(ctx interface{}, channel interface{}, externalChatID interface{}) *MockChannelLinkRepository_GetChannelLink_Call {
+	return &MockChannelLinkRepository_GetChannelLink_Call{Call: _e.mock.On("GetChannelLink", ctx, channel, externalChatID)}
+}
+
+func (_c *MockChannelLinkRepository_GetChannelLink_Call) Run(run func(ctx context.Context, channel Channel, externalChatID string)) *MockChannelLinkRepository_GetChannelLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Channel
+		if args[1] != nil {
+			arg1 = args[1].(Channel)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelLinkRepository_GetChannelLink_Call) Return(channelLink ChannelLink, b bool, err error) *MockChannelLinkRepository_GetChannelLink_Call {
+	_c.Call.Return(channelLink, b, err)
+	return _c
+}
+
+func (_c *MockChannelLinkRepository_GetChannelLink_Call) RunAndReturn(run func(ctx context.Context, channel Channel, externalChatID string) (ChannelLink, bool, error)) *MockChannelLinkRepository_GetChannelLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveChannelLink provides a mock function for the type MockChannelLinkRepository
+func (_mock *MockChannelLinkRepository) SaveChannelLink(ctx context.Context, link ChannelLink) error {
+	ret := _mock.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveChannelLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ChannelLink) error); ok {
+		r0 = returnFunc(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockChannelLinkRepository_SaveChannelLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveChannelLink'
+type MockChannelLinkRepository_SaveChannelLink_Call struct {
+	*mock.Call
+}
+
+// SaveChannelLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link ChannelLink
+func (_e *MockChannelLinkRepository_Expecter) SaveChannelLink(ctx interface{}, link interface{}) *MockChannelLinkRepository_SaveChannelLink_Call {
+	return &MockChannelLinkRepository_SaveChannelLink_Call{Call: _e.mock.On("SaveChannelLink", ctx, link)}
+}
+
+func (_c *MockChannelLinkRepository_SaveChannelLink_Call) Run(run func(ctx context.Context, link ChannelLink)) *MockChannelLinkRepository_SaveChannelLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ChannelLink
+		if args[1] != nil {
+			arg1 = args[1].(ChannelLink)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChannelLinkRepository_SaveChannelLink_Call) Return(err error) *MockChannelLinkRepository_SaveChannelLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockChannelLinkRepository_SaveChannelLink_Call) RunAndReturn(run func(ctx context.Context, link ChannelLink) error) *MockChannelLinkRepository_SaveChannelLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockChatMessageRepository creates a new instance of MockChatMessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockChatMessageRepository(t interface {
@@ -1205,85 +1489,49 @@ func (_c *MockChatMessageRepository_ListChatMessages_Call) RunAndReturn(run func
 	return _c
 }
 
-// NewMockConversationRepository creates a new instance of MockConversationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockConversationRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockConversationRepository {
-	mock := &MockConversationRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockConversationRepository is an autogenerated mock type for the ConversationRepository type
-type MockConversationRepository struct {
-	mock.Mock
-}
-
-type MockConversationRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockConversationRepository) EXPECT() *MockConversationRepository_Expecter {
-	return &MockConversationRepository_Expecter{mock: &_m.Mock}
-}
-
-// CreateConversation provides a mock function for the type MockConversationRepository
-func (_mock *MockConversationRepository) CreateConversation(context1 context.Context, s string, conversationTitleSource ConversationTitleSource) (Conversation, error) {
-	ret := _mock.Called(context1, s, conversationTitleSource)
+// SupersedeChatMessages provides a mock function for the type MockChatMessageRepository
+func (_mock *MockChatMessageRepository) SupersedeChatMessages(ctx context.Context, messageIDs []uuid.UUID, supersededAt time.Time) error {
+	ret := _mock.Called(ctx, messageIDs, supersededAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateConversation")
+		panic("no return value specified for SupersedeChatMessages")
 	}
 
-	var r0 Conversation
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ConversationTitleSource) (Conversation, error)); ok {
-		return returnFunc(context1, s, conversationTitleSource)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ConversationTitleSource) Conversation); ok {
-		r0 = returnFunc(context1, s, conversationTitleSource)
-	} else {
-		r0 = ret.Get(0).(Conversation)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ConversationTitleSource) error); ok {
-		r1 = returnFunc(context1, s, conversationTitleSource)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, messageIDs, supersededAt)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockConversationRepository_CreateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversation'
-type MockConversationRepository_CreateConversation_Call struct {
+// MockChatMessageRepository_SupersedeChatMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SupersedeChatMessages'
+type MockChatMessageRepository_SupersedeChatMessages_Call struct {
 	*mock.Call
 }
 
-// CreateConversation is a helper method to define mock.On call
-//   - context1 context.Context
-//   - s string
-//   - conversationTitleSource ConversationTitleSource
-func (_e *MockConversationRepository_Expecter) CreateConversation(context1 interface{}, s interface{}, conversationTitleSource interface{}) *MockConversationRepository_CreateConversation_Call {
-	return &MockConversationRepository_CreateConversation_Call{Call: _e.mock.On("CreateConversation", context1, s, conversationTitleSource)}
+// SupersedeChatMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageIDs []uuid.UUID
+//   - supersededAt time.Time
+func (_e *MockChatMessageRepository_Expecter) SupersedeChatMessages(ctx interface{}, messageIDs interface{}, supersededAt interface{}) *MockChatMessageRepository_SupersedeChatMessages_Call {
+	return &MockChatMessageRepository_SupersedeChatMessages_Call{Call: _e.mock.On("SupersedeChatMessages", ctx, messageIDs, supersededAt)}
 }
 
-func (_c *MockConversationRepository_CreateConversation_Call) Run(run func(context1 context.Context, s string, conversationTitleSource ConversationTitleSource)) *MockConversationRepository_CreateConversation_Call {
+func (_c *MockChatMessageRepository_SupersedeChatMessages_Call) Run(run func(ctx context.Context, messageIDs []uuid.UUID, supersededAt time.Time)) *MockChatMessageRepository_SupersedeChatMessages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 []uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].([]uuid.UUID)
 		}
-		var arg2 ConversationTitleSource
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(ConversationTitleSource)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
@@ -1294,46 +1542,56 @@ func (_c *MockConversationRepository_CreateConversation_Call) Run(run func(conte
 	return _c
 }
 
-func (_c *MockConversationRepository_CreateConversation_Call) Return(conversation Conversation, err error) *MockConversationRepository_CreateConversation_Call {
-	_c.Call.Return(conversation, err)
+func (_c *MockChatMessageRepository_SupersedeChatMessages_Call) Return(err error) *MockChatMessageRepository_SupersedeChatMessages_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockConversationRepository_CreateConversation_Call) RunAndReturn(run func(context1 context.Context, s string, conversationTitleSource ConversationTitleSource) (Conversation, error)) *MockConversationRepository_CreateConversation_Call {
+func (_c *MockChatMessageRepository_SupersedeChatMessages_Call) RunAndReturn(run func(ctx context.Context, messageIDs []uuid.UUID, supersededAt time.Time) error) *MockChatMessageRepository_SupersedeChatMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteConversation provides a mock function for the type MockConversationRepository
-func (_mock *MockConversationRepository) DeleteConversation(context1 context.Context, uUID uuid.UUID) error {
-	ret := _mock.Called(context1, uUID)
+// UpdateChatMessageFeedback provides a mock function for the type MockChatMessageRepository
+func (_mock *MockChatMessageRepository) UpdateChatMessageFeedback(ctx context.Context, messageID uuid.UUID, score int) (bool, error) {
+	ret := _mock.Called(ctx, messageID, score)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteConversation")
+		panic("no return value specified for UpdateChatMessageFeedback")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(context1, uUID)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (bool, error)); ok {
+		return returnFunc(ctx, messageID, score)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) bool); ok {
+		r0 = returnFunc(ctx, messageID, score)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, messageID, score)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConversationRepository_DeleteConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConversation'
-type MockConversationRepository_DeleteConversation_Call struct {
+// MockChatMessageRepository_UpdateChatMessageFeedback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChatMessageFeedback'
+type MockChatMessageRepository_UpdateChatMessageFeedback_Call struct {
 	*mock.Call
 }
 
-// DeleteConversation is a helper method to define mock.On call
-//   - context1 context.Context
-//   - uUID uuid.UUID
-func (_e *MockConversationRepository_Expecter) DeleteConversation(context1 interface{}, uUID interface{}) *MockConversationRepository_DeleteConversation_Call {
-	return &MockConversationRepository_DeleteConversation_Call{Call: _e.mock.On("DeleteConversation", context1, uUID)}
+// UpdateChatMessageFeedback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uuid.UUID
+//   - score int
+func (_e *MockChatMessageRepository_Expecter) UpdateChatMessageFeedback(ctx interface{}, messageID interface{}, score interface{}) *MockChatMessageRepository_UpdateChatMessageFeedback_Call {
+	return &MockChatMessageRepository_UpdateChatMessageFeedback_Call{Call: _e.mock.On("UpdateChatMessageFeedback", ctx, messageID, score)}
 }
 
-func (_c *MockConversationRepository_DeleteConversation_Call) Run(run func(context1 context.Context, uUID uuid.UUID)) *MockConversationRepository_DeleteConversation_Call {
+func (_c *MockChatMessageRepository_UpdateChatMessageFeedback_Call) Run(run func(ctx context.Context, messageID uuid.UUID, score int)) *MockChatMessageRepository_UpdateChatMessageFeedback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1343,77 +1601,105 @@ func (_c *MockConversationRepository_DeleteConversation_Call) Run(run func(conte
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConversationRepository_DeleteConversation_Call) Return(err error) *MockConversationRepository_DeleteConversation_Call {
-	_c.Call.Return(err)
+func (_c *MockChatMessageRepository_UpdateChatMessageFeedback_Call) Return(b bool, err error) *MockChatMessageRepository_UpdateChatMessageFeedback_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockConversationRepository_DeleteConversation_Call) RunAndReturn(run func(context1 context.Context, uUID uuid.UUID) error) *MockConversationRepository_DeleteConversation_Call {
+func (_c *MockChatMessageRepository_UpdateChatMessageFeedback_Call) RunAndReturn(run func(ctx context.Context, messageID uuid.UUID, score int) (bool, error)) *MockChatMessageRepository_UpdateChatMessageFeedback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConversation provides a mock function for the type MockConversationRepository
-func (_mock *MockConversationRepository) GetConversation(context1 context.Context, uUID uuid.UUID) (Conversation, bool, error) {
-	ret := _mock.Called(context1, uUID)
+// NewMockChatMessagePartitionRepository creates a new instance of MockChatMessagePartitionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChatMessagePartitionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChatMessagePartitionRepository {
+	mock := &MockChatMessagePartitionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChatMessagePartitionRepository is an autogenerated mock type for the ChatMessagePartitionRepository type
+type MockChatMessagePartitionRepository struct {
+	mock.Mock
+}
+
+type MockChatMessagePartitionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChatMessagePartitionRepository) EXPECT() *MockChatMessagePartitionRepository_Expecter {
+	return &MockChatMessagePartitionRepository_Expecter{mock: &_m.Mock}
+}
+
+// DropChatMessagePartitionsBefore provides a mock function for the type MockChatMessagePartitionRepository
+func (_mock *MockChatMessagePartitionRepository) DropChatMessagePartitionsBefore(ctx context.Context, before time.Time) ([]time.Time, error) {
+	ret := _mock.Called(ctx, before)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConversation")
+		panic("no return value specified for DropChatMessagePartitionsBefore")
 	}
 
-	var r0 Conversation
-	var r1 bool
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Conversation, bool, error)); ok {
-		return returnFunc(context1, uUID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Conversation); ok {
-		r0 = returnFunc(context1, uUID)
-	} else {
-		r0 = ret.Get(0).(Conversation)
+	var r0 []time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]time.Time, error)); ok {
+		return returnFunc(ctx, before)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
-		r1 = returnFunc(context1, uUID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []time.Time); ok {
+		r0 = returnFunc(ctx, before)
 	} else {
-		r1 = ret.Get(1).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
-		r2 = returnFunc(context1, uUID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, before)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockConversationRepository_GetConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversation'
-type MockConversationRepository_GetConversation_Call struct {
+// MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropChatMessagePartitionsBefore'
+type MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call struct {
 	*mock.Call
 }
 
-// GetConversation is a helper method to define mock.On call
-//   - context1 context.Context
-//   - uUID uuid.UUID
-func (_e *MockConversationRepository_Expecter) GetConversation(context1 interface{}, uUID interface{}) *MockConversationRepository_GetConversation_Call {
-	return &MockConversationRepository_GetConversation_Call{Call: _e.mock.On("GetConversation", context1, uUID)}
+// DropChatMessagePartitionsBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockChatMessagePartitionRepository_Expecter) DropChatMessagePartitionsBefore(ctx interface{}, before interface{}) *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call {
+	return &MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call{Call: _e.mock.On("DropChatMessagePartitionsBefore", ctx, before)}
 }
 
-func (_c *MockConversationRepository_GetConversation_Call) Run(run func(context1 context.Context, uUID uuid.UUID)) *MockConversationRepository_GetConversation_Call {
+func (_c *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call) Run(run func(ctx context.Context, before time.Time)) *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(time.Time)
 		}
 		run(
 			arg0,
@@ -1423,202 +1709,206 @@ func (_c *MockConversationRepository_GetConversation_Call) Run(run func(context1
 	return _c
 }
 
-func (_c *MockConversationRepository_GetConversation_Call) Return(conversation Conversation, b bool, err error) *MockConversationRepository_GetConversation_Call {
-	_c.Call.Return(conversation, b, err)
+func (_c *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call) Return(times []time.Time, err error) *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call {
+	_c.Call.Return(times, err)
 	return _c
 }
 
-func (_c *MockConversationRepository_GetConversation_Call) RunAndReturn(run func(context1 context.Context, uUID uuid.UUID) (Conversation, bool, error)) *MockConversationRepository_GetConversation_Call {
+func (_c *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call) RunAndReturn(run func(ctx context.Context, before time.Time) ([]time.Time, error)) *MockChatMessagePartitionRepository_DropChatMessagePartitionsBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConversationContextTokenUsage provides a mock function for the type MockConversationRepository
-func (_mock *MockConversationRepository) GetConversationContextTokenUsage(context1 context.Context, uUIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
-	ret := _mock.Called(context1, uUIDs)
+// EnsureChatMessagePartitions provides a mock function for the type MockChatMessagePartitionRepository
+func (_mock *MockChatMessagePartitionRepository) EnsureChatMessagePartitions(ctx context.Context, from time.Time, to time.Time) error {
+	ret := _mock.Called(ctx, from, to)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConversationContextTokenUsage")
+		panic("no return value specified for EnsureChatMessagePartitions")
 	}
 
-	var r0 map[uuid.UUID]int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)); ok {
-		return returnFunc(context1, uUIDs)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]int64); ok {
-		r0 = returnFunc(context1, uUIDs)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[uuid.UUID]int64)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = returnFunc(context1, uUIDs)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, from, to)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockConversationRepository_GetConversationContextTokenUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationContextTokenUsage'
-type MockConversationRepository_GetConversationContextTokenUsage_Call struct {
+// MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureChatMessagePartitions'
+type MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call struct {
 	*mock.Call
 }
 
-// GetConversationContextTokenUsage is a helper method to define mock.On call
-//   - context1 context.Context
-//   - uUIDs []uuid.UUID
-func (_e *MockConversationRepository_Expecter) GetConversationContextTokenUsage(context1 interface{}, uUIDs interface{}) *MockConversationRepository_GetConversationContextTokenUsage_Call {
-	return &MockConversationRepository_GetConversationContextTokenUsage_Call{Call: _e.mock.On("GetConversationContextTokenUsage", context1, uUIDs)}
+// EnsureChatMessagePartitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockChatMessagePartitionRepository_Expecter) EnsureChatMessagePartitions(ctx interface{}, from interface{}, to interface{}) *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call {
+	return &MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call{Call: _e.mock.On("EnsureChatMessagePartitions", ctx, from, to)}
 }
 
-func (_c *MockConversationRepository_GetConversationContextTokenUsage_Call) Run(run func(context1 context.Context, uUIDs []uuid.UUID)) *MockConversationRepository_GetConversationContextTokenUsage_Call {
+func (_c *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []uuid.UUID
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].([]uuid.UUID)
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConversationRepository_GetConversationContextTokenUsage_Call) Return(uUIDToInt64 map[uuid.UUID]int64, err error) *MockConversationRepository_GetConversationContextTokenUsage_Call {
-	_c.Call.Return(uUIDToInt64, err)
+func (_c *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call) Return(err error) *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockConversationRepository_GetConversationContextTokenUsage_Call) RunAndReturn(run func(context1 context.Context, uUIDs []uuid.UUID) (map[uuid.UUID]int64, error)) *MockConversationRepository_GetConversationContextTokenUsage_Call {
+func (_c *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) error) *MockChatMessagePartitionRepository_EnsureChatMessagePartitions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConversations provides a mock function for the type MockConversationRepository
-func (_mock *MockConversationRepository) ListConversations(ctx context.Context, page int, pageSize int) ([]Conversation, bool, error) {
-	ret := _mock.Called(ctx, page, pageSize)
+// NewMockContentBlobRepository creates a new instance of MockContentBlobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContentBlobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContentBlobRepository {
+	mock := &MockContentBlobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockContentBlobRepository is an autogenerated mock type for the ContentBlobRepository type
+type MockContentBlobRepository struct {
+	mock.Mock
+}
+
+type MockContentBlobRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContentBlobRepository) EXPECT() *MockContentBlobRepository_Expecter {
+	return &MockContentBlobRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteUnreferencedContentBlobs provides a mock function for the type MockContentBlobRepository
+func (_mock *MockContentBlobRepository) DeleteUnreferencedContentBlobs(ctx context.Context) error {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConversations")
+		panic("no return value specified for DeleteUnreferencedContentBlobs")
 	}
 
-	var r0 []Conversation
-	var r1 bool
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]Conversation, bool, error)); ok {
-		return returnFunc(ctx, page, pageSize)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []Conversation); ok {
-		r0 = returnFunc(ctx, page, pageSize)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Conversation)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) bool); ok {
-		r1 = returnFunc(ctx, page, pageSize)
-	} else {
-		r1 = ret.Get(1).(bool)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
-		r2 = returnFunc(ctx, page, pageSize)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Error(0)
 	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockConversationRepository_ListConversations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConversations'
-type MockConversationRepository_ListConversations_Call struct {
+// MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUnreferencedContentBlobs'
+type MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call struct {
 	*mock.Call
 }
 
-// ListConversations is a helper method to define mock.On call
+// DeleteUnreferencedContentBlobs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-//   - pageSize int
-func (_e *MockConversationRepository_Expecter) ListConversations(ctx interface{}, page interface{}, pageSize interface{}) *MockConversationRepository_ListConversations_Call {
-	return &MockConversationRepository_ListConversations_Call{Call: _e.mock.On("ListConversations", ctx, page, pageSize)}
+func (_e *MockContentBlobRepository_Expecter) DeleteUnreferencedContentBlobs(ctx interface{}) *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call {
+	return &MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call{Call: _e.mock.On("DeleteUnreferencedContentBlobs", ctx)}
 }
 
-func (_c *MockConversationRepository_ListConversations_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockConversationRepository_ListConversations_Call {
+func (_c *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call) Run(run func(ctx context.Context)) *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
-		if args[1] != nil {
-			arg1 = args[1].(int)
-		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
 		run(
 			arg0,
-			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConversationRepository_ListConversations_Call) Return(conversations []Conversation, b bool, err error) *MockConversationRepository_ListConversations_Call {
-	_c.Call.Return(conversations, b, err)
+func (_c *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call) Return(err error) *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockConversationRepository_ListConversations_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int) ([]Conversation, bool, error)) *MockConversationRepository_ListConversations_Call {
+func (_c *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call) RunAndReturn(run func(ctx context.Context) error) *MockContentBlobRepository_DeleteUnreferencedContentBlobs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateConversation provides a mock function for the type MockConversationRepository
-func (_mock *MockConversationRepository) UpdateConversation(context1 context.Context, conversation Conversation) error {
-	ret := _mock.Called(context1, conversation)
+// ListContentBlobs provides a mock function for the type MockContentBlobRepository
+func (_mock *MockContentBlobRepository) ListContentBlobs(ctx context.Context, hashes []string) ([]ContentBlob, error) {
+	ret := _mock.Called(ctx, hashes)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateConversation")
+		panic("no return value specified for ListContentBlobs")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, Conversation) error); ok {
-		r0 = returnFunc(context1, conversation)
+	var r0 []ContentBlob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]ContentBlob, error)); ok {
+		return returnFunc(ctx, hashes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []ContentBlob); ok {
+		r0 = returnFunc(ctx, hashes)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ContentBlob)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, hashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConversationRepository_UpdateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConversation'
-type MockConversationRepository_UpdateConversation_Call struct {
+// MockContentBlobRepository_ListContentBlobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListContentBlobs'
+type MockContentBlobRepository_ListContentBlobs_Call struct {
 	*mock.Call
 }
 
-// UpdateConversation is a helper method to define mock.On call
-//   - context1 context.Context
-//   - conversation Conversation
-func (_e *MockConversationRepository_Expecter) UpdateConversation(context1 interface{}, conversation interface{}) *MockConversationRepository_UpdateConversation_Call {
-	return &MockConversationRepository_UpdateConversation_Call{Call: _e.mock.On("UpdateConversation", context1, conversation)}
+// ListContentBlobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashes []string
+func (_e *MockContentBlobRepository_Expecter) ListContentBlobs(ctx interface{}, hashes interface{}) *MockContentBlobRepository_ListContentBlobs_Call {
+	return &MockContentBlobRepository_ListContentBlobs_Call{Call: _e.mock.On("ListContentBlobs", ctx, hashes)}
 }
 
-func (_c *MockConversationRepository_UpdateConversation_Call) Run(run func(context1 context.Context, conversation Conversation)) *MockConversationRepository_UpdateConversation_Call {
+func (_c *MockContentBlobRepository_ListContentBlobs_Call) Run(run func(ctx context.Context, hashes []string)) *MockContentBlobRepository_ListContentBlobs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 Conversation
+		var arg1 []string
 		if args[1] != nil {
-			arg1 = args[1].(Conversation)
+			arg1 = args[1].([]string)
 		}
 		run(
 			arg0,
@@ -1628,23 +1918,80 @@ func (_c *MockConversationRepository_UpdateConversation_Call) Run(run func(conte
 	return _c
 }
 
-func (_c *MockConversationRepository_UpdateConversation_Call) Return(err error) *MockConversationRepository_UpdateConversation_Call {
-	_c.Call.Return(err)
+func (_c *MockContentBlobRepository_ListContentBlobs_Call) Return(contentBlobs []ContentBlob, err error) *MockContentBlobRepository_ListContentBlobs_Call {
+	_c.Call.Return(contentBlobs, err)
 	return _c
 }
 
-func (_c *MockConversationRepository_UpdateConversation_Call) RunAndReturn(run func(context1 context.Context, conversation Conversation) error) *MockConversationRepository_UpdateConversation_Call {
+func (_c *MockContentBlobRepository_ListContentBlobs_Call) RunAndReturn(run func(ctx context.Context, hashes []string) ([]ContentBlob, error)) *MockContentBlobRepository_ListContentBlobs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockConversationSummaryRepository creates a new instance of MockConversationSummaryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockConversationSummaryRepository(t interface {
-	mock.TestingT
+// SaveContentBlob provides a mock function for the type MockContentBlobRepository
+func (_mock *MockContentBlobRepository) SaveContentBlob(ctx context.Context, blob ContentBlob) error {
+	ret := _mock.Called(ctx, blob)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveContentBlob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ContentBlob) error); ok {
+		r0 = returnFunc(ctx, blob)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockContentBlobRepository_SaveContentBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveContentBlob'
+type MockContentBlobRepository_SaveContentBlob_Call struct {
+	*mock.Call
+}
+
+// SaveContentBlob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blob ContentBlob
+func (_e *MockContentBlobRepository_Expecter) SaveContentBlob(ctx interface{}, blob interface{}) *MockContentBlobRepository_SaveContentBlob_Call {
+	return &MockContentBlobRepository_SaveContentBlob_Call{Call: _e.mock.On("SaveContentBlob", ctx, blob)}
+}
+
+func (_c *MockContentBlobRepository_SaveContentBlob_Call) Run(run func(ctx context.Context, blob ContentBlob)) *MockContentBlobRepository_SaveContentBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ContentBlob
+		if args[1] != nil {
+			arg1 = args[1].(ContentBlob)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockContentBlobRepository_SaveContentBlob_Call) Return(err error) *MockContentBlobRepository_SaveContentBlob_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockContentBlobRepository_SaveContentBlob_Call) RunAndReturn(run func(ctx context.Context, blob ContentBlob) error) *MockContentBlobRepository_SaveContentBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationRepository creates a new instance of MockConversationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationRepository(t interface {
+	mock.TestingT
 	Cleanup(func())
-}) *MockConversationSummaryRepository {
-	mock := &MockConversationSummaryRepository{}
+}) *MockConversationRepository {
+	mock := &MockConversationRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1652,49 +1999,121 @@ func NewMockConversationSummaryRepository(t interface {
 	return mock
 }
 
-// MockConversationSummaryRepository is an autogenerated mock type for the ConversationSummaryRepository type
-type MockConversationSummaryRepository struct {
+// MockConversationRepository is an autogenerated mock type for the ConversationRepository type
+type MockConversationRepository struct {
 	mock.Mock
 }
 
-type MockConversationSummaryRepository_Expecter struct {
+type MockConversationRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockConversationSummaryRepository) EXPECT() *MockConversationSummaryRepository_Expecter {
-	return &MockConversationSummaryRepository_Expecter{mock: &_m.Mock}
+func (_m *MockConversationRepository) EXPECT() *MockConversationRepository_Expecter {
+	return &MockConversationRepository_Expecter{mock: &_m.Mock}
 }
 
-// DeleteConversationSummary provides a mock function for the type MockConversationSummaryRepository
-func (_mock *MockConversationSummaryRepository) DeleteConversationSummary(ctx context.Context, conversationID uuid.UUID) error {
-	ret := _mock.Called(ctx, conversationID)
+// CreateConversation provides a mock function for the type MockConversationRepository
+func (_mock *MockConversationRepository) CreateConversation(context1 context.Context, s string, conversationTitleSource ConversationTitleSource) (Conversation, error) {
+	ret := _mock.Called(context1, s, conversationTitleSource)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteConversationSummary")
+		panic("no return value specified for CreateConversation")
+	}
+
+	var r0 Conversation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ConversationTitleSource) (Conversation, error)); ok {
+		return returnFunc(context1, s, conversationTitleSource)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ConversationTitleSource) Conversation); ok {
+		r0 = returnFunc(context1, s, conversationTitleSource)
+	} else {
+		r0 = ret.Get(0).(Conversation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ConversationTitleSource) error); ok {
+		r1 = returnFunc(context1, s, conversationTitleSource)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConversationRepository_CreateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversation'
+type MockConversationRepository_CreateConversation_Call struct {
+	*mock.Call
+}
+
+// CreateConversation is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+//   - conversationTitleSource ConversationTitleSource
+func (_e *MockConversationRepository_Expecter) CreateConversation(context1 interface{}, s interface{}, conversationTitleSource interface{}) *MockConversationRepository_CreateConversation_Call {
+	return &MockConversationRepository_CreateConversation_Call{Call: _e.mock.On("CreateConversation", context1, s, conversationTitleSource)}
+}
+
+func (_c *MockConversationRepository_CreateConversation_Call) Run(run func(context1 context.Context, s string, conversationTitleSource ConversationTitleSource)) *MockConversationRepository_CreateConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 ConversationTitleSource
+		if args[2] != nil {
+			arg2 = args[2].(ConversationTitleSource)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationRepository_CreateConversation_Call) Return(conversation Conversation, err error) *MockConversationRepository_CreateConversation_Call {
+	_c.Call.Return(conversation, err)
+	return _c
+}
+
+func (_c *MockConversationRepository_CreateConversation_Call) RunAndReturn(run func(context1 context.Context, s string, conversationTitleSource ConversationTitleSource) (Conversation, error)) *MockConversationRepository_CreateConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteConversation provides a mock function for the type MockConversationRepository
+func (_mock *MockConversationRepository) DeleteConversation(context1 context.Context, uUID uuid.UUID) error {
+	ret := _mock.Called(context1, uUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConversation")
 	}
 
 	var r0 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, conversationID)
+		r0 = returnFunc(context1, uUID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockConversationSummaryRepository_DeleteConversationSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConversationSummary'
-type MockConversationSummaryRepository_DeleteConversationSummary_Call struct {
+// MockConversationRepository_DeleteConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConversation'
+type MockConversationRepository_DeleteConversation_Call struct {
 	*mock.Call
 }
 
-// DeleteConversationSummary is a helper method to define mock.On call
-//   - ctx context.Context
-//   - conversationID uuid.UUID
-func (_e *MockConversationSummaryRepository_Expecter) DeleteConversationSummary(ctx interface{}, conversationID interface{}) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
-	return &MockConversationSummaryRepository_DeleteConversationSummary_Call{Call: _e.mock.On("DeleteConversationSummary", ctx, conversationID)}
+// DeleteConversation is a helper method to define mock.On call
+//   - context1 context.Context
+//   - uUID uuid.UUID
+func (_e *MockConversationRepository_Expecter) DeleteConversation(context1 interface{}, uUID interface{}) *MockConversationRepository_DeleteConversation_Call {
+	return &MockConversationRepository_DeleteConversation_Call{Call: _e.mock.On("DeleteConversation", context1, uUID)}
 }
 
-func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+func (_c *MockConversationRepository_DeleteConversation_Call) Run(run func(context1 context.Context, uUID uuid.UUID)) *MockConversationRepository_DeleteConversation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1712,61 +2131,61 @@ func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) Run(
 	return _c
 }
 
-func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) Return(err error) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+func (_c *MockConversationRepository_DeleteConversation_Call) Return(err error) *MockConversationRepository_DeleteConversation_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+func (_c *MockConversationRepository_DeleteConversation_Call) RunAndReturn(run func(context1 context.Context, uUID uuid.UUID) error) *MockConversationRepository_DeleteConversation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConversationSummary provides a mock function for the type MockConversationSummaryRepository
-func (_mock *MockConversationSummaryRepository) GetConversationSummary(ctx context.Context, conversationID uuid.UUID) (ConversationSummary, bool, error) {
-	ret := _mock.Called(ctx, conversationID)
+// GetConversation provides a mock function for the type MockConversationRepository
+func (_mock *MockConversationRepository) GetConversation(context1 context.Context, uUID uuid.UUID) (Conversation, bool, error) {
+	ret := _mock.Called(context1, uUID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConversationSummary")
+		panic("no return value specified for GetConversation")
 	}
 
-	var r0 ConversationSummary
+	var r0 Conversation
 	var r1 bool
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (ConversationSummary, bool, error)); ok {
-		return returnFunc(ctx, conversationID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Conversation, bool, error)); ok {
+		return returnFunc(context1, uUID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ConversationSummary); ok {
-		r0 = returnFunc(ctx, conversationID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Conversation); ok {
+		r0 = returnFunc(context1, uUID)
 	} else {
-		r0 = ret.Get(0).(ConversationSummary)
+		r0 = ret.Get(0).(Conversation)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
-		r1 = returnFunc(ctx, conversationID)
+		r1 = returnFunc(context1, uUID)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
 	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
-		r2 = returnFunc(ctx, conversationID)
+		r2 = returnFunc(context1, uUID)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockConversationSummaryRepository_GetConversationSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationSummary'
-type MockConversationSummaryRepository_GetConversationSummary_Call struct {
+// MockConversationRepository_GetConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversation'
+type MockConversationRepository_GetConversation_Call struct {
 	*mock.Call
 }
 
-// GetConversationSummary is a helper method to define mock.On call
-//   - ctx context.Context
-//   - conversationID uuid.UUID
-func (_e *MockConversationSummaryRepository_Expecter) GetConversationSummary(ctx interface{}, conversationID interface{}) *MockConversationSummaryRepository_GetConversationSummary_Call {
-	return &MockConversationSummaryRepository_GetConversationSummary_Call{Call: _e.mock.On("GetConversationSummary", ctx, conversationID)}
+// GetConversation is a helper method to define mock.On call
+//   - context1 context.Context
+//   - uUID uuid.UUID
+func (_e *MockConversationRepository_Expecter) GetConversation(context1 interface{}, uUID interface{}) *MockConversationRepository_GetConversation_Call {
+	return &MockConversationRepository_GetConversation_Call{Call: _e.mock.On("GetConversation", context1, uUID)}
 }
 
-func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSummaryRepository_GetConversationSummary_Call {
+func (_c *MockConversationRepository_GetConversation_Call) Run(run func(context1 context.Context, uUID uuid.UUID)) *MockConversationRepository_GetConversation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1784,80 +2203,2645 @@ func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) Run(run
 	return _c
 }
 
-func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) Return(conversationSummary ConversationSummary, b bool, err error) *MockConversationSummaryRepository_GetConversationSummary_Call {
-	_c.Call.Return(conversationSummary, b, err)
+func (_c *MockConversationRepository_GetConversation_Call) Return(conversation Conversation, b bool, err error) *MockConversationRepository_GetConversation_Call {
+	_c.Call.Return(conversation, b, err)
 	return _c
 }
 
-func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) (ConversationSummary, bool, error)) *MockConversationSummaryRepository_GetConversationSummary_Call {
+func (_c *MockConversationRepository_GetConversation_Call) RunAndReturn(run func(context1 context.Context, uUID uuid.UUID) (Conversation, bool, error)) *MockConversationRepository_GetConversation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StoreConversationSummary provides a mock function for the type MockConversationSummaryRepository
-func (_mock *MockConversationSummaryRepository) StoreConversationSummary(ctx context.Context, summary ConversationSummary) error {
-	ret := _mock.Called(ctx, summary)
+// GetConversationContextTokenUsage provides a mock function for the type MockConversationRepository
+func (_mock *MockConversationRepository) GetConversationContextTokenUsage(context1 context.Context, uUIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	ret := _mock.Called(context1, uUIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StoreConversationSummary")
+		panic("no return value specified for GetConversationContextTokenUsage")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ConversationSummary) error); ok {
-		r0 = returnFunc(ctx, summary)
+	var r0 map[uuid.UUID]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)); ok {
+		return returnFunc(context1, uUIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]int64); ok {
+		r0 = returnFunc(context1, uUIDs)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]int64)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(context1, uUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConversationSummaryRepository_StoreConversationSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreConversationSummary'
-type MockConversationSummaryRepository_StoreConversationSummary_Call struct {
+// MockConversationRepository_GetConversationContextTokenUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationContextTokenUsage'
+type MockConversationRepository_GetConversationContextTokenUsage_Call struct {
 	*mock.Call
 }
 
-// StoreConversationSummary is a helper method to define mock.On call
-//   - ctx context.Context
-//   - summary ConversationSummary
-func (_e *MockConversationSummaryRepository_Expecter) StoreConversationSummary(ctx interface{}, summary interface{}) *MockConversationSummaryRepository_StoreConversationSummary_Call {
-	return &MockConversationSummaryRepository_StoreConversationSummary_Call{Call: _e.mock.On("StoreConversationSummary", ctx, summary)}
+// GetConversationContextTokenUsage is a helper method to define mock.On call
+//   - context1 context.Context
+//   - uUIDs []uuid.UUID
+func (_e *MockConversationRepository_Expecter) GetConversationContextTokenUsage(context1 interface{}, uUIDs interface{}) *MockConversationRepository_GetConversationContextTokenUsage_Call {
+	return &MockConversationRepository_GetConversationContextTokenUsage_Call{Call: _e.mock.On("GetConversationContextTokenUsage", context1, uUIDs)}
+}
+
+func (_c *MockConversationRepository_GetConversationContextTokenUsage_Call) Run(run func(context1 context.Context, uUIDs []uuid.UUID)) *MockConversationRepository_GetConversationContextTokenUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationRepository_GetConversationContextTokenUsage_Call) Return(uUIDToInt64 map[uuid.UUID]int64, err error) *MockConversationRepository_GetConversationContextTokenUsage_Call {
+	_c.Call.Return(uUIDToInt64, err)
+	return _c
+}
+
+func (_c *MockConversationRepository_GetConversationContextTokenUsage_Call) RunAndReturn(run func(context1 context.Context, uUIDs []uuid.UUID) (map[uuid.UUID]int64, error)) *MockConversationRepository_GetConversationContextTokenUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConversations provides a mock function for the type MockConversationRepository
+func (_mock *MockConversationRepository) ListConversations(ctx context.Context, page int, pageSize int) ([]Conversation, bool, error) {
+	ret := _mock.Called(ctx, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConversations")
+	}
+
+	var r0 []Conversation
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]Conversation, bool, error)); ok {
+		return returnFunc(ctx, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []Conversation); ok {
+		r0 = returnFunc(ctx, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Conversation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) bool); ok {
+		r1 = returnFunc(ctx, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConversationRepository_ListConversations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConversations'
+type MockConversationRepository_ListConversations_Call struct {
+	*mock.Call
+}
+
+// ListConversations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+func (_e *MockConversationRepository_Expecter) ListConversations(ctx interface{}, page interface{}, pageSize interface{}) *MockConversationRepository_ListConversations_Call {
+	return &MockConversationRepository_ListConversations_Call{Call: _e.mock.On("ListConversations", ctx, page, pageSize)}
+}
+
+func (_c *MockConversationRepository_ListConversations_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockConversationRepository_ListConversations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationRepository_ListConversations_Call) Return(conversations []Conversation, b bool, err error) *MockConversationRepository_ListConversations_Call {
+	_c.Call.Return(conversations, b, err)
+	return _c
+}
+
+func (_c *MockConversationRepository_ListConversations_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int) ([]Conversation, bool, error)) *MockConversationRepository_ListConversations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConversation provides a mock function for the type MockConversationRepository
+func (_mock *MockConversationRepository) UpdateConversation(context1 context.Context, conversation Conversation) error {
+	ret := _mock.Called(context1, conversation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConversation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Conversation) error); ok {
+		r0 = returnFunc(context1, conversation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationRepository_UpdateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConversation'
+type MockConversationRepository_UpdateConversation_Call struct {
+	*mock.Call
+}
+
+// UpdateConversation is a helper method to define mock.On call
+//   - context1 context.Context
+//   - conversation Conversation
+func (_e *MockConversationRepository_Expecter) UpdateConversation(context1 interface{}, conversation interface{}) *MockConversationRepository_UpdateConversation_Call {
+	return &MockConversationRepository_UpdateConversation_Call{Call: _e.mock.On("UpdateConversation", context1, conversation)}
+}
+
+func (_c *MockConversationRepository_UpdateConversation_Call) Run(run func(context1 context.Context, conversation Conversation)) *MockConversationRepository_UpdateConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Conversation
+		if args[1] != nil {
+			arg1 = args[1].(Conversation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationRepository_UpdateConversation_Call) Return(err error) *MockConversationRepository_UpdateConversation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationRepository_UpdateConversation_Call) RunAndReturn(run func(context1 context.Context, conversation Conversation) error) *MockConversationRepository_UpdateConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationChangeRepository creates a new instance of MockConversationChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationChangeRepository {
+	mock := &MockConversationChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationChangeRepository is an autogenerated mock type for the ConversationChangeRepository type
+type MockConversationChangeRepository struct {
+	mock.Mock
+}
+
+type MockConversationChangeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationChangeRepository) EXPECT() *MockConversationChangeRepository_Expecter {
+	return &MockConversationChangeRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListConversationChangesSince provides a mock function for the type MockConversationChangeRepository
+func (_mock *MockConversationChangeRepository) ListConversationChangesSince(ctx context.Context, since int64, limit int) ([]ConversationChange, bool, error) {
+	ret := _mock.Called(ctx, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConversationChangesSince")
+	}
+
+	var r0 []ConversationChange
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) ([]ConversationChange, bool, error)); ok {
+		return returnFunc(ctx, since, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) []ConversationChange); ok {
+		r0 = returnFunc(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ConversationChange)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) bool); ok {
+		r1 = returnFunc(ctx, since, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, int) error); ok {
+		r2 = returnFunc(ctx, since, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConversationChangeRepository_ListConversationChangesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConversationChangesSince'
+type MockConversationChangeRepository_ListConversationChangesSince_Call struct {
+	*mock.Call
+}
+
+// ListConversationChangesSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since int64
+//   - limit int
+func (_e *MockConversationChangeRepository_Expecter) ListConversationChangesSince(ctx interface{}, since interface{}, limit interface{}) *MockConversationChangeRepository_ListConversationChangesSince_Call {
+	return &MockConversationChangeRepository_ListConversationChangesSince_Call{Call: _e.mock.On("ListConversationChangesSince", ctx, since, limit)}
+}
+
+func (_c *MockConversationChangeRepository_ListConversationChangesSince_Call) Run(run func(ctx context.Context, since int64, limit int)) *MockConversationChangeRepository_ListConversationChangesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationChangeRepository_ListConversationChangesSince_Call) Return(conversationChanges []ConversationChange, b bool, err error) *MockConversationChangeRepository_ListConversationChangesSince_Call {
+	_c.Call.Return(conversationChanges, b, err)
+	return _c
+}
+
+func (_c *MockConversationChangeRepository_ListConversationChangesSince_Call) RunAndReturn(run func(ctx context.Context, since int64, limit int) ([]ConversationChange, bool, error)) *MockConversationChangeRepository_ListConversationChangesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationReadCursorRepository creates a new instance of MockConversationReadCursorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationReadCursorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationReadCursorRepository {
+	mock := &MockConversationReadCursorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationReadCursorRepository is an autogenerated mock type for the ConversationReadCursorRepository type
+type MockConversationReadCursorRepository struct {
+	mock.Mock
+}
+
+type MockConversationReadCursorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationReadCursorRepository) EXPECT() *MockConversationReadCursorRepository_Expecter {
+	return &MockConversationReadCursorRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUnreadMessages provides a mock function for the type MockConversationReadCursorRepository
+func (_mock *MockConversationReadCursorRepository) CountUnreadMessages(ctx context.Context, reader string, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	ret := _mock.Called(ctx, reader, conversationIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadMessages")
+	}
+
+	var r0 map[uuid.UUID]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) (map[uuid.UUID]int64, error)); ok {
+		return returnFunc(ctx, reader, conversationIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) map[uuid.UUID]int64); ok {
+		r0 = returnFunc(ctx, reader, conversationIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, reader, conversationIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConversationReadCursorRepository_CountUnreadMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnreadMessages'
+type MockConversationReadCursorRepository_CountUnreadMessages_Call struct {
+	*mock.Call
+}
+
+// CountUnreadMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reader string
+//   - conversationIDs []uuid.UUID
+func (_e *MockConversationReadCursorRepository_Expecter) CountUnreadMessages(ctx interface{}, reader interface{}, conversationIDs interface{}) *MockConversationReadCursorRepository_CountUnreadMessages_Call {
+	return &MockConversationReadCursorRepository_CountUnreadMessages_Call{Call: _e.mock.On("CountUnreadMessages", ctx, reader, conversationIDs)}
+}
+
+func (_c *MockConversationReadCursorRepository_CountUnreadMessages_Call) Run(run func(ctx context.Context, reader string, conversationIDs []uuid.UUID)) *MockConversationReadCursorRepository_CountUnreadMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationReadCursorRepository_CountUnreadMessages_Call) Return(uUIDToInt64 map[uuid.UUID]int64, err error) *MockConversationReadCursorRepository_CountUnreadMessages_Call {
+	_c.Call.Return(uUIDToInt64, err)
+	return _c
+}
+
+func (_c *MockConversationReadCursorRepository_CountUnreadMessages_Call) RunAndReturn(run func(ctx context.Context, reader string, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error)) *MockConversationReadCursorRepository_CountUnreadMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkConversationRead provides a mock function for the type MockConversationReadCursorRepository
+func (_mock *MockConversationReadCursorRepository) MarkConversationRead(ctx context.Context, conversationID uuid.UUID, reader string, messageID *uuid.UUID, readAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, conversationID, reader, messageID, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkConversationRead")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *uuid.UUID, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, conversationID, reader, messageID, readAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *uuid.UUID, time.Time) bool); ok {
+		r0 = returnFunc(ctx, conversationID, reader, messageID, readAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, conversationID, reader, messageID, readAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConversationReadCursorRepository_MarkConversationRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkConversationRead'
+type MockConversationReadCursorRepository_MarkConversationRead_Call struct {
+	*mock.Call
+}
+
+// MarkConversationRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - reader string
+//   - messageID *uuid.UUID
+//   - readAt time.Time
+func (_e *MockConversationReadCursorRepository_Expecter) MarkConversationRead(ctx interface{}, conversationID interface{}, reader interface{}, messageID interface{}, readAt interface{}) *MockConversationReadCursorRepository_MarkConversationRead_Call {
+	return &MockConversationReadCursorRepository_MarkConversationRead_Call{Call: _e.mock.On("MarkConversationRead", ctx, conversationID, reader, messageID, readAt)}
+}
+
+func (_c *MockConversationReadCursorRepository_MarkConversationRead_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, reader string, messageID *uuid.UUID, readAt time.Time)) *MockConversationReadCursorRepository_MarkConversationRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(*uuid.UUID)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationReadCursorRepository_MarkConversationRead_Call) Return(b bool, err error) *MockConversationReadCursorRepository_MarkConversationRead_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockConversationReadCursorRepository_MarkConversationRead_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, reader string, messageID *uuid.UUID, readAt time.Time) (bool, error)) *MockConversationReadCursorRepository_MarkConversationRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationReadModelRepository creates a new instance of MockConversationReadModelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationReadModelRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationReadModelRepository {
+	mock := &MockConversationReadModelRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationReadModelRepository is an autogenerated mock type for the ConversationReadModelRepository type
+type MockConversationReadModelRepository struct {
+	mock.Mock
+}
+
+type MockConversationReadModelRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationReadModelRepository) EXPECT() *MockConversationReadModelRepository_Expecter {
+	return &MockConversationReadModelRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListConversationReadModels provides a mock function for the type MockConversationReadModelRepository
+func (_mock *MockConversationReadModelRepository) ListConversationReadModels(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]ConversationReadModel, error) {
+	ret := _mock.Called(ctx, conversationIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConversationReadModels")
+	}
+
+	var r0 map[uuid.UUID]ConversationReadModel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]ConversationReadModel, error)); ok {
+		return returnFunc(ctx, conversationIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]ConversationReadModel); ok {
+		r0 = returnFunc(ctx, conversationIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]ConversationReadModel)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConversationReadModelRepository_ListConversationReadModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConversationReadModels'
+type MockConversationReadModelRepository_ListConversationReadModels_Call struct {
+	*mock.Call
+}
+
+// ListConversationReadModels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationIDs []uuid.UUID
+func (_e *MockConversationReadModelRepository_Expecter) ListConversationReadModels(ctx interface{}, conversationIDs interface{}) *MockConversationReadModelRepository_ListConversationReadModels_Call {
+	return &MockConversationReadModelRepository_ListConversationReadModels_Call{Call: _e.mock.On("ListConversationReadModels", ctx, conversationIDs)}
+}
+
+func (_c *MockConversationReadModelRepository_ListConversationReadModels_Call) Run(run func(ctx context.Context, conversationIDs []uuid.UUID)) *MockConversationReadModelRepository_ListConversationReadModels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationReadModelRepository_ListConversationReadModels_Call) Return(uUIDToConversationReadModel map[uuid.UUID]ConversationReadModel, err error) *MockConversationReadModelRepository_ListConversationReadModels_Call {
+	_c.Call.Return(uUIDToConversationReadModel, err)
+	return _c
+}
+
+func (_c *MockConversationReadModelRepository_ListConversationReadModels_Call) RunAndReturn(run func(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]ConversationReadModel, error)) *MockConversationReadModelRepository_ListConversationReadModels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshConversationReadModel provides a mock function for the type MockConversationReadModelRepository
+func (_mock *MockConversationReadModelRepository) RefreshConversationReadModel(ctx context.Context, conversationID uuid.UUID, projectedAt time.Time) error {
+	ret := _mock.Called(ctx, conversationID, projectedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshConversationReadModel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, conversationID, projectedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationReadModelRepository_RefreshConversationReadModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshConversationReadModel'
+type MockConversationReadModelRepository_RefreshConversationReadModel_Call struct {
+	*mock.Call
+}
+
+// RefreshConversationReadModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - projectedAt time.Time
+func (_e *MockConversationReadModelRepository_Expecter) RefreshConversationReadModel(ctx interface{}, conversationID interface{}, projectedAt interface{}) *MockConversationReadModelRepository_RefreshConversationReadModel_Call {
+	return &MockConversationReadModelRepository_RefreshConversationReadModel_Call{Call: _e.mock.On("RefreshConversationReadModel", ctx, conversationID, projectedAt)}
+}
+
+func (_c *MockConversationReadModelRepository_RefreshConversationReadModel_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, projectedAt time.Time)) *MockConversationReadModelRepository_RefreshConversationReadModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationReadModelRepository_RefreshConversationReadModel_Call) Return(err error) *MockConversationReadModelRepository_RefreshConversationReadModel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationReadModelRepository_RefreshConversationReadModel_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, projectedAt time.Time) error) *MockConversationReadModelRepository_RefreshConversationReadModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationSnapshotRepository creates a new instance of MockConversationSnapshotRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationSnapshotRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationSnapshotRepository {
+	mock := &MockConversationSnapshotRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationSnapshotRepository is an autogenerated mock type for the ConversationSnapshotRepository type
+type MockConversationSnapshotRepository struct {
+	mock.Mock
+}
+
+type MockConversationSnapshotRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationSnapshotRepository) EXPECT() *MockConversationSnapshotRepository_Expecter {
+	return &MockConversationSnapshotRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteConversationSnapshots provides a mock function for the type MockConversationSnapshotRepository
+func (_mock *MockConversationSnapshotRepository) DeleteConversationSnapshots(ctx context.Context, conversationID uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConversationSnapshots")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationSnapshotRepository_DeleteConversationSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConversationSnapshots'
+type MockConversationSnapshotRepository_DeleteConversationSnapshots_Call struct {
+	*mock.Call
+}
+
+// DeleteConversationSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockConversationSnapshotRepository_Expecter) DeleteConversationSnapshots(ctx interface{}, conversationID interface{}) *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call {
+	return &MockConversationSnapshotRepository_DeleteConversationSnapshots_Call{Call: _e.mock.On("DeleteConversationSnapshots", ctx, conversationID)}
+}
+
+func (_c *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call) Return(err error) *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockConversationSnapshotRepository_DeleteConversationSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestConversationSnapshot provides a mock function for the type MockConversationSnapshotRepository
+func (_mock *MockConversationSnapshotRepository) GetLatestConversationSnapshot(ctx context.Context, conversationID uuid.UUID) (ConversationSnapshot, bool, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestConversationSnapshot")
+	}
+
+	var r0 ConversationSnapshot
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (ConversationSnapshot, bool, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ConversationSnapshot); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Get(0).(ConversationSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, conversationID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestConversationSnapshot'
+type MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetLatestConversationSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockConversationSnapshotRepository_Expecter) GetLatestConversationSnapshot(ctx interface{}, conversationID interface{}) *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call {
+	return &MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call{Call: _e.mock.On("GetLatestConversationSnapshot", ctx, conversationID)}
+}
+
+func (_c *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call) Return(conversationSnapshot ConversationSnapshot, b bool, err error) *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call {
+	_c.Call.Return(conversationSnapshot, b, err)
+	return _c
+}
+
+func (_c *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) (ConversationSnapshot, bool, error)) *MockConversationSnapshotRepository_GetLatestConversationSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreConversationSnapshot provides a mock function for the type MockConversationSnapshotRepository
+func (_mock *MockConversationSnapshotRepository) StoreConversationSnapshot(ctx context.Context, snapshot ConversationSnapshot) error {
+	ret := _mock.Called(ctx, snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreConversationSnapshot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConversationSnapshot) error); ok {
+		r0 = returnFunc(ctx, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationSnapshotRepository_StoreConversationSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreConversationSnapshot'
+type MockConversationSnapshotRepository_StoreConversationSnapshot_Call struct {
+	*mock.Call
+}
+
+// StoreConversationSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshot ConversationSnapshot
+func (_e *MockConversationSnapshotRepository_Expecter) StoreConversationSnapshot(ctx interface{}, snapshot interface{}) *MockConversationSnapshotRepository_StoreConversationSnapshot_Call {
+	return &MockConversationSnapshotRepository_StoreConversationSnapshot_Call{Call: _e.mock.On("StoreConversationSnapshot", ctx, snapshot)}
+}
+
+func (_c *MockConversationSnapshotRepository_StoreConversationSnapshot_Call) Run(run func(ctx context.Context, snapshot ConversationSnapshot)) *MockConversationSnapshotRepository_StoreConversationSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConversationSnapshot
+		if args[1] != nil {
+			arg1 = args[1].(ConversationSnapshot)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationSnapshotRepository_StoreConversationSnapshot_Call) Return(err error) *MockConversationSnapshotRepository_StoreConversationSnapshot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationSnapshotRepository_StoreConversationSnapshot_Call) RunAndReturn(run func(ctx context.Context, snapshot ConversationSnapshot) error) *MockConversationSnapshotRepository_StoreConversationSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationStreams creates a new instance of MockConversationStreams. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationStreams(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationStreams {
+	mock := &MockConversationStreams{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationStreams is an autogenerated mock type for the ConversationStreams type
+type MockConversationStreams struct {
+	mock.Mock
+}
+
+type MockConversationStreams_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationStreams) EXPECT() *MockConversationStreams_Expecter {
+	return &MockConversationStreams_Expecter{mock: &_m.Mock}
+}
+
+// Attach provides a mock function for the type MockConversationStreams
+func (_mock *MockConversationStreams) Attach(conversationID uuid.UUID, onEvent EventCallback) func() {
+	ret := _mock.Called(conversationID, onEvent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 func()
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, EventCallback) func()); ok {
+		r0 = returnFunc(conversationID, onEvent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+	return r0
+}
+
+// MockConversationStreams_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type MockConversationStreams_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - conversationID uuid.UUID
+//   - onEvent EventCallback
+func (_e *MockConversationStreams_Expecter) Attach(conversationID interface{}, onEvent interface{}) *MockConversationStreams_Attach_Call {
+	return &MockConversationStreams_Attach_Call{Call: _e.mock.On("Attach", conversationID, onEvent)}
+}
+
+func (_c *MockConversationStreams_Attach_Call) Run(run func(conversationID uuid.UUID, onEvent EventCallback)) *MockConversationStreams_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uuid.UUID
+		if args[0] != nil {
+			arg0 = args[0].(uuid.UUID)
+		}
+		var arg1 EventCallback
+		if args[1] != nil {
+			arg1 = args[1].(EventCallback)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationStreams_Attach_Call) Return(detach func()) *MockConversationStreams_Attach_Call {
+	_c.Call.Return(detach)
+	return _c
+}
+
+func (_c *MockConversationStreams_Attach_Call) RunAndReturn(run func(conversationID uuid.UUID, onEvent EventCallback) func()) *MockConversationStreams_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Emit provides a mock function for the type MockConversationStreams
+func (_mock *MockConversationStreams) Emit(ctx context.Context, conversationID uuid.UUID, eventType EventType, data any) (bool, error) {
+	ret := _mock.Called(ctx, conversationID, eventType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Emit")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, EventType, any) (bool, error)); ok {
+		return returnFunc(ctx, conversationID, eventType, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, EventType, any) bool); ok {
+		r0 = returnFunc(ctx, conversationID, eventType, data)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, EventType, any) error); ok {
+		r1 = returnFunc(ctx, conversationID, eventType, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConversationStreams_Emit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Emit'
+type MockConversationStreams_Emit_Call struct {
+	*mock.Call
+}
+
+// Emit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - eventType EventType
+//   - data any
+func (_e *MockConversationStreams_Expecter) Emit(ctx interface{}, conversationID interface{}, eventType interface{}, data interface{}) *MockConversationStreams_Emit_Call {
+	return &MockConversationStreams_Emit_Call{Call: _e.mock.On("Emit", ctx, conversationID, eventType, data)}
+}
+
+func (_c *MockConversationStreams_Emit_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, eventType EventType, data any)) *MockConversationStreams_Emit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 EventType
+		if args[2] != nil {
+			arg2 = args[2].(EventType)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationStreams_Emit_Call) Return(b bool, err error) *MockConversationStreams_Emit_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockConversationStreams_Emit_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, eventType EventType, data any) (bool, error)) *MockConversationStreams_Emit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationSummaryRepository creates a new instance of MockConversationSummaryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationSummaryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationSummaryRepository {
+	mock := &MockConversationSummaryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationSummaryRepository is an autogenerated mock type for the ConversationSummaryRepository type
+type MockConversationSummaryRepository struct {
+	mock.Mock
+}
+
+type MockConversationSummaryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationSummaryRepository) EXPECT() *MockConversationSummaryRepository_Expecter {
+	return &MockConversationSummaryRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteConversationSummary provides a mock function for the type MockConversationSummaryRepository
+func (_mock *MockConversationSummaryRepository) DeleteConversationSummary(ctx context.Context, conversationID uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConversationSummary")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationSummaryRepository_DeleteConversationSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteConversationSummary'
+type MockConversationSummaryRepository_DeleteConversationSummary_Call struct {
+	*mock.Call
+}
+
+// DeleteConversationSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockConversationSummaryRepository_Expecter) DeleteConversationSummary(ctx interface{}, conversationID interface{}) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+	return &MockConversationSummaryRepository_DeleteConversationSummary_Call{Call: _e.mock.On("DeleteConversationSummary", ctx, conversationID)}
+}
+
+func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) Return(err error) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationSummaryRepository_DeleteConversationSummary_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockConversationSummaryRepository_DeleteConversationSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConversationSummary provides a mock function for the type MockConversationSummaryRepository
+func (_mock *MockConversationSummaryRepository) GetConversationSummary(ctx context.Context, conversationID uuid.UUID) (ConversationSummary, bool, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversationSummary")
+	}
+
+	var r0 ConversationSummary
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (ConversationSummary, bool, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ConversationSummary); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Get(0).(ConversationSummary)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, conversationID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConversationSummaryRepository_GetConversationSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationSummary'
+type MockConversationSummaryRepository_GetConversationSummary_Call struct {
+	*mock.Call
+}
+
+// GetConversationSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockConversationSummaryRepository_Expecter) GetConversationSummary(ctx interface{}, conversationID interface{}) *MockConversationSummaryRepository_GetConversationSummary_Call {
+	return &MockConversationSummaryRepository_GetConversationSummary_Call{Call: _e.mock.On("GetConversationSummary", ctx, conversationID)}
+}
+
+func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSummaryRepository_GetConversationSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) Return(conversationSummary ConversationSummary, b bool, err error) *MockConversationSummaryRepository_GetConversationSummary_Call {
+	_c.Call.Return(conversationSummary, b, err)
+	return _c
+}
+
+func (_c *MockConversationSummaryRepository_GetConversationSummary_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) (ConversationSummary, bool, error)) *MockConversationSummaryRepository_GetConversationSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreConversationSummary provides a mock function for the type MockConversationSummaryRepository
+func (_mock *MockConversationSummaryRepository) StoreConversationSummary(ctx context.Context, summary ConversationSummary) error {
+	ret := _mock.Called(ctx, summary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreConversationSummary")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConversationSummary) error); ok {
+		r0 = returnFunc(ctx, summary)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationSummaryRepository_StoreConversationSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreConversationSummary'
+type MockConversationSummaryRepository_StoreConversationSummary_Call struct {
+	*mock.Call
+}
+
+// StoreConversationSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - summary ConversationSummary
+func (_e *MockConversationSummaryRepository_Expecter) StoreConversationSummary(ctx interface{}, summary interface{}) *MockConversationSummaryRepository_StoreConversationSummary_Call {
+	return &MockConversationSummaryRepository_StoreConversationSummary_Call{Call: _e.mock.On("StoreConversationSummary", ctx, summary)}
+}
+
+func (_c *MockConversationSummaryRepository_StoreConversationSummary_Call) Run(run func(ctx context.Context, summary ConversationSummary)) *MockConversationSummaryRepository_StoreConversationSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConversationSummary
+		if args[1] != nil {
+			arg1 = args[1].(ConversationSummary)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationSummaryRepository_StoreConversationSummary_Call) Return(err error) *MockConversationSummaryRepository_StoreConversationSummary_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationSummaryRepository_StoreConversationSummary_Call) RunAndReturn(run func(ctx context.Context, summary ConversationSummary) error) *MockConversationSummaryRepository_StoreConversationSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConversationTopicRepository creates a new instance of MockConversationTopicRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationTopicRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationTopicRepository {
+	mock := &MockConversationTopicRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationTopicRepository is an autogenerated mock type for the ConversationTopicRepository type
+type MockConversationTopicRepository struct {
+	mock.Mock
+}
+
+type MockConversationTopicRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationTopicRepository) EXPECT() *MockConversationTopicRepository_Expecter {
+	return &MockConversationTopicRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetConversationTopic provides a mock function for the type MockConversationTopicRepository
+func (_mock *MockConversationTopicRepository) GetConversationTopic(ctx context.Context, conversationID uuid.UUID) (ConversationTopic, bool, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversationTopic")
+	}
+
+	var r0 ConversationTopic
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (ConversationTopic, bool, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ConversationTopic); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Get(0).(ConversationTopic)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, conversationID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockConversationTopicRepository_GetConversationTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversationTopic'
+type MockConversationTopicRepository_GetConversationTopic_Call struct {
+	*mock.Call
+}
+
+// GetConversationTopic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockConversationTopicRepository_Expecter) GetConversationTopic(ctx interface{}, conversationID interface{}) *MockConversationTopicRepository_GetConversationTopic_Call {
+	return &MockConversationTopicRepository_GetConversationTopic_Call{Call: _e.mock.On("GetConversationTopic", ctx, conversationID)}
+}
+
+func (_c *MockConversationTopicRepository_GetConversationTopic_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationTopicRepository_GetConversationTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationTopicRepository_GetConversationTopic_Call) Return(conversationTopic ConversationTopic, b bool, err error) *MockConversationTopicRepository_GetConversationTopic_Call {
+	_c.Call.Return(conversationTopic, b, err)
+	return _c
+}
+
+func (_c *MockConversationTopicRepository_GetConversationTopic_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) (ConversationTopic, bool, error)) *MockConversationTopicRepository_GetConversationTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchRelatedConversations provides a mock function for the type MockConversationTopicRepository
+func (_mock *MockConversationTopicRepository) SearchRelatedConversations(ctx context.Context, embedding []float64, excludeConversationID uuid.UUID, limit int, maxDistance float64) ([]RelatedConversation, error) {
+	ret := _mock.Called(ctx, embedding, excludeConversationID, limit, maxDistance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRelatedConversations")
+	}
+
+	var r0 []RelatedConversation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []float64, uuid.UUID, int, float64) ([]RelatedConversation, error)); ok {
+		return returnFunc(ctx, embedding, excludeConversationID, limit, maxDistance)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []float64, uuid.UUID, int, float64) []RelatedConversation); ok {
+		r0 = returnFunc(ctx, embedding, excludeConversationID, limit, maxDistance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]RelatedConversation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []float64, uuid.UUID, int, float64) error); ok {
+		r1 = returnFunc(ctx, embedding, excludeConversationID, limit, maxDistance)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConversationTopicRepository_SearchRelatedConversations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchRelatedConversations'
+type MockConversationTopicRepository_SearchRelatedConversations_Call struct {
+	*mock.Call
+}
+
+// SearchRelatedConversations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - embedding []float64
+//   - excludeConversationID uuid.UUID
+//   - limit int
+//   - maxDistance float64
+func (_e *MockConversationTopicRepository_Expecter) SearchRelatedConversations(ctx interface{}, embedding interface{}, excludeConversationID interface{}, limit interface{}, maxDistance interface{}) *MockConversationTopicRepository_SearchRelatedConversations_Call {
+	return &MockConversationTopicRepository_SearchRelatedConversations_Call{Call: _e.mock.On("SearchRelatedConversations", ctx, embedding, excludeConversationID, limit, maxDistance)}
+}
+
+func (_c *MockConversationTopicRepository_SearchRelatedConversations_Call) Run(run func(ctx context.Context, embedding []float64, excludeConversationID uuid.UUID, limit int, maxDistance float64)) *MockConversationTopicRepository_SearchRelatedConversations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []float64
+		if args[1] != nil {
+			arg1 = args[1].([]float64)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 float64
+		if args[4] != nil {
+			arg4 = args[4].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationTopicRepository_SearchRelatedConversations_Call) Return(relatedConversations []RelatedConversation, err error) *MockConversationTopicRepository_SearchRelatedConversations_Call {
+	_c.Call.Return(relatedConversations, err)
+	return _c
+}
+
+func (_c *MockConversationTopicRepository_SearchRelatedConversations_Call) RunAndReturn(run func(ctx context.Context, embedding []float64, excludeConversationID uuid.UUID, limit int, maxDistance float64) ([]RelatedConversation, error)) *MockConversationTopicRepository_SearchRelatedConversations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreConversationTopic provides a mock function for the type MockConversationTopicRepository
+func (_mock *MockConversationTopicRepository) StoreConversationTopic(ctx context.Context, topic ConversationTopic) error {
+	ret := _mock.Called(ctx, topic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreConversationTopic")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConversationTopic) error); ok {
+		r0 = returnFunc(ctx, topic)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConversationTopicRepository_StoreConversationTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreConversationTopic'
+type MockConversationTopicRepository_StoreConversationTopic_Call struct {
+	*mock.Call
+}
+
+// StoreConversationTopic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topic ConversationTopic
+func (_e *MockConversationTopicRepository_Expecter) StoreConversationTopic(ctx interface{}, topic interface{}) *MockConversationTopicRepository_StoreConversationTopic_Call {
+	return &MockConversationTopicRepository_StoreConversationTopic_Call{Call: _e.mock.On("StoreConversationTopic", ctx, topic)}
+}
+
+func (_c *MockConversationTopicRepository_StoreConversationTopic_Call) Run(run func(ctx context.Context, topic ConversationTopic)) *MockConversationTopicRepository_StoreConversationTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConversationTopic
+		if args[1] != nil {
+			arg1 = args[1].(ConversationTopic)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConversationTopicRepository_StoreConversationTopic_Call) Return(err error) *MockConversationTopicRepository_StoreConversationTopic_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationTopicRepository_StoreConversationTopic_Call) RunAndReturn(run func(ctx context.Context, topic ConversationTopic) error) *MockConversationTopicRepository_StoreConversationTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExperimentRepository creates a new instance of MockExperimentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExperimentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExperimentRepository {
+	mock := &MockExperimentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExperimentRepository is an autogenerated mock type for the ExperimentRepository type
+type MockExperimentRepository struct {
+	mock.Mock
+}
+
+type MockExperimentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExperimentRepository) EXPECT() *MockExperimentRepository_Expecter {
+	return &MockExperimentRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListVariantOutcomes provides a mock function for the type MockExperimentRepository
+func (_mock *MockExperimentRepository) ListVariantOutcomes(ctx context.Context, experiment string) ([]VariantOutcome, error) {
+	ret := _mock.Called(ctx, experiment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListVariantOutcomes")
+	}
+
+	var r0 []VariantOutcome
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]VariantOutcome, error)); ok {
+		return returnFunc(ctx, experiment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []VariantOutcome); ok {
+		r0 = returnFunc(ctx, experiment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]VariantOutcome)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, experiment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExperimentRepository_ListVariantOutcomes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListVariantOutcomes'
+type MockExperimentRepository_ListVariantOutcomes_Call struct {
+	*mock.Call
+}
+
+// ListVariantOutcomes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experiment string
+func (_e *MockExperimentRepository_Expecter) ListVariantOutcomes(ctx interface{}, experiment interface{}) *MockExperimentRepository_ListVariantOutcomes_Call {
+	return &MockExperimentRepository_ListVariantOutcomes_Call{Call: _e.mock.On("ListVariantOutcomes", ctx, experiment)}
+}
+
+func (_c *MockExperimentRepository_ListVariantOutcomes_Call) Run(run func(ctx context.Context, experiment string)) *MockExperimentRepository_ListVariantOutcomes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExperimentRepository_ListVariantOutcomes_Call) Return(variantOutcomes []VariantOutcome, err error) *MockExperimentRepository_ListVariantOutcomes_Call {
+	_c.Call.Return(variantOutcomes, err)
+	return _c
+}
+
+func (_c *MockExperimentRepository_ListVariantOutcomes_Call) RunAndReturn(run func(ctx context.Context, experiment string) ([]VariantOutcome, error)) *MockExperimentRepository_ListVariantOutcomes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInstructionRepository creates a new instance of MockInstructionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInstructionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInstructionRepository {
+	mock := &MockInstructionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInstructionRepository is an autogenerated mock type for the InstructionRepository type
+type MockInstructionRepository struct {
+	mock.Mock
+}
+
+type MockInstructionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInstructionRepository) EXPECT() *MockInstructionRepository_Expecter {
+	return &MockInstructionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInstruction provides a mock function for the type MockInstructionRepository
+func (_mock *MockInstructionRepository) CreateInstruction(ctx context.Context, instruction Instruction) error {
+	ret := _mock.Called(ctx, instruction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstruction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Instruction) error); ok {
+		r0 = returnFunc(ctx, instruction)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInstructionRepository_CreateInstruction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstruction'
+type MockInstructionRepository_CreateInstruction_Call struct {
+	*mock.Call
+}
+
+// CreateInstruction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instruction Instruction
+func (_e *MockInstructionRepository_Expecter) CreateInstruction(ctx interface{}, instruction interface{}) *MockInstructionRepository_CreateInstruction_Call {
+	return &MockInstructionRepository_CreateInstruction_Call{Call: _e.mock.On("CreateInstruction", ctx, instruction)}
+}
+
+func (_c *MockInstructionRepository_CreateInstruction_Call) Run(run func(ctx context.Context, instruction Instruction)) *MockInstructionRepository_CreateInstruction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Instruction
+		if args[1] != nil {
+			arg1 = args[1].(Instruction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructionRepository_CreateInstruction_Call) Return(err error) *MockInstructionRepository_CreateInstruction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInstructionRepository_CreateInstruction_Call) RunAndReturn(run func(ctx context.Context, instruction Instruction) error) *MockInstructionRepository_CreateInstruction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstruction provides a mock function for the type MockInstructionRepository
+func (_mock *MockInstructionRepository) DeleteInstruction(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstruction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInstructionRepository_DeleteInstruction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstruction'
+type MockInstructionRepository_DeleteInstruction_Call struct {
+	*mock.Call
+}
+
+// DeleteInstruction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockInstructionRepository_Expecter) DeleteInstruction(ctx interface{}, id interface{}) *MockInstructionRepository_DeleteInstruction_Call {
+	return &MockInstructionRepository_DeleteInstruction_Call{Call: _e.mock.On("DeleteInstruction", ctx, id)}
+}
+
+func (_c *MockInstructionRepository_DeleteInstruction_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockInstructionRepository_DeleteInstruction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructionRepository_DeleteInstruction_Call) Return(err error) *MockInstructionRepository_DeleteInstruction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInstructionRepository_DeleteInstruction_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockInstructionRepository_DeleteInstruction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstruction provides a mock function for the type MockInstructionRepository
+func (_mock *MockInstructionRepository) GetInstruction(ctx context.Context, id uuid.UUID) (Instruction, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstruction")
+	}
+
+	var r0 Instruction
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Instruction, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Instruction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Instruction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockInstructionRepository_GetInstruction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstruction'
+type MockInstructionRepository_GetInstruction_Call struct {
+	*mock.Call
+}
+
+// GetInstruction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockInstructionRepository_Expecter) GetInstruction(ctx interface{}, id interface{}) *MockInstructionRepository_GetInstruction_Call {
+	return &MockInstructionRepository_GetInstruction_Call{Call: _e.mock.On("GetInstruction", ctx, id)}
+}
+
+func (_c *MockInstructionRepository_GetInstruction_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockInstructionRepository_GetInstruction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructionRepository_GetInstruction_Call) Return(instruction Instruction, b bool, err error) *MockInstructionRepository_GetInstruction_Call {
+	_c.Call.Return(instruction, b, err)
+	return _c
+}
+
+func (_c *MockInstructionRepository_GetInstruction_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Instruction, bool, error)) *MockInstructionRepository_GetInstruction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstructions provides a mock function for the type MockInstructionRepository
+func (_mock *MockInstructionRepository) ListInstructions(ctx context.Context, conversationID uuid.UUID) ([]Instruction, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstructions")
+	}
+
+	var r0 []Instruction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]Instruction, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []Instruction); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Instruction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInstructionRepository_ListInstructions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstructions'
+type MockInstructionRepository_ListInstructions_Call struct {
+	*mock.Call
+}
+
+// ListInstructions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockInstructionRepository_Expecter) ListInstructions(ctx interface{}, conversationID interface{}) *MockInstructionRepository_ListInstructions_Call {
+	return &MockInstructionRepository_ListInstructions_Call{Call: _e.mock.On("ListInstructions", ctx, conversationID)}
+}
+
+func (_c *MockInstructionRepository_ListInstructions_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockInstructionRepository_ListInstructions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructionRepository_ListInstructions_Call) Return(instructions []Instruction, err error) *MockInstructionRepository_ListInstructions_Call {
+	_c.Call.Return(instructions, err)
+	return _c
+}
+
+func (_c *MockInstructionRepository_ListInstructions_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) ([]Instruction, error)) *MockInstructionRepository_ListInstructions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMemoryRepository creates a new instance of MockMemoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMemoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMemoryRepository {
+	mock := &MockMemoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMemoryRepository is an autogenerated mock type for the MemoryRepository type
+type MockMemoryRepository struct {
+	mock.Mock
+}
+
+type MockMemoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMemoryRepository) EXPECT() *MockMemoryRepository_Expecter {
+	return &MockMemoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMemory provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) CreateMemory(ctx context.Context, memory Memory) error {
+	ret := _mock.Called(ctx, memory)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMemory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Memory) error); ok {
+		r0 = returnFunc(ctx, memory)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMemoryRepository_CreateMemory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMemory'
+type MockMemoryRepository_CreateMemory_Call struct {
+	*mock.Call
+}
+
+// CreateMemory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memory Memory
+func (_e *MockMemoryRepository_Expecter) CreateMemory(ctx interface{}, memory interface{}) *MockMemoryRepository_CreateMemory_Call {
+	return &MockMemoryRepository_CreateMemory_Call{Call: _e.mock.On("CreateMemory", ctx, memory)}
+}
+
+func (_c *MockMemoryRepository_CreateMemory_Call) Run(run func(ctx context.Context, memory Memory)) *MockMemoryRepository_CreateMemory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Memory
+		if args[1] != nil {
+			arg1 = args[1].(Memory)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_CreateMemory_Call) Return(err error) *MockMemoryRepository_CreateMemory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_CreateMemory_Call) RunAndReturn(run func(ctx context.Context, memory Memory) error) *MockMemoryRepository_CreateMemory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMemory provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) DeleteMemory(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMemory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMemoryRepository_DeleteMemory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMemory'
+type MockMemoryRepository_DeleteMemory_Call struct {
+	*mock.Call
+}
+
+// DeleteMemory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMemoryRepository_Expecter) DeleteMemory(ctx interface{}, id interface{}) *MockMemoryRepository_DeleteMemory_Call {
+	return &MockMemoryRepository_DeleteMemory_Call{Call: _e.mock.On("DeleteMemory", ctx, id)}
+}
+
+func (_c *MockMemoryRepository_DeleteMemory_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMemoryRepository_DeleteMemory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_DeleteMemory_Call) Return(err error) *MockMemoryRepository_DeleteMemory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_DeleteMemory_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockMemoryRepository_DeleteMemory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemory provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) GetMemory(ctx context.Context, id uuid.UUID) (Memory, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemory")
+	}
+
+	var r0 Memory
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Memory, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Memory); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Memory)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockMemoryRepository_GetMemory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemory'
+type MockMemoryRepository_GetMemory_Call struct {
+	*mock.Call
+}
+
+// GetMemory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMemoryRepository_Expecter) GetMemory(ctx interface{}, id interface{}) *MockMemoryRepository_GetMemory_Call {
+	return &MockMemoryRepository_GetMemory_Call{Call: _e.mock.On("GetMemory", ctx, id)}
+}
+
+func (_c *MockMemoryRepository_GetMemory_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMemoryRepository_GetMemory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_GetMemory_Call) Return(memory Memory, b bool, err error) *MockMemoryRepository_GetMemory_Call {
+	_c.Call.Return(memory, b, err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_GetMemory_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Memory, bool, error)) *MockMemoryRepository_GetMemory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemoryExtractionCursor provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) GetMemoryExtractionCursor(ctx context.Context, conversationID uuid.UUID) (time.Time, bool, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemoryExtractionCursor")
+	}
+
+	var r0 time.Time
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (time.Time, bool, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) time.Time); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, conversationID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockMemoryRepository_GetMemoryExtractionCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemoryExtractionCursor'
+type MockMemoryRepository_GetMemoryExtractionCursor_Call struct {
+	*mock.Call
+}
+
+// GetMemoryExtractionCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockMemoryRepository_Expecter) GetMemoryExtractionCursor(ctx interface{}, conversationID interface{}) *MockMemoryRepository_GetMemoryExtractionCursor_Call {
+	return &MockMemoryRepository_GetMemoryExtractionCursor_Call{Call: _e.mock.On("GetMemoryExtractionCursor", ctx, conversationID)}
+}
+
+func (_c *MockMemoryRepository_GetMemoryExtractionCursor_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockMemoryRepository_GetMemoryExtractionCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_GetMemoryExtractionCursor_Call) Return(time1 time.Time, b bool, err error) *MockMemoryRepository_GetMemoryExtractionCursor_Call {
+	_c.Call.Return(time1, b, err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_GetMemoryExtractionCursor_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) (time.Time, bool, error)) *MockMemoryRepository_GetMemoryExtractionCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMemories provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) ListMemories(ctx context.Context) ([]Memory, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMemories")
+	}
+
+	var r0 []Memory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Memory, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Memory); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Memory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMemoryRepository_ListMemories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMemories'
+type MockMemoryRepository_ListMemories_Call struct {
+	*mock.Call
+}
+
+// ListMemories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMemoryRepository_Expecter) ListMemories(ctx interface{}) *MockMemoryRepository_ListMemories_Call {
+	return &MockMemoryRepository_ListMemories_Call{Call: _e.mock.On("ListMemories", ctx)}
+}
+
+func (_c *MockMemoryRepository_ListMemories_Call) Run(run func(ctx context.Context)) *MockMemoryRepository_ListMemories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_ListMemories_Call) Return(memorys []Memory, err error) *MockMemoryRepository_ListMemories_Call {
+	_c.Call.Return(memorys, err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_ListMemories_Call) RunAndReturn(run func(ctx context.Context) ([]Memory, error)) *MockMemoryRepository_ListMemories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveMemoryExtractionCursor provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) SaveMemoryExtractionCursor(ctx context.Context, conversationID uuid.UUID, extractedThrough time.Time) error {
+	ret := _mock.Called(ctx, conversationID, extractedThrough)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveMemoryExtractionCursor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, conversationID, extractedThrough)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMemoryRepository_SaveMemoryExtractionCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveMemoryExtractionCursor'
+type MockMemoryRepository_SaveMemoryExtractionCursor_Call struct {
+	*mock.Call
+}
+
+// SaveMemoryExtractionCursor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - extractedThrough time.Time
+func (_e *MockMemoryRepository_Expecter) SaveMemoryExtractionCursor(ctx interface{}, conversationID interface{}, extractedThrough interface{}) *MockMemoryRepository_SaveMemoryExtractionCursor_Call {
+	return &MockMemoryRepository_SaveMemoryExtractionCursor_Call{Call: _e.mock.On("SaveMemoryExtractionCursor", ctx, conversationID, extractedThrough)}
+}
+
+func (_c *MockMemoryRepository_SaveMemoryExtractionCursor_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, extractedThrough time.Time)) *MockMemoryRepository_SaveMemoryExtractionCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_SaveMemoryExtractionCursor_Call) Return(err error) *MockMemoryRepository_SaveMemoryExtractionCursor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_SaveMemoryExtractionCursor_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, extractedThrough time.Time) error) *MockMemoryRepository_SaveMemoryExtractionCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMemories provides a mock function for the type MockMemoryRepository
+func (_mock *MockMemoryRepository) SearchMemories(ctx context.Context, embedding []float64, limit int, maxDistance float64) ([]Memory, error) {
+	ret := _mock.Called(ctx, embedding, limit, maxDistance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMemories")
+	}
+
+	var r0 []Memory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []float64, int, float64) ([]Memory, error)); ok {
+		return returnFunc(ctx, embedding, limit, maxDistance)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []float64, int, float64) []Memory); ok {
+		r0 = returnFunc(ctx, embedding, limit, maxDistance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Memory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []float64, int, float64) error); ok {
+		r1 = returnFunc(ctx, embedding, limit, maxDistance)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMemoryRepository_SearchMemories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMemories'
+type MockMemoryRepository_SearchMemories_Call struct {
+	*mock.Call
+}
+
+// SearchMemories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - embedding []float64
+//   - limit int
+//   - maxDistance float64
+func (_e *MockMemoryRepository_Expecter) SearchMemories(ctx interface{}, embedding interface{}, limit interface{}, maxDistance interface{}) *MockMemoryRepository_SearchMemories_Call {
+	return &MockMemoryRepository_SearchMemories_Call{Call: _e.mock.On("SearchMemories", ctx, embedding, limit, maxDistance)}
+}
+
+func (_c *MockMemoryRepository_SearchMemories_Call) Run(run func(ctx context.Context, embedding []float64, limit int, maxDistance float64)) *MockMemoryRepository_SearchMemories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []float64
+		if args[1] != nil {
+			arg1 = args[1].([]float64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 float64
+		if args[3] != nil {
+			arg3 = args[3].(float64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemoryRepository_SearchMemories_Call) Return(memorys []Memory, err error) *MockMemoryRepository_SearchMemories_Call {
+	_c.Call.Return(memorys, err)
+	return _c
+}
+
+func (_c *MockMemoryRepository_SearchMemories_Call) RunAndReturn(run func(ctx context.Context, embedding []float64, limit int, maxDistance float64) ([]Memory, error)) *MockMemoryRepository_SearchMemories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModelCatalog creates a new instance of MockModelCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModelCatalog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModelCatalog {
+	mock := &MockModelCatalog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModelCatalog is an autogenerated mock type for the ModelCatalog type
+type MockModelCatalog struct {
+	mock.Mock
+}
+
+type MockModelCatalog_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModelCatalog) EXPECT() *MockModelCatalog_Expecter {
+	return &MockModelCatalog_Expecter{mock: &_m.Mock}
+}
+
+// ListModels provides a mock function for the type MockModelCatalog
+func (_mock *MockModelCatalog) ListModels(ctx context.Context) ([]ModelCapabilities, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListModels")
+	}
+
+	var r0 []ModelCapabilities
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ModelCapabilities, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ModelCapabilities); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ModelCapabilities)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModelCatalog_ListModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListModels'
+type MockModelCatalog_ListModels_Call struct {
+	*mock.Call
+}
+
+// ListModels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockModelCatalog_Expecter) ListModels(ctx interface{}) *MockModelCatalog_ListModels_Call {
+	return &MockModelCatalog_ListModels_Call{Call: _e.mock.On("ListModels", ctx)}
+}
+
+func (_c *MockModelCatalog_ListModels_Call) Run(run func(ctx context.Context)) *MockModelCatalog_ListModels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModelCatalog_ListModels_Call) Return(modelCapabilitiess []ModelCapabilities, err error) *MockModelCatalog_ListModels_Call {
+	_c.Call.Return(modelCapabilitiess, err)
+	return _c
+}
+
+func (_c *MockModelCatalog_ListModels_Call) RunAndReturn(run func(ctx context.Context) ([]ModelCapabilities, error)) *MockModelCatalog_ListModels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModerator creates a new instance of MockModerator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModerator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModerator {
+	mock := &MockModerator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModerator is an autogenerated mock type for the Moderator type
+type MockModerator struct {
+	mock.Mock
+}
+
+type MockModerator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModerator) EXPECT() *MockModerator_Expecter {
+	return &MockModerator_Expecter{mock: &_m.Mock}
+}
+
+// Moderate provides a mock function for the type MockModerator
+func (_mock *MockModerator) Moderate(ctx context.Context, content string) (ModerationResult, error) {
+	ret := _mock.Called(ctx, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Moderate")
+	}
+
+	var r0 ModerationResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (ModerationResult, error)); ok {
+		return returnFunc(ctx, content)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ModerationResult); ok {
+		r0 = returnFunc(ctx, content)
+	} else {
+		r0 = ret.Get(0).(ModerationResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerator_Moderate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Moderate'
+type MockModerator_Moderate_Call struct {
+	*mock.Call
+}
+
+// Moderate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content string
+func (_e *MockModerator_Expecter) Moderate(ctx interface{}, content interface{}) *MockModerator_Moderate_Call {
+	return &MockModerator_Moderate_Call{Call: _e.mock.On("Moderate", ctx, content)}
+}
+
+func (_c *MockModerator_Moderate_Call) Run(run func(ctx context.Context, content string)) *MockModerator_Moderate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerator_Moderate_Call) Return(moderationResult ModerationResult, err error) *MockModerator_Moderate_Call {
+	_c.Call.Return(moderationResult, err)
+	return _c
+}
+
+func (_c *MockModerator_Moderate_Call) RunAndReturn(run func(ctx context.Context, content string) (ModerationResult, error)) *MockModerator_Moderate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRedactor creates a new instance of MockRedactor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRedactor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRedactor {
+	mock := &MockRedactor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRedactor is an autogenerated mock type for the Redactor type
+type MockRedactor struct {
+	mock.Mock
+}
+
+type MockRedactor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRedactor) EXPECT() *MockRedactor_Expecter {
+	return &MockRedactor_Expecter{mock: &_m.Mock}
+}
+
+// Redact provides a mock function for the type MockRedactor
+func (_mock *MockRedactor) Redact(content string) (string, error) {
+	ret := _mock.Called(content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redact")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(content)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(content)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(content)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedactor_Redact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redact'
+type MockRedactor_Redact_Call struct {
+	*mock.Call
+}
+
+// Redact is a helper method to define mock.On call
+//   - content string
+func (_e *MockRedactor_Expecter) Redact(content interface{}) *MockRedactor_Redact_Call {
+	return &MockRedactor_Redact_Call{Call: _e.mock.On("Redact", content)}
 }
 
-func (_c *MockConversationSummaryRepository_StoreConversationSummary_Call) Run(run func(ctx context.Context, summary ConversationSummary)) *MockConversationSummaryRepository_StoreConversationSummary_Call {
+func (_c *MockRedactor_Redact_Call) Run(run func(content string)) *MockRedactor_Redact_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 ConversationSummary
-		if args[1] != nil {
-			arg1 = args[1].(ConversationSummary)
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockConversationSummaryRepository_StoreConversationSummary_Call) Return(err error) *MockConversationSummaryRepository_StoreConversationSummary_Call {
-	_c.Call.Return(err)
+func (_c *MockRedactor_Redact_Call) Return(s string, err error) *MockRedactor_Redact_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockConversationSummaryRepository_StoreConversationSummary_Call) RunAndReturn(run func(ctx context.Context, summary ConversationSummary) error) *MockConversationSummaryRepository_StoreConversationSummary_Call {
+func (_c *MockRedactor_Redact_Call) RunAndReturn(run func(content string) (string, error)) *MockRedactor_Redact_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockModelCatalog creates a new instance of MockModelCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockShadowEvaluationRepository creates a new instance of MockShadowEvaluationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockModelCatalog(t interface {
+func NewMockShadowEvaluationRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockModelCatalog {
-	mock := &MockModelCatalog{}
+}) *MockShadowEvaluationRepository {
+	mock := &MockShadowEvaluationRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1865,77 +4849,72 @@ func NewMockModelCatalog(t interface {
 	return mock
 }
 
-// MockModelCatalog is an autogenerated mock type for the ModelCatalog type
-type MockModelCatalog struct {
+// MockShadowEvaluationRepository is an autogenerated mock type for the ShadowEvaluationRepository type
+type MockShadowEvaluationRepository struct {
 	mock.Mock
 }
 
-type MockModelCatalog_Expecter struct {
+type MockShadowEvaluationRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockModelCatalog) EXPECT() *MockModelCatalog_Expecter {
-	return &MockModelCatalog_Expecter{mock: &_m.Mock}
+func (_m *MockShadowEvaluationRepository) EXPECT() *MockShadowEvaluationRepository_Expecter {
+	return &MockShadowEvaluationRepository_Expecter{mock: &_m.Mock}
 }
 
-// ListModels provides a mock function for the type MockModelCatalog
-func (_mock *MockModelCatalog) ListModels(ctx context.Context) ([]ModelCapabilities, error) {
-	ret := _mock.Called(ctx)
+// CreateShadowEvaluation provides a mock function for the type MockShadowEvaluationRepository
+func (_mock *MockShadowEvaluationRepository) CreateShadowEvaluation(ctx context.Context, evaluation ShadowEvaluation) error {
+	ret := _mock.Called(ctx, evaluation)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListModels")
+		panic("no return value specified for CreateShadowEvaluation")
 	}
 
-	var r0 []ModelCapabilities
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ModelCapabilities, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []ModelCapabilities); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]ModelCapabilities)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ShadowEvaluation) error); ok {
+		r0 = returnFunc(ctx, evaluation)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockModelCatalog_ListModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListModels'
-type MockModelCatalog_ListModels_Call struct {
+// MockShadowEvaluationRepository_CreateShadowEvaluation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShadowEvaluation'
+type MockShadowEvaluationRepository_CreateShadowEvaluation_Call struct {
 	*mock.Call
 }
 
-// ListModels is a helper method to define mock.On call
+// CreateShadowEvaluation is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockModelCatalog_Expecter) ListModels(ctx interface{}) *MockModelCatalog_ListModels_Call {
-	return &MockModelCatalog_ListModels_Call{Call: _e.mock.On("ListModels", ctx)}
+//   - evaluation ShadowEvaluation
+func (_e *MockShadowEvaluationRepository_Expecter) CreateShadowEvaluation(ctx interface{}, evaluation interface{}) *MockShadowEvaluationRepository_CreateShadowEvaluation_Call {
+	return &MockShadowEvaluationRepository_CreateShadowEvaluation_Call{Call: _e.mock.On("CreateShadowEvaluation", ctx, evaluation)}
 }
 
-func (_c *MockModelCatalog_ListModels_Call) Run(run func(ctx context.Context)) *MockModelCatalog_ListModels_Call {
+func (_c *MockShadowEvaluationRepository_CreateShadowEvaluation_Call) Run(run func(ctx context.Context, evaluation ShadowEvaluation)) *MockShadowEvaluationRepository_CreateShadowEvaluation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 ShadowEvaluation
+		if args[1] != nil {
+			arg1 = args[1].(ShadowEvaluation)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockModelCatalog_ListModels_Call) Return(modelCapabilitiess []ModelCapabilities, err error) *MockModelCatalog_ListModels_Call {
-	_c.Call.Return(modelCapabilitiess, err)
+func (_c *MockShadowEvaluationRepository_CreateShadowEvaluation_Call) Return(err error) *MockShadowEvaluationRepository_CreateShadowEvaluation_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockModelCatalog_ListModels_Call) RunAndReturn(run func(ctx context.Context) ([]ModelCapabilities, error)) *MockModelCatalog_ListModels_Call {
+func (_c *MockShadowEvaluationRepository_CreateShadowEvaluation_Call) RunAndReturn(run func(ctx context.Context, evaluation ShadowEvaluation) error) *MockShadowEvaluationRepository_CreateShadowEvaluation_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2186,3 +5165,296 @@ func (_c *MockTokenizer_CountTokens_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockWeatherForecaster creates a new instance of MockWeatherForecaster. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWeatherForecaster(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWeatherForecaster {
+	mock := &MockWeatherForecaster{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWeatherForecaster is an autogenerated mock type for the WeatherForecaster type
+type MockWeatherForecaster struct {
+	mock.Mock
+}
+
+type MockWeatherForecaster_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWeatherForecaster) EXPECT() *MockWeatherForecaster_Expecter {
+	return &MockWeatherForecaster_Expecter{mock: &_m.Mock}
+}
+
+// Forecast provides a mock function for the type MockWeatherForecaster
+func (_mock *MockWeatherForecaster) Forecast(ctx context.Context, location string, days int) (WeatherForecast, error) {
+	ret := _mock.Called(ctx, location, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Forecast")
+	}
+
+	var r0 WeatherForecast
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (WeatherForecast, error)); ok {
+		return returnFunc(ctx, location, days)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) WeatherForecast); ok {
+		r0 = returnFunc(ctx, location, days)
+	} else {
+		r0 = ret.Get(0).(WeatherForecast)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, location, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWeatherForecaster_Forecast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forecast'
+type MockWeatherForecaster_Forecast_Call struct {
+	*mock.Call
+}
+
+// Forecast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - location string
+//   - days int
+func (_e *MockWeatherForecaster_Expecter) Forecast(ctx interface{}, location interface{}, days interface{}) *MockWeatherForecaster_Forecast_Call {
+	return &MockWeatherForecaster_Forecast_Call{Call: _e.mock.On("Forecast", ctx, location, days)}
+}
+
+func (_c *MockWeatherForecaster_Forecast_Call) Run(run func(ctx context.Context, location string, days int)) *MockWeatherForecaster_Forecast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWeatherForecaster_Forecast_Call) Return(weatherForecast WeatherForecast, err error) *MockWeatherForecaster_Forecast_Call {
+	_c.Call.Return(weatherForecast, err)
+	return _c
+}
+
+func (_c *MockWeatherForecaster_Forecast_Call) RunAndReturn(run func(ctx context.Context, location string, days int) (WeatherForecast, error)) *MockWeatherForecaster_Forecast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebSearcher creates a new instance of MockWebSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebSearcher {
+	mock := &MockWebSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebSearcher is an autogenerated mock type for the WebSearcher type
+type MockWebSearcher struct {
+	mock.Mock
+}
+
+type MockWebSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebSearcher) EXPECT() *MockWebSearcher_Expecter {
+	return &MockWebSearcher_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function for the type MockWebSearcher
+func (_mock *MockWebSearcher) Search(ctx context.Context, query string, limit int) ([]WebSearchResult, error) {
+	ret := _mock.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []WebSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]WebSearchResult, error)); ok {
+		return returnFunc(ctx, query, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []WebSearchResult); ok {
+		r0 = returnFunc(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WebSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebSearcher_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockWebSearcher_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - limit int
+func (_e *MockWebSearcher_Expecter) Search(ctx interface{}, query interface{}, limit interface{}) *MockWebSearcher_Search_Call {
+	return &MockWebSearcher_Search_Call{Call: _e.mock.On("Search", ctx, query, limit)}
+}
+
+func (_c *MockWebSearcher_Search_Call) Run(run func(ctx context.Context, query string, limit int)) *MockWebSearcher_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebSearcher_Search_Call) Return(webSearchResults []WebSearchResult, err error) *MockWebSearcher_Search_Call {
+	_c.Call.Return(webSearchResults, err)
+	return _c
+}
+
+func (_c *MockWebSearcher_Search_Call) RunAndReturn(run func(ctx context.Context, query string, limit int) ([]WebSearchResult, error)) *MockWebSearcher_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebPageReader creates a new instance of MockWebPageReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebPageReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebPageReader {
+	mock := &MockWebPageReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebPageReader is an autogenerated mock type for the WebPageReader type
+type MockWebPageReader struct {
+	mock.Mock
+}
+
+type MockWebPageReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebPageReader) EXPECT() *MockWebPageReader_Expecter {
+	return &MockWebPageReader_Expecter{mock: &_m.Mock}
+}
+
+// Read provides a mock function for the type MockWebPageReader
+func (_mock *MockWebPageReader) Read(ctx context.Context, rawURL string) (WebPage, error) {
+	ret := _mock.Called(ctx, rawURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 WebPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (WebPage, error)); ok {
+		return returnFunc(ctx, rawURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) WebPage); ok {
+		r0 = returnFunc(ctx, rawURL)
+	} else {
+		r0 = ret.Get(0).(WebPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, rawURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebPageReader_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type MockWebPageReader_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rawURL string
+func (_e *MockWebPageReader_Expecter) Read(ctx interface{}, rawURL interface{}) *MockWebPageReader_Read_Call {
+	return &MockWebPageReader_Read_Call{Call: _e.mock.On("Read", ctx, rawURL)}
+}
+
+func (_c *MockWebPageReader_Read_Call) Run(run func(ctx context.Context, rawURL string)) *MockWebPageReader_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebPageReader_Read_Call) Return(webPage WebPage, err error) *MockWebPageReader_Read_Call {
+	_c.Call.Return(webPage, err)
+	return _c
+}
+
+func (_c *MockWebPageReader_Read_Call) RunAndReturn(run func(ctx context.Context, rawURL string) (WebPage, error)) *MockWebPageReader_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -17,6 +17,8 @@ type ModelInfo struct {
 	ID   string
 	Name string
 	Kind ModelKind
+	// MaxOutputTokens bounds the tokens one response may generate. Zero means unknown.
+	MaxOutputTokens int
 }
 
 // ModelCapabilities describes a model that can be used for assistant turns.
@@ -27,6 +29,8 @@ type ModelCapabilities struct {
 	SupportsStreaming bool
 	// SupportsActions indicates the model can request assistant actions/tools.
 	SupportsActions bool
+	// MaxOutputTokens bounds the tokens one response may generate. Zero means unknown.
+	MaxOutputTokens int
 }
 
 // ModelCatalog exposes available assistant-capable models.
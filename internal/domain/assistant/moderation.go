@@ -0,0 +1,17 @@
+package assistant
+
+import "context"
+
+// ModerationResult describes the outcome of moderating a piece of user content.
+type ModerationResult struct {
+	// Flagged reports whether the content must be blocked.
+	Flagged bool
+	// Categories lists the policy categories the content was flagged for.
+	Categories []string
+}
+
+// Moderator screens user content before it reaches the assistant.
+type Moderator interface {
+	// Moderate classifies the content and reports whether it must be blocked.
+	Moderate(ctx context.Context, content string) (ModerationResult, error)
+}
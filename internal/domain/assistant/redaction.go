@@ -0,0 +1,7 @@
+package assistant
+
+// Redactor masks sensitive content, such as contact details or profanity, before chat messages are persisted.
+type Redactor interface {
+	// Redact returns the content with every sensitive match replaced by a placeholder.
+	Redact(content string) (string, error)
+}
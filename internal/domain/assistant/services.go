@@ -22,21 +22,38 @@ func DetermineContextCompactionDecision(
 	policy CompactionPolicy,
 ) CompactionDecision {
 	totalTokens := estimateMessagesContextTokens(messages)
+	turnCount := countUserTurns(messages)
 	decision := CompactionDecision{
 		ShouldCompact: false,
 		Reason:        ContextCompactionReasonNone,
 		MessageCount:  len(messages),
 		TotalTokens:   totalTokens,
+		TurnCount:     turnCount,
 	}
 
-	if totalTokens >= policy.TriggerTokenCount {
+	switch {
+	case totalTokens >= policy.TriggerTokenCount:
 		decision.ShouldCompact = true
 		decision.Reason = ContextCompactionReasonTokenCountThreshold
+	case policy.TriggerTurnCount > 0 && turnCount >= policy.TriggerTurnCount:
+		decision.ShouldCompact = true
+		decision.Reason = ContextCompactionReasonTurnInterval
 	}
 
 	return decision
 }
 
+// countUserTurns counts the user messages, one per turn, in the given messages.
+func countUserTurns(messages []ChatMessage) int {
+	turns := 0
+	for _, message := range messages {
+		if message.ChatRole == ChatRole_User {
+			turns++
+		}
+	}
+	return turns
+}
+
 // estimateMessagesContextTokens approximates the active context size represented
 // by the persisted chat messages, instead of using model usage/billing tokens.
 func estimateMessagesContextTokens(messages []ChatMessage) int {
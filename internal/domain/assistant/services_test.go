@@ -52,6 +52,7 @@ func TestDetermineContextCompactionDecision(t *testing.T) {
 
 	policy := CompactionPolicy{
 		TriggerTokenCount: 2000,
+		TriggerTurnCount:  3,
 	}
 
 	tests := map[string]struct {
@@ -111,6 +112,37 @@ func TestDetermineContextCompactionDecision(t *testing.T) {
 				Reason:        ContextCompactionReasonNone,
 				MessageCount:  2,
 				TotalTokens:   11,
+				TurnCount:     1,
+			},
+		},
+		"triggered-by-turn-interval": {
+			messages: []ChatMessage{
+				{ChatRole: ChatRole_User, Content: "one", ContextTokensEstimate: 5},
+				{ChatRole: ChatRole_Assistant, Content: "ok", ContextTokensEstimate: 5},
+				{ChatRole: ChatRole_User, Content: "two", ContextTokensEstimate: 5},
+				{ChatRole: ChatRole_Assistant, Content: "ok", ContextTokensEstimate: 5},
+				{ChatRole: ChatRole_User, Content: "three", ContextTokensEstimate: 5},
+			},
+			want: CompactionDecision{
+				ShouldCompact: true,
+				Reason:        ContextCompactionReasonTurnInterval,
+				MessageCount:  5,
+				TotalTokens:   25,
+				TurnCount:     3,
+			},
+		},
+		"token-count-threshold-takes-precedence-over-turn-interval": {
+			messages: []ChatMessage{
+				{ChatRole: ChatRole_User, Content: "one", ContextTokensEstimate: 1000},
+				{ChatRole: ChatRole_User, Content: "two", ContextTokensEstimate: 500},
+				{ChatRole: ChatRole_User, Content: "three", ContextTokensEstimate: 500},
+			},
+			want: CompactionDecision{
+				ShouldCompact: true,
+				Reason:        ContextCompactionReasonTokenCountThreshold,
+				MessageCount:  3,
+				TotalTokens:   2000,
+				TurnCount:     3,
 			},
 		},
 		"ignores-llm-usage-total-tokens-for-thresholds": {
@@ -0,0 +1,37 @@
+package assistant
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShadowEvaluation records one interactive turn replayed against a candidate model, side by side with
+// the turn the user received, for offline comparison. The candidate never affects the user: its
+// response is not streamed and the actions it chooses are not executed.
+type ShadowEvaluation struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	TurnID         uuid.UUID
+	PrimaryModel   string
+	PrimaryContent string
+	// PrimaryActions lists the actions run or submitted for approval during the turn, in order.
+	PrimaryActions []string
+	CandidateModel string
+	// CandidateContent is the candidate response to the initial turn request.
+	CandidateContent string
+	// CandidateActions lists the actions the candidate chose in response to the initial turn request.
+	CandidateActions []string
+	// CandidateError holds the error of a failed candidate turn.
+	CandidateError   *string
+	CandidateUsage   Usage
+	CandidateLatency time.Duration
+	CreatedAt        time.Time
+}
+
+// ShadowEvaluationRepository persists shadow evaluations.
+type ShadowEvaluationRepository interface {
+	// CreateShadowEvaluation stores a shadow evaluation.
+	CreateShadowEvaluation(ctx context.Context, evaluation ShadowEvaluation) error
+}
@@ -0,0 +1,77 @@
+package assistant
+
+import (
+	"errors"
+	"time"
+)
+
+// TurnErrorCode is a machine-readable classification of an assistant turn failure.
+type TurnErrorCode string
+
+const (
+	// TurnErrorCode_RateLimited indicates the model provider rejected the request due to rate limits.
+	TurnErrorCode_RateLimited TurnErrorCode = "rate_limited"
+	// TurnErrorCode_ContextTooLong indicates the prompt exceeded the model context window.
+	TurnErrorCode_ContextTooLong TurnErrorCode = "context_too_long"
+	// TurnErrorCode_ContentFiltered indicates the model provider filtered the response content.
+	TurnErrorCode_ContentFiltered TurnErrorCode = "content_filtered"
+	// TurnErrorCode_Network indicates the model provider could not be reached or the stream was interrupted.
+	TurnErrorCode_Network TurnErrorCode = "network"
+	// TurnErrorCode_Shutdown indicates the turn was interrupted because the server shut down before it finished.
+	TurnErrorCode_Shutdown TurnErrorCode = "shutdown"
+	// TurnErrorCode_RequestTooLarge indicates the request exceeded a configured size limit other than its history.
+	TurnErrorCode_RequestTooLarge TurnErrorCode = "request_too_large"
+	// TurnErrorCode_ResponseTooLong indicates the model response exceeded the configured size limit.
+	TurnErrorCode_ResponseTooLong TurnErrorCode = "response_too_long"
+	// TurnErrorCode_Unknown indicates a failure that could not be classified.
+	TurnErrorCode_Unknown TurnErrorCode = "unknown"
+)
+
+// Retriable reports whether retrying the same turn may succeed.
+func (c TurnErrorCode) Retriable() bool {
+	return c == TurnErrorCode_RateLimited || c == TurnErrorCode_Network || c == TurnErrorCode_Shutdown
+}
+
+// TurnError wraps an assistant turn failure with its classification.
+type TurnError struct {
+	Code TurnErrorCode
+	// RetryAfter is the provider supplied delay before retrying, zero when unknown.
+	RetryAfter time.Duration
+	Err        error
+}
+
+// NewTurnError creates a new TurnError.
+func NewTurnError(code TurnErrorCode, retryAfter time.Duration, err error) *TurnError {
+	return &TurnError{
+		Code:       code,
+		RetryAfter: retryAfter,
+		Err:        err,
+	}
+}
+
+// Error returns the underlying error message.
+func (e *TurnError) Error() string {
+	if e.Err == nil {
+		return string(e.Code)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *TurnError) Unwrap() error {
+	return e.Err
+}
+
+// Retriable reports whether retrying the same turn may succeed.
+func (e *TurnError) Retriable() bool {
+	return e.Code.Retriable()
+}
+
+// ClassifyTurnError returns the TurnError carried by err, or an unknown TurnError wrapping it.
+func ClassifyTurnError(err error) *TurnError {
+	var turnErr *TurnError
+	if errors.As(err, &turnErr) {
+		return turnErr
+	}
+	return NewTurnError(TurnErrorCode_Unknown, 0, err)
+}
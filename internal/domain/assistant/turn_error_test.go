@@ -0,0 +1,63 @@
+package assistant
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyTurnError(t *testing.T) {
+	t.Parallel()
+
+	baseErr := errors.New("too many requests")
+
+	tests := map[string]struct {
+		err           error
+		wantCode      TurnErrorCode
+		wantRetry     time.Duration
+		wantRetriable bool
+	}{
+		"rate-limited": {
+			err:           NewTurnError(TurnErrorCode_RateLimited, 5*time.Second, baseErr),
+			wantCode:      TurnErrorCode_RateLimited,
+			wantRetry:     5 * time.Second,
+			wantRetriable: true,
+		},
+		"wrapped-network": {
+			err:           fmt.Errorf("run turn: %w", NewTurnError(TurnErrorCode_Network, 0, baseErr)),
+			wantCode:      TurnErrorCode_Network,
+			wantRetriable: true,
+		},
+		"context-too-long": {
+			err:      NewTurnError(TurnErrorCode_ContextTooLong, 0, baseErr),
+			wantCode: TurnErrorCode_ContextTooLong,
+		},
+		"content-filtered": {
+			err:      NewTurnError(TurnErrorCode_ContentFiltered, 0, baseErr),
+			wantCode: TurnErrorCode_ContentFiltered,
+		},
+		"shutdown": {
+			err:           NewTurnError(TurnErrorCode_Shutdown, 0, baseErr),
+			wantCode:      TurnErrorCode_Shutdown,
+			wantRetriable: true,
+		},
+		"unclassified": {
+			err:      baseErr,
+			wantCode: TurnErrorCode_Unknown,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ClassifyTurnError(tt.err)
+			assert.Equal(t, tt.wantCode, got.Code)
+			assert.Equal(t, tt.wantRetry, got.RetryAfter)
+			assert.Equal(t, tt.wantRetriable, got.Retriable())
+			assert.Equal(t, baseErr.Error(), got.Error())
+			assert.ErrorIs(t, got, baseErr)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package assistant
+
+import (
+	"context"
+	"time"
+)
+
+// WeatherForecast is the daily forecast for one place.
+type WeatherForecast struct {
+	// Location is the resolved place name, e.g. "Lisbon, Portugal".
+	Location string
+	// Timezone is the IANA time zone of the place; Days are local dates in it.
+	Timezone string
+	// Days holds one entry per forecast day, starting today.
+	Days []WeatherDay
+}
+
+// WeatherDay is the forecast for one local day.
+type WeatherDay struct {
+	Date                     time.Time
+	Summary                  string
+	MinTemperatureC          float64
+	MaxTemperatureC          float64
+	PrecipitationMM          float64
+	PrecipitationProbability int
+}
+
+// Dry reports whether little or no rain is expected on the day.
+func (d WeatherDay) Dry() bool {
+	return d.PrecipitationMM < 1 && d.PrecipitationProbability < 40
+}
+
+// WeatherForecaster provides daily weather forecasts for named places.
+type WeatherForecaster interface {
+	// Forecast returns the forecast for location over the next days, today included. It returns a
+	// core.NotFoundErr when the location is unknown.
+	Forecast(ctx context.Context, location string, days int) (WeatherForecast, error)
+}
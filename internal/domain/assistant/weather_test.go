@@ -0,0 +1,29 @@
+package assistant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeatherDay_Dry(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		day      WeatherDay
+		expected bool
+	}{
+		"no-rain":           {day: WeatherDay{}, expected: true},
+		"light-drizzle":     {day: WeatherDay{PrecipitationMM: 0.4, PrecipitationProbability: 30}, expected: true},
+		"rain":              {day: WeatherDay{PrecipitationMM: 5, PrecipitationProbability: 80}, expected: false},
+		"likely-rain":       {day: WeatherDay{PrecipitationMM: 0.2, PrecipitationProbability: 60}, expected: false},
+		"unlikely-downpour": {day: WeatherDay{PrecipitationMM: 3, PrecipitationProbability: 20}, expected: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.day.Dry())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package assistant
+
+import "context"
+
+// WebSearchResult is one web page returned by a WebSearcher.
+type WebSearchResult struct {
+	Title   string
+	URL     string
+	Snippet string
+}
+
+// WebSearcher searches the web.
+type WebSearcher interface {
+	// Search returns at most limit results for query, best match first.
+	Search(ctx context.Context, query string, limit int) ([]WebSearchResult, error)
+}
+
+// WebPage is the readable text of a fetched web page.
+type WebPage struct {
+	URL   string
+	Title string
+	Text  string
+	// Truncated reports whether the page was cut at the size limit of the WebPageReader.
+	Truncated bool
+}
+
+// WebPageReader fetches web pages as readable text.
+type WebPageReader interface {
+	// Read fetches rawURL and extracts its readable text. It returns a core.ForbiddenErr when the URL is
+	// outside the allowed domains or its robots.txt disallows it.
+	Read(ctx context.Context, rawURL string) (WebPage, error)
+}
@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/google/uuid"
+)
+
+// Source is the channel a mutation reached the application through.
+type Source string
+
+const (
+	// SourceAPI records REST API calls.
+	SourceAPI Source = "api"
+	// SourceChat records actions the assistant ran on behalf of a chat.
+	SourceChat Source = "chat"
+)
+
+// Anonymous is the principal recorded for calls to routes that are not authenticated with API tokens,
+// such as inbound webhooks and session refreshes.
+const Anonymous = "anonymous"
+
+// Entry records one mutation: who performed it, what it was, when, a hash of its payload and its result.
+// Entries are append-only and are only removed by the retention policy. They are distinct from the todo
+// history, which records the resulting changes of each todo rather than the calls that caused them.
+type Entry struct {
+	ID uuid.UUID
+	// Sequence orders the entries in the order they were appended. It is assigned by the repository.
+	Sequence  int64
+	Principal string
+	Role      access.Role
+	Source    Source
+	// Operation is the route pattern of API calls, such as "POST /api/v1/todos", or the name of chat actions.
+	Operation string
+	// Target is the request path of API calls or the conversation of chat actions.
+	Target string
+	// PayloadHash is the hex-encoded SHA-256 of the request body or action input.
+	PayloadHash string
+	// Result is the HTTP status code of API calls, or "ok" or the error of chat actions.
+	Result     string
+	Succeeded  bool
+	OccurredAt time.Time
+}
+
+// HashPayload returns the hex-encoded SHA-256 of the payload.
+func HashPayload(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+// Query selects the entries to export.
+type Query struct {
+	// From and To bound the time the entries occurred at, inclusive and exclusive. Zero values leave the bound open.
+	From time.Time
+	To   time.Time
+	// AfterSequence continues a previous export after the last sequence it returned.
+	AfterSequence int64
+	Limit         int
+}
+
+// Repository appends and exports audit entries.
+type Repository interface {
+	// AppendEntry stores an entry.
+	AppendEntry(ctx context.Context, entry Entry) error
+	// ListEntries returns the entries matching the query in sequence order.
+	ListEntries(ctx context.Context, query Query) ([]Entry, error)
+	// DeleteEntriesBefore removes the entries of every tenant that occurred before the given time and
+	// returns how many were removed.
+	DeleteEntriesBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPayload(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		payload []byte
+		want    string
+	}{
+		"empty": {
+			payload: nil,
+			want:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		"json-body": {
+			payload: []byte(`{"title":"Buy milk"}`),
+			want:    "6330399f2342cfc9311b85fb26dcac5b706b3080b49e3aadedde2f3a864efdc9",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := HashPayload(tt.payload)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, got, 64)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package core
+
+import "context"
+
+// ConfigReloader re-reads the configuration at runtime so subsystems that copied values at Initialize time
+// can apply the new ones without a restart.
+type ConfigReloader interface {
+	// OnReload registers reload under name. It runs after every configuration reload and re-reads the values
+	// of its subsystem; an error rejects them and keeps the previous values in effect.
+	OnReload(name string, reload func(ctx context.Context) error)
+	// Reload re-reads the configuration and notifies every registered subsystem. It returns the names of the
+	// subsystems that applied the new values and an error joining the rejections of the others.
+	Reload(ctx context.Context) ([]string, error)
+}
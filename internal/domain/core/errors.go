@@ -33,3 +33,51 @@ func NewValidationErr(message string) *ValidationErr {
 		domainErr: domainErr{message: message},
 	}
 }
+
+// UnauthorizedErr represents an error when a request cannot be authenticated.
+type UnauthorizedErr struct {
+	domainErr
+}
+
+// NewUnauthorizedErr creates a new UnauthorizedErr with the given message.
+func NewUnauthorizedErr(message string) *UnauthorizedErr {
+	return &UnauthorizedErr{
+		domainErr: domainErr{message: message},
+	}
+}
+
+// ForbiddenErr represents an error when an authenticated caller is not allowed to perform a request.
+type ForbiddenErr struct {
+	domainErr
+}
+
+// NewForbiddenErr creates a new ForbiddenErr with the given message.
+func NewForbiddenErr(message string) *ForbiddenErr {
+	return &ForbiddenErr{
+		domainErr: domainErr{message: message},
+	}
+}
+
+// ConflictErr represents an error when a change was based on an outdated version of an entity.
+type ConflictErr struct {
+	domainErr
+}
+
+// NewConflictErr creates a new ConflictErr with the given message.
+func NewConflictErr(message string) *ConflictErr {
+	return &ConflictErr{
+		domainErr: domainErr{message: message},
+	}
+}
+
+// UnavailableErr represents an error when a request cannot be served right now, e.g. while the server shuts down.
+type UnavailableErr struct {
+	domainErr
+}
+
+// NewUnavailableErr creates a new UnavailableErr with the given message.
+func NewUnavailableErr(message string) *UnavailableErr {
+	return &UnavailableErr{
+		domainErr: domainErr{message: message},
+	}
+}
@@ -11,6 +11,141 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockConfigReloader creates a new instance of MockConfigReloader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfigReloader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfigReloader {
+	mock := &MockConfigReloader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConfigReloader is an autogenerated mock type for the ConfigReloader type
+type MockConfigReloader struct {
+	mock.Mock
+}
+
+type MockConfigReloader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfigReloader) EXPECT() *MockConfigReloader_Expecter {
+	return &MockConfigReloader_Expecter{mock: &_m.Mock}
+}
+
+// OnReload provides a mock function for the type MockConfigReloader
+func (_mock *MockConfigReloader) OnReload(name string, reload func(ctx context.Context) error) {
+	_mock.Called(name, reload)
+	return
+}
+
+// MockConfigReloader_OnReload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnReload'
+type MockConfigReloader_OnReload_Call struct {
+	*mock.Call
+}
+
+// OnReload is a helper method to define mock.On call
+//   - name string
+//   - reload func(ctx context.Context) error
+func (_e *MockConfigReloader_Expecter) OnReload(name interface{}, reload interface{}) *MockConfigReloader_OnReload_Call {
+	return &MockConfigReloader_OnReload_Call{Call: _e.mock.On("OnReload", name, reload)}
+}
+
+func (_c *MockConfigReloader_OnReload_Call) Run(run func(name string, reload func(ctx context.Context) error)) *MockConfigReloader_OnReload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigReloader_OnReload_Call) Return() *MockConfigReloader_OnReload_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockConfigReloader_OnReload_Call) RunAndReturn(run func(name string, reload func(ctx context.Context) error)) *MockConfigReloader_OnReload_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Reload provides a mock function for the type MockConfigReloader
+func (_mock *MockConfigReloader) Reload(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reload")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfigReloader_Reload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reload'
+type MockConfigReloader_Reload_Call struct {
+	*mock.Call
+}
+
+// Reload is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockConfigReloader_Expecter) Reload(ctx interface{}) *MockConfigReloader_Reload_Call {
+	return &MockConfigReloader_Reload_Call{Call: _e.mock.On("Reload", ctx)}
+}
+
+func (_c *MockConfigReloader_Reload_Call) Run(run func(ctx context.Context)) *MockConfigReloader_Reload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfigReloader_Reload_Call) Return(strings []string, err error) *MockConfigReloader_Reload_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockConfigReloader_Reload_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockConfigReloader_Reload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockLocker creates a new instance of MockLocker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockLocker(t interface {
@@ -0,0 +1,11 @@
+package outbox
+
+// ChatMessageEventStream fans chat message events out to the live listeners of the serving process,
+// such as chat UIs waiting for unread badges over server-sent events.
+type ChatMessageEventStream interface {
+	// Subscribe registers a listener and returns its event channel and a function that removes it.
+	// The channel is closed once the listener is removed.
+	Subscribe() (<-chan ChatMessageEvent, func())
+	// Broadcast delivers the event to every listener. Slow listeners miss events instead of blocking.
+	Broadcast(event ChatMessageEvent)
+}
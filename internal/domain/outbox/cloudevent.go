@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// CloudEventsSpecVersion is the CloudEvents specification version of the published envelopes.
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of a message carrying a structured CloudEvent.
+	CloudEventsContentType = "application/cloudevents+json"
+)
+
+// CloudEvent is the CloudEvents 1.0 structured envelope wrapping a published outbox event.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+	// TenantID is the tenantid extension attribute, set to the tenant owning the event.
+	TenantID string `json:"tenantid,omitempty"`
+}
+
+// NewCloudEvent wraps event in a CloudEvent emitted by source. The outbox event ID becomes the
+// CloudEvent ID, so consumers can deduplicate redelivered events.
+func NewCloudEvent(event Event, source string) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              event.ID.String(),
+		Source:          source,
+		Type:            string(event.EventType),
+		Subject:         event.EntityID.String(),
+		Time:            event.CreatedAt.UTC(),
+		DataContentType: "application/json",
+		Data:            json.RawMessage(event.Payload),
+		TenantID:        string(event.TenantID),
+	}
+}
+
+// Validate checks the envelope carries the required CloudEvents attributes.
+func (e CloudEvent) Validate() error {
+	if e.SpecVersion != CloudEventsSpecVersion {
+		return fmt.Errorf("unsupported cloudevents spec version %q", e.SpecVersion)
+	}
+	if e.ID == "" || e.Source == "" || e.Type == "" {
+		return errors.New("cloudevent is missing id, source or type")
+	}
+	return nil
+}
+
+// DecodeCloudEvent parses a structured CloudEvent and validates its required attributes.
+func DecodeCloudEvent(data []byte) (CloudEvent, error) {
+	var event CloudEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return CloudEvent{}, err
+	}
+	if err := event.Validate(); err != nil {
+		return CloudEvent{}, err
+	}
+	return event, nil
+}
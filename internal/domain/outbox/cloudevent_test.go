@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCloudEvent(t *testing.T) {
+	t.Parallel()
+
+	eventID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	todoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	event := NewCloudEvent(Event{
+		ID:        eventID,
+		TenantID:  "acme",
+		EntityID:  todoID,
+		EventType: EventType_TODO_CREATED,
+		Payload:   []byte(`{"TodoID":"223e4567-e89b-12d3-a456-426614174000"}`),
+		CreatedAt: createdAt,
+	}, "/todoapp")
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"source": "/todoapp",
+		"type": "TODO.CREATED",
+		"subject": "223e4567-e89b-12d3-a456-426614174000",
+		"time": "2024-01-01T15:00:00Z",
+		"datacontenttype": "application/json",
+		"data": {"TodoID": "223e4567-e89b-12d3-a456-426614174000"},
+		"tenantid": "acme"
+	}`, string(data))
+}
+
+func TestDecodeCloudEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data     string
+		wantData string
+		wantErr  bool
+	}{
+		"valid": {
+			data:     `{"specversion":"1.0","id":"1","source":"/todoapp","type":"TODO.CREATED","data":{"a":1}}`,
+			wantData: `{"a":1}`,
+		},
+		"unsupported-version": {
+			data:    `{"specversion":"0.3","id":"1","source":"/todoapp","type":"TODO.CREATED","data":{}}`,
+			wantErr: true,
+		},
+		"missing-type": {
+			data:    `{"specversion":"1.0","id":"1","source":"/todoapp","data":{}}`,
+			wantErr: true,
+		},
+		"invalid-json": {
+			data:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			event, err := DecodeCloudEvent([]byte(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantData, string(event.Data))
+		})
+	}
+}
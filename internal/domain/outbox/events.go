@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 )
 
@@ -26,9 +27,25 @@ const (
 
 // TodoEvent represents a domain event in the system.
 type TodoEvent struct {
-	Type      EventType
-	TodoID    uuid.UUID
-	CreatedAt time.Time
+	Type                 EventType
+	TodoID               uuid.UUID
+	CreatedAt            time.Time
+	Sequence             int64
+	ConversationID       *uuid.UUID
+	ConversationSequence *int64
+	// TenantID is the tenant owning the todo. The outbox stamps it when the event is recorded.
+	TenantID tenant.ID
+	// Reprocessed marks a synthetic event re-emitted by an administrative reprocess run rather than a todo change.
+	Reprocessed bool `json:",omitempty"`
+}
+
+// OrderingKey returns the key ordering the event with the other events of its conversation,
+// or of its todo when the change was not made by the assistant.
+func (e TodoEvent) OrderingKey() string {
+	if e.ConversationID != nil {
+		return conversationOrderingKey(*e.ConversationID)
+	}
+	return "todo:" + e.TodoID.String()
 }
 
 // ChatMessageEvent represents a domain event for chat messages in the system.
@@ -38,9 +55,41 @@ type ChatMessageEvent struct {
 	ChatMessageID  uuid.UUID
 	ConversationID uuid.UUID
 	CreatedAt      time.Time
+	// Background marks a message added by background work, such as the weekly review, rather than by a turn
+	// the user follows.
+	Background bool `json:",omitempty"`
+	// TenantID is the tenant owning the conversation. Consumers stamp it from the tenant the message was published for.
+	TenantID tenant.ID `json:",omitempty"`
+	// Reprocessed marks a synthetic event re-emitted by an administrative reprocess run rather than a new message.
+	Reprocessed bool `json:",omitempty"`
+}
+
+// NewBackgroundChatMessageEvent returns the event of a message background work posted into a conversation.
+func NewBackgroundChatMessageEvent(message assistant.ChatMessage) ChatMessageEvent {
+	return ChatMessageEvent{
+		Type:           EventType_CHAT_MESSAGE_SENT,
+		ChatRole:       message.ChatRole,
+		ChatMessageID:  message.ID,
+		ConversationID: message.ConversationID,
+		CreatedAt:      message.CreatedAt,
+		Background:     true,
+	}
+}
+
+// OrderingKey returns the key ordering the event with the other events of its conversation.
+func (e ChatMessageEvent) OrderingKey() string {
+	return conversationOrderingKey(e.ConversationID)
+}
+
+// conversationOrderingKey is the ordering key shared by every event of a conversation.
+func conversationOrderingKey(conversationID uuid.UUID) string {
+	return "conversation:" + conversationID.String()
 }
 
 // EventPublisher defines the interface for publishing events.
 type EventPublisher interface {
 	PublishEvent(ctx context.Context, event Event) error
+	// PublishEvents publishes a batch of events and returns one error per event, nil when it was published.
+	// Events sharing an ordering key are delivered in the order of the batch.
+	PublishEvents(ctx context.Context, events []Event) []error
 }
@@ -0,0 +1,63 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Backlog summarizes the outbox events of one topic.
+type Backlog struct {
+	Topic Topic
+	// Pending counts the events waiting to be published, including the ones waiting for a retry.
+	Pending int
+	// Failed counts the events that exhausted their retries and are no longer published.
+	Failed int
+	// OldestPendingAt is the creation time of the oldest pending event, nil when none is pending.
+	OldestPendingAt *time.Time
+	// LastPublishedAt is when the relay last published an event of the topic, nil when it never did.
+	LastPublishedAt *time.Time
+}
+
+// EventError describes the most recent publishing error recorded on an outbox event.
+type EventError struct {
+	EventID    uuid.UUID
+	Topic      Topic
+	Status     Status
+	RetryCount int
+	Message    string
+	// AvailableAt is when the relay retries the event, or when it last failed for a failed event.
+	AvailableAt time.Time
+}
+
+// ConsumerCheckpoint records how far a durable subscription has consumed the events of its topic.
+type ConsumerCheckpoint struct {
+	Subscription string
+	Topic        Topic
+	// LastEventAt is the publish time of the newest event the subscription handled, nil when it has not handled any.
+	LastEventAt *time.Time
+	LastError   *string
+	LastErrorAt *time.Time
+	UpdatedAt   time.Time
+}
+
+// ConsumerLag reports how far a durable subscription trails the events published on its topic.
+type ConsumerLag struct {
+	ConsumerCheckpoint
+	// Lag is the time between the last event published on the topic and the last event the subscription handled.
+	Lag time.Duration
+}
+
+// Health reports whether outbox events are published and consumed.
+type Health struct {
+	// Pending and Failed total the events of every topic.
+	Pending int
+	Failed  int
+	// OldestPendingAge is the age of the oldest pending event, zero when none is pending.
+	OldestPendingAge time.Duration
+	Topics           []Backlog
+	// LastError is the most recent error of an event still pending or failed, nil when there is none.
+	LastError *EventError
+	Consumers []ConsumerLag
+	CheckedAt time.Time
+}
@@ -11,6 +11,130 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockChatMessageEventStream creates a new instance of MockChatMessageEventStream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChatMessageEventStream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChatMessageEventStream {
+	mock := &MockChatMessageEventStream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChatMessageEventStream is an autogenerated mock type for the ChatMessageEventStream type
+type MockChatMessageEventStream struct {
+	mock.Mock
+}
+
+type MockChatMessageEventStream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChatMessageEventStream) EXPECT() *MockChatMessageEventStream_Expecter {
+	return &MockChatMessageEventStream_Expecter{mock: &_m.Mock}
+}
+
+// Broadcast provides a mock function for the type MockChatMessageEventStream
+func (_mock *MockChatMessageEventStream) Broadcast(event ChatMessageEvent) {
+	_mock.Called(event)
+	return
+}
+
+// MockChatMessageEventStream_Broadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Broadcast'
+type MockChatMessageEventStream_Broadcast_Call struct {
+	*mock.Call
+}
+
+// Broadcast is a helper method to define mock.On call
+//   - event ChatMessageEvent
+func (_e *MockChatMessageEventStream_Expecter) Broadcast(event interface{}) *MockChatMessageEventStream_Broadcast_Call {
+	return &MockChatMessageEventStream_Broadcast_Call{Call: _e.mock.On("Broadcast", event)}
+}
+
+func (_c *MockChatMessageEventStream_Broadcast_Call) Run(run func(event ChatMessageEvent)) *MockChatMessageEventStream_Broadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 ChatMessageEvent
+		if args[0] != nil {
+			arg0 = args[0].(ChatMessageEvent)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChatMessageEventStream_Broadcast_Call) Return() *MockChatMessageEventStream_Broadcast_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockChatMessageEventStream_Broadcast_Call) RunAndReturn(run func(event ChatMessageEvent)) *MockChatMessageEventStream_Broadcast_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockChatMessageEventStream
+func (_mock *MockChatMessageEventStream) Subscribe() (<-chan ChatMessageEvent, func()) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan ChatMessageEvent
+	var r1 func()
+	if returnFunc, ok := ret.Get(0).(func() (<-chan ChatMessageEvent, func())); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() <-chan ChatMessageEvent); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan ChatMessageEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() func()); ok {
+		r1 = returnFunc()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+	return r0, r1
+}
+
+// MockChatMessageEventStream_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockChatMessageEventStream_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+func (_e *MockChatMessageEventStream_Expecter) Subscribe() *MockChatMessageEventStream_Subscribe_Call {
+	return &MockChatMessageEventStream_Subscribe_Call{Call: _e.mock.On("Subscribe")}
+}
+
+func (_c *MockChatMessageEventStream_Subscribe_Call) Run(run func()) *MockChatMessageEventStream_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockChatMessageEventStream_Subscribe_Call) Return(chatMessageEventCh <-chan ChatMessageEvent, fn func()) *MockChatMessageEventStream_Subscribe_Call {
+	_c.Call.Return(chatMessageEventCh, fn)
+	return _c
+}
+
+func (_c *MockChatMessageEventStream_Subscribe_Call) RunAndReturn(run func() (<-chan ChatMessageEvent, func())) *MockChatMessageEventStream_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockEventPublisher creates a new instance of MockEventPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockEventPublisher(t interface {
@@ -95,6 +219,65 @@ func (_c *MockEventPublisher_PublishEvent_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// PublishEvents provides a mock function for the type MockEventPublisher
+func (_mock *MockEventPublisher) PublishEvents(ctx context.Context, events []Event) []error {
+	ret := _mock.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishEvents")
+	}
+
+	var r0 []error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []Event) []error); ok {
+		r0 = returnFunc(ctx, events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+	return r0
+}
+
+// MockEventPublisher_PublishEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishEvents'
+type MockEventPublisher_PublishEvents_Call struct {
+	*mock.Call
+}
+
+// PublishEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []Event
+func (_e *MockEventPublisher_Expecter) PublishEvents(ctx interface{}, events interface{}) *MockEventPublisher_PublishEvents_Call {
+	return &MockEventPublisher_PublishEvents_Call{Call: _e.mock.On("PublishEvents", ctx, events)}
+}
+
+func (_c *MockEventPublisher_PublishEvents_Call) Run(run func(ctx context.Context, events []Event)) *MockEventPublisher_PublishEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []Event
+		if args[1] != nil {
+			arg1 = args[1].([]Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventPublisher_PublishEvents_Call) Return(errs []error) *MockEventPublisher_PublishEvents_Call {
+	_c.Call.Return(errs)
+	return _c
+}
+
+func (_c *MockEventPublisher_PublishEvents_Call) RunAndReturn(run func(ctx context.Context, events []Event) []error) *MockEventPublisher_PublishEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepository(t interface {
@@ -361,6 +544,253 @@ func (_c *MockRepository_FetchPendingEvents_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetLastEventError provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetLastEventError(ctx context.Context) (EventError, bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastEventError")
+	}
+
+	var r0 EventError
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (EventError, bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) EventError); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(EventError)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = returnFunc(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRepository_GetLastEventError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastEventError'
+type MockRepository_GetLastEventError_Call struct {
+	*mock.Call
+}
+
+// GetLastEventError is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) GetLastEventError(ctx interface{}) *MockRepository_GetLastEventError_Call {
+	return &MockRepository_GetLastEventError_Call{Call: _e.mock.On("GetLastEventError", ctx)}
+}
+
+func (_c *MockRepository_GetLastEventError_Call) Run(run func(ctx context.Context)) *MockRepository_GetLastEventError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetLastEventError_Call) Return(eventError EventError, b bool, err error) *MockRepository_GetLastEventError_Call {
+	_c.Call.Return(eventError, b, err)
+	return _c
+}
+
+func (_c *MockRepository_GetLastEventError_Call) RunAndReturn(run func(ctx context.Context) (EventError, bool, error)) *MockRepository_GetLastEventError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBacklogs provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListBacklogs(ctx context.Context) ([]Backlog, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBacklogs")
+	}
+
+	var r0 []Backlog
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Backlog, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Backlog); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Backlog)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ListBacklogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBacklogs'
+type MockRepository_ListBacklogs_Call struct {
+	*mock.Call
+}
+
+// ListBacklogs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) ListBacklogs(ctx interface{}) *MockRepository_ListBacklogs_Call {
+	return &MockRepository_ListBacklogs_Call{Call: _e.mock.On("ListBacklogs", ctx)}
+}
+
+func (_c *MockRepository_ListBacklogs_Call) Run(run func(ctx context.Context)) *MockRepository_ListBacklogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListBacklogs_Call) Return(backlogs []Backlog, err error) *MockRepository_ListBacklogs_Call {
+	_c.Call.Return(backlogs, err)
+	return _c
+}
+
+func (_c *MockRepository_ListBacklogs_Call) RunAndReturn(run func(ctx context.Context) ([]Backlog, error)) *MockRepository_ListBacklogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConsumerCheckpoints provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListConsumerCheckpoints(ctx context.Context) ([]ConsumerCheckpoint, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConsumerCheckpoints")
+	}
+
+	var r0 []ConsumerCheckpoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ConsumerCheckpoint, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ConsumerCheckpoint); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ConsumerCheckpoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ListConsumerCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConsumerCheckpoints'
+type MockRepository_ListConsumerCheckpoints_Call struct {
+	*mock.Call
+}
+
+// ListConsumerCheckpoints is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) ListConsumerCheckpoints(ctx interface{}) *MockRepository_ListConsumerCheckpoints_Call {
+	return &MockRepository_ListConsumerCheckpoints_Call{Call: _e.mock.On("ListConsumerCheckpoints", ctx)}
+}
+
+func (_c *MockRepository_ListConsumerCheckpoints_Call) Run(run func(ctx context.Context)) *MockRepository_ListConsumerCheckpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListConsumerCheckpoints_Call) Return(consumerCheckpoints []ConsumerCheckpoint, err error) *MockRepository_ListConsumerCheckpoints_Call {
+	_c.Call.Return(consumerCheckpoints, err)
+	return _c
+}
+
+func (_c *MockRepository_ListConsumerCheckpoints_Call) RunAndReturn(run func(ctx context.Context) ([]ConsumerCheckpoint, error)) *MockRepository_ListConsumerCheckpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveConsumerCheckpoint provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveConsumerCheckpoint(ctx context.Context, checkpoint ConsumerCheckpoint) error {
+	ret := _mock.Called(ctx, checkpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveConsumerCheckpoint")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConsumerCheckpoint) error); ok {
+		r0 = returnFunc(ctx, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SaveConsumerCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveConsumerCheckpoint'
+type MockRepository_SaveConsumerCheckpoint_Call struct {
+	*mock.Call
+}
+
+// SaveConsumerCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - checkpoint ConsumerCheckpoint
+func (_e *MockRepository_Expecter) SaveConsumerCheckpoint(ctx interface{}, checkpoint interface{}) *MockRepository_SaveConsumerCheckpoint_Call {
+	return &MockRepository_SaveConsumerCheckpoint_Call{Call: _e.mock.On("SaveConsumerCheckpoint", ctx, checkpoint)}
+}
+
+func (_c *MockRepository_SaveConsumerCheckpoint_Call) Run(run func(ctx context.Context, checkpoint ConsumerCheckpoint)) *MockRepository_SaveConsumerCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConsumerCheckpoint
+		if args[1] != nil {
+			arg1 = args[1].(ConsumerCheckpoint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveConsumerCheckpoint_Call) Return(err error) *MockRepository_SaveConsumerCheckpoint_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SaveConsumerCheckpoint_Call) RunAndReturn(run func(ctx context.Context, checkpoint ConsumerCheckpoint) error) *MockRepository_SaveConsumerCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateEvent provides a mock function for the type MockRepository
 func (_mock *MockRepository) UpdateEvent(ctx context.Context, eventID uuid.UUID, status Status, retryCount int, lastError string) error {
 	ret := _mock.Called(ctx, eventID, status, retryCount, lastError)
@@ -435,3 +865,295 @@ func (_c *MockRepository_UpdateEvent_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockTodoEventStream creates a new instance of MockTodoEventStream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTodoEventStream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTodoEventStream {
+	mock := &MockTodoEventStream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTodoEventStream is an autogenerated mock type for the TodoEventStream type
+type MockTodoEventStream struct {
+	mock.Mock
+}
+
+type MockTodoEventStream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTodoEventStream) EXPECT() *MockTodoEventStream_Expecter {
+	return &MockTodoEventStream_Expecter{mock: &_m.Mock}
+}
+
+// Broadcast provides a mock function for the type MockTodoEventStream
+func (_mock *MockTodoEventStream) Broadcast(event TodoEvent) {
+	_mock.Called(event)
+	return
+}
+
+// MockTodoEventStream_Broadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Broadcast'
+type MockTodoEventStream_Broadcast_Call struct {
+	*mock.Call
+}
+
+// Broadcast is a helper method to define mock.On call
+//   - event TodoEvent
+func (_e *MockTodoEventStream_Expecter) Broadcast(event interface{}) *MockTodoEventStream_Broadcast_Call {
+	return &MockTodoEventStream_Broadcast_Call{Call: _e.mock.On("Broadcast", event)}
+}
+
+func (_c *MockTodoEventStream_Broadcast_Call) Run(run func(event TodoEvent)) *MockTodoEventStream_Broadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 TodoEvent
+		if args[0] != nil {
+			arg0 = args[0].(TodoEvent)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTodoEventStream_Broadcast_Call) Return() *MockTodoEventStream_Broadcast_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTodoEventStream_Broadcast_Call) RunAndReturn(run func(event TodoEvent)) *MockTodoEventStream_Broadcast_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockTodoEventStream
+func (_mock *MockTodoEventStream) Subscribe() (<-chan TodoEvent, func()) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan TodoEvent
+	var r1 func()
+	if returnFunc, ok := ret.Get(0).(func() (<-chan TodoEvent, func())); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() <-chan TodoEvent); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan TodoEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() func()); ok {
+		r1 = returnFunc()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+	return r0, r1
+}
+
+// MockTodoEventStream_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockTodoEventStream_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+func (_e *MockTodoEventStream_Expecter) Subscribe() *MockTodoEventStream_Subscribe_Call {
+	return &MockTodoEventStream_Subscribe_Call{Call: _e.mock.On("Subscribe")}
+}
+
+func (_c *MockTodoEventStream_Subscribe_Call) Run(run func()) *MockTodoEventStream_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTodoEventStream_Subscribe_Call) Return(todoEventCh <-chan TodoEvent, fn func()) *MockTodoEventStream_Subscribe_Call {
+	_c.Call.Return(todoEventCh, fn)
+	return _c
+}
+
+func (_c *MockTodoEventStream_Subscribe_Call) RunAndReturn(run func() (<-chan TodoEvent, func())) *MockTodoEventStream_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReplicatedTodoEventStream creates a new instance of MockReplicatedTodoEventStream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReplicatedTodoEventStream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReplicatedTodoEventStream {
+	mock := &MockReplicatedTodoEventStream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReplicatedTodoEventStream is an autogenerated mock type for the ReplicatedTodoEventStream type
+type MockReplicatedTodoEventStream struct {
+	mock.Mock
+}
+
+type MockReplicatedTodoEventStream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReplicatedTodoEventStream) EXPECT() *MockReplicatedTodoEventStream_Expecter {
+	return &MockReplicatedTodoEventStream_Expecter{mock: &_m.Mock}
+}
+
+// Broadcast provides a mock function for the type MockReplicatedTodoEventStream
+func (_mock *MockReplicatedTodoEventStream) Broadcast(event TodoEvent) {
+	_mock.Called(event)
+	return
+}
+
+// MockReplicatedTodoEventStream_Broadcast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Broadcast'
+type MockReplicatedTodoEventStream_Broadcast_Call struct {
+	*mock.Call
+}
+
+// Broadcast is a helper method to define mock.On call
+//   - event TodoEvent
+func (_e *MockReplicatedTodoEventStream_Expecter) Broadcast(event interface{}) *MockReplicatedTodoEventStream_Broadcast_Call {
+	return &MockReplicatedTodoEventStream_Broadcast_Call{Call: _e.mock.On("Broadcast", event)}
+}
+
+func (_c *MockReplicatedTodoEventStream_Broadcast_Call) Run(run func(event TodoEvent)) *MockReplicatedTodoEventStream_Broadcast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 TodoEvent
+		if args[0] != nil {
+			arg0 = args[0].(TodoEvent)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReplicatedTodoEventStream_Broadcast_Call) Return() *MockReplicatedTodoEventStream_Broadcast_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockReplicatedTodoEventStream_Broadcast_Call) RunAndReturn(run func(event TodoEvent)) *MockReplicatedTodoEventStream_Broadcast_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Replicated provides a mock function for the type MockReplicatedTodoEventStream
+func (_mock *MockReplicatedTodoEventStream) Replicated() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replicated")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockReplicatedTodoEventStream_Replicated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replicated'
+type MockReplicatedTodoEventStream_Replicated_Call struct {
+	*mock.Call
+}
+
+// Replicated is a helper method to define mock.On call
+func (_e *MockReplicatedTodoEventStream_Expecter) Replicated() *MockReplicatedTodoEventStream_Replicated_Call {
+	return &MockReplicatedTodoEventStream_Replicated_Call{Call: _e.mock.On("Replicated")}
+}
+
+func (_c *MockReplicatedTodoEventStream_Replicated_Call) Run(run func()) *MockReplicatedTodoEventStream_Replicated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReplicatedTodoEventStream_Replicated_Call) Return(b bool) *MockReplicatedTodoEventStream_Replicated_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockReplicatedTodoEventStream_Replicated_Call) RunAndReturn(run func() bool) *MockReplicatedTodoEventStream_Replicated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockReplicatedTodoEventStream
+func (_mock *MockReplicatedTodoEventStream) Subscribe() (<-chan TodoEvent, func()) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan TodoEvent
+	var r1 func()
+	if returnFunc, ok := ret.Get(0).(func() (<-chan TodoEvent, func())); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() <-chan TodoEvent); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan TodoEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() func()); ok {
+		r1 = returnFunc()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+	return r0, r1
+}
+
+// MockReplicatedTodoEventStream_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockReplicatedTodoEventStream_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+func (_e *MockReplicatedTodoEventStream_Expecter) Subscribe() *MockReplicatedTodoEventStream_Subscribe_Call {
+	return &MockReplicatedTodoEventStream_Subscribe_Call{Call: _e.mock.On("Subscribe")}
+}
+
+func (_c *MockReplicatedTodoEventStream_Subscribe_Call) Run(run func()) *MockReplicatedTodoEventStream_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReplicatedTodoEventStream_Subscribe_Call) Return(todoEventCh <-chan TodoEvent, fn func()) *MockReplicatedTodoEventStream_Subscribe_Call {
+	_c.Call.Return(todoEventCh, fn)
+	return _c
+}
+
+func (_c *MockReplicatedTodoEventStream_Subscribe_Call) RunAndReturn(run func() (<-chan TodoEvent, func())) *MockReplicatedTodoEventStream_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/google/uuid"
 )
 
@@ -43,12 +44,15 @@ const (
 
 // Event represents an event stored in the outbox.
 type Event struct {
-	ID          uuid.UUID
-	EntityType  EntityType
-	EntityID    uuid.UUID
-	Topic       Topic
-	EventType   EventType
-	Payload     []byte
+	ID         uuid.UUID
+	TenantID   tenant.ID
+	EntityType EntityType
+	EntityID   uuid.UUID
+	Topic      Topic
+	EventType  EventType
+	Payload    []byte
+	// OrderingKey groups events that consumers must process in order, such as the events of one conversation.
+	OrderingKey string
 	Status      Status
 	RetryCount  int
 	MaxRetries  int
@@ -71,4 +75,13 @@ type Repository interface {
 	UpdateEvent(ctx context.Context, eventID uuid.UUID, status Status, retryCount int, lastError string) error
 	// DeleteEvent deletes an event from the outbox.
 	DeleteEvent(ctx context.Context, eventID uuid.UUID) error
+	// ListBacklogs summarizes the events of every topic across tenants.
+	ListBacklogs(ctx context.Context) ([]Backlog, error)
+	// GetLastEventError returns the most recent error of an event still pending or failed.
+	GetLastEventError(ctx context.Context) (EventError, bool, error)
+	// SaveConsumerCheckpoint records the progress of a subscription. A nil LastEventAt keeps the
+	// recorded progress, and a nil LastError keeps the recorded error.
+	SaveConsumerCheckpoint(ctx context.Context, checkpoint ConsumerCheckpoint) error
+	// ListConsumerCheckpoints returns the progress of every subscription.
+	ListConsumerCheckpoints(ctx context.Context) ([]ConsumerCheckpoint, error)
 }
@@ -0,0 +1,19 @@
+package outbox
+
+// TodoEventStream fans todo events out to the live listeners of the serving process,
+// such as board UIs connected over server-sent events.
+type TodoEventStream interface {
+	// Subscribe registers a listener and returns its event channel and a function that removes it.
+	// The channel is closed once the listener is removed.
+	Subscribe() (<-chan TodoEvent, func())
+	// Broadcast delivers the event to every listener. Slow listeners miss events instead of blocking.
+	Broadcast(event TodoEvent)
+}
+
+// ReplicatedTodoEventStream is a TodoEventStream whose broadcasts reach the listeners of every replica,
+// so each event needs to be broadcast by a single replica.
+type ReplicatedTodoEventStream interface {
+	TodoEventStream
+	// Replicated reports whether broadcasts reach the listeners of every replica.
+	Replicated() bool
+}
@@ -0,0 +1,83 @@
+package tenant
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// ID identifies an organization served by the deployment. Every stored entity belongs to exactly one tenant.
+type ID string
+
+// Default is the tenant of single-tenant deployments and of data created before multi-tenant mode.
+const Default ID = "default"
+
+// idPattern restricts tenant IDs to values that are safe in hostnames, Pub/Sub filters and log lines.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,39}$`)
+
+// Validate checks that the tenant ID is lowercase alphanumeric with dashes or underscores, up to 40 characters.
+func (id ID) Validate() error {
+	if !idPattern.MatchString(string(id)) {
+		return core.NewValidationErr("tenant id must be 1-40 lowercase letters, digits, '-' or '_'")
+	}
+	return nil
+}
+
+// Settings overrides the deployment-wide assistant configuration for one tenant.
+// Zero values keep the deployment defaults.
+type Settings struct {
+	// Models restricts the chat models the tenant may use. Empty allows every model of the catalog.
+	Models []string
+	// MaxOutputTokens caps the tokens one assistant response may generate.
+	MaxOutputTokens int
+	// MaxActionCycles overrides the number of action cycles one assistant turn may run.
+	MaxActionCycles int
+}
+
+// AllowsModel reports whether the tenant may chat with the model.
+func (s Settings) AllowsModel(model string) bool {
+	return len(s.Models) == 0 || slices.Contains(s.Models, model)
+}
+
+// Tenant is an organization served by the deployment.
+type Tenant struct {
+	ID ID
+	// Hosts are the host names whose requests are routed to the tenant.
+	Hosts    []string
+	Settings Settings
+}
+
+// Directory resolves the tenant addressed by an incoming request.
+type Directory interface {
+	// Resolve returns the tenant with the requested ID or, when no ID is requested, the tenant serving the host.
+	// It returns a NotFoundErr when no tenant matches, and a ForbiddenErr when the host belongs to another tenant.
+	Resolve(ctx context.Context, host string, requested ID) (Tenant, error)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx scoped to the tenant.
+func NewContext(ctx context.Context, t Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// WithID returns a copy of ctx scoped to the tenant with the given ID and default settings.
+// It is meant for background work, such as event consumers, that only knows the tenant ID.
+func WithID(ctx context.Context, id ID) context.Context {
+	return NewContext(ctx, Tenant{ID: id})
+}
+
+// FromContext returns the tenant ctx is scoped to, or the Default tenant when ctx is not scoped.
+func FromContext(ctx context.Context) Tenant {
+	if t, ok := ctx.Value(contextKey{}).(Tenant); ok && t.ID != "" {
+		return t
+	}
+	return Tenant{ID: Default}
+}
+
+// IDFromContext returns the ID of the tenant ctx is scoped to.
+func IDFromContext(ctx context.Context) ID {
+	return FromContext(ctx).ID
+}
@@ -0,0 +1,99 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestID_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id      ID
+		wantErr bool
+	}{
+		"default":         {id: Default},
+		"with-separators": {id: "acme-corp_eu"},
+		"digits":          {id: "42"},
+		"empty":           {id: "", wantErr: true},
+		"uppercase":       {id: "Acme", wantErr: true},
+		"leading-dash":    {id: "-acme", wantErr: true},
+		"quote":           {id: `acme"`, wantErr: true},
+		"max-length":      {id: ID(strings.Repeat("a", 40))},
+		"too-long":        {id: ID(strings.Repeat("a", 41)), wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.id.Validate()
+			if tt.wantErr {
+				assert.IsType(t, &core.ValidationErr{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSettings_AllowsModel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		settings Settings
+		model    string
+		want     bool
+	}{
+		"no-restriction": {settings: Settings{}, model: "any", want: true},
+		"allowed":        {settings: Settings{Models: []string{"a", "b"}}, model: "b", want: true},
+		"not-allowed":    {settings: Settings{Models: []string{"a"}}, model: "b", want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.settings.AllowsModel(tt.model))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	acme := Tenant{ID: "acme", Settings: Settings{MaxActionCycles: 5}}
+
+	tests := map[string]struct {
+		ctx  context.Context
+		want Tenant
+	}{
+		"unscoped": {
+			ctx:  context.Background(),
+			want: Tenant{ID: Default},
+		},
+		"scoped": {
+			ctx:  NewContext(context.Background(), acme),
+			want: acme,
+		},
+		"scoped-by-id": {
+			ctx:  WithID(context.Background(), "acme"),
+			want: Tenant{ID: "acme"},
+		},
+		"empty-id": {
+			ctx:  WithID(context.Background(), ""),
+			want: Tenant{ID: Default},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, FromContext(tt.ctx))
+			assert.Equal(t, tt.want.ID, IDFromContext(tt.ctx))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangeType identifies the kind of todo change recorded in the change log.
+type ChangeType string
+
+const (
+	// ChangeType_Created indicates a todo was created.
+	ChangeType_Created ChangeType = "CREATED"
+	// ChangeType_Updated indicates a todo was updated.
+	ChangeType_Updated ChangeType = "UPDATED"
+	// ChangeType_Deleted indicates a todo was deleted.
+	ChangeType_Deleted ChangeType = "DELETED"
+)
+
+// Change is an entry of the todo change log.
+// Sequence increases monotonically across all changes, while ConversationSequence
+// increases monotonically within the conversation that produced the change.
+type Change struct {
+	Sequence             int64
+	TodoID               uuid.UUID
+	Type                 ChangeType
+	ConversationID       *uuid.UUID
+	ConversationSequence *int64
+	CreatedAt            time.Time
+}
+
+// ChangeSequences holds the latest change sequence numbers known at a point in time.
+type ChangeSequences struct {
+	Global       int64
+	Conversation int64
+}
+
+// ChangeRepository defines the interface for the todo change log.
+type ChangeRepository interface {
+	// RecordChange appends a change to the log and returns it with its assigned sequence numbers.
+	RecordChange(ctx context.Context, change Change) (Change, error)
+	// ListChangesSince lists up to limit changes recorded after the since sequence, oldest first.
+	// When conversationID is set, only that conversation's changes are listed and since refers to
+	// the conversation sequence.
+	ListChangesSince(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]Change, bool, error)
+	// LatestSequences returns the latest global sequence and the latest sequence of the given conversation.
+	LatestSequences(ctx context.Context, conversationID uuid.UUID) (ChangeSequences, error)
+}
@@ -0,0 +1,78 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// MaxCommentBodyChars is the maximum number of characters allowed in a comment body.
+const MaxCommentBodyChars = 2000
+
+// CommentAuthor identifies who wrote a comment.
+type CommentAuthor string
+
+const (
+	// CommentAuthor_User indicates the comment was written by the user.
+	CommentAuthor_User CommentAuthor = "user"
+	// CommentAuthor_Assistant indicates the comment was written by the assistant.
+	CommentAuthor_Assistant CommentAuthor = "assistant"
+)
+
+// Validate checks if the comment author is valid.
+func (a CommentAuthor) Validate() error {
+	switch a {
+	case CommentAuthor_User, CommentAuthor_Assistant:
+		return nil
+	default:
+		return core.NewValidationErr(fmt.Sprintf("invalid comment author: %s", a))
+	}
+}
+
+// Comment represents a note attached to a todo.
+type Comment struct {
+	ID        uuid.UUID
+	TodoID    uuid.UUID
+	Author    CommentAuthor
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks if the comment has valid fields.
+func (c Comment) Validate() error {
+	if c.TodoID == uuid.Nil {
+		return core.NewValidationErr("todo_id cannot be empty")
+	}
+	if err := c.Author.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(c.Body) == "" {
+		return core.NewValidationErr("body cannot be empty")
+	}
+	if utf8.RuneCountInString(c.Body) > MaxCommentBodyChars {
+		return core.NewValidationErr(fmt.Sprintf("body cannot exceed %d characters", MaxCommentBodyChars))
+	}
+	return nil
+}
+
+// CommentRepository defines the interface for todo comment persistence.
+type CommentRepository interface {
+	// CreateComment stores a new comment.
+	CreateComment(ctx context.Context, comment Comment) error
+	// UpdateComment updates the body of an existing comment.
+	UpdateComment(ctx context.Context, comment Comment) error
+	// DeleteComment deletes a comment by its ID.
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	// GetComment retrieves a comment by its ID.
+	GetComment(ctx context.Context, id uuid.UUID) (Comment, bool, error)
+	// ListComments lists the comments of a todo, newest first.
+	ListComments(ctx context.Context, todoID uuid.UUID, page, pageSize int) ([]Comment, bool, error)
+	// ListRecentComments returns up to perTodo newest comments for each of the provided todos.
+	ListRecentComments(ctx context.Context, todoIDs []uuid.UUID, perTodo int) (map[uuid.UUID][]Comment, error)
+}
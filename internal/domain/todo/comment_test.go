@@ -0,0 +1,53 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComment_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		comment Comment
+		errMsg  string
+	}{
+		"valid-user-comment": {
+			comment: Comment{TodoID: uuid.New(), Author: CommentAuthor_User, Body: "Called the dentist"},
+		},
+		"valid-assistant-comment": {
+			comment: Comment{TodoID: uuid.New(), Author: CommentAuthor_Assistant, Body: "Rescheduled from chat"},
+		},
+		"missing-todo-id": {
+			comment: Comment{Author: CommentAuthor_User, Body: "note"},
+			errMsg:  "todo_id cannot be empty",
+		},
+		"invalid-author": {
+			comment: Comment{TodoID: uuid.New(), Author: "robot", Body: "note"},
+			errMsg:  "invalid comment author: robot",
+		},
+		"empty-body": {
+			comment: Comment{TodoID: uuid.New(), Author: CommentAuthor_User, Body: "   "},
+			errMsg:  "body cannot be empty",
+		},
+		"body-too-long": {
+			comment: Comment{TodoID: uuid.New(), Author: CommentAuthor_User, Body: strings.Repeat("a", MaxCommentBodyChars+1)},
+			errMsg:  "body cannot exceed 2000 characters",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.comment.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
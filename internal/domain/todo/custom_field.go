@@ -0,0 +1,220 @@
+package todo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+const (
+	// MaxCustomFields is the largest number of custom fields a tenant can define.
+	MaxCustomFields = 20
+	// MaxCustomFieldNameChars is the longest custom field name.
+	MaxCustomFieldNameChars = 50
+	// MaxCustomFieldTextChars is the longest TEXT custom field value.
+	MaxCustomFieldTextChars = 500
+	// MaxCustomFieldOptions is the largest number of options of an ENUM custom field.
+	MaxCustomFieldOptions = 50
+)
+
+// customFieldNamePattern restricts custom field names to lowercase identifiers, so they are safe as JSON keys
+// and tool input names.
+var customFieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// CustomFieldType represents the type of the values stored in a custom field.
+type CustomFieldType string
+
+const (
+	// CustomFieldType_TEXT stores free text.
+	CustomFieldType_TEXT CustomFieldType = "TEXT"
+	// CustomFieldType_NUMBER stores a number.
+	CustomFieldType_NUMBER CustomFieldType = "NUMBER"
+	// CustomFieldType_BOOLEAN stores true or false.
+	CustomFieldType_BOOLEAN CustomFieldType = "BOOLEAN"
+	// CustomFieldType_DATE stores a calendar date in YYYY-MM-DD format.
+	CustomFieldType_DATE CustomFieldType = "DATE"
+	// CustomFieldType_ENUM stores one of the options defined on the field.
+	CustomFieldType_ENUM CustomFieldType = "ENUM"
+)
+
+// Validate checks if the CustomFieldType is valid.
+func (t CustomFieldType) Validate() error {
+	switch t {
+	case CustomFieldType_TEXT, CustomFieldType_NUMBER, CustomFieldType_BOOLEAN, CustomFieldType_DATE, CustomFieldType_ENUM:
+		return nil
+	}
+	return core.NewValidationErr("custom field type must be one of TEXT, NUMBER, BOOLEAN, DATE or ENUM")
+}
+
+// CustomField is a tenant-defined attribute that todos can carry in addition to the built-in ones.
+type CustomField struct {
+	Name string
+	Type CustomFieldType
+	// Options lists the accepted values of an ENUM field. It is empty for the other types.
+	Options   []string
+	CreatedAt time.Time
+}
+
+// Validate checks if the custom field definition has valid fields.
+func (f CustomField) Validate() error {
+	if len(f.Name) == 0 || len(f.Name) > MaxCustomFieldNameChars {
+		return core.NewValidationErr(fmt.Sprintf("custom field name must be between 1 and %d characters", MaxCustomFieldNameChars))
+	}
+	if !customFieldNamePattern.MatchString(f.Name) {
+		return core.NewValidationErr("custom field name must start with a lowercase letter and contain only lowercase letters, digits and underscores")
+	}
+	if err := f.Type.Validate(); err != nil {
+		return err
+	}
+	if f.Type != CustomFieldType_ENUM {
+		if len(f.Options) > 0 {
+			return core.NewValidationErr("only ENUM custom fields can have options")
+		}
+		return nil
+	}
+	if len(f.Options) == 0 || len(f.Options) > MaxCustomFieldOptions {
+		return core.NewValidationErr(fmt.Sprintf("ENUM custom fields must have between 1 and %d options", MaxCustomFieldOptions))
+	}
+	seen := make(map[string]bool, len(f.Options))
+	for _, o := range f.Options {
+		if strings.TrimSpace(o) == "" {
+			return core.NewValidationErr("custom field options cannot be empty")
+		}
+		if seen[o] {
+			return core.NewValidationErr(fmt.Sprintf("duplicate custom field option: %s", o))
+		}
+		seen[o] = true
+	}
+	return nil
+}
+
+// NormalizeValue checks that v is a valid value for the field and returns it in its stored form: a string
+// for TEXT, DATE and ENUM fields, a float64 for NUMBER fields and a bool for BOOLEAN fields.
+func (f CustomField) NormalizeValue(v any) (any, error) {
+	switch f.Type {
+	case CustomFieldType_NUMBER:
+		switch n := v.(type) {
+		case float64:
+			return n, nil
+		case float32:
+			return float64(n), nil
+		case int:
+			return float64(n), nil
+		case int64:
+			return float64(n), nil
+		case json.Number:
+			if parsed, err := n.Float64(); err == nil {
+				return parsed, nil
+			}
+		}
+		return nil, f.invalidValueErr("a number")
+	case CustomFieldType_BOOLEAN:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		return nil, f.invalidValueErr("true or false")
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return nil, f.invalidValueErr("a string")
+	}
+	return f.normalizeString(s)
+}
+
+// ParseValue converts the text form of a value, as received in query strings, into its stored form.
+func (f CustomField) ParseValue(raw string) (any, error) {
+	switch f.Type {
+	case CustomFieldType_NUMBER:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, f.invalidValueErr("a number")
+		}
+		return n, nil
+	case CustomFieldType_BOOLEAN:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, f.invalidValueErr("true or false")
+		}
+		return b, nil
+	}
+	return f.normalizeString(raw)
+}
+
+// normalizeString validates a TEXT, DATE or ENUM value.
+func (f CustomField) normalizeString(s string) (any, error) {
+	switch f.Type {
+	case CustomFieldType_DATE:
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return nil, f.invalidValueErr("a date in YYYY-MM-DD format")
+		}
+	case CustomFieldType_ENUM:
+		if !slices.Contains(f.Options, s) {
+			return nil, f.invalidValueErr("one of " + strings.Join(f.Options, ", "))
+		}
+	default:
+		if len([]rune(s)) > MaxCustomFieldTextChars {
+			return nil, f.invalidValueErr(fmt.Sprintf("at most %d characters", MaxCustomFieldTextChars))
+		}
+	}
+	return s, nil
+}
+
+// invalidValueErr builds the validation error returned for a value that does not match the field type.
+func (f CustomField) invalidValueErr(expected string) error {
+	return core.NewValidationErr(fmt.Sprintf("custom field %s must be %s", f.Name, expected))
+}
+
+// FindCustomField returns the field with the given name.
+func FindCustomField(fields []CustomField, name string) (CustomField, bool) {
+	i := slices.IndexFunc(fields, func(f CustomField) bool { return f.Name == name })
+	if i < 0 {
+		return CustomField{}, false
+	}
+	return fields[i], true
+}
+
+// CustomFieldValues holds the custom field values of a todo, keyed by field name.
+type CustomFieldValues map[string]any
+
+// Apply returns a copy of the values with the changes applied. A nil change removes the value. Every other
+// change is validated against its field definition; values for undefined fields are rejected.
+func (v CustomFieldValues) Apply(changes CustomFieldValues, fields []CustomField) (CustomFieldValues, error) {
+	result := make(CustomFieldValues, len(v)+len(changes))
+	maps.Copy(result, v)
+	for _, name := range slices.Sorted(maps.Keys(changes)) {
+		value := changes[name]
+		if value == nil {
+			delete(result, name)
+			continue
+		}
+		field, ok := FindCustomField(fields, name)
+		if !ok {
+			return nil, core.NewValidationErr(fmt.Sprintf("unknown custom field: %s", name))
+		}
+		normalized, err := field.NormalizeValue(value)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = normalized
+	}
+	return result, nil
+}
+
+// CustomFieldRepository defines the interface for storing the custom field definitions of a tenant.
+type CustomFieldRepository interface {
+	// ListCustomFields returns the custom fields of the tenant ordered by name.
+	ListCustomFields(ctx context.Context) ([]CustomField, error)
+	// UpsertCustomField creates the custom field or replaces the definition with the same name.
+	UpsertCustomField(ctx context.Context, field CustomField) error
+	// DeleteCustomField removes the custom field and its values from every todo of the tenant.
+	DeleteCustomField(ctx context.Context, name string) error
+}
@@ -0,0 +1,259 @@
+package todo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomField_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field  CustomField
+		errMsg string
+	}{
+		"valid-text": {
+			field: CustomField{Name: "client", Type: CustomFieldType_TEXT},
+		},
+		"valid-enum": {
+			field: CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", "work"}},
+		},
+		"empty-name": {
+			field:  CustomField{Type: CustomFieldType_TEXT},
+			errMsg: "custom field name must be between 1 and 50 characters",
+		},
+		"name-too-long": {
+			field:  CustomField{Name: strings.Repeat("a", MaxCustomFieldNameChars+1), Type: CustomFieldType_TEXT},
+			errMsg: "custom field name must be between 1 and 50 characters",
+		},
+		"invalid-name": {
+			field:  CustomField{Name: "Client Name", Type: CustomFieldType_TEXT},
+			errMsg: "custom field name must start with a lowercase letter and contain only lowercase letters, digits and underscores",
+		},
+		"invalid-type": {
+			field:  CustomField{Name: "client", Type: "LIST"},
+			errMsg: "custom field type must be one of TEXT, NUMBER, BOOLEAN, DATE or ENUM",
+		},
+		"options-on-non-enum": {
+			field:  CustomField{Name: "client", Type: CustomFieldType_TEXT, Options: []string{"a"}},
+			errMsg: "only ENUM custom fields can have options",
+		},
+		"enum-without-options": {
+			field:  CustomField{Name: "area", Type: CustomFieldType_ENUM},
+			errMsg: "ENUM custom fields must have between 1 and 50 options",
+		},
+		"empty-option": {
+			field:  CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", " "}},
+			errMsg: "custom field options cannot be empty",
+		},
+		"duplicate-option": {
+			field:  CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", "home"}},
+			errMsg: "duplicate custom field option: home",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.field.Validate()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomField_NormalizeValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field  CustomField
+		value  any
+		want   any
+		errMsg string
+	}{
+		"text": {
+			field: CustomField{Name: "client", Type: CustomFieldType_TEXT},
+			value: "Acme",
+			want:  "Acme",
+		},
+		"text-too-long": {
+			field:  CustomField{Name: "client", Type: CustomFieldType_TEXT},
+			value:  strings.Repeat("a", MaxCustomFieldTextChars+1),
+			errMsg: "custom field client must be at most 500 characters",
+		},
+		"number-from-int": {
+			field: CustomField{Name: "points", Type: CustomFieldType_NUMBER},
+			value: 3,
+			want:  float64(3),
+		},
+		"number-from-json-number": {
+			field: CustomField{Name: "points", Type: CustomFieldType_NUMBER},
+			value: json.Number("2.5"),
+			want:  2.5,
+		},
+		"number-from-string": {
+			field:  CustomField{Name: "points", Type: CustomFieldType_NUMBER},
+			value:  "3",
+			errMsg: "custom field points must be a number",
+		},
+		"boolean": {
+			field: CustomField{Name: "billable", Type: CustomFieldType_BOOLEAN},
+			value: true,
+			want:  true,
+		},
+		"boolean-from-string": {
+			field:  CustomField{Name: "billable", Type: CustomFieldType_BOOLEAN},
+			value:  "yes",
+			errMsg: "custom field billable must be true or false",
+		},
+		"date": {
+			field: CustomField{Name: "start", Type: CustomFieldType_DATE},
+			value: "2026-05-01",
+			want:  "2026-05-01",
+		},
+		"invalid-date": {
+			field:  CustomField{Name: "start", Type: CustomFieldType_DATE},
+			value:  "May 1st",
+			errMsg: "custom field start must be a date in YYYY-MM-DD format",
+		},
+		"enum": {
+			field: CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", "work"}},
+			value: "work",
+			want:  "work",
+		},
+		"enum-unknown-option": {
+			field:  CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", "work"}},
+			value:  "garden",
+			errMsg: "custom field area must be one of home, work",
+		},
+		"string-field-with-number": {
+			field:  CustomField{Name: "client", Type: CustomFieldType_TEXT},
+			value:  12,
+			errMsg: "custom field client must be a string",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.field.NormalizeValue(tt.value)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomField_ParseValue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field  CustomField
+		raw    string
+		want   any
+		errMsg string
+	}{
+		"number": {
+			field: CustomField{Name: "points", Type: CustomFieldType_NUMBER},
+			raw:   "5",
+			want:  float64(5),
+		},
+		"invalid-number": {
+			field:  CustomField{Name: "points", Type: CustomFieldType_NUMBER},
+			raw:    "five",
+			errMsg: "custom field points must be a number",
+		},
+		"boolean": {
+			field: CustomField{Name: "billable", Type: CustomFieldType_BOOLEAN},
+			raw:   "false",
+			want:  false,
+		},
+		"invalid-boolean": {
+			field:  CustomField{Name: "billable", Type: CustomFieldType_BOOLEAN},
+			raw:    "maybe",
+			errMsg: "custom field billable must be true or false",
+		},
+		"enum": {
+			field: CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home"}},
+			raw:   "home",
+			want:  "home",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.field.ParseValue(tt.raw)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomFieldValues_Apply(t *testing.T) {
+	t.Parallel()
+
+	fields := []CustomField{
+		{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", "work"}},
+		{Name: "points", Type: CustomFieldType_NUMBER},
+	}
+
+	tests := map[string]struct {
+		current CustomFieldValues
+		changes CustomFieldValues
+		want    CustomFieldValues
+		errMsg  string
+	}{
+		"sets-values": {
+			changes: CustomFieldValues{"area": "home", "points": 2},
+			want:    CustomFieldValues{"area": "home", "points": float64(2)},
+		},
+		"merges-and-removes": {
+			current: CustomFieldValues{"area": "home", "points": float64(2)},
+			changes: CustomFieldValues{"area": "work", "points": nil},
+			want:    CustomFieldValues{"area": "work"},
+		},
+		"no-changes": {
+			current: CustomFieldValues{"area": "home"},
+			want:    CustomFieldValues{"area": "home"},
+		},
+		"unknown-field": {
+			changes: CustomFieldValues{"client": "Acme"},
+			errMsg:  "unknown custom field: client",
+		},
+		"invalid-value": {
+			changes: CustomFieldValues{"area": "garden"},
+			errMsg:  "custom field area must be one of home, work",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.current.Apply(tt.changes, fields)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// EmbeddingSlot identifies one of the two embeddings stored for each todo.
+type EmbeddingSlot string
+
+const (
+	// EmbeddingSlot_Primary is the embedding produced by the configured embedding model.
+	EmbeddingSlot_Primary EmbeddingSlot = "primary"
+	// EmbeddingSlot_Secondary is the embedding produced by the model being migrated to.
+	EmbeddingSlot_Secondary EmbeddingSlot = "secondary"
+)
+
+// Validate verifies the slot is a known embedding slot.
+func (s EmbeddingSlot) Validate() error {
+	if s != EmbeddingSlot_Primary && s != EmbeddingSlot_Secondary {
+		return core.NewValidationErr(fmt.Sprintf("invalid embedding slot: %s", s))
+	}
+	return nil
+}
+
+// EmbeddingBackfill is the checkpoint of a run that re-embeds the todos whose embedding is missing or was
+// produced by another embedding model, e.g. after a large CSV import or an embedding model change.
+// Stale todos are processed in ID order, so a run resumes after Cursor when it is interrupted.
+type EmbeddingBackfill struct {
+	// Model is the embedding model the run embeds todos with.
+	Model string
+	// Slot is the embedding of the todos the run fills.
+	Slot EmbeddingSlot
+	// Cursor is the ID of the last todo processed by the run.
+	Cursor uuid.UUID
+	// Total is the number of stale todos found when the run started.
+	Total int
+	// Processed is the number of todos embedded by the run so far.
+	Processed   int
+	StartedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// Completed reports whether the run has embedded every stale todo.
+func (b EmbeddingBackfill) Completed() bool {
+	return b.CompletedAt != nil
+}
+
+// Remaining returns the number of todos left to embed, based on the count taken when the run started.
+func (b EmbeddingBackfill) Remaining() int {
+	if b.Completed() || b.Processed >= b.Total {
+		return 0
+	}
+	return b.Total - b.Processed
+}
+
+// EmbeddingBackfillRepository defines the interface for storing embedding backfill checkpoints.
+type EmbeddingBackfillRepository interface {
+	// GetEmbeddingBackfill retrieves the latest run for the model, with a boolean indicating if it was found.
+	GetEmbeddingBackfill(ctx context.Context, model string) (EmbeddingBackfill, bool, error)
+	// SaveEmbeddingBackfill stores the checkpoint of a run, replacing the previous run for the same model.
+	SaveEmbeddingBackfill(ctx context.Context, backfill EmbeddingBackfill) error
+	// ListEmbeddingBackfills returns the latest run of every model, most recently updated first.
+	ListEmbeddingBackfills(ctx context.Context) ([]EmbeddingBackfill, error)
+}
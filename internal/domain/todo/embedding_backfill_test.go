@@ -0,0 +1,47 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddingBackfill_Remaining(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		backfill EmbeddingBackfill
+		expected int
+	}{
+		"in-progress": {
+			backfill: EmbeddingBackfill{Total: 120, Processed: 50},
+			expected: 70,
+		},
+		"processed-more-than-counted": {
+			backfill: EmbeddingBackfill{Total: 10, Processed: 12},
+			expected: 0,
+		},
+		"completed": {
+			backfill: EmbeddingBackfill{Total: 120, Processed: 100, CompletedAt: &completedAt},
+			expected: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.backfill.Remaining())
+		})
+	}
+}
+
+func TestEmbeddingSlot_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, EmbeddingSlot_Primary.Validate())
+	assert.NoError(t, EmbeddingSlot_Secondary.Validate())
+	assert.EqualError(t, EmbeddingSlot("tertiary").Validate(), "invalid embedding slot: tertiary")
+}
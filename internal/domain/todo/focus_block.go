@@ -0,0 +1,193 @@
+package todo
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+const (
+	// FocusBlockDays is the number of days, starting today, covered by focus block suggestions.
+	FocusBlockDays = 7
+	// MaxFocusBlockDuration is the longest focus block; larger estimates are split into several blocks.
+	MaxFocusBlockDuration = 2 * time.Hour
+	// minFocusBlockDuration is the shortest focus block, and the granularity of block start times.
+	minFocusBlockDuration = 15 * time.Minute
+)
+
+// FocusBlockStatus represents whether a focus block is a suggestion or a firm calendar entry.
+type FocusBlockStatus string
+
+const (
+	// FocusBlockStatus_SUGGESTED is a block computed from due dates and estimates; it is never stored.
+	FocusBlockStatus_SUGGESTED FocusBlockStatus = "SUGGESTED"
+	// FocusBlockStatus_ACCEPTED is a block the user accepted into the calendar.
+	FocusBlockStatus_ACCEPTED FocusBlockStatus = "ACCEPTED"
+)
+
+// FocusBlock is a calendar hold reserving time to work on one todo.
+type FocusBlock struct {
+	ID        uuid.UUID
+	TodoID    uuid.UUID
+	Title     string
+	Start     time.Time
+	End       time.Time
+	Status    FocusBlockStatus
+	CreatedAt time.Time
+}
+
+// FocusBlockID returns the ID of the block of a todo starting at start. Suggestions and the blocks accepted
+// from them share this ID, so a calendar client replaces the tentative event instead of duplicating it.
+func FocusBlockID(todoID uuid.UUID, start time.Time) uuid.UUID {
+	return uuid.NewSHA1(todoID, []byte(start.UTC().Format(time.RFC3339)))
+}
+
+// Duration returns the length of the focus block.
+func (b FocusBlock) Duration() time.Duration {
+	return b.End.Sub(b.Start)
+}
+
+// Overlaps reports whether the focus block shares any time with other.
+func (b FocusBlock) Overlaps(other FocusBlock) bool {
+	return b.Start.Before(other.End) && other.Start.Before(b.End)
+}
+
+// Validate checks if the focus block has valid fields.
+func (b FocusBlock) Validate() error {
+	if b.TodoID == uuid.Nil {
+		return core.NewValidationErr("todo_id cannot be empty")
+	}
+	if b.Start.IsZero() {
+		return core.NewValidationErr("start cannot be empty")
+	}
+	if b.Duration() < minFocusBlockDuration {
+		return core.NewValidationErr("focus block must last at least 15 minutes")
+	}
+	if b.Duration() > MaxFocusBlockDuration {
+		return core.NewValidationErr("focus block cannot exceed 2 hours")
+	}
+	return nil
+}
+
+// WorkHours is the daily window, in hours of the local day, in which focus blocks are suggested.
+type WorkHours struct {
+	StartHour int
+	EndHour   int
+}
+
+// Validate checks if the work hours describe a non-empty window within one day.
+func (h WorkHours) Validate() error {
+	if h.StartHour < 0 || h.EndHour > 24 || h.StartHour >= h.EndHour {
+		return core.NewValidationErr("work hours must start before they end, between 0 and 24")
+	}
+	return nil
+}
+
+// SuggestFocusBlocks reserves time for the open todos with an effort estimate, earliest due date first.
+// Each estimate is split into blocks of at most MaxFocusBlockDuration, placed in the earliest free slots of the
+// work hours between now and the end of the todo's due date; overdue todos are scheduled from today.
+// Todos that already have a block in busy are skipped, and the blocks in busy are never overlapped.
+// The part of an estimate that does not fit before the due date, or within FocusBlockDays, is not suggested.
+func SuggestFocusBlocks(todos []Todo, now time.Time, hours WorkHours, busy []FocusBlock) []FocusBlock {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	earliest := now.Truncate(minFocusBlockDuration)
+	if earliest.Before(now) {
+		earliest = earliest.Add(minFocusBlockDuration)
+	}
+
+	planned := make(map[uuid.UUID]bool, len(busy))
+	for _, b := range busy {
+		planned[b.TodoID] = true
+	}
+	taken := slices.Clone(busy)
+
+	candidates := make([]Todo, 0, len(todos))
+	for _, t := range todos {
+		if t.Status == Status_OPEN && t.EstimatedMinutes > 0 && !planned[t.ID] {
+			candidates = append(candidates, t)
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b Todo) int {
+		return a.DueDate.Compare(b.DueDate)
+	})
+
+	suggested := []FocusBlock{}
+	for _, t := range candidates {
+		lastDay := min(
+			int(time.Date(t.DueDate.Year(), t.DueDate.Month(), t.DueDate.Day(), 0, 0, 0, 0, today.Location()).Sub(today).Hours()/24),
+			FocusBlockDays-1,
+		)
+		remaining := time.Duration(t.EstimatedMinutes) * time.Minute
+		for day := 0; day <= max(lastDay, 0) && remaining > 0; day++ {
+			date := today.AddDate(0, 0, day)
+			for remaining > 0 {
+				length := min(remaining, MaxFocusBlockDuration)
+				start, ok := freeSlot(taken, date, hours, earliest, max(length, minFocusBlockDuration))
+				if !ok {
+					break
+				}
+				block := FocusBlock{
+					ID:     FocusBlockID(t.ID, start),
+					TodoID: t.ID,
+					Title:  t.Title,
+					Start:  start,
+					End:    start.Add(max(length, minFocusBlockDuration)),
+					Status: FocusBlockStatus_SUGGESTED,
+				}
+				taken = append(taken, block)
+				suggested = append(suggested, block)
+				remaining -= length
+			}
+		}
+	}
+
+	slices.SortFunc(suggested, func(a, b FocusBlock) int {
+		return cmp.Compare(a.Start.UnixNano(), b.Start.UnixNano())
+	})
+	return suggested
+}
+
+// freeSlot returns the earliest start on date, within the work hours and not before earliest, where a block
+// of the given length does not overlap any taken block.
+func freeSlot(taken []FocusBlock, date time.Time, hours WorkHours, earliest time.Time, length time.Duration) (time.Time, bool) {
+	start := date.Add(time.Duration(hours.StartHour) * time.Hour)
+	end := date.Add(time.Duration(hours.EndHour) * time.Hour)
+	if start.Before(earliest) {
+		start = earliest
+	}
+
+	dayBlocks := []FocusBlock{}
+	for _, b := range taken {
+		if b.Start.Before(end) && start.Before(b.End) {
+			dayBlocks = append(dayBlocks, b)
+		}
+	}
+	slices.SortFunc(dayBlocks, func(a, b FocusBlock) int {
+		return cmp.Compare(a.Start.UnixNano(), b.Start.UnixNano())
+	})
+
+	for _, b := range dayBlocks {
+		if !start.Add(length).After(b.Start) {
+			break
+		}
+		if b.End.After(start) {
+			start = b.End
+		}
+	}
+	if start.Add(length).After(end) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// FocusBlockRepository defines the interface for storing accepted focus blocks.
+type FocusBlockRepository interface {
+	// CreateFocusBlocks stores new accepted focus blocks.
+	CreateFocusBlocks(ctx context.Context, blocks []FocusBlock) error
+	// ListFocusBlocks returns the accepted focus blocks starting in the [from, to) range, earliest first.
+	ListFocusBlocks(ctx context.Context, from, to time.Time) ([]FocusBlock, error)
+}
@@ -0,0 +1,163 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFocusBlock_Validate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	todoID := uuid.New()
+
+	tests := map[string]struct {
+		block  FocusBlock
+		errMsg string
+	}{
+		"valid": {
+			block: FocusBlock{TodoID: todoID, Start: start, End: start.Add(time.Hour)},
+		},
+		"missing-todo-id": {
+			block:  FocusBlock{Start: start, End: start.Add(time.Hour)},
+			errMsg: "todo_id cannot be empty",
+		},
+		"missing-start": {
+			block:  FocusBlock{TodoID: todoID},
+			errMsg: "start cannot be empty",
+		},
+		"too-short": {
+			block:  FocusBlock{TodoID: todoID, Start: start, End: start.Add(10 * time.Minute)},
+			errMsg: "focus block must last at least 15 minutes",
+		},
+		"too-long": {
+			block:  FocusBlock{TodoID: todoID, Start: start, End: start.Add(3 * time.Hour)},
+			errMsg: "focus block cannot exceed 2 hours",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.block.Validate()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSuggestFocusBlocks(t *testing.T) {
+	t.Parallel()
+
+	// 2026-03-02 is a Monday.
+	now := time.Date(2026, 3, 2, 10, 5, 0, 0, time.UTC)
+	hours := WorkHours{StartHour: 9, EndHour: 17}
+	at := func(dayOffset, hour, minute int) time.Time {
+		return time.Date(2026, 3, 2+dayOffset, hour, minute, 0, 0, time.UTC)
+	}
+	day := func(offset int) time.Time {
+		return time.Date(2026, 3, 2+offset, 0, 0, 0, 0, time.UTC)
+	}
+	id1, id2, id3 := uuid.New(), uuid.New(), uuid.New()
+
+	type span struct {
+		todoID     uuid.UUID
+		start, end time.Time
+	}
+
+	tests := map[string]struct {
+		todos    []Todo
+		busy     []FocusBlock
+		expected []span
+	}{
+		"starts-after-now-on-the-quarter-hour": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(1), EstimatedMinutes: 60},
+			},
+			expected: []span{{id1, at(0, 10, 15), at(0, 11, 15)}},
+		},
+		"earliest-due-date-first": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(3), EstimatedMinutes: 60},
+				{ID: id2, Status: Status_OPEN, DueDate: day(1), EstimatedMinutes: 30},
+			},
+			expected: []span{
+				{id2, at(0, 10, 15), at(0, 10, 45)},
+				{id1, at(0, 10, 45), at(0, 11, 45)},
+			},
+		},
+		"large-estimate-is-split": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(2), EstimatedMinutes: 300},
+			},
+			expected: []span{
+				{id1, at(0, 10, 15), at(0, 12, 15)},
+				{id1, at(0, 12, 15), at(0, 14, 15)},
+				{id1, at(0, 14, 15), at(0, 15, 15)},
+			},
+		},
+		"busy-blocks-are-skipped": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(2), EstimatedMinutes: 60},
+				{ID: id3, Status: Status_OPEN, DueDate: day(2), EstimatedMinutes: 60},
+			},
+			busy: []FocusBlock{
+				{TodoID: id2, Start: at(0, 10, 30), End: at(0, 16, 30)},
+				{TodoID: id3, Start: at(1, 9, 0), End: at(1, 10, 0)},
+			},
+			expected: []span{{id1, at(1, 10, 0), at(1, 11, 0)}},
+		},
+		"overdue-todo-is-scheduled-today": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(-3), EstimatedMinutes: 45},
+			},
+			expected: []span{{id1, at(0, 10, 15), at(0, 11, 0)}},
+		},
+		"nothing-fits-before-due-date": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(0), EstimatedMinutes: 120},
+			},
+			busy: []FocusBlock{
+				{TodoID: id2, Start: at(0, 11, 0), End: at(0, 16, 0)},
+			},
+			expected: []span{},
+		},
+		"done-and-unestimated-todos-are-ignored": {
+			todos: []Todo{
+				{ID: id1, Status: Status_DONE, DueDate: day(1), EstimatedMinutes: 60},
+				{ID: id2, Status: Status_OPEN, DueDate: day(1)},
+			},
+			expected: []span{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			blocks := SuggestFocusBlocks(tt.todos, now, hours, tt.busy)
+			got := make([]span, 0, len(blocks))
+			for _, b := range blocks {
+				assert.Equal(t, FocusBlockStatus_SUGGESTED, b.Status)
+				assert.Equal(t, FocusBlockID(b.TodoID, b.Start), b.ID)
+				got = append(got, span{b.TodoID, b.Start, b.End})
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestWorkHours_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, WorkHours{StartHour: 9, EndHour: 17}.Validate())
+	assert.Error(t, WorkHours{StartHour: 17, EndHour: 9}.Validate())
+	assert.Error(t, WorkHours{StartHour: 0, EndHour: 25}.Validate())
+}
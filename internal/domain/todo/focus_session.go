@@ -0,0 +1,49 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// MaxFocusSessionDuration is the longest focus session that can be started.
+const MaxFocusSessionDuration = 4 * time.Hour
+
+// FocusSession represents a timed pomodoro-style work session on one todo.
+type FocusSession struct {
+	ID             uuid.UUID
+	TodoID         uuid.UUID
+	ConversationID *uuid.UUID
+	Duration       time.Duration
+	StartedAt      time.Time
+}
+
+// EndsAt returns the time when the focus session completes.
+func (s FocusSession) EndsAt() time.Time {
+	return s.StartedAt.Add(s.Duration)
+}
+
+// Validate checks if the focus session has valid fields.
+func (s FocusSession) Validate() error {
+	if s.TodoID == uuid.Nil {
+		return core.NewValidationErr("todo_id cannot be empty")
+	}
+	if s.Duration <= 0 {
+		return core.NewValidationErr("duration must be greater than zero")
+	}
+	if s.Duration > MaxFocusSessionDuration {
+		return core.NewValidationErr("duration cannot exceed 4 hours")
+	}
+	if s.StartedAt.IsZero() {
+		return core.NewValidationErr("started_at cannot be empty")
+	}
+	return nil
+}
+
+// FocusSessionNotifier delivers focus session notifications outside of a chat stream.
+type FocusSessionNotifier interface {
+	// NotifyFocusSessionCompleted notifies the user that a focus session has finished.
+	NotifyFocusSessionCompleted(ctx context.Context, session FocusSession) error
+}
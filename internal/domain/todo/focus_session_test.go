@@ -0,0 +1,61 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFocusSession_EndsAt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	session := FocusSession{StartedAt: start, Duration: 25 * time.Minute}
+
+	assert.Equal(t, start.Add(25*time.Minute), session.EndsAt())
+}
+
+func TestFocusSession_Validate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		session FocusSession
+		errMsg  string
+	}{
+		"valid": {
+			session: FocusSession{TodoID: uuid.New(), Duration: 25 * time.Minute, StartedAt: start},
+		},
+		"missing-todo-id": {
+			session: FocusSession{Duration: 25 * time.Minute, StartedAt: start},
+			errMsg:  "todo_id cannot be empty",
+		},
+		"zero-duration": {
+			session: FocusSession{TodoID: uuid.New(), StartedAt: start},
+			errMsg:  "duration must be greater than zero",
+		},
+		"duration-too-long": {
+			session: FocusSession{TodoID: uuid.New(), Duration: 5 * time.Hour, StartedAt: start},
+			errMsg:  "duration cannot exceed 4 hours",
+		},
+		"missing-started-at": {
+			session: FocusSession{TodoID: uuid.New(), Duration: 25 * time.Minute},
+			errMsg:  "started_at cannot be empty",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.session.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
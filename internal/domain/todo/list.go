@@ -1,12 +1,26 @@
 package todo
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 )
 
+// MaxSimilarityDistance is the largest cosine distance between a todo and the query embedding for the todo to
+// match a similarity search.
+const MaxSimilarityDistance = 0.5
+
+// SortSmartOrder orders todos by how urgently they need attention: overdue todos first, then by the rank of
+// their priority custom field and by how close their due date is.
+const SortSmartOrder = "smartOrder"
+
+// PriorityCustomField is the name of the ENUM custom field whose options, from highest to lowest, rank todos
+// in the smart order.
+const PriorityCustomField = "priority"
+
 // SortBy represents sorting criteria for listing todos.
 type SortBy struct {
 	Field     string
@@ -19,6 +33,7 @@ func (s *SortBy) Validate() error {
 		"createdAt":  "created_at",
 		"dueDate":    "due_date",
 		"similarity": "similarity",
+		"smartOrder": "smart_order",
 	}
 	val, ok := allowedFields[s.Field]
 	if !ok {
@@ -33,17 +48,45 @@ func (s *SortBy) Validate() error {
 
 // ListParams represents the parameters for listing todo items.
 type ListParams struct {
-	Status        *Status
-	Embedding     []float64
-	TitleContains *string
-	DueAfter      *time.Time
-	DueBefore     *time.Time
-	SortBy        *SortBy
+	Status    *Status
+	Embedding []float64
+	// EmbeddingModel is the model that produced Embedding. When set, each todo is compared through the
+	// embedding produced by the same model, and the query must have the dimension of the stored embeddings.
+	EmbeddingModel string
+	TitleContains  *string
+	DueAfter       *time.Time
+	DueBefore      *time.Time
+	SortBy         *SortBy
+	// IncludeArchived lists archived todos along with the active ones. They are excluded by default.
+	IncludeArchived bool
+	// CustomFields keeps the todos whose custom fields hold all the given values.
+	CustomFields CustomFieldValues
+	// ExcludedCustomFields drops the todos whose custom fields hold any of the given values.
+	ExcludedCustomFields []CustomFieldValues
+	// Near keeps the todos whose location is within a radius of a point.
+	Near *NearFilter
+	// Today is the current date the smart order measures overdue todos and due proximity against.
+	Today time.Time
+}
+
+// NearFilter selects the todos located within RadiusKm kilometers of Point.
+type NearFilter struct {
+	Point    GeoPoint
+	RadiusKm float64
 }
 
 // ListOption defines a function type for modifying ListParams.
 type ListOption func(*ListParams)
 
+// NewListParams returns the parameters set by the options.
+func NewListParams(opts ...ListOption) ListParams {
+	params := ListParams{}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	return params
+}
+
 // WithStatus filters todos by their status.
 func WithStatus(status Status) ListOption {
 	return func(params *ListParams) {
@@ -58,6 +101,13 @@ func WithEmbedding(embedding []float64) ListOption {
 	}
 }
 
+// WithEmbeddingModel sets the model that produced the embedding given to WithEmbedding.
+func WithEmbeddingModel(model string) ListOption {
+	return func(params *ListParams) {
+		params.EmbeddingModel = model
+	}
+}
+
 // WithTitleContains filters todos whose title contains the specified substring.
 func WithTitleContains(substring string) ListOption {
 	return func(params *ListParams) {
@@ -73,9 +123,49 @@ func WithDueDateRange(dueAfter, dueBefore time.Time) ListOption {
 	}
 }
 
+// WithIncludeArchived includes archived todos in the listing.
+func WithIncludeArchived() ListOption {
+	return func(params *ListParams) {
+		params.IncludeArchived = true
+	}
+}
+
+// WithCustomFields filters todos whose custom fields hold all the given values.
+func WithCustomFields(values CustomFieldValues) ListOption {
+	return func(params *ListParams) {
+		params.CustomFields = values
+	}
+}
+
+// WithoutCustomFields drops the todos whose custom fields hold any of the given values.
+func WithoutCustomFields(values []CustomFieldValues) ListOption {
+	return func(params *ListParams) {
+		params.ExcludedCustomFields = values
+	}
+}
+
+// WithNear filters todos located within radiusKm kilometers of the point.
+func WithNear(point GeoPoint, radiusKm float64) ListOption {
+	return func(params *ListParams) {
+		params.Near = &NearFilter{Point: point, RadiusKm: radiusKm}
+	}
+}
+
+// WithToday sets the current date used by the smart order.
+func WithToday(today time.Time) ListOption {
+	return func(params *ListParams) {
+		params.Today = today
+	}
+}
+
 // WithSortBy sets sorting criteria for listing todos.
 func WithSortBy(sort string) ListOption {
 	return func(params *ListParams) {
+		if sort == SortSmartOrder {
+			// The smart order only ranks the most urgent todos first.
+			params.SortBy = &SortBy{Field: sort, Direction: "DESC"}
+			return
+		}
 		if after, ok := strings.CutSuffix(sort, "Desc"); ok {
 			params.SortBy = &SortBy{Field: after, Direction: "DESC"}
 			return
@@ -87,3 +177,76 @@ func WithSortBy(sort string) ListOption {
 		params.SortBy = &SortBy{Field: sort, Direction: ""}
 	}
 }
+
+// IsTodayView reports whether the params select the default board view: open todos sorted by
+// ascending due date, without search, due date, custom field or location filters.
+func (p ListParams) IsTodayView() bool {
+	return p.Status != nil && *p.Status == Status_OPEN &&
+		p.SortBy != nil && p.SortBy.Field == "dueDate" && p.SortBy.Direction == "ASC" &&
+		p.Embedding == nil && p.TitleContains == nil && p.DueAfter == nil && p.DueBefore == nil &&
+		len(p.CustomFields) == 0 && len(p.ExcludedCustomFields) == 0 && p.Near == nil
+}
+
+// Explain describes the query the params select as a SQL-like statement, e.g. to show why todos did or did
+// not match a search. The query embedding is not included.
+func (p ListParams) Explain() string {
+	conditions := []string{}
+	if p.Status != nil {
+		conditions = append(conditions, fmt.Sprintf("status = '%s'", *p.Status))
+	}
+	if len(p.Embedding) > 0 {
+		conditions = append(conditions, fmt.Sprintf("cosine_distance(embedding, query_embedding) < %g", MaxSimilarityDistance))
+	}
+	if p.TitleContains != nil {
+		conditions = append(conditions, fmt.Sprintf("title ILIKE '%%%s%%'", *p.TitleContains))
+	}
+	if p.DueAfter != nil && p.DueBefore != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"due_date BETWEEN '%s' AND '%s'", p.DueAfter.Format(time.DateOnly), p.DueBefore.Format(time.DateOnly),
+		))
+	}
+	if len(p.CustomFields) > 0 {
+		conditions = append(conditions, "custom_fields CONTAINS "+explainJSON(p.CustomFields))
+	}
+	for _, excluded := range p.ExcludedCustomFields {
+		conditions = append(conditions, "NOT custom_fields CONTAINS "+explainJSON(excluded))
+	}
+	if p.Near != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"distance_km(location, (%g, %g)) <= %g", p.Near.Point.Latitude, p.Near.Point.Longitude, p.Near.RadiusKm,
+		))
+	}
+	if !p.IncludeArchived {
+		conditions = append(conditions, "archived_at IS NULL")
+	}
+
+	var b strings.Builder
+	b.WriteString("SELECT todos")
+	if len(conditions) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(conditions, " AND "))
+	}
+	b.WriteString(" ORDER BY ")
+	switch {
+	case p.SortBy == nil:
+		b.WriteString("due_date ASC")
+	case p.SortBy.Field == "similarity":
+		b.WriteString("cosine_distance(embedding, query_embedding) " + p.SortBy.Direction)
+	case p.SortBy.Field == SortSmartOrder:
+		b.WriteString("smart_order_score DESC, due_date ASC")
+	default:
+		sortBy := *p.SortBy
+		_ = sortBy.Validate() // an invalid sort is described as given; the repository rejects it
+		b.WriteString(sortBy.Field + " " + sortBy.Direction)
+	}
+	return b.String()
+}
+
+// explainJSON renders custom field values for Explain.
+func explainJSON(values CustomFieldValues) string {
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return "{}"
+	}
+	return string(encoded)
+}
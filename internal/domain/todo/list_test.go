@@ -0,0 +1,116 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListParams_IsTodayView(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []ListOption
+		want bool
+	}{
+		"open-by-due-date": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy("dueDateAsc")},
+			want: true,
+		},
+		"no-filters": {
+			want: false,
+		},
+		"done-status": {
+			opts: []ListOption{WithStatus(Status_DONE), WithSortBy("dueDateAsc")},
+			want: false,
+		},
+		"descending-sort": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy("dueDateDesc")},
+			want: false,
+		},
+		"title-search": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy("dueDateAsc"), WithTitleContains("milk")},
+			want: false,
+		},
+		"due-range": {
+			opts: []ListOption{
+				WithStatus(Status_OPEN),
+				WithSortBy("dueDateAsc"),
+				WithDueDateRange(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)),
+			},
+			want: false,
+		},
+		"custom-field-filter": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy("dueDateAsc"), WithCustomFields(CustomFieldValues{"area": "home"})},
+			want: false,
+		},
+		"excluded-custom-field": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy("dueDateAsc"), WithoutCustomFields([]CustomFieldValues{{"area": "home"}})},
+			want: false,
+		},
+		"similarity-search": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy("dueDateAsc"), WithEmbedding([]float64{0.1})},
+			want: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params := ListParams{}
+			for _, opt := range tt.opts {
+				opt(&params)
+			}
+			assert.Equal(t, tt.want, params.IsTodayView())
+		})
+	}
+}
+
+func TestListParams_Explain(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []ListOption
+		want string
+	}{
+		"no-filters": {
+			want: "SELECT todos WHERE archived_at IS NULL ORDER BY due_date ASC",
+		},
+		"all-filters": {
+			opts: []ListOption{
+				WithStatus(Status_OPEN),
+				WithEmbedding([]float64{0.1}),
+				WithTitleContains("milk"),
+				WithDueDateRange(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)),
+				WithCustomFields(CustomFieldValues{"area": "home"}),
+				WithoutCustomFields([]CustomFieldValues{{"area": "work"}}),
+				WithIncludeArchived(),
+				WithSortBy("similarityAsc"),
+			},
+			want: "SELECT todos WHERE status = 'OPEN' AND cosine_distance(embedding, query_embedding) < 0.5 AND title ILIKE '%milk%' " +
+				"AND due_date BETWEEN '2026-01-01' AND '2026-01-07' AND custom_fields CONTAINS {\"area\":\"home\"} " +
+				"AND NOT custom_fields CONTAINS {\"area\":\"work\"} ORDER BY cosine_distance(embedding, query_embedding) ASC",
+		},
+		"sort-by-created-at": {
+			opts: []ListOption{WithStatus(Status_DONE), WithSortBy("createdAtDesc")},
+			want: "SELECT todos WHERE status = 'DONE' AND archived_at IS NULL ORDER BY created_at DESC",
+		},
+		"near": {
+			opts: []ListOption{WithNear(GeoPoint{Latitude: 38.7223, Longitude: -9.1393}, 2)},
+			want: "SELECT todos WHERE distance_km(location, (38.7223, -9.1393)) <= 2 AND archived_at IS NULL ORDER BY due_date ASC",
+		},
+		"smart-order": {
+			opts: []ListOption{WithStatus(Status_OPEN), WithSortBy(SortSmartOrder)},
+			want: "SELECT todos WHERE status = 'OPEN' AND archived_at IS NULL ORDER BY smart_order_score DESC, due_date ASC",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, NewListParams(tt.opts...).Explain())
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package todo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+const (
+	// MaxLocationNameChars is the maximum number of characters allowed in a location name.
+	MaxLocationNameChars = 200
+	// DefaultNearRadiusKm is the radius of a proximity search when none is given.
+	DefaultNearRadiusKm = 2.0
+	// MaxNearRadiusKm is the largest radius of a proximity search.
+	MaxNearRadiusKm = 100.0
+	// earthRadiusKm is the mean radius of the Earth used for great-circle distances.
+	earthRadiusKm = 6371.0
+)
+
+// GeoPoint is a WGS 84 coordinate in decimal degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Validate checks that the coordinate is within the valid latitude and longitude ranges.
+func (p GeoPoint) Validate() error {
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return core.NewValidationErr("latitude must be between -90 and 90")
+	}
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return core.NewValidationErr("longitude must be between -180 and 180")
+	}
+	return nil
+}
+
+// DistanceKm returns the great-circle distance to another point, in kilometers.
+func (p GeoPoint) DistanceKm(other GeoPoint) float64 {
+	lat1, lat2 := p.Latitude*math.Pi/180, other.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - p.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Location is where a todo is done: a free text name, such as "Downtown pharmacy", and optionally its
+// coordinates. Only todos with coordinates match proximity searches.
+type Location struct {
+	Name  string
+	Point *GeoPoint
+}
+
+// IsZero reports whether the location has neither a name nor coordinates.
+func (l Location) IsZero() bool {
+	return strings.TrimSpace(l.Name) == "" && l.Point == nil
+}
+
+// Validate checks if the location has valid fields.
+func (l Location) Validate() error {
+	if l.IsZero() {
+		return core.NewValidationErr("location must have a name or coordinates")
+	}
+	if utf8.RuneCountInString(l.Name) > MaxLocationNameChars {
+		return core.NewValidationErr(fmt.Sprintf("location name cannot exceed %d characters", MaxLocationNameChars))
+	}
+	if l.Point != nil {
+		return l.Point.Validate()
+	}
+	return nil
+}
+
+// String describes the location for people and prompts, e.g. "Downtown pharmacy (38.7223, -9.1393)".
+func (l Location) String() string {
+	name := strings.TrimSpace(l.Name)
+	if l.Point == nil {
+		return name
+	}
+	coords := fmt.Sprintf("(%.4f, %.4f)", l.Point.Latitude, l.Point.Longitude)
+	if name == "" {
+		return coords
+	}
+	return name + " " + coords
+}
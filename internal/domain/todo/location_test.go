@@ -0,0 +1,71 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocation_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		location Location
+		errMsg   string
+	}{
+		"name-only": {
+			location: Location{Name: "Downtown pharmacy"},
+		},
+		"point-only": {
+			location: Location{Point: &GeoPoint{Latitude: 38.7223, Longitude: -9.1393}},
+		},
+		"empty": {
+			location: Location{Name: "  "},
+			errMsg:   "location must have a name or coordinates",
+		},
+		"name-too-long": {
+			location: Location{Name: strings.Repeat("a", MaxLocationNameChars+1)},
+			errMsg:   "location name cannot exceed 200 characters",
+		},
+		"invalid-latitude": {
+			location: Location{Name: "Pole", Point: &GeoPoint{Latitude: -90.5}},
+			errMsg:   "latitude must be between -90 and 90",
+		},
+		"invalid-longitude": {
+			location: Location{Name: "Dateline", Point: &GeoPoint{Longitude: 180.5}},
+			errMsg:   "longitude must be between -180 and 180",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.location.Validate()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLocation_String(t *testing.T) {
+	t.Parallel()
+
+	point := &GeoPoint{Latitude: 38.72231, Longitude: -9.13934}
+	assert.Equal(t, "Downtown pharmacy (38.7223, -9.1393)", Location{Name: "Downtown pharmacy", Point: point}.String())
+	assert.Equal(t, "Downtown pharmacy", Location{Name: " Downtown pharmacy "}.String())
+	assert.Equal(t, "(38.7223, -9.1393)", Location{Point: point}.String())
+}
+
+func TestGeoPoint_DistanceKm(t *testing.T) {
+	t.Parallel()
+
+	lisbon := GeoPoint{Latitude: 38.7223, Longitude: -9.1393}
+	porto := GeoPoint{Latitude: 41.1579, Longitude: -8.6291}
+	assert.InDelta(t, 274, lisbon.DistanceKm(porto), 1)
+	assert.Zero(t, lisbon.DistanceKm(lisbon))
+}
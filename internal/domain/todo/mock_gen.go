@@ -6,18 +6,3903 @@ package todo
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockChangeRepository creates a new instance of MockChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockChangeRepository {
+	mock := &MockChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockChangeRepository is an autogenerated mock type for the ChangeRepository type
+type MockChangeRepository struct {
+	mock.Mock
+}
+
+type MockChangeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockChangeRepository) EXPECT() *MockChangeRepository_Expecter {
+	return &MockChangeRepository_Expecter{mock: &_m.Mock}
+}
+
+// LatestSequences provides a mock function for the type MockChangeRepository
+func (_mock *MockChangeRepository) LatestSequences(ctx context.Context, conversationID uuid.UUID) (ChangeSequences, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestSequences")
+	}
+
+	var r0 ChangeSequences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (ChangeSequences, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ChangeSequences); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Get(0).(ChangeSequences)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChangeRepository_LatestSequences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestSequences'
+type MockChangeRepository_LatestSequences_Call struct {
+	*mock.Call
+}
+
+// LatestSequences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockChangeRepository_Expecter) LatestSequences(ctx interface{}, conversationID interface{}) *MockChangeRepository_LatestSequences_Call {
+	return &MockChangeRepository_LatestSequences_Call{Call: _e.mock.On("LatestSequences", ctx, conversationID)}
+}
+
+func (_c *MockChangeRepository_LatestSequences_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockChangeRepository_LatestSequences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChangeRepository_LatestSequences_Call) Return(changeSequences ChangeSequences, err error) *MockChangeRepository_LatestSequences_Call {
+	_c.Call.Return(changeSequences, err)
+	return _c
+}
+
+func (_c *MockChangeRepository_LatestSequences_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) (ChangeSequences, error)) *MockChangeRepository_LatestSequences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChangesSince provides a mock function for the type MockChangeRepository
+func (_mock *MockChangeRepository) ListChangesSince(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]Change, bool, error) {
+	ret := _mock.Called(ctx, since, conversationID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChangesSince")
+	}
+
+	var r0 []Change
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *uuid.UUID, int) ([]Change, bool, error)); ok {
+		return returnFunc(ctx, since, conversationID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *uuid.UUID, int) []Change); ok {
+		r0 = returnFunc(ctx, since, conversationID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Change)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *uuid.UUID, int) bool); ok {
+		r1 = returnFunc(ctx, since, conversationID, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *uuid.UUID, int) error); ok {
+		r2 = returnFunc(ctx, since, conversationID, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockChangeRepository_ListChangesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChangesSince'
+type MockChangeRepository_ListChangesSince_Call struct {
+	*mock.Call
+}
+
+// ListChangesSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since int64
+//   - conversationID *uuid.UUID
+//   - limit int
+func (_e *MockChangeRepository_Expecter) ListChangesSince(ctx interface{}, since interface{}, conversationID interface{}, limit interface{}) *MockChangeRepository_ListChangesSince_Call {
+	return &MockChangeRepository_ListChangesSince_Call{Call: _e.mock.On("ListChangesSince", ctx, since, conversationID, limit)}
+}
+
+func (_c *MockChangeRepository_ListChangesSince_Call) Run(run func(ctx context.Context, since int64, conversationID *uuid.UUID, limit int)) *MockChangeRepository_ListChangesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChangeRepository_ListChangesSince_Call) Return(changes []Change, b bool, err error) *MockChangeRepository_ListChangesSince_Call {
+	_c.Call.Return(changes, b, err)
+	return _c
+}
+
+func (_c *MockChangeRepository_ListChangesSince_Call) RunAndReturn(run func(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]Change, bool, error)) *MockChangeRepository_ListChangesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordChange provides a mock function for the type MockChangeRepository
+func (_mock *MockChangeRepository) RecordChange(ctx context.Context, change Change) (Change, error) {
+	ret := _mock.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordChange")
+	}
+
+	var r0 Change
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Change) (Change, error)); ok {
+		return returnFunc(ctx, change)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Change) Change); ok {
+		r0 = returnFunc(ctx, change)
+	} else {
+		r0 = ret.Get(0).(Change)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, Change) error); ok {
+		r1 = returnFunc(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockChangeRepository_RecordChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordChange'
+type MockChangeRepository_RecordChange_Call struct {
+	*mock.Call
+}
+
+// RecordChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change Change
+func (_e *MockChangeRepository_Expecter) RecordChange(ctx interface{}, change interface{}) *MockChangeRepository_RecordChange_Call {
+	return &MockChangeRepository_RecordChange_Call{Call: _e.mock.On("RecordChange", ctx, change)}
+}
+
+func (_c *MockChangeRepository_RecordChange_Call) Run(run func(ctx context.Context, change Change)) *MockChangeRepository_RecordChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Change
+		if args[1] != nil {
+			arg1 = args[1].(Change)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockChangeRepository_RecordChange_Call) Return(change1 Change, err error) *MockChangeRepository_RecordChange_Call {
+	_c.Call.Return(change1, err)
+	return _c
+}
+
+func (_c *MockChangeRepository_RecordChange_Call) RunAndReturn(run func(ctx context.Context, change Change) (Change, error)) *MockChangeRepository_RecordChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommentRepository creates a new instance of MockCommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentRepository {
+	mock := &MockCommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCommentRepository is an autogenerated mock type for the CommentRepository type
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+type MockCommentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentRepository) EXPECT() *MockCommentRepository_Expecter {
+	return &MockCommentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) CreateComment(ctx context.Context, comment Comment) error {
+	ret := _mock.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Comment) error); ok {
+		r0 = returnFunc(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentRepository_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockCommentRepository_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment Comment
+func (_e *MockCommentRepository_Expecter) CreateComment(ctx interface{}, comment interface{}) *MockCommentRepository_CreateComment_Call {
+	return &MockCommentRepository_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, comment)}
+}
+
+func (_c *MockCommentRepository_CreateComment_Call) Run(run func(ctx context.Context, comment Comment)) *MockCommentRepository_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Comment
+		if args[1] != nil {
+			arg1 = args[1].(Comment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_CreateComment_Call) Return(err error) *MockCommentRepository_CreateComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentRepository_CreateComment_Call) RunAndReturn(run func(ctx context.Context, comment Comment) error) *MockCommentRepository_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentRepository_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockCommentRepository_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockCommentRepository_Expecter) DeleteComment(ctx interface{}, id interface{}) *MockCommentRepository_DeleteComment_Call {
+	return &MockCommentRepository_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, id)}
+}
+
+func (_c *MockCommentRepository_DeleteComment_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockCommentRepository_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_DeleteComment_Call) Return(err error) *MockCommentRepository_DeleteComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentRepository_DeleteComment_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockCommentRepository_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComment provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) GetComment(ctx context.Context, id uuid.UUID) (Comment, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComment")
+	}
+
+	var r0 Comment
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Comment, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Comment); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Comment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCommentRepository_GetComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComment'
+type MockCommentRepository_GetComment_Call struct {
+	*mock.Call
+}
+
+// GetComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockCommentRepository_Expecter) GetComment(ctx interface{}, id interface{}) *MockCommentRepository_GetComment_Call {
+	return &MockCommentRepository_GetComment_Call{Call: _e.mock.On("GetComment", ctx, id)}
+}
+
+func (_c *MockCommentRepository_GetComment_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockCommentRepository_GetComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_GetComment_Call) Return(comment Comment, b bool, err error) *MockCommentRepository_GetComment_Call {
+	_c.Call.Return(comment, b, err)
+	return _c
+}
+
+func (_c *MockCommentRepository_GetComment_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Comment, bool, error)) *MockCommentRepository_GetComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) ListComments(ctx context.Context, todoID uuid.UUID, page int, pageSize int) ([]Comment, bool, error) {
+	ret := _mock.Called(ctx, todoID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []Comment
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]Comment, bool, error)); ok {
+		return returnFunc(ctx, todoID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []Comment); ok {
+		r0 = returnFunc(ctx, todoID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) bool); ok {
+		r1 = returnFunc(ctx, todoID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, todoID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockCommentRepository_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockCommentRepository_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+//   - page int
+//   - pageSize int
+func (_e *MockCommentRepository_Expecter) ListComments(ctx interface{}, todoID interface{}, page interface{}, pageSize interface{}) *MockCommentRepository_ListComments_Call {
+	return &MockCommentRepository_ListComments_Call{Call: _e.mock.On("ListComments", ctx, todoID, page, pageSize)}
+}
+
+func (_c *MockCommentRepository_ListComments_Call) Run(run func(ctx context.Context, todoID uuid.UUID, page int, pageSize int)) *MockCommentRepository_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_ListComments_Call) Return(comments []Comment, b bool, err error) *MockCommentRepository_ListComments_Call {
+	_c.Call.Return(comments, b, err)
+	return _c
+}
+
+func (_c *MockCommentRepository_ListComments_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, page int, pageSize int) ([]Comment, bool, error)) *MockCommentRepository_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentComments provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) ListRecentComments(ctx context.Context, todoIDs []uuid.UUID, perTodo int) (map[uuid.UUID][]Comment, error) {
+	ret := _mock.Called(ctx, todoIDs, perTodo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRecentComments")
+	}
+
+	var r0 map[uuid.UUID][]Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) (map[uuid.UUID][]Comment, error)); ok {
+		return returnFunc(ctx, todoIDs, perTodo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) map[uuid.UUID][]Comment); ok {
+		r0 = returnFunc(ctx, todoIDs, perTodo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, todoIDs, perTodo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentRepository_ListRecentComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRecentComments'
+type MockCommentRepository_ListRecentComments_Call struct {
+	*mock.Call
+}
+
+// ListRecentComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoIDs []uuid.UUID
+//   - perTodo int
+func (_e *MockCommentRepository_Expecter) ListRecentComments(ctx interface{}, todoIDs interface{}, perTodo interface{}) *MockCommentRepository_ListRecentComments_Call {
+	return &MockCommentRepository_ListRecentComments_Call{Call: _e.mock.On("ListRecentComments", ctx, todoIDs, perTodo)}
+}
+
+func (_c *MockCommentRepository_ListRecentComments_Call) Run(run func(ctx context.Context, todoIDs []uuid.UUID, perTodo int)) *MockCommentRepository_ListRecentComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_ListRecentComments_Call) Return(uUIDToComments map[uuid.UUID][]Comment, err error) *MockCommentRepository_ListRecentComments_Call {
+	_c.Call.Return(uUIDToComments, err)
+	return _c
+}
+
+func (_c *MockCommentRepository_ListRecentComments_Call) RunAndReturn(run func(ctx context.Context, todoIDs []uuid.UUID, perTodo int) (map[uuid.UUID][]Comment, error)) *MockCommentRepository_ListRecentComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function for the type MockCommentRepository
+func (_mock *MockCommentRepository) UpdateComment(ctx context.Context, comment Comment) error {
+	ret := _mock.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Comment) error); ok {
+		r0 = returnFunc(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentRepository_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockCommentRepository_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment Comment
+func (_e *MockCommentRepository_Expecter) UpdateComment(ctx interface{}, comment interface{}) *MockCommentRepository_UpdateComment_Call {
+	return &MockCommentRepository_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, comment)}
+}
+
+func (_c *MockCommentRepository_UpdateComment_Call) Run(run func(ctx context.Context, comment Comment)) *MockCommentRepository_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Comment
+		if args[1] != nil {
+			arg1 = args[1].(Comment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_UpdateComment_Call) Return(err error) *MockCommentRepository_UpdateComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentRepository_UpdateComment_Call) RunAndReturn(run func(ctx context.Context, comment Comment) error) *MockCommentRepository_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCustomFieldRepository creates a new instance of MockCustomFieldRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCustomFieldRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCustomFieldRepository {
+	mock := &MockCustomFieldRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCustomFieldRepository is an autogenerated mock type for the CustomFieldRepository type
+type MockCustomFieldRepository struct {
+	mock.Mock
+}
+
+type MockCustomFieldRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCustomFieldRepository) EXPECT() *MockCustomFieldRepository_Expecter {
+	return &MockCustomFieldRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteCustomField provides a mock function for the type MockCustomFieldRepository
+func (_mock *MockCustomFieldRepository) DeleteCustomField(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomField")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCustomFieldRepository_DeleteCustomField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCustomField'
+type MockCustomFieldRepository_DeleteCustomField_Call struct {
+	*mock.Call
+}
+
+// DeleteCustomField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockCustomFieldRepository_Expecter) DeleteCustomField(ctx interface{}, name interface{}) *MockCustomFieldRepository_DeleteCustomField_Call {
+	return &MockCustomFieldRepository_DeleteCustomField_Call{Call: _e.mock.On("DeleteCustomField", ctx, name)}
+}
+
+func (_c *MockCustomFieldRepository_DeleteCustomField_Call) Run(run func(ctx context.Context, name string)) *MockCustomFieldRepository_DeleteCustomField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomFieldRepository_DeleteCustomField_Call) Return(err error) *MockCustomFieldRepository_DeleteCustomField_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCustomFieldRepository_DeleteCustomField_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockCustomFieldRepository_DeleteCustomField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCustomFields provides a mock function for the type MockCustomFieldRepository
+func (_mock *MockCustomFieldRepository) ListCustomFields(ctx context.Context) ([]CustomField, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomFields")
+	}
+
+	var r0 []CustomField
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]CustomField, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []CustomField); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]CustomField)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCustomFieldRepository_ListCustomFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCustomFields'
+type MockCustomFieldRepository_ListCustomFields_Call struct {
+	*mock.Call
+}
+
+// ListCustomFields is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCustomFieldRepository_Expecter) ListCustomFields(ctx interface{}) *MockCustomFieldRepository_ListCustomFields_Call {
+	return &MockCustomFieldRepository_ListCustomFields_Call{Call: _e.mock.On("ListCustomFields", ctx)}
+}
+
+func (_c *MockCustomFieldRepository_ListCustomFields_Call) Run(run func(ctx context.Context)) *MockCustomFieldRepository_ListCustomFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomFieldRepository_ListCustomFields_Call) Return(customFields []CustomField, err error) *MockCustomFieldRepository_ListCustomFields_Call {
+	_c.Call.Return(customFields, err)
+	return _c
+}
+
+func (_c *MockCustomFieldRepository_ListCustomFields_Call) RunAndReturn(run func(ctx context.Context) ([]CustomField, error)) *MockCustomFieldRepository_ListCustomFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertCustomField provides a mock function for the type MockCustomFieldRepository
+func (_mock *MockCustomFieldRepository) UpsertCustomField(ctx context.Context, field CustomField) error {
+	ret := _mock.Called(ctx, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCustomField")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CustomField) error); ok {
+		r0 = returnFunc(ctx, field)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCustomFieldRepository_UpsertCustomField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCustomField'
+type MockCustomFieldRepository_UpsertCustomField_Call struct {
+	*mock.Call
+}
+
+// UpsertCustomField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - field CustomField
+func (_e *MockCustomFieldRepository_Expecter) UpsertCustomField(ctx interface{}, field interface{}) *MockCustomFieldRepository_UpsertCustomField_Call {
+	return &MockCustomFieldRepository_UpsertCustomField_Call{Call: _e.mock.On("UpsertCustomField", ctx, field)}
+}
+
+func (_c *MockCustomFieldRepository_UpsertCustomField_Call) Run(run func(ctx context.Context, field CustomField)) *MockCustomFieldRepository_UpsertCustomField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CustomField
+		if args[1] != nil {
+			arg1 = args[1].(CustomField)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomFieldRepository_UpsertCustomField_Call) Return(err error) *MockCustomFieldRepository_UpsertCustomField_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCustomFieldRepository_UpsertCustomField_Call) RunAndReturn(run func(ctx context.Context, field CustomField) error) *MockCustomFieldRepository_UpsertCustomField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEmbeddingBackfillRepository creates a new instance of MockEmbeddingBackfillRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEmbeddingBackfillRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEmbeddingBackfillRepository {
+	mock := &MockEmbeddingBackfillRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEmbeddingBackfillRepository is an autogenerated mock type for the EmbeddingBackfillRepository type
+type MockEmbeddingBackfillRepository struct {
+	mock.Mock
+}
+
+type MockEmbeddingBackfillRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEmbeddingBackfillRepository) EXPECT() *MockEmbeddingBackfillRepository_Expecter {
+	return &MockEmbeddingBackfillRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetEmbeddingBackfill provides a mock function for the type MockEmbeddingBackfillRepository
+func (_mock *MockEmbeddingBackfillRepository) GetEmbeddingBackfill(ctx context.Context, model string) (EmbeddingBackfill, bool, error) {
+	ret := _mock.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmbeddingBackfill")
+	}
+
+	var r0 EmbeddingBackfill
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (EmbeddingBackfill, bool, error)); ok {
+		return returnFunc(ctx, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) EmbeddingBackfill); ok {
+		r0 = returnFunc(ctx, model)
+	} else {
+		r0 = ret.Get(0).(EmbeddingBackfill)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, model)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, model)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEmbeddingBackfill'
+type MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call struct {
+	*mock.Call
+}
+
+// GetEmbeddingBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model string
+func (_e *MockEmbeddingBackfillRepository_Expecter) GetEmbeddingBackfill(ctx interface{}, model interface{}) *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call {
+	return &MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call{Call: _e.mock.On("GetEmbeddingBackfill", ctx, model)}
+}
+
+func (_c *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call) Run(run func(ctx context.Context, model string)) *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call) Return(embeddingBackfill EmbeddingBackfill, b bool, err error) *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call {
+	_c.Call.Return(embeddingBackfill, b, err)
+	return _c
+}
+
+func (_c *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call) RunAndReturn(run func(ctx context.Context, model string) (EmbeddingBackfill, bool, error)) *MockEmbeddingBackfillRepository_GetEmbeddingBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEmbeddingBackfills provides a mock function for the type MockEmbeddingBackfillRepository
+func (_mock *MockEmbeddingBackfillRepository) ListEmbeddingBackfills(ctx context.Context) ([]EmbeddingBackfill, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmbeddingBackfills")
+	}
+
+	var r0 []EmbeddingBackfill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]EmbeddingBackfill, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []EmbeddingBackfill); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]EmbeddingBackfill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEmbeddingBackfills'
+type MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call struct {
+	*mock.Call
+}
+
+// ListEmbeddingBackfills is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEmbeddingBackfillRepository_Expecter) ListEmbeddingBackfills(ctx interface{}) *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call {
+	return &MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call{Call: _e.mock.On("ListEmbeddingBackfills", ctx)}
+}
+
+func (_c *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call) Run(run func(ctx context.Context)) *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call) Return(embeddingBackfills []EmbeddingBackfill, err error) *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call {
+	_c.Call.Return(embeddingBackfills, err)
+	return _c
+}
+
+func (_c *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call) RunAndReturn(run func(ctx context.Context) ([]EmbeddingBackfill, error)) *MockEmbeddingBackfillRepository_ListEmbeddingBackfills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveEmbeddingBackfill provides a mock function for the type MockEmbeddingBackfillRepository
+func (_mock *MockEmbeddingBackfillRepository) SaveEmbeddingBackfill(ctx context.Context, backfill EmbeddingBackfill) error {
+	ret := _mock.Called(ctx, backfill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEmbeddingBackfill")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EmbeddingBackfill) error); ok {
+		r0 = returnFunc(ctx, backfill)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEmbeddingBackfill'
+type MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call struct {
+	*mock.Call
+}
+
+// SaveEmbeddingBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backfill EmbeddingBackfill
+func (_e *MockEmbeddingBackfillRepository_Expecter) SaveEmbeddingBackfill(ctx interface{}, backfill interface{}) *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call {
+	return &MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call{Call: _e.mock.On("SaveEmbeddingBackfill", ctx, backfill)}
+}
+
+func (_c *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call) Run(run func(ctx context.Context, backfill EmbeddingBackfill)) *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 EmbeddingBackfill
+		if args[1] != nil {
+			arg1 = args[1].(EmbeddingBackfill)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call) Return(err error) *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call) RunAndReturn(run func(ctx context.Context, backfill EmbeddingBackfill) error) *MockEmbeddingBackfillRepository_SaveEmbeddingBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFocusBlockRepository creates a new instance of MockFocusBlockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFocusBlockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFocusBlockRepository {
+	mock := &MockFocusBlockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFocusBlockRepository is an autogenerated mock type for the FocusBlockRepository type
+type MockFocusBlockRepository struct {
+	mock.Mock
+}
+
+type MockFocusBlockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFocusBlockRepository) EXPECT() *MockFocusBlockRepository_Expecter {
+	return &MockFocusBlockRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateFocusBlocks provides a mock function for the type MockFocusBlockRepository
+func (_mock *MockFocusBlockRepository) CreateFocusBlocks(ctx context.Context, blocks []FocusBlock) error {
+	ret := _mock.Called(ctx, blocks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFocusBlocks")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []FocusBlock) error); ok {
+		r0 = returnFunc(ctx, blocks)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFocusBlockRepository_CreateFocusBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFocusBlocks'
+type MockFocusBlockRepository_CreateFocusBlocks_Call struct {
+	*mock.Call
+}
+
+// CreateFocusBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocks []FocusBlock
+func (_e *MockFocusBlockRepository_Expecter) CreateFocusBlocks(ctx interface{}, blocks interface{}) *MockFocusBlockRepository_CreateFocusBlocks_Call {
+	return &MockFocusBlockRepository_CreateFocusBlocks_Call{Call: _e.mock.On("CreateFocusBlocks", ctx, blocks)}
+}
+
+func (_c *MockFocusBlockRepository_CreateFocusBlocks_Call) Run(run func(ctx context.Context, blocks []FocusBlock)) *MockFocusBlockRepository_CreateFocusBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []FocusBlock
+		if args[1] != nil {
+			arg1 = args[1].([]FocusBlock)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusBlockRepository_CreateFocusBlocks_Call) Return(err error) *MockFocusBlockRepository_CreateFocusBlocks_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFocusBlockRepository_CreateFocusBlocks_Call) RunAndReturn(run func(ctx context.Context, blocks []FocusBlock) error) *MockFocusBlockRepository_CreateFocusBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFocusBlocks provides a mock function for the type MockFocusBlockRepository
+func (_mock *MockFocusBlockRepository) ListFocusBlocks(ctx context.Context, from time.Time, to time.Time) ([]FocusBlock, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFocusBlocks")
+	}
+
+	var r0 []FocusBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]FocusBlock, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []FocusBlock); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]FocusBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFocusBlockRepository_ListFocusBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFocusBlocks'
+type MockFocusBlockRepository_ListFocusBlocks_Call struct {
+	*mock.Call
+}
+
+// ListFocusBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockFocusBlockRepository_Expecter) ListFocusBlocks(ctx interface{}, from interface{}, to interface{}) *MockFocusBlockRepository_ListFocusBlocks_Call {
+	return &MockFocusBlockRepository_ListFocusBlocks_Call{Call: _e.mock.On("ListFocusBlocks", ctx, from, to)}
+}
+
+func (_c *MockFocusBlockRepository_ListFocusBlocks_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockFocusBlockRepository_ListFocusBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusBlockRepository_ListFocusBlocks_Call) Return(focusBlocks []FocusBlock, err error) *MockFocusBlockRepository_ListFocusBlocks_Call {
+	_c.Call.Return(focusBlocks, err)
+	return _c
+}
+
+func (_c *MockFocusBlockRepository_ListFocusBlocks_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) ([]FocusBlock, error)) *MockFocusBlockRepository_ListFocusBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFocusSessionNotifier creates a new instance of MockFocusSessionNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFocusSessionNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFocusSessionNotifier {
+	mock := &MockFocusSessionNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFocusSessionNotifier is an autogenerated mock type for the FocusSessionNotifier type
+type MockFocusSessionNotifier struct {
+	mock.Mock
+}
+
+type MockFocusSessionNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFocusSessionNotifier) EXPECT() *MockFocusSessionNotifier_Expecter {
+	return &MockFocusSessionNotifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyFocusSessionCompleted provides a mock function for the type MockFocusSessionNotifier
+func (_mock *MockFocusSessionNotifier) NotifyFocusSessionCompleted(ctx context.Context, session FocusSession) error {
+	ret := _mock.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyFocusSessionCompleted")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, FocusSession) error); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyFocusSessionCompleted'
+type MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call struct {
+	*mock.Call
+}
+
+// NotifyFocusSessionCompleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session FocusSession
+func (_e *MockFocusSessionNotifier_Expecter) NotifyFocusSessionCompleted(ctx interface{}, session interface{}) *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call {
+	return &MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call{Call: _e.mock.On("NotifyFocusSessionCompleted", ctx, session)}
+}
+
+func (_c *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call) Run(run func(ctx context.Context, session FocusSession)) *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 FocusSession
+		if args[1] != nil {
+			arg1 = args[1].(FocusSession)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call) Return(err error) *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call) RunAndReturn(run func(ctx context.Context, session FocusSession) error) *MockFocusSessionNotifier_NotifyFocusSessionCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectRepository creates a new instance of MockProjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectRepository {
+	mock := &MockProjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectRepository is an autogenerated mock type for the ProjectRepository type
+type MockProjectRepository struct {
+	mock.Mock
+}
+
+type MockProjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectRepository) EXPECT() *MockProjectRepository_Expecter {
+	return &MockProjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateProject provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) CreateProject(ctx context.Context, project Project) error {
+	ret := _mock.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Project) error); ok {
+		r0 = returnFunc(ctx, project)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_CreateProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProject'
+type MockProjectRepository_CreateProject_Call struct {
+	*mock.Call
+}
+
+// CreateProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project Project
+func (_e *MockProjectRepository_Expecter) CreateProject(ctx interface{}, project interface{}) *MockProjectRepository_CreateProject_Call {
+	return &MockProjectRepository_CreateProject_Call{Call: _e.mock.On("CreateProject", ctx, project)}
+}
+
+func (_c *MockProjectRepository_CreateProject_Call) Run(run func(ctx context.Context, project Project)) *MockProjectRepository_CreateProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Project
+		if args[1] != nil {
+			arg1 = args[1].(Project)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_CreateProject_Call) Return(err error) *MockProjectRepository_CreateProject_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_CreateProject_Call) RunAndReturn(run func(ctx context.Context, project Project) error) *MockProjectRepository_CreateProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProjectSuggestions provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) CreateProjectSuggestions(ctx context.Context, suggestions []ProjectSuggestion) error {
+	ret := _mock.Called(ctx, suggestions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProjectSuggestions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []ProjectSuggestion) error); ok {
+		r0 = returnFunc(ctx, suggestions)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_CreateProjectSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProjectSuggestions'
+type MockProjectRepository_CreateProjectSuggestions_Call struct {
+	*mock.Call
+}
+
+// CreateProjectSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestions []ProjectSuggestion
+func (_e *MockProjectRepository_Expecter) CreateProjectSuggestions(ctx interface{}, suggestions interface{}) *MockProjectRepository_CreateProjectSuggestions_Call {
+	return &MockProjectRepository_CreateProjectSuggestions_Call{Call: _e.mock.On("CreateProjectSuggestions", ctx, suggestions)}
+}
+
+func (_c *MockProjectRepository_CreateProjectSuggestions_Call) Run(run func(ctx context.Context, suggestions []ProjectSuggestion)) *MockProjectRepository_CreateProjectSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []ProjectSuggestion
+		if args[1] != nil {
+			arg1 = args[1].([]ProjectSuggestion)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_CreateProjectSuggestions_Call) Return(err error) *MockProjectRepository_CreateProjectSuggestions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_CreateProjectSuggestions_Call) RunAndReturn(run func(ctx context.Context, suggestions []ProjectSuggestion) error) *MockProjectRepository_CreateProjectSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProjectSuggestion provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) DeleteProjectSuggestion(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProjectSuggestion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_DeleteProjectSuggestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProjectSuggestion'
+type MockProjectRepository_DeleteProjectSuggestion_Call struct {
+	*mock.Call
+}
+
+// DeleteProjectSuggestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockProjectRepository_Expecter) DeleteProjectSuggestion(ctx interface{}, id interface{}) *MockProjectRepository_DeleteProjectSuggestion_Call {
+	return &MockProjectRepository_DeleteProjectSuggestion_Call{Call: _e.mock.On("DeleteProjectSuggestion", ctx, id)}
+}
+
+func (_c *MockProjectRepository_DeleteProjectSuggestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockProjectRepository_DeleteProjectSuggestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_DeleteProjectSuggestion_Call) Return(err error) *MockProjectRepository_DeleteProjectSuggestion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_DeleteProjectSuggestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockProjectRepository_DeleteProjectSuggestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProjectSuggestions provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) DeleteProjectSuggestions(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProjectSuggestions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_DeleteProjectSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProjectSuggestions'
+type MockProjectRepository_DeleteProjectSuggestions_Call struct {
+	*mock.Call
+}
+
+// DeleteProjectSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjectRepository_Expecter) DeleteProjectSuggestions(ctx interface{}) *MockProjectRepository_DeleteProjectSuggestions_Call {
+	return &MockProjectRepository_DeleteProjectSuggestions_Call{Call: _e.mock.On("DeleteProjectSuggestions", ctx)}
+}
+
+func (_c *MockProjectRepository_DeleteProjectSuggestions_Call) Run(run func(ctx context.Context)) *MockProjectRepository_DeleteProjectSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_DeleteProjectSuggestions_Call) Return(err error) *MockProjectRepository_DeleteProjectSuggestions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_DeleteProjectSuggestions_Call) RunAndReturn(run func(ctx context.Context) error) *MockProjectRepository_DeleteProjectSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectSuggestion provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) GetProjectSuggestion(ctx context.Context, id uuid.UUID) (ProjectSuggestion, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectSuggestion")
+	}
+
+	var r0 ProjectSuggestion
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (ProjectSuggestion, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ProjectSuggestion); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(ProjectSuggestion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockProjectRepository_GetProjectSuggestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectSuggestion'
+type MockProjectRepository_GetProjectSuggestion_Call struct {
+	*mock.Call
+}
+
+// GetProjectSuggestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockProjectRepository_Expecter) GetProjectSuggestion(ctx interface{}, id interface{}) *MockProjectRepository_GetProjectSuggestion_Call {
+	return &MockProjectRepository_GetProjectSuggestion_Call{Call: _e.mock.On("GetProjectSuggestion", ctx, id)}
+}
+
+func (_c *MockProjectRepository_GetProjectSuggestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockProjectRepository_GetProjectSuggestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_GetProjectSuggestion_Call) Return(projectSuggestion ProjectSuggestion, b bool, err error) *MockProjectRepository_GetProjectSuggestion_Call {
+	_c.Call.Return(projectSuggestion, b, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_GetProjectSuggestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (ProjectSuggestion, bool, error)) *MockProjectRepository_GetProjectSuggestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjectSuggestions provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) ListProjectSuggestions(ctx context.Context) ([]ProjectSuggestion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectSuggestions")
+	}
+
+	var r0 []ProjectSuggestion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]ProjectSuggestion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []ProjectSuggestion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ProjectSuggestion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_ListProjectSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectSuggestions'
+type MockProjectRepository_ListProjectSuggestions_Call struct {
+	*mock.Call
+}
+
+// ListProjectSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjectRepository_Expecter) ListProjectSuggestions(ctx interface{}) *MockProjectRepository_ListProjectSuggestions_Call {
+	return &MockProjectRepository_ListProjectSuggestions_Call{Call: _e.mock.On("ListProjectSuggestions", ctx)}
+}
+
+func (_c *MockProjectRepository_ListProjectSuggestions_Call) Run(run func(ctx context.Context)) *MockProjectRepository_ListProjectSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_ListProjectSuggestions_Call) Return(projectSuggestions []ProjectSuggestion, err error) *MockProjectRepository_ListProjectSuggestions_Call {
+	_c.Call.Return(projectSuggestions, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_ListProjectSuggestions_Call) RunAndReturn(run func(ctx context.Context) ([]ProjectSuggestion, error)) *MockProjectRepository_ListProjectSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProjects provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) ListProjects(ctx context.Context) ([]Project, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Project, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Project); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_ListProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjects'
+type MockProjectRepository_ListProjects_Call struct {
+	*mock.Call
+}
+
+// ListProjects is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjectRepository_Expecter) ListProjects(ctx interface{}) *MockProjectRepository_ListProjects_Call {
+	return &MockProjectRepository_ListProjects_Call{Call: _e.mock.On("ListProjects", ctx)}
+}
+
+func (_c *MockProjectRepository_ListProjects_Call) Run(run func(ctx context.Context)) *MockProjectRepository_ListProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_ListProjects_Call) Return(projects []Project, err error) *MockProjectRepository_ListProjects_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_ListProjects_Call) RunAndReturn(run func(ctx context.Context) ([]Project, error)) *MockProjectRepository_ListProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUngroupedTodos provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) ListUngroupedTodos(ctx context.Context, limit int) ([]Todo, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUngroupedTodos")
+	}
+
+	var r0 []Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]Todo, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []Todo); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_ListUngroupedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUngroupedTodos'
+type MockProjectRepository_ListUngroupedTodos_Call struct {
+	*mock.Call
+}
+
+// ListUngroupedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockProjectRepository_Expecter) ListUngroupedTodos(ctx interface{}, limit interface{}) *MockProjectRepository_ListUngroupedTodos_Call {
+	return &MockProjectRepository_ListUngroupedTodos_Call{Call: _e.mock.On("ListUngroupedTodos", ctx, limit)}
+}
+
+func (_c *MockProjectRepository_ListUngroupedTodos_Call) Run(run func(ctx context.Context, limit int)) *MockProjectRepository_ListUngroupedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_ListUngroupedTodos_Call) Return(todos []Todo, err error) *MockProjectRepository_ListUngroupedTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_ListUngroupedTodos_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]Todo, error)) *MockProjectRepository_ListUngroupedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockRepository(t interface {
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveCompletedTodos provides a mock function for the type MockRepository
+func (_mock *MockRepository) ArchiveCompletedTodos(ctx context.Context, doneBefore time.Time, archivedAt time.Time) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, doneBefore, archivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveCompletedTodos")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, doneBefore, archivedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, doneBefore, archivedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, doneBefore, archivedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ArchiveCompletedTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveCompletedTodos'
+type MockRepository_ArchiveCompletedTodos_Call struct {
+	*mock.Call
+}
+
+// ArchiveCompletedTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - doneBefore time.Time
+//   - archivedAt time.Time
+func (_e *MockRepository_Expecter) ArchiveCompletedTodos(ctx interface{}, doneBefore interface{}, archivedAt interface{}) *MockRepository_ArchiveCompletedTodos_Call {
+	return &MockRepository_ArchiveCompletedTodos_Call{Call: _e.mock.On("ArchiveCompletedTodos", ctx, doneBefore, archivedAt)}
+}
+
+func (_c *MockRepository_ArchiveCompletedTodos_Call) Run(run func(ctx context.Context, doneBefore time.Time, archivedAt time.Time)) *MockRepository_ArchiveCompletedTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ArchiveCompletedTodos_Call) Return(uUIDs []uuid.UUID, err error) *MockRepository_ArchiveCompletedTodos_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockRepository_ArchiveCompletedTodos_Call) RunAndReturn(run func(ctx context.Context, doneBefore time.Time, archivedAt time.Time) ([]uuid.UUID, error)) *MockRepository_ArchiveCompletedTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStaleEmbeddingTodos provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountStaleEmbeddingTodos(ctx context.Context, slot EmbeddingSlot, model string) (int, error) {
+	ret := _mock.Called(ctx, slot, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountStaleEmbeddingTodos")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EmbeddingSlot, string) (int, error)); ok {
+		return returnFunc(ctx, slot, model)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EmbeddingSlot, string) int); ok {
+		r0 = returnFunc(ctx, slot, model)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, EmbeddingSlot, string) error); ok {
+		r1 = returnFunc(ctx, slot, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountStaleEmbeddingTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStaleEmbeddingTodos'
+type MockRepository_CountStaleEmbeddingTodos_Call struct {
+	*mock.Call
+}
+
+// CountStaleEmbeddingTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slot EmbeddingSlot
+//   - model string
+func (_e *MockRepository_Expecter) CountStaleEmbeddingTodos(ctx interface{}, slot interface{}, model interface{}) *MockRepository_CountStaleEmbeddingTodos_Call {
+	return &MockRepository_CountStaleEmbeddingTodos_Call{Call: _e.mock.On("CountStaleEmbeddingTodos", ctx, slot, model)}
+}
+
+func (_c *MockRepository_CountStaleEmbeddingTodos_Call) Run(run func(ctx context.Context, slot EmbeddingSlot, model string)) *MockRepository_CountStaleEmbeddingTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 EmbeddingSlot
+		if args[1] != nil {
+			arg1 = args[1].(EmbeddingSlot)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountStaleEmbeddingTodos_Call) Return(n int, err error) *MockRepository_CountStaleEmbeddingTodos_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_CountStaleEmbeddingTodos_Call) RunAndReturn(run func(ctx context.Context, slot EmbeddingSlot, model string) (int, error)) *MockRepository_CountStaleEmbeddingTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTodos provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountTodos(ctx context.Context, limit int, opts ...ListOption) (int, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, limit, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, limit)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTodos")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, ...ListOption) (int, error)); ok {
+		return returnFunc(ctx, limit, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, ...ListOption) int); ok {
+		r0 = returnFunc(ctx, limit, opts...)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, ...ListOption) error); ok {
+		r1 = returnFunc(ctx, limit, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTodos'
+type MockRepository_CountTodos_Call struct {
+	*mock.Call
+}
+
+// CountTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - opts ...ListOption
+func (_e *MockRepository_Expecter) CountTodos(ctx interface{}, limit interface{}, opts ...interface{}) *MockRepository_CountTodos_Call {
+	return &MockRepository_CountTodos_Call{Call: _e.mock.On("CountTodos",
+		append([]interface{}{ctx, limit}, opts...)...)}
+}
+
+func (_c *MockRepository_CountTodos_Call) Run(run func(ctx context.Context, limit int, opts ...ListOption)) *MockRepository_CountTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 []ListOption
+		var variadicArgs []ListOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]ListOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountTodos_Call) Return(n int, err error) *MockRepository_CountTodos_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_CountTodos_Call) RunAndReturn(run func(ctx context.Context, limit int, opts ...ListOption) (int, error)) *MockRepository_CountTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTodo provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateTodo(ctx context.Context, todo Todo) error {
+	ret := _mock.Called(ctx, todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTodo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Todo) error); ok {
+		r0 = returnFunc(ctx, todo)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
+type MockRepository_CreateTodo_Call struct {
+	*mock.Call
+}
+
+// CreateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todo Todo
+func (_e *MockRepository_Expecter) CreateTodo(ctx interface{}, todo interface{}) *MockRepository_CreateTodo_Call {
+	return &MockRepository_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, todo)}
+}
+
+func (_c *MockRepository_CreateTodo_Call) Run(run func(ctx context.Context, todo Todo)) *MockRepository_CreateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Todo
+		if args[1] != nil {
+			arg1 = args[1].(Todo)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateTodo_Call) Return(err error) *MockRepository_CreateTodo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_CreateTodo_Call) RunAndReturn(run func(ctx context.Context, todo Todo) error) *MockRepository_CreateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTodo provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteTodo(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTodo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
+type MockRepository_DeleteTodo_Call struct {
+	*mock.Call
+}
+
+// DeleteTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) DeleteTodo(ctx interface{}, id interface{}) *MockRepository_DeleteTodo_Call {
+	return &MockRepository_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, id)}
+}
+
+func (_c *MockRepository_DeleteTodo_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_DeleteTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteTodo_Call) Return(err error) *MockRepository_DeleteTodo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteTodo_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockRepository_DeleteTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodo provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetTodo(ctx context.Context, id uuid.UUID) (Todo, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodo")
+	}
+
+	var r0 Todo
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Todo, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Todo); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Todo)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRepository_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
+type MockRepository_GetTodo_Call struct {
+	*mock.Call
+}
+
+// GetTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) GetTodo(ctx interface{}, id interface{}) *MockRepository_GetTodo_Call {
+	return &MockRepository_GetTodo_Call{Call: _e.mock.On("GetTodo", ctx, id)}
+}
+
+func (_c *MockRepository_GetTodo_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_GetTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetTodo_Call) Return(todo Todo, b bool, err error) *MockRepository_GetTodo_Call {
+	_c.Call.Return(todo, b, err)
+	return _c
+}
+
+func (_c *MockRepository_GetTodo_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Todo, bool, error)) *MockRepository_GetTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockTodo provides a mock function for the type MockRepository
+func (_mock *MockRepository) LockTodo(ctx context.Context, id uuid.UUID) (Todo, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockTodo")
+	}
+
+	var r0 Todo
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Todo, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Todo); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Todo)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRepository_LockTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockTodo'
+type MockRepository_LockTodo_Call struct {
+	*mock.Call
+}
+
+// LockTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRepository_Expecter) LockTodo(ctx interface{}, id interface{}) *MockRepository_LockTodo_Call {
+	return &MockRepository_LockTodo_Call{Call: _e.mock.On("LockTodo", ctx, id)}
+}
+
+func (_c *MockRepository_LockTodo_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_LockTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_LockTodo_Call) Return(todo Todo, b bool, err error) *MockRepository_LockTodo_Call {
+	_c.Call.Return(todo, b, err)
+	return _c
+}
+
+func (_c *MockRepository_LockTodo_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Todo, bool, error)) *MockRepository_LockTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStaleEmbeddingTodos provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListStaleEmbeddingTodos(ctx context.Context, slot EmbeddingSlot, model string, after uuid.UUID, limit int) ([]Todo, error) {
+	ret := _mock.Called(ctx, slot, model, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStaleEmbeddingTodos")
+	}
+
+	var r0 []Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EmbeddingSlot, string, uuid.UUID, int) ([]Todo, error)); ok {
+		return returnFunc(ctx, slot, model, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, EmbeddingSlot, string, uuid.UUID, int) []Todo); ok {
+		r0 = returnFunc(ctx, slot, model, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, EmbeddingSlot, string, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, slot, model, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ListStaleEmbeddingTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStaleEmbeddingTodos'
+type MockRepository_ListStaleEmbeddingTodos_Call struct {
+	*mock.Call
+}
+
+// ListStaleEmbeddingTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slot EmbeddingSlot
+//   - model string
+//   - after uuid.UUID
+//   - limit int
+func (_e *MockRepository_Expecter) ListStaleEmbeddingTodos(ctx interface{}, slot interface{}, model interface{}, after interface{}, limit interface{}) *MockRepository_ListStaleEmbeddingTodos_Call {
+	return &MockRepository_ListStaleEmbeddingTodos_Call{Call: _e.mock.On("ListStaleEmbeddingTodos", ctx, slot, model, after, limit)}
+}
+
+func (_c *MockRepository_ListStaleEmbeddingTodos_Call) Run(run func(ctx context.Context, slot EmbeddingSlot, model string, after uuid.UUID, limit int)) *MockRepository_ListStaleEmbeddingTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 EmbeddingSlot
+		if args[1] != nil {
+			arg1 = args[1].(EmbeddingSlot)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListStaleEmbeddingTodos_Call) Return(todos []Todo, err error) *MockRepository_ListStaleEmbeddingTodos_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockRepository_ListStaleEmbeddingTodos_Call) RunAndReturn(run func(ctx context.Context, slot EmbeddingSlot, model string, after uuid.UUID, limit int) ([]Todo, error)) *MockRepository_ListStaleEmbeddingTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodos provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListTodos(ctx context.Context, page int, pageSize int, opts ...ListOption) ([]Todo, bool, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, page, pageSize, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, page, pageSize)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodos")
+	}
+
+	var r0 []Todo
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOption) ([]Todo, bool, error)); ok {
+		return returnFunc(ctx, page, pageSize, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOption) []Todo); ok {
+		r0 = returnFunc(ctx, page, pageSize, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, ...ListOption) bool); ok {
+		r1 = returnFunc(ctx, page, pageSize, opts...)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int, ...ListOption) error); ok {
+		r2 = returnFunc(ctx, page, pageSize, opts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRepository_ListTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodos'
+type MockRepository_ListTodos_Call struct {
+	*mock.Call
+}
+
+// ListTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+//   - opts ...ListOption
+func (_e *MockRepository_Expecter) ListTodos(ctx interface{}, page interface{}, pageSize interface{}, opts ...interface{}) *MockRepository_ListTodos_Call {
+	return &MockRepository_ListTodos_Call{Call: _e.mock.On("ListTodos",
+		append([]interface{}{ctx, page, pageSize}, opts...)...)}
+}
+
+func (_c *MockRepository_ListTodos_Call) Run(run func(ctx context.Context, page int, pageSize int, opts ...ListOption)) *MockRepository_ListTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 []ListOption
+		var variadicArgs []ListOption
+		if len(args) > 3 {
+			variadicArgs = args[3].([]ListOption)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListTodos_Call) Return(todos []Todo, b bool, err error) *MockRepository_ListTodos_Call {
+	_c.Call.Return(todos, b, err)
+	return _c
+}
+
+func (_c *MockRepository_ListTodos_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int, opts ...ListOption) ([]Todo, bool, error)) *MockRepository_ListTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScoreTodos provides a mock function for the type MockRepository
+func (_mock *MockRepository) ScoreTodos(ctx context.Context, ids []uuid.UUID, opts ...ListOption) (map[uuid.UUID]float64, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, ids, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, ids)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScoreTodos")
+	}
+
+	var r0 map[uuid.UUID]float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, ...ListOption) (map[uuid.UUID]float64, error)); ok {
+		return returnFunc(ctx, ids, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID, ...ListOption) map[uuid.UUID]float64); ok {
+		r0 = returnFunc(ctx, ids, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]float64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID, ...ListOption) error); ok {
+		r1 = returnFunc(ctx, ids, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ScoreTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScoreTodos'
+type MockRepository_ScoreTodos_Call struct {
+	*mock.Call
+}
+
+// ScoreTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+//   - opts ...ListOption
+func (_e *MockRepository_Expecter) ScoreTodos(ctx interface{}, ids interface{}, opts ...interface{}) *MockRepository_ScoreTodos_Call {
+	return &MockRepository_ScoreTodos_Call{Call: _e.mock.On("ScoreTodos",
+		append([]interface{}{ctx, ids}, opts...)...)}
+}
+
+func (_c *MockRepository_ScoreTodos_Call) Run(run func(ctx context.Context, ids []uuid.UUID, opts ...ListOption)) *MockRepository_ScoreTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		var arg2 []ListOption
+		var variadicArgs []ListOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]ListOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ScoreTodos_Call) Return(uUIDToFloat64 map[uuid.UUID]float64, err error) *MockRepository_ScoreTodos_Call {
+	_c.Call.Return(uUIDToFloat64, err)
+	return _c
+}
+
+func (_c *MockRepository_ScoreTodos_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID, opts ...ListOption) (map[uuid.UUID]float64, error)) *MockRepository_ScoreTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodo provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateTodo(ctx context.Context, todo Todo) error {
+	ret := _mock.Called(ctx, todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Todo) error); ok {
+		r0 = returnFunc(ctx, todo)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
+type MockRepository_UpdateTodo_Call struct {
+	*mock.Call
+}
+
+// UpdateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todo Todo
+func (_e *MockRepository_Expecter) UpdateTodo(ctx interface{}, todo interface{}) *MockRepository_UpdateTodo_Call {
+	return &MockRepository_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, todo)}
+}
+
+func (_c *MockRepository_UpdateTodo_Call) Run(run func(ctx context.Context, todo Todo)) *MockRepository_UpdateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Todo
+		if args[1] != nil {
+			arg1 = args[1].(Todo)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateTodo_Call) Return(err error) *MockRepository_UpdateTodo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateTodo_Call) RunAndReturn(run func(ctx context.Context, todo Todo) error) *MockRepository_UpdateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodoEmbedding provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateTodoEmbedding(ctx context.Context, id uuid.UUID, slot EmbeddingSlot, embedding []float64, model string) error {
+	ret := _mock.Called(ctx, id, slot, embedding, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodoEmbedding")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, EmbeddingSlot, []float64, string) error); ok {
+		r0 = returnFunc(ctx, id, slot, embedding, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateTodoEmbedding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoEmbedding'
+type MockRepository_UpdateTodoEmbedding_Call struct {
+	*mock.Call
+}
+
+// UpdateTodoEmbedding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - slot EmbeddingSlot
+//   - embedding []float64
+//   - model string
+func (_e *MockRepository_Expecter) UpdateTodoEmbedding(ctx interface{}, id interface{}, slot interface{}, embedding interface{}, model interface{}) *MockRepository_UpdateTodoEmbedding_Call {
+	return &MockRepository_UpdateTodoEmbedding_Call{Call: _e.mock.On("UpdateTodoEmbedding", ctx, id, slot, embedding, model)}
+}
+
+func (_c *MockRepository_UpdateTodoEmbedding_Call) Run(run func(ctx context.Context, id uuid.UUID, slot EmbeddingSlot, embedding []float64, model string)) *MockRepository_UpdateTodoEmbedding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 EmbeddingSlot
+		if args[2] != nil {
+			arg2 = args[2].(EmbeddingSlot)
+		}
+		var arg3 []float64
+		if args[3] != nil {
+			arg3 = args[3].([]float64)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateTodoEmbedding_Call) Return(err error) *MockRepository_UpdateTodoEmbedding_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateTodoEmbedding_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, slot EmbeddingSlot, embedding []float64, model string) error) *MockRepository_UpdateTodoEmbedding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBoardSnapshotRepository creates a new instance of MockBoardSnapshotRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBoardSnapshotRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBoardSnapshotRepository {
+	mock := &MockBoardSnapshotRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBoardSnapshotRepository is an autogenerated mock type for the BoardSnapshotRepository type
+type MockBoardSnapshotRepository struct {
+	mock.Mock
+}
+
+type MockBoardSnapshotRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBoardSnapshotRepository) EXPECT() *MockBoardSnapshotRepository_Expecter {
+	return &MockBoardSnapshotRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateSnapshot provides a mock function for the type MockBoardSnapshotRepository
+func (_mock *MockBoardSnapshotRepository) CreateSnapshot(ctx context.Context, snapshot BoardSnapshot) error {
+	ret := _mock.Called(ctx, snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BoardSnapshot) error); ok {
+		r0 = returnFunc(ctx, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBoardSnapshotRepository_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockBoardSnapshotRepository_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshot BoardSnapshot
+func (_e *MockBoardSnapshotRepository_Expecter) CreateSnapshot(ctx interface{}, snapshot interface{}) *MockBoardSnapshotRepository_CreateSnapshot_Call {
+	return &MockBoardSnapshotRepository_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", ctx, snapshot)}
+}
+
+func (_c *MockBoardSnapshotRepository_CreateSnapshot_Call) Run(run func(ctx context.Context, snapshot BoardSnapshot)) *MockBoardSnapshotRepository_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 BoardSnapshot
+		if args[1] != nil {
+			arg1 = args[1].(BoardSnapshot)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardSnapshotRepository_CreateSnapshot_Call) Return(err error) *MockBoardSnapshotRepository_CreateSnapshot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBoardSnapshotRepository_CreateSnapshot_Call) RunAndReturn(run func(ctx context.Context, snapshot BoardSnapshot) error) *MockBoardSnapshotRepository_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSnapshotByToken provides a mock function for the type MockBoardSnapshotRepository
+func (_mock *MockBoardSnapshotRepository) GetSnapshotByToken(ctx context.Context, token string) (BoardSnapshot, bool, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSnapshotByToken")
+	}
+
+	var r0 BoardSnapshot
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (BoardSnapshot, bool, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) BoardSnapshot); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(BoardSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, token)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockBoardSnapshotRepository_GetSnapshotByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSnapshotByToken'
+type MockBoardSnapshotRepository_GetSnapshotByToken_Call struct {
+	*mock.Call
+}
+
+// GetSnapshotByToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockBoardSnapshotRepository_Expecter) GetSnapshotByToken(ctx interface{}, token interface{}) *MockBoardSnapshotRepository_GetSnapshotByToken_Call {
+	return &MockBoardSnapshotRepository_GetSnapshotByToken_Call{Call: _e.mock.On("GetSnapshotByToken", ctx, token)}
+}
+
+func (_c *MockBoardSnapshotRepository_GetSnapshotByToken_Call) Run(run func(ctx context.Context, token string)) *MockBoardSnapshotRepository_GetSnapshotByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardSnapshotRepository_GetSnapshotByToken_Call) Return(boardSnapshot BoardSnapshot, b bool, err error) *MockBoardSnapshotRepository_GetSnapshotByToken_Call {
+	_c.Call.Return(boardSnapshot, b, err)
+	return _c
+}
+
+func (_c *MockBoardSnapshotRepository_GetSnapshotByToken_Call) RunAndReturn(run func(ctx context.Context, token string) (BoardSnapshot, bool, error)) *MockBoardSnapshotRepository_GetSnapshotByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBoardSummaryRepository creates a new instance of MockBoardSummaryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBoardSummaryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBoardSummaryRepository {
+	mock := &MockBoardSummaryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBoardSummaryRepository is an autogenerated mock type for the BoardSummaryRepository type
+type MockBoardSummaryRepository struct {
+	mock.Mock
+}
+
+type MockBoardSummaryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBoardSummaryRepository) EXPECT() *MockBoardSummaryRepository_Expecter {
+	return &MockBoardSummaryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CalculateSummaryContent provides a mock function for the type MockBoardSummaryRepository
+func (_mock *MockBoardSummaryRepository) CalculateSummaryContent(ctx context.Context) (BoardSummaryContent, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CalculateSummaryContent")
+	}
+
+	var r0 BoardSummaryContent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (BoardSummaryContent, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) BoardSummaryContent); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(BoardSummaryContent)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBoardSummaryRepository_CalculateSummaryContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CalculateSummaryContent'
+type MockBoardSummaryRepository_CalculateSummaryContent_Call struct {
+	*mock.Call
+}
+
+// CalculateSummaryContent is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBoardSummaryRepository_Expecter) CalculateSummaryContent(ctx interface{}) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
+	return &MockBoardSummaryRepository_CalculateSummaryContent_Call{Call: _e.mock.On("CalculateSummaryContent", ctx)}
+}
+
+func (_c *MockBoardSummaryRepository_CalculateSummaryContent_Call) Run(run func(ctx context.Context)) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardSummaryRepository_CalculateSummaryContent_Call) Return(boardSummaryContent BoardSummaryContent, err error) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
+	_c.Call.Return(boardSummaryContent, err)
+	return _c
+}
+
+func (_c *MockBoardSummaryRepository_CalculateSummaryContent_Call) RunAndReturn(run func(ctx context.Context) (BoardSummaryContent, error)) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestSummary provides a mock function for the type MockBoardSummaryRepository
+func (_mock *MockBoardSummaryRepository) GetLatestSummary(ctx context.Context) (BoardSummary, bool, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestSummary")
+	}
+
+	var r0 BoardSummary
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (BoardSummary, bool, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) BoardSummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(BoardSummary)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = returnFunc(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockBoardSummaryRepository_GetLatestSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSummary'
+type MockBoardSummaryRepository_GetLatestSummary_Call struct {
+	*mock.Call
+}
+
+// GetLatestSummary is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBoardSummaryRepository_Expecter) GetLatestSummary(ctx interface{}) *MockBoardSummaryRepository_GetLatestSummary_Call {
+	return &MockBoardSummaryRepository_GetLatestSummary_Call{Call: _e.mock.On("GetLatestSummary", ctx)}
+}
+
+func (_c *MockBoardSummaryRepository_GetLatestSummary_Call) Run(run func(ctx context.Context)) *MockBoardSummaryRepository_GetLatestSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardSummaryRepository_GetLatestSummary_Call) Return(boardSummary BoardSummary, b bool, err error) *MockBoardSummaryRepository_GetLatestSummary_Call {
+	_c.Call.Return(boardSummary, b, err)
+	return _c
+}
+
+func (_c *MockBoardSummaryRepository_GetLatestSummary_Call) RunAndReturn(run func(ctx context.Context) (BoardSummary, bool, error)) *MockBoardSummaryRepository_GetLatestSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreSummary provides a mock function for the type MockBoardSummaryRepository
+func (_mock *MockBoardSummaryRepository) StoreSummary(ctx context.Context, summary BoardSummary) error {
+	ret := _mock.Called(ctx, summary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreSummary")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BoardSummary) error); ok {
+		r0 = returnFunc(ctx, summary)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBoardSummaryRepository_StoreSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreSummary'
+type MockBoardSummaryRepository_StoreSummary_Call struct {
+	*mock.Call
+}
+
+// StoreSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - summary BoardSummary
+func (_e *MockBoardSummaryRepository_Expecter) StoreSummary(ctx interface{}, summary interface{}) *MockBoardSummaryRepository_StoreSummary_Call {
+	return &MockBoardSummaryRepository_StoreSummary_Call{Call: _e.mock.On("StoreSummary", ctx, summary)}
+}
+
+func (_c *MockBoardSummaryRepository_StoreSummary_Call) Run(run func(ctx context.Context, summary BoardSummary)) *MockBoardSummaryRepository_StoreSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 BoardSummary
+		if args[1] != nil {
+			arg1 = args[1].(BoardSummary)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBoardSummaryRepository_StoreSummary_Call) Return(err error) *MockBoardSummaryRepository_StoreSummary_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBoardSummaryRepository_StoreSummary_Call) RunAndReturn(run func(ctx context.Context, summary BoardSummary) error) *MockBoardSummaryRepository_StoreSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTemplateRepository creates a new instance of MockTemplateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTemplateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTemplateRepository {
+	mock := &MockTemplateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTemplateRepository is an autogenerated mock type for the TemplateRepository type
+type MockTemplateRepository struct {
+	mock.Mock
+}
+
+type MockTemplateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTemplateRepository) EXPECT() *MockTemplateRepository_Expecter {
+	return &MockTemplateRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTemplate provides a mock function for the type MockTemplateRepository
+func (_mock *MockTemplateRepository) CreateTemplate(ctx context.Context, template Template) error {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Template) error); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTemplateRepository_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type MockTemplateRepository_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template Template
+func (_e *MockTemplateRepository_Expecter) CreateTemplate(ctx interface{}, template interface{}) *MockTemplateRepository_CreateTemplate_Call {
+	return &MockTemplateRepository_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, template)}
+}
+
+func (_c *MockTemplateRepository_CreateTemplate_Call) Run(run func(ctx context.Context, template Template)) *MockTemplateRepository_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Template
+		if args[1] != nil {
+			arg1 = args[1].(Template)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplateRepository_CreateTemplate_Call) Return(err error) *MockTemplateRepository_CreateTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTemplateRepository_CreateTemplate_Call) RunAndReturn(run func(ctx context.Context, template Template) error) *MockTemplateRepository_CreateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTemplate provides a mock function for the type MockTemplateRepository
+func (_mock *MockTemplateRepository) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTemplateRepository_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type MockTemplateRepository_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockTemplateRepository_Expecter) DeleteTemplate(ctx interface{}, id interface{}) *MockTemplateRepository_DeleteTemplate_Call {
+	return &MockTemplateRepository_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, id)}
+}
+
+func (_c *MockTemplateRepository_DeleteTemplate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockTemplateRepository_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplateRepository_DeleteTemplate_Call) Return(err error) *MockTemplateRepository_DeleteTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTemplateRepository_DeleteTemplate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockTemplateRepository_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function for the type MockTemplateRepository
+func (_mock *MockTemplateRepository) GetTemplate(ctx context.Context, id uuid.UUID) (Template, bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 Template
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Template, bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Template); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Template)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockTemplateRepository_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type MockTemplateRepository_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockTemplateRepository_Expecter) GetTemplate(ctx interface{}, id interface{}) *MockTemplateRepository_GetTemplate_Call {
+	return &MockTemplateRepository_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, id)}
+}
+
+func (_c *MockTemplateRepository_GetTemplate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockTemplateRepository_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplateRepository_GetTemplate_Call) Return(template Template, b bool, err error) *MockTemplateRepository_GetTemplate_Call {
+	_c.Call.Return(template, b, err)
+	return _c
+}
+
+func (_c *MockTemplateRepository_GetTemplate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Template, bool, error)) *MockTemplateRepository_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplateByName provides a mock function for the type MockTemplateRepository
+func (_mock *MockTemplateRepository) GetTemplateByName(ctx context.Context, name string) (Template, bool, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateByName")
+	}
+
+	var r0 Template
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (Template, bool, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) Template); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(Template)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, name)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockTemplateRepository_GetTemplateByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplateByName'
+type MockTemplateRepository_GetTemplateByName_Call struct {
+	*mock.Call
+}
+
+// GetTemplateByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockTemplateRepository_Expecter) GetTemplateByName(ctx interface{}, name interface{}) *MockTemplateRepository_GetTemplateByName_Call {
+	return &MockTemplateRepository_GetTemplateByName_Call{Call: _e.mock.On("GetTemplateByName", ctx, name)}
+}
+
+func (_c *MockTemplateRepository_GetTemplateByName_Call) Run(run func(ctx context.Context, name string)) *MockTemplateRepository_GetTemplateByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplateRepository_GetTemplateByName_Call) Return(template Template, b bool, err error) *MockTemplateRepository_GetTemplateByName_Call {
+	_c.Call.Return(template, b, err)
+	return _c
+}
+
+func (_c *MockTemplateRepository_GetTemplateByName_Call) RunAndReturn(run func(ctx context.Context, name string) (Template, bool, error)) *MockTemplateRepository_GetTemplateByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTemplates provides a mock function for the type MockTemplateRepository
+func (_mock *MockTemplateRepository) ListTemplates(ctx context.Context) ([]Template, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTemplates")
+	}
+
+	var r0 []Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]Template, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []Template); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Template)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplateRepository_ListTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTemplates'
+type MockTemplateRepository_ListTemplates_Call struct {
+	*mock.Call
+}
+
+// ListTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTemplateRepository_Expecter) ListTemplates(ctx interface{}) *MockTemplateRepository_ListTemplates_Call {
+	return &MockTemplateRepository_ListTemplates_Call{Call: _e.mock.On("ListTemplates", ctx)}
+}
+
+func (_c *MockTemplateRepository_ListTemplates_Call) Run(run func(ctx context.Context)) *MockTemplateRepository_ListTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplateRepository_ListTemplates_Call) Return(templates []Template, err error) *MockTemplateRepository_ListTemplates_Call {
+	_c.Call.Return(templates, err)
+	return _c
+}
+
+func (_c *MockTemplateRepository_ListTemplates_Call) RunAndReturn(run func(ctx context.Context) ([]Template, error)) *MockTemplateRepository_ListTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTemplate provides a mock function for the type MockTemplateRepository
+func (_mock *MockTemplateRepository) UpdateTemplate(ctx context.Context, template Template) error {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, Template) error); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTemplateRepository_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type MockTemplateRepository_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template Template
+func (_e *MockTemplateRepository_Expecter) UpdateTemplate(ctx interface{}, template interface{}) *MockTemplateRepository_UpdateTemplate_Call {
+	return &MockTemplateRepository_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, template)}
+}
+
+func (_c *MockTemplateRepository_UpdateTemplate_Call) Run(run func(ctx context.Context, template Template)) *MockTemplateRepository_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 Template
+		if args[1] != nil {
+			arg1 = args[1].(Template)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplateRepository_UpdateTemplate_Call) Return(err error) *MockTemplateRepository_UpdateTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTemplateRepository_UpdateTemplate_Call) RunAndReturn(run func(ctx context.Context, template Template) error) *MockTemplateRepository_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeEntryRepository creates a new instance of MockTimeEntryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeEntryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeEntryRepository {
+	mock := &MockTimeEntryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTimeEntryRepository is an autogenerated mock type for the TimeEntryRepository type
+type MockTimeEntryRepository struct {
+	mock.Mock
+}
+
+type MockTimeEntryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeEntryRepository) EXPECT() *MockTimeEntryRepository_Expecter {
+	return &MockTimeEntryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTimeEntry provides a mock function for the type MockTimeEntryRepository
+func (_mock *MockTimeEntryRepository) CreateTimeEntry(ctx context.Context, entry TimeEntry) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTimeEntry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TimeEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTimeEntryRepository_CreateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeEntry'
+type MockTimeEntryRepository_CreateTimeEntry_Call struct {
+	*mock.Call
+}
+
+// CreateTimeEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry TimeEntry
+func (_e *MockTimeEntryRepository_Expecter) CreateTimeEntry(ctx interface{}, entry interface{}) *MockTimeEntryRepository_CreateTimeEntry_Call {
+	return &MockTimeEntryRepository_CreateTimeEntry_Call{Call: _e.mock.On("CreateTimeEntry", ctx, entry)}
+}
+
+func (_c *MockTimeEntryRepository_CreateTimeEntry_Call) Run(run func(ctx context.Context, entry TimeEntry)) *MockTimeEntryRepository_CreateTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TimeEntry
+		if args[1] != nil {
+			arg1 = args[1].(TimeEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_CreateTimeEntry_Call) Return(err error) *MockTimeEntryRepository_CreateTimeEntry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_CreateTimeEntry_Call) RunAndReturn(run func(ctx context.Context, entry TimeEntry) error) *MockTimeEntryRepository_CreateTimeEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunningTimeEntry provides a mock function for the type MockTimeEntryRepository
+func (_mock *MockTimeEntryRepository) GetRunningTimeEntry(ctx context.Context, todoID uuid.UUID) (TimeEntry, bool, error) {
+	ret := _mock.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunningTimeEntry")
+	}
+
+	var r0 TimeEntry
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (TimeEntry, bool, error)); ok {
+		return returnFunc(ctx, todoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) TimeEntry); ok {
+		r0 = returnFunc(ctx, todoID)
+	} else {
+		r0 = ret.Get(0).(TimeEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, todoID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, todoID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockTimeEntryRepository_GetRunningTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunningTimeEntry'
+type MockTimeEntryRepository_GetRunningTimeEntry_Call struct {
+	*mock.Call
+}
+
+// GetRunningTimeEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+func (_e *MockTimeEntryRepository_Expecter) GetRunningTimeEntry(ctx interface{}, todoID interface{}) *MockTimeEntryRepository_GetRunningTimeEntry_Call {
+	return &MockTimeEntryRepository_GetRunningTimeEntry_Call{Call: _e.mock.On("GetRunningTimeEntry", ctx, todoID)}
+}
+
+func (_c *MockTimeEntryRepository_GetRunningTimeEntry_Call) Run(run func(ctx context.Context, todoID uuid.UUID)) *MockTimeEntryRepository_GetRunningTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_GetRunningTimeEntry_Call) Return(timeEntry TimeEntry, b bool, err error) *MockTimeEntryRepository_GetRunningTimeEntry_Call {
+	_c.Call.Return(timeEntry, b, err)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_GetRunningTimeEntry_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID) (TimeEntry, bool, error)) *MockTimeEntryRepository_GetRunningTimeEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumLoggedTime provides a mock function for the type MockTimeEntryRepository
+func (_mock *MockTimeEntryRepository) SumLoggedTime(ctx context.Context, todoIDs []uuid.UUID) (map[uuid.UUID]time.Duration, error) {
+	ret := _mock.Called(ctx, todoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumLoggedTime")
+	}
+
+	var r0 map[uuid.UUID]time.Duration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]time.Duration, error)); ok {
+		return returnFunc(ctx, todoIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]time.Duration); ok {
+		r0 = returnFunc(ctx, todoIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]time.Duration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, todoIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTimeEntryRepository_SumLoggedTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumLoggedTime'
+type MockTimeEntryRepository_SumLoggedTime_Call struct {
+	*mock.Call
+}
+
+// SumLoggedTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoIDs []uuid.UUID
+func (_e *MockTimeEntryRepository_Expecter) SumLoggedTime(ctx interface{}, todoIDs interface{}) *MockTimeEntryRepository_SumLoggedTime_Call {
+	return &MockTimeEntryRepository_SumLoggedTime_Call{Call: _e.mock.On("SumLoggedTime", ctx, todoIDs)}
+}
+
+func (_c *MockTimeEntryRepository_SumLoggedTime_Call) Run(run func(ctx context.Context, todoIDs []uuid.UUID)) *MockTimeEntryRepository_SumLoggedTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_SumLoggedTime_Call) Return(uUIDToDuration map[uuid.UUID]time.Duration, err error) *MockTimeEntryRepository_SumLoggedTime_Call {
+	_c.Call.Return(uUIDToDuration, err)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_SumLoggedTime_Call) RunAndReturn(run func(ctx context.Context, todoIDs []uuid.UUID) (map[uuid.UUID]time.Duration, error)) *MockTimeEntryRepository_SumLoggedTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TimeReport provides a mock function for the type MockTimeEntryRepository
+func (_mock *MockTimeEntryRepository) TimeReport(ctx context.Context, from time.Time, to time.Time) ([]TimeReportItem, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TimeReport")
+	}
+
+	var r0 []TimeReportItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]TimeReportItem, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []TimeReportItem); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TimeReportItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTimeEntryRepository_TimeReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TimeReport'
+type MockTimeEntryRepository_TimeReport_Call struct {
+	*mock.Call
+}
+
+// TimeReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockTimeEntryRepository_Expecter) TimeReport(ctx interface{}, from interface{}, to interface{}) *MockTimeEntryRepository_TimeReport_Call {
+	return &MockTimeEntryRepository_TimeReport_Call{Call: _e.mock.On("TimeReport", ctx, from, to)}
+}
+
+func (_c *MockTimeEntryRepository_TimeReport_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockTimeEntryRepository_TimeReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_TimeReport_Call) Return(timeReportItems []TimeReportItem, err error) *MockTimeEntryRepository_TimeReport_Call {
+	_c.Call.Return(timeReportItems, err)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_TimeReport_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) ([]TimeReportItem, error)) *MockTimeEntryRepository_TimeReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTimeEntry provides a mock function for the type MockTimeEntryRepository
+func (_mock *MockTimeEntryRepository) UpdateTimeEntry(ctx context.Context, entry TimeEntry) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTimeEntry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TimeEntry) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTimeEntryRepository_UpdateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTimeEntry'
+type MockTimeEntryRepository_UpdateTimeEntry_Call struct {
+	*mock.Call
+}
+
+// UpdateTimeEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry TimeEntry
+func (_e *MockTimeEntryRepository_Expecter) UpdateTimeEntry(ctx interface{}, entry interface{}) *MockTimeEntryRepository_UpdateTimeEntry_Call {
+	return &MockTimeEntryRepository_UpdateTimeEntry_Call{Call: _e.mock.On("UpdateTimeEntry", ctx, entry)}
+}
+
+func (_c *MockTimeEntryRepository_UpdateTimeEntry_Call) Run(run func(ctx context.Context, entry TimeEntry)) *MockTimeEntryRepository_UpdateTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TimeEntry
+		if args[1] != nil {
+			arg1 = args[1].(TimeEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_UpdateTimeEntry_Call) Return(err error) *MockTimeEntryRepository_UpdateTimeEntry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_UpdateTimeEntry_Call) RunAndReturn(run func(ctx context.Context, entry TimeEntry) error) *MockTimeEntryRepository_UpdateTimeEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockViewRepository creates a new instance of MockViewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockViewRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockRepository {
-	mock := &MockRepository{}
+}) *MockViewRepository {
+	mock := &MockViewRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,57 +3910,57 @@ func NewMockRepository(t interface {
 	return mock
 }
 
-// MockRepository is an autogenerated mock type for the Repository type
-type MockRepository struct {
+// MockViewRepository is an autogenerated mock type for the ViewRepository type
+type MockViewRepository struct {
 	mock.Mock
 }
 
-type MockRepository_Expecter struct {
+type MockViewRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
-	return &MockRepository_Expecter{mock: &_m.Mock}
+func (_m *MockViewRepository) EXPECT() *MockViewRepository_Expecter {
+	return &MockViewRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateTodo provides a mock function for the type MockRepository
-func (_mock *MockRepository) CreateTodo(ctx context.Context, todo Todo) error {
-	ret := _mock.Called(ctx, todo)
+// CreateView provides a mock function for the type MockViewRepository
+func (_mock *MockViewRepository) CreateView(ctx context.Context, view View) error {
+	ret := _mock.Called(ctx, view)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTodo")
+		panic("no return value specified for CreateView")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, Todo) error); ok {
-		r0 = returnFunc(ctx, todo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, View) error); ok {
+		r0 = returnFunc(ctx, view)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRepository_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
-type MockRepository_CreateTodo_Call struct {
+// MockViewRepository_CreateView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateView'
+type MockViewRepository_CreateView_Call struct {
 	*mock.Call
 }
 
-// CreateTodo is a helper method to define mock.On call
+// CreateView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - todo Todo
-func (_e *MockRepository_Expecter) CreateTodo(ctx interface{}, todo interface{}) *MockRepository_CreateTodo_Call {
-	return &MockRepository_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, todo)}
+//   - view View
+func (_e *MockViewRepository_Expecter) CreateView(ctx interface{}, view interface{}) *MockViewRepository_CreateView_Call {
+	return &MockViewRepository_CreateView_Call{Call: _e.mock.On("CreateView", ctx, view)}
 }
 
-func (_c *MockRepository_CreateTodo_Call) Run(run func(ctx context.Context, todo Todo)) *MockRepository_CreateTodo_Call {
+func (_c *MockViewRepository_CreateView_Call) Run(run func(ctx context.Context, view View)) *MockViewRepository_CreateView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 Todo
+		var arg1 View
 		if args[1] != nil {
-			arg1 = args[1].(Todo)
+			arg1 = args[1].(View)
 		}
 		run(
 			arg0,
@@ -85,22 +3970,22 @@ func (_c *MockRepository_CreateTodo_Call) Run(run func(ctx context.Context, todo
 	return _c
 }
 
-func (_c *MockRepository_CreateTodo_Call) Return(err error) *MockRepository_CreateTodo_Call {
+func (_c *MockViewRepository_CreateView_Call) Return(err error) *MockViewRepository_CreateView_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_CreateTodo_Call) RunAndReturn(run func(ctx context.Context, todo Todo) error) *MockRepository_CreateTodo_Call {
+func (_c *MockViewRepository_CreateView_Call) RunAndReturn(run func(ctx context.Context, view View) error) *MockViewRepository_CreateView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTodo provides a mock function for the type MockRepository
-func (_mock *MockRepository) DeleteTodo(ctx context.Context, id uuid.UUID) error {
+// DeleteView provides a mock function for the type MockViewRepository
+func (_mock *MockViewRepository) DeleteView(ctx context.Context, id uuid.UUID) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTodo")
+		panic("no return value specified for DeleteView")
 	}
 
 	var r0 error
@@ -112,19 +3997,19 @@ func (_mock *MockRepository) DeleteTodo(ctx context.Context, id uuid.UUID) error
 	return r0
 }
 
-// MockRepository_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
-type MockRepository_DeleteTodo_Call struct {
+// MockViewRepository_DeleteView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteView'
+type MockViewRepository_DeleteView_Call struct {
 	*mock.Call
 }
 
-// DeleteTodo is a helper method to define mock.On call
+// DeleteView is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockRepository_Expecter) DeleteTodo(ctx interface{}, id interface{}) *MockRepository_DeleteTodo_Call {
-	return &MockRepository_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, id)}
+func (_e *MockViewRepository_Expecter) DeleteView(ctx interface{}, id interface{}) *MockViewRepository_DeleteView_Call {
+	return &MockViewRepository_DeleteView_Call{Call: _e.mock.On("DeleteView", ctx, id)}
 }
 
-func (_c *MockRepository_DeleteTodo_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_DeleteTodo_Call {
+func (_c *MockViewRepository_DeleteView_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockViewRepository_DeleteView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -142,69 +4027,141 @@ func (_c *MockRepository_DeleteTodo_Call) Run(run func(ctx context.Context, id u
 	return _c
 }
 
-func (_c *MockRepository_DeleteTodo_Call) Return(err error) *MockRepository_DeleteTodo_Call {
+func (_c *MockViewRepository_DeleteView_Call) Return(err error) *MockViewRepository_DeleteView_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_DeleteTodo_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockRepository_DeleteTodo_Call {
+func (_c *MockViewRepository_DeleteView_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockViewRepository_DeleteView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodo provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetTodo(ctx context.Context, id uuid.UUID) (Todo, bool, error) {
+// GetView provides a mock function for the type MockViewRepository
+func (_mock *MockViewRepository) GetView(ctx context.Context, id uuid.UUID) (View, bool, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodo")
+		panic("no return value specified for GetView")
 	}
 
-	var r0 Todo
+	var r0 View
 	var r1 bool
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (Todo, bool, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (View, bool, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) Todo); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) View); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(View)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockViewRepository_GetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetView'
+type MockViewRepository_GetView_Call struct {
+	*mock.Call
+}
+
+// GetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockViewRepository_Expecter) GetView(ctx interface{}, id interface{}) *MockViewRepository_GetView_Call {
+	return &MockViewRepository_GetView_Call{Call: _e.mock.On("GetView", ctx, id)}
+}
+
+func (_c *MockViewRepository_GetView_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockViewRepository_GetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViewRepository_GetView_Call) Return(view View, b bool, err error) *MockViewRepository_GetView_Call {
+	_c.Call.Return(view, b, err)
+	return _c
+}
+
+func (_c *MockViewRepository_GetView_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (View, bool, error)) *MockViewRepository_GetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetViewByName provides a mock function for the type MockViewRepository
+func (_mock *MockViewRepository) GetViewByName(ctx context.Context, name string) (View, bool, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetViewByName")
+	}
+
+	var r0 View
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (View, bool, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) View); ok {
+		r0 = returnFunc(ctx, name)
 	} else {
-		r0 = ret.Get(0).(Todo)
+		r0 = ret.Get(0).(View)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) bool); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, name)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
-		r2 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, name)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockRepository_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
-type MockRepository_GetTodo_Call struct {
+// MockViewRepository_GetViewByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetViewByName'
+type MockViewRepository_GetViewByName_Call struct {
 	*mock.Call
 }
 
-// GetTodo is a helper method to define mock.On call
+// GetViewByName is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockRepository_Expecter) GetTodo(ctx interface{}, id interface{}) *MockRepository_GetTodo_Call {
-	return &MockRepository_GetTodo_Call{Call: _e.mock.On("GetTodo", ctx, id)}
+//   - name string
+func (_e *MockViewRepository_Expecter) GetViewByName(ctx interface{}, name interface{}) *MockViewRepository_GetViewByName_Call {
+	return &MockViewRepository_GetViewByName_Call{Call: _e.mock.On("GetViewByName", ctx, name)}
 }
 
-func (_c *MockRepository_GetTodo_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRepository_GetTodo_Call {
+func (_c *MockViewRepository_GetViewByName_Call) Run(run func(ctx context.Context, name string)) *MockViewRepository_GetViewByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -214,149 +4171,116 @@ func (_c *MockRepository_GetTodo_Call) Run(run func(ctx context.Context, id uuid
 	return _c
 }
 
-func (_c *MockRepository_GetTodo_Call) Return(todo Todo, b bool, err error) *MockRepository_GetTodo_Call {
-	_c.Call.Return(todo, b, err)
+func (_c *MockViewRepository_GetViewByName_Call) Return(view View, b bool, err error) *MockViewRepository_GetViewByName_Call {
+	_c.Call.Return(view, b, err)
 	return _c
 }
 
-func (_c *MockRepository_GetTodo_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (Todo, bool, error)) *MockRepository_GetTodo_Call {
+func (_c *MockViewRepository_GetViewByName_Call) RunAndReturn(run func(ctx context.Context, name string) (View, bool, error)) *MockViewRepository_GetViewByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTodos provides a mock function for the type MockRepository
-func (_mock *MockRepository) ListTodos(ctx context.Context, page int, pageSize int, opts ...ListOption) ([]Todo, bool, error) {
-	var tmpRet mock.Arguments
-	if len(opts) > 0 {
-		tmpRet = _mock.Called(ctx, page, pageSize, opts)
-	} else {
-		tmpRet = _mock.Called(ctx, page, pageSize)
-	}
-	ret := tmpRet
+// ListViews provides a mock function for the type MockViewRepository
+func (_mock *MockViewRepository) ListViews(ctx context.Context) ([]View, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTodos")
+		panic("no return value specified for ListViews")
 	}
 
-	var r0 []Todo
-	var r1 bool
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOption) ([]Todo, bool, error)); ok {
-		return returnFunc(ctx, page, pageSize, opts...)
+	var r0 []View
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]View, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOption) []Todo); ok {
-		r0 = returnFunc(ctx, page, pageSize, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []View); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]Todo)
+			r0 = ret.Get(0).([]View)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, ...ListOption) bool); ok {
-		r1 = returnFunc(ctx, page, pageSize, opts...)
-	} else {
-		r1 = ret.Get(1).(bool)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int, ...ListOption) error); ok {
-		r2 = returnFunc(ctx, page, pageSize, opts...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockRepository_ListTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodos'
-type MockRepository_ListTodos_Call struct {
+// MockViewRepository_ListViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListViews'
+type MockViewRepository_ListViews_Call struct {
 	*mock.Call
 }
 
-// ListTodos is a helper method to define mock.On call
+// ListViews is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-//   - pageSize int
-//   - opts ...ListOption
-func (_e *MockRepository_Expecter) ListTodos(ctx interface{}, page interface{}, pageSize interface{}, opts ...interface{}) *MockRepository_ListTodos_Call {
-	return &MockRepository_ListTodos_Call{Call: _e.mock.On("ListTodos",
-		append([]interface{}{ctx, page, pageSize}, opts...)...)}
+func (_e *MockViewRepository_Expecter) ListViews(ctx interface{}) *MockViewRepository_ListViews_Call {
+	return &MockViewRepository_ListViews_Call{Call: _e.mock.On("ListViews", ctx)}
 }
 
-func (_c *MockRepository_ListTodos_Call) Run(run func(ctx context.Context, page int, pageSize int, opts ...ListOption)) *MockRepository_ListTodos_Call {
+func (_c *MockViewRepository_ListViews_Call) Run(run func(ctx context.Context)) *MockViewRepository_ListViews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
-		if args[1] != nil {
-			arg1 = args[1].(int)
-		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 []ListOption
-		var variadicArgs []ListOption
-		if len(args) > 3 {
-			variadicArgs = args[3].([]ListOption)
-		}
-		arg3 = variadicArgs
 		run(
 			arg0,
-			arg1,
-			arg2,
-			arg3...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_ListTodos_Call) Return(todos []Todo, b bool, err error) *MockRepository_ListTodos_Call {
-	_c.Call.Return(todos, b, err)
+func (_c *MockViewRepository_ListViews_Call) Return(views []View, err error) *MockViewRepository_ListViews_Call {
+	_c.Call.Return(views, err)
 	return _c
 }
 
-func (_c *MockRepository_ListTodos_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int, opts ...ListOption) ([]Todo, bool, error)) *MockRepository_ListTodos_Call {
+func (_c *MockViewRepository_ListViews_Call) RunAndReturn(run func(ctx context.Context) ([]View, error)) *MockViewRepository_ListViews_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTodo provides a mock function for the type MockRepository
-func (_mock *MockRepository) UpdateTodo(ctx context.Context, todo Todo) error {
-	ret := _mock.Called(ctx, todo)
+// UpdateView provides a mock function for the type MockViewRepository
+func (_mock *MockViewRepository) UpdateView(ctx context.Context, view View) error {
+	ret := _mock.Called(ctx, view)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTodo")
+		panic("no return value specified for UpdateView")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, Todo) error); ok {
-		r0 = returnFunc(ctx, todo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, View) error); ok {
+		r0 = returnFunc(ctx, view)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRepository_UpdateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodo'
-type MockRepository_UpdateTodo_Call struct {
+// MockViewRepository_UpdateView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateView'
+type MockViewRepository_UpdateView_Call struct {
 	*mock.Call
 }
 
-// UpdateTodo is a helper method to define mock.On call
+// UpdateView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - todo Todo
-func (_e *MockRepository_Expecter) UpdateTodo(ctx interface{}, todo interface{}) *MockRepository_UpdateTodo_Call {
-	return &MockRepository_UpdateTodo_Call{Call: _e.mock.On("UpdateTodo", ctx, todo)}
+//   - view View
+func (_e *MockViewRepository_Expecter) UpdateView(ctx interface{}, view interface{}) *MockViewRepository_UpdateView_Call {
+	return &MockViewRepository_UpdateView_Call{Call: _e.mock.On("UpdateView", ctx, view)}
 }
 
-func (_c *MockRepository_UpdateTodo_Call) Run(run func(ctx context.Context, todo Todo)) *MockRepository_UpdateTodo_Call {
+func (_c *MockViewRepository_UpdateView_Call) Run(run func(ctx context.Context, view View)) *MockViewRepository_UpdateView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 Todo
+		var arg1 View
 		if args[1] != nil {
-			arg1 = args[1].(Todo)
+			arg1 = args[1].(View)
 		}
 		run(
 			arg0,
@@ -366,23 +4290,23 @@ func (_c *MockRepository_UpdateTodo_Call) Run(run func(ctx context.Context, todo
 	return _c
 }
 
-func (_c *MockRepository_UpdateTodo_Call) Return(err error) *MockRepository_UpdateTodo_Call {
+func (_c *MockViewRepository_UpdateView_Call) Return(err error) *MockViewRepository_UpdateView_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRepository_UpdateTodo_Call) RunAndReturn(run func(ctx context.Context, todo Todo) error) *MockRepository_UpdateTodo_Call {
+func (_c *MockViewRepository_UpdateView_Call) RunAndReturn(run func(ctx context.Context, view View) error) *MockViewRepository_UpdateView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockBoardSummaryRepository creates a new instance of MockBoardSummaryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockWeeklyReviewRepository creates a new instance of MockWeeklyReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockBoardSummaryRepository(t interface {
+func NewMockWeeklyReviewRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockBoardSummaryRepository {
-	mock := &MockBoardSummaryRepository{}
+}) *MockWeeklyReviewRepository {
+	mock := &MockWeeklyReviewRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -390,183 +4314,293 @@ func NewMockBoardSummaryRepository(t interface {
 	return mock
 }
 
-// MockBoardSummaryRepository is an autogenerated mock type for the BoardSummaryRepository type
-type MockBoardSummaryRepository struct {
+// MockWeeklyReviewRepository is an autogenerated mock type for the WeeklyReviewRepository type
+type MockWeeklyReviewRepository struct {
 	mock.Mock
 }
 
-type MockBoardSummaryRepository_Expecter struct {
+type MockWeeklyReviewRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockBoardSummaryRepository) EXPECT() *MockBoardSummaryRepository_Expecter {
-	return &MockBoardSummaryRepository_Expecter{mock: &_m.Mock}
+func (_m *MockWeeklyReviewRepository) EXPECT() *MockWeeklyReviewRepository_Expecter {
+	return &MockWeeklyReviewRepository_Expecter{mock: &_m.Mock}
 }
 
-// CalculateSummaryContent provides a mock function for the type MockBoardSummaryRepository
-func (_mock *MockBoardSummaryRepository) CalculateSummaryContent(ctx context.Context) (BoardSummaryContent, error) {
-	ret := _mock.Called(ctx)
+// CreateWeeklyReview provides a mock function for the type MockWeeklyReviewRepository
+func (_mock *MockWeeklyReviewRepository) CreateWeeklyReview(ctx context.Context, review WeeklyReview) error {
+	ret := _mock.Called(ctx, review)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CalculateSummaryContent")
+		panic("no return value specified for CreateWeeklyReview")
 	}
 
-	var r0 BoardSummaryContent
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (BoardSummaryContent, error)); ok {
-		return returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, WeeklyReview) error); ok {
+		r0 = returnFunc(ctx, review)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) BoardSummaryContent); ok {
-		r0 = returnFunc(ctx)
+	return r0
+}
+
+// MockWeeklyReviewRepository_CreateWeeklyReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWeeklyReview'
+type MockWeeklyReviewRepository_CreateWeeklyReview_Call struct {
+	*mock.Call
+}
+
+// CreateWeeklyReview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - review WeeklyReview
+func (_e *MockWeeklyReviewRepository_Expecter) CreateWeeklyReview(ctx interface{}, review interface{}) *MockWeeklyReviewRepository_CreateWeeklyReview_Call {
+	return &MockWeeklyReviewRepository_CreateWeeklyReview_Call{Call: _e.mock.On("CreateWeeklyReview", ctx, review)}
+}
+
+func (_c *MockWeeklyReviewRepository_CreateWeeklyReview_Call) Run(run func(ctx context.Context, review WeeklyReview)) *MockWeeklyReviewRepository_CreateWeeklyReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 WeeklyReview
+		if args[1] != nil {
+			arg1 = args[1].(WeeklyReview)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWeeklyReviewRepository_CreateWeeklyReview_Call) Return(err error) *MockWeeklyReviewRepository_CreateWeeklyReview_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWeeklyReviewRepository_CreateWeeklyReview_Call) RunAndReturn(run func(ctx context.Context, review WeeklyReview) error) *MockWeeklyReviewRepository_CreateWeeklyReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWeeklyReviewByPeriod provides a mock function for the type MockWeeklyReviewRepository
+func (_mock *MockWeeklyReviewRepository) GetWeeklyReviewByPeriod(ctx context.Context, periodStart time.Time) (WeeklyReview, bool, error) {
+	ret := _mock.Called(ctx, periodStart)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWeeklyReviewByPeriod")
+	}
+
+	var r0 WeeklyReview
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) (WeeklyReview, bool, error)); ok {
+		return returnFunc(ctx, periodStart)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) WeeklyReview); ok {
+		r0 = returnFunc(ctx, periodStart)
 	} else {
-		r0 = ret.Get(0).(BoardSummaryContent)
+		r0 = ret.Get(0).(WeeklyReview)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) bool); ok {
+		r1 = returnFunc(ctx, periodStart)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, time.Time) error); ok {
+		r2 = returnFunc(ctx, periodStart)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockBoardSummaryRepository_CalculateSummaryContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CalculateSummaryContent'
-type MockBoardSummaryRepository_CalculateSummaryContent_Call struct {
+// MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWeeklyReviewByPeriod'
+type MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call struct {
 	*mock.Call
 }
 
-// CalculateSummaryContent is a helper method to define mock.On call
+// GetWeeklyReviewByPeriod is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockBoardSummaryRepository_Expecter) CalculateSummaryContent(ctx interface{}) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
-	return &MockBoardSummaryRepository_CalculateSummaryContent_Call{Call: _e.mock.On("CalculateSummaryContent", ctx)}
+//   - periodStart time.Time
+func (_e *MockWeeklyReviewRepository_Expecter) GetWeeklyReviewByPeriod(ctx interface{}, periodStart interface{}) *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call {
+	return &MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call{Call: _e.mock.On("GetWeeklyReviewByPeriod", ctx, periodStart)}
 }
 
-func (_c *MockBoardSummaryRepository_CalculateSummaryContent_Call) Run(run func(ctx context.Context)) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
+func (_c *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call) Run(run func(ctx context.Context, periodStart time.Time)) *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBoardSummaryRepository_CalculateSummaryContent_Call) Return(boardSummaryContent BoardSummaryContent, err error) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
-	_c.Call.Return(boardSummaryContent, err)
+func (_c *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call) Return(weeklyReview WeeklyReview, b bool, err error) *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call {
+	_c.Call.Return(weeklyReview, b, err)
 	return _c
 }
 
-func (_c *MockBoardSummaryRepository_CalculateSummaryContent_Call) RunAndReturn(run func(ctx context.Context) (BoardSummaryContent, error)) *MockBoardSummaryRepository_CalculateSummaryContent_Call {
+func (_c *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call) RunAndReturn(run func(ctx context.Context, periodStart time.Time) (WeeklyReview, bool, error)) *MockWeeklyReviewRepository_GetWeeklyReviewByPeriod_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestSummary provides a mock function for the type MockBoardSummaryRepository
-func (_mock *MockBoardSummaryRepository) GetLatestSummary(ctx context.Context) (BoardSummary, bool, error) {
-	ret := _mock.Called(ctx)
+// ListWeeklyReviews provides a mock function for the type MockWeeklyReviewRepository
+func (_mock *MockWeeklyReviewRepository) ListWeeklyReviews(ctx context.Context, page int, pageSize int) ([]WeeklyReview, bool, error) {
+	ret := _mock.Called(ctx, page, pageSize)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestSummary")
+		panic("no return value specified for ListWeeklyReviews")
 	}
 
-	var r0 BoardSummary
+	var r0 []WeeklyReview
 	var r1 bool
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (BoardSummary, bool, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]WeeklyReview, bool, error)); ok {
+		return returnFunc(ctx, page, pageSize)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) BoardSummary); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []WeeklyReview); ok {
+		r0 = returnFunc(ctx, page, pageSize)
 	} else {
-		r0 = ret.Get(0).(BoardSummary)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WeeklyReview)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) bool); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) bool); ok {
+		r1 = returnFunc(ctx, page, pageSize)
 	} else {
 		r1 = ret.Get(1).(bool)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context) error); ok {
-		r2 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, page, pageSize)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// MockBoardSummaryRepository_GetLatestSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestSummary'
-type MockBoardSummaryRepository_GetLatestSummary_Call struct {
+// MockWeeklyReviewRepository_ListWeeklyReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWeeklyReviews'
+type MockWeeklyReviewRepository_ListWeeklyReviews_Call struct {
 	*mock.Call
 }
 
-// GetLatestSummary is a helper method to define mock.On call
+// ListWeeklyReviews is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockBoardSummaryRepository_Expecter) GetLatestSummary(ctx interface{}) *MockBoardSummaryRepository_GetLatestSummary_Call {
-	return &MockBoardSummaryRepository_GetLatestSummary_Call{Call: _e.mock.On("GetLatestSummary", ctx)}
+//   - page int
+//   - pageSize int
+func (_e *MockWeeklyReviewRepository_Expecter) ListWeeklyReviews(ctx interface{}, page interface{}, pageSize interface{}) *MockWeeklyReviewRepository_ListWeeklyReviews_Call {
+	return &MockWeeklyReviewRepository_ListWeeklyReviews_Call{Call: _e.mock.On("ListWeeklyReviews", ctx, page, pageSize)}
 }
 
-func (_c *MockBoardSummaryRepository_GetLatestSummary_Call) Run(run func(ctx context.Context)) *MockBoardSummaryRepository_GetLatestSummary_Call {
+func (_c *MockWeeklyReviewRepository_ListWeeklyReviews_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockWeeklyReviewRepository_ListWeeklyReviews_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockBoardSummaryRepository_GetLatestSummary_Call) Return(boardSummary BoardSummary, b bool, err error) *MockBoardSummaryRepository_GetLatestSummary_Call {
-	_c.Call.Return(boardSummary, b, err)
+func (_c *MockWeeklyReviewRepository_ListWeeklyReviews_Call) Return(weeklyReviews []WeeklyReview, b bool, err error) *MockWeeklyReviewRepository_ListWeeklyReviews_Call {
+	_c.Call.Return(weeklyReviews, b, err)
 	return _c
 }
 
-func (_c *MockBoardSummaryRepository_GetLatestSummary_Call) RunAndReturn(run func(ctx context.Context) (BoardSummary, bool, error)) *MockBoardSummaryRepository_GetLatestSummary_Call {
+func (_c *MockWeeklyReviewRepository_ListWeeklyReviews_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int) ([]WeeklyReview, bool, error)) *MockWeeklyReviewRepository_ListWeeklyReviews_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StoreSummary provides a mock function for the type MockBoardSummaryRepository
-func (_mock *MockBoardSummaryRepository) StoreSummary(ctx context.Context, summary BoardSummary) error {
-	ret := _mock.Called(ctx, summary)
+// NewMockWeeklyReviewNotifier creates a new instance of MockWeeklyReviewNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWeeklyReviewNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWeeklyReviewNotifier {
+	mock := &MockWeeklyReviewNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWeeklyReviewNotifier is an autogenerated mock type for the WeeklyReviewNotifier type
+type MockWeeklyReviewNotifier struct {
+	mock.Mock
+}
+
+type MockWeeklyReviewNotifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWeeklyReviewNotifier) EXPECT() *MockWeeklyReviewNotifier_Expecter {
+	return &MockWeeklyReviewNotifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyWeeklyReviewReady provides a mock function for the type MockWeeklyReviewNotifier
+func (_mock *MockWeeklyReviewNotifier) NotifyWeeklyReviewReady(ctx context.Context, review WeeklyReview) error {
+	ret := _mock.Called(ctx, review)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StoreSummary")
+		panic("no return value specified for NotifyWeeklyReviewReady")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, BoardSummary) error); ok {
-		r0 = returnFunc(ctx, summary)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, WeeklyReview) error); ok {
+		r0 = returnFunc(ctx, review)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockBoardSummaryRepository_StoreSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreSummary'
-type MockBoardSummaryRepository_StoreSummary_Call struct {
+// MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyWeeklyReviewReady'
+type MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call struct {
 	*mock.Call
 }
 
-// StoreSummary is a helper method to define mock.On call
+// NotifyWeeklyReviewReady is a helper method to define mock.On call
 //   - ctx context.Context
-//   - summary BoardSummary
-func (_e *MockBoardSummaryRepository_Expecter) StoreSummary(ctx interface{}, summary interface{}) *MockBoardSummaryRepository_StoreSummary_Call {
-	return &MockBoardSummaryRepository_StoreSummary_Call{Call: _e.mock.On("StoreSummary", ctx, summary)}
+//   - review WeeklyReview
+func (_e *MockWeeklyReviewNotifier_Expecter) NotifyWeeklyReviewReady(ctx interface{}, review interface{}) *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call {
+	return &MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call{Call: _e.mock.On("NotifyWeeklyReviewReady", ctx, review)}
 }
 
-func (_c *MockBoardSummaryRepository_StoreSummary_Call) Run(run func(ctx context.Context, summary BoardSummary)) *MockBoardSummaryRepository_StoreSummary_Call {
+func (_c *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call) Run(run func(ctx context.Context, review WeeklyReview)) *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 BoardSummary
+		var arg1 WeeklyReview
 		if args[1] != nil {
-			arg1 = args[1].(BoardSummary)
+			arg1 = args[1].(WeeklyReview)
 		}
 		run(
 			arg0,
@@ -576,12 +4610,12 @@ func (_c *MockBoardSummaryRepository_StoreSummary_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockBoardSummaryRepository_StoreSummary_Call) Return(err error) *MockBoardSummaryRepository_StoreSummary_Call {
+func (_c *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call) Return(err error) *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockBoardSummaryRepository_StoreSummary_Call) RunAndReturn(run func(ctx context.Context, summary BoardSummary) error) *MockBoardSummaryRepository_StoreSummary_Call {
+func (_c *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call) RunAndReturn(run func(ctx context.Context, review WeeklyReview) error) *MockWeeklyReviewNotifier_NotifyWeeklyReviewReady_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,75 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+const (
+	// MinProjectSuggestionTodos is the smallest group of similar todos proposed as a project.
+	MinProjectSuggestionTodos = 3
+	// MaxProjectNameChars is the longest project name.
+	MaxProjectNameChars = 100
+)
+
+// ProjectTodo is a todo grouped into a project or a project suggestion.
+type ProjectTodo struct {
+	ID    uuid.UUID
+	Title string
+}
+
+// Project is a named group of related todos. A todo belongs to at most one project.
+type Project struct {
+	ID        uuid.UUID
+	Name      string
+	Todos     []ProjectTodo
+	CreatedAt time.Time
+}
+
+// Validate checks if the project has valid fields.
+func (p Project) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return core.NewValidationErr("project name cannot be empty")
+	}
+	if len([]rune(p.Name)) > MaxProjectNameChars {
+		return core.NewValidationErr(fmt.Sprintf("project name cannot exceed %d characters", MaxProjectNameChars))
+	}
+	if len(p.Todos) == 0 {
+		return core.NewValidationErr("project must group at least one todo")
+	}
+	return nil
+}
+
+// ProjectSuggestion is a group of open todos with similar embeddings, named by the assistant and waiting to
+// be accepted as a project.
+type ProjectSuggestion struct {
+	ID        uuid.UUID
+	Name      string
+	Todos     []ProjectTodo
+	CreatedAt time.Time
+}
+
+// ProjectRepository defines the interface for storing projects and project suggestions.
+type ProjectRepository interface {
+	// ListUngroupedTodos returns up to limit open todos with an embedding that belong to no project, oldest first.
+	ListUngroupedTodos(ctx context.Context, limit int) ([]Todo, error)
+	// CreateProject stores a new project and the membership of its todos.
+	CreateProject(ctx context.Context, project Project) error
+	// ListProjects returns the projects with their todos, newest first.
+	ListProjects(ctx context.Context) ([]Project, error)
+	// CreateProjectSuggestions stores new project suggestions.
+	CreateProjectSuggestions(ctx context.Context, suggestions []ProjectSuggestion) error
+	// ListProjectSuggestions returns the pending project suggestions, largest group first.
+	ListProjectSuggestions(ctx context.Context) ([]ProjectSuggestion, error)
+	// GetProjectSuggestion retrieves a project suggestion by its ID.
+	GetProjectSuggestion(ctx context.Context, id uuid.UUID) (ProjectSuggestion, bool, error)
+	// DeleteProjectSuggestion removes a project suggestion.
+	DeleteProjectSuggestion(ctx context.Context, id uuid.UUID) error
+	// DeleteProjectSuggestions removes all pending project suggestions.
+	DeleteProjectSuggestions(ctx context.Context) error
+}
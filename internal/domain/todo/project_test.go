@@ -0,0 +1,49 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProject_Validate(t *testing.T) {
+	t.Parallel()
+
+	todos := []ProjectTodo{{ID: uuid.New(), Title: "Pack kitchen boxes"}}
+
+	tests := map[string]struct {
+		project Project
+		errMsg  string
+	}{
+		"valid": {
+			project: Project{Name: "House move", Todos: todos},
+		},
+		"empty-name": {
+			project: Project{Name: "  ", Todos: todos},
+			errMsg:  "project name cannot be empty",
+		},
+		"name-too-long": {
+			project: Project{Name: strings.Repeat("a", MaxProjectNameChars+1), Todos: todos},
+			errMsg:  "project name cannot exceed 100 characters",
+		},
+		"no-todos": {
+			project: Project{Name: "House move"},
+			errMsg:  "project must group at least one todo",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.project.Validate()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package todo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// MaxQueryChars is the maximum number of characters allowed in a query expression.
+const MaxQueryChars = 500
+
+// querySortValues maps the lowercase form of the accepted sort values to their canonical form.
+var querySortValues = map[string]string{
+	"duedateasc":     "dueDateAsc",
+	"duedatedesc":    "dueDateDesc",
+	"createdatasc":   "createdAtAsc",
+	"createdatdesc":  "createdAtDesc",
+	"similarityasc":  "similarityAsc",
+	"similaritydesc": "similarityDesc",
+	"smartorder":     "smartOrder",
+}
+
+// queryKeywords are the query term keys that are not custom field names.
+var queryKeywords = map[string]bool{
+	"status":   true,
+	"due":      true,
+	"title":    true,
+	"similar":  true,
+	"sort":     true,
+	"archived": true,
+}
+
+// DueBound is a due date bound of a query, given either as an absolute date or as a day offset
+// relative to today.
+type DueBound struct {
+	Date     time.Time
+	Days     int
+	Relative bool
+}
+
+// Resolve returns the date of the bound for the day of now.
+func (b DueBound) Resolve(now time.Time) time.Time {
+	if !b.Relative {
+		return b.Date
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return today.AddDate(0, 0, b.Days)
+}
+
+// shift moves the bound by the given number of days.
+func (b DueBound) shift(days int) DueBound {
+	if b.Relative {
+		b.Days += days
+		return b
+	}
+	b.Date = b.Date.AddDate(0, 0, days)
+	return b
+}
+
+// Query is a parsed todo query expression, such as `status:open due<2026-05-01 area:work -area:errands`.
+//
+// The expression is a list of space separated terms that must all match:
+//   - status:open|done filters by status.
+//   - due:DATE, due<DATE, due<=DATE, due>DATE and due>=DATE bound the due date, where DATE is YYYY-MM-DD,
+//     today, tomorrow, yesterday or a day offset such as today+7.
+//   - title:TEXT and similar:TEXT search by title substring or by meaning.
+//   - sort:SORT orders the result, e.g. sort:dueDateAsc.
+//   - archived:true includes archived todos.
+//   - NAME:VALUE keeps the todos whose custom field NAME holds VALUE; -NAME:VALUE excludes them.
+//   - Any other word is part of the title search.
+//
+// Values with spaces are double-quoted, e.g. title:"buy milk".
+type Query struct {
+	Status          *Status
+	TitleContains   *string
+	Similar         *string
+	SortBy          *string
+	DueAfter        *DueBound
+	DueBefore       *DueBound
+	IncludeArchived bool
+	// CustomFields and ExcludedCustomFields hold custom field values in their text form, keyed by field name.
+	CustomFields         map[string]string
+	ExcludedCustomFields map[string][]string
+}
+
+// DueRange returns the due date range of the query for the day of now. A query that only sets one bound is
+// closed with the earliest or latest representable date. It reports false when the query has no due bounds.
+func (q Query) DueRange(now time.Time) (time.Time, time.Time, bool) {
+	if q.DueAfter == nil && q.DueBefore == nil {
+		return time.Time{}, time.Time{}, false
+	}
+	dueAfter, dueBefore := minViewDueDate, maxViewDueDate
+	if q.DueAfter != nil {
+		dueAfter = q.DueAfter.Resolve(now)
+	}
+	if q.DueBefore != nil {
+		dueBefore = q.DueBefore.Resolve(now)
+	}
+	return dueAfter, dueBefore, true
+}
+
+// ParseQuery parses a todo query expression. Custom field terms are not checked against the tenant
+// custom fields; that is left to the listing.
+func ParseQuery(expr string) (Query, error) {
+	if utf8.RuneCountInString(expr) > MaxQueryChars {
+		return Query{}, core.NewValidationErr(fmt.Sprintf("query cannot exceed %d characters", MaxQueryChars))
+	}
+
+	terms, err := splitQueryTerms(expr)
+	if err != nil {
+		return Query{}, err
+	}
+
+	q := Query{}
+	words := []string{}
+	for _, term := range terms {
+		if strings.HasPrefix(term, `"`) {
+			words = append(words, strings.Trim(term, `"`))
+			continue
+		}
+
+		key, op, value, ok := cutQueryTerm(term)
+		if !ok {
+			words = append(words, term)
+			continue
+		}
+		if err := q.applyTerm(key, op, value); err != nil {
+			return Query{}, err
+		}
+	}
+
+	if len(words) > 0 {
+		if q.TitleContains != nil {
+			return Query{}, core.NewValidationErr("title words cannot be combined with a title term")
+		}
+		title := strings.Join(words, " ")
+		q.TitleContains = &title
+	}
+	if q.TitleContains != nil && q.Similar != nil {
+		return Query{}, core.NewValidationErr("title and similar searches cannot be combined")
+	}
+	if q.DueAfter != nil && q.DueBefore != nil && !q.DueAfter.Relative && !q.DueBefore.Relative &&
+		q.DueAfter.Date.After(q.DueBefore.Date) {
+		return Query{}, core.NewValidationErr("query due date range is empty")
+	}
+	return q, nil
+}
+
+// applyTerm applies a single key/operator/value term to the query.
+func (q *Query) applyTerm(key, op, value string) error {
+	name, negated := strings.CutPrefix(key, "-")
+	keyword := strings.ToLower(name)
+	if value == "" {
+		return core.NewValidationErr(fmt.Sprintf("query term %s%s has no value", key, op))
+	}
+	if op != ":" && keyword != "due" {
+		return core.NewValidationErr(fmt.Sprintf("operator %s is only supported by due", op))
+	}
+	if negated && queryKeywords[keyword] {
+		return core.NewValidationErr(fmt.Sprintf("only custom field terms can be negated: %s", key))
+	}
+
+	switch keyword {
+	case "status":
+		status := Status(strings.ToUpper(value))
+		if err := status.Validate(); err != nil {
+			return err
+		}
+		q.Status = &status
+	case "due":
+		return q.applyDueTerm(op, value)
+	case "title":
+		q.TitleContains = &value
+	case "similar":
+		q.Similar = &value
+	case "sort":
+		sortBy, ok := querySortValues[strings.ToLower(value)]
+		if !ok {
+			return core.NewValidationErr(fmt.Sprintf("invalid sort value: %s", value))
+		}
+		q.SortBy = &sortBy
+	case "archived":
+		include, err := strconv.ParseBool(value)
+		if err != nil {
+			return core.NewValidationErr(fmt.Sprintf("invalid archived value: %s", value))
+		}
+		q.IncludeArchived = include
+	default:
+		if negated {
+			if q.ExcludedCustomFields == nil {
+				q.ExcludedCustomFields = map[string][]string{}
+			}
+			q.ExcludedCustomFields[name] = append(q.ExcludedCustomFields[name], value)
+			return nil
+		}
+		if q.CustomFields == nil {
+			q.CustomFields = map[string]string{}
+		}
+		q.CustomFields[name] = value
+	}
+	return nil
+}
+
+// applyDueTerm sets the due date bounds of a due term. Strict comparisons exclude the given day.
+func (q *Query) applyDueTerm(op, value string) error {
+	bound, err := parseDueBound(value)
+	if err != nil {
+		return err
+	}
+
+	switch op {
+	case ":", "=":
+		q.DueAfter, q.DueBefore = &bound, &bound
+	case "<":
+		before := bound.shift(-1)
+		q.DueBefore = &before
+	case "<=":
+		q.DueBefore = &bound
+	case ">":
+		after := bound.shift(1)
+		q.DueAfter = &after
+	case ">=":
+		q.DueAfter = &bound
+	default:
+		return core.NewValidationErr(fmt.Sprintf("invalid due operator: %s", op))
+	}
+	return nil
+}
+
+// parseDueBound parses a YYYY-MM-DD date or a day relative to today, such as today, tomorrow or today+7.
+func parseDueBound(value string) (DueBound, error) {
+	lower := strings.ToLower(value)
+	switch lower {
+	case "today":
+		return DueBound{Relative: true}, nil
+	case "tomorrow":
+		return DueBound{Days: 1, Relative: true}, nil
+	case "yesterday":
+		return DueBound{Days: -1, Relative: true}, nil
+	}
+
+	if offset, ok := strings.CutPrefix(lower, "today"); ok {
+		days, err := strconv.Atoi(offset)
+		if err != nil || (offset[0] != '+' && offset[0] != '-') {
+			return DueBound{}, core.NewValidationErr(fmt.Sprintf("invalid due date: %s", value))
+		}
+		return DueBound{Days: days, Relative: true}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return DueBound{}, core.NewValidationErr(fmt.Sprintf("invalid due date: %s (expected YYYY-MM-DD or today+N)", value))
+	}
+	return DueBound{Date: date}, nil
+}
+
+// cutQueryTerm splits a term into its key, operator and unquoted value. It reports false for plain words.
+func cutQueryTerm(term string) (key, op, value string, ok bool) {
+	idx := strings.IndexAny(term, ":<>=")
+	if idx <= 0 || term[:idx] == "-" {
+		return "", "", "", false
+	}
+
+	key, rest := term[:idx], term[idx:]
+	op = rest[:1]
+	if len(rest) > 1 && rest[1] == '=' && (op == "<" || op == ">") {
+		op = rest[:2]
+	}
+	value = strings.Trim(strings.TrimPrefix(rest, op), `"`)
+	return key, op, value, true
+}
+
+// splitQueryTerms splits an expression on whitespace, keeping double-quoted parts together.
+func splitQueryTerms(expr string) ([]string, error) {
+	terms := []string{}
+	var (
+		current strings.Builder
+		quoted  bool
+	)
+	for _, r := range expr {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if current.Len() > 0 {
+				terms = append(terms, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, core.NewValidationErr("query has an unterminated quote")
+	}
+	if current.Len() > 0 {
+		terms = append(terms, current.String())
+	}
+	return terms, nil
+}
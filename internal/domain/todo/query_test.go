@@ -0,0 +1,166 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	may1 := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		expr     string
+		expected Query
+		errMsg   string
+	}{
+		"empty": {
+			expr:     "  ",
+			expected: Query{},
+		},
+		"full-expression": {
+			expr: `status:open due<2026-05-01 area:work -area:errands -area:home sort:duedateasc archived:true`,
+			expected: Query{
+				Status:               common.Ptr(Status_OPEN),
+				DueBefore:            &DueBound{Date: may1.AddDate(0, 0, -1)},
+				SortBy:               common.Ptr("dueDateAsc"),
+				IncludeArchived:      true,
+				CustomFields:         map[string]string{"area": "work"},
+				ExcludedCustomFields: map[string][]string{"area": {"errands", "home"}},
+			},
+		},
+		"relative-due-bounds": {
+			expr: "due>=today due<=today+7",
+			expected: Query{
+				DueAfter:  &DueBound{Relative: true},
+				DueBefore: &DueBound{Days: 7, Relative: true},
+			},
+		},
+		"due-on-day": {
+			expr:     "due:tomorrow",
+			expected: Query{DueAfter: &DueBound{Days: 1, Relative: true}, DueBefore: &DueBound{Days: 1, Relative: true}},
+		},
+		"strict-lower-bound": {
+			expr:     "due>2026-05-01",
+			expected: Query{DueAfter: &DueBound{Date: may1.AddDate(0, 0, 1)}},
+		},
+		"bare-words-search-title": {
+			expr:     `status:done "buy milk" today`,
+			expected: Query{Status: common.Ptr(Status_DONE), TitleContains: common.Ptr("buy milk today")},
+		},
+		"quoted-value": {
+			expr:     `similar:"dentist appointment" sort:similarityDesc`,
+			expected: Query{Similar: common.Ptr("dentist appointment"), SortBy: common.Ptr("similarityDesc")},
+		},
+		"smart-order": {
+			expr:     "status:open sort:SMARTORDER",
+			expected: Query{Status: common.Ptr(Status_OPEN), SortBy: common.Ptr("smartOrder")},
+		},
+		"invalid-status": {
+			expr:   "status:later",
+			errMsg: "status must be either OPEN or DONE",
+		},
+		"invalid-due-date": {
+			expr:   "due<next-week",
+			errMsg: "invalid due date: next-week (expected YYYY-MM-DD or today+N)",
+		},
+		"invalid-relative-offset": {
+			expr:   "due<today7",
+			errMsg: "invalid due date: today7",
+		},
+		"operator-on-non-due-term": {
+			expr:   "points>3",
+			errMsg: "operator > is only supported by due",
+		},
+		"negated-keyword": {
+			expr:   "-status:open",
+			errMsg: "only custom field terms can be negated: -status",
+		},
+		"missing-value": {
+			expr:   "area:",
+			errMsg: "query term area: has no value",
+		},
+		"invalid-sort": {
+			expr:   "sort:priority",
+			errMsg: "invalid sort value: priority",
+		},
+		"title-and-similar": {
+			expr:   "milk similar:groceries",
+			errMsg: "title and similar searches cannot be combined",
+		},
+		"title-term-and-words": {
+			expr:   "milk title:bread",
+			errMsg: "title words cannot be combined with a title term",
+		},
+		"empty-range": {
+			expr:   "due>=2026-05-02 due<=2026-05-01",
+			errMsg: "query due date range is empty",
+		},
+		"unterminated-quote": {
+			expr:   `title:"buy milk`,
+			errMsg: "query has an unterminated quote",
+		},
+		"too-long": {
+			expr:   strings.Repeat("a", MaxQueryChars+1),
+			errMsg: "query cannot exceed 500 characters",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseQuery(tt.expr)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestQuery_DueRange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		query          Query
+		expectedAfter  time.Time
+		expectedBefore time.Time
+		expectedOK     bool
+	}{
+		"no-bounds": {},
+		"upper-bound-only": {
+			query:          Query{DueBefore: &DueBound{Days: 7, Relative: true}},
+			expectedAfter:  minViewDueDate,
+			expectedBefore: today.AddDate(0, 0, 7),
+			expectedOK:     true,
+		},
+		"lower-bound-only": {
+			query:          Query{DueAfter: &DueBound{Date: today}},
+			expectedAfter:  today,
+			expectedBefore: maxViewDueDate,
+			expectedOK:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			after, before, ok := tt.query.DueRange(now)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedAfter, after)
+			assert.Equal(t, tt.expectedBefore, before)
+		})
+	}
+}
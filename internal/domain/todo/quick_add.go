@@ -0,0 +1,218 @@
+package todo
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// MaxQuickAddChars caps the length of a quick add line.
+const MaxQuickAddChars = 300
+
+// quickAddWeekdays maps the weekday names and abbreviations accepted in quick add lines.
+var quickAddWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "weds": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// quickAddDatePrepositions are dropped from the title when they precede a due date, as in "pay rent by fri".
+var quickAddDatePrepositions = map[string]bool{"on": true, "by": true, "due": true}
+
+// QuickAdd is a todo described by one line of free text, e.g. "dentist next tue 3pm #health !high".
+type QuickAdd struct {
+	Title string
+	// DueDate is the day named by the line, or nil when it names none.
+	DueDate *time.Time
+	// Tags are the #words of the line, lowercased and without the # sign.
+	Tags []string
+	// Priority is the last !word of the line, lowercased and without the ! sign.
+	Priority string
+}
+
+// ParseQuickAdd parses a quick add line. Words starting with # are tags and a word starting with ! is the
+// priority. The first date phrase sets the due date relative to today: today, tomorrow, a weekday (the next
+// one after today, also as "next tue"), next week, next month, "in N days" or "in N weeks", or a YYYY-MM-DD
+// date. Every other word, times of day included, is kept in the title.
+func ParseQuickAdd(line string, today time.Time) (QuickAdd, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return QuickAdd{}, core.NewValidationErr("text cannot be empty")
+	}
+	if len([]rune(line)) > MaxQuickAddChars {
+		return QuickAdd{}, core.NewValidationErr(fmt.Sprintf("text must be at most %d characters", MaxQuickAddChars))
+	}
+
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	words := strings.Fields(line)
+	title := make([]string, 0, len(words))
+	var q QuickAdd
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if tag, ok := strings.CutPrefix(word, "#"); ok && tag != "" {
+			if tag = strings.ToLower(tag); !slices.Contains(q.Tags, tag) {
+				q.Tags = append(q.Tags, tag)
+			}
+			continue
+		}
+		if priority, ok := strings.CutPrefix(word, "!"); ok && priority != "" {
+			q.Priority = strings.ToLower(priority)
+			continue
+		}
+		if q.DueDate == nil {
+			if due, consumed, ok := parseQuickAddDate(words[i:], today); ok {
+				q.DueDate = &due
+				i += consumed - 1
+				if n := len(title); n > 0 && quickAddDatePrepositions[strings.ToLower(title[n-1])] {
+					title = title[:n-1]
+				}
+				continue
+			}
+		}
+		title = append(title, word)
+	}
+
+	q.Title = strings.Join(title, " ")
+	if q.Title == "" {
+		return QuickAdd{}, core.NewValidationErr("text must contain a title")
+	}
+	return q, nil
+}
+
+// parseQuickAddDate parses the date phrase at the start of words and returns the number of words it spans.
+func parseQuickAddDate(words []string, today time.Time) (time.Time, int, bool) {
+	word := strings.ToLower(strings.TrimRight(words[0], ".,;"))
+	next := ""
+	if len(words) > 1 {
+		next = strings.ToLower(strings.TrimRight(words[1], ".,;"))
+	}
+
+	switch word {
+	case "today", "tonight":
+		return today, 1, true
+	case "tomorrow", "tmr", "tmrw":
+		return today.AddDate(0, 0, 1), 1, true
+	case "next":
+		if weekday, ok := quickAddWeekdays[next]; ok {
+			return nextWeekday(today, weekday), 2, true
+		}
+		switch next {
+		case "week":
+			return today.AddDate(0, 0, 7), 2, true
+		case "month":
+			return today.AddDate(0, 1, 0), 2, true
+		}
+		return time.Time{}, 0, false
+	case "in":
+		if len(words) < 3 {
+			return time.Time{}, 0, false
+		}
+		n, err := strconv.Atoi(next)
+		if err != nil || n < 0 {
+			return time.Time{}, 0, false
+		}
+		switch strings.ToLower(strings.TrimRight(words[2], ".,;")) {
+		case "day", "days":
+			return today.AddDate(0, 0, n), 3, true
+		case "week", "weeks":
+			return today.AddDate(0, 0, 7*n), 3, true
+		}
+		return time.Time{}, 0, false
+	}
+
+	if weekday, ok := quickAddWeekdays[word]; ok {
+		return nextWeekday(today, weekday), 1, true
+	}
+	if date, err := time.Parse(time.DateOnly, word); err == nil {
+		return date, 1, true
+	}
+	return time.Time{}, 0, false
+}
+
+// nextWeekday returns the first day after today that falls on the weekday.
+func nextWeekday(today time.Time, weekday time.Weekday) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
+
+// CustomFieldValues maps the tags and priority onto the tenant's custom fields and returns the #tag and
+// !priority words that match none. The priority sets PriorityCustomField: an ENUM field takes a matching
+// option or the 1-based position of one, and a NUMBER field takes a number. A tag sets the BOOLEAN field of
+// the same name to true, or else the first ENUM field with a matching option. Options match case-insensitively.
+func (q QuickAdd) CustomFieldValues(fields []CustomField) (CustomFieldValues, []string) {
+	values := CustomFieldValues{}
+	unresolved := []string{}
+
+	if q.Priority != "" {
+		if value, ok := quickAddPriorityValue(fields, q.Priority); ok {
+			values[PriorityCustomField] = value
+		} else {
+			unresolved = append(unresolved, "!"+q.Priority)
+		}
+	}
+
+	for _, tag := range q.Tags {
+		if field, ok := FindCustomField(fields, tag); ok && field.Type == CustomFieldType_BOOLEAN {
+			values[tag] = true
+			continue
+		}
+		resolved := false
+		for _, field := range fields {
+			if _, taken := values[field.Name]; taken || field.Type != CustomFieldType_ENUM {
+				continue
+			}
+			if option, ok := matchCustomFieldOption(field, tag); ok {
+				values[field.Name] = option
+				resolved = true
+				break
+			}
+		}
+		if !resolved {
+			unresolved = append(unresolved, "#"+tag)
+		}
+	}
+
+	return values, unresolved
+}
+
+// quickAddPriorityValue returns the value of PriorityCustomField named by a quick add priority.
+func quickAddPriorityValue(fields []CustomField, priority string) (any, bool) {
+	field, ok := FindCustomField(fields, PriorityCustomField)
+	if !ok {
+		return nil, false
+	}
+	switch field.Type {
+	case CustomFieldType_ENUM:
+		if option, ok := matchCustomFieldOption(field, priority); ok {
+			return option, true
+		}
+		if n, err := strconv.Atoi(priority); err == nil && n >= 1 && n <= len(field.Options) {
+			return field.Options[n-1], true
+		}
+	case CustomFieldType_NUMBER:
+		if n, err := strconv.ParseFloat(priority, 64); err == nil {
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// matchCustomFieldOption returns the option of an ENUM field that equals the value regardless of case.
+func matchCustomFieldOption(field CustomField, value string) (string, bool) {
+	i := slices.IndexFunc(field.Options, func(o string) bool { return strings.EqualFold(o, value) })
+	if i < 0 {
+		return "", false
+	}
+	return field.Options[i], true
+}
@@ -0,0 +1,144 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	t.Parallel()
+
+	// A Friday.
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	today := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		line     string
+		expected QuickAdd
+		errMsg   string
+	}{
+		"tags-priority-and-weekday": {
+			line: "dentist next tue 3pm #health !High",
+			expected: QuickAdd{
+				Title:    "dentist 3pm",
+				DueDate:  common.Ptr(today.AddDate(0, 0, 4)),
+				Tags:     []string{"health"},
+				Priority: "high",
+			},
+		},
+		"plain-weekday-skips-today": {
+			line:     "team retro fri",
+			expected: QuickAdd{Title: "team retro", DueDate: common.Ptr(today.AddDate(0, 0, 7))},
+		},
+		"tomorrow-drops-preposition": {
+			line:     "pay rent by tomorrow #home #Home",
+			expected: QuickAdd{Title: "pay rent", DueDate: common.Ptr(today.AddDate(0, 0, 1)), Tags: []string{"home"}},
+		},
+		"in-n-weeks": {
+			line:     "renew passport in 3 weeks",
+			expected: QuickAdd{Title: "renew passport", DueDate: common.Ptr(today.AddDate(0, 0, 21))},
+		},
+		"next-month": {
+			line:     "book flights next month",
+			expected: QuickAdd{Title: "book flights", DueDate: common.Ptr(today.AddDate(0, 1, 0))},
+		},
+		"iso-date-and-only-first-date": {
+			line:     "file taxes on 2027-04-15 or today",
+			expected: QuickAdd{Title: "file taxes or today", DueDate: common.Ptr(time.Date(2027, 4, 15, 0, 0, 0, 0, time.UTC))},
+		},
+		"no-date": {
+			line:     "call mom",
+			expected: QuickAdd{Title: "call mom"},
+		},
+		"in-without-duration-is-title": {
+			line:     "check in with Ana",
+			expected: QuickAdd{Title: "check in with Ana"},
+		},
+		"empty": {
+			line:   "  ",
+			errMsg: "text cannot be empty",
+		},
+		"only-markers": {
+			line:   "today #home !1",
+			errMsg: "text must contain a title",
+		},
+		"too-long": {
+			line:   strings.Repeat("a", MaxQuickAddChars+1),
+			errMsg: "text must be at most 300 characters",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseQuickAdd(tt.line, now)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestQuickAdd_CustomFieldValues(t *testing.T) {
+	t.Parallel()
+
+	enumPriority := CustomField{Name: PriorityCustomField, Type: CustomFieldType_ENUM, Options: []string{"High", "Medium", "Low"}}
+	area := CustomField{Name: "area", Type: CustomFieldType_ENUM, Options: []string{"home", "health", "work"}}
+	errand := CustomField{Name: "errand", Type: CustomFieldType_BOOLEAN}
+
+	tests := map[string]struct {
+		quickAdd           QuickAdd
+		fields             []CustomField
+		expectedValues     CustomFieldValues
+		expectedUnresolved []string
+	}{
+		"enum-priority-and-tags": {
+			quickAdd:           QuickAdd{Tags: []string{"health", "errand", "later"}, Priority: "high"},
+			fields:             []CustomField{area, errand, enumPriority},
+			expectedValues:     CustomFieldValues{"priority": "High", "area": "health", "errand": true},
+			expectedUnresolved: []string{"#later"},
+		},
+		"enum-priority-by-position": {
+			quickAdd:           QuickAdd{Priority: "2"},
+			fields:             []CustomField{enumPriority},
+			expectedValues:     CustomFieldValues{"priority": "Medium"},
+			expectedUnresolved: []string{},
+		},
+		"number-priority": {
+			quickAdd:           QuickAdd{Priority: "3"},
+			fields:             []CustomField{{Name: PriorityCustomField, Type: CustomFieldType_NUMBER}},
+			expectedValues:     CustomFieldValues{"priority": 3.0},
+			expectedUnresolved: []string{},
+		},
+		"one-value-per-enum-field": {
+			quickAdd:           QuickAdd{Tags: []string{"home", "work"}},
+			fields:             []CustomField{area},
+			expectedValues:     CustomFieldValues{"area": "home"},
+			expectedUnresolved: []string{"#work"},
+		},
+		"no-priority-field": {
+			quickAdd:           QuickAdd{Priority: "high"},
+			fields:             []CustomField{area},
+			expectedValues:     CustomFieldValues{},
+			expectedUnresolved: []string{"!high"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			values, unresolved := tt.quickAdd.CustomFieldValues(tt.fields)
+			assert.Equal(t, tt.expectedValues, values)
+			assert.Equal(t, tt.expectedUnresolved, unresolved)
+		})
+	}
+}
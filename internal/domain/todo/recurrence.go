@@ -0,0 +1,235 @@
+package todo
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+)
+
+// MaxRecurrenceInterval is the largest number of frequency units between two occurrences of a recurring todo.
+const MaxRecurrenceInterval = 365
+
+// RecurrenceFrequency is the unit a recurring todo repeats by.
+type RecurrenceFrequency string
+
+const (
+	// RecurrenceFrequency_DAILY repeats the todo every Interval days.
+	RecurrenceFrequency_DAILY RecurrenceFrequency = "DAILY"
+	// RecurrenceFrequency_WEEKLY repeats the todo every Interval weeks.
+	RecurrenceFrequency_WEEKLY RecurrenceFrequency = "WEEKLY"
+	// RecurrenceFrequency_MONTHLY repeats the todo every Interval months.
+	RecurrenceFrequency_MONTHLY RecurrenceFrequency = "MONTHLY"
+)
+
+// Validate checks if the RecurrenceFrequency is valid.
+func (f RecurrenceFrequency) Validate() error {
+	switch f {
+	case RecurrenceFrequency_DAILY, RecurrenceFrequency_WEEKLY, RecurrenceFrequency_MONTHLY:
+		return nil
+	}
+	return core.NewValidationErr("recurrence frequency must be one of DAILY, WEEKLY or MONTHLY")
+}
+
+// rruleWeekdays maps the iCalendar weekday codes to time weekdays.
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Recurrence is the rule a recurring todo repeats by. It covers the subset of the iCalendar RRULE made of
+// FREQ, INTERVAL, BYDAY for weekly rules and BYMONTHDAY for monthly rules. When a recurring todo is done, its next occurrence is created
+// with the rule and the completed todo stops recurring.
+type Recurrence struct {
+	Frequency RecurrenceFrequency
+	// Interval is the number of frequency units between two occurrences, e.g. 2 for every other week.
+	Interval int
+	// Weekdays restricts a weekly rule to these days. Empty repeats on the weekday of the due date.
+	Weekdays []time.Weekday
+	// MonthDay is the day of the month a monthly rule repeats on, falling back to the last day of shorter
+	// months. Zero repeats on the day of the due date; see AnchoredTo.
+	MonthDay int
+}
+
+// ParseRecurrence parses a recurrence rule. It accepts the shorthands daily, weekly and monthly, or an RRULE
+// such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH" or "FREQ=MONTHLY;BYMONTHDAY=31", with or without the "RRULE:" prefix.
+func ParseRecurrence(rule string) (Recurrence, error) {
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	rule = strings.TrimPrefix(rule, "RRULE:")
+	if rule == "" {
+		return Recurrence{}, core.NewValidationErr("recurrence rule cannot be empty")
+	}
+
+	r := Recurrence{Interval: 1}
+	if !strings.Contains(rule, "=") {
+		r.Frequency = RecurrenceFrequency(rule)
+		return r, r.Validate()
+	}
+
+	for part := range strings.SplitSeq(rule, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, core.NewValidationErr(fmt.Sprintf("invalid recurrence rule part %q", part))
+		}
+		switch name {
+		case "FREQ":
+			r.Frequency = RecurrenceFrequency(value)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil {
+				return Recurrence{}, core.NewValidationErr(fmt.Sprintf("invalid recurrence interval %q", value))
+			}
+			r.Interval = interval
+		case "BYDAY":
+			for code := range strings.SplitSeq(value, ",") {
+				day, ok := rruleWeekdays[code]
+				if !ok {
+					return Recurrence{}, core.NewValidationErr(fmt.Sprintf("invalid recurrence weekday %q", code))
+				}
+				if !slices.Contains(r.Weekdays, day) {
+					r.Weekdays = append(r.Weekdays, day)
+				}
+			}
+		case "BYMONTHDAY":
+			day, err := strconv.Atoi(value)
+			if err != nil {
+				return Recurrence{}, core.NewValidationErr(fmt.Sprintf("invalid recurrence month day %q", value))
+			}
+			r.MonthDay = day
+		case "WKST":
+			if value != "MO" {
+				return Recurrence{}, core.NewValidationErr("recurrence weeks must start on MO")
+			}
+		default:
+			return Recurrence{}, core.NewValidationErr(fmt.Sprintf("unsupported recurrence rule part %q", name))
+		}
+	}
+	slices.Sort(r.Weekdays)
+
+	return r, r.Validate()
+}
+
+// IsZero reports whether the recurrence has no frequency.
+func (r Recurrence) IsZero() bool {
+	return r.Frequency == ""
+}
+
+// Validate checks if the recurrence has valid fields.
+func (r Recurrence) Validate() error {
+	if err := r.Frequency.Validate(); err != nil {
+		return err
+	}
+	if r.Interval < 1 || r.Interval > MaxRecurrenceInterval {
+		return core.NewValidationErr(fmt.Sprintf("recurrence interval must be between 1 and %d", MaxRecurrenceInterval))
+	}
+	if len(r.Weekdays) > 0 && r.Frequency != RecurrenceFrequency_WEEKLY {
+		return core.NewValidationErr("recurrence weekdays are only allowed for WEEKLY rules")
+	}
+	if r.MonthDay != 0 && r.Frequency != RecurrenceFrequency_MONTHLY {
+		return core.NewValidationErr("recurrence month day is only allowed for MONTHLY rules")
+	}
+	if r.MonthDay < 0 || r.MonthDay > 31 {
+		return core.NewValidationErr("recurrence month day must be between 1 and 31")
+	}
+	return nil
+}
+
+// String returns the rule as an RRULE, e.g. "FREQ=WEEKLY;BYDAY=MO". INTERVAL is omitted when it is 1.
+func (r Recurrence) String() string {
+	var b strings.Builder
+	b.WriteString("FREQ=" + string(r.Frequency))
+	if r.Interval > 1 {
+		b.WriteString(";INTERVAL=" + strconv.Itoa(r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		codes := make([]string, 0, len(r.Weekdays))
+		for _, day := range r.Weekdays {
+			codes = append(codes, strings.ToUpper(day.String()[:2]))
+		}
+		b.WriteString(";BYDAY=" + strings.Join(codes, ","))
+	}
+	if r.MonthDay > 0 {
+		b.WriteString(";BYMONTHDAY=" + strconv.Itoa(r.MonthDay))
+	}
+	return b.String()
+}
+
+// Next returns the first occurrence after the due date. Monthly rules repeat on MonthDay, or the day of the due
+// date when it is zero, falling back to the last day of shorter months.
+func (r Recurrence) Next(due time.Time) time.Time {
+	interval := max(r.Interval, 1)
+	switch r.Frequency {
+	case RecurrenceFrequency_WEEKLY:
+		if len(r.Weekdays) == 0 {
+			return due.AddDate(0, 0, 7*interval)
+		}
+		dueWeek := startOfWeek(due)
+		for candidate := due.AddDate(0, 0, 1); ; candidate = candidate.AddDate(0, 0, 1) {
+			weeks := int(startOfWeek(candidate).Sub(dueWeek).Hours()/24+0.5) / 7
+			if weeks%interval == 0 && slices.Contains(r.Weekdays, candidate.Weekday()) {
+				return candidate
+			}
+		}
+	case RecurrenceFrequency_MONTHLY:
+		year, month, day := due.Date()
+		if r.MonthDay > 0 {
+			day = r.MonthDay
+		}
+		firstOfMonth := time.Date(year, month+time.Month(interval), 1, 0, 0, 0, 0, due.Location())
+		lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+		hour, minute, sec := due.Clock()
+		return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), min(day, lastDay), hour, minute, sec, due.Nanosecond(), due.Location())
+	default:
+		return due.AddDate(0, 0, interval)
+	}
+}
+
+// NextOccurrence returns the due date of the occurrence following the one due on due, skipping the
+// occurrences that fall before the day of now so that a late completion is not followed by an overdue todo.
+func (r Recurrence) NextOccurrence(due, now time.Time) time.Time {
+	r = r.AnchoredTo(due)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, due.Location())
+	next := r.Next(due)
+	for next.Before(today) {
+		next = r.Next(next)
+	}
+	return next
+}
+
+// AnchoredTo returns the rule with the MonthDay of a monthly rule set to the day of due when it has none, so the
+// occurrences of the series keep that day instead of drifting after a shorter month, e.g. Jan 31, Feb 28, Mar 31.
+func (r Recurrence) AnchoredTo(due time.Time) Recurrence {
+	if r.Frequency == RecurrenceFrequency_MONTHLY && r.MonthDay == 0 {
+		r.MonthDay = due.Day()
+	}
+	return r
+}
+
+// FirstOnOrAfter returns the due date of the first occurrence of a series starting on day: day itself or, for a
+// weekly rule restricted to weekdays, the first of those weekdays from day on.
+func (r Recurrence) FirstOnOrAfter(day time.Time) time.Time {
+	if r.Frequency != RecurrenceFrequency_WEEKLY || len(r.Weekdays) == 0 {
+		return day
+	}
+	for !slices.Contains(r.Weekdays, day.Weekday()) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// startOfWeek returns the midnight of the Monday of the week of t.
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,284 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		rule     string
+		expected Recurrence
+		errMsg   string
+	}{
+		"daily-shorthand": {
+			rule:     "daily",
+			expected: Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1},
+		},
+		"monthly-shorthand": {
+			rule:     " Monthly ",
+			expected: Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1},
+		},
+		"rrule-with-prefix": {
+			rule: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TH,MO,MO",
+			expected: Recurrence{
+				Frequency: RecurrenceFrequency_WEEKLY,
+				Interval:  2,
+				Weekdays:  []time.Weekday{time.Monday, time.Thursday},
+			},
+		},
+		"rrule-month-day": {
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=31",
+			expected: Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 31},
+		},
+		"rrule-week-start": {
+			rule:     "freq=daily;wkst=mo",
+			expected: Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1},
+		},
+		"empty": {
+			rule:   "  ",
+			errMsg: "recurrence rule cannot be empty",
+		},
+		"unknown-shorthand": {
+			rule:   "yearly",
+			errMsg: "recurrence frequency must be one of DAILY, WEEKLY or MONTHLY",
+		},
+		"missing-frequency": {
+			rule:   "INTERVAL=2",
+			errMsg: "recurrence frequency must be one of DAILY, WEEKLY or MONTHLY",
+		},
+		"invalid-interval": {
+			rule:   "FREQ=DAILY;INTERVAL=two",
+			errMsg: `invalid recurrence interval "TWO"`,
+		},
+		"interval-out-of-range": {
+			rule:   "FREQ=DAILY;INTERVAL=0",
+			errMsg: "recurrence interval must be between 1 and 365",
+		},
+		"invalid-weekday": {
+			rule:   "FREQ=WEEKLY;BYDAY=1MO",
+			errMsg: `invalid recurrence weekday "1MO"`,
+		},
+		"weekdays-on-daily": {
+			rule:   "FREQ=DAILY;BYDAY=MO",
+			errMsg: "recurrence weekdays are only allowed for WEEKLY rules",
+		},
+		"invalid-month-day": {
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=last",
+			errMsg: `invalid recurrence month day "LAST"`,
+		},
+		"month-day-out-of-range": {
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=32",
+			errMsg: "recurrence month day must be between 1 and 31",
+		},
+		"month-day-on-weekly": {
+			rule:   "FREQ=WEEKLY;BYMONTHDAY=5",
+			errMsg: "recurrence month day is only allowed for MONTHLY rules",
+		},
+		"unsupported-part": {
+			rule:   "FREQ=DAILY;COUNT=3",
+			errMsg: `unsupported recurrence rule part "COUNT"`,
+		},
+		"malformed-part": {
+			rule:   "FREQ=DAILY;INTERVAL",
+			errMsg: `invalid recurrence rule part "INTERVAL"`,
+		},
+		"week-start-not-monday": {
+			rule:   "FREQ=WEEKLY;WKST=SU",
+			errMsg: "recurrence weeks must start on MO",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseRecurrence(tt.rule)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestRecurrence_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "FREQ=DAILY", Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1}.String())
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", Recurrence{
+		Frequency: RecurrenceFrequency_WEEKLY,
+		Interval:  2,
+		Weekdays:  []time.Weekday{time.Monday, time.Thursday},
+	}.String())
+
+	parsed, err := ParseRecurrence("FREQ=WEEKLY;INTERVAL=3;BYDAY=SU")
+	assert.NoError(t, err)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=3;BYDAY=SU", parsed.String())
+
+	parsed, err = ParseRecurrence("FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=31")
+	assert.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=31", parsed.String())
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-14 is a Wednesday.
+	wednesday := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		recurrence Recurrence
+		due        time.Time
+		expected   time.Time
+	}{
+		"daily": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		},
+		"every-three-days": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 3},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		},
+		"weekly-on-due-weekday": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 1},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC),
+		},
+		"weekly-later-weekday-same-week": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Monday, time.Friday}},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		},
+		"weekly-next-monday": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Monday}},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		},
+		"every-other-week-sunday-closes-the-week": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Sunday}},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		},
+		"every-other-week-skips-a-week": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 2, Weekdays: []time.Weekday{time.Monday}},
+			due:        wednesday,
+			expected:   time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC),
+		},
+		"monthly": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1},
+			due:        wednesday,
+			expected:   time.Date(2026, 11, 14, 0, 0, 0, 0, time.UTC),
+		},
+		"monthly-clamps-to-shorter-month": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1},
+			due:        time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		"monthly-on-month-day-after-shorter-month": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 31},
+			due:        time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"quarterly-across-year": {
+			recurrence: Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 3},
+			due:        time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+			expected:   time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.recurrence.Next(tt.due))
+		})
+	}
+}
+
+func TestRecurrence_NextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	daily := Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1}
+	due := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	// Completed on time: the next occurrence follows the due date.
+	now := time.Date(2026, 10, 14, 18, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), daily.NextOccurrence(due, now))
+
+	// Completed days late: the missed occurrences are skipped up to today.
+	now = time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), daily.NextOccurrence(due, now))
+
+	weekly := Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 1}
+	assert.Equal(t, time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC), weekly.NextOccurrence(due, now))
+}
+
+func TestRecurrence_NextOccurrence_KeepsMonthDay(t *testing.T) {
+	t.Parallel()
+
+	// Each occurrence is completed on its due date, the way the scheduler walks a series.
+	monthly := Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1}.AnchoredTo(time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC))
+	due := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	var got []time.Time
+	for range 4 {
+		due = monthly.NextOccurrence(due, due)
+		got = append(got, due)
+	}
+
+	assert.Equal(t, []time.Time{
+		time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2027, 4, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2027, 5, 31, 0, 0, 0, 0, time.UTC),
+	}, got)
+
+	// A series completed months late skips to the first occurrence from today without drifting.
+	due = time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2027, 3, 5, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC), Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1}.NextOccurrence(due, now))
+}
+
+func TestRecurrence_AnchoredTo(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t,
+		Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 31},
+		Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1}.AnchoredTo(due),
+	)
+	assert.Equal(t,
+		Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 15},
+		Recurrence{Frequency: RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 15}.AnchoredTo(due),
+	)
+	assert.Equal(t,
+		Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1},
+		Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1}.AnchoredTo(due),
+	)
+}
+
+func TestRecurrence_FirstOnOrAfter(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-14 is a Wednesday.
+	wednesday := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	daily := Recurrence{Frequency: RecurrenceFrequency_DAILY, Interval: 1}
+	assert.Equal(t, wednesday, daily.FirstOnOrAfter(wednesday))
+
+	mondays := Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Monday}}
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), mondays.FirstOnOrAfter(wednesday))
+
+	midweek := Recurrence{Frequency: RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Wednesday, time.Friday}}
+	assert.Equal(t, wednesday, midweek.FirstOnOrAfter(wednesday))
+}
@@ -2,6 +2,7 @@ package todo
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -22,4 +23,29 @@ type Repository interface {
 
 	// GetTodo retrieves one todo item by ID.
 	GetTodo(ctx context.Context, id uuid.UUID) (Todo, bool, error)
+
+	// LockTodo retrieves one todo item by ID and locks it against concurrent writers until the unit of
+	// work ends. It must be called inside a unit of work.
+	LockTodo(ctx context.Context, id uuid.UUID) (Todo, bool, error)
+
+	// ArchiveCompletedTodos archives the active DONE todos last updated before doneBefore, stamping them with
+	// archivedAt, and returns their IDs.
+	ArchiveCompletedTodos(ctx context.Context, doneBefore time.Time, archivedAt time.Time) ([]uuid.UUID, error)
+
+	// ListStaleEmbeddingTodos returns up to limit todos, in ID order after the given ID, whose embedding in
+	// the slot is missing or was not produced by model.
+	ListStaleEmbeddingTodos(ctx context.Context, slot EmbeddingSlot, model string, after uuid.UUID, limit int) ([]Todo, error)
+
+	// CountStaleEmbeddingTodos counts the todos whose embedding in the slot is missing or was not produced by model.
+	CountStaleEmbeddingTodos(ctx context.Context, slot EmbeddingSlot, model string) (int, error)
+
+	// CountTodos counts the todos matching the options, ignoring sorting, and stops counting at limit.
+	CountTodos(ctx context.Context, limit int, opts ...ListOption) (int, error)
+
+	// ScoreTodos returns the similarity, from 0 to 1, between each of the given todos and the embedding set by
+	// the options. Todos without a comparable embedding are left out.
+	ScoreTodos(ctx context.Context, ids []uuid.UUID, opts ...ListOption) (map[uuid.UUID]float64, error)
+
+	// UpdateTodoEmbedding replaces the embedding of a todo in the slot without touching its other fields.
+	UpdateTodoEmbedding(ctx context.Context, id uuid.UUID, slot EmbeddingSlot, embedding []float64, model string) error
 }
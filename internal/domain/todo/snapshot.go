@@ -0,0 +1,117 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// MaxSnapshotTitleChars is the maximum number of characters allowed in a snapshot title.
+const MaxSnapshotTitleChars = 120
+
+// BoardSnapshot is an immutable, shareable copy of a filtered todo list and the board summary
+// current when it was taken. It is retrieved by its token and never changes after it is stored.
+type BoardSnapshot struct {
+	ID     uuid.UUID
+	Token  string
+	Title  string
+	Filter ViewFilter
+	Todos  []Todo
+	// Summary is the latest board summary when the snapshot was taken, nil when none was generated yet.
+	Summary *BoardSummary
+	// Markdown is the document rendered by RenderMarkdown when the snapshot was taken.
+	Markdown  string
+	CreatedAt time.Time
+}
+
+// Validate checks if the snapshot has valid fields.
+func (s BoardSnapshot) Validate() error {
+	title := strings.TrimSpace(s.Title)
+	if title == "" {
+		return core.NewValidationErr("title cannot be empty")
+	}
+	if utf8.RuneCountInString(title) > MaxSnapshotTitleChars {
+		return core.NewValidationErr(fmt.Sprintf("title cannot exceed %d characters", MaxSnapshotTitleChars))
+	}
+	return s.Filter.Validate()
+}
+
+// RenderMarkdown renders the snapshot as a Markdown document: the board summary followed by the
+// todos grouped by status.
+func (s BoardSnapshot) RenderMarkdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", escapeMarkdown(s.Title))
+	fmt.Fprintf(&b, "_Snapshot taken on %s_\n", s.CreatedAt.UTC().Format("2006-01-02 15:04 MST"))
+
+	if s.Summary != nil {
+		content := s.Summary.Content
+		b.WriteString("\n## Summary\n\n")
+		if content.Summary != "" {
+			fmt.Fprintf(&b, "%s\n\n", escapeMarkdown(content.Summary))
+		}
+		fmt.Fprintf(&b, "- Open: %d\n- Done: %d\n", content.Counts.Open, content.Counts.Done)
+		if len(content.NextUp) > 0 {
+			b.WriteString("\n### Next up\n\n")
+			for _, item := range content.NextUp {
+				fmt.Fprintf(&b, "- %s (%s)\n", escapeMarkdown(item.Title), item.Reason)
+			}
+		}
+		writeMarkdownTitles(&b, "Overdue", content.Overdue)
+		writeMarkdownTitles(&b, "Near deadline", content.NearDeadline)
+	}
+
+	for _, status := range []Status{Status_OPEN, Status_DONE} {
+		heading, box := "Open", " "
+		if status == Status_DONE {
+			heading, box = "Done", "x"
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", heading)
+		count := 0
+		for _, t := range s.Todos {
+			if t.Status != status {
+				continue
+			}
+			fmt.Fprintf(&b, "- [%s] %s (due %s)\n", box, escapeMarkdown(t.Title), t.DueDate.Format(time.DateOnly))
+			count++
+		}
+		if count == 0 {
+			b.WriteString("_None_\n")
+		}
+	}
+
+	return b.String()
+}
+
+// writeMarkdownTitles writes a list of todo titles under a heading, skipping empty lists.
+func writeMarkdownTitles(b *strings.Builder, heading string, titles []string) {
+	if len(titles) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n### %s\n\n", heading)
+	for _, title := range titles {
+		fmt.Fprintf(b, "- %s\n", escapeMarkdown(title))
+	}
+}
+
+// markdownEscaper escapes the characters that would turn user text into Markdown markup.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "#", `\#`, "<", `\<`, ">", `\>`, "\n", " ",
+)
+
+// escapeMarkdown escapes user text for inline use in a Markdown document.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// BoardSnapshotRepository defines the interface for board snapshot persistence.
+type BoardSnapshotRepository interface {
+	// CreateSnapshot stores a new snapshot.
+	CreateSnapshot(ctx context.Context, snapshot BoardSnapshot) error
+	// GetSnapshotByToken retrieves a snapshot by its token.
+	GetSnapshotByToken(ctx context.Context, token string) (BoardSnapshot, bool, error)
+}
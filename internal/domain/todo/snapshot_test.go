@@ -0,0 +1,127 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardSnapshot_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		snapshot BoardSnapshot
+		errMsg   string
+	}{
+		"valid": {
+			snapshot: BoardSnapshot{Title: "Sprint board", Filter: ViewFilter{Status: common.Ptr(Status_OPEN)}},
+		},
+		"empty-title": {
+			snapshot: BoardSnapshot{Title: " "},
+			errMsg:   "title cannot be empty",
+		},
+		"title-too-long": {
+			snapshot: BoardSnapshot{Title: strings.Repeat("a", MaxSnapshotTitleChars+1)},
+			errMsg:   "title cannot exceed 120 characters",
+		},
+		"invalid-filter": {
+			snapshot: BoardSnapshot{Title: "Sprint board", Filter: ViewFilter{DueFromDays: common.Ptr(3), DueToDays: common.Ptr(1)}},
+			errMsg:   "due_from_days must be less than or equal to due_to_days",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.snapshot.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestBoardSnapshot_RenderMarkdown(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		snapshot BoardSnapshot
+		expected string
+	}{
+		"with-summary": {
+			snapshot: BoardSnapshot{
+				Title: "Sprint *board*",
+				Todos: []Todo{
+					{Title: "Write [draft]", Status: Status_OPEN, DueDate: due},
+					{Title: "Book venue", Status: Status_DONE, DueDate: due},
+				},
+				Summary: &BoardSummary{Content: BoardSummaryContent{
+					Counts:  StatusCounts{Open: 1, Done: 1},
+					NextUp:  []NextUpItem{{Title: "Write [draft]", Reason: "due within 7 days"}},
+					Overdue: []string{"Pay rent"},
+					Summary: "One task left.",
+				}},
+				CreatedAt: createdAt,
+			},
+			expected: `# Sprint \*board\*
+
+_Snapshot taken on 2026-03-02 09:30 UTC_
+
+## Summary
+
+One task left.
+
+- Open: 1
+- Done: 1
+
+### Next up
+
+- Write \[draft\] (due within 7 days)
+
+### Overdue
+
+- Pay rent
+
+## Open
+
+- [ ] Write \[draft\] (due 2026-03-05)
+
+## Done
+
+- [x] Book venue (due 2026-03-05)
+`,
+		},
+		"without-summary-or-todos": {
+			snapshot: BoardSnapshot{Title: "Empty", CreatedAt: createdAt},
+			expected: `# Empty
+
+_Snapshot taken on 2026-03-02 09:30 UTC_
+
+## Open
+
+_None_
+
+## Done
+
+_None_
+`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.snapshot.RenderMarkdown())
+		})
+	}
+}
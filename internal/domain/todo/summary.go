@@ -104,7 +104,8 @@ func (c *BoardSummaryContent) ApplySummary(summary string) {
 
 // BuildComparisonHints computes hints by comparing this content with a previous version.
 func (c BoardSummaryContent) BuildComparisonHints(previous BoardSummaryContent) ComparisonHints {
-	doneDelta := c.Counts.Done - previous.Counts.Done
+	// Archiving moves todos out of Done, so archived todos still count as completed here.
+	doneDelta := (c.Counts.Done + c.Counts.Archived) - (previous.Counts.Done + previous.Counts.Archived)
 	completedCandidates := c.extractCompletedCandidates(previous)
 
 	overdueTitles := normalizeTitles(c.Overdue)
@@ -176,6 +177,8 @@ func (c BoardSummaryContent) categorizeNextUpItems() (overdue, dueSoon, upcoming
 type StatusCounts struct {
 	Open int `json:"OPEN"`
 	Done int `json:"DONE"`
+	// Archived counts the completed todos archived by the retention policy. They are not part of Done.
+	Archived int `json:"ARCHIVED"`
 	// If you later add more statuses, add fields here to keep JSON stable.
 }
 
@@ -96,6 +96,19 @@ func TestBoardSummaryContent_BuildComparisonHints(t *testing.T) {
 				NextUpFuture:        "Task D",
 			},
 		},
+		"archived-todos-stay-completed": {
+			current:  BoardSummaryContent{Counts: StatusCounts{Open: 1, Done: 1, Archived: 2}},
+			previous: BoardSummaryContent{Counts: StatusCounts{Open: 1, Done: 3}},
+			want: ComparisonHints{
+				DoneDelta:          0,
+				OverdueTitles:      "none",
+				NearDeadlineTitles: "none",
+				NextUpOverdue:      "none",
+				NextUpDueSoon:      "none",
+				NextUpUpcoming:     "none",
+				NextUpFuture:       "none",
+			},
+		},
 		"empty-hints": {
 			current:  BoardSummaryContent{},
 			previous: BoardSummaryContent{},
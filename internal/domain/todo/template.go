@@ -0,0 +1,87 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxTemplateNameChars is the maximum number of characters allowed in a template name.
+	MaxTemplateNameChars = 60
+	// MaxTemplateItems caps the todos a template creates when applied.
+	MaxTemplateItems = 50
+	// MaxTemplateDueOffsetDays bounds the distance, in either direction, between an item due date and the anchor date.
+	MaxTemplateDueOffsetDays = 365
+)
+
+// TemplateItem is one todo created when a template is applied.
+type TemplateItem struct {
+	Title string
+	// DueOffsetDays is the number of days between the anchor date and the due date, e.g. -1 for the day before.
+	DueOffsetDays    int
+	EstimatedMinutes int
+}
+
+// DueDate returns the due date of the item for the anchor date.
+func (i TemplateItem) DueDate(anchor time.Time) time.Time {
+	return time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, i.DueOffsetDays)
+}
+
+// Template is a named, reusable list of todos, such as "Trip packing", created together from an anchor date.
+type Template struct {
+	ID        uuid.UUID
+	Name      string
+	Items     []TemplateItem
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks if the template has valid fields.
+func (t Template) Validate() error {
+	name := strings.TrimSpace(t.Name)
+	if name == "" {
+		return core.NewValidationErr("name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > MaxTemplateNameChars {
+		return core.NewValidationErr(fmt.Sprintf("name cannot exceed %d characters", MaxTemplateNameChars))
+	}
+	if len(t.Items) == 0 || len(t.Items) > MaxTemplateItems {
+		return core.NewValidationErr(fmt.Sprintf("templates must have between 1 and %d items", MaxTemplateItems))
+	}
+	for i, item := range t.Items {
+		if len(item.Title) < 3 || len(item.Title) > 200 {
+			return core.NewValidationErr(fmt.Sprintf("item %d: title must be between 3 and 200 characters", i+1))
+		}
+		if item.DueOffsetDays < -MaxTemplateDueOffsetDays || item.DueOffsetDays > MaxTemplateDueOffsetDays {
+			return core.NewValidationErr(fmt.Sprintf(
+				"item %d: due_offset_days must be between -%d and %d", i+1, MaxTemplateDueOffsetDays, MaxTemplateDueOffsetDays,
+			))
+		}
+		if item.EstimatedMinutes < 0 || item.EstimatedMinutes > MaxEstimatedMinutes {
+			return core.NewValidationErr(fmt.Sprintf("item %d: estimated_minutes must be between 0 and %d", i+1, MaxEstimatedMinutes))
+		}
+	}
+	return nil
+}
+
+// TemplateRepository defines the interface for template persistence.
+type TemplateRepository interface {
+	// CreateTemplate stores a new template.
+	CreateTemplate(ctx context.Context, template Template) error
+	// UpdateTemplate replaces the name and items of an existing template.
+	UpdateTemplate(ctx context.Context, template Template) error
+	// DeleteTemplate deletes a template by its ID.
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	// GetTemplate retrieves a template by its ID.
+	GetTemplate(ctx context.Context, id uuid.UUID) (Template, bool, error)
+	// GetTemplateByName retrieves a template by its case-insensitive name.
+	GetTemplateByName(ctx context.Context, name string) (Template, bool, error)
+	// ListTemplates lists the templates ordered by name.
+	ListTemplates(ctx context.Context) ([]Template, error)
+}
@@ -0,0 +1,73 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate_Validate(t *testing.T) {
+	t.Parallel()
+
+	packing := []TemplateItem{{Title: "Pack passport", DueOffsetDays: -1}, {Title: "Charge phone", EstimatedMinutes: 5}}
+
+	tests := map[string]struct {
+		template Template
+		errMsg   string
+	}{
+		"valid": {
+			template: Template{Name: "Trip packing", Items: packing},
+		},
+		"empty-name": {
+			template: Template{Name: "  ", Items: packing},
+			errMsg:   "name cannot be empty",
+		},
+		"name-too-long": {
+			template: Template{Name: strings.Repeat("a", MaxTemplateNameChars+1), Items: packing},
+			errMsg:   "name cannot exceed 60 characters",
+		},
+		"no-items": {
+			template: Template{Name: "Trip packing"},
+			errMsg:   "templates must have between 1 and 50 items",
+		},
+		"too-many-items": {
+			template: Template{Name: "Trip packing", Items: make([]TemplateItem, MaxTemplateItems+1)},
+			errMsg:   "templates must have between 1 and 50 items",
+		},
+		"short-item-title": {
+			template: Template{Name: "Trip packing", Items: []TemplateItem{packing[0], {Title: "ok"}}},
+			errMsg:   "item 2: title must be between 3 and 200 characters",
+		},
+		"offset-out-of-range": {
+			template: Template{Name: "Trip packing", Items: []TemplateItem{{Title: "Pack passport", DueOffsetDays: -366}}},
+			errMsg:   "item 1: due_offset_days must be between -365 and 365",
+		},
+		"invalid-estimate": {
+			template: Template{Name: "Trip packing", Items: []TemplateItem{{Title: "Pack passport", EstimatedMinutes: -1}}},
+			errMsg:   "item 1: estimated_minutes must be between 0 and 1440",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.template.Validate()
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTemplateItem_DueDate(t *testing.T) {
+	t.Parallel()
+
+	anchor := time.Date(2026, 10, 16, 18, 45, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), TemplateItem{DueOffsetDays: -2}.DueDate(anchor))
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), TemplateItem{}.DueDate(anchor))
+}
@@ -0,0 +1,72 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// TimeEntry represents one work session logged against a todo.
+// A running timer is a TimeEntry without EndedAt.
+type TimeEntry struct {
+	ID        uuid.UUID
+	TodoID    uuid.UUID
+	StartedAt time.Time
+	EndedAt   *time.Time
+	CreatedAt time.Time
+}
+
+// Running returns true when the entry has not been stopped yet.
+func (e TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// Duration returns the logged duration, measuring running entries up to now.
+func (e TimeEntry) Duration(now time.Time) time.Duration {
+	end := now
+	if e.EndedAt != nil {
+		end = *e.EndedAt
+	}
+	if end.Before(e.StartedAt) {
+		return 0
+	}
+	return end.Sub(e.StartedAt)
+}
+
+// Validate checks that the time entry has a consistent time range.
+func (e TimeEntry) Validate() error {
+	if e.TodoID == uuid.Nil {
+		return core.NewValidationErr("todo_id cannot be empty")
+	}
+	if e.StartedAt.IsZero() {
+		return core.NewValidationErr("started_at cannot be empty")
+	}
+	if e.EndedAt != nil && !e.EndedAt.After(e.StartedAt) {
+		return core.NewValidationErr("ended_at must be after started_at")
+	}
+	return nil
+}
+
+// TimeReportItem aggregates the time logged against one todo.
+type TimeReportItem struct {
+	TodoID  uuid.UUID
+	Title   string
+	Total   time.Duration
+	Entries int
+}
+
+// TimeEntryRepository defines the interface for storing and aggregating todo time entries.
+type TimeEntryRepository interface {
+	// CreateTimeEntry stores a new time entry.
+	CreateTimeEntry(ctx context.Context, entry TimeEntry) error
+	// UpdateTimeEntry updates an existing time entry.
+	UpdateTimeEntry(ctx context.Context, entry TimeEntry) error
+	// GetRunningTimeEntry retrieves the running time entry of a todo, returning a boolean indicating if one exists.
+	GetRunningTimeEntry(ctx context.Context, todoID uuid.UUID) (TimeEntry, bool, error)
+	// SumLoggedTime returns the total logged time of finished entries for the provided todos.
+	SumLoggedTime(ctx context.Context, todoIDs []uuid.UUID) (map[uuid.UUID]time.Duration, error)
+	// TimeReport aggregates finished entries started in the [from, to) range per todo.
+	TimeReport(ctx context.Context, from, to time.Time) ([]TimeReportItem, error)
+}
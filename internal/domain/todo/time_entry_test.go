@@ -0,0 +1,87 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntry_Duration(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	now := start.Add(2 * time.Hour)
+
+	tests := map[string]struct {
+		entry   TimeEntry
+		want    time.Duration
+		running bool
+	}{
+		"running": {
+			entry:   TimeEntry{StartedAt: start},
+			want:    2 * time.Hour,
+			running: true,
+		},
+		"stopped": {
+			entry: TimeEntry{StartedAt: start, EndedAt: common.Ptr(start.Add(25 * time.Minute))},
+			want:  25 * time.Minute,
+		},
+		"ended-before-start": {
+			entry: TimeEntry{StartedAt: start, EndedAt: common.Ptr(start.Add(-time.Minute))},
+			want:  0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.entry.Duration(now))
+			assert.Equal(t, tt.running, tt.entry.Running())
+		})
+	}
+}
+
+func TestTimeEntry_Validate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		entry  TimeEntry
+		errMsg string
+	}{
+		"valid-running": {
+			entry: TimeEntry{TodoID: uuid.New(), StartedAt: start},
+		},
+		"valid-stopped": {
+			entry: TimeEntry{TodoID: uuid.New(), StartedAt: start, EndedAt: common.Ptr(start.Add(time.Minute))},
+		},
+		"missing-todo-id": {
+			entry:  TimeEntry{StartedAt: start},
+			errMsg: "todo_id cannot be empty",
+		},
+		"missing-started-at": {
+			entry:  TimeEntry{TodoID: uuid.New()},
+			errMsg: "started_at cannot be empty",
+		},
+		"ended-not-after-start": {
+			entry:  TimeEntry{TodoID: uuid.New(), StartedAt: start, EndedAt: common.Ptr(start)},
+			errMsg: "ended_at must be after started_at",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.entry.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
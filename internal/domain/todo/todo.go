@@ -1,12 +1,16 @@
 package todo
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/google/uuid"
 )
 
+// MaxEstimatedMinutes is the largest effort estimate accepted for a todo: one full day.
+const MaxEstimatedMinutes = 24 * 60
+
 // Status represents the status of a todo item.
 type Status string
 
@@ -27,13 +31,35 @@ func (s Status) Validate() error {
 
 // Todo represents a todo item in the system.
 type Todo struct {
-	ID        uuid.UUID
-	Title     string
-	DueDate   time.Time
-	Status    Status
-	Embedding []float64
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID      uuid.UUID
+	Title   string
+	DueDate time.Time
+	Status  Status
+	// EstimatedMinutes is the expected effort to complete the todo. Zero means no estimate.
+	EstimatedMinutes int
+	Embedding        []float64
+	// EmbeddingModel is the model that produced Embedding.
+	EmbeddingModel string
+	// SecondaryEmbedding is produced by the model being migrated to while an embedding model migration is in
+	// progress, so searches keep working with either model.
+	SecondaryEmbedding      []float64
+	SecondaryEmbeddingModel string
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	// ArchivedAt is set when the retention policy archives a completed todo. Archived todos are hidden
+	// from the default listings until they are restored.
+	ArchivedAt *time.Time
+	// CustomFields holds the values of the tenant-defined custom fields set on the todo.
+	CustomFields CustomFieldValues
+	// Location is where the todo is done. Nil means the todo has no location.
+	Location *Location
+	// Recurrence is the rule the todo repeats by. Nil means the todo does not recur.
+	Recurrence *Recurrence
+}
+
+// IsArchived reports whether the todo has been archived.
+func (t Todo) IsArchived() bool {
+	return t.ArchivedAt != nil
 }
 
 // Validate verifies the Todo fields satisfy domain constraints.
@@ -51,6 +77,19 @@ func (t Todo) Validate(now time.Time) error {
 	if err := t.Status.Validate(); err != nil {
 		return err
 	}
+	if t.EstimatedMinutes < 0 || t.EstimatedMinutes > MaxEstimatedMinutes {
+		return core.NewValidationErr(fmt.Sprintf("estimated_minutes must be between 0 and %d", MaxEstimatedMinutes))
+	}
+	if t.Location != nil {
+		if err := t.Location.Validate(); err != nil {
+			return err
+		}
+	}
+	if t.Recurrence != nil {
+		if err := t.Recurrence.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
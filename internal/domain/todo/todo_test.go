@@ -31,6 +31,41 @@ func TestTodo_Validate(t *testing.T) {
 			now:     now,
 			wantErr: false,
 		},
+		"valid-todo-with-estimate": {
+			todo:    Todo{Title: "Finish report", Status: Status_OPEN, DueDate: now.Add(24 * time.Hour), EstimatedMinutes: 90},
+			now:     now,
+			wantErr: false,
+		},
+		"negative-estimate": {
+			todo:    Todo{Title: "Finish report", Status: Status_OPEN, DueDate: now.Add(24 * time.Hour), EstimatedMinutes: -5},
+			now:     now,
+			wantErr: true,
+			errMsg:  "estimated_minutes must be between 0 and 1440",
+		},
+		"estimate-too-large": {
+			todo:    Todo{Title: "Finish report", Status: Status_OPEN, DueDate: now.Add(24 * time.Hour), EstimatedMinutes: 1441},
+			now:     now,
+			wantErr: true,
+			errMsg:  "estimated_minutes must be between 0 and 1440",
+		},
+		"invalid-location": {
+			todo: Todo{
+				Title: "Finish report", Status: Status_OPEN, DueDate: now.Add(24 * time.Hour),
+				Location: &Location{Name: "Office", Point: &GeoPoint{Latitude: 91}},
+			},
+			now:     now,
+			wantErr: true,
+			errMsg:  "latitude must be between -90 and 90",
+		},
+		"invalid-recurrence": {
+			todo: Todo{
+				Title: "Water plants", Status: Status_OPEN, DueDate: now.Add(24 * time.Hour),
+				Recurrence: &Recurrence{Frequency: RecurrenceFrequency_WEEKLY},
+			},
+			now:     now,
+			wantErr: true,
+			errMsg:  "recurrence interval must be between 1 and 365",
+		},
 		"empty-title": {
 			todo:    Todo{Title: "", Status: Status_OPEN, DueDate: now.Add(24 * time.Hour)},
 			now:     now,
@@ -121,6 +156,14 @@ func TestListOptions_WithOptions(t *testing.T) {
 				SortBy: &SortBy{Field: "dueDate", Direction: "DESC"},
 			},
 		},
+		"with-include-archived-only": {
+			opts: []ListOption{WithIncludeArchived()},
+			want: ListParams{IncludeArchived: true},
+		},
+		"with-custom-fields-only": {
+			opts: []ListOption{WithCustomFields(CustomFieldValues{"area": "home"})},
+			want: ListParams{CustomFields: CustomFieldValues{"area": "home"}},
+		},
 		"with-multiple-options": {
 			opts: []ListOption{
 				WithStatus(Status_OPEN),
@@ -0,0 +1,161 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// MaxViewNameChars is the maximum number of characters allowed in a view name.
+const MaxViewNameChars = 60
+
+var (
+	// builtinViewNamespace derives stable IDs for the built-in views from their names.
+	builtinViewNamespace = uuid.MustParse("6f0f4a3e-8f5e-4c1e-9a57-0c4a3c1d2b7e")
+	// minViewDueDate and maxViewDueDate close relative due ranges that only set one bound.
+	minViewDueDate = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxViewDueDate = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// ViewFilter is the todo list filter stored by a view.
+// Due dates are bounded either by absolute dates or by day offsets relative to the current day.
+type ViewFilter struct {
+	Status             *Status
+	SearchBySimilarity *string
+	SearchByTitle      *string
+	SortBy             *string
+	DueAfter           *time.Time
+	DueBefore          *time.Time
+	// DueFromDays and DueToDays bound the due date relative to today, e.g. 0 for today and 7 for a week ahead.
+	DueFromDays *int
+	DueToDays   *int
+	// Query is a query expression applied on top of the other fields, see ParseQuery.
+	Query *string
+}
+
+// Validate checks that the due date bounds of the filter are consistent.
+func (f ViewFilter) Validate() error {
+	if f.Status != nil {
+		if err := f.Status.Validate(); err != nil {
+			return err
+		}
+	}
+	if (f.DueAfter == nil) != (f.DueBefore == nil) {
+		return core.NewValidationErr("due_after and due_before must be provided together")
+	}
+	if f.DueAfter != nil && f.DueAfter.After(*f.DueBefore) {
+		return core.NewValidationErr("due_after must be less than or equal to due_before")
+	}
+	if f.DueAfter != nil && (f.DueFromDays != nil || f.DueToDays != nil) {
+		return core.NewValidationErr("absolute and relative due date bounds cannot be combined")
+	}
+	if f.DueFromDays != nil && f.DueToDays != nil && *f.DueFromDays > *f.DueToDays {
+		return core.NewValidationErr("due_from_days must be less than or equal to due_to_days")
+	}
+	if f.Query != nil {
+		if _, err := ParseQuery(*f.Query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resolve returns the filter with relative due date bounds converted to absolute dates for the day of now.
+func (f ViewFilter) Resolve(now time.Time) ViewFilter {
+	if f.DueFromDays == nil && f.DueToDays == nil {
+		return f
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	dueAfter, dueBefore := minViewDueDate, maxViewDueDate
+	if f.DueFromDays != nil {
+		dueAfter = today.AddDate(0, 0, *f.DueFromDays)
+	}
+	if f.DueToDays != nil {
+		dueBefore = today.AddDate(0, 0, *f.DueToDays)
+	}
+
+	resolved := f
+	resolved.DueAfter = &dueAfter
+	resolved.DueBefore = &dueBefore
+	resolved.DueFromDays = nil
+	resolved.DueToDays = nil
+	return resolved
+}
+
+// View is a named todo list filter, such as "Today" or a user saved "Work focus".
+// Built-in views are computed and cannot be changed; the others are stored.
+type View struct {
+	ID        uuid.UUID
+	Name      string
+	Filter    ViewFilter
+	BuiltIn   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks if the view has valid fields.
+func (v View) Validate() error {
+	name := strings.TrimSpace(v.Name)
+	if name == "" {
+		return core.NewValidationErr("name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > MaxViewNameChars {
+		return core.NewValidationErr(fmt.Sprintf("name cannot exceed %d characters", MaxViewNameChars))
+	}
+	return v.Filter.Validate()
+}
+
+// BuiltinViews returns the views every board has: open todos due up to today, in the next week, and later.
+func BuiltinViews() []View {
+	open := Status_OPEN
+	sortBy := "dueDateAsc"
+	days := func(d int) *int { return &d }
+
+	return []View{
+		newBuiltinView("Today", ViewFilter{Status: &open, SortBy: &sortBy, DueToDays: days(0)}),
+		newBuiltinView("Upcoming", ViewFilter{Status: &open, SortBy: &sortBy, DueFromDays: days(1), DueToDays: days(7)}),
+		newBuiltinView("Someday", ViewFilter{Status: &open, SortBy: &sortBy, DueFromDays: days(8)}),
+	}
+}
+
+// FindBuiltinView returns the built-in view with the given name or ID.
+func FindBuiltinView(name string, id uuid.UUID) (View, bool) {
+	for _, view := range BuiltinViews() {
+		if view.ID == id || strings.EqualFold(view.Name, strings.TrimSpace(name)) {
+			return view, true
+		}
+	}
+	return View{}, false
+}
+
+// newBuiltinView creates a built-in view with a stable ID.
+func newBuiltinView(name string, filter ViewFilter) View {
+	return View{
+		ID:      uuid.NewSHA1(builtinViewNamespace, []byte(strings.ToLower(name))),
+		Name:    name,
+		Filter:  filter,
+		BuiltIn: true,
+	}
+}
+
+// ViewRepository defines the interface for saved view persistence.
+type ViewRepository interface {
+	// CreateView stores a new view.
+	CreateView(ctx context.Context, view View) error
+	// UpdateView updates the name and filter of an existing view.
+	UpdateView(ctx context.Context, view View) error
+	// DeleteView deletes a view by its ID.
+	DeleteView(ctx context.Context, id uuid.UUID) error
+	// GetView retrieves a view by its ID.
+	GetView(ctx context.Context, id uuid.UUID) (View, bool, error)
+	// GetViewByName retrieves a view by its case-insensitive name.
+	GetViewByName(ctx context.Context, name string) (View, bool, error)
+	// ListViews lists the saved views ordered by name.
+	ListViews(ctx context.Context) ([]View, error)
+}
@@ -0,0 +1,150 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestView_Validate(t *testing.T) {
+	t.Parallel()
+
+	dueAfter := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	dueBefore := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		view   View
+		errMsg string
+	}{
+		"valid-absolute-range": {
+			view: View{Name: "Work focus", Filter: ViewFilter{DueAfter: &dueAfter, DueBefore: &dueBefore}},
+		},
+		"valid-relative-range": {
+			view: View{Name: "Next week", Filter: ViewFilter{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)}},
+		},
+		"empty-name": {
+			view:   View{Name: "  "},
+			errMsg: "name cannot be empty",
+		},
+		"name-too-long": {
+			view:   View{Name: strings.Repeat("a", MaxViewNameChars+1)},
+			errMsg: "name cannot exceed 60 characters",
+		},
+		"invalid-status": {
+			view:   View{Name: "Work", Filter: ViewFilter{Status: common.Ptr(Status("LATER"))}},
+			errMsg: "status must be either OPEN or DONE",
+		},
+		"half-absolute-range": {
+			view:   View{Name: "Work", Filter: ViewFilter{DueAfter: &dueAfter}},
+			errMsg: "due_after and due_before must be provided together",
+		},
+		"inverted-absolute-range": {
+			view:   View{Name: "Work", Filter: ViewFilter{DueAfter: &dueBefore, DueBefore: &dueAfter}},
+			errMsg: "due_after must be less than or equal to due_before",
+		},
+		"mixed-ranges": {
+			view:   View{Name: "Work", Filter: ViewFilter{DueAfter: &dueAfter, DueBefore: &dueBefore, DueToDays: common.Ptr(3)}},
+			errMsg: "absolute and relative due date bounds cannot be combined",
+		},
+		"inverted-relative-range": {
+			view:   View{Name: "Work", Filter: ViewFilter{DueFromDays: common.Ptr(7), DueToDays: common.Ptr(1)}},
+			errMsg: "due_from_days must be less than or equal to due_to_days",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.view.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestViewFilter_Resolve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)
+	dueAfter := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	dueBefore := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		filter        ViewFilter
+		wantDueAfter  *time.Time
+		wantDueBefore *time.Time
+	}{
+		"absolute-range-unchanged": {
+			filter:        ViewFilter{DueAfter: &dueAfter, DueBefore: &dueBefore},
+			wantDueAfter:  &dueAfter,
+			wantDueBefore: &dueBefore,
+		},
+		"no-range": {
+			filter: ViewFilter{},
+		},
+		"relative-range": {
+			filter:        ViewFilter{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)},
+			wantDueAfter:  common.Ptr(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)),
+			wantDueBefore: common.Ptr(time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)),
+		},
+		"up-to-today": {
+			filter:        ViewFilter{DueToDays: common.Ptr(0)},
+			wantDueAfter:  &minViewDueDate,
+			wantDueBefore: common.Ptr(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)),
+		},
+		"from-next-week": {
+			filter:        ViewFilter{DueFromDays: common.Ptr(8)},
+			wantDueAfter:  common.Ptr(time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)),
+			wantDueBefore: &maxViewDueDate,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.filter.Resolve(now)
+			assert.Equal(t, tt.wantDueAfter, got.DueAfter)
+			assert.Equal(t, tt.wantDueBefore, got.DueBefore)
+			assert.Nil(t, got.DueFromDays)
+			assert.Nil(t, got.DueToDays)
+		})
+	}
+}
+
+func TestFindBuiltinView(t *testing.T) {
+	t.Parallel()
+
+	today := BuiltinViews()[0]
+
+	tests := map[string]struct {
+		name     string
+		id       uuid.UUID
+		wantName string
+		wantOK   bool
+	}{
+		"by-name":         {name: " today ", wantName: "Today", wantOK: true},
+		"by-id":           {id: today.ID, wantName: "Today", wantOK: true},
+		"saved-view":      {name: "Work focus", id: uuid.New()},
+		"empty-lookup":    {},
+		"someday-by-name": {name: "Someday", wantName: "Someday", wantOK: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := FindBuiltinView(tt.name, tt.id)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantName, got.Name)
+			if ok {
+				assert.True(t, got.BuiltIn)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeeklyReviewDays is the number of days covered by a weekly review.
+const WeeklyReviewDays = 7
+
+// WeeklyReview is an assistant-written review of the todos planned for one week.
+// The report is stored as the assistant message of its own conversation, so the user can follow up on it in chat.
+type WeeklyReview struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	// PeriodStart and PeriodEnd are the first and last days of the review, both inclusive.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// Planned is the number of todos due in the period.
+	Planned int
+	// Completed holds the titles of the planned todos that are done.
+	Completed []string
+	// Slipped holds the titles of the planned todos that are still open.
+	Slipped     []string
+	Report      string
+	Model       string
+	GeneratedAt time.Time
+}
+
+// NewWeeklyReview creates the review of the week before now from the todos due in that week.
+func NewWeeklyReview(now time.Time, todos []Todo) WeeklyReview {
+	start, end := WeeklyReviewPeriod(now)
+	review := WeeklyReview{
+		ID:          uuid.New(),
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Planned:     len(todos),
+		Completed:   []string{},
+		Slipped:     []string{},
+		GeneratedAt: now,
+	}
+	for _, t := range todos {
+		if t.Status == Status_DONE {
+			review.Completed = append(review.Completed, t.Title)
+		} else {
+			review.Slipped = append(review.Slipped, t.Title)
+		}
+	}
+	return review
+}
+
+// WeeklyReviewPeriod returns the first and last days of the week ending the day before now.
+func WeeklyReviewPeriod(now time.Time) (time.Time, time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return today.AddDate(0, 0, -WeeklyReviewDays), today.AddDate(0, 0, -1)
+}
+
+// Title returns the title of the conversation holding the review.
+func (r WeeklyReview) Title() string {
+	return fmt.Sprintf("Weekly review: %s – %s", r.PeriodStart.Format("Jan 2"), r.PeriodEnd.Format("Jan 2, 2006"))
+}
+
+// WeeklyReviewRepository defines the interface for storing weekly reviews.
+type WeeklyReviewRepository interface {
+	// CreateWeeklyReview stores a new weekly review.
+	CreateWeeklyReview(ctx context.Context, review WeeklyReview) error
+	// GetWeeklyReviewByPeriod retrieves the review starting on the given day, with a boolean indicating if it was found.
+	GetWeeklyReviewByPeriod(ctx context.Context, periodStart time.Time) (WeeklyReview, bool, error)
+	// ListWeeklyReviews returns the reviews with pagination support, most recent period first.
+	ListWeeklyReviews(ctx context.Context, page int, pageSize int) ([]WeeklyReview, bool, error)
+}
+
+// WeeklyReviewNotifier delivers weekly review notifications outside of a chat stream.
+type WeeklyReviewNotifier interface {
+	// NotifyWeeklyReviewReady notifies the user that a new weekly review is available.
+	NotifyWeeklyReviewReady(ctx context.Context, review WeeklyReview) error
+}
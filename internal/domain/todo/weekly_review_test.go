@@ -0,0 +1,59 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeeklyReviewPeriod(t *testing.T) {
+	t.Parallel()
+
+	start, end := WeeklyReviewPeriod(time.Date(2026, 3, 9, 9, 30, 0, 0, time.UTC))
+
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestNewWeeklyReview(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		todos             []Todo
+		expectedPlanned   int
+		expectedCompleted []string
+		expectedSlipped   []string
+	}{
+		"mixed": {
+			todos: []Todo{
+				{Title: "Write draft", Status: Status_DONE},
+				{Title: "Book flights", Status: Status_OPEN},
+				{Title: "Pay rent", Status: Status_DONE},
+			},
+			expectedPlanned:   3,
+			expectedCompleted: []string{"Write draft", "Pay rent"},
+			expectedSlipped:   []string{"Book flights"},
+		},
+		"nothing-planned": {
+			expectedCompleted: []string{},
+			expectedSlipped:   []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			review := NewWeeklyReview(now, tt.todos)
+			assert.Equal(t, tt.expectedPlanned, review.Planned)
+			assert.Equal(t, tt.expectedCompleted, review.Completed)
+			assert.Equal(t, tt.expectedSlipped, review.Slipped)
+			assert.Equal(t, now, review.GeneratedAt)
+			assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), review.PeriodStart)
+			assert.Equal(t, "Weekly review: Mar 2 – Mar 8, 2026", review.Title())
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package todo
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeekPlanDays is the number of days covered by a week plan, starting today.
+const WeekPlanDays = 7
+
+// DayWorkload holds the todos planned for one day and their estimated effort.
+type DayWorkload struct {
+	Date  time.Time
+	Count int
+	// Minutes is the sum of the estimated minutes of the planned todos.
+	Minutes int
+	// OverbookedMinutes is how far Minutes exceeds the plan capacity, or zero when the day fits.
+	OverbookedMinutes int
+}
+
+// PlannedTodo represents one todo assignment inside a week plan.
+type PlannedTodo struct {
+	Todo    Todo
+	DueDate time.Time
+}
+
+// Changed returns true when the planned due date differs from the current one.
+func (p PlannedTodo) Changed() bool {
+	return !sameDay(p.Todo.DueDate, p.DueDate)
+}
+
+// WeekPlan distributes open todos across the next WeekPlanDays days.
+type WeekPlan struct {
+	Start     time.Time
+	MaxPerDay int
+	// CapacityMinutes is the estimated effort that fits in one day. Zero disables the capacity check.
+	CapacityMinutes int
+	Items           []PlannedTodo
+}
+
+// NewWeekPlan builds a balanced week plan starting at the day of now.
+// Only open todos that are overdue or due inside the window are planned.
+// Proposed dates are honored when they fall between today and the todo's
+// current due date and the day still has capacity. Otherwise the todo is
+// placed on the latest day with capacity before its due date. A day has
+// capacity while it holds fewer than maxPerDay todos and, when capacityMinutes
+// is positive, the todo's estimate still fits in the day's remaining minutes.
+// A todo is never moved later than its current due date; when no day has
+// capacity the todo is kept on its deadline (or today when overdue), even if
+// that overbooks the day.
+func NewWeekPlan(todos []Todo, now time.Time, maxPerDay, capacityMinutes int, proposed map[uuid.UUID]time.Time) WeekPlan {
+	start := startOfDay(now)
+	end := start.AddDate(0, 0, WeekPlanDays-1)
+	plan := WeekPlan{
+		Start:           start,
+		MaxPerDay:       maxPerDay,
+		CapacityMinutes: capacityMinutes,
+	}
+
+	type candidate struct {
+		todo     Todo
+		deadline time.Time
+	}
+	candidates := make([]candidate, 0, len(todos))
+	for _, t := range todos {
+		if t.Status != Status_OPEN {
+			continue
+		}
+		deadline := startOfDay(t.DueDate)
+		if deadline.After(end) {
+			continue
+		}
+		if deadline.Before(start) {
+			deadline = start
+		}
+		candidates = append(candidates, candidate{todo: t, deadline: deadline})
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return a.deadline.Compare(b.deadline)
+	})
+
+	load := make([]int, WeekPlanDays)
+	minutes := make([]int, WeekPlanDays)
+	fits := func(idx int, t Todo) bool {
+		if load[idx] >= maxPerDay {
+			return false
+		}
+		return capacityMinutes <= 0 || minutes[idx]+t.EstimatedMinutes <= capacityMinutes
+	}
+	for _, c := range candidates {
+		deadlineIdx := dayIndex(start, c.deadline)
+		idx := -1
+		if p, ok := proposed[c.todo.ID]; ok {
+			pIdx := dayIndex(start, startOfDay(p))
+			if pIdx >= 0 && pIdx <= deadlineIdx && fits(pIdx, c.todo) {
+				idx = pIdx
+			}
+		}
+		if idx < 0 {
+			for i := deadlineIdx; i >= 0; i-- {
+				if fits(i, c.todo) {
+					idx = i
+					break
+				}
+			}
+		}
+		if idx < 0 {
+			idx = deadlineIdx
+		}
+		load[idx]++
+		minutes[idx] += c.todo.EstimatedMinutes
+		plan.Items = append(plan.Items, PlannedTodo{
+			Todo:    c.todo,
+			DueDate: start.AddDate(0, 0, idx),
+		})
+	}
+
+	return plan
+}
+
+// Changes returns the planned todos whose due date must be updated.
+func (p WeekPlan) Changes() []PlannedTodo {
+	changes := []PlannedTodo{}
+	for _, item := range p.Items {
+		if item.Changed() {
+			changes = append(changes, item)
+		}
+	}
+	return changes
+}
+
+// Workload returns the number of planned todos and their estimated effort for each day of the plan.
+func (p WeekPlan) Workload() []DayWorkload {
+	workload := make([]DayWorkload, WeekPlanDays)
+	for i := range workload {
+		workload[i].Date = p.Start.AddDate(0, 0, i)
+	}
+	for _, item := range p.Items {
+		if idx := dayIndex(p.Start, item.DueDate); idx >= 0 && idx < WeekPlanDays {
+			workload[idx].Count++
+			workload[idx].Minutes += item.Todo.EstimatedMinutes
+		}
+	}
+	if p.CapacityMinutes > 0 {
+		for i := range workload {
+			workload[i].OverbookedMinutes = max(0, workload[i].Minutes-p.CapacityMinutes)
+		}
+	}
+	return workload
+}
+
+// startOfDay truncates t to midnight in its own location.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// dayIndex returns the number of whole days between start and day.
+func dayIndex(start, day time.Time) int {
+	y, m, d := day.Date()
+	normalized := time.Date(y, m, d, 0, 0, 0, 0, start.Location())
+	return int(math.Round(normalized.Sub(start).Hours() / 24))
+}
+
+// sameDay reports whether a and b fall on the same calendar day.
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,148 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWeekPlan(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time {
+		return time.Date(2026, 3, 2+offset, 0, 0, 0, 0, time.UTC)
+	}
+	id1, id2, id3, id4 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := map[string]struct {
+		todos     []Todo
+		maxPerDay int
+		capacity  int
+		proposed  map[uuid.UUID]time.Time
+		expected  map[uuid.UUID]time.Time
+	}{
+		"proposed-dates-honored": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(3)},
+				{ID: id2, Status: Status_OPEN, DueDate: day(5)},
+			},
+			maxPerDay: 2,
+			proposed:  map[uuid.UUID]time.Time{id1: day(1), id2: day(4)},
+			expected:  map[uuid.UUID]time.Time{id1: day(1), id2: day(4)},
+		},
+		"proposal-after-due-date-is-ignored": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(2)},
+			},
+			maxPerDay: 2,
+			proposed:  map[uuid.UUID]time.Time{id1: day(6)},
+			expected:  map[uuid.UUID]time.Time{id1: day(2)},
+		},
+		"overflow-moves-earlier": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(3)},
+				{ID: id2, Status: Status_OPEN, DueDate: day(3)},
+				{ID: id3, Status: Status_OPEN, DueDate: day(3)},
+			},
+			maxPerDay: 1,
+			expected:  map[uuid.UUID]time.Time{id1: day(3), id2: day(2), id3: day(1)},
+		},
+		"overdue-goes-today-even-when-full": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(-2)},
+				{ID: id2, Status: Status_OPEN, DueDate: day(-1)},
+			},
+			maxPerDay: 1,
+			expected:  map[uuid.UUID]time.Time{id1: day(0), id2: day(0)},
+		},
+		"capacity-moves-long-todos-earlier": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(3), EstimatedMinutes: 240},
+				{ID: id2, Status: Status_OPEN, DueDate: day(3), EstimatedMinutes: 300},
+				{ID: id3, Status: Status_OPEN, DueDate: day(3), EstimatedMinutes: 30},
+			},
+			maxPerDay: 3,
+			capacity:  480,
+			expected:  map[uuid.UUID]time.Time{id1: day(3), id2: day(2), id3: day(3)},
+		},
+		"proposal-over-capacity-is-ignored": {
+			todos: []Todo{
+				{ID: id1, Status: Status_OPEN, DueDate: day(1), EstimatedMinutes: 400},
+				{ID: id2, Status: Status_OPEN, DueDate: day(2), EstimatedMinutes: 200},
+			},
+			maxPerDay: 3,
+			capacity:  480,
+			proposed:  map[uuid.UUID]time.Time{id1: day(1), id2: day(1)},
+			expected:  map[uuid.UUID]time.Time{id1: day(1), id2: day(2)},
+		},
+		"done-and-later-todos-skipped": {
+			todos: []Todo{
+				{ID: id1, Status: Status_DONE, DueDate: day(1)},
+				{ID: id2, Status: Status_OPEN, DueDate: day(10)},
+				{ID: id4, Status: Status_OPEN, DueDate: day(6)},
+			},
+			maxPerDay: 3,
+			expected:  map[uuid.UUID]time.Time{id4: day(6)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := NewWeekPlan(tt.todos, now, tt.maxPerDay, tt.capacity, tt.proposed)
+			assert.Equal(t, day(0), plan.Start)
+			got := map[uuid.UUID]time.Time{}
+			for _, item := range plan.Items {
+				got[item.Todo.ID] = item.DueDate
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestWeekPlan_ChangesAndWorkload(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	id1, id2 := uuid.New(), uuid.New()
+	plan := NewWeekPlan([]Todo{
+		{ID: id1, Status: Status_OPEN, DueDate: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{ID: id2, Status: Status_OPEN, DueDate: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)},
+	}, now, 1, 0, nil)
+
+	changes := plan.Changes()
+	assert.Len(t, changes, 1)
+	assert.Equal(t, id2, changes[0].Todo.ID)
+	assert.Equal(t, time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), changes[0].DueDate)
+
+	workload := plan.Workload()
+	assert.Len(t, workload, WeekPlanDays)
+	counts := make([]int, 0, len(workload))
+	for _, w := range workload {
+		counts = append(counts, w.Count)
+	}
+	assert.Equal(t, []int{0, 1, 1, 0, 0, 0, 0}, counts)
+}
+
+func TestWeekPlan_Workload_Capacity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+	plan := NewWeekPlan([]Todo{
+		{ID: uuid.New(), Status: Status_OPEN, DueDate: monday, EstimatedMinutes: 60},
+		{ID: uuid.New(), Status: Status_OPEN, DueDate: monday, EstimatedMinutes: 500},
+		{ID: uuid.New(), Status: Status_OPEN, DueDate: tuesday, EstimatedMinutes: 360},
+		{ID: uuid.New(), Status: Status_OPEN, DueDate: tuesday, EstimatedMinutes: 240},
+	}, now, 5, 480, nil)
+
+	workload := plan.Workload()
+	assert.Equal(t, DayWorkload{Date: monday, Count: 2, Minutes: 560, OverbookedMinutes: 80}, workload[0])
+	assert.Equal(t, DayWorkload{Date: tuesday, Count: 2, Minutes: 600, OverbookedMinutes: 120}, workload[1])
+	assert.Equal(t, DayWorkload{Date: tuesday.AddDate(0, 0, 1)}, workload[2])
+}
@@ -13,6 +13,90 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockSandbox creates a new instance of MockSandbox. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSandbox(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSandbox {
+	mock := &MockSandbox{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSandbox is an autogenerated mock type for the Sandbox type
+type MockSandbox struct {
+	mock.Mock
+}
+
+type MockSandbox_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSandbox) EXPECT() *MockSandbox_Expecter {
+	return &MockSandbox_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function for the type MockSandbox
+func (_mock *MockSandbox) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSandbox_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockSandbox_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *MockSandbox_Expecter) Run(ctx interface{}, fn interface{}) *MockSandbox_Run_Call {
+	return &MockSandbox_Run_Call{Call: _e.mock.On("Run", ctx, fn)}
+}
+
+func (_c *MockSandbox_Run_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *MockSandbox_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSandbox_Run_Call) Return(err error) *MockSandbox_Run_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSandbox_Run_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *MockSandbox_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockScope creates a new instance of MockScope. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockScope(t interface {
@@ -40,6 +124,52 @@ func (_m *MockScope) EXPECT() *MockScope_Expecter {
 	return &MockScope_Expecter{mock: &_m.Mock}
 }
 
+// Change provides a mock function for the type MockScope
+func (_mock *MockScope) Change() todo.ChangeRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Change")
+	}
+
+	var r0 todo.ChangeRepository
+	if returnFunc, ok := ret.Get(0).(func() todo.ChangeRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(todo.ChangeRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_Change_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Change'
+type MockScope_Change_Call struct {
+	*mock.Call
+}
+
+// Change is a helper method to define mock.On call
+func (_e *MockScope_Expecter) Change() *MockScope_Change_Call {
+	return &MockScope_Change_Call{Call: _e.mock.On("Change")}
+}
+
+func (_c *MockScope_Change_Call) Run(run func()) *MockScope_Change_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_Change_Call) Return(changeRepository todo.ChangeRepository) *MockScope_Change_Call {
+	_c.Call.Return(changeRepository)
+	return _c
+}
+
+func (_c *MockScope_Change_Call) RunAndReturn(run func() todo.ChangeRepository) *MockScope_Change_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChatMessage provides a mock function for the type MockScope
 func (_mock *MockScope) ChatMessage() assistant.ChatMessageRepository {
 	ret := _mock.Called()
@@ -86,6 +216,98 @@ func (_c *MockScope_ChatMessage_Call) RunAndReturn(run func() assistant.ChatMess
 	return _c
 }
 
+// Comment provides a mock function for the type MockScope
+func (_mock *MockScope) Comment() todo.CommentRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Comment")
+	}
+
+	var r0 todo.CommentRepository
+	if returnFunc, ok := ret.Get(0).(func() todo.CommentRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(todo.CommentRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_Comment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Comment'
+type MockScope_Comment_Call struct {
+	*mock.Call
+}
+
+// Comment is a helper method to define mock.On call
+func (_e *MockScope_Expecter) Comment() *MockScope_Comment_Call {
+	return &MockScope_Comment_Call{Call: _e.mock.On("Comment")}
+}
+
+func (_c *MockScope_Comment_Call) Run(run func()) *MockScope_Comment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_Comment_Call) Return(commentRepository todo.CommentRepository) *MockScope_Comment_Call {
+	_c.Call.Return(commentRepository)
+	return _c
+}
+
+func (_c *MockScope_Comment_Call) RunAndReturn(run func() todo.CommentRepository) *MockScope_Comment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ContentBlob provides a mock function for the type MockScope
+func (_mock *MockScope) ContentBlob() assistant.ContentBlobRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContentBlob")
+	}
+
+	var r0 assistant.ContentBlobRepository
+	if returnFunc, ok := ret.Get(0).(func() assistant.ContentBlobRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(assistant.ContentBlobRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_ContentBlob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContentBlob'
+type MockScope_ContentBlob_Call struct {
+	*mock.Call
+}
+
+// ContentBlob is a helper method to define mock.On call
+func (_e *MockScope_Expecter) ContentBlob() *MockScope_ContentBlob_Call {
+	return &MockScope_ContentBlob_Call{Call: _e.mock.On("ContentBlob")}
+}
+
+func (_c *MockScope_ContentBlob_Call) Run(run func()) *MockScope_ContentBlob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_ContentBlob_Call) Return(contentBlobRepository assistant.ContentBlobRepository) *MockScope_ContentBlob_Call {
+	_c.Call.Return(contentBlobRepository)
+	return _c
+}
+
+func (_c *MockScope_ContentBlob_Call) RunAndReturn(run func() assistant.ContentBlobRepository) *MockScope_ContentBlob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Conversation provides a mock function for the type MockScope
 func (_mock *MockScope) Conversation() assistant.ConversationRepository {
 	ret := _mock.Called()
@@ -132,6 +354,52 @@ func (_c *MockScope_Conversation_Call) RunAndReturn(run func() assistant.Convers
 	return _c
 }
 
+// ConversationSnapshot provides a mock function for the type MockScope
+func (_mock *MockScope) ConversationSnapshot() assistant.ConversationSnapshotRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConversationSnapshot")
+	}
+
+	var r0 assistant.ConversationSnapshotRepository
+	if returnFunc, ok := ret.Get(0).(func() assistant.ConversationSnapshotRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(assistant.ConversationSnapshotRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_ConversationSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConversationSnapshot'
+type MockScope_ConversationSnapshot_Call struct {
+	*mock.Call
+}
+
+// ConversationSnapshot is a helper method to define mock.On call
+func (_e *MockScope_Expecter) ConversationSnapshot() *MockScope_ConversationSnapshot_Call {
+	return &MockScope_ConversationSnapshot_Call{Call: _e.mock.On("ConversationSnapshot")}
+}
+
+func (_c *MockScope_ConversationSnapshot_Call) Run(run func()) *MockScope_ConversationSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_ConversationSnapshot_Call) Return(conversationSnapshotRepository assistant.ConversationSnapshotRepository) *MockScope_ConversationSnapshot_Call {
+	_c.Call.Return(conversationSnapshotRepository)
+	return _c
+}
+
+func (_c *MockScope_ConversationSnapshot_Call) RunAndReturn(run func() assistant.ConversationSnapshotRepository) *MockScope_ConversationSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConversationSummary provides a mock function for the type MockScope
 func (_mock *MockScope) ConversationSummary() assistant.ConversationSummaryRepository {
 	ret := _mock.Called()
@@ -178,6 +446,52 @@ func (_c *MockScope_ConversationSummary_Call) RunAndReturn(run func() assistant.
 	return _c
 }
 
+// CustomField provides a mock function for the type MockScope
+func (_mock *MockScope) CustomField() todo.CustomFieldRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CustomField")
+	}
+
+	var r0 todo.CustomFieldRepository
+	if returnFunc, ok := ret.Get(0).(func() todo.CustomFieldRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(todo.CustomFieldRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_CustomField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CustomField'
+type MockScope_CustomField_Call struct {
+	*mock.Call
+}
+
+// CustomField is a helper method to define mock.On call
+func (_e *MockScope_Expecter) CustomField() *MockScope_CustomField_Call {
+	return &MockScope_CustomField_Call{Call: _e.mock.On("CustomField")}
+}
+
+func (_c *MockScope_CustomField_Call) Run(run func()) *MockScope_CustomField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_CustomField_Call) Return(customFieldRepository todo.CustomFieldRepository) *MockScope_CustomField_Call {
+	_c.Call.Return(customFieldRepository)
+	return _c
+}
+
+func (_c *MockScope_CustomField_Call) RunAndReturn(run func() todo.CustomFieldRepository) *MockScope_CustomField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Outbox provides a mock function for the type MockScope
 func (_mock *MockScope) Outbox() outbox.Repository {
 	ret := _mock.Called()
@@ -224,6 +538,98 @@ func (_c *MockScope_Outbox_Call) RunAndReturn(run func() outbox.Repository) *Moc
 	return _c
 }
 
+// Project provides a mock function for the type MockScope
+func (_mock *MockScope) Project() todo.ProjectRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Project")
+	}
+
+	var r0 todo.ProjectRepository
+	if returnFunc, ok := ret.Get(0).(func() todo.ProjectRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(todo.ProjectRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_Project_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Project'
+type MockScope_Project_Call struct {
+	*mock.Call
+}
+
+// Project is a helper method to define mock.On call
+func (_e *MockScope_Expecter) Project() *MockScope_Project_Call {
+	return &MockScope_Project_Call{Call: _e.mock.On("Project")}
+}
+
+func (_c *MockScope_Project_Call) Run(run func()) *MockScope_Project_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_Project_Call) Return(projectRepository todo.ProjectRepository) *MockScope_Project_Call {
+	_c.Call.Return(projectRepository)
+	return _c
+}
+
+func (_c *MockScope_Project_Call) RunAndReturn(run func() todo.ProjectRepository) *MockScope_Project_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TimeEntry provides a mock function for the type MockScope
+func (_mock *MockScope) TimeEntry() todo.TimeEntryRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TimeEntry")
+	}
+
+	var r0 todo.TimeEntryRepository
+	if returnFunc, ok := ret.Get(0).(func() todo.TimeEntryRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(todo.TimeEntryRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_TimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TimeEntry'
+type MockScope_TimeEntry_Call struct {
+	*mock.Call
+}
+
+// TimeEntry is a helper method to define mock.On call
+func (_e *MockScope_Expecter) TimeEntry() *MockScope_TimeEntry_Call {
+	return &MockScope_TimeEntry_Call{Call: _e.mock.On("TimeEntry")}
+}
+
+func (_c *MockScope_TimeEntry_Call) Run(run func()) *MockScope_TimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_TimeEntry_Call) Return(timeEntryRepository todo.TimeEntryRepository) *MockScope_TimeEntry_Call {
+	_c.Call.Return(timeEntryRepository)
+	return _c
+}
+
+func (_c *MockScope_TimeEntry_Call) RunAndReturn(run func() todo.TimeEntryRepository) *MockScope_TimeEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Todo provides a mock function for the type MockScope
 func (_mock *MockScope) Todo() todo.Repository {
 	ret := _mock.Called()
@@ -270,6 +676,52 @@ func (_c *MockScope_Todo_Call) RunAndReturn(run func() todo.Repository) *MockSco
 	return _c
 }
 
+// WeeklyReview provides a mock function for the type MockScope
+func (_mock *MockScope) WeeklyReview() todo.WeeklyReviewRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WeeklyReview")
+	}
+
+	var r0 todo.WeeklyReviewRepository
+	if returnFunc, ok := ret.Get(0).(func() todo.WeeklyReviewRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(todo.WeeklyReviewRepository)
+		}
+	}
+	return r0
+}
+
+// MockScope_WeeklyReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WeeklyReview'
+type MockScope_WeeklyReview_Call struct {
+	*mock.Call
+}
+
+// WeeklyReview is a helper method to define mock.On call
+func (_e *MockScope_Expecter) WeeklyReview() *MockScope_WeeklyReview_Call {
+	return &MockScope_WeeklyReview_Call{Call: _e.mock.On("WeeklyReview")}
+}
+
+func (_c *MockScope_WeeklyReview_Call) Run(run func()) *MockScope_WeeklyReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScope_WeeklyReview_Call) Return(weeklyReviewRepository todo.WeeklyReviewRepository) *MockScope_WeeklyReview_Call {
+	_c.Call.Return(weeklyReviewRepository)
+	return _c
+}
+
+func (_c *MockScope_WeeklyReview_Call) RunAndReturn(run func() todo.WeeklyReviewRepository) *MockScope_WeeklyReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUnitOfWork creates a new instance of MockUnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUnitOfWork(t interface {
@@ -12,12 +12,28 @@ import (
 type Scope interface {
 	// Todo returns the todo repository for the current transaction scope.
 	Todo() todo.Repository
+	// TimeEntry returns the todo time entry repository for the current transaction scope.
+	TimeEntry() todo.TimeEntryRepository
+	// Comment returns the todo comment repository for the current transaction scope.
+	Comment() todo.CommentRepository
+	// Change returns the todo change log repository for the current transaction scope.
+	Change() todo.ChangeRepository
 	// Conversation returns the conversation repository for the current transaction scope.
 	Conversation() assistant.ConversationRepository
 	// ChatMessage returns the chat message repository for the current transaction scope.
 	ChatMessage() assistant.ChatMessageRepository
+	// ContentBlob returns the chat content blob repository for the current transaction scope.
+	ContentBlob() assistant.ContentBlobRepository
 	// ConversationSummary returns the conversation summary repository for the current transaction scope.
 	ConversationSummary() assistant.ConversationSummaryRepository
+	// ConversationSnapshot returns the conversation snapshot repository for the current transaction scope.
+	ConversationSnapshot() assistant.ConversationSnapshotRepository
+	// WeeklyReview returns the weekly review repository for the current transaction scope.
+	WeeklyReview() todo.WeeklyReviewRepository
+	// Project returns the project repository for the current transaction scope.
+	Project() todo.ProjectRepository
+	// CustomField returns the custom field definition repository for the current transaction scope.
+	CustomField() todo.CustomFieldRepository
 	// Outbox returns the outbox repository for the current transaction scope.
 	Outbox() outbox.Repository
 }
@@ -28,3 +44,27 @@ type UnitOfWork interface {
 	// Returning an error rolls the transaction back; returning nil commits it.
 	Execute(ctx context.Context, fn func(ctx context.Context, scope Scope) error) error
 }
+
+// Sandbox runs work whose changes are discarded, such as the preview of an assistant turn.
+type Sandbox interface {
+	// Run runs fn with a ctx in which every unit of work joins one transaction that is rolled back when fn
+	// returns, whatever fn returns. Units of work inside fn must not run concurrently.
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// sandboxKey is the context key marking work that runs inside a Sandbox.
+type sandboxKey struct{}
+
+// WithSandbox returns a copy of ctx marking the work as running inside a Sandbox.
+// It is called by Sandbox implementations.
+func WithSandbox(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sandboxKey{}, true)
+}
+
+// InSandbox reports whether the work of ctx runs inside a Sandbox, so its changes are discarded.
+// Work with side effects outside the unit of work, such as calling external services or notifying
+// other clients, must be skipped in a sandbox.
+func InSandbox(ctx context.Context) bool {
+	inSandbox, _ := ctx.Value(sandboxKey{}).(bool)
+	return inSandbox
+}
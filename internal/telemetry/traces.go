@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -15,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
 )
 
 const tracerName = "todoapp"
@@ -72,6 +74,11 @@ func Middleware(operation string) func(http.Handler) http.Handler {
 	}
 }
 
+// GRPCServerHandler returns a gRPC stats handler that instruments server calls with OpenTelemetry.
+func GRPCServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
+
 // getCallerName retrieves the name of the function at the specified stack depth.
 func getCallerName(skip int) string {
 	pc, _, _, ok := runtime.Caller(skip)
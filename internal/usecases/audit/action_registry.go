@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+)
+
+// ActionRegistry is an assistant.ActionRegistry recording the actions that may change data in the
+// audit log, whatever channel the chat came through. Read-only actions are not recorded.
+type ActionRegistry struct {
+	assistant.ActionRegistry
+	log Log
+}
+
+// NewActionRegistry creates an ActionRegistry recording the actions run by next.
+func NewActionRegistry(next assistant.ActionRegistry, log Log) ActionRegistry {
+	return ActionRegistry{ActionRegistry: next, log: log}
+}
+
+// Execute runs the action and records it unless it is read-only.
+func (r ActionRegistry) Execute(ctx context.Context, call assistant.ActionCall, messages []assistant.Message) assistant.Message {
+	result := r.ActionRegistry.Execute(ctx, call, messages)
+	if definition, ok := r.GetDefinition(ctx, call.Name); ok && definition.ReadOnly {
+		return result
+	}
+
+	entry := audit.Entry{
+		Source:      audit.SourceChat,
+		Operation:   call.Name,
+		PayloadHash: audit.HashPayload([]byte(call.Input)),
+		Result:      "ok",
+		Succeeded:   result.IsActionCallSuccess(),
+	}
+	if conversationID, ok := assistant.ConversationIDFromContext(ctx); ok {
+		entry.Target = "conversation:" + conversationID.String()
+	}
+	if !entry.Succeeded {
+		entry.Result = "failed"
+		if result.ActionError != nil {
+			entry.Result = *result.ActionError
+		}
+	}
+	r.log.Record(ctx, entry)
+	return result
+}
@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestActionRegistry_Execute(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174000")
+	call := assistant.ActionCall{ID: "call-1", Name: "create_todos", Input: `{"todos":[{"title":"Buy milk"}]}`}
+	succeeded := assistant.Message{Role: assistant.ChatRole_Tool, ActionCallID: &call.ID, Content: "created"}
+	failed := assistant.Message{Role: assistant.ChatRole_Tool, ActionCallID: &call.ID, ActionError: common.Ptr("invalid due date")}
+
+	tests := map[string]struct {
+		ctx        context.Context
+		definition assistant.ActionDefinition
+		found      bool
+		result     assistant.Message
+		expected   *audit.Entry
+	}{
+		"records-write-action": {
+			ctx:        assistant.WithConversationID(context.Background(), conversationID),
+			definition: assistant.ActionDefinition{Name: call.Name},
+			found:      true,
+			result:     succeeded,
+			expected: &audit.Entry{
+				Source:      audit.SourceChat,
+				Operation:   call.Name,
+				Target:      "conversation:" + conversationID.String(),
+				PayloadHash: audit.HashPayload([]byte(call.Input)),
+				Result:      "ok",
+				Succeeded:   true,
+			},
+		},
+		"records-failed-action": {
+			ctx:        context.Background(),
+			definition: assistant.ActionDefinition{Name: call.Name},
+			found:      true,
+			result:     failed,
+			expected: &audit.Entry{
+				Source:      audit.SourceChat,
+				Operation:   call.Name,
+				PayloadHash: audit.HashPayload([]byte(call.Input)),
+				Result:      "invalid due date",
+			},
+		},
+		"records-unknown-action": {
+			ctx:    context.Background(),
+			result: failed,
+			expected: &audit.Entry{
+				Source:      audit.SourceChat,
+				Operation:   call.Name,
+				PayloadHash: audit.HashPayload([]byte(call.Input)),
+				Result:      "invalid due date",
+			},
+		},
+		"skips-read-only-action": {
+			ctx:        context.Background(),
+			definition: assistant.ActionDefinition{Name: call.Name, ReadOnly: true},
+			found:      true,
+			result:     succeeded,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := assistant.NewMockActionRegistry(t)
+			auditLog := NewMockLog(t)
+
+			next.EXPECT().Execute(tt.ctx, call, mock.Anything).Return(tt.result)
+			next.EXPECT().GetDefinition(mock.Anything, call.Name).Return(tt.definition, tt.found)
+			if tt.expected != nil {
+				auditLog.EXPECT().Record(tt.ctx, *tt.expected).Return()
+			}
+
+			got := NewActionRegistry(next, auditLog).Execute(tt.ctx, call, nil)
+			assert.Equal(t, tt.result, got)
+		})
+	}
+}
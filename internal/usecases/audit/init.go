@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitLog initializes the Log use case and registers it in the dependency container.
+type InitLog struct {
+	Logger       *log.Logger              `resolve:""`
+	Repo         audit.Repository         `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	Retention    time.Duration            `config:"AUDIT_RETENTION" default:"8760h"`
+}
+
+// Initialize registers the Log use case in the dependency container.
+func (i InitLog) Initialize(ctx context.Context) (context.Context, error) {
+	// The locker is optional: deployables without the audit log purger do not register one.
+	locker, _ := depend.Resolve[core.Locker]()
+	depend.Register[Log](NewLogImpl(i.Logger, i.Repo, i.TimeProvider, locker, i.Retention))
+	return ctx, nil
+}
+
+// InitActionRegistry wraps the registered assistant.ActionRegistry so the actions run by the assistant
+// are recorded in the audit log. It must run after the action registry is composed.
+type InitActionRegistry struct {
+	Next assistant.ActionRegistry `resolve:""`
+	Log  Log                      `resolve:""`
+}
+
+// Initialize replaces the assistant.ActionRegistry in the dependency container.
+func (i InitActionRegistry) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[assistant.ActionRegistry](NewActionRegistry(i.Next, i.Log))
+	return ctx, nil
+}
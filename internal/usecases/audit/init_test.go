@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitLog_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitLog{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Log]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitActionRegistry_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitActionRegistry{Next: assistant.NewMockActionRegistry(t), Log: NewMockLog(t)}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[assistant.ActionRegistry]()
+	assert.NoError(t, err)
+	assert.IsType(t, ActionRegistry{}, registered)
+}
@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultExportLimit is the number of entries exported when the query sets no limit.
+	DefaultExportLimit = 1000
+	// MaxExportLimit caps the number of entries one export returns.
+	MaxExportLimit = 10000
+)
+
+// Log defines the interface for recording, exporting and purging the audit log.
+type Log interface {
+	// Record appends an entry for a mutation performed by the principal of ctx, unless the entry names
+	// its principal. Failures are logged rather than returned, since the mutation has already happened.
+	Record(ctx context.Context, entry audit.Entry)
+	// Export returns the entries of the tenant of ctx matching the query, in sequence order.
+	Export(ctx context.Context, query audit.Query) ([]audit.Entry, error)
+	// Purge removes the entries older than the retention period and returns how many were removed.
+	// It is a no-op when entries are retained forever.
+	Purge(ctx context.Context) (int64, error)
+}
+
+// LogImpl is the implementation of the Log use case.
+type LogImpl struct {
+	logger       *log.Logger
+	repo         audit.Repository
+	timeProvider core.CurrentTimeProvider
+	locker       core.Locker
+	retention    time.Duration
+}
+
+// NewLogImpl creates a new instance of LogImpl. A zero retention keeps the entries forever.
+// Purges run on one replica at a time when a locker is supplied.
+func NewLogImpl(logger *log.Logger, repo audit.Repository, tp core.CurrentTimeProvider, locker core.Locker, retention time.Duration) LogImpl {
+	return LogImpl{
+		logger:       logger,
+		repo:         repo,
+		timeProvider: tp,
+		locker:       locker,
+		retention:    retention,
+	}
+}
+
+// Record implements Log.
+func (l LogImpl) Record(ctx context.Context, entry audit.Entry) {
+	// The entry must be stored even when the caller went away after the mutation.
+	spanCtx, span := telemetry.StartSpan(context.WithoutCancel(ctx))
+	defer span.End()
+
+	if entry.Principal == "" {
+		principal := access.FromContext(ctx)
+		entry.Principal = principal.Name
+		entry.Role = principal.Role
+	}
+	entry.ID = uuid.New()
+	entry.OccurredAt = l.timeProvider.Now()
+
+	err := l.repo.AppendEntry(spanCtx, entry)
+	if telemetry.IsErrorRecorded(span, err) {
+		l.logger.Printf("Audit: failed to record %s %s by %s: %v", entry.Source, entry.Operation, entry.Principal, err)
+	}
+}
+
+// Export implements Log.
+func (l LogImpl) Export(ctx context.Context, query audit.Query) ([]audit.Entry, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	switch {
+	case query.Limit < 0 || query.Limit > MaxExportLimit:
+		return nil, core.NewValidationErr(fmt.Sprintf("limit must be between 1 and %d", MaxExportLimit))
+	case query.Limit == 0:
+		query.Limit = DefaultExportLimit
+	}
+	if query.AfterSequence < 0 {
+		return nil, core.NewValidationErr("after must not be negative")
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return nil, core.NewValidationErr("from must be before to")
+	}
+
+	entries, err := l.repo.ListEntries(spanCtx, query)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Purge implements Log.
+func (l LogImpl) Purge(ctx context.Context) (int64, error) {
+	if l.retention <= 0 {
+		return 0, nil
+	}
+
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if l.locker != nil {
+		unlock, locked, err := l.locker.TryLock(spanCtx, "purge_audit_log")
+		if telemetry.IsErrorRecorded(span, err) {
+			return 0, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		// Another replica is purging.
+		if !locked {
+			return 0, nil
+		}
+		defer unlock()
+	}
+
+	removed, err := l.repo.DeleteEntriesBefore(spanCtx, l.timeProvider.Now().Add(-l.retention))
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+	return removed, nil
+}
@@ -0,0 +1,204 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/audit"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var fixedNow = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+func TestLogImpl_Record(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ctx           context.Context
+		entry         audit.Entry
+		appendErr     error
+		wantPrincipal string
+		wantRole      access.Role
+		wantLogged    bool
+	}{
+		"principal-from-context": {
+			ctx:           access.NewContext(context.Background(), access.Principal{Name: "ops", Role: access.RoleMember}),
+			entry:         audit.Entry{Source: audit.SourceAPI, Operation: "POST /api/v1/todos", Result: "201", Succeeded: true},
+			wantPrincipal: "ops",
+			wantRole:      access.RoleMember,
+		},
+		"system-without-principal": {
+			ctx:           context.Background(),
+			entry:         audit.Entry{Source: audit.SourceChat, Operation: "create_todos", Result: "ok", Succeeded: true},
+			wantPrincipal: access.System.Name,
+			wantRole:      access.RoleAdmin,
+		},
+		"named-principal-kept": {
+			ctx:           context.Background(),
+			entry:         audit.Entry{Principal: audit.Anonymous, Source: audit.SourceAPI, Operation: "POST /api/v1/sessions/refresh", Result: "200", Succeeded: true},
+			wantPrincipal: audit.Anonymous,
+		},
+		"append-error-logged": {
+			ctx:           context.Background(),
+			entry:         audit.Entry{Source: audit.SourceAPI, Operation: "DELETE /api/v1/todos/{id}", Result: "204", Succeeded: true},
+			appendErr:     errors.New("db error"),
+			wantPrincipal: access.System.Name,
+			wantRole:      access.RoleAdmin,
+			wantLogged:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := audit.NewMockRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedNow)
+
+			var stored audit.Entry
+			repo.EXPECT().AppendEntry(mock.Anything, mock.Anything).
+				Run(func(_ context.Context, entry audit.Entry) { stored = entry }).
+				Return(tt.appendErr)
+
+			var logs bytes.Buffer
+			NewLogImpl(log.New(&logs, "", 0), repo, timeProvider, nil, 0).Record(tt.ctx, tt.entry)
+
+			assert.NotEqual(t, uuid.Nil, stored.ID)
+			assert.Equal(t, fixedNow, stored.OccurredAt)
+			assert.Equal(t, tt.wantPrincipal, stored.Principal)
+			assert.Equal(t, tt.wantRole, stored.Role)
+			assert.Equal(t, tt.entry.Operation, stored.Operation)
+			assert.Equal(t, tt.wantLogged, logs.Len() > 0)
+		})
+	}
+}
+
+func TestLogImpl_Export(t *testing.T) {
+	t.Parallel()
+
+	entries := []audit.Entry{{Sequence: 1, Principal: "ops"}}
+
+	tests := map[string]struct {
+		query         audit.Query
+		setupRepo     func(*audit.MockRepository)
+		expected      []audit.Entry
+		expectedError error
+	}{
+		"default-limit": {
+			query: audit.Query{},
+			setupRepo: func(repo *audit.MockRepository) {
+				repo.EXPECT().ListEntries(mock.Anything, audit.Query{Limit: DefaultExportLimit}).Return(entries, nil)
+			},
+			expected: entries,
+		},
+		"time-range": {
+			query: audit.Query{From: fixedNow.Add(-time.Hour), To: fixedNow, AfterSequence: 4, Limit: 10},
+			setupRepo: func(repo *audit.MockRepository) {
+				repo.EXPECT().ListEntries(mock.Anything, audit.Query{From: fixedNow.Add(-time.Hour), To: fixedNow, AfterSequence: 4, Limit: 10}).
+					Return(entries, nil)
+			},
+			expected: entries,
+		},
+		"limit-too-high": {
+			query:         audit.Query{Limit: MaxExportLimit + 1},
+			setupRepo:     func(*audit.MockRepository) {},
+			expectedError: core.NewValidationErr("limit must be between 1 and 10000"),
+		},
+		"negative-after": {
+			query:         audit.Query{AfterSequence: -1},
+			setupRepo:     func(*audit.MockRepository) {},
+			expectedError: core.NewValidationErr("after must not be negative"),
+		},
+		"inverted-range": {
+			query:         audit.Query{From: fixedNow, To: fixedNow.Add(-time.Hour)},
+			setupRepo:     func(*audit.MockRepository) {},
+			expectedError: core.NewValidationErr("from must be before to"),
+		},
+		"repository-error": {
+			query: audit.Query{Limit: 5},
+			setupRepo: func(repo *audit.MockRepository) {
+				repo.EXPECT().ListEntries(mock.Anything, audit.Query{Limit: 5}).Return(nil, errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := audit.NewMockRepository(t)
+			tt.setupRepo(repo)
+
+			got, err := NewLogImpl(log.Default(), repo, core.NewMockCurrentTimeProvider(t), nil, 0).Export(t.Context(), tt.query)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestLogImpl_Purge(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		retention     time.Duration
+		setupMocks    func(*audit.MockRepository, *core.MockCurrentTimeProvider, *core.MockLocker)
+		expected      int64
+		expectedError error
+	}{
+		"removes-expired": {
+			retention: 24 * time.Hour,
+			setupMocks: func(repo *audit.MockRepository, tp *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "purge_audit_log").Return(func() {}, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow)
+				repo.EXPECT().DeleteEntriesBefore(mock.Anything, fixedNow.Add(-24*time.Hour)).Return(3, nil)
+			},
+			expected: 3,
+		},
+		"kept-forever": {
+			retention:  0,
+			setupMocks: func(*audit.MockRepository, *core.MockCurrentTimeProvider, *core.MockLocker) {},
+		},
+		"purged-by-another-replica": {
+			retention: time.Hour,
+			setupMocks: func(_ *audit.MockRepository, _ *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "purge_audit_log").Return(nil, false, nil).Once()
+			},
+		},
+		"lock-error": {
+			retention: time.Hour,
+			setupMocks: func(_ *audit.MockRepository, _ *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "purge_audit_log").Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedError: fmt.Errorf("failed to acquire lock: %w", errors.New("db error")),
+		},
+		"repository-error": {
+			retention: time.Hour,
+			setupMocks: func(repo *audit.MockRepository, tp *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "purge_audit_log").Return(func() {}, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow)
+				repo.EXPECT().DeleteEntriesBefore(mock.Anything, fixedNow.Add(-time.Hour)).Return(0, errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := audit.NewMockRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			locker := core.NewMockLocker(t)
+			tt.setupMocks(repo, timeProvider, locker)
+
+			got, err := NewLogImpl(log.Default(), repo, timeProvider, locker, tt.retention).Purge(t.Context())
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -126,6 +126,9 @@ func (gs GenerateBoardSummaryImpl) generateBoardSummary(ctx context.Context) (to
 		Temperature: common.Ptr(1.2),
 		TopP:        common.Ptr(0.95),
 		Messages:    promptMessages,
+		ResponseSchema: assistant.NewTextResponseSchema(
+			"board_summary", "summary", "Board progress summary for the user.",
+		),
 	}
 
 	resp, err := gs.assistant.RunTurnSync(ctx, req)
@@ -133,7 +136,7 @@ func (gs GenerateBoardSummaryImpl) generateBoardSummary(ctx context.Context) (to
 		return todo.BoardSummary{}, false, err
 	}
 
-	new.ApplySummary(resp.Content)
+	new.ApplySummary(resp.TextField("summary"))
 
 	metrics.RecordLLMTokensUsed(ctx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
 
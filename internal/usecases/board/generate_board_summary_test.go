@@ -81,7 +81,9 @@ func TestGenerateBoardSummaryImpl_Execute(t *testing.T) {
 							req.Messages[0].Role == "system" &&
 							req.Messages[1].Role == "user" &&
 							strings.Contains(req.Messages[0].Content, "You are a helpful assistant that summarizes todo progress") &&
-							strings.Contains(req.Messages[1].Content, "Open: 2\n  Done: 1")
+							strings.Contains(req.Messages[1].Content, "Open: 2\n  Done: 1") &&
+							req.ResponseSchema != nil &&
+							req.ResponseSchema.Name == "board_summary"
 					}),
 				).Return(assistant.TurnResponse{Content: "You have 2 open todos, 1 overdue todo, and 1 completed todo."}, nil)
 
@@ -92,6 +94,47 @@ func TestGenerateBoardSummaryImpl_Execute(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"success-unwraps-constrained-json-response": {
+			setExpectations: func(
+				locker *core.MockLocker,
+				sr *todo.MockBoardSummaryRepository,
+				tp *core.MockCurrentTimeProvider,
+				assist *assistant.MockAssistant,
+			) {
+				locker.EXPECT().TryLock(mock.Anything, "generate_board_summary").
+					Return(func() {}, true, nil).
+					Once()
+
+				tp.EXPECT().Now().Return(fixedTime)
+
+				sr.EXPECT().CalculateSummaryContent(mock.Anything).
+					Return(
+						calculated,
+						nil,
+					)
+
+				sr.EXPECT().GetLatestSummary(mock.Anything).
+					Return(todo.BoardSummary{}, false, nil)
+
+				assist.EXPECT().RunTurnSync(
+					mock.Anything,
+					mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						return req.Model == "mistral" &&
+							len(req.Messages) == 2 &&
+							req.Messages[0].Role == "system" &&
+							req.Messages[1].Role == "user" &&
+							strings.Contains(req.Messages[0].Content, "You are a helpful assistant that summarizes todo progress") &&
+							strings.Contains(req.Messages[1].Content, "Open: 2\n  Done: 1")
+					}),
+				).Return(assistant.TurnResponse{Content: `{"summary": "You have 2 open todos, 1 overdue todo, and 1 completed todo."}`}, nil)
+
+				sr.EXPECT().StoreSummary(
+					mock.Anything,
+					boardSummary,
+				).Return(nil)
+			},
+			expectedErr: nil,
+		},
 		"llm-client-error": {
 			setExpectations: func(
 				locker *core.MockLocker,
@@ -0,0 +1,232 @@
+package board
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.yaml.in/yaml/v3"
+)
+
+// maxWeeklyReviewTodos caps the todos of one week passed to the review prompt.
+const maxWeeklyReviewTodos = 200
+
+// GenerateWeeklyReview is the use case interface for generating the weekly review of the todo board.
+type GenerateWeeklyReview interface {
+	Execute(ctx context.Context) error
+}
+
+// GenerateWeeklyReviewImpl is the implementation of the GenerateWeeklyReview use case.
+type GenerateWeeklyReviewImpl struct {
+	locker       core.Locker
+	todoRepo     todo.Repository
+	reviewRepo   todo.WeeklyReviewRepository
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+	assistant    assistant.Assistant
+	notifier     todo.WeeklyReviewNotifier
+	model        string
+}
+
+// NewGenerateWeeklyReviewImpl creates a new instance of GenerateWeeklyReviewImpl.
+func NewGenerateWeeklyReviewImpl(
+	locker core.Locker,
+	todoRepo todo.Repository,
+	reviewRepo todo.WeeklyReviewRepository,
+	uow transaction.UnitOfWork,
+	tp core.CurrentTimeProvider,
+	assistant assistant.Assistant,
+	notifier todo.WeeklyReviewNotifier,
+	m string,
+) GenerateWeeklyReviewImpl {
+	return GenerateWeeklyReviewImpl{
+		locker:       locker,
+		todoRepo:     todoRepo,
+		reviewRepo:   reviewRepo,
+		uow:          uow,
+		timeProvider: tp,
+		assistant:    assistant,
+		notifier:     notifier,
+		model:        m,
+	}
+}
+
+// Execute reviews the week before today: it compares the todos that were due with the ones completed,
+// asks the assistant for the report and suggestions, stores the report as a new conversation and
+// notifies the user. A week that already has a review is skipped.
+func (gr GenerateWeeklyReviewImpl) Execute(ctx context.Context) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	unlock, locked, err := gr.locker.TryLock(spanCtx, "generate_weekly_review")
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !locked {
+		return nil
+	}
+	defer unlock()
+
+	now := gr.timeProvider.Now()
+	periodStart, periodEnd := todo.WeeklyReviewPeriod(now)
+
+	_, found, err := gr.reviewRepo.GetWeeklyReviewByPeriod(spanCtx, periodStart)
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to get weekly review: %w", err)
+	}
+	if found {
+		return nil
+	}
+
+	planned, err := gr.listDue(spanCtx, periodStart, periodEnd)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	nextWeekStart := periodEnd.AddDate(0, 0, 1)
+	upcoming, err := gr.listDue(spanCtx, nextWeekStart, nextWeekStart.AddDate(0, 0, todo.WeeklyReviewDays-1), todo.WithStatus(todo.Status_OPEN))
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	review := todo.NewWeeklyReview(now, planned)
+	resp, err := gr.generateReport(spanCtx, review, upcoming)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	review.Report = strings.TrimSpace(resp.TextField("report"))
+	review.Model = gr.model
+
+	err = gr.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		conversation, err := scope.Conversation().CreateConversation(uowCtx, review.Title(), assistant.ConversationTitleSource_User)
+		if err != nil {
+			return err
+		}
+
+		message := assistant.NewBackgroundMessage(conversation.ID, review.Report, gr.model, now)
+		message.PromptTokens = resp.Usage.PromptTokens
+		message.CompletionTokens = resp.Usage.CompletionTokens
+		message.TotalTokens = resp.Usage.TotalTokens
+		if err := scope.ChatMessage().CreateChatMessages(uowCtx, []assistant.ChatMessage{message}); err != nil {
+			return err
+		}
+
+		// The review is posted without the user following along, so it is raised as an unread badge.
+		if err := scope.Outbox().CreateChatEvent(uowCtx, outbox.NewBackgroundChatMessageEvent(message)); err != nil {
+			return err
+		}
+
+		conversation.LastMessageAt = &now
+		conversation.UpdatedAt = now
+		if err := scope.Conversation().UpdateConversation(uowCtx, conversation); err != nil {
+			return err
+		}
+
+		review.ConversationID = conversation.ID
+		return scope.WeeklyReview().CreateWeeklyReview(uowCtx, review)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to store weekly review: %w", err)
+	}
+
+	if err := gr.notifier.NotifyWeeklyReviewReady(spanCtx, review); telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to notify weekly review: %w", err)
+	}
+
+	return nil
+}
+
+// listDue returns the todos due between from and to, both inclusive, ordered by due date.
+func (gr GenerateWeeklyReviewImpl) listDue(ctx context.Context, from, to time.Time, opts ...todo.ListOption) ([]todo.Todo, error) {
+	opts = append(opts, todo.WithDueDateRange(from, to), todo.WithSortBy("dueDateAsc"))
+	todos, _, err := gr.todoRepo.ListTodos(ctx, 1, maxWeeklyReviewTodos, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+	return todos, nil
+}
+
+// generateReport asks the assistant to write the review report.
+func (gr GenerateWeeklyReviewImpl) generateReport(ctx context.Context, review todo.WeeklyReview, upcoming []todo.Todo) (assistant.TurnResponse, error) {
+	promptMessages, err := buildWeeklyReviewPromptMessages(review, upcoming)
+	if err != nil {
+		return assistant.TurnResponse{}, fmt.Errorf("failed to build prompt: %w", err)
+	}
+
+	req := assistant.TurnRequest{
+		Model:       gr.model,
+		Stream:      false,
+		Temperature: common.Ptr(0.7),
+		TopP:        common.Ptr(0.95),
+		Messages:    promptMessages,
+		ResponseSchema: assistant.NewTextResponseSchema(
+			"weekly_review", "report", "Weekly review of completed and slipped todos with suggestions for next week.",
+		),
+	}
+
+	resp, err := gr.assistant.RunTurnSync(ctx, req)
+	if err != nil {
+		return assistant.TurnResponse{}, err
+	}
+
+	metrics.RecordLLMTokensUsed(ctx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+
+	return resp, nil
+}
+
+//go:embed prompts/weekly_review.yml
+var weeklyReviewPrompt embed.FS
+
+// buildWeeklyReviewPromptMessages constructs the LLM messages for the weekly review prompt.
+func buildWeeklyReviewPromptMessages(review todo.WeeklyReview, upcoming []todo.Todo) ([]assistant.Message, error) {
+	file, err := weeklyReviewPrompt.Open("prompts/weekly_review.yml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open weekly review prompt: %w", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	messages := []assistant.Message{}
+	err = yaml.NewDecoder(file).Decode(&messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode weekly review prompt: %w", err)
+	}
+
+	upcomingTitles := make([]string, len(upcoming))
+	for i, t := range upcoming {
+		upcomingTitles[i] = fmt.Sprintf("%s (due %s)", t.Title, t.DueDate.Format(time.DateOnly))
+	}
+
+	for i, msg := range messages {
+		if strings.Contains(msg.Content, "%[") {
+			msg.Content = fmt.Sprintf(
+				msg.Content,
+				review.PeriodStart.Format(time.DateOnly),
+				review.PeriodEnd.Format(time.DateOnly),
+				review.Planned,
+				joinTitles(review.Completed),
+				joinTitles(review.Slipped),
+				joinTitles(upcomingTitles),
+			)
+		}
+		messages[i] = msg
+	}
+
+	return messages, nil
+}
+
+// joinTitles formats titles as a prompt list.
+func joinTitles(titles []string) string {
+	if len(titles) == 0 {
+		return "none"
+	}
+	return strings.Join(titles, "; ")
+}
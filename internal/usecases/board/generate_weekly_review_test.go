@@ -0,0 +1,208 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGenerateWeeklyReviewImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+	periodStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	planned := []todo.Todo{
+		{Title: "Write draft", Status: todo.Status_DONE},
+		{Title: "Book flights", Status: todo.Status_OPEN},
+	}
+	upcoming := []todo.Todo{{Title: "Pay rent", Status: todo.Status_OPEN, DueDate: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)}}
+	report := "You finished 1 of 2 todos."
+
+	type mocks struct {
+		locker           *core.MockLocker
+		todoRepo         *todo.MockRepository
+		reviewRepo       *todo.MockWeeklyReviewRepository
+		uow              *transaction.MockUnitOfWork
+		scope            *transaction.MockScope
+		conversationRepo *assistant.MockConversationRepository
+		chatRepo         *assistant.MockChatMessageRepository
+		outbox           *outbox.MockRepository
+		assistant        *assistant.MockAssistant
+		notifier         *todo.MockWeeklyReviewNotifier
+	}
+
+	expectGeneration := func(m mocks) {
+		m.locker.EXPECT().TryLock(mock.Anything, "generate_weekly_review").Return(func() {}, true, nil).Once()
+		m.reviewRepo.EXPECT().GetWeeklyReviewByPeriod(mock.Anything, periodStart).Return(todo.WeeklyReview{}, false, nil).Once()
+		m.todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxWeeklyReviewTodos, mock.Anything, mock.Anything).
+			Return(planned, false, nil).Once()
+		m.todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxWeeklyReviewTodos, mock.Anything, mock.Anything, mock.Anything).
+			Return(upcoming, false, nil).Once()
+		m.assistant.EXPECT().RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+			return req.Model == "review-model" &&
+				len(req.Messages) == 2 &&
+				strings.Contains(req.Messages[1].Content, "REVIEWED WEEK: 2026-03-02 to 2026-03-08") &&
+				strings.Contains(req.Messages[1].Content, "COMPLETED: Write draft") &&
+				strings.Contains(req.Messages[1].Content, "SLIPPED (due in the reviewed week and still open): Book flights") &&
+				strings.Contains(req.Messages[1].Content, "DUE NEXT WEEK (open): Pay rent (due 2026-03-10)")
+		})).Return(assistant.TurnResponse{
+			Content: `{"report":"` + report + `"}`,
+			Usage:   assistant.Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		}, nil).Once()
+	}
+
+	expectStore := func(m mocks, storeErr error) {
+		m.uow.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+				return fn(ctx, m.scope)
+			}).
+			Once()
+		m.scope.EXPECT().Conversation().Return(m.conversationRepo)
+		m.scope.EXPECT().ChatMessage().Return(m.chatRepo).Once()
+		m.scope.EXPECT().WeeklyReview().Return(m.reviewRepo).Once()
+		m.scope.EXPECT().Outbox().Return(m.outbox).Once()
+		m.conversationRepo.EXPECT().
+			CreateConversation(mock.Anything, "Weekly review: Mar 2 – Mar 8, 2026", assistant.ConversationTitleSource_User).
+			Return(conversation, nil).Once()
+		m.chatRepo.EXPECT().CreateChatMessages(mock.Anything, mock.MatchedBy(func(messages []assistant.ChatMessage) bool {
+			return len(messages) == 1 &&
+				messages[0].ConversationID == conversation.ID &&
+				messages[0].ChatRole == assistant.ChatRole_Assistant &&
+				messages[0].Content == report &&
+				messages[0].MessageState == assistant.ChatMessageState_Completed &&
+				messages[0].TotalTokens == 15
+		})).Return(nil).Once()
+		m.outbox.EXPECT().CreateChatEvent(mock.Anything, mock.MatchedBy(func(e outbox.ChatMessageEvent) bool {
+			return e.Type == outbox.EventType_CHAT_MESSAGE_SENT &&
+				e.ConversationID == conversation.ID &&
+				e.ChatRole == assistant.ChatRole_Assistant &&
+				e.CreatedAt.Equal(now) &&
+				e.Background
+		})).Return(nil).Once()
+		m.conversationRepo.EXPECT().UpdateConversation(mock.Anything, mock.MatchedBy(func(c assistant.Conversation) bool {
+			return c.ID == conversation.ID && c.LastMessageAt != nil && c.LastMessageAt.Equal(now)
+		})).Return(nil).Once()
+		m.reviewRepo.EXPECT().CreateWeeklyReview(mock.Anything, mock.MatchedBy(func(r todo.WeeklyReview) bool {
+			return r.ConversationID == conversation.ID &&
+				r.PeriodStart.Equal(periodStart) &&
+				r.PeriodEnd.Equal(periodEnd) &&
+				r.Planned == 2 &&
+				r.Report == report &&
+				r.Model == "review-model"
+		})).Return(storeErr).Once()
+	}
+
+	tests := map[string]struct {
+		setExpectations func(m mocks)
+		expectedErr     string
+	}{
+		"success": {
+			setExpectations: func(m mocks) {
+				expectGeneration(m)
+				expectStore(m, nil)
+				m.notifier.EXPECT().NotifyWeeklyReviewReady(mock.Anything, mock.MatchedBy(func(r todo.WeeklyReview) bool {
+					return r.ConversationID == conversation.ID
+				})).Return(nil).Once()
+			},
+		},
+		"lock-not-acquired": {
+			setExpectations: func(m mocks) {
+				m.locker.EXPECT().TryLock(mock.Anything, "generate_weekly_review").Return(nil, false, nil).Once()
+			},
+		},
+		"lock-error": {
+			setExpectations: func(m mocks) {
+				m.locker.EXPECT().TryLock(mock.Anything, "generate_weekly_review").Return(nil, false, errors.New("lock error")).Once()
+			},
+			expectedErr: "failed to acquire lock: lock error",
+		},
+		"already-reviewed": {
+			setExpectations: func(m mocks) {
+				m.locker.EXPECT().TryLock(mock.Anything, "generate_weekly_review").Return(func() {}, true, nil).Once()
+				m.reviewRepo.EXPECT().GetWeeklyReviewByPeriod(mock.Anything, periodStart).Return(todo.WeeklyReview{}, true, nil).Once()
+			},
+		},
+		"list-error": {
+			setExpectations: func(m mocks) {
+				m.locker.EXPECT().TryLock(mock.Anything, "generate_weekly_review").Return(func() {}, true, nil).Once()
+				m.reviewRepo.EXPECT().GetWeeklyReviewByPeriod(mock.Anything, periodStart).Return(todo.WeeklyReview{}, false, nil).Once()
+				m.todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxWeeklyReviewTodos, mock.Anything, mock.Anything).
+					Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: "failed to list todos: db error",
+		},
+		"assistant-error": {
+			setExpectations: func(m mocks) {
+				m.locker.EXPECT().TryLock(mock.Anything, "generate_weekly_review").Return(func() {}, true, nil).Once()
+				m.reviewRepo.EXPECT().GetWeeklyReviewByPeriod(mock.Anything, periodStart).Return(todo.WeeklyReview{}, false, nil).Once()
+				m.todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxWeeklyReviewTodos, mock.Anything, mock.Anything).
+					Return(planned, false, nil).Once()
+				m.todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxWeeklyReviewTodos, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, false, nil).Once()
+				m.assistant.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{}, errors.New("llm error")).Once()
+			},
+			expectedErr: "llm error",
+		},
+		"store-error": {
+			setExpectations: func(m mocks) {
+				expectGeneration(m)
+				expectStore(m, errors.New("db error"))
+			},
+			expectedErr: "failed to store weekly review: db error",
+		},
+		"notify-error": {
+			setExpectations: func(m mocks) {
+				expectGeneration(m)
+				expectStore(m, nil)
+				m.notifier.EXPECT().NotifyWeeklyReviewReady(mock.Anything, mock.Anything).Return(errors.New("notify error")).Once()
+			},
+			expectedErr: "failed to notify weekly review: notify error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				locker:           core.NewMockLocker(t),
+				todoRepo:         todo.NewMockRepository(t),
+				reviewRepo:       todo.NewMockWeeklyReviewRepository(t),
+				uow:              transaction.NewMockUnitOfWork(t),
+				scope:            transaction.NewMockScope(t),
+				conversationRepo: assistant.NewMockConversationRepository(t),
+				chatRepo:         assistant.NewMockChatMessageRepository(t),
+				outbox:           outbox.NewMockRepository(t),
+				assistant:        assistant.NewMockAssistant(t),
+				notifier:         todo.NewMockWeeklyReviewNotifier(t),
+			}
+			tt.setExpectations(m)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			gr := NewGenerateWeeklyReviewImpl(
+				m.locker, m.todoRepo, m.reviewRepo, m.uow, timeProvider, m.assistant, m.notifier, "review-model",
+			)
+			err := gr.Execute(t.Context())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
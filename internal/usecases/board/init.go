@@ -6,6 +6,7 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/cleitonmarx/symbiont/depend"
 )
 
@@ -37,3 +38,34 @@ func (igbs InitGetBoardSummary) Initialize(ctx context.Context) (context.Context
 	depend.Register[GetBoardSummary](NewGetBoardSummaryImpl(igbs.SummaryRepo))
 	return ctx, nil
 }
+
+// InitGenerateWeeklyReview initializes the GenerateWeeklyReview use case.
+type InitGenerateWeeklyReview struct {
+	Locker       core.Locker                 `resolve:""`
+	TodoRepo     todo.Repository             `resolve:""`
+	ReviewRepo   todo.WeeklyReviewRepository `resolve:""`
+	Uow          transaction.UnitOfWork      `resolve:""`
+	TimeProvider core.CurrentTimeProvider    `resolve:""`
+	Assistant    assistant.Assistant         `resolve:""`
+	Notifier     todo.WeeklyReviewNotifier   `resolve:""`
+	Model        string                      `config:"LLM_SUMMARY_MODEL"`
+}
+
+// Initialize registers the GenerateWeeklyReview use case in the dependency container.
+func (igwr InitGenerateWeeklyReview) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[GenerateWeeklyReview](NewGenerateWeeklyReviewImpl(
+		igwr.Locker, igwr.TodoRepo, igwr.ReviewRepo, igwr.Uow, igwr.TimeProvider, igwr.Assistant, igwr.Notifier, igwr.Model,
+	))
+	return ctx, nil
+}
+
+// InitListWeeklyReviews initializes the ListWeeklyReviews use case.
+type InitListWeeklyReviews struct {
+	ReviewRepo todo.WeeklyReviewRepository `resolve:""`
+}
+
+// Initialize registers the ListWeeklyReviews use case in the dependency container.
+func (ilwr InitListWeeklyReviews) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ListWeeklyReviews](NewListWeeklyReviewsImpl(ilwr.ReviewRepo))
+	return ctx, nil
+}
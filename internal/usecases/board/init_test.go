@@ -35,3 +35,33 @@ func TestInitGetBoardSummary_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, ctx)
 }
+
+func TestInitGenerateWeeklyReview_Initialize(t *testing.T) {
+	t.Parallel()
+
+	igwr := InitGenerateWeeklyReview{}
+
+	ctx, err := igwr.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[GenerateWeeklyReview]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitListWeeklyReviews_Initialize(t *testing.T) {
+	t.Parallel()
+
+	ilwr := InitListWeeklyReviews{
+		ReviewRepo: todo.NewMockWeeklyReviewRepository(t),
+	}
+
+	ctx, err := ilwr.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[ListWeeklyReviews]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
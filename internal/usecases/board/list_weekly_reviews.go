@@ -0,0 +1,39 @@
+package board
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// ListWeeklyReviews is a use case interface for listing past weekly reviews.
+type ListWeeklyReviews interface {
+	// Query returns a paginated list of weekly reviews, most recent week first.
+	Query(ctx context.Context, page int, pageSize int) ([]todo.WeeklyReview, bool, error)
+}
+
+// ListWeeklyReviewsImpl is the implementation of the ListWeeklyReviews use case.
+type ListWeeklyReviewsImpl struct {
+	reviewRepo todo.WeeklyReviewRepository
+}
+
+// NewListWeeklyReviewsImpl creates a new instance of ListWeeklyReviewsImpl.
+func NewListWeeklyReviewsImpl(r todo.WeeklyReviewRepository) ListWeeklyReviewsImpl {
+	return ListWeeklyReviewsImpl{
+		reviewRepo: r,
+	}
+}
+
+// Query implements ListWeeklyReviews.
+func (lwr ListWeeklyReviewsImpl) Query(ctx context.Context, page int, pageSize int) ([]todo.WeeklyReview, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	reviews, hasMore, err := lwr.reviewRepo.ListWeeklyReviews(spanCtx, page, pageSize)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	return reviews, hasMore, nil
+}
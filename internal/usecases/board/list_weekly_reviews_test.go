@@ -0,0 +1,62 @@
+package board
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListWeeklyReviewsImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	reviews := []todo.WeeklyReview{{
+		ID:             uuid.MustParse("223e4567-e89b-12d3-a456-426614174000"),
+		ConversationID: uuid.MustParse("323e4567-e89b-12d3-a456-426614174000"),
+		PeriodStart:    time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:      time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		Planned:        1,
+		Completed:      []string{"Write draft"},
+		Slipped:        []string{},
+		Report:         "Great week.",
+	}}
+
+	tests := map[string]struct {
+		setExpectations func(reviewRepo *todo.MockWeeklyReviewRepository)
+		expected        []todo.WeeklyReview
+		expectedHasMore bool
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(reviewRepo *todo.MockWeeklyReviewRepository) {
+				reviewRepo.EXPECT().ListWeeklyReviews(mock.Anything, 2, 10).Return(reviews, true, nil).Once()
+			},
+			expected:        reviews,
+			expectedHasMore: true,
+		},
+		"repository-error": {
+			setExpectations: func(reviewRepo *todo.MockWeeklyReviewRepository) {
+				reviewRepo.EXPECT().ListWeeklyReviews(mock.Anything, 2, 10).Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reviewRepo := todo.NewMockWeeklyReviewRepository(t)
+			tt.setExpectations(reviewRepo)
+
+			got, hasMore, err := NewListWeeklyReviewsImpl(reviewRepo).Query(t.Context(), 2, 10)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, tt.expectedHasMore, hasMore)
+		})
+	}
+}
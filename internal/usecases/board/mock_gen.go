@@ -89,6 +89,84 @@ func (_c *MockGenerateBoardSummary_Execute_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// NewMockGenerateWeeklyReview creates a new instance of MockGenerateWeeklyReview. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateWeeklyReview(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateWeeklyReview {
+	mock := &MockGenerateWeeklyReview{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateWeeklyReview is an autogenerated mock type for the GenerateWeeklyReview type
+type MockGenerateWeeklyReview struct {
+	mock.Mock
+}
+
+type MockGenerateWeeklyReview_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateWeeklyReview) EXPECT() *MockGenerateWeeklyReview_Expecter {
+	return &MockGenerateWeeklyReview_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGenerateWeeklyReview
+func (_mock *MockGenerateWeeklyReview) Execute(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGenerateWeeklyReview_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGenerateWeeklyReview_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGenerateWeeklyReview_Expecter) Execute(ctx interface{}) *MockGenerateWeeklyReview_Execute_Call {
+	return &MockGenerateWeeklyReview_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockGenerateWeeklyReview_Execute_Call) Run(run func(ctx context.Context)) *MockGenerateWeeklyReview_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateWeeklyReview_Execute_Call) Return(err error) *MockGenerateWeeklyReview_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGenerateWeeklyReview_Execute_Call) RunAndReturn(run func(ctx context.Context) error) *MockGenerateWeeklyReview_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGetBoardSummary creates a new instance of MockGetBoardSummary. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGetBoardSummary(t interface {
@@ -175,3 +253,110 @@ func (_c *MockGetBoardSummary_Query_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockListWeeklyReviews creates a new instance of MockListWeeklyReviews. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListWeeklyReviews(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListWeeklyReviews {
+	mock := &MockListWeeklyReviews{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListWeeklyReviews is an autogenerated mock type for the ListWeeklyReviews type
+type MockListWeeklyReviews struct {
+	mock.Mock
+}
+
+type MockListWeeklyReviews_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListWeeklyReviews) EXPECT() *MockListWeeklyReviews_Expecter {
+	return &MockListWeeklyReviews_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockListWeeklyReviews
+func (_mock *MockListWeeklyReviews) Query(ctx context.Context, page int, pageSize int) ([]todo.WeeklyReview, bool, error) {
+	ret := _mock.Called(ctx, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []todo.WeeklyReview
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]todo.WeeklyReview, bool, error)); ok {
+		return returnFunc(ctx, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []todo.WeeklyReview); ok {
+		r0 = returnFunc(ctx, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.WeeklyReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) bool); ok {
+		r1 = returnFunc(ctx, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = returnFunc(ctx, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockListWeeklyReviews_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockListWeeklyReviews_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+func (_e *MockListWeeklyReviews_Expecter) Query(ctx interface{}, page interface{}, pageSize interface{}) *MockListWeeklyReviews_Query_Call {
+	return &MockListWeeklyReviews_Query_Call{Call: _e.mock.On("Query", ctx, page, pageSize)}
+}
+
+func (_c *MockListWeeklyReviews_Query_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockListWeeklyReviews_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListWeeklyReviews_Query_Call) Return(weeklyReviews []todo.WeeklyReview, b bool, err error) *MockListWeeklyReviews_Query_Call {
+	_c.Call.Return(weeklyReviews, b, err)
+	return _c
+}
+
+func (_c *MockListWeeklyReviews_Query_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int) ([]todo.WeeklyReview, bool, error)) *MockListWeeklyReviews_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,17 +2,22 @@ package chat
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/google/uuid"
-	"github.com/toon-format/toon-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ActionPipeline handles assistant-requested actions within an in-flight streamed turn.
@@ -32,6 +37,8 @@ type ActionPipelineImpl struct {
 	approvalDispatcher assistant.ActionApprovalDispatcher
 	transcriptWriter   ConversationTranscriptWriter
 	timeProvider       core.CurrentTimeProvider
+	changeRepo         todo.ChangeRepository
+	resultMemory       *actionResultMemory
 }
 
 // NewActionPipelineImpl creates an ActionPipelineImpl.
@@ -40,12 +47,15 @@ func NewActionPipelineImpl(
 	approvalDispatcher assistant.ActionApprovalDispatcher,
 	transcriptWriter ConversationTranscriptWriter,
 	timeProvider core.CurrentTimeProvider,
+	changeRepo todo.ChangeRepository,
 ) ActionPipelineImpl {
 	return ActionPipelineImpl{
 		actionRegistry:     actionRegistry,
 		approvalDispatcher: approvalDispatcher,
 		transcriptWriter:   transcriptWriter,
 		timeProvider:       timeProvider,
+		changeRepo:         changeRepo,
+		resultMemory:       newActionResultMemory(),
 	}
 }
 
@@ -63,6 +73,9 @@ func (p ActionPipelineImpl) Handle(
 		return false, nil
 	}
 	actionCall.Text = p.actionRegistry.StatusMessage(actionCall.Name)
+	if result, executed := state.ExecutedActionResult(actionCall.ID); executed {
+		return p.replayExecutedAction(spanCtx, actionCall, result, state), nil
+	}
 
 	conversation := state.Conversation()
 	assistantActionCallMsg := assistant.ChatMessage{
@@ -73,14 +86,23 @@ func (p ActionPipelineImpl) Handle(
 		ChatRole:       assistant.ChatRole_Assistant,
 		ActionCalls:    []assistant.ActionCall{actionCall},
 		Model:          state.Model(),
+		Seed:           state.Seed(),
 		MessageState:   assistant.ChatMessageState_Completed,
+		Experiment:     state.Experiment(),
 		CreatedAt:      p.timeProvider.Now(),
 	}
 	assistantActionCallMsg.UpdatedAt = assistantActionCallMsg.CreatedAt
-	if err := p.transcriptWriter.WriteMessage(spanCtx, conversation, assistantActionCallMsg); err != nil {
+	if err := writeTurnMessage(spanCtx, p.transcriptWriter, state, assistantActionCallMsg); err != nil {
 		return false, err
 	}
 
+	if decision, denied := p.denyForbiddenAction(spanCtx, actionCall); denied {
+		return p.handleBlockedAction(spanCtx, actionCall, state, onEvent, decision)
+	}
+	if decision, denied := p.denyUnsandboxedAction(spanCtx, actionCall); denied {
+		return p.handleBlockedAction(spanCtx, actionCall, state, onEvent, decision)
+	}
+
 	approvalDecision, blockedByApproval, approvalErr := p.requestApprovalIfRequired(
 		spanCtx,
 		actionCall,
@@ -100,7 +122,11 @@ func (p ActionPipelineImpl) Handle(
 	}
 
 	request := state.Request()
-	actionMessage := p.actionRegistry.Execute(spanCtx, actionCall, request.Messages)
+	actionCtx := assistant.WithConversationID(spanCtx, conversation.ID)
+	executedAt := time.Now()
+	actionMessage := p.executeAction(actionCtx, actionCall, state, request.Messages)
+	state.RecordActionLatency(actionCall, time.Since(executedAt))
+	state.RecordExecutedAction(actionCall.ID, actionMessage)
 	actionSucceeded := actionMessage.IsActionCallSuccess()
 	now := p.timeProvider.Now()
 	actionChatMsg := assistant.ChatMessage{
@@ -114,6 +140,7 @@ func (p ActionPipelineImpl) Handle(
 		Model:          state.Model(),
 		MessageState:   assistant.ChatMessageState_Completed,
 		ActionExecuted: common.Ptr(true),
+		Experiment:     state.Experiment(),
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -127,7 +154,7 @@ func (p ActionPipelineImpl) Handle(
 		actionChatMsg.ApprovalDecidedAt = common.Ptr(approvalDecision.DecidedAt)
 	}
 
-	if err := p.transcriptWriter.WriteMessage(spanCtx, conversation, actionChatMsg); err != nil {
+	if err := writeTurnMessage(spanCtx, p.transcriptWriter, state, actionChatMsg); err != nil {
 		return false, err
 	}
 
@@ -140,10 +167,12 @@ func (p ActionPipelineImpl) Handle(
 		ActionExecuted:  common.Ptr(true),
 		OutputPreview:   buildOutputPreview(actionMessage.Content),
 		OutputTruncated: isOutputPreviewTruncated(actionMessage.Content),
+		Result:          actionResultJSON(actionMessage.Content),
 	}
 	if !actionSucceeded {
 		actionCompleted.Error = resolveActionErrorMessage(actionMessage)
 	}
+	p.attachChangeSequences(spanCtx, conversation.ID, &actionCompleted)
 	if err := onEvent(spanCtx, assistant.EventType_ActionCompleted, actionCompleted); err != nil {
 		return false, err
 	}
@@ -189,6 +218,94 @@ func (p ActionPipelineImpl) Handle(
 	return true, nil
 }
 
+// executeAction runs the action, serving an identical read-only call from the conversation's action memory when
+// no todo changed since it was fetched. Running any other action forgets the memory, since it may change the data.
+// Actions run inside a transaction.Sandbox bypass the memory: their results include changes that are discarded.
+func (p ActionPipelineImpl) executeAction(
+	ctx context.Context,
+	actionCall assistant.ActionCall,
+	state TurnState,
+	conversationHistory []assistant.Message,
+) assistant.Message {
+	if transaction.InSandbox(ctx) {
+		return p.actionRegistry.Execute(ctx, actionCall, conversationHistory)
+	}
+
+	conversationID := state.Conversation().ID
+	signature, rememberable := rememberedActionSignature(actionCall)
+	if !rememberable || p.changeRepo == nil || p.resultMemory == nil {
+		if p.resultMemory != nil {
+			p.resultMemory.Forget(conversationID)
+		}
+		return p.actionRegistry.Execute(ctx, actionCall, conversationHistory)
+	}
+
+	// The sequence is read before the action runs, so a change made while it runs makes the result stale.
+	span := trace.SpanFromContext(ctx)
+	sequences, err := p.changeRepo.LatestSequences(ctx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return p.actionRegistry.Execute(ctx, actionCall, conversationHistory)
+	}
+
+	now := p.timeProvider.Now()
+	if remembered, found := p.resultMemory.Lookup(conversationID, state.TurnID(), signature, sequences.Global, now); found {
+		span.AddEvent("Action result served from memory", trace.WithAttributes(
+			attribute.String("action_call_id", actionCall.ID),
+			attribute.String("action_name", actionCall.Name),
+		))
+		remembered.ActionCallID = common.Ptr(actionCall.ID)
+		return remembered
+	}
+
+	actionMessage := p.actionRegistry.Execute(ctx, actionCall, conversationHistory)
+	if actionMessage.IsActionCallSuccess() {
+		p.resultMemory.Remember(conversationID, state.TurnID(), signature, actionMessage, sequences.Global, now)
+	}
+	return actionMessage
+}
+
+// replayExecutedAction answers a duplicate of an action call already executed in the turn, such as one
+// re-issued by the model after a stream retry, with the recorded result instead of repeating its side effects.
+// The original call and result are already in the transcript and were streamed to the client, so only the
+// request is extended for the model to continue.
+func (p ActionPipelineImpl) replayExecutedAction(
+	ctx context.Context,
+	actionCall assistant.ActionCall,
+	result assistant.Message,
+	state TurnState,
+) bool {
+	trace.SpanFromContext(ctx).AddEvent("Duplicate action call skipped", trace.WithAttributes(
+		attribute.String("action_call_id", actionCall.ID),
+		attribute.String("action_name", actionCall.Name),
+	))
+	state.AppendRequestMessages(
+		assistant.Message{
+			Role:        assistant.ChatRole_Assistant,
+			ActionCalls: []assistant.ActionCall{actionCall},
+		},
+		result,
+	)
+	return true
+}
+
+// attachChangeSequences stamps the completed action with the latest todo change sequences so clients
+// can reconcile optimistic updates and detect missed todo events.
+// Sequences are omitted when the change log is unavailable; the action outcome is not affected.
+func (p ActionPipelineImpl) attachChangeSequences(ctx context.Context, conversationID uuid.UUID, actionCompleted *assistant.ActionCompleted) {
+	if p.changeRepo == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	sequences, err := p.changeRepo.LatestSequences(ctx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return
+	}
+
+	actionCompleted.ChangeSequence = common.Ptr(sequences.Global)
+	actionCompleted.ConversationChangeSequence = common.Ptr(sequences.Conversation)
+}
+
 // handleBlockedAction persists and emits the synthetic tool result produced when approval blocks execution.
 func (p ActionPipelineImpl) handleBlockedAction(
 	ctx context.Context,
@@ -223,11 +340,12 @@ func (p ActionPipelineImpl) handleBlockedAction(
 		ApprovalDecisionReason: approvalDecision.Reason,
 		ApprovalDecidedAt:      common.Ptr(approvalDecision.DecidedAt),
 		ActionExecuted:         common.Ptr(false),
+		Experiment:             state.Experiment(),
 		CreatedAt:              now,
 		UpdatedAt:              now,
 	}
 
-	if err := p.transcriptWriter.WriteMessage(ctx, conversation, actionChatMsg); err != nil {
+	if err := writeTurnMessage(ctx, p.transcriptWriter, state, actionChatMsg); err != nil {
 		return false, err
 	}
 
@@ -241,6 +359,7 @@ func (p ActionPipelineImpl) handleBlockedAction(
 		ActionExecuted:  common.Ptr(false),
 		OutputPreview:   buildOutputPreview(actionContent),
 		OutputTruncated: isOutputPreviewTruncated(actionContent),
+		Result:          actionResultJSON(actionContent),
 	}
 	if err := onEvent(ctx, assistant.EventType_ActionCompleted, actionCompleted); err != nil {
 		return false, err
@@ -268,6 +387,14 @@ func resolveActionErrorMessage(message assistant.Message) *string {
 	return &message.Content
 }
 
+// actionResultJSON returns the action output when it is an ActionResult envelope, or nil otherwise.
+func actionResultJSON(content string) json.RawMessage {
+	if _, ok := assistant.ParseActionResult(content); !ok {
+		return nil
+	}
+	return json.RawMessage(strings.TrimSpace(content))
+}
+
 // approvalDecisionReason derives a human-readable explanation for an approval decision.
 func approvalDecisionReason(decision assistant.ActionApprovalDecision) string {
 	if decision.Reason != nil {
@@ -322,43 +449,79 @@ func approvalBlockedActionContent(
 		ActionCallID   string                              `json:"action_call_id"`
 		Executed       bool                                `json:"executed"`
 		Reason         string                              `json:"reason"`
-		Message        string                              `json:"message"`
 	}
 
-	payload := blockedPayload{
-		ApprovalStatus: status,
-		ActionName:     actionCall.Name,
-		ActionCallID:   actionCall.ID,
-		Executed:       false,
-		Reason:         reason,
-		Message:        "Action execution blocked by approval policy. Do not assume this action was executed.",
-	}
-
-	data, err := toon.Marshal(payload)
-	if err != nil {
-		return fmt.Sprintf(
-			"Action execution blocked by approval policy. action=%s action_call_id=%s approval_status=%s reason=%s",
-			actionCall.Name,
-			actionCall.ID,
-			status,
-			reason,
-		)
-	}
-	return string(data)
+	return assistant.ActionResult{
+		Status: assistant.ActionResultStatus_Error,
+		Data: blockedPayload{
+			ApprovalStatus: status,
+			ActionName:     actionCall.Name,
+			ActionCallID:   actionCall.ID,
+			Executed:       false,
+			Reason:         reason,
+		},
+		Error: &assistant.ActionResultError{
+			Code:    "action_blocked",
+			Details: "Action execution blocked by approval policy. Do not assume this action was executed.",
+		},
+	}.String()
+}
+
+// denyForbiddenAction rejects actions the principal of the turn may not run. Readonly principals, and tokens
+// without the todos:write scope, are only offered read-only actions, but the model may still request others.
+func (p ActionPipelineImpl) denyForbiddenAction(ctx context.Context, actionCall assistant.ActionCall) (assistant.ActionApprovalDecision, bool) {
+	principal := access.FromContext(ctx)
+	if principal.CanWrite() {
+		return assistant.ActionApprovalDecision{}, false
+	}
+
+	definition, found := p.actionRegistry.GetDefinition(ctx, actionCall.Name)
+	if found && definition.AllowedFor(principal) {
+		return assistant.ActionApprovalDecision{}, false
+	}
+	reason := fmt.Sprintf("%s role may only run read-only actions", principal.Role)
+	if principal.Role.CanWrite() {
+		reason = fmt.Sprintf("tokens without the %s scope may only run read-only actions", access.ScopeTodosWrite)
+	}
+	return assistant.ActionApprovalDecision{
+		ActionName: actionCall.Name,
+		Status:     assistant.ChatMessageApprovalStatus_AutoRejected,
+		Reason:     common.Ptr(reason),
+		DecidedAt:  p.timeProvider.Now(),
+	}, true
+}
+
+// denyUnsandboxedAction rejects, inside a transaction.Sandbox, the actions whose changes would not be discarded.
+func (p ActionPipelineImpl) denyUnsandboxedAction(ctx context.Context, actionCall assistant.ActionCall) (assistant.ActionApprovalDecision, bool) {
+	if !transaction.InSandbox(ctx) {
+		return assistant.ActionApprovalDecision{}, false
+	}
+
+	definition, found := p.actionRegistry.GetDefinition(ctx, actionCall.Name)
+	if found && definition.AllowedInSandbox() {
+		return assistant.ActionApprovalDecision{}, false
+	}
+	return assistant.ActionApprovalDecision{
+		ActionName: actionCall.Name,
+		Status:     assistant.ChatMessageApprovalStatus_AutoRejected,
+		Reason:     common.Ptr("previews do not run actions whose changes cannot be discarded"),
+		DecidedAt:  p.timeProvider.Now(),
+	}, true
 }
 
 // requestApprovalIfRequired emits approval events and waits for a decision when the action requires approval.
+// Actions run inside a transaction.Sandbox need no approval since their changes are discarded.
 func (p ActionPipelineImpl) requestApprovalIfRequired(
 	ctx context.Context,
 	actionCall assistant.ActionCall,
 	state TurnState,
 	onEvent assistant.EventCallback,
 ) (assistant.ActionApprovalDecision, bool, error) {
-	if p.approvalDispatcher == nil {
+	if p.approvalDispatcher == nil || transaction.InSandbox(ctx) {
 		return assistant.ActionApprovalDecision{}, false, nil
 	}
 
-	definition, found := p.actionRegistry.GetDefinition(actionCall.Name)
+	definition, found := p.actionRegistry.GetDefinition(ctx, actionCall.Name)
 	if !found || !definition.RequiresApproval() {
 		return assistant.ActionApprovalDecision{}, false, nil
 	}
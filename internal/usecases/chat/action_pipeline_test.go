@@ -2,12 +2,16 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,62 +21,243 @@ import (
 func TestActionPipeline_Handle_SuccessWithRenderer(t *testing.T) {
 	t.Parallel()
 
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		setupChangeRepo                    func(*todo.MockChangeRepository)
+		expectedChangeSequence             *int64
+		expectedConversationChangeSequence *int64
+	}{
+		"attaches-change-sequences": {
+			setupChangeRepo: func(m *todo.MockChangeRepository) {
+				m.EXPECT().LatestSequences(mock.Anything, conversationID).
+					Return(todo.ChangeSequences{Global: 42, Conversation: 3}, nil).
+					Once()
+			},
+			expectedChangeSequence:             common.Ptr(int64(42)),
+			expectedConversationChangeSequence: common.Ptr(int64(3)),
+		},
+		"omits-change-sequences-on-error": {
+			setupChangeRepo: func(m *todo.MockChangeRepository) {
+				m.EXPECT().LatestSequences(mock.Anything, conversationID).
+					Return(todo.ChangeSequences{}, errors.New("db error")).
+					Once()
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fixedTime := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
+			actionRegistry := assistant.NewMockActionRegistry(t)
+			renderer := assistant.NewMockActionResultRenderer(t)
+			transcriptWriter := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			changeRepo := todo.NewMockChangeRepository(t)
+			tt.setupChangeRepo(changeRepo)
+
+			actionRegistry.EXPECT().StatusMessage("list_todos").Return("Listing todos").Once()
+			actionRegistry.EXPECT().
+				Execute(mock.MatchedBy(func(ctx context.Context) bool {
+					id, ok := assistant.ConversationIDFromContext(ctx)
+					return ok && id == conversationID
+				}), assistant.ActionCall{
+					ID:    "call-1",
+					Name:  "list_todos",
+					Input: `{"page":1}`,
+					Text:  "Listing todos",
+				}, mock.Anything).
+				Return(assistant.Message{
+					Role:         assistant.ChatRole_Tool,
+					Content:      `{"status":"success","data":{"items":["a","b"]}}`,
+					ActionCallID: common.Ptr("call-1"),
+				}).
+				Once()
+			actionRegistry.EXPECT().
+				GetRenderer("list_todos").
+				Return(renderer, true).
+				Once()
+			renderer.EXPECT().
+				Render(
+					assistant.ActionCall{ID: "call-1", Name: "list_todos", Input: `{"page":1}`, Text: "Listing todos"},
+					assistant.Message{
+						Role:         assistant.ChatRole_Tool,
+						Content:      `{"status":"success","data":{"items":["a","b"]}}`,
+						ActionCallID: common.Ptr("call-1"),
+					},
+				).
+				Return(assistant.Message{Role: assistant.ChatRole_Assistant, Content: "Found 2 todos."}, true).
+				Once()
+
+			pipeline := NewActionPipelineImpl(
+				actionRegistry,
+				nil,
+				transcriptWriter,
+				timeProvider,
+				changeRepo,
+			)
+
+			state := NewTurnState(
+				assistant.Conversation{ID: conversationID},
+				false,
+				nil,
+				assistant.TurnRequest{
+					Model:    "test-model",
+					Messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "List todos"}},
+				},
+				7,
+				nil,
+			)
+
+			var persistedMessages []assistant.ChatMessage
+			timeProvider.EXPECT().Now().Return(fixedTime).Twice()
+			transcriptWriter.EXPECT().
+				WriteMessage(mock.Anything, state.Conversation(), mock.Anything).
+				Run(func(_ context.Context, _ assistant.Conversation, message assistant.ChatMessage) {
+					persistedMessages = append(persistedMessages, message)
+				}).
+				Return(nil).
+				Twice()
+
+			var eventTypes []assistant.EventType
+			var actionCompleted assistant.ActionCompleted
+			continueStreaming, err := pipeline.Handle(
+				t.Context(),
+				assistant.ActionCall{ID: "call-1", Name: "list_todos", Input: `{"page":1}`},
+				state,
+				func(_ context.Context, eventType assistant.EventType, data any) error {
+					eventTypes = append(eventTypes, eventType)
+					if completed, ok := data.(assistant.ActionCompleted); ok {
+						actionCompleted = completed
+					}
+					return nil
+				},
+			)
+
+			require.NoError(t, err)
+			assert.False(t, continueStreaming)
+			assert.Len(t, persistedMessages, 2)
+			assert.Equal(t, assistant.ChatRole_Assistant, persistedMessages[0].ChatRole)
+			assert.Equal(t, assistant.ChatRole_Tool, persistedMessages[1].ChatRole)
+			assert.Equal(t, []assistant.EventType{
+				assistant.EventType_ActionStarted,
+				assistant.EventType_ActionCompleted,
+				assistant.EventType_MessageDelta,
+			}, eventTypes)
+			assert.True(t, actionCompleted.Success)
+			assert.JSONEq(t, `{"status":"success","data":{"items":["a","b"]}}`, string(actionCompleted.Result))
+			assert.Equal(t, tt.expectedChangeSequence, actionCompleted.ChangeSequence)
+			assert.Equal(t, tt.expectedConversationChangeSequence, actionCompleted.ConversationChangeSequence)
+			assert.Equal(t, "Found 2 todos.", state.AssistantContent())
+			request := state.Request()
+			assert.Len(t, request.Messages, 3)
+			actionLatencies := state.Latency().Actions
+			require.Len(t, actionLatencies, 1)
+			assert.Equal(t, "call-1", actionLatencies[0].ActionCallID)
+			assert.Equal(t, "list_todos", actionLatencies[0].Name)
+		})
+	}
+}
+
+func TestActionPipeline_Handle_ReadonlyPrincipalForbiddenAction(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	tests := map[string]struct {
+		definition      assistant.ActionDefinition
+		definitionFound bool
+	}{
+		"write-action":   {definition: assistant.ActionDefinition{Name: "delete_todos"}, definitionFound: true},
+		"unknown-action": {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fixedTime := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
+			actionRegistry := assistant.NewMockActionRegistry(t)
+			transcriptWriter := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+
+			actionRegistry.EXPECT().StatusMessage("delete_todos").Return("Deleting todos").Once()
+			actionRegistry.EXPECT().GetDefinition(mock.Anything, "delete_todos").Return(tt.definition, tt.definitionFound).Once()
+			timeProvider.EXPECT().Now().Return(fixedTime).Times(3)
+
+			pipeline := NewActionPipelineImpl(actionRegistry, nil, transcriptWriter, timeProvider, nil)
+			state := NewTurnState(
+				assistant.Conversation{ID: conversationID},
+				false,
+				nil,
+				assistant.TurnRequest{Model: "test-model"},
+				7,
+				nil,
+			)
+
+			var persistedMessages []assistant.ChatMessage
+			transcriptWriter.EXPECT().
+				WriteMessage(mock.Anything, state.Conversation(), mock.Anything).
+				Run(func(_ context.Context, _ assistant.Conversation, message assistant.ChatMessage) {
+					persistedMessages = append(persistedMessages, message)
+				}).
+				Return(nil).
+				Twice()
+
+			var actionCompleted assistant.ActionCompleted
+			ctx := access.NewContext(t.Context(), access.Principal{Name: "viewer", Role: access.RoleReadonly})
+			continueStreaming, err := pipeline.Handle(
+				ctx,
+				assistant.ActionCall{ID: "call-1", Name: "delete_todos", Input: `{"todos":[]}`},
+				state,
+				func(_ context.Context, _ assistant.EventType, data any) error {
+					if completed, ok := data.(assistant.ActionCompleted); ok {
+						actionCompleted = completed
+					}
+					return nil
+				},
+			)
+
+			require.NoError(t, err)
+			assert.True(t, continueStreaming)
+			require.Len(t, persistedMessages, 2)
+			assert.Equal(t, common.Ptr(false), persistedMessages[1].ActionExecuted)
+			assert.Equal(t, common.Ptr("readonly role may only run read-only actions"), persistedMessages[1].ErrorMessage)
+			assert.False(t, actionCompleted.Success)
+			assert.Equal(t, common.Ptr(assistant.ChatMessageApprovalStatus_AutoRejected), actionCompleted.ApprovalStatus)
+			assert.Contains(t, string(actionCompleted.Result), `"code":"action_blocked"`)
+			assert.Empty(t, state.Latency().Actions)
+		})
+	}
+}
+
+func TestActionPipeline_Handle_UnsandboxedActionInSandbox(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
 	fixedTime := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
 	actionRegistry := assistant.NewMockActionRegistry(t)
-	renderer := assistant.NewMockActionResultRenderer(t)
 	transcriptWriter := NewMockConversationTranscriptWriter(t)
 	timeProvider := core.NewMockCurrentTimeProvider(t)
 
-	actionRegistry.EXPECT().StatusMessage("list_todos").Return("Listing todos").Once()
-	actionRegistry.EXPECT().
-		Execute(mock.Anything, assistant.ActionCall{
-			ID:    "call-1",
-			Name:  "list_todos",
-			Input: `{"page":1}`,
-			Text:  "Listing todos",
-		}, mock.Anything).
-		Return(assistant.Message{
-			Role:         assistant.ChatRole_Tool,
-			Content:      `{"items":["a","b"]}`,
-			ActionCallID: common.Ptr("call-1"),
-		}).
-		Once()
-	actionRegistry.EXPECT().
-		GetRenderer("list_todos").
-		Return(renderer, true).
-		Once()
-	renderer.EXPECT().
-		Render(
-			assistant.ActionCall{ID: "call-1", Name: "list_todos", Input: `{"page":1}`, Text: "Listing todos"},
-			assistant.Message{
-				Role:         assistant.ChatRole_Tool,
-				Content:      `{"items":["a","b"]}`,
-				ActionCallID: common.Ptr("call-1"),
-			},
-		).
-		Return(assistant.Message{Role: assistant.ChatRole_Assistant, Content: "Found 2 todos."}, true).
-		Once()
-
-	pipeline := NewActionPipelineImpl(
-		actionRegistry,
-		nil,
-		transcriptWriter,
-		timeProvider,
-	)
+	actionRegistry.EXPECT().StatusMessage("send_invoice").Return("Sending invoice").Once()
+	actionRegistry.EXPECT().GetDefinition(mock.Anything, "send_invoice").
+		Return(assistant.ActionDefinition{Name: "send_invoice"}, true).Once()
+	timeProvider.EXPECT().Now().Return(fixedTime).Times(3)
 
+	pipeline := NewActionPipelineImpl(actionRegistry, nil, transcriptWriter, timeProvider, nil)
 	state := NewTurnState(
-		assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		assistant.Conversation{ID: conversationID},
 		false,
 		nil,
-		assistant.TurnRequest{
-			Model:    "test-model",
-			Messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "List todos"}},
-		},
+		assistant.TurnRequest{Model: "test-model"},
 		7,
+		nil,
 	)
 
 	var persistedMessages []assistant.ChatMessage
-	timeProvider.EXPECT().Now().Return(fixedTime).Twice()
 	transcriptWriter.EXPECT().
 		WriteMessage(mock.Anything, state.Conversation(), mock.Anything).
 		Run(func(_ context.Context, _ assistant.Conversation, message assistant.ChatMessage) {
@@ -81,28 +266,186 @@ func TestActionPipeline_Handle_SuccessWithRenderer(t *testing.T) {
 		Return(nil).
 		Twice()
 
-	var eventTypes []assistant.EventType
 	continueStreaming, err := pipeline.Handle(
-		t.Context(),
-		assistant.ActionCall{ID: "call-1", Name: "list_todos", Input: `{"page":1}`},
+		transaction.WithSandbox(t.Context()),
+		assistant.ActionCall{ID: "call-1", Name: "send_invoice", Input: `{}`},
 		state,
-		func(_ context.Context, eventType assistant.EventType, _ any) error {
-			eventTypes = append(eventTypes, eventType)
-			return nil
-		},
+		func(context.Context, assistant.EventType, any) error { return nil },
 	)
 
 	require.NoError(t, err)
-	assert.False(t, continueStreaming)
-	assert.Len(t, persistedMessages, 2)
-	assert.Equal(t, assistant.ChatRole_Assistant, persistedMessages[0].ChatRole)
-	assert.Equal(t, assistant.ChatRole_Tool, persistedMessages[1].ChatRole)
+	assert.True(t, continueStreaming)
+	require.Len(t, persistedMessages, 2)
+	assert.Equal(t, common.Ptr(false), persistedMessages[1].ActionExecuted)
+	assert.Equal(t, common.Ptr("previews do not run actions whose changes cannot be discarded"), persistedMessages[1].ErrorMessage)
+}
+
+func TestActionPipeline_Handle_DuplicateActionCallID(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	fixedTime := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
+	actionCall := assistant.ActionCall{ID: "call-1", Name: "create_todos", Input: `{"todos":[{"title":"Buy milk"}]}`}
+	result := assistant.Message{
+		Role:         assistant.ChatRole_Tool,
+		Content:      `{"status":"success","data":{"created":1}}`,
+		ActionCallID: common.Ptr("call-1"),
+	}
+
+	actionRegistry := assistant.NewMockActionRegistry(t)
+	transcriptWriter := NewMockConversationTranscriptWriter(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	actionRegistry.EXPECT().StatusMessage("create_todos").Return("Creating todos").Twice()
+	actionRegistry.EXPECT().Execute(mock.Anything, mock.Anything, mock.Anything).Return(result).Once()
+	actionRegistry.EXPECT().GetRenderer("create_todos").Return(nil, false).Once()
+	timeProvider.EXPECT().Now().Return(fixedTime).Twice()
+	transcriptWriter.EXPECT().WriteMessage(mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	pipeline := NewActionPipelineImpl(actionRegistry, nil, transcriptWriter, timeProvider, nil)
+	state := NewTurnState(
+		assistant.Conversation{ID: conversationID},
+		false,
+		nil,
+		assistant.TurnRequest{Model: "test-model"},
+		7,
+		nil,
+	)
+
+	var eventTypes []assistant.EventType
+	onEvent := func(_ context.Context, eventType assistant.EventType, _ any) error {
+		eventTypes = append(eventTypes, eventType)
+		return nil
+	}
+
+	continueStreaming, err := pipeline.Handle(t.Context(), actionCall, state, onEvent)
+	require.NoError(t, err)
+	assert.True(t, continueStreaming)
+
+	continueStreaming, err = pipeline.Handle(t.Context(), actionCall, state, onEvent)
+	require.NoError(t, err)
+	assert.True(t, continueStreaming)
+
 	assert.Equal(t, []assistant.EventType{
 		assistant.EventType_ActionStarted,
 		assistant.EventType_ActionCompleted,
-		assistant.EventType_MessageDelta,
 	}, eventTypes)
-	assert.Equal(t, "Found 2 todos.", state.AssistantContent())
 	request := state.Request()
-	assert.Len(t, request.Messages, 3)
+	require.Len(t, request.Messages, 4)
+	assert.Equal(t, result.Content, request.Messages[3].Content)
+	assert.Equal(t, common.Ptr("call-1"), request.Messages[3].ActionCallID)
+}
+
+func TestActionPipeline_Handle_RemembersFetchResults(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000004")}
+	fixedTime := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
+	fetchCall := assistant.ActionCall{Name: "fetch_todos", Input: `{"page":1,"page_size":10}`}
+	result := assistant.Message{
+		Role:         assistant.ChatRole_Tool,
+		Content:      `{"status":"success","data":{"todos":[]}}`,
+		ActionCallID: common.Ptr("call-1"),
+	}
+
+	actionRegistry := assistant.NewMockActionRegistry(t)
+	transcriptWriter := NewMockConversationTranscriptWriter(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	changeRepo := todo.NewMockChangeRepository(t)
+
+	actionRegistry.EXPECT().StatusMessage(mock.Anything).Return("Working")
+	actionRegistry.EXPECT().GetRenderer(mock.Anything).Return(nil, false)
+	timeProvider.EXPECT().Now().Return(fixedTime)
+	transcriptWriter.EXPECT().WriteMessage(mock.Anything, conversation, mock.Anything).Return(nil)
+	changeRepo.EXPECT().LatestSequences(mock.Anything, conversation.ID).Return(todo.ChangeSequences{Global: 1}, nil).Times(7)
+	changeRepo.EXPECT().LatestSequences(mock.Anything, conversation.ID).Return(todo.ChangeSequences{Global: 2}, nil).Times(2)
+	actionRegistry.EXPECT().
+		Execute(mock.Anything, mock.MatchedBy(func(call assistant.ActionCall) bool { return call.Name == "fetch_todos" }), mock.Anything).
+		Return(result).
+		Times(3)
+	actionRegistry.EXPECT().
+		Execute(mock.Anything, mock.MatchedBy(func(call assistant.ActionCall) bool { return call.Name == "create_todos" }), mock.Anything).
+		Return(assistant.Message{Role: assistant.ChatRole_Tool, Content: `{"status":"success"}`, ActionCallID: common.Ptr("call-3")}).
+		Once()
+
+	pipeline := NewActionPipelineImpl(actionRegistry, nil, transcriptWriter, timeProvider, changeRepo)
+	newState := func() TurnState {
+		return NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 7, nil)
+	}
+	noEvents := func(context.Context, assistant.EventType, any) error { return nil }
+	handle := func(state TurnState, callID string, call assistant.ActionCall) {
+		call.ID = callID
+		_, err := pipeline.Handle(t.Context(), call, state, noEvents)
+		require.NoError(t, err)
+	}
+
+	// The first fetch runs and the identical fetch of the next turn is served from memory.
+	firstTurn, secondTurn := newState(), newState()
+	handle(firstTurn, "call-1", fetchCall)
+	handle(secondTurn, "call-2", fetchCall)
+	served := secondTurn.Request().Messages[1]
+	assert.Equal(t, result.Content, served.Content)
+	assert.Equal(t, common.Ptr("call-2"), served.ActionCallID)
+
+	// A write forgets the memory, so the following fetch runs again.
+	handle(secondTurn, "call-3", assistant.ActionCall{Name: "create_todos", Input: `{"todos":[]}`})
+	handle(secondTurn, "call-4", fetchCall)
+
+	// A change made outside the conversation makes the remembered result stale.
+	thirdTurn := newState()
+	handle(thirdTurn, "call-5", fetchCall)
+}
+
+func TestActionPipeline_Handle_SandboxBypassesActionMemory(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000004")}
+	fixedTime := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
+	fetchCall := assistant.ActionCall{Name: "fetch_todos", Input: `{"page":1,"page_size":10}`}
+
+	actionRegistry := assistant.NewMockActionRegistry(t)
+	transcriptWriter := NewMockConversationTranscriptWriter(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	changeRepo := todo.NewMockChangeRepository(t)
+
+	actionRegistry.EXPECT().StatusMessage(mock.Anything).Return("Working")
+	actionRegistry.EXPECT().GetRenderer(mock.Anything).Return(nil, false)
+	actionRegistry.EXPECT().GetDefinition(mock.Anything, "fetch_todos").
+		Return(assistant.ActionDefinition{Name: "fetch_todos", ReadOnly: true}, true).Once()
+	timeProvider.EXPECT().Now().Return(fixedTime)
+	transcriptWriter.EXPECT().WriteMessage(mock.Anything, conversation, mock.Anything).Return(nil)
+	changeRepo.EXPECT().LatestSequences(mock.Anything, conversation.ID).Return(todo.ChangeSequences{Global: 1}, nil)
+	// The sandboxed result includes a todo the sandbox discards, so the real fetch runs again.
+	actionRegistry.EXPECT().
+		Execute(mock.Anything, mock.Anything, mock.Anything).
+		Return(assistant.Message{
+			Role:         assistant.ChatRole_Tool,
+			Content:      `{"status":"success","data":{"todos":[{"title":"Discarded"}]}}`,
+			ActionCallID: common.Ptr("call-1"),
+		}).
+		Once()
+	actionRegistry.EXPECT().
+		Execute(mock.Anything, mock.Anything, mock.Anything).
+		Return(assistant.Message{
+			Role:         assistant.ChatRole_Tool,
+			Content:      `{"status":"success","data":{"todos":[]}}`,
+			ActionCallID: common.Ptr("call-2"),
+		}).
+		Once()
+
+	pipeline := NewActionPipelineImpl(actionRegistry, nil, transcriptWriter, timeProvider, changeRepo)
+	noEvents := func(context.Context, assistant.EventType, any) error { return nil }
+
+	previewCall := fetchCall
+	previewCall.ID = "call-1"
+	previewState := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 7, nil)
+	_, err := pipeline.Handle(transaction.WithSandbox(t.Context()), previewCall, previewState, noEvents)
+	require.NoError(t, err)
+
+	realCall := fetchCall
+	realCall.ID = "call-2"
+	realState := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 7, nil)
+	_, err = pipeline.Handle(t.Context(), realCall, realState, noEvents)
+	require.NoError(t, err)
+	assert.Equal(t, `{"status":"success","data":{"todos":[]}}`, realState.Request().Messages[1].Content)
 }
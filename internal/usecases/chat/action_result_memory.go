@@ -0,0 +1,157 @@
+package chat
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+)
+
+// actionMemoryTTL bounds how long an idle conversation keeps its remembered action results.
+const actionMemoryTTL = 10 * time.Minute
+
+// rememberedActions lists the read-only actions whose results can be served again while the todos are
+// unchanged, with the input fields that pull in data outside the todo change log and make a call unrememberable.
+var rememberedActions = map[string][]string{
+	"fetch_todos": {"include_logged_time", "include_comments"},
+}
+
+// rememberedActionResult is one action result kept for a conversation.
+type rememberedActionResult struct {
+	result         assistant.Message
+	turn           int
+	changeSequence int64
+}
+
+// conversationActionMemory holds the action results remembered for one conversation.
+type conversationActionMemory struct {
+	turnID  uuid.UUID
+	turn    int
+	results map[string]rememberedActionResult
+	usedAt  time.Time
+}
+
+// actionResultMemory remembers the results of read-only actions per conversation, so an identical call in the
+// same turn or the next one is served without running the action again. A result is only served while the
+// global todo change sequence it was fetched at is still the latest one.
+type actionResultMemory struct {
+	mu            sync.Mutex
+	conversations map[uuid.UUID]*conversationActionMemory
+}
+
+// newActionResultMemory creates an empty actionResultMemory.
+func newActionResultMemory() *actionResultMemory {
+	return &actionResultMemory{
+		conversations: make(map[uuid.UUID]*conversationActionMemory),
+	}
+}
+
+// Lookup returns the result remembered for the call signature when it was fetched in the given turn or the
+// previous one and no todo changed since.
+func (m *actionResultMemory) Lookup(
+	conversationID, turnID uuid.UUID,
+	signature string,
+	changeSequence int64,
+	now time.Time,
+) (assistant.Message, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	memory, exists := m.conversations[conversationID]
+	if !exists {
+		return assistant.Message{}, false
+	}
+	memory.observeTurn(turnID, now)
+
+	remembered, exists := memory.results[signature]
+	if !exists || remembered.changeSequence != changeSequence {
+		return assistant.Message{}, false
+	}
+	return remembered.result, true
+}
+
+// Remember stores the result of a call signature fetched at the given change sequence.
+func (m *actionResultMemory) Remember(
+	conversationID, turnID uuid.UUID,
+	signature string,
+	result assistant.Message,
+	changeSequence int64,
+	now time.Time,
+) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, memory := range m.conversations {
+		if now.Sub(memory.usedAt) > actionMemoryTTL {
+			delete(m.conversations, id)
+		}
+	}
+
+	memory, exists := m.conversations[conversationID]
+	if !exists {
+		memory = &conversationActionMemory{
+			turnID:  turnID,
+			results: make(map[string]rememberedActionResult),
+		}
+		m.conversations[conversationID] = memory
+	}
+	memory.observeTurn(turnID, now)
+	memory.results[signature] = rememberedActionResult{
+		result:         result,
+		turn:           memory.turn,
+		changeSequence: changeSequence,
+	}
+}
+
+// Forget drops every result remembered for the conversation.
+func (m *actionResultMemory) Forget(conversationID uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.conversations, conversationID)
+}
+
+// observeTurn moves the memory to the given turn and drops the results fetched before the previous turn.
+// Turns that run no action are not observed.
+func (c *conversationActionMemory) observeTurn(turnID uuid.UUID, now time.Time) {
+	c.usedAt = now
+	if turnID == c.turnID {
+		return
+	}
+
+	c.turnID = turnID
+	c.turn++
+	for signature, remembered := range c.results {
+		if remembered.turn < c.turn-1 {
+			delete(c.results, signature)
+		}
+	}
+}
+
+// rememberedActionSignature returns the signature identifying an action call in the memory, with the input
+// normalized so field order and spacing do not matter. It reports false for calls that cannot be remembered.
+func rememberedActionSignature(actionCall assistant.ActionCall) (string, bool) {
+	excludedFields, remembered := rememberedActions[actionCall.Name]
+	if !remembered {
+		return "", false
+	}
+
+	input := map[string]any{}
+	if actionCall.Input != "" {
+		if err := json.Unmarshal([]byte(actionCall.Input), &input); err != nil {
+			return "", false
+		}
+	}
+	for _, field := range excludedFields {
+		if value, set := input[field]; set && value != false {
+			return "", false
+		}
+	}
+
+	normalized, err := json.Marshal(input)
+	if err != nil {
+		return "", false
+	}
+	return actionCall.Name + ":" + string(normalized), true
+}
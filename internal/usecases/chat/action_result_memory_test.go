@@ -0,0 +1,127 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionResultMemory_Lookup(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.New()
+	firstTurn, secondTurn, thirdTurn := uuid.New(), uuid.New(), uuid.New()
+	now := time.Date(2026, 3, 14, 14, 0, 0, 0, time.UTC)
+	result := assistant.Message{Role: assistant.ChatRole_Tool, Content: `{"status":"success"}`}
+	signature := `fetch_todos:{"page":1}`
+
+	tests := map[string]struct {
+		lookup         func(m *actionResultMemory) (assistant.Message, bool)
+		expectedFound  bool
+		expectedResult assistant.Message
+	}{
+		"same-turn": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				return m.Lookup(conversationID, firstTurn, signature, 5, now)
+			},
+			expectedFound:  true,
+			expectedResult: result,
+		},
+		"next-turn": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				return m.Lookup(conversationID, secondTurn, signature, 5, now)
+			},
+			expectedFound:  true,
+			expectedResult: result,
+		},
+		"two-turns-later": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				m.Lookup(conversationID, secondTurn, signature, 5, now)
+				return m.Lookup(conversationID, thirdTurn, signature, 5, now)
+			},
+		},
+		"todos-changed": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				return m.Lookup(conversationID, firstTurn, signature, 6, now)
+			},
+		},
+		"other-signature": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				return m.Lookup(conversationID, firstTurn, `fetch_todos:{"page":2}`, 5, now)
+			},
+		},
+		"other-conversation": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				return m.Lookup(uuid.New(), firstTurn, signature, 5, now)
+			},
+		},
+		"forgotten": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				m.Forget(conversationID)
+				return m.Lookup(conversationID, firstTurn, signature, 5, now)
+			},
+		},
+		"expired": {
+			lookup: func(m *actionResultMemory) (assistant.Message, bool) {
+				m.Remember(uuid.New(), firstTurn, signature, result, 5, now.Add(actionMemoryTTL+time.Second))
+				return m.Lookup(conversationID, firstTurn, signature, 5, now)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			memory := newActionResultMemory()
+			memory.Remember(conversationID, firstTurn, signature, result, 5, now)
+
+			got, found := tt.lookup(memory)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expectedResult, got)
+		})
+	}
+}
+
+func TestRememberedActionSignature(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		actionCall        assistant.ActionCall
+		expectedSignature string
+		expectedOK        bool
+	}{
+		"normalizes-input": {
+			actionCall:        assistant.ActionCall{Name: "fetch_todos", Input: `{ "page_size": 10, "page": 1 }`},
+			expectedSignature: `fetch_todos:{"page":1,"page_size":10}`,
+			expectedOK:        true,
+		},
+		"excluded-field-unset": {
+			actionCall:        assistant.ActionCall{Name: "fetch_todos", Input: `{"page":1,"include_comments":false}`},
+			expectedSignature: `fetch_todos:{"include_comments":false,"page":1}`,
+			expectedOK:        true,
+		},
+		"excluded-field-set": {
+			actionCall: assistant.ActionCall{Name: "fetch_todos", Input: `{"page":1,"include_logged_time":true}`},
+		},
+		"invalid-input": {
+			actionCall: assistant.ActionCall{Name: "fetch_todos", Input: `{"page":`},
+		},
+		"other-action": {
+			actionCall: assistant.ActionCall{Name: "create_todos", Input: `{"todos":[]}`},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			signature, ok := rememberedActionSignature(tt.actionCall)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedSignature, signature)
+		})
+	}
+}
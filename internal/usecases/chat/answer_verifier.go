@@ -0,0 +1,210 @@
+package chat
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	// ANSWER_VERIFICATION_MAX_TOKENS is the maximum token budget for an answer correction.
+	ANSWER_VERIFICATION_MAX_TOKENS = 160
+	// ANSWER_VERIFICATION_TEMPERATURE keeps the verification deterministic.
+	ANSWER_VERIFICATION_TEMPERATURE = 0.0
+	// ANSWER_CORRECTION_PREFIX introduces the correction appended to the streamed answer.
+	ANSWER_CORRECTION_PREFIX = "\n\n**Correction:** "
+)
+
+//go:embed prompts/answer-verification.yml
+var answerVerificationPrompt embed.FS
+
+// VerifiedAction is the outcome of one action the answer is checked against.
+type VerifiedAction struct {
+	Name    string
+	Success bool
+	Error   string
+	Output  string
+}
+
+// AnswerVerifier checks a final assistant answer against the results of the actions run in its turn.
+type AnswerVerifier interface {
+	// Verify returns a correction when the answer contradicts the action results, or an empty string.
+	Verify(ctx context.Context, answer string, actions []VerifiedAction) (string, error)
+}
+
+// AnswerVerifierImpl implements AnswerVerifier with a single call to a cheap model.
+type AnswerVerifierImpl struct {
+	assistant assistant.Assistant
+	model     string
+}
+
+// NewAnswerVerifierImpl creates an AnswerVerifierImpl.
+func NewAnswerVerifierImpl(assistantClient assistant.Assistant, model string) AnswerVerifierImpl {
+	return AnswerVerifierImpl{
+		assistant: assistantClient,
+		model:     model,
+	}
+}
+
+// Verify implements AnswerVerifier. Answers of turns that ran no action are not checked.
+func (v AnswerVerifierImpl) Verify(ctx context.Context, answer string, actions []VerifiedAction) (string, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	answer = strings.TrimSpace(answer)
+	if answer == "" || len(actions) == 0 {
+		return "", nil
+	}
+
+	promptMessages, err := buildAnswerVerificationPrompt(answer, actions)
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", fmt.Errorf("failed to build answer verification prompt: %w", err)
+	}
+
+	resp, err := v.assistant.RunTurnSync(spanCtx, assistant.TurnRequest{
+		Model:       v.model,
+		Messages:    promptMessages,
+		Stream:      false,
+		MaxTokens:   common.Ptr(ANSWER_VERIFICATION_MAX_TOKENS),
+		Temperature: common.Ptr(ANSWER_VERIFICATION_TEMPERATURE),
+		ResponseSchema: assistant.NewTextResponseSchema(
+			"answer_verification", "correction", "Short correction of the answer, or an empty string when it agrees with the action results.",
+		),
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", fmt.Errorf("failed to verify answer: %w", err)
+	}
+	metrics.RecordLLMTokensUsed(spanCtx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+
+	correction := strings.TrimSpace(resp.TextField("correction"))
+	span.SetAttributes(attribute.Bool("answer_corrected", correction != ""))
+	return correction, nil
+}
+
+// buildAnswerVerificationPrompt loads the prompt template and injects the action results and the answer.
+func buildAnswerVerificationPrompt(answer string, actions []VerifiedAction) ([]assistant.Message, error) {
+	file, err := answerVerificationPrompt.Open("prompts/answer-verification.yml")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() //nolint:errcheck
+
+	prompt := []assistant.Message{}
+	if err := yaml.NewDecoder(file).Decode(&prompt); err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(actions))
+	for _, action := range actions {
+		switch {
+		case action.Success:
+			lines = append(lines, fmt.Sprintf("- %s: success; output: %s", action.Name, action.Output))
+		case action.Error != "":
+			lines = append(lines, fmt.Sprintf("- %s: failed; error: %s", action.Name, action.Error))
+		default:
+			lines = append(lines, fmt.Sprintf("- %s: failed; output: %s", action.Name, action.Output))
+		}
+	}
+	for i, msg := range prompt {
+		if strings.Contains(msg.Content, "%[") {
+			prompt[i].Content = fmt.Sprintf(msg.Content, strings.Join(lines, "\n"), answer)
+		}
+	}
+
+	return prompt, nil
+}
+
+// VerifyingTurnRunner decorates a TurnRunner, checking the final answer of every completed turn that ran
+// actions and streaming a correction before the turn completes. Verification failures never fail the turn.
+type VerifyingTurnRunner struct {
+	logger   *log.Logger
+	next     TurnRunner
+	verifier AnswerVerifier
+	timeout  time.Duration
+}
+
+// NewVerifyingTurnRunner creates a VerifyingTurnRunner. A zero timeout leaves the verification unbounded.
+func NewVerifyingTurnRunner(logger *log.Logger, next TurnRunner, verifier AnswerVerifier, timeout time.Duration) VerifyingTurnRunner {
+	return VerifyingTurnRunner{
+		logger:   logger,
+		next:     next,
+		verifier: verifier,
+		timeout:  timeout,
+	}
+}
+
+// Run implements TurnRunner.
+func (r VerifyingTurnRunner) Run(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+	recorder := &verifiedActionRecorder{}
+	if err := r.next.Run(ctx, state, recorder.wrap(onEvent)); err != nil {
+		return err
+	}
+
+	verifyCtx := ctx
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		verifyCtx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	correction, err := r.verifier.Verify(verifyCtx, state.AssistantContent(), recorder.actions())
+	if err != nil {
+		if r.logger != nil {
+			r.logger.Printf("StreamChat: answer verification failed for conversation %s: %v", state.Conversation().ID, err)
+		}
+		return nil
+	}
+	if correction == "" {
+		return nil
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("Answer corrected", trace.WithAttributes(
+		attribute.String("correction", correction),
+	))
+	text := ANSWER_CORRECTION_PREFIX + correction
+	state.AppendAssistantContent(text)
+	return onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: text})
+}
+
+// verifiedActionRecorder records the outcome of every completed action of a turn from its events.
+type verifiedActionRecorder struct {
+	mu       sync.Mutex
+	outcomes []VerifiedAction
+}
+
+// wrap returns an event callback recording the completed actions before forwarding the events to onEvent.
+func (r *verifiedActionRecorder) wrap(onEvent assistant.EventCallback) assistant.EventCallback {
+	return func(ctx context.Context, eventType assistant.EventType, data any) error {
+		if completed, ok := data.(assistant.ActionCompleted); ok && eventType == assistant.EventType_ActionCompleted {
+			action := VerifiedAction{Name: completed.Name, Success: completed.Success}
+			if completed.Error != nil {
+				action.Error = *completed.Error
+			}
+			if completed.OutputPreview != nil {
+				action.Output = *completed.OutputPreview
+			}
+			r.mu.Lock()
+			r.outcomes = append(r.outcomes, action)
+			r.mu.Unlock()
+		}
+		return onEvent(ctx, eventType, data)
+	}
+}
+
+// actions returns the recorded action outcomes in completion order.
+func (r *verifiedActionRecorder) actions() []VerifiedAction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.outcomes
+}
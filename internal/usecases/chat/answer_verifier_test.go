@@ -0,0 +1,184 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswerVerifierImpl_Verify(t *testing.T) {
+	t.Parallel()
+
+	failedCreate := []VerifiedAction{{Name: "create_todos", Error: "title is required"}}
+
+	tests := map[string]struct {
+		answer             string
+		actions            []VerifiedAction
+		setExpectations    func(*assistant.MockAssistant)
+		expectedCorrection string
+		expectedErr        error
+	}{
+		"no-actions": {
+			answer:          "Hello!",
+			setExpectations: func(*assistant.MockAssistant) {},
+		},
+		"empty-answer": {
+			actions:         failedCreate,
+			setExpectations: func(*assistant.MockAssistant) {},
+		},
+		"consistent": {
+			answer:  "I could not create the todo because the title is missing.",
+			actions: failedCreate,
+			setExpectations: func(m *assistant.MockAssistant) {
+				m.EXPECT().RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: `{"correction":""}`}, nil).
+					Once()
+			},
+		},
+		"contradiction": {
+			answer:  "Done! I created the todo.",
+			actions: failedCreate,
+			setExpectations: func(m *assistant.MockAssistant) {
+				m.EXPECT().
+					RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						require.Len(t, req.Messages, 1)
+						assert.Equal(t, "verifier", req.Model)
+						assert.Contains(t, req.Messages[0].Content, "- create_todos: failed; error: title is required")
+						assert.Contains(t, req.Messages[0].Content, "Done! I created the todo.")
+						return true
+					})).
+					Return(assistant.TurnResponse{Content: `{"correction":" The todo was not created: the title is missing. "}`}, nil).
+					Once()
+			},
+			expectedCorrection: "The todo was not created: the title is missing.",
+		},
+		"assistant-error": {
+			answer:  "Done!",
+			actions: failedCreate,
+			setExpectations: func(m *assistant.MockAssistant) {
+				m.EXPECT().RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{}, errors.New("llm unavailable")).
+					Once()
+			},
+			expectedErr: errors.New("failed to verify answer: llm unavailable"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assistantClient := assistant.NewMockAssistant(t)
+			tt.setExpectations(assistantClient)
+
+			verifier := NewAnswerVerifierImpl(assistantClient, "verifier")
+			got, err := verifier.Verify(t.Context(), tt.answer, tt.actions)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCorrection, got)
+		})
+	}
+}
+
+func TestVerifyingTurnRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	expectedActions := []VerifiedAction{
+		{Name: "create_todos", Error: "title is required"},
+		{Name: "fetch_todos", Success: true, Output: "[]"},
+	}
+
+	tests := map[string]struct {
+		runErr          error
+		correction      string
+		verifyErr       error
+		expectVerify    bool
+		expectedErr     error
+		expectedContent string
+		expectedDeltas  []string
+	}{
+		"correction": {
+			expectVerify:    true,
+			correction:      "The todo was not created.",
+			expectedContent: "Created it!" + ANSWER_CORRECTION_PREFIX + "The todo was not created.",
+			expectedDeltas:  []string{ANSWER_CORRECTION_PREFIX + "The todo was not created."},
+		},
+		"consistent": {
+			expectVerify:    true,
+			expectedContent: "Created it!",
+		},
+		"verify-error-is-ignored": {
+			expectVerify:    true,
+			verifyErr:       errors.New("llm unavailable"),
+			expectedContent: "Created it!",
+		},
+		"turn-error": {
+			runErr:          errors.New("turn failed"),
+			expectedErr:     errors.New("turn failed"),
+			expectedContent: "Created it!",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "primary"}, 5, nil)
+			next := NewMockTurnRunner(t)
+			verifier := NewMockAnswerVerifier(t)
+
+			next.EXPECT().
+				Run(mock.Anything, state, mock.Anything).
+				RunAndReturn(func(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+					events := []struct {
+						eventType assistant.EventType
+						data      any
+					}{
+						{assistant.EventType_ActionStarted, assistant.ActionCall{ID: "call-1", Name: "create_todos"}},
+						{assistant.EventType_ActionCompleted, assistant.ActionCompleted{ID: "call-1", Name: "create_todos", Error: common.Ptr("title is required")}},
+						{assistant.EventType_ActionCompleted, assistant.ActionCompleted{ID: "call-2", Name: "fetch_todos", Success: true, OutputPreview: common.Ptr("[]")}},
+					}
+					for _, event := range events {
+						if err := onEvent(ctx, event.eventType, event.data); err != nil {
+							return err
+						}
+					}
+					state.AppendAssistantContent("Created it!")
+					return tt.runErr
+				}).Once()
+			if tt.expectVerify {
+				verifier.EXPECT().
+					Verify(mock.Anything, "Created it!", expectedActions).
+					Return(tt.correction, tt.verifyErr).
+					Once()
+			}
+
+			var deltas []string
+			runner := NewVerifyingTurnRunner(log.New(io.Discard, "", 0), next, verifier, time.Second)
+			err := runner.Run(t.Context(), state, func(_ context.Context, eventType assistant.EventType, data any) error {
+				if eventType == assistant.EventType_MessageDelta {
+					deltas = append(deltas, data.(assistant.MessageDelta).Text)
+				}
+				return nil
+			})
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedContent, state.AssistantContent())
+			assert.Equal(t, tt.expectedDeltas, deltas)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
@@ -56,6 +57,9 @@ type ConversationCompactor interface {
 	) (assistant.CompactionDecision, error)
 	// Compact refreshes the persisted compacted memory from unsummarized messages.
 	Compact(ctx context.Context, conversationID uuid.UUID) error
+	// Recompact rebuilds the compacted memory from the messages before fromMessageID when it already covers
+	// that message, so messages about to be superseded do not linger in the summary.
+	Recompact(ctx context.Context, conversationID, fromMessageID uuid.UUID) error
 }
 
 // ConversationCompactorImpl implements ConversationCompactor.
@@ -140,6 +144,65 @@ func (gcs ConversationCompactorImpl) Compact(ctx context.Context, conversationID
 	return gcs.compactConversationFromState(spanCtx, conversationID, currentSummary, previous, found, unsummarizedMessages)
 }
 
+// Recompact implements ConversationCompactor.
+func (gcs ConversationCompactorImpl) Recompact(ctx context.Context, conversationID, fromMessageID uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if conversationID == uuid.Nil {
+		return core.NewValidationErr("conversation id cannot be empty")
+	}
+
+	previous, found, err := gcs.conversationSummaryRepo.GetConversationSummary(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to get conversation summary: %w", err)
+	}
+	if !found || previous.LastSummarizedMessageID == nil {
+		return nil
+	}
+
+	if *previous.LastSummarizedMessageID != fromMessageID {
+		laterMessages, _, err := gcs.chatMessageRepo.ListChatMessages(spanCtx, conversationID, 1, 0, assistant.WithChatMessagesAfterMessageID(fromMessageID))
+		if telemetry.IsErrorRecorded(span, err) {
+			return fmt.Errorf("failed to list chat messages: %w", err)
+		}
+		covered := slices.ContainsFunc(laterMessages, func(message assistant.ChatMessage) bool {
+			return message.ID == *previous.LastSummarizedMessageID
+		})
+		if !covered {
+			return nil
+		}
+	}
+
+	earlierMessages, _, err := gcs.chatMessageRepo.ListChatMessages(spanCtx, conversationID, 1, 0, assistant.WithChatMessagesBeforeMessageID(fromMessageID))
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to list chat messages: %w", err)
+	}
+	span.SetAttributes(attribute.Int("recompacted_messages_count", len(earlierMessages)))
+
+	summaryContent := ""
+	if len(earlierMessages) > 0 {
+		summaryContent, err = gcs.summarize(spanCtx, assistant.DefaultConversationStateSummary, earlierMessages)
+		if telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+	}
+
+	// The checkpoint moves to fromMessageID so only the messages recorded from there on are unsummarized.
+	err = gcs.conversationSummaryRepo.StoreConversationSummary(spanCtx, assistant.ConversationSummary{
+		ID:                      previous.ID,
+		ConversationID:          conversationID,
+		CurrentStateSummary:     summaryContent,
+		LastSummarizedMessageID: &fromMessageID,
+		UpdatedAt:               gcs.timeProvider.Now(),
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to store compacted conversation context: %w", err)
+	}
+
+	return nil
+}
+
 // compactConversationFromState runs the compaction prompt against the current unsummarized window and persists the result.
 func (gcs ConversationCompactorImpl) compactConversationFromState(
 	spanCtx context.Context,
@@ -151,33 +214,13 @@ func (gcs ConversationCompactorImpl) compactConversationFromState(
 ) error {
 	span := trace.SpanFromContext(spanCtx)
 
-	promptMessages, err := gcs.buildPromptMessages(currentSummary, formatMessagesForSummary(unsummarizedMessages))
-	if telemetry.IsErrorRecorded(span, err) {
-		return fmt.Errorf("failed to build prompt messages: %w", err)
-	}
-
-	resp, err := gcs.assistant.RunTurnSync(spanCtx, assistant.TurnRequest{
-		Model:            gcs.model,
-		Messages:         promptMessages,
-		Stream:           false,
-		MaxTokens:        common.Ptr(CHAT_SUMMARY_MAX_TOKENS),
-		Temperature:      common.Ptr(CHAT_SUMMARY_TEMPERATURE),
-		TopP:             common.Ptr(CHAT_SUMMARY_TOP_P),
-		FrequencyPenalty: common.Ptr(CHAT_SUMMARY_FREQUENCY_PENALTY),
-	})
-	if telemetry.IsErrorRecorded(span, err) {
-		return fmt.Errorf("failed to compact conversation context: %w", err)
+	summaryContent, err := gcs.summarize(spanCtx, currentSummary, unsummarizedMessages)
+	if err != nil {
+		return err
 	}
-
-	metrics.RecordLLMTokensUsed(spanCtx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
-	summaryContent := strings.TrimSpace(resp.Content)
 	if summaryContent == "" {
 		return nil
 	}
-	summaryContent = normalizeConversationSummary(currentSummary, summaryContent)
-	if summaryContent == "" {
-		summaryContent = normalizeConversationSummary("", currentSummary)
-	}
 
 	summaryID := uuid.New()
 	if found {
@@ -202,6 +245,48 @@ func (gcs ConversationCompactorImpl) compactConversationFromState(
 	return nil
 }
 
+// summarize runs the compaction prompt over the messages and returns the normalized compacted memory.
+// It returns an empty string when the model produced no summary.
+func (gcs ConversationCompactorImpl) summarize(
+	spanCtx context.Context,
+	currentSummary string,
+	messages []assistant.ChatMessage,
+) (string, error) {
+	span := trace.SpanFromContext(spanCtx)
+
+	promptMessages, err := gcs.buildPromptMessages(currentSummary, formatMessagesForSummary(messages))
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", fmt.Errorf("failed to build prompt messages: %w", err)
+	}
+
+	resp, err := gcs.assistant.RunTurnSync(spanCtx, assistant.TurnRequest{
+		Model:            gcs.model,
+		Messages:         promptMessages,
+		Stream:           false,
+		MaxTokens:        common.Ptr(CHAT_SUMMARY_MAX_TOKENS),
+		Temperature:      common.Ptr(CHAT_SUMMARY_TEMPERATURE),
+		TopP:             common.Ptr(CHAT_SUMMARY_TOP_P),
+		FrequencyPenalty: common.Ptr(CHAT_SUMMARY_FREQUENCY_PENALTY),
+		ResponseSchema: assistant.NewTextResponseSchema(
+			"conversation_summary", "summary", "Compacted conversation state in the requested summary format.",
+		),
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", fmt.Errorf("failed to compact conversation context: %w", err)
+	}
+
+	metrics.RecordLLMTokensUsed(spanCtx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+	summaryContent := strings.TrimSpace(resp.TextField("summary"))
+	if summaryContent == "" {
+		return "", nil
+	}
+	summaryContent = normalizeConversationSummary(currentSummary, summaryContent)
+	if summaryContent == "" {
+		summaryContent = normalizeConversationSummary("", currentSummary)
+	}
+	return summaryContent, nil
+}
+
 // loadCompactionInput loads the latest compacted context and the unsummarized message slice that still needs compaction.
 func (gcs ConversationCompactorImpl) loadCompactionInput(
 	ctx context.Context,
@@ -265,6 +350,9 @@ func (gcs ConversationCompactorImpl) buildPromptMessages(currentState, newMessag
 func formatMessagesForSummary(messages []assistant.ChatMessage) string {
 	formatted := make([]string, 0, len(messages))
 	for _, message := range messages {
+		if message.IsModerated() || message.IsSuperseded() {
+			continue
+		}
 		formatted = append(formatted, formatMessageForSummary(message))
 	}
 	return strings.Join(formatted, "\n")
@@ -140,6 +140,52 @@ func TestConversationCompactorImpl_Compact(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		"success-unwraps-constrained-json-summary": {
+			model:          "summary-model",
+			conversationID: conversationID,
+			setExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+				assist *assistant.MockAssistant,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(assistant.ConversationSummary{}, false, nil).
+					Once()
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0).
+					Return([]assistant.ChatMessage{
+						{
+							ID:                    chatMessageID,
+							ConversationID:        conversationID,
+							ChatRole:              assistant.ChatRole_Assistant,
+							Content:               largeContextMessage,
+							MessageState:          assistant.ChatMessageState_Completed,
+							ContextTokensEstimate: CHAT_SUMMARY_TRIGGER_TOKENS + 1,
+						},
+					}, false, nil).
+					Once()
+				assist.EXPECT().
+					RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						return req.ResponseSchema != nil && req.ResponseSchema.Name == "conversation_summary"
+					})).
+					Return(assistant.TurnResponse{Content: `{"summary": "memory: compacted\ncarry: pending confirmation"}`}, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				summaryRepo.EXPECT().
+					StoreConversationSummary(mock.Anything, mock.MatchedBy(func(summary assistant.ConversationSummary) bool {
+						return summary.ConversationID == conversationID &&
+							summary.LastSummarizedMessageID != nil &&
+							*summary.LastSummarizedMessageID == chatMessageID &&
+							summary.CurrentStateSummary == "memory: compacted\ncarry: pending confirmation" &&
+							summary.UpdatedAt.Equal(fixedTime)
+					})).
+					Return(nil).
+					Once()
+			},
+			expectedErr: "",
+		},
 	}
 
 	for name, tt := range tests {
@@ -171,6 +217,192 @@ func TestConversationCompactorImpl_Compact(t *testing.T) {
 	}
 }
 
+func TestConversationCompactorImpl_Recompact(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	summaryID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	earlierMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	editedMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	laterMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+	fixedTime := time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC)
+
+	summaryAt := func(checkpoint uuid.UUID) assistant.ConversationSummary {
+		return assistant.ConversationSummary{
+			ID:                      summaryID,
+			ConversationID:          conversationID,
+			CurrentStateSummary:     "memory: stale",
+			LastSummarizedMessageID: &checkpoint,
+		}
+	}
+	afterEdited := mock.MatchedBy(func(options []assistant.ListChatMessagesOption) bool {
+		params := assistant.ListChatMessagesParams{}
+		for _, option := range options {
+			option(&params)
+		}
+		return params.AfterMessageID != nil && *params.AfterMessageID == editedMessageID
+	})
+	beforeEdited := mock.MatchedBy(func(options []assistant.ListChatMessagesOption) bool {
+		params := assistant.ListChatMessagesParams{}
+		for _, option := range options {
+			option(&params)
+		}
+		return params.BeforeMessageID != nil && *params.BeforeMessageID == editedMessageID
+	})
+
+	tests := map[string]struct {
+		setExpectations func(
+			*assistant.MockChatMessageRepository,
+			*assistant.MockConversationSummaryRepository,
+			*core.MockCurrentTimeProvider,
+			*assistant.MockAssistant,
+		)
+		expectedErr string
+	}{
+		"no-summary-noop": {
+			setExpectations: func(
+				_ *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				_ *core.MockCurrentTimeProvider,
+				_ *assistant.MockAssistant,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(assistant.ConversationSummary{}, false, nil).
+					Once()
+			},
+		},
+		"summary-before-edited-message-noop": {
+			setExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				_ *core.MockCurrentTimeProvider,
+				_ *assistant.MockAssistant,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summaryAt(earlierMessageID), true, nil).
+					Once()
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, afterEdited).
+					Return([]assistant.ChatMessage{{ID: laterMessageID}}, false, nil).
+					Once()
+			},
+		},
+		"summary-covering-later-message-is-rebuilt": {
+			setExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+				assist *assistant.MockAssistant,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summaryAt(laterMessageID), true, nil).
+					Once()
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, afterEdited).
+					Return([]assistant.ChatMessage{{ID: laterMessageID}}, false, nil).
+					Once()
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, beforeEdited).
+					Return([]assistant.ChatMessage{
+						{ID: earlierMessageID, ChatRole: assistant.ChatRole_User, Content: "Add milk to my list"},
+					}, false, nil).
+					Once()
+				assist.EXPECT().
+					RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						return strings.Contains(req.Messages[0].Content, "user: Add milk to my list") &&
+							!strings.Contains(req.Messages[0].Content, "memory: stale")
+					})).
+					Return(assistant.TurnResponse{Content: "memory: milk added"}, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				summaryRepo.EXPECT().
+					StoreConversationSummary(mock.Anything, assistant.ConversationSummary{
+						ID:                      summaryID,
+						ConversationID:          conversationID,
+						CurrentStateSummary:     "memory: milk added",
+						LastSummarizedMessageID: &editedMessageID,
+						UpdatedAt:               fixedTime,
+					}).
+					Return(nil).
+					Once()
+			},
+		},
+		"summary-at-first-message-is-reset": {
+			setExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+				_ *assistant.MockAssistant,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summaryAt(editedMessageID), true, nil).
+					Once()
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, beforeEdited).
+					Return([]assistant.ChatMessage{}, false, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				summaryRepo.EXPECT().
+					StoreConversationSummary(mock.Anything, assistant.ConversationSummary{
+						ID:                      summaryID,
+						ConversationID:          conversationID,
+						LastSummarizedMessageID: &editedMessageID,
+						UpdatedAt:               fixedTime,
+					}).
+					Return(nil).
+					Once()
+			},
+		},
+		"summarize-error": {
+			setExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				_ *core.MockCurrentTimeProvider,
+				assist *assistant.MockAssistant,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summaryAt(editedMessageID), true, nil).
+					Once()
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, beforeEdited).
+					Return([]assistant.ChatMessage{{ID: earlierMessageID, ChatRole: assistant.ChatRole_User}}, false, nil).
+					Once()
+				assist.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{}, errors.New("llm down")).
+					Once()
+			},
+			expectedErr: "failed to compact conversation context: llm down",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			assistantClient := assistant.NewMockAssistant(t)
+			tt.setExpectations(chatRepo, summaryRepo, timeProvider, assistantClient)
+
+			uc := NewConversationCompactorImpl(chatRepo, summaryRepo, timeProvider, assistantClient, "summary-model")
+
+			gotErr := uc.Recompact(t.Context(), conversationID, editedMessageID)
+			if tt.expectedErr == "" {
+				assert.NoError(t, gotErr)
+				return
+			}
+			require.EqualError(t, gotErr, tt.expectedErr)
+		})
+	}
+}
+
 func TestConversationCompactorImpl_EvaluateConversationCompaction(t *testing.T) {
 	t.Parallel()
 
@@ -325,3 +557,29 @@ func TestFormatMessageForSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatMessagesForSummary_SkipsModeratedMessages(t *testing.T) {
+	t.Parallel()
+
+	got := formatMessagesForSummary([]assistant.ChatMessage{
+		{ChatRole: assistant.ChatRole_User, MessageState: assistant.ChatMessageState_Moderated, Content: "blocked content"},
+		{ChatRole: assistant.ChatRole_User, MessageState: assistant.ChatMessageState_Completed, Content: "Add a todo"},
+	})
+
+	assert.NotContains(t, got, "blocked content")
+	assert.Contains(t, got, "user: Add a todo")
+}
+
+func TestFormatMessagesForSummary_SkipsSupersededMessages(t *testing.T) {
+	t.Parallel()
+
+	supersededAt := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	got := formatMessagesForSummary([]assistant.ChatMessage{
+		{ChatRole: assistant.ChatRole_User, Content: "Add a todo"},
+		{ChatRole: assistant.ChatRole_Assistant, Content: "first answer", SupersededAt: &supersededAt},
+		{ChatRole: assistant.ChatRole_Assistant, Content: "regenerated answer"},
+	})
+
+	assert.NotContains(t, got, "first answer")
+	assert.Contains(t, got, "regenerated answer")
+}
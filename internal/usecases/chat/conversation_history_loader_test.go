@@ -210,6 +210,8 @@ func TestTurnStateBuilder_LoadMessagesHistory(t *testing.T) {
 		Return([]assistant.ChatMessage{
 			{ChatRole: assistant.ChatRole_Tool, Content: "orphan tool"},
 			{ChatRole: assistant.ChatRole_User, Content: "Hello"},
+			{ChatRole: assistant.ChatRole_User, Content: "Blocked", MessageState: assistant.ChatMessageState_Moderated},
+			{ChatRole: assistant.ChatRole_Assistant, Content: "Replaced", SupersededAt: &fixedTime},
 			{ChatRole: assistant.ChatRole_Assistant, Content: "Hi"},
 		}, false, nil).
 		Once()
@@ -218,13 +220,19 @@ func TestTurnStateBuilder_LoadMessagesHistory(t *testing.T) {
 
 	builder := NewTurnStateBuilderImpl(
 		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
 		chatRepo,
 		timeProvider,
 		nil,
 		nil,
+		noContentBlobs{},
+		0,
 	)
 
-	messages, summaryContext, err := builder.loadMessagesHistory(context.Background(), conversationID)
+	messages, summaryContext, err := builder.loadMessagesHistory(context.Background(), conversationID, "", nil)
 	require.NoError(t, err)
 	assert.Equal(t, "Summary state", summaryContext)
 	require.GreaterOrEqual(t, len(messages), 4)
@@ -233,4 +241,8 @@ func TestTurnStateBuilder_LoadMessagesHistory(t *testing.T) {
 	assert.Equal(t, "Hello", messages[len(messages)-2].Content)
 	assert.Equal(t, assistant.ChatRole_Assistant, messages[len(messages)-1].Role)
 	assert.Equal(t, "Hi", messages[len(messages)-1].Content)
+	for _, message := range messages {
+		assert.NotEqual(t, "Blocked", message.Content)
+		assert.NotEqual(t, "Replaced", message.Content)
+	}
 }
@@ -0,0 +1,225 @@
+package chat
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// MAX_SNAPSHOT_PINNED_TODOS bounds the number of todos pinned into a conversation snapshot.
+	MAX_SNAPSHOT_PINNED_TODOS = 8
+	// SNAPSHOT_CHANGES_PAGE_SIZE is the page size used to read the conversation todo change log.
+	SNAPSHOT_CHANGES_PAGE_SIZE = 100
+)
+
+// ConversationSnapshotter persists compacted snapshots of a conversation.
+type ConversationSnapshotter interface {
+	// Snapshot persists a snapshot from the current compacted summary, when it moved past the latest snapshot.
+	Snapshot(ctx context.Context, conversationID uuid.UUID) error
+	// CarryOver seeds the target conversation with a snapshot holding the pinned todos of the source conversation.
+	CarryOver(ctx context.Context, fromConversationID, toConversationID uuid.UUID) ([]assistant.PinnedTodo, error)
+}
+
+// ConversationSnapshotterImpl implements ConversationSnapshotter.
+type ConversationSnapshotterImpl struct {
+	conversationSummaryRepo  assistant.ConversationSummaryRepository
+	conversationSnapshotRepo assistant.ConversationSnapshotRepository
+	changeRepo               todo.ChangeRepository
+	todoRepo                 todo.Repository
+	timeProvider             core.CurrentTimeProvider
+}
+
+// NewConversationSnapshotterImpl creates a ConversationSnapshotterImpl.
+func NewConversationSnapshotterImpl(
+	conversationSummaryRepo assistant.ConversationSummaryRepository,
+	conversationSnapshotRepo assistant.ConversationSnapshotRepository,
+	changeRepo todo.ChangeRepository,
+	todoRepo todo.Repository,
+	timeProvider core.CurrentTimeProvider,
+) ConversationSnapshotterImpl {
+	return ConversationSnapshotterImpl{
+		conversationSummaryRepo:  conversationSummaryRepo,
+		conversationSnapshotRepo: conversationSnapshotRepo,
+		changeRepo:               changeRepo,
+		todoRepo:                 todoRepo,
+		timeProvider:             timeProvider,
+	}
+}
+
+// Snapshot implements ConversationSnapshotter.
+func (cs ConversationSnapshotterImpl) Snapshot(ctx context.Context, conversationID uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	summary, found, err := cs.conversationSummaryRepo.GetConversationSummary(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if !found || summary.LastSummarizedMessageID == nil {
+		return nil
+	}
+
+	previous, hasPrevious, err := cs.conversationSnapshotRepo.GetLatestConversationSnapshot(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if hasPrevious && previous.LastMessageID == *summary.LastSummarizedMessageID {
+		return nil
+	}
+
+	changes, err := cs.listChangesSince(spanCtx, conversationID, previous.ChangeSequence)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	pinnedTodos, err := cs.loadPinnedTodos(spanCtx, changes, previous.PinnedTodos)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	changeSequence := previous.ChangeSequence
+	if len(changes) > 0 && changes[len(changes)-1].ConversationSequence != nil {
+		changeSequence = *changes[len(changes)-1].ConversationSequence
+	}
+
+	snapshot := assistant.ConversationSnapshot{
+		ID:             uuid.New(),
+		ConversationID: conversationID,
+		Summary:        summary.CurrentStateOrDefault(),
+		PinnedTodos:    pinnedTodos,
+		OpenLoops:      assistant.ExtractOpenLoops(summary.CurrentStateSummary),
+		LastMessageID:  *summary.LastSummarizedMessageID,
+		ChangeSequence: changeSequence,
+		CreatedAt:      cs.timeProvider.Now(),
+	}
+	span.SetAttributes(
+		attribute.Int("pinned_todos_count", len(snapshot.PinnedTodos)),
+		attribute.Int("open_loops_count", len(snapshot.OpenLoops)),
+	)
+
+	err = cs.conversationSnapshotRepo.StoreConversationSnapshot(spanCtx, snapshot)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// CarryOver implements ConversationSnapshotter.
+func (cs ConversationSnapshotterImpl) CarryOver(
+	ctx context.Context,
+	fromConversationID uuid.UUID,
+	toConversationID uuid.UUID,
+) ([]assistant.PinnedTodo, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	previous, _, err := cs.conversationSnapshotRepo.GetLatestConversationSnapshot(spanCtx, fromConversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	changes, err := cs.listChangesSince(spanCtx, fromConversationID, previous.ChangeSequence)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	pinnedTodos, err := cs.loadPinnedTodos(spanCtx, changes, previous.PinnedTodos)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("pinned_todos_count", len(pinnedTodos)))
+	if len(pinnedTodos) == 0 {
+		return pinnedTodos, nil
+	}
+
+	// The seed snapshot covers no messages yet, so the whole new conversation follows it.
+	err = cs.conversationSnapshotRepo.StoreConversationSnapshot(spanCtx, assistant.ConversationSnapshot{
+		ID:             uuid.New(),
+		ConversationID: toConversationID,
+		PinnedTodos:    pinnedTodos,
+		CreatedAt:      cs.timeProvider.Now(),
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return pinnedTodos, nil
+}
+
+// listChangesSince reads every todo change the conversation made after the given conversation sequence.
+func (cs ConversationSnapshotterImpl) listChangesSince(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	since int64,
+) ([]todo.Change, error) {
+	changes := []todo.Change{}
+	for {
+		page, hasMore, err := cs.changeRepo.ListChangesSince(ctx, since, &conversationID, SNAPSHOT_CHANGES_PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, page...)
+		if !hasMore || len(page) == 0 || page[len(page)-1].ConversationSequence == nil {
+			return changes, nil
+		}
+		since = *page[len(page)-1].ConversationSequence
+	}
+}
+
+// loadPinnedTodos pins the most recently changed todos first, then carries over previously pinned ones.
+// Deleted todos are dropped.
+func (cs ConversationSnapshotterImpl) loadPinnedTodos(
+	ctx context.Context,
+	changes []todo.Change,
+	previous []assistant.PinnedTodo,
+) ([]assistant.PinnedTodo, error) {
+	seen := map[uuid.UUID]struct{}{}
+	todoIDs := make([]uuid.UUID, 0, MAX_SNAPSHOT_PINNED_TODOS)
+	for _, change := range slices.Backward(changes) {
+		if _, ok := seen[change.TodoID]; ok {
+			continue
+		}
+		seen[change.TodoID] = struct{}{}
+		if change.Type != todo.ChangeType_Deleted {
+			todoIDs = append(todoIDs, change.TodoID)
+		}
+	}
+	for _, pinned := range previous {
+		if _, ok := seen[pinned.ID]; ok {
+			continue
+		}
+		seen[pinned.ID] = struct{}{}
+		todoIDs = append(todoIDs, pinned.ID)
+	}
+
+	pinnedTodos := make([]assistant.PinnedTodo, 0, min(len(todoIDs), MAX_SNAPSHOT_PINNED_TODOS))
+	for _, todoID := range todoIDs {
+		if len(pinnedTodos) == MAX_SNAPSHOT_PINNED_TODOS {
+			break
+		}
+		t, found, err := cs.todoRepo.GetTodo(ctx, todoID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		pinnedTodos = append(pinnedTodos, assistant.PinnedTodo{
+			ID:      t.ID,
+			Title:   t.Title,
+			Status:  string(t.Status),
+			DueDate: t.DueDate.Format(time.DateOnly),
+		})
+	}
+
+	return pinnedTodos, nil
+}
@@ -0,0 +1,334 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConversationSnapshotterImpl_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	lastMessageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	previousMessageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174009")
+	createdTodoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	deletedTodoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174002")
+	pinnedTodoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174003")
+	dueDate := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+	fixedTime := time.Date(2026, 2, 12, 10, 0, 0, 0, time.UTC)
+
+	summary := assistant.ConversationSummary{
+		ConversationID:          conversationID,
+		CurrentStateSummary:     "memory: dinner planning\ncarry: confirm guest list",
+		LastSummarizedMessageID: &lastMessageID,
+	}
+	previous := assistant.ConversationSnapshot{
+		ConversationID: conversationID,
+		PinnedTodos: []assistant.PinnedTodo{
+			{ID: pinnedTodoID, Title: "Old title", Status: "OPEN", DueDate: "2026-02-19"},
+			{ID: deletedTodoID, Title: "Call caterer", Status: "OPEN", DueDate: "2026-02-19"},
+		},
+		LastMessageID:  previousMessageID,
+		ChangeSequence: 2,
+	}
+
+	tests := map[string]struct {
+		setExpectations func(
+			*assistant.MockConversationSummaryRepository,
+			*assistant.MockConversationSnapshotRepository,
+			*todo.MockChangeRepository,
+			*todo.MockRepository,
+			*core.MockCurrentTimeProvider,
+		)
+		expectedErr error
+	}{
+		"skips-without-summary": {
+			setExpectations: func(
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				_ *assistant.MockConversationSnapshotRepository,
+				_ *todo.MockChangeRepository,
+				_ *todo.MockRepository,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(assistant.ConversationSummary{}, false, nil).
+					Once()
+			},
+		},
+		"skips-when-latest-snapshot-is-current": {
+			setExpectations: func(
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				_ *todo.MockChangeRepository,
+				_ *todo.MockRepository,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, conversationID).
+					Return(assistant.ConversationSnapshot{LastMessageID: lastMessageID}, true, nil).
+					Once()
+			},
+		},
+		"stores-snapshot-with-pinned-todos-and-open-loops": {
+			setExpectations: func(
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				changeRepo *todo.MockChangeRepository,
+				todoRepo *todo.MockRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, conversationID).
+					Return(previous, true, nil).
+					Once()
+				changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(2), &conversationID, SNAPSHOT_CHANGES_PAGE_SIZE).
+					Return([]todo.Change{
+						{TodoID: createdTodoID, Type: todo.ChangeType_Created, ConversationSequence: common.Ptr(int64(3))},
+					}, true, nil).
+					Once()
+				changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(3), &conversationID, SNAPSHOT_CHANGES_PAGE_SIZE).
+					Return([]todo.Change{
+						{TodoID: deletedTodoID, Type: todo.ChangeType_Deleted, ConversationSequence: common.Ptr(int64(4))},
+					}, false, nil).
+					Once()
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, createdTodoID).
+					Return(todo.Todo{ID: createdTodoID, Title: "Buy wine", Status: todo.Status_OPEN, DueDate: dueDate}, true, nil).
+					Once()
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, pinnedTodoID).
+					Return(todo.Todo{ID: pinnedTodoID, Title: "Book table", Status: todo.Status_DONE, DueDate: dueDate}, true, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				snapshotRepo.EXPECT().
+					StoreConversationSnapshot(mock.Anything, mock.MatchedBy(func(snapshot assistant.ConversationSnapshot) bool {
+						return snapshot.ID != uuid.Nil &&
+							assert.ObjectsAreEqual(assistant.ConversationSnapshot{
+								ID:             snapshot.ID,
+								ConversationID: conversationID,
+								Summary:        summary.CurrentStateSummary,
+								PinnedTodos: []assistant.PinnedTodo{
+									{ID: createdTodoID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+									{ID: pinnedTodoID, Title: "Book table", Status: "DONE", DueDate: "2026-02-20"},
+								},
+								OpenLoops:      []string{"confirm guest list"},
+								LastMessageID:  lastMessageID,
+								ChangeSequence: 4,
+								CreatedAt:      fixedTime,
+							}, snapshot)
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"list-changes-error": {
+			setExpectations: func(
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				changeRepo *todo.MockChangeRepository,
+				_ *todo.MockRepository,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, conversationID).
+					Return(assistant.ConversationSnapshot{}, false, nil).
+					Once()
+				changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(0), &conversationID, SNAPSHOT_CHANGES_PAGE_SIZE).
+					Return(nil, false, errors.New("db error")).
+					Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"store-snapshot-error": {
+			setExpectations: func(
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				changeRepo *todo.MockChangeRepository,
+				_ *todo.MockRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, conversationID).
+					Return(assistant.ConversationSnapshot{}, false, nil).
+					Once()
+				changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(0), &conversationID, SNAPSHOT_CHANGES_PAGE_SIZE).
+					Return([]todo.Change{}, false, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				snapshotRepo.EXPECT().
+					StoreConversationSnapshot(mock.Anything, mock.Anything).
+					Return(errors.New("db error")).
+					Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			snapshotRepo := assistant.NewMockConversationSnapshotRepository(t)
+			changeRepo := todo.NewMockChangeRepository(t)
+			todoRepo := todo.NewMockRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(summaryRepo, snapshotRepo, changeRepo, todoRepo, timeProvider)
+
+			snapshotter := NewConversationSnapshotterImpl(summaryRepo, snapshotRepo, changeRepo, todoRepo, timeProvider)
+			err := snapshotter.Snapshot(t.Context(), conversationID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestConversationSnapshotterImpl_CarryOver(t *testing.T) {
+	t.Parallel()
+
+	fromConversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	toConversationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	createdTodoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	pinnedTodoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174003")
+	dueDate := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+	fixedTime := time.Date(2026, 2, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setExpectations func(
+			*assistant.MockConversationSnapshotRepository,
+			*todo.MockChangeRepository,
+			*todo.MockRepository,
+			*core.MockCurrentTimeProvider,
+		)
+		expected    []assistant.PinnedTodo
+		expectedErr error
+	}{
+		"seeds-target-with-pinned-todos": {
+			setExpectations: func(
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				changeRepo *todo.MockChangeRepository,
+				todoRepo *todo.MockRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, fromConversationID).
+					Return(assistant.ConversationSnapshot{
+						PinnedTodos:    []assistant.PinnedTodo{{ID: pinnedTodoID}},
+						ChangeSequence: 2,
+					}, true, nil).
+					Once()
+				changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(2), &fromConversationID, SNAPSHOT_CHANGES_PAGE_SIZE).
+					Return([]todo.Change{
+						{TodoID: createdTodoID, Type: todo.ChangeType_Created, ConversationSequence: common.Ptr(int64(3))},
+					}, false, nil).
+					Once()
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, createdTodoID).
+					Return(todo.Todo{ID: createdTodoID, Title: "Buy wine", Status: todo.Status_OPEN, DueDate: dueDate}, true, nil).
+					Once()
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, pinnedTodoID).
+					Return(todo.Todo{}, false, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				snapshotRepo.EXPECT().
+					StoreConversationSnapshot(mock.Anything, mock.MatchedBy(func(snapshot assistant.ConversationSnapshot) bool {
+						return snapshot.ID != uuid.Nil &&
+							assert.ObjectsAreEqual(assistant.ConversationSnapshot{
+								ID:             snapshot.ID,
+								ConversationID: toConversationID,
+								PinnedTodos: []assistant.PinnedTodo{
+									{ID: createdTodoID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+								},
+								CreatedAt: fixedTime,
+							}, snapshot)
+					})).
+					Return(nil).
+					Once()
+			},
+			expected: []assistant.PinnedTodo{
+				{ID: createdTodoID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+			},
+		},
+		"skips-store-without-pinned-todos": {
+			setExpectations: func(
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				changeRepo *todo.MockChangeRepository,
+				_ *todo.MockRepository,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, fromConversationID).
+					Return(assistant.ConversationSnapshot{}, false, nil).
+					Once()
+				changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(0), &fromConversationID, SNAPSHOT_CHANGES_PAGE_SIZE).
+					Return([]todo.Change{}, false, nil).
+					Once()
+			},
+			expected: []assistant.PinnedTodo{},
+		},
+		"get-latest-snapshot-error": {
+			setExpectations: func(
+				snapshotRepo *assistant.MockConversationSnapshotRepository,
+				_ *todo.MockChangeRepository,
+				_ *todo.MockRepository,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				snapshotRepo.EXPECT().
+					GetLatestConversationSnapshot(mock.Anything, fromConversationID).
+					Return(assistant.ConversationSnapshot{}, false, errors.New("db error")).
+					Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			snapshotRepo := assistant.NewMockConversationSnapshotRepository(t)
+			changeRepo := todo.NewMockChangeRepository(t)
+			todoRepo := todo.NewMockRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(snapshotRepo, changeRepo, todoRepo, timeProvider)
+
+			snapshotter := NewConversationSnapshotterImpl(summaryRepo, snapshotRepo, changeRepo, todoRepo, timeProvider)
+			got, err := snapshotter.CarryOver(t.Context(), fromConversationID, toConversationID)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
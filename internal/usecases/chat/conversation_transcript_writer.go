@@ -2,6 +2,7 @@ package chat
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strings"
 	"time"
@@ -13,28 +14,42 @@ import (
 	"github.com/google/uuid"
 )
 
+// contentBlobPreviewMaxChars is the size of the preview kept inline for an action result stored as a content blob.
+const contentBlobPreviewMaxChars = 1000
+
 // ConversationTranscriptWriter persists chat transcript entries and repairs persisted turn history when needed.
 type ConversationTranscriptWriter interface {
 	// WriteMessage persists one chat message and its related conversation side effects.
 	WriteMessage(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage) error
-	// RepairTurnTranscript removes dangling persisted action-call history from a failed turn.
+	// WriteCheckpoint persists the partial content of an assistant message still being streamed, replacing its
+	// previous checkpoint. It has none of the conversation side effects of WriteMessage.
+	WriteCheckpoint(ctx context.Context, message assistant.ChatMessage) error
+	// RepairTurnTranscript removes dangling persisted action-call history and streaming checkpoints from a failed turn.
 	RepairTurnTranscript(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) error
 }
 
 // ConversationTranscriptWriterImpl implements ConversationTranscriptWriter.
 type ConversationTranscriptWriterImpl struct {
-	uow       transaction.UnitOfWork
-	tokenizer assistant.Tokenizer
+	uow                  transaction.UnitOfWork
+	tokenizer            assistant.Tokenizer
+	redactor             assistant.Redactor
+	contentBlobThreshold int
 }
 
 // NewConversationTranscriptWriterImpl creates a ConversationTranscriptWriterImpl.
+// A nil redactor persists message content as is. Action results larger than contentBlobThreshold bytes are
+// stored as content blobs; a zero threshold keeps every result inline.
 func NewConversationTranscriptWriterImpl(
 	uow transaction.UnitOfWork,
 	tokenizer assistant.Tokenizer,
+	redactor assistant.Redactor,
+	contentBlobThreshold int,
 ) ConversationTranscriptWriterImpl {
 	return ConversationTranscriptWriterImpl{
-		uow:       uow,
-		tokenizer: tokenizer,
+		uow:                  uow,
+		tokenizer:            tokenizer,
+		redactor:             redactor,
+		contentBlobThreshold: contentBlobThreshold,
 	}
 }
 
@@ -47,9 +62,20 @@ func (p ConversationTranscriptWriterImpl) WriteMessage(
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
+	message, err := p.redact(message)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
 	message.ContextTokensEstimate = p.estimateContextTokens(spanCtx, message)
+	message, blob := p.offloadContent(message)
 
 	return p.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if blob != nil {
+			if err := scope.ContentBlob().SaveContentBlob(uowCtx, *blob); err != nil {
+				return err
+			}
+		}
+
 		if err := scope.ChatMessage().CreateChatMessages(uowCtx, []assistant.ChatMessage{message}); err != nil {
 			return err
 		}
@@ -79,6 +105,16 @@ func (p ConversationTranscriptWriterImpl) WriteMessage(
 	})
 }
 
+// WriteCheckpoint implements ConversationTranscriptWriter.
+func (p ConversationTranscriptWriterImpl) WriteCheckpoint(ctx context.Context, message assistant.ChatMessage) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	return p.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		return scope.ChatMessage().CreateChatMessages(uowCtx, []assistant.ChatMessage{message})
+	})
+}
+
 // RepairTurnTranscript implements ConversationTranscriptWriter.
 func (p ConversationTranscriptWriterImpl) RepairTurnTranscript(
 	ctx context.Context,
@@ -95,6 +131,7 @@ func (p ConversationTranscriptWriterImpl) RepairTurnTranscript(
 		}
 
 		danglingMessageIDs := danglingAssistantActionCallMessageIDs(messages, turnID)
+		danglingMessageIDs = append(danglingMessageIDs, streamingCheckpointMessageIDs(messages, turnID)...)
 		if len(danglingMessageIDs) == 0 {
 			return nil
 		}
@@ -120,6 +157,32 @@ func (p ConversationTranscriptWriterImpl) RepairTurnTranscript(
 	})
 }
 
+// redact masks sensitive content of user messages and tool results before they are persisted.
+// The current turn keeps working with the original content; only the stored transcript is redacted.
+func (p ConversationTranscriptWriterImpl) redact(message assistant.ChatMessage) (assistant.ChatMessage, error) {
+	if p.redactor == nil || (message.ChatRole != assistant.ChatRole_User && message.ChatRole != assistant.ChatRole_Tool) {
+		return message, nil
+	}
+	content, err := p.redactor.Redact(message.Content)
+	if err != nil {
+		return assistant.ChatMessage{}, fmt.Errorf("failed to redact chat message: %w", err)
+	}
+	message.Content = content
+	return message, nil
+}
+
+// offloadContent moves the content of an action result larger than the content blob threshold to a content
+// blob, keeping a preview of it and the blob hash in the message. It returns nil when the content stays inline.
+func (p ConversationTranscriptWriterImpl) offloadContent(message assistant.ChatMessage) (assistant.ChatMessage, *assistant.ContentBlob) {
+	if p.contentBlobThreshold <= 0 || message.ChatRole != assistant.ChatRole_Tool || len(message.Content) <= p.contentBlobThreshold {
+		return message, nil
+	}
+	blob := assistant.NewContentBlob(message.Content, message.CreatedAt)
+	message.Content = truncateToFirstChars(message.Content, contentBlobPreviewMaxChars)
+	message.ContentRef = &blob.Hash
+	return message, &blob
+}
+
 // estimateContextTokens computes the persisted context footprint for a chat message.
 func (p ConversationTranscriptWriterImpl) estimateContextTokens(ctx context.Context, message assistant.ChatMessage) int {
 	input := assistant.BuildChatMessageTokenizationInput(message)
@@ -171,6 +234,17 @@ func danglingAssistantActionCallMessageIDs(
 	return danglingMessageIDs
 }
 
+// streamingCheckpointMessageIDs returns the streaming checkpoints of one turn, which its failure message replaces.
+func streamingCheckpointMessageIDs(messages []assistant.ChatMessage, turnID uuid.UUID) []uuid.UUID {
+	checkpointIDs := make([]uuid.UUID, 0)
+	for _, message := range messages {
+		if message.TurnID == turnID && message.IsStreaming() {
+			checkpointIDs = append(checkpointIDs, message.ID)
+		}
+	}
+	return checkpointIDs
+}
+
 // updateConversationAfterMessageDeletion recalculates message timestamps after cancellation repair deletes dangling rows.
 func updateConversationAfterMessageDeletion(
 	conversation *assistant.Conversation,
@@ -198,3 +272,11 @@ func updateConversationAfterMessageDeletion(
 		conversation.UpdatedAt = *latestMessageAt
 	}
 }
+
+// writeTurnMessage persists a message of the turn and records the time the write took as persistence latency.
+func writeTurnMessage(ctx context.Context, writer ConversationTranscriptWriter, state TurnState, message assistant.ChatMessage) error {
+	startedAt := time.Now()
+	err := writer.WriteMessage(ctx, state.Conversation(), message)
+	state.RecordLatency(TurnPhase_Persistence, time.Since(startedAt))
+	return err
+}
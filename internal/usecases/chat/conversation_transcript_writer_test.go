@@ -2,6 +2,8 @@ package chat
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -40,8 +43,8 @@ func TestConversationTranscriptWriter_WriteMessage(t *testing.T) {
 		},
 	})
 
-	writer := NewConversationTranscriptWriterImpl(uow, nil)
-	state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 7)
+	writer := NewConversationTranscriptWriterImpl(uow, nil, nil, 0)
+	state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 7, nil)
 
 	userMessage := assistant.ChatMessage{
 		ID:             uuid.New(),
@@ -85,6 +88,7 @@ func TestConversationTranscriptWriter_RepairTurnTranscript(t *testing.T) {
 	userMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000011")
 	danglingAssistantMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000012")
 	otherTurnMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000013")
+	checkpointMessageID := uuid.MustParse("00000000-0000-0000-0000-000000000014")
 	actionCallID := "func-123"
 	userCreatedAt := time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC)
 	danglingCreatedAt := userCreatedAt.Add(5 * time.Second)
@@ -126,6 +130,15 @@ func TestConversationTranscriptWriter_RepairTurnTranscript(t *testing.T) {
 				},
 				CreatedAt: danglingCreatedAt,
 			},
+			{
+				ID:             checkpointMessageID,
+				ConversationID: conversationID,
+				TurnID:         turnID,
+				ChatRole:       assistant.ChatRole_Assistant,
+				Content:        "partial answer",
+				MessageState:   assistant.ChatMessageState_Streaming,
+				CreatedAt:      danglingCreatedAt,
+			},
 			{
 				ID:             otherTurnMessageID,
 				ConversationID: conversationID,
@@ -138,7 +151,7 @@ func TestConversationTranscriptWriter_RepairTurnTranscript(t *testing.T) {
 		Once()
 
 	chatRepo.EXPECT().
-		DeleteChatMessages(mock.Anything, []uuid.UUID{danglingAssistantMessageID}).
+		DeleteChatMessages(mock.Anything, []uuid.UUID{danglingAssistantMessageID, checkpointMessageID}).
 		Return(nil).
 		Once()
 
@@ -165,9 +178,242 @@ func TestConversationTranscriptWriter_RepairTurnTranscript(t *testing.T) {
 		Return(nil).
 		Once()
 
-	writer := NewConversationTranscriptWriterImpl(uow, nil)
+	writer := NewConversationTranscriptWriterImpl(uow, nil, nil, 0)
 	err := writer.RepairTurnTranscript(t.Context(), conversationID, turnID)
 	if err != nil {
 		t.Fatalf("RepairTurnTranscript returned error: %v", err)
 	}
 }
+
+func TestConversationTranscriptWriter_WriteCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	checkpoint := assistant.ChatMessage{
+		ID:             uuid.MustParse("00000000-0000-0000-0000-000000000021"),
+		ConversationID: uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ChatRole:       assistant.ChatRole_Assistant,
+		Content:        "partial answer",
+		MessageState:   assistant.ChatMessageState_Streaming,
+	}
+
+	tests := map[string]struct {
+		createErr   error
+		expectedErr error
+	}{
+		"success": {},
+		"create-error": {
+			createErr:   errors.New("db error"),
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			uow := transaction.NewMockUnitOfWork(t)
+			scope := transaction.NewMockScope(t)
+
+			uow.EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+					return fn(ctx, scope)
+				}).
+				Once()
+			scope.EXPECT().ChatMessage().Return(chatRepo).Once()
+			chatRepo.EXPECT().
+				CreateChatMessages(mock.Anything, []assistant.ChatMessage{checkpoint}).
+				Return(tt.createErr).
+				Once()
+
+			writer := NewConversationTranscriptWriterImpl(uow, nil, nil, 0)
+			err := writer.WriteCheckpoint(t.Context(), checkpoint)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestConversationTranscriptWriter_WriteMessage_RedactsContent(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+
+	tests := map[string]struct {
+		role            assistant.ChatRole
+		setExpectations func(*assistant.MockRedactor)
+		expectedContent string
+		expectErr       bool
+	}{
+		"user-message-is-redacted": {
+			role: assistant.ChatRole_User,
+			setExpectations: func(redactor *assistant.MockRedactor) {
+				redactor.EXPECT().Redact("mail jane@example.com").Return("mail [REDACTED_EMAIL]", nil).Once()
+			},
+			expectedContent: "mail [REDACTED_EMAIL]",
+		},
+		"tool-result-is-redacted": {
+			role: assistant.ChatRole_Tool,
+			setExpectations: func(redactor *assistant.MockRedactor) {
+				redactor.EXPECT().Redact("mail jane@example.com").Return("mail [REDACTED_EMAIL]", nil).Once()
+			},
+			expectedContent: "mail [REDACTED_EMAIL]",
+		},
+		"assistant-message-is-kept": {
+			role:            assistant.ChatRole_Assistant,
+			setExpectations: func(*assistant.MockRedactor) {},
+			expectedContent: "mail jane@example.com",
+		},
+		"redaction-error": {
+			role: assistant.ChatRole_User,
+			setExpectations: func(redactor *assistant.MockRedactor) {
+				redactor.EXPECT().Redact("mail jane@example.com").Return("", errors.New("seal failed")).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			redactor := assistant.NewMockRedactor(t)
+			tt.setExpectations(redactor)
+			uow := transaction.NewMockUnitOfWork(t)
+			scope := transaction.NewMockScope(t)
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			conversationRepo := assistant.NewMockConversationRepository(t)
+			outboxRepo := outbox.NewMockRepository(t)
+
+			if !tt.expectErr {
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).
+					Once()
+				scope.EXPECT().ChatMessage().Return(chatRepo).Once()
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+				scope.EXPECT().Conversation().Return(conversationRepo).Once()
+				chatRepo.EXPECT().
+					CreateChatMessages(mock.Anything, mock.MatchedBy(func(messages []assistant.ChatMessage) bool {
+						return len(messages) == 1 && messages[0].Content == tt.expectedContent
+					})).
+					Return(nil).
+					Once()
+				outboxRepo.EXPECT().CreateChatEvent(mock.Anything, mock.Anything).Return(nil).Once()
+				conversationRepo.EXPECT().UpdateConversation(mock.Anything, mock.Anything).Return(nil).Once()
+			}
+
+			writer := NewConversationTranscriptWriterImpl(uow, nil, redactor, 0)
+			err := writer.WriteMessage(t.Context(), conversation, assistant.ChatMessage{
+				ID:             uuid.New(),
+				ConversationID: conversation.ID,
+				ChatRole:       tt.role,
+				Content:        "mail jane@example.com",
+			})
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConversationTranscriptWriter_WriteMessage_OffloadsLargeActionResults(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	createdAt := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+	largeContent := strings.Repeat("x", contentBlobPreviewMaxChars+1)
+	largeBlob := assistant.NewContentBlob(largeContent, createdAt)
+
+	tests := map[string]struct {
+		role            assistant.ChatRole
+		content         string
+		saveErr         error
+		expectedBlob    *assistant.ContentBlob
+		expectedContent string
+		expectedRef     *string
+		expectErr       bool
+	}{
+		"large-action-result": {
+			role:            assistant.ChatRole_Tool,
+			content:         largeContent,
+			expectedBlob:    &largeBlob,
+			expectedContent: largeContent[:contentBlobPreviewMaxChars],
+			expectedRef:     &largeBlob.Hash,
+		},
+		"small-action-result": {
+			role:            assistant.ChatRole_Tool,
+			content:         "small",
+			expectedContent: "small",
+		},
+		"large-assistant-message": {
+			role:            assistant.ChatRole_Assistant,
+			content:         largeContent,
+			expectedContent: largeContent,
+		},
+		"save-error": {
+			role:         assistant.ChatRole_Tool,
+			content:      largeContent,
+			saveErr:      errors.New("db error"),
+			expectedBlob: &largeBlob,
+			expectErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			scope := transaction.NewMockScope(t)
+			uow.EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+					return fn(ctx, scope)
+				}).
+				Once()
+
+			if tt.expectedBlob != nil {
+				blobRepo := assistant.NewMockContentBlobRepository(t)
+				scope.EXPECT().ContentBlob().Return(blobRepo).Once()
+				blobRepo.EXPECT().SaveContentBlob(mock.Anything, *tt.expectedBlob).Return(tt.saveErr).Once()
+			}
+			if !tt.expectErr {
+				chatRepo := assistant.NewMockChatMessageRepository(t)
+				conversationRepo := assistant.NewMockConversationRepository(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				scope.EXPECT().ChatMessage().Return(chatRepo).Once()
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+				scope.EXPECT().Conversation().Return(conversationRepo).Once()
+				chatRepo.EXPECT().
+					CreateChatMessages(mock.Anything, mock.MatchedBy(func(messages []assistant.ChatMessage) bool {
+						return len(messages) == 1 &&
+							messages[0].Content == tt.expectedContent &&
+							assert.ObjectsAreEqual(tt.expectedRef, messages[0].ContentRef)
+					})).
+					Return(nil).
+					Once()
+				outboxRepo.EXPECT().CreateChatEvent(mock.Anything, mock.Anything).Return(nil).Once()
+				conversationRepo.EXPECT().UpdateConversation(mock.Anything, mock.Anything).Return(nil).Once()
+			}
+
+			writer := NewConversationTranscriptWriterImpl(uow, nil, nil, contentBlobPreviewMaxChars)
+			err := writer.WriteMessage(t.Context(), conversation, assistant.ChatMessage{
+				ID:             uuid.New(),
+				ConversationID: conversation.ID,
+				ChatRole:       tt.role,
+				Content:        tt.content,
+				CreatedAt:      createdAt,
+			})
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// CountUnreadMessages returns the unread message counts of conversations for the caller.
+type CountUnreadMessages interface {
+	// Query returns how many messages of each given conversation the caller has not read, keyed by conversation ID.
+	// Conversations without unread messages are missing from the map.
+	Query(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+}
+
+// CountUnreadMessagesImpl implements CountUnreadMessages.
+type CountUnreadMessagesImpl struct {
+	readCursorRepo assistant.ConversationReadCursorRepository
+}
+
+// NewCountUnreadMessagesImpl creates a CountUnreadMessagesImpl.
+func NewCountUnreadMessagesImpl(readCursorRepo assistant.ConversationReadCursorRepository) CountUnreadMessagesImpl {
+	return CountUnreadMessagesImpl{
+		readCursorRepo: readCursorRepo,
+	}
+}
+
+// Query implements CountUnreadMessages.
+func (uc CountUnreadMessagesImpl) Query(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	reader := access.FromContext(spanCtx).Subject()
+	unread, err := uc.readCursorRepo.CountUnreadMessages(spanCtx, reader, conversationIDs)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	return unread, nil
+}
@@ -0,0 +1,53 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCountUnreadMessagesImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	c1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	c2 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+
+	tests := map[string]struct {
+		setExpectations func(*assistant.MockConversationReadCursorRepository)
+		expected        map[uuid.UUID]int64
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *assistant.MockConversationReadCursorRepository) {
+				repo.EXPECT().CountUnreadMessages(mock.Anything, "principal:system", []uuid.UUID{c1, c2}).
+					Return(map[uuid.UUID]int64{c2: 3}, nil)
+			},
+			expected: map[uuid.UUID]int64{c2: 3},
+		},
+		"repository-error": {
+			setExpectations: func(repo *assistant.MockConversationReadCursorRepository) {
+				repo.EXPECT().CountUnreadMessages(mock.Anything, "principal:system", []uuid.UUID{c1, c2}).
+					Return(nil, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockConversationReadCursorRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewCountUnreadMessagesImpl(repo)
+			got, err := uc.Query(t.Context(), []uuid.UUID{c1, c2})
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -45,9 +45,16 @@ func (uc *DeleteConversationImpl) Execute(ctx context.Context, conversationID uu
 		if err := scope.ChatMessage().DeleteConversationMessages(uowCtx, conversationID); err != nil {
 			return err
 		}
+		// Content blobs are shared by identical action results, so only the ones left unreferenced go.
+		if err := scope.ContentBlob().DeleteUnreferencedContentBlobs(uowCtx); err != nil {
+			return err
+		}
 		if err := scope.ConversationSummary().DeleteConversationSummary(uowCtx, conversationID); err != nil {
 			return err
 		}
+		if err := scope.ConversationSnapshot().DeleteConversationSnapshots(uowCtx, conversationID); err != nil {
+			return err
+		}
 		if err := scope.Conversation().DeleteConversation(uowCtx, conversationID); err != nil {
 			return err
 		}
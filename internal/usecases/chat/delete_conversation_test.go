@@ -43,10 +43,20 @@ func TestDeleteConversationImpl_Execute(t *testing.T) {
 					DeleteConversationMessages(mock.Anything, fixedConversationID).
 					Return(nil).
 					Once()
+				blobRepo := assistant.NewMockContentBlobRepository(t)
+				blobRepo.EXPECT().
+					DeleteUnreferencedContentBlobs(mock.Anything).
+					Return(nil).
+					Once()
 				summaryRepo.EXPECT().
 					DeleteConversationSummary(mock.Anything, fixedConversationID).
 					Return(nil).
 					Once()
+				snapshotRepo := assistant.NewMockConversationSnapshotRepository(t)
+				snapshotRepo.EXPECT().
+					DeleteConversationSnapshots(mock.Anything, fixedConversationID).
+					Return(nil).
+					Once()
 				convRepo.EXPECT().
 					DeleteConversation(mock.Anything, fixedConversationID).
 					Return(nil).
@@ -54,7 +64,9 @@ func TestDeleteConversationImpl_Execute(t *testing.T) {
 
 				scope := transaction.NewMockScope(t)
 				scope.EXPECT().ChatMessage().Return(repo).Once()
+				scope.EXPECT().ContentBlob().Return(blobRepo).Once()
 				scope.EXPECT().ConversationSummary().Return(summaryRepo).Once()
+				scope.EXPECT().ConversationSnapshot().Return(snapshotRepo).Once()
 				scope.EXPECT().Conversation().Return(convRepo).Twice()
 
 				uow.EXPECT().
@@ -0,0 +1,396 @@
+package chat
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	// MEMORY_EXTRACTION_PAGE_SIZE is the number of conversations scanned per page.
+	MEMORY_EXTRACTION_PAGE_SIZE = 50
+	// MEMORY_EXTRACTION_LOOKBACK bounds the scan to conversations with a message in this period.
+	MEMORY_EXTRACTION_LOOKBACK = 7 * 24 * time.Hour
+	// MAX_CHAT_MESSAGES_FOR_MEMORY is the number of recent messages mined from one conversation.
+	MAX_CHAT_MESSAGES_FOR_MEMORY = 40
+	// MAX_KNOWN_MEMORIES_IN_PROMPT is the number of known memories shown to the model to avoid duplicates.
+	MAX_KNOWN_MEMORIES_IN_PROMPT = 50
+
+	// MEMORY_EXTRACTION_MAX_TOKENS is the maximum token budget for memory extraction.
+	MEMORY_EXTRACTION_MAX_TOKENS = 400
+	// MEMORY_EXTRACTION_TEMPERATURE controls generation randomness for memory extraction.
+	MEMORY_EXTRACTION_TEMPERATURE = 0.1
+
+	// MEMORY_MIN_CONFIDENCE is the confidence below which an extracted memory is discarded.
+	MEMORY_MIN_CONFIDENCE = 0.7
+	// MEMORY_DUPLICATE_MAX_DISTANCE is the cosine distance under which an extracted memory repeats a stored one.
+	MEMORY_DUPLICATE_MAX_DISTANCE = 0.1
+)
+
+//go:embed prompts/memory-extraction.yml
+var memoryExtractionPrompt embed.FS
+
+// memoryExtractionSchema is the structured response requested from the model.
+var memoryExtractionSchema = &assistant.ResponseSchema{
+	Name: "memory_extraction",
+	Input: assistant.ActionInput{
+		Type: "object",
+		Fields: map[string]assistant.ActionField{
+			"memories": {
+				Type:        "array",
+				Description: "Durable facts and preferences about the user. Empty when nothing qualifies.",
+				Required:    true,
+				Items: &assistant.ActionField{
+					Type: "object",
+					Fields: map[string]assistant.ActionField{
+						"kind":       {Type: "string", Enum: []any{"fact", "preference"}, Required: true},
+						"text":       {Type: "string", Description: "One short third-person sentence.", Required: true},
+						"confidence": {Type: "number", Description: "Confidence from 0 to 1 that the memory is durable.", Required: true},
+					},
+				},
+			},
+		},
+	},
+}
+
+// ExtractMemories mines completed conversations for durable facts and preferences about the user.
+type ExtractMemories interface {
+	// Execute mines the conversations idle since the last extraction and returns the number of memories stored.
+	Execute(ctx context.Context) (int, error)
+}
+
+// ExtractMemoriesImpl implements ExtractMemories.
+type ExtractMemoriesImpl struct {
+	conversationRepo assistant.ConversationRepository
+	chatMessageRepo  assistant.ChatMessageRepository
+	memoryRepo       assistant.MemoryRepository
+	lock             core.Locker
+	timeProvider     core.CurrentTimeProvider
+	assistant        assistant.Assistant
+	encoder          semantic.Encoder
+	model            string
+	embeddingModel   string
+	idle             time.Duration
+}
+
+// NewExtractMemoriesImpl creates an ExtractMemoriesImpl. A conversation is completed once it had no
+// message for the idle duration.
+func NewExtractMemoriesImpl(
+	conversationRepo assistant.ConversationRepository,
+	chatMessageRepo assistant.ChatMessageRepository,
+	memoryRepo assistant.MemoryRepository,
+	lock core.Locker,
+	timeProvider core.CurrentTimeProvider,
+	assistantClient assistant.Assistant,
+	encoder semantic.Encoder,
+	model string,
+	embeddingModel string,
+	idle time.Duration,
+) ExtractMemoriesImpl {
+	return ExtractMemoriesImpl{
+		conversationRepo: conversationRepo,
+		chatMessageRepo:  chatMessageRepo,
+		memoryRepo:       memoryRepo,
+		lock:             lock,
+		timeProvider:     timeProvider,
+		assistant:        assistantClient,
+		encoder:          encoder,
+		model:            model,
+		embeddingModel:   embeddingModel,
+		idle:             idle,
+	}
+}
+
+// Execute implements ExtractMemories. A failed conversation is retried on the next run and does not
+// stop the others.
+func (uc ExtractMemoriesImpl) Execute(ctx context.Context) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := uc.timeProvider.Now()
+	idleSince := now.Add(-uc.idle)
+	oldest := now.Add(-MEMORY_EXTRACTION_LOOKBACK)
+
+	stored := 0
+	var errs []error
+	for page := 1; ; page++ {
+		conversations, hasMore, err := uc.conversationRepo.ListConversations(spanCtx, page, MEMORY_EXTRACTION_PAGE_SIZE)
+		if telemetry.IsErrorRecorded(span, err) {
+			return stored, fmt.Errorf("failed to list conversations: %w", err)
+		}
+
+		for _, conversation := range conversations {
+			// Conversations are ordered by last message, most recent first.
+			if conversation.LastMessageAt == nil || conversation.LastMessageAt.Before(oldest) {
+				return stored, errors.Join(errs...)
+			}
+			if conversation.LastMessageAt.After(idleSince) {
+				continue
+			}
+
+			count, err := uc.extractConversation(spanCtx, conversation)
+			if telemetry.IsErrorRecorded(span, err) {
+				errs = append(errs, fmt.Errorf("conversation %s: %w", conversation.ID, err))
+				continue
+			}
+			stored += count
+		}
+
+		if !hasMore {
+			return stored, errors.Join(errs...)
+		}
+	}
+}
+
+// extractConversation mines the messages recorded since the extraction cursor of the conversation and
+// stores the memories that pass vetting.
+func (uc ExtractMemoriesImpl) extractConversation(ctx context.Context, conversation assistant.Conversation) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("conversation_id", conversation.ID.String()),
+	))
+	defer span.End()
+
+	unlock, locked, err := uc.lock.TryLock(spanCtx, "memory-extraction:"+conversation.ID.String())
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire memory extraction lock: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+	defer unlock()
+
+	cursor, found, err := uc.memoryRepo.GetMemoryExtractionCursor(spanCtx, conversation.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get memory extraction cursor: %w", err)
+	}
+	if found && !conversation.LastMessageAt.After(cursor) {
+		return 0, nil
+	}
+
+	messages, _, err := uc.chatMessageRepo.ListChatMessages(spanCtx, conversation.ID, 1, MAX_CHAT_MESSAGES_FOR_MEMORY)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list chat messages: %w", err)
+	}
+	extractedThrough := *conversation.LastMessageAt
+	if len(messages) > 0 {
+		extractedThrough = messages[len(messages)-1].CreatedAt
+	}
+	if found {
+		messages = messagesAfter(messages, cursor)
+	}
+
+	stored := 0
+	existing, err := uc.memoryRepo.ListMemories(spanCtx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list memories: %w", err)
+	}
+	transcript := formatMessagesForMemoryExtraction(messages)
+	switch {
+	case transcript == "":
+		span.AddEvent("No new user messages to mine")
+	case len(existing) >= assistant.MaxMemories:
+		span.AddEvent("Memory limit reached, skipping extraction")
+	default:
+		stored, err = uc.storeMemories(spanCtx, conversation.ID, existing, transcript)
+		if err != nil {
+			return stored, err
+		}
+	}
+
+	if err := uc.memoryRepo.SaveMemoryExtractionCursor(spanCtx, conversation.ID, extractedThrough); err != nil {
+		return stored, fmt.Errorf("failed to save memory extraction cursor: %w", err)
+	}
+
+	return stored, nil
+}
+
+// storeMemories asks the model for memory candidates and stores the ones that pass vetting: a known kind,
+// enough confidence, valid text and no near-duplicate among the stored memories.
+func (uc ExtractMemoriesImpl) storeMemories(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	existing []assistant.Memory,
+	transcript string,
+) (int, error) {
+	candidates, err := uc.proposeMemories(ctx, existing, transcript)
+	if err != nil {
+		return 0, err
+	}
+
+	span := trace.SpanFromContext(ctx)
+	stored := 0
+	for _, candidate := range candidates {
+		if len(existing)+stored >= assistant.MaxMemories {
+			break
+		}
+		if candidate.Confidence < MEMORY_MIN_CONFIDENCE {
+			span.AddEvent("Memory rejected for low confidence", trace.WithAttributes(attribute.String("memory", candidate.Text)))
+			continue
+		}
+
+		memory := assistant.Memory{
+			ID:                   uuid.New(),
+			Kind:                 assistant.MemoryKind(strings.ToLower(strings.TrimSpace(candidate.Kind))),
+			Text:                 strings.TrimSpace(candidate.Text),
+			SourceConversationID: &conversationID,
+			CreatedAt:            uc.timeProvider.Now(),
+		}
+		if !memory.Kind.IsValid() || memory.Text == "" {
+			continue
+		}
+
+		vector, err := uc.encoder.VectorizeQuery(ctx, uc.embeddingModel, memory.Text)
+		if err != nil {
+			return stored, fmt.Errorf("failed to embed memory: %w", err)
+		}
+		metrics.RecordLLMTokensEmbedding(ctx, vector.TotalTokens)
+		memory.Embedding = vector.Vector
+
+		if err := memory.Validate(); err != nil {
+			span.AddEvent("Memory rejected by validation", trace.WithAttributes(attribute.String("error", err.Error())))
+			continue
+		}
+
+		duplicates, err := uc.memoryRepo.SearchMemories(ctx, memory.Embedding, 1, MEMORY_DUPLICATE_MAX_DISTANCE)
+		if err != nil {
+			return stored, fmt.Errorf("failed to search memories: %w", err)
+		}
+		if len(duplicates) > 0 {
+			continue
+		}
+
+		if err := uc.memoryRepo.CreateMemory(ctx, memory); err != nil {
+			return stored, fmt.Errorf("failed to store memory: %w", err)
+		}
+		stored++
+	}
+
+	return stored, nil
+}
+
+// memoryCandidate is one memory proposed by the model.
+type memoryCandidate struct {
+	Kind       string  `json:"kind"`
+	Text       string  `json:"text"`
+	Confidence float64 `json:"confidence"`
+}
+
+// proposeMemories runs the extraction prompt and parses the proposed memories.
+func (uc ExtractMemoriesImpl) proposeMemories(ctx context.Context, existing []assistant.Memory, transcript string) ([]memoryCandidate, error) {
+	promptMessages, err := buildMemoryExtractionPrompt(existing, transcript)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build memory extraction prompt: %w", err)
+	}
+
+	resp, err := uc.assistant.RunTurnSync(ctx, assistant.TurnRequest{
+		Model:          uc.model,
+		Messages:       promptMessages,
+		Stream:         false,
+		MaxTokens:      common.Ptr(MEMORY_EXTRACTION_MAX_TOKENS),
+		Temperature:    common.Ptr(MEMORY_EXTRACTION_TEMPERATURE),
+		ResponseSchema: memoryExtractionSchema,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract memories: %w", err)
+	}
+	metrics.RecordLLMTokensUsed(ctx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+
+	return parseMemoryCandidates(resp.Content), nil
+}
+
+// buildMemoryExtractionPrompt loads the prompt template and injects the known memories and the transcript.
+func buildMemoryExtractionPrompt(existing []assistant.Memory, transcript string) ([]assistant.Message, error) {
+	file, err := memoryExtractionPrompt.Open("prompts/memory-extraction.yml")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() //nolint:errcheck
+
+	prompt := []assistant.Message{}
+	if err := yaml.NewDecoder(file).Decode(&prompt); err != nil {
+		return nil, err
+	}
+
+	known := "none"
+	if len(existing) > 0 {
+		lines := make([]string, 0, min(len(existing), MAX_KNOWN_MEMORIES_IN_PROMPT))
+		for _, memory := range existing[:min(len(existing), MAX_KNOWN_MEMORIES_IN_PROMPT)] {
+			lines = append(lines, "- "+memory.Text)
+		}
+		known = strings.Join(lines, "\n")
+	}
+
+	for i, msg := range prompt {
+		if strings.Contains(msg.Content, "%[") {
+			prompt[i].Content = fmt.Sprintf(msg.Content, known, transcript)
+		}
+	}
+
+	return prompt, nil
+}
+
+// parseMemoryCandidates extracts the proposed memories from the model response, ignoring malformed output.
+func parseMemoryCandidates(content string) []memoryCandidate {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end <= start {
+		return nil
+	}
+
+	var resp struct {
+		Memories []memoryCandidate `json:"memories"`
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), &resp); err != nil {
+		return nil
+	}
+	return resp.Memories
+}
+
+// messagesAfter returns the messages created after the cursor.
+func messagesAfter(messages []assistant.ChatMessage, cursor time.Time) []assistant.ChatMessage {
+	out := make([]assistant.ChatMessage, 0, len(messages))
+	for _, message := range messages {
+		if message.CreatedAt.After(cursor) {
+			out = append(out, message)
+		}
+	}
+	return out
+}
+
+// formatMessagesForMemoryExtraction renders the user and assistant messages as a transcript, or "" when
+// there is no user message to mine.
+func formatMessagesForMemoryExtraction(messages []assistant.ChatMessage) string {
+	lines := make([]string, 0, len(messages))
+	hasUserMessage := false
+	for _, message := range messages {
+		if (message.ChatRole != assistant.ChatRole_User && message.ChatRole != assistant.ChatRole_Assistant) ||
+			message.IsModerated() || message.IsSuperseded() || message.IsStreaming() {
+			continue
+		}
+		content := strings.Join(strings.Fields(message.Content), " ")
+		if content == "" {
+			continue
+		}
+		hasUserMessage = hasUserMessage || message.ChatRole == assistant.ChatRole_User
+		lines = append(lines, fmt.Sprintf("%s: %s", message.ChatRole, clampRunes(content, MAX_PROMPT_MESSAGE_CHARS*2)))
+	}
+	if !hasUserMessage {
+		return ""
+	}
+	return strings.Join(lines, "\n")
+}
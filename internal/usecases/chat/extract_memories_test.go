@@ -0,0 +1,205 @@
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// extractMemoriesMocks groups the dependencies of ExtractMemoriesImpl.
+type extractMemoriesMocks struct {
+	conversations *assistant.MockConversationRepository
+	messages      *assistant.MockChatMessageRepository
+	memories      *assistant.MockMemoryRepository
+	lock          *core.MockLocker
+	assistant     *assistant.MockAssistant
+	encoder       *semantic.MockEncoder
+}
+
+func TestExtractMemoriesImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	lastMessageAt := now.Add(-2 * time.Hour)
+	idleConversation := assistant.Conversation{ID: conversationID, LastMessageAt: &lastMessageAt}
+	lockKey := "memory-extraction:" + conversationID.String()
+	messages := []assistant.ChatMessage{
+		{ChatRole: assistant.ChatRole_User, Content: "I only work out in the mornings, add a run tomorrow", CreatedAt: now.Add(-3 * time.Hour)},
+		{ChatRole: assistant.ChatRole_Tool, Content: `{"created":1}`, CreatedAt: now.Add(-150 * time.Minute)},
+		{ChatRole: assistant.ChatRole_Assistant, Content: "Added 'Morning run' for tomorrow.", CreatedAt: lastMessageAt},
+	}
+	vector := []float64{0.1, 0.2}
+	fullMemories := make([]assistant.Memory, assistant.MaxMemories)
+	for i := range fullMemories {
+		fullMemories[i] = assistant.Memory{ID: uuid.New(), Text: fmt.Sprintf("memory %d", i)}
+	}
+
+	expectLocked := func(m extractMemoriesMocks) {
+		m.conversations.EXPECT().ListConversations(mock.Anything, 1, MEMORY_EXTRACTION_PAGE_SIZE).
+			Return([]assistant.Conversation{idleConversation}, false, nil).Once()
+		m.lock.EXPECT().TryLock(mock.Anything, lockKey).Return(func() {}, true, nil).Once()
+	}
+
+	tests := map[string]struct {
+		setExpectations func(m extractMemoriesMocks)
+		expectedStored  int
+		expectedErr     string
+	}{
+		"stores-vetted-memories": {
+			setExpectations: func(m extractMemoriesMocks) {
+				expectLocked(m)
+				m.memories.EXPECT().GetMemoryExtractionCursor(mock.Anything, conversationID).Return(time.Time{}, false, nil).Once()
+				m.messages.EXPECT().ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_MESSAGES_FOR_MEMORY).Return(messages, false, nil).Once()
+				m.memories.EXPECT().ListMemories(mock.Anything).Return([]assistant.Memory{{Text: "Has a dog named Rex"}}, nil).Once()
+				m.assistant.EXPECT().RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+					prompt := req.Messages[0].Content
+					return req.Model == "memory-model" && req.ResponseSchema == memoryExtractionSchema &&
+						assert.Contains(t, prompt, "- Has a dog named Rex") &&
+						assert.Contains(t, prompt, "user: I only work out in the mornings, add a run tomorrow\nassistant: Added 'Morning run' for tomorrow.") &&
+						assert.NotContains(t, prompt, `{"created":1}`)
+				})).Return(assistant.TurnResponse{Content: `{"memories":[` +
+					`{"kind":"preference","text":"Works out only in the mornings","confidence":0.9},` +
+					`{"kind":"fact","text":"Has a run tomorrow","confidence":0.3},` +
+					`{"kind":"secret","text":"Unknown kind","confidence":0.9},` +
+					`{"kind":"fact","text":"Owns a dog called Rex","confidence":0.95}]}`}, nil).Once()
+				m.encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Works out only in the mornings").
+					Return(semantic.EmbeddingVector{Vector: vector}, nil).Once()
+				m.encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Owns a dog called Rex").
+					Return(semantic.EmbeddingVector{Vector: []float64{0.9, 0.1}}, nil).Once()
+				m.memories.EXPECT().SearchMemories(mock.Anything, vector, 1, MEMORY_DUPLICATE_MAX_DISTANCE).Return(nil, nil).Once()
+				m.memories.EXPECT().SearchMemories(mock.Anything, []float64{0.9, 0.1}, 1, MEMORY_DUPLICATE_MAX_DISTANCE).
+					Return([]assistant.Memory{{Text: "Has a dog named Rex"}}, nil).Once()
+				m.memories.EXPECT().CreateMemory(mock.Anything, mock.MatchedBy(func(memory assistant.Memory) bool {
+					return memory.ID != uuid.Nil &&
+						memory.Kind == assistant.MemoryKind_Preference &&
+						memory.Text == "Works out only in the mornings" &&
+						*memory.SourceConversationID == conversationID &&
+						memory.CreatedAt.Equal(now)
+				})).Return(nil).Once()
+				m.memories.EXPECT().SaveMemoryExtractionCursor(mock.Anything, conversationID, lastMessageAt).Return(nil).Once()
+			},
+			expectedStored: 1,
+		},
+		"skips-active-conversation": {
+			setExpectations: func(m extractMemoriesMocks) {
+				active := assistant.Conversation{ID: uuid.New(), LastMessageAt: common.Ptr(now.Add(-time.Minute))}
+				m.conversations.EXPECT().ListConversations(mock.Anything, 1, MEMORY_EXTRACTION_PAGE_SIZE).
+					Return([]assistant.Conversation{active}, false, nil).Once()
+			},
+		},
+		"stops-at-lookback": {
+			setExpectations: func(m extractMemoriesMocks) {
+				stale := assistant.Conversation{ID: uuid.New(), LastMessageAt: common.Ptr(now.Add(-MEMORY_EXTRACTION_LOOKBACK - time.Hour))}
+				m.conversations.EXPECT().ListConversations(mock.Anything, 1, MEMORY_EXTRACTION_PAGE_SIZE).
+					Return([]assistant.Conversation{stale}, true, nil).Once()
+			},
+		},
+		"already-extracted": {
+			setExpectations: func(m extractMemoriesMocks) {
+				expectLocked(m)
+				m.memories.EXPECT().GetMemoryExtractionCursor(mock.Anything, conversationID).Return(lastMessageAt, true, nil).Once()
+			},
+		},
+		"no-new-user-messages": {
+			setExpectations: func(m extractMemoriesMocks) {
+				expectLocked(m)
+				m.memories.EXPECT().GetMemoryExtractionCursor(mock.Anything, conversationID).Return(now.Add(-140*time.Minute), true, nil).Once()
+				m.messages.EXPECT().ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_MESSAGES_FOR_MEMORY).Return(messages, false, nil).Once()
+				m.memories.EXPECT().ListMemories(mock.Anything).Return(nil, nil).Once()
+				m.memories.EXPECT().SaveMemoryExtractionCursor(mock.Anything, conversationID, lastMessageAt).Return(nil).Once()
+			},
+		},
+		"memory-limit-reached": {
+			setExpectations: func(m extractMemoriesMocks) {
+				expectLocked(m)
+				m.memories.EXPECT().GetMemoryExtractionCursor(mock.Anything, conversationID).Return(time.Time{}, false, nil).Once()
+				m.messages.EXPECT().ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_MESSAGES_FOR_MEMORY).Return(messages, false, nil).Once()
+				m.memories.EXPECT().ListMemories(mock.Anything).Return(fullMemories, nil).Once()
+				m.memories.EXPECT().SaveMemoryExtractionCursor(mock.Anything, conversationID, lastMessageAt).Return(nil).Once()
+			},
+		},
+		"lock-held": {
+			setExpectations: func(m extractMemoriesMocks) {
+				m.conversations.EXPECT().ListConversations(mock.Anything, 1, MEMORY_EXTRACTION_PAGE_SIZE).
+					Return([]assistant.Conversation{idleConversation}, false, nil).Once()
+				m.lock.EXPECT().TryLock(mock.Anything, lockKey).Return(nil, false, nil).Once()
+			},
+		},
+		"assistant-error-keeps-cursor": {
+			setExpectations: func(m extractMemoriesMocks) {
+				expectLocked(m)
+				m.memories.EXPECT().GetMemoryExtractionCursor(mock.Anything, conversationID).Return(time.Time{}, false, nil).Once()
+				m.messages.EXPECT().ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_MESSAGES_FOR_MEMORY).Return(messages, false, nil).Once()
+				m.memories.EXPECT().ListMemories(mock.Anything).Return(nil, nil).Once()
+				m.assistant.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{}, errors.New("model down")).Once()
+			},
+			expectedErr: "conversation 223e4567-e89b-12d3-a456-426614174001: failed to extract memories: model down",
+		},
+		"malformed-response-stores-nothing": {
+			setExpectations: func(m extractMemoriesMocks) {
+				expectLocked(m)
+				m.memories.EXPECT().GetMemoryExtractionCursor(mock.Anything, conversationID).Return(time.Time{}, false, nil).Once()
+				m.messages.EXPECT().ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_MESSAGES_FOR_MEMORY).Return(messages, false, nil).Once()
+				m.memories.EXPECT().ListMemories(mock.Anything).Return(nil, nil).Once()
+				m.assistant.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{Content: "Nothing to remember."}, nil).Once()
+				m.memories.EXPECT().SaveMemoryExtractionCursor(mock.Anything, conversationID, lastMessageAt).Return(nil).Once()
+			},
+		},
+		"list-conversations-error": {
+			setExpectations: func(m extractMemoriesMocks) {
+				m.conversations.EXPECT().ListConversations(mock.Anything, 1, MEMORY_EXTRACTION_PAGE_SIZE).
+					Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: "failed to list conversations: db error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := extractMemoriesMocks{
+				conversations: assistant.NewMockConversationRepository(t),
+				messages:      assistant.NewMockChatMessageRepository(t),
+				memories:      assistant.NewMockMemoryRepository(t),
+				lock:          core.NewMockLocker(t),
+				assistant:     assistant.NewMockAssistant(t),
+				encoder:       semantic.NewMockEncoder(t),
+			}
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now)
+			tt.setExpectations(m)
+
+			uc := NewExtractMemoriesImpl(
+				m.conversations,
+				m.messages,
+				m.memories,
+				m.lock,
+				timeProvider,
+				m.assistant,
+				m.encoder,
+				"memory-model",
+				"embedding-model",
+				30*time.Minute,
+			)
+
+			stored, err := uc.Execute(t.Context())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedStored, stored)
+		})
+	}
+}
@@ -150,6 +150,9 @@ func (gct GenerateConversationTitleImpl) Execute(ctx context.Context, event outb
 		MaxTokens:   common.Ptr(CHAT_TITLE_MAX_TOKENS),
 		Temperature: common.Ptr(CHAT_TITLE_TEMPERATURE),
 		TopP:        common.Ptr(CHAT_TITLE_TOP_P),
+		ResponseSchema: assistant.NewTextResponseSchema(
+			"conversation_title", "title", "Short conversation title without quotes or markdown.",
+		),
 	})
 	if telemetry.IsErrorRecorded(span, err) {
 		return fmt.Errorf("failed to generate conversation title: %w", err)
@@ -157,9 +160,10 @@ func (gct GenerateConversationTitleImpl) Execute(ctx context.Context, event outb
 
 	metrics.RecordLLMTokensUsed(spanCtx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
 
-	applyStatus := conversation.ApplyLLMGeneratedTitle(resp.Content, focusedSummary)
+	generatedTitle := resp.TextField("title")
+	applyStatus := conversation.ApplyLLMGeneratedTitle(generatedTitle, focusedSummary)
 	if applyStatus != assistant.ConversationTitleApplyStatus_Updated {
-		rawTitle := strings.TrimSpace(resp.Content)
+		rawTitle := strings.TrimSpace(generatedTitle)
 		switch applyStatus {
 		case assistant.ConversationTitleApplyStatus_SkippedNotGrounded:
 			span.AddEvent("Generated title rejected for low grounding in summary", trace.WithAttributes(
@@ -228,7 +232,7 @@ func (gct GenerateConversationTitleImpl) buildPromptMessages(
 func formatMessagesForConversationTitle(messages []assistant.ChatMessage) string {
 	lines := make([]string, 0, len(messages))
 	for _, message := range messages {
-		if message.ChatRole != assistant.ChatRole_User && message.ChatRole != assistant.ChatRole_Assistant {
+		if (message.ChatRole != assistant.ChatRole_User && message.ChatRole != assistant.ChatRole_Assistant) || message.IsModerated() || message.IsSuperseded() {
 			continue
 		}
 		content := summarizeMessageForTitlePrompt(message.ChatRole, message.Content)
@@ -236,7 +236,9 @@ func TestGenerateConversationTitleImpl_Execute(t *testing.T) {
 							req.Stream == false &&
 							assert.Equal(t, common.Ptr(CHAT_TITLE_MAX_TOKENS), req.MaxTokens) &&
 							assert.Equal(t, common.Ptr(CHAT_TITLE_TEMPERATURE), req.Temperature) &&
-							assert.Equal(t, common.Ptr(CHAT_TITLE_TOP_P), req.TopP)
+							assert.Equal(t, common.Ptr(CHAT_TITLE_TOP_P), req.TopP) &&
+							assert.Equal(t, "conversation_title", req.ResponseSchema.Name) &&
+							assert.Contains(t, req.ResponseSchema.Input.Fields, "title")
 					})).
 					Return(assistant.TurnResponse{
 						Content: "\"Spring Cleaning Task Breakdown\"",
@@ -262,6 +264,93 @@ func TestGenerateConversationTitleImpl_Execute(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"success-unwraps-constrained-json-title": {
+			model: "title-model",
+			event: outbox.ChatMessageEvent{
+				Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+				ConversationID: conversationID,
+				ChatMessageID:  chatMessageID,
+				ChatRole:       assistant.ChatRole_Assistant,
+			},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+				locker *core.MockLocker,
+				assist *assistant.MockAssistant,
+			) {
+				locker.EXPECT().
+					TryLock(mock.Anything, "conversation-title:"+conversationID.String()).
+					Return(func() {}, true, nil).
+					Once()
+
+				conversationRepo.EXPECT().
+					GetConversation(mock.Anything, conversationID).
+					Return(assistant.Conversation{
+						ID:          conversationID,
+						Title:       "Show my tasks",
+						TitleSource: assistant.ConversationTitleSource_Auto,
+					}, true, nil).
+					Once()
+
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_MESSAGES_FOR_TITLE).
+					Return([]assistant.ChatMessage{
+						{ChatRole: assistant.ChatRole_User, Content: "Break down spring cleaning", MessageState: assistant.ChatMessageState_Completed},
+						{ChatRole: assistant.ChatRole_Assistant, Content: "I split this into room-based tasks.", MessageState: assistant.ChatMessageState_Completed},
+					}, false, nil).
+					Once()
+
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(assistant.ConversationSummary{
+						ConversationID:      conversationID,
+						CurrentStateSummary: "Spring cleaning plan with room-based todo breakdown and due-date schedule",
+					}, true, nil).
+					Once()
+
+				assist.EXPECT().
+					RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+						require.GreaterOrEqual(t, len(req.Messages), 1)
+						assert.Equal(t, assistant.ChatRole_System, req.Messages[0].Role)
+						assert.Contains(t, req.Messages[0].Content, "Current title:")
+						assert.Contains(t, req.Messages[0].Content, "Focused compacted context:")
+						assert.Contains(t, req.Messages[0].Content, "Recent conversation context:")
+						assert.Contains(t, req.Messages[0].Content, "Spring cleaning plan with room-based todo breakdown")
+						assert.NotContains(t, req.Messages[0].Content, "**")
+						return req.Model == "title-model" &&
+							req.Stream == false &&
+							assert.Equal(t, common.Ptr(CHAT_TITLE_MAX_TOKENS), req.MaxTokens) &&
+							assert.Equal(t, common.Ptr(CHAT_TITLE_TEMPERATURE), req.Temperature) &&
+							assert.Equal(t, common.Ptr(CHAT_TITLE_TOP_P), req.TopP) &&
+							assert.Equal(t, "conversation_title", req.ResponseSchema.Name) &&
+							assert.Contains(t, req.ResponseSchema.Input.Fields, "title")
+					})).
+					Return(assistant.TurnResponse{
+						Content: `{"title": "Spring Cleaning Task Breakdown"}`,
+						Usage: assistant.Usage{
+							PromptTokens:     10,
+							CompletionTokens: 4,
+							TotalTokens:      14,
+						},
+					}, nil).
+					Once()
+
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+
+				conversationRepo.EXPECT().
+					UpdateConversation(mock.Anything, mock.MatchedBy(func(c assistant.Conversation) bool {
+						return c.ID == conversationID &&
+							c.Title == "Spring Cleaning Task Breakdown" &&
+							c.TitleSource == assistant.ConversationTitleSource_LLM &&
+							c.UpdatedAt.Equal(fixedTime)
+					})).
+					Return(nil).
+					Once()
+			},
+			expectedErr: nil,
+		},
 		"off-topic-title-noop": {
 			model: "title-model",
 			event: outbox.ChatMessageEvent{
@@ -0,0 +1,56 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetContentBlob returns the whole content of an action result stored as a content blob, for clients that
+// only received its preview.
+type GetContentBlob interface {
+	// Query returns the content blob with the given hash.
+	Query(ctx context.Context, hash string) (assistant.ContentBlob, error)
+}
+
+// GetContentBlobImpl implements GetContentBlob.
+type GetContentBlobImpl struct {
+	contentBlobRepo assistant.ContentBlobRepository
+}
+
+// NewGetContentBlobImpl creates a GetContentBlobImpl.
+func NewGetContentBlobImpl(contentBlobRepo assistant.ContentBlobRepository) GetContentBlobImpl {
+	return GetContentBlobImpl{
+		contentBlobRepo: contentBlobRepo,
+	}
+}
+
+// Query implements GetContentBlob.
+func (uc GetContentBlobImpl) Query(ctx context.Context, hash string) (assistant.ContentBlob, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("hash", hash),
+	))
+	defer span.End()
+
+	if !assistant.IsContentBlobHash(hash) {
+		err := core.NewValidationErr("hash must be a lowercase hex-encoded SHA-256 hash")
+		telemetry.IsErrorRecorded(span, err)
+		return assistant.ContentBlob{}, err
+	}
+
+	blobs, err := uc.contentBlobRepo.ListContentBlobs(spanCtx, []string{hash})
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.ContentBlob{}, err
+	}
+	if len(blobs) == 0 {
+		err := core.NewNotFoundErr(fmt.Sprintf("content blob %s not found", hash))
+		telemetry.IsErrorRecorded(span, err)
+		return assistant.ContentBlob{}, err
+	}
+	return blobs[0], nil
+}
@@ -0,0 +1,65 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetContentBlobImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	blob := assistant.NewContentBlob(`{"ok":true}`, time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC))
+
+	tests := map[string]struct {
+		hash            string
+		setExpectations func(repo *assistant.MockContentBlobRepository)
+		expected        assistant.ContentBlob
+		expectedErr     error
+	}{
+		"found": {
+			hash: blob.Hash,
+			setExpectations: func(repo *assistant.MockContentBlobRepository) {
+				repo.EXPECT().ListContentBlobs(mock.Anything, []string{blob.Hash}).Return([]assistant.ContentBlob{blob}, nil).Once()
+			},
+			expected: blob,
+		},
+		"invalid-hash": {
+			hash:            "not-a-hash",
+			setExpectations: func(*assistant.MockContentBlobRepository) {},
+			expectedErr:     core.NewValidationErr("hash must be a lowercase hex-encoded SHA-256 hash"),
+		},
+		"not-found": {
+			hash: blob.Hash,
+			setExpectations: func(repo *assistant.MockContentBlobRepository) {
+				repo.EXPECT().ListContentBlobs(mock.Anything, []string{blob.Hash}).Return(nil, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("content blob " + blob.Hash + " not found"),
+		},
+		"repository-error": {
+			hash: blob.Hash,
+			setExpectations: func(repo *assistant.MockContentBlobRepository) {
+				repo.EXPECT().ListContentBlobs(mock.Anything, []string{blob.Hash}).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockContentBlobRepository(t)
+			tt.setExpectations(repo)
+
+			got, err := NewGetContentBlobImpl(repo).Query(t.Context(), tt.hash)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
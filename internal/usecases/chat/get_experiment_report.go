@@ -0,0 +1,97 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// ExperimentReport compares the outcomes of the variants of an experiment.
+type ExperimentReport struct {
+	Experiment string
+	// Active reports whether the experiment is the one currently assigning conversations.
+	Active   bool
+	Variants []ExperimentVariantReport
+}
+
+// ExperimentVariantReport holds the configuration and outcomes of one variant. The configuration
+// is only known for the variants of the active experiment.
+type ExperimentVariantReport struct {
+	Config  *assistant.ExperimentVariant
+	Outcome assistant.VariantOutcome
+}
+
+// GetExperimentReport reports the outcome metrics of the chat experiments.
+type GetExperimentReport interface {
+	// Query returns the report of the named experiment, or of the active experiment when name is empty.
+	Query(ctx context.Context, name string) (ExperimentReport, error)
+}
+
+// GetExperimentReportImpl implements GetExperimentReport.
+type GetExperimentReportImpl struct {
+	experimentRepo assistant.ExperimentRepository
+	experiment     *assistant.Experiment
+}
+
+// NewGetExperimentReportImpl creates a GetExperimentReportImpl. experiment is the active experiment, or nil.
+func NewGetExperimentReportImpl(experimentRepo assistant.ExperimentRepository, experiment *assistant.Experiment) GetExperimentReportImpl {
+	return GetExperimentReportImpl{
+		experimentRepo: experimentRepo,
+		experiment:     experiment,
+	}
+}
+
+// Query implements GetExperimentReport.
+func (uc GetExperimentReportImpl) Query(ctx context.Context, name string) (ExperimentReport, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if name == "" {
+		if uc.experiment == nil {
+			err := core.NewValidationErr("experiment name is required when no experiment is active")
+			telemetry.IsErrorRecorded(span, err)
+			return ExperimentReport{}, err
+		}
+		name = uc.experiment.Name
+	}
+
+	outcomes, err := uc.experimentRepo.ListVariantOutcomes(spanCtx, name)
+	if telemetry.IsErrorRecorded(span, err) {
+		return ExperimentReport{}, err
+	}
+
+	report := ExperimentReport{
+		Experiment: name,
+		Active:     uc.experiment != nil && uc.experiment.Name == name,
+		Variants:   make([]ExperimentVariantReport, 0, len(outcomes)),
+	}
+	if !report.Active {
+		for _, outcome := range outcomes {
+			report.Variants = append(report.Variants, ExperimentVariantReport{Outcome: outcome})
+		}
+		return report, nil
+	}
+
+	// Configured variants are listed first, even before they answer a turn, followed by the
+	// variants removed from the configuration since the experiment started.
+	outcomesByVariant := make(map[string]assistant.VariantOutcome, len(outcomes))
+	for _, outcome := range outcomes {
+		outcomesByVariant[outcome.Variant] = outcome
+	}
+	for _, variant := range uc.experiment.Variants {
+		outcome, found := outcomesByVariant[variant.Name]
+		if !found {
+			outcome = assistant.VariantOutcome{Variant: variant.Name}
+		}
+		delete(outcomesByVariant, variant.Name)
+		report.Variants = append(report.Variants, ExperimentVariantReport{Config: &variant, Outcome: outcome})
+	}
+	for _, outcome := range outcomes {
+		if _, removed := outcomesByVariant[outcome.Variant]; removed {
+			report.Variants = append(report.Variants, ExperimentVariantReport{Outcome: outcome})
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,93 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetExperimentReportImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	control := assistant.ExperimentVariant{Name: "control", Weight: 50}
+	concise := assistant.ExperimentVariant{Name: "concise", Weight: 50, PromptVersion: "concise", Temperature: common.Ptr(0.4)}
+	active := &assistant.Experiment{
+		Name:     "prompt-v2",
+		Variants: []assistant.ExperimentVariant{control, concise},
+	}
+
+	tests := map[string]struct {
+		experiment      *assistant.Experiment
+		name            string
+		setExpectations func(repo *assistant.MockExperimentRepository)
+		expectedReport  ExperimentReport
+		expectedErr     error
+	}{
+		"active-experiment": {
+			experiment: active,
+			setExpectations: func(repo *assistant.MockExperimentRepository) {
+				repo.EXPECT().ListVariantOutcomes(mock.Anything, "prompt-v2").Return([]assistant.VariantOutcome{
+					{Variant: "control", Turns: 10, FailedTurns: 1},
+					{Variant: "verbose", Turns: 4, ActionLoopTurns: 1},
+				}, nil).Once()
+			},
+			expectedReport: ExperimentReport{
+				Experiment: "prompt-v2",
+				Active:     true,
+				Variants: []ExperimentVariantReport{
+					{Config: &control, Outcome: assistant.VariantOutcome{Variant: "control", Turns: 10, FailedTurns: 1}},
+					{Config: &concise, Outcome: assistant.VariantOutcome{Variant: "concise"}},
+					{Outcome: assistant.VariantOutcome{Variant: "verbose", Turns: 4, ActionLoopTurns: 1}},
+				},
+			},
+		},
+		"past-experiment": {
+			experiment: active,
+			name:       "prompt-v1",
+			setExpectations: func(repo *assistant.MockExperimentRepository) {
+				repo.EXPECT().ListVariantOutcomes(mock.Anything, "prompt-v1").Return([]assistant.VariantOutcome{
+					{Variant: "control", Turns: 3, FeedbackCount: 2, FeedbackScore: 0.5},
+				}, nil).Once()
+			},
+			expectedReport: ExperimentReport{
+				Experiment: "prompt-v1",
+				Variants: []ExperimentVariantReport{
+					{Outcome: assistant.VariantOutcome{Variant: "control", Turns: 3, FeedbackCount: 2, FeedbackScore: 0.5}},
+				},
+			},
+		},
+		"no-active-experiment": {
+			setExpectations: func(*assistant.MockExperimentRepository) {},
+			expectedErr:     core.NewValidationErr("experiment name is required when no experiment is active"),
+		},
+		"repository-error": {
+			experiment: active,
+			setExpectations: func(repo *assistant.MockExperimentRepository) {
+				repo.EXPECT().ListVariantOutcomes(mock.Anything, "prompt-v2").Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockExperimentRepository(t)
+			tt.setExpectations(repo)
+
+			report, err := NewGetExperimentReportImpl(repo, tt.experiment).Query(t.Context(), tt.name)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package chat
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// TurnTranscriptStep is one stored message of a turn with its timing relative to the turn start.
+type TurnTranscriptStep struct {
+	Message assistant.ChatMessage
+	// Offset is the time between the start of the turn and the creation of the message.
+	Offset time.Duration
+	// Duration is the time the message took from creation to its last update, e.g. while streaming.
+	Duration time.Duration
+}
+
+// TurnTranscript is the ordered, unprojected sequence of messages of one turn.
+type TurnTranscript struct {
+	ConversationID uuid.UUID
+	TurnID         uuid.UUID
+	Steps          []TurnTranscriptStep
+	// Usage sums the token usage of every message of the turn.
+	Usage       assistant.Usage
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// Duration returns the time between the first message of the turn and the last update of any of its messages.
+func (t TurnTranscript) Duration() time.Duration {
+	return t.CompletedAt.Sub(t.StartedAt)
+}
+
+// GetTurnTranscript returns the full transcript of a past turn for debugging and evaluation.
+type GetTurnTranscript interface {
+	// Query returns every message of the turn, including action calls, action results and superseded responses.
+	Query(ctx context.Context, conversationID, turnID uuid.UUID) (TurnTranscript, error)
+}
+
+// GetTurnTranscriptImpl implements GetTurnTranscript.
+type GetTurnTranscriptImpl struct {
+	conversationRepo assistant.ConversationRepository
+	chatMessageRepo  assistant.ChatMessageRepository
+}
+
+// NewGetTurnTranscriptImpl creates a GetTurnTranscriptImpl.
+func NewGetTurnTranscriptImpl(
+	conversationRepo assistant.ConversationRepository,
+	chatMessageRepo assistant.ChatMessageRepository,
+) GetTurnTranscriptImpl {
+	return GetTurnTranscriptImpl{
+		conversationRepo: conversationRepo,
+		chatMessageRepo:  chatMessageRepo,
+	}
+}
+
+// Query implements GetTurnTranscript.
+func (uc GetTurnTranscriptImpl) Query(ctx context.Context, conversationID, turnID uuid.UUID) (TurnTranscript, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, found, err := uc.conversationRepo.GetConversation(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return TurnTranscript{}, err
+	}
+	if !found {
+		err := core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", conversationID))
+		telemetry.IsErrorRecorded(span, err)
+		return TurnTranscript{}, err
+	}
+
+	messages, _, err := uc.chatMessageRepo.ListChatMessages(spanCtx, conversationID, 1, 0, assistant.WithChatMessagesTurnID(turnID))
+	if telemetry.IsErrorRecorded(span, err) {
+		return TurnTranscript{}, err
+	}
+	if len(messages) == 0 {
+		err := core.NewNotFoundErr(fmt.Sprintf("turn with ID %s not found", turnID))
+		telemetry.IsErrorRecorded(span, err)
+		return TurnTranscript{}, err
+	}
+
+	return buildTurnTranscript(conversationID, turnID, messages), nil
+}
+
+// buildTurnTranscript orders the turn messages by their sequence and derives usage and timing from them.
+func buildTurnTranscript(conversationID, turnID uuid.UUID, messages []assistant.ChatMessage) TurnTranscript {
+	ordered := slices.Clone(messages)
+	// Storage order follows creation time, which cannot tell apart messages created in the same instant.
+	slices.SortStableFunc(ordered, func(a, b assistant.ChatMessage) int {
+		return cmp.Compare(a.TurnSequence, b.TurnSequence)
+	})
+
+	transcript := TurnTranscript{
+		ConversationID: conversationID,
+		TurnID:         turnID,
+		Steps:          make([]TurnTranscriptStep, 0, len(ordered)),
+	}
+	for _, msg := range ordered {
+		if transcript.StartedAt.IsZero() || msg.CreatedAt.Before(transcript.StartedAt) {
+			transcript.StartedAt = msg.CreatedAt
+		}
+		completedAt := msg.CreatedAt
+		if msg.UpdatedAt.After(completedAt) {
+			completedAt = msg.UpdatedAt
+		}
+		if completedAt.After(transcript.CompletedAt) {
+			transcript.CompletedAt = completedAt
+		}
+		transcript.Usage.PromptTokens += msg.PromptTokens
+		transcript.Usage.CompletionTokens += msg.CompletionTokens
+		transcript.Usage.TotalTokens += msg.TotalTokens
+	}
+	for _, msg := range ordered {
+		transcript.Steps = append(transcript.Steps, TurnTranscriptStep{
+			Message:  msg,
+			Offset:   max(msg.CreatedAt.Sub(transcript.StartedAt), 0),
+			Duration: max(msg.UpdatedAt.Sub(msg.CreatedAt), 0),
+		})
+	}
+
+	return transcript
+}
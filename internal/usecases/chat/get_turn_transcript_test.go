@@ -0,0 +1,148 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetTurnTranscriptImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("61000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("61000000-0000-0000-0000-000000000002")
+	conversation := assistant.Conversation{ID: conversationID}
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	userMessage := assistant.ChatMessage{
+		TurnID:       turnID,
+		TurnSequence: 0,
+		ChatRole:     assistant.ChatRole_User,
+		Content:      "Delete my done todos",
+		CreatedAt:    start,
+		UpdatedAt:    start,
+	}
+	actionCallMessage := assistant.ChatMessage{
+		TurnID:           turnID,
+		TurnSequence:     1,
+		ChatRole:         assistant.ChatRole_Assistant,
+		ActionCalls:      []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos", Input: `{"status":"DONE"}`}},
+		PromptTokens:     100,
+		CompletionTokens: 10,
+		TotalTokens:      110,
+		CreatedAt:        start.Add(200 * time.Millisecond),
+		UpdatedAt:        start.Add(900 * time.Millisecond),
+	}
+	actionResultMessage := assistant.ChatMessage{
+		TurnID:       turnID,
+		TurnSequence: 2,
+		ChatRole:     assistant.ChatRole_Tool,
+		ActionCallID: common.Ptr("call-1"),
+		Content:      "[]",
+		CreatedAt:    start.Add(1200 * time.Millisecond),
+		UpdatedAt:    start.Add(1200 * time.Millisecond),
+	}
+	finalMessage := assistant.ChatMessage{
+		TurnID:           turnID,
+		TurnSequence:     3,
+		ChatRole:         assistant.ChatRole_Assistant,
+		Content:          "You have no done todos.",
+		PromptTokens:     120,
+		CompletionTokens: 8,
+		TotalTokens:      128,
+		// Created in the same instant as the action result, as happens with coarse clocks.
+		CreatedAt: start.Add(1200 * time.Millisecond),
+		UpdatedAt: start.Add(2 * time.Second),
+	}
+
+	tests := map[string]struct {
+		setExpectations func(
+			convRepo *assistant.MockConversationRepository,
+			msgRepo *assistant.MockChatMessageRepository,
+		)
+		expectedTranscript TurnTranscript
+		expectedErr        error
+	}{
+		"success": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return([]assistant.ChatMessage{userMessage, actionCallMessage, finalMessage, actionResultMessage}, false, nil).
+					Once()
+			},
+			expectedTranscript: TurnTranscript{
+				ConversationID: conversationID,
+				TurnID:         turnID,
+				Steps: []TurnTranscriptStep{
+					{Message: userMessage},
+					{Message: actionCallMessage, Offset: 200 * time.Millisecond, Duration: 700 * time.Millisecond},
+					{Message: actionResultMessage, Offset: 1200 * time.Millisecond},
+					{Message: finalMessage, Offset: 1200 * time.Millisecond, Duration: 800 * time.Millisecond},
+				},
+				Usage:       assistant.Usage{PromptTokens: 220, CompletionTokens: 18, TotalTokens: 238},
+				StartedAt:   start,
+				CompletedAt: start.Add(2 * time.Second),
+			},
+		},
+		"conversation-not-found": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				_ *assistant.MockChatMessageRepository,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID 61000000-0000-0000-0000-000000000001 not found"),
+		},
+		"turn-not-found": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return(nil, false, nil).
+					Once()
+			},
+			expectedErr: core.NewNotFoundErr("turn with ID 61000000-0000-0000-0000-000000000002 not found"),
+		},
+		"list-messages-error": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return(nil, false, errors.New("db down")).
+					Once()
+			},
+			expectedErr: errors.New("db down"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			convRepo := assistant.NewMockConversationRepository(t)
+			msgRepo := assistant.NewMockChatMessageRepository(t)
+			tt.setExpectations(convRepo, msgRepo)
+
+			transcript, err := NewGetTurnTranscriptImpl(convRepo, msgRepo).Query(t.Context(), conversationID, turnID)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedTranscript, transcript)
+		})
+	}
+}
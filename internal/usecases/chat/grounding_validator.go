@@ -0,0 +1,245 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// groundingIDPattern matches the UUIDs mentioned in an answer.
+	groundingIDPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	// groundingDatePattern matches the ISO dates mentioned in an answer.
+	groundingDatePattern = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+	// groundingStatusWords map status language to the todo status it states.
+	groundingStatusWords = map[string]todo.Status{
+		"done":       todo.Status_DONE,
+		"completed":  todo.Status_DONE,
+		"complete":   todo.Status_DONE,
+		"finished":   todo.Status_DONE,
+		"open":       todo.Status_OPEN,
+		"pending":    todo.Status_OPEN,
+		"incomplete": todo.Status_OPEN,
+		"unfinished": todo.Status_OPEN,
+	}
+	// groundingNegations are words that flip the status a line states, so such lines are not checked.
+	groundingNegations = map[string]bool{"not": true, "no": true, "isn": true, "aren": true, "yet": true}
+)
+
+// groundedTodo is a todo as the action results of the turn last showed it.
+type groundedTodo struct {
+	id     string
+	title  string
+	status todo.Status
+}
+
+// CheckAnswerGrounding cross-checks the UUIDs, dates and todo statuses mentioned in an answer against the
+// action results of its turn. IDs and dates must appear in the results or in the inputs of the turn, such as
+// the user message and the action arguments. A status is checked on each answer line naming exactly one todo
+// of the results, by ID or title, and stating exactly one status without a negation.
+func CheckAnswerGrounding(answer string, results, inputs []string) []assistant.GroundingIssue {
+	evidence := strings.ToLower(strings.Join(results, "\n") + "\n" + strings.Join(inputs, "\n"))
+	issues := []assistant.GroundingIssue{}
+	seen := map[string]bool{}
+
+	for _, id := range groundingIDPattern.FindAllString(answer, -1) {
+		id = strings.ToLower(id)
+		if !seen[id] && !strings.Contains(evidence, id) {
+			issues = append(issues, assistant.GroundingIssue{Kind: assistant.GroundingIssueKind_UnknownID, Value: id})
+		}
+		seen[id] = true
+	}
+	for _, date := range groundingDatePattern.FindAllString(answer, -1) {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			continue
+		}
+		if !seen[date] && !strings.Contains(evidence, date) {
+			issues = append(issues, assistant.GroundingIssue{Kind: assistant.GroundingIssueKind_UnknownDate, Value: date})
+		}
+		seen[date] = true
+	}
+
+	todos := groundedTodos(results)
+	misstated := map[string]bool{}
+	for line := range strings.SplitSeq(strings.ToLower(answer), "\n") {
+		named, ok := namedTodo(line, todos)
+		if !ok || misstated[named.id] {
+			continue
+		}
+		stated, ok := statedStatus(line)
+		if !ok || stated == named.status {
+			continue
+		}
+		value := named.title
+		if value == "" {
+			value = named.id
+		}
+		issues = append(issues, assistant.GroundingIssue{
+			Kind:     assistant.GroundingIssueKind_StatusMismatch,
+			Value:    value,
+			Stated:   string(stated),
+			Expected: string(named.status),
+		})
+		misstated[named.id] = true
+	}
+
+	return issues
+}
+
+// groundedTodos collects the todos with a status found anywhere in the JSON action results.
+// A todo shown by several results keeps the status of the latest one.
+func groundedTodos(results []string) []groundedTodo {
+	byID := map[string]int{}
+	todos := []groundedTodo{}
+
+	var walk func(value any)
+	walk = func(value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			id, _ := v["id"].(string)
+			status, _ := v["status"].(string)
+			if id != "" && (status == string(todo.Status_OPEN) || status == string(todo.Status_DONE)) {
+				title, _ := v["title"].(string)
+				entry := groundedTodo{id: strings.ToLower(id), title: title, status: todo.Status(status)}
+				if i, found := byID[entry.id]; found {
+					todos[i] = entry
+				} else {
+					byID[entry.id] = len(todos)
+					todos = append(todos, entry)
+				}
+			}
+			for _, field := range v {
+				walk(field)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+
+	for _, result := range results {
+		var decoded any
+		if err := json.Unmarshal([]byte(result), &decoded); err == nil {
+			walk(decoded)
+		}
+	}
+	return todos
+}
+
+// namedTodo returns the only todo an answer line names by ID or title.
+func namedTodo(line string, todos []groundedTodo) (groundedTodo, bool) {
+	var named []groundedTodo
+	for _, t := range todos {
+		title := strings.ToLower(strings.TrimSpace(t.title))
+		if strings.Contains(line, t.id) || (title != "" && strings.Contains(line, title)) {
+			named = append(named, t)
+		}
+	}
+	if len(named) != 1 {
+		return groundedTodo{}, false
+	}
+	return named[0], true
+}
+
+// statedStatus returns the only todo status an answer line states, ignoring lines with a negation.
+func statedStatus(line string) (todo.Status, bool) {
+	words := strings.FieldsFunc(line, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	var stated todo.Status
+	for _, word := range words {
+		if groundingNegations[word] {
+			return "", false
+		}
+		status, ok := groundingStatusWords[word]
+		if !ok {
+			continue
+		}
+		if stated != "" && stated != status {
+			return "", false
+		}
+		stated = status
+	}
+	return stated, stated != ""
+}
+
+// groundingEvidence splits the current turn of the request into the action results and the inputs an answer
+// may quote: the system messages, the user message and the action arguments.
+func groundingEvidence(messages []assistant.Message) ([]string, []string) {
+	lastUserIdx := -1
+	for i, msg := range messages {
+		if msg.Role == assistant.ChatRole_User {
+			lastUserIdx = i
+		}
+	}
+
+	var results, inputs []string
+	for i, msg := range messages {
+		switch {
+		case msg.Role == assistant.ChatRole_System || msg.Role == assistant.ChatRole_Developer:
+			inputs = append(inputs, msg.Content)
+		case i < lastUserIdx:
+			// Earlier turns are not evidence for the current answer.
+		case msg.Role == assistant.ChatRole_Tool:
+			results = append(results, msg.Content)
+		case msg.Role == assistant.ChatRole_User:
+			inputs = append(inputs, msg.Content)
+		default:
+			for _, call := range msg.ActionCalls {
+				inputs = append(inputs, call.Input)
+			}
+		}
+	}
+	return results, inputs
+}
+
+// GroundingTurnRunner decorates a TurnRunner, cross-checking the final answer of every completed turn that ran
+// actions against their results. Unsupported values are reported with a grounding_warning event and counted
+// in metrics for prompt tuning; the answer is not changed.
+type GroundingTurnRunner struct {
+	next TurnRunner
+}
+
+// NewGroundingTurnRunner creates a GroundingTurnRunner.
+func NewGroundingTurnRunner(next TurnRunner) GroundingTurnRunner {
+	return GroundingTurnRunner{next: next}
+}
+
+// Run implements TurnRunner.
+func (r GroundingTurnRunner) Run(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+	if err := r.next.Run(ctx, state, onEvent); err != nil {
+		return err
+	}
+
+	results, inputs := groundingEvidence(state.Request().Messages)
+	if len(results) == 0 {
+		return nil
+	}
+	issues := CheckAnswerGrounding(state.AssistantContent(), results, inputs)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	for _, issue := range issues {
+		metrics.RecordChatGroundingFailure(ctx, state.Model(), string(issue.Kind))
+	}
+	trace.SpanFromContext(ctx).AddEvent("Answer grounding failed", trace.WithAttributes(
+		attribute.Int("grounding_issue_count", len(issues)),
+	))
+	return onEvent(ctx, assistant.EventType_GroundingWarning, assistant.GroundingWarning{
+		ConversationID: state.Conversation().ID,
+		TurnID:         state.TurnID(),
+		Issues:         issues,
+	})
+}
@@ -0,0 +1,159 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckAnswerGrounding(t *testing.T) {
+	t.Parallel()
+
+	results := []string{
+		`{"status":"success","data":[` +
+			`{"id":"11111111-1111-1111-1111-111111111111","title":"Buy milk","status":"OPEN","due_date":"2026-03-20"},` +
+			`{"id":"22222222-2222-2222-2222-222222222222","title":"Pay rent","status":"DONE","due_date":"2026-03-01"}]}`,
+	}
+	inputs := []string{"What is due before 2026-03-31?"}
+
+	tests := map[string]struct {
+		answer         string
+		expectedIssues []assistant.GroundingIssue
+	}{
+		"grounded": {
+			answer:         "- Buy milk is open, due 2026-03-20\n- Pay rent is done (22222222-2222-2222-2222-222222222222)\nBoth are due before 2026-03-31.",
+			expectedIssues: []assistant.GroundingIssue{},
+		},
+		"unknown-id": {
+			answer: "Buy milk has the id 33333333-3333-3333-3333-333333333333 and 33333333-3333-3333-3333-333333333333.",
+			expectedIssues: []assistant.GroundingIssue{
+				{Kind: assistant.GroundingIssueKind_UnknownID, Value: "33333333-3333-3333-3333-333333333333"},
+			},
+		},
+		"unknown-date": {
+			answer: "Buy milk is due 2026-03-21.",
+			expectedIssues: []assistant.GroundingIssue{
+				{Kind: assistant.GroundingIssueKind_UnknownDate, Value: "2026-03-21"},
+			},
+		},
+		"invalid-date-is-ignored": {
+			answer:         "Reference 2026-13-45.",
+			expectedIssues: []assistant.GroundingIssue{},
+		},
+		"status-mismatch": {
+			answer: "- Buy milk is completed\n- Pay rent is still pending",
+			expectedIssues: []assistant.GroundingIssue{
+				{Kind: assistant.GroundingIssueKind_StatusMismatch, Value: "Buy milk", Stated: "DONE", Expected: "OPEN"},
+				{Kind: assistant.GroundingIssueKind_StatusMismatch, Value: "Pay rent", Stated: "OPEN", Expected: "DONE"},
+			},
+		},
+		"negated-status-is-ignored": {
+			answer:         "Buy milk is not done yet.",
+			expectedIssues: []assistant.GroundingIssue{},
+		},
+		"line-naming-several-todos-is-ignored": {
+			answer:         "Buy milk and Pay rent are done.",
+			expectedIssues: []assistant.GroundingIssue{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := CheckAnswerGrounding(tt.answer, results, inputs)
+			assert.Equal(t, tt.expectedIssues, got)
+		})
+	}
+}
+
+func TestGroundingTurnRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	toolResult := `{"status":"success","data":[{"id":"11111111-1111-1111-1111-111111111111","title":"Buy milk","status":"OPEN"}]}`
+	withResults := []assistant.Message{
+		{Role: assistant.ChatRole_System, Content: "You are a todo assistant."},
+		{Role: assistant.ChatRole_User, Content: "Is Buy milk done?"},
+		{Role: assistant.ChatRole_Assistant, ActionCalls: []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos", Input: `{"search_by_similarity":"Buy milk"}`}}},
+		{Role: assistant.ChatRole_Tool, ActionCallID: common.Ptr("call-1"), Content: toolResult},
+	}
+
+	tests := map[string]struct {
+		messages         []assistant.Message
+		answer           string
+		runErr           error
+		expectedErr      error
+		expectedWarnings int
+	}{
+		"grounded": {
+			messages: withResults,
+			answer:   "Buy milk is still open.",
+		},
+		"not-grounded": {
+			messages:         withResults,
+			answer:           "Buy milk is done.",
+			expectedWarnings: 1,
+		},
+		"results-of-earlier-turns-are-not-evidence": {
+			messages: append(append([]assistant.Message{}, withResults...),
+				assistant.Message{Role: assistant.ChatRole_Assistant, Content: "Buy milk is still open."},
+				assistant.Message{Role: assistant.ChatRole_User, Content: "Thanks!"},
+			),
+			answer: "Buy milk is done.",
+		},
+		"no-actions": {
+			messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "Hi"}},
+			answer:   "Your todo 33333333-3333-3333-3333-333333333333 is done.",
+		},
+		"turn-error": {
+			messages:    withResults,
+			answer:      "Buy milk is done.",
+			runErr:      errors.New("turn failed"),
+			expectedErr: errors.New("turn failed"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "primary", Messages: tt.messages}, 5, nil)
+			next := NewMockTurnRunner(t)
+			next.EXPECT().
+				Run(mock.Anything, state, mock.Anything).
+				RunAndReturn(func(_ context.Context, state TurnState, _ assistant.EventCallback) error {
+					state.AppendAssistantContent(tt.answer)
+					return tt.runErr
+				}).Once()
+
+			var warnings []assistant.GroundingWarning
+			runner := NewGroundingTurnRunner(next)
+			err := runner.Run(t.Context(), state, func(_ context.Context, eventType assistant.EventType, data any) error {
+				if eventType == assistant.EventType_GroundingWarning {
+					warnings = append(warnings, data.(assistant.GroundingWarning))
+				}
+				return nil
+			})
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Len(t, warnings, tt.expectedWarnings)
+			for _, warning := range warnings {
+				assert.Equal(t, conversation.ID, warning.ConversationID)
+				assert.Equal(t, state.TurnID(), warning.TurnID)
+				assert.Equal(t, []assistant.GroundingIssue{{
+					Kind:     assistant.GroundingIssueKind_StatusMismatch,
+					Value:    "Buy milk",
+					Stated:   "DONE",
+					Expected: "OPEN",
+				}}, warning.Issues)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+)
+
+// DEFAULT_DRAIN_PROGRESS_INTERVAL is how often a shutdown logs the turns it is still waiting for.
+const DEFAULT_DRAIN_PROGRESS_INTERVAL = 5 * time.Second
+
+// ErrShuttingDown is the cancellation cause of the turns still running when the shutdown drain timeout expires.
+var ErrShuttingDown = errors.New("server is shutting down")
+
+// InFlightTurns tracks the chat turns being streamed so a shutdown can stop accepting new turns and
+// let the running ones finish. Turns still running after the drain timeout are canceled with ErrShuttingDown.
+// A nil InFlightTurns admits every turn.
+type InFlightTurns struct {
+	logger           *log.Logger
+	drainTimeout     time.Duration
+	progressInterval time.Duration
+
+	mu        sync.Mutex
+	draining  bool
+	nextID    uint64
+	cancels   map[uint64]context.CancelCauseFunc
+	running   sync.WaitGroup
+	drainOnce sync.Once
+	drained   chan struct{}
+}
+
+// NewInFlightTurns creates an InFlightTurns. A zero drainTimeout waits for the running turns indefinitely.
+func NewInFlightTurns(logger *log.Logger, drainTimeout time.Duration) *InFlightTurns {
+	return &InFlightTurns{
+		logger:           logger,
+		drainTimeout:     drainTimeout,
+		progressInterval: DEFAULT_DRAIN_PROGRESS_INTERVAL,
+		cancels:          make(map[uint64]context.CancelCauseFunc),
+		drained:          make(chan struct{}),
+	}
+}
+
+// Begin admits a turn and returns its context and the function to call when it ends.
+// Turns are rejected with an UnavailableErr once the shutdown started draining.
+func (t *InFlightTurns) Begin(ctx context.Context) (context.Context, func(), error) {
+	if t == nil {
+		return ctx, func() {}, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		return ctx, nil, core.NewUnavailableErr("the server is shutting down, please retry the request")
+	}
+
+	turnCtx, cancel := context.WithCancelCause(ctx)
+	id := t.nextID
+	t.nextID++
+	t.cancels[id] = cancel
+	t.running.Add(1)
+	metrics.RecordChatTurnsInFlight(ctx, 1)
+
+	var once sync.Once
+	return turnCtx, func() {
+		once.Do(func() {
+			t.mu.Lock()
+			delete(t.cancels, id)
+			t.mu.Unlock()
+			cancel(nil)
+			metrics.RecordChatTurnsInFlight(ctx, -1)
+			t.running.Done()
+		})
+	}, nil
+}
+
+// Drain stops admitting turns and waits up to the drain timeout for the running ones to finish, logging
+// its progress. Afterwards the remaining turns are canceled with ErrShuttingDown and Drain waits for them
+// to record their failure. Concurrent and later calls wait for the same drain.
+func (t *InFlightTurns) Drain() {
+	if t == nil {
+		return
+	}
+	t.drainOnce.Do(func() {
+		defer close(t.drained)
+
+		t.mu.Lock()
+		t.draining = true
+		remaining := len(t.cancels)
+		t.mu.Unlock()
+		if remaining == 0 {
+			return
+		}
+		t.logf("StreamChat: draining %d in-flight turns", remaining)
+
+		finished := make(chan struct{})
+		go func() {
+			t.running.Wait()
+			close(finished)
+		}()
+
+		var timeout <-chan time.Time
+		if t.drainTimeout > 0 {
+			timer := time.NewTimer(t.drainTimeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		progress := time.NewTicker(t.progressInterval)
+		defer progress.Stop()
+
+		for {
+			select {
+			case <-finished:
+				t.logf("StreamChat: drained all in-flight turns")
+				return
+			case <-progress.C:
+				t.logf("StreamChat: waiting for %d in-flight turns", t.remaining())
+			case <-timeout:
+				interrupted := t.interrupt()
+				metrics.RecordChatTurnsInterrupted(context.Background(), interrupted)
+				t.logf("StreamChat: drain timeout of %s expired, interrupting %d in-flight turns", t.drainTimeout, interrupted)
+				<-finished
+				return
+			}
+		}
+	})
+	<-t.drained
+}
+
+// remaining returns the number of running turns.
+func (t *InFlightTurns) remaining() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.cancels)
+}
+
+// interrupt cancels the running turns with ErrShuttingDown and returns how many there were.
+func (t *InFlightTurns) interrupt() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, cancel := range t.cancels {
+		cancel(ErrShuttingDown)
+	}
+	return len(t.cancels)
+}
+
+func (t *InFlightTurns) logf(format string, args ...any) {
+	if t.logger != nil {
+		t.logger.Printf(format, args...)
+	}
+}
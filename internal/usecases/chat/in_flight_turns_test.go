@@ -0,0 +1,202 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlightTurns_Drain(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		drainTimeout  time.Duration
+		turns         int
+		finishTurns   bool
+		expectedCause error
+	}{
+		"no-turns": {
+			drainTimeout: time.Minute,
+		},
+		"turns-finish-before-timeout": {
+			drainTimeout: time.Minute,
+			turns:        2,
+			finishTurns:  true,
+		},
+		"timeout-interrupts-turns": {
+			drainTimeout:  10 * time.Millisecond,
+			turns:         2,
+			expectedCause: ErrShuttingDown,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			turns := NewInFlightTurns(log.New(io.Discard, "", 0), tt.drainTimeout)
+			turns.progressInterval = time.Millisecond
+
+			causes := make(chan error, tt.turns)
+			release := make(chan struct{})
+			for range tt.turns {
+				ctx, end, err := turns.Begin(t.Context())
+				require.NoError(t, err)
+				go func() {
+					defer end()
+					select {
+					case <-release:
+					case <-ctx.Done():
+					}
+					causes <- context.Cause(ctx)
+				}()
+			}
+
+			drained := make(chan struct{})
+			go func() {
+				turns.Drain()
+				close(drained)
+			}()
+
+			// New turns are rejected as soon as the drain started.
+			assert.Eventually(t, func() bool {
+				turns.mu.Lock()
+				defer turns.mu.Unlock()
+				return turns.draining
+			}, time.Second, time.Millisecond)
+			_, _, err := turns.Begin(t.Context())
+			var unavailableErr *core.UnavailableErr
+			assert.True(t, errors.As(err, &unavailableErr))
+
+			if tt.finishTurns {
+				close(release)
+			}
+			select {
+			case <-drained:
+			case <-time.After(5 * time.Second):
+				t.Fatal("drain did not finish")
+			}
+			for range tt.turns {
+				assert.Equal(t, tt.expectedCause, <-causes)
+			}
+
+			// Later drains return at once.
+			turns.Drain()
+		})
+	}
+}
+
+func TestInFlightTurns_Nil(t *testing.T) {
+	t.Parallel()
+
+	var turns *InFlightTurns
+	ctx, end, err := turns.Begin(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, t.Context(), ctx)
+	end()
+	turns.Drain()
+}
+
+func TestStreamChatImpl_Execute_ShutdownInterruptsTurn(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("90000000-0000-0000-0000-000000000003")
+	conversation := assistant.Conversation{ID: conversationID}
+	fixedTime := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	conversationRepo := assistant.NewMockConversationRepository(t)
+	builder := NewMockTurnStateBuilder(t)
+	runner := NewMockTurnRunner(t)
+	writer := NewMockConversationTranscriptWriter(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	timeProvider.EXPECT().Now().Return(fixedTime)
+
+	conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+	state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 5, nil)
+	builder.EXPECT().Build(mock.Anything, mock.Anything).Return(state, nil).Once()
+	writer.EXPECT().
+		WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+			return m.ChatRole == assistant.ChatRole_User
+		})).
+		Return(nil).
+		Once()
+
+	turns := NewInFlightTurns(log.New(io.Discard, "", 0), 10*time.Millisecond)
+	started := make(chan struct{})
+	runner.EXPECT().
+		Run(mock.Anything, state, mock.Anything).
+		RunAndReturn(func(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+			state.AppendAssistantContent("Partial answer")
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		}).
+		Once()
+	writer.EXPECT().RepairTurnTranscript(mock.Anything, conversationID, state.TurnID()).Return(nil).Once()
+	writer.EXPECT().
+		WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+			return m.ChatRole == assistant.ChatRole_Assistant &&
+				m.Content == "Partial answer" &&
+				m.MessageState == assistant.ChatMessageState_Failed &&
+				m.ErrorMessage != nil && *m.ErrorMessage == ErrShuttingDown.Error()
+		})).
+		RunAndReturn(func(ctx context.Context, _ assistant.Conversation, _ assistant.ChatMessage) error {
+			// The failure is recorded even though the turn context is canceled.
+			return ctx.Err()
+		}).
+		Once()
+
+	useCase := NewStreamChatImpl(
+		log.New(io.Discard, "", 0),
+		timeProvider,
+		conversationRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		false,
+		assistant.CompactionPolicy{},
+		DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
+		5,
+		0,
+		nil,
+		nil,
+		nil,
+		builder,
+		runner,
+		writer,
+		newFakeConversationStreams(),
+		turns,
+	)
+
+	go func() {
+		<-started
+		turns.Drain()
+	}()
+
+	var failed assistant.TurnFailed
+	err := useCase.Execute(t.Context(), "Tell me everything", "test-model", func(_ context.Context, eventType assistant.EventType, data any) error {
+		if eventType == assistant.EventType_TurnFailed {
+			failed = data.(assistant.TurnFailed)
+		}
+		return nil
+	}, WithConversationID(conversationID))
+
+	var turnErr *assistant.TurnError
+	require.ErrorAs(t, err, &turnErr)
+	assert.Equal(t, assistant.TurnErrorCode_Shutdown, turnErr.Code)
+	assert.Equal(t, assistant.TurnErrorCode_Shutdown, failed.Code)
+	assert.True(t, failed.Retriable)
+}
@@ -2,13 +2,17 @@ package chat
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont/config"
 	"github.com/cleitonmarx/symbiont/depend"
 )
 
@@ -45,6 +49,46 @@ func (i InitConversationCompactor) Initialize(ctx context.Context) (context.Cont
 	return ctx, nil
 }
 
+// InitConversationSnapshotter initializes the conversation snapshotter.
+type InitConversationSnapshotter struct {
+	ConversationSummaryRepo  assistant.ConversationSummaryRepository  `resolve:""`
+	ConversationSnapshotRepo assistant.ConversationSnapshotRepository `resolve:""`
+	ChangeRepo               todo.ChangeRepository                    `resolve:""`
+	TodoRepo                 todo.Repository                          `resolve:""`
+	TimeProvider             core.CurrentTimeProvider                 `resolve:""`
+}
+
+// Initialize registers the ConversationSnapshotter in the dependency container.
+func (i InitConversationSnapshotter) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ConversationSnapshotter](NewConversationSnapshotterImpl(
+		i.ConversationSummaryRepo,
+		i.ConversationSnapshotRepo,
+		i.ChangeRepo,
+		i.TodoRepo,
+		i.TimeProvider,
+	))
+	return ctx, nil
+}
+
+// InitTopicShiftDetector initializes the topic shift detector.
+type InitTopicShiftDetector struct {
+	ConversationSummaryRepo assistant.ConversationSummaryRepository `resolve:""`
+	Encoder                 semantic.Encoder                        `resolve:""`
+	EmbeddingModel          string                                  `config:"LLM_EMBEDDING_MODEL"`
+	DistanceThreshold       float64                                 `config:"CHAT_TOPIC_SHIFT_THRESHOLD" default:"0.65"`
+}
+
+// Initialize registers the TopicShiftDetector in the dependency container.
+func (i InitTopicShiftDetector) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[TopicShiftDetector](NewTopicShiftDetectorImpl(
+		i.ConversationSummaryRepo,
+		i.Encoder,
+		i.EmbeddingModel,
+		i.DistanceThreshold,
+	))
+	return ctx, nil
+}
+
 // InitGenerateConversationTitle is the initializer for the GenerateConversationTitle use case
 type InitGenerateConversationTitle struct {
 	ConversationRepo        assistant.ConversationRepository        `resolve:""`
@@ -109,60 +153,184 @@ func (i InitListChatMessages) Initialize(ctx context.Context) (context.Context,
 	return ctx, nil
 }
 
+// InitGetContentBlob is the initializer for the GetContentBlob use case.
+type InitGetContentBlob struct {
+	ContentBlobRepo assistant.ContentBlobRepository `resolve:""`
+}
+
+// Initialize registers the GetContentBlob use case in the dependency container.
+func (i InitGetContentBlob) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[GetContentBlob](NewGetContentBlobImpl(i.ContentBlobRepo))
+	return ctx, nil
+}
+
+// InitMaintainChatMessagePartitions is the initializer for the MaintainChatMessagePartitions use case.
+type InitMaintainChatMessagePartitions struct {
+	PartitionRepo   assistant.ChatMessagePartitionRepository `resolve:""`
+	TimeProvider    core.CurrentTimeProvider                 `resolve:""`
+	MonthsAhead     int                                      `config:"CHAT_MESSAGES_PARTITION_MONTHS_AHEAD" default:"2"`
+	RetentionMonths int                                      `config:"CHAT_MESSAGES_RETENTION_MONTHS" default:"0"`
+}
+
+// Initialize registers the MaintainChatMessagePartitions use case in the dependency container.
+func (i InitMaintainChatMessagePartitions) Initialize(ctx context.Context) (context.Context, error) {
+	if i.MonthsAhead < 0 {
+		return ctx, fmt.Errorf("invalid chat message partition months ahead %d: months must not be negative", i.MonthsAhead)
+	}
+	if i.RetentionMonths < 0 {
+		return ctx, fmt.Errorf("invalid chat message retention %d: months must not be negative", i.RetentionMonths)
+	}
+	// The locker is optional: without one, every replica maintains the partitions.
+	locker, _ := depend.Resolve[core.Locker]()
+	depend.Register[MaintainChatMessagePartitions](NewMaintainChatMessagePartitionsImpl(
+		i.PartitionRepo,
+		i.TimeProvider,
+		locker,
+		i.MonthsAhead,
+		i.RetentionMonths,
+	))
+	return ctx, nil
+}
+
 // InitListConversations is the initializer for the ListConversations use case
 type InitListConversations struct {
-	ConversationRepo assistant.ConversationRepository `resolve:""`
+	ConversationRepo assistant.ConversationRepository          `resolve:""`
+	ReadModelRepo    assistant.ConversationReadModelRepository `resolve:""`
 }
 
 // Initialize registers the ListConversations use case in the dependency container.
 func (init InitListConversations) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[ListConversations](NewListConversationsImpl(init.ConversationRepo))
+	depend.Register[ListConversations](NewListConversationsImpl(init.ConversationRepo, init.ReadModelRepo))
 	return ctx, nil
 }
 
-// InitStreamChat is the initializer for the StreamChat use case
+// InitProjectConversationReadModel is the initializer for the ProjectConversationReadModel use case.
+type InitProjectConversationReadModel struct {
+	ReadModelRepo assistant.ConversationReadModelRepository `resolve:""`
+	TimeProvider  core.CurrentTimeProvider                  `resolve:""`
+}
+
+// Initialize registers the ProjectConversationReadModel use case in the dependency container.
+func (i InitProjectConversationReadModel) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ProjectConversationReadModel](NewProjectConversationReadModelImpl(i.ReadModelRepo, i.TimeProvider))
+	return ctx, nil
+}
+
+// InitStreamChat is the initializer for the StreamChat use case.
+// On shutdown it stops accepting turns and drains the running ones for up to CHAT_SHUTDOWN_DRAIN_TIMEOUT.
 type InitStreamChat struct {
 	Logger                  *log.Logger                      `resolve:""`
 	TimeProvider            core.CurrentTimeProvider         `resolve:""`
 	ConversationRepo        assistant.ConversationRepository `resolve:""`
+	ChatMessageRepo         assistant.ChatMessageRepository  `resolve:""`
+	ModelCatalog            assistant.ModelCatalog           `resolve:""`
 	ConversationCompactor   ConversationCompactor            `resolve:""`
+	ConversationSnapshotter ConversationSnapshotter          `resolve:""`
+	RelatedConversations    RelatedConversations             `resolve:""`
+	TopicShiftDetector      TopicShiftDetector               `resolve:""`
+	TopicShiftAutoSplit     bool                             `config:"CHAT_TOPIC_SHIFT_AUTO_SPLIT" default:"false"`
 	CompactionTriggerTokens int                              `config:"CHAT_COMPACTION_TRIGGER_TOKENS"`
+	SnapshotEveryTurns      int                              `config:"CHAT_SNAPSHOT_EVERY_TURNS" default:"10"`
 	CompactionTimeout       time.Duration                    `config:"CHAT_COMPACTION_TIMEOUT" default:"20s"`
 	StateBuilder            TurnStateBuilder                 `resolve:""`
 	TurnRunner              TurnRunner                       `resolve:""`
 	TranscriptWriter        ConversationTranscriptWriter     `resolve:""`
 	MaxActionCycles         int                              `config:"LLM_MAX_ACTION_CYCLES" default:"50"`
+	CheckpointBytes         int                              `config:"CHAT_STREAM_CHECKPOINT_BYTES" default:"2048"`
+	Experiment              string                           `config:"CHAT_EXPERIMENT" default:""`
+	Streams                 assistant.ConversationStreams    `resolve:""`
+	ShutdownDrainTimeout    time.Duration                    `config:"CHAT_SHUTDOWN_DRAIN_TIMEOUT" default:"30s"`
+	turns                   *InFlightTurns
 }
 
 // Initialize registers the StreamChat use case in the dependency container.
-func (i InitStreamChat) Initialize(ctx context.Context) (context.Context, error) {
+func (i *InitStreamChat) Initialize(ctx context.Context) (context.Context, error) {
+	experiment, err := parseChatExperiment(i.Experiment)
+	if err != nil {
+		return ctx, err
+	}
+
+	// The initialization context ends with the shutdown signal, so draining starts before the servers stop.
+	i.turns = NewInFlightTurns(i.Logger, i.ShutdownDrainTimeout)
+	context.AfterFunc(ctx, i.turns.Drain)
+
+	// Content moderation is optional: the moderator is only registered when a provider is configured.
+	moderator, _ := depend.Resolve[assistant.Moderator]()
+	redactor, _ := depend.Resolve[assistant.Redactor]()
 	useCase := NewStreamChatImpl(
 		i.Logger,
 		i.TimeProvider,
 		i.ConversationRepo,
+		i.ChatMessageRepo,
+		i.ModelCatalog,
 		i.ConversationCompactor,
-		assistant.CompactionPolicy{TriggerTokenCount: i.CompactionTriggerTokens},
+		i.ConversationSnapshotter,
+		i.RelatedConversations,
+		i.TopicShiftDetector,
+		i.TopicShiftAutoSplit,
+		assistant.CompactionPolicy{
+			TriggerTokenCount: i.CompactionTriggerTokens,
+			TriggerTurnCount:  i.SnapshotEveryTurns,
+		},
 		i.CompactionTimeout,
 		i.MaxActionCycles,
+		i.CheckpointBytes,
+		experiment,
+		moderator,
+		redactor,
 		i.StateBuilder,
 		i.TurnRunner,
 		i.TranscriptWriter,
+		i.Streams,
+		i.turns,
 	)
 	depend.Register[StreamChat](useCase)
 	return ctx, nil
 }
 
+// Close waits for the in-flight turns to drain.
+func (i *InitStreamChat) Close() {
+	if i == nil || i.turns == nil {
+		return
+	}
+	i.turns.Drain()
+}
+
+// InitPreviewChat is the initializer for the PreviewChat use case.
+type InitPreviewChat struct {
+	StreamChat StreamChat          `resolve:""`
+	Sandbox    transaction.Sandbox `resolve:""`
+	Timeout    time.Duration       `config:"CHAT_PREVIEW_TIMEOUT" default:"60s"`
+}
+
+// Initialize registers the PreviewChat use case in the dependency container.
+func (i InitPreviewChat) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Timeout <= 0 {
+		return ctx, fmt.Errorf("invalid chat preview timeout %s: it must be positive", i.Timeout)
+	}
+	depend.Register[PreviewChat](NewPreviewChatImpl(i.StreamChat, i.Sandbox, i.Timeout))
+	return ctx, nil
+}
+
 // InitConversationTranscriptWriter is the initializer for the ConversationTranscriptWriter component.
 type InitConversationTranscriptWriter struct {
-	Uow       transaction.UnitOfWork `resolve:""`
-	Tokenizer assistant.Tokenizer    `resolve:""`
+	Uow                  transaction.UnitOfWork `resolve:""`
+	Tokenizer            assistant.Tokenizer    `resolve:""`
+	ContentBlobThreshold int                    `config:"CHAT_CONTENT_BLOB_THRESHOLD_BYTES" default:"8192"`
 }
 
 // Initialize registers the ConversationTranscriptWriter component in the dependency container.
 func (i InitConversationTranscriptWriter) Initialize(ctx context.Context) (context.Context, error) {
+	if i.ContentBlobThreshold < 0 {
+		return ctx, fmt.Errorf("invalid content blob threshold %d: bytes must not be negative", i.ContentBlobThreshold)
+	}
+	// Redaction is optional: the redactor is only registered when a pattern is enabled.
+	redactor, _ := depend.Resolve[assistant.Redactor]()
 	depend.Register[ConversationTranscriptWriter](NewConversationTranscriptWriterImpl(
 		i.Uow,
 		i.Tokenizer,
+		redactor,
+		i.ContentBlobThreshold,
 	))
 	return ctx, nil
 }
@@ -173,6 +341,7 @@ type InitActionPipeline struct {
 	ApprovalDispatcher assistant.ActionApprovalDispatcher `resolve:""`
 	TranscriptWriter   ConversationTranscriptWriter       `resolve:""`
 	TimeProvider       core.CurrentTimeProvider           `resolve:""`
+	ChangeRepo         todo.ChangeRepository              `resolve:""`
 }
 
 // Initialize registers the ActionPipeline component in the dependency container.
@@ -182,6 +351,7 @@ func (i InitActionPipeline) Initialize(ctx context.Context) (context.Context, er
 		i.ApprovalDispatcher,
 		i.TranscriptWriter,
 		i.TimeProvider,
+		i.ChangeRepo,
 	))
 	return ctx, nil
 }
@@ -191,6 +361,7 @@ type InitTurnRunner struct {
 	Logger         *log.Logger         `resolve:""`
 	Assistant      assistant.Assistant `resolve:""`
 	ActionPipeline ActionPipeline      `resolve:""`
+	TraceReasoning bool                `config:"CHAT_TRACE_REASONING" default:"false"`
 }
 
 // Initialize registers the TurnRunner component in the dependency container.
@@ -199,27 +370,227 @@ func (i InitTurnRunner) Initialize(ctx context.Context) (context.Context, error)
 		i.Logger,
 		i.Assistant,
 		i.ActionPipeline,
+		i.TraceReasoning,
 	))
 	return ctx, nil
 }
 
+// InitVerifyingTurnRunner wraps the registered TurnRunner with a check of the final answer against the action
+// results of the turn. It is disabled unless CHAT_VERIFICATION_MODEL is set, and must run after InitTurnRunner.
+type InitVerifyingTurnRunner struct {
+	Logger     *log.Logger         `resolve:""`
+	TurnRunner TurnRunner          `resolve:""`
+	Assistant  assistant.Assistant `resolve:""`
+	Model      string              `config:"CHAT_VERIFICATION_MODEL" default:""`
+	Timeout    time.Duration       `config:"CHAT_VERIFICATION_TIMEOUT" default:"10s"`
+}
+
+// Initialize registers the verifying TurnRunner in place of the registered one.
+func (i InitVerifyingTurnRunner) Initialize(ctx context.Context) (context.Context, error) {
+	if i.Model == "" {
+		return ctx, nil
+	}
+	verifier := NewAnswerVerifierImpl(i.Assistant, i.Model)
+	depend.Register[TurnRunner](NewVerifyingTurnRunner(i.Logger, i.TurnRunner, verifier, i.Timeout))
+	i.Logger.Printf("InitVerifyingTurnRunner: verifying chat answers with %s", i.Model)
+	return ctx, nil
+}
+
+// InitGroundingTurnRunner wraps the registered TurnRunner with the grounding check of the final answer against
+// the action results of the turn. It is enabled unless CHAT_GROUNDING_CHECK_ENABLED is false, and must run after
+// InitTurnRunner.
+type InitGroundingTurnRunner struct {
+	TurnRunner TurnRunner `resolve:""`
+	Enabled    bool       `config:"CHAT_GROUNDING_CHECK_ENABLED" default:"true"`
+}
+
+// Initialize registers the grounding TurnRunner in place of the registered one.
+func (i InitGroundingTurnRunner) Initialize(ctx context.Context) (context.Context, error) {
+	if !i.Enabled {
+		return ctx, nil
+	}
+	depend.Register[TurnRunner](NewGroundingTurnRunner(i.TurnRunner))
+	return ctx, nil
+}
+
+// InitShadowTurnRunner wraps the registered TurnRunner with the shadow evaluation of a candidate model.
+// It is disabled unless SHADOW_CANDIDATE_MODEL is set, and must run after InitTurnRunner.
+type InitShadowTurnRunner struct {
+	Logger           *log.Logger                          `resolve:""`
+	Reloader         core.ConfigReloader                  `resolve:""`
+	TurnRunner       TurnRunner                           `resolve:""`
+	Assistant        assistant.Assistant                  `resolve:""`
+	Repo             assistant.ShadowEvaluationRepository `resolve:""`
+	TimeProvider     core.CurrentTimeProvider             `resolve:""`
+	CandidateModel   string                               `config:"SHADOW_CANDIDATE_MODEL" default:""`
+	SampleRate       float64                              `config:"SHADOW_SAMPLE_RATE" default:"0.1"`
+	MaxConcurrent    int                                  `config:"SHADOW_MAX_CONCURRENCY" default:"2"`
+	DailyTokenBudget int                                  `config:"SHADOW_DAILY_TOKEN_BUDGET" default:"200000"`
+	Timeout          time.Duration                        `config:"SHADOW_TIMEOUT" default:"60s"`
+	evaluator        *ShadowEvaluatorImpl
+}
+
+// Initialize registers the shadow evaluating TurnRunner in place of the registered one.
+// The candidate model, sample rate, daily token budget and timeout follow configuration reloads.
+func (i *InitShadowTurnRunner) Initialize(ctx context.Context) (context.Context, error) {
+	if i.CandidateModel == "" {
+		return ctx, nil
+	}
+	cfg := i.shadowConfig()
+	if err := cfg.Validate(); err != nil {
+		return ctx, fmt.Errorf("invalid shadow evaluation config: %w", err)
+	}
+
+	i.evaluator = NewShadowEvaluatorImpl(i.Logger, i.Assistant, i.Repo, i.TimeProvider, cfg)
+	if i.Reloader != nil {
+		evaluator := i.evaluator
+		i.Reloader.OnReload("shadow_evaluation", func(ctx context.Context) error {
+			reloaded := *i
+			if err := config.LoadStruct(ctx, &reloaded); err != nil {
+				return err
+			}
+			cfg := reloaded.shadowConfig()
+			if err := cfg.Validate(); err != nil {
+				return fmt.Errorf("invalid shadow evaluation config: %w", err)
+			}
+			evaluator.SetConfig(cfg)
+			return nil
+		})
+	}
+	depend.Register[TurnRunner](NewShadowTurnRunner(i.TurnRunner, i.evaluator))
+	i.Logger.Printf("InitShadowTurnRunner: replaying %.0f%% of the chat turns against %s", cfg.SampleRate*100, cfg.CandidateModel)
+	return ctx, nil
+}
+
+// shadowConfig returns the configured shadow evaluation.
+func (i *InitShadowTurnRunner) shadowConfig() ShadowConfig {
+	return ShadowConfig{
+		CandidateModel:   i.CandidateModel,
+		SampleRate:       i.SampleRate,
+		MaxConcurrent:    i.MaxConcurrent,
+		DailyTokenBudget: i.DailyTokenBudget,
+		Timeout:          i.Timeout,
+	}
+}
+
+// Close waits for the running shadow evaluations.
+func (i *InitShadowTurnRunner) Close() {
+	if i == nil || i.evaluator == nil {
+		return
+	}
+	i.evaluator.Close()
+}
+
 // InitTurnStateBuilder is the initializer for the TurnStateBuilder component.
 type InitTurnStateBuilder struct {
-	ConversationSummaryRepo assistant.ConversationSummaryRepository `resolve:""`
-	ChatMessageRepo         assistant.ChatMessageRepository         `resolve:""`
-	TimeProvider            core.CurrentTimeProvider                `resolve:""`
-	SkillRegistry           assistant.SkillRegistry                 `resolve:""`
-	ActionRegistry          assistant.ActionRegistry                `resolve:""`
+	ConversationSummaryRepo  assistant.ConversationSummaryRepository  `resolve:""`
+	ConversationSnapshotRepo assistant.ConversationSnapshotRepository `resolve:""`
+	InstructionRepo          assistant.InstructionRepository          `resolve:""`
+	Memories                 Memories                                 `resolve:""`
+	RelatedConversations     RelatedConversations                     `resolve:""`
+	ChatMessageRepo          assistant.ChatMessageRepository          `resolve:""`
+	TimeProvider             core.CurrentTimeProvider                 `resolve:""`
+	SkillRegistry            assistant.SkillRegistry                  `resolve:""`
+	ActionRegistry           assistant.ActionRegistry                 `resolve:""`
+	ContentBlobRepo          assistant.ContentBlobRepository          `resolve:""`
+	ContentBlobMaxBytes      int                                      `config:"CHAT_CONTENT_BLOB_PROMPT_MAX_BYTES" default:"8192"`
 }
 
 // Initialize registers the TurnStateBuilder component in the dependency container.
 func (i InitTurnStateBuilder) Initialize(ctx context.Context) (context.Context, error) {
+	if i.ContentBlobMaxBytes < 0 {
+		return ctx, fmt.Errorf("invalid content blob prompt limit %d: bytes must not be negative", i.ContentBlobMaxBytes)
+	}
 	depend.Register[TurnStateBuilder](NewTurnStateBuilderImpl(
 		i.ConversationSummaryRepo,
+		i.ConversationSnapshotRepo,
+		i.InstructionRepo,
+		i.Memories,
+		i.RelatedConversations,
 		i.ChatMessageRepo,
 		i.TimeProvider,
 		i.SkillRegistry,
 		i.ActionRegistry,
+		i.ContentBlobRepo,
+		i.ContentBlobMaxBytes,
+	))
+	return ctx, nil
+}
+
+// InitInstructions is the initializer for the Instructions use case.
+type InitInstructions struct {
+	InstructionRepo  assistant.InstructionRepository  `resolve:""`
+	ConversationRepo assistant.ConversationRepository `resolve:""`
+	TimeProvider     core.CurrentTimeProvider         `resolve:""`
+}
+
+// Initialize registers the Instructions use case in the dependency container.
+func (i InitInstructions) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Instructions](NewInstructionsImpl(i.InstructionRepo, i.ConversationRepo, i.TimeProvider))
+	return ctx, nil
+}
+
+// InitMemories is the initializer for the Memories use case.
+type InitMemories struct {
+	MemoryRepo     assistant.MemoryRepository `resolve:""`
+	Encoder        semantic.Encoder           `resolve:""`
+	EmbeddingModel string                     `config:"LLM_EMBEDDING_MODEL"`
+}
+
+// Initialize registers the Memories use case in the dependency container.
+func (i InitMemories) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Memories](NewMemoriesImpl(i.MemoryRepo, i.Encoder, i.EmbeddingModel))
+	return ctx, nil
+}
+
+// InitRelatedConversations is the initializer for the RelatedConversations use case.
+type InitRelatedConversations struct {
+	ConversationRepo        assistant.ConversationRepository        `resolve:""`
+	ConversationSummaryRepo assistant.ConversationSummaryRepository `resolve:""`
+	ConversationTopicRepo   assistant.ConversationTopicRepository   `resolve:""`
+	Encoder                 semantic.Encoder                        `resolve:""`
+	EmbeddingModel          string                                  `config:"LLM_EMBEDDING_MODEL"`
+}
+
+// Initialize registers the RelatedConversations use case in the dependency container.
+func (i InitRelatedConversations) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[RelatedConversations](NewRelatedConversationsImpl(
+		i.ConversationRepo,
+		i.ConversationSummaryRepo,
+		i.ConversationTopicRepo,
+		i.Encoder,
+		i.EmbeddingModel,
+	))
+	return ctx, nil
+}
+
+// InitExtractMemories is the initializer for the ExtractMemories use case.
+type InitExtractMemories struct {
+	ConversationRepo assistant.ConversationRepository `resolve:""`
+	ChatMessageRepo  assistant.ChatMessageRepository  `resolve:""`
+	MemoryRepo       assistant.MemoryRepository       `resolve:""`
+	Lock             core.Locker                      `resolve:""`
+	TimeProvider     core.CurrentTimeProvider         `resolve:""`
+	Assistant        assistant.Assistant              `resolve:""`
+	Encoder          semantic.Encoder                 `resolve:""`
+	Model            string                           `config:"LLM_SUMMARY_MODEL"`
+	EmbeddingModel   string                           `config:"LLM_EMBEDDING_MODEL"`
+	Idle             time.Duration                    `config:"MEMORY_EXTRACTION_IDLE" default:"30m"`
+}
+
+// Initialize registers the ExtractMemories use case in the dependency container.
+func (i InitExtractMemories) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ExtractMemories](NewExtractMemoriesImpl(
+		i.ConversationRepo,
+		i.ChatMessageRepo,
+		i.MemoryRepo,
+		i.Lock,
+		i.TimeProvider,
+		i.Assistant,
+		i.Encoder,
+		i.Model,
+		i.EmbeddingModel,
+		i.Idle,
 	))
 	return ctx, nil
 }
@@ -239,10 +610,117 @@ func (i InitSubmitActionApproval) Initialize(ctx context.Context) (context.Conte
 type InitUpdateConversation struct {
 	Uow          transaction.UnitOfWork   `resolve:""`
 	TimeProvider core.CurrentTimeProvider `resolve:""`
+	ModelCatalog assistant.ModelCatalog   `resolve:""`
 }
 
 // Initialize registers the UpdateConversation use case in the dependency container.
 func (i InitUpdateConversation) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[UpdateConversation](NewUpdateConversationImpl(i.Uow, i.TimeProvider))
+	depend.Register[UpdateConversation](NewUpdateConversationImpl(i.Uow, i.TimeProvider, i.ModelCatalog))
 	return ctx, nil
 }
+
+// InitMarkConversationRead is the initializer for the MarkConversationRead use case.
+type InitMarkConversationRead struct {
+	ConversationRepo assistant.ConversationRepository           `resolve:""`
+	ReadCursorRepo   assistant.ConversationReadCursorRepository `resolve:""`
+	TimeProvider     core.CurrentTimeProvider                   `resolve:""`
+}
+
+// Initialize registers the MarkConversationRead use case in the dependency container.
+func (i InitMarkConversationRead) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[MarkConversationRead](NewMarkConversationReadImpl(i.ConversationRepo, i.ReadCursorRepo, i.TimeProvider))
+	return ctx, nil
+}
+
+// InitCountUnreadMessages is the initializer for the CountUnreadMessages use case.
+type InitCountUnreadMessages struct {
+	ReadCursorRepo assistant.ConversationReadCursorRepository `resolve:""`
+}
+
+// Initialize registers the CountUnreadMessages use case in the dependency container.
+func (i InitCountUnreadMessages) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[CountUnreadMessages](NewCountUnreadMessagesImpl(i.ReadCursorRepo))
+	return ctx, nil
+}
+
+// InitPostSystemMessage is the initializer for the PostSystemMessage use case.
+type InitPostSystemMessage struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// Initialize registers the PostSystemMessage use case in the dependency container.
+func (i InitPostSystemMessage) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[PostSystemMessage](NewPostSystemMessageImpl(i.Uow, i.TimeProvider))
+	return ctx, nil
+}
+
+// InitSubmitMessageFeedback is the initializer for the SubmitMessageFeedback use case.
+type InitSubmitMessageFeedback struct {
+	Repo assistant.ChatMessageRepository `resolve:""`
+}
+
+// Initialize registers the SubmitMessageFeedback use case in the dependency container.
+func (i InitSubmitMessageFeedback) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[SubmitMessageFeedback](NewSubmitMessageFeedbackImpl(i.Repo))
+	return ctx, nil
+}
+
+// InitReplayTurn is the initializer for the ReplayTurn use case.
+type InitReplayTurn struct {
+	ConversationRepo assistant.ConversationRepository `resolve:""`
+	ChatMessageRepo  assistant.ChatMessageRepository  `resolve:""`
+	StateBuilder     TurnStateBuilder                 `resolve:""`
+	Assistant        assistant.Assistant              `resolve:""`
+}
+
+// Initialize registers the ReplayTurn use case in the dependency container.
+func (i InitReplayTurn) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ReplayTurn](NewReplayTurnImpl(i.ConversationRepo, i.ChatMessageRepo, i.StateBuilder, i.Assistant))
+	return ctx, nil
+}
+
+// InitGetTurnTranscript is the initializer for the GetTurnTranscript use case.
+type InitGetTurnTranscript struct {
+	ConversationRepo assistant.ConversationRepository `resolve:""`
+	ChatMessageRepo  assistant.ChatMessageRepository  `resolve:""`
+}
+
+// Initialize registers the GetTurnTranscript use case in the dependency container.
+func (i InitGetTurnTranscript) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[GetTurnTranscript](NewGetTurnTranscriptImpl(i.ConversationRepo, i.ChatMessageRepo))
+	return ctx, nil
+}
+
+// InitGetExperimentReport is the initializer for the GetExperimentReport use case.
+type InitGetExperimentReport struct {
+	Repo       assistant.ExperimentRepository `resolve:""`
+	Experiment string                         `config:"CHAT_EXPERIMENT" default:""`
+}
+
+// Initialize registers the GetExperimentReport use case in the dependency container.
+func (i InitGetExperimentReport) Initialize(ctx context.Context) (context.Context, error) {
+	experiment, err := parseChatExperiment(i.Experiment)
+	if err != nil {
+		return ctx, err
+	}
+	depend.Register[GetExperimentReport](NewGetExperimentReportImpl(i.Repo, experiment))
+	return ctx, nil
+}
+
+// parseChatExperiment parses the CHAT_EXPERIMENT configuration and checks its prompt versions exist.
+func parseChatExperiment(raw string) (*assistant.Experiment, error) {
+	experiment, err := assistant.ParseExperiment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chat experiment: %w", err)
+	}
+	if experiment == nil {
+		return nil, nil
+	}
+	for _, variant := range experiment.Variants {
+		if err := ValidateChatPromptVersion(variant.PromptVersion); err != nil {
+			return nil, fmt.Errorf("invalid chat experiment: variant %s: %w", variant.Name, err)
+		}
+	}
+	return experiment, nil
+}
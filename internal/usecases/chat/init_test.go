@@ -1,7 +1,10 @@
 package chat
 
 import (
+	"io"
+	"log"
 	"testing"
+	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
@@ -37,6 +40,34 @@ func TestInitConversationCompactor_Initialize(t *testing.T) {
 	assert.NotNil(t, uc)
 }
 
+func TestInitConversationSnapshotter_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitConversationSnapshotter{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	component, err := depend.Resolve[ConversationSnapshotter]()
+	assert.NoError(t, err)
+	assert.NotNil(t, component)
+}
+
+func TestInitTopicShiftDetector_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitTopicShiftDetector{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	component, err := depend.Resolve[TopicShiftDetector]()
+	assert.NoError(t, err)
+	assert.NotNil(t, component)
+}
+
 func TestInitGenerateConversationTitle_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -94,10 +125,22 @@ func TestInitListConversations_Initialize(t *testing.T) {
 	assert.NotNil(t, registeredListConversations)
 }
 
+func TestInitProjectConversationReadModel_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitProjectConversationReadModel{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[ProjectConversationReadModel]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
 func TestInitStreamChat_Initialize(t *testing.T) {
 	t.Parallel()
 
-	i := InitStreamChat{}
+	i := &InitStreamChat{}
 
 	ctx, err := i.Initialize(t.Context())
 	assert.NoError(t, err)
@@ -109,6 +152,26 @@ func TestInitStreamChat_Initialize(t *testing.T) {
 	assert.NotNil(t, streamChatUseCase)
 }
 
+func TestInitPreviewChat_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitPreviewChat{Timeout: time.Minute}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[PreviewChat]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
+func TestInitPreviewChat_InvalidTimeout(t *testing.T) {
+	t.Parallel()
+
+	i := InitPreviewChat{}
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+}
+
 func TestInitConversationTranscriptWriter_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -121,6 +184,14 @@ func TestInitConversationTranscriptWriter_Initialize(t *testing.T) {
 	assert.NotNil(t, component)
 }
 
+func TestInitConversationTranscriptWriter_InvalidContentBlobThreshold(t *testing.T) {
+	t.Parallel()
+
+	i := InitConversationTranscriptWriter{ContentBlobThreshold: -1}
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+}
+
 func TestInitActionPipeline_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -145,6 +216,86 @@ func TestInitTurnRunner_Initialize(t *testing.T) {
 	assert.NotNil(t, component)
 }
 
+func TestInitVerifyingTurnRunner_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitVerifyingTurnRunner{
+		Logger:     log.New(io.Discard, "", 0),
+		TurnRunner: NewMockTurnRunner(t),
+		Model:      "verifier",
+	}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	component, err := depend.Resolve[TurnRunner]()
+	assert.NoError(t, err)
+	assert.NotNil(t, component)
+}
+
+func TestInitGroundingTurnRunner_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitGroundingTurnRunner{
+		TurnRunner: NewMockTurnRunner(t),
+		Enabled:    true,
+	}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	component, err := depend.Resolve[TurnRunner]()
+	assert.NoError(t, err)
+	assert.NotNil(t, component)
+}
+
+func TestInitShadowTurnRunner_Initialize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		init        InitShadowTurnRunner
+		expectedErr bool
+		enabled     bool
+	}{
+		"disabled": {
+			init: InitShadowTurnRunner{},
+		},
+		"enabled": {
+			init: InitShadowTurnRunner{
+				CandidateModel: "candidate",
+				SampleRate:     0.1,
+				MaxConcurrent:  2,
+				Timeout:        time.Minute,
+			},
+			enabled: true,
+		},
+		"invalid-config": {
+			init: InitShadowTurnRunner{
+				CandidateModel: "candidate",
+				SampleRate:     2,
+				MaxConcurrent:  2,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			i := tt.init
+			i.Logger = log.New(io.Discard, "", 0)
+			i.TurnRunner = NewMockTurnRunner(t)
+			_, err := i.Initialize(t.Context())
+			defer i.Close()
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.enabled, i.evaluator != nil)
+		})
+	}
+}
+
 func TestInitTurnStateBuilder_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -157,6 +308,62 @@ func TestInitTurnStateBuilder_Initialize(t *testing.T) {
 	assert.NotNil(t, component)
 }
 
+func TestInitTurnStateBuilder_InvalidContentBlobMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	i := InitTurnStateBuilder{ContentBlobMaxBytes: -1}
+	_, err := i.Initialize(t.Context())
+	assert.Error(t, err)
+}
+
+func TestInitInstructions_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitInstructions{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	uc, err := depend.Resolve[Instructions]()
+	assert.NoError(t, err)
+	assert.NotNil(t, uc)
+}
+
+func TestInitMemories_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitMemories{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	uc, err := depend.Resolve[Memories]()
+	assert.NoError(t, err)
+	assert.NotNil(t, uc)
+}
+
+func TestInitRelatedConversations_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitRelatedConversations{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	uc, err := depend.Resolve[RelatedConversations]()
+	assert.NoError(t, err)
+	assert.NotNil(t, uc)
+}
+
+func TestInitExtractMemories_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitExtractMemories{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	uc, err := depend.Resolve[ExtractMemories]()
+	assert.NoError(t, err)
+	assert.NotNil(t, uc)
+}
+
 func TestInitSubmitActionApproval_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -186,3 +393,186 @@ func TestInitUpdateConversation_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, registeredUpdateConversation)
 }
+
+func TestInitMarkConversationRead_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitMarkConversationRead{}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	registered, err := depend.Resolve[MarkConversationRead]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitCountUnreadMessages_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitCountUnreadMessages{}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	registered, err := depend.Resolve[CountUnreadMessages]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitPostSystemMessage_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitPostSystemMessage{}
+
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	registered, err := depend.Resolve[PostSystemMessage]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitGetContentBlob_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitGetContentBlob{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[GetContentBlob]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
+func TestInitMaintainChatMessagePartitions_Initialize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		init      InitMaintainChatMessagePartitions
+		expectErr bool
+	}{
+		"success": {
+			init: InitMaintainChatMessagePartitions{MonthsAhead: 2, RetentionMonths: 12},
+		},
+		"negative-months-ahead": {
+			init:      InitMaintainChatMessagePartitions{MonthsAhead: -1},
+			expectErr: true,
+		},
+		"negative-retention": {
+			init:      InitMaintainChatMessagePartitions{RetentionMonths: -1},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tt.init.Initialize(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			useCase, err := depend.Resolve[MaintainChatMessagePartitions]()
+			assert.NoError(t, err)
+			assert.NotNil(t, useCase)
+		})
+	}
+}
+
+func TestInitSubmitMessageFeedback_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitSubmitMessageFeedback{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[SubmitMessageFeedback]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
+func TestInitReplayTurn_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitReplayTurn{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[ReplayTurn]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
+func TestInitGetTurnTranscript_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitGetTurnTranscript{}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[GetTurnTranscript]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
+func TestInitGetExperimentReport_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitGetExperimentReport{
+		Experiment: `{"name":"prompt-v2","variants":[{"name":"control","weight":1},{"name":"concise","weight":1,"prompt_version":"concise"}]}`,
+	}
+	_, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+
+	useCase, err := depend.Resolve[GetExperimentReport]()
+	assert.NoError(t, err)
+	assert.NotNil(t, useCase)
+}
+
+func TestParseChatExperiment(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		raw                string
+		expectedExperiment *assistant.Experiment
+		expectedErr        string
+	}{
+		"disabled": {
+			raw: "",
+		},
+		"valid": {
+			raw: `{"name":"prompt-v2","variants":[{"name":"control","weight":1},{"name":"concise","weight":1,"prompt_version":"concise"}]}`,
+			expectedExperiment: &assistant.Experiment{
+				Name: "prompt-v2",
+				Variants: []assistant.ExperimentVariant{
+					{Name: "control", Weight: 1},
+					{Name: "concise", Weight: 1, PromptVersion: "concise"},
+				},
+			},
+		},
+		"invalid-json": {
+			raw:         `{"name":`,
+			expectedErr: "invalid chat experiment: invalid experiment JSON: unexpected end of JSON input",
+		},
+		"unknown-prompt-version": {
+			raw:         `{"name":"prompt-v2","variants":[{"name":"control","weight":1},{"name":"verbose","weight":1,"prompt_version":"verbose"}]}`,
+			expectedErr: `invalid chat experiment: variant verbose: unknown chat prompt version "verbose"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			experiment, err := parseChatExperiment(tt.raw)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedExperiment, experiment)
+		})
+	}
+}
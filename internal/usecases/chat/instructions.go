@@ -0,0 +1,135 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// Instructions defines the interface for managing the pinned instructions added to the chat system prompt.
+type Instructions interface {
+	// List lists the global instructions followed by the ones pinned to the conversation.
+	// A nil conversation ID lists only the global instructions.
+	List(ctx context.Context, conversationID uuid.UUID) ([]assistant.Instruction, error)
+	// Remember pins an instruction to the conversation, or stores it globally when conversationID is nil.
+	Remember(ctx context.Context, conversationID *uuid.UUID, text string) (assistant.Instruction, error)
+	// Delete removes an instruction.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// InstructionsImpl is the implementation of the Instructions use case.
+type InstructionsImpl struct {
+	instructionRepo  assistant.InstructionRepository
+	conversationRepo assistant.ConversationRepository
+	timeProvider     core.CurrentTimeProvider
+}
+
+// NewInstructionsImpl creates a new instance of InstructionsImpl.
+func NewInstructionsImpl(
+	instructionRepo assistant.InstructionRepository,
+	conversationRepo assistant.ConversationRepository,
+	timeProvider core.CurrentTimeProvider,
+) InstructionsImpl {
+	return InstructionsImpl{
+		instructionRepo:  instructionRepo,
+		conversationRepo: conversationRepo,
+		timeProvider:     timeProvider,
+	}
+}
+
+// List lists the global instructions followed by the ones pinned to the conversation.
+func (uc InstructionsImpl) List(ctx context.Context, conversationID uuid.UUID) ([]assistant.Instruction, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	instructions, err := uc.instructionRepo.ListInstructions(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return instructions, nil
+}
+
+// Remember pins an instruction to the conversation, or stores it globally when conversationID is nil.
+// Remembering the same text again in the same scope returns the existing instruction.
+func (uc InstructionsImpl) Remember(ctx context.Context, conversationID *uuid.UUID, text string) (assistant.Instruction, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	instruction := assistant.Instruction{
+		ID:             uuid.New(),
+		ConversationID: conversationID,
+		Text:           strings.TrimSpace(text),
+		CreatedAt:      uc.timeProvider.Now(),
+	}
+	if err := instruction.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return assistant.Instruction{}, err
+	}
+
+	listID := uuid.Nil
+	if conversationID != nil {
+		_, found, err := uc.conversationRepo.GetConversation(spanCtx, *conversationID)
+		if telemetry.IsErrorRecorded(span, err) {
+			return assistant.Instruction{}, err
+		}
+		if !found {
+			err := core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", *conversationID))
+			telemetry.IsErrorRecorded(span, err)
+			return assistant.Instruction{}, err
+		}
+		listID = *conversationID
+	}
+
+	current, err := uc.instructionRepo.ListInstructions(spanCtx, listID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.Instruction{}, err
+	}
+	inScope := 0
+	for _, existing := range current {
+		if existing.IsGlobal() != instruction.IsGlobal() {
+			continue
+		}
+		if strings.EqualFold(existing.Text, instruction.Text) {
+			return existing, nil
+		}
+		inScope++
+	}
+	if inScope >= assistant.MaxPinnedInstructions {
+		err := core.NewValidationErr(fmt.Sprintf("cannot pin more than %d instructions; delete one first", assistant.MaxPinnedInstructions))
+		telemetry.IsErrorRecorded(span, err)
+		return assistant.Instruction{}, err
+	}
+
+	if err := uc.instructionRepo.CreateInstruction(spanCtx, instruction); telemetry.IsErrorRecorded(span, err) {
+		return assistant.Instruction{}, err
+	}
+
+	return instruction, nil
+}
+
+// Delete removes an instruction.
+func (uc InstructionsImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, found, err := uc.instructionRepo.GetInstruction(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if !found {
+		err := core.NewNotFoundErr(fmt.Sprintf("instruction with ID %s not found", id))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+
+	if err := uc.instructionRepo.DeleteInstruction(spanCtx, id); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
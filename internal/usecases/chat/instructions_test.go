@@ -0,0 +1,220 @@
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInstructionsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.New()
+	instructions := []assistant.Instruction{{ID: uuid.New(), Text: "Always use DD/MM dates"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *assistant.MockInstructionRepository)
+		expected        []assistant.Instruction
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *assistant.MockInstructionRepository) {
+				repo.EXPECT().ListInstructions(mock.Anything, conversationID).Return(instructions, nil).Once()
+			},
+			expected: instructions,
+		},
+		"repository-error": {
+			setExpectations: func(repo *assistant.MockInstructionRepository) {
+				repo.EXPECT().ListInstructions(mock.Anything, conversationID).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockInstructionRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewInstructionsImpl(repo, assistant.NewMockConversationRepository(t), core.NewMockCurrentTimeProvider(t))
+			got, err := uc.List(t.Context(), conversationID)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestInstructionsImpl_Remember(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	existing := assistant.Instruction{
+		ID:             uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+		ConversationID: &conversationID,
+		Text:           "Always use DD/MM dates",
+	}
+	full := make([]assistant.Instruction, assistant.MaxPinnedInstructions)
+	for i := range full {
+		full[i] = assistant.Instruction{ID: uuid.New(), Text: fmt.Sprintf("instruction %d", i)}
+	}
+
+	tests := map[string]struct {
+		conversationID  *uuid.UUID
+		text            string
+		setExpectations func(repo *assistant.MockInstructionRepository, conversations *assistant.MockConversationRepository)
+		validate        func(t *testing.T, got assistant.Instruction)
+		expectedErr     error
+	}{
+		"pins-to-conversation": {
+			conversationID: &conversationID,
+			text:           " Answer in Portuguese ",
+			setExpectations: func(repo *assistant.MockInstructionRepository, conversations *assistant.MockConversationRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil).Once()
+				repo.EXPECT().ListInstructions(mock.Anything, conversationID).Return([]assistant.Instruction{existing}, nil).Once()
+				repo.EXPECT().CreateInstruction(mock.Anything, mock.MatchedBy(func(i assistant.Instruction) bool {
+					return i.ID != uuid.Nil && i.Text == "Answer in Portuguese" && *i.ConversationID == conversationID && i.CreatedAt.Equal(now)
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got assistant.Instruction) {
+				assert.Equal(t, "Answer in Portuguese", got.Text)
+				assert.Equal(t, &conversationID, got.ConversationID)
+			},
+		},
+		"stores-global": {
+			text: "Answer in Portuguese",
+			setExpectations: func(repo *assistant.MockInstructionRepository, _ *assistant.MockConversationRepository) {
+				repo.EXPECT().ListInstructions(mock.Anything, uuid.Nil).Return(full[:1], nil).Once()
+				repo.EXPECT().CreateInstruction(mock.Anything, mock.MatchedBy(func(i assistant.Instruction) bool {
+					return i.IsGlobal() && i.Text == "Answer in Portuguese"
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got assistant.Instruction) {
+				assert.True(t, got.IsGlobal())
+			},
+		},
+		"returns-existing-instruction": {
+			conversationID: &conversationID,
+			text:           "always use dd/mm dates",
+			setExpectations: func(repo *assistant.MockInstructionRepository, conversations *assistant.MockConversationRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil).Once()
+				repo.EXPECT().ListInstructions(mock.Anything, conversationID).Return([]assistant.Instruction{existing}, nil).Once()
+			},
+			validate: func(t *testing.T, got assistant.Instruction) {
+				assert.Equal(t, existing, got)
+			},
+		},
+		"global-instructions-do-not-count-for-conversation": {
+			conversationID: &conversationID,
+			text:           "Answer in Portuguese",
+			setExpectations: func(repo *assistant.MockInstructionRepository, conversations *assistant.MockConversationRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil).Once()
+				repo.EXPECT().ListInstructions(mock.Anything, conversationID).Return(full, nil).Once()
+				repo.EXPECT().CreateInstruction(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got assistant.Instruction) {
+				assert.Equal(t, "Answer in Portuguese", got.Text)
+			},
+		},
+		"limit-reached": {
+			text: "Answer in Portuguese",
+			setExpectations: func(repo *assistant.MockInstructionRepository, _ *assistant.MockConversationRepository) {
+				repo.EXPECT().ListInstructions(mock.Anything, uuid.Nil).Return(full, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("cannot pin more than 20 instructions; delete one first"),
+		},
+		"empty-text": {
+			text:            "  ",
+			setExpectations: func(*assistant.MockInstructionRepository, *assistant.MockConversationRepository) {},
+			expectedErr:     core.NewValidationErr("instruction cannot be empty"),
+		},
+		"conversation-not-found": {
+			conversationID: &conversationID,
+			text:           "Answer in Portuguese",
+			setExpectations: func(_ *assistant.MockInstructionRepository, conversations *assistant.MockConversationRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID 223e4567-e89b-12d3-a456-426614174001 not found"),
+		},
+		"create-error": {
+			text: "Answer in Portuguese",
+			setExpectations: func(repo *assistant.MockInstructionRepository, _ *assistant.MockConversationRepository) {
+				repo.EXPECT().ListInstructions(mock.Anything, uuid.Nil).Return(nil, nil).Once()
+				repo.EXPECT().CreateInstruction(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockInstructionRepository(t)
+			conversations := assistant.NewMockConversationRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Once()
+			tt.setExpectations(repo, conversations)
+
+			got, err := NewInstructionsImpl(repo, conversations, timeProvider).Remember(t.Context(), tt.conversationID, tt.text)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.validate != nil {
+				tt.validate(t, got)
+			}
+		})
+	}
+}
+
+func TestInstructionsImpl_Delete(t *testing.T) {
+	t.Parallel()
+
+	instructionID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setExpectations func(repo *assistant.MockInstructionRepository)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *assistant.MockInstructionRepository) {
+				repo.EXPECT().GetInstruction(mock.Anything, instructionID).Return(assistant.Instruction{ID: instructionID, ConversationID: common.Ptr(uuid.New())}, true, nil).Once()
+				repo.EXPECT().DeleteInstruction(mock.Anything, instructionID).Return(nil).Once()
+			},
+		},
+		"not-found": {
+			setExpectations: func(repo *assistant.MockInstructionRepository) {
+				repo.EXPECT().GetInstruction(mock.Anything, instructionID).Return(assistant.Instruction{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("instruction with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"delete-error": {
+			setExpectations: func(repo *assistant.MockInstructionRepository) {
+				repo.EXPECT().GetInstruction(mock.Anything, instructionID).Return(assistant.Instruction{ID: instructionID}, true, nil).Once()
+				repo.EXPECT().DeleteInstruction(mock.Anything, instructionID).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockInstructionRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewInstructionsImpl(repo, assistant.NewMockConversationRepository(t), core.NewMockCurrentTimeProvider(t))
+			err := uc.Delete(t.Context(), instructionID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 )
 
@@ -37,12 +38,17 @@ func (uc ListAvailableModelsImpl) Query(ctx context.Context) ([]assistant.ModelI
 		return nil, err
 	}
 
+	settings := tenant.FromContext(spanCtx).Settings
 	res := make([]assistant.ModelInfo, 0, len(assistantModels))
 	for _, m := range assistantModels {
+		if !settings.AllowsModel(m.ID) {
+			continue
+		}
 		res = append(res, assistant.ModelInfo{
-			ID:   m.ID,
-			Name: m.Name,
-			Kind: assistant.ModelKindAssistant,
+			ID:              m.ID,
+			Name:            m.Name,
+			Kind:            assistant.ModelKindAssistant,
+			MaxOutputTokens: m.MaxOutputTokens,
 		})
 	}
 	return res, nil
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -13,6 +14,7 @@ func TestListAvailableModelsImpl_Query(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
+		tenantSettings  tenant.Settings
 		setExpectations func(*assistant.MockModelCatalog)
 		expectedModels  []assistant.ModelInfo
 		expectedErr     error
@@ -22,15 +24,30 @@ func TestListAvailableModelsImpl_Query(t *testing.T) {
 				assistantCatalog.EXPECT().
 					ListModels(mock.Anything).
 					Return([]assistant.ModelCapabilities{
-						{ID: "gpt-4", Name: "gpt-4"},
+						{ID: "gpt-4", Name: "gpt-4", MaxOutputTokens: 4096},
 					}, nil).
 					Once()
 			},
 			expectedModels: []assistant.ModelInfo{
-				{ID: "gpt-4", Name: "gpt-4", Kind: assistant.ModelKindAssistant},
+				{ID: "gpt-4", Name: "gpt-4", Kind: assistant.ModelKindAssistant, MaxOutputTokens: 4096},
 			},
 			expectedErr: nil,
 		},
+		"filters-models-not-enabled-for-tenant": {
+			tenantSettings: tenant.Settings{Models: []string{"gpt-4"}},
+			setExpectations: func(assistantCatalog *assistant.MockModelCatalog) {
+				assistantCatalog.EXPECT().
+					ListModels(mock.Anything).
+					Return([]assistant.ModelCapabilities{
+						{ID: "gpt-4", Name: "gpt-4", MaxOutputTokens: 4096},
+						{ID: "qwen3", Name: "qwen3", MaxOutputTokens: 1024},
+					}, nil).
+					Once()
+			},
+			expectedModels: []assistant.ModelInfo{
+				{ID: "gpt-4", Name: "gpt-4", Kind: assistant.ModelKindAssistant, MaxOutputTokens: 4096},
+			},
+		},
 		"assistant-catalog-error": {
 			setExpectations: func(assistantCatalog *assistant.MockModelCatalog) {
 				assistantCatalog.EXPECT().
@@ -51,7 +68,8 @@ func TestListAvailableModelsImpl_Query(t *testing.T) {
 			uc := NewListAvailableModelsImpl(
 				assistantCatalog,
 			)
-			got, err := uc.Query(t.Context())
+			ctx := tenant.NewContext(t.Context(), tenant.Tenant{ID: "acme", Settings: tt.tenantSettings})
+			got, err := uc.Query(ctx)
 
 			assert.Equal(t, tt.expectedErr, err)
 			assert.Equal(t, tt.expectedModels, got)
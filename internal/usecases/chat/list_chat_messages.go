@@ -99,6 +99,7 @@ func projectTurnMessages(messages []assistant.ChatMessage) []assistant.ChatMessa
 			}
 			if result, found := actionResultsByID[actionCall.ID]; found {
 				detail.Output = result.Content
+				detail.OutputRef = result.ContentRef
 				detail.MessageState = result.MessageState
 				detail.ErrorMessage = result.ErrorMessage
 				detail.ApprovalStatus = result.ApprovalStatus
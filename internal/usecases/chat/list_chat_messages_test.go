@@ -69,6 +69,7 @@ func TestListChatMessagesImpl_Query(t *testing.T) {
 							ChatRole:               assistant.ChatRole_Tool,
 							ActionCallID:           common.Ptr("call-1"),
 							Content:                "todo deleted",
+							ContentRef:             common.Ptr("blob-hash"),
 							MessageState:           assistant.ChatMessageState_Completed,
 							ApprovalStatus:         &approvalStatus,
 							ApprovalDecisionReason: common.Ptr("approved by user"),
@@ -111,6 +112,7 @@ func TestListChatMessagesImpl_Query(t *testing.T) {
 							Input:                  `{"todos":[{"id":"1"}]}`,
 							Text:                   "Deleting todos...",
 							Output:                 "todo deleted",
+							OutputRef:              common.Ptr("blob-hash"),
 							MessageState:           assistant.ChatMessageState_Completed,
 							ApprovalStatus:         &approvalStatus,
 							ApprovalDecisionReason: common.Ptr("approved by user"),
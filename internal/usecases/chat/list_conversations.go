@@ -6,6 +6,7 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // ListConversations returns paginated conversation summaries for the chat UI.
@@ -17,16 +18,23 @@ type ListConversations interface {
 // ListConversationsImpl implements ListConversations.
 type ListConversationsImpl struct {
 	conversationRepo assistant.ConversationRepository
+	readModelRepo    assistant.ConversationReadModelRepository
 }
 
 // NewListConversationsImpl creates a ListConversationsImpl.
-func NewListConversationsImpl(conversationRepo assistant.ConversationRepository) *ListConversationsImpl {
+func NewListConversationsImpl(
+	conversationRepo assistant.ConversationRepository,
+	readModelRepo assistant.ConversationReadModelRepository,
+) *ListConversationsImpl {
 	return &ListConversationsImpl{
 		conversationRepo: conversationRepo,
+		readModelRepo:    readModelRepo,
 	}
 }
 
 // Query implements ListConversations.
+// The context token usage is served from the conversation read model. Conversations whose projection is missing
+// or trails their last message, while the projector catches up, have their usage computed from the messages.
 func (uc *ListConversationsImpl) Query(ctx context.Context, page int, pageSize int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error) {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
@@ -41,10 +49,34 @@ func (uc *ListConversationsImpl) Query(ctx context.Context, page int, pageSize i
 		conversationIDs = append(conversationIDs, conversation.ID)
 	}
 
-	usageByConversationID, err := uc.conversationRepo.GetConversationContextTokenUsage(spanCtx, conversationIDs)
+	readModels, err := uc.readModelRepo.ListConversationReadModels(spanCtx, conversationIDs)
 	if telemetry.IsErrorRecorded(span, err) {
 		return nil, nil, false, err
 	}
 
+	usageByConversationID := make(map[uuid.UUID]int64, len(conversations))
+	var staleIDs []uuid.UUID
+	for _, conversation := range conversations {
+		readModel, found := readModels[conversation.ID]
+		if !found || !readModel.IsCurrent(conversation) {
+			staleIDs = append(staleIDs, conversation.ID)
+			continue
+		}
+		usageByConversationID[conversation.ID] = readModel.ContextTokensUsed
+	}
+	span.SetAttributes(attribute.Int("stale_projections", len(staleIDs)))
+
+	if len(staleIDs) == 0 {
+		return conversations, usageByConversationID, hasMore, nil
+	}
+
+	liveUsage, err := uc.conversationRepo.GetConversationContextTokenUsage(spanCtx, staleIDs)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, nil, false, err
+	}
+	for conversationID, usage := range liveUsage {
+		usageByConversationID[conversationID] = usage
+	}
+
 	return conversations, usageByConversationID, hasMore, nil
 }
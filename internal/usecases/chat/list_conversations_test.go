@@ -16,9 +16,29 @@ func TestListConversationsImpl_Query(t *testing.T) {
 	t.Parallel()
 
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastMessageAt := fixedTime.Add(time.Hour)
+	previousMessageAt := fixedTime.Add(30 * time.Minute)
+
+	c1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	c2 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	conversation1 := assistant.Conversation{
+		ID:            c1,
+		Title:         "Conversation 1",
+		TitleSource:   assistant.ConversationTitleSource_User,
+		LastMessageAt: &lastMessageAt,
+		CreatedAt:     fixedTime,
+		UpdatedAt:     fixedTime,
+	}
+	conversation2 := assistant.Conversation{
+		ID:          c2,
+		Title:       "Conversation 2",
+		TitleSource: assistant.ConversationTitleSource_LLM,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
 
 	tests := map[string]struct {
-		setExpectations       func(repo *assistant.MockConversationRepository)
+		setExpectations       func(*assistant.MockConversationRepository, *assistant.MockConversationReadModelRepository)
 		page                  int
 		pageSize              int
 		expectedConversations []assistant.Conversation
@@ -26,194 +46,113 @@ func TestListConversationsImpl_Query(t *testing.T) {
 		expectedHasMore       bool
 		expectedErr           error
 	}{
-		"success": {
+		"served-from-projections": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
-				repo.EXPECT().ListConversations(mock.Anything, 1, 10).Return([]assistant.Conversation{
-					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-						Title:       "Conversation 1",
-						TitleSource: assistant.ConversationTitleSource_User,
-						CreatedAt:   fixedTime,
-						UpdatedAt:   fixedTime,
-					},
-					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
-						Title:       "Conversation 2",
-						TitleSource: assistant.ConversationTitleSource_LLM,
-						CreatedAt:   fixedTime,
-						UpdatedAt:   fixedTime,
-					},
-				}, true, nil)
-				repo.EXPECT().GetConversationContextTokenUsage(
-					mock.Anything,
-					[]uuid.UUID{
-						uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-						uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
-					},
-				).Return(map[uuid.UUID]int64{
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 320,
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"): 125,
-				}, nil)
-			},
-			expectedConversations: []assistant.Conversation{
-				{
-					ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-					Title:       "Conversation 1",
-					TitleSource: assistant.ConversationTitleSource_User,
-					CreatedAt:   fixedTime,
-					UpdatedAt:   fixedTime,
-				},
-				{
-					ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
-					Title:       "Conversation 2",
-					TitleSource: assistant.ConversationTitleSource_LLM,
-					CreatedAt:   fixedTime,
-					UpdatedAt:   fixedTime,
-				},
+			setExpectations: func(repo *assistant.MockConversationRepository, readModelRepo *assistant.MockConversationReadModelRepository) {
+				repo.EXPECT().ListConversations(mock.Anything, 1, 10).
+					Return([]assistant.Conversation{conversation1, conversation2}, true, nil)
+				readModelRepo.EXPECT().ListConversationReadModels(mock.Anything, []uuid.UUID{c1, c2}).
+					Return(map[uuid.UUID]assistant.ConversationReadModel{
+						c1: {ConversationID: c1, LastMessageAt: &lastMessageAt, ContextTokensUsed: 320},
+						c2: {ConversationID: c2, ContextTokensUsed: 0},
+					}, nil)
 			},
-			expectedTokenUsage: map[uuid.UUID]int64{
-				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 320,
-				uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"): 125,
-			},
-			expectedHasMore: true,
-			expectedErr:     nil,
+			expectedConversations: []assistant.Conversation{conversation1, conversation2},
+			expectedTokenUsage:    map[uuid.UUID]int64{c1: 320, c2: 0},
+			expectedHasMore:       true,
 		},
-		"success-first-page": {
+		"stale-projection-falls-back-to-messages": {
 			page:     1,
-			pageSize: 5,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
-				repo.EXPECT().ListConversations(mock.Anything, 1, 5).Return([]assistant.Conversation{
-					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-						Title:       "First Conversation",
-						TitleSource: assistant.ConversationTitleSource_Auto,
-						CreatedAt:   fixedTime,
-						UpdatedAt:   fixedTime,
-					},
-				}, true, nil)
-				repo.EXPECT().GetConversationContextTokenUsage(
-					mock.Anything,
-					[]uuid.UUID{uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")},
-				).Return(map[uuid.UUID]int64{
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 42,
-				}, nil)
-			},
-			expectedConversations: []assistant.Conversation{
-				{
-					ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-					Title:       "First Conversation",
-					TitleSource: assistant.ConversationTitleSource_Auto,
-					CreatedAt:   fixedTime,
-					UpdatedAt:   fixedTime,
-				},
-			},
-			expectedTokenUsage: map[uuid.UUID]int64{
-				uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"): 42,
+			pageSize: 10,
+			setExpectations: func(repo *assistant.MockConversationRepository, readModelRepo *assistant.MockConversationReadModelRepository) {
+				repo.EXPECT().ListConversations(mock.Anything, 1, 10).
+					Return([]assistant.Conversation{conversation1, conversation2}, false, nil)
+				readModelRepo.EXPECT().ListConversationReadModels(mock.Anything, []uuid.UUID{c1, c2}).
+					Return(map[uuid.UUID]assistant.ConversationReadModel{
+						c1: {ConversationID: c1, LastMessageAt: &previousMessageAt, ContextTokensUsed: 200},
+						c2: {ConversationID: c2, ContextTokensUsed: 0},
+					}, nil)
+				repo.EXPECT().GetConversationContextTokenUsage(mock.Anything, []uuid.UUID{c1}).
+					Return(map[uuid.UUID]int64{c1: 320}, nil)
 			},
-			expectedHasMore: true,
-			expectedErr:     nil,
+			expectedConversations: []assistant.Conversation{conversation1, conversation2},
+			expectedTokenUsage:    map[uuid.UUID]int64{c1: 320, c2: 0},
 		},
-		"success-last-page": {
+		"missing-projection-falls-back-to-messages": {
 			page:     3,
 			pageSize: 5,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
-				repo.EXPECT().ListConversations(mock.Anything, 3, 5).Return([]assistant.Conversation{
-					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
-						Title:       "Last Conversation",
-						TitleSource: assistant.ConversationTitleSource_User,
-						CreatedAt:   fixedTime,
-						UpdatedAt:   fixedTime,
-					},
-				}, false, nil)
-				repo.EXPECT().GetConversationContextTokenUsage(
-					mock.Anything,
-					[]uuid.UUID{uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")},
-				).Return(map[uuid.UUID]int64{
-					uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"): 9,
-				}, nil)
-			},
-			expectedConversations: []assistant.Conversation{
-				{
-					ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
-					Title:       "Last Conversation",
-					TitleSource: assistant.ConversationTitleSource_User,
-					CreatedAt:   fixedTime,
-					UpdatedAt:   fixedTime,
-				},
+			setExpectations: func(repo *assistant.MockConversationRepository, readModelRepo *assistant.MockConversationReadModelRepository) {
+				repo.EXPECT().ListConversations(mock.Anything, 3, 5).
+					Return([]assistant.Conversation{conversation1, conversation2}, false, nil)
+				readModelRepo.EXPECT().ListConversationReadModels(mock.Anything, []uuid.UUID{c1, c2}).
+					Return(map[uuid.UUID]assistant.ConversationReadModel{}, nil)
+				repo.EXPECT().GetConversationContextTokenUsage(mock.Anything, []uuid.UUID{c1, c2}).
+					Return(map[uuid.UUID]int64{c1: 320, c2: 9}, nil)
 			},
-			expectedTokenUsage: map[uuid.UUID]int64{
-				uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"): 9,
-			},
-			expectedHasMore: false,
-			expectedErr:     nil,
+			expectedConversations: []assistant.Conversation{conversation1, conversation2},
+			expectedTokenUsage:    map[uuid.UUID]int64{c1: 320, c2: 9},
 		},
 		"success-empty-list": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
+			setExpectations: func(repo *assistant.MockConversationRepository, readModelRepo *assistant.MockConversationReadModelRepository) {
 				repo.EXPECT().ListConversations(mock.Anything, 1, 10).Return([]assistant.Conversation{}, false, nil)
-				repo.EXPECT().GetConversationContextTokenUsage(mock.Anything, []uuid.UUID{}).Return(map[uuid.UUID]int64{}, nil)
+				readModelRepo.EXPECT().ListConversationReadModels(mock.Anything, []uuid.UUID{}).
+					Return(map[uuid.UUID]assistant.ConversationReadModel{}, nil)
 			},
 			expectedConversations: []assistant.Conversation{},
 			expectedTokenUsage:    map[uuid.UUID]int64{},
-			expectedHasMore:       false,
-			expectedErr:           nil,
 		},
 		"repository-error": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
+			setExpectations: func(repo *assistant.MockConversationRepository, _ *assistant.MockConversationReadModelRepository) {
 				repo.EXPECT().ListConversations(mock.Anything, 1, 10).Return(nil, false, errors.New("database error"))
 			},
-			expectedConversations: nil,
-			expectedHasMore:       false,
-			expectedErr:           errors.New("database error"),
+			expectedErr: errors.New("database error"),
+		},
+		"read-model-error": {
+			page:     1,
+			pageSize: 10,
+			setExpectations: func(repo *assistant.MockConversationRepository, readModelRepo *assistant.MockConversationReadModelRepository) {
+				repo.EXPECT().ListConversations(mock.Anything, 1, 10).
+					Return([]assistant.Conversation{conversation1}, false, nil)
+				readModelRepo.EXPECT().ListConversationReadModels(mock.Anything, []uuid.UUID{c1}).
+					Return(nil, errors.New("read model error"))
+			},
+			expectedErr: errors.New("read model error"),
 		},
 		"usage-query-error": {
 			page:     1,
 			pageSize: 10,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
-				repo.EXPECT().ListConversations(mock.Anything, 1, 10).Return([]assistant.Conversation{
-					{
-						ID:          uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
-						Title:       "Conversation 1",
-						TitleSource: assistant.ConversationTitleSource_User,
-						CreatedAt:   fixedTime,
-						UpdatedAt:   fixedTime,
-					},
-				}, false, nil)
-				repo.EXPECT().GetConversationContextTokenUsage(
-					mock.Anything,
-					[]uuid.UUID{uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")},
-				).Return(nil, errors.New("usage error"))
+			setExpectations: func(repo *assistant.MockConversationRepository, readModelRepo *assistant.MockConversationReadModelRepository) {
+				repo.EXPECT().ListConversations(mock.Anything, 1, 10).
+					Return([]assistant.Conversation{conversation1}, false, nil)
+				readModelRepo.EXPECT().ListConversationReadModels(mock.Anything, []uuid.UUID{c1}).
+					Return(map[uuid.UUID]assistant.ConversationReadModel{}, nil)
+				repo.EXPECT().GetConversationContextTokenUsage(mock.Anything, []uuid.UUID{c1}).
+					Return(nil, errors.New("usage error"))
 			},
-			expectedConversations: nil,
-			expectedHasMore:       false,
-			expectedErr:           errors.New("usage error"),
+			expectedErr: errors.New("usage error"),
 		},
 		"invalid-page-number": {
 			page:     0,
 			pageSize: 10,
-			setExpectations: func(repo *assistant.MockConversationRepository) {
+			setExpectations: func(repo *assistant.MockConversationRepository, _ *assistant.MockConversationReadModelRepository) {
 				repo.EXPECT().ListConversations(mock.Anything, 0, 10).Return(nil, false, core.NewValidationErr("page must be greater than 0"))
 			},
-			expectedConversations: nil,
-			expectedHasMore:       false,
-			expectedErr:           core.NewValidationErr("page must be greater than 0"),
+			expectedErr: core.NewValidationErr("page must be greater than 0"),
 		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			repo := assistant.NewMockConversationRepository(t)
-			if tt.setExpectations != nil {
-				tt.setExpectations(repo)
-			}
+			readModelRepo := assistant.NewMockConversationReadModelRepository(t)
+			tt.setExpectations(repo, readModelRepo)
 
-			lc := NewListConversationsImpl(repo)
+			lc := NewListConversationsImpl(repo, readModelRepo)
 
 			got, tokenUsage, hasMore, gotErr := lc.Query(t.Context(), tt.page, tt.pageSize)
 			assert.Equal(t, tt.expectedErr, gotErr)
@@ -0,0 +1,88 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MaintainChatMessagePartitions keeps the monthly chat message partitions ready ahead of time and drops the
+// months past the retention period.
+type MaintainChatMessagePartitions interface {
+	// Execute creates the partitions of the current month and the months ahead, then drops the partitions
+	// of the months past the retention period. It returns how many partitions were dropped.
+	Execute(ctx context.Context) (int, error)
+}
+
+// MaintainChatMessagePartitionsImpl is the implementation of the MaintainChatMessagePartitions use case.
+type MaintainChatMessagePartitionsImpl struct {
+	partitionRepo   assistant.ChatMessagePartitionRepository
+	timeProvider    core.CurrentTimeProvider
+	locker          core.Locker
+	monthsAhead     int
+	retentionMonths int
+}
+
+// NewMaintainChatMessagePartitionsImpl creates a new instance of MaintainChatMessagePartitionsImpl.
+// Zero retention months keep the messages forever. Runs happen on one replica at a time when a locker
+// is supplied.
+func NewMaintainChatMessagePartitionsImpl(
+	partitionRepo assistant.ChatMessagePartitionRepository,
+	timeProvider core.CurrentTimeProvider,
+	locker core.Locker,
+	monthsAhead int,
+	retentionMonths int,
+) MaintainChatMessagePartitionsImpl {
+	return MaintainChatMessagePartitionsImpl{
+		partitionRepo:   partitionRepo,
+		timeProvider:    timeProvider,
+		locker:          locker,
+		monthsAhead:     monthsAhead,
+		retentionMonths: retentionMonths,
+	}
+}
+
+// Execute implements MaintainChatMessagePartitions.
+func (uc MaintainChatMessagePartitionsImpl) Execute(ctx context.Context) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.Int("months_ahead", uc.monthsAhead),
+		attribute.Int("retention_months", uc.retentionMonths),
+	))
+	defer span.End()
+
+	if uc.locker != nil {
+		unlock, locked, err := uc.locker.TryLock(spanCtx, "maintain_chat_message_partitions")
+		if telemetry.IsErrorRecorded(span, err) {
+			return 0, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		// Another replica is maintaining the partitions.
+		if !locked {
+			return 0, nil
+		}
+		defer unlock()
+	}
+
+	currentMonth := assistant.ChatMessagePartitionMonth(uc.timeProvider.Now())
+	err := uc.partitionRepo.EnsureChatMessagePartitions(spanCtx, currentMonth, currentMonth.AddDate(0, uc.monthsAhead, 0))
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, fmt.Errorf("failed to create partitions: %w", err)
+	}
+
+	if uc.retentionMonths <= 0 {
+		return 0, nil
+	}
+
+	// The current month is never complete, so the retention counts the complete months before it.
+	dropped, err := uc.partitionRepo.DropChatMessagePartitionsBefore(spanCtx, currentMonth.AddDate(0, -uc.retentionMonths, 0))
+	span.SetAttributes(attribute.Int("dropped", len(dropped)))
+	if telemetry.IsErrorRecorded(span, err) {
+		return len(dropped), fmt.Errorf("failed to drop partitions: %w", err)
+	}
+
+	return len(dropped), nil
+}
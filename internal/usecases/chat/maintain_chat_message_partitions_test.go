@@ -0,0 +1,101 @@
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMaintainChatMessagePartitionsImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	currentMonth := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	aheadMonth := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		retentionMonths int
+		setupMocks      func(*assistant.MockChatMessagePartitionRepository, *core.MockCurrentTimeProvider, *core.MockLocker)
+		expected        int
+		expectedError   error
+	}{
+		"creates-and-drops": {
+			retentionMonths: 12,
+			setupMocks: func(repo *assistant.MockChatMessagePartitionRepository, tp *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "maintain_chat_message_partitions").Return(func() {}, true, nil).Once()
+				tp.EXPECT().Now().Return(now)
+				repo.EXPECT().EnsureChatMessagePartitions(mock.Anything, currentMonth, aheadMonth).Return(nil).Once()
+				repo.EXPECT().DropChatMessagePartitionsBefore(mock.Anything, cutoff).Return([]time.Time{
+					time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				}, nil).Once()
+			},
+			expected: 2,
+		},
+		"kept-forever": {
+			setupMocks: func(repo *assistant.MockChatMessagePartitionRepository, tp *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "maintain_chat_message_partitions").Return(func() {}, true, nil).Once()
+				tp.EXPECT().Now().Return(now)
+				repo.EXPECT().EnsureChatMessagePartitions(mock.Anything, currentMonth, aheadMonth).Return(nil).Once()
+			},
+		},
+		"maintained-by-another-replica": {
+			retentionMonths: 12,
+			setupMocks: func(_ *assistant.MockChatMessagePartitionRepository, _ *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "maintain_chat_message_partitions").Return(nil, false, nil).Once()
+			},
+		},
+		"lock-error": {
+			retentionMonths: 12,
+			setupMocks: func(_ *assistant.MockChatMessagePartitionRepository, _ *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "maintain_chat_message_partitions").Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedError: fmt.Errorf("failed to acquire lock: %w", errors.New("db error")),
+		},
+		"create-error": {
+			retentionMonths: 12,
+			setupMocks: func(repo *assistant.MockChatMessagePartitionRepository, tp *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "maintain_chat_message_partitions").Return(func() {}, true, nil).Once()
+				tp.EXPECT().Now().Return(now)
+				repo.EXPECT().EnsureChatMessagePartitions(mock.Anything, currentMonth, aheadMonth).Return(errors.New("db error")).Once()
+			},
+			expectedError: fmt.Errorf("failed to create partitions: %w", errors.New("db error")),
+		},
+		"drop-error": {
+			retentionMonths: 12,
+			setupMocks: func(repo *assistant.MockChatMessagePartitionRepository, tp *core.MockCurrentTimeProvider, locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "maintain_chat_message_partitions").Return(func() {}, true, nil).Once()
+				tp.EXPECT().Now().Return(now)
+				repo.EXPECT().EnsureChatMessagePartitions(mock.Anything, currentMonth, aheadMonth).Return(nil).Once()
+				repo.EXPECT().DropChatMessagePartitionsBefore(mock.Anything, cutoff).Return([]time.Time{
+					time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+				}, errors.New("db error")).Once()
+			},
+			expected:      1,
+			expectedError: fmt.Errorf("failed to drop partitions: %w", errors.New("db error")),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockChatMessagePartitionRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			locker := core.NewMockLocker(t)
+			tt.setupMocks(repo, timeProvider, locker)
+
+			uc := NewMaintainChatMessagePartitionsImpl(repo, timeProvider, locker, 2, tt.retentionMonths)
+			got, err := uc.Execute(t.Context())
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
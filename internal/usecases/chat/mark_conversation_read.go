@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// MarkConversationRead moves the read cursor of the caller on a conversation.
+type MarkConversationRead interface {
+	// Execute marks the conversation read up to the given message, or up to its latest message when messageID is nil.
+	Execute(ctx context.Context, conversationID uuid.UUID, messageID *uuid.UUID) error
+}
+
+// MarkConversationReadImpl implements MarkConversationRead.
+type MarkConversationReadImpl struct {
+	conversationRepo assistant.ConversationRepository
+	readCursorRepo   assistant.ConversationReadCursorRepository
+	timeProvider     core.CurrentTimeProvider
+}
+
+// NewMarkConversationReadImpl creates a MarkConversationReadImpl.
+func NewMarkConversationReadImpl(
+	conversationRepo assistant.ConversationRepository,
+	readCursorRepo assistant.ConversationReadCursorRepository,
+	timeProvider core.CurrentTimeProvider,
+) MarkConversationReadImpl {
+	return MarkConversationReadImpl{
+		conversationRepo: conversationRepo,
+		readCursorRepo:   readCursorRepo,
+		timeProvider:     timeProvider,
+	}
+}
+
+// Execute implements MarkConversationRead.
+// Marking a conversation without messages read is a no-op.
+func (uc MarkConversationReadImpl) Execute(ctx context.Context, conversationID uuid.UUID, messageID *uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, found, err := uc.conversationRepo.GetConversation(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if !found {
+		err := core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", conversationID))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+
+	reader := access.FromContext(spanCtx).Subject()
+	marked, err := uc.readCursorRepo.MarkConversationRead(spanCtx, conversationID, reader, messageID, uc.timeProvider.Now())
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if !marked && messageID != nil {
+		err := core.NewNotFoundErr(fmt.Sprintf("message with ID %s not found in conversation %s", *messageID, conversationID))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+	return nil
+}
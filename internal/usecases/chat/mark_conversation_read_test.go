@@ -0,0 +1,98 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMarkConversationReadImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	messageID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	userID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	readAt := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	reader := "user:" + userID.String()
+
+	tests := map[string]struct {
+		messageID       *uuid.UUID
+		setExpectations func(*assistant.MockConversationRepository, *assistant.MockConversationReadCursorRepository, *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"marks-latest-message": {
+			setExpectations: func(convRepo *assistant.MockConversationRepository, cursorRepo *assistant.MockConversationReadCursorRepository, tp *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil)
+				tp.EXPECT().Now().Return(readAt)
+				cursorRepo.EXPECT().MarkConversationRead(mock.Anything, conversationID, reader, (*uuid.UUID)(nil), readAt).Return(true, nil)
+			},
+		},
+		"conversation-without-messages": {
+			setExpectations: func(convRepo *assistant.MockConversationRepository, cursorRepo *assistant.MockConversationReadCursorRepository, tp *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil)
+				tp.EXPECT().Now().Return(readAt)
+				cursorRepo.EXPECT().MarkConversationRead(mock.Anything, conversationID, reader, (*uuid.UUID)(nil), readAt).Return(false, nil)
+			},
+		},
+		"marks-given-message": {
+			messageID: &messageID,
+			setExpectations: func(convRepo *assistant.MockConversationRepository, cursorRepo *assistant.MockConversationReadCursorRepository, tp *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil)
+				tp.EXPECT().Now().Return(readAt)
+				cursorRepo.EXPECT().MarkConversationRead(mock.Anything, conversationID, reader, &messageID, readAt).Return(true, nil)
+			},
+		},
+		"message-not-found": {
+			messageID: &messageID,
+			setExpectations: func(convRepo *assistant.MockConversationRepository, cursorRepo *assistant.MockConversationReadCursorRepository, tp *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil)
+				tp.EXPECT().Now().Return(readAt)
+				cursorRepo.EXPECT().MarkConversationRead(mock.Anything, conversationID, reader, &messageID, readAt).Return(false, nil)
+			},
+			expectedErr: core.NewNotFoundErr("message with ID " + messageID.String() + " not found in conversation " + conversationID.String()),
+		},
+		"conversation-not-found": {
+			setExpectations: func(convRepo *assistant.MockConversationRepository, _ *assistant.MockConversationReadCursorRepository, _ *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil)
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID " + conversationID.String() + " not found"),
+		},
+		"get-conversation-error": {
+			setExpectations: func(convRepo *assistant.MockConversationRepository, _ *assistant.MockConversationReadCursorRepository, _ *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"mark-error": {
+			setExpectations: func(convRepo *assistant.MockConversationRepository, cursorRepo *assistant.MockConversationReadCursorRepository, tp *core.MockCurrentTimeProvider) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil)
+				tp.EXPECT().Now().Return(readAt)
+				cursorRepo.EXPECT().MarkConversationRead(mock.Anything, conversationID, reader, (*uuid.UUID)(nil), readAt).Return(false, errors.New("db error"))
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			convRepo := assistant.NewMockConversationRepository(t)
+			cursorRepo := assistant.NewMockConversationReadCursorRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(convRepo, cursorRepo, timeProvider)
+
+			ctx := access.NewContext(t.Context(), access.Principal{Name: "alice", Role: access.RoleMember, UserID: userID})
+			uc := NewMarkConversationReadImpl(convRepo, cursorRepo, timeProvider)
+			err := uc.Execute(ctx, conversationID, tt.messageID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
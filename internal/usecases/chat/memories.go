@@ -0,0 +1,106 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+)
+
+const (
+	// MAX_RECALLED_MEMORIES is the maximum number of memories added to the system prompt of a turn.
+	MAX_RECALLED_MEMORIES = 5
+	// MEMORY_RECALL_MAX_DISTANCE is the cosine distance under which a memory is relevant to a user message.
+	MEMORY_RECALL_MAX_DISTANCE = 0.5
+)
+
+// Memories defines the interface for managing and recalling the long-term memories about the user.
+type Memories interface {
+	// List lists the memories, newest first.
+	List(ctx context.Context) ([]assistant.Memory, error)
+	// Delete removes a memory.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Recall lists the memories most relevant to the user message.
+	Recall(ctx context.Context, userMessage string) ([]assistant.Memory, error)
+}
+
+// MemoriesImpl is the implementation of the Memories use case.
+type MemoriesImpl struct {
+	memoryRepo     assistant.MemoryRepository
+	encoder        semantic.Encoder
+	embeddingModel string
+}
+
+// NewMemoriesImpl creates a new instance of MemoriesImpl.
+func NewMemoriesImpl(memoryRepo assistant.MemoryRepository, encoder semantic.Encoder, embeddingModel string) MemoriesImpl {
+	return MemoriesImpl{
+		memoryRepo:     memoryRepo,
+		encoder:        encoder,
+		embeddingModel: embeddingModel,
+	}
+}
+
+// List lists the memories, newest first.
+func (uc MemoriesImpl) List(ctx context.Context) ([]assistant.Memory, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	memories, err := uc.memoryRepo.ListMemories(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return memories, nil
+}
+
+// Delete removes a memory.
+func (uc MemoriesImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, found, err := uc.memoryRepo.GetMemory(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if !found {
+		err := core.NewNotFoundErr(fmt.Sprintf("memory with ID %s not found", id))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+
+	if err := uc.memoryRepo.DeleteMemory(spanCtx, id); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// Recall lists the memories closest to the user message by embedding similarity.
+func (uc MemoriesImpl) Recall(ctx context.Context, userMessage string) ([]assistant.Memory, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	userMessage = strings.TrimSpace(userMessage)
+	if userMessage == "" {
+		return nil, nil
+	}
+
+	vector, err := uc.encoder.VectorizeQuery(spanCtx, uc.embeddingModel, userMessage)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, fmt.Errorf("failed to embed user message: %w", err)
+	}
+	metrics.RecordLLMTokensEmbedding(spanCtx, vector.TotalTokens)
+
+	memories, err := uc.memoryRepo.SearchMemories(spanCtx, vector.Vector, MAX_RECALLED_MEMORIES, MEMORY_RECALL_MAX_DISTANCE)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return memories, nil
+}
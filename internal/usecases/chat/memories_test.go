@@ -0,0 +1,159 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMemoriesImpl_List(t *testing.T) {
+	t.Parallel()
+
+	memories := []assistant.Memory{{ID: uuid.New(), Kind: assistant.MemoryKind_Preference, Text: "Prefers morning workouts"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *assistant.MockMemoryRepository)
+		expected        []assistant.Memory
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *assistant.MockMemoryRepository) {
+				repo.EXPECT().ListMemories(mock.Anything).Return(memories, nil).Once()
+			},
+			expected: memories,
+		},
+		"repository-error": {
+			setExpectations: func(repo *assistant.MockMemoryRepository) {
+				repo.EXPECT().ListMemories(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockMemoryRepository(t)
+			tt.setExpectations(repo)
+
+			got, err := NewMemoriesImpl(repo, semantic.NewMockEncoder(t), "embedding-model").List(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMemoriesImpl_Delete(t *testing.T) {
+	t.Parallel()
+
+	memoryID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		setExpectations func(repo *assistant.MockMemoryRepository)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *assistant.MockMemoryRepository) {
+				repo.EXPECT().GetMemory(mock.Anything, memoryID).Return(assistant.Memory{ID: memoryID}, true, nil).Once()
+				repo.EXPECT().DeleteMemory(mock.Anything, memoryID).Return(nil).Once()
+			},
+		},
+		"not-found": {
+			setExpectations: func(repo *assistant.MockMemoryRepository) {
+				repo.EXPECT().GetMemory(mock.Anything, memoryID).Return(assistant.Memory{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("memory with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"delete-error": {
+			setExpectations: func(repo *assistant.MockMemoryRepository) {
+				repo.EXPECT().GetMemory(mock.Anything, memoryID).Return(assistant.Memory{ID: memoryID}, true, nil).Once()
+				repo.EXPECT().DeleteMemory(mock.Anything, memoryID).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockMemoryRepository(t)
+			tt.setExpectations(repo)
+
+			err := NewMemoriesImpl(repo, semantic.NewMockEncoder(t), "embedding-model").Delete(t.Context(), memoryID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestMemoriesImpl_Recall(t *testing.T) {
+	t.Parallel()
+
+	vector := []float64{0.1, 0.2}
+	memories := []assistant.Memory{{ID: uuid.New(), Kind: assistant.MemoryKind_Preference, Text: "Prefers morning workouts"}}
+
+	tests := map[string]struct {
+		userMessage     string
+		setExpectations func(repo *assistant.MockMemoryRepository, encoder *semantic.MockEncoder)
+		expected        []assistant.Memory
+		expectedErr     string
+	}{
+		"success": {
+			userMessage: "Plan my workouts",
+			setExpectations: func(repo *assistant.MockMemoryRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Plan my workouts").
+					Return(semantic.EmbeddingVector{Vector: vector, TotalTokens: 3}, nil).Once()
+				repo.EXPECT().SearchMemories(mock.Anything, vector, MAX_RECALLED_MEMORIES, MEMORY_RECALL_MAX_DISTANCE).
+					Return(memories, nil).Once()
+			},
+			expected: memories,
+		},
+		"blank-message": {
+			userMessage:     "  ",
+			setExpectations: func(*assistant.MockMemoryRepository, *semantic.MockEncoder) {},
+		},
+		"encoder-error": {
+			userMessage: "Plan my workouts",
+			setExpectations: func(_ *assistant.MockMemoryRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Plan my workouts").
+					Return(semantic.EmbeddingVector{}, errors.New("encoder down")).Once()
+			},
+			expectedErr: "failed to embed user message: encoder down",
+		},
+		"search-error": {
+			userMessage: "Plan my workouts",
+			setExpectations: func(repo *assistant.MockMemoryRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Plan my workouts").
+					Return(semantic.EmbeddingVector{Vector: vector}, nil).Once()
+				repo.EXPECT().SearchMemories(mock.Anything, vector, MAX_RECALLED_MEMORIES, MEMORY_RECALL_MAX_DISTANCE).
+					Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockMemoryRepository(t)
+			encoder := semantic.NewMockEncoder(t)
+			tt.setExpectations(repo, encoder)
+
+			got, err := NewMemoriesImpl(repo, encoder, "embedding-model").Recall(t.Context(), tt.userMessage)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package chat
 
 import (
 	"context"
+	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
@@ -118,6 +119,105 @@ func (_c *MockActionPipeline_Handle_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// NewMockAnswerVerifier creates a new instance of MockAnswerVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnswerVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnswerVerifier {
+	mock := &MockAnswerVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnswerVerifier is an autogenerated mock type for the AnswerVerifier type
+type MockAnswerVerifier struct {
+	mock.Mock
+}
+
+type MockAnswerVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnswerVerifier) EXPECT() *MockAnswerVerifier_Expecter {
+	return &MockAnswerVerifier_Expecter{mock: &_m.Mock}
+}
+
+// Verify provides a mock function for the type MockAnswerVerifier
+func (_mock *MockAnswerVerifier) Verify(ctx context.Context, answer string, actions []VerifiedAction) (string, error) {
+	ret := _mock.Called(ctx, answer, actions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []VerifiedAction) (string, error)); ok {
+		return returnFunc(ctx, answer, actions)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []VerifiedAction) string); ok {
+		r0 = returnFunc(ctx, answer, actions)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []VerifiedAction) error); ok {
+		r1 = returnFunc(ctx, answer, actions)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnswerVerifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockAnswerVerifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - answer string
+//   - actions []VerifiedAction
+func (_e *MockAnswerVerifier_Expecter) Verify(ctx interface{}, answer interface{}, actions interface{}) *MockAnswerVerifier_Verify_Call {
+	return &MockAnswerVerifier_Verify_Call{Call: _e.mock.On("Verify", ctx, answer, actions)}
+}
+
+func (_c *MockAnswerVerifier_Verify_Call) Run(run func(ctx context.Context, answer string, actions []VerifiedAction)) *MockAnswerVerifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []VerifiedAction
+		if args[2] != nil {
+			arg2 = args[2].([]VerifiedAction)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnswerVerifier_Verify_Call) Return(s string, err error) *MockAnswerVerifier_Verify_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockAnswerVerifier_Verify_Call) RunAndReturn(run func(ctx context.Context, answer string, actions []VerifiedAction) (string, error)) *MockAnswerVerifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockConversationCompactor creates a new instance of MockConversationCompactor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockConversationCompactor(t interface {
@@ -274,64 +374,37 @@ func (_c *MockConversationCompactor_EvaluateConversationCompaction_Call) RunAndR
 	return _c
 }
 
-// NewMockConversationTranscriptWriter creates a new instance of MockConversationTranscriptWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockConversationTranscriptWriter(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockConversationTranscriptWriter {
-	mock := &MockConversationTranscriptWriter{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockConversationTranscriptWriter is an autogenerated mock type for the ConversationTranscriptWriter type
-type MockConversationTranscriptWriter struct {
-	mock.Mock
-}
-
-type MockConversationTranscriptWriter_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockConversationTranscriptWriter) EXPECT() *MockConversationTranscriptWriter_Expecter {
-	return &MockConversationTranscriptWriter_Expecter{mock: &_m.Mock}
-}
-
-// RepairTurnTranscript provides a mock function for the type MockConversationTranscriptWriter
-func (_mock *MockConversationTranscriptWriter) RepairTurnTranscript(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) error {
-	ret := _mock.Called(ctx, conversationID, turnID)
+// Recompact provides a mock function for the type MockConversationCompactor
+func (_mock *MockConversationCompactor) Recompact(ctx context.Context, conversationID uuid.UUID, fromMessageID uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID, fromMessageID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RepairTurnTranscript")
+		panic("no return value specified for Recompact")
 	}
 
 	var r0 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, conversationID, turnID)
+		r0 = returnFunc(ctx, conversationID, fromMessageID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockConversationTranscriptWriter_RepairTurnTranscript_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairTurnTranscript'
-type MockConversationTranscriptWriter_RepairTurnTranscript_Call struct {
+// MockConversationCompactor_Recompact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recompact'
+type MockConversationCompactor_Recompact_Call struct {
 	*mock.Call
 }
 
-// RepairTurnTranscript is a helper method to define mock.On call
+// Recompact is a helper method to define mock.On call
 //   - ctx context.Context
 //   - conversationID uuid.UUID
-//   - turnID uuid.UUID
-func (_e *MockConversationTranscriptWriter_Expecter) RepairTurnTranscript(ctx interface{}, conversationID interface{}, turnID interface{}) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
-	return &MockConversationTranscriptWriter_RepairTurnTranscript_Call{Call: _e.mock.On("RepairTurnTranscript", ctx, conversationID, turnID)}
+//   - fromMessageID uuid.UUID
+func (_e *MockConversationCompactor_Expecter) Recompact(ctx interface{}, conversationID interface{}, fromMessageID interface{}) *MockConversationCompactor_Recompact_Call {
+	return &MockConversationCompactor_Recompact_Call{Call: _e.mock.On("Recompact", ctx, conversationID, fromMessageID)}
 }
 
-func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID)) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
+func (_c *MockConversationCompactor_Recompact_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, fromMessageID uuid.UUID)) *MockConversationCompactor_Recompact_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -354,59 +427,97 @@ func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) Run(run fu
 	return _c
 }
 
-func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) Return(err error) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
+func (_c *MockConversationCompactor_Recompact_Call) Return(err error) *MockConversationCompactor_Recompact_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) error) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
+func (_c *MockConversationCompactor_Recompact_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, fromMessageID uuid.UUID) error) *MockConversationCompactor_Recompact_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WriteMessage provides a mock function for the type MockConversationTranscriptWriter
-func (_mock *MockConversationTranscriptWriter) WriteMessage(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage) error {
-	ret := _mock.Called(ctx, conversation, message)
+// NewMockConversationSnapshotter creates a new instance of MockConversationSnapshotter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConversationSnapshotter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConversationSnapshotter {
+	mock := &MockConversationSnapshotter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConversationSnapshotter is an autogenerated mock type for the ConversationSnapshotter type
+type MockConversationSnapshotter struct {
+	mock.Mock
+}
+
+type MockConversationSnapshotter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConversationSnapshotter) EXPECT() *MockConversationSnapshotter_Expecter {
+	return &MockConversationSnapshotter_Expecter{mock: &_m.Mock}
+}
+
+// CarryOver provides a mock function for the type MockConversationSnapshotter
+func (_mock *MockConversationSnapshotter) CarryOver(ctx context.Context, fromConversationID uuid.UUID, toConversationID uuid.UUID) ([]assistant.PinnedTodo, error) {
+	ret := _mock.Called(ctx, fromConversationID, toConversationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for WriteMessage")
+		panic("no return value specified for CarryOver")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, assistant.Conversation, assistant.ChatMessage) error); ok {
-		r0 = returnFunc(ctx, conversation, message)
+	var r0 []assistant.PinnedTodo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]assistant.PinnedTodo, error)); ok {
+		return returnFunc(ctx, fromConversationID, toConversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []assistant.PinnedTodo); ok {
+		r0 = returnFunc(ctx, fromConversationID, toConversationID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.PinnedTodo)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, fromConversationID, toConversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockConversationTranscriptWriter_WriteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteMessage'
-type MockConversationTranscriptWriter_WriteMessage_Call struct {
+// MockConversationSnapshotter_CarryOver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CarryOver'
+type MockConversationSnapshotter_CarryOver_Call struct {
 	*mock.Call
 }
 
-// WriteMessage is a helper method to define mock.On call
+// CarryOver is a helper method to define mock.On call
 //   - ctx context.Context
-//   - conversation assistant.Conversation
-//   - message assistant.ChatMessage
-func (_e *MockConversationTranscriptWriter_Expecter) WriteMessage(ctx interface{}, conversation interface{}, message interface{}) *MockConversationTranscriptWriter_WriteMessage_Call {
-	return &MockConversationTranscriptWriter_WriteMessage_Call{Call: _e.mock.On("WriteMessage", ctx, conversation, message)}
+//   - fromConversationID uuid.UUID
+//   - toConversationID uuid.UUID
+func (_e *MockConversationSnapshotter_Expecter) CarryOver(ctx interface{}, fromConversationID interface{}, toConversationID interface{}) *MockConversationSnapshotter_CarryOver_Call {
+	return &MockConversationSnapshotter_CarryOver_Call{Call: _e.mock.On("CarryOver", ctx, fromConversationID, toConversationID)}
 }
 
-func (_c *MockConversationTranscriptWriter_WriteMessage_Call) Run(run func(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage)) *MockConversationTranscriptWriter_WriteMessage_Call {
+func (_c *MockConversationSnapshotter_CarryOver_Call) Run(run func(ctx context.Context, fromConversationID uuid.UUID, toConversationID uuid.UUID)) *MockConversationSnapshotter_CarryOver_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 assistant.Conversation
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(assistant.Conversation)
+			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 assistant.ChatMessage
+		var arg2 uuid.UUID
 		if args[2] != nil {
-			arg2 = args[2].(assistant.ChatMessage)
+			arg2 = args[2].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -417,49 +528,22 @@ func (_c *MockConversationTranscriptWriter_WriteMessage_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockConversationTranscriptWriter_WriteMessage_Call) Return(err error) *MockConversationTranscriptWriter_WriteMessage_Call {
-	_c.Call.Return(err)
+func (_c *MockConversationSnapshotter_CarryOver_Call) Return(pinnedTodos []assistant.PinnedTodo, err error) *MockConversationSnapshotter_CarryOver_Call {
+	_c.Call.Return(pinnedTodos, err)
 	return _c
 }
 
-func (_c *MockConversationTranscriptWriter_WriteMessage_Call) RunAndReturn(run func(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage) error) *MockConversationTranscriptWriter_WriteMessage_Call {
+func (_c *MockConversationSnapshotter_CarryOver_Call) RunAndReturn(run func(ctx context.Context, fromConversationID uuid.UUID, toConversationID uuid.UUID) ([]assistant.PinnedTodo, error)) *MockConversationSnapshotter_CarryOver_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockDeleteConversation creates a new instance of MockDeleteConversation. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockDeleteConversation(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockDeleteConversation {
-	mock := &MockDeleteConversation{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockDeleteConversation is an autogenerated mock type for the DeleteConversation type
-type MockDeleteConversation struct {
-	mock.Mock
-}
-
-type MockDeleteConversation_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockDeleteConversation) EXPECT() *MockDeleteConversation_Expecter {
-	return &MockDeleteConversation_Expecter{mock: &_m.Mock}
-}
-
-// Execute provides a mock function for the type MockDeleteConversation
-func (_mock *MockDeleteConversation) Execute(ctx context.Context, conversationID uuid.UUID) error {
+// Snapshot provides a mock function for the type MockConversationSnapshotter
+func (_mock *MockConversationSnapshotter) Snapshot(ctx context.Context, conversationID uuid.UUID) error {
 	ret := _mock.Called(ctx, conversationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Execute")
+		panic("no return value specified for Snapshot")
 	}
 
 	var r0 error
@@ -471,19 +555,19 @@ func (_mock *MockDeleteConversation) Execute(ctx context.Context, conversationID
 	return r0
 }
 
-// MockDeleteConversation_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockDeleteConversation_Execute_Call struct {
+// MockConversationSnapshotter_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type MockConversationSnapshotter_Snapshot_Call struct {
 	*mock.Call
 }
 
-// Execute is a helper method to define mock.On call
+// Snapshot is a helper method to define mock.On call
 //   - ctx context.Context
 //   - conversationID uuid.UUID
-func (_e *MockDeleteConversation_Expecter) Execute(ctx interface{}, conversationID interface{}) *MockDeleteConversation_Execute_Call {
-	return &MockDeleteConversation_Execute_Call{Call: _e.mock.On("Execute", ctx, conversationID)}
+func (_e *MockConversationSnapshotter_Expecter) Snapshot(ctx interface{}, conversationID interface{}) *MockConversationSnapshotter_Snapshot_Call {
+	return &MockConversationSnapshotter_Snapshot_Call{Call: _e.mock.On("Snapshot", ctx, conversationID)}
 }
 
-func (_c *MockDeleteConversation_Execute_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockDeleteConversation_Execute_Call {
+func (_c *MockConversationSnapshotter_Snapshot_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockConversationSnapshotter_Snapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -501,23 +585,23 @@ func (_c *MockDeleteConversation_Execute_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockDeleteConversation_Execute_Call) Return(err error) *MockDeleteConversation_Execute_Call {
+func (_c *MockConversationSnapshotter_Snapshot_Call) Return(err error) *MockConversationSnapshotter_Snapshot_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockDeleteConversation_Execute_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockDeleteConversation_Execute_Call {
+func (_c *MockConversationSnapshotter_Snapshot_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockConversationSnapshotter_Snapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockGenerateConversationTitle creates a new instance of MockGenerateConversationTitle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockConversationTranscriptWriter creates a new instance of MockConversationTranscriptWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockGenerateConversationTitle(t interface {
+func NewMockConversationTranscriptWriter(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockGenerateConversationTitle {
-	mock := &MockGenerateConversationTitle{}
+}) *MockConversationTranscriptWriter {
+	mock := &MockConversationTranscriptWriter{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -525,172 +609,209 @@ func NewMockGenerateConversationTitle(t interface {
 	return mock
 }
 
-// MockGenerateConversationTitle is an autogenerated mock type for the GenerateConversationTitle type
-type MockGenerateConversationTitle struct {
+// MockConversationTranscriptWriter is an autogenerated mock type for the ConversationTranscriptWriter type
+type MockConversationTranscriptWriter struct {
 	mock.Mock
 }
 
-type MockGenerateConversationTitle_Expecter struct {
+type MockConversationTranscriptWriter_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockGenerateConversationTitle) EXPECT() *MockGenerateConversationTitle_Expecter {
-	return &MockGenerateConversationTitle_Expecter{mock: &_m.Mock}
+func (_m *MockConversationTranscriptWriter) EXPECT() *MockConversationTranscriptWriter_Expecter {
+	return &MockConversationTranscriptWriter_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function for the type MockGenerateConversationTitle
-func (_mock *MockGenerateConversationTitle) Execute(ctx context.Context, event outbox.ChatMessageEvent) error {
-	ret := _mock.Called(ctx, event)
+// RepairTurnTranscript provides a mock function for the type MockConversationTranscriptWriter
+func (_mock *MockConversationTranscriptWriter) RepairTurnTranscript(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID, turnID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Execute")
+		panic("no return value specified for RepairTurnTranscript")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, outbox.ChatMessageEvent) error); ok {
-		r0 = returnFunc(ctx, event)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, conversationID, turnID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockGenerateConversationTitle_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockGenerateConversationTitle_Execute_Call struct {
+// MockConversationTranscriptWriter_RepairTurnTranscript_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairTurnTranscript'
+type MockConversationTranscriptWriter_RepairTurnTranscript_Call struct {
 	*mock.Call
 }
 
-// Execute is a helper method to define mock.On call
+// RepairTurnTranscript is a helper method to define mock.On call
 //   - ctx context.Context
-//   - event outbox.ChatMessageEvent
-func (_e *MockGenerateConversationTitle_Expecter) Execute(ctx interface{}, event interface{}) *MockGenerateConversationTitle_Execute_Call {
-	return &MockGenerateConversationTitle_Execute_Call{Call: _e.mock.On("Execute", ctx, event)}
+//   - conversationID uuid.UUID
+//   - turnID uuid.UUID
+func (_e *MockConversationTranscriptWriter_Expecter) RepairTurnTranscript(ctx interface{}, conversationID interface{}, turnID interface{}) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
+	return &MockConversationTranscriptWriter_RepairTurnTranscript_Call{Call: _e.mock.On("RepairTurnTranscript", ctx, conversationID, turnID)}
 }
 
-func (_c *MockGenerateConversationTitle_Execute_Call) Run(run func(ctx context.Context, event outbox.ChatMessageEvent)) *MockGenerateConversationTitle_Execute_Call {
+func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID)) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 outbox.ChatMessageEvent
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(outbox.ChatMessageEvent)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGenerateConversationTitle_Execute_Call) Return(err error) *MockGenerateConversationTitle_Execute_Call {
+func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) Return(err error) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGenerateConversationTitle_Execute_Call) RunAndReturn(run func(ctx context.Context, event outbox.ChatMessageEvent) error) *MockGenerateConversationTitle_Execute_Call {
+func (_c *MockConversationTranscriptWriter_RepairTurnTranscript_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) error) *MockConversationTranscriptWriter_RepairTurnTranscript_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListAvailableModels creates a new instance of MockListAvailableModels. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockListAvailableModels(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockListAvailableModels {
-	mock := &MockListAvailableModels{}
-	mock.Mock.Test(t)
+// WriteCheckpoint provides a mock function for the type MockConversationTranscriptWriter
+func (_mock *MockConversationTranscriptWriter) WriteCheckpoint(ctx context.Context, message assistant.ChatMessage) error {
+	ret := _mock.Called(ctx, message)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for WriteCheckpoint")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, assistant.ChatMessage) error); ok {
+		r0 = returnFunc(ctx, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockListAvailableModels is an autogenerated mock type for the ListAvailableModels type
-type MockListAvailableModels struct {
-	mock.Mock
+// MockConversationTranscriptWriter_WriteCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteCheckpoint'
+type MockConversationTranscriptWriter_WriteCheckpoint_Call struct {
+	*mock.Call
 }
 
-type MockListAvailableModels_Expecter struct {
-	mock *mock.Mock
+// WriteCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message assistant.ChatMessage
+func (_e *MockConversationTranscriptWriter_Expecter) WriteCheckpoint(ctx interface{}, message interface{}) *MockConversationTranscriptWriter_WriteCheckpoint_Call {
+	return &MockConversationTranscriptWriter_WriteCheckpoint_Call{Call: _e.mock.On("WriteCheckpoint", ctx, message)}
 }
 
-func (_m *MockListAvailableModels) EXPECT() *MockListAvailableModels_Expecter {
-	return &MockListAvailableModels_Expecter{mock: &_m.Mock}
+func (_c *MockConversationTranscriptWriter_WriteCheckpoint_Call) Run(run func(ctx context.Context, message assistant.ChatMessage)) *MockConversationTranscriptWriter_WriteCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 assistant.ChatMessage
+		if args[1] != nil {
+			arg1 = args[1].(assistant.ChatMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-// Query provides a mock function for the type MockListAvailableModels
-func (_mock *MockListAvailableModels) Query(ctx context.Context) ([]assistant.ModelInfo, error) {
-	ret := _mock.Called(ctx)
+func (_c *MockConversationTranscriptWriter_WriteCheckpoint_Call) Return(err error) *MockConversationTranscriptWriter_WriteCheckpoint_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConversationTranscriptWriter_WriteCheckpoint_Call) RunAndReturn(run func(ctx context.Context, message assistant.ChatMessage) error) *MockConversationTranscriptWriter_WriteCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteMessage provides a mock function for the type MockConversationTranscriptWriter
+func (_mock *MockConversationTranscriptWriter) WriteMessage(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage) error {
+	ret := _mock.Called(ctx, conversation, message)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Query")
+		panic("no return value specified for WriteMessage")
 	}
 
-	var r0 []assistant.ModelInfo
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]assistant.ModelInfo, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []assistant.ModelInfo); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]assistant.ModelInfo)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, assistant.Conversation, assistant.ChatMessage) error); ok {
+		r0 = returnFunc(ctx, conversation, message)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockListAvailableModels_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockListAvailableModels_Query_Call struct {
+// MockConversationTranscriptWriter_WriteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteMessage'
+type MockConversationTranscriptWriter_WriteMessage_Call struct {
 	*mock.Call
 }
 
-// Query is a helper method to define mock.On call
+// WriteMessage is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockListAvailableModels_Expecter) Query(ctx interface{}) *MockListAvailableModels_Query_Call {
-	return &MockListAvailableModels_Query_Call{Call: _e.mock.On("Query", ctx)}
+//   - conversation assistant.Conversation
+//   - message assistant.ChatMessage
+func (_e *MockConversationTranscriptWriter_Expecter) WriteMessage(ctx interface{}, conversation interface{}, message interface{}) *MockConversationTranscriptWriter_WriteMessage_Call {
+	return &MockConversationTranscriptWriter_WriteMessage_Call{Call: _e.mock.On("WriteMessage", ctx, conversation, message)}
 }
 
-func (_c *MockListAvailableModels_Query_Call) Run(run func(ctx context.Context)) *MockListAvailableModels_Query_Call {
+func (_c *MockConversationTranscriptWriter_WriteMessage_Call) Run(run func(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage)) *MockConversationTranscriptWriter_WriteMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 assistant.Conversation
+		if args[1] != nil {
+			arg1 = args[1].(assistant.Conversation)
+		}
+		var arg2 assistant.ChatMessage
+		if args[2] != nil {
+			arg2 = args[2].(assistant.ChatMessage)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockListAvailableModels_Query_Call) Return(modelInfos []assistant.ModelInfo, err error) *MockListAvailableModels_Query_Call {
-	_c.Call.Return(modelInfos, err)
+func (_c *MockConversationTranscriptWriter_WriteMessage_Call) Return(err error) *MockConversationTranscriptWriter_WriteMessage_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockListAvailableModels_Query_Call) RunAndReturn(run func(ctx context.Context) ([]assistant.ModelInfo, error)) *MockListAvailableModels_Query_Call {
+func (_c *MockConversationTranscriptWriter_WriteMessage_Call) RunAndReturn(run func(ctx context.Context, conversation assistant.Conversation, message assistant.ChatMessage) error) *MockConversationTranscriptWriter_WriteMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListAvailableSkills creates a new instance of MockListAvailableSkills. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockCountUnreadMessages creates a new instance of MockCountUnreadMessages. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockListAvailableSkills(t interface {
+func NewMockCountUnreadMessages(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockListAvailableSkills {
-	mock := &MockListAvailableSkills{}
+}) *MockCountUnreadMessages {
+	mock := &MockCountUnreadMessages{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -698,88 +819,94 @@ func NewMockListAvailableSkills(t interface {
 	return mock
 }
 
-// MockListAvailableSkills is an autogenerated mock type for the ListAvailableSkills type
-type MockListAvailableSkills struct {
+// MockCountUnreadMessages is an autogenerated mock type for the CountUnreadMessages type
+type MockCountUnreadMessages struct {
 	mock.Mock
 }
 
-type MockListAvailableSkills_Expecter struct {
+type MockCountUnreadMessages_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockListAvailableSkills) EXPECT() *MockListAvailableSkills_Expecter {
-	return &MockListAvailableSkills_Expecter{mock: &_m.Mock}
+func (_m *MockCountUnreadMessages) EXPECT() *MockCountUnreadMessages_Expecter {
+	return &MockCountUnreadMessages_Expecter{mock: &_m.Mock}
 }
 
-// Query provides a mock function for the type MockListAvailableSkills
-func (_mock *MockListAvailableSkills) Query(ctx context.Context) ([]assistant.SkillDefinition, error) {
-	ret := _mock.Called(ctx)
+// Query provides a mock function for the type MockCountUnreadMessages
+func (_mock *MockCountUnreadMessages) Query(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	ret := _mock.Called(ctx, conversationIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Query")
 	}
 
-	var r0 []assistant.SkillDefinition
+	var r0 map[uuid.UUID]int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]assistant.SkillDefinition, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)); ok {
+		return returnFunc(ctx, conversationIDs)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []assistant.SkillDefinition); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]int64); ok {
+		r0 = returnFunc(ctx, conversationIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]assistant.SkillDefinition)
+			r0 = ret.Get(0).(map[uuid.UUID]int64)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockListAvailableSkills_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockListAvailableSkills_Query_Call struct {
+// MockCountUnreadMessages_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockCountUnreadMessages_Query_Call struct {
 	*mock.Call
 }
 
 // Query is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockListAvailableSkills_Expecter) Query(ctx interface{}) *MockListAvailableSkills_Query_Call {
-	return &MockListAvailableSkills_Query_Call{Call: _e.mock.On("Query", ctx)}
+//   - conversationIDs []uuid.UUID
+func (_e *MockCountUnreadMessages_Expecter) Query(ctx interface{}, conversationIDs interface{}) *MockCountUnreadMessages_Query_Call {
+	return &MockCountUnreadMessages_Query_Call{Call: _e.mock.On("Query", ctx, conversationIDs)}
 }
 
-func (_c *MockListAvailableSkills_Query_Call) Run(run func(ctx context.Context)) *MockListAvailableSkills_Query_Call {
+func (_c *MockCountUnreadMessages_Query_Call) Run(run func(ctx context.Context, conversationIDs []uuid.UUID)) *MockCountUnreadMessages_Query_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockListAvailableSkills_Query_Call) Return(skillDefinitions []assistant.SkillDefinition, err error) *MockListAvailableSkills_Query_Call {
-	_c.Call.Return(skillDefinitions, err)
+func (_c *MockCountUnreadMessages_Query_Call) Return(uUIDToInt64 map[uuid.UUID]int64, err error) *MockCountUnreadMessages_Query_Call {
+	_c.Call.Return(uUIDToInt64, err)
 	return _c
 }
 
-func (_c *MockListAvailableSkills_Query_Call) RunAndReturn(run func(ctx context.Context) ([]assistant.SkillDefinition, error)) *MockListAvailableSkills_Query_Call {
+func (_c *MockCountUnreadMessages_Query_Call) RunAndReturn(run func(ctx context.Context, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error)) *MockCountUnreadMessages_Query_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListChatMessages creates a new instance of MockListChatMessages. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockDeleteConversation creates a new instance of MockDeleteConversation. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockListChatMessages(t interface {
+func NewMockDeleteConversation(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockListChatMessages {
-	mock := &MockListChatMessages{}
+}) *MockDeleteConversation {
+	mock := &MockDeleteConversation{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -787,68 +914,49 @@ func NewMockListChatMessages(t interface {
 	return mock
 }
 
-// MockListChatMessages is an autogenerated mock type for the ListChatMessages type
-type MockListChatMessages struct {
+// MockDeleteConversation is an autogenerated mock type for the DeleteConversation type
+type MockDeleteConversation struct {
 	mock.Mock
 }
 
-type MockListChatMessages_Expecter struct {
+type MockDeleteConversation_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockListChatMessages) EXPECT() *MockListChatMessages_Expecter {
-	return &MockListChatMessages_Expecter{mock: &_m.Mock}
+func (_m *MockDeleteConversation) EXPECT() *MockDeleteConversation_Expecter {
+	return &MockDeleteConversation_Expecter{mock: &_m.Mock}
 }
 
-// Query provides a mock function for the type MockListChatMessages
-func (_mock *MockListChatMessages) Query(ctx context.Context, conversationID uuid.UUID, page int, pageSize int) ([]assistant.ChatMessage, bool, error) {
-	ret := _mock.Called(ctx, conversationID, page, pageSize)
+// Execute provides a mock function for the type MockDeleteConversation
+func (_mock *MockDeleteConversation) Execute(ctx context.Context, conversationID uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Query")
+		panic("no return value specified for Execute")
 	}
 
-	var r0 []assistant.ChatMessage
-	var r1 bool
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]assistant.ChatMessage, bool, error)); ok {
-		return returnFunc(ctx, conversationID, page, pageSize)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []assistant.ChatMessage); ok {
-		r0 = returnFunc(ctx, conversationID, page, pageSize)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]assistant.ChatMessage)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) bool); ok {
-		r1 = returnFunc(ctx, conversationID, page, pageSize)
-	} else {
-		r1 = ret.Get(1).(bool)
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
-		r2 = returnFunc(ctx, conversationID, page, pageSize)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, conversationID)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Error(0)
 	}
-	return r0, r1, r2
+	return r0
 }
 
-// MockListChatMessages_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockListChatMessages_Query_Call struct {
+// MockDeleteConversation_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDeleteConversation_Execute_Call struct {
 	*mock.Call
 }
 
-// Query is a helper method to define mock.On call
+// Execute is a helper method to define mock.On call
 //   - ctx context.Context
 //   - conversationID uuid.UUID
-//   - page int
-//   - pageSize int
-func (_e *MockListChatMessages_Expecter) Query(ctx interface{}, conversationID interface{}, page interface{}, pageSize interface{}) *MockListChatMessages_Query_Call {
-	return &MockListChatMessages_Query_Call{Call: _e.mock.On("Query", ctx, conversationID, page, pageSize)}
+func (_e *MockDeleteConversation_Expecter) Execute(ctx interface{}, conversationID interface{}) *MockDeleteConversation_Execute_Call {
+	return &MockDeleteConversation_Execute_Call{Call: _e.mock.On("Execute", ctx, conversationID)}
 }
 
-func (_c *MockListChatMessages_Query_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, page int, pageSize int)) *MockListChatMessages_Query_Call {
+func (_c *MockDeleteConversation_Execute_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockDeleteConversation_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -858,41 +966,31 @@ func (_c *MockListChatMessages_Query_Call) Run(run func(ctx context.Context, con
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockListChatMessages_Query_Call) Return(chatMessages []assistant.ChatMessage, b bool, err error) *MockListChatMessages_Query_Call {
-	_c.Call.Return(chatMessages, b, err)
+func (_c *MockDeleteConversation_Execute_Call) Return(err error) *MockDeleteConversation_Execute_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockListChatMessages_Query_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, page int, pageSize int) ([]assistant.ChatMessage, bool, error)) *MockListChatMessages_Query_Call {
+func (_c *MockDeleteConversation_Execute_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockDeleteConversation_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockListConversations creates a new instance of MockListConversations. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockExtractMemories creates a new instance of MockExtractMemories. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockListConversations(t interface {
+func NewMockExtractMemories(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockListConversations {
-	mock := &MockListConversations{}
+}) *MockExtractMemories {
+	mock := &MockExtractMemories{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -900,114 +998,2361 @@ func NewMockListConversations(t interface {
 	return mock
 }
 
-// MockListConversations is an autogenerated mock type for the ListConversations type
-type MockListConversations struct {
+// MockExtractMemories is an autogenerated mock type for the ExtractMemories type
+type MockExtractMemories struct {
 	mock.Mock
 }
 
-type MockListConversations_Expecter struct {
+type MockExtractMemories_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockListConversations) EXPECT() *MockListConversations_Expecter {
-	return &MockListConversations_Expecter{mock: &_m.Mock}
+func (_m *MockExtractMemories) EXPECT() *MockExtractMemories_Expecter {
+	return &MockExtractMemories_Expecter{mock: &_m.Mock}
 }
 
-// Query provides a mock function for the type MockListConversations
-func (_mock *MockListConversations) Query(ctx context.Context, page int, pageSize int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error) {
-	ret := _mock.Called(ctx, page, pageSize)
+// Execute provides a mock function for the type MockExtractMemories
+func (_mock *MockExtractMemories) Execute(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Query")
+		panic("no return value specified for Execute")
 	}
 
-	var r0 []assistant.Conversation
-	var r1 map[uuid.UUID]int64
-	var r2 bool
-	var r3 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error)); ok {
-		return returnFunc(ctx, page, pageSize)
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []assistant.Conversation); ok {
-		r0 = returnFunc(ctx, page, pageSize)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]assistant.Conversation)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) map[uuid.UUID]int64); ok {
-		r1 = returnFunc(ctx, page, pageSize)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExtractMemories_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExtractMemories_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExtractMemories_Expecter) Execute(ctx interface{}) *MockExtractMemories_Execute_Call {
+	return &MockExtractMemories_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockExtractMemories_Execute_Call) Run(run func(ctx context.Context)) *MockExtractMemories_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExtractMemories_Execute_Call) Return(n int, err error) *MockExtractMemories_Execute_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockExtractMemories_Execute_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockExtractMemories_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGenerateConversationTitle creates a new instance of MockGenerateConversationTitle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGenerateConversationTitle(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGenerateConversationTitle {
+	mock := &MockGenerateConversationTitle{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGenerateConversationTitle is an autogenerated mock type for the GenerateConversationTitle type
+type MockGenerateConversationTitle struct {
+	mock.Mock
+}
+
+type MockGenerateConversationTitle_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGenerateConversationTitle) EXPECT() *MockGenerateConversationTitle_Expecter {
+	return &MockGenerateConversationTitle_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGenerateConversationTitle
+func (_mock *MockGenerateConversationTitle) Execute(ctx context.Context, event outbox.ChatMessageEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, outbox.ChatMessageEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGenerateConversationTitle_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGenerateConversationTitle_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event outbox.ChatMessageEvent
+func (_e *MockGenerateConversationTitle_Expecter) Execute(ctx interface{}, event interface{}) *MockGenerateConversationTitle_Execute_Call {
+	return &MockGenerateConversationTitle_Execute_Call{Call: _e.mock.On("Execute", ctx, event)}
+}
+
+func (_c *MockGenerateConversationTitle_Execute_Call) Run(run func(ctx context.Context, event outbox.ChatMessageEvent)) *MockGenerateConversationTitle_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 outbox.ChatMessageEvent
+		if args[1] != nil {
+			arg1 = args[1].(outbox.ChatMessageEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGenerateConversationTitle_Execute_Call) Return(err error) *MockGenerateConversationTitle_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGenerateConversationTitle_Execute_Call) RunAndReturn(run func(ctx context.Context, event outbox.ChatMessageEvent) error) *MockGenerateConversationTitle_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetContentBlob creates a new instance of MockGetContentBlob. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetContentBlob(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetContentBlob {
+	mock := &MockGetContentBlob{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetContentBlob is an autogenerated mock type for the GetContentBlob type
+type MockGetContentBlob struct {
+	mock.Mock
+}
+
+type MockGetContentBlob_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetContentBlob) EXPECT() *MockGetContentBlob_Expecter {
+	return &MockGetContentBlob_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockGetContentBlob
+func (_mock *MockGetContentBlob) Query(ctx context.Context, hash string) (assistant.ContentBlob, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 assistant.ContentBlob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (assistant.ContentBlob, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) assistant.ContentBlob); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(assistant.ContentBlob)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetContentBlob_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockGetContentBlob_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *MockGetContentBlob_Expecter) Query(ctx interface{}, hash interface{}) *MockGetContentBlob_Query_Call {
+	return &MockGetContentBlob_Query_Call{Call: _e.mock.On("Query", ctx, hash)}
+}
+
+func (_c *MockGetContentBlob_Query_Call) Run(run func(ctx context.Context, hash string)) *MockGetContentBlob_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetContentBlob_Query_Call) Return(contentBlob assistant.ContentBlob, err error) *MockGetContentBlob_Query_Call {
+	_c.Call.Return(contentBlob, err)
+	return _c
+}
+
+func (_c *MockGetContentBlob_Query_Call) RunAndReturn(run func(ctx context.Context, hash string) (assistant.ContentBlob, error)) *MockGetContentBlob_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetExperimentReport creates a new instance of MockGetExperimentReport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetExperimentReport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetExperimentReport {
+	mock := &MockGetExperimentReport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetExperimentReport is an autogenerated mock type for the GetExperimentReport type
+type MockGetExperimentReport struct {
+	mock.Mock
+}
+
+type MockGetExperimentReport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetExperimentReport) EXPECT() *MockGetExperimentReport_Expecter {
+	return &MockGetExperimentReport_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockGetExperimentReport
+func (_mock *MockGetExperimentReport) Query(ctx context.Context, name string) (ExperimentReport, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 ExperimentReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (ExperimentReport, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ExperimentReport); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Get(0).(ExperimentReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetExperimentReport_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockGetExperimentReport_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockGetExperimentReport_Expecter) Query(ctx interface{}, name interface{}) *MockGetExperimentReport_Query_Call {
+	return &MockGetExperimentReport_Query_Call{Call: _e.mock.On("Query", ctx, name)}
+}
+
+func (_c *MockGetExperimentReport_Query_Call) Run(run func(ctx context.Context, name string)) *MockGetExperimentReport_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetExperimentReport_Query_Call) Return(experimentReport ExperimentReport, err error) *MockGetExperimentReport_Query_Call {
+	_c.Call.Return(experimentReport, err)
+	return _c
+}
+
+func (_c *MockGetExperimentReport_Query_Call) RunAndReturn(run func(ctx context.Context, name string) (ExperimentReport, error)) *MockGetExperimentReport_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetTurnTranscript creates a new instance of MockGetTurnTranscript. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTurnTranscript(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTurnTranscript {
+	mock := &MockGetTurnTranscript{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetTurnTranscript is an autogenerated mock type for the GetTurnTranscript type
+type MockGetTurnTranscript struct {
+	mock.Mock
+}
+
+type MockGetTurnTranscript_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTurnTranscript) EXPECT() *MockGetTurnTranscript_Expecter {
+	return &MockGetTurnTranscript_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockGetTurnTranscript
+func (_mock *MockGetTurnTranscript) Query(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) (TurnTranscript, error) {
+	ret := _mock.Called(ctx, conversationID, turnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 TurnTranscript
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (TurnTranscript, error)); ok {
+		return returnFunc(ctx, conversationID, turnID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) TurnTranscript); ok {
+		r0 = returnFunc(ctx, conversationID, turnID)
+	} else {
+		r0 = ret.Get(0).(TurnTranscript)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationID, turnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetTurnTranscript_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockGetTurnTranscript_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - turnID uuid.UUID
+func (_e *MockGetTurnTranscript_Expecter) Query(ctx interface{}, conversationID interface{}, turnID interface{}) *MockGetTurnTranscript_Query_Call {
+	return &MockGetTurnTranscript_Query_Call{Call: _e.mock.On("Query", ctx, conversationID, turnID)}
+}
+
+func (_c *MockGetTurnTranscript_Query_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID)) *MockGetTurnTranscript_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetTurnTranscript_Query_Call) Return(turnTranscript TurnTranscript, err error) *MockGetTurnTranscript_Query_Call {
+	_c.Call.Return(turnTranscript, err)
+	return _c
+}
+
+func (_c *MockGetTurnTranscript_Query_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) (TurnTranscript, error)) *MockGetTurnTranscript_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInstructions creates a new instance of MockInstructions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInstructions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInstructions {
+	mock := &MockInstructions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInstructions is an autogenerated mock type for the Instructions type
+type MockInstructions struct {
+	mock.Mock
+}
+
+type MockInstructions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInstructions) EXPECT() *MockInstructions_Expecter {
+	return &MockInstructions_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockInstructions
+func (_mock *MockInstructions) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockInstructions_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockInstructions_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockInstructions_Expecter) Delete(ctx interface{}, id interface{}) *MockInstructions_Delete_Call {
+	return &MockInstructions_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockInstructions_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockInstructions_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructions_Delete_Call) Return(err error) *MockInstructions_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockInstructions_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockInstructions_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockInstructions
+func (_mock *MockInstructions) List(ctx context.Context, conversationID uuid.UUID) ([]assistant.Instruction, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []assistant.Instruction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]assistant.Instruction, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []assistant.Instruction); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.Instruction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInstructions_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockInstructions_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockInstructions_Expecter) List(ctx interface{}, conversationID interface{}) *MockInstructions_List_Call {
+	return &MockInstructions_List_Call{Call: _e.mock.On("List", ctx, conversationID)}
+}
+
+func (_c *MockInstructions_List_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockInstructions_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructions_List_Call) Return(instructions []assistant.Instruction, err error) *MockInstructions_List_Call {
+	_c.Call.Return(instructions, err)
+	return _c
+}
+
+func (_c *MockInstructions_List_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) ([]assistant.Instruction, error)) *MockInstructions_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remember provides a mock function for the type MockInstructions
+func (_mock *MockInstructions) Remember(ctx context.Context, conversationID *uuid.UUID, text string) (assistant.Instruction, error) {
+	ret := _mock.Called(ctx, conversationID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remember")
+	}
+
+	var r0 assistant.Instruction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) (assistant.Instruction, error)); ok {
+		return returnFunc(ctx, conversationID, text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *uuid.UUID, string) assistant.Instruction); ok {
+		r0 = returnFunc(ctx, conversationID, text)
+	} else {
+		r0 = ret.Get(0).(assistant.Instruction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, conversationID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockInstructions_Remember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remember'
+type MockInstructions_Remember_Call struct {
+	*mock.Call
+}
+
+// Remember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID *uuid.UUID
+//   - text string
+func (_e *MockInstructions_Expecter) Remember(ctx interface{}, conversationID interface{}, text interface{}) *MockInstructions_Remember_Call {
+	return &MockInstructions_Remember_Call{Call: _e.mock.On("Remember", ctx, conversationID, text)}
+}
+
+func (_c *MockInstructions_Remember_Call) Run(run func(ctx context.Context, conversationID *uuid.UUID, text string)) *MockInstructions_Remember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(*uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInstructions_Remember_Call) Return(instruction assistant.Instruction, err error) *MockInstructions_Remember_Call {
+	_c.Call.Return(instruction, err)
+	return _c
+}
+
+func (_c *MockInstructions_Remember_Call) RunAndReturn(run func(ctx context.Context, conversationID *uuid.UUID, text string) (assistant.Instruction, error)) *MockInstructions_Remember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAvailableModels creates a new instance of MockListAvailableModels. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAvailableModels(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAvailableModels {
+	mock := &MockListAvailableModels{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListAvailableModels is an autogenerated mock type for the ListAvailableModels type
+type MockListAvailableModels struct {
+	mock.Mock
+}
+
+type MockListAvailableModels_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAvailableModels) EXPECT() *MockListAvailableModels_Expecter {
+	return &MockListAvailableModels_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockListAvailableModels
+func (_mock *MockListAvailableModels) Query(ctx context.Context) ([]assistant.ModelInfo, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []assistant.ModelInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]assistant.ModelInfo, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []assistant.ModelInfo); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.ModelInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListAvailableModels_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockListAvailableModels_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockListAvailableModels_Expecter) Query(ctx interface{}) *MockListAvailableModels_Query_Call {
+	return &MockListAvailableModels_Query_Call{Call: _e.mock.On("Query", ctx)}
+}
+
+func (_c *MockListAvailableModels_Query_Call) Run(run func(ctx context.Context)) *MockListAvailableModels_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListAvailableModels_Query_Call) Return(modelInfos []assistant.ModelInfo, err error) *MockListAvailableModels_Query_Call {
+	_c.Call.Return(modelInfos, err)
+	return _c
+}
+
+func (_c *MockListAvailableModels_Query_Call) RunAndReturn(run func(ctx context.Context) ([]assistant.ModelInfo, error)) *MockListAvailableModels_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAvailableSkills creates a new instance of MockListAvailableSkills. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAvailableSkills(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAvailableSkills {
+	mock := &MockListAvailableSkills{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListAvailableSkills is an autogenerated mock type for the ListAvailableSkills type
+type MockListAvailableSkills struct {
+	mock.Mock
+}
+
+type MockListAvailableSkills_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAvailableSkills) EXPECT() *MockListAvailableSkills_Expecter {
+	return &MockListAvailableSkills_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockListAvailableSkills
+func (_mock *MockListAvailableSkills) Query(ctx context.Context) ([]assistant.SkillDefinition, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []assistant.SkillDefinition
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]assistant.SkillDefinition, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []assistant.SkillDefinition); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.SkillDefinition)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockListAvailableSkills_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockListAvailableSkills_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockListAvailableSkills_Expecter) Query(ctx interface{}) *MockListAvailableSkills_Query_Call {
+	return &MockListAvailableSkills_Query_Call{Call: _e.mock.On("Query", ctx)}
+}
+
+func (_c *MockListAvailableSkills_Query_Call) Run(run func(ctx context.Context)) *MockListAvailableSkills_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListAvailableSkills_Query_Call) Return(skillDefinitions []assistant.SkillDefinition, err error) *MockListAvailableSkills_Query_Call {
+	_c.Call.Return(skillDefinitions, err)
+	return _c
+}
+
+func (_c *MockListAvailableSkills_Query_Call) RunAndReturn(run func(ctx context.Context) ([]assistant.SkillDefinition, error)) *MockListAvailableSkills_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListChatMessages creates a new instance of MockListChatMessages. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListChatMessages(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListChatMessages {
+	mock := &MockListChatMessages{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListChatMessages is an autogenerated mock type for the ListChatMessages type
+type MockListChatMessages struct {
+	mock.Mock
+}
+
+type MockListChatMessages_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListChatMessages) EXPECT() *MockListChatMessages_Expecter {
+	return &MockListChatMessages_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockListChatMessages
+func (_mock *MockListChatMessages) Query(ctx context.Context, conversationID uuid.UUID, page int, pageSize int) ([]assistant.ChatMessage, bool, error) {
+	ret := _mock.Called(ctx, conversationID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []assistant.ChatMessage
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]assistant.ChatMessage, bool, error)); ok {
+		return returnFunc(ctx, conversationID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []assistant.ChatMessage); ok {
+		r0 = returnFunc(ctx, conversationID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.ChatMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) bool); ok {
+		r1 = returnFunc(ctx, conversationID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, conversationID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockListChatMessages_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockListChatMessages_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - page int
+//   - pageSize int
+func (_e *MockListChatMessages_Expecter) Query(ctx interface{}, conversationID interface{}, page interface{}, pageSize interface{}) *MockListChatMessages_Query_Call {
+	return &MockListChatMessages_Query_Call{Call: _e.mock.On("Query", ctx, conversationID, page, pageSize)}
+}
+
+func (_c *MockListChatMessages_Query_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, page int, pageSize int)) *MockListChatMessages_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListChatMessages_Query_Call) Return(chatMessages []assistant.ChatMessage, b bool, err error) *MockListChatMessages_Query_Call {
+	_c.Call.Return(chatMessages, b, err)
+	return _c
+}
+
+func (_c *MockListChatMessages_Query_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, page int, pageSize int) ([]assistant.ChatMessage, bool, error)) *MockListChatMessages_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListConversations creates a new instance of MockListConversations. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListConversations(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListConversations {
+	mock := &MockListConversations{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockListConversations is an autogenerated mock type for the ListConversations type
+type MockListConversations struct {
+	mock.Mock
+}
+
+type MockListConversations_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListConversations) EXPECT() *MockListConversations_Expecter {
+	return &MockListConversations_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockListConversations
+func (_mock *MockListConversations) Query(ctx context.Context, page int, pageSize int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error) {
+	ret := _mock.Called(ctx, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []assistant.Conversation
+	var r1 map[uuid.UUID]int64
+	var r2 bool
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error)); ok {
+		return returnFunc(ctx, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) []assistant.Conversation); ok {
+		r0 = returnFunc(ctx, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.Conversation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) map[uuid.UUID]int64); ok {
+		r1 = returnFunc(ctx, page, pageSize)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(map[uuid.UUID]int64)
 		}
-	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) bool); ok {
-		r2 = returnFunc(ctx, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int) bool); ok {
+		r2 = returnFunc(ctx, page, pageSize)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+	if returnFunc, ok := ret.Get(3).(func(context.Context, int, int) error); ok {
+		r3 = returnFunc(ctx, page, pageSize)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockListConversations_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockListConversations_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+func (_e *MockListConversations_Expecter) Query(ctx interface{}, page interface{}, pageSize interface{}) *MockListConversations_Query_Call {
+	return &MockListConversations_Query_Call{Call: _e.mock.On("Query", ctx, page, pageSize)}
+}
+
+func (_c *MockListConversations_Query_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockListConversations_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockListConversations_Query_Call) Return(conversations []assistant.Conversation, uUIDToInt64 map[uuid.UUID]int64, b bool, err error) *MockListConversations_Query_Call {
+	_c.Call.Return(conversations, uUIDToInt64, b, err)
+	return _c
+}
+
+func (_c *MockListConversations_Query_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error)) *MockListConversations_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMaintainChatMessagePartitions creates a new instance of MockMaintainChatMessagePartitions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMaintainChatMessagePartitions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMaintainChatMessagePartitions {
+	mock := &MockMaintainChatMessagePartitions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMaintainChatMessagePartitions is an autogenerated mock type for the MaintainChatMessagePartitions type
+type MockMaintainChatMessagePartitions struct {
+	mock.Mock
+}
+
+type MockMaintainChatMessagePartitions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMaintainChatMessagePartitions) EXPECT() *MockMaintainChatMessagePartitions_Expecter {
+	return &MockMaintainChatMessagePartitions_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockMaintainChatMessagePartitions
+func (_mock *MockMaintainChatMessagePartitions) Execute(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMaintainChatMessagePartitions_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockMaintainChatMessagePartitions_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMaintainChatMessagePartitions_Expecter) Execute(ctx interface{}) *MockMaintainChatMessagePartitions_Execute_Call {
+	return &MockMaintainChatMessagePartitions_Execute_Call{Call: _e.mock.On("Execute", ctx)}
+}
+
+func (_c *MockMaintainChatMessagePartitions_Execute_Call) Run(run func(ctx context.Context)) *MockMaintainChatMessagePartitions_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMaintainChatMessagePartitions_Execute_Call) Return(n int, err error) *MockMaintainChatMessagePartitions_Execute_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockMaintainChatMessagePartitions_Execute_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockMaintainChatMessagePartitions_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMarkConversationRead creates a new instance of MockMarkConversationRead. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMarkConversationRead(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMarkConversationRead {
+	mock := &MockMarkConversationRead{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMarkConversationRead is an autogenerated mock type for the MarkConversationRead type
+type MockMarkConversationRead struct {
+	mock.Mock
+}
+
+type MockMarkConversationRead_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMarkConversationRead) EXPECT() *MockMarkConversationRead_Expecter {
+	return &MockMarkConversationRead_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockMarkConversationRead
+func (_mock *MockMarkConversationRead) Execute(ctx context.Context, conversationID uuid.UUID, messageID *uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, conversationID, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMarkConversationRead_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockMarkConversationRead_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - messageID *uuid.UUID
+func (_e *MockMarkConversationRead_Expecter) Execute(ctx interface{}, conversationID interface{}, messageID interface{}) *MockMarkConversationRead_Execute_Call {
+	return &MockMarkConversationRead_Execute_Call{Call: _e.mock.On("Execute", ctx, conversationID, messageID)}
+}
+
+func (_c *MockMarkConversationRead_Execute_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, messageID *uuid.UUID)) *MockMarkConversationRead_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMarkConversationRead_Execute_Call) Return(err error) *MockMarkConversationRead_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMarkConversationRead_Execute_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, messageID *uuid.UUID) error) *MockMarkConversationRead_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMemories creates a new instance of MockMemories. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMemories(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMemories {
+	mock := &MockMemories{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMemories is an autogenerated mock type for the Memories type
+type MockMemories struct {
+	mock.Mock
+}
+
+type MockMemories_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMemories) EXPECT() *MockMemories_Expecter {
+	return &MockMemories_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockMemories
+func (_mock *MockMemories) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMemories_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMemories_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMemories_Expecter) Delete(ctx interface{}, id interface{}) *MockMemories_Delete_Call {
+	return &MockMemories_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockMemories_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMemories_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemories_Delete_Call) Return(err error) *MockMemories_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMemories_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockMemories_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockMemories
+func (_mock *MockMemories) List(ctx context.Context) ([]assistant.Memory, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []assistant.Memory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]assistant.Memory, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []assistant.Memory); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.Memory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMemories_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockMemories_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMemories_Expecter) List(ctx interface{}) *MockMemories_List_Call {
+	return &MockMemories_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockMemories_List_Call) Run(run func(ctx context.Context)) *MockMemories_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemories_List_Call) Return(memorys []assistant.Memory, err error) *MockMemories_List_Call {
+	_c.Call.Return(memorys, err)
+	return _c
+}
+
+func (_c *MockMemories_List_Call) RunAndReturn(run func(ctx context.Context) ([]assistant.Memory, error)) *MockMemories_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Recall provides a mock function for the type MockMemories
+func (_mock *MockMemories) Recall(ctx context.Context, userMessage string) ([]assistant.Memory, error) {
+	ret := _mock.Called(ctx, userMessage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recall")
+	}
+
+	var r0 []assistant.Memory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]assistant.Memory, error)); ok {
+		return returnFunc(ctx, userMessage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []assistant.Memory); ok {
+		r0 = returnFunc(ctx, userMessage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.Memory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userMessage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMemories_Recall_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recall'
+type MockMemories_Recall_Call struct {
+	*mock.Call
+}
+
+// Recall is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userMessage string
+func (_e *MockMemories_Expecter) Recall(ctx interface{}, userMessage interface{}) *MockMemories_Recall_Call {
+	return &MockMemories_Recall_Call{Call: _e.mock.On("Recall", ctx, userMessage)}
+}
+
+func (_c *MockMemories_Recall_Call) Run(run func(ctx context.Context, userMessage string)) *MockMemories_Recall_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMemories_Recall_Call) Return(memorys []assistant.Memory, err error) *MockMemories_Recall_Call {
+	_c.Call.Return(memorys, err)
+	return _c
+}
+
+func (_c *MockMemories_Recall_Call) RunAndReturn(run func(ctx context.Context, userMessage string) ([]assistant.Memory, error)) *MockMemories_Recall_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostSystemMessage creates a new instance of MockPostSystemMessage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostSystemMessage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostSystemMessage {
+	mock := &MockPostSystemMessage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostSystemMessage is an autogenerated mock type for the PostSystemMessage type
+type MockPostSystemMessage struct {
+	mock.Mock
+}
+
+type MockPostSystemMessage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostSystemMessage) EXPECT() *MockPostSystemMessage_Expecter {
+	return &MockPostSystemMessage_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockPostSystemMessage
+func (_mock *MockPostSystemMessage) Execute(ctx context.Context, message SystemMessage) (assistant.ChatMessage, error) {
+	ret := _mock.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 assistant.ChatMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SystemMessage) (assistant.ChatMessage, error)); ok {
+		return returnFunc(ctx, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SystemMessage) assistant.ChatMessage); ok {
+		r0 = returnFunc(ctx, message)
+	} else {
+		r0 = ret.Get(0).(assistant.ChatMessage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SystemMessage) error); ok {
+		r1 = returnFunc(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostSystemMessage_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockPostSystemMessage_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message SystemMessage
+func (_e *MockPostSystemMessage_Expecter) Execute(ctx interface{}, message interface{}) *MockPostSystemMessage_Execute_Call {
+	return &MockPostSystemMessage_Execute_Call{Call: _e.mock.On("Execute", ctx, message)}
+}
+
+func (_c *MockPostSystemMessage_Execute_Call) Run(run func(ctx context.Context, message SystemMessage)) *MockPostSystemMessage_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SystemMessage
+		if args[1] != nil {
+			arg1 = args[1].(SystemMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostSystemMessage_Execute_Call) Return(chatMessage assistant.ChatMessage, err error) *MockPostSystemMessage_Execute_Call {
+	_c.Call.Return(chatMessage, err)
+	return _c
+}
+
+func (_c *MockPostSystemMessage_Execute_Call) RunAndReturn(run func(ctx context.Context, message SystemMessage) (assistant.ChatMessage, error)) *MockPostSystemMessage_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPreviewChat creates a new instance of MockPreviewChat. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPreviewChat(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPreviewChat {
+	mock := &MockPreviewChat{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPreviewChat is an autogenerated mock type for the PreviewChat type
+type MockPreviewChat struct {
+	mock.Mock
+}
+
+type MockPreviewChat_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPreviewChat) EXPECT() *MockPreviewChat_Expecter {
+	return &MockPreviewChat_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockPreviewChat
+func (_mock *MockPreviewChat) Execute(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, userMessage, model, onEvent, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, userMessage, model, onEvent)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, assistant.EventCallback, ...StreamChatOption) error); ok {
+		r0 = returnFunc(ctx, userMessage, model, onEvent, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPreviewChat_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockPreviewChat_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userMessage string
+//   - model string
+//   - onEvent assistant.EventCallback
+//   - opts ...StreamChatOption
+func (_e *MockPreviewChat_Expecter) Execute(ctx interface{}, userMessage interface{}, model interface{}, onEvent interface{}, opts ...interface{}) *MockPreviewChat_Execute_Call {
+	return &MockPreviewChat_Execute_Call{Call: _e.mock.On("Execute",
+		append([]interface{}{ctx, userMessage, model, onEvent}, opts...)...)}
+}
+
+func (_c *MockPreviewChat_Execute_Call) Run(run func(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption)) *MockPreviewChat_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 assistant.EventCallback
+		if args[3] != nil {
+			arg3 = args[3].(assistant.EventCallback)
+		}
+		var arg4 []StreamChatOption
+		var variadicArgs []StreamChatOption
+		if len(args) > 4 {
+			variadicArgs = args[4].([]StreamChatOption)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPreviewChat_Execute_Call) Return(err error) *MockPreviewChat_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPreviewChat_Execute_Call) RunAndReturn(run func(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error) *MockPreviewChat_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectConversationReadModel creates a new instance of MockProjectConversationReadModel. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectConversationReadModel(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectConversationReadModel {
+	mock := &MockProjectConversationReadModel{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjectConversationReadModel is an autogenerated mock type for the ProjectConversationReadModel type
+type MockProjectConversationReadModel struct {
+	mock.Mock
+}
+
+type MockProjectConversationReadModel_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectConversationReadModel) EXPECT() *MockProjectConversationReadModel_Expecter {
+	return &MockProjectConversationReadModel_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockProjectConversationReadModel
+func (_mock *MockProjectConversationReadModel) Execute(ctx context.Context, event outbox.ChatMessageEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, outbox.ChatMessageEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectConversationReadModel_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockProjectConversationReadModel_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event outbox.ChatMessageEvent
+func (_e *MockProjectConversationReadModel_Expecter) Execute(ctx interface{}, event interface{}) *MockProjectConversationReadModel_Execute_Call {
+	return &MockProjectConversationReadModel_Execute_Call{Call: _e.mock.On("Execute", ctx, event)}
+}
+
+func (_c *MockProjectConversationReadModel_Execute_Call) Run(run func(ctx context.Context, event outbox.ChatMessageEvent)) *MockProjectConversationReadModel_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 outbox.ChatMessageEvent
+		if args[1] != nil {
+			arg1 = args[1].(outbox.ChatMessageEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectConversationReadModel_Execute_Call) Return(err error) *MockProjectConversationReadModel_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectConversationReadModel_Execute_Call) RunAndReturn(run func(ctx context.Context, event outbox.ChatMessageEvent) error) *MockProjectConversationReadModel_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRelatedConversations creates a new instance of MockRelatedConversations. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRelatedConversations(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRelatedConversations {
+	mock := &MockRelatedConversations{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRelatedConversations is an autogenerated mock type for the RelatedConversations type
+type MockRelatedConversations struct {
+	mock.Mock
+}
+
+type MockRelatedConversations_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRelatedConversations) EXPECT() *MockRelatedConversations_Expecter {
+	return &MockRelatedConversations_Expecter{mock: &_m.Mock}
+}
+
+// Hint provides a mock function for the type MockRelatedConversations
+func (_mock *MockRelatedConversations) Hint(ctx context.Context, conversationID uuid.UUID, userMessage string) (assistant.RelatedConversation, bool, error) {
+	ret := _mock.Called(ctx, conversationID, userMessage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hint")
+	}
+
+	var r0 assistant.RelatedConversation
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (assistant.RelatedConversation, bool, error)); ok {
+		return returnFunc(ctx, conversationID, userMessage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) assistant.RelatedConversation); ok {
+		r0 = returnFunc(ctx, conversationID, userMessage)
+	} else {
+		r0 = ret.Get(0).(assistant.RelatedConversation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) bool); ok {
+		r1 = returnFunc(ctx, conversationID, userMessage)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, string) error); ok {
+		r2 = returnFunc(ctx, conversationID, userMessage)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRelatedConversations_Hint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hint'
+type MockRelatedConversations_Hint_Call struct {
+	*mock.Call
+}
+
+// Hint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - userMessage string
+func (_e *MockRelatedConversations_Expecter) Hint(ctx interface{}, conversationID interface{}, userMessage interface{}) *MockRelatedConversations_Hint_Call {
+	return &MockRelatedConversations_Hint_Call{Call: _e.mock.On("Hint", ctx, conversationID, userMessage)}
+}
+
+func (_c *MockRelatedConversations_Hint_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, userMessage string)) *MockRelatedConversations_Hint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelatedConversations_Hint_Call) Return(relatedConversation assistant.RelatedConversation, b bool, err error) *MockRelatedConversations_Hint_Call {
+	_c.Call.Return(relatedConversation, b, err)
+	return _c
+}
+
+func (_c *MockRelatedConversations_Hint_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, userMessage string) (assistant.RelatedConversation, bool, error)) *MockRelatedConversations_Hint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Index provides a mock function for the type MockRelatedConversations
+func (_mock *MockRelatedConversations) Index(ctx context.Context, conversationID uuid.UUID) error {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Index")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRelatedConversations_Index_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Index'
+type MockRelatedConversations_Index_Call struct {
+	*mock.Call
+}
+
+// Index is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockRelatedConversations_Expecter) Index(ctx interface{}, conversationID interface{}) *MockRelatedConversations_Index_Call {
+	return &MockRelatedConversations_Index_Call{Call: _e.mock.On("Index", ctx, conversationID)}
+}
+
+func (_c *MockRelatedConversations_Index_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockRelatedConversations_Index_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelatedConversations_Index_Call) Return(err error) *MockRelatedConversations_Index_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRelatedConversations_Index_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) error) *MockRelatedConversations_Index_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockRelatedConversations
+func (_mock *MockRelatedConversations) List(ctx context.Context, conversationID uuid.UUID) ([]assistant.RelatedConversation, error) {
+	ret := _mock.Called(ctx, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []assistant.RelatedConversation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]assistant.RelatedConversation, error)); ok {
+		return returnFunc(ctx, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []assistant.RelatedConversation); ok {
+		r0 = returnFunc(ctx, conversationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]assistant.RelatedConversation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRelatedConversations_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRelatedConversations_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+func (_e *MockRelatedConversations_Expecter) List(ctx interface{}, conversationID interface{}) *MockRelatedConversations_List_Call {
+	return &MockRelatedConversations_List_Call{Call: _e.mock.On("List", ctx, conversationID)}
+}
+
+func (_c *MockRelatedConversations_List_Call) Run(run func(ctx context.Context, conversationID uuid.UUID)) *MockRelatedConversations_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelatedConversations_List_Call) Return(relatedConversations []assistant.RelatedConversation, err error) *MockRelatedConversations_List_Call {
+	_c.Call.Return(relatedConversations, err)
+	return _c
+}
+
+func (_c *MockRelatedConversations_List_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID) ([]assistant.RelatedConversation, error)) *MockRelatedConversations_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReplayTurn creates a new instance of MockReplayTurn. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReplayTurn(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReplayTurn {
+	mock := &MockReplayTurn{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReplayTurn is an autogenerated mock type for the ReplayTurn type
+type MockReplayTurn struct {
+	mock.Mock
+}
+
+type MockReplayTurn_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReplayTurn) EXPECT() *MockReplayTurn_Expecter {
+	return &MockReplayTurn_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockReplayTurn
+func (_mock *MockReplayTurn) Execute(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) (TurnReplay, error) {
+	ret := _mock.Called(ctx, conversationID, turnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 TurnReplay
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (TurnReplay, error)); ok {
+		return returnFunc(ctx, conversationID, turnID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) TurnReplay); ok {
+		r0 = returnFunc(ctx, conversationID, turnID)
+	} else {
+		r0 = ret.Get(0).(TurnReplay)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, conversationID, turnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReplayTurn_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockReplayTurn_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - turnID uuid.UUID
+func (_e *MockReplayTurn_Expecter) Execute(ctx interface{}, conversationID interface{}, turnID interface{}) *MockReplayTurn_Execute_Call {
+	return &MockReplayTurn_Execute_Call{Call: _e.mock.On("Execute", ctx, conversationID, turnID)}
+}
+
+func (_c *MockReplayTurn_Execute_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID)) *MockReplayTurn_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReplayTurn_Execute_Call) Return(turnReplay TurnReplay, err error) *MockReplayTurn_Execute_Call {
+	_c.Call.Return(turnReplay, err)
+	return _c
+}
+
+func (_c *MockReplayTurn_Execute_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, turnID uuid.UUID) (TurnReplay, error)) *MockReplayTurn_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShadowEvaluator creates a new instance of MockShadowEvaluator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShadowEvaluator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShadowEvaluator {
+	mock := &MockShadowEvaluator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockShadowEvaluator is an autogenerated mock type for the ShadowEvaluator type
+type MockShadowEvaluator struct {
+	mock.Mock
+}
+
+type MockShadowEvaluator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShadowEvaluator) EXPECT() *MockShadowEvaluator_Expecter {
+	return &MockShadowEvaluator_Expecter{mock: &_m.Mock}
+}
+
+// Submit provides a mock function for the type MockShadowEvaluator
+func (_mock *MockShadowEvaluator) Submit(ctx context.Context, turn ShadowTurn) {
+	_mock.Called(ctx, turn)
+	return
+}
+
+// MockShadowEvaluator_Submit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Submit'
+type MockShadowEvaluator_Submit_Call struct {
+	*mock.Call
+}
+
+// Submit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - turn ShadowTurn
+func (_e *MockShadowEvaluator_Expecter) Submit(ctx interface{}, turn interface{}) *MockShadowEvaluator_Submit_Call {
+	return &MockShadowEvaluator_Submit_Call{Call: _e.mock.On("Submit", ctx, turn)}
+}
+
+func (_c *MockShadowEvaluator_Submit_Call) Run(run func(ctx context.Context, turn ShadowTurn)) *MockShadowEvaluator_Submit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ShadowTurn
+		if args[1] != nil {
+			arg1 = args[1].(ShadowTurn)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockShadowEvaluator_Submit_Call) Return() *MockShadowEvaluator_Submit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockShadowEvaluator_Submit_Call) RunAndReturn(run func(ctx context.Context, turn ShadowTurn)) *MockShadowEvaluator_Submit_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockStreamChat creates a new instance of MockStreamChat. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamChat(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamChat {
+	mock := &MockStreamChat{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStreamChat is an autogenerated mock type for the StreamChat type
+type MockStreamChat struct {
+	mock.Mock
+}
+
+type MockStreamChat_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreamChat) EXPECT() *MockStreamChat_Expecter {
+	return &MockStreamChat_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockStreamChat
+func (_mock *MockStreamChat) Execute(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, userMessage, model, onEvent, opts)
 	} else {
-		r2 = ret.Get(2).(bool)
+		tmpRet = _mock.Called(ctx, userMessage, model, onEvent)
 	}
-	if returnFunc, ok := ret.Get(3).(func(context.Context, int, int) error); ok {
-		r3 = returnFunc(ctx, page, pageSize)
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, assistant.EventCallback, ...StreamChatOption) error); ok {
+		r0 = returnFunc(ctx, userMessage, model, onEvent, opts...)
 	} else {
-		r3 = ret.Error(3)
+		r0 = ret.Error(0)
 	}
-	return r0, r1, r2, r3
+	return r0
 }
 
-// MockListConversations_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockListConversations_Query_Call struct {
+// MockStreamChat_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockStreamChat_Execute_Call struct {
 	*mock.Call
 }
 
-// Query is a helper method to define mock.On call
+// Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-//   - pageSize int
-func (_e *MockListConversations_Expecter) Query(ctx interface{}, page interface{}, pageSize interface{}) *MockListConversations_Query_Call {
-	return &MockListConversations_Query_Call{Call: _e.mock.On("Query", ctx, page, pageSize)}
+//   - userMessage string
+//   - model string
+//   - onEvent assistant.EventCallback
+//   - opts ...StreamChatOption
+func (_e *MockStreamChat_Expecter) Execute(ctx interface{}, userMessage interface{}, model interface{}, onEvent interface{}, opts ...interface{}) *MockStreamChat_Execute_Call {
+	return &MockStreamChat_Execute_Call{Call: _e.mock.On("Execute",
+		append([]interface{}{ctx, userMessage, model, onEvent}, opts...)...)}
 }
 
-func (_c *MockListConversations_Query_Call) Run(run func(ctx context.Context, page int, pageSize int)) *MockListConversations_Query_Call {
+func (_c *MockStreamChat_Execute_Call) Run(run func(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption)) *MockStreamChat_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
+		}
+		var arg3 assistant.EventCallback
+		if args[3] != nil {
+			arg3 = args[3].(assistant.EventCallback)
+		}
+		var arg4 []StreamChatOption
+		var variadicArgs []StreamChatOption
+		if len(args) > 4 {
+			variadicArgs = args[4].([]StreamChatOption)
 		}
+		arg4 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockListConversations_Query_Call) Return(conversations []assistant.Conversation, uUIDToInt64 map[uuid.UUID]int64, b bool, err error) *MockListConversations_Query_Call {
-	_c.Call.Return(conversations, uUIDToInt64, b, err)
+func (_c *MockStreamChat_Execute_Call) Return(err error) *MockStreamChat_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStreamChat_Execute_Call) RunAndReturn(run func(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error) *MockStreamChat_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSubmitActionApproval creates a new instance of MockSubmitActionApproval. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubmitActionApproval(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubmitActionApproval {
+	mock := &MockSubmitActionApproval{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSubmitActionApproval is an autogenerated mock type for the SubmitActionApproval type
+type MockSubmitActionApproval struct {
+	mock.Mock
+}
+
+type MockSubmitActionApproval_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubmitActionApproval) EXPECT() *MockSubmitActionApproval_Expecter {
+	return &MockSubmitActionApproval_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockSubmitActionApproval
+func (_mock *MockSubmitActionApproval) Execute(ctx context.Context, input SubmitActionApprovalInput) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SubmitActionApprovalInput) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubmitActionApproval_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSubmitActionApproval_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input SubmitActionApprovalInput
+func (_e *MockSubmitActionApproval_Expecter) Execute(ctx interface{}, input interface{}) *MockSubmitActionApproval_Execute_Call {
+	return &MockSubmitActionApproval_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+}
+
+func (_c *MockSubmitActionApproval_Execute_Call) Run(run func(ctx context.Context, input SubmitActionApprovalInput)) *MockSubmitActionApproval_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 SubmitActionApprovalInput
+		if args[1] != nil {
+			arg1 = args[1].(SubmitActionApprovalInput)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubmitActionApproval_Execute_Call) Return(err error) *MockSubmitActionApproval_Execute_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockListConversations_Query_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int) ([]assistant.Conversation, map[uuid.UUID]int64, bool, error)) *MockListConversations_Query_Call {
+func (_c *MockSubmitActionApproval_Execute_Call) RunAndReturn(run func(ctx context.Context, input SubmitActionApprovalInput) error) *MockSubmitActionApproval_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockStreamChat creates a new instance of MockStreamChat. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSubmitMessageFeedback creates a new instance of MockSubmitMessageFeedback. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockStreamChat(t interface {
+func NewMockSubmitMessageFeedback(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockStreamChat {
-	mock := &MockStreamChat{}
+}) *MockSubmitMessageFeedback {
+	mock := &MockSubmitMessageFeedback{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1015,110 +3360,89 @@ func NewMockStreamChat(t interface {
 	return mock
 }
 
-// MockStreamChat is an autogenerated mock type for the StreamChat type
-type MockStreamChat struct {
+// MockSubmitMessageFeedback is an autogenerated mock type for the SubmitMessageFeedback type
+type MockSubmitMessageFeedback struct {
 	mock.Mock
 }
 
-type MockStreamChat_Expecter struct {
+type MockSubmitMessageFeedback_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockStreamChat) EXPECT() *MockStreamChat_Expecter {
-	return &MockStreamChat_Expecter{mock: &_m.Mock}
+func (_m *MockSubmitMessageFeedback) EXPECT() *MockSubmitMessageFeedback_Expecter {
+	return &MockSubmitMessageFeedback_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function for the type MockStreamChat
-func (_mock *MockStreamChat) Execute(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error {
-	var tmpRet mock.Arguments
-	if len(opts) > 0 {
-		tmpRet = _mock.Called(ctx, userMessage, model, onEvent, opts)
-	} else {
-		tmpRet = _mock.Called(ctx, userMessage, model, onEvent)
-	}
-	ret := tmpRet
+// Execute provides a mock function for the type MockSubmitMessageFeedback
+func (_mock *MockSubmitMessageFeedback) Execute(ctx context.Context, messageID uuid.UUID, score int) error {
+	ret := _mock.Called(ctx, messageID, score)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, assistant.EventCallback, ...StreamChatOption) error); ok {
-		r0 = returnFunc(ctx, userMessage, model, onEvent, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = returnFunc(ctx, messageID, score)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStreamChat_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockStreamChat_Execute_Call struct {
+// MockSubmitMessageFeedback_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockSubmitMessageFeedback_Execute_Call struct {
 	*mock.Call
 }
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userMessage string
-//   - model string
-//   - onEvent assistant.EventCallback
-//   - opts ...StreamChatOption
-func (_e *MockStreamChat_Expecter) Execute(ctx interface{}, userMessage interface{}, model interface{}, onEvent interface{}, opts ...interface{}) *MockStreamChat_Execute_Call {
-	return &MockStreamChat_Execute_Call{Call: _e.mock.On("Execute",
-		append([]interface{}{ctx, userMessage, model, onEvent}, opts...)...)}
+//   - messageID uuid.UUID
+//   - score int
+func (_e *MockSubmitMessageFeedback_Expecter) Execute(ctx interface{}, messageID interface{}, score interface{}) *MockSubmitMessageFeedback_Execute_Call {
+	return &MockSubmitMessageFeedback_Execute_Call{Call: _e.mock.On("Execute", ctx, messageID, score)}
 }
 
-func (_c *MockStreamChat_Execute_Call) Run(run func(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption)) *MockStreamChat_Execute_Call {
+func (_c *MockSubmitMessageFeedback_Execute_Call) Run(run func(ctx context.Context, messageID uuid.UUID, score int)) *MockSubmitMessageFeedback_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 string
+		var arg2 int
 		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 assistant.EventCallback
-		if args[3] != nil {
-			arg3 = args[3].(assistant.EventCallback)
-		}
-		var arg4 []StreamChatOption
-		var variadicArgs []StreamChatOption
-		if len(args) > 4 {
-			variadicArgs = args[4].([]StreamChatOption)
+			arg2 = args[2].(int)
 		}
-		arg4 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStreamChat_Execute_Call) Return(err error) *MockStreamChat_Execute_Call {
+func (_c *MockSubmitMessageFeedback_Execute_Call) Return(err error) *MockSubmitMessageFeedback_Execute_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStreamChat_Execute_Call) RunAndReturn(run func(ctx context.Context, userMessage string, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error) *MockStreamChat_Execute_Call {
+func (_c *MockSubmitMessageFeedback_Execute_Call) RunAndReturn(run func(ctx context.Context, messageID uuid.UUID, score int) error) *MockSubmitMessageFeedback_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSubmitActionApproval creates a new instance of MockSubmitActionApproval. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockTopicShiftDetector creates a new instance of MockTopicShiftDetector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSubmitActionApproval(t interface {
+func NewMockTopicShiftDetector(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSubmitActionApproval {
-	mock := &MockSubmitActionApproval{}
+}) *MockTopicShiftDetector {
+	mock := &MockTopicShiftDetector{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1126,72 +3450,87 @@ func NewMockSubmitActionApproval(t interface {
 	return mock
 }
 
-// MockSubmitActionApproval is an autogenerated mock type for the SubmitActionApproval type
-type MockSubmitActionApproval struct {
+// MockTopicShiftDetector is an autogenerated mock type for the TopicShiftDetector type
+type MockTopicShiftDetector struct {
 	mock.Mock
 }
 
-type MockSubmitActionApproval_Expecter struct {
+type MockTopicShiftDetector_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSubmitActionApproval) EXPECT() *MockSubmitActionApproval_Expecter {
-	return &MockSubmitActionApproval_Expecter{mock: &_m.Mock}
+func (_m *MockTopicShiftDetector) EXPECT() *MockTopicShiftDetector_Expecter {
+	return &MockTopicShiftDetector_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function for the type MockSubmitActionApproval
-func (_mock *MockSubmitActionApproval) Execute(ctx context.Context, input SubmitActionApprovalInput) error {
-	ret := _mock.Called(ctx, input)
+// Detect provides a mock function for the type MockTopicShiftDetector
+func (_mock *MockTopicShiftDetector) Detect(ctx context.Context, conversationID uuid.UUID, userMessage string) (TopicShift, error) {
+	ret := _mock.Called(ctx, conversationID, userMessage)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Execute")
+		panic("no return value specified for Detect")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, SubmitActionApprovalInput) error); ok {
-		r0 = returnFunc(ctx, input)
+	var r0 TopicShift
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (TopicShift, error)); ok {
+		return returnFunc(ctx, conversationID, userMessage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) TopicShift); ok {
+		r0 = returnFunc(ctx, conversationID, userMessage)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(TopicShift)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, conversationID, userMessage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSubmitActionApproval_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockSubmitActionApproval_Execute_Call struct {
+// MockTopicShiftDetector_Detect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detect'
+type MockTopicShiftDetector_Detect_Call struct {
 	*mock.Call
 }
 
-// Execute is a helper method to define mock.On call
+// Detect is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input SubmitActionApprovalInput
-func (_e *MockSubmitActionApproval_Expecter) Execute(ctx interface{}, input interface{}) *MockSubmitActionApproval_Execute_Call {
-	return &MockSubmitActionApproval_Execute_Call{Call: _e.mock.On("Execute", ctx, input)}
+//   - conversationID uuid.UUID
+//   - userMessage string
+func (_e *MockTopicShiftDetector_Expecter) Detect(ctx interface{}, conversationID interface{}, userMessage interface{}) *MockTopicShiftDetector_Detect_Call {
+	return &MockTopicShiftDetector_Detect_Call{Call: _e.mock.On("Detect", ctx, conversationID, userMessage)}
 }
 
-func (_c *MockSubmitActionApproval_Execute_Call) Run(run func(ctx context.Context, input SubmitActionApprovalInput)) *MockSubmitActionApproval_Execute_Call {
+func (_c *MockTopicShiftDetector_Detect_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, userMessage string)) *MockTopicShiftDetector_Detect_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 SubmitActionApprovalInput
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(SubmitActionApprovalInput)
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSubmitActionApproval_Execute_Call) Return(err error) *MockSubmitActionApproval_Execute_Call {
-	_c.Call.Return(err)
+func (_c *MockTopicShiftDetector_Detect_Call) Return(topicShift TopicShift, err error) *MockTopicShiftDetector_Detect_Call {
+	_c.Call.Return(topicShift, err)
 	return _c
 }
 
-func (_c *MockSubmitActionApproval_Execute_Call) RunAndReturn(run func(ctx context.Context, input SubmitActionApprovalInput) error) *MockSubmitActionApproval_Execute_Call {
+func (_c *MockTopicShiftDetector_Detect_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, userMessage string) (TopicShift, error)) *MockTopicShiftDetector_Detect_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1353,6 +3692,50 @@ func (_c *MockTurnState_AccumulateTokenUsage_Call) RunAndReturn(run func(usage a
 	return _c
 }
 
+// ActionLoopDetected provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) ActionLoopDetected() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActionLoopDetected")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockTurnState_ActionLoopDetected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActionLoopDetected'
+type MockTurnState_ActionLoopDetected_Call struct {
+	*mock.Call
+}
+
+// ActionLoopDetected is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) ActionLoopDetected() *MockTurnState_ActionLoopDetected_Call {
+	return &MockTurnState_ActionLoopDetected_Call{Call: _e.mock.On("ActionLoopDetected")}
+}
+
+func (_c *MockTurnState_ActionLoopDetected_Call) Run(run func()) *MockTurnState_ActionLoopDetected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTurnState_ActionLoopDetected_Call) Return(b bool) *MockTurnState_ActionLoopDetected_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockTurnState_ActionLoopDetected_Call) RunAndReturn(run func() bool) *MockTurnState_ActionLoopDetected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AppendAssistantContent provides a mock function for the type MockTurnState
 func (_mock *MockTurnState) AppendAssistantContent(text string) {
 	_mock.Called(text)
@@ -1441,52 +3824,6 @@ func (_c *MockTurnState_AppendRequestMessages_Call) RunAndReturn(run func(messag
 	return _c
 }
 
-// ApplyRecoveryPolicy provides a mock function for the type MockTurnState
-func (_mock *MockTurnState) ApplyRecoveryPolicy(runErr error, maxMessages int) {
-	_mock.Called(runErr, maxMessages)
-	return
-}
-
-// MockTurnState_ApplyRecoveryPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyRecoveryPolicy'
-type MockTurnState_ApplyRecoveryPolicy_Call struct {
-	*mock.Call
-}
-
-// ApplyRecoveryPolicy is a helper method to define mock.On call
-//   - runErr error
-//   - maxMessages int
-func (_e *MockTurnState_Expecter) ApplyRecoveryPolicy(runErr interface{}, maxMessages interface{}) *MockTurnState_ApplyRecoveryPolicy_Call {
-	return &MockTurnState_ApplyRecoveryPolicy_Call{Call: _e.mock.On("ApplyRecoveryPolicy", runErr, maxMessages)}
-}
-
-func (_c *MockTurnState_ApplyRecoveryPolicy_Call) Run(run func(runErr error, maxMessages int)) *MockTurnState_ApplyRecoveryPolicy_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 error
-		if args[0] != nil {
-			arg0 = args[0].(error)
-		}
-		var arg1 int
-		if args[1] != nil {
-			arg1 = args[1].(int)
-		}
-		run(
-			arg0,
-			arg1,
-		)
-	})
-	return _c
-}
-
-func (_c *MockTurnState_ApplyRecoveryPolicy_Call) Return() *MockTurnState_ApplyRecoveryPolicy_Call {
-	_c.Call.Return()
-	return _c
-}
-
-func (_c *MockTurnState_ApplyRecoveryPolicy_Call) RunAndReturn(run func(runErr error, maxMessages int)) *MockTurnState_ApplyRecoveryPolicy_Call {
-	_c.Run(run)
-	return _c
-}
-
 // AssistantContent provides a mock function for the type MockTurnState
 func (_mock *MockTurnState) AssistantContent() string {
 	ret := _mock.Called()
@@ -1545,76 +3882,182 @@ func (_mock *MockTurnState) Conversation() assistant.Conversation {
 	} else {
 		r0 = ret.Get(0).(assistant.Conversation)
 	}
-	return r0
+	return r0
+}
+
+// MockTurnState_Conversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Conversation'
+type MockTurnState_Conversation_Call struct {
+	*mock.Call
+}
+
+// Conversation is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) Conversation() *MockTurnState_Conversation_Call {
+	return &MockTurnState_Conversation_Call{Call: _e.mock.On("Conversation")}
+}
+
+func (_c *MockTurnState_Conversation_Call) Run(run func()) *MockTurnState_Conversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTurnState_Conversation_Call) Return(conversation assistant.Conversation) *MockTurnState_Conversation_Call {
+	_c.Call.Return(conversation)
+	return _c
+}
+
+func (_c *MockTurnState_Conversation_Call) RunAndReturn(run func() assistant.Conversation) *MockTurnState_Conversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConversationCreated provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) ConversationCreated() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConversationCreated")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockTurnState_ConversationCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConversationCreated'
+type MockTurnState_ConversationCreated_Call struct {
+	*mock.Call
+}
+
+// ConversationCreated is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) ConversationCreated() *MockTurnState_ConversationCreated_Call {
+	return &MockTurnState_ConversationCreated_Call{Call: _e.mock.On("ConversationCreated")}
+}
+
+func (_c *MockTurnState_ConversationCreated_Call) Run(run func()) *MockTurnState_ConversationCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTurnState_ConversationCreated_Call) Return(b bool) *MockTurnState_ConversationCreated_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockTurnState_ConversationCreated_Call) RunAndReturn(run func() bool) *MockTurnState_ConversationCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecutedActionResult provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) ExecutedActionResult(actionCallID string) (assistant.Message, bool) {
+	ret := _mock.Called(actionCallID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecutedActionResult")
+	}
+
+	var r0 assistant.Message
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(string) (assistant.Message, bool)); ok {
+		return returnFunc(actionCallID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) assistant.Message); ok {
+		r0 = returnFunc(actionCallID)
+	} else {
+		r0 = ret.Get(0).(assistant.Message)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(actionCallID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
 }
 
-// MockTurnState_Conversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Conversation'
-type MockTurnState_Conversation_Call struct {
+// MockTurnState_ExecutedActionResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecutedActionResult'
+type MockTurnState_ExecutedActionResult_Call struct {
 	*mock.Call
 }
 
-// Conversation is a helper method to define mock.On call
-func (_e *MockTurnState_Expecter) Conversation() *MockTurnState_Conversation_Call {
-	return &MockTurnState_Conversation_Call{Call: _e.mock.On("Conversation")}
+// ExecutedActionResult is a helper method to define mock.On call
+//   - actionCallID string
+func (_e *MockTurnState_Expecter) ExecutedActionResult(actionCallID interface{}) *MockTurnState_ExecutedActionResult_Call {
+	return &MockTurnState_ExecutedActionResult_Call{Call: _e.mock.On("ExecutedActionResult", actionCallID)}
 }
 
-func (_c *MockTurnState_Conversation_Call) Run(run func()) *MockTurnState_Conversation_Call {
+func (_c *MockTurnState_ExecutedActionResult_Call) Run(run func(actionCallID string)) *MockTurnState_ExecutedActionResult_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockTurnState_Conversation_Call) Return(conversation assistant.Conversation) *MockTurnState_Conversation_Call {
-	_c.Call.Return(conversation)
+func (_c *MockTurnState_ExecutedActionResult_Call) Return(message assistant.Message, b bool) *MockTurnState_ExecutedActionResult_Call {
+	_c.Call.Return(message, b)
 	return _c
 }
 
-func (_c *MockTurnState_Conversation_Call) RunAndReturn(run func() assistant.Conversation) *MockTurnState_Conversation_Call {
+func (_c *MockTurnState_ExecutedActionResult_Call) RunAndReturn(run func(actionCallID string) (assistant.Message, bool)) *MockTurnState_ExecutedActionResult_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ConversationCreated provides a mock function for the type MockTurnState
-func (_mock *MockTurnState) ConversationCreated() bool {
+// Experiment provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) Experiment() *assistant.ExperimentAssignment {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConversationCreated")
+		panic("no return value specified for Experiment")
 	}
 
-	var r0 bool
-	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+	var r0 *assistant.ExperimentAssignment
+	if returnFunc, ok := ret.Get(0).(func() *assistant.ExperimentAssignment); ok {
 		r0 = returnFunc()
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assistant.ExperimentAssignment)
+		}
 	}
 	return r0
 }
 
-// MockTurnState_ConversationCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConversationCreated'
-type MockTurnState_ConversationCreated_Call struct {
+// MockTurnState_Experiment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Experiment'
+type MockTurnState_Experiment_Call struct {
 	*mock.Call
 }
 
-// ConversationCreated is a helper method to define mock.On call
-func (_e *MockTurnState_Expecter) ConversationCreated() *MockTurnState_ConversationCreated_Call {
-	return &MockTurnState_ConversationCreated_Call{Call: _e.mock.On("ConversationCreated")}
+// Experiment is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) Experiment() *MockTurnState_Experiment_Call {
+	return &MockTurnState_Experiment_Call{Call: _e.mock.On("Experiment")}
 }
 
-func (_c *MockTurnState_ConversationCreated_Call) Run(run func()) *MockTurnState_ConversationCreated_Call {
+func (_c *MockTurnState_Experiment_Call) Run(run func()) *MockTurnState_Experiment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockTurnState_ConversationCreated_Call) Return(b bool) *MockTurnState_ConversationCreated_Call {
-	_c.Call.Return(b)
+func (_c *MockTurnState_Experiment_Call) Return(experimentAssignment *assistant.ExperimentAssignment) *MockTurnState_Experiment_Call {
+	_c.Call.Return(experimentAssignment)
 	return _c
 }
 
-func (_c *MockTurnState_ConversationCreated_Call) RunAndReturn(run func() bool) *MockTurnState_ConversationCreated_Call {
+func (_c *MockTurnState_Experiment_Call) RunAndReturn(run func() *assistant.ExperimentAssignment) *MockTurnState_Experiment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1720,6 +4163,50 @@ func (_c *MockTurnState_HasExceededRepeatedActionCalls_Call) RunAndReturn(run fu
 	return _c
 }
 
+// Latency provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) Latency() assistant.TurnLatency {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Latency")
+	}
+
+	var r0 assistant.TurnLatency
+	if returnFunc, ok := ret.Get(0).(func() assistant.TurnLatency); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(assistant.TurnLatency)
+	}
+	return r0
+}
+
+// MockTurnState_Latency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Latency'
+type MockTurnState_Latency_Call struct {
+	*mock.Call
+}
+
+// Latency is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) Latency() *MockTurnState_Latency_Call {
+	return &MockTurnState_Latency_Call{Call: _e.mock.On("Latency")}
+}
+
+func (_c *MockTurnState_Latency_Call) Run(run func()) *MockTurnState_Latency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTurnState_Latency_Call) Return(turnLatency assistant.TurnLatency) *MockTurnState_Latency_Call {
+	_c.Call.Return(turnLatency)
+	return _c
+}
+
+func (_c *MockTurnState_Latency_Call) RunAndReturn(run func() assistant.TurnLatency) *MockTurnState_Latency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Model provides a mock function for the type MockTurnState
 func (_mock *MockTurnState) Model() string {
 	ret := _mock.Called()
@@ -1808,6 +4295,190 @@ func (_c *MockTurnState_NextTurnSequence_Call) RunAndReturn(run func() int64) *M
 	return _c
 }
 
+// PrepareFallbackResponseRequest provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) PrepareFallbackResponseRequest(runErr error, maxMessages int) {
+	_mock.Called(runErr, maxMessages)
+	return
+}
+
+// MockTurnState_PrepareFallbackResponseRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrepareFallbackResponseRequest'
+type MockTurnState_PrepareFallbackResponseRequest_Call struct {
+	*mock.Call
+}
+
+// PrepareFallbackResponseRequest is a helper method to define mock.On call
+//   - runErr error
+//   - maxMessages int
+func (_e *MockTurnState_Expecter) PrepareFallbackResponseRequest(runErr interface{}, maxMessages interface{}) *MockTurnState_PrepareFallbackResponseRequest_Call {
+	return &MockTurnState_PrepareFallbackResponseRequest_Call{Call: _e.mock.On("PrepareFallbackResponseRequest", runErr, maxMessages)}
+}
+
+func (_c *MockTurnState_PrepareFallbackResponseRequest_Call) Run(run func(runErr error, maxMessages int)) *MockTurnState_PrepareFallbackResponseRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 error
+		if args[0] != nil {
+			arg0 = args[0].(error)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTurnState_PrepareFallbackResponseRequest_Call) Return() *MockTurnState_PrepareFallbackResponseRequest_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTurnState_PrepareFallbackResponseRequest_Call) RunAndReturn(run func(runErr error, maxMessages int)) *MockTurnState_PrepareFallbackResponseRequest_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RecordActionLatency provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) RecordActionLatency(actionCall assistant.ActionCall, d time.Duration) {
+	_mock.Called(actionCall, d)
+	return
+}
+
+// MockTurnState_RecordActionLatency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordActionLatency'
+type MockTurnState_RecordActionLatency_Call struct {
+	*mock.Call
+}
+
+// RecordActionLatency is a helper method to define mock.On call
+//   - actionCall assistant.ActionCall
+//   - d time.Duration
+func (_e *MockTurnState_Expecter) RecordActionLatency(actionCall interface{}, d interface{}) *MockTurnState_RecordActionLatency_Call {
+	return &MockTurnState_RecordActionLatency_Call{Call: _e.mock.On("RecordActionLatency", actionCall, d)}
+}
+
+func (_c *MockTurnState_RecordActionLatency_Call) Run(run func(actionCall assistant.ActionCall, d time.Duration)) *MockTurnState_RecordActionLatency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 assistant.ActionCall
+		if args[0] != nil {
+			arg0 = args[0].(assistant.ActionCall)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTurnState_RecordActionLatency_Call) Return() *MockTurnState_RecordActionLatency_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTurnState_RecordActionLatency_Call) RunAndReturn(run func(actionCall assistant.ActionCall, d time.Duration)) *MockTurnState_RecordActionLatency_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RecordExecutedAction provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) RecordExecutedAction(actionCallID string, result assistant.Message) {
+	_mock.Called(actionCallID, result)
+	return
+}
+
+// MockTurnState_RecordExecutedAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordExecutedAction'
+type MockTurnState_RecordExecutedAction_Call struct {
+	*mock.Call
+}
+
+// RecordExecutedAction is a helper method to define mock.On call
+//   - actionCallID string
+//   - result assistant.Message
+func (_e *MockTurnState_Expecter) RecordExecutedAction(actionCallID interface{}, result interface{}) *MockTurnState_RecordExecutedAction_Call {
+	return &MockTurnState_RecordExecutedAction_Call{Call: _e.mock.On("RecordExecutedAction", actionCallID, result)}
+}
+
+func (_c *MockTurnState_RecordExecutedAction_Call) Run(run func(actionCallID string, result assistant.Message)) *MockTurnState_RecordExecutedAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 assistant.Message
+		if args[1] != nil {
+			arg1 = args[1].(assistant.Message)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTurnState_RecordExecutedAction_Call) Return() *MockTurnState_RecordExecutedAction_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTurnState_RecordExecutedAction_Call) RunAndReturn(run func(actionCallID string, result assistant.Message)) *MockTurnState_RecordExecutedAction_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RecordLatency provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) RecordLatency(phase TurnPhase, d time.Duration) {
+	_mock.Called(phase, d)
+	return
+}
+
+// MockTurnState_RecordLatency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordLatency'
+type MockTurnState_RecordLatency_Call struct {
+	*mock.Call
+}
+
+// RecordLatency is a helper method to define mock.On call
+//   - phase TurnPhase
+//   - d time.Duration
+func (_e *MockTurnState_Expecter) RecordLatency(phase interface{}, d interface{}) *MockTurnState_RecordLatency_Call {
+	return &MockTurnState_RecordLatency_Call{Call: _e.mock.On("RecordLatency", phase, d)}
+}
+
+func (_c *MockTurnState_RecordLatency_Call) Run(run func(phase TurnPhase, d time.Duration)) *MockTurnState_RecordLatency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 TurnPhase
+		if args[0] != nil {
+			arg0 = args[0].(TurnPhase)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTurnState_RecordLatency_Call) Return() *MockTurnState_RecordLatency_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTurnState_RecordLatency_Call) RunAndReturn(run func(phase TurnPhase, d time.Duration)) *MockTurnState_RecordLatency_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Request provides a mock function for the type MockTurnState
 func (_mock *MockTurnState) Request() assistant.TurnRequest {
 	ret := _mock.Called()
@@ -1852,6 +4523,52 @@ func (_c *MockTurnState_Request_Call) RunAndReturn(run func() assistant.TurnRequ
 	return _c
 }
 
+// Seed provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) Seed() *int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Seed")
+	}
+
+	var r0 *int64
+	if returnFunc, ok := ret.Get(0).(func() *int64); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*int64)
+		}
+	}
+	return r0
+}
+
+// MockTurnState_Seed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Seed'
+type MockTurnState_Seed_Call struct {
+	*mock.Call
+}
+
+// Seed is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) Seed() *MockTurnState_Seed_Call {
+	return &MockTurnState_Seed_Call{Call: _e.mock.On("Seed")}
+}
+
+func (_c *MockTurnState_Seed_Call) Run(run func()) *MockTurnState_Seed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTurnState_Seed_Call) Return(n *int64) *MockTurnState_Seed_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockTurnState_Seed_Call) RunAndReturn(run func() *int64) *MockTurnState_Seed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectedSkills provides a mock function for the type MockTurnState
 func (_mock *MockTurnState) SelectedSkills() []assistant.SelectedSkill {
 	ret := _mock.Called()
@@ -1942,6 +4659,50 @@ func (_c *MockTurnState_TokenUsage_Call) RunAndReturn(run func() assistant.Usage
 	return _c
 }
 
+// TruncateToCurrentTurn provides a mock function for the type MockTurnState
+func (_mock *MockTurnState) TruncateToCurrentTurn() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateToCurrentTurn")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockTurnState_TruncateToCurrentTurn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateToCurrentTurn'
+type MockTurnState_TruncateToCurrentTurn_Call struct {
+	*mock.Call
+}
+
+// TruncateToCurrentTurn is a helper method to define mock.On call
+func (_e *MockTurnState_Expecter) TruncateToCurrentTurn() *MockTurnState_TruncateToCurrentTurn_Call {
+	return &MockTurnState_TruncateToCurrentTurn_Call{Call: _e.mock.On("TruncateToCurrentTurn")}
+}
+
+func (_c *MockTurnState_TruncateToCurrentTurn_Call) Run(run func()) *MockTurnState_TruncateToCurrentTurn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTurnState_TruncateToCurrentTurn_Call) Return(n int) *MockTurnState_TruncateToCurrentTurn_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockTurnState_TruncateToCurrentTurn_Call) RunAndReturn(run func() int) *MockTurnState_TruncateToCurrentTurn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TurnID provides a mock function for the type MockTurnState
 func (_mock *MockTurnState) TurnID() uuid.UUID {
 	ret := _mock.Called()
@@ -2111,8 +4872,8 @@ func (_m *MockUpdateConversation) EXPECT() *MockUpdateConversation_Expecter {
 }
 
 // Execute provides a mock function for the type MockUpdateConversation
-func (_mock *MockUpdateConversation) Execute(ctx context.Context, conversationID uuid.UUID, title string) (assistant.Conversation, error) {
-	ret := _mock.Called(ctx, conversationID, title)
+func (_mock *MockUpdateConversation) Execute(ctx context.Context, conversationID uuid.UUID, update ConversationUpdate) (assistant.Conversation, error) {
+	ret := _mock.Called(ctx, conversationID, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -2120,16 +4881,16 @@ func (_mock *MockUpdateConversation) Execute(ctx context.Context, conversationID
 
 	var r0 assistant.Conversation
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (assistant.Conversation, error)); ok {
-		return returnFunc(ctx, conversationID, title)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, ConversationUpdate) (assistant.Conversation, error)); ok {
+		return returnFunc(ctx, conversationID, update)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) assistant.Conversation); ok {
-		r0 = returnFunc(ctx, conversationID, title)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, ConversationUpdate) assistant.Conversation); ok {
+		r0 = returnFunc(ctx, conversationID, update)
 	} else {
 		r0 = ret.Get(0).(assistant.Conversation)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = returnFunc(ctx, conversationID, title)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, ConversationUpdate) error); ok {
+		r1 = returnFunc(ctx, conversationID, update)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2144,12 +4905,12 @@ type MockUpdateConversation_Execute_Call struct {
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
 //   - conversationID uuid.UUID
-//   - title string
-func (_e *MockUpdateConversation_Expecter) Execute(ctx interface{}, conversationID interface{}, title interface{}) *MockUpdateConversation_Execute_Call {
-	return &MockUpdateConversation_Execute_Call{Call: _e.mock.On("Execute", ctx, conversationID, title)}
+//   - update ConversationUpdate
+func (_e *MockUpdateConversation_Expecter) Execute(ctx interface{}, conversationID interface{}, update interface{}) *MockUpdateConversation_Execute_Call {
+	return &MockUpdateConversation_Execute_Call{Call: _e.mock.On("Execute", ctx, conversationID, update)}
 }
 
-func (_c *MockUpdateConversation_Execute_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, title string)) *MockUpdateConversation_Execute_Call {
+func (_c *MockUpdateConversation_Execute_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, update ConversationUpdate)) *MockUpdateConversation_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2159,9 +4920,9 @@ func (_c *MockUpdateConversation_Execute_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 string
+		var arg2 ConversationUpdate
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(ConversationUpdate)
 		}
 		run(
 			arg0,
@@ -2177,7 +4938,7 @@ func (_c *MockUpdateConversation_Execute_Call) Return(conversation assistant.Con
 	return _c
 }
 
-func (_c *MockUpdateConversation_Execute_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, title string) (assistant.Conversation, error)) *MockUpdateConversation_Execute_Call {
+func (_c *MockUpdateConversation_Execute_Call) RunAndReturn(run func(ctx context.Context, conversationID uuid.UUID, update ConversationUpdate) (assistant.Conversation, error)) *MockUpdateConversation_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PostSystemMessage appends the messages of background jobs, such as reminders and board summaries, to an
+// existing conversation, so the chat thread is the single timeline of the assistant activity.
+type PostSystemMessage interface {
+	// Execute posts the message into its conversation as an assistant message and returns it.
+	Execute(ctx context.Context, message SystemMessage) (assistant.ChatMessage, error)
+}
+
+// SystemMessage is a message a background job posts into a conversation.
+type SystemMessage struct {
+	ConversationID uuid.UUID
+	Content        string
+	// Source names the background job posting the message, such as reminders, for tracing.
+	Source string
+}
+
+// PostSystemMessageImpl implements PostSystemMessage.
+type PostSystemMessageImpl struct {
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewPostSystemMessageImpl creates a PostSystemMessageImpl.
+func NewPostSystemMessageImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider) PostSystemMessageImpl {
+	return PostSystemMessageImpl{
+		uow:          uow,
+		timeProvider: timeProvider,
+	}
+}
+
+// Execute implements PostSystemMessage.
+// The message opens a turn of its own and is published as a background chat event, so readers see it as unread
+// and the read model and badges of the conversation follow it.
+func (uc PostSystemMessageImpl) Execute(ctx context.Context, systemMessage SystemMessage) (assistant.ChatMessage, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("conversation_id", systemMessage.ConversationID.String()),
+		attribute.String("source", systemMessage.Source),
+	))
+	defer span.End()
+
+	content := strings.TrimSpace(systemMessage.Content)
+	if content == "" {
+		err := core.NewValidationErr("content cannot be empty")
+		telemetry.IsErrorRecorded(span, err)
+		return assistant.ChatMessage{}, err
+	}
+
+	var message assistant.ChatMessage
+	err := uc.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		conversation, found, err := scope.Conversation().GetConversation(uowCtx, systemMessage.ConversationID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", systemMessage.ConversationID))
+		}
+
+		now := uc.timeProvider.Now()
+		message = assistant.NewBackgroundMessage(conversation.ID, content, "", now)
+		if err := scope.ChatMessage().CreateChatMessages(uowCtx, []assistant.ChatMessage{message}); err != nil {
+			return err
+		}
+		if err := scope.Outbox().CreateChatEvent(uowCtx, outbox.NewBackgroundChatMessageEvent(message)); err != nil {
+			return err
+		}
+
+		conversation.LastMessageAt = &now
+		conversation.UpdatedAt = now
+		return scope.Conversation().UpdateConversation(uowCtx, conversation)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.ChatMessage{}, err
+	}
+	return message, nil
+}
@@ -0,0 +1,128 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostSystemMessageImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdAt := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	conversation := assistant.Conversation{ID: conversationID, Title: "Planning", CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	type mocks struct {
+		uow              *transaction.MockUnitOfWork
+		scope            *transaction.MockScope
+		conversationRepo *assistant.MockConversationRepository
+		chatRepo         *assistant.MockChatMessageRepository
+		outboxRepo       *outbox.MockRepository
+		timeProvider     *core.MockCurrentTimeProvider
+	}
+
+	expectUow := func(m mocks) {
+		m.uow.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+				return fn(ctx, m.scope)
+			}).
+			Once()
+		m.scope.EXPECT().Conversation().Return(m.conversationRepo)
+	}
+
+	tests := map[string]struct {
+		message         SystemMessage
+		setExpectations func(m mocks)
+		expectedErr     error
+	}{
+		"posts-message": {
+			message: SystemMessage{ConversationID: conversationID, Content: "  Pay rent is due tomorrow. ", Source: "reminders"},
+			setExpectations: func(m mocks) {
+				expectUow(m)
+				m.scope.EXPECT().ChatMessage().Return(m.chatRepo).Once()
+				m.scope.EXPECT().Outbox().Return(m.outboxRepo).Once()
+				m.timeProvider.EXPECT().Now().Return(now).Once()
+				m.conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				m.chatRepo.EXPECT().CreateChatMessages(mock.Anything, mock.MatchedBy(func(messages []assistant.ChatMessage) bool {
+					return len(messages) == 1 &&
+						messages[0].ConversationID == conversationID &&
+						messages[0].ChatRole == assistant.ChatRole_Assistant &&
+						messages[0].Content == "Pay rent is due tomorrow." &&
+						messages[0].MessageState == assistant.ChatMessageState_Completed &&
+						messages[0].CreatedAt.Equal(now)
+				})).Return(nil).Once()
+				m.outboxRepo.EXPECT().CreateChatEvent(mock.Anything, mock.MatchedBy(func(e outbox.ChatMessageEvent) bool {
+					return e.Type == outbox.EventType_CHAT_MESSAGE_SENT &&
+						e.ConversationID == conversationID &&
+						e.ChatRole == assistant.ChatRole_Assistant &&
+						e.Background
+				})).Return(nil).Once()
+				m.conversationRepo.EXPECT().UpdateConversation(mock.Anything, mock.MatchedBy(func(c assistant.Conversation) bool {
+					return c.ID == conversationID && c.LastMessageAt != nil && c.LastMessageAt.Equal(now) && c.UpdatedAt.Equal(now)
+				})).Return(nil).Once()
+			},
+		},
+		"empty-content": {
+			message:         SystemMessage{ConversationID: conversationID, Content: "  "},
+			setExpectations: func(mocks) {},
+			expectedErr:     core.NewValidationErr("content cannot be empty"),
+		},
+		"conversation-not-found": {
+			message: SystemMessage{ConversationID: conversationID, Content: "Hello"},
+			setExpectations: func(m mocks) {
+				expectUow(m)
+				m.conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID " + conversationID.String() + " not found"),
+		},
+		"outbox-error": {
+			message: SystemMessage{ConversationID: conversationID, Content: "Hello"},
+			setExpectations: func(m mocks) {
+				expectUow(m)
+				m.scope.EXPECT().ChatMessage().Return(m.chatRepo).Once()
+				m.scope.EXPECT().Outbox().Return(m.outboxRepo).Once()
+				m.timeProvider.EXPECT().Now().Return(now).Once()
+				m.conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				m.chatRepo.EXPECT().CreateChatMessages(mock.Anything, mock.Anything).Return(nil).Once()
+				m.outboxRepo.EXPECT().CreateChatEvent(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				uow:              transaction.NewMockUnitOfWork(t),
+				scope:            transaction.NewMockScope(t),
+				conversationRepo: assistant.NewMockConversationRepository(t),
+				chatRepo:         assistant.NewMockChatMessageRepository(t),
+				outboxRepo:       outbox.NewMockRepository(t),
+				timeProvider:     core.NewMockCurrentTimeProvider(t),
+			}
+			tt.setExpectations(m)
+
+			uc := NewPostSystemMessageImpl(m.uow, m.timeProvider)
+			got, err := uc.Execute(t.Context(), tt.message)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, conversationID, got.ConversationID)
+				assert.Equal(t, "Pay rent is due tomorrow.", got.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// PreviewChat previews an assistant turn without side effects, to debug prompts or show what an automation would do.
+type PreviewChat interface {
+	// Execute streams the events of the turn StreamChat would run for the user message. The turn runs in a
+	// transaction.Sandbox, so the messages, conversations and todo changes it makes are discarded when it ends,
+	// and actions whose changes cannot be discarded are rejected.
+	Execute(ctx context.Context, userMessage, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error
+}
+
+// PreviewChatImpl implements PreviewChat.
+type PreviewChatImpl struct {
+	streamChat StreamChat
+	sandbox    transaction.Sandbox
+	timeout    time.Duration
+}
+
+// NewPreviewChatImpl creates a PreviewChatImpl. The sandbox holds the locks of the rows the turn changes
+// until it ends, so previews are stopped after timeout to keep them from blocking live turns.
+func NewPreviewChatImpl(streamChat StreamChat, sandbox transaction.Sandbox, timeout time.Duration) PreviewChatImpl {
+	return PreviewChatImpl{
+		streamChat: streamChat,
+		sandbox:    sandbox,
+		timeout:    timeout,
+	}
+}
+
+// Execute implements PreviewChat.
+func (uc PreviewChatImpl) Execute(
+	ctx context.Context,
+	userMessage, model string,
+	onEvent assistant.EventCallback,
+	opts ...StreamChatOption,
+) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	previewCtx, cancel := context.WithTimeout(spanCtx, uc.timeout)
+	defer cancel()
+
+	err := uc.sandbox.Run(previewCtx, func(sandboxCtx context.Context) error {
+		return uc.streamChat.Execute(sandboxCtx, userMessage, model, onEvent, opts...)
+	})
+	if errors.Is(err, context.DeadlineExceeded) && spanCtx.Err() == nil {
+		err = fmt.Errorf("chat preview timed out after %s: %w", uc.timeout, err)
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPreviewChatImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		setExpectations func(streamChat *MockStreamChat, sandbox *transaction.MockSandbox)
+		expectedErr     string
+	}{
+		"runs-turn-in-sandbox": {
+			setExpectations: func(streamChat *MockStreamChat, sandbox *transaction.MockSandbox) {
+				sandbox.EXPECT().Run(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(transaction.WithSandbox(ctx))
+					}).
+					Once()
+				streamChat.EXPECT().Execute(mock.Anything, "Add buy milk", "test-model", mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, _, _ string, onEvent assistant.EventCallback, _ ...StreamChatOption) error {
+						assert.True(t, transaction.InSandbox(ctx))
+						_, hasDeadline := ctx.Deadline()
+						assert.True(t, hasDeadline)
+						return onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Done."})
+					}).
+					Once()
+			},
+		},
+		"turn-error": {
+			setExpectations: func(streamChat *MockStreamChat, sandbox *transaction.MockSandbox) {
+				sandbox.EXPECT().Run(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(transaction.WithSandbox(ctx))
+					}).
+					Once()
+				streamChat.EXPECT().Execute(mock.Anything, "Add buy milk", "test-model", mock.Anything, mock.Anything).
+					Return(errors.New("llm error")).
+					Once()
+			},
+			expectedErr: "llm error",
+		},
+		"timeout": {
+			setExpectations: func(_ *MockStreamChat, sandbox *transaction.MockSandbox) {
+				sandbox.EXPECT().Run(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, _ func(context.Context) error) error {
+						<-ctx.Done()
+						return ctx.Err()
+					}).
+					Once()
+			},
+			expectedErr: "chat preview timed out after 10ms: context deadline exceeded",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			streamChat := NewMockStreamChat(t)
+			sandbox := transaction.NewMockSandbox(t)
+			tt.setExpectations(streamChat, sandbox)
+
+			var events []assistant.EventType
+			err := NewPreviewChatImpl(streamChat, sandbox, 10*time.Millisecond).Execute(
+				t.Context(),
+				"Add buy milk",
+				"test-model",
+				func(_ context.Context, eventType assistant.EventType, _ any) error {
+					events = append(events, eventType)
+					return nil
+				},
+				WithConversationID(conversationID),
+			)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []assistant.EventType{assistant.EventType_MessageDelta}, events)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ProjectConversationReadModel keeps the conversation read model up to date with the chat message events.
+type ProjectConversationReadModel interface {
+	// Execute refreshes the projection of the conversation the event belongs to.
+	Execute(ctx context.Context, event outbox.ChatMessageEvent) error
+}
+
+// ProjectConversationReadModelImpl implements ProjectConversationReadModel.
+type ProjectConversationReadModelImpl struct {
+	readModelRepo assistant.ConversationReadModelRepository
+	timeProvider  core.CurrentTimeProvider
+}
+
+// NewProjectConversationReadModelImpl creates a ProjectConversationReadModelImpl.
+func NewProjectConversationReadModelImpl(
+	readModelRepo assistant.ConversationReadModelRepository,
+	timeProvider core.CurrentTimeProvider,
+) ProjectConversationReadModelImpl {
+	return ProjectConversationReadModelImpl{
+		readModelRepo: readModelRepo,
+		timeProvider:  timeProvider,
+	}
+}
+
+// Execute implements ProjectConversationReadModel.
+func (uc ProjectConversationReadModelImpl) Execute(ctx context.Context, event outbox.ChatMessageEvent) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("conversation_id", event.ConversationID.String()),
+		attribute.String("chat_message_id", event.ChatMessageID.String()),
+	))
+	defer span.End()
+
+	// Only new messages change the aggregates of the conversation list.
+	if event.Type != outbox.EventType_CHAT_MESSAGE_SENT {
+		return nil
+	}
+
+	err := uc.readModelRepo.RefreshConversationReadModel(spanCtx, event.ConversationID, uc.timeProvider.Now())
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProjectConversationReadModelImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC)
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		eventType     outbox.EventType
+		setupMocks    func(*assistant.MockConversationReadModelRepository, *core.MockCurrentTimeProvider)
+		expectedError error
+	}{
+		"refreshes-on-sent-message": {
+			eventType: outbox.EventType_CHAT_MESSAGE_SENT,
+			setupMocks: func(repo *assistant.MockConversationReadModelRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				repo.EXPECT().RefreshConversationReadModel(mock.Anything, conversationID, now).Return(nil).Once()
+			},
+		},
+		"ignores-other-events": {
+			eventType:  outbox.EventType_TODO_CREATED,
+			setupMocks: func(*assistant.MockConversationReadModelRepository, *core.MockCurrentTimeProvider) {},
+		},
+		"refresh-error": {
+			eventType: outbox.EventType_CHAT_MESSAGE_SENT,
+			setupMocks: func(repo *assistant.MockConversationReadModelRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				repo.EXPECT().RefreshConversationReadModel(mock.Anything, conversationID, now).Return(errors.New("db error")).Once()
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockConversationReadModelRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setupMocks(repo, timeProvider)
+
+			uc := NewProjectConversationReadModelImpl(repo, timeProvider)
+			err := uc.Execute(t.Context(), outbox.ChatMessageEvent{
+				Type:           tt.eventType,
+				ChatRole:       assistant.ChatRole_User,
+				ChatMessageID:  uuid.New(),
+				ConversationID: conversationID,
+			})
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
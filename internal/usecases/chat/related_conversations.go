@@ -0,0 +1,165 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+)
+
+const (
+	// MAX_RELATED_CONVERSATIONS is the maximum number of related conversations listed for a conversation.
+	MAX_RELATED_CONVERSATIONS = 5
+	// RELATED_CONVERSATION_MAX_DISTANCE is the cosine distance under which two conversation topics are related.
+	RELATED_CONVERSATION_MAX_DISTANCE = 0.35
+	// RELATED_CONVERSATION_HINT_MAX_DISTANCE is the cosine distance under which an earlier conversation is
+	// close enough to the user message to hint it to the assistant.
+	RELATED_CONVERSATION_HINT_MAX_DISTANCE = 0.2
+)
+
+// RelatedConversations defines the interface for the cross-conversation topic index.
+type RelatedConversations interface {
+	// Index stores the embedding of the compacted conversation summary in the topic index.
+	Index(ctx context.Context, conversationID uuid.UUID) error
+	// List lists the conversations whose topic is close to the conversation topic, closest first.
+	List(ctx context.Context, conversationID uuid.UUID) ([]assistant.RelatedConversation, error)
+	// Hint returns the earlier conversation whose topic is closest to the user message, when it is close enough.
+	Hint(ctx context.Context, conversationID uuid.UUID, userMessage string) (assistant.RelatedConversation, bool, error)
+}
+
+// RelatedConversationsImpl is the implementation of the RelatedConversations use case.
+type RelatedConversationsImpl struct {
+	conversationRepo        assistant.ConversationRepository
+	conversationSummaryRepo assistant.ConversationSummaryRepository
+	conversationTopicRepo   assistant.ConversationTopicRepository
+	encoder                 semantic.Encoder
+	embeddingModel          string
+}
+
+// NewRelatedConversationsImpl creates a new instance of RelatedConversationsImpl.
+func NewRelatedConversationsImpl(
+	conversationRepo assistant.ConversationRepository,
+	conversationSummaryRepo assistant.ConversationSummaryRepository,
+	conversationTopicRepo assistant.ConversationTopicRepository,
+	encoder semantic.Encoder,
+	embeddingModel string,
+) RelatedConversationsImpl {
+	return RelatedConversationsImpl{
+		conversationRepo:        conversationRepo,
+		conversationSummaryRepo: conversationSummaryRepo,
+		conversationTopicRepo:   conversationTopicRepo,
+		encoder:                 encoder,
+		embeddingModel:          embeddingModel,
+	}
+}
+
+// Index stores the embedding of the compacted conversation summary in the topic index. Conversations
+// without a summary are left out of the index.
+func (uc RelatedConversationsImpl) Index(ctx context.Context, conversationID uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	summary, found, err := uc.conversationSummaryRepo.GetConversationSummary(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	topic := strings.TrimSpace(summary.CurrentStateSummary)
+	if !found || topic == "" {
+		return nil
+	}
+
+	vector, err := uc.encoder.VectorizeQuery(spanCtx, uc.embeddingModel, topic)
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to embed conversation summary: %w", err)
+	}
+	metrics.RecordLLMTokensEmbedding(spanCtx, vector.TotalTokens)
+
+	err = uc.conversationTopicRepo.StoreConversationTopic(spanCtx, assistant.ConversationTopic{
+		ConversationID: conversationID,
+		Embedding:      vector.Vector,
+		UpdatedAt:      summary.UpdatedAt,
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// List lists the conversations whose topic is close to the conversation topic, closest first.
+// A conversation that was not indexed yet has no related conversations.
+func (uc RelatedConversationsImpl) List(ctx context.Context, conversationID uuid.UUID) ([]assistant.RelatedConversation, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, found, err := uc.conversationRepo.GetConversation(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	if !found {
+		err := core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", conversationID))
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+
+	topic, found, err := uc.conversationTopicRepo.GetConversationTopic(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	if !found {
+		return []assistant.RelatedConversation{}, nil
+	}
+
+	related, err := uc.conversationTopicRepo.SearchRelatedConversations(
+		spanCtx,
+		topic.Embedding,
+		conversationID,
+		MAX_RELATED_CONVERSATIONS,
+		RELATED_CONVERSATION_MAX_DISTANCE,
+	)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return related, nil
+}
+
+// Hint returns the earlier conversation whose topic is closest to the user message, when it is within
+// RELATED_CONVERSATION_HINT_MAX_DISTANCE.
+func (uc RelatedConversationsImpl) Hint(ctx context.Context, conversationID uuid.UUID, userMessage string) (assistant.RelatedConversation, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	userMessage = strings.TrimSpace(userMessage)
+	if userMessage == "" {
+		return assistant.RelatedConversation{}, false, nil
+	}
+
+	vector, err := uc.encoder.VectorizeQuery(spanCtx, uc.embeddingModel, userMessage)
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.RelatedConversation{}, false, fmt.Errorf("failed to embed user message: %w", err)
+	}
+	metrics.RecordLLMTokensEmbedding(spanCtx, vector.TotalTokens)
+
+	related, err := uc.conversationTopicRepo.SearchRelatedConversations(
+		spanCtx,
+		vector.Vector,
+		conversationID,
+		1,
+		RELATED_CONVERSATION_HINT_MAX_DISTANCE,
+	)
+	if telemetry.IsErrorRecorded(span, err) {
+		return assistant.RelatedConversation{}, false, err
+	}
+	if len(related) == 0 {
+		return assistant.RelatedConversation{}, false, nil
+	}
+
+	return related[0], true, nil
+}
@@ -0,0 +1,234 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRelatedConversationsImpl_Index(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	updatedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	vector := []float64{0.1, 0.2}
+	summary := assistant.ConversationSummary{
+		ConversationID:      conversationID,
+		CurrentStateSummary: " Planning the spring cleaning of the garage ",
+		UpdatedAt:           updatedAt,
+	}
+
+	tests := map[string]struct {
+		setExpectations func(summaries *assistant.MockConversationSummaryRepository, topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder)
+		expectedErr     string
+	}{
+		"indexes-summary": {
+			setExpectations: func(summaries *assistant.MockConversationSummaryRepository, topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				summaries.EXPECT().GetConversationSummary(mock.Anything, conversationID).Return(summary, true, nil).Once()
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Planning the spring cleaning of the garage").
+					Return(semantic.EmbeddingVector{Vector: vector, TotalTokens: 8}, nil).Once()
+				topics.EXPECT().StoreConversationTopic(mock.Anything, assistant.ConversationTopic{
+					ConversationID: conversationID,
+					Embedding:      vector,
+					UpdatedAt:      updatedAt,
+				}).Return(nil).Once()
+			},
+		},
+		"no-summary": {
+			setExpectations: func(summaries *assistant.MockConversationSummaryRepository, _ *assistant.MockConversationTopicRepository, _ *semantic.MockEncoder) {
+				summaries.EXPECT().GetConversationSummary(mock.Anything, conversationID).Return(assistant.ConversationSummary{}, false, nil).Once()
+			},
+		},
+		"summary-error": {
+			setExpectations: func(summaries *assistant.MockConversationSummaryRepository, _ *assistant.MockConversationTopicRepository, _ *semantic.MockEncoder) {
+				summaries.EXPECT().GetConversationSummary(mock.Anything, conversationID).Return(assistant.ConversationSummary{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: "db error",
+		},
+		"encoder-error": {
+			setExpectations: func(summaries *assistant.MockConversationSummaryRepository, _ *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				summaries.EXPECT().GetConversationSummary(mock.Anything, conversationID).Return(summary, true, nil).Once()
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", mock.Anything).
+					Return(semantic.EmbeddingVector{}, errors.New("encoder down")).Once()
+			},
+			expectedErr: "failed to embed conversation summary: encoder down",
+		},
+		"store-error": {
+			setExpectations: func(summaries *assistant.MockConversationSummaryRepository, topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				summaries.EXPECT().GetConversationSummary(mock.Anything, conversationID).Return(summary, true, nil).Once()
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: vector}, nil).Once()
+				topics.EXPECT().StoreConversationTopic(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summaries := assistant.NewMockConversationSummaryRepository(t)
+			topics := assistant.NewMockConversationTopicRepository(t)
+			encoder := semantic.NewMockEncoder(t)
+			tt.setExpectations(summaries, topics, encoder)
+
+			uc := NewRelatedConversationsImpl(assistant.NewMockConversationRepository(t), summaries, topics, encoder, "embedding-model")
+			err := uc.Index(t.Context(), conversationID)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRelatedConversationsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	vector := []float64{0.1, 0.2}
+	related := []assistant.RelatedConversation{{ConversationID: uuid.New(), Title: "Spring cleaning", Similarity: 0.9}}
+
+	tests := map[string]struct {
+		setExpectations func(conversations *assistant.MockConversationRepository, topics *assistant.MockConversationTopicRepository)
+		expected        []assistant.RelatedConversation
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(conversations *assistant.MockConversationRepository, topics *assistant.MockConversationTopicRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil).Once()
+				topics.EXPECT().GetConversationTopic(mock.Anything, conversationID).
+					Return(assistant.ConversationTopic{ConversationID: conversationID, Embedding: vector}, true, nil).Once()
+				topics.EXPECT().SearchRelatedConversations(mock.Anything, vector, conversationID, MAX_RELATED_CONVERSATIONS, RELATED_CONVERSATION_MAX_DISTANCE).
+					Return(related, nil).Once()
+			},
+			expected: related,
+		},
+		"not-indexed": {
+			setExpectations: func(conversations *assistant.MockConversationRepository, topics *assistant.MockConversationTopicRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil).Once()
+				topics.EXPECT().GetConversationTopic(mock.Anything, conversationID).Return(assistant.ConversationTopic{}, false, nil).Once()
+			},
+			expected: []assistant.RelatedConversation{},
+		},
+		"conversation-not-found": {
+			setExpectations: func(conversations *assistant.MockConversationRepository, _ *assistant.MockConversationTopicRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID 223e4567-e89b-12d3-a456-426614174001 not found"),
+		},
+		"search-error": {
+			setExpectations: func(conversations *assistant.MockConversationRepository, topics *assistant.MockConversationTopicRepository) {
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{ID: conversationID}, true, nil).Once()
+				topics.EXPECT().GetConversationTopic(mock.Anything, conversationID).
+					Return(assistant.ConversationTopic{ConversationID: conversationID, Embedding: vector}, true, nil).Once()
+				topics.EXPECT().SearchRelatedConversations(mock.Anything, vector, conversationID, MAX_RELATED_CONVERSATIONS, RELATED_CONVERSATION_MAX_DISTANCE).
+					Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversations := assistant.NewMockConversationRepository(t)
+			topics := assistant.NewMockConversationTopicRepository(t)
+			tt.setExpectations(conversations, topics)
+
+			uc := NewRelatedConversationsImpl(conversations, assistant.NewMockConversationSummaryRepository(t), topics, semantic.NewMockEncoder(t), "embedding-model")
+			got, err := uc.List(t.Context(), conversationID)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestRelatedConversationsImpl_Hint(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	vector := []float64{0.1, 0.2}
+	related := assistant.RelatedConversation{ConversationID: uuid.New(), Title: "Spring cleaning", Similarity: 0.9}
+
+	tests := map[string]struct {
+		userMessage     string
+		setExpectations func(topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder)
+		expected        assistant.RelatedConversation
+		expectedFound   bool
+		expectedErr     string
+	}{
+		"related-conversation": {
+			userMessage: "Clean the garage",
+			setExpectations: func(topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Clean the garage").
+					Return(semantic.EmbeddingVector{Vector: vector, TotalTokens: 3}, nil).Once()
+				topics.EXPECT().SearchRelatedConversations(mock.Anything, vector, conversationID, 1, RELATED_CONVERSATION_HINT_MAX_DISTANCE).
+					Return([]assistant.RelatedConversation{related}, nil).Once()
+			},
+			expected:      related,
+			expectedFound: true,
+		},
+		"no-close-conversation": {
+			userMessage: "Clean the garage",
+			setExpectations: func(topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Clean the garage").
+					Return(semantic.EmbeddingVector{Vector: vector}, nil).Once()
+				topics.EXPECT().SearchRelatedConversations(mock.Anything, vector, conversationID, 1, RELATED_CONVERSATION_HINT_MAX_DISTANCE).
+					Return([]assistant.RelatedConversation{}, nil).Once()
+			},
+		},
+		"blank-message": {
+			userMessage:     " ",
+			setExpectations: func(*assistant.MockConversationTopicRepository, *semantic.MockEncoder) {},
+		},
+		"encoder-error": {
+			userMessage: "Clean the garage",
+			setExpectations: func(_ *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Clean the garage").
+					Return(semantic.EmbeddingVector{}, errors.New("encoder down")).Once()
+			},
+			expectedErr: "failed to embed user message: encoder down",
+		},
+		"search-error": {
+			userMessage: "Clean the garage",
+			setExpectations: func(topics *assistant.MockConversationTopicRepository, encoder *semantic.MockEncoder) {
+				encoder.EXPECT().VectorizeQuery(mock.Anything, "embedding-model", "Clean the garage").
+					Return(semantic.EmbeddingVector{Vector: vector}, nil).Once()
+				topics.EXPECT().SearchRelatedConversations(mock.Anything, vector, conversationID, 1, RELATED_CONVERSATION_HINT_MAX_DISTANCE).
+					Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			topics := assistant.NewMockConversationTopicRepository(t)
+			encoder := semantic.NewMockEncoder(t)
+			tt.setExpectations(topics, encoder)
+
+			uc := NewRelatedConversationsImpl(assistant.NewMockConversationRepository(t), assistant.NewMockConversationSummaryRepository(t), topics, encoder, "embedding-model")
+			got, found, err := uc.Hint(t.Context(), conversationID, tt.userMessage)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
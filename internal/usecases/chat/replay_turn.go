@@ -0,0 +1,144 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// TurnReplay compares the first assistant response of a past turn with a new run of the same turn.
+type TurnReplay struct {
+	TurnID uuid.UUID
+	Model  string
+	// Seed is the recorded sampling seed the turn was replayed with, if the original turn had one.
+	Seed                *int64
+	OriginalContent     string
+	OriginalActionCalls []assistant.ActionCall
+	ReplayedContent     string
+	ReplayedActionCalls []assistant.ActionCall
+	Usage               assistant.Usage
+	// Matches reports whether the replayed content and action calls are identical to the original ones.
+	Matches bool
+}
+
+// ReplayTurn re-runs a past turn with its recorded model and seed to debug nondeterministic behavior.
+type ReplayTurn interface {
+	// Execute replays the turn without persisting messages or executing the chosen actions.
+	Execute(ctx context.Context, conversationID, turnID uuid.UUID) (TurnReplay, error)
+}
+
+// ReplayTurnImpl implements ReplayTurn.
+type ReplayTurnImpl struct {
+	conversationRepo assistant.ConversationRepository
+	chatMessageRepo  assistant.ChatMessageRepository
+	stateBuilder     TurnStateBuilder
+	assistant        assistant.Assistant
+}
+
+// NewReplayTurnImpl creates a ReplayTurnImpl.
+func NewReplayTurnImpl(
+	conversationRepo assistant.ConversationRepository,
+	chatMessageRepo assistant.ChatMessageRepository,
+	stateBuilder TurnStateBuilder,
+	assistantClient assistant.Assistant,
+) ReplayTurnImpl {
+	return ReplayTurnImpl{
+		conversationRepo: conversationRepo,
+		chatMessageRepo:  chatMessageRepo,
+		stateBuilder:     stateBuilder,
+		assistant:        assistantClient,
+	}
+}
+
+// Execute implements ReplayTurn.
+func (uc ReplayTurnImpl) Execute(ctx context.Context, conversationID, turnID uuid.UUID) (TurnReplay, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	conversation, found, err := uc.conversationRepo.GetConversation(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return TurnReplay{}, err
+	}
+	if !found {
+		err := core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", conversationID))
+		telemetry.IsErrorRecorded(span, err)
+		return TurnReplay{}, err
+	}
+
+	messages, _, err := uc.chatMessageRepo.ListChatMessages(spanCtx, conversationID, 1, 0, assistant.WithChatMessagesTurnID(turnID))
+	if telemetry.IsErrorRecorded(span, err) {
+		return TurnReplay{}, err
+	}
+	userMessage, original, ok := findReplayableTurn(messages)
+	if !ok {
+		err := core.NewNotFoundErr(fmt.Sprintf("turn with ID %s not found", turnID))
+		telemetry.IsErrorRecorded(span, err)
+		return TurnReplay{}, err
+	}
+
+	// The history stops at the original user message so later turns do not leak into the replay.
+	state, err := uc.stateBuilder.Build(spanCtx, BuildTurnStateParams{
+		UserMessage:   userMessage.Content,
+		Model:         original.Model,
+		Conversation:  conversation,
+		Generation:    assistant.GenerationOptions{Seed: original.Seed},
+		Experiment:    original.Experiment,
+		HistoryBefore: &userMessage.ID,
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return TurnReplay{}, err
+	}
+
+	req := state.Request()
+	req.Stream = false
+	resp, err := uc.assistant.RunTurnSync(spanCtx, req)
+	if telemetry.IsErrorRecorded(span, err) {
+		return TurnReplay{}, err
+	}
+
+	return TurnReplay{
+		TurnID:              turnID,
+		Model:               original.Model,
+		Seed:                original.Seed,
+		OriginalContent:     original.Content,
+		OriginalActionCalls: original.ActionCalls,
+		ReplayedContent:     resp.Content,
+		ReplayedActionCalls: resp.ActionCalls,
+		Usage:               resp.Usage,
+		Matches:             original.Content == resp.Content && sameActionCalls(original.ActionCalls, resp.ActionCalls),
+	}, nil
+}
+
+// findReplayableTurn returns the user message of a turn and the first assistant response to it.
+func findReplayableTurn(messages []assistant.ChatMessage) (assistant.ChatMessage, assistant.ChatMessage, bool) {
+	sorted := slices.Clone(messages)
+	slices.SortFunc(sorted, func(a, b assistant.ChatMessage) int {
+		return int(a.TurnSequence - b.TurnSequence)
+	})
+
+	userIdx := slices.IndexFunc(sorted, func(m assistant.ChatMessage) bool {
+		return m.ChatRole == assistant.ChatRole_User
+	})
+	if userIdx < 0 {
+		return assistant.ChatMessage{}, assistant.ChatMessage{}, false
+	}
+	assistantIdx := slices.IndexFunc(sorted[userIdx:], func(m assistant.ChatMessage) bool {
+		return m.ChatRole == assistant.ChatRole_Assistant
+	})
+	if assistantIdx < 0 {
+		return assistant.ChatMessage{}, assistant.ChatMessage{}, false
+	}
+	return sorted[userIdx], sorted[userIdx+assistantIdx], true
+}
+
+// sameActionCalls compares action calls by name and input, ignoring the provider-generated IDs.
+func sameActionCalls(a, b []assistant.ActionCall) bool {
+	return slices.EqualFunc(a, b, func(x, y assistant.ActionCall) bool {
+		return x.Name == y.Name && x.Input == y.Input
+	})
+}
@@ -0,0 +1,189 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReplayTurnImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("60000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("60000000-0000-0000-0000-000000000002")
+	userMessageID := uuid.MustParse("60000000-0000-0000-0000-000000000003")
+	conversation := assistant.Conversation{ID: conversationID}
+	seed := common.Ptr[int64](7)
+
+	userMessage := assistant.ChatMessage{
+		ID:           userMessageID,
+		TurnID:       turnID,
+		TurnSequence: 0,
+		ChatRole:     assistant.ChatRole_User,
+		Content:      "Delete my done todos",
+	}
+	actionCallMessage := assistant.ChatMessage{
+		TurnID:       turnID,
+		TurnSequence: 1,
+		ChatRole:     assistant.ChatRole_Assistant,
+		ActionCalls:  []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos", Input: `{"status":"DONE"}`}},
+		Model:        "test-model",
+		Seed:         seed,
+	}
+	finalMessage := assistant.ChatMessage{
+		TurnID:       turnID,
+		TurnSequence: 3,
+		ChatRole:     assistant.ChatRole_Assistant,
+		Content:      "Done.",
+		Model:        "test-model",
+		Seed:         seed,
+	}
+
+	state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model", Stream: true, Seed: seed}, 0, nil)
+	expectBuild := func(builder *MockTurnStateBuilder) {
+		builder.EXPECT().
+			Build(mock.Anything, BuildTurnStateParams{
+				UserMessage:   "Delete my done todos",
+				Model:         "test-model",
+				Conversation:  conversation,
+				Generation:    assistant.GenerationOptions{Seed: seed},
+				HistoryBefore: &userMessageID,
+			}).
+			Return(state, nil).
+			Once()
+	}
+
+	tests := map[string]struct {
+		setExpectations func(
+			convRepo *assistant.MockConversationRepository,
+			msgRepo *assistant.MockChatMessageRepository,
+			builder *MockTurnStateBuilder,
+			client *assistant.MockAssistant,
+		)
+		expectedReplay TurnReplay
+		expectedErr    error
+	}{
+		"matching-replay": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+				builder *MockTurnStateBuilder,
+				client *assistant.MockAssistant,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return([]assistant.ChatMessage{finalMessage, actionCallMessage, userMessage}, false, nil).
+					Once()
+				expectBuild(builder)
+				client.EXPECT().
+					RunTurnSync(mock.Anything, assistant.TurnRequest{Model: "test-model", Seed: seed}).
+					Return(assistant.TurnResponse{
+						ActionCalls: []assistant.ActionCall{{ID: "call-9", Name: "fetch_todos", Input: `{"status":"DONE"}`}},
+						Usage:       assistant.Usage{TotalTokens: 12},
+					}, nil).
+					Once()
+			},
+			expectedReplay: TurnReplay{
+				TurnID:              turnID,
+				Model:               "test-model",
+				Seed:                seed,
+				OriginalActionCalls: actionCallMessage.ActionCalls,
+				ReplayedActionCalls: []assistant.ActionCall{{ID: "call-9", Name: "fetch_todos", Input: `{"status":"DONE"}`}},
+				Usage:               assistant.Usage{TotalTokens: 12},
+				Matches:             true,
+			},
+		},
+		"diverging-replay": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+				builder *MockTurnStateBuilder,
+				client *assistant.MockAssistant,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return([]assistant.ChatMessage{userMessage, actionCallMessage}, false, nil).
+					Once()
+				expectBuild(builder)
+				client.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: "Which todos?"}, nil).
+					Once()
+			},
+			expectedReplay: TurnReplay{
+				TurnID:              turnID,
+				Model:               "test-model",
+				Seed:                seed,
+				OriginalActionCalls: actionCallMessage.ActionCalls,
+				ReplayedContent:     "Which todos?",
+			},
+		},
+		"conversation-not-found": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				_ *assistant.MockChatMessageRepository,
+				_ *MockTurnStateBuilder,
+				_ *assistant.MockAssistant,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID 60000000-0000-0000-0000-000000000001 not found"),
+		},
+		"turn-not-found": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+				_ *MockTurnStateBuilder,
+				_ *assistant.MockAssistant,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return([]assistant.ChatMessage{userMessage}, false, nil).
+					Once()
+			},
+			expectedErr: core.NewNotFoundErr("turn with ID 60000000-0000-0000-0000-000000000002 not found"),
+		},
+		"assistant-error": {
+			setExpectations: func(
+				convRepo *assistant.MockConversationRepository,
+				msgRepo *assistant.MockChatMessageRepository,
+				builder *MockTurnStateBuilder,
+				client *assistant.MockAssistant,
+			) {
+				convRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				msgRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+					Return([]assistant.ChatMessage{userMessage, finalMessage}, false, nil).
+					Once()
+				builder.EXPECT().Build(mock.Anything, mock.Anything).Return(state, nil).Once()
+				client.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{}, errors.New("llm down")).Once()
+			},
+			expectedErr: errors.New("llm down"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			convRepo := assistant.NewMockConversationRepository(t)
+			msgRepo := assistant.NewMockChatMessageRepository(t)
+			builder := NewMockTurnStateBuilder(t)
+			client := assistant.NewMockAssistant(t)
+			tt.setExpectations(convRepo, msgRepo, builder, client)
+
+			replay, err := NewReplayTurnImpl(convRepo, msgRepo, builder, client).Execute(t.Context(), conversationID, turnID)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedReplay, replay)
+		})
+	}
+}
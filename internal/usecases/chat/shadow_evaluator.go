@@ -0,0 +1,317 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Shadow evaluation outcomes recorded in metrics.
+const (
+	shadowOutcomeRecorded        = "recorded"
+	shadowOutcomeCandidateFailed = "candidate_failed"
+	shadowOutcomeStoreFailed     = "store_failed"
+	shadowOutcomeOverBudget      = "over_budget"
+	shadowOutcomeBusy            = "busy"
+)
+
+// ShadowTurn is a completed interactive turn submitted for shadow evaluation.
+type ShadowTurn struct {
+	ConversationID uuid.UUID
+	TurnID         uuid.UUID
+	// Request is the initial turn request, before any action result was appended to it.
+	Request assistant.TurnRequest
+	// Content is the assistant response the user received.
+	Content string
+	// Actions lists the actions run or submitted for approval during the turn, in order.
+	Actions []string
+}
+
+// ShadowEvaluator replays interactive turns against a candidate model and stores both responses side by side
+// for offline comparison.
+type ShadowEvaluator interface {
+	// Submit replays the turn in the background when it is sampled and the budget allows it.
+	// It never blocks and never fails the turn.
+	Submit(ctx context.Context, turn ShadowTurn)
+}
+
+// ShadowConfig configures the shadow evaluation of a candidate model.
+type ShadowConfig struct {
+	// CandidateModel is the model interactive turns are replayed against.
+	CandidateModel string
+	// SampleRate is the fraction, from 0 to 1, of interactive turns replayed.
+	SampleRate float64
+	// MaxConcurrent caps the replays running at the same time. Sampled turns are skipped while every slot is busy.
+	MaxConcurrent int
+	// DailyTokenBudget caps the candidate tokens spent per UTC day. Zero leaves it unlimited.
+	DailyTokenBudget int
+	// Timeout bounds one replay.
+	Timeout time.Duration
+}
+
+// Validate checks the configuration values are in range.
+func (c ShadowConfig) Validate() error {
+	if c.CandidateModel == "" {
+		return errors.New("shadow candidate model must be set")
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return errors.New("shadow sample rate must be between 0 and 1")
+	}
+	if c.MaxConcurrent < 1 {
+		return errors.New("shadow max concurrency must be positive")
+	}
+	if c.DailyTokenBudget < 0 || c.Timeout < 0 {
+		return errors.New("shadow daily token budget and timeout must not be negative")
+	}
+	return nil
+}
+
+// ShadowEvaluatorImpl implements ShadowEvaluator.
+type ShadowEvaluatorImpl struct {
+	logger       *log.Logger
+	assistant    assistant.Assistant
+	repo         assistant.ShadowEvaluationRepository
+	timeProvider core.CurrentTimeProvider
+	cfg          ShadowConfig
+	rand         func() float64
+	slots        chan struct{}
+	inFlight     sync.WaitGroup
+
+	mu          sync.Mutex
+	closed      bool
+	budgetDay   time.Time
+	spentTokens int
+}
+
+// NewShadowEvaluatorImpl creates a ShadowEvaluatorImpl.
+func NewShadowEvaluatorImpl(
+	logger *log.Logger,
+	assistantClient assistant.Assistant,
+	repo assistant.ShadowEvaluationRepository,
+	timeProvider core.CurrentTimeProvider,
+	cfg ShadowConfig,
+) *ShadowEvaluatorImpl {
+	return &ShadowEvaluatorImpl{
+		logger:       logger,
+		assistant:    assistantClient,
+		repo:         repo,
+		timeProvider: timeProvider,
+		cfg:          cfg,
+		rand:         rand.Float64,
+		slots:        make(chan struct{}, cfg.MaxConcurrent),
+	}
+}
+
+// Submit implements ShadowEvaluator.
+func (e *ShadowEvaluatorImpl) Submit(ctx context.Context, turn ShadowTurn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	cfg := e.cfg
+
+	// A turn served by the candidate has nothing to be compared with.
+	if turn.Request.Model == cfg.CandidateModel || e.rand() >= cfg.SampleRate {
+		return
+	}
+	if e.closed {
+		return
+	}
+	if !e.withinBudget() {
+		metrics.RecordShadowEvaluation(ctx, cfg.CandidateModel, shadowOutcomeOverBudget)
+		return
+	}
+	select {
+	case e.slots <- struct{}{}:
+	default:
+		metrics.RecordShadowEvaluation(ctx, cfg.CandidateModel, shadowOutcomeBusy)
+		return
+	}
+
+	e.inFlight.Add(1)
+	go func() {
+		defer e.inFlight.Done()
+		defer func() { <-e.slots }()
+		// The replay outlives the turn but keeps its tenant and trace.
+		e.evaluate(context.WithoutCancel(ctx), turn, cfg)
+	}()
+}
+
+// SetConfig applies a new configuration at runtime. Running replays finish with the previous one, and
+// the concurrency cap keeps its initial value since the replay slots are allocated once. The tokens spent
+// today still count against a changed budget.
+func (e *ShadowEvaluatorImpl) SetConfig(cfg ShadowConfig) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	cfg.MaxConcurrent = e.cfg.MaxConcurrent
+	e.cfg = cfg
+}
+
+// Close stops accepting turns and waits for the running replays.
+func (e *ShadowEvaluatorImpl) Close() {
+	e.mu.Lock()
+	e.closed = true
+	e.mu.Unlock()
+	e.inFlight.Wait()
+}
+
+// evaluate replays the turn against the candidate model of cfg and stores the evaluation.
+func (e *ShadowEvaluatorImpl) evaluate(ctx context.Context, turn ShadowTurn, cfg ShadowConfig) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("candidate_model", cfg.CandidateModel),
+	))
+	defer span.End()
+
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		spanCtx, cancel = context.WithTimeout(spanCtx, cfg.Timeout)
+		defer cancel()
+	}
+
+	req := turn.Request
+	req.Model = cfg.CandidateModel
+	req.Stream = false
+	started := time.Now()
+	resp, err := e.assistant.RunTurnSync(spanCtx, req)
+	latency := time.Since(started)
+	e.spend(resp.Usage.TotalTokens)
+
+	evaluation := assistant.ShadowEvaluation{
+		ID:               uuid.New(),
+		ConversationID:   turn.ConversationID,
+		TurnID:           turn.TurnID,
+		PrimaryModel:     turn.Request.Model,
+		PrimaryContent:   turn.Content,
+		PrimaryActions:   turn.Actions,
+		CandidateModel:   cfg.CandidateModel,
+		CandidateContent: resp.Content,
+		CandidateUsage:   resp.Usage,
+		CandidateLatency: latency,
+		CreatedAt:        e.timeProvider.Now(),
+	}
+	for _, call := range resp.ActionCalls {
+		evaluation.CandidateActions = append(evaluation.CandidateActions, call.Name)
+	}
+	outcome := shadowOutcomeRecorded
+	if telemetry.IsErrorRecorded(span, err) {
+		message := err.Error()
+		evaluation.CandidateError = &message
+		outcome = shadowOutcomeCandidateFailed
+	}
+
+	if err := e.repo.CreateShadowEvaluation(spanCtx, evaluation); telemetry.IsErrorRecorded(span, err) {
+		e.logger.Printf("ShadowEvaluator: failed to store the evaluation of turn %s: %v", turn.TurnID, err)
+		outcome = shadowOutcomeStoreFailed
+	}
+	metrics.RecordShadowEvaluation(ctx, cfg.CandidateModel, outcome)
+}
+
+// withinBudget reports whether the daily token budget still allows a replay, starting a new budget
+// every UTC day. The caller must hold mu.
+func (e *ShadowEvaluatorImpl) withinBudget() bool {
+	if e.cfg.DailyTokenBudget <= 0 {
+		return true
+	}
+	day := e.timeProvider.Now().UTC().Truncate(24 * time.Hour)
+	if !day.Equal(e.budgetDay) {
+		e.budgetDay = day
+		e.spentTokens = 0
+	}
+	return e.spentTokens < e.cfg.DailyTokenBudget
+}
+
+// spend counts the tokens of a replay against the daily budget. Replays already running when the budget
+// runs out still complete, so the budget can be exceeded by at most MaxConcurrent replays.
+func (e *ShadowEvaluatorImpl) spend(tokens int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.spentTokens += tokens
+}
+
+// ShadowTurnRunner decorates a TurnRunner, submitting every completed turn to a ShadowEvaluator.
+// Turns run in a sandbox are not submitted, since their data is discarded.
+type ShadowTurnRunner struct {
+	next      TurnRunner
+	evaluator ShadowEvaluator
+}
+
+// NewShadowTurnRunner creates a ShadowTurnRunner.
+func NewShadowTurnRunner(next TurnRunner, evaluator ShadowEvaluator) ShadowTurnRunner {
+	return ShadowTurnRunner{next: next, evaluator: evaluator}
+}
+
+// Run implements TurnRunner.
+func (r ShadowTurnRunner) Run(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+	request := state.Request()
+	// The turn rewrites its request messages while it runs.
+	request.Messages = slices.Clone(request.Messages)
+
+	recorder := &shadowActionRecorder{seen: map[string]bool{}}
+	if err := r.next.Run(ctx, state, recorder.wrap(onEvent)); err != nil {
+		return err
+	}
+	if transaction.InSandbox(ctx) {
+		return nil
+	}
+
+	r.evaluator.Submit(ctx, ShadowTurn{
+		ConversationID: state.Conversation().ID,
+		TurnID:         state.TurnID(),
+		Request:        request,
+		Content:        state.AssistantContent(),
+		Actions:        recorder.actions(),
+	})
+	return nil
+}
+
+// shadowActionRecorder records the actions of a turn from its events. Actions requiring approval are
+// recorded when the approval is requested, whatever the decision.
+type shadowActionRecorder struct {
+	mu    sync.Mutex
+	seen  map[string]bool
+	names []string
+}
+
+// wrap returns an event callback recording the actions before forwarding the events to onEvent.
+func (r *shadowActionRecorder) wrap(onEvent assistant.EventCallback) assistant.EventCallback {
+	return func(ctx context.Context, eventType assistant.EventType, data any) error {
+		switch event := data.(type) {
+		case assistant.ActionCall:
+			if eventType == assistant.EventType_ActionStarted {
+				r.record(event.ID, event.Name)
+			}
+		case assistant.ActionApprovalRequired:
+			r.record(event.ActionCallID, event.Name)
+		}
+		return onEvent(ctx, eventType, data)
+	}
+}
+
+// record adds the action once per action call.
+func (r *shadowActionRecorder) record(callID, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.seen[callID] {
+		return
+	}
+	r.seen[callID] = true
+	r.names = append(r.names, name)
+}
+
+// actions returns the recorded action names.
+func (r *shadowActionRecorder) actions() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.names)
+}
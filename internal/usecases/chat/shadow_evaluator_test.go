@@ -0,0 +1,365 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShadowConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := ShadowConfig{CandidateModel: "candidate", SampleRate: 0.5, MaxConcurrent: 1, Timeout: time.Second}
+
+	tests := map[string]struct {
+		update      func(cfg *ShadowConfig)
+		expectedErr bool
+	}{
+		"valid":                   {update: func(cfg *ShadowConfig) {}},
+		"missing-candidate-model": {update: func(cfg *ShadowConfig) { cfg.CandidateModel = "" }, expectedErr: true},
+		"sample-rate-above-one":   {update: func(cfg *ShadowConfig) { cfg.SampleRate = 1.5 }, expectedErr: true},
+		"negative-sample-rate":    {update: func(cfg *ShadowConfig) { cfg.SampleRate = -0.1 }, expectedErr: true},
+		"zero-concurrency":        {update: func(cfg *ShadowConfig) { cfg.MaxConcurrent = 0 }, expectedErr: true},
+		"negative-budget":         {update: func(cfg *ShadowConfig) { cfg.DailyTokenBudget = -1 }, expectedErr: true},
+		"negative-timeout":        {update: func(cfg *ShadowConfig) { cfg.Timeout = -time.Second }, expectedErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := valid
+			tt.update(&cfg)
+			err := cfg.Validate()
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestShadowEvaluatorImpl_Submit(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC)
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
+	cfg := ShadowConfig{
+		CandidateModel:   "candidate",
+		SampleRate:       0.5,
+		MaxConcurrent:    1,
+		DailyTokenBudget: 100,
+		Timeout:          time.Second,
+	}
+	turn := ShadowTurn{
+		ConversationID: conversationID,
+		TurnID:         turnID,
+		Request: assistant.TurnRequest{
+			Model:    "primary",
+			Stream:   true,
+			Messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "hello"}},
+		},
+		Content: "Hi!",
+		Actions: []string{"fetch_todos"},
+	}
+	candidateError := "candidate down"
+
+	tests := map[string]struct {
+		turn            ShadowTurn
+		sample          float64
+		prepare         func(e *ShadowEvaluatorImpl)
+		setExpectations func(assistantClient *assistant.MockAssistant, repo *assistant.MockShadowEvaluationRepository)
+	}{
+		"recorded": {
+			turn:   turn,
+			sample: 0.1,
+			setExpectations: func(assistantClient *assistant.MockAssistant, repo *assistant.MockShadowEvaluationRepository) {
+				assistantClient.EXPECT().
+					RunTurnSync(mock.Anything, assistant.TurnRequest{
+						Model:    "candidate",
+						Messages: turn.Request.Messages,
+					}).
+					Return(assistant.TurnResponse{
+						Content:     "Hello!",
+						ActionCalls: []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos"}, {ID: "call-2", Name: "create_todo"}},
+						Usage:       assistant.Usage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+					}, nil).Once()
+				repo.EXPECT().
+					CreateShadowEvaluation(mock.Anything, mock.MatchedBy(func(e assistant.ShadowEvaluation) bool {
+						return e.ConversationID == conversationID &&
+							e.TurnID == turnID &&
+							e.PrimaryModel == "primary" &&
+							e.PrimaryContent == "Hi!" &&
+							assert.ObjectsAreEqual([]string{"fetch_todos"}, e.PrimaryActions) &&
+							e.CandidateModel == "candidate" &&
+							e.CandidateContent == "Hello!" &&
+							assert.ObjectsAreEqual([]string{"fetch_todos", "create_todo"}, e.CandidateActions) &&
+							e.CandidateError == nil &&
+							e.CandidateUsage.TotalTokens == 15 &&
+							e.CreatedAt.Equal(fixedTime)
+					})).
+					Return(nil).Once()
+			},
+		},
+		"candidate-failed": {
+			turn:   turn,
+			sample: 0.1,
+			setExpectations: func(assistantClient *assistant.MockAssistant, repo *assistant.MockShadowEvaluationRepository) {
+				assistantClient.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{}, errors.New(candidateError)).Once()
+				repo.EXPECT().
+					CreateShadowEvaluation(mock.Anything, mock.MatchedBy(func(e assistant.ShadowEvaluation) bool {
+						return e.CandidateError != nil && *e.CandidateError == candidateError && e.CandidateContent == ""
+					})).
+					Return(nil).Once()
+			},
+		},
+		"store-failed": {
+			turn:   turn,
+			sample: 0.1,
+			setExpectations: func(assistantClient *assistant.MockAssistant, repo *assistant.MockShadowEvaluationRepository) {
+				assistantClient.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: "Hello!"}, nil).Once()
+				repo.EXPECT().
+					CreateShadowEvaluation(mock.Anything, mock.Anything).
+					Return(errors.New("db error")).Once()
+			},
+		},
+		"not-sampled": {
+			turn:   turn,
+			sample: 0.5,
+		},
+		"served-by-candidate": {
+			turn: func() ShadowTurn {
+				candidateTurn := turn
+				candidateTurn.Request.Model = "candidate"
+				return candidateTurn
+			}(),
+			sample: 0.1,
+		},
+		"over-budget": {
+			turn:   turn,
+			sample: 0.1,
+			prepare: func(e *ShadowEvaluatorImpl) {
+				e.budgetDay = fixedTime.Truncate(24 * time.Hour)
+				e.spentTokens = 100
+			},
+		},
+		"budget-reset-next-day": {
+			turn:   turn,
+			sample: 0.1,
+			prepare: func(e *ShadowEvaluatorImpl) {
+				e.budgetDay = fixedTime.Add(-24 * time.Hour).Truncate(24 * time.Hour)
+				e.spentTokens = 100
+			},
+			setExpectations: func(assistantClient *assistant.MockAssistant, repo *assistant.MockShadowEvaluationRepository) {
+				assistantClient.EXPECT().
+					RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: "Hello!"}, nil).Once()
+				repo.EXPECT().
+					CreateShadowEvaluation(mock.Anything, mock.Anything).
+					Return(nil).Once()
+			},
+		},
+		"busy": {
+			turn:   turn,
+			sample: 0.1,
+			prepare: func(e *ShadowEvaluatorImpl) {
+				e.slots <- struct{}{}
+			},
+		},
+		"closed": {
+			turn:   turn,
+			sample: 0.1,
+			prepare: func(e *ShadowEvaluatorImpl) {
+				e.Close()
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assistantClient := assistant.NewMockAssistant(t)
+			repo := assistant.NewMockShadowEvaluationRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedTime).Maybe()
+			if tt.setExpectations != nil {
+				tt.setExpectations(assistantClient, repo)
+			}
+
+			evaluator := NewShadowEvaluatorImpl(log.New(io.Discard, "", 0), assistantClient, repo, timeProvider, cfg)
+			evaluator.rand = func() float64 { return tt.sample }
+			if tt.prepare != nil {
+				tt.prepare(evaluator)
+			}
+
+			evaluator.Submit(t.Context(), tt.turn)
+			evaluator.Close()
+		})
+	}
+}
+
+func TestShadowEvaluatorImpl_Submit_SpendsBudget(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC)
+	assistantClient := assistant.NewMockAssistant(t)
+	repo := assistant.NewMockShadowEvaluationRepository(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	timeProvider.EXPECT().Now().Return(fixedTime)
+
+	assistantClient.EXPECT().
+		RunTurnSync(mock.Anything, mock.Anything).
+		Return(assistant.TurnResponse{Content: "Hello!", Usage: assistant.Usage{TotalTokens: 150}}, nil).Once()
+	repo.EXPECT().
+		CreateShadowEvaluation(mock.Anything, mock.Anything).
+		Return(nil).Once()
+
+	evaluator := NewShadowEvaluatorImpl(log.New(io.Discard, "", 0), assistantClient, repo, timeProvider, ShadowConfig{
+		CandidateModel:   "candidate",
+		SampleRate:       1,
+		MaxConcurrent:    1,
+		DailyTokenBudget: 100,
+	})
+	turn := ShadowTurn{Request: assistant.TurnRequest{Model: "primary"}}
+
+	evaluator.Submit(t.Context(), turn)
+	evaluator.inFlight.Wait()
+	// The first replay exhausted the budget, so the second one is skipped.
+	evaluator.Submit(t.Context(), turn)
+	evaluator.Close()
+
+	assert.Equal(t, 150, evaluator.spentTokens)
+}
+
+func TestShadowEvaluatorImpl_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC)
+	assistantClient := assistant.NewMockAssistant(t)
+	repo := assistant.NewMockShadowEvaluationRepository(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	timeProvider.EXPECT().Now().Return(fixedTime)
+
+	assistantClient.EXPECT().
+		RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool { return req.Model == "candidate-v2" })).
+		Return(assistant.TurnResponse{Content: "Hello!", Usage: assistant.Usage{TotalTokens: 150}}, nil).Once()
+	repo.EXPECT().
+		CreateShadowEvaluation(mock.Anything, mock.MatchedBy(func(e assistant.ShadowEvaluation) bool { return e.CandidateModel == "candidate-v2" })).
+		Return(nil).Once()
+
+	evaluator := NewShadowEvaluatorImpl(log.New(io.Discard, "", 0), assistantClient, repo, timeProvider, ShadowConfig{
+		CandidateModel: "candidate",
+		SampleRate:     0,
+		MaxConcurrent:  1,
+	})
+	turn := ShadowTurn{Request: assistant.TurnRequest{Model: "primary"}}
+
+	// Nothing is sampled until the new configuration raises the sample rate.
+	evaluator.Submit(t.Context(), turn)
+	evaluator.SetConfig(ShadowConfig{CandidateModel: "candidate-v2", SampleRate: 1, MaxConcurrent: 5, DailyTokenBudget: 100})
+	evaluator.Submit(t.Context(), turn)
+	evaluator.inFlight.Wait()
+	// The new budget is exhausted by the replay, so the next turn is skipped.
+	evaluator.Submit(t.Context(), turn)
+	evaluator.Close()
+
+	assert.Equal(t, 1, evaluator.cfg.MaxConcurrent)
+	assert.Equal(t, 150, evaluator.spentTokens)
+}
+
+func TestShadowTurnRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	messages := []assistant.Message{{Role: assistant.ChatRole_User, Content: "create a todo"}}
+
+	tests := map[string]struct {
+		runErr         error
+		sandboxed      bool
+		expectedSubmit bool
+	}{
+		"success": {
+			expectedSubmit: true,
+		},
+		"sandboxed": {
+			sandboxed: true,
+		},
+		"failure": {
+			runErr: errors.New("turn failed"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "primary", Messages: messages}, 5, nil)
+			next := NewMockTurnRunner(t)
+			evaluator := NewMockShadowEvaluator(t)
+
+			next.EXPECT().
+				Run(mock.Anything, state, mock.Anything).
+				RunAndReturn(func(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+					events := []struct {
+						eventType assistant.EventType
+						data      any
+					}{
+						{assistant.EventType_ActionStarted, assistant.ActionCall{ID: "call-1", Name: "fetch_todos"}},
+						{assistant.EventType_ActionApprovalRequired, assistant.ActionApprovalRequired{ActionCallID: "call-2", Name: "delete_todo"}},
+						{assistant.EventType_ActionStarted, assistant.ActionCall{ID: "call-2", Name: "delete_todo"}},
+						{assistant.EventType_ActionCompleted, assistant.ActionCall{ID: "call-3", Name: "create_todo"}},
+					}
+					for _, event := range events {
+						if err := onEvent(ctx, event.eventType, event.data); err != nil {
+							return err
+						}
+					}
+					state.AppendRequestMessages(assistant.Message{Role: assistant.ChatRole_Tool, Content: "done"})
+					state.AppendAssistantContent("Done!")
+					return tt.runErr
+				}).Once()
+
+			if tt.expectedSubmit {
+				evaluator.EXPECT().
+					Submit(mock.Anything, ShadowTurn{
+						ConversationID: conversation.ID,
+						TurnID:         state.TurnID(),
+						Request:        assistant.TurnRequest{Model: "primary", Messages: messages},
+						Content:        "Done!",
+						Actions:        []string{"fetch_todos", "delete_todo"},
+					}).Once()
+			}
+
+			ctx := t.Context()
+			if tt.sandboxed {
+				ctx = transaction.WithSandbox(ctx)
+			}
+
+			var forwarded int
+			runner := NewShadowTurnRunner(next, evaluator)
+			err := runner.Run(ctx, state, func(ctx context.Context, eventType assistant.EventType, data any) error {
+				forwarded++
+				return nil
+			})
+			assert.Equal(t, tt.runErr, err)
+			assert.Equal(t, 4, forwarded)
+		})
+	}
+}
@@ -5,14 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -20,11 +27,20 @@ const (
 	DEFAULT_CONTEXT_COMPACTION_TIMEOUT = 20 * time.Second
 	// DEFAULT_CANCELED_TURN_REPAIR_TIMEOUT bounds cleanup work after a canceled turn.
 	DEFAULT_CANCELED_TURN_REPAIR_TIMEOUT = 3 * time.Second
+	// MODERATION_REFUSAL_MESSAGE is sent to the user in place of a turn when content moderation blocks their message.
+	MODERATION_REFUSAL_MESSAGE = "Sorry, I can't help with that request because it goes against the content policy."
 )
 
 // StreamChatParams holds optional parameters for StreamChat execution.
 type StreamChatParams struct {
 	ConversationID *uuid.UUID
+	Generation     assistant.GenerationOptions
+	// RegeneratedMessageID is the assistant message whose turn is regenerated instead of answering a new user message.
+	RegeneratedMessageID *uuid.UUID
+	// EditedMessageID is the user message replaced by the new user message. It and every later message are superseded.
+	EditedMessageID *uuid.UUID
+	// IncludeActionResults keeps the structured action results in the action_completed events.
+	IncludeActionResults bool
 }
 
 // StreamChatOption defines a functional option for configuring StreamChatParams.
@@ -37,69 +53,185 @@ func WithConversationID(conversationID uuid.UUID) StreamChatOption {
 	}
 }
 
+// WithGenerationOptions bounds the assistant response, e.g. its length or stop sequences.
+func WithGenerationOptions(generation assistant.GenerationOptions) StreamChatOption {
+	return func(params *StreamChatParams) {
+		params.Generation = generation
+	}
+}
+
+// WithRegeneratedMessage reruns the turn of an assistant message from its user message instead of answering
+// a new one. The previous response of the turn is superseded but stays retrievable.
+func WithRegeneratedMessage(messageID uuid.UUID) StreamChatOption {
+	return func(params *StreamChatParams) {
+		params.RegeneratedMessageID = &messageID
+	}
+}
+
+// WithEditedMessage resends the conversation from a previous user message with the new user message in its place.
+// The edited message and every message after it are superseded but stay retrievable.
+func WithEditedMessage(messageID uuid.UUID) StreamChatOption {
+	return func(params *StreamChatParams) {
+		params.EditedMessageID = &messageID
+	}
+}
+
+// WithActionResults includes the structured result of each action in its action_completed event,
+// so clients can render the fetched or changed data.
+func WithActionResults() StreamChatOption {
+	return func(params *StreamChatParams) {
+		params.IncludeActionResults = true
+	}
+}
+
 // StreamChat streams one assistant turn and persists the resulting conversation state.
 type StreamChat interface {
-	// Execute runs one streamed turn for the supplied user message.
+	// Execute runs one streamed turn for the supplied user message. The message is ignored, and the model
+	// defaults to the one of the regenerated message, when WithRegeneratedMessage is set. With WithEditedMessage
+	// the model defaults to the one of the edited message.
 	Execute(ctx context.Context, userMessage, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error
 }
 
 // StreamChatImpl implements StreamChat.
 type StreamChatImpl struct {
-	logger                *log.Logger
-	timeProvider          core.CurrentTimeProvider
-	conversationRepo      assistant.ConversationRepository
-	conversationCompactor ConversationCompactor
-	compactionPolicy      assistant.CompactionPolicy
-	compactionTimeout     time.Duration
-	maxActionCycles       int
-	stateBuilder          TurnStateBuilder
-	turnRunner            TurnRunner
-	transcriptWriter      ConversationTranscriptWriter
+	logger                  *log.Logger
+	timeProvider            core.CurrentTimeProvider
+	conversationRepo        assistant.ConversationRepository
+	chatMessageRepo         assistant.ChatMessageRepository
+	modelCatalog            assistant.ModelCatalog
+	conversationCompactor   ConversationCompactor
+	conversationSnapshotter ConversationSnapshotter
+	relatedConversations    RelatedConversations
+	topicShiftDetector      TopicShiftDetector
+	topicShiftAutoSplit     bool
+	compactionPolicy        assistant.CompactionPolicy
+	compactionTimeout       time.Duration
+	maxActionCycles         int
+	checkpointBytes         int
+	experiment              *assistant.Experiment
+	moderator               assistant.Moderator
+	redactor                assistant.Redactor
+	stateBuilder            TurnStateBuilder
+	turnRunner              TurnRunner
+	transcriptWriter        ConversationTranscriptWriter
+	streams                 assistant.ConversationStreams
+	turns                   *InFlightTurns
 }
 
 // NewStreamChatImpl creates a StreamChatImpl.
+// The streamed assistant content is checkpointed every checkpointBytes bytes; zero disables checkpoints.
+// Turns are admitted through turns so a shutdown can drain them; nil admits every turn.
 func NewStreamChatImpl(
 	logger *log.Logger,
 	timeProvider core.CurrentTimeProvider,
 	conversationRepo assistant.ConversationRepository,
+	chatMessageRepo assistant.ChatMessageRepository,
+	modelCatalog assistant.ModelCatalog,
 	conversationCompactor ConversationCompactor,
+	conversationSnapshotter ConversationSnapshotter,
+	relatedConversations RelatedConversations,
+	topicShiftDetector TopicShiftDetector,
+	topicShiftAutoSplit bool,
 	compactionPolicy assistant.CompactionPolicy,
 	compactionTimeout time.Duration,
 	maxActionCycles int,
+	checkpointBytes int,
+	experiment *assistant.Experiment,
+	moderator assistant.Moderator,
+	redactor assistant.Redactor,
 	stateBuilder TurnStateBuilder,
 	turnRunner TurnRunner,
 	transcriptWriter ConversationTranscriptWriter,
+	streams assistant.ConversationStreams,
+	turns *InFlightTurns,
 ) StreamChatImpl {
 	return StreamChatImpl{
-		logger:                logger,
-		timeProvider:          timeProvider,
-		conversationRepo:      conversationRepo,
-		conversationCompactor: conversationCompactor,
-		compactionPolicy:      compactionPolicy,
-		compactionTimeout:     compactionTimeout,
-		maxActionCycles:       maxActionCycles,
-		stateBuilder:          stateBuilder,
-		turnRunner:            turnRunner,
-		transcriptWriter:      transcriptWriter,
+		logger:                  logger,
+		timeProvider:            timeProvider,
+		conversationRepo:        conversationRepo,
+		chatMessageRepo:         chatMessageRepo,
+		modelCatalog:            modelCatalog,
+		conversationCompactor:   conversationCompactor,
+		conversationSnapshotter: conversationSnapshotter,
+		relatedConversations:    relatedConversations,
+		topicShiftDetector:      topicShiftDetector,
+		topicShiftAutoSplit:     topicShiftAutoSplit,
+		compactionPolicy:        compactionPolicy,
+		compactionTimeout:       compactionTimeout,
+		maxActionCycles:         maxActionCycles,
+		checkpointBytes:         checkpointBytes,
+		experiment:              experiment,
+		moderator:               moderator,
+		redactor:                redactor,
+		stateBuilder:            stateBuilder,
+		turnRunner:              turnRunner,
+		transcriptWriter:        transcriptWriter,
+		streams:                 streams,
+		turns:                   turns,
 	}
 }
 
 // Execute implements StreamChat.
 func (sc StreamChatImpl) Execute(ctx context.Context, userMessage, model string, onEvent assistant.EventCallback, opts ...StreamChatOption) error {
+	ctx, end, err := sc.turns.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer end()
+
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
+	params := &StreamChatParams{}
+	for _, opt := range opts {
+		opt(params)
+	}
+	if !params.IncludeActionResults {
+		onEvent = withoutActionResults(onEvent)
+	}
+	if params.RegeneratedMessageID != nil {
+		err := sc.regenerate(spanCtx, model, params, onEvent)
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+
 	if strings.TrimSpace(userMessage) == "" {
 		return core.NewValidationErr("message cannot be empty")
 	}
 
+	var edit editTarget
+	if params.EditedMessageID != nil {
+		edit, err = sc.loadEditTarget(spanCtx, params)
+		if telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+		if model == "" {
+			model = edit.model
+		}
+	}
+
 	if model == "" {
 		return core.NewValidationErr("model cannot be empty")
 	}
 
-	params := &StreamChatParams{}
-	for _, opt := range opts {
-		opt(params)
+	generation, maxActionCycles, err := sc.applyTenantSettings(spanCtx, model, params.Generation)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	params.Generation = generation
+
+	if err := sc.validateGenerationOptions(spanCtx, model, params.Generation); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	moderation, err := sc.moderate(spanCtx, userMessage)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if moderation.Flagged {
+		err := sc.refuseModeratedMessage(spanCtx, params.ConversationID, userMessage, model, moderation, onEvent)
+		telemetry.IsErrorRecorded(span, err)
+		return err
 	}
 
 	conversation, conversationCreated, err := sc.createOrRetrieveConversation(spanCtx, params.ConversationID, userMessage)
@@ -107,16 +239,45 @@ func (sc StreamChatImpl) Execute(ctx context.Context, userMessage, model string,
 		return err
 	}
 
+	onEvent = synchronizedEventCallback(onEvent)
+	// Edits stay in their conversation: the new message replaces one that already belonged to its topic.
+	if params.EditedMessageID != nil {
+		if err := sc.rewindToEditedMessage(spanCtx, conversation.ID, edit); telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+	} else if !conversationCreated {
+		conversation, conversationCreated, err = sc.handleTopicShift(spanCtx, conversation, userMessage, onEvent)
+		if telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+	}
+
+	detach := sc.attachStream(ctx, conversation.ID, onEvent)
+	defer detach()
+
 	if err := sc.compactIfNeeded(spanCtx, conversation.ID, onEvent); telemetry.IsErrorRecorded(span, err) {
 		return err
 	}
 
+	model, err = sc.applyConversationSettings(spanCtx, conversation.Settings, model, params.Generation)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	// Conversations with their own settings stay out of experiments so they do not skew the variant results.
+	var experiment *assistant.ExperimentAssignment
+	if conversation.Settings.IsZero() {
+		experiment, model = sc.assignExperiment(spanCtx, conversation.ID, model, params.Generation)
+	}
+
 	state, err := sc.stateBuilder.Build(spanCtx, BuildTurnStateParams{
 		UserMessage:         userMessage,
 		Model:               model,
-		MaxActionCycles:     sc.maxActionCycles,
+		MaxActionCycles:     maxActionCycles,
 		Conversation:        conversation,
 		ConversationCreated: conversationCreated,
+		Generation:          params.Generation,
+		Experiment:          experiment,
 	})
 	if telemetry.IsErrorRecorded(span, err) {
 		return err
@@ -132,44 +293,262 @@ func (sc StreamChatImpl) Execute(ctx context.Context, userMessage, model string,
 		Content:        userMessage,
 		Model:          model,
 		MessageState:   assistant.ChatMessageState_Completed,
+		Experiment:     state.Experiment(),
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
-	if err := sc.transcriptWriter.WriteMessage(spanCtx, state.Conversation(), userChatMessage); telemetry.IsErrorRecorded(span, err) {
+	if err := writeTurnMessage(spanCtx, sc.transcriptWriter, state, userChatMessage); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return sc.runTurn(ctx, state, onEvent)
+}
+
+// regenerationTarget is the latest turn of a conversation whose response is regenerated.
+type regenerationTarget struct {
+	userMessage assistant.ChatMessage
+	// model is the model of the regenerated message, used when the request does not pick another one.
+	model string
+	// supersededIDs are the current assistant and tool messages of the turn the regenerated response replaces.
+	supersededIDs []uuid.UUID
+	nextSequence  int64
+}
+
+// regenerate reruns the latest turn of a conversation from its user message and supersedes its previous response.
+// The user message is not moderated again, and regenerated turns are not enrolled in experiments.
+func (sc StreamChatImpl) regenerate(
+	ctx context.Context,
+	model string,
+	params *StreamChatParams,
+	onEvent assistant.EventCallback,
+) error {
+	if params.ConversationID == nil {
+		return core.NewValidationErr("conversation ID is required to regenerate a message")
+	}
+	conversation, found, err := sc.conversationRepo.GetConversation(ctx, *params.ConversationID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", *params.ConversationID))
+	}
+
+	target, err := sc.loadRegenerationTarget(ctx, conversation.ID, *params.RegeneratedMessageID)
+	if err != nil {
+		return err
+	}
+	if model == "" {
+		model = target.model
+	}
+
+	generation, maxActionCycles, err := sc.applyTenantSettings(ctx, model, params.Generation)
+	if err != nil {
+		return err
+	}
+	if err := sc.validateGenerationOptions(ctx, model, generation); err != nil {
+		return err
+	}
+
+	onEvent = synchronizedEventCallback(onEvent)
+	detach := sc.attachStream(ctx, conversation.ID, onEvent)
+	defer detach()
+
+	model, err = sc.applyConversationSettings(ctx, conversation.Settings, model, generation)
+	if err != nil {
+		return err
+	}
+
+	state, err := sc.stateBuilder.Build(ctx, BuildTurnStateParams{
+		UserMessage:     target.userMessage.Content,
+		Model:           model,
+		MaxActionCycles: maxActionCycles,
+		Conversation:    conversation,
+		Generation:      generation,
+		HistoryBefore:   &target.userMessage.ID,
+		TurnID:          target.userMessage.TurnID,
+		TurnSequence:    target.nextSequence,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := sc.chatMessageRepo.SupersedeChatMessages(ctx, target.supersededIDs, sc.timeProvider.Now()); err != nil {
 		return err
 	}
 
-	if err := sc.turnRunner.Run(spanCtx, state, onEvent); telemetry.IsErrorRecorded(span, err) {
+	return sc.runTurn(ctx, state, onEvent)
+}
+
+// loadRegenerationTarget loads the latest turn of the conversation and checks the message is one of its assistant
+// messages. Only the latest turn can be regenerated, so the new response never lands before later turns.
+func (sc StreamChatImpl) loadRegenerationTarget(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	messageID uuid.UUID,
+) (regenerationTarget, error) {
+	latest, _, err := sc.chatMessageRepo.ListChatMessages(ctx, conversationID, 1, 1)
+	if err != nil {
+		return regenerationTarget{}, err
+	}
+	if len(latest) == 0 {
+		return regenerationTarget{}, core.NewNotFoundErr(fmt.Sprintf("message with ID %s not found", messageID))
+	}
+
+	turnMessages, _, err := sc.chatMessageRepo.ListChatMessages(ctx, conversationID, 1, 0, assistant.WithChatMessagesTurnID(latest[0].TurnID))
+	if err != nil {
+		return regenerationTarget{}, err
+	}
+
+	target := regenerationTarget{}
+	found := false
+	for _, message := range turnMessages {
+		target.nextSequence = max(target.nextSequence, message.TurnSequence+1)
+		switch {
+		case message.ChatRole == assistant.ChatRole_User:
+			target.userMessage = message
+		case message.ID == messageID && message.ChatRole == assistant.ChatRole_Assistant:
+			target.model = message.Model
+			found = true
+		}
+		if message.IsApprovalPending() && !message.IsSuperseded() {
+			return regenerationTarget{}, core.NewValidationErr("turn is waiting for an action approval")
+		}
+		if (message.ChatRole == assistant.ChatRole_Assistant || message.ChatRole == assistant.ChatRole_Tool) && !message.IsSuperseded() {
+			target.supersededIDs = append(target.supersededIDs, message.ID)
+		}
+	}
+	if !found {
+		return regenerationTarget{}, core.NewValidationErr("only assistant messages of the latest turn can be regenerated")
+	}
+	if target.userMessage.ID == uuid.Nil || target.userMessage.IsModerated() {
+		return regenerationTarget{}, core.NewValidationErr("turn has no user message to regenerate from")
+	}
+	return target, nil
+}
+
+// editTarget is a user message resent with new content, and the messages the resend supersedes.
+type editTarget struct {
+	messageID uuid.UUID
+	// model is the model of the edited message, used when the request does not pick another one.
+	model string
+	// supersededIDs are the edited message and the current messages recorded after it.
+	supersededIDs []uuid.UUID
+}
+
+// loadEditTarget checks the edited message is a current user message of the conversation and collects the
+// messages the resend supersedes.
+func (sc StreamChatImpl) loadEditTarget(ctx context.Context, params *StreamChatParams) (editTarget, error) {
+	if params.ConversationID == nil {
+		return editTarget{}, core.NewValidationErr("conversation ID is required to edit a message")
+	}
+	messageID := *params.EditedMessageID
+
+	messages, _, err := sc.chatMessageRepo.ListChatMessages(ctx, *params.ConversationID, 1, 0)
+	if err != nil {
+		return editTarget{}, err
+	}
+	idx := slices.IndexFunc(messages, func(message assistant.ChatMessage) bool {
+		return message.ID == messageID
+	})
+	if idx < 0 {
+		return editTarget{}, core.NewNotFoundErr(fmt.Sprintf("message with ID %s not found", messageID))
+	}
+	edited := messages[idx]
+	if edited.ChatRole != assistant.ChatRole_User || edited.IsSuperseded() {
+		return editTarget{}, core.NewValidationErr("only current user messages can be edited")
+	}
+
+	target := editTarget{messageID: messageID, model: edited.Model}
+	for _, message := range messages[idx:] {
+		if message.IsSuperseded() {
+			continue
+		}
+		if message.IsApprovalPending() {
+			return editTarget{}, core.NewValidationErr("conversation is waiting for an action approval")
+		}
+		target.supersededIDs = append(target.supersededIDs, message.ID)
+	}
+	return target, nil
+}
+
+// rewindToEditedMessage supersedes the edited message and everything after it. The compacted memory is rebuilt
+// first, so a failure leaves the conversation untouched.
+func (sc StreamChatImpl) rewindToEditedMessage(ctx context.Context, conversationID uuid.UUID, target editTarget) error {
+	if sc.conversationCompactor != nil {
+		compactCtx, cancel := context.WithTimeout(ctx, sc.compactionTimeout)
+		defer cancel()
+
+		if err := sc.conversationCompactor.Recompact(compactCtx, conversationID, target.messageID); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = fmt.Errorf("context compaction timed out after %s", sc.compactionTimeout)
+			}
+			return err
+		}
+		// A missing snapshot only widens the next prompt, so snapshot failures do not fail the turn.
+		if sc.conversationSnapshotter != nil {
+			if err := sc.conversationSnapshotter.Snapshot(compactCtx, conversationID); err != nil && sc.logger != nil {
+				sc.logger.Printf("StreamChat: conversation snapshot failed for conversation %s: %v", conversationID, err)
+			}
+		}
+		sc.indexConversationTopic(compactCtx, conversationID)
+	}
+
+	return sc.chatMessageRepo.SupersedeChatMessages(ctx, target.supersededIDs, sc.timeProvider.Now())
+}
+
+// runTurn streams the prepared turn and persists its final assistant message. Failed turns are repaired
+// and recorded with a failure message before the failure is reported on the stream. Canceled turns are only
+// recorded when a shutdown interrupted them.
+func (sc StreamChatImpl) runTurn(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	// Checkpoints recover interrupted turns, which a sandbox discards anyway.
+	checkpointBytes := sc.checkpointBytes
+	if transaction.InSandbox(ctx) {
+		checkpointBytes = 0
+	}
+	checkpointer := newStreamCheckpointer(sc.transcriptWriter, sc.timeProvider, sc.logger, state, checkpointBytes)
+	if err := sc.turnRunner.Run(spanCtx, state, checkpointer.Observe(onEvent)); telemetry.IsErrorRecorded(span, err) {
 		if repairErr := sc.repairFailedTurn(ctx, state); telemetry.IsErrorRecorded(span, repairErr) {
 			return errors.Join(err, repairErr)
 		}
 		if isCanceledTurnError(err) {
-			return err
+			if !errors.Is(context.Cause(ctx), ErrShuttingDown) {
+				return err
+			}
+			err = assistant.NewTurnError(assistant.TurnErrorCode_Shutdown, 0, ErrShuttingDown)
+			var cancel context.CancelFunc
+			spanCtx, cancel = context.WithTimeout(context.WithoutCancel(spanCtx), DEFAULT_CANCELED_TURN_REPAIR_TIMEOUT)
+			defer cancel()
 		}
 		failedAt := sc.timeProvider.Now()
-		failureMsg := sc.buildFailureAssistantMessage(state, failedAt, err)
-		if persistErr := sc.transcriptWriter.WriteMessage(spanCtx, state.Conversation(), failureMsg); telemetry.IsErrorRecorded(span, persistErr) {
+		failureMsg := sc.buildFailureAssistantMessage(state, checkpointer.MessageID(), failedAt, err)
+		if persistErr := writeTurnMessage(spanCtx, sc.transcriptWriter, state, failureMsg); telemetry.IsErrorRecorded(span, persistErr) {
 			return persistErr
 		}
-		return err
+		return sc.reportTurnFailure(ctx, state, err, onEvent)
 	}
 
 	completedAt := sc.timeProvider.Now()
 	assistantMsg := assistant.ChatMessage{
-		ID:               uuid.New(),
-		ConversationID:   state.Conversation().ID,
-		TurnID:           state.TurnID(),
-		TurnSequence:     state.NextTurnSequence(),
-		ChatRole:         assistant.ChatRole_Assistant,
-		Content:          state.AssistantContent(),
-		SelectedSkills:   state.SelectedSkills(),
-		Model:            state.Model(),
-		MessageState:     assistant.ChatMessageState_Completed,
-		PromptTokens:     state.TokenUsage().PromptTokens,
-		CompletionTokens: state.TokenUsage().CompletionTokens,
-		TotalTokens:      state.TokenUsage().TotalTokens,
-		CreatedAt:        completedAt,
-		UpdatedAt:        completedAt,
+		ID:                 checkpointer.MessageID(),
+		ConversationID:     state.Conversation().ID,
+		TurnID:             state.TurnID(),
+		TurnSequence:       state.NextTurnSequence(),
+		ChatRole:           assistant.ChatRole_Assistant,
+		Content:            state.AssistantContent(),
+		SelectedSkills:     state.SelectedSkills(),
+		Model:              state.Model(),
+		Seed:               state.Seed(),
+		MessageState:       assistant.ChatMessageState_Completed,
+		PromptTokens:       state.TokenUsage().PromptTokens,
+		CompletionTokens:   state.TokenUsage().CompletionTokens,
+		TotalTokens:        state.TokenUsage().TotalTokens,
+		Experiment:         state.Experiment(),
+		ActionLoopDetected: state.ActionLoopDetected(),
+		CreatedAt:          completedAt,
+		UpdatedAt:          completedAt,
 	}
 
 	if assistantMsg.Content == "" {
@@ -183,22 +562,80 @@ func (sc StreamChatImpl) Execute(ctx context.Context, userMessage, model string,
 		}
 	}
 
-	err = sc.transcriptWriter.WriteMessage(spanCtx, state.Conversation(), assistantMsg)
+	err := writeTurnMessage(spanCtx, sc.transcriptWriter, state, assistantMsg)
 	if telemetry.IsErrorRecorded(span, err) {
 		return err
 	}
 
 	tokenUsage := state.TokenUsage()
 	metrics.RecordLLMTokensUsed(spanCtx, tokenUsage.PromptTokens, tokenUsage.CompletionTokens)
+	latency := state.Latency()
+	recordTurnLatency(spanCtx, state.Model(), latency)
 
 	if err := onEvent(ctx, assistant.EventType_TurnCompleted, assistant.TurnCompleted{
-		Usage: tokenUsage,
+		Usage:   tokenUsage,
+		Latency: &latency,
 	}); telemetry.IsErrorRecorded(span, err) {
 		return err
 	}
 	return nil
 }
 
+// recordTurnLatency records the latency breakdown of a completed turn on its span and in the phase duration metrics.
+func recordTurnLatency(ctx context.Context, model string, latency assistant.TurnLatency) {
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.Int64("latency.history_fetch_ms", latency.HistoryFetchMs),
+		attribute.Int64("latency.prompt_build_ms", latency.PromptBuildMs),
+		attribute.Int64("latency.time_to_first_token_ms", latency.TimeToFirstTokenMs),
+		attribute.Int64("latency.persistence_ms", latency.PersistenceMs),
+		attribute.Int64("latency.total_ms", latency.TotalMs),
+		attribute.Int("latency.action_count", len(latency.Actions)),
+	)
+	metrics.RecordChatTurnPhase(ctx, model, string(TurnPhase_HistoryFetch), msDuration(latency.HistoryFetchMs))
+	metrics.RecordChatTurnPhase(ctx, model, string(TurnPhase_PromptBuild), msDuration(latency.PromptBuildMs))
+	metrics.RecordChatTurnPhase(ctx, model, string(TurnPhase_FirstToken), msDuration(latency.TimeToFirstTokenMs))
+	metrics.RecordChatTurnPhase(ctx, model, string(TurnPhase_Persistence), msDuration(latency.PersistenceMs))
+	for _, action := range latency.Actions {
+		metrics.RecordAssistantActionDuration(ctx, action.Name, msDuration(action.DurationMs))
+	}
+}
+
+// msDuration converts milliseconds to a duration.
+func msDuration(ms int64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
+
+// attachStream attaches onEvent to the conversation streams so out-of-band events reach it. Turns run in a
+// sandbox stay detached: their conversation state is discarded, so they must not receive its real events.
+func (sc StreamChatImpl) attachStream(ctx context.Context, conversationID uuid.UUID, onEvent assistant.EventCallback) func() {
+	if transaction.InSandbox(ctx) {
+		return func() {}
+	}
+	return sc.streams.Attach(conversationID, onEvent)
+}
+
+// synchronizedEventCallback serializes calls to onEvent so events emitted out-of-band
+// through the conversation streams never interleave with the turn events.
+func synchronizedEventCallback(onEvent assistant.EventCallback) assistant.EventCallback {
+	var mu sync.Mutex
+	return func(ctx context.Context, eventType assistant.EventType, data any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return onEvent(ctx, eventType, data)
+	}
+}
+
+// withoutActionResults drops the structured action results from the action_completed events passed to onEvent.
+func withoutActionResults(onEvent assistant.EventCallback) assistant.EventCallback {
+	return func(ctx context.Context, eventType assistant.EventType, data any) error {
+		if completed, ok := data.(assistant.ActionCompleted); ok {
+			completed.Result = nil
+			data = completed
+		}
+		return onEvent(ctx, eventType, data)
+	}
+}
+
 // repairFailedTurn performs detached cleanup so failed turns do not leave dangling assistant tool-call messages in history.
 func (sc StreamChatImpl) repairFailedTurn(ctx context.Context, state TurnState) error {
 	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DEFAULT_CANCELED_TURN_REPAIR_TIMEOUT)
@@ -212,9 +649,37 @@ func isCanceledTurnError(err error) bool {
 	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
 }
 
+// reportTurnFailure emits a turn_failed event with the failure classification and returns the classified error,
+// so callers can tell the failure was already reported on the stream.
+func (sc StreamChatImpl) reportTurnFailure(
+	ctx context.Context,
+	state TurnState,
+	err error,
+	onEvent assistant.EventCallback,
+) error {
+	turnErr := assistant.ClassifyTurnError(err)
+	event := assistant.TurnFailed{
+		ConversationID: state.Conversation().ID,
+		TurnID:         state.TurnID(),
+		Code:           turnErr.Code,
+		Error:          turnErr.Error(),
+		Retriable:      turnErr.Retriable(),
+	}
+	if turnErr.RetryAfter > 0 {
+		retryAfterSeconds := int(math.Ceil(turnErr.RetryAfter.Seconds()))
+		event.RetryAfterSeconds = &retryAfterSeconds
+	}
+
+	if emitErr := onEvent(ctx, assistant.EventType_TurnFailed, event); emitErr != nil && sc.logger != nil {
+		sc.logger.Printf("StreamChat: turn failure notification failed for conversation %s: %v", event.ConversationID, emitErr)
+	}
+	return turnErr
+}
+
 // buildFailureAssistantMessage creates the persisted assistant failure message from the use-case-owned turn state.
 func (sc StreamChatImpl) buildFailureAssistantMessage(
 	state TurnState,
+	messageID uuid.UUID,
 	now time.Time,
 	streamErr error,
 ) assistant.ChatMessage {
@@ -227,21 +692,24 @@ func (sc StreamChatImpl) buildFailureAssistantMessage(
 	tokenUsage := state.TokenUsage()
 
 	return assistant.ChatMessage{
-		ID:               uuid.New(),
-		ConversationID:   state.Conversation().ID,
-		TurnID:           state.TurnID(),
-		TurnSequence:     state.NextTurnSequence(),
-		ChatRole:         assistant.ChatRole_Assistant,
-		Content:          content,
-		SelectedSkills:   state.SelectedSkills(),
-		Model:            state.Model(),
-		MessageState:     assistant.ChatMessageState_Failed,
-		ErrorMessage:     &errorMessage,
-		PromptTokens:     tokenUsage.PromptTokens,
-		CompletionTokens: tokenUsage.CompletionTokens,
-		TotalTokens:      tokenUsage.TotalTokens,
-		CreatedAt:        now,
-		UpdatedAt:        now,
+		ID:                 messageID,
+		ConversationID:     state.Conversation().ID,
+		TurnID:             state.TurnID(),
+		TurnSequence:       state.NextTurnSequence(),
+		ChatRole:           assistant.ChatRole_Assistant,
+		Content:            content,
+		SelectedSkills:     state.SelectedSkills(),
+		Model:              state.Model(),
+		Seed:               state.Seed(),
+		MessageState:       assistant.ChatMessageState_Failed,
+		ErrorMessage:       &errorMessage,
+		PromptTokens:       tokenUsage.PromptTokens,
+		CompletionTokens:   tokenUsage.CompletionTokens,
+		TotalTokens:        tokenUsage.TotalTokens,
+		Experiment:         state.Experiment(),
+		ActionLoopDetected: state.ActionLoopDetected(),
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 }
 
@@ -252,7 +720,10 @@ func (sc StreamChatImpl) createOrRetrieveConversation(
 	userMessage string,
 ) (assistant.Conversation, bool, error) {
 	if conversationID == nil {
-		title := assistant.GenerateAutoConversationTitle(userMessage)
+		title, err := sc.autoConversationTitle(userMessage)
+		if err != nil {
+			return assistant.Conversation{}, false, err
+		}
 		conversation, err := sc.conversationRepo.CreateConversation(ctx, title, assistant.ConversationTitleSource_Auto)
 		if err != nil {
 			return assistant.Conversation{}, false, err
@@ -271,13 +742,263 @@ func (sc StreamChatImpl) createOrRetrieveConversation(
 	return conversation, false, nil
 }
 
+// moderate screens the user message with the configured moderator. Moderation is disabled when no
+// moderator is configured; moderator failures fail the request so unscreened content never reaches the model.
+func (sc StreamChatImpl) moderate(ctx context.Context, userMessage string) (assistant.ModerationResult, error) {
+	if sc.moderator == nil {
+		return assistant.ModerationResult{}, nil
+	}
+	result, err := sc.moderator.Moderate(ctx, userMessage)
+	if err != nil {
+		return assistant.ModerationResult{}, fmt.Errorf("failed to moderate user message: %w", err)
+	}
+	return result, nil
+}
+
+// refuseModeratedMessage persists a user message blocked by content moderation for audit and emits
+// the refusal instead of running a turn. The blocked content is kept out of the title of a new conversation.
+func (sc StreamChatImpl) refuseModeratedMessage(
+	ctx context.Context,
+	conversationID *uuid.UUID,
+	userMessage, model string,
+	result assistant.ModerationResult,
+	onEvent assistant.EventCallback,
+) error {
+	conversation, conversationCreated, err := sc.createOrRetrieveConversation(ctx, conversationID, "")
+	if err != nil {
+		return err
+	}
+
+	reason := "blocked by content moderation"
+	if len(result.Categories) > 0 {
+		reason += ": " + strings.Join(result.Categories, ", ")
+	}
+	now := sc.timeProvider.Now()
+	message := assistant.ChatMessage{
+		ID:             uuid.New(),
+		ConversationID: conversation.ID,
+		TurnID:         uuid.New(),
+		ChatRole:       assistant.ChatRole_User,
+		Content:        userMessage,
+		Model:          model,
+		MessageState:   assistant.ChatMessageState_Moderated,
+		ErrorMessage:   &reason,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := sc.transcriptWriter.WriteMessage(ctx, conversation, message); err != nil {
+		return err
+	}
+	if sc.logger != nil {
+		sc.logger.Printf("StreamChat: message %s in conversation %s %s", message.ID, conversation.ID, reason)
+	}
+
+	return onEvent(ctx, assistant.EventType_MessageModerated, assistant.MessageModerated{
+		ConversationID:      conversation.ID,
+		ConversationCreated: conversationCreated,
+		TurnID:              message.TurnID,
+		MessageID:           message.ID,
+		Categories:          result.Categories,
+		Message:             MODERATION_REFUSAL_MESSAGE,
+	})
+}
+
+// autoConversationTitle derives the title of a new conversation from the user message. The title is
+// redacted like the message itself, so sensitive content does not reach Postgres through it.
+func (sc StreamChatImpl) autoConversationTitle(userMessage string) (string, error) {
+	title := assistant.GenerateAutoConversationTitle(userMessage)
+	if sc.redactor == nil {
+		return title, nil
+	}
+	redacted, err := sc.redactor.Redact(title)
+	if err != nil {
+		return "", fmt.Errorf("failed to redact conversation title: %w", err)
+	}
+	return redacted, nil
+}
+
+// applyTenantSettings applies the overrides of the tenant ctx is scoped to: the models it may use,
+// its output token budget and its action cycle limit. It returns the generation options bounded by
+// the budget and the action cycle limit of the turn.
+func (sc StreamChatImpl) applyTenantSettings(
+	ctx context.Context,
+	model string,
+	generation assistant.GenerationOptions,
+) (assistant.GenerationOptions, int, error) {
+	settings := tenant.FromContext(ctx).Settings
+	if !settings.AllowsModel(model) {
+		return generation, 0, core.NewValidationErr(fmt.Sprintf("model %s is not enabled for this tenant", model))
+	}
+
+	// Requests without max_tokens get the budget itself, so the tenant limit always applies.
+	if budget := settings.MaxOutputTokens; budget > 0 && (generation.MaxTokens == nil || *generation.MaxTokens > budget) {
+		generation.MaxTokens = &budget
+	}
+
+	maxActionCycles := sc.maxActionCycles
+	if settings.MaxActionCycles > 0 {
+		maxActionCycles = settings.MaxActionCycles
+	}
+	return generation, maxActionCycles, nil
+}
+
+// applyConversationSettings returns the model the turn runs on once the conversation settings are applied.
+// A model override must be enabled for the tenant and support the generation options of the turn.
+func (sc StreamChatImpl) applyConversationSettings(
+	ctx context.Context,
+	settings assistant.ConversationSettings,
+	model string,
+	generation assistant.GenerationOptions,
+) (string, error) {
+	if settings.Model == "" || settings.Model == model {
+		return model, nil
+	}
+	if !tenant.FromContext(ctx).Settings.AllowsModel(settings.Model) {
+		return model, core.NewValidationErr(fmt.Sprintf("model %s is not enabled for this tenant", settings.Model))
+	}
+	if err := sc.validateGenerationOptions(ctx, settings.Model, generation); err != nil {
+		return model, err
+	}
+	return settings.Model, nil
+}
+
+// assignExperiment enrolls the conversation in the active experiment and returns its assignment with the
+// model the turn runs on. Conversations stay out of the experiment, keeping the requested model, when the
+// variant model is not enabled for the tenant or does not support the generation options, or when the turn
+// runs in a sandbox.
+func (sc StreamChatImpl) assignExperiment(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	model string,
+	generation assistant.GenerationOptions,
+) (*assistant.ExperimentAssignment, string) {
+	if sc.experiment == nil || transaction.InSandbox(ctx) {
+		return nil, model
+	}
+
+	variant := sc.experiment.Assign(conversationID)
+	if variant.Model != "" && variant.Model != model {
+		if !tenant.FromContext(ctx).Settings.AllowsModel(variant.Model) {
+			return nil, model
+		}
+		if err := sc.validateGenerationOptions(ctx, variant.Model, generation); err != nil {
+			if sc.logger != nil {
+				sc.logger.Printf("StreamChat: experiment %s variant %s skipped for conversation %s: %v",
+					sc.experiment.Name, variant.Name, conversationID, err)
+			}
+			return nil, model
+		}
+		model = variant.Model
+	}
+
+	assignment := assistant.NewExperimentAssignment(*sc.experiment, variant)
+	return &assignment, model
+}
+
+// validateGenerationOptions checks client-supplied generation options against the limits of the requested model.
+func (sc StreamChatImpl) validateGenerationOptions(
+	ctx context.Context,
+	model string,
+	generation assistant.GenerationOptions,
+) error {
+	if generation.IsZero() {
+		return nil
+	}
+
+	capabilities := assistant.ModelCapabilities{ID: model}
+	if sc.modelCatalog != nil {
+		models, err := sc.modelCatalog.ListModels(ctx)
+		if err != nil {
+			return err
+		}
+		idx := slices.IndexFunc(models, func(m assistant.ModelCapabilities) bool { return m.ID == model })
+		if idx < 0 {
+			return core.NewValidationErr(fmt.Sprintf("model %s is not available", model))
+		}
+		capabilities = models[idx]
+	}
+
+	return generation.Validate(capabilities)
+}
+
+// handleTopicShift checks whether the user message drifted away from the conversation topic.
+// It either suggests starting a new conversation or, when auto-split is enabled, moves the turn
+// to a new conversation that carries over the pinned todos. Detection failures never fail the turn.
+// Turns run in a sandbox stay in their conversation.
+func (sc StreamChatImpl) handleTopicShift(
+	ctx context.Context,
+	conversation assistant.Conversation,
+	userMessage string,
+	onEvent assistant.EventCallback,
+) (assistant.Conversation, bool, error) {
+	if sc.topicShiftDetector == nil || transaction.InSandbox(ctx) {
+		return conversation, false, nil
+	}
+
+	shift, err := sc.topicShiftDetector.Detect(ctx, conversation.ID, userMessage)
+	if err != nil {
+		if sc.logger != nil {
+			sc.logger.Printf("StreamChat: topic shift detection failed for conversation %s: %v", conversation.ID, err)
+		}
+		return conversation, false, nil
+	}
+	if !shift.Detected {
+		return conversation, false, nil
+	}
+
+	if !sc.topicShiftAutoSplit {
+		return conversation, false, onEvent(ctx, assistant.EventType_TopicShiftSuggested, assistant.TopicShiftSuggested{
+			ConversationID: conversation.ID,
+			Distance:       shift.Distance,
+			Threshold:      shift.Threshold,
+		})
+	}
+
+	title := assistant.GenerateAutoConversationTitle(userMessage)
+	splitConversation, err := sc.conversationRepo.CreateConversation(ctx, title, assistant.ConversationTitleSource_Auto)
+	if err != nil {
+		return assistant.Conversation{}, false, err
+	}
+
+	pinnedTodos := []assistant.PinnedTodo{}
+	if sc.conversationSnapshotter != nil {
+		carried, err := sc.conversationSnapshotter.CarryOver(ctx, conversation.ID, splitConversation.ID)
+		if err != nil && sc.logger != nil {
+			sc.logger.Printf("StreamChat: pinned todos carry over failed for conversation %s: %v", conversation.ID, err)
+		}
+		if err == nil {
+			pinnedTodos = carried
+		}
+	}
+
+	return splitConversation, true, onEvent(ctx, assistant.EventType_ConversationSplit, assistant.ConversationSplit{
+		PreviousConversationID: conversation.ID,
+		ConversationID:         splitConversation.ID,
+		Distance:               shift.Distance,
+		Threshold:              shift.Threshold,
+		PinnedTodos:            pinnedTodos,
+	})
+}
+
+// indexConversationTopic refreshes the conversation in the topic index after its summary changed.
+// A stale topic only affects related conversation lookups, so indexing failures do not fail the turn.
+func (sc StreamChatImpl) indexConversationTopic(ctx context.Context, conversationID uuid.UUID) {
+	if sc.relatedConversations == nil {
+		return
+	}
+	if err := sc.relatedConversations.Index(ctx, conversationID); err != nil && sc.logger != nil {
+		sc.logger.Printf("StreamChat: conversation topic indexing failed for conversation %s: %v", conversationID, err)
+	}
+}
+
 // compactIfNeeded evaluates and runs pre-turn context compaction while emitting the corresponding stream events.
+// Turns run in a sandbox are not compacted, since the summaries would be discarded with the sandbox.
 func (sc StreamChatImpl) compactIfNeeded(
 	ctx context.Context,
 	conversationID uuid.UUID,
 	onEvent assistant.EventCallback,
 ) error {
-	if sc.conversationCompactor == nil {
+	if sc.conversationCompactor == nil || transaction.InSandbox(ctx) {
 		return nil
 	}
 
@@ -333,6 +1054,14 @@ func (sc StreamChatImpl) compactIfNeeded(
 		})
 	}
 
+	// A missing snapshot only widens the next prompt, so snapshot failures do not fail the turn.
+	if sc.conversationSnapshotter != nil {
+		if err := sc.conversationSnapshotter.Snapshot(compactCtx, conversationID); err != nil && sc.logger != nil {
+			sc.logger.Printf("StreamChat: conversation snapshot failed for conversation %s: %v", conversationID, err)
+		}
+	}
+	sc.indexConversationTopic(compactCtx, conversationID)
+
 	return onEvent(ctx, assistant.EventType_ContextCompactionCompleted, assistant.ContextCompactionCompleted{
 		ConversationID:           conversationID,
 		UnsummarizedMessageCount: decision.MessageCount,
@@ -176,7 +176,7 @@ func TestStreamChatImpl_Execute_ActionApprovalFlows(t *testing.T) {
 				Once()
 
 			actionRegistry.EXPECT().
-				GetDefinition(actionName).
+				GetDefinition(mock.Anything, actionName).
 				Return(actionDefinition, true).
 				Once()
 
@@ -0,0 +1,263 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStreamChatImpl_Execute_EditsMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("80000000-0000-0000-0000-000000000001")
+	firstUserMessageID := uuid.MustParse("80000000-0000-0000-0000-000000000002")
+	firstReplyID := uuid.MustParse("80000000-0000-0000-0000-000000000003")
+	editedMessageID := uuid.MustParse("80000000-0000-0000-0000-000000000004")
+	editedReplyID := uuid.MustParse("80000000-0000-0000-0000-000000000005")
+	regeneratedReplyID := uuid.MustParse("80000000-0000-0000-0000-000000000006")
+	laterUserMessageID := uuid.MustParse("80000000-0000-0000-0000-000000000007")
+	fixedTime := time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC)
+	supersededAt := fixedTime.Add(-time.Hour)
+	conversation := assistant.Conversation{ID: conversationID}
+	pending := assistant.ChatMessageApprovalStatus_Pending
+
+	conversationMessages := []assistant.ChatMessage{
+		{ID: firstUserMessageID, ChatRole: assistant.ChatRole_User, Content: "Add milk", Model: "original-model"},
+		{ID: firstReplyID, ChatRole: assistant.ChatRole_Assistant, Content: "Added."},
+		{ID: editedMessageID, ChatRole: assistant.ChatRole_User, Content: "Add egs", Model: "original-model"},
+		{ID: editedReplyID, ChatRole: assistant.ChatRole_Assistant, Content: "Which egs?", SupersededAt: &supersededAt},
+		{ID: regeneratedReplyID, ChatRole: assistant.ChatRole_Assistant, Content: "Added egs."},
+		{ID: laterUserMessageID, ChatRole: assistant.ChatRole_User, Content: "Thanks"},
+	}
+
+	expectMessages := func(chatRepo *assistant.MockChatMessageRepository, messages []assistant.ChatMessage) {
+		chatRepo.EXPECT().
+			ListChatMessages(mock.Anything, conversationID, 1, 0).
+			Return(messages, false, nil).
+			Once()
+	}
+
+	tests := map[string]struct {
+		model           string
+		opts            []StreamChatOption
+		setExpectations func(
+			conversationRepo *assistant.MockConversationRepository,
+			chatRepo *assistant.MockChatMessageRepository,
+			compactor *MockConversationCompactor,
+			builder *MockTurnStateBuilder,
+			runner *MockTurnRunner,
+			writer *MockConversationTranscriptWriter,
+			timeProvider *core.MockCurrentTimeProvider,
+		)
+		expectedErr error
+	}{
+		"success": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithEditedMessage(editedMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				compactor *MockConversationCompactor,
+				builder *MockTurnStateBuilder,
+				runner *MockTurnRunner,
+				writer *MockConversationTranscriptWriter,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				expectMessages(chatRepo, conversationMessages)
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+
+				recompact := compactor.EXPECT().Recompact(mock.Anything, conversationID, editedMessageID).Return(nil).Once()
+				timeProvider.EXPECT().Now().Return(fixedTime)
+				chatRepo.EXPECT().
+					SupersedeChatMessages(mock.Anything, []uuid.UUID{editedMessageID, regeneratedReplyID, laterUserMessageID}, fixedTime).
+					Return(nil).
+					Once().
+					NotBefore(recompact)
+				compactor.EXPECT().
+					EvaluateConversationCompaction(mock.Anything, conversationID, mock.Anything).
+					Return(assistant.CompactionDecision{}, nil).
+					Once()
+
+				state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "original-model"}, 5, nil)
+				builder.EXPECT().
+					Build(mock.Anything, BuildTurnStateParams{
+						UserMessage:     "Add eggs",
+						Model:           "original-model",
+						MaxActionCycles: 5,
+						Conversation:    conversation,
+					}).
+					Return(state, nil).
+					Once()
+				writer.EXPECT().
+					WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+						return m.ChatRole == assistant.ChatRole_User && m.Content == "Add eggs" && m.Model == "original-model"
+					})).
+					Return(nil).
+					Once()
+				runner.EXPECT().
+					Run(mock.Anything, state, mock.Anything).
+					RunAndReturn(func(_ context.Context, state TurnState, _ assistant.EventCallback) error {
+						state.AppendAssistantContent("Added eggs.")
+						return nil
+					}).
+					Once()
+				writer.EXPECT().
+					WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+						return m.ChatRole == assistant.ChatRole_Assistant && m.Content == "Added eggs."
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"missing-conversation-id": {
+			opts: []StreamChatOption{WithEditedMessage(editedMessageID)},
+			setExpectations: func(
+				*assistant.MockConversationRepository,
+				*assistant.MockChatMessageRepository,
+				*MockConversationCompactor,
+				*MockTurnStateBuilder,
+				*MockTurnRunner,
+				*MockConversationTranscriptWriter,
+				*core.MockCurrentTimeProvider,
+			) {
+			},
+			expectedErr: core.NewValidationErr("conversation ID is required to edit a message"),
+		},
+		"message-not-found": {
+			model: "test-model",
+			opts:  []StreamChatOption{WithConversationID(conversationID), WithEditedMessage(uuid.MustParse("80000000-0000-0000-0000-000000000099"))},
+			setExpectations: func(
+				_ *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockConversationCompactor,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				expectMessages(chatRepo, conversationMessages)
+			},
+			expectedErr: core.NewNotFoundErr("message with ID 80000000-0000-0000-0000-000000000099 not found"),
+		},
+		"assistant-message-not-editable": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithEditedMessage(firstReplyID)},
+			setExpectations: func(
+				_ *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockConversationCompactor,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				expectMessages(chatRepo, conversationMessages)
+			},
+			expectedErr: core.NewValidationErr("only current user messages can be edited"),
+		},
+		"superseded-message-not-editable": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithEditedMessage(editedMessageID)},
+			setExpectations: func(
+				_ *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockConversationCompactor,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				messages := append([]assistant.ChatMessage(nil), conversationMessages...)
+				messages[2].SupersededAt = &supersededAt
+				expectMessages(chatRepo, messages)
+			},
+			expectedErr: core.NewValidationErr("only current user messages can be edited"),
+		},
+		"pending-approval": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithEditedMessage(firstUserMessageID)},
+			setExpectations: func(
+				_ *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockConversationCompactor,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				messages := append([]assistant.ChatMessage(nil), conversationMessages...)
+				messages[4].ApprovalStatus = &pending
+				expectMessages(chatRepo, messages)
+			},
+			expectedErr: core.NewValidationErr("conversation is waiting for an action approval"),
+		},
+		"recompact-error-leaves-messages-untouched": {
+			model: "other-model",
+			opts:  []StreamChatOption{WithConversationID(conversationID), WithEditedMessage(editedMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				compactor *MockConversationCompactor,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				expectMessages(chatRepo, conversationMessages)
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				compactor.EXPECT().Recompact(mock.Anything, conversationID, editedMessageID).Return(errors.New("llm down")).Once()
+			},
+			expectedErr: errors.New("llm down"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversationRepo := assistant.NewMockConversationRepository(t)
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			compactor := NewMockConversationCompactor(t)
+			builder := NewMockTurnStateBuilder(t)
+			runner := NewMockTurnRunner(t)
+			writer := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(conversationRepo, chatRepo, compactor, builder, runner, writer, timeProvider)
+
+			useCase := NewStreamChatImpl(
+				log.New(io.Discard, "", 0),
+				timeProvider,
+				conversationRepo,
+				chatRepo,
+				nil,
+				compactor,
+				nil,
+				nil,
+				nil,
+				false,
+				assistant.CompactionPolicy{},
+				DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
+				5,
+				0,
+				nil,
+				nil,
+				nil,
+				builder,
+				runner,
+				writer,
+				newFakeConversationStreams(),
+				nil,
+			)
+
+			err := useCase.Execute(t.Context(), "Add eggs", tt.model, func(context.Context, assistant.EventType, any) error {
+				return nil
+			}, tt.opts...)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -35,30 +36,177 @@ func newTestStreamChatUseCase(
 	compactionTriggerTokens int,
 	compactionTimeout time.Duration,
 ) StreamChatImpl {
-	transcriptWriter := NewConversationTranscriptWriterImpl(uow, tokenizer)
-	actionPipeline := NewActionPipelineImpl(actionRegistry, approvalDispatcher, transcriptWriter, timeProvider)
-	turnRunner := NewTurnRunnerImpl(logger, assist, actionPipeline)
+	transcriptWriter := NewConversationTranscriptWriterImpl(uow, tokenizer, nil, 0)
+	actionPipeline := NewActionPipelineImpl(actionRegistry, approvalDispatcher, transcriptWriter, timeProvider, nil)
+	turnRunner := NewTurnRunnerImpl(logger, assist, actionPipeline, false)
 	stateBuilder := NewTurnStateBuilderImpl(
 		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
 		chatRepo,
 		timeProvider,
 		skillRegistry,
 		actionRegistry,
+		noContentBlobs{},
+		0,
 	)
 	return NewStreamChatImpl(
 		logger,
 		timeProvider,
 		conversationRepo,
+		chatRepo,
+		nil,
 		compactor,
+		nil,
+		nil,
+		nil,
+		false,
 		assistant.CompactionPolicy{TriggerTokenCount: compactionTriggerTokens},
 		compactionTimeout,
 		maxActionCycles,
+		0,
+		nil,
+		nil,
+		nil,
 		stateBuilder,
 		turnRunner,
 		transcriptWriter,
+		newFakeConversationStreams(),
+		nil,
 	)
 }
 
+// noConversationSnapshots is a ConversationSnapshotRepository without snapshots, so turns build from summaries.
+type noConversationSnapshots struct{}
+
+// StoreConversationSnapshot implements assistant.ConversationSnapshotRepository.
+func (noConversationSnapshots) StoreConversationSnapshot(context.Context, assistant.ConversationSnapshot) error {
+	return nil
+}
+
+// GetLatestConversationSnapshot implements assistant.ConversationSnapshotRepository.
+func (noConversationSnapshots) GetLatestConversationSnapshot(context.Context, uuid.UUID) (assistant.ConversationSnapshot, bool, error) {
+	return assistant.ConversationSnapshot{}, false, nil
+}
+
+// DeleteConversationSnapshots implements assistant.ConversationSnapshotRepository.
+func (noConversationSnapshots) DeleteConversationSnapshots(context.Context, uuid.UUID) error {
+	return nil
+}
+
+// noContentBlobs is a ContentBlobRepository without blobs, so action results keep their inline previews.
+type noContentBlobs struct{}
+
+// SaveContentBlob implements assistant.ContentBlobRepository.
+func (noContentBlobs) SaveContentBlob(context.Context, assistant.ContentBlob) error {
+	return nil
+}
+
+// ListContentBlobs implements assistant.ContentBlobRepository.
+func (noContentBlobs) ListContentBlobs(context.Context, []string) ([]assistant.ContentBlob, error) {
+	return nil, nil
+}
+
+// DeleteUnreferencedContentBlobs implements assistant.ContentBlobRepository.
+func (noContentBlobs) DeleteUnreferencedContentBlobs(context.Context) error {
+	return nil
+}
+
+// noMemories is a Memories use case without long-term memories.
+type noMemories struct{}
+
+// List implements Memories.
+func (noMemories) List(context.Context) ([]assistant.Memory, error) {
+	return nil, nil
+}
+
+// Delete implements Memories.
+func (noMemories) Delete(context.Context, uuid.UUID) error {
+	return nil
+}
+
+// Recall implements Memories.
+func (noMemories) Recall(context.Context, string) ([]assistant.Memory, error) {
+	return nil, nil
+}
+
+// noRelatedConversations is a RelatedConversations use case without related conversations.
+type noRelatedConversations struct{}
+
+// Index implements RelatedConversations.
+func (noRelatedConversations) Index(context.Context, uuid.UUID) error {
+	return nil
+}
+
+// List implements RelatedConversations.
+func (noRelatedConversations) List(context.Context, uuid.UUID) ([]assistant.RelatedConversation, error) {
+	return nil, nil
+}
+
+// Hint implements RelatedConversations.
+func (noRelatedConversations) Hint(context.Context, uuid.UUID, string) (assistant.RelatedConversation, bool, error) {
+	return assistant.RelatedConversation{}, false, nil
+}
+
+// noInstructions is an InstructionRepository without pinned instructions.
+type noInstructions struct{}
+
+// CreateInstruction implements assistant.InstructionRepository.
+func (noInstructions) CreateInstruction(context.Context, assistant.Instruction) error {
+	return nil
+}
+
+// DeleteInstruction implements assistant.InstructionRepository.
+func (noInstructions) DeleteInstruction(context.Context, uuid.UUID) error {
+	return nil
+}
+
+// GetInstruction implements assistant.InstructionRepository.
+func (noInstructions) GetInstruction(context.Context, uuid.UUID) (assistant.Instruction, bool, error) {
+	return assistant.Instruction{}, false, nil
+}
+
+// ListInstructions implements assistant.InstructionRepository.
+func (noInstructions) ListInstructions(context.Context, uuid.UUID) ([]assistant.Instruction, error) {
+	return nil, nil
+}
+
+// fakeConversationStreams is an in-memory ConversationStreams used to observe stream attachment in tests.
+type fakeConversationStreams struct {
+	mu       sync.Mutex
+	attached map[uuid.UUID]assistant.EventCallback
+}
+
+// newFakeConversationStreams creates an empty fakeConversationStreams.
+func newFakeConversationStreams() *fakeConversationStreams {
+	return &fakeConversationStreams{attached: make(map[uuid.UUID]assistant.EventCallback)}
+}
+
+// Attach implements assistant.ConversationStreams.
+func (f *fakeConversationStreams) Attach(conversationID uuid.UUID, onEvent assistant.EventCallback) func() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attached[conversationID] = onEvent
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.attached, conversationID)
+	}
+}
+
+// Emit implements assistant.ConversationStreams.
+func (f *fakeConversationStreams) Emit(ctx context.Context, conversationID uuid.UUID, eventType assistant.EventType, data any) (bool, error) {
+	f.mu.Lock()
+	onEvent, found := f.attached[conversationID]
+	f.mu.Unlock()
+	if !found {
+		return false, nil
+	}
+	return true, onEvent(ctx, eventType, data)
+}
+
 // streamChatTestTableEntry defines the structure for test cases of StreamChatImpl's Execute method,
 // including input parameters, expectations, and error scenarios.
 type streamChatTestTableEntry struct {
@@ -90,9 +238,10 @@ type streamChatTestTableEntry struct {
 		*transaction.MockUnitOfWork,
 		*outbox.MockRepository,
 	)
-	expectErr       bool
-	expectedContent string
-	onEventErrType  assistant.EventType
+	expectErr          bool
+	expectedContent    string
+	expectedTurnFailed *assistant.TurnFailed
+	onEventErrType     assistant.EventType
 }
 
 // testStreamChatImpl is a helper function that executes the StreamChatImpl use case with the provided test case entry,
@@ -171,7 +320,10 @@ func testStreamChatImpl(t *testing.T, tt streamChatTestTableEntry) {
 		DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
 	)
 
-	var capturedContent string
+	var (
+		capturedContent    string
+		capturedTurnFailed *assistant.TurnFailed
+	)
 	err := useCase.Execute(t.Context(), tt.userMessage, tt.model, func(_ context.Context, eventType assistant.EventType, data any) error {
 		if tt.onEventErrType != "" && eventType == tt.onEventErrType {
 			return errors.New("onEvent error")
@@ -184,9 +336,19 @@ func testStreamChatImpl(t *testing.T, tt streamChatTestTableEntry) {
 			actionCall := data.(assistant.ActionCall)
 			capturedContent += actionCall.Text
 		}
+		if eventType == assistant.EventType_TurnFailed {
+			turnFailed := data.(assistant.TurnFailed)
+			capturedTurnFailed = &turnFailed
+		}
 		return nil
 	}, tt.options...)
 
+	if tt.expectedTurnFailed != nil && assert.NotNil(t, capturedTurnFailed) {
+		assert.NotEqual(t, uuid.Nil, capturedTurnFailed.TurnID)
+		capturedTurnFailed.TurnID = uuid.Nil
+		assert.Equal(t, *tt.expectedTurnFailed, *capturedTurnFailed)
+	}
+
 	if tt.expectErr {
 		assert.Error(t, err)
 	} else {
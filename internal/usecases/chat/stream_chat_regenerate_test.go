@@ -0,0 +1,256 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStreamChatImpl_Execute_RegeneratesMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("70000000-0000-0000-0000-000000000001")
+	turnID := uuid.MustParse("70000000-0000-0000-0000-000000000002")
+	userMessageID := uuid.MustParse("70000000-0000-0000-0000-000000000003")
+	actionCallMessageID := uuid.MustParse("70000000-0000-0000-0000-000000000004")
+	toolMessageID := uuid.MustParse("70000000-0000-0000-0000-000000000005")
+	finalMessageID := uuid.MustParse("70000000-0000-0000-0000-000000000006")
+	previousVersionID := uuid.MustParse("70000000-0000-0000-0000-000000000007")
+	fixedTime := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	supersededAt := fixedTime.Add(-time.Hour)
+	conversation := assistant.Conversation{ID: conversationID}
+	pending := assistant.ChatMessageApprovalStatus_Pending
+
+	turnMessages := []assistant.ChatMessage{
+		{ID: userMessageID, TurnID: turnID, TurnSequence: 0, ChatRole: assistant.ChatRole_User, Content: "Delete my done todos"},
+		{ID: previousVersionID, TurnID: turnID, TurnSequence: 1, ChatRole: assistant.ChatRole_Assistant, Content: "First try", SupersededAt: &supersededAt},
+		{ID: actionCallMessageID, TurnID: turnID, TurnSequence: 2, ChatRole: assistant.ChatRole_Assistant, ActionCalls: []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos"}}},
+		{ID: toolMessageID, TurnID: turnID, TurnSequence: 3, ChatRole: assistant.ChatRole_Tool, ActionCallID: common.Ptr("call-1")},
+		{ID: finalMessageID, TurnID: turnID, TurnSequence: 4, ChatRole: assistant.ChatRole_Assistant, Content: "Done.", Model: "original-model"},
+	}
+
+	expectTurn := func(chatRepo *assistant.MockChatMessageRepository, messages []assistant.ChatMessage) {
+		chatRepo.EXPECT().
+			ListChatMessages(mock.Anything, conversationID, 1, 1).
+			Return(messages[len(messages)-1:], false, nil).
+			Once()
+		chatRepo.EXPECT().
+			ListChatMessages(mock.Anything, conversationID, 1, 0, mock.Anything).
+			Return(messages, false, nil).
+			Once()
+	}
+
+	tests := map[string]struct {
+		model           string
+		opts            []StreamChatOption
+		setExpectations func(
+			conversationRepo *assistant.MockConversationRepository,
+			chatRepo *assistant.MockChatMessageRepository,
+			builder *MockTurnStateBuilder,
+			runner *MockTurnRunner,
+			writer *MockConversationTranscriptWriter,
+			timeProvider *core.MockCurrentTimeProvider,
+		)
+		expectedErr error
+	}{
+		"success": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithRegeneratedMessage(finalMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				builder *MockTurnStateBuilder,
+				runner *MockTurnRunner,
+				writer *MockConversationTranscriptWriter,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				expectTurn(chatRepo, turnMessages)
+
+				state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "original-model"}, 5, nil)
+				builder.EXPECT().
+					Build(mock.Anything, BuildTurnStateParams{
+						UserMessage:     "Delete my done todos",
+						Model:           "original-model",
+						MaxActionCycles: 5,
+						Conversation:    conversation,
+						HistoryBefore:   &userMessageID,
+						TurnID:          turnID,
+						TurnSequence:    5,
+					}).
+					Return(state, nil).
+					Once()
+
+				timeProvider.EXPECT().Now().Return(fixedTime).Times(2)
+				chatRepo.EXPECT().
+					SupersedeChatMessages(mock.Anything, []uuid.UUID{actionCallMessageID, toolMessageID, finalMessageID}, fixedTime).
+					Return(nil).
+					Once()
+				runner.EXPECT().
+					Run(mock.Anything, state, mock.Anything).
+					RunAndReturn(func(_ context.Context, state TurnState, _ assistant.EventCallback) error {
+						state.AppendAssistantContent("Regenerated.")
+						return nil
+					}).
+					Once()
+				writer.EXPECT().
+					WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+						return m.ChatRole == assistant.ChatRole_Assistant &&
+							m.Content == "Regenerated." &&
+							m.Model == "original-model" &&
+							m.Experiment == nil
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"missing-conversation-id": {
+			opts: []StreamChatOption{WithRegeneratedMessage(finalMessageID)},
+			setExpectations: func(*assistant.MockConversationRepository, *assistant.MockChatMessageRepository, *MockTurnStateBuilder, *MockTurnRunner, *MockConversationTranscriptWriter, *core.MockCurrentTimeProvider) {
+			},
+			expectedErr: core.NewValidationErr("conversation ID is required to regenerate a message"),
+		},
+		"conversation-not-found": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithRegeneratedMessage(finalMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				_ *assistant.MockChatMessageRepository,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("conversation with ID 70000000-0000-0000-0000-000000000001 not found"),
+		},
+		"message-not-in-latest-turn": {
+			model: "test-model",
+			opts:  []StreamChatOption{WithConversationID(conversationID), WithRegeneratedMessage(uuid.MustParse("70000000-0000-0000-0000-000000000099"))},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				expectTurn(chatRepo, turnMessages)
+			},
+			expectedErr: core.NewValidationErr("only assistant messages of the latest turn can be regenerated"),
+		},
+		"user-message-not-regenerable": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithRegeneratedMessage(userMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				expectTurn(chatRepo, turnMessages)
+			},
+			expectedErr: core.NewValidationErr("only assistant messages of the latest turn can be regenerated"),
+		},
+		"pending-approval": {
+			opts: []StreamChatOption{WithConversationID(conversationID), WithRegeneratedMessage(actionCallMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				_ *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				messages := append([]assistant.ChatMessage(nil), turnMessages[:4]...)
+				messages[3].ApprovalStatus = &pending
+				expectTurn(chatRepo, messages)
+			},
+			expectedErr: core.NewValidationErr("turn is waiting for an action approval"),
+		},
+		"supersede-error": {
+			model: "other-model",
+			opts:  []StreamChatOption{WithConversationID(conversationID), WithRegeneratedMessage(finalMessageID)},
+			setExpectations: func(
+				conversationRepo *assistant.MockConversationRepository,
+				chatRepo *assistant.MockChatMessageRepository,
+				builder *MockTurnStateBuilder,
+				_ *MockTurnRunner,
+				_ *MockConversationTranscriptWriter,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+				expectTurn(chatRepo, turnMessages)
+				builder.EXPECT().
+					Build(mock.Anything, mock.MatchedBy(func(params BuildTurnStateParams) bool {
+						return params.Model == "other-model"
+					})).
+					Return(NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "other-model"}, 5, nil), nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+				chatRepo.EXPECT().
+					SupersedeChatMessages(mock.Anything, mock.Anything, fixedTime).
+					Return(errors.New("db error")).
+					Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversationRepo := assistant.NewMockConversationRepository(t)
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			builder := NewMockTurnStateBuilder(t)
+			runner := NewMockTurnRunner(t)
+			writer := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(conversationRepo, chatRepo, builder, runner, writer, timeProvider)
+
+			useCase := NewStreamChatImpl(
+				log.New(io.Discard, "", 0),
+				timeProvider,
+				conversationRepo,
+				chatRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+				assistant.CompactionPolicy{},
+				DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
+				5,
+				0,
+				nil,
+				nil,
+				nil,
+				builder,
+				runner,
+				writer,
+				newFakeConversationStreams(),
+				nil,
+			)
+
+			err := useCase.Execute(t.Context(), "", tt.model, func(context.Context, assistant.EventType, any) error {
+				return nil
+			}, tt.opts...)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package chat
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"log"
@@ -9,9 +10,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -524,6 +527,97 @@ func TestStreamChatImpl_Execute(t *testing.T) {
 			expectErr:      true,
 			onEventErrType: assistant.EventType_MessageDelta,
 		},
+		"llm-rate-limited-reports-turn-failed": {
+			userMessage: "Test",
+			model:       "test-model",
+			fixedTime:   fixedTime,
+			options: []StreamChatOption{
+				WithConversationID(conversationID),
+			},
+			setExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				conversationRepo *assistant.MockConversationRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+				assist *assistant.MockAssistant,
+				actionRegistry *assistant.MockActionRegistry,
+				skillRegistry *assistant.MockSkillRegistry,
+				uow *transaction.MockUnitOfWork,
+				outbox *outbox.MockRepository,
+			) {
+				skillRegistry.EXPECT().
+					ListRelevant(mock.Anything, mock.Anything).
+					Return([]assistant.SkillDefinition{}).
+					Once()
+
+				conversationRepo.EXPECT().
+					GetConversation(mock.Anything, conversationID).
+					Return(assistant.Conversation{
+						ID: conversationID,
+					}, true, nil).
+					Once()
+				expectNowCalls(timeProvider, fixedTime, 3)
+
+				chatRepo.EXPECT().
+					ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+					Return([]assistant.ChatMessage{}, false, nil).
+					Once()
+
+				assist.EXPECT().
+					RunTurn(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, req assistant.TurnRequest, onEvent assistant.EventCallback) error {
+						if err := onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hi"}); err != nil {
+							return err
+						}
+						return assistant.NewTurnError(assistant.TurnErrorCode_RateLimited, 1500*time.Millisecond, errors.New("too many requests"))
+					}).
+					Once()
+			},
+			persistExpectations: []persistCallExpectation{
+				{
+					Role:            assistant.ChatRole_User,
+					Content:         "Test",
+					ID:              &userMsgID,
+					ActionCallsLen:  0,
+					HasActionCallID: false,
+				},
+			},
+			setAfterPersistExpectations: func(
+				chatRepo *assistant.MockChatMessageRepository,
+				summaryRepo *assistant.MockConversationSummaryRepository,
+				conversationRepo *assistant.MockConversationRepository,
+				timeProvider *core.MockCurrentTimeProvider,
+				assist *assistant.MockAssistant,
+				actionRegistry *assistant.MockActionRegistry,
+				skillRegistry *assistant.MockSkillRegistry,
+				uow *transaction.MockUnitOfWork,
+				outbox *outbox.MockRepository,
+			) {
+				expectRepairTurnNoOp(t, chatRepo, uow, conversationID)
+				errMsg := "too many requests"
+				turnSequence := int64(1)
+				expectPersistSequence(t, chatRepo, conversationRepo, uow, outbox, fixedTime, []persistCallExpectation{
+					{
+						Role:            assistant.ChatRole_Assistant,
+						Content:         "Hi",
+						ID:              &assistantMsgID,
+						MessageState:    assistant.ChatMessageState_Failed,
+						ErrorMessage:    &errMsg,
+						ActionCallsLen:  0,
+						HasActionCallID: false,
+						TurnSequence:    &turnSequence,
+					},
+				})
+			},
+			expectErr: true,
+			expectedTurnFailed: &assistant.TurnFailed{
+				ConversationID:    conversationID,
+				Code:              assistant.TurnErrorCode_RateLimited,
+				Error:             "too many requests",
+				Retriable:         true,
+				RetryAfterSeconds: common.Ptr(2),
+			},
+		},
 		"llm-chatstream-error": {
 			userMessage: "Test",
 			model:       "test-model",
@@ -847,13 +941,16 @@ func TestStreamChatImpl_Execute_PersistsSelectedSkillsAndEmitsTurnMetadata(t *te
 		Once()
 
 	actionRegistry.EXPECT().
-		GetDefinition("fetch_todos").
+		GetDefinition(mock.Anything, "fetch_todos").
 		Return(assistant.ActionDefinition{Name: "fetch_todos"}, true).
 		Once()
 	actionRegistry.EXPECT().
-		GetDefinition("update_todos").
+		GetDefinition(mock.Anything, "update_todos").
 		Return(assistant.ActionDefinition{Name: "update_todos"}, true).
 		Once()
+	actionRegistry.EXPECT().
+		Prefetch(mock.Anything, []string{"fetch_todos", "update_todos"}, mock.Anything).
+		Once()
 
 	conversationRepo.EXPECT().
 		GetConversation(mock.Anything, conversationID).
@@ -1074,3 +1171,675 @@ func TestStreamChatImpl_Execute_UsesUnsummarizedHistoryAfterSummaryCheckpoint(t
 }
 
 // Verify that the StreamChat use case is registered
+
+func TestStreamChatImpl_Execute_RoutesConversationStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	fixedTime := time.Date(2026, 1, 24, 15, 0, 0, 0, time.UTC)
+
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	conversationRepo := assistant.NewMockConversationRepository(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	assist := assistant.NewMockAssistant(t)
+	actionRegistry := assistant.NewMockActionRegistry(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	uow := transaction.NewMockUnitOfWork(t)
+	outbox := outbox.NewMockRepository(t)
+	streams := newFakeConversationStreams()
+
+	skillRegistry.EXPECT().
+		ListRelevant(mock.Anything, mock.Anything).
+		Return([]assistant.SkillDefinition{}).
+		Once()
+	conversationRepo.EXPECT().
+		GetConversation(mock.Anything, conversationID).
+		Return(assistant.Conversation{ID: conversationID}, true, nil).
+		Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES, mock.Anything).
+		Return([]assistant.ChatMessage{}, false, nil).
+		Once()
+	expectNowCalls(timeProvider, fixedTime, 4)
+
+	assist.EXPECT().
+		RunTurn(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, req assistant.TurnRequest, onEvent assistant.EventCallback) {
+			delivered, err := streams.Emit(ctx, conversationID, assistant.EventType_FocusSessionCompleted, assistant.FocusSessionCompleted{})
+			assert.NoError(t, err)
+			assert.True(t, delivered)
+			_ = onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Done."})
+		}).
+		Return(nil)
+
+	expectPersistSequence(t, chatRepo, conversationRepo, uow, outbox, fixedTime, []persistCallExpectation{
+		{Role: assistant.ChatRole_User, Content: "Start a focus session"},
+		{Role: assistant.ChatRole_Assistant, Content: "Done."},
+	})
+
+	useCase := newTestStreamChatUseCase(
+		log.New(io.Discard, "", 0),
+		chatRepo,
+		summaryRepo,
+		nil,
+		conversationRepo,
+		timeProvider,
+		nil,
+		assist,
+		actionRegistry,
+		skillRegistry,
+		nil,
+		uow,
+		7,
+		8000,
+		DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
+	)
+	useCase.streams = streams
+
+	var events []assistant.EventType
+	err := useCase.Execute(t.Context(), "Start a focus session", "test-model", func(_ context.Context, eventType assistant.EventType, _ any) error {
+		events = append(events, eventType)
+		return nil
+	}, WithConversationID(conversationID))
+
+	assert.NoError(t, err)
+	assert.Contains(t, events, assistant.EventType_FocusSessionCompleted)
+
+	delivered, err := streams.Emit(t.Context(), conversationID, assistant.EventType_FocusSessionCompleted, nil)
+	assert.NoError(t, err)
+	assert.False(t, delivered)
+}
+
+func TestStreamChatImpl_Execute_SandboxStaysDetachedFromConversationStreams(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	fixedTime := time.Date(2026, 1, 24, 15, 0, 0, 0, time.UTC)
+
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	conversationRepo := assistant.NewMockConversationRepository(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	assist := assistant.NewMockAssistant(t)
+	actionRegistry := assistant.NewMockActionRegistry(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	uow := transaction.NewMockUnitOfWork(t)
+	outbox := outbox.NewMockRepository(t)
+	streams := newFakeConversationStreams()
+
+	skillRegistry.EXPECT().
+		ListRelevant(mock.Anything, mock.Anything).
+		Return([]assistant.SkillDefinition{}).
+		Once()
+	conversationRepo.EXPECT().
+		GetConversation(mock.Anything, conversationID).
+		Return(assistant.Conversation{ID: conversationID}, true, nil).
+		Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES, mock.Anything).
+		Return([]assistant.ChatMessage{}, false, nil).
+		Once()
+	expectNowCalls(timeProvider, fixedTime, 4)
+
+	assist.EXPECT().
+		RunTurn(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, req assistant.TurnRequest, onEvent assistant.EventCallback) {
+			delivered, err := streams.Emit(ctx, conversationID, assistant.EventType_FocusSessionCompleted, assistant.FocusSessionCompleted{})
+			assert.NoError(t, err)
+			assert.False(t, delivered)
+			_ = onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Done."})
+		}).
+		Return(nil)
+
+	expectPersistSequence(t, chatRepo, conversationRepo, uow, outbox, fixedTime, []persistCallExpectation{
+		{Role: assistant.ChatRole_User, Content: "Start a focus session"},
+		{Role: assistant.ChatRole_Assistant, Content: "Done."},
+	})
+
+	useCase := newTestStreamChatUseCase(
+		log.New(io.Discard, "", 0),
+		chatRepo,
+		summaryRepo,
+		nil,
+		conversationRepo,
+		timeProvider,
+		nil,
+		assist,
+		actionRegistry,
+		skillRegistry,
+		nil,
+		uow,
+		7,
+		8000,
+		DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
+	)
+	useCase.streams = streams
+
+	var events []assistant.EventType
+	err := useCase.Execute(transaction.WithSandbox(t.Context()), "Start a focus session", "test-model", func(_ context.Context, eventType assistant.EventType, _ any) error {
+		events = append(events, eventType)
+		return nil
+	}, WithConversationID(conversationID))
+
+	assert.NoError(t, err)
+	assert.NotContains(t, events, assistant.EventType_FocusSessionCompleted)
+}
+
+func TestStreamChatImpl_ValidateGenerationOptions(t *testing.T) {
+	t.Parallel()
+
+	models := []assistant.ModelCapabilities{
+		{ID: "qwen3", Name: "qwen3", MaxOutputTokens: 1024},
+	}
+
+	tests := map[string]struct {
+		model           string
+		generation      assistant.GenerationOptions
+		setExpectations func(*assistant.MockModelCatalog)
+		expectedErr     error
+	}{
+		"skips-catalog-without-options": {
+			model:           "qwen3",
+			setExpectations: func(*assistant.MockModelCatalog) {},
+		},
+		"within-model-limit": {
+			model:      "qwen3",
+			generation: assistant.GenerationOptions{MaxTokens: common.Ptr(256)},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+		},
+		"above-model-limit": {
+			model:      "qwen3",
+			generation: assistant.GenerationOptions{MaxTokens: common.Ptr(2048)},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("max_tokens must be at most 1024 for model qwen3"),
+		},
+		"unknown-model": {
+			model:      "llama3",
+			generation: assistant.GenerationOptions{Stop: []string{"END"}},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("model llama3 is not available"),
+		},
+		"catalog-error": {
+			model:      "qwen3",
+			generation: assistant.GenerationOptions{Stop: []string{"END"}},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(nil, errors.New("catalog error")).Once()
+			},
+			expectedErr: errors.New("catalog error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog := assistant.NewMockModelCatalog(t)
+			tt.setExpectations(catalog)
+
+			useCase := StreamChatImpl{modelCatalog: catalog}
+			err := useCase.validateGenerationOptions(t.Context(), tt.model, tt.generation)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestStreamChatImpl_ApplyTenantSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		settings                tenant.Settings
+		model                   string
+		generation              assistant.GenerationOptions
+		expectedGeneration      assistant.GenerationOptions
+		expectedMaxActionCycles int
+		expectedErr             error
+	}{
+		"no-overrides": {
+			model:                   "qwen3",
+			generation:              assistant.GenerationOptions{MaxTokens: common.Ptr(512)},
+			expectedGeneration:      assistant.GenerationOptions{MaxTokens: common.Ptr(512)},
+			expectedMaxActionCycles: 50,
+		},
+		"allowed-model": {
+			settings:                tenant.Settings{Models: []string{"qwen3"}},
+			model:                   "qwen3",
+			expectedMaxActionCycles: 50,
+		},
+		"model-not-enabled": {
+			settings:    tenant.Settings{Models: []string{"qwen3"}},
+			model:       "llama3",
+			expectedErr: core.NewValidationErr("model llama3 is not enabled for this tenant"),
+		},
+		"caps-max-tokens-above-budget": {
+			settings:                tenant.Settings{MaxOutputTokens: 256},
+			model:                   "qwen3",
+			generation:              assistant.GenerationOptions{MaxTokens: common.Ptr(1024), Stop: []string{"END"}},
+			expectedGeneration:      assistant.GenerationOptions{MaxTokens: common.Ptr(256), Stop: []string{"END"}},
+			expectedMaxActionCycles: 50,
+		},
+		"keeps-max-tokens-within-budget": {
+			settings:                tenant.Settings{MaxOutputTokens: 256},
+			model:                   "qwen3",
+			generation:              assistant.GenerationOptions{MaxTokens: common.Ptr(128)},
+			expectedGeneration:      assistant.GenerationOptions{MaxTokens: common.Ptr(128)},
+			expectedMaxActionCycles: 50,
+		},
+		"applies-budget-without-max-tokens": {
+			settings:                tenant.Settings{MaxOutputTokens: 256},
+			model:                   "qwen3",
+			expectedGeneration:      assistant.GenerationOptions{MaxTokens: common.Ptr(256)},
+			expectedMaxActionCycles: 50,
+		},
+		"overrides-max-action-cycles": {
+			settings:                tenant.Settings{MaxActionCycles: 5},
+			model:                   "qwen3",
+			expectedMaxActionCycles: 5,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := tenant.NewContext(t.Context(), tenant.Tenant{ID: "acme", Settings: tt.settings})
+			useCase := StreamChatImpl{maxActionCycles: 50}
+			generation, maxActionCycles, err := useCase.applyTenantSettings(ctx, tt.model, tt.generation)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedGeneration, generation)
+			assert.Equal(t, tt.expectedMaxActionCycles, maxActionCycles)
+		})
+	}
+}
+
+func TestStreamChatImpl_ApplyConversationSettings(t *testing.T) {
+	t.Parallel()
+
+	models := []assistant.ModelCapabilities{
+		{ID: "qwen3", Name: "qwen3", MaxOutputTokens: 1024},
+		{ID: "llama3", Name: "llama3", MaxOutputTokens: 512},
+	}
+
+	tests := map[string]struct {
+		conversationSettings assistant.ConversationSettings
+		settings             tenant.Settings
+		generation           assistant.GenerationOptions
+		setExpectations      func(*assistant.MockModelCatalog)
+		expectedModel        string
+		expectedErr          error
+	}{
+		"no-model-override": {
+			conversationSettings: assistant.ConversationSettings{Temperature: common.Ptr(0.9)},
+			expectedModel:        "qwen3",
+		},
+		"model-override": {
+			conversationSettings: assistant.ConversationSettings{Model: "llama3"},
+			expectedModel:        "llama3",
+		},
+		"model-override-with-generation-options": {
+			conversationSettings: assistant.ConversationSettings{Model: "llama3"},
+			generation:           assistant.GenerationOptions{MaxTokens: common.Ptr(256)},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+			expectedModel: "llama3",
+		},
+		"model-override-not-enabled-for-tenant": {
+			conversationSettings: assistant.ConversationSettings{Model: "llama3"},
+			settings:             tenant.Settings{Models: []string{"qwen3"}},
+			expectedModel:        "qwen3",
+			expectedErr:          core.NewValidationErr("model llama3 is not enabled for this tenant"),
+		},
+		"model-override-rejects-generation-options": {
+			conversationSettings: assistant.ConversationSettings{Model: "llama3"},
+			generation:           assistant.GenerationOptions{MaxTokens: common.Ptr(1024)},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+			expectedModel: "qwen3",
+			expectedErr:   core.NewValidationErr("max_tokens must be at most 512 for model llama3"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog := assistant.NewMockModelCatalog(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(catalog)
+			}
+
+			ctx := tenant.NewContext(t.Context(), tenant.Tenant{ID: "acme", Settings: tt.settings})
+			useCase := StreamChatImpl{modelCatalog: catalog}
+			model, err := useCase.applyConversationSettings(ctx, tt.conversationSettings, "qwen3", tt.generation)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedModel, model)
+		})
+	}
+}
+
+func TestStreamChatImpl_AssignExperiment(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	models := []assistant.ModelCapabilities{
+		{ID: "qwen3", Name: "qwen3", MaxOutputTokens: 1024},
+		{ID: "llama3", Name: "llama3", MaxOutputTokens: 512},
+	}
+	experimentWith := func(variant assistant.ExperimentVariant) *assistant.Experiment {
+		return &assistant.Experiment{Name: "prompt-v2", Variants: []assistant.ExperimentVariant{variant}}
+	}
+
+	tests := map[string]struct {
+		experiment         *assistant.Experiment
+		settings           tenant.Settings
+		generation         assistant.GenerationOptions
+		sandboxed          bool
+		setExpectations    func(*assistant.MockModelCatalog)
+		expectedAssignment *assistant.ExperimentAssignment
+		expectedModel      string
+	}{
+		"no-experiment": {
+			expectedModel: "qwen3",
+		},
+		"sandboxed": {
+			experiment:    experimentWith(assistant.ExperimentVariant{Name: "llama", Weight: 1, Model: "llama3"}),
+			sandboxed:     true,
+			expectedModel: "qwen3",
+		},
+		"prompt-variant-keeps-model": {
+			experiment: experimentWith(assistant.ExperimentVariant{Name: "concise", Weight: 1, PromptVersion: "concise"}),
+			expectedAssignment: &assistant.ExperimentAssignment{
+				Experiment:    "prompt-v2",
+				Variant:       "concise",
+				PromptVersion: "concise",
+			},
+			expectedModel: "qwen3",
+		},
+		"model-variant-switches-model": {
+			experiment: experimentWith(assistant.ExperimentVariant{Name: "llama", Weight: 1, Model: "llama3"}),
+			generation: assistant.GenerationOptions{MaxTokens: common.Ptr(256)},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+			expectedAssignment: &assistant.ExperimentAssignment{
+				Experiment: "prompt-v2",
+				Variant:    "llama",
+				Model:      "llama3",
+			},
+			expectedModel: "llama3",
+		},
+		"model-variant-not-enabled-for-tenant": {
+			experiment:    experimentWith(assistant.ExperimentVariant{Name: "llama", Weight: 1, Model: "llama3"}),
+			settings:      tenant.Settings{Models: []string{"qwen3"}},
+			expectedModel: "qwen3",
+		},
+		"model-variant-rejects-generation-options": {
+			experiment: experimentWith(assistant.ExperimentVariant{Name: "llama", Weight: 1, Model: "llama3"}),
+			generation: assistant.GenerationOptions{MaxTokens: common.Ptr(1024)},
+			setExpectations: func(catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().ListModels(mock.Anything).Return(models, nil).Once()
+			},
+			expectedModel: "qwen3",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			catalog := assistant.NewMockModelCatalog(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(catalog)
+			}
+
+			ctx := tenant.NewContext(t.Context(), tenant.Tenant{ID: "acme", Settings: tt.settings})
+			if tt.sandboxed {
+				ctx = transaction.WithSandbox(ctx)
+			}
+			useCase := StreamChatImpl{
+				logger:       log.New(io.Discard, "", 0),
+				modelCatalog: catalog,
+				experiment:   tt.experiment,
+			}
+			assignment, model := useCase.assignExperiment(ctx, conversationID, "qwen3", tt.generation)
+			assert.Equal(t, tt.expectedAssignment, assignment)
+			assert.Equal(t, tt.expectedModel, model)
+		})
+	}
+}
+
+func TestStreamChatImpl_Execute_ModeratesUserMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	fixedTime := time.Date(2026, 1, 24, 15, 0, 0, 0, time.UTC)
+	flagged := assistant.ModerationResult{Flagged: true, Categories: []string{"violence"}}
+
+	tests := map[string]struct {
+		opts            []StreamChatOption
+		setExpectations func(*assistant.MockModerator, *assistant.MockConversationRepository, *MockConversationTranscriptWriter)
+		expectedEvent   *assistant.MessageModerated
+		expectErr       bool
+	}{
+		"blocked-in-new-conversation": {
+			setExpectations: func(moderator *assistant.MockModerator, repo *assistant.MockConversationRepository, writer *MockConversationTranscriptWriter) {
+				moderator.EXPECT().Moderate(mock.Anything, "I will hurt them").Return(flagged, nil).Once()
+				repo.EXPECT().
+					CreateConversation(mock.Anything, "New Conversation", assistant.ConversationTitleSource_Auto).
+					Return(assistant.Conversation{ID: conversationID}, nil).
+					Once()
+				writer.EXPECT().
+					WriteMessage(mock.Anything, assistant.Conversation{ID: conversationID}, mock.MatchedBy(func(message assistant.ChatMessage) bool {
+						return message.IsModerated() &&
+							message.ChatRole == assistant.ChatRole_User &&
+							message.Content == "I will hurt them" &&
+							*message.ErrorMessage == "blocked by content moderation: violence"
+					})).
+					Return(nil).
+					Once()
+			},
+			expectedEvent: &assistant.MessageModerated{
+				ConversationID:      conversationID,
+				ConversationCreated: true,
+				Categories:          []string{"violence"},
+				Message:             MODERATION_REFUSAL_MESSAGE,
+			},
+		},
+		"blocked-in-existing-conversation": {
+			opts: []StreamChatOption{WithConversationID(conversationID)},
+			setExpectations: func(moderator *assistant.MockModerator, repo *assistant.MockConversationRepository, writer *MockConversationTranscriptWriter) {
+				moderator.EXPECT().Moderate(mock.Anything, "I will hurt them").Return(flagged, nil).Once()
+				repo.EXPECT().
+					GetConversation(mock.Anything, conversationID).
+					Return(assistant.Conversation{ID: conversationID}, true, nil).
+					Once()
+				writer.EXPECT().
+					WriteMessage(mock.Anything, assistant.Conversation{ID: conversationID}, mock.Anything).
+					Return(nil).
+					Once()
+			},
+			expectedEvent: &assistant.MessageModerated{
+				ConversationID: conversationID,
+				Categories:     []string{"violence"},
+				Message:        MODERATION_REFUSAL_MESSAGE,
+			},
+		},
+		"persist-error": {
+			opts: []StreamChatOption{WithConversationID(conversationID)},
+			setExpectations: func(moderator *assistant.MockModerator, repo *assistant.MockConversationRepository, writer *MockConversationTranscriptWriter) {
+				moderator.EXPECT().Moderate(mock.Anything, "I will hurt them").Return(flagged, nil).Once()
+				repo.EXPECT().
+					GetConversation(mock.Anything, conversationID).
+					Return(assistant.Conversation{ID: conversationID}, true, nil).
+					Once()
+				writer.EXPECT().
+					WriteMessage(mock.Anything, assistant.Conversation{ID: conversationID}, mock.Anything).
+					Return(errors.New("database error")).
+					Once()
+			},
+			expectErr: true,
+		},
+		"moderator-error-fails-closed": {
+			setExpectations: func(moderator *assistant.MockModerator, _ *assistant.MockConversationRepository, _ *MockConversationTranscriptWriter) {
+				moderator.EXPECT().Moderate(mock.Anything, "I will hurt them").Return(assistant.ModerationResult{}, errors.New("provider down")).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			moderator := assistant.NewMockModerator(t)
+			conversationRepo := assistant.NewMockConversationRepository(t)
+			transcriptWriter := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedTime).Maybe()
+			tt.setExpectations(moderator, conversationRepo, transcriptWriter)
+
+			useCase := StreamChatImpl{
+				logger:           log.New(io.Discard, "", 0),
+				timeProvider:     timeProvider,
+				conversationRepo: conversationRepo,
+				moderator:        moderator,
+				transcriptWriter: transcriptWriter,
+			}
+
+			var events []assistant.MessageModerated
+			err := useCase.Execute(t.Context(), "I will hurt them", "test-model", func(_ context.Context, eventType assistant.EventType, data any) error {
+				assert.Equal(t, assistant.EventType_MessageModerated, eventType)
+				events = append(events, data.(assistant.MessageModerated))
+				return nil
+			}, tt.opts...)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				return
+			}
+			assert.NoError(t, err)
+			if assert.Len(t, events, 1) {
+				assert.NotEqual(t, uuid.Nil, events[0].TurnID)
+				assert.NotEqual(t, uuid.Nil, events[0].MessageID)
+				events[0].TurnID, events[0].MessageID = uuid.Nil, uuid.Nil
+				assert.Equal(t, *tt.expectedEvent, events[0])
+			}
+		})
+	}
+}
+
+func TestStreamChatImpl_AutoConversationTitle(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setExpectations func(*assistant.MockRedactor)
+		withRedactor    bool
+		expected        string
+		expectErr       bool
+	}{
+		"without-redactor": {
+			expected: "Email jane@example.com about the trip...",
+		},
+		"redacted": {
+			withRedactor: true,
+			setExpectations: func(redactor *assistant.MockRedactor) {
+				redactor.EXPECT().
+					Redact("Email jane@example.com about the trip...").
+					Return("Email [REDACTED_EMAIL] about the trip...", nil).
+					Once()
+			},
+			expected: "Email [REDACTED_EMAIL] about the trip...",
+		},
+		"redaction-error": {
+			withRedactor: true,
+			setExpectations: func(redactor *assistant.MockRedactor) {
+				redactor.EXPECT().Redact(mock.Anything).Return("", errors.New("seal failed")).Once()
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			useCase := StreamChatImpl{}
+			if tt.withRedactor {
+				redactor := assistant.NewMockRedactor(t)
+				tt.setExpectations(redactor)
+				useCase.redactor = redactor
+			}
+
+			got, err := useCase.autoConversationTitle("Email jane@example.com about the trip plans")
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestWithoutActionResults(t *testing.T) {
+	t.Parallel()
+
+	result := json.RawMessage(`{"status":"success","data":{"todos":[]}}`)
+	tests := map[string]struct {
+		eventType assistant.EventType
+		data      any
+		expected  any
+	}{
+		"drops-action-result": {
+			eventType: assistant.EventType_ActionCompleted,
+			data:      assistant.ActionCompleted{ID: "call-1", Name: "fetch_todos", Success: true, Result: result},
+			expected:  assistant.ActionCompleted{ID: "call-1", Name: "fetch_todos", Success: true},
+		},
+		"keeps-other-events": {
+			eventType: assistant.EventType_MessageDelta,
+			data:      assistant.MessageDelta{Text: "Hi"},
+			expected:  assistant.MessageDelta{Text: "Hi"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got any
+			onEvent := withoutActionResults(func(_ context.Context, eventType assistant.EventType, data any) error {
+				assert.Equal(t, tt.eventType, eventType)
+				got = data
+				return nil
+			})
+
+			assert.NoError(t, onEvent(t.Context(), tt.eventType, tt.data))
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package chat
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+)
+
+// streamCheckpointer persists the assistant content of a turn every few kilobytes while it streams, so a crash
+// mid-turn leaves the partial response behind instead of nothing. The final or failure message of the turn
+// is written under the same ID and replaces the checkpoint.
+type streamCheckpointer struct {
+	writer       ConversationTranscriptWriter
+	timeProvider core.CurrentTimeProvider
+	logger       *log.Logger
+	state        TurnState
+	interval     int
+	messageID    uuid.UUID
+	started      bool
+	sequence     int64
+	createdAt    time.Time
+	// checkpointed is the content length persisted by the latest checkpoint.
+	checkpointed int
+}
+
+// newStreamCheckpointer creates a streamCheckpointer. A non-positive interval disables checkpoints.
+func newStreamCheckpointer(
+	writer ConversationTranscriptWriter,
+	timeProvider core.CurrentTimeProvider,
+	logger *log.Logger,
+	state TurnState,
+	interval int,
+) *streamCheckpointer {
+	return &streamCheckpointer{
+		writer:       writer,
+		timeProvider: timeProvider,
+		logger:       logger,
+		state:        state,
+		interval:     interval,
+		messageID:    uuid.New(),
+	}
+}
+
+// MessageID returns the ID of the assistant message the checkpoints are written under.
+func (c *streamCheckpointer) MessageID() uuid.UUID {
+	return c.messageID
+}
+
+// Observe wraps onEvent to checkpoint the assistant content after the message deltas sent to the client.
+func (c *streamCheckpointer) Observe(onEvent assistant.EventCallback) assistant.EventCallback {
+	if c.interval <= 0 {
+		return onEvent
+	}
+	return func(ctx context.Context, eventType assistant.EventType, data any) error {
+		if err := onEvent(ctx, eventType, data); err != nil {
+			return err
+		}
+		if eventType == assistant.EventType_MessageDelta {
+			c.checkpoint(ctx)
+		}
+		return nil
+	}
+}
+
+// checkpoint persists the content once it grew by at least the interval since the latest checkpoint.
+func (c *streamCheckpointer) checkpoint(ctx context.Context) {
+	content := c.state.AssistantContent()
+	if len(content)-c.checkpointed < c.interval {
+		return
+	}
+
+	now := c.timeProvider.Now()
+	if !c.started {
+		c.started = true
+		c.sequence = c.state.NextTurnSequence()
+		c.createdAt = now
+	}
+
+	writtenAt := time.Now()
+	err := c.writer.WriteCheckpoint(ctx, assistant.ChatMessage{
+		ID:             c.messageID,
+		ConversationID: c.state.Conversation().ID,
+		TurnID:         c.state.TurnID(),
+		TurnSequence:   c.sequence,
+		ChatRole:       assistant.ChatRole_Assistant,
+		Content:        content,
+		SelectedSkills: c.state.SelectedSkills(),
+		Model:          c.state.Model(),
+		Seed:           c.state.Seed(),
+		MessageState:   assistant.ChatMessageState_Streaming,
+		Experiment:     c.state.Experiment(),
+		CreatedAt:      c.createdAt,
+		UpdatedAt:      now,
+	})
+	c.state.RecordLatency(TurnPhase_Persistence, time.Since(writtenAt))
+	// A missing checkpoint only matters if the process crashes, so failures do not fail the turn.
+	if err != nil {
+		if c.logger != nil {
+			c.logger.Printf("StreamChat: failed to checkpoint streamed content of turn %s: %v", c.state.TurnID(), err)
+		}
+		return
+	}
+	c.checkpointed = len(content)
+}
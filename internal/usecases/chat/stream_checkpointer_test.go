@@ -0,0 +1,222 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamCheckpointer_Observe(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("90000000-0000-0000-0000-000000000001")}
+	firstCheckpointAt := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	secondCheckpointAt := firstCheckpointAt.Add(time.Second)
+
+	isCheckpoint := func(content string, createdAt, updatedAt time.Time) any {
+		return mock.MatchedBy(func(m assistant.ChatMessage) bool {
+			return m.ChatRole == assistant.ChatRole_Assistant &&
+				m.MessageState == assistant.ChatMessageState_Streaming &&
+				m.Content == content &&
+				m.TurnSequence == 0 &&
+				m.Model == "test-model" &&
+				m.CreatedAt.Equal(createdAt) &&
+				m.UpdatedAt.Equal(updatedAt)
+		})
+	}
+
+	tests := map[string]struct {
+		interval        int
+		deltas          []string
+		onEventErr      error
+		setExpectations func(writer *MockConversationTranscriptWriter, timeProvider *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"disabled": {
+			interval: 0,
+			deltas:   []string{"abcdefgh"},
+			setExpectations: func(*MockConversationTranscriptWriter, *core.MockCurrentTimeProvider) {
+			},
+		},
+		"checkpoints-every-interval": {
+			interval: 4,
+			deltas:   []string{"ab", "cd", "e", "fgh"},
+			setExpectations: func(writer *MockConversationTranscriptWriter, timeProvider *core.MockCurrentTimeProvider) {
+				timeProvider.EXPECT().Now().Return(firstCheckpointAt).Once()
+				writer.EXPECT().WriteCheckpoint(mock.Anything, isCheckpoint("abcd", firstCheckpointAt, firstCheckpointAt)).Return(nil).Once()
+				timeProvider.EXPECT().Now().Return(secondCheckpointAt).Once()
+				writer.EXPECT().WriteCheckpoint(mock.Anything, isCheckpoint("abcdefgh", firstCheckpointAt, secondCheckpointAt)).Return(nil).Once()
+			},
+		},
+		"failed-checkpoint-is-retried-on-next-delta": {
+			interval: 4,
+			deltas:   []string{"abcd", "e"},
+			setExpectations: func(writer *MockConversationTranscriptWriter, timeProvider *core.MockCurrentTimeProvider) {
+				timeProvider.EXPECT().Now().Return(firstCheckpointAt).Once()
+				writer.EXPECT().WriteCheckpoint(mock.Anything, isCheckpoint("abcd", firstCheckpointAt, firstCheckpointAt)).Return(errors.New("db error")).Once()
+				timeProvider.EXPECT().Now().Return(secondCheckpointAt).Once()
+				writer.EXPECT().WriteCheckpoint(mock.Anything, isCheckpoint("abcde", firstCheckpointAt, secondCheckpointAt)).Return(nil).Once()
+			},
+		},
+		"event-error-skips-checkpoint": {
+			interval:   1,
+			deltas:     []string{"abcd"},
+			onEventErr: errors.New("client gone"),
+			setExpectations: func(*MockConversationTranscriptWriter, *core.MockCurrentTimeProvider) {
+			},
+			expectedErr: errors.New("client gone"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			writer := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(writer, timeProvider)
+
+			state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 5, nil)
+			checkpointer := newStreamCheckpointer(writer, timeProvider, log.New(io.Discard, "", 0), state, tt.interval)
+			onEvent := checkpointer.Observe(func(context.Context, assistant.EventType, any) error {
+				return tt.onEventErr
+			})
+
+			var err error
+			for _, delta := range tt.deltas {
+				state.AppendAssistantContent(delta)
+				err = onEvent(t.Context(), assistant.EventType_MessageDelta, assistant.MessageDelta{Text: delta})
+			}
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestStreamChatImpl_Execute_FinalizesCheckpointedMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("90000000-0000-0000-0000-000000000002")
+	conversation := assistant.Conversation{ID: conversationID}
+	fixedTime := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		runErr        error
+		expectedState assistant.ChatMessageState
+		expectedErr   bool
+	}{
+		"completed": {
+			expectedState: assistant.ChatMessageState_Completed,
+		},
+		"failed": {
+			runErr:        errors.New("stream broke"),
+			expectedState: assistant.ChatMessageState_Failed,
+			expectedErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversationRepo := assistant.NewMockConversationRepository(t)
+			builder := NewMockTurnStateBuilder(t)
+			runner := NewMockTurnRunner(t)
+			writer := NewMockConversationTranscriptWriter(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedTime)
+
+			conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+			state := NewTurnState(conversation, false, nil, assistant.TurnRequest{Model: "test-model"}, 5, nil)
+			builder.EXPECT().Build(mock.Anything, mock.Anything).Return(state, nil).Once()
+			writer.EXPECT().
+				WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+					return m.ChatRole == assistant.ChatRole_User
+				})).
+				Return(nil).
+				Once()
+
+			var checkpointID uuid.UUID
+			writer.EXPECT().
+				WriteCheckpoint(mock.Anything, mock.Anything).
+				Run(func(_ context.Context, m assistant.ChatMessage) {
+					checkpointID = m.ID
+					assert.Equal(t, int64(1), m.TurnSequence)
+				}).
+				Return(nil).
+				Once()
+			runner.EXPECT().
+				Run(mock.Anything, state, mock.Anything).
+				RunAndReturn(func(ctx context.Context, state TurnState, onEvent assistant.EventCallback) error {
+					state.AppendAssistantContent("Long answer")
+					if err := onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Long answer"}); err != nil {
+						return err
+					}
+					return tt.runErr
+				}).
+				Once()
+			if tt.runErr != nil {
+				writer.EXPECT().RepairTurnTranscript(mock.Anything, conversationID, state.TurnID()).Return(nil).Once()
+			}
+			writer.EXPECT().
+				WriteMessage(mock.Anything, conversation, mock.MatchedBy(func(m assistant.ChatMessage) bool {
+					return m.ChatRole == assistant.ChatRole_Assistant &&
+						m.ID == checkpointID &&
+						m.TurnSequence == 2 &&
+						m.Content == "Long answer" &&
+						m.MessageState == tt.expectedState
+				})).
+				Return(nil).
+				Once()
+
+			useCase := NewStreamChatImpl(
+				log.New(io.Discard, "", 0),
+				timeProvider,
+				conversationRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+				assistant.CompactionPolicy{},
+				DEFAULT_CONTEXT_COMPACTION_TIMEOUT,
+				5,
+				4,
+				nil,
+				nil,
+				nil,
+				builder,
+				runner,
+				writer,
+				newFakeConversationStreams(),
+				nil,
+			)
+
+			var completed *assistant.TurnCompleted
+			err := useCase.Execute(t.Context(), "Tell me everything", "test-model", func(_ context.Context, eventType assistant.EventType, data any) error {
+				if eventType == assistant.EventType_TurnCompleted {
+					completed = common.Ptr(data.(assistant.TurnCompleted))
+				}
+				return nil
+			}, WithConversationID(conversationID))
+			assert.Equal(t, tt.expectedErr, err != nil)
+			if !tt.expectedErr {
+				require.NotNil(t, completed)
+				require.NotNil(t, completed.Latency)
+				assert.GreaterOrEqual(t, completed.Latency.TotalMs, completed.Latency.PersistenceMs)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/google/uuid"
 )
@@ -65,6 +66,7 @@ func (uc SubmitActionApprovalImpl) Execute(ctx context.Context, input SubmitActi
 
 	return uc.publisher.PublishEvent(spanCtx, outbox.Event{
 		ID:         uuid.New(),
+		TenantID:   tenant.IDFromContext(spanCtx),
 		EntityType: outbox.EntityType_ChatMessage,
 		EntityID:   input.ConversationID,
 		Topic:      outbox.Topic_ActionApprovals,
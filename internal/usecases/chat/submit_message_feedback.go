@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+const (
+	// FEEDBACK_SCORE_NEGATIVE rates an assistant response as unhelpful.
+	FEEDBACK_SCORE_NEGATIVE = -1
+	// FEEDBACK_SCORE_POSITIVE rates an assistant response as helpful.
+	FEEDBACK_SCORE_POSITIVE = 1
+)
+
+// SubmitMessageFeedback records the user rating of an assistant response.
+type SubmitMessageFeedback interface {
+	// Execute rates the assistant message, replacing any previous rating.
+	Execute(ctx context.Context, messageID uuid.UUID, score int) error
+}
+
+// SubmitMessageFeedbackImpl implements SubmitMessageFeedback.
+type SubmitMessageFeedbackImpl struct {
+	chatMessageRepo assistant.ChatMessageRepository
+}
+
+// NewSubmitMessageFeedbackImpl creates a SubmitMessageFeedbackImpl.
+func NewSubmitMessageFeedbackImpl(chatMessageRepo assistant.ChatMessageRepository) SubmitMessageFeedbackImpl {
+	return SubmitMessageFeedbackImpl{
+		chatMessageRepo: chatMessageRepo,
+	}
+}
+
+// Execute implements SubmitMessageFeedback.
+func (uc SubmitMessageFeedbackImpl) Execute(ctx context.Context, messageID uuid.UUID, score int) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if score != FEEDBACK_SCORE_NEGATIVE && score != FEEDBACK_SCORE_POSITIVE {
+		err := core.NewValidationErr("score must be -1 or 1")
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+
+	updated, err := uc.chatMessageRepo.UpdateChatMessageFeedback(spanCtx, messageID, score)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	if !updated {
+		err := core.NewNotFoundErr(fmt.Sprintf("assistant message with ID %s not found", messageID))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package chat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubmitMessageFeedbackImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	messageID := uuid.MustParse("50000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		score           int
+		setExpectations func(repo *assistant.MockChatMessageRepository)
+		expectedErr     error
+	}{
+		"positive": {
+			score: 1,
+			setExpectations: func(repo *assistant.MockChatMessageRepository) {
+				repo.EXPECT().UpdateChatMessageFeedback(mock.Anything, messageID, 1).Return(true, nil).Once()
+			},
+		},
+		"negative": {
+			score: -1,
+			setExpectations: func(repo *assistant.MockChatMessageRepository) {
+				repo.EXPECT().UpdateChatMessageFeedback(mock.Anything, messageID, -1).Return(true, nil).Once()
+			},
+		},
+		"invalid-score": {
+			score:           2,
+			setExpectations: func(*assistant.MockChatMessageRepository) {},
+			expectedErr:     core.NewValidationErr("score must be -1 or 1"),
+		},
+		"message-not-found": {
+			score: 1,
+			setExpectations: func(repo *assistant.MockChatMessageRepository) {
+				repo.EXPECT().UpdateChatMessageFeedback(mock.Anything, messageID, 1).Return(false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("assistant message with ID 50000000-0000-0000-0000-000000000001 not found"),
+		},
+		"repository-error": {
+			score: 1,
+			setExpectations: func(repo *assistant.MockChatMessageRepository) {
+				repo.EXPECT().UpdateChatMessageFeedback(mock.Anything, messageID, 1).Return(false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := assistant.NewMockChatMessageRepository(t)
+			tt.setExpectations(repo)
+
+			err := NewSubmitMessageFeedbackImpl(repo).Execute(t.Context(), messageID, tt.score)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package chat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TopicShift is the outcome of comparing a user message against the conversation topic.
+type TopicShift struct {
+	Detected  bool
+	Distance  float64
+	Threshold float64
+}
+
+// TopicShiftDetector detects user messages that drift away from the conversation topic.
+type TopicShiftDetector interface {
+	// Detect compares the user message against the compacted conversation summary.
+	Detect(ctx context.Context, conversationID uuid.UUID, userMessage string) (TopicShift, error)
+}
+
+// TopicShiftDetectorImpl implements TopicShiftDetector using the embedding distance
+// between the user message and the conversation summary.
+type TopicShiftDetectorImpl struct {
+	conversationSummaryRepo assistant.ConversationSummaryRepository
+	encoder                 semantic.Encoder
+	embeddingModel          string
+	distanceThreshold       float64
+}
+
+// NewTopicShiftDetectorImpl creates a TopicShiftDetectorImpl.
+// A non-positive distanceThreshold disables detection.
+func NewTopicShiftDetectorImpl(
+	conversationSummaryRepo assistant.ConversationSummaryRepository,
+	encoder semantic.Encoder,
+	embeddingModel string,
+	distanceThreshold float64,
+) TopicShiftDetectorImpl {
+	return TopicShiftDetectorImpl{
+		conversationSummaryRepo: conversationSummaryRepo,
+		encoder:                 encoder,
+		embeddingModel:          embeddingModel,
+		distanceThreshold:       distanceThreshold,
+	}
+}
+
+// Detect implements TopicShiftDetector.
+func (d TopicShiftDetectorImpl) Detect(ctx context.Context, conversationID uuid.UUID, userMessage string) (TopicShift, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	shift := TopicShift{Threshold: d.distanceThreshold}
+	if d.distanceThreshold <= 0 {
+		return shift, nil
+	}
+
+	summary, found, err := d.conversationSummaryRepo.GetConversationSummary(spanCtx, conversationID)
+	if telemetry.IsErrorRecorded(span, err) {
+		return TopicShift{}, err
+	}
+	topic := strings.TrimSpace(summary.CurrentStateSummary)
+	if !found || topic == "" {
+		return shift, nil
+	}
+
+	topicVector, err := d.encoder.VectorizeQuery(spanCtx, d.embeddingModel, topic)
+	if telemetry.IsErrorRecorded(span, err) {
+		return TopicShift{}, err
+	}
+	messageVector, err := d.encoder.VectorizeQuery(spanCtx, d.embeddingModel, userMessage)
+	if telemetry.IsErrorRecorded(span, err) {
+		return TopicShift{}, err
+	}
+	if totalTokens := topicVector.TotalTokens + messageVector.TotalTokens; totalTokens > 0 {
+		metrics.RecordLLMTokensEmbedding(spanCtx, totalTokens)
+	}
+
+	similarity, ok := semantic.CosineSimilarity(topicVector.Vector, messageVector.Vector)
+	if !ok {
+		return shift, nil
+	}
+
+	shift.Distance = 1 - similarity
+	shift.Detected = shift.Distance >= d.distanceThreshold
+	span.SetAttributes(
+		attribute.Float64("topic_distance", shift.Distance),
+		attribute.Bool("topic_shift_detected", shift.Detected),
+	)
+
+	return shift, nil
+}
@@ -0,0 +1,323 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTopicShiftDetectorImpl_Detect(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	summary := assistant.ConversationSummary{
+		ConversationID:      conversationID,
+		CurrentStateSummary: "memory: dinner planning",
+	}
+
+	tests := map[string]struct {
+		threshold       float64
+		setExpectations func(*assistant.MockConversationSummaryRepository, *semantic.MockEncoder)
+		expected        TopicShift
+		expectedErr     error
+	}{
+		"disabled-threshold": {
+			threshold:       0,
+			setExpectations: func(*assistant.MockConversationSummaryRepository, *semantic.MockEncoder) {},
+			expected:        TopicShift{},
+		},
+		"no-summary": {
+			threshold: 0.5,
+			setExpectations: func(summaryRepo *assistant.MockConversationSummaryRepository, _ *semantic.MockEncoder) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(assistant.ConversationSummary{}, false, nil).
+					Once()
+			},
+			expected: TopicShift{Threshold: 0.5},
+		},
+		"shift-detected": {
+			threshold: 0.5,
+			setExpectations: func(summaryRepo *assistant.MockConversationSummaryRepository, encoder *semantic.MockEncoder) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				encoder.EXPECT().
+					VectorizeQuery(mock.Anything, "embedding-model", "memory: dinner planning").
+					Return(semantic.EmbeddingVector{Vector: []float64{1, 0}, TotalTokens: 4}, nil).
+					Once()
+				encoder.EXPECT().
+					VectorizeQuery(mock.Anything, "embedding-model", "renew my passport").
+					Return(semantic.EmbeddingVector{Vector: []float64{0, 1}, TotalTokens: 3}, nil).
+					Once()
+			},
+			expected: TopicShift{Detected: true, Distance: 1, Threshold: 0.5},
+		},
+		"same-topic": {
+			threshold: 0.5,
+			setExpectations: func(summaryRepo *assistant.MockConversationSummaryRepository, encoder *semantic.MockEncoder) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				encoder.EXPECT().
+					VectorizeQuery(mock.Anything, "embedding-model", "memory: dinner planning").
+					Return(semantic.EmbeddingVector{Vector: []float64{1, 0}}, nil).
+					Once()
+				encoder.EXPECT().
+					VectorizeQuery(mock.Anything, "embedding-model", "renew my passport").
+					Return(semantic.EmbeddingVector{Vector: []float64{1, 0}}, nil).
+					Once()
+			},
+			expected: TopicShift{Distance: 0, Threshold: 0.5},
+		},
+		"summary-error": {
+			threshold: 0.5,
+			setExpectations: func(summaryRepo *assistant.MockConversationSummaryRepository, _ *semantic.MockEncoder) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(assistant.ConversationSummary{}, false, errors.New("db error")).
+					Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"encoder-error": {
+			threshold: 0.5,
+			setExpectations: func(summaryRepo *assistant.MockConversationSummaryRepository, encoder *semantic.MockEncoder) {
+				summaryRepo.EXPECT().
+					GetConversationSummary(mock.Anything, conversationID).
+					Return(summary, true, nil).
+					Once()
+				encoder.EXPECT().
+					VectorizeQuery(mock.Anything, "embedding-model", "memory: dinner planning").
+					Return(semantic.EmbeddingVector{}, errors.New("encoder error")).
+					Once()
+			},
+			expectedErr: errors.New("encoder error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			encoder := semantic.NewMockEncoder(t)
+			tt.setExpectations(summaryRepo, encoder)
+
+			detector := NewTopicShiftDetectorImpl(summaryRepo, encoder, "embedding-model", tt.threshold)
+			got, err := detector.Detect(t.Context(), conversationID, "renew my passport")
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestStreamChatImpl_HandleTopicShift(t *testing.T) {
+	t.Parallel()
+
+	conversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}
+	splitConversation := assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002")}
+	pinnedTodos := []assistant.PinnedTodo{
+		{ID: uuid.MustParse("223e4567-e89b-12d3-a456-426614174001"), Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+	}
+	shift := TopicShift{Detected: true, Distance: 0.8, Threshold: 0.65}
+
+	tests := map[string]struct {
+		autoSplit            bool
+		sandboxed            bool
+		setExpectations      func(*MockTopicShiftDetector, *MockConversationSnapshotter, *assistant.MockConversationRepository)
+		expectedConversation assistant.Conversation
+		expectedCreated      bool
+		expectedEvents       []assistant.EventType
+		expectedPayloads     []any
+		expectErr            bool
+	}{
+		"no-shift": {
+			setExpectations: func(detector *MockTopicShiftDetector, _ *MockConversationSnapshotter, _ *assistant.MockConversationRepository) {
+				detector.EXPECT().
+					Detect(mock.Anything, conversation.ID, "renew my passport").
+					Return(TopicShift{Distance: 0.2, Threshold: 0.65}, nil).
+					Once()
+			},
+			expectedConversation: conversation,
+			expectedEvents:       []assistant.EventType{},
+			expectedPayloads:     []any{},
+		},
+		"detection-error-is-ignored": {
+			setExpectations: func(detector *MockTopicShiftDetector, _ *MockConversationSnapshotter, _ *assistant.MockConversationRepository) {
+				detector.EXPECT().
+					Detect(mock.Anything, conversation.ID, "renew my passport").
+					Return(TopicShift{}, errors.New("encoder error")).
+					Once()
+			},
+			expectedConversation: conversation,
+			expectedEvents:       []assistant.EventType{},
+			expectedPayloads:     []any{},
+		},
+		"suggests-new-conversation": {
+			setExpectations: func(detector *MockTopicShiftDetector, _ *MockConversationSnapshotter, _ *assistant.MockConversationRepository) {
+				detector.EXPECT().
+					Detect(mock.Anything, conversation.ID, "renew my passport").
+					Return(shift, nil).
+					Once()
+			},
+			expectedConversation: conversation,
+			expectedEvents:       []assistant.EventType{assistant.EventType_TopicShiftSuggested},
+			expectedPayloads: []any{
+				assistant.TopicShiftSuggested{ConversationID: conversation.ID, Distance: 0.8, Threshold: 0.65},
+			},
+		},
+		"splits-conversation-and-carries-over-pinned-todos": {
+			autoSplit: true,
+			setExpectations: func(
+				detector *MockTopicShiftDetector,
+				snapshotter *MockConversationSnapshotter,
+				conversationRepo *assistant.MockConversationRepository,
+			) {
+				detector.EXPECT().
+					Detect(mock.Anything, conversation.ID, "renew my passport").
+					Return(shift, nil).
+					Once()
+				conversationRepo.EXPECT().
+					CreateConversation(mock.Anything, "renew my passport", assistant.ConversationTitleSource_Auto).
+					Return(splitConversation, nil).
+					Once()
+				snapshotter.EXPECT().
+					CarryOver(mock.Anything, conversation.ID, splitConversation.ID).
+					Return(pinnedTodos, nil).
+					Once()
+			},
+			expectedConversation: splitConversation,
+			expectedCreated:      true,
+			expectedEvents:       []assistant.EventType{assistant.EventType_ConversationSplit},
+			expectedPayloads: []any{
+				assistant.ConversationSplit{
+					PreviousConversationID: conversation.ID,
+					ConversationID:         splitConversation.ID,
+					Distance:               0.8,
+					Threshold:              0.65,
+					PinnedTodos:            pinnedTodos,
+				},
+			},
+		},
+		"splits-conversation-when-carry-over-fails": {
+			autoSplit: true,
+			setExpectations: func(
+				detector *MockTopicShiftDetector,
+				snapshotter *MockConversationSnapshotter,
+				conversationRepo *assistant.MockConversationRepository,
+			) {
+				detector.EXPECT().
+					Detect(mock.Anything, conversation.ID, "renew my passport").
+					Return(shift, nil).
+					Once()
+				conversationRepo.EXPECT().
+					CreateConversation(mock.Anything, "renew my passport", assistant.ConversationTitleSource_Auto).
+					Return(splitConversation, nil).
+					Once()
+				snapshotter.EXPECT().
+					CarryOver(mock.Anything, conversation.ID, splitConversation.ID).
+					Return(nil, errors.New("db error")).
+					Once()
+			},
+			expectedConversation: splitConversation,
+			expectedCreated:      true,
+			expectedEvents:       []assistant.EventType{assistant.EventType_ConversationSplit},
+			expectedPayloads: []any{
+				assistant.ConversationSplit{
+					PreviousConversationID: conversation.ID,
+					ConversationID:         splitConversation.ID,
+					Distance:               0.8,
+					Threshold:              0.65,
+					PinnedTodos:            []assistant.PinnedTodo{},
+				},
+			},
+		},
+		"sandboxed-skips-detection": {
+			autoSplit:            true,
+			sandboxed:            true,
+			setExpectations:      func(*MockTopicShiftDetector, *MockConversationSnapshotter, *assistant.MockConversationRepository) {},
+			expectedConversation: conversation,
+			expectedEvents:       []assistant.EventType{},
+			expectedPayloads:     []any{},
+		},
+		"create-conversation-error": {
+			autoSplit: true,
+			setExpectations: func(
+				detector *MockTopicShiftDetector,
+				_ *MockConversationSnapshotter,
+				conversationRepo *assistant.MockConversationRepository,
+			) {
+				detector.EXPECT().
+					Detect(mock.Anything, conversation.ID, "renew my passport").
+					Return(shift, nil).
+					Once()
+				conversationRepo.EXPECT().
+					CreateConversation(mock.Anything, "renew my passport", assistant.ConversationTitleSource_Auto).
+					Return(assistant.Conversation{}, errors.New("db error")).
+					Once()
+			},
+			expectedEvents:   []assistant.EventType{},
+			expectedPayloads: []any{},
+			expectErr:        true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			detector := NewMockTopicShiftDetector(t)
+			snapshotter := NewMockConversationSnapshotter(t)
+			conversationRepo := assistant.NewMockConversationRepository(t)
+			tt.setExpectations(detector, snapshotter, conversationRepo)
+
+			useCase := StreamChatImpl{
+				logger:                  log.New(io.Discard, "", 0),
+				conversationRepo:        conversationRepo,
+				conversationSnapshotter: snapshotter,
+				topicShiftDetector:      detector,
+				topicShiftAutoSplit:     tt.autoSplit,
+			}
+
+			ctx := t.Context()
+			if tt.sandboxed {
+				ctx = transaction.WithSandbox(ctx)
+			}
+
+			gotEvents := []assistant.EventType{}
+			gotPayloads := []any{}
+			gotConversation, gotCreated, err := useCase.handleTopicShift(
+				ctx,
+				conversation,
+				"renew my passport",
+				func(_ context.Context, eventType assistant.EventType, data any) error {
+					gotEvents = append(gotEvents, eventType)
+					gotPayloads = append(gotPayloads, data)
+					return nil
+				},
+			)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedConversation, gotConversation)
+			assert.Equal(t, tt.expectedCreated, gotCreated)
+			assert.Equal(t, tt.expectedEvents, gotEvents)
+			assert.Equal(t, tt.expectedPayloads, gotPayloads)
+		})
+	}
+}
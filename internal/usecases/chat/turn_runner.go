@@ -2,10 +2,16 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -24,18 +30,22 @@ type TurnRunnerImpl struct {
 	logger         *log.Logger
 	assistant      assistant.Assistant
 	actionPipeline ActionPipeline
+	traceReasoning bool
 }
 
 // NewTurnRunnerImpl creates a TurnRunnerImpl.
+// When traceReasoning is enabled, the reasoning of each turn is recorded on its trace span for debugging.
 func NewTurnRunnerImpl(
 	logger *log.Logger,
 	assistantClient assistant.Assistant,
 	actionPipeline ActionPipeline,
+	traceReasoning bool,
 ) TurnRunnerImpl {
 	return TurnRunnerImpl{
 		logger:         logger,
 		assistant:      assistantClient,
 		actionPipeline: actionPipeline,
+		traceReasoning: traceReasoning,
 	}
 }
 
@@ -53,14 +63,24 @@ func (r TurnRunnerImpl) Run(ctx context.Context, state TurnState, onEvent assist
 		return err
 	}
 
+	var reasoning strings.Builder
+	defer r.recordReasoning(span, &reasoning)
+
 	runTurnRecoveryAttempted := false
+	contextTruncated := false
+	requestedAt := time.Now()
+	firstTokenRecorded := false
 	for continueStreaming := true; continueStreaming; {
 		continueStreaming = false
 		var streamEventErr error
 		request := state.Request()
 
 		err := r.assistant.RunTurn(spanCtx, request, func(turnCtx context.Context, eventType assistant.EventType, data any) error {
-			continueStreamingRequested, eventErr := r.handleStreamEvent(turnCtx, eventType, data, state, onEvent)
+			if !firstTokenRecorded && isFirstTokenEvent(eventType) {
+				firstTokenRecorded = true
+				state.RecordLatency(TurnPhase_FirstToken, time.Since(requestedAt))
+			}
+			continueStreamingRequested, eventErr := r.handleStreamEvent(turnCtx, eventType, data, state, &reasoning, onEvent)
 			if continueStreamingRequested {
 				continueStreaming = true
 			}
@@ -70,6 +90,18 @@ func (r TurnRunnerImpl) Run(ctx context.Context, state TurnState, onEvent assist
 			return eventErr
 		})
 		if err != nil {
+			if streamEventErr == nil && !contextTruncated && isContextTooLong(err) {
+				contextTruncated = true
+				truncated, truncateErr := r.truncateContext(spanCtx, state, onEvent)
+				if truncateErr != nil {
+					return truncateErr
+				}
+				if truncated {
+					continueStreaming = true
+					r.logger.Printf("StreamChat: context too long, retrying with truncated history. err=%v", err)
+					continue
+				}
+			}
 			if streamEventErr == nil && prepareRunTurnRecovery(err, state, &runTurnRecoveryAttempted) {
 				continueStreaming = true
 				r.logger.Printf("StreamChat: encountered error during RunTurn, but prepared recovery. err=%v", err)
@@ -88,6 +120,7 @@ func (r TurnRunnerImpl) handleStreamEvent(
 	eventType assistant.EventType,
 	data any,
 	state TurnState,
+	reasoning *strings.Builder,
 	onEvent assistant.EventCallback,
 ) (bool, error) {
 	switch eventType {
@@ -97,6 +130,12 @@ func (r TurnRunnerImpl) handleStreamEvent(
 		delta := data.(assistant.MessageDelta)
 		state.AppendAssistantContent(delta.Text)
 		return false, onEvent(ctx, assistant.EventType_MessageDelta, delta)
+	case assistant.EventType_Reasoning:
+		delta := data.(assistant.Reasoning)
+		if r.traceReasoning {
+			reasoning.WriteString(delta.Text)
+		}
+		return false, onEvent(ctx, assistant.EventType_Reasoning, delta)
 	case assistant.EventType_TurnCompleted:
 		done := data.(assistant.TurnCompleted)
 		state.AccumulateTokenUsage(done.Usage)
@@ -106,11 +145,66 @@ func (r TurnRunnerImpl) handleStreamEvent(
 	}
 }
 
+// isFirstTokenEvent reports whether the stream event carries model output, as opposed to completion metadata.
+func isFirstTokenEvent(eventType assistant.EventType) bool {
+	switch eventType {
+	case assistant.EventType_MessageDelta, assistant.EventType_Reasoning, assistant.EventType_ActionRequested:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordReasoning attaches the accumulated turn reasoning to the span when reasoning tracing is enabled.
+func (r TurnRunnerImpl) recordReasoning(span trace.Span, reasoning *strings.Builder) {
+	if !r.traceReasoning || reasoning.Len() == 0 {
+		return
+	}
+	span.AddEvent("Assistant reasoning", trace.WithAttributes(
+		attribute.String("reasoning", reasoning.String()),
+	))
+}
+
+// truncateContext drops the earlier conversation history from the request, warns the client and
+// records the degradation. It reports false when there was no history left to drop.
+func (r TurnRunnerImpl) truncateContext(ctx context.Context, state TurnState, onEvent assistant.EventCallback) (bool, error) {
+	dropped := state.TruncateToCurrentTurn()
+	if dropped == 0 {
+		return false, nil
+	}
+
+	event := assistant.ContextTruncated{
+		ConversationID:       state.Conversation().ID,
+		TurnID:               state.TurnID(),
+		DroppedMessageCount:  dropped,
+		RetainedMessageCount: len(state.Request().Messages),
+	}
+	trace.SpanFromContext(ctx).AddEvent("Context truncated", trace.WithAttributes(
+		attribute.Int("dropped_message_count", event.DroppedMessageCount),
+		attribute.Int("retained_message_count", event.RetainedMessageCount),
+	))
+	metrics.RecordChatContextTruncated(ctx, state.Model())
+
+	return true, onEvent(ctx, assistant.EventType_ContextTruncated, event)
+}
+
+// isContextTooLong reports whether the turn failed because the prompt exceeded the model context window.
+func isContextTooLong(err error) bool {
+	var turnErr *assistant.TurnError
+	return errors.As(err, &turnErr) && turnErr.Code == assistant.TurnErrorCode_ContextTooLong
+}
+
 // prepareRunTurnRecovery rewrites the request for one retry after an internal streaming failure.
+// Classified failures other than an oversized context are reported to the client instead,
+// since a fallback request would hit the same provider condition.
 func prepareRunTurnRecovery(runErr error, state TurnState, attempted *bool) bool {
 	if *attempted {
 		return false
 	}
+	var turnErr *assistant.TurnError
+	if errors.As(runErr, &turnErr) && turnErr.Code != assistant.TurnErrorCode_ContextTooLong {
+		return false
+	}
 	*attempted = true
 	state.PrepareFallbackResponseRequest(runErr, MAX_RECOVERY_MESSAGES)
 
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/google/uuid"
@@ -40,18 +41,192 @@ func TestTurnRunner_Run_RetriesAfterRecovery(t *testing.T) {
 		log.New(io.Discard, "", 0),
 		assistantClient,
 		actionPipeline,
+		false,
 	)
 
 	state := NewTurnState(assistant.Conversation{}, false, nil, assistant.TurnRequest{
 		Model:    "test-model",
 		Messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "Hello"}},
-	}, 7)
+	}, 7, nil)
 
 	err := runner.Run(t.Context(), state, func(context.Context, assistant.EventType, any) error { return nil })
 	require.NoError(t, err)
 	assert.Equal(t, 2, callCount)
 }
 
+func TestTurnRunner_Run_SkipsRecoveryForClassifiedFailures(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		runErr        error
+		wantCallCount int
+		wantErr       bool
+	}{
+		"rate-limited": {
+			runErr:        assistant.NewTurnError(assistant.TurnErrorCode_RateLimited, 0, errors.New("too many requests")),
+			wantCallCount: 1,
+			wantErr:       true,
+		},
+		"network": {
+			runErr:        assistant.NewTurnError(assistant.TurnErrorCode_Network, 0, errors.New("connection reset")),
+			wantCallCount: 1,
+			wantErr:       true,
+		},
+		"content-filtered": {
+			runErr:        assistant.NewTurnError(assistant.TurnErrorCode_ContentFiltered, 0, errors.New("filtered")),
+			wantCallCount: 1,
+			wantErr:       true,
+		},
+		"context-too-long-recovers": {
+			runErr:        assistant.NewTurnError(assistant.TurnErrorCode_ContextTooLong, 0, errors.New("context length exceeded")),
+			wantCallCount: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assistantClient := assistant.NewMockAssistant(t)
+			callCount := 0
+			assistantClient.EXPECT().
+				RunTurn(mock.Anything, mock.Anything, mock.Anything).
+				RunAndReturn(func(context.Context, assistant.TurnRequest, assistant.EventCallback) error {
+					callCount++
+					if callCount == 1 {
+						return tt.runErr
+					}
+					return nil
+				}).
+				Times(tt.wantCallCount)
+
+			runner := NewTurnRunnerImpl(
+				log.New(io.Discard, "", 0),
+				assistantClient,
+				NewMockActionPipeline(t),
+				false,
+			)
+
+			state := NewTurnState(assistant.Conversation{}, false, nil, assistant.TurnRequest{
+				Model:    "test-model",
+				Messages: []assistant.Message{{Role: assistant.ChatRole_User, Content: "Hello"}},
+			}, 7, nil)
+
+			err := runner.Run(t.Context(), state, func(context.Context, assistant.EventType, any) error { return nil })
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.runErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCallCount, callCount)
+		})
+	}
+}
+
+func TestTurnRunner_Run_TruncatesContextWhenTooLong(t *testing.T) {
+	t.Parallel()
+
+	contextErr := assistant.NewTurnError(assistant.TurnErrorCode_ContextTooLong, 0, errors.New("context length exceeded"))
+	messages := []assistant.Message{
+		{Role: assistant.ChatRole_System, Content: "system prompt"},
+		{Role: assistant.ChatRole_System, Content: "compacted summary"},
+		{Role: assistant.ChatRole_User, Content: "old question"},
+		{Role: assistant.ChatRole_Assistant, Content: "old answer"},
+		{Role: assistant.ChatRole_User, Content: "current question"},
+		{Role: assistant.ChatRole_System, Content: "skills"},
+	}
+	truncated := []assistant.Message{messages[0], messages[1], messages[4], messages[5]}
+
+	tests := map[string]struct {
+		messages      []assistant.Message
+		runErrs       []error
+		wantRequests  [][]assistant.Message
+		wantTruncated *assistant.ContextTruncated
+		wantErr       bool
+	}{
+		"retries-with-truncated-history": {
+			messages:     messages,
+			runErrs:      []error{contextErr, nil},
+			wantRequests: [][]assistant.Message{messages, truncated},
+			wantTruncated: &assistant.ContextTruncated{
+				DroppedMessageCount:  2,
+				RetainedMessageCount: 4,
+			},
+		},
+		"falls-back-when-truncated-history-still-too-long": {
+			messages:     messages,
+			runErrs:      []error{contextErr, contextErr, contextErr},
+			wantRequests: [][]assistant.Message{messages, truncated, nil},
+			wantTruncated: &assistant.ContextTruncated{
+				DroppedMessageCount:  2,
+				RetainedMessageCount: 4,
+			},
+			wantErr: true,
+		},
+		"skips-truncation-without-history": {
+			messages:     truncated,
+			runErrs:      []error{contextErr, nil},
+			wantRequests: [][]assistant.Message{truncated, nil},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assistantClient := assistant.NewMockAssistant(t)
+			callCount := 0
+			assistantClient.EXPECT().
+				RunTurn(mock.Anything, mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, req assistant.TurnRequest, _ assistant.EventCallback) error {
+					if want := tt.wantRequests[callCount]; want != nil {
+						assert.Equal(t, want, req.Messages)
+					}
+					err := tt.runErrs[callCount]
+					callCount++
+					return err
+				}).
+				Times(len(tt.runErrs))
+
+			runner := NewTurnRunnerImpl(
+				log.New(io.Discard, "", 0),
+				assistantClient,
+				NewMockActionPipeline(t),
+				false,
+			)
+
+			conversation := assistant.Conversation{ID: uuid.New()}
+			state := NewTurnState(conversation, false, nil, assistant.TurnRequest{
+				Model:    "test-model",
+				Messages: tt.messages,
+			}, 7, nil)
+
+			var gotTruncated *assistant.ContextTruncated
+			err := runner.Run(t.Context(), state, func(_ context.Context, eventType assistant.EventType, data any) error {
+				if eventType == assistant.EventType_ContextTruncated {
+					event := data.(assistant.ContextTruncated)
+					gotTruncated = &event
+				}
+				return nil
+			})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, contextErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.wantTruncated == nil {
+				assert.Nil(t, gotTruncated)
+				return
+			}
+			want := *tt.wantTruncated
+			want.ConversationID = conversation.ID
+			want.TurnID = state.TurnID()
+			assert.Equal(t, &want, gotTruncated)
+		})
+	}
+}
+
 func TestTurnRunner_Run_ProcessesStreamEvents(t *testing.T) {
 	t.Parallel()
 
@@ -61,6 +236,7 @@ func TestTurnRunner_Run_ProcessesStreamEvents(t *testing.T) {
 		log.New(io.Discard, "", 0),
 		assistantClient,
 		actionPipeline,
+		true,
 	)
 
 	state := NewTurnState(
@@ -69,6 +245,7 @@ func TestTurnRunner_Run_ProcessesStreamEvents(t *testing.T) {
 		nil,
 		assistant.TurnRequest{Model: "test-model"},
 		7,
+		nil,
 	)
 
 	actionPipeline.EXPECT().
@@ -79,6 +256,9 @@ func TestTurnRunner_Run_ProcessesStreamEvents(t *testing.T) {
 	assistantClient.EXPECT().
 		RunTurn(mock.Anything, request, mock.Anything).
 		RunAndReturn(func(ctx context.Context, _ assistant.TurnRequest, onEvent assistant.EventCallback) error {
+			if err := onEvent(ctx, assistant.EventType_Reasoning, assistant.Reasoning{Text: "The user greets me."}); err != nil {
+				return err
+			}
 			if err := onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hello back"}); err != nil {
 				return err
 			}
@@ -92,23 +272,72 @@ func TestTurnRunner_Run_ProcessesStreamEvents(t *testing.T) {
 		Once()
 
 	var turnStarted assistant.TurnStarted
+	var reasoning assistant.Reasoning
 	var eventTypes []assistant.EventType
 	err := runner.Run(t.Context(), state, func(_ context.Context, eventType assistant.EventType, data any) error {
 		eventTypes = append(eventTypes, eventType)
-		if eventType == assistant.EventType_TurnStarted {
+		switch eventType {
+		case assistant.EventType_TurnStarted:
 			turnStarted = data.(assistant.TurnStarted)
+		case assistant.EventType_Reasoning:
+			reasoning = data.(assistant.Reasoning)
 		}
 		return nil
 	})
 
 	require.NoError(t, err)
 	assert.Equal(t, state.TurnID(), turnStarted.TurnID)
+	assert.Equal(t, "The user greets me.", reasoning.Text)
 	assert.Equal(t, "Hello back", state.AssistantContent())
 	assert.Equal(t, 3, state.TokenUsage().PromptTokens)
 	assert.Equal(t, 5, state.TokenUsage().CompletionTokens)
 	assert.Equal(t, 8, state.TokenUsage().TotalTokens)
 	assert.Equal(t, []assistant.EventType{
 		assistant.EventType_TurnStarted,
+		assistant.EventType_Reasoning,
 		assistant.EventType_MessageDelta,
 	}, eventTypes)
 }
+
+func TestTurnRunner_Run_RecordsTimeToFirstToken(t *testing.T) {
+	t.Parallel()
+
+	assistantClient := assistant.NewMockAssistant(t)
+	runner := NewTurnRunnerImpl(
+		log.New(io.Discard, "", 0),
+		assistantClient,
+		NewMockActionPipeline(t),
+		false,
+	)
+
+	state := NewTurnState(
+		assistant.Conversation{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		false,
+		nil,
+		assistant.TurnRequest{Model: "test-model"},
+		7,
+		nil,
+	)
+
+	assistantClient.EXPECT().
+		RunTurn(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ assistant.TurnRequest, onEvent assistant.EventCallback) error {
+			time.Sleep(20 * time.Millisecond)
+			if err := onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: "Hello"}); err != nil {
+				return err
+			}
+			time.Sleep(50 * time.Millisecond)
+			if err := onEvent(ctx, assistant.EventType_MessageDelta, assistant.MessageDelta{Text: " there"}); err != nil {
+				return err
+			}
+			return onEvent(ctx, assistant.EventType_TurnCompleted, assistant.TurnCompleted{})
+		}).
+		Once()
+
+	err := runner.Run(t.Context(), state, func(context.Context, assistant.EventType, any) error { return nil })
+
+	require.NoError(t, err)
+	timeToFirstToken := state.Latency().TimeToFirstTokenMs
+	assert.GreaterOrEqual(t, timeToFirstToken, int64(20))
+	assert.Less(t, timeToFirstToken, int64(70))
+}
@@ -1,7 +1,9 @@
 package chat
 
 import (
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/google/uuid"
@@ -12,6 +14,20 @@ const (
 	MAX_REPEATED_ACTION_CALL_HIT = 5
 )
 
+// TurnPhase identifies a timed phase of a turn.
+type TurnPhase string
+
+const (
+	// TurnPhase_HistoryFetch is the loading of the conversation history and its compacted summary.
+	TurnPhase_HistoryFetch TurnPhase = "history_fetch"
+	// TurnPhase_PromptBuild is the rest of the turn preparation after the history was loaded.
+	TurnPhase_PromptBuild TurnPhase = "prompt_build"
+	// TurnPhase_FirstToken is the wait for the first streamed event of the first model request.
+	TurnPhase_FirstToken TurnPhase = "time_to_first_token"
+	// TurnPhase_Persistence is the writing of the turn messages to the transcript.
+	TurnPhase_Persistence TurnPhase = "persistence"
+)
+
 // TurnState owns the mutable in-memory state for one streamed assistant turn.
 type TurnState interface {
 	// Conversation returns the target conversation for the turn.
@@ -25,8 +41,14 @@ type TurnState interface {
 	// PrepareFallbackResponseRequest rewrites the request into a text-only fallback response after an internal turn failure.
 	// It removes available actions, trims the retained context window, and appends one system instruction for the retry.
 	PrepareFallbackResponseRequest(runErr error, maxMessages int)
+	// TruncateToCurrentTurn drops the conversation history that precedes the current user message,
+	// keeping system messages (prompt, compacted summary and skills) and the current turn exchange.
+	// It returns the number of dropped messages.
+	TruncateToCurrentTurn() int
 	// Model returns the current request model name.
 	Model() string
+	// Seed returns the sampling seed of the turn request, or nil when the turn has none.
+	Seed() *int64
 	// SelectedSkills returns the skills selected for the turn.
 	SelectedSkills() []assistant.SelectedSkill
 	// TokenUsage returns the accumulated token usage for the turn.
@@ -45,6 +67,20 @@ type TurnState interface {
 	HasExceededMaxActionCycles() bool
 	// HasExceededRepeatedActionCalls reports whether the same action signature repeated too many times.
 	HasExceededRepeatedActionCalls(functionName, arguments string) bool
+	// ActionLoopDetected reports whether the action cycle or repeated action call limit stopped an action.
+	ActionLoopDetected() bool
+	// ExecutedActionResult returns the result of the action call with the given ID when it was already executed in the turn.
+	ExecutedActionResult(actionCallID string) (assistant.Message, bool)
+	// RecordExecutedAction remembers the result of an executed action call, so a duplicate of its ID is not executed again.
+	RecordExecutedAction(actionCallID string, result assistant.Message)
+	// Experiment returns the experiment variant the turn runs under, or nil outside experiments.
+	Experiment() *assistant.ExperimentAssignment
+	// RecordLatency adds d to the time spent in a phase of the turn.
+	RecordLatency(phase TurnPhase, d time.Duration)
+	// RecordActionLatency records how long an executed action call took.
+	RecordActionLatency(actionCall assistant.ActionCall, d time.Duration)
+	// Latency returns the latency breakdown of the turn so far.
+	Latency() assistant.TurnLatency
 }
 
 // turnState is the default TurnState implementation.
@@ -59,6 +95,12 @@ type turnState struct {
 	turnSequence            int64
 	assistantMessageContent strings.Builder
 	tracker                 *actionCycleTracker
+	actionLoopDetected      bool
+	executedActions         map[string]assistant.Message
+	experiment              *assistant.ExperimentAssignment
+	startedAt               time.Time
+	phaseLatencies          map[TurnPhase]time.Duration
+	actionLatencies         []assistant.ActionLatency
 }
 
 // NewTurnState creates the default TurnState implementation.
@@ -68,7 +110,20 @@ func NewTurnState(
 	selectedSkills []assistant.SelectedSkill,
 	request assistant.TurnRequest,
 	maxActionCycles int,
+	experiment *assistant.ExperimentAssignment,
 ) TurnState {
+	return newTurnState(conversation, conversationCreated, selectedSkills, request, maxActionCycles, experiment)
+}
+
+// newTurnState creates a turnState for a new turn.
+func newTurnState(
+	conversation assistant.Conversation,
+	conversationCreated bool,
+	selectedSkills []assistant.SelectedSkill,
+	request assistant.TurnRequest,
+	maxActionCycles int,
+	experiment *assistant.ExperimentAssignment,
+) *turnState {
 	state := &turnState{
 		conversation:        conversation,
 		conversationCreated: conversationCreated,
@@ -76,6 +131,10 @@ func NewTurnState(
 		request:             request,
 		turnID:              uuid.New(),
 		selectedSkills:      selectedSkills,
+		experiment:          experiment,
+		executedActions:     map[string]assistant.Message{},
+		startedAt:           time.Now(),
+		phaseLatencies:      map[TurnPhase]time.Duration{},
 		tracker: newActionCycleTracker(
 			maxActionCycles,
 			MAX_REPEATED_ACTION_CALL_HIT,
@@ -103,12 +162,69 @@ func (s *turnState) AssistantContent() string {
 
 // HasExceededMaxActionCycles increments the action cycle count and reports whether the limit was exceeded.
 func (s *turnState) HasExceededMaxActionCycles() bool {
-	return s.tracker.hasExceededMaxCycles()
+	exceeded := s.tracker.hasExceededMaxCycles()
+	s.actionLoopDetected = s.actionLoopDetected || exceeded
+	return exceeded
 }
 
 // HasExceededRepeatedActionCalls reports whether the same action signature repeated too many times.
 func (s *turnState) HasExceededRepeatedActionCalls(functionName, arguments string) bool {
-	return s.tracker.hasExceededMaxActionCalls(functionName, arguments)
+	exceeded := s.tracker.hasExceededMaxActionCalls(functionName, arguments)
+	s.actionLoopDetected = s.actionLoopDetected || exceeded
+	return exceeded
+}
+
+// ActionLoopDetected reports whether an action loop guard tripped during the turn.
+func (s *turnState) ActionLoopDetected() bool {
+	return s.actionLoopDetected
+}
+
+// ExecutedActionResult returns the recorded result of an executed action call. Calls without an ID are never matched.
+func (s *turnState) ExecutedActionResult(actionCallID string) (assistant.Message, bool) {
+	if actionCallID == "" {
+		return assistant.Message{}, false
+	}
+	result, ok := s.executedActions[actionCallID]
+	return result, ok
+}
+
+// RecordExecutedAction records the result of an executed action call by its ID.
+func (s *turnState) RecordExecutedAction(actionCallID string, result assistant.Message) {
+	if actionCallID == "" {
+		return
+	}
+	s.executedActions[actionCallID] = result
+}
+
+// Experiment returns the experiment variant of the turn.
+func (s *turnState) Experiment() *assistant.ExperimentAssignment {
+	return s.experiment
+}
+
+// RecordLatency adds d to the time spent in a phase of the turn.
+func (s *turnState) RecordLatency(phase TurnPhase, d time.Duration) {
+	s.phaseLatencies[phase] += d
+}
+
+// RecordActionLatency appends the execution time of an action call.
+func (s *turnState) RecordActionLatency(actionCall assistant.ActionCall, d time.Duration) {
+	s.actionLatencies = append(s.actionLatencies, assistant.ActionLatency{
+		ActionCallID: actionCall.ID,
+		Name:         actionCall.Name,
+		DurationMs:   d.Milliseconds(),
+	})
+}
+
+// Latency returns the recorded phase latencies and the time elapsed since the turn preparation started.
+func (s *turnState) Latency() assistant.TurnLatency {
+	return assistant.TurnLatency{
+		HistoryFetchMs:     s.phaseLatencies[TurnPhase_HistoryFetch].Milliseconds(),
+		PromptBuildMs:      s.phaseLatencies[TurnPhase_PromptBuild].Milliseconds(),
+		TimeToFirstTokenMs: s.phaseLatencies[TurnPhase_FirstToken].Milliseconds(),
+		Actions:            slices.Clone(s.actionLatencies),
+		PersistenceMs:      s.phaseLatencies[TurnPhase_Persistence].Milliseconds(),
+		TotalMs:            time.Since(s.startedAt).Milliseconds(),
+	}
 }
 
 // Conversation returns the target conversation for the turn.
@@ -145,11 +261,41 @@ func (s *turnState) PrepareFallbackResponseRequest(runErr error, maxMessages int
 	})
 }
 
+// TruncateToCurrentTurn drops history before the last user message while keeping every system message.
+// Action results of the current turn are kept so executed actions are not requested again.
+func (s *turnState) TruncateToCurrentTurn() int {
+	lastUserIdx := -1
+	for i, msg := range s.request.Messages {
+		if msg.Role == assistant.ChatRole_User {
+			lastUserIdx = i
+		}
+	}
+	if lastUserIdx < 0 {
+		return 0
+	}
+
+	truncated := make([]assistant.Message, 0, len(s.request.Messages))
+	for i, msg := range s.request.Messages {
+		if i >= lastUserIdx || msg.Role == assistant.ChatRole_System || msg.Role == assistant.ChatRole_Developer {
+			truncated = append(truncated, msg)
+		}
+	}
+
+	dropped := len(s.request.Messages) - len(truncated)
+	s.request.Messages = truncated
+	return dropped
+}
+
 // Model returns the current request model name.
 func (s *turnState) Model() string {
 	return s.model
 }
 
+// Seed returns the sampling seed of the turn request.
+func (s *turnState) Seed() *int64 {
+	return s.request.Seed
+}
+
 // SelectedSkills returns the skills selected for the turn.
 func (s *turnState) SelectedSkills() []assistant.SelectedSkill {
 	return s.selectedSkills
@@ -4,18 +4,22 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"io/fs"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 	"go.yaml.in/yaml/v3"
 )
 
-//go:embed prompts/chat.yml
+//go:embed prompts/chat.yml prompts/chat.*.yml
 var chatPrompt embed.FS
 
 const (
@@ -38,6 +42,15 @@ type BuildTurnStateParams struct {
 	MaxActionCycles     int
 	Conversation        assistant.Conversation
 	ConversationCreated bool
+	Generation          assistant.GenerationOptions
+	// Experiment is the experiment variant assigned to the conversation, if any.
+	Experiment *assistant.ExperimentAssignment
+	// HistoryBefore limits the loaded history to messages recorded before this message, if set.
+	HistoryBefore *uuid.UUID
+	// TurnID continues an existing turn, e.g. to regenerate its response, instead of starting a new one.
+	TurnID uuid.UUID
+	// TurnSequence is the sequence of the first message written when TurnID continues an existing turn.
+	TurnSequence int64
 }
 
 // TurnStateBuilder assembles the initial TurnState before streaming begins.
@@ -48,27 +61,46 @@ type TurnStateBuilder interface {
 
 // TurnStateBuilderImpl implements TurnStateBuilder.
 type TurnStateBuilderImpl struct {
-	conversationSummaryRepo assistant.ConversationSummaryRepository
-	chatMessageRepo         assistant.ChatMessageRepository
-	timeProvider            core.CurrentTimeProvider
-	skillRegistry           assistant.SkillRegistry
-	actionRegistry          assistant.ActionRegistry
+	conversationSummaryRepo  assistant.ConversationSummaryRepository
+	conversationSnapshotRepo assistant.ConversationSnapshotRepository
+	instructionRepo          assistant.InstructionRepository
+	memories                 Memories
+	relatedConversations     RelatedConversations
+	chatMessageRepo          assistant.ChatMessageRepository
+	timeProvider             core.CurrentTimeProvider
+	skillRegistry            assistant.SkillRegistry
+	actionRegistry           assistant.ActionRegistry
+	contentBlobRepo          assistant.ContentBlobRepository
+	contentBlobMaxBytes      int
 }
 
 // NewTurnStateBuilderImpl creates a TurnStateBuilderImpl.
+// Action results stored as content blobs reach the model cut to contentBlobMaxBytes; zero sends them whole.
 func NewTurnStateBuilderImpl(
 	conversationSummaryRepo assistant.ConversationSummaryRepository,
+	conversationSnapshotRepo assistant.ConversationSnapshotRepository,
+	instructionRepo assistant.InstructionRepository,
+	memories Memories,
+	relatedConversations RelatedConversations,
 	chatMessageRepo assistant.ChatMessageRepository,
 	timeProvider core.CurrentTimeProvider,
 	skillRegistry assistant.SkillRegistry,
 	actionRegistry assistant.ActionRegistry,
+	contentBlobRepo assistant.ContentBlobRepository,
+	contentBlobMaxBytes int,
 ) TurnStateBuilderImpl {
 	return TurnStateBuilderImpl{
-		conversationSummaryRepo: conversationSummaryRepo,
-		chatMessageRepo:         chatMessageRepo,
-		timeProvider:            timeProvider,
-		skillRegistry:           skillRegistry,
-		actionRegistry:          actionRegistry,
+		conversationSummaryRepo:  conversationSummaryRepo,
+		conversationSnapshotRepo: conversationSnapshotRepo,
+		instructionRepo:          instructionRepo,
+		memories:                 memories,
+		relatedConversations:     relatedConversations,
+		chatMessageRepo:          chatMessageRepo,
+		timeProvider:             timeProvider,
+		skillRegistry:            skillRegistry,
+		actionRegistry:           actionRegistry,
+		contentBlobRepo:          contentBlobRepo,
+		contentBlobMaxBytes:      contentBlobMaxBytes,
 	}
 }
 
@@ -77,11 +109,22 @@ func (b TurnStateBuilderImpl) Build(ctx context.Context, params BuildTurnStatePa
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
-	messagesHistory, summaryContext, err := b.loadMessagesHistory(spanCtx, params.Conversation.ID)
+	startedAt := time.Now()
+	promptVersion := ""
+	if params.Experiment != nil {
+		promptVersion = params.Experiment.PromptVersion
+	}
+
+	messagesHistory, summaryContext, err := b.loadMessagesHistory(spanCtx, params.Conversation.ID, promptVersion, params.HistoryBefore)
 	if err != nil {
 		return nil, err
 	}
+	historyFetch := time.Since(startedAt)
 
+	messagesHistory = append(messagesHistory, b.recallMemories(spanCtx, params.UserMessage)...)
+	if params.ConversationCreated {
+		messagesHistory = append(messagesHistory, b.hintRelatedConversation(spanCtx, params.Conversation.ID, params.UserMessage)...)
+	}
 	messagesHistory = append(messagesHistory, assistant.Message{
 		Role:    assistant.ChatRole_User,
 		Content: params.UserMessage,
@@ -91,13 +134,15 @@ func (b TurnStateBuilderImpl) Build(ctx context.Context, params BuildTurnStatePa
 		Messages:            messagesHistory,
 		ConversationSummary: summaryContext,
 	})
+	principal := access.FromContext(ctx)
 	selectedSkills := make([]assistant.SelectedSkill, 0, len(skills))
 	relevantActions := make([]assistant.ActionDefinition, 0, len(skills))
 	uniqueActionNames := make(map[string]struct{})
 	for _, s := range skills {
 		selectedSkills = append(selectedSkills, assistant.NewSelectedSkill(s))
 		for _, tool := range s.Tools {
-			if action, ok := b.actionRegistry.GetDefinition(tool); ok {
+			// Readonly principals are only offered the actions that do not change their data.
+			if action, ok := b.actionRegistry.GetDefinition(ctx, tool); ok && action.AllowedFor(principal) {
 				if _, exists := uniqueActionNames[action.Name]; !exists {
 					relevantActions = append(relevantActions, action)
 					uniqueActionNames[action.Name] = struct{}{}
@@ -106,6 +151,8 @@ func (b TurnStateBuilderImpl) Build(ctx context.Context, params BuildTurnStatePa
 		}
 	}
 
+	b.prefetchActions(spanCtx, relevantActions, messagesHistory)
+
 	if skillsPrompt := buildSkillsPrompt(skills); skillsPrompt != "" {
 		messagesHistory = append(messagesHistory, assistant.Message{
 			Role:    assistant.ChatRole_System,
@@ -121,27 +168,89 @@ func (b TurnStateBuilderImpl) Build(ctx context.Context, params BuildTurnStatePa
 		TopP:             common.Ptr(CHAT_TOP_P),
 		AvailableActions: relevantActions,
 	}
+	if params.Experiment != nil && params.Experiment.Temperature != nil {
+		request.Temperature = common.Ptr(*params.Experiment.Temperature)
+	}
+	params.Conversation.Settings.ApplyTo(&request)
+	params.Generation.ApplyTo(&request)
 
-	return NewTurnState(
+	state := newTurnState(
 		params.Conversation,
 		params.ConversationCreated,
 		selectedSkills,
 		request,
 		params.MaxActionCycles,
-	), nil
+		params.Experiment,
+	)
+	if params.TurnID != uuid.Nil {
+		state.turnID = params.TurnID
+		state.turnSequence = params.TurnSequence
+	}
+	state.startedAt = startedAt
+	state.RecordLatency(TurnPhase_HistoryFetch, historyFetch)
+	state.RecordLatency(TurnPhase_PromptBuild, time.Since(startedAt)-historyFetch)
+	return state, nil
+}
+
+// recallMemories returns a system message with the long-term memories relevant to the user message.
+// Recall is best effort: a failure leaves the memories out instead of failing the turn.
+func (b TurnStateBuilderImpl) recallMemories(ctx context.Context, userMessage string) []assistant.Message {
+	memories, err := b.memories.Recall(ctx, userMessage)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		return nil
+	}
+	memoriesPrompt := assistant.MemoriesPrompt(memories)
+	if memoriesPrompt == "" {
+		return nil
+	}
+	return []assistant.Message{{Role: assistant.ChatRole_System, Content: memoriesPrompt}}
+}
+
+// hintRelatedConversation returns a system message pointing at an earlier conversation about the same topic
+// as the user message. The hint is best effort: a failure leaves it out instead of failing the turn.
+func (b TurnStateBuilderImpl) hintRelatedConversation(ctx context.Context, conversationID uuid.UUID, userMessage string) []assistant.Message {
+	related, found, err := b.relatedConversations.Hint(ctx, conversationID, userMessage)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) || !found {
+		return nil
+	}
+	return []assistant.Message{{
+		Role:    assistant.ChatRole_System,
+		Content: assistant.RelatedConversationHint(related, b.timeProvider.Now()),
+	}}
+}
+
+// prefetchActions lets the action registry speculatively start the likely calls of the selected actions,
+// so their results may be ready when the model requests them.
+func (b TurnStateBuilderImpl) prefetchActions(ctx context.Context, actions []assistant.ActionDefinition, messages []assistant.Message) {
+	if len(actions) == 0 {
+		return
+	}
+	actionNames := make([]string, len(actions))
+	for i, action := range actions {
+		actionNames[i] = action.Name
+	}
+	b.actionRegistry.Prefetch(ctx, actionNames, messages)
 }
 
 // loadMessagesHistory combines the current system prompt with recent non-system conversation history.
-func (b TurnStateBuilderImpl) loadMessagesHistory(ctx context.Context, conversationID uuid.UUID) ([]assistant.Message, string, error) {
-	systemPrompt, summaryContext, lastSummarizedMessageID, err := b.buildSystemPrompt(ctx, conversationID)
+func (b TurnStateBuilderImpl) loadMessagesHistory(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	promptVersion string,
+	historyBefore *uuid.UUID,
+) ([]assistant.Message, string, error) {
+	systemPrompt, summaryContext, lastSummarizedMessageID, err := b.buildSystemPrompt(ctx, conversationID, promptVersion)
 	if err != nil {
 		return nil, "", err
 	}
 
-	historyOptions := make([]assistant.ListChatMessagesOption, 0, 1)
+	historyOptions := make([]assistant.ListChatMessagesOption, 0, 2)
 	if lastSummarizedMessageID != nil {
 		historyOptions = append(historyOptions, assistant.WithChatMessagesAfterMessageID(*lastSummarizedMessageID))
 	}
+	if historyBefore != nil {
+		historyOptions = append(historyOptions, assistant.WithChatMessagesBeforeMessageID(*historyBefore))
+	}
 
 	history, _, err := b.chatMessageRepo.ListChatMessages(ctx, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES, historyOptions...)
 	if err != nil {
@@ -155,11 +264,18 @@ func (b TurnStateBuilderImpl) loadMessagesHistory(ctx context.Context, conversat
 		history = history[1:]
 	}
 
+	blobs := b.loadContentBlobs(ctx, history)
 	for _, msg := range history {
-		if msg.ChatRole != assistant.ChatRole_System {
+		if msg.ChatRole != assistant.ChatRole_System && !msg.IsModerated() && !msg.IsSuperseded() {
+			content := msg.Content
+			if msg.ContentRef != nil {
+				if blob, found := blobs[*msg.ContentRef]; found {
+					content = blob.Excerpt(b.contentBlobMaxBytes)
+				}
+			}
 			messages = append(messages, assistant.Message{
 				Role:         msg.ChatRole,
-				Content:      msg.Content,
+				Content:      content,
 				ActionCallID: msg.ActionCallID,
 				ActionCalls:  msg.ActionCalls,
 				ActionError:  msg.ErrorMessage,
@@ -170,12 +286,38 @@ func (b TurnStateBuilderImpl) loadMessagesHistory(ctx context.Context, conversat
 	return messages, summaryContext, nil
 }
 
-// buildSystemPrompt loads the base prompt template and appends the latest conversation summary context.
+// loadContentBlobs loads the content blobs referenced by the history, keyed by hash. When they cannot be
+// loaded, the messages keep their inline previews and the turn goes on.
+func (b TurnStateBuilderImpl) loadContentBlobs(ctx context.Context, history []assistant.ChatMessage) map[string]assistant.ContentBlob {
+	hashes := make([]string, 0)
+	for _, msg := range history {
+		if msg.ContentRef != nil && !slices.Contains(hashes, *msg.ContentRef) {
+			hashes = append(hashes, *msg.ContentRef)
+		}
+	}
+	if len(hashes) == 0 || b.contentBlobRepo == nil {
+		return nil
+	}
+
+	blobs, err := b.contentBlobRepo.ListContentBlobs(ctx, hashes)
+	if telemetry.IsErrorRecorded(trace.SpanFromContext(ctx), err) {
+		return nil
+	}
+	byHash := make(map[string]assistant.ContentBlob, len(blobs))
+	for _, blob := range blobs {
+		byHash[blob.Hash] = blob
+	}
+	return byHash
+}
+
+// buildSystemPrompt loads the base prompt template and appends the latest compacted conversation context
+// and the pinned instructions that apply to the conversation.
 func (b TurnStateBuilderImpl) buildSystemPrompt(
 	ctx context.Context,
 	conversationID uuid.UUID,
+	promptVersion string,
 ) ([]assistant.Message, string, *uuid.UUID, error) {
-	file, err := chatPrompt.Open("prompts/chat.yml")
+	file, err := chatPrompt.Open(chatPromptPath(promptVersion))
 	if err != nil {
 		return nil, "", nil, fmt.Errorf("failed to open chat prompt: %w", err)
 	}
@@ -199,23 +341,79 @@ func (b TurnStateBuilderImpl) buildSystemPrompt(
 		}
 	}
 
-	latestSummary, found, err := b.conversationSummaryRepo.GetConversationSummary(ctx, conversationID)
+	compactedContext, summaryContext, lastCompactedMessageID, err := b.loadCompactedContext(ctx, conversationID)
 	if err != nil {
-		return nil, "", nil, fmt.Errorf("failed to load conversation summary: %w", err)
-	}
-
-	summaryText := "No conversation summary available."
-	if found && strings.TrimSpace(latestSummary.CurrentStateSummary) != "" {
-		summaryText = strings.TrimSpace(latestSummary.CurrentStateSummary)
+		return nil, "", nil, err
 	}
 	messages = append(messages, assistant.Message{
 		Role: assistant.ChatRole_System,
 		Content: fmt.Sprintf(
 			"Conversation compacted context:\n%s\n\nUse this as compact memory, but prioritize explicit user instructions in this turn.",
-			summaryText,
+			compactedContext,
 		),
 	})
 
+	instructions, err := b.instructionRepo.ListInstructions(ctx, conversationID)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("failed to load pinned instructions: %w", err)
+	}
+	if instructionsPrompt := assistant.InstructionsPrompt(instructions); instructionsPrompt != "" {
+		messages = append(messages, assistant.Message{
+			Role:    assistant.ChatRole_System,
+			Content: instructionsPrompt,
+		})
+	}
+
+	return messages, summaryContext, lastCompactedMessageID, nil
+}
+
+// chatPromptPath returns the embedded path of a chat prompt version. The empty version is the default prompt.
+func chatPromptPath(version string) string {
+	if version == "" {
+		return "prompts/chat.yml"
+	}
+	return "prompts/chat." + version + ".yml"
+}
+
+// ValidateChatPromptVersion checks that the chat prompt version exists.
+func ValidateChatPromptVersion(version string) error {
+	if _, err := fs.Stat(chatPrompt, chatPromptPath(version)); err != nil {
+		return fmt.Errorf("unknown chat prompt version %q", version)
+	}
+	return nil
+}
+
+// loadCompactedContext returns the compacted context for the prompt, the summary used for skill
+// matching, and the last message it covers. The latest snapshot is preferred while it is as recent
+// as the conversation summary, so its pinned todos and open loops survive compaction.
+func (b TurnStateBuilderImpl) loadCompactedContext(
+	ctx context.Context,
+	conversationID uuid.UUID,
+) (string, string, *uuid.UUID, error) {
+	latestSummary, found, err := b.conversationSummaryRepo.GetConversationSummary(ctx, conversationID)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to load conversation summary: %w", err)
+	}
+
+	latestSnapshot, snapshotFound, err := b.conversationSnapshotRepo.GetLatestConversationSnapshot(ctx, conversationID)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to load conversation snapshot: %w", err)
+	}
+
+	if snapshotFound && (!found || latestSummary.LastSummarizedMessageID == nil ||
+		*latestSummary.LastSummarizedMessageID == latestSnapshot.LastMessageID) {
+		var lastMessageID *uuid.UUID
+		if latestSnapshot.LastMessageID != uuid.Nil {
+			lastMessageID = &latestSnapshot.LastMessageID
+		}
+		return latestSnapshot.PromptContext(), strings.TrimSpace(latestSnapshot.Summary), lastMessageID, nil
+	}
+
+	summaryText := "No conversation summary available."
+	if found && strings.TrimSpace(latestSummary.CurrentStateSummary) != "" {
+		summaryText = strings.TrimSpace(latestSummary.CurrentStateSummary)
+	}
+
 	summaryContext := ""
 	if summaryText != "No conversation summary available." {
 		summaryContext = summaryText
@@ -226,7 +424,7 @@ func (b TurnStateBuilderImpl) buildSystemPrompt(
 		lastSummarizedMessageID = latestSummary.LastSummarizedMessageID
 	}
 
-	return messages, summaryContext, lastSummarizedMessageID, nil
+	return summaryText, summaryContext, lastSummarizedMessageID, nil
 }
 
 // buildSkillsPrompt serializes the selected skills into a compact runbook prompt for the model.
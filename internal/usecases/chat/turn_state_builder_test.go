@@ -2,12 +2,15 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/google/uuid"
@@ -60,20 +63,31 @@ func TestTurnStateBuilder_Build(t *testing.T) {
 		Once()
 
 	actionRegistry.EXPECT().
-		GetDefinition("fetch_todos").
+		GetDefinition(mock.Anything, "fetch_todos").
 		Return(assistant.ActionDefinition{Name: "todo_lookup"}, true).
 		Once()
 	actionRegistry.EXPECT().
-		GetDefinition("update_todos").
+		GetDefinition(mock.Anything, "update_todos").
 		Return(assistant.ActionDefinition{Name: "todo_lookup"}, true).
 		Once()
+	actionRegistry.EXPECT().
+		Prefetch(mock.Anything, []string{"todo_lookup"}, mock.MatchedBy(func(messages []assistant.Message) bool {
+			return len(messages) == 3 && messages[2].Content == "Update my todos"
+		})).
+		Once()
 
 	builder := NewTurnStateBuilderImpl(
 		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
 		chatRepo,
 		timeProvider,
 		skillRegistry,
 		actionRegistry,
+		noContentBlobs{},
+		0,
 	)
 
 	state, err := builder.Build(t.Context(), BuildTurnStateParams{
@@ -82,6 +96,7 @@ func TestTurnStateBuilder_Build(t *testing.T) {
 		MaxActionCycles:     7,
 		Conversation:        assistant.Conversation{ID: conversationID},
 		ConversationCreated: false,
+		Generation:          assistant.GenerationOptions{MaxTokens: common.Ptr(256), Stop: []string{"END"}},
 	})
 	require.NoError(t, err)
 	request := state.Request()
@@ -89,6 +104,8 @@ func TestTurnStateBuilder_Build(t *testing.T) {
 	assert.False(t, state.ConversationCreated())
 	assert.Len(t, state.SelectedSkills(), 1)
 	assert.Equal(t, "todo-skill", state.SelectedSkills()[0].Name)
+	assert.Equal(t, common.Ptr(256), request.MaxTokens)
+	assert.Equal(t, []string{"END"}, request.Stop)
 	assert.Len(t, request.AvailableActions, 1)
 	assert.Equal(t, "todo_lookup", request.AvailableActions[0].Name)
 	assert.Len(t, request.Messages, 4)
@@ -98,6 +115,712 @@ func TestTurnStateBuilder_Build(t *testing.T) {
 	assert.True(t, strings.Contains(request.Messages[3].Content, "Skill runbooks for this turn"))
 }
 
+func TestTurnStateBuilder_Build_AppliesExperimentVariant(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+		Return([]assistant.ChatMessage{}, false, nil).
+		Once()
+	skillRegistry.EXPECT().ListRelevant(mock.Anything, mock.Anything).Return(nil).Once()
+
+	builder := NewTurnStateBuilderImpl(
+		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
+		chatRepo,
+		timeProvider,
+		skillRegistry,
+		assistant.NewMockActionRegistry(t),
+		noContentBlobs{},
+		0,
+	)
+
+	experiment := &assistant.ExperimentAssignment{
+		Experiment:    "prompt-v2",
+		Variant:       "concise",
+		PromptVersion: "concise",
+		Temperature:   common.Ptr(0.4),
+	}
+	state, err := builder.Build(t.Context(), BuildTurnStateParams{
+		UserMessage:  "List my todos",
+		Model:        "test-model",
+		Conversation: assistant.Conversation{ID: conversationID},
+		Experiment:   experiment,
+	})
+	require.NoError(t, err)
+	request := state.Request()
+	assert.Equal(t, experiment, state.Experiment())
+	assert.Equal(t, common.Ptr(0.4), request.Temperature)
+	assert.Contains(t, request.Messages[0].Content, "Manage the user's tasks with as few words as possible.")
+	assert.Contains(t, request.Messages[0].Content, "Today is 2026-03-15.")
+}
+
+func TestTurnStateBuilder_Build_AddsPinnedInstructions(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000006")
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	instructionRepo := assistant.NewMockInstructionRepository(t)
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	instructionRepo.EXPECT().
+		ListInstructions(mock.Anything, conversationID).
+		Return([]assistant.Instruction{
+			{Text: "Always use DD/MM dates"},
+			{ConversationID: &conversationID, Text: "Answer in Portuguese"},
+		}, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+		Return([]assistant.ChatMessage{}, false, nil).
+		Once()
+	skillRegistry.EXPECT().ListRelevant(mock.Anything, mock.Anything).Return(nil).Once()
+
+	builder := NewTurnStateBuilderImpl(
+		summaryRepo,
+		noConversationSnapshots{},
+		instructionRepo,
+		noMemories{},
+		noRelatedConversations{},
+		chatRepo,
+		timeProvider,
+		skillRegistry,
+		assistant.NewMockActionRegistry(t),
+		noContentBlobs{},
+		0,
+	)
+
+	state, err := builder.Build(t.Context(), BuildTurnStateParams{
+		UserMessage:  "When is my dentist appointment?",
+		Model:        "test-model",
+		Conversation: assistant.Conversation{ID: conversationID},
+	})
+	require.NoError(t, err)
+	messages := state.Request().Messages
+	require.Len(t, messages, 4)
+	assert.Equal(t, assistant.ChatRole_System, messages[2].Role)
+	assert.Equal(t, "Pinned user instructions. Follow them in every answer unless the user overrides them in this turn:\n"+
+		"- Always use DD/MM dates\n"+
+		"- Answer in Portuguese", messages[2].Content)
+	assert.Equal(t, "When is my dentist appointment?", messages[3].Content)
+}
+
+func TestTurnStateBuilder_Build_PinnedInstructionsError(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000007")
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	instructionRepo := assistant.NewMockInstructionRepository(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	instructionRepo.EXPECT().
+		ListInstructions(mock.Anything, conversationID).
+		Return(nil, errors.New("db error")).
+		Once()
+
+	builder := NewTurnStateBuilderImpl(
+		summaryRepo,
+		noConversationSnapshots{},
+		instructionRepo,
+		noMemories{},
+		noRelatedConversations{},
+		assistant.NewMockChatMessageRepository(t),
+		timeProvider,
+		assistant.NewMockSkillRegistry(t),
+		assistant.NewMockActionRegistry(t),
+		noContentBlobs{},
+		0,
+	)
+
+	_, err := builder.Build(t.Context(), BuildTurnStateParams{
+		UserMessage:  "Hello",
+		Model:        "test-model",
+		Conversation: assistant.Conversation{ID: conversationID},
+	})
+	assert.EqualError(t, err, "failed to load pinned instructions: db error")
+}
+
+func TestTurnStateBuilder_Build_AddsRecalledMemories(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		recalled     []assistant.Memory
+		recallErr    error
+		wantMemories bool
+	}{
+		"memories-recalled": {
+			recalled:     []assistant.Memory{{Kind: assistant.MemoryKind_Preference, Text: "Prefers morning workouts"}},
+			wantMemories: true,
+		},
+		"no-relevant-memories": {},
+		"recall-error-is-ignored": {
+			recallErr: errors.New("encoder down"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000008")
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			memories := NewMockMemories(t)
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			skillRegistry := assistant.NewMockSkillRegistry(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+
+			timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+			summaryRepo.EXPECT().
+				GetConversationSummary(mock.Anything, conversationID).
+				Return(assistant.ConversationSummary{}, false, nil).
+				Once()
+			chatRepo.EXPECT().
+				ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+				Return([]assistant.ChatMessage{}, false, nil).
+				Once()
+			memories.EXPECT().Recall(mock.Anything, "Plan my workouts").Return(tt.recalled, tt.recallErr).Once()
+			skillRegistry.EXPECT().ListRelevant(mock.Anything, mock.Anything).Return(nil).Once()
+
+			builder := NewTurnStateBuilderImpl(
+				summaryRepo,
+				noConversationSnapshots{},
+				noInstructions{},
+				memories,
+				noRelatedConversations{},
+				chatRepo,
+				timeProvider,
+				skillRegistry,
+				assistant.NewMockActionRegistry(t),
+				noContentBlobs{},
+				0,
+			)
+
+			state, err := builder.Build(t.Context(), BuildTurnStateParams{
+				UserMessage:  "Plan my workouts",
+				Model:        "test-model",
+				Conversation: assistant.Conversation{ID: conversationID},
+			})
+			require.NoError(t, err)
+			messages := state.Request().Messages
+			if !tt.wantMemories {
+				require.Len(t, messages, 3)
+				assert.Equal(t, "Plan my workouts", messages[2].Content)
+				return
+			}
+			require.Len(t, messages, 4)
+			assert.Equal(t, assistant.ChatRole_System, messages[2].Role)
+			assert.Equal(t, assistant.MemoriesPrompt(tt.recalled), messages[2].Content)
+			assert.Equal(t, "Plan my workouts", messages[3].Content)
+		})
+	}
+}
+
+func TestTurnStateBuilder_Build_HintsRelatedConversation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	related := assistant.RelatedConversation{
+		ConversationID: uuid.MustParse("00000000-0000-0000-0000-000000000010"),
+		Title:          "Spring cleaning",
+		Similarity:     0.9,
+		LastActiveAt:   now.AddDate(0, 0, -8),
+	}
+
+	tests := map[string]struct {
+		conversationCreated bool
+		setExpectations     func(m *MockRelatedConversations, conversationID uuid.UUID)
+		wantHint            bool
+	}{
+		"hints-on-new-conversation": {
+			conversationCreated: true,
+			setExpectations: func(m *MockRelatedConversations, conversationID uuid.UUID) {
+				m.EXPECT().Hint(mock.Anything, conversationID, "Clean the garage").Return(related, true, nil).Once()
+			},
+			wantHint: true,
+		},
+		"no-related-conversation": {
+			conversationCreated: true,
+			setExpectations: func(m *MockRelatedConversations, conversationID uuid.UUID) {
+				m.EXPECT().Hint(mock.Anything, conversationID, "Clean the garage").Return(assistant.RelatedConversation{}, false, nil).Once()
+			},
+		},
+		"hint-error-is-ignored": {
+			conversationCreated: true,
+			setExpectations: func(m *MockRelatedConversations, conversationID uuid.UUID) {
+				m.EXPECT().Hint(mock.Anything, conversationID, "Clean the garage").Return(assistant.RelatedConversation{}, false, errors.New("encoder down")).Once()
+			},
+		},
+		"existing-conversation-is-not-hinted": {
+			setExpectations: func(*MockRelatedConversations, uuid.UUID) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000009")
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			relatedConversations := NewMockRelatedConversations(t)
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			skillRegistry := assistant.NewMockSkillRegistry(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+
+			timeProvider.EXPECT().Now().Return(now)
+			summaryRepo.EXPECT().
+				GetConversationSummary(mock.Anything, conversationID).
+				Return(assistant.ConversationSummary{}, false, nil).
+				Once()
+			chatRepo.EXPECT().
+				ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+				Return([]assistant.ChatMessage{}, false, nil).
+				Once()
+			tt.setExpectations(relatedConversations, conversationID)
+			skillRegistry.EXPECT().ListRelevant(mock.Anything, mock.Anything).Return(nil).Once()
+
+			builder := NewTurnStateBuilderImpl(
+				summaryRepo,
+				noConversationSnapshots{},
+				noInstructions{},
+				noMemories{},
+				relatedConversations,
+				chatRepo,
+				timeProvider,
+				skillRegistry,
+				assistant.NewMockActionRegistry(t),
+				noContentBlobs{},
+				0,
+			)
+
+			state, err := builder.Build(t.Context(), BuildTurnStateParams{
+				UserMessage:         "Clean the garage",
+				Model:               "test-model",
+				Conversation:        assistant.Conversation{ID: conversationID},
+				ConversationCreated: tt.conversationCreated,
+			})
+			require.NoError(t, err)
+			messages := state.Request().Messages
+			if !tt.wantHint {
+				require.Len(t, messages, 3)
+				assert.Equal(t, "Clean the garage", messages[2].Content)
+				return
+			}
+			require.Len(t, messages, 4)
+			assert.Equal(t, assistant.ChatRole_System, messages[2].Role)
+			assert.Equal(t, assistant.RelatedConversationHint(related, now), messages[2].Content)
+			assert.Equal(t, "Clean the garage", messages[3].Content)
+		})
+	}
+}
+
+func TestTurnStateBuilder_Build_AppliesConversationSettings(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000005")
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+		Return([]assistant.ChatMessage{}, false, nil).
+		Once()
+	skillRegistry.EXPECT().ListRelevant(mock.Anything, mock.Anything).Return(nil).Once()
+
+	builder := NewTurnStateBuilderImpl(
+		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
+		chatRepo,
+		timeProvider,
+		skillRegistry,
+		assistant.NewMockActionRegistry(t),
+		noContentBlobs{},
+		0,
+	)
+
+	state, err := builder.Build(t.Context(), BuildTurnStateParams{
+		UserMessage: "List my todos",
+		Model:       "test-model",
+		Conversation: assistant.Conversation{
+			ID:       conversationID,
+			Settings: assistant.ConversationSettings{Temperature: common.Ptr(0.9), TopP: common.Ptr(0.95)},
+		},
+		Generation: assistant.GenerationOptions{Temperature: common.Ptr(1.1)},
+	})
+	require.NoError(t, err)
+	request := state.Request()
+	assert.Equal(t, common.Ptr(1.1), request.Temperature, "turn overrides win over conversation settings")
+	assert.Equal(t, common.Ptr(0.95), request.TopP)
+}
+
+func TestTurnStateBuilder_Build_ContinuesTurnBeforeItsUserMessage(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000006")
+	userMessageID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	turnID := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(
+			mock.Anything,
+			conversationID,
+			1,
+			MAX_CHAT_HISTORY_MESSAGES,
+			mock.MatchedBy(func(options []assistant.ListChatMessagesOption) bool {
+				params := assistant.ListChatMessagesParams{}
+				for _, opt := range options {
+					opt(&params)
+				}
+				return params.BeforeMessageID != nil && *params.BeforeMessageID == userMessageID
+			}),
+		).
+		Return([]assistant.ChatMessage{{ChatRole: assistant.ChatRole_User, Content: "Earlier question"}}, false, nil).
+		Once()
+	skillRegistry.EXPECT().ListRelevant(mock.Anything, mock.Anything).Return(nil).Once()
+
+	builder := NewTurnStateBuilderImpl(
+		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
+		chatRepo,
+		timeProvider,
+		skillRegistry,
+		assistant.NewMockActionRegistry(t),
+		noContentBlobs{},
+		0,
+	)
+
+	state, err := builder.Build(t.Context(), BuildTurnStateParams{
+		UserMessage:   "List my todos",
+		Model:         "test-model",
+		Conversation:  assistant.Conversation{ID: conversationID},
+		Generation:    assistant.GenerationOptions{Seed: common.Ptr[int64](42)},
+		HistoryBefore: &userMessageID,
+		TurnID:        turnID,
+		TurnSequence:  4,
+	})
+	require.NoError(t, err)
+	request := state.Request()
+	require.GreaterOrEqual(t, len(request.Messages), 2)
+	assert.Equal(t, "Earlier question", request.Messages[len(request.Messages)-2].Content)
+	assert.Equal(t, "List my todos", request.Messages[len(request.Messages)-1].Content)
+	assert.Equal(t, common.Ptr[int64](42), state.Seed())
+	assert.Equal(t, turnID, state.TurnID())
+	assert.Equal(t, int64(4), state.NextTurnSequence())
+}
+
+func TestTurnStateBuilder_Build_ReadonlyPrincipalGetsReadActions(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+	chatRepo := assistant.NewMockChatMessageRepository(t)
+	skillRegistry := assistant.NewMockSkillRegistry(t)
+	actionRegistry := assistant.NewMockActionRegistry(t)
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+
+	timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)).Once()
+	summaryRepo.EXPECT().
+		GetConversationSummary(mock.Anything, conversationID).
+		Return(assistant.ConversationSummary{}, false, nil).
+		Once()
+	chatRepo.EXPECT().
+		ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+		Return([]assistant.ChatMessage{}, false, nil).
+		Once()
+	skillRegistry.EXPECT().
+		ListRelevant(mock.Anything, mock.Anything).
+		Return([]assistant.SkillDefinition{{Name: "todo-skill", Tools: []string{"fetch_todos", "delete_todos"}}}).
+		Once()
+	actionRegistry.EXPECT().
+		GetDefinition(mock.Anything, "fetch_todos").
+		Return(assistant.ActionDefinition{Name: "fetch_todos", ReadOnly: true}, true).
+		Once()
+	actionRegistry.EXPECT().
+		GetDefinition(mock.Anything, "delete_todos").
+		Return(assistant.ActionDefinition{Name: "delete_todos"}, true).
+		Once()
+	actionRegistry.EXPECT().
+		Prefetch(mock.Anything, []string{"fetch_todos"}, mock.Anything).
+		Once()
+
+	builder := NewTurnStateBuilderImpl(
+		summaryRepo,
+		noConversationSnapshots{},
+		noInstructions{},
+		noMemories{},
+		noRelatedConversations{},
+		chatRepo,
+		timeProvider,
+		skillRegistry,
+		actionRegistry,
+		noContentBlobs{},
+		0,
+	)
+
+	ctx := access.NewContext(t.Context(), access.Principal{Name: "viewer", Role: access.RoleReadonly})
+	state, err := builder.Build(ctx, BuildTurnStateParams{
+		UserMessage:  "Delete my done todos",
+		Model:        "test-model",
+		Conversation: assistant.Conversation{ID: conversationID},
+	})
+	require.NoError(t, err)
+	request := state.Request()
+	require.Len(t, request.AvailableActions, 1)
+	assert.Equal(t, "fetch_todos", request.AvailableActions[0].Name)
+}
+
+func TestValidateChatPromptVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		version     string
+		expectedErr error
+	}{
+		"default": {
+			version: "",
+		},
+		"concise": {
+			version: "concise",
+		},
+		"unknown": {
+			version:     "verbose",
+			expectedErr: errors.New(`unknown chat prompt version "verbose"`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedErr, ValidateChatPromptVersion(tt.version))
+		})
+	}
+}
+
+func TestTurnStateBuilder_LoadCompactedContext(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	snapshotMessageID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	summaryMessageID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	snapshot := assistant.ConversationSnapshot{
+		ConversationID: conversationID,
+		Summary:        "memory: dinner planning",
+		OpenLoops:      []string{"confirm guest list"},
+		LastMessageID:  snapshotMessageID,
+	}
+
+	tests := map[string]struct {
+		summary            assistant.ConversationSummary
+		summaryFound       bool
+		snapshot           assistant.ConversationSnapshot
+		snapshotFound      bool
+		snapshotErr        error
+		wantContext        string
+		wantSummaryContext string
+		wantLastMessageID  *uuid.UUID
+		wantErr            bool
+	}{
+		"no-compacted-context": {
+			wantContext: "No conversation summary available.",
+		},
+		"uses-summary-without-snapshot": {
+			summary:            assistant.ConversationSummary{CurrentStateSummary: " summary state ", LastSummarizedMessageID: &summaryMessageID},
+			summaryFound:       true,
+			wantContext:        "summary state",
+			wantSummaryContext: "summary state",
+			wantLastMessageID:  &summaryMessageID,
+		},
+		"uses-snapshot-covering-latest-summary": {
+			summary:            assistant.ConversationSummary{CurrentStateSummary: "memory: dinner planning", LastSummarizedMessageID: &snapshotMessageID},
+			summaryFound:       true,
+			snapshot:           snapshot,
+			snapshotFound:      true,
+			wantContext:        "memory: dinner planning\n\nOpen loops:\n- confirm guest list",
+			wantSummaryContext: "memory: dinner planning",
+			wantLastMessageID:  &snapshotMessageID,
+		},
+		"uses-summary-newer-than-snapshot": {
+			summary:            assistant.ConversationSummary{CurrentStateSummary: "summary state", LastSummarizedMessageID: &summaryMessageID},
+			summaryFound:       true,
+			snapshot:           snapshot,
+			snapshotFound:      true,
+			wantContext:        "summary state",
+			wantSummaryContext: "summary state",
+			wantLastMessageID:  &summaryMessageID,
+		},
+		"uses-carried-over-snapshot-without-message-checkpoint": {
+			snapshot: assistant.ConversationSnapshot{
+				ConversationID: conversationID,
+				PinnedTodos: []assistant.PinnedTodo{
+					{ID: snapshotMessageID, Title: "Buy wine", Status: "OPEN", DueDate: "2026-02-20"},
+				},
+			},
+			snapshotFound: true,
+			wantContext: "No current state.\n\nPinned todos:\n" +
+				"- Buy wine | OPEN | due 2026-02-20 | id 11111111-1111-1111-1111-111111111111",
+		},
+		"snapshot-error": {
+			snapshotErr: errors.New("db error"),
+			wantErr:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			summaryRepo.EXPECT().
+				GetConversationSummary(mock.Anything, conversationID).
+				Return(tt.summary, tt.summaryFound, nil).
+				Once()
+			snapshotRepo := assistant.NewMockConversationSnapshotRepository(t)
+			snapshotRepo.EXPECT().
+				GetLatestConversationSnapshot(mock.Anything, conversationID).
+				Return(tt.snapshot, tt.snapshotFound, tt.snapshotErr).
+				Once()
+
+			builder := NewTurnStateBuilderImpl(summaryRepo, snapshotRepo, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+			gotContext, gotSummaryContext, gotLastMessageID, err := builder.loadCompactedContext(t.Context(), conversationID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantContext, gotContext)
+			assert.Equal(t, tt.wantSummaryContext, gotSummaryContext)
+			assert.Equal(t, tt.wantLastMessageID, gotLastMessageID)
+		})
+	}
+}
+
+func TestTurnStateBuilder_LoadMessagesHistory_ContentBlobs(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	blob := assistant.ContentBlob{Hash: "abc", Content: "line one\nline two\nline three", SizeBytes: 28}
+	history := []assistant.ChatMessage{
+		{ChatRole: assistant.ChatRole_User, Content: "show my todos"},
+		{ChatRole: assistant.ChatRole_Assistant, ActionCalls: []assistant.ActionCall{{ID: "call-1", Name: "fetch_todos"}}},
+		{ChatRole: assistant.ChatRole_Tool, ActionCallID: common.Ptr("call-1"), Content: "line one", ContentRef: common.Ptr("abc")},
+	}
+
+	tests := map[string]struct {
+		blobs       []assistant.ContentBlob
+		blobsErr    error
+		maxBytes    int
+		wantContent string
+	}{
+		"uses-blob-content": {
+			blobs:       []assistant.ContentBlob{blob},
+			wantContent: blob.Content,
+		},
+		"cuts-blob-content": {
+			blobs:       []assistant.ContentBlob{blob},
+			maxBytes:    27,
+			wantContent: blob.Excerpt(27),
+		},
+		"keeps-preview-when-blob-missing": {
+			wantContent: "line one",
+		},
+		"keeps-preview-when-blobs-fail-to-load": {
+			blobsErr:    errors.New("db error"),
+			wantContent: "line one",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summaryRepo := assistant.NewMockConversationSummaryRepository(t)
+			summaryRepo.EXPECT().
+				GetConversationSummary(mock.Anything, conversationID).
+				Return(assistant.ConversationSummary{}, false, nil).
+				Once()
+			chatRepo := assistant.NewMockChatMessageRepository(t)
+			chatRepo.EXPECT().
+				ListChatMessages(mock.Anything, conversationID, 1, MAX_CHAT_HISTORY_MESSAGES).
+				Return(history, false, nil).
+				Once()
+			blobRepo := assistant.NewMockContentBlobRepository(t)
+			blobRepo.EXPECT().
+				ListContentBlobs(mock.Anything, []string{"abc"}).
+				Return(tt.blobs, tt.blobsErr).
+				Once()
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC))
+
+			builder := NewTurnStateBuilderImpl(
+				summaryRepo,
+				noConversationSnapshots{},
+				noInstructions{},
+				noMemories{},
+				noRelatedConversations{},
+				chatRepo,
+				timeProvider,
+				nil,
+				nil,
+				blobRepo,
+				tt.maxBytes,
+			)
+			messages, _, err := builder.loadMessagesHistory(t.Context(), conversationID, "", nil)
+			require.NoError(t, err)
+
+			last := messages[len(messages)-1]
+			assert.Equal(t, assistant.ChatRole_Tool, last.Role)
+			assert.Equal(t, tt.wantContent, last.Content)
+		})
+	}
+}
+
 func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 	t.Parallel()
 
@@ -108,13 +831,13 @@ func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		setExpectations func(*MockConversationCompactor)
+		setExpectations func(*MockConversationCompactor, *MockConversationSnapshotter, *MockRelatedConversations)
 		wantEvents      []assistant.EventType
 		wantTimeCalled  bool
 		timeout         time.Duration
 	}{
 		"skips-when-not-triggered": {
-			setExpectations: func(compactor *MockConversationCompactor) {
+			setExpectations: func(compactor *MockConversationCompactor, _ *MockConversationSnapshotter, _ *MockRelatedConversations) {
 				compactor.EXPECT().EvaluateConversationCompaction(mock.Anything, conversationID, compactionPolicy).Return(assistant.CompactionDecision{
 					ShouldCompact: false,
 					Reason:        assistant.ContextCompactionReasonNone,
@@ -124,7 +847,7 @@ func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 			wantTimeCalled: false,
 		},
 		"emits-started-and-completed-when-triggered": {
-			setExpectations: func(compactor *MockConversationCompactor) {
+			setExpectations: func(compactor *MockConversationCompactor, snapshotter *MockConversationSnapshotter, relatedConversations *MockRelatedConversations) {
 				compactor.EXPECT().EvaluateConversationCompaction(mock.Anything, conversationID, compactionPolicy).Return(assistant.CompactionDecision{
 					ShouldCompact: true,
 					Reason:        assistant.ContextCompactionReasonTokenCountThreshold,
@@ -132,19 +855,37 @@ func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 					TotalTokens:   1200,
 				}, nil).Once()
 				compactor.EXPECT().Compact(mock.Anything, conversationID).Return(nil).Once()
+				snapshotter.EXPECT().Snapshot(mock.Anything, conversationID).Return(nil).Once()
+				relatedConversations.EXPECT().Index(mock.Anything, conversationID).Return(nil).Once()
+			},
+			wantEvents:     []assistant.EventType{assistant.EventType_ContextCompactionStarted, assistant.EventType_ContextCompactionCompleted},
+			wantTimeCalled: true,
+		},
+		"emits-completed-when-snapshot-and-indexing-fail": {
+			setExpectations: func(compactor *MockConversationCompactor, snapshotter *MockConversationSnapshotter, relatedConversations *MockRelatedConversations) {
+				compactor.EXPECT().EvaluateConversationCompaction(mock.Anything, conversationID, compactionPolicy).Return(assistant.CompactionDecision{
+					ShouldCompact: true,
+					Reason:        assistant.ContextCompactionReasonTurnInterval,
+					MessageCount:  20,
+					TotalTokens:   900,
+					TurnCount:     10,
+				}, nil).Once()
+				compactor.EXPECT().Compact(mock.Anything, conversationID).Return(nil).Once()
+				snapshotter.EXPECT().Snapshot(mock.Anything, conversationID).Return(assert.AnError).Once()
+				relatedConversations.EXPECT().Index(mock.Anything, conversationID).Return(assert.AnError).Once()
 			},
 			wantEvents:     []assistant.EventType{assistant.EventType_ContextCompactionStarted, assistant.EventType_ContextCompactionCompleted},
 			wantTimeCalled: true,
 		},
 		"emits-failed-when-evaluation-errors": {
-			setExpectations: func(compactor *MockConversationCompactor) {
+			setExpectations: func(compactor *MockConversationCompactor, _ *MockConversationSnapshotter, _ *MockRelatedConversations) {
 				compactor.EXPECT().EvaluateConversationCompaction(mock.Anything, conversationID, compactionPolicy).Return(assistant.CompactionDecision{}, assert.AnError).Once()
 			},
 			wantEvents:     []assistant.EventType{assistant.EventType_ContextCompactionFailed},
 			wantTimeCalled: false,
 		},
 		"emits-started-then-failed-when-compaction-errors": {
-			setExpectations: func(compactor *MockConversationCompactor) {
+			setExpectations: func(compactor *MockConversationCompactor, _ *MockConversationSnapshotter, _ *MockRelatedConversations) {
 				compactor.EXPECT().EvaluateConversationCompaction(mock.Anything, conversationID, compactionPolicy).Return(assistant.CompactionDecision{
 					ShouldCompact: true,
 					Reason:        assistant.ContextCompactionReasonTokenCountThreshold,
@@ -157,7 +898,7 @@ func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 			wantTimeCalled: false,
 		},
 		"emits-started-then-failed-when-compaction-times-out": {
-			setExpectations: func(compactor *MockConversationCompactor) {
+			setExpectations: func(compactor *MockConversationCompactor, _ *MockConversationSnapshotter, _ *MockRelatedConversations) {
 				compactor.EXPECT().EvaluateConversationCompaction(mock.Anything, conversationID, compactionPolicy).Return(assistant.CompactionDecision{
 					ShouldCompact: true,
 					Reason:        assistant.ContextCompactionReasonTokenCountThreshold,
@@ -180,7 +921,9 @@ func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 			t.Parallel()
 
 			compactor := NewMockConversationCompactor(t)
-			tt.setExpectations(compactor)
+			snapshotter := NewMockConversationSnapshotter(t)
+			relatedConversations := NewMockRelatedConversations(t)
+			tt.setExpectations(compactor, snapshotter, relatedConversations)
 
 			timeProvider := core.NewMockCurrentTimeProvider(t)
 			if tt.wantTimeCalled {
@@ -188,11 +931,13 @@ func TestStreamChatImpl_CompactIfNeeded(t *testing.T) {
 			}
 
 			useCase := StreamChatImpl{
-				logger:                log.New(io.Discard, "", 0),
-				timeProvider:          timeProvider,
-				conversationCompactor: compactor,
-				compactionPolicy:      compactionPolicy,
-				compactionTimeout:     tt.timeout,
+				logger:                  log.New(io.Discard, "", 0),
+				timeProvider:            timeProvider,
+				conversationCompactor:   compactor,
+				conversationSnapshotter: snapshotter,
+				relatedConversations:    relatedConversations,
+				compactionPolicy:        compactionPolicy,
+				compactionTimeout:       tt.timeout,
 			}
 
 			gotEvents := make([]assistant.EventType, 0, 2)
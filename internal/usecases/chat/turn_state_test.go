@@ -0,0 +1,34 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTurnState_Latency(t *testing.T) {
+	t.Parallel()
+
+	state := NewTurnState(assistant.Conversation{}, false, nil, assistant.TurnRequest{Model: "test-model"}, 7, nil)
+
+	state.RecordLatency(TurnPhase_HistoryFetch, 12*time.Millisecond)
+	state.RecordLatency(TurnPhase_PromptBuild, 3*time.Millisecond)
+	state.RecordLatency(TurnPhase_FirstToken, 250*time.Millisecond)
+	state.RecordLatency(TurnPhase_Persistence, 4*time.Millisecond)
+	state.RecordLatency(TurnPhase_Persistence, 6*time.Millisecond)
+	state.RecordActionLatency(assistant.ActionCall{ID: "call-1", Name: "fetch_todos"}, 40*time.Millisecond)
+	state.RecordActionLatency(assistant.ActionCall{ID: "call-2", Name: "update_todos"}, 15*time.Millisecond)
+
+	latency := state.Latency()
+	assert.Equal(t, int64(12), latency.HistoryFetchMs)
+	assert.Equal(t, int64(3), latency.PromptBuildMs)
+	assert.Equal(t, int64(250), latency.TimeToFirstTokenMs)
+	assert.Equal(t, int64(10), latency.PersistenceMs)
+	assert.Equal(t, []assistant.ActionLatency{
+		{ActionCallID: "call-1", Name: "fetch_todos", DurationMs: 40},
+		{ActionCallID: "call-2", Name: "update_todos", DurationMs: 15},
+	}, latency.Actions)
+	assert.GreaterOrEqual(t, latency.TotalMs, int64(0))
+}
@@ -3,35 +3,59 @@ package chat
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
 )
 
 // UpdateConversation applies user-driven updates to a conversation.
 type UpdateConversation interface {
-	// Execute partially updates a conversation, such as the title.
-	Execute(ctx context.Context, conversationID uuid.UUID, title string) (assistant.Conversation, error)
+	// Execute partially updates a conversation, such as the title or its generation settings.
+	Execute(ctx context.Context, conversationID uuid.UUID, update ConversationUpdate) (assistant.Conversation, error)
+}
+
+// ConversationUpdate holds the fields of a conversation to update. Nil fields are left unchanged.
+type ConversationUpdate struct {
+	Title *string
+	// Settings replaces all generation settings of the conversation.
+	Settings *assistant.ConversationSettings
 }
 
 // UpdateConversationImpl implements UpdateConversation.
 type UpdateConversationImpl struct {
 	uow          transaction.UnitOfWork
 	timeProvider core.CurrentTimeProvider
+	modelCatalog assistant.ModelCatalog
 }
 
 // NewUpdateConversationImpl creates an UpdateConversationImpl.
-func NewUpdateConversationImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider) *UpdateConversationImpl {
+func NewUpdateConversationImpl(
+	uow transaction.UnitOfWork,
+	timeProvider core.CurrentTimeProvider,
+	modelCatalog assistant.ModelCatalog,
+) *UpdateConversationImpl {
 	return &UpdateConversationImpl{
 		uow:          uow,
 		timeProvider: timeProvider,
+		modelCatalog: modelCatalog,
 	}
 }
 
 // Execute implements UpdateConversation.
-func (uc *UpdateConversationImpl) Execute(ctx context.Context, conversationID uuid.UUID, title string) (assistant.Conversation, error) {
+func (uc *UpdateConversationImpl) Execute(ctx context.Context, conversationID uuid.UUID, update ConversationUpdate) (assistant.Conversation, error) {
+	if update.Title == nil && update.Settings == nil {
+		return assistant.Conversation{}, core.NewValidationErr("title or settings must be provided")
+	}
+	if update.Settings != nil {
+		if err := uc.validateSettings(ctx, *update.Settings); err != nil {
+			return assistant.Conversation{}, err
+		}
+	}
+
 	var updatedConv assistant.Conversation
 	err := uc.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
 		conversationRepo := scope.Conversation()
@@ -44,8 +68,13 @@ func (uc *UpdateConversationImpl) Execute(ctx context.Context, conversationID uu
 			return core.NewNotFoundErr(fmt.Sprintf("conversation with ID %s not found", conversationID))
 		}
 
-		if err := conv.ApplyUserTitle(title); err != nil {
-			return err
+		if update.Title != nil {
+			if err := conv.ApplyUserTitle(*update.Title); err != nil {
+				return err
+			}
+		}
+		if update.Settings != nil {
+			conv.Settings = *update.Settings
 		}
 
 		conv.UpdatedAt = uc.timeProvider.Now()
@@ -60,3 +89,25 @@ func (uc *UpdateConversationImpl) Execute(ctx context.Context, conversationID uu
 	}
 	return updatedConv, nil
 }
+
+// validateSettings checks the settings ranges and that a model override is available and enabled for the tenant.
+func (uc *UpdateConversationImpl) validateSettings(ctx context.Context, settings assistant.ConversationSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	if settings.Model == "" {
+		return nil
+	}
+	if !tenant.FromContext(ctx).Settings.AllowsModel(settings.Model) {
+		return core.NewValidationErr(fmt.Sprintf("model %s is not enabled for this tenant", settings.Model))
+	}
+
+	models, err := uc.modelCatalog.ListModels(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(models, func(m assistant.ModelCapabilities) bool { return m.ID == settings.Model }) {
+		return core.NewValidationErr(fmt.Sprintf("model %s is not available", settings.Model))
+	}
+	return nil
+}
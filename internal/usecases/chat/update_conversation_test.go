@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/tenant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +22,11 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 	fixedUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	newTitle := "Updated Conversation Title"
+	settings := assistant.ConversationSettings{
+		Temperature: common.Ptr(0.9),
+		TopP:        common.Ptr(0.5),
+		Model:       "gpt-oss",
+	}
 
 	expectedConversation := assistant.Conversation{
 		ID:          fixedUUID,
@@ -31,15 +38,16 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 
 	tests := map[string]struct {
 		conversationID  uuid.UUID
-		title           string
-		setExpectations func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider)
+		update          ConversationUpdate
+		tenantSettings  tenant.Settings
+		setExpectations func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog)
 		expectedConv    assistant.Conversation
 		expectedErr     error
 	}{
 		"success-update-title": {
 			conversationID: fixedUUID,
-			title:          newTitle,
-			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+			update:         ConversationUpdate{Title: &newTitle},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
 				mockConvRepo := assistant.NewMockConversationRepository(t)
 				mockConvRepo.EXPECT().
 					GetConversation(mock.Anything, fixedUUID).
@@ -72,10 +80,96 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 			expectedConv: expectedConversation,
 			expectedErr:  nil,
 		},
+		"success-update-settings": {
+			conversationID: fixedUUID,
+			update:         ConversationUpdate{Settings: &settings},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().
+					ListModels(mock.Anything).
+					Return([]assistant.ModelCapabilities{{ID: "qwen3"}, {ID: "gpt-oss"}}, nil).
+					Once()
+
+				mockConvRepo := assistant.NewMockConversationRepository(t)
+				mockConvRepo.EXPECT().
+					GetConversation(mock.Anything, fixedUUID).
+					Return(assistant.Conversation{
+						ID:          fixedUUID,
+						Title:       "Old Title",
+						TitleSource: assistant.ConversationTitleSource_Auto,
+						CreatedAt:   fixedTime,
+						UpdatedAt:   fixedTime,
+					}, true, nil)
+				mockConvRepo.EXPECT().
+					UpdateConversation(mock.Anything, assistant.Conversation{
+						ID:          fixedUUID,
+						Title:       "Old Title",
+						TitleSource: assistant.ConversationTitleSource_Auto,
+						Settings:    settings,
+						CreatedAt:   fixedTime,
+						UpdatedAt:   fixedTime,
+					}).
+					Return(nil)
+				timeProvider.EXPECT().Now().Return(fixedTime).Once()
+
+				scope := transaction.NewMockScope(t)
+				scope.EXPECT().Conversation().Return(mockConvRepo).Once()
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					})
+			},
+			expectedConv: assistant.Conversation{
+				ID:          fixedUUID,
+				Title:       "Old Title",
+				TitleSource: assistant.ConversationTitleSource_Auto,
+				Settings:    settings,
+				CreatedAt:   fixedTime,
+				UpdatedAt:   fixedTime,
+			},
+		},
+		"error-no-fields": {
+			conversationID: fixedUUID,
+			expectedErr:    core.NewValidationErr("title or settings must be provided"),
+		},
+		"error-settings-out-of-range": {
+			conversationID: fixedUUID,
+			update:         ConversationUpdate{Settings: &assistant.ConversationSettings{TopP: common.Ptr(0.0)}},
+			expectedErr:    core.NewValidationErr("top_p must be greater than 0 and at most 1"),
+		},
+		"error-model-not-enabled-for-tenant": {
+			conversationID: fixedUUID,
+			update:         ConversationUpdate{Settings: &settings},
+			tenantSettings: tenant.Settings{Models: []string{"qwen3"}},
+			expectedErr:    core.NewValidationErr("model gpt-oss is not enabled for this tenant"),
+		},
+		"error-model-not-available": {
+			conversationID: fixedUUID,
+			update:         ConversationUpdate{Settings: &settings},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().
+					ListModels(mock.Anything).
+					Return([]assistant.ModelCapabilities{{ID: "qwen3"}}, nil).
+					Once()
+			},
+			expectedErr: core.NewValidationErr("model gpt-oss is not available"),
+		},
+		"error-list-models-failure": {
+			conversationID: fixedUUID,
+			update:         ConversationUpdate{Settings: &settings},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
+				catalog.EXPECT().
+					ListModels(mock.Anything).
+					Return(nil, errors.New("catalog unavailable")).
+					Once()
+			},
+			expectedErr: errors.New("catalog unavailable"),
+		},
 		"error-conversation-not-found": {
 			conversationID: fixedUUID,
-			title:          newTitle,
-			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+			update:         ConversationUpdate{Title: &newTitle},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
 				mockConvRepo := assistant.NewMockConversationRepository(t)
 				mockConvRepo.EXPECT().
 					GetConversation(mock.Anything, fixedUUID).
@@ -95,8 +189,8 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 		},
 		"error-get-conversation-failure": {
 			conversationID: fixedUUID,
-			title:          newTitle,
-			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+			update:         ConversationUpdate{Title: &newTitle},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
 				mockConvRepo := assistant.NewMockConversationRepository(t)
 				mockConvRepo.EXPECT().
 					GetConversation(mock.Anything, fixedUUID).
@@ -116,8 +210,8 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 		},
 		"error-validation-empty-title": {
 			conversationID: fixedUUID,
-			title:          "",
-			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+			update:         ConversationUpdate{Title: common.Ptr("")},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
 				mockConvRepo := assistant.NewMockConversationRepository(t)
 				mockConvRepo.EXPECT().
 					GetConversation(mock.Anything, fixedUUID).
@@ -143,8 +237,8 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 		},
 		"error-update-conversation-failure": {
 			conversationID: fixedUUID,
-			title:          newTitle,
-			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+			update:         ConversationUpdate{Title: &newTitle},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
 				mockConvRepo := assistant.NewMockConversationRepository(t)
 				mockConvRepo.EXPECT().
 					GetConversation(mock.Anything, fixedUUID).
@@ -175,8 +269,8 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 		},
 		"error-uow-execute-failure": {
 			conversationID: fixedUUID,
-			title:          newTitle,
-			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+			update:         ConversationUpdate{Title: &newTitle},
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider, catalog *assistant.MockModelCatalog) {
 				uow.EXPECT().
 					Execute(mock.Anything, mock.Anything).
 					Return(errors.New("transaction failed"))
@@ -190,13 +284,14 @@ func TestUpdateConversationImpl_Execute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			uow := transaction.NewMockUnitOfWork(t)
 			timeProvider := core.NewMockCurrentTimeProvider(t)
+			catalog := assistant.NewMockModelCatalog(t)
 			if tt.setExpectations != nil {
-				tt.setExpectations(uow, timeProvider)
+				tt.setExpectations(uow, timeProvider, catalog)
 			}
 
-			uc := NewUpdateConversationImpl(uow, timeProvider)
+			uc := NewUpdateConversationImpl(uow, timeProvider, catalog)
 
-			got, gotErr := uc.Execute(t.Context(), tt.conversationID, tt.title)
+			got, gotErr := uc.Execute(tenant.NewContext(t.Context(), tenant.Tenant{ID: "acme", Settings: tt.tenantSettings}), tt.conversationID, tt.update)
 			if tt.expectedErr != nil {
 				assert.Error(t, gotErr)
 				assert.Equal(t, tt.expectedErr.Error(), gotErr.Error())
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -9,8 +10,31 @@ import (
 )
 
 var (
-	meter         = otel.Meter("usecases")
-	llmTokensUsed metric.Int64Counter
+	meter                       = otel.Meter("usecases")
+	llmTokensUsed               metric.Int64Counter
+	chatContextTruncations      metric.Int64Counter
+	actionPrefetches            metric.Int64Counter
+	actionResultTruncations     metric.Int64Counter
+	todayViewCacheRequests      metric.Int64Counter
+	queryEmbeddingCacheRequests metric.Int64Counter
+	weatherCacheRequests        metric.Int64Counter
+	configReloads               metric.Int64Counter
+	llmQueueWaits               metric.Float64Histogram
+	llmInFlight                 metric.Int64UpDownCounter
+	workerPoolWorkers           metric.Int64Gauge
+	workerPoolBacklog           metric.Int64Gauge
+	outboxPublishLatency        metric.Float64Histogram
+	outboxRelayBatchSize        metric.Int64Histogram
+	outboxPendingEvents         metric.Int64Gauge
+	outboxFailedEvents          metric.Int64Gauge
+	outboxOldestPendingAge      metric.Float64Gauge
+	outboxConsumerLag           metric.Float64Gauge
+	shadowEvaluations           metric.Int64Counter
+	chatTurnsInFlight           metric.Int64UpDownCounter
+	chatTurnsInterrupted        metric.Int64Counter
+	chatGroundingFailures       metric.Int64Counter
+	chatTurnPhaseDurations      metric.Float64Histogram
+	assistantActionDurations    metric.Float64Histogram
 )
 
 func init() {
@@ -23,6 +47,219 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	// Chat turns retried with truncated history after a context-length error
+	chatContextTruncations, err = meter.Int64Counter(
+		"chat_context_truncations_total",
+		metric.WithDescription("Total chat turns retried with truncated history"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Speculative action prefetches started and served
+	actionPrefetches, err = meter.Int64Counter(
+		"assistant_action_prefetches_total",
+		metric.WithDescription("Total speculative action prefetches by outcome"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Today view cache lookups, split by hit and miss
+	todayViewCacheRequests, err = meter.Int64Counter(
+		"todo_today_view_cache_requests_total",
+		metric.WithDescription("Total today view cache lookups by result"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Shared query embedding cache lookups, split by hit and miss
+	queryEmbeddingCacheRequests, err = meter.Int64Counter(
+		"query_embedding_cache_requests_total",
+		metric.WithDescription("Total shared query embedding cache lookups by result"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Action results truncated to fit the configured size limit
+	actionResultTruncations, err = meter.Int64Counter(
+		"assistant_action_result_truncations_total",
+		metric.WithDescription("Total action results truncated to fit the configured size limit"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Weather forecast cache lookups, split by hit and miss
+	weatherCacheRequests, err = meter.Int64Counter(
+		"weather_forecast_cache_requests_total",
+		metric.WithDescription("Total weather forecast cache lookups by result"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Configuration reloads, per subsystem and result
+	configReloads, err = meter.Int64Counter(
+		"config_reloads_total",
+		metric.WithDescription("Total configuration reloads by subsystem and result"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Time LLM turns waited for a concurrency slot, by outcome
+	llmQueueWaits, err = meter.Float64Histogram(
+		"llm_queue_wait_seconds",
+		metric.WithDescription("Time LLM turns waited for a concurrency slot"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// LLM turns currently holding a concurrency slot
+	llmInFlight, err = meter.Int64UpDownCounter(
+		"llm_in_flight_turns",
+		metric.WithDescription("LLM turns currently running behind the concurrency limiter"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Live workers of the outbox consumer worker pool
+	workerPoolWorkers, err = meter.Int64Gauge(
+		"worker_pool_workers",
+		metric.WithDescription("Live workers of the outbox consumer worker pool"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Jobs queued in the outbox consumer worker pool
+	workerPoolBacklog, err = meter.Int64Gauge(
+		"worker_pool_backlog",
+		metric.WithDescription("Jobs queued in the outbox consumer worker pool"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Time from publishing an outbox event to the broker acknowledging it
+	outboxPublishLatency, err = meter.Float64Histogram(
+		"outbox_publish_latency_seconds",
+		metric.WithDescription("Time from publishing an outbox event to its acknowledgement by the broker"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Events published per outbox relay batch
+	outboxRelayBatchSize, err = meter.Int64Histogram(
+		"outbox_relay_batch_size",
+		metric.WithDescription("Events published per outbox relay batch"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Outbox events waiting to be published, by topic
+	outboxPendingEvents, err = meter.Int64Gauge(
+		"outbox_pending_events",
+		metric.WithDescription("Outbox events waiting to be published by topic"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Outbox events that exhausted their retries, by topic
+	outboxFailedEvents, err = meter.Int64Gauge(
+		"outbox_failed_events",
+		metric.WithDescription("Outbox events that exhausted their publish retries by topic"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Age of the oldest outbox event waiting to be published, by topic
+	outboxOldestPendingAge, err = meter.Float64Gauge(
+		"outbox_oldest_pending_age_seconds",
+		metric.WithDescription("Age of the oldest outbox event waiting to be published by topic"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Time a durable subscription trails the last event published on its topic
+	outboxConsumerLag, err = meter.Float64Gauge(
+		"outbox_consumer_lag_seconds",
+		metric.WithDescription("Time a subscription trails the last event published on its topic"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Interactive turns replayed against the shadow candidate model, by outcome
+	shadowEvaluations, err = meter.Int64Counter(
+		"chat_shadow_evaluations_total",
+		metric.WithDescription("Total chat turns replayed against the shadow candidate model by outcome"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Chat turns currently being streamed, which a shutdown waits for
+	chatTurnsInFlight, err = meter.Int64UpDownCounter(
+		"chat_in_flight_turns",
+		metric.WithDescription("Chat turns currently being streamed"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Chat turns still running when the shutdown drain timeout expired
+	chatTurnsInterrupted, err = meter.Int64Counter(
+		"chat_shutdown_interrupted_turns_total",
+		metric.WithDescription("Total chat turns interrupted because the shutdown drain timeout expired"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Assistant answers mentioning values the action results do not support
+	chatGroundingFailures, err = meter.Int64Counter(
+		"chat_grounding_failures_total",
+		metric.WithDescription("Total assistant answer values not supported by the action results of their turn"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Time completed chat turns spent in each phase, by model
+	chatTurnPhaseDurations, err = meter.Float64Histogram(
+		"chat_turn_phase_duration_seconds",
+		metric.WithDescription("Time completed chat turns spent in each phase"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Execution time of the actions run by chat turns, by action
+	assistantActionDurations, err = meter.Float64Histogram(
+		"assistant_action_duration_seconds",
+		metric.WithDescription("Execution time of the actions run by chat turns"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RecordLLMTokensUsed records the number of tokens used in an LLM chat operation.
@@ -41,3 +278,169 @@ func RecordLLMTokensEmbedding(ctx context.Context, totalTokens int) {
 		attribute.String("token_type", "embedding"),
 	))
 }
+
+// RecordChatContextTruncated records a chat turn retried with truncated history.
+func RecordChatContextTruncated(ctx context.Context, model string) {
+	chatContextTruncations.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("model", model),
+	))
+}
+
+// RecordActionPrefetch records a speculative action prefetch outcome, either started or hit.
+func RecordActionPrefetch(ctx context.Context, action, outcome string) {
+	actionPrefetches.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("action", action),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordActionResultTruncated records an action result truncated to fit its size limit.
+func RecordActionResultTruncated(ctx context.Context, action string) {
+	actionResultTruncations.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("action", action),
+	))
+}
+
+// RecordTodayViewCacheRequest records one today view cache lookup as a hit or a miss.
+func RecordTodayViewCacheRequest(ctx context.Context, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	todayViewCacheRequests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("result", result),
+	))
+}
+
+// RecordQueryEmbeddingCacheRequest records one shared query embedding cache lookup as a hit or a miss.
+func RecordQueryEmbeddingCacheRequest(ctx context.Context, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	queryEmbeddingCacheRequests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("result", result),
+	))
+}
+
+// RecordWeatherCacheRequest records one weather forecast cache lookup as a hit or a miss.
+func RecordWeatherCacheRequest(ctx context.Context, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	weatherCacheRequests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("result", result),
+	))
+}
+
+// RecordConfigReload records whether a subsystem applied or rejected a configuration reload.
+func RecordConfigReload(ctx context.Context, subsystem string, applied bool) {
+	result := "rejected"
+	if applied {
+		result = "applied"
+	}
+	configReloads.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("subsystem", subsystem),
+		attribute.String("result", result),
+	))
+}
+
+// RecordLLMQueueWait records how long an LLM turn waited for a concurrency slot and whether it got one,
+// including background turns promoted after waiting too long.
+func RecordLLMQueueWait(ctx context.Context, model, lane, outcome string, wait time.Duration) {
+	llmQueueWaits.Record(ctx, wait.Seconds(), metric.WithAttributes(
+		attribute.String("model", model),
+		attribute.String("lane", lane),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordLLMInFlight adds delta to the number of LLM turns running behind the concurrency limiter.
+func RecordLLMInFlight(ctx context.Context, model, lane string, delta int) {
+	llmInFlight.Add(ctx, int64(delta), metric.WithAttributes(
+		attribute.String("model", model),
+		attribute.String("lane", lane),
+	))
+}
+
+// RecordWorkerPoolState records the live workers and the queued jobs of the outbox consumer worker pool.
+func RecordWorkerPoolState(ctx context.Context, workers, backlog int) {
+	workerPoolWorkers.Record(ctx, int64(workers))
+	workerPoolBacklog.Record(ctx, int64(backlog))
+}
+
+// RecordOutboxPublish records how long the broker took to acknowledge an outbox event and whether it succeeded.
+func RecordOutboxPublish(ctx context.Context, topic string, success bool, latency time.Duration) {
+	outcome := "success"
+	if !success {
+		outcome = "error"
+	}
+	outboxPublishLatency.Record(ctx, latency.Seconds(), metric.WithAttributes(
+		attribute.String("topic", topic),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordOutboxRelayBatch records the number of events published by one outbox relay batch.
+func RecordOutboxRelayBatch(ctx context.Context, size int) {
+	outboxRelayBatchSize.Record(ctx, int64(size))
+}
+
+// RecordOutboxBacklog records the pending and failed outbox events of a topic and the age of its oldest pending event.
+func RecordOutboxBacklog(ctx context.Context, topic string, pending, failed int, oldestPendingAge time.Duration) {
+	attrs := metric.WithAttributes(attribute.String("topic", topic))
+	outboxPendingEvents.Record(ctx, int64(pending), attrs)
+	outboxFailedEvents.Record(ctx, int64(failed), attrs)
+	outboxOldestPendingAge.Record(ctx, oldestPendingAge.Seconds(), attrs)
+}
+
+// RecordOutboxConsumerLag records how far a subscription trails the last event published on its topic.
+func RecordOutboxConsumerLag(ctx context.Context, subscription, topic string, lag time.Duration) {
+	outboxConsumerLag.Record(ctx, lag.Seconds(), metric.WithAttributes(
+		attribute.String("subscription", subscription),
+		attribute.String("topic", topic),
+	))
+}
+
+// RecordShadowEvaluation records the outcome of replaying one chat turn against the shadow candidate model,
+// including turns skipped because the budget was spent or every evaluation slot was busy.
+func RecordShadowEvaluation(ctx context.Context, model, outcome string) {
+	shadowEvaluations.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("model", model),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordChatTurnsInFlight adds delta to the number of chat turns being streamed.
+func RecordChatTurnsInFlight(ctx context.Context, delta int) {
+	chatTurnsInFlight.Add(ctx, int64(delta))
+}
+
+// RecordChatTurnsInterrupted records the chat turns a shutdown interrupted once its drain timeout expired.
+func RecordChatTurnsInterrupted(ctx context.Context, count int) {
+	chatTurnsInterrupted.Add(ctx, int64(count))
+}
+
+// RecordChatGroundingFailure records an assistant answer value not supported by the action results of its turn.
+func RecordChatGroundingFailure(ctx context.Context, model, kind string) {
+	chatGroundingFailures.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("model", model),
+		attribute.String("kind", kind),
+	))
+}
+
+// RecordChatTurnPhase records the time a completed chat turn spent in one phase, such as history_fetch or persistence.
+func RecordChatTurnPhase(ctx context.Context, model, phase string, duration time.Duration) {
+	chatTurnPhaseDurations.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("model", model),
+		attribute.String("phase", phase),
+	))
+}
+
+// RecordAssistantActionDuration records the execution time of one action run by a chat turn.
+func RecordAssistantActionDuration(ctx context.Context, action string, duration time.Duration) {
+	assistantActionDurations.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("action", action),
+	))
+}
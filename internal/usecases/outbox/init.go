@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/cleitonmarx/symbiont/depend"
@@ -14,10 +15,37 @@ type InitRelay struct {
 	Uow       transaction.UnitOfWork `resolve:""`
 	Logger    *log.Logger            `resolve:""`
 	Publisher outbox.EventPublisher  `resolve:""`
+	Locker    core.Locker            `resolve:""`
+	// MaxBatchSize is shared with the publisher so one relay batch fits in one publish request per topic.
+	MaxBatchSize int `config:"OUTBOX_RELAY_MAX_BATCH_SIZE" default:"100"`
 }
 
 // Initialize registers the outbox relay use case in the dependency container.
 func (iro InitRelay) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[Relay](NewRelayImpl(iro.Uow, iro.Publisher, iro.Logger))
+	depend.Register[Relay](NewRelayImpl(iro.Uow, iro.Publisher, iro.Logger, iro.Locker, iro.MaxBatchSize))
+	return ctx, nil
+}
+
+// InitReprocessEvents is used to initialize the ReprocessEvents use case in the dependency container
+type InitReprocessEvents struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// Initialize registers the reprocess events use case in the dependency container.
+func (i InitReprocessEvents) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ReprocessEvents](NewReprocessEventsImpl(i.Uow, i.TimeProvider))
+	return ctx, nil
+}
+
+// InitMonitor is used to initialize the Monitor use case in the dependency container
+type InitMonitor struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// Initialize registers the outbox monitor use case in the dependency container.
+func (i InitMonitor) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Monitor](NewMonitorImpl(i.Uow, i.TimeProvider))
 	return ctx, nil
 }
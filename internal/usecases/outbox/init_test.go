@@ -20,3 +20,31 @@ func TestInitRelayOutbox_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, registeredRelay)
 }
+
+func TestInitReprocessEvents_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitReprocessEvents{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[ReprocessEvents]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitMonitor_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitMonitor{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Monitor]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
@@ -6,10 +6,174 @@ package outbox
 
 import (
 	"context"
+	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockMonitor creates a new instance of MockMonitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitor {
+	mock := &MockMonitor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMonitor is an autogenerated mock type for the Monitor type
+type MockMonitor struct {
+	mock.Mock
+}
+
+type MockMonitor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitor) EXPECT() *MockMonitor_Expecter {
+	return &MockMonitor_Expecter{mock: &_m.Mock}
+}
+
+// Health provides a mock function for the type MockMonitor
+func (_mock *MockMonitor) Health(ctx context.Context) (outbox.Health, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 outbox.Health
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (outbox.Health, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) outbox.Health); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(outbox.Health)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMonitor_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type MockMonitor_Health_Call struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMonitor_Expecter) Health(ctx interface{}) *MockMonitor_Health_Call {
+	return &MockMonitor_Health_Call{Call: _e.mock.On("Health", ctx)}
+}
+
+func (_c *MockMonitor_Health_Call) Run(run func(ctx context.Context)) *MockMonitor_Health_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMonitor_Health_Call) Return(health outbox.Health, err error) *MockMonitor_Health_Call {
+	_c.Call.Return(health, err)
+	return _c
+}
+
+func (_c *MockMonitor_Health_Call) RunAndReturn(run func(ctx context.Context) (outbox.Health, error)) *MockMonitor_Health_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordConsumption provides a mock function for the type MockMonitor
+func (_mock *MockMonitor) RecordConsumption(ctx context.Context, subscription string, topic outbox.Topic, lastEventAt time.Time, consumeErr error) error {
+	ret := _mock.Called(ctx, subscription, topic, lastEventAt, consumeErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordConsumption")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, outbox.Topic, time.Time, error) error); ok {
+		r0 = returnFunc(ctx, subscription, topic, lastEventAt, consumeErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMonitor_RecordConsumption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordConsumption'
+type MockMonitor_RecordConsumption_Call struct {
+	*mock.Call
+}
+
+// RecordConsumption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription string
+//   - topic outbox.Topic
+//   - lastEventAt time.Time
+//   - consumeErr error
+func (_e *MockMonitor_Expecter) RecordConsumption(ctx interface{}, subscription interface{}, topic interface{}, lastEventAt interface{}, consumeErr interface{}) *MockMonitor_RecordConsumption_Call {
+	return &MockMonitor_RecordConsumption_Call{Call: _e.mock.On("RecordConsumption", ctx, subscription, topic, lastEventAt, consumeErr)}
+}
+
+func (_c *MockMonitor_RecordConsumption_Call) Run(run func(ctx context.Context, subscription string, topic outbox.Topic, lastEventAt time.Time, consumeErr error)) *MockMonitor_RecordConsumption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 outbox.Topic
+		if args[2] != nil {
+			arg2 = args[2].(outbox.Topic)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 error
+		if args[4] != nil {
+			arg4 = args[4].(error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMonitor_RecordConsumption_Call) Return(err error) *MockMonitor_RecordConsumption_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMonitor_RecordConsumption_Call) RunAndReturn(run func(ctx context.Context, subscription string, topic outbox.Topic, lastEventAt time.Time, consumeErr error) error) *MockMonitor_RecordConsumption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRelay creates a new instance of MockRelay. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRelay(t interface {
@@ -87,3 +251,96 @@ func (_c *MockRelay_Execute_Call) RunAndReturn(run func(ctx context.Context) err
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockReprocessEvents creates a new instance of MockReprocessEvents. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReprocessEvents(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReprocessEvents {
+	mock := &MockReprocessEvents{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReprocessEvents is an autogenerated mock type for the ReprocessEvents type
+type MockReprocessEvents struct {
+	mock.Mock
+}
+
+type MockReprocessEvents_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReprocessEvents) EXPECT() *MockReprocessEvents_Expecter {
+	return &MockReprocessEvents_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockReprocessEvents
+func (_mock *MockReprocessEvents) Execute(ctx context.Context, req ReprocessRequest) (int, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReprocessRequest) (int, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ReprocessRequest) int); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ReprocessRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReprocessEvents_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockReprocessEvents_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ReprocessRequest
+func (_e *MockReprocessEvents_Expecter) Execute(ctx interface{}, req interface{}) *MockReprocessEvents_Execute_Call {
+	return &MockReprocessEvents_Execute_Call{Call: _e.mock.On("Execute", ctx, req)}
+}
+
+func (_c *MockReprocessEvents_Execute_Call) Run(run func(ctx context.Context, req ReprocessRequest)) *MockReprocessEvents_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ReprocessRequest
+		if args[1] != nil {
+			arg1 = args[1].(ReprocessRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReprocessEvents_Execute_Call) Return(n int, err error) *MockReprocessEvents_Execute_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockReprocessEvents_Execute_Call) RunAndReturn(run func(ctx context.Context, req ReprocessRequest) (int, error)) *MockReprocessEvents_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
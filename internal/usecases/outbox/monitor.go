@@ -0,0 +1,149 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Monitor tracks whether outbox events are published and consumed, so a relay or a consumer that
+// silently stopped, such as the board summary or conversation title generator, is noticed.
+type Monitor interface {
+	// Health reports the outbox backlog and the consumer lag across tenants and records them as metrics.
+	Health(ctx context.Context) (outbox.Health, error)
+	// RecordConsumption records that the subscription handled the events of the topic published up to
+	// lastEventAt, or the error that made it fail to handle them.
+	RecordConsumption(ctx context.Context, subscription string, topic outbox.Topic, lastEventAt time.Time, consumeErr error) error
+}
+
+// MonitorImpl implements Monitor.
+type MonitorImpl struct {
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewMonitorImpl creates a new instance of MonitorImpl.
+func NewMonitorImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider) MonitorImpl {
+	return MonitorImpl{
+		uow:          uow,
+		timeProvider: timeProvider,
+	}
+}
+
+// Health implements Monitor.
+func (m MonitorImpl) Health(ctx context.Context) (outbox.Health, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var (
+		backlogs    []outbox.Backlog
+		lastError   outbox.EventError
+		found       bool
+		checkpoints []outbox.ConsumerCheckpoint
+	)
+	err := m.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		var err error
+		if backlogs, err = scope.Outbox().ListBacklogs(uowCtx); err != nil {
+			return err
+		}
+		if lastError, found, err = scope.Outbox().GetLastEventError(uowCtx); err != nil {
+			return err
+		}
+		checkpoints, err = scope.Outbox().ListConsumerCheckpoints(uowCtx)
+		return err
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return outbox.Health{}, err
+	}
+
+	now := m.timeProvider.Now()
+	health := outbox.Health{
+		Topics:    backlogs,
+		Consumers: make([]outbox.ConsumerLag, 0, len(checkpoints)),
+		CheckedAt: now,
+	}
+	if found {
+		health.LastError = &lastError
+	}
+
+	lastPublished := make(map[outbox.Topic]*time.Time, len(backlogs))
+	for _, b := range backlogs {
+		health.Pending += b.Pending
+		health.Failed += b.Failed
+		lastPublished[b.Topic] = b.LastPublishedAt
+
+		var age time.Duration
+		if b.OldestPendingAt != nil {
+			age = max(now.Sub(*b.OldestPendingAt), 0)
+		}
+		health.OldestPendingAge = max(health.OldestPendingAge, age)
+		metrics.RecordOutboxBacklog(spanCtx, string(b.Topic), b.Pending, b.Failed, age)
+	}
+
+	for _, c := range checkpoints {
+		consumer := outbox.ConsumerLag{ConsumerCheckpoint: c}
+		// A subscription that never handled an event has no progress to measure, its last error tells why.
+		if published := lastPublished[c.Topic]; published != nil && c.LastEventAt != nil {
+			consumer.Lag = max(published.Sub(*c.LastEventAt), 0)
+		}
+		health.Consumers = append(health.Consumers, consumer)
+		metrics.RecordOutboxConsumerLag(spanCtx, c.Subscription, string(c.Topic), consumer.Lag)
+	}
+
+	span.SetAttributes(
+		attribute.Int("pending", health.Pending),
+		attribute.Int("failed", health.Failed),
+	)
+	return health, nil
+}
+
+// RecordConsumption implements Monitor. Canceled consumptions are not errors of the subscription and
+// are not recorded.
+func (m MonitorImpl) RecordConsumption(ctx context.Context, subscription string, topic outbox.Topic, lastEventAt time.Time, consumeErr error) error {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("subscription", subscription),
+		attribute.String("topic", string(topic)),
+	))
+	defer span.End()
+
+	subscription = strings.TrimSpace(subscription)
+	if subscription == "" {
+		err := core.NewValidationErr("subscription is required")
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+	if errors.Is(consumeErr, context.Canceled) {
+		return nil
+	}
+
+	now := m.timeProvider.Now()
+	checkpoint := outbox.ConsumerCheckpoint{
+		Subscription: subscription,
+		Topic:        topic,
+		UpdatedAt:    now,
+	}
+	if consumeErr != nil {
+		message := consumeErr.Error()
+		checkpoint.LastError = &message
+		checkpoint.LastErrorAt = &now
+	} else {
+		checkpoint.LastEventAt = &lastEventAt
+	}
+
+	err := m.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		return scope.Outbox().SaveConsumerCheckpoint(uowCtx, checkpoint)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMonitorImpl_Health(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := fixedTime.Add(d)
+		return &t
+	}
+	eventID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	lastError := "generation failed"
+
+	tests := map[string]struct {
+		setExpectations func(outboxRepo *outbox.MockRepository)
+		expected        outbox.Health
+		expectedErr     bool
+	}{
+		"backlog-and-lag": {
+			setExpectations: func(outboxRepo *outbox.MockRepository) {
+				outboxRepo.EXPECT().ListBacklogs(mock.Anything).Return([]outbox.Backlog{
+					{Topic: outbox.Topic_ChatMessages, LastPublishedAt: at(-time.Minute)},
+					{Topic: outbox.Topic_Todo, Pending: 3, Failed: 1, OldestPendingAt: at(-10 * time.Minute), LastPublishedAt: at(-2 * time.Minute)},
+				}, nil).Once()
+				outboxRepo.EXPECT().GetLastEventError(mock.Anything).Return(outbox.EventError{
+					EventID: eventID,
+					Topic:   outbox.Topic_Todo,
+					Status:  outbox.Status_Failed,
+					Message: "broker unavailable",
+				}, true, nil).Once()
+				outboxRepo.EXPECT().ListConsumerCheckpoints(mock.Anything).Return([]outbox.ConsumerCheckpoint{
+					{Subscription: "chat-title", Topic: outbox.Topic_ChatMessages, LastError: &lastError, LastErrorAt: at(-time.Minute)},
+					{Subscription: "todo-summary", Topic: outbox.Topic_Todo, LastEventAt: at(-32 * time.Minute)},
+				}, nil).Once()
+			},
+			expected: outbox.Health{
+				Pending:          3,
+				Failed:           1,
+				OldestPendingAge: 10 * time.Minute,
+				Topics: []outbox.Backlog{
+					{Topic: outbox.Topic_ChatMessages, LastPublishedAt: at(-time.Minute)},
+					{Topic: outbox.Topic_Todo, Pending: 3, Failed: 1, OldestPendingAt: at(-10 * time.Minute), LastPublishedAt: at(-2 * time.Minute)},
+				},
+				LastError: &outbox.EventError{
+					EventID: eventID,
+					Topic:   outbox.Topic_Todo,
+					Status:  outbox.Status_Failed,
+					Message: "broker unavailable",
+				},
+				Consumers: []outbox.ConsumerLag{
+					{ConsumerCheckpoint: outbox.ConsumerCheckpoint{
+						Subscription: "chat-title", Topic: outbox.Topic_ChatMessages, LastError: &lastError, LastErrorAt: at(-time.Minute),
+					}},
+					{
+						ConsumerCheckpoint: outbox.ConsumerCheckpoint{
+							Subscription: "todo-summary", Topic: outbox.Topic_Todo, LastEventAt: at(-32 * time.Minute),
+						},
+						Lag: 30 * time.Minute,
+					},
+				},
+				CheckedAt: fixedTime,
+			},
+		},
+		"empty-outbox": {
+			setExpectations: func(outboxRepo *outbox.MockRepository) {
+				outboxRepo.EXPECT().ListBacklogs(mock.Anything).Return(nil, nil).Once()
+				outboxRepo.EXPECT().GetLastEventError(mock.Anything).Return(outbox.EventError{}, false, nil).Once()
+				outboxRepo.EXPECT().ListConsumerCheckpoints(mock.Anything).Return(nil, nil).Once()
+			},
+			expected: outbox.Health{
+				Consumers: []outbox.ConsumerLag{},
+				CheckedAt: fixedTime,
+			},
+		},
+		"repository-error": {
+			setExpectations: func(outboxRepo *outbox.MockRepository) {
+				outboxRepo.EXPECT().ListBacklogs(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedTime).Maybe()
+
+			scope := transaction.NewMockScope(t)
+			outboxRepo := outbox.NewMockRepository(t)
+			scope.EXPECT().Outbox().Return(outboxRepo)
+			uow.EXPECT().
+				Execute(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+					return fn(ctx, scope)
+				}).Once()
+			tt.setExpectations(outboxRepo)
+
+			monitor := NewMonitorImpl(uow, timeProvider)
+			got, err := monitor.Health(t.Context())
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestMonitorImpl_RecordConsumption(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	eventAt := fixedTime.Add(-time.Second)
+	lastError := "generation failed"
+
+	tests := map[string]struct {
+		subscription string
+		consumeErr   error
+		expected     *outbox.ConsumerCheckpoint
+		saveErr      error
+		expectedErr  bool
+	}{
+		"records-progress": {
+			subscription: "todo-summary",
+			expected: &outbox.ConsumerCheckpoint{
+				Subscription: "todo-summary",
+				Topic:        outbox.Topic_Todo,
+				LastEventAt:  &eventAt,
+				UpdatedAt:    fixedTime,
+			},
+		},
+		"records-error": {
+			subscription: "todo-summary",
+			consumeErr:   errors.New(lastError),
+			expected: &outbox.ConsumerCheckpoint{
+				Subscription: "todo-summary",
+				Topic:        outbox.Topic_Todo,
+				LastError:    &lastError,
+				LastErrorAt:  &fixedTime,
+				UpdatedAt:    fixedTime,
+			},
+		},
+		"ignores-canceled": {
+			subscription: "todo-summary",
+			consumeErr:   context.Canceled,
+		},
+		"missing-subscription": {
+			subscription: " ",
+			expectedErr:  true,
+		},
+		"save-error": {
+			subscription: "todo-summary",
+			expected: &outbox.ConsumerCheckpoint{
+				Subscription: "todo-summary",
+				Topic:        outbox.Topic_Todo,
+				LastEventAt:  &eventAt,
+				UpdatedAt:    fixedTime,
+			},
+			saveErr:     errors.New("db error"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedTime).Maybe()
+
+			if tt.expected != nil {
+				scope := transaction.NewMockScope(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+				outboxRepo.EXPECT().SaveConsumerCheckpoint(mock.Anything, *tt.expected).Return(tt.saveErr).Once()
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).Once()
+			}
+
+			monitor := NewMonitorImpl(uow, timeProvider)
+			err := monitor.RecordConsumption(t.Context(), tt.subscription, outbox.Topic_Todo, eventAt, tt.consumeErr)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
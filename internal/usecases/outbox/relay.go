@@ -2,14 +2,22 @@ package outbox
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 )
 
-const outboxRelayBatchSize = 100
+// defaultRelayBatchSize is the number of events fetched and published per batch when none is configured.
+const defaultRelayBatchSize = 100
+
+// relayLockKey is the lock held by the replica relaying the outbox, so replicas never publish
+// the events of one ordering key out of order.
+const relayLockKey = "outbox_relay"
 
 // Relay defines the interface for relaying outbox events
 type Relay interface {
@@ -19,17 +27,25 @@ type Relay interface {
 
 // RelayImpl implements Relay interface.
 type RelayImpl struct {
-	Uow       transaction.UnitOfWork `resolve:""`
-	Publisher outbox.EventPublisher  `resolve:""`
-	Logger    *log.Logger            `resolve:""`
+	Uow          transaction.UnitOfWork `resolve:""`
+	Publisher    outbox.EventPublisher  `resolve:""`
+	Logger       *log.Logger            `resolve:""`
+	Locker       core.Locker
+	MaxBatchSize int
 }
 
-// NewRelayImpl creates a new instance of RelayImpl.
-func NewRelayImpl(uow transaction.UnitOfWork, publisher outbox.EventPublisher, logger *log.Logger) RelayImpl {
+// NewRelayImpl creates a new instance of RelayImpl publishing at most maxBatchSize events per batch.
+// Only the replica holding the relay lock of locker relays; a nil locker relays without coordination.
+func NewRelayImpl(uow transaction.UnitOfWork, publisher outbox.EventPublisher, logger *log.Logger, locker core.Locker, maxBatchSize int) RelayImpl {
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultRelayBatchSize
+	}
 	return RelayImpl{
-		Uow:       uow,
-		Publisher: publisher,
-		Logger:    logger,
+		Uow:          uow,
+		Publisher:    publisher,
+		Logger:       logger,
+		Locker:       locker,
+		MaxBatchSize: maxBatchSize,
 	}
 }
 
@@ -38,11 +54,23 @@ func (r RelayImpl) Execute(ctx context.Context) error {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
+	if r.Locker != nil {
+		unlock, locked, err := r.Locker.TryLock(spanCtx, relayLockKey)
+		if telemetry.IsErrorRecorded(span, err) {
+			return fmt.Errorf("failed to acquire relay lock: %w", err)
+		}
+		// Another replica is relaying.
+		if !locked {
+			return nil
+		}
+		defer unlock()
+	}
+
 	err := r.Uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
 		outboxRepo := scope.Outbox()
 
 		for {
-			events, err := outboxRepo.FetchPendingEvents(uowCtx, outboxRelayBatchSize)
+			events, err := outboxRepo.FetchPendingEvents(uowCtx, r.MaxBatchSize)
 			if err != nil {
 				return err
 			}
@@ -53,8 +81,12 @@ func (r RelayImpl) Execute(ctx context.Context) error {
 
 			r.Logger.Printf("Fetched %d pending outbox events", len(events))
 
-			for _, event := range events {
-				if err := r.relayEvent(uowCtx, outboxRepo, event); err != nil {
+			// Events are fetched in creation order, so publishing them as one batch keeps
+			// the order of the events sharing an ordering key.
+			publishErrs := r.Publisher.PublishEvents(uowCtx, events)
+			metrics.RecordOutboxRelayBatch(uowCtx, len(events))
+			for i, event := range events {
+				if err := r.recordResult(uowCtx, outboxRepo, event, publishErrs[i]); err != nil {
 					r.Logger.Printf("relay failed for event %s: %v", event.ID, err)
 				}
 			}
@@ -66,13 +98,13 @@ func (r RelayImpl) Execute(ctx context.Context) error {
 	return nil
 }
 
-// relayEvent processes and relays a single outbox event.
-func (r RelayImpl) relayEvent(ctx context.Context, outboxRepo outbox.Repository, event outbox.Event) error {
-	if err := r.Publisher.PublishEvent(ctx, event); err != nil {
+// recordResult stores the outcome of publishing a single outbox event.
+func (r RelayImpl) recordResult(ctx context.Context, outboxRepo outbox.Repository, event outbox.Event, publishErr error) error {
+	if publishErr != nil {
 		if event.RetryCount+1 >= event.MaxRetries {
-			return outboxRepo.UpdateEvent(ctx, event.ID, outbox.Status_Failed, event.RetryCount+1, err.Error())
+			return outboxRepo.UpdateEvent(ctx, event.ID, outbox.Status_Failed, event.RetryCount+1, publishErr.Error())
 		}
-		return outboxRepo.UpdateEvent(ctx, event.ID, outbox.Status_Pending, event.RetryCount+1, err.Error())
+		return outboxRepo.UpdateEvent(ctx, event.ID, outbox.Status_Pending, event.RetryCount+1, publishErr.Error())
 	}
 	return outboxRepo.UpdateEvent(ctx, event.ID, outbox.Status_Processed, event.RetryCount, "")
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
@@ -50,24 +51,24 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{oe}, nil).Once()
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{}, nil).Once()
 
-				publisher.EXPECT().PublishEvent(
+				publisher.EXPECT().PublishEvents(
 					mock.Anything,
-					mock.Anything,
-				).Return(nil)
+					[]outbox.Event{oe},
+				).Return([]error{nil})
 
 				outboxRepo.EXPECT().UpdateEvent(
 					mock.Anything,
 					eventID,
-					mock.Anything,
-					mock.Anything,
-					mock.Anything,
+					outbox.Status_Processed,
+					0,
+					"",
 				).Return(nil)
 			},
 			expectedErr: nil,
@@ -109,19 +110,19 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return(events, nil).Once()
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{}, nil).Once()
 
-				for _, event := range events {
-					publisher.EXPECT().PublishEvent(
-						mock.Anything,
-						mock.Anything,
-					).Return(nil)
+				publisher.EXPECT().PublishEvents(
+					mock.Anything,
+					events,
+				).Return([]error{nil, nil}).Once()
 
+				for _, event := range events {
 					outboxRepo.EXPECT().UpdateEvent(
 						mock.Anything,
 						event.ID,
@@ -148,7 +149,7 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{
 					{
 						ID:         eventID,
@@ -161,20 +162,20 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 				}, nil).Once()
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{}, nil).Once()
 
-				publisher.EXPECT().PublishEvent(
+				publisher.EXPECT().PublishEvents(
 					mock.Anything,
 					mock.Anything,
-				).Return(errors.New("publish error"))
+				).Return([]error{errors.New("publish error")})
 
 				outboxRepo.EXPECT().UpdateEvent(
 					mock.Anything,
 					eventID,
-					mock.Anything,
-					mock.Anything,
-					mock.Anything,
+					outbox.Status_Pending,
+					1,
+					"publish error",
 				).Return(nil)
 			},
 			expectedErr: nil,
@@ -194,7 +195,7 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{
 					{
 						ID:         eventID,
@@ -207,21 +208,59 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 				}, nil).Once()
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{}, nil).Once()
 
-				publisher.EXPECT().PublishEvent(
+				publisher.EXPECT().PublishEvents(
 					mock.Anything,
 					mock.Anything,
-				).Return(errors.New("publish error"))
+				).Return([]error{errors.New("publish error")})
 
 				outboxRepo.EXPECT().UpdateEvent(
 					mock.Anything,
 					eventID,
+					outbox.Status_Failed,
+					3,
+					"publish error",
+				).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		"partial-batch-failure": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, publisher *outbox.MockEventPublisher) {
+				outboxRepo := outbox.NewMockRepository(t)
+
+				scope := transaction.NewMockScope(t)
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					})
+
+				eventID2 := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+				events := []outbox.Event{
+					{ID: eventID, EventType: outbox.EventType_CHAT_MESSAGE_SENT, OrderingKey: "conversation:1", MaxRetries: 3},
+					{ID: eventID2, EventType: outbox.EventType_CHAT_MESSAGE_SENT, OrderingKey: "conversation:2", MaxRetries: 3},
+				}
+
+				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
+					defaultRelayBatchSize,
+				).Return(events, nil).Once()
+				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
+					defaultRelayBatchSize,
+				).Return([]outbox.Event{}, nil).Once()
+
+				publisher.EXPECT().PublishEvents(
 					mock.Anything,
-				).Return(nil)
+					events,
+				).Return([]error{nil, errors.New("publish error")}).Once()
+
+				outboxRepo.EXPECT().UpdateEvent(mock.Anything, eventID, outbox.Status_Processed, 0, "").Return(nil).Once()
+				outboxRepo.EXPECT().UpdateEvent(mock.Anything, eventID2, outbox.Status_Pending, 1, "publish error").Return(nil).Once()
 			},
 			expectedErr: nil,
 		},
@@ -240,7 +279,7 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return(nil, errors.New("database error")).Once()
 			},
 			expectedErr: errors.New("database error"),
@@ -260,7 +299,7 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 
 				outboxRepo.EXPECT().FetchPendingEvents(
 					mock.Anything,
-					outboxRelayBatchSize,
+					defaultRelayBatchSize,
 				).Return([]outbox.Event{}, nil).Once()
 			},
 			expectedErr: nil,
@@ -276,10 +315,82 @@ func TestRelayOutboxImpl_Execute(t *testing.T) {
 				tt.setExpectations(uow, publisher)
 			}
 
-			relay := NewRelayImpl(uow, publisher, log.New(io.Discard, "", 0))
+			relay := NewRelayImpl(uow, publisher, log.New(io.Discard, "", 0), nil, 0)
 			gotErr := relay.Execute(t.Context())
 
 			assert.Equal(t, tt.expectedErr, gotErr)
 		})
 	}
 }
+
+func TestRelayImpl_Execute_Lock(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		setExpectations func(locker *core.MockLocker, uow *transaction.MockUnitOfWork)
+		expectedErr     bool
+	}{
+		"lock-acquired": {
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork) {
+				unlocked := false
+				locker.EXPECT().TryLock(mock.Anything, relayLockKey).Return(func() { unlocked = true }, true, nil).Once()
+
+				outboxRepo := outbox.NewMockRepository(t)
+				scope := transaction.NewMockScope(t)
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						assert.False(t, unlocked, "the lock must be held while relaying")
+						return fn(ctx, scope)
+					}).
+					Once()
+				outboxRepo.EXPECT().FetchPendingEvents(mock.Anything, defaultRelayBatchSize).Return([]outbox.Event{}, nil).Once()
+				t.Cleanup(func() { assert.True(t, unlocked) })
+			},
+		},
+		"held-by-another-replica": {
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork) {
+				locker.EXPECT().TryLock(mock.Anything, relayLockKey).Return(nil, false, nil).Once()
+			},
+		},
+		"lock-error": {
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork) {
+				locker.EXPECT().TryLock(mock.Anything, relayLockKey).Return(nil, false, errors.New("db down")).Once()
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			locker := core.NewMockLocker(t)
+			uow := transaction.NewMockUnitOfWork(t)
+			tt.setExpectations(locker, uow)
+
+			relay := NewRelayImpl(uow, outbox.NewMockEventPublisher(t), log.New(io.Discard, "", 0), locker, 0)
+			err := relay.Execute(t.Context())
+			assert.Equal(t, tt.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestNewRelayImpl_MaxBatchSize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		maxBatchSize int
+		want         int
+	}{
+		"configured": {maxBatchSize: 25, want: 25},
+		"default":    {maxBatchSize: 0, want: defaultRelayBatchSize},
+		"negative":   {maxBatchSize: -1, want: defaultRelayBatchSize},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			relay := NewRelayImpl(nil, nil, nil, nil, tt.maxBatchSize)
+			assert.Equal(t, tt.want, relay.MaxBatchSize)
+		})
+	}
+}
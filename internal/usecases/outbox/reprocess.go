@@ -0,0 +1,268 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// reprocessPageSize is the number of changes or conversations scanned per query.
+	reprocessPageSize = 200
+	// reprocessMessageWindow is the number of recent messages searched for the last assistant reply.
+	reprocessMessageWindow = 20
+)
+
+// ReprocessTarget selects the consumer work regenerated by a reprocess run.
+type ReprocessTarget string
+
+const (
+	// ReprocessTarget_BoardSummaries regenerates the board summary of the tenant.
+	ReprocessTarget_BoardSummaries ReprocessTarget = "board-summaries"
+	// ReprocessTarget_ConversationTitles regenerates the titles of the selected conversations.
+	ReprocessTarget_ConversationTitles ReprocessTarget = "conversation-titles"
+)
+
+// ReprocessRequest selects the historical records a reprocess run re-emits events for.
+type ReprocessRequest struct {
+	Target ReprocessTarget
+	// ConversationID restricts the run to one conversation.
+	ConversationID *uuid.UUID
+	// From and To bound the activity considered, To excluded. Zero values leave the range open.
+	From time.Time
+	To   time.Time
+	// DryRun counts the events the run would emit without recording them.
+	DryRun bool
+}
+
+// Validate checks the request targets a known consumer with a consistent range.
+func (r ReprocessRequest) Validate() error {
+	switch r.Target {
+	case ReprocessTarget_BoardSummaries, ReprocessTarget_ConversationTitles:
+	default:
+		return core.NewValidationErr(fmt.Sprintf(
+			"reprocess target must be %q or %q", ReprocessTarget_BoardSummaries, ReprocessTarget_ConversationTitles,
+		))
+	}
+	if !r.From.IsZero() && !r.To.IsZero() && !r.From.Before(r.To) {
+		return core.NewValidationErr("reprocess range start must be before its end")
+	}
+	return nil
+}
+
+// inRange reports whether t falls in the requested range.
+func (r ReprocessRequest) inRange(t time.Time) bool {
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || t.Before(r.To))
+}
+
+// ReprocessEvents re-emits synthetic events for historical records through the outbox, so the
+// regular consumers redo their work, for example after a prompt upgrade.
+type ReprocessEvents interface {
+	// Execute records the synthetic events selected by req for the tenant of ctx and returns how many were emitted.
+	Execute(ctx context.Context, req ReprocessRequest) (int, error)
+}
+
+// ReprocessEventsImpl implements ReprocessEvents.
+type ReprocessEventsImpl struct {
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewReprocessEventsImpl creates a new instance of ReprocessEventsImpl.
+func NewReprocessEventsImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider) ReprocessEventsImpl {
+	return ReprocessEventsImpl{
+		uow:          uow,
+		timeProvider: timeProvider,
+	}
+}
+
+// Execute implements ReprocessEvents.
+func (r ReprocessEventsImpl) Execute(ctx context.Context, req ReprocessRequest) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("target", string(req.Target)),
+		attribute.Bool("dry_run", req.DryRun),
+	))
+	defer span.End()
+
+	if err := req.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+
+	var emitted int
+	err := r.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		var err error
+		switch req.Target {
+		case ReprocessTarget_BoardSummaries:
+			emitted, err = r.reprocessBoardSummaries(uowCtx, scope, req)
+		case ReprocessTarget_ConversationTitles:
+			emitted, err = r.reprocessConversationTitles(uowCtx, scope, req)
+		}
+		return err
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int("emitted", emitted))
+	return emitted, nil
+}
+
+// reprocessBoardSummaries emits one todo event when the change log has activity in the requested range.
+// The board summary covers the whole tenant, so a single event regenerates it.
+func (r ReprocessEventsImpl) reprocessBoardSummaries(ctx context.Context, scope transaction.Scope, req ReprocessRequest) (int, error) {
+	latest, found, err := latestChangeInRange(ctx, scope.Change(), req)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, nil
+	}
+	if req.DryRun {
+		return 1, nil
+	}
+
+	err = scope.Outbox().CreateTodoEvent(ctx, outbox.TodoEvent{
+		Type:           outbox.EventType_TODO_UPDATED,
+		TodoID:         latest.TodoID,
+		CreatedAt:      r.timeProvider.Now(),
+		ConversationID: latest.ConversationID,
+		Reprocessed:    true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// latestChangeInRange scans the change log, oldest first, and returns the last change in the requested range.
+func latestChangeInRange(ctx context.Context, changes todo.ChangeRepository, req ReprocessRequest) (todo.Change, bool, error) {
+	var (
+		latest todo.Change
+		found  bool
+		since  int64
+	)
+	for {
+		page, hasMore, err := changes.ListChangesSince(ctx, since, req.ConversationID, reprocessPageSize)
+		if err != nil {
+			return todo.Change{}, false, err
+		}
+		for _, change := range page {
+			if !req.To.IsZero() && !change.CreatedAt.Before(req.To) {
+				return latest, found, nil
+			}
+			if req.inRange(change.CreatedAt) {
+				latest, found = change, true
+			}
+			since = change.Sequence
+			if req.ConversationID != nil && change.ConversationSequence != nil {
+				since = *change.ConversationSequence
+			}
+		}
+		if !hasMore || len(page) == 0 {
+			return latest, found, nil
+		}
+	}
+}
+
+// reprocessConversationTitles emits an assistant message event for every selected conversation, which
+// makes the title generator retitle the conversations whose title was not set by the user.
+func (r ReprocessEventsImpl) reprocessConversationTitles(ctx context.Context, scope transaction.Scope, req ReprocessRequest) (int, error) {
+	conversations, err := conversationsInRange(ctx, scope.Conversation(), req)
+	if err != nil {
+		return 0, err
+	}
+
+	emitted := 0
+	for _, conversation := range conversations {
+		messages, _, err := scope.ChatMessage().ListChatMessages(ctx, conversation.ID, 1, reprocessMessageWindow)
+		if err != nil {
+			return 0, err
+		}
+		reply, found := lastAssistantReply(messages)
+		if !found {
+			continue
+		}
+
+		if !req.DryRun {
+			err = scope.Outbox().CreateChatEvent(ctx, outbox.ChatMessageEvent{
+				Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+				ChatRole:       assistant.ChatRole_Assistant,
+				ChatMessageID:  reply.ID,
+				ConversationID: conversation.ID,
+				CreatedAt:      r.timeProvider.Now(),
+				Reprocessed:    true,
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+		emitted++
+	}
+	return emitted, nil
+}
+
+// conversationsInRange returns the requested conversation, or the conversations whose last message falls
+// in the requested range.
+func conversationsInRange(ctx context.Context, repo assistant.ConversationRepository, req ReprocessRequest) ([]assistant.Conversation, error) {
+	if req.ConversationID != nil {
+		conversation, found, err := repo.GetConversation(ctx, *req.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, core.NewNotFoundErr("conversation not found")
+		}
+		return []assistant.Conversation{conversation}, nil
+	}
+
+	var selected []assistant.Conversation
+	for page := 1; ; page++ {
+		conversations, hasMore, err := repo.ListConversations(ctx, page, reprocessPageSize)
+		if err != nil {
+			return nil, err
+		}
+		// Conversations are listed by last message time, newest first, and conversations without
+		// messages come last.
+		for _, conversation := range conversations {
+			if conversation.LastMessageAt == nil {
+				return selected, nil
+			}
+			if !req.From.IsZero() && conversation.LastMessageAt.Before(req.From) {
+				return selected, nil
+			}
+			if req.inRange(*conversation.LastMessageAt) {
+				selected = append(selected, conversation)
+			}
+		}
+		if !hasMore {
+			return selected, nil
+		}
+	}
+}
+
+// lastAssistantReply returns the most recent assistant message.
+func lastAssistantReply(messages []assistant.ChatMessage) (assistant.ChatMessage, bool) {
+	var (
+		reply assistant.ChatMessage
+		found bool
+	)
+	for _, message := range messages {
+		if message.ChatRole != assistant.ChatRole_Assistant {
+			continue
+		}
+		if !found || message.CreatedAt.After(reply.CreatedAt) {
+			reply, found = message, true
+		}
+	}
+	return reply, found
+}
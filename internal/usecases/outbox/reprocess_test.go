@@ -0,0 +1,181 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReprocessEventsImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2026, 4, 2, 9, 0, 0, 0, time.UTC)
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	todoID1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	todoID2 := uuid.MustParse("223e4567-e89b-12d3-a456-426614174000")
+	todoID3 := uuid.MustParse("323e4567-e89b-12d3-a456-426614174000")
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	replyID := uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
+
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := map[string]struct {
+		req             ReprocessRequest
+		setExpectations func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository)
+		expected        int
+		expectedErr     bool
+	}{
+		"board-summaries-latest-change-in-range": {
+			req: ReprocessRequest{Target: ReprocessTarget_BoardSummaries, From: march, To: april},
+			setExpectations: func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository) {
+				changes := todo.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changes).Once()
+				changes.EXPECT().ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), reprocessPageSize).Return([]todo.Change{
+					{Sequence: 1, TodoID: todoID1, CreatedAt: march.Add(-time.Hour)},
+					{Sequence: 2, TodoID: todoID2, CreatedAt: march.Add(time.Hour)},
+				}, true, nil).Once()
+				changes.EXPECT().ListChangesSince(mock.Anything, int64(2), (*uuid.UUID)(nil), reprocessPageSize).Return([]todo.Change{
+					{Sequence: 3, TodoID: todoID3, CreatedAt: april.Add(time.Hour)},
+				}, true, nil).Once()
+
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, outbox.TodoEvent{
+					Type:        outbox.EventType_TODO_UPDATED,
+					TodoID:      todoID2,
+					CreatedAt:   fixedTime,
+					Reprocessed: true,
+				}).Return(nil).Once()
+			},
+			expected: 1,
+		},
+		"board-summaries-no-activity": {
+			req: ReprocessRequest{Target: ReprocessTarget_BoardSummaries, From: april},
+			setExpectations: func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository) {
+				changes := todo.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changes).Once()
+				changes.EXPECT().ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), reprocessPageSize).Return([]todo.Change{
+					{Sequence: 1, TodoID: todoID1, CreatedAt: march},
+				}, false, nil).Once()
+			},
+			expected: 0,
+		},
+		"board-summaries-conversation-dry-run": {
+			req: ReprocessRequest{Target: ReprocessTarget_BoardSummaries, ConversationID: &conversationID, DryRun: true},
+			setExpectations: func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository) {
+				changes := todo.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changes).Once()
+				conversationSequence := int64(1)
+				changes.EXPECT().ListChangesSince(mock.Anything, int64(0), &conversationID, reprocessPageSize).Return([]todo.Change{
+					{Sequence: 7, TodoID: todoID1, ConversationID: &conversationID, ConversationSequence: &conversationSequence, CreatedAt: march},
+				}, false, nil).Once()
+			},
+			expected: 1,
+		},
+		"board-summaries-change-log-error": {
+			req: ReprocessRequest{Target: ReprocessTarget_BoardSummaries},
+			setExpectations: func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository) {
+				changes := todo.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changes).Once()
+				changes.EXPECT().ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), reprocessPageSize).
+					Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: true,
+		},
+		"conversation-titles-in-range": {
+			req: ReprocessRequest{Target: ReprocessTarget_ConversationTitles, From: march, To: april},
+			setExpectations: func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository) {
+				conversations := assistant.NewMockConversationRepository(t)
+				scope.EXPECT().Conversation().Return(conversations).Once()
+				otherID := uuid.New()
+				conversations.EXPECT().ListConversations(mock.Anything, 1, reprocessPageSize).Return([]assistant.Conversation{
+					{ID: uuid.New(), LastMessageAt: at(april.Add(time.Hour))},
+					{ID: conversationID, LastMessageAt: at(march.Add(time.Hour))},
+					{ID: otherID, LastMessageAt: at(march.Add(time.Minute))},
+					{ID: uuid.New(), LastMessageAt: at(march.Add(-time.Hour))},
+				}, true, nil).Once()
+
+				messages := assistant.NewMockChatMessageRepository(t)
+				scope.EXPECT().ChatMessage().Return(messages).Twice()
+				messages.EXPECT().ListChatMessages(mock.Anything, conversationID, 1, reprocessMessageWindow).Return([]assistant.ChatMessage{
+					{ID: uuid.New(), ChatRole: assistant.ChatRole_Assistant, CreatedAt: march},
+					{ID: replyID, ChatRole: assistant.ChatRole_Assistant, CreatedAt: march.Add(time.Hour)},
+					{ID: uuid.New(), ChatRole: assistant.ChatRole_User, CreatedAt: march.Add(2 * time.Hour)},
+				}, false, nil).Once()
+				// Conversations without an assistant reply have no title to regenerate.
+				messages.EXPECT().ListChatMessages(mock.Anything, otherID, 1, reprocessMessageWindow).Return([]assistant.ChatMessage{
+					{ID: uuid.New(), ChatRole: assistant.ChatRole_User, CreatedAt: march},
+				}, false, nil).Once()
+
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+				outboxRepo.EXPECT().CreateChatEvent(mock.Anything, outbox.ChatMessageEvent{
+					Type:           outbox.EventType_CHAT_MESSAGE_SENT,
+					ChatRole:       assistant.ChatRole_Assistant,
+					ChatMessageID:  replyID,
+					ConversationID: conversationID,
+					CreatedAt:      fixedTime,
+					Reprocessed:    true,
+				}).Return(nil).Once()
+			},
+			expected: 1,
+		},
+		"conversation-titles-not-found": {
+			req: ReprocessRequest{Target: ReprocessTarget_ConversationTitles, ConversationID: &conversationID},
+			setExpectations: func(scope *transaction.MockScope, outboxRepo *outbox.MockRepository) {
+				conversations := assistant.NewMockConversationRepository(t)
+				scope.EXPECT().Conversation().Return(conversations).Once()
+				conversations.EXPECT().GetConversation(mock.Anything, conversationID).
+					Return(assistant.Conversation{}, false, nil).Once()
+			},
+			expectedErr: true,
+		},
+		"invalid-target": {
+			req:         ReprocessRequest{Target: "summaries"},
+			expectedErr: true,
+		},
+		"invalid-range": {
+			req:         ReprocessRequest{Target: ReprocessTarget_BoardSummaries, From: april, To: march},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(fixedTime).Maybe()
+
+			if tt.setExpectations != nil {
+				scope := transaction.NewMockScope(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					}).Once()
+				tt.setExpectations(scope, outboxRepo)
+			}
+
+			reprocess := NewReprocessEventsImpl(uow, timeProvider)
+			got, err := reprocess.Execute(t.Context(), tt.req)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package session
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// lastUsedResolution throttles how often authenticating with a session records its last use.
+const lastUsedResolution = time.Minute
+
+// Authenticator authenticates session access tokens and delegates every other token, such as
+// API tokens, to the wrapped authenticator.
+type Authenticator struct {
+	next         access.Authenticator
+	repo         access.SessionRepository
+	timeProvider core.CurrentTimeProvider
+	userLogins   bool
+}
+
+// NewAuthenticator creates a new instance of Authenticator. userLogins reports whether users may
+// sign in through an identity provider, which requires authentication even without API principals.
+func NewAuthenticator(
+	next access.Authenticator,
+	repo access.SessionRepository,
+	timeProvider core.CurrentTimeProvider,
+	userLogins bool,
+) Authenticator {
+	return Authenticator{
+		next:         next,
+		repo:         repo,
+		timeProvider: timeProvider,
+		userLogins:   userLogins,
+	}
+}
+
+// Enabled reports whether API principals are configured or users may sign in, the only ways to start sessions.
+func (a Authenticator) Enabled() bool {
+	return a.next.Enabled() || a.userLogins
+}
+
+// Authenticate returns the principal of the session holding the access token, or delegates
+// tokens that are not session access tokens.
+func (a Authenticator) Authenticate(ctx context.Context, token string) (access.Principal, error) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		// Without API principals the wrapped authenticator accepts every token as System.
+		if a.userLogins && !a.next.Enabled() {
+			return access.Principal{}, core.NewUnauthorizedErr("missing or invalid session token")
+		}
+		return a.next.Authenticate(ctx, token)
+	}
+
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := a.timeProvider.Now()
+	session, found, err := a.repo.GetSessionByAccessToken(spanCtx, HashToken(token))
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Principal{}, err
+	}
+	if !found || !session.IsActive(now) || !now.Before(session.AccessExpiresAt) {
+		err := core.NewUnauthorizedErr("invalid or expired session token")
+		telemetry.IsErrorRecorded(span, err)
+		return access.Principal{}, err
+	}
+
+	if now.Sub(session.LastUsedAt) >= lastUsedResolution {
+		// Only the last use is written so a concurrent revocation or refresh is not undone.
+		if err := a.repo.TouchSession(spanCtx, session.ID, now); telemetry.IsErrorRecorded(span, err) {
+			return access.Principal{}, err
+		}
+	}
+
+	return session.AsPrincipal(), nil
+}
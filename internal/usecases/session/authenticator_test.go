@@ -0,0 +1,167 @@
+package session
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	token := AccessTokenPrefix + "token"
+	active := access.Session{
+		ID:              fixedID,
+		Principal:       "viewer",
+		Role:            access.RoleReadonly,
+		AccessExpiresAt: fixedNow.Add(time.Minute),
+		ExpiresAt:       fixedNow.Add(time.Hour),
+		LastUsedAt:      fixedNow.Add(-30 * time.Second),
+	}
+	stale := active
+	stale.LastUsedAt = fixedNow.Add(-time.Hour)
+	accessExpired := active
+	accessExpired.AccessExpiresAt = fixedNow
+	revokedAt := fixedNow.Add(-time.Minute)
+	revoked := active
+	revoked.RevokedAt = &revokedAt
+	invalid := core.NewUnauthorizedErr("invalid or expired session token")
+
+	tests := map[string]struct {
+		token             string
+		userLogins        bool
+		setExpectations   func(next *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider)
+		expectedPrincipal access.Principal
+		expectedErr       error
+	}{
+		"api-token-delegated": {
+			token: "viewer-api-token",
+			setExpectations: func(next *access.MockAuthenticator, _ *access.MockSessionRepository, _ *core.MockCurrentTimeProvider) {
+				next.EXPECT().Authenticate(mock.Anything, "viewer-api-token").Return(viewer, nil).Once()
+			},
+			expectedPrincipal: viewer,
+		},
+		"api-token-with-principals-and-user-logins": {
+			token:      "viewer-api-token",
+			userLogins: true,
+			setExpectations: func(next *access.MockAuthenticator, _ *access.MockSessionRepository, _ *core.MockCurrentTimeProvider) {
+				next.EXPECT().Enabled().Return(true).Once()
+				next.EXPECT().Authenticate(mock.Anything, "viewer-api-token").Return(viewer, nil).Once()
+			},
+			expectedPrincipal: viewer,
+		},
+		"api-token-with-user-logins-only": {
+			token:      "any-token",
+			userLogins: true,
+			setExpectations: func(next *access.MockAuthenticator, _ *access.MockSessionRepository, _ *core.MockCurrentTimeProvider) {
+				next.EXPECT().Enabled().Return(false).Once()
+			},
+			expectedErr: core.NewUnauthorizedErr("missing or invalid session token"),
+		},
+		"session-token": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(active, true, nil).Once()
+			},
+			expectedPrincipal: active.AsPrincipal(),
+		},
+		"records-last-use": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(stale, true, nil).Once()
+				repo.EXPECT().TouchSession(mock.Anything, stale.ID, fixedNow).Return(nil).Once()
+			},
+			expectedPrincipal: stale.AsPrincipal(),
+		},
+		"record-last-use-error": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(stale, true, nil).Once()
+				repo.EXPECT().TouchSession(mock.Anything, stale.ID, fixedNow).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"unknown-token": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(access.Session{}, false, nil).Once()
+			},
+			expectedErr: invalid,
+		},
+		"access-token-expired": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(accessExpired, true, nil).Once()
+			},
+			expectedErr: invalid,
+		},
+		"session-revoked": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(revoked, true, nil).Once()
+			},
+			expectedErr: invalid,
+		},
+		"repository-error": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().GetSessionByAccessToken(mock.Anything, HashToken(token)).Return(access.Session{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := access.NewMockAuthenticator(t)
+			repo := access.NewMockSessionRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(next, repo, tp)
+
+			got, err := NewAuthenticator(next, repo, tp, tt.userLogins).Authenticate(t.Context(), tt.token)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedPrincipal, got)
+		})
+	}
+}
+
+func TestAuthenticator_Enabled(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		principals bool
+		userLogins bool
+		want       bool
+	}{
+		"principals":  {principals: true, want: true},
+		"user-logins": {userLogins: true, want: true},
+		"both":        {principals: true, userLogins: true, want: true},
+		"neither":     {want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := access.NewMockAuthenticator(t)
+			next.EXPECT().Enabled().Return(tt.principals).Maybe()
+
+			authenticator := NewAuthenticator(next, access.NewMockSessionRepository(t), core.NewMockCurrentTimeProvider(t), tt.userLogins)
+			assert.Equal(t, tt.want, authenticator.Enabled())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// InitSessions initializes the Sessions use case and registers it in the dependency container.
+type InitSessions struct {
+	Repo         access.SessionRepository `resolve:""`
+	Streams      access.SessionStreams    `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	AccessTTL    time.Duration            `config:"SESSION_ACCESS_TTL" default:"15m"`
+	RefreshTTL   time.Duration            `config:"SESSION_REFRESH_TTL" default:"720h"`
+}
+
+// Initialize registers the Sessions use case in the dependency container.
+func (i InitSessions) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Sessions](NewSessionsImpl(i.Repo, i.Streams, i.TimeProvider, i.AccessTTL, i.RefreshTTL))
+	return ctx, nil
+}
+
+// InitLogins initializes the Logins use case and registers it in the dependency container.
+// Logins stay disabled unless an identity provider is registered.
+type InitLogins struct {
+	Users        access.UserRepository    `resolve:""`
+	Sessions     Sessions                 `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	DefaultRole  string                   `config:"OIDC_DEFAULT_ROLE" default:"member"`
+}
+
+// Initialize registers the Logins use case in the dependency container.
+func (i InitLogins) Initialize(ctx context.Context) (context.Context, error) {
+	role := access.Role(strings.ToLower(strings.TrimSpace(i.DefaultRole)))
+	if err := role.Validate(); err != nil {
+		return ctx, fmt.Errorf("invalid OIDC_DEFAULT_ROLE: %w", err)
+	}
+	provider, _ := depend.Resolve[access.IdentityProvider]()
+	depend.Register[Logins](NewLoginsImpl(provider, i.Users, i.Sessions, i.TimeProvider, role))
+	return ctx, nil
+}
+
+// InitAuthenticator wraps the registered access.Authenticator so it also accepts session access tokens.
+// It must run after the API principal authenticator and the optional identity provider are registered.
+type InitAuthenticator struct {
+	Next         access.Authenticator     `resolve:""`
+	Repo         access.SessionRepository `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// Initialize replaces the access.Authenticator in the dependency container.
+func (i InitAuthenticator) Initialize(ctx context.Context) (context.Context, error) {
+	provider, _ := depend.Resolve[access.IdentityProvider]()
+	depend.Register[access.Authenticator](NewAuthenticator(i.Next, i.Repo, i.TimeProvider, provider != nil))
+	return ctx, nil
+}
+
+// InitTokens initializes the Tokens use case and registers it in the dependency container.
+type InitTokens struct {
+	Repo         access.TokenRepository   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// Initialize registers the Tokens use case in the dependency container.
+func (i InitTokens) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Tokens](NewTokensImpl(i.Repo, i.TimeProvider))
+	return ctx, nil
+}
+
+// InitTokenAuthenticator wraps the registered access.Authenticator so it also accepts personal access tokens.
+// It must run after InitAuthenticator.
+type InitTokenAuthenticator struct {
+	Next         access.Authenticator     `resolve:""`
+	Repo         access.TokenRepository   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// Initialize replaces the access.Authenticator in the dependency container.
+func (i InitTokenAuthenticator) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[access.Authenticator](NewTokenAuthenticator(i.Next, i.Repo, i.TimeProvider))
+	return ctx, nil
+}
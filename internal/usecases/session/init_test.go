@@ -0,0 +1,95 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitSessions_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitSessions{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Sessions]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitAuthenticator_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitAuthenticator{Next: access.NewMockAuthenticator(t)}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[access.Authenticator]()
+	assert.NoError(t, err)
+	assert.IsType(t, Authenticator{}, registered)
+}
+
+func TestInitTokens_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitTokens{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Tokens]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+// TestInitTokenAuthenticator_Initialize is not parallel since it replaces the same
+// access.Authenticator that TestInitAuthenticator_Initialize checks.
+func TestInitTokenAuthenticator_Initialize(t *testing.T) {
+	i := InitTokenAuthenticator{Next: access.NewMockAuthenticator(t)}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[access.Authenticator]()
+	assert.NoError(t, err)
+	assert.IsType(t, TokenAuthenticator{}, registered)
+}
+
+func TestInitLogins_Initialize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		role    string
+		wantErr bool
+	}{
+		"default-role":   {role: "member"},
+		"uppercase-role": {role: " Readonly "},
+		"unknown-role":   {role: "owner", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			i := InitLogins{DefaultRole: tt.role}
+
+			_, err := i.Initialize(t.Context())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			registered, err := depend.Resolve[Logins]()
+			assert.NoError(t, err)
+			assert.NotNil(t, registered)
+		})
+	}
+}
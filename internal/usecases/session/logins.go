@@ -0,0 +1,141 @@
+package session
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// LoginAttempt holds the secrets binding an identity provider login to the browser that started it.
+// The browser keeps the attempt until the provider redirects it back.
+type LoginAttempt struct {
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// Logins defines the interface for signing users in through the identity provider.
+type Logins interface {
+	// Begin starts a login and returns the URL the user signs in at with the attempt to keep until the callback.
+	Begin(ctx context.Context) (string, LoginAttempt, error)
+	// Complete finishes a login with the code and state of the provider callback and starts a session for
+	// the user, provisioning the user on the first login.
+	Complete(ctx context.Context, attempt LoginAttempt, code, state, device string) (Issued, error)
+}
+
+// LoginsImpl is the implementation of the Logins use case.
+type LoginsImpl struct {
+	provider     access.IdentityProvider
+	users        access.UserRepository
+	sessions     Sessions
+	timeProvider core.CurrentTimeProvider
+	defaultRole  access.Role
+}
+
+// NewLoginsImpl creates a new instance of LoginsImpl. The provider is nil when logins are not configured.
+// Users are provisioned with the default role.
+func NewLoginsImpl(
+	provider access.IdentityProvider,
+	users access.UserRepository,
+	sessions Sessions,
+	timeProvider core.CurrentTimeProvider,
+	defaultRole access.Role,
+) LoginsImpl {
+	return LoginsImpl{
+		provider:     provider,
+		users:        users,
+		sessions:     sessions,
+		timeProvider: timeProvider,
+		defaultRole:  defaultRole,
+	}
+}
+
+// Begin starts a login and returns the URL the user signs in at with the attempt to keep until the callback.
+func (l LoginsImpl) Begin(ctx context.Context) (string, LoginAttempt, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if l.provider == nil {
+		err := core.NewNotFoundErr("OIDC login is not configured")
+		telemetry.IsErrorRecorded(span, err)
+		return "", LoginAttempt{}, err
+	}
+
+	var attempt LoginAttempt
+	for _, secret := range []*string{&attempt.State, &attempt.Nonce, &attempt.Verifier} {
+		token, err := newToken("")
+		if telemetry.IsErrorRecorded(span, err) {
+			return "", LoginAttempt{}, err
+		}
+		*secret = token
+	}
+
+	url, err := l.provider.AuthCodeURL(spanCtx, attempt.State, attempt.Nonce, attempt.Verifier)
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", LoginAttempt{}, err
+	}
+
+	return url, attempt, nil
+}
+
+// Complete finishes a login with the code and state of the provider callback and starts a session for
+// the user, provisioning the user on the first login. Returning users get their email and name refreshed
+// from the provider and keep their role.
+func (l LoginsImpl) Complete(ctx context.Context, attempt LoginAttempt, code, state, device string) (Issued, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if l.provider == nil {
+		err := core.NewNotFoundErr("OIDC login is not configured")
+		telemetry.IsErrorRecorded(span, err)
+		return Issued{}, err
+	}
+	if attempt.State == "" || subtle.ConstantTimeCompare([]byte(attempt.State), []byte(state)) != 1 {
+		err := core.NewUnauthorizedErr("login state does not match, start the login again")
+		telemetry.IsErrorRecorded(span, err)
+		return Issued{}, err
+	}
+
+	identity, err := l.provider.Exchange(spanCtx, code, attempt.Verifier, attempt.Nonce)
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	now := l.timeProvider.Now()
+	user, found, err := l.users.GetUserBySubject(spanCtx, identity.Issuer, identity.Subject)
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+	if found {
+		user.Email = identity.Email
+		user.Name = identity.Name
+		user.LastLoginAt = now
+		err = l.users.UpdateUser(spanCtx, user)
+	} else {
+		user = access.User{
+			ID:          uuid.New(),
+			Issuer:      identity.Issuer,
+			Subject:     identity.Subject,
+			Email:       identity.Email,
+			Name:        identity.Name,
+			Role:        l.defaultRole,
+			CreatedAt:   now,
+			LastLoginAt: now,
+		}
+		err = l.users.CreateUser(spanCtx, user)
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	issued, err := l.sessions.Start(access.NewContext(spanCtx, user.AsPrincipal()), device)
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	return issued, nil
+}
@@ -0,0 +1,177 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLoginsImpl_Begin(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		noProvider      bool
+		setExpectations func(provider *access.MockIdentityProvider)
+		expectedURL     string
+		expectedErr     error
+	}{
+		"returns-provider-url": {
+			setExpectations: func(provider *access.MockIdentityProvider) {
+				provider.EXPECT().AuthCodeURL(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, state, nonce, verifier string) (string, error) {
+						if state == "" || nonce == "" || len(verifier) < 43 || state == nonce {
+							return "", errors.New("weak login secrets")
+						}
+						return "https://idp.example.com/authorize?state=" + state, nil
+					}).Once()
+			},
+			expectedURL: "https://idp.example.com/authorize?state=",
+		},
+		"provider-error": {
+			setExpectations: func(provider *access.MockIdentityProvider) {
+				provider.EXPECT().AuthCodeURL(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return("", errors.New("discovery failed")).Once()
+			},
+			expectedErr: errors.New("discovery failed"),
+		},
+		"not-configured": {
+			noProvider:  true,
+			expectedErr: core.NewNotFoundErr("OIDC login is not configured"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var provider access.IdentityProvider
+			if !tt.noProvider {
+				p := access.NewMockIdentityProvider(t)
+				tt.setExpectations(p)
+				provider = p
+			}
+
+			logins := NewLoginsImpl(provider, access.NewMockUserRepository(t), NewMockSessions(t), core.NewMockCurrentTimeProvider(t), access.RoleMember)
+			url, attempt, err := logins.Begin(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.expectedURL+attempt.State, url)
+			}
+		})
+	}
+}
+
+func TestLoginsImpl_Complete(t *testing.T) {
+	t.Parallel()
+
+	attempt := LoginAttempt{State: "state", Nonce: "nonce", Verifier: "verifier"}
+	identity := access.Identity{Issuer: "https://idp.example.com", Subject: "248289761001", Email: "jane@example.com", Name: "Jane"}
+	userID := uuid.MustParse("623e4567-e89b-12d3-a456-426614174000")
+	existing := access.User{
+		ID:        userID,
+		Issuer:    identity.Issuer,
+		Subject:   identity.Subject,
+		Email:     "jane@old.example.com",
+		Role:      access.RoleAdmin,
+		CreatedAt: fixedNow.AddDate(0, -1, 0),
+	}
+	issued := Issued{Session: access.Session{ID: fixedID}, AccessToken: "sess_access", RefreshToken: "refr_refresh"}
+
+	tests := map[string]struct {
+		state           string
+		noProvider      bool
+		setExpectations func(provider *access.MockIdentityProvider, users *access.MockUserRepository, sessions *MockSessions, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"provisions-new-user": {
+			state: "state",
+			setExpectations: func(provider *access.MockIdentityProvider, users *access.MockUserRepository, sessions *MockSessions, tp *core.MockCurrentTimeProvider) {
+				provider.EXPECT().Exchange(mock.Anything, "code", "verifier", "nonce").Return(identity, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				users.EXPECT().GetUserBySubject(mock.Anything, identity.Issuer, identity.Subject).Return(access.User{}, false, nil).Once()
+				users.EXPECT().CreateUser(mock.Anything, mock.MatchedBy(func(u access.User) bool {
+					return u.ID != uuid.Nil && u.Subject == identity.Subject && u.Email == "jane@example.com" &&
+						u.Role == access.RoleMember && u.CreatedAt.Equal(fixedNow) && u.LastLoginAt.Equal(fixedNow)
+				})).Return(nil).Once()
+				sessions.EXPECT().Start(mock.MatchedBy(func(ctx context.Context) bool {
+					p := access.FromContext(ctx)
+					return p.UserID != uuid.Nil && p.Role == access.RoleMember && p.Name == "user:"+p.UserID.String()
+				}), "laptop").Return(issued, nil).Once()
+			},
+		},
+		"returning-user-keeps-role": {
+			state: "state",
+			setExpectations: func(provider *access.MockIdentityProvider, users *access.MockUserRepository, sessions *MockSessions, tp *core.MockCurrentTimeProvider) {
+				provider.EXPECT().Exchange(mock.Anything, "code", "verifier", "nonce").Return(identity, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				users.EXPECT().GetUserBySubject(mock.Anything, identity.Issuer, identity.Subject).Return(existing, true, nil).Once()
+				users.EXPECT().UpdateUser(mock.Anything, mock.MatchedBy(func(u access.User) bool {
+					return u.ID == userID && u.Email == "jane@example.com" && u.Name == "Jane" &&
+						u.Role == access.RoleAdmin && u.LastLoginAt.Equal(fixedNow)
+				})).Return(nil).Once()
+				sessions.EXPECT().Start(mock.MatchedBy(func(ctx context.Context) bool {
+					return assert.ObjectsAreEqual(access.Principal{Name: "user:" + userID.String(), Role: access.RoleAdmin, UserID: userID}, access.FromContext(ctx))
+				}), "laptop").Return(issued, nil).Once()
+			},
+		},
+		"state-mismatch": {
+			state: "forged",
+			setExpectations: func(*access.MockIdentityProvider, *access.MockUserRepository, *MockSessions, *core.MockCurrentTimeProvider) {
+			},
+			expectedErr: core.NewUnauthorizedErr("login state does not match, start the login again"),
+		},
+		"exchange-rejected": {
+			state: "state",
+			setExpectations: func(provider *access.MockIdentityProvider, _ *access.MockUserRepository, _ *MockSessions, _ *core.MockCurrentTimeProvider) {
+				provider.EXPECT().Exchange(mock.Anything, "code", "verifier", "nonce").
+					Return(access.Identity{}, core.NewUnauthorizedErr("invalid ID token")).Once()
+			},
+			expectedErr: core.NewUnauthorizedErr("invalid ID token"),
+		},
+		"repository-error": {
+			state: "state",
+			setExpectations: func(provider *access.MockIdentityProvider, users *access.MockUserRepository, _ *MockSessions, tp *core.MockCurrentTimeProvider) {
+				provider.EXPECT().Exchange(mock.Anything, "code", "verifier", "nonce").Return(identity, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				users.EXPECT().GetUserBySubject(mock.Anything, identity.Issuer, identity.Subject).Return(access.User{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"not-configured": {
+			state:      "state",
+			noProvider: true,
+			setExpectations: func(*access.MockIdentityProvider, *access.MockUserRepository, *MockSessions, *core.MockCurrentTimeProvider) {
+			},
+			expectedErr: core.NewNotFoundErr("OIDC login is not configured"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := access.NewMockIdentityProvider(t)
+			users := access.NewMockUserRepository(t)
+			sessions := NewMockSessions(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(provider, users, sessions, tp)
+
+			var idp access.IdentityProvider = provider
+			if tt.noProvider {
+				idp = nil
+			}
+
+			got, err := NewLoginsImpl(idp, users, sessions, tp, access.RoleMember).Complete(t.Context(), attempt, "code", tt.state, "laptop")
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, issued, got)
+			}
+		})
+	}
+}
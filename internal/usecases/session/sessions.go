@@ -0,0 +1,233 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+const (
+	// AccessTokenPrefix marks the access tokens of sessions so they are told apart from API tokens.
+	AccessTokenPrefix = "sess_"
+	// RefreshTokenPrefix marks the refresh tokens of sessions.
+	RefreshTokenPrefix = "refr_"
+
+	// maxDeviceLength caps the stored device description.
+	maxDeviceLength = 200
+)
+
+// Issued is a session together with the plain tokens issued for it. The tokens are only
+// available when they are issued.
+type Issued struct {
+	Session      access.Session
+	AccessToken  string
+	RefreshToken string
+}
+
+// Sessions defines the interface for managing the device sessions of the principals.
+type Sessions interface {
+	// Start starts a session for the principal of ctx, which must have authenticated with an API token.
+	Start(ctx context.Context, device string) (Issued, error)
+	// Refresh exchanges a refresh token for new access and refresh tokens, extending the session.
+	Refresh(ctx context.Context, refreshToken string) (Issued, error)
+	// List lists the active sessions of the principal of ctx, most recently used first.
+	List(ctx context.Context) ([]access.Session, error)
+	// Revoke ends a session and terminates the streams opened with it.
+	// Principals may revoke their own sessions and admins may revoke any session.
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
+
+// SessionsImpl is the implementation of the Sessions use case.
+type SessionsImpl struct {
+	repo         access.SessionRepository
+	streams      access.SessionStreams
+	timeProvider core.CurrentTimeProvider
+	accessTTL    time.Duration
+	refreshTTL   time.Duration
+}
+
+// NewSessionsImpl creates a new instance of SessionsImpl.
+func NewSessionsImpl(
+	repo access.SessionRepository,
+	streams access.SessionStreams,
+	timeProvider core.CurrentTimeProvider,
+	accessTTL time.Duration,
+	refreshTTL time.Duration,
+) SessionsImpl {
+	return SessionsImpl{
+		repo:         repo,
+		streams:      streams,
+		timeProvider: timeProvider,
+		accessTTL:    accessTTL,
+		refreshTTL:   refreshTTL,
+	}
+}
+
+// Start starts a session for the principal of ctx, which must have authenticated with an API token.
+func (s SessionsImpl) Start(ctx context.Context, device string) (Issued, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	principal := access.FromContext(spanCtx)
+	if principal.IsSystem() {
+		err := core.NewValidationErr("sessions require API_PRINCIPALS to be configured")
+		telemetry.IsErrorRecorded(span, err)
+		return Issued{}, err
+	}
+	if principal.SessionID != uuid.Nil || principal.TokenID != uuid.Nil {
+		err := core.NewForbiddenErr("sessions can only be started with an API token")
+		telemetry.IsErrorRecorded(span, err)
+		return Issued{}, err
+	}
+
+	now := s.timeProvider.Now()
+	session := access.Session{
+		ID:         uuid.New(),
+		Principal:  principal.Name,
+		Role:       principal.Role,
+		UserID:     principal.UserID,
+		Device:     normalizeDevice(device),
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	issued, err := s.issue(session, now)
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	if err := s.repo.CreateSession(spanCtx, issued.Session); telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	return issued, nil
+}
+
+// Refresh exchanges a refresh token for new access and refresh tokens, extending the session.
+// The previous tokens stop working.
+func (s SessionsImpl) Refresh(ctx context.Context, refreshToken string) (Issued, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := s.timeProvider.Now()
+	session, found, err := s.repo.GetSessionByRefreshToken(spanCtx, HashToken(refreshToken))
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+	if !found || !session.IsActive(now) {
+		err := core.NewUnauthorizedErr("invalid or expired refresh token")
+		telemetry.IsErrorRecorded(span, err)
+		return Issued{}, err
+	}
+
+	session.LastUsedAt = now
+	issued, err := s.issue(session, now)
+	if telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	if err := s.repo.UpdateSession(spanCtx, issued.Session); telemetry.IsErrorRecorded(span, err) {
+		return Issued{}, err
+	}
+
+	return issued, nil
+}
+
+// List lists the active sessions of the principal of ctx, most recently used first.
+func (s SessionsImpl) List(ctx context.Context) ([]access.Session, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	sessions, err := s.repo.ListActiveSessions(spanCtx, access.FromContext(spanCtx).Name, s.timeProvider.Now())
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// Revoke ends a session and terminates the streams opened with it.
+// Revoking a session that already ended is a no-op.
+func (s SessionsImpl) Revoke(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	principal := access.FromContext(spanCtx)
+	session, found, err := s.repo.GetSession(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	// Sessions of other principals are reported as missing so their IDs are not disclosed.
+	if !found || (session.Principal != principal.Name && !principal.Role.Allows(access.RoleAdmin)) {
+		err := core.NewNotFoundErr(fmt.Sprintf("session %s not found", id))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+
+	now := s.timeProvider.Now()
+	if session.IsActive(now) {
+		session.RevokedAt = &now
+		if err := s.repo.UpdateSession(spanCtx, session); telemetry.IsErrorRecorded(span, err) {
+			return err
+		}
+	}
+
+	s.streams.Terminate(id)
+	return nil
+}
+
+// issue generates new tokens for the session and sets their expirations.
+func (s SessionsImpl) issue(session access.Session, now time.Time) (Issued, error) {
+	accessToken, err := newToken(AccessTokenPrefix)
+	if err != nil {
+		return Issued{}, err
+	}
+	refreshToken, err := newToken(RefreshTokenPrefix)
+	if err != nil {
+		return Issued{}, err
+	}
+
+	session.AccessTokenHash = HashToken(accessToken)
+	session.AccessExpiresAt = now.Add(s.accessTTL)
+	session.RefreshTokenHash = HashToken(refreshToken)
+	session.ExpiresAt = now.Add(s.refreshTTL)
+
+	return Issued{
+		Session:      session,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// HashToken returns the hex-encoded SHA-256 hash under which a session token is stored.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newToken generates a random token with the given prefix.
+func newToken(prefix string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate session token: %w", err)
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// normalizeDevice trims the device description and caps its length.
+func normalizeDevice(device string) string {
+	device = strings.TrimSpace(device)
+	if len(device) > maxDeviceLength {
+		device = strings.ToValidUTF8(device[:maxDeviceLength], "")
+	}
+	return device
+}
@@ -0,0 +1,305 @@
+package session
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	fixedNow   = time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	fixedID    = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	viewer     = access.Principal{Name: "viewer", Role: access.RoleReadonly}
+	ops        = access.Principal{Name: "ops", Role: access.RoleAdmin}
+	accessTTL  = 15 * time.Minute
+	refreshTTL = 720 * time.Hour
+)
+
+func TestSessionsImpl_Start(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		principal       *access.Principal
+		device          string
+		setExpectations func(repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider)
+		expectedDevice  string
+		expectedErr     error
+	}{
+		"starts-session": {
+			principal: &viewer,
+			device:    "  laptop  ",
+			setExpectations: func(repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().CreateSession(mock.Anything, mock.MatchedBy(func(s access.Session) bool {
+					return s.ID != uuid.Nil && s.Principal == "viewer" && s.Role == access.RoleReadonly &&
+						s.AccessExpiresAt.Equal(fixedNow.Add(accessTTL)) && s.ExpiresAt.Equal(fixedNow.Add(refreshTTL))
+				})).Return(nil).Once()
+			},
+			expectedDevice: "laptop",
+		},
+		"caps-device-length": {
+			principal: &viewer,
+			device:    strings.Repeat("a", 300),
+			setExpectations: func(repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().CreateSession(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expectedDevice: strings.Repeat("a", maxDeviceLength),
+		},
+		"principals-disabled": {
+			expectedErr: core.NewValidationErr("sessions require API_PRINCIPALS to be configured"),
+		},
+		"started-from-session": {
+			principal:   &access.Principal{Name: "viewer", Role: access.RoleReadonly, SessionID: fixedID},
+			expectedErr: core.NewForbiddenErr("sessions can only be started with an API token"),
+		},
+		"started-from-token": {
+			principal:   &access.Principal{Name: "viewer", Role: access.RoleReadonly, TokenID: fixedID},
+			expectedErr: core.NewForbiddenErr("sessions can only be started with an API token"),
+		},
+		"repository-error": {
+			principal: &viewer,
+			setExpectations: func(repo *access.MockSessionRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().CreateSession(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockSessionRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(repo, tp)
+			}
+
+			ctx := t.Context()
+			if tt.principal != nil {
+				ctx = access.NewContext(ctx, *tt.principal)
+			}
+
+			got, err := NewSessionsImpl(repo, access.NewMockSessionStreams(t), tp, accessTTL, refreshTTL).Start(ctx, tt.device)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr != nil {
+				return
+			}
+			assert.Equal(t, tt.expectedDevice, got.Session.Device)
+			assert.True(t, strings.HasPrefix(got.AccessToken, AccessTokenPrefix))
+			assert.True(t, strings.HasPrefix(got.RefreshToken, RefreshTokenPrefix))
+			assert.Equal(t, HashToken(got.AccessToken), got.Session.AccessTokenHash)
+			assert.Equal(t, HashToken(got.RefreshToken), got.Session.RefreshTokenHash)
+		})
+	}
+}
+
+func TestSessionsImpl_Refresh(t *testing.T) {
+	t.Parallel()
+
+	revokedAt := fixedNow.Add(-time.Minute)
+	current := access.Session{
+		ID:               fixedID,
+		Principal:        "viewer",
+		Role:             access.RoleReadonly,
+		AccessTokenHash:  HashToken("sess_old"),
+		RefreshTokenHash: HashToken("refr_old"),
+		ExpiresAt:        fixedNow.Add(time.Hour),
+		CreatedAt:        fixedNow.Add(-time.Hour),
+		LastUsedAt:       fixedNow.Add(-time.Hour),
+	}
+	revoked := current
+	revoked.RevokedAt = &revokedAt
+	expired := current
+	expired.ExpiresAt = fixedNow
+
+	tests := map[string]struct {
+		setExpectations func(repo *access.MockSessionRepository)
+		expectedErr     error
+	}{
+		"rotates-tokens": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().GetSessionByRefreshToken(mock.Anything, HashToken("refr_old")).Return(current, true, nil).Once()
+				repo.EXPECT().UpdateSession(mock.Anything, mock.MatchedBy(func(s access.Session) bool {
+					return s.ID == fixedID && s.RefreshTokenHash != current.RefreshTokenHash &&
+						s.AccessTokenHash != current.AccessTokenHash && s.LastUsedAt.Equal(fixedNow) &&
+						s.ExpiresAt.Equal(fixedNow.Add(refreshTTL))
+				})).Return(nil).Once()
+			},
+		},
+		"unknown-token": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().GetSessionByRefreshToken(mock.Anything, HashToken("refr_old")).Return(access.Session{}, false, nil).Once()
+			},
+			expectedErr: core.NewUnauthorizedErr("invalid or expired refresh token"),
+		},
+		"revoked-session": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().GetSessionByRefreshToken(mock.Anything, HashToken("refr_old")).Return(revoked, true, nil).Once()
+			},
+			expectedErr: core.NewUnauthorizedErr("invalid or expired refresh token"),
+		},
+		"expired-session": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().GetSessionByRefreshToken(mock.Anything, HashToken("refr_old")).Return(expired, true, nil).Once()
+			},
+			expectedErr: core.NewUnauthorizedErr("invalid or expired refresh token"),
+		},
+		"repository-error": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().GetSessionByRefreshToken(mock.Anything, HashToken("refr_old")).Return(access.Session{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockSessionRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tp.EXPECT().Now().Return(fixedNow).Once()
+			tt.setExpectations(repo)
+
+			got, err := NewSessionsImpl(repo, access.NewMockSessionStreams(t), tp, accessTTL, refreshTTL).Refresh(t.Context(), "refr_old")
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.Equal(t, HashToken(got.RefreshToken), got.Session.RefreshTokenHash)
+			}
+		})
+	}
+}
+
+func TestSessionsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	sessions := []access.Session{{ID: fixedID, Principal: "viewer"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *access.MockSessionRepository)
+		expected        []access.Session
+		expectedErr     error
+	}{
+		"lists-own-sessions": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().ListActiveSessions(mock.Anything, "viewer", fixedNow).Return(sessions, nil).Once()
+			},
+			expected: sessions,
+		},
+		"repository-error": {
+			setExpectations: func(repo *access.MockSessionRepository) {
+				repo.EXPECT().ListActiveSessions(mock.Anything, "viewer", fixedNow).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockSessionRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tp.EXPECT().Now().Return(fixedNow).Once()
+			tt.setExpectations(repo)
+
+			ctx := access.NewContext(t.Context(), viewer)
+			got, err := NewSessionsImpl(repo, access.NewMockSessionStreams(t), tp, accessTTL, refreshTTL).List(ctx)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestSessionsImpl_Revoke(t *testing.T) {
+	t.Parallel()
+
+	revokedAt := fixedNow.Add(-time.Minute)
+	active := access.Session{ID: fixedID, Principal: "viewer", Role: access.RoleReadonly, ExpiresAt: fixedNow.Add(time.Hour)}
+	revoked := active
+	revoked.RevokedAt = &revokedAt
+	notFound := core.NewNotFoundErr("session 123e4567-e89b-12d3-a456-426614174000 not found")
+
+	tests := map[string]struct {
+		principal       access.Principal
+		setExpectations func(repo *access.MockSessionRepository, streams *access.MockSessionStreams, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"revokes-own-session": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockSessionRepository, streams *access.MockSessionStreams, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetSession(mock.Anything, fixedID).Return(active, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().UpdateSession(mock.Anything, mock.MatchedBy(func(s access.Session) bool {
+					return s.RevokedAt != nil && s.RevokedAt.Equal(fixedNow)
+				})).Return(nil).Once()
+				streams.EXPECT().Terminate(fixedID).Return(2).Once()
+			},
+		},
+		"admin-revokes-any-session": {
+			principal: ops,
+			setExpectations: func(repo *access.MockSessionRepository, streams *access.MockSessionStreams, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetSession(mock.Anything, fixedID).Return(active, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().UpdateSession(mock.Anything, mock.Anything).Return(nil).Once()
+				streams.EXPECT().Terminate(fixedID).Return(0).Once()
+			},
+		},
+		"already-revoked": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockSessionRepository, streams *access.MockSessionStreams, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetSession(mock.Anything, fixedID).Return(revoked, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				streams.EXPECT().Terminate(fixedID).Return(0).Once()
+			},
+		},
+		"other-principal-session": {
+			principal: access.Principal{Name: "dev", Role: access.RoleMember},
+			setExpectations: func(repo *access.MockSessionRepository, _ *access.MockSessionStreams, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetSession(mock.Anything, fixedID).Return(active, true, nil).Once()
+			},
+			expectedErr: notFound,
+		},
+		"not-found": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockSessionRepository, _ *access.MockSessionStreams, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetSession(mock.Anything, fixedID).Return(access.Session{}, false, nil).Once()
+			},
+			expectedErr: notFound,
+		},
+		"update-error": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockSessionRepository, _ *access.MockSessionStreams, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetSession(mock.Anything, fixedID).Return(active, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().UpdateSession(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockSessionRepository(t)
+			streams := access.NewMockSessionStreams(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(repo, streams, tp)
+
+			ctx := access.NewContext(t.Context(), tt.principal)
+			err := NewSessionsImpl(repo, streams, tp, accessTTL, refreshTTL).Revoke(ctx, fixedID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenPrefix marks personal access tokens so they are told apart from session and API tokens.
+const PersonalAccessTokenPrefix = "pat_"
+
+// IssuedToken is a personal access token together with its plain token, which is only
+// available when the token is created.
+type IssuedToken struct {
+	Token      access.PersonalAccessToken
+	PlainToken string
+}
+
+// Tokens defines the interface for managing the personal access tokens of the principals.
+type Tokens interface {
+	// Create creates a token for the principal of ctx limited to the scopes.
+	Create(ctx context.Context, name string, scopes []access.Scope) (IssuedToken, error)
+	// List lists the active tokens of the principal of ctx, newest first.
+	List(ctx context.Context) ([]access.PersonalAccessToken, error)
+	// Revoke revokes a token so it stops authenticating requests.
+	// Principals may revoke their own tokens and admins may revoke any token.
+	Revoke(ctx context.Context, id uuid.UUID) error
+}
+
+// TokensImpl is the implementation of the Tokens use case.
+type TokensImpl struct {
+	repo         access.TokenRepository
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewTokensImpl creates a new instance of TokensImpl.
+func NewTokensImpl(repo access.TokenRepository, timeProvider core.CurrentTimeProvider) TokensImpl {
+	return TokensImpl{
+		repo:         repo,
+		timeProvider: timeProvider,
+	}
+}
+
+// Create creates a token for the principal of ctx limited to the scopes. Tokens cannot create
+// other tokens, so a leaked token cannot be used to mint more.
+func (t TokensImpl) Create(ctx context.Context, name string, scopes []access.Scope) (IssuedToken, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	principal := access.FromContext(spanCtx)
+	if principal.IsSystem() {
+		err := core.NewValidationErr("personal access tokens require API_PRINCIPALS to be configured")
+		telemetry.IsErrorRecorded(span, err)
+		return IssuedToken{}, err
+	}
+	if principal.TokenID != uuid.Nil {
+		err := core.NewForbiddenErr("personal access tokens cannot be created with a personal access token")
+		telemetry.IsErrorRecorded(span, err)
+		return IssuedToken{}, err
+	}
+
+	token := access.PersonalAccessToken{
+		ID:        uuid.New(),
+		Principal: principal.Name,
+		Role:      principal.Role,
+		UserID:    principal.UserID,
+		Name:      strings.TrimSpace(name),
+		Scopes:    scopes,
+		CreatedAt: t.timeProvider.Now(),
+	}
+	if err := token.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return IssuedToken{}, err
+	}
+
+	plain, err := newToken(PersonalAccessTokenPrefix)
+	if telemetry.IsErrorRecorded(span, err) {
+		return IssuedToken{}, err
+	}
+	token.TokenHash = HashToken(plain)
+
+	if err := t.repo.CreateToken(spanCtx, token); telemetry.IsErrorRecorded(span, err) {
+		return IssuedToken{}, err
+	}
+
+	return IssuedToken{Token: token, PlainToken: plain}, nil
+}
+
+// List lists the active tokens of the principal of ctx, newest first.
+func (t TokensImpl) List(ctx context.Context) ([]access.PersonalAccessToken, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	tokens, err := t.repo.ListActiveTokens(spanCtx, access.FromContext(spanCtx).Name)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// Revoke revokes a token so it stops authenticating requests.
+// Revoking a token that is already revoked is a no-op.
+func (t TokensImpl) Revoke(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	principal := access.FromContext(spanCtx)
+	token, found, err := t.repo.GetToken(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+	// Tokens of other principals are reported as missing so their IDs are not disclosed.
+	if !found || (token.Principal != principal.Name && !principal.Role.Allows(access.RoleAdmin)) {
+		err := core.NewNotFoundErr(fmt.Sprintf("token %s not found", id))
+		telemetry.IsErrorRecorded(span, err)
+		return err
+	}
+	if !token.IsActive() {
+		return nil
+	}
+
+	now := t.timeProvider.Now()
+	token.RevokedAt = &now
+	if err := t.repo.UpdateToken(spanCtx, token); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// TokenAuthenticator authenticates personal access tokens and delegates every other token, such as
+// session and API tokens, to the wrapped authenticator.
+type TokenAuthenticator struct {
+	next         access.Authenticator
+	repo         access.TokenRepository
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewTokenAuthenticator creates a new instance of TokenAuthenticator.
+func NewTokenAuthenticator(
+	next access.Authenticator,
+	repo access.TokenRepository,
+	timeProvider core.CurrentTimeProvider,
+) TokenAuthenticator {
+	return TokenAuthenticator{
+		next:         next,
+		repo:         repo,
+		timeProvider: timeProvider,
+	}
+}
+
+// Enabled reports whether the wrapped authenticator is enabled, since tokens can only be created
+// by principals it authenticates.
+func (a TokenAuthenticator) Enabled() bool {
+	return a.next.Enabled()
+}
+
+// Authenticate returns the principal of the personal access token, or delegates tokens that are not
+// personal access tokens.
+func (a TokenAuthenticator) Authenticate(ctx context.Context, token string) (access.Principal, error) {
+	if !strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		return a.next.Authenticate(ctx, token)
+	}
+
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	pat, found, err := a.repo.GetTokenByHash(spanCtx, HashToken(token))
+	if telemetry.IsErrorRecorded(span, err) {
+		return access.Principal{}, err
+	}
+	if !found || !pat.IsActive() {
+		err := core.NewUnauthorizedErr("invalid or revoked personal access token")
+		telemetry.IsErrorRecorded(span, err)
+		return access.Principal{}, err
+	}
+
+	now := a.timeProvider.Now()
+	if pat.LastUsedAt == nil || now.Sub(*pat.LastUsedAt) >= lastUsedResolution {
+		// Only the last use is written so a concurrent revocation is not undone.
+		if err := a.repo.TouchToken(spanCtx, pat.ID, now); telemetry.IsErrorRecorded(span, err) {
+			return access.Principal{}, err
+		}
+	}
+
+	return pat.AsPrincipal(), nil
+}
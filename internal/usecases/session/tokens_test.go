@@ -0,0 +1,316 @@
+package session
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTokensImpl_Create(t *testing.T) {
+	t.Parallel()
+
+	scopes := []access.Scope{access.ScopeTodosRead, access.ScopeChat}
+
+	tests := map[string]struct {
+		principal       *access.Principal
+		name            string
+		scopes          []access.Scope
+		setExpectations func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"creates-token": {
+			principal: &viewer,
+			name:      "  backup script  ",
+			scopes:    scopes,
+			setExpectations: func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().CreateToken(mock.Anything, mock.MatchedBy(func(tk access.PersonalAccessToken) bool {
+					return tk.ID != uuid.Nil && tk.Principal == "viewer" && tk.Role == access.RoleReadonly &&
+						tk.Name == "backup script" && tk.CreatedAt.Equal(fixedNow) && tk.TokenHash != ""
+				})).Return(nil).Once()
+			},
+		},
+		"created-from-session": {
+			principal: &access.Principal{Name: "viewer", Role: access.RoleReadonly, SessionID: fixedID},
+			name:      "backup script",
+			scopes:    scopes,
+			setExpectations: func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().CreateToken(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		"principals-disabled": {
+			name:        "backup script",
+			scopes:      scopes,
+			expectedErr: core.NewValidationErr("personal access tokens require API_PRINCIPALS to be configured"),
+		},
+		"created-from-token": {
+			principal:   &access.Principal{Name: "viewer", Role: access.RoleReadonly, TokenID: fixedID, Scopes: scopes},
+			name:        "backup script",
+			scopes:      scopes,
+			expectedErr: core.NewForbiddenErr("personal access tokens cannot be created with a personal access token"),
+		},
+		"invalid-scope": {
+			principal: &viewer,
+			name:      "backup script",
+			scopes:    []access.Scope{"todos:admin"},
+			setExpectations: func(_ *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+			},
+			expectedErr: core.NewValidationErr(`scope must be one of todos:read, todos:write or chat, got "todos:admin"`),
+		},
+		"repository-error": {
+			principal: &viewer,
+			name:      "backup script",
+			scopes:    scopes,
+			setExpectations: func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().CreateToken(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockTokenRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(repo, tp)
+			}
+
+			ctx := t.Context()
+			if tt.principal != nil {
+				ctx = access.NewContext(ctx, *tt.principal)
+			}
+
+			got, err := NewTokensImpl(repo, tp).Create(ctx, tt.name, tt.scopes)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr != nil {
+				return
+			}
+			assert.True(t, strings.HasPrefix(got.PlainToken, PersonalAccessTokenPrefix))
+			assert.Equal(t, HashToken(got.PlainToken), got.Token.TokenHash)
+			assert.Equal(t, tt.scopes, got.Token.Scopes)
+		})
+	}
+}
+
+func TestTokensImpl_List(t *testing.T) {
+	t.Parallel()
+
+	tokens := []access.PersonalAccessToken{{ID: fixedID, Principal: "viewer", Name: "backup script"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *access.MockTokenRepository)
+		expected        []access.PersonalAccessToken
+		expectedErr     error
+	}{
+		"lists-tokens": {
+			setExpectations: func(repo *access.MockTokenRepository) {
+				repo.EXPECT().ListActiveTokens(mock.Anything, "viewer").Return(tokens, nil).Once()
+			},
+			expected: tokens,
+		},
+		"repository-error": {
+			setExpectations: func(repo *access.MockTokenRepository) {
+				repo.EXPECT().ListActiveTokens(mock.Anything, "viewer").Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockTokenRepository(t)
+			tt.setExpectations(repo)
+
+			got, err := NewTokensImpl(repo, core.NewMockCurrentTimeProvider(t)).List(access.NewContext(t.Context(), viewer))
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestTokensImpl_Revoke(t *testing.T) {
+	t.Parallel()
+
+	active := access.PersonalAccessToken{ID: fixedID, Principal: "viewer", Role: access.RoleReadonly}
+	revokedAt := fixedNow.Add(-time.Hour)
+	revoked := active
+	revoked.RevokedAt = &revokedAt
+	notFound := core.NewNotFoundErr("token " + fixedID.String() + " not found")
+
+	tests := map[string]struct {
+		principal       access.Principal
+		setExpectations func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"revokes-own-token": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetToken(mock.Anything, fixedID).Return(active, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().UpdateToken(mock.Anything, mock.MatchedBy(func(tk access.PersonalAccessToken) bool {
+					return tk.RevokedAt != nil && tk.RevokedAt.Equal(fixedNow)
+				})).Return(nil).Once()
+			},
+		},
+		"admin-revokes-any-token": {
+			principal: ops,
+			setExpectations: func(repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetToken(mock.Anything, fixedID).Return(active, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().UpdateToken(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		"already-revoked": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetToken(mock.Anything, fixedID).Return(revoked, true, nil).Once()
+			},
+		},
+		"token-of-other-principal": {
+			principal: access.Principal{Name: "ci", Role: access.RoleMember},
+			setExpectations: func(repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetToken(mock.Anything, fixedID).Return(active, true, nil).Once()
+			},
+			expectedErr: notFound,
+		},
+		"unknown-token": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetToken(mock.Anything, fixedID).Return(access.PersonalAccessToken{}, false, nil).Once()
+			},
+			expectedErr: notFound,
+		},
+		"repository-error": {
+			principal: viewer,
+			setExpectations: func(repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetToken(mock.Anything, fixedID).Return(access.PersonalAccessToken{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := access.NewMockTokenRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(repo, tp)
+
+			err := NewTokensImpl(repo, tp).Revoke(access.NewContext(t.Context(), tt.principal), fixedID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestTokenAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	token := PersonalAccessTokenPrefix + "token"
+	recentlyUsed := fixedNow.Add(-30 * time.Second)
+	active := access.PersonalAccessToken{
+		ID:         fixedID,
+		Principal:  "viewer",
+		Role:       access.RoleReadonly,
+		Scopes:     []access.Scope{access.ScopeTodosRead},
+		LastUsedAt: &recentlyUsed,
+	}
+	neverUsed := active
+	neverUsed.LastUsedAt = nil
+	revokedAt := fixedNow.Add(-time.Minute)
+	revoked := active
+	revoked.RevokedAt = &revokedAt
+	invalid := core.NewUnauthorizedErr("invalid or revoked personal access token")
+
+	tests := map[string]struct {
+		token             string
+		setExpectations   func(next *access.MockAuthenticator, repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider)
+		expectedPrincipal access.Principal
+		expectedErr       error
+	}{
+		"other-token-delegated": {
+			token: "viewer-api-token",
+			setExpectations: func(next *access.MockAuthenticator, _ *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				next.EXPECT().Authenticate(mock.Anything, "viewer-api-token").Return(viewer, nil).Once()
+			},
+			expectedPrincipal: viewer,
+		},
+		"personal-access-token": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTokenByHash(mock.Anything, HashToken(token)).Return(active, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+			},
+			expectedPrincipal: active.AsPrincipal(),
+		},
+		"records-last-use": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTokenByHash(mock.Anything, HashToken(token)).Return(neverUsed, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().TouchToken(mock.Anything, neverUsed.ID, fixedNow).Return(nil).Once()
+			},
+			expectedPrincipal: neverUsed.AsPrincipal(),
+		},
+		"record-last-use-error": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockTokenRepository, tp *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTokenByHash(mock.Anything, HashToken(token)).Return(neverUsed, true, nil).Once()
+				tp.EXPECT().Now().Return(fixedNow).Once()
+				repo.EXPECT().TouchToken(mock.Anything, neverUsed.ID, fixedNow).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"unknown-token": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTokenByHash(mock.Anything, HashToken(token)).Return(access.PersonalAccessToken{}, false, nil).Once()
+			},
+			expectedErr: invalid,
+		},
+		"token-revoked": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTokenByHash(mock.Anything, HashToken(token)).Return(revoked, true, nil).Once()
+			},
+			expectedErr: invalid,
+		},
+		"repository-error": {
+			token: token,
+			setExpectations: func(_ *access.MockAuthenticator, repo *access.MockTokenRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTokenByHash(mock.Anything, HashToken(token)).Return(access.PersonalAccessToken{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			next := access.NewMockAuthenticator(t)
+			repo := access.NewMockTokenRepository(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(next, repo, tp)
+
+			got, err := NewTokenAuthenticator(next, repo, tp).Authenticate(t.Context(), tt.token)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedPrincipal, got)
+		})
+	}
+}
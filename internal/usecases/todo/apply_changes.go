@@ -0,0 +1,197 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// MaxTodoOperations is the maximum number of operations accepted in one ApplyChanges batch.
+const MaxTodoOperations = 100
+
+// TodoOperationKind is the kind of change applied by a TodoOperation.
+type TodoOperationKind string
+
+const (
+	// TodoOperationKind_CREATE creates a todo from Title and DueDate.
+	TodoOperationKind_CREATE TodoOperationKind = "CREATE"
+	// TodoOperationKind_UPDATE updates the todo identified by ID.
+	TodoOperationKind_UPDATE TodoOperationKind = "UPDATE"
+	// TodoOperationKind_DELETE deletes the todo identified by ID.
+	TodoOperationKind_DELETE TodoOperationKind = "DELETE"
+)
+
+// TodoOperationStatus is the outcome of a TodoOperation within a batch.
+type TodoOperationStatus string
+
+const (
+	// TodoOperationStatus_APPLIED means the operation was committed.
+	TodoOperationStatus_APPLIED TodoOperationStatus = "APPLIED"
+	// TodoOperationStatus_FAILED means the operation was rejected and the batch was rolled back.
+	TodoOperationStatus_FAILED TodoOperationStatus = "FAILED"
+	// TodoOperationStatus_ROLLED_BACK means the operation was discarded because another operation failed.
+	TodoOperationStatus_ROLLED_BACK TodoOperationStatus = "ROLLED_BACK"
+)
+
+// TodoOperation is a single create, update or delete in an ApplyChanges batch.
+type TodoOperation struct {
+	Kind             TodoOperationKind
+	ID               *uuid.UUID
+	Title            *string
+	Status           *domain.Status
+	DueDate          *time.Time
+	EstimatedMinutes *int
+}
+
+// TodoOperationResult is the result of the operation at the same position in the batch.
+type TodoOperationResult struct {
+	Kind   TodoOperationKind
+	Status TodoOperationStatus
+	// ID is the affected todo ID. It is uuid.Nil for creates that were not applied.
+	ID uuid.UUID
+	// Todo is the created or updated todo when the operation was applied.
+	Todo *domain.Todo
+	// Err explains why a FAILED operation was rejected.
+	Err error
+}
+
+// ApplyChanges defines the interface for applying a batch of todo operations atomically.
+type ApplyChanges interface {
+	Execute(ctx context.Context, operations []TodoOperation) ([]TodoOperationResult, error)
+}
+
+// ApplyChangesImpl is the implementation of the ApplyChanges use case.
+type ApplyChangesImpl struct {
+	uow      transaction.UnitOfWork
+	operator todoOperator
+}
+
+// NewApplyChangesImpl creates a new instance of ApplyChangesImpl.
+func NewApplyChangesImpl(uow transaction.UnitOfWork, creator Creator, updater Updater, deleter Deleter) ApplyChangesImpl {
+	return ApplyChangesImpl{
+		uow: uow,
+		operator: todoOperator{
+			creator: creator,
+			updater: updater,
+			deleter: deleter,
+		},
+	}
+}
+
+// Execute applies the operations in order within one unit of work and returns one result per operation.
+// When an operation is rejected with a validation or not found error, nothing is committed: the
+// rejected operation is reported as FAILED and the others as ROLLED_BACK. Other errors are returned as is.
+func (ac ApplyChangesImpl) Execute(ctx context.Context, operations []TodoOperation) ([]TodoOperationResult, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if len(operations) == 0 {
+		err := core.NewValidationErr("at least one operation is required")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+	if len(operations) > MaxTodoOperations {
+		err := core.NewValidationErr(fmt.Sprintf("at most %d operations are allowed", MaxTodoOperations))
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+
+	applied := make([]domain.Todo, len(operations))
+	failedIndex := -1
+	err := ac.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		for i, op := range operations {
+			td, err := ac.operator.apply(uowCtx, scope, op)
+			if err != nil {
+				failedIndex = i
+				return err
+			}
+			applied[i] = td
+		}
+		return nil
+	})
+
+	results := make([]TodoOperationResult, len(operations))
+	if err == nil {
+		for i, op := range operations {
+			results[i] = TodoOperationResult{
+				Kind:   op.Kind,
+				Status: TodoOperationStatus_APPLIED,
+				ID:     applied[i].ID,
+			}
+			if op.Kind != TodoOperationKind_DELETE {
+				results[i].Todo = &applied[i]
+			}
+		}
+		return results, nil
+	}
+
+	if failedIndex < 0 || !isOperationErr(err) {
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+
+	for i, op := range operations {
+		results[i] = TodoOperationResult{
+			Kind:   op.Kind,
+			Status: TodoOperationStatus_ROLLED_BACK,
+		}
+		if op.ID != nil && op.Kind != TodoOperationKind_CREATE {
+			results[i].ID = *op.ID
+		}
+	}
+	results[failedIndex].Status = TodoOperationStatus_FAILED
+	results[failedIndex].Err = err
+
+	return results, nil
+}
+
+// todoOperator applies single todo operations within a unit of work scope.
+type todoOperator struct {
+	creator Creator
+	updater Updater
+	deleter Deleter
+}
+
+// apply runs a single operation within the unit of work scope.
+func (o todoOperator) apply(ctx context.Context, scope transaction.Scope, op TodoOperation) (domain.Todo, error) {
+	switch op.Kind {
+	case TodoOperationKind_CREATE:
+		if op.Title == nil || op.DueDate == nil {
+			return domain.Todo{}, core.NewValidationErr("title and due_date are required to create a todo")
+		}
+		estimatedMinutes := 0
+		if op.EstimatedMinutes != nil {
+			estimatedMinutes = *op.EstimatedMinutes
+		}
+		return o.creator.Create(ctx, scope, *op.Title, *op.DueDate, estimatedMinutes, nil)
+	case TodoOperationKind_UPDATE:
+		if op.ID == nil {
+			return domain.Todo{}, core.NewValidationErr("id is required to update a todo")
+		}
+		return o.updater.Update(ctx, scope, *op.ID, op.Title, op.Status, op.DueDate, op.EstimatedMinutes, nil)
+	case TodoOperationKind_DELETE:
+		if op.ID == nil {
+			return domain.Todo{}, core.NewValidationErr("id is required to delete a todo")
+		}
+		if err := o.deleter.Delete(ctx, scope, *op.ID); err != nil {
+			return domain.Todo{}, err
+		}
+		return domain.Todo{ID: *op.ID}, nil
+	default:
+		return domain.Todo{}, core.NewValidationErr(fmt.Sprintf("unknown operation %q", op.Kind))
+	}
+}
+
+// isOperationErr reports whether err rejects a single operation rather than the whole batch.
+func isOperationErr(err error) bool {
+	var validationErr *core.ValidationErr
+	var notFoundErr *core.NotFoundErr
+	return errors.As(err, &validationErr) || errors.As(err, &notFoundErr)
+}
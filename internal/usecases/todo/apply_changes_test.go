@@ -0,0 +1,143 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyChangesImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	createdID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	updatedID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	deletedID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	dueDate := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	created := domain.Todo{ID: createdID, Title: "Buy milk", Status: domain.Status_OPEN, DueDate: dueDate}
+	updated := domain.Todo{ID: updatedID, Title: "Call mom", Status: domain.Status_DONE, DueDate: dueDate}
+
+	operations := []TodoOperation{
+		{Kind: TodoOperationKind_CREATE, Title: common.Ptr("Buy milk"), DueDate: &dueDate},
+		{Kind: TodoOperationKind_UPDATE, ID: &updatedID, Status: common.Ptr(domain.Status_DONE)},
+		{Kind: TodoOperationKind_DELETE, ID: &deletedID},
+	}
+
+	tests := map[string]struct {
+		operations      []TodoOperation
+		setExpectations func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter)
+		expected        []TodoOperationResult
+		expectedErr     error
+	}{
+		"all-applied": {
+			operations: operations,
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+				expectScope(t, uow)
+				creator.EXPECT().Create(mock.Anything, mock.Anything, "Buy milk", dueDate, 0, domain.CustomFieldValues(nil)).Return(created, nil).Once()
+				updater.EXPECT().
+					Update(mock.Anything, mock.Anything, updatedID, (*string)(nil), common.Ptr(domain.Status_DONE), (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
+					Return(updated, nil).
+					Once()
+				deleter.EXPECT().Delete(mock.Anything, mock.Anything, deletedID).Return(nil).Once()
+			},
+			expected: []TodoOperationResult{
+				{Kind: TodoOperationKind_CREATE, Status: TodoOperationStatus_APPLIED, ID: createdID, Todo: &created},
+				{Kind: TodoOperationKind_UPDATE, Status: TodoOperationStatus_APPLIED, ID: updatedID, Todo: &updated},
+				{Kind: TodoOperationKind_DELETE, Status: TodoOperationStatus_APPLIED, ID: deletedID},
+			},
+		},
+		"not-found-rolls-back-batch": {
+			operations: operations,
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+				expectScope(t, uow)
+				creator.EXPECT().Create(mock.Anything, mock.Anything, "Buy milk", dueDate, 0, domain.CustomFieldValues(nil)).Return(created, nil).Once()
+				updater.EXPECT().
+					Update(mock.Anything, mock.Anything, updatedID, (*string)(nil), common.Ptr(domain.Status_DONE), (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
+					Return(domain.Todo{}, core.NewNotFoundErr("todo not found")).
+					Once()
+			},
+			expected: []TodoOperationResult{
+				{Kind: TodoOperationKind_CREATE, Status: TodoOperationStatus_ROLLED_BACK},
+				{Kind: TodoOperationKind_UPDATE, Status: TodoOperationStatus_FAILED, ID: updatedID, Err: core.NewNotFoundErr("todo not found")},
+				{Kind: TodoOperationKind_DELETE, Status: TodoOperationStatus_ROLLED_BACK, ID: deletedID},
+			},
+		},
+		"missing-create-fields": {
+			operations: []TodoOperation{{Kind: TodoOperationKind_CREATE, Title: common.Ptr("Buy milk")}},
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+				expectScope(t, uow)
+			},
+			expected: []TodoOperationResult{
+				{
+					Kind:   TodoOperationKind_CREATE,
+					Status: TodoOperationStatus_FAILED,
+					Err:    core.NewValidationErr("title and due_date are required to create a todo"),
+				},
+			},
+		},
+		"missing-update-id": {
+			operations: []TodoOperation{{Kind: TodoOperationKind_UPDATE}},
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+				expectScope(t, uow)
+			},
+			expected: []TodoOperationResult{
+				{
+					Kind:   TodoOperationKind_UPDATE,
+					Status: TodoOperationStatus_FAILED,
+					Err:    core.NewValidationErr("id is required to update a todo"),
+				},
+			},
+		},
+		"unknown-operation": {
+			operations: []TodoOperation{{Kind: "MOVE", ID: &deletedID}},
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+				expectScope(t, uow)
+			},
+			expected: []TodoOperationResult{
+				{Kind: "MOVE", Status: TodoOperationStatus_FAILED, ID: deletedID, Err: core.NewValidationErr(`unknown operation "MOVE"`)},
+			},
+		},
+		"infrastructure-error": {
+			operations: operations[2:],
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+				expectScope(t, uow)
+				deleter.EXPECT().Delete(mock.Anything, mock.Anything, deletedID).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"empty-batch": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+			},
+			expectedErr: core.NewValidationErr("at least one operation is required"),
+		},
+		"too-many-operations": {
+			operations: make([]TodoOperation, MaxTodoOperations+1),
+			setExpectations: func(uow *transaction.MockUnitOfWork, creator *MockCreator, updater *MockUpdater, deleter *MockDeleter) {
+			},
+			expectedErr: core.NewValidationErr("at most 100 operations are allowed"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			creator := NewMockCreator(t)
+			updater := NewMockUpdater(t)
+			deleter := NewMockDeleter(t)
+			tt.setExpectations(uow, creator, updater, deleter)
+
+			got, err := NewApplyChangesImpl(uow, creator, updater, deleter).Execute(t.Context(), tt.operations)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// Archive defines the interface for archiving completed todos and restoring them.
+type Archive interface {
+	// ArchiveCompleted archives the DONE todos that were not updated within the retention window and
+	// returns how many were archived.
+	ArchiveCompleted(ctx context.Context) (int, error)
+	// Restore brings an archived todo back to the default listings.
+	Restore(ctx context.Context, id uuid.UUID) (domain.Todo, error)
+}
+
+// ArchiveImpl is the implementation of the Archive use case.
+type ArchiveImpl struct {
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+	locker       core.Locker
+	retention    time.Duration
+}
+
+// NewArchiveImpl creates a new instance of ArchiveImpl. Completed todos are archived once they have not been
+// updated for the retention duration. The todos of a tenant are archived on one replica at a time when a
+// locker is supplied.
+func NewArchiveImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider, locker core.Locker, retention time.Duration) ArchiveImpl {
+	return ArchiveImpl{
+		uow:          uow,
+		timeProvider: timeProvider,
+		locker:       locker,
+		retention:    retention,
+	}
+}
+
+// ArchiveCompleted archives the DONE todos last updated before the retention window and records an update
+// change for each of them, so synced clients drop them from their default views.
+func (a ArchiveImpl) ArchiveCompleted(ctx context.Context) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if a.locker != nil {
+		// Lock keys are scoped to the tenant of ctx, so each tenant is archived by one replica at a time.
+		unlock, locked, err := a.locker.TryLock(spanCtx, "archive_completed_todos")
+		if telemetry.IsErrorRecorded(span, err) {
+			return 0, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		// Another replica is archiving the todos of the tenant.
+		if !locked {
+			return 0, nil
+		}
+		defer unlock()
+	}
+
+	now := a.timeProvider.Now()
+	var archived int
+	err := a.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		ids, err := scope.Todo().ArchiveCompletedTodos(uowCtx, now.Add(-a.retention), now)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := recordTodoChange(uowCtx, scope, outbox.EventType_TODO_UPDATED, id, now); err != nil {
+				return err
+			}
+		}
+		archived = len(ids)
+		return nil
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, fmt.Errorf("failed to archive completed todos: %w", err)
+	}
+
+	return archived, nil
+}
+
+// Restore clears the archived state of a todo. The update time is refreshed so the restored todo gets a new
+// retention window before it can be archived again.
+func (a ArchiveImpl) Restore(ctx context.Context, id uuid.UUID) (domain.Todo, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := a.timeProvider.Now()
+	var td domain.Todo
+	err := a.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		current, found, err := scope.Todo().GetTodo(uowCtx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return core.NewNotFoundErr(fmt.Sprintf("todo with ID %s not found", id))
+		}
+		if !current.IsArchived() {
+			return core.NewValidationErr("todo is not archived")
+		}
+
+		current.ArchivedAt = nil
+		current.UpdatedAt = now
+		if err := scope.Todo().UpdateTodo(uowCtx, current); err != nil {
+			return err
+		}
+		td = current
+		return recordTodoChange(uowCtx, scope, outbox.EventType_TODO_UPDATED, id, now)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Todo{}, err
+	}
+
+	return td, nil
+}
@@ -0,0 +1,210 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestArchiveImpl_ArchiveCompleted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC)
+	retention := 30 * 24 * time.Hour
+	id1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	id2 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork)
+		lock            func(locker *core.MockLocker)
+		expected        int
+		expectedErr     bool
+	}{
+		"archives-and-records-changes": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repos := expectScope(t, uow)
+				todoRepo, changeRepo, outboxRepo := repos.Todo, repos.Change, repos.Outbox
+				todoRepo.EXPECT().
+					ArchiveCompletedTodos(mock.Anything, now.Add(-retention), now).
+					Return([]uuid.UUID{id1, id2}, nil).
+					Once()
+				changeRepo.EXPECT().
+					RecordChange(mock.Anything, mock.MatchedBy(func(c domain.Change) bool {
+						return c.Type == domain.ChangeType_Updated && c.CreatedAt.Equal(now)
+					})).
+					Return(domain.Change{Sequence: 7}, nil).
+					Twice()
+				outboxRepo.EXPECT().
+					CreateTodoEvent(mock.Anything, mock.MatchedBy(func(e outbox.TodoEvent) bool {
+						return e.Type == outbox.EventType_TODO_UPDATED && (e.TodoID == id1 || e.TodoID == id2)
+					})).
+					Return(nil).
+					Twice()
+			},
+			expected: 2,
+		},
+		"nothing-to-archive": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().
+					ArchiveCompletedTodos(mock.Anything, now.Add(-retention), now).
+					Return([]uuid.UUID{}, nil).
+					Once()
+			},
+			expected: 0,
+		},
+		"another-replica-archiving": {
+			setExpectations: func(*transaction.MockUnitOfWork) {},
+			lock: func(locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "archive_completed_todos").Return(nil, false, nil).Once()
+			},
+			expected: 0,
+		},
+		"lock-error": {
+			setExpectations: func(*transaction.MockUnitOfWork) {},
+			lock: func(locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "archive_completed_todos").Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: true,
+		},
+		"archive-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().
+					ArchiveCompletedTodos(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("db error")).
+					Once()
+			},
+			expectedErr: true,
+		},
+		"record-change-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repos := expectScope(t, uow)
+				todoRepo, changeRepo := repos.Todo, repos.Change
+				todoRepo.EXPECT().
+					ArchiveCompletedTodos(mock.Anything, mock.Anything, mock.Anything).
+					Return([]uuid.UUID{id1}, nil).
+					Once()
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{}, errors.New("db error")).Once()
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tp.EXPECT().Now().Return(now).Maybe()
+			tt.setExpectations(uow)
+			locker := core.NewMockLocker(t)
+			if tt.lock != nil {
+				tt.lock(locker)
+			} else {
+				locker.EXPECT().TryLock(mock.Anything, "archive_completed_todos").Return(func() {}, true, nil).Once()
+			}
+
+			uc := NewArchiveImpl(uow, tp, locker, retention)
+			got, err := uc.ArchiveCompleted(t.Context())
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestArchiveImpl_Restore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC)
+	archivedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	archived := domain.Todo{
+		ID:         todoID,
+		Title:      "File taxes",
+		Status:     domain.Status_DONE,
+		UpdatedAt:  time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC),
+		ArchivedAt: &archivedAt,
+	}
+	restored := archived
+	restored.ArchivedAt = nil
+	restored.UpdatedAt = now
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork)
+		expected        domain.Todo
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repos := expectScope(t, uow)
+				todoRepo, changeRepo, outboxRepo := repos.Todo, repos.Change, repos.Outbox
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(archived, true, nil).Once()
+				todoRepo.EXPECT().UpdateTodo(mock.Anything, restored).Return(nil).Once()
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{Sequence: 3}, nil).Once()
+				outboxRepo.EXPECT().
+					CreateTodoEvent(mock.Anything, mock.MatchedBy(func(e outbox.TodoEvent) bool {
+						return e.Type == outbox.EventType_TODO_UPDATED && e.TodoID == todoID && e.Sequence == 3
+					})).
+					Return(nil).
+					Once()
+			},
+			expected: restored,
+		},
+		"not-found": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"not-archived": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(restored, true, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("todo is not archived"),
+		},
+		"update-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(archived, true, nil).Once()
+				todoRepo.EXPECT().UpdateTodo(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tp.EXPECT().Now().Return(now).Once()
+			tt.setExpectations(uow)
+
+			uc := NewArchiveImpl(uow, tp, nil, 30*24*time.Hour)
+			got, err := uc.Restore(t.Context(), todoID)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// ListChanges defines the interface for listing todo changes recorded after a sequence number.
+type ListChanges interface {
+	Query(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]domain.Change, bool, error)
+}
+
+// ListChangesImpl is the implementation of the ListChanges use case.
+type ListChangesImpl struct {
+	repo domain.ChangeRepository
+}
+
+// NewListChangesImpl creates a new instance of ListChangesImpl.
+func NewListChangesImpl(repo domain.ChangeRepository) ListChangesImpl {
+	return ListChangesImpl{
+		repo: repo,
+	}
+}
+
+// Query lists the todo changes recorded after the since sequence, oldest first.
+// When conversationID is set, since refers to the conversation sequence.
+func (lc ListChangesImpl) Query(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]domain.Change, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if since < 0 {
+		err := core.NewValidationErr("since must be greater than or equal to 0")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, false, err
+	}
+
+	changes, hasMore, err := lc.repo.ListChangesSince(spanCtx, since, conversationID, limit)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	return changes, hasMore, nil
+}
+
+// recordTodoChange appends the change to the todo change log and records the matching outbox
+// event carrying the assigned sequence numbers. Changes made from a chat action are also
+// sequenced within the conversation.
+func recordTodoChange(ctx context.Context, scope transaction.Scope, eventType outbox.EventType, todoID uuid.UUID, now time.Time) error {
+	change := domain.Change{
+		TodoID:    todoID,
+		Type:      changeTypeFor(eventType),
+		CreatedAt: now,
+	}
+	if conversationID, ok := assistant.ConversationIDFromContext(ctx); ok {
+		change.ConversationID = &conversationID
+	}
+
+	change, err := scope.Change().RecordChange(ctx, change)
+	if err != nil {
+		return err
+	}
+
+	return scope.Outbox().CreateTodoEvent(ctx, outbox.TodoEvent{
+		Type:                 eventType,
+		TodoID:               todoID,
+		CreatedAt:            now,
+		Sequence:             change.Sequence,
+		ConversationID:       change.ConversationID,
+		ConversationSequence: change.ConversationSequence,
+	})
+}
+
+// changeTypeFor maps a todo outbox event type to its change log type.
+func changeTypeFor(eventType outbox.EventType) domain.ChangeType {
+	switch eventType {
+	case outbox.EventType_TODO_CREATED:
+		return domain.ChangeType_Created
+	case outbox.EventType_TODO_DELETED:
+		return domain.ChangeType_Deleted
+	default:
+		return domain.ChangeType_Updated
+	}
+}
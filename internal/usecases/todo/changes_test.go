@@ -0,0 +1,73 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListChangesImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	conversationID := uuid.MustParse("9b2e4567-e89b-12d3-a456-426614174099")
+	changes := []domain.Change{
+		{Sequence: 11, TodoID: uuid.New(), Type: domain.ChangeType_Created, CreatedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+	}
+
+	tests := map[string]struct {
+		since           int64
+		conversationID  *uuid.UUID
+		setExpectations func(repo *domain.MockChangeRepository)
+		expected        []domain.Change
+		expectedHasMore bool
+		expectedErr     error
+	}{
+		"global-changes": {
+			since: 10,
+			setExpectations: func(repo *domain.MockChangeRepository) {
+				repo.EXPECT().ListChangesSince(mock.Anything, int64(10), (*uuid.UUID)(nil), 50).Return(changes, true, nil).Once()
+			},
+			expected:        changes,
+			expectedHasMore: true,
+		},
+		"conversation-changes": {
+			since:          2,
+			conversationID: &conversationID,
+			setExpectations: func(repo *domain.MockChangeRepository) {
+				repo.EXPECT().ListChangesSince(mock.Anything, int64(2), &conversationID, 50).Return(changes, false, nil).Once()
+			},
+			expected: changes,
+		},
+		"negative-since": {
+			since:           -1,
+			setExpectations: func(repo *domain.MockChangeRepository) {},
+			expectedErr:     core.NewValidationErr("since must be greater than or equal to 0"),
+		},
+		"repository-error": {
+			setExpectations: func(repo *domain.MockChangeRepository) {
+				repo.EXPECT().ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), 50).Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockChangeRepository(t)
+			tt.setExpectations(repo)
+
+			got, hasMore, err := NewListChangesImpl(repo).Query(t.Context(), tt.since, tt.conversationID, 50)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, tt.expectedHasMore, hasMore)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// Comments defines the interface for managing the comments of a todo.
+type Comments interface {
+	// Add attaches a new comment to the todo.
+	Add(ctx context.Context, todoID uuid.UUID, author domain.CommentAuthor, body string) (domain.Comment, error)
+	// Update changes the body of a user comment.
+	Update(ctx context.Context, todoID, commentID uuid.UUID, body string) (domain.Comment, error)
+	// Delete removes a comment from the todo.
+	Delete(ctx context.Context, todoID, commentID uuid.UUID) error
+	// List lists the comments of the todo, newest first.
+	List(ctx context.Context, todoID uuid.UUID, page, pageSize int) ([]domain.Comment, bool, error)
+}
+
+// CommentsImpl is the implementation of the Comments use case.
+type CommentsImpl struct {
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewCommentsImpl creates a new instance of CommentsImpl.
+func NewCommentsImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider) CommentsImpl {
+	return CommentsImpl{
+		uow:          uow,
+		timeProvider: timeProvider,
+	}
+}
+
+// Add attaches a new comment to the todo.
+func (c CommentsImpl) Add(ctx context.Context, todoID uuid.UUID, author domain.CommentAuthor, body string) (domain.Comment, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := c.timeProvider.Now()
+	comment := domain.Comment{
+		ID:        uuid.New(),
+		TodoID:    todoID,
+		Author:    author,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := comment.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return domain.Comment{}, err
+	}
+
+	err := c.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if err := ensureTodoExists(uowCtx, scope, todoID); err != nil {
+			return err
+		}
+		return scope.Comment().CreateComment(uowCtx, comment)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Comment{}, err
+	}
+
+	return comment, nil
+}
+
+// Update changes the body of a user comment. Assistant comments are read-only.
+func (c CommentsImpl) Update(ctx context.Context, todoID, commentID uuid.UUID, body string) (domain.Comment, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var comment domain.Comment
+	err := c.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		current, err := getTodoComment(uowCtx, scope, todoID, commentID)
+		if err != nil {
+			return err
+		}
+		if current.Author != domain.CommentAuthor_User {
+			return core.NewValidationErr("only user comments can be edited")
+		}
+
+		current.Body = body
+		current.UpdatedAt = c.timeProvider.Now()
+		if err := current.Validate(); err != nil {
+			return err
+		}
+		comment = current
+		return scope.Comment().UpdateComment(uowCtx, comment)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Comment{}, err
+	}
+
+	return comment, nil
+}
+
+// Delete removes a comment from the todo.
+func (c CommentsImpl) Delete(ctx context.Context, todoID, commentID uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	err := c.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if _, err := getTodoComment(uowCtx, scope, todoID, commentID); err != nil {
+			return err
+		}
+		return scope.Comment().DeleteComment(uowCtx, commentID)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// List lists the comments of the todo, newest first.
+func (c CommentsImpl) List(ctx context.Context, todoID uuid.UUID, page, pageSize int) ([]domain.Comment, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var (
+		comments []domain.Comment
+		hasMore  bool
+	)
+	err := c.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if err := ensureTodoExists(uowCtx, scope, todoID); err != nil {
+			return err
+		}
+		var err error
+		comments, hasMore, err = scope.Comment().ListComments(uowCtx, todoID, page, pageSize)
+		return err
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
+
+	return comments, hasMore, nil
+}
+
+// getTodoComment returns the comment, or a not found error when it does not belong to the todo.
+func getTodoComment(ctx context.Context, scope transaction.Scope, todoID, commentID uuid.UUID) (domain.Comment, error) {
+	comment, found, err := scope.Comment().GetComment(ctx, commentID)
+	if err != nil {
+		return domain.Comment{}, err
+	}
+	if !found || comment.TodoID != todoID {
+		return domain.Comment{}, core.NewNotFoundErr(fmt.Sprintf("comment with ID %s not found", commentID))
+	}
+	return comment, nil
+}
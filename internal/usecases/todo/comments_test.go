@@ -0,0 +1,311 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentsImpl_Add(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		author          domain.CommentAuthor
+		body            string
+		setExpectations func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"success": {
+			author: domain.CommentAuthor_User,
+			body:   "Waiting on the vendor",
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				repos := expectScope(t, uow)
+				todoRepo, commentRepo := repos.Todo, repos.Comment
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				commentRepo.EXPECT().
+					CreateComment(mock.Anything, mock.MatchedBy(func(c domain.Comment) bool {
+						return c.TodoID == todoID && c.Author == domain.CommentAuthor_User &&
+							c.Body == "Waiting on the vendor" && c.CreatedAt.Equal(now)
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"empty-body": {
+			author: domain.CommentAuthor_User,
+			body:   "   ",
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+			},
+			expectedErr: core.NewValidationErr("body cannot be empty"),
+		},
+		"body-too-long": {
+			author: domain.CommentAuthor_User,
+			body:   strings.Repeat("a", domain.MaxCommentBodyChars+1),
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+			},
+			expectedErr: core.NewValidationErr("body cannot exceed 2000 characters"),
+		},
+		"todo-not-found": {
+			author: domain.CommentAuthor_User,
+			body:   "Waiting on the vendor",
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"create-error": {
+			author: domain.CommentAuthor_Assistant,
+			body:   "Marked DONE from chat.",
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				repos := expectScope(t, uow)
+				todoRepo, commentRepo := repos.Todo, repos.Comment
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				commentRepo.EXPECT().CreateComment(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(uow, tp)
+
+			uc := NewCommentsImpl(uow, tp)
+			comment, err := uc.Add(t.Context(), todoID, tt.author, tt.body)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, todoID, comment.TodoID)
+			assert.Equal(t, tt.author, comment.Author)
+		})
+	}
+}
+
+func TestCommentsImpl_Update(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	commentID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+	userComment := domain.Comment{
+		ID:        commentID,
+		TodoID:    todoID,
+		Author:    domain.CommentAuthor_User,
+		Body:      "Waiting on the vendor",
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				commentRepo := expectScope(t, uow).Comment
+				commentRepo.EXPECT().GetComment(mock.Anything, commentID).Return(userComment, true, nil).Once()
+				tp.EXPECT().Now().Return(now).Once()
+				commentRepo.EXPECT().
+					UpdateComment(mock.Anything, mock.MatchedBy(func(c domain.Comment) bool {
+						return c.ID == commentID && c.Body == "Vendor replied" && c.UpdatedAt.Equal(now)
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"comment-not-found": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				commentRepo := expectScope(t, uow).Comment
+				commentRepo.EXPECT().GetComment(mock.Anything, commentID).Return(domain.Comment{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("comment with ID 223e4567-e89b-12d3-a456-426614174001 not found"),
+		},
+		"comment-of-another-todo": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				commentRepo := expectScope(t, uow).Comment
+				other := userComment
+				other.TodoID = uuid.New()
+				commentRepo.EXPECT().GetComment(mock.Anything, commentID).Return(other, true, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("comment with ID 223e4567-e89b-12d3-a456-426614174001 not found"),
+		},
+		"assistant-comment-read-only": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				commentRepo := expectScope(t, uow).Comment
+				assistantComment := userComment
+				assistantComment.Author = domain.CommentAuthor_Assistant
+				commentRepo.EXPECT().GetComment(mock.Anything, commentID).Return(assistantComment, true, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("only user comments can be edited"),
+		},
+		"update-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				commentRepo := expectScope(t, uow).Comment
+				commentRepo.EXPECT().GetComment(mock.Anything, commentID).Return(userComment, true, nil).Once()
+				tp.EXPECT().Now().Return(now).Once()
+				commentRepo.EXPECT().UpdateComment(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(uow, tp)
+
+			uc := NewCommentsImpl(uow, tp)
+			comment, err := uc.Update(t.Context(), todoID, commentID, "Vendor replied")
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Vendor replied", comment.Body)
+			assert.Equal(t, createdAt, comment.CreatedAt)
+		})
+	}
+}
+
+func TestCommentsImpl_Delete(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	commentID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				commentRepo := expectScope(t, uow).Comment
+				commentRepo.EXPECT().
+					GetComment(mock.Anything, commentID).
+					Return(domain.Comment{ID: commentID, TodoID: todoID}, true, nil).
+					Once()
+				commentRepo.EXPECT().DeleteComment(mock.Anything, commentID).Return(nil).Once()
+			},
+		},
+		"comment-not-found": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				commentRepo := expectScope(t, uow).Comment
+				commentRepo.EXPECT().GetComment(mock.Anything, commentID).Return(domain.Comment{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("comment with ID 223e4567-e89b-12d3-a456-426614174001 not found"),
+		},
+		"delete-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				commentRepo := expectScope(t, uow).Comment
+				commentRepo.EXPECT().
+					GetComment(mock.Anything, commentID).
+					Return(domain.Comment{ID: commentID, TodoID: todoID}, true, nil).
+					Once()
+				commentRepo.EXPECT().DeleteComment(mock.Anything, commentID).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tt.setExpectations(uow)
+
+			uc := NewCommentsImpl(uow, core.NewMockCurrentTimeProvider(t))
+			err := uc.Delete(t.Context(), todoID, commentID)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestCommentsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	comments := []domain.Comment{
+		{ID: uuid.New(), TodoID: todoID, Author: domain.CommentAuthor_User, Body: "Waiting on the vendor"},
+	}
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork)
+		expected        []domain.Comment
+		expectedHasMore bool
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repos := expectScope(t, uow)
+				todoRepo, commentRepo := repos.Todo, repos.Comment
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				commentRepo.EXPECT().ListComments(mock.Anything, todoID, 1, 10).Return(comments, true, nil).Once()
+			},
+			expected:        comments,
+			expectedHasMore: true,
+		},
+		"todo-not-found": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"list-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repos := expectScope(t, uow)
+				todoRepo, commentRepo := repos.Todo, repos.Comment
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				commentRepo.EXPECT().ListComments(mock.Anything, todoID, 1, 10).Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tt.setExpectations(uow)
+
+			uc := NewCommentsImpl(uow, core.NewMockCurrentTimeProvider(t))
+			got, hasMore, err := uc.List(t.Context(), todoID, 1, 10)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+			assert.Equal(t, tt.expectedHasMore, hasMore)
+		})
+	}
+}
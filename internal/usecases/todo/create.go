@@ -11,7 +11,7 @@ import (
 
 // Create defines the interface for the create use case.
 type Create interface {
-	Execute(ctx context.Context, title string, dueDate time.Time) (domain.Todo, error)
+	Execute(ctx context.Context, title string, dueDate time.Time, estimatedMinutes int, customFields domain.CustomFieldValues, opts ...CreateOption) (domain.Todo, error)
 }
 
 // CreateImpl is the implementation of the create use case.
@@ -28,15 +28,16 @@ func NewCreateImpl(uow transaction.UnitOfWork, creator Creator) CreateImpl {
 	}
 }
 
-// Execute creates a new todo item.
-func (cti CreateImpl) Execute(ctx context.Context, title string, dueDate time.Time) (domain.Todo, error) {
+// Execute creates a new todo item. An estimatedMinutes of zero means the todo has no effort estimate and a nil
+// customFields sets no custom field values. Options such as WithCreateLocation are passed on to the creator.
+func (cti CreateImpl) Execute(ctx context.Context, title string, dueDate time.Time, estimatedMinutes int, customFields domain.CustomFieldValues, opts ...CreateOption) (domain.Todo, error) {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
 	var todo domain.Todo
 	err := cti.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
 		var err error
-		todo, err = cti.creator.Create(uowCtx, scope, title, dueDate)
+		todo, err = cti.creator.Create(uowCtx, scope, title, dueDate, estimatedMinutes, customFields, opts...)
 		return err
 	})
 	if telemetry.IsErrorRecorded(span, err) {
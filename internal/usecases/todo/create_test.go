@@ -47,7 +47,7 @@ func TestCreateImpl_Execute(t *testing.T) {
 				creator *MockCreator,
 			) {
 				creator.EXPECT().
-					Create(mock.Anything, mock.Anything, title, dueDate).
+					Create(mock.Anything, mock.Anything, title, dueDate, 0, domain.CustomFieldValues(nil)).
 					Return(expectedTodo, nil)
 
 				uow.EXPECT().
@@ -67,7 +67,7 @@ func TestCreateImpl_Execute(t *testing.T) {
 				creator *MockCreator,
 			) {
 				creator.EXPECT().
-					Create(mock.Anything, mock.Anything, title, dueDate).
+					Create(mock.Anything, mock.Anything, title, dueDate, 0, domain.CustomFieldValues(nil)).
 					Return(domain.Todo{}, errors.New("creation failed"))
 
 				uow.EXPECT().
@@ -105,7 +105,7 @@ func TestCreateImpl_Execute(t *testing.T) {
 
 			cti := NewCreateImpl(uow, creator)
 
-			got, gotErr := cti.Execute(t.Context(), tt.title, tt.dueDate)
+			got, gotErr := cti.Execute(t.Context(), tt.title, tt.dueDate, 0, nil)
 			assert.Equal(t, tt.expectedErr, gotErr)
 			if tt.expectedErr == nil {
 				assert.Equal(t, tt.expectedTodo.ID, got.ID)
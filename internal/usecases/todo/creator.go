@@ -9,68 +9,106 @@ import (
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
-	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 	"github.com/google/uuid"
 )
 
+// CreateParams holds the optional settings of a todo creation.
+type CreateParams struct {
+	Location   *domain.Location
+	Recurrence *domain.Recurrence
+}
+
+// CreateOption defines a function type for specifying options when creating a todo.
+type CreateOption func(*CreateParams)
+
+// WithCreateLocation creates a CreateOption that sets the location of the new todo.
+func WithCreateLocation(loc domain.Location) CreateOption {
+	return func(params *CreateParams) {
+		params.Location = &loc
+	}
+}
+
+// WithCreateRecurrence creates a CreateOption that makes the new todo repeat by the rule.
+func WithCreateRecurrence(recurrence domain.Recurrence) CreateOption {
+	return func(params *CreateParams) {
+		params.Recurrence = &recurrence
+	}
+}
+
 // Creator defines the interface for creating todos within a unit of work scope.
 type Creator interface {
-	Create(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time) (domain.Todo, error)
+	Create(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time, estimatedMinutes int, customFields domain.CustomFieldValues, opts ...CreateOption) (domain.Todo, error)
 }
 
 // CreatorImpl is the implementation of the Creator use case.
 type CreatorImpl struct {
-	timeProvider core.CurrentTimeProvider
-	createUUID   func() uuid.UUID
-	encoder      semantic.Encoder
-	llmModel     string
+	timeProvider   core.CurrentTimeProvider
+	createUUID     func() uuid.UUID
+	encoder        semantic.Encoder
+	llmModel       string
+	secondaryModel string
 }
 
-// NewCreatorImpl creates a new instance of CreatorImpl.
-func NewCreatorImpl(timeProvider core.CurrentTimeProvider, encoder semantic.Encoder, llmModel string) CreatorImpl {
+// NewCreatorImpl creates a new instance of CreatorImpl. A non-empty secondaryModel also embeds new todos into
+// the secondary embedding while migrating to that model.
+func NewCreatorImpl(timeProvider core.CurrentTimeProvider, encoder semantic.Encoder, llmModel, secondaryModel string) CreatorImpl {
 	return CreatorImpl{
-		timeProvider: timeProvider,
-		createUUID:   uuid.New,
-		encoder:      encoder,
-		llmModel:     llmModel,
+		timeProvider:   timeProvider,
+		createUUID:     uuid.New,
+		encoder:        encoder,
+		llmModel:       llmModel,
+		secondaryModel: secondaryModel,
 	}
 }
 
 // Create creates a new todo item within the provided unit of work scope.
-func (tci CreatorImpl) Create(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time) (domain.Todo, error) {
+// An estimatedMinutes of zero creates the todo without an effort estimate. Custom field values are validated
+// against the fields defined by the tenant.
+func (tci CreatorImpl) Create(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time, estimatedMinutes int, customFields domain.CustomFieldValues, opts ...CreateOption) (domain.Todo, error) {
 	now := tci.timeProvider.Now()
 
+	params := CreateParams{}
+	for _, opt := range opts {
+		opt(&params)
+	}
+
 	todo := domain.Todo{
-		ID:        tci.createUUID(),
-		Title:     title,
-		Status:    domain.Status_OPEN,
-		DueDate:   dueDate.UTC(),
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:               tci.createUUID(),
+		Title:            title,
+		Status:           domain.Status_OPEN,
+		DueDate:          dueDate.UTC(),
+		EstimatedMinutes: estimatedMinutes,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		Location:         params.Location,
+	}
+	if params.Recurrence != nil {
+		recurrence := params.Recurrence.AnchoredTo(todo.DueDate)
+		todo.Recurrence = &recurrence
 	}
 
 	if err := todo.Validate(now); err != nil {
 		return domain.Todo{}, err
 	}
 
-	resp, err := tci.encoder.VectorizeTodo(ctx, tci.llmModel, todo)
-	if err != nil {
-		return domain.Todo{}, err
+	if len(customFields) > 0 {
+		values, err := applyCustomFields(ctx, scope, nil, customFields)
+		if err != nil {
+			return domain.Todo{}, err
+		}
+		todo.CustomFields = values
 	}
 
-	metrics.RecordLLMTokensEmbedding(ctx, resp.TotalTokens)
-	todo.Embedding = resp.Vector
+	if err := embedTodo(ctx, tci.encoder, tci.llmModel, tci.secondaryModel, &todo); err != nil {
+		return domain.Todo{}, err
+	}
 
-	err = scope.Todo().CreateTodo(ctx, todo)
+	err := scope.Todo().CreateTodo(ctx, todo)
 	if err != nil {
 		return domain.Todo{}, err
 	}
 
-	err = scope.Outbox().CreateTodoEvent(ctx, outbox.TodoEvent{
-		Type:      outbox.EventType_TODO_CREATED,
-		TodoID:    todo.ID,
-		CreatedAt: now,
-	})
+	err = recordTodoChange(ctx, scope, outbox.EventType_TODO_CREATED, todo.ID, now)
 	if err != nil {
 		return domain.Todo{}, err
 	}
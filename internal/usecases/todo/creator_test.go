@@ -23,14 +23,22 @@ func TestCreatorImpl_Create(t *testing.T) {
 	}
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	todo := domain.Todo{
-		ID:        fixedUUID(),
-		Title:     "My new todo",
-		Status:    domain.Status_OPEN,
-		Embedding: []float64{0.1, 0.2, 0.3},
-		CreatedAt: fixedTime,
-		UpdatedAt: fixedTime,
-		DueDate:   fixedTime,
+		ID:               fixedUUID(),
+		Title:            "My new todo",
+		Status:           domain.Status_OPEN,
+		Embedding:        []float64{0.1, 0.2, 0.3},
+		EmbeddingModel:   "model-name",
+		CreatedAt:        fixedTime,
+		UpdatedAt:        fixedTime,
+		DueDate:          fixedTime,
+		EstimatedMinutes: 90,
 	}
+	weekly := domain.Recurrence{Frequency: domain.RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Monday}}
+	recurringTodo := todo
+	recurringTodo.Recurrence = &weekly
+	monthlyTodo := todo
+	monthlyTodo.DueDate = time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	monthlyTodo.Recurrence = &domain.Recurrence{Frequency: domain.RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 31}
 
 	tests := map[string]struct {
 		setExpectations func(
@@ -38,14 +46,19 @@ func TestCreatorImpl_Create(t *testing.T) {
 			timeProvider *core.MockCurrentTimeProvider,
 			semanticEncoder *semantic.MockEncoder,
 		)
-		title        string
-		dueDate      time.Time
-		expectedTodo domain.Todo
-		expectedErr  error
+		secondaryModel   string
+		title            string
+		dueDate          time.Time
+		estimatedMinutes int
+		customFields     domain.CustomFieldValues
+		opts             []CreateOption
+		expectedTodo     domain.Todo
+		expectedErr      error
 	}{
 		"success": {
-			title:   "My new todo",
-			dueDate: fixedTime,
+			title:            "My new todo",
+			dueDate:          fixedTime,
+			estimatedMinutes: 90,
 			setExpectations: func(
 				scope *transaction.MockScope,
 				timeProvider *core.MockCurrentTimeProvider,
@@ -64,7 +77,10 @@ func TestCreatorImpl_Create(t *testing.T) {
 					}),
 				).Return(semantic.EmbeddingVector{Vector: []float64{0.1, 0.2, 0.3}}, nil)
 
+				changeRepo := domain.NewMockChangeRepository(t)
+
 				scope.EXPECT().Todo().Return(repo).Once()
+				scope.EXPECT().Change().Return(changeRepo).Once()
 				scope.EXPECT().Outbox().Return(outboxRepo).Once()
 
 				repo.EXPECT().CreateTodo(
@@ -74,18 +90,94 @@ func TestCreatorImpl_Create(t *testing.T) {
 					}),
 				).Return(nil)
 
+				changeRepo.EXPECT().RecordChange(
+					mock.Anything,
+					domain.Change{
+						TodoID:    fixedUUID(),
+						Type:      domain.ChangeType_Created,
+						CreatedAt: fixedTime,
+					},
+				).Return(domain.Change{
+					Sequence:  7,
+					TodoID:    fixedUUID(),
+					Type:      domain.ChangeType_Created,
+					CreatedAt: fixedTime,
+				}, nil)
+
 				outboxRepo.EXPECT().CreateTodoEvent(
 					mock.Anything,
 					outbox.TodoEvent{
 						Type:      outbox.EventType_TODO_CREATED,
 						TodoID:    fixedUUID(),
 						CreatedAt: fixedTime,
+						Sequence:  7,
 					},
 				).Return(nil)
 			},
 			expectedTodo: todo,
 			expectedErr:  nil,
 		},
+		"secondary-model": {
+			secondaryModel:   "model-name-v2",
+			title:            "My new todo",
+			dueDate:          fixedTime,
+			estimatedMinutes: 90,
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				repo := domain.NewMockRepository(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				changeRepo := domain.NewMockChangeRepository(t)
+
+				semanticEncoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: []float64{0.1, 0.2, 0.3}}, nil)
+				semanticEncoder.EXPECT().VectorizeTodo(mock.Anything, "model-name-v2", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: []float64{0.4, 0.5}}, nil)
+
+				scope.EXPECT().Todo().Return(repo).Once()
+				scope.EXPECT().Change().Return(changeRepo).Once()
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+
+				repo.EXPECT().CreateTodo(
+					mock.Anything,
+					mock.MatchedBy(func(t domain.Todo) bool {
+						return t.EmbeddingModel == "model-name" && t.SecondaryEmbeddingModel == "model-name-v2"
+					}),
+				).Return(nil)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{Sequence: 7}, nil)
+				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedTodo: func() domain.Todo {
+				td := todo
+				td.SecondaryEmbedding = []float64{0.4, 0.5}
+				td.SecondaryEmbeddingModel = "model-name-v2"
+				return td
+			}(),
+		},
+		"validation-error-invalid-custom-field": {
+			title:        "My new todo",
+			dueDate:      fixedTime,
+			customFields: domain.CustomFieldValues{"priority": "urgent"},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				customFieldRepo := domain.NewMockCustomFieldRepository(t)
+				scope.EXPECT().CustomField().Return(customFieldRepo).Once()
+				customFieldRepo.EXPECT().ListCustomFields(mock.Anything).Return([]domain.CustomField{
+					{Name: "priority", Type: domain.CustomFieldType_ENUM, Options: []string{"low", "high"}},
+				}, nil).Once()
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  core.NewValidationErr("custom field priority must be one of low, high"),
+		},
 		"validation-error-short-title": {
 			title:   "Hi",
 			dueDate: fixedTime,
@@ -99,6 +191,104 @@ func TestCreatorImpl_Create(t *testing.T) {
 			expectedTodo: domain.Todo{},
 			expectedErr:  core.NewValidationErr("title must be between 3 and 200 characters"),
 		},
+		"validation-error-estimate": {
+			title:            "My new todo",
+			dueDate:          fixedTime,
+			estimatedMinutes: 2000,
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  core.NewValidationErr("estimated_minutes must be between 0 and 1440"),
+		},
+		"with-recurrence": {
+			title:            "My new todo",
+			dueDate:          fixedTime,
+			estimatedMinutes: 90,
+			opts:             []CreateOption{WithCreateRecurrence(weekly)},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+				semanticEncoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: []float64{0.1, 0.2, 0.3}}, nil)
+
+				repo := domain.NewMockRepository(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				changeRepo := domain.NewMockChangeRepository(t)
+
+				scope.EXPECT().Todo().Return(repo).Once()
+				scope.EXPECT().Change().Return(changeRepo).Once()
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+
+				repo.EXPECT().CreateTodo(mock.Anything, recurringTodo).Return(nil)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{Sequence: 7}, nil)
+				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedTodo: recurringTodo,
+		},
+		"with-monthly-recurrence": {
+			title:            "My new todo",
+			dueDate:          monthlyTodo.DueDate,
+			estimatedMinutes: 90,
+			opts:             []CreateOption{WithCreateRecurrence(domain.Recurrence{Frequency: domain.RecurrenceFrequency_MONTHLY, Interval: 1})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+				semanticEncoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: []float64{0.1, 0.2, 0.3}}, nil)
+
+				repo := domain.NewMockRepository(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				changeRepo := domain.NewMockChangeRepository(t)
+
+				scope.EXPECT().Todo().Return(repo).Once()
+				scope.EXPECT().Change().Return(changeRepo).Once()
+				scope.EXPECT().Outbox().Return(outboxRepo).Once()
+
+				repo.EXPECT().CreateTodo(mock.Anything, monthlyTodo).Return(nil)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{Sequence: 7}, nil)
+				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedTodo: monthlyTodo,
+		},
+		"validation-error-recurrence": {
+			title:   "My new todo",
+			dueDate: fixedTime,
+			opts:    []CreateOption{WithCreateRecurrence(domain.Recurrence{Frequency: domain.RecurrenceFrequency_DAILY})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  core.NewValidationErr("recurrence interval must be between 1 and 365"),
+		},
+		"validation-error-location": {
+			title:   "My new todo",
+			dueDate: fixedTime,
+			opts:    []CreateOption{WithCreateLocation(domain.Location{Point: &domain.GeoPoint{Longitude: 200}})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  core.NewValidationErr("longitude must be between -180 and 180"),
+		},
 		"embedding-error": {
 			title:   "My new todo",
 			dueDate: fixedTime,
@@ -152,6 +342,31 @@ func TestCreatorImpl_Create(t *testing.T) {
 			expectedTodo: domain.Todo{},
 			expectedErr:  errors.New("database error"),
 		},
+		"change-log-error": {
+			title:   "My new todo",
+			dueDate: fixedTime,
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				repo := domain.NewMockRepository(t)
+				changeRepo := domain.NewMockChangeRepository(t)
+				semanticEncoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", mock.Anything).
+					Return(semantic.EmbeddingVector{Vector: []float64{0.1, 0.2, 0.3}}, nil)
+
+				scope.EXPECT().Todo().Return(repo)
+				scope.EXPECT().Change().Return(changeRepo)
+
+				repo.EXPECT().CreateTodo(mock.Anything, mock.Anything).Return(nil)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).
+					Return(domain.Change{}, errors.New("change log error"))
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  errors.New("change log error"),
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -162,10 +377,10 @@ func TestCreatorImpl_Create(t *testing.T) {
 				tt.setExpectations(scope, timeProvider, semanticEncoder)
 			}
 
-			cti := NewCreatorImpl(timeProvider, semanticEncoder, "model-name")
+			cti := NewCreatorImpl(timeProvider, semanticEncoder, "model-name", tt.secondaryModel)
 			cti.createUUID = fixedUUID
 
-			got, gotErr := cti.Create(t.Context(), scope, tt.title, tt.dueDate)
+			got, gotErr := cti.Create(t.Context(), scope, tt.title, tt.dueDate, tt.estimatedMinutes, tt.customFields, tt.opts...)
 			assert.Equal(t, tt.expectedErr, gotErr)
 			assert.Equal(t, tt.expectedTodo, got)
 		})
@@ -0,0 +1,130 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// CustomFields defines the interface for managing the custom fields a tenant defines on its todos.
+type CustomFields interface {
+	// List returns the custom fields of the tenant ordered by name.
+	List(ctx context.Context) ([]domain.CustomField, error)
+	// Define creates a custom field or updates the options of an existing one. The type of an existing
+	// field cannot be changed.
+	Define(ctx context.Context, field domain.CustomField) (domain.CustomField, error)
+	// Delete removes a custom field and its values from every todo.
+	Delete(ctx context.Context, name string) error
+}
+
+// CustomFieldsImpl is the implementation of the CustomFields use case.
+type CustomFieldsImpl struct {
+	customFieldRepo domain.CustomFieldRepository
+	uow             transaction.UnitOfWork
+	timeProvider    core.CurrentTimeProvider
+}
+
+// NewCustomFieldsImpl creates a new instance of CustomFieldsImpl.
+func NewCustomFieldsImpl(
+	customFieldRepo domain.CustomFieldRepository,
+	uow transaction.UnitOfWork,
+	timeProvider core.CurrentTimeProvider,
+) CustomFieldsImpl {
+	return CustomFieldsImpl{
+		customFieldRepo: customFieldRepo,
+		uow:             uow,
+		timeProvider:    timeProvider,
+	}
+}
+
+// List returns the custom fields of the tenant ordered by name.
+func (c CustomFieldsImpl) List(ctx context.Context) ([]domain.CustomField, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	fields, err := c.customFieldRepo.ListCustomFields(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// Define validates and stores a custom field definition. Redefining a field keeps its creation time.
+func (c CustomFieldsImpl) Define(ctx context.Context, field domain.CustomField) (domain.CustomField, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if err := field.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return domain.CustomField{}, err
+	}
+
+	err := c.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		fields, err := scope.CustomField().ListCustomFields(uowCtx)
+		if err != nil {
+			return err
+		}
+
+		existing, found := domain.FindCustomField(fields, field.Name)
+		switch {
+		case found && existing.Type != field.Type:
+			return core.NewValidationErr(fmt.Sprintf("custom field %s is %s and its type cannot be changed", field.Name, existing.Type))
+		case found:
+			field.CreatedAt = existing.CreatedAt
+		case len(fields) >= domain.MaxCustomFields:
+			return core.NewValidationErr(fmt.Sprintf("cannot define more than %d custom fields", domain.MaxCustomFields))
+		default:
+			field.CreatedAt = c.timeProvider.Now()
+		}
+
+		return scope.CustomField().UpsertCustomField(uowCtx, field)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.CustomField{}, err
+	}
+
+	return field, nil
+}
+
+// Delete removes a custom field and its values from every todo.
+func (c CustomFieldsImpl) Delete(ctx context.Context, name string) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	err := c.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		fields, err := scope.CustomField().ListCustomFields(uowCtx)
+		if err != nil {
+			return err
+		}
+		if _, found := domain.FindCustomField(fields, name); !found {
+			return core.NewNotFoundErr(fmt.Sprintf("custom field %s not found", name))
+		}
+		return scope.CustomField().DeleteCustomField(uowCtx, name)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// applyCustomFields validates the custom field changes against the fields defined by the tenant and merges
+// them into the current values.
+func applyCustomFields(ctx context.Context, scope transaction.Scope, current, changes domain.CustomFieldValues) (domain.CustomFieldValues, error) {
+	fields, err := scope.CustomField().ListCustomFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values, err := current.Apply(changes, fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
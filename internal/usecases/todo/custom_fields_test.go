@@ -0,0 +1,197 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCustomFieldsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	fields := []domain.CustomField{{Name: "points", Type: domain.CustomFieldType_NUMBER}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockCustomFieldRepository)
+		expected        []domain.CustomField
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *domain.MockCustomFieldRepository) {
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(fields, nil).Once()
+			},
+			expected: fields,
+		},
+		"repository-error": {
+			setExpectations: func(repo *domain.MockCustomFieldRepository) {
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockCustomFieldRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewCustomFieldsImpl(repo, transaction.NewMockUnitOfWork(t), core.NewMockCurrentTimeProvider(t))
+			got, err := uc.List(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCustomFieldsImpl_Define(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	area := domain.CustomField{Name: "area", Type: domain.CustomFieldType_ENUM, Options: []string{"home", "work"}}
+
+	tests := map[string]struct {
+		field           domain.CustomField
+		setExpectations func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider)
+		expected        domain.CustomField
+		expectedErr     error
+	}{
+		"creates-new-field": {
+			field: area,
+			setExpectations: func(uow *transaction.MockUnitOfWork, timeProvider *core.MockCurrentTimeProvider) {
+				repo := expectScope(t, uow).CustomField
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+				timeProvider.EXPECT().Now().Return(now).Once()
+				repo.EXPECT().UpsertCustomField(mock.Anything, domain.CustomField{
+					Name:      "area",
+					Type:      domain.CustomFieldType_ENUM,
+					Options:   []string{"home", "work"},
+					CreatedAt: now,
+				}).Return(nil).Once()
+			},
+			expected: domain.CustomField{
+				Name:      "area",
+				Type:      domain.CustomFieldType_ENUM,
+				Options:   []string{"home", "work"},
+				CreatedAt: now,
+			},
+		},
+		"redefines-options-keeping-creation-time": {
+			field: domain.CustomField{Name: "area", Type: domain.CustomFieldType_ENUM, Options: []string{"home", "work", "errands"}},
+			setExpectations: func(uow *transaction.MockUnitOfWork, _ *core.MockCurrentTimeProvider) {
+				repo := expectScope(t, uow).CustomField
+				existing := area
+				existing.CreatedAt = createdAt
+				repo.EXPECT().ListCustomFields(mock.Anything).Return([]domain.CustomField{existing}, nil).Once()
+				repo.EXPECT().UpsertCustomField(mock.Anything, domain.CustomField{
+					Name:      "area",
+					Type:      domain.CustomFieldType_ENUM,
+					Options:   []string{"home", "work", "errands"},
+					CreatedAt: createdAt,
+				}).Return(nil).Once()
+			},
+			expected: domain.CustomField{
+				Name:      "area",
+				Type:      domain.CustomFieldType_ENUM,
+				Options:   []string{"home", "work", "errands"},
+				CreatedAt: createdAt,
+			},
+		},
+		"rejects-type-change": {
+			field: domain.CustomField{Name: "area", Type: domain.CustomFieldType_TEXT},
+			setExpectations: func(uow *transaction.MockUnitOfWork, _ *core.MockCurrentTimeProvider) {
+				repo := expectScope(t, uow).CustomField
+				repo.EXPECT().ListCustomFields(mock.Anything).Return([]domain.CustomField{area}, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("custom field area is ENUM and its type cannot be changed"),
+		},
+		"rejects-too-many-fields": {
+			field: domain.CustomField{Name: "extra", Type: domain.CustomFieldType_TEXT},
+			setExpectations: func(uow *transaction.MockUnitOfWork, _ *core.MockCurrentTimeProvider) {
+				repo := expectScope(t, uow).CustomField
+				fields := make([]domain.CustomField, domain.MaxCustomFields)
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(fields, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("cannot define more than 20 custom fields"),
+		},
+		"rejects-invalid-definition": {
+			field:       domain.CustomField{Name: "Area", Type: domain.CustomFieldType_TEXT},
+			expectedErr: core.NewValidationErr("custom field name must start with a lowercase letter and contain only lowercase letters, digits and underscores"),
+		},
+		"repository-error": {
+			field: area,
+			setExpectations: func(uow *transaction.MockUnitOfWork, _ *core.MockCurrentTimeProvider) {
+				repo := expectScope(t, uow).CustomField
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			if tt.setExpectations != nil {
+				tt.setExpectations(uow, timeProvider)
+			}
+
+			uc := NewCustomFieldsImpl(domain.NewMockCustomFieldRepository(t), uow, timeProvider)
+			got, err := uc.Define(t.Context(), tt.field)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCustomFieldsImpl_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name            string
+		setExpectations func(uow *transaction.MockUnitOfWork)
+		expectedErr     error
+	}{
+		"success": {
+			name: "area",
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repo := expectScope(t, uow).CustomField
+				repo.EXPECT().ListCustomFields(mock.Anything).
+					Return([]domain.CustomField{{Name: "area", Type: domain.CustomFieldType_TEXT}}, nil).
+					Once()
+				repo.EXPECT().DeleteCustomField(mock.Anything, "area").Return(nil).Once()
+			},
+		},
+		"not-found": {
+			name: "area",
+			setExpectations: func(uow *transaction.MockUnitOfWork) {
+				repo := expectScope(t, uow).CustomField
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("custom field area not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tt.setExpectations(uow)
+
+			uc := NewCustomFieldsImpl(domain.NewMockCustomFieldRepository(t), uow, core.NewMockCurrentTimeProvider(t))
+			err := uc.Delete(t.Context(), tt.name)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -41,9 +41,5 @@ func (dt DeleterImpl) Delete(ctx context.Context, scope transaction.Scope, id uu
 		return err
 	}
 
-	return scope.Outbox().CreateTodoEvent(ctx, outbox.TodoEvent{
-		Type:      outbox.EventType_TODO_DELETED,
-		TodoID:    id,
-		CreatedAt: dt.timeProvider.Now(),
-	})
+	return recordTodoChange(ctx, scope, outbox.EventType_TODO_DELETED, id, dt.timeProvider.Now())
 }
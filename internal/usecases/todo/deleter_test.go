@@ -34,11 +34,20 @@ func TestDeleterImpl_Delete(t *testing.T) {
 				scope.EXPECT().Todo().Return(todoRepo)
 				todoRepo.EXPECT().DeleteTodo(mock.Anything, todoID).Return(nil)
 
+				changeRepo := domain.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changeRepo)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.MatchedBy(func(change domain.Change) bool {
+					return change.Type == domain.ChangeType_Deleted &&
+						change.TodoID == todoID &&
+						change.ConversationID == nil
+				})).Return(domain.Change{Sequence: 12, TodoID: todoID, Type: domain.ChangeType_Deleted}, nil)
+
 				scope.EXPECT().Outbox().Return(outboxRepo)
 				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.MatchedBy(func(event outbox.TodoEvent) bool {
 					return event.Type == outbox.EventType_TODO_DELETED &&
 						event.TodoID == todoID &&
-						event.CreatedAt.Equal(fixedTime)
+						event.CreatedAt.Equal(fixedTime) &&
+						event.Sequence == 12
 				})).Return(nil)
 			},
 			expectErr: false,
@@ -71,6 +80,22 @@ func TestDeleterImpl_Delete(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"error-record-change-fails": {
+			setupDomain: func(scope *transaction.MockScope, todoRepo *domain.MockRepository, outboxRepo *outbox.MockRepository) {
+				scope.EXPECT().Todo().Return(todoRepo)
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).
+					Return(domain.Todo{ID: todoID}, true, nil)
+
+				scope.EXPECT().Todo().Return(todoRepo)
+				todoRepo.EXPECT().DeleteTodo(mock.Anything, todoID).Return(nil)
+
+				changeRepo := domain.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changeRepo)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).
+					Return(domain.Change{}, assert.AnError)
+			},
+			expectErr: true,
+		},
 		"error-record-event-fails": {
 			setupDomain: func(scope *transaction.MockScope, todoRepo *domain.MockRepository, outboxRepo *outbox.MockRepository) {
 				scope.EXPECT().Todo().Return(todoRepo)
@@ -80,6 +105,11 @@ func TestDeleterImpl_Delete(t *testing.T) {
 				scope.EXPECT().Todo().Return(todoRepo)
 				todoRepo.EXPECT().DeleteTodo(mock.Anything, todoID).Return(nil)
 
+				changeRepo := domain.NewMockChangeRepository(t)
+				scope.EXPECT().Change().Return(changeRepo)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).
+					Return(domain.Change{Sequence: 13}, nil)
+
 				scope.EXPECT().Outbox().Return(outboxRepo)
 				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.Anything).
 					Return(assert.AnError)
@@ -0,0 +1,191 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BackfillEmbeddings defines the interface for re-embedding the todos whose embedding is missing or was
+// produced by another embedding model.
+type BackfillEmbeddings interface {
+	// Step embeds the next batch of stale todos of the current run for the slot and returns its checkpoint.
+	// A new run is started when there is none for the model configured for the slot or the last one completed.
+	Step(ctx context.Context, slot domain.EmbeddingSlot, batchSize int) (domain.EmbeddingBackfill, error)
+	// List returns the latest run of every embedding model.
+	List(ctx context.Context) ([]domain.EmbeddingBackfill, error)
+}
+
+// BackfillEmbeddingsImpl is the implementation of the BackfillEmbeddings use case.
+type BackfillEmbeddingsImpl struct {
+	todoRepo       domain.Repository
+	backfillRepo   domain.EmbeddingBackfillRepository
+	encoder        semantic.Encoder
+	timeProvider   core.CurrentTimeProvider
+	model          string
+	secondaryModel string
+}
+
+// NewBackfillEmbeddingsImpl creates a new instance of BackfillEmbeddingsImpl.
+func NewBackfillEmbeddingsImpl(
+	todoRepo domain.Repository,
+	backfillRepo domain.EmbeddingBackfillRepository,
+	encoder semantic.Encoder,
+	timeProvider core.CurrentTimeProvider,
+	model string,
+	secondaryModel string,
+) BackfillEmbeddingsImpl {
+	return BackfillEmbeddingsImpl{
+		todoRepo:       todoRepo,
+		backfillRepo:   backfillRepo,
+		encoder:        encoder,
+		timeProvider:   timeProvider,
+		model:          model,
+		secondaryModel: secondaryModel,
+	}
+}
+
+// Step embeds up to batchSize stale todos of the slot after the checkpoint cursor. The checkpoint is saved even
+// when the encoder fails midway, so the todos embedded before the failure are not processed again on resume.
+func (b BackfillEmbeddingsImpl) Step(ctx context.Context, slot domain.EmbeddingSlot, batchSize int) (domain.EmbeddingBackfill, error) {
+	spanCtx, span := telemetry.StartSpan(ctx, trace.WithAttributes(
+		attribute.String("slot", string(slot)),
+		attribute.Int("batch_size", batchSize),
+	))
+	defer span.End()
+
+	model, err := b.modelOf(slot)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.EmbeddingBackfill{}, err
+	}
+	if batchSize <= 0 {
+		err := core.NewValidationErr("batch_size must be greater than 0")
+		telemetry.IsErrorRecorded(span, err)
+		return domain.EmbeddingBackfill{}, err
+	}
+
+	backfill, err := b.currentRun(spanCtx, slot, model)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.EmbeddingBackfill{}, err
+	}
+
+	todos, err := b.todoRepo.ListStaleEmbeddingTodos(spanCtx, slot, model, backfill.Cursor, batchSize)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.EmbeddingBackfill{}, err
+	}
+
+	var embedErr error
+	for _, td := range todos {
+		resp, err := b.encoder.VectorizeTodo(spanCtx, model, td)
+		if err != nil {
+			embedErr = fmt.Errorf("failed to embed todo %s: %w", td.ID, err)
+			break
+		}
+		metrics.RecordLLMTokensEmbedding(spanCtx, resp.TotalTokens)
+
+		if err := b.todoRepo.UpdateTodoEmbedding(spanCtx, td.ID, slot, resp.Vector, model); err != nil {
+			embedErr = err
+			break
+		}
+		backfill.Cursor = td.ID
+		backfill.Processed++
+	}
+
+	now := b.timeProvider.Now()
+	backfill.UpdatedAt = now
+	if embedErr == nil && len(todos) < batchSize {
+		backfill.CompletedAt = &now
+	}
+
+	if err := b.backfillRepo.SaveEmbeddingBackfill(spanCtx, backfill); telemetry.IsErrorRecorded(span, err) {
+		return domain.EmbeddingBackfill{}, err
+	}
+	if telemetry.IsErrorRecorded(span, embedErr) {
+		return backfill, embedErr
+	}
+
+	return backfill, nil
+}
+
+// modelOf returns the embedding model configured for the slot.
+func (b BackfillEmbeddingsImpl) modelOf(slot domain.EmbeddingSlot) (string, error) {
+	if err := slot.Validate(); err != nil {
+		return "", err
+	}
+	if slot == domain.EmbeddingSlot_Primary {
+		return b.model, nil
+	}
+	if b.secondaryModel == "" {
+		return "", core.NewValidationErr("no secondary embedding model is configured")
+	}
+	return b.secondaryModel, nil
+}
+
+// currentRun returns the unfinished run of the model in the slot, or a new run counting the stale todos.
+func (b BackfillEmbeddingsImpl) currentRun(ctx context.Context, slot domain.EmbeddingSlot, model string) (domain.EmbeddingBackfill, error) {
+	backfill, found, err := b.backfillRepo.GetEmbeddingBackfill(ctx, model)
+	if err != nil {
+		return domain.EmbeddingBackfill{}, err
+	}
+	if found && !backfill.Completed() && backfill.Slot == slot {
+		return backfill, nil
+	}
+
+	total, err := b.todoRepo.CountStaleEmbeddingTodos(ctx, slot, model)
+	if err != nil {
+		return domain.EmbeddingBackfill{}, err
+	}
+
+	now := b.timeProvider.Now()
+	return domain.EmbeddingBackfill{
+		Model:     model,
+		Slot:      slot,
+		Cursor:    uuid.Nil,
+		Total:     total,
+		StartedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// embedTodo embeds the todo with the primary model and, during a model migration, with the secondary model too.
+func embedTodo(ctx context.Context, encoder semantic.Encoder, model, secondaryModel string, td *domain.Todo) error {
+	resp, err := encoder.VectorizeTodo(ctx, model, *td)
+	if err != nil {
+		return err
+	}
+	metrics.RecordLLMTokensEmbedding(ctx, resp.TotalTokens)
+	td.Embedding = resp.Vector
+	td.EmbeddingModel = model
+
+	if secondaryModel == "" {
+		return nil
+	}
+	resp, err = encoder.VectorizeTodo(ctx, secondaryModel, *td)
+	if err != nil {
+		return err
+	}
+	metrics.RecordLLMTokensEmbedding(ctx, resp.TotalTokens)
+	td.SecondaryEmbedding = resp.Vector
+	td.SecondaryEmbeddingModel = secondaryModel
+	return nil
+}
+
+// List returns the latest run of every embedding model.
+func (b BackfillEmbeddingsImpl) List(ctx context.Context) ([]domain.EmbeddingBackfill, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	backfills, err := b.backfillRepo.ListEmbeddingBackfills(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	return backfills, nil
+}
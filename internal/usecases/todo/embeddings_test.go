@@ -0,0 +1,344 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBackfillEmbeddingsImpl_Step(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	now := startedAt.Add(time.Minute)
+	id1 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174001")
+	id2 := uuid.MustParse("123e4567-e89b-12d3-a456-426614174002")
+	todo1 := domain.Todo{ID: id1, Title: "Imported todo 1"}
+	todo2 := domain.Todo{ID: id2, Title: "Imported todo 2"}
+	inProgress := domain.EmbeddingBackfill{
+		Model:     "model-name",
+		Slot:      domain.EmbeddingSlot_Primary,
+		Cursor:    id1,
+		Total:     3,
+		Processed: 1,
+		StartedAt: startedAt,
+		UpdatedAt: startedAt,
+	}
+	completedAt := startedAt.Add(-time.Hour)
+	completed := domain.EmbeddingBackfill{
+		Model:       "model-name",
+		Slot:        domain.EmbeddingSlot_Primary,
+		Cursor:      id2,
+		Total:       2,
+		Processed:   2,
+		StartedAt:   startedAt.Add(-2 * time.Hour),
+		UpdatedAt:   completedAt,
+		CompletedAt: &completedAt,
+	}
+
+	tests := map[string]struct {
+		slot            domain.EmbeddingSlot
+		secondaryModel  string
+		batchSize       int
+		setExpectations func(
+			todoRepo *domain.MockRepository,
+			backfillRepo *domain.MockEmbeddingBackfillRepository,
+			encoder *semantic.MockEncoder,
+			timeProvider *core.MockCurrentTimeProvider,
+		)
+		expected    domain.EmbeddingBackfill
+		expectedErr string
+	}{
+		"starts-a-new-run": {
+			batchSize: 2,
+			setExpectations: func(
+				todoRepo *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				encoder *semantic.MockEncoder,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				timeProvider.EXPECT().Now().Return(startedAt).Once()
+				timeProvider.EXPECT().Now().Return(now).Once()
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-name").Return(domain.EmbeddingBackfill{}, false, nil)
+				todoRepo.EXPECT().CountStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Primary, "model-name").Return(3, nil)
+				todoRepo.EXPECT().ListStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Primary, "model-name", uuid.Nil, 2).Return([]domain.Todo{todo1, todo2}, nil)
+				encoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", todo1).Return(semantic.EmbeddingVector{Vector: []float64{0.1}}, nil)
+				encoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", todo2).Return(semantic.EmbeddingVector{Vector: []float64{0.2}}, nil)
+				todoRepo.EXPECT().UpdateTodoEmbedding(mock.Anything, id1, domain.EmbeddingSlot_Primary, []float64{0.1}, "model-name").Return(nil)
+				todoRepo.EXPECT().UpdateTodoEmbedding(mock.Anything, id2, domain.EmbeddingSlot_Primary, []float64{0.2}, "model-name").Return(nil)
+				backfillRepo.EXPECT().SaveEmbeddingBackfill(mock.Anything, domain.EmbeddingBackfill{
+					Model:     "model-name",
+					Slot:      domain.EmbeddingSlot_Primary,
+					Cursor:    id2,
+					Total:     3,
+					Processed: 2,
+					StartedAt: startedAt,
+					UpdatedAt: now,
+				}).Return(nil)
+			},
+			expected: domain.EmbeddingBackfill{
+				Model:     "model-name",
+				Slot:      domain.EmbeddingSlot_Primary,
+				Cursor:    id2,
+				Total:     3,
+				Processed: 2,
+				StartedAt: startedAt,
+				UpdatedAt: now,
+			},
+		},
+		"resumes-and-completes-the-run": {
+			batchSize: 2,
+			setExpectations: func(
+				todoRepo *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				encoder *semantic.MockEncoder,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				timeProvider.EXPECT().Now().Return(now)
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-name").Return(inProgress, true, nil)
+				todoRepo.EXPECT().ListStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Primary, "model-name", id1, 2).Return([]domain.Todo{todo2}, nil)
+				encoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", todo2).Return(semantic.EmbeddingVector{Vector: []float64{0.2}}, nil)
+				todoRepo.EXPECT().UpdateTodoEmbedding(mock.Anything, id2, domain.EmbeddingSlot_Primary, []float64{0.2}, "model-name").Return(nil)
+				backfillRepo.EXPECT().SaveEmbeddingBackfill(mock.Anything, mock.MatchedBy(func(b domain.EmbeddingBackfill) bool {
+					return b.Cursor == id2 && b.Processed == 2 && b.Completed()
+				})).Return(nil)
+			},
+			expected: domain.EmbeddingBackfill{
+				Model:       "model-name",
+				Slot:        domain.EmbeddingSlot_Primary,
+				Cursor:      id2,
+				Total:       3,
+				Processed:   2,
+				StartedAt:   startedAt,
+				UpdatedAt:   now,
+				CompletedAt: &now,
+			},
+		},
+		"restarts-after-a-completed-run": {
+			batchSize: 2,
+			setExpectations: func(
+				todoRepo *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				encoder *semantic.MockEncoder,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				timeProvider.EXPECT().Now().Return(now)
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-name").Return(completed, true, nil)
+				todoRepo.EXPECT().CountStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Primary, "model-name").Return(0, nil)
+				todoRepo.EXPECT().ListStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Primary, "model-name", uuid.Nil, 2).Return(nil, nil)
+				backfillRepo.EXPECT().SaveEmbeddingBackfill(mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: domain.EmbeddingBackfill{
+				Model:       "model-name",
+				Slot:        domain.EmbeddingSlot_Primary,
+				StartedAt:   now,
+				UpdatedAt:   now,
+				CompletedAt: &now,
+			},
+		},
+		"encoder-error-saves-progress": {
+			batchSize: 2,
+			setExpectations: func(
+				todoRepo *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				encoder *semantic.MockEncoder,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				timeProvider.EXPECT().Now().Return(now)
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-name").Return(inProgress, true, nil)
+				todoRepo.EXPECT().ListStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Primary, "model-name", id1, 2).Return([]domain.Todo{todo2}, nil)
+				encoder.EXPECT().VectorizeTodo(mock.Anything, "model-name", todo2).Return(semantic.EmbeddingVector{}, errors.New("rate limited"))
+				backfillRepo.EXPECT().SaveEmbeddingBackfill(mock.Anything, mock.MatchedBy(func(b domain.EmbeddingBackfill) bool {
+					return b.Cursor == id1 && b.Processed == 1 && !b.Completed()
+				})).Return(nil)
+			},
+			expected: domain.EmbeddingBackfill{
+				Model:     "model-name",
+				Slot:      domain.EmbeddingSlot_Primary,
+				Cursor:    id1,
+				Total:     3,
+				Processed: 1,
+				StartedAt: startedAt,
+				UpdatedAt: now,
+			},
+			expectedErr: "failed to embed todo 123e4567-e89b-12d3-a456-426614174002: rate limited",
+		},
+		"starts-a-secondary-run": {
+			slot:           domain.EmbeddingSlot_Secondary,
+			secondaryModel: "model-v2",
+			batchSize:      2,
+			setExpectations: func(
+				todoRepo *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				encoder *semantic.MockEncoder,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				timeProvider.EXPECT().Now().Return(now)
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-v2").Return(domain.EmbeddingBackfill{}, false, nil)
+				todoRepo.EXPECT().CountStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Secondary, "model-v2").Return(1, nil)
+				todoRepo.EXPECT().ListStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Secondary, "model-v2", uuid.Nil, 2).Return([]domain.Todo{todo1}, nil)
+				encoder.EXPECT().VectorizeTodo(mock.Anything, "model-v2", todo1).Return(semantic.EmbeddingVector{Vector: []float64{0.1}}, nil)
+				todoRepo.EXPECT().UpdateTodoEmbedding(mock.Anything, id1, domain.EmbeddingSlot_Secondary, []float64{0.1}, "model-v2").Return(nil)
+				backfillRepo.EXPECT().SaveEmbeddingBackfill(mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: domain.EmbeddingBackfill{
+				Model:       "model-v2",
+				Slot:        domain.EmbeddingSlot_Secondary,
+				Cursor:      id1,
+				Total:       1,
+				Processed:   1,
+				StartedAt:   now,
+				UpdatedAt:   now,
+				CompletedAt: &now,
+			},
+		},
+		"restarts-a-run-of-another-slot": {
+			slot:           domain.EmbeddingSlot_Secondary,
+			secondaryModel: "model-name-v2",
+			batchSize:      2,
+			setExpectations: func(
+				todoRepo *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				_ *semantic.MockEncoder,
+				timeProvider *core.MockCurrentTimeProvider,
+			) {
+				unfinished := inProgress
+				unfinished.Model = "model-name-v2"
+				timeProvider.EXPECT().Now().Return(now)
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-name-v2").Return(unfinished, true, nil)
+				todoRepo.EXPECT().CountStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Secondary, "model-name-v2").Return(0, nil)
+				todoRepo.EXPECT().ListStaleEmbeddingTodos(mock.Anything, domain.EmbeddingSlot_Secondary, "model-name-v2", uuid.Nil, 2).Return(nil, nil)
+				backfillRepo.EXPECT().SaveEmbeddingBackfill(mock.Anything, mock.Anything).Return(nil)
+			},
+			expected: domain.EmbeddingBackfill{
+				Model:       "model-name-v2",
+				Slot:        domain.EmbeddingSlot_Secondary,
+				StartedAt:   now,
+				UpdatedAt:   now,
+				CompletedAt: &now,
+			},
+		},
+		"no-secondary-model": {
+			slot:      domain.EmbeddingSlot_Secondary,
+			batchSize: 2,
+			setExpectations: func(
+				*domain.MockRepository,
+				*domain.MockEmbeddingBackfillRepository,
+				*semantic.MockEncoder,
+				*core.MockCurrentTimeProvider,
+			) {
+			},
+			expectedErr: "no secondary embedding model is configured",
+		},
+		"invalid-slot": {
+			slot:      domain.EmbeddingSlot("tertiary"),
+			batchSize: 2,
+			setExpectations: func(
+				*domain.MockRepository,
+				*domain.MockEmbeddingBackfillRepository,
+				*semantic.MockEncoder,
+				*core.MockCurrentTimeProvider,
+			) {
+			},
+			expectedErr: "invalid embedding slot: tertiary",
+		},
+		"invalid-batch-size": {
+			batchSize: 0,
+			setExpectations: func(
+				*domain.MockRepository,
+				*domain.MockEmbeddingBackfillRepository,
+				*semantic.MockEncoder,
+				*core.MockCurrentTimeProvider,
+			) {
+			},
+			expectedErr: "batch_size must be greater than 0",
+		},
+		"get-backfill-error": {
+			batchSize: 2,
+			setExpectations: func(
+				_ *domain.MockRepository,
+				backfillRepo *domain.MockEmbeddingBackfillRepository,
+				_ *semantic.MockEncoder,
+				_ *core.MockCurrentTimeProvider,
+			) {
+				backfillRepo.EXPECT().GetEmbeddingBackfill(mock.Anything, "model-name").Return(domain.EmbeddingBackfill{}, false, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			todoRepo := domain.NewMockRepository(t)
+			backfillRepo := domain.NewMockEmbeddingBackfillRepository(t)
+			encoder := semantic.NewMockEncoder(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(todoRepo, backfillRepo, encoder, timeProvider)
+
+			slot := tt.slot
+			if slot == "" {
+				slot = domain.EmbeddingSlot_Primary
+			}
+			uc := NewBackfillEmbeddingsImpl(todoRepo, backfillRepo, encoder, timeProvider, "model-name", tt.secondaryModel)
+			got, err := uc.Step(t.Context(), slot, tt.batchSize)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestBackfillEmbeddingsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	backfills := []domain.EmbeddingBackfill{{Model: "model-name", Total: 3, Processed: 1}}
+
+	tests := map[string]struct {
+		setExpectations func(backfillRepo *domain.MockEmbeddingBackfillRepository)
+		expected        []domain.EmbeddingBackfill
+		expectErr       bool
+	}{
+		"success": {
+			setExpectations: func(backfillRepo *domain.MockEmbeddingBackfillRepository) {
+				backfillRepo.EXPECT().ListEmbeddingBackfills(mock.Anything).Return(backfills, nil)
+			},
+			expected: backfills,
+		},
+		"repository-error": {
+			setExpectations: func(backfillRepo *domain.MockEmbeddingBackfillRepository) {
+				backfillRepo.EXPECT().ListEmbeddingBackfills(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			backfillRepo := domain.NewMockEmbeddingBackfillRepository(t)
+			tt.setExpectations(backfillRepo)
+
+			uc := NewBackfillEmbeddingsImpl(nil, backfillRepo, nil, nil, "model-name", "")
+			got, err := uc.List(t.Context())
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
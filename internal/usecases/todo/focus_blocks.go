@@ -0,0 +1,177 @@
+package todo
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// maxFocusBlockTodos caps how many open todos are considered when suggesting focus blocks.
+const maxFocusBlockTodos = 100
+
+// FocusBlocks defines the interface for suggesting focus blocks and accepting them into the calendar.
+type FocusBlocks interface {
+	// Suggest returns focus blocks for the open todos with an effort estimate and no accepted block yet.
+	Suggest(ctx context.Context) ([]domain.FocusBlock, error)
+	// Accept stores the blocks as firm calendar entries and returns them with their todo titles.
+	Accept(ctx context.Context, blocks []domain.FocusBlock) ([]domain.FocusBlock, error)
+	// Calendar returns the accepted blocks and the current suggestions of the next days, earliest first.
+	Calendar(ctx context.Context) ([]domain.FocusBlock, error)
+}
+
+// FocusBlocksImpl is the implementation of the FocusBlocks use case.
+type FocusBlocksImpl struct {
+	todoRepo     domain.Repository
+	blockRepo    domain.FocusBlockRepository
+	timeProvider core.CurrentTimeProvider
+	hours        domain.WorkHours
+}
+
+// NewFocusBlocksImpl creates a new instance of FocusBlocksImpl.
+func NewFocusBlocksImpl(
+	todoRepo domain.Repository,
+	blockRepo domain.FocusBlockRepository,
+	timeProvider core.CurrentTimeProvider,
+	hours domain.WorkHours,
+) FocusBlocksImpl {
+	return FocusBlocksImpl{
+		todoRepo:     todoRepo,
+		blockRepo:    blockRepo,
+		timeProvider: timeProvider,
+		hours:        hours,
+	}
+}
+
+// Suggest returns focus blocks for the open todos with an effort estimate, placed around the accepted blocks.
+func (fb FocusBlocksImpl) Suggest(ctx context.Context) ([]domain.FocusBlock, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	_, suggested, err := fb.blocks(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return suggested, nil
+}
+
+// Calendar returns the accepted blocks and the current suggestions of the next domain.FocusBlockDays, earliest first.
+func (fb FocusBlocksImpl) Calendar(ctx context.Context) ([]domain.FocusBlock, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	accepted, suggested, err := fb.blocks(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	blocks := append(accepted, suggested...)
+	slices.SortStableFunc(blocks, func(a, b domain.FocusBlock) int {
+		return cmp.Compare(a.Start.UnixNano(), b.Start.UnixNano())
+	})
+	return blocks, nil
+}
+
+// Accept stores the blocks as firm calendar entries. Each block must reference an existing todo and must not
+// overlap another accepted block. A block keeps the ID of the suggestion it was accepted from.
+func (fb FocusBlocksImpl) Accept(ctx context.Context, blocks []domain.FocusBlock) ([]domain.FocusBlock, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if len(blocks) == 0 {
+		err := core.NewValidationErr("at least one focus block is required")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+
+	now := fb.timeProvider.Now()
+	accepted := make([]domain.FocusBlock, 0, len(blocks))
+	from, to := blocks[0].Start, blocks[0].End
+	for _, b := range blocks {
+		if err := b.Validate(); telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		t, found, err := fb.todoRepo.GetTodo(spanCtx, b.TodoID)
+		if telemetry.IsErrorRecorded(span, err) {
+			return nil, err
+		}
+		if !found {
+			err := core.NewNotFoundErr(fmt.Sprintf("todo %s not found", b.TodoID))
+			telemetry.IsErrorRecorded(span, err)
+			return nil, err
+		}
+
+		b.ID = domain.FocusBlockID(b.TodoID, b.Start)
+		b.Title = t.Title
+		b.Status = domain.FocusBlockStatus_ACCEPTED
+		b.CreatedAt = now
+		accepted = append(accepted, b)
+		from, to = minTime(from, b.Start), maxTime(to, b.End)
+	}
+
+	existing, err := fb.blockRepo.ListFocusBlocks(spanCtx, from.Add(-domain.MaxFocusBlockDuration), to)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+	for i, b := range accepted {
+		for _, other := range slices.Concat(existing, accepted[:i]) {
+			if b.Overlaps(other) {
+				err := core.NewValidationErr(fmt.Sprintf("focus block for %q at %s overlaps another focus block", b.Title, b.Start.Format(time.RFC3339)))
+				telemetry.IsErrorRecorded(span, err)
+				return nil, err
+			}
+		}
+	}
+
+	if err := fb.blockRepo.CreateFocusBlocks(spanCtx, accepted); telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return accepted, nil
+}
+
+// blocks returns the accepted blocks of the next domain.FocusBlockDays and the suggestions placed around them.
+func (fb FocusBlocksImpl) blocks(ctx context.Context) ([]domain.FocusBlock, []domain.FocusBlock, error) {
+	now := fb.timeProvider.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	accepted, err := fb.blockRepo.ListFocusBlocks(ctx, today, today.AddDate(0, 0, domain.FocusBlockDays))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	todos, _, err := fb.todoRepo.ListTodos(
+		ctx,
+		1,
+		maxFocusBlockTodos,
+		domain.WithStatus(domain.Status_OPEN),
+		domain.WithSortBy("dueDateAsc"),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accepted, domain.SuggestFocusBlocks(todos, now, fb.hours, accepted), nil
+}
+
+// minTime returns the earlier of two times.
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// maxTime returns the later of two times.
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,215 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFocusBlocksImpl_Calendar(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	hours := domain.WorkHours{StartHour: 9, EndHour: 17}
+	planned := domain.Todo{ID: uuid.New(), Title: "Write report", Status: domain.Status_OPEN, DueDate: today.AddDate(0, 0, 1), EstimatedMinutes: 60}
+	open := domain.Todo{ID: uuid.New(), Title: "Review budget", Status: domain.Status_OPEN, DueDate: today.AddDate(0, 0, 2), EstimatedMinutes: 30}
+	accepted := domain.FocusBlock{
+		ID:     uuid.New(),
+		TodoID: planned.ID,
+		Title:  planned.Title,
+		Start:  today.Add(9 * time.Hour),
+		End:    today.Add(10 * time.Hour),
+		Status: domain.FocusBlockStatus_ACCEPTED,
+	}
+
+	tests := map[string]struct {
+		setExpectations func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository)
+		expected        []domain.FocusBlock
+		expectedErr     error
+	}{
+		"accepted-and-suggested": {
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, today, today.AddDate(0, 0, domain.FocusBlockDays)).
+					Return([]domain.FocusBlock{accepted}, nil).Once()
+				todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxFocusBlockTodos, mock.Anything, mock.Anything).
+					Return([]domain.Todo{planned, open}, false, nil).Once()
+			},
+			expected: []domain.FocusBlock{
+				accepted,
+				{
+					ID:     domain.FocusBlockID(open.ID, today.Add(10*time.Hour)),
+					TodoID: open.ID,
+					Title:  open.Title,
+					Start:  today.Add(10 * time.Hour),
+					End:    today.Add(10*time.Hour + 30*time.Minute),
+					Status: domain.FocusBlockStatus_SUGGESTED,
+				},
+			},
+		},
+		"list-blocks-error": {
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"list-todos-error": {
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.FocusBlock{}, nil).Once()
+				todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxFocusBlockTodos, mock.Anything, mock.Anything).
+					Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			todoRepo := domain.NewMockRepository(t)
+			blockRepo := domain.NewMockFocusBlockRepository(t)
+			tt.setExpectations(todoRepo, blockRepo)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			fb := NewFocusBlocksImpl(todoRepo, blockRepo, timeProvider, hours)
+			got, err := fb.Calendar(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestFocusBlocksImpl_Suggest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	open := domain.Todo{ID: uuid.New(), Title: "Review budget", Status: domain.Status_OPEN, DueDate: today, EstimatedMinutes: 30}
+
+	todoRepo := domain.NewMockRepository(t)
+	blockRepo := domain.NewMockFocusBlockRepository(t)
+	blockRepo.EXPECT().ListFocusBlocks(mock.Anything, mock.Anything, mock.Anything).Return([]domain.FocusBlock{}, nil).Once()
+	todoRepo.EXPECT().ListTodos(mock.Anything, 1, maxFocusBlockTodos, mock.Anything, mock.Anything).
+		Return([]domain.Todo{open}, false, nil).Once()
+	timeProvider := core.NewMockCurrentTimeProvider(t)
+	timeProvider.EXPECT().Now().Return(now)
+
+	fb := NewFocusBlocksImpl(todoRepo, blockRepo, timeProvider, domain.WorkHours{StartHour: 9, EndHour: 17})
+	got, err := fb.Suggest(t.Context())
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, today.Add(9*time.Hour), got[0].Start)
+		assert.Equal(t, domain.FocusBlockStatus_SUGGESTED, got[0].Status)
+	}
+}
+
+func TestFocusBlocksImpl_Accept(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	report := domain.Todo{ID: uuid.New(), Title: "Write report", Status: domain.Status_OPEN}
+	budget := domain.Todo{ID: uuid.New(), Title: "Review budget", Status: domain.Status_OPEN}
+	requested := []domain.FocusBlock{
+		{TodoID: report.ID, Start: start, End: start.Add(time.Hour)},
+		{TodoID: budget.ID, Start: start.Add(time.Hour), End: start.Add(90 * time.Minute)},
+	}
+
+	tests := map[string]struct {
+		blocks          []domain.FocusBlock
+		setExpectations func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository)
+		expectedErr     error
+	}{
+		"success": {
+			blocks: requested,
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				todoRepo.EXPECT().GetTodo(mock.Anything, report.ID).Return(report, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, budget.ID).Return(budget, true, nil).Once()
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, start.Add(-domain.MaxFocusBlockDuration), start.Add(90*time.Minute)).
+					Return([]domain.FocusBlock{}, nil).Once()
+				blockRepo.EXPECT().CreateFocusBlocks(mock.Anything, mock.MatchedBy(func(blocks []domain.FocusBlock) bool {
+					return len(blocks) == 2 &&
+						blocks[0].ID == domain.FocusBlockID(report.ID, start) &&
+						blocks[0].Title == report.Title &&
+						blocks[0].Status == domain.FocusBlockStatus_ACCEPTED &&
+						blocks[0].CreatedAt.Equal(now) &&
+						blocks[1].Title == budget.Title
+				})).Return(nil).Once()
+			},
+		},
+		"no-blocks": {
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {},
+			expectedErr:     core.NewValidationErr("at least one focus block is required"),
+		},
+		"invalid-block": {
+			blocks:          []domain.FocusBlock{{TodoID: report.ID, Start: start, End: start.Add(3 * time.Hour)}},
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {},
+			expectedErr:     core.NewValidationErr("focus block cannot exceed 2 hours"),
+		},
+		"todo-not-found": {
+			blocks: requested[:1],
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				todoRepo.EXPECT().GetTodo(mock.Anything, report.ID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("todo " + report.ID.String() + " not found"),
+		},
+		"overlaps-accepted-block": {
+			blocks: requested[:1],
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				todoRepo.EXPECT().GetTodo(mock.Anything, report.ID).Return(report, true, nil).Once()
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.FocusBlock{{TodoID: budget.ID, Start: start.Add(30 * time.Minute), End: start.Add(2 * time.Hour)}}, nil).Once()
+			},
+			expectedErr: core.NewValidationErr(`focus block for "Write report" at 2026-03-02T09:00:00Z overlaps another focus block`),
+		},
+		"overlapping-requested-blocks": {
+			blocks: []domain.FocusBlock{requested[0], requested[0]},
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				todoRepo.EXPECT().GetTodo(mock.Anything, report.ID).Return(report, true, nil).Twice()
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, mock.Anything, mock.Anything).Return([]domain.FocusBlock{}, nil).Once()
+			},
+			expectedErr: core.NewValidationErr(`focus block for "Write report" at 2026-03-02T09:00:00Z overlaps another focus block`),
+		},
+		"create-error": {
+			blocks: requested[:1],
+			setExpectations: func(todoRepo *domain.MockRepository, blockRepo *domain.MockFocusBlockRepository) {
+				todoRepo.EXPECT().GetTodo(mock.Anything, report.ID).Return(report, true, nil).Once()
+				blockRepo.EXPECT().ListFocusBlocks(mock.Anything, mock.Anything, mock.Anything).Return([]domain.FocusBlock{}, nil).Once()
+				blockRepo.EXPECT().CreateFocusBlocks(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			todoRepo := domain.NewMockRepository(t)
+			blockRepo := domain.NewMockFocusBlockRepository(t)
+			tt.setExpectations(todoRepo, blockRepo)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			fb := NewFocusBlocksImpl(todoRepo, blockRepo, timeProvider, domain.WorkHours{StartHour: 9, EndHour: 17})
+			got, err := fb.Accept(t.Context(), tt.blocks)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, got, len(tt.blocks))
+			}
+		})
+	}
+}
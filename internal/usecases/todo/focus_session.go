@@ -0,0 +1,156 @@
+package todo
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// DEFAULT_FOCUS_SESSION_COMPLETION_TIMEOUT bounds the work done when a focus session completes.
+const DEFAULT_FOCUS_SESSION_COMPLETION_TIMEOUT = 10 * time.Second
+
+// FocusSessions defines the interface for running pomodoro-style focus sessions on todos.
+type FocusSessions interface {
+	// Start starts a focus session on the todo. When conversationID is set, the completion
+	// event is emitted into that conversation stream if it is still open.
+	Start(ctx context.Context, todoID uuid.UUID, duration time.Duration, conversationID *uuid.UUID) (domain.FocusSession, error)
+}
+
+// FocusSessionsImpl is the in-process implementation of the FocusSessions use case.
+// Running sessions are tracked in memory and completed by a timer.
+type FocusSessionsImpl struct {
+	logger       *log.Logger
+	uow          transaction.UnitOfWork
+	timeTracker  TimeTracker
+	streams      assistant.ConversationStreams
+	notifier     domain.FocusSessionNotifier
+	timeProvider core.CurrentTimeProvider
+
+	mu        sync.Mutex
+	running   map[uuid.UUID]domain.FocusSession
+	afterFunc func(time.Duration, func())
+}
+
+// NewFocusSessionsImpl creates a new instance of FocusSessionsImpl.
+func NewFocusSessionsImpl(
+	logger *log.Logger,
+	uow transaction.UnitOfWork,
+	timeTracker TimeTracker,
+	streams assistant.ConversationStreams,
+	notifier domain.FocusSessionNotifier,
+	timeProvider core.CurrentTimeProvider,
+) *FocusSessionsImpl {
+	return &FocusSessionsImpl{
+		logger:       logger,
+		uow:          uow,
+		timeTracker:  timeTracker,
+		streams:      streams,
+		notifier:     notifier,
+		timeProvider: timeProvider,
+		running:      make(map[uuid.UUID]domain.FocusSession),
+		afterFunc: func(d time.Duration, f func()) {
+			time.AfterFunc(d, f)
+		},
+	}
+}
+
+// Start starts a focus session on the todo. Only one focus session can run per todo.
+func (fs *FocusSessionsImpl) Start(ctx context.Context, todoID uuid.UUID, duration time.Duration, conversationID *uuid.UUID) (domain.FocusSession, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	session := domain.FocusSession{
+		ID:             uuid.New(),
+		TodoID:         todoID,
+		ConversationID: conversationID,
+		Duration:       duration,
+		StartedAt:      fs.timeProvider.Now(),
+	}
+	if err := session.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return domain.FocusSession{}, err
+	}
+
+	err := fs.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		return ensureTodoExists(uowCtx, scope, todoID)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.FocusSession{}, err
+	}
+
+	if !fs.track(session) {
+		err := core.NewValidationErr("a focus session is already running for this todo")
+		telemetry.IsErrorRecorded(span, err)
+		return domain.FocusSession{}, err
+	}
+
+	fs.afterFunc(duration, func() {
+		completeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DEFAULT_FOCUS_SESSION_COMPLETION_TIMEOUT)
+		defer cancel()
+		fs.complete(completeCtx, session)
+	})
+
+	return session, nil
+}
+
+// track registers the session as running, returning false when the todo already has one.
+func (fs *FocusSessionsImpl) track(session domain.FocusSession) bool {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if _, found := fs.running[session.TodoID]; found {
+		return false
+	}
+	fs.running[session.TodoID] = session
+	return true
+}
+
+// untrack removes the running session of the todo.
+func (fs *FocusSessionsImpl) untrack(todoID uuid.UUID) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	delete(fs.running, todoID)
+}
+
+// complete logs the session time against the todo and notifies the user, preferring
+// the originating conversation stream and falling back to the notifier.
+func (fs *FocusSessionsImpl) complete(ctx context.Context, session domain.FocusSession) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	fs.untrack(session.TodoID)
+
+	_, logErr := fs.timeTracker.LogTime(spanCtx, session.TodoID, session.StartedAt, session.Duration)
+	if telemetry.IsErrorRecorded(span, logErr) {
+		fs.logger.Printf("FocusSessions: failed to log time for todo_id=%s: %v", session.TodoID, logErr)
+	}
+
+	if session.ConversationID != nil {
+		delivered, err := fs.streams.Emit(spanCtx, *session.ConversationID, assistant.EventType_FocusSessionCompleted, assistant.FocusSessionCompleted{
+			SessionID:       session.ID,
+			TodoID:          session.TodoID,
+			DurationMinutes: int(session.Duration.Minutes()),
+			StartedAt:       session.StartedAt,
+			CompletedAt:     session.EndsAt(),
+			TimeLogged:      logErr == nil,
+		})
+		if telemetry.IsErrorRecorded(span, err) {
+			fs.logger.Printf("FocusSessions: failed to emit completion for conversation_id=%s: %v", *session.ConversationID, err)
+		}
+		if delivered && err == nil {
+			return
+		}
+	}
+
+	if err := fs.notifier.NotifyFocusSessionCompleted(spanCtx, session); telemetry.IsErrorRecorded(span, err) {
+		fs.logger.Printf("FocusSessions: failed to notify completion for todo_id=%s: %v", session.TodoID, err)
+	}
+}
@@ -0,0 +1,185 @@
+package todo
+
+import (
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFocusSessionsImpl_Start(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	conversationID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	type mocks struct {
+		uow      *transaction.MockUnitOfWork
+		tracker  *MockTimeTracker
+		streams  *assistant.MockConversationStreams
+		notifier *domain.MockFocusSessionNotifier
+		tp       *core.MockCurrentTimeProvider
+	}
+
+	expectTodoExists := func(t *testing.T, m mocks, found bool) {
+		todoRepo := expectScope(t, m.uow).Todo
+		todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, found, nil).Once()
+	}
+
+	tests := map[string]struct {
+		duration        time.Duration
+		conversationID  *uuid.UUID
+		setExpectations func(t *testing.T, m mocks)
+		expectedErr     error
+	}{
+		"completion-emitted-to-open-stream": {
+			duration:       25 * time.Minute,
+			conversationID: &conversationID,
+			setExpectations: func(t *testing.T, m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				expectTodoExists(t, m, true)
+				m.tracker.EXPECT().
+					LogTime(mock.Anything, todoID, now, 25*time.Minute).
+					Return(domain.TimeEntry{}, nil).
+					Once()
+				m.streams.EXPECT().
+					Emit(mock.Anything, conversationID, assistant.EventType_FocusSessionCompleted, mock.MatchedBy(func(e assistant.FocusSessionCompleted) bool {
+						return e.TodoID == todoID && e.DurationMinutes == 25 && e.TimeLogged && e.CompletedAt.Equal(now.Add(25*time.Minute))
+					})).
+					Return(true, nil).
+					Once()
+			},
+		},
+		"completion-notified-when-stream-closed": {
+			duration:       25 * time.Minute,
+			conversationID: &conversationID,
+			setExpectations: func(t *testing.T, m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				expectTodoExists(t, m, true)
+				m.tracker.EXPECT().LogTime(mock.Anything, todoID, now, 25*time.Minute).Return(domain.TimeEntry{}, nil).Once()
+				m.streams.EXPECT().
+					Emit(mock.Anything, conversationID, assistant.EventType_FocusSessionCompleted, mock.Anything).
+					Return(false, nil).
+					Once()
+				m.notifier.EXPECT().
+					NotifyFocusSessionCompleted(mock.Anything, mock.MatchedBy(func(s domain.FocusSession) bool {
+						return s.TodoID == todoID
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"completion-notified-without-conversation": {
+			duration: 50 * time.Minute,
+			setExpectations: func(t *testing.T, m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				expectTodoExists(t, m, true)
+				m.tracker.EXPECT().LogTime(mock.Anything, todoID, now, 50*time.Minute).Return(domain.TimeEntry{}, nil).Once()
+				m.notifier.EXPECT().NotifyFocusSessionCompleted(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		"log-time-error-still-notifies": {
+			duration:       25 * time.Minute,
+			conversationID: &conversationID,
+			setExpectations: func(t *testing.T, m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				expectTodoExists(t, m, true)
+				m.tracker.EXPECT().LogTime(mock.Anything, todoID, now, 25*time.Minute).Return(domain.TimeEntry{}, errors.New("db error")).Once()
+				m.streams.EXPECT().
+					Emit(mock.Anything, conversationID, assistant.EventType_FocusSessionCompleted, mock.MatchedBy(func(e assistant.FocusSessionCompleted) bool {
+						return !e.TimeLogged
+					})).
+					Return(true, nil).
+					Once()
+			},
+		},
+		"invalid-duration": {
+			duration: 0,
+			setExpectations: func(t *testing.T, m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+			},
+			expectedErr: core.NewValidationErr("duration must be greater than zero"),
+		},
+		"todo-not-found": {
+			duration: 25 * time.Minute,
+			setExpectations: func(t *testing.T, m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				expectTodoExists(t, m, false)
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				uow:      transaction.NewMockUnitOfWork(t),
+				tracker:  NewMockTimeTracker(t),
+				streams:  assistant.NewMockConversationStreams(t),
+				notifier: domain.NewMockFocusSessionNotifier(t),
+				tp:       core.NewMockCurrentTimeProvider(t),
+			}
+			tt.setExpectations(t, m)
+
+			uc := NewFocusSessionsImpl(log.New(io.Discard, "", 0), m.uow, m.tracker, m.streams, m.notifier, m.tp)
+			var scheduled time.Duration
+			uc.afterFunc = func(d time.Duration, f func()) {
+				scheduled = d
+				f()
+			}
+
+			session, err := uc.Start(t.Context(), todoID, tt.duration, tt.conversationID)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, todoID, session.TodoID)
+			assert.Equal(t, tt.duration, scheduled)
+			assert.Empty(t, uc.running)
+		})
+	}
+}
+
+func TestFocusSessionsImpl_Start_AlreadyRunning(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	uow := transaction.NewMockUnitOfWork(t)
+	tp := core.NewMockCurrentTimeProvider(t)
+	tp.EXPECT().Now().Return(now).Times(2)
+	for range 2 {
+		repos := expectScope(t, uow)
+		repos.Todo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+	}
+
+	uc := NewFocusSessionsImpl(
+		log.New(io.Discard, "", 0),
+		uow,
+		NewMockTimeTracker(t),
+		assistant.NewMockConversationStreams(t),
+		domain.NewMockFocusSessionNotifier(t),
+		tp,
+	)
+	uc.afterFunc = func(time.Duration, func()) {}
+
+	_, err := uc.Start(t.Context(), todoID, 25*time.Minute, nil)
+	assert.NoError(t, err)
+
+	_, err = uc.Start(t.Context(), todoID, 25*time.Minute, nil)
+	assert.Equal(t, core.NewValidationErr("a focus session is already running for this todo"), err)
+}
@@ -0,0 +1,50 @@
+package todo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/stretchr/testify/mock"
+)
+
+// scopeRepos holds the repository mocks exposed by the scope of expectScope.
+type scopeRepos struct {
+	Todo        *domain.MockRepository
+	TimeEntry   *domain.MockTimeEntryRepository
+	Comment     *domain.MockCommentRepository
+	Project     *domain.MockProjectRepository
+	Change      *domain.MockChangeRepository
+	Outbox      *outbox.MockRepository
+	CustomField *domain.MockCustomFieldRepository
+}
+
+// expectScope makes the unit of work run its function once with a scope mock exposing the repository mocks.
+func expectScope(t *testing.T, uow *transaction.MockUnitOfWork) scopeRepos {
+	repos := scopeRepos{
+		Todo:        domain.NewMockRepository(t),
+		TimeEntry:   domain.NewMockTimeEntryRepository(t),
+		Comment:     domain.NewMockCommentRepository(t),
+		Project:     domain.NewMockProjectRepository(t),
+		Change:      domain.NewMockChangeRepository(t),
+		Outbox:      outbox.NewMockRepository(t),
+		CustomField: domain.NewMockCustomFieldRepository(t),
+	}
+	scope := transaction.NewMockScope(t)
+	scope.EXPECT().Todo().Return(repos.Todo).Maybe()
+	scope.EXPECT().TimeEntry().Return(repos.TimeEntry).Maybe()
+	scope.EXPECT().Comment().Return(repos.Comment).Maybe()
+	scope.EXPECT().Project().Return(repos.Project).Maybe()
+	scope.EXPECT().Change().Return(repos.Change).Maybe()
+	scope.EXPECT().Outbox().Return(repos.Outbox).Maybe()
+	scope.EXPECT().CustomField().Return(repos.CustomField).Maybe()
+	uow.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+			return fn(ctx, scope)
+		}).
+		Once()
+	return repos
+}
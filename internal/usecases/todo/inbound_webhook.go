@@ -0,0 +1,302 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// maxWebhookTitleBytes is the longest todo title accepted by domain.Todo.Validate.
+const maxWebhookTitleBytes = 200
+
+// WebhookDelivery is a request received from an inbound integration.
+type WebhookDelivery struct {
+	// Event is the event name sent by the source, e.g. the X-GitHub-Event header.
+	Event string
+	// Signature is the hex HMAC-SHA256 of Payload keyed with the source secret,
+	// optionally prefixed with "sha256=".
+	Signature string
+	// Secret is the shared secret sent as is, for sources that cannot sign payloads.
+	Secret string
+	// Payload is the raw JSON body.
+	Payload []byte
+}
+
+// WebhookTemplate maps matching webhook payloads to a new todo.
+// Title, DueDate and Comment are text/template sources rendered with the decoded JSON payload.
+type WebhookTemplate struct {
+	// Event restricts the template to deliveries with this event name. Empty matches any event.
+	Event string
+	// Action restricts the template to payloads whose "action" field has this value. Empty matches any action.
+	Action string
+	Title  string
+	// DueDate renders a YYYY-MM-DD date or an RFC 3339 timestamp. The todo is due today when it renders empty.
+	DueDate string
+	// Comment renders an optional comment attached to the todo, e.g. a link back to the source.
+	Comment string
+}
+
+// DefaultWebhookTemplates holds the mapping templates of well-known sources, keyed by source name.
+var DefaultWebhookTemplates = map[string][]WebhookTemplate{
+	"github": {
+		{
+			Event:   "issues",
+			Action:  "opened",
+			Title:   "GitHub #{{.issue.number}}: {{.issue.title}}",
+			Comment: "Opened from GitHub issue {{.issue.html_url}}",
+		},
+	},
+}
+
+// GenericWebhookTemplate maps deliveries of sources without default templates.
+// It expects a {"title", "due_date", "url"} payload.
+var GenericWebhookTemplate = WebhookTemplate{
+	Title:   "{{.title}}",
+	DueDate: "{{.due_date}}",
+	Comment: "{{with .url}}Source: {{.}}{{end}}",
+}
+
+// ParseWebhookSecrets builds the per-source shared secrets from a comma-separated list of
+// source=secret entries, e.g. "github=s3cr3t,zapier=0th3r".
+func ParseWebhookSecrets(list string) (map[string]string, error) {
+	secrets := map[string]string{}
+	for entry := range strings.SplitSeq(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		source, secret, ok := strings.Cut(entry, "=")
+		source, secret = strings.TrimSpace(source), strings.TrimSpace(secret)
+		if !ok || source == "" || secret == "" {
+			return nil, fmt.Errorf("invalid webhook secret entry for %q: expected source=secret", source)
+		}
+		secrets[source] = secret
+	}
+	return secrets, nil
+}
+
+// InboundWebhooks defines the interface for creating todos from inbound integration webhooks.
+type InboundWebhooks interface {
+	// Receive authenticates the delivery with the source secret and creates a todo from the first
+	// matching template. Deliveries made with a personal access token that may write todos need no
+	// source secret. It returns false when no template matches the delivery.
+	Receive(ctx context.Context, source string, delivery WebhookDelivery) (domain.Todo, bool, error)
+}
+
+// InboundWebhooksImpl is the implementation of the InboundWebhooks use case.
+type InboundWebhooksImpl struct {
+	uow          transaction.UnitOfWork
+	creator      Creator
+	timeProvider core.CurrentTimeProvider
+	secrets      map[string]string
+	templates    map[string][]WebhookTemplate
+}
+
+// NewInboundWebhooksImpl creates a new instance of InboundWebhooksImpl.
+// Sources without an entry in templates are mapped with GenericWebhookTemplate.
+func NewInboundWebhooksImpl(
+	uow transaction.UnitOfWork,
+	creator Creator,
+	timeProvider core.CurrentTimeProvider,
+	secrets map[string]string,
+	templates map[string][]WebhookTemplate,
+) InboundWebhooksImpl {
+	return InboundWebhooksImpl{
+		uow:          uow,
+		creator:      creator,
+		timeProvider: timeProvider,
+		secrets:      secrets,
+		templates:    templates,
+	}
+}
+
+// Receive creates a todo, and its optional comment, through the standard Creator so embeddings
+// and todo events are produced as for any other todo.
+func (iw InboundWebhooksImpl) Receive(ctx context.Context, source string, delivery WebhookDelivery) (domain.Todo, bool, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if principal := access.FromContext(spanCtx); principal.TokenID == uuid.Nil || !principal.CanWrite() {
+		secret, ok := iw.secrets[source]
+		if !ok {
+			err := core.NewNotFoundErr(fmt.Sprintf("webhook source %q not found", source))
+			telemetry.IsErrorRecorded(span, err)
+			return domain.Todo{}, false, err
+		}
+		if !verifyWebhookDelivery(secret, delivery) {
+			err := core.NewUnauthorizedErr("invalid webhook signature")
+			telemetry.IsErrorRecorded(span, err)
+			return domain.Todo{}, false, err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(delivery.Payload))
+	decoder.UseNumber()
+	var payload map[string]any
+	if err := decoder.Decode(&payload); err != nil {
+		verr := core.NewValidationErr(fmt.Sprintf("invalid webhook payload: %v", err))
+		telemetry.IsErrorRecorded(span, verr)
+		return domain.Todo{}, false, verr
+	}
+
+	tmpl, ok := iw.match(source, delivery.Event, payload)
+	if !ok {
+		return domain.Todo{}, false, nil
+	}
+
+	now := iw.timeProvider.Now()
+	title, dueDate, comment, err := renderWebhookTemplate(tmpl, payload, now)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Todo{}, false, err
+	}
+
+	var todo domain.Todo
+	err = iw.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		var err error
+		todo, err = iw.creator.Create(uowCtx, scope, title, dueDate, 0, nil)
+		if err != nil {
+			return err
+		}
+		if comment == "" {
+			return nil
+		}
+		c := domain.Comment{
+			ID:        uuid.New(),
+			TodoID:    todo.ID,
+			Author:    domain.CommentAuthor_User,
+			Body:      comment,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		return scope.Comment().CreateComment(uowCtx, c)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Todo{}, false, err
+	}
+
+	return todo, true, nil
+}
+
+// match returns the first template of the source that matches the delivery.
+func (iw InboundWebhooksImpl) match(source, event string, payload map[string]any) (WebhookTemplate, bool) {
+	templates, ok := iw.templates[source]
+	if !ok {
+		templates = []WebhookTemplate{GenericWebhookTemplate}
+	}
+	action, _ := payload["action"].(string)
+	for _, t := range templates {
+		if (t.Event == "" || t.Event == event) && (t.Action == "" || t.Action == action) {
+			return t, true
+		}
+	}
+	return WebhookTemplate{}, false
+}
+
+// verifyWebhookDelivery checks the delivery signature, or the plain shared secret when it is not signed.
+func verifyWebhookDelivery(secret string, delivery WebhookDelivery) bool {
+	if delivery.Signature != "" {
+		got, err := hex.DecodeString(strings.TrimPrefix(delivery.Signature, "sha256="))
+		if err != nil {
+			return false
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(delivery.Payload)
+		return hmac.Equal(got, mac.Sum(nil))
+	}
+	if delivery.Secret != "" {
+		return subtle.ConstantTimeCompare([]byte(delivery.Secret), []byte(secret)) == 1
+	}
+	return false
+}
+
+// renderWebhookTemplate renders the todo title, due date and comment of a matching template.
+func renderWebhookTemplate(tmpl WebhookTemplate, payload map[string]any, now time.Time) (string, time.Time, string, error) {
+	title, err := renderWebhookField("title", tmpl.Title, payload)
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+	if title == "" {
+		return "", time.Time{}, "", core.NewValidationErr("webhook payload did not produce a todo title")
+	}
+	title = truncateUTF8(title, maxWebhookTitleBytes)
+
+	rawDueDate, err := renderWebhookField("due_date", tmpl.DueDate, payload)
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+	dueDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if rawDueDate != "" {
+		dueDate, err = parseWebhookDueDate(rawDueDate)
+		if err != nil {
+			return "", time.Time{}, "", err
+		}
+	}
+
+	comment, err := renderWebhookField("comment", tmpl.Comment, payload)
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+	if runes := []rune(comment); len(runes) > domain.MaxCommentBodyChars {
+		comment = string(runes[:domain.MaxCommentBodyChars])
+	}
+
+	return title, dueDate, comment, nil
+}
+
+// renderWebhookField renders one template field. Missing payload fields render empty.
+func renderWebhookField(name, source string, payload map[string]any) (string, error) {
+	if source == "" {
+		return "", nil
+	}
+	t, err := template.New(name).Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse webhook %s template: %w", name, err)
+	}
+	var sb strings.Builder
+	if err := t.Execute(&sb, payload); err != nil {
+		return "", core.NewValidationErr(fmt.Sprintf("failed to render webhook %s: %v", name, err))
+	}
+	return strings.TrimSpace(strings.ReplaceAll(sb.String(), "<no value>", "")), nil
+}
+
+// parseWebhookDueDate parses a YYYY-MM-DD date or an RFC 3339 timestamp into a UTC date.
+func parseWebhookDueDate(value string) (time.Time, error) {
+	if d, err := time.Parse(time.DateOnly, value); err == nil {
+		return d, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, core.NewValidationErr(fmt.Sprintf("invalid webhook due_date %q", value))
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// truncateUTF8 shortens s to at most maxBytes bytes without splitting a rune.
+func truncateUTF8(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	for maxBytes > 0 && !utf8.RuneStart(s[maxBytes]) {
+		maxBytes--
+	}
+	return s[:maxBytes]
+}
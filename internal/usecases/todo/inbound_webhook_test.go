@@ -0,0 +1,270 @@
+package todo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/access"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParseWebhookSecrets(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		list        string
+		expected    map[string]string
+		expectedErr bool
+	}{
+		"empty": {
+			list:     "",
+			expected: map[string]string{},
+		},
+		"entries": {
+			list:     "github=s3cr3t, zapier = 0th3r ,",
+			expected: map[string]string{"github": "s3cr3t", "zapier": "0th3r"},
+		},
+		"missing-secret": {
+			list:        "github=",
+			expectedErr: true,
+		},
+		"missing-separator": {
+			list:        "github",
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseWebhookSecrets(tt.list)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestInboundWebhooksImpl_Receive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	secrets := map[string]string{"github": "gh-secret", "zapier": "zap-secret"}
+
+	githubPayload := []byte(`{"action":"opened","issue":{"number":42,"title":"Crash on save","html_url":"https://github.com/acme/app/issues/42"}}`)
+	sign := func(secret string, payload []byte) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	type mocks struct {
+		uow         *transaction.MockUnitOfWork
+		scope       *transaction.MockScope
+		commentRepo *domain.MockCommentRepository
+		creator     *MockCreator
+		tp          *core.MockCurrentTimeProvider
+	}
+	runInUow := func(m mocks) {
+		m.uow.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+				return fn(ctx, m.scope)
+			}).
+			Once()
+	}
+
+	writer := access.Principal{Name: "ci", Role: access.RoleMember, TokenID: todoID, Scopes: []access.Scope{access.ScopeTodosWrite}}
+	reader := access.Principal{Name: "ci", Role: access.RoleMember, TokenID: todoID, Scopes: []access.Scope{access.ScopeTodosRead}}
+
+	tests := map[string]struct {
+		principal       *access.Principal
+		source          string
+		delivery        WebhookDelivery
+		setExpectations func(m mocks)
+		expected        domain.Todo
+		expectedCreated bool
+		expectedErr     error
+	}{
+		"github-issue-opened": {
+			source:   "github",
+			delivery: WebhookDelivery{Event: "issues", Signature: sign("gh-secret", githubPayload), Payload: githubPayload},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				runInUow(m)
+				m.creator.EXPECT().
+					Create(mock.Anything, m.scope, "GitHub #42: Crash on save", today, 0, domain.CustomFieldValues(nil)).
+					Return(domain.Todo{ID: todoID, Title: "GitHub #42: Crash on save", DueDate: today}, nil).
+					Once()
+				m.scope.EXPECT().Comment().Return(m.commentRepo).Once()
+				m.commentRepo.EXPECT().
+					CreateComment(mock.Anything, mock.MatchedBy(func(c domain.Comment) bool {
+						return c.TodoID == todoID &&
+							c.Author == domain.CommentAuthor_User &&
+							c.Body == "Opened from GitHub issue https://github.com/acme/app/issues/42"
+					})).
+					Return(nil).
+					Once()
+			},
+			expected:        domain.Todo{ID: todoID, Title: "GitHub #42: Crash on save", DueDate: today},
+			expectedCreated: true,
+		},
+		"generic-source-with-shared-secret": {
+			source:   "zapier",
+			delivery: WebhookDelivery{Secret: "zap-secret", Payload: []byte(`{"title":"Renew passport","due_date":"2026-04-01"}`)},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				runInUow(m)
+				m.creator.EXPECT().
+					Create(mock.Anything, m.scope, "Renew passport", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), 0, domain.CustomFieldValues(nil)).
+					Return(domain.Todo{ID: todoID, Title: "Renew passport"}, nil).
+					Once()
+			},
+			expected:        domain.Todo{ID: todoID, Title: "Renew passport"},
+			expectedCreated: true,
+		},
+		"personal-access-token-without-secret": {
+			principal: &writer,
+			source:    "todoist",
+			delivery:  WebhookDelivery{Payload: []byte(`{"title":"Renew passport"}`)},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				runInUow(m)
+				m.creator.EXPECT().
+					Create(mock.Anything, m.scope, "Renew passport", today, 0, domain.CustomFieldValues(nil)).
+					Return(domain.Todo{ID: todoID, Title: "Renew passport"}, nil).
+					Once()
+			},
+			expected:        domain.Todo{ID: todoID, Title: "Renew passport"},
+			expectedCreated: true,
+		},
+		"read-only-token-needs-secret": {
+			principal:       &reader,
+			source:          "zapier",
+			delivery:        WebhookDelivery{Payload: []byte(`{"title":"Renew passport"}`)},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewUnauthorizedErr("invalid webhook signature"),
+		},
+		"long-title-is-truncated": {
+			source:   "zapier",
+			delivery: WebhookDelivery{Secret: "zap-secret", Payload: []byte(`{"title":"` + strings.Repeat("a", 250) + `"}`)},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				runInUow(m)
+				m.creator.EXPECT().
+					Create(mock.Anything, m.scope, strings.Repeat("a", 200), today, 0, domain.CustomFieldValues(nil)).
+					Return(domain.Todo{ID: todoID}, nil).
+					Once()
+			},
+			expected:        domain.Todo{ID: todoID},
+			expectedCreated: true,
+		},
+		"unmatched-event-is-ignored": {
+			source:          "github",
+			delivery:        WebhookDelivery{Event: "ping", Signature: sign("gh-secret", []byte(`{"zen":"Keep it simple."}`)), Payload: []byte(`{"zen":"Keep it simple."}`)},
+			setExpectations: func(m mocks) {},
+		},
+		"unknown-source": {
+			source:          "gitlab",
+			delivery:        WebhookDelivery{Secret: "x", Payload: githubPayload},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewNotFoundErr(`webhook source "gitlab" not found`),
+		},
+		"invalid-signature": {
+			source:          "github",
+			delivery:        WebhookDelivery{Event: "issues", Signature: sign("wrong", githubPayload), Payload: githubPayload},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewUnauthorizedErr("invalid webhook signature"),
+		},
+		"wrong-shared-secret": {
+			source:          "zapier",
+			delivery:        WebhookDelivery{Secret: "gh-secret", Payload: []byte(`{"title":"Renew passport"}`)},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewUnauthorizedErr("invalid webhook signature"),
+		},
+		"missing-credentials": {
+			source:          "zapier",
+			delivery:        WebhookDelivery{Payload: []byte(`{"title":"Renew passport"}`)},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewUnauthorizedErr("invalid webhook signature"),
+		},
+		"invalid-payload": {
+			source:          "zapier",
+			delivery:        WebhookDelivery{Secret: "zap-secret", Payload: []byte(`[1,2]`)},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewValidationErr("invalid webhook payload: json: cannot unmarshal array into Go value of type map[string]interface {}"),
+		},
+		"missing-title": {
+			source:   "zapier",
+			delivery: WebhookDelivery{Secret: "zap-secret", Payload: []byte(`{"url":"https://example.com"}`)},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+			},
+			expectedErr: core.NewValidationErr("webhook payload did not produce a todo title"),
+		},
+		"invalid-due-date": {
+			source:   "zapier",
+			delivery: WebhookDelivery{Secret: "zap-secret", Payload: []byte(`{"title":"Renew passport","due_date":"soon"}`)},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+			},
+			expectedErr: core.NewValidationErr(`invalid webhook due_date "soon"`),
+		},
+		"creator-error": {
+			source:   "zapier",
+			delivery: WebhookDelivery{Secret: "zap-secret", Payload: []byte(`{"title":"Renew passport","url":"https://example.com"}`)},
+			setExpectations: func(m mocks) {
+				m.tp.EXPECT().Now().Return(now).Once()
+				runInUow(m)
+				m.creator.EXPECT().
+					Create(mock.Anything, m.scope, "Renew passport", today, 0, domain.CustomFieldValues(nil)).
+					Return(domain.Todo{}, errors.New("encoder error")).
+					Once()
+			},
+			expectedErr: errors.New("encoder error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				uow:         transaction.NewMockUnitOfWork(t),
+				scope:       transaction.NewMockScope(t),
+				commentRepo: domain.NewMockCommentRepository(t),
+				creator:     NewMockCreator(t),
+				tp:          core.NewMockCurrentTimeProvider(t),
+			}
+			tt.setExpectations(m)
+
+			ctx := t.Context()
+			if tt.principal != nil {
+				ctx = access.NewContext(ctx, *tt.principal)
+			}
+
+			uc := NewInboundWebhooksImpl(m.uow, m.creator, m.tp, secrets, DefaultWebhookTemplates)
+			got, created, err := uc.Receive(ctx, tt.source, tt.delivery)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedCreated, created)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
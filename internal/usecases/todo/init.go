@@ -2,7 +2,11 @@ package todo
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
@@ -16,24 +20,65 @@ type InitCreateTodo struct {
 	Creator Creator                `resolve:""`
 }
 
+// InitQuickAdd initializes the QuickAdd use case and registers it in the dependency container.
+type InitQuickAdd struct {
+	CustomFieldRepo domain.CustomFieldRepository `resolve:""`
+	Uow             transaction.UnitOfWork       `resolve:""`
+	Creator         Creator                      `resolve:""`
+	TimeProvider    core.CurrentTimeProvider     `resolve:""`
+	Assistant       assistant.Assistant          `resolve:""`
+	Model           string                       `config:"QUICK_ADD_LLM_MODEL" default:""`
+}
+
 // InitDeleteTodo initializes the Delete use case.
 type InitDeleteTodo struct {
 	Uow     transaction.UnitOfWork `resolve:""`
 	Deleter Deleter                `resolve:""`
 }
 
+// InitApplyChanges initializes the ApplyChanges use case and registers it in the dependency container.
+type InitApplyChanges struct {
+	Uow     transaction.UnitOfWork `resolve:""`
+	Creator Creator                `resolve:""`
+	Updater Updater                `resolve:""`
+	Deleter Deleter                `resolve:""`
+}
+
+// InitSync initializes the Sync use case and registers it in the dependency container.
+type InitSync struct {
+	Uow                    transaction.UnitOfWork                 `resolve:""`
+	Creator                Creator                                `resolve:""`
+	Updater                Updater                                `resolve:""`
+	Deleter                Deleter                                `resolve:""`
+	TodoRepo               domain.Repository                      `resolve:""`
+	ChangeRepo             domain.ChangeRepository                `resolve:""`
+	ConversationRepo       assistant.ConversationRepository       `resolve:""`
+	ConversationChangeRepo assistant.ConversationChangeRepository `resolve:""`
+}
+
+// InitInboundWebhooks initializes the InboundWebhooks use case and registers it in the dependency container.
+type InitInboundWebhooks struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	Creator      Creator                  `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	Secrets      string                   `config:"INBOUND_WEBHOOK_SECRETS" default:""`
+}
+
 // InitListTodos initializes the List use case and registers it in the dependency container.
 type InitListTodos struct {
-	TodoRepo       domain.Repository `resolve:""`
-	Encoder        semantic.Encoder  `resolve:""`
-	EmbeddingModel string            `config:"LLM_EMBEDDING_MODEL"`
+	TodoRepo        domain.Repository            `resolve:""`
+	CustomFieldRepo domain.CustomFieldRepository `resolve:""`
+	Encoder         semantic.Encoder             `resolve:""`
+	TimeProvider    core.CurrentTimeProvider     `resolve:""`
+	EmbeddingModel  string                       `config:"LLM_EMBEDDING_MODEL"`
 }
 
 // InitCreator initializes the Creator and registers it in the dependency container.
 type InitCreator struct {
-	TimeService core.CurrentTimeProvider `resolve:""`
-	Encoder     semantic.Encoder         `resolve:""`
-	Model       string                   `config:"LLM_EMBEDDING_MODEL"`
+	TimeService    core.CurrentTimeProvider `resolve:""`
+	Encoder        semantic.Encoder         `resolve:""`
+	Model          string                   `config:"LLM_EMBEDDING_MODEL"`
+	SecondaryModel string                   `config:"LLM_EMBEDDING_SECONDARY_MODEL" default:""`
 }
 
 // InitDeleter initializes the Deleter.
@@ -43,9 +88,10 @@ type InitDeleter struct {
 
 // InitUpdater initializes the Updater and registers it in the dependency container.
 type InitUpdater struct {
-	TimeService core.CurrentTimeProvider `resolve:""`
-	Encoder     semantic.Encoder         `resolve:""`
-	Model       string                   `config:"LLM_EMBEDDING_MODEL"`
+	TimeService    core.CurrentTimeProvider `resolve:""`
+	Encoder        semantic.Encoder         `resolve:""`
+	Model          string                   `config:"LLM_EMBEDDING_MODEL"`
+	SecondaryModel string                   `config:"LLM_EMBEDDING_SECONDARY_MODEL" default:""`
 }
 
 // InitUpdateTodo initializes the Update use case and registers it in the dependency container.
@@ -54,6 +100,111 @@ type InitUpdateTodo struct {
 	TodoModifier Updater                `resolve:""`
 }
 
+// InitTimeTracker initializes the TimeTracker use case and registers it in the dependency container.
+type InitTimeTracker struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// InitFocusSessions initializes the FocusSessions use case and registers it in the dependency container.
+type InitFocusSessions struct {
+	Logger       *log.Logger                   `resolve:""`
+	Uow          transaction.UnitOfWork        `resolve:""`
+	TimeTracker  TimeTracker                   `resolve:""`
+	Streams      assistant.ConversationStreams `resolve:""`
+	Notifier     domain.FocusSessionNotifier   `resolve:""`
+	TimeProvider core.CurrentTimeProvider      `resolve:""`
+}
+
+// InitFocusBlocks initializes the FocusBlocks use case and registers it in the dependency container.
+type InitFocusBlocks struct {
+	TodoRepo     domain.Repository           `resolve:""`
+	BlockRepo    domain.FocusBlockRepository `resolve:""`
+	TimeProvider core.CurrentTimeProvider    `resolve:""`
+	StartHour    int                         `config:"FOCUS_WORKDAY_START_HOUR" default:"9"`
+	EndHour      int                         `config:"FOCUS_WORKDAY_END_HOUR" default:"17"`
+}
+
+// InitProjects initializes the Projects use case and registers it in the dependency container.
+type InitProjects struct {
+	ProjectRepo  domain.ProjectRepository `resolve:""`
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	Assistant    assistant.Assistant      `resolve:""`
+	Model        string                   `config:"LLM_SUMMARY_MODEL"`
+}
+
+// InitArchive initializes the Archive use case and registers it in the dependency container.
+type InitArchive struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+	AfterDays    int                      `config:"TODO_ARCHIVE_AFTER_DAYS" default:"30"`
+}
+
+// InitBackfillEmbeddings initializes the BackfillEmbeddings use case and registers it in the dependency container.
+type InitBackfillEmbeddings struct {
+	TodoRepo       domain.Repository                  `resolve:""`
+	BackfillRepo   domain.EmbeddingBackfillRepository `resolve:""`
+	Encoder        semantic.Encoder                   `resolve:""`
+	TimeProvider   core.CurrentTimeProvider           `resolve:""`
+	Model          string                             `config:"LLM_EMBEDDING_MODEL"`
+	SecondaryModel string                             `config:"LLM_EMBEDDING_SECONDARY_MODEL" default:""`
+}
+
+// InitCustomFields initializes the CustomFields use case and registers it in the dependency container.
+type InitCustomFields struct {
+	CustomFieldRepo domain.CustomFieldRepository `resolve:""`
+	Uow             transaction.UnitOfWork       `resolve:""`
+	TimeProvider    core.CurrentTimeProvider     `resolve:""`
+}
+
+// InitListChanges initializes the ListChanges use case and registers it in the dependency container.
+type InitListChanges struct {
+	ChangeRepo domain.ChangeRepository `resolve:""`
+}
+
+// InitComments initializes the Comments use case and registers it in the dependency container.
+type InitComments struct {
+	Uow          transaction.UnitOfWork   `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// InitViews initializes the Views use case and registers it in the dependency container.
+type InitViews struct {
+	ViewRepo     domain.ViewRepository    `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// InitTemplates initializes the Templates use case and registers it in the dependency container.
+type InitTemplates struct {
+	TemplateRepo domain.TemplateRepository `resolve:""`
+	Uow          transaction.UnitOfWork    `resolve:""`
+	Creator      Creator                   `resolve:""`
+	TimeProvider core.CurrentTimeProvider  `resolve:""`
+}
+
+// InitScheduleNextOccurrence initializes the ScheduleNextOccurrence use case and registers it in the dependency
+// container.
+type InitScheduleNextOccurrence struct {
+	Locker       core.Locker              `resolve:""`
+	Uow          transaction.UnitOfWork   `resolve:""`
+	Creator      Creator                  `resolve:""`
+	TimeProvider core.CurrentTimeProvider `resolve:""`
+}
+
+// InitSnapshots initializes the Snapshots use case and registers it in the dependency container.
+type InitSnapshots struct {
+	List         List                           `resolve:""`
+	SummaryRepo  domain.BoardSummaryRepository  `resolve:""`
+	SnapshotRepo domain.BoardSnapshotRepository `resolve:""`
+	TimeProvider core.CurrentTimeProvider       `resolve:""`
+}
+
+// InitGetTimeReport initializes the GetTimeReport use case and registers it in the dependency container.
+type InitGetTimeReport struct {
+	TimeEntryRepo domain.TimeEntryRepository `resolve:""`
+}
+
 // Initialize registers the Create use case in the dependency container.
 func (ict InitCreateTodo) Initialize(ctx context.Context) (context.Context, error) {
 	uc := NewCreateImpl(ict.Uow, ict.Creator)
@@ -61,6 +212,12 @@ func (ict InitCreateTodo) Initialize(ctx context.Context) (context.Context, erro
 	return ctx, nil
 }
 
+// Initialize registers the QuickAdd use case in the dependency container.
+func (i InitQuickAdd) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[QuickAdd](NewQuickAddImpl(i.CustomFieldRepo, i.Uow, i.Creator, i.TimeProvider, i.Assistant, i.Model))
+	return ctx, nil
+}
+
 // Initialize registers the Delete use case in the dependency container.
 func (i InitDeleteTodo) Initialize(ctx context.Context) (context.Context, error) {
 	uc := NewDelete(i.Uow, i.Deleter)
@@ -68,15 +225,52 @@ func (i InitDeleteTodo) Initialize(ctx context.Context) (context.Context, error)
 	return ctx, nil
 }
 
+// Initialize registers the ApplyChanges use case in the dependency container.
+func (i InitApplyChanges) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ApplyChanges](NewApplyChangesImpl(i.Uow, i.Creator, i.Updater, i.Deleter))
+	return ctx, nil
+}
+
+// Initialize registers the Sync use case in the dependency container.
+func (i InitSync) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Sync](NewSyncImpl(
+		i.Uow,
+		i.Creator,
+		i.Updater,
+		i.Deleter,
+		i.TodoRepo,
+		i.ChangeRepo,
+		i.ConversationRepo,
+		i.ConversationChangeRepo,
+	))
+	return ctx, nil
+}
+
+// Initialize registers the InboundWebhooks use case in the dependency container.
+func (i InitInboundWebhooks) Initialize(ctx context.Context) (context.Context, error) {
+	secrets, err := ParseWebhookSecrets(i.Secrets)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to parse inbound webhook secrets: %w", err)
+	}
+	depend.Register[InboundWebhooks](NewInboundWebhooksImpl(
+		i.Uow,
+		i.Creator,
+		i.TimeProvider,
+		secrets,
+		DefaultWebhookTemplates,
+	))
+	return ctx, nil
+}
+
 // Initialize registers the List use case in the dependency container.
 func (ilt InitListTodos) Initialize(ctx context.Context) (context.Context, error) {
-	depend.Register[List](NewListImpl(ilt.TodoRepo, ilt.Encoder, ilt.EmbeddingModel))
+	depend.Register[List](NewListImpl(ilt.TodoRepo, ilt.CustomFieldRepo, ilt.Encoder, ilt.TimeProvider, ilt.EmbeddingModel))
 	return ctx, nil
 }
 
 // Initialize registers the Creator in the dependency container.
 func (ict InitCreator) Initialize(ctx context.Context) (context.Context, error) {
-	uc := NewCreatorImpl(ict.TimeService, ict.Encoder, ict.Model)
+	uc := NewCreatorImpl(ict.TimeService, ict.Encoder, ict.Model, ict.SecondaryModel)
 	depend.Register[Creator](uc)
 	return ctx, nil
 }
@@ -93,6 +287,7 @@ func (itu InitUpdater) Initialize(ctx context.Context) (context.Context, error)
 		itu.TimeService,
 		itu.Encoder,
 		itu.Model,
+		itu.SecondaryModel,
 	)
 	depend.Register[Updater](todoUpdater)
 	return ctx, nil
@@ -104,3 +299,108 @@ func (iut InitUpdateTodo) Initialize(ctx context.Context) (context.Context, erro
 	depend.Register[Update](uc)
 	return ctx, nil
 }
+
+// Initialize registers the TimeTracker use case in the dependency container.
+func (i InitTimeTracker) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[TimeTracker](NewTimeTrackerImpl(i.Uow, i.TimeProvider))
+	return ctx, nil
+}
+
+// Initialize registers the FocusSessions use case in the dependency container.
+func (i InitFocusSessions) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[FocusSessions](NewFocusSessionsImpl(
+		i.Logger,
+		i.Uow,
+		i.TimeTracker,
+		i.Streams,
+		i.Notifier,
+		i.TimeProvider,
+	))
+	return ctx, nil
+}
+
+// Initialize registers the FocusBlocks use case in the dependency container.
+func (i InitFocusBlocks) Initialize(ctx context.Context) (context.Context, error) {
+	hours := domain.WorkHours{StartHour: i.StartHour, EndHour: i.EndHour}
+	if err := hours.Validate(); err != nil {
+		return ctx, fmt.Errorf("invalid FOCUS_WORKDAY_START_HOUR/FOCUS_WORKDAY_END_HOUR: %w", err)
+	}
+	depend.Register[FocusBlocks](NewFocusBlocksImpl(i.TodoRepo, i.BlockRepo, i.TimeProvider, hours))
+	return ctx, nil
+}
+
+// Initialize registers the Projects use case in the dependency container.
+func (i InitProjects) Initialize(ctx context.Context) (context.Context, error) {
+	// The locker is optional: deployables without the project suggester do not register one.
+	locker, _ := depend.Resolve[core.Locker]()
+	depend.Register[Projects](NewProjectsImpl(i.ProjectRepo, i.Uow, i.TimeProvider, locker, i.Assistant, i.Model))
+	return ctx, nil
+}
+
+// Initialize registers the Archive use case in the dependency container.
+func (i InitArchive) Initialize(ctx context.Context) (context.Context, error) {
+	if i.AfterDays < 1 {
+		return ctx, fmt.Errorf("invalid TODO_ARCHIVE_AFTER_DAYS: must be at least 1, got %d", i.AfterDays)
+	}
+	// The locker is optional: deployables without the todo archiver do not register one.
+	locker, _ := depend.Resolve[core.Locker]()
+	depend.Register[Archive](NewArchiveImpl(i.Uow, i.TimeProvider, locker, time.Duration(i.AfterDays)*24*time.Hour))
+	return ctx, nil
+}
+
+// Initialize registers the BackfillEmbeddings use case in the dependency container.
+func (i InitBackfillEmbeddings) Initialize(ctx context.Context) (context.Context, error) {
+	if i.SecondaryModel != "" && i.SecondaryModel == i.Model {
+		return ctx, fmt.Errorf("invalid LLM_EMBEDDING_SECONDARY_MODEL: must differ from LLM_EMBEDDING_MODEL")
+	}
+	depend.Register[BackfillEmbeddings](NewBackfillEmbeddingsImpl(i.TodoRepo, i.BackfillRepo, i.Encoder, i.TimeProvider, i.Model, i.SecondaryModel))
+	return ctx, nil
+}
+
+// Initialize registers the CustomFields use case in the dependency container.
+func (i InitCustomFields) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[CustomFields](NewCustomFieldsImpl(i.CustomFieldRepo, i.Uow, i.TimeProvider))
+	return ctx, nil
+}
+
+// Initialize registers the GetTimeReport use case in the dependency container.
+func (i InitGetTimeReport) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[GetTimeReport](NewGetTimeReportImpl(i.TimeEntryRepo))
+	return ctx, nil
+}
+
+// Initialize registers the ListChanges use case in the dependency container.
+func (i InitListChanges) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ListChanges](NewListChangesImpl(i.ChangeRepo))
+	return ctx, nil
+}
+
+// Initialize registers the Comments use case in the dependency container.
+func (i InitComments) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Comments](NewCommentsImpl(i.Uow, i.TimeProvider))
+	return ctx, nil
+}
+
+// Initialize registers the Views use case in the dependency container.
+func (i InitViews) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Views](NewViewsImpl(i.ViewRepo, i.TimeProvider))
+	return ctx, nil
+}
+
+// Initialize registers the Templates use case in the dependency container.
+func (i InitTemplates) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Templates](NewTemplatesImpl(i.TemplateRepo, i.Uow, i.Creator, i.TimeProvider))
+	return ctx, nil
+}
+
+// Initialize registers the Snapshots use case in the dependency container.
+func (i InitSnapshots) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[Snapshots](NewSnapshotsImpl(i.List, i.SummaryRepo, i.SnapshotRepo, i.TimeProvider))
+	return ctx, nil
+}
+
+// Initialize registers the ScheduleNextOccurrence use case in the dependency container.
+func (i InitScheduleNextOccurrence) Initialize(ctx context.Context) (context.Context, error) {
+	depend.Register[ScheduleNextOccurrence](NewScheduleNextOccurrenceImpl(i.Locker, i.Uow, i.Creator, i.TimeProvider))
+	return ctx, nil
+}
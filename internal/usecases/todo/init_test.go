@@ -22,6 +22,20 @@ func TestInitCreateTodo_Initialize(t *testing.T) {
 	assert.NotNil(t, registeredCreateTodo)
 }
 
+func TestInitQuickAdd_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitQuickAdd{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[QuickAdd]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
 func TestInitDeleteTodo_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +50,34 @@ func TestInitDeleteTodo_Initialize(t *testing.T) {
 	assert.NotNil(t, registeredDeleteTodo)
 }
 
+func TestInitApplyChanges_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitApplyChanges{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[ApplyChanges]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitSync_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitSync{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Sync]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
 func TestInitListTodos_Initialize(t *testing.T) {
 	t.Parallel()
 
@@ -109,3 +151,244 @@ func TestInitUpdateTodo_Initialize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, registeredUpdateTodo)
 }
+
+func TestInitTimeTracker_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitTimeTracker{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[TimeTracker]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitFocusSessions_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitFocusSessions{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[FocusSessions]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitGetTimeReport_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitGetTimeReport{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[GetTimeReport]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitFocusBlocks_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitFocusBlocks{StartHour: 9, EndHour: 17}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[FocusBlocks]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+
+	_, err = InitFocusBlocks{StartHour: 17, EndHour: 9}.Initialize(t.Context())
+	assert.EqualError(t, err, "invalid FOCUS_WORKDAY_START_HOUR/FOCUS_WORKDAY_END_HOUR: work hours must start before they end, between 0 and 24")
+}
+
+func TestInitProjects_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitProjects{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Projects]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitArchive_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitArchive{AfterDays: 30}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Archive]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+
+	_, err = InitArchive{AfterDays: 0}.Initialize(t.Context())
+	assert.EqualError(t, err, "invalid TODO_ARCHIVE_AFTER_DAYS: must be at least 1, got 0")
+}
+
+func TestInitBackfillEmbeddings_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitBackfillEmbeddings{Model: "model-name"}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[BackfillEmbeddings]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+
+	_, err = InitBackfillEmbeddings{Model: "model-name", SecondaryModel: "model-name"}.Initialize(t.Context())
+	assert.EqualError(t, err, "invalid LLM_EMBEDDING_SECONDARY_MODEL: must differ from LLM_EMBEDDING_MODEL")
+}
+
+func TestInitCustomFields_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitCustomFields{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[CustomFields]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitComments_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitComments{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Comments]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitInboundWebhooks_Initialize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		secrets     string
+		expectedErr bool
+	}{
+		"valid-secrets": {
+			secrets: "github=s3cr3t, zapier=0th3r",
+		},
+		"no-secrets": {},
+		"invalid-secrets": {
+			secrets:     "github",
+			expectedErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			i := InitInboundWebhooks{Secrets: tt.secrets}
+
+			ctx, err := i.Initialize(t.Context())
+			assert.NotNil(t, ctx)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			registered, err := depend.Resolve[InboundWebhooks]()
+			assert.NoError(t, err)
+			assert.NotNil(t, registered)
+		})
+	}
+}
+
+func TestInitListChanges_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitListChanges{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[ListChanges]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitViews_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitViews{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Views]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitTemplates_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitTemplates{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Templates]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitScheduleNextOccurrence_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitScheduleNextOccurrence{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[ScheduleNextOccurrence]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
+
+func TestInitSnapshots_Initialize(t *testing.T) {
+	t.Parallel()
+
+	i := InitSnapshots{}
+
+	ctx, err := i.Initialize(t.Context())
+	assert.NoError(t, err)
+	assert.NotNil(t, ctx)
+
+	registered, err := depend.Resolve[Snapshots]()
+	assert.NoError(t, err)
+	assert.NotNil(t, registered)
+}
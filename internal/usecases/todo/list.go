@@ -2,9 +2,13 @@ package todo
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
@@ -29,6 +33,20 @@ type ListParams struct {
 	DueAfter   *time.Time
 	DueBefore  *time.Time
 	SortBy     *string
+	// IncludeArchived lists archived todos along with the active ones.
+	IncludeArchived bool
+	// CustomFields filters todos by custom field values, keyed by field name, in their text form.
+	CustomFields map[string]string
+	// ExcludedCustomFields drops the todos whose custom field holds any of the values, in their text form.
+	ExcludedCustomFields map[string][]string
+	// NearLat, NearLon and NearRadiusKm keep the todos located within the radius of the point. A nil radius
+	// uses domain.DefaultNearRadiusKm.
+	NearLat      *float64
+	NearLon      *float64
+	NearRadiusKm *float64
+	// Query is a query expression parsed when listing, see domain.ParseQuery. Its terms take precedence
+	// over the other params.
+	Query *string
 }
 
 // ListOptions defines a function type for specifying options when listing todos.
@@ -76,6 +94,94 @@ func WithSortBy(sortBy string) ListOptions {
 	}
 }
 
+// WithIncludeArchived creates a ListOptions to include archived todos.
+func WithIncludeArchived() ListOptions {
+	return func(params *ListParams) {
+		params.IncludeArchived = true
+	}
+}
+
+// WithCustomFieldFilter creates a ListOptions to keep the todos whose custom field holds the given value.
+// The value is given in text form and parsed according to the field type.
+func WithCustomFieldFilter(name, value string) ListOptions {
+	return func(params *ListParams) {
+		if params.CustomFields == nil {
+			params.CustomFields = map[string]string{}
+		}
+		params.CustomFields[name] = value
+	}
+}
+
+// WithoutCustomFieldValue creates a ListOptions to drop the todos whose custom field holds the given value.
+// The value is given in text form and parsed according to the field type.
+func WithoutCustomFieldValue(name, value string) ListOptions {
+	return func(params *ListParams) {
+		if params.ExcludedCustomFields == nil {
+			params.ExcludedCustomFields = map[string][]string{}
+		}
+		params.ExcludedCustomFields[name] = append(params.ExcludedCustomFields[name], value)
+	}
+}
+
+// WithNear creates a ListOptions to keep the todos located within radiusKm of the given coordinates. The
+// coordinates must be given together; a nil radiusKm uses domain.DefaultNearRadiusKm.
+func WithNear(lat, lon, radiusKm *float64) ListOptions {
+	return func(params *ListParams) {
+		params.NearLat = lat
+		params.NearLon = lon
+		params.NearRadiusKm = radiusKm
+	}
+}
+
+// WithQuery creates a ListOptions to filter todos by a query expression, such as
+// `status:open due<today+7 area:work`.
+func WithQuery(expr string) ListOptions {
+	return func(params *ListParams) {
+		params.Query = &expr
+	}
+}
+
+// applyQuery parses the query expression of the params, if any, and applies its terms for the day of now.
+func (p *ListParams) applyQuery(now time.Time) error {
+	if p.Query == nil {
+		return nil
+	}
+	query, err := domain.ParseQuery(*p.Query)
+	if err != nil {
+		return err
+	}
+
+	if query.Status != nil {
+		p.Status = query.Status
+	}
+	if query.TitleContains != nil {
+		WithSearchQuery(*query.TitleContains)(p)
+		WithSearchType(SearchType_Title)(p)
+	}
+	if query.Similar != nil {
+		WithSearchQuery(*query.Similar)(p)
+		WithSearchType(SearchType_Similarity)(p)
+	}
+	if dueAfter, dueBefore, ok := query.DueRange(now); ok {
+		WithDueDateRange(dueAfter, dueBefore)(p)
+	}
+	if query.SortBy != nil {
+		p.SortBy = query.SortBy
+	}
+	if query.IncludeArchived {
+		p.IncludeArchived = true
+	}
+	for name, value := range query.CustomFields {
+		WithCustomFieldFilter(name, value)(p)
+	}
+	for name, values := range query.ExcludedCustomFields {
+		for _, value := range values {
+			WithoutCustomFieldValue(name, value)(p)
+		}
+	}
+	return nil
+}
+
 // List defines the interface for the list use case.
 type List interface {
 	Query(ctx context.Context, page int, pageSize int, opts ...ListOptions) ([]domain.Todo, bool, error)
@@ -84,15 +190,25 @@ type List interface {
 // ListImpl is the implementation of the list use case.
 type ListImpl struct {
 	todoRepo        domain.Repository
+	customFieldRepo domain.CustomFieldRepository
 	semanticEncoder semantic.Encoder
+	timeProvider    core.CurrentTimeProvider
 	embeddingModel  string
 }
 
 // NewListImpl creates a new instance of ListImpl.
-func NewListImpl(todoRepo domain.Repository, semanticEncoder semantic.Encoder, embeddingModel string) ListImpl {
+func NewListImpl(
+	todoRepo domain.Repository,
+	customFieldRepo domain.CustomFieldRepository,
+	semanticEncoder semantic.Encoder,
+	timeProvider core.CurrentTimeProvider,
+	embeddingModel string,
+) ListImpl {
 	return ListImpl{
 		todoRepo:        todoRepo,
+		customFieldRepo: customFieldRepo,
 		semanticEncoder: semanticEncoder,
+		timeProvider:    timeProvider,
 		embeddingModel:  embeddingModel,
 	}
 }
@@ -106,12 +222,28 @@ func (lti ListImpl) Query(ctx context.Context, page int, pageSize int, opts ...L
 	for _, opt := range opts {
 		opt(&params)
 	}
+	now := lti.timeProvider.Now()
+	if params.Query != nil {
+		if err := params.applyQuery(now); telemetry.IsErrorRecorded(span, err) {
+			return nil, false, err
+		}
+	}
+
+	customFields, excludedCustomFields, err := lti.parseCustomFieldFilters(spanCtx, params.CustomFields, params.ExcludedCustomFields)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, false, err
+	}
 
 	builder := NewSearchBuilder().
 		WithStatus(params.Status).
 		WithDueDateRange(params.DueAfter, params.DueBefore).
 		WithSortBy(params.SortBy).
-		WithSearch(params.Search, params.SearchType)
+		WithSearch(params.Search, params.SearchType).
+		WithIncludeArchived(params.IncludeArchived).
+		WithCustomFields(customFields).
+		WithExcludedCustomFields(excludedCustomFields).
+		WithNear(params.NearLat, params.NearLon, params.NearRadiusKm).
+		WithToday(now)
 
 	buildResult, err := builder.Build(spanCtx, lti.semanticEncoder, lti.embeddingModel)
 	if telemetry.IsErrorRecorded(span, err) {
@@ -127,3 +259,52 @@ func (lti ListImpl) Query(ctx context.Context, page int, pageSize int, opts ...L
 	}
 	return todos, hasMore, nil
 }
+
+// parseCustomFieldFilters converts the text form of the custom field filters and exclusions into typed
+// values, rejecting fields the tenant has not defined.
+func (lti ListImpl) parseCustomFieldFilters(
+	ctx context.Context,
+	filters map[string]string,
+	exclusions map[string][]string,
+) (domain.CustomFieldValues, []domain.CustomFieldValues, error) {
+	if len(filters) == 0 && len(exclusions) == 0 {
+		return nil, nil, nil
+	}
+
+	fields, err := lti.customFieldRepo.ListCustomFields(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parse := func(name, raw string) (any, error) {
+		field, ok := domain.FindCustomField(fields, name)
+		if !ok {
+			return nil, core.NewValidationErr(fmt.Sprintf("unknown custom field: %s", name))
+		}
+		return field.ParseValue(raw)
+	}
+
+	var values domain.CustomFieldValues
+	if len(filters) > 0 {
+		values = make(domain.CustomFieldValues, len(filters))
+		for name, raw := range filters {
+			value, err := parse(name, raw)
+			if err != nil {
+				return nil, nil, err
+			}
+			values[name] = value
+		}
+	}
+
+	var excluded []domain.CustomFieldValues
+	for _, name := range slices.Sorted(maps.Keys(exclusions)) {
+		for _, raw := range exclusions[name] {
+			value, err := parse(name, raw)
+			if err != nil {
+				return nil, nil, err
+			}
+			excluded = append(excluded, domain.CustomFieldValues{name: value})
+		}
+	}
+	return values, excluded, nil
+}
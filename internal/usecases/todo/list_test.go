@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
@@ -22,6 +23,7 @@ func TestListImpl_Query(t *testing.T) {
 		page            int
 		pageSize        int
 		queryParams     []ListOptions
+		customFields    []domain.CustomField
 		expectedTodos   []domain.Todo
 		expectedHasMore bool
 		expectedErr     error
@@ -141,6 +143,30 @@ func TestListImpl_Query(t *testing.T) {
 			expectedHasMore: false,
 			expectedErr:     nil,
 		},
+		"success-with-near-filter": {
+			page:     1,
+			pageSize: 10,
+			queryParams: []ListOptions{
+				WithNear(common.Ptr(38.7223), common.Ptr(-9.1393), common.Ptr(3.5)),
+			},
+			setExpectations: func(repo *domain.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Run(func(ctx context.Context, page int, pageSize int, opts ...domain.ListOption) {
+						var params domain.ListParams
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, &domain.NearFilter{
+							Point:    domain.GeoPoint{Latitude: 38.7223, Longitude: -9.1393},
+							RadiusKm: 3.5,
+						}, params.Near)
+					}).
+					Return([]domain.Todo{}, false, nil)
+			},
+			expectedTodos:   []domain.Todo{},
+			expectedHasMore: false,
+			expectedErr:     nil,
+		},
 		"sort-by-created-at-desc": {
 			page:     1,
 			pageSize: 10,
@@ -200,6 +226,75 @@ func TestListImpl_Query(t *testing.T) {
 			expectedHasMore: false,
 			expectedErr:     errors.New("database error"),
 		},
+		"success-with-custom-field-filter": {
+			page:     1,
+			pageSize: 10,
+			queryParams: []ListOptions{
+				WithCustomFieldFilter("points", "3"),
+			},
+			customFields: []domain.CustomField{{Name: "points", Type: domain.CustomFieldType_NUMBER}},
+			setExpectations: func(repo *domain.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Run(func(ctx context.Context, page int, pageSize int, opts ...domain.ListOption) {
+						var params domain.ListParams
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, domain.CustomFieldValues{"points": float64(3)}, params.CustomFields)
+					}).
+					Return(nil, false, nil)
+			},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     nil,
+		},
+		"success-with-query": {
+			page:     1,
+			pageSize: 10,
+			queryParams: []ListOptions{
+				WithStatus(domain.Status_DONE),
+				WithQuery("status:open due<=today+7 area:work -area:errands milk"),
+			},
+			customFields: []domain.CustomField{{Name: "area", Type: domain.CustomFieldType_ENUM, Options: []string{"errands", "work"}}},
+			setExpectations: func(repo *domain.MockRepository, semanticEncoder *semantic.MockEncoder) {
+				repo.EXPECT().ListTodos(mock.Anything, 1, 10, mock.Anything).
+					Run(func(ctx context.Context, page int, pageSize int, opts ...domain.ListOption) {
+						var params domain.ListParams
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, domain.Status_OPEN, *params.Status)
+						assert.Equal(t, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), *params.DueAfter)
+						assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), *params.DueBefore)
+						assert.Equal(t, "milk", *params.TitleContains)
+						assert.Equal(t, domain.CustomFieldValues{"area": "work"}, params.CustomFields)
+						assert.Equal(t, []domain.CustomFieldValues{{"area": "errands"}}, params.ExcludedCustomFields)
+					}).
+					Return(nil, false, nil)
+			},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     nil,
+		},
+		"error-invalid-query": {
+			page:            1,
+			pageSize:        10,
+			queryParams:     []ListOptions{WithQuery("status:later")},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     core.NewValidationErr("status must be either OPEN or DONE"),
+		},
+		"error-unknown-custom-field-filter": {
+			page:     1,
+			pageSize: 10,
+			queryParams: []ListOptions{
+				WithCustomFieldFilter("sprint", "S1"),
+			},
+			customFields:    []domain.CustomField{{Name: "points", Type: domain.CustomFieldType_NUMBER}},
+			expectedTodos:   nil,
+			expectedHasMore: false,
+			expectedErr:     core.NewValidationErr("unknown custom field: sprint"),
+		},
 	}
 
 	for name, tt := range tests {
@@ -210,7 +305,15 @@ func TestListImpl_Query(t *testing.T) {
 				tt.setExpectations(repo, semanticEncoder)
 			}
 
-			lti := NewListImpl(repo, semanticEncoder, "test-model")
+			customFieldRepo := domain.NewMockCustomFieldRepository(t)
+			if tt.customFields != nil {
+				customFieldRepo.EXPECT().ListCustomFields(mock.Anything).Return(tt.customFields, nil).Once()
+			}
+
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(time.Date(2026, 3, 4, 15, 30, 0, 0, time.UTC)).Maybe()
+
+			lti := NewListImpl(repo, customFieldRepo, semanticEncoder, timeProvider, "test-model")
 
 			got, hasMore, gotErr := lti.Query(t.Context(), tt.page, tt.pageSize, tt.queryParams...)
 			assert.Equal(t, tt.expectedErr, gotErr)
@@ -8,19 +8,20 @@ import (
 	"context"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockCreate creates a new instance of MockCreate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockApplyChanges creates a new instance of MockApplyChanges. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCreate(t interface {
+func NewMockApplyChanges(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCreate {
-	mock := &MockCreate{}
+}) *MockApplyChanges {
+	mock := &MockApplyChanges{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -28,98 +29,94 @@ func NewMockCreate(t interface {
 	return mock
 }
 
-// MockCreate is an autogenerated mock type for the Create type
-type MockCreate struct {
+// MockApplyChanges is an autogenerated mock type for the ApplyChanges type
+type MockApplyChanges struct {
 	mock.Mock
 }
 
-type MockCreate_Expecter struct {
+type MockApplyChanges_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCreate) EXPECT() *MockCreate_Expecter {
-	return &MockCreate_Expecter{mock: &_m.Mock}
+func (_m *MockApplyChanges) EXPECT() *MockApplyChanges_Expecter {
+	return &MockApplyChanges_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function for the type MockCreate
-func (_mock *MockCreate) Execute(ctx context.Context, title string, dueDate time.Time) (todo.Todo, error) {
-	ret := _mock.Called(ctx, title, dueDate)
+// Execute provides a mock function for the type MockApplyChanges
+func (_mock *MockApplyChanges) Execute(ctx context.Context, operations []TodoOperation) ([]TodoOperationResult, error) {
+	ret := _mock.Called(ctx, operations)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 todo.Todo
+	var r0 []TodoOperationResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (todo.Todo, error)); ok {
-		return returnFunc(ctx, title, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []TodoOperation) ([]TodoOperationResult, error)); ok {
+		return returnFunc(ctx, operations)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) todo.Todo); ok {
-		r0 = returnFunc(ctx, title, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []TodoOperation) []TodoOperationResult); ok {
+		r0 = returnFunc(ctx, operations)
 	} else {
-		r0 = ret.Get(0).(todo.Todo)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TodoOperationResult)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
-		r1 = returnFunc(ctx, title, dueDate)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []TodoOperation) error); ok {
+		r1 = returnFunc(ctx, operations)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCreate_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockCreate_Execute_Call struct {
+// MockApplyChanges_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockApplyChanges_Execute_Call struct {
 	*mock.Call
 }
 
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - title string
-//   - dueDate time.Time
-func (_e *MockCreate_Expecter) Execute(ctx interface{}, title interface{}, dueDate interface{}) *MockCreate_Execute_Call {
-	return &MockCreate_Execute_Call{Call: _e.mock.On("Execute", ctx, title, dueDate)}
+//   - operations []TodoOperation
+func (_e *MockApplyChanges_Expecter) Execute(ctx interface{}, operations interface{}) *MockApplyChanges_Execute_Call {
+	return &MockApplyChanges_Execute_Call{Call: _e.mock.On("Execute", ctx, operations)}
 }
 
-func (_c *MockCreate_Execute_Call) Run(run func(ctx context.Context, title string, dueDate time.Time)) *MockCreate_Execute_Call {
+func (_c *MockApplyChanges_Execute_Call) Run(run func(ctx context.Context, operations []TodoOperation)) *MockApplyChanges_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 []TodoOperation
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 time.Time
-		if args[2] != nil {
-			arg2 = args[2].(time.Time)
+			arg1 = args[1].([]TodoOperation)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCreate_Execute_Call) Return(todo1 todo.Todo, err error) *MockCreate_Execute_Call {
-	_c.Call.Return(todo1, err)
+func (_c *MockApplyChanges_Execute_Call) Return(todoOperationResults []TodoOperationResult, err error) *MockApplyChanges_Execute_Call {
+	_c.Call.Return(todoOperationResults, err)
 	return _c
 }
 
-func (_c *MockCreate_Execute_Call) RunAndReturn(run func(ctx context.Context, title string, dueDate time.Time) (todo.Todo, error)) *MockCreate_Execute_Call {
+func (_c *MockApplyChanges_Execute_Call) RunAndReturn(run func(ctx context.Context, operations []TodoOperation) ([]TodoOperationResult, error)) *MockApplyChanges_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockCreator creates a new instance of MockCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockArchive creates a new instance of MockArchive. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockCreator(t interface {
+func NewMockArchive(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockCreator {
-	mock := &MockCreator{}
+}) *MockArchive {
+	mock := &MockArchive{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -127,154 +124,118 @@ func NewMockCreator(t interface {
 	return mock
 }
 
-// MockCreator is an autogenerated mock type for the Creator type
-type MockCreator struct {
+// MockArchive is an autogenerated mock type for the Archive type
+type MockArchive struct {
 	mock.Mock
 }
 
-type MockCreator_Expecter struct {
+type MockArchive_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockCreator) EXPECT() *MockCreator_Expecter {
-	return &MockCreator_Expecter{mock: &_m.Mock}
+func (_m *MockArchive) EXPECT() *MockArchive_Expecter {
+	return &MockArchive_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockCreator
-func (_mock *MockCreator) Create(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time) (todo.Todo, error) {
-	ret := _mock.Called(ctx, scope, title, dueDate)
+// ArchiveCompleted provides a mock function for the type MockArchive
+func (_mock *MockArchive) ArchiveCompleted(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for ArchiveCompleted")
 	}
 
-	var r0 todo.Todo
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, string, time.Time) (todo.Todo, error)); ok {
-		return returnFunc(ctx, scope, title, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, string, time.Time) todo.Todo); ok {
-		r0 = returnFunc(ctx, scope, title, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Get(0).(todo.Todo)
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, transaction.Scope, string, time.Time) error); ok {
-		r1 = returnFunc(ctx, scope, title, dueDate)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockCreator_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockCreator_Create_Call struct {
+// MockArchive_ArchiveCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveCompleted'
+type MockArchive_ArchiveCompleted_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// ArchiveCompleted is a helper method to define mock.On call
 //   - ctx context.Context
-//   - scope transaction.Scope
-//   - title string
-//   - dueDate time.Time
-func (_e *MockCreator_Expecter) Create(ctx interface{}, scope interface{}, title interface{}, dueDate interface{}) *MockCreator_Create_Call {
-	return &MockCreator_Create_Call{Call: _e.mock.On("Create", ctx, scope, title, dueDate)}
+func (_e *MockArchive_Expecter) ArchiveCompleted(ctx interface{}) *MockArchive_ArchiveCompleted_Call {
+	return &MockArchive_ArchiveCompleted_Call{Call: _e.mock.On("ArchiveCompleted", ctx)}
 }
 
-func (_c *MockCreator_Create_Call) Run(run func(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time)) *MockCreator_Create_Call {
+func (_c *MockArchive_ArchiveCompleted_Call) Run(run func(ctx context.Context)) *MockArchive_ArchiveCompleted_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 transaction.Scope
-		if args[1] != nil {
-			arg1 = args[1].(transaction.Scope)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 time.Time
-		if args[3] != nil {
-			arg3 = args[3].(time.Time)
-		}
 		run(
 			arg0,
-			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockCreator_Create_Call) Return(todo1 todo.Todo, err error) *MockCreator_Create_Call {
-	_c.Call.Return(todo1, err)
+func (_c *MockArchive_ArchiveCompleted_Call) Return(n int, err error) *MockArchive_ArchiveCompleted_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockCreator_Create_Call) RunAndReturn(run func(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time) (todo.Todo, error)) *MockCreator_Create_Call {
+func (_c *MockArchive_ArchiveCompleted_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockArchive_ArchiveCompleted_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockDelete creates a new instance of MockDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockDelete(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockDelete {
-	mock := &MockDelete{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockDelete is an autogenerated mock type for the Delete type
-type MockDelete struct {
-	mock.Mock
-}
-
-type MockDelete_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockDelete) EXPECT() *MockDelete_Expecter {
-	return &MockDelete_Expecter{mock: &_m.Mock}
-}
-
-// Execute provides a mock function for the type MockDelete
-func (_mock *MockDelete) Execute(ctx context.Context, id uuid.UUID) error {
+// Restore provides a mock function for the type MockArchive
+func (_mock *MockArchive) Restore(ctx context.Context, id uuid.UUID) (todo.Todo, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Execute")
+		panic("no return value specified for Restore")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+	var r0 todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (todo.Todo, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) todo.Todo); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(todo.Todo)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockDelete_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
-type MockDelete_Execute_Call struct {
+// MockArchive_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockArchive_Restore_Call struct {
 	*mock.Call
 }
 
-// Execute is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockDelete_Expecter) Execute(ctx interface{}, id interface{}) *MockDelete_Execute_Call {
-	return &MockDelete_Execute_Call{Call: _e.mock.On("Execute", ctx, id)}
+func (_e *MockArchive_Expecter) Restore(ctx interface{}, id interface{}) *MockArchive_Restore_Call {
+	return &MockArchive_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
 }
 
-func (_c *MockDelete_Execute_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockDelete_Execute_Call {
+func (_c *MockArchive_Restore_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockArchive_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -292,23 +253,23 @@ func (_c *MockDelete_Execute_Call) Run(run func(ctx context.Context, id uuid.UUI
 	return _c
 }
 
-func (_c *MockDelete_Execute_Call) Return(err error) *MockDelete_Execute_Call {
-	_c.Call.Return(err)
+func (_c *MockArchive_Restore_Call) Return(todo1 todo.Todo, err error) *MockArchive_Restore_Call {
+	_c.Call.Return(todo1, err)
 	return _c
 }
 
-func (_c *MockDelete_Execute_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockDelete_Execute_Call {
+func (_c *MockArchive_Restore_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (todo.Todo, error)) *MockArchive_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockDeleter creates a new instance of MockDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockListChanges creates a new instance of MockListChanges. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockDeleter(t interface {
+func NewMockListChanges(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockDeleter {
-	mock := &MockDeleter{}
+}) *MockListChanges {
+	mock := &MockListChanges{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -316,89 +277,112 @@ func NewMockDeleter(t interface {
 	return mock
 }
 
-// MockDeleter is an autogenerated mock type for the Deleter type
-type MockDeleter struct {
+// MockListChanges is an autogenerated mock type for the ListChanges type
+type MockListChanges struct {
 	mock.Mock
 }
 
-type MockDeleter_Expecter struct {
+type MockListChanges_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockDeleter) EXPECT() *MockDeleter_Expecter {
-	return &MockDeleter_Expecter{mock: &_m.Mock}
+func (_m *MockListChanges) EXPECT() *MockListChanges_Expecter {
+	return &MockListChanges_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function for the type MockDeleter
-func (_mock *MockDeleter) Delete(ctx context.Context, scope transaction.Scope, id uuid.UUID) error {
-	ret := _mock.Called(ctx, scope, id)
+// Query provides a mock function for the type MockListChanges
+func (_mock *MockListChanges) Query(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]todo.Change, bool, error) {
+	ret := _mock.Called(ctx, since, conversationID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Query")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, scope, id)
+	var r0 []todo.Change
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *uuid.UUID, int) ([]todo.Change, bool, error)); ok {
+		return returnFunc(ctx, since, conversationID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *uuid.UUID, int) []todo.Change); ok {
+		r0 = returnFunc(ctx, since, conversationID, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Change)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *uuid.UUID, int) bool); ok {
+		r1 = returnFunc(ctx, since, conversationID, limit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *uuid.UUID, int) error); ok {
+		r2 = returnFunc(ctx, since, conversationID, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockDeleter_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockDeleter_Delete_Call struct {
+// MockListChanges_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockListChanges_Query_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Query is a helper method to define mock.On call
 //   - ctx context.Context
-//   - scope transaction.Scope
-//   - id uuid.UUID
-func (_e *MockDeleter_Expecter) Delete(ctx interface{}, scope interface{}, id interface{}) *MockDeleter_Delete_Call {
-	return &MockDeleter_Delete_Call{Call: _e.mock.On("Delete", ctx, scope, id)}
+//   - since int64
+//   - conversationID *uuid.UUID
+//   - limit int
+func (_e *MockListChanges_Expecter) Query(ctx interface{}, since interface{}, conversationID interface{}, limit interface{}) *MockListChanges_Query_Call {
+	return &MockListChanges_Query_Call{Call: _e.mock.On("Query", ctx, since, conversationID, limit)}
 }
 
-func (_c *MockDeleter_Delete_Call) Run(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID)) *MockDeleter_Delete_Call {
+func (_c *MockListChanges_Query_Call) Run(run func(ctx context.Context, since int64, conversationID *uuid.UUID, limit int)) *MockListChanges_Query_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 transaction.Scope
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(transaction.Scope)
+			arg1 = args[1].(int64)
 		}
-		var arg2 uuid.UUID
+		var arg2 *uuid.UUID
 		if args[2] != nil {
-			arg2 = args[2].(uuid.UUID)
+			arg2 = args[2].(*uuid.UUID)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockDeleter_Delete_Call) Return(err error) *MockDeleter_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockListChanges_Query_Call) Return(changes []todo.Change, b bool, err error) *MockListChanges_Query_Call {
+	_c.Call.Return(changes, b, err)
 	return _c
 }
 
-func (_c *MockDeleter_Delete_Call) RunAndReturn(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID) error) *MockDeleter_Delete_Call {
+func (_c *MockListChanges_Query_Call) RunAndReturn(run func(ctx context.Context, since int64, conversationID *uuid.UUID, limit int) ([]todo.Change, bool, error)) *MockListChanges_Query_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockList creates a new instance of MockList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockComments creates a new instance of MockComments. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockList(t interface {
+func NewMockComments(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockList {
-	mock := &MockList{}
+}) *MockComments {
+	mock := &MockComments{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -406,134 +390,3272 @@ func NewMockList(t interface {
 	return mock
 }
 
-// MockList is an autogenerated mock type for the List type
-type MockList struct {
+// MockComments is an autogenerated mock type for the Comments type
+type MockComments struct {
 	mock.Mock
 }
 
-type MockList_Expecter struct {
+type MockComments_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockList) EXPECT() *MockList_Expecter {
-	return &MockList_Expecter{mock: &_m.Mock}
+func (_m *MockComments) EXPECT() *MockComments_Expecter {
+	return &MockComments_Expecter{mock: &_m.Mock}
 }
 
-// Query provides a mock function for the type MockList
-func (_mock *MockList) Query(ctx context.Context, page int, pageSize int, opts ...ListOptions) ([]todo.Todo, bool, error) {
-	var tmpRet mock.Arguments
-	if len(opts) > 0 {
-		tmpRet = _mock.Called(ctx, page, pageSize, opts)
-	} else {
-		tmpRet = _mock.Called(ctx, page, pageSize)
-	}
-	ret := tmpRet
+// Add provides a mock function for the type MockComments
+func (_mock *MockComments) Add(ctx context.Context, todoID uuid.UUID, author todo.CommentAuthor, body string) (todo.Comment, error) {
+	ret := _mock.Called(ctx, todoID, author, body)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Query")
+		panic("no return value specified for Add")
 	}
 
-	var r0 []todo.Todo
-	var r1 bool
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOptions) ([]todo.Todo, bool, error)); ok {
-		return returnFunc(ctx, page, pageSize, opts...)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOptions) []todo.Todo); ok {
-		r0 = returnFunc(ctx, page, pageSize, opts...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]todo.Todo)
-		}
+	var r0 todo.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, todo.CommentAuthor, string) (todo.Comment, error)); ok {
+		return returnFunc(ctx, todoID, author, body)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, ...ListOptions) bool); ok {
-		r1 = returnFunc(ctx, page, pageSize, opts...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, todo.CommentAuthor, string) todo.Comment); ok {
+		r0 = returnFunc(ctx, todoID, author, body)
 	} else {
-		r1 = ret.Get(1).(bool)
+		r0 = ret.Get(0).(todo.Comment)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int, ...ListOptions) error); ok {
-		r2 = returnFunc(ctx, page, pageSize, opts...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, todo.CommentAuthor, string) error); ok {
+		r1 = returnFunc(ctx, todoID, author, body)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockList_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockList_Query_Call struct {
+// MockComments_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockComments_Add_Call struct {
 	*mock.Call
 }
 
-// Query is a helper method to define mock.On call
+// Add is a helper method to define mock.On call
 //   - ctx context.Context
-//   - page int
-//   - pageSize int
-//   - opts ...ListOptions
-func (_e *MockList_Expecter) Query(ctx interface{}, page interface{}, pageSize interface{}, opts ...interface{}) *MockList_Query_Call {
-	return &MockList_Query_Call{Call: _e.mock.On("Query",
-		append([]interface{}{ctx, page, pageSize}, opts...)...)}
+//   - todoID uuid.UUID
+//   - author todo.CommentAuthor
+//   - body string
+func (_e *MockComments_Expecter) Add(ctx interface{}, todoID interface{}, author interface{}, body interface{}) *MockComments_Add_Call {
+	return &MockComments_Add_Call{Call: _e.mock.On("Add", ctx, todoID, author, body)}
 }
 
-func (_c *MockList_Query_Call) Run(run func(ctx context.Context, page int, pageSize int, opts ...ListOptions)) *MockList_Query_Call {
+func (_c *MockComments_Add_Call) Run(run func(ctx context.Context, todoID uuid.UUID, author todo.CommentAuthor, body string)) *MockComments_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 int
+		var arg2 todo.CommentAuthor
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(todo.CommentAuthor)
 		}
-		var arg3 []ListOptions
-		var variadicArgs []ListOptions
-		if len(args) > 3 {
-			variadicArgs = args[3].([]ListOptions)
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
-		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3...,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockList_Query_Call) Return(todos []todo.Todo, b bool, err error) *MockList_Query_Call {
-	_c.Call.Return(todos, b, err)
+func (_c *MockComments_Add_Call) Return(comment todo.Comment, err error) *MockComments_Add_Call {
+	_c.Call.Return(comment, err)
 	return _c
 }
 
-func (_c *MockList_Query_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int, opts ...ListOptions) ([]todo.Todo, bool, error)) *MockList_Query_Call {
+func (_c *MockComments_Add_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, author todo.CommentAuthor, body string) (todo.Comment, error)) *MockComments_Add_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUpdate creates a new instance of MockUpdate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUpdate(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUpdate {
-	mock := &MockUpdate{}
-	mock.Mock.Test(t)
+// Delete provides a mock function for the type MockComments
+func (_mock *MockComments) Delete(ctx context.Context, todoID uuid.UUID, commentID uuid.UUID) error {
+	ret := _mock.Called(ctx, todoID, commentID)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
 
-	return mock
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, todoID, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockUpdate is an autogenerated mock type for the Update type
-type MockUpdate struct {
-	mock.Mock
+// MockComments_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockComments_Delete_Call struct {
+	*mock.Call
 }
 
-type MockUpdate_Expecter struct {
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+//   - commentID uuid.UUID
+func (_e *MockComments_Expecter) Delete(ctx interface{}, todoID interface{}, commentID interface{}) *MockComments_Delete_Call {
+	return &MockComments_Delete_Call{Call: _e.mock.On("Delete", ctx, todoID, commentID)}
+}
+
+func (_c *MockComments_Delete_Call) Run(run func(ctx context.Context, todoID uuid.UUID, commentID uuid.UUID)) *MockComments_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockComments_Delete_Call) Return(err error) *MockComments_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockComments_Delete_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, commentID uuid.UUID) error) *MockComments_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockComments
+func (_mock *MockComments) List(ctx context.Context, todoID uuid.UUID, page int, pageSize int) ([]todo.Comment, bool, error) {
+	ret := _mock.Called(ctx, todoID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []todo.Comment
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]todo.Comment, bool, error)); ok {
+		return returnFunc(ctx, todoID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []todo.Comment); ok {
+		r0 = returnFunc(ctx, todoID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) bool); ok {
+		r1 = returnFunc(ctx, todoID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, todoID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockComments_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockComments_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+//   - page int
+//   - pageSize int
+func (_e *MockComments_Expecter) List(ctx interface{}, todoID interface{}, page interface{}, pageSize interface{}) *MockComments_List_Call {
+	return &MockComments_List_Call{Call: _e.mock.On("List", ctx, todoID, page, pageSize)}
+}
+
+func (_c *MockComments_List_Call) Run(run func(ctx context.Context, todoID uuid.UUID, page int, pageSize int)) *MockComments_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockComments_List_Call) Return(comments []todo.Comment, b bool, err error) *MockComments_List_Call {
+	_c.Call.Return(comments, b, err)
+	return _c
+}
+
+func (_c *MockComments_List_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, page int, pageSize int) ([]todo.Comment, bool, error)) *MockComments_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockComments
+func (_mock *MockComments) Update(ctx context.Context, todoID uuid.UUID, commentID uuid.UUID, body string) (todo.Comment, error) {
+	ret := _mock.Called(ctx, todoID, commentID, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 todo.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (todo.Comment, error)); ok {
+		return returnFunc(ctx, todoID, commentID, body)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) todo.Comment); ok {
+		r0 = returnFunc(ctx, todoID, commentID, body)
+	} else {
+		r0 = ret.Get(0).(todo.Comment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, todoID, commentID, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockComments_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockComments_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+//   - commentID uuid.UUID
+//   - body string
+func (_e *MockComments_Expecter) Update(ctx interface{}, todoID interface{}, commentID interface{}, body interface{}) *MockComments_Update_Call {
+	return &MockComments_Update_Call{Call: _e.mock.On("Update", ctx, todoID, commentID, body)}
+}
+
+func (_c *MockComments_Update_Call) Run(run func(ctx context.Context, todoID uuid.UUID, commentID uuid.UUID, body string)) *MockComments_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockComments_Update_Call) Return(comment todo.Comment, err error) *MockComments_Update_Call {
+	_c.Call.Return(comment, err)
+	return _c
+}
+
+func (_c *MockComments_Update_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, commentID uuid.UUID, body string) (todo.Comment, error)) *MockComments_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreate creates a new instance of MockCreate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreate {
+	mock := &MockCreate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreate is an autogenerated mock type for the Create type
+type MockCreate struct {
+	mock.Mock
+}
+
+type MockCreate_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreate) EXPECT() *MockCreate_Expecter {
+	return &MockCreate_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockCreate
+func (_mock *MockCreate) Execute(ctx context.Context, title string, dueDate time.Time, estimatedMinutes int, customFields todo.CustomFieldValues, opts ...CreateOption) (todo.Todo, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, title, dueDate, estimatedMinutes, customFields, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, title, dueDate, estimatedMinutes, customFields)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, int, todo.CustomFieldValues, ...CreateOption) (todo.Todo, error)); ok {
+		return returnFunc(ctx, title, dueDate, estimatedMinutes, customFields, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, int, todo.CustomFieldValues, ...CreateOption) todo.Todo); ok {
+		r0 = returnFunc(ctx, title, dueDate, estimatedMinutes, customFields, opts...)
+	} else {
+		r0 = ret.Get(0).(todo.Todo)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, int, todo.CustomFieldValues, ...CreateOption) error); ok {
+		r1 = returnFunc(ctx, title, dueDate, estimatedMinutes, customFields, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreate_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreate_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - title string
+//   - dueDate time.Time
+//   - estimatedMinutes int
+//   - customFields todo.CustomFieldValues
+//   - opts ...CreateOption
+func (_e *MockCreate_Expecter) Execute(ctx interface{}, title interface{}, dueDate interface{}, estimatedMinutes interface{}, customFields interface{}, opts ...interface{}) *MockCreate_Execute_Call {
+	return &MockCreate_Execute_Call{Call: _e.mock.On("Execute",
+		append([]interface{}{ctx, title, dueDate, estimatedMinutes, customFields}, opts...)...)}
+}
+
+func (_c *MockCreate_Execute_Call) Run(run func(ctx context.Context, title string, dueDate time.Time, estimatedMinutes int, customFields todo.CustomFieldValues, opts ...CreateOption)) *MockCreate_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 todo.CustomFieldValues
+		if args[4] != nil {
+			arg4 = args[4].(todo.CustomFieldValues)
+		}
+		var arg5 []CreateOption
+		var variadicArgs []CreateOption
+		if len(args) > 5 {
+			variadicArgs = args[5].([]CreateOption)
+		}
+		arg5 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreate_Execute_Call) Return(todo1 todo.Todo, err error) *MockCreate_Execute_Call {
+	_c.Call.Return(todo1, err)
+	return _c
+}
+
+func (_c *MockCreate_Execute_Call) RunAndReturn(run func(ctx context.Context, title string, dueDate time.Time, estimatedMinutes int, customFields todo.CustomFieldValues, opts ...CreateOption) (todo.Todo, error)) *MockCreate_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreator creates a new instance of MockCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreator {
+	mock := &MockCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCreator is an autogenerated mock type for the Creator type
+type MockCreator struct {
+	mock.Mock
+}
+
+type MockCreator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreator) EXPECT() *MockCreator_Expecter {
+	return &MockCreator_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockCreator
+func (_mock *MockCreator) Create(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time, estimatedMinutes int, customFields todo.CustomFieldValues, opts ...CreateOption) (todo.Todo, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, scope, title, dueDate, estimatedMinutes, customFields, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, scope, title, dueDate, estimatedMinutes, customFields)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, string, time.Time, int, todo.CustomFieldValues, ...CreateOption) (todo.Todo, error)); ok {
+		return returnFunc(ctx, scope, title, dueDate, estimatedMinutes, customFields, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, string, time.Time, int, todo.CustomFieldValues, ...CreateOption) todo.Todo); ok {
+		r0 = returnFunc(ctx, scope, title, dueDate, estimatedMinutes, customFields, opts...)
+	} else {
+		r0 = ret.Get(0).(todo.Todo)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, transaction.Scope, string, time.Time, int, todo.CustomFieldValues, ...CreateOption) error); ok {
+		r1 = returnFunc(ctx, scope, title, dueDate, estimatedMinutes, customFields, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCreator_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCreator_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope transaction.Scope
+//   - title string
+//   - dueDate time.Time
+//   - estimatedMinutes int
+//   - customFields todo.CustomFieldValues
+//   - opts ...CreateOption
+func (_e *MockCreator_Expecter) Create(ctx interface{}, scope interface{}, title interface{}, dueDate interface{}, estimatedMinutes interface{}, customFields interface{}, opts ...interface{}) *MockCreator_Create_Call {
+	return &MockCreator_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, scope, title, dueDate, estimatedMinutes, customFields}, opts...)...)}
+}
+
+func (_c *MockCreator_Create_Call) Run(run func(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time, estimatedMinutes int, customFields todo.CustomFieldValues, opts ...CreateOption)) *MockCreator_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 transaction.Scope
+		if args[1] != nil {
+			arg1 = args[1].(transaction.Scope)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		var arg5 todo.CustomFieldValues
+		if args[5] != nil {
+			arg5 = args[5].(todo.CustomFieldValues)
+		}
+		var arg6 []CreateOption
+		var variadicArgs []CreateOption
+		if len(args) > 6 {
+			variadicArgs = args[6].([]CreateOption)
+		}
+		arg6 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCreator_Create_Call) Return(todo1 todo.Todo, err error) *MockCreator_Create_Call {
+	_c.Call.Return(todo1, err)
+	return _c
+}
+
+func (_c *MockCreator_Create_Call) RunAndReturn(run func(ctx context.Context, scope transaction.Scope, title string, dueDate time.Time, estimatedMinutes int, customFields todo.CustomFieldValues, opts ...CreateOption) (todo.Todo, error)) *MockCreator_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCustomFields creates a new instance of MockCustomFields. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCustomFields(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCustomFields {
+	mock := &MockCustomFields{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCustomFields is an autogenerated mock type for the CustomFields type
+type MockCustomFields struct {
+	mock.Mock
+}
+
+type MockCustomFields_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCustomFields) EXPECT() *MockCustomFields_Expecter {
+	return &MockCustomFields_Expecter{mock: &_m.Mock}
+}
+
+// Define provides a mock function for the type MockCustomFields
+func (_mock *MockCustomFields) Define(ctx context.Context, field todo.CustomField) (todo.CustomField, error) {
+	ret := _mock.Called(ctx, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Define")
+	}
+
+	var r0 todo.CustomField
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.CustomField) (todo.CustomField, error)); ok {
+		return returnFunc(ctx, field)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.CustomField) todo.CustomField); ok {
+		r0 = returnFunc(ctx, field)
+	} else {
+		r0 = ret.Get(0).(todo.CustomField)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.CustomField) error); ok {
+		r1 = returnFunc(ctx, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCustomFields_Define_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Define'
+type MockCustomFields_Define_Call struct {
+	*mock.Call
+}
+
+// Define is a helper method to define mock.On call
+//   - ctx context.Context
+//   - field todo.CustomField
+func (_e *MockCustomFields_Expecter) Define(ctx interface{}, field interface{}) *MockCustomFields_Define_Call {
+	return &MockCustomFields_Define_Call{Call: _e.mock.On("Define", ctx, field)}
+}
+
+func (_c *MockCustomFields_Define_Call) Run(run func(ctx context.Context, field todo.CustomField)) *MockCustomFields_Define_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 todo.CustomField
+		if args[1] != nil {
+			arg1 = args[1].(todo.CustomField)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomFields_Define_Call) Return(customField todo.CustomField, err error) *MockCustomFields_Define_Call {
+	_c.Call.Return(customField, err)
+	return _c
+}
+
+func (_c *MockCustomFields_Define_Call) RunAndReturn(run func(ctx context.Context, field todo.CustomField) (todo.CustomField, error)) *MockCustomFields_Define_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockCustomFields
+func (_mock *MockCustomFields) Delete(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCustomFields_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCustomFields_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockCustomFields_Expecter) Delete(ctx interface{}, name interface{}) *MockCustomFields_Delete_Call {
+	return &MockCustomFields_Delete_Call{Call: _e.mock.On("Delete", ctx, name)}
+}
+
+func (_c *MockCustomFields_Delete_Call) Run(run func(ctx context.Context, name string)) *MockCustomFields_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomFields_Delete_Call) Return(err error) *MockCustomFields_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCustomFields_Delete_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockCustomFields_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockCustomFields
+func (_mock *MockCustomFields) List(ctx context.Context) ([]todo.CustomField, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []todo.CustomField
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.CustomField, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.CustomField); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.CustomField)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCustomFields_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockCustomFields_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCustomFields_Expecter) List(ctx interface{}) *MockCustomFields_List_Call {
+	return &MockCustomFields_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockCustomFields_List_Call) Run(run func(ctx context.Context)) *MockCustomFields_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCustomFields_List_Call) Return(customFields []todo.CustomField, err error) *MockCustomFields_List_Call {
+	_c.Call.Return(customFields, err)
+	return _c
+}
+
+func (_c *MockCustomFields_List_Call) RunAndReturn(run func(ctx context.Context) ([]todo.CustomField, error)) *MockCustomFields_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDelete creates a new instance of MockDelete. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDelete(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDelete {
+	mock := &MockDelete{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDelete is an autogenerated mock type for the Delete type
+type MockDelete struct {
+	mock.Mock
+}
+
+type MockDelete_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDelete) EXPECT() *MockDelete_Expecter {
+	return &MockDelete_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockDelete
+func (_mock *MockDelete) Execute(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDelete_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockDelete_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockDelete_Expecter) Execute(ctx interface{}, id interface{}) *MockDelete_Execute_Call {
+	return &MockDelete_Execute_Call{Call: _e.mock.On("Execute", ctx, id)}
+}
+
+func (_c *MockDelete_Execute_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockDelete_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelete_Execute_Call) Return(err error) *MockDelete_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDelete_Execute_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockDelete_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeleter creates a new instance of MockDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeleter {
+	mock := &MockDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeleter is an autogenerated mock type for the Deleter type
+type MockDeleter struct {
+	mock.Mock
+}
+
+type MockDeleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeleter) EXPECT() *MockDeleter_Expecter {
+	return &MockDeleter_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockDeleter
+func (_mock *MockDeleter) Delete(ctx context.Context, scope transaction.Scope, id uuid.UUID) error {
+	ret := _mock.Called(ctx, scope, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, scope, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDeleter_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockDeleter_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope transaction.Scope
+//   - id uuid.UUID
+func (_e *MockDeleter_Expecter) Delete(ctx interface{}, scope interface{}, id interface{}) *MockDeleter_Delete_Call {
+	return &MockDeleter_Delete_Call{Call: _e.mock.On("Delete", ctx, scope, id)}
+}
+
+func (_c *MockDeleter_Delete_Call) Run(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID)) *MockDeleter_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 transaction.Scope
+		if args[1] != nil {
+			arg1 = args[1].(transaction.Scope)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeleter_Delete_Call) Return(err error) *MockDeleter_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDeleter_Delete_Call) RunAndReturn(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID) error) *MockDeleter_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBackfillEmbeddings creates a new instance of MockBackfillEmbeddings. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBackfillEmbeddings(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBackfillEmbeddings {
+	mock := &MockBackfillEmbeddings{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBackfillEmbeddings is an autogenerated mock type for the BackfillEmbeddings type
+type MockBackfillEmbeddings struct {
+	mock.Mock
+}
+
+type MockBackfillEmbeddings_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBackfillEmbeddings) EXPECT() *MockBackfillEmbeddings_Expecter {
+	return &MockBackfillEmbeddings_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function for the type MockBackfillEmbeddings
+func (_mock *MockBackfillEmbeddings) List(ctx context.Context) ([]todo.EmbeddingBackfill, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []todo.EmbeddingBackfill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.EmbeddingBackfill, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.EmbeddingBackfill); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.EmbeddingBackfill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBackfillEmbeddings_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockBackfillEmbeddings_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBackfillEmbeddings_Expecter) List(ctx interface{}) *MockBackfillEmbeddings_List_Call {
+	return &MockBackfillEmbeddings_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockBackfillEmbeddings_List_Call) Run(run func(ctx context.Context)) *MockBackfillEmbeddings_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBackfillEmbeddings_List_Call) Return(embeddingBackfills []todo.EmbeddingBackfill, err error) *MockBackfillEmbeddings_List_Call {
+	_c.Call.Return(embeddingBackfills, err)
+	return _c
+}
+
+func (_c *MockBackfillEmbeddings_List_Call) RunAndReturn(run func(ctx context.Context) ([]todo.EmbeddingBackfill, error)) *MockBackfillEmbeddings_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Step provides a mock function for the type MockBackfillEmbeddings
+func (_mock *MockBackfillEmbeddings) Step(ctx context.Context, slot todo.EmbeddingSlot, batchSize int) (todo.EmbeddingBackfill, error) {
+	ret := _mock.Called(ctx, slot, batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Step")
+	}
+
+	var r0 todo.EmbeddingBackfill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.EmbeddingSlot, int) (todo.EmbeddingBackfill, error)); ok {
+		return returnFunc(ctx, slot, batchSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, todo.EmbeddingSlot, int) todo.EmbeddingBackfill); ok {
+		r0 = returnFunc(ctx, slot, batchSize)
+	} else {
+		r0 = ret.Get(0).(todo.EmbeddingBackfill)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, todo.EmbeddingSlot, int) error); ok {
+		r1 = returnFunc(ctx, slot, batchSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBackfillEmbeddings_Step_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Step'
+type MockBackfillEmbeddings_Step_Call struct {
+	*mock.Call
+}
+
+// Step is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slot todo.EmbeddingSlot
+//   - batchSize int
+func (_e *MockBackfillEmbeddings_Expecter) Step(ctx interface{}, slot interface{}, batchSize interface{}) *MockBackfillEmbeddings_Step_Call {
+	return &MockBackfillEmbeddings_Step_Call{Call: _e.mock.On("Step", ctx, slot, batchSize)}
+}
+
+func (_c *MockBackfillEmbeddings_Step_Call) Run(run func(ctx context.Context, slot todo.EmbeddingSlot, batchSize int)) *MockBackfillEmbeddings_Step_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 todo.EmbeddingSlot
+		if args[1] != nil {
+			arg1 = args[1].(todo.EmbeddingSlot)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBackfillEmbeddings_Step_Call) Return(embeddingBackfill todo.EmbeddingBackfill, err error) *MockBackfillEmbeddings_Step_Call {
+	_c.Call.Return(embeddingBackfill, err)
+	return _c
+}
+
+func (_c *MockBackfillEmbeddings_Step_Call) RunAndReturn(run func(ctx context.Context, slot todo.EmbeddingSlot, batchSize int) (todo.EmbeddingBackfill, error)) *MockBackfillEmbeddings_Step_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFocusBlocks creates a new instance of MockFocusBlocks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFocusBlocks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFocusBlocks {
+	mock := &MockFocusBlocks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFocusBlocks is an autogenerated mock type for the FocusBlocks type
+type MockFocusBlocks struct {
+	mock.Mock
+}
+
+type MockFocusBlocks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFocusBlocks) EXPECT() *MockFocusBlocks_Expecter {
+	return &MockFocusBlocks_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function for the type MockFocusBlocks
+func (_mock *MockFocusBlocks) Accept(ctx context.Context, blocks []todo.FocusBlock) ([]todo.FocusBlock, error) {
+	ret := _mock.Called(ctx, blocks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 []todo.FocusBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []todo.FocusBlock) ([]todo.FocusBlock, error)); ok {
+		return returnFunc(ctx, blocks)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []todo.FocusBlock) []todo.FocusBlock); ok {
+		r0 = returnFunc(ctx, blocks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.FocusBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []todo.FocusBlock) error); ok {
+		r1 = returnFunc(ctx, blocks)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFocusBlocks_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type MockFocusBlocks_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blocks []todo.FocusBlock
+func (_e *MockFocusBlocks_Expecter) Accept(ctx interface{}, blocks interface{}) *MockFocusBlocks_Accept_Call {
+	return &MockFocusBlocks_Accept_Call{Call: _e.mock.On("Accept", ctx, blocks)}
+}
+
+func (_c *MockFocusBlocks_Accept_Call) Run(run func(ctx context.Context, blocks []todo.FocusBlock)) *MockFocusBlocks_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []todo.FocusBlock
+		if args[1] != nil {
+			arg1 = args[1].([]todo.FocusBlock)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusBlocks_Accept_Call) Return(focusBlocks []todo.FocusBlock, err error) *MockFocusBlocks_Accept_Call {
+	_c.Call.Return(focusBlocks, err)
+	return _c
+}
+
+func (_c *MockFocusBlocks_Accept_Call) RunAndReturn(run func(ctx context.Context, blocks []todo.FocusBlock) ([]todo.FocusBlock, error)) *MockFocusBlocks_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Calendar provides a mock function for the type MockFocusBlocks
+func (_mock *MockFocusBlocks) Calendar(ctx context.Context) ([]todo.FocusBlock, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Calendar")
+	}
+
+	var r0 []todo.FocusBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.FocusBlock, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.FocusBlock); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.FocusBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFocusBlocks_Calendar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Calendar'
+type MockFocusBlocks_Calendar_Call struct {
+	*mock.Call
+}
+
+// Calendar is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFocusBlocks_Expecter) Calendar(ctx interface{}) *MockFocusBlocks_Calendar_Call {
+	return &MockFocusBlocks_Calendar_Call{Call: _e.mock.On("Calendar", ctx)}
+}
+
+func (_c *MockFocusBlocks_Calendar_Call) Run(run func(ctx context.Context)) *MockFocusBlocks_Calendar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusBlocks_Calendar_Call) Return(focusBlocks []todo.FocusBlock, err error) *MockFocusBlocks_Calendar_Call {
+	_c.Call.Return(focusBlocks, err)
+	return _c
+}
+
+func (_c *MockFocusBlocks_Calendar_Call) RunAndReturn(run func(ctx context.Context) ([]todo.FocusBlock, error)) *MockFocusBlocks_Calendar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function for the type MockFocusBlocks
+func (_mock *MockFocusBlocks) Suggest(ctx context.Context) ([]todo.FocusBlock, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 []todo.FocusBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.FocusBlock, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.FocusBlock); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.FocusBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFocusBlocks_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockFocusBlocks_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFocusBlocks_Expecter) Suggest(ctx interface{}) *MockFocusBlocks_Suggest_Call {
+	return &MockFocusBlocks_Suggest_Call{Call: _e.mock.On("Suggest", ctx)}
+}
+
+func (_c *MockFocusBlocks_Suggest_Call) Run(run func(ctx context.Context)) *MockFocusBlocks_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusBlocks_Suggest_Call) Return(focusBlocks []todo.FocusBlock, err error) *MockFocusBlocks_Suggest_Call {
+	_c.Call.Return(focusBlocks, err)
+	return _c
+}
+
+func (_c *MockFocusBlocks_Suggest_Call) RunAndReturn(run func(ctx context.Context) ([]todo.FocusBlock, error)) *MockFocusBlocks_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFocusSessions creates a new instance of MockFocusSessions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFocusSessions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFocusSessions {
+	mock := &MockFocusSessions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFocusSessions is an autogenerated mock type for the FocusSessions type
+type MockFocusSessions struct {
+	mock.Mock
+}
+
+type MockFocusSessions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFocusSessions) EXPECT() *MockFocusSessions_Expecter {
+	return &MockFocusSessions_Expecter{mock: &_m.Mock}
+}
+
+// Start provides a mock function for the type MockFocusSessions
+func (_mock *MockFocusSessions) Start(ctx context.Context, todoID uuid.UUID, duration time.Duration, conversationID *uuid.UUID) (todo.FocusSession, error) {
+	ret := _mock.Called(ctx, todoID, duration, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 todo.FocusSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, *uuid.UUID) (todo.FocusSession, error)); ok {
+		return returnFunc(ctx, todoID, duration, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration, *uuid.UUID) todo.FocusSession); ok {
+		r0 = returnFunc(ctx, todoID, duration, conversationID)
+	} else {
+		r0 = ret.Get(0).(todo.FocusSession)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, todoID, duration, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFocusSessions_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockFocusSessions_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+//   - duration time.Duration
+//   - conversationID *uuid.UUID
+func (_e *MockFocusSessions_Expecter) Start(ctx interface{}, todoID interface{}, duration interface{}, conversationID interface{}) *MockFocusSessions_Start_Call {
+	return &MockFocusSessions_Start_Call{Call: _e.mock.On("Start", ctx, todoID, duration, conversationID)}
+}
+
+func (_c *MockFocusSessions_Start_Call) Run(run func(ctx context.Context, todoID uuid.UUID, duration time.Duration, conversationID *uuid.UUID)) *MockFocusSessions_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Duration
+		if args[2] != nil {
+			arg2 = args[2].(time.Duration)
+		}
+		var arg3 *uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFocusSessions_Start_Call) Return(focusSession todo.FocusSession, err error) *MockFocusSessions_Start_Call {
+	_c.Call.Return(focusSession, err)
+	return _c
+}
+
+func (_c *MockFocusSessions_Start_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, duration time.Duration, conversationID *uuid.UUID) (todo.FocusSession, error)) *MockFocusSessions_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInboundWebhooks creates a new instance of MockInboundWebhooks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInboundWebhooks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInboundWebhooks {
+	mock := &MockInboundWebhooks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockInboundWebhooks is an autogenerated mock type for the InboundWebhooks type
+type MockInboundWebhooks struct {
+	mock.Mock
+}
+
+type MockInboundWebhooks_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInboundWebhooks) EXPECT() *MockInboundWebhooks_Expecter {
+	return &MockInboundWebhooks_Expecter{mock: &_m.Mock}
+}
+
+// Receive provides a mock function for the type MockInboundWebhooks
+func (_mock *MockInboundWebhooks) Receive(ctx context.Context, source string, delivery WebhookDelivery) (todo.Todo, bool, error) {
+	ret := _mock.Called(ctx, source, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Receive")
+	}
+
+	var r0 todo.Todo
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, WebhookDelivery) (todo.Todo, bool, error)); ok {
+		return returnFunc(ctx, source, delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, WebhookDelivery) todo.Todo); ok {
+		r0 = returnFunc(ctx, source, delivery)
+	} else {
+		r0 = ret.Get(0).(todo.Todo)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, WebhookDelivery) bool); ok {
+		r1 = returnFunc(ctx, source, delivery)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, WebhookDelivery) error); ok {
+		r2 = returnFunc(ctx, source, delivery)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockInboundWebhooks_Receive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Receive'
+type MockInboundWebhooks_Receive_Call struct {
+	*mock.Call
+}
+
+// Receive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - delivery WebhookDelivery
+func (_e *MockInboundWebhooks_Expecter) Receive(ctx interface{}, source interface{}, delivery interface{}) *MockInboundWebhooks_Receive_Call {
+	return &MockInboundWebhooks_Receive_Call{Call: _e.mock.On("Receive", ctx, source, delivery)}
+}
+
+func (_c *MockInboundWebhooks_Receive_Call) Run(run func(ctx context.Context, source string, delivery WebhookDelivery)) *MockInboundWebhooks_Receive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 WebhookDelivery
+		if args[2] != nil {
+			arg2 = args[2].(WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockInboundWebhooks_Receive_Call) Return(todo1 todo.Todo, b bool, err error) *MockInboundWebhooks_Receive_Call {
+	_c.Call.Return(todo1, b, err)
+	return _c
+}
+
+func (_c *MockInboundWebhooks_Receive_Call) RunAndReturn(run func(ctx context.Context, source string, delivery WebhookDelivery) (todo.Todo, bool, error)) *MockInboundWebhooks_Receive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockList creates a new instance of MockList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockList {
+	mock := &MockList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockList is an autogenerated mock type for the List type
+type MockList struct {
+	mock.Mock
+}
+
+type MockList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockList) EXPECT() *MockList_Expecter {
+	return &MockList_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockList
+func (_mock *MockList) Query(ctx context.Context, page int, pageSize int, opts ...ListOptions) ([]todo.Todo, bool, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, page, pageSize, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, page, pageSize)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []todo.Todo
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOptions) ([]todo.Todo, bool, error)); ok {
+		return returnFunc(ctx, page, pageSize, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, ...ListOptions) []todo.Todo); ok {
+		r0 = returnFunc(ctx, page, pageSize, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, ...ListOptions) bool); ok {
+		r1 = returnFunc(ctx, page, pageSize, opts...)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int, ...ListOptions) error); ok {
+		r2 = returnFunc(ctx, page, pageSize, opts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockList_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockList_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page int
+//   - pageSize int
+//   - opts ...ListOptions
+func (_e *MockList_Expecter) Query(ctx interface{}, page interface{}, pageSize interface{}, opts ...interface{}) *MockList_Query_Call {
+	return &MockList_Query_Call{Call: _e.mock.On("Query",
+		append([]interface{}{ctx, page, pageSize}, opts...)...)}
+}
+
+func (_c *MockList_Query_Call) Run(run func(ctx context.Context, page int, pageSize int, opts ...ListOptions)) *MockList_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 []ListOptions
+		var variadicArgs []ListOptions
+		if len(args) > 3 {
+			variadicArgs = args[3].([]ListOptions)
+		}
+		arg3 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockList_Query_Call) Return(todos []todo.Todo, b bool, err error) *MockList_Query_Call {
+	_c.Call.Return(todos, b, err)
+	return _c
+}
+
+func (_c *MockList_Query_Call) RunAndReturn(run func(ctx context.Context, page int, pageSize int, opts ...ListOptions) ([]todo.Todo, bool, error)) *MockList_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjects creates a new instance of MockProjects. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjects(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjects {
+	mock := &MockProjects{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProjects is an autogenerated mock type for the Projects type
+type MockProjects struct {
+	mock.Mock
+}
+
+type MockProjects_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjects) EXPECT() *MockProjects_Expecter {
+	return &MockProjects_Expecter{mock: &_m.Mock}
+}
+
+// Accept provides a mock function for the type MockProjects
+func (_mock *MockProjects) Accept(ctx context.Context, suggestionID uuid.UUID, name string) (todo.Project, error) {
+	ret := _mock.Called(ctx, suggestionID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accept")
+	}
+
+	var r0 todo.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (todo.Project, error)); ok {
+		return returnFunc(ctx, suggestionID, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) todo.Project); ok {
+		r0 = returnFunc(ctx, suggestionID, name)
+	} else {
+		r0 = ret.Get(0).(todo.Project)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, suggestionID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjects_Accept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accept'
+type MockProjects_Accept_Call struct {
+	*mock.Call
+}
+
+// Accept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suggestionID uuid.UUID
+//   - name string
+func (_e *MockProjects_Expecter) Accept(ctx interface{}, suggestionID interface{}, name interface{}) *MockProjects_Accept_Call {
+	return &MockProjects_Accept_Call{Call: _e.mock.On("Accept", ctx, suggestionID, name)}
+}
+
+func (_c *MockProjects_Accept_Call) Run(run func(ctx context.Context, suggestionID uuid.UUID, name string)) *MockProjects_Accept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjects_Accept_Call) Return(project todo.Project, err error) *MockProjects_Accept_Call {
+	_c.Call.Return(project, err)
+	return _c
+}
+
+func (_c *MockProjects_Accept_Call) RunAndReturn(run func(ctx context.Context, suggestionID uuid.UUID, name string) (todo.Project, error)) *MockProjects_Accept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockProjects
+func (_mock *MockProjects) List(ctx context.Context) ([]todo.Project, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []todo.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.Project, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.Project); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjects_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProjects_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjects_Expecter) List(ctx interface{}) *MockProjects_List_Call {
+	return &MockProjects_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockProjects_List_Call) Run(run func(ctx context.Context)) *MockProjects_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjects_List_Call) Return(projects []todo.Project, err error) *MockProjects_List_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockProjects_List_Call) RunAndReturn(run func(ctx context.Context) ([]todo.Project, error)) *MockProjects_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockProjects
+func (_mock *MockProjects) Refresh(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjects_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockProjects_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjects_Expecter) Refresh(ctx interface{}) *MockProjects_Refresh_Call {
+	return &MockProjects_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockProjects_Refresh_Call) Run(run func(ctx context.Context)) *MockProjects_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjects_Refresh_Call) Return(n int, err error) *MockProjects_Refresh_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProjects_Refresh_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockProjects_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggestions provides a mock function for the type MockProjects
+func (_mock *MockProjects) Suggestions(ctx context.Context) ([]todo.ProjectSuggestion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggestions")
+	}
+
+	var r0 []todo.ProjectSuggestion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.ProjectSuggestion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.ProjectSuggestion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.ProjectSuggestion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjects_Suggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggestions'
+type MockProjects_Suggestions_Call struct {
+	*mock.Call
+}
+
+// Suggestions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjects_Expecter) Suggestions(ctx interface{}) *MockProjects_Suggestions_Call {
+	return &MockProjects_Suggestions_Call{Call: _e.mock.On("Suggestions", ctx)}
+}
+
+func (_c *MockProjects_Suggestions_Call) Run(run func(ctx context.Context)) *MockProjects_Suggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjects_Suggestions_Call) Return(projectSuggestions []todo.ProjectSuggestion, err error) *MockProjects_Suggestions_Call {
+	_c.Call.Return(projectSuggestions, err)
+	return _c
+}
+
+func (_c *MockProjects_Suggestions_Call) RunAndReturn(run func(ctx context.Context) ([]todo.ProjectSuggestion, error)) *MockProjects_Suggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQuickAdd creates a new instance of MockQuickAdd. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQuickAdd(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQuickAdd {
+	mock := &MockQuickAdd{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockQuickAdd is an autogenerated mock type for the QuickAdd type
+type MockQuickAdd struct {
+	mock.Mock
+}
+
+type MockQuickAdd_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuickAdd) EXPECT() *MockQuickAdd_Expecter {
+	return &MockQuickAdd_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockQuickAdd
+func (_mock *MockQuickAdd) Execute(ctx context.Context, text string) (QuickAddPreview, todo.Todo, error) {
+	ret := _mock.Called(ctx, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 QuickAddPreview
+	var r1 todo.Todo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QuickAddPreview, todo.Todo, error)); ok {
+		return returnFunc(ctx, text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QuickAddPreview); ok {
+		r0 = returnFunc(ctx, text)
+	} else {
+		r0 = ret.Get(0).(QuickAddPreview)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) todo.Todo); ok {
+		r1 = returnFunc(ctx, text)
+	} else {
+		r1 = ret.Get(1).(todo.Todo)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, text)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockQuickAdd_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockQuickAdd_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - text string
+func (_e *MockQuickAdd_Expecter) Execute(ctx interface{}, text interface{}) *MockQuickAdd_Execute_Call {
+	return &MockQuickAdd_Execute_Call{Call: _e.mock.On("Execute", ctx, text)}
+}
+
+func (_c *MockQuickAdd_Execute_Call) Run(run func(ctx context.Context, text string)) *MockQuickAdd_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuickAdd_Execute_Call) Return(quickAddPreview QuickAddPreview, todo1 todo.Todo, err error) *MockQuickAdd_Execute_Call {
+	_c.Call.Return(quickAddPreview, todo1, err)
+	return _c
+}
+
+func (_c *MockQuickAdd_Execute_Call) RunAndReturn(run func(ctx context.Context, text string) (QuickAddPreview, todo.Todo, error)) *MockQuickAdd_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preview provides a mock function for the type MockQuickAdd
+func (_mock *MockQuickAdd) Preview(ctx context.Context, text string) (QuickAddPreview, error) {
+	ret := _mock.Called(ctx, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preview")
+	}
+
+	var r0 QuickAddPreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (QuickAddPreview, error)); ok {
+		return returnFunc(ctx, text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) QuickAddPreview); ok {
+		r0 = returnFunc(ctx, text)
+	} else {
+		r0 = ret.Get(0).(QuickAddPreview)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuickAdd_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type MockQuickAdd_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - text string
+func (_e *MockQuickAdd_Expecter) Preview(ctx interface{}, text interface{}) *MockQuickAdd_Preview_Call {
+	return &MockQuickAdd_Preview_Call{Call: _e.mock.On("Preview", ctx, text)}
+}
+
+func (_c *MockQuickAdd_Preview_Call) Run(run func(ctx context.Context, text string)) *MockQuickAdd_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuickAdd_Preview_Call) Return(quickAddPreview QuickAddPreview, err error) *MockQuickAdd_Preview_Call {
+	_c.Call.Return(quickAddPreview, err)
+	return _c
+}
+
+func (_c *MockQuickAdd_Preview_Call) RunAndReturn(run func(ctx context.Context, text string) (QuickAddPreview, error)) *MockQuickAdd_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockScheduleNextOccurrence creates a new instance of MockScheduleNextOccurrence. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockScheduleNextOccurrence(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockScheduleNextOccurrence {
+	mock := &MockScheduleNextOccurrence{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockScheduleNextOccurrence is an autogenerated mock type for the ScheduleNextOccurrence type
+type MockScheduleNextOccurrence struct {
+	mock.Mock
+}
+
+type MockScheduleNextOccurrence_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockScheduleNextOccurrence) EXPECT() *MockScheduleNextOccurrence_Expecter {
+	return &MockScheduleNextOccurrence_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockScheduleNextOccurrence
+func (_mock *MockScheduleNextOccurrence) Execute(ctx context.Context, event outbox.TodoEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, outbox.TodoEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockScheduleNextOccurrence_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockScheduleNextOccurrence_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event outbox.TodoEvent
+func (_e *MockScheduleNextOccurrence_Expecter) Execute(ctx interface{}, event interface{}) *MockScheduleNextOccurrence_Execute_Call {
+	return &MockScheduleNextOccurrence_Execute_Call{Call: _e.mock.On("Execute", ctx, event)}
+}
+
+func (_c *MockScheduleNextOccurrence_Execute_Call) Run(run func(ctx context.Context, event outbox.TodoEvent)) *MockScheduleNextOccurrence_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 outbox.TodoEvent
+		if args[1] != nil {
+			arg1 = args[1].(outbox.TodoEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockScheduleNextOccurrence_Execute_Call) Return(err error) *MockScheduleNextOccurrence_Execute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockScheduleNextOccurrence_Execute_Call) RunAndReturn(run func(ctx context.Context, event outbox.TodoEvent) error) *MockScheduleNextOccurrence_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSnapshots creates a new instance of MockSnapshots. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSnapshots(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSnapshots {
+	mock := &MockSnapshots{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSnapshots is an autogenerated mock type for the Snapshots type
+type MockSnapshots struct {
+	mock.Mock
+}
+
+type MockSnapshots_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSnapshots) EXPECT() *MockSnapshots_Expecter {
+	return &MockSnapshots_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockSnapshots
+func (_mock *MockSnapshots) Create(ctx context.Context, title string, filter todo.ViewFilter) (todo.BoardSnapshot, error) {
+	ret := _mock.Called(ctx, title, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 todo.BoardSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, todo.ViewFilter) (todo.BoardSnapshot, error)); ok {
+		return returnFunc(ctx, title, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, todo.ViewFilter) todo.BoardSnapshot); ok {
+		r0 = returnFunc(ctx, title, filter)
+	} else {
+		r0 = ret.Get(0).(todo.BoardSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, todo.ViewFilter) error); ok {
+		r1 = returnFunc(ctx, title, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnapshots_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSnapshots_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - title string
+//   - filter todo.ViewFilter
+func (_e *MockSnapshots_Expecter) Create(ctx interface{}, title interface{}, filter interface{}) *MockSnapshots_Create_Call {
+	return &MockSnapshots_Create_Call{Call: _e.mock.On("Create", ctx, title, filter)}
+}
+
+func (_c *MockSnapshots_Create_Call) Run(run func(ctx context.Context, title string, filter todo.ViewFilter)) *MockSnapshots_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 todo.ViewFilter
+		if args[2] != nil {
+			arg2 = args[2].(todo.ViewFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshots_Create_Call) Return(boardSnapshot todo.BoardSnapshot, err error) *MockSnapshots_Create_Call {
+	_c.Call.Return(boardSnapshot, err)
+	return _c
+}
+
+func (_c *MockSnapshots_Create_Call) RunAndReturn(run func(ctx context.Context, title string, filter todo.ViewFilter) (todo.BoardSnapshot, error)) *MockSnapshots_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockSnapshots
+func (_mock *MockSnapshots) Get(ctx context.Context, token string) (todo.BoardSnapshot, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 todo.BoardSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (todo.BoardSnapshot, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) todo.BoardSnapshot); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Get(0).(todo.BoardSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSnapshots_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSnapshots_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *MockSnapshots_Expecter) Get(ctx interface{}, token interface{}) *MockSnapshots_Get_Call {
+	return &MockSnapshots_Get_Call{Call: _e.mock.On("Get", ctx, token)}
+}
+
+func (_c *MockSnapshots_Get_Call) Run(run func(ctx context.Context, token string)) *MockSnapshots_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSnapshots_Get_Call) Return(boardSnapshot todo.BoardSnapshot, err error) *MockSnapshots_Get_Call {
+	_c.Call.Return(boardSnapshot, err)
+	return _c
+}
+
+func (_c *MockSnapshots_Get_Call) RunAndReturn(run func(ctx context.Context, token string) (todo.BoardSnapshot, error)) *MockSnapshots_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSync creates a new instance of MockSync. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSync(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSync {
+	mock := &MockSync{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSync is an autogenerated mock type for the Sync type
+type MockSync struct {
+	mock.Mock
+}
+
+type MockSync_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSync) EXPECT() *MockSync_Expecter {
+	return &MockSync_Expecter{mock: &_m.Mock}
+}
+
+// Pull provides a mock function for the type MockSync
+func (_mock *MockSync) Pull(ctx context.Context, cursor string, limit int) (SyncPage, error) {
+	ret := _mock.Called(ctx, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pull")
+	}
+
+	var r0 SyncPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (SyncPage, error)); ok {
+		return returnFunc(ctx, cursor, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) SyncPage); ok {
+		r0 = returnFunc(ctx, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(SyncPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSync_Pull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pull'
+type MockSync_Pull_Call struct {
+	*mock.Call
+}
+
+// Pull is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor string
+//   - limit int
+func (_e *MockSync_Expecter) Pull(ctx interface{}, cursor interface{}, limit interface{}) *MockSync_Pull_Call {
+	return &MockSync_Pull_Call{Call: _e.mock.On("Pull", ctx, cursor, limit)}
+}
+
+func (_c *MockSync_Pull_Call) Run(run func(ctx context.Context, cursor string, limit int)) *MockSync_Pull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSync_Pull_Call) Return(syncPage SyncPage, err error) *MockSync_Pull_Call {
+	_c.Call.Return(syncPage, err)
+	return _c
+}
+
+func (_c *MockSync_Pull_Call) RunAndReturn(run func(ctx context.Context, cursor string, limit int) (SyncPage, error)) *MockSync_Pull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Push provides a mock function for the type MockSync
+func (_mock *MockSync) Push(ctx context.Context, mutations []SyncMutation) ([]SyncMutationResult, error) {
+	ret := _mock.Called(ctx, mutations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Push")
+	}
+
+	var r0 []SyncMutationResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []SyncMutation) ([]SyncMutationResult, error)); ok {
+		return returnFunc(ctx, mutations)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []SyncMutation) []SyncMutationResult); ok {
+		r0 = returnFunc(ctx, mutations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SyncMutationResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []SyncMutation) error); ok {
+		r1 = returnFunc(ctx, mutations)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSync_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type MockSync_Push_Call struct {
+	*mock.Call
+}
+
+// Push is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mutations []SyncMutation
+func (_e *MockSync_Expecter) Push(ctx interface{}, mutations interface{}) *MockSync_Push_Call {
+	return &MockSync_Push_Call{Call: _e.mock.On("Push", ctx, mutations)}
+}
+
+func (_c *MockSync_Push_Call) Run(run func(ctx context.Context, mutations []SyncMutation)) *MockSync_Push_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []SyncMutation
+		if args[1] != nil {
+			arg1 = args[1].([]SyncMutation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSync_Push_Call) Return(syncMutationResults []SyncMutationResult, err error) *MockSync_Push_Call {
+	_c.Call.Return(syncMutationResults, err)
+	return _c
+}
+
+func (_c *MockSync_Push_Call) RunAndReturn(run func(ctx context.Context, mutations []SyncMutation) ([]SyncMutationResult, error)) *MockSync_Push_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTemplates creates a new instance of MockTemplates. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTemplates(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTemplates {
+	mock := &MockTemplates{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTemplates is an autogenerated mock type for the Templates type
+type MockTemplates struct {
+	mock.Mock
+}
+
+type MockTemplates_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTemplates) EXPECT() *MockTemplates_Expecter {
+	return &MockTemplates_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function for the type MockTemplates
+func (_mock *MockTemplates) Apply(ctx context.Context, id uuid.UUID, anchor time.Time) ([]todo.Todo, error) {
+	ret := _mock.Called(ctx, id, anchor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 []todo.Todo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]todo.Todo, error)); ok {
+		return returnFunc(ctx, id, anchor)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []todo.Todo); ok {
+		r0 = returnFunc(ctx, id, anchor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Todo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, anchor)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplates_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type MockTemplates_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - anchor time.Time
+func (_e *MockTemplates_Expecter) Apply(ctx interface{}, id interface{}, anchor interface{}) *MockTemplates_Apply_Call {
+	return &MockTemplates_Apply_Call{Call: _e.mock.On("Apply", ctx, id, anchor)}
+}
+
+func (_c *MockTemplates_Apply_Call) Run(run func(ctx context.Context, id uuid.UUID, anchor time.Time)) *MockTemplates_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplates_Apply_Call) Return(todos []todo.Todo, err error) *MockTemplates_Apply_Call {
+	_c.Call.Return(todos, err)
+	return _c
+}
+
+func (_c *MockTemplates_Apply_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, anchor time.Time) ([]todo.Todo, error)) *MockTemplates_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockTemplates
+func (_mock *MockTemplates) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTemplates_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTemplates_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockTemplates_Expecter) Delete(ctx interface{}, id interface{}) *MockTemplates_Delete_Call {
+	return &MockTemplates_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockTemplates_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockTemplates_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplates_Delete_Call) Return(err error) *MockTemplates_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTemplates_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockTemplates_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockTemplates
+func (_mock *MockTemplates) List(ctx context.Context) ([]todo.Template, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []todo.Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.Template, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.Template); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.Template)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplates_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockTemplates_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTemplates_Expecter) List(ctx interface{}) *MockTemplates_List_Call {
+	return &MockTemplates_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockTemplates_List_Call) Run(run func(ctx context.Context)) *MockTemplates_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplates_List_Call) Return(templates []todo.Template, err error) *MockTemplates_List_Call {
+	_c.Call.Return(templates, err)
+	return _c
+}
+
+func (_c *MockTemplates_List_Call) RunAndReturn(run func(ctx context.Context) ([]todo.Template, error)) *MockTemplates_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockTemplates
+func (_mock *MockTemplates) Save(ctx context.Context, name string, items []todo.TemplateItem) (todo.Template, error) {
+	ret := _mock.Called(ctx, name, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 todo.Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []todo.TemplateItem) (todo.Template, error)); ok {
+		return returnFunc(ctx, name, items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []todo.TemplateItem) todo.Template); ok {
+		r0 = returnFunc(ctx, name, items)
+	} else {
+		r0 = ret.Get(0).(todo.Template)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []todo.TemplateItem) error); ok {
+		r1 = returnFunc(ctx, name, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplates_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockTemplates_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - items []todo.TemplateItem
+func (_e *MockTemplates_Expecter) Save(ctx interface{}, name interface{}, items interface{}) *MockTemplates_Save_Call {
+	return &MockTemplates_Save_Call{Call: _e.mock.On("Save", ctx, name, items)}
+}
+
+func (_c *MockTemplates_Save_Call) Run(run func(ctx context.Context, name string, items []todo.TemplateItem)) *MockTemplates_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []todo.TemplateItem
+		if args[2] != nil {
+			arg2 = args[2].([]todo.TemplateItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplates_Save_Call) Return(template todo.Template, err error) *MockTemplates_Save_Call {
+	_c.Call.Return(template, err)
+	return _c
+}
+
+func (_c *MockTemplates_Save_Call) RunAndReturn(run func(ctx context.Context, name string, items []todo.TemplateItem) (todo.Template, error)) *MockTemplates_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockTemplates
+func (_mock *MockTemplates) Update(ctx context.Context, id uuid.UUID, name string, items []todo.TemplateItem) (todo.Template, error) {
+	ret := _mock.Called(ctx, id, name, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 todo.Template
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []todo.TemplateItem) (todo.Template, error)); ok {
+		return returnFunc(ctx, id, name, items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []todo.TemplateItem) todo.Template); ok {
+		r0 = returnFunc(ctx, id, name, items)
+	} else {
+		r0 = ret.Get(0).(todo.Template)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []todo.TemplateItem) error); ok {
+		r1 = returnFunc(ctx, id, name, items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTemplates_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTemplates_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - name string
+//   - items []todo.TemplateItem
+func (_e *MockTemplates_Expecter) Update(ctx interface{}, id interface{}, name interface{}, items interface{}) *MockTemplates_Update_Call {
+	return &MockTemplates_Update_Call{Call: _e.mock.On("Update", ctx, id, name, items)}
+}
+
+func (_c *MockTemplates_Update_Call) Run(run func(ctx context.Context, id uuid.UUID, name string, items []todo.TemplateItem)) *MockTemplates_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []todo.TemplateItem
+		if args[3] != nil {
+			arg3 = args[3].([]todo.TemplateItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTemplates_Update_Call) Return(template todo.Template, err error) *MockTemplates_Update_Call {
+	_c.Call.Return(template, err)
+	return _c
+}
+
+func (_c *MockTemplates_Update_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, name string, items []todo.TemplateItem) (todo.Template, error)) *MockTemplates_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetTimeReport creates a new instance of MockGetTimeReport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetTimeReport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetTimeReport {
+	mock := &MockGetTimeReport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetTimeReport is an autogenerated mock type for the GetTimeReport type
+type MockGetTimeReport struct {
+	mock.Mock
+}
+
+type MockGetTimeReport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetTimeReport) EXPECT() *MockGetTimeReport_Expecter {
+	return &MockGetTimeReport_Expecter{mock: &_m.Mock}
+}
+
+// Query provides a mock function for the type MockGetTimeReport
+func (_mock *MockGetTimeReport) Query(ctx context.Context, from time.Time, to time.Time) ([]todo.TimeReportItem, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []todo.TimeReportItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]todo.TimeReportItem, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []todo.TimeReportItem); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.TimeReportItem)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetTimeReport_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockGetTimeReport_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *MockGetTimeReport_Expecter) Query(ctx interface{}, from interface{}, to interface{}) *MockGetTimeReport_Query_Call {
+	return &MockGetTimeReport_Query_Call{Call: _e.mock.On("Query", ctx, from, to)}
+}
+
+func (_c *MockGetTimeReport_Query_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *MockGetTimeReport_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetTimeReport_Query_Call) Return(timeReportItems []todo.TimeReportItem, err error) *MockGetTimeReport_Query_Call {
+	_c.Call.Return(timeReportItems, err)
+	return _c
+}
+
+func (_c *MockGetTimeReport_Query_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) ([]todo.TimeReportItem, error)) *MockGetTimeReport_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeTracker creates a new instance of MockTimeTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeTracker {
+	mock := &MockTimeTracker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTimeTracker is an autogenerated mock type for the TimeTracker type
+type MockTimeTracker struct {
+	mock.Mock
+}
+
+type MockTimeTracker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeTracker) EXPECT() *MockTimeTracker_Expecter {
+	return &MockTimeTracker_Expecter{mock: &_m.Mock}
+}
+
+// LogTime provides a mock function for the type MockTimeTracker
+func (_mock *MockTimeTracker) LogTime(ctx context.Context, todoID uuid.UUID, startedAt time.Time, duration time.Duration) (todo.TimeEntry, error) {
+	ret := _mock.Called(ctx, todoID, startedAt, duration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogTime")
+	}
+
+	var r0 todo.TimeEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Duration) (todo.TimeEntry, error)); ok {
+		return returnFunc(ctx, todoID, startedAt, duration)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Duration) todo.TimeEntry); ok {
+		r0 = returnFunc(ctx, todoID, startedAt, duration)
+	} else {
+		r0 = ret.Get(0).(todo.TimeEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Duration) error); ok {
+		r1 = returnFunc(ctx, todoID, startedAt, duration)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTimeTracker_LogTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogTime'
+type MockTimeTracker_LogTime_Call struct {
+	*mock.Call
+}
+
+// LogTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+//   - startedAt time.Time
+//   - duration time.Duration
+func (_e *MockTimeTracker_Expecter) LogTime(ctx interface{}, todoID interface{}, startedAt interface{}, duration interface{}) *MockTimeTracker_LogTime_Call {
+	return &MockTimeTracker_LogTime_Call{Call: _e.mock.On("LogTime", ctx, todoID, startedAt, duration)}
+}
+
+func (_c *MockTimeTracker_LogTime_Call) Run(run func(ctx context.Context, todoID uuid.UUID, startedAt time.Time, duration time.Duration)) *MockTimeTracker_LogTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeTracker_LogTime_Call) Return(timeEntry todo.TimeEntry, err error) *MockTimeTracker_LogTime_Call {
+	_c.Call.Return(timeEntry, err)
+	return _c
+}
+
+func (_c *MockTimeTracker_LogTime_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID, startedAt time.Time, duration time.Duration) (todo.TimeEntry, error)) *MockTimeTracker_LogTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartTimer provides a mock function for the type MockTimeTracker
+func (_mock *MockTimeTracker) StartTimer(ctx context.Context, todoID uuid.UUID) (todo.TimeEntry, error) {
+	ret := _mock.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartTimer")
+	}
+
+	var r0 todo.TimeEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (todo.TimeEntry, error)); ok {
+		return returnFunc(ctx, todoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) todo.TimeEntry); ok {
+		r0 = returnFunc(ctx, todoID)
+	} else {
+		r0 = ret.Get(0).(todo.TimeEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTimeTracker_StartTimer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartTimer'
+type MockTimeTracker_StartTimer_Call struct {
+	*mock.Call
+}
+
+// StartTimer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+func (_e *MockTimeTracker_Expecter) StartTimer(ctx interface{}, todoID interface{}) *MockTimeTracker_StartTimer_Call {
+	return &MockTimeTracker_StartTimer_Call{Call: _e.mock.On("StartTimer", ctx, todoID)}
+}
+
+func (_c *MockTimeTracker_StartTimer_Call) Run(run func(ctx context.Context, todoID uuid.UUID)) *MockTimeTracker_StartTimer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeTracker_StartTimer_Call) Return(timeEntry todo.TimeEntry, err error) *MockTimeTracker_StartTimer_Call {
+	_c.Call.Return(timeEntry, err)
+	return _c
+}
+
+func (_c *MockTimeTracker_StartTimer_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID) (todo.TimeEntry, error)) *MockTimeTracker_StartTimer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopTimer provides a mock function for the type MockTimeTracker
+func (_mock *MockTimeTracker) StopTimer(ctx context.Context, todoID uuid.UUID) (todo.TimeEntry, error) {
+	ret := _mock.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopTimer")
+	}
+
+	var r0 todo.TimeEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (todo.TimeEntry, error)); ok {
+		return returnFunc(ctx, todoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) todo.TimeEntry); ok {
+		r0 = returnFunc(ctx, todoID)
+	} else {
+		r0 = ret.Get(0).(todo.TimeEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTimeTracker_StopTimer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTimer'
+type MockTimeTracker_StopTimer_Call struct {
+	*mock.Call
+}
+
+// StopTimer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID uuid.UUID
+func (_e *MockTimeTracker_Expecter) StopTimer(ctx interface{}, todoID interface{}) *MockTimeTracker_StopTimer_Call {
+	return &MockTimeTracker_StopTimer_Call{Call: _e.mock.On("StopTimer", ctx, todoID)}
+}
+
+func (_c *MockTimeTracker_StopTimer_Call) Run(run func(ctx context.Context, todoID uuid.UUID)) *MockTimeTracker_StopTimer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTimeTracker_StopTimer_Call) Return(timeEntry todo.TimeEntry, err error) *MockTimeTracker_StopTimer_Call {
+	_c.Call.Return(timeEntry, err)
+	return _c
+}
+
+func (_c *MockTimeTracker_StopTimer_Call) RunAndReturn(run func(ctx context.Context, todoID uuid.UUID) (todo.TimeEntry, error)) *MockTimeTracker_StopTimer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUpdate creates a new instance of MockUpdate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdate(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdate {
+	mock := &MockUpdate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdate is an autogenerated mock type for the Update type
+type MockUpdate struct {
+	mock.Mock
+}
+
+type MockUpdate_Expecter struct {
 	mock *mock.Mock
 }
 
@@ -542,8 +3664,14 @@ func (_m *MockUpdate) EXPECT() *MockUpdate_Expecter {
 }
 
 // Execute provides a mock function for the type MockUpdate
-func (_mock *MockUpdate) Execute(ctx context.Context, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time) (todo.Todo, error) {
-	ret := _mock.Called(ctx, id, title, status, dueDate)
+func (_mock *MockUpdate) Execute(ctx context.Context, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time, opts ...UpdateOption) (todo.Todo, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, id, title, status, dueDate, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, id, title, status, dueDate)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -551,16 +3679,16 @@ func (_mock *MockUpdate) Execute(ctx context.Context, id uuid.UUID, title *strin
 
 	var r0 todo.Todo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *todo.Status, *time.Time) (todo.Todo, error)); ok {
-		return returnFunc(ctx, id, title, status, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *todo.Status, *time.Time, ...UpdateOption) (todo.Todo, error)); ok {
+		return returnFunc(ctx, id, title, status, dueDate, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *todo.Status, *time.Time) todo.Todo); ok {
-		r0 = returnFunc(ctx, id, title, status, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *todo.Status, *time.Time, ...UpdateOption) todo.Todo); ok {
+		r0 = returnFunc(ctx, id, title, status, dueDate, opts...)
 	} else {
 		r0 = ret.Get(0).(todo.Todo)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *todo.Status, *time.Time) error); ok {
-		r1 = returnFunc(ctx, id, title, status, dueDate)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *todo.Status, *time.Time, ...UpdateOption) error); ok {
+		r1 = returnFunc(ctx, id, title, status, dueDate, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -578,11 +3706,13 @@ type MockUpdate_Execute_Call struct {
 //   - title *string
 //   - status *todo.Status
 //   - dueDate *time.Time
-func (_e *MockUpdate_Expecter) Execute(ctx interface{}, id interface{}, title interface{}, status interface{}, dueDate interface{}) *MockUpdate_Execute_Call {
-	return &MockUpdate_Execute_Call{Call: _e.mock.On("Execute", ctx, id, title, status, dueDate)}
+//   - opts ...UpdateOption
+func (_e *MockUpdate_Expecter) Execute(ctx interface{}, id interface{}, title interface{}, status interface{}, dueDate interface{}, opts ...interface{}) *MockUpdate_Execute_Call {
+	return &MockUpdate_Execute_Call{Call: _e.mock.On("Execute",
+		append([]interface{}{ctx, id, title, status, dueDate}, opts...)...)}
 }
 
-func (_c *MockUpdate_Execute_Call) Run(run func(ctx context.Context, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time)) *MockUpdate_Execute_Call {
+func (_c *MockUpdate_Execute_Call) Run(run func(ctx context.Context, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time, opts ...UpdateOption)) *MockUpdate_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -604,12 +3734,19 @@ func (_c *MockUpdate_Execute_Call) Run(run func(ctx context.Context, id uuid.UUI
 		if args[4] != nil {
 			arg4 = args[4].(*time.Time)
 		}
+		var arg5 []UpdateOption
+		var variadicArgs []UpdateOption
+		if len(args) > 5 {
+			variadicArgs = args[5].([]UpdateOption)
+		}
+		arg5 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5...,
 		)
 	})
 	return _c
@@ -620,7 +3757,7 @@ func (_c *MockUpdate_Execute_Call) Return(todo1 todo.Todo, err error) *MockUpdat
 	return _c
 }
 
-func (_c *MockUpdate_Execute_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time) (todo.Todo, error)) *MockUpdate_Execute_Call {
+func (_c *MockUpdate_Execute_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time, opts ...UpdateOption) (todo.Todo, error)) *MockUpdate_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -653,8 +3790,14 @@ func (_m *MockUpdater) EXPECT() *MockUpdater_Expecter {
 }
 
 // Update provides a mock function for the type MockUpdater
-func (_mock *MockUpdater) Update(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time) (todo.Todo, error) {
-	ret := _mock.Called(ctx, scope, id, title, status, dueDate)
+func (_mock *MockUpdater) Update(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time, estimatedMinutes *int, customFields todo.CustomFieldValues, opts ...UpdateOption) (todo.Todo, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, scope, id, title, status, dueDate, estimatedMinutes, customFields, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, scope, id, title, status, dueDate, estimatedMinutes, customFields)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -662,16 +3805,16 @@ func (_mock *MockUpdater) Update(ctx context.Context, scope transaction.Scope, i
 
 	var r0 todo.Todo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, uuid.UUID, *string, *todo.Status, *time.Time) (todo.Todo, error)); ok {
-		return returnFunc(ctx, scope, id, title, status, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, uuid.UUID, *string, *todo.Status, *time.Time, *int, todo.CustomFieldValues, ...UpdateOption) (todo.Todo, error)); ok {
+		return returnFunc(ctx, scope, id, title, status, dueDate, estimatedMinutes, customFields, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, uuid.UUID, *string, *todo.Status, *time.Time) todo.Todo); ok {
-		r0 = returnFunc(ctx, scope, id, title, status, dueDate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, transaction.Scope, uuid.UUID, *string, *todo.Status, *time.Time, *int, todo.CustomFieldValues, ...UpdateOption) todo.Todo); ok {
+		r0 = returnFunc(ctx, scope, id, title, status, dueDate, estimatedMinutes, customFields, opts...)
 	} else {
 		r0 = ret.Get(0).(todo.Todo)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, transaction.Scope, uuid.UUID, *string, *todo.Status, *time.Time) error); ok {
-		r1 = returnFunc(ctx, scope, id, title, status, dueDate)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, transaction.Scope, uuid.UUID, *string, *todo.Status, *time.Time, *int, todo.CustomFieldValues, ...UpdateOption) error); ok {
+		r1 = returnFunc(ctx, scope, id, title, status, dueDate, estimatedMinutes, customFields, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -690,11 +3833,15 @@ type MockUpdater_Update_Call struct {
 //   - title *string
 //   - status *todo.Status
 //   - dueDate *time.Time
-func (_e *MockUpdater_Expecter) Update(ctx interface{}, scope interface{}, id interface{}, title interface{}, status interface{}, dueDate interface{}) *MockUpdater_Update_Call {
-	return &MockUpdater_Update_Call{Call: _e.mock.On("Update", ctx, scope, id, title, status, dueDate)}
+//   - estimatedMinutes *int
+//   - customFields todo.CustomFieldValues
+//   - opts ...UpdateOption
+func (_e *MockUpdater_Expecter) Update(ctx interface{}, scope interface{}, id interface{}, title interface{}, status interface{}, dueDate interface{}, estimatedMinutes interface{}, customFields interface{}, opts ...interface{}) *MockUpdater_Update_Call {
+	return &MockUpdater_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, scope, id, title, status, dueDate, estimatedMinutes, customFields}, opts...)...)}
 }
 
-func (_c *MockUpdater_Update_Call) Run(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time)) *MockUpdater_Update_Call {
+func (_c *MockUpdater_Update_Call) Run(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time, estimatedMinutes *int, customFields todo.CustomFieldValues, opts ...UpdateOption)) *MockUpdater_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -720,6 +3867,20 @@ func (_c *MockUpdater_Update_Call) Run(run func(ctx context.Context, scope trans
 		if args[5] != nil {
 			arg5 = args[5].(*time.Time)
 		}
+		var arg6 *int
+		if args[6] != nil {
+			arg6 = args[6].(*int)
+		}
+		var arg7 todo.CustomFieldValues
+		if args[7] != nil {
+			arg7 = args[7].(todo.CustomFieldValues)
+		}
+		var arg8 []UpdateOption
+		var variadicArgs []UpdateOption
+		if len(args) > 8 {
+			variadicArgs = args[8].([]UpdateOption)
+		}
+		arg8 = variadicArgs
 		run(
 			arg0,
 			arg1,
@@ -727,6 +3888,9 @@ func (_c *MockUpdater_Update_Call) Run(run func(ctx context.Context, scope trans
 			arg3,
 			arg4,
 			arg5,
+			arg6,
+			arg7,
+			arg8...,
 		)
 	})
 	return _c
@@ -737,7 +3901,303 @@ func (_c *MockUpdater_Update_Call) Return(todo1 todo.Todo, err error) *MockUpdat
 	return _c
 }
 
-func (_c *MockUpdater_Update_Call) RunAndReturn(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time) (todo.Todo, error)) *MockUpdater_Update_Call {
+func (_c *MockUpdater_Update_Call) RunAndReturn(run func(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *todo.Status, dueDate *time.Time, estimatedMinutes *int, customFields todo.CustomFieldValues, opts ...UpdateOption) (todo.Todo, error)) *MockUpdater_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockViews creates a new instance of MockViews. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockViews(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockViews {
+	mock := &MockViews{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockViews is an autogenerated mock type for the Views type
+type MockViews struct {
+	mock.Mock
+}
+
+type MockViews_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockViews) EXPECT() *MockViews_Expecter {
+	return &MockViews_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockViews
+func (_mock *MockViews) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockViews_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockViews_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockViews_Expecter) Delete(ctx interface{}, id interface{}) *MockViews_Delete_Call {
+	return &MockViews_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockViews_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockViews_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViews_Delete_Call) Return(err error) *MockViews_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockViews_Delete_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockViews_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockViews
+func (_mock *MockViews) List(ctx context.Context) ([]todo.View, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []todo.View
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]todo.View, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []todo.View); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]todo.View)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViews_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockViews_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockViews_Expecter) List(ctx interface{}) *MockViews_List_Call {
+	return &MockViews_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockViews_List_Call) Run(run func(ctx context.Context)) *MockViews_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViews_List_Call) Return(views []todo.View, err error) *MockViews_List_Call {
+	_c.Call.Return(views, err)
+	return _c
+}
+
+func (_c *MockViews_List_Call) RunAndReturn(run func(ctx context.Context) ([]todo.View, error)) *MockViews_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockViews
+func (_mock *MockViews) Save(ctx context.Context, name string, filter todo.ViewFilter) (todo.View, error) {
+	ret := _mock.Called(ctx, name, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 todo.View
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, todo.ViewFilter) (todo.View, error)); ok {
+		return returnFunc(ctx, name, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, todo.ViewFilter) todo.View); ok {
+		r0 = returnFunc(ctx, name, filter)
+	} else {
+		r0 = ret.Get(0).(todo.View)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, todo.ViewFilter) error); ok {
+		r1 = returnFunc(ctx, name, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViews_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockViews_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - filter todo.ViewFilter
+func (_e *MockViews_Expecter) Save(ctx interface{}, name interface{}, filter interface{}) *MockViews_Save_Call {
+	return &MockViews_Save_Call{Call: _e.mock.On("Save", ctx, name, filter)}
+}
+
+func (_c *MockViews_Save_Call) Run(run func(ctx context.Context, name string, filter todo.ViewFilter)) *MockViews_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 todo.ViewFilter
+		if args[2] != nil {
+			arg2 = args[2].(todo.ViewFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViews_Save_Call) Return(view todo.View, err error) *MockViews_Save_Call {
+	_c.Call.Return(view, err)
+	return _c
+}
+
+func (_c *MockViews_Save_Call) RunAndReturn(run func(ctx context.Context, name string, filter todo.ViewFilter) (todo.View, error)) *MockViews_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockViews
+func (_mock *MockViews) Update(ctx context.Context, id uuid.UUID, name string, filter todo.ViewFilter) (todo.View, error) {
+	ret := _mock.Called(ctx, id, name, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 todo.View
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, todo.ViewFilter) (todo.View, error)); ok {
+		return returnFunc(ctx, id, name, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, todo.ViewFilter) todo.View); ok {
+		r0 = returnFunc(ctx, id, name, filter)
+	} else {
+		r0 = ret.Get(0).(todo.View)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, todo.ViewFilter) error); ok {
+		r1 = returnFunc(ctx, id, name, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockViews_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockViews_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - name string
+//   - filter todo.ViewFilter
+func (_e *MockViews_Expecter) Update(ctx interface{}, id interface{}, name interface{}, filter interface{}) *MockViews_Update_Call {
+	return &MockViews_Update_Call{Call: _e.mock.On("Update", ctx, id, name, filter)}
+}
+
+func (_c *MockViews_Update_Call) Run(run func(ctx context.Context, id uuid.UUID, name string, filter todo.ViewFilter)) *MockViews_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 todo.ViewFilter
+		if args[3] != nil {
+			arg3 = args[3].(todo.ViewFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockViews_Update_Call) Return(view todo.View, err error) *MockViews_Update_Call {
+	_c.Call.Return(view, err)
+	return _c
+}
+
+func (_c *MockViews_Update_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, name string, filter todo.ViewFilter) (todo.View, error)) *MockViews_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,318 @@
+package todo
+
+import (
+	"cmp"
+	"context"
+	"embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"github.com/google/uuid"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	// maxProjectClusterTodos caps the ungrouped todos clustered in one refresh.
+	maxProjectClusterTodos = 200
+	// maxProjectSuggestions caps the project suggestions kept after a refresh, largest groups first.
+	maxProjectSuggestions = 5
+	// projectClusterSimilarity is the minimum cosine similarity between a todo and a cluster centroid for
+	// the todo to join the cluster.
+	projectClusterSimilarity = 0.75
+)
+
+// Projects defines the interface for suggesting groups of similar todos and accepting them as projects.
+type Projects interface {
+	// List returns the projects with their todos, newest first.
+	List(ctx context.Context) ([]domain.Project, error)
+	// Suggestions returns the pending project suggestions, largest group first.
+	Suggestions(ctx context.Context) ([]domain.ProjectSuggestion, error)
+	// Refresh clusters the ungrouped open todos by embedding, names each cluster and replaces the pending
+	// suggestions. It returns the number of suggestions stored.
+	Refresh(ctx context.Context) (int, error)
+	// Accept turns a suggestion into a project. An empty name keeps the suggested one.
+	Accept(ctx context.Context, suggestionID uuid.UUID, name string) (domain.Project, error)
+}
+
+// ProjectsImpl is the implementation of the Projects use case.
+type ProjectsImpl struct {
+	projectRepo  domain.ProjectRepository
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+	locker       core.Locker
+	assistant    assistant.Assistant
+	model        string
+}
+
+// NewProjectsImpl creates a new instance of ProjectsImpl. Refreshes of a tenant run on one replica at a time
+// when a locker is supplied.
+func NewProjectsImpl(
+	projectRepo domain.ProjectRepository,
+	uow transaction.UnitOfWork,
+	timeProvider core.CurrentTimeProvider,
+	locker core.Locker,
+	assistant assistant.Assistant,
+	model string,
+) ProjectsImpl {
+	return ProjectsImpl{
+		projectRepo:  projectRepo,
+		uow:          uow,
+		timeProvider: timeProvider,
+		locker:       locker,
+		assistant:    assistant,
+		model:        model,
+	}
+}
+
+// List returns the projects with their todos, newest first.
+func (p ProjectsImpl) List(ctx context.Context) ([]domain.Project, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	projects, err := p.projectRepo.ListProjects(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// Suggestions returns the pending project suggestions, largest group first.
+func (p ProjectsImpl) Suggestions(ctx context.Context) ([]domain.ProjectSuggestion, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	suggestions, err := p.projectRepo.ListProjectSuggestions(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+// Refresh clusters the ungrouped open todos by embedding and keeps the clusters of at least
+// domain.MinProjectSuggestionTodos todos as suggestions, each named by the assistant. The previous
+// suggestions are replaced even when no cluster is found.
+func (p ProjectsImpl) Refresh(ctx context.Context) (int, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if p.locker != nil {
+		// Lock keys are scoped to the tenant of ctx, so each tenant is refreshed by one replica at a time.
+		unlock, locked, err := p.locker.TryLock(spanCtx, "refresh_project_suggestions")
+		if telemetry.IsErrorRecorded(span, err) {
+			return 0, fmt.Errorf("failed to acquire lock: %w", err)
+		}
+		// Another replica is refreshing the suggestions of the tenant.
+		if !locked {
+			return 0, nil
+		}
+		defer unlock()
+	}
+
+	todos, err := p.projectRepo.ListUngroupedTodos(spanCtx, maxProjectClusterTodos)
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, fmt.Errorf("failed to list ungrouped todos: %w", err)
+	}
+
+	now := p.timeProvider.Now()
+	suggestions := []domain.ProjectSuggestion{}
+	for _, cluster := range clusterTodos(todos, projectClusterSimilarity) {
+		name, err := p.nameCluster(spanCtx, cluster)
+		if telemetry.IsErrorRecorded(span, err) {
+			return 0, fmt.Errorf("failed to name project suggestion: %w", err)
+		}
+		suggestions = append(suggestions, domain.ProjectSuggestion{
+			ID:        uuid.New(),
+			Name:      name,
+			Todos:     toProjectTodos(cluster),
+			CreatedAt: now,
+		})
+	}
+
+	err = p.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if err := scope.Project().DeleteProjectSuggestions(uowCtx); err != nil {
+			return err
+		}
+		return scope.Project().CreateProjectSuggestions(uowCtx, suggestions)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return 0, fmt.Errorf("failed to store project suggestions: %w", err)
+	}
+
+	return len(suggestions), nil
+}
+
+// Accept turns a suggestion into a project in one transaction: the project is created with the suggested
+// todos that still exist and the suggestion is removed. An empty name keeps the suggested one.
+func (p ProjectsImpl) Accept(ctx context.Context, suggestionID uuid.UUID, name string) (domain.Project, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var project domain.Project
+	err := p.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		suggestion, found, err := scope.Project().GetProjectSuggestion(uowCtx, suggestionID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return core.NewNotFoundErr(fmt.Sprintf("project suggestion with ID %s not found", suggestionID))
+		}
+
+		project = domain.Project{
+			ID:        uuid.New(),
+			Name:      cmp.Or(strings.TrimSpace(name), suggestion.Name),
+			Todos:     []domain.ProjectTodo{},
+			CreatedAt: p.timeProvider.Now(),
+		}
+		for _, member := range suggestion.Todos {
+			t, found, err := scope.Todo().GetTodo(uowCtx, member.ID)
+			if err != nil {
+				return err
+			}
+			if found {
+				project.Todos = append(project.Todos, domain.ProjectTodo{ID: t.ID, Title: t.Title})
+			}
+		}
+		if err := project.Validate(); err != nil {
+			return err
+		}
+
+		if err := scope.Project().CreateProject(uowCtx, project); err != nil {
+			return err
+		}
+		return scope.Project().DeleteProjectSuggestion(uowCtx, suggestionID)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Project{}, err
+	}
+
+	return project, nil
+}
+
+// nameCluster asks the assistant for a short project name for the todos. When the answer is empty the
+// title of the first todo is used.
+func (p ProjectsImpl) nameCluster(ctx context.Context, cluster []domain.Todo) (string, error) {
+	promptMessages, err := buildProjectNamePromptMessages(cluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to build prompt: %w", err)
+	}
+
+	resp, err := p.assistant.RunTurnSync(ctx, assistant.TurnRequest{
+		Model:          p.model,
+		Stream:         false,
+		Temperature:    common.Ptr(0.2),
+		Messages:       promptMessages,
+		ResponseSchema: assistant.NewTextResponseSchema("project_name", "name", "Short name of the project grouping the todos."),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	metrics.RecordLLMTokensUsed(ctx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+
+	name := strings.Trim(strings.TrimSpace(resp.TextField("name")), `"'.`)
+	if name == "" {
+		name = cluster[0].Title
+	}
+	if runes := []rune(name); len(runes) > domain.MaxProjectNameChars {
+		name = strings.TrimSpace(string(runes[:domain.MaxProjectNameChars]))
+	}
+	return name, nil
+}
+
+//go:embed prompts/project_name.yml
+var projectNamePrompt embed.FS
+
+// buildProjectNamePromptMessages constructs the LLM messages for the project name prompt.
+func buildProjectNamePromptMessages(cluster []domain.Todo) ([]assistant.Message, error) {
+	file, err := projectNamePrompt.Open("prompts/project_name.yml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open project name prompt: %w", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	messages := []assistant.Message{}
+	if err := yaml.NewDecoder(file).Decode(&messages); err != nil {
+		return nil, fmt.Errorf("failed to decode project name prompt: %w", err)
+	}
+
+	titles := make([]string, len(cluster))
+	for i, t := range cluster {
+		titles[i] = t.Title
+	}
+
+	for i, msg := range messages {
+		if strings.Contains(msg.Content, "%[") {
+			msg.Content = fmt.Sprintf(msg.Content, strings.Join(titles, "; "))
+		}
+		messages[i] = msg
+	}
+
+	return messages, nil
+}
+
+// clusterTodos groups todos whose embeddings are close to each other. Each todo joins the cluster with the
+// most similar centroid when the similarity reaches minSimilarity, otherwise it starts a new cluster.
+// Only the clusters of at least domain.MinProjectSuggestionTodos todos are returned, largest first and
+// capped at maxProjectSuggestions.
+func clusterTodos(todos []domain.Todo, minSimilarity float64) [][]domain.Todo {
+	type cluster struct {
+		todos    []domain.Todo
+		centroid []float64
+	}
+
+	clusters := []*cluster{}
+	for _, t := range todos {
+		var best *cluster
+		bestSimilarity := minSimilarity
+		for _, c := range clusters {
+			similarity, ok := semantic.CosineSimilarity(t.Embedding, c.centroid)
+			if ok && similarity >= bestSimilarity {
+				best, bestSimilarity = c, similarity
+			}
+		}
+		if best == nil {
+			clusters = append(clusters, &cluster{todos: []domain.Todo{t}, centroid: slices.Clone(t.Embedding)})
+			continue
+		}
+
+		n := float64(len(best.todos))
+		for i := range best.centroid {
+			best.centroid[i] = (best.centroid[i]*n + t.Embedding[i]) / (n + 1)
+		}
+		best.todos = append(best.todos, t)
+	}
+
+	groups := [][]domain.Todo{}
+	for _, c := range clusters {
+		if len(c.todos) >= domain.MinProjectSuggestionTodos {
+			groups = append(groups, c.todos)
+		}
+	}
+	slices.SortStableFunc(groups, func(a, b []domain.Todo) int {
+		return cmp.Compare(len(b), len(a))
+	})
+	if len(groups) > maxProjectSuggestions {
+		groups = groups[:maxProjectSuggestions]
+	}
+	return groups
+}
+
+// toProjectTodos returns the ID and title of each todo.
+func toProjectTodos(todos []domain.Todo) []domain.ProjectTodo {
+	members := make([]domain.ProjectTodo, len(todos))
+	for i, t := range todos {
+		members[i] = domain.ProjectTodo{ID: t.ID, Title: t.Title}
+	}
+	return members
+}
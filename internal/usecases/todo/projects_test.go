@@ -0,0 +1,362 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProjectsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	projects := []domain.Project{{ID: uuid.New(), Name: "House move"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockProjectRepository)
+		expected        []domain.Project
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *domain.MockProjectRepository) {
+				repo.EXPECT().ListProjects(mock.Anything).Return(projects, nil).Once()
+			},
+			expected: projects,
+		},
+		"repository-error": {
+			setExpectations: func(repo *domain.MockProjectRepository) {
+				repo.EXPECT().ListProjects(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockProjectRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewProjectsImpl(repo, transaction.NewMockUnitOfWork(t), core.NewMockCurrentTimeProvider(t), nil, assistant.NewMockAssistant(t), "model")
+			got, err := uc.List(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestProjectsImpl_Suggestions(t *testing.T) {
+	t.Parallel()
+
+	suggestions := []domain.ProjectSuggestion{{ID: uuid.New(), Name: "House move"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockProjectRepository)
+		expected        []domain.ProjectSuggestion
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *domain.MockProjectRepository) {
+				repo.EXPECT().ListProjectSuggestions(mock.Anything).Return(suggestions, nil).Once()
+			},
+			expected: suggestions,
+		},
+		"repository-error": {
+			setExpectations: func(repo *domain.MockProjectRepository) {
+				repo.EXPECT().ListProjectSuggestions(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockProjectRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewProjectsImpl(repo, transaction.NewMockUnitOfWork(t), core.NewMockCurrentTimeProvider(t), nil, assistant.NewMockAssistant(t), "model")
+			got, err := uc.Suggestions(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestProjectsImpl_Refresh(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	move := []domain.Todo{
+		{ID: uuid.New(), Title: "Pack kitchen boxes", Embedding: []float64{1, 0.1}},
+		{ID: uuid.New(), Title: "Book movers", Embedding: []float64{1, 0}},
+		{ID: uuid.New(), Title: "Change address at the bank", Embedding: []float64{0.9, 0.1}},
+	}
+	unrelated := domain.Todo{ID: uuid.New(), Title: "Dentist appointment", Embedding: []float64{0, 1}}
+	todos := append(append([]domain.Todo{}, move...), unrelated)
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockProjectRepository, uow *transaction.MockUnitOfWork, ai *assistant.MockAssistant)
+		lock            func(locker *core.MockLocker)
+		expected        int
+		expectedErr     string
+	}{
+		"stores-named-clusters": {
+			setExpectations: func(repo *domain.MockProjectRepository, uow *transaction.MockUnitOfWork, ai *assistant.MockAssistant) {
+				repo.EXPECT().ListUngroupedTodos(mock.Anything, maxProjectClusterTodos).Return(todos, nil).Once()
+				ai.EXPECT().RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+					return req.Model == "model" &&
+						len(req.Messages) == 2 &&
+						strings.Contains(req.Messages[1].Content, "TODOS: Pack kitchen boxes; Book movers; Change address at the bank")
+				})).Return(assistant.TurnResponse{Content: `{"name":"\"House move\""}`}, nil).Once()
+				scopeRepo := expectScope(t, uow).Project
+				scopeRepo.EXPECT().DeleteProjectSuggestions(mock.Anything).Return(nil).Once()
+				scopeRepo.EXPECT().CreateProjectSuggestions(mock.Anything, mock.MatchedBy(func(s []domain.ProjectSuggestion) bool {
+					return len(s) == 1 && s[0].ID != uuid.Nil && s[0].Name == "House move" &&
+						assert.ObjectsAreEqual(toProjectTodos(move), s[0].Todos) && s[0].CreatedAt.Equal(now)
+				})).Return(nil).Once()
+			},
+			expected: 1,
+		},
+		"clears-stale-suggestions": {
+			setExpectations: func(repo *domain.MockProjectRepository, uow *transaction.MockUnitOfWork, _ *assistant.MockAssistant) {
+				repo.EXPECT().ListUngroupedTodos(mock.Anything, maxProjectClusterTodos).Return([]domain.Todo{unrelated}, nil).Once()
+				scopeRepo := expectScope(t, uow).Project
+				scopeRepo.EXPECT().DeleteProjectSuggestions(mock.Anything).Return(nil).Once()
+				scopeRepo.EXPECT().CreateProjectSuggestions(mock.Anything, []domain.ProjectSuggestion{}).Return(nil).Once()
+			},
+		},
+		"another-replica-refreshing": {
+			setExpectations: func(*domain.MockProjectRepository, *transaction.MockUnitOfWork, *assistant.MockAssistant) {},
+			lock: func(locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "refresh_project_suggestions").Return(nil, false, nil).Once()
+			},
+		},
+		"lock-error": {
+			setExpectations: func(*domain.MockProjectRepository, *transaction.MockUnitOfWork, *assistant.MockAssistant) {},
+			lock: func(locker *core.MockLocker) {
+				locker.EXPECT().TryLock(mock.Anything, "refresh_project_suggestions").Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: "failed to acquire lock: db error",
+		},
+		"list-error": {
+			setExpectations: func(repo *domain.MockProjectRepository, _ *transaction.MockUnitOfWork, _ *assistant.MockAssistant) {
+				repo.EXPECT().ListUngroupedTodos(mock.Anything, maxProjectClusterTodos).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: "failed to list ungrouped todos: db error",
+		},
+		"assistant-error": {
+			setExpectations: func(repo *domain.MockProjectRepository, _ *transaction.MockUnitOfWork, ai *assistant.MockAssistant) {
+				repo.EXPECT().ListUngroupedTodos(mock.Anything, maxProjectClusterTodos).Return(todos, nil).Once()
+				ai.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{}, errors.New("llm error")).Once()
+			},
+			expectedErr: "failed to name project suggestion: llm error",
+		},
+		"store-error": {
+			setExpectations: func(repo *domain.MockProjectRepository, uow *transaction.MockUnitOfWork, _ *assistant.MockAssistant) {
+				repo.EXPECT().ListUngroupedTodos(mock.Anything, maxProjectClusterTodos).Return(nil, nil).Once()
+				scopeRepo := expectScope(t, uow).Project
+				scopeRepo.EXPECT().DeleteProjectSuggestions(mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: "failed to store project suggestions: db error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockProjectRepository(t)
+			uow := transaction.NewMockUnitOfWork(t)
+			ai := assistant.NewMockAssistant(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+			tt.setExpectations(repo, uow, ai)
+			locker := core.NewMockLocker(t)
+			if tt.lock != nil {
+				tt.lock(locker)
+			} else {
+				locker.EXPECT().TryLock(mock.Anything, "refresh_project_suggestions").Return(func() {}, true, nil).Once()
+			}
+
+			got, err := NewProjectsImpl(repo, uow, timeProvider, locker, ai, "model").Refresh(t.Context())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestProjectsImpl_Accept(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	suggestionID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	packID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	moversID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	suggestion := domain.ProjectSuggestion{
+		ID:   suggestionID,
+		Name: "House move",
+		Todos: []domain.ProjectTodo{
+			{ID: packID, Title: "Pack kitchen boxes"},
+			{ID: moversID, Title: "Book movers"},
+		},
+	}
+
+	tests := map[string]struct {
+		name            string
+		setExpectations func(todoRepo *domain.MockRepository, projectRepo *domain.MockProjectRepository)
+		expected        domain.Project
+		expectedErr     error
+	}{
+		"keeps-suggested-name-and-skips-deleted-todos": {
+			setExpectations: func(todoRepo *domain.MockRepository, projectRepo *domain.MockProjectRepository) {
+				projectRepo.EXPECT().GetProjectSuggestion(mock.Anything, suggestionID).Return(suggestion, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, packID).Return(domain.Todo{ID: packID, Title: "Pack all boxes"}, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, moversID).Return(domain.Todo{}, false, nil).Once()
+				projectRepo.EXPECT().CreateProject(mock.Anything, mock.MatchedBy(func(p domain.Project) bool {
+					return p.ID != uuid.Nil && p.Name == "House move" && len(p.Todos) == 1
+				})).Return(nil).Once()
+				projectRepo.EXPECT().DeleteProjectSuggestion(mock.Anything, suggestionID).Return(nil).Once()
+			},
+			expected: domain.Project{
+				Name:      "House move",
+				Todos:     []domain.ProjectTodo{{ID: packID, Title: "Pack all boxes"}},
+				CreatedAt: now,
+			},
+		},
+		"renames": {
+			name: " Moving to Lisbon ",
+			setExpectations: func(todoRepo *domain.MockRepository, projectRepo *domain.MockProjectRepository) {
+				projectRepo.EXPECT().GetProjectSuggestion(mock.Anything, suggestionID).Return(suggestion, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, packID).Return(domain.Todo{ID: packID, Title: "Pack kitchen boxes"}, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, moversID).Return(domain.Todo{ID: moversID, Title: "Book movers"}, true, nil).Once()
+				projectRepo.EXPECT().CreateProject(mock.Anything, mock.Anything).Return(nil).Once()
+				projectRepo.EXPECT().DeleteProjectSuggestion(mock.Anything, suggestionID).Return(nil).Once()
+			},
+			expected: domain.Project{
+				Name:      "Moving to Lisbon",
+				Todos:     suggestion.Todos,
+				CreatedAt: now,
+			},
+		},
+		"suggestion-not-found": {
+			setExpectations: func(_ *domain.MockRepository, projectRepo *domain.MockProjectRepository) {
+				projectRepo.EXPECT().GetProjectSuggestion(mock.Anything, suggestionID).Return(domain.ProjectSuggestion{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("project suggestion with ID 223e4567-e89b-12d3-a456-426614174001 not found"),
+		},
+		"all-todos-deleted": {
+			setExpectations: func(todoRepo *domain.MockRepository, projectRepo *domain.MockProjectRepository) {
+				projectRepo.EXPECT().GetProjectSuggestion(mock.Anything, suggestionID).Return(suggestion, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, mock.Anything).Return(domain.Todo{}, false, nil).Twice()
+			},
+			expectedErr: core.NewValidationErr("project must group at least one todo"),
+		},
+		"create-error": {
+			setExpectations: func(todoRepo *domain.MockRepository, projectRepo *domain.MockProjectRepository) {
+				projectRepo.EXPECT().GetProjectSuggestion(mock.Anything, suggestionID).Return(suggestion, true, nil).Once()
+				todoRepo.EXPECT().GetTodo(mock.Anything, mock.Anything).Return(domain.Todo{ID: packID, Title: "Pack kitchen boxes"}, true, nil).Twice()
+				projectRepo.EXPECT().CreateProject(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+			repos := expectScope(t, uow)
+			todoRepo, projectRepo := repos.Todo, repos.Project
+			tt.setExpectations(todoRepo, projectRepo)
+
+			uc := NewProjectsImpl(domain.NewMockProjectRepository(t), uow, timeProvider, nil, assistant.NewMockAssistant(t), "model")
+			got, err := uc.Accept(t.Context(), suggestionID, tt.name)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.NotEqual(t, uuid.Nil, got.ID)
+				got.ID = uuid.Nil
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestClusterTodos(t *testing.T) {
+	t.Parallel()
+
+	todo := func(title string, embedding ...float64) domain.Todo {
+		return domain.Todo{Title: title, Embedding: embedding}
+	}
+
+	tests := map[string]struct {
+		todos    []domain.Todo
+		expected [][]string
+	}{
+		"groups-similar-todos-largest-first": {
+			todos: []domain.Todo{
+				todo("Pack boxes", 1, 0),
+				todo("File taxes", 0, 1),
+				todo("Book movers", 0.95, 0.05),
+				todo("Collect receipts", 0.05, 0.95),
+				todo("Change address", 0.9, 0.1),
+				todo("Pay accountant", 0.1, 0.9),
+				todo("Cancel internet", 1, 0.1),
+			},
+			expected: [][]string{
+				{"Pack boxes", "Book movers", "Change address", "Cancel internet"},
+				{"File taxes", "Collect receipts", "Pay accountant"},
+			},
+		},
+		"drops-small-clusters": {
+			todos: []domain.Todo{
+				todo("Pack boxes", 1, 0),
+				todo("Book movers", 1, 0),
+				todo("File taxes", 0, 1),
+			},
+			expected: [][]string{},
+		},
+		"skips-todos-without-embedding": {
+			todos: []domain.Todo{
+				todo("Pack boxes", 1, 0),
+				todo("Untitled"),
+				todo("Book movers", 1, 0),
+				todo("Change address", 1, 0),
+			},
+			expected: [][]string{{"Pack boxes", "Book movers", "Change address"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := [][]string{}
+			for _, cluster := range clusterTodos(tt.todos, projectClusterSimilarity) {
+				titles := []string{}
+				for _, t := range cluster {
+					titles = append(titles, t.Title)
+				}
+				got = append(got, titles)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package todo
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
+	"go.yaml.in/yaml/v3"
+)
+
+// QuickAddSource tells what parsed a quick add line.
+type QuickAddSource string
+
+const (
+	// QuickAddSource_PARSER means the deterministic parser read the whole line.
+	QuickAddSource_PARSER QuickAddSource = "PARSER"
+	// QuickAddSource_LLM means the model resolved the title and due date the parser found no date in.
+	QuickAddSource_LLM QuickAddSource = "LLM"
+)
+
+// quickAddSchema is the structured response requested from the model.
+var quickAddSchema = &assistant.ResponseSchema{
+	Name: "quick_add",
+	Input: assistant.ActionInput{
+		Type: "object",
+		Fields: map[string]assistant.ActionField{
+			"title":    {Type: "string", Description: "What has to be done, without the date words.", Required: true},
+			"due_date": {Type: "string", Description: "Due date as YYYY-MM-DD, or empty when the line names no date.", Required: true},
+		},
+	},
+}
+
+// QuickAddPreview is the todo a quick add line describes.
+type QuickAddPreview struct {
+	Title string
+	// DueDate is the day the line names, or today when it names none.
+	DueDate  time.Time
+	Tags     []string
+	Priority string
+	// CustomFields holds the values the tags and priority set on the tenant's custom fields.
+	CustomFields domain.CustomFieldValues
+	// Unresolved lists the #tag and !priority words that match no custom field and are not applied.
+	Unresolved []string
+	Source     QuickAddSource
+}
+
+// QuickAdd defines the interface for creating todos from one line of free text.
+type QuickAdd interface {
+	// Preview parses the line without creating the todo.
+	Preview(ctx context.Context, text string) (QuickAddPreview, error)
+	// Execute parses the line and creates the todo it describes.
+	Execute(ctx context.Context, text string) (QuickAddPreview, domain.Todo, error)
+}
+
+// QuickAddImpl is the implementation of the QuickAdd use case.
+type QuickAddImpl struct {
+	customFieldRepo domain.CustomFieldRepository
+	uow             transaction.UnitOfWork
+	creator         Creator
+	timeProvider    core.CurrentTimeProvider
+	assistant       assistant.Assistant
+	model           string
+}
+
+// NewQuickAddImpl creates a new instance of QuickAddImpl. An empty model disables the LLM fallback.
+func NewQuickAddImpl(
+	customFieldRepo domain.CustomFieldRepository,
+	uow transaction.UnitOfWork,
+	creator Creator,
+	timeProvider core.CurrentTimeProvider,
+	assistant assistant.Assistant,
+	model string,
+) QuickAddImpl {
+	return QuickAddImpl{
+		customFieldRepo: customFieldRepo,
+		uow:             uow,
+		creator:         creator,
+		timeProvider:    timeProvider,
+		assistant:       assistant,
+		model:           model,
+	}
+}
+
+// Preview implements QuickAdd. When the parser finds no date and a model is set, the model reads the
+// remaining title; if it fails, the parser result is kept.
+func (uc QuickAddImpl) Preview(ctx context.Context, text string) (QuickAddPreview, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	preview, err := uc.preview(spanCtx, text)
+	if telemetry.IsErrorRecorded(span, err) {
+		return QuickAddPreview{}, err
+	}
+	return preview, nil
+}
+
+// Execute implements QuickAdd.
+func (uc QuickAddImpl) Execute(ctx context.Context, text string) (QuickAddPreview, domain.Todo, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	preview, err := uc.preview(spanCtx, text)
+	if telemetry.IsErrorRecorded(span, err) {
+		return QuickAddPreview{}, domain.Todo{}, err
+	}
+
+	var todo domain.Todo
+	err = uc.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		var err error
+		todo, err = uc.creator.Create(uowCtx, scope, preview.Title, preview.DueDate, 0, preview.CustomFields)
+		return err
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return QuickAddPreview{}, domain.Todo{}, err
+	}
+
+	return preview, todo, nil
+}
+
+// preview parses the line and maps its tags and priority onto the tenant's custom fields.
+func (uc QuickAddImpl) preview(ctx context.Context, text string) (QuickAddPreview, error) {
+	now := uc.timeProvider.Now()
+	parsed, err := domain.ParseQuickAdd(text, now)
+	if err != nil {
+		return QuickAddPreview{}, err
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	preview := QuickAddPreview{
+		Title:    parsed.Title,
+		DueDate:  today,
+		Tags:     parsed.Tags,
+		Priority: parsed.Priority,
+		Source:   QuickAddSource_PARSER,
+	}
+	if parsed.DueDate != nil {
+		preview.DueDate = *parsed.DueDate
+	} else if uc.model != "" {
+		if title, dueDate, ok := uc.resolveWithModel(ctx, parsed.Title, today); ok {
+			preview.Title, preview.DueDate, preview.Source = title, dueDate, QuickAddSource_LLM
+		}
+	}
+
+	fields, err := uc.customFieldRepo.ListCustomFields(ctx)
+	if err != nil {
+		return QuickAddPreview{}, err
+	}
+	preview.CustomFields, preview.Unresolved = parsed.CustomFieldValues(fields)
+
+	return preview, nil
+}
+
+// resolveWithModel asks the model for the title and due date of a line the parser found no date in. It
+// reports false when the model fails or names no valid date.
+func (uc QuickAddImpl) resolveWithModel(ctx context.Context, line string, today time.Time) (string, time.Time, bool) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	promptMessages, err := buildQuickAddPromptMessages(line, today)
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", time.Time{}, false
+	}
+
+	resp, err := uc.assistant.RunTurnSync(spanCtx, assistant.TurnRequest{
+		Model:          uc.model,
+		Stream:         false,
+		Temperature:    common.Ptr(0.0),
+		Messages:       promptMessages,
+		ResponseSchema: quickAddSchema,
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return "", time.Time{}, false
+	}
+
+	metrics.RecordLLMTokensUsed(spanCtx, resp.Usage.PromptTokens, resp.Usage.CompletionTokens)
+
+	title := strings.TrimSpace(resp.TextField("title"))
+	dueDate, err := time.Parse(time.DateOnly, strings.TrimSpace(resp.TextField("due_date")))
+	if title == "" || err != nil {
+		return "", time.Time{}, false
+	}
+	return title, dueDate, true
+}
+
+//go:embed prompts/quick_add.yml
+var quickAddPrompt embed.FS
+
+// buildQuickAddPromptMessages constructs the LLM messages for the quick add prompt.
+func buildQuickAddPromptMessages(line string, today time.Time) ([]assistant.Message, error) {
+	file, err := quickAddPrompt.Open("prompts/quick_add.yml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open quick add prompt: %w", err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	messages := []assistant.Message{}
+	if err := yaml.NewDecoder(file).Decode(&messages); err != nil {
+		return nil, fmt.Errorf("failed to decode quick add prompt: %w", err)
+	}
+
+	for i, msg := range messages {
+		if strings.Contains(msg.Content, "%[") {
+			msg.Content = fmt.Sprintf(msg.Content, line, today.Format("2006-01-02 (Monday)"))
+		}
+		messages[i] = msg
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,208 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQuickAddImpl_Preview(t *testing.T) {
+	t.Parallel()
+
+	// A Friday.
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	today := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	fields := []domain.CustomField{
+		{Name: "area", Type: domain.CustomFieldType_ENUM, Options: []string{"health", "home"}},
+		{Name: domain.PriorityCustomField, Type: domain.CustomFieldType_ENUM, Options: []string{"high", "low"}},
+	}
+
+	tests := map[string]struct {
+		text            string
+		model           string
+		setExpectations func(repo *domain.MockCustomFieldRepository, ai *assistant.MockAssistant)
+		expected        QuickAddPreview
+		expectedErr     error
+	}{
+		"parsed": {
+			text: "dentist next tue 3pm #health #later !high",
+			setExpectations: func(repo *domain.MockCustomFieldRepository, _ *assistant.MockAssistant) {
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(fields, nil).Once()
+			},
+			expected: QuickAddPreview{
+				Title:        "dentist 3pm",
+				DueDate:      today.AddDate(0, 0, 4),
+				Tags:         []string{"health", "later"},
+				Priority:     "high",
+				CustomFields: domain.CustomFieldValues{"area": "health", "priority": "high"},
+				Unresolved:   []string{"#later"},
+				Source:       QuickAddSource_PARSER,
+			},
+		},
+		"no-date-without-model-is-due-today": {
+			text: "call mom",
+			setExpectations: func(repo *domain.MockCustomFieldRepository, _ *assistant.MockAssistant) {
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+			},
+			expected: QuickAddPreview{
+				Title:        "call mom",
+				DueDate:      today,
+				CustomFields: domain.CustomFieldValues{},
+				Unresolved:   []string{},
+				Source:       QuickAddSource_PARSER,
+			},
+		},
+		"llm-fallback": {
+			text:  "pay rent end of the month #home",
+			model: "model",
+			setExpectations: func(repo *domain.MockCustomFieldRepository, ai *assistant.MockAssistant) {
+				ai.EXPECT().RunTurnSync(mock.Anything, mock.MatchedBy(func(req assistant.TurnRequest) bool {
+					return req.Model == "model" && req.ResponseSchema == quickAddSchema &&
+						len(req.Messages) == 2 &&
+						strings.Contains(req.Messages[1].Content, "TODAY: 2026-10-16 (Friday)") &&
+						strings.Contains(req.Messages[1].Content, "LINE: pay rent end of the month")
+				})).Return(assistant.TurnResponse{Content: `{"title":"Pay rent","due_date":"2026-10-31"}`}, nil).Once()
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(fields, nil).Once()
+			},
+			expected: QuickAddPreview{
+				Title:        "Pay rent",
+				DueDate:      time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+				Tags:         []string{"home"},
+				CustomFields: domain.CustomFieldValues{"area": "home"},
+				Unresolved:   []string{},
+				Source:       QuickAddSource_LLM,
+			},
+		},
+		"llm-without-date-keeps-parser-result": {
+			text:  "call mom",
+			model: "model",
+			setExpectations: func(repo *domain.MockCustomFieldRepository, ai *assistant.MockAssistant) {
+				ai.EXPECT().RunTurnSync(mock.Anything, mock.Anything).
+					Return(assistant.TurnResponse{Content: `{"title":"Call mom","due_date":""}`}, nil).Once()
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+			},
+			expected: QuickAddPreview{
+				Title:        "call mom",
+				DueDate:      today,
+				CustomFields: domain.CustomFieldValues{},
+				Unresolved:   []string{},
+				Source:       QuickAddSource_PARSER,
+			},
+		},
+		"llm-error-keeps-parser-result": {
+			text:  "call mom",
+			model: "model",
+			setExpectations: func(repo *domain.MockCustomFieldRepository, ai *assistant.MockAssistant) {
+				ai.EXPECT().RunTurnSync(mock.Anything, mock.Anything).Return(assistant.TurnResponse{}, errors.New("llm down")).Once()
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+			},
+			expected: QuickAddPreview{
+				Title:        "call mom",
+				DueDate:      today,
+				CustomFields: domain.CustomFieldValues{},
+				Unresolved:   []string{},
+				Source:       QuickAddSource_PARSER,
+			},
+		},
+		"invalid-text": {
+			text:            "  ",
+			setExpectations: func(*domain.MockCustomFieldRepository, *assistant.MockAssistant) {},
+			expectedErr:     core.NewValidationErr("text cannot be empty"),
+		},
+		"custom-fields-error": {
+			text: "call mom today",
+			setExpectations: func(repo *domain.MockCustomFieldRepository, _ *assistant.MockAssistant) {
+				repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockCustomFieldRepository(t)
+			ai := assistant.NewMockAssistant(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+			tt.setExpectations(repo, ai)
+
+			uc := NewQuickAddImpl(repo, transaction.NewMockUnitOfWork(t), NewMockCreator(t), timeProvider, ai, tt.model)
+			preview, err := uc.Preview(t.Context(), tt.text)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, preview)
+		})
+	}
+}
+
+func TestQuickAddImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	tomorrow := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	created := domain.Todo{
+		ID:      uuid.MustParse("95000000-0000-0000-0000-000000000001"),
+		Title:   "water plants",
+		Status:  domain.Status_OPEN,
+		DueDate: tomorrow,
+	}
+
+	tests := map[string]struct {
+		setExpectations func(creator *MockCreator)
+		expectedTodo    domain.Todo
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(creator *MockCreator) {
+				creator.EXPECT().
+					Create(mock.Anything, mock.Anything, "water plants", tomorrow, 0, domain.CustomFieldValues{}).
+					Return(created, nil).
+					Once()
+			},
+			expectedTodo: created,
+		},
+		"creator-error": {
+			setExpectations: func(creator *MockCreator) {
+				creator.EXPECT().
+					Create(mock.Anything, mock.Anything, "water plants", tomorrow, 0, domain.CustomFieldValues{}).
+					Return(domain.Todo{}, errors.New("creation failed")).
+					Once()
+			},
+			expectedErr: errors.New("creation failed"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockCustomFieldRepository(t)
+			repo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Once()
+			uow := transaction.NewMockUnitOfWork(t)
+			expectScope(t, uow)
+			creator := NewMockCreator(t)
+			tt.setExpectations(creator)
+
+			uc := NewQuickAddImpl(repo, uow, creator, timeProvider, assistant.NewMockAssistant(t), "")
+			preview, todo, err := uc.Execute(t.Context(), "water plants tomorrow")
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedTodo, todo)
+			if tt.expectedErr == nil {
+				assert.Equal(t, "water plants", preview.Title)
+				assert.Equal(t, tomorrow, preview.DueDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// ScheduleNextOccurrence defines the interface for creating the next occurrence of a completed recurring todo.
+type ScheduleNextOccurrence interface {
+	Execute(ctx context.Context, event outbox.TodoEvent) error
+}
+
+// ScheduleNextOccurrenceImpl is the implementation of the ScheduleNextOccurrence use case.
+type ScheduleNextOccurrenceImpl struct {
+	locker       core.Locker
+	uow          transaction.UnitOfWork
+	creator      Creator
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewScheduleNextOccurrenceImpl creates a new instance of ScheduleNextOccurrenceImpl.
+func NewScheduleNextOccurrenceImpl(
+	locker core.Locker,
+	uow transaction.UnitOfWork,
+	creator Creator,
+	timeProvider core.CurrentTimeProvider,
+) ScheduleNextOccurrenceImpl {
+	return ScheduleNextOccurrenceImpl{
+		locker:       locker,
+		uow:          uow,
+		creator:      creator,
+		timeProvider: timeProvider,
+	}
+}
+
+// Execute handles a todo event. When the todo is a DONE recurring todo, the next occurrence is created with the
+// same title, estimate, location and rule, and the completed todo stops recurring, all in one unit of work.
+// Custom field values stay with the completed todo, since their fields may have changed since it was created.
+// Events of other todos, and redeliveries of an event already handled, change nothing.
+func (s ScheduleNextOccurrenceImpl) Execute(ctx context.Context, event outbox.TodoEvent) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if event.Type != outbox.EventType_TODO_UPDATED {
+		return nil
+	}
+
+	unlock, locked, err := s.locker.TryLock(spanCtx, "schedule_next_occurrence:"+event.TodoID.String())
+	if telemetry.IsErrorRecorded(span, err) {
+		return fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !locked {
+		return nil
+	}
+	defer unlock()
+
+	err = s.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		completed, found, err := scope.Todo().GetTodo(uowCtx, event.TodoID)
+		if err != nil {
+			return err
+		}
+		if !found || completed.Status != domain.Status_DONE || completed.Recurrence == nil {
+			return nil
+		}
+
+		now := s.timeProvider.Now()
+		opts := []CreateOption{WithCreateRecurrence(completed.Recurrence.AnchoredTo(completed.DueDate))}
+		if completed.Location != nil {
+			opts = append(opts, WithCreateLocation(*completed.Location))
+		}
+		dueDate := completed.Recurrence.NextOccurrence(completed.DueDate, now)
+		if _, err := s.creator.Create(uowCtx, scope, completed.Title, dueDate, completed.EstimatedMinutes, nil, opts...); err != nil {
+			return err
+		}
+
+		completed.Recurrence = nil
+		completed.UpdatedAt = now
+		if err := scope.Todo().UpdateTodo(uowCtx, completed); err != nil {
+			return err
+		}
+		return recordTodoChange(uowCtx, scope, outbox.EventType_TODO_UPDATED, completed.ID, now)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScheduleNextOccurrenceImpl_Execute(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2026, 10, 14, 18, 0, 0, 0, time.UTC)
+	// 2026-10-12 is a Monday.
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	weekly := domain.Recurrence{Frequency: domain.RecurrenceFrequency_WEEKLY, Interval: 1, Weekdays: []time.Weekday{time.Monday}}
+	office := domain.Location{Name: "Office"}
+	completed := domain.Todo{
+		ID:               todoID,
+		Title:            "Send weekly report",
+		Status:           domain.Status_DONE,
+		DueDate:          monday,
+		EstimatedMinutes: 30,
+		CustomFields:     domain.CustomFieldValues{"priority": "high"},
+		Location:         &office,
+		Recurrence:       &weekly,
+	}
+	updated := outbox.TodoEvent{Type: outbox.EventType_TODO_UPDATED, TodoID: todoID}
+
+	tests := map[string]struct {
+		event           outbox.TodoEvent
+		setExpectations func(locker *core.MockLocker, uow *transaction.MockUnitOfWork, creator *MockCreator, timeProvider *core.MockCurrentTimeProvider)
+		expectedErr     string
+	}{
+		"creates-next-occurrence": {
+			event: updated,
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork, creator *MockCreator, timeProvider *core.MockCurrentTimeProvider) {
+				locker.EXPECT().TryLock(mock.Anything, "schedule_next_occurrence:"+todoID.String()).
+					Return(func() {}, true, nil).
+					Once()
+				timeProvider.EXPECT().Now().Return(now).Once()
+
+				repos := expectScope(t, uow)
+
+				repos.Todo.EXPECT().GetTodo(mock.Anything, todoID).Return(completed, true, nil).Once()
+				creator.EXPECT().Create(
+					mock.Anything,
+					mock.Anything,
+					"Send weekly report",
+					time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+					30,
+					domain.CustomFieldValues(nil),
+					mock.Anything,
+					mock.Anything,
+				).
+					RunAndReturn(func(_ context.Context, _ transaction.Scope, _ string, _ time.Time, _ int, _ domain.CustomFieldValues, opts ...CreateOption) (domain.Todo, error) {
+						params := CreateParams{}
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, &weekly, params.Recurrence)
+						assert.Equal(t, &office, params.Location)
+						return domain.Todo{ID: uuid.New()}, nil
+					}).
+					Once()
+				repos.Todo.EXPECT().UpdateTodo(mock.Anything, mock.MatchedBy(func(td domain.Todo) bool {
+					return td.ID == todoID && td.Recurrence == nil && td.UpdatedAt.Equal(now)
+				})).Return(nil).Once()
+				repos.Change.EXPECT().RecordChange(mock.Anything, domain.Change{
+					TodoID:    todoID,
+					Type:      domain.ChangeType_Updated,
+					CreatedAt: now,
+				}).Return(domain.Change{Sequence: 9}, nil).Once()
+				repos.Outbox.EXPECT().CreateTodoEvent(mock.Anything, outbox.TodoEvent{
+					Type:      outbox.EventType_TODO_UPDATED,
+					TodoID:    todoID,
+					CreatedAt: now,
+					Sequence:  9,
+				}).Return(nil).Once()
+			},
+		},
+		"ignores-other-event-types": {
+			event: outbox.TodoEvent{Type: outbox.EventType_TODO_CREATED, TodoID: todoID},
+			setExpectations: func(_ *core.MockLocker, _ *transaction.MockUnitOfWork, _ *MockCreator, _ *core.MockCurrentTimeProvider) {
+			},
+		},
+		"already-handled": {
+			event: updated,
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork, _ *MockCreator, _ *core.MockCurrentTimeProvider) {
+				locker.EXPECT().TryLock(mock.Anything, mock.Anything).Return(func() {}, true, nil).Once()
+
+				repos := expectScope(t, uow)
+
+				handled := completed
+				handled.Recurrence = nil
+				repos.Todo.EXPECT().GetTodo(mock.Anything, todoID).Return(handled, true, nil).Once()
+			},
+		},
+		"still-open": {
+			event: updated,
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork, _ *MockCreator, _ *core.MockCurrentTimeProvider) {
+				locker.EXPECT().TryLock(mock.Anything, mock.Anything).Return(func() {}, true, nil).Once()
+
+				repos := expectScope(t, uow)
+
+				open := completed
+				open.Status = domain.Status_OPEN
+				repos.Todo.EXPECT().GetTodo(mock.Anything, todoID).Return(open, true, nil).Once()
+			},
+		},
+		"lock-held-elsewhere": {
+			event: updated,
+			setExpectations: func(locker *core.MockLocker, _ *transaction.MockUnitOfWork, _ *MockCreator, _ *core.MockCurrentTimeProvider) {
+				locker.EXPECT().TryLock(mock.Anything, mock.Anything).Return(nil, false, nil).Once()
+			},
+		},
+		"lock-error": {
+			event: updated,
+			setExpectations: func(locker *core.MockLocker, _ *transaction.MockUnitOfWork, _ *MockCreator, _ *core.MockCurrentTimeProvider) {
+				locker.EXPECT().TryLock(mock.Anything, mock.Anything).Return(nil, false, errors.New("db down")).Once()
+			},
+			expectedErr: "failed to acquire lock: db down",
+		},
+		"create-error": {
+			event: updated,
+			setExpectations: func(locker *core.MockLocker, uow *transaction.MockUnitOfWork, creator *MockCreator, timeProvider *core.MockCurrentTimeProvider) {
+				locker.EXPECT().TryLock(mock.Anything, mock.Anything).Return(func() {}, true, nil).Once()
+				timeProvider.EXPECT().Now().Return(now).Once()
+
+				repos := expectScope(t, uow)
+				repos.Todo.EXPECT().GetTodo(mock.Anything, todoID).Return(completed, true, nil).Once()
+				creator.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Todo{}, errors.New("encoder unavailable")).
+					Once()
+			},
+			expectedErr: "encoder unavailable",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			locker := core.NewMockLocker(t)
+			uow := transaction.NewMockUnitOfWork(t)
+			creator := NewMockCreator(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(locker, uow, creator, timeProvider)
+
+			err := NewScheduleNextOccurrenceImpl(locker, uow, creator, timeProvider).Execute(t.Context(), tt.event)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package todo
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -26,11 +27,18 @@ type searchClause struct {
 // SearchBuilder builds todo list options and centralizes validation plus
 // optional similarity embedding generation for usecases.
 type SearchBuilder struct {
-	status       *domain.Status
-	dueAfter     *time.Time
-	dueBefore    *time.Time
-	sortBy       *string
-	searchClause []searchClause
+	status          *domain.Status
+	dueAfter        *time.Time
+	dueBefore       *time.Time
+	sortBy          *string
+	includeArchived bool
+	customFields    domain.CustomFieldValues
+	excludedFields  []domain.CustomFieldValues
+	searchClause    []searchClause
+	nearLat         *float64
+	nearLon         *float64
+	nearRadiusKm    *float64
+	today           time.Time
 }
 
 // NewSearchBuilder creates a new SearchBuilder.
@@ -84,6 +92,41 @@ func (b *SearchBuilder) WithSortBy(sortBy *string) *SearchBuilder {
 	return b
 }
 
+// WithToday sets the current date the smart order compares due dates against.
+func (b *SearchBuilder) WithToday(today time.Time) *SearchBuilder {
+	b.today = today
+	return b
+}
+
+// WithIncludeArchived sets whether archived todos are included.
+func (b *SearchBuilder) WithIncludeArchived(include bool) *SearchBuilder {
+	b.includeArchived = include
+	return b
+}
+
+// WithCustomFields sets an optional filter on custom field values. The values must already be normalized
+// against the tenant custom fields.
+func (b *SearchBuilder) WithCustomFields(values domain.CustomFieldValues) *SearchBuilder {
+	b.customFields = values
+	return b
+}
+
+// WithExcludedCustomFields sets optional custom field values whose todos are dropped. The values must
+// already be normalized against the tenant custom fields.
+func (b *SearchBuilder) WithExcludedCustomFields(values []domain.CustomFieldValues) *SearchBuilder {
+	b.excludedFields = values
+	return b
+}
+
+// WithNear sets an optional proximity filter around the given coordinates. A nil radius uses
+// domain.DefaultNearRadiusKm.
+func (b *SearchBuilder) WithNear(lat, lon, radiusKm *float64) *SearchBuilder {
+	b.nearLat = lat
+	b.nearLon = lon
+	b.nearRadiusKm = radiusKm
+	return b
+}
+
 // Validate checks that all configured filters and search options are consistent.
 func (b *SearchBuilder) Validate() error {
 	if (b.dueAfter == nil) != (b.dueBefore == nil) {
@@ -97,6 +140,21 @@ func (b *SearchBuilder) Validate() error {
 		return core.NewValidationErr("status must be either OPEN or DONE")
 	}
 
+	if (b.nearLat == nil) != (b.nearLon == nil) {
+		return core.NewValidationErr("near_lat and near_lon must be provided together")
+	}
+	if b.nearLat == nil && b.nearRadiusKm != nil {
+		return core.NewValidationErr("near_radius_km requires near_lat and near_lon")
+	}
+	if b.nearLat != nil {
+		if err := (domain.GeoPoint{Latitude: *b.nearLat, Longitude: *b.nearLon}).Validate(); err != nil {
+			return err
+		}
+	}
+	if b.nearRadiusKm != nil && (*b.nearRadiusKm <= 0 || *b.nearRadiusKm > domain.MaxNearRadiusKm) {
+		return core.NewValidationErr(fmt.Sprintf("near_radius_km must be greater than 0 and at most %g", domain.MaxNearRadiusKm))
+	}
+
 	resolvedSearchCount := 0
 	similarityQuery := ""
 	for _, clause := range b.searchClause {
@@ -122,7 +180,7 @@ func (b *SearchBuilder) Validate() error {
 	if b.sortBy != nil {
 		sortBy := strings.TrimSpace(*b.sortBy)
 		switch sortBy {
-		case "dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc":
+		case "dueDateAsc", "dueDateDesc", "createdAtAsc", "createdAtDesc", "similarityAsc", "similarityDesc", domain.SortSmartOrder:
 			b.sortBy = &sortBy
 		default:
 			return core.NewValidationErr("sort_by is invalid")
@@ -152,6 +210,25 @@ func (b *SearchBuilder) Build(ctx context.Context, semanticEncoder semantic.Enco
 	if b.sortBy != nil {
 		opts = append(opts, domain.WithSortBy(*b.sortBy))
 	}
+	if !b.today.IsZero() {
+		opts = append(opts, domain.WithToday(b.today))
+	}
+	if b.includeArchived {
+		opts = append(opts, domain.WithIncludeArchived())
+	}
+	if len(b.customFields) > 0 {
+		opts = append(opts, domain.WithCustomFields(b.customFields))
+	}
+	if len(b.excludedFields) > 0 {
+		opts = append(opts, domain.WithoutCustomFields(b.excludedFields))
+	}
+	if b.nearLat != nil && b.nearLon != nil {
+		radiusKm := domain.DefaultNearRadiusKm
+		if b.nearRadiusKm != nil {
+			radiusKm = *b.nearRadiusKm
+		}
+		opts = append(opts, domain.WithNear(domain.GeoPoint{Latitude: *b.nearLat, Longitude: *b.nearLon}, radiusKm))
+	}
 
 	var (
 		titleSearch     *string
@@ -195,7 +272,7 @@ func (b *SearchBuilder) Build(ctx context.Context, semanticEncoder semantic.Enco
 	if err != nil {
 		return SearchBuildResult{}, err
 	}
-	result.Options = append(result.Options, domain.WithEmbedding(resp.Vector))
+	result.Options = append(result.Options, domain.WithEmbedding(resp.Vector), domain.WithEmbeddingModel(embeddingModel))
 	result.EmbeddingTotalTokens = resp.TotalTokens
 	return result, nil
 }
@@ -27,6 +27,8 @@ func TestSearchBuilder_Build(t *testing.T) {
 	searchMeeting := "meeting"
 	sortDueDateAsc := "dueDateAsc"
 	sortSimilarityAsc := "similarityAsc"
+	nearLat := 38.7223
+	nearLon := -9.1393
 
 	type searchInput struct {
 		query      *string
@@ -38,6 +40,11 @@ func TestSearchBuilder_Build(t *testing.T) {
 		dueAfter   *time.Time
 		dueBefore  *time.Time
 		sortBy     *string
+		archived   bool
+		nearLat    *float64
+		nearLon    *float64
+		nearRadius *float64
+		today      time.Time
 		searches   []searchInput
 		setupMocks func(t *testing.T, semanticEncoder *semantic.MockEncoder)
 		wantErr    string
@@ -77,6 +84,16 @@ func TestSearchBuilder_Build(t *testing.T) {
 				}
 			},
 		},
+		"builds-options-including-archived": {
+			archived: true,
+			assertRes: func(t *testing.T, _ *semantic.MockEncoder, res SearchBuildResult) {
+				params := domain.ListParams{}
+				for _, opt := range res.Options {
+					opt(&params)
+				}
+				assert.Equal(t, domain.ListParams{IncludeArchived: true}, params)
+			},
+		},
 		"builds-options-with-similarity-embedding": {
 			model: "embedding-model",
 			searches: []searchInput{
@@ -110,6 +127,65 @@ func TestSearchBuilder_Build(t *testing.T) {
 				semanticEncoder.AssertNotCalled(t, "VectorizeQuery", mock.Anything, "embedding-model", mock.Anything)
 			},
 		},
+		"builds-options-with-smart-order": {
+			sortBy: common.Ptr(domain.SortSmartOrder),
+			today:  dueAfter,
+			assertRes: func(t *testing.T, _ *semantic.MockEncoder, res SearchBuildResult) {
+				params := domain.ListParams{}
+				for _, opt := range res.Options {
+					opt(&params)
+				}
+				assert.Equal(t, &domain.SortBy{Field: domain.SortSmartOrder, Direction: "DESC"}, params.SortBy)
+				assert.Equal(t, dueAfter, params.Today)
+			},
+		},
+		"builds-options-with-near-default-radius": {
+			nearLat: &nearLat,
+			nearLon: &nearLon,
+			assertRes: func(t *testing.T, _ *semantic.MockEncoder, res SearchBuildResult) {
+				params := domain.ListParams{}
+				for _, opt := range res.Options {
+					opt(&params)
+				}
+				assert.Equal(t, &domain.NearFilter{
+					Point:    domain.GeoPoint{Latitude: nearLat, Longitude: nearLon},
+					RadiusKm: domain.DefaultNearRadiusKm,
+				}, params.Near)
+			},
+		},
+		"builds-options-with-near-radius": {
+			nearLat:    &nearLat,
+			nearLon:    &nearLon,
+			nearRadius: common.Ptr(5.0),
+			assertRes: func(t *testing.T, _ *semantic.MockEncoder, res SearchBuildResult) {
+				params := domain.ListParams{}
+				for _, opt := range res.Options {
+					opt(&params)
+				}
+				if assert.NotNil(t, params.Near) {
+					assert.Equal(t, 5.0, params.Near.RadiusKm)
+				}
+			},
+		},
+		"fails-on-partial-near-point": {
+			nearLat: &nearLat,
+			wantErr: "near_lat and near_lon must be provided together",
+		},
+		"fails-on-near-radius-without-point": {
+			nearRadius: common.Ptr(5.0),
+			wantErr:    "near_radius_km requires near_lat and near_lon",
+		},
+		"fails-on-invalid-near-point": {
+			nearLat: common.Ptr(91.0),
+			nearLon: &nearLon,
+			wantErr: "latitude must be between -90 and 90",
+		},
+		"fails-on-near-radius-out-of-range": {
+			nearLat:    &nearLat,
+			nearLon:    &nearLon,
+			nearRadius: common.Ptr(500.0),
+			wantErr:    "near_radius_km must be greater than 0 and at most 100",
+		},
 		"fails-on-partial-due-range": {
 			dueAfter: &dueAfter,
 			wantErr:  "due_after and due_before must be provided together",
@@ -167,7 +243,10 @@ func TestSearchBuilder_Build(t *testing.T) {
 			builder := NewSearchBuilder().
 				WithStatus(tt.status).
 				WithDueDateRange(tt.dueAfter, tt.dueBefore).
-				WithSortBy(tt.sortBy)
+				WithSortBy(tt.sortBy).
+				WithIncludeArchived(tt.archived).
+				WithNear(tt.nearLat, tt.nearLon, tt.nearRadius).
+				WithToday(tt.today)
 			for _, search := range tt.searches {
 				builder.WithSearch(search.query, search.searchType)
 			}
@@ -0,0 +1,164 @@
+package todo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxSnapshotTodos is the maximum number of todos copied into a board snapshot.
+	MaxSnapshotTodos = 500
+	// DefaultSnapshotTitle is the title of snapshots created without one.
+	DefaultSnapshotTitle = "Board snapshot"
+)
+
+// Snapshots defines the interface for sharing immutable board snapshots.
+type Snapshots interface {
+	// Create freezes the todos matching the filter and the latest board summary into a new snapshot.
+	Create(ctx context.Context, title string, filter domain.ViewFilter) (domain.BoardSnapshot, error)
+	// Get retrieves a snapshot by its share token.
+	Get(ctx context.Context, token string) (domain.BoardSnapshot, error)
+}
+
+// SnapshotsImpl is the implementation of the Snapshots use case.
+type SnapshotsImpl struct {
+	list         List
+	summaryRepo  domain.BoardSummaryRepository
+	snapshotRepo domain.BoardSnapshotRepository
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewSnapshotsImpl creates a new instance of SnapshotsImpl.
+func NewSnapshotsImpl(
+	list List,
+	summaryRepo domain.BoardSummaryRepository,
+	snapshotRepo domain.BoardSnapshotRepository,
+	timeProvider core.CurrentTimeProvider,
+) SnapshotsImpl {
+	return SnapshotsImpl{
+		list:         list,
+		summaryRepo:  summaryRepo,
+		snapshotRepo: snapshotRepo,
+		timeProvider: timeProvider,
+	}
+}
+
+// Create freezes the todos matching the filter, up to MaxSnapshotTodos, and the latest board summary
+// into a new snapshot with its rendered Markdown document. Relative due date bounds are resolved
+// against the current day and stored as absolute dates.
+func (s SnapshotsImpl) Create(ctx context.Context, title string, filter domain.ViewFilter) (domain.BoardSnapshot, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := s.timeProvider.Now()
+	snapshot := domain.BoardSnapshot{
+		ID:        uuid.New(),
+		Title:     strings.TrimSpace(title),
+		Filter:    filter.Resolve(now),
+		CreatedAt: now,
+	}
+	if snapshot.Title == "" {
+		snapshot.Title = DefaultSnapshotTitle
+	}
+	if err := s.validate(snapshot); telemetry.IsErrorRecorded(span, err) {
+		return domain.BoardSnapshot{}, err
+	}
+
+	todos, _, err := s.list.Query(spanCtx, 1, MaxSnapshotTodos, snapshotListOptions(snapshot.Filter)...)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.BoardSnapshot{}, err
+	}
+	snapshot.Todos = todos
+
+	summary, found, err := s.summaryRepo.GetLatestSummary(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.BoardSnapshot{}, err
+	}
+	if found {
+		snapshot.Summary = &summary
+	}
+
+	snapshot.Token, err = newSnapshotToken()
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.BoardSnapshot{}, err
+	}
+	snapshot.Markdown = snapshot.RenderMarkdown()
+
+	if err := s.snapshotRepo.CreateSnapshot(spanCtx, snapshot); telemetry.IsErrorRecorded(span, err) {
+		return domain.BoardSnapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// Get retrieves a snapshot by its share token.
+func (s SnapshotsImpl) Get(ctx context.Context, token string) (domain.BoardSnapshot, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	snapshot, found, err := s.snapshotRepo.GetSnapshotByToken(spanCtx, strings.TrimSpace(token))
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.BoardSnapshot{}, err
+	}
+	if !found {
+		return domain.BoardSnapshot{}, core.NewNotFoundErr("board snapshot not found")
+	}
+
+	return snapshot, nil
+}
+
+// validate checks the snapshot fields and that its filter builds a valid todo search.
+func (s SnapshotsImpl) validate(snapshot domain.BoardSnapshot) error {
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+
+	filter := snapshot.Filter
+	return NewSearchBuilder().
+		WithStatus(filter.Status).
+		WithDueDateRange(filter.DueAfter, filter.DueBefore).
+		WithSortBy(filter.SortBy).
+		WithTitleContains(filter.SearchByTitle).
+		WithSimilaritySearch(filter.SearchBySimilarity).
+		Validate()
+}
+
+// snapshotListOptions converts a resolved view filter into list options.
+func snapshotListOptions(filter domain.ViewFilter) []ListOptions {
+	opts := []ListOptions{}
+	if filter.Status != nil {
+		opts = append(opts, WithStatus(*filter.Status))
+	}
+	if filter.SearchBySimilarity != nil && strings.TrimSpace(*filter.SearchBySimilarity) != "" {
+		opts = append(opts, WithSearchQuery(*filter.SearchBySimilarity), WithSearchType(SearchType_Similarity))
+	} else if filter.SearchByTitle != nil && strings.TrimSpace(*filter.SearchByTitle) != "" {
+		opts = append(opts, WithSearchQuery(*filter.SearchByTitle), WithSearchType(SearchType_Title))
+	}
+	if filter.DueAfter != nil && filter.DueBefore != nil {
+		opts = append(opts, WithDueDateRange(*filter.DueAfter, *filter.DueBefore))
+	}
+	if filter.SortBy != nil {
+		opts = append(opts, WithSortBy(*filter.SortBy))
+	}
+	if filter.Query != nil && strings.TrimSpace(*filter.Query) != "" {
+		opts = append(opts, WithQuery(*filter.Query))
+	}
+	return opts
+}
+
+// newSnapshotToken generates the random token a snapshot is shared by.
+func newSnapshotToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate snapshot token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,176 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSnapshotsImpl_Create(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	todos := []domain.Todo{{ID: uuid.New(), Title: "Write draft", Status: domain.Status_OPEN, DueDate: now}}
+	summary := domain.BoardSummary{ID: uuid.New(), Content: domain.BoardSummaryContent{Summary: "One task left."}}
+
+	type mocks struct {
+		list         *MockList
+		summaryRepo  *domain.MockBoardSummaryRepository
+		snapshotRepo *domain.MockBoardSnapshotRepository
+	}
+
+	tests := map[string]struct {
+		title           string
+		filter          domain.ViewFilter
+		setExpectations func(m mocks)
+		validate        func(t *testing.T, got domain.BoardSnapshot)
+		expectedErr     error
+	}{
+		"with-summary": {
+			title:  " Sprint board ",
+			filter: domain.ViewFilter{Status: common.Ptr(domain.Status_OPEN), SearchByTitle: common.Ptr("draft")},
+			setExpectations: func(m mocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, MaxSnapshotTodos, mock.Anything, mock.Anything, mock.Anything).
+					Return(todos, false, nil).Once()
+				m.summaryRepo.EXPECT().GetLatestSummary(mock.Anything).Return(summary, true, nil).Once()
+				m.snapshotRepo.EXPECT().CreateSnapshot(mock.Anything, mock.MatchedBy(func(s domain.BoardSnapshot) bool {
+					return s.Token != "" && s.Markdown == s.RenderMarkdown()
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got domain.BoardSnapshot) {
+				assert.NotEqual(t, uuid.Nil, got.ID)
+				assert.Len(t, got.Token, 32)
+				assert.Equal(t, "Sprint board", got.Title)
+				assert.Equal(t, todos, got.Todos)
+				assert.Equal(t, &summary, got.Summary)
+				assert.Equal(t, now, got.CreatedAt)
+				assert.True(t, strings.HasPrefix(got.Markdown, "# Sprint board\n"))
+			},
+		},
+		"resolves-relative-dates-without-summary": {
+			filter: domain.ViewFilter{DueFromDays: common.Ptr(0), DueToDays: common.Ptr(7)},
+			setExpectations: func(m mocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, MaxSnapshotTodos, mock.Anything).
+					Run(func(_ context.Context, _ int, _ int, opts ...ListOptions) {
+						params := ListParams{}
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, today, *params.DueAfter)
+						assert.Equal(t, today.AddDate(0, 0, 7), *params.DueBefore)
+					}).
+					Return(nil, false, nil).Once()
+				m.summaryRepo.EXPECT().GetLatestSummary(mock.Anything).Return(domain.BoardSummary{}, false, nil).Once()
+				m.snapshotRepo.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got domain.BoardSnapshot) {
+				assert.Equal(t, DefaultSnapshotTitle, got.Title)
+				assert.Nil(t, got.Summary)
+				assert.Nil(t, got.Filter.DueFromDays)
+				assert.Equal(t, today.AddDate(0, 0, 7), *got.Filter.DueBefore)
+			},
+		},
+		"invalid-filter": {
+			filter:          domain.ViewFilter{SearchByTitle: common.Ptr("draft"), SearchBySimilarity: common.Ptr("writing")},
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewValidationErr("only one search query is allowed"),
+		},
+		"list-error": {
+			setExpectations: func(m mocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, MaxSnapshotTodos).Return(nil, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"summary-error": {
+			setExpectations: func(m mocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, MaxSnapshotTodos).Return(todos, false, nil).Once()
+				m.summaryRepo.EXPECT().GetLatestSummary(mock.Anything).Return(domain.BoardSummary{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"repository-error": {
+			setExpectations: func(m mocks) {
+				m.list.EXPECT().Query(mock.Anything, 1, MaxSnapshotTodos).Return(todos, false, nil).Once()
+				m.summaryRepo.EXPECT().GetLatestSummary(mock.Anything).Return(summary, true, nil).Once()
+				m.snapshotRepo.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				list:         NewMockList(t),
+				summaryRepo:  domain.NewMockBoardSummaryRepository(t),
+				snapshotRepo: domain.NewMockBoardSnapshotRepository(t),
+			}
+			tt.setExpectations(m)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Once()
+
+			got, err := NewSnapshotsImpl(m.list, m.summaryRepo, m.snapshotRepo, timeProvider).Create(t.Context(), tt.title, tt.filter)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.validate != nil {
+				tt.validate(t, got)
+			}
+		})
+	}
+}
+
+func TestSnapshotsImpl_Get(t *testing.T) {
+	t.Parallel()
+
+	snapshot := domain.BoardSnapshot{ID: uuid.New(), Token: "tok_abc", Title: "Sprint board"}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockBoardSnapshotRepository)
+		expected        domain.BoardSnapshot
+		expectedErr     error
+	}{
+		"found": {
+			setExpectations: func(repo *domain.MockBoardSnapshotRepository) {
+				repo.EXPECT().GetSnapshotByToken(mock.Anything, "tok_abc").Return(snapshot, true, nil).Once()
+			},
+			expected: snapshot,
+		},
+		"not-found": {
+			setExpectations: func(repo *domain.MockBoardSnapshotRepository) {
+				repo.EXPECT().GetSnapshotByToken(mock.Anything, "tok_abc").Return(domain.BoardSnapshot{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("board snapshot not found"),
+		},
+		"repository-error": {
+			setExpectations: func(repo *domain.MockBoardSnapshotRepository) {
+				repo.EXPECT().GetSnapshotByToken(mock.Anything, "tok_abc").Return(domain.BoardSnapshot{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockBoardSnapshotRepository(t)
+			tt.setExpectations(repo)
+
+			got, err := NewSnapshotsImpl(NewMockList(t), domain.NewMockBoardSummaryRepository(t), repo, core.NewMockCurrentTimeProvider(t)).
+				Get(t.Context(), " tok_abc ")
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
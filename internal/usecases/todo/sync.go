@@ -0,0 +1,329 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// MaxSyncLimit is the maximum number of changes read from each change log in one Pull.
+const MaxSyncLimit = 500
+
+// SyncCursor is the position of a client in the todo and conversation change logs.
+type SyncCursor struct {
+	Todo         int64
+	Conversation int64
+}
+
+// String encodes the cursor as "<todo sequence>.<conversation sequence>".
+func (c SyncCursor) String() string {
+	return fmt.Sprintf("%d.%d", c.Todo, c.Conversation)
+}
+
+// ParseSyncCursor decodes a cursor produced by SyncCursor.String. An empty value is the start of both logs.
+func ParseSyncCursor(value string) (SyncCursor, error) {
+	if value == "" {
+		return SyncCursor{}, nil
+	}
+
+	invalidErr := core.NewValidationErr(fmt.Sprintf("invalid sync cursor %q", value))
+	todoPart, conversationPart, ok := strings.Cut(value, ".")
+	if !ok {
+		return SyncCursor{}, invalidErr
+	}
+	todoSeq, err := strconv.ParseInt(todoPart, 10, 64)
+	if err != nil || todoSeq < 0 {
+		return SyncCursor{}, invalidErr
+	}
+	conversationSeq, err := strconv.ParseInt(conversationPart, 10, 64)
+	if err != nil || conversationSeq < 0 {
+		return SyncCursor{}, invalidErr
+	}
+
+	return SyncCursor{Todo: todoSeq, Conversation: conversationSeq}, nil
+}
+
+// SyncEntity identifies the kind of entity referenced by a tombstone.
+type SyncEntity string
+
+const (
+	// SyncEntity_TODO is a todo.
+	SyncEntity_TODO SyncEntity = "TODO"
+	// SyncEntity_CONVERSATION is a conversation.
+	SyncEntity_CONVERSATION SyncEntity = "CONVERSATION"
+)
+
+// SyncTombstone reports an entity deleted since the client cursor.
+type SyncTombstone struct {
+	Entity    SyncEntity
+	ID        uuid.UUID
+	DeletedAt time.Time
+}
+
+// SyncPage holds the current state of the todos and conversations changed since a cursor.
+type SyncPage struct {
+	Todos         []domain.Todo
+	Conversations []assistant.Conversation
+	Tombstones    []SyncTombstone
+	// Cursor is the position to pull from next.
+	Cursor SyncCursor
+	// HasMore reports that more changes are available after Cursor.
+	HasMore bool
+}
+
+// SyncMutationStatus is the outcome of a client mutation pushed to the server.
+type SyncMutationStatus string
+
+const (
+	// SyncMutationStatus_APPLIED means the mutation was committed.
+	SyncMutationStatus_APPLIED SyncMutationStatus = "APPLIED"
+	// SyncMutationStatus_CONFLICT means the todo changed or was deleted on the server after the client's base version.
+	SyncMutationStatus_CONFLICT SyncMutationStatus = "CONFLICT"
+	// SyncMutationStatus_FAILED means the mutation was rejected.
+	SyncMutationStatus_FAILED SyncMutationStatus = "FAILED"
+)
+
+// SyncMutation is a todo operation recorded by an offline client.
+type SyncMutation struct {
+	TodoOperation
+	// BaseUpdatedAt is the updated_at of the todo version the client edited.
+	// When set, updates and deletes are rejected as conflicts if the server version differs.
+	BaseUpdatedAt *time.Time
+}
+
+// SyncMutationResult is the result of the mutation at the same position in a push.
+type SyncMutationResult struct {
+	Kind   TodoOperationKind
+	Status SyncMutationStatus
+	// ID is the affected todo ID. It is uuid.Nil for creates that were not applied.
+	ID uuid.UUID
+	// Todo is the applied todo, or the current server version on conflict.
+	Todo *domain.Todo
+	// Err explains why a FAILED mutation was rejected.
+	Err error
+}
+
+// Sync defines the interface for synchronizing offline clients.
+type Sync interface {
+	// Pull returns up to limit todo and conversation changes recorded after the cursor.
+	Pull(ctx context.Context, cursor string, limit int) (SyncPage, error)
+	// Push applies client mutations in order, each in its own unit of work.
+	Push(ctx context.Context, mutations []SyncMutation) ([]SyncMutationResult, error)
+}
+
+// SyncImpl is the implementation of the Sync use case.
+type SyncImpl struct {
+	uow                    transaction.UnitOfWork
+	operator               todoOperator
+	todoRepo               domain.Repository
+	changeRepo             domain.ChangeRepository
+	conversationRepo       assistant.ConversationRepository
+	conversationChangeRepo assistant.ConversationChangeRepository
+}
+
+// NewSyncImpl creates a new instance of SyncImpl.
+func NewSyncImpl(
+	uow transaction.UnitOfWork,
+	creator Creator,
+	updater Updater,
+	deleter Deleter,
+	todoRepo domain.Repository,
+	changeRepo domain.ChangeRepository,
+	conversationRepo assistant.ConversationRepository,
+	conversationChangeRepo assistant.ConversationChangeRepository,
+) SyncImpl {
+	return SyncImpl{
+		uow: uow,
+		operator: todoOperator{
+			creator: creator,
+			updater: updater,
+			deleter: deleter,
+		},
+		todoRepo:               todoRepo,
+		changeRepo:             changeRepo,
+		conversationRepo:       conversationRepo,
+		conversationChangeRepo: conversationChangeRepo,
+	}
+}
+
+// Pull returns the current state of every todo and conversation changed after the cursor, plus
+// tombstones for the deleted ones. Several changes of the same entity are collapsed into one entry.
+func (s SyncImpl) Pull(ctx context.Context, cursor string, limit int) (SyncPage, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if limit > MaxSyncLimit {
+		err := core.NewValidationErr(fmt.Sprintf("limit must be at most %d", MaxSyncLimit))
+		telemetry.IsErrorRecorded(span, err)
+		return SyncPage{}, err
+	}
+
+	since, err := ParseSyncCursor(cursor)
+	if telemetry.IsErrorRecorded(span, err) {
+		return SyncPage{}, err
+	}
+
+	todoChanges, todosHaveMore, err := s.changeRepo.ListChangesSince(spanCtx, since.Todo, nil, limit)
+	if telemetry.IsErrorRecorded(span, err) {
+		return SyncPage{}, err
+	}
+	conversationChanges, conversationsHaveMore, err := s.conversationChangeRepo.ListConversationChangesSince(spanCtx, since.Conversation, limit)
+	if telemetry.IsErrorRecorded(span, err) {
+		return SyncPage{}, err
+	}
+
+	page := SyncPage{
+		Todos:         []domain.Todo{},
+		Conversations: []assistant.Conversation{},
+		Tombstones:    []SyncTombstone{},
+		Cursor:        since,
+		HasMore:       todosHaveMore || conversationsHaveMore,
+	}
+
+	if err := s.pullTodos(spanCtx, todoChanges, &page); telemetry.IsErrorRecorded(span, err) {
+		return SyncPage{}, err
+	}
+	if err := s.pullConversations(spanCtx, conversationChanges, &page); telemetry.IsErrorRecorded(span, err) {
+		return SyncPage{}, err
+	}
+
+	return page, nil
+}
+
+// pullTodos adds the latest state of each changed todo to the page and advances its todo cursor.
+func (s SyncImpl) pullTodos(ctx context.Context, changes []domain.Change, page *SyncPage) error {
+	last := make(map[uuid.UUID]int64, len(changes))
+	for _, c := range changes {
+		last[c.TodoID] = c.Sequence
+		page.Cursor.Todo = c.Sequence
+	}
+
+	for _, c := range changes {
+		if last[c.TodoID] != c.Sequence {
+			continue
+		}
+		if c.Type == domain.ChangeType_Deleted {
+			page.Tombstones = append(page.Tombstones, SyncTombstone{Entity: SyncEntity_TODO, ID: c.TodoID, DeletedAt: c.CreatedAt})
+			continue
+		}
+		td, found, err := s.todoRepo.GetTodo(ctx, c.TodoID)
+		if err != nil {
+			return err
+		}
+		// A todo deleted after this change is reported by its own, later, delete change.
+		if found {
+			page.Todos = append(page.Todos, td)
+		}
+	}
+	return nil
+}
+
+// pullConversations adds the latest state of each changed conversation to the page and advances its conversation cursor.
+func (s SyncImpl) pullConversations(ctx context.Context, changes []assistant.ConversationChange, page *SyncPage) error {
+	last := make(map[uuid.UUID]int64, len(changes))
+	for _, c := range changes {
+		last[c.ConversationID] = c.Sequence
+		page.Cursor.Conversation = c.Sequence
+	}
+
+	for _, c := range changes {
+		if last[c.ConversationID] != c.Sequence {
+			continue
+		}
+		if c.Type == assistant.ConversationChangeType_Deleted {
+			page.Tombstones = append(page.Tombstones, SyncTombstone{Entity: SyncEntity_CONVERSATION, ID: c.ConversationID, DeletedAt: c.CreatedAt})
+			continue
+		}
+		conversation, found, err := s.conversationRepo.GetConversation(ctx, c.ConversationID)
+		if err != nil {
+			return err
+		}
+		if found {
+			page.Conversations = append(page.Conversations, conversation)
+		}
+	}
+	return nil
+}
+
+// Push applies the mutations in order, each in its own unit of work, so one rejected mutation
+// does not discard the others. Updates and deletes with a BaseUpdatedAt that no longer matches
+// the server version are not applied and are reported as CONFLICT with the server version.
+func (s SyncImpl) Push(ctx context.Context, mutations []SyncMutation) ([]SyncMutationResult, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if len(mutations) == 0 {
+		err := core.NewValidationErr("at least one mutation is required")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+	if len(mutations) > MaxTodoOperations {
+		err := core.NewValidationErr(fmt.Sprintf("at most %d mutations are allowed", MaxTodoOperations))
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+
+	results := make([]SyncMutationResult, len(mutations))
+	for i, m := range mutations {
+		results[i] = s.push(spanCtx, m)
+		if results[i].Err != nil && !isOperationErr(results[i].Err) {
+			telemetry.IsErrorRecorded(span, results[i].Err)
+		}
+	}
+
+	return results, nil
+}
+
+// push applies one mutation within its own unit of work.
+func (s SyncImpl) push(ctx context.Context, m SyncMutation) SyncMutationResult {
+	result := SyncMutationResult{Kind: m.Kind}
+	if m.ID != nil && m.Kind != TodoOperationKind_CREATE {
+		result.ID = *m.ID
+	}
+
+	err := s.uow.Execute(ctx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if m.BaseUpdatedAt != nil && m.ID != nil && m.Kind != TodoOperationKind_CREATE {
+			// Lock the row so a concurrent writer cannot change it between the version check and the apply.
+			current, found, err := scope.Todo().LockTodo(uowCtx, *m.ID)
+			if err != nil {
+				return err
+			}
+			if !found {
+				result.Status = SyncMutationStatus_CONFLICT
+				return nil
+			}
+			if !current.UpdatedAt.Equal(*m.BaseUpdatedAt) {
+				result.Status = SyncMutationStatus_CONFLICT
+				result.Todo = &current
+				return nil
+			}
+		}
+
+		td, err := s.operator.apply(uowCtx, scope, m.TodoOperation)
+		if err != nil {
+			return err
+		}
+		result.Status = SyncMutationStatus_APPLIED
+		result.ID = td.ID
+		if m.Kind != TodoOperationKind_DELETE {
+			result.Todo = &td
+		}
+		return nil
+	})
+	if err != nil {
+		result.Status = SyncMutationStatus_FAILED
+		result.Todo = nil
+		result.Err = err
+	}
+
+	return result
+}
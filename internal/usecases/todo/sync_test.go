@@ -0,0 +1,363 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestParseSyncCursor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		expected    SyncCursor
+		expectedErr error
+	}{
+		"empty": {
+			value: "",
+		},
+		"valid": {
+			value:    "42.7",
+			expected: SyncCursor{Todo: 42, Conversation: 7},
+		},
+		"missing-separator": {
+			value:       "42",
+			expectedErr: core.NewValidationErr(`invalid sync cursor "42"`),
+		},
+		"not-a-number": {
+			value:       "a.7",
+			expectedErr: core.NewValidationErr(`invalid sync cursor "a.7"`),
+		},
+		"negative": {
+			value:       "1.-1",
+			expectedErr: core.NewValidationErr(`invalid sync cursor "1.-1"`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSyncCursor(tt.value)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+			if err == nil && tt.value != "" {
+				assert.Equal(t, tt.value, got.String())
+			}
+		})
+	}
+}
+
+func TestSyncImpl_Pull(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	deletedTodoID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	conversationID := uuid.MustParse("323e4567-e89b-12d3-a456-426614174002")
+	deletedConversationID := uuid.MustParse("423e4567-e89b-12d3-a456-426614174003")
+	changedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	td := domain.Todo{ID: todoID, Title: "Buy milk", Status: domain.Status_OPEN, UpdatedAt: changedAt}
+	conversation := assistant.Conversation{ID: conversationID, Title: "Weekly plan", UpdatedAt: changedAt}
+
+	type mocks struct {
+		todoRepo               *domain.MockRepository
+		changeRepo             *domain.MockChangeRepository
+		conversationRepo       *assistant.MockConversationRepository
+		conversationChangeRepo *assistant.MockConversationChangeRepository
+	}
+
+	tests := map[string]struct {
+		cursor          string
+		limit           int
+		setExpectations func(m mocks)
+		expected        SyncPage
+		expectedErr     error
+	}{
+		"collapses-changes-and-reports-tombstones": {
+			cursor: "10.3",
+			setExpectations: func(m mocks) {
+				m.changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(10), (*uuid.UUID)(nil), 50).
+					Return([]domain.Change{
+						{Sequence: 11, TodoID: todoID, Type: domain.ChangeType_Created, CreatedAt: changedAt},
+						{Sequence: 12, TodoID: deletedTodoID, Type: domain.ChangeType_Updated, CreatedAt: changedAt},
+						{Sequence: 13, TodoID: todoID, Type: domain.ChangeType_Updated, CreatedAt: changedAt},
+						{Sequence: 14, TodoID: deletedTodoID, Type: domain.ChangeType_Deleted, CreatedAt: changedAt},
+					}, true, nil).
+					Once()
+				m.conversationChangeRepo.EXPECT().
+					ListConversationChangesSince(mock.Anything, int64(3), 50).
+					Return([]assistant.ConversationChange{
+						{Sequence: 4, ConversationID: conversationID, Type: assistant.ConversationChangeType_Created, CreatedAt: changedAt},
+						{Sequence: 5, ConversationID: deletedConversationID, Type: assistant.ConversationChangeType_Deleted, CreatedAt: changedAt},
+					}, false, nil).
+					Once()
+				m.todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(td, true, nil).Once()
+				m.conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(conversation, true, nil).Once()
+			},
+			expected: SyncPage{
+				Todos:         []domain.Todo{td},
+				Conversations: []assistant.Conversation{conversation},
+				Tombstones: []SyncTombstone{
+					{Entity: SyncEntity_TODO, ID: deletedTodoID, DeletedAt: changedAt},
+					{Entity: SyncEntity_CONVERSATION, ID: deletedConversationID, DeletedAt: changedAt},
+				},
+				Cursor:  SyncCursor{Todo: 14, Conversation: 5},
+				HasMore: true,
+			},
+		},
+		"no-changes-keeps-cursor": {
+			cursor: "10.3",
+			setExpectations: func(m mocks) {
+				m.changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(10), (*uuid.UUID)(nil), 50).
+					Return([]domain.Change{}, false, nil).
+					Once()
+				m.conversationChangeRepo.EXPECT().
+					ListConversationChangesSince(mock.Anything, int64(3), 50).
+					Return([]assistant.ConversationChange{}, false, nil).
+					Once()
+			},
+			expected: SyncPage{
+				Todos:         []domain.Todo{},
+				Conversations: []assistant.Conversation{},
+				Tombstones:    []SyncTombstone{},
+				Cursor:        SyncCursor{Todo: 10, Conversation: 3},
+			},
+		},
+		"skips-todo-deleted-later": {
+			setExpectations: func(m mocks) {
+				m.changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), 50).
+					Return([]domain.Change{{Sequence: 1, TodoID: todoID, Type: domain.ChangeType_Created}}, false, nil).
+					Once()
+				m.conversationChangeRepo.EXPECT().
+					ListConversationChangesSince(mock.Anything, int64(0), 50).
+					Return([]assistant.ConversationChange{}, false, nil).
+					Once()
+				m.todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expected: SyncPage{
+				Todos:         []domain.Todo{},
+				Conversations: []assistant.Conversation{},
+				Tombstones:    []SyncTombstone{},
+				Cursor:        SyncCursor{Todo: 1},
+			},
+		},
+		"limit-too-large": {
+			limit:           MaxSyncLimit + 1,
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewValidationErr("limit must be at most 500"),
+		},
+		"invalid-cursor": {
+			cursor:          "abc",
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewValidationErr(`invalid sync cursor "abc"`),
+		},
+		"change-repo-error": {
+			setExpectations: func(m mocks) {
+				m.changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), 50).
+					Return(nil, false, errors.New("db error")).
+					Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+		"get-conversation-error": {
+			setExpectations: func(m mocks) {
+				m.changeRepo.EXPECT().
+					ListChangesSince(mock.Anything, int64(0), (*uuid.UUID)(nil), 50).
+					Return([]domain.Change{}, false, nil).
+					Once()
+				m.conversationChangeRepo.EXPECT().
+					ListConversationChangesSince(mock.Anything, int64(0), 50).
+					Return([]assistant.ConversationChange{{Sequence: 1, ConversationID: conversationID, Type: assistant.ConversationChangeType_Updated}}, false, nil).
+					Once()
+				m.conversationRepo.EXPECT().GetConversation(mock.Anything, conversationID).Return(assistant.Conversation{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				todoRepo:               domain.NewMockRepository(t),
+				changeRepo:             domain.NewMockChangeRepository(t),
+				conversationRepo:       assistant.NewMockConversationRepository(t),
+				conversationChangeRepo: assistant.NewMockConversationChangeRepository(t),
+			}
+			tt.setExpectations(m)
+
+			s := NewSyncImpl(nil, nil, nil, nil, m.todoRepo, m.changeRepo, m.conversationRepo, m.conversationChangeRepo)
+			limit := tt.limit
+			if limit == 0 {
+				limit = 50
+			}
+			got, err := s.Pull(t.Context(), tt.cursor, limit)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestSyncImpl_Push(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	createdID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	baseUpdatedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	serverUpdatedAt := baseUpdatedAt.Add(time.Hour)
+	dueDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	current := domain.Todo{ID: todoID, Title: "Buy milk", Status: domain.Status_OPEN, UpdatedAt: baseUpdatedAt}
+	changedOnServer := domain.Todo{ID: todoID, Title: "Buy oat milk", Status: domain.Status_OPEN, UpdatedAt: serverUpdatedAt}
+	updated := domain.Todo{ID: todoID, Title: "Buy milk", Status: domain.Status_DONE, UpdatedAt: serverUpdatedAt}
+	created := domain.Todo{ID: createdID, Title: "Call mom", Status: domain.Status_OPEN, DueDate: dueDate}
+
+	updateDone := SyncMutation{
+		TodoOperation: TodoOperation{Kind: TodoOperationKind_UPDATE, ID: &todoID, Status: common.Ptr(domain.Status_DONE)},
+		BaseUpdatedAt: &baseUpdatedAt,
+	}
+
+	type mocks struct {
+		uow     *transaction.MockUnitOfWork
+		scope   *transaction.MockScope
+		repo    *domain.MockRepository
+		creator *MockCreator
+		updater *MockUpdater
+		deleter *MockDeleter
+	}
+
+	runInUow := func(m mocks, times int) {
+		m.uow.EXPECT().
+			Execute(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+				return fn(ctx, m.scope)
+			}).
+			Times(times)
+	}
+
+	tests := map[string]struct {
+		mutations       []SyncMutation
+		setExpectations func(m mocks)
+		expected        []SyncMutationResult
+		expectedErr     error
+	}{
+		"applies-matching-base-version": {
+			mutations: []SyncMutation{
+				updateDone,
+				{TodoOperation: TodoOperation{Kind: TodoOperationKind_CREATE, Title: common.Ptr("Call mom"), DueDate: &dueDate}},
+			},
+			setExpectations: func(m mocks) {
+				runInUow(m, 2)
+				m.scope.EXPECT().Todo().Return(m.repo).Once()
+				m.repo.EXPECT().LockTodo(mock.Anything, todoID).Return(current, true, nil).Once()
+				m.updater.EXPECT().
+					Update(mock.Anything, m.scope, todoID, (*string)(nil), common.Ptr(domain.Status_DONE), (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
+					Return(updated, nil).
+					Once()
+				m.creator.EXPECT().Create(mock.Anything, m.scope, "Call mom", dueDate, 0, domain.CustomFieldValues(nil)).Return(created, nil).Once()
+			},
+			expected: []SyncMutationResult{
+				{Kind: TodoOperationKind_UPDATE, Status: SyncMutationStatus_APPLIED, ID: todoID, Todo: &updated},
+				{Kind: TodoOperationKind_CREATE, Status: SyncMutationStatus_APPLIED, ID: createdID, Todo: &created},
+			},
+		},
+		"conflict-when-changed-on-server": {
+			mutations: []SyncMutation{updateDone},
+			setExpectations: func(m mocks) {
+				runInUow(m, 1)
+				m.scope.EXPECT().Todo().Return(m.repo).Once()
+				m.repo.EXPECT().LockTodo(mock.Anything, todoID).Return(changedOnServer, true, nil).Once()
+			},
+			expected: []SyncMutationResult{
+				{Kind: TodoOperationKind_UPDATE, Status: SyncMutationStatus_CONFLICT, ID: todoID, Todo: &changedOnServer},
+			},
+		},
+		"conflict-when-deleted-on-server": {
+			mutations: []SyncMutation{{
+				TodoOperation: TodoOperation{Kind: TodoOperationKind_DELETE, ID: &todoID},
+				BaseUpdatedAt: &baseUpdatedAt,
+			}},
+			setExpectations: func(m mocks) {
+				runInUow(m, 1)
+				m.scope.EXPECT().Todo().Return(m.repo).Once()
+				m.repo.EXPECT().LockTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expected: []SyncMutationResult{
+				{Kind: TodoOperationKind_DELETE, Status: SyncMutationStatus_CONFLICT, ID: todoID},
+			},
+		},
+		"delete-without-base-version": {
+			mutations: []SyncMutation{{TodoOperation: TodoOperation{Kind: TodoOperationKind_DELETE, ID: &todoID}}},
+			setExpectations: func(m mocks) {
+				runInUow(m, 1)
+				m.deleter.EXPECT().Delete(mock.Anything, m.scope, todoID).Return(nil).Once()
+			},
+			expected: []SyncMutationResult{
+				{Kind: TodoOperationKind_DELETE, Status: SyncMutationStatus_APPLIED, ID: todoID},
+			},
+		},
+		"failed-mutation-does-not-stop-push": {
+			mutations: []SyncMutation{
+				{TodoOperation: TodoOperation{Kind: TodoOperationKind_CREATE, Title: common.Ptr("Call mom")}},
+				{TodoOperation: TodoOperation{Kind: TodoOperationKind_DELETE, ID: &todoID}},
+			},
+			setExpectations: func(m mocks) {
+				runInUow(m, 2)
+				m.deleter.EXPECT().Delete(mock.Anything, m.scope, todoID).Return(errors.New("db error")).Once()
+			},
+			expected: []SyncMutationResult{
+				{
+					Kind:   TodoOperationKind_CREATE,
+					Status: SyncMutationStatus_FAILED,
+					Err:    core.NewValidationErr("title and due_date are required to create a todo"),
+				},
+				{Kind: TodoOperationKind_DELETE, Status: SyncMutationStatus_FAILED, ID: todoID, Err: errors.New("db error")},
+			},
+		},
+		"empty-push": {
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewValidationErr("at least one mutation is required"),
+		},
+		"too-many-mutations": {
+			mutations:       make([]SyncMutation, MaxTodoOperations+1),
+			setExpectations: func(m mocks) {},
+			expectedErr:     core.NewValidationErr("at most 100 mutations are allowed"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mocks{
+				uow:     transaction.NewMockUnitOfWork(t),
+				scope:   transaction.NewMockScope(t),
+				repo:    domain.NewMockRepository(t),
+				creator: NewMockCreator(t),
+				updater: NewMockUpdater(t),
+				deleter: NewMockDeleter(t),
+			}
+			tt.setExpectations(m)
+
+			s := NewSyncImpl(m.uow, m.creator, m.updater, m.deleter, nil, nil, nil, nil)
+			got, err := s.Push(t.Context(), tt.mutations)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
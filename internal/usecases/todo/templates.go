@@ -0,0 +1,200 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// Templates defines the interface for managing reusable todo templates.
+type Templates interface {
+	// List lists the templates ordered by name.
+	List(ctx context.Context) ([]domain.Template, error)
+	// Save stores a template, replacing the items of the template with the same name.
+	Save(ctx context.Context, name string, items []domain.TemplateItem) (domain.Template, error)
+	// Update renames a template and replaces its items.
+	Update(ctx context.Context, id uuid.UUID, name string, items []domain.TemplateItem) (domain.Template, error)
+	// Delete removes a template.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Apply creates one todo per template item, due relative to the anchor date.
+	Apply(ctx context.Context, id uuid.UUID, anchor time.Time) ([]domain.Todo, error)
+}
+
+// TemplatesImpl is the implementation of the Templates use case.
+type TemplatesImpl struct {
+	templateRepo domain.TemplateRepository
+	uow          transaction.UnitOfWork
+	creator      Creator
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewTemplatesImpl creates a new instance of TemplatesImpl.
+func NewTemplatesImpl(
+	templateRepo domain.TemplateRepository,
+	uow transaction.UnitOfWork,
+	creator Creator,
+	timeProvider core.CurrentTimeProvider,
+) TemplatesImpl {
+	return TemplatesImpl{
+		templateRepo: templateRepo,
+		uow:          uow,
+		creator:      creator,
+		timeProvider: timeProvider,
+	}
+}
+
+// List lists the templates ordered by name.
+func (t TemplatesImpl) List(ctx context.Context) ([]domain.Template, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	templates, err := t.templateRepo.ListTemplates(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// Save stores a template, replacing the items of the template with the same name.
+func (t TemplatesImpl) Save(ctx context.Context, name string, items []domain.TemplateItem) (domain.Template, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := t.timeProvider.Now()
+	template := domain.Template{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		Items:     trimTemplateItems(items),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := template.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+
+	current, found, err := t.templateRepo.GetTemplateByName(spanCtx, template.Name)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+	if found {
+		template.ID = current.ID
+		template.CreatedAt = current.CreatedAt
+		err = t.templateRepo.UpdateTemplate(spanCtx, template)
+	} else {
+		err = t.templateRepo.CreateTemplate(spanCtx, template)
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+
+	return template, nil
+}
+
+// Update renames a template and replaces its items.
+func (t TemplatesImpl) Update(ctx context.Context, id uuid.UUID, name string, items []domain.TemplateItem) (domain.Template, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	template, err := t.getTemplate(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+
+	template.Name = strings.TrimSpace(name)
+	template.Items = trimTemplateItems(items)
+	template.UpdatedAt = t.timeProvider.Now()
+	if err := template.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+
+	other, found, err := t.templateRepo.GetTemplateByName(spanCtx, template.Name)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+	if found && other.ID != template.ID {
+		err := core.NewValidationErr(fmt.Sprintf("a template named %q already exists", template.Name))
+		telemetry.IsErrorRecorded(span, err)
+		return domain.Template{}, err
+	}
+
+	if err := t.templateRepo.UpdateTemplate(spanCtx, template); telemetry.IsErrorRecorded(span, err) {
+		return domain.Template{}, err
+	}
+
+	return template, nil
+}
+
+// Delete removes a template.
+func (t TemplatesImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if _, err := t.getTemplate(spanCtx, id); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	if err := t.templateRepo.DeleteTemplate(spanCtx, id); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// Apply creates one todo per template item, due relative to the anchor date. All todos are
+// created in a single unit of work, so either every item is created or none is.
+func (t TemplatesImpl) Apply(ctx context.Context, id uuid.UUID, anchor time.Time) ([]domain.Todo, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	template, err := t.getTemplate(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	todos := make([]domain.Todo, 0, len(template.Items))
+	err = t.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		for _, item := range template.Items {
+			todo, err := t.creator.Create(uowCtx, scope, item.Title, item.DueDate(anchor), item.EstimatedMinutes, nil)
+			if err != nil {
+				return err
+			}
+			todos = append(todos, todo)
+		}
+		return nil
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return todos, nil
+}
+
+// getTemplate returns the template, rejecting unknown IDs.
+func (t TemplatesImpl) getTemplate(ctx context.Context, id uuid.UUID) (domain.Template, error) {
+	template, found, err := t.templateRepo.GetTemplate(ctx, id)
+	if err != nil {
+		return domain.Template{}, err
+	}
+	if !found {
+		return domain.Template{}, core.NewNotFoundErr(fmt.Sprintf("template with ID %s not found", id))
+	}
+	return template, nil
+}
+
+// trimTemplateItems returns a copy of the items with surrounding whitespace removed from their titles.
+func trimTemplateItems(items []domain.TemplateItem) []domain.TemplateItem {
+	trimmed := make([]domain.TemplateItem, len(items))
+	for i, item := range items {
+		item.Title = strings.TrimSpace(item.Title)
+		trimmed[i] = item
+	}
+	return trimmed
+}
@@ -0,0 +1,247 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTemplatesImpl_Save(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+	existingID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+	items := []domain.TemplateItem{{Title: " Pack passport ", DueOffsetDays: -1}}
+
+	tests := map[string]struct {
+		name            string
+		setExpectations func(repo *domain.MockTemplateRepository)
+		validate        func(t *testing.T, got domain.Template)
+		expectedErr     error
+	}{
+		"creates-new-template": {
+			name: " Trip packing ",
+			setExpectations: func(repo *domain.MockTemplateRepository) {
+				repo.EXPECT().GetTemplateByName(mock.Anything, "Trip packing").Return(domain.Template{}, false, nil).Once()
+				repo.EXPECT().CreateTemplate(mock.Anything, mock.MatchedBy(func(tpl domain.Template) bool {
+					return tpl.Name == "Trip packing" && tpl.ID != uuid.Nil && tpl.Items[0].Title == "Pack passport"
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got domain.Template) {
+				assert.Equal(t, "Trip packing", got.Name)
+				assert.Equal(t, now, got.CreatedAt)
+			},
+		},
+		"replaces-template-with-same-name": {
+			name: "trip packing",
+			setExpectations: func(repo *domain.MockTemplateRepository) {
+				repo.EXPECT().GetTemplateByName(mock.Anything, "trip packing").
+					Return(domain.Template{ID: existingID, Name: "Trip packing", CreatedAt: createdAt}, true, nil).Once()
+				repo.EXPECT().UpdateTemplate(mock.Anything, mock.MatchedBy(func(tpl domain.Template) bool {
+					return tpl.ID == existingID && tpl.CreatedAt.Equal(createdAt) && tpl.UpdatedAt.Equal(now)
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got domain.Template) {
+				assert.Equal(t, existingID, got.ID)
+			},
+		},
+		"invalid-template": {
+			name:            " ",
+			setExpectations: func(*domain.MockTemplateRepository) {},
+			expectedErr:     core.NewValidationErr("name cannot be empty"),
+		},
+		"repository-error": {
+			name: "Trip packing",
+			setExpectations: func(repo *domain.MockTemplateRepository) {
+				repo.EXPECT().GetTemplateByName(mock.Anything, "Trip packing").Return(domain.Template{}, false, nil).Once()
+				repo.EXPECT().CreateTemplate(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockTemplateRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Once()
+			tt.setExpectations(repo)
+
+			uc := NewTemplatesImpl(repo, transaction.NewMockUnitOfWork(t), NewMockCreator(t), timeProvider)
+			got, err := uc.Save(t.Context(), tt.name, items)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.validate != nil {
+				tt.validate(t, got)
+			}
+		})
+	}
+}
+
+func TestTemplatesImpl_Update(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	templateID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+	current := domain.Template{ID: templateID, Name: "Trip packing", Items: []domain.TemplateItem{{Title: "Pack passport"}}}
+	items := []domain.TemplateItem{{Title: "Book hotel", DueOffsetDays: -7}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockTemplateRepository, timeProvider *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *domain.MockTemplateRepository, timeProvider *core.MockCurrentTimeProvider) {
+				timeProvider.EXPECT().Now().Return(now).Once()
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(current, true, nil).Once()
+				repo.EXPECT().GetTemplateByName(mock.Anything, "Weekend trip").Return(domain.Template{}, false, nil).Once()
+				repo.EXPECT().UpdateTemplate(mock.Anything, domain.Template{
+					ID:        templateID,
+					Name:      "Weekend trip",
+					Items:     items,
+					UpdatedAt: now,
+				}).Return(nil).Once()
+			},
+		},
+		"not-found": {
+			setExpectations: func(repo *domain.MockTemplateRepository, _ *core.MockCurrentTimeProvider) {
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(domain.Template{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("template with ID 96000000-0000-0000-0000-000000000001 not found"),
+		},
+		"name-conflict": {
+			setExpectations: func(repo *domain.MockTemplateRepository, timeProvider *core.MockCurrentTimeProvider) {
+				timeProvider.EXPECT().Now().Return(now).Once()
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(current, true, nil).Once()
+				repo.EXPECT().GetTemplateByName(mock.Anything, "Weekend trip").
+					Return(domain.Template{ID: uuid.New(), Name: "Weekend trip"}, true, nil).Once()
+			},
+			expectedErr: core.NewValidationErr(`a template named "Weekend trip" already exists`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockTemplateRepository(t)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(repo, timeProvider)
+
+			uc := NewTemplatesImpl(repo, transaction.NewMockUnitOfWork(t), NewMockCreator(t), timeProvider)
+			_, err := uc.Update(t.Context(), templateID, "Weekend trip", items)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestTemplatesImpl_Delete(t *testing.T) {
+	t.Parallel()
+
+	templateID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockTemplateRepository)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(repo *domain.MockTemplateRepository) {
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(domain.Template{ID: templateID}, true, nil).Once()
+				repo.EXPECT().DeleteTemplate(mock.Anything, templateID).Return(nil).Once()
+			},
+		},
+		"not-found": {
+			setExpectations: func(repo *domain.MockTemplateRepository) {
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(domain.Template{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("template with ID 96000000-0000-0000-0000-000000000001 not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockTemplateRepository(t)
+			tt.setExpectations(repo)
+
+			uc := NewTemplatesImpl(repo, transaction.NewMockUnitOfWork(t), NewMockCreator(t), core.NewMockCurrentTimeProvider(t))
+			assert.Equal(t, tt.expectedErr, uc.Delete(t.Context(), templateID))
+		})
+	}
+}
+
+func TestTemplatesImpl_Apply(t *testing.T) {
+	t.Parallel()
+
+	templateID := uuid.MustParse("96000000-0000-0000-0000-000000000001")
+	anchor := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	template := domain.Template{
+		ID:   templateID,
+		Name: "Trip packing",
+		Items: []domain.TemplateItem{
+			{Title: "Book hotel", DueOffsetDays: -7, EstimatedMinutes: 20},
+			{Title: "Pack passport"},
+		},
+	}
+	hotel := domain.Todo{ID: uuid.New(), Title: "Book hotel", DueDate: anchor.AddDate(0, 0, -7)}
+	passport := domain.Todo{ID: uuid.New(), Title: "Pack passport", DueDate: anchor}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockTemplateRepository, uow *transaction.MockUnitOfWork, creator *MockCreator)
+		expected        []domain.Todo
+		expectedErr     error
+	}{
+		"creates-all-items": {
+			setExpectations: func(repo *domain.MockTemplateRepository, uow *transaction.MockUnitOfWork, creator *MockCreator) {
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(template, true, nil).Once()
+				expectScope(t, uow)
+				creator.EXPECT().Create(mock.Anything, mock.Anything, "Book hotel", anchor.AddDate(0, 0, -7), 20, domain.CustomFieldValues(nil)).
+					Return(hotel, nil).Once()
+				creator.EXPECT().Create(mock.Anything, mock.Anything, "Pack passport", anchor, 0, domain.CustomFieldValues(nil)).
+					Return(passport, nil).Once()
+			},
+			expected: []domain.Todo{hotel, passport},
+		},
+		"item-error-aborts": {
+			setExpectations: func(repo *domain.MockTemplateRepository, uow *transaction.MockUnitOfWork, creator *MockCreator) {
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(template, true, nil).Once()
+				expectScope(t, uow)
+				creator.EXPECT().Create(mock.Anything, mock.Anything, "Book hotel", anchor.AddDate(0, 0, -7), 20, domain.CustomFieldValues(nil)).
+					Return(domain.Todo{}, errors.New("creation failed")).Once()
+			},
+			expectedErr: errors.New("creation failed"),
+		},
+		"not-found": {
+			setExpectations: func(repo *domain.MockTemplateRepository, _ *transaction.MockUnitOfWork, _ *MockCreator) {
+				repo.EXPECT().GetTemplate(mock.Anything, templateID).Return(domain.Template{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("template with ID 96000000-0000-0000-0000-000000000001 not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockTemplateRepository(t)
+			uow := transaction.NewMockUnitOfWork(t)
+			creator := NewMockCreator(t)
+			tt.setExpectations(repo, uow, creator)
+
+			uc := NewTemplatesImpl(repo, uow, creator, core.NewMockCurrentTimeProvider(t))
+			got, err := uc.Apply(t.Context(), templateID, anchor)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
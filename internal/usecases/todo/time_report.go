@@ -0,0 +1,46 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+)
+
+// GetTimeReport defines the interface for the time report use case.
+type GetTimeReport interface {
+	Query(ctx context.Context, from, to time.Time) ([]domain.TimeReportItem, error)
+}
+
+// GetTimeReportImpl is the implementation of the GetTimeReport use case.
+type GetTimeReportImpl struct {
+	repo domain.TimeEntryRepository
+}
+
+// NewGetTimeReportImpl creates a new instance of GetTimeReportImpl.
+func NewGetTimeReportImpl(repo domain.TimeEntryRepository) GetTimeReportImpl {
+	return GetTimeReportImpl{
+		repo: repo,
+	}
+}
+
+// Query aggregates the time logged per todo for work sessions started in the [from, to) range.
+func (gtr GetTimeReportImpl) Query(ctx context.Context, from, to time.Time) ([]domain.TimeReportItem, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if !to.After(from) {
+		err := core.NewValidationErr("to must be after from")
+		telemetry.IsErrorRecorded(span, err)
+		return nil, err
+	}
+
+	items, err := gtr.repo.TimeReport(spanCtx, from, to)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return items, nil
+}
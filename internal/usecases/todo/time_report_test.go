@@ -0,0 +1,67 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetTimeReportImpl_Query(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	items := []domain.TimeReportItem{
+		{TodoID: uuid.New(), Title: "Write report", Total: time.Hour, Entries: 2},
+	}
+
+	tests := map[string]struct {
+		from            time.Time
+		to              time.Time
+		setExpectations func(repo *domain.MockTimeEntryRepository)
+		expected        []domain.TimeReportItem
+		expectedErr     error
+	}{
+		"success": {
+			from: from,
+			to:   to,
+			setExpectations: func(repo *domain.MockTimeEntryRepository) {
+				repo.EXPECT().TimeReport(mock.Anything, from, to).Return(items, nil).Once()
+			},
+			expected: items,
+		},
+		"invalid-range": {
+			from:            to,
+			to:              from,
+			setExpectations: func(repo *domain.MockTimeEntryRepository) {},
+			expectedErr:     core.NewValidationErr("to must be after from"),
+		},
+		"repository-error": {
+			from: from,
+			to:   to,
+			setExpectations: func(repo *domain.MockTimeEntryRepository) {
+				repo.EXPECT().TimeReport(mock.Anything, from, to).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockTimeEntryRepository(t)
+			tt.setExpectations(repo)
+
+			got, err := NewGetTimeReportImpl(repo).Query(t.Context(), tt.from, tt.to)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// TimeTracker defines the interface for recording work sessions against todos.
+type TimeTracker interface {
+	// StartTimer starts a running timer for the todo.
+	StartTimer(ctx context.Context, todoID uuid.UUID) (domain.TimeEntry, error)
+	// StopTimer stops the running timer of the todo.
+	StopTimer(ctx context.Context, todoID uuid.UUID) (domain.TimeEntry, error)
+	// LogTime records a finished work session for the todo.
+	LogTime(ctx context.Context, todoID uuid.UUID, startedAt time.Time, duration time.Duration) (domain.TimeEntry, error)
+}
+
+// TimeTrackerImpl is the implementation of the TimeTracker use case.
+type TimeTrackerImpl struct {
+	uow          transaction.UnitOfWork
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewTimeTrackerImpl creates a new instance of TimeTrackerImpl.
+func NewTimeTrackerImpl(uow transaction.UnitOfWork, timeProvider core.CurrentTimeProvider) TimeTrackerImpl {
+	return TimeTrackerImpl{
+		uow:          uow,
+		timeProvider: timeProvider,
+	}
+}
+
+// StartTimer starts a running timer for the todo. Only one timer can run per todo.
+func (tt TimeTrackerImpl) StartTimer(ctx context.Context, todoID uuid.UUID) (domain.TimeEntry, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var entry domain.TimeEntry
+	err := tt.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if err := ensureTodoExists(uowCtx, scope, todoID); err != nil {
+			return err
+		}
+
+		_, running, err := scope.TimeEntry().GetRunningTimeEntry(uowCtx, todoID)
+		if err != nil {
+			return err
+		}
+		if running {
+			return core.NewValidationErr("a timer is already running for this todo")
+		}
+
+		now := tt.timeProvider.Now()
+		entry = domain.TimeEntry{
+			ID:        uuid.New(),
+			TodoID:    todoID,
+			StartedAt: now,
+			CreatedAt: now,
+		}
+		return scope.TimeEntry().CreateTimeEntry(uowCtx, entry)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// StopTimer stops the running timer of the todo.
+func (tt TimeTrackerImpl) StopTimer(ctx context.Context, todoID uuid.UUID) (domain.TimeEntry, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	var entry domain.TimeEntry
+	err := tt.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		running, found, err := scope.TimeEntry().GetRunningTimeEntry(uowCtx, todoID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return core.NewNotFoundErr(fmt.Sprintf("no running timer for todo with ID %s", todoID))
+		}
+
+		now := tt.timeProvider.Now()
+		running.EndedAt = &now
+		if err := running.Validate(); err != nil {
+			return err
+		}
+		entry = running
+		return scope.TimeEntry().UpdateTimeEntry(uowCtx, entry)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// LogTime records a finished work session of the given duration for the todo.
+func (tt TimeTrackerImpl) LogTime(ctx context.Context, todoID uuid.UUID, startedAt time.Time, duration time.Duration) (domain.TimeEntry, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if duration <= 0 {
+		err := core.NewValidationErr("duration must be greater than zero")
+		telemetry.IsErrorRecorded(span, err)
+		return domain.TimeEntry{}, err
+	}
+
+	endedAt := startedAt.Add(duration)
+	entry := domain.TimeEntry{
+		ID:        uuid.New(),
+		TodoID:    todoID,
+		StartedAt: startedAt,
+		EndedAt:   &endedAt,
+		CreatedAt: tt.timeProvider.Now(),
+	}
+	if err := entry.Validate(); telemetry.IsErrorRecorded(span, err) {
+		return domain.TimeEntry{}, err
+	}
+
+	err := tt.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
+		if err := ensureTodoExists(uowCtx, scope, todoID); err != nil {
+			return err
+		}
+		return scope.TimeEntry().CreateTimeEntry(uowCtx, entry)
+	})
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.TimeEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// ensureTodoExists returns a not found error when the todo does not exist.
+func ensureTodoExists(ctx context.Context, scope transaction.Scope, todoID uuid.UUID) error {
+	_, found, err := scope.Todo().GetTodo(ctx, todoID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return core.NewNotFoundErr(fmt.Sprintf("todo with ID %s not found", todoID))
+	}
+	return nil
+}
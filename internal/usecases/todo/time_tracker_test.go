@@ -0,0 +1,215 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTimeTrackerImpl_StartTimer(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				repos := expectScope(t, uow)
+				todoRepo, timeRepo := repos.Todo, repos.TimeEntry
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				timeRepo.EXPECT().GetRunningTimeEntry(mock.Anything, todoID).Return(domain.TimeEntry{}, false, nil).Once()
+				tp.EXPECT().Now().Return(now).Once()
+				timeRepo.EXPECT().
+					CreateTimeEntry(mock.Anything, mock.MatchedBy(func(e domain.TimeEntry) bool {
+						return e.TodoID == todoID && e.StartedAt.Equal(now) && e.EndedAt == nil
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"todo-not-found": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"already-running": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				repos := expectScope(t, uow)
+				todoRepo, timeRepo := repos.Todo, repos.TimeEntry
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				timeRepo.EXPECT().GetRunningTimeEntry(mock.Anything, todoID).Return(domain.TimeEntry{TodoID: todoID}, true, nil).Once()
+			},
+			expectedErr: core.NewValidationErr("a timer is already running for this todo"),
+		},
+		"create-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				repos := expectScope(t, uow)
+				todoRepo, timeRepo := repos.Todo, repos.TimeEntry
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				timeRepo.EXPECT().GetRunningTimeEntry(mock.Anything, todoID).Return(domain.TimeEntry{}, false, nil).Once()
+				tp.EXPECT().Now().Return(now).Once()
+				timeRepo.EXPECT().CreateTimeEntry(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(uow, tp)
+
+			uc := NewTimeTrackerImpl(uow, tp)
+			entry, err := uc.StartTimer(t.Context(), todoID)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, todoID, entry.TodoID)
+			assert.True(t, entry.Running())
+		})
+	}
+}
+
+func TestTimeTrackerImpl_StopTimer(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	now := startedAt.Add(45 * time.Minute)
+	running := domain.TimeEntry{ID: uuid.New(), TodoID: todoID, StartedAt: startedAt}
+
+	tests := map[string]struct {
+		setExpectations func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"success": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				timeRepo := expectScope(t, uow).TimeEntry
+				timeRepo.EXPECT().GetRunningTimeEntry(mock.Anything, todoID).Return(running, true, nil).Once()
+				tp.EXPECT().Now().Return(now).Once()
+				timeRepo.EXPECT().
+					UpdateTimeEntry(mock.Anything, mock.MatchedBy(func(e domain.TimeEntry) bool {
+						return e.ID == running.ID && e.EndedAt != nil && e.EndedAt.Equal(now)
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"no-running-timer": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				timeRepo := expectScope(t, uow).TimeEntry
+				timeRepo.EXPECT().GetRunningTimeEntry(mock.Anything, todoID).Return(domain.TimeEntry{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("no running timer for todo with ID 123e4567-e89b-12d3-a456-426614174000"),
+		},
+		"repository-error": {
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				timeRepo := expectScope(t, uow).TimeEntry
+				timeRepo.EXPECT().GetRunningTimeEntry(mock.Anything, todoID).Return(domain.TimeEntry{}, false, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(uow, tp)
+
+			uc := NewTimeTrackerImpl(uow, tp)
+			entry, err := uc.StopTimer(t.Context(), todoID)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 45*time.Minute, entry.Duration(now))
+			assert.False(t, entry.Running())
+		})
+	}
+}
+
+func TestTimeTrackerImpl_LogTime(t *testing.T) {
+	t.Parallel()
+
+	todoID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		duration        time.Duration
+		setExpectations func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider)
+		expectedErr     error
+	}{
+		"success": {
+			duration: 30 * time.Minute,
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				repos := expectScope(t, uow)
+				todoRepo, timeRepo := repos.Todo, repos.TimeEntry
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{ID: todoID}, true, nil).Once()
+				timeRepo.EXPECT().
+					CreateTimeEntry(mock.Anything, mock.MatchedBy(func(e domain.TimeEntry) bool {
+						return e.TodoID == todoID && e.Duration(now) == 30*time.Minute
+					})).
+					Return(nil).
+					Once()
+			},
+		},
+		"invalid-duration": {
+			duration:        0,
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {},
+			expectedErr:     core.NewValidationErr("duration must be greater than zero"),
+		},
+		"todo-not-found": {
+			duration: time.Hour,
+			setExpectations: func(uow *transaction.MockUnitOfWork, tp *core.MockCurrentTimeProvider) {
+				tp.EXPECT().Now().Return(now).Once()
+				todoRepo := expectScope(t, uow).Todo
+				todoRepo.EXPECT().GetTodo(mock.Anything, todoID).Return(domain.Todo{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			uow := transaction.NewMockUnitOfWork(t)
+			tp := core.NewMockCurrentTimeProvider(t)
+			tt.setExpectations(uow, tp)
+
+			uc := NewTimeTrackerImpl(uow, tp)
+			entry, err := uc.LogTime(t.Context(), todoID, startedAt, tt.duration)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, startedAt, entry.StartedAt)
+			assert.Equal(t, tt.duration, entry.Duration(now))
+		})
+	}
+}
@@ -2,17 +2,70 @@ package todo
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
 	"github.com/google/uuid"
 )
 
+// UpdateParams holds the optional settings of an update.
+type UpdateParams struct {
+	ExpectedUpdatedAt *time.Time
+	EstimatedMinutes  *int
+	CustomFields      domain.CustomFieldValues
+	Location          *domain.Location
+	Recurrence        *domain.Recurrence
+}
+
+// UpdateOption defines a function type for specifying options when updating a todo.
+type UpdateOption func(*UpdateParams)
+
+// WithExpectedUpdatedAt creates an UpdateOption that rejects the update with a ConflictErr
+// when the stored todo was modified after the given updated_at.
+func WithExpectedUpdatedAt(updatedAt time.Time) UpdateOption {
+	return func(params *UpdateParams) {
+		params.ExpectedUpdatedAt = &updatedAt
+	}
+}
+
+// WithEstimatedMinutes creates an UpdateOption that sets the estimated minutes of the todo.
+// Zero clears the estimate.
+func WithEstimatedMinutes(minutes int) UpdateOption {
+	return func(params *UpdateParams) {
+		params.EstimatedMinutes = &minutes
+	}
+}
+
+// WithCustomFields creates an UpdateOption that sets custom field values on the todo. A nil value removes
+// the field from the todo.
+func WithCustomFields(values domain.CustomFieldValues) UpdateOption {
+	return func(params *UpdateParams) {
+		params.CustomFields = values
+	}
+}
+
+// WithLocation creates an UpdateOption that sets the location of the todo. A zero location clears it.
+func WithLocation(loc domain.Location) UpdateOption {
+	return func(params *UpdateParams) {
+		params.Location = &loc
+	}
+}
+
+// WithRecurrence creates an UpdateOption that sets the rule the todo repeats by. A zero recurrence stops
+// the todo from recurring.
+func WithRecurrence(recurrence domain.Recurrence) UpdateOption {
+	return func(params *UpdateParams) {
+		params.Recurrence = &recurrence
+	}
+}
+
 // Update defines the interface for the update use case.
 type Update interface {
-	Execute(ctx context.Context, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time) (domain.Todo, error)
+	Execute(ctx context.Context, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time, opts ...UpdateOption) (domain.Todo, error)
 }
 
 // UpdateImpl is the implementation of the update use case.
@@ -30,13 +83,36 @@ func NewUpdateImpl(uow transaction.UnitOfWork, modifier Updater) UpdateImpl {
 }
 
 // Execute updates an existing todo item identified by id with the provided title, status, and/or due date.
-func (uti UpdateImpl) Execute(ctx context.Context, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time) (domain.Todo, error) {
+func (uti UpdateImpl) Execute(ctx context.Context, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time, opts ...UpdateOption) (domain.Todo, error) {
 	spanCtx, span := telemetry.StartSpan(ctx)
 	defer span.End()
 
+	params := UpdateParams{}
+	for _, opt := range opts {
+		opt(&params)
+	}
+
 	var todo domain.Todo
 	err := uti.uow.Execute(spanCtx, func(uowCtx context.Context, scope transaction.Scope) error {
-		td, err := uti.modifier.Update(uowCtx, scope, id, title, status, dueDate)
+		if params.ExpectedUpdatedAt != nil {
+			current, found, err := scope.Todo().GetTodo(uowCtx, id)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return core.NewNotFoundErr(fmt.Sprintf("todo with ID %s not found", id))
+			}
+			if !current.UpdatedAt.Equal(*params.ExpectedUpdatedAt) {
+				return core.NewConflictErr(fmt.Sprintf("todo with ID %s was modified by another client", id))
+			}
+		}
+
+		var modifierOpts []UpdateOption
+		if params.Location != nil {
+			modifierOpts = append(modifierOpts, WithLocation(*params.Location))
+		}
+
+		td, err := uti.modifier.Update(uowCtx, scope, id, title, status, dueDate, params.EstimatedMinutes, params.CustomFields, modifierOpts...)
 		if err != nil {
 			return err
 		}
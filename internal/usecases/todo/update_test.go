@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
 	"github.com/google/uuid"
@@ -36,6 +38,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 		title           *string
 		status          *domain.Status
 		dueDate         *time.Time
+		opts            []UpdateOption
 		setExpectations func(
 			uow *transaction.MockUnitOfWork,
 			modifier *MockUpdater,
@@ -53,7 +56,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 				modifier *MockUpdater,
 			) {
 				modifier.EXPECT().
-					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, &newStatus, &newDueDate).
+					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, &newStatus, &newDueDate, (*int)(nil), domain.CustomFieldValues(nil)).
 					Return(expectedTodo, nil)
 
 				uow.EXPECT().
@@ -76,7 +79,27 @@ func TestUpdateImpl_Execute(t *testing.T) {
 			) {
 
 				modifier.EXPECT().
-					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, (*domain.Status)(nil), (*time.Time)(nil)).
+					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, (*domain.Status)(nil), (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
+					Return(expectedTodo, nil)
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, transaction.NewMockScope(t))
+					})
+			},
+			expectedTodo: expectedTodo,
+			expectedErr:  nil,
+		},
+		"success-update-estimated-minutes-only": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithEstimatedMinutes(45)},
+			setExpectations: func(
+				uow *transaction.MockUnitOfWork,
+				modifier *MockUpdater,
+			) {
+				modifier.EXPECT().
+					Update(mock.Anything, mock.Anything, fixedUUID, (*string)(nil), (*domain.Status)(nil), (*time.Time)(nil), common.Ptr(45), domain.CustomFieldValues(nil)).
 					Return(expectedTodo, nil)
 
 				uow.EXPECT().
@@ -98,7 +121,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 				modifier *MockUpdater,
 			) {
 				modifier.EXPECT().
-					Update(mock.Anything, mock.Anything, fixedUUID, (*string)(nil), &newStatus, (*time.Time)(nil)).
+					Update(mock.Anything, mock.Anything, fixedUUID, (*string)(nil), &newStatus, (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
 					Return(expectedTodo, nil)
 
 				uow.EXPECT().
@@ -120,7 +143,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 				modifier *MockUpdater,
 			) {
 				modifier.EXPECT().
-					Update(mock.Anything, mock.Anything, fixedUUID, (*string)(nil), (*domain.Status)(nil), &newDueDate).
+					Update(mock.Anything, mock.Anything, fixedUUID, (*string)(nil), (*domain.Status)(nil), &newDueDate, (*int)(nil), domain.CustomFieldValues(nil)).
 					Return(expectedTodo, nil)
 
 				uow.EXPECT().
@@ -132,6 +155,105 @@ func TestUpdateImpl_Execute(t *testing.T) {
 			expectedTodo: expectedTodo,
 			expectedErr:  nil,
 		},
+		"success-update-location-forwards-option": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithLocation(domain.Location{Name: "Downtown"})},
+			setExpectations: func(
+				uow *transaction.MockUnitOfWork,
+				modifier *MockUpdater,
+			) {
+				modifier.EXPECT().
+					Update(mock.Anything, mock.Anything, fixedUUID, (*string)(nil), (*domain.Status)(nil), (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil), mock.Anything).
+					RunAndReturn(func(_ context.Context, _ transaction.Scope, _ uuid.UUID, _ *string, _ *domain.Status, _ *time.Time, _ *int, _ domain.CustomFieldValues, opts ...UpdateOption) (domain.Todo, error) {
+						params := UpdateParams{}
+						for _, opt := range opts {
+							opt(&params)
+						}
+						assert.Equal(t, &domain.Location{Name: "Downtown"}, params.Location)
+						return expectedTodo, nil
+					})
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, transaction.NewMockScope(t))
+					})
+			},
+			expectedTodo: expectedTodo,
+		},
+		"success-expected-updated-at-matches": {
+			id:     fixedUUID,
+			status: &newStatus,
+			opts:   []UpdateOption{WithExpectedUpdatedAt(fixedTime)},
+			setExpectations: func(
+				uow *transaction.MockUnitOfWork,
+				modifier *MockUpdater,
+			) {
+				todoRepo := domain.NewMockRepository(t)
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, fixedUUID).
+					Return(domain.Todo{ID: fixedUUID, UpdatedAt: fixedTime}, true, nil)
+				scope := transaction.NewMockScope(t)
+				scope.EXPECT().Todo().Return(todoRepo)
+
+				modifier.EXPECT().
+					Update(mock.Anything, scope, fixedUUID, (*string)(nil), &newStatus, (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
+					Return(expectedTodo, nil)
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					})
+			},
+			expectedTodo: expectedTodo,
+		},
+		"expected-updated-at-conflict": {
+			id:     fixedUUID,
+			status: &newStatus,
+			opts:   []UpdateOption{WithExpectedUpdatedAt(fixedTime.Add(-time.Hour))},
+			setExpectations: func(
+				uow *transaction.MockUnitOfWork,
+				modifier *MockUpdater,
+			) {
+				todoRepo := domain.NewMockRepository(t)
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, fixedUUID).
+					Return(domain.Todo{ID: fixedUUID, UpdatedAt: fixedTime}, true, nil)
+				scope := transaction.NewMockScope(t)
+				scope.EXPECT().Todo().Return(todoRepo)
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					})
+			},
+			expectedErr: core.NewConflictErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 was modified by another client"),
+		},
+		"expected-updated-at-not-found": {
+			id:     fixedUUID,
+			status: &newStatus,
+			opts:   []UpdateOption{WithExpectedUpdatedAt(fixedTime)},
+			setExpectations: func(
+				uow *transaction.MockUnitOfWork,
+				modifier *MockUpdater,
+			) {
+				todoRepo := domain.NewMockRepository(t)
+				todoRepo.EXPECT().
+					GetTodo(mock.Anything, fixedUUID).
+					Return(domain.Todo{}, false, nil)
+				scope := transaction.NewMockScope(t)
+				scope.EXPECT().Todo().Return(todoRepo)
+
+				uow.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context, transaction.Scope) error) error {
+						return fn(ctx, scope)
+					})
+			},
+			expectedErr: core.NewNotFoundErr("todo with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
 		"modifier-error-not-found": {
 			id:      fixedUUID,
 			title:   &newTitle,
@@ -142,7 +264,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 				modifier *MockUpdater,
 			) {
 				modifier.EXPECT().
-					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, (*domain.Status)(nil), (*time.Time)(nil)).
+					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, (*domain.Status)(nil), (*time.Time)(nil), (*int)(nil), domain.CustomFieldValues(nil)).
 					Return(domain.Todo{}, errors.New("todo not found"))
 
 				uow.EXPECT().
@@ -164,7 +286,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 				modifier *MockUpdater,
 			) {
 				modifier.EXPECT().
-					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, &newStatus, &newDueDate).
+					Update(mock.Anything, mock.Anything, fixedUUID, &newTitle, &newStatus, &newDueDate, (*int)(nil), domain.CustomFieldValues(nil)).
 					Return(domain.Todo{}, errors.New("validation failed"))
 
 				uow.EXPECT().
@@ -204,7 +326,7 @@ func TestUpdateImpl_Execute(t *testing.T) {
 
 			uti := NewUpdateImpl(uow, modifier)
 
-			got, gotErr := uti.Execute(t.Context(), tt.id, tt.title, tt.status, tt.dueDate)
+			got, gotErr := uti.Execute(t.Context(), tt.id, tt.title, tt.status, tt.dueDate, tt.opts...)
 			assert.Equal(t, tt.expectedErr, gotErr)
 			if tt.expectedErr == nil {
 				assert.Equal(t, tt.expectedTodo.ID, got.ID)
@@ -3,44 +3,52 @@ package todo
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
 	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/transaction"
-	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/usecases/metrics"
 	"github.com/google/uuid"
 )
 
 // Updater defines the interface for modifying todo items.
 type Updater interface {
-	Update(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time) (domain.Todo, error)
+	Update(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time, estimatedMinutes *int, customFields domain.CustomFieldValues, opts ...UpdateOption) (domain.Todo, error)
 }
 
 // UpdaterImpl is the implementation of the Updater interface.
 type UpdaterImpl struct {
-	timeProvider core.CurrentTimeProvider
-	encoder      semantic.Encoder
-	model        string
+	timeProvider   core.CurrentTimeProvider
+	encoder        semantic.Encoder
+	model          string
+	secondaryModel string
 }
 
-// NewUpdaterImpl creates a new instance of UpdaterImpl.
+// NewUpdaterImpl creates a new instance of UpdaterImpl. A non-empty secondaryModel also embeds re-embedded todos
+// into the secondary embedding while migrating to that model.
 func NewUpdaterImpl(
 	timeProvider core.CurrentTimeProvider,
 	encoder semantic.Encoder,
 	model string,
+	secondaryModel string,
 ) UpdaterImpl {
 	return UpdaterImpl{
-		timeProvider: timeProvider,
-		encoder:      encoder,
-		model:        model,
+		timeProvider:   timeProvider,
+		encoder:        encoder,
+		model:          model,
+		secondaryModel: secondaryModel,
 	}
 }
 
-// Update modifies an existing todo item identified by id with the provided title, status, and/or due date.
-func (tui UpdaterImpl) Update(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time) (domain.Todo, error) {
+// Update modifies an existing todo item identified by id with the provided title, status, due date and/or
+// estimated minutes. Setting estimatedMinutes to zero clears the estimate. Custom field changes are merged into
+// the current values; a nil value removes the field from the todo. Only the WithLocation and WithRecurrence
+// options are applied here.
+func (tui UpdaterImpl) Update(ctx context.Context, scope transaction.Scope, id uuid.UUID, title *string, status *domain.Status, dueDate *time.Time, estimatedMinutes *int, customFields domain.CustomFieldValues, opts ...UpdateOption) (domain.Todo, error) {
 	now := tui.timeProvider.Now()
 	var todo domain.Todo
 	td, found, err := scope.Todo().GetTodo(ctx, id)
@@ -51,18 +59,60 @@ func (tui UpdaterImpl) Update(ctx context.Context, scope transaction.Scope, id u
 		return domain.Todo{}, core.NewNotFoundErr(fmt.Sprintf("todo with ID %s not found", id))
 	}
 
+	before := td
+
 	if title != nil {
 		td.Title = *title
 	}
 
 	if status != nil {
 		td.Status = *status
+		// Reopening an archived todo brings it back to the default listings.
+		if td.Status == domain.Status_OPEN {
+			td.ArchivedAt = nil
+		}
 	}
 
 	if dueDate != nil {
 		td.DueDate = dueDate.UTC()
 	}
 
+	if estimatedMinutes != nil {
+		td.EstimatedMinutes = *estimatedMinutes
+	}
+
+	if len(customFields) > 0 {
+		values, err := applyCustomFields(ctx, scope, td.CustomFields, customFields)
+		if err != nil {
+			return domain.Todo{}, err
+		}
+		td.CustomFields = values
+	}
+
+	params := UpdateParams{}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	if params.Location != nil {
+		td.Location = nil
+		if !params.Location.IsZero() {
+			td.Location = params.Location
+		}
+	}
+	if params.Recurrence != nil {
+		td.Recurrence = nil
+		if !params.Recurrence.IsZero() {
+			recurrence := params.Recurrence.AnchoredTo(td.DueDate)
+			td.Recurrence = &recurrence
+		}
+	} else if td.Recurrence != nil && !td.DueDate.Equal(before.DueDate) {
+		// Moving the due date of a monthly todo moves the day of the month its series repeats on.
+		recurrence := *td.Recurrence
+		recurrence.MonthDay = 0
+		recurrence = recurrence.AnchoredTo(td.DueDate)
+		td.Recurrence = &recurrence
+	}
+
 	td.UpdatedAt = now
 
 	if err := td.Validate(now); err != nil {
@@ -70,13 +120,9 @@ func (tui UpdaterImpl) Update(ctx context.Context, scope transaction.Scope, id u
 	}
 
 	if title != nil || len(td.Embedding) == 0 {
-		resp, err := tui.encoder.VectorizeTodo(ctx, tui.model, td)
-		if err != nil {
+		if err := embedTodo(ctx, tui.encoder, tui.model, tui.secondaryModel, &td); err != nil {
 			return domain.Todo{}, err
 		}
-
-		metrics.RecordLLMTokensEmbedding(ctx, resp.TotalTokens)
-		td.Embedding = resp.Vector
 	}
 
 	if err := scope.Todo().UpdateTodo(ctx, td); err != nil {
@@ -85,13 +131,47 @@ func (tui UpdaterImpl) Update(ctx context.Context, scope transaction.Scope, id u
 
 	todo = td
 
-	if err = scope.Outbox().CreateTodoEvent(ctx, outbox.TodoEvent{
-		Type:      outbox.EventType_TODO_UPDATED,
-		TodoID:    todo.ID,
-		CreatedAt: now,
-	}); err != nil {
+	if _, fromChat := assistant.ConversationIDFromContext(ctx); fromChat {
+		if note := describeChatChanges(before, todo); note != "" {
+			if err := scope.Comment().CreateComment(ctx, domain.Comment{
+				ID:        uuid.New(),
+				TodoID:    todo.ID,
+				Author:    domain.CommentAuthor_Assistant,
+				Body:      note,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}); err != nil {
+				return domain.Todo{}, err
+			}
+		}
+	}
+
+	if err = recordTodoChange(ctx, scope, outbox.EventType_TODO_UPDATED, todo.ID, now); err != nil {
 		return domain.Todo{}, err
 	}
 
 	return todo, nil
 }
+
+// describeChatChanges builds the assistant comment recorded when a chat action modifies a todo.
+// It returns an empty string when nothing visible changed.
+func describeChatChanges(before, after domain.Todo) string {
+	var changes []string
+	if before.Title != after.Title {
+		changes = append(changes, fmt.Sprintf("renamed from %q to %q", before.Title, after.Title))
+	}
+	if before.Status != after.Status {
+		changes = append(changes, fmt.Sprintf("marked %s", after.Status))
+	}
+	if !before.DueDate.Equal(after.DueDate) {
+		changes = append(changes, fmt.Sprintf(
+			"rescheduled from %s to %s",
+			before.DueDate.Format(time.DateOnly),
+			after.DueDate.Format(time.DateOnly),
+		))
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	return "Updated from chat: " + strings.Join(changes, "; ") + "."
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/outbox"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/semantic"
@@ -21,6 +22,7 @@ func TestUpdaterImpl_Update(t *testing.T) {
 	t.Parallel()
 
 	fixedUUID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	chatConversationID := uuid.MustParse("9b2e4567-e89b-12d3-a456-426614174099")
 	fixedTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	todo := domain.Todo{
 		ID:        fixedUUID,
@@ -29,18 +31,52 @@ func TestUpdaterImpl_Update(t *testing.T) {
 		Embedding: []float64{0.4, 0.5, 0.6},
 		DueDate:   fixedTime,
 	}
+	downtown := domain.Location{Name: "Downtown", Point: &domain.GeoPoint{Latitude: 38.7223, Longitude: -9.1393}}
+	locatedTodo := todo
+	locatedTodo.Location = &downtown
+	weekly := domain.Recurrence{Frequency: domain.RecurrenceFrequency_WEEKLY, Interval: 1}
+	recurringTodo := todo
+	recurringTodo.Recurrence = &weekly
+
+	expectOptionUpdate := func(
+		scope *transaction.MockScope,
+		timeProvider *core.MockCurrentTimeProvider,
+		current domain.Todo,
+		matches func(domain.Todo) bool,
+	) {
+		timeProvider.EXPECT().Now().Return(fixedTime)
+
+		repo := domain.NewMockRepository(t)
+		outboxRepo := outbox.NewMockRepository(t)
+		changeRepo := domain.NewMockChangeRepository(t)
+
+		scope.EXPECT().Todo().Return(repo)
+		scope.EXPECT().Change().Return(changeRepo)
+		scope.EXPECT().Outbox().Return(outboxRepo)
+
+		repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(current, true, nil)
+		repo.EXPECT().UpdateTodo(mock.Anything, mock.MatchedBy(func(t domain.Todo) bool {
+			return t.ID == fixedUUID && matches(t)
+		})).Return(nil)
+		changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{Sequence: 8}, nil)
+		outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.Anything).Return(nil)
+	}
 
 	tests := map[string]struct {
 		setExpectations func(
 			scope *transaction.MockScope,
 			timeProvider *core.MockCurrentTimeProvider,
 			semanticEncoder *semantic.MockEncoder)
-		id           uuid.UUID
-		title        *string
-		status       *domain.Status
-		dueDate      *time.Time
-		expectedTodo domain.Todo
-		expectedErr  error
+		id               uuid.UUID
+		title            *string
+		status           *domain.Status
+		dueDate          *time.Time
+		estimatedMinutes *int
+		customFields     domain.CustomFieldValues
+		opts             []UpdateOption
+		fromChat         bool
+		expectedTodo     domain.Todo
+		expectedErr      error
 	}{
 		"success": {
 			id:      fixedUUID,
@@ -64,7 +100,10 @@ func TestUpdaterImpl_Update(t *testing.T) {
 				repo := domain.NewMockRepository(t)
 				outboxRepo := outbox.NewMockRepository(t)
 
+				changeRepo := domain.NewMockChangeRepository(t)
+
 				scope.EXPECT().Todo().Return(repo)
+				scope.EXPECT().Change().Return(changeRepo)
 				scope.EXPECT().Outbox().Return(outboxRepo)
 
 				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
@@ -72,12 +111,27 @@ func TestUpdaterImpl_Update(t *testing.T) {
 					return t.ID == fixedUUID && t.Title == todo.Title && t.UpdatedAt.Equal(fixedTime)
 				})).Return(nil)
 
+				changeRepo.EXPECT().RecordChange(
+					mock.Anything,
+					domain.Change{
+						TodoID:    fixedUUID,
+						Type:      domain.ChangeType_Updated,
+						CreatedAt: fixedTime,
+					},
+				).Return(domain.Change{
+					Sequence:  8,
+					TodoID:    fixedUUID,
+					Type:      domain.ChangeType_Updated,
+					CreatedAt: fixedTime,
+				}, nil)
+
 				outboxRepo.EXPECT().CreateTodoEvent(
 					mock.Anything,
 					outbox.TodoEvent{
 						Type:      outbox.EventType_TODO_UPDATED,
 						TodoID:    fixedUUID,
 						CreatedAt: fixedTime,
+						Sequence:  8,
 					},
 				).Return(nil)
 			},
@@ -85,8 +139,9 @@ func TestUpdaterImpl_Update(t *testing.T) {
 			expectedErr:  nil,
 		},
 		"success-without-title-change-skips-embedding": {
-			id:     fixedUUID,
-			status: common.Ptr(domain.Status_DONE),
+			id:               fixedUUID,
+			status:           common.Ptr(domain.Status_DONE),
+			estimatedMinutes: common.Ptr(120),
 			setExpectations: func(
 				scope *transaction.MockScope,
 				timeProvider *core.MockCurrentTimeProvider,
@@ -97,24 +152,43 @@ func TestUpdaterImpl_Update(t *testing.T) {
 				repo := domain.NewMockRepository(t)
 				outboxRepo := outbox.NewMockRepository(t)
 
+				changeRepo := domain.NewMockChangeRepository(t)
+
 				scope.EXPECT().Todo().Return(repo)
+				scope.EXPECT().Change().Return(changeRepo)
 				scope.EXPECT().Outbox().Return(outboxRepo)
 
 				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
 				repo.EXPECT().UpdateTodo(mock.Anything, mock.MatchedBy(func(t domain.Todo) bool {
 					return t.ID == fixedUUID &&
 						t.Status == domain.Status_DONE &&
+						t.EstimatedMinutes == 120 &&
 						t.Title == todo.Title &&
 						t.UpdatedAt.Equal(fixedTime) &&
 						assert.ObjectsAreEqual(t.Embedding, todo.Embedding)
 				})).Return(nil)
 
+				changeRepo.EXPECT().RecordChange(
+					mock.Anything,
+					domain.Change{
+						TodoID:    fixedUUID,
+						Type:      domain.ChangeType_Updated,
+						CreatedAt: fixedTime,
+					},
+				).Return(domain.Change{
+					Sequence:  8,
+					TodoID:    fixedUUID,
+					Type:      domain.ChangeType_Updated,
+					CreatedAt: fixedTime,
+				}, nil)
+
 				outboxRepo.EXPECT().CreateTodoEvent(
 					mock.Anything,
 					outbox.TodoEvent{
 						Type:      outbox.EventType_TODO_UPDATED,
 						TodoID:    fixedUUID,
 						CreatedAt: fixedTime,
+						Sequence:  8,
 					},
 				).Return(nil)
 			},
@@ -127,6 +201,222 @@ func TestUpdaterImpl_Update(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"sets-location": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithLocation(downtown)},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				expectOptionUpdate(scope, timeProvider, todo, func(t domain.Todo) bool {
+					return assert.ObjectsAreEqual(&downtown, t.Location)
+				})
+			},
+		},
+		"zero-location-clears-it": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithLocation(domain.Location{})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				expectOptionUpdate(scope, timeProvider, locatedTodo, func(t domain.Todo) bool {
+					return t.Location == nil
+				})
+			},
+		},
+		"invalid-location": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithLocation(domain.Location{Point: &domain.GeoPoint{Latitude: 120}})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+				repo := domain.NewMockRepository(t)
+				scope.EXPECT().Todo().Return(repo)
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
+			},
+			expectedErr: core.NewValidationErr("latitude must be between -90 and 90"),
+		},
+		"sets-recurrence": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithRecurrence(weekly)},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				expectOptionUpdate(scope, timeProvider, todo, func(t domain.Todo) bool {
+					return assert.ObjectsAreEqual(&weekly, t.Recurrence)
+				})
+			},
+		},
+		"sets-monthly-recurrence-on-due-day": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithRecurrence(domain.Recurrence{Frequency: domain.RecurrenceFrequency_MONTHLY, Interval: 1})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				expectOptionUpdate(scope, timeProvider, todo, func(t domain.Todo) bool {
+					return t.Recurrence != nil && t.Recurrence.MonthDay == fixedTime.Day()
+				})
+			},
+		},
+		"moving-due-date-moves-month-day": {
+			id:      fixedUUID,
+			dueDate: common.Ptr(time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)),
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				monthlyTodo := todo
+				monthlyTodo.Recurrence = &domain.Recurrence{Frequency: domain.RecurrenceFrequency_MONTHLY, Interval: 1, MonthDay: 1}
+				expectOptionUpdate(scope, timeProvider, monthlyTodo, func(t domain.Todo) bool {
+					return t.Recurrence != nil && t.Recurrence.MonthDay == 20
+				})
+			},
+		},
+		"zero-recurrence-stops-it": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithRecurrence(domain.Recurrence{})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				expectOptionUpdate(scope, timeProvider, recurringTodo, func(t domain.Todo) bool {
+					return t.Recurrence == nil
+				})
+			},
+		},
+		"invalid-recurrence": {
+			id:   fixedUUID,
+			opts: []UpdateOption{WithRecurrence(domain.Recurrence{Frequency: "YEARLY", Interval: 1})},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+				repo := domain.NewMockRepository(t)
+				scope.EXPECT().Todo().Return(repo)
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
+			},
+			expectedErr: core.NewValidationErr("recurrence frequency must be one of DAILY, WEEKLY or MONTHLY"),
+		},
+		"reopening-archived-todo-restores-it": {
+			id:     fixedUUID,
+			status: common.Ptr(domain.Status_OPEN),
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				repo := domain.NewMockRepository(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				changeRepo := domain.NewMockChangeRepository(t)
+
+				scope.EXPECT().Todo().Return(repo)
+				scope.EXPECT().Change().Return(changeRepo)
+				scope.EXPECT().Outbox().Return(outboxRepo)
+
+				archived := todo
+				archived.Status = domain.Status_DONE
+				archived.ArchivedAt = common.Ptr(fixedTime.AddDate(0, 0, -1))
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(archived, true, nil)
+				repo.EXPECT().UpdateTodo(mock.Anything, mock.MatchedBy(func(t domain.Todo) bool {
+					return t.Status == domain.Status_OPEN && t.ArchivedAt == nil
+				})).Return(nil)
+
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.Anything).Return(domain.Change{Sequence: 9}, nil)
+				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedTodo: todo,
+		},
+		"success-from-chat-records-assistant-comment": {
+			id:       fixedUUID,
+			dueDate:  common.Ptr(fixedTime.AddDate(0, 0, 3)),
+			fromChat: true,
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				repo := domain.NewMockRepository(t)
+				outboxRepo := outbox.NewMockRepository(t)
+				commentRepo := domain.NewMockCommentRepository(t)
+
+				changeRepo := domain.NewMockChangeRepository(t)
+
+				scope.EXPECT().Todo().Return(repo)
+				scope.EXPECT().Change().Return(changeRepo)
+				scope.EXPECT().Outbox().Return(outboxRepo)
+				scope.EXPECT().Comment().Return(commentRepo)
+
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
+				repo.EXPECT().UpdateTodo(mock.Anything, mock.Anything).Return(nil)
+				commentRepo.EXPECT().CreateComment(mock.Anything, mock.MatchedBy(func(c domain.Comment) bool {
+					return c.TodoID == fixedUUID &&
+						c.Author == domain.CommentAuthor_Assistant &&
+						c.Body == "Updated from chat: rescheduled from 2024-01-01 to 2024-01-04." &&
+						c.CreatedAt.Equal(fixedTime)
+				})).Return(nil)
+				changeRepo.EXPECT().RecordChange(mock.Anything, mock.MatchedBy(func(c domain.Change) bool {
+					return c.Type == domain.ChangeType_Updated &&
+						c.ConversationID != nil && *c.ConversationID == chatConversationID
+				})).Return(domain.Change{
+					Sequence:             9,
+					TodoID:               fixedUUID,
+					Type:                 domain.ChangeType_Updated,
+					ConversationID:       &chatConversationID,
+					ConversationSequence: common.Ptr(int64(2)),
+					CreatedAt:            fixedTime,
+				}, nil)
+				outboxRepo.EXPECT().CreateTodoEvent(mock.Anything, outbox.TodoEvent{
+					Type:                 outbox.EventType_TODO_UPDATED,
+					TodoID:               fixedUUID,
+					CreatedAt:            fixedTime,
+					Sequence:             9,
+					ConversationID:       &chatConversationID,
+					ConversationSequence: common.Ptr(int64(2)),
+				}).Return(nil)
+			},
+			expectedTodo: todo,
+		},
+		"from-chat-comment-fails": {
+			id:       fixedUUID,
+			status:   common.Ptr(domain.Status_DONE),
+			fromChat: true,
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				repo := domain.NewMockRepository(t)
+				commentRepo := domain.NewMockCommentRepository(t)
+
+				scope.EXPECT().Todo().Return(repo)
+				scope.EXPECT().Comment().Return(commentRepo)
+
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
+				repo.EXPECT().UpdateTodo(mock.Anything, mock.Anything).Return(nil)
+				commentRepo.EXPECT().CreateComment(mock.Anything, mock.Anything).Return(errors.New("database error"))
+			},
+			expectedErr: errors.New("database error"),
+		},
 		"invalid-update-data": {
 			id:    fixedUUID,
 			title: common.Ptr(""),
@@ -145,6 +435,24 @@ func TestUpdaterImpl_Update(t *testing.T) {
 			expectedTodo: domain.Todo{},
 			expectedErr:  core.NewValidationErr("title cannot be empty"),
 		},
+		"invalid-estimate": {
+			id:               fixedUUID,
+			estimatedMinutes: common.Ptr(-30),
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+				repo := domain.NewMockRepository(t)
+
+				scope.EXPECT().Todo().Return(repo)
+
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  core.NewValidationErr("estimated_minutes must be between 0 and 1440"),
+		},
 		"embedding-fails": {
 			id:    fixedUUID,
 			title: &todo.Title,
@@ -234,6 +542,27 @@ func TestUpdaterImpl_Update(t *testing.T) {
 			expectedTodo: domain.Todo{},
 			expectedErr:  errors.New("database error"),
 		},
+		"unknown-custom-field": {
+			id:           fixedUUID,
+			customFields: domain.CustomFieldValues{"sprint": "S1"},
+			setExpectations: func(
+				scope *transaction.MockScope,
+				timeProvider *core.MockCurrentTimeProvider,
+				semanticEncoder *semantic.MockEncoder,
+			) {
+				timeProvider.EXPECT().Now().Return(fixedTime)
+
+				repo := domain.NewMockRepository(t)
+				repo.EXPECT().GetTodo(mock.Anything, fixedUUID).Return(todo, true, nil)
+				scope.EXPECT().Todo().Return(repo)
+
+				customFieldRepo := domain.NewMockCustomFieldRepository(t)
+				customFieldRepo.EXPECT().ListCustomFields(mock.Anything).Return(nil, nil).Once()
+				scope.EXPECT().CustomField().Return(customFieldRepo).Once()
+			},
+			expectedTodo: domain.Todo{},
+			expectedErr:  core.NewValidationErr("unknown custom field: sprint"),
+		},
 	}
 
 	for name, tt := range tests {
@@ -245,9 +574,14 @@ func TestUpdaterImpl_Update(t *testing.T) {
 				tt.setExpectations(scope, timeProvider, semanticEncoder)
 			}
 
-			uti := NewUpdaterImpl(timeProvider, semanticEncoder, "model-name")
+			uti := NewUpdaterImpl(timeProvider, semanticEncoder, "model-name", "")
+
+			ctx := t.Context()
+			if tt.fromChat {
+				ctx = assistant.WithConversationID(ctx, chatConversationID)
+			}
 
-			got, gotErr := uti.Update(t.Context(), scope, tt.id, tt.title, tt.status, tt.dueDate)
+			got, gotErr := uti.Update(ctx, scope, tt.id, tt.title, tt.status, tt.dueDate, tt.estimatedMinutes, tt.customFields, tt.opts...)
 			assert.Equal(t, tt.expectedErr, gotErr)
 			if tt.expectedErr == nil {
 				assert.Equal(t, tt.id, got.ID)
@@ -255,3 +589,35 @@ func TestUpdaterImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDescribeChatChanges(t *testing.T) {
+	t.Parallel()
+
+	dueDate := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	before := domain.Todo{Title: "Pay rent", Status: domain.Status_OPEN, DueDate: dueDate}
+
+	tests := map[string]struct {
+		after    domain.Todo
+		expected string
+	}{
+		"no-changes": {
+			after:    before,
+			expected: "",
+		},
+		"rescheduled": {
+			after:    domain.Todo{Title: "Pay rent", Status: domain.Status_OPEN, DueDate: dueDate.AddDate(0, 0, 1)},
+			expected: "Updated from chat: rescheduled from 2026-03-02 to 2026-03-03.",
+		},
+		"all-changes": {
+			after:    domain.Todo{Title: "Pay March rent", Status: domain.Status_DONE, DueDate: dueDate.AddDate(0, 0, 1)},
+			expected: `Updated from chat: renamed from "Pay rent" to "Pay March rent"; marked DONE; rescheduled from 2026-03-02 to 2026-03-03.`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, describeChatChanges(before, tt.after))
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/telemetry"
+	"github.com/google/uuid"
+)
+
+// Views defines the interface for managing named todo list views.
+type Views interface {
+	// List lists the built-in views followed by the saved views.
+	List(ctx context.Context) ([]domain.View, error)
+	// Save stores a view, replacing the filter of the saved view with the same name.
+	Save(ctx context.Context, name string, filter domain.ViewFilter) (domain.View, error)
+	// Update renames a saved view and replaces its filter.
+	Update(ctx context.Context, id uuid.UUID, name string, filter domain.ViewFilter) (domain.View, error)
+	// Delete removes a saved view.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ViewsImpl is the implementation of the Views use case.
+type ViewsImpl struct {
+	viewRepo     domain.ViewRepository
+	timeProvider core.CurrentTimeProvider
+}
+
+// NewViewsImpl creates a new instance of ViewsImpl.
+func NewViewsImpl(viewRepo domain.ViewRepository, timeProvider core.CurrentTimeProvider) ViewsImpl {
+	return ViewsImpl{
+		viewRepo:     viewRepo,
+		timeProvider: timeProvider,
+	}
+}
+
+// List lists the built-in views followed by the saved views.
+func (v ViewsImpl) List(ctx context.Context) ([]domain.View, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	saved, err := v.viewRepo.ListViews(spanCtx)
+	if telemetry.IsErrorRecorded(span, err) {
+		return nil, err
+	}
+
+	return append(domain.BuiltinViews(), saved...), nil
+}
+
+// Save stores a view, replacing the filter of the saved view with the same name.
+// Built-in view names are reserved.
+func (v ViewsImpl) Save(ctx context.Context, name string, filter domain.ViewFilter) (domain.View, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	now := v.timeProvider.Now()
+	view := domain.View{
+		ID:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		Filter:    filter,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := v.validate(view); telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+
+	current, found, err := v.viewRepo.GetViewByName(spanCtx, view.Name)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+	if found {
+		view.ID = current.ID
+		view.CreatedAt = current.CreatedAt
+		err = v.viewRepo.UpdateView(spanCtx, view)
+	} else {
+		err = v.viewRepo.CreateView(spanCtx, view)
+	}
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+
+	return view, nil
+}
+
+// Update renames a saved view and replaces its filter. Built-in views cannot be changed.
+func (v ViewsImpl) Update(ctx context.Context, id uuid.UUID, name string, filter domain.ViewFilter) (domain.View, error) {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	view, err := v.getSavedView(spanCtx, id)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+
+	view.Name = strings.TrimSpace(name)
+	view.Filter = filter
+	view.UpdatedAt = v.timeProvider.Now()
+	if err := v.validate(view); telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+
+	other, found, err := v.viewRepo.GetViewByName(spanCtx, view.Name)
+	if telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+	if found && other.ID != view.ID {
+		err := core.NewValidationErr(fmt.Sprintf("a view named %q already exists", view.Name))
+		telemetry.IsErrorRecorded(span, err)
+		return domain.View{}, err
+	}
+
+	if err := v.viewRepo.UpdateView(spanCtx, view); telemetry.IsErrorRecorded(span, err) {
+		return domain.View{}, err
+	}
+
+	return view, nil
+}
+
+// Delete removes a saved view. Built-in views cannot be deleted.
+func (v ViewsImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	spanCtx, span := telemetry.StartSpan(ctx)
+	defer span.End()
+
+	if _, err := v.getSavedView(spanCtx, id); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	if err := v.viewRepo.DeleteView(spanCtx, id); telemetry.IsErrorRecorded(span, err) {
+		return err
+	}
+
+	return nil
+}
+
+// validate checks the view fields, reserved names and that its filter builds a valid todo search.
+func (v ViewsImpl) validate(view domain.View) error {
+	if err := view.Validate(); err != nil {
+		return err
+	}
+	if builtin, ok := domain.FindBuiltinView(view.Name, uuid.Nil); ok {
+		return core.NewValidationErr(fmt.Sprintf("view name %q is reserved", builtin.Name))
+	}
+
+	filter := view.Filter.Resolve(v.timeProvider.Now())
+	return NewSearchBuilder().
+		WithStatus(filter.Status).
+		WithDueDateRange(filter.DueAfter, filter.DueBefore).
+		WithSortBy(filter.SortBy).
+		WithTitleContains(filter.SearchByTitle).
+		WithSimilaritySearch(filter.SearchBySimilarity).
+		Validate()
+}
+
+// getSavedView returns the saved view, rejecting built-in views and unknown IDs.
+func (v ViewsImpl) getSavedView(ctx context.Context, id uuid.UUID) (domain.View, error) {
+	if _, ok := domain.FindBuiltinView("", id); ok {
+		return domain.View{}, core.NewValidationErr("built-in views cannot be changed")
+	}
+
+	view, found, err := v.viewRepo.GetView(ctx, id)
+	if err != nil {
+		return domain.View{}, err
+	}
+	if !found {
+		return domain.View{}, core.NewNotFoundErr(fmt.Sprintf("view with ID %s not found", id))
+	}
+	return view, nil
+}
@@ -0,0 +1,263 @@
+package todo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/core"
+	domain "github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/todo"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestViewsImpl_List(t *testing.T) {
+	t.Parallel()
+
+	saved := []domain.View{{ID: uuid.New(), Name: "Work focus"}}
+
+	tests := map[string]struct {
+		setExpectations func(repo *domain.MockViewRepository)
+		expectedNames   []string
+		expectedErr     error
+	}{
+		"builtin-then-saved": {
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().ListViews(mock.Anything).Return(saved, nil).Once()
+			},
+			expectedNames: []string{"Today", "Upcoming", "Someday", "Work focus"},
+		},
+		"repository-error": {
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().ListViews(mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockViewRepository(t)
+			tt.setExpectations(repo)
+
+			got, err := NewViewsImpl(repo, core.NewMockCurrentTimeProvider(t)).List(t.Context())
+			assert.Equal(t, tt.expectedErr, err)
+			var names []string
+			for _, v := range got {
+				names = append(names, v.Name)
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestViewsImpl_Save(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	existingID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	filter := domain.ViewFilter{Status: common.Ptr(domain.Status_OPEN), SearchByTitle: common.Ptr("work")}
+
+	tests := map[string]struct {
+		name            string
+		filter          domain.ViewFilter
+		setExpectations func(repo *domain.MockViewRepository)
+		validate        func(t *testing.T, got domain.View)
+		expectedErr     error
+	}{
+		"creates-new-view": {
+			name:   " Work focus ",
+			filter: filter,
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetViewByName(mock.Anything, "Work focus").Return(domain.View{}, false, nil).Once()
+				repo.EXPECT().CreateView(mock.Anything, mock.MatchedBy(func(v domain.View) bool {
+					return v.Name == "Work focus" && v.ID != uuid.Nil && v.CreatedAt.Equal(now)
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got domain.View) {
+				assert.Equal(t, "Work focus", got.Name)
+				assert.Equal(t, filter, got.Filter)
+				assert.Equal(t, now, got.UpdatedAt)
+			},
+		},
+		"replaces-view-with-same-name": {
+			name:   "work focus",
+			filter: filter,
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetViewByName(mock.Anything, "work focus").
+					Return(domain.View{ID: existingID, Name: "Work focus", CreatedAt: createdAt}, true, nil).Once()
+				repo.EXPECT().UpdateView(mock.Anything, mock.MatchedBy(func(v domain.View) bool {
+					return v.ID == existingID && v.CreatedAt.Equal(createdAt) && v.UpdatedAt.Equal(now)
+				})).Return(nil).Once()
+			},
+			validate: func(t *testing.T, got domain.View) {
+				assert.Equal(t, existingID, got.ID)
+			},
+		},
+		"reserved-name": {
+			name:            "today",
+			setExpectations: func(repo *domain.MockViewRepository) {},
+			expectedErr:     core.NewValidationErr(`view name "Today" is reserved`),
+		},
+		"invalid-filter": {
+			name:            "Similar",
+			filter:          domain.ViewFilter{SortBy: common.Ptr("similarityAsc")},
+			setExpectations: func(repo *domain.MockViewRepository) {},
+			expectedErr:     core.NewValidationErr("search_by_similarity is required when using similarity sorting"),
+		},
+		"empty-name": {
+			name:            " ",
+			setExpectations: func(repo *domain.MockViewRepository) {},
+			expectedErr:     core.NewValidationErr("name cannot be empty"),
+		},
+		"repository-error": {
+			name: "Work focus",
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetViewByName(mock.Anything, "Work focus").Return(domain.View{}, false, nil).Once()
+				repo.EXPECT().CreateView(mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockViewRepository(t)
+			tt.setExpectations(repo)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now)
+
+			got, err := NewViewsImpl(repo, timeProvider).Save(t.Context(), tt.name, tt.filter)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.validate != nil {
+				tt.validate(t, got)
+			}
+		})
+	}
+}
+
+func TestViewsImpl_Update(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	viewID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	otherID := uuid.MustParse("223e4567-e89b-12d3-a456-426614174001")
+	current := domain.View{ID: viewID, Name: "Work focus"}
+	filter := domain.ViewFilter{DueFromDays: common.Ptr(1), DueToDays: common.Ptr(7)}
+
+	tests := map[string]struct {
+		id              uuid.UUID
+		name            string
+		setExpectations func(repo *domain.MockViewRepository)
+		expected        domain.View
+		expectedErr     error
+	}{
+		"success": {
+			id:   viewID,
+			name: "Work next week",
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetView(mock.Anything, viewID).Return(current, true, nil).Once()
+				repo.EXPECT().GetViewByName(mock.Anything, "Work next week").Return(domain.View{}, false, nil).Once()
+				repo.EXPECT().UpdateView(mock.Anything, domain.View{ID: viewID, Name: "Work next week", Filter: filter, UpdatedAt: now}).Return(nil).Once()
+			},
+			expected: domain.View{ID: viewID, Name: "Work next week", Filter: filter, UpdatedAt: now},
+		},
+		"builtin-view": {
+			id:              domain.BuiltinViews()[0].ID,
+			name:            "Work",
+			setExpectations: func(repo *domain.MockViewRepository) {},
+			expectedErr:     core.NewValidationErr("built-in views cannot be changed"),
+		},
+		"not-found": {
+			id:   viewID,
+			name: "Work",
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetView(mock.Anything, viewID).Return(domain.View{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("view with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"name-taken": {
+			id:   viewID,
+			name: "Errands",
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetView(mock.Anything, viewID).Return(current, true, nil).Once()
+				repo.EXPECT().GetViewByName(mock.Anything, "Errands").Return(domain.View{ID: otherID, Name: "Errands"}, true, nil).Once()
+			},
+			expectedErr: core.NewValidationErr(`a view named "Errands" already exists`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockViewRepository(t)
+			tt.setExpectations(repo)
+			timeProvider := core.NewMockCurrentTimeProvider(t)
+			timeProvider.EXPECT().Now().Return(now).Maybe()
+
+			got, err := NewViewsImpl(repo, timeProvider).Update(t.Context(), tt.id, tt.name, filter)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestViewsImpl_Delete(t *testing.T) {
+	t.Parallel()
+
+	viewID := uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+
+	tests := map[string]struct {
+		id              uuid.UUID
+		setExpectations func(repo *domain.MockViewRepository)
+		expectedErr     error
+	}{
+		"success": {
+			id: viewID,
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetView(mock.Anything, viewID).Return(domain.View{ID: viewID}, true, nil).Once()
+				repo.EXPECT().DeleteView(mock.Anything, viewID).Return(nil).Once()
+			},
+		},
+		"builtin-view": {
+			id:              domain.BuiltinViews()[2].ID,
+			setExpectations: func(repo *domain.MockViewRepository) {},
+			expectedErr:     core.NewValidationErr("built-in views cannot be changed"),
+		},
+		"not-found": {
+			id: viewID,
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetView(mock.Anything, viewID).Return(domain.View{}, false, nil).Once()
+			},
+			expectedErr: core.NewNotFoundErr("view with ID 123e4567-e89b-12d3-a456-426614174000 not found"),
+		},
+		"repository-error": {
+			id: viewID,
+			setExpectations: func(repo *domain.MockViewRepository) {
+				repo.EXPECT().GetView(mock.Anything, viewID).Return(domain.View{ID: viewID}, true, nil).Once()
+				repo.EXPECT().DeleteView(mock.Anything, viewID).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := domain.NewMockViewRepository(t)
+			tt.setExpectations(repo)
+
+			err := NewViewsImpl(repo, core.NewMockCurrentTimeProvider(t)).Delete(t.Context(), tt.id)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/domain/assistant"
+	"github.com/google/uuid"
+)
+
+// EventType is the type of a chat stream event.
+type EventType = assistant.EventType
+
+const (
+	EventTurnStarted                = assistant.EventType_TurnStarted
+	EventMessageDelta               = assistant.EventType_MessageDelta
+	EventReasoning                  = assistant.EventType_Reasoning
+	EventActionApprovalRequired     = assistant.EventType_ActionApprovalRequired
+	EventActionApprovalResolved     = assistant.EventType_ActionApprovalResolved
+	EventActionStarted              = assistant.EventType_ActionStarted
+	EventActionCompleted            = assistant.EventType_ActionCompleted
+	EventTurnCompleted              = assistant.EventType_TurnCompleted
+	EventTurnFailed                 = assistant.EventType_TurnFailed
+	EventContextCompactionStarted   = assistant.EventType_ContextCompactionStarted
+	EventContextCompactionCompleted = assistant.EventType_ContextCompactionCompleted
+	EventContextCompactionFailed    = assistant.EventType_ContextCompactionFailed
+	EventContextTruncated           = assistant.EventType_ContextTruncated
+	EventFocusSessionCompleted      = assistant.EventType_FocusSessionCompleted
+	EventTopicShiftSuggested        = assistant.EventType_TopicShiftSuggested
+	EventConversationSplit          = assistant.EventType_ConversationSplit
+	EventMessageModerated           = assistant.EventType_MessageModerated
+	EventGroundingWarning           = assistant.EventType_GroundingWarning
+)
+
+// Payloads of the chat stream events, in the Data of an Event.
+type (
+	TurnStarted                = assistant.TurnStarted
+	MessageDelta               = assistant.MessageDelta
+	Reasoning                  = assistant.Reasoning
+	ActionApprovalRequired     = assistant.ActionApprovalRequired
+	ActionApprovalResolved     = assistant.ActionApprovalResolved
+	ActionStarted              = assistant.ActionCall
+	ActionCompleted            = assistant.ActionCompleted
+	TurnCompleted              = assistant.TurnCompleted
+	Usage                      = assistant.Usage
+	TurnFailed                 = assistant.TurnFailed
+	ContextCompactionStarted   = assistant.ContextCompactionStarted
+	ContextCompactionCompleted = assistant.ContextCompactionCompleted
+	ContextCompactionFailed    = assistant.ContextCompactionFailed
+	ContextTruncated           = assistant.ContextTruncated
+	FocusSessionCompleted      = assistant.FocusSessionCompleted
+	TopicShiftSuggested        = assistant.TopicShiftSuggested
+	ConversationSplit          = assistant.ConversationSplit
+	MessageModerated           = assistant.MessageModerated
+	GroundingWarning           = assistant.GroundingWarning
+	GroundingIssue             = assistant.GroundingIssue
+)
+
+// eventDecoders decodes the payload of each known event type into its typed value.
+var eventDecoders = map[EventType]func(json.RawMessage) (any, error){
+	EventTurnStarted:                decodeEvent[TurnStarted],
+	EventMessageDelta:               decodeEvent[MessageDelta],
+	EventReasoning:                  decodeEvent[Reasoning],
+	EventActionApprovalRequired:     decodeEvent[ActionApprovalRequired],
+	EventActionApprovalResolved:     decodeEvent[ActionApprovalResolved],
+	EventActionStarted:              decodeEvent[ActionStarted],
+	EventActionCompleted:            decodeEvent[ActionCompleted],
+	EventTurnCompleted:              decodeEvent[TurnCompleted],
+	EventTurnFailed:                 decodeEvent[TurnFailed],
+	EventContextCompactionStarted:   decodeEvent[ContextCompactionStarted],
+	EventContextCompactionCompleted: decodeEvent[ContextCompactionCompleted],
+	EventContextCompactionFailed:    decodeEvent[ContextCompactionFailed],
+	EventContextTruncated:           decodeEvent[ContextTruncated],
+	EventFocusSessionCompleted:      decodeEvent[FocusSessionCompleted],
+	EventTopicShiftSuggested:        decodeEvent[TopicShiftSuggested],
+	EventConversationSplit:          decodeEvent[ConversationSplit],
+	EventMessageModerated:           decodeEvent[MessageModerated],
+	EventGroundingWarning:           decodeEvent[GroundingWarning],
+}
+
+func decodeEvent[T any](raw json.RawMessage) (any, error) {
+	var payload T
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// Event is one decoded chat stream event.
+type Event struct {
+	Type EventType
+	// Data holds the typed payload, e.g. a MessageDelta for EventMessageDelta. It is nil for
+	// event types this SDK does not know yet; their payload is still available in Raw.
+	Data any
+	// Raw is the JSON payload as sent by the server.
+	Raw json.RawMessage
+}
+
+// ErrStreamInterrupted is returned by ChatStream.Err when the connection ended before the turn did.
+// Turns cannot be resumed: list the conversation messages to see what was saved before sending again.
+var ErrStreamInterrupted = errors.New("chat stream interrupted before the turn ended")
+
+// maxEventBytes bounds one Server-Sent Event line, e.g. an action_completed event with its result.
+const maxEventBytes = 4 << 20
+
+// ChatRequest is one user message sent to the assistant.
+type ChatRequest struct {
+	Message string
+	Model   string
+	// ConversationID continues a conversation. Nil starts a new one, announced by the TurnStarted event.
+	ConversationID *uuid.UUID
+	// IncludeActionResults adds the structured result of each action to its ActionCompleted event.
+	IncludeActionResults bool
+	MaxTokens            *int
+	Temperature          *float64
+	TopP                 *float64
+	Seed                 *int64
+}
+
+// ChatStream iterates over the events of one assistant turn:
+//
+//	stream, err := c.Chat(ctx, req)
+//	if err != nil { ... }
+//	defer stream.Close()
+//	for stream.Next() {
+//		event := stream.Event()
+//		...
+//	}
+//	if err := stream.Err(); err != nil { ... }
+type ChatStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	event   Event
+	ended   bool
+	err     error
+}
+
+// Chat sends a message and streams the assistant turn. Opening the stream is retried with
+// backoff according to the RetryPolicy of the client while the server is unreachable,
+// overloaded or shutting down; once events flow the stream is never retried, since that
+// would run the turn again.
+func (c *Client) Chat(ctx context.Context, req ChatRequest) (*ChatStream, error) {
+	body := gen.ChatStreamRequest{
+		Message:        req.Message,
+		Model:          req.Model,
+		ConversationId: req.ConversationID,
+		MaxTokens:      req.MaxTokens,
+		Temperature:    req.Temperature,
+		TopP:           req.TopP,
+		Seed:           req.Seed,
+	}
+	params := &gen.StreamChatParams{}
+	if req.IncludeActionResults {
+		params.IncludeActionResults = &req.IncludeActionResults
+	}
+
+	resp, err := c.openStream(ctx, func() (*http.Request, error) {
+		return gen.NewStreamChatRequest(c.baseURL, params, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newChatStream(resp.Body), nil
+}
+
+// openStream sends the request built by newRequest until it gets a streaming response,
+// waiting between attempts as the retry policy says.
+func (c *Client) openStream(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	attempts := max(c.retry.MaxAttempts, 1)
+	backoff := c.retry.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+		req.Header.Set("Accept", "text/event-stream")
+		c.authorize(req)
+
+		resp, err := c.httpClient.Do(req)
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || attempt >= attempts {
+				return nil, err
+			}
+		case resp.StatusCode == http.StatusOK:
+			return resp, nil
+		default:
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxEventBytes))
+			_ = resp.Body.Close()
+			err = newAPIError(resp, body)
+			if !isRetriableStatus(resp.StatusCode) || attempt >= attempts {
+				return nil, err
+			}
+			wait = err.(*APIError).RetryAfter
+		}
+
+		if wait == 0 {
+			wait = backoff
+		}
+		if c.retry.MaxBackoff > 0 {
+			wait = min(wait, c.retry.MaxBackoff)
+		}
+		if err := c.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+func isRetriableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func newChatStream(body io.ReadCloser) *ChatStream {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventBytes)
+	return &ChatStream{body: body, scanner: scanner}
+}
+
+// Next advances to the next event. It returns false when the turn ended or the stream failed;
+// Err tells them apart.
+func (s *ChatStream) Next() bool {
+	if s.err != nil || s.ended {
+		return false
+	}
+	for {
+		eventType, data, ok := s.readEvent()
+		if !ok {
+			return false
+		}
+		if eventType == "" {
+			continue
+		}
+
+		event := Event{Type: EventType(eventType), Raw: json.RawMessage(data)}
+		if decode, known := eventDecoders[event.Type]; known {
+			payload, err := decode(event.Raw)
+			if err != nil {
+				s.err = fmt.Errorf("decode %s event: %w", eventType, err)
+				return false
+			}
+			event.Data = payload
+		}
+		switch event.Type {
+		case EventTurnCompleted, EventTurnFailed, EventMessageModerated:
+			s.ended = true
+		}
+		s.event = event
+		return true
+	}
+}
+
+// readEvent reads the fields of the next Server-Sent Event. Comments and unknown fields are skipped.
+func (s *ChatStream) readEvent() (string, string, bool) {
+	var eventType string
+	var data []string
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		if line == "" {
+			if eventType != "" || len(data) > 0 {
+				return eventType, strings.Join(data, "\n"), true
+			}
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			eventType = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		s.err = fmt.Errorf("%w: %w", ErrStreamInterrupted, err)
+		return "", "", false
+	}
+	if eventType != "" || len(data) > 0 {
+		return eventType, strings.Join(data, "\n"), true
+	}
+	s.err = ErrStreamInterrupted
+	return "", "", false
+}
+
+// Event returns the event read by the last call to Next.
+func (s *ChatStream) Event() Event {
+	return s.event
+}
+
+// Err returns the error that stopped the stream, or nil when the turn ended.
+func (s *ChatStream) Err() error {
+	return s.err
+}
+
+// Close releases the connection. Closing before the turn ended cancels it on the server.
+func (s *ChatStream) Close() error {
+	return s.body.Close()
+}
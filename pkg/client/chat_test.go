@@ -0,0 +1,246 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSSE(w http.ResponseWriter, events ...string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	for _, event := range events {
+		_, _ = io.WriteString(w, event)
+	}
+}
+
+func TestClient_Chat(t *testing.T) {
+	t.Parallel()
+
+	turnID := uuid.MustParse("8d1b3124-4d8a-4d8f-8b8b-2f1cc4d55aa1")
+	tests := map[string]struct {
+		req           ChatRequest
+		events        []string
+		expectedQuery string
+		expectedBody  string
+		expected      []Event
+		expectErr     error
+	}{
+		"typed-events": {
+			req: ChatRequest{Message: "hi", Model: "m1", ConversationID: &conversationID},
+			events: []string{
+				": keep-alive\n\n",
+				"event: turn_started\ndata: {\"conversation_id\":\"" + conversationID.String() + "\",\"conversation_created\":false,\"turn_id\":\"" + turnID.String() + "\"}\n\n",
+				"event: message_delta\ndata: {\"text\":\"Hello\"}\n\n",
+				"event: turn_completed\ndata: {\"usage\":{\"prompt_tokens\":1,\"completion_tokens\":2,\"total_tokens\":3}}\n\n",
+				"event: message_delta\ndata: {\"text\":\"ignored after the turn ended\"}\n\n",
+			},
+			expectedBody: `{"message":"hi","model":"m1","conversation_id":"` + conversationID.String() + `"}`,
+			expected: []Event{
+				{
+					Type: EventTurnStarted,
+					Data: TurnStarted{ConversationID: conversationID, TurnID: turnID},
+					Raw:  json.RawMessage(`{"conversation_id":"` + conversationID.String() + `","conversation_created":false,"turn_id":"` + turnID.String() + `"}`),
+				},
+				{Type: EventMessageDelta, Data: MessageDelta{Text: "Hello"}, Raw: json.RawMessage(`{"text":"Hello"}`)},
+				{
+					Type: EventTurnCompleted,
+					Data: TurnCompleted{Usage: Usage{PromptTokens: 1, CompletionTokens: 2, TotalTokens: 3}},
+					Raw:  json.RawMessage(`{"usage":{"prompt_tokens":1,"completion_tokens":2,"total_tokens":3}}`),
+				},
+			},
+		},
+		"unknown-event-and-action-results": {
+			req: ChatRequest{Message: "hi", Model: "m1", IncludeActionResults: true},
+			events: []string{
+				"event: something_new\ndata: {\"a\":\ndata: 1}\n\n",
+				"event: action_completed\ndata: {\"id\":\"call-1\",\"name\":\"fetch_todos\",\"success\":true,\"should_refetch\":false,\"result\":{\"status\":\"success\"}}\n\n",
+				"event: message_moderated\ndata: {\"categories\":[\"spam\"]}\n\n",
+			},
+			expectedQuery: "include_action_results=true",
+			expectedBody:  `{"message":"hi","model":"m1","conversation_id":null}`,
+			expected: []Event{
+				{Type: "something_new", Raw: json.RawMessage("{\"a\":\n1}")},
+				{
+					Type: EventActionCompleted,
+					Data: ActionCompleted{ID: "call-1", Name: "fetch_todos", Success: true, Result: json.RawMessage(`{"status":"success"}`)},
+					Raw:  json.RawMessage(`{"id":"call-1","name":"fetch_todos","success":true,"should_refetch":false,"result":{"status":"success"}}`),
+				},
+				{Type: EventMessageModerated, Data: MessageModerated{Categories: []string{"spam"}}, Raw: json.RawMessage(`{"categories":["spam"]}`)},
+			},
+		},
+		"interrupted": {
+			req: ChatRequest{Message: "hi", Model: "m1"},
+			events: []string{
+				"event: message_delta\ndata: {\"text\":\"Hel\"}\n\n",
+			},
+			expectedBody: `{"message":"hi","model":"m1","conversation_id":null}`,
+			expected: []Event{
+				{Type: EventMessageDelta, Data: MessageDelta{Text: "Hel"}, Raw: json.RawMessage(`{"text":"Hel"}`)},
+			},
+			expectErr: ErrStreamInterrupted,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/v1/chat", r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.RawQuery)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				writeSSE(w, tt.events...)
+			})
+
+			stream, err := c.Chat(t.Context(), tt.req)
+			require.NoError(t, err)
+			defer stream.Close() //nolint:errcheck
+
+			var got []Event
+			for stream.Next() {
+				got = append(got, stream.Event())
+			}
+			assert.Equal(t, tt.expected, got)
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, stream.Err(), tt.expectErr)
+				return
+			}
+			assert.NoError(t, stream.Err())
+		})
+	}
+}
+
+func TestClient_Chat_Retry(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		policy        RetryPolicy
+		failures      []int
+		retryAfter    string
+		expectedCalls int32
+		expectedWaits []time.Duration
+		expectErr     string
+	}{
+		"retries-until-success": {
+			policy:        RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+			failures:      []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+			expectedCalls: 3,
+			expectedWaits: []time.Duration{time.Second, 2 * time.Second},
+		},
+		"retry-after-header": {
+			policy:        RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+			failures:      []int{http.StatusTooManyRequests},
+			retryAfter:    "7",
+			expectedCalls: 2,
+			expectedWaits: []time.Duration{7 * time.Second},
+		},
+		"max-backoff": {
+			policy:        RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: 3 * time.Second},
+			failures:      []int{http.StatusServiceUnavailable},
+			retryAfter:    "60",
+			expectedCalls: 2,
+			expectedWaits: []time.Duration{3 * time.Second},
+		},
+		"attempts-exhausted": {
+			policy:        RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Second},
+			failures:      []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			expectedCalls: 2,
+			expectedWaits: []time.Duration{time.Second},
+			expectErr:     "todo app API: status 503: Service Unavailable",
+		},
+		"not-retriable": {
+			policy:        RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second},
+			failures:      []int{http.StatusBadRequest},
+			expectedCalls: 1,
+			expectErr:     "todo app API: status 400: Bad Request",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				call := int(calls.Add(1))
+				if call <= len(tt.failures) {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(tt.failures[call-1])
+					return
+				}
+				writeSSE(w, "event: turn_completed\ndata: {\"usage\":{}}\n\n")
+			})
+			c.retry = tt.policy
+			var waits []time.Duration
+			c.sleep = func(_ context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			stream, err := c.Chat(t.Context(), ChatRequest{Message: "hi", Model: "m1"})
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+			assert.Equal(t, tt.expectedWaits, waits)
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			defer stream.Close() //nolint:errcheck
+			assert.True(t, stream.Next())
+			assert.Equal(t, EventTurnCompleted, stream.Event().Type)
+		})
+	}
+}
+
+func TestClient_Chat_ContextCanceledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c.sleep = func(context.Context, time.Duration) error {
+		return context.Canceled
+	}
+
+	_, err := c.Chat(t.Context(), ChatRequest{Message: "hi", Model: "m1"})
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+// TestEventDecoders_WebappInSync fails when the hand-written event union of the web UI streaming
+// helper and the event types decoded by this SDK diverge.
+func TestEventDecoders_WebappInSync(t *testing.T) {
+	t.Parallel()
+
+	source, err := os.ReadFile("../../webapp/src/services/assistantStream.ts")
+	require.NoError(t, err)
+	union := regexp.MustCompile(`(?s)ASSISTANT_EVENT_TYPES = \[(.*?)\] as const`).FindSubmatch(source)
+	require.NotNil(t, union, "ASSISTANT_EVENT_TYPES not found")
+
+	var webappTypes []string
+	for _, match := range regexp.MustCompile(`'([a-z_]+)'`).FindAllSubmatch(union[1], -1) {
+		webappTypes = append(webappTypes, string(match[1]))
+	}
+	var sdkTypes []string
+	for eventType := range eventDecoders {
+		sdkTypes = append(sdkTypes, string(eventType))
+	}
+	assert.ElementsMatch(t, sdkTypes, webappTypes)
+}
@@ -0,0 +1,175 @@
+// Package client is a Go SDK for the todo app HTTP API.
+//
+// It wraps the generated OpenAPI client with ergonomic methods for todos and conversations,
+// and streams assistant turns through ChatStream, which decodes the Server-Sent Events of a
+// turn into typed events.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+)
+
+// Client calls the todo app HTTP API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+	retry      RetryPolicy
+	sleep      func(ctx context.Context, d time.Duration) error
+	api        *gen.ClientWithResponses
+}
+
+// RetryPolicy controls how opening a chat stream is retried after a network error or a
+// 429, 502, 503 or 504 response. A Retry-After header from the server takes precedence
+// over the computed backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 1 mean 1.
+	MaxAttempts int
+	// InitialBackoff is the wait before the second attempt. It doubles after every attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used when WithRetryPolicy is not set.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, InitialBackoff: 500 * time.Millisecond, MaxBackoff: 5 * time.Second}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for every call. The default is http.DefaultClient.
+// Chat streams stay open for a whole turn, so the client should not set a short Timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken authenticates every call with the bearer token of an API principal or session.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetryPolicy sets how opening a chat stream is retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// New creates a Client for the API served at baseURL, e.g. "https://todo.example.com".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		retry:      DefaultRetryPolicy,
+		sleep:      sleepContext,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	api, err := gen.NewClientWithResponses(
+		c.baseURL,
+		gen.WithHTTPClient(c.httpClient),
+		gen.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			c.authorize(req)
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create API client: %w", err)
+	}
+	c.api = api
+	return c, nil
+}
+
+// authorize sets the bearer token of the client on req.
+func (c *Client) authorize(req *http.Request) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+}
+
+// APIError is returned when the API answers with an error status.
+type APIError struct {
+	StatusCode int
+	// Code is the machine-readable error code, e.g. BAD_REQUEST or NOT_FOUND. It is empty
+	// when the response body is not an API error.
+	Code    string
+	Message string
+	// RetryAfter is the wait the server asked for with a Retry-After header, if any.
+	RetryAfter time.Duration
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("todo app API: status %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("todo app API: status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether err is an APIError for a missing resource.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// newAPIError builds the APIError of a response with its already read body.
+func newAPIError(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return &APIError{Message: "no response"}
+	}
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+	var errResp gen.ErrorResp
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Code != "" {
+		apiErr.Code = string(errResp.Error.Code)
+		apiErr.Message = errResp.Error.Message
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0)
+	}
+	return 0
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resp     *http.Response
+		body     string
+		expected *APIError
+	}{
+		"api-error-body": {
+			resp:     &http.Response{StatusCode: http.StatusNotFound, Header: http.Header{}},
+			body:     `{"error":{"code":"NOT_FOUND","message":"todo not found"}}`,
+			expected: &APIError{StatusCode: http.StatusNotFound, Code: "NOT_FOUND", Message: "todo not found"},
+		},
+		"plain-body": {
+			resp:     &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}},
+			body:     "upstream unavailable\n",
+			expected: &APIError{StatusCode: http.StatusBadGateway, Message: "upstream unavailable"},
+		},
+		"empty-body-with-retry-after": {
+			resp:     &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"3"}}},
+			expected: &APIError{StatusCode: http.StatusTooManyRequests, Message: "Too Many Requests", RetryAfter: 3 * time.Second},
+		},
+		"no-response": {
+			expected: &APIError{Message: "no response"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, newAPIError(tt.resp, []byte(tt.body)))
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    string
+		expected time.Duration
+	}{
+		"empty":      {value: "", expected: 0},
+		"seconds":    {value: "12", expected: 12 * time.Second},
+		"negative":   {value: "-1", expected: 0},
+		"past-date":  {value: "Wed, 21 Oct 2015 07:28:00 GMT", expected: 0},
+		"not-a-time": {value: "soon", expected: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, parseRetryAfter(tt.value))
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"not-found":         {err: &APIError{StatusCode: http.StatusNotFound}, expected: true},
+		"wrapped-not-found": {err: fmt.Errorf("load todo: %w", &APIError{StatusCode: http.StatusNotFound}), expected: true},
+		"other-status":      {err: &APIError{StatusCode: http.StatusBadRequest}},
+		"other-error":       {err: errors.New("boom")},
+		"nil":               {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, IsNotFound(tt.err))
+		})
+	}
+}
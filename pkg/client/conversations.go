@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/google/uuid"
+)
+
+// Conversation is an assistant conversation.
+type Conversation struct {
+	ID              uuid.UUID
+	Title           string
+	TotalTokensUsed int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ConversationPage is one page of listed conversations.
+type ConversationPage struct {
+	Conversations []Conversation
+	Page          int
+	// NextPage is nil on the last page.
+	NextPage *int
+}
+
+// Message is a message of a conversation.
+type Message struct {
+	ID uuid.UUID
+	// Role is user, assistant or system.
+	Role    string
+	Content string
+	// TurnID is the turn the message belongs to, if any.
+	TurnID *uuid.UUID
+	// SupersededAt is set when the message was replaced by a regenerated or edited turn.
+	SupersededAt *time.Time
+	CreatedAt    time.Time
+}
+
+// MessagePage is one page of the messages of a conversation.
+type MessagePage struct {
+	Messages []Message
+	Page     int
+	// NextPage is nil on the last page.
+	NextPage *int
+}
+
+// ListConversations lists one page of conversations. A page size of zero uses DEFAULT_PAGE_SIZE.
+func (c *Client) ListConversations(ctx context.Context, page, pageSize int) (ConversationPage, error) {
+	resp, err := c.api.ListConversationsWithResponse(ctx, &gen.ListConversationsParams{
+		Page:     max(page, 1),
+		PageSize: pageSizeOrDefault(pageSize),
+	})
+	if err != nil {
+		return ConversationPage{}, err
+	}
+	if resp.JSON200 == nil {
+		return ConversationPage{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	result := ConversationPage{
+		Conversations: make([]Conversation, 0, len(resp.JSON200.Conversations)),
+		Page:          resp.JSON200.Page,
+		NextPage:      resp.JSON200.NextPage,
+	}
+	for _, conversation := range resp.JSON200.Conversations {
+		result.Conversations = append(result.Conversations, fromConversation(conversation))
+	}
+	return result, nil
+}
+
+// RenameConversation sets the title of a conversation.
+func (c *Client) RenameConversation(ctx context.Context, id uuid.UUID, title string) (Conversation, error) {
+	resp, err := c.api.UpdateConversationWithResponse(ctx, id, gen.UpdateConversationRequest{Title: &title})
+	if err != nil {
+		return Conversation{}, err
+	}
+	if resp.JSON200 == nil {
+		return Conversation{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return fromConversation(*resp.JSON200), nil
+}
+
+// DeleteConversation deletes a conversation and its messages.
+func (c *Client) DeleteConversation(ctx context.Context, id uuid.UUID) error {
+	resp, err := c.api.DeleteConversationWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+// ListMessages lists one page of the messages of a conversation. A page size of zero uses DEFAULT_PAGE_SIZE.
+func (c *Client) ListMessages(ctx context.Context, conversationID uuid.UUID, page, pageSize int) (MessagePage, error) {
+	resp, err := c.api.ListChatMessagesWithResponse(ctx, &gen.ListChatMessagesParams{
+		ConversationId: conversationID,
+		Page:           max(page, 1),
+		PageSize:       pageSizeOrDefault(pageSize),
+	})
+	if err != nil {
+		return MessagePage{}, err
+	}
+	if resp.JSON200 == nil {
+		return MessagePage{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	result := MessagePage{
+		Messages: make([]Message, 0, len(resp.JSON200.Messages)),
+		Page:     resp.JSON200.Page,
+		NextPage: resp.JSON200.NextPage,
+	}
+	for _, message := range resp.JSON200.Messages {
+		result.Messages = append(result.Messages, Message{
+			ID:           message.Id,
+			Role:         string(message.Role),
+			Content:      message.Content,
+			TurnID:       message.TurnId,
+			SupersededAt: message.SupersededAt,
+			CreatedAt:    message.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// ActionApproval is the decision for an action waiting for approval, announced by an
+// ActionApprovalRequired event.
+type ActionApproval struct {
+	ConversationID uuid.UUID
+	TurnID         uuid.UUID
+	ActionCallID   string
+	ActionName     string
+	Approved       bool
+	Reason         string
+}
+
+// SubmitActionApproval approves or rejects an action. The waiting turn resumes asynchronously
+// and reports the outcome on its chat stream.
+func (c *Client) SubmitActionApproval(ctx context.Context, approval ActionApproval) error {
+	body := gen.SubmitActionApprovalRequest{
+		ConversationId: approval.ConversationID,
+		TurnId:         approval.TurnID,
+		ActionCallId:   approval.ActionCallID,
+		Status:         gen.ActionApprovalStatusREJECTED,
+	}
+	if approval.Approved {
+		body.Status = gen.ActionApprovalStatusAPPROVED
+	}
+	if approval.ActionName != "" {
+		body.ActionName = &approval.ActionName
+	}
+	if approval.Reason != "" {
+		body.Reason = &approval.Reason
+	}
+
+	resp, err := c.api.SubmitActionApprovalWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusAccepted {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func fromConversation(conversation gen.Conversation) Conversation {
+	return Conversation{
+		ID:              conversation.Id,
+		Title:           conversation.Title,
+		TotalTokensUsed: conversation.TotalTokensUsed,
+		CreatedAt:       conversation.CreatedAt,
+		UpdatedAt:       conversation.UpdatedAt,
+	}
+}
+
+func pageSizeOrDefault(pageSize int) int {
+	if pageSize <= 0 {
+		return DEFAULT_PAGE_SIZE
+	}
+	return pageSize
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var conversationID = uuid.MustParse("4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f")
+
+const conversationJSON = `{"id":"4b825f1e-8c3a-4d2b-9f1e-7c9a0b5e6d8f","title":"Groceries","title_source":"user","total_tokens_used":120,"context_compaction_trigger_tokens":4000,"created_at":"2026-03-01T09:00:00Z","updated_at":"2026-03-01T09:00:00Z"}`
+
+var expectConversation = Conversation{
+	ID:              conversationID,
+	Title:           "Groceries",
+	TotalTokensUsed: 120,
+	CreatedAt:       createdAt,
+	UpdatedAt:       createdAt,
+}
+
+func TestClient_ListConversations(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		page          int
+		pageSize      int
+		expectedQuery url.Values
+	}{
+		"defaults": {
+			expectedQuery: url.Values{"page": {"1"}, "pageSize": {"50"}},
+		},
+		"explicit-page": {
+			page:          3,
+			pageSize:      5,
+			expectedQuery: url.Values{"page": {"3"}, "pageSize": {"5"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/conversations", r.URL.Path)
+				assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				writeJSON(w, http.StatusOK, `{"conversations":[`+conversationJSON+`],"page":1,"next_page":null,"previous_page":null}`)
+			})
+
+			got, err := c.ListConversations(t.Context(), tt.page, tt.pageSize)
+			require.NoError(t, err)
+			assert.Equal(t, ConversationPage{Conversations: []Conversation{expectConversation}, Page: 1}, got)
+		})
+	}
+}
+
+func TestClient_RenameConversation(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/v1/conversations/"+conversationID.String(), r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"title":"Groceries"}`, string(body))
+		writeJSON(w, http.StatusOK, conversationJSON)
+	})
+
+	got, err := c.RenameConversation(t.Context(), conversationID, "Groceries")
+	require.NoError(t, err)
+	assert.Equal(t, expectConversation, got)
+}
+
+func TestClient_DeleteConversation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status    int
+		expectErr bool
+	}{
+		"deleted":   {status: http.StatusNoContent},
+		"not-found": {status: http.StatusNotFound, expectErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				w.WriteHeader(tt.status)
+			})
+
+			err := c.DeleteConversation(t.Context(), conversationID)
+			if tt.expectErr {
+				assert.True(t, IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestClient_ListMessages(t *testing.T) {
+	t.Parallel()
+
+	messageID := uuid.MustParse("0f7d6ef6-1f2a-4e0c-9f6d-7d7c7c2e1a11")
+	turnID := uuid.MustParse("8d1b3124-4d8a-4d8f-8b8b-2f1cc4d55aa1")
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/chat/messages", r.URL.Path)
+		assert.Equal(t, url.Values{"conversation_id": {conversationID.String()}, "page": {"2"}, "pageSize": {"10"}}, r.URL.Query())
+		writeJSON(w, http.StatusOK, `{"conversation_id":"`+conversationID.String()+`","messages":[{"id":"`+messageID.String()+`","role":"assistant","content":"Done.","turn_id":"`+turnID.String()+`","action_executed":null,"feedback_score":null,"seed":null,"superseded_at":null,"created_at":"2026-03-01T09:00:00Z"}],"page":2,"next_page":3,"previous_page":1}`)
+	})
+
+	got, err := c.ListMessages(t.Context(), conversationID, 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, MessagePage{
+		Messages: []Message{{
+			ID:        messageID,
+			Role:      "assistant",
+			Content:   "Done.",
+			TurnID:    &turnID,
+			CreatedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+		}},
+		Page:     2,
+		NextPage: common.Ptr(3),
+	}, got)
+}
+
+func TestClient_SubmitActionApproval(t *testing.T) {
+	t.Parallel()
+
+	turnID := uuid.MustParse("8d1b3124-4d8a-4d8f-8b8b-2f1cc4d55aa1")
+	tests := map[string]struct {
+		approval     ActionApproval
+		status       int
+		expectedBody string
+		expectErr    bool
+	}{
+		"approved": {
+			approval:     ActionApproval{ConversationID: conversationID, TurnID: turnID, ActionCallID: "call-1", ActionName: "delete_todos", Approved: true},
+			status:       http.StatusAccepted,
+			expectedBody: `{"conversation_id":"` + conversationID.String() + `","turn_id":"` + turnID.String() + `","action_call_id":"call-1","action_name":"delete_todos","status":"APPROVED","reason":null}`,
+		},
+		"rejected-with-reason": {
+			approval:     ActionApproval{ConversationID: conversationID, TurnID: turnID, ActionCallID: "call-1", Reason: "wrong todos"},
+			status:       http.StatusAccepted,
+			expectedBody: `{"conversation_id":"` + conversationID.String() + `","turn_id":"` + turnID.String() + `","action_call_id":"call-1","status":"REJECTED","reason":"wrong todos"}`,
+		},
+		"invalid": {
+			approval:     ActionApproval{ConversationID: conversationID, TurnID: turnID},
+			status:       http.StatusBadRequest,
+			expectedBody: `{"conversation_id":"` + conversationID.String() + `","turn_id":"` + turnID.String() + `","action_call_id":"","status":"REJECTED","reason":null}`,
+			expectErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/v1/chat/approvals", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				w.WriteHeader(tt.status)
+			})
+
+			err := c.SubmitActionApproval(t.Context(), tt.approval)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
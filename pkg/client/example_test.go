@@ -0,0 +1,82 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/pkg/client"
+)
+
+func Example() {
+	ctx := context.Background()
+	c, err := client.New("http://localhost:8080", client.WithToken("my-api-token"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	todo, err := c.CreateTodo(ctx, "Buy milk", time.Now().AddDate(0, 0, 1))
+	if err != nil {
+		log.Fatal(err)
+	}
+	done := client.TodoStatusDone
+	if _, err := c.UpdateTodo(ctx, todo.ID, client.TodoUpdate{Status: &done}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func ExampleClient_AllTodos() {
+	c, err := client.New("http://localhost:8080", client.WithToken("my-api-token"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for todo, err := range c.AllTodos(context.Background(), client.ListTodosOptions{Status: client.TodoStatusOpen, Sort: client.TodoSortDueDateAsc}) {
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(todo.DueDate.Format(time.DateOnly), todo.Title)
+	}
+}
+
+func ExampleClient_Chat() {
+	ctx := context.Background()
+	c, err := client.New("http://localhost:8080", client.WithToken("my-api-token"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	stream, err := c.Chat(ctx, client.ChatRequest{Message: "What is due this week?", Model: "gpt-oss:20b"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer stream.Close() //nolint:errcheck
+
+	for stream.Next() {
+		switch event := stream.Event().Data.(type) {
+		case client.MessageDelta:
+			fmt.Print(event.Text)
+		case client.ActionApprovalRequired:
+			err := c.SubmitActionApproval(ctx, client.ActionApproval{
+				ConversationID: event.ConversationID,
+				TurnID:         event.TurnID,
+				ActionCallID:   event.ActionCallID,
+				ActionName:     event.Name,
+				Approved:       true,
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+		case client.TurnFailed:
+			fmt.Println("\nturn failed:", event.Code, event.Error)
+		}
+	}
+	if err := stream.Err(); errors.Is(err, client.ErrStreamInterrupted) {
+		// The turn may have been saved partially: reload the conversation before sending again.
+		log.Print(err)
+	} else if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// TodoStatus is the status of a todo.
+type TodoStatus string
+
+const (
+	TodoStatusOpen TodoStatus = "OPEN"
+	TodoStatusDone TodoStatus = "DONE"
+)
+
+// TodoSort orders listed todos.
+type TodoSort string
+
+const (
+	TodoSortCreatedAtAsc   TodoSort = "createdAtAsc"
+	TodoSortCreatedAtDesc  TodoSort = "createdAtDesc"
+	TodoSortDueDateAsc     TodoSort = "dueDateAsc"
+	TodoSortDueDateDesc    TodoSort = "dueDateDesc"
+	TodoSortSimilarityAsc  TodoSort = "similarityAsc"
+	TodoSortSimilarityDesc TodoSort = "similarityDesc"
+	TodoSortSmartOrder     TodoSort = "smartOrder"
+)
+
+// DEFAULT_PAGE_SIZE is used by list calls that do not set a page size.
+const DEFAULT_PAGE_SIZE = 50
+
+// Todo is a todo item.
+type Todo struct {
+	ID     uuid.UUID
+	Title  string
+	Status TodoStatus
+	// DueDate is the due day at midnight UTC.
+	DueDate   time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TodoPage is one page of listed todos.
+type TodoPage struct {
+	Todos []Todo
+	Page  int
+	// NextPage is nil on the last page.
+	NextPage *int
+}
+
+// ListTodosOptions filters and pages ListTodos. Zero values leave a filter unset.
+type ListTodosOptions struct {
+	// Page starts at 1, the default.
+	Page int
+	// PageSize defaults to DEFAULT_PAGE_SIZE and is capped at 500 by the API.
+	PageSize int
+	Status   TodoStatus
+	// Search matches titles, or meanings when BySimilarity is set.
+	Search       string
+	BySimilarity bool
+	// DueAfter and DueBefore bound the due date, inclusive. Only their day is used.
+	DueAfter  time.Time
+	DueBefore time.Time
+	Sort      TodoSort
+}
+
+// TodoUpdate changes the fields of a todo that are set.
+type TodoUpdate struct {
+	Title   *string
+	Status  *TodoStatus
+	DueDate *time.Time
+}
+
+// ListTodos lists one page of todos.
+func (c *Client) ListTodos(ctx context.Context, opts ListTodosOptions) (TodoPage, error) {
+	resp, err := c.api.ListTodosWithResponse(ctx, toListTodosParams(opts))
+	if err != nil {
+		return TodoPage{}, err
+	}
+	if resp.JSON200 == nil {
+		return TodoPage{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	page := TodoPage{Todos: make([]Todo, 0, len(resp.JSON200.Items)), Page: resp.JSON200.Page, NextPage: resp.JSON200.NextPage}
+	for _, item := range resp.JSON200.Items {
+		page.Todos = append(page.Todos, fromTodo(item))
+	}
+	return page, nil
+}
+
+// AllTodos iterates over the todos of every page, starting at opts.Page. Iteration stops
+// after the first error.
+func (c *Client) AllTodos(ctx context.Context, opts ListTodosOptions) iter.Seq2[Todo, error] {
+	return func(yield func(Todo, error) bool) {
+		for {
+			page, err := c.ListTodos(ctx, opts)
+			if err != nil {
+				yield(Todo{}, err)
+				return
+			}
+			for _, todo := range page.Todos {
+				if !yield(todo, nil) {
+					return
+				}
+			}
+			if page.NextPage == nil {
+				return
+			}
+			opts.Page = *page.NextPage
+		}
+	}
+}
+
+// CreateTodo creates an open todo due on the day of dueDate.
+func (c *Client) CreateTodo(ctx context.Context, title string, dueDate time.Time) (Todo, error) {
+	resp, err := c.api.CreateTodoWithResponse(ctx, gen.CreateTodoRequest{
+		Title:   title,
+		DueDate: openapi_types.Date{Time: dueDate},
+	})
+	if err != nil {
+		return Todo{}, err
+	}
+	if resp.JSON201 == nil {
+		return Todo{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return fromTodo(*resp.JSON201), nil
+}
+
+// UpdateTodo changes a todo and returns it updated.
+func (c *Client) UpdateTodo(ctx context.Context, id uuid.UUID, update TodoUpdate) (Todo, error) {
+	body := gen.UpdateTodoRequest{Title: update.Title}
+	if update.Status != nil {
+		status := gen.TodoStatus(*update.Status)
+		body.Status = &status
+	}
+	if update.DueDate != nil {
+		body.DueDate = &openapi_types.Date{Time: *update.DueDate}
+	}
+
+	resp, err := c.api.UpdateTodoWithResponse(ctx, id, body)
+	if err != nil {
+		return Todo{}, err
+	}
+	if resp.JSON200 == nil {
+		return Todo{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return fromTodo(*resp.JSON200), nil
+}
+
+// DeleteTodo deletes a todo.
+func (c *Client) DeleteTodo(ctx context.Context, id uuid.UUID) error {
+	resp, err := c.api.DeleteTodoWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return nil
+}
+
+func toListTodosParams(opts ListTodosOptions) *gen.ListTodosParams {
+	params := &gen.ListTodosParams{Page: max(opts.Page, 1), PageSize: pageSizeOrDefault(opts.PageSize)}
+	if opts.Status != "" {
+		status := gen.TodoStatus(opts.Status)
+		params.Status = &status
+	}
+	if opts.Search != "" {
+		searchType := gen.TITLE
+		if opts.BySimilarity {
+			searchType = gen.SIMILARITY
+		}
+		params.Search = &opts.Search
+		params.SearchType = &searchType
+	}
+	if !opts.DueAfter.IsZero() || !opts.DueBefore.IsZero() {
+		dateRange := gen.DateRange{}
+		if !opts.DueAfter.IsZero() {
+			dateRange.DueAfter = &openapi_types.Date{Time: opts.DueAfter}
+		}
+		if !opts.DueBefore.IsZero() {
+			dateRange.DueBefore = &openapi_types.Date{Time: opts.DueBefore}
+		}
+		params.DateRange = &dateRange
+	}
+	if opts.Sort != "" {
+		sort := gen.ListTodosParamsSort(opts.Sort)
+		params.Sort = &sort
+	}
+	return params
+}
+
+func fromTodo(todo gen.Todo) Todo {
+	return Todo{
+		ID:        todo.Id,
+		Title:     todo.Title,
+		Status:    TodoStatus(todo.Status),
+		DueDate:   todo.DueDate.Time,
+		CreatedAt: todo.CreatedAt,
+		UpdatedAt: todo.UpdatedAt,
+	}
+}
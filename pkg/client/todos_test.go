@@ -0,0 +1,285 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	todoID     = uuid.MustParse("0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1")
+	createdAt  = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	todoJSON   = `{"id":"0b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1","title":"Buy milk","status":"OPEN","due_date":"2026-03-02","created_at":"2026-03-01T09:00:00Z","updated_at":"2026-03-01T09:00:00Z"}`
+	expectTodo = Todo{
+		ID:        todoID,
+		Title:     "Buy milk",
+		Status:    TodoStatusOpen,
+		DueDate:   time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+)
+
+// newTestClient starts a server answering with handler and returns a client calling it with a token.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := New(server.URL, WithToken("secret"), WithHTTPClient(server.Client()))
+	require.NoError(t, err)
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, body)
+}
+
+func TestClient_ListTodos(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts          ListTodosOptions
+		status        int
+		body          string
+		expectedQuery url.Values
+		expected      TodoPage
+		expectErr     string
+	}{
+		"defaults": {
+			status:        http.StatusOK,
+			body:          `{"items":[` + todoJSON + `],"page":1,"next_page":2,"previous_page":null}`,
+			expectedQuery: url.Values{"page": {"1"}, "pageSize": {"50"}},
+			expected:      TodoPage{Todos: []Todo{expectTodo}, Page: 1, NextPage: common.Ptr(2)},
+		},
+		"filters": {
+			opts: ListTodosOptions{
+				Page:         2,
+				PageSize:     10,
+				Status:       TodoStatusOpen,
+				Search:       "groceries",
+				BySimilarity: true,
+				DueAfter:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+				DueBefore:    time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+				Sort:         TodoSortDueDateAsc,
+			},
+			status: http.StatusOK,
+			body:   `{"items":[],"page":2,"next_page":null,"previous_page":1}`,
+			expectedQuery: url.Values{
+				"page":                 {"2"},
+				"pageSize":             {"10"},
+				"status":               {"OPEN"},
+				"search":               {"groceries"},
+				"searchType":           {"SIMILARITY"},
+				"dateRange[dueAfter]":  {"2026-03-01"},
+				"dateRange[dueBefore]": {"2026-03-07"},
+				"sort":                 {"dueDateAsc"},
+			},
+			expected: TodoPage{Todos: []Todo{}, Page: 2},
+		},
+		"api-error": {
+			status:        http.StatusBadRequest,
+			body:          `{"error":{"code":"BAD_REQUEST","message":"invalid page size"}}`,
+			expectedQuery: url.Values{"page": {"1"}, "pageSize": {"50"}},
+			expectErr:     "todo app API: status 400: BAD_REQUEST: invalid page size",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/api/v1/todos", r.URL.Path)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				assert.Equal(t, tt.expectedQuery, r.URL.Query())
+				writeJSON(w, tt.status, tt.body)
+			})
+
+			got, err := c.ListTodos(t.Context(), tt.opts)
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestClient_AllTodos(t *testing.T) {
+	t.Parallel()
+
+	secondID := uuid.MustParse("1b6b0b0c-2a8a-4c1a-8a55-2d5dd3c7e0b1")
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			writeJSON(w, http.StatusOK, `{"items":[`+todoJSON+`],"page":1,"next_page":2,"previous_page":null}`)
+		case "2":
+			writeJSON(w, http.StatusOK, `{"items":[{"id":"`+secondID.String()+`","title":"Pay rent","status":"DONE","due_date":"2026-03-03","created_at":"2026-03-01T09:00:00Z","updated_at":"2026-03-01T09:00:00Z"}],"page":2,"next_page":null,"previous_page":1}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	var ids []uuid.UUID
+	for todo, err := range c.AllTodos(t.Context(), ListTodosOptions{}) {
+		require.NoError(t, err)
+		ids = append(ids, todo.ID)
+	}
+	assert.Equal(t, []uuid.UUID{todoID, secondID}, ids)
+}
+
+func TestClient_CreateTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status    int
+		body      string
+		expected  Todo
+		expectErr string
+	}{
+		"created": {
+			status:   http.StatusCreated,
+			body:     todoJSON,
+			expected: expectTodo,
+		},
+		"invalid": {
+			status:    http.StatusBadRequest,
+			body:      `{"error":{"code":"BAD_REQUEST","message":"title is required"}}`,
+			expectErr: "todo app API: status 400: BAD_REQUEST: title is required",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/v1/todos", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"title":"Buy milk","due_date":"2026-03-02"}`, string(body))
+				writeJSON(w, tt.status, tt.body)
+			})
+
+			got, err := c.CreateTodo(t.Context(), "Buy milk", time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC))
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestClient_UpdateTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		update       TodoUpdate
+		status       int
+		body         string
+		expectedBody string
+		expectErr    bool
+		notFound     bool
+	}{
+		"status-and-due-date": {
+			update:       TodoUpdate{Status: common.Ptr(TodoStatusDone), DueDate: common.Ptr(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC))},
+			status:       http.StatusOK,
+			body:         todoJSON,
+			expectedBody: `{"status":"DONE","due_date":"2026-03-04"}`,
+		},
+		"title": {
+			update:       TodoUpdate{Title: common.Ptr("Buy oat milk")},
+			status:       http.StatusOK,
+			body:         todoJSON,
+			expectedBody: `{"title":"Buy oat milk"}`,
+		},
+		"not-found": {
+			update:       TodoUpdate{Title: common.Ptr("Buy oat milk")},
+			status:       http.StatusNotFound,
+			body:         `{"error":{"code":"NOT_FOUND","message":"todo not found"}}`,
+			expectedBody: `{"title":"Buy oat milk"}`,
+			expectErr:    true,
+			notFound:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "/api/v1/todos/"+todoID.String(), r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				writeJSON(w, tt.status, tt.body)
+			})
+
+			got, err := c.UpdateTodo(t.Context(), todoID, tt.update)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.notFound, IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expectTodo, got)
+		})
+	}
+}
+
+func TestClient_DeleteTodo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status    int
+		body      string
+		expectErr bool
+	}{
+		"deleted": {
+			status: http.StatusNoContent,
+		},
+		"not-found": {
+			status:    http.StatusNotFound,
+			body:      `{"error":{"code":"NOT_FOUND","message":"todo not found"}}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/api/v1/todos/"+todoID.String(), r.URL.Path)
+				if tt.body == "" {
+					w.WriteHeader(tt.status)
+					return
+				}
+				writeJSON(w, tt.status, tt.body)
+			})
+
+			err := c.DeleteTodo(t.Context(), todoID)
+			if tt.expectErr {
+				assert.True(t, IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql"
 	gqlmodels "github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/gen"
+	gqlscalars "github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/graphql/types"
 	rest "github.com/cleitonmarx/symbiont-ai-todoapp/internal/adapters/inbound/http/gen"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/app"
 	"github.com/cleitonmarx/symbiont-ai-todoapp/internal/common"
@@ -64,8 +65,10 @@ func TestMain(m *testing.M) {
 				"LLM_EMBEDDING_MODEL":                        "embeddinggemma:300M-Q8_0",
 				"MCP_GATEWAY_ENDPOINT":                       "http://localhost:8811",
 				"ACTION_APPROVAL_EVENTS_SUBSCRIPTION_PREFIX": "action_approval_dispatcher",
+				"TODO_STREAM_EVENTS_SUBSCRIPTION_PREFIX":     "todo_event_forwarder",
 				"CHAT_COMPACTION_TRIGGER_TOKENS":             fmt.Sprintf("%d", contextCompactionTriggerTokens),
 				"CHAT_COMPACTION_TIMEOUT":                    "8s",
+				"FAULT_INJECTION_ENABLED":                    "true",
 			},
 		},
 		&InitDockerCompose{},
@@ -241,6 +244,38 @@ func TestTodoApp_GraphQLAPI(t *testing.T) {
 		require.NotNil(t, listResp, "expected non-nil response for ListTodos GraphQL query after deletions")
 		require.Equal(t, 0, len(listResp.Items), "expected 0 todos in the list after deletions")
 	})
+
+	t.Run("apply-todo-changes", func(t *testing.T) {
+		dueDate := gqlscalars.Date(time.Now().Add(24 * time.Hour))
+		createResp, err := cli.ApplyTodoChanges(t.Context(), []gqlmodels.TodoOperationInput{
+			{Operation: gqlmodels.TodoOperationKindCreate, Title: common.Ptr("Offline Todo 1"), DueDate: &dueDate},
+			{Operation: gqlmodels.TodoOperationKindCreate, Title: common.Ptr("Offline Todo 2"), DueDate: &dueDate},
+		})
+		require.NoError(t, err, "failed to call ApplyTodoChanges GraphQL mutation")
+		require.True(t, createResp.Applied, "expected create batch to be applied")
+		require.Equal(t, 2, len(createResp.Results), "expected 2 results in the create batch")
+
+		missingID := uuid.New()
+		rollbackResp, err := cli.ApplyTodoChanges(t.Context(), []gqlmodels.TodoOperationInput{
+			{Operation: gqlmodels.TodoOperationKindDelete, ID: createResp.Results[0].ID},
+			{Operation: gqlmodels.TodoOperationKindUpdate, ID: &missingID, Status: common.Ptr(gqlmodels.TodoStatusDone)},
+		})
+		require.NoError(t, err, "failed to call ApplyTodoChanges GraphQL mutation")
+		require.False(t, rollbackResp.Applied, "expected batch with a missing todo to be rolled back")
+		require.Equal(t, gqlmodels.TodoOperationStatusRolledBack, rollbackResp.Results[0].Status)
+		require.Equal(t, gqlmodels.TodoOperationStatusFailed, rollbackResp.Results[1].Status)
+
+		deleteResp, err := cli.ApplyTodoChanges(t.Context(), []gqlmodels.TodoOperationInput{
+			{Operation: gqlmodels.TodoOperationKindDelete, ID: createResp.Results[0].ID},
+			{Operation: gqlmodels.TodoOperationKindDelete, ID: createResp.Results[1].ID},
+		})
+		require.NoError(t, err, "failed to call ApplyTodoChanges GraphQL mutation")
+		require.True(t, deleteResp.Applied, "expected delete batch to be applied")
+
+		listResp, err := cli.ListTodos(t.Context(), nil, 1, 10)
+		require.NoError(t, err, "failed to call ListTodos GraphQL query after batch changes")
+		require.Equal(t, 0, len(listResp.Items), "expected 0 todos in the list after batch changes")
+	})
 }
 
 func TestTodoApp_ChatRestAPI(t *testing.T) {
@@ -524,6 +559,59 @@ func TestToodApp_MCPGatewayIntegration(t *testing.T) {
 	})
 }
 
+func TestTodoApp_FaultInjection(t *testing.T) {
+	t.Run("assistant-failure-is-persisted", func(t *testing.T) {
+		setFault(t, "assistant", `{"error_rate":1,"message":"model host unavailable"}`)
+
+		chatResp, err := restCli.StreamChat(t.Context(), rest.StreamChatJSONRequestBody{
+			Model:   "qwen3:4B-F16",
+			Message: "List my todos.",
+		})
+		require.NoError(t, err, "failed to call StreamChat endpoint")
+		defer chatResp.Body.Close() //nolint:errcheck
+		require.Equal(t, 200, chatResp.StatusCode, "expected 200 OK response for StreamChat")
+
+		dataPayload := readFirstSSEEventData(t, newSSEScanner(chatResp.Body), "turn_failed")
+		var turnFailed assistant.TurnFailed
+		require.NoError(t, json.Unmarshal([]byte(dataPayload), &turnFailed), "failed to unmarshal chat turn failed payload")
+		require.Contains(t, turnFailed.Error, "model host unavailable", "expected turn failure to carry the injected error")
+
+		messagesResp, err := restCli.ListChatMessagesWithResponse(t.Context(), &rest.ListChatMessagesParams{
+			ConversationId: turnFailed.ConversationID,
+			Page:           1,
+			PageSize:       10,
+		})
+		require.NoError(t, err, "failed to call ListChatMessages endpoint")
+		require.NotNil(t, messagesResp.JSON200, "expected non-nil response for ListChatMessages")
+		require.Len(t, messagesResp.JSON200.Messages, 2, "expected the user message and the failure message to be persisted")
+		i := slices.IndexFunc(messagesResp.JSON200.Messages, func(m rest.ChatMessage) bool {
+			return m.Role == rest.ChatMessageRoleAssistant
+		})
+		require.NotEqual(t, -1, i, "expected the failure message to be an assistant message")
+		require.Equal(t, "Sorry, I could not process your request. Please try again.", messagesResp.JSON200.Messages[i].Content)
+	})
+}
+
+// setFault toggles a fault through the fault injection admin API of the monolith.
+func setFault(t *testing.T, target, fault string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, "http://localhost:8080/admin/faults/"+target, strings.NewReader(fault))
+	require.NoError(t, err, "failed to build fault injection request")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "failed to call fault injection admin API")
+	defer resp.Body.Close() //nolint:errcheck
+	require.Equal(t, http.StatusOK, resp.StatusCode, "expected 200 OK response for fault injection admin API")
+	t.Cleanup(func() {
+		req, err := http.NewRequest(http.MethodDelete, "http://localhost:8080/admin/faults/"+target, nil)
+		if err == nil {
+			if resp, err := http.DefaultClient.Do(req); err == nil {
+				resp.Body.Close() //nolint:errcheck
+			}
+		}
+	})
+}
+
 func readChatEventsText(t *testing.T, reader io.Reader) (string, string, int, uuid.UUID) {
 	t.Helper()
 	return readChatEventsTextFromScanner(t, newSSEScanner(reader))
@@ -69,6 +69,7 @@ const TODO_SORT_OPTIONS = new Set([
   'dueDateDesc',
   'similarityAsc',
   'similarityDesc',
+  'smartOrder',
 ]);
 
 interface StreamTurnStartedEventData {
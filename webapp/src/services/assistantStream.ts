@@ -0,0 +1,313 @@
+// Streaming helpers for assistant turns. The event types and payloads mirror the assistant
+// EventType constants and event structs of the server (internal/domain/assistant/events.go),
+// which the generated OpenAPI client cannot describe because they travel as Server-Sent Events.
+import type { ContextCompactionReason, TurnErrorCode } from '../types/openapi';
+
+export const ASSISTANT_EVENT_TYPES = [
+  'turn_started',
+  'message_delta',
+  'reasoning',
+  'action_approval_required',
+  'action_approval_resolved',
+  'action_started',
+  'action_completed',
+  'turn_completed',
+  'turn_failed',
+  'context_compaction_started',
+  'context_compaction_completed',
+  'context_compaction_failed',
+  'context_truncated',
+  'focus_session_completed',
+  'topic_shift_suggested',
+  'conversation_split',
+  'message_moderated',
+  'grounding_warning',
+] as const;
+
+export type AssistantEventType = (typeof ASSISTANT_EVENT_TYPES)[number];
+
+export type AssistantApprovalStatus = 'PENDING' | 'APPROVED' | 'REJECTED' | 'AUTO_REJECTED' | 'EXPIRED';
+
+/** Skill selected for a turn, as serialized in turn_started events. */
+export interface StreamSelectedSkill {
+  Name: string;
+  Source: string;
+  Tools: string[];
+}
+
+export interface TurnStartedEvent {
+  conversation_id: string;
+  conversation_created: boolean;
+  turn_id: string;
+  selected_skills?: StreamSelectedSkill[];
+}
+
+export interface MessageDeltaEvent {
+  text: string;
+}
+
+export interface ReasoningEvent {
+  text: string;
+}
+
+export interface ActionApprovalRequiredEvent {
+  conversation_id: string;
+  turn_id: string;
+  action_call_id: string;
+  name: string;
+  input: string;
+  title: string;
+  description: string;
+  preview_fields?: string[];
+  /** Approval timeout in nanoseconds. */
+  timeout: number;
+}
+
+export interface ActionApprovalResolvedEvent {
+  conversation_id: string;
+  turn_id: string;
+  action_call_id: string;
+  name: string;
+  status: AssistantApprovalStatus;
+  reason?: string;
+}
+
+export interface ActionStartedEvent {
+  id: string;
+  name: string;
+  input: string;
+  text: string;
+}
+
+export interface ActionCompletedEvent {
+  id: string;
+  name: string;
+  success: boolean;
+  error?: string;
+  should_refetch: boolean;
+  approval_status?: AssistantApprovalStatus;
+  action_executed?: boolean;
+  output_preview?: string;
+  output_truncated?: boolean;
+  /** Structured action result, only sent when the turn was requested with include_action_results. */
+  result?: unknown;
+  change_sequence?: number;
+  conversation_change_sequence?: number;
+}
+
+export interface TurnCompletedEvent {
+  usage: {
+    prompt_tokens: number;
+    completion_tokens: number;
+    total_tokens: number;
+  };
+  /** Time the turn spent in each phase, in milliseconds. */
+  latency?: {
+    history_fetch_ms: number;
+    prompt_build_ms: number;
+    time_to_first_token_ms: number;
+    actions?: { action_call_id: string; name: string; duration_ms: number }[];
+    persistence_ms: number;
+    total_ms: number;
+  };
+}
+
+export interface TurnFailedEvent {
+  conversation_id: string;
+  turn_id: string;
+  code: TurnErrorCode;
+  error: string;
+  retriable: boolean;
+  retry_after_seconds?: number;
+}
+
+export interface ContextCompactionStartedEvent {
+  conversation_id: string;
+  unsummarized_message_count: number;
+  unsummarized_total_tokens: number;
+  reason: ContextCompactionReason;
+}
+
+export interface ContextCompactionCompletedEvent extends ContextCompactionStartedEvent {
+  compacted_at: string;
+}
+
+export interface ContextCompactionFailedEvent extends ContextCompactionStartedEvent {
+  error: string;
+}
+
+export interface ContextTruncatedEvent {
+  conversation_id: string;
+  turn_id: string;
+  dropped_message_count: number;
+  retained_message_count: number;
+}
+
+export interface FocusSessionCompletedEvent {
+  session_id: string;
+  todo_id: string;
+  duration_minutes: number;
+  started_at: string;
+  completed_at: string;
+  time_logged: boolean;
+}
+
+export interface TopicShiftSuggestedEvent {
+  conversation_id: string;
+  distance: number;
+  threshold: number;
+}
+
+export interface ConversationSplitEvent {
+  previous_conversation_id: string;
+  conversation_id: string;
+  distance: number;
+  threshold: number;
+  pinned_todos: { id: string; title: string; status: string; due_date: string }[];
+}
+
+export interface MessageModeratedEvent {
+  conversation_id: string;
+  conversation_created: boolean;
+  turn_id: string;
+  message_id: string;
+  categories?: string[];
+  message: string;
+}
+
+export interface GroundingIssue {
+  kind: 'unknown_id' | 'unknown_date' | 'status_mismatch';
+  value: string;
+  stated?: string;
+  expected?: string;
+}
+
+export interface GroundingWarningEvent {
+  conversation_id: string;
+  turn_id: string;
+  issues: GroundingIssue[];
+}
+
+/** Payload of each assistant event type. */
+export interface AssistantEventPayloads {
+  turn_started: TurnStartedEvent;
+  message_delta: MessageDeltaEvent;
+  reasoning: ReasoningEvent;
+  action_approval_required: ActionApprovalRequiredEvent;
+  action_approval_resolved: ActionApprovalResolvedEvent;
+  action_started: ActionStartedEvent;
+  action_completed: ActionCompletedEvent;
+  turn_completed: TurnCompletedEvent;
+  turn_failed: TurnFailedEvent;
+  context_compaction_started: ContextCompactionStartedEvent;
+  context_compaction_completed: ContextCompactionCompletedEvent;
+  context_compaction_failed: ContextCompactionFailedEvent;
+  context_truncated: ContextTruncatedEvent;
+  focus_session_completed: FocusSessionCompletedEvent;
+  topic_shift_suggested: TopicShiftSuggestedEvent;
+  conversation_split: ConversationSplitEvent;
+  message_moderated: MessageModeratedEvent;
+  grounding_warning: GroundingWarningEvent;
+}
+
+/** One decoded assistant event, discriminated by type. */
+export type AssistantEvent = {
+  [T in AssistantEventType]: { type: T; data: AssistantEventPayloads[T] };
+}[AssistantEventType];
+
+/** Event of a type this helper does not know yet, e.g. from a newer server. */
+export interface UnknownAssistantEvent {
+  type: string;
+  data: unknown;
+}
+
+/** Events that end a turn; the stream closes after them. */
+export const TERMINAL_ASSISTANT_EVENT_TYPES: readonly AssistantEventType[] = [
+  'turn_completed',
+  'turn_failed',
+  'message_moderated',
+];
+
+const knownEventTypes = new Set<string>(ASSISTANT_EVENT_TYPES);
+
+export const isAssistantEventType = (type: string): type is AssistantEventType => knownEventTypes.has(type);
+
+/** Decodes one event frame. It does not depend on the transport, so any channel carrying the event type and its JSON payload can use it. */
+export const decodeAssistantEvent = (type: string, data: string): AssistantEvent | UnknownAssistantEvent => {
+  const payload: unknown = JSON.parse(data);
+  if (isAssistantEventType(type)) {
+    return { type, data: payload } as AssistantEvent;
+  }
+  return { type, data: payload };
+};
+
+/** Raised when the connection ends before the turn did. Turns cannot be resumed: reload the conversation messages to see what was saved. */
+export class AssistantStreamInterruptedError extends Error {
+  constructor() {
+    super('Chat stream interrupted before the turn ended');
+    this.name = 'AssistantStreamInterruptedError';
+  }
+}
+
+/** Reads the Server-Sent Events of a turn, e.g. the response of streamChat, regenerateMessage or editMessage. */
+export async function* readAssistantEvents(
+  response: Response,
+): AsyncGenerator<AssistantEvent | UnknownAssistantEvent, void, undefined> {
+  if (!response.body) {
+    throw new Error('No response body');
+  }
+
+  const reader = response.body.getReader();
+  const decoder = new TextDecoder();
+  let buffer = '';
+  try {
+    while (true) {
+      const { done, value } = await reader.read();
+      if (done) {
+        break;
+      }
+
+      buffer += decoder.decode(value, { stream: true });
+      const frames = buffer.split(/\r?\n\r?\n/);
+      buffer = frames.pop() ?? '';
+      for (const frame of frames) {
+        const event = parseFrame(frame);
+        if (!event) {
+          continue;
+        }
+        yield event;
+        if (TERMINAL_ASSISTANT_EVENT_TYPES.includes(event.type as AssistantEventType)) {
+          return;
+        }
+      }
+    }
+
+    const event = parseFrame(buffer + decoder.decode());
+    if (event) {
+      yield event;
+      if (TERMINAL_ASSISTANT_EVENT_TYPES.includes(event.type as AssistantEventType)) {
+        return;
+      }
+    }
+    throw new AssistantStreamInterruptedError();
+  } finally {
+    reader.releaseLock();
+  }
+}
+
+/** Parses one Server-Sent Event frame. Comments and frames without data are skipped. */
+const parseFrame = (frame: string): AssistantEvent | UnknownAssistantEvent | null => {
+  let type = 'message';
+  const data: string[] = [];
+  for (const line of frame.split(/\r?\n/)) {
+    if (line.startsWith('event:')) {
+      type = line.slice('event:'.length).trim();
+    } else if (line.startsWith('data:')) {
+      data.push(line.slice('data:'.length).replace(/^ /, ''));
+    }
+  }
+  if (data.length === 0) {
+    return null;
+  }
+  return decodeAssistantEvent(type, data.join('\n'));
+};
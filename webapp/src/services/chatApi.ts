@@ -1,29 +1,25 @@
 import { apiClient, API_BASE_URL } from './httpClient';
+import { createApiClient } from './openapiClient';
 import type { AvailableSkill, Conversation, ConversationListResp, ModelInfo, ModelListResponse, SkillListResponse } from '../types';
 
+const openapiClient = createApiClient({ baseUrl: API_BASE_URL });
+
 export const streamChat = async (
   message: string,
   model: string,
   conversationId?: string | null,
   signal?: AbortSignal,
-) => {
-  const response = await fetch(`${API_BASE_URL}/api/v1/chat`, {
-    method: 'POST',
-    headers: { 'Content-Type': 'application/json' },
-    body: JSON.stringify({
-      message,
-      model,
-      ...(conversationId ? { conversation_id: conversationId } : {}),
-    }),
-    signal,
-  });
-
-  if (!response.ok) {
-    throw new Error('Failed to stream chat');
-  }
-
-  return response;
-};
+) =>
+  openapiClient.streamChat(
+    {
+      body: {
+        message,
+        model,
+        ...(conversationId ? { conversation_id: conversationId } : {}),
+      },
+    },
+    { signal },
+  );
 
 export const fetchChatMessages = async (conversationId: string, page: number, pageSize: number) => {
   const response = await apiClient.get('/api/v1/chat/messages', {
@@ -0,0 +1,1010 @@
+// Code generated by tsclient-gen from api/openapi/openapi.yml. DO NOT EDIT.
+import type * as schema from '../types/openapi';
+
+/** Parameters of completeOIDCLogin. */
+export interface CompleteOIDCLoginParams {
+  /** Authorization code issued by the provider. */
+  code?: string;
+  /** State of the login, which must match the login cookie. */
+  state?: string;
+  /** Error code returned by the provider when the user did not sign in. */
+  error?: string;
+}
+
+/** Parameters of listWeeklyReviews. */
+export interface ListWeeklyReviewsParams {
+  /** Maximum number of reviews to return. */
+  pageSize: number;
+  /** Page number, starting at 1. */
+  page: number;
+}
+
+/** Parameters of createBoardSnapshot. */
+export interface CreateBoardSnapshotParams {
+  body: schema.CreateBoardSnapshotRequest;
+}
+
+/** Parameters of getBoardSnapshot. */
+export interface GetBoardSnapshotParams {
+  /** Share token of the snapshot. */
+  token: string;
+  /** Response format. markdown returns the Markdown document as text/markdown. */
+  format?: 'json' | 'markdown';
+}
+
+/** Parameters of streamChat. */
+export interface StreamChatParams {
+  /** When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit. */
+  include_action_results?: boolean;
+  body: schema.ChatStreamRequest;
+}
+
+/** Parameters of submitActionApproval. */
+export interface SubmitActionApprovalParams {
+  body: schema.SubmitActionApprovalRequest;
+}
+
+/** Parameters of getContentBlob. */
+export interface GetContentBlobParams {
+  /** Lowercase hex-encoded SHA-256 hash of the content. */
+  hash: string;
+}
+
+/** Parameters of listInstructions. */
+export interface ListInstructionsParams {
+  /** Conversation identifier (UUID). */
+  conversation_id?: string;
+}
+
+/** Parameters of rememberInstruction. */
+export interface RememberInstructionParams {
+  body: schema.RememberInstructionRequest;
+}
+
+/** Parameters of deleteInstruction. */
+export interface DeleteInstructionParams {
+  /** Instruction identifier (UUID). */
+  instruction_id: string;
+}
+
+/** Parameters of deleteMemory. */
+export interface DeleteMemoryParams {
+  /** Memory identifier (UUID). */
+  memory_id: string;
+}
+
+/** Parameters of listChatMessages. */
+export interface ListChatMessagesParams {
+  /** Identifier for the conversation. */
+  conversation_id: string;
+  /** Maximum number of messages to return (server may cap). */
+  pageSize: number;
+  /** Opaque cursor from a prior ListChatMessagesResp to fetch the next page. Omit or set to null to fetch the first page. */
+  page: number;
+}
+
+/** Parameters of submitMessageFeedback. */
+export interface SubmitMessageFeedbackParams {
+  /** Assistant message identifier (UUID). */
+  message_id: string;
+  body: schema.SubmitMessageFeedbackRequest;
+}
+
+/** Parameters of previewChat. */
+export interface PreviewChatParams {
+  /** When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit. */
+  include_action_results?: boolean;
+  body: schema.ChatStreamRequest;
+}
+
+/** Parameters of listConversations. */
+export interface ListConversationsParams {
+  /** Maximum number of messages to return (server may cap). */
+  pageSize: number;
+  /** Opaque cursor from a prior ListChatMessagesResp to fetch the next page. Omit or set to null to fetch the first page. */
+  page: number;
+}
+
+/** Parameters of listRelatedConversations. */
+export interface ListRelatedConversationsParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+}
+
+/** Parameters of updateConversation. */
+export interface UpdateConversationParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  body: schema.UpdateConversationRequest;
+}
+
+/** Parameters of deleteConversation. */
+export interface DeleteConversationParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+}
+
+/** Parameters of editMessage. */
+export interface EditMessageParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  /** User message identifier (UUID). */
+  message_id: string;
+  /** When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit. */
+  include_action_results?: boolean;
+  body: schema.EditMessageRequest;
+}
+
+/** Parameters of regenerateMessage. */
+export interface RegenerateMessageParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  /** Assistant message identifier (UUID). */
+  message_id: string;
+  /** When true, each action_completed event carries the structured result of the action in result: the JSON envelope with status, data, error, pagination and truncated, bounded by the action result size limit. */
+  include_action_results?: boolean;
+  body?: schema.RegenerateMessageRequest;
+}
+
+/** Parameters of markConversationRead. */
+export interface MarkConversationReadParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  body?: schema.MarkConversationReadRequest;
+}
+
+/** Parameters of postSystemMessage. */
+export interface PostSystemMessageParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  body: schema.PostSystemMessageRequest;
+}
+
+/** Parameters of getConversationTurn. */
+export interface GetConversationTurnParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  /** Turn identifier (UUID). */
+  turn_id: string;
+}
+
+/** Parameters of replayConversationTurn. */
+export interface ReplayConversationTurnParams {
+  /** Conversation identifier (UUID). */
+  conversation_id: string;
+  /** Turn identifier (UUID). */
+  turn_id: string;
+}
+
+/** Parameters of defineCustomField. */
+export interface DefineCustomFieldParams {
+  /** Custom field name, a lowercase identifier such as "area" or "story_points". */
+  name: string;
+  body: schema.DefineCustomFieldRequest;
+}
+
+/** Parameters of deleteCustomField. */
+export interface DeleteCustomFieldParams {
+  /** Custom field name. */
+  name: string;
+}
+
+/** Parameters of receiveInboundWebhook. */
+export interface ReceiveInboundWebhookParams {
+  /** Integration name, e.g. `github`. */
+  source: string;
+  /** GitHub event name. */
+  'X-GitHub-Event'?: string;
+  /** Event name for sources other than GitHub. */
+  'X-Webhook-Event'?: string;
+  /** GitHub HMAC-SHA256 signature of the body, `sha256=<hex>`. */
+  'X-Hub-Signature-256'?: string;
+  /** HMAC-SHA256 signature of the body, `sha256=<hex>`. */
+  'X-Webhook-Signature'?: string;
+  /** Shared secret, for sources that cannot sign payloads. */
+  'X-Webhook-Secret'?: string;
+  body: Record<string, unknown>;
+}
+
+/** Parameters of acceptProjectSuggestion. */
+export interface AcceptProjectSuggestionParams {
+  /** Project suggestion identifier (UUID). */
+  suggestion_id: string;
+  body?: schema.AcceptProjectSuggestionRequest;
+}
+
+/** Parameters of startSession. */
+export interface StartSessionParams {
+  body?: schema.StartSessionRequest;
+}
+
+/** Parameters of refreshSession. */
+export interface RefreshSessionParams {
+  body: schema.RefreshSessionRequest;
+}
+
+/** Parameters of revokeSession. */
+export interface RevokeSessionParams {
+  /** Session identifier (UUID). */
+  session_id: string;
+}
+
+/** Parameters of getTimeReport. */
+export interface GetTimeReportParams {
+  /** Inclusive start date (YYYY-MM-DD). */
+  from?: string;
+  /** Exclusive end date (YYYY-MM-DD). */
+  to?: string;
+}
+
+/** Parameters of pullSync. */
+export interface PullSyncParams {
+  /** Opaque cursor returned by a previous sync. */
+  since?: string;
+  /** Maximum number of todo changes and of conversation changes to read. */
+  limit?: number;
+}
+
+/** Parameters of pushSync. */
+export interface PushSyncParams {
+  body: schema.SyncPushRequest;
+}
+
+/** Parameters of saveTemplate. */
+export interface SaveTemplateParams {
+  body: schema.TemplateRequest;
+}
+
+/** Parameters of updateTemplate. */
+export interface UpdateTemplateParams {
+  /** Template identifier (UUID). */
+  template_id: string;
+  body: schema.TemplateRequest;
+}
+
+/** Parameters of deleteTemplate. */
+export interface DeleteTemplateParams {
+  /** Template identifier (UUID). */
+  template_id: string;
+}
+
+/** Parameters of applyTemplate. */
+export interface ApplyTemplateParams {
+  /** Template identifier (UUID). */
+  template_id: string;
+  body?: schema.ApplyTemplateRequest;
+}
+
+/** Parameters of listTodos. */
+export interface ListTodosParams {
+  /** Maximum number of todos to return (server may cap). */
+  pageSize: number;
+  /** Opaque cursor from a prior ListTodosResp to fetch the next page. Omit or set to null to fetch the first page. */
+  page: number;
+  /** Filter todos by status. */
+  status?: schema.TodoStatus;
+  /** Full-text or semantic search query to retrieve todos most relevant to the provided keywords or context using vector similarity. */
+  search?: string;
+  /** The type of search to perform when the 'search' parameter is provided. 'title' performs a case-insensitive substring match on todo titles. 'similarity' uses vector similarity search based on the todo embeddings. */
+  searchType?: 'TITLE' | 'SIMILARITY';
+  dateRange?: schema.DateRange;
+  /** Sorting criteria. smartOrder lists the todos to do first: overdue open todos, then by the rank of the priority custom field, when the tenant defines an ENUM field named priority, and by due date proximity of open todos. */
+  sort?: 'createdAtAsc' | 'createdAtDesc' | 'dueDateAsc' | 'dueDateDesc' | 'similarityAsc' | 'similarityDesc' | 'smartOrder';
+  /** Include the completed todos archived by the retention policy. They are hidden by default. */
+  includeArchived?: boolean;
+  /** Filter todos by custom field value, as name:value (e.g. area:work). The value is parsed according to the field type. Repeat the parameter to require several values. */
+  customField?: string[];
+  /** Latitude of the point to keep the todos located around, in decimal degrees. Requires nearLon. */
+  nearLat?: number;
+  /** Longitude of the point to keep the todos located around, in decimal degrees. Requires nearLat. */
+  nearLon?: number;
+  /** Radius, in kilometers, around nearLat and nearLon within which todos are kept. Todos without coordinates are left out. */
+  nearRadiusKm?: number;
+  /** Query expression of space separated terms that must all match, e.g. `status:open due<2026-05-01 area:work -area:errands`. Supported terms are status:open|done; due:DATE, due<DATE, due<=DATE, due>DATE and due>=DATE with DATE as YYYY-MM-DD, today, tomorrow or today+N; title:TEXT; similar:TEXT; sort:SORT; archived:true; and NAME:VALUE or -NAME:VALUE to keep or drop todos by custom field. Other words search the title. Its terms take precedence over the other filter parameters. */
+  q?: string;
+}
+
+/** Parameters of createTodo. */
+export interface CreateTodoParams {
+  body: schema.CreateTodoRequest;
+}
+
+/** Parameters of listTodoChanges. */
+export interface ListTodoChangesParams {
+  /** Return changes with a sequence greater than this value. */
+  since?: number;
+  /** Only list changes produced by this conversation. */
+  conversation_id?: string;
+  /** Maximum number of changes to return. */
+  limit?: number;
+}
+
+/** Parameters of quickAddTodo. */
+export interface QuickAddTodoParams {
+  body: schema.QuickAddRequest;
+}
+
+/** Parameters of updateTodo. */
+export interface UpdateTodoParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+  body: schema.UpdateTodoRequest;
+}
+
+/** Parameters of deleteTodo. */
+export interface DeleteTodoParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+}
+
+/** Parameters of listTodoComments. */
+export interface ListTodoCommentsParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+  /** Maximum number of comments to return (server may cap). */
+  pageSize: number;
+  /** Page number to fetch, starting at 1. */
+  page: number;
+}
+
+/** Parameters of createTodoComment. */
+export interface CreateTodoCommentParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+  body: schema.CommentRequest;
+}
+
+/** Parameters of updateTodoComment. */
+export interface UpdateTodoCommentParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+  /** Comment identifier (UUID). */
+  comment_id: string;
+  body: schema.CommentRequest;
+}
+
+/** Parameters of deleteTodoComment. */
+export interface DeleteTodoCommentParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+  /** Comment identifier (UUID). */
+  comment_id: string;
+}
+
+/** Parameters of restoreTodo. */
+export interface RestoreTodoParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+}
+
+/** Parameters of startTodoTimer. */
+export interface StartTodoTimerParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+}
+
+/** Parameters of stopTodoTimer. */
+export interface StopTodoTimerParams {
+  /** Todo identifier (UUID). */
+  todo_id: string;
+}
+
+/** Parameters of createToken. */
+export interface CreateTokenParams {
+  body: schema.CreateTokenRequest;
+}
+
+/** Parameters of revokeToken. */
+export interface RevokeTokenParams {
+  /** Token identifier (UUID). */
+  token_id: string;
+}
+
+/** Parameters of saveView. */
+export interface SaveViewParams {
+  body: schema.ViewRequest;
+}
+
+/** Parameters of updateView. */
+export interface UpdateViewParams {
+  /** View identifier (UUID). */
+  view_id: string;
+  body: schema.ViewRequest;
+}
+
+/** Parameters of deleteView. */
+export interface DeleteViewParams {
+  /** View identifier (UUID). */
+  view_id: string;
+}
+
+/** Options of createApiClient. */
+export interface ApiClientOptions {
+  /** Base URL of the API, e.g. "https://todo.example.com". Defaults to the same origin. */
+  baseUrl?: string;
+  /** Returns headers sent with every request, e.g. an Authorization bearer token. */
+  headers?: () => Record<string, string>;
+  /** Fetch implementation, defaults to the global fetch. */
+  fetch?: typeof fetch;
+}
+
+/** Error thrown when the API answers with an error status. */
+export class ApiError extends Error {
+  readonly status: number;
+  /** Machine-readable error code, e.g. BAD_REQUEST, when the body is an API error. */
+  readonly code: string | undefined;
+
+  constructor(status: number, message: string, code?: string) {
+    super(`[${status}] ${message}`);
+    this.name = 'ApiError';
+    this.status = status;
+    this.code = code;
+  }
+}
+
+interface Operation {
+  method: string;
+  path: string;
+  query?: Record<string, unknown>;
+  /** Query parameters serialized as deepObject, e.g. dateRange[dueAfter]=2026-01-01. */
+  deepObject?: string[];
+  headers?: Record<string, string | undefined>;
+  body?: unknown;
+}
+
+const encodeQuery = (query: Record<string, unknown> = {}, deepObject: string[] = []): string => {
+  const search = new URLSearchParams();
+  for (const [name, value] of Object.entries(query)) {
+    if (value === undefined || value === null) {
+      continue;
+    }
+    if (Array.isArray(value)) {
+      value.forEach((item) => search.append(name, String(item)));
+    } else if (deepObject.includes(name) && typeof value === 'object') {
+      for (const [key, item] of Object.entries(value as Record<string, unknown>)) {
+        if (item !== undefined && item !== null) {
+          search.append(`${name}[${key}]`, String(item));
+        }
+      }
+    } else {
+      search.append(name, String(value));
+    }
+  }
+  const encoded = search.toString();
+  return encoded ? `?${encoded}` : '';
+};
+
+const toApiError = async (response: Response): Promise<ApiError> => {
+  const text = await response.text().catch(() => '');
+  try {
+    const body = JSON.parse(text) as { error?: { code?: string; message?: string } };
+    if (body.error?.message) {
+      return new ApiError(response.status, body.error.message, body.error.code);
+    }
+  } catch {
+    // Not an API error body.
+  }
+  return new ApiError(response.status, text.trim() || response.statusText || 'Request failed');
+};
+
+/** Creates a client of the REST API. Every method rejects with an ApiError when the API answers with an error status. */
+export const createApiClient = (options: ApiClientOptions = {}) => {
+  const baseUrl = (options.baseUrl ?? '').replace(/\/+$/, '');
+  const fetchImpl = options.fetch ?? ((input: RequestInfo | URL, init?: RequestInit) => fetch(input, init));
+
+  const send = async (operation: Operation, init?: RequestInit): Promise<Response> => {
+    const headers = new Headers(init?.headers);
+    for (const [name, value] of Object.entries({ ...options.headers?.(), ...operation.headers })) {
+      if (value !== undefined) {
+        headers.set(name, value);
+      }
+    }
+    if (operation.body !== undefined) {
+      headers.set('Content-Type', 'application/json');
+    }
+
+    const response = await fetchImpl(`${baseUrl}${operation.path}${encodeQuery(operation.query, operation.deepObject)}`, {
+      ...init,
+      method: operation.method,
+      headers,
+      body: operation.body === undefined ? undefined : JSON.stringify(operation.body),
+    });
+    if (!response.ok) {
+      throw await toApiError(response);
+    }
+    return response;
+  };
+
+  const json = async <T>(operation: Operation, init?: RequestInit): Promise<T> =>
+    (await (await send(operation, init)).json()) as T;
+
+  const text = async (operation: Operation, init?: RequestInit): Promise<string> =>
+    (await send(operation, init)).text();
+
+  const none = async (operation: Operation, init?: RequestInit): Promise<void> => {
+    await send(operation, init);
+  };
+
+  return {
+    /** Complete an OpenID Connect login. Redirect target of the OpenID Connect provider. Redeems the authorization code, verifies the ID token and starts a session for the user mapped to the provider subject, provisioning the user with OIDC_DEFAULT_ROLE on the first login. */
+    completeOIDCLogin: (params: CompleteOIDCLoginParams, init?: RequestInit) =>
+      json<schema.SessionTokens>({
+        method: 'GET',
+        path: `/api/v1/auth/oidc/callback`,
+        query: { code: params.code, state: params.state, error: params.error },
+      }, init),
+    /** Begin an OpenID Connect login. Redirects the browser to the OpenID Connect provider with the authorization code flow and PKCE. The state, nonce and code verifier of the login are kept in a short-lived HTTP-only cookie until the callback. Answers 404 when OIDC login is not configured. */
+    beginOIDCLogin: (init?: RequestInit) =>
+      none({
+        method: 'GET',
+        path: `/api/v1/auth/oidc/login`,
+      }, init),
+    /** List weekly reviews. Lists the assistant-written weekly reviews, most recent week first. Reviews are generated on the WEEKLY_REVIEW_SCHEDULE cron schedule and each one is stored as a conversation, so the user can ask follow-up questions about it in chat. */
+    listWeeklyReviews: (params: ListWeeklyReviewsParams, init?: RequestInit) =>
+      json<schema.WeeklyReviewListResp>({
+        method: 'GET',
+        path: `/api/v1/board/reviews`,
+        query: { pageSize: params.pageSize, page: params.page },
+      }, init),
+    /** Create a board snapshot. Freezes the todos matching the filter (up to 500) and the latest board summary into an immutable snapshot that can be shared by its token. Relative due date bounds are resolved against the current day. The snapshot also stores its rendering as a Markdown document. */
+    createBoardSnapshot: (params: CreateBoardSnapshotParams, init?: RequestInit) =>
+      json<schema.BoardSnapshot>({
+        method: 'POST',
+        path: `/api/v1/board/snapshots`,
+        body: params.body,
+      }, init),
+    /** Get a board snapshot. Returns the snapshot shared by the token, as JSON or as its Markdown document. */
+    getBoardSnapshot: (params: GetBoardSnapshotParams, init?: RequestInit) =>
+      json<schema.BoardSnapshot>({
+        method: 'GET',
+        path: `/api/v1/board/snapshots/${encodeURIComponent(String(params.token))}`,
+        query: { format: params.format },
+      }, init),
+    /** Get AI-generated board summary. Returns the latest AI-generated summary of the todo board. The summary is generated asynchronously and reflects the most recent known state of the board. */
+    getBoardSummary: (init?: RequestInit) =>
+      json<schema.BoardSummary>({
+        method: 'GET',
+        path: `/api/v1/board/summary`,
+      }, init),
+    /** Stream assistant response for a user message (single global chat). Streams Server-Sent Events (SSE). Events: turn_started, message_delta, reasoning, context_compaction_started, context_compaction_completed, context_compaction_failed, context_truncated, topic_shift_suggested, conversation_split, action_approval_required, action_approval_resolved, action_started, action_completed, grounding_warning, turn_completed, turn_failed, message_moderated. A focus_session_completed event is emitted into the open stream of the conversation that started the focus session. When the user message drifts away from the conversation topic, topic_shift_suggested is emitted; with CHAT_TOPIC_SHIFT_AUTO_SPLIT enabled the turn instead continues in a new conversation announced by conversation_split. Reasoning tokens emitted by the model (e.g. qwen3 <think> blocks) are streamed as reasoning events and never become part of the assistant message. When the turn fails after streaming started, turn_failed is emitted with a machine-readable code (rate_limited, context_too_long, content_filtered, request_too_large, response_too_long, network, shutdown, unknown) and a retry hint, and the stream ends without an error response body. Turns still running when a server shutdown stops waiting for them fail with the retriable shutdown code. turn_completed carries the token usage and a latency breakdown of the turn phases in milliseconds. A context_too_long failure is first retried once with only the system prompt, the compacted summary and the current turn, announced by a context_truncated warning event. When content moderation is enabled and blocks the user message, no turn runs: the message is stored for audit only, never sent to the model, and a single message_moderated event carries the refusal text and the flagged categories. With include_action_results=true, action_completed events also carry the structured action result so clients can render the fetched or changed todos. When CHAT_GROUNDING_CHECK_ENABLED is on and the final answer mentions IDs, dates or todo statuses that the action results of the turn do not support, a grounding_warning event lists the issues before turn_completed; the answer itself is not changed. Resolves with the open streaming response. */
+    streamChat: (params: StreamChatParams, init?: RequestInit) =>
+      send({
+        method: 'POST',
+        path: `/api/v1/chat`,
+        query: { include_action_results: params.include_action_results },
+        body: params.body,
+      }, init),
+    /** Submit action approval decision. Submits a human approval decision for one assistant action call. The decision is published to the ActionApprovals topic and consumed asynchronously. */
+    submitActionApproval: (params: SubmitActionApprovalParams, init?: RequestInit) =>
+      none({
+        method: 'POST',
+        path: `/api/v1/chat/approvals`,
+        body: params.body,
+      }, init),
+    /** Get a stored action result. Returns the whole content of an action result too large to keep inline in the chat history. Chat messages only carry a preview of such results in output, and the hash of the stored content in output_ref. */
+    getContentBlob: (params: GetContentBlobParams, init?: RequestInit) =>
+      json<schema.ContentBlob>({
+        method: 'GET',
+        path: `/api/v1/chat/blobs/${encodeURIComponent(String(params.hash))}`,
+      }, init),
+    /** List pinned instructions. Lists the global pinned instructions followed by the ones pinned to the conversation, oldest first. Omit `conversation_id` to list only the global instructions. */
+    listInstructions: (params: ListInstructionsParams, init?: RequestInit) =>
+      json<schema.ListInstructionsResp>({
+        method: 'GET',
+        path: `/api/v1/chat/instructions`,
+        query: { conversation_id: params.conversation_id },
+      }, init),
+    /** Pin an instruction. Pins an instruction that the assistant follows in every answer of the conversation, or in every conversation when `conversation_id` is omitted. Pinning the same text again in the same scope returns the existing instruction. Each scope holds up to 20 instructions. */
+    rememberInstruction: (params: RememberInstructionParams, init?: RequestInit) =>
+      json<schema.Instruction>({
+        method: 'POST',
+        path: `/api/v1/chat/instructions`,
+        body: params.body,
+      }, init),
+    /** Delete a pinned instruction. Deletes a pinned instruction so the assistant stops following it. */
+    deleteInstruction: (params: DeleteInstructionParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/chat/instructions/${encodeURIComponent(String(params.instruction_id))}`,
+      }, init),
+    /** List long-term memories. Lists the facts and preferences the assistant extracted from earlier conversations, newest first. The most relevant memories are recalled into the context of every chat turn. */
+    listMemories: (init?: RequestInit) =>
+      json<schema.ListMemoriesResp>({
+        method: 'GET',
+        path: `/api/v1/chat/memories`,
+      }, init),
+    /** Delete a long-term memory. Deletes a long-term memory so the assistant stops recalling it. */
+    deleteMemory: (params: DeleteMemoryParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/chat/memories/${encodeURIComponent(String(params.memory_id))}`,
+      }, init),
+    /** Fetch chat history (single global chat). */
+    listChatMessages: (params: ListChatMessagesParams, init?: RequestInit) =>
+      json<schema.ChatHistoryResp>({
+        method: 'GET',
+        path: `/api/v1/chat/messages`,
+        query: { conversation_id: params.conversation_id, pageSize: params.pageSize, page: params.page },
+      }, init),
+    /** Rate an assistant response. Records the user rating of an assistant message, replacing any previous rating. Ratings feed the feedback score of the experiment variant the message was produced under. */
+    submitMessageFeedback: (params: SubmitMessageFeedbackParams, init?: RequestInit) =>
+      none({
+        method: 'PUT',
+        path: `/api/v1/chat/messages/${encodeURIComponent(String(params.message_id))}/feedback`,
+        body: params.body,
+      }, init),
+    /** Preview the assistant turn for a user message without side effects. Runs the same turn as POST /api/v1/chat inside a sandbox transaction that is rolled back when the turn ends, and streams its Server-Sent Events: the tool calls, the todos they would create or change, and the final answer. Nothing is kept: the IDs announced by turn_started, the messages, a new conversation and every todo change are discarded. Actions that change data outside the database, such as sending notifications, are auto-rejected, approvals are not requested, and the turn skips context compaction, topic shift splitting, experiment enrollment and the open streams of the conversation. Useful to debug prompts and to show what an automation would do. The preview is stopped after CHAT_PREVIEW_TIMEOUT, since the sandbox holds the locks of the rows it changes until it ends. Resolves with the open streaming response. */
+    previewChat: (params: PreviewChatParams, init?: RequestInit) =>
+      send({
+        method: 'POST',
+        path: `/api/v1/chat/preview`,
+        query: { include_action_results: params.include_action_results },
+        body: params.body,
+      }, init),
+    /** List available skills. Lists the available chat skills that users can explicitly select with slash commands. */
+    listAvailableSkills: (init?: RequestInit) =>
+      json<schema.SkillListResp>({
+        method: 'GET',
+        path: `/api/v1/chat/skills`,
+      }, init),
+    /** List conversations. Lists all conversations. */
+    listConversations: (params: ListConversationsParams, init?: RequestInit) =>
+      json<schema.ConversationListResp>({
+        method: 'GET',
+        path: `/api/v1/conversations`,
+        query: { pageSize: params.pageSize, page: params.page },
+      }, init),
+    /** Stream conversation unread events. Long-lived Server-Sent Events (SSE) stream that pushes the unread count of a conversation whenever background work, such as the weekly review, adds a message to it. Clients ignore the events of the conversation on screen and mark it read instead. A keep-alive comment is sent periodically while no events are flowing. Resolves with the open streaming response. */
+    streamConversationEvents: (init?: RequestInit) =>
+      send({
+        method: 'GET',
+        path: `/api/v1/conversations/events`,
+      }, init),
+    /** List related conversations. Lists up to 5 other conversations about the same topic, closest first. Conversations are compared by the embedding of their compacted summary, so a conversation is only related to others once it has been compacted; until then the list is empty. */
+    listRelatedConversations: (params: ListRelatedConversationsParams, init?: RequestInit) =>
+      json<schema.RelatedConversationListResp>({
+        method: 'GET',
+        path: `/api/v1/conversations/related/${encodeURIComponent(String(params.conversation_id))}`,
+      }, init),
+    /** Update conversation. Partially updates a conversation, such as the title or its generation settings. Settings replace all previously stored settings; omitted settings fall back to the chat defaults. */
+    updateConversation: (params: UpdateConversationParams, init?: RequestInit) =>
+      json<schema.Conversation>({
+        method: 'PATCH',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}`,
+        body: params.body,
+      }, init),
+    /** Delete a conversation. Deletes a conversation and all its messages. */
+    deleteConversation: (params: DeleteConversationParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}`,
+      }, init),
+    /** Edit and resend a user message. Replaces a previous user message with new content and continues the conversation from that point, streaming the new turn as Server-Sent Events with the same events as streamChat. The edited message and every message after it are marked with superseded_at: they are still listed by getChatMessages but never sent to the model again. A compacted summary that covered them is recomputed from the earlier messages first. The model defaults to the one of the edited message. Resolves with the open streaming response. */
+    editMessage: (params: EditMessageParams, init?: RequestInit) =>
+      send({
+        method: 'POST',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}/messages/${encodeURIComponent(String(params.message_id))}/edit`,
+        query: { include_action_results: params.include_action_results },
+        body: params.body,
+      }, init),
+    /** Regenerate an assistant message. Re-runs the latest turn of the conversation from its user message, optionally with another model or generation options, and streams the new response as Server-Sent Events with the same events as streamChat. Only assistant messages of the latest turn can be regenerated. The model defaults to the one of the regenerated message. The previous assistant and tool messages of the turn are marked with superseded_at: they are still listed by getChatMessages but never sent to the model again. Resolves with the open streaming response. */
+    regenerateMessage: (params: RegenerateMessageParams, init?: RequestInit) =>
+      send({
+        method: 'POST',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}/messages/${encodeURIComponent(String(params.message_id))}/regenerate`,
+        query: { include_action_results: params.include_action_results },
+        body: params.body,
+      }, init),
+    /** Mark a conversation read. Moves the read cursor of the caller on a conversation up to a message, or up to the latest message when no message is given. The cursor never moves back to an older message. */
+    markConversationRead: (params: MarkConversationReadParams, init?: RequestInit) =>
+      none({
+        method: 'POST',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}/read`,
+        body: params.body,
+      }, init),
+    /** Post a system message. Appends a message of a background job, such as a reminder, to an existing conversation so the chat thread stays the single timeline of the assistant activity. The message is stored as an assistant message in a turn of its own and counts as unread for every reader. Requires the admin role when API principals are configured. */
+    postSystemMessage: (params: PostSystemMessageParams, init?: RequestInit) =>
+      json<schema.ChatMessage>({
+        method: 'POST',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}/system-messages`,
+        body: params.body,
+      }, init),
+    /** Get a conversation turn transcript. Returns every stored message of a turn in order: the user message, the assistant action calls, the action results and the assistant responses, including superseded ones. Each step carries its token usage and its timing relative to the start of the turn, so debugging tools and the eval harness can inspect the whole turn. */
+    getConversationTurn: (params: GetConversationTurnParams, init?: RequestInit) =>
+      json<schema.TurnTranscript>({
+        method: 'GET',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}/turns/${encodeURIComponent(String(params.turn_id))}`,
+      }, init),
+    /** Replay a conversation turn. Re-runs a past turn with its recorded model and seed and compares the new first response with the original one. The replay sees the history that preceded the turn and the current conversation settings. Nothing is persisted and the actions the assistant chooses are returned but never executed. */
+    replayConversationTurn: (params: ReplayConversationTurnParams, init?: RequestInit) =>
+      json<schema.TurnReplay>({
+        method: 'POST',
+        path: `/api/v1/conversations/${encodeURIComponent(String(params.conversation_id))}/turns/${encodeURIComponent(String(params.turn_id))}/replay`,
+      }, init),
+    /** List custom fields. Lists the custom fields defined by the tenant, ordered by name. */
+    listCustomFields: (init?: RequestInit) =>
+      json<schema.ListCustomFieldsResp>({
+        method: 'GET',
+        path: `/api/v1/custom-fields`,
+      }, init),
+    /** Define a custom field. Creates the custom field or updates the options of an existing one. The type of an existing field cannot be changed; delete and define it again instead. */
+    defineCustomField: (params: DefineCustomFieldParams, init?: RequestInit) =>
+      json<schema.CustomField>({
+        method: 'PUT',
+        path: `/api/v1/custom-fields/${encodeURIComponent(String(params.name))}`,
+        body: params.body,
+      }, init),
+    /** Delete a custom field. Deletes the custom field and removes its values from every todo. */
+    deleteCustomField: (params: DeleteCustomFieldParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/custom-fields/${encodeURIComponent(String(params.name))}`,
+      }, init),
+    /** Get the GraphQL schema. Returns the schema of the GraphQL API in SDL, with the version of the running build. */
+    getGraphQLSchema: (init?: RequestInit) =>
+      json<schema.GraphQLSchemaResp>({
+        method: 'GET',
+        path: `/api/v1/graphql/schema`,
+      }, init),
+    /** Create a todo from an inbound webhook. Receives a webhook from an external service and creates a todo through the standard todo creation flow. The delivery is authenticated with the shared secret configured for the source, either as an HMAC-SHA256 signature of the body (`X-Hub-Signature-256` or `X-Webhook-Signature`) or as the plain secret in `X-Webhook-Secret`. Integrations may instead send a personal access token with the todos:write scope as a bearer token, which needs no source secret. The payload is mapped with the source's templates: `github` turns opened issues into todos linked back to the issue, and other sources expect `{"title", "due_date", "url"}`. Deliveries that match no template are acknowledged and ignored. */
+    receiveInboundWebhook: (params: ReceiveInboundWebhookParams, init?: RequestInit) =>
+      json<schema.InboundWebhookResp>({
+        method: 'POST',
+        path: `/api/v1/inbound/webhooks/${encodeURIComponent(String(params.source))}`,
+        headers: { 'X-GitHub-Event': params['X-GitHub-Event'], 'X-Webhook-Event': params['X-Webhook-Event'], 'X-Hub-Signature-256': params['X-Hub-Signature-256'], 'X-Webhook-Signature': params['X-Webhook-Signature'], 'X-Webhook-Secret': params['X-Webhook-Secret'] },
+        body: params.body,
+      }, init),
+    /** List available AI models. Lists the AI models available for generating board summaries and chat responses. */
+    listAvailableModels: (init?: RequestInit) =>
+      json<schema.ModelListResp>({
+        method: 'GET',
+        path: `/api/v1/models`,
+      }, init),
+    /** Get the OpenAPI specification. Returns this OpenAPI specification as JSON. The servers list points at the server answering the request, honoring X-Forwarded-Proto and X-Forwarded-Host, and info.x-build-version holds the version of the running build. */
+    getOpenAPISpec: (init?: RequestInit) =>
+      json<Record<string, unknown>>({
+        method: 'GET',
+        path: `/api/v1/openapi.json`,
+      }, init),
+    /** List projects. Lists the accepted projects with their todos, newest first. */
+    listProjects: (init?: RequestInit) =>
+      json<schema.ListProjectsResp>({
+        method: 'GET',
+        path: `/api/v1/projects`,
+      }, init),
+    /** List project suggestions. Lists the groups of similar open todos proposed as projects, largest group first. A periodic job clusters the todos that belong to no project by embedding and names each group; every run replaces the pending suggestions. */
+    listProjectSuggestions: (init?: RequestInit) =>
+      json<schema.ListProjectSuggestionsResp>({
+        method: 'GET',
+        path: `/api/v1/projects/suggestions`,
+      }, init),
+    /** Accept a project suggestion. Creates a project from the suggestion in one transaction, grouping the suggested todos that still exist, and removes the suggestion. The suggested name is kept unless a name is given. */
+    acceptProjectSuggestion: (params: AcceptProjectSuggestionParams, init?: RequestInit) =>
+      json<schema.Project>({
+        method: 'POST',
+        path: `/api/v1/projects/suggestions/${encodeURIComponent(String(params.suggestion_id))}/accept`,
+        body: params.body,
+      }, init),
+    /** List sessions. Lists the active sessions of the calling principal, most recently used first. */
+    listSessions: (init?: RequestInit) =>
+      json<schema.ListSessionsResp>({
+        method: 'GET',
+        path: `/api/v1/sessions`,
+      }, init),
+    /** Start a session. Starts a session for the principal of the API token and issues an access token and a refresh token. Sessions require API principals and can only be started with an API token, not with a session access token. The tokens are only returned once. */
+    startSession: (params: StartSessionParams, init?: RequestInit) =>
+      json<schema.SessionTokens>({
+        method: 'POST',
+        path: `/api/v1/sessions`,
+        body: params.body,
+      }, init),
+    /** Refresh a session. Exchanges a refresh token for a new access token and a new refresh token, extending the session. The previous tokens stop working. This route does not require a bearer token. */
+    refreshSession: (params: RefreshSessionParams, init?: RequestInit) =>
+      json<schema.SessionTokens>({
+        method: 'POST',
+        path: `/api/v1/sessions/refresh`,
+        body: params.body,
+      }, init),
+    /** Revoke a session. Revokes a session of the calling principal and terminates the chat and todo event streams opened with it. Admins may revoke the sessions of any principal. */
+    revokeSession: (params: RevokeSessionParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/sessions/${encodeURIComponent(String(params.session_id))}`,
+      }, init),
+    /** Get logged time report. Aggregates finished work sessions per todo for sessions started in the [from, to) range. Defaults to the last 7 days. */
+    getTimeReport: (params: GetTimeReportParams, init?: RequestInit) =>
+      json<schema.TimeReport>({
+        method: 'GET',
+        path: `/api/v1/stats/time`,
+        query: { from: params.from, to: params.to },
+      }, init),
+    /** Pull changes since a sync cursor. Returns the current state of every todo and conversation changed after the cursor, plus tombstones for the ones deleted, so clients can catch up without refetching everything. Several changes of the same item are collapsed into one entry. Omit `since` for the first sync, then pass the returned `cursor` on the next request and keep pulling while `has_more` is true. */
+    pullSync: (params: PullSyncParams, init?: RequestInit) =>
+      json<schema.SyncResp>({
+        method: 'GET',
+        path: `/api/v1/sync`,
+        query: { since: params.since, limit: params.limit },
+      }, init),
+    /** Push client mutations. Applies todo mutations recorded by an offline client, in order. Each mutation is applied on its own, so a rejected mutation does not discard the others. When `base_updated_at` is set on an update or delete and the todo changed or was deleted on the server since, the mutation is not applied and is reported as CONFLICT with the server version. */
+    pushSync: (params: PushSyncParams, init?: RequestInit) =>
+      json<schema.SyncPushResp>({
+        method: 'POST',
+        path: `/api/v1/sync`,
+        body: params.body,
+      }, init),
+    /** List templates. Lists the saved todo templates ordered by name. */
+    listTemplates: (init?: RequestInit) =>
+      json<schema.ListTemplatesResp>({
+        method: 'GET',
+        path: `/api/v1/templates`,
+      }, init),
+    /** Save a template. Saves a named list of todos. Saving a name that already exists replaces the items of that template. */
+    saveTemplate: (params: SaveTemplateParams, init?: RequestInit) =>
+      json<schema.Template>({
+        method: 'POST',
+        path: `/api/v1/templates`,
+        body: params.body,
+      }, init),
+    /** Update a template. Renames a template and replaces its items. */
+    updateTemplate: (params: UpdateTemplateParams, init?: RequestInit) =>
+      json<schema.Template>({
+        method: 'PATCH',
+        path: `/api/v1/templates/${encodeURIComponent(String(params.template_id))}`,
+        body: params.body,
+      }, init),
+    /** Delete a template. Deletes a template. Todos already created from it are kept. */
+    deleteTemplate: (params: DeleteTemplateParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/templates/${encodeURIComponent(String(params.template_id))}`,
+      }, init),
+    /** Apply a template. Creates one todo per template item in one transaction, each due its offset in days from the anchor date. Either every todo is created or none is. */
+    applyTemplate: (params: ApplyTemplateParams, init?: RequestInit) =>
+      json<schema.ApplyTemplateResp>({
+        method: 'POST',
+        path: `/api/v1/templates/${encodeURIComponent(String(params.template_id))}/apply`,
+        body: params.body,
+      }, init),
+    /** List todos. Deprecated in favor of the cursor-paginated GET /api/v2/todos; responses carry Deprecation, Sunset and successor-version Link headers until it is removed on 2027-04-15. Lists todos with pagination support. Optionally filter by status. */
+    listTodos: (params: ListTodosParams, init?: RequestInit) =>
+      json<schema.ListTodosResp>({
+        method: 'GET',
+        path: `/api/v1/todos`,
+        query: { pageSize: params.pageSize, page: params.page, status: params.status, search: params.search, searchType: params.searchType, dateRange: params.dateRange, sort: params.sort, includeArchived: params.includeArchived, customField: params.customField, nearLat: params.nearLat, nearLon: params.nearLon, nearRadiusKm: params.nearRadiusKm, q: params.q },
+        deepObject: ['dateRange'],
+      }, init),
+    /** Create a todo. Creates a new todo in OPEN state. */
+    createTodo: (params: CreateTodoParams, init?: RequestInit) =>
+      json<schema.Todo>({
+        method: 'POST',
+        path: `/api/v1/todos`,
+        body: params.body,
+      }, init),
+    /** List todo changes since a sequence number. Lists todo changes recorded after the given sequence number, oldest first. Clients use it to reconcile optimistic updates and to catch up on todo events missed while disconnected. When conversation_id is set, only changes produced by that conversation's assistant actions are listed and `since` refers to the conversation sequence. */
+    listTodoChanges: (params: ListTodoChangesParams, init?: RequestInit) =>
+      json<schema.TodoChangesResp>({
+        method: 'GET',
+        path: `/api/v1/todos/changes`,
+        query: { since: params.since, conversation_id: params.conversation_id, limit: params.limit },
+      }, init),
+    /** Stream todo change events. Long-lived Server-Sent Events (SSE) stream that pushes todo change events (TODO_CREATED, TODO_UPDATED, TODO_DELETED) as they are published, including changes applied by the assistant in other chat sessions. A keep-alive comment is sent periodically while no events are flowing. Resolves with the open streaming response. */
+    streamTodoEvents: (init?: RequestInit) =>
+      send({
+        method: 'GET',
+        path: `/api/v1/todos/events`,
+      }, init),
+    /** Parse a quick add line into a todo. Parses one line of free text, such as "dentist next tue 3pm #health !high", into a todo title and due date. #tags and the !priority set the matching custom fields of the tenant. Date phrases are read by a deterministic parser; when it finds none and QUICK_ADD_LLM_MODEL is set, the model resolves the date. Without commit the parsed todo is only previewed; with commit it is created. */
+    quickAddTodo: (params: QuickAddTodoParams, init?: RequestInit) =>
+      json<schema.QuickAddResp>({
+        method: 'POST',
+        path: `/api/v1/todos/quick-add`,
+        body: params.body,
+      }, init),
+    /** Update a todo. Partially updates a todo. Supports renaming and/or completing a todo. */
+    updateTodo: (params: UpdateTodoParams, init?: RequestInit) =>
+      json<schema.Todo>({
+        method: 'PATCH',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}`,
+        body: params.body,
+      }, init),
+    /** Delete a todo. Deletes a todo by its ID. */
+    deleteTodo: (params: DeleteTodoParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}`,
+      }, init),
+    /** List todo comments. Lists the comments of a todo, newest first. */
+    listTodoComments: (params: ListTodoCommentsParams, init?: RequestInit) =>
+      json<schema.ListCommentsResp>({
+        method: 'GET',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/comments`,
+        query: { pageSize: params.pageSize, page: params.page },
+      }, init),
+    /** Add a comment to a todo. Adds a user-authored comment to the todo. */
+    createTodoComment: (params: CreateTodoCommentParams, init?: RequestInit) =>
+      json<schema.Comment>({
+        method: 'POST',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/comments`,
+        body: params.body,
+      }, init),
+    /** Edit a comment. Replaces the body of a user-authored comment. Assistant comments are read-only. */
+    updateTodoComment: (params: UpdateTodoCommentParams, init?: RequestInit) =>
+      json<schema.Comment>({
+        method: 'PATCH',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/comments/${encodeURIComponent(String(params.comment_id))}`,
+        body: params.body,
+      }, init),
+    /** Delete a comment. Deletes a comment from the todo. */
+    deleteTodoComment: (params: DeleteTodoCommentParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/comments/${encodeURIComponent(String(params.comment_id))}`,
+      }, init),
+    /** Restore an archived todo. Brings a completed todo archived by the retention policy back to the default listings. The todo gets a fresh retention window before it can be archived again. */
+    restoreTodo: (params: RestoreTodoParams, init?: RequestInit) =>
+      json<schema.Todo>({
+        method: 'POST',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/restore`,
+      }, init),
+    /** Start a todo timer. Starts a work session timer for the todo. Only one timer can run per todo. */
+    startTodoTimer: (params: StartTodoTimerParams, init?: RequestInit) =>
+      json<schema.TimeEntry>({
+        method: 'POST',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/timer/start`,
+      }, init),
+    /** Stop a todo timer. Stops the running work session timer of the todo and records the session. */
+    stopTodoTimer: (params: StopTodoTimerParams, init?: RequestInit) =>
+      json<schema.TimeEntry>({
+        method: 'POST',
+        path: `/api/v1/todos/${encodeURIComponent(String(params.todo_id))}/timer/stop`,
+      }, init),
+    /** List personal access tokens. Lists the active personal access tokens of the calling principal, newest first. Personal access tokens cannot manage tokens. */
+    listTokens: (init?: RequestInit) =>
+      json<schema.ListTokensResp>({
+        method: 'GET',
+        path: `/api/v1/tokens`,
+      }, init),
+    /** Create a personal access token. Creates a personal access token for the calling principal, limited to the given scopes on top of the role of the principal. The token works until it is revoked and is stored only as a hash, so it is only returned once. Tokens require API principals or user logins and cannot be created with another personal access token. */
+    createToken: (params: CreateTokenParams, init?: RequestInit) =>
+      json<schema.CreatedToken>({
+        method: 'POST',
+        path: `/api/v1/tokens`,
+        body: params.body,
+      }, init),
+    /** Revoke a personal access token. Revokes a personal access token of the calling principal so it stops authenticating requests. Admins may revoke the tokens of any principal. */
+    revokeToken: (params: RevokeTokenParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/tokens/${encodeURIComponent(String(params.token_id))}`,
+      }, init),
+    /** List views. Lists the built-in views (Today, Upcoming, Someday) followed by the saved views ordered by name. */
+    listViews: (init?: RequestInit) =>
+      json<schema.ListViewsResp>({
+        method: 'GET',
+        path: `/api/v1/views`,
+      }, init),
+    /** Save a view. Saves a named filter. Saving a name that already exists replaces the filter of that view. Built-in view names are reserved. */
+    saveView: (params: SaveViewParams, init?: RequestInit) =>
+      json<schema.View>({
+        method: 'POST',
+        path: `/api/v1/views`,
+        body: params.body,
+      }, init),
+    /** Update a view. Renames a saved view and replaces its filter. Built-in views cannot be changed. */
+    updateView: (params: UpdateViewParams, init?: RequestInit) =>
+      json<schema.View>({
+        method: 'PATCH',
+        path: `/api/v1/views/${encodeURIComponent(String(params.view_id))}`,
+        body: params.body,
+      }, init),
+    /** Delete a view. Deletes a saved view. Built-in views cannot be deleted. */
+    deleteView: (params: DeleteViewParams, init?: RequestInit) =>
+      none({
+        method: 'DELETE',
+        path: `/api/v1/views/${encodeURIComponent(String(params.view_id))}`,
+      }, init),
+  };
+};
+
+/** Client of the REST API returned by createApiClient. */
+export type ApiClient = ReturnType<typeof createApiClient>;
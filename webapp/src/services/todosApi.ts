@@ -7,7 +7,8 @@ export type TodoSort =
   | 'dueDateAsc'
   | 'dueDateDesc'
   | 'similarityAsc'
-  | 'similarityDesc';
+  | 'similarityDesc'
+  | 'smartOrder';
 
 export type TodoSearchType = 'TITLE' | 'SIMILARITY';
 
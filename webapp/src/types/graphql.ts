@@ -83,7 +83,8 @@ export type TodoSortBy =
   | 'dueDateAsc'
   | 'dueDateDesc'
   | 'similarityAsc'
-  | 'similarityDesc';
+  | 'similarityDesc'
+  | 'smartOrder';
 
 export type TodoStatus =
   | 'DONE'
@@ -8,7 +8,8 @@ export type TodoSortOption =
   | 'dueDateAsc'
   | 'dueDateDesc'
   | 'similarityAsc'
-  | 'similarityDesc';
+  | 'similarityDesc'
+  | 'smartOrder';
 
 export interface Todo {
   id: string;
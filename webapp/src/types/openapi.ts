@@ -0,0 +1,1117 @@
+// Code generated by tsclient-gen from api/openapi/openapi.yml. DO NOT EDIT.
+
+/** Request payload for accepting a project suggestion. */
+export interface AcceptProjectSuggestionRequest {
+  /** Project name replacing the suggested one. */
+  name?: string;
+}
+
+/** Human approval decision status for a requested action execution. */
+export type ActionApprovalStatus = 'APPROVED' | 'REJECTED';
+
+/** Request payload for applying a template. */
+export interface ApplyTemplateRequest {
+  /** Date the item offsets are counted from. Defaults to today. */
+  anchor_date?: string;
+}
+
+/** The todos created from a template. */
+export interface ApplyTemplateResp {
+  /** Created todos, in template order. */
+  items: Todo[];
+}
+
+/** Skill metadata displayed for slash-command selection. */
+export interface AvailableSkill {
+  /** Hidden slash aliases that map to this canonical skill. */
+  aliases: string[];
+  /** User-facing guidance shown in the skills dropdown. */
+  description: string;
+  /** User-facing label shown in skill selectors. */
+  display_name: string;
+  /** Unique skill name used in slash commands. */
+  name: string;
+  /** Action tools commonly used by this skill. */
+  tools: string[];
+}
+
+/** Immutable copy of a filtered todo list and the board summary current when it was taken. */
+export interface BoardSnapshot {
+  /** Timestamp when the snapshot was taken. */
+  created_at: string;
+  /** Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today. */
+  filter: ViewFilter;
+  /** The snapshot rendered as a Markdown document. */
+  markdown: string;
+  summary?: BoardSummary;
+  /** Snapshot title. */
+  title: string;
+  /** Todos matching the filter when the snapshot was taken. */
+  todos: Todo[];
+  /** Share token of the snapshot. */
+  token: string;
+}
+
+export interface BoardSummary {
+  /** Count of todos per status. */
+  counts: TodoStatusCounts;
+  /** Timestamp when this summary was generated. */
+  generated_at: string;
+  /** Titles of todos approaching their due date. */
+  near_deadline: string[];
+  /** Prioritized list of up to three todos recommended for completion next. */
+  next_up: NextUpTodoItem[];
+  /** Titles of overdue todos. */
+  overdue: string[];
+  /** Short, user-facing summary of the board state. */
+  summary: string;
+}
+
+export interface ChatHistoryResp {
+  conversation_id: string;
+  messages: ChatMessage[];
+  /** Opaque cursor to fetch the next page of results. Null if there are no more pages. */
+  next_page?: number | null;
+  /** Opaque cursor for the current page of results. */
+  page: number;
+  /** Opaque cursor to fetch the previous page of results. Null if there is no previous page. */
+  previous_page?: number | null;
+}
+
+export interface ChatMessage {
+  action_details?: ChatMessageActionDetail[];
+  action_executed?: boolean | null;
+  content: string;
+  created_at: string;
+  /** User rating of an assistant response, -1 or 1. */
+  feedback_score?: number | null;
+  id: string;
+  /** True when content moderation blocked the user message; it never reached the assistant. */
+  moderated?: boolean;
+  role: 'user' | 'assistant' | 'system';
+  /** Sampling seed the assistant message was generated with. */
+  seed?: number | null;
+  selected_skills?: SelectedSkill[];
+  /** Set when a regenerated response of the turn, or an edit of an earlier user message, replaced this message. Superseded messages are kept so every version of a turn stays retrievable. */
+  superseded_at?: string | null;
+  turn_id?: string;
+}
+
+export interface ChatMessageActionDetail {
+  action_call_id: string;
+  action_executed?: boolean | null;
+  approval_decided_at?: string | null;
+  approval_decision_reason?: string | null;
+  approval_status?: 'PENDING' | 'APPROVED' | 'REJECTED' | 'AUTO_REJECTED' | 'EXPIRED' | null;
+  error_message?: string | null;
+  input: string;
+  message_state: 'COMPLETED' | 'FAILED';
+  name: string;
+  output: string;
+  /** Hash of the stored whole output when output only holds a preview of it. Fetch it with GET /api/v1/chat/blobs/{hash}. */
+  output_ref?: string | null;
+  text: string;
+}
+
+export interface ChatStreamRequest {
+  /** Identifier for the conversation. For this API, it should always be "global". */
+  conversation_id?: string | null;
+  /** Penalizes tokens proportionally to how often they already appeared. */
+  frequency_penalty?: number;
+  /** Upper bound on the tokens generated for the assistant response. Must not exceed the model's max_output_tokens. */
+  max_tokens?: number;
+  /** User message to send to the AI assistant. */
+  message: string;
+  /** AI model to use for generating the assistant response. */
+  model: string;
+  /** Penalizes tokens that already appeared, encouraging new topics. */
+  presence_penalty?: number;
+  /** Seed for best-effort deterministic sampling on providers that support it. */
+  seed?: number;
+  /** Sequences where the model stops generating further tokens. */
+  stop?: string[];
+  /** Sampling temperature for this turn only. Overrides the conversation settings and chat default. */
+  temperature?: number;
+  /** Nucleus sampling probability mass for this turn only. Overrides the conversation settings and chat default. */
+  top_p?: number;
+}
+
+/** A note attached to a todo. */
+export interface Comment {
+  /** Who wrote the comment. USER comments are written by the user. ASSISTANT comments are recorded when the assistant changes a todo from chat. */
+  author: CommentAuthor;
+  /** Comment text. */
+  body: string;
+  /** Timestamp when the comment was created. */
+  created_at: string;
+  /** Unique identifier for the comment. */
+  id: string;
+  /** Identifier of the todo the comment belongs to. */
+  todo_id: string;
+  /** Timestamp when the comment was last edited. */
+  updated_at: string;
+}
+
+/** Who wrote the comment. USER comments are written by the user. ASSISTANT comments are recorded when the assistant changes a todo from chat. */
+export type CommentAuthor = 'USER' | 'ASSISTANT';
+
+/** Request payload for adding or editing a comment. */
+export interface CommentRequest {
+  /** Comment text. */
+  body: string;
+}
+
+export interface ContentBlob {
+  content: string;
+  created_at: string;
+  /** Lowercase hex-encoded SHA-256 hash of the content. */
+  hash: string;
+  size_bytes: number;
+}
+
+export type ContextCompactionReason = 'none' | 'token_count_threshold' | 'turn_interval';
+
+/** A conversation between the user and the AI assistant. */
+export interface Conversation {
+  /** Configured token threshold that triggers synchronous context compaction. */
+  context_compaction_trigger_tokens: number;
+  /** Timestamp when the conversation was created. */
+  created_at: string;
+  /** Unique identifier for the conversation. */
+  id: string;
+  /** Generation settings applied to every turn of the conversation. Unset settings fall back to the chat defaults. */
+  settings?: ConversationSettings;
+  /** User-defined title for the conversation. */
+  title: string;
+  /** Source of the conversation title. */
+  title_source: ConversationTitleSource;
+  /** Estimated current context tokens since the last summarized message checkpoint. */
+  total_tokens_used: number;
+  /** Messages added by background work, such as the weekly review, that the caller has not read. Only set in the conversation list. */
+  unread_count?: number;
+  /** Timestamp when the conversation was last updated. */
+  updated_at: string;
+}
+
+/** List of conversations. */
+export interface ConversationListResp {
+  /** List of conversations. */
+  conversations: Conversation[];
+  /** Opaque cursor to fetch the next page of results. Null if there are no more pages. */
+  next_page?: number | null;
+  /** Opaque cursor to fetch the current page of results. Omit or set to null when fetching the first page. */
+  page: number;
+  /** Opaque cursor to fetch the previous page of results. Null if there is no previous page. */
+  previous_page?: number | null;
+}
+
+/** Generation settings applied to every turn of the conversation. Unset settings fall back to the chat defaults. */
+export interface ConversationSettings {
+  /** Model that answers the conversation turns instead of the requested one. It must be available and enabled for the tenant. */
+  model?: string;
+  /** Sampling temperature for the conversation turns. */
+  temperature?: number;
+  /** Nucleus sampling probability mass for the conversation turns. */
+  top_p?: number;
+}
+
+/** Source of the conversation title. */
+export type ConversationTitleSource = 'user' | 'llm' | 'auto';
+
+/** Unread count of a conversation after background work added a message to it. */
+export interface ConversationUnreadEvent {
+  /** Conversation the message was added to. */
+  conversation_id: string;
+  /** Timestamp when the message was added. */
+  created_at: string;
+  /** Message added to the conversation. */
+  message_id: string;
+  /** Messages of the conversation the caller has not read. */
+  unread_count: number;
+}
+
+/** Request payload for creating a board snapshot. */
+export interface CreateBoardSnapshotRequest {
+  /** Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today. */
+  filter?: ViewFilter;
+  /** Snapshot title. Defaults to "Board snapshot". */
+  title?: string;
+}
+
+/** Request payload for creating a todo. */
+export interface CreateTodoRequest {
+  /** Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged. */
+  custom_fields?: CustomFieldValues;
+  /** Calendar due date (date only, no time component). */
+  due_date: string;
+  /** Estimated effort in minutes. Omit or set to 0 when unknown. */
+  estimated_minutes?: number;
+  /** Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location. */
+  location?: TodoLocation;
+  /** Human-readable todo title. Must be non-empty. */
+  title: string;
+}
+
+/** Request payload for creating a personal access token. */
+export interface CreateTokenRequest {
+  /** Name that tells the token apart, such as the script or integration using it. */
+  name: string;
+  /** Scopes granted to the token. */
+  scopes: TokenScope[];
+}
+
+/** A newly created personal access token together with the bearer token, which is only returned once. */
+export interface CreatedToken {
+  /** Bearer token for API requests, starting with pat_. */
+  secret: string;
+  /** A personal access token of a principal. The token itself is never returned after creation. */
+  token: PersonalAccessToken;
+}
+
+/** A tenant-defined field of todos. */
+export interface CustomField {
+  /** Timestamp when the field was first defined. */
+  created_at: string;
+  /** Field name, used as the key of the todo custom_fields object. */
+  name: string;
+  /** Accepted values of an ENUM field. Empty for the other types. */
+  options: string[];
+  /** Type of the values stored in a custom field. */
+  type: CustomFieldType;
+}
+
+/** Type of the values stored in a custom field. */
+export type CustomFieldType = 'TEXT' | 'NUMBER' | 'BOOLEAN' | 'DATE' | 'ENUM';
+
+/** Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged. */
+export type CustomFieldValues = Record<string, unknown>;
+
+export type DateRange = unknown | unknown;
+
+/** Request payload for defining a custom field. */
+export interface DefineCustomFieldRequest {
+  /** Accepted values. Required for ENUM fields and not allowed for the other types. */
+  options?: string[];
+  /** Type of the values stored in a custom field. */
+  type: CustomFieldType;
+}
+
+export interface EditMessageRequest {
+  frequency_penalty?: number;
+  /** Upper bound on the tokens generated for the response. Must not exceed the model's max_output_tokens. */
+  max_tokens?: number;
+  /** New content of the edited user message. */
+  message: string;
+  /** AI model for the new turn. Defaults to the model of the edited message. */
+  model?: string;
+  presence_penalty?: number;
+  /** Sampling seed for best-effort reproducible responses on providers that support it. */
+  seed?: number;
+  /** Sequences where the model stops generating further tokens. */
+  stop?: string[];
+  /** Sampling temperature for the new turn. Overrides the conversation settings and chat default. */
+  temperature?: number;
+  /** Nucleus sampling probability mass for the new turn. Overrides the conversation settings and chat default. */
+  top_p?: number;
+}
+
+/** Error details. */
+export interface Error {
+  /** Machine-readable error code. */
+  code: 'BAD_REQUEST' | 'NOT_FOUND' | 'UNAUTHORIZED' | 'FORBIDDEN' | 'SERVICE_UNAVAILABLE' | 'INTERNAL_ERROR';
+  /** Human-readable error message. */
+  message: string;
+}
+
+/** Standard error envelope. */
+export interface ErrorResp {
+  /** Error details. */
+  error: Error;
+}
+
+/** GraphQL schema of the running build. */
+export interface GraphQLSchemaResp {
+  /** GraphQL schema in SDL. */
+  schema: string;
+  /** Version of the running build. */
+  version: string;
+}
+
+export interface InboundWebhookResp {
+  /** False when the delivery matched no mapping template and was ignored. */
+  created: boolean;
+  /** A todo item. */
+  todo?: Todo;
+}
+
+/** An instruction the assistant follows in every answer. */
+export interface Instruction {
+  /** Conversation the instruction is pinned to. Absent for global instructions. */
+  conversation_id?: string;
+  /** Timestamp when the instruction was pinned. */
+  created_at: string;
+  /** Unique identifier for the instruction. */
+  id: string;
+  /** Instruction text. */
+  text: string;
+}
+
+/** A paginated list of comments. */
+export interface ListCommentsResp {
+  /** List of comments, newest first. */
+  items: Comment[];
+  /** Next page number. Null if there are no more pages. */
+  next_page?: number | null;
+  /** Current page number. */
+  page: number;
+  /** Previous page number. Null if there is no previous page. */
+  previous_page?: number | null;
+}
+
+/** The custom fields of the tenant. */
+export interface ListCustomFieldsResp {
+  /** Custom fields ordered by name. */
+  items: CustomField[];
+}
+
+/** Pinned instructions. */
+export interface ListInstructionsResp {
+  /** Global instructions followed by the ones pinned to the conversation. */
+  items: Instruction[];
+}
+
+/** Long-term memories. */
+export interface ListMemoriesResp {
+  /** Memories, newest first. */
+  items: Memory[];
+}
+
+/** The pending project suggestions. */
+export interface ListProjectSuggestionsResp {
+  /** Suggestions, largest group first. */
+  items: ProjectSuggestion[];
+}
+
+/** The accepted projects. */
+export interface ListProjectsResp {
+  /** Projects, newest first. */
+  items: Project[];
+}
+
+/** The active sessions of the calling principal. */
+export interface ListSessionsResp {
+  /** Sessions ordered by last use, most recent first. */
+  items: Session[];
+}
+
+/** The saved templates. */
+export interface ListTemplatesResp {
+  /** Templates ordered by name. */
+  items: Template[];
+}
+
+/** A paginated list of todos. */
+export interface ListTodosResp {
+  /** List of todos. */
+  items: Todo[];
+  /** Opaque cursor to fetch the next page of results. Null if there are no more pages. */
+  next_page?: number | null;
+  /** Opaque cursor to fetch the current page of results. Omit or set to null when fetching the first page. */
+  page: number;
+  /** Opaque cursor to fetch the previous page of results. Null if there is no previous page. */
+  previous_page?: number | null;
+}
+
+/** The active personal access tokens of the calling principal. */
+export interface ListTokensResp {
+  /** Tokens ordered by creation, newest first. */
+  items: PersonalAccessToken[];
+}
+
+/** The built-in and saved views. */
+export interface ListViewsResp {
+  /** Built-in views followed by saved views ordered by name. */
+  items: View[];
+}
+
+/** Message to mark the conversation read up to. */
+export interface MarkConversationReadRequest {
+  /** Last message read. Omit to mark every message of the conversation read. */
+  message_id?: string;
+}
+
+/** A fact or preference about the user extracted from an earlier conversation. */
+export interface Memory {
+  /** Timestamp when the memory was stored. */
+  created_at: string;
+  /** Unique identifier for the memory. */
+  id: string;
+  /** Whether the memory is a durable fact or a preference. */
+  kind: 'fact' | 'preference';
+  /** Conversation the memory was extracted from. Absent when that conversation was deleted. */
+  source_conversation_id?: string;
+  /** Memory text. */
+  text: string;
+}
+
+/** Information about an AI model. */
+export interface ModelInfo {
+  /** Unique identifier for the model. */
+  id: string;
+  /** Maximum number of tokens one response of this model may generate. Omitted when unknown. */
+  max_output_tokens?: number;
+  /** Human-readable name for the model. */
+  name: string;
+}
+
+/** List of available AI models. */
+export interface ModelListResp {
+  /** Available AI model identifiers. */
+  models: ModelInfo[];
+}
+
+export interface NextUpTodoItem {
+  reason: string;
+  title: string;
+}
+
+/** A personal access token of a principal. The token itself is never returned after creation. */
+export interface PersonalAccessToken {
+  /** Timestamp when the token was created. */
+  created_at: string;
+  /** Unique identifier for the token. */
+  id: string;
+  /** Timestamp when the token was last used, recorded at most once a minute. Absent when never used. */
+  last_used_at?: string;
+  /** Name of the token. */
+  name: string;
+  /** Scopes granted to the token. */
+  scopes: TokenScope[];
+}
+
+/** Message of a background job to post into a conversation. */
+export interface PostSystemMessageRequest {
+  /** Message content, in Markdown. */
+  content: string;
+  /** Name of the background job posting the message, recorded in traces. */
+  source?: string;
+}
+
+/** A named group of related todos. */
+export interface Project {
+  /** Timestamp when the project was created. */
+  created_at: string;
+  /** Unique identifier for the project. */
+  id: string;
+  /** Project name. */
+  name: string;
+  /** Todos of the project ordered by title. */
+  todos: ProjectTodo[];
+}
+
+/** A group of similar open todos proposed as a project. */
+export interface ProjectSuggestion {
+  /** Timestamp when the group was found. */
+  created_at: string;
+  /** Unique identifier for the suggestion. */
+  id: string;
+  /** Project name suggested by the assistant. */
+  name: string;
+  /** Todos of the group. */
+  todos: ProjectTodo[];
+}
+
+/** A todo grouped into a project or a project suggestion. */
+export interface ProjectTodo {
+  /** Todo identifier. */
+  id: string;
+  /** Todo title. */
+  title: string;
+}
+
+/** Request payload for parsing a quick add line. */
+export interface QuickAddRequest {
+  /** Create the parsed todo instead of only previewing it. */
+  commit?: boolean;
+  /** One line describing the todo. Words starting with # are tags and a word starting with ! is the priority; today, tomorrow, weekdays, next week, next month, "in N days|weeks" and YYYY-MM-DD set the due date. */
+  text: string;
+}
+
+/** Todo parsed from a quick add line. */
+export interface QuickAddResp {
+  /** Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged. */
+  custom_fields: CustomFieldValues;
+  /** Due date named by the line, or today when it names none. */
+  due_date: string;
+  /** Lowercased priority of the line, without the ! sign. */
+  priority?: string;
+  /** Whether the deterministic parser or the model resolved the title and due date. */
+  source: 'PARSER' | 'LLM';
+  /** Lowercased tags of the line, without the # sign. */
+  tags: string[];
+  /** Title left once the date, tags and priority are removed. */
+  title: string;
+  /** A todo item. */
+  todo?: Todo;
+  /** Tags and priority matching no custom field; they are not applied. A tag sets the BOOLEAN field of the same name or an ENUM field with a matching option, and the priority sets the priority field. */
+  unresolved: string[];
+}
+
+/** Request payload for refreshing a session. */
+export interface RefreshSessionRequest {
+  /** Refresh token issued when the session was started or last refreshed. */
+  refresh_token: string;
+}
+
+export interface RegenerateMessageRequest {
+  frequency_penalty?: number;
+  /** Upper bound on the tokens generated for the response. Must not exceed the model's max_output_tokens. */
+  max_tokens?: number;
+  /** AI model for the regenerated response. Defaults to the model of the regenerated message. */
+  model?: string;
+  presence_penalty?: number;
+  /** Sampling seed for best-effort reproducible responses on providers that support it. */
+  seed?: number;
+  /** Sequences where the model stops generating further tokens. */
+  stop?: string[];
+  /** Sampling temperature for the regenerated response. Overrides the conversation settings and chat default. */
+  temperature?: number;
+  /** Nucleus sampling probability mass for the regenerated response. Overrides the conversation settings and chat default. */
+  top_p?: number;
+}
+
+/** A conversation about the same topic as another conversation. */
+export interface RelatedConversation {
+  /** Identifier of the related conversation. */
+  conversation_id: string;
+  /** Timestamp of the last message of the related conversation. */
+  last_active_at: string;
+  /** Cosine similarity between the conversation topics. */
+  similarity: number;
+  /** Title of the related conversation. */
+  title: string;
+}
+
+/** Related conversations. */
+export interface RelatedConversationListResp {
+  /** Related conversations, closest first. */
+  items: RelatedConversation[];
+}
+
+/** Request payload for pinning an instruction. */
+export interface RememberInstructionRequest {
+  /** Conversation to pin the instruction to. Omit to store it globally. */
+  conversation_id?: string;
+  /** Instruction text. */
+  text: string;
+}
+
+export interface SelectedSkill {
+  name: string;
+  source: string;
+  tools: string[];
+}
+
+/** A device login of a principal. */
+export interface Session {
+  /** Timestamp when the session was started. */
+  created_at: string;
+  /** True for the session the request was authenticated with. */
+  current: boolean;
+  /** Description of the device that started the session. */
+  device: string;
+  /** Timestamp when the refresh token expires unless the session is refreshed. */
+  expires_at: string;
+  /** Unique identifier for the session. */
+  id: string;
+  /** Timestamp when the session was last used, recorded at most once a minute. */
+  last_used_at: string;
+}
+
+/** A session together with its newly issued tokens. */
+export interface SessionTokens {
+  /** Timestamp when the access token expires. */
+  access_expires_at: string;
+  /** Bearer token for API requests. */
+  access_token: string;
+  /** Token to refresh the session. It changes on every refresh. */
+  refresh_token: string;
+  /** A device login of a principal. */
+  session: Session;
+}
+
+/** List of available skills. */
+export interface SkillListResp {
+  /** Available skill definitions. */
+  skills: AvailableSkill[];
+}
+
+export interface SseContextCompactionCompleted {
+  compacted_at: string;
+  conversation_id: string;
+  reason: ContextCompactionReason;
+  unsummarized_message_count: number;
+  unsummarized_total_tokens: number;
+}
+
+export interface SseContextCompactionFailed {
+  conversation_id: string;
+  error: string;
+  reason: ContextCompactionReason;
+  unsummarized_message_count: number;
+  unsummarized_total_tokens: number;
+}
+
+export interface SseContextCompactionStarted {
+  conversation_id: string;
+  reason: ContextCompactionReason;
+  unsummarized_message_count: number;
+  unsummarized_total_tokens: number;
+}
+
+export interface SseContextTruncated {
+  conversation_id: string;
+  dropped_message_count: number;
+  retained_message_count: number;
+  turn_id: string;
+}
+
+export interface SseConversationSplit {
+  conversation_id: string;
+  distance: number;
+  pinned_todos: {
+    due_date: string;
+    id: string;
+    status: string;
+    title: string;
+  }[];
+  previous_conversation_id: string;
+  threshold: number;
+}
+
+export interface SseDelta {
+  text: string;
+}
+
+export interface SseDone {
+  assistant_message_id: string;
+  completed_at: string;
+}
+
+export interface SseMeta {
+  assistant_message_id: string;
+  conversation_id: string;
+  started_at: string;
+  user_message_id: string;
+}
+
+export interface SseReasoning {
+  text: string;
+}
+
+export interface SseTopicShiftSuggested {
+  conversation_id: string;
+  distance: number;
+  threshold: number;
+}
+
+export interface SseTurnFailed {
+  code: TurnErrorCode;
+  conversation_id: string;
+  error: string;
+  /** Whether retrying the same message may succeed. */
+  retriable: boolean;
+  /** Provider supplied delay before retrying, when known. */
+  retry_after_seconds?: number;
+  turn_id: string;
+}
+
+/** Request payload for starting a session. */
+export interface StartSessionRequest {
+  /** Description of the device starting the session. Defaults to the User-Agent header. */
+  device?: string;
+}
+
+export interface SubmitActionApprovalRequest {
+  /** Assistant action call identifier. */
+  action_call_id: string;
+  /** Optional action name for observability. */
+  action_name?: string;
+  conversation_id: string;
+  /** Optional human-readable reason for the decision. */
+  reason?: string | null;
+  /** Human approval decision status for a requested action execution. */
+  status: ActionApprovalStatus;
+  turn_id: string;
+}
+
+export interface SubmitMessageFeedbackRequest {
+  /** -1 for an unhelpful response, 1 for a helpful one. */
+  score: -1 | 1;
+}
+
+export interface SyncConversation {
+  created_at: string;
+  id: string;
+  last_message_at?: string;
+  title: string;
+  /** Source of the conversation title. */
+  title_source: ConversationTitleSource;
+  updated_at: string;
+}
+
+export type SyncEntity = 'TODO' | 'CONVERSATION';
+
+/** A todo mutation recorded offline. CREATE requires title and due_date; UPDATE and DELETE require todo_id. */
+export interface SyncMutation {
+  /** updated_at of the todo version the client edited. When set, the mutation is rejected as a conflict if the server version differs. */
+  base_updated_at?: string;
+  due_date?: string;
+  operation: SyncOperation;
+  /** Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed. */
+  status?: TodoStatus;
+  title?: string;
+  todo_id?: string;
+}
+
+export interface SyncMutationResult {
+  /** Error details. */
+  error?: Error;
+  /** Position of the mutation in the request. */
+  index: number;
+  operation: SyncOperation;
+  status: SyncMutationStatus;
+  /** A todo item. */
+  todo?: Todo;
+  /** Affected todo. Omitted for creates that were not applied. */
+  todo_id?: string;
+}
+
+export type SyncMutationStatus = 'APPLIED' | 'CONFLICT' | 'FAILED';
+
+export type SyncOperation = 'CREATE' | 'UPDATE' | 'DELETE';
+
+export interface SyncPushRequest {
+  mutations: SyncMutation[];
+}
+
+export interface SyncPushResp {
+  results: SyncMutationResult[];
+}
+
+export interface SyncResp {
+  /** Current state of the conversations changed since the cursor. */
+  conversations: SyncConversation[];
+  /** Cursor to pass as `since` on the next sync. */
+  cursor: string;
+  /** True when more changes are available after the returned cursor. */
+  has_more: boolean;
+  /** Current state of the todos changed since the cursor. */
+  todos: Todo[];
+  /** Todos and conversations deleted since the cursor. */
+  tombstones: SyncTombstone[];
+}
+
+export interface SyncTombstone {
+  deleted_at: string;
+  entity: SyncEntity;
+  id: string;
+}
+
+/** A named, reusable list of todos. */
+export interface Template {
+  /** Timestamp when the template was saved. */
+  created_at: string;
+  /** Unique identifier for the template. */
+  id: string;
+  /** Todos the template creates, in order. */
+  items: TemplateItem[];
+  /** Template name. */
+  name: string;
+  /** Timestamp when the template was last changed. */
+  updated_at: string;
+}
+
+/** A todo created when the template is applied. */
+export interface TemplateItem {
+  /** Days between the anchor date and the due date; negative values fall before the anchor. */
+  due_offset_days: number;
+  /** Estimated effort of the todo in minutes. */
+  estimated_minutes?: number;
+  /** Todo title. */
+  title: string;
+}
+
+/** Request payload for saving or updating a template. */
+export interface TemplateRequest {
+  /** Todos the template creates, in order. */
+  items: TemplateItem[];
+  /** Template name, unique regardless of case. */
+  name: string;
+}
+
+/** A work session logged against a todo. */
+export interface TimeEntry {
+  /** Session duration in seconds, measured up to now while running. */
+  duration_seconds: number;
+  /** Timestamp when the session ended. Absent while the timer is running. */
+  ended_at?: string;
+  /** Unique identifier for the time entry. */
+  id: string;
+  /** Timestamp when the session started. */
+  started_at: string;
+  /** Identifier of the todo the session belongs to. */
+  todo_id: string;
+}
+
+/** Logged time aggregated per todo. */
+export interface TimeReport {
+  /** Inclusive start date of the report. */
+  from: string;
+  items: TimeReportItem[];
+  /** Exclusive end date of the report. */
+  to: string;
+  /** Total logged time across all todos in seconds. */
+  total_seconds: number;
+}
+
+export interface TimeReportItem {
+  /** Number of work sessions logged. */
+  entries: number;
+  title: string;
+  todo_id: string;
+  /** Total logged time for the todo in seconds. */
+  total_seconds: number;
+}
+
+/** A todo item. */
+export interface Todo {
+  /** Timestamp when the completed todo was archived. Null for active todos. */
+  archived_at?: string | null;
+  /** Timestamp when the todo was created. */
+  created_at: string;
+  /** Values of the tenant custom fields, keyed by field name. Strings hold TEXT, DATE (YYYY-MM-DD) and ENUM values, numbers hold NUMBER values and booleans hold BOOLEAN values. In updates, a null value removes the field from the todo and omitted fields are left unchanged. */
+  custom_fields?: CustomFieldValues;
+  /** Calendar due date (date only, no time component). */
+  due_date: string;
+  /** Estimated effort in minutes. 0 means the todo has no estimate. */
+  estimated_minutes: number;
+  /** Unique identifier for the todo. */
+  id: string;
+  /** Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location. */
+  location?: TodoLocation;
+  /** Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed. */
+  status: TodoStatus;
+  /** Human-readable todo title. */
+  title: string;
+  /** Timestamp when the todo was last updated. */
+  updated_at: string;
+}
+
+export interface TodoChangeEvent {
+  /** Conversation whose assistant action produced the change, if any. */
+  conversation_id?: string;
+  /** Monotonically increasing sequence of the changes produced by the conversation. */
+  conversation_sequence?: number;
+  created_at: string;
+  /** Monotonically increasing sequence across all todo changes. */
+  sequence: number;
+  todo_id: string;
+  type: 'TODO_CREATED' | 'TODO_UPDATED' | 'TODO_DELETED';
+}
+
+export interface TodoChangesResp {
+  changes: TodoChangeEvent[];
+  /** True when more changes are available after the returned ones. */
+  has_more: boolean;
+  /** Sequence to pass as `since` on the next request. It is the last returned sequence (the conversation sequence when filtering by conversation), or the requested `since` when no changes were returned. */
+  latest_sequence: number;
+}
+
+/** Where the todo takes place, as a free text name and/or coordinates. Latitude and longitude are given together. In an update, an empty object clears the location. */
+export interface TodoLocation {
+  /** Latitude in decimal degrees. */
+  latitude?: number;
+  /** Longitude in decimal degrees. */
+  longitude?: number;
+  /** Free text place name or address. */
+  name?: string;
+}
+
+/** Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed. */
+export type TodoStatus = 'OPEN' | 'DONE';
+
+/** Count of todos per status. */
+export interface TodoStatusCounts {
+  /** Number of completed todos archived by the retention policy. */
+  ARCHIVED: number;
+  /** Number of completed todos that are not archived. */
+  DONE: number;
+  /** Number of open todos. */
+  OPEN: number;
+}
+
+/** Scope of a personal access token. todos:read allows reading todos and the rest of the API, todos:write also allows changing them, and chat allows chatting with the assistant, which only gets write actions with todos:write. */
+export type TokenScope = 'todos:read' | 'todos:write' | 'chat';
+
+export type TurnErrorCode = 'rate_limited' | 'context_too_long' | 'content_filtered' | 'request_too_large' | 'response_too_long' | 'network' | 'shutdown' | 'unknown';
+
+export interface TurnReplay {
+  /** True when the replayed content and action calls are identical to the original ones. */
+  matches: boolean;
+  model: string;
+  original_action_calls: TurnReplayActionCall[];
+  original_content: string;
+  replayed_action_calls: TurnReplayActionCall[];
+  replayed_content: string;
+  /** Recorded sampling seed the turn was replayed with; null when the original turn had none. */
+  seed?: number | null;
+  total_tokens: number;
+  turn_id: string;
+}
+
+export interface TurnReplayActionCall {
+  input: string;
+  name: string;
+}
+
+export interface TurnTranscript {
+  completed_at: string;
+  completion_tokens: number;
+  conversation_id: string;
+  duration_ms: number;
+  prompt_tokens: number;
+  started_at: string;
+  steps: TurnTranscriptStep[];
+  total_tokens: number;
+  turn_id: string;
+}
+
+export interface TurnTranscriptActionCall {
+  id: string;
+  input: string;
+  name: string;
+}
+
+export interface TurnTranscriptStep {
+  /** Action call answered by a tool step. */
+  action_call_id?: string;
+  /** Actions requested by an assistant step. */
+  action_calls?: TurnTranscriptActionCall[];
+  action_executed?: boolean;
+  approval_status?: 'PENDING' | 'APPROVED' | 'REJECTED' | 'AUTO_REJECTED' | 'EXPIRED';
+  completion_tokens: number;
+  content: string;
+  /** Hash of the content blob holding the whole action result when content is only a preview. */
+  content_ref?: string;
+  created_at: string;
+  /** Milliseconds between the creation of the step and its last update, e.g. while streaming. */
+  duration_ms: number;
+  error_message?: string;
+  id: string;
+  message_state: 'COMPLETED' | 'FAILED' | 'MODERATED' | 'STREAMING';
+  model?: string;
+  /** Milliseconds between the start of the turn and the creation of the step. */
+  offset_ms: number;
+  prompt_tokens: number;
+  role: 'user' | 'assistant' | 'system' | 'developer' | 'tool';
+  seed?: number;
+  /** Set when the step was replaced by a regenerated response and no longer reaches the model. */
+  superseded_at?: string;
+  total_tokens: number;
+  turn_sequence: number;
+  updated_at: string;
+}
+
+/** Payload to update conversation. At least one of title or settings must be provided. */
+export interface UpdateConversationRequest {
+  /** Generation settings applied to every turn of the conversation. Unset settings fall back to the chat defaults. */
+  settings?: ConversationSettings;
+  /** New title for the conversation. Must be non-empty. */
+  title?: string;
+}
+
+/** Partial update payload. Provide at least one of: title, status, due_date, estimated_minutes, custom_fields, location. */
+export type UpdateTodoRequest = unknown | unknown | unknown | unknown | unknown | unknown;
+
+/** A named todo list filter. */
+export interface View {
+  /** True for the Today, Upcoming and Someday views, which cannot be changed. */
+  built_in: boolean;
+  /** Timestamp when the view was saved. Absent for built-in views. */
+  created_at?: string;
+  /** Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today. */
+  filter: ViewFilter;
+  /** Unique identifier for the view. Built-in views have stable identifiers. */
+  id: string;
+  /** View name. */
+  name: string;
+  /** Timestamp when the view was last changed. Absent for built-in views. */
+  updated_at?: string;
+}
+
+/** Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today. */
+export interface ViewFilter {
+  /** Lower due date bound (YYYY-MM-DD). Must be provided with due_before. */
+  due_after?: string;
+  /** Upper due date bound (YYYY-MM-DD). Must be provided with due_after. */
+  due_before?: string;
+  /** Lower due date bound in days relative to today, e.g. 1 for tomorrow. */
+  due_from_days?: number;
+  /** Upper due date bound in days relative to today, e.g. 7 for a week ahead. */
+  due_to_days?: number;
+  /** Query expression applied on top of the other fields, using the syntax of the q parameter of the todo list, e.g. status:open area:work -area:errands. */
+  query?: string;
+  /** Semantic search query. */
+  search_by_similarity?: string;
+  /** Title contains query. */
+  search_by_title?: string;
+  /** Sorting criteria. Similarity sorting requires search_by_similarity. */
+  sort_by?: 'createdAtAsc' | 'createdAtDesc' | 'dueDateAsc' | 'dueDateDesc' | 'similarityAsc' | 'similarityDesc' | 'smartOrder';
+  /** Todo lifecycle status. OPEN means the todo is active. DONE means the todo has been completed. */
+  status?: TodoStatus;
+}
+
+/** Request payload for saving or updating a view. */
+export interface ViewRequest {
+  /** Todo list filter stored by a view, using the same fields as the todo list filters. Due dates are bounded either by due_after and due_before, or by day offsets relative to today. */
+  filter: ViewFilter;
+  /** View name, unique regardless of case. */
+  name: string;
+}
+
+/** Assistant-written review of the todos that were due in one week. */
+export interface WeeklyReview {
+  /** Titles of the planned todos that are done. */
+  completed: string[];
+  /** Conversation holding the review report. */
+  conversation_id: string;
+  /** Timestamp when the review was generated. */
+  generated_at: string;
+  /** Review identifier. */
+  id: string;
+  /** Model that wrote the report. */
+  model: string;
+  /** Last day of the reviewed week. */
+  period_end: string;
+  /** First day of the reviewed week. */
+  period_start: string;
+  /** Number of todos due in the reviewed week. */
+  planned: number;
+  /** The review report with suggestions for the next week. */
+  report: string;
+  /** Titles of the planned todos that are still open. */
+  slipped: string[];
+}
+
+/** List of weekly reviews. */
+export interface WeeklyReviewListResp {
+  /** Next page number. Null if there are no more pages. */
+  next_page?: number | null;
+  /** Current page number. */
+  page: number;
+  /** Previous page number. Null if there is no previous page. */
+  previous_page?: number | null;
+  /** List of weekly reviews. */
+  reviews: WeeklyReview[];
+}